@@ -1,6 +1,8 @@
 {{- define "image" -}}
 {{- if $.ref -}}
 {{ $.ref }}
+{{- else if $.digest -}}
+{{ required "$.repository is required" $.repository }}@{{ $.digest }}
 {{- else -}}
 {{- if hasPrefix "sha256:" (required "$.tag is required" $.tag) -}}
 {{ required "$.repository is required" $.repository }}@{{ required "$.tag is required" $.tag }}
@@ -216,6 +218,13 @@ controllers:
     {{- if .Values.config.controllers.shoot.dnsEntryTTLSeconds }}
     dnsEntryTTLSeconds: {{ .Values.config.controllers.shoot.dnsEntryTTLSeconds }}
     {{- end }}
+    {{- if .Values.config.controllers.shoot.forceDeletionExtensionGracePeriod }}
+    forceDeletionExtensionGracePeriod: {{ .Values.config.controllers.shoot.forceDeletionExtensionGracePeriod }}
+    {{- end }}
+    {{- if .Values.config.controllers.shoot.dnsPropagation }}
+    dnsPropagation:
+{{ toYaml .Values.config.controllers.shoot.dnsPropagation | indent 4 }}
+    {{- end }}
   shootCare:
     concurrentSyncs: {{ required ".Values.config.controllers.shootCare.concurrentSyncs is required" .Values.config.controllers.shootCare.concurrentSyncs }}
     syncPeriod: {{ required ".Values.config.controllers.shootCare.syncPeriod is required" .Values.config.controllers.shootCare.syncPeriod }}
@@ -234,6 +243,10 @@ controllers:
 {{ toYaml .Values.config.controllers.shootCare.conditionThresholds | indent 4 }}
     {{- end }}
     webhookRemediatorEnabled: {{ required ".Values.config.controllers.shootCare.webhookRemediatorEnabled is required" .Values.config.controllers.shootCare.webhookRemediatorEnabled }}
+    {{- if .Values.config.controllers.shootCare.remediations }}
+    remediations:
+{{ toYaml .Values.config.controllers.shootCare.remediations | indent 4 }}
+    {{- end }}
   seedCare:
     syncPeriod: {{ required ".Values.config.controllers.seedCare.syncPeriod is required" .Values.config.controllers.seedCare.syncPeriod }}
     conditionThresholds:
@@ -245,6 +258,18 @@ controllers:
     concurrentSyncs: {{ required ".Values.config.controllers.shootState.concurrentSyncs is required" .Values.config.controllers.shootState.concurrentSyncs }}
     syncPeriod: {{ required ".Values.config.controllers.shootState.syncPeriod is required" .Values.config.controllers.shootState.syncPeriod }}
   {{- end }}
+  {{- if .Values.config.controllers.shootHibernationCleanup }}
+  shootHibernationCleanup:
+    {{- if hasKey .Values.config.controllers.shootHibernationCleanup "concurrentSyncs" }}
+    concurrentSyncs: {{ .Values.config.controllers.shootHibernationCleanup.concurrentSyncs }}
+    {{- end }}
+    {{- if .Values.config.controllers.shootHibernationCleanup.syncPeriod }}
+    syncPeriod: {{ .Values.config.controllers.shootHibernationCleanup.syncPeriod }}
+    {{- end }}
+    {{- if .Values.config.controllers.shootHibernationCleanup.minimumHibernationDuration }}
+    minimumHibernationDuration: {{ .Values.config.controllers.shootHibernationCleanup.minimumHibernationDuration }}
+    {{- end }}
+  {{- end }}
   {{- if .Values.config.controllers.managedSeed }}
   managedSeed:
     concurrentSyncs: {{ required ".Values.config.controllers.managedSeed.concurrentSyncs is required" .Values.config.controllers.managedSeed.concurrentSyncs }}
@@ -256,6 +281,10 @@ controllers:
     {{- if .Values.config.controllers.managedSeed.jitterUpdates }}
     jitterUpdates: {{ .Values.config.controllers.managedSeed.jitterUpdates }}
     {{- end }}
+    {{- if .Values.config.controllers.managedSeed.preflightChecks }}
+    preflightChecks:
+{{ toYaml .Values.config.controllers.managedSeed.preflightChecks | indent 6 }}
+    {{- end }}
   {{- end }}
   {{- if .Values.config.controllers.networkPolicy }}
   networkPolicy:
@@ -277,6 +306,18 @@ controllers:
     concurrentSyncs: {{ .Values.config.controllers.vpaEvictionRequirements.concurrentSyncs }}
     {{- end }}
   {{- end }}
+  {{- if .Values.config.controllers.etcdDefragmentationCoordinator }}
+  etcdDefragmentationCoordinator:
+    {{- if .Values.config.controllers.etcdDefragmentationCoordinator.syncPeriod }}
+    syncPeriod: {{ .Values.config.controllers.etcdDefragmentationCoordinator.syncPeriod }}
+    {{- end }}
+    {{- if .Values.config.controllers.etcdDefragmentationCoordinator.slotDuration }}
+    slotDuration: {{ .Values.config.controllers.etcdDefragmentationCoordinator.slotDuration }}
+    {{- end }}
+    {{- if .Values.config.controllers.etcdDefragmentationCoordinator.maxConcurrentDefragmentationsPerZone }}
+    maxConcurrentDefragmentationsPerZone: {{ .Values.config.controllers.etcdDefragmentationCoordinator.maxConcurrentDefragmentationsPerZone }}
+    {{- end }}
+  {{- end }}
 resources:
   capacity:
     shoots: {{ required ".Values.config.resources.capacity.shoots is required" .Values.config.resources.capacity.shoots }}
@@ -344,6 +385,14 @@ exposureClassHandlers:
 nodeToleration:
 {{ toYaml .Values.nodeToleration | indent 2 }}
 {{- end}}
+{{- if .Values.config.shootNamespaceConstraints }}
+shootNamespaceConstraints:
+{{ toYaml .Values.config.shootNamespaceConstraints | indent 2 }}
+{{- end }}
+{{- if .Values.config.imagePrePulling }}
+imagePrePulling:
+{{ toYaml .Values.config.imagePrePulling | indent 2 }}
+{{- end }}
 {{- end -}}
 
 {{- define "gardenlet.config.name" -}}
@@ -164,7 +164,7 @@ func getGardenletClusterRole(labels map[string]string) *rbacv1.ClusterRole {
 			},
 			{
 				APIGroups: []string{""},
-				Resources: []string{"configmaps", "namespaces", "secrets", "serviceaccounts", "services"},
+				Resources: []string{"configmaps", "limitranges", "namespaces", "resourcequotas", "secrets", "serviceaccounts", "services"},
 				Verbs:     []string{"create", "delete", "deletecollection", "get", "list", "watch", "patch", "update"},
 			},
 			{
@@ -230,6 +230,11 @@ func getGardenletClusterRole(labels map[string]string) *rbacv1.ClusterRole {
 				Resources: []string{"deployments", "deployments/scale", "statefulsets", "statefulsets/scale", "replicasets"},
 				Verbs:     []string{"create", "delete", "deletecollection", "get", "list", "watch", "patch", "update"},
 			},
+			{
+				APIGroups: []string{"apps"},
+				Resources: []string{"daemonsets"},
+				Verbs:     []string{"get", "list", "watch"},
+			},
 			{
 				APIGroups: []string{"autoscaling"},
 				Resources: []string{"horizontalpodautoscalers"},
@@ -240,6 +245,16 @@ func getGardenletClusterRole(labels map[string]string) *rbacv1.ClusterRole {
 				Resources: []string{"verticalpodautoscalers"},
 				Verbs:     []string{"create", "delete", "get", "list", "watch", "patch", "update"},
 			},
+			{
+				APIGroups: []string{"autoscaling.k8s.io"},
+				Resources: []string{"verticalpodautoscalers/status"},
+				Verbs:     []string{"patch"},
+			},
+			{
+				APIGroups: []string{"autoscaling.k8s.io"},
+				Resources: []string{"verticalpodautoscalercheckpoints"},
+				Verbs:     []string{"deletecollection"},
+			},
 			{
 				APIGroups: []string{"druid.gardener.cloud"},
 				Resources: []string{"etcds", "etcdcopybackupstasks"},
@@ -247,12 +262,12 @@ func getGardenletClusterRole(labels map[string]string) *rbacv1.ClusterRole {
 			},
 			{
 				APIGroups: []string{"extensions.gardener.cloud"},
-				Resources: []string{"backupbuckets", "backupentries", "bastions", "clusters", "containerruntimes", "controlplanes", "dnsrecords", "extensions", "infrastructures", "networks", "operatingsystemconfigs", "workers"},
+				Resources: []string{"auditsinks", "backupbuckets", "backupentries", "bastions", "clusters", "containerruntimes", "controlplanes", "dnsrecords", "extensions", "infrastructures", "networks", "operatingsystemconfigs", "workers"},
 				Verbs:     []string{"create", "delete", "get", "list", "watch", "patch", "update"},
 			},
 			{
 				APIGroups: []string{"extensions.gardener.cloud"},
-				Resources: []string{"backupbuckets/status", "backupentries/status", "containerruntimes/status", "controlplanes/status", "dnsrecords/status", "extensions/status", "infrastructures/status", "networks/status", "operatingsystemconfigs/status", "workers/status"},
+				Resources: []string{"auditsinks/status", "backupbuckets/status", "backupentries/status", "containerruntimes/status", "controlplanes/status", "dnsrecords/status", "extensions/status", "infrastructures/status", "networks/status", "operatingsystemconfigs/status", "workers/status"},
 				Verbs:     []string{"patch", "update"},
 			},
 			{
@@ -290,6 +305,11 @@ func getGardenletClusterRole(labels map[string]string) *rbacv1.ClusterRole {
 				Resources: []string{"priorityclasses"},
 				Verbs:     []string{"create", "delete", "get", "list", "watch", "patch", "update"},
 			},
+			{
+				APIGroups: []string{"storage.k8s.io"},
+				Resources: []string{"volumeattachments"},
+				Verbs:     []string{"get", "list", "watch"},
+			},
 			{
 				NonResourceURLs: []string{"/healthz", "/version"},
 				Verbs:           []string{"get"},
@@ -664,7 +684,8 @@ func ComputeExpectedGardenletConfiguration(
 				RetryDuration: &metav1.Duration{
 					Duration: 12 * time.Hour,
 				},
-				DNSEntryTTLSeconds: ptr.To[int64](120),
+				DNSEntryTTLSeconds:                ptr.To[int64](120),
+				ForceDeletionExtensionGracePeriod: &metav1.Duration{Duration: 5 * time.Minute},
 			},
 			ManagedSeed: &gardenletconfigv1alpha1.ManagedSeedControllerConfiguration{
 				ConcurrentSyncs: &five,
@@ -740,6 +761,11 @@ func ComputeExpectedGardenletConfiguration(
 				ConcurrentSyncs: &five,
 				SyncPeriod:      &metav1.Duration{Duration: 6 * time.Hour},
 			},
+			ShootHibernationCleanup: &gardenletconfigv1alpha1.ShootHibernationCleanupControllerConfiguration{
+				ConcurrentSyncs:            &five,
+				SyncPeriod:                 &metav1.Duration{Duration: time.Hour},
+				MinimumHibernationDuration: &metav1.Duration{Duration: 168 * time.Hour},
+			},
 			TokenRequestorServiceAccount: &gardenletconfigv1alpha1.TokenRequestorServiceAccountControllerConfiguration{
 				ConcurrentSyncs: &five,
 			},
@@ -749,6 +775,11 @@ func ComputeExpectedGardenletConfiguration(
 			VPAEvictionRequirements: &gardenletconfigv1alpha1.VPAEvictionRequirementsControllerConfiguration{
 				ConcurrentSyncs: &five,
 			},
+			EtcdDefragmentationCoordinator: &gardenletconfigv1alpha1.EtcdDefragmentationCoordinatorControllerConfiguration{
+				SyncPeriod:                           &metav1.Duration{Duration: time.Hour},
+				SlotDuration:                         &metav1.Duration{Duration: 10 * time.Minute},
+				MaxConcurrentDefragmentationsPerZone: ptr.To(2),
+			},
 			ControllerInstallation: &gardenletconfigv1alpha1.ControllerInstallationControllerConfiguration{
 				ConcurrentSyncs: &twenty,
 			},
@@ -919,6 +950,11 @@ func ComputeExpectedGardenletDeploymentSpec(
 		return appsv1.DeploymentSpec{}, errors.New("the image repository and tag must be provided")
 	}
 
+	imageRef := fmt.Sprintf("%s:%s", *image.Repository, *image.Tag)
+	if image.Digest != nil {
+		imageRef = fmt.Sprintf("%s@%s", *image.Repository, *image.Digest)
+	}
+
 	deployment := appsv1.DeploymentSpec{
 		RevisionHistoryLimit: ptr.To[int32](2),
 		Replicas:             ptr.To[int32](2),
@@ -947,7 +983,7 @@ func ComputeExpectedGardenletDeploymentSpec(
 				Containers: []corev1.Container{
 					{
 						Name:            "gardenlet",
-						Image:           fmt.Sprintf("%s:%s", *image.Repository, *image.Tag),
+						Image:           imageRef,
 						ImagePullPolicy: corev1.PullIfNotPresent,
 						Args: []string{
 							"--config=/etc/gardenlet/config/config.yaml",
@@ -12,9 +12,11 @@ import (
 
 	"github.com/gardener/gardener/pkg/gardenadm/cmd/bootstrap"
 	"github.com/gardener/gardener/pkg/gardenadm/cmd/connect"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd/disconnect"
 	"github.com/gardener/gardener/pkg/gardenadm/cmd/discover"
 	initcmd "github.com/gardener/gardener/pkg/gardenadm/cmd/init"
 	"github.com/gardener/gardener/pkg/gardenadm/cmd/join"
+	"github.com/gardener/gardener/pkg/gardenadm/cmd/reconnect"
 	"github.com/gardener/gardener/pkg/gardenadm/cmd/token"
 	"github.com/gardener/gardener/pkg/gardenadm/cmd/version"
 )
@@ -70,6 +72,8 @@ func prepareGardenGroup(cmd *cobra.Command, opts *Options) {
 	for _, subcommand := range []*cobra.Command{
 		discover.NewCommand(opts.IOStreams),
 		connect.NewCommand(opts.IOStreams),
+		disconnect.NewCommand(opts.IOStreams),
+		reconnect.NewCommand(opts.IOStreams),
 	} {
 		subcommand.GroupID = group.ID
 		cmd.AddCommand(subcommand)
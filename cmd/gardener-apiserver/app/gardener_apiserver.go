@@ -18,6 +18,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/apiserver/pkg/admission"
 	openapinamer "k8s.io/apiserver/pkg/endpoints/openapi"
 	"k8s.io/apiserver/pkg/quota/v1/generic"
@@ -52,6 +53,7 @@ import (
 	admissioninitializer "github.com/gardener/gardener/pkg/apiserver/admission/initializer"
 	"github.com/gardener/gardener/pkg/apiserver/openapi"
 	"github.com/gardener/gardener/pkg/apiserver/storage"
+	"github.com/gardener/gardener/pkg/apiserver/storage/projectencryption"
 	gardencoreclientset "github.com/gardener/gardener/pkg/client/core/clientset/versioned"
 	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/externalversions"
 	kubernetesclient "github.com/gardener/gardener/pkg/client/kubernetes"
@@ -450,5 +452,24 @@ func (o *Options) ApplyTo(config *apiserver.Config, kubeClient kubernetes.Interf
 		),
 	}
 
-	return o.Recommended.Etcd.ApplyWithStorageFactoryTo(storageFactory, &gardenerAPIServerConfig.Config)
+	if err := o.Recommended.Etcd.ApplyWithStorageFactoryTo(storageFactory, &gardenerAPIServerConfig.Config); err != nil {
+		return err
+	}
+
+	projectEncryptionConfig, err := o.ExtraOptions.ProjectEncryptionConfiguration()
+	if err != nil || projectEncryptionConfig == nil {
+		return err
+	}
+
+	// Wrap the transformers configured via `--encryption-provider-config` (if any) so that project-scoped resources are
+	// additionally encrypted with per-project data keys. Same as for the KMS transformers, the connection to the KMS
+	// plugin is kept until the server is drained.
+	resourceTransformers, err := projectencryption.NewResourceTransformers(wait.ContextForChannel(gardenerAPIServerConfig.DrainedNotify()), projectEncryptionConfig, gardenerAPIServerConfig.ResourceTransformers)
+	if err != nil {
+		return err
+	}
+	gardenerAPIServerConfig.ResourceTransformers = resourceTransformers
+	gardenerAPIServerConfig.RESTOptionsGetter = o.Recommended.Etcd.CreateRESTOptionsGetter(storageFactory, resourceTransformers)
+
+	return nil
 }
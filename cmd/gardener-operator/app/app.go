@@ -33,6 +33,8 @@ import (
 	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
 	"github.com/gardener/gardener/extensions/pkg/webhook/certificates"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	operatorv1alpha1 "github.com/gardener/gardener/pkg/apis/operator/v1alpha1"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	clientmapbuilder "github.com/gardener/gardener/pkg/client/kubernetes/clientmap/builder"
 	"github.com/gardener/gardener/pkg/controllerutils"
@@ -86,9 +88,15 @@ func run(ctx context.Context, cancel context.CancelFunc, log logr.Logger, cfg *c
 		return err
 	}
 
-	var extraHandlers map[string]http.Handler
+	var (
+		extraHandlers map[string]http.Handler
+		debugSnapshot = &routes.DebugSnapshot{Component: Name}
+	)
 	if cfg.Debugging != nil && cfg.Debugging.EnableProfiling {
-		extraHandlers = routes.ProfilingHandlers
+		var err error
+		if extraHandlers, err = routes.DebugHandlers(log, restConfig, debugSnapshot); err != nil {
+			return err
+		}
 		if cfg.Debugging.EnableContentionProfiling {
 			goruntime.SetBlockProfileRate(1)
 		}
@@ -125,6 +133,16 @@ func run(ctx context.Context, cancel context.CancelFunc, log logr.Logger, cfg *c
 		return err
 	}
 
+	debugSnapshot.AddCachedObjects(routes.CachedObjects{
+		Cluster: "runtime",
+		Reader:  mgr.GetCache(),
+		Lists: []client.ObjectList{
+			&operatorv1alpha1.GardenList{},
+			&operatorv1alpha1.ExtensionList{},
+			&resourcesv1alpha1.ManagedResourceList{},
+		},
+	})
+
 	log.Info("Setting up health check endpoints")
 	if err := mgr.AddHealthzCheck("ping", healthz.Ping); err != nil {
 		return err
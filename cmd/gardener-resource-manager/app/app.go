@@ -35,6 +35,7 @@ import (
 	"github.com/gardener/gardener/cmd/gardener-resource-manager/app/bootstrappers"
 	"github.com/gardener/gardener/cmd/utils/initrun"
 	"github.com/gardener/gardener/pkg/api/indexer"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/controllerutils"
 	"github.com/gardener/gardener/pkg/controllerutils/routes"
@@ -102,9 +103,15 @@ func run(ctx context.Context, log logr.Logger, cfg *config.ResourceManagerConfig
 		managerScheme = resourcemanagerclient.SourceScheme
 	}
 
-	var extraHandlers map[string]http.Handler
+	var (
+		extraHandlers map[string]http.Handler
+		debugSnapshot = &routes.DebugSnapshot{Component: Name}
+	)
 	if cfg.Debugging != nil && cfg.Debugging.EnableProfiling {
-		extraHandlers = routes.ProfilingHandlers
+		var err error
+		if extraHandlers, err = routes.DebugHandlers(log, sourceRESTConfig, debugSnapshot); err != nil {
+			return err
+		}
 		if cfg.Debugging.EnableContentionProfiling {
 			goruntime.SetBlockProfileRate(1)
 		}
@@ -144,6 +151,12 @@ func run(ctx context.Context, log logr.Logger, cfg *config.ResourceManagerConfig
 		return err
 	}
 
+	debugSnapshot.AddCachedObjects(routes.CachedObjects{
+		Cluster: "source",
+		Reader:  mgr.GetCache(),
+		Lists:   []client.ObjectList{&resourcesv1alpha1.ManagedResourceList{}},
+	})
+
 	log.Info("Setting up health check endpoints")
 	sourceClientSet, err := kubernetesclientset.NewForConfig(sourceRESTConfig)
 	if err != nil {
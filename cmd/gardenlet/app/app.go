@@ -50,6 +50,7 @@ import (
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/gardener/gardener/pkg/apis/operations"
 	operationsv1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
 	securityv1alpha1 "github.com/gardener/gardener/pkg/apis/security/v1alpha1"
 	seedmanagementv1alpha1 "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
@@ -112,9 +113,15 @@ func run(ctx context.Context, cancel context.CancelFunc, log logr.Logger, cfg *c
 		return err
 	}
 
-	var extraHandlers map[string]http.Handler
+	var (
+		extraHandlers map[string]http.Handler
+		debugSnapshot = &routes.DebugSnapshot{Component: Name}
+	)
 	if cfg.Debugging != nil && cfg.Debugging.EnableProfiling {
-		extraHandlers = routes.ProfilingHandlers
+		var err error
+		if extraHandlers, err = routes.DebugHandlers(log, seedRESTConfig, debugSnapshot); err != nil {
+			return err
+		}
 		if cfg.Debugging.EnableContentionProfiling {
 			goruntime.SetBlockProfileRate(1)
 		}
@@ -155,6 +162,12 @@ func run(ctx context.Context, cancel context.CancelFunc, log logr.Logger, cfg *c
 		return err
 	}
 
+	debugSnapshot.AddCachedObjects(routes.CachedObjects{
+		Cluster: "seed",
+		Reader:  mgr.GetCache(),
+		Lists:   []client.ObjectList{&resourcesv1alpha1.ManagedResourceList{}},
+	})
+
 	log.Info("Setting up periodic health manager")
 	healthGracePeriod := time.Duration((*cfg.Controllers.Seed.LeaseResyncSeconds)*(*cfg.Controllers.Seed.LeaseResyncMissThreshold)) * time.Second
 	healthManager := gardenerhealthz.NewPeriodicHealthz(clock.RealClock{}, healthGracePeriod)
@@ -195,6 +208,7 @@ func run(ctx context.Context, cancel context.CancelFunc, log logr.Logger, cfg *c
 				config:                    cfg,
 				healthManager:             healthManager,
 				kubeconfigBootstrapResult: kubeconfigBootstrapResult,
+				debugSnapshot:             debugSnapshot,
 			},
 		},
 	}); err != nil {
@@ -211,6 +225,7 @@ type garden struct {
 	config                    *config.GardenletConfiguration
 	healthManager             gardenerhealthz.Manager
 	kubeconfigBootstrapResult *bootstrappers.KubeconfigBootstrapResult
+	debugSnapshot             *routes.DebugSnapshot
 }
 
 func (g *garden) Start(ctx context.Context) error {
@@ -332,6 +347,12 @@ func (g *garden) Start(ctx context.Context) error {
 	}
 
 	log.Info("Adding garden cluster to manager")
+	g.debugSnapshot.AddCachedObjects(routes.CachedObjects{
+		Cluster: "garden",
+		Reader:  gardenCluster.GetCache(),
+		Lists:   []client.ObjectList{&gardencorev1beta1.ShootList{}},
+	})
+
 	if err := g.mgr.Add(gardenCluster); err != nil {
 		return fmt.Errorf("failed adding garden cluster to manager: %w", err)
 	}
@@ -50,6 +50,8 @@ func InitRun(cmd *cobra.Command, opts Options, name string) (logr.Logger, error)
 	if err != nil {
 		return logr.Discard(), fmt.Errorf("error instantiating zap logger: %w", err)
 	}
+	// remember the most recent errors for debug snapshots
+	log = logger.RecentErrors.Wrap(log)
 
 	logf.SetLogger(log)
 	klog.SetLogger(log)
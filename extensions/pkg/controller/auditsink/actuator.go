@@ -0,0 +1,28 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package auditsink
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+// Actuator acts upon AuditSink resources.
+type Actuator interface {
+	// Reconcile reconciles the AuditSink resource.
+	Reconcile(context.Context, logr.Logger, *extensionsv1alpha1.AuditSink, *extensionscontroller.Cluster) error
+	// Delete deletes the AuditSink resource.
+	Delete(context.Context, logr.Logger, *extensionsv1alpha1.AuditSink, *extensionscontroller.Cluster) error
+	// ForceDelete forcefully deletes the AuditSink resource.
+	ForceDelete(context.Context, logr.Logger, *extensionsv1alpha1.AuditSink, *extensionscontroller.Cluster) error
+	// Restore restores the AuditSink resource.
+	Restore(context.Context, logr.Logger, *extensionsv1alpha1.AuditSink, *extensionscontroller.Cluster) error
+	// Migrate migrates the AuditSink resource.
+	Migrate(context.Context, logr.Logger, *extensionsv1alpha1.AuditSink, *extensionscontroller.Cluster) error
+}
@@ -0,0 +1,63 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package extension
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+type compositeActuator struct {
+	actuators []Actuator
+}
+
+// NewCompositeActuator returns an actuator which chains the given actuators acting upon the same Extension, e.g., the
+// actuator of a base extension and the actuator of an extension layered on top of it. The actuators are reconciled
+// and restored in the given order, and deleted and migrated in the reverse order. The chain stops at the first
+// actuator returning an error.
+func NewCompositeActuator(actuators ...Actuator) Actuator {
+	return &compositeActuator{actuators: actuators}
+}
+
+func (c *compositeActuator) Reconcile(ctx context.Context, log logr.Logger, ex *extensionsv1alpha1.Extension) error {
+	return c.chain(log, func(log logr.Logger, actuator Actuator) error { return actuator.Reconcile(ctx, log, ex) })
+}
+
+func (c *compositeActuator) Restore(ctx context.Context, log logr.Logger, ex *extensionsv1alpha1.Extension) error {
+	return c.chain(log, func(log logr.Logger, actuator Actuator) error { return actuator.Restore(ctx, log, ex) })
+}
+
+func (c *compositeActuator) Delete(ctx context.Context, log logr.Logger, ex *extensionsv1alpha1.Extension) error {
+	return c.chainReverse(log, func(log logr.Logger, actuator Actuator) error { return actuator.Delete(ctx, log, ex) })
+}
+
+func (c *compositeActuator) ForceDelete(ctx context.Context, log logr.Logger, ex *extensionsv1alpha1.Extension) error {
+	return c.chainReverse(log, func(log logr.Logger, actuator Actuator) error { return actuator.ForceDelete(ctx, log, ex) })
+}
+
+func (c *compositeActuator) Migrate(ctx context.Context, log logr.Logger, ex *extensionsv1alpha1.Extension) error {
+	return c.chainReverse(log, func(log logr.Logger, actuator Actuator) error { return actuator.Migrate(ctx, log, ex) })
+}
+
+func (c *compositeActuator) chain(log logr.Logger, fn func(logr.Logger, Actuator) error) error {
+	for i, actuator := range c.actuators {
+		if err := fn(log.WithValues("actuator", i), actuator); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *compositeActuator) chainReverse(log logr.Logger, fn func(logr.Logger, Actuator) error) error {
+	for i := len(c.actuators) - 1; i >= 0; i-- {
+		if err := fn(log.WithValues("actuator", i), c.actuators[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
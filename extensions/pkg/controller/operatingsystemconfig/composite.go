@@ -0,0 +1,113 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operatingsystemconfig
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+type compositeActuator struct {
+	actuators []Actuator
+}
+
+// NewCompositeActuator returns an actuator which chains the given actuators acting upon the same
+// OperatingSystemConfig, e.g., the actuator of a base operating system extension and the actuator of a hardening
+// extension. The actuators are reconciled and restored in the given order, and deleted and migrated in the reverse
+// order. The results are merged as follows:
+//   - The user data is owned by exactly one actuator. It is an error if more than one actuator returns user data.
+//   - Units and files are owned by the actuator returning them. If a later actuator returns a unit with the same name
+//     or a file with the same path, it takes over the ownership and its unit or file replaces the earlier one.
+func NewCompositeActuator(actuators ...Actuator) Actuator {
+	return &compositeActuator{actuators: actuators}
+}
+
+func (c *compositeActuator) Reconcile(ctx context.Context, log logr.Logger, osc *extensionsv1alpha1.OperatingSystemConfig) ([]byte, []extensionsv1alpha1.Unit, []extensionsv1alpha1.File, error) {
+	return c.chain(log, func(log logr.Logger, actuator Actuator) ([]byte, []extensionsv1alpha1.Unit, []extensionsv1alpha1.File, error) {
+		return actuator.Reconcile(ctx, log, osc)
+	})
+}
+
+func (c *compositeActuator) Restore(ctx context.Context, log logr.Logger, osc *extensionsv1alpha1.OperatingSystemConfig) ([]byte, []extensionsv1alpha1.Unit, []extensionsv1alpha1.File, error) {
+	return c.chain(log, func(log logr.Logger, actuator Actuator) ([]byte, []extensionsv1alpha1.Unit, []extensionsv1alpha1.File, error) {
+		return actuator.Restore(ctx, log, osc)
+	})
+}
+
+func (c *compositeActuator) Delete(ctx context.Context, log logr.Logger, osc *extensionsv1alpha1.OperatingSystemConfig) error {
+	return c.chainReverse(log, func(log logr.Logger, actuator Actuator) error {
+		return actuator.Delete(ctx, log, osc)
+	})
+}
+
+func (c *compositeActuator) ForceDelete(ctx context.Context, log logr.Logger, osc *extensionsv1alpha1.OperatingSystemConfig) error {
+	return c.chainReverse(log, func(log logr.Logger, actuator Actuator) error {
+		return actuator.ForceDelete(ctx, log, osc)
+	})
+}
+
+func (c *compositeActuator) Migrate(ctx context.Context, log logr.Logger, osc *extensionsv1alpha1.OperatingSystemConfig) error {
+	return c.chainReverse(log, func(log logr.Logger, actuator Actuator) error {
+		return actuator.Migrate(ctx, log, osc)
+	})
+}
+
+func (c *compositeActuator) chain(log logr.Logger, fn func(logr.Logger, Actuator) ([]byte, []extensionsv1alpha1.Unit, []extensionsv1alpha1.File, error)) ([]byte, []extensionsv1alpha1.Unit, []extensionsv1alpha1.File, error) {
+	var (
+		userData        []byte
+		userDataOwner   int
+		units           []extensionsv1alpha1.Unit
+		unitIndexByName = map[string]int{}
+		files           []extensionsv1alpha1.File
+		fileIndexByPath = map[string]int{}
+	)
+
+	for i, actuator := range c.actuators {
+		actuatorUserData, actuatorUnits, actuatorFiles, err := fn(log.WithValues("actuator", i), actuator)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		if len(actuatorUserData) > 0 {
+			if len(userData) > 0 {
+				return nil, nil, nil, fmt.Errorf("actuator %d returned user data which is already owned by actuator %d", i, userDataOwner)
+			}
+			userData, userDataOwner = actuatorUserData, i
+		}
+
+		for _, unit := range actuatorUnits {
+			if idx, ok := unitIndexByName[unit.Name]; ok {
+				units[idx] = unit
+				continue
+			}
+			unitIndexByName[unit.Name] = len(units)
+			units = append(units, unit)
+		}
+
+		for _, file := range actuatorFiles {
+			if idx, ok := fileIndexByPath[file.Path]; ok {
+				files[idx] = file
+				continue
+			}
+			fileIndexByPath[file.Path] = len(files)
+			files = append(files, file)
+		}
+	}
+
+	return userData, units, files, nil
+}
+
+func (c *compositeActuator) chainReverse(log logr.Logger, fn func(logr.Logger, Actuator) error) error {
+	for i := len(c.actuators) - 1; i >= 0; i-- {
+		if err := fn(log.WithValues("actuator", i), c.actuators[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,143 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operatingsystemconfig_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	. "github.com/gardener/gardener/extensions/pkg/controller/operatingsystemconfig"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+var _ = Describe("CompositeActuator", func() {
+	var (
+		ctx = context.TODO()
+		log = logr.Discard()
+		osc = &extensionsv1alpha1.OperatingSystemConfig{}
+
+		calls          []string
+		base, hardener *fakeActuator
+		actuator       Actuator
+	)
+
+	BeforeEach(func() {
+		calls = nil
+		base = &fakeActuator{
+			name:     "base",
+			calls:    &calls,
+			userData: []byte("base"),
+			units:    []extensionsv1alpha1.Unit{{Name: "containerd.service"}, {Name: "sshd.service"}},
+			files:    []extensionsv1alpha1.File{{Path: "/etc/ssh/sshd_config", Permissions: ptr.To[uint32](0644)}},
+		}
+		hardener = &fakeActuator{
+			name:  "hardener",
+			calls: &calls,
+			units: []extensionsv1alpha1.Unit{{Name: "sshd.service", Enable: ptr.To(false)}, {Name: "auditd.service"}},
+			files: []extensionsv1alpha1.File{{Path: "/etc/ssh/sshd_config", Permissions: ptr.To[uint32](0600)}, {Path: "/etc/audit/audit.rules"}},
+		}
+		actuator = NewCompositeActuator(base, hardener)
+	})
+
+	Describe("#Reconcile", func() {
+		It("should reconcile the actuators in order and merge their results", func() {
+			userData, units, files, err := actuator.Reconcile(ctx, log, osc)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(calls).To(Equal([]string{"base/reconcile", "hardener/reconcile"}))
+			Expect(userData).To(Equal([]byte("base")))
+			Expect(units).To(Equal([]extensionsv1alpha1.Unit{{Name: "containerd.service"}, {Name: "sshd.service", Enable: ptr.To(false)}, {Name: "auditd.service"}}))
+			Expect(files).To(Equal([]extensionsv1alpha1.File{{Path: "/etc/ssh/sshd_config", Permissions: ptr.To[uint32](0600)}, {Path: "/etc/audit/audit.rules"}}))
+		})
+
+		It("should fail if more than one actuator returns user data", func() {
+			hardener.userData = []byte("hardener")
+
+			_, _, _, err := actuator.Reconcile(ctx, log, osc)
+			Expect(err).To(MatchError("actuator 1 returned user data which is already owned by actuator 0"))
+		})
+
+		It("should stop at the first failing actuator", func() {
+			base.err = errors.New("fake")
+
+			_, _, _, err := actuator.Reconcile(ctx, log, osc)
+			Expect(err).To(MatchError("fake"))
+			Expect(calls).To(Equal([]string{"base/reconcile"}))
+		})
+	})
+
+	Describe("#Restore", func() {
+		It("should restore the actuators in order", func() {
+			userData, _, _, err := actuator.Restore(ctx, log, osc)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(calls).To(Equal([]string{"base/restore", "hardener/restore"}))
+			Expect(userData).To(Equal([]byte("base")))
+		})
+	})
+
+	Describe("#Delete, #ForceDelete, #Migrate", func() {
+		It("should call the actuators in reverse order", func() {
+			Expect(actuator.Delete(ctx, log, osc)).To(Succeed())
+			Expect(actuator.ForceDelete(ctx, log, osc)).To(Succeed())
+			Expect(actuator.Migrate(ctx, log, osc)).To(Succeed())
+
+			Expect(calls).To(Equal([]string{
+				"hardener/delete", "base/delete",
+				"hardener/force-delete", "base/force-delete",
+				"hardener/migrate", "base/migrate",
+			}))
+		})
+
+		It("should stop at the first failing actuator", func() {
+			hardener.err = errors.New("fake")
+
+			Expect(actuator.Delete(ctx, log, osc)).To(MatchError("fake"))
+			Expect(calls).To(Equal([]string{"hardener/delete"}))
+		})
+	})
+})
+
+type fakeActuator struct {
+	name     string
+	calls    *[]string
+	userData []byte
+	units    []extensionsv1alpha1.Unit
+	files    []extensionsv1alpha1.File
+	err      error
+}
+
+func (f *fakeActuator) result(call string) ([]byte, []extensionsv1alpha1.Unit, []extensionsv1alpha1.File, error) {
+	*f.calls = append(*f.calls, f.name+"/"+call)
+	return f.userData, f.units, f.files, f.err
+}
+
+func (f *fakeActuator) Reconcile(context.Context, logr.Logger, *extensionsv1alpha1.OperatingSystemConfig) ([]byte, []extensionsv1alpha1.Unit, []extensionsv1alpha1.File, error) {
+	return f.result("reconcile")
+}
+
+func (f *fakeActuator) Restore(context.Context, logr.Logger, *extensionsv1alpha1.OperatingSystemConfig) ([]byte, []extensionsv1alpha1.Unit, []extensionsv1alpha1.File, error) {
+	return f.result("restore")
+}
+
+func (f *fakeActuator) Delete(context.Context, logr.Logger, *extensionsv1alpha1.OperatingSystemConfig) error {
+	_, _, _, err := f.result("delete")
+	return err
+}
+
+func (f *fakeActuator) ForceDelete(context.Context, logr.Logger, *extensionsv1alpha1.OperatingSystemConfig) error {
+	_, _, _, err := f.result("force-delete")
+	return err
+}
+
+func (f *fakeActuator) Migrate(context.Context, logr.Logger, *extensionsv1alpha1.OperatingSystemConfig) error {
+	_, _, _, err := f.result("migrate")
+	return err
+}
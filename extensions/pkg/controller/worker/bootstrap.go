@@ -0,0 +1,136 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+)
+
+// DefaultMachineBootstrapTimeout is the default period after which a node which has not completed its bootstrap is
+// considered stuck.
+const DefaultMachineBootstrapTimeout = 20 * time.Minute
+
+// MachineBootstrapTimeout returns the period after which a node of the given worker pool which has not completed its
+// bootstrap is considered stuck.
+func MachineBootstrapTimeout(pool extensionsv1alpha1.WorkerPool) time.Duration {
+	if pool.MachineControllerManagerSettings != nil && pool.MachineControllerManagerSettings.MachineBootstrapTimeout != nil {
+		return pool.MachineControllerManagerSettings.MachineBootstrapTimeout.Duration
+	}
+	return DefaultMachineBootstrapTimeout
+}
+
+// ReplacesMachinesStuckInBootstrap returns true if the machines of nodes of the given worker pool which are stuck in
+// their bootstrap should be replaced.
+func ReplacesMachinesStuckInBootstrap(pool extensionsv1alpha1.WorkerPool) bool {
+	return pool.MachineControllerManagerSettings != nil &&
+		pool.MachineControllerManagerSettings.MachineBootstrapFailurePolicy != nil &&
+		*pool.MachineControllerManagerSettings.MachineBootstrapFailurePolicy == gardencorev1beta1.MachineBootstrapFailurePolicyReplace
+}
+
+// IsNodeBootstrapped returns true if the given node has completed its bootstrap, i.e., gardener-node-agent has applied
+// the operating system config and the node-critical components are ready.
+func IsNodeBootstrapped(node *corev1.Node) bool {
+	if _, ok := node.Annotations[nodeagentconfigv1alpha1.AnnotationKeyChecksumAppliedOperatingSystemConfig]; !ok {
+		return false
+	}
+
+	return !slices.ContainsFunc(node.Spec.Taints, func(taint corev1.Taint) bool {
+		return taint.Key == v1beta1constants.TaintNodeCriticalComponentsNotReady
+	})
+}
+
+// ReconcileNodeBootstrap determines the nodes of the given worker pool which have not completed their bootstrap within
+// the bootstrap timeout of the pool. If the pool's failure policy is `Replace`, the machines of these nodes are deleted
+// so that machine-controller-manager replaces them. At most `maxUnavailable` machines of the pool are replaced at the
+// same time, however, at least one machine is always replaced. Machines which are already being deleted count towards
+// this limit.
+func ReconcileNodeBootstrap(
+	ctx context.Context,
+	log logr.Logger,
+	seedClient client.Client,
+	shootClient client.Client,
+	clock clock.PassiveClock,
+	namespace string,
+	pool extensionsv1alpha1.WorkerPool,
+) (
+	*extensionsv1alpha1.NodeBootstrapStatus,
+	error,
+) {
+	nodeList := &corev1.NodeList{}
+	if err := shootClient.List(ctx, nodeList, client.MatchingLabels{v1beta1constants.LabelWorkerPool: pool.Name}); err != nil {
+		return nil, fmt.Errorf("failed listing nodes of worker pool %q: %w", pool.Name, err)
+	}
+
+	var (
+		status  = &extensionsv1alpha1.NodeBootstrapStatus{PoolName: pool.Name}
+		timeout = MachineBootstrapTimeout(pool)
+
+		stuckNodes []*corev1.Node
+	)
+
+	for i := range nodeList.Items {
+		node := &nodeList.Items[i]
+		if IsNodeBootstrapped(node) || clock.Since(node.CreationTimestamp.Time) < timeout {
+			continue
+		}
+		stuckNodes = append(stuckNodes, node)
+	}
+
+	status.NodesStuckInBootstrap = int32(len(stuckNodes)) // #nosec G115 -- number of nodes cannot exceed int32 range.
+	if len(stuckNodes) == 0 || !ReplacesMachinesStuckInBootstrap(pool) {
+		return status, nil
+	}
+
+	maxUnavailable, err := intstr.GetScaledValueFromIntOrPercent(&pool.MaxUnavailable, len(nodeList.Items), false)
+	if err != nil {
+		return nil, fmt.Errorf("failed computing max unavailable nodes of worker pool %q: %w", pool.Name, err)
+	}
+	maxUnavailable = max(maxUnavailable, 1)
+
+	slices.SortFunc(stuckNodes, func(a, b *corev1.Node) int { return strings.Compare(a.Name, b.Name) })
+
+	var inDeletion int
+	for _, node := range stuckNodes {
+		if inDeletion >= maxUnavailable {
+			break
+		}
+
+		machineList := &machinev1alpha1.MachineList{}
+		if err := seedClient.List(ctx, machineList, client.InNamespace(namespace), client.MatchingLabels{machinev1alpha1.NodeLabelKey: node.Name}); err != nil {
+			return nil, fmt.Errorf("failed listing machines of node %q: %w", node.Name, err)
+		}
+
+		for _, machine := range machineList.Items {
+			inDeletion++
+			if machine.DeletionTimestamp != nil {
+				continue
+			}
+
+			log.Info("Deleting machine of node stuck in bootstrap", "node", client.ObjectKeyFromObject(node), "machine", client.ObjectKeyFromObject(&machine), "workerPool", pool.Name)
+			if err := seedClient.Delete(ctx, &machine); client.IgnoreNotFound(err) != nil {
+				return nil, fmt.Errorf("failed deleting machine %q of node %q stuck in bootstrap: %w", machine.Name, node.Name, err)
+			}
+			status.NodesReplaced++
+		}
+	}
+
+	return status, nil
+}
@@ -0,0 +1,223 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker_test
+
+import (
+	"context"
+	"time"
+
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/gardener/gardener/extensions/pkg/controller/worker"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+)
+
+var _ = Describe("Bootstrap", func() {
+	var pool extensionsv1alpha1.WorkerPool
+
+	BeforeEach(func() {
+		pool = extensionsv1alpha1.WorkerPool{
+			Name:           "pool",
+			MaxUnavailable: intstr.FromInt32(1),
+		}
+	})
+
+	Describe("#MachineBootstrapTimeout", func() {
+		It("should return the default timeout if nothing is configured", func() {
+			Expect(MachineBootstrapTimeout(pool)).To(Equal(DefaultMachineBootstrapTimeout))
+		})
+
+		It("should return the configured timeout", func() {
+			pool.MachineControllerManagerSettings = &gardencorev1beta1.MachineControllerManagerSettings{MachineBootstrapTimeout: &metav1.Duration{Duration: 5 * time.Minute}}
+			Expect(MachineBootstrapTimeout(pool)).To(Equal(5 * time.Minute))
+		})
+	})
+
+	Describe("#ReplacesMachinesStuckInBootstrap", func() {
+		It("should return false if nothing is configured", func() {
+			Expect(ReplacesMachinesStuckInBootstrap(pool)).To(BeFalse())
+		})
+
+		It("should return false for the Ignore policy", func() {
+			pool.MachineControllerManagerSettings = &gardencorev1beta1.MachineControllerManagerSettings{MachineBootstrapFailurePolicy: ptr.To(gardencorev1beta1.MachineBootstrapFailurePolicyIgnore)}
+			Expect(ReplacesMachinesStuckInBootstrap(pool)).To(BeFalse())
+		})
+
+		It("should return true for the Replace policy", func() {
+			pool.MachineControllerManagerSettings = &gardencorev1beta1.MachineControllerManagerSettings{MachineBootstrapFailurePolicy: ptr.To(gardencorev1beta1.MachineBootstrapFailurePolicyReplace)}
+			Expect(ReplacesMachinesStuckInBootstrap(pool)).To(BeTrue())
+		})
+	})
+
+	Describe("#IsNodeBootstrapped", func() {
+		var node *corev1.Node
+
+		BeforeEach(func() {
+			node = &corev1.Node{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{"checksum/cloud-config-data": "foo"}}}
+		})
+
+		It("should return true if the operating system config was applied and no taint is present", func() {
+			Expect(IsNodeBootstrapped(node)).To(BeTrue())
+		})
+
+		It("should return false if the operating system config was not applied yet", func() {
+			node.Annotations = nil
+			Expect(IsNodeBootstrapped(node)).To(BeFalse())
+		})
+
+		It("should return false if the node-critical components are not ready yet", func() {
+			node.Spec.Taints = []corev1.Taint{{Key: "node.gardener.cloud/critical-components-not-ready", Effect: corev1.TaintEffectNoSchedule}}
+			Expect(IsNodeBootstrapped(node)).To(BeFalse())
+		})
+	})
+
+	Describe("#ReconcileNodeBootstrap", func() {
+		const namespace = "shoot--foo--bar"
+
+		var (
+			ctx         = context.Background()
+			now         = time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+			fakeClock   *testclock.FakeClock
+			seedClient  client.Client
+			shootClient client.Client
+		)
+
+		BeforeEach(func() {
+			fakeClock = testclock.NewFakeClock(now)
+			seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+			shootClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).Build()
+		})
+
+		createNode := func(name, pool string, age time.Duration, bootstrapped bool) {
+			node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Labels:            map[string]string{"worker.gardener.cloud/pool": pool},
+				CreationTimestamp: metav1.NewTime(now.Add(-age)),
+			}}
+			if bootstrapped {
+				node.Annotations = map[string]string{"checksum/cloud-config-data": "foo"}
+			}
+			ExpectWithOffset(1, shootClient.Create(ctx, node)).To(Succeed())
+		}
+
+		createMachine := func(name, nodeName string, finalizers ...string) *machinev1alpha1.Machine {
+			machine := &machinev1alpha1.Machine{ObjectMeta: metav1.ObjectMeta{
+				Name:       name,
+				Namespace:  namespace,
+				Labels:     map[string]string{"node": nodeName},
+				Finalizers: finalizers,
+			}}
+			ExpectWithOffset(1, seedClient.Create(ctx, machine)).To(Succeed())
+			return machine
+		}
+
+		machineExists := func(name string) bool {
+			err := seedClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, &machinev1alpha1.Machine{})
+			if apierrors.IsNotFound(err) {
+				return false
+			}
+			ExpectWithOffset(1, err).NotTo(HaveOccurred())
+			return true
+		}
+
+		It("should not report nodes which are bootstrapped or still within the timeout", func() {
+			createNode("node-a", "pool", time.Hour, true)
+			createNode("node-b", "pool", 10*time.Minute, false)
+			createNode("node-c", "other-pool", time.Hour, false)
+
+			Expect(ReconcileNodeBootstrap(ctx, logr.Discard(), seedClient, shootClient, fakeClock, namespace, pool)).To(Equal(&extensionsv1alpha1.NodeBootstrapStatus{
+				PoolName: "pool",
+			}))
+		})
+
+		It("should only report stuck nodes if the Ignore policy is configured", func() {
+			createNode("node-a", "pool", time.Hour, false)
+			createMachine("machine-a", "node-a")
+
+			Expect(ReconcileNodeBootstrap(ctx, logr.Discard(), seedClient, shootClient, fakeClock, namespace, pool)).To(Equal(&extensionsv1alpha1.NodeBootstrapStatus{
+				PoolName:              "pool",
+				NodesStuckInBootstrap: 1,
+			}))
+			Expect(machineExists("machine-a")).To(BeTrue())
+		})
+
+		It("should consider the configured timeout", func() {
+			pool.MachineControllerManagerSettings = &gardencorev1beta1.MachineControllerManagerSettings{MachineBootstrapTimeout: &metav1.Duration{Duration: 5 * time.Minute}}
+			createNode("node-a", "pool", 10*time.Minute, false)
+
+			Expect(ReconcileNodeBootstrap(ctx, logr.Discard(), seedClient, shootClient, fakeClock, namespace, pool)).To(Equal(&extensionsv1alpha1.NodeBootstrapStatus{
+				PoolName:              "pool",
+				NodesStuckInBootstrap: 1,
+			}))
+		})
+
+		Context("Replace policy", func() {
+			BeforeEach(func() {
+				pool.MachineControllerManagerSettings = &gardencorev1beta1.MachineControllerManagerSettings{MachineBootstrapFailurePolicy: ptr.To(gardencorev1beta1.MachineBootstrapFailurePolicyReplace)}
+			})
+
+			It("should replace at most maxUnavailable machines", func() {
+				pool.MaxUnavailable = intstr.FromInt32(2)
+				createNode("node-c", "pool", time.Hour, false)
+				createNode("node-b", "pool", time.Hour, false)
+				createNode("node-a", "pool", time.Hour, false)
+				createNode("node-d", "pool", time.Hour, true)
+				createMachine("machine-a", "node-a")
+				createMachine("machine-b", "node-b")
+				createMachine("machine-c", "node-c")
+				createMachine("machine-d", "node-d")
+
+				Expect(ReconcileNodeBootstrap(ctx, logr.Discard(), seedClient, shootClient, fakeClock, namespace, pool)).To(Equal(&extensionsv1alpha1.NodeBootstrapStatus{
+					PoolName:              "pool",
+					NodesStuckInBootstrap: 3,
+					NodesReplaced:         2,
+				}))
+				Expect(machineExists("machine-a")).To(BeFalse())
+				Expect(machineExists("machine-b")).To(BeFalse())
+				Expect(machineExists("machine-c")).To(BeTrue())
+				Expect(machineExists("machine-d")).To(BeTrue())
+			})
+
+			It("should count machines which are already being deleted towards maxUnavailable", func() {
+				createNode("node-a", "pool", time.Hour, false)
+				createNode("node-b", "pool", time.Hour, false)
+				Expect(seedClient.Delete(ctx, createMachine("machine-a", "node-a", "test"))).To(Succeed())
+				createMachine("machine-b", "node-b")
+
+				Expect(ReconcileNodeBootstrap(ctx, logr.Discard(), seedClient, shootClient, fakeClock, namespace, pool)).To(Equal(&extensionsv1alpha1.NodeBootstrapStatus{
+					PoolName:              "pool",
+					NodesStuckInBootstrap: 2,
+				}))
+				Expect(machineExists("machine-b")).To(BeTrue())
+			})
+
+			It("should always replace at least one machine", func() {
+				pool.MaxUnavailable = intstr.FromString("0%")
+				createNode("node-a", "pool", time.Hour, false)
+				createMachine("machine-a", "node-a")
+
+				Expect(ReconcileNodeBootstrap(ctx, logr.Discard(), seedClient, shootClient, fakeClock, namespace, pool)).To(Equal(&extensionsv1alpha1.NodeBootstrapStatus{
+					PoolName:              "pool",
+					NodesStuckInBootstrap: 1,
+					NodesReplaced:         1,
+				}))
+				Expect(machineExists("machine-a")).To(BeFalse())
+			})
+		})
+	})
+})
@@ -15,6 +15,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/cluster"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -35,6 +36,7 @@ type genericActuator struct {
 	seedReader         client.Reader
 	scheme             *runtime.Scheme
 	errorCodeCheckFunc healthcheck.ErrorCodeCheckFunc
+	clock              clock.PassiveClock
 }
 
 // NewActuator creates a new Actuator that reconciles
@@ -49,6 +51,7 @@ func NewActuator(mgr manager.Manager, gardenCluster cluster.Cluster, delegateFac
 		seedReader:         mgr.GetAPIReader(),
 		scheme:             mgr.GetScheme(),
 		errorCodeCheckFunc: errorCodeCheckFunc,
+		clock:              clock.RealClock{},
 	}
 }
 
@@ -15,14 +15,17 @@ import (
 	"github.com/go-logr/logr"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	extensionsconfigv1alpha1 "github.com/gardener/gardener/extensions/pkg/apis/config/v1alpha1"
 	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	extensionsworkercontroller "github.com/gardener/gardener/extensions/pkg/controller/worker"
 	extensionsworkerhelper "github.com/gardener/gardener/extensions/pkg/controller/worker/helper"
+	"github.com/gardener/gardener/extensions/pkg/util"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
@@ -81,44 +84,63 @@ func (a *genericActuator) Reconcile(ctx context.Context, log logr.Logger, worker
 		return err
 	}
 
-	// Generate machine deployment configuration based on previously computed list of deployments and deploy them.
-	if err := deployMachineDeployments(ctx, log, a.seedClient, cluster, worker, existingMachineDeployments, wantedMachineDeployments, clusterAutoscalerUsed); err != nil {
-		return fmt.Errorf("failed to generate the machine deployment config: %w", err)
-	}
-
-	// update machineDeploymentsLastUpdateTime and the machine deployment slice in worker status
-	if err := a.updateWorkerStatusMachineDeployments(ctx, worker, wantedMachineDeployments); err != nil {
-		return fmt.Errorf("failed to update the machine deployments in worker status: %w", err)
-	}
+	// Roll out the machine deployments in the order of their worker pools. Each stage contains the machine deployments of
+	// the previous stages, hence the last stage deploys all wanted machine deployments.
+	for _, machineDeployments := range rolloutStages(worker, wantedMachineDeployments, isHibernationEnabled) {
+		// Generate machine deployment configuration based on previously computed list of deployments and deploy them.
+		if err := deployMachineDeployments(ctx, log, a.seedClient, cluster, worker, existingMachineDeployments, machineDeployments, clusterAutoscalerUsed); err != nil {
+			return fmt.Errorf("failed to generate the machine deployment config: %w", err)
+		}
 
-	// Wait until all generated machine deployments are healthy/available.
-	if err := a.waitUntilWantedMachineDeploymentsAvailable(ctx, log, cluster, worker, existingMachineDeployments, existingMachineClassNames, wantedMachineDeployments); err != nil {
-		// check if the machine-controller-manager is stuck
-		isStuck, msg, err2 := a.IsMachineControllerStuck(ctx, worker)
-		if err2 != nil {
-			log.Error(err2, "Failed to check if the machine-controller-manager pod is stuck after unsuccessfully waiting for all machine deployments to be ready")
-			// continue in order to return `err` and determine error codes
+		// update machineDeploymentsLastUpdateTime and the machine deployment slice in worker status
+		if err := a.updateWorkerStatusMachineDeployments(ctx, worker, deployedMachineDeployments(existingMachineDeployments, wantedMachineDeployments, machineDeployments)); err != nil {
+			return fmt.Errorf("failed to update the machine deployments in worker status: %w", err)
 		}
 
-		if isStuck {
-			podList := corev1.PodList{}
-			if err2 := a.seedClient.List(ctx, &podList, client.InNamespace(worker.Namespace), client.MatchingLabels{"role": "machine-controller-manager"}); err2 != nil {
-				return fmt.Errorf("failed to list machine-controller-manager pods for worker (%s/%s): %w", worker.Namespace, worker.Name, err2)
+		// Wait until all generated machine deployments are healthy/available.
+		if err := a.waitUntilWantedMachineDeploymentsAvailable(ctx, log, cluster, worker, existingMachineDeployments, existingMachineClassNames, machineDeployments); err != nil {
+			// check if the machine-controller-manager is stuck
+			isStuck, msg, err2 := a.IsMachineControllerStuck(ctx, worker)
+			if err2 != nil {
+				log.Error(err2, "Failed to check if the machine-controller-manager pod is stuck after unsuccessfully waiting for all machine deployments to be ready")
+				// continue in order to return `err` and determine error codes
 			}
 
-			for _, pod := range podList.Items {
-				if err2 := a.seedClient.Delete(ctx, &pod); err2 != nil {
-					return fmt.Errorf("failed to delete stuck machine-controller-manager pod for worker (%s/%s): %w", worker.Namespace, worker.Name, err2)
+			if isStuck {
+				podList := corev1.PodList{}
+				if err2 := a.seedClient.List(ctx, &podList, client.InNamespace(worker.Namespace), client.MatchingLabels{"role": "machine-controller-manager"}); err2 != nil {
+					return fmt.Errorf("failed to list machine-controller-manager pods for worker (%s/%s): %w", worker.Namespace, worker.Name, err2)
+				}
+
+				for _, pod := range podList.Items {
+					if err2 := a.seedClient.Delete(ctx, &pod); err2 != nil {
+						return fmt.Errorf("failed to delete stuck machine-controller-manager pod for worker (%s/%s): %w", worker.Namespace, worker.Name, err2)
+					}
 				}
+				log.Info("Successfully deleted stuck machine-controller-manager pod", "reason", msg)
 			}
-			log.Info("Successfully deleted stuck machine-controller-manager pod", "reason", msg)
+
+			newError := fmt.Errorf("failed while waiting for all machine deployments to be ready: %w", err)
+			if a.errorCodeCheckFunc != nil {
+				return v1beta1helper.NewErrorWithCodes(newError, a.errorCodeCheckFunc(err)...)
+			}
+			return newError
+		}
+	}
+
+	// Update the nodes of worker pools with the in-place update strategy and wait until they have applied the desired
+	// machine image and Kubernetes version.
+	if !isHibernationEnabled {
+		if err := a.reconcileInPlaceUpdates(ctx, log, worker, cluster); err != nil {
+			return fmt.Errorf("failed while waiting for the in-place updates of the worker pools: %w", err)
 		}
+	}
 
-		newError := fmt.Errorf("failed while waiting for all machine deployments to be ready: %w", err)
-		if a.errorCodeCheckFunc != nil {
-			return v1beta1helper.NewErrorWithCodes(newError, a.errorCodeCheckFunc(err)...)
+	// Report the nodes which are stuck in their bootstrap and replace them if configured for their worker pool.
+	if !isHibernationEnabled {
+		if err := a.reconcileNodeBootstrap(ctx, log, worker); err != nil {
+			return fmt.Errorf("failed reconciling nodes stuck in bootstrap: %w", err)
 		}
-		return newError
 	}
 
 	// Delete all old machine deployments (i.e. those which were not previously computed but exist in the cluster).
@@ -424,6 +446,107 @@ func (a *genericActuator) waitUntilUnwantedMachineDeploymentsDeleted(ctx context
 	})
 }
 
+// reconcileInPlaceUpdates selects the nodes of worker pools with the in-place update strategy for updates and waits
+// until all of them have applied the desired machine image and Kubernetes version. The progress is reported in the
+// worker status. It polls the nodes every 10 seconds.
+func (a *genericActuator) reconcileInPlaceUpdates(ctx context.Context, log logr.Logger, worker *extensionsv1alpha1.Worker, cluster *extensionscontroller.Cluster) error {
+	var pools []extensionsv1alpha1.WorkerPool
+	for _, pool := range worker.Spec.Pools {
+		if extensionsworkercontroller.IsInPlaceUpdate(pool) {
+			pools = append(pools, pool)
+		}
+	}
+
+	if len(pools) == 0 {
+		return a.updateWorkerStatusInPlaceUpdates(ctx, worker, nil)
+	}
+
+	_, shootClient, err := util.NewClientForShoot(ctx, a.seedClient, worker.Namespace, client.Options{}, extensionsconfigv1alpha1.RESTOptions{})
+	if err != nil {
+		return fmt.Errorf("failed creating client for shoot: %w", err)
+	}
+
+	log.Info("Waiting until in-place updates of worker pools are completed")
+
+	return retryutils.UntilTimeout(ctx, 10*time.Second, 10*time.Minute, func(ctx context.Context) (bool, error) {
+		var (
+			statuses           []extensionsv1alpha1.InPlaceUpdateStatus
+			nodesPendingUpdate int
+		)
+
+		for _, pool := range pools {
+			status, err := extensionsworkercontroller.ReconcileInPlaceUpdate(ctx, log, shootClient, pool, extensionsworkercontroller.WorkerPoolInPlaceUpdateHash(pool, cluster))
+			if err != nil {
+				return retryutils.SevereError(err)
+			}
+
+			statuses = append(statuses, *status)
+			nodesPendingUpdate += len(status.NodesPendingUpdate)
+		}
+
+		if err := a.updateWorkerStatusInPlaceUpdates(ctx, worker, statuses); err != nil {
+			return retryutils.SevereError(err)
+		}
+
+		if nodesPendingUpdate > 0 {
+			msg := fmt.Sprintf("Waiting until %d node(s) have applied their in-place update", nodesPendingUpdate)
+			log.Info(msg)
+			return retryutils.MinorError(errors.New(msg))
+		}
+
+		return retryutils.Ok()
+	})
+}
+
+// reconcileNodeBootstrap determines the nodes of all worker pools which have not completed their bootstrap within the
+// bootstrap timeout of their pool and replaces them if the failure policy of their pool says so. Worker pools with such
+// nodes are reported in the worker status.
+func (a *genericActuator) reconcileNodeBootstrap(ctx context.Context, log logr.Logger, worker *extensionsv1alpha1.Worker) error {
+	if len(worker.Spec.Pools) == 0 {
+		return a.updateWorkerStatusNodeBootstrap(ctx, worker, nil)
+	}
+
+	_, shootClient, err := util.NewClientForShoot(ctx, a.seedClient, worker.Namespace, client.Options{}, extensionsconfigv1alpha1.RESTOptions{})
+	if err != nil {
+		return fmt.Errorf("failed creating client for shoot: %w", err)
+	}
+
+	var statuses []extensionsv1alpha1.NodeBootstrapStatus
+	for _, pool := range worker.Spec.Pools {
+		status, err := extensionsworkercontroller.ReconcileNodeBootstrap(ctx, log, a.seedClient, shootClient, a.clock, worker.Namespace, pool)
+		if err != nil {
+			return err
+		}
+
+		if status.NodesStuckInBootstrap > 0 {
+			log.Info("Found nodes stuck in bootstrap", "workerPool", pool.Name, "nodesStuckInBootstrap", status.NodesStuckInBootstrap, "nodesReplaced", status.NodesReplaced)
+			statuses = append(statuses, *status)
+		}
+	}
+
+	return a.updateWorkerStatusNodeBootstrap(ctx, worker, statuses)
+}
+
+func (a *genericActuator) updateWorkerStatusNodeBootstrap(ctx context.Context, worker *extensionsv1alpha1.Worker, statuses []extensionsv1alpha1.NodeBootstrapStatus) error {
+	if apiequality.Semantic.DeepEqual(worker.Status.NodeBootstrap, statuses) {
+		return nil
+	}
+
+	patch := client.MergeFrom(worker.DeepCopy())
+	worker.Status.NodeBootstrap = statuses
+	return a.seedClient.Status().Patch(ctx, worker, patch)
+}
+
+func (a *genericActuator) updateWorkerStatusInPlaceUpdates(ctx context.Context, worker *extensionsv1alpha1.Worker, statuses []extensionsv1alpha1.InPlaceUpdateStatus) error {
+	if apiequality.Semantic.DeepEqual(worker.Status.InPlaceUpdates, statuses) {
+		return nil
+	}
+
+	patch := client.MergeFrom(worker.DeepCopy())
+	worker.Status.InPlaceUpdates = statuses
+	return a.seedClient.Status().Patch(ctx, worker, patch)
+}
+
 func (a *genericActuator) updateWorkerStatusMachineDeployments(ctx context.Context, worker *extensionsv1alpha1.Worker, machineDeployments extensionsworkercontroller.MachineDeployments) error {
 	if len(machineDeployments) == 0 {
 		return nil
@@ -447,6 +570,56 @@ func (a *genericActuator) updateWorkerStatusMachineDeployments(ctx context.Conte
 
 // Helper functions
 
+// rolloutStages groups the wanted machine deployments by the rollout order of their worker pools (determined via the
+// worker pool label of the nodes). Each stage contains the machine deployments of the previous stages, i.e., the last
+// stage contains all wanted machine deployments. Hibernated workers are handled in one stage.
+func rolloutStages(worker *extensionsv1alpha1.Worker, wantedMachineDeployments extensionsworkercontroller.MachineDeployments, isHibernationEnabled bool) []extensionsworkercontroller.MachineDeployments {
+	poolOrders := make(map[string]int32, len(worker.Spec.Pools))
+	for _, pool := range worker.Spec.Pools {
+		if pool.RolloutStrategy != nil {
+			poolOrders[pool.Name] = ptr.Deref(pool.RolloutStrategy.Order, 0)
+		}
+	}
+
+	orderOf := func(deployment extensionsworkercontroller.MachineDeployment) int32 {
+		return poolOrders[deployment.Labels[v1beta1constants.LabelWorkerPool]]
+	}
+
+	orders := sets.New[int32]()
+	for _, deployment := range wantedMachineDeployments {
+		orders.Insert(orderOf(deployment))
+	}
+
+	if isHibernationEnabled || orders.Len() <= 1 {
+		return []extensionsworkercontroller.MachineDeployments{wantedMachineDeployments}
+	}
+
+	stages := make([]extensionsworkercontroller.MachineDeployments, 0, orders.Len())
+	for _, order := range sets.List(orders) {
+		var stage extensionsworkercontroller.MachineDeployments
+		for _, deployment := range wantedMachineDeployments {
+			if orderOf(deployment) <= order {
+				stage = append(stage, deployment)
+			}
+		}
+		stages = append(stages, stage)
+	}
+
+	return stages
+}
+
+// deployedMachineDeployments returns the wanted machine deployments which are either part of the given stage or already
+// exist, i.e., those which can be considered by the cluster-autoscaler.
+func deployedMachineDeployments(existingMachineDeployments *machinev1alpha1.MachineDeploymentList, wantedMachineDeployments, stage extensionsworkercontroller.MachineDeployments) extensionsworkercontroller.MachineDeployments {
+	var machineDeployments extensionsworkercontroller.MachineDeployments
+	for _, deployment := range wantedMachineDeployments {
+		if stage.HasDeployment(deployment.Name) || getExistingMachineDeployment(existingMachineDeployments, deployment.Name) != nil {
+			machineDeployments = append(machineDeployments, deployment)
+		}
+	}
+	return machineDeployments
+}
+
 func shootIsAwake(isHibernated bool, existingMachineDeployments *machinev1alpha1.MachineDeploymentList) bool {
 	if isHibernated {
 		return false
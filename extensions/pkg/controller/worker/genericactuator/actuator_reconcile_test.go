@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package genericactuator
+
+import (
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener/extensions/pkg/controller/worker"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+var _ = Describe("ActuatorReconcile", func() {
+	var (
+		workerObj *extensionsv1alpha1.Worker
+
+		deploymentA1, deploymentA2, deploymentB, deploymentC worker.MachineDeployment
+	)
+
+	machineDeployment := func(name, pool string) worker.MachineDeployment {
+		return worker.MachineDeployment{Name: name, Labels: map[string]string{"worker.gardener.cloud/pool": pool}}
+	}
+
+	BeforeEach(func() {
+		workerObj = &extensionsv1alpha1.Worker{
+			Spec: extensionsv1alpha1.WorkerSpec{
+				Pools: []extensionsv1alpha1.WorkerPool{{Name: "a"}, {Name: "b"}, {Name: "c"}},
+			},
+		}
+
+		deploymentA1 = machineDeployment("a-z1", "a")
+		deploymentA2 = machineDeployment("a-z2", "a")
+		deploymentB = machineDeployment("b-z1", "b")
+		deploymentC = machineDeployment("c-z1", "c")
+	})
+
+	Describe("#rolloutStages", func() {
+		It("should return one stage if no rollout order is configured", func() {
+			wanted := worker.MachineDeployments{deploymentA1, deploymentA2, deploymentB, deploymentC}
+
+			Expect(rolloutStages(workerObj, wanted, false)).To(Equal([]worker.MachineDeployments{wanted}))
+		})
+
+		It("should return one stage if all pools have the same order", func() {
+			for i := range workerObj.Spec.Pools {
+				workerObj.Spec.Pools[i].RolloutStrategy = &gardencorev1beta1.WorkerRolloutStrategy{Order: ptr.To[int32](2)}
+			}
+			wanted := worker.MachineDeployments{deploymentA1, deploymentB, deploymentC}
+
+			Expect(rolloutStages(workerObj, wanted, false)).To(Equal([]worker.MachineDeployments{wanted}))
+		})
+
+		It("should return cumulative stages in the order of the pools", func() {
+			workerObj.Spec.Pools[0].RolloutStrategy = &gardencorev1beta1.WorkerRolloutStrategy{Order: ptr.To[int32](2)}
+			workerObj.Spec.Pools[1].RolloutStrategy = &gardencorev1beta1.WorkerRolloutStrategy{}
+			workerObj.Spec.Pools[2].RolloutStrategy = &gardencorev1beta1.WorkerRolloutStrategy{Order: ptr.To[int32](1)}
+			wanted := worker.MachineDeployments{deploymentA1, deploymentA2, deploymentB, deploymentC}
+
+			Expect(rolloutStages(workerObj, wanted, false)).To(Equal([]worker.MachineDeployments{
+				{deploymentB},
+				{deploymentB, deploymentC},
+				{deploymentA1, deploymentA2, deploymentB, deploymentC},
+			}))
+		})
+
+		It("should treat machine deployments of unknown pools like pools without order", func() {
+			workerObj.Spec.Pools[0].RolloutStrategy = &gardencorev1beta1.WorkerRolloutStrategy{Order: ptr.To[int32](1)}
+			deploymentUnknown := worker.MachineDeployment{Name: "unknown"}
+			wanted := worker.MachineDeployments{deploymentA1, deploymentUnknown}
+
+			Expect(rolloutStages(workerObj, wanted, false)).To(Equal([]worker.MachineDeployments{
+				{deploymentUnknown},
+				{deploymentA1, deploymentUnknown},
+			}))
+		})
+
+		It("should return one stage if the worker is hibernated", func() {
+			workerObj.Spec.Pools[0].RolloutStrategy = &gardencorev1beta1.WorkerRolloutStrategy{Order: ptr.To[int32](1)}
+			wanted := worker.MachineDeployments{deploymentA1, deploymentB}
+
+			Expect(rolloutStages(workerObj, wanted, true)).To(Equal([]worker.MachineDeployments{wanted}))
+		})
+	})
+
+	Describe("#deployedMachineDeployments", func() {
+		It("should return the machine deployments of the stage and the already existing ones", func() {
+			existing := &machinev1alpha1.MachineDeploymentList{Items: []machinev1alpha1.MachineDeployment{
+				{ObjectMeta: metav1.ObjectMeta{Name: deploymentC.Name}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "unwanted"}},
+			}}
+			wanted := worker.MachineDeployments{deploymentA1, deploymentA2, deploymentB, deploymentC}
+
+			Expect(deployedMachineDeployments(existing, wanted, worker.MachineDeployments{deploymentB})).To(Equal(worker.MachineDeployments{deploymentB, deploymentC}))
+		})
+	})
+})
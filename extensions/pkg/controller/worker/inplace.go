@@ -0,0 +1,109 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils"
+)
+
+// IsInPlaceUpdate returns true if the given worker pool uses the in-place update strategy.
+func IsInPlaceUpdate(pool extensionsv1alpha1.WorkerPool) bool {
+	return ptr.Deref(pool.UpdateStrategy, extensionsv1alpha1.UpdateStrategyRollingUpdate) == extensionsv1alpha1.UpdateStrategyInPlace
+}
+
+// WorkerPoolInPlaceUpdateHash returns a hash value for the machine image version and the Kubernetes version of the
+// given worker pool. Nodes of worker pools with the in-place update strategy are updated by gardener-node-agent when
+// this hash changes.
+func WorkerPoolInPlaceUpdateHash(pool extensionsv1alpha1.WorkerPool, cluster *extensionscontroller.Cluster) string {
+	kubernetesVersion := cluster.Shoot.Spec.Kubernetes.Version
+	if pool.KubernetesVersion != nil {
+		kubernetesVersion = *pool.KubernetesVersion
+	}
+
+	var result string
+	for _, v := range []string{kubernetesVersion, pool.MachineImage.Name, pool.MachineImage.Version} {
+		result += utils.ComputeSHA256Hex([]byte(v))
+	}
+
+	return utils.ComputeSHA256Hex([]byte(result))[:16]
+}
+
+// ReconcileInPlaceUpdate drives the in-place update of the nodes of the given worker pool to the given hash. Nodes which
+// have not yet reported the desired hash are selected for the update by annotating them with the desired hash. At most
+// `maxUnavailable` nodes of the pool are updated at the same time, however, at least one node is always updated. The
+// update itself is performed by gardener-node-agent which reports the applied hash in a node annotation. The returned
+// status contains the names of all nodes which have not yet applied the desired hash.
+func ReconcileInPlaceUpdate(ctx context.Context, log logr.Logger, shootClient client.Client, pool extensionsv1alpha1.WorkerPool, hash string) (*extensionsv1alpha1.InPlaceUpdateStatus, error) {
+	nodeList := &corev1.NodeList{}
+	if err := shootClient.List(ctx, nodeList, client.MatchingLabels{v1beta1constants.LabelWorkerPool: pool.Name}); err != nil {
+		return nil, fmt.Errorf("failed listing nodes of worker pool %q: %w", pool.Name, err)
+	}
+
+	var (
+		status = &extensionsv1alpha1.InPlaceUpdateStatus{PoolName: pool.Name, Hash: hash}
+
+		inProgress int
+		candidates []*corev1.Node
+	)
+
+	for i := range nodeList.Items {
+		node := &nodeList.Items[i]
+
+		if node.Annotations[v1beta1constants.AnnotationInPlaceUpdateCurrentHash] == hash {
+			continue
+		}
+
+		status.NodesPendingUpdate = append(status.NodesPendingUpdate, node.Name)
+		if node.Annotations[v1beta1constants.AnnotationInPlaceUpdateDesiredHash] == hash {
+			inProgress++
+			continue
+		}
+		candidates = append(candidates, node)
+	}
+
+	if len(status.NodesPendingUpdate) == 0 {
+		return status, nil
+	}
+
+	maxUnavailable, err := intstr.GetScaledValueFromIntOrPercent(&pool.MaxUnavailable, len(nodeList.Items), false)
+	if err != nil {
+		return nil, fmt.Errorf("failed computing max unavailable nodes of worker pool %q: %w", pool.Name, err)
+	}
+	maxUnavailable = max(maxUnavailable, 1)
+
+	slices.SortFunc(candidates, func(a, b *corev1.Node) int { return strings.Compare(a.Name, b.Name) })
+
+	for _, node := range candidates {
+		if inProgress >= maxUnavailable {
+			break
+		}
+
+		log.Info("Selecting node for in-place update", "node", client.ObjectKeyFromObject(node), "workerPool", pool.Name, "hash", hash)
+		patch := client.MergeFrom(node.DeepCopy())
+		metav1.SetMetaDataAnnotation(&node.ObjectMeta, v1beta1constants.AnnotationInPlaceUpdateDesiredHash, hash)
+		if err := shootClient.Patch(ctx, node, patch); err != nil {
+			return nil, fmt.Errorf("failed selecting node %q for in-place update: %w", node.Name, err)
+		}
+		inProgress++
+	}
+
+	slices.Sort(status.NodesPendingUpdate)
+	return status, nil
+}
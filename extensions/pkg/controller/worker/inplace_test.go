@@ -0,0 +1,168 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker_test
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	. "github.com/gardener/gardener/extensions/pkg/controller/worker"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+)
+
+var _ = Describe("InPlace", func() {
+	var pool extensionsv1alpha1.WorkerPool
+
+	BeforeEach(func() {
+		pool = extensionsv1alpha1.WorkerPool{
+			Name:           "pool",
+			MachineImage:   extensionsv1alpha1.MachineImage{Name: "gardenlinux", Version: "1.0.0"},
+			MaxUnavailable: intstr.FromInt32(1),
+		}
+	})
+
+	Describe("#IsInPlaceUpdate", func() {
+		It("should return false if no update strategy is set", func() {
+			Expect(IsInPlaceUpdate(pool)).To(BeFalse())
+		})
+
+		It("should return false for the rolling update strategy", func() {
+			pool.UpdateStrategy = ptr.To(extensionsv1alpha1.UpdateStrategyRollingUpdate)
+			Expect(IsInPlaceUpdate(pool)).To(BeFalse())
+		})
+
+		It("should return true for the in-place update strategy", func() {
+			pool.UpdateStrategy = ptr.To(extensionsv1alpha1.UpdateStrategyInPlace)
+			Expect(IsInPlaceUpdate(pool)).To(BeTrue())
+		})
+	})
+
+	Describe("#WorkerPoolInPlaceUpdateHash", func() {
+		var (
+			cluster *extensionscontroller.Cluster
+			hash    string
+		)
+
+		BeforeEach(func() {
+			cluster = &extensionscontroller.Cluster{Shoot: &gardencorev1beta1.Shoot{Spec: gardencorev1beta1.ShootSpec{
+				Kubernetes: gardencorev1beta1.Kubernetes{Version: "1.31.1"},
+			}}}
+			hash = WorkerPoolInPlaceUpdateHash(pool, cluster)
+		})
+
+		It("should change the hash when changing the kubernetes patch version of the control plane", func() {
+			cluster.Shoot.Spec.Kubernetes.Version = "1.31.2"
+			Expect(WorkerPoolInPlaceUpdateHash(pool, cluster)).NotTo(Equal(hash))
+		})
+
+		It("should change the hash when changing the kubernetes version of the worker pool", func() {
+			pool.KubernetesVersion = ptr.To("1.30.5")
+			Expect(WorkerPoolInPlaceUpdateHash(pool, cluster)).NotTo(Equal(hash))
+		})
+
+		It("should change the hash when changing the machine image version", func() {
+			pool.MachineImage.Version = "1.1.0"
+			Expect(WorkerPoolInPlaceUpdateHash(pool, cluster)).NotTo(Equal(hash))
+		})
+
+		It("should not change the hash when changing other fields", func() {
+			pool.MachineType = "large"
+			pool.Labels = map[string]string{"foo": "bar"}
+			Expect(WorkerPoolInPlaceUpdateHash(pool, cluster)).To(Equal(hash))
+		})
+	})
+
+	Describe("#ReconcileInPlaceUpdate", func() {
+		const hash = "new-hash"
+
+		var (
+			ctx         = context.Background()
+			shootClient client.Client
+		)
+
+		BeforeEach(func() {
+			shootClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).Build()
+		})
+
+		createNode := func(name, pool string, annotations map[string]string) {
+			ExpectWithOffset(1, shootClient.Create(ctx, &corev1.Node{ObjectMeta: metav1.ObjectMeta{
+				Name:        name,
+				Labels:      map[string]string{"worker.gardener.cloud/pool": pool},
+				Annotations: annotations,
+			}})).To(Succeed())
+		}
+
+		desiredHash := func(name string) string {
+			node := &corev1.Node{}
+			ExpectWithOffset(1, shootClient.Get(ctx, client.ObjectKey{Name: name}, node)).To(Succeed())
+			return node.Annotations["worker.gardener.cloud/in-place-update-desired-hash"]
+		}
+
+		It("should report no pending nodes if all nodes are up to date", func() {
+			createNode("node-a", "pool", map[string]string{"worker.gardener.cloud/in-place-update-current-hash": hash})
+			createNode("node-b", "other-pool", nil)
+
+			Expect(ReconcileInPlaceUpdate(ctx, logr.Discard(), shootClient, pool, hash)).To(Equal(&extensionsv1alpha1.InPlaceUpdateStatus{
+				PoolName: "pool",
+				Hash:     hash,
+			}))
+			Expect(desiredHash("node-b")).To(BeEmpty())
+		})
+
+		It("should select at most maxUnavailable nodes for the update", func() {
+			pool.MaxUnavailable = intstr.FromInt32(2)
+			createNode("node-c", "pool", nil)
+			createNode("node-b", "pool", map[string]string{"worker.gardener.cloud/in-place-update-current-hash": "old-hash"})
+			createNode("node-a", "pool", nil)
+			createNode("node-d", "pool", map[string]string{"worker.gardener.cloud/in-place-update-current-hash": hash})
+
+			Expect(ReconcileInPlaceUpdate(ctx, logr.Discard(), shootClient, pool, hash)).To(Equal(&extensionsv1alpha1.InPlaceUpdateStatus{
+				PoolName:           "pool",
+				Hash:               hash,
+				NodesPendingUpdate: []string{"node-a", "node-b", "node-c"},
+			}))
+			Expect(desiredHash("node-a")).To(Equal(hash))
+			Expect(desiredHash("node-b")).To(Equal(hash))
+			Expect(desiredHash("node-c")).To(BeEmpty())
+			Expect(desiredHash("node-d")).To(BeEmpty())
+		})
+
+		It("should not select further nodes while the update of other nodes is in progress", func() {
+			createNode("node-a", "pool", map[string]string{"worker.gardener.cloud/in-place-update-desired-hash": hash})
+			createNode("node-b", "pool", nil)
+
+			Expect(ReconcileInPlaceUpdate(ctx, logr.Discard(), shootClient, pool, hash)).To(Equal(&extensionsv1alpha1.InPlaceUpdateStatus{
+				PoolName:           "pool",
+				Hash:               hash,
+				NodesPendingUpdate: []string{"node-a", "node-b"},
+			}))
+			Expect(desiredHash("node-b")).To(BeEmpty())
+		})
+
+		It("should always select at least one node", func() {
+			pool.MaxUnavailable = intstr.FromString("0%")
+			createNode("node-a", "pool", nil)
+
+			Expect(ReconcileInPlaceUpdate(ctx, logr.Discard(), shootClient, pool, hash)).To(Equal(&extensionsv1alpha1.InPlaceUpdateStatus{
+				PoolName:           "pool",
+				Hash:               hash,
+				NodesPendingUpdate: []string{"node-a"},
+			}))
+			Expect(desiredHash("node-a")).To(Equal(hash))
+		})
+	})
+})
@@ -117,6 +117,15 @@ func WorkerPoolHashV1(pool extensionsv1alpha1.WorkerPool, cluster *extensionscon
 		pool.MachineImage.Name + pool.MachineImage.Version,
 	}
 
+	// Machines of worker pools with the in-place update strategy are not replaced when the Kubernetes version or the
+	// machine image version changes, see WorkerPoolInPlaceUpdateHash.
+	if IsInPlaceUpdate(pool) {
+		data = []string{
+			pool.MachineType,
+			pool.MachineImage.Name,
+		}
+	}
+
 	if pool.Volume != nil {
 		data = append(data, pool.Volume.Size)
 
@@ -276,6 +276,40 @@ var _ = Describe("Machines", func() {
 				c.Shoot.Spec.SystemComponents = &gardencorev1beta1.SystemComponents{NodeLocalDNS: &gardencorev1beta1.NodeLocalDNS{Enabled: true}}
 			})
 		})
+
+		Context("in-place update strategy", func() {
+			BeforeEach(func() {
+				p.UpdateStrategy = ptr.To(extensionsv1alpha1.UpdateStrategyInPlace)
+
+				var err error
+				hash, err = WorkerPoolHash(p, c, additionalDataV1, additionalDataV2)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("should not change the hash when changing the kubernetes minor version", func() {
+				c.Shoot.Spec.Kubernetes.Version = "1.3.0"
+
+				Expect(WorkerPoolHash(p, c, additionalDataV1, additionalDataV2)).To(Equal(hash))
+			})
+
+			It("should not change the hash when changing the machine image version", func() {
+				p.MachineImage.Version = "new-version"
+
+				Expect(WorkerPoolHash(p, c, additionalDataV1, additionalDataV2)).To(Equal(hash))
+			})
+
+			It("should change the hash when changing the machine image name", func() {
+				p.MachineImage.Name = "new-image"
+
+				Expect(WorkerPoolHash(p, c, additionalDataV1, additionalDataV2)).NotTo(Equal(hash))
+			})
+
+			It("should change the hash when changing the machine type", func() {
+				p.MachineType = "new-type"
+
+				Expect(WorkerPoolHash(p, c, additionalDataV1, additionalDataV2)).NotTo(Equal(hash))
+			})
+		})
 	})
 
 	Describe("#WorkerPoolHashV2", func() {
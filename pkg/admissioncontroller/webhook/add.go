@@ -15,6 +15,7 @@ import (
 	"github.com/gardener/gardener/pkg/admissioncontroller/webhook/admission/auditpolicy"
 	"github.com/gardener/gardener/pkg/admissioncontroller/webhook/admission/authenticationconfig"
 	"github.com/gardener/gardener/pkg/admissioncontroller/webhook/admission/authorizationconfig"
+	"github.com/gardener/gardener/pkg/admissioncontroller/webhook/admission/flowcontrolconfig"
 	"github.com/gardener/gardener/pkg/admissioncontroller/webhook/admission/internaldomainsecret"
 	"github.com/gardener/gardener/pkg/admissioncontroller/webhook/admission/kubeconfigsecret"
 	"github.com/gardener/gardener/pkg/admissioncontroller/webhook/admission/namespacedeletion"
@@ -42,6 +43,10 @@ func AddToManager(
 		return fmt.Errorf("failed adding %s webhook handler: %w", authorizationconfig.HandlerName, err)
 	}
 
+	if err := flowcontrolconfig.AddToManager(mgr); err != nil {
+		return fmt.Errorf("failed adding %s webhook handler: %w", flowcontrolconfig.HandlerName, err)
+	}
+
 	if err := (&internaldomainsecret.Handler{
 		Logger:    mgr.GetLogger().WithName("webhook").WithName(internaldomainsecret.HandlerName),
 		APIReader: mgr.GetAPIReader(),
@@ -0,0 +1,192 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package flowcontrolconfig
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/go-logr/logr"
+	flowcontrolv1 "k8s.io/api/flowcontrol/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/apiserver/pkg/apis/flowcontrol/bootstrap"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	gardencore "github.com/gardener/gardener/pkg/apis/core"
+	gardencorehelper "github.com/gardener/gardener/pkg/apis/core/helper"
+	"github.com/gardener/gardener/pkg/webhook/configvalidator"
+)
+
+const (
+	// HandlerName is the name of this admission webhook handler.
+	HandlerName = "flowcontrolconfig_validator"
+	// WebhookPath is the HTTP handler path for this admission webhook handler.
+	WebhookPath = "/webhooks/flowcontrol-configuration"
+)
+
+// AddToManager adds the webhook to the given manager.
+func AddToManager(mgr manager.Manager) error {
+	webhook := &admission.Webhook{
+		Handler: NewHandler(
+			mgr.GetLogger().WithName("webhook").WithName(HandlerName),
+			mgr.GetAPIReader(),
+			mgr.GetClient(),
+			admission.NewDecoder(mgr.GetScheme()),
+		),
+		RecoverPanic: ptr.To(true),
+	}
+
+	mgr.GetWebhookServer().Register(WebhookPath, webhook)
+	return nil
+}
+
+// NewHandler returns a new handler for validating the custom API Priority and Fairness configurations.
+func NewHandler(log logr.Logger, apiReader, c client.Reader, decoder admission.Decoder) admission.Handler {
+	return &configvalidator.Handler{
+		Logger:    log,
+		APIReader: apiReader,
+		Client:    c,
+		Decoder:   decoder,
+
+		ConfigMapPurpose: "flow control configuration",
+		ConfigMapDataKey: "config.yaml",
+		GetConfigMapNameFromShoot: func(shoot *gardencore.Shoot) string {
+			return gardencorehelper.GetShootAPIPriorityAndFairnessConfigMapName(shoot.Spec.Kubernetes.KubeAPIServer)
+		},
+		AdmitConfig: admitConfig,
+	}
+}
+
+var (
+	decoder runtime.Decoder
+
+	// systemCriticalPriorityLevels are the names of the priority levels which serve the requests of the kube-apiserver
+	// itself, the nodes and the leader elections of the control plane components.
+	systemCriticalPriorityLevels = sets.New(
+		bootstrap.MandatoryPriorityLevelConfigurationExempt.Name,
+		bootstrap.MandatoryPriorityLevelConfigurationCatchAll.Name,
+		bootstrap.SuggestedPriorityLevelConfigurationSystem.Name,
+		bootstrap.SuggestedPriorityLevelConfigurationNodeHigh.Name,
+		bootstrap.SuggestedPriorityLevelConfigurationLeaderElection.Name,
+	)
+	// systemCriticalFlowSchemas are the names of the flow schemas which route requests to the system-critical priority
+	// levels.
+	systemCriticalFlowSchemas = sets.New[string]()
+	// maxSystemCriticalMatchingPrecedence is the highest matching precedence of the system-critical flow schemas (except
+	// for the catch-all flow schema). Custom flow schemas must not take precedence over them.
+	maxSystemCriticalMatchingPrecedence int32
+)
+
+func init() {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(flowcontrolv1.AddToScheme(scheme))
+	decoder = serializer.NewCodecFactory(scheme).UniversalDeserializer()
+
+	for _, flowSchema := range append(bootstrap.MandatoryFlowSchemas, bootstrap.SuggestedFlowSchemas...) {
+		if !systemCriticalPriorityLevels.Has(flowSchema.Spec.PriorityLevelConfiguration.Name) {
+			continue
+		}
+
+		systemCriticalFlowSchemas.Insert(flowSchema.Name)
+		if flowSchema.Name != bootstrap.MandatoryFlowSchemaCatchAll.Name {
+			maxSystemCriticalMatchingPrecedence = max(maxSystemCriticalMatchingPrecedence, flowSchema.Spec.MatchingPrecedence)
+		}
+	}
+}
+
+func admitConfig(flowControlConfigurationRaw string, _ []*gardencore.Shoot) (int32, error) {
+	var (
+		allErrs field.ErrorList
+		reader  = utilyaml.NewYAMLReader(bufio.NewReader(strings.NewReader(flowControlConfigurationRaw)))
+	)
+
+	for {
+		document, err := reader.Read()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return http.StatusUnprocessableEntity, fmt.Errorf("failed to read the provided flow control configuration: %w", err)
+		}
+
+		if len(bytes.TrimSpace(document)) == 0 {
+			continue
+		}
+
+		obj, _, err := decoder.Decode(document, nil, nil)
+		if err != nil {
+			return http.StatusUnprocessableEntity, fmt.Errorf("failed to decode the provided flow control configuration: %w", err)
+		}
+
+		switch o := obj.(type) {
+		case *flowcontrolv1.FlowSchema:
+			allErrs = append(allErrs, validateFlowSchema(o)...)
+		case *flowcontrolv1.PriorityLevelConfiguration:
+			allErrs = append(allErrs, validatePriorityLevelConfiguration(o)...)
+		default:
+			return http.StatusUnprocessableEntity, fmt.Errorf("provided invalid flow control configuration: only FlowSchemas and PriorityLevelConfigurations are supported, got %T", obj)
+		}
+	}
+
+	if len(allErrs) != 0 {
+		return http.StatusUnprocessableEntity, fmt.Errorf("provided invalid flow control configuration: %v", allErrs)
+	}
+
+	return 0, nil
+}
+
+func validateFlowSchema(flowSchema *flowcontrolv1.FlowSchema) field.ErrorList {
+	var (
+		allErrs field.ErrorList
+		fldPath = field.NewPath("FlowSchema").Key(flowSchema.Name)
+	)
+
+	if len(flowSchema.Name) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("metadata", "name"), "must provide a name"))
+	}
+	if systemCriticalFlowSchemas.Has(flowSchema.Name) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("metadata", "name"), "system-critical flow schemas must not be overwritten"))
+	}
+	if flowSchema.Spec.MatchingPrecedence <= maxSystemCriticalMatchingPrecedence {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("spec", "matchingPrecedence"), flowSchema.Spec.MatchingPrecedence, fmt.Sprintf("must be greater than %d to not take precedence over the system-critical flow schemas", maxSystemCriticalMatchingPrecedence)))
+	}
+	if systemCriticalPriorityLevels.Has(flowSchema.Spec.PriorityLevelConfiguration.Name) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("spec", "priorityLevelConfiguration", "name"), "must not route requests to system-critical priority levels"))
+	}
+
+	return allErrs
+}
+
+func validatePriorityLevelConfiguration(priorityLevel *flowcontrolv1.PriorityLevelConfiguration) field.ErrorList {
+	var (
+		allErrs field.ErrorList
+		fldPath = field.NewPath("PriorityLevelConfiguration").Key(priorityLevel.Name)
+	)
+
+	if len(priorityLevel.Name) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("metadata", "name"), "must provide a name"))
+	}
+	if systemCriticalPriorityLevels.Has(priorityLevel.Name) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("metadata", "name"), "system-critical priority levels must not be overwritten"))
+	}
+	if priorityLevel.Spec.Type != flowcontrolv1.PriorityLevelEnablementLimited {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("spec", "type"), priorityLevel.Spec.Type, []flowcontrolv1.PriorityLevelEnablement{flowcontrolv1.PriorityLevelEnablementLimited}))
+	}
+
+	return allErrs
+}
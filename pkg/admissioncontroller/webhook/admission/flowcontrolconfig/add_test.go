@@ -0,0 +1,283 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package flowcontrolconfig_test
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	jsonserializer "k8s.io/apimachinery/pkg/runtime/serializer/json"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	logzap "sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	. "github.com/gardener/gardener/pkg/admissioncontroller/webhook/admission/flowcontrolconfig"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/logger"
+	mockclient "github.com/gardener/gardener/third_party/mock/controller-runtime/client"
+)
+
+var _ = Describe("handler", func() {
+	var (
+		ctx = context.TODO()
+		log logr.Logger
+
+		request admission.Request
+		decoder admission.Decoder
+		handler admission.Handler
+
+		ctrl       *gomock.Controller
+		mockReader *mockclient.MockReader
+		fakeClient client.Client
+
+		statusCodeAllowed int32 = http.StatusOK
+		statusCodeInvalid int32 = http.StatusUnprocessableEntity
+
+		testEncoder runtime.Encoder
+
+		cmName         = "fake-cm-name"
+		cmNamespace    = "fake-cm-namespace"
+		shootName      = "fake-shoot-name"
+		shootNamespace = cmNamespace
+
+		cm           *corev1.ConfigMap
+		shootv1beta1 *gardencorev1beta1.Shoot
+
+		validConfig = `
+---
+apiVersion: flowcontrol.apiserver.k8s.io/v1
+kind: PriorityLevelConfiguration
+metadata:
+  name: ci-jobs
+spec:
+  type: Limited
+  limited:
+    nominalConcurrencyShares: 10
+    limitResponse:
+      type: Reject
+---
+apiVersion: flowcontrol.apiserver.k8s.io/v1
+kind: FlowSchema
+metadata:
+  name: ci-jobs
+spec:
+  matchingPrecedence: 1000
+  priorityLevelConfiguration:
+    name: ci-jobs
+  rules:
+  - subjects:
+    - kind: ServiceAccount
+      serviceAccount:
+        name: ci-runner
+        namespace: ci
+    resourceRules:
+    - verbs: ["*"]
+      apiGroups: ["*"]
+      resources: ["*"]
+      namespaces: ["*"]
+`
+		systemCriticalConfig = `
+---
+apiVersion: flowcontrol.apiserver.k8s.io/v1
+kind: PriorityLevelConfiguration
+metadata:
+  name: system
+spec:
+  type: Limited
+  limited:
+    nominalConcurrencyShares: 1
+---
+apiVersion: flowcontrol.apiserver.k8s.io/v1
+kind: FlowSchema
+metadata:
+  name: system-nodes
+spec:
+  matchingPrecedence: 1000
+  priorityLevelConfiguration:
+    name: ci-jobs
+`
+		precedenceConfig = `
+apiVersion: flowcontrol.apiserver.k8s.io/v1
+kind: FlowSchema
+metadata:
+  name: ci-jobs
+spec:
+  matchingPrecedence: 100
+  priorityLevelConfiguration:
+    name: leader-election
+`
+		exemptConfig = `
+apiVersion: flowcontrol.apiserver.k8s.io/v1
+kind: PriorityLevelConfiguration
+metadata:
+  name: ci-jobs
+spec:
+  type: Exempt
+`
+		unsupportedKindConfig = `
+apiVersion: flowcontrol.apiserver.k8s.io/v1
+kind: FlowSchemaList
+items: []
+`
+	)
+
+	BeforeEach(func() {
+		log = logger.MustNewZapLogger(logger.DebugLevel, logger.FormatJSON, logzap.WriteTo(GinkgoWriter))
+		testEncoder = &jsonserializer.Serializer{}
+
+		ctrl = gomock.NewController(GinkgoT())
+		mockReader = mockclient.NewMockReader(ctrl)
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+
+		decoder = admission.NewDecoder(kubernetes.GardenScheme)
+
+		handler = NewHandler(log, mockReader, fakeClient, decoder)
+
+		request = admission.Request{}
+
+		shootv1beta1 = &gardencorev1beta1.Shoot{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: gardencorev1beta1.SchemeGroupVersion.String(),
+				Kind:       "Shoot",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      shootName,
+				Namespace: shootNamespace,
+			},
+			Spec: gardencorev1beta1.ShootSpec{
+				Kubernetes: gardencorev1beta1.Kubernetes{
+					Version: "1.31.1",
+					KubeAPIServer: &gardencorev1beta1.KubeAPIServerConfig{
+						APIPriorityAndFairness: &gardencorev1beta1.APIPriorityAndFairness{
+							ConfigMapName: ptr.To(cmName),
+						},
+					},
+				},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	test := func(op admissionv1.Operation, oldObj runtime.Object, obj runtime.Object, expectedAllowed bool, expectedStatusCode int32, expectedMsg string) {
+		request.Operation = op
+
+		if oldObj != nil {
+			objData, err := runtime.Encode(testEncoder, oldObj)
+			ExpectWithOffset(1, err).NotTo(HaveOccurred())
+			request.OldObject.Raw = objData
+		}
+
+		if obj != nil {
+			objData, err := runtime.Encode(testEncoder, obj)
+			ExpectWithOffset(1, err).NotTo(HaveOccurred())
+			request.Object.Raw = objData
+		}
+
+		response := handler.Handle(ctx, request)
+		ExpectWithOffset(1, response).To(Not(BeNil()))
+		ExpectWithOffset(1, response.Allowed).To(Equal(expectedAllowed))
+		ExpectWithOffset(1, response.Result.Code).To(Equal(expectedStatusCode))
+		if expectedMsg != "" {
+			ExpectWithOffset(1, response.Result.Message).To(ContainSubstring(expectedMsg))
+		}
+		ExpectWithOffset(1, response.Patches).To(BeEmpty())
+	}
+
+	Context("Shoots", func() {
+		BeforeEach(func() {
+			request.Kind = metav1.GroupVersionKind{Group: "core.gardener.cloud", Version: "v1beta1", Kind: "Shoot"}
+		})
+
+		expectConfigMap := func(config string) {
+			mockReader.EXPECT().Get(gomock.Any(), client.ObjectKey{Namespace: shootNamespace, Name: cmName}, gomock.AssignableToTypeOf(&corev1.ConfigMap{})).DoAndReturn(func(_ context.Context, _ client.ObjectKey, cm *corev1.ConfigMap, _ ...client.GetOption) error {
+				*cm = corev1.ConfigMap{Data: map[string]string{"config.yaml": config}}
+				return nil
+			})
+		}
+
+		It("should allow if no ConfigMap is referenced", func() {
+			shootv1beta1.Spec.Kubernetes.KubeAPIServer.APIPriorityAndFairness = &gardencorev1beta1.APIPriorityAndFairness{MaxSeats: ptr.To[int32](1000)}
+			test(admissionv1.Create, nil, shootv1beta1, true, statusCodeAllowed, "Shoot resource does not specify any flow control configuration ConfigMap")
+		})
+
+		It("should allow a valid configuration", func() {
+			expectConfigMap(validConfig)
+			test(admissionv1.Create, nil, shootv1beta1, true, statusCodeAllowed, "referenced flow control configuration is valid")
+		})
+
+		It("should deny overwriting system-critical configurations", func() {
+			expectConfigMap(systemCriticalConfig)
+			test(admissionv1.Create, nil, shootv1beta1, false, statusCodeInvalid, "system-critical priority levels must not be overwritten")
+		})
+
+		It("should deny flow schemas taking precedence over or routing to system-critical configurations", func() {
+			expectConfigMap(precedenceConfig)
+			test(admissionv1.Create, nil, shootv1beta1, false, statusCodeInvalid, "must not route requests to system-critical priority levels")
+		})
+
+		It("should deny exempt priority levels", func() {
+			expectConfigMap(exemptConfig)
+			test(admissionv1.Create, nil, shootv1beta1, false, statusCodeInvalid, `Unsupported value: "Exempt"`)
+		})
+
+		It("should deny unsupported kinds", func() {
+			expectConfigMap(unsupportedKindConfig)
+			test(admissionv1.Create, nil, shootv1beta1, false, statusCodeInvalid, "only FlowSchemas and PriorityLevelConfigurations are supported")
+		})
+	})
+
+	Context("ConfigMaps", func() {
+		BeforeEach(func() {
+			request.Kind = metav1.GroupVersionKind{Group: "", Version: "v1", Kind: "ConfigMap"}
+			request.Name = cmName
+			request.Namespace = cmNamespace
+
+			cm = &corev1.ConfigMap{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "ConfigMap",
+					APIVersion: "v1",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      cmName,
+					Namespace: cmNamespace,
+				},
+				Data: map[string]string{
+					"config.yaml": validConfig,
+				},
+			}
+
+			Expect(fakeClient.Create(ctx, shootv1beta1)).To(Succeed())
+		})
+
+		It("should allow changing the configuration to something valid", func() {
+			newCm := cm.DeepCopy()
+			newCm.Data["config.yaml"] = strings.Replace(validConfig, "nominalConcurrencyShares: 10", "nominalConcurrencyShares: 20", 1)
+
+			test(admissionv1.Update, cm, newCm, true, statusCodeAllowed, "referenced flow control configuration is valid")
+		})
+
+		It("should deny changing the configuration to something invalid", func() {
+			newCm := cm.DeepCopy()
+			newCm.Data["config.yaml"] = precedenceConfig
+
+			test(admissionv1.Update, cm, newCm, false, statusCodeInvalid, "must be greater than 500")
+		})
+	})
+})
@@ -70,6 +70,11 @@ func (g *graph) handleGardenletCreateOrUpdate(ctx context.Context, gardenlet *se
 
 	g.addEdge(gardenletVertex, seedVertex)
 
+	if registry := gardenlet.Spec.Deployment.Registry; registry != nil && registry.PullSecretRef != nil {
+		secretVertex := g.getOrCreateVertex(VertexTypeSecret, gardenlet.Namespace, registry.PullSecretRef.Name)
+		g.addEdge(secretVertex, gardenletVertex)
+	}
+
 	seedTemplate, _, err := seedmanagementv1alpha1helper.ExtractSeedTemplateAndGardenletConfig(gardenlet.Name, &gardenlet.Spec.Config)
 	if err != nil {
 		return
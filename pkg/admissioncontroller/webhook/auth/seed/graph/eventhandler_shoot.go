@@ -51,6 +51,7 @@ func (g *graph) setupShootWatch(ctx context.Context, informer cache.Informer) er
 				v1beta1helper.GetShootAuditPolicyConfigMapName(oldShoot.Spec.Kubernetes.KubeAPIServer) != v1beta1helper.GetShootAuditPolicyConfigMapName(newShoot.Spec.Kubernetes.KubeAPIServer) ||
 				v1beta1helper.GetShootAuthenticationConfigurationConfigMapName(oldShoot.Spec.Kubernetes.KubeAPIServer) != v1beta1helper.GetShootAuthenticationConfigurationConfigMapName(newShoot.Spec.Kubernetes.KubeAPIServer) ||
 				!apiequality.Semantic.DeepEqual(v1beta1helper.GetShootAuthorizationConfiguration(oldShoot.Spec.Kubernetes.KubeAPIServer), v1beta1helper.GetShootAuthorizationConfiguration(newShoot.Spec.Kubernetes.KubeAPIServer)) ||
+				v1beta1helper.GetShootAPIPriorityAndFairnessConfigMapName(oldShoot.Spec.Kubernetes.KubeAPIServer) != v1beta1helper.GetShootAPIPriorityAndFairnessConfigMapName(newShoot.Spec.Kubernetes.KubeAPIServer) ||
 				!v1beta1helper.ShootDNSProviderSecretNamesEqual(oldShoot.Spec.DNS, newShoot.Spec.DNS) ||
 				!v1beta1helper.ShootResourceReferencesEqual(oldShoot.Spec.Resources, newShoot.Spec.Resources) ||
 				v1beta1helper.HasManagedIssuer(oldShoot) != v1beta1helper.HasManagedIssuer(newShoot) {
@@ -152,6 +153,11 @@ func (g *graph) handleShootCreateOrUpdate(ctx context.Context, shoot *gardencore
 			g.addEdge(configMapVertex, shootVertex)
 		}
 
+		if configMapName := v1beta1helper.GetShootAPIPriorityAndFairnessConfigMapName(kubeAPIServer); len(configMapName) > 0 {
+			configMapVertex := g.getOrCreateVertex(VertexTypeConfigMap, shoot.Namespace, configMapName)
+			g.addEdge(configMapVertex, shootVertex)
+		}
+
 		if kubeAPIServer.StructuredAuthorization != nil {
 			for _, kubeconfig := range kubeAPIServer.StructuredAuthorization.Kubeconfigs {
 				secretVertex := g.getOrCreateVertex(VertexTypeSecret, shoot.Namespace, kubeconfig.SecretName)
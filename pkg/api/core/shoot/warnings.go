@@ -37,6 +37,10 @@ func GetWarnings(_ context.Context, shoot, oldShoot *core.Shoot, credentialsRota
 		warnings = append(warnings, "you are setting the spec.kubernetes.kubeControllerManager.podEvictionTimeout field. The field does not have effect since Kubernetes 1.13. Instead, use the spec.kubernetes.kubeAPIServer.(defaultNotReadyTolerationSeconds/defaultUnreachableTolerationSeconds) fields.")
 	}
 
+	if helper.NginxIngressEnabled(shoot.Spec.Addons) || helper.KubernetesDashboardEnabled(shoot.Spec.Addons) {
+		warnings = append(warnings, "the spec.addons.nginxIngress and spec.addons.kubernetesDashboard fields are deprecated and will be removed in a future version. Instead, use managed addons via the spec.addons.managed field, see https://github.com/gardener/gardener/blob/master/docs/usage/shoot/shoot_managed_addons.md for details.")
+	}
+
 	return warnings
 }
 
@@ -289,5 +289,19 @@ var _ = Describe("Warnings", func() {
 			}
 			Expect(GetWarnings(ctx, shoot, nil, credentialsRotationInterval)).To(ContainElement(Equal("you are setting the spec.kubernetes.kubeControllerManager.podEvictionTimeout field. The field does not have effect since Kubernetes 1.13. Instead, use the spec.kubernetes.kubeAPIServer.(defaultNotReadyTolerationSeconds/defaultUnreachableTolerationSeconds) fields.")))
 		})
+
+		It("should return a warning when legacy addons are enabled", func() {
+			shoot.Spec.Addons = &core.Addons{
+				NginxIngress: &core.NginxIngress{Addon: core.Addon{Enabled: true}},
+			}
+			Expect(GetWarnings(ctx, shoot, nil, credentialsRotationInterval)).To(ContainElement(ContainSubstring("use managed addons via the spec.addons.managed field")))
+		})
+
+		It("should not return a warning when only managed addons are configured", func() {
+			shoot.Spec.Addons = &core.Addons{
+				Managed: []core.ManagedAddon{{Name: "foo", Version: "1.0.0"}},
+			}
+			Expect(GetWarnings(ctx, shoot, nil, credentialsRotationInterval)).NotTo(ContainElement(ContainSubstring("spec.addons")))
+		})
 	})
 })
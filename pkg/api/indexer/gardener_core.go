@@ -33,6 +33,15 @@ func BackupBucketSeedNameIndexerFunc(obj client.Object) []string {
 	return []string{ptr.Deref(backupBucket.Spec.SeedName, "")}
 }
 
+// BackupEntrySeedNameIndexerFunc extracts the .spec.seedName field of a BackupEntry.
+func BackupEntrySeedNameIndexerFunc(obj client.Object) []string {
+	backupEntry, ok := obj.(*gardencorev1beta1.BackupEntry)
+	if !ok {
+		return []string{""}
+	}
+	return []string{ptr.Deref(backupEntry.Spec.SeedName, "")}
+}
+
 // BackupEntryBucketNameIndexerFunc extracts the .spec.bucketName field of a BackupEntry.
 func BackupEntryBucketNameIndexerFunc(obj client.Object) []string {
 	backupEntry, ok := obj.(*gardencorev1beta1.BackupEntry)
@@ -124,13 +133,7 @@ func AddBackupBucketSeedName(ctx context.Context, indexer client.FieldIndexer) e
 
 // AddBackupEntrySeedName adds an index for core.BackupEntrySeedName to the given indexer.
 func AddBackupEntrySeedName(ctx context.Context, indexer client.FieldIndexer) error {
-	if err := indexer.IndexField(ctx, &gardencorev1beta1.BackupEntry{}, core.BackupEntrySeedName, func(obj client.Object) []string {
-		backupEntry, ok := obj.(*gardencorev1beta1.BackupEntry)
-		if !ok {
-			return []string{""}
-		}
-		return []string{ptr.Deref(backupEntry.Spec.SeedName, "")}
-	}); err != nil {
+	if err := indexer.IndexField(ctx, &gardencorev1beta1.BackupEntry{}, core.BackupEntrySeedName, BackupEntrySeedNameIndexerFunc); err != nil {
 		return fmt.Errorf("failed to add indexer for %s to BackupEntry Informer: %w", core.BackupEntrySeedName, err)
 	}
 	return nil
@@ -28,6 +28,9 @@ type KubeconfigRequestSpec struct {
 	// response.
 	// Defaults to 1 hour.
 	ExpirationSeconds int64
+	// Token is the token issued by an external identity provider which is exchanged for the kubeconfig. It is only set
+	// for requests of kind TokenExchangeRequest.
+	Token string
 }
 
 // KubeconfigRequestStatus is the status of the KubeconfigRequest containing the kubeconfig and expiration of the
@@ -39,3 +39,33 @@ func Convert_authentication_KubeconfigRequest_To_v1alpha1_ViewerKubeconfigReques
 	out.Status.ExpirationTimestamp = in.Status.ExpirationTimestamp
 	return nil
 }
+
+func Convert_v1alpha1_TokenExchangeRequest_To_authentication_KubeconfigRequest(in *TokenExchangeRequest, out *authentication.KubeconfigRequest, _ conversion.Scope) error {
+	out.Spec.ExpirationSeconds = ptr.Deref(in.Spec.ExpirationSeconds, 0)
+	out.Spec.Token = in.Spec.Token
+	out.Status.Kubeconfig = in.Status.Kubeconfig
+	out.Status.ExpirationTimestamp = in.Status.ExpirationTimestamp
+	return nil
+}
+
+func Convert_authentication_KubeconfigRequest_To_v1alpha1_TokenExchangeRequest(in *authentication.KubeconfigRequest, out *TokenExchangeRequest, _ conversion.Scope) error {
+	out.Spec.ExpirationSeconds = &in.Spec.ExpirationSeconds
+	out.Spec.Token = in.Spec.Token
+	out.Status.Kubeconfig = in.Status.Kubeconfig
+	out.Status.ExpirationTimestamp = in.Status.ExpirationTimestamp
+	return nil
+}
+
+func Convert_v1alpha1_KubeconfigBundleRequest_To_authentication_KubeconfigRequest(in *KubeconfigBundleRequest, out *authentication.KubeconfigRequest, _ conversion.Scope) error {
+	out.Spec.ExpirationSeconds = ptr.Deref(in.Spec.ExpirationSeconds, 0)
+	out.Status.Kubeconfig = in.Status.Kubeconfig
+	out.Status.ExpirationTimestamp = in.Status.ExpirationTimestamp
+	return nil
+}
+
+func Convert_authentication_KubeconfigRequest_To_v1alpha1_KubeconfigBundleRequest(in *authentication.KubeconfigRequest, out *KubeconfigBundleRequest, _ conversion.Scope) error {
+	out.Spec.ExpirationSeconds = &in.Spec.ExpirationSeconds
+	out.Status.Kubeconfig = in.Status.Kubeconfig
+	out.Status.ExpirationTimestamp = in.Status.ExpirationTimestamp
+	return nil
+}
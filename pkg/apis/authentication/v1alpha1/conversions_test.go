@@ -79,4 +79,64 @@ var _ = Describe("conversion", func() {
 			Expect(out.Status).To(Equal(ViewerKubeconfigRequestStatus{Kubeconfig: kubeconfig, ExpirationTimestamp: expirationTimestamp}))
 		})
 	})
+
+	Describe("#Convert_v1alpha1_TokenExchangeRequest_To_authentication_KubeconfigRequest", func() {
+		It("should properly convert", func() {
+			in := &TokenExchangeRequest{
+				Spec:   TokenExchangeRequestSpec{Token: "token", ExpirationSeconds: &expirationSeconds},
+				Status: TokenExchangeRequestStatus{Kubeconfig: kubeconfig, ExpirationTimestamp: expirationTimestamp},
+			}
+			out := &authentication.KubeconfigRequest{}
+
+			Expect(Convert_v1alpha1_TokenExchangeRequest_To_authentication_KubeconfigRequest(in, out, nil)).To(Succeed())
+
+			Expect(out.Spec).To(Equal(authentication.KubeconfigRequestSpec{Token: "token", ExpirationSeconds: expirationSeconds}))
+			Expect(out.Status).To(Equal(authentication.KubeconfigRequestStatus{Kubeconfig: kubeconfig, ExpirationTimestamp: expirationTimestamp}))
+		})
+	})
+
+	Describe("#Convert_authentication_KubeconfigRequest_To_v1alpha1_TokenExchangeRequest", func() {
+		It("should properly convert", func() {
+			in := &authentication.KubeconfigRequest{
+				Spec:   authentication.KubeconfigRequestSpec{Token: "token", ExpirationSeconds: expirationSeconds},
+				Status: authentication.KubeconfigRequestStatus{Kubeconfig: kubeconfig, ExpirationTimestamp: expirationTimestamp},
+			}
+			out := &TokenExchangeRequest{}
+
+			Expect(Convert_authentication_KubeconfigRequest_To_v1alpha1_TokenExchangeRequest(in, out, nil)).To(Succeed())
+
+			Expect(out.Spec).To(Equal(TokenExchangeRequestSpec{Token: "token", ExpirationSeconds: &expirationSeconds}))
+			Expect(out.Status).To(Equal(TokenExchangeRequestStatus{Kubeconfig: kubeconfig, ExpirationTimestamp: expirationTimestamp}))
+		})
+	})
+
+	Describe("#Convert_v1alpha1_KubeconfigBundleRequest_To_authentication_KubeconfigRequest", func() {
+		It("should properly convert", func() {
+			in := &KubeconfigBundleRequest{
+				Spec:   KubeconfigBundleRequestSpec{ExpirationSeconds: &expirationSeconds},
+				Status: KubeconfigBundleRequestStatus{Kubeconfig: kubeconfig, ExpirationTimestamp: expirationTimestamp},
+			}
+			out := &authentication.KubeconfigRequest{}
+
+			Expect(Convert_v1alpha1_KubeconfigBundleRequest_To_authentication_KubeconfigRequest(in, out, nil)).To(Succeed())
+
+			Expect(out.Spec).To(Equal(authentication.KubeconfigRequestSpec{ExpirationSeconds: expirationSeconds}))
+			Expect(out.Status).To(Equal(authentication.KubeconfigRequestStatus{Kubeconfig: kubeconfig, ExpirationTimestamp: expirationTimestamp}))
+		})
+	})
+
+	Describe("#Convert_authentication_KubeconfigRequest_To_v1alpha1_KubeconfigBundleRequest", func() {
+		It("should properly convert", func() {
+			in := &authentication.KubeconfigRequest{
+				Spec:   authentication.KubeconfigRequestSpec{ExpirationSeconds: expirationSeconds},
+				Status: authentication.KubeconfigRequestStatus{Kubeconfig: kubeconfig, ExpirationTimestamp: expirationTimestamp},
+			}
+			out := &KubeconfigBundleRequest{}
+
+			Expect(Convert_authentication_KubeconfigRequest_To_v1alpha1_KubeconfigBundleRequest(in, out, nil)).To(Succeed())
+
+			Expect(out.Spec).To(Equal(KubeconfigBundleRequestSpec{ExpirationSeconds: &expirationSeconds}))
+			Expect(out.Status).To(Equal(KubeconfigBundleRequestStatus{Kubeconfig: kubeconfig, ExpirationTimestamp: expirationTimestamp}))
+		})
+	})
 })
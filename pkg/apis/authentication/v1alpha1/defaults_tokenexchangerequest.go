@@ -0,0 +1,16 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"k8s.io/utils/ptr"
+)
+
+// SetDefaults_TokenExchangeRequestSpec sets default values for TokenExchangeRequestSpec objects.
+func SetDefaults_TokenExchangeRequestSpec(obj *TokenExchangeRequestSpec) {
+	if obj.ExpirationSeconds == nil {
+		obj.ExpirationSeconds = ptr.To(int64(60 * 60))
+	}
+}
@@ -38,6 +38,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
 		&AdminKubeconfigRequest{},
 		&ViewerKubeconfigRequest{},
+		&TokenExchangeRequest{},
+		&KubeconfigBundleRequest{},
 	)
 
 	return nil
@@ -0,0 +1,43 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// KubeconfigBundleRequest can be used to request a kubeconfig for a Shoot cluster which contains multiple contexts
+// (admin, viewer, and in-cluster endpoint) and the full bundle of cluster CAs, including CAs which are introduced
+// during an ongoing CA rotation.
+type KubeconfigBundleRequest struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object metadata.
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	// Spec is the specification of the KubeconfigBundleRequest.
+	Spec KubeconfigBundleRequestSpec `json:"spec" protobuf:"bytes,2,opt,name=spec"`
+	// Status is the status of the KubeconfigBundleRequest.
+	Status KubeconfigBundleRequestStatus `json:"status" protobuf:"bytes,3,opt,name=status"`
+}
+
+// KubeconfigBundleRequestStatus is the status of the KubeconfigBundleRequest containing
+// the kubeconfig and expiration of the credentials.
+type KubeconfigBundleRequestStatus struct {
+	// Kubeconfig contains the kubeconfig with admin and viewer contexts for the shoot cluster.
+	Kubeconfig []byte `json:"kubeconfig" protobuf:"bytes,1,opt,name=kubeconfig"`
+	// ExpirationTimestamp is the expiration timestamp of the earliest expiring credential in the returned kubeconfig.
+	ExpirationTimestamp metav1.Time `json:"expirationTimestamp" protobuf:"bytes,2,opt,name=expirationTimestamp"`
+}
+
+// KubeconfigBundleRequestSpec contains the expiration time of the kubeconfig.
+type KubeconfigBundleRequestSpec struct {
+	// ExpirationSeconds is the requested validity duration of the credentials. The
+	// credential issuer may return credentials with a different validity duration so a
+	// client needs to check the 'expirationTimestamp' field in a response.
+	// Defaults to 1 hour.
+	// +optional
+	ExpirationSeconds *int64 `json:"expirationSeconds,omitempty" protobuf:"varint,1,opt,name=expirationSeconds"`
+}
@@ -0,0 +1,45 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// TokenExchangeRequest can be used to exchange a token issued by an external OIDC identity provider, which is trusted
+// by the project of the Shoot, for a short-lived kubeconfig for a Shoot cluster.
+type TokenExchangeRequest struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object metadata.
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+
+	// Spec is the specification of the TokenExchangeRequest.
+	Spec TokenExchangeRequestSpec `json:"spec" protobuf:"bytes,2,opt,name=spec"`
+	// Status is the status of the TokenExchangeRequest.
+	Status TokenExchangeRequestStatus `json:"status" protobuf:"bytes,3,opt,name=status"`
+}
+
+// TokenExchangeRequestSpec contains the token to exchange and the expiration time of the kubeconfig.
+type TokenExchangeRequestSpec struct {
+	// Token is the token issued by a trusted identity provider of the Shoot's project.
+	Token string `json:"token" protobuf:"bytes,1,opt,name=token"`
+	// ExpirationSeconds is the requested validity duration of the credential. The
+	// credential issuer may return a credential with a different validity duration so a
+	// client needs to check the 'expirationTimestamp' field in a response.
+	// Defaults to 1 hour.
+	// +optional
+	ExpirationSeconds *int64 `json:"expirationSeconds,omitempty" protobuf:"varint,2,opt,name=expirationSeconds"`
+}
+
+// TokenExchangeRequestStatus is the status of the TokenExchangeRequest containing
+// the kubeconfig and expiration of the credential.
+type TokenExchangeRequestStatus struct {
+	// Kubeconfig contains the kubeconfig for the shoot cluster.
+	Kubeconfig []byte `json:"kubeconfig" protobuf:"bytes,1,opt,name=kubeconfig"`
+	// ExpirationTimestamp is the expiration timestamp of the returned credential.
+	ExpirationTimestamp metav1.Time `json:"expirationTimestamp" protobuf:"bytes,2,opt,name=expirationTimestamp"`
+}
@@ -27,6 +27,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*authentication.KubeconfigRequest)(nil), (*KubeconfigBundleRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_authentication_KubeconfigRequest_To_v1alpha1_KubeconfigBundleRequest(a.(*authentication.KubeconfigRequest), b.(*KubeconfigBundleRequest), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*authentication.KubeconfigRequest)(nil), (*TokenExchangeRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_authentication_KubeconfigRequest_To_v1alpha1_TokenExchangeRequest(a.(*authentication.KubeconfigRequest), b.(*TokenExchangeRequest), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*authentication.KubeconfigRequest)(nil), (*ViewerKubeconfigRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_authentication_KubeconfigRequest_To_v1alpha1_ViewerKubeconfigRequest(a.(*authentication.KubeconfigRequest), b.(*ViewerKubeconfigRequest), scope)
 	}); err != nil {
@@ -37,6 +47,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddConversionFunc((*KubeconfigBundleRequest)(nil), (*authentication.KubeconfigRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_KubeconfigBundleRequest_To_authentication_KubeconfigRequest(a.(*KubeconfigBundleRequest), b.(*authentication.KubeconfigRequest), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddConversionFunc((*TokenExchangeRequest)(nil), (*authentication.KubeconfigRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TokenExchangeRequest_To_authentication_KubeconfigRequest(a.(*TokenExchangeRequest), b.(*authentication.KubeconfigRequest), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*ViewerKubeconfigRequest)(nil), (*authentication.KubeconfigRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ViewerKubeconfigRequest_To_authentication_KubeconfigRequest(a.(*ViewerKubeconfigRequest), b.(*authentication.KubeconfigRequest), scope)
 	}); err != nil {
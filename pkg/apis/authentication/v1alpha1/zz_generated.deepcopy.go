@@ -84,6 +84,148 @@ func (in *AdminKubeconfigRequestStatus) DeepCopy() *AdminKubeconfigRequestStatus
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeconfigBundleRequest) DeepCopyInto(out *KubeconfigBundleRequest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeconfigBundleRequest.
+func (in *KubeconfigBundleRequest) DeepCopy() *KubeconfigBundleRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeconfigBundleRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KubeconfigBundleRequest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeconfigBundleRequestSpec) DeepCopyInto(out *KubeconfigBundleRequestSpec) {
+	*out = *in
+	if in.ExpirationSeconds != nil {
+		in, out := &in.ExpirationSeconds, &out.ExpirationSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeconfigBundleRequestSpec.
+func (in *KubeconfigBundleRequestSpec) DeepCopy() *KubeconfigBundleRequestSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeconfigBundleRequestSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeconfigBundleRequestStatus) DeepCopyInto(out *KubeconfigBundleRequestStatus) {
+	*out = *in
+	if in.Kubeconfig != nil {
+		in, out := &in.Kubeconfig, &out.Kubeconfig
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	in.ExpirationTimestamp.DeepCopyInto(&out.ExpirationTimestamp)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeconfigBundleRequestStatus.
+func (in *KubeconfigBundleRequestStatus) DeepCopy() *KubeconfigBundleRequestStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeconfigBundleRequestStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TokenExchangeRequest) DeepCopyInto(out *TokenExchangeRequest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TokenExchangeRequest.
+func (in *TokenExchangeRequest) DeepCopy() *TokenExchangeRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(TokenExchangeRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TokenExchangeRequest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TokenExchangeRequestSpec) DeepCopyInto(out *TokenExchangeRequestSpec) {
+	*out = *in
+	if in.ExpirationSeconds != nil {
+		in, out := &in.ExpirationSeconds, &out.ExpirationSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TokenExchangeRequestSpec.
+func (in *TokenExchangeRequestSpec) DeepCopy() *TokenExchangeRequestSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TokenExchangeRequestSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TokenExchangeRequestStatus) DeepCopyInto(out *TokenExchangeRequestStatus) {
+	*out = *in
+	if in.Kubeconfig != nil {
+		in, out := &in.Kubeconfig, &out.Kubeconfig
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	in.ExpirationTimestamp.DeepCopyInto(&out.ExpirationTimestamp)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TokenExchangeRequestStatus.
+func (in *TokenExchangeRequestStatus) DeepCopy() *TokenExchangeRequestStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TokenExchangeRequestStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ViewerKubeconfigRequest) DeepCopyInto(out *ViewerKubeconfigRequest) {
 	*out = *in
@@ -18,6 +18,8 @@ import (
 // All generated defaulters are covering - they call all nested defaulters.
 func RegisterDefaults(scheme *runtime.Scheme) error {
 	scheme.AddTypeDefaultingFunc(&AdminKubeconfigRequest{}, func(obj interface{}) { SetObjectDefaults_AdminKubeconfigRequest(obj.(*AdminKubeconfigRequest)) })
+	scheme.AddTypeDefaultingFunc(&KubeconfigBundleRequest{}, func(obj interface{}) { SetObjectDefaults_KubeconfigBundleRequest(obj.(*KubeconfigBundleRequest)) })
+	scheme.AddTypeDefaultingFunc(&TokenExchangeRequest{}, func(obj interface{}) { SetObjectDefaults_TokenExchangeRequest(obj.(*TokenExchangeRequest)) })
 	scheme.AddTypeDefaultingFunc(&ViewerKubeconfigRequest{}, func(obj interface{}) { SetObjectDefaults_ViewerKubeconfigRequest(obj.(*ViewerKubeconfigRequest)) })
 	return nil
 }
@@ -26,6 +28,14 @@ func SetObjectDefaults_AdminKubeconfigRequest(in *AdminKubeconfigRequest) {
 	SetDefaults_AdminKubeconfigRequestSpec(&in.Spec)
 }
 
+func SetObjectDefaults_KubeconfigBundleRequest(in *KubeconfigBundleRequest) {
+	SetDefaults_KubeconfigBundleRequestSpec(&in.Spec)
+}
+
+func SetObjectDefaults_TokenExchangeRequest(in *TokenExchangeRequest) {
+	SetDefaults_TokenExchangeRequestSpec(&in.Spec)
+}
+
 func SetObjectDefaults_ViewerKubeconfigRequest(in *ViewerKubeconfigRequest) {
 	SetDefaults_ViewerKubeconfigRequestSpec(&in.Spec)
 }
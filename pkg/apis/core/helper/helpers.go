@@ -7,6 +7,7 @@ package helper
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -21,6 +22,7 @@ import (
 	"github.com/gardener/gardener/pkg/apis/core"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	"github.com/gardener/gardener/pkg/utils"
 )
 
@@ -158,14 +160,34 @@ func TaintsHave(taints []core.SeedTaint, key string) bool {
 	return false
 }
 
-// TaintsAreTolerated returns true when all the given taints are tolerated by the given tolerations.
+// TaintsAreTolerated returns true when all the given taints are tolerated by the given tolerations. Taints with the
+// PreferNoSchedule effect are ignored since they do not prevent using the seed.
 func TaintsAreTolerated(taints []core.SeedTaint, tolerations []core.Toleration) bool {
+	return taintsAreTolerated(taints, tolerations, func(effect core.SeedTaintEffect) bool {
+		return effect != core.SeedTaintEffectPreferNoSchedule
+	})
+}
+
+// PreferNoScheduleTaintsAreTolerated returns true when all the given taints with the PreferNoSchedule effect are
+// tolerated by the given tolerations.
+func PreferNoScheduleTaintsAreTolerated(taints []core.SeedTaint, tolerations []core.Toleration) bool {
+	return taintsAreTolerated(taints, tolerations, func(effect core.SeedTaintEffect) bool {
+		return effect == core.SeedTaintEffectPreferNoSchedule
+	})
+}
+
+// TaintEffect returns the effect of the given taint. Taints without an explicit effect have the NoSchedule effect.
+func TaintEffect(taint core.SeedTaint) core.SeedTaintEffect {
+	if taint.Effect == nil {
+		return core.SeedTaintEffectNoSchedule
+	}
+	return *taint.Effect
+}
+
+func taintsAreTolerated(taints []core.SeedTaint, tolerations []core.Toleration, considerEffect func(core.SeedTaintEffect) bool) bool {
 	if len(taints) == 0 {
 		return true
 	}
-	if len(taints) > len(tolerations) {
-		return false
-	}
 
 	tolerationKeyValues := make(map[string]string, len(tolerations))
 	for _, toleration := range tolerations {
@@ -177,6 +199,10 @@ func TaintsAreTolerated(taints []core.SeedTaint, tolerations []core.Toleration)
 	}
 
 	for _, taint := range taints {
+		if !considerEffect(TaintEffect(taint)) {
+			continue
+		}
+
 		tolerationValue, ok := tolerationKeyValues[taint.Key]
 		if !ok {
 			return false
@@ -212,6 +238,26 @@ func AccessRestrictionsAreSupported(seedAccessRestrictions []core.AccessRestrict
 	return true
 }
 
+// DataResidencyIsSatisfied returns true when the region with the given metadata satisfies the given data residency
+// constraints. Regions without metadata do not satisfy any constraint.
+func DataResidencyIsSatisfied(regionMetadata *core.RegionMetadata, dataResidency *core.DataResidency) bool {
+	if dataResidency == nil {
+		return true
+	}
+
+	if len(dataResidency.Jurisdictions) > 0 &&
+		(regionMetadata == nil || regionMetadata.Jurisdiction == nil || !slices.Contains(dataResidency.Jurisdictions, *regionMetadata.Jurisdiction)) {
+		return false
+	}
+
+	if len(dataResidency.Geographies) > 0 &&
+		(regionMetadata == nil || regionMetadata.Geography == nil || !slices.Contains(dataResidency.Geographies, *regionMetadata.Geography)) {
+		return false
+	}
+
+	return true
+}
+
 // SeedSettingSchedulingVisible returns true if the 'scheduling' setting is set to 'visible'.
 func SeedSettingSchedulingVisible(settings *core.SeedSettings) bool {
 	return settings == nil || settings.Scheduling == nil || settings.Scheduling.Visible
@@ -421,6 +467,15 @@ func GetShootAuthenticationConfigurationConfigMapName(apiServerConfig *core.Kube
 	return ""
 }
 
+// GetShootAPIPriorityAndFairnessConfigMapName returns the Shoot's ConfigMap reference name for the custom API Priority
+// and Fairness configurations.
+func GetShootAPIPriorityAndFairnessConfigMapName(apiServerConfig *core.KubeAPIServerConfig) string {
+	if apiServerConfig != nil && apiServerConfig.APIPriorityAndFairness != nil {
+		return ptr.Deref(apiServerConfig.APIPriorityAndFairness.ConfigMapName, "")
+	}
+	return ""
+}
+
 // GetShootAuthorizationConfigurationConfigMapName returns the Shoot's ConfigMap reference name for the authorization
 // configuration.
 func GetShootAuthorizationConfigurationConfigMapName(apiServerConfig *core.KubeAPIServerConfig) string {
@@ -498,6 +553,14 @@ func GetShootCARotationPhase(credentials *core.ShootCredentials) core.Credential
 	return ""
 }
 
+// GetSeedCARotationPhase returns the specified seed CA rotation phase or an empty string.
+func GetSeedCARotationPhase(credentials *core.SeedCredentials) core.CredentialsRotationPhase {
+	if credentials != nil && credentials.Rotation != nil && credentials.Rotation.CertificateAuthorities != nil {
+		return credentials.Rotation.CertificateAuthorities.Phase
+	}
+	return ""
+}
+
 // GetShootServiceAccountKeyRotationPhase returns the specified shoot service account key rotation phase or an empty
 // string.
 func GetShootServiceAccountKeyRotationPhase(credentials *core.ShootCredentials) core.CredentialsRotationPhase {
@@ -628,3 +691,24 @@ func DeterminePrimaryIPFamily(ipFamilies []core.IPFamily) core.IPFamily {
 func HasManagedIssuer(shoot *core.Shoot) bool {
 	return shoot.GetAnnotations()[v1beta1constants.AnnotationAuthenticationIssuer] == v1beta1constants.AnnotationAuthenticationIssuerManaged
 }
+
+// GetShootSizeClass returns the size class of the given shoot. If it was not computed yet, it is derived from the
+// maximum number of nodes of all worker pools.
+func GetShootSizeClass(shoot *core.Shoot) core.ShootSizeClass {
+	if shoot.Status.SizeClass != nil {
+		return *shoot.Status.SizeClass
+	}
+
+	var maxNodes int
+	for _, worker := range shoot.Spec.Provider.Workers {
+		maxNodes += int(worker.Maximum)
+	}
+
+	return core.ShootSizeClass(v1beta1helper.ComputeShootSizeClass(maxNodes, 0))
+}
+
+// ShootSizeClassWeight returns the relative weight of the given size class, i.e., each class weighs twice as much as the
+// next smaller class.
+func ShootSizeClassWeight(sizeClass core.ShootSizeClass) int64 {
+	return v1beta1helper.ShootSizeClassWeight(gardencorev1beta1.ShootSizeClass(sizeClass))
+}
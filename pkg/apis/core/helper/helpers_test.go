@@ -81,6 +81,18 @@ var _ = Describe("helper", func() {
 		Entry("phase set", &core.ShootCredentials{Rotation: &core.ShootCredentialsRotation{CertificateAuthorities: &core.CARotation{Phase: core.RotationCompleting}}}, core.RotationCompleting),
 	)
 
+	DescribeTable("#GetSeedCARotationPhase",
+		func(credentials *core.SeedCredentials, expectedPhase core.CredentialsRotationPhase) {
+			Expect(GetSeedCARotationPhase(credentials)).To(Equal(expectedPhase))
+		},
+
+		Entry("credentials nil", nil, core.CredentialsRotationPhase("")),
+		Entry("rotation nil", &core.SeedCredentials{}, core.CredentialsRotationPhase("")),
+		Entry("ca nil", &core.SeedCredentials{Rotation: &core.SeedCredentialsRotation{}}, core.CredentialsRotationPhase("")),
+		Entry("phase empty", &core.SeedCredentials{Rotation: &core.SeedCredentialsRotation{CertificateAuthorities: &core.CARotation{}}}, core.CredentialsRotationPhase("")),
+		Entry("phase set", &core.SeedCredentials{Rotation: &core.SeedCredentialsRotation{CertificateAuthorities: &core.CARotation{Phase: core.RotationCompleting}}}, core.RotationCompleting),
+	)
+
 	DescribeTable("#GetShootServiceAccountKeyRotationPhase",
 		func(credentials *core.ShootCredentials, expectedPhase core.CredentialsRotationPhase) {
 			Expect(GetShootServiceAccountKeyRotationPhase(credentials)).To(Equal(expectedPhase))
@@ -215,6 +227,62 @@ var _ = Describe("helper", func() {
 			},
 			true,
 		),
+		Entry("taints with PreferNoSchedule effect are ignored",
+			[]core.SeedTaint{
+				{Key: "foo", Effect: ptr.To(core.SeedTaintEffectPreferNoSchedule)},
+			},
+			nil,
+			true,
+		),
+		Entry("taints with NoExecute effect (non-tolerated)",
+			[]core.SeedTaint{
+				{Key: "foo", Effect: ptr.To(core.SeedTaintEffectNoExecute)},
+			},
+			[]core.Toleration{{Key: "bar"}},
+			false,
+		),
+		Entry("taints with NoExecute effect (tolerated)",
+			[]core.SeedTaint{
+				{Key: "foo", Effect: ptr.To(core.SeedTaintEffectNoExecute)},
+				{Key: "bar", Effect: ptr.To(core.SeedTaintEffectPreferNoSchedule)},
+			},
+			[]core.Toleration{{Key: "foo"}},
+			true,
+		),
+	)
+
+	DescribeTable("#PreferNoScheduleTaintsAreTolerated",
+		func(taints []core.SeedTaint, tolerations []core.Toleration, expectation bool) {
+			Expect(PreferNoScheduleTaintsAreTolerated(taints, tolerations)).To(Equal(expectation))
+		},
+
+		Entry("no taints",
+			nil,
+			nil,
+			true,
+		),
+		Entry("taints without PreferNoSchedule effect are ignored",
+			[]core.SeedTaint{
+				{Key: "foo"},
+				{Key: "bar", Effect: ptr.To(core.SeedTaintEffectNoExecute)},
+			},
+			nil,
+			true,
+		),
+		Entry("taints with PreferNoSchedule effect (non-tolerated)",
+			[]core.SeedTaint{
+				{Key: "foo", Value: ptr.To("bar"), Effect: ptr.To(core.SeedTaintEffectPreferNoSchedule)},
+			},
+			[]core.Toleration{{Key: "foo", Value: ptr.To("baz")}},
+			false,
+		),
+		Entry("taints with PreferNoSchedule effect (tolerated)",
+			[]core.SeedTaint{
+				{Key: "foo", Value: ptr.To("bar"), Effect: ptr.To(core.SeedTaintEffectPreferNoSchedule)},
+			},
+			[]core.Toleration{{Key: "foo", Value: ptr.To("bar")}},
+			true,
+		),
 	)
 
 	DescribeTable("#AccessRestrictionsAreSupported",
@@ -249,6 +317,21 @@ var _ = Describe("helper", func() {
 		),
 	)
 
+	DescribeTable("#DataResidencyIsSatisfied",
+		func(regionMetadata *core.RegionMetadata, dataResidency *core.DataResidency, expectation bool) {
+			Expect(DataResidencyIsSatisfied(regionMetadata, dataResidency)).To(Equal(expectation))
+		},
+
+		Entry("no data residency constraints", nil, nil, true),
+		Entry("empty data residency constraints", nil, &core.DataResidency{}, true),
+		Entry("region without metadata", nil, &core.DataResidency{Jurisdictions: []string{"EU"}}, false),
+		Entry("region without jurisdiction", &core.RegionMetadata{Geography: ptr.To("europe")}, &core.DataResidency{Jurisdictions: []string{"EU"}}, false),
+		Entry("matching jurisdiction", &core.RegionMetadata{Jurisdiction: ptr.To("EU")}, &core.DataResidency{Jurisdictions: []string{"CH", "EU"}}, true),
+		Entry("non-matching jurisdiction", &core.RegionMetadata{Jurisdiction: ptr.To("US")}, &core.DataResidency{Jurisdictions: []string{"EU"}}, false),
+		Entry("matching jurisdiction but non-matching geography", &core.RegionMetadata{Jurisdiction: ptr.To("EU"), Geography: ptr.To("europe")}, &core.DataResidency{Jurisdictions: []string{"EU"}, Geographies: []string{"asia"}}, false),
+		Entry("matching jurisdiction and geography", &core.RegionMetadata{Jurisdiction: ptr.To("EU"), Geography: ptr.To("europe")}, &core.DataResidency{Jurisdictions: []string{"EU"}, Geographies: []string{"europe"}}, true),
+	)
+
 	var (
 		unmanagedType = core.DNSUnmanaged
 		differentType = "foo"
@@ -533,6 +616,21 @@ var _ = Describe("helper", func() {
 		}, "foo"),
 	)
 
+	DescribeTable("#GetShootAPIPriorityAndFairnessConfigMapName",
+		func(kubeAPIServerConfig *core.KubeAPIServerConfig, expectedName string) {
+			Expect(GetShootAPIPriorityAndFairnessConfigMapName(kubeAPIServerConfig)).To(Equal(expectedName))
+		},
+
+		Entry("KubeAPIServerConfig = nil", nil, ""),
+		Entry("APIPriorityAndFairness = nil", &core.KubeAPIServerConfig{}, ""),
+		Entry("ConfigMapName not set", &core.KubeAPIServerConfig{
+			APIPriorityAndFairness: &core.APIPriorityAndFairness{},
+		}, ""),
+		Entry("ConfigMapName set", &core.KubeAPIServerConfig{
+			APIPriorityAndFairness: &core.APIPriorityAndFairness{ConfigMapName: ptr.To("foo")},
+		}, "foo"),
+	)
+
 	DescribeTable("#GetShootAuthorizationConfigurationConfigMapName",
 		func(kubeAPIServerConfig *core.KubeAPIServerConfig, expectedName string) {
 			authConfigName := GetShootAuthorizationConfigurationConfigMapName(kubeAPIServerConfig)
@@ -1234,4 +1332,22 @@ var _ = Describe("helper", func() {
 			Expect(addedVersions).To(BeEmpty())
 		})
 	})
+
+	Describe("#GetShootSizeClass", func() {
+		It("should return the size class from the status", func() {
+			shoot := &core.Shoot{Status: core.ShootStatus{SizeClass: ptr.To(core.ShootSizeClassMedium)}}
+			Expect(GetShootSizeClass(shoot)).To(Equal(core.ShootSizeClassMedium))
+		})
+
+		It("should derive the size class from the maximum number of nodes", func() {
+			shoot := &core.Shoot{Spec: core.ShootSpec{Provider: core.Provider{Workers: []core.Worker{{Maximum: 300}}}}}
+			Expect(GetShootSizeClass(shoot)).To(Equal(core.ShootSizeClassExtraLarge))
+		})
+	})
+
+	Describe("#ShootSizeClassWeight", func() {
+		It("should return the weight of the size class", func() {
+			Expect(ShootSizeClassWeight(core.ShootSizeClassLarge)).To(Equal(int64(4)))
+		})
+	})
 })
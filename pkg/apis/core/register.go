@@ -49,6 +49,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&ControllerInstallationList{},
 		&ExposureClass{},
 		&ExposureClassList{},
+		&FeatureMatrix{},
+		&FeatureMatrixList{},
 		&InternalSecret{},
 		&InternalSecretList{},
 		&NamespacedCloudProfile{},
@@ -61,6 +63,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&SecretBindingList{},
 		&Seed{},
 		&SeedList{},
+		&ShootClass{},
+		&ShootClassList{},
 		&ShootState{},
 		&ShootStateList{},
 		&Shoot{},
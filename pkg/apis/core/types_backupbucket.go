@@ -47,6 +47,9 @@ type BackupBucketSpec struct {
 	// SeedName holds the name of the seed allocated to BackupBucket for running controller.
 	// This field is immutable.
 	SeedName *string
+	// Immutability contains the immutability (object lock) configuration of the bucket. If set, objects stored in the
+	// bucket are protected against deletion and overwrites for the configured retention period.
+	Immutability *BackupBucketImmutability
 }
 
 // BackupBucketStatus holds the most recently observed status of the Backup Bucket.
@@ -72,3 +75,24 @@ type BackupBucketProvider struct {
 	// Region is the region of the bucket.
 	Region string
 }
+
+// BackupBucketImmutability contains the immutability (object lock) configuration of a backup bucket.
+type BackupBucketImmutability struct {
+	// RetentionMode is the retention mode of the objects in the bucket.
+	RetentionMode BackupBucketRetentionMode
+	// RetentionPeriod is the duration for which objects in the bucket are protected against deletion and overwrites
+	// after they have been written.
+	RetentionPeriod metav1.Duration
+}
+
+// BackupBucketRetentionMode is a type alias for string.
+type BackupBucketRetentionMode string
+
+const (
+	// BackupBucketRetentionModeGovernance is a constant for the governance retention mode. Protected objects can still
+	// be deleted and the retention settings can still be relaxed by principals with special permissions.
+	BackupBucketRetentionModeGovernance BackupBucketRetentionMode = "Governance"
+	// BackupBucketRetentionModeCompliance is a constant for the compliance retention mode. Protected objects cannot be
+	// deleted by anyone before their retention period has expired and the retention settings can only be tightened.
+	BackupBucketRetentionModeCompliance BackupBucketRetentionMode = "Compliance"
+)
@@ -21,6 +21,8 @@ type CloudProfile struct {
 	metav1.ObjectMeta
 	// Spec defines the provider environment properties.
 	Spec CloudProfileSpec
+	// Status contains the current status of the cloud profile.
+	Status CloudProfileStatus
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -34,6 +36,41 @@ type CloudProfileList struct {
 	Items []CloudProfile
 }
 
+// CloudProfileStatus contains the status of the cloud profile.
+type CloudProfileStatus struct {
+	// Usage contains information about the Kubernetes and machine image versions which are used by Shoots referencing
+	// the CloudProfile, either directly or via a NamespacedCloudProfile. It is maintained by gardener-controller-manager.
+	Usage *CloudProfileUsage
+}
+
+// CloudProfileUsage contains information about the versions of a CloudProfile which are in use.
+type CloudProfileUsage struct {
+	// KubernetesVersions is the list of Kubernetes versions which are in use.
+	KubernetesVersions []VersionUsage
+	// MachineImageVersions is the list of machine image versions which are in use.
+	MachineImageVersions []MachineImageVersionUsage
+	// LastUpdateTime is the time when the usage was computed the last time.
+	LastUpdateTime *metav1.Time
+}
+
+// VersionUsage contains information about the usage of a version.
+type VersionUsage struct {
+	// Version is the version.
+	Version string
+	// Shoots is the number of Shoots using the version.
+	Shoots int32
+}
+
+// MachineImageVersionUsage contains information about the usage of a machine image version.
+type MachineImageVersionUsage struct {
+	// Name is the name of the machine image.
+	Name string
+	// Version is the version of the machine image.
+	Version string
+	// Shoots is the number of Shoots using the machine image version in at least one of their worker pools.
+	Shoots int32
+}
+
 // CloudProfileSpec is the specification of a CloudProfile.
 // It must contain exactly one of its defined keys.
 type CloudProfileSpec struct {
@@ -61,6 +98,9 @@ type CloudProfileSpec struct {
 	VolumeTypes []VolumeType
 	// Bastion contains machine and image properties
 	Bastion *Bastion
+	// Addons is the catalog of managed addons which can be deployed into shoot clusters using this profile via
+	// `.spec.addons.managed` in the Shoot specification.
+	Addons []AddonDefinition
 }
 
 // SeedSelector contains constraints for selecting seed to be usable for shoots using a profile
@@ -77,6 +117,10 @@ type SeedSelector struct {
 type KubernetesSettings struct {
 	// Versions is the list of allowed Kubernetes versions with optional expiration dates for Shoot clusters.
 	Versions []ExpirableVersion
+	// MaxExpirationExtension is the maximum duration by which NamespacedCloudProfiles may extend the expiration date of
+	// a Kubernetes version beyond the expiration date defined in the CloudProfile. If not set, the expiration dates may
+	// be extended without limit. It must not be set in NamespacedCloudProfiles.
+	MaxExpirationExtension *metav1.Duration
 }
 
 // MachineImage defines the name and multiple versions of the machine image in any environment.
@@ -133,6 +177,23 @@ type MachineType struct {
 	Usable *bool
 	// Architecture is the CPU architecture of this machine type.
 	Architecture *string
+	// CapacityTypes is the list of capacity types supported by this machine type. If empty, only on-demand capacity is
+	// supported.
+	CapacityTypes []CapacityType
+	// Regions restricts the availability of this machine type to the listed regions. If empty, the machine type is
+	// available in all regions of the cloud profile.
+	Regions []TypeRegionAvailability
+}
+
+// TypeRegionAvailability describes the availability of a machine or volume type in a region.
+type TypeRegionAvailability struct {
+	// Name is the name of the region.
+	Name string
+	// Zones is the list of zones of the region in which the type is available. If empty, the type is available in all
+	// zones of the region.
+	Zones []string
+	// PricingClass is an optional classification of the price of the type in this region, e.g. `standard` or `premium`.
+	PricingClass *string
 }
 
 // MachineTypeStorage is the amount of storage associated with the root volume of this machine type.
@@ -160,6 +221,19 @@ type Region struct {
 	Labels map[string]string
 	// AccessRestrictions describe a list of access restrictions that can be used for Shoots using this region.
 	AccessRestrictions []AccessRestriction
+	// Metadata contains structured information about the region, e.g. wrt data residency.
+	Metadata *RegionMetadata
+}
+
+// RegionMetadata contains structured information about a region.
+type RegionMetadata struct {
+	// Geography is the geographical area the region is located in, e.g. `europe` or `north-america`.
+	Geography *string
+	// Jurisdiction is the legal jurisdiction which applies to data stored in the region, e.g. `EU` or `US`.
+	Jurisdiction *string
+	// LatencyMatrixKey is the key of the region in the region ConfigMap of the gardener-scheduler which contains the
+	// latencies between regions (used by the `MinimalDistance` strategy). Defaults to the name of the region.
+	LatencyMatrixKey *string
 }
 
 // AvailabilityZone is an availability zone.
@@ -182,6 +256,15 @@ type VolumeType struct {
 	Usable *bool
 	// MinSize is the minimal supported storage size.
 	MinSize *resource.Quantity
+	// MaxIOPS is the maximal number of provisioned I/O operations per second supported by this volume type.
+	// If not set, volumes of this type do not support configuring IOPS.
+	MaxIOPS *int64
+	// MaxThroughput is the maximal provisioned throughput in MiB/s supported by this volume type.
+	// If not set, volumes of this type do not support configuring throughput.
+	MaxThroughput *int64
+	// Regions restricts the availability of this volume type to the listed regions. If empty, the volume type is
+	// available in all regions of the cloud profile.
+	Regions []TypeRegionAvailability
 }
 
 // Bastion contains the bastions creation info
@@ -213,6 +296,27 @@ const (
 	VolumeClassPremium string = "premium"
 )
 
+// AddonDefinition declares a managed addon which can be deployed into shoot clusters.
+type AddonDefinition struct {
+	// Name is the name of the addon.
+	Name string
+	// Versions contains the available versions of the addon.
+	Versions []AddonVersion
+	// WorkerlessSupported specifies whether the addon can be enabled for workerless Shoot clusters, i.e., whether it
+	// does not require any nodes.
+	WorkerlessSupported *bool
+}
+
+// AddonVersion is a version of a managed addon.
+type AddonVersion struct {
+	ExpirableVersion
+	// Chart is the reference to the Helm chart of the addon in an OCI repository.
+	Chart OCIRepository
+	// ValuesSchema is an OpenAPI v3 schema which the values provided for the addon in the Shoot specification are
+	// validated against. If not set, no values are allowed for this version.
+	ValuesSchema *runtime.RawExtension
+}
+
 // VersionClassification is the logical state of a version.
 type VersionClassification string
 
@@ -23,6 +23,12 @@ type ExposureClass struct {
 	// Scheduling holds information how to select applicable Seed's for ExposureClass usage.
 	// This field is immutable.
 	Scheduling *ExposureClassScheduling
+	// LoadBalancerService contains optional configuration for the load balancer service of the istio ingress gateway
+	// of the handler which exposes the kube-apiservers of shoots using this ExposureClass.
+	LoadBalancerService *ExposureClassLoadBalancerService
+	// IngressGateway contains optional sizing configuration for the istio ingress gateway of the handler which exposes
+	// the kube-apiservers of shoots using this ExposureClass.
+	IngressGateway *ExposureClassIngressGateway
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -43,3 +49,21 @@ type ExposureClassScheduling struct {
 	// Tolerations contains the tolerations for taints on Seed clusters.
 	Tolerations []Toleration
 }
+
+// ExposureClassLoadBalancerService contains configuration for the load balancer service of an istio ingress gateway.
+type ExposureClassLoadBalancerService struct {
+	// Annotations is a key value map to annotate the load balancer service. They take precedence over the annotations
+	// configured for the handler in the gardenlet configuration and for the load balancer services of the Seed.
+	Annotations map[string]string
+	// SourceRanges is a list of CIDRs which are allowed to access the load balancer. If not specified, access is not
+	// restricted.
+	SourceRanges []string
+}
+
+// ExposureClassIngressGateway contains sizing configuration for an istio ingress gateway.
+type ExposureClassIngressGateway struct {
+	// MinReplicas is the minimum number of replicas of the istio ingress gateway.
+	MinReplicas *int32
+	// MaxReplicas is the maximum number of replicas of the istio ingress gateway.
+	MaxReplicas *int32
+}
@@ -0,0 +1,66 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package core
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// FeatureMatrix lists the feature gates and the deprecated API fields of the Gardener version serving it. It is
+// computed by gardener-apiserver on request and cannot be created or modified.
+type FeatureMatrix struct {
+	metav1.TypeMeta
+	// Standard object metadata.
+	metav1.ObjectMeta
+	// GardenerVersion is the version of the gardener-apiserver serving the FeatureMatrix.
+	GardenerVersion string
+	// FeatureGates is the list of all feature gates of the Gardener version.
+	FeatureGates []FeatureGate
+	// DeprecatedFields is the list of deprecated API fields of the Gardener version.
+	DeprecatedFields []DeprecatedField
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// FeatureMatrixList is a collection of FeatureMatrices.
+type FeatureMatrixList struct {
+	metav1.TypeMeta
+	// Standard list object metadata.
+	metav1.ListMeta
+	// Items is the list of FeatureMatrices.
+	Items []FeatureMatrix
+}
+
+// FeatureGate contains information about a feature gate.
+type FeatureGate struct {
+	// Name is the name of the feature gate.
+	Name string
+	// Default is the default value of the feature gate.
+	Default bool
+	// PreRelease is the maturity level of the feature gate, e.g. ALPHA, BETA or GA.
+	PreRelease string
+	// LockToDefault indicates that the feature gate cannot be set to a value other than its default.
+	LockToDefault bool
+	// Enabled indicates whether the feature gate is enabled in gardener-apiserver. It is only set for feature gates
+	// which are relevant for gardener-apiserver, other components might be configured differently.
+	Enabled *bool
+}
+
+// DeprecatedField contains information about a deprecated API field.
+type DeprecatedField struct {
+	// APIVersion is the API version of the resource containing the field.
+	APIVersion string
+	// Kind is the kind of the resource containing the field.
+	Kind string
+	// Path is the path of the field in the resource, e.g. spec.kubernetes.kubeAPIServer.oidcConfig.
+	Path string
+	// Description explains the deprecation and names the replacement of the field, if any.
+	Description string
+	// ForbiddenFromKubernetesVersion is the Kubernetes version starting from which the field is forbidden. It is only
+	// set if the field is forbidden depending on the Kubernetes version of the resource.
+	ForbiddenFromKubernetesVersion *string
+}
@@ -5,6 +5,7 @@
 package core
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -58,6 +59,15 @@ type ProjectSpec struct {
 	Tolerations *ProjectTolerations
 	// DualApprovalForDeletion contains configuration for the dual approval concept for resource deletion.
 	DualApprovalForDeletion []DualApprovalForDeletion
+	// TrustedIdentityProviders contains a list of external OIDC identity providers whose tokens can be exchanged for
+	// short-lived credentials for the shoot clusters of this project.
+	TrustedIdentityProviders []TrustedIdentityProvider
+	// Quotas is a list of references to Quota objects which are enforced for all shoots of this project, independent
+	// of the credentials they use.
+	Quotas []corev1.ObjectReference
+	// MemberSync contains the configuration for synchronizing the project members from groups of an external identity
+	// provider.
+	MemberSync *ProjectMemberSync
 }
 
 // ProjectStatus holds the most recently observed status of the project.
@@ -84,6 +94,23 @@ type ProjectMember struct {
 	Roles []string
 }
 
+// ProjectMemberSync contains the configuration for synchronizing the project members from groups of an external
+// identity provider.
+type ProjectMemberSync struct {
+	// GroupPrefix is the prefix which is prepended to the group names when they are added as members of the project.
+	GroupPrefix *string
+	// Groups is a list of mappings from groups of the identity provider to roles in the project.
+	Groups []ProjectMemberSyncGroup
+}
+
+// ProjectMemberSyncGroup maps a group of an external identity provider to roles in the project.
+type ProjectMemberSyncGroup struct {
+	// Name is the name of the group as presented in the groups claim of the identity provider.
+	Name string
+	// Roles is the list of project roles which are granted to the members of the group.
+	Roles []string
+}
+
 // ProjectTolerations contains the tolerations for taints on seed clusters.
 type ProjectTolerations struct {
 	// Defaults contains a list of tolerations that are added to the shoots in this project by default.
@@ -101,6 +128,32 @@ type Toleration struct {
 	Value *string
 }
 
+// TrustedIdentityProvider contains the configuration of an external OIDC identity provider (e.g., a CI system) whose
+// tokens are federated into short-lived shoot credentials.
+type TrustedIdentityProvider struct {
+	// Name is the name of the identity provider.
+	Name string
+	// Issuer is the URL of the OIDC issuer. It must use the https scheme.
+	Issuer string
+	// Audiences is the list of acceptable audiences. The 'aud' claim of presented tokens must contain at least one of them.
+	Audiences []string
+	// Subjects is the list of acceptable values for the 'sub' claim of presented tokens. A value ending with '*' matches
+	// all subjects with the given prefix.
+	Subjects []string
+	// Access is the access level granted to the shoot clusters when exchanging tokens of this identity provider.
+	Access *TrustedIdentityProviderAccess
+}
+
+// TrustedIdentityProviderAccess is the access level granted for tokens of a trusted identity provider.
+type TrustedIdentityProviderAccess string
+
+const (
+	// TrustedIdentityProviderAccessAdmin grants admin access to the shoot clusters.
+	TrustedIdentityProviderAccessAdmin TrustedIdentityProviderAccess = "Admin"
+	// TrustedIdentityProviderAccessViewer grants viewer access (excluding Secrets) to the shoot clusters.
+	TrustedIdentityProviderAccessViewer TrustedIdentityProviderAccess = "Viewer"
+)
+
 // DualApprovalForDeletion contains configuration for the dual approval concept for resource deletion.
 type DualApprovalForDeletion struct {
 	// Resource is the name of the resource this applies to.
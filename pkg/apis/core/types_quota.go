@@ -55,4 +55,12 @@ const (
 	QuotaMetricStoragePremium corev1.ResourceName = corev1.ResourceStorage + ".premium"
 	// QuotaMetricLoadbalancer is the constraint for the amount of loadbalancers
 	QuotaMetricLoadbalancer corev1.ResourceName = "loadbalancer"
+	// QuotaMetricControlPlaneUnits is the constraint for the amount of control plane units. Each shoot consumes a number
+	// of units depending on its size class.
+	QuotaMetricControlPlaneUnits corev1.ResourceName = "controlplaneunits"
+	// QuotaMetricShoots is the constraint for the amount of shoots.
+	QuotaMetricShoots corev1.ResourceName = "shoots"
+	// QuotaMetricNodes is the constraint for the amount of worker nodes. Each shoot consumes the sum of the maximum
+	// numbers of nodes of its worker pools.
+	QuotaMetricNodes corev1.ResourceName = "nodes"
 )
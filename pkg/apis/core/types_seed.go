@@ -68,6 +68,8 @@ type SeedSpec struct {
 	Ingress *Ingress
 	// AccessRestrictions describe a list of access restrictions for this seed cluster.
 	AccessRestrictions []AccessRestriction
+	// Maintenance contains information about the time window in which gardenlet may update the seed system components.
+	Maintenance *SeedMaintenance
 }
 
 // SeedStatus is the status of a Seed.
@@ -92,6 +94,76 @@ type SeedStatus struct {
 	ClientCertificateExpirationTimestamp *metav1.Time
 	// LastOperation holds information about the last operation on the Seed.
 	LastOperation *LastOperation
+	// IngressDomainMigration contains information about the migration of the shoots hosted on this seed to a changed
+	// ingress domain.
+	IngressDomainMigration *SeedIngressDomainMigration
+	// Credentials contains information about the credentials of the seed-internal PKI.
+	Credentials *SeedCredentials
+	// SystemComponentsVersion is the version of the gardenlet which last deployed the seed system components.
+	SystemComponentsVersion *string
+	// PendingMaintenanceOperations lists the operations which will be performed in the next maintenance time window of
+	// the Seed.
+	PendingMaintenanceOperations []SeedPendingMaintenanceOperation
+}
+
+// SeedMaintenance contains information about the maintenance of a Seed.
+type SeedMaintenance struct {
+	// TimeWindow contains information about the time window in which gardenlet may update the seed system components,
+	// e.g., after gardenlet was upgraded.
+	TimeWindow MaintenanceTimeWindow
+}
+
+// SeedPendingMaintenanceOperation is an operation which will be performed in the next maintenance time window of the
+// Seed.
+type SeedPendingMaintenanceOperation struct {
+	// Type is the type of the operation, one of SystemComponentsUpdate.
+	Type SeedPendingMaintenanceOperationType
+	// CurrentVersion is the version of the gardenlet which last deployed the seed system components.
+	CurrentVersion *string
+	// TargetVersion is the version of the gardenlet which will deploy the seed system components.
+	TargetVersion *string
+	// Description is a human-readable description of the operation.
+	Description string
+	// ScheduledTime is the beginning of the maintenance time window in which the operation will be performed.
+	ScheduledTime metav1.Time
+}
+
+// SeedPendingMaintenanceOperationType is a string alias.
+type SeedPendingMaintenanceOperationType string
+
+const (
+	// SeedPendingMaintenanceOperationSystemComponentsUpdate is the type of pending updates of the seed system components,
+	// e.g., after gardenlet was upgraded.
+	SeedPendingMaintenanceOperationSystemComponentsUpdate SeedPendingMaintenanceOperationType = "SystemComponentsUpdate"
+)
+
+// SeedCredentials contains information about the credentials of the seed-internal PKI.
+type SeedCredentials struct {
+	// Rotation contains information about the credential rotations.
+	Rotation *SeedCredentialsRotation
+}
+
+// SeedCredentialsRotation contains information about the rotation of credentials.
+type SeedCredentialsRotation struct {
+	// CertificateAuthorities contains information about the seed certificate authority credential rotation.
+	CertificateAuthorities *CARotation
+}
+
+// SeedIngressDomainMigration contains information about the migration of the shoots hosted on a seed to a changed
+// ingress domain.
+type SeedIngressDomainMigration struct {
+	// PreviousDomain is the ingress domain of the seed before the migration was started.
+	PreviousDomain string
+	// Domain is the ingress domain the shoots are migrated to.
+	Domain string
+	// StartTime is the time when the migration was started.
+	StartTime metav1.Time
+	// CompletionTime is the time when all shoots were migrated to the new ingress domain.
+	CompletionTime *metav1.Time
+	// Shoots is the number of shoots hosted on the seed which have to be migrated.
+	Shoots int32
+	// MigratedShoots is the number of shoots which were successfully reconciled with the new ingress domain.
+	MigratedShoots int32
 }
 
 // SeedBackup contains the object store configuration for backups for shoot (currently only etcd).
@@ -106,6 +178,21 @@ type SeedBackup struct {
 	// the object store where backups should be stored. It should have enough privileges to manipulate
 	// the objects as well as buckets.
 	SecretRef corev1.SecretReference
+	// Immutability contains the immutability (object lock) configuration of the backup bucket. If set, the etcd backups
+	// of the shoots are protected against deletion and overwrites for the configured retention period.
+	Immutability *BackupBucketImmutability
+	// ETCD contains bounds for the etcd backup settings which can be configured in the `.spec.etcd.backup` field of the
+	// shoots hosted on this seed.
+	ETCD *SeedBackupETCD
+}
+
+// SeedBackupETCD contains bounds for the etcd backup settings of the shoots hosted on a seed.
+type SeedBackupETCD struct {
+	// MinFullSnapshotInterval is the minimum interval between two full snapshots which shoots may configure via their
+	// full snapshot schedule.
+	MinFullSnapshotInterval *metav1.Duration
+	// MaxDeltaSnapshotRetentionPeriod is the maximum retention period for delta snapshots which shoots may configure.
+	MaxDeltaSnapshotRetentionPeriod *metav1.Duration
 }
 
 // SeedDNS contains the external domain and configuration for the DNS provider
@@ -125,10 +212,14 @@ type SeedDNSProvider struct {
 // Ingress configures the Ingress specific settings of the Seed cluster
 type Ingress struct {
 	// Domain specifies the ingress domain of the Seed cluster pointing to the ingress controller endpoint. It will be used
-	// to construct ingress URLs for system applications running in Shoot clusters. Once set this field is immutable.
+	// to construct ingress URLs for system applications running in Shoot clusters. Changing this field triggers a
+	// migration of all shoots hosted on the seed, see `.status.ingressDomainMigration`.
 	Domain string
 	// Controller configures a Gardener managed Ingress Controller listening on the ingressDomain
 	Controller IngressController
+	// KubeAPIServerPorts are additional ports of the Seed's istio ingress gateways on which the kube-apiservers of shoots
+	// can be exposed besides port 443.
+	KubeAPIServerPorts []int32
 }
 
 // IngressController enables a Gardener managed Ingress Controller listening on the ingressDomain
@@ -176,6 +267,9 @@ type SeedProvider struct {
 	Region string
 	// Zones is the list of availability zones the seed cluster is deployed to.
 	Zones []string
+	// RegionMetadata contains structured information about the region of the seed cluster, e.g. wrt data residency.
+	// If not set, the metadata of the region in the CloudProfile of the shoot is used (if the provider types match).
+	RegionMetadata *RegionMetadata
 }
 
 // SeedSettings contains certain settings for this seed cluster.
@@ -193,6 +287,9 @@ type SeedSettings struct {
 	// TopologyAwareRouting controls certain settings for topology-aware traffic routing in the seed.
 	// See https://github.com/gardener/gardener/blob/master/docs/operations/topology_aware_routing.md.
 	TopologyAwareRouting *SeedSettingTopologyAwareRouting
+	// ACME configures the ACME server which is used for issuing managed certificates for the kube-apiservers of shoots
+	// hosted by the seed.
+	ACME *SeedSettingACME
 }
 
 // SeedSettingExcessCapacityReservation controls the excess capacity reservation for shoot control planes in the
@@ -304,14 +401,41 @@ type SeedSettingTopologyAwareRouting struct {
 	Enabled bool
 }
 
+// SeedSettingACME configures the ACME server which is used for issuing managed certificates for the kube-apiservers
+// of shoots hosted by the seed.
+type SeedSettingACME struct {
+	// DirectoryURL is the URL of the directory endpoint of the ACME server.
+	DirectoryURL string
+	// Email is the contact email address of the ACME account.
+	Email *string
+}
+
 // SeedTaint describes a taint on a seed.
 type SeedTaint struct {
 	// Key is the taint key to be applied to a seed.
 	Key string
 	// Value is the taint value corresponding to the taint key.
 	Value *string
+	// Effect is the effect of the taint on shoots that do not tolerate it.
+	Effect *SeedTaintEffect
 }
 
+// SeedTaintEffect is the effect of a seed taint.
+type SeedTaintEffect string
+
+const (
+	// SeedTaintEffectNoSchedule is the effect of a taint which prevents shoots that do not tolerate the taint from being
+	// scheduled to the seed. Shoots which are already scheduled to the seed are not affected.
+	SeedTaintEffectNoSchedule SeedTaintEffect = "NoSchedule"
+	// SeedTaintEffectPreferNoSchedule is the effect of a taint which makes the scheduler avoid the seed for shoots that
+	// do not tolerate the taint. The seed is still chosen if there is no other suitable seed.
+	SeedTaintEffectPreferNoSchedule SeedTaintEffect = "PreferNoSchedule"
+	// SeedTaintEffectNoExecute is the effect of a taint which prevents shoots that do not tolerate the taint from being
+	// scheduled to the seed. Additionally, it indicates that the shoots already running on the seed without tolerating
+	// the taint should be migrated to another seed. Such migrations are not triggered automatically.
+	SeedTaintEffectNoExecute SeedTaintEffect = "NoExecute"
+)
+
 const (
 	// SeedTaintProtected is a constant for a taint key on a seed that marks it as protected. Protected seeds
 	// may only be used by shoots in the `garden` namespace.
@@ -343,6 +467,9 @@ const (
 	SeedGardenletReady ConditionType = "GardenletReady"
 	// SeedSystemComponentsHealthy is a constant for a condition type indicating the system components health.
 	SeedSystemComponentsHealthy ConditionType = "SeedSystemComponentsHealthy"
+	// SeedVolumeAttachmentsHealthy is a constant for a condition type indicating that no volumes of shoot control planes
+	// are stuck in attaching or detaching.
+	SeedVolumeAttachmentsHealthy ConditionType = "VolumeAttachmentsHealthy"
 )
 
 // Resource constants for Gardener object types
@@ -109,6 +109,45 @@ type ShootSpec struct {
 	CredentialsBindingName *string
 	// AccessRestrictions describe a list of access restrictions for this shoot cluster.
 	AccessRestrictions []AccessRestrictionWithOptions
+	// DataResidency contains constraints for the placement of the shoot cluster wrt data residency. Both the region of
+	// the shoot and the region of the seed hosting its control plane must satisfy them.
+	DataResidency *DataResidency
+	// ETCD contains settings for the etcd of the shoot cluster.
+	ETCD *ETCD
+	// ExpirationTimestamp is the time after which the shoot cluster is automatically deleted. Warning events are emitted
+	// on the Shoot shortly before. If the project requires a dual approval for the deletion of this shoot, it is not
+	// deleted automatically.
+	ExpirationTimestamp *metav1.Time
+	// ShootClassName is the name of a ShootClass whose worker pools, control plane and hibernation settings are applied
+	// to the Shoot when it is created, unless the Shoot specifies them itself.
+	// This field is immutable.
+	ShootClassName *string
+}
+
+// DataResidency contains constraints for the placement of a shoot cluster wrt data residency.
+type DataResidency struct {
+	// Jurisdictions is the list of legal jurisdictions which are allowed for the regions of the shoot and its seed.
+	Jurisdictions []string
+	// Geographies is the list of geographical areas which are allowed for the regions of the shoot and its seed.
+	Geographies []string
+}
+
+// ETCD contains settings for the etcd of the shoot cluster.
+type ETCD struct {
+	// Backup contains settings for the backup of the etcd of the shoot cluster.
+	Backup *ETCDBackup
+}
+
+// ETCDBackup contains settings for the backup of the etcd of the shoot cluster. The settings are only considered if the
+// seed hosting the control plane of the shoot has a backup configured. They must be within the bounds defined in the
+// `.spec.backup.etcd` field of the seed.
+type ETCDBackup struct {
+	// FullSnapshotSchedule is the cron schedule (in standard cron format) which defines when full snapshots of the etcd
+	// are taken. If not specified, a daily schedule within the maintenance time window of the shoot is used.
+	FullSnapshotSchedule *string
+	// DeltaSnapshotRetentionPeriod is the duration for which delta snapshots are retained, excluding the latest snapshot
+	// set. If not specified, the default configured for the gardenlet is used.
+	DeltaSnapshotRetentionPeriod *metav1.Duration
 }
 
 // ShootStatus holds the most recently observed status of the Shoot cluster.
@@ -160,8 +199,75 @@ type ShootStatus struct {
 	EncryptedResources []string
 	// Networking contains information about cluster networking such as CIDRs.
 	Networking *NetworkingStatus
+	// SizeClass is the size class of the shoot cluster. It is periodically computed based on the number of nodes and
+	// the volume of requests to the API server.
+	SizeClass *ShootSizeClass
+	// LegacyComponentRemovals contains information about the removal of deprecated components from the shoot cluster.
+	LegacyComponentRemovals []LegacyComponentRemoval
+	// EtcdSnapshot contains information about the last on-demand full snapshot of the Shoot's main etcd.
+	EtcdSnapshot *EtcdSnapshot
+	// PendingMaintenanceOperations lists the updates which will be applied to the Shoot in its next maintenance time
+	// window(s).
+	PendingMaintenanceOperations []PendingMaintenanceOperation
+	// Remediations contains information about the last executions of remediation actions for failed conditions of the
+	// Shoot.
+	Remediations []ShootRemediation
+}
+
+// ShootRemediation contains information about the last execution of a remediation action for a failed condition.
+type ShootRemediation struct {
+	// Action is the name of the remediation action.
+	Action string
+	// ConditionType is the type of the failed condition which triggered the last execution.
+	ConditionType ConditionType
+	// State is the state of the last execution, one of Succeeded, Failed.
+	State LastOperationState
+	// Description is a human-readable description of the result of the last execution.
+	Description string
+	// LastExecutionTime is the time of the last execution.
+	LastExecutionTime metav1.Time
 }
 
+// LegacyComponentRemoval contains information about the removal of a deprecated component from the shoot cluster.
+type LegacyComponentRemoval struct {
+	// Name is the name of the legacy component.
+	Name string
+	// State is the state of the removal, one of Processing, Succeeded.
+	State LastOperationState
+	// LastTransitionTime is the last time the state transitioned.
+	LastTransitionTime metav1.Time
+}
+
+// EtcdSnapshot contains information about an on-demand full snapshot of the main etcd.
+type EtcdSnapshot struct {
+	// Phase is the phase of the snapshot.
+	Phase EtcdSnapshotPhase
+	// LastInitiationTime is the most recent time when a snapshot was requested.
+	LastInitiationTime *metav1.Time
+	// LastCompletionTime is the most recent time when a requested snapshot was taken successfully.
+	LastCompletionTime *metav1.Time
+	// Name is the name of the snapshot.
+	Name *string
+	// Location is the location of the snapshot in the backup bucket (<bucket>/<path>).
+	Location *string
+	// LastRevision is the last etcd revision contained in the snapshot.
+	LastRevision *int64
+	// Message contains details in case the snapshot could not be taken.
+	Message *string
+}
+
+// EtcdSnapshotPhase is a string alias.
+type EtcdSnapshotPhase string
+
+const (
+	// EtcdSnapshotPending indicates that a snapshot was requested but not yet taken.
+	EtcdSnapshotPending EtcdSnapshotPhase = "Pending"
+	// EtcdSnapshotSucceeded indicates that the requested snapshot was taken successfully.
+	EtcdSnapshotSucceeded EtcdSnapshotPhase = "Succeeded"
+	// EtcdSnapshotFailed indicates that the requested snapshot could not be taken.
+	EtcdSnapshotFailed EtcdSnapshotPhase = "Failed"
+)
+
 // LastMaintenance holds information about a maintenance operation on the Shoot.
 type LastMaintenance struct {
 	// A human-readable message containing details about the operations performed in the last maintenance.
@@ -174,6 +280,39 @@ type LastMaintenance struct {
 	FailureReason *string
 }
 
+// PendingMaintenanceOperation is an update which will be applied to the Shoot in its next maintenance time window.
+type PendingMaintenanceOperation struct {
+	// Type is the type of the update.
+	Type PendingMaintenanceOperationType
+	// WorkerPool is the name of the worker pool which is updated. It is not set for updates of the control plane.
+	WorkerPool *string
+	// CurrentVersion is the currently used Kubernetes or machine image version.
+	CurrentVersion *string
+	// TargetVersion is the Kubernetes or machine image version the Shoot will be updated to. It is not set if no
+	// suitable version could be determined, in which case the maintenance will fail.
+	TargetVersion *string
+	// Forced indicates that the update is applied regardless of the auto-update settings of the Shoot, e.g., because
+	// the current version expires.
+	Forced bool
+	// Description is a human-readable description of the update and the reason why it is applied.
+	Description string
+	// ScheduledTime is the beginning of the maintenance time window in which the update will be applied.
+	ScheduledTime metav1.Time
+}
+
+// PendingMaintenanceOperationType is a string alias.
+type PendingMaintenanceOperationType string
+
+const (
+	// PendingMaintenanceOperationKubernetesVersion is the type of pending updates of the Kubernetes version.
+	PendingMaintenanceOperationKubernetesVersion PendingMaintenanceOperationType = "KubernetesVersion"
+	// PendingMaintenanceOperationMachineImageVersion is the type of pending updates of a machine image version.
+	PendingMaintenanceOperationMachineImageVersion PendingMaintenanceOperationType = "MachineImageVersion"
+	// PendingMaintenanceOperationConfiguration is the type of pending changes of the Shoot configuration which are
+	// enforced by an update, e.g., fields which are no longer supported by the target Kubernetes version.
+	PendingMaintenanceOperationConfiguration PendingMaintenanceOperationType = "Configuration"
+)
+
 // NetworkingStatus contains information about cluster networking such as CIDRs.
 type NetworkingStatus struct {
 	// Pods are the CIDRs of the pod network.
@@ -186,6 +325,10 @@ type NetworkingStatus struct {
 	// Infrastructure extension controller. For certain environments the egress IPs may not be stable in which case the
 	// extension controller may opt to not populate this field.
 	EgressCIDRs []string
+	// ControlPlaneEgressCIDRs is a list of CIDRs used by the control plane components of the shoot as the source IP for
+	// egress traffic as reported by the used ControlPlane extension controller. It is only populated if a dedicated
+	// control plane egress is configured.
+	ControlPlaneEgressCIDRs []string
 }
 
 // ShootCredentials contains information about the shoot credentials.
@@ -314,6 +457,20 @@ type Addons struct {
 	KubernetesDashboard *KubernetesDashboard
 	// NginxIngress holds configuration settings for the nginx-ingress addon.
 	NginxIngress *NginxIngress
+	// Managed is the list of managed addons which are deployed into the shoot cluster. The addons must be declared in
+	// the catalog of the referenced CloudProfile.
+	Managed []ManagedAddon
+}
+
+// ManagedAddon references an addon from the catalog of the CloudProfile which is deployed into the shoot cluster.
+type ManagedAddon struct {
+	// Name is the name of the addon in the catalog.
+	Name string
+	// Version is the version of the addon.
+	Version string
+	// Values are the values for the Helm chart of the addon. They are validated against the values schema of the
+	// addon version.
+	Values *runtime.RawExtension
 }
 
 // Addon allows enabling or disabling a specific addon and is used to derive from.
@@ -352,6 +509,17 @@ type ControlPlane struct {
 	// HighAvailability holds the configuration settings for high availability of the
 	// control plane of a shoot.
 	HighAvailability *HighAvailability
+	// Egress holds the configuration settings for the egress traffic of the control plane components of a shoot.
+	Egress *ControlPlaneEgress
+}
+
+// ControlPlaneEgress holds the configuration settings for the egress traffic of the control plane components of a shoot.
+type ControlPlaneEgress struct {
+	// Dedicated specifies whether the egress traffic of the control plane components running in the seed (e.g., calls
+	// from the kube-apiserver to webhooks in the shoot or calls to the cloud provider API) shall leave the seed via a
+	// source IP dedicated to this shoot. This allows allow-listing a stable per-cluster source IP. The dedicated egress
+	// must be provided by the extension controller responsible for the `ControlPlane` resource.
+	Dedicated bool
 }
 
 // DNS holds information about the provider, the hosted zone id and the domain.
@@ -444,8 +612,16 @@ type HibernationSchedule struct {
 	End *string
 	// Location is the time location in which both start and shall be evaluated.
 	Location *string
+	// Exceptions is a list of dates or cron specs at which the shoot must not be hibernated by this schedule, e.g.,
+	// during planned load tests or release weekends. Dates must be specified in the RFC 5545 date format (YYYYMMDD).
+	// Hibernations whose time matches one of the cron specs are skipped as well. Both are evaluated in the location of
+	// the schedule. Waking up the shoot is never skipped.
+	Exceptions []string
 }
 
+// HibernationScheduleExceptionDateLayout is the layout of dates in the exceptions of hibernation schedules.
+const HibernationScheduleExceptionDateLayout = "20060102"
+
 // Kubernetes contains the version and configuration variables for the Shoot control plane.
 type Kubernetes struct {
 	// ClusterAutoscaler contains the configuration flags for the Kubernetes cluster autoscaler.
@@ -474,6 +650,46 @@ type Kubernetes struct {
 	// Defaults to true for Shoots with Kubernetes versions < 1.26. Defaults to false for Shoots with Kubernetes versions >= 1.26.
 	// Starting Kubernetes 1.27 the field will be locked to false.
 	EnableStaticTokenKubeconfig *bool
+	// NamespaceDefaults contains LimitRanges and ResourceQuotas which are applied to the namespaces in the Shoot cluster.
+	NamespaceDefaults *NamespaceDefaults
+}
+
+// NamespaceDefaults contains LimitRanges and ResourceQuotas which are applied to the namespaces in the Shoot cluster.
+type NamespaceDefaults struct {
+	// NamespaceSelector selects the namespaces to which the LimitRanges and ResourceQuotas are applied. If not set, they
+	// are applied to all namespaces. Namespaces managed by Gardener, e.g. `kube-system`, are never selected.
+	NamespaceSelector *metav1.LabelSelector
+	// LimitRanges are the LimitRanges which are applied to the selected namespaces.
+	LimitRanges []NamespaceDefaultsLimitRange
+	// ResourceQuotas are the ResourceQuotas which are applied to the selected namespaces.
+	ResourceQuotas []NamespaceDefaultsResourceQuota
+	// NetworkPolicies configures the default NetworkPolicies which are applied to the selected namespaces.
+	NetworkPolicies *NamespaceDefaultsNetworkPolicies
+}
+
+// NamespaceDefaultsNetworkPolicies configures the default NetworkPolicies which are applied to the selected namespaces
+// in the Shoot cluster.
+type NamespaceDefaultsNetworkPolicies struct {
+	// DenyAll enables a NetworkPolicy which denies all ingress and egress traffic of the pods in the selected namespaces.
+	// Additionally, NetworkPolicies allowing egress traffic to the cluster DNS and to the kube-apiserver are applied.
+	// Namespaces labeled with `networking.gardener.cloud/default-network-policies=disabled` are excluded.
+	DenyAll bool
+}
+
+// NamespaceDefaultsLimitRange is a LimitRange which is applied to the selected namespaces in the Shoot cluster.
+type NamespaceDefaultsLimitRange struct {
+	// Name is the name of the LimitRange in the namespaces.
+	Name string
+	// Spec is the specification of the LimitRange.
+	Spec corev1.LimitRangeSpec
+}
+
+// NamespaceDefaultsResourceQuota is a ResourceQuota which is applied to the selected namespaces in the Shoot cluster.
+type NamespaceDefaultsResourceQuota struct {
+	// Name is the name of the ResourceQuota in the namespaces.
+	Name string
+	// Spec is the specification of the ResourceQuota.
+	Spec corev1.ResourceQuotaSpec
 }
 
 // ClusterAutoscaler contains the configuration flags for the Kubernetes cluster autoscaler.
@@ -649,6 +865,51 @@ type KubeAPIServerConfig struct {
 	StructuredAuthentication *StructuredAuthentication
 	// StructuredAuthorization contains configuration settings for structured authorization for the kube-apiserver.
 	StructuredAuthorization *StructuredAuthorization
+	// AuthorizedNetworks is a list of CIDRs which are allowed to access the kube-apiserver via its external domain.
+	// If empty, the access is not restricted.
+	AuthorizedNetworks []string
+	// Endpoint contains settings for exposing the kube-apiserver under additional host names and on an additional port.
+	Endpoint *KubeAPIServerEndpoint
+	// APIPriorityAndFairness contains settings for the API Priority and Fairness feature of the kube-apiserver.
+	APIPriorityAndFairness *APIPriorityAndFairness
+	// VPN contains settings for the VPN tunnels between the kube-apiserver and the shoot cluster.
+	VPN *KubeAPIServerVPNConfig
+}
+
+// KubeAPIServerVPNConfig contains settings for the VPN tunnels between the kube-apiserver and the shoot cluster.
+type KubeAPIServerVPNConfig struct {
+	// HighAvailability enables the highly available VPN. In this mode, multiple vpn-seed-server replicas and vpn-shoot
+	// clients establish multiple tunnels, and the kube-apiserver selects a working tunnel for each request path.
+	// Defaults to true if the control plane is configured to be highly available.
+	HighAvailability *bool
+	// SeedServers is the number of vpn-seed-server replicas used for the highly available VPN. Allowed values are
+	// between 2 and 4. Defaults to 2.
+	SeedServers *int32
+	// ShootClients is the number of vpn-shoot clients used for the highly available VPN. Allowed values are between 2
+	// and 4. Defaults to 2.
+	ShootClients *int32
+}
+
+// APIPriorityAndFairness contains settings for the API Priority and Fairness feature of the kube-apiserver.
+type APIPriorityAndFairness struct {
+	// MaxSeats is the total number of seats (i.e., the concurrency limit) of the kube-apiserver which are shared by all
+	// priority levels.
+	MaxSeats *int32
+	// ConfigMapName is the name of the ConfigMap in the project namespace which contains custom FlowSchemas and
+	// PriorityLevelConfigurations.
+	ConfigMapName *string
+}
+
+// KubeAPIServerEndpoint contains settings for exposing the kube-apiserver under additional host names and on an additional
+// port of the seed's istio ingress gateway.
+type KubeAPIServerEndpoint struct {
+	// AdditionalHostnames are additional host names under which the kube-apiserver is reachable.
+	AdditionalHostnames []string
+	// Port is the port on which the kube-apiserver is advertised via its external domain instead of 443.
+	Port *int32
+	// ManagedCertificate specifies whether gardenlet issues and rotates a publicly trusted certificate for the
+	// additional host names.
+	ManagedCertificate *bool
 }
 
 // APIServerLogging contains configuration for the logs level and http access logs
@@ -705,6 +966,32 @@ type ServiceAccountConfig struct {
 type AuditConfig struct {
 	// AuditPolicy contains configuration settings for audit policy of the kube-apiserver.
 	AuditPolicy *AuditPolicy
+	// Webhook contains settings for sending the audit events of the kube-apiserver to a webhook backend.
+	Webhook *AuditWebhook
+	// Sink contains settings for delivering the audit events of the kube-apiserver to a managed service of a provider
+	// (e.g., AWS CloudWatch or Google Cloud Logging). The delivery is implemented by an extension for the given type.
+	Sink *AuditSink
+}
+
+// AuditSink contains settings for delivering the audit events of the kube-apiserver to a managed service of a provider.
+type AuditSink struct {
+	// Type is the type of the audit sink extension.
+	Type string
+	// ProviderConfig is the configuration passed to the audit sink extension.
+	ProviderConfig *runtime.RawExtension
+}
+
+// AuditWebhook contains settings for an audit webhook backend of the kube-apiserver.
+type AuditWebhook struct {
+	// KubeconfigSecretName specifies the name of a secret containing the kubeconfig for the audit webhook backend.
+	// The secret must be referenced in the shoot's `.spec.resources`.
+	KubeconfigSecretName string
+	// BatchMaxSize is the maximum size of a batch of audit events sent to the webhook backend
+	// (flag `--audit-webhook-batch-max-size`).
+	BatchMaxSize *int32
+	// BatchMaxWait is the amount of time to wait before sending a batch of audit events that has not reached the
+	// maximum size (flag `--audit-webhook-batch-max-wait`).
+	BatchMaxWait *metav1.Duration
 }
 
 // AuditPolicy contains audit policy for kube-apiserver
@@ -786,6 +1073,9 @@ type AdmissionPlugin struct {
 	Config *runtime.RawExtension
 	// KubeconfigSecretName specifies the name of a secret containing the kubeconfig for this admission plugin.
 	KubeconfigSecretName *string
+	// ConfigMapName specifies the name of a ConfigMap containing the configuration of the plugin in its `config.yaml`
+	// data key. It is mutually exclusive with Config.
+	ConfigMapName *string
 }
 
 // WatchCacheSizes contains configuration of the API server's watch cache sizes.
@@ -830,6 +1120,21 @@ type KubeControllerManagerConfig struct {
 	PodEvictionTimeout *metav1.Duration
 	// NodeMonitorGracePeriod defines the grace period before an unresponsive node is marked unhealthy.
 	NodeMonitorGracePeriod *metav1.Duration
+	// Controllers contains the settings of individual controllers of the kube-controller-manager, e.g., to disable them
+	// or to configure the number of objects they sync concurrently.
+	Controllers []KubeControllerManagerController
+}
+
+// KubeControllerManagerController contains the settings of an individual controller of the kube-controller-manager.
+type KubeControllerManagerController struct {
+	// Name is the name of the controller, e.g., `ttl-after-finished`.
+	Name string
+	// Enabled indicates whether the controller is enabled. If unset, the controller keeps its default state. Controllers
+	// which are required by Gardener cannot be disabled.
+	Enabled *bool
+	// ConcurrentSyncs is the number of objects the controller is allowed to sync concurrently. Only supported for
+	// controllers offering a corresponding `--concurrent-*-syncs` flag.
+	ConcurrentSyncs *int32
 }
 
 // HorizontalPodAutoscalerConfig contains horizontal pod autoscaler configuration settings for the kube-controller-manager.
@@ -990,6 +1295,13 @@ type KubeletConfig struct {
 	StreamingConnectionIdleTimeout *metav1.Duration
 	// MemorySwap configures swap memory available to container workloads.
 	MemorySwap *MemorySwapConfiguration
+	// MemoryManagerPolicy is the name of the policy to use by the memory manager. May be one of {"None", "Static"}.
+	// The "Static" policy requires reservedMemory to be configured.
+	MemoryManagerPolicy *MemoryManagerPolicy
+	// ReservedMemory specifies the memory reservations for NUMA nodes used by the "Static" memory manager policy.
+	// The sum of all reservations for a resource must equal the memory reserved via kubeReserved plus the hard eviction
+	// threshold (memory.available), otherwise the kubelet refuses to start.
+	ReservedMemory []KubeletMemoryReservation
 }
 
 // KubeletConfigEviction contains kubelet eviction thresholds supporting either a resource.Quantity or a percentage based value.
@@ -1062,6 +1374,25 @@ const (
 	UnlimitedSwap SwapBehavior = "UnlimitedSwap"
 )
 
+// MemoryManagerPolicy is the name of the policy used by the kubelet's memory manager.
+type MemoryManagerPolicy string
+
+const (
+	// MemoryManagerPolicyNone is a constant for the kubelet's memory manager policy not affecting the memory allocation.
+	MemoryManagerPolicyNone MemoryManagerPolicy = "None"
+	// MemoryManagerPolicyStatic is a constant for the kubelet's memory manager policy guaranteeing memory (and hugepages)
+	// allocation from a minimal set of NUMA nodes for pods in the Guaranteed QoS class.
+	MemoryManagerPolicyStatic MemoryManagerPolicy = "Static"
+)
+
+// KubeletMemoryReservation specifies the memory reservation of different types for a NUMA node.
+type KubeletMemoryReservation struct {
+	// NumaNode is the index of the NUMA node.
+	NumaNode int32
+	// Limits are the reserved amounts per resource, e.g. memory or hugepages-1Gi.
+	Limits corev1.ResourceList
+}
+
 // MemorySwapConfiguration contains kubelet swap configuration
 // For more information, please see KEP: 2400-node-swap
 type MemorySwapConfiguration struct {
@@ -1083,7 +1414,8 @@ type Networking struct {
 	Nodes *string
 	// Services is the CIDR of the service network. This field is immutable.
 	Services *string
-	// IPFamilies specifies the IP protocol versions to use for shoot networking. This field is immutable.
+	// IPFamilies specifies the IP protocol versions to use for shoot networking.
+	// The only supported change of this field is the migration from ["IPv4"] to ["IPv4", "IPv6"].
 	// See https://github.com/gardener/gardener/blob/master/docs/development/ipv6.md.
 	// Defaults to ["IPv4"].
 	IPFamilies []IPFamily
@@ -1101,6 +1433,8 @@ const (
 	MaintenanceTimeWindowDurationMinimum = 30 * time.Minute
 	// MaintenanceTimeWindowDurationMaximum is the maximum duration for a maintenance time window.
 	MaintenanceTimeWindowDurationMaximum = 6 * time.Hour
+	// MaintenanceExclusionDurationMaximum is the maximum duration for a maintenance exclusion.
+	MaintenanceExclusionDurationMaximum = 90 * 24 * time.Hour
 )
 
 // Maintenance contains information about the time window for maintenance operations and which
@@ -1114,8 +1448,52 @@ type Maintenance struct {
 	// Instead, they are rolled out during the shoot's maintenance time window. There is one exception that will trigger
 	// an immediate roll out which is changes to the Spec.Hibernation.Enabled field.
 	ConfineSpecUpdateRollout *bool
+	// Exclusions is a list of time windows in which the listed operations must not be performed by Gardener, e.g.,
+	// during blackout periods like retail peak seasons.
+	Exclusions []MaintenanceExclusion
+	// WorkerPools contains maintenance time windows for individual worker pools. Automatic updates of the machine image
+	// and Kubernetes versions of the listed worker pools are performed in their own time window instead of the time
+	// window of the shoot.
+	WorkerPools []WorkerPoolMaintenance
+}
+
+// WorkerPoolMaintenance contains information about the time window for maintenance operations of a worker pool.
+type WorkerPoolMaintenance struct {
+	// Name is the name of the worker pool.
+	Name string
+	// TimeWindow contains information about the time window for maintenance operations of the worker pool.
+	TimeWindow MaintenanceTimeWindow
 }
 
+// MaintenanceExclusion is a time window in which certain operations must not be performed.
+type MaintenanceExclusion struct {
+	// Name is the name of the exclusion.
+	Name string
+	// Begin is the point in time when the exclusion starts.
+	Begin metav1.Time
+	// End is the point in time when the exclusion ends.
+	End metav1.Time
+	// Operations is the list of operations which must not be performed while the exclusion is active.
+	Operations []MaintenanceExclusionOperation
+}
+
+// MaintenanceExclusionOperation is an operation which can be excluded from a time window.
+type MaintenanceExclusionOperation string
+
+const (
+	// MaintenanceExclusionOperationNodeRoll excludes operations initiated by Gardener which roll the nodes of the shoot
+	// cluster, i.e., automatic updates of machine image and Kubernetes versions and the start of credentials rotations.
+	// Node rolls caused by other changes of the shoot specification or by the replacement of unhealthy machines are not
+	// prevented.
+	MaintenanceExclusionOperationNodeRoll MaintenanceExclusionOperation = "NodeRoll"
+	// MaintenanceExclusionOperationEtcdMaintenance excludes the maintenance of etcd, i.e., defragmentation and
+	// restarts caused by scaling down.
+	MaintenanceExclusionOperationEtcdMaintenance MaintenanceExclusionOperation = "EtcdMaintenance"
+	// MaintenanceExclusionOperationCARotation excludes starting and completing the rotation of the certificate
+	// authorities.
+	MaintenanceExclusionOperationCARotation MaintenanceExclusionOperation = "CARotation"
+)
+
 // MaintenanceAutoUpdate contains information about which constraints should be automatically updated.
 type MaintenanceAutoUpdate struct {
 	// KubernetesVersion indicates whether the patch Kubernetes version may be automatically updated (default: true).
@@ -1211,9 +1589,64 @@ type Worker struct {
 	MachineControllerManagerSettings *MachineControllerManagerSettings
 	// Sysctls is a map of kernel settings to apply on all machines in this worker pool.
 	Sysctls map[string]string
+	// KernelModules is a list of kernel modules to load on all machines in this worker pool.
+	KernelModules []string
 	// ClusterAutoscaler contains the cluster autoscaler configurations for the worker pool.
 	ClusterAutoscaler *ClusterAutoscalerOptions
-}
+	// CapacityType is the type of capacity used for the machines of this worker pool (default: OnDemand).
+	CapacityType *CapacityType
+	// UpdateStrategy is the strategy used for applying changes of the machine image version and the Kubernetes version
+	// to the machines of this worker pool (default: RollingUpdate).
+	UpdateStrategy *WorkerUpdateStrategy
+	// RolloutStrategy contains settings for rolling out the machines of this worker pool relative to the other worker
+	// pools.
+	RolloutStrategy *WorkerRolloutStrategy
+	// NodeTemplate contains settings for how the labels, annotations and taints of this worker pool are applied to the
+	// `Node` objects.
+	NodeTemplate *WorkerNodeTemplate
+}
+
+// WorkerNodeTemplate contains settings for how the labels, annotations and taints of a worker pool are applied to the
+// `Node` objects.
+type WorkerNodeTemplate struct {
+	// EnforceLabels makes gardener-node-agent continuously reconcile the labels, annotations and taints of the worker
+	// pool on the existing `Node` objects, i.e., manual changes to them are reverted.
+	EnforceLabels *bool
+}
+
+// WorkerRolloutStrategy contains settings for rolling out the machines of a worker pool.
+type WorkerRolloutStrategy struct {
+	// Order is the position of the worker pool in the sequence of rolling updates of all worker pools. Worker pools with
+	// a lower order are rolled before worker pools with a higher order, worker pools with the same order are rolled
+	// concurrently (default: 0).
+	Order *int32
+}
+
+// WorkerUpdateStrategy is a type for the update strategy of a worker pool.
+type WorkerUpdateStrategy string
+
+const (
+	// WorkerUpdateStrategyRollingUpdate indicates that machines are replaced by new machines when the machine image
+	// version or the Kubernetes version of the worker pool changes.
+	WorkerUpdateStrategyRollingUpdate WorkerUpdateStrategy = "RollingUpdate"
+	// WorkerUpdateStrategyInPlace indicates that changes of the machine image version or the Kubernetes version of the
+	// worker pool are applied to the existing machines.
+	WorkerUpdateStrategyInPlace WorkerUpdateStrategy = "InPlace"
+)
+
+// CapacityType is a type for the capacity of machines in a worker pool.
+type CapacityType string
+
+const (
+	// CapacityTypeOnDemand is a constant for regular on-demand capacity.
+	CapacityTypeOnDemand CapacityType = "OnDemand"
+	// CapacityTypeSpot is a constant for spot/preemptible capacity which can be reclaimed by the infrastructure provider
+	// at any time.
+	CapacityTypeSpot CapacityType = "Spot"
+	// CapacityTypeSpotWithFallback is a constant for preferring spot/preemptible capacity while falling back to on-demand
+	// capacity if no spot capacity is available.
+	CapacityTypeSpotWithFallback CapacityType = "SpotWithFallback"
+)
 
 // ClusterAutoscalerOptions contains the cluster autoscaler configurations for a worker pool.
 type ClusterAutoscalerOptions struct {
@@ -1241,12 +1674,29 @@ type MachineControllerManagerSettings struct {
 	MaxEvictRetries *int32
 	// NodeConditions are the set of conditions if set to true for the period of MachineHealthTimeout, machine will be declared failed.
 	NodeConditions []string
+	// MachineBootstrapTimeout is the period after which a node which has not completed its bootstrap is considered stuck.
+	MachineBootstrapTimeout *metav1.Duration
+	// MachineBootstrapFailurePolicy determines what happens with nodes which are stuck in their bootstrap.
+	MachineBootstrapFailurePolicy *MachineBootstrapFailurePolicy
 }
 
+// MachineBootstrapFailurePolicy is a type for the policy applied to nodes which are stuck in their bootstrap.
+type MachineBootstrapFailurePolicy string
+
+const (
+	// MachineBootstrapFailurePolicyIgnore indicates that nodes stuck in their bootstrap are only reported.
+	MachineBootstrapFailurePolicyIgnore MachineBootstrapFailurePolicy = "Ignore"
+	// MachineBootstrapFailurePolicyReplace indicates that the machines of nodes stuck in their bootstrap are replaced.
+	MachineBootstrapFailurePolicyReplace MachineBootstrapFailurePolicy = "Replace"
+)
+
 // WorkerSystemComponents contains configuration for system components related to this worker pool
 type WorkerSystemComponents struct {
 	// Allow determines whether the pool should be allowed to host system components or not (defaults to true)
 	Allow bool
+	// ReservedNodesPercentage is the percentage (1-100) of nodes of this worker pool which are reserved for system
+	// components.
+	ReservedNodesPercentage *int32
 }
 
 // WorkerKubernetes contains configuration for Kubernetes components related to this worker pool.
@@ -1270,6 +1720,9 @@ type Machine struct {
 	Image *ShootMachineImage
 	// Architecture is the CPU architecture of the machines in this worker pool.
 	Architecture *string
+	// OSFamily is the operating system family of machines in this worker pool. Possible values are "linux" and
+	// "windows". Defaults to "linux". This field is immutable.
+	OSFamily *string
 }
 
 // ShootMachineImage defines the name and the version of the shoot's machine image in any environment. Has to be
@@ -1294,6 +1747,10 @@ type Volume struct {
 	VolumeSize string
 	// Encrypted determines if the volume should be encrypted.
 	Encrypted *bool
+	// IOPS is the number of provisioned I/O operations per second of the volume.
+	IOPS *int64
+	// Throughput is the provisioned throughput of the volume in MiB/s.
+	Throughput *int64
 }
 
 // DataVolume contains information about a data volume.
@@ -1306,6 +1763,10 @@ type DataVolume struct {
 	VolumeSize string
 	// Encrypted determines if the volume should be encrypted.
 	Encrypted *bool
+	// IOPS is the number of provisioned I/O operations per second of the volume.
+	IOPS *int64
+	// Throughput is the provisioned throughput of the volume in MiB/s.
+	Throughput *int64
 }
 
 // CRI contains information about the Container Runtimes.
@@ -1358,6 +1819,9 @@ type SystemComponents struct {
 	CoreDNS *CoreDNS
 	// NodeLocalDNS contains the settings of the node local DNS components running in the data plane of the Shoot cluster.
 	NodeLocalDNS *NodeLocalDNS
+	// DedicatedPool is the name of the worker pool which exclusively hosts the system components running in the data
+	// plane of the Shoot cluster. The nodes of this pool are tainted so that regular workload is not scheduled onto them.
+	DedicatedPool *string
 }
 
 // CoreDNS contains the settings of the Core DNS components running in the data plane of the Shoot cluster.
@@ -1366,6 +1830,9 @@ type CoreDNS struct {
 	Autoscaling *CoreDNSAutoscaling
 	// Rewriting contains the setting related to rewriting of requests, which are obviously incorrect due to the unnecessary application of the search path.
 	Rewriting *CoreDNSRewriting
+	// CustomPlugins contains the settings of the optional plugins in the plugin chain of the Core DNS components
+	// running in the data plane of the Shoot cluster.
+	CustomPlugins *CoreDNSCustomPlugins
 }
 
 // CoreDNSAutoscaling contains the settings related to autoscaling of the Core DNS components running in the data plane of the Shoot cluster.
@@ -1391,6 +1858,34 @@ type CoreDNSRewriting struct {
 	CommonSuffixes []string
 }
 
+// CoreDNSCustomPlugins contains the settings of the optional plugins in the plugin chain of the Core DNS components.
+type CoreDNSCustomPlugins struct {
+	// Log indicates whether all DNS queries are logged. By default, only failed queries are logged.
+	Log *bool
+	// DNS64 contains the settings of the dns64 plugin which synthesizes AAAA records from A records. If unset, the
+	// plugin is disabled.
+	DNS64 *CoreDNSDNS64
+	// ForwardZones is a list of zones whose DNS queries are forwarded to dedicated upstream name servers instead of the
+	// name servers of the nodes.
+	ForwardZones []CoreDNSForwardZone
+}
+
+// CoreDNSDNS64 contains the settings of the dns64 plugin.
+type CoreDNSDNS64 struct {
+	// Prefix is the IPv6 prefix used to synthesize AAAA records. Its length must be one of 32, 40, 48, 56, 64 or 96.
+	// Defaults to the well-known prefix `64:ff9b::/96` if unset.
+	Prefix *string
+}
+
+// CoreDNSForwardZone contains the settings for forwarding the DNS queries of a zone.
+type CoreDNSForwardZone struct {
+	// Zone is the domain whose DNS queries are forwarded, e.g. `corp.example.com`.
+	Zone string
+	// Upstreams is the list of name servers the DNS queries are forwarded to. Each entry is an IP address with an
+	// optional port, e.g. `10.0.0.10` or `10.0.0.10:5353`. At most 15 upstreams are supported.
+	Upstreams []string
+}
+
 // NodeLocalDNS contains the settings of the node local DNS components running in the data plane of the Shoot cluster.
 type NodeLocalDNS struct {
 	// Enabled indicates whether node local DNS is enabled or not.
@@ -1405,7 +1900,47 @@ type NodeLocalDNS struct {
 	// Default, if unspecified, is to forward requests for external domains to upstream DNS
 	// +optional
 	DisableForwardToUpstreamDNS *bool
-}
+	// Cache contains the settings of the cache of node local DNS. If unset, successful responses are cached for up to 30
+	// seconds and negative responses for up to 5 seconds (`cluster.local`) or 30 seconds (all other zones).
+	Cache *NodeLocalDNSCache
+	// ZoneProtocols is a list of zones for which the protocol used by node local DNS to forward requests is overridden.
+	// Overrides take precedence over ForceTCPToClusterDNS and ForceTCPToUpstreamDNS.
+	ZoneProtocols []NodeLocalDNSZoneProtocol
+	// DisabledWorkerPools is a list of names of worker pools whose nodes do not run node local DNS. Pods on these nodes
+	// resolve DNS directly via the cluster DNS (Core DNS).
+	DisabledWorkerPools []string
+}
+
+// NodeLocalDNSCache contains the settings of the cache of node local DNS.
+type NodeLocalDNSCache struct {
+	// SuccessTTLSeconds is the maximum time in seconds successful responses are cached. Defaults to 30.
+	SuccessTTLSeconds *int32
+	// DenialTTLSeconds is the maximum time in seconds negative responses (NXDOMAIN, NODATA) are cached. Defaults to 5
+	// for `cluster.local` and 30 for all other zones. Must not be set if negative caching is disabled.
+	DenialTTLSeconds *int32
+	// DisableNegativeCaching indicates whether caching of negative responses is disabled. Defaults to false.
+	DisableNegativeCaching *bool
+}
+
+// NodeLocalDNSZoneProtocol contains the protocol used by node local DNS to forward requests of a zone.
+type NodeLocalDNSZoneProtocol struct {
+	// Zone is the zone served by node local DNS. Supported values are `cluster.local`, `in-addr.arpa`, `ip6.arpa` and `.`
+	// (all other domains).
+	Zone string
+	// Protocol is the protocol used to forward requests of the zone. Supported values are `TCP` (always use TCP) and
+	// `UDP` (prefer UDP and retry truncated responses with TCP).
+	Protocol NodeLocalDNSProtocol
+}
+
+// NodeLocalDNSProtocol is a type alias for the protocol used by node local DNS to forward requests.
+type NodeLocalDNSProtocol string
+
+const (
+	// NodeLocalDNSProtocolTCP is a constant for forwarding requests always via TCP.
+	NodeLocalDNSProtocolTCP NodeLocalDNSProtocol = "TCP"
+	// NodeLocalDNSProtocolUDP is a constant for forwarding requests preferably via UDP.
+	NodeLocalDNSProtocolUDP NodeLocalDNSProtocol = "UDP"
+)
 
 const (
 	// ShootEventImageVersionMaintenance indicates that a maintenance operation regarding the image version has been performed.
@@ -1416,6 +1951,8 @@ const (
 	ShootEventHibernationEnabled = "Hibernated"
 	// ShootEventHibernationDisabled indicates that hibernation ended.
 	ShootEventHibernationDisabled = "WokenUp"
+	// ShootEventHibernationSkipped indicates that a scheduled hibernation was skipped due to an exception.
+	ShootEventHibernationSkipped = "HibernationSkipped"
 	// ShootEventSchedulingSuccessful indicates that a scheduling decision was taken successfully.
 	ShootEventSchedulingSuccessful = "SchedulingSuccessful"
 	// ShootEventSchedulingFailed indicates that a scheduling decision failed.
@@ -1458,3 +1995,17 @@ const (
 	// ShootPurposeInfrastructure is a constant for the infrastructure purpose.
 	ShootPurposeInfrastructure ShootPurpose = "infrastructure"
 )
+
+// ShootSizeClass is a type alias for string.
+type ShootSizeClass string
+
+const (
+	// ShootSizeClassSmall is a constant for small shoot clusters.
+	ShootSizeClassSmall ShootSizeClass = "S"
+	// ShootSizeClassMedium is a constant for medium-sized shoot clusters.
+	ShootSizeClassMedium ShootSizeClass = "M"
+	// ShootSizeClassLarge is a constant for large shoot clusters.
+	ShootSizeClassLarge ShootSizeClass = "L"
+	// ShootSizeClassExtraLarge is a constant for extra-large shoot clusters.
+	ShootSizeClassExtraLarge ShootSizeClass = "XL"
+)
@@ -0,0 +1,49 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package core
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ShootClass represents a template for Shoot clusters, e.g., a t-shirt size, which encapsulates the worker pool layout,
+// the high availability settings and the hibernation defaults. Shoots can reference a ShootClass by name, its settings
+// are applied to the Shoot when it is created.
+type ShootClass struct {
+	metav1.TypeMeta
+	// Standard object metadata.
+	metav1.ObjectMeta
+	// Spec contains the settings of the ShootClass.
+	Spec ShootClassSpec
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ShootClassList is a collection of ShootClasses.
+type ShootClassList struct {
+	metav1.TypeMeta
+	// Standard list object metadata.
+	metav1.ListMeta
+	// Items is the list of ShootClasses.
+	Items []ShootClass
+}
+
+// ShootClassSpec contains the settings of a ShootClass.
+type ShootClassSpec struct {
+	// ProviderType restricts the usage of the ShootClass to Shoots of the given provider type. It must be set if worker
+	// pools are specified.
+	ProviderType *string
+	// Workers is the list of worker pools which is applied to Shoots not specifying any worker pools.
+	Workers []Worker
+	// ControlPlane contains the control plane settings (e.g., high availability) which are applied to Shoots not
+	// specifying any control plane settings.
+	ControlPlane *ControlPlane
+	// Hibernation contains the hibernation settings which are applied to Shoots not specifying any hibernation settings.
+	Hibernation *Hibernation
+}
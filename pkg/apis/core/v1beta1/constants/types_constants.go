@@ -174,6 +174,12 @@ const (
 	// ConfigMapNameShootInfo is the name of a ConfigMap in the kube-system namespace of shoot clusters which contains
 	// information about the shoot cluster.
 	ConfigMapNameShootInfo = "shoot-info"
+	// ConfigMapNameOrphanedBackupEntries is the name of a ConfigMap in the namespace of a seed in the garden cluster
+	// (seed-<name>) which reports the BackupEntries scheduled to the seed that do no longer belong to an existing shoot.
+	ConfigMapNameOrphanedBackupEntries = "orphaned-backupentries"
+	// ConfigMapNameOrphanedControlPlanes is the name of a ConfigMap in the garden namespace of seed clusters which
+	// reports the shoot control plane namespaces that do no longer belong to an existing shoot.
+	ConfigMapNameOrphanedControlPlanes = "orphaned-control-planes"
 
 	// StatefulSetNameAlertManager is a constant for the name of a Kubernetes stateful set object that contains
 	// the alertmanager pod.
@@ -255,6 +261,8 @@ const (
 	GardenRoleMonitoring = "monitoring"
 	// GardenRoleOptionalAddon is the value of the GardenRole key indicating type 'optional-addon'.
 	GardenRoleOptionalAddon = "optional-addon"
+	// GardenRoleManagedAddon is the value of the GardenRole key indicating type 'managed-addon'.
+	GardenRoleManagedAddon = "managed-addon"
 	// GardenRoleOperatingSystemConfig is the value of the GardenRole key indicating type 'operating-system-config'.
 	GardenRoleOperatingSystemConfig = "operating-system-config"
 	// GardenRoleKubeconfig is the value of the GardenRole key indicating type 'kubeconfig'.
@@ -320,6 +328,11 @@ const (
 	// new Go implementation of VPN.
 	// TODO(MartinWeindel) Remove after feature gate `NewVPN` gets promoted to GA.
 	ShootAlphaControlPlaneDisableNewVPN = "alpha.control-plane.shoot.gardener.cloud/disable-new-vpn"
+	// ShootAlphaRemoveLegacyComponents is a constant for an annotation on the Shoot resource containing a comma-separated
+	// list of legacy components (e.g., `logging`) which shall be removed from the shoot even if the respective feature
+	// gate is not enabled in gardenlet yet. It allows opting in to the removal for single shoots.
+	// Note that this annotation is alpha and can be removed anytime without further notice.
+	ShootAlphaRemoveLegacyComponents = "alpha.shoot.gardener.cloud/remove-legacy-components"
 	// ShootExpirationTimestamp is an annotation on a Shoot resource whose value represents the time when the Shoot lifetime
 	// is expired. The lifetime can be extended, but at most by the minimal value of the 'clusterLifetimeDays' property
 	// of referenced quotas.
@@ -356,6 +369,9 @@ const (
 	// ShootOperationRetry is a constant for an annotation on a Shoot indicating that a failed Shoot reconciliation shall be
 	// retried.
 	ShootOperationRetry = "retry"
+	// ShootOperationBackupNow is a constant for an annotation on a Shoot indicating that a full snapshot of the main etcd
+	// shall be taken immediately.
+	ShootOperationBackupNow = "backup-now"
 	// OperationRotateCredentialsStart is a constant for an annotation indicating that the rotation of all credentials
 	// shall be started. This includes CAs, certificates, kubeconfigs, SSH keypairs, observability credentials, and
 	// ServiceAccount signing key.
@@ -440,6 +456,9 @@ const (
 	// LabelSeedNetworkPrivate is used to specify that the seed is in private networks and not reachable from the garden
 	// cluster.
 	LabelSeedNetworkPrivate = "private"
+	// LabelSeedCapacity is used to identify ConfigMaps in the garden namespace of the seed cluster which report the
+	// capacity of resources of the seed.
+	LabelSeedCapacity = "seed.gardener.cloud/capacity"
 	// LabelKeyAggregateToProjectMember is a constant for a label on ClusterRoles that are aggregated to the project
 	// member ClusterRole.
 	LabelKeyAggregateToProjectMember = "rbac.gardener.cloud/aggregate-to-project-member"
@@ -503,6 +522,13 @@ const (
 	LabelNetworkPolicyShootToAPIServer = "networking.gardener.cloud/to-apiserver"
 	// LabelNetworkPolicyShootToKubelet allows Egress traffic to the kubelets.
 	LabelNetworkPolicyShootToKubelet = "networking.gardener.cloud/to-kubelet"
+	// LabelNetworkPolicyDefaultNetworkPolicies is a constant for a label on namespaces in the shoot cluster. When set to
+	// LabelNetworkPolicyDefaultNetworkPoliciesDisabled, the default NetworkPolicies configured in the namespace defaults
+	// of the Shoot are not applied to the namespace.
+	LabelNetworkPolicyDefaultNetworkPolicies = "networking.gardener.cloud/default-network-policies"
+	// LabelNetworkPolicyDefaultNetworkPoliciesDisabled is a constant for a label value which disables the default
+	// NetworkPolicies for a namespace.
+	LabelNetworkPolicyDefaultNetworkPoliciesDisabled = "disabled"
 	// LabelNetworkPolicyAllowed is a constant for allowing a network policy.
 	LabelNetworkPolicyAllowed = "allowed"
 	// LabelNetworkPolicyScrapeTargets is a constant for pod selector label which can be used on Services for components
@@ -646,6 +672,21 @@ const (
 	// AnnotationShootSkipCleanup is a key for an annotation on a Shoot resource that declares that the clean up steps should be skipped when the
 	// cluster is deleted. Concretely, this will skip everything except the deletion of (load balancer) services and persistent volume resources.
 	AnnotationShootSkipCleanup = "shoot.gardener.cloud/skip-cleanup"
+	// AnnotationSeedOutageMode is a key for an annotation on a Seed resource declaring that the seed is in a planned
+	// maintenance or outage state. As long as its value is "true", gardenlet reduces the work for the hosted Shoots to
+	// health-critical actions, i.e., it does not execute their reconciliation, migration, or deletion flows.
+	AnnotationSeedOutageMode = "seed.gardener.cloud/outage-mode"
+	// AnnotationShootObjectRecoveryNamespaces is a key for an annotation on a Shoot resource that instructs the shoot flow
+	// to recover the objects of the given comma-separated namespaces from the etcd backup. The recovered objects are
+	// stored in the `<shoot-name>.object-recovery` secret in the project namespace.
+	AnnotationShootObjectRecoveryNamespaces = "shoot.gardener.cloud/object-recovery-namespaces"
+	// AnnotationShootObjectRecoveryResources is a key for an annotation on a Shoot resource that restricts the object
+	// recovery to the given comma-separated resources, e.g. `configmaps,deployments`.
+	AnnotationShootObjectRecoveryResources = "shoot.gardener.cloud/object-recovery-resources"
+	// AnnotationShootObjectRecoverySnapshot is a key for an annotation on a Shoot resource that selects the etcd snapshot
+	// (the name of a full or delta snapshot in the backup bucket) whose state shall be recovered. If it is not set, the
+	// state of the latest snapshot is recovered.
+	AnnotationShootObjectRecoverySnapshot = "shoot.gardener.cloud/object-recovery-snapshot"
 	// AnnotationShootSkipReadiness is a key for an annotation on a Shoot resource that instructs the shoot flow to skip readiness steps during reconciliation.
 	AnnotationShootSkipReadiness = "shoot.gardener.cloud/skip-readiness"
 	// AnnotationShootCleanupWebhooksFinalizeGracePeriodSeconds is a key for an annotation on a Shoot resource that
@@ -670,6 +711,23 @@ const (
 	// Note that changing this value only applies to new nodes. Existing nodes which already computed their individual
 	// delays will not recompute it.
 	AnnotationShootCloudConfigExecutionMaxDelaySeconds = "shoot.gardener.cloud/cloud-config-execution-max-delay-seconds"
+	// AnnotationShootKubeAPIServerAccessLogSamplingPercentage is a key for an annotation on a Shoot resource that
+	// declares the percentage (1-100) of connections to the kube-apiserver which are logged by the istio ingress gateway
+	// of the seed. It is meant for operators to identify clients which overload the kube-apiserver without enabling the
+	// audit log. Invalid values are ignored.
+	AnnotationShootKubeAPIServerAccessLogSamplingPercentage = "shoot.gardener.cloud/kube-apiserver-access-log-sampling-percentage"
+	// AnnotationShootSkipDeprecatedFieldsMigration is a key for an annotation on a Shoot resource whose value must be
+	// set to "true" in order to opt out of the automatic migration of deprecated fields to their replacements performed
+	// by gardener-controller-manager.
+	AnnotationShootSkipDeprecatedFieldsMigration = "shoot.gardener.cloud/skip-deprecated-fields-migration"
+	// AnnotationShootCloneFrom is a key for an annotation on a Shoot resource which can be set on creation in order to
+	// copy the specification of another Shoot. Its value must be either `<namespace>/<name>` or `<name>` (for a Shoot in
+	// the same namespace).
+	AnnotationShootCloneFrom = "shoot.gardener.cloud/clone-from"
+	// AnnotationShootIngressDomainMigration is a key for an annotation on a Shoot resource which is set by
+	// gardener-controller-manager when it triggers the reconciliation of the Shoot for migrating it to the changed
+	// ingress domain of its Seed. Its value is the ingress domain the Shoot is migrated to.
+	AnnotationShootIngressDomainMigration = "shoot.gardener.cloud/ingress-domain-migration"
 
 	// AnnotationAuthenticationIssuer is the key for an annotation applied to a Shoot which specifies
 	// if the shoot's issuer is managed by Gardener.
@@ -687,6 +745,8 @@ const (
 	OperatingSystemConfigUnitNameContainerDService = "containerd.service"
 	// OperatingSystemConfigFilePathKernelSettings is a constant for a path to a file in the operating system config that contains some general kernel settings.
 	OperatingSystemConfigFilePathKernelSettings = "/etc/sysctl.d/99-k8s-general.conf"
+	// OperatingSystemConfigFilePathKernelModules is a constant for a path to a file in the operating system config that contains the kernel modules to load.
+	OperatingSystemConfigFilePathKernelModules = "/etc/modules-load.d/99-k8s-worker.conf"
 	// OperatingSystemConfigFilePathKubeletConfig is a constant for a path to a file in the operating system config that contains the kubelet configuration.
 	OperatingSystemConfigFilePathKubeletConfig = "/var/lib/kubelet/config/kubelet"
 	// OperatingSystemConfigUnitNameValitailService is a constant for a unit in the operating system config that contains the valitail service.
@@ -719,8 +779,25 @@ const (
 	LabelWorkerPoolDeprecated = "worker.garden.sapcloud.io/group"
 	// LabelWorkerPoolSystemComponents is a constant that indicates whether the worker pool should host system components
 	LabelWorkerPoolSystemComponents = "worker.gardener.cloud/system-components"
+	// TaintWorkerPoolSystemComponentsDedicated is a constant for a taint key that is added to the nodes of the worker
+	// pool which is dedicated to host system components (see `.spec.systemComponents.dedicatedPool` in the Shoot API).
+	TaintWorkerPoolSystemComponentsDedicated = "worker.gardener.cloud/system-components-dedicated"
+	// LabelWorkerPoolSystemComponentsReservedNodesPercentage is a constant for a label on nodes which contains the
+	// percentage of nodes of the worker pool which are reserved for system components (see
+	// `.spec.provider.workers[].systemComponents.reservedNodesPercentage` in the Shoot API).
+	LabelWorkerPoolSystemComponentsReservedNodesPercentage = "worker.gardener.cloud/system-components-reserved-nodes-percentage"
+	// LabelWorkerPoolSystemComponentsReserved is a constant for a label (and a taint key) which is added to the nodes
+	// reserved for system components by gardener-resource-manager.
+	LabelWorkerPoolSystemComponentsReserved = "worker.gardener.cloud/system-components-reserved"
 	// LabelWorkerPoolGardenerNodeAgentSecretName is the name of the secret used by the gardener node agent
 	LabelWorkerPoolGardenerNodeAgentSecretName = "worker.gardener.cloud/gardener-node-agent-secret-name"
+	// AnnotationInPlaceUpdateDesiredHash is a constant for an annotation on a Node which is set by the worker actuator
+	// when the node is selected for an in-place update. Its value is the hash of the desired machine image and Kubernetes
+	// version of the worker pool.
+	AnnotationInPlaceUpdateDesiredHash = "worker.gardener.cloud/in-place-update-desired-hash"
+	// AnnotationInPlaceUpdateCurrentHash is a constant for an annotation on a Node which is set by gardener-node-agent
+	// after it has applied the in-place update requested via AnnotationInPlaceUpdateDesiredHash.
+	AnnotationInPlaceUpdateCurrentHash = "worker.gardener.cloud/in-place-update-current-hash"
 
 	// EventResourceReferenced indicates that the resource deletion is in waiting mode because the resource is still
 	// being referenced by at least one other resource (e.g. a SecretBinding is still referenced by a Shoot)
@@ -766,6 +843,9 @@ const (
 	// skipped by the stale project controller. If the project has already configured stale timestamps in its status
 	// then they will be reset.
 	ProjectSkipStaleCheck = "project.gardener.cloud/skip-stale-check"
+	// ProjectSyncedMemberGroups is the key of an annotation on a project whose value holds the comma-separated list of
+	// group members which are managed by the project member sync controller.
+	ProjectSyncedMemberGroups = "project.gardener.cloud/synced-member-groups"
 	// NamespaceProject is the key of an annotation on namespace whose value holds the project uid.
 	NamespaceProject = "namespace.gardener.cloud/project"
 	// NamespaceKeepAfterProjectDeletion is a constant for an annotation on a `Namespace` resource that states that it
@@ -804,6 +884,11 @@ const (
 	// ArchitectureARM64 is a constant for the 'arm64' architecture.
 	ArchitectureARM64 = "arm64"
 
+	// OSFamilyLinux is a constant for the 'linux' operating system family.
+	OSFamilyLinux = "linux"
+	// OSFamilyWindows is a constant for the 'windows' operating system family.
+	OSFamilyWindows = "windows"
+
 	// EnvGenericGardenKubeconfig is a constant for the environment variable which holds the path to the generic garden kubeconfig.
 	EnvGenericGardenKubeconfig = "GARDEN_KUBECONFIG"
 	// EnvSeedName is a constant for the environment variable which holds the name of the Seed that the extension
@@ -847,6 +932,12 @@ var (
 		ArchitectureAMD64,
 		ArchitectureARM64,
 	}
+
+	// ValidOSFamilies contains all operating system families which are supported by the Shoot.
+	ValidOSFamilies = []string{
+		OSFamilyLinux,
+		OSFamilyWindows,
+	}
 )
 
 // constants for well-known PriorityClass names
@@ -6,6 +6,7 @@ package v1beta1
 
 import (
 	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/utils/ptr"
 
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 )
@@ -49,3 +50,10 @@ func SetDefaults_ProjectMember(obj *ProjectMember) {
 		obj.Role = ProjectMemberViewer
 	}
 }
+
+// SetDefaults_TrustedIdentityProvider sets default values for TrustedIdentityProvider objects.
+func SetDefaults_TrustedIdentityProvider(obj *TrustedIdentityProvider) {
+	if obj.Access == nil {
+		obj.Access = ptr.To(TrustedIdentityProviderAccessViewer)
+	}
+}
@@ -7,6 +7,7 @@ package v1beta1_test
 import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
 	rbacv1 "k8s.io/api/rbac/v1"
 	"k8s.io/utils/ptr"
 
@@ -160,4 +161,18 @@ var _ = Describe("Project defaulting", func() {
 			}
 		})
 	})
+
+	Describe("trusted identity provider defaulting", func() {
+		It("should default the access of trusted identity providers", func() {
+			obj.Spec.TrustedIdentityProviders = []TrustedIdentityProvider{
+				{Name: "foo"},
+				{Name: "bar", Access: ptr.To(TrustedIdentityProviderAccessAdmin)},
+			}
+
+			SetObjectDefaults_Project(obj)
+
+			Expect(obj.Spec.TrustedIdentityProviders[0].Access).To(PointTo(Equal(TrustedIdentityProviderAccessViewer)))
+			Expect(obj.Spec.TrustedIdentityProviders[1].Access).To(PointTo(Equal(TrustedIdentityProviderAccessAdmin)))
+		})
+	})
 })
@@ -52,6 +52,10 @@ func SetDefaults_Shoot(obj *Shoot) {
 			obj.Spec.Provider.Workers[i].Machine.Architecture = ptr.To(v1beta1constants.ArchitectureAMD64)
 		}
 
+		if worker.Machine.OSFamily == nil {
+			obj.Spec.Provider.Workers[i].Machine.OSFamily = ptr.To(v1beta1constants.OSFamilyLinux)
+		}
+
 		if worker.CRI == nil {
 			obj.Spec.Provider.Workers[i].CRI = &CRI{Name: CRINameContainerD}
 		}
@@ -170,6 +174,15 @@ func SetDefaults_Shoot(obj *Shoot) {
 		if obj.Spec.SystemComponents.CoreDNS.Autoscaling.Mode != CoreDNSAutoscalingModeHorizontal && obj.Spec.SystemComponents.CoreDNS.Autoscaling.Mode != CoreDNSAutoscalingModeClusterProportional {
 			obj.Spec.SystemComponents.CoreDNS.Autoscaling.Mode = CoreDNSAutoscalingModeHorizontal
 		}
+
+		// If a worker pool is dedicated to system components, then no other worker pool is allowed to host them.
+		if dedicatedPool := obj.Spec.SystemComponents.DedicatedPool; dedicatedPool != nil {
+			for i, worker := range obj.Spec.Provider.Workers {
+				if worker.SystemComponents == nil {
+					obj.Spec.Provider.Workers[i].SystemComponents = &WorkerSystemComponents{Allow: worker.Name == *dedicatedPool}
+				}
+			}
+		}
 	}
 
 	if obj.Spec.SchedulerName == nil {
@@ -701,6 +701,19 @@ var _ = Describe("Shoot defaulting", func() {
 		Expect(*obj.Spec.Provider.Workers[1].Machine.Architecture).To(Equal("test"))
 	})
 
+	It("should default OS family of worker's machine to linux", func() {
+		obj.Spec.Provider.Workers = []Worker{
+			{Name: "Default Worker"},
+			{Name: "Windows Worker",
+				Machine: Machine{OSFamily: ptr.To(v1beta1constants.OSFamilyWindows)}},
+		}
+
+		SetObjectDefaults_Shoot(obj)
+
+		Expect(*obj.Spec.Provider.Workers[0].Machine.OSFamily).To(Equal(v1beta1constants.OSFamilyLinux))
+		Expect(*obj.Spec.Provider.Workers[1].Machine.OSFamily).To(Equal(v1beta1constants.OSFamilyWindows))
+	})
+
 	It("should default worker cri.name to containerd", func() {
 		obj.Spec.Provider.Workers = []Worker{
 			{Name: "DefaultWorker"},
@@ -754,6 +767,21 @@ var _ = Describe("Shoot defaulting", func() {
 			Expect(obj.Spec.SystemComponents.CoreDNS.Autoscaling.Mode).To(Equal(CoreDNSAutoscalingModeHorizontal))
 		})
 
+		It("should only allow system components on the dedicated worker pool", func() {
+			obj.Spec.SystemComponents = &SystemComponents{DedicatedPool: ptr.To("system")}
+			obj.Spec.Provider.Workers = []Worker{
+				{Name: "system"},
+				{Name: "user"},
+				{Name: "other", SystemComponents: &WorkerSystemComponents{Allow: true}},
+			}
+
+			SetObjectDefaults_Shoot(obj)
+
+			Expect(obj.Spec.Provider.Workers[0].SystemComponents).To(Equal(&WorkerSystemComponents{Allow: true}))
+			Expect(obj.Spec.Provider.Workers[1].SystemComponents).To(Equal(&WorkerSystemComponents{Allow: false}))
+			Expect(obj.Spec.Provider.Workers[2].SystemComponents).To(Equal(&WorkerSystemComponents{Allow: true}))
+		})
+
 		It("should not default the system components for workerless Shoot", func() {
 			obj.Spec.Provider.Workers = nil
 
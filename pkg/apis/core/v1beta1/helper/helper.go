@@ -23,6 +23,7 @@ import (
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/utils/timewindow"
 	versionutils "github.com/gardener/gardener/pkg/utils/version"
 )
 
@@ -98,14 +99,34 @@ func TaintsHave(taints []gardencorev1beta1.SeedTaint, key string) bool {
 	return false
 }
 
-// TaintsAreTolerated returns true when all the given taints are tolerated by the given tolerations.
+// TaintsAreTolerated returns true when all the given taints are tolerated by the given tolerations. Taints with the
+// PreferNoSchedule effect are ignored since they do not prevent using the seed.
 func TaintsAreTolerated(taints []gardencorev1beta1.SeedTaint, tolerations []gardencorev1beta1.Toleration) bool {
+	return taintsAreTolerated(taints, tolerations, func(effect gardencorev1beta1.SeedTaintEffect) bool {
+		return effect != gardencorev1beta1.SeedTaintEffectPreferNoSchedule
+	})
+}
+
+// PreferNoScheduleTaintsAreTolerated returns true when all the given taints with the PreferNoSchedule effect are
+// tolerated by the given tolerations.
+func PreferNoScheduleTaintsAreTolerated(taints []gardencorev1beta1.SeedTaint, tolerations []gardencorev1beta1.Toleration) bool {
+	return taintsAreTolerated(taints, tolerations, func(effect gardencorev1beta1.SeedTaintEffect) bool {
+		return effect == gardencorev1beta1.SeedTaintEffectPreferNoSchedule
+	})
+}
+
+// TaintEffect returns the effect of the given taint. Taints without an explicit effect have the NoSchedule effect.
+func TaintEffect(taint gardencorev1beta1.SeedTaint) gardencorev1beta1.SeedTaintEffect {
+	if taint.Effect == nil {
+		return gardencorev1beta1.SeedTaintEffectNoSchedule
+	}
+	return *taint.Effect
+}
+
+func taintsAreTolerated(taints []gardencorev1beta1.SeedTaint, tolerations []gardencorev1beta1.Toleration, considerEffect func(gardencorev1beta1.SeedTaintEffect) bool) bool {
 	if len(taints) == 0 {
 		return true
 	}
-	if len(taints) > len(tolerations) {
-		return false
-	}
 
 	tolerationKeyValues := make(map[string]string, len(tolerations))
 	for _, toleration := range tolerations {
@@ -117,6 +138,10 @@ func TaintsAreTolerated(taints []gardencorev1beta1.SeedTaint, tolerations []gard
 	}
 
 	for _, taint := range taints {
+		if !considerEffect(TaintEffect(taint)) {
+			continue
+		}
+
 		tolerationValue, ok := tolerationKeyValues[taint.Key]
 		if !ok {
 			return false
@@ -152,6 +177,81 @@ func AccessRestrictionsAreSupported(seedAccessRestrictions []gardencorev1beta1.A
 	return true
 }
 
+// GetRegionMetadata returns the metadata of the region with the given name in the given CloudProfile spec. It returns
+// nil if the region does not exist or does not have metadata.
+func GetRegionMetadata(cloudProfileSpec *gardencorev1beta1.CloudProfileSpec, regionName string) *gardencorev1beta1.RegionMetadata {
+	if cloudProfileSpec == nil {
+		return nil
+	}
+
+	for _, region := range cloudProfileSpec.Regions {
+		if region.Name == regionName {
+			return region.Metadata
+		}
+	}
+
+	return nil
+}
+
+// GetSeedRegionMetadata returns the metadata of the region of the given seed. If the seed does not specify region
+// metadata, the metadata of the region in the given CloudProfile spec is returned if the provider types match.
+func GetSeedRegionMetadata(seed *gardencorev1beta1.Seed, cloudProfileSpec *gardencorev1beta1.CloudProfileSpec) *gardencorev1beta1.RegionMetadata {
+	if seed.Spec.Provider.RegionMetadata != nil {
+		return seed.Spec.Provider.RegionMetadata
+	}
+
+	if cloudProfileSpec != nil && cloudProfileSpec.Type == seed.Spec.Provider.Type {
+		return GetRegionMetadata(cloudProfileSpec, seed.Spec.Provider.Region)
+	}
+
+	return nil
+}
+
+// DataResidencyIsSatisfied returns true when the region with the given metadata satisfies the given data residency
+// constraints. Regions without metadata do not satisfy any constraint.
+func DataResidencyIsSatisfied(regionMetadata *gardencorev1beta1.RegionMetadata, dataResidency *gardencorev1beta1.DataResidency) bool {
+	if dataResidency == nil {
+		return true
+	}
+
+	if len(dataResidency.Jurisdictions) > 0 &&
+		(regionMetadata == nil || regionMetadata.Jurisdiction == nil || !slices.Contains(dataResidency.Jurisdictions, *regionMetadata.Jurisdiction)) {
+		return false
+	}
+
+	if len(dataResidency.Geographies) > 0 &&
+		(regionMetadata == nil || regionMetadata.Geography == nil || !slices.Contains(dataResidency.Geographies, *regionMetadata.Geography)) {
+		return false
+	}
+
+	return true
+}
+
+// CheckETCDFullSnapshotSchedule checks whether the given full snapshot schedule of a shoot's etcd satisfies the minimum
+// full snapshot interval in the given etcd backup bounds of a seed.
+func CheckETCDFullSnapshotSchedule(bounds *gardencorev1beta1.SeedBackupETCD, schedule string) error {
+	interval, err := timewindow.MinimumScheduleInterval(schedule)
+	if err != nil {
+		return err
+	}
+
+	if bounds != nil && bounds.MinFullSnapshotInterval != nil && interval < bounds.MinFullSnapshotInterval.Duration {
+		return fmt.Errorf("schedule %q takes full snapshots in intervals of %s, but the seed requires intervals of at least %s", schedule, interval, bounds.MinFullSnapshotInterval.Duration)
+	}
+
+	return nil
+}
+
+// CheckETCDDeltaSnapshotRetentionPeriod checks whether the given delta snapshot retention period of a shoot's etcd
+// does not exceed the maximum delta snapshot retention period in the given etcd backup bounds of a seed.
+func CheckETCDDeltaSnapshotRetentionPeriod(bounds *gardencorev1beta1.SeedBackupETCD, retentionPeriod time.Duration) error {
+	if bounds != nil && bounds.MaxDeltaSnapshotRetentionPeriod != nil && retentionPeriod > bounds.MaxDeltaSnapshotRetentionPeriod.Duration {
+		return fmt.Errorf("retention period %s exceeds the maximum retention period %s of the seed", retentionPeriod, bounds.MaxDeltaSnapshotRetentionPeriod.Duration)
+	}
+
+	return nil
+}
+
 // ManagedSeedAPIServer contains the configuration of a ManagedSeed API server.
 type ManagedSeedAPIServer struct {
 	Replicas   *int32
@@ -365,6 +465,15 @@ func ShootIgnoresAlerts(shoot *gardencorev1beta1.Shoot) bool {
 	return ignore
 }
 
+// SeedOutageModeActive checks if the given seed is annotated to be in outage mode.
+func SeedOutageModeActive(seed *gardencorev1beta1.Seed) bool {
+	active := false
+	if value, ok := seed.Annotations[v1beta1constants.AnnotationSeedOutageMode]; ok {
+		active, _ = strconv.ParseBool(value)
+	}
+	return active
+}
+
 // ShootWantsAlertManager checks if the given shoot specification requires an alert manager.
 func ShootWantsAlertManager(shoot *gardencorev1beta1.Shoot) bool {
 	return !ShootIgnoresAlerts(shoot) && shoot.Spec.Monitoring != nil && shoot.Spec.Monitoring.Alerting != nil && len(shoot.Spec.Monitoring.Alerting.EmailReceivers) > 0
@@ -403,6 +512,28 @@ func ShootConfinesSpecUpdateRollout(maintenance *gardencorev1beta1.Maintenance)
 	return maintenance != nil && maintenance.ConfineSpecUpdateRollout != nil && *maintenance.ConfineSpecUpdateRollout
 }
 
+// ActiveMaintenanceExclusion returns the maintenance exclusion which is active at the given time and which excludes the
+// given operation. If multiple such exclusions are active, the one ending last is returned. It returns nil if the given
+// operation is not excluded at the given time.
+func ActiveMaintenanceExclusion(maintenance *gardencorev1beta1.Maintenance, operation gardencorev1beta1.MaintenanceExclusionOperation, now time.Time) *gardencorev1beta1.MaintenanceExclusion {
+	if maintenance == nil {
+		return nil
+	}
+
+	var active *gardencorev1beta1.MaintenanceExclusion
+	for i, exclusion := range maintenance.Exclusions {
+		if now.Before(exclusion.Begin.Time) || !now.Before(exclusion.End.Time) || !slices.Contains(exclusion.Operations, operation) {
+			continue
+		}
+
+		if active == nil || exclusion.End.After(active.End.Time) {
+			active = &maintenance.Exclusions[i]
+		}
+	}
+
+	return active
+}
+
 // SeedSettingExcessCapacityReservationEnabled returns true if the 'excess capacity reservation' setting is enabled.
 func SeedSettingExcessCapacityReservationEnabled(settings *gardencorev1beta1.SeedSettings) bool {
 	return settings == nil || settings.ExcessCapacityReservation == nil || ptr.Deref(settings.ExcessCapacityReservation.Enabled, true)
@@ -480,6 +611,26 @@ func ToExpirableVersions(versions []gardencorev1beta1.MachineImageVersion) []gar
 	return expVersions
 }
 
+// FindAddonDefinition tries to find the addon definition with the given name. If it cannot be found it returns nil.
+func FindAddonDefinition(addons []gardencorev1beta1.AddonDefinition, name string) *gardencorev1beta1.AddonDefinition {
+	for _, addon := range addons {
+		if addon.Name == name {
+			return &addon
+		}
+	}
+	return nil
+}
+
+// FindAddonVersion tries to find the addon version with the given version. If it cannot be found it returns nil.
+func FindAddonVersion(versions []gardencorev1beta1.AddonVersion, version string) *gardencorev1beta1.AddonVersion {
+	for _, v := range versions {
+		if v.Version == version {
+			return &v
+		}
+	}
+	return nil
+}
+
 // FindMachineTypeByName tries to find the machine type details with the given name. If it cannot be found it returns nil.
 func FindMachineTypeByName(machines []gardencorev1beta1.MachineType, name string) *gardencorev1beta1.MachineType {
 	for _, m := range machines {
@@ -490,11 +641,62 @@ func FindMachineTypeByName(machines []gardencorev1beta1.MachineType, name string
 	return nil
 }
 
+// MachineTypeSupportsCapacityType checks if the given machine type supports the given capacity type. Machine types
+// without capacity types only support on-demand capacity. Spot capacity with fallback requires both spot and on-demand
+// capacity.
+func MachineTypeSupportsCapacityType(machineType gardencorev1beta1.MachineType, capacityType gardencorev1beta1.CapacityType) bool {
+	supportsOnDemand := len(machineType.CapacityTypes) == 0 || slices.Contains(machineType.CapacityTypes, gardencorev1beta1.CapacityTypeOnDemand)
+
+	switch capacityType {
+	case gardencorev1beta1.CapacityTypeOnDemand:
+		return supportsOnDemand
+	case gardencorev1beta1.CapacityTypeSpot:
+		return slices.Contains(machineType.CapacityTypes, gardencorev1beta1.CapacityTypeSpot)
+	case gardencorev1beta1.CapacityTypeSpotWithFallback:
+		return supportsOnDemand && slices.Contains(machineType.CapacityTypes, gardencorev1beta1.CapacityTypeSpot)
+	}
+
+	return false
+}
+
+// IsTypeAvailableInRegion checks if a machine or volume type with the given region availability can be used in the
+// given region and zones. Types without region availability are available everywhere.
+func IsTypeAvailableInRegion(availability []gardencorev1beta1.TypeRegionAvailability, region string, zones []string) bool {
+	if len(availability) == 0 {
+		return true
+	}
+
+	for _, r := range availability {
+		if r.Name != region {
+			continue
+		}
+
+		if len(r.Zones) == 0 {
+			return true
+		}
+
+		for _, zone := range zones {
+			if !slices.Contains(r.Zones, zone) {
+				return false
+			}
+		}
+		return true
+	}
+
+	return false
+}
+
 // SystemComponentsAllowed checks if the given worker allows system components to be scheduled onto it
 func SystemComponentsAllowed(worker *gardencorev1beta1.Worker) bool {
 	return worker.SystemComponents == nil || worker.SystemComponents.Allow
 }
 
+// NodeTemplateEnforced checks if the labels, annotations and taints of the given worker should be continuously
+// reconciled on the existing nodes.
+func NodeTemplateEnforced(worker *gardencorev1beta1.Worker) bool {
+	return worker.NodeTemplate != nil && ptr.Deref(worker.NodeTemplate.EnforceLabels, false)
+}
+
 // KubernetesVersionExistsInCloudProfile checks if the given Kubernetes version exists in the CloudProfile
 func KubernetesVersionExistsInCloudProfile(cloudProfile *gardencorev1beta1.CloudProfile, currentVersion string) (bool, gardencorev1beta1.ExpirableVersion, error) {
 	for _, version := range cloudProfile.Spec.Kubernetes.Versions {
@@ -1144,6 +1346,14 @@ func GetShootAuditPolicyConfigMapRef(apiServerConfig *gardencorev1beta1.KubeAPIS
 	return nil
 }
 
+// GetShootAuditSink returns the Shoot's audit sink configuration.
+func GetShootAuditSink(apiServerConfig *gardencorev1beta1.KubeAPIServerConfig) *gardencorev1beta1.AuditSink {
+	if apiServerConfig != nil && apiServerConfig.AuditConfig != nil {
+		return apiServerConfig.AuditConfig.Sink
+	}
+	return nil
+}
+
 // GetShootAuthenticationConfigurationConfigMapName returns the Shoot's ConfigMap reference name for the authentication
 // configuration.
 func GetShootAuthenticationConfigurationConfigMapName(apiServerConfig *gardencorev1beta1.KubeAPIServerConfig) string {
@@ -1153,6 +1363,15 @@ func GetShootAuthenticationConfigurationConfigMapName(apiServerConfig *gardencor
 	return ""
 }
 
+// GetShootAPIPriorityAndFairnessConfigMapName returns the Shoot's ConfigMap reference name for the custom API Priority
+// and Fairness configurations.
+func GetShootAPIPriorityAndFairnessConfigMapName(apiServerConfig *gardencorev1beta1.KubeAPIServerConfig) string {
+	if apiServerConfig != nil && apiServerConfig.APIPriorityAndFairness != nil {
+		return ptr.Deref(apiServerConfig.APIPriorityAndFairness.ConfigMapName, "")
+	}
+	return ""
+}
+
 // GetShootAuthorizationConfigurationConfigMapName returns the Shoot's ConfigMap reference name for the authorization
 // configuration.
 func GetShootAuthorizationConfigurationConfigMapName(apiServerConfig *gardencorev1beta1.KubeAPIServerConfig) string {
@@ -1203,6 +1422,29 @@ func CalculateSeedUsage(shootList []*gardencorev1beta1.Shoot) map[string]int {
 	return m
 }
 
+// CalculateWeightedSeedUsage returns a map representing the sum of the size class weights (see ShootSizeClassWeight) of
+// all shoots per seed from the given list of shoots. It takes both spec.seedName and status.seedName into account.
+func CalculateWeightedSeedUsage(shootList []*gardencorev1beta1.Shoot) map[string]int64 {
+	m := map[string]int64{}
+
+	for _, shoot := range shootList {
+		var (
+			specSeed   = ptr.Deref(shoot.Spec.SeedName, "")
+			statusSeed = ptr.Deref(shoot.Status.SeedName, "")
+			weight     = ShootSizeClassWeight(GetShootSizeClass(shoot))
+		)
+
+		if specSeed != "" {
+			m[specSeed] += weight
+		}
+		if statusSeed != "" && specSeed != statusSeed {
+			m[statusSeed] += weight
+		}
+	}
+
+	return m
+}
+
 // CalculateEffectiveKubernetesVersion if a shoot has kubernetes version specified by worker group, return this,
 // otherwise the shoot kubernetes version
 func CalculateEffectiveKubernetesVersion(controlPlaneVersion *semver.Version, workerKubernetes *gardencorev1beta1.WorkerKubernetes) (*semver.Version, error) {
@@ -1279,6 +1521,18 @@ func IsNodeLocalDNSEnabled(systemComponents *gardencorev1beta1.SystemComponents)
 	return systemComponents != nil && systemComponents.NodeLocalDNS != nil && systemComponents.NodeLocalDNS.Enabled
 }
 
+// IsNodeLocalDNSDisabledForWorkerPool indicates whether the node local DNS cache is explicitly disabled for the worker
+// pool with the given name.
+func IsNodeLocalDNSDisabledForWorkerPool(systemComponents *gardencorev1beta1.SystemComponents, workerPoolName string) bool {
+	return systemComponents != nil && systemComponents.NodeLocalDNS != nil && slices.Contains(systemComponents.NodeLocalDNS.DisabledWorkerPools, workerPoolName)
+}
+
+// IsSystemComponentsDedicatedPool indicates whether the worker pool with the given name is dedicated to host the
+// system components.
+func IsSystemComponentsDedicatedPool(systemComponents *gardencorev1beta1.SystemComponents, workerPoolName string) bool {
+	return systemComponents != nil && ptr.Deref(systemComponents.DedicatedPool, "") == workerPoolName
+}
+
 // GetNodeLocalDNS returns a pointer to the NodeLocalDNS spec.
 func GetNodeLocalDNS(systemComponents *gardencorev1beta1.SystemComponents) *gardencorev1beta1.NodeLocalDNS {
 	if systemComponents != nil {
@@ -1315,6 +1569,34 @@ func MutateShootCARotation(shoot *gardencorev1beta1.Shoot, f func(*gardencorev1b
 	f(shoot.Status.Credentials.Rotation.CertificateAuthorities)
 }
 
+// GetSeedCARotationPhase returns the specified seed CA rotation phase or an empty string.
+func GetSeedCARotationPhase(credentials *gardencorev1beta1.SeedCredentials) gardencorev1beta1.CredentialsRotationPhase {
+	if credentials != nil && credentials.Rotation != nil && credentials.Rotation.CertificateAuthorities != nil {
+		return credentials.Rotation.CertificateAuthorities.Phase
+	}
+	return ""
+}
+
+// MutateSeedCARotation mutates the .status.credentials.rotation.certificateAuthorities field based on the provided
+// mutation function. If the field is nil then it is initialized.
+func MutateSeedCARotation(seed *gardencorev1beta1.Seed, f func(*gardencorev1beta1.CARotation)) {
+	if f == nil {
+		return
+	}
+
+	if seed.Status.Credentials == nil {
+		seed.Status.Credentials = &gardencorev1beta1.SeedCredentials{}
+	}
+	if seed.Status.Credentials.Rotation == nil {
+		seed.Status.Credentials.Rotation = &gardencorev1beta1.SeedCredentialsRotation{}
+	}
+	if seed.Status.Credentials.Rotation.CertificateAuthorities == nil {
+		seed.Status.Credentials.Rotation.CertificateAuthorities = &gardencorev1beta1.CARotation{}
+	}
+
+	f(seed.Status.Credentials.Rotation.CertificateAuthorities)
+}
+
 // MutateShootKubeconfigRotation mutates the .status.credentials.rotation.kubeconfig field based on the provided
 // mutation function. If the field is nil then it is initialized.
 func MutateShootKubeconfigRotation(shoot *gardencorev1beta1.Shoot, f func(*gardencorev1beta1.ShootKubeconfigRotation)) {
@@ -1502,6 +1784,11 @@ func IsHAControlPlaneConfigured(shoot *gardencorev1beta1.Shoot) bool {
 	return shoot.Spec.ControlPlane != nil && shoot.Spec.ControlPlane.HighAvailability != nil
 }
 
+// IsControlPlaneEgressDedicated returns true if a dedicated egress for the shoot control plane has been configured.
+func IsControlPlaneEgressDedicated(shoot *gardencorev1beta1.Shoot) bool {
+	return shoot.Spec.ControlPlane != nil && shoot.Spec.ControlPlane.Egress != nil && shoot.Spec.ControlPlane.Egress.Dedicated
+}
+
 // IsMultiZonalShootControlPlane checks if the shoot should have a multi-zonal control plane.
 func IsMultiZonalShootControlPlane(shoot *gardencorev1beta1.Shoot) bool {
 	return shoot.Spec.ControlPlane != nil && shoot.Spec.ControlPlane.HighAvailability != nil && shoot.Spec.ControlPlane.HighAvailability.FailureTolerance.Type == gardencorev1beta1.FailureToleranceTypeZone
@@ -1621,3 +1908,66 @@ func sumQuantities(left, right *resource.Quantity) *resource.Quantity {
 	copy.Add(*right)
 	return &copy
 }
+
+// ComputeShootSizeClass computes the size class of a shoot based on its number of nodes and the average rate of requests
+// per second to its API server. The larger of the classes determined by both criteria is returned.
+func ComputeShootSizeClass(nodeCount int, requestsPerSecond float64) gardencorev1beta1.ShootSizeClass {
+	var nodesClass, requestsClass gardencorev1beta1.ShootSizeClass
+
+	switch {
+	case nodeCount <= 10:
+		nodesClass = gardencorev1beta1.ShootSizeClassSmall
+	case nodeCount <= 50:
+		nodesClass = gardencorev1beta1.ShootSizeClassMedium
+	case nodeCount <= 200:
+		nodesClass = gardencorev1beta1.ShootSizeClassLarge
+	default:
+		nodesClass = gardencorev1beta1.ShootSizeClassExtraLarge
+	}
+
+	switch {
+	case requestsPerSecond <= 25:
+		requestsClass = gardencorev1beta1.ShootSizeClassSmall
+	case requestsPerSecond <= 100:
+		requestsClass = gardencorev1beta1.ShootSizeClassMedium
+	case requestsPerSecond <= 500:
+		requestsClass = gardencorev1beta1.ShootSizeClassLarge
+	default:
+		requestsClass = gardencorev1beta1.ShootSizeClassExtraLarge
+	}
+
+	if ShootSizeClassWeight(requestsClass) > ShootSizeClassWeight(nodesClass) {
+		return requestsClass
+	}
+	return nodesClass
+}
+
+// GetShootSizeClass returns the size class of the given shoot. If it was not computed yet, it is derived from the
+// maximum number of nodes of all worker pools.
+func GetShootSizeClass(shoot *gardencorev1beta1.Shoot) gardencorev1beta1.ShootSizeClass {
+	if shoot.Status.SizeClass != nil {
+		return *shoot.Status.SizeClass
+	}
+
+	var maxNodes int
+	for _, worker := range shoot.Spec.Provider.Workers {
+		maxNodes += int(worker.Maximum)
+	}
+
+	return ComputeShootSizeClass(maxNodes, 0)
+}
+
+// ShootSizeClassWeight returns the relative weight of the given size class, i.e., each class weighs twice as much as the
+// next smaller class. Unknown size classes weigh as much as the smallest class.
+func ShootSizeClassWeight(sizeClass gardencorev1beta1.ShootSizeClass) int64 {
+	switch sizeClass {
+	case gardencorev1beta1.ShootSizeClassMedium:
+		return 2
+	case gardencorev1beta1.ShootSizeClassLarge:
+		return 4
+	case gardencorev1beta1.ShootSizeClassExtraLarge:
+		return 8
+	default:
+		return 1
+	}
+}
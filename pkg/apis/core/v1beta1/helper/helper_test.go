@@ -235,6 +235,33 @@ var _ = Describe("Helper", func() {
 		Entry("worker found", []gardencorev1beta1.MachineType{{Name: "foo"}}, "foo", &gardencorev1beta1.MachineType{Name: "foo"}),
 	)
 
+	DescribeTable("#MachineTypeSupportsCapacityType",
+		func(capacityTypes []gardencorev1beta1.CapacityType, capacityType gardencorev1beta1.CapacityType, expectation bool) {
+			Expect(MachineTypeSupportsCapacityType(gardencorev1beta1.MachineType{Name: "foo", CapacityTypes: capacityTypes}, capacityType)).To(Equal(expectation))
+		},
+
+		Entry("on-demand without capacity types", nil, gardencorev1beta1.CapacityTypeOnDemand, true),
+		Entry("spot without capacity types", nil, gardencorev1beta1.CapacityTypeSpot, false),
+		Entry("spot with fallback without capacity types", nil, gardencorev1beta1.CapacityTypeSpotWithFallback, false),
+		Entry("on-demand with spot only", []gardencorev1beta1.CapacityType{gardencorev1beta1.CapacityTypeSpot}, gardencorev1beta1.CapacityTypeOnDemand, false),
+		Entry("spot with spot only", []gardencorev1beta1.CapacityType{gardencorev1beta1.CapacityTypeSpot}, gardencorev1beta1.CapacityTypeSpot, true),
+		Entry("spot with fallback with spot only", []gardencorev1beta1.CapacityType{gardencorev1beta1.CapacityTypeSpot}, gardencorev1beta1.CapacityTypeSpotWithFallback, false),
+		Entry("spot with fallback with spot and on-demand", []gardencorev1beta1.CapacityType{gardencorev1beta1.CapacityTypeOnDemand, gardencorev1beta1.CapacityTypeSpot}, gardencorev1beta1.CapacityTypeSpotWithFallback, true),
+		Entry("unknown capacity type", []gardencorev1beta1.CapacityType{gardencorev1beta1.CapacityTypeOnDemand}, gardencorev1beta1.CapacityType("foo"), false),
+	)
+
+	DescribeTable("#IsTypeAvailableInRegion",
+		func(availability []gardencorev1beta1.TypeRegionAvailability, region string, zones []string, expectation bool) {
+			Expect(IsTypeAvailableInRegion(availability, region, zones)).To(Equal(expectation))
+		},
+
+		Entry("no region availability", nil, "eu-1", []string{"a"}, true),
+		Entry("region not listed", []gardencorev1beta1.TypeRegionAvailability{{Name: "us-1"}}, "eu-1", []string{"a"}, false),
+		Entry("region listed without zones", []gardencorev1beta1.TypeRegionAvailability{{Name: "eu-1"}}, "eu-1", []string{"a", "b"}, true),
+		Entry("region listed with all zones", []gardencorev1beta1.TypeRegionAvailability{{Name: "eu-1", Zones: []string{"a", "b", "c"}}}, "eu-1", []string{"a", "b"}, true),
+		Entry("region listed but zone missing", []gardencorev1beta1.TypeRegionAvailability{{Name: "eu-1", Zones: []string{"a"}}}, "eu-1", []string{"a", "b"}, false),
+	)
+
 	DescribeTable("#TaintsHave",
 		func(taints []gardencorev1beta1.SeedTaint, key string, expectation bool) {
 			Expect(TaintsHave(taints, key)).To(Equal(expectation))
@@ -344,6 +371,62 @@ var _ = Describe("Helper", func() {
 			},
 			true,
 		),
+		Entry("taints with PreferNoSchedule effect are ignored",
+			[]gardencorev1beta1.SeedTaint{
+				{Key: "foo", Effect: ptr.To(gardencorev1beta1.SeedTaintEffectPreferNoSchedule)},
+			},
+			nil,
+			true,
+		),
+		Entry("taints with NoExecute effect (non-tolerated)",
+			[]gardencorev1beta1.SeedTaint{
+				{Key: "foo", Effect: ptr.To(gardencorev1beta1.SeedTaintEffectNoExecute)},
+			},
+			[]gardencorev1beta1.Toleration{{Key: "bar"}},
+			false,
+		),
+		Entry("taints with NoExecute effect (tolerated)",
+			[]gardencorev1beta1.SeedTaint{
+				{Key: "foo", Effect: ptr.To(gardencorev1beta1.SeedTaintEffectNoExecute)},
+				{Key: "bar", Effect: ptr.To(gardencorev1beta1.SeedTaintEffectPreferNoSchedule)},
+			},
+			[]gardencorev1beta1.Toleration{{Key: "foo"}},
+			true,
+		),
+	)
+
+	DescribeTable("#PreferNoScheduleTaintsAreTolerated",
+		func(taints []gardencorev1beta1.SeedTaint, tolerations []gardencorev1beta1.Toleration, expectation bool) {
+			Expect(PreferNoScheduleTaintsAreTolerated(taints, tolerations)).To(Equal(expectation))
+		},
+
+		Entry("no taints",
+			nil,
+			nil,
+			true,
+		),
+		Entry("taints without PreferNoSchedule effect are ignored",
+			[]gardencorev1beta1.SeedTaint{
+				{Key: "foo"},
+				{Key: "bar", Effect: ptr.To(gardencorev1beta1.SeedTaintEffectNoExecute)},
+			},
+			nil,
+			true,
+		),
+		Entry("taints with PreferNoSchedule effect (non-tolerated)",
+			[]gardencorev1beta1.SeedTaint{
+				{Key: "foo", Value: ptr.To("bar"), Effect: ptr.To(gardencorev1beta1.SeedTaintEffectPreferNoSchedule)},
+			},
+			[]gardencorev1beta1.Toleration{{Key: "foo", Value: ptr.To("baz")}},
+			false,
+		),
+		Entry("taints with PreferNoSchedule effect (tolerated)",
+			[]gardencorev1beta1.SeedTaint{
+				{Key: "foo", Value: ptr.To("bar"), Effect: ptr.To(gardencorev1beta1.SeedTaintEffectPreferNoSchedule)},
+			},
+			[]gardencorev1beta1.Toleration{{Key: "foo", Value: ptr.To("bar")}},
+			true,
+		),
 	)
 
 	DescribeTable("#AccessRestrictionsAreSupported",
@@ -378,6 +461,95 @@ var _ = Describe("Helper", func() {
 		),
 	)
 
+	DescribeTable("#DataResidencyIsSatisfied",
+		func(regionMetadata *gardencorev1beta1.RegionMetadata, dataResidency *gardencorev1beta1.DataResidency, expectation bool) {
+			Expect(DataResidencyIsSatisfied(regionMetadata, dataResidency)).To(Equal(expectation))
+		},
+
+		Entry("no data residency constraints", nil, nil, true),
+		Entry("empty data residency constraints", nil, &gardencorev1beta1.DataResidency{}, true),
+		Entry("region without metadata", nil, &gardencorev1beta1.DataResidency{Jurisdictions: []string{"EU"}}, false),
+		Entry("region without jurisdiction", &gardencorev1beta1.RegionMetadata{Geography: ptr.To("europe")}, &gardencorev1beta1.DataResidency{Jurisdictions: []string{"EU"}}, false),
+		Entry("matching jurisdiction", &gardencorev1beta1.RegionMetadata{Jurisdiction: ptr.To("EU")}, &gardencorev1beta1.DataResidency{Jurisdictions: []string{"CH", "EU"}}, true),
+		Entry("non-matching jurisdiction", &gardencorev1beta1.RegionMetadata{Jurisdiction: ptr.To("US")}, &gardencorev1beta1.DataResidency{Jurisdictions: []string{"EU"}}, false),
+		Entry("matching jurisdiction but non-matching geography", &gardencorev1beta1.RegionMetadata{Jurisdiction: ptr.To("EU"), Geography: ptr.To("europe")}, &gardencorev1beta1.DataResidency{Jurisdictions: []string{"EU"}, Geographies: []string{"asia"}}, false),
+		Entry("matching jurisdiction and geography", &gardencorev1beta1.RegionMetadata{Jurisdiction: ptr.To("EU"), Geography: ptr.To("europe")}, &gardencorev1beta1.DataResidency{Jurisdictions: []string{"EU"}, Geographies: []string{"europe"}}, true),
+	)
+
+	Describe("#CheckETCDFullSnapshotSchedule", func() {
+		bounds := &gardencorev1beta1.SeedBackupETCD{MinFullSnapshotInterval: &metav1.Duration{Duration: 6 * time.Hour}}
+
+		It("should return an error because the schedule cannot be parsed", func() {
+			Expect(CheckETCDFullSnapshotSchedule(bounds, "foo")).To(HaveOccurred())
+		})
+
+		It("should succeed if no bounds are defined", func() {
+			Expect(CheckETCDFullSnapshotSchedule(nil, "*/5 * * * *")).To(Succeed())
+			Expect(CheckETCDFullSnapshotSchedule(&gardencorev1beta1.SeedBackupETCD{}, "*/5 * * * *")).To(Succeed())
+		})
+
+		It("should succeed if the schedule satisfies the minimum interval", func() {
+			Expect(CheckETCDFullSnapshotSchedule(bounds, "0 */6 * * *")).To(Succeed())
+			Expect(CheckETCDFullSnapshotSchedule(bounds, "0 3 * * *")).To(Succeed())
+		})
+
+		It("should return an error if the schedule does not satisfy the minimum interval", func() {
+			Expect(CheckETCDFullSnapshotSchedule(bounds, "0 */4 * * *")).To(MatchError(ContainSubstring("requires intervals of at least 6h0m0s")))
+			Expect(CheckETCDFullSnapshotSchedule(bounds, "0 3,5 * * *")).To(HaveOccurred())
+		})
+	})
+
+	DescribeTable("#CheckETCDDeltaSnapshotRetentionPeriod",
+		func(bounds *gardencorev1beta1.SeedBackupETCD, retentionPeriod time.Duration, matcher gomegatypes.GomegaMatcher) {
+			Expect(CheckETCDDeltaSnapshotRetentionPeriod(bounds, retentionPeriod)).To(matcher)
+		},
+
+		Entry("no bounds", nil, 30*24*time.Hour, Succeed()),
+		Entry("no maximum retention period", &gardencorev1beta1.SeedBackupETCD{}, 30*24*time.Hour, Succeed()),
+		Entry("retention period below maximum", &gardencorev1beta1.SeedBackupETCD{MaxDeltaSnapshotRetentionPeriod: &metav1.Duration{Duration: 7 * 24 * time.Hour}}, 3*24*time.Hour, Succeed()),
+		Entry("retention period equal to maximum", &gardencorev1beta1.SeedBackupETCD{MaxDeltaSnapshotRetentionPeriod: &metav1.Duration{Duration: 7 * 24 * time.Hour}}, 7*24*time.Hour, Succeed()),
+		Entry("retention period above maximum", &gardencorev1beta1.SeedBackupETCD{MaxDeltaSnapshotRetentionPeriod: &metav1.Duration{Duration: 7 * 24 * time.Hour}}, 8*24*time.Hour, HaveOccurred()),
+	)
+
+	Describe("#GetSeedRegionMetadata", func() {
+		var (
+			seed             *gardencorev1beta1.Seed
+			cloudProfileSpec *gardencorev1beta1.CloudProfileSpec
+		)
+
+		BeforeEach(func() {
+			seed = &gardencorev1beta1.Seed{Spec: gardencorev1beta1.SeedSpec{Provider: gardencorev1beta1.SeedProvider{Type: "foo", Region: "eu-1"}}}
+			cloudProfileSpec = &gardencorev1beta1.CloudProfileSpec{
+				Type: "foo",
+				Regions: []gardencorev1beta1.Region{
+					{Name: "eu-1", Metadata: &gardencorev1beta1.RegionMetadata{Jurisdiction: ptr.To("EU")}},
+				},
+			}
+		})
+
+		It("should return the metadata of the seed", func() {
+			seed.Spec.Provider.RegionMetadata = &gardencorev1beta1.RegionMetadata{Jurisdiction: ptr.To("CH")}
+
+			Expect(GetSeedRegionMetadata(seed, cloudProfileSpec)).To(Equal(&gardencorev1beta1.RegionMetadata{Jurisdiction: ptr.To("CH")}))
+		})
+
+		It("should return the metadata of the CloudProfile region", func() {
+			Expect(GetSeedRegionMetadata(seed, cloudProfileSpec)).To(Equal(&gardencorev1beta1.RegionMetadata{Jurisdiction: ptr.To("EU")}))
+		})
+
+		It("should return nil if the provider types do not match", func() {
+			seed.Spec.Provider.Type = "bar"
+
+			Expect(GetSeedRegionMetadata(seed, cloudProfileSpec)).To(BeNil())
+		})
+
+		It("should return nil if the region is not part of the CloudProfile", func() {
+			seed.Spec.Provider.Region = "us-1"
+
+			Expect(GetSeedRegionMetadata(seed, cloudProfileSpec)).To(BeNil())
+		})
+	})
+
 	Describe("#ReadManagedSeedAPIServer", func() {
 		var shoot *gardencorev1beta1.Shoot
 
@@ -527,6 +699,26 @@ var _ = Describe("Helper", func() {
 		Entry("systemComponents.allowed = true", &gardencorev1beta1.Worker{SystemComponents: &gardencorev1beta1.WorkerSystemComponents{Allow: true}}, true),
 	)
 
+	DescribeTable("#NodeTemplateEnforced",
+		func(worker *gardencorev1beta1.Worker, enforced bool) {
+			Expect(NodeTemplateEnforced(worker)).To(Equal(enforced))
+		},
+		Entry("no nodeTemplate section", &gardencorev1beta1.Worker{}, false),
+		Entry("enforceLabels not set", &gardencorev1beta1.Worker{NodeTemplate: &gardencorev1beta1.WorkerNodeTemplate{}}, false),
+		Entry("enforceLabels = false", &gardencorev1beta1.Worker{NodeTemplate: &gardencorev1beta1.WorkerNodeTemplate{EnforceLabels: ptr.To(false)}}, false),
+		Entry("enforceLabels = true", &gardencorev1beta1.Worker{NodeTemplate: &gardencorev1beta1.WorkerNodeTemplate{EnforceLabels: ptr.To(true)}}, true),
+	)
+
+	DescribeTable("#IsSystemComponentsDedicatedPool",
+		func(systemComponents *gardencorev1beta1.SystemComponents, workerPoolName string, dedicated bool) {
+			Expect(IsSystemComponentsDedicatedPool(systemComponents, workerPoolName)).To(Equal(dedicated))
+		},
+		Entry("no systemComponents section", nil, "pool", false),
+		Entry("no dedicated pool", &gardencorev1beta1.SystemComponents{}, "pool", false),
+		Entry("other dedicated pool", &gardencorev1beta1.SystemComponents{DedicatedPool: ptr.To("system")}, "pool", false),
+		Entry("dedicated pool", &gardencorev1beta1.SystemComponents{DedicatedPool: ptr.To("system")}, "system", true),
+	)
+
 	DescribeTable("#HibernationIsEnabled",
 		func(shoot *gardencorev1beta1.Shoot, hibernated bool) {
 			Expect(HibernationIsEnabled(shoot)).To(Equal(hibernated))
@@ -671,6 +863,64 @@ var _ = Describe("Helper", func() {
 		),
 	)
 
+	Describe("#ActiveMaintenanceExclusion", func() {
+		var (
+			now         = time.Date(2024, 11, 28, 12, 0, 0, 0, time.UTC)
+			maintenance *gardencorev1beta1.Maintenance
+		)
+
+		BeforeEach(func() {
+			maintenance = &gardencorev1beta1.Maintenance{
+				Exclusions: []gardencorev1beta1.MaintenanceExclusion{
+					{
+						Name:       "past",
+						Begin:      metav1.NewTime(now.Add(-48 * time.Hour)),
+						End:        metav1.NewTime(now.Add(-24 * time.Hour)),
+						Operations: []gardencorev1beta1.MaintenanceExclusionOperation{gardencorev1beta1.MaintenanceExclusionOperationNodeRoll},
+					},
+					{
+						Name:       "black-friday",
+						Begin:      metav1.NewTime(now.Add(-time.Hour)),
+						End:        metav1.NewTime(now.Add(24 * time.Hour)),
+						Operations: []gardencorev1beta1.MaintenanceExclusionOperation{gardencorev1beta1.MaintenanceExclusionOperationNodeRoll, gardencorev1beta1.MaintenanceExclusionOperationCARotation},
+					},
+					{
+						Name:       "cyber-monday",
+						Begin:      metav1.NewTime(now.Add(-time.Hour)),
+						End:        metav1.NewTime(now.Add(96 * time.Hour)),
+						Operations: []gardencorev1beta1.MaintenanceExclusionOperation{gardencorev1beta1.MaintenanceExclusionOperationNodeRoll},
+					},
+					{
+						Name:       "future",
+						Begin:      metav1.NewTime(now.Add(240 * time.Hour)),
+						End:        metav1.NewTime(now.Add(480 * time.Hour)),
+						Operations: []gardencorev1beta1.MaintenanceExclusionOperation{gardencorev1beta1.MaintenanceExclusionOperationEtcdMaintenance},
+					},
+				},
+			}
+		})
+
+		It("should return nil if maintenance is nil", func() {
+			Expect(ActiveMaintenanceExclusion(nil, gardencorev1beta1.MaintenanceExclusionOperationNodeRoll, now)).To(BeNil())
+		})
+
+		It("should return nil if the operation is not excluded right now", func() {
+			Expect(ActiveMaintenanceExclusion(maintenance, gardencorev1beta1.MaintenanceExclusionOperationEtcdMaintenance, now)).To(BeNil())
+		})
+
+		It("should return the active exclusion for the operation", func() {
+			Expect(ActiveMaintenanceExclusion(maintenance, gardencorev1beta1.MaintenanceExclusionOperationCARotation, now)).To(Equal(&maintenance.Exclusions[1]))
+		})
+
+		It("should return the active exclusion ending last", func() {
+			Expect(ActiveMaintenanceExclusion(maintenance, gardencorev1beta1.MaintenanceExclusionOperationNodeRoll, now)).To(Equal(&maintenance.Exclusions[2]))
+		})
+
+		It("should not consider the exclusion active at its end", func() {
+			Expect(ActiveMaintenanceExclusion(maintenance, gardencorev1beta1.MaintenanceExclusionOperationCARotation, now.Add(24*time.Hour))).To(BeNil())
+		})
+	})
+
 	DescribeTable("#SeedSettingExcessCapacityReservationEnabled",
 		func(settings *gardencorev1beta1.SeedSettings, expectation bool) {
 			Expect(SeedSettingExcessCapacityReservationEnabled(settings)).To(Equal(expectation))
@@ -2386,6 +2636,18 @@ var _ = Describe("Helper", func() {
 			})
 		})
 
+		DescribeTable("#SeedOutageModeActive",
+			func(annotations map[string]string, expected bool) {
+				seed := &gardencorev1beta1.Seed{ObjectMeta: metav1.ObjectMeta{Annotations: annotations}}
+				Expect(SeedOutageModeActive(seed)).To(Equal(expected))
+			},
+
+			Entry("no annotations", nil, false),
+			Entry("annotation value is false", map[string]string{v1beta1constants.AnnotationSeedOutageMode: "false"}, false),
+			Entry("annotation value is invalid", map[string]string{v1beta1constants.AnnotationSeedOutageMode: "foo"}, false),
+			Entry("annotation value is true", map[string]string{v1beta1constants.AnnotationSeedOutageMode: "true"}, true),
+		)
+
 		Describe("#ShootWantsAlertManager", func() {
 			It("should not want alert manager because alerts are ignored", func() {
 				metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.AnnotationShootIgnoreAlerts, "true")
@@ -2577,6 +2839,25 @@ var _ = Describe("Helper", func() {
 		}, &corev1.ObjectReference{Name: "foo"})
 	})
 
+	Describe("GetShootAuditSink", func() {
+		test := func(description string, config *gardencorev1beta1.KubeAPIServerConfig, expectedSink *gardencorev1beta1.AuditSink) {
+			It(description, Offset(1), func() {
+				Expect(GetShootAuditSink(config)).To(Equal(expectedSink))
+			})
+		}
+
+		test("KubeAPIServerConfig = nil", nil, nil)
+		test("AuditConfig = nil", &gardencorev1beta1.KubeAPIServerConfig{}, nil)
+		test("Sink = nil", &gardencorev1beta1.KubeAPIServerConfig{
+			AuditConfig: &gardencorev1beta1.AuditConfig{},
+		}, nil)
+		test("Sink set", &gardencorev1beta1.KubeAPIServerConfig{
+			AuditConfig: &gardencorev1beta1.AuditConfig{
+				Sink: &gardencorev1beta1.AuditSink{Type: "foo"},
+			},
+		}, &gardencorev1beta1.AuditSink{Type: "foo"})
+	})
+
 	DescribeTable("#GetShootAuthenticationConfigurationConfigMapName",
 		func(kubeAPIServerConfig *gardencorev1beta1.KubeAPIServerConfig, expectedName string) {
 			authConfigName := GetShootAuthenticationConfigurationConfigMapName(kubeAPIServerConfig)
@@ -2595,6 +2876,21 @@ var _ = Describe("Helper", func() {
 		}, "foo"),
 	)
 
+	DescribeTable("#GetShootAPIPriorityAndFairnessConfigMapName",
+		func(kubeAPIServerConfig *gardencorev1beta1.KubeAPIServerConfig, expectedName string) {
+			Expect(GetShootAPIPriorityAndFairnessConfigMapName(kubeAPIServerConfig)).To(Equal(expectedName))
+		},
+
+		Entry("KubeAPIServerConfig = nil", nil, ""),
+		Entry("APIPriorityAndFairness = nil", &gardencorev1beta1.KubeAPIServerConfig{}, ""),
+		Entry("ConfigMapName not set", &gardencorev1beta1.KubeAPIServerConfig{
+			APIPriorityAndFairness: &gardencorev1beta1.APIPriorityAndFairness{},
+		}, ""),
+		Entry("ConfigMapName set", &gardencorev1beta1.KubeAPIServerConfig{
+			APIPriorityAndFairness: &gardencorev1beta1.APIPriorityAndFairness{ConfigMapName: ptr.To("foo")},
+		}, "foo"),
+	)
+
 	DescribeTable("#GetShootAuthorizationConfigurationConfigMapName",
 		func(kubeAPIServerConfig *gardencorev1beta1.KubeAPIServerConfig, expectedName string) {
 			authConfigName := GetShootAuthorizationConfigurationConfigMapName(kubeAPIServerConfig)
@@ -2675,6 +2971,18 @@ var _ = Describe("Helper", func() {
 		})
 	})
 
+	Describe("#CalculateWeightedSeedUsage", func() {
+		It("should sum up the weights of the shoots' size classes", func() {
+			shootList := []*gardencorev1beta1.Shoot{
+				{Spec: gardencorev1beta1.ShootSpec{SeedName: ptr.To("seed")}},
+				{Spec: gardencorev1beta1.ShootSpec{SeedName: ptr.To("seed")}, Status: gardencorev1beta1.ShootStatus{SizeClass: ptr.To(gardencorev1beta1.ShootSizeClassLarge)}},
+				{Spec: gardencorev1beta1.ShootSpec{SeedName: ptr.To("seed2")}, Status: gardencorev1beta1.ShootStatus{SeedName: ptr.To("seed3"), SizeClass: ptr.To(gardencorev1beta1.ShootSizeClassMedium)}},
+			}
+
+			Expect(CalculateWeightedSeedUsage(shootList)).To(Equal(map[string]int64{"seed": 5, "seed2": 2, "seed3": 2}))
+		})
+	})
+
 	DescribeTable("#CalculateEffectiveKubernetesVersion",
 		func(controlPlaneVersion *semver.Version, workerKubernetes *gardencorev1beta1.WorkerKubernetes, expectedRes *semver.Version) {
 			res, err := CalculateEffectiveKubernetesVersion(controlPlaneVersion, workerKubernetes)
@@ -2775,6 +3083,18 @@ var _ = Describe("Helper", func() {
 		Entry("with system components and node-local-dns is disabled", &gardencorev1beta1.SystemComponents{NodeLocalDNS: &gardencorev1beta1.NodeLocalDNS{Enabled: false}}, false),
 	)
 
+	DescribeTable("#IsNodeLocalDNSDisabledForWorkerPool",
+		func(systemComponents *gardencorev1beta1.SystemComponents, expected bool) {
+			Expect(IsNodeLocalDNSDisabledForWorkerPool(systemComponents, "pool")).To(Equal(expected))
+		},
+
+		Entry("with nil", nil, false),
+		Entry("with empty system components", &gardencorev1beta1.SystemComponents{}, false),
+		Entry("with no disabled worker pools", &gardencorev1beta1.SystemComponents{NodeLocalDNS: &gardencorev1beta1.NodeLocalDNS{Enabled: true}}, false),
+		Entry("with other disabled worker pools", &gardencorev1beta1.SystemComponents{NodeLocalDNS: &gardencorev1beta1.NodeLocalDNS{Enabled: true, DisabledWorkerPools: []string{"other"}}}, false),
+		Entry("with worker pool disabled", &gardencorev1beta1.SystemComponents{NodeLocalDNS: &gardencorev1beta1.NodeLocalDNS{Enabled: true, DisabledWorkerPools: []string{"other", "pool"}}}, true),
+	)
+
 	DescribeTable("#GetNodeLocalDNS",
 		func(systemComponents *gardencorev1beta1.SystemComponents, expected *gardencorev1beta1.NodeLocalDNS) {
 			Expect(GetNodeLocalDNS(systemComponents)).To(Equal(expected))
@@ -2818,6 +3138,40 @@ var _ = Describe("Helper", func() {
 		)
 	})
 
+	DescribeTable("#GetSeedCARotationPhase",
+		func(credentials *gardencorev1beta1.SeedCredentials, expectedPhase gardencorev1beta1.CredentialsRotationPhase) {
+			Expect(GetSeedCARotationPhase(credentials)).To(Equal(expectedPhase))
+		},
+
+		Entry("credentials nil", nil, gardencorev1beta1.CredentialsRotationPhase("")),
+		Entry("rotation nil", &gardencorev1beta1.SeedCredentials{}, gardencorev1beta1.CredentialsRotationPhase("")),
+		Entry("ca nil", &gardencorev1beta1.SeedCredentials{Rotation: &gardencorev1beta1.SeedCredentialsRotation{}}, gardencorev1beta1.CredentialsRotationPhase("")),
+		Entry("phase empty", &gardencorev1beta1.SeedCredentials{Rotation: &gardencorev1beta1.SeedCredentialsRotation{CertificateAuthorities: &gardencorev1beta1.CARotation{}}}, gardencorev1beta1.CredentialsRotationPhase("")),
+		Entry("phase set", &gardencorev1beta1.SeedCredentials{Rotation: &gardencorev1beta1.SeedCredentialsRotation{CertificateAuthorities: &gardencorev1beta1.CARotation{Phase: gardencorev1beta1.RotationCompleting}}}, gardencorev1beta1.RotationCompleting),
+	)
+
+	Describe("#MutateSeedCARotation", func() {
+		It("should do nothing when mutate function is nil", func() {
+			seed := &gardencorev1beta1.Seed{}
+			MutateSeedCARotation(seed, nil)
+			Expect(GetSeedCARotationPhase(seed.Status.Credentials)).To(BeEmpty())
+		})
+
+		DescribeTable("mutate function not nil",
+			func(seed *gardencorev1beta1.Seed, phase gardencorev1beta1.CredentialsRotationPhase) {
+				MutateSeedCARotation(seed, func(rotation *gardencorev1beta1.CARotation) {
+					rotation.Phase = phase
+				})
+				Expect(seed.Status.Credentials.Rotation.CertificateAuthorities.Phase).To(Equal(phase))
+			},
+
+			Entry("credentials nil", &gardencorev1beta1.Seed{}, gardencorev1beta1.RotationCompleting),
+			Entry("rotation nil", &gardencorev1beta1.Seed{Status: gardencorev1beta1.SeedStatus{Credentials: &gardencorev1beta1.SeedCredentials{}}}, gardencorev1beta1.RotationCompleting),
+			Entry("certificateAuthorities nil", &gardencorev1beta1.Seed{Status: gardencorev1beta1.SeedStatus{Credentials: &gardencorev1beta1.SeedCredentials{Rotation: &gardencorev1beta1.SeedCredentialsRotation{}}}}, gardencorev1beta1.RotationCompleting),
+			Entry("certificateAuthorities non-nil", &gardencorev1beta1.Seed{Status: gardencorev1beta1.SeedStatus{Credentials: &gardencorev1beta1.SeedCredentials{Rotation: &gardencorev1beta1.SeedCredentialsRotation{CertificateAuthorities: &gardencorev1beta1.CARotation{}}}}}, gardencorev1beta1.RotationCompleting),
+		)
+	})
+
 	Describe("#MutateShootKubeconfigRotation", func() {
 		It("should do nothing when mutate function is nil", func() {
 			shoot := &gardencorev1beta1.Shoot{}
@@ -3524,4 +3878,46 @@ var _ = Describe("Helper", func() {
 			&gardencorev1beta1.KubeletConfigReserved{CPU: resource.NewQuantity(150, resource.DecimalSI), Memory: resource.NewQuantity(160, resource.DecimalSI), EphemeralStorage: resource.NewQuantity(60, resource.DecimalSI), PID: resource.NewQuantity(10, resource.DecimalSI)},
 		),
 	)
+
+	DescribeTable("#ComputeShootSizeClass",
+		func(nodeCount int, requestsPerSecond float64, expected gardencorev1beta1.ShootSizeClass) {
+			Expect(ComputeShootSizeClass(nodeCount, requestsPerSecond)).To(Equal(expected))
+		},
+
+		Entry("small shoot", 3, 5.0, gardencorev1beta1.ShootSizeClassSmall),
+		Entry("medium shoot due to node count", 11, 5.0, gardencorev1beta1.ShootSizeClassMedium),
+		Entry("large shoot due to node count", 200, 5.0, gardencorev1beta1.ShootSizeClassLarge),
+		Entry("extra-large shoot due to node count", 201, 5.0, gardencorev1beta1.ShootSizeClassExtraLarge),
+		Entry("medium shoot due to request rate", 0, 100.0, gardencorev1beta1.ShootSizeClassMedium),
+		Entry("large shoot due to request rate", 20, 150.0, gardencorev1beta1.ShootSizeClassLarge),
+		Entry("extra-large shoot due to request rate", 20, 501.0, gardencorev1beta1.ShootSizeClassExtraLarge),
+	)
+
+	Describe("#GetShootSizeClass", func() {
+		It("should return the size class from the status", func() {
+			shoot := &gardencorev1beta1.Shoot{Status: gardencorev1beta1.ShootStatus{SizeClass: ptr.To(gardencorev1beta1.ShootSizeClassLarge)}}
+			Expect(GetShootSizeClass(shoot)).To(Equal(gardencorev1beta1.ShootSizeClassLarge))
+		})
+
+		It("should derive the size class from the maximum number of nodes", func() {
+			shoot := &gardencorev1beta1.Shoot{Spec: gardencorev1beta1.ShootSpec{Provider: gardencorev1beta1.Provider{Workers: []gardencorev1beta1.Worker{{Maximum: 30}, {Maximum: 25}}}}}
+			Expect(GetShootSizeClass(shoot)).To(Equal(gardencorev1beta1.ShootSizeClassLarge))
+		})
+
+		It("should return the smallest size class for workerless shoots", func() {
+			Expect(GetShootSizeClass(&gardencorev1beta1.Shoot{})).To(Equal(gardencorev1beta1.ShootSizeClassSmall))
+		})
+	})
+
+	DescribeTable("#ShootSizeClassWeight",
+		func(sizeClass gardencorev1beta1.ShootSizeClass, expected int64) {
+			Expect(ShootSizeClassWeight(sizeClass)).To(Equal(expected))
+		},
+
+		Entry("S", gardencorev1beta1.ShootSizeClassSmall, int64(1)),
+		Entry("M", gardencorev1beta1.ShootSizeClassMedium, int64(2)),
+		Entry("L", gardencorev1beta1.ShootSizeClassLarge, int64(4)),
+		Entry("XL", gardencorev1beta1.ShootSizeClassExtraLarge, int64(8)),
+		Entry("unknown", gardencorev1beta1.ShootSizeClass(""), int64(1)),
+	)
 })
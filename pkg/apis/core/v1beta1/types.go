@@ -15,6 +15,7 @@ const (
 )
 
 // IPFamily is a type for specifying an IP protocol version to use in Gardener clusters.
+// +enum
 type IPFamily string
 
 const (
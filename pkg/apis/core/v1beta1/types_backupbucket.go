@@ -50,6 +50,10 @@ type BackupBucketSpec struct {
 	// This field is immutable.
 	// +optional
 	SeedName *string `json:"seedName,omitempty" protobuf:"bytes,4,opt,name=seedName"`
+	// Immutability contains the immutability (object lock) configuration of the bucket. If set, objects stored in the
+	// bucket are protected against deletion and overwrites for the configured retention period.
+	// +optional
+	Immutability *BackupBucketImmutability `json:"immutability,omitempty" protobuf:"bytes,5,opt,name=immutability"`
 }
 
 // BackupBucketStatus holds the most recently observed status of the Backup Bucket.
@@ -80,3 +84,26 @@ type BackupBucketProvider struct {
 	// Region is the region of the bucket.
 	Region string `json:"region" protobuf:"bytes,2,opt,name=region"`
 }
+
+// BackupBucketImmutability contains the immutability (object lock) configuration of a backup bucket.
+type BackupBucketImmutability struct {
+	// RetentionMode is the retention mode of the objects in the bucket. Possible values are `Governance` and
+	// `Compliance`.
+	RetentionMode BackupBucketRetentionMode `json:"retentionMode" protobuf:"bytes,1,opt,name=retentionMode,casttype=BackupBucketRetentionMode"`
+	// RetentionPeriod is the duration for which objects in the bucket are protected against deletion and overwrites
+	// after they have been written.
+	RetentionPeriod metav1.Duration `json:"retentionPeriod" protobuf:"bytes,2,opt,name=retentionPeriod"`
+}
+
+// BackupBucketRetentionMode is a type alias for string.
+// +enum
+type BackupBucketRetentionMode string
+
+const (
+	// BackupBucketRetentionModeGovernance is a constant for the governance retention mode. Protected objects can still
+	// be deleted and the retention settings can still be relaxed by principals with special permissions.
+	BackupBucketRetentionModeGovernance BackupBucketRetentionMode = "Governance"
+	// BackupBucketRetentionModeCompliance is a constant for the compliance retention mode. Protected objects cannot be
+	// deleted by anyone before their retention period has expired and the retention settings can only be tightened.
+	BackupBucketRetentionModeCompliance BackupBucketRetentionMode = "Compliance"
+)
@@ -23,6 +23,9 @@ type CloudProfile struct {
 	// Spec defines the provider environment properties.
 	// +optional
 	Spec CloudProfileSpec `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+	// Status contains the current status of the cloud profile.
+	// +optional
+	Status CloudProfileStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -37,6 +40,45 @@ type CloudProfileList struct {
 	Items []CloudProfile `json:"items" protobuf:"bytes,2,rep,name=items"`
 }
 
+// CloudProfileStatus contains the status of the cloud profile.
+type CloudProfileStatus struct {
+	// Usage contains information about the Kubernetes and machine image versions which are used by Shoots referencing
+	// the CloudProfile, either directly or via a NamespacedCloudProfile. It is maintained by gardener-controller-manager.
+	// +optional
+	Usage *CloudProfileUsage `json:"usage,omitempty" protobuf:"bytes,1,opt,name=usage"`
+}
+
+// CloudProfileUsage contains information about the versions of a CloudProfile which are in use.
+type CloudProfileUsage struct {
+	// KubernetesVersions is the list of Kubernetes versions which are in use.
+	// +optional
+	KubernetesVersions []VersionUsage `json:"kubernetesVersions,omitempty" protobuf:"bytes,1,rep,name=kubernetesVersions"`
+	// MachineImageVersions is the list of machine image versions which are in use.
+	// +optional
+	MachineImageVersions []MachineImageVersionUsage `json:"machineImageVersions,omitempty" protobuf:"bytes,2,rep,name=machineImageVersions"`
+	// LastUpdateTime is the time when the usage was computed the last time.
+	// +optional
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty" protobuf:"bytes,3,opt,name=lastUpdateTime"`
+}
+
+// VersionUsage contains information about the usage of a version.
+type VersionUsage struct {
+	// Version is the version.
+	Version string `json:"version" protobuf:"bytes,1,opt,name=version"`
+	// Shoots is the number of Shoots using the version.
+	Shoots int32 `json:"shoots" protobuf:"varint,2,opt,name=shoots"`
+}
+
+// MachineImageVersionUsage contains information about the usage of a machine image version.
+type MachineImageVersionUsage struct {
+	// Name is the name of the machine image.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Version is the version of the machine image.
+	Version string `json:"version" protobuf:"bytes,2,opt,name=version"`
+	// Shoots is the number of Shoots using the machine image version in at least one of their worker pools.
+	Shoots int32 `json:"shoots" protobuf:"varint,3,opt,name=shoots"`
+}
+
 // CloudProfileSpec is the specification of a CloudProfile.
 // It must contain exactly one of its defined keys.
 type CloudProfileSpec struct {
@@ -77,6 +119,12 @@ type CloudProfileSpec struct {
 	// Bastion contains the machine and image properties
 	// +optional
 	Bastion *Bastion `json:"bastion,omitempty" protobuf:"bytes,10,opt,name=bastion"`
+	// Addons is the catalog of managed addons which can be deployed into shoot clusters using this profile via
+	// `.spec.addons.managed` in the Shoot specification.
+	// +patchMergeKey=name
+	// +patchStrategy=merge
+	// +optional
+	Addons []AddonDefinition `json:"addons,omitempty" patchStrategy:"merge" patchMergeKey:"name" protobuf:"bytes,11,rep,name=addons"`
 }
 
 // SeedSelector contains constraints for selecting seed to be usable for shoots using a profile
@@ -92,19 +140,30 @@ type SeedSelector struct {
 // KubernetesSettings contains constraints regarding allowed values of the 'kubernetes' block in the Shoot specification.
 type KubernetesSettings struct {
 	// Versions is the list of allowed Kubernetes versions with optional expiration dates for Shoot clusters.
+	// Only one version per minor version may be classified as supported.
 	// +patchMergeKey=version
 	// +patchStrategy=merge
 	// +optional
+	// +k8s:validation:cel[0]:rule="self.filter(v, has(v.classification) && v.classification == 'supported' && v.version.split('.').size() >= 2).all(v, self.filter(w, has(w.classification) && w.classification == 'supported' && w.version.split('.').size() >= 2 && w.version.split('.')[0] == v.version.split('.')[0] && w.version.split('.')[1] == v.version.split('.')[1]).size() == 1)"
+	// +k8s:validation:cel[0]:message="only one supported version is allowed per minor version"
 	Versions []ExpirableVersion `json:"versions,omitempty" patchStrategy:"merge" patchMergeKey:"version" protobuf:"bytes,1,rep,name=versions"`
+	// MaxExpirationExtension is the maximum duration by which NamespacedCloudProfiles may extend the expiration date of
+	// a Kubernetes version beyond the expiration date defined in the CloudProfile. If not set, the expiration dates may
+	// be extended without limit. It must not be set in NamespacedCloudProfiles.
+	// +optional
+	MaxExpirationExtension *metav1.Duration `json:"maxExpirationExtension,omitempty" protobuf:"bytes,2,opt,name=maxExpirationExtension"`
 }
 
 // MachineImage defines the name and multiple versions of the machine image in any environment.
 type MachineImage struct {
 	// Name is the name of the image.
 	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
-	// Versions contains versions, expiration dates and container runtimes of the machine image
+	// Versions contains versions, expiration dates and container runtimes of the machine image.
+	// Only one version per minor version may be classified as supported.
 	// +patchMergeKey=version
 	// +patchStrategy=merge
+	// +k8s:validation:cel[0]:rule="self.filter(v, has(v.classification) && v.classification == 'supported' && v.version.split('.').size() >= 2).all(v, self.filter(w, has(w.classification) && w.classification == 'supported' && w.version.split('.').size() >= 2 && w.version.split('.')[0] == v.version.split('.')[0] && w.version.split('.')[1] == v.version.split('.')[1]).size() == 1)"
+	// +k8s:validation:cel[0]:message="only one supported version is allowed per minor version"
 	Versions []MachineImageVersion `json:"versions" patchStrategy:"merge" patchMergeKey:"version" protobuf:"bytes,2,rep,name=versions"`
 	// UpdateStrategy is the update strategy to use for the machine image. Possible values are:
 	//  - patch: update to the latest patch version of the current minor version.
@@ -158,11 +217,34 @@ type MachineType struct {
 	// +optional
 	Storage *MachineTypeStorage `json:"storage,omitempty" protobuf:"bytes,5,opt,name=storage"`
 	// Usable defines if the machine type can be used for shoot clusters.
+	// +default=true
 	// +optional
 	Usable *bool `json:"usable,omitempty" protobuf:"varint,6,opt,name=usable"`
 	// Architecture is the CPU architecture of this machine type.
+	// +default="amd64"
 	// +optional
 	Architecture *string `json:"architecture,omitempty" protobuf:"bytes,7,opt,name=architecture"`
+	// CapacityTypes is the list of capacity types supported by this machine type. If empty, only on-demand capacity is
+	// supported. Worker pools using the `SpotWithFallback` capacity type require both `Spot` and `OnDemand` capacity.
+	// +optional
+	CapacityTypes []CapacityType `json:"capacityTypes,omitempty" protobuf:"bytes,8,rep,name=capacityTypes,casttype=CapacityType"`
+	// Regions restricts the availability of this machine type to the listed regions. If empty, the machine type is
+	// available in all regions of the cloud profile.
+	// +optional
+	Regions []TypeRegionAvailability `json:"regions,omitempty" protobuf:"bytes,9,rep,name=regions"`
+}
+
+// TypeRegionAvailability describes the availability of a machine or volume type in a region.
+type TypeRegionAvailability struct {
+	// Name is the name of the region.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Zones is the list of zones of the region in which the type is available. If empty, the type is available in all
+	// zones of the region.
+	// +optional
+	Zones []string `json:"zones,omitempty" protobuf:"bytes,2,rep,name=zones"`
+	// PricingClass is an optional classification of the price of the type in this region, e.g. `standard` or `premium`.
+	// +optional
+	PricingClass *string `json:"pricingClass,omitempty" protobuf:"bytes,3,opt,name=pricingClass"`
 }
 
 // MachineTypeStorage is the amount of storage associated with the root volume of this machine type.
@@ -197,6 +279,23 @@ type Region struct {
 	// AccessRestrictions describe a list of access restrictions that can be used for Shoots using this region.
 	// +optional
 	AccessRestrictions []AccessRestriction `json:"accessRestrictions,omitempty" protobuf:"bytes,4,rep,name=accessRestrictions"`
+	// Metadata contains structured information about the region, e.g. wrt data residency.
+	// +optional
+	Metadata *RegionMetadata `json:"metadata,omitempty" protobuf:"bytes,5,opt,name=metadata"`
+}
+
+// RegionMetadata contains structured information about a region.
+type RegionMetadata struct {
+	// Geography is the geographical area the region is located in, e.g. `europe` or `north-america`.
+	// +optional
+	Geography *string `json:"geography,omitempty" protobuf:"bytes,1,opt,name=geography"`
+	// Jurisdiction is the legal jurisdiction which applies to data stored in the region, e.g. `EU` or `US`.
+	// +optional
+	Jurisdiction *string `json:"jurisdiction,omitempty" protobuf:"bytes,2,opt,name=jurisdiction"`
+	// LatencyMatrixKey is the key of the region in the region ConfigMap of the gardener-scheduler which contains the
+	// latencies between regions (used by the `MinimalDistance` strategy). Defaults to the name of the region.
+	// +optional
+	LatencyMatrixKey *string `json:"latencyMatrixKey,omitempty" protobuf:"bytes,3,opt,name=latencyMatrixKey"`
 }
 
 // AvailabilityZone is an availability zone.
@@ -218,11 +317,24 @@ type VolumeType struct {
 	// Name is the name of the volume type.
 	Name string `json:"name" protobuf:"bytes,2,opt,name=name"`
 	// Usable defines if the volume type can be used for shoot clusters.
+	// +default=true
 	// +optional
 	Usable *bool `json:"usable,omitempty" protobuf:"varint,3,opt,name=usable"`
 	// MinSize is the minimal supported storage size.
 	// +optional
 	MinSize *resource.Quantity `json:"minSize,omitempty" protobuf:"bytes,4,opt,name=minSize"`
+	// MaxIOPS is the maximal number of provisioned I/O operations per second supported by this volume type.
+	// If not set, volumes of this type do not support configuring IOPS.
+	// +optional
+	MaxIOPS *int64 `json:"maxIOPS,omitempty" protobuf:"varint,5,opt,name=maxIOPS"`
+	// MaxThroughput is the maximal provisioned throughput in MiB/s supported by this volume type.
+	// If not set, volumes of this type do not support configuring throughput.
+	// +optional
+	MaxThroughput *int64 `json:"maxThroughput,omitempty" protobuf:"varint,6,opt,name=maxThroughput"`
+	// Regions restricts the availability of this volume type to the listed regions. If empty, the volume type is
+	// available in all regions of the cloud profile.
+	// +optional
+	Regions []TypeRegionAvailability `json:"regions,omitempty" protobuf:"bytes,7,rep,name=regions"`
 }
 
 // Bastion contains the bastions creation info
@@ -257,7 +369,33 @@ const (
 	VolumeClassPremium string = "premium"
 )
 
+// AddonDefinition declares a managed addon which can be deployed into shoot clusters.
+type AddonDefinition struct {
+	// Name is the name of the addon.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Versions contains the available versions of the addon.
+	// +patchMergeKey=version
+	// +patchStrategy=merge
+	Versions []AddonVersion `json:"versions" patchStrategy:"merge" patchMergeKey:"version" protobuf:"bytes,2,rep,name=versions"`
+	// WorkerlessSupported specifies whether the addon can be enabled for workerless Shoot clusters, i.e., whether it
+	// does not require any nodes. Defaults to false.
+	// +optional
+	WorkerlessSupported *bool `json:"workerlessSupported,omitempty" protobuf:"varint,3,opt,name=workerlessSupported"`
+}
+
+// AddonVersion is a version of a managed addon.
+type AddonVersion struct {
+	ExpirableVersion `json:",inline" protobuf:"bytes,1,opt,name=expirableVersion"`
+	// Chart is the reference to the Helm chart of the addon in an OCI repository.
+	Chart OCIRepository `json:"chart" protobuf:"bytes,2,opt,name=chart"`
+	// ValuesSchema is an OpenAPI v3 schema which the values provided for the addon in the Shoot specification are
+	// validated against. If not set, no values are allowed for this version.
+	// +optional
+	ValuesSchema *runtime.RawExtension `json:"valuesSchema,omitempty" protobuf:"bytes,3,opt,name=valuesSchema"`
+}
+
 // VersionClassification is the logical state of a version.
+// +enum
 type VersionClassification string
 
 const (
@@ -274,6 +412,7 @@ const (
 )
 
 // MachineImageUpdateStrategy is the update strategy to use for a machine image
+// +enum
 type MachineImageUpdateStrategy string
 
 const (
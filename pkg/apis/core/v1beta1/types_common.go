@@ -43,6 +43,37 @@ const (
 	ErrorProblematicWebhook ErrorCode = "ERR_PROBLEMATIC_WEBHOOK"
 )
 
+// ErrorCategory is a string alias.
+// +enum
+type ErrorCategory string
+
+const (
+	// ErrorCategoryUserConfiguration indicates that the error was caused by the configuration provided by the user, e.g.,
+	// invalid infrastructure credentials or a problematic webhook.
+	ErrorCategoryUserConfiguration ErrorCategory = "UserConfiguration"
+	// ErrorCategoryProviderQuota indicates that the error was caused by exhausted quotas or depleted resources at the
+	// infrastructure provider.
+	ErrorCategoryProviderQuota ErrorCategory = "ProviderQuota"
+	// ErrorCategoryTransient indicates that the error is likely transient and will be resolved by a retry.
+	ErrorCategoryTransient ErrorCategory = "Transient"
+	// ErrorCategoryGardenerBugSuspect indicates that the error could not be attributed to the user or the infrastructure
+	// provider, hence it might be caused by a bug in Gardener or one of its extensions.
+	ErrorCategoryGardenerBugSuspect ErrorCategory = "GardenerBugSuspect"
+)
+
+// ErrorTriageConfidence is a string alias.
+// +enum
+type ErrorTriageConfidence string
+
+const (
+	// ErrorTriageConfidenceHigh indicates that the classification is based on well-defined error codes.
+	ErrorTriageConfidenceHigh ErrorTriageConfidence = "High"
+	// ErrorTriageConfidenceMedium indicates that the classification is based on well-known patterns in the error description.
+	ErrorTriageConfidenceMedium ErrorTriageConfidence = "Medium"
+	// ErrorTriageConfidenceLow indicates that the error could not be classified based on codes or known patterns.
+	ErrorTriageConfidenceLow ErrorTriageConfidence = "Low"
+)
+
 // LastError indicates the last occurred error for an operation on a resource.
 type LastError struct {
 	// A human readable message indicating details about the last error.
@@ -56,9 +87,24 @@ type LastError struct {
 	// Last time the error was reported
 	// +optional
 	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty" protobuf:"bytes,4,opt,name=lastUpdateTime"`
+	// Triage contains the classification of the last error into an actionable category.
+	// +optional
+	Triage *ErrorTriage `json:"triage,omitempty" protobuf:"bytes,5,opt,name=triage"`
+}
+
+// ErrorTriage contains the classification of an error into an actionable category.
+type ErrorTriage struct {
+	// Category is the category the error was classified into.
+	Category ErrorCategory `json:"category" protobuf:"bytes,1,opt,name=category,casttype=ErrorCategory"`
+	// Confidence is the confidence of the classification.
+	Confidence ErrorTriageConfidence `json:"confidence" protobuf:"bytes,2,opt,name=confidence,casttype=ErrorTriageConfidence"`
+	// Suggestions are hints on how the error can be resolved.
+	// +optional
+	Suggestions []string `json:"suggestions,omitempty" protobuf:"bytes,3,rep,name=suggestions"`
 }
 
 // LastOperationType is a string alias.
+// +enum
 type LastOperationType string
 
 const (
@@ -75,6 +121,7 @@ const (
 )
 
 // LastOperationState is a string alias.
+// +enum
 type LastOperationState string
 
 const (
@@ -162,6 +209,7 @@ type FailureTolerance struct {
 
 // FailureToleranceType specifies the type of failure that a highly available
 // shoot control plane that can tolerate.
+// +enum
 type FailureToleranceType string
 
 const (
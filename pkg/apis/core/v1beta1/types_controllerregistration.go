@@ -100,6 +100,8 @@ type ControllerRegistrationDeployment struct {
 }
 
 // ControllerDeploymentPolicy is a string alias.
+// +enum
+// +kubebuilder:validation:Enum=OnDemand;Always;AlwaysExceptNoShoots
 type ControllerDeploymentPolicy string
 
 const (
@@ -115,6 +117,7 @@ const (
 )
 
 // ControllerResourceLifecycleStrategy is a string alias.
+// +enum
 type ControllerResourceLifecycleStrategy string
 
 const (
@@ -25,6 +25,14 @@ type ExposureClass struct {
 	// This field is immutable.
 	// +optional
 	Scheduling *ExposureClassScheduling `json:"scheduling,omitempty" protobuf:"bytes,3,opt,name=scheduling"`
+	// LoadBalancerService contains optional configuration for the load balancer service of the istio ingress gateway
+	// of the handler which exposes the kube-apiservers of shoots using this ExposureClass.
+	// +optional
+	LoadBalancerService *ExposureClassLoadBalancerService `json:"loadBalancerService,omitempty" protobuf:"bytes,4,opt,name=loadBalancerService"`
+	// IngressGateway contains optional sizing configuration for the istio ingress gateway of the handler which exposes
+	// the kube-apiservers of shoots using this ExposureClass.
+	// +optional
+	IngressGateway *ExposureClassIngressGateway `json:"ingressGateway,omitempty" protobuf:"bytes,5,opt,name=ingressGateway"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -50,3 +58,25 @@ type ExposureClassScheduling struct {
 	// +optional
 	Tolerations []Toleration `json:"tolerations,omitempty" patchStrategy:"merge" patchMergeKey:"key" protobuf:"bytes,2,rep,name=tolerations"`
 }
+
+// ExposureClassLoadBalancerService contains configuration for the load balancer service of an istio ingress gateway.
+type ExposureClassLoadBalancerService struct {
+	// Annotations is a key value map to annotate the load balancer service. They take precedence over the annotations
+	// configured for the handler in the gardenlet configuration and for the load balancer services of the Seed.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty" protobuf:"bytes,1,rep,name=annotations"`
+	// SourceRanges is a list of CIDRs which are allowed to access the load balancer. If not specified, access is not
+	// restricted.
+	// +optional
+	SourceRanges []string `json:"sourceRanges,omitempty" protobuf:"bytes,2,rep,name=sourceRanges"`
+}
+
+// ExposureClassIngressGateway contains sizing configuration for an istio ingress gateway.
+type ExposureClassIngressGateway struct {
+	// MinReplicas is the minimum number of replicas of the istio ingress gateway.
+	// +optional
+	MinReplicas *int32 `json:"minReplicas,omitempty" protobuf:"varint,1,opt,name=minReplicas"`
+	// MaxReplicas is the maximum number of replicas of the istio ingress gateway.
+	// +optional
+	MaxReplicas *int32 `json:"maxReplicas,omitempty" protobuf:"varint,2,opt,name=maxReplicas"`
+}
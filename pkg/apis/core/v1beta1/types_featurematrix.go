@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// FeatureMatrix lists the feature gates and the deprecated API fields of the Gardener version serving it. It is
+// computed by gardener-apiserver on request and cannot be created or modified.
+type FeatureMatrix struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object metadata.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	// GardenerVersion is the version of the gardener-apiserver serving the FeatureMatrix.
+	GardenerVersion string `json:"gardenerVersion" protobuf:"bytes,2,opt,name=gardenerVersion"`
+	// FeatureGates is the list of all feature gates of the Gardener version.
+	// +optional
+	FeatureGates []FeatureGate `json:"featureGates,omitempty" protobuf:"bytes,3,rep,name=featureGates"`
+	// DeprecatedFields is the list of deprecated API fields of the Gardener version.
+	// +optional
+	DeprecatedFields []DeprecatedField `json:"deprecatedFields,omitempty" protobuf:"bytes,4,rep,name=deprecatedFields"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// FeatureMatrixList is a collection of FeatureMatrices.
+type FeatureMatrixList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard list object metadata.
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	// Items is the list of FeatureMatrices.
+	Items []FeatureMatrix `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// FeatureGate contains information about a feature gate.
+type FeatureGate struct {
+	// Name is the name of the feature gate.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Default is the default value of the feature gate.
+	Default bool `json:"default" protobuf:"varint,2,opt,name=default"`
+	// PreRelease is the maturity level of the feature gate, e.g. ALPHA, BETA or GA.
+	PreRelease string `json:"preRelease" protobuf:"bytes,3,opt,name=preRelease"`
+	// LockToDefault indicates that the feature gate cannot be set to a value other than its default.
+	// +optional
+	LockToDefault bool `json:"lockToDefault,omitempty" protobuf:"varint,4,opt,name=lockToDefault"`
+	// Enabled indicates whether the feature gate is enabled in gardener-apiserver. It is only set for feature gates
+	// which are relevant for gardener-apiserver, other components might be configured differently.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty" protobuf:"varint,5,opt,name=enabled"`
+}
+
+// DeprecatedField contains information about a deprecated API field.
+type DeprecatedField struct {
+	// APIVersion is the API version of the resource containing the field.
+	APIVersion string `json:"apiVersion" protobuf:"bytes,1,opt,name=apiVersion"`
+	// Kind is the kind of the resource containing the field.
+	Kind string `json:"kind" protobuf:"bytes,2,opt,name=kind"`
+	// Path is the path of the field in the resource, e.g. spec.kubernetes.kubeAPIServer.oidcConfig.
+	Path string `json:"path" protobuf:"bytes,3,opt,name=path"`
+	// Description explains the deprecation and names the replacement of the field, if any.
+	Description string `json:"description" protobuf:"bytes,4,opt,name=description"`
+	// ForbiddenFromKubernetesVersion is the Kubernetes version starting from which the field is forbidden. It is only
+	// set if the field is forbidden depending on the Kubernetes version of the resource.
+	// +optional
+	ForbiddenFromKubernetesVersion *string `json:"forbiddenFromKubernetesVersion,omitempty" protobuf:"bytes,5,opt,name=forbiddenFromKubernetesVersion"`
+}
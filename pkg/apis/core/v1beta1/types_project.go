@@ -5,6 +5,7 @@
 package v1beta1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -74,6 +75,19 @@ type ProjectSpec struct {
 	// DualApprovalForDeletion contains configuration for the dual approval concept for resource deletion.
 	// +optional
 	DualApprovalForDeletion []DualApprovalForDeletion `json:"dualApprovalForDeletion,omitempty" protobuf:"bytes,8,opt,name=dualApprovalForDeletion"`
+	// TrustedIdentityProviders contains a list of external OIDC identity providers whose tokens can be exchanged for
+	// short-lived credentials for the shoot clusters of this project.
+	// +optional
+	TrustedIdentityProviders []TrustedIdentityProvider `json:"trustedIdentityProviders,omitempty" protobuf:"bytes,9,rep,name=trustedIdentityProviders"`
+	// Quotas is a list of references to Quota objects which are enforced for all shoots of this project, independent
+	// of the credentials they use. Modifying this field requires the `modify-spec-quotas` custom verb.
+	// +optional
+	Quotas []corev1.ObjectReference `json:"quotas,omitempty" protobuf:"bytes,10,rep,name=quotas"`
+	// MemberSync contains the configuration for synchronizing the project members from groups of an external identity
+	// provider. Members which are managed by the synchronization are maintained by Gardener and should not be changed
+	// manually.
+	// +optional
+	MemberSync *ProjectMemberSync `json:"memberSync,omitempty" protobuf:"bytes,11,opt,name=memberSync"`
 }
 
 // ProjectStatus holds the most recently observed status of the project.
@@ -110,6 +124,25 @@ type ProjectMember struct {
 	Roles []string `json:"roles,omitempty" protobuf:"bytes,3,rep,name=roles"`
 }
 
+// ProjectMemberSync contains the configuration for synchronizing the project members from groups of an external
+// identity provider.
+type ProjectMemberSync struct {
+	// GroupPrefix is the prefix which is prepended to the group names when they are added as members of the project.
+	// It must match the groups prefix configured for the OIDC authentication of the garden cluster (e.g., 'oidc:').
+	// +optional
+	GroupPrefix *string `json:"groupPrefix,omitempty" protobuf:"bytes,1,opt,name=groupPrefix"`
+	// Groups is a list of mappings from groups of the identity provider to roles in the project.
+	Groups []ProjectMemberSyncGroup `json:"groups" protobuf:"bytes,2,rep,name=groups"`
+}
+
+// ProjectMemberSyncGroup maps a group of an external identity provider to roles in the project.
+type ProjectMemberSyncGroup struct {
+	// Name is the name of the group as presented in the groups claim of the identity provider.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Roles is the list of project roles which are granted to the members of the group.
+	Roles []string `json:"roles" protobuf:"bytes,2,rep,name=roles"`
+}
+
 // ProjectTolerations contains the tolerations for taints on seed clusters.
 type ProjectTolerations struct {
 	// Defaults contains a list of tolerations that are added to the shoots in this project by default.
@@ -134,6 +167,35 @@ type Toleration struct {
 	Value *string `json:"value,omitempty" protobuf:"bytes,2,opt,name=value"`
 }
 
+// TrustedIdentityProvider contains the configuration of an external OIDC identity provider (e.g., a CI system) whose
+// tokens are federated into short-lived shoot credentials.
+type TrustedIdentityProvider struct {
+	// Name is the name of the identity provider.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Issuer is the URL of the OIDC issuer. It must use the https scheme.
+	Issuer string `json:"issuer" protobuf:"bytes,2,opt,name=issuer"`
+	// Audiences is the list of acceptable audiences. The 'aud' claim of presented tokens must contain at least one of them.
+	Audiences []string `json:"audiences" protobuf:"bytes,3,rep,name=audiences"`
+	// Subjects is the list of acceptable values for the 'sub' claim of presented tokens. A value ending with '*' matches
+	// all subjects with the given prefix.
+	Subjects []string `json:"subjects" protobuf:"bytes,4,rep,name=subjects"`
+	// Access is the access level granted to the shoot clusters when exchanging tokens of this identity provider.
+	// Possible values are 'Admin' and 'Viewer'. Defaults to 'Viewer'.
+	// +optional
+	Access *TrustedIdentityProviderAccess `json:"access,omitempty" protobuf:"bytes,5,opt,name=access,casttype=TrustedIdentityProviderAccess"`
+}
+
+// TrustedIdentityProviderAccess is the access level granted for tokens of a trusted identity provider.
+// +enum
+type TrustedIdentityProviderAccess string
+
+const (
+	// TrustedIdentityProviderAccessAdmin grants admin access to the shoot clusters.
+	TrustedIdentityProviderAccessAdmin TrustedIdentityProviderAccess = "Admin"
+	// TrustedIdentityProviderAccessViewer grants viewer access (excluding Secrets) to the shoot clusters.
+	TrustedIdentityProviderAccessViewer TrustedIdentityProviderAccess = "Viewer"
+)
+
 // DualApprovalForDeletion contains configuration for the dual approval concept for resource deletion.
 type DualApprovalForDeletion struct {
 	// Resource is the name of the resource this applies to.
@@ -162,6 +224,7 @@ const (
 )
 
 // ProjectPhase is a label for the condition of a project at the current time.
+// +enum
 type ProjectPhase string
 
 const (
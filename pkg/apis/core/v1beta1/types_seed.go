@@ -81,6 +81,10 @@ type SeedSpec struct {
 	// AccessRestrictions describe a list of access restrictions for this seed cluster.
 	// +optional
 	AccessRestrictions []AccessRestriction `json:"accessRestrictions,omitempty" protobuf:"bytes,10,rep,name=accessRestrictions"`
+	// Maintenance contains information about the time window in which gardenlet may update the seed system components.
+	// If it is not specified, the seed system components are updated immediately, e.g., after gardenlet was upgraded.
+	// +optional
+	Maintenance *SeedMaintenance `json:"maintenance,omitempty" protobuf:"bytes,11,opt,name=maintenance"`
 }
 
 // SeedStatus is the status of a Seed.
@@ -116,6 +120,87 @@ type SeedStatus struct {
 	// LastOperation holds information about the last operation on the Seed.
 	// +optional
 	LastOperation *LastOperation `json:"lastOperation,omitempty" protobuf:"bytes,9,opt,name=lastOperation"`
+	// IngressDomainMigration contains information about the migration of the shoots hosted on this seed to a changed
+	// ingress domain.
+	// +optional
+	IngressDomainMigration *SeedIngressDomainMigration `json:"ingressDomainMigration,omitempty" protobuf:"bytes,10,opt,name=ingressDomainMigration"`
+	// Credentials contains information about the credentials of the seed-internal PKI.
+	// +optional
+	Credentials *SeedCredentials `json:"credentials,omitempty" protobuf:"bytes,11,opt,name=credentials"`
+	// SystemComponentsVersion is the version of the gardenlet which last deployed the seed system components.
+	// +optional
+	SystemComponentsVersion *string `json:"systemComponentsVersion,omitempty" protobuf:"bytes,12,opt,name=systemComponentsVersion"`
+	// PendingMaintenanceOperations lists the operations which will be performed in the next maintenance time window of
+	// the Seed.
+	// +optional
+	PendingMaintenanceOperations []SeedPendingMaintenanceOperation `json:"pendingMaintenanceOperations,omitempty" protobuf:"bytes,13,rep,name=pendingMaintenanceOperations"`
+}
+
+// SeedMaintenance contains information about the maintenance of a Seed.
+type SeedMaintenance struct {
+	// TimeWindow contains information about the time window in which gardenlet may update the seed system components,
+	// e.g., after gardenlet was upgraded.
+	TimeWindow MaintenanceTimeWindow `json:"timeWindow" protobuf:"bytes,1,opt,name=timeWindow"`
+}
+
+// SeedPendingMaintenanceOperation is an operation which will be performed in the next maintenance time window of the
+// Seed.
+type SeedPendingMaintenanceOperation struct {
+	// Type is the type of the operation, one of SystemComponentsUpdate.
+	Type SeedPendingMaintenanceOperationType `json:"type" protobuf:"bytes,1,opt,name=type,casttype=SeedPendingMaintenanceOperationType"`
+	// CurrentVersion is the version of the gardenlet which last deployed the seed system components.
+	// +optional
+	CurrentVersion *string `json:"currentVersion,omitempty" protobuf:"bytes,2,opt,name=currentVersion"`
+	// TargetVersion is the version of the gardenlet which will deploy the seed system components.
+	// +optional
+	TargetVersion *string `json:"targetVersion,omitempty" protobuf:"bytes,3,opt,name=targetVersion"`
+	// Description is a human-readable description of the operation.
+	Description string `json:"description" protobuf:"bytes,4,opt,name=description"`
+	// ScheduledTime is the beginning of the maintenance time window in which the operation will be performed.
+	ScheduledTime metav1.Time `json:"scheduledTime" protobuf:"bytes,5,opt,name=scheduledTime"`
+}
+
+// SeedPendingMaintenanceOperationType is a string alias.
+type SeedPendingMaintenanceOperationType string
+
+const (
+	// SeedPendingMaintenanceOperationSystemComponentsUpdate is the type of pending updates of the seed system components,
+	// e.g., after gardenlet was upgraded.
+	SeedPendingMaintenanceOperationSystemComponentsUpdate SeedPendingMaintenanceOperationType = "SystemComponentsUpdate"
+)
+
+// SeedCredentials contains information about the credentials of the seed-internal PKI.
+type SeedCredentials struct {
+	// Rotation contains information about the credential rotations.
+	// +optional
+	Rotation *SeedCredentialsRotation `json:"rotation,omitempty" protobuf:"bytes,1,opt,name=rotation"`
+}
+
+// SeedCredentialsRotation contains information about the rotation of credentials.
+type SeedCredentialsRotation struct {
+	// CertificateAuthorities contains information about the seed certificate authority credential rotation.
+	// +optional
+	CertificateAuthorities *CARotation `json:"certificateAuthorities,omitempty" protobuf:"bytes,1,opt,name=certificateAuthorities"`
+}
+
+// SeedIngressDomainMigration contains information about the migration of the shoots hosted on a seed to a changed
+// ingress domain.
+type SeedIngressDomainMigration struct {
+	// PreviousDomain is the ingress domain of the seed before the migration was started.
+	PreviousDomain string `json:"previousDomain" protobuf:"bytes,1,opt,name=previousDomain"`
+	// Domain is the ingress domain the shoots are migrated to.
+	Domain string `json:"domain" protobuf:"bytes,2,opt,name=domain"`
+	// StartTime is the time when the migration was started.
+	StartTime metav1.Time `json:"startTime" protobuf:"bytes,3,opt,name=startTime"`
+	// CompletionTime is the time when all shoots were migrated to the new ingress domain.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty" protobuf:"bytes,4,opt,name=completionTime"`
+	// Shoots is the number of shoots hosted on the seed which have to be migrated.
+	// +optional
+	Shoots int32 `json:"shoots,omitempty" protobuf:"varint,5,opt,name=shoots"`
+	// MigratedShoots is the number of shoots which were successfully reconciled with the new ingress domain.
+	// +optional
+	MigratedShoots int32 `json:"migratedShoots,omitempty" protobuf:"varint,6,opt,name=migratedShoots"`
 }
 
 // SeedBackup contains the object store configuration for backups for shoot (currently only etcd).
@@ -132,6 +217,25 @@ type SeedBackup struct {
 	// the object store where backups should be stored. It should have enough privileges to manipulate
 	// the objects as well as buckets.
 	SecretRef corev1.SecretReference `json:"secretRef" protobuf:"bytes,4,opt,name=secretRef"`
+	// Immutability contains the immutability (object lock) configuration of the backup bucket. If set, the etcd backups
+	// of the shoots are protected against deletion and overwrites for the configured retention period.
+	// +optional
+	Immutability *BackupBucketImmutability `json:"immutability,omitempty" protobuf:"bytes,5,opt,name=immutability"`
+	// ETCD contains bounds for the etcd backup settings which can be configured in the `.spec.etcd.backup` field of the
+	// shoots hosted on this seed.
+	// +optional
+	ETCD *SeedBackupETCD `json:"etcd,omitempty" protobuf:"bytes,6,opt,name=etcd"`
+}
+
+// SeedBackupETCD contains bounds for the etcd backup settings of the shoots hosted on a seed.
+type SeedBackupETCD struct {
+	// MinFullSnapshotInterval is the minimum interval between two full snapshots which shoots may configure via their
+	// full snapshot schedule.
+	// +optional
+	MinFullSnapshotInterval *metav1.Duration `json:"minFullSnapshotInterval,omitempty" protobuf:"bytes,1,opt,name=minFullSnapshotInterval"`
+	// MaxDeltaSnapshotRetentionPeriod is the maximum retention period for delta snapshots which shoots may configure.
+	// +optional
+	MaxDeltaSnapshotRetentionPeriod *metav1.Duration `json:"maxDeltaSnapshotRetentionPeriod,omitempty" protobuf:"bytes,2,opt,name=maxDeltaSnapshotRetentionPeriod"`
 }
 
 // SeedDNS contains DNS-relevant information about this seed cluster.
@@ -161,13 +265,18 @@ type SeedDNSProvider struct {
 // Ingress configures the Ingress specific settings of the cluster
 type Ingress struct {
 	// Domain specifies the IngressDomain of the cluster pointing to the ingress controller endpoint. It will be used
-	// to construct ingress URLs for system applications running in Shoot/Garden clusters. Once set this field is immutable.
+	// to construct ingress URLs for system applications running in Shoot/Garden clusters. Changing this field triggers a
+	// migration of all shoots hosted on the seed, see `.status.ingressDomainMigration`.
 	// +kubebuilder:validation:MaxLength=253
-	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="Value is immutable"
 	// +kubebuilder:validation:Pattern="^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$"
 	Domain string `json:"domain" protobuf:"bytes,1,name=domain"`
 	// Controller configures a Gardener managed Ingress Controller listening on the ingressDomain
 	Controller IngressController `json:"controller" protobuf:"bytes,2,name=controller"`
+	// KubeAPIServerPorts are additional ports of the Seed's istio ingress gateways on which the kube-apiservers of shoots
+	// can be exposed besides port 443 (see `.spec.kubernetes.kubeAPIServer.endpoint.port` of Shoots). The ports are forwarded
+	// to the same listener as port 443, i.e., all shoot kube-apiservers on this Seed are reachable via these ports.
+	// +optional
+	KubeAPIServerPorts []int32 `json:"kubeAPIServerPorts,omitempty" protobuf:"varint,3,rep,name=kubeAPIServerPorts"`
 }
 
 // IngressController enables a Gardener managed Ingress Controller listening on the ingressDomain
@@ -181,6 +290,18 @@ type IngressController struct {
 }
 
 // SeedNetworks contains CIDRs for the pod, service and node networks of a Kubernetes cluster.
+// +k8s:validation:cel[0]:rule="!isCIDR(self.pods) || !isCIDR(self.services) || !(cidr(self.pods).containsIP(cidr(self.services).ip()) || cidr(self.services).containsIP(cidr(self.pods).ip()))"
+// +k8s:validation:cel[0]:message="must not overlap with the pod network"
+// +k8s:validation:cel[0]:fieldPath=".services"
+// +k8s:validation:cel[1]:rule="!has(self.nodes) || !isCIDR(self.nodes) || [self.pods, self.services].all(n, !isCIDR(n) || !(cidr(n).containsIP(cidr(self.nodes).ip()) || cidr(self.nodes).containsIP(cidr(n).ip())))"
+// +k8s:validation:cel[1]:message="must not overlap with the pod or service network"
+// +k8s:validation:cel[1]:fieldPath=".nodes"
+// +k8s:validation:cel[2]:rule="!has(self.shootDefaults) || !has(self.shootDefaults.pods) || !isCIDR(self.shootDefaults.pods) || ([self.pods, self.services] + (has(self.nodes) ? [self.nodes] : [])).all(n, !isCIDR(n) || !(cidr(n).containsIP(cidr(self.shootDefaults.pods).ip()) || cidr(self.shootDefaults.pods).containsIP(cidr(n).ip())))"
+// +k8s:validation:cel[2]:message="must not overlap with the pod, service or node network"
+// +k8s:validation:cel[2]:fieldPath=".shootDefaults.pods"
+// +k8s:validation:cel[3]:rule="!has(self.shootDefaults) || !has(self.shootDefaults.services) || !isCIDR(self.shootDefaults.services) || ([self.pods, self.services] + (has(self.nodes) ? [self.nodes] : []) + (has(self.shootDefaults.pods) ? [self.shootDefaults.pods] : [])).all(n, !isCIDR(n) || !(cidr(n).containsIP(cidr(self.shootDefaults.services).ip()) || cidr(self.shootDefaults.services).containsIP(cidr(n).ip())))"
+// +k8s:validation:cel[3]:message="must not overlap with the pod, service or node network or the default shoot pod network"
+// +k8s:validation:cel[3]:fieldPath=".shootDefaults.services"
 type SeedNetworks struct {
 	// Nodes is the CIDR of the node network. This field is immutable.
 	// +optional
@@ -225,6 +346,10 @@ type SeedProvider struct {
 	// Zones is the list of availability zones the seed cluster is deployed to.
 	// +optional
 	Zones []string `json:"zones,omitempty" protobuf:"bytes,4,rep,name=zones"`
+	// RegionMetadata contains structured information about the region of the seed cluster, e.g. wrt data residency.
+	// If not set, the metadata of the region in the CloudProfile of the shoot is used (if the provider types match).
+	// +optional
+	RegionMetadata *RegionMetadata `json:"regionMetadata,omitempty" protobuf:"bytes,5,opt,name=regionMetadata"`
 }
 
 // SeedSettings contains certain settings for this seed cluster.
@@ -256,6 +381,11 @@ type SeedSettings struct {
 	// See https://github.com/gardener/gardener/blob/master/docs/operations/topology_aware_routing.md.
 	// +optional
 	TopologyAwareRouting *SeedSettingTopologyAwareRouting `json:"topologyAwareRouting,omitempty" protobuf:"bytes,8,opt,name=topologyAwareRouting"`
+	// ACME configures the ACME server (e.g., Let's Encrypt) which is used for issuing managed certificates for the
+	// kube-apiservers of shoots hosted by the seed, see `.spec.kubernetes.kubeAPIServer.endpoint.managedCertificate` in
+	// the Shoot API.
+	// +optional
+	ACME *SeedSettingACME `json:"acme,omitempty" protobuf:"bytes,9,opt,name=acme"`
 }
 
 // SeedSettingExcessCapacityReservation controls the excess capacity reservation for shoot control planes in the seed.
@@ -385,6 +515,17 @@ type SeedSettingTopologyAwareRouting struct {
 	Enabled bool `json:"enabled" protobuf:"bytes,1,opt,name=enabled"`
 }
 
+// SeedSettingACME configures the ACME server which is used for issuing managed certificates for the kube-apiservers
+// of shoots hosted by the seed.
+type SeedSettingACME struct {
+	// DirectoryURL is the URL of the directory endpoint of the ACME server, e.g.
+	// `https://acme-v02.api.letsencrypt.org/directory`.
+	DirectoryURL string `json:"directoryURL" protobuf:"bytes,1,opt,name=directoryURL"`
+	// Email is the contact email address of the ACME account which is registered by gardenlet.
+	// +optional
+	Email *string `json:"email,omitempty" protobuf:"bytes,2,opt,name=email"`
+}
+
 // SeedTaint describes a taint on a seed.
 type SeedTaint struct {
 	// Key is the taint key to be applied to a seed.
@@ -392,8 +533,28 @@ type SeedTaint struct {
 	// Value is the taint value corresponding to the taint key.
 	// +optional
 	Value *string `json:"value,omitempty" protobuf:"bytes,2,opt,name=value"`
+	// Effect is the effect of the taint on shoots that do not tolerate it.
+	// Valid effects are NoSchedule, PreferNoSchedule and NoExecute. If not set, the taint has the NoSchedule effect.
+	// +optional
+	Effect *SeedTaintEffect `json:"effect,omitempty" protobuf:"bytes,3,opt,name=effect,casttype=SeedTaintEffect"`
 }
 
+// SeedTaintEffect is the effect of a seed taint.
+type SeedTaintEffect string
+
+const (
+	// SeedTaintEffectNoSchedule is the effect of a taint which prevents shoots that do not tolerate the taint from being
+	// scheduled to the seed. Shoots which are already scheduled to the seed are not affected.
+	SeedTaintEffectNoSchedule SeedTaintEffect = "NoSchedule"
+	// SeedTaintEffectPreferNoSchedule is the effect of a taint which makes the scheduler avoid the seed for shoots that
+	// do not tolerate the taint. The seed is still chosen if there is no other suitable seed.
+	SeedTaintEffectPreferNoSchedule SeedTaintEffect = "PreferNoSchedule"
+	// SeedTaintEffectNoExecute is the effect of a taint which prevents shoots that do not tolerate the taint from being
+	// scheduled to the seed. Additionally, it indicates that the shoots already running on the seed without tolerating
+	// the taint should be migrated to another seed. Such migrations are not triggered automatically.
+	SeedTaintEffectNoExecute SeedTaintEffect = "NoExecute"
+)
+
 const (
 	// SeedTaintProtected is a constant for a taint key on a seed that marks it as protected. Protected seeds
 	// may only be used by shoots in the `garden` namespace.
@@ -429,6 +590,9 @@ const (
 	SeedGardenletReady ConditionType = "GardenletReady"
 	// SeedSystemComponentsHealthy is a constant for a condition type indicating the system components health.
 	SeedSystemComponentsHealthy ConditionType = "SeedSystemComponentsHealthy"
+	// SeedVolumeAttachmentsHealthy is a constant for a condition type indicating that no volumes of shoot control planes
+	// are stuck in attaching or detaching.
+	SeedVolumeAttachmentsHealthy ConditionType = "VolumeAttachmentsHealthy"
 )
 
 // Resource constants for Gardener object types
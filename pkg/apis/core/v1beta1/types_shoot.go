@@ -91,6 +91,7 @@ type ShootSpec struct {
 	// Provider contains all provider-specific and provider-relevant information.
 	Provider Provider `json:"provider" protobuf:"bytes,10,opt,name=provider"`
 	// Purpose is the purpose class for this cluster.
+	// +default="evaluation"
 	// +optional
 	Purpose *ShootPurpose `json:"purpose,omitempty" protobuf:"bytes,11,opt,name=purpose,casttype=ShootPurpose"`
 	// Region is a name of a region. This field is immutable.
@@ -128,6 +129,7 @@ type ShootSpec struct {
 	// SchedulerName is the name of the responsible scheduler which schedules the shoot.
 	// If not specified, the default scheduler takes over.
 	// This field is immutable.
+	// +default="default-scheduler"
 	// +optional
 	SchedulerName *string `json:"schedulerName,omitempty" protobuf:"bytes,21,opt,name=schedulerName"`
 	// CloudProfile contains a reference to a CloudProfile or a NamespacedCloudProfile.
@@ -140,6 +142,54 @@ type ShootSpec struct {
 	// AccessRestrictions describe a list of access restrictions for this shoot cluster.
 	// +optional
 	AccessRestrictions []AccessRestrictionWithOptions `json:"accessRestrictions,omitempty" protobuf:"bytes,24,rep,name=accessRestrictions"`
+	// DataResidency contains constraints for the placement of the shoot cluster wrt data residency. Both the region of
+	// the shoot and the region of the seed hosting its control plane must satisfy them.
+	// +optional
+	DataResidency *DataResidency `json:"dataResidency,omitempty" protobuf:"bytes,25,opt,name=dataResidency"`
+	// ETCD contains settings for the etcd of the shoot cluster.
+	// +optional
+	ETCD *ETCD `json:"etcd,omitempty" protobuf:"bytes,26,opt,name=etcd"`
+	// ExpirationTimestamp is the time after which the shoot cluster is automatically deleted. Warning events are emitted
+	// on the Shoot shortly before. If the project requires a dual approval for the deletion of this shoot, it is not
+	// deleted automatically.
+	// +optional
+	ExpirationTimestamp *metav1.Time `json:"expirationTimestamp,omitempty" protobuf:"bytes,27,opt,name=expirationTimestamp"`
+	// ShootClassName is the name of a ShootClass whose worker pools, control plane and hibernation settings are applied
+	// to the Shoot when it is created, unless the Shoot specifies them itself.
+	// This field is immutable.
+	// +optional
+	ShootClassName *string `json:"shootClassName,omitempty" protobuf:"bytes,28,opt,name=shootClassName"`
+}
+
+// DataResidency contains constraints for the placement of a shoot cluster wrt data residency.
+type DataResidency struct {
+	// Jurisdictions is the list of legal jurisdictions which are allowed for the regions of the shoot and its seed.
+	// +optional
+	Jurisdictions []string `json:"jurisdictions,omitempty" protobuf:"bytes,1,rep,name=jurisdictions"`
+	// Geographies is the list of geographical areas which are allowed for the regions of the shoot and its seed.
+	// +optional
+	Geographies []string `json:"geographies,omitempty" protobuf:"bytes,2,rep,name=geographies"`
+}
+
+// ETCD contains settings for the etcd of the shoot cluster.
+type ETCD struct {
+	// Backup contains settings for the backup of the etcd of the shoot cluster.
+	// +optional
+	Backup *ETCDBackup `json:"backup,omitempty" protobuf:"bytes,1,opt,name=backup"`
+}
+
+// ETCDBackup contains settings for the backup of the etcd of the shoot cluster. The settings are only considered if the
+// seed hosting the control plane of the shoot has a backup configured. They must be within the bounds defined in the
+// `.spec.backup.etcd` field of the seed.
+type ETCDBackup struct {
+	// FullSnapshotSchedule is the cron schedule (in standard cron format) which defines when full snapshots of the etcd
+	// are taken. If not specified, a daily schedule within the maintenance time window of the shoot is used.
+	// +optional
+	FullSnapshotSchedule *string `json:"fullSnapshotSchedule,omitempty" protobuf:"bytes,1,opt,name=fullSnapshotSchedule"`
+	// DeltaSnapshotRetentionPeriod is the duration for which delta snapshots are retained, excluding the latest snapshot
+	// set. If not specified, the default configured for the gardenlet is used.
+	// +optional
+	DeltaSnapshotRetentionPeriod *metav1.Duration `json:"deltaSnapshotRetentionPeriod,omitempty" protobuf:"bytes,2,opt,name=deltaSnapshotRetentionPeriod"`
 }
 
 // ShootStatus holds the most recently observed status of the Shoot cluster.
@@ -212,8 +262,91 @@ type ShootStatus struct {
 	// Networking contains information about cluster networking such as CIDRs.
 	// +optional
 	Networking *NetworkingStatus `json:"networking,omitempty" protobuf:"bytes,19,opt,name=networking"`
+	// SizeClass is the size class of the shoot cluster. It is periodically computed based on the number of nodes and
+	// the volume of requests to the API server.
+	// +optional
+	SizeClass *ShootSizeClass `json:"sizeClass,omitempty" protobuf:"bytes,20,opt,name=sizeClass,casttype=ShootSizeClass"`
+	// LegacyComponentRemovals contains information about the removal of deprecated components from the shoot cluster.
+	// +optional
+	// +patchMergeKey=name
+	// +patchStrategy=merge
+	LegacyComponentRemovals []LegacyComponentRemoval `json:"legacyComponentRemovals,omitempty" patchStrategy:"merge" patchMergeKey:"name" protobuf:"bytes,21,rep,name=legacyComponentRemovals"`
+	// EtcdSnapshot contains information about the last on-demand full snapshot of the Shoot's main etcd.
+	// +optional
+	EtcdSnapshot *EtcdSnapshot `json:"etcdSnapshot,omitempty" protobuf:"bytes,22,opt,name=etcdSnapshot"`
+	// PendingMaintenanceOperations lists the updates which will be applied to the Shoot in its next maintenance time
+	// window(s).
+	// +optional
+	PendingMaintenanceOperations []PendingMaintenanceOperation `json:"pendingMaintenanceOperations,omitempty" protobuf:"bytes,23,rep,name=pendingMaintenanceOperations"`
+	// Remediations contains information about the last executions of remediation actions for failed conditions of the
+	// Shoot.
+	// +optional
+	// +patchMergeKey=action
+	// +patchStrategy=merge
+	Remediations []ShootRemediation `json:"remediations,omitempty" patchStrategy:"merge" patchMergeKey:"action" protobuf:"bytes,24,rep,name=remediations"`
 }
 
+// ShootRemediation contains information about the last execution of a remediation action for a failed condition.
+type ShootRemediation struct {
+	// Action is the name of the remediation action.
+	Action string `json:"action" protobuf:"bytes,1,opt,name=action"`
+	// ConditionType is the type of the failed condition which triggered the last execution.
+	ConditionType ConditionType `json:"conditionType" protobuf:"bytes,2,opt,name=conditionType,casttype=ConditionType"`
+	// State is the state of the last execution, one of Succeeded, Failed.
+	State LastOperationState `json:"state" protobuf:"bytes,3,opt,name=state,casttype=LastOperationState"`
+	// Description is a human-readable description of the result of the last execution.
+	Description string `json:"description" protobuf:"bytes,4,opt,name=description"`
+	// LastExecutionTime is the time of the last execution.
+	LastExecutionTime metav1.Time `json:"lastExecutionTime" protobuf:"bytes,5,opt,name=lastExecutionTime"`
+}
+
+// LegacyComponentRemoval contains information about the removal of a deprecated component from the shoot cluster.
+type LegacyComponentRemoval struct {
+	// Name is the name of the legacy component.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// State is the state of the removal, one of Processing, Succeeded.
+	State LastOperationState `json:"state" protobuf:"bytes,2,opt,name=state,casttype=LastOperationState"`
+	// LastTransitionTime is the last time the state transitioned.
+	LastTransitionTime metav1.Time `json:"lastTransitionTime" protobuf:"bytes,3,opt,name=lastTransitionTime"`
+}
+
+// EtcdSnapshot contains information about an on-demand full snapshot of the main etcd.
+type EtcdSnapshot struct {
+	// Phase is the phase of the snapshot.
+	Phase EtcdSnapshotPhase `json:"phase" protobuf:"bytes,1,opt,name=phase,casttype=EtcdSnapshotPhase"`
+	// LastInitiationTime is the most recent time when a snapshot was requested.
+	// +optional
+	LastInitiationTime *metav1.Time `json:"lastInitiationTime,omitempty" protobuf:"bytes,2,opt,name=lastInitiationTime"`
+	// LastCompletionTime is the most recent time when a requested snapshot was taken successfully.
+	// +optional
+	LastCompletionTime *metav1.Time `json:"lastCompletionTime,omitempty" protobuf:"bytes,3,opt,name=lastCompletionTime"`
+	// Name is the name of the snapshot.
+	// +optional
+	Name *string `json:"name,omitempty" protobuf:"bytes,4,opt,name=name"`
+	// Location is the location of the snapshot in the backup bucket (<bucket>/<path>).
+	// +optional
+	Location *string `json:"location,omitempty" protobuf:"bytes,5,opt,name=location"`
+	// LastRevision is the last etcd revision contained in the snapshot.
+	// +optional
+	LastRevision *int64 `json:"lastRevision,omitempty" protobuf:"varint,6,opt,name=lastRevision"`
+	// Message contains details in case the snapshot could not be taken.
+	// +optional
+	Message *string `json:"message,omitempty" protobuf:"bytes,7,opt,name=message"`
+}
+
+// EtcdSnapshotPhase is a string alias.
+// +enum
+type EtcdSnapshotPhase string
+
+const (
+	// EtcdSnapshotPending indicates that a snapshot was requested but not yet taken.
+	EtcdSnapshotPending EtcdSnapshotPhase = "Pending"
+	// EtcdSnapshotSucceeded indicates that the requested snapshot was taken successfully.
+	EtcdSnapshotSucceeded EtcdSnapshotPhase = "Succeeded"
+	// EtcdSnapshotFailed indicates that the requested snapshot could not be taken.
+	EtcdSnapshotFailed EtcdSnapshotPhase = "Failed"
+)
+
 // LastMaintenance holds information about a maintenance operation on the Shoot.
 type LastMaintenance struct {
 	// A human-readable message containing details about the operations performed in the last maintenance.
@@ -227,6 +360,43 @@ type LastMaintenance struct {
 	FailureReason *string `json:"failureReason,omitempty" protobuf:"bytes,4,opt,name=failureReason"`
 }
 
+// PendingMaintenanceOperation is an update which will be applied to the Shoot in its next maintenance time window.
+type PendingMaintenanceOperation struct {
+	// Type is the type of the update, one of KubernetesVersion, MachineImageVersion, Configuration.
+	Type PendingMaintenanceOperationType `json:"type" protobuf:"bytes,1,opt,name=type,casttype=PendingMaintenanceOperationType"`
+	// WorkerPool is the name of the worker pool which is updated. It is not set for updates of the control plane.
+	// +optional
+	WorkerPool *string `json:"workerPool,omitempty" protobuf:"bytes,2,opt,name=workerPool"`
+	// CurrentVersion is the currently used Kubernetes or machine image version.
+	// +optional
+	CurrentVersion *string `json:"currentVersion,omitempty" protobuf:"bytes,3,opt,name=currentVersion"`
+	// TargetVersion is the Kubernetes or machine image version the Shoot will be updated to. It is not set if no
+	// suitable version could be determined, in which case the maintenance will fail.
+	// +optional
+	TargetVersion *string `json:"targetVersion,omitempty" protobuf:"bytes,4,opt,name=targetVersion"`
+	// Forced indicates that the update is applied regardless of the auto-update settings of the Shoot, e.g., because
+	// the current version expires.
+	// +optional
+	Forced bool `json:"forced,omitempty" protobuf:"varint,5,opt,name=forced"`
+	// Description is a human-readable description of the update and the reason why it is applied.
+	Description string `json:"description" protobuf:"bytes,6,opt,name=description"`
+	// ScheduledTime is the beginning of the maintenance time window in which the update will be applied.
+	ScheduledTime metav1.Time `json:"scheduledTime" protobuf:"bytes,7,opt,name=scheduledTime"`
+}
+
+// PendingMaintenanceOperationType is a string alias.
+type PendingMaintenanceOperationType string
+
+const (
+	// PendingMaintenanceOperationKubernetesVersion is the type of pending updates of the Kubernetes version.
+	PendingMaintenanceOperationKubernetesVersion PendingMaintenanceOperationType = "KubernetesVersion"
+	// PendingMaintenanceOperationMachineImageVersion is the type of pending updates of a machine image version.
+	PendingMaintenanceOperationMachineImageVersion PendingMaintenanceOperationType = "MachineImageVersion"
+	// PendingMaintenanceOperationConfiguration is the type of pending changes of the Shoot configuration which are
+	// enforced by an update, e.g., fields which are no longer supported by the target Kubernetes version.
+	PendingMaintenanceOperationConfiguration PendingMaintenanceOperationType = "Configuration"
+)
+
 // NetworkingStatus contains information about cluster networking such as CIDRs.
 type NetworkingStatus struct {
 	// Pods are the CIDRs of the pod network.
@@ -243,6 +413,11 @@ type NetworkingStatus struct {
 	// extension controller may opt to not populate this field.
 	// +optional
 	EgressCIDRs []string `json:"egressCIDRs,omitempty" protobuf:"bytes,4,rep,name=egressCIDRs"`
+	// ControlPlaneEgressCIDRs is a list of CIDRs used by the control plane components of the shoot as the source IP for
+	// egress traffic as reported by the used ControlPlane extension controller. It is only populated if a dedicated
+	// control plane egress is configured.
+	// +optional
+	ControlPlaneEgressCIDRs []string `json:"controlPlaneEgressCIDRs,omitempty" protobuf:"bytes,5,rep,name=controlPlaneEgressCIDRs"`
 }
 
 // ShootCredentials contains information about the shoot credentials.
@@ -368,6 +543,8 @@ type ETCDEncryptionKeyRotation struct {
 }
 
 // CredentialsRotationPhase is a string alias.
+// +enum
+// +kubebuilder:validation:Enum=Preparing;Prepared;Completing;Completed
 type CredentialsRotationPhase string
 
 const (
@@ -393,11 +570,31 @@ type ShootAdvertisedAddress struct {
 // Addons is a collection of configuration for specific addons which are managed by the Gardener.
 type Addons struct {
 	// KubernetesDashboard holds configuration settings for the kubernetes dashboard addon.
+	// This field is deprecated and will be removed in a future version, use managed addons instead.
 	// +optional
 	KubernetesDashboard *KubernetesDashboard `json:"kubernetesDashboard,omitempty" protobuf:"bytes,1,opt,name=kubernetesDashboard"`
 	// NginxIngress holds configuration settings for the nginx-ingress addon.
+	// This field is deprecated and will be removed in a future version, use managed addons instead.
 	// +optional
 	NginxIngress *NginxIngress `json:"nginxIngress,omitempty" protobuf:"bytes,2,opt,name=nginxIngress"`
+	// Managed is the list of managed addons which are deployed into the shoot cluster. The addons must be declared in
+	// the catalog of the referenced CloudProfile.
+	// +patchMergeKey=name
+	// +patchStrategy=merge
+	// +optional
+	Managed []ManagedAddon `json:"managed,omitempty" patchStrategy:"merge" patchMergeKey:"name" protobuf:"bytes,3,rep,name=managed"`
+}
+
+// ManagedAddon references an addon from the catalog of the CloudProfile which is deployed into the shoot cluster.
+type ManagedAddon struct {
+	// Name is the name of the addon in the catalog.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Version is the version of the addon.
+	Version string `json:"version" protobuf:"bytes,2,opt,name=version"`
+	// Values are the values for the Helm chart of the addon. They are validated against the values schema of the
+	// addon version.
+	// +optional
+	Values *runtime.RawExtension `json:"values,omitempty" protobuf:"bytes,3,opt,name=values"`
 }
 
 // Addon allows enabling or disabling a specific addon and is used to derive from.
@@ -441,6 +638,19 @@ type ControlPlane struct {
 	// control plane of a shoot.
 	// +optional
 	HighAvailability *HighAvailability `json:"highAvailability,omitempty" protobuf:"bytes,1,name=highAvailability"`
+	// Egress holds the configuration settings for the egress traffic of the control plane components of a shoot.
+	// +optional
+	Egress *ControlPlaneEgress `json:"egress,omitempty" protobuf:"bytes,2,opt,name=egress"`
+}
+
+// ControlPlaneEgress holds the configuration settings for the egress traffic of the control plane components of a shoot.
+type ControlPlaneEgress struct {
+	// Dedicated specifies whether the egress traffic of the control plane components running in the seed (e.g., calls
+	// from the kube-apiserver to webhooks in the shoot or calls to the cloud provider API) shall leave the seed via a
+	// source IP dedicated to this shoot. This allows allow-listing a stable per-cluster source IP. The dedicated egress
+	// must be provided by the extension controller responsible for the `ControlPlane` resource.
+	// +optional
+	Dedicated bool `json:"dedicated,omitempty" protobuf:"varint,1,opt,name=dedicated"`
 }
 
 // DNS holds information about the provider, the hosted zone id and the domain.
@@ -551,8 +761,17 @@ type HibernationSchedule struct {
 	// Location is the time location in which both start and shall be evaluated.
 	// +optional
 	Location *string `json:"location,omitempty" protobuf:"bytes,3,opt,name=location"`
+	// Exceptions is a list of dates or cron specs at which the shoot must not be hibernated by this schedule, e.g.,
+	// during planned load tests or release weekends. Dates must be specified in the RFC 5545 date format (YYYYMMDD).
+	// Hibernations whose time matches one of the cron specs are skipped as well. Both are evaluated in the location of
+	// the schedule. Waking up the shoot is never skipped.
+	// +optional
+	Exceptions []string `json:"exceptions,omitempty" protobuf:"bytes,4,rep,name=exceptions"`
 }
 
+// HibernationScheduleExceptionDateLayout is the layout of dates in the exceptions of hibernation schedules.
+const HibernationScheduleExceptionDateLayout = "20060102"
+
 // Kubernetes contains the version and configuration variables for the Shoot control plane.
 type Kubernetes struct {
 	// AllowPrivilegedContainers is tombstoned to show why 1 is reserved protobuf tag.
@@ -593,6 +812,51 @@ type Kubernetes struct {
 	// Starting Kubernetes 1.27 the field will be locked to false.
 	// +optional
 	EnableStaticTokenKubeconfig *bool `json:"enableStaticTokenKubeconfig,omitempty" protobuf:"varint,10,opt,name=enableStaticTokenKubeconfig"`
+	// NamespaceDefaults contains LimitRanges and ResourceQuotas which are applied to the namespaces in the Shoot cluster.
+	// +optional
+	NamespaceDefaults *NamespaceDefaults `json:"namespaceDefaults,omitempty" protobuf:"bytes,11,opt,name=namespaceDefaults"`
+}
+
+// NamespaceDefaults contains LimitRanges and ResourceQuotas which are applied to the namespaces in the Shoot cluster.
+type NamespaceDefaults struct {
+	// NamespaceSelector selects the namespaces to which the LimitRanges and ResourceQuotas are applied. If not set, they
+	// are applied to all namespaces. Namespaces managed by Gardener, e.g. `kube-system`, are never selected.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty" protobuf:"bytes,1,opt,name=namespaceSelector"`
+	// LimitRanges are the LimitRanges which are applied to the selected namespaces.
+	// +optional
+	LimitRanges []NamespaceDefaultsLimitRange `json:"limitRanges,omitempty" protobuf:"bytes,2,rep,name=limitRanges"`
+	// ResourceQuotas are the ResourceQuotas which are applied to the selected namespaces.
+	// +optional
+	ResourceQuotas []NamespaceDefaultsResourceQuota `json:"resourceQuotas,omitempty" protobuf:"bytes,3,rep,name=resourceQuotas"`
+	// NetworkPolicies configures the default NetworkPolicies which are applied to the selected namespaces.
+	// +optional
+	NetworkPolicies *NamespaceDefaultsNetworkPolicies `json:"networkPolicies,omitempty" protobuf:"bytes,4,opt,name=networkPolicies"`
+}
+
+// NamespaceDefaultsNetworkPolicies configures the default NetworkPolicies which are applied to the selected namespaces
+// in the Shoot cluster.
+type NamespaceDefaultsNetworkPolicies struct {
+	// DenyAll enables a NetworkPolicy which denies all ingress and egress traffic of the pods in the selected namespaces.
+	// Additionally, NetworkPolicies allowing egress traffic to the cluster DNS and to the kube-apiserver are applied.
+	// Namespaces labeled with `networking.gardener.cloud/default-network-policies=disabled` are excluded.
+	DenyAll bool `json:"denyAll" protobuf:"varint,1,opt,name=denyAll"`
+}
+
+// NamespaceDefaultsLimitRange is a LimitRange which is applied to the selected namespaces in the Shoot cluster.
+type NamespaceDefaultsLimitRange struct {
+	// Name is the name of the LimitRange in the namespaces.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Spec is the specification of the LimitRange.
+	Spec corev1.LimitRangeSpec `json:"spec" protobuf:"bytes,2,opt,name=spec"`
+}
+
+// NamespaceDefaultsResourceQuota is a ResourceQuota which is applied to the selected namespaces in the Shoot cluster.
+type NamespaceDefaultsResourceQuota struct {
+	// Name is the name of the ResourceQuota in the namespaces.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Spec is the specification of the ResourceQuota.
+	Spec corev1.ResourceQuotaSpec `json:"spec" protobuf:"bytes,2,opt,name=spec"`
 }
 
 // ClusterAutoscaler contains the configuration flags for the Kubernetes cluster autoscaler.
@@ -610,6 +874,7 @@ type ClusterAutoscaler struct {
 	// +optional
 	ScaleDownUnneededTime *metav1.Duration `json:"scaleDownUnneededTime,omitempty" protobuf:"bytes,4,opt,name=scaleDownUnneededTime"`
 	// ScaleDownUtilizationThreshold defines the threshold in fraction (0.0 - 1.0) under which a node is being removed (default: 0.5).
+	// +default=0.5
 	// +optional
 	ScaleDownUtilizationThreshold *float64 `json:"scaleDownUtilizationThreshold,omitempty" protobuf:"fixed64,5,opt,name=scaleDownUtilizationThreshold"`
 	// ScanInterval how often cluster is reevaluated for scale up or down (default: 10 secs).
@@ -617,12 +882,14 @@ type ClusterAutoscaler struct {
 	ScanInterval *metav1.Duration `json:"scanInterval,omitempty" protobuf:"bytes,6,opt,name=scanInterval"`
 	// Expander defines the algorithm to use during scale up (default: least-waste).
 	// See: https://github.com/gardener/autoscaler/blob/machine-controller-manager-provider/cluster-autoscaler/FAQ.md#what-are-expanders.
+	// +default="least-waste"
 	// +optional
 	Expander *ExpanderMode `json:"expander,omitempty" protobuf:"bytes,7,opt,name=expander"`
 	// MaxNodeProvisionTime defines how long CA waits for node to be provisioned (default: 20 mins).
 	// +optional
 	MaxNodeProvisionTime *metav1.Duration `json:"maxNodeProvisionTime,omitempty" protobuf:"bytes,8,opt,name=maxNodeProvisionTime"`
 	// MaxGracefulTerminationSeconds is the number of seconds CA waits for pod termination when trying to scale down a node (default: 600).
+	// +default=600
 	// +optional
 	MaxGracefulTerminationSeconds *int32 `json:"maxGracefulTerminationSeconds,omitempty" protobuf:"varint,9,opt,name=maxGracefulTerminationSeconds"`
 	// IgnoreTaints specifies a list of taint keys to ignore in node templates when considering to scale a node group.
@@ -634,12 +901,15 @@ type ClusterAutoscaler struct {
 	// +optional
 	NewPodScaleUpDelay *metav1.Duration `json:"newPodScaleUpDelay,omitempty" protobuf:"bytes,11,opt,name=newPodScaleUpDelay"`
 	// MaxEmptyBulkDelete specifies the maximum number of empty nodes that can be deleted at the same time (default: 10).
+	// +default=10
 	// +optional
 	MaxEmptyBulkDelete *int32 `json:"maxEmptyBulkDelete,omitempty" protobuf:"varint,12,opt,name=maxEmptyBulkDelete"`
 	// IgnoreDaemonsetsUtilization allows CA to ignore DaemonSet pods when calculating resource utilization for scaling down (default: false).
+	// +default=false
 	// +optional
 	IgnoreDaemonsetsUtilization *bool `json:"ignoreDaemonsetsUtilization,omitempty" protobuf:"varint,13,opt,name=ignoreDaemonsetsUtilization"`
 	// Verbosity allows CA to modify its log level (default: 2).
+	// +default=2
 	// +optional
 	Verbosity *int32 `json:"verbosity,omitempty" protobuf:"varint,14,opt,name=verbosity"`
 	// StartupTaints specifies a list of taint keys to ignore in node templates when considering to scale a node group.
@@ -864,6 +1134,72 @@ type KubeAPIServerConfig struct {
 	// This field is only available for Kubernetes v1.30 or later.
 	// +optional
 	StructuredAuthorization *StructuredAuthorization `json:"structuredAuthorization,omitempty" protobuf:"bytes,18,opt,name=structuredAuthorization"`
+	// AuthorizedNetworks is a list of CIDRs which are allowed to access the kube-apiserver via its external domain.
+	// Connections from other source IPs are rejected by the istio ingress gateway of the seed. The internal domain
+	// (used by the shoot's nodes and control plane components) is not restricted.
+	// If empty, the access is not restricted.
+	// +optional
+	AuthorizedNetworks []string `json:"authorizedNetworks,omitempty" protobuf:"bytes,19,rep,name=authorizedNetworks"`
+	// Endpoint contains settings for exposing the kube-apiserver under additional host names and on an additional port.
+	// +optional
+	Endpoint *KubeAPIServerEndpoint `json:"endpoint,omitempty" protobuf:"bytes,20,opt,name=endpoint"`
+	// APIPriorityAndFairness contains settings for the API Priority and Fairness feature of the kube-apiserver.
+	// +optional
+	APIPriorityAndFairness *APIPriorityAndFairness `json:"apiPriorityAndFairness,omitempty" protobuf:"bytes,21,opt,name=apiPriorityAndFairness"`
+	// VPN contains settings for the VPN tunnels between the kube-apiserver and the shoot cluster.
+	// +optional
+	VPN *KubeAPIServerVPNConfig `json:"vpn,omitempty" protobuf:"bytes,22,opt,name=vpn"`
+}
+
+// KubeAPIServerVPNConfig contains settings for the VPN tunnels between the kube-apiserver and the shoot cluster.
+type KubeAPIServerVPNConfig struct {
+	// HighAvailability enables the highly available VPN. In this mode, multiple vpn-seed-server replicas and vpn-shoot
+	// clients establish multiple tunnels, and the kube-apiserver selects a working tunnel for each request path.
+	// Defaults to true if the control plane is configured to be highly available.
+	// +optional
+	HighAvailability *bool `json:"highAvailability,omitempty" protobuf:"varint,1,opt,name=highAvailability"`
+	// SeedServers is the number of vpn-seed-server replicas used for the highly available VPN. Allowed values are
+	// between 2 and 4. Defaults to 2.
+	// +optional
+	SeedServers *int32 `json:"seedServers,omitempty" protobuf:"varint,2,opt,name=seedServers"`
+	// ShootClients is the number of vpn-shoot clients used for the highly available VPN. Allowed values are between 2
+	// and 4. Defaults to 2.
+	// +optional
+	ShootClients *int32 `json:"shootClients,omitempty" protobuf:"varint,3,opt,name=shootClients"`
+}
+
+// APIPriorityAndFairness contains settings for the API Priority and Fairness feature of the kube-apiserver.
+type APIPriorityAndFairness struct {
+	// MaxSeats is the total number of seats (i.e., the concurrency limit) of the kube-apiserver which are shared by all
+	// priority levels. It cannot be combined with `.requests`.
+	// +optional
+	MaxSeats *int32 `json:"maxSeats,omitempty" protobuf:"varint,1,opt,name=maxSeats"`
+	// ConfigMapName is the name of the ConfigMap in the project namespace which contains custom FlowSchemas and
+	// PriorityLevelConfigurations (flowcontrol.apiserver.k8s.io/v1) in the `config.yaml` data key. They are deployed to
+	// the shoot cluster as managed resources. The mandatory and system-critical configurations cannot be overwritten,
+	// and custom FlowSchemas must neither take precedence over nor route requests to the system-critical ones.
+	// +optional
+	ConfigMapName *string `json:"configMapName,omitempty" protobuf:"bytes,2,opt,name=configMapName"`
+}
+
+// KubeAPIServerEndpoint contains settings for exposing the kube-apiserver under additional host names and on an additional
+// port of the seed's istio ingress gateway.
+type KubeAPIServerEndpoint struct {
+	// AdditionalHostnames are additional host names under which the kube-apiserver is reachable via the seed's istio
+	// ingress gateway. They are added to the serving certificate of the kube-apiserver. The DNS records for these host
+	// names are not managed by Gardener and must point to the external domain of the kube-apiserver.
+	// +optional
+	AdditionalHostnames []string `json:"additionalHostnames,omitempty" protobuf:"bytes,1,rep,name=additionalHostnames"`
+	// Port is the port on which the kube-apiserver is advertised via its external domain instead of 443. It must be
+	// one of the ports offered by the seed in `.spec.ingress.kubeAPIServerPorts`. Port 443 continues to be served.
+	// +optional
+	Port *int32 `json:"port,omitempty" protobuf:"varint,2,opt,name=port"`
+	// ManagedCertificate specifies whether gardenlet issues and rotates a publicly trusted certificate for the
+	// additional host names using the ACME server configured in `.spec.settings.acme` of the seed. The certificate is
+	// served by the kube-apiserver for the additional host names instead of the certificate signed by the cluster CA.
+	// Defaults to false.
+	// +optional
+	ManagedCertificate *bool `json:"managedCertificate,omitempty" protobuf:"varint,3,opt,name=managedCertificate"`
 }
 
 // APIServerLogging contains configuration for the logs level and http access logs
@@ -934,6 +1270,38 @@ type AuditConfig struct {
 	// AuditPolicy contains configuration settings for audit policy of the kube-apiserver.
 	// +optional
 	AuditPolicy *AuditPolicy `json:"auditPolicy,omitempty" protobuf:"bytes,1,opt,name=auditPolicy"`
+	// Webhook contains settings for sending the audit events of the kube-apiserver to a webhook backend.
+	// +optional
+	Webhook *AuditWebhook `json:"webhook,omitempty" protobuf:"bytes,2,opt,name=webhook"`
+	// Sink contains settings for delivering the audit events of the kube-apiserver to a managed service of a provider
+	// (e.g., AWS CloudWatch or Google Cloud Logging). The delivery is implemented by an extension for the given type.
+	// This field is mutually exclusive with the `webhook` field.
+	// +optional
+	Sink *AuditSink `json:"sink,omitempty" protobuf:"bytes,3,opt,name=sink"`
+}
+
+// AuditSink contains settings for delivering the audit events of the kube-apiserver to a managed service of a provider.
+type AuditSink struct {
+	// Type is the type of the audit sink extension.
+	Type string `json:"type" protobuf:"bytes,1,opt,name=type"`
+	// ProviderConfig is the configuration passed to the audit sink extension.
+	// +optional
+	ProviderConfig *runtime.RawExtension `json:"providerConfig,omitempty" protobuf:"bytes,2,opt,name=providerConfig"`
+}
+
+// AuditWebhook contains settings for an audit webhook backend of the kube-apiserver.
+type AuditWebhook struct {
+	// KubeconfigSecretName specifies the name of a secret containing the kubeconfig for the audit webhook backend.
+	// The secret must be referenced in the shoot's `.spec.resources`.
+	KubeconfigSecretName string `json:"kubeconfigSecretName" protobuf:"bytes,1,opt,name=kubeconfigSecretName"`
+	// BatchMaxSize is the maximum size of a batch of audit events sent to the webhook backend
+	// (flag `--audit-webhook-batch-max-size`).
+	// +optional
+	BatchMaxSize *int32 `json:"batchMaxSize,omitempty" protobuf:"varint,2,opt,name=batchMaxSize"`
+	// BatchMaxWait is the amount of time to wait before sending a batch of audit events that has not reached the
+	// maximum size (flag `--audit-webhook-batch-max-wait`).
+	// +optional
+	BatchMaxWait *metav1.Duration `json:"batchMaxWait,omitempty" protobuf:"bytes,3,opt,name=batchMaxWait"`
 }
 
 // AuditPolicy contains audit policy for kube-apiserver
@@ -1031,6 +1399,11 @@ type AdmissionPlugin struct {
 	// KubeconfigSecretName specifies the name of a secret containing the kubeconfig for this admission plugin.
 	// +optional
 	KubeconfigSecretName *string `json:"kubeconfigSecretName,omitempty" protobuf:"bytes,4,opt,name=kubeconfigSecretName"`
+	// ConfigMapName specifies the name of a ConfigMap containing the configuration of the plugin in its `config.yaml`
+	// data key. The ConfigMap must be in the same namespace as the object referencing it. It is mutually exclusive
+	// with Config. Changes to the ConfigMap are applied during the next reconciliation.
+	// +optional
+	ConfigMapName *string `json:"configMapName,omitempty" protobuf:"bytes,5,opt,name=configMapName"`
 }
 
 // WatchCacheSizes contains configuration of the API server's watch cache sizes.
@@ -1082,6 +1455,24 @@ type KubeControllerManagerConfig struct {
 	// NodeMonitorGracePeriod defines the grace period before an unresponsive node is marked unhealthy.
 	// +optional
 	NodeMonitorGracePeriod *metav1.Duration `json:"nodeMonitorGracePeriod,omitempty" protobuf:"bytes,5,opt,name=nodeMonitorGracePeriod"`
+	// Controllers contains the settings of individual controllers of the kube-controller-manager, e.g., to disable them
+	// or to configure the number of objects they sync concurrently.
+	// +optional
+	Controllers []KubeControllerManagerController `json:"controllers,omitempty" protobuf:"bytes,6,rep,name=controllers"`
+}
+
+// KubeControllerManagerController contains the settings of an individual controller of the kube-controller-manager.
+type KubeControllerManagerController struct {
+	// Name is the name of the controller, e.g., `ttl-after-finished`.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Enabled indicates whether the controller is enabled. If unset, the controller keeps its default state. Controllers
+	// which are required by Gardener cannot be disabled.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty" protobuf:"varint,2,opt,name=enabled"`
+	// ConcurrentSyncs is the number of objects the controller is allowed to sync concurrently. Only supported for
+	// controllers offering a corresponding `--concurrent-*-syncs` flag.
+	// +optional
+	ConcurrentSyncs *int32 `json:"concurrentSyncs,omitempty" protobuf:"varint,3,opt,name=concurrentSyncs"`
 }
 
 // HorizontalPodAutoscalerConfig contains horizontal pod autoscaler configuration settings for the kube-controller-manager.
@@ -1133,6 +1524,7 @@ type KubeSchedulerConfig struct {
 }
 
 // SchedulingProfile is a string alias used for scheduling profile values.
+// +enum
 type SchedulingProfile string
 
 const (
@@ -1165,6 +1557,7 @@ type KubeProxyConfig struct {
 // In Linux platform, if the iptables proxy is selected, regardless of how, but the system's kernel or iptables versions are
 // insufficient, this always falls back to the userspace proxy. IPVS mode will be enabled when proxy mode is set to 'ipvs',
 // and the fall back path is firstly iptables and then userspace.
+// +enum
 type ProxyMode string
 
 const (
@@ -1298,6 +1691,16 @@ type KubeletConfig struct {
 	// MemorySwap configures swap memory available to container workloads.
 	// +optional
 	MemorySwap *MemorySwapConfiguration `json:"memorySwap,omitempty" protobuf:"bytes,26,opt,name=memorySwap"`
+	// MemoryManagerPolicy is the name of the policy to use by the memory manager. May be one of {"None", "Static"}.
+	// The "Static" policy requires reservedMemory to be configured.
+	// +optional
+	// Default: None
+	MemoryManagerPolicy *MemoryManagerPolicy `json:"memoryManagerPolicy,omitempty" protobuf:"bytes,27,opt,name=memoryManagerPolicy"`
+	// ReservedMemory specifies the memory reservations for NUMA nodes used by the "Static" memory manager policy.
+	// The sum of all reservations for a resource must equal the memory reserved via kubeReserved plus the hard eviction
+	// threshold (memory.available), otherwise the kubelet refuses to start.
+	// +optional
+	ReservedMemory []KubeletMemoryReservation `json:"reservedMemory,omitempty" protobuf:"bytes,28,rep,name=reservedMemory"`
 }
 
 // KubeletConfigEviction contains kubelet eviction thresholds supporting either a resource.Quantity or a percentage based value.
@@ -1374,6 +1777,7 @@ type KubeletConfigReserved struct {
 }
 
 // SwapBehavior configures swap memory available to container workloads
+// +enum
 type SwapBehavior string
 
 const (
@@ -1389,6 +1793,26 @@ const (
 	UnlimitedSwap SwapBehavior = "UnlimitedSwap"
 )
 
+// MemoryManagerPolicy is the name of the policy used by the kubelet's memory manager.
+// +enum
+type MemoryManagerPolicy string
+
+const (
+	// MemoryManagerPolicyNone is a constant for the kubelet's memory manager policy not affecting the memory allocation.
+	MemoryManagerPolicyNone MemoryManagerPolicy = "None"
+	// MemoryManagerPolicyStatic is a constant for the kubelet's memory manager policy guaranteeing memory (and hugepages)
+	// allocation from a minimal set of NUMA nodes for pods in the Guaranteed QoS class.
+	MemoryManagerPolicyStatic MemoryManagerPolicy = "Static"
+)
+
+// KubeletMemoryReservation specifies the memory reservation of different types for a NUMA node.
+type KubeletMemoryReservation struct {
+	// NumaNode is the index of the NUMA node.
+	NumaNode int32 `json:"numaNode" protobuf:"varint,1,opt,name=numaNode"`
+	// Limits are the reserved amounts per resource, e.g. memory or hugepages-1Gi.
+	Limits corev1.ResourceList `json:"limits" protobuf:"bytes,2,rep,name=limits,casttype=k8s.io/api/core/v1.ResourceList,castkey=k8s.io/api/core/v1.ResourceName"`
+}
+
 // MemorySwapConfiguration contains kubelet swap configuration
 // For more information, please see KEP: 2400-node-swap
 type MemorySwapConfiguration struct {
@@ -1416,7 +1840,8 @@ type Networking struct {
 	// Services is the CIDR of the service network. This field is immutable.
 	// +optional
 	Services *string `json:"services,omitempty" protobuf:"bytes,5,opt,name=services"`
-	// IPFamilies specifies the IP protocol versions to use for shoot networking. This field is immutable.
+	// IPFamilies specifies the IP protocol versions to use for shoot networking.
+	// The only supported change of this field is the migration from ["IPv4"] to ["IPv4", "IPv6"].
 	// See https://github.com/gardener/gardener/blob/master/docs/development/ipv6.md.
 	// Defaults to ["IPv4"].
 	// +optional
@@ -1435,6 +1860,8 @@ const (
 	MaintenanceTimeWindowDurationMinimum = 30 * time.Minute
 	// MaintenanceTimeWindowDurationMaximum is the maximum duration for a maintenance time window.
 	MaintenanceTimeWindowDurationMaximum = 6 * time.Hour
+	// MaintenanceExclusionDurationMaximum is the maximum duration for a maintenance exclusion.
+	MaintenanceExclusionDurationMaximum = 90 * 24 * time.Hour
 )
 
 // Maintenance contains information about the time window for maintenance operations and which
@@ -1451,8 +1878,60 @@ type Maintenance struct {
 	// an immediate roll out which is changes to the Spec.Hibernation.Enabled field.
 	// +optional
 	ConfineSpecUpdateRollout *bool `json:"confineSpecUpdateRollout,omitempty" protobuf:"varint,3,opt,name=confineSpecUpdateRollout"`
+	// Exclusions is a list of time windows in which the listed operations must not be performed by Gardener, e.g.,
+	// during blackout periods like retail peak seasons.
+	// +patchMergeKey=name
+	// +patchStrategy=merge
+	// +optional
+	Exclusions []MaintenanceExclusion `json:"exclusions,omitempty" patchStrategy:"merge" patchMergeKey:"name" protobuf:"bytes,4,rep,name=exclusions"`
+	// WorkerPools contains maintenance time windows for individual worker pools. Automatic updates of the machine image
+	// and Kubernetes versions of the listed worker pools are performed in their own time window instead of the time
+	// window of the shoot.
+	// +patchMergeKey=name
+	// +patchStrategy=merge
+	// +optional
+	WorkerPools []WorkerPoolMaintenance `json:"workerPools,omitempty" patchStrategy:"merge" patchMergeKey:"name" protobuf:"bytes,5,rep,name=workerPools"`
+}
+
+// WorkerPoolMaintenance contains information about the time window for maintenance operations of a worker pool.
+type WorkerPoolMaintenance struct {
+	// Name is the name of the worker pool.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// TimeWindow contains information about the time window for maintenance operations of the worker pool.
+	TimeWindow MaintenanceTimeWindow `json:"timeWindow" protobuf:"bytes,2,opt,name=timeWindow"`
 }
 
+// MaintenanceExclusion is a time window in which certain operations must not be performed.
+type MaintenanceExclusion struct {
+	// Name is the name of the exclusion.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Begin is the point in time when the exclusion starts.
+	Begin metav1.Time `json:"begin" protobuf:"bytes,2,opt,name=begin"`
+	// End is the point in time when the exclusion ends.
+	End metav1.Time `json:"end" protobuf:"bytes,3,opt,name=end"`
+	// Operations is the list of operations which must not be performed while the exclusion is active.
+	// Possible values are "NodeRoll", "EtcdMaintenance" and "CARotation".
+	Operations []MaintenanceExclusionOperation `json:"operations" protobuf:"bytes,4,rep,name=operations,casttype=MaintenanceExclusionOperation"`
+}
+
+// MaintenanceExclusionOperation is an operation which can be excluded from a time window.
+// +enum
+type MaintenanceExclusionOperation string
+
+const (
+	// MaintenanceExclusionOperationNodeRoll excludes operations initiated by Gardener which roll the nodes of the shoot
+	// cluster, i.e., automatic updates of machine image and Kubernetes versions and the start of credentials rotations.
+	// Node rolls caused by other changes of the shoot specification or by the replacement of unhealthy machines are not
+	// prevented.
+	MaintenanceExclusionOperationNodeRoll MaintenanceExclusionOperation = "NodeRoll"
+	// MaintenanceExclusionOperationEtcdMaintenance excludes the maintenance of etcd, i.e., defragmentation and
+	// restarts caused by scaling down.
+	MaintenanceExclusionOperationEtcdMaintenance MaintenanceExclusionOperation = "EtcdMaintenance"
+	// MaintenanceExclusionOperationCARotation excludes starting and completing the rotation of the certificate
+	// authorities.
+	MaintenanceExclusionOperationCARotation MaintenanceExclusionOperation = "CARotation"
+)
+
 // MaintenanceAutoUpdate contains information about which constraints should be automatically updated.
 type MaintenanceAutoUpdate struct {
 	// KubernetesVersion indicates whether the patch Kubernetes version may be automatically updated (default: true).
@@ -1575,13 +2054,87 @@ type Worker struct {
 	// +optional
 	MachineControllerManagerSettings *MachineControllerManagerSettings `json:"machineControllerManager,omitempty" protobuf:"bytes,19,opt,name=machineControllerManager"`
 	// Sysctls is a map of kernel settings to apply on all machines in this worker pool.
+	// Only settings in the `fs`, `kernel`, `net`, `user` and `vm` namespaces are allowed.
 	// +optional
 	Sysctls map[string]string `json:"sysctls,omitempty" protobuf:"bytes,20,rep,name=sysctls"`
 	// ClusterAutoscaler contains the cluster autoscaler configurations for the worker pool.
 	// +optional
 	ClusterAutoscaler *ClusterAutoscalerOptions `json:"clusterAutoscaler,omitempty" protobuf:"bytes,21,opt,name=clusterAutoscaler"`
+	// CapacityType is the type of capacity used for the machines of this worker pool (default: OnDemand).
+	// Possible values are `OnDemand`, `Spot` and `SpotWithFallback`. With `SpotWithFallback`, the machines are preferably
+	// created with spot/preemptible capacity and the cluster-autoscaler falls back to on-demand capacity if no spot
+	// capacity is available.
+	// The capacity type must be supported by the machine type in the CloudProfile.
+	// +optional
+	CapacityType *CapacityType `json:"capacityType,omitempty" protobuf:"bytes,22,opt,name=capacityType,casttype=CapacityType"`
+	// UpdateStrategy is the strategy used for applying changes of the machine image version and the Kubernetes version
+	// to the machines of this worker pool (default: RollingUpdate).
+	// With `RollingUpdate`, the machines are replaced by new machines. With `InPlace`, the changes are applied to the
+	// existing machines by gardener-node-agent without rolling the worker pool.
+	// The update strategy cannot be switched between `RollingUpdate` and `InPlace` once the worker pool has been created.
+	// +optional
+	UpdateStrategy *WorkerUpdateStrategy `json:"updateStrategy,omitempty" protobuf:"bytes,23,opt,name=updateStrategy,casttype=WorkerUpdateStrategy"`
+	// RolloutStrategy contains settings for rolling out the machines of this worker pool relative to the other worker
+	// pools.
+	// +optional
+	RolloutStrategy *WorkerRolloutStrategy `json:"rolloutStrategy,omitempty" protobuf:"bytes,24,opt,name=rolloutStrategy"`
+	// NodeTemplate contains settings for how the labels, annotations and taints of this worker pool are applied to the
+	// `Node` objects.
+	// +optional
+	NodeTemplate *WorkerNodeTemplate `json:"nodeTemplate,omitempty" protobuf:"bytes,25,opt,name=nodeTemplate"`
+	// KernelModules is a list of kernel modules to load on all machines in this worker pool.
+	// Only kernel modules from a list of supported modules are allowed.
+	// +optional
+	KernelModules []string `json:"kernelModules,omitempty" protobuf:"bytes,26,rep,name=kernelModules"`
+}
+
+// WorkerNodeTemplate contains settings for how the labels, annotations and taints of a worker pool are applied to the
+// `Node` objects.
+type WorkerNodeTemplate struct {
+	// EnforceLabels makes gardener-node-agent continuously reconcile the labels, annotations and taints of the worker
+	// pool on the existing `Node` objects, i.e., manual changes to them are reverted. By default, they are only applied
+	// when a `Node` is created or when they are changed in the worker pool (default: false).
+	// +optional
+	EnforceLabels *bool `json:"enforceLabels,omitempty" protobuf:"varint,1,opt,name=enforceLabels"`
+}
+
+// WorkerRolloutStrategy contains settings for rolling out the machines of a worker pool.
+type WorkerRolloutStrategy struct {
+	// Order is the position of the worker pool in the sequence of rolling updates of all worker pools, e.g., during a
+	// Kubernetes version upgrade or a credentials rotation. Worker pools with a lower order are rolled before worker
+	// pools with a higher order, worker pools with the same order are rolled concurrently (default: 0).
+	// +optional
+	Order *int32 `json:"order,omitempty" protobuf:"varint,1,opt,name=order"`
 }
 
+// WorkerUpdateStrategy is a type for the update strategy of a worker pool.
+// +enum
+type WorkerUpdateStrategy string
+
+const (
+	// WorkerUpdateStrategyRollingUpdate indicates that machines are replaced by new machines when the machine image
+	// version or the Kubernetes version of the worker pool changes.
+	WorkerUpdateStrategyRollingUpdate WorkerUpdateStrategy = "RollingUpdate"
+	// WorkerUpdateStrategyInPlace indicates that changes of the machine image version or the Kubernetes version of the
+	// worker pool are applied to the existing machines.
+	WorkerUpdateStrategyInPlace WorkerUpdateStrategy = "InPlace"
+)
+
+// CapacityType is a type for the capacity of machines in a worker pool.
+// +enum
+type CapacityType string
+
+const (
+	// CapacityTypeOnDemand is a constant for regular on-demand capacity.
+	CapacityTypeOnDemand CapacityType = "OnDemand"
+	// CapacityTypeSpot is a constant for spot/preemptible capacity which can be reclaimed by the infrastructure provider
+	// at any time.
+	CapacityTypeSpot CapacityType = "Spot"
+	// CapacityTypeSpotWithFallback is a constant for preferring spot/preemptible capacity while falling back to on-demand
+	// capacity if no spot capacity is available.
+	CapacityTypeSpotWithFallback CapacityType = "SpotWithFallback"
+)
+
 // ClusterAutoscalerOptions contains the cluster autoscaler configurations for a worker pool.
 type ClusterAutoscalerOptions struct {
 	// ScaleDownUtilizationThreshold defines the threshold in fraction (0.0 - 1.0) under which a node is being removed.
@@ -1618,12 +2171,39 @@ type MachineControllerManagerSettings struct {
 	// NodeConditions are the set of conditions if set to true for the period of MachineHealthTimeout, machine will be declared failed.
 	// +optional
 	NodeConditions []string `json:"nodeConditions,omitempty" protobuf:"bytes,5,name=nodeConditions"`
+	// MachineBootstrapTimeout is the period after which a node which has not completed its bootstrap is considered stuck.
+	// A node has not completed its bootstrap as long as gardener-node-agent has not applied the operating system config
+	// or the node-critical components are not ready (default: 20m).
+	// +optional
+	MachineBootstrapTimeout *metav1.Duration `json:"machineBootstrapTimeout,omitempty" protobuf:"bytes,6,opt,name=machineBootstrapTimeout"`
+	// MachineBootstrapFailurePolicy determines what happens with nodes which are stuck in their bootstrap. Possible
+	// values are `Ignore` and `Replace`. With `Replace`, the machines of such nodes are deleted so that they get replaced
+	// by new machines (default: Ignore).
+	// +optional
+	MachineBootstrapFailurePolicy *MachineBootstrapFailurePolicy `json:"machineBootstrapFailurePolicy,omitempty" protobuf:"bytes,7,opt,name=machineBootstrapFailurePolicy,casttype=MachineBootstrapFailurePolicy"`
 }
 
+// MachineBootstrapFailurePolicy is a type for the policy applied to nodes which are stuck in their bootstrap.
+// +enum
+type MachineBootstrapFailurePolicy string
+
+const (
+	// MachineBootstrapFailurePolicyIgnore indicates that nodes stuck in their bootstrap are only reported.
+	MachineBootstrapFailurePolicyIgnore MachineBootstrapFailurePolicy = "Ignore"
+	// MachineBootstrapFailurePolicyReplace indicates that the machines of nodes stuck in their bootstrap are replaced.
+	MachineBootstrapFailurePolicyReplace MachineBootstrapFailurePolicy = "Replace"
+)
+
 // WorkerSystemComponents contains configuration for system components related to this worker pool
 type WorkerSystemComponents struct {
 	// Allow determines whether the pool should be allowed to host system components or not (defaults to true)
 	Allow bool `json:"allow" protobuf:"bytes,1,name=allow"`
+	// ReservedNodesPercentage is the percentage (1-100) of nodes of this worker pool which are reserved for system
+	// components. The reserved nodes are selected by gardener-resource-manager and tainted so that only system components
+	// (and pods tolerating the taint) are scheduled onto them. This keeps capacity for system components available even
+	// if the other nodes of the worker pool are saturated by user workload. The number of reserved nodes is rounded up.
+	// +optional
+	ReservedNodesPercentage *int32 `json:"reservedNodesPercentage,omitempty" protobuf:"varint,2,opt,name=reservedNodesPercentage"`
 }
 
 // WorkerKubernetes contains configuration for Kubernetes components related to this worker pool.
@@ -1649,8 +2229,14 @@ type Machine struct {
 	// +optional
 	Image *ShootMachineImage `json:"image,omitempty" protobuf:"bytes,2,opt,name=image"`
 	// Architecture is CPU architecture of machines in this worker pool.
+	// +default="amd64"
 	// +optional
 	Architecture *string `json:"architecture,omitempty" protobuf:"bytes,3,opt,name=architecture"`
+	// OSFamily is the operating system family of machines in this worker pool. Possible values are "linux" and
+	// "windows". Defaults to "linux". This field is immutable.
+	// +default="linux"
+	// +optional
+	OSFamily *string `json:"osFamily,omitempty" protobuf:"bytes,4,opt,name=osFamily"`
 }
 
 // ShootMachineImage defines the name and the version of the shoot's machine image in any environment. Has to be
@@ -1680,6 +2266,12 @@ type Volume struct {
 	// Encrypted determines if the volume should be encrypted.
 	// +optional
 	Encrypted *bool `json:"encrypted,omitempty" protobuf:"varint,4,opt,name=encrypted"`
+	// IOPS is the number of provisioned I/O operations per second of the volume.
+	// +optional
+	IOPS *int64 `json:"iops,omitempty" protobuf:"varint,5,opt,name=iops"`
+	// Throughput is the provisioned throughput of the volume in MiB/s.
+	// +optional
+	Throughput *int64 `json:"throughput,omitempty" protobuf:"varint,6,opt,name=throughput"`
 }
 
 // DataVolume contains information about a data volume.
@@ -1694,6 +2286,12 @@ type DataVolume struct {
 	// Encrypted determines if the volume should be encrypted.
 	// +optional
 	Encrypted *bool `json:"encrypted,omitempty" protobuf:"varint,4,opt,name=encrypted"`
+	// IOPS is the number of provisioned I/O operations per second of the volume.
+	// +optional
+	IOPS *int64 `json:"iops,omitempty" protobuf:"varint,5,opt,name=iops"`
+	// Throughput is the provisioned throughput of the volume in MiB/s.
+	// +optional
+	Throughput *int64 `json:"throughput,omitempty" protobuf:"varint,6,opt,name=throughput"`
 }
 
 // CRI contains information about the Container Runtimes.
@@ -1706,6 +2304,7 @@ type CRI struct {
 }
 
 // CRIName is a type alias for the CRI name string.
+// +enum
 type CRIName string
 
 const (
@@ -1754,6 +2353,12 @@ type SystemComponents struct {
 	// NodeLocalDNS contains the settings of the node local DNS components running in the data plane of the Shoot cluster.
 	// +optional
 	NodeLocalDNS *NodeLocalDNS `json:"nodeLocalDNS,omitempty" protobuf:"bytes,2,opt,name=nodeLocalDNS"`
+	// DedicatedPool is the name of the worker pool which exclusively hosts the system components running in the data
+	// plane of the Shoot cluster. The nodes of this pool are tainted so that regular workload is not scheduled onto them.
+	// System components are not allowed to run on any other worker pool, i.e., `.systemComponents.allow` of all other
+	// worker pools defaults to `false` and must not be set to `true`.
+	// +optional
+	DedicatedPool *string `json:"dedicatedPool,omitempty" protobuf:"bytes,3,opt,name=dedicatedPool"`
 }
 
 // CoreDNS contains the settings of the Core DNS components running in the data plane of the Shoot cluster.
@@ -1764,6 +2369,10 @@ type CoreDNS struct {
 	// Rewriting contains the setting related to rewriting of requests, which are obviously incorrect due to the unnecessary application of the search path.
 	// +optional
 	Rewriting *CoreDNSRewriting `json:"rewriting,omitempty" protobuf:"bytes,2,opt,name=rewriting"`
+	// CustomPlugins contains the settings of the optional plugins in the plugin chain of the Core DNS components
+	// running in the data plane of the Shoot cluster.
+	// +optional
+	CustomPlugins *CoreDNSCustomPlugins `json:"customPlugins,omitempty" protobuf:"bytes,3,opt,name=customPlugins"`
 }
 
 // CoreDNSAutoscaling contains the settings related to autoscaling of the Core DNS components running in the data plane of the Shoot cluster.
@@ -1774,6 +2383,7 @@ type CoreDNSAutoscaling struct {
 }
 
 // CoreDNSAutoscalingMode is a type alias for the Core DNS autoscaling mode string.
+// +enum
 type CoreDNSAutoscalingMode string
 
 const (
@@ -1790,6 +2400,38 @@ type CoreDNSRewriting struct {
 	CommonSuffixes []string `json:"commonSuffixes,omitempty" protobuf:"bytes,1,rep,name=commonSuffixes"`
 }
 
+// CoreDNSCustomPlugins contains the settings of the optional plugins in the plugin chain of the Core DNS components.
+type CoreDNSCustomPlugins struct {
+	// Log indicates whether all DNS queries are logged. By default, only failed queries are logged.
+	// +optional
+	Log *bool `json:"log,omitempty" protobuf:"varint,1,opt,name=log"`
+	// DNS64 contains the settings of the dns64 plugin which synthesizes AAAA records from A records. If unset, the
+	// plugin is disabled.
+	// +optional
+	DNS64 *CoreDNSDNS64 `json:"dns64,omitempty" protobuf:"bytes,2,opt,name=dns64"`
+	// ForwardZones is a list of zones whose DNS queries are forwarded to dedicated upstream name servers instead of the
+	// name servers of the nodes.
+	// +optional
+	ForwardZones []CoreDNSForwardZone `json:"forwardZones,omitempty" protobuf:"bytes,3,rep,name=forwardZones"`
+}
+
+// CoreDNSDNS64 contains the settings of the dns64 plugin.
+type CoreDNSDNS64 struct {
+	// Prefix is the IPv6 prefix used to synthesize AAAA records. Its length must be one of 32, 40, 48, 56, 64 or 96.
+	// Defaults to the well-known prefix `64:ff9b::/96` if unset.
+	// +optional
+	Prefix *string `json:"prefix,omitempty" protobuf:"bytes,1,opt,name=prefix"`
+}
+
+// CoreDNSForwardZone contains the settings for forwarding the DNS queries of a zone.
+type CoreDNSForwardZone struct {
+	// Zone is the domain whose DNS queries are forwarded, e.g. `corp.example.com`.
+	Zone string `json:"zone" protobuf:"bytes,1,opt,name=zone"`
+	// Upstreams is the list of name servers the DNS queries are forwarded to. Each entry is an IP address with an
+	// optional port, e.g. `10.0.0.10` or `10.0.0.10:5353`. At most 15 upstreams are supported.
+	Upstreams []string `json:"upstreams" protobuf:"bytes,2,rep,name=upstreams"`
+}
+
 // NodeLocalDNS contains the settings of the node local DNS components running in the data plane of the Shoot cluster.
 type NodeLocalDNS struct {
 	// Enabled indicates whether node local DNS is enabled or not.
@@ -1806,11 +2448,60 @@ type NodeLocalDNS struct {
 	// Default, if unspecified, is to forward requests for external domains to upstream DNS
 	// +optional
 	DisableForwardToUpstreamDNS *bool `json:"disableForwardToUpstreamDNS,omitempty" protobuf:"varint,4,opt,name=disableForwardToUpstreamDNS"`
+	// Cache contains the settings of the cache of node local DNS. If unset, successful responses are cached for up to 30
+	// seconds and negative responses for up to 5 seconds (`cluster.local`) or 30 seconds (all other zones).
+	// +optional
+	Cache *NodeLocalDNSCache `json:"cache,omitempty" protobuf:"bytes,5,opt,name=cache"`
+	// ZoneProtocols is a list of zones for which the protocol used by node local DNS to forward requests is overridden.
+	// Overrides take precedence over ForceTCPToClusterDNS and ForceTCPToUpstreamDNS.
+	// +optional
+	ZoneProtocols []NodeLocalDNSZoneProtocol `json:"zoneProtocols,omitempty" protobuf:"bytes,6,rep,name=zoneProtocols"`
+	// DisabledWorkerPools is a list of names of worker pools whose nodes do not run node local DNS. Pods on these nodes
+	// resolve DNS directly via the cluster DNS (Core DNS).
+	// +optional
+	DisabledWorkerPools []string `json:"disabledWorkerPools,omitempty" protobuf:"bytes,7,rep,name=disabledWorkerPools"`
 }
 
+// NodeLocalDNSCache contains the settings of the cache of node local DNS.
+type NodeLocalDNSCache struct {
+	// SuccessTTLSeconds is the maximum time in seconds successful responses are cached. Defaults to 30.
+	// +optional
+	SuccessTTLSeconds *int32 `json:"successTTLSeconds,omitempty" protobuf:"varint,1,opt,name=successTTLSeconds"`
+	// DenialTTLSeconds is the maximum time in seconds negative responses (NXDOMAIN, NODATA) are cached. Defaults to 5
+	// for `cluster.local` and 30 for all other zones. Must not be set if negative caching is disabled.
+	// +optional
+	DenialTTLSeconds *int32 `json:"denialTTLSeconds,omitempty" protobuf:"varint,2,opt,name=denialTTLSeconds"`
+	// DisableNegativeCaching indicates whether caching of negative responses is disabled. Defaults to false.
+	// +optional
+	DisableNegativeCaching *bool `json:"disableNegativeCaching,omitempty" protobuf:"varint,3,opt,name=disableNegativeCaching"`
+}
+
+// NodeLocalDNSZoneProtocol contains the protocol used by node local DNS to forward requests of a zone.
+type NodeLocalDNSZoneProtocol struct {
+	// Zone is the zone served by node local DNS. Supported values are `cluster.local`, `in-addr.arpa`, `ip6.arpa` and `.`
+	// (all other domains).
+	Zone string `json:"zone" protobuf:"bytes,1,opt,name=zone"`
+	// Protocol is the protocol used to forward requests of the zone. Supported values are `TCP` (always use TCP) and
+	// `UDP` (prefer UDP and retry truncated responses with TCP).
+	Protocol NodeLocalDNSProtocol `json:"protocol" protobuf:"bytes,2,opt,name=protocol,casttype=NodeLocalDNSProtocol"`
+}
+
+// NodeLocalDNSProtocol is a type alias for the protocol used by node local DNS to forward requests.
+// +enum
+type NodeLocalDNSProtocol string
+
+const (
+	// NodeLocalDNSProtocolTCP is a constant for forwarding requests always via TCP.
+	NodeLocalDNSProtocolTCP NodeLocalDNSProtocol = "TCP"
+	// NodeLocalDNSProtocolUDP is a constant for forwarding requests preferably via UDP.
+	NodeLocalDNSProtocolUDP NodeLocalDNSProtocol = "UDP"
+)
+
 const (
 	// ShootMaintenanceFailed indicates that a shoot maintenance operation failed.
 	ShootMaintenanceFailed = "MaintenanceFailed"
+	// ShootMaintenancePostponed indicates that a shoot maintenance operation has been postponed.
+	ShootMaintenancePostponed = "MaintenancePostponed"
 	// ShootEventImageVersionMaintenance indicates that a maintenance operation regarding the image version has been performed.
 	ShootEventImageVersionMaintenance = "MachineImageVersionMaintenance"
 	// ShootEventK8sVersionMaintenance indicates that a maintenance operation regarding the K8s version has been performed.
@@ -1819,10 +2510,27 @@ const (
 	ShootEventHibernationEnabled = "Hibernated"
 	// ShootEventHibernationDisabled indicates that hibernation ended.
 	ShootEventHibernationDisabled = "WokenUp"
+	// ShootEventHibernationSkipped indicates that a scheduled hibernation was skipped due to an exception.
+	ShootEventHibernationSkipped = "HibernationSkipped"
 	// ShootEventSchedulingSuccessful indicates that a scheduling decision was taken successfully.
 	ShootEventSchedulingSuccessful = "SchedulingSuccessful"
 	// ShootEventSchedulingFailed indicates that a scheduling decision failed.
 	ShootEventSchedulingFailed = "SchedulingFailed"
+	// ShootEventDeprecatedFieldsMigrated indicates that deprecated fields were rewritten to their replacements.
+	ShootEventDeprecatedFieldsMigrated = "DeprecatedFieldsMigrated"
+	// ShootEventExpiring indicates that the expiration timestamp of the shoot is about to be reached.
+	ShootEventExpiring = "Expiring"
+	// ShootEventExpired indicates that the expiration timestamp of the shoot was reached and the shoot is deleted.
+	ShootEventExpired = "Expired"
+	// ShootEventExpiredDeletionNotApproved indicates that the expiration timestamp of the shoot was reached but the shoot
+	// cannot be deleted automatically because its deletion requires a dual approval.
+	ShootEventExpiredDeletionNotApproved = "ExpiredDeletionNotApproved"
+	// ShootEventDeletionPostponed indicates that the deletion of the shoot is postponed because its seed is in outage
+	// mode.
+	ShootEventDeletionPostponed = "DeletionPostponed"
+	// ShootEventDeprecatedFieldsMigrationFailed indicates that deprecated fields could not be rewritten to their
+	// replacements.
+	ShootEventDeprecatedFieldsMigrationFailed = "DeprecatedFieldsMigrationFailed"
 )
 
 const (
@@ -1847,9 +2555,22 @@ const (
 	// ShootCRDsWithProblematicConversionWebhooks is a constant for a condition type indicating that the Shoot cluster has
 	// CRDs with conversion webhooks and multiple stored versions which can break the reconciliation flow of the cluster.
 	ShootCRDsWithProblematicConversionWebhooks ConditionType = "CRDsWithProblematicConversionWebhooks"
+	// ShootPodCIDRUtilizationAcceptable is a constant for a condition type indicating that enough IP addresses are
+	// still available in the pod CIDR of the Shoot cluster.
+	ShootPodCIDRUtilizationAcceptable ConditionType = "PodCIDRUtilizationAcceptable"
+	// ShootBackupImmutabilityConfigured is a constant for a condition type indicating that the etcd backups of the
+	// Shoot cluster are protected against deletion and overwrites by an immutability policy of the backup bucket.
+	ShootBackupImmutabilityConfigured ConditionType = "BackupImmutabilityConfigured"
+	// ShootDualStackNodesMigrationReady is a constant for a condition type indicating that all nodes of the Shoot
+	// cluster have been migrated to dual-stack networking, i.e., they have been assigned pod CIDRs of all IP families.
+	ShootDualStackNodesMigrationReady ConditionType = "DualStackNodesMigrationReady"
+	// ShootReconciliationDegraded is a constant for a condition type indicating that the reconciliation of the Shoot is
+	// reduced to health-critical actions because its Seed is in outage mode.
+	ShootReconciliationDegraded ConditionType = "ReconciliationDegraded"
 )
 
 // ShootPurpose is a type alias for string.
+// +enum
 type ShootPurpose string
 
 const (
@@ -1864,3 +2585,18 @@ const (
 	// ShootPurposeInfrastructure is a constant for the infrastructure purpose.
 	ShootPurposeInfrastructure ShootPurpose = "infrastructure"
 )
+
+// ShootSizeClass is a type alias for string.
+// +enum
+type ShootSizeClass string
+
+const (
+	// ShootSizeClassSmall is a constant for small shoot clusters.
+	ShootSizeClassSmall ShootSizeClass = "S"
+	// ShootSizeClassMedium is a constant for medium-sized shoot clusters.
+	ShootSizeClassMedium ShootSizeClass = "M"
+	// ShootSizeClassLarge is a constant for large shoot clusters.
+	ShootSizeClassLarge ShootSizeClass = "L"
+	// ShootSizeClassExtraLarge is a constant for extra-large shoot clusters.
+	ShootSizeClassExtraLarge ShootSizeClass = "XL"
+)
@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ShootClass represents a template for Shoot clusters, e.g., a t-shirt size, which encapsulates the worker pool layout,
+// the high availability settings and the hibernation defaults. Shoots can reference a ShootClass by name, its settings
+// are applied to the Shoot when it is created.
+type ShootClass struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object metadata.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	// Spec contains the settings of the ShootClass.
+	Spec ShootClassSpec `json:"spec" protobuf:"bytes,2,opt,name=spec"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ShootClassList is a collection of ShootClasses.
+type ShootClassList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard list object metadata.
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	// Items is the list of ShootClasses.
+	Items []ShootClass `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// ShootClassSpec contains the settings of a ShootClass.
+type ShootClassSpec struct {
+	// ProviderType restricts the usage of the ShootClass to Shoots of the given provider type. It must be set if worker
+	// pools are specified.
+	// +optional
+	ProviderType *string `json:"providerType,omitempty" protobuf:"bytes,1,opt,name=providerType"`
+	// Workers is the list of worker pools which is applied to Shoots not specifying any worker pools.
+	// +patchMergeKey=name
+	// +patchStrategy=merge
+	// +optional
+	Workers []Worker `json:"workers,omitempty" patchStrategy:"merge" patchMergeKey:"name" protobuf:"bytes,2,rep,name=workers"`
+	// ControlPlane contains the control plane settings (e.g., high availability) which are applied to Shoots not
+	// specifying any control plane settings.
+	// +optional
+	ControlPlane *ControlPlane `json:"controlPlane,omitempty" protobuf:"bytes,3,opt,name=controlPlane"`
+	// Hibernation contains the hibernation settings which are applied to Shoots not specifying any hibernation settings.
+	// +optional
+	Hibernation *Hibernation `json:"hibernation,omitempty" protobuf:"bytes,4,opt,name=hibernation"`
+}
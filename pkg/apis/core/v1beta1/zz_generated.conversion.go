@@ -31,6 +31,16 @@ func init() {
 // RegisterConversions adds conversion functions to the given scheme.
 // Public to allow building arbitrary schemes.
 func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*APIPriorityAndFairness)(nil), (*core.APIPriorityAndFairness)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_APIPriorityAndFairness_To_core_APIPriorityAndFairness(a.(*APIPriorityAndFairness), b.(*core.APIPriorityAndFairness), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.APIPriorityAndFairness)(nil), (*APIPriorityAndFairness)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_APIPriorityAndFairness_To_v1beta1_APIPriorityAndFairness(a.(*core.APIPriorityAndFairness), b.(*APIPriorityAndFairness), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*APIServerLogging)(nil), (*core.APIServerLogging)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_APIServerLogging_To_core_APIServerLogging(a.(*APIServerLogging), b.(*core.APIServerLogging), scope)
 	}); err != nil {
@@ -81,6 +91,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*AddonDefinition)(nil), (*core.AddonDefinition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AddonDefinition_To_core_AddonDefinition(a.(*AddonDefinition), b.(*core.AddonDefinition), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.AddonDefinition)(nil), (*AddonDefinition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_AddonDefinition_To_v1beta1_AddonDefinition(a.(*core.AddonDefinition), b.(*AddonDefinition), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AddonVersion)(nil), (*core.AddonVersion)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AddonVersion_To_core_AddonVersion(a.(*AddonVersion), b.(*core.AddonVersion), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.AddonVersion)(nil), (*AddonVersion)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_AddonVersion_To_v1beta1_AddonVersion(a.(*core.AddonVersion), b.(*AddonVersion), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Addons)(nil), (*core.Addons)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Addons_To_core_Addons(a.(*Addons), b.(*core.Addons), scope)
 	}); err != nil {
@@ -131,6 +161,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*AuditSink)(nil), (*core.AuditSink)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AuditSink_To_core_AuditSink(a.(*AuditSink), b.(*core.AuditSink), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.AuditSink)(nil), (*AuditSink)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_AuditSink_To_v1beta1_AuditSink(a.(*core.AuditSink), b.(*AuditSink), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AuditWebhook)(nil), (*core.AuditWebhook)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AuditWebhook_To_core_AuditWebhook(a.(*AuditWebhook), b.(*core.AuditWebhook), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.AuditWebhook)(nil), (*AuditWebhook)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_AuditWebhook_To_v1beta1_AuditWebhook(a.(*core.AuditWebhook), b.(*AuditWebhook), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*AuthorizerKubeconfigReference)(nil), (*core.AuthorizerKubeconfigReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_AuthorizerKubeconfigReference_To_core_AuthorizerKubeconfigReference(a.(*AuthorizerKubeconfigReference), b.(*core.AuthorizerKubeconfigReference), scope)
 	}); err != nil {
@@ -161,6 +211,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*BackupBucketImmutability)(nil), (*core.BackupBucketImmutability)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_BackupBucketImmutability_To_core_BackupBucketImmutability(a.(*BackupBucketImmutability), b.(*core.BackupBucketImmutability), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.BackupBucketImmutability)(nil), (*BackupBucketImmutability)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_BackupBucketImmutability_To_v1beta1_BackupBucketImmutability(a.(*core.BackupBucketImmutability), b.(*BackupBucketImmutability), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*BackupBucketList)(nil), (*core.BackupBucketList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_BackupBucketList_To_core_BackupBucketList(a.(*BackupBucketList), b.(*core.BackupBucketList), scope)
 	}); err != nil {
@@ -331,6 +391,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*CloudProfileStatus)(nil), (*core.CloudProfileStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CloudProfileStatus_To_core_CloudProfileStatus(a.(*CloudProfileStatus), b.(*core.CloudProfileStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.CloudProfileStatus)(nil), (*CloudProfileStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_CloudProfileStatus_To_v1beta1_CloudProfileStatus(a.(*core.CloudProfileStatus), b.(*CloudProfileStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CloudProfileUsage)(nil), (*core.CloudProfileUsage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CloudProfileUsage_To_core_CloudProfileUsage(a.(*CloudProfileUsage), b.(*core.CloudProfileUsage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.CloudProfileUsage)(nil), (*CloudProfileUsage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_CloudProfileUsage_To_v1beta1_CloudProfileUsage(a.(*core.CloudProfileUsage), b.(*CloudProfileUsage), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ClusterAutoscaler)(nil), (*core.ClusterAutoscaler)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ClusterAutoscaler_To_core_ClusterAutoscaler(a.(*ClusterAutoscaler), b.(*core.ClusterAutoscaler), scope)
 	}); err != nil {
@@ -381,6 +461,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ControlPlaneEgress)(nil), (*core.ControlPlaneEgress)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ControlPlaneEgress_To_core_ControlPlaneEgress(a.(*ControlPlaneEgress), b.(*core.ControlPlaneEgress), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ControlPlaneEgress)(nil), (*ControlPlaneEgress)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ControlPlaneEgress_To_v1beta1_ControlPlaneEgress(a.(*core.ControlPlaneEgress), b.(*ControlPlaneEgress), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ControllerDeploymentList)(nil), (*core.ControllerDeploymentList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ControllerDeploymentList_To_core_ControllerDeploymentList(a.(*ControllerDeploymentList), b.(*core.ControllerDeploymentList), scope)
 	}); err != nil {
@@ -511,6 +601,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*CoreDNSCustomPlugins)(nil), (*core.CoreDNSCustomPlugins)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CoreDNSCustomPlugins_To_core_CoreDNSCustomPlugins(a.(*CoreDNSCustomPlugins), b.(*core.CoreDNSCustomPlugins), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.CoreDNSCustomPlugins)(nil), (*CoreDNSCustomPlugins)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_CoreDNSCustomPlugins_To_v1beta1_CoreDNSCustomPlugins(a.(*core.CoreDNSCustomPlugins), b.(*CoreDNSCustomPlugins), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CoreDNSDNS64)(nil), (*core.CoreDNSDNS64)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CoreDNSDNS64_To_core_CoreDNSDNS64(a.(*CoreDNSDNS64), b.(*core.CoreDNSDNS64), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.CoreDNSDNS64)(nil), (*CoreDNSDNS64)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_CoreDNSDNS64_To_v1beta1_CoreDNSDNS64(a.(*core.CoreDNSDNS64), b.(*CoreDNSDNS64), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CoreDNSForwardZone)(nil), (*core.CoreDNSForwardZone)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CoreDNSForwardZone_To_core_CoreDNSForwardZone(a.(*CoreDNSForwardZone), b.(*core.CoreDNSForwardZone), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.CoreDNSForwardZone)(nil), (*CoreDNSForwardZone)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_CoreDNSForwardZone_To_v1beta1_CoreDNSForwardZone(a.(*core.CoreDNSForwardZone), b.(*CoreDNSForwardZone), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CoreDNSRewriting)(nil), (*core.CoreDNSRewriting)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_CoreDNSRewriting_To_core_CoreDNSRewriting(a.(*CoreDNSRewriting), b.(*core.CoreDNSRewriting), scope)
 	}); err != nil {
@@ -551,6 +671,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*DataResidency)(nil), (*core.DataResidency)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_DataResidency_To_core_DataResidency(a.(*DataResidency), b.(*core.DataResidency), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.DataResidency)(nil), (*DataResidency)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_DataResidency_To_v1beta1_DataResidency(a.(*core.DataResidency), b.(*DataResidency), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*DataVolume)(nil), (*core.DataVolume)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_DataVolume_To_core_DataVolume(a.(*DataVolume), b.(*core.DataVolume), scope)
 	}); err != nil {
@@ -571,6 +701,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*DeprecatedField)(nil), (*core.DeprecatedField)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_DeprecatedField_To_core_DeprecatedField(a.(*DeprecatedField), b.(*core.DeprecatedField), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.DeprecatedField)(nil), (*DeprecatedField)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_DeprecatedField_To_v1beta1_DeprecatedField(a.(*core.DeprecatedField), b.(*DeprecatedField), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*DualApprovalForDeletion)(nil), (*core.DualApprovalForDeletion)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_DualApprovalForDeletion_To_core_DualApprovalForDeletion(a.(*DualApprovalForDeletion), b.(*core.DualApprovalForDeletion), scope)
 	}); err != nil {
@@ -581,6 +721,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ETCD)(nil), (*core.ETCD)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ETCD_To_core_ETCD(a.(*ETCD), b.(*core.ETCD), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ETCD)(nil), (*ETCD)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ETCD_To_v1beta1_ETCD(a.(*core.ETCD), b.(*ETCD), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ETCDBackup)(nil), (*core.ETCDBackup)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ETCDBackup_To_core_ETCDBackup(a.(*ETCDBackup), b.(*core.ETCDBackup), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ETCDBackup)(nil), (*ETCDBackup)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ETCDBackup_To_v1beta1_ETCDBackup(a.(*core.ETCDBackup), b.(*ETCDBackup), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ETCDEncryptionKeyRotation)(nil), (*core.ETCDEncryptionKeyRotation)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ETCDEncryptionKeyRotation_To_core_ETCDEncryptionKeyRotation(a.(*ETCDEncryptionKeyRotation), b.(*core.ETCDEncryptionKeyRotation), scope)
 	}); err != nil {
@@ -601,6 +761,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ErrorTriage)(nil), (*core.ErrorTriage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ErrorTriage_To_core_ErrorTriage(a.(*ErrorTriage), b.(*core.ErrorTriage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ErrorTriage)(nil), (*ErrorTriage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ErrorTriage_To_v1beta1_ErrorTriage(a.(*core.ErrorTriage), b.(*ErrorTriage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*EtcdSnapshot)(nil), (*core.EtcdSnapshot)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_EtcdSnapshot_To_core_EtcdSnapshot(a.(*EtcdSnapshot), b.(*core.EtcdSnapshot), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.EtcdSnapshot)(nil), (*EtcdSnapshot)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_EtcdSnapshot_To_v1beta1_EtcdSnapshot(a.(*core.EtcdSnapshot), b.(*EtcdSnapshot), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ExpirableVersion)(nil), (*core.ExpirableVersion)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ExpirableVersion_To_core_ExpirableVersion(a.(*ExpirableVersion), b.(*core.ExpirableVersion), scope)
 	}); err != nil {
@@ -621,6 +801,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ExposureClassIngressGateway)(nil), (*core.ExposureClassIngressGateway)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ExposureClassIngressGateway_To_core_ExposureClassIngressGateway(a.(*ExposureClassIngressGateway), b.(*core.ExposureClassIngressGateway), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ExposureClassIngressGateway)(nil), (*ExposureClassIngressGateway)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ExposureClassIngressGateway_To_v1beta1_ExposureClassIngressGateway(a.(*core.ExposureClassIngressGateway), b.(*ExposureClassIngressGateway), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ExposureClassList)(nil), (*core.ExposureClassList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ExposureClassList_To_core_ExposureClassList(a.(*ExposureClassList), b.(*core.ExposureClassList), scope)
 	}); err != nil {
@@ -631,6 +821,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ExposureClassLoadBalancerService)(nil), (*core.ExposureClassLoadBalancerService)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ExposureClassLoadBalancerService_To_core_ExposureClassLoadBalancerService(a.(*ExposureClassLoadBalancerService), b.(*core.ExposureClassLoadBalancerService), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ExposureClassLoadBalancerService)(nil), (*ExposureClassLoadBalancerService)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ExposureClassLoadBalancerService_To_v1beta1_ExposureClassLoadBalancerService(a.(*core.ExposureClassLoadBalancerService), b.(*ExposureClassLoadBalancerService), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ExposureClassScheduling)(nil), (*core.ExposureClassScheduling)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ExposureClassScheduling_To_core_ExposureClassScheduling(a.(*ExposureClassScheduling), b.(*core.ExposureClassScheduling), scope)
 	}); err != nil {
@@ -671,6 +871,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*FeatureGate)(nil), (*core.FeatureGate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_FeatureGate_To_core_FeatureGate(a.(*FeatureGate), b.(*core.FeatureGate), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.FeatureGate)(nil), (*FeatureGate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_FeatureGate_To_v1beta1_FeatureGate(a.(*core.FeatureGate), b.(*FeatureGate), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*FeatureMatrix)(nil), (*core.FeatureMatrix)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_FeatureMatrix_To_core_FeatureMatrix(a.(*FeatureMatrix), b.(*core.FeatureMatrix), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.FeatureMatrix)(nil), (*FeatureMatrix)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_FeatureMatrix_To_v1beta1_FeatureMatrix(a.(*core.FeatureMatrix), b.(*FeatureMatrix), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*FeatureMatrixList)(nil), (*core.FeatureMatrixList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_FeatureMatrixList_To_core_FeatureMatrixList(a.(*FeatureMatrixList), b.(*core.FeatureMatrixList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.FeatureMatrixList)(nil), (*FeatureMatrixList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_FeatureMatrixList_To_v1beta1_FeatureMatrixList(a.(*core.FeatureMatrixList), b.(*FeatureMatrixList), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Gardener)(nil), (*core.Gardener)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Gardener_To_core_Gardener(a.(*Gardener), b.(*core.Gardener), scope)
 	}); err != nil {
@@ -776,6 +1006,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*KubeAPIServerEndpoint)(nil), (*core.KubeAPIServerEndpoint)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_KubeAPIServerEndpoint_To_core_KubeAPIServerEndpoint(a.(*KubeAPIServerEndpoint), b.(*core.KubeAPIServerEndpoint), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.KubeAPIServerEndpoint)(nil), (*KubeAPIServerEndpoint)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_KubeAPIServerEndpoint_To_v1beta1_KubeAPIServerEndpoint(a.(*core.KubeAPIServerEndpoint), b.(*KubeAPIServerEndpoint), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*KubeAPIServerVPNConfig)(nil), (*core.KubeAPIServerVPNConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_KubeAPIServerVPNConfig_To_core_KubeAPIServerVPNConfig(a.(*KubeAPIServerVPNConfig), b.(*core.KubeAPIServerVPNConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.KubeAPIServerVPNConfig)(nil), (*KubeAPIServerVPNConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_KubeAPIServerVPNConfig_To_v1beta1_KubeAPIServerVPNConfig(a.(*core.KubeAPIServerVPNConfig), b.(*KubeAPIServerVPNConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*KubeControllerManagerConfig)(nil), (*core.KubeControllerManagerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_KubeControllerManagerConfig_To_core_KubeControllerManagerConfig(a.(*KubeControllerManagerConfig), b.(*core.KubeControllerManagerConfig), scope)
 	}); err != nil {
@@ -786,6 +1036,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*KubeControllerManagerController)(nil), (*core.KubeControllerManagerController)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_KubeControllerManagerController_To_core_KubeControllerManagerController(a.(*KubeControllerManagerController), b.(*core.KubeControllerManagerController), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.KubeControllerManagerController)(nil), (*KubeControllerManagerController)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_KubeControllerManagerController_To_v1beta1_KubeControllerManagerController(a.(*core.KubeControllerManagerController), b.(*KubeControllerManagerController), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*KubeProxyConfig)(nil), (*core.KubeProxyConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_KubeProxyConfig_To_core_KubeProxyConfig(a.(*KubeProxyConfig), b.(*core.KubeProxyConfig), scope)
 	}); err != nil {
@@ -856,6 +1116,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*KubeletMemoryReservation)(nil), (*core.KubeletMemoryReservation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_KubeletMemoryReservation_To_core_KubeletMemoryReservation(a.(*KubeletMemoryReservation), b.(*core.KubeletMemoryReservation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.KubeletMemoryReservation)(nil), (*KubeletMemoryReservation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_KubeletMemoryReservation_To_v1beta1_KubeletMemoryReservation(a.(*core.KubeletMemoryReservation), b.(*KubeletMemoryReservation), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Kubernetes)(nil), (*core.Kubernetes)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Kubernetes_To_core_Kubernetes(a.(*Kubernetes), b.(*core.Kubernetes), scope)
 	}); err != nil {
@@ -926,6 +1196,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*LegacyComponentRemoval)(nil), (*core.LegacyComponentRemoval)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_LegacyComponentRemoval_To_core_LegacyComponentRemoval(a.(*LegacyComponentRemoval), b.(*core.LegacyComponentRemoval), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.LegacyComponentRemoval)(nil), (*LegacyComponentRemoval)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_LegacyComponentRemoval_To_v1beta1_LegacyComponentRemoval(a.(*core.LegacyComponentRemoval), b.(*LegacyComponentRemoval), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*LoadBalancerServicesProxyProtocol)(nil), (*core.LoadBalancerServicesProxyProtocol)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_LoadBalancerServicesProxyProtocol_To_core_LoadBalancerServicesProxyProtocol(a.(*LoadBalancerServicesProxyProtocol), b.(*core.LoadBalancerServicesProxyProtocol), scope)
 	}); err != nil {
@@ -976,6 +1256,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*MachineImageVersionUsage)(nil), (*core.MachineImageVersionUsage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MachineImageVersionUsage_To_core_MachineImageVersionUsage(a.(*MachineImageVersionUsage), b.(*core.MachineImageVersionUsage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.MachineImageVersionUsage)(nil), (*MachineImageVersionUsage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_MachineImageVersionUsage_To_v1beta1_MachineImageVersionUsage(a.(*core.MachineImageVersionUsage), b.(*MachineImageVersionUsage), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*MachineType)(nil), (*core.MachineType)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_MachineType_To_core_MachineType(a.(*MachineType), b.(*core.MachineType), scope)
 	}); err != nil {
@@ -1016,6 +1306,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*MaintenanceExclusion)(nil), (*core.MaintenanceExclusion)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MaintenanceExclusion_To_core_MaintenanceExclusion(a.(*MaintenanceExclusion), b.(*core.MaintenanceExclusion), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.MaintenanceExclusion)(nil), (*MaintenanceExclusion)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_MaintenanceExclusion_To_v1beta1_MaintenanceExclusion(a.(*core.MaintenanceExclusion), b.(*MaintenanceExclusion), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*MaintenanceTimeWindow)(nil), (*core.MaintenanceTimeWindow)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_MaintenanceTimeWindow_To_core_MaintenanceTimeWindow(a.(*MaintenanceTimeWindow), b.(*core.MaintenanceTimeWindow), scope)
 	}); err != nil {
@@ -1026,6 +1326,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ManagedAddon)(nil), (*core.ManagedAddon)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ManagedAddon_To_core_ManagedAddon(a.(*ManagedAddon), b.(*core.ManagedAddon), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ManagedAddon)(nil), (*ManagedAddon)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ManagedAddon_To_v1beta1_ManagedAddon(a.(*core.ManagedAddon), b.(*ManagedAddon), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*MemorySwapConfiguration)(nil), (*core.MemorySwapConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_MemorySwapConfiguration_To_core_MemorySwapConfiguration(a.(*MemorySwapConfiguration), b.(*core.MemorySwapConfiguration), scope)
 	}); err != nil {
@@ -1056,6 +1366,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*NamespaceDefaults)(nil), (*core.NamespaceDefaults)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_NamespaceDefaults_To_core_NamespaceDefaults(a.(*NamespaceDefaults), b.(*core.NamespaceDefaults), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.NamespaceDefaults)(nil), (*NamespaceDefaults)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_NamespaceDefaults_To_v1beta1_NamespaceDefaults(a.(*core.NamespaceDefaults), b.(*NamespaceDefaults), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NamespaceDefaultsLimitRange)(nil), (*core.NamespaceDefaultsLimitRange)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_NamespaceDefaultsLimitRange_To_core_NamespaceDefaultsLimitRange(a.(*NamespaceDefaultsLimitRange), b.(*core.NamespaceDefaultsLimitRange), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.NamespaceDefaultsLimitRange)(nil), (*NamespaceDefaultsLimitRange)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_NamespaceDefaultsLimitRange_To_v1beta1_NamespaceDefaultsLimitRange(a.(*core.NamespaceDefaultsLimitRange), b.(*NamespaceDefaultsLimitRange), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NamespaceDefaultsNetworkPolicies)(nil), (*core.NamespaceDefaultsNetworkPolicies)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_NamespaceDefaultsNetworkPolicies_To_core_NamespaceDefaultsNetworkPolicies(a.(*NamespaceDefaultsNetworkPolicies), b.(*core.NamespaceDefaultsNetworkPolicies), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.NamespaceDefaultsNetworkPolicies)(nil), (*NamespaceDefaultsNetworkPolicies)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_NamespaceDefaultsNetworkPolicies_To_v1beta1_NamespaceDefaultsNetworkPolicies(a.(*core.NamespaceDefaultsNetworkPolicies), b.(*NamespaceDefaultsNetworkPolicies), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NamespaceDefaultsResourceQuota)(nil), (*core.NamespaceDefaultsResourceQuota)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_NamespaceDefaultsResourceQuota_To_core_NamespaceDefaultsResourceQuota(a.(*NamespaceDefaultsResourceQuota), b.(*core.NamespaceDefaultsResourceQuota), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.NamespaceDefaultsResourceQuota)(nil), (*NamespaceDefaultsResourceQuota)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_NamespaceDefaultsResourceQuota_To_v1beta1_NamespaceDefaultsResourceQuota(a.(*core.NamespaceDefaultsResourceQuota), b.(*NamespaceDefaultsResourceQuota), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*NamespacedCloudProfile)(nil), (*core.NamespacedCloudProfile)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_NamespacedCloudProfile_To_core_NamespacedCloudProfile(a.(*NamespacedCloudProfile), b.(*core.NamespacedCloudProfile), scope)
 	}); err != nil {
@@ -1136,6 +1486,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*NodeLocalDNSCache)(nil), (*core.NodeLocalDNSCache)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_NodeLocalDNSCache_To_core_NodeLocalDNSCache(a.(*NodeLocalDNSCache), b.(*core.NodeLocalDNSCache), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.NodeLocalDNSCache)(nil), (*NodeLocalDNSCache)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_NodeLocalDNSCache_To_v1beta1_NodeLocalDNSCache(a.(*core.NodeLocalDNSCache), b.(*NodeLocalDNSCache), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NodeLocalDNSZoneProtocol)(nil), (*core.NodeLocalDNSZoneProtocol)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_NodeLocalDNSZoneProtocol_To_core_NodeLocalDNSZoneProtocol(a.(*NodeLocalDNSZoneProtocol), b.(*core.NodeLocalDNSZoneProtocol), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.NodeLocalDNSZoneProtocol)(nil), (*NodeLocalDNSZoneProtocol)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_NodeLocalDNSZoneProtocol_To_v1beta1_NodeLocalDNSZoneProtocol(a.(*core.NodeLocalDNSZoneProtocol), b.(*NodeLocalDNSZoneProtocol), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*OCIRepository)(nil), (*core.OCIRepository)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_OCIRepository_To_core_OCIRepository(a.(*OCIRepository), b.(*core.OCIRepository), scope)
 	}); err != nil {
@@ -1176,6 +1546,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*PendingMaintenanceOperation)(nil), (*core.PendingMaintenanceOperation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_PendingMaintenanceOperation_To_core_PendingMaintenanceOperation(a.(*PendingMaintenanceOperation), b.(*core.PendingMaintenanceOperation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.PendingMaintenanceOperation)(nil), (*PendingMaintenanceOperation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_PendingMaintenanceOperation_To_v1beta1_PendingMaintenanceOperation(a.(*core.PendingMaintenanceOperation), b.(*PendingMaintenanceOperation), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Project)(nil), (*core.Project)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Project_To_core_Project(a.(*Project), b.(*core.Project), scope)
 	}); err != nil {
@@ -1196,6 +1576,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ProjectMemberSync)(nil), (*core.ProjectMemberSync)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ProjectMemberSync_To_core_ProjectMemberSync(a.(*ProjectMemberSync), b.(*core.ProjectMemberSync), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ProjectMemberSync)(nil), (*ProjectMemberSync)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ProjectMemberSync_To_v1beta1_ProjectMemberSync(a.(*core.ProjectMemberSync), b.(*ProjectMemberSync), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ProjectMemberSyncGroup)(nil), (*core.ProjectMemberSyncGroup)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ProjectMemberSyncGroup_To_core_ProjectMemberSyncGroup(a.(*ProjectMemberSyncGroup), b.(*core.ProjectMemberSyncGroup), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ProjectMemberSyncGroup)(nil), (*ProjectMemberSyncGroup)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ProjectMemberSyncGroup_To_v1beta1_ProjectMemberSyncGroup(a.(*core.ProjectMemberSyncGroup), b.(*ProjectMemberSyncGroup), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ProjectStatus)(nil), (*core.ProjectStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ProjectStatus_To_core_ProjectStatus(a.(*ProjectStatus), b.(*core.ProjectStatus), scope)
 	}); err != nil {
@@ -1266,6 +1666,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*RegionMetadata)(nil), (*core.RegionMetadata)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_RegionMetadata_To_core_RegionMetadata(a.(*RegionMetadata), b.(*core.RegionMetadata), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.RegionMetadata)(nil), (*RegionMetadata)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_RegionMetadata_To_v1beta1_RegionMetadata(a.(*core.RegionMetadata), b.(*RegionMetadata), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ResourceData)(nil), (*core.ResourceData)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ResourceData_To_core_ResourceData(a.(*ResourceData), b.(*core.ResourceData), scope)
 	}); err != nil {
@@ -1346,6 +1756,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SeedBackupETCD)(nil), (*core.SeedBackupETCD)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SeedBackupETCD_To_core_SeedBackupETCD(a.(*SeedBackupETCD), b.(*core.SeedBackupETCD), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.SeedBackupETCD)(nil), (*SeedBackupETCD)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_SeedBackupETCD_To_v1beta1_SeedBackupETCD(a.(*core.SeedBackupETCD), b.(*SeedBackupETCD), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SeedCredentials)(nil), (*core.SeedCredentials)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SeedCredentials_To_core_SeedCredentials(a.(*SeedCredentials), b.(*core.SeedCredentials), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.SeedCredentials)(nil), (*SeedCredentials)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_SeedCredentials_To_v1beta1_SeedCredentials(a.(*core.SeedCredentials), b.(*SeedCredentials), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SeedCredentialsRotation)(nil), (*core.SeedCredentialsRotation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SeedCredentialsRotation_To_core_SeedCredentialsRotation(a.(*SeedCredentialsRotation), b.(*core.SeedCredentialsRotation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.SeedCredentialsRotation)(nil), (*SeedCredentialsRotation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_SeedCredentialsRotation_To_v1beta1_SeedCredentialsRotation(a.(*core.SeedCredentialsRotation), b.(*SeedCredentialsRotation), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SeedDNS)(nil), (*core.SeedDNS)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_SeedDNS_To_core_SeedDNS(a.(*SeedDNS), b.(*core.SeedDNS), scope)
 	}); err != nil {
@@ -1366,6 +1806,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SeedIngressDomainMigration)(nil), (*core.SeedIngressDomainMigration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SeedIngressDomainMigration_To_core_SeedIngressDomainMigration(a.(*SeedIngressDomainMigration), b.(*core.SeedIngressDomainMigration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.SeedIngressDomainMigration)(nil), (*SeedIngressDomainMigration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_SeedIngressDomainMigration_To_v1beta1_SeedIngressDomainMigration(a.(*core.SeedIngressDomainMigration), b.(*SeedIngressDomainMigration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SeedList)(nil), (*core.SeedList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_SeedList_To_core_SeedList(a.(*SeedList), b.(*core.SeedList), scope)
 	}); err != nil {
@@ -1376,6 +1826,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SeedMaintenance)(nil), (*core.SeedMaintenance)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SeedMaintenance_To_core_SeedMaintenance(a.(*SeedMaintenance), b.(*core.SeedMaintenance), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.SeedMaintenance)(nil), (*SeedMaintenance)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_SeedMaintenance_To_v1beta1_SeedMaintenance(a.(*core.SeedMaintenance), b.(*SeedMaintenance), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SeedNetworks)(nil), (*core.SeedNetworks)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_SeedNetworks_To_core_SeedNetworks(a.(*SeedNetworks), b.(*core.SeedNetworks), scope)
 	}); err != nil {
@@ -1386,6 +1846,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SeedPendingMaintenanceOperation)(nil), (*core.SeedPendingMaintenanceOperation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SeedPendingMaintenanceOperation_To_core_SeedPendingMaintenanceOperation(a.(*SeedPendingMaintenanceOperation), b.(*core.SeedPendingMaintenanceOperation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.SeedPendingMaintenanceOperation)(nil), (*SeedPendingMaintenanceOperation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_SeedPendingMaintenanceOperation_To_v1beta1_SeedPendingMaintenanceOperation(a.(*core.SeedPendingMaintenanceOperation), b.(*SeedPendingMaintenanceOperation), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SeedProvider)(nil), (*core.SeedProvider)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_SeedProvider_To_core_SeedProvider(a.(*SeedProvider), b.(*core.SeedProvider), scope)
 	}); err != nil {
@@ -1406,6 +1876,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SeedSettingACME)(nil), (*core.SeedSettingACME)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SeedSettingACME_To_core_SeedSettingACME(a.(*SeedSettingACME), b.(*core.SeedSettingACME), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.SeedSettingACME)(nil), (*SeedSettingACME)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_SeedSettingACME_To_v1beta1_SeedSettingACME(a.(*core.SeedSettingACME), b.(*SeedSettingACME), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SeedSettingDependencyWatchdog)(nil), (*core.SeedSettingDependencyWatchdog)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_SeedSettingDependencyWatchdog_To_core_SeedSettingDependencyWatchdog(a.(*SeedSettingDependencyWatchdog), b.(*core.SeedSettingDependencyWatchdog), scope)
 	}); err != nil {
@@ -1616,6 +2096,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShootClass)(nil), (*core.ShootClass)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootClass_To_core_ShootClass(a.(*ShootClass), b.(*core.ShootClass), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ShootClass)(nil), (*ShootClass)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ShootClass_To_v1beta1_ShootClass(a.(*core.ShootClass), b.(*ShootClass), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootClassList)(nil), (*core.ShootClassList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootClassList_To_core_ShootClassList(a.(*ShootClassList), b.(*core.ShootClassList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ShootClassList)(nil), (*ShootClassList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ShootClassList_To_v1beta1_ShootClassList(a.(*core.ShootClassList), b.(*ShootClassList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootClassSpec)(nil), (*core.ShootClassSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootClassSpec_To_core_ShootClassSpec(a.(*ShootClassSpec), b.(*core.ShootClassSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ShootClassSpec)(nil), (*ShootClassSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ShootClassSpec_To_v1beta1_ShootClassSpec(a.(*core.ShootClassSpec), b.(*ShootClassSpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ShootCredentials)(nil), (*core.ShootCredentials)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ShootCredentials_To_core_ShootCredentials(a.(*ShootCredentials), b.(*core.ShootCredentials), scope)
 	}); err != nil {
@@ -1676,6 +2186,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShootRemediation)(nil), (*core.ShootRemediation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootRemediation_To_core_ShootRemediation(a.(*ShootRemediation), b.(*core.ShootRemediation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ShootRemediation)(nil), (*ShootRemediation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ShootRemediation_To_v1beta1_ShootRemediation(a.(*core.ShootRemediation), b.(*ShootRemediation), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ShootSSHKeypairRotation)(nil), (*core.ShootSSHKeypairRotation)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ShootSSHKeypairRotation_To_core_ShootSSHKeypairRotation(a.(*ShootSSHKeypairRotation), b.(*core.ShootSSHKeypairRotation), scope)
 	}); err != nil {
@@ -1781,8 +2301,38 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*core.Toleration)(nil), (*Toleration)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_core_Toleration_To_v1beta1_Toleration(a.(*core.Toleration), b.(*Toleration), scope)
+	if err := s.AddGeneratedConversionFunc((*core.Toleration)(nil), (*Toleration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_Toleration_To_v1beta1_Toleration(a.(*core.Toleration), b.(*Toleration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TrustedIdentityProvider)(nil), (*core.TrustedIdentityProvider)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_TrustedIdentityProvider_To_core_TrustedIdentityProvider(a.(*TrustedIdentityProvider), b.(*core.TrustedIdentityProvider), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.TrustedIdentityProvider)(nil), (*TrustedIdentityProvider)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_TrustedIdentityProvider_To_v1beta1_TrustedIdentityProvider(a.(*core.TrustedIdentityProvider), b.(*TrustedIdentityProvider), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TypeRegionAvailability)(nil), (*core.TypeRegionAvailability)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_TypeRegionAvailability_To_core_TypeRegionAvailability(a.(*TypeRegionAvailability), b.(*core.TypeRegionAvailability), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.TypeRegionAvailability)(nil), (*TypeRegionAvailability)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_TypeRegionAvailability_To_v1beta1_TypeRegionAvailability(a.(*core.TypeRegionAvailability), b.(*TypeRegionAvailability), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VersionUsage)(nil), (*core.VersionUsage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_VersionUsage_To_core_VersionUsage(a.(*VersionUsage), b.(*core.VersionUsage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.VersionUsage)(nil), (*VersionUsage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_VersionUsage_To_v1beta1_VersionUsage(a.(*core.VersionUsage), b.(*VersionUsage), scope)
 	}); err != nil {
 		return err
 	}
@@ -1846,6 +2396,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*WorkerNodeTemplate)(nil), (*core.WorkerNodeTemplate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_WorkerNodeTemplate_To_core_WorkerNodeTemplate(a.(*WorkerNodeTemplate), b.(*core.WorkerNodeTemplate), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.WorkerNodeTemplate)(nil), (*WorkerNodeTemplate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_WorkerNodeTemplate_To_v1beta1_WorkerNodeTemplate(a.(*core.WorkerNodeTemplate), b.(*WorkerNodeTemplate), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*WorkerPoolMaintenance)(nil), (*core.WorkerPoolMaintenance)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_WorkerPoolMaintenance_To_core_WorkerPoolMaintenance(a.(*WorkerPoolMaintenance), b.(*core.WorkerPoolMaintenance), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.WorkerPoolMaintenance)(nil), (*WorkerPoolMaintenance)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_WorkerPoolMaintenance_To_v1beta1_WorkerPoolMaintenance(a.(*core.WorkerPoolMaintenance), b.(*WorkerPoolMaintenance), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*WorkerRolloutStrategy)(nil), (*core.WorkerRolloutStrategy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_WorkerRolloutStrategy_To_core_WorkerRolloutStrategy(a.(*WorkerRolloutStrategy), b.(*core.WorkerRolloutStrategy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.WorkerRolloutStrategy)(nil), (*WorkerRolloutStrategy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_WorkerRolloutStrategy_To_v1beta1_WorkerRolloutStrategy(a.(*core.WorkerRolloutStrategy), b.(*WorkerRolloutStrategy), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*WorkerSystemComponents)(nil), (*core.WorkerSystemComponents)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_WorkerSystemComponents_To_core_WorkerSystemComponents(a.(*WorkerSystemComponents), b.(*core.WorkerSystemComponents), scope)
 	}); err != nil {
@@ -1914,6 +2494,28 @@ func RegisterConversions(s *runtime.Scheme) error {
 	return nil
 }
 
+func autoConvert_v1beta1_APIPriorityAndFairness_To_core_APIPriorityAndFairness(in *APIPriorityAndFairness, out *core.APIPriorityAndFairness, s conversion.Scope) error {
+	out.MaxSeats = (*int32)(unsafe.Pointer(in.MaxSeats))
+	out.ConfigMapName = (*string)(unsafe.Pointer(in.ConfigMapName))
+	return nil
+}
+
+// Convert_v1beta1_APIPriorityAndFairness_To_core_APIPriorityAndFairness is an autogenerated conversion function.
+func Convert_v1beta1_APIPriorityAndFairness_To_core_APIPriorityAndFairness(in *APIPriorityAndFairness, out *core.APIPriorityAndFairness, s conversion.Scope) error {
+	return autoConvert_v1beta1_APIPriorityAndFairness_To_core_APIPriorityAndFairness(in, out, s)
+}
+
+func autoConvert_core_APIPriorityAndFairness_To_v1beta1_APIPriorityAndFairness(in *core.APIPriorityAndFairness, out *APIPriorityAndFairness, s conversion.Scope) error {
+	out.MaxSeats = (*int32)(unsafe.Pointer(in.MaxSeats))
+	out.ConfigMapName = (*string)(unsafe.Pointer(in.ConfigMapName))
+	return nil
+}
+
+// Convert_core_APIPriorityAndFairness_To_v1beta1_APIPriorityAndFairness is an autogenerated conversion function.
+func Convert_core_APIPriorityAndFairness_To_v1beta1_APIPriorityAndFairness(in *core.APIPriorityAndFairness, out *APIPriorityAndFairness, s conversion.Scope) error {
+	return autoConvert_core_APIPriorityAndFairness_To_v1beta1_APIPriorityAndFairness(in, out, s)
+}
+
 func autoConvert_v1beta1_APIServerLogging_To_core_APIServerLogging(in *APIServerLogging, out *core.APIServerLogging, s conversion.Scope) error {
 	out.Verbosity = (*int32)(unsafe.Pointer(in.Verbosity))
 	out.HTTPAccessVerbosity = (*int32)(unsafe.Pointer(in.HTTPAccessVerbosity))
@@ -2024,9 +2626,66 @@ func Convert_core_Addon_To_v1beta1_Addon(in *core.Addon, out *Addon, s conversio
 	return autoConvert_core_Addon_To_v1beta1_Addon(in, out, s)
 }
 
+func autoConvert_v1beta1_AddonDefinition_To_core_AddonDefinition(in *AddonDefinition, out *core.AddonDefinition, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Versions = *(*[]core.AddonVersion)(unsafe.Pointer(&in.Versions))
+	out.WorkerlessSupported = (*bool)(unsafe.Pointer(in.WorkerlessSupported))
+	return nil
+}
+
+// Convert_v1beta1_AddonDefinition_To_core_AddonDefinition is an autogenerated conversion function.
+func Convert_v1beta1_AddonDefinition_To_core_AddonDefinition(in *AddonDefinition, out *core.AddonDefinition, s conversion.Scope) error {
+	return autoConvert_v1beta1_AddonDefinition_To_core_AddonDefinition(in, out, s)
+}
+
+func autoConvert_core_AddonDefinition_To_v1beta1_AddonDefinition(in *core.AddonDefinition, out *AddonDefinition, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Versions = *(*[]AddonVersion)(unsafe.Pointer(&in.Versions))
+	out.WorkerlessSupported = (*bool)(unsafe.Pointer(in.WorkerlessSupported))
+	return nil
+}
+
+// Convert_core_AddonDefinition_To_v1beta1_AddonDefinition is an autogenerated conversion function.
+func Convert_core_AddonDefinition_To_v1beta1_AddonDefinition(in *core.AddonDefinition, out *AddonDefinition, s conversion.Scope) error {
+	return autoConvert_core_AddonDefinition_To_v1beta1_AddonDefinition(in, out, s)
+}
+
+func autoConvert_v1beta1_AddonVersion_To_core_AddonVersion(in *AddonVersion, out *core.AddonVersion, s conversion.Scope) error {
+	if err := Convert_v1beta1_ExpirableVersion_To_core_ExpirableVersion(&in.ExpirableVersion, &out.ExpirableVersion, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_OCIRepository_To_core_OCIRepository(&in.Chart, &out.Chart, s); err != nil {
+		return err
+	}
+	out.ValuesSchema = (*runtime.RawExtension)(unsafe.Pointer(in.ValuesSchema))
+	return nil
+}
+
+// Convert_v1beta1_AddonVersion_To_core_AddonVersion is an autogenerated conversion function.
+func Convert_v1beta1_AddonVersion_To_core_AddonVersion(in *AddonVersion, out *core.AddonVersion, s conversion.Scope) error {
+	return autoConvert_v1beta1_AddonVersion_To_core_AddonVersion(in, out, s)
+}
+
+func autoConvert_core_AddonVersion_To_v1beta1_AddonVersion(in *core.AddonVersion, out *AddonVersion, s conversion.Scope) error {
+	if err := Convert_core_ExpirableVersion_To_v1beta1_ExpirableVersion(&in.ExpirableVersion, &out.ExpirableVersion, s); err != nil {
+		return err
+	}
+	if err := Convert_core_OCIRepository_To_v1beta1_OCIRepository(&in.Chart, &out.Chart, s); err != nil {
+		return err
+	}
+	out.ValuesSchema = (*runtime.RawExtension)(unsafe.Pointer(in.ValuesSchema))
+	return nil
+}
+
+// Convert_core_AddonVersion_To_v1beta1_AddonVersion is an autogenerated conversion function.
+func Convert_core_AddonVersion_To_v1beta1_AddonVersion(in *core.AddonVersion, out *AddonVersion, s conversion.Scope) error {
+	return autoConvert_core_AddonVersion_To_v1beta1_AddonVersion(in, out, s)
+}
+
 func autoConvert_v1beta1_Addons_To_core_Addons(in *Addons, out *core.Addons, s conversion.Scope) error {
 	out.KubernetesDashboard = (*core.KubernetesDashboard)(unsafe.Pointer(in.KubernetesDashboard))
 	out.NginxIngress = (*core.NginxIngress)(unsafe.Pointer(in.NginxIngress))
+	out.Managed = *(*[]core.ManagedAddon)(unsafe.Pointer(&in.Managed))
 	return nil
 }
 
@@ -2038,6 +2697,7 @@ func Convert_v1beta1_Addons_To_core_Addons(in *Addons, out *core.Addons, s conve
 func autoConvert_core_Addons_To_v1beta1_Addons(in *core.Addons, out *Addons, s conversion.Scope) error {
 	out.KubernetesDashboard = (*KubernetesDashboard)(unsafe.Pointer(in.KubernetesDashboard))
 	out.NginxIngress = (*NginxIngress)(unsafe.Pointer(in.NginxIngress))
+	out.Managed = *(*[]ManagedAddon)(unsafe.Pointer(&in.Managed))
 	return nil
 }
 
@@ -2051,6 +2711,7 @@ func autoConvert_v1beta1_AdmissionPlugin_To_core_AdmissionPlugin(in *AdmissionPl
 	out.Config = (*runtime.RawExtension)(unsafe.Pointer(in.Config))
 	out.Disabled = (*bool)(unsafe.Pointer(in.Disabled))
 	out.KubeconfigSecretName = (*string)(unsafe.Pointer(in.KubeconfigSecretName))
+	out.ConfigMapName = (*string)(unsafe.Pointer(in.ConfigMapName))
 	return nil
 }
 
@@ -2064,6 +2725,7 @@ func autoConvert_core_AdmissionPlugin_To_v1beta1_AdmissionPlugin(in *core.Admiss
 	out.Disabled = (*bool)(unsafe.Pointer(in.Disabled))
 	out.Config = (*runtime.RawExtension)(unsafe.Pointer(in.Config))
 	out.KubeconfigSecretName = (*string)(unsafe.Pointer(in.KubeconfigSecretName))
+	out.ConfigMapName = (*string)(unsafe.Pointer(in.ConfigMapName))
 	return nil
 }
 
@@ -2094,6 +2756,8 @@ func Convert_core_Alerting_To_v1beta1_Alerting(in *core.Alerting, out *Alerting,
 
 func autoConvert_v1beta1_AuditConfig_To_core_AuditConfig(in *AuditConfig, out *core.AuditConfig, s conversion.Scope) error {
 	out.AuditPolicy = (*core.AuditPolicy)(unsafe.Pointer(in.AuditPolicy))
+	out.Webhook = (*core.AuditWebhook)(unsafe.Pointer(in.Webhook))
+	out.Sink = (*core.AuditSink)(unsafe.Pointer(in.Sink))
 	return nil
 }
 
@@ -2104,6 +2768,8 @@ func Convert_v1beta1_AuditConfig_To_core_AuditConfig(in *AuditConfig, out *core.
 
 func autoConvert_core_AuditConfig_To_v1beta1_AuditConfig(in *core.AuditConfig, out *AuditConfig, s conversion.Scope) error {
 	out.AuditPolicy = (*AuditPolicy)(unsafe.Pointer(in.AuditPolicy))
+	out.Webhook = (*AuditWebhook)(unsafe.Pointer(in.Webhook))
+	out.Sink = (*AuditSink)(unsafe.Pointer(in.Sink))
 	return nil
 }
 
@@ -2132,6 +2798,52 @@ func Convert_core_AuditPolicy_To_v1beta1_AuditPolicy(in *core.AuditPolicy, out *
 	return autoConvert_core_AuditPolicy_To_v1beta1_AuditPolicy(in, out, s)
 }
 
+func autoConvert_v1beta1_AuditSink_To_core_AuditSink(in *AuditSink, out *core.AuditSink, s conversion.Scope) error {
+	out.Type = in.Type
+	out.ProviderConfig = (*runtime.RawExtension)(unsafe.Pointer(in.ProviderConfig))
+	return nil
+}
+
+// Convert_v1beta1_AuditSink_To_core_AuditSink is an autogenerated conversion function.
+func Convert_v1beta1_AuditSink_To_core_AuditSink(in *AuditSink, out *core.AuditSink, s conversion.Scope) error {
+	return autoConvert_v1beta1_AuditSink_To_core_AuditSink(in, out, s)
+}
+
+func autoConvert_core_AuditSink_To_v1beta1_AuditSink(in *core.AuditSink, out *AuditSink, s conversion.Scope) error {
+	out.Type = in.Type
+	out.ProviderConfig = (*runtime.RawExtension)(unsafe.Pointer(in.ProviderConfig))
+	return nil
+}
+
+// Convert_core_AuditSink_To_v1beta1_AuditSink is an autogenerated conversion function.
+func Convert_core_AuditSink_To_v1beta1_AuditSink(in *core.AuditSink, out *AuditSink, s conversion.Scope) error {
+	return autoConvert_core_AuditSink_To_v1beta1_AuditSink(in, out, s)
+}
+
+func autoConvert_v1beta1_AuditWebhook_To_core_AuditWebhook(in *AuditWebhook, out *core.AuditWebhook, s conversion.Scope) error {
+	out.KubeconfigSecretName = in.KubeconfigSecretName
+	out.BatchMaxSize = (*int32)(unsafe.Pointer(in.BatchMaxSize))
+	out.BatchMaxWait = (*metav1.Duration)(unsafe.Pointer(in.BatchMaxWait))
+	return nil
+}
+
+// Convert_v1beta1_AuditWebhook_To_core_AuditWebhook is an autogenerated conversion function.
+func Convert_v1beta1_AuditWebhook_To_core_AuditWebhook(in *AuditWebhook, out *core.AuditWebhook, s conversion.Scope) error {
+	return autoConvert_v1beta1_AuditWebhook_To_core_AuditWebhook(in, out, s)
+}
+
+func autoConvert_core_AuditWebhook_To_v1beta1_AuditWebhook(in *core.AuditWebhook, out *AuditWebhook, s conversion.Scope) error {
+	out.KubeconfigSecretName = in.KubeconfigSecretName
+	out.BatchMaxSize = (*int32)(unsafe.Pointer(in.BatchMaxSize))
+	out.BatchMaxWait = (*metav1.Duration)(unsafe.Pointer(in.BatchMaxWait))
+	return nil
+}
+
+// Convert_core_AuditWebhook_To_v1beta1_AuditWebhook is an autogenerated conversion function.
+func Convert_core_AuditWebhook_To_v1beta1_AuditWebhook(in *core.AuditWebhook, out *AuditWebhook, s conversion.Scope) error {
+	return autoConvert_core_AuditWebhook_To_v1beta1_AuditWebhook(in, out, s)
+}
+
 func autoConvert_v1beta1_AuthorizerKubeconfigReference_To_core_AuthorizerKubeconfigReference(in *AuthorizerKubeconfigReference, out *core.AuthorizerKubeconfigReference, s conversion.Scope) error {
 	out.AuthorizerName = in.AuthorizerName
 	out.SecretName = in.SecretName
@@ -2210,6 +2922,28 @@ func Convert_core_BackupBucket_To_v1beta1_BackupBucket(in *core.BackupBucket, ou
 	return autoConvert_core_BackupBucket_To_v1beta1_BackupBucket(in, out, s)
 }
 
+func autoConvert_v1beta1_BackupBucketImmutability_To_core_BackupBucketImmutability(in *BackupBucketImmutability, out *core.BackupBucketImmutability, s conversion.Scope) error {
+	out.RetentionMode = core.BackupBucketRetentionMode(in.RetentionMode)
+	out.RetentionPeriod = in.RetentionPeriod
+	return nil
+}
+
+// Convert_v1beta1_BackupBucketImmutability_To_core_BackupBucketImmutability is an autogenerated conversion function.
+func Convert_v1beta1_BackupBucketImmutability_To_core_BackupBucketImmutability(in *BackupBucketImmutability, out *core.BackupBucketImmutability, s conversion.Scope) error {
+	return autoConvert_v1beta1_BackupBucketImmutability_To_core_BackupBucketImmutability(in, out, s)
+}
+
+func autoConvert_core_BackupBucketImmutability_To_v1beta1_BackupBucketImmutability(in *core.BackupBucketImmutability, out *BackupBucketImmutability, s conversion.Scope) error {
+	out.RetentionMode = BackupBucketRetentionMode(in.RetentionMode)
+	out.RetentionPeriod = in.RetentionPeriod
+	return nil
+}
+
+// Convert_core_BackupBucketImmutability_To_v1beta1_BackupBucketImmutability is an autogenerated conversion function.
+func Convert_core_BackupBucketImmutability_To_v1beta1_BackupBucketImmutability(in *core.BackupBucketImmutability, out *BackupBucketImmutability, s conversion.Scope) error {
+	return autoConvert_core_BackupBucketImmutability_To_v1beta1_BackupBucketImmutability(in, out, s)
+}
+
 func autoConvert_v1beta1_BackupBucketList_To_core_BackupBucketList(in *BackupBucketList, out *core.BackupBucketList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	out.Items = *(*[]core.BackupBucket)(unsafe.Pointer(&in.Items))
@@ -2261,6 +2995,7 @@ func autoConvert_v1beta1_BackupBucketSpec_To_core_BackupBucketSpec(in *BackupBuc
 	out.ProviderConfig = (*runtime.RawExtension)(unsafe.Pointer(in.ProviderConfig))
 	out.SecretRef = in.SecretRef
 	out.SeedName = (*string)(unsafe.Pointer(in.SeedName))
+	out.Immutability = (*core.BackupBucketImmutability)(unsafe.Pointer(in.Immutability))
 	return nil
 }
 
@@ -2276,6 +3011,7 @@ func autoConvert_core_BackupBucketSpec_To_v1beta1_BackupBucketSpec(in *core.Back
 	out.ProviderConfig = (*runtime.RawExtension)(unsafe.Pointer(in.ProviderConfig))
 	out.SecretRef = in.SecretRef
 	out.SeedName = (*string)(unsafe.Pointer(in.SeedName))
+	out.Immutability = (*BackupBucketImmutability)(unsafe.Pointer(in.Immutability))
 	return nil
 }
 
@@ -2535,6 +3271,9 @@ func autoConvert_v1beta1_CloudProfile_To_core_CloudProfile(in *CloudProfile, out
 	if err := Convert_v1beta1_CloudProfileSpec_To_core_CloudProfileSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
+	if err := Convert_v1beta1_CloudProfileStatus_To_core_CloudProfileStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2548,6 +3287,9 @@ func autoConvert_core_CloudProfile_To_v1beta1_CloudProfile(in *core.CloudProfile
 	if err := Convert_core_CloudProfileSpec_To_v1beta1_CloudProfileSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
+	if err := Convert_core_CloudProfileStatus_To_v1beta1_CloudProfileStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -2613,6 +3355,7 @@ func autoConvert_v1beta1_CloudProfileSpec_To_core_CloudProfileSpec(in *CloudProf
 	out.Type = in.Type
 	out.VolumeTypes = *(*[]core.VolumeType)(unsafe.Pointer(&in.VolumeTypes))
 	out.Bastion = (*core.Bastion)(unsafe.Pointer(in.Bastion))
+	out.Addons = *(*[]core.AddonDefinition)(unsafe.Pointer(&in.Addons))
 	return nil
 }
 
@@ -2634,6 +3377,7 @@ func autoConvert_core_CloudProfileSpec_To_v1beta1_CloudProfileSpec(in *core.Clou
 	out.Type = in.Type
 	out.VolumeTypes = *(*[]VolumeType)(unsafe.Pointer(&in.VolumeTypes))
 	out.Bastion = (*Bastion)(unsafe.Pointer(in.Bastion))
+	out.Addons = *(*[]AddonDefinition)(unsafe.Pointer(&in.Addons))
 	return nil
 }
 
@@ -2642,6 +3386,50 @@ func Convert_core_CloudProfileSpec_To_v1beta1_CloudProfileSpec(in *core.CloudPro
 	return autoConvert_core_CloudProfileSpec_To_v1beta1_CloudProfileSpec(in, out, s)
 }
 
+func autoConvert_v1beta1_CloudProfileStatus_To_core_CloudProfileStatus(in *CloudProfileStatus, out *core.CloudProfileStatus, s conversion.Scope) error {
+	out.Usage = (*core.CloudProfileUsage)(unsafe.Pointer(in.Usage))
+	return nil
+}
+
+// Convert_v1beta1_CloudProfileStatus_To_core_CloudProfileStatus is an autogenerated conversion function.
+func Convert_v1beta1_CloudProfileStatus_To_core_CloudProfileStatus(in *CloudProfileStatus, out *core.CloudProfileStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_CloudProfileStatus_To_core_CloudProfileStatus(in, out, s)
+}
+
+func autoConvert_core_CloudProfileStatus_To_v1beta1_CloudProfileStatus(in *core.CloudProfileStatus, out *CloudProfileStatus, s conversion.Scope) error {
+	out.Usage = (*CloudProfileUsage)(unsafe.Pointer(in.Usage))
+	return nil
+}
+
+// Convert_core_CloudProfileStatus_To_v1beta1_CloudProfileStatus is an autogenerated conversion function.
+func Convert_core_CloudProfileStatus_To_v1beta1_CloudProfileStatus(in *core.CloudProfileStatus, out *CloudProfileStatus, s conversion.Scope) error {
+	return autoConvert_core_CloudProfileStatus_To_v1beta1_CloudProfileStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_CloudProfileUsage_To_core_CloudProfileUsage(in *CloudProfileUsage, out *core.CloudProfileUsage, s conversion.Scope) error {
+	out.KubernetesVersions = *(*[]core.VersionUsage)(unsafe.Pointer(&in.KubernetesVersions))
+	out.MachineImageVersions = *(*[]core.MachineImageVersionUsage)(unsafe.Pointer(&in.MachineImageVersions))
+	out.LastUpdateTime = (*metav1.Time)(unsafe.Pointer(in.LastUpdateTime))
+	return nil
+}
+
+// Convert_v1beta1_CloudProfileUsage_To_core_CloudProfileUsage is an autogenerated conversion function.
+func Convert_v1beta1_CloudProfileUsage_To_core_CloudProfileUsage(in *CloudProfileUsage, out *core.CloudProfileUsage, s conversion.Scope) error {
+	return autoConvert_v1beta1_CloudProfileUsage_To_core_CloudProfileUsage(in, out, s)
+}
+
+func autoConvert_core_CloudProfileUsage_To_v1beta1_CloudProfileUsage(in *core.CloudProfileUsage, out *CloudProfileUsage, s conversion.Scope) error {
+	out.KubernetesVersions = *(*[]VersionUsage)(unsafe.Pointer(&in.KubernetesVersions))
+	out.MachineImageVersions = *(*[]MachineImageVersionUsage)(unsafe.Pointer(&in.MachineImageVersions))
+	out.LastUpdateTime = (*metav1.Time)(unsafe.Pointer(in.LastUpdateTime))
+	return nil
+}
+
+// Convert_core_CloudProfileUsage_To_v1beta1_CloudProfileUsage is an autogenerated conversion function.
+func Convert_core_CloudProfileUsage_To_v1beta1_CloudProfileUsage(in *core.CloudProfileUsage, out *CloudProfileUsage, s conversion.Scope) error {
+	return autoConvert_core_CloudProfileUsage_To_v1beta1_CloudProfileUsage(in, out, s)
+}
+
 func autoConvert_v1beta1_ClusterAutoscaler_To_core_ClusterAutoscaler(in *ClusterAutoscaler, out *core.ClusterAutoscaler, s conversion.Scope) error {
 	out.ScaleDownDelayAfterAdd = (*metav1.Duration)(unsafe.Pointer(in.ScaleDownDelayAfterAdd))
 	out.ScaleDownDelayAfterDelete = (*metav1.Duration)(unsafe.Pointer(in.ScaleDownDelayAfterDelete))
@@ -2776,6 +3564,7 @@ func Convert_core_ContainerRuntime_To_v1beta1_ContainerRuntime(in *core.Containe
 
 func autoConvert_v1beta1_ControlPlane_To_core_ControlPlane(in *ControlPlane, out *core.ControlPlane, s conversion.Scope) error {
 	out.HighAvailability = (*core.HighAvailability)(unsafe.Pointer(in.HighAvailability))
+	out.Egress = (*core.ControlPlaneEgress)(unsafe.Pointer(in.Egress))
 	return nil
 }
 
@@ -2786,6 +3575,7 @@ func Convert_v1beta1_ControlPlane_To_core_ControlPlane(in *ControlPlane, out *co
 
 func autoConvert_core_ControlPlane_To_v1beta1_ControlPlane(in *core.ControlPlane, out *ControlPlane, s conversion.Scope) error {
 	out.HighAvailability = (*HighAvailability)(unsafe.Pointer(in.HighAvailability))
+	out.Egress = (*ControlPlaneEgress)(unsafe.Pointer(in.Egress))
 	return nil
 }
 
@@ -2794,6 +3584,26 @@ func Convert_core_ControlPlane_To_v1beta1_ControlPlane(in *core.ControlPlane, ou
 	return autoConvert_core_ControlPlane_To_v1beta1_ControlPlane(in, out, s)
 }
 
+func autoConvert_v1beta1_ControlPlaneEgress_To_core_ControlPlaneEgress(in *ControlPlaneEgress, out *core.ControlPlaneEgress, s conversion.Scope) error {
+	out.Dedicated = in.Dedicated
+	return nil
+}
+
+// Convert_v1beta1_ControlPlaneEgress_To_core_ControlPlaneEgress is an autogenerated conversion function.
+func Convert_v1beta1_ControlPlaneEgress_To_core_ControlPlaneEgress(in *ControlPlaneEgress, out *core.ControlPlaneEgress, s conversion.Scope) error {
+	return autoConvert_v1beta1_ControlPlaneEgress_To_core_ControlPlaneEgress(in, out, s)
+}
+
+func autoConvert_core_ControlPlaneEgress_To_v1beta1_ControlPlaneEgress(in *core.ControlPlaneEgress, out *ControlPlaneEgress, s conversion.Scope) error {
+	out.Dedicated = in.Dedicated
+	return nil
+}
+
+// Convert_core_ControlPlaneEgress_To_v1beta1_ControlPlaneEgress is an autogenerated conversion function.
+func Convert_core_ControlPlaneEgress_To_v1beta1_ControlPlaneEgress(in *core.ControlPlaneEgress, out *ControlPlaneEgress, s conversion.Scope) error {
+	return autoConvert_core_ControlPlaneEgress_To_v1beta1_ControlPlaneEgress(in, out, s)
+}
+
 func autoConvert_v1beta1_ControllerDeployment_To_core_ControllerDeployment(in *ControllerDeployment, out *core.ControllerDeployment, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	out.Type = in.Type
@@ -3108,6 +3918,7 @@ func Convert_core_ControllerResourceLifecycle_To_v1beta1_ControllerResourceLifec
 func autoConvert_v1beta1_CoreDNS_To_core_CoreDNS(in *CoreDNS, out *core.CoreDNS, s conversion.Scope) error {
 	out.Autoscaling = (*core.CoreDNSAutoscaling)(unsafe.Pointer(in.Autoscaling))
 	out.Rewriting = (*core.CoreDNSRewriting)(unsafe.Pointer(in.Rewriting))
+	out.CustomPlugins = (*core.CoreDNSCustomPlugins)(unsafe.Pointer(in.CustomPlugins))
 	return nil
 }
 
@@ -3119,6 +3930,7 @@ func Convert_v1beta1_CoreDNS_To_core_CoreDNS(in *CoreDNS, out *core.CoreDNS, s c
 func autoConvert_core_CoreDNS_To_v1beta1_CoreDNS(in *core.CoreDNS, out *CoreDNS, s conversion.Scope) error {
 	out.Autoscaling = (*CoreDNSAutoscaling)(unsafe.Pointer(in.Autoscaling))
 	out.Rewriting = (*CoreDNSRewriting)(unsafe.Pointer(in.Rewriting))
+	out.CustomPlugins = (*CoreDNSCustomPlugins)(unsafe.Pointer(in.CustomPlugins))
 	return nil
 }
 
@@ -3147,6 +3959,72 @@ func Convert_core_CoreDNSAutoscaling_To_v1beta1_CoreDNSAutoscaling(in *core.Core
 	return autoConvert_core_CoreDNSAutoscaling_To_v1beta1_CoreDNSAutoscaling(in, out, s)
 }
 
+func autoConvert_v1beta1_CoreDNSCustomPlugins_To_core_CoreDNSCustomPlugins(in *CoreDNSCustomPlugins, out *core.CoreDNSCustomPlugins, s conversion.Scope) error {
+	out.Log = (*bool)(unsafe.Pointer(in.Log))
+	out.DNS64 = (*core.CoreDNSDNS64)(unsafe.Pointer(in.DNS64))
+	out.ForwardZones = *(*[]core.CoreDNSForwardZone)(unsafe.Pointer(&in.ForwardZones))
+	return nil
+}
+
+// Convert_v1beta1_CoreDNSCustomPlugins_To_core_CoreDNSCustomPlugins is an autogenerated conversion function.
+func Convert_v1beta1_CoreDNSCustomPlugins_To_core_CoreDNSCustomPlugins(in *CoreDNSCustomPlugins, out *core.CoreDNSCustomPlugins, s conversion.Scope) error {
+	return autoConvert_v1beta1_CoreDNSCustomPlugins_To_core_CoreDNSCustomPlugins(in, out, s)
+}
+
+func autoConvert_core_CoreDNSCustomPlugins_To_v1beta1_CoreDNSCustomPlugins(in *core.CoreDNSCustomPlugins, out *CoreDNSCustomPlugins, s conversion.Scope) error {
+	out.Log = (*bool)(unsafe.Pointer(in.Log))
+	out.DNS64 = (*CoreDNSDNS64)(unsafe.Pointer(in.DNS64))
+	out.ForwardZones = *(*[]CoreDNSForwardZone)(unsafe.Pointer(&in.ForwardZones))
+	return nil
+}
+
+// Convert_core_CoreDNSCustomPlugins_To_v1beta1_CoreDNSCustomPlugins is an autogenerated conversion function.
+func Convert_core_CoreDNSCustomPlugins_To_v1beta1_CoreDNSCustomPlugins(in *core.CoreDNSCustomPlugins, out *CoreDNSCustomPlugins, s conversion.Scope) error {
+	return autoConvert_core_CoreDNSCustomPlugins_To_v1beta1_CoreDNSCustomPlugins(in, out, s)
+}
+
+func autoConvert_v1beta1_CoreDNSDNS64_To_core_CoreDNSDNS64(in *CoreDNSDNS64, out *core.CoreDNSDNS64, s conversion.Scope) error {
+	out.Prefix = (*string)(unsafe.Pointer(in.Prefix))
+	return nil
+}
+
+// Convert_v1beta1_CoreDNSDNS64_To_core_CoreDNSDNS64 is an autogenerated conversion function.
+func Convert_v1beta1_CoreDNSDNS64_To_core_CoreDNSDNS64(in *CoreDNSDNS64, out *core.CoreDNSDNS64, s conversion.Scope) error {
+	return autoConvert_v1beta1_CoreDNSDNS64_To_core_CoreDNSDNS64(in, out, s)
+}
+
+func autoConvert_core_CoreDNSDNS64_To_v1beta1_CoreDNSDNS64(in *core.CoreDNSDNS64, out *CoreDNSDNS64, s conversion.Scope) error {
+	out.Prefix = (*string)(unsafe.Pointer(in.Prefix))
+	return nil
+}
+
+// Convert_core_CoreDNSDNS64_To_v1beta1_CoreDNSDNS64 is an autogenerated conversion function.
+func Convert_core_CoreDNSDNS64_To_v1beta1_CoreDNSDNS64(in *core.CoreDNSDNS64, out *CoreDNSDNS64, s conversion.Scope) error {
+	return autoConvert_core_CoreDNSDNS64_To_v1beta1_CoreDNSDNS64(in, out, s)
+}
+
+func autoConvert_v1beta1_CoreDNSForwardZone_To_core_CoreDNSForwardZone(in *CoreDNSForwardZone, out *core.CoreDNSForwardZone, s conversion.Scope) error {
+	out.Zone = in.Zone
+	out.Upstreams = *(*[]string)(unsafe.Pointer(&in.Upstreams))
+	return nil
+}
+
+// Convert_v1beta1_CoreDNSForwardZone_To_core_CoreDNSForwardZone is an autogenerated conversion function.
+func Convert_v1beta1_CoreDNSForwardZone_To_core_CoreDNSForwardZone(in *CoreDNSForwardZone, out *core.CoreDNSForwardZone, s conversion.Scope) error {
+	return autoConvert_v1beta1_CoreDNSForwardZone_To_core_CoreDNSForwardZone(in, out, s)
+}
+
+func autoConvert_core_CoreDNSForwardZone_To_v1beta1_CoreDNSForwardZone(in *core.CoreDNSForwardZone, out *CoreDNSForwardZone, s conversion.Scope) error {
+	out.Zone = in.Zone
+	out.Upstreams = *(*[]string)(unsafe.Pointer(&in.Upstreams))
+	return nil
+}
+
+// Convert_core_CoreDNSForwardZone_To_v1beta1_CoreDNSForwardZone is an autogenerated conversion function.
+func Convert_core_CoreDNSForwardZone_To_v1beta1_CoreDNSForwardZone(in *core.CoreDNSForwardZone, out *CoreDNSForwardZone, s conversion.Scope) error {
+	return autoConvert_core_CoreDNSForwardZone_To_v1beta1_CoreDNSForwardZone(in, out, s)
+}
+
 func autoConvert_v1beta1_CoreDNSRewriting_To_core_CoreDNSRewriting(in *CoreDNSRewriting, out *core.CoreDNSRewriting, s conversion.Scope) error {
 	out.CommonSuffixes = *(*[]string)(unsafe.Pointer(&in.CommonSuffixes))
 	return nil
@@ -3239,11 +4117,35 @@ func Convert_core_DNSProvider_To_v1beta1_DNSProvider(in *core.DNSProvider, out *
 	return autoConvert_core_DNSProvider_To_v1beta1_DNSProvider(in, out, s)
 }
 
+func autoConvert_v1beta1_DataResidency_To_core_DataResidency(in *DataResidency, out *core.DataResidency, s conversion.Scope) error {
+	out.Jurisdictions = *(*[]string)(unsafe.Pointer(&in.Jurisdictions))
+	out.Geographies = *(*[]string)(unsafe.Pointer(&in.Geographies))
+	return nil
+}
+
+// Convert_v1beta1_DataResidency_To_core_DataResidency is an autogenerated conversion function.
+func Convert_v1beta1_DataResidency_To_core_DataResidency(in *DataResidency, out *core.DataResidency, s conversion.Scope) error {
+	return autoConvert_v1beta1_DataResidency_To_core_DataResidency(in, out, s)
+}
+
+func autoConvert_core_DataResidency_To_v1beta1_DataResidency(in *core.DataResidency, out *DataResidency, s conversion.Scope) error {
+	out.Jurisdictions = *(*[]string)(unsafe.Pointer(&in.Jurisdictions))
+	out.Geographies = *(*[]string)(unsafe.Pointer(&in.Geographies))
+	return nil
+}
+
+// Convert_core_DataResidency_To_v1beta1_DataResidency is an autogenerated conversion function.
+func Convert_core_DataResidency_To_v1beta1_DataResidency(in *core.DataResidency, out *DataResidency, s conversion.Scope) error {
+	return autoConvert_core_DataResidency_To_v1beta1_DataResidency(in, out, s)
+}
+
 func autoConvert_v1beta1_DataVolume_To_core_DataVolume(in *DataVolume, out *core.DataVolume, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Type = (*string)(unsafe.Pointer(in.Type))
 	out.VolumeSize = in.VolumeSize
 	out.Encrypted = (*bool)(unsafe.Pointer(in.Encrypted))
+	out.IOPS = (*int64)(unsafe.Pointer(in.IOPS))
+	out.Throughput = (*int64)(unsafe.Pointer(in.Throughput))
 	return nil
 }
 
@@ -3257,6 +4159,8 @@ func autoConvert_core_DataVolume_To_v1beta1_DataVolume(in *core.DataVolume, out
 	out.Type = (*string)(unsafe.Pointer(in.Type))
 	out.VolumeSize = in.VolumeSize
 	out.Encrypted = (*bool)(unsafe.Pointer(in.Encrypted))
+	out.IOPS = (*int64)(unsafe.Pointer(in.IOPS))
+	out.Throughput = (*int64)(unsafe.Pointer(in.Throughput))
 	return nil
 }
 
@@ -3285,6 +4189,34 @@ func Convert_core_DeploymentRef_To_v1beta1_DeploymentRef(in *core.DeploymentRef,
 	return autoConvert_core_DeploymentRef_To_v1beta1_DeploymentRef(in, out, s)
 }
 
+func autoConvert_v1beta1_DeprecatedField_To_core_DeprecatedField(in *DeprecatedField, out *core.DeprecatedField, s conversion.Scope) error {
+	out.APIVersion = in.APIVersion
+	out.Kind = in.Kind
+	out.Path = in.Path
+	out.Description = in.Description
+	out.ForbiddenFromKubernetesVersion = (*string)(unsafe.Pointer(in.ForbiddenFromKubernetesVersion))
+	return nil
+}
+
+// Convert_v1beta1_DeprecatedField_To_core_DeprecatedField is an autogenerated conversion function.
+func Convert_v1beta1_DeprecatedField_To_core_DeprecatedField(in *DeprecatedField, out *core.DeprecatedField, s conversion.Scope) error {
+	return autoConvert_v1beta1_DeprecatedField_To_core_DeprecatedField(in, out, s)
+}
+
+func autoConvert_core_DeprecatedField_To_v1beta1_DeprecatedField(in *core.DeprecatedField, out *DeprecatedField, s conversion.Scope) error {
+	out.APIVersion = in.APIVersion
+	out.Kind = in.Kind
+	out.Path = in.Path
+	out.Description = in.Description
+	out.ForbiddenFromKubernetesVersion = (*string)(unsafe.Pointer(in.ForbiddenFromKubernetesVersion))
+	return nil
+}
+
+// Convert_core_DeprecatedField_To_v1beta1_DeprecatedField is an autogenerated conversion function.
+func Convert_core_DeprecatedField_To_v1beta1_DeprecatedField(in *core.DeprecatedField, out *DeprecatedField, s conversion.Scope) error {
+	return autoConvert_core_DeprecatedField_To_v1beta1_DeprecatedField(in, out, s)
+}
+
 func autoConvert_v1beta1_DualApprovalForDeletion_To_core_DualApprovalForDeletion(in *DualApprovalForDeletion, out *core.DualApprovalForDeletion, s conversion.Scope) error {
 	out.Resource = in.Resource
 	out.Selector = in.Selector
@@ -3309,6 +4241,48 @@ func Convert_core_DualApprovalForDeletion_To_v1beta1_DualApprovalForDeletion(in
 	return autoConvert_core_DualApprovalForDeletion_To_v1beta1_DualApprovalForDeletion(in, out, s)
 }
 
+func autoConvert_v1beta1_ETCD_To_core_ETCD(in *ETCD, out *core.ETCD, s conversion.Scope) error {
+	out.Backup = (*core.ETCDBackup)(unsafe.Pointer(in.Backup))
+	return nil
+}
+
+// Convert_v1beta1_ETCD_To_core_ETCD is an autogenerated conversion function.
+func Convert_v1beta1_ETCD_To_core_ETCD(in *ETCD, out *core.ETCD, s conversion.Scope) error {
+	return autoConvert_v1beta1_ETCD_To_core_ETCD(in, out, s)
+}
+
+func autoConvert_core_ETCD_To_v1beta1_ETCD(in *core.ETCD, out *ETCD, s conversion.Scope) error {
+	out.Backup = (*ETCDBackup)(unsafe.Pointer(in.Backup))
+	return nil
+}
+
+// Convert_core_ETCD_To_v1beta1_ETCD is an autogenerated conversion function.
+func Convert_core_ETCD_To_v1beta1_ETCD(in *core.ETCD, out *ETCD, s conversion.Scope) error {
+	return autoConvert_core_ETCD_To_v1beta1_ETCD(in, out, s)
+}
+
+func autoConvert_v1beta1_ETCDBackup_To_core_ETCDBackup(in *ETCDBackup, out *core.ETCDBackup, s conversion.Scope) error {
+	out.FullSnapshotSchedule = (*string)(unsafe.Pointer(in.FullSnapshotSchedule))
+	out.DeltaSnapshotRetentionPeriod = (*metav1.Duration)(unsafe.Pointer(in.DeltaSnapshotRetentionPeriod))
+	return nil
+}
+
+// Convert_v1beta1_ETCDBackup_To_core_ETCDBackup is an autogenerated conversion function.
+func Convert_v1beta1_ETCDBackup_To_core_ETCDBackup(in *ETCDBackup, out *core.ETCDBackup, s conversion.Scope) error {
+	return autoConvert_v1beta1_ETCDBackup_To_core_ETCDBackup(in, out, s)
+}
+
+func autoConvert_core_ETCDBackup_To_v1beta1_ETCDBackup(in *core.ETCDBackup, out *ETCDBackup, s conversion.Scope) error {
+	out.FullSnapshotSchedule = (*string)(unsafe.Pointer(in.FullSnapshotSchedule))
+	out.DeltaSnapshotRetentionPeriod = (*metav1.Duration)(unsafe.Pointer(in.DeltaSnapshotRetentionPeriod))
+	return nil
+}
+
+// Convert_core_ETCDBackup_To_v1beta1_ETCDBackup is an autogenerated conversion function.
+func Convert_core_ETCDBackup_To_v1beta1_ETCDBackup(in *core.ETCDBackup, out *ETCDBackup, s conversion.Scope) error {
+	return autoConvert_core_ETCDBackup_To_v1beta1_ETCDBackup(in, out, s)
+}
+
 func autoConvert_v1beta1_ETCDEncryptionKeyRotation_To_core_ETCDEncryptionKeyRotation(in *ETCDEncryptionKeyRotation, out *core.ETCDEncryptionKeyRotation, s conversion.Scope) error {
 	out.Phase = core.CredentialsRotationPhase(in.Phase)
 	out.LastCompletionTime = (*metav1.Time)(unsafe.Pointer(in.LastCompletionTime))
@@ -3357,6 +4331,62 @@ func Convert_core_EncryptionConfig_To_v1beta1_EncryptionConfig(in *core.Encrypti
 	return autoConvert_core_EncryptionConfig_To_v1beta1_EncryptionConfig(in, out, s)
 }
 
+func autoConvert_v1beta1_ErrorTriage_To_core_ErrorTriage(in *ErrorTriage, out *core.ErrorTriage, s conversion.Scope) error {
+	out.Category = core.ErrorCategory(in.Category)
+	out.Confidence = core.ErrorTriageConfidence(in.Confidence)
+	out.Suggestions = *(*[]string)(unsafe.Pointer(&in.Suggestions))
+	return nil
+}
+
+// Convert_v1beta1_ErrorTriage_To_core_ErrorTriage is an autogenerated conversion function.
+func Convert_v1beta1_ErrorTriage_To_core_ErrorTriage(in *ErrorTriage, out *core.ErrorTriage, s conversion.Scope) error {
+	return autoConvert_v1beta1_ErrorTriage_To_core_ErrorTriage(in, out, s)
+}
+
+func autoConvert_core_ErrorTriage_To_v1beta1_ErrorTriage(in *core.ErrorTriage, out *ErrorTriage, s conversion.Scope) error {
+	out.Category = ErrorCategory(in.Category)
+	out.Confidence = ErrorTriageConfidence(in.Confidence)
+	out.Suggestions = *(*[]string)(unsafe.Pointer(&in.Suggestions))
+	return nil
+}
+
+// Convert_core_ErrorTriage_To_v1beta1_ErrorTriage is an autogenerated conversion function.
+func Convert_core_ErrorTriage_To_v1beta1_ErrorTriage(in *core.ErrorTriage, out *ErrorTriage, s conversion.Scope) error {
+	return autoConvert_core_ErrorTriage_To_v1beta1_ErrorTriage(in, out, s)
+}
+
+func autoConvert_v1beta1_EtcdSnapshot_To_core_EtcdSnapshot(in *EtcdSnapshot, out *core.EtcdSnapshot, s conversion.Scope) error {
+	out.Phase = core.EtcdSnapshotPhase(in.Phase)
+	out.LastInitiationTime = (*metav1.Time)(unsafe.Pointer(in.LastInitiationTime))
+	out.LastCompletionTime = (*metav1.Time)(unsafe.Pointer(in.LastCompletionTime))
+	out.Name = (*string)(unsafe.Pointer(in.Name))
+	out.Location = (*string)(unsafe.Pointer(in.Location))
+	out.LastRevision = (*int64)(unsafe.Pointer(in.LastRevision))
+	out.Message = (*string)(unsafe.Pointer(in.Message))
+	return nil
+}
+
+// Convert_v1beta1_EtcdSnapshot_To_core_EtcdSnapshot is an autogenerated conversion function.
+func Convert_v1beta1_EtcdSnapshot_To_core_EtcdSnapshot(in *EtcdSnapshot, out *core.EtcdSnapshot, s conversion.Scope) error {
+	return autoConvert_v1beta1_EtcdSnapshot_To_core_EtcdSnapshot(in, out, s)
+}
+
+func autoConvert_core_EtcdSnapshot_To_v1beta1_EtcdSnapshot(in *core.EtcdSnapshot, out *EtcdSnapshot, s conversion.Scope) error {
+	out.Phase = EtcdSnapshotPhase(in.Phase)
+	out.LastInitiationTime = (*metav1.Time)(unsafe.Pointer(in.LastInitiationTime))
+	out.LastCompletionTime = (*metav1.Time)(unsafe.Pointer(in.LastCompletionTime))
+	out.Name = (*string)(unsafe.Pointer(in.Name))
+	out.Location = (*string)(unsafe.Pointer(in.Location))
+	out.LastRevision = (*int64)(unsafe.Pointer(in.LastRevision))
+	out.Message = (*string)(unsafe.Pointer(in.Message))
+	return nil
+}
+
+// Convert_core_EtcdSnapshot_To_v1beta1_EtcdSnapshot is an autogenerated conversion function.
+func Convert_core_EtcdSnapshot_To_v1beta1_EtcdSnapshot(in *core.EtcdSnapshot, out *EtcdSnapshot, s conversion.Scope) error {
+	return autoConvert_core_EtcdSnapshot_To_v1beta1_EtcdSnapshot(in, out, s)
+}
+
 func autoConvert_v1beta1_ExpirableVersion_To_core_ExpirableVersion(in *ExpirableVersion, out *core.ExpirableVersion, s conversion.Scope) error {
 	out.Version = in.Version
 	out.ExpirationDate = (*metav1.Time)(unsafe.Pointer(in.ExpirationDate))
@@ -3385,6 +4415,8 @@ func autoConvert_v1beta1_ExposureClass_To_core_ExposureClass(in *ExposureClass,
 	out.ObjectMeta = in.ObjectMeta
 	out.Handler = in.Handler
 	out.Scheduling = (*core.ExposureClassScheduling)(unsafe.Pointer(in.Scheduling))
+	out.LoadBalancerService = (*core.ExposureClassLoadBalancerService)(unsafe.Pointer(in.LoadBalancerService))
+	out.IngressGateway = (*core.ExposureClassIngressGateway)(unsafe.Pointer(in.IngressGateway))
 	return nil
 }
 
@@ -3397,6 +4429,8 @@ func autoConvert_core_ExposureClass_To_v1beta1_ExposureClass(in *core.ExposureCl
 	out.ObjectMeta = in.ObjectMeta
 	out.Handler = in.Handler
 	out.Scheduling = (*ExposureClassScheduling)(unsafe.Pointer(in.Scheduling))
+	out.LoadBalancerService = (*ExposureClassLoadBalancerService)(unsafe.Pointer(in.LoadBalancerService))
+	out.IngressGateway = (*ExposureClassIngressGateway)(unsafe.Pointer(in.IngressGateway))
 	return nil
 }
 
@@ -3405,6 +4439,28 @@ func Convert_core_ExposureClass_To_v1beta1_ExposureClass(in *core.ExposureClass,
 	return autoConvert_core_ExposureClass_To_v1beta1_ExposureClass(in, out, s)
 }
 
+func autoConvert_v1beta1_ExposureClassIngressGateway_To_core_ExposureClassIngressGateway(in *ExposureClassIngressGateway, out *core.ExposureClassIngressGateway, s conversion.Scope) error {
+	out.MinReplicas = (*int32)(unsafe.Pointer(in.MinReplicas))
+	out.MaxReplicas = (*int32)(unsafe.Pointer(in.MaxReplicas))
+	return nil
+}
+
+// Convert_v1beta1_ExposureClassIngressGateway_To_core_ExposureClassIngressGateway is an autogenerated conversion function.
+func Convert_v1beta1_ExposureClassIngressGateway_To_core_ExposureClassIngressGateway(in *ExposureClassIngressGateway, out *core.ExposureClassIngressGateway, s conversion.Scope) error {
+	return autoConvert_v1beta1_ExposureClassIngressGateway_To_core_ExposureClassIngressGateway(in, out, s)
+}
+
+func autoConvert_core_ExposureClassIngressGateway_To_v1beta1_ExposureClassIngressGateway(in *core.ExposureClassIngressGateway, out *ExposureClassIngressGateway, s conversion.Scope) error {
+	out.MinReplicas = (*int32)(unsafe.Pointer(in.MinReplicas))
+	out.MaxReplicas = (*int32)(unsafe.Pointer(in.MaxReplicas))
+	return nil
+}
+
+// Convert_core_ExposureClassIngressGateway_To_v1beta1_ExposureClassIngressGateway is an autogenerated conversion function.
+func Convert_core_ExposureClassIngressGateway_To_v1beta1_ExposureClassIngressGateway(in *core.ExposureClassIngressGateway, out *ExposureClassIngressGateway, s conversion.Scope) error {
+	return autoConvert_core_ExposureClassIngressGateway_To_v1beta1_ExposureClassIngressGateway(in, out, s)
+}
+
 func autoConvert_v1beta1_ExposureClassList_To_core_ExposureClassList(in *ExposureClassList, out *core.ExposureClassList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	out.Items = *(*[]core.ExposureClass)(unsafe.Pointer(&in.Items))
@@ -3427,6 +4483,28 @@ func Convert_core_ExposureClassList_To_v1beta1_ExposureClassList(in *core.Exposu
 	return autoConvert_core_ExposureClassList_To_v1beta1_ExposureClassList(in, out, s)
 }
 
+func autoConvert_v1beta1_ExposureClassLoadBalancerService_To_core_ExposureClassLoadBalancerService(in *ExposureClassLoadBalancerService, out *core.ExposureClassLoadBalancerService, s conversion.Scope) error {
+	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
+	out.SourceRanges = *(*[]string)(unsafe.Pointer(&in.SourceRanges))
+	return nil
+}
+
+// Convert_v1beta1_ExposureClassLoadBalancerService_To_core_ExposureClassLoadBalancerService is an autogenerated conversion function.
+func Convert_v1beta1_ExposureClassLoadBalancerService_To_core_ExposureClassLoadBalancerService(in *ExposureClassLoadBalancerService, out *core.ExposureClassLoadBalancerService, s conversion.Scope) error {
+	return autoConvert_v1beta1_ExposureClassLoadBalancerService_To_core_ExposureClassLoadBalancerService(in, out, s)
+}
+
+func autoConvert_core_ExposureClassLoadBalancerService_To_v1beta1_ExposureClassLoadBalancerService(in *core.ExposureClassLoadBalancerService, out *ExposureClassLoadBalancerService, s conversion.Scope) error {
+	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
+	out.SourceRanges = *(*[]string)(unsafe.Pointer(&in.SourceRanges))
+	return nil
+}
+
+// Convert_core_ExposureClassLoadBalancerService_To_v1beta1_ExposureClassLoadBalancerService is an autogenerated conversion function.
+func Convert_core_ExposureClassLoadBalancerService_To_v1beta1_ExposureClassLoadBalancerService(in *core.ExposureClassLoadBalancerService, out *ExposureClassLoadBalancerService, s conversion.Scope) error {
+	return autoConvert_core_ExposureClassLoadBalancerService_To_v1beta1_ExposureClassLoadBalancerService(in, out, s)
+}
+
 func autoConvert_v1beta1_ExposureClassScheduling_To_core_ExposureClassScheduling(in *ExposureClassScheduling, out *core.ExposureClassScheduling, s conversion.Scope) error {
 	out.SeedSelector = (*core.SeedSelector)(unsafe.Pointer(in.SeedSelector))
 	out.Tolerations = *(*[]core.Toleration)(unsafe.Pointer(&in.Tolerations))
@@ -3521,6 +4599,82 @@ func Convert_core_FailureTolerance_To_v1beta1_FailureTolerance(in *core.FailureT
 	return autoConvert_core_FailureTolerance_To_v1beta1_FailureTolerance(in, out, s)
 }
 
+func autoConvert_v1beta1_FeatureGate_To_core_FeatureGate(in *FeatureGate, out *core.FeatureGate, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Default = in.Default
+	out.PreRelease = in.PreRelease
+	out.LockToDefault = in.LockToDefault
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	return nil
+}
+
+// Convert_v1beta1_FeatureGate_To_core_FeatureGate is an autogenerated conversion function.
+func Convert_v1beta1_FeatureGate_To_core_FeatureGate(in *FeatureGate, out *core.FeatureGate, s conversion.Scope) error {
+	return autoConvert_v1beta1_FeatureGate_To_core_FeatureGate(in, out, s)
+}
+
+func autoConvert_core_FeatureGate_To_v1beta1_FeatureGate(in *core.FeatureGate, out *FeatureGate, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Default = in.Default
+	out.PreRelease = in.PreRelease
+	out.LockToDefault = in.LockToDefault
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	return nil
+}
+
+// Convert_core_FeatureGate_To_v1beta1_FeatureGate is an autogenerated conversion function.
+func Convert_core_FeatureGate_To_v1beta1_FeatureGate(in *core.FeatureGate, out *FeatureGate, s conversion.Scope) error {
+	return autoConvert_core_FeatureGate_To_v1beta1_FeatureGate(in, out, s)
+}
+
+func autoConvert_v1beta1_FeatureMatrix_To_core_FeatureMatrix(in *FeatureMatrix, out *core.FeatureMatrix, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.GardenerVersion = in.GardenerVersion
+	out.FeatureGates = *(*[]core.FeatureGate)(unsafe.Pointer(&in.FeatureGates))
+	out.DeprecatedFields = *(*[]core.DeprecatedField)(unsafe.Pointer(&in.DeprecatedFields))
+	return nil
+}
+
+// Convert_v1beta1_FeatureMatrix_To_core_FeatureMatrix is an autogenerated conversion function.
+func Convert_v1beta1_FeatureMatrix_To_core_FeatureMatrix(in *FeatureMatrix, out *core.FeatureMatrix, s conversion.Scope) error {
+	return autoConvert_v1beta1_FeatureMatrix_To_core_FeatureMatrix(in, out, s)
+}
+
+func autoConvert_core_FeatureMatrix_To_v1beta1_FeatureMatrix(in *core.FeatureMatrix, out *FeatureMatrix, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	out.GardenerVersion = in.GardenerVersion
+	out.FeatureGates = *(*[]FeatureGate)(unsafe.Pointer(&in.FeatureGates))
+	out.DeprecatedFields = *(*[]DeprecatedField)(unsafe.Pointer(&in.DeprecatedFields))
+	return nil
+}
+
+// Convert_core_FeatureMatrix_To_v1beta1_FeatureMatrix is an autogenerated conversion function.
+func Convert_core_FeatureMatrix_To_v1beta1_FeatureMatrix(in *core.FeatureMatrix, out *FeatureMatrix, s conversion.Scope) error {
+	return autoConvert_core_FeatureMatrix_To_v1beta1_FeatureMatrix(in, out, s)
+}
+
+func autoConvert_v1beta1_FeatureMatrixList_To_core_FeatureMatrixList(in *FeatureMatrixList, out *core.FeatureMatrixList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]core.FeatureMatrix)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1beta1_FeatureMatrixList_To_core_FeatureMatrixList is an autogenerated conversion function.
+func Convert_v1beta1_FeatureMatrixList_To_core_FeatureMatrixList(in *FeatureMatrixList, out *core.FeatureMatrixList, s conversion.Scope) error {
+	return autoConvert_v1beta1_FeatureMatrixList_To_core_FeatureMatrixList(in, out, s)
+}
+
+func autoConvert_core_FeatureMatrixList_To_v1beta1_FeatureMatrixList(in *core.FeatureMatrixList, out *FeatureMatrixList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]FeatureMatrix)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_core_FeatureMatrixList_To_v1beta1_FeatureMatrixList is an autogenerated conversion function.
+func Convert_core_FeatureMatrixList_To_v1beta1_FeatureMatrixList(in *core.FeatureMatrixList, out *FeatureMatrixList, s conversion.Scope) error {
+	return autoConvert_core_FeatureMatrixList_To_v1beta1_FeatureMatrixList(in, out, s)
+}
+
 func autoConvert_v1beta1_Gardener_To_core_Gardener(in *Gardener, out *core.Gardener, s conversion.Scope) error {
 	out.ID = in.ID
 	out.Name = in.Name
@@ -3611,6 +4765,7 @@ func autoConvert_v1beta1_HibernationSchedule_To_core_HibernationSchedule(in *Hib
 	out.Start = (*string)(unsafe.Pointer(in.Start))
 	out.End = (*string)(unsafe.Pointer(in.End))
 	out.Location = (*string)(unsafe.Pointer(in.Location))
+	out.Exceptions = *(*[]string)(unsafe.Pointer(&in.Exceptions))
 	return nil
 }
 
@@ -3623,6 +4778,7 @@ func autoConvert_core_HibernationSchedule_To_v1beta1_HibernationSchedule(in *cor
 	out.Start = (*string)(unsafe.Pointer(in.Start))
 	out.End = (*string)(unsafe.Pointer(in.End))
 	out.Location = (*string)(unsafe.Pointer(in.Location))
+	out.Exceptions = *(*[]string)(unsafe.Pointer(&in.Exceptions))
 	return nil
 }
 
@@ -3688,6 +4844,7 @@ func autoConvert_v1beta1_Ingress_To_core_Ingress(in *Ingress, out *core.Ingress,
 	if err := Convert_v1beta1_IngressController_To_core_IngressController(&in.Controller, &out.Controller, s); err != nil {
 		return err
 	}
+	out.KubeAPIServerPorts = *(*[]int32)(unsafe.Pointer(&in.KubeAPIServerPorts))
 	return nil
 }
 
@@ -3701,6 +4858,7 @@ func autoConvert_core_Ingress_To_v1beta1_Ingress(in *core.Ingress, out *Ingress,
 	if err := Convert_core_IngressController_To_v1beta1_IngressController(&in.Controller, &out.Controller, s); err != nil {
 		return err
 	}
+	out.KubeAPIServerPorts = *(*[]int32)(unsafe.Pointer(&in.KubeAPIServerPorts))
 	return nil
 }
 
@@ -3825,6 +4983,10 @@ func autoConvert_v1beta1_KubeAPIServerConfig_To_core_KubeAPIServerConfig(in *Kub
 	out.EncryptionConfig = (*core.EncryptionConfig)(unsafe.Pointer(in.EncryptionConfig))
 	out.StructuredAuthentication = (*core.StructuredAuthentication)(unsafe.Pointer(in.StructuredAuthentication))
 	out.StructuredAuthorization = (*core.StructuredAuthorization)(unsafe.Pointer(in.StructuredAuthorization))
+	out.AuthorizedNetworks = *(*[]string)(unsafe.Pointer(&in.AuthorizedNetworks))
+	out.Endpoint = (*core.KubeAPIServerEndpoint)(unsafe.Pointer(in.Endpoint))
+	out.APIPriorityAndFairness = (*core.APIPriorityAndFairness)(unsafe.Pointer(in.APIPriorityAndFairness))
+	out.VPN = (*core.KubeAPIServerVPNConfig)(unsafe.Pointer(in.VPN))
 	return nil
 }
 
@@ -3863,6 +5025,10 @@ func autoConvert_core_KubeAPIServerConfig_To_v1beta1_KubeAPIServerConfig(in *cor
 	out.EncryptionConfig = (*EncryptionConfig)(unsafe.Pointer(in.EncryptionConfig))
 	out.StructuredAuthentication = (*StructuredAuthentication)(unsafe.Pointer(in.StructuredAuthentication))
 	out.StructuredAuthorization = (*StructuredAuthorization)(unsafe.Pointer(in.StructuredAuthorization))
+	out.AuthorizedNetworks = *(*[]string)(unsafe.Pointer(&in.AuthorizedNetworks))
+	out.Endpoint = (*KubeAPIServerEndpoint)(unsafe.Pointer(in.Endpoint))
+	out.APIPriorityAndFairness = (*APIPriorityAndFairness)(unsafe.Pointer(in.APIPriorityAndFairness))
+	out.VPN = (*KubeAPIServerVPNConfig)(unsafe.Pointer(in.VPN))
 	return nil
 }
 
@@ -3871,6 +5037,54 @@ func Convert_core_KubeAPIServerConfig_To_v1beta1_KubeAPIServerConfig(in *core.Ku
 	return autoConvert_core_KubeAPIServerConfig_To_v1beta1_KubeAPIServerConfig(in, out, s)
 }
 
+func autoConvert_v1beta1_KubeAPIServerEndpoint_To_core_KubeAPIServerEndpoint(in *KubeAPIServerEndpoint, out *core.KubeAPIServerEndpoint, s conversion.Scope) error {
+	out.AdditionalHostnames = *(*[]string)(unsafe.Pointer(&in.AdditionalHostnames))
+	out.Port = (*int32)(unsafe.Pointer(in.Port))
+	out.ManagedCertificate = (*bool)(unsafe.Pointer(in.ManagedCertificate))
+	return nil
+}
+
+// Convert_v1beta1_KubeAPIServerEndpoint_To_core_KubeAPIServerEndpoint is an autogenerated conversion function.
+func Convert_v1beta1_KubeAPIServerEndpoint_To_core_KubeAPIServerEndpoint(in *KubeAPIServerEndpoint, out *core.KubeAPIServerEndpoint, s conversion.Scope) error {
+	return autoConvert_v1beta1_KubeAPIServerEndpoint_To_core_KubeAPIServerEndpoint(in, out, s)
+}
+
+func autoConvert_core_KubeAPIServerEndpoint_To_v1beta1_KubeAPIServerEndpoint(in *core.KubeAPIServerEndpoint, out *KubeAPIServerEndpoint, s conversion.Scope) error {
+	out.AdditionalHostnames = *(*[]string)(unsafe.Pointer(&in.AdditionalHostnames))
+	out.Port = (*int32)(unsafe.Pointer(in.Port))
+	out.ManagedCertificate = (*bool)(unsafe.Pointer(in.ManagedCertificate))
+	return nil
+}
+
+// Convert_core_KubeAPIServerEndpoint_To_v1beta1_KubeAPIServerEndpoint is an autogenerated conversion function.
+func Convert_core_KubeAPIServerEndpoint_To_v1beta1_KubeAPIServerEndpoint(in *core.KubeAPIServerEndpoint, out *KubeAPIServerEndpoint, s conversion.Scope) error {
+	return autoConvert_core_KubeAPIServerEndpoint_To_v1beta1_KubeAPIServerEndpoint(in, out, s)
+}
+
+func autoConvert_v1beta1_KubeAPIServerVPNConfig_To_core_KubeAPIServerVPNConfig(in *KubeAPIServerVPNConfig, out *core.KubeAPIServerVPNConfig, s conversion.Scope) error {
+	out.HighAvailability = (*bool)(unsafe.Pointer(in.HighAvailability))
+	out.SeedServers = (*int32)(unsafe.Pointer(in.SeedServers))
+	out.ShootClients = (*int32)(unsafe.Pointer(in.ShootClients))
+	return nil
+}
+
+// Convert_v1beta1_KubeAPIServerVPNConfig_To_core_KubeAPIServerVPNConfig is an autogenerated conversion function.
+func Convert_v1beta1_KubeAPIServerVPNConfig_To_core_KubeAPIServerVPNConfig(in *KubeAPIServerVPNConfig, out *core.KubeAPIServerVPNConfig, s conversion.Scope) error {
+	return autoConvert_v1beta1_KubeAPIServerVPNConfig_To_core_KubeAPIServerVPNConfig(in, out, s)
+}
+
+func autoConvert_core_KubeAPIServerVPNConfig_To_v1beta1_KubeAPIServerVPNConfig(in *core.KubeAPIServerVPNConfig, out *KubeAPIServerVPNConfig, s conversion.Scope) error {
+	out.HighAvailability = (*bool)(unsafe.Pointer(in.HighAvailability))
+	out.SeedServers = (*int32)(unsafe.Pointer(in.SeedServers))
+	out.ShootClients = (*int32)(unsafe.Pointer(in.ShootClients))
+	return nil
+}
+
+// Convert_core_KubeAPIServerVPNConfig_To_v1beta1_KubeAPIServerVPNConfig is an autogenerated conversion function.
+func Convert_core_KubeAPIServerVPNConfig_To_v1beta1_KubeAPIServerVPNConfig(in *core.KubeAPIServerVPNConfig, out *KubeAPIServerVPNConfig, s conversion.Scope) error {
+	return autoConvert_core_KubeAPIServerVPNConfig_To_v1beta1_KubeAPIServerVPNConfig(in, out, s)
+}
+
 func autoConvert_v1beta1_KubeControllerManagerConfig_To_core_KubeControllerManagerConfig(in *KubeControllerManagerConfig, out *core.KubeControllerManagerConfig, s conversion.Scope) error {
 	if err := Convert_v1beta1_KubernetesConfig_To_core_KubernetesConfig(&in.KubernetesConfig, &out.KubernetesConfig, s); err != nil {
 		return err
@@ -3879,6 +5093,7 @@ func autoConvert_v1beta1_KubeControllerManagerConfig_To_core_KubeControllerManag
 	out.NodeCIDRMaskSize = (*int32)(unsafe.Pointer(in.NodeCIDRMaskSize))
 	out.PodEvictionTimeout = (*metav1.Duration)(unsafe.Pointer(in.PodEvictionTimeout))
 	out.NodeMonitorGracePeriod = (*metav1.Duration)(unsafe.Pointer(in.NodeMonitorGracePeriod))
+	out.Controllers = *(*[]core.KubeControllerManagerController)(unsafe.Pointer(&in.Controllers))
 	return nil
 }
 
@@ -3895,6 +5110,7 @@ func autoConvert_core_KubeControllerManagerConfig_To_v1beta1_KubeControllerManag
 	out.NodeCIDRMaskSize = (*int32)(unsafe.Pointer(in.NodeCIDRMaskSize))
 	out.PodEvictionTimeout = (*metav1.Duration)(unsafe.Pointer(in.PodEvictionTimeout))
 	out.NodeMonitorGracePeriod = (*metav1.Duration)(unsafe.Pointer(in.NodeMonitorGracePeriod))
+	out.Controllers = *(*[]KubeControllerManagerController)(unsafe.Pointer(&in.Controllers))
 	return nil
 }
 
@@ -3903,6 +5119,30 @@ func Convert_core_KubeControllerManagerConfig_To_v1beta1_KubeControllerManagerCo
 	return autoConvert_core_KubeControllerManagerConfig_To_v1beta1_KubeControllerManagerConfig(in, out, s)
 }
 
+func autoConvert_v1beta1_KubeControllerManagerController_To_core_KubeControllerManagerController(in *KubeControllerManagerController, out *core.KubeControllerManagerController, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	out.ConcurrentSyncs = (*int32)(unsafe.Pointer(in.ConcurrentSyncs))
+	return nil
+}
+
+// Convert_v1beta1_KubeControllerManagerController_To_core_KubeControllerManagerController is an autogenerated conversion function.
+func Convert_v1beta1_KubeControllerManagerController_To_core_KubeControllerManagerController(in *KubeControllerManagerController, out *core.KubeControllerManagerController, s conversion.Scope) error {
+	return autoConvert_v1beta1_KubeControllerManagerController_To_core_KubeControllerManagerController(in, out, s)
+}
+
+func autoConvert_core_KubeControllerManagerController_To_v1beta1_KubeControllerManagerController(in *core.KubeControllerManagerController, out *KubeControllerManagerController, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	out.ConcurrentSyncs = (*int32)(unsafe.Pointer(in.ConcurrentSyncs))
+	return nil
+}
+
+// Convert_core_KubeControllerManagerController_To_v1beta1_KubeControllerManagerController is an autogenerated conversion function.
+func Convert_core_KubeControllerManagerController_To_v1beta1_KubeControllerManagerController(in *core.KubeControllerManagerController, out *KubeControllerManagerController, s conversion.Scope) error {
+	return autoConvert_core_KubeControllerManagerController_To_v1beta1_KubeControllerManagerController(in, out, s)
+}
+
 func autoConvert_v1beta1_KubeProxyConfig_To_core_KubeProxyConfig(in *KubeProxyConfig, out *core.KubeProxyConfig, s conversion.Scope) error {
 	if err := Convert_v1beta1_KubernetesConfig_To_core_KubernetesConfig(&in.KubernetesConfig, &out.KubernetesConfig, s); err != nil {
 		return err
@@ -3987,6 +5227,8 @@ func autoConvert_v1beta1_KubeletConfig_To_core_KubeletConfig(in *KubeletConfig,
 	out.ProtectKernelDefaults = (*bool)(unsafe.Pointer(in.ProtectKernelDefaults))
 	out.StreamingConnectionIdleTimeout = (*metav1.Duration)(unsafe.Pointer(in.StreamingConnectionIdleTimeout))
 	out.MemorySwap = (*core.MemorySwapConfiguration)(unsafe.Pointer(in.MemorySwap))
+	out.MemoryManagerPolicy = (*core.MemoryManagerPolicy)(unsafe.Pointer(in.MemoryManagerPolicy))
+	out.ReservedMemory = *(*[]core.KubeletMemoryReservation)(unsafe.Pointer(&in.ReservedMemory))
 	return nil
 }
 
@@ -4023,6 +5265,8 @@ func autoConvert_core_KubeletConfig_To_v1beta1_KubeletConfig(in *core.KubeletCon
 	out.ProtectKernelDefaults = (*bool)(unsafe.Pointer(in.ProtectKernelDefaults))
 	out.StreamingConnectionIdleTimeout = (*metav1.Duration)(unsafe.Pointer(in.StreamingConnectionIdleTimeout))
 	out.MemorySwap = (*MemorySwapConfiguration)(unsafe.Pointer(in.MemorySwap))
+	out.MemoryManagerPolicy = (*MemoryManagerPolicy)(unsafe.Pointer(in.MemoryManagerPolicy))
+	out.ReservedMemory = *(*[]KubeletMemoryReservation)(unsafe.Pointer(&in.ReservedMemory))
 	return nil
 }
 
@@ -4141,6 +5385,28 @@ func Convert_core_KubeletConfigReserved_To_v1beta1_KubeletConfigReserved(in *cor
 	return autoConvert_core_KubeletConfigReserved_To_v1beta1_KubeletConfigReserved(in, out, s)
 }
 
+func autoConvert_v1beta1_KubeletMemoryReservation_To_core_KubeletMemoryReservation(in *KubeletMemoryReservation, out *core.KubeletMemoryReservation, s conversion.Scope) error {
+	out.NumaNode = in.NumaNode
+	out.Limits = *(*v1.ResourceList)(unsafe.Pointer(&in.Limits))
+	return nil
+}
+
+// Convert_v1beta1_KubeletMemoryReservation_To_core_KubeletMemoryReservation is an autogenerated conversion function.
+func Convert_v1beta1_KubeletMemoryReservation_To_core_KubeletMemoryReservation(in *KubeletMemoryReservation, out *core.KubeletMemoryReservation, s conversion.Scope) error {
+	return autoConvert_v1beta1_KubeletMemoryReservation_To_core_KubeletMemoryReservation(in, out, s)
+}
+
+func autoConvert_core_KubeletMemoryReservation_To_v1beta1_KubeletMemoryReservation(in *core.KubeletMemoryReservation, out *KubeletMemoryReservation, s conversion.Scope) error {
+	out.NumaNode = in.NumaNode
+	out.Limits = *(*v1.ResourceList)(unsafe.Pointer(&in.Limits))
+	return nil
+}
+
+// Convert_core_KubeletMemoryReservation_To_v1beta1_KubeletMemoryReservation is an autogenerated conversion function.
+func Convert_core_KubeletMemoryReservation_To_v1beta1_KubeletMemoryReservation(in *core.KubeletMemoryReservation, out *KubeletMemoryReservation, s conversion.Scope) error {
+	return autoConvert_core_KubeletMemoryReservation_To_v1beta1_KubeletMemoryReservation(in, out, s)
+}
+
 func autoConvert_v1beta1_Kubernetes_To_core_Kubernetes(in *Kubernetes, out *core.Kubernetes, s conversion.Scope) error {
 	if in.ClusterAutoscaler != nil {
 		in, out := &in.ClusterAutoscaler, &out.ClusterAutoscaler
@@ -4175,6 +5441,7 @@ func autoConvert_v1beta1_Kubernetes_To_core_Kubernetes(in *Kubernetes, out *core
 	out.Version = in.Version
 	out.VerticalPodAutoscaler = (*core.VerticalPodAutoscaler)(unsafe.Pointer(in.VerticalPodAutoscaler))
 	out.EnableStaticTokenKubeconfig = (*bool)(unsafe.Pointer(in.EnableStaticTokenKubeconfig))
+	out.NamespaceDefaults = (*core.NamespaceDefaults)(unsafe.Pointer(in.NamespaceDefaults))
 	return nil
 }
 
@@ -4217,6 +5484,7 @@ func autoConvert_core_Kubernetes_To_v1beta1_Kubernetes(in *core.Kubernetes, out
 	out.Version = in.Version
 	out.VerticalPodAutoscaler = (*VerticalPodAutoscaler)(unsafe.Pointer(in.VerticalPodAutoscaler))
 	out.EnableStaticTokenKubeconfig = (*bool)(unsafe.Pointer(in.EnableStaticTokenKubeconfig))
+	out.NamespaceDefaults = (*NamespaceDefaults)(unsafe.Pointer(in.NamespaceDefaults))
 	return nil
 }
 
@@ -4273,6 +5541,7 @@ func Convert_core_KubernetesDashboard_To_v1beta1_KubernetesDashboard(in *core.Ku
 
 func autoConvert_v1beta1_KubernetesSettings_To_core_KubernetesSettings(in *KubernetesSettings, out *core.KubernetesSettings, s conversion.Scope) error {
 	out.Versions = *(*[]core.ExpirableVersion)(unsafe.Pointer(&in.Versions))
+	out.MaxExpirationExtension = (*metav1.Duration)(unsafe.Pointer(in.MaxExpirationExtension))
 	return nil
 }
 
@@ -4283,6 +5552,7 @@ func Convert_v1beta1_KubernetesSettings_To_core_KubernetesSettings(in *Kubernete
 
 func autoConvert_core_KubernetesSettings_To_v1beta1_KubernetesSettings(in *core.KubernetesSettings, out *KubernetesSettings, s conversion.Scope) error {
 	out.Versions = *(*[]ExpirableVersion)(unsafe.Pointer(&in.Versions))
+	out.MaxExpirationExtension = (*metav1.Duration)(unsafe.Pointer(in.MaxExpirationExtension))
 	return nil
 }
 
@@ -4296,6 +5566,7 @@ func autoConvert_v1beta1_LastError_To_core_LastError(in *LastError, out *core.La
 	out.TaskID = (*string)(unsafe.Pointer(in.TaskID))
 	out.Codes = *(*[]core.ErrorCode)(unsafe.Pointer(&in.Codes))
 	out.LastUpdateTime = (*metav1.Time)(unsafe.Pointer(in.LastUpdateTime))
+	out.Triage = (*core.ErrorTriage)(unsafe.Pointer(in.Triage))
 	return nil
 }
 
@@ -4309,6 +5580,7 @@ func autoConvert_core_LastError_To_v1beta1_LastError(in *core.LastError, out *La
 	out.TaskID = (*string)(unsafe.Pointer(in.TaskID))
 	out.Codes = *(*[]ErrorCode)(unsafe.Pointer(&in.Codes))
 	out.LastUpdateTime = (*metav1.Time)(unsafe.Pointer(in.LastUpdateTime))
+	out.Triage = (*ErrorTriage)(unsafe.Pointer(in.Triage))
 	return nil
 }
 
@@ -4371,6 +5643,30 @@ func Convert_core_LastOperation_To_v1beta1_LastOperation(in *core.LastOperation,
 	return autoConvert_core_LastOperation_To_v1beta1_LastOperation(in, out, s)
 }
 
+func autoConvert_v1beta1_LegacyComponentRemoval_To_core_LegacyComponentRemoval(in *LegacyComponentRemoval, out *core.LegacyComponentRemoval, s conversion.Scope) error {
+	out.Name = in.Name
+	out.State = core.LastOperationState(in.State)
+	out.LastTransitionTime = in.LastTransitionTime
+	return nil
+}
+
+// Convert_v1beta1_LegacyComponentRemoval_To_core_LegacyComponentRemoval is an autogenerated conversion function.
+func Convert_v1beta1_LegacyComponentRemoval_To_core_LegacyComponentRemoval(in *LegacyComponentRemoval, out *core.LegacyComponentRemoval, s conversion.Scope) error {
+	return autoConvert_v1beta1_LegacyComponentRemoval_To_core_LegacyComponentRemoval(in, out, s)
+}
+
+func autoConvert_core_LegacyComponentRemoval_To_v1beta1_LegacyComponentRemoval(in *core.LegacyComponentRemoval, out *LegacyComponentRemoval, s conversion.Scope) error {
+	out.Name = in.Name
+	out.State = LastOperationState(in.State)
+	out.LastTransitionTime = in.LastTransitionTime
+	return nil
+}
+
+// Convert_core_LegacyComponentRemoval_To_v1beta1_LegacyComponentRemoval is an autogenerated conversion function.
+func Convert_core_LegacyComponentRemoval_To_v1beta1_LegacyComponentRemoval(in *core.LegacyComponentRemoval, out *LegacyComponentRemoval, s conversion.Scope) error {
+	return autoConvert_core_LegacyComponentRemoval_To_v1beta1_LegacyComponentRemoval(in, out, s)
+}
+
 func autoConvert_v1beta1_LoadBalancerServicesProxyProtocol_To_core_LoadBalancerServicesProxyProtocol(in *LoadBalancerServicesProxyProtocol, out *core.LoadBalancerServicesProxyProtocol, s conversion.Scope) error {
 	out.Allowed = in.Allowed
 	return nil
@@ -4403,6 +5699,7 @@ func autoConvert_v1beta1_Machine_To_core_Machine(in *Machine, out *core.Machine,
 		out.Image = nil
 	}
 	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
+	out.OSFamily = (*string)(unsafe.Pointer(in.OSFamily))
 	return nil
 }
 
@@ -4423,6 +5720,7 @@ func autoConvert_core_Machine_To_v1beta1_Machine(in *core.Machine, out *Machine,
 		out.Image = nil
 	}
 	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
+	out.OSFamily = (*string)(unsafe.Pointer(in.OSFamily))
 	return nil
 }
 
@@ -4437,6 +5735,8 @@ func autoConvert_v1beta1_MachineControllerManagerSettings_To_core_MachineControl
 	out.MachineCreationTimeout = (*metav1.Duration)(unsafe.Pointer(in.MachineCreationTimeout))
 	out.MaxEvictRetries = (*int32)(unsafe.Pointer(in.MaxEvictRetries))
 	out.NodeConditions = *(*[]string)(unsafe.Pointer(&in.NodeConditions))
+	out.MachineBootstrapTimeout = (*metav1.Duration)(unsafe.Pointer(in.MachineBootstrapTimeout))
+	out.MachineBootstrapFailurePolicy = (*core.MachineBootstrapFailurePolicy)(unsafe.Pointer(in.MachineBootstrapFailurePolicy))
 	return nil
 }
 
@@ -4451,6 +5751,8 @@ func autoConvert_core_MachineControllerManagerSettings_To_v1beta1_MachineControl
 	out.MachineCreationTimeout = (*metav1.Duration)(unsafe.Pointer(in.MachineCreationTimeout))
 	out.MaxEvictRetries = (*int32)(unsafe.Pointer(in.MaxEvictRetries))
 	out.NodeConditions = *(*[]string)(unsafe.Pointer(&in.NodeConditions))
+	out.MachineBootstrapTimeout = (*metav1.Duration)(unsafe.Pointer(in.MachineBootstrapTimeout))
+	out.MachineBootstrapFailurePolicy = (*MachineBootstrapFailurePolicy)(unsafe.Pointer(in.MachineBootstrapFailurePolicy))
 	return nil
 }
 
@@ -4513,6 +5815,30 @@ func Convert_core_MachineImageVersion_To_v1beta1_MachineImageVersion(in *core.Ma
 	return autoConvert_core_MachineImageVersion_To_v1beta1_MachineImageVersion(in, out, s)
 }
 
+func autoConvert_v1beta1_MachineImageVersionUsage_To_core_MachineImageVersionUsage(in *MachineImageVersionUsage, out *core.MachineImageVersionUsage, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Version = in.Version
+	out.Shoots = in.Shoots
+	return nil
+}
+
+// Convert_v1beta1_MachineImageVersionUsage_To_core_MachineImageVersionUsage is an autogenerated conversion function.
+func Convert_v1beta1_MachineImageVersionUsage_To_core_MachineImageVersionUsage(in *MachineImageVersionUsage, out *core.MachineImageVersionUsage, s conversion.Scope) error {
+	return autoConvert_v1beta1_MachineImageVersionUsage_To_core_MachineImageVersionUsage(in, out, s)
+}
+
+func autoConvert_core_MachineImageVersionUsage_To_v1beta1_MachineImageVersionUsage(in *core.MachineImageVersionUsage, out *MachineImageVersionUsage, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Version = in.Version
+	out.Shoots = in.Shoots
+	return nil
+}
+
+// Convert_core_MachineImageVersionUsage_To_v1beta1_MachineImageVersionUsage is an autogenerated conversion function.
+func Convert_core_MachineImageVersionUsage_To_v1beta1_MachineImageVersionUsage(in *core.MachineImageVersionUsage, out *MachineImageVersionUsage, s conversion.Scope) error {
+	return autoConvert_core_MachineImageVersionUsage_To_v1beta1_MachineImageVersionUsage(in, out, s)
+}
+
 func autoConvert_v1beta1_MachineType_To_core_MachineType(in *MachineType, out *core.MachineType, s conversion.Scope) error {
 	out.CPU = in.CPU
 	out.GPU = in.GPU
@@ -4521,6 +5847,8 @@ func autoConvert_v1beta1_MachineType_To_core_MachineType(in *MachineType, out *c
 	out.Storage = (*core.MachineTypeStorage)(unsafe.Pointer(in.Storage))
 	out.Usable = (*bool)(unsafe.Pointer(in.Usable))
 	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
+	out.CapacityTypes = *(*[]core.CapacityType)(unsafe.Pointer(&in.CapacityTypes))
+	out.Regions = *(*[]core.TypeRegionAvailability)(unsafe.Pointer(&in.Regions))
 	return nil
 }
 
@@ -4537,6 +5865,8 @@ func autoConvert_core_MachineType_To_v1beta1_MachineType(in *core.MachineType, o
 	out.Storage = (*MachineTypeStorage)(unsafe.Pointer(in.Storage))
 	out.Usable = (*bool)(unsafe.Pointer(in.Usable))
 	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
+	out.CapacityTypes = *(*[]CapacityType)(unsafe.Pointer(&in.CapacityTypes))
+	out.Regions = *(*[]TypeRegionAvailability)(unsafe.Pointer(&in.Regions))
 	return nil
 }
 
@@ -4575,6 +5905,8 @@ func autoConvert_v1beta1_Maintenance_To_core_Maintenance(in *Maintenance, out *c
 	out.AutoUpdate = (*core.MaintenanceAutoUpdate)(unsafe.Pointer(in.AutoUpdate))
 	out.TimeWindow = (*core.MaintenanceTimeWindow)(unsafe.Pointer(in.TimeWindow))
 	out.ConfineSpecUpdateRollout = (*bool)(unsafe.Pointer(in.ConfineSpecUpdateRollout))
+	out.Exclusions = *(*[]core.MaintenanceExclusion)(unsafe.Pointer(&in.Exclusions))
+	out.WorkerPools = *(*[]core.WorkerPoolMaintenance)(unsafe.Pointer(&in.WorkerPools))
 	return nil
 }
 
@@ -4587,6 +5919,8 @@ func autoConvert_core_Maintenance_To_v1beta1_Maintenance(in *core.Maintenance, o
 	out.AutoUpdate = (*MaintenanceAutoUpdate)(unsafe.Pointer(in.AutoUpdate))
 	out.TimeWindow = (*MaintenanceTimeWindow)(unsafe.Pointer(in.TimeWindow))
 	out.ConfineSpecUpdateRollout = (*bool)(unsafe.Pointer(in.ConfineSpecUpdateRollout))
+	out.Exclusions = *(*[]MaintenanceExclusion)(unsafe.Pointer(&in.Exclusions))
+	out.WorkerPools = *(*[]WorkerPoolMaintenance)(unsafe.Pointer(&in.WorkerPools))
 	return nil
 }
 
@@ -4617,6 +5951,32 @@ func Convert_core_MaintenanceAutoUpdate_To_v1beta1_MaintenanceAutoUpdate(in *cor
 	return autoConvert_core_MaintenanceAutoUpdate_To_v1beta1_MaintenanceAutoUpdate(in, out, s)
 }
 
+func autoConvert_v1beta1_MaintenanceExclusion_To_core_MaintenanceExclusion(in *MaintenanceExclusion, out *core.MaintenanceExclusion, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Begin = in.Begin
+	out.End = in.End
+	out.Operations = *(*[]core.MaintenanceExclusionOperation)(unsafe.Pointer(&in.Operations))
+	return nil
+}
+
+// Convert_v1beta1_MaintenanceExclusion_To_core_MaintenanceExclusion is an autogenerated conversion function.
+func Convert_v1beta1_MaintenanceExclusion_To_core_MaintenanceExclusion(in *MaintenanceExclusion, out *core.MaintenanceExclusion, s conversion.Scope) error {
+	return autoConvert_v1beta1_MaintenanceExclusion_To_core_MaintenanceExclusion(in, out, s)
+}
+
+func autoConvert_core_MaintenanceExclusion_To_v1beta1_MaintenanceExclusion(in *core.MaintenanceExclusion, out *MaintenanceExclusion, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Begin = in.Begin
+	out.End = in.End
+	out.Operations = *(*[]MaintenanceExclusionOperation)(unsafe.Pointer(&in.Operations))
+	return nil
+}
+
+// Convert_core_MaintenanceExclusion_To_v1beta1_MaintenanceExclusion is an autogenerated conversion function.
+func Convert_core_MaintenanceExclusion_To_v1beta1_MaintenanceExclusion(in *core.MaintenanceExclusion, out *MaintenanceExclusion, s conversion.Scope) error {
+	return autoConvert_core_MaintenanceExclusion_To_v1beta1_MaintenanceExclusion(in, out, s)
+}
+
 func autoConvert_v1beta1_MaintenanceTimeWindow_To_core_MaintenanceTimeWindow(in *MaintenanceTimeWindow, out *core.MaintenanceTimeWindow, s conversion.Scope) error {
 	out.Begin = in.Begin
 	out.End = in.End
@@ -4639,6 +5999,30 @@ func Convert_core_MaintenanceTimeWindow_To_v1beta1_MaintenanceTimeWindow(in *cor
 	return autoConvert_core_MaintenanceTimeWindow_To_v1beta1_MaintenanceTimeWindow(in, out, s)
 }
 
+func autoConvert_v1beta1_ManagedAddon_To_core_ManagedAddon(in *ManagedAddon, out *core.ManagedAddon, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Version = in.Version
+	out.Values = (*runtime.RawExtension)(unsafe.Pointer(in.Values))
+	return nil
+}
+
+// Convert_v1beta1_ManagedAddon_To_core_ManagedAddon is an autogenerated conversion function.
+func Convert_v1beta1_ManagedAddon_To_core_ManagedAddon(in *ManagedAddon, out *core.ManagedAddon, s conversion.Scope) error {
+	return autoConvert_v1beta1_ManagedAddon_To_core_ManagedAddon(in, out, s)
+}
+
+func autoConvert_core_ManagedAddon_To_v1beta1_ManagedAddon(in *core.ManagedAddon, out *ManagedAddon, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Version = in.Version
+	out.Values = (*runtime.RawExtension)(unsafe.Pointer(in.Values))
+	return nil
+}
+
+// Convert_core_ManagedAddon_To_v1beta1_ManagedAddon is an autogenerated conversion function.
+func Convert_core_ManagedAddon_To_v1beta1_ManagedAddon(in *core.ManagedAddon, out *ManagedAddon, s conversion.Scope) error {
+	return autoConvert_core_ManagedAddon_To_v1beta1_ManagedAddon(in, out, s)
+}
+
 func autoConvert_v1beta1_MemorySwapConfiguration_To_core_MemorySwapConfiguration(in *MemorySwapConfiguration, out *core.MemorySwapConfiguration, s conversion.Scope) error {
 	out.SwapBehavior = (*core.SwapBehavior)(unsafe.Pointer(in.SwapBehavior))
 	return nil
@@ -4701,6 +6085,96 @@ func Convert_core_NamedResourceReference_To_v1beta1_NamedResourceReference(in *c
 	return autoConvert_core_NamedResourceReference_To_v1beta1_NamedResourceReference(in, out, s)
 }
 
+func autoConvert_v1beta1_NamespaceDefaults_To_core_NamespaceDefaults(in *NamespaceDefaults, out *core.NamespaceDefaults, s conversion.Scope) error {
+	out.NamespaceSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.NamespaceSelector))
+	out.LimitRanges = *(*[]core.NamespaceDefaultsLimitRange)(unsafe.Pointer(&in.LimitRanges))
+	out.ResourceQuotas = *(*[]core.NamespaceDefaultsResourceQuota)(unsafe.Pointer(&in.ResourceQuotas))
+	out.NetworkPolicies = (*core.NamespaceDefaultsNetworkPolicies)(unsafe.Pointer(in.NetworkPolicies))
+	return nil
+}
+
+// Convert_v1beta1_NamespaceDefaults_To_core_NamespaceDefaults is an autogenerated conversion function.
+func Convert_v1beta1_NamespaceDefaults_To_core_NamespaceDefaults(in *NamespaceDefaults, out *core.NamespaceDefaults, s conversion.Scope) error {
+	return autoConvert_v1beta1_NamespaceDefaults_To_core_NamespaceDefaults(in, out, s)
+}
+
+func autoConvert_core_NamespaceDefaults_To_v1beta1_NamespaceDefaults(in *core.NamespaceDefaults, out *NamespaceDefaults, s conversion.Scope) error {
+	out.NamespaceSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.NamespaceSelector))
+	out.LimitRanges = *(*[]NamespaceDefaultsLimitRange)(unsafe.Pointer(&in.LimitRanges))
+	out.ResourceQuotas = *(*[]NamespaceDefaultsResourceQuota)(unsafe.Pointer(&in.ResourceQuotas))
+	out.NetworkPolicies = (*NamespaceDefaultsNetworkPolicies)(unsafe.Pointer(in.NetworkPolicies))
+	return nil
+}
+
+// Convert_core_NamespaceDefaults_To_v1beta1_NamespaceDefaults is an autogenerated conversion function.
+func Convert_core_NamespaceDefaults_To_v1beta1_NamespaceDefaults(in *core.NamespaceDefaults, out *NamespaceDefaults, s conversion.Scope) error {
+	return autoConvert_core_NamespaceDefaults_To_v1beta1_NamespaceDefaults(in, out, s)
+}
+
+func autoConvert_v1beta1_NamespaceDefaultsLimitRange_To_core_NamespaceDefaultsLimitRange(in *NamespaceDefaultsLimitRange, out *core.NamespaceDefaultsLimitRange, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Spec = in.Spec
+	return nil
+}
+
+// Convert_v1beta1_NamespaceDefaultsLimitRange_To_core_NamespaceDefaultsLimitRange is an autogenerated conversion function.
+func Convert_v1beta1_NamespaceDefaultsLimitRange_To_core_NamespaceDefaultsLimitRange(in *NamespaceDefaultsLimitRange, out *core.NamespaceDefaultsLimitRange, s conversion.Scope) error {
+	return autoConvert_v1beta1_NamespaceDefaultsLimitRange_To_core_NamespaceDefaultsLimitRange(in, out, s)
+}
+
+func autoConvert_core_NamespaceDefaultsLimitRange_To_v1beta1_NamespaceDefaultsLimitRange(in *core.NamespaceDefaultsLimitRange, out *NamespaceDefaultsLimitRange, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Spec = in.Spec
+	return nil
+}
+
+// Convert_core_NamespaceDefaultsLimitRange_To_v1beta1_NamespaceDefaultsLimitRange is an autogenerated conversion function.
+func Convert_core_NamespaceDefaultsLimitRange_To_v1beta1_NamespaceDefaultsLimitRange(in *core.NamespaceDefaultsLimitRange, out *NamespaceDefaultsLimitRange, s conversion.Scope) error {
+	return autoConvert_core_NamespaceDefaultsLimitRange_To_v1beta1_NamespaceDefaultsLimitRange(in, out, s)
+}
+
+func autoConvert_v1beta1_NamespaceDefaultsNetworkPolicies_To_core_NamespaceDefaultsNetworkPolicies(in *NamespaceDefaultsNetworkPolicies, out *core.NamespaceDefaultsNetworkPolicies, s conversion.Scope) error {
+	out.DenyAll = in.DenyAll
+	return nil
+}
+
+// Convert_v1beta1_NamespaceDefaultsNetworkPolicies_To_core_NamespaceDefaultsNetworkPolicies is an autogenerated conversion function.
+func Convert_v1beta1_NamespaceDefaultsNetworkPolicies_To_core_NamespaceDefaultsNetworkPolicies(in *NamespaceDefaultsNetworkPolicies, out *core.NamespaceDefaultsNetworkPolicies, s conversion.Scope) error {
+	return autoConvert_v1beta1_NamespaceDefaultsNetworkPolicies_To_core_NamespaceDefaultsNetworkPolicies(in, out, s)
+}
+
+func autoConvert_core_NamespaceDefaultsNetworkPolicies_To_v1beta1_NamespaceDefaultsNetworkPolicies(in *core.NamespaceDefaultsNetworkPolicies, out *NamespaceDefaultsNetworkPolicies, s conversion.Scope) error {
+	out.DenyAll = in.DenyAll
+	return nil
+}
+
+// Convert_core_NamespaceDefaultsNetworkPolicies_To_v1beta1_NamespaceDefaultsNetworkPolicies is an autogenerated conversion function.
+func Convert_core_NamespaceDefaultsNetworkPolicies_To_v1beta1_NamespaceDefaultsNetworkPolicies(in *core.NamespaceDefaultsNetworkPolicies, out *NamespaceDefaultsNetworkPolicies, s conversion.Scope) error {
+	return autoConvert_core_NamespaceDefaultsNetworkPolicies_To_v1beta1_NamespaceDefaultsNetworkPolicies(in, out, s)
+}
+
+func autoConvert_v1beta1_NamespaceDefaultsResourceQuota_To_core_NamespaceDefaultsResourceQuota(in *NamespaceDefaultsResourceQuota, out *core.NamespaceDefaultsResourceQuota, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Spec = in.Spec
+	return nil
+}
+
+// Convert_v1beta1_NamespaceDefaultsResourceQuota_To_core_NamespaceDefaultsResourceQuota is an autogenerated conversion function.
+func Convert_v1beta1_NamespaceDefaultsResourceQuota_To_core_NamespaceDefaultsResourceQuota(in *NamespaceDefaultsResourceQuota, out *core.NamespaceDefaultsResourceQuota, s conversion.Scope) error {
+	return autoConvert_v1beta1_NamespaceDefaultsResourceQuota_To_core_NamespaceDefaultsResourceQuota(in, out, s)
+}
+
+func autoConvert_core_NamespaceDefaultsResourceQuota_To_v1beta1_NamespaceDefaultsResourceQuota(in *core.NamespaceDefaultsResourceQuota, out *NamespaceDefaultsResourceQuota, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Spec = in.Spec
+	return nil
+}
+
+// Convert_core_NamespaceDefaultsResourceQuota_To_v1beta1_NamespaceDefaultsResourceQuota is an autogenerated conversion function.
+func Convert_core_NamespaceDefaultsResourceQuota_To_v1beta1_NamespaceDefaultsResourceQuota(in *core.NamespaceDefaultsResourceQuota, out *NamespaceDefaultsResourceQuota, s conversion.Scope) error {
+	return autoConvert_core_NamespaceDefaultsResourceQuota_To_v1beta1_NamespaceDefaultsResourceQuota(in, out, s)
+}
+
 func autoConvert_v1beta1_NamespacedCloudProfile_To_core_NamespacedCloudProfile(in *NamespacedCloudProfile, out *core.NamespacedCloudProfile, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1beta1_NamespacedCloudProfileSpec_To_core_NamespacedCloudProfileSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -4852,6 +6326,7 @@ func autoConvert_v1beta1_NetworkingStatus_To_core_NetworkingStatus(in *Networkin
 	out.Nodes = *(*[]string)(unsafe.Pointer(&in.Nodes))
 	out.Services = *(*[]string)(unsafe.Pointer(&in.Services))
 	out.EgressCIDRs = *(*[]string)(unsafe.Pointer(&in.EgressCIDRs))
+	out.ControlPlaneEgressCIDRs = *(*[]string)(unsafe.Pointer(&in.ControlPlaneEgressCIDRs))
 	return nil
 }
 
@@ -4865,6 +6340,7 @@ func autoConvert_core_NetworkingStatus_To_v1beta1_NetworkingStatus(in *core.Netw
 	out.Nodes = *(*[]string)(unsafe.Pointer(&in.Nodes))
 	out.Services = *(*[]string)(unsafe.Pointer(&in.Services))
 	out.EgressCIDRs = *(*[]string)(unsafe.Pointer(&in.EgressCIDRs))
+	out.ControlPlaneEgressCIDRs = *(*[]string)(unsafe.Pointer(&in.ControlPlaneEgressCIDRs))
 	return nil
 }
 
@@ -4908,6 +6384,9 @@ func autoConvert_v1beta1_NodeLocalDNS_To_core_NodeLocalDNS(in *NodeLocalDNS, out
 	out.ForceTCPToClusterDNS = (*bool)(unsafe.Pointer(in.ForceTCPToClusterDNS))
 	out.ForceTCPToUpstreamDNS = (*bool)(unsafe.Pointer(in.ForceTCPToUpstreamDNS))
 	out.DisableForwardToUpstreamDNS = (*bool)(unsafe.Pointer(in.DisableForwardToUpstreamDNS))
+	out.Cache = (*core.NodeLocalDNSCache)(unsafe.Pointer(in.Cache))
+	out.ZoneProtocols = *(*[]core.NodeLocalDNSZoneProtocol)(unsafe.Pointer(&in.ZoneProtocols))
+	out.DisabledWorkerPools = *(*[]string)(unsafe.Pointer(&in.DisabledWorkerPools))
 	return nil
 }
 
@@ -4921,6 +6400,9 @@ func autoConvert_core_NodeLocalDNS_To_v1beta1_NodeLocalDNS(in *core.NodeLocalDNS
 	out.ForceTCPToClusterDNS = (*bool)(unsafe.Pointer(in.ForceTCPToClusterDNS))
 	out.ForceTCPToUpstreamDNS = (*bool)(unsafe.Pointer(in.ForceTCPToUpstreamDNS))
 	out.DisableForwardToUpstreamDNS = (*bool)(unsafe.Pointer(in.DisableForwardToUpstreamDNS))
+	out.Cache = (*NodeLocalDNSCache)(unsafe.Pointer(in.Cache))
+	out.ZoneProtocols = *(*[]NodeLocalDNSZoneProtocol)(unsafe.Pointer(&in.ZoneProtocols))
+	out.DisabledWorkerPools = *(*[]string)(unsafe.Pointer(&in.DisabledWorkerPools))
 	return nil
 }
 
@@ -4929,6 +6411,52 @@ func Convert_core_NodeLocalDNS_To_v1beta1_NodeLocalDNS(in *core.NodeLocalDNS, ou
 	return autoConvert_core_NodeLocalDNS_To_v1beta1_NodeLocalDNS(in, out, s)
 }
 
+func autoConvert_v1beta1_NodeLocalDNSCache_To_core_NodeLocalDNSCache(in *NodeLocalDNSCache, out *core.NodeLocalDNSCache, s conversion.Scope) error {
+	out.SuccessTTLSeconds = (*int32)(unsafe.Pointer(in.SuccessTTLSeconds))
+	out.DenialTTLSeconds = (*int32)(unsafe.Pointer(in.DenialTTLSeconds))
+	out.DisableNegativeCaching = (*bool)(unsafe.Pointer(in.DisableNegativeCaching))
+	return nil
+}
+
+// Convert_v1beta1_NodeLocalDNSCache_To_core_NodeLocalDNSCache is an autogenerated conversion function.
+func Convert_v1beta1_NodeLocalDNSCache_To_core_NodeLocalDNSCache(in *NodeLocalDNSCache, out *core.NodeLocalDNSCache, s conversion.Scope) error {
+	return autoConvert_v1beta1_NodeLocalDNSCache_To_core_NodeLocalDNSCache(in, out, s)
+}
+
+func autoConvert_core_NodeLocalDNSCache_To_v1beta1_NodeLocalDNSCache(in *core.NodeLocalDNSCache, out *NodeLocalDNSCache, s conversion.Scope) error {
+	out.SuccessTTLSeconds = (*int32)(unsafe.Pointer(in.SuccessTTLSeconds))
+	out.DenialTTLSeconds = (*int32)(unsafe.Pointer(in.DenialTTLSeconds))
+	out.DisableNegativeCaching = (*bool)(unsafe.Pointer(in.DisableNegativeCaching))
+	return nil
+}
+
+// Convert_core_NodeLocalDNSCache_To_v1beta1_NodeLocalDNSCache is an autogenerated conversion function.
+func Convert_core_NodeLocalDNSCache_To_v1beta1_NodeLocalDNSCache(in *core.NodeLocalDNSCache, out *NodeLocalDNSCache, s conversion.Scope) error {
+	return autoConvert_core_NodeLocalDNSCache_To_v1beta1_NodeLocalDNSCache(in, out, s)
+}
+
+func autoConvert_v1beta1_NodeLocalDNSZoneProtocol_To_core_NodeLocalDNSZoneProtocol(in *NodeLocalDNSZoneProtocol, out *core.NodeLocalDNSZoneProtocol, s conversion.Scope) error {
+	out.Zone = in.Zone
+	out.Protocol = core.NodeLocalDNSProtocol(in.Protocol)
+	return nil
+}
+
+// Convert_v1beta1_NodeLocalDNSZoneProtocol_To_core_NodeLocalDNSZoneProtocol is an autogenerated conversion function.
+func Convert_v1beta1_NodeLocalDNSZoneProtocol_To_core_NodeLocalDNSZoneProtocol(in *NodeLocalDNSZoneProtocol, out *core.NodeLocalDNSZoneProtocol, s conversion.Scope) error {
+	return autoConvert_v1beta1_NodeLocalDNSZoneProtocol_To_core_NodeLocalDNSZoneProtocol(in, out, s)
+}
+
+func autoConvert_core_NodeLocalDNSZoneProtocol_To_v1beta1_NodeLocalDNSZoneProtocol(in *core.NodeLocalDNSZoneProtocol, out *NodeLocalDNSZoneProtocol, s conversion.Scope) error {
+	out.Zone = in.Zone
+	out.Protocol = NodeLocalDNSProtocol(in.Protocol)
+	return nil
+}
+
+// Convert_core_NodeLocalDNSZoneProtocol_To_v1beta1_NodeLocalDNSZoneProtocol is an autogenerated conversion function.
+func Convert_core_NodeLocalDNSZoneProtocol_To_v1beta1_NodeLocalDNSZoneProtocol(in *core.NodeLocalDNSZoneProtocol, out *NodeLocalDNSZoneProtocol, s conversion.Scope) error {
+	return autoConvert_core_NodeLocalDNSZoneProtocol_To_v1beta1_NodeLocalDNSZoneProtocol(in, out, s)
+}
+
 func autoConvert_v1beta1_OCIRepository_To_core_OCIRepository(in *OCIRepository, out *core.OCIRepository, s conversion.Scope) error {
 	out.Ref = (*string)(unsafe.Pointer(in.Ref))
 	out.Repository = (*string)(unsafe.Pointer(in.Repository))
@@ -5037,6 +6565,38 @@ func Convert_core_OpenIDConnectClientAuthentication_To_v1beta1_OpenIDConnectClie
 	return autoConvert_core_OpenIDConnectClientAuthentication_To_v1beta1_OpenIDConnectClientAuthentication(in, out, s)
 }
 
+func autoConvert_v1beta1_PendingMaintenanceOperation_To_core_PendingMaintenanceOperation(in *PendingMaintenanceOperation, out *core.PendingMaintenanceOperation, s conversion.Scope) error {
+	out.Type = core.PendingMaintenanceOperationType(in.Type)
+	out.WorkerPool = (*string)(unsafe.Pointer(in.WorkerPool))
+	out.CurrentVersion = (*string)(unsafe.Pointer(in.CurrentVersion))
+	out.TargetVersion = (*string)(unsafe.Pointer(in.TargetVersion))
+	out.Forced = in.Forced
+	out.Description = in.Description
+	out.ScheduledTime = in.ScheduledTime
+	return nil
+}
+
+// Convert_v1beta1_PendingMaintenanceOperation_To_core_PendingMaintenanceOperation is an autogenerated conversion function.
+func Convert_v1beta1_PendingMaintenanceOperation_To_core_PendingMaintenanceOperation(in *PendingMaintenanceOperation, out *core.PendingMaintenanceOperation, s conversion.Scope) error {
+	return autoConvert_v1beta1_PendingMaintenanceOperation_To_core_PendingMaintenanceOperation(in, out, s)
+}
+
+func autoConvert_core_PendingMaintenanceOperation_To_v1beta1_PendingMaintenanceOperation(in *core.PendingMaintenanceOperation, out *PendingMaintenanceOperation, s conversion.Scope) error {
+	out.Type = PendingMaintenanceOperationType(in.Type)
+	out.WorkerPool = (*string)(unsafe.Pointer(in.WorkerPool))
+	out.CurrentVersion = (*string)(unsafe.Pointer(in.CurrentVersion))
+	out.TargetVersion = (*string)(unsafe.Pointer(in.TargetVersion))
+	out.Forced = in.Forced
+	out.Description = in.Description
+	out.ScheduledTime = in.ScheduledTime
+	return nil
+}
+
+// Convert_core_PendingMaintenanceOperation_To_v1beta1_PendingMaintenanceOperation is an autogenerated conversion function.
+func Convert_core_PendingMaintenanceOperation_To_v1beta1_PendingMaintenanceOperation(in *core.PendingMaintenanceOperation, out *PendingMaintenanceOperation, s conversion.Scope) error {
+	return autoConvert_core_PendingMaintenanceOperation_To_v1beta1_PendingMaintenanceOperation(in, out, s)
+}
+
 func autoConvert_v1beta1_Project_To_core_Project(in *Project, out *core.Project, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1beta1_ProjectSpec_To_core_ProjectSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -5124,6 +6684,50 @@ func autoConvert_core_ProjectMember_To_v1beta1_ProjectMember(in *core.ProjectMem
 	return nil
 }
 
+func autoConvert_v1beta1_ProjectMemberSync_To_core_ProjectMemberSync(in *ProjectMemberSync, out *core.ProjectMemberSync, s conversion.Scope) error {
+	out.GroupPrefix = (*string)(unsafe.Pointer(in.GroupPrefix))
+	out.Groups = *(*[]core.ProjectMemberSyncGroup)(unsafe.Pointer(&in.Groups))
+	return nil
+}
+
+// Convert_v1beta1_ProjectMemberSync_To_core_ProjectMemberSync is an autogenerated conversion function.
+func Convert_v1beta1_ProjectMemberSync_To_core_ProjectMemberSync(in *ProjectMemberSync, out *core.ProjectMemberSync, s conversion.Scope) error {
+	return autoConvert_v1beta1_ProjectMemberSync_To_core_ProjectMemberSync(in, out, s)
+}
+
+func autoConvert_core_ProjectMemberSync_To_v1beta1_ProjectMemberSync(in *core.ProjectMemberSync, out *ProjectMemberSync, s conversion.Scope) error {
+	out.GroupPrefix = (*string)(unsafe.Pointer(in.GroupPrefix))
+	out.Groups = *(*[]ProjectMemberSyncGroup)(unsafe.Pointer(&in.Groups))
+	return nil
+}
+
+// Convert_core_ProjectMemberSync_To_v1beta1_ProjectMemberSync is an autogenerated conversion function.
+func Convert_core_ProjectMemberSync_To_v1beta1_ProjectMemberSync(in *core.ProjectMemberSync, out *ProjectMemberSync, s conversion.Scope) error {
+	return autoConvert_core_ProjectMemberSync_To_v1beta1_ProjectMemberSync(in, out, s)
+}
+
+func autoConvert_v1beta1_ProjectMemberSyncGroup_To_core_ProjectMemberSyncGroup(in *ProjectMemberSyncGroup, out *core.ProjectMemberSyncGroup, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Roles = *(*[]string)(unsafe.Pointer(&in.Roles))
+	return nil
+}
+
+// Convert_v1beta1_ProjectMemberSyncGroup_To_core_ProjectMemberSyncGroup is an autogenerated conversion function.
+func Convert_v1beta1_ProjectMemberSyncGroup_To_core_ProjectMemberSyncGroup(in *ProjectMemberSyncGroup, out *core.ProjectMemberSyncGroup, s conversion.Scope) error {
+	return autoConvert_v1beta1_ProjectMemberSyncGroup_To_core_ProjectMemberSyncGroup(in, out, s)
+}
+
+func autoConvert_core_ProjectMemberSyncGroup_To_v1beta1_ProjectMemberSyncGroup(in *core.ProjectMemberSyncGroup, out *ProjectMemberSyncGroup, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Roles = *(*[]string)(unsafe.Pointer(&in.Roles))
+	return nil
+}
+
+// Convert_core_ProjectMemberSyncGroup_To_v1beta1_ProjectMemberSyncGroup is an autogenerated conversion function.
+func Convert_core_ProjectMemberSyncGroup_To_v1beta1_ProjectMemberSyncGroup(in *core.ProjectMemberSyncGroup, out *ProjectMemberSyncGroup, s conversion.Scope) error {
+	return autoConvert_core_ProjectMemberSyncGroup_To_v1beta1_ProjectMemberSyncGroup(in, out, s)
+}
+
 func autoConvert_v1beta1_ProjectSpec_To_core_ProjectSpec(in *ProjectSpec, out *core.ProjectSpec, s conversion.Scope) error {
 	out.CreatedBy = (*rbacv1.Subject)(unsafe.Pointer(in.CreatedBy))
 	out.Description = (*string)(unsafe.Pointer(in.Description))
@@ -5143,6 +6747,9 @@ func autoConvert_v1beta1_ProjectSpec_To_core_ProjectSpec(in *ProjectSpec, out *c
 	out.Namespace = (*string)(unsafe.Pointer(in.Namespace))
 	out.Tolerations = (*core.ProjectTolerations)(unsafe.Pointer(in.Tolerations))
 	out.DualApprovalForDeletion = *(*[]core.DualApprovalForDeletion)(unsafe.Pointer(&in.DualApprovalForDeletion))
+	out.TrustedIdentityProviders = *(*[]core.TrustedIdentityProvider)(unsafe.Pointer(&in.TrustedIdentityProviders))
+	out.Quotas = *(*[]v1.ObjectReference)(unsafe.Pointer(&in.Quotas))
+	out.MemberSync = (*core.ProjectMemberSync)(unsafe.Pointer(in.MemberSync))
 	return nil
 }
 
@@ -5165,6 +6772,9 @@ func autoConvert_core_ProjectSpec_To_v1beta1_ProjectSpec(in *core.ProjectSpec, o
 	out.Namespace = (*string)(unsafe.Pointer(in.Namespace))
 	out.Tolerations = (*ProjectTolerations)(unsafe.Pointer(in.Tolerations))
 	out.DualApprovalForDeletion = *(*[]DualApprovalForDeletion)(unsafe.Pointer(&in.DualApprovalForDeletion))
+	out.TrustedIdentityProviders = *(*[]TrustedIdentityProvider)(unsafe.Pointer(&in.TrustedIdentityProviders))
+	out.Quotas = *(*[]v1.ObjectReference)(unsafe.Pointer(&in.Quotas))
+	out.MemberSync = (*ProjectMemberSync)(unsafe.Pointer(in.MemberSync))
 	return nil
 }
 
@@ -5343,6 +6953,7 @@ func autoConvert_v1beta1_Region_To_core_Region(in *Region, out *core.Region, s c
 	out.Zones = *(*[]core.AvailabilityZone)(unsafe.Pointer(&in.Zones))
 	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
 	out.AccessRestrictions = *(*[]core.AccessRestriction)(unsafe.Pointer(&in.AccessRestrictions))
+	out.Metadata = (*core.RegionMetadata)(unsafe.Pointer(in.Metadata))
 	return nil
 }
 
@@ -5356,12 +6967,37 @@ func autoConvert_core_Region_To_v1beta1_Region(in *core.Region, out *Region, s c
 	out.Zones = *(*[]AvailabilityZone)(unsafe.Pointer(&in.Zones))
 	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
 	out.AccessRestrictions = *(*[]AccessRestriction)(unsafe.Pointer(&in.AccessRestrictions))
+	out.Metadata = (*RegionMetadata)(unsafe.Pointer(in.Metadata))
+	return nil
+}
+
+// Convert_core_Region_To_v1beta1_Region is an autogenerated conversion function.
+func Convert_core_Region_To_v1beta1_Region(in *core.Region, out *Region, s conversion.Scope) error {
+	return autoConvert_core_Region_To_v1beta1_Region(in, out, s)
+}
+
+func autoConvert_v1beta1_RegionMetadata_To_core_RegionMetadata(in *RegionMetadata, out *core.RegionMetadata, s conversion.Scope) error {
+	out.Geography = (*string)(unsafe.Pointer(in.Geography))
+	out.Jurisdiction = (*string)(unsafe.Pointer(in.Jurisdiction))
+	out.LatencyMatrixKey = (*string)(unsafe.Pointer(in.LatencyMatrixKey))
+	return nil
+}
+
+// Convert_v1beta1_RegionMetadata_To_core_RegionMetadata is an autogenerated conversion function.
+func Convert_v1beta1_RegionMetadata_To_core_RegionMetadata(in *RegionMetadata, out *core.RegionMetadata, s conversion.Scope) error {
+	return autoConvert_v1beta1_RegionMetadata_To_core_RegionMetadata(in, out, s)
+}
+
+func autoConvert_core_RegionMetadata_To_v1beta1_RegionMetadata(in *core.RegionMetadata, out *RegionMetadata, s conversion.Scope) error {
+	out.Geography = (*string)(unsafe.Pointer(in.Geography))
+	out.Jurisdiction = (*string)(unsafe.Pointer(in.Jurisdiction))
+	out.LatencyMatrixKey = (*string)(unsafe.Pointer(in.LatencyMatrixKey))
 	return nil
 }
 
-// Convert_core_Region_To_v1beta1_Region is an autogenerated conversion function.
-func Convert_core_Region_To_v1beta1_Region(in *core.Region, out *Region, s conversion.Scope) error {
-	return autoConvert_core_Region_To_v1beta1_Region(in, out, s)
+// Convert_core_RegionMetadata_To_v1beta1_RegionMetadata is an autogenerated conversion function.
+func Convert_core_RegionMetadata_To_v1beta1_RegionMetadata(in *core.RegionMetadata, out *RegionMetadata, s conversion.Scope) error {
+	return autoConvert_core_RegionMetadata_To_v1beta1_RegionMetadata(in, out, s)
 }
 
 func autoConvert_v1beta1_ResourceData_To_core_ResourceData(in *ResourceData, out *core.ResourceData, s conversion.Scope) error {
@@ -5535,6 +7171,8 @@ func autoConvert_v1beta1_SeedBackup_To_core_SeedBackup(in *SeedBackup, out *core
 	out.ProviderConfig = (*runtime.RawExtension)(unsafe.Pointer(in.ProviderConfig))
 	out.Region = (*string)(unsafe.Pointer(in.Region))
 	out.SecretRef = in.SecretRef
+	out.Immutability = (*core.BackupBucketImmutability)(unsafe.Pointer(in.Immutability))
+	out.ETCD = (*core.SeedBackupETCD)(unsafe.Pointer(in.ETCD))
 	return nil
 }
 
@@ -5548,6 +7186,8 @@ func autoConvert_core_SeedBackup_To_v1beta1_SeedBackup(in *core.SeedBackup, out
 	out.ProviderConfig = (*runtime.RawExtension)(unsafe.Pointer(in.ProviderConfig))
 	out.Region = (*string)(unsafe.Pointer(in.Region))
 	out.SecretRef = in.SecretRef
+	out.Immutability = (*BackupBucketImmutability)(unsafe.Pointer(in.Immutability))
+	out.ETCD = (*SeedBackupETCD)(unsafe.Pointer(in.ETCD))
 	return nil
 }
 
@@ -5556,6 +7196,68 @@ func Convert_core_SeedBackup_To_v1beta1_SeedBackup(in *core.SeedBackup, out *See
 	return autoConvert_core_SeedBackup_To_v1beta1_SeedBackup(in, out, s)
 }
 
+func autoConvert_v1beta1_SeedBackupETCD_To_core_SeedBackupETCD(in *SeedBackupETCD, out *core.SeedBackupETCD, s conversion.Scope) error {
+	out.MinFullSnapshotInterval = (*metav1.Duration)(unsafe.Pointer(in.MinFullSnapshotInterval))
+	out.MaxDeltaSnapshotRetentionPeriod = (*metav1.Duration)(unsafe.Pointer(in.MaxDeltaSnapshotRetentionPeriod))
+	return nil
+}
+
+// Convert_v1beta1_SeedBackupETCD_To_core_SeedBackupETCD is an autogenerated conversion function.
+func Convert_v1beta1_SeedBackupETCD_To_core_SeedBackupETCD(in *SeedBackupETCD, out *core.SeedBackupETCD, s conversion.Scope) error {
+	return autoConvert_v1beta1_SeedBackupETCD_To_core_SeedBackupETCD(in, out, s)
+}
+
+func autoConvert_core_SeedBackupETCD_To_v1beta1_SeedBackupETCD(in *core.SeedBackupETCD, out *SeedBackupETCD, s conversion.Scope) error {
+	out.MinFullSnapshotInterval = (*metav1.Duration)(unsafe.Pointer(in.MinFullSnapshotInterval))
+	out.MaxDeltaSnapshotRetentionPeriod = (*metav1.Duration)(unsafe.Pointer(in.MaxDeltaSnapshotRetentionPeriod))
+	return nil
+}
+
+// Convert_core_SeedBackupETCD_To_v1beta1_SeedBackupETCD is an autogenerated conversion function.
+func Convert_core_SeedBackupETCD_To_v1beta1_SeedBackupETCD(in *core.SeedBackupETCD, out *SeedBackupETCD, s conversion.Scope) error {
+	return autoConvert_core_SeedBackupETCD_To_v1beta1_SeedBackupETCD(in, out, s)
+}
+
+func autoConvert_v1beta1_SeedCredentials_To_core_SeedCredentials(in *SeedCredentials, out *core.SeedCredentials, s conversion.Scope) error {
+	out.Rotation = (*core.SeedCredentialsRotation)(unsafe.Pointer(in.Rotation))
+	return nil
+}
+
+// Convert_v1beta1_SeedCredentials_To_core_SeedCredentials is an autogenerated conversion function.
+func Convert_v1beta1_SeedCredentials_To_core_SeedCredentials(in *SeedCredentials, out *core.SeedCredentials, s conversion.Scope) error {
+	return autoConvert_v1beta1_SeedCredentials_To_core_SeedCredentials(in, out, s)
+}
+
+func autoConvert_core_SeedCredentials_To_v1beta1_SeedCredentials(in *core.SeedCredentials, out *SeedCredentials, s conversion.Scope) error {
+	out.Rotation = (*SeedCredentialsRotation)(unsafe.Pointer(in.Rotation))
+	return nil
+}
+
+// Convert_core_SeedCredentials_To_v1beta1_SeedCredentials is an autogenerated conversion function.
+func Convert_core_SeedCredentials_To_v1beta1_SeedCredentials(in *core.SeedCredentials, out *SeedCredentials, s conversion.Scope) error {
+	return autoConvert_core_SeedCredentials_To_v1beta1_SeedCredentials(in, out, s)
+}
+
+func autoConvert_v1beta1_SeedCredentialsRotation_To_core_SeedCredentialsRotation(in *SeedCredentialsRotation, out *core.SeedCredentialsRotation, s conversion.Scope) error {
+	out.CertificateAuthorities = (*core.CARotation)(unsafe.Pointer(in.CertificateAuthorities))
+	return nil
+}
+
+// Convert_v1beta1_SeedCredentialsRotation_To_core_SeedCredentialsRotation is an autogenerated conversion function.
+func Convert_v1beta1_SeedCredentialsRotation_To_core_SeedCredentialsRotation(in *SeedCredentialsRotation, out *core.SeedCredentialsRotation, s conversion.Scope) error {
+	return autoConvert_v1beta1_SeedCredentialsRotation_To_core_SeedCredentialsRotation(in, out, s)
+}
+
+func autoConvert_core_SeedCredentialsRotation_To_v1beta1_SeedCredentialsRotation(in *core.SeedCredentialsRotation, out *SeedCredentialsRotation, s conversion.Scope) error {
+	out.CertificateAuthorities = (*CARotation)(unsafe.Pointer(in.CertificateAuthorities))
+	return nil
+}
+
+// Convert_core_SeedCredentialsRotation_To_v1beta1_SeedCredentialsRotation is an autogenerated conversion function.
+func Convert_core_SeedCredentialsRotation_To_v1beta1_SeedCredentialsRotation(in *core.SeedCredentialsRotation, out *SeedCredentialsRotation, s conversion.Scope) error {
+	return autoConvert_core_SeedCredentialsRotation_To_v1beta1_SeedCredentialsRotation(in, out, s)
+}
+
 func autoConvert_v1beta1_SeedDNS_To_core_SeedDNS(in *SeedDNS, out *core.SeedDNS, s conversion.Scope) error {
 	out.Provider = (*core.SeedDNSProvider)(unsafe.Pointer(in.Provider))
 	return nil
@@ -5598,6 +7300,36 @@ func Convert_core_SeedDNSProvider_To_v1beta1_SeedDNSProvider(in *core.SeedDNSPro
 	return autoConvert_core_SeedDNSProvider_To_v1beta1_SeedDNSProvider(in, out, s)
 }
 
+func autoConvert_v1beta1_SeedIngressDomainMigration_To_core_SeedIngressDomainMigration(in *SeedIngressDomainMigration, out *core.SeedIngressDomainMigration, s conversion.Scope) error {
+	out.PreviousDomain = in.PreviousDomain
+	out.Domain = in.Domain
+	out.StartTime = in.StartTime
+	out.CompletionTime = (*metav1.Time)(unsafe.Pointer(in.CompletionTime))
+	out.Shoots = in.Shoots
+	out.MigratedShoots = in.MigratedShoots
+	return nil
+}
+
+// Convert_v1beta1_SeedIngressDomainMigration_To_core_SeedIngressDomainMigration is an autogenerated conversion function.
+func Convert_v1beta1_SeedIngressDomainMigration_To_core_SeedIngressDomainMigration(in *SeedIngressDomainMigration, out *core.SeedIngressDomainMigration, s conversion.Scope) error {
+	return autoConvert_v1beta1_SeedIngressDomainMigration_To_core_SeedIngressDomainMigration(in, out, s)
+}
+
+func autoConvert_core_SeedIngressDomainMigration_To_v1beta1_SeedIngressDomainMigration(in *core.SeedIngressDomainMigration, out *SeedIngressDomainMigration, s conversion.Scope) error {
+	out.PreviousDomain = in.PreviousDomain
+	out.Domain = in.Domain
+	out.StartTime = in.StartTime
+	out.CompletionTime = (*metav1.Time)(unsafe.Pointer(in.CompletionTime))
+	out.Shoots = in.Shoots
+	out.MigratedShoots = in.MigratedShoots
+	return nil
+}
+
+// Convert_core_SeedIngressDomainMigration_To_v1beta1_SeedIngressDomainMigration is an autogenerated conversion function.
+func Convert_core_SeedIngressDomainMigration_To_v1beta1_SeedIngressDomainMigration(in *core.SeedIngressDomainMigration, out *SeedIngressDomainMigration, s conversion.Scope) error {
+	return autoConvert_core_SeedIngressDomainMigration_To_v1beta1_SeedIngressDomainMigration(in, out, s)
+}
+
 func autoConvert_v1beta1_SeedList_To_core_SeedList(in *SeedList, out *core.SeedList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	if in.Items != nil {
@@ -5640,6 +7372,30 @@ func Convert_core_SeedList_To_v1beta1_SeedList(in *core.SeedList, out *SeedList,
 	return autoConvert_core_SeedList_To_v1beta1_SeedList(in, out, s)
 }
 
+func autoConvert_v1beta1_SeedMaintenance_To_core_SeedMaintenance(in *SeedMaintenance, out *core.SeedMaintenance, s conversion.Scope) error {
+	if err := Convert_v1beta1_MaintenanceTimeWindow_To_core_MaintenanceTimeWindow(&in.TimeWindow, &out.TimeWindow, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_SeedMaintenance_To_core_SeedMaintenance is an autogenerated conversion function.
+func Convert_v1beta1_SeedMaintenance_To_core_SeedMaintenance(in *SeedMaintenance, out *core.SeedMaintenance, s conversion.Scope) error {
+	return autoConvert_v1beta1_SeedMaintenance_To_core_SeedMaintenance(in, out, s)
+}
+
+func autoConvert_core_SeedMaintenance_To_v1beta1_SeedMaintenance(in *core.SeedMaintenance, out *SeedMaintenance, s conversion.Scope) error {
+	if err := Convert_core_MaintenanceTimeWindow_To_v1beta1_MaintenanceTimeWindow(&in.TimeWindow, &out.TimeWindow, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_core_SeedMaintenance_To_v1beta1_SeedMaintenance is an autogenerated conversion function.
+func Convert_core_SeedMaintenance_To_v1beta1_SeedMaintenance(in *core.SeedMaintenance, out *SeedMaintenance, s conversion.Scope) error {
+	return autoConvert_core_SeedMaintenance_To_v1beta1_SeedMaintenance(in, out, s)
+}
+
 func autoConvert_v1beta1_SeedNetworks_To_core_SeedNetworks(in *SeedNetworks, out *core.SeedNetworks, s conversion.Scope) error {
 	out.Nodes = (*string)(unsafe.Pointer(in.Nodes))
 	out.Pods = in.Pods
@@ -5670,11 +7426,40 @@ func Convert_core_SeedNetworks_To_v1beta1_SeedNetworks(in *core.SeedNetworks, ou
 	return autoConvert_core_SeedNetworks_To_v1beta1_SeedNetworks(in, out, s)
 }
 
+func autoConvert_v1beta1_SeedPendingMaintenanceOperation_To_core_SeedPendingMaintenanceOperation(in *SeedPendingMaintenanceOperation, out *core.SeedPendingMaintenanceOperation, s conversion.Scope) error {
+	out.Type = core.SeedPendingMaintenanceOperationType(in.Type)
+	out.CurrentVersion = (*string)(unsafe.Pointer(in.CurrentVersion))
+	out.TargetVersion = (*string)(unsafe.Pointer(in.TargetVersion))
+	out.Description = in.Description
+	out.ScheduledTime = in.ScheduledTime
+	return nil
+}
+
+// Convert_v1beta1_SeedPendingMaintenanceOperation_To_core_SeedPendingMaintenanceOperation is an autogenerated conversion function.
+func Convert_v1beta1_SeedPendingMaintenanceOperation_To_core_SeedPendingMaintenanceOperation(in *SeedPendingMaintenanceOperation, out *core.SeedPendingMaintenanceOperation, s conversion.Scope) error {
+	return autoConvert_v1beta1_SeedPendingMaintenanceOperation_To_core_SeedPendingMaintenanceOperation(in, out, s)
+}
+
+func autoConvert_core_SeedPendingMaintenanceOperation_To_v1beta1_SeedPendingMaintenanceOperation(in *core.SeedPendingMaintenanceOperation, out *SeedPendingMaintenanceOperation, s conversion.Scope) error {
+	out.Type = SeedPendingMaintenanceOperationType(in.Type)
+	out.CurrentVersion = (*string)(unsafe.Pointer(in.CurrentVersion))
+	out.TargetVersion = (*string)(unsafe.Pointer(in.TargetVersion))
+	out.Description = in.Description
+	out.ScheduledTime = in.ScheduledTime
+	return nil
+}
+
+// Convert_core_SeedPendingMaintenanceOperation_To_v1beta1_SeedPendingMaintenanceOperation is an autogenerated conversion function.
+func Convert_core_SeedPendingMaintenanceOperation_To_v1beta1_SeedPendingMaintenanceOperation(in *core.SeedPendingMaintenanceOperation, out *SeedPendingMaintenanceOperation, s conversion.Scope) error {
+	return autoConvert_core_SeedPendingMaintenanceOperation_To_v1beta1_SeedPendingMaintenanceOperation(in, out, s)
+}
+
 func autoConvert_v1beta1_SeedProvider_To_core_SeedProvider(in *SeedProvider, out *core.SeedProvider, s conversion.Scope) error {
 	out.Type = in.Type
 	out.ProviderConfig = (*runtime.RawExtension)(unsafe.Pointer(in.ProviderConfig))
 	out.Region = in.Region
 	out.Zones = *(*[]string)(unsafe.Pointer(&in.Zones))
+	out.RegionMetadata = (*core.RegionMetadata)(unsafe.Pointer(in.RegionMetadata))
 	return nil
 }
 
@@ -5688,6 +7473,7 @@ func autoConvert_core_SeedProvider_To_v1beta1_SeedProvider(in *core.SeedProvider
 	out.ProviderConfig = (*runtime.RawExtension)(unsafe.Pointer(in.ProviderConfig))
 	out.Region = in.Region
 	out.Zones = *(*[]string)(unsafe.Pointer(&in.Zones))
+	out.RegionMetadata = (*RegionMetadata)(unsafe.Pointer(in.RegionMetadata))
 	return nil
 }
 
@@ -5718,6 +7504,28 @@ func Convert_core_SeedSelector_To_v1beta1_SeedSelector(in *core.SeedSelector, ou
 	return autoConvert_core_SeedSelector_To_v1beta1_SeedSelector(in, out, s)
 }
 
+func autoConvert_v1beta1_SeedSettingACME_To_core_SeedSettingACME(in *SeedSettingACME, out *core.SeedSettingACME, s conversion.Scope) error {
+	out.DirectoryURL = in.DirectoryURL
+	out.Email = (*string)(unsafe.Pointer(in.Email))
+	return nil
+}
+
+// Convert_v1beta1_SeedSettingACME_To_core_SeedSettingACME is an autogenerated conversion function.
+func Convert_v1beta1_SeedSettingACME_To_core_SeedSettingACME(in *SeedSettingACME, out *core.SeedSettingACME, s conversion.Scope) error {
+	return autoConvert_v1beta1_SeedSettingACME_To_core_SeedSettingACME(in, out, s)
+}
+
+func autoConvert_core_SeedSettingACME_To_v1beta1_SeedSettingACME(in *core.SeedSettingACME, out *SeedSettingACME, s conversion.Scope) error {
+	out.DirectoryURL = in.DirectoryURL
+	out.Email = (*string)(unsafe.Pointer(in.Email))
+	return nil
+}
+
+// Convert_core_SeedSettingACME_To_v1beta1_SeedSettingACME is an autogenerated conversion function.
+func Convert_core_SeedSettingACME_To_v1beta1_SeedSettingACME(in *core.SeedSettingACME, out *SeedSettingACME, s conversion.Scope) error {
+	return autoConvert_core_SeedSettingACME_To_v1beta1_SeedSettingACME(in, out, s)
+}
+
 func autoConvert_v1beta1_SeedSettingDependencyWatchdog_To_core_SeedSettingDependencyWatchdog(in *SeedSettingDependencyWatchdog, out *core.SeedSettingDependencyWatchdog, s conversion.Scope) error {
 	out.Weeder = (*core.SeedSettingDependencyWatchdogWeeder)(unsafe.Pointer(in.Weeder))
 	out.Prober = (*core.SeedSettingDependencyWatchdogProber)(unsafe.Pointer(in.Prober))
@@ -5945,6 +7753,7 @@ func autoConvert_v1beta1_SeedSettings_To_core_SeedSettings(in *SeedSettings, out
 	out.VerticalPodAutoscaler = (*core.SeedSettingVerticalPodAutoscaler)(unsafe.Pointer(in.VerticalPodAutoscaler))
 	out.DependencyWatchdog = (*core.SeedSettingDependencyWatchdog)(unsafe.Pointer(in.DependencyWatchdog))
 	out.TopologyAwareRouting = (*core.SeedSettingTopologyAwareRouting)(unsafe.Pointer(in.TopologyAwareRouting))
+	out.ACME = (*core.SeedSettingACME)(unsafe.Pointer(in.ACME))
 	return nil
 }
 
@@ -5960,6 +7769,7 @@ func autoConvert_core_SeedSettings_To_v1beta1_SeedSettings(in *core.SeedSettings
 	out.VerticalPodAutoscaler = (*SeedSettingVerticalPodAutoscaler)(unsafe.Pointer(in.VerticalPodAutoscaler))
 	out.DependencyWatchdog = (*SeedSettingDependencyWatchdog)(unsafe.Pointer(in.DependencyWatchdog))
 	out.TopologyAwareRouting = (*SeedSettingTopologyAwareRouting)(unsafe.Pointer(in.TopologyAwareRouting))
+	out.ACME = (*SeedSettingACME)(unsafe.Pointer(in.ACME))
 	return nil
 }
 
@@ -5984,6 +7794,7 @@ func autoConvert_v1beta1_SeedSpec_To_core_SeedSpec(in *SeedSpec, out *core.SeedS
 	out.Settings = (*core.SeedSettings)(unsafe.Pointer(in.Settings))
 	out.Ingress = (*core.Ingress)(unsafe.Pointer(in.Ingress))
 	out.AccessRestrictions = *(*[]core.AccessRestriction)(unsafe.Pointer(&in.AccessRestrictions))
+	out.Maintenance = (*core.SeedMaintenance)(unsafe.Pointer(in.Maintenance))
 	return nil
 }
 
@@ -6008,6 +7819,7 @@ func autoConvert_core_SeedSpec_To_v1beta1_SeedSpec(in *core.SeedSpec, out *SeedS
 	out.Volume = (*SeedVolume)(unsafe.Pointer(in.Volume))
 	out.Ingress = (*Ingress)(unsafe.Pointer(in.Ingress))
 	out.AccessRestrictions = *(*[]AccessRestriction)(unsafe.Pointer(&in.AccessRestrictions))
+	out.Maintenance = (*SeedMaintenance)(unsafe.Pointer(in.Maintenance))
 	return nil
 }
 
@@ -6026,6 +7838,10 @@ func autoConvert_v1beta1_SeedStatus_To_core_SeedStatus(in *SeedStatus, out *core
 	out.Allocatable = *(*v1.ResourceList)(unsafe.Pointer(&in.Allocatable))
 	out.ClientCertificateExpirationTimestamp = (*metav1.Time)(unsafe.Pointer(in.ClientCertificateExpirationTimestamp))
 	out.LastOperation = (*core.LastOperation)(unsafe.Pointer(in.LastOperation))
+	out.IngressDomainMigration = (*core.SeedIngressDomainMigration)(unsafe.Pointer(in.IngressDomainMigration))
+	out.Credentials = (*core.SeedCredentials)(unsafe.Pointer(in.Credentials))
+	out.SystemComponentsVersion = (*string)(unsafe.Pointer(in.SystemComponentsVersion))
+	out.PendingMaintenanceOperations = *(*[]core.SeedPendingMaintenanceOperation)(unsafe.Pointer(&in.PendingMaintenanceOperations))
 	return nil
 }
 
@@ -6044,6 +7860,10 @@ func autoConvert_core_SeedStatus_To_v1beta1_SeedStatus(in *core.SeedStatus, out
 	out.Allocatable = *(*v1.ResourceList)(unsafe.Pointer(&in.Allocatable))
 	out.ClientCertificateExpirationTimestamp = (*metav1.Time)(unsafe.Pointer(in.ClientCertificateExpirationTimestamp))
 	out.LastOperation = (*LastOperation)(unsafe.Pointer(in.LastOperation))
+	out.IngressDomainMigration = (*SeedIngressDomainMigration)(unsafe.Pointer(in.IngressDomainMigration))
+	out.Credentials = (*SeedCredentials)(unsafe.Pointer(in.Credentials))
+	out.SystemComponentsVersion = (*string)(unsafe.Pointer(in.SystemComponentsVersion))
+	out.PendingMaintenanceOperations = *(*[]SeedPendingMaintenanceOperation)(unsafe.Pointer(&in.PendingMaintenanceOperations))
 	return nil
 }
 
@@ -6055,6 +7875,7 @@ func Convert_core_SeedStatus_To_v1beta1_SeedStatus(in *core.SeedStatus, out *See
 func autoConvert_v1beta1_SeedTaint_To_core_SeedTaint(in *SeedTaint, out *core.SeedTaint, s conversion.Scope) error {
 	out.Key = in.Key
 	out.Value = (*string)(unsafe.Pointer(in.Value))
+	out.Effect = (*core.SeedTaintEffect)(unsafe.Pointer(in.Effect))
 	return nil
 }
 
@@ -6066,6 +7887,7 @@ func Convert_v1beta1_SeedTaint_To_core_SeedTaint(in *SeedTaint, out *core.SeedTa
 func autoConvert_core_SeedTaint_To_v1beta1_SeedTaint(in *core.SeedTaint, out *SeedTaint, s conversion.Scope) error {
 	out.Key = in.Key
 	out.Value = (*string)(unsafe.Pointer(in.Value))
+	out.Effect = (*SeedTaintEffect)(unsafe.Pointer(in.Effect))
 	return nil
 }
 
@@ -6252,6 +8074,120 @@ func Convert_core_ShootAdvertisedAddress_To_v1beta1_ShootAdvertisedAddress(in *c
 	return autoConvert_core_ShootAdvertisedAddress_To_v1beta1_ShootAdvertisedAddress(in, out, s)
 }
 
+func autoConvert_v1beta1_ShootClass_To_core_ShootClass(in *ShootClass, out *core.ShootClass, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_ShootClassSpec_To_core_ShootClassSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_ShootClass_To_core_ShootClass is an autogenerated conversion function.
+func Convert_v1beta1_ShootClass_To_core_ShootClass(in *ShootClass, out *core.ShootClass, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootClass_To_core_ShootClass(in, out, s)
+}
+
+func autoConvert_core_ShootClass_To_v1beta1_ShootClass(in *core.ShootClass, out *ShootClass, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_core_ShootClassSpec_To_v1beta1_ShootClassSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_core_ShootClass_To_v1beta1_ShootClass is an autogenerated conversion function.
+func Convert_core_ShootClass_To_v1beta1_ShootClass(in *core.ShootClass, out *ShootClass, s conversion.Scope) error {
+	return autoConvert_core_ShootClass_To_v1beta1_ShootClass(in, out, s)
+}
+
+func autoConvert_v1beta1_ShootClassList_To_core_ShootClassList(in *ShootClassList, out *core.ShootClassList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]core.ShootClass, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta1_ShootClass_To_core_ShootClass(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1beta1_ShootClassList_To_core_ShootClassList is an autogenerated conversion function.
+func Convert_v1beta1_ShootClassList_To_core_ShootClassList(in *ShootClassList, out *core.ShootClassList, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootClassList_To_core_ShootClassList(in, out, s)
+}
+
+func autoConvert_core_ShootClassList_To_v1beta1_ShootClassList(in *core.ShootClassList, out *ShootClassList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ShootClass, len(*in))
+		for i := range *in {
+			if err := Convert_core_ShootClass_To_v1beta1_ShootClass(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_core_ShootClassList_To_v1beta1_ShootClassList is an autogenerated conversion function.
+func Convert_core_ShootClassList_To_v1beta1_ShootClassList(in *core.ShootClassList, out *ShootClassList, s conversion.Scope) error {
+	return autoConvert_core_ShootClassList_To_v1beta1_ShootClassList(in, out, s)
+}
+
+func autoConvert_v1beta1_ShootClassSpec_To_core_ShootClassSpec(in *ShootClassSpec, out *core.ShootClassSpec, s conversion.Scope) error {
+	out.ProviderType = (*string)(unsafe.Pointer(in.ProviderType))
+	if in.Workers != nil {
+		in, out := &in.Workers, &out.Workers
+		*out = make([]core.Worker, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta1_Worker_To_core_Worker(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Workers = nil
+	}
+	out.ControlPlane = (*core.ControlPlane)(unsafe.Pointer(in.ControlPlane))
+	out.Hibernation = (*core.Hibernation)(unsafe.Pointer(in.Hibernation))
+	return nil
+}
+
+// Convert_v1beta1_ShootClassSpec_To_core_ShootClassSpec is an autogenerated conversion function.
+func Convert_v1beta1_ShootClassSpec_To_core_ShootClassSpec(in *ShootClassSpec, out *core.ShootClassSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootClassSpec_To_core_ShootClassSpec(in, out, s)
+}
+
+func autoConvert_core_ShootClassSpec_To_v1beta1_ShootClassSpec(in *core.ShootClassSpec, out *ShootClassSpec, s conversion.Scope) error {
+	out.ProviderType = (*string)(unsafe.Pointer(in.ProviderType))
+	if in.Workers != nil {
+		in, out := &in.Workers, &out.Workers
+		*out = make([]Worker, len(*in))
+		for i := range *in {
+			if err := Convert_core_Worker_To_v1beta1_Worker(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Workers = nil
+	}
+	out.ControlPlane = (*ControlPlane)(unsafe.Pointer(in.ControlPlane))
+	out.Hibernation = (*Hibernation)(unsafe.Pointer(in.Hibernation))
+	return nil
+}
+
+// Convert_core_ShootClassSpec_To_v1beta1_ShootClassSpec is an autogenerated conversion function.
+func Convert_core_ShootClassSpec_To_v1beta1_ShootClassSpec(in *core.ShootClassSpec, out *ShootClassSpec, s conversion.Scope) error {
+	return autoConvert_core_ShootClassSpec_To_v1beta1_ShootClassSpec(in, out, s)
+}
+
 func autoConvert_v1beta1_ShootCredentials_To_core_ShootCredentials(in *ShootCredentials, out *core.ShootCredentials, s conversion.Scope) error {
 	out.Rotation = (*core.ShootCredentialsRotation)(unsafe.Pointer(in.Rotation))
 	return nil
@@ -6416,6 +8352,34 @@ func Convert_core_ShootNetworks_To_v1beta1_ShootNetworks(in *core.ShootNetworks,
 	return autoConvert_core_ShootNetworks_To_v1beta1_ShootNetworks(in, out, s)
 }
 
+func autoConvert_v1beta1_ShootRemediation_To_core_ShootRemediation(in *ShootRemediation, out *core.ShootRemediation, s conversion.Scope) error {
+	out.Action = in.Action
+	out.ConditionType = core.ConditionType(in.ConditionType)
+	out.State = core.LastOperationState(in.State)
+	out.Description = in.Description
+	out.LastExecutionTime = in.LastExecutionTime
+	return nil
+}
+
+// Convert_v1beta1_ShootRemediation_To_core_ShootRemediation is an autogenerated conversion function.
+func Convert_v1beta1_ShootRemediation_To_core_ShootRemediation(in *ShootRemediation, out *core.ShootRemediation, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootRemediation_To_core_ShootRemediation(in, out, s)
+}
+
+func autoConvert_core_ShootRemediation_To_v1beta1_ShootRemediation(in *core.ShootRemediation, out *ShootRemediation, s conversion.Scope) error {
+	out.Action = in.Action
+	out.ConditionType = ConditionType(in.ConditionType)
+	out.State = LastOperationState(in.State)
+	out.Description = in.Description
+	out.LastExecutionTime = in.LastExecutionTime
+	return nil
+}
+
+// Convert_core_ShootRemediation_To_v1beta1_ShootRemediation is an autogenerated conversion function.
+func Convert_core_ShootRemediation_To_v1beta1_ShootRemediation(in *core.ShootRemediation, out *ShootRemediation, s conversion.Scope) error {
+	return autoConvert_core_ShootRemediation_To_v1beta1_ShootRemediation(in, out, s)
+}
+
 func autoConvert_v1beta1_ShootSSHKeypairRotation_To_core_ShootSSHKeypairRotation(in *ShootSSHKeypairRotation, out *core.ShootSSHKeypairRotation, s conversion.Scope) error {
 	out.LastInitiationTime = (*metav1.Time)(unsafe.Pointer(in.LastInitiationTime))
 	out.LastCompletionTime = (*metav1.Time)(unsafe.Pointer(in.LastCompletionTime))
@@ -6467,6 +8431,10 @@ func autoConvert_v1beta1_ShootSpec_To_core_ShootSpec(in *ShootSpec, out *core.Sh
 	out.CloudProfile = (*core.CloudProfileReference)(unsafe.Pointer(in.CloudProfile))
 	out.CredentialsBindingName = (*string)(unsafe.Pointer(in.CredentialsBindingName))
 	out.AccessRestrictions = *(*[]core.AccessRestrictionWithOptions)(unsafe.Pointer(&in.AccessRestrictions))
+	out.DataResidency = (*core.DataResidency)(unsafe.Pointer(in.DataResidency))
+	out.ETCD = (*core.ETCD)(unsafe.Pointer(in.ETCD))
+	out.ExpirationTimestamp = (*metav1.Time)(unsafe.Pointer(in.ExpirationTimestamp))
+	out.ShootClassName = (*string)(unsafe.Pointer(in.ShootClassName))
 	return nil
 }
 
@@ -6504,6 +8472,10 @@ func autoConvert_core_ShootSpec_To_v1beta1_ShootSpec(in *core.ShootSpec, out *Sh
 	out.CloudProfile = (*CloudProfileReference)(unsafe.Pointer(in.CloudProfile))
 	out.CredentialsBindingName = (*string)(unsafe.Pointer(in.CredentialsBindingName))
 	out.AccessRestrictions = *(*[]AccessRestrictionWithOptions)(unsafe.Pointer(&in.AccessRestrictions))
+	out.DataResidency = (*DataResidency)(unsafe.Pointer(in.DataResidency))
+	out.ETCD = (*ETCD)(unsafe.Pointer(in.ETCD))
+	out.ExpirationTimestamp = (*metav1.Time)(unsafe.Pointer(in.ExpirationTimestamp))
+	out.ShootClassName = (*string)(unsafe.Pointer(in.ShootClassName))
 	return nil
 }
 
@@ -6606,6 +8578,11 @@ func autoConvert_v1beta1_ShootStatus_To_core_ShootStatus(in *ShootStatus, out *c
 	out.LastMaintenance = (*core.LastMaintenance)(unsafe.Pointer(in.LastMaintenance))
 	out.EncryptedResources = *(*[]string)(unsafe.Pointer(&in.EncryptedResources))
 	out.Networking = (*core.NetworkingStatus)(unsafe.Pointer(in.Networking))
+	out.SizeClass = (*core.ShootSizeClass)(unsafe.Pointer(in.SizeClass))
+	out.LegacyComponentRemovals = *(*[]core.LegacyComponentRemoval)(unsafe.Pointer(&in.LegacyComponentRemovals))
+	out.EtcdSnapshot = (*core.EtcdSnapshot)(unsafe.Pointer(in.EtcdSnapshot))
+	out.PendingMaintenanceOperations = *(*[]core.PendingMaintenanceOperation)(unsafe.Pointer(&in.PendingMaintenanceOperations))
+	out.Remediations = *(*[]core.ShootRemediation)(unsafe.Pointer(&in.Remediations))
 	return nil
 }
 
@@ -6636,6 +8613,11 @@ func autoConvert_core_ShootStatus_To_v1beta1_ShootStatus(in *core.ShootStatus, o
 	out.LastMaintenance = (*LastMaintenance)(unsafe.Pointer(in.LastMaintenance))
 	out.EncryptedResources = *(*[]string)(unsafe.Pointer(&in.EncryptedResources))
 	out.Networking = (*NetworkingStatus)(unsafe.Pointer(in.Networking))
+	out.SizeClass = (*ShootSizeClass)(unsafe.Pointer(in.SizeClass))
+	out.LegacyComponentRemovals = *(*[]LegacyComponentRemoval)(unsafe.Pointer(&in.LegacyComponentRemovals))
+	out.EtcdSnapshot = (*EtcdSnapshot)(unsafe.Pointer(in.EtcdSnapshot))
+	out.PendingMaintenanceOperations = *(*[]PendingMaintenanceOperation)(unsafe.Pointer(&in.PendingMaintenanceOperations))
+	out.Remediations = *(*[]ShootRemediation)(unsafe.Pointer(&in.Remediations))
 	return nil
 }
 
@@ -6715,6 +8697,7 @@ func Convert_core_StructuredAuthorization_To_v1beta1_StructuredAuthorization(in
 func autoConvert_v1beta1_SystemComponents_To_core_SystemComponents(in *SystemComponents, out *core.SystemComponents, s conversion.Scope) error {
 	out.CoreDNS = (*core.CoreDNS)(unsafe.Pointer(in.CoreDNS))
 	out.NodeLocalDNS = (*core.NodeLocalDNS)(unsafe.Pointer(in.NodeLocalDNS))
+	out.DedicatedPool = (*string)(unsafe.Pointer(in.DedicatedPool))
 	return nil
 }
 
@@ -6726,6 +8709,7 @@ func Convert_v1beta1_SystemComponents_To_core_SystemComponents(in *SystemCompone
 func autoConvert_core_SystemComponents_To_v1beta1_SystemComponents(in *core.SystemComponents, out *SystemComponents, s conversion.Scope) error {
 	out.CoreDNS = (*CoreDNS)(unsafe.Pointer(in.CoreDNS))
 	out.NodeLocalDNS = (*NodeLocalDNS)(unsafe.Pointer(in.NodeLocalDNS))
+	out.DedicatedPool = (*string)(unsafe.Pointer(in.DedicatedPool))
 	return nil
 }
 
@@ -6756,6 +8740,80 @@ func Convert_core_Toleration_To_v1beta1_Toleration(in *core.Toleration, out *Tol
 	return autoConvert_core_Toleration_To_v1beta1_Toleration(in, out, s)
 }
 
+func autoConvert_v1beta1_TrustedIdentityProvider_To_core_TrustedIdentityProvider(in *TrustedIdentityProvider, out *core.TrustedIdentityProvider, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Issuer = in.Issuer
+	out.Audiences = *(*[]string)(unsafe.Pointer(&in.Audiences))
+	out.Subjects = *(*[]string)(unsafe.Pointer(&in.Subjects))
+	out.Access = (*core.TrustedIdentityProviderAccess)(unsafe.Pointer(in.Access))
+	return nil
+}
+
+// Convert_v1beta1_TrustedIdentityProvider_To_core_TrustedIdentityProvider is an autogenerated conversion function.
+func Convert_v1beta1_TrustedIdentityProvider_To_core_TrustedIdentityProvider(in *TrustedIdentityProvider, out *core.TrustedIdentityProvider, s conversion.Scope) error {
+	return autoConvert_v1beta1_TrustedIdentityProvider_To_core_TrustedIdentityProvider(in, out, s)
+}
+
+func autoConvert_core_TrustedIdentityProvider_To_v1beta1_TrustedIdentityProvider(in *core.TrustedIdentityProvider, out *TrustedIdentityProvider, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Issuer = in.Issuer
+	out.Audiences = *(*[]string)(unsafe.Pointer(&in.Audiences))
+	out.Subjects = *(*[]string)(unsafe.Pointer(&in.Subjects))
+	out.Access = (*TrustedIdentityProviderAccess)(unsafe.Pointer(in.Access))
+	return nil
+}
+
+// Convert_core_TrustedIdentityProvider_To_v1beta1_TrustedIdentityProvider is an autogenerated conversion function.
+func Convert_core_TrustedIdentityProvider_To_v1beta1_TrustedIdentityProvider(in *core.TrustedIdentityProvider, out *TrustedIdentityProvider, s conversion.Scope) error {
+	return autoConvert_core_TrustedIdentityProvider_To_v1beta1_TrustedIdentityProvider(in, out, s)
+}
+
+func autoConvert_v1beta1_TypeRegionAvailability_To_core_TypeRegionAvailability(in *TypeRegionAvailability, out *core.TypeRegionAvailability, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Zones = *(*[]string)(unsafe.Pointer(&in.Zones))
+	out.PricingClass = (*string)(unsafe.Pointer(in.PricingClass))
+	return nil
+}
+
+// Convert_v1beta1_TypeRegionAvailability_To_core_TypeRegionAvailability is an autogenerated conversion function.
+func Convert_v1beta1_TypeRegionAvailability_To_core_TypeRegionAvailability(in *TypeRegionAvailability, out *core.TypeRegionAvailability, s conversion.Scope) error {
+	return autoConvert_v1beta1_TypeRegionAvailability_To_core_TypeRegionAvailability(in, out, s)
+}
+
+func autoConvert_core_TypeRegionAvailability_To_v1beta1_TypeRegionAvailability(in *core.TypeRegionAvailability, out *TypeRegionAvailability, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Zones = *(*[]string)(unsafe.Pointer(&in.Zones))
+	out.PricingClass = (*string)(unsafe.Pointer(in.PricingClass))
+	return nil
+}
+
+// Convert_core_TypeRegionAvailability_To_v1beta1_TypeRegionAvailability is an autogenerated conversion function.
+func Convert_core_TypeRegionAvailability_To_v1beta1_TypeRegionAvailability(in *core.TypeRegionAvailability, out *TypeRegionAvailability, s conversion.Scope) error {
+	return autoConvert_core_TypeRegionAvailability_To_v1beta1_TypeRegionAvailability(in, out, s)
+}
+
+func autoConvert_v1beta1_VersionUsage_To_core_VersionUsage(in *VersionUsage, out *core.VersionUsage, s conversion.Scope) error {
+	out.Version = in.Version
+	out.Shoots = in.Shoots
+	return nil
+}
+
+// Convert_v1beta1_VersionUsage_To_core_VersionUsage is an autogenerated conversion function.
+func Convert_v1beta1_VersionUsage_To_core_VersionUsage(in *VersionUsage, out *core.VersionUsage, s conversion.Scope) error {
+	return autoConvert_v1beta1_VersionUsage_To_core_VersionUsage(in, out, s)
+}
+
+func autoConvert_core_VersionUsage_To_v1beta1_VersionUsage(in *core.VersionUsage, out *VersionUsage, s conversion.Scope) error {
+	out.Version = in.Version
+	out.Shoots = in.Shoots
+	return nil
+}
+
+// Convert_core_VersionUsage_To_v1beta1_VersionUsage is an autogenerated conversion function.
+func Convert_core_VersionUsage_To_v1beta1_VersionUsage(in *core.VersionUsage, out *VersionUsage, s conversion.Scope) error {
+	return autoConvert_core_VersionUsage_To_v1beta1_VersionUsage(in, out, s)
+}
+
 func autoConvert_v1beta1_VerticalPodAutoscaler_To_core_VerticalPodAutoscaler(in *VerticalPodAutoscaler, out *core.VerticalPodAutoscaler, s conversion.Scope) error {
 	out.Enabled = in.Enabled
 	out.EvictAfterOOMThreshold = (*metav1.Duration)(unsafe.Pointer(in.EvictAfterOOMThreshold))
@@ -6811,6 +8869,8 @@ func autoConvert_v1beta1_Volume_To_core_Volume(in *Volume, out *core.Volume, s c
 	out.Type = (*string)(unsafe.Pointer(in.Type))
 	out.VolumeSize = in.VolumeSize
 	out.Encrypted = (*bool)(unsafe.Pointer(in.Encrypted))
+	out.IOPS = (*int64)(unsafe.Pointer(in.IOPS))
+	out.Throughput = (*int64)(unsafe.Pointer(in.Throughput))
 	return nil
 }
 
@@ -6824,6 +8884,8 @@ func autoConvert_core_Volume_To_v1beta1_Volume(in *core.Volume, out *Volume, s c
 	out.Type = (*string)(unsafe.Pointer(in.Type))
 	out.VolumeSize = in.VolumeSize
 	out.Encrypted = (*bool)(unsafe.Pointer(in.Encrypted))
+	out.IOPS = (*int64)(unsafe.Pointer(in.IOPS))
+	out.Throughput = (*int64)(unsafe.Pointer(in.Throughput))
 	return nil
 }
 
@@ -6837,6 +8899,9 @@ func autoConvert_v1beta1_VolumeType_To_core_VolumeType(in *VolumeType, out *core
 	out.Name = in.Name
 	out.Usable = (*bool)(unsafe.Pointer(in.Usable))
 	out.MinSize = (*resource.Quantity)(unsafe.Pointer(in.MinSize))
+	out.MaxIOPS = (*int64)(unsafe.Pointer(in.MaxIOPS))
+	out.MaxThroughput = (*int64)(unsafe.Pointer(in.MaxThroughput))
+	out.Regions = *(*[]core.TypeRegionAvailability)(unsafe.Pointer(&in.Regions))
 	return nil
 }
 
@@ -6850,6 +8915,9 @@ func autoConvert_core_VolumeType_To_v1beta1_VolumeType(in *core.VolumeType, out
 	out.Name = in.Name
 	out.Usable = (*bool)(unsafe.Pointer(in.Usable))
 	out.MinSize = (*resource.Quantity)(unsafe.Pointer(in.MinSize))
+	out.MaxIOPS = (*int64)(unsafe.Pointer(in.MaxIOPS))
+	out.MaxThroughput = (*int64)(unsafe.Pointer(in.MaxThroughput))
+	out.Regions = *(*[]TypeRegionAvailability)(unsafe.Pointer(&in.Regions))
 	return nil
 }
 
@@ -6912,6 +8980,11 @@ func autoConvert_v1beta1_Worker_To_core_Worker(in *Worker, out *core.Worker, s c
 	out.MachineControllerManagerSettings = (*core.MachineControllerManagerSettings)(unsafe.Pointer(in.MachineControllerManagerSettings))
 	out.Sysctls = *(*map[string]string)(unsafe.Pointer(&in.Sysctls))
 	out.ClusterAutoscaler = (*core.ClusterAutoscalerOptions)(unsafe.Pointer(in.ClusterAutoscaler))
+	out.CapacityType = (*core.CapacityType)(unsafe.Pointer(in.CapacityType))
+	out.UpdateStrategy = (*core.WorkerUpdateStrategy)(unsafe.Pointer(in.UpdateStrategy))
+	out.RolloutStrategy = (*core.WorkerRolloutStrategy)(unsafe.Pointer(in.RolloutStrategy))
+	out.NodeTemplate = (*core.WorkerNodeTemplate)(unsafe.Pointer(in.NodeTemplate))
+	out.KernelModules = *(*[]string)(unsafe.Pointer(&in.KernelModules))
 	return nil
 }
 
@@ -6951,7 +9024,12 @@ func autoConvert_core_Worker_To_v1beta1_Worker(in *core.Worker, out *Worker, s c
 	out.Zones = *(*[]string)(unsafe.Pointer(&in.Zones))
 	out.MachineControllerManagerSettings = (*MachineControllerManagerSettings)(unsafe.Pointer(in.MachineControllerManagerSettings))
 	out.Sysctls = *(*map[string]string)(unsafe.Pointer(&in.Sysctls))
+	out.KernelModules = *(*[]string)(unsafe.Pointer(&in.KernelModules))
 	out.ClusterAutoscaler = (*ClusterAutoscalerOptions)(unsafe.Pointer(in.ClusterAutoscaler))
+	out.CapacityType = (*CapacityType)(unsafe.Pointer(in.CapacityType))
+	out.UpdateStrategy = (*WorkerUpdateStrategy)(unsafe.Pointer(in.UpdateStrategy))
+	out.RolloutStrategy = (*WorkerRolloutStrategy)(unsafe.Pointer(in.RolloutStrategy))
+	out.NodeTemplate = (*WorkerNodeTemplate)(unsafe.Pointer(in.NodeTemplate))
 	return nil
 }
 
@@ -6998,8 +9076,75 @@ func Convert_core_WorkerKubernetes_To_v1beta1_WorkerKubernetes(in *core.WorkerKu
 	return autoConvert_core_WorkerKubernetes_To_v1beta1_WorkerKubernetes(in, out, s)
 }
 
+func autoConvert_v1beta1_WorkerNodeTemplate_To_core_WorkerNodeTemplate(in *WorkerNodeTemplate, out *core.WorkerNodeTemplate, s conversion.Scope) error {
+	out.EnforceLabels = (*bool)(unsafe.Pointer(in.EnforceLabels))
+	return nil
+}
+
+// Convert_v1beta1_WorkerNodeTemplate_To_core_WorkerNodeTemplate is an autogenerated conversion function.
+func Convert_v1beta1_WorkerNodeTemplate_To_core_WorkerNodeTemplate(in *WorkerNodeTemplate, out *core.WorkerNodeTemplate, s conversion.Scope) error {
+	return autoConvert_v1beta1_WorkerNodeTemplate_To_core_WorkerNodeTemplate(in, out, s)
+}
+
+func autoConvert_core_WorkerNodeTemplate_To_v1beta1_WorkerNodeTemplate(in *core.WorkerNodeTemplate, out *WorkerNodeTemplate, s conversion.Scope) error {
+	out.EnforceLabels = (*bool)(unsafe.Pointer(in.EnforceLabels))
+	return nil
+}
+
+// Convert_core_WorkerNodeTemplate_To_v1beta1_WorkerNodeTemplate is an autogenerated conversion function.
+func Convert_core_WorkerNodeTemplate_To_v1beta1_WorkerNodeTemplate(in *core.WorkerNodeTemplate, out *WorkerNodeTemplate, s conversion.Scope) error {
+	return autoConvert_core_WorkerNodeTemplate_To_v1beta1_WorkerNodeTemplate(in, out, s)
+}
+
+func autoConvert_v1beta1_WorkerPoolMaintenance_To_core_WorkerPoolMaintenance(in *WorkerPoolMaintenance, out *core.WorkerPoolMaintenance, s conversion.Scope) error {
+	out.Name = in.Name
+	if err := Convert_v1beta1_MaintenanceTimeWindow_To_core_MaintenanceTimeWindow(&in.TimeWindow, &out.TimeWindow, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_WorkerPoolMaintenance_To_core_WorkerPoolMaintenance is an autogenerated conversion function.
+func Convert_v1beta1_WorkerPoolMaintenance_To_core_WorkerPoolMaintenance(in *WorkerPoolMaintenance, out *core.WorkerPoolMaintenance, s conversion.Scope) error {
+	return autoConvert_v1beta1_WorkerPoolMaintenance_To_core_WorkerPoolMaintenance(in, out, s)
+}
+
+func autoConvert_core_WorkerPoolMaintenance_To_v1beta1_WorkerPoolMaintenance(in *core.WorkerPoolMaintenance, out *WorkerPoolMaintenance, s conversion.Scope) error {
+	out.Name = in.Name
+	if err := Convert_core_MaintenanceTimeWindow_To_v1beta1_MaintenanceTimeWindow(&in.TimeWindow, &out.TimeWindow, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_core_WorkerPoolMaintenance_To_v1beta1_WorkerPoolMaintenance is an autogenerated conversion function.
+func Convert_core_WorkerPoolMaintenance_To_v1beta1_WorkerPoolMaintenance(in *core.WorkerPoolMaintenance, out *WorkerPoolMaintenance, s conversion.Scope) error {
+	return autoConvert_core_WorkerPoolMaintenance_To_v1beta1_WorkerPoolMaintenance(in, out, s)
+}
+
+func autoConvert_v1beta1_WorkerRolloutStrategy_To_core_WorkerRolloutStrategy(in *WorkerRolloutStrategy, out *core.WorkerRolloutStrategy, s conversion.Scope) error {
+	out.Order = (*int32)(unsafe.Pointer(in.Order))
+	return nil
+}
+
+// Convert_v1beta1_WorkerRolloutStrategy_To_core_WorkerRolloutStrategy is an autogenerated conversion function.
+func Convert_v1beta1_WorkerRolloutStrategy_To_core_WorkerRolloutStrategy(in *WorkerRolloutStrategy, out *core.WorkerRolloutStrategy, s conversion.Scope) error {
+	return autoConvert_v1beta1_WorkerRolloutStrategy_To_core_WorkerRolloutStrategy(in, out, s)
+}
+
+func autoConvert_core_WorkerRolloutStrategy_To_v1beta1_WorkerRolloutStrategy(in *core.WorkerRolloutStrategy, out *WorkerRolloutStrategy, s conversion.Scope) error {
+	out.Order = (*int32)(unsafe.Pointer(in.Order))
+	return nil
+}
+
+// Convert_core_WorkerRolloutStrategy_To_v1beta1_WorkerRolloutStrategy is an autogenerated conversion function.
+func Convert_core_WorkerRolloutStrategy_To_v1beta1_WorkerRolloutStrategy(in *core.WorkerRolloutStrategy, out *WorkerRolloutStrategy, s conversion.Scope) error {
+	return autoConvert_core_WorkerRolloutStrategy_To_v1beta1_WorkerRolloutStrategy(in, out, s)
+}
+
 func autoConvert_v1beta1_WorkerSystemComponents_To_core_WorkerSystemComponents(in *WorkerSystemComponents, out *core.WorkerSystemComponents, s conversion.Scope) error {
 	out.Allow = in.Allow
+	out.ReservedNodesPercentage = (*int32)(unsafe.Pointer(in.ReservedNodesPercentage))
 	return nil
 }
 
@@ -7010,6 +9155,7 @@ func Convert_v1beta1_WorkerSystemComponents_To_core_WorkerSystemComponents(in *W
 
 func autoConvert_core_WorkerSystemComponents_To_v1beta1_WorkerSystemComponents(in *core.WorkerSystemComponents, out *WorkerSystemComponents, s conversion.Scope) error {
 	out.Allow = in.Allow
+	out.ReservedNodesPercentage = (*int32)(unsafe.Pointer(in.ReservedNodesPercentage))
 	return nil
 }
 
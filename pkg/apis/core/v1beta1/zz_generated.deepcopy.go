@@ -18,6 +18,32 @@ import (
 	intstr "k8s.io/apimachinery/pkg/util/intstr"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIPriorityAndFairness) DeepCopyInto(out *APIPriorityAndFairness) {
+	*out = *in
+	if in.MaxSeats != nil {
+		in, out := &in.MaxSeats, &out.MaxSeats
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ConfigMapName != nil {
+		in, out := &in.ConfigMapName, &out.ConfigMapName
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIPriorityAndFairness.
+func (in *APIPriorityAndFairness) DeepCopy() *APIPriorityAndFairness {
+	if in == nil {
+		return nil
+	}
+	out := new(APIPriorityAndFairness)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *APIServerLogging) DeepCopyInto(out *APIServerLogging) {
 	*out = *in
@@ -126,6 +152,57 @@ func (in *Addon) DeepCopy() *Addon {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AddonDefinition) DeepCopyInto(out *AddonDefinition) {
+	*out = *in
+	if in.Versions != nil {
+		in, out := &in.Versions, &out.Versions
+		*out = make([]AddonVersion, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.WorkerlessSupported != nil {
+		in, out := &in.WorkerlessSupported, &out.WorkerlessSupported
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddonDefinition.
+func (in *AddonDefinition) DeepCopy() *AddonDefinition {
+	if in == nil {
+		return nil
+	}
+	out := new(AddonDefinition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AddonVersion) DeepCopyInto(out *AddonVersion) {
+	*out = *in
+	in.ExpirableVersion.DeepCopyInto(&out.ExpirableVersion)
+	in.Chart.DeepCopyInto(&out.Chart)
+	if in.ValuesSchema != nil {
+		in, out := &in.ValuesSchema, &out.ValuesSchema
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddonVersion.
+func (in *AddonVersion) DeepCopy() *AddonVersion {
+	if in == nil {
+		return nil
+	}
+	out := new(AddonVersion)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Addons) DeepCopyInto(out *Addons) {
 	*out = *in
@@ -139,6 +216,13 @@ func (in *Addons) DeepCopyInto(out *Addons) {
 		*out = new(NginxIngress)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Managed != nil {
+		in, out := &in.Managed, &out.Managed
+		*out = make([]ManagedAddon, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -170,6 +254,11 @@ func (in *AdmissionPlugin) DeepCopyInto(out *AdmissionPlugin) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ConfigMapName != nil {
+		in, out := &in.ConfigMapName, &out.ConfigMapName
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -212,6 +301,16 @@ func (in *AuditConfig) DeepCopyInto(out *AuditConfig) {
 		*out = new(AuditPolicy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Webhook != nil {
+		in, out := &in.Webhook, &out.Webhook
+		*out = new(AuditWebhook)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Sink != nil {
+		in, out := &in.Sink, &out.Sink
+		*out = new(AuditSink)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -246,6 +345,53 @@ func (in *AuditPolicy) DeepCopy() *AuditPolicy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuditSink) DeepCopyInto(out *AuditSink) {
+	*out = *in
+	if in.ProviderConfig != nil {
+		in, out := &in.ProviderConfig, &out.ProviderConfig
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuditSink.
+func (in *AuditSink) DeepCopy() *AuditSink {
+	if in == nil {
+		return nil
+	}
+	out := new(AuditSink)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuditWebhook) DeepCopyInto(out *AuditWebhook) {
+	*out = *in
+	if in.BatchMaxSize != nil {
+		in, out := &in.BatchMaxSize, &out.BatchMaxSize
+		*out = new(int32)
+		**out = **in
+	}
+	if in.BatchMaxWait != nil {
+		in, out := &in.BatchMaxWait, &out.BatchMaxWait
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuditWebhook.
+func (in *AuditWebhook) DeepCopy() *AuditWebhook {
+	if in == nil {
+		return nil
+	}
+	out := new(AuditWebhook)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AuthorizerKubeconfigReference) DeepCopyInto(out *AuthorizerKubeconfigReference) {
 	*out = *in
@@ -316,6 +462,23 @@ func (in *BackupBucket) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BackupBucketImmutability) DeepCopyInto(out *BackupBucketImmutability) {
+	*out = *in
+	out.RetentionPeriod = in.RetentionPeriod
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupBucketImmutability.
+func (in *BackupBucketImmutability) DeepCopy() *BackupBucketImmutability {
+	if in == nil {
+		return nil
+	}
+	out := new(BackupBucketImmutability)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackupBucketList) DeepCopyInto(out *BackupBucketList) {
 	*out = *in
@@ -380,6 +543,11 @@ func (in *BackupBucketSpec) DeepCopyInto(out *BackupBucketSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Immutability != nil {
+		in, out := &in.Immutability, &out.Immutability
+		*out = new(BackupBucketImmutability)
+		**out = **in
+	}
 	return
 }
 
@@ -670,6 +838,7 @@ func (in *CloudProfile) DeepCopyInto(out *CloudProfile) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -792,6 +961,13 @@ func (in *CloudProfileSpec) DeepCopyInto(out *CloudProfileSpec) {
 		*out = new(Bastion)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Addons != nil {
+		in, out := &in.Addons, &out.Addons
+		*out = make([]AddonDefinition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -805,6 +981,57 @@ func (in *CloudProfileSpec) DeepCopy() *CloudProfileSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudProfileStatus) DeepCopyInto(out *CloudProfileStatus) {
+	*out = *in
+	if in.Usage != nil {
+		in, out := &in.Usage, &out.Usage
+		*out = new(CloudProfileUsage)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudProfileStatus.
+func (in *CloudProfileStatus) DeepCopy() *CloudProfileStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudProfileStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudProfileUsage) DeepCopyInto(out *CloudProfileUsage) {
+	*out = *in
+	if in.KubernetesVersions != nil {
+		in, out := &in.KubernetesVersions, &out.KubernetesVersions
+		*out = make([]VersionUsage, len(*in))
+		copy(*out, *in)
+	}
+	if in.MachineImageVersions != nil {
+		in, out := &in.MachineImageVersions, &out.MachineImageVersions
+		*out = make([]MachineImageVersionUsage, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastUpdateTime != nil {
+		in, out := &in.LastUpdateTime, &out.LastUpdateTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudProfileUsage.
+func (in *CloudProfileUsage) DeepCopy() *CloudProfileUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudProfileUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterAutoscaler) DeepCopyInto(out *ClusterAutoscaler) {
 	*out = *in
@@ -994,6 +1221,11 @@ func (in *ControlPlane) DeepCopyInto(out *ControlPlane) {
 		*out = new(HighAvailability)
 		**out = **in
 	}
+	if in.Egress != nil {
+		in, out := &in.Egress, &out.Egress
+		*out = new(ControlPlaneEgress)
+		**out = **in
+	}
 	return
 }
 
@@ -1007,6 +1239,22 @@ func (in *ControlPlane) DeepCopy() *ControlPlane {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ControlPlaneEgress) DeepCopyInto(out *ControlPlaneEgress) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ControlPlaneEgress.
+func (in *ControlPlaneEgress) DeepCopy() *ControlPlaneEgress {
+	if in == nil {
+		return nil
+	}
+	out := new(ControlPlaneEgress)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ControllerDeployment) DeepCopyInto(out *ControllerDeployment) {
 	*out = *in
@@ -1383,6 +1631,11 @@ func (in *CoreDNS) DeepCopyInto(out *CoreDNS) {
 		*out = new(CoreDNSRewriting)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.CustomPlugins != nil {
+		in, out := &in.CustomPlugins, &out.CustomPlugins
+		*out = new(CoreDNSCustomPlugins)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1412,6 +1665,81 @@ func (in *CoreDNSAutoscaling) DeepCopy() *CoreDNSAutoscaling {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CoreDNSCustomPlugins) DeepCopyInto(out *CoreDNSCustomPlugins) {
+	*out = *in
+	if in.Log != nil {
+		in, out := &in.Log, &out.Log
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DNS64 != nil {
+		in, out := &in.DNS64, &out.DNS64
+		*out = new(CoreDNSDNS64)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ForwardZones != nil {
+		in, out := &in.ForwardZones, &out.ForwardZones
+		*out = make([]CoreDNSForwardZone, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CoreDNSCustomPlugins.
+func (in *CoreDNSCustomPlugins) DeepCopy() *CoreDNSCustomPlugins {
+	if in == nil {
+		return nil
+	}
+	out := new(CoreDNSCustomPlugins)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CoreDNSDNS64) DeepCopyInto(out *CoreDNSDNS64) {
+	*out = *in
+	if in.Prefix != nil {
+		in, out := &in.Prefix, &out.Prefix
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CoreDNSDNS64.
+func (in *CoreDNSDNS64) DeepCopy() *CoreDNSDNS64 {
+	if in == nil {
+		return nil
+	}
+	out := new(CoreDNSDNS64)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CoreDNSForwardZone) DeepCopyInto(out *CoreDNSForwardZone) {
+	*out = *in
+	if in.Upstreams != nil {
+		in, out := &in.Upstreams, &out.Upstreams
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CoreDNSForwardZone.
+func (in *CoreDNSForwardZone) DeepCopy() *CoreDNSForwardZone {
+	if in == nil {
+		return nil
+	}
+	out := new(CoreDNSForwardZone)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CoreDNSRewriting) DeepCopyInto(out *CoreDNSRewriting) {
 	*out = *in
@@ -1528,6 +1856,32 @@ func (in *DNSProvider) DeepCopy() *DNSProvider {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataResidency) DeepCopyInto(out *DataResidency) {
+	*out = *in
+	if in.Jurisdictions != nil {
+		in, out := &in.Jurisdictions, &out.Jurisdictions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Geographies != nil {
+		in, out := &in.Geographies, &out.Geographies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataResidency.
+func (in *DataResidency) DeepCopy() *DataResidency {
+	if in == nil {
+		return nil
+	}
+	out := new(DataResidency)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DataVolume) DeepCopyInto(out *DataVolume) {
 	*out = *in
@@ -1541,6 +1895,16 @@ func (in *DataVolume) DeepCopyInto(out *DataVolume) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.IOPS != nil {
+		in, out := &in.IOPS, &out.IOPS
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Throughput != nil {
+		in, out := &in.Throughput, &out.Throughput
+		*out = new(int64)
+		**out = **in
+	}
 	return
 }
 
@@ -1571,18 +1935,39 @@ func (in *DeploymentRef) DeepCopy() *DeploymentRef {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DualApprovalForDeletion) DeepCopyInto(out *DualApprovalForDeletion) {
+func (in *DeprecatedField) DeepCopyInto(out *DeprecatedField) {
 	*out = *in
-	in.Selector.DeepCopyInto(&out.Selector)
-	if in.IncludeServiceAccounts != nil {
-		in, out := &in.IncludeServiceAccounts, &out.IncludeServiceAccounts
-		*out = new(bool)
+	if in.ForbiddenFromKubernetesVersion != nil {
+		in, out := &in.ForbiddenFromKubernetesVersion, &out.ForbiddenFromKubernetesVersion
+		*out = new(string)
 		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DualApprovalForDeletion.
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeprecatedField.
+func (in *DeprecatedField) DeepCopy() *DeprecatedField {
+	if in == nil {
+		return nil
+	}
+	out := new(DeprecatedField)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DualApprovalForDeletion) DeepCopyInto(out *DualApprovalForDeletion) {
+	*out = *in
+	in.Selector.DeepCopyInto(&out.Selector)
+	if in.IncludeServiceAccounts != nil {
+		in, out := &in.IncludeServiceAccounts, &out.IncludeServiceAccounts
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DualApprovalForDeletion.
 func (in *DualApprovalForDeletion) DeepCopy() *DualApprovalForDeletion {
 	if in == nil {
 		return nil
@@ -1592,6 +1977,53 @@ func (in *DualApprovalForDeletion) DeepCopy() *DualApprovalForDeletion {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ETCD) DeepCopyInto(out *ETCD) {
+	*out = *in
+	if in.Backup != nil {
+		in, out := &in.Backup, &out.Backup
+		*out = new(ETCDBackup)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ETCD.
+func (in *ETCD) DeepCopy() *ETCD {
+	if in == nil {
+		return nil
+	}
+	out := new(ETCD)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ETCDBackup) DeepCopyInto(out *ETCDBackup) {
+	*out = *in
+	if in.FullSnapshotSchedule != nil {
+		in, out := &in.FullSnapshotSchedule, &out.FullSnapshotSchedule
+		*out = new(string)
+		**out = **in
+	}
+	if in.DeltaSnapshotRetentionPeriod != nil {
+		in, out := &in.DeltaSnapshotRetentionPeriod, &out.DeltaSnapshotRetentionPeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ETCDBackup.
+func (in *ETCDBackup) DeepCopy() *ETCDBackup {
+	if in == nil {
+		return nil
+	}
+	out := new(ETCDBackup)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ETCDEncryptionKeyRotation) DeepCopyInto(out *ETCDEncryptionKeyRotation) {
 	*out = *in
@@ -1645,6 +2077,71 @@ func (in *EncryptionConfig) DeepCopy() *EncryptionConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ErrorTriage) DeepCopyInto(out *ErrorTriage) {
+	*out = *in
+	if in.Suggestions != nil {
+		in, out := &in.Suggestions, &out.Suggestions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ErrorTriage.
+func (in *ErrorTriage) DeepCopy() *ErrorTriage {
+	if in == nil {
+		return nil
+	}
+	out := new(ErrorTriage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EtcdSnapshot) DeepCopyInto(out *EtcdSnapshot) {
+	*out = *in
+	if in.LastInitiationTime != nil {
+		in, out := &in.LastInitiationTime, &out.LastInitiationTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastCompletionTime != nil {
+		in, out := &in.LastCompletionTime, &out.LastCompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Location != nil {
+		in, out := &in.Location, &out.Location
+		*out = new(string)
+		**out = **in
+	}
+	if in.LastRevision != nil {
+		in, out := &in.LastRevision, &out.LastRevision
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Message != nil {
+		in, out := &in.Message, &out.Message
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdSnapshot.
+func (in *EtcdSnapshot) DeepCopy() *EtcdSnapshot {
+	if in == nil {
+		return nil
+	}
+	out := new(EtcdSnapshot)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExpirableVersion) DeepCopyInto(out *ExpirableVersion) {
 	*out = *in
@@ -1680,6 +2177,16 @@ func (in *ExposureClass) DeepCopyInto(out *ExposureClass) {
 		*out = new(ExposureClassScheduling)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.LoadBalancerService != nil {
+		in, out := &in.LoadBalancerService, &out.LoadBalancerService
+		*out = new(ExposureClassLoadBalancerService)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IngressGateway != nil {
+		in, out := &in.IngressGateway, &out.IngressGateway
+		*out = new(ExposureClassIngressGateway)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1701,6 +2208,32 @@ func (in *ExposureClass) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExposureClassIngressGateway) DeepCopyInto(out *ExposureClassIngressGateway) {
+	*out = *in
+	if in.MinReplicas != nil {
+		in, out := &in.MinReplicas, &out.MinReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxReplicas != nil {
+		in, out := &in.MaxReplicas, &out.MaxReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExposureClassIngressGateway.
+func (in *ExposureClassIngressGateway) DeepCopy() *ExposureClassIngressGateway {
+	if in == nil {
+		return nil
+	}
+	out := new(ExposureClassIngressGateway)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExposureClassList) DeepCopyInto(out *ExposureClassList) {
 	*out = *in
@@ -1734,6 +2267,34 @@ func (in *ExposureClassList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExposureClassLoadBalancerService) DeepCopyInto(out *ExposureClassLoadBalancerService) {
+	*out = *in
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.SourceRanges != nil {
+		in, out := &in.SourceRanges, &out.SourceRanges
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExposureClassLoadBalancerService.
+func (in *ExposureClassLoadBalancerService) DeepCopy() *ExposureClassLoadBalancerService {
+	if in == nil {
+		return nil
+	}
+	out := new(ExposureClassLoadBalancerService)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExposureClassScheduling) DeepCopyInto(out *ExposureClassScheduling) {
 	*out = *in
@@ -1840,6 +2401,100 @@ func (in *FailureTolerance) DeepCopy() *FailureTolerance {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FeatureGate) DeepCopyInto(out *FeatureGate) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FeatureGate.
+func (in *FeatureGate) DeepCopy() *FeatureGate {
+	if in == nil {
+		return nil
+	}
+	out := new(FeatureGate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FeatureMatrix) DeepCopyInto(out *FeatureMatrix) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	if in.FeatureGates != nil {
+		in, out := &in.FeatureGates, &out.FeatureGates
+		*out = make([]FeatureGate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DeprecatedFields != nil {
+		in, out := &in.DeprecatedFields, &out.DeprecatedFields
+		*out = make([]DeprecatedField, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FeatureMatrix.
+func (in *FeatureMatrix) DeepCopy() *FeatureMatrix {
+	if in == nil {
+		return nil
+	}
+	out := new(FeatureMatrix)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FeatureMatrix) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FeatureMatrixList) DeepCopyInto(out *FeatureMatrixList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]FeatureMatrix, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FeatureMatrixList.
+func (in *FeatureMatrixList) DeepCopy() *FeatureMatrixList {
+	if in == nil {
+		return nil
+	}
+	out := new(FeatureMatrixList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FeatureMatrixList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Gardener) DeepCopyInto(out *Gardener) {
 	*out = *in
@@ -1957,6 +2612,11 @@ func (in *HibernationSchedule) DeepCopyInto(out *HibernationSchedule) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Exceptions != nil {
+		in, out := &in.Exceptions, &out.Exceptions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -2032,6 +2692,11 @@ func (in *HorizontalPodAutoscalerConfig) DeepCopy() *HorizontalPodAutoscalerConf
 func (in *Ingress) DeepCopyInto(out *Ingress) {
 	*out = *in
 	in.Controller.DeepCopyInto(&out.Controller)
+	if in.KubeAPIServerPorts != nil {
+		in, out := &in.KubeAPIServerPorts, &out.KubeAPIServerPorts
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -2220,35 +2885,117 @@ func (in *KubeAPIServerConfig) DeepCopyInto(out *KubeAPIServerConfig) {
 		*out = new(int64)
 		**out = **in
 	}
-	if in.DefaultUnreachableTolerationSeconds != nil {
-		in, out := &in.DefaultUnreachableTolerationSeconds, &out.DefaultUnreachableTolerationSeconds
-		*out = new(int64)
+	if in.DefaultUnreachableTolerationSeconds != nil {
+		in, out := &in.DefaultUnreachableTolerationSeconds, &out.DefaultUnreachableTolerationSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.EncryptionConfig != nil {
+		in, out := &in.EncryptionConfig, &out.EncryptionConfig
+		*out = new(EncryptionConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StructuredAuthentication != nil {
+		in, out := &in.StructuredAuthentication, &out.StructuredAuthentication
+		*out = new(StructuredAuthentication)
+		**out = **in
+	}
+	if in.StructuredAuthorization != nil {
+		in, out := &in.StructuredAuthorization, &out.StructuredAuthorization
+		*out = new(StructuredAuthorization)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AuthorizedNetworks != nil {
+		in, out := &in.AuthorizedNetworks, &out.AuthorizedNetworks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(KubeAPIServerEndpoint)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.APIPriorityAndFairness != nil {
+		in, out := &in.APIPriorityAndFairness, &out.APIPriorityAndFairness
+		*out = new(APIPriorityAndFairness)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VPN != nil {
+		in, out := &in.VPN, &out.VPN
+		*out = new(KubeAPIServerVPNConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeAPIServerConfig.
+func (in *KubeAPIServerConfig) DeepCopy() *KubeAPIServerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeAPIServerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeAPIServerEndpoint) DeepCopyInto(out *KubeAPIServerEndpoint) {
+	*out = *in
+	if in.AdditionalHostnames != nil {
+		in, out := &in.AdditionalHostnames, &out.AdditionalHostnames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ManagedCertificate != nil {
+		in, out := &in.ManagedCertificate, &out.ManagedCertificate
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeAPIServerEndpoint.
+func (in *KubeAPIServerEndpoint) DeepCopy() *KubeAPIServerEndpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeAPIServerEndpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeAPIServerVPNConfig) DeepCopyInto(out *KubeAPIServerVPNConfig) {
+	*out = *in
+	if in.HighAvailability != nil {
+		in, out := &in.HighAvailability, &out.HighAvailability
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SeedServers != nil {
+		in, out := &in.SeedServers, &out.SeedServers
+		*out = new(int32)
 		**out = **in
 	}
-	if in.EncryptionConfig != nil {
-		in, out := &in.EncryptionConfig, &out.EncryptionConfig
-		*out = new(EncryptionConfig)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.StructuredAuthentication != nil {
-		in, out := &in.StructuredAuthentication, &out.StructuredAuthentication
-		*out = new(StructuredAuthentication)
+	if in.ShootClients != nil {
+		in, out := &in.ShootClients, &out.ShootClients
+		*out = new(int32)
 		**out = **in
 	}
-	if in.StructuredAuthorization != nil {
-		in, out := &in.StructuredAuthorization, &out.StructuredAuthorization
-		*out = new(StructuredAuthorization)
-		(*in).DeepCopyInto(*out)
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeAPIServerConfig.
-func (in *KubeAPIServerConfig) DeepCopy() *KubeAPIServerConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeAPIServerVPNConfig.
+func (in *KubeAPIServerVPNConfig) DeepCopy() *KubeAPIServerVPNConfig {
 	if in == nil {
 		return nil
 	}
-	out := new(KubeAPIServerConfig)
+	out := new(KubeAPIServerVPNConfig)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -2277,6 +3024,13 @@ func (in *KubeControllerManagerConfig) DeepCopyInto(out *KubeControllerManagerCo
 		*out = new(metav1.Duration)
 		**out = **in
 	}
+	if in.Controllers != nil {
+		in, out := &in.Controllers, &out.Controllers
+		*out = make([]KubeControllerManagerController, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -2290,6 +3044,32 @@ func (in *KubeControllerManagerConfig) DeepCopy() *KubeControllerManagerConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeControllerManagerController) DeepCopyInto(out *KubeControllerManagerController) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeControllerManagerController.
+func (in *KubeControllerManagerController) DeepCopy() *KubeControllerManagerController {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeControllerManagerController)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubeProxyConfig) DeepCopyInto(out *KubeProxyConfig) {
 	*out = *in
@@ -2468,6 +3248,18 @@ func (in *KubeletConfig) DeepCopyInto(out *KubeletConfig) {
 		*out = new(MemorySwapConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.MemoryManagerPolicy != nil {
+		in, out := &in.MemoryManagerPolicy, &out.MemoryManagerPolicy
+		*out = new(MemoryManagerPolicy)
+		**out = **in
+	}
+	if in.ReservedMemory != nil {
+		in, out := &in.ReservedMemory, &out.ReservedMemory
+		*out = make([]KubeletMemoryReservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -2640,6 +3432,29 @@ func (in *KubeletConfigReserved) DeepCopy() *KubeletConfigReserved {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeletMemoryReservation) DeepCopyInto(out *KubeletMemoryReservation) {
+	*out = *in
+	if in.Limits != nil {
+		in, out := &in.Limits, &out.Limits
+		*out = make(v1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeletMemoryReservation.
+func (in *KubeletMemoryReservation) DeepCopy() *KubeletMemoryReservation {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeletMemoryReservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Kubernetes) DeepCopyInto(out *Kubernetes) {
 	*out = *in
@@ -2683,6 +3498,11 @@ func (in *Kubernetes) DeepCopyInto(out *Kubernetes) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.NamespaceDefaults != nil {
+		in, out := &in.NamespaceDefaults, &out.NamespaceDefaults
+		*out = new(NamespaceDefaults)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2751,6 +3571,11 @@ func (in *KubernetesSettings) DeepCopyInto(out *KubernetesSettings) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.MaxExpirationExtension != nil {
+		in, out := &in.MaxExpirationExtension, &out.MaxExpirationExtension
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -2781,6 +3606,11 @@ func (in *LastError) DeepCopyInto(out *LastError) {
 		in, out := &in.LastUpdateTime, &out.LastUpdateTime
 		*out = (*in).DeepCopy()
 	}
+	if in.Triage != nil {
+		in, out := &in.Triage, &out.Triage
+		*out = new(ErrorTriage)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2833,6 +3663,23 @@ func (in *LastOperation) DeepCopy() *LastOperation {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LegacyComponentRemoval) DeepCopyInto(out *LegacyComponentRemoval) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LegacyComponentRemoval.
+func (in *LegacyComponentRemoval) DeepCopy() *LegacyComponentRemoval {
+	if in == nil {
+		return nil
+	}
+	out := new(LegacyComponentRemoval)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LoadBalancerServicesProxyProtocol) DeepCopyInto(out *LoadBalancerServicesProxyProtocol) {
 	*out = *in
@@ -2862,6 +3709,11 @@ func (in *Machine) DeepCopyInto(out *Machine) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.OSFamily != nil {
+		in, out := &in.OSFamily, &out.OSFamily
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -2903,6 +3755,16 @@ func (in *MachineControllerManagerSettings) DeepCopyInto(out *MachineControllerM
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.MachineBootstrapTimeout != nil {
+		in, out := &in.MachineBootstrapTimeout, &out.MachineBootstrapTimeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MachineBootstrapFailurePolicy != nil {
+		in, out := &in.MachineBootstrapFailurePolicy, &out.MachineBootstrapFailurePolicy
+		*out = new(MachineBootstrapFailurePolicy)
+		**out = **in
+	}
 	return
 }
 
@@ -2978,6 +3840,22 @@ func (in *MachineImageVersion) DeepCopy() *MachineImageVersion {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineImageVersionUsage) DeepCopyInto(out *MachineImageVersionUsage) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineImageVersionUsage.
+func (in *MachineImageVersionUsage) DeepCopy() *MachineImageVersionUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineImageVersionUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MachineType) DeepCopyInto(out *MachineType) {
 	*out = *in
@@ -2999,6 +3877,18 @@ func (in *MachineType) DeepCopyInto(out *MachineType) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.CapacityTypes != nil {
+		in, out := &in.CapacityTypes, &out.CapacityTypes
+		*out = make([]CapacityType, len(*in))
+		copy(*out, *in)
+	}
+	if in.Regions != nil {
+		in, out := &in.Regions, &out.Regions
+		*out = make([]TypeRegionAvailability, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -3056,6 +3946,18 @@ func (in *Maintenance) DeepCopyInto(out *Maintenance) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Exclusions != nil {
+		in, out := &in.Exclusions, &out.Exclusions
+		*out = make([]MaintenanceExclusion, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.WorkerPools != nil {
+		in, out := &in.WorkerPools, &out.WorkerPools
+		*out = make([]WorkerPoolMaintenance, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -3090,6 +3992,29 @@ func (in *MaintenanceAutoUpdate) DeepCopy() *MaintenanceAutoUpdate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceExclusion) DeepCopyInto(out *MaintenanceExclusion) {
+	*out = *in
+	in.Begin.DeepCopyInto(&out.Begin)
+	in.End.DeepCopyInto(&out.End)
+	if in.Operations != nil {
+		in, out := &in.Operations, &out.Operations
+		*out = make([]MaintenanceExclusionOperation, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceExclusion.
+func (in *MaintenanceExclusion) DeepCopy() *MaintenanceExclusion {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceExclusion)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MaintenanceTimeWindow) DeepCopyInto(out *MaintenanceTimeWindow) {
 	*out = *in
@@ -3106,6 +4031,27 @@ func (in *MaintenanceTimeWindow) DeepCopy() *MaintenanceTimeWindow {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedAddon) DeepCopyInto(out *ManagedAddon) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedAddon.
+func (in *ManagedAddon) DeepCopy() *ManagedAddon {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedAddon)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MemorySwapConfiguration) DeepCopyInto(out *MemorySwapConfiguration) {
 	*out = *in
@@ -3165,6 +4111,96 @@ func (in *NamedResourceReference) DeepCopy() *NamedResourceReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceDefaults) DeepCopyInto(out *NamespaceDefaults) {
+	*out = *in
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LimitRanges != nil {
+		in, out := &in.LimitRanges, &out.LimitRanges
+		*out = make([]NamespaceDefaultsLimitRange, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ResourceQuotas != nil {
+		in, out := &in.ResourceQuotas, &out.ResourceQuotas
+		*out = make([]NamespaceDefaultsResourceQuota, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NetworkPolicies != nil {
+		in, out := &in.NetworkPolicies, &out.NetworkPolicies
+		*out = new(NamespaceDefaultsNetworkPolicies)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceDefaults.
+func (in *NamespaceDefaults) DeepCopy() *NamespaceDefaults {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceDefaults)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceDefaultsLimitRange) DeepCopyInto(out *NamespaceDefaultsLimitRange) {
+	*out = *in
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceDefaultsLimitRange.
+func (in *NamespaceDefaultsLimitRange) DeepCopy() *NamespaceDefaultsLimitRange {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceDefaultsLimitRange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceDefaultsNetworkPolicies) DeepCopyInto(out *NamespaceDefaultsNetworkPolicies) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceDefaultsNetworkPolicies.
+func (in *NamespaceDefaultsNetworkPolicies) DeepCopy() *NamespaceDefaultsNetworkPolicies {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceDefaultsNetworkPolicies)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceDefaultsResourceQuota) DeepCopyInto(out *NamespaceDefaultsResourceQuota) {
+	*out = *in
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceDefaultsResourceQuota.
+func (in *NamespaceDefaultsResourceQuota) DeepCopy() *NamespaceDefaultsResourceQuota {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceDefaultsResourceQuota)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NamespacedCloudProfile) DeepCopyInto(out *NamespacedCloudProfile) {
 	*out = *in
@@ -3365,6 +4401,11 @@ func (in *NetworkingStatus) DeepCopyInto(out *NetworkingStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ControlPlaneEgressCIDRs != nil {
+		in, out := &in.ControlPlaneEgressCIDRs, &out.ControlPlaneEgressCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -3402,43 +4443,105 @@ func (in *NginxIngress) DeepCopyInto(out *NginxIngress) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NginxIngress.
-func (in *NginxIngress) DeepCopy() *NginxIngress {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NginxIngress.
+func (in *NginxIngress) DeepCopy() *NginxIngress {
+	if in == nil {
+		return nil
+	}
+	out := new(NginxIngress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeLocalDNS) DeepCopyInto(out *NodeLocalDNS) {
+	*out = *in
+	if in.ForceTCPToClusterDNS != nil {
+		in, out := &in.ForceTCPToClusterDNS, &out.ForceTCPToClusterDNS
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ForceTCPToUpstreamDNS != nil {
+		in, out := &in.ForceTCPToUpstreamDNS, &out.ForceTCPToUpstreamDNS
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DisableForwardToUpstreamDNS != nil {
+		in, out := &in.DisableForwardToUpstreamDNS, &out.DisableForwardToUpstreamDNS
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Cache != nil {
+		in, out := &in.Cache, &out.Cache
+		*out = new(NodeLocalDNSCache)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ZoneProtocols != nil {
+		in, out := &in.ZoneProtocols, &out.ZoneProtocols
+		*out = make([]NodeLocalDNSZoneProtocol, len(*in))
+		copy(*out, *in)
+	}
+	if in.DisabledWorkerPools != nil {
+		in, out := &in.DisabledWorkerPools, &out.DisabledWorkerPools
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeLocalDNS.
+func (in *NodeLocalDNS) DeepCopy() *NodeLocalDNS {
 	if in == nil {
 		return nil
 	}
-	out := new(NginxIngress)
+	out := new(NodeLocalDNS)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *NodeLocalDNS) DeepCopyInto(out *NodeLocalDNS) {
+func (in *NodeLocalDNSCache) DeepCopyInto(out *NodeLocalDNSCache) {
 	*out = *in
-	if in.ForceTCPToClusterDNS != nil {
-		in, out := &in.ForceTCPToClusterDNS, &out.ForceTCPToClusterDNS
-		*out = new(bool)
+	if in.SuccessTTLSeconds != nil {
+		in, out := &in.SuccessTTLSeconds, &out.SuccessTTLSeconds
+		*out = new(int32)
 		**out = **in
 	}
-	if in.ForceTCPToUpstreamDNS != nil {
-		in, out := &in.ForceTCPToUpstreamDNS, &out.ForceTCPToUpstreamDNS
-		*out = new(bool)
+	if in.DenialTTLSeconds != nil {
+		in, out := &in.DenialTTLSeconds, &out.DenialTTLSeconds
+		*out = new(int32)
 		**out = **in
 	}
-	if in.DisableForwardToUpstreamDNS != nil {
-		in, out := &in.DisableForwardToUpstreamDNS, &out.DisableForwardToUpstreamDNS
+	if in.DisableNegativeCaching != nil {
+		in, out := &in.DisableNegativeCaching, &out.DisableNegativeCaching
 		*out = new(bool)
 		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeLocalDNS.
-func (in *NodeLocalDNS) DeepCopy() *NodeLocalDNS {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeLocalDNSCache.
+func (in *NodeLocalDNSCache) DeepCopy() *NodeLocalDNSCache {
 	if in == nil {
 		return nil
 	}
-	out := new(NodeLocalDNS)
+	out := new(NodeLocalDNSCache)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeLocalDNSZoneProtocol) DeepCopyInto(out *NodeLocalDNSZoneProtocol) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeLocalDNSZoneProtocol.
+func (in *NodeLocalDNSZoneProtocol) DeepCopy() *NodeLocalDNSZoneProtocol {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeLocalDNSZoneProtocol)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -3599,6 +4702,38 @@ func (in *OpenIDConnectClientAuthentication) DeepCopy() *OpenIDConnectClientAuth
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PendingMaintenanceOperation) DeepCopyInto(out *PendingMaintenanceOperation) {
+	*out = *in
+	if in.WorkerPool != nil {
+		in, out := &in.WorkerPool, &out.WorkerPool
+		*out = new(string)
+		**out = **in
+	}
+	if in.CurrentVersion != nil {
+		in, out := &in.CurrentVersion, &out.CurrentVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.TargetVersion != nil {
+		in, out := &in.TargetVersion, &out.TargetVersion
+		*out = new(string)
+		**out = **in
+	}
+	in.ScheduledTime.DeepCopyInto(&out.ScheduledTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PendingMaintenanceOperation.
+func (in *PendingMaintenanceOperation) DeepCopy() *PendingMaintenanceOperation {
+	if in == nil {
+		return nil
+	}
+	out := new(PendingMaintenanceOperation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Project) DeepCopyInto(out *Project) {
 	*out = *in
@@ -3682,6 +4817,55 @@ func (in *ProjectMember) DeepCopy() *ProjectMember {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectMemberSync) DeepCopyInto(out *ProjectMemberSync) {
+	*out = *in
+	if in.GroupPrefix != nil {
+		in, out := &in.GroupPrefix, &out.GroupPrefix
+		*out = new(string)
+		**out = **in
+	}
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]ProjectMemberSyncGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectMemberSync.
+func (in *ProjectMemberSync) DeepCopy() *ProjectMemberSync {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectMemberSync)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectMemberSyncGroup) DeepCopyInto(out *ProjectMemberSyncGroup) {
+	*out = *in
+	if in.Roles != nil {
+		in, out := &in.Roles, &out.Roles
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectMemberSyncGroup.
+func (in *ProjectMemberSyncGroup) DeepCopy() *ProjectMemberSyncGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectMemberSyncGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProjectSpec) DeepCopyInto(out *ProjectSpec) {
 	*out = *in
@@ -3729,6 +4913,23 @@ func (in *ProjectSpec) DeepCopyInto(out *ProjectSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.TrustedIdentityProviders != nil {
+		in, out := &in.TrustedIdentityProviders, &out.TrustedIdentityProviders
+		*out = make([]TrustedIdentityProvider, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Quotas != nil {
+		in, out := &in.Quotas, &out.Quotas
+		*out = make([]v1.ObjectReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.MemberSync != nil {
+		in, out := &in.MemberSync, &out.MemberSync
+		*out = new(ProjectMemberSync)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -3949,6 +5150,11 @@ func (in *Region) DeepCopyInto(out *Region) {
 		*out = make([]AccessRestriction, len(*in))
 		copy(*out, *in)
 	}
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = new(RegionMetadata)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -3962,6 +5168,37 @@ func (in *Region) DeepCopy() *Region {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegionMetadata) DeepCopyInto(out *RegionMetadata) {
+	*out = *in
+	if in.Geography != nil {
+		in, out := &in.Geography, &out.Geography
+		*out = new(string)
+		**out = **in
+	}
+	if in.Jurisdiction != nil {
+		in, out := &in.Jurisdiction, &out.Jurisdiction
+		*out = new(string)
+		**out = **in
+	}
+	if in.LatencyMatrixKey != nil {
+		in, out := &in.LatencyMatrixKey, &out.LatencyMatrixKey
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegionMetadata.
+func (in *RegionMetadata) DeepCopy() *RegionMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(RegionMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceData) DeepCopyInto(out *ResourceData) {
 	*out = *in
@@ -4145,6 +5382,16 @@ func (in *SeedBackup) DeepCopyInto(out *SeedBackup) {
 		**out = **in
 	}
 	out.SecretRef = in.SecretRef
+	if in.Immutability != nil {
+		in, out := &in.Immutability, &out.Immutability
+		*out = new(BackupBucketImmutability)
+		**out = **in
+	}
+	if in.ETCD != nil {
+		in, out := &in.ETCD, &out.ETCD
+		*out = new(SeedBackupETCD)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -4158,6 +5405,74 @@ func (in *SeedBackup) DeepCopy() *SeedBackup {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedBackupETCD) DeepCopyInto(out *SeedBackupETCD) {
+	*out = *in
+	if in.MinFullSnapshotInterval != nil {
+		in, out := &in.MinFullSnapshotInterval, &out.MinFullSnapshotInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MaxDeltaSnapshotRetentionPeriod != nil {
+		in, out := &in.MaxDeltaSnapshotRetentionPeriod, &out.MaxDeltaSnapshotRetentionPeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedBackupETCD.
+func (in *SeedBackupETCD) DeepCopy() *SeedBackupETCD {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedBackupETCD)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedCredentials) DeepCopyInto(out *SeedCredentials) {
+	*out = *in
+	if in.Rotation != nil {
+		in, out := &in.Rotation, &out.Rotation
+		*out = new(SeedCredentialsRotation)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedCredentials.
+func (in *SeedCredentials) DeepCopy() *SeedCredentials {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedCredentials)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedCredentialsRotation) DeepCopyInto(out *SeedCredentialsRotation) {
+	*out = *in
+	if in.CertificateAuthorities != nil {
+		in, out := &in.CertificateAuthorities, &out.CertificateAuthorities
+		*out = new(CARotation)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedCredentialsRotation.
+func (in *SeedCredentialsRotation) DeepCopy() *SeedCredentialsRotation {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedCredentialsRotation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SeedDNS) DeepCopyInto(out *SeedDNS) {
 	*out = *in
@@ -4196,6 +5511,27 @@ func (in *SeedDNSProvider) DeepCopy() *SeedDNSProvider {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedIngressDomainMigration) DeepCopyInto(out *SeedIngressDomainMigration) {
+	*out = *in
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedIngressDomainMigration.
+func (in *SeedIngressDomainMigration) DeepCopy() *SeedIngressDomainMigration {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedIngressDomainMigration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SeedList) DeepCopyInto(out *SeedList) {
 	*out = *in
@@ -4229,6 +5565,23 @@ func (in *SeedList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedMaintenance) DeepCopyInto(out *SeedMaintenance) {
+	*out = *in
+	out.TimeWindow = in.TimeWindow
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedMaintenance.
+func (in *SeedMaintenance) DeepCopy() *SeedMaintenance {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedMaintenance)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SeedNetworks) DeepCopyInto(out *SeedNetworks) {
 	*out = *in
@@ -4265,6 +5618,33 @@ func (in *SeedNetworks) DeepCopy() *SeedNetworks {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedPendingMaintenanceOperation) DeepCopyInto(out *SeedPendingMaintenanceOperation) {
+	*out = *in
+	if in.CurrentVersion != nil {
+		in, out := &in.CurrentVersion, &out.CurrentVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.TargetVersion != nil {
+		in, out := &in.TargetVersion, &out.TargetVersion
+		*out = new(string)
+		**out = **in
+	}
+	in.ScheduledTime.DeepCopyInto(&out.ScheduledTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedPendingMaintenanceOperation.
+func (in *SeedPendingMaintenanceOperation) DeepCopy() *SeedPendingMaintenanceOperation {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedPendingMaintenanceOperation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SeedProvider) DeepCopyInto(out *SeedProvider) {
 	*out = *in
@@ -4278,6 +5658,11 @@ func (in *SeedProvider) DeepCopyInto(out *SeedProvider) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.RegionMetadata != nil {
+		in, out := &in.RegionMetadata, &out.RegionMetadata
+		*out = new(RegionMetadata)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -4313,6 +5698,27 @@ func (in *SeedSelector) DeepCopy() *SeedSelector {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedSettingACME) DeepCopyInto(out *SeedSettingACME) {
+	*out = *in
+	if in.Email != nil {
+		in, out := &in.Email, &out.Email
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedSettingACME.
+func (in *SeedSettingACME) DeepCopy() *SeedSettingACME {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedSettingACME)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SeedSettingDependencyWatchdog) DeepCopyInto(out *SeedSettingDependencyWatchdog) {
 	*out = *in
@@ -4590,6 +5996,11 @@ func (in *SeedSettings) DeepCopyInto(out *SeedSettings) {
 		*out = new(SeedSettingTopologyAwareRouting)
 		**out = **in
 	}
+	if in.ACME != nil {
+		in, out := &in.ACME, &out.ACME
+		*out = new(SeedSettingACME)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -4641,6 +6052,11 @@ func (in *SeedSpec) DeepCopyInto(out *SeedSpec) {
 		*out = make([]AccessRestriction, len(*in))
 		copy(*out, *in)
 	}
+	if in.Maintenance != nil {
+		in, out := &in.Maintenance, &out.Maintenance
+		*out = new(SeedMaintenance)
+		**out = **in
+	}
 	return
 }
 
@@ -4702,6 +6118,28 @@ func (in *SeedStatus) DeepCopyInto(out *SeedStatus) {
 		*out = new(LastOperation)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.IngressDomainMigration != nil {
+		in, out := &in.IngressDomainMigration, &out.IngressDomainMigration
+		*out = new(SeedIngressDomainMigration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Credentials != nil {
+		in, out := &in.Credentials, &out.Credentials
+		*out = new(SeedCredentials)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SystemComponentsVersion != nil {
+		in, out := &in.SystemComponentsVersion, &out.SystemComponentsVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.PendingMaintenanceOperations != nil {
+		in, out := &in.PendingMaintenanceOperations, &out.PendingMaintenanceOperations
+		*out = make([]SeedPendingMaintenanceOperation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -4723,6 +6161,11 @@ func (in *SeedTaint) DeepCopyInto(out *SeedTaint) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Effect != nil {
+		in, out := &in.Effect, &out.Effect
+		*out = new(SeedTaintEffect)
+		**out = **in
+	}
 	return
 }
 
@@ -4908,6 +6351,104 @@ func (in *ShootAdvertisedAddress) DeepCopy() *ShootAdvertisedAddress {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootClass) DeepCopyInto(out *ShootClass) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootClass.
+func (in *ShootClass) DeepCopy() *ShootClass {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootClass)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ShootClass) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootClassList) DeepCopyInto(out *ShootClassList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ShootClass, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootClassList.
+func (in *ShootClassList) DeepCopy() *ShootClassList {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootClassList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ShootClassList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootClassSpec) DeepCopyInto(out *ShootClassSpec) {
+	*out = *in
+	if in.ProviderType != nil {
+		in, out := &in.ProviderType, &out.ProviderType
+		*out = new(string)
+		**out = **in
+	}
+	if in.Workers != nil {
+		in, out := &in.Workers, &out.Workers
+		*out = make([]Worker, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ControlPlane != nil {
+		in, out := &in.ControlPlane, &out.ControlPlane
+		*out = new(ControlPlane)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Hibernation != nil {
+		in, out := &in.Hibernation, &out.Hibernation
+		*out = new(Hibernation)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootClassSpec.
+func (in *ShootClassSpec) DeepCopy() *ShootClassSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootClassSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootCredentials) DeepCopyInto(out *ShootCredentials) {
 	*out = *in
@@ -5084,6 +6625,23 @@ func (in *ShootNetworks) DeepCopy() *ShootNetworks {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootRemediation) DeepCopyInto(out *ShootRemediation) {
+	*out = *in
+	in.LastExecutionTime.DeepCopyInto(&out.LastExecutionTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootRemediation.
+func (in *ShootRemediation) DeepCopy() *ShootRemediation {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootRemediation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootSSHKeypairRotation) DeepCopyInto(out *ShootSSHKeypairRotation) {
 	*out = *in
@@ -5224,6 +6782,25 @@ func (in *ShootSpec) DeepCopyInto(out *ShootSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.DataResidency != nil {
+		in, out := &in.DataResidency, &out.DataResidency
+		*out = new(DataResidency)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ETCD != nil {
+		in, out := &in.ETCD, &out.ETCD
+		*out = new(ETCD)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExpirationTimestamp != nil {
+		in, out := &in.ExpirationTimestamp, &out.ExpirationTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.ShootClassName != nil {
+		in, out := &in.ShootClassName, &out.ShootClassName
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -5411,6 +6988,37 @@ func (in *ShootStatus) DeepCopyInto(out *ShootStatus) {
 		*out = new(NetworkingStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SizeClass != nil {
+		in, out := &in.SizeClass, &out.SizeClass
+		*out = new(ShootSizeClass)
+		**out = **in
+	}
+	if in.LegacyComponentRemovals != nil {
+		in, out := &in.LegacyComponentRemovals, &out.LegacyComponentRemovals
+		*out = make([]LegacyComponentRemoval, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.EtcdSnapshot != nil {
+		in, out := &in.EtcdSnapshot, &out.EtcdSnapshot
+		*out = new(EtcdSnapshot)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PendingMaintenanceOperations != nil {
+		in, out := &in.PendingMaintenanceOperations, &out.PendingMaintenanceOperations
+		*out = make([]PendingMaintenanceOperation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Remediations != nil {
+		in, out := &in.Remediations, &out.Remediations
+		*out = make([]ShootRemediation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -5492,6 +7100,11 @@ func (in *SystemComponents) DeepCopyInto(out *SystemComponents) {
 		*out = new(NodeLocalDNS)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.DedicatedPool != nil {
+		in, out := &in.DedicatedPool, &out.DedicatedPool
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -5526,6 +7139,79 @@ func (in *Toleration) DeepCopy() *Toleration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrustedIdentityProvider) DeepCopyInto(out *TrustedIdentityProvider) {
+	*out = *in
+	if in.Audiences != nil {
+		in, out := &in.Audiences, &out.Audiences
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Subjects != nil {
+		in, out := &in.Subjects, &out.Subjects
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Access != nil {
+		in, out := &in.Access, &out.Access
+		*out = new(TrustedIdentityProviderAccess)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrustedIdentityProvider.
+func (in *TrustedIdentityProvider) DeepCopy() *TrustedIdentityProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(TrustedIdentityProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TypeRegionAvailability) DeepCopyInto(out *TypeRegionAvailability) {
+	*out = *in
+	if in.Zones != nil {
+		in, out := &in.Zones, &out.Zones
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PricingClass != nil {
+		in, out := &in.PricingClass, &out.PricingClass
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TypeRegionAvailability.
+func (in *TypeRegionAvailability) DeepCopy() *TypeRegionAvailability {
+	if in == nil {
+		return nil
+	}
+	out := new(TypeRegionAvailability)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VersionUsage) DeepCopyInto(out *VersionUsage) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VersionUsage.
+func (in *VersionUsage) DeepCopy() *VersionUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(VersionUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VerticalPodAutoscaler) DeepCopyInto(out *VerticalPodAutoscaler) {
 	*out = *in
@@ -5635,6 +7321,16 @@ func (in *Volume) DeepCopyInto(out *Volume) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.IOPS != nil {
+		in, out := &in.IOPS, &out.IOPS
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Throughput != nil {
+		in, out := &in.Throughput, &out.Throughput
+		*out = new(int64)
+		**out = **in
+	}
 	return
 }
 
@@ -5661,6 +7357,23 @@ func (in *VolumeType) DeepCopyInto(out *VolumeType) {
 		x := (*in).DeepCopy()
 		*out = &x
 	}
+	if in.MaxIOPS != nil {
+		in, out := &in.MaxIOPS, &out.MaxIOPS
+		*out = new(int64)
+		**out = **in
+	}
+	if in.MaxThroughput != nil {
+		in, out := &in.MaxThroughput, &out.MaxThroughput
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Regions != nil {
+		in, out := &in.Regions, &out.Regions
+		*out = make([]TypeRegionAvailability, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -5782,7 +7495,7 @@ func (in *Worker) DeepCopyInto(out *Worker) {
 	if in.SystemComponents != nil {
 		in, out := &in.SystemComponents, &out.SystemComponents
 		*out = new(WorkerSystemComponents)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.MachineControllerManagerSettings != nil {
 		in, out := &in.MachineControllerManagerSettings, &out.MachineControllerManagerSettings
@@ -5801,6 +7514,31 @@ func (in *Worker) DeepCopyInto(out *Worker) {
 		*out = new(ClusterAutoscalerOptions)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.CapacityType != nil {
+		in, out := &in.CapacityType, &out.CapacityType
+		*out = new(CapacityType)
+		**out = **in
+	}
+	if in.UpdateStrategy != nil {
+		in, out := &in.UpdateStrategy, &out.UpdateStrategy
+		*out = new(WorkerUpdateStrategy)
+		**out = **in
+	}
+	if in.RolloutStrategy != nil {
+		in, out := &in.RolloutStrategy, &out.RolloutStrategy
+		*out = new(WorkerRolloutStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NodeTemplate != nil {
+		in, out := &in.NodeTemplate, &out.NodeTemplate
+		*out = new(WorkerNodeTemplate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KernelModules != nil {
+		in, out := &in.KernelModules, &out.KernelModules
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -5840,9 +7578,73 @@ func (in *WorkerKubernetes) DeepCopy() *WorkerKubernetes {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerNodeTemplate) DeepCopyInto(out *WorkerNodeTemplate) {
+	*out = *in
+	if in.EnforceLabels != nil {
+		in, out := &in.EnforceLabels, &out.EnforceLabels
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerNodeTemplate.
+func (in *WorkerNodeTemplate) DeepCopy() *WorkerNodeTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerNodeTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerPoolMaintenance) DeepCopyInto(out *WorkerPoolMaintenance) {
+	*out = *in
+	out.TimeWindow = in.TimeWindow
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerPoolMaintenance.
+func (in *WorkerPoolMaintenance) DeepCopy() *WorkerPoolMaintenance {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerPoolMaintenance)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerRolloutStrategy) DeepCopyInto(out *WorkerRolloutStrategy) {
+	*out = *in
+	if in.Order != nil {
+		in, out := &in.Order, &out.Order
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerRolloutStrategy.
+func (in *WorkerRolloutStrategy) DeepCopy() *WorkerRolloutStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerRolloutStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkerSystemComponents) DeepCopyInto(out *WorkerSystemComponents) {
 	*out = *in
+	if in.ReservedNodesPercentage != nil {
+		in, out := &in.ReservedNodesPercentage, &out.ReservedNodesPercentage
+		*out = new(int32)
+		**out = **in
+	}
 	return
 }
 
@@ -30,6 +30,8 @@ func RegisterDefaults(scheme *runtime.Scheme) error {
 	scheme.AddTypeDefaultingFunc(&Seed{}, func(obj interface{}) { SetObjectDefaults_Seed(obj.(*Seed)) })
 	scheme.AddTypeDefaultingFunc(&SeedList{}, func(obj interface{}) { SetObjectDefaults_SeedList(obj.(*SeedList)) })
 	scheme.AddTypeDefaultingFunc(&Shoot{}, func(obj interface{}) { SetObjectDefaults_Shoot(obj.(*Shoot)) })
+	scheme.AddTypeDefaultingFunc(&ShootClass{}, func(obj interface{}) { SetObjectDefaults_ShootClass(obj.(*ShootClass)) })
+	scheme.AddTypeDefaultingFunc(&ShootClassList{}, func(obj interface{}) { SetObjectDefaults_ShootClassList(obj.(*ShootClassList)) })
 	scheme.AddTypeDefaultingFunc(&ShootList{}, func(obj interface{}) { SetObjectDefaults_ShootList(obj.(*ShootList)) })
 	return nil
 }
@@ -46,10 +48,22 @@ func SetObjectDefaults_CloudProfile(in *CloudProfile) {
 	for i := range in.Spec.MachineTypes {
 		a := &in.Spec.MachineTypes[i]
 		SetDefaults_MachineType(a)
+		if a.Usable == nil {
+			var ptrVar1 bool = true
+			a.Usable = &ptrVar1
+		}
+		if a.Architecture == nil {
+			var ptrVar1 string = "amd64"
+			a.Architecture = &ptrVar1
+		}
 	}
 	for i := range in.Spec.VolumeTypes {
 		a := &in.Spec.VolumeTypes[i]
 		SetDefaults_VolumeType(a)
+		if a.Usable == nil {
+			var ptrVar1 bool = true
+			a.Usable = &ptrVar1
+		}
 	}
 }
 
@@ -98,6 +112,10 @@ func SetObjectDefaults_Project(in *Project) {
 		a := &in.Spec.Members[i]
 		SetDefaults_ProjectMember(a)
 	}
+	for i := range in.Spec.TrustedIdentityProviders {
+		a := &in.Spec.TrustedIdentityProviders[i]
+		SetDefaults_TrustedIdentityProvider(a)
+	}
 }
 
 func SetObjectDefaults_ProjectList(in *ProjectList) {
@@ -145,6 +163,30 @@ func SetObjectDefaults_Shoot(in *Shoot) {
 	}
 	if in.Spec.Kubernetes.ClusterAutoscaler != nil {
 		SetDefaults_ClusterAutoscaler(in.Spec.Kubernetes.ClusterAutoscaler)
+		if in.Spec.Kubernetes.ClusterAutoscaler.ScaleDownUtilizationThreshold == nil {
+			var ptrVar1 float64 = 0.5
+			in.Spec.Kubernetes.ClusterAutoscaler.ScaleDownUtilizationThreshold = &ptrVar1
+		}
+		if in.Spec.Kubernetes.ClusterAutoscaler.Expander == nil {
+			var ptrVar1 ExpanderMode = "least-waste"
+			in.Spec.Kubernetes.ClusterAutoscaler.Expander = &ptrVar1
+		}
+		if in.Spec.Kubernetes.ClusterAutoscaler.MaxGracefulTerminationSeconds == nil {
+			var ptrVar1 int32 = 600
+			in.Spec.Kubernetes.ClusterAutoscaler.MaxGracefulTerminationSeconds = &ptrVar1
+		}
+		if in.Spec.Kubernetes.ClusterAutoscaler.MaxEmptyBulkDelete == nil {
+			var ptrVar1 int32 = 10
+			in.Spec.Kubernetes.ClusterAutoscaler.MaxEmptyBulkDelete = &ptrVar1
+		}
+		if in.Spec.Kubernetes.ClusterAutoscaler.IgnoreDaemonsetsUtilization == nil {
+			var ptrVar1 bool = false
+			in.Spec.Kubernetes.ClusterAutoscaler.IgnoreDaemonsetsUtilization = &ptrVar1
+		}
+		if in.Spec.Kubernetes.ClusterAutoscaler.Verbosity == nil {
+			var ptrVar1 int32 = 2
+			in.Spec.Kubernetes.ClusterAutoscaler.Verbosity = &ptrVar1
+		}
 	}
 	if in.Spec.Kubernetes.KubeAPIServer != nil {
 		SetDefaults_KubeAPIServerConfig(in.Spec.Kubernetes.KubeAPIServer)
@@ -161,6 +203,44 @@ func SetObjectDefaults_Shoot(in *Shoot) {
 	for i := range in.Spec.Provider.Workers {
 		a := &in.Spec.Provider.Workers[i]
 		SetDefaults_Worker(a)
+		if a.Machine.Architecture == nil {
+			var ptrVar1 string = "amd64"
+			a.Machine.Architecture = &ptrVar1
+		}
+		if a.Machine.OSFamily == nil {
+			var ptrVar1 string = "linux"
+			a.Machine.OSFamily = &ptrVar1
+		}
+	}
+	if in.Spec.Purpose == nil {
+		var ptrVar1 ShootPurpose = "evaluation"
+		in.Spec.Purpose = &ptrVar1
+	}
+	if in.Spec.SchedulerName == nil {
+		var ptrVar1 string = "default-scheduler"
+		in.Spec.SchedulerName = &ptrVar1
+	}
+}
+
+func SetObjectDefaults_ShootClass(in *ShootClass) {
+	for i := range in.Spec.Workers {
+		a := &in.Spec.Workers[i]
+		SetDefaults_Worker(a)
+		if a.Machine.Architecture == nil {
+			var ptrVar1 string = "amd64"
+			a.Machine.Architecture = &ptrVar1
+		}
+		if a.Machine.OSFamily == nil {
+			var ptrVar1 string = "linux"
+			a.Machine.OSFamily = &ptrVar1
+		}
+	}
+}
+
+func SetObjectDefaults_ShootClassList(in *ShootClassList) {
+	for i := range in.Items {
+		a := &in.Items[i]
+		SetObjectDefaults_ShootClass(a)
 	}
 }
 
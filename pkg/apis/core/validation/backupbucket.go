@@ -5,12 +5,25 @@
 package validation
 
 import (
+	"fmt"
+	"time"
+
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	"github.com/gardener/gardener/pkg/apis/core"
 )
 
+// minimumBackupBucketRetentionPeriod is the minimum retention period of immutable backup buckets. Object lock and
+// retention policies of the object stores are typically configured with a granularity of days.
+const minimumBackupBucketRetentionPeriod = 24 * time.Hour
+
+var availableBackupBucketRetentionModes = sets.New(
+	string(core.BackupBucketRetentionModeGovernance),
+	string(core.BackupBucketRetentionModeCompliance),
+)
+
 // ValidateBackupBucket validates a BackupBucket object.
 func ValidateBackupBucket(backupBucket *core.BackupBucket) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -48,6 +61,7 @@ func ValidateBackupBucketSpec(spec *core.BackupBucketSpec, fldPath *field.Path)
 	}
 
 	allErrs = append(allErrs, validateSecretReference(spec.SecretRef, fldPath.Child("secretRef"))...)
+	allErrs = append(allErrs, ValidateBackupBucketImmutability(spec.Immutability, fldPath.Child("immutability"))...)
 
 	return allErrs
 }
@@ -58,6 +72,49 @@ func ValidateBackupBucketSpecUpdate(newSpec, oldSpec *core.BackupBucketSpec, fld
 
 	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.Provider, oldSpec.Provider, fldPath.Child("provider"))...)
 	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.SeedName, oldSpec.SeedName, fldPath.Child("seedName"))...)
+	allErrs = append(allErrs, ValidateBackupBucketImmutabilityUpdate(newSpec.Immutability, oldSpec.Immutability, fldPath.Child("immutability"))...)
+
+	return allErrs
+}
+
+// ValidateBackupBucketImmutability validates the immutability configuration of a backup bucket.
+func ValidateBackupBucketImmutability(immutability *core.BackupBucketImmutability, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if immutability == nil {
+		return allErrs
+	}
+
+	if !availableBackupBucketRetentionModes.Has(string(immutability.RetentionMode)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("retentionMode"), immutability.RetentionMode, sets.List(availableBackupBucketRetentionModes)))
+	}
+
+	if immutability.RetentionPeriod.Duration < minimumBackupBucketRetentionPeriod {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("retentionPeriod"), immutability.RetentionPeriod.Duration.String(), fmt.Sprintf("must be at least %s", minimumBackupBucketRetentionPeriod)))
+	}
+
+	return allErrs
+}
+
+// ValidateBackupBucketImmutabilityUpdate validates updates of the immutability configuration of a backup bucket. Once
+// the compliance retention mode is configured, the immutability configuration can only be tightened, i.e., it must not
+// be removed, the retention mode must not be changed, and the retention period must not be shortened.
+func ValidateBackupBucketImmutabilityUpdate(newImmutability, oldImmutability *core.BackupBucketImmutability, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if oldImmutability == nil || oldImmutability.RetentionMode != core.BackupBucketRetentionModeCompliance {
+		return allErrs
+	}
+
+	if newImmutability == nil {
+		return append(allErrs, field.Forbidden(fldPath, "immutability configuration in compliance mode must not be removed"))
+	}
+
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newImmutability.RetentionMode, oldImmutability.RetentionMode, fldPath.Child("retentionMode"))...)
+
+	if newImmutability.RetentionPeriod.Duration < oldImmutability.RetentionPeriod.Duration {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("retentionPeriod"), fmt.Sprintf("retention period in compliance mode must not be shortened (old value: %s)", oldImmutability.RetentionPeriod.Duration)))
+	}
 
 	return allErrs
 }
@@ -5,6 +5,8 @@
 package validation_test
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
@@ -134,6 +136,80 @@ var _ = Describe("validation", func() {
 					"Field": Equal("spec.seedName"),
 				}))))
 		})
+
+		Context("immutability", func() {
+			BeforeEach(func() {
+				backupBucket.Spec.Immutability = &core.BackupBucketImmutability{
+					RetentionMode:   core.BackupBucketRetentionModeCompliance,
+					RetentionPeriod: metav1.Duration{Duration: 7 * 24 * time.Hour},
+				}
+			})
+
+			It("should allow a valid immutability configuration", func() {
+				Expect(ValidateBackupBucket(backupBucket)).To(BeEmpty())
+			})
+
+			It("should forbid an invalid immutability configuration", func() {
+				backupBucket.Spec.Immutability.RetentionMode = "Locked"
+				backupBucket.Spec.Immutability.RetentionPeriod = metav1.Duration{Duration: time.Hour}
+
+				Expect(ValidateBackupBucket(backupBucket)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("spec.immutability.retentionMode"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("spec.immutability.retentionPeriod"),
+						"Detail": Equal("must be at least 24h0m0s"),
+					})),
+				))
+			})
+
+			It("should allow extending the retention period in compliance mode", func() {
+				newBackupBucket := prepareBackupBucketForUpdate(backupBucket)
+				newBackupBucket.Spec.Immutability.RetentionPeriod = metav1.Duration{Duration: 14 * 24 * time.Hour}
+
+				Expect(ValidateBackupBucketUpdate(newBackupBucket, backupBucket)).To(BeEmpty())
+			})
+
+			It("should forbid relaxing the immutability configuration in compliance mode", func() {
+				newBackupBucket := prepareBackupBucketForUpdate(backupBucket)
+				newBackupBucket.Spec.Immutability.RetentionMode = core.BackupBucketRetentionModeGovernance
+				newBackupBucket.Spec.Immutability.RetentionPeriod = metav1.Duration{Duration: 2 * 24 * time.Hour}
+
+				Expect(ValidateBackupBucketUpdate(newBackupBucket, backupBucket)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.immutability.retentionMode"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.immutability.retentionPeriod"),
+					})),
+				))
+			})
+
+			It("should forbid removing the immutability configuration in compliance mode", func() {
+				newBackupBucket := prepareBackupBucketForUpdate(backupBucket)
+				newBackupBucket.Spec.Immutability = nil
+
+				Expect(ValidateBackupBucketUpdate(newBackupBucket, backupBucket)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.immutability"),
+					})),
+				))
+			})
+
+			It("should allow relaxing the immutability configuration in governance mode", func() {
+				backupBucket.Spec.Immutability.RetentionMode = core.BackupBucketRetentionModeGovernance
+				newBackupBucket := prepareBackupBucketForUpdate(backupBucket)
+				newBackupBucket.Spec.Immutability = nil
+
+				Expect(ValidateBackupBucketUpdate(newBackupBucket, backupBucket)).To(BeEmpty())
+			})
+		})
 	})
 
 })
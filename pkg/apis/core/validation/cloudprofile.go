@@ -18,6 +18,7 @@ import (
 	"github.com/gardener/gardener/pkg/apis/core"
 	"github.com/gardener/gardener/pkg/apis/core/helper"
 	"github.com/gardener/gardener/pkg/utils"
+	"github.com/gardener/gardener/pkg/utils/validation/jsonschema"
 )
 
 var (
@@ -48,6 +49,52 @@ func ValidateCloudProfileUpdate(newProfile, oldProfile *core.CloudProfile) field
 	return allErrs
 }
 
+// ValidateCloudProfileStatus validates the status of a CloudProfile object.
+func ValidateCloudProfileStatus(status *core.CloudProfileStatus, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if status.Usage == nil {
+		return allErrs
+	}
+
+	usagePath := fldPath.Child("usage")
+
+	kubernetesVersions := sets.New[string]()
+	for i, usage := range status.Usage.KubernetesVersions {
+		idxPath := usagePath.Child("kubernetesVersions").Index(i)
+
+		if len(usage.Version) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("version"), "must provide a version"))
+		} else if kubernetesVersions.Has(usage.Version) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("version"), usage.Version))
+		}
+		kubernetesVersions.Insert(usage.Version)
+
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(usage.Shoots), idxPath.Child("shoots"))...)
+	}
+
+	machineImageVersions := sets.New[string]()
+	for i, usage := range status.Usage.MachineImageVersions {
+		idxPath := usagePath.Child("machineImageVersions").Index(i)
+
+		if len(usage.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide a machine image name"))
+		}
+		if len(usage.Version) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("version"), "must provide a version"))
+		}
+		if key := usage.Name + "/" + usage.Version; machineImageVersions.Has(key) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, key))
+		} else {
+			machineImageVersions.Insert(key)
+		}
+
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(usage.Shoots), idxPath.Child("shoots"))...)
+	}
+
+	return allErrs
+}
+
 // ValidateCloudProfileSpec validates the specification of a CloudProfile object.
 func ValidateCloudProfileSpec(spec *core.CloudProfileSpec, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -61,7 +108,9 @@ func ValidateCloudProfileSpec(spec *core.CloudProfileSpec, fldPath *field.Path)
 	allErrs = append(allErrs, validateCloudProfileMachineTypes(spec.MachineTypes, fldPath.Child("machineTypes"))...)
 	allErrs = append(allErrs, validateVolumeTypes(spec.VolumeTypes, fldPath.Child("volumeTypes"))...)
 	allErrs = append(allErrs, validateCloudProfileRegions(spec.Regions, fldPath.Child("regions"))...)
+	allErrs = append(allErrs, validateCloudProfileTypeRegionReferences(spec, fldPath)...)
 	allErrs = append(allErrs, validateCloudProfileBastion(spec, fldPath.Child("bastion"))...)
+	allErrs = append(allErrs, validateCloudProfileAddons(spec.Addons, fldPath.Child("addons"))...)
 	if spec.SeedSelector != nil {
 		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(&spec.SeedSelector.LabelSelector, metav1validation.LabelSelectorValidationOptions{AllowInvalidLabelValueInSelector: true}, fldPath.Child("seedSelector"))...)
 	}
@@ -76,6 +125,48 @@ func ValidateCloudProfileSpec(spec *core.CloudProfileSpec, fldPath *field.Path)
 	return allErrs
 }
 
+// validateCloudProfileTypeRegionReferences validates that the regions and zones in which machine and volume types are
+// declared to be available are defined in the CloudProfile.
+func validateCloudProfileTypeRegionReferences(spec *core.CloudProfileSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	zonesByRegion := make(map[string]sets.Set[string], len(spec.Regions))
+	for _, region := range spec.Regions {
+		zones := sets.New[string]()
+		for _, zone := range region.Zones {
+			zones.Insert(zone.Name)
+		}
+		zonesByRegion[region.Name] = zones
+	}
+
+	validateReferences := func(regions []core.TypeRegionAvailability, regionsPath *field.Path) {
+		for i, region := range regions {
+			idxPath := regionsPath.Index(i)
+
+			zones, ok := zonesByRegion[region.Name]
+			if !ok {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), region.Name, "region is not defined in the CloudProfile"))
+				continue
+			}
+
+			for j, zone := range region.Zones {
+				if !zones.Has(zone) {
+					allErrs = append(allErrs, field.Invalid(idxPath.Child("zones").Index(j), zone, fmt.Sprintf("zone is not defined for region %q in the CloudProfile", region.Name)))
+				}
+			}
+		}
+	}
+
+	for i, machineType := range spec.MachineTypes {
+		validateReferences(machineType.Regions, fldPath.Child("machineTypes").Index(i).Child("regions"))
+	}
+	for i, volumeType := range spec.VolumeTypes {
+		validateReferences(volumeType.Regions, fldPath.Child("volumeTypes").Index(i).Child("regions"))
+	}
+
+	return allErrs
+}
+
 func validateCloudProfileKubernetesSettings(kubernetes core.KubernetesSettings, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 	if len(kubernetes.Versions) == 0 {
@@ -91,6 +182,10 @@ func validateCloudProfileKubernetesSettings(kubernetes core.KubernetesSettings,
 
 	allErrs = append(allErrs, validateKubernetesVersions(kubernetes.Versions, fldPath)...)
 
+	if kubernetes.MaxExpirationExtension != nil && kubernetes.MaxExpirationExtension.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxExpirationExtension"), kubernetes.MaxExpirationExtension.Duration.String(), "must be non-negative"))
+	}
+
 	for i, version := range kubernetes.Versions {
 		idxPath := fldPath.Child("versions").Index(i)
 		allErrs = append(allErrs, validateSupportedVersionsConfiguration(version, kubernetes.Versions, idxPath)...)
@@ -242,6 +337,81 @@ func validateCloudProfileRegions(regions []core.Region, fldPath *field.Path) fie
 		}
 
 		allErrs = append(allErrs, metav1validation.ValidateLabels(region.Labels, labelsPath)...)
+		allErrs = append(allErrs, ValidateRegionMetadata(region.Metadata, idxPath.Child("metadata"))...)
+	}
+
+	return allErrs
+}
+
+// ValidateRegionMetadata validates the given region metadata.
+func ValidateRegionMetadata(metadata *core.RegionMetadata, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if metadata == nil {
+		return allErrs
+	}
+
+	if metadata.Geography != nil && len(*metadata.Geography) == 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("geography"), *metadata.Geography, "must not be empty"))
+	}
+	if metadata.Jurisdiction != nil && len(*metadata.Jurisdiction) == 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("jurisdiction"), *metadata.Jurisdiction, "must not be empty"))
+	}
+	if metadata.LatencyMatrixKey != nil && len(*metadata.LatencyMatrixKey) == 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("latencyMatrixKey"), *metadata.LatencyMatrixKey, "must not be empty"))
+	}
+
+	return allErrs
+}
+
+func validateCloudProfileAddons(addons []core.AddonDefinition, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs = field.ErrorList{}
+		names   = sets.New[string]()
+	)
+
+	for i, addon := range addons {
+		idxPath := fldPath.Index(i)
+
+		if len(addon.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide an addon name"))
+		} else {
+			for _, msg := range apivalidation.NameIsDNSLabel(addon.Name, false) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), addon.Name, msg))
+			}
+			if names.Has(addon.Name) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), addon.Name))
+			}
+			names.Insert(addon.Name)
+		}
+
+		if len(addon.Versions) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("versions"), fmt.Sprintf("must provide at least one version for the addon '%s'", addon.Name)))
+		}
+
+		versions := sets.New[string]()
+		for j, version := range addon.Versions {
+			versionPath := idxPath.Child("versions").Index(j)
+
+			if _, err := semver.NewVersion(version.Version); err != nil {
+				allErrs = append(allErrs, field.Invalid(versionPath.Child("version"), version.Version, fmt.Sprintf("must be a semantic version: %v", err)))
+			} else if versions.Has(version.Version) {
+				allErrs = append(allErrs, field.Duplicate(versionPath.Child("version"), version.Version))
+			}
+			versions.Insert(version.Version)
+
+			if version.Classification != nil && !supportedVersionClassifications.Has(string(*version.Classification)) {
+				allErrs = append(allErrs, field.NotSupported(versionPath.Child("classification"), *version.Classification, sets.List(supportedVersionClassifications)))
+			}
+
+			allErrs = append(allErrs, ValidateOCIRepository(&version.Chart, versionPath.Child("chart"))...)
+
+			if version.ValuesSchema != nil {
+				if _, err := jsonschema.Parse(version.ValuesSchema); err != nil {
+					allErrs = append(allErrs, field.Invalid(versionPath.Child("valuesSchema"), string(version.ValuesSchema.Raw), err.Error()))
+				}
+			}
+		}
 	}
 
 	return allErrs
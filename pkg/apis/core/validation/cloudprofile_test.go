@@ -14,6 +14,7 @@ import (
 	gomegatypes "github.com/onsi/gomega/types"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
 
@@ -298,6 +299,23 @@ var _ = Describe("CloudProfile Validation Tests ", func() {
 					}))))
 				})
 
+				It("should allow a non-negative maximum expiration extension", func() {
+					cloudProfile.Spec.Kubernetes.MaxExpirationExtension = &metav1.Duration{Duration: 30 * 24 * time.Hour}
+
+					Expect(ValidateCloudProfile(cloudProfile)).To(BeEmpty())
+				})
+
+				It("should forbid a negative maximum expiration extension", func() {
+					cloudProfile.Spec.Kubernetes.MaxExpirationExtension = &metav1.Duration{Duration: -time.Hour}
+
+					errorList := ValidateCloudProfile(cloudProfile)
+
+					Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.kubernetes.maxExpirationExtension"),
+					}))))
+				})
+
 				It("should forbid versions of a not allowed pattern", func() {
 					cloudProfile.Spec.Kubernetes.Versions = []core.ExpirableVersion{{Version: "1.11"}}
 
@@ -927,6 +945,78 @@ var _ = Describe("CloudProfile Validation Tests ", func() {
 					errorList := ValidateCloudProfile(cloudProfile)
 					Expect(errorList).To(BeEmpty())
 				})
+
+				It("should allow machine types with supported capacity types", func() {
+					cloudProfile.Spec.MachineTypes = []core.MachineType{*cloudProfile.Spec.MachineTypes[0].DeepCopy()}
+					cloudProfile.Spec.MachineTypes[0].CapacityTypes = []core.CapacityType{core.CapacityTypeOnDemand, core.CapacityTypeSpot}
+
+					Expect(ValidateCloudProfile(cloudProfile)).To(BeEmpty())
+				})
+
+				It("should forbid machine types with unsupported or duplicate capacity types", func() {
+					cloudProfile.Spec.MachineTypes = []core.MachineType{*cloudProfile.Spec.MachineTypes[0].DeepCopy()}
+					cloudProfile.Spec.MachineTypes[0].CapacityTypes = []core.CapacityType{core.CapacityTypeSpot, core.CapacityTypeSpotWithFallback, core.CapacityTypeSpot}
+
+					Expect(ValidateCloudProfile(cloudProfile)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeNotSupported),
+							"Field": Equal("spec.machineTypes[0].capacityTypes[1]"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeDuplicate),
+							"Field": Equal("spec.machineTypes[0].capacityTypes[2]"),
+						})),
+					))
+				})
+
+				It("should allow machine types restricted to defined regions and zones", func() {
+					cloudProfile.Spec.MachineTypes = []core.MachineType{*cloudProfile.Spec.MachineTypes[0].DeepCopy()}
+					cloudProfile.Spec.MachineTypes[0].Regions = []core.TypeRegionAvailability{{Name: regionName, Zones: []string{zoneName}, PricingClass: ptr.To("premium")}}
+
+					Expect(ValidateCloudProfile(cloudProfile)).To(BeEmpty())
+				})
+
+				It("should forbid machine types with invalid region availability", func() {
+					cloudProfile.Spec.MachineTypes = []core.MachineType{*cloudProfile.Spec.MachineTypes[0].DeepCopy()}
+					cloudProfile.Spec.MachineTypes[0].Regions = []core.TypeRegionAvailability{
+						{Name: regionName, Zones: []string{zoneName, zoneName, "unknown-zone"}, PricingClass: ptr.To("")},
+						{Name: regionName},
+						{Name: ""},
+						{Name: "unknown-region"},
+					}
+
+					Expect(ValidateCloudProfile(cloudProfile)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeDuplicate),
+							"Field": Equal("spec.machineTypes[0].regions[0].zones[1]"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.machineTypes[0].regions[0].pricingClass"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeDuplicate),
+							"Field": Equal("spec.machineTypes[0].regions[1].name"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("spec.machineTypes[0].regions[2].name"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeInvalid),
+							"Field":  Equal("spec.machineTypes[0].regions[0].zones[2]"),
+							"Detail": Equal(`zone is not defined for region "region1" in the CloudProfile`),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.machineTypes[0].regions[2].name"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.machineTypes[0].regions[3].name"),
+						})),
+					))
+				})
 			})
 
 			Context("regions validation", func() {
@@ -996,6 +1086,39 @@ var _ = Describe("CloudProfile Validation Tests ", func() {
 						})),
 					))
 				})
+
+				It("should allow valid region metadata", func() {
+					cloudProfile.Spec.Regions[0].Metadata = &core.RegionMetadata{
+						Geography:        ptr.To("europe"),
+						Jurisdiction:     ptr.To("EU"),
+						LatencyMatrixKey: ptr.To("eu-central"),
+					}
+
+					Expect(ValidateCloudProfile(cloudProfile)).To(BeEmpty())
+				})
+
+				It("should forbid empty region metadata values", func() {
+					cloudProfile.Spec.Regions[0].Metadata = &core.RegionMetadata{
+						Geography:        ptr.To(""),
+						Jurisdiction:     ptr.To(""),
+						LatencyMatrixKey: ptr.To(""),
+					}
+
+					Expect(ValidateCloudProfile(cloudProfile)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.regions[0].metadata.geography"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.regions[0].metadata.jurisdiction"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.regions[0].metadata.latencyMatrixKey"),
+						})),
+					))
+				})
 			})
 
 			Context("volume types validation", func() {
@@ -1030,6 +1153,43 @@ var _ = Describe("CloudProfile Validation Tests ", func() {
 					})),
 					))
 				})
+
+				It("should forbid volume types with negative performance limits", func() {
+					cloudProfile.Spec.VolumeTypes[0].MaxIOPS = ptr.To[int64](-1)
+					cloudProfile.Spec.VolumeTypes[0].MaxThroughput = ptr.To[int64](-1)
+
+					errorList := ValidateCloudProfile(cloudProfile)
+
+					Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.volumeTypes[0].maxIOPS"),
+					})), PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.volumeTypes[0].maxThroughput"),
+					})),
+					))
+				})
+
+				It("should forbid volume types available in undefined regions", func() {
+					cloudProfile.Spec.VolumeTypes = []core.VolumeType{{
+						Name:    "volume-type",
+						Class:   "standard",
+						Regions: []core.TypeRegionAvailability{{Name: regionName}, {Name: "unknown-region"}},
+					}}
+
+					Expect(ValidateCloudProfile(cloudProfile)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("spec.volumeTypes[0].regions[1].name"),
+						"Detail": Equal("region is not defined in the CloudProfile"),
+					}))))
+				})
+
+				It("should allow volume types with performance limits", func() {
+					cloudProfile.Spec.VolumeTypes[0].MaxIOPS = ptr.To[int64](16000)
+					cloudProfile.Spec.VolumeTypes[0].MaxThroughput = ptr.To[int64](1000)
+
+					Expect(ValidateCloudProfile(cloudProfile)).To(BeEmpty())
+				})
 			})
 
 			Context("bastion validation", func() {
@@ -1174,6 +1334,85 @@ var _ = Describe("CloudProfile Validation Tests ", func() {
 				})
 			})
 
+			Context("addons validation", func() {
+				var addon core.AddonDefinition
+
+				BeforeEach(func() {
+					addon = core.AddonDefinition{
+						Name: "cert-manager",
+						Versions: []core.AddonVersion{{
+							ExpirableVersion: core.ExpirableVersion{Version: "1.16.1"},
+							Chart:            core.OCIRepository{Ref: ptr.To("example.com/charts/cert-manager:v1.16.1")},
+							ValuesSchema:     &runtime.RawExtension{Raw: []byte(`{"type":"object"}`)},
+						}},
+					}
+				})
+
+				It("should allow valid addons", func() {
+					cloudProfile.Spec.Addons = []core.AddonDefinition{addon}
+
+					Expect(ValidateCloudProfile(cloudProfile)).To(BeEmpty())
+				})
+
+				It("should forbid invalid and duplicate addon names", func() {
+					invalidAddon := *addon.DeepCopy()
+					invalidAddon.Name = "Cert_Manager"
+					cloudProfile.Spec.Addons = []core.AddonDefinition{addon, addon, invalidAddon}
+
+					Expect(ValidateCloudProfile(cloudProfile)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeDuplicate),
+							"Field": Equal("spec.addons[1].name"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.addons[2].name"),
+						})),
+					))
+				})
+
+				It("should forbid addons without versions", func() {
+					addon.Versions = nil
+					cloudProfile.Spec.Addons = []core.AddonDefinition{addon}
+
+					Expect(ValidateCloudProfile(cloudProfile)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.addons[0].versions"),
+					}))))
+				})
+
+				It("should forbid invalid versions", func() {
+					addon.Versions = append(addon.Versions, addon.Versions[0], core.AddonVersion{
+						ExpirableVersion: core.ExpirableVersion{Version: "latest", Classification: ptr.To(core.VersionClassification("foo"))},
+						ValuesSchema:     &runtime.RawExtension{Raw: []byte(`{"type":`)},
+					})
+					cloudProfile.Spec.Addons = []core.AddonDefinition{addon}
+
+					Expect(ValidateCloudProfile(cloudProfile)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeDuplicate),
+							"Field": Equal("spec.addons[0].versions[1].version"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.addons[0].versions[2].version"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeNotSupported),
+							"Field": Equal("spec.addons[0].versions[2].classification"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("spec.addons[0].versions[2].chart"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.addons[0].versions[2].valuesSchema"),
+						})),
+					))
+				})
+			})
+
 			It("should forbid unsupported seed selectors", func() {
 				cloudProfile.Spec.SeedSelector.MatchLabels["foo"] = "no/slash/allowed"
 
@@ -1294,4 +1533,50 @@ var _ = Describe("CloudProfile Validation Tests ", func() {
 			})
 		})
 	})
+
+	Describe("#ValidateCloudProfileStatus", func() {
+		var (
+			fldPath = field.NewPath("status")
+			status  *core.CloudProfileStatus
+		)
+
+		BeforeEach(func() {
+			status = &core.CloudProfileStatus{
+				Usage: &core.CloudProfileUsage{
+					KubernetesVersions: []core.VersionUsage{{Version: "1.31.1", Shoots: 2}},
+				},
+			}
+		})
+
+		It("should allow an empty status", func() {
+			Expect(ValidateCloudProfileStatus(&core.CloudProfileStatus{}, fldPath)).To(BeEmpty())
+		})
+
+		It("should allow a valid usage", func() {
+			status.Usage.MachineImageVersions = []core.MachineImageVersionUsage{{Name: "gardenlinux", Version: "1592.1.0", Shoots: 1}}
+
+			Expect(ValidateCloudProfileStatus(status, fldPath)).To(BeEmpty())
+		})
+
+		It("should forbid invalid usage entries", func() {
+			status.Usage.KubernetesVersions = append(status.Usage.KubernetesVersions,
+				core.VersionUsage{Version: "1.31.1"},
+				core.VersionUsage{Shoots: -1},
+			)
+			status.Usage.MachineImageVersions = []core.MachineImageVersionUsage{
+				{Name: "gardenlinux", Version: "1592.1.0"},
+				{Name: "gardenlinux", Version: "1592.1.0"},
+				{},
+			}
+
+			Expect(ValidateCloudProfileStatus(status, fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeDuplicate), "Field": Equal("status.usage.kubernetesVersions[1].version")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeRequired), "Field": Equal("status.usage.kubernetesVersions[2].version")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("status.usage.kubernetesVersions[2].shoots")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeDuplicate), "Field": Equal("status.usage.machineImageVersions[1]")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeRequired), "Field": Equal("status.usage.machineImageVersions[2].name")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeRequired), "Field": Equal("status.usage.machineImageVersions[2].version")})),
+			))
+		})
+	})
 })
@@ -47,6 +47,7 @@ func ValidateControllerRegistration(controllerRegistration *core.ControllerRegis
 
 // SupportedExtensionKinds contains all supported extension kinds.
 var SupportedExtensionKinds = sets.New(
+	extensionsv1alpha1.AuditSinkResource,
 	extensionsv1alpha1.BackupBucketResource,
 	extensionsv1alpha1.BackupEntryResource,
 	extensionsv1alpha1.BastionResource,
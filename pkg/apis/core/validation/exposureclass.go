@@ -11,6 +11,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	"github.com/gardener/gardener/pkg/apis/core"
+	cidrvalidation "github.com/gardener/gardener/pkg/utils/validation/cidr"
 )
 
 // ValidateExposureClass validates a ExposureClass object.
@@ -37,6 +38,39 @@ func ValidateExposureClass(exposureClass *core.ExposureClass) field.ErrorList {
 		allErrs = append(allErrs, ValidateTolerations(exposureClass.Scheduling.Tolerations, field.NewPath("scheduling", "tolerations"))...)
 	}
 
+	if exposureClass.LoadBalancerService != nil {
+		fldPath := field.NewPath("loadBalancerService")
+		allErrs = append(allErrs, apivalidation.ValidateAnnotations(exposureClass.LoadBalancerService.Annotations, fldPath.Child("annotations"))...)
+
+		var sourceRanges []cidrvalidation.CIDR
+		for i, sourceRange := range exposureClass.LoadBalancerService.SourceRanges {
+			sourceRanges = append(sourceRanges, cidrvalidation.NewCIDR(sourceRange, fldPath.Child("sourceRanges").Index(i)))
+		}
+		allErrs = append(allErrs, cidrvalidation.ValidateCIDRParse(sourceRanges...)...)
+	}
+
+	if exposureClass.IngressGateway != nil {
+		allErrs = append(allErrs, validateExposureClassIngressGateway(exposureClass.IngressGateway, field.NewPath("ingressGateway"))...)
+	}
+
+	return allErrs
+}
+
+func validateExposureClassIngressGateway(ingressGateway *core.ExposureClassIngressGateway, fldPath *field.Path) field.ErrorList {
+	var allErrs = field.ErrorList{}
+
+	if ingressGateway.MinReplicas != nil && *ingressGateway.MinReplicas < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("minReplicas"), *ingressGateway.MinReplicas, "must be at least 1"))
+	}
+
+	if ingressGateway.MaxReplicas != nil {
+		if *ingressGateway.MaxReplicas < 1 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("maxReplicas"), *ingressGateway.MaxReplicas, "must be at least 1"))
+		} else if ingressGateway.MinReplicas != nil && *ingressGateway.MaxReplicas < *ingressGateway.MinReplicas {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("maxReplicas"), *ingressGateway.MaxReplicas, "must be greater than or equal to minReplicas"))
+		}
+	}
+
 	return allErrs
 }
 
@@ -109,6 +109,72 @@ var _ = Describe("ExposureClass Validation Tests ", func() {
 		})
 	})
 
+	Describe("#ValidateExposureClass load balancer service and ingress gateway", func() {
+		It("should pass as load balancer service and ingress gateway are valid", func() {
+			exposureClass.LoadBalancerService = &core.ExposureClassLoadBalancerService{
+				Annotations:  map[string]string{"service.beta.kubernetes.io/aws-load-balancer-internal": "true"},
+				SourceRanges: []string{"10.0.0.0/8", "2001:db8::/64"},
+			}
+			exposureClass.IngressGateway = &core.ExposureClassIngressGateway{
+				MinReplicas: ptr.To[int32](2),
+				MaxReplicas: ptr.To[int32](5),
+			}
+
+			Expect(ValidateExposureClass(exposureClass)).To(BeEmpty())
+		})
+
+		It("should fail as load balancer service has invalid annotations and source ranges", func() {
+			exposureClass.LoadBalancerService = &core.ExposureClassLoadBalancerService{
+				Annotations:  map[string]string{"-invalid-": "true"},
+				SourceRanges: []string{"10.0.0.0/8", "foo"},
+			}
+
+			Expect(ValidateExposureClass(exposureClass)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("loadBalancerService.annotations"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("loadBalancerService.sourceRanges[1]"),
+				})),
+			))
+		})
+
+		It("should fail as ingress gateway replicas are invalid", func() {
+			exposureClass.IngressGateway = &core.ExposureClassIngressGateway{
+				MinReplicas: ptr.To[int32](0),
+				MaxReplicas: ptr.To[int32](0),
+			}
+
+			Expect(ValidateExposureClass(exposureClass)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("ingressGateway.minReplicas"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("ingressGateway.maxReplicas"),
+				})),
+			))
+		})
+
+		It("should fail as ingress gateway max replicas are lower than min replicas", func() {
+			exposureClass.IngressGateway = &core.ExposureClassIngressGateway{
+				MinReplicas: ptr.To[int32](3),
+				MaxReplicas: ptr.To[int32](2),
+			}
+
+			Expect(ValidateExposureClass(exposureClass)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("ingressGateway.maxReplicas"),
+					"Detail": Equal("must be greater than or equal to minReplicas"),
+				})),
+			))
+		})
+	})
+
 	Describe("#ValidateExposureClassUpdate", func() {
 		var exposureClassNew *core.ExposureClass
 
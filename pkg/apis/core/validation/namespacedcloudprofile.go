@@ -92,6 +92,10 @@ func validateNamespacedCloudProfileKubernetesVersions(kubernetesSettings *core.K
 		return allErrs
 	}
 
+	if kubernetesSettings.MaxExpirationExtension != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("maxExpirationExtension"), "must not provide a maximum expiration extension in NamespacedCloudProfile"))
+	}
+
 	versions := kubernetesSettings.Versions
 	for i, version := range versions {
 		idxPath := fldPath.Child("versions").Index(i)
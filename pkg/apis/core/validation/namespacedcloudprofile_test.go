@@ -260,6 +260,18 @@ var _ = Describe("NamespacedCloudProfile Validation Tests ", func() {
 						"Detail": Equal("must not provide a classification to a Kubernetes version in NamespacedCloudProfile"),
 					}))))
 				})
+
+				It("should forbid providing a maximum expiration extension", func() {
+					namespacedCloudProfile.Spec.Kubernetes.MaxExpirationExtension = &metav1.Duration{Duration: 24 * time.Hour}
+
+					errorList := ValidateNamespacedCloudProfile(namespacedCloudProfile)
+
+					Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeForbidden),
+						"Field":  Equal("spec.kubernetes.maxExpirationExtension"),
+						"Detail": Equal("must not provide a maximum expiration extension in NamespacedCloudProfile"),
+					}))))
+				})
 			})
 
 			Context("machine image validation", func() {
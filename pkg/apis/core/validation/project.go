@@ -6,9 +6,11 @@ package validation
 
 import (
 	"fmt"
+	"net/url"
 	"slices"
 	"strings"
 
+	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	"k8s.io/apimachinery/pkg/api/validation/path"
@@ -115,6 +117,79 @@ func ValidateProjectSpec(projectSpec *core.ProjectSpec, fldPath *field.Path) fie
 	}
 
 	allErrs = append(allErrs, validateDualApprovalForDeletion(projectSpec.DualApprovalForDeletion, fldPath.Child("dualApprovalForDeletion"))...)
+	allErrs = append(allErrs, validateTrustedIdentityProviders(projectSpec.TrustedIdentityProviders, fldPath.Child("trustedIdentityProviders"))...)
+	allErrs = append(allErrs, validateProjectQuotas(projectSpec.Quotas, fldPath.Child("quotas"))...)
+	allErrs = append(allErrs, validateProjectMemberSync(projectSpec.MemberSync, fldPath.Child("memberSync"))...)
+
+	return allErrs
+}
+
+func validateProjectMemberSync(memberSync *core.ProjectMemberSync, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if memberSync == nil {
+		return allErrs
+	}
+
+	if len(memberSync.Groups) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("groups"), "must provide at least one group"))
+	}
+
+	groupNames := sets.New[string]()
+	for i, group := range memberSync.Groups {
+		idxPath := fldPath.Child("groups").Index(i)
+
+		if groupNames.Has(group.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), group.Name))
+		}
+		groupNames.Insert(group.Name)
+
+		if len(group.Roles) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("roles"), "must provide at least one role"))
+		}
+
+		member := core.ProjectMember{
+			Subject: rbacv1.Subject{
+				APIGroup: rbacv1.GroupName,
+				Kind:     rbacv1.GroupKind,
+				Name:     group.Name,
+			},
+			Roles: group.Roles,
+		}
+		allErrs = append(allErrs, ValidateProjectMember(member, idxPath)...)
+
+		for j, role := range group.Roles {
+			if role == core.ProjectMemberOwner {
+				allErrs = append(allErrs, field.Forbidden(idxPath.Child("roles").Index(j), "the owner role cannot be granted to synchronized groups"))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+func validateProjectQuotas(quotas []corev1.ObjectReference, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs = field.ErrorList{}
+		refs    = sets.New[string]()
+	)
+
+	for i, quota := range quotas {
+		idxPath := fldPath.Index(i)
+
+		if len(quota.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide a name"))
+		}
+		if len(quota.Namespace) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("namespace"), "must provide a namespace"))
+		}
+
+		ref := quota.Namespace + "/" + quota.Name
+		if refs.Has(ref) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, ref))
+		}
+		refs.Insert(ref)
+	}
 
 	return allErrs
 }
@@ -273,6 +348,67 @@ func validateDualApprovalForDeletion(dualApproval []core.DualApprovalForDeletion
 	return allErrs
 }
 
+var availableTrustedIdentityProviderAccess = sets.New(
+	string(core.TrustedIdentityProviderAccessAdmin),
+	string(core.TrustedIdentityProviderAccessViewer),
+)
+
+func validateTrustedIdentityProviders(providers []core.TrustedIdentityProvider, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs field.ErrorList
+		names   = sets.New[string]()
+	)
+
+	for i, provider := range providers {
+		idxPath := fldPath.Index(i)
+
+		if len(provider.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "cannot be empty"))
+		} else {
+			for _, msg := range apivalidation.NameIsDNSLabel(provider.Name, false) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), provider.Name, msg))
+			}
+
+			if names.Has(provider.Name) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), provider.Name))
+			}
+			names.Insert(provider.Name)
+		}
+
+		if len(provider.Issuer) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("issuer"), "cannot be empty"))
+		} else if issuer, err := url.Parse(provider.Issuer); err != nil {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("issuer"), provider.Issuer, err.Error()))
+		} else if issuer.Scheme != "https" || len(issuer.Host) == 0 || len(issuer.RawQuery) > 0 || len(issuer.Fragment) > 0 || issuer.User != nil {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("issuer"), provider.Issuer, "must be a https URL without query, fragment or user info"))
+		}
+
+		if len(provider.Audiences) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("audiences"), "must provide at least one audience"))
+		}
+		for j, audience := range provider.Audiences {
+			if len(audience) == 0 {
+				allErrs = append(allErrs, field.Required(idxPath.Child("audiences").Index(j), "cannot be empty"))
+			}
+		}
+
+		if len(provider.Subjects) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("subjects"), "must provide at least one subject"))
+		}
+		for j, subject := range provider.Subjects {
+			if len(strings.TrimSuffix(subject, "*")) == 0 {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("subjects").Index(j), subject, "must not be empty or match all subjects"))
+			}
+		}
+
+		if provider.Access != nil && !availableTrustedIdentityProviderAccess.Has(string(*provider.Access)) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("access"), *provider.Access, sets.List(availableTrustedIdentityProviderAccess)))
+		}
+	}
+
+	return allErrs
+}
+
 // ValidateProjectStatusUpdate validates the status field of a Project object.
 func ValidateProjectStatusUpdate(newProject, oldProject *core.Project) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -11,6 +11,7 @@ import (
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
 	gomegatypes "github.com/onsi/gomega/types"
+	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -456,6 +457,185 @@ var _ = Describe("Project Validation Tests", func() {
 			})
 		})
 
+		Context("trusted identity providers", func() {
+			var provider core.TrustedIdentityProvider
+
+			BeforeEach(func() {
+				provider = core.TrustedIdentityProvider{
+					Name:      "github",
+					Issuer:    "https://token.actions.githubusercontent.com",
+					Audiences: []string{"gardener"},
+					Subjects:  []string{"repo:gardener/gardener:ref:refs/heads/master", "repo:gardener/dashboard:*"},
+					Access:    ptr.To(core.TrustedIdentityProviderAccessViewer),
+				}
+			})
+
+			It("should allow valid configurations", func() {
+				project.Spec.TrustedIdentityProviders = []core.TrustedIdentityProvider{provider}
+
+				Expect(ValidateProject(project)).To(BeEmpty())
+			})
+
+			It("should forbid empty fields", func() {
+				project.Spec.TrustedIdentityProviders = []core.TrustedIdentityProvider{{}}
+
+				Expect(ValidateProject(project)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.trustedIdentityProviders[0].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.trustedIdentityProviders[0].issuer"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.trustedIdentityProviders[0].audiences"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.trustedIdentityProviders[0].subjects"),
+					})),
+				))
+			})
+
+			It("should forbid duplicate names", func() {
+				project.Spec.TrustedIdentityProviders = []core.TrustedIdentityProvider{provider, provider}
+
+				Expect(ValidateProject(project)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("spec.trustedIdentityProviders[1].name"),
+					})),
+				))
+			})
+
+			It("should forbid invalid values", func() {
+				provider.Name = "Foo_Bar"
+				provider.Issuer = "http://issuer.example.com?foo=bar"
+				provider.Audiences = []string{""}
+				provider.Subjects = []string{"*", ""}
+				provider.Access = ptr.To(core.TrustedIdentityProviderAccess("Owner"))
+				project.Spec.TrustedIdentityProviders = []core.TrustedIdentityProvider{provider}
+
+				Expect(ValidateProject(project)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.trustedIdentityProviders[0].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.trustedIdentityProviders[0].issuer"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.trustedIdentityProviders[0].audiences[0]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.trustedIdentityProviders[0].subjects[0]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.trustedIdentityProviders[0].subjects[1]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("spec.trustedIdentityProviders[0].access"),
+					})),
+				))
+			})
+		})
+
+		Context("quotas", func() {
+			It("should allow valid quota references", func() {
+				project.Spec.Quotas = []corev1.ObjectReference{{Name: "quota", Namespace: "garden"}}
+
+				Expect(ValidateProject(project)).To(BeEmpty())
+			})
+
+			It("should forbid invalid quota references", func() {
+				project.Spec.Quotas = []corev1.ObjectReference{
+					{},
+					{Name: "quota", Namespace: "garden"},
+					{Name: "quota", Namespace: "garden"},
+				}
+
+				Expect(ValidateProject(project)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.quotas[0].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.quotas[0].namespace"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("spec.quotas[2]"),
+					})),
+				))
+			})
+		})
+
+		Context("memberSync", func() {
+			It("should allow a valid member sync configuration", func() {
+				project.Spec.MemberSync = &core.ProjectMemberSync{
+					GroupPrefix: ptr.To("oidc:"),
+					Groups: []core.ProjectMemberSyncGroup{
+						{Name: "developers", Roles: []string{"admin"}},
+						{Name: "auditors", Roles: []string{"viewer", "extension:foo"}},
+					},
+				}
+
+				Expect(ValidateProject(project)).To(BeEmpty())
+			})
+
+			It("should forbid an empty list of groups", func() {
+				project.Spec.MemberSync = &core.ProjectMemberSync{}
+
+				Expect(ValidateProject(project)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.memberSync.groups"),
+					})),
+				))
+			})
+
+			It("should forbid invalid groups", func() {
+				project.Spec.MemberSync = &core.ProjectMemberSync{
+					Groups: []core.ProjectMemberSyncGroup{
+						{},
+						{Name: "developers", Roles: []string{"admin", "foo"}},
+						{Name: "developers", Roles: []string{"owner"}},
+					},
+				}
+
+				Expect(ValidateProject(project)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.memberSync.groups[0].roles"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.memberSync.groups[0].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("spec.memberSync.groups[1].roles[1]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("spec.memberSync.groups[2].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.memberSync.groups[2].roles[0]"),
+					})),
+				))
+			})
+		})
+
 		DescribeTable("namespace immutability",
 			func(old, new *string, matcher gomegatypes.GomegaMatcher) {
 				project.Spec.Namespace = old
@@ -63,7 +63,10 @@ func isValidQuotaMetric(metric corev1.ResourceName) bool {
 		core.QuotaMetricMemory,
 		core.QuotaMetricStorageStandard,
 		core.QuotaMetricStoragePremium,
-		core.QuotaMetricLoadbalancer:
+		core.QuotaMetricLoadbalancer,
+		core.QuotaMetricControlPlaneUnits,
+		core.QuotaMetricShoots,
+		core.QuotaMetricNodes:
 		return true
 	}
 	return false
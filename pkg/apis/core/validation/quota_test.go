@@ -34,8 +34,11 @@ var _ = Describe("Quota Validation Tests ", func() {
 						Kind:       "Secret",
 					},
 					Metrics: corev1.ResourceList{
-						"cpu":    resource.MustParse("200"),
-						"memory": resource.MustParse("4000Gi"),
+						"cpu":               resource.MustParse("200"),
+						"memory":            resource.MustParse("4000Gi"),
+						"controlplaneunits": resource.MustParse("50"),
+						"shoots":            resource.MustParse("10"),
+						"nodes":             resource.MustParse("100"),
 					},
 				},
 			}
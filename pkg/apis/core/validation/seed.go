@@ -6,6 +6,8 @@ package validation
 
 import (
 	"fmt"
+	"net/mail"
+	"net/url"
 
 	corev1 "k8s.io/api/core/v1"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
@@ -35,6 +37,13 @@ var (
 		v1beta1constants.GardenerOperationReconcile,
 		v1beta1constants.GardenerOperationRenewKubeconfig,
 		v1beta1constants.SeedOperationRenewWorkloadIdentityTokens,
+		v1beta1constants.OperationRotateCAStart,
+		v1beta1constants.OperationRotateCAComplete,
+	)
+	availableSeedTaintEffects = sets.New(
+		string(core.SeedTaintEffectNoSchedule),
+		string(core.SeedTaintEffectPreferNoSchedule),
+		string(core.SeedTaintEffectNoExecute),
 	)
 )
 
@@ -43,7 +52,7 @@ func ValidateSeed(seed *core.Seed) field.ErrorList {
 	allErrs := field.ErrorList{}
 
 	allErrs = append(allErrs, apivalidation.ValidateObjectMeta(&seed.ObjectMeta, false, apivalidation.NameIsDNSLabel, field.NewPath("metadata"))...)
-	allErrs = append(allErrs, validateSeedOperation(seed.Annotations[v1beta1constants.GardenerOperation], field.NewPath("metadata", "annotations").Key(v1beta1constants.GardenerOperation))...)
+	allErrs = append(allErrs, validateSeedOperation(seed.Annotations[v1beta1constants.GardenerOperation], seed, field.NewPath("metadata", "annotations").Key(v1beta1constants.GardenerOperation))...)
 	allErrs = append(allErrs, ValidateSeedSpec(&seed.Spec, field.NewPath("spec"), false)...)
 
 	return allErrs
@@ -56,11 +65,26 @@ func ValidateSeedUpdate(newSeed, oldSeed *core.Seed) field.ErrorList {
 	allErrs = append(allErrs, apivalidation.ValidateObjectMetaUpdate(&newSeed.ObjectMeta, &oldSeed.ObjectMeta, field.NewPath("metadata"))...)
 	allErrs = append(allErrs, validateSeedOperationUpdate(newSeed.Annotations[v1beta1constants.GardenerOperation], oldSeed.Annotations[v1beta1constants.GardenerOperation], field.NewPath("metadata", "annotations").Key(v1beta1constants.GardenerOperation))...)
 	allErrs = append(allErrs, ValidateSeedSpecUpdate(&newSeed.Spec, &oldSeed.Spec, field.NewPath("spec"))...)
+	allErrs = append(allErrs, validateSeedIngressDomainUpdate(newSeed, oldSeed, field.NewPath("spec", "ingress", "domain"))...)
 	allErrs = append(allErrs, ValidateSeed(newSeed)...)
 
 	return allErrs
 }
 
+func validateSeedIngressDomainUpdate(newSeed, oldSeed *core.Seed, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if oldSeed.Spec.Ingress == nil || newSeed.Spec.Ingress == nil || oldSeed.Spec.Ingress.Domain == newSeed.Spec.Ingress.Domain {
+		return allErrs
+	}
+
+	if migration := oldSeed.Status.IngressDomainMigration; migration != nil && migration.CompletionTime == nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("ingress domain cannot be changed while the migration from %q to %q is still in progress", migration.PreviousDomain, migration.Domain)))
+	}
+
+	return allErrs
+}
+
 // ValidateSeedTemplate validates a SeedTemplate.
 func ValidateSeedTemplate(seedTemplate *core.SeedTemplate, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -102,6 +126,8 @@ func ValidateSeedSpec(seedSpec *core.SeedSpec, fldPath *field.Path, inTemplate b
 		zones.Insert(zone)
 	}
 
+	allErrs = append(allErrs, ValidateRegionMetadata(seedSpec.Provider.RegionMetadata, providerPath.Child("regionMetadata"))...)
+
 	allErrs = append(allErrs, validateSeedNetworks(seedSpec.Networks, fldPath.Child("networks"), inTemplate)...)
 
 	if seedSpec.Backup != nil {
@@ -114,6 +140,8 @@ func ValidateSeedSpec(seedSpec *core.SeedSpec, fldPath *field.Path, inTemplate b
 		}
 
 		allErrs = append(allErrs, validateSecretReference(seedSpec.Backup.SecretRef, fldPath.Child("backup", "secretRef"))...)
+		allErrs = append(allErrs, ValidateBackupBucketImmutability(seedSpec.Backup.Immutability, fldPath.Child("backup", "immutability"))...)
+		allErrs = append(allErrs, validateSeedBackupETCD(seedSpec.Backup.ETCD, fldPath.Child("backup", "etcd"))...)
 	}
 
 	var keyValues = sets.New[string]()
@@ -125,6 +153,10 @@ func ValidateSeedSpec(seedSpec *core.SeedSpec, fldPath *field.Path, inTemplate b
 			allErrs = append(allErrs, field.Required(idxPath.Child("key"), "cannot be empty"))
 		}
 
+		if taint.Effect != nil && !availableSeedTaintEffects.Has(string(*taint.Effect)) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("effect"), *taint.Effect, sets.List(availableSeedTaintEffects)))
+		}
+
 		id := utils.IDForKeyWithOptionalValue(taint.Key, taint.Value)
 		if keyValues.Has(id) {
 			allErrs = append(allErrs, field.Duplicate(idxPath, id))
@@ -198,6 +230,13 @@ func ValidateSeedSpec(seedSpec *core.SeedSpec, fldPath *field.Path, inTemplate b
 		if helper.SeedSettingTopologyAwareRoutingEnabled(seedSpec.Settings) && len(seedSpec.Provider.Zones) <= 1 {
 			allErrs = append(allErrs, field.Forbidden(fldPath.Child("settings", "topologyAwareRouting", "enabled"), "topology-aware routing can only be enabled on multi-zone Seed clusters (with at least two zones in spec.provider.zones)"))
 		}
+		if seedSpec.Settings.ACME != nil {
+			allErrs = append(allErrs, validateSeedSettingACME(seedSpec.Settings.ACME, fldPath.Child("settings", "acme"))...)
+		}
+	}
+
+	if seedSpec.Maintenance != nil {
+		allErrs = append(allErrs, validateMaintenanceTimeWindow(seedSpec.Maintenance.TimeWindow, fldPath.Child("maintenance", "timeWindow"))...)
 	}
 
 	if !inTemplate && seedSpec.Ingress == nil {
@@ -234,6 +273,69 @@ func ValidateSeedSpec(seedSpec *core.SeedSpec, fldPath *field.Path, inTemplate b
 					"secret reference namespace must be set"))
 			}
 		}
+		allErrs = append(allErrs, validateSeedIngressKubeAPIServerPorts(seedSpec.Ingress.KubeAPIServerPorts, fldPath.Child("ingress", "kubeAPIServerPorts"))...)
+	}
+
+	return allErrs
+}
+
+// reservedIngressGatewayPorts are the ports already used by the istio ingress gateway of the seed.
+var reservedIngressGatewayPorts = sets.New[int32](443, 8132, 8443)
+
+func validateSeedIngressKubeAPIServerPorts(ports []int32, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	seen := sets.New[int32]()
+
+	for i, port := range ports {
+		idxPath := fldPath.Index(i)
+
+		if port < 1024 || port > 65535 {
+			allErrs = append(allErrs, field.Invalid(idxPath, port, "must be between 1024 and 65535, inclusive"))
+		} else if reservedIngressGatewayPorts.Has(port) {
+			allErrs = append(allErrs, field.Forbidden(idxPath, fmt.Sprintf("port is already used by the istio ingress gateway, reserved ports are %v", sets.List(reservedIngressGatewayPorts))))
+		}
+		if seen.Has(port) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, port))
+		}
+		seen.Insert(port)
+	}
+
+	return allErrs
+}
+
+func validateSeedSettingACME(acme *core.SeedSettingACME, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(acme.DirectoryURL) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("directoryURL"), "must provide the directory URL of the ACME server"))
+	} else if u, err := url.Parse(acme.DirectoryURL); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("directoryURL"), acme.DirectoryURL, fmt.Sprintf("must be a valid URL: %v", err)))
+	} else if u.Scheme != "https" || len(u.Host) == 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("directoryURL"), acme.DirectoryURL, "must be an absolute URL with scheme 'https'"))
+	}
+
+	if acme.Email != nil {
+		if _, err := mail.ParseAddress(*acme.Email); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("email"), *acme.Email, fmt.Sprintf("must be a valid email address: %v", err)))
+		}
+	}
+
+	return allErrs
+}
+
+func validateSeedBackupETCD(etcd *core.SeedBackupETCD, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if etcd == nil {
+		return allErrs
+	}
+
+	if interval := etcd.MinFullSnapshotInterval; interval != nil && interval.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("minFullSnapshotInterval"), interval.Duration.String(), "must be larger than 0"))
+	}
+
+	if retentionPeriod := etcd.MaxDeltaSnapshotRetentionPeriod; retentionPeriod != nil && retentionPeriod.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxDeltaSnapshotRetentionPeriod"), retentionPeriod.Duration.String(), "must be larger than 0"))
 	}
 
 	return allErrs
@@ -299,14 +401,11 @@ func ValidateSeedSpecUpdate(newSeedSpec, oldSeedSpec *core.SeedSpec, fldPath *fi
 
 	allErrs = append(allErrs, validateSeedNetworksUpdate(newSeedSpec.Networks, oldSeedSpec.Networks, fldPath.Child("networks"))...)
 
-	if oldSeedSpec.Ingress != nil && newSeedSpec.Ingress != nil {
-		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSeedSpec.Ingress.Domain, oldSeedSpec.Ingress.Domain, fldPath.Child("ingress", "domain"))...)
-	}
-
 	if oldSeedSpec.Backup != nil {
 		if newSeedSpec.Backup != nil {
 			allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSeedSpec.Backup.Provider, oldSeedSpec.Backup.Provider, fldPath.Child("backup", "provider"))...)
 			allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSeedSpec.Backup.Region, oldSeedSpec.Backup.Region, fldPath.Child("backup", "region"))...)
+			allErrs = append(allErrs, ValidateBackupBucketImmutabilityUpdate(newSeedSpec.Backup.Immutability, oldSeedSpec.Backup.Immutability, fldPath.Child("backup", "immutability"))...)
 		} else {
 			allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSeedSpec.Backup, oldSeedSpec.Backup, fldPath.Child("backup"))...)
 		}
@@ -346,7 +445,7 @@ func ValidateSeedStatusUpdate(newSeed, oldSeed *core.Seed) field.ErrorList {
 	return allErrs
 }
 
-func validateSeedOperation(operation string, fldPath *field.Path) field.ErrorList {
+func validateSeedOperation(operation string, seed *core.Seed, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
 	if operation == "" {
@@ -357,6 +456,31 @@ func validateSeedOperation(operation string, fldPath *field.Path) field.ErrorLis
 		allErrs = append(allErrs, field.NotSupported(fldPath, operation, sets.List(availableSeedOperations)))
 	}
 
+	allErrs = append(allErrs, validateSeedOperationContext(operation, seed, fldPath)...)
+
+	return allErrs
+}
+
+func validateSeedOperationContext(operation string, seed *core.Seed, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	switch operation {
+	case v1beta1constants.OperationRotateCAStart:
+		if seed.DeletionTimestamp != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath, "cannot start CA rotation if seed has deletion timestamp"))
+		}
+		if phase := helper.GetSeedCARotationPhase(seed.Status.Credentials); len(phase) > 0 && phase != core.RotationCompleted {
+			allErrs = append(allErrs, field.Forbidden(fldPath, "cannot start CA rotation if .status.credentials.rotation.certificateAuthorities.phase is not 'Completed'"))
+		}
+	case v1beta1constants.OperationRotateCAComplete:
+		if seed.DeletionTimestamp != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath, "cannot complete CA rotation if seed has deletion timestamp"))
+		}
+		if helper.GetSeedCARotationPhase(seed.Status.Credentials) != core.RotationPrepared {
+			allErrs = append(allErrs, field.Forbidden(fldPath, "cannot complete CA rotation if .status.credentials.rotation.certificateAuthorities.phase is not 'Prepared'"))
+		}
+	}
+
 	return allErrs
 }
 
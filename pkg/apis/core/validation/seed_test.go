@@ -7,6 +7,7 @@ package validation_test
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -158,6 +159,7 @@ var _ = Describe("Seed Validation Tests", func() {
 				Entry("renew-garden-access-secrets", "renew-garden-access-secrets"),
 				Entry("renew-kubeconfig", "renew-kubeconfig"),
 				Entry("renew-workload-identity-tokens", "renew-workload-identity-tokens"),
+				Entry("rotate-ca-start", "rotate-ca-start"),
 			)
 
 			DescribeTable("should do nothing if a valid operation annotation is added", func(operation string) {
@@ -169,6 +171,7 @@ var _ = Describe("Seed Validation Tests", func() {
 				Entry("renew-garden-access-secrets", "renew-garden-access-secrets"),
 				Entry("renew-kubeconfig", "renew-kubeconfig"),
 				Entry("renew-workload-identity-tokens", "renew-workload-identity-tokens"),
+				Entry("rotate-ca-start", "rotate-ca-start"),
 			)
 
 			DescribeTable("should do nothing if a valid operation annotation is removed", func(operation string) {
@@ -181,6 +184,7 @@ var _ = Describe("Seed Validation Tests", func() {
 				Entry("renew-garden-access-secrets", "renew-garden-access-secrets"),
 				Entry("renew-kubeconfig", "renew-kubeconfig"),
 				Entry("renew-workload-identity-tokens", "renew-workload-identity-tokens"),
+				Entry("rotate-ca-start", "rotate-ca-start"),
 			)
 
 			DescribeTable("should do nothing if a valid operation annotation does not change during an update", func(operation string) {
@@ -192,6 +196,7 @@ var _ = Describe("Seed Validation Tests", func() {
 				Entry("renew-garden-access-secrets", "renew-garden-access-secrets"),
 				Entry("renew-kubeconfig", "renew-kubeconfig"),
 				Entry("renew-workload-identity-tokens", "renew-workload-identity-tokens"),
+				Entry("rotate-ca-start", "rotate-ca-start"),
 			)
 
 			It("should return an error if a valid operation should be overwritten with a different valid operation", func() {
@@ -205,6 +210,65 @@ var _ = Describe("Seed Validation Tests", func() {
 						"Detail": Equal("must not overwrite operation \"renew-garden-access-secrets\" with \"renew-kubeconfig\""),
 					}))))
 			})
+
+			Context("CA rotation", func() {
+				DescribeTable("starting CA rotation",
+					func(allowed bool, status core.SeedStatus) {
+						metav1.SetMetaDataAnnotation(&seed.ObjectMeta, "gardener.cloud/operation", "rotate-ca-start")
+						seed.Status = status
+
+						matcher := BeEmpty()
+						if !allowed {
+							matcher = ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+								"Type":   Equal(field.ErrorTypeForbidden),
+								"Field":  Equal("metadata.annotations[gardener.cloud/operation]"),
+								"Detail": ContainSubstring("cannot start CA rotation"),
+							})))
+						}
+
+						Expect(ValidateSeed(seed)).To(matcher)
+					},
+
+					Entry("no rotation status", true, core.SeedStatus{}),
+					Entry("phase is Prepared", false, core.SeedStatus{Credentials: &core.SeedCredentials{Rotation: &core.SeedCredentialsRotation{CertificateAuthorities: &core.CARotation{Phase: core.RotationPrepared}}}}),
+					Entry("phase is Completing", false, core.SeedStatus{Credentials: &core.SeedCredentials{Rotation: &core.SeedCredentialsRotation{CertificateAuthorities: &core.CARotation{Phase: core.RotationCompleting}}}}),
+					Entry("phase is Completed", true, core.SeedStatus{Credentials: &core.SeedCredentials{Rotation: &core.SeedCredentialsRotation{CertificateAuthorities: &core.CARotation{Phase: core.RotationCompleted}}}}),
+				)
+
+				DescribeTable("completing CA rotation",
+					func(allowed bool, status core.SeedStatus) {
+						metav1.SetMetaDataAnnotation(&seed.ObjectMeta, "gardener.cloud/operation", "rotate-ca-complete")
+						seed.Status = status
+
+						matcher := BeEmpty()
+						if !allowed {
+							matcher = ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+								"Type":   Equal(field.ErrorTypeForbidden),
+								"Field":  Equal("metadata.annotations[gardener.cloud/operation]"),
+								"Detail": ContainSubstring("cannot complete CA rotation"),
+							})))
+						}
+
+						Expect(ValidateSeed(seed)).To(matcher)
+					},
+
+					Entry("no rotation status", false, core.SeedStatus{}),
+					Entry("phase is Preparing", false, core.SeedStatus{Credentials: &core.SeedCredentials{Rotation: &core.SeedCredentialsRotation{CertificateAuthorities: &core.CARotation{Phase: core.RotationPreparing}}}}),
+					Entry("phase is Prepared", true, core.SeedStatus{Credentials: &core.SeedCredentials{Rotation: &core.SeedCredentialsRotation{CertificateAuthorities: &core.CARotation{Phase: core.RotationPrepared}}}}),
+					Entry("phase is Completed", false, core.SeedStatus{Credentials: &core.SeedCredentials{Rotation: &core.SeedCredentialsRotation{CertificateAuthorities: &core.CARotation{Phase: core.RotationCompleted}}}}),
+				)
+
+				It("should forbid starting CA rotation if the seed has a deletion timestamp", func() {
+					metav1.SetMetaDataAnnotation(&seed.ObjectMeta, "gardener.cloud/operation", "rotate-ca-start")
+					seed.DeletionTimestamp = &metav1.Time{}
+
+					Expect(ValidateSeed(seed)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeForbidden),
+						"Field":  Equal("metadata.annotations[gardener.cloud/operation]"),
+						"Detail": Equal("cannot start CA rotation if seed has deletion timestamp"),
+					}))))
+				})
+			})
 		})
 
 		It("should forbid Seed specification with empty or invalid keys", func() {
@@ -324,6 +388,43 @@ var _ = Describe("Seed Validation Tests", func() {
 			))
 		})
 
+		It("should allow valid taint effects", func() {
+			seed.Spec.Taints = []core.SeedTaint{
+				{Key: "foo", Effect: ptr.To(core.SeedTaintEffectNoSchedule)},
+				{Key: "bar", Effect: ptr.To(core.SeedTaintEffectPreferNoSchedule)},
+				{Key: "baz", Effect: ptr.To(core.SeedTaintEffectNoExecute)},
+			}
+
+			Expect(ValidateSeed(seed)).To(BeEmpty())
+		})
+
+		It("should forbid unsupported taint effects", func() {
+			seed.Spec.Taints = []core.SeedTaint{
+				{Key: "foo", Effect: ptr.To(core.SeedTaintEffect("Evict"))},
+			}
+
+			Expect(ValidateSeed(seed)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("spec.taints[0].effect"),
+				})),
+			))
+		})
+
+		It("should forbid invalid region metadata", func() {
+			seed.Spec.Provider.RegionMetadata = &core.RegionMetadata{
+				Geography:    ptr.To(""),
+				Jurisdiction: ptr.To("EU"),
+			}
+
+			Expect(ValidateSeed(seed)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.provider.regionMetadata.geography"),
+				})),
+			))
+		})
+
 		Context("networks", func() {
 			It("should forbid specifying unsupported IP family", func() {
 				seed.Spec.Networks.IPFamilies = []core.IPFamily{"IPv5"}
@@ -1055,6 +1156,87 @@ var _ = Describe("Seed Validation Tests", func() {
 
 				Expect(errorList).To(BeEmpty())
 			})
+
+			It("should allow a valid ACME configuration", func() {
+				seed.Spec.Settings = &core.SeedSettings{
+					ACME: &core.SeedSettingACME{
+						DirectoryURL: "https://acme-v02.api.letsencrypt.org/directory",
+						Email:        ptr.To("admin@example.com"),
+					},
+				}
+
+				Expect(ValidateSeed(seed)).To(BeEmpty())
+			})
+
+			It("should forbid an invalid ACME configuration", func() {
+				seed.Spec.Settings = &core.SeedSettings{
+					ACME: &core.SeedSettingACME{
+						DirectoryURL: "http://acme.example.com/directory",
+						Email:        ptr.To("admin"),
+					},
+				}
+
+				Expect(ValidateSeed(seed)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.settings.acme.directoryURL"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.settings.acme.email"),
+					})),
+				))
+			})
+
+			It("should require the ACME directory URL", func() {
+				seed.Spec.Settings = &core.SeedSettings{
+					ACME: &core.SeedSettingACME{},
+				}
+
+				Expect(ValidateSeed(seed)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.settings.acme.directoryURL"),
+					})),
+				))
+			})
+		})
+
+		Context("maintenance", func() {
+			It("should allow a valid maintenance time window", func() {
+				seed.Spec.Maintenance = &core.SeedMaintenance{
+					TimeWindow: core.MaintenanceTimeWindow{Begin: "220000+0000", End: "230000+0000"},
+				}
+
+				Expect(ValidateSeed(seed)).To(BeEmpty())
+			})
+
+			It("should forbid an invalid maintenance time window", func() {
+				seed.Spec.Maintenance = &core.SeedMaintenance{
+					TimeWindow: core.MaintenanceTimeWindow{Begin: "invalid", End: "230000+0000"},
+				}
+
+				Expect(ValidateSeed(seed)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.maintenance.timeWindow.begin/end"),
+					})),
+				))
+			})
+
+			It("should forbid a too short maintenance time window", func() {
+				seed.Spec.Maintenance = &core.SeedMaintenance{
+					TimeWindow: core.MaintenanceTimeWindow{Begin: "220000+0000", End: "221000+0000"},
+				}
+
+				Expect(ValidateSeed(seed)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("spec.maintenance.timeWindow"),
+						"Detail": ContainSubstring("time window must not be smaller than"),
+					})),
+				))
+			})
 		})
 
 		It("should fail updating immutable fields", func() {
@@ -1118,6 +1300,58 @@ var _ = Describe("Seed Validation Tests", func() {
 					"Detail": Equal(`field is immutable`),
 				}))
 			})
+
+			It("should forbid an invalid immutability configuration", func() {
+				seed.Spec.Backup.Immutability = &core.BackupBucketImmutability{
+					RetentionMode: core.BackupBucketRetentionModeGovernance,
+				}
+
+				Expect(ValidateSeed(seed)).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.backup.immutability.retentionPeriod"),
+				}))
+			})
+
+			It("should allow valid bounds for the etcd backup settings of shoots", func() {
+				seed.Spec.Backup.ETCD = &core.SeedBackupETCD{
+					MinFullSnapshotInterval:         &metav1.Duration{Duration: 6 * time.Hour},
+					MaxDeltaSnapshotRetentionPeriod: &metav1.Duration{Duration: 7 * 24 * time.Hour},
+				}
+
+				Expect(ValidateSeed(seed)).To(BeEmpty())
+			})
+
+			It("should forbid invalid bounds for the etcd backup settings of shoots", func() {
+				seed.Spec.Backup.ETCD = &core.SeedBackupETCD{
+					MinFullSnapshotInterval:         &metav1.Duration{},
+					MaxDeltaSnapshotRetentionPeriod: &metav1.Duration{Duration: -time.Hour},
+				}
+
+				Expect(ValidateSeed(seed)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.backup.etcd.minFullSnapshotInterval"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.backup.etcd.maxDeltaSnapshotRetentionPeriod"),
+					})),
+				))
+			})
+
+			It("should forbid removing the immutability configuration in compliance mode", func() {
+				seed.Spec.Backup.Immutability = &core.BackupBucketImmutability{
+					RetentionMode:   core.BackupBucketRetentionModeCompliance,
+					RetentionPeriod: metav1.Duration{Duration: 30 * 24 * time.Hour},
+				}
+				newSeed := prepareSeedForUpdate(seed)
+				newSeed.Spec.Backup.Immutability = nil
+
+				Expect(ValidateSeedUpdate(newSeed, seed)).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.backup.immutability"),
+				}))
+			})
 		})
 
 		Context("ingress config", func() {
@@ -1137,16 +1371,30 @@ var _ = Describe("Seed Validation Tests", func() {
 				}
 			})
 
-			It("should fail if immutable spec.ingress.domain gets changed", func() {
+			It("should allow changing spec.ingress.domain", func() {
 				newSeed := prepareSeedForUpdate(seed)
 				newSeed.Spec.Ingress.Domain = "other-domain"
 
-				errorList := ValidateSeedUpdate(newSeed, seed)
+				Expect(ValidateSeedUpdate(newSeed, seed)).To(BeEmpty())
+			})
 
-				Expect(errorList).To(ConsistOfFields(Fields{
-					"Type":   Equal(field.ErrorTypeInvalid),
+			It("should allow changing spec.ingress.domain if the previous migration is completed", func() {
+				seed.Status.IngressDomainMigration = &core.SeedIngressDomainMigration{PreviousDomain: "previous-domain", Domain: "foo.bar.com", CompletionTime: ptr.To(metav1.Now())}
+				newSeed := prepareSeedForUpdate(seed)
+				newSeed.Spec.Ingress.Domain = "other-domain"
+
+				Expect(ValidateSeedUpdate(newSeed, seed)).To(BeEmpty())
+			})
+
+			It("should forbid changing spec.ingress.domain while a migration is in progress", func() {
+				seed.Status.IngressDomainMigration = &core.SeedIngressDomainMigration{PreviousDomain: "previous-domain", Domain: "foo.bar.com"}
+				newSeed := prepareSeedForUpdate(seed)
+				newSeed.Spec.Ingress.Domain = "other-domain"
+
+				Expect(ValidateSeedUpdate(newSeed, seed)).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeForbidden),
 					"Field":  Equal("spec.ingress.domain"),
-					"Detail": Equal(`field is immutable`),
+					"Detail": ContainSubstring(`migration from "previous-domain" to "foo.bar.com" is still in progress`),
 				}))
 			})
 
@@ -1211,6 +1459,30 @@ var _ = Describe("Seed Validation Tests", func() {
 				}))
 			})
 
+			It("should allow valid kube-apiserver ports", func() {
+				seed.Spec.Ingress.KubeAPIServerPorts = []int32{6443, 9444}
+
+				Expect(ValidateSeed(seed)).To(BeEmpty())
+			})
+
+			It("should fail if kube-apiserver ports are invalid, reserved or duplicated", func() {
+				seed.Spec.Ingress.KubeAPIServerPorts = []int32{80, 6443, 8443, 6443, 70000}
+
+				Expect(ValidateSeed(seed)).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.ingress.kubeAPIServerPorts[0]"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.ingress.kubeAPIServerPorts[2]"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("spec.ingress.kubeAPIServerPorts[3]"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.ingress.kubeAPIServerPorts[4]"),
+				}))
+			})
+
 			It("should fail if DNS provider config is invalid", func() {
 				seed.Spec.DNS.Provider = &core.SeedDNSProvider{}
 
@@ -1287,6 +1559,60 @@ var _ = Describe("Seed Validation Tests", func() {
 				})),
 			))
 		})
+
+		It("should forbid overlapping networks", func() {
+			shootDefaultPodCIDR := "10.0.1.128/28"     // 10.0.1.128 -> 10.0.1.13
+			shootDefaultServiceCIDR := "10.0.1.144/30" // 10.0.1.144 -> 10.0.1.17
+
+			nodesCIDR := "10.0.0.0/8" // 10.0.0.0 -> 10.255.255.25
+			// Pods CIDR overlaps with Nodes network
+			// Services CIDR overlaps with Nodes and Pods
+			// Shoot default pod CIDR overlaps with services
+			// Shoot default pod CIDR overlaps with shoot default pod CIDR
+			seedTemplate.Spec.Networks = core.SeedNetworks{
+				Nodes:    &nodesCIDR,     // 10.0.0.0 -> 10.255.255.25
+				Pods:     "10.0.1.0/24",  // 10.0.1.0 -> 10.0.1.25
+				Services: "10.0.1.64/26", // 10.0.1.64 -> 10.0.1.17
+				ShootDefaults: &core.ShootNetworks{
+					Pods:     &shootDefaultPodCIDR,
+					Services: &shootDefaultServiceCIDR,
+				},
+			}
+
+			errorList := ValidateSeedTemplate(seedTemplate, nil)
+
+			Expect(errorList).To(ConsistOfFields(Fields{
+				"Type":     Equal(field.ErrorTypeInvalid),
+				"Field":    Equal("spec.networks.nodes"),
+				"BadValue": Equal("10.0.0.0/8"),
+				"Detail":   Equal("must not overlap with \"spec.networks.pods\" (\"10.0.1.0/24\")"),
+			}, Fields{
+				"Type":     Equal(field.ErrorTypeInvalid),
+				"Field":    Equal("spec.networks.nodes"),
+				"BadValue": Equal("10.0.0.0/8"),
+				"Detail":   Equal("must not overlap with \"spec.networks.services\" (\"10.0.1.64/26\")"),
+			}, Fields{
+				"Type":   Equal(field.ErrorTypeInvalid),
+				"Field":  Equal("spec.networks.shootDefaults.pods"),
+				"Detail": Equal(`must not overlap with "spec.networks.nodes" ("10.0.0.0/8")`),
+			}, Fields{
+				"Type":   Equal(field.ErrorTypeInvalid),
+				"Field":  Equal("spec.networks.shootDefaults.services"),
+				"Detail": Equal(`must not overlap with "spec.networks.nodes" ("10.0.0.0/8")`),
+			}, Fields{
+				"Type":   Equal(field.ErrorTypeInvalid),
+				"Field":  Equal("spec.networks.services"),
+				"Detail": Equal(`must not overlap with "spec.networks.pods" ("10.0.1.0/24")`),
+			}, Fields{
+				"Type":   Equal(field.ErrorTypeInvalid),
+				"Field":  Equal("spec.networks.shootDefaults.pods"),
+				"Detail": Equal(`must not overlap with "spec.networks.pods" ("10.0.1.0/24")`),
+			}, Fields{
+				"Type":   Equal(field.ErrorTypeInvalid),
+				"Field":  Equal("spec.networks.shootDefaults.services"),
+				"Detail": Equal(`must not overlap with "spec.networks.pods" ("10.0.1.0/24")`),
+			}))
+		})
 	})
 
 	Describe("#ValidateSeedTemplateUpdate", func() {
@@ -5,7 +5,9 @@
 package validation
 
 import (
+	"encoding/json"
 	"fmt"
+	"maps"
 	"math/big"
 	"net"
 	"net/url"
@@ -43,6 +45,7 @@ import (
 	apigroupsvalidation "github.com/gardener/gardener/pkg/utils/validation/apigroups"
 	cidrvalidation "github.com/gardener/gardener/pkg/utils/validation/cidr"
 	featuresvalidation "github.com/gardener/gardener/pkg/utils/validation/features"
+	kubecontrollermanagervalidation "github.com/gardener/gardener/pkg/utils/validation/kubecontrollermanager"
 	kubernetescorevalidation "github.com/gardener/gardener/pkg/utils/validation/kubernetes/core"
 	versionutils "github.com/gardener/gardener/pkg/utils/version"
 )
@@ -62,6 +65,7 @@ var (
 	availableShootOperations = sets.New(
 		v1beta1constants.ShootOperationMaintain,
 		v1beta1constants.ShootOperationRetry,
+		v1beta1constants.ShootOperationBackupNow,
 	).Union(availableShootMaintenanceOperations)
 	availableShootMaintenanceOperations = sets.New(
 		v1beta1constants.GardenerOperationReconcile,
@@ -89,6 +93,17 @@ var (
 		string(core.ShootPurposeDevelopment),
 		string(core.ShootPurposeProduction),
 	)
+	availableShootSizeClasses = sets.New(
+		string(core.ShootSizeClassSmall),
+		string(core.ShootSizeClassMedium),
+		string(core.ShootSizeClassLarge),
+		string(core.ShootSizeClassExtraLarge),
+	)
+	availableMaintenanceExclusionOperations = sets.New(
+		string(core.MaintenanceExclusionOperationNodeRoll),
+		string(core.MaintenanceExclusionOperationEtcdMaintenance),
+		string(core.MaintenanceExclusionOperationCARotation),
+	)
 	availableWorkerCRINames = sets.New(
 		string(core.CRINameContainerD),
 	)
@@ -98,6 +113,55 @@ var (
 		string(core.ClusterAutoscalerExpanderPriority),
 		string(core.ClusterAutoscalerExpanderRandom),
 	)
+	availableWorkerCapacityTypes = sets.New(
+		string(core.CapacityTypeOnDemand),
+		string(core.CapacityTypeSpot),
+		string(core.CapacityTypeSpotWithFallback),
+	)
+	availableWorkerUpdateStrategies = sets.New(
+		string(core.WorkerUpdateStrategyRollingUpdate),
+		string(core.WorkerUpdateStrategyInPlace),
+	)
+	// allowedWorkerSysctlNamespaces is the list of top-level sysctl namespaces which can be configured for worker pools.
+	allowedWorkerSysctlNamespaces = sets.New(
+		"fs",
+		"kernel",
+		"net",
+		"user",
+		"vm",
+	)
+	// allowedWorkerKernelModules is the list of kernel modules which can be loaded on the machines of worker pools.
+	allowedWorkerKernelModules = sets.New(
+		"8021q",
+		"bonding",
+		"br_netfilter",
+		"ceph",
+		"dm_thin_pool",
+		"ip_tables",
+		"ip_vs",
+		"ip_vs_rr",
+		"ip_vs_sh",
+		"ip_vs_wrr",
+		"ip6_tables",
+		"iptable_filter",
+		"iptable_mangle",
+		"iptable_nat",
+		"iscsi_tcp",
+		"nbd",
+		"nf_conntrack",
+		"nvme_tcp",
+		"overlay",
+		"rbd",
+		"sctp",
+		"tcp_bbr",
+		"vxlan",
+		"wireguard",
+		"xt_conntrack",
+	)
+	availableMachineBootstrapFailurePolicies = sets.New(
+		string(core.MachineBootstrapFailurePolicyIgnore),
+		string(core.MachineBootstrapFailurePolicyReplace),
+	)
 	availableCoreDNSAutoscalingModes = sets.New(
 		string(core.CoreDNSAutoscalingModeClusterProportional),
 		string(core.CoreDNSAutoscalingModeHorizontal),
@@ -133,7 +197,8 @@ var (
 		"none",
 	)
 
-	workerlessErrorMsg = "this field should not be set for workerless Shoot clusters"
+	workerlessErrorMsg         = "this field should not be set for workerless Shoot clusters"
+	addonRequiresNodesErrorMsg = "addon requires nodes and cannot be enabled for workerless Shoot clusters"
 )
 
 // ValidateShoot validates a Shoot object.
@@ -263,7 +328,7 @@ func ValidateShootSpec(meta metav1.ObjectMeta, spec *core.ShootSpec, fldPath *fi
 	allErrs = append(allErrs, validateResources(spec.Resources, fldPath.Child("resources"))...)
 	allErrs = append(allErrs, validateKubernetes(spec.Kubernetes, spec.Networking, workerless, fldPath.Child("kubernetes"))...)
 	allErrs = append(allErrs, validateNetworking(spec.Networking, workerless, fldPath.Child("networking"))...)
-	allErrs = append(allErrs, validateMaintenance(spec.Maintenance, fldPath.Child("maintenance"), workerless)...)
+	allErrs = append(allErrs, validateMaintenance(spec.Maintenance, spec.Provider.Workers, fldPath.Child("maintenance"), workerless)...)
 	allErrs = append(allErrs, validateMonitoring(spec.Monitoring, fldPath.Child("monitoring"))...)
 	allErrs = append(allErrs, ValidateHibernation(meta.Annotations, spec.Hibernation, fldPath.Child("hibernation"))...)
 
@@ -277,6 +342,9 @@ func ValidateShootSpec(meta metav1.ObjectMeta, spec *core.ShootSpec, fldPath *fi
 		if spec.CredentialsBindingName != nil {
 			allErrs = append(allErrs, field.Forbidden(fldPath.Child("credentialsBindingName"), workerlessErrorMsg))
 		}
+		if spec.ControlPlane != nil && spec.ControlPlane.Egress != nil && spec.ControlPlane.Egress.Dedicated {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("controlPlane", "egress", "dedicated"), workerlessErrorMsg))
+		}
 	} else {
 		if len(ptr.Deref(spec.SecretBindingName, "")) == 0 && len(ptr.Deref(spec.CredentialsBindingName, "")) == 0 {
 			allErrs = append(allErrs, field.Required(fldPath.Child("secretBindingName"), "must be set when credentialsBindingName is not"))
@@ -301,7 +369,56 @@ func ValidateShootSpec(meta metav1.ObjectMeta, spec *core.ShootSpec, fldPath *fi
 		}
 	}
 	allErrs = append(allErrs, ValidateTolerations(spec.Tolerations, fldPath.Child("tolerations"))...)
-	allErrs = append(allErrs, ValidateSystemComponents(spec.SystemComponents, fldPath.Child("systemComponents"), workerless)...)
+	allErrs = append(allErrs, ValidateSystemComponents(spec.SystemComponents, spec.Provider.Workers, fldPath.Child("systemComponents"), workerless)...)
+	allErrs = append(allErrs, validateDataResidency(spec.DataResidency, fldPath.Child("dataResidency"))...)
+	allErrs = append(allErrs, validateETCD(spec.ETCD, fldPath.Child("etcd"))...)
+
+	return allErrs
+}
+
+func validateDataResidency(dataResidency *core.DataResidency, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if dataResidency == nil {
+		return allErrs
+	}
+
+	validateValues := func(values []string, fldPath *field.Path) {
+		found := sets.New[string]()
+		for i, value := range values {
+			if len(value) == 0 {
+				allErrs = append(allErrs, field.Invalid(fldPath.Index(i), value, "must not be empty"))
+			} else if found.Has(value) {
+				allErrs = append(allErrs, field.Duplicate(fldPath.Index(i), value))
+			}
+			found.Insert(value)
+		}
+	}
+
+	validateValues(dataResidency.Jurisdictions, fldPath.Child("jurisdictions"))
+	validateValues(dataResidency.Geographies, fldPath.Child("geographies"))
+
+	return allErrs
+}
+
+func validateETCD(etcd *core.ETCD, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if etcd == nil || etcd.Backup == nil {
+		return allErrs
+	}
+
+	backupPath := fldPath.Child("backup")
+
+	if schedule := etcd.Backup.FullSnapshotSchedule; schedule != nil {
+		if _, err := cron.ParseStandard(*schedule); err != nil {
+			allErrs = append(allErrs, field.Invalid(backupPath.Child("fullSnapshotSchedule"), *schedule, fmt.Sprintf("not a valid cron spec: %v", err)))
+		}
+	}
+
+	if retentionPeriod := etcd.Backup.DeltaSnapshotRetentionPeriod; retentionPeriod != nil && retentionPeriod.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(backupPath.Child("deltaSnapshotRetentionPeriod"), retentionPeriod.Duration.String(), "must be larger than 0"))
+	}
 
 	return allErrs
 }
@@ -332,11 +449,13 @@ func ValidateShootSpecUpdate(newSpec, oldSpec *core.ShootSpec, newObjectMeta met
 		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.SecretBindingName, oldSpec.SecretBindingName, fldPath.Child("secretBindingName"))...)
 	}
 	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.ExposureClassName, oldSpec.ExposureClassName, fldPath.Child("exposureClassName"))...)
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.ShootClassName, oldSpec.ShootClassName, fldPath.Child("shootClassName"))...)
 
 	allErrs = append(allErrs, validateDNSUpdate(newSpec.DNS, oldSpec.DNS, newSpec.SeedName != nil, fldPath.Child("dns"))...)
 	allErrs = append(allErrs, ValidateKubernetesVersionUpdate(newSpec.Kubernetes.Version, oldSpec.Kubernetes.Version, false, fldPath.Child("kubernetes", "version"))...)
 
 	allErrs = append(allErrs, validateKubeControllerManagerUpdate(newSpec.Kubernetes.KubeControllerManager, oldSpec.Kubernetes.KubeControllerManager, fldPath.Child("kubernetes", "kubeControllerManager"))...)
+	allErrs = append(allErrs, validateAuditSinkUpdate(newSpec.Kubernetes.KubeAPIServer, oldSpec.Kubernetes.KubeAPIServer, fldPath.Child("kubernetes", "kubeAPIServer", "auditConfig", "sink"))...)
 
 	if newSpec.SeedName != nil {
 		if oldSpec.SeedSelector == nil && newSpec.SeedSelector != nil {
@@ -375,6 +494,8 @@ func ValidateShootSpecUpdate(newSpec, oldSpec *core.ShootSpec, newObjectMeta met
 
 		// worker kubernetes versions must not be downgraded and but can skip minor versions
 		allErrs = append(allErrs, ValidateKubernetesVersionUpdate(newKubernetesVersion, oldKubernetesVersion, true, idxPath.Child("kubernetes", "version"))...)
+		allErrs = append(allErrs, validateWorkerUpdateStrategyUpdate(newWorker, oldWorker, idxPath)...)
+		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newWorker.Machine.OSFamily, oldWorker.Machine.OSFamily, idxPath.Child("machine", "osFamily"))...)
 	}
 
 	allErrs = append(allErrs, validateNetworkingUpdate(newSpec.Networking, oldSpec.Networking, fldPath.Child("networking"))...)
@@ -389,6 +510,27 @@ func ValidateShootSpecUpdate(newSpec, oldSpec *core.ShootSpec, newObjectMeta met
 	return allErrs
 }
 
+func validateWorkerUpdateStrategyUpdate(newWorker, oldWorker core.Worker, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs           field.ErrorList
+		newUpdateStrategy = ptr.Deref(newWorker.UpdateStrategy, core.WorkerUpdateStrategyRollingUpdate)
+		oldUpdateStrategy = ptr.Deref(oldWorker.UpdateStrategy, core.WorkerUpdateStrategyRollingUpdate)
+	)
+
+	// Nodes of worker pools with the in-place update strategy are not replaced, hence the strategy cannot be switched
+	// for existing worker pools.
+	if newUpdateStrategy != oldUpdateStrategy {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("updateStrategy"), newWorker.UpdateStrategy, "field is immutable"))
+	}
+
+	if newUpdateStrategy == core.WorkerUpdateStrategyInPlace && oldUpdateStrategy == core.WorkerUpdateStrategyInPlace &&
+		newWorker.Machine.Image != nil && oldWorker.Machine.Image != nil && newWorker.Machine.Image.Name != oldWorker.Machine.Image.Name {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("machine", "image", "name"), newWorker.Machine.Image.Name, "machine image name cannot be changed for worker pools with the in-place update strategy"))
+	}
+
+	return allErrs
+}
+
 func validateWorkerUpdate(newHasWorkers, oldHasWorkers bool, fldPath *field.Path) *field.Error {
 	if oldHasWorkers && !newHasWorkers {
 		return field.Forbidden(fldPath, "cannot switch from a Shoot with workers to a workerless Shoot")
@@ -428,6 +570,37 @@ func ValidateShootStatusUpdate(newStatus, oldStatus core.ShootStatus) field.Erro
 
 	allErrs = append(allErrs, validateNetworkingStatus(newStatus.Networking, fldPath.Child("networking"))...)
 
+	if sizeClass := newStatus.SizeClass; sizeClass != nil && !availableShootSizeClasses.Has(string(*sizeClass)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("sizeClass"), *sizeClass, sets.List(availableShootSizeClasses)))
+	}
+
+	allErrs = append(allErrs, validateLegacyComponentRemovals(newStatus.LegacyComponentRemovals, fldPath.Child("legacyComponentRemovals"))...)
+
+	return allErrs
+}
+
+func validateLegacyComponentRemovals(removals []core.LegacyComponentRemoval, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs         = field.ErrorList{}
+		names           = sets.New[string]()
+		availableStates = sets.New(string(core.LastOperationStateProcessing), string(core.LastOperationStateSucceeded))
+	)
+
+	for i, removal := range removals {
+		idxPath := fldPath.Index(i)
+
+		if removal.Name == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide a name"))
+		} else if names.Has(removal.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), removal.Name))
+		}
+		names.Insert(removal.Name)
+
+		if !availableStates.Has(string(removal.State)) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("state"), removal.State, sets.List(availableStates)))
+		}
+	}
+
 	return allErrs
 }
 
@@ -478,8 +651,15 @@ func validateAddons(addons *core.Addons, purpose *core.ShootPurpose, workerless
 	allErrs := field.ErrorList{}
 
 	if workerless && addons != nil {
-		allErrs = append(allErrs, field.Forbidden(fldPath, "addons cannot be enabled for Workerless Shoot clusters"))
-		return allErrs
+		// The built-in addons run workloads in the shoot cluster and hence require nodes. Managed addons declare whether
+		// they support workerless Shoot clusters in the CloudProfile, which is checked by the ShootValidator admission
+		// plugin.
+		if addons.KubernetesDashboard != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("kubernetesDashboard"), addonRequiresNodesErrorMsg))
+		}
+		if addons.NginxIngress != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("nginxIngress"), addonRequiresNodesErrorMsg))
+		}
 	}
 
 	if (helper.NginxIngressEnabled(addons) || helper.KubernetesDashboardEnabled(addons)) && (purpose != nil && *purpose != core.ShootPurposeEvaluation) {
@@ -502,6 +682,41 @@ func validateAddons(addons *core.Addons, purpose *core.ShootPurpose, workerless
 		}
 	}
 
+	if addons != nil {
+		allErrs = append(allErrs, validateManagedAddons(addons.Managed, fldPath.Child("managed"))...)
+	}
+
+	return allErrs
+}
+
+func validateManagedAddons(addons []core.ManagedAddon, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs = field.ErrorList{}
+		names   = sets.New[string]()
+	)
+
+	for i, addon := range addons {
+		idxPath := fldPath.Index(i)
+
+		if len(addon.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide an addon name"))
+		} else if names.Has(addon.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), addon.Name))
+		}
+		names.Insert(addon.Name)
+
+		if len(addon.Version) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("version"), "must provide an addon version"))
+		}
+
+		if addon.Values != nil {
+			var values map[string]any
+			if err := json.Unmarshal(addon.Values.Raw, &values); err != nil {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("values"), string(addon.Values.Raw), "must be a JSON object"))
+			}
+		}
+	}
+
 	return allErrs
 }
 
@@ -595,6 +810,25 @@ func validateKubeControllerManagerUpdate(newConfig, oldConfig *core.KubeControll
 	return allErrs
 }
 
+func validateAuditSinkUpdate(newConfig, oldConfig *core.KubeAPIServerConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	auditSink := func(config *core.KubeAPIServerConfig) *core.AuditSink {
+		if config == nil || config.AuditConfig == nil {
+			return nil
+		}
+		return config.AuditConfig.Sink
+	}
+
+	// The type of the audit sink cannot be changed in-place since the AuditSink extension resource would need to be
+	// handed over to another extension. The sink has to be removed first before a sink of a different type can be added.
+	if newSink, oldSink := auditSink(newConfig), auditSink(oldConfig); newSink != nil && oldSink != nil {
+		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSink.Type, oldSink.Type, fldPath.Child("type"))...)
+	}
+
+	return allErrs
+}
+
 func validateDNSUpdate(new, old *core.DNS, seedGotAssigned bool, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -681,7 +915,9 @@ func validateNetworkingUpdate(newNetworking, oldNetworking *core.Networking, fld
 	}
 
 	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newNetworking.Type, oldNetworking.Type, fldPath.Child("type"))...)
-	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newNetworking.IPFamilies, oldNetworking.IPFamilies, fldPath.Child("ipFamilies"))...)
+	if !isIPv4ToDualStackMigration(oldNetworking.IPFamilies, newNetworking.IPFamilies) {
+		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newNetworking.IPFamilies, oldNetworking.IPFamilies, fldPath.Child("ipFamilies"))...)
+	}
 	if oldNetworking.Pods != nil {
 		allErrs = append(allErrs, apivalidation.ValidateImmutableField(newNetworking.Pods, oldNetworking.Pods, fldPath.Child("pods"))...)
 	}
@@ -692,6 +928,12 @@ func validateNetworkingUpdate(newNetworking, oldNetworking *core.Networking, fld
 	return allErrs
 }
 
+// isIPv4ToDualStackMigration returns true if the IP families are changed from IPv4 single-stack to dual-stack networking
+// with IPv4 as primary IP family. This is the only supported change of the IP families of existing shoots.
+func isIPv4ToDualStackMigration(oldIPFamilies, newIPFamilies []core.IPFamily) bool {
+	return core.IsIPv4SingleStack(oldIPFamilies) && slices.Equal(newIPFamilies, []core.IPFamily{core.IPFamilyIPv4, core.IPFamilyIPv6})
+}
+
 // validateWorkerGroupAndControlPlaneKubernetesVersion ensures that new version is newer than old version and does not skip two minor
 func validateWorkerGroupAndControlPlaneKubernetesVersion(controlPlaneVersion, workerGroupVersion string, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -839,6 +1081,7 @@ func validateKubernetes(kubernetes core.Kubernetes, networking *core.Networking,
 
 	allErrs = append(allErrs, ValidateKubeAPIServer(kubernetes.KubeAPIServer, kubernetes.Version, workerless, gardenerutils.DefaultResourcesForEncryption(), fldPath.Child("kubeAPIServer"))...)
 	allErrs = append(allErrs, ValidateKubeControllerManager(kubernetes.KubeControllerManager, networking, kubernetes.Version, workerless, fldPath.Child("kubeControllerManager"))...)
+	allErrs = append(allErrs, validateNamespaceDefaults(kubernetes.NamespaceDefaults, fldPath.Child("namespaceDefaults"))...)
 
 	if workerless {
 		allErrs = append(allErrs, validateKubernetesForWorkerlessShoot(kubernetes, fldPath)...)
@@ -862,6 +1105,114 @@ func validateKubernetes(kubernetes core.Kubernetes, networking *core.Networking,
 	return allErrs
 }
 
+var (
+	availableLimitTypes = sets.New(
+		corev1.LimitTypePod,
+		corev1.LimitTypeContainer,
+		corev1.LimitTypePersistentVolumeClaim,
+	)
+	availableResourceQuotaScopes = sets.New(
+		corev1.ResourceQuotaScopeTerminating,
+		corev1.ResourceQuotaScopeNotTerminating,
+		corev1.ResourceQuotaScopeBestEffort,
+		corev1.ResourceQuotaScopeNotBestEffort,
+		corev1.ResourceQuotaScopePriorityClass,
+		corev1.ResourceQuotaScopeCrossNamespacePodAffinity,
+	)
+)
+
+func validateNamespaceDefaults(namespaceDefaults *core.NamespaceDefaults, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if namespaceDefaults == nil {
+		return allErrs
+	}
+
+	if namespaceDefaults.NamespaceSelector != nil {
+		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(namespaceDefaults.NamespaceSelector, metav1validation.LabelSelectorValidationOptions{}, fldPath.Child("namespaceSelector"))...)
+	}
+
+	limitRangeNames := sets.New[string]()
+	for i, limitRange := range namespaceDefaults.LimitRanges {
+		idxPath := fldPath.Child("limitRanges").Index(i)
+		allErrs = append(allErrs, validateNamespaceDefaultsName(limitRange.Name, limitRangeNames, idxPath.Child("name"))...)
+
+		if len(limitRange.Spec.Limits) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("spec", "limits"), "at least one limit must be specified"))
+		}
+
+		for j, limit := range limitRange.Spec.Limits {
+			limitPath := idxPath.Child("spec", "limits").Index(j)
+
+			if !availableLimitTypes.Has(limit.Type) {
+				allErrs = append(allErrs, field.NotSupported(limitPath.Child("type"), limit.Type, sets.List(availableLimitTypes)))
+			}
+
+			for _, resources := range []struct {
+				name string
+				list corev1.ResourceList
+			}{
+				{"max", limit.Max},
+				{"min", limit.Min},
+				{"default", limit.Default},
+				{"defaultRequest", limit.DefaultRequest},
+				{"maxLimitRequestRatio", limit.MaxLimitRequestRatio},
+			} {
+				allErrs = append(allErrs, validateResourceList(resources.list, limitPath.Child(resources.name))...)
+			}
+		}
+	}
+
+	resourceQuotaNames := sets.New[string]()
+	for i, resourceQuota := range namespaceDefaults.ResourceQuotas {
+		idxPath := fldPath.Child("resourceQuotas").Index(i)
+		allErrs = append(allErrs, validateNamespaceDefaultsName(resourceQuota.Name, resourceQuotaNames, idxPath.Child("name"))...)
+
+		if len(resourceQuota.Spec.Hard) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("spec", "hard"), "at least one hard limit must be specified"))
+		}
+		allErrs = append(allErrs, validateResourceList(resourceQuota.Spec.Hard, idxPath.Child("spec", "hard"))...)
+
+		for j, scope := range resourceQuota.Spec.Scopes {
+			if !availableResourceQuotaScopes.Has(scope) {
+				allErrs = append(allErrs, field.NotSupported(idxPath.Child("spec", "scopes").Index(j), scope, sets.List(availableResourceQuotaScopes)))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+func validateNamespaceDefaultsName(name string, names sets.Set[string], fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(name) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath, "name must be specified"))
+		return allErrs
+	}
+
+	for _, msg := range apivalidation.NameIsDNSSubdomain(name, false) {
+		allErrs = append(allErrs, field.Invalid(fldPath, name, msg))
+	}
+
+	if names.Has(name) {
+		allErrs = append(allErrs, field.Duplicate(fldPath, name))
+	}
+	names.Insert(name)
+
+	return allErrs
+}
+
+func validateResourceList(resources corev1.ResourceList, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for _, name := range slices.Sorted(maps.Keys(resources)) {
+		allErrs = append(allErrs, kubernetescorevalidation.ValidateResourceQuantityValue(string(name), resources[name], fldPath.Key(string(name)))...)
+	}
+
+	return allErrs
+}
+
 func validateKubernetesForWorkerlessShoot(kubernetes core.Kubernetes, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -1045,6 +1396,130 @@ func ValidateAPIServerLogging(loggingConfig *core.APIServerLogging, fldPath *fie
 	return allErrs
 }
 
+func validateAuthorizedNetworks(authorizedNetworks []string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	networks := sets.New[string]()
+
+	for i, network := range authorizedNetworks {
+		idxPath := fldPath.Index(i)
+
+		cidr := cidrvalidation.NewCIDR(network, idxPath)
+		if errs := cidr.ValidateParse(); len(errs) > 0 {
+			allErrs = append(allErrs, errs...)
+			continue
+		}
+		allErrs = append(allErrs, cidrvalidation.ValidateCIDRIsCanonical(idxPath, cidr.GetCIDR())...)
+
+		if networks.Has(network) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, network))
+		}
+		networks.Insert(network)
+	}
+
+	return allErrs
+}
+
+func validateKubeAPIServerEndpoint(endpoint *core.KubeAPIServerEndpoint, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if endpoint == nil {
+		return allErrs
+	}
+
+	hostnames := sets.New[string]()
+	for i, hostname := range endpoint.AdditionalHostnames {
+		idxPath := fldPath.Child("additionalHostnames").Index(i)
+
+		allErrs = append(allErrs, ValidateDNS1123Subdomain(hostname, idxPath)...)
+
+		if hostnames.Has(hostname) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, hostname))
+		}
+		hostnames.Insert(hostname)
+	}
+
+	if endpoint.Port != nil {
+		for _, msg := range validation.IsValidPortNum(int(*endpoint.Port)) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("port"), *endpoint.Port, msg))
+		}
+		if *endpoint.Port == 443 {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("port"), "port 443 is always served and must not be configured"))
+		}
+	}
+
+	if ptr.Deref(endpoint.ManagedCertificate, false) && len(endpoint.AdditionalHostnames) == 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("managedCertificate"), "a managed certificate can only be requested for additional host names"))
+	}
+
+	return allErrs
+}
+
+const (
+	minVPNHighAvailabilityReplicas = 2
+	maxVPNHighAvailabilityReplicas = 4
+)
+
+func validateKubeAPIServerVPN(vpn *core.KubeAPIServerVPNConfig, workerless bool, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if vpn == nil {
+		return allErrs
+	}
+
+	if workerless {
+		return append(allErrs, field.Forbidden(fldPath, workerlessErrorMsg))
+	}
+
+	for _, f := range []struct {
+		name     string
+		replicas *int32
+	}{
+		{"seedServers", vpn.SeedServers},
+		{"shootClients", vpn.ShootClients},
+	} {
+		name, replicas := f.name, f.replicas
+		if replicas == nil {
+			continue
+		}
+
+		if *replicas < minVPNHighAvailabilityReplicas || *replicas > maxVPNHighAvailabilityReplicas {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child(name), *replicas, fmt.Sprintf("must be between %d and %d", minVPNHighAvailabilityReplicas, maxVPNHighAvailabilityReplicas)))
+		}
+		if vpn.HighAvailability != nil && !*vpn.HighAvailability {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child(name), "can only be configured for the highly available VPN"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateAPIPriorityAndFairness(apf *core.APIPriorityAndFairness, requests *core.APIServerRequests, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if apf == nil {
+		return allErrs
+	}
+
+	if apf.MaxSeats != nil {
+		if *apf.MaxSeats <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("maxSeats"), *apf.MaxSeats, "must be greater than 0"))
+		}
+		if requests != nil && (requests.MaxNonMutatingInflight != nil || requests.MaxMutatingInflight != nil) {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("maxSeats"), "cannot be combined with the inflight limits in .requests"))
+		}
+	}
+
+	if apf.ConfigMapName != nil {
+		if len(*apf.ConfigMapName) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("configMapName"), "must provide a name"))
+		} else {
+			allErrs = append(allErrs, ValidateDNS1123Subdomain(*apf.ConfigMapName, fldPath.Child("configMapName"))...)
+		}
+	}
+
+	return allErrs
+}
+
 // ValidateAPIServerRequests validates the given KubeAPIServer request fields.
 func ValidateAPIServerRequests(requests *core.APIServerRequests, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -1349,6 +1824,17 @@ func ValidateKubeAPIServer(kubeAPIServer *core.KubeAPIServerConfig, version stri
 		if auditPolicy := auditConfig.AuditPolicy; auditPolicy != nil && auditConfig.AuditPolicy.ConfigMapRef != nil {
 			allErrs = append(allErrs, ValidateAuditPolicyConfigMapReference(auditPolicy.ConfigMapRef, auditPath.Child("auditPolicy", "configMapRef"))...)
 		}
+		if webhook := auditConfig.Webhook; webhook != nil {
+			allErrs = append(allErrs, validateAuditWebhook(webhook, auditPath.Child("webhook"))...)
+		}
+		if sink := auditConfig.Sink; sink != nil {
+			if auditConfig.Webhook != nil {
+				allErrs = append(allErrs, field.Forbidden(auditPath.Child("sink"), "sink and webhook are mutually exclusive"))
+			}
+			if len(sink.Type) == 0 {
+				allErrs = append(allErrs, field.Required(auditPath.Child("sink", "type"), "must provide the type of the audit sink"))
+			}
+		}
 	}
 
 	k8sLess130, _ := versionutils.CheckVersionMeetsConstraint(version, "< 1.30")
@@ -1399,6 +1885,14 @@ func ValidateKubeAPIServer(kubeAPIServer *core.KubeAPIServerConfig, version stri
 
 	allErrs = append(allErrs, ValidateAPIServerRequests(kubeAPIServer.Requests, fldPath.Child("requests"))...)
 
+	allErrs = append(allErrs, validateAuthorizedNetworks(kubeAPIServer.AuthorizedNetworks, fldPath.Child("authorizedNetworks"))...)
+
+	allErrs = append(allErrs, validateKubeAPIServerEndpoint(kubeAPIServer.Endpoint, fldPath.Child("endpoint"))...)
+
+	allErrs = append(allErrs, validateAPIPriorityAndFairness(kubeAPIServer.APIPriorityAndFairness, kubeAPIServer.Requests, fldPath.Child("apiPriorityAndFairness"))...)
+
+	allErrs = append(allErrs, validateKubeAPIServerVPN(kubeAPIServer.VPN, workerless, fldPath.Child("vpn"))...)
+
 	if kubeAPIServer.ServiceAccountConfig != nil {
 		if kubeAPIServer.ServiceAccountConfig.MaxTokenExpiration != nil {
 			if kubeAPIServer.ServiceAccountConfig.MaxTokenExpiration.Duration < 0 {
@@ -1447,6 +1941,22 @@ func ValidateKubeAPIServer(kubeAPIServer *core.KubeAPIServerConfig, version stri
 	return allErrs
 }
 
+func validateAuditWebhook(webhook *core.AuditWebhook, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(webhook.KubeconfigSecretName) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("kubeconfigSecretName"), "must provide the name of the secret containing the kubeconfig"))
+	}
+	if batchMaxSize := webhook.BatchMaxSize; batchMaxSize != nil && *batchMaxSize < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("batchMaxSize"), *batchMaxSize, "must be at least 1"))
+	}
+	if batchMaxWait := webhook.BatchMaxWait; batchMaxWait != nil && batchMaxWait.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("batchMaxWait"), *batchMaxWait, "must be a positive duration"))
+	}
+
+	return allErrs
+}
+
 // ValidateOIDCIssuerURL validates if the given issuerURL follow the expected format.
 func ValidateOIDCIssuerURL(issuerURL string, issuerFldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -1531,6 +2041,7 @@ func ValidateKubeControllerManager(kcm *core.KubeControllerManagerConfig, networ
 	}
 
 	allErrs = append(allErrs, featuresvalidation.ValidateFeatureGates(kcm.FeatureGates, version, fldPath.Child("featureGates"))...)
+	allErrs = append(allErrs, kubecontrollermanagervalidation.ValidateControllers(kcm.Controllers, version, fldPath.Child("controllers"))...)
 
 	return allErrs
 }
@@ -1589,7 +2100,7 @@ func validateAlerting(alerting *core.Alerting, fldPath *field.Path) field.ErrorL
 	return allErrs
 }
 
-func validateMaintenance(maintenance *core.Maintenance, fldPath *field.Path, workerless bool) field.ErrorList {
+func validateMaintenance(maintenance *core.Maintenance, workers []core.Worker, fldPath *field.Path, workerless bool) field.ErrorList {
 	allErrs := field.ErrorList{}
 
 	if maintenance == nil {
@@ -1602,20 +2113,110 @@ func validateMaintenance(maintenance *core.Maintenance, fldPath *field.Path, wor
 		}
 	}
 
+	allErrs = append(allErrs, validateMaintenanceExclusions(maintenance.Exclusions, fldPath.Child("exclusions"))...)
+	allErrs = append(allErrs, validateWorkerPoolMaintenances(maintenance.WorkerPools, workers, fldPath.Child("workerPools"))...)
+
 	if maintenance.TimeWindow != nil {
-		maintenanceTimeWindow, err := timewindow.ParseMaintenanceTimeWindow(maintenance.TimeWindow.Begin, maintenance.TimeWindow.End)
-		if err != nil {
-			allErrs = append(allErrs, field.Invalid(fldPath.Child("timeWindow", "begin/end"), maintenance.TimeWindow, err.Error()))
+		allErrs = append(allErrs, validateMaintenanceTimeWindow(*maintenance.TimeWindow, fldPath.Child("timeWindow"))...)
+	}
+
+	return allErrs
+}
+
+func validateMaintenanceTimeWindow(timeWindow core.MaintenanceTimeWindow, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	maintenanceTimeWindow, err := timewindow.ParseMaintenanceTimeWindow(timeWindow.Begin, timeWindow.End)
+	if err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("begin/end"), timeWindow, err.Error()))
+		return allErrs
+	}
+
+	duration := maintenanceTimeWindow.Duration()
+	if duration > core.MaintenanceTimeWindowDurationMaximum {
+		allErrs = append(allErrs, field.Invalid(fldPath, duration, fmt.Sprintf("time window must not be greater than %s", core.MaintenanceTimeWindowDurationMaximum)))
+	}
+	if duration < core.MaintenanceTimeWindowDurationMinimum {
+		allErrs = append(allErrs, field.Invalid(fldPath, duration, fmt.Sprintf("time window must not be smaller than %s", core.MaintenanceTimeWindowDurationMinimum)))
+	}
+
+	return allErrs
+}
+
+func validateWorkerPoolMaintenances(workerPools []core.WorkerPoolMaintenance, workers []core.Worker, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs     = field.ErrorList{}
+		names       = sets.New[string]()
+		workerNames = sets.New[string]()
+	)
+
+	for _, worker := range workers {
+		workerNames.Insert(worker.Name)
+	}
+
+	for i, workerPool := range workerPools {
+		idxPath := fldPath.Index(i)
+
+		if workerPool.Name == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide the name of a worker pool"))
+		} else if names.Has(workerPool.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), workerPool.Name))
+		} else if !workerNames.Has(workerPool.Name) {
+			allErrs = append(allErrs, field.NotFound(idxPath.Child("name"), workerPool.Name))
+		}
+		names.Insert(workerPool.Name)
+
+		allErrs = append(allErrs, validateMaintenanceTimeWindow(workerPool.TimeWindow, idxPath.Child("timeWindow"))...)
+	}
+
+	return allErrs
+}
+
+func validateMaintenanceExclusions(exclusions []core.MaintenanceExclusion, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs = field.ErrorList{}
+		names   = sets.New[string]()
+	)
+
+	for i, exclusion := range exclusions {
+		idxPath := fldPath.Index(i)
+
+		if exclusion.Name == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide a name"))
+		} else if names.Has(exclusion.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), exclusion.Name))
 		} else {
-			duration := maintenanceTimeWindow.Duration()
-			if duration > core.MaintenanceTimeWindowDurationMaximum {
-				allErrs = append(allErrs, field.Invalid(fldPath.Child("timeWindow"), duration, fmt.Sprintf("time window must not be greater than %s", core.MaintenanceTimeWindowDurationMaximum)))
-				return allErrs
+			names.Insert(exclusion.Name)
+		}
+
+		if exclusion.Begin.IsZero() {
+			allErrs = append(allErrs, field.Required(idxPath.Child("begin"), "must provide the begin of the exclusion"))
+		}
+		if exclusion.End.IsZero() {
+			allErrs = append(allErrs, field.Required(idxPath.Child("end"), "must provide the end of the exclusion"))
+		}
+		if !exclusion.Begin.IsZero() && !exclusion.End.IsZero() {
+			if !exclusion.End.After(exclusion.Begin.Time) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("end"), exclusion.End, "end must be after begin"))
+			} else if duration := exclusion.End.Sub(exclusion.Begin.Time); duration > core.MaintenanceExclusionDurationMaximum {
+				allErrs = append(allErrs, field.Invalid(idxPath, duration, fmt.Sprintf("exclusion must not be longer than %s", core.MaintenanceExclusionDurationMaximum)))
 			}
-			if duration < core.MaintenanceTimeWindowDurationMinimum {
-				allErrs = append(allErrs, field.Invalid(fldPath.Child("timeWindow"), duration, fmt.Sprintf("time window must not be smaller than %s", core.MaintenanceTimeWindowDurationMinimum)))
-				return allErrs
+		}
+
+		if len(exclusion.Operations) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("operations"), "must provide at least one operation"))
+		}
+
+		operations := sets.New[core.MaintenanceExclusionOperation]()
+		for j, operation := range exclusion.Operations {
+			operationPath := idxPath.Child("operations").Index(j)
+
+			if !availableMaintenanceExclusionOperations.Has(string(operation)) {
+				allErrs = append(allErrs, field.NotSupported(operationPath, operation, sets.List(availableMaintenanceExclusionOperations)))
+			} else if operations.Has(operation) {
+				allErrs = append(allErrs, field.Duplicate(operationPath, operation))
 			}
+			operations.Insert(operation)
 		}
 	}
 
@@ -1749,6 +2350,7 @@ func ValidateWorker(worker core.Worker, kubernetes core.Kubernetes, fldPath *fie
 		if !volumeSizeRegex.MatchString(worker.Volume.VolumeSize) {
 			allErrs = append(allErrs, field.Invalid(fldPath.Child("volume", "size"), worker.Volume.VolumeSize, fmt.Sprintf("volume size must match the regex %s", volumeSizeRegex)))
 		}
+		allErrs = append(allErrs, validateVolumePerformance(worker.Volume.IOPS, worker.Volume.Throughput, fldPath.Child("volume"))...)
 	}
 
 	if worker.DataVolumes != nil {
@@ -1775,6 +2377,7 @@ func ValidateWorker(worker core.Worker, kubernetes core.Kubernetes, fldPath *fie
 			if !volumeSizeRegex.MatchString(volume.VolumeSize) {
 				allErrs = append(allErrs, field.Invalid(idxPath.Child("size"), volume.VolumeSize, fmt.Sprintf("data volume size must match the regex %s", volumeSizeRegex)))
 			}
+			allErrs = append(allErrs, validateVolumePerformance(volume.IOPS, volume.Throughput, idxPath)...)
 		}
 	}
 
@@ -1798,10 +2401,101 @@ func ValidateWorker(worker core.Worker, kubernetes core.Kubernetes, fldPath *fie
 		allErrs = append(allErrs, ValidateArchitecture(worker.Machine.Architecture, fldPath.Child("machine", "architecture"))...)
 	}
 
+	if worker.Machine.OSFamily != nil {
+		allErrs = append(allErrs, validateOSFamily(worker, fldPath)...)
+	}
+
 	if worker.ClusterAutoscaler != nil {
 		allErrs = append(allErrs, ValidateClusterAutoscalerOptions(worker.ClusterAutoscaler, fldPath.Child("autoscaler"))...)
 	}
 
+	if worker.CapacityType != nil && !availableWorkerCapacityTypes.Has(string(*worker.CapacityType)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("capacityType"), *worker.CapacityType, sets.List(availableWorkerCapacityTypes)))
+	}
+
+	if worker.UpdateStrategy != nil && !availableWorkerUpdateStrategies.Has(string(*worker.UpdateStrategy)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("updateStrategy"), *worker.UpdateStrategy, sets.List(availableWorkerUpdateStrategies)))
+	}
+
+	if worker.SystemComponents != nil && worker.SystemComponents.ReservedNodesPercentage != nil {
+		idxPath := fldPath.Child("systemComponents", "reservedNodesPercentage")
+		if percentage := *worker.SystemComponents.ReservedNodesPercentage; percentage < 1 || percentage > 100 {
+			allErrs = append(allErrs, field.Invalid(idxPath, percentage, "must be between 1 and 100"))
+		}
+		if !worker.SystemComponents.Allow {
+			allErrs = append(allErrs, field.Forbidden(idxPath, "nodes can only be reserved for system components if the worker pool allows system components"))
+		}
+	}
+
+	if worker.RolloutStrategy != nil && worker.RolloutStrategy.Order != nil {
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(*worker.RolloutStrategy.Order), fldPath.Child("rolloutStrategy", "order"))...)
+	}
+
+	allErrs = append(allErrs, validateWorkerSysctls(worker.Sysctls, fldPath.Child("sysctls"))...)
+	allErrs = append(allErrs, validateWorkerKernelModules(worker.KernelModules, fldPath.Child("kernelModules"))...)
+
+	if mcmSettings := worker.MachineControllerManagerSettings; mcmSettings != nil {
+		idxPath := fldPath.Child("machineControllerManager")
+		if mcmSettings.MachineBootstrapTimeout != nil && mcmSettings.MachineBootstrapTimeout.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("machineBootstrapTimeout"), mcmSettings.MachineBootstrapTimeout.Duration.String(), "must be a positive duration"))
+		}
+		if mcmSettings.MachineBootstrapFailurePolicy != nil && !availableMachineBootstrapFailurePolicies.Has(string(*mcmSettings.MachineBootstrapFailurePolicy)) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("machineBootstrapFailurePolicy"), *mcmSettings.MachineBootstrapFailurePolicy, sets.List(availableMachineBootstrapFailurePolicies)))
+		}
+	}
+
+	return allErrs
+}
+
+var sysctlNameRegex = regexp.MustCompile(`^[a-z0-9_-]+(\.[a-zA-Z0-9_-]+)+$`)
+
+func validateWorkerSysctls(sysctls map[string]string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for _, name := range slices.Sorted(maps.Keys(sysctls)) {
+		if !sysctlNameRegex.MatchString(name) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Key(name), name, fmt.Sprintf("sysctl name must match the regex %s", sysctlNameRegex)))
+			continue
+		}
+		if namespace, _, _ := strings.Cut(name, "."); !allowedWorkerSysctlNamespaces.Has(namespace) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Key(name), namespace, sets.List(allowedWorkerSysctlNamespaces)))
+		}
+		if len(strings.TrimSpace(sysctls[name])) == 0 || strings.ContainsAny(sysctls[name], "\n\r") {
+			allErrs = append(allErrs, field.Invalid(fldPath.Key(name), sysctls[name], "sysctl value must be a non-empty single line"))
+		}
+	}
+
+	return allErrs
+}
+
+func validateWorkerKernelModules(kernelModules []string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	names := sets.New[string]()
+
+	for i, name := range kernelModules {
+		idxPath := fldPath.Index(i)
+		if !allowedWorkerKernelModules.Has(name) {
+			allErrs = append(allErrs, field.NotSupported(idxPath, name, sets.List(allowedWorkerKernelModules)))
+		}
+		if names.Has(name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, name))
+		}
+		names.Insert(name)
+	}
+
+	return allErrs
+}
+
+func validateVolumePerformance(iops, throughput *int64, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if iops != nil && *iops <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("iops"), *iops, "iops must be greater than 0"))
+	}
+	if throughput != nil && *throughput <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("throughput"), *throughput, "throughput must be greater than 0"))
+	}
+
 	return allErrs
 }
 
@@ -1937,6 +2631,61 @@ func ValidateKubeletConfig(kubeletConfig core.KubeletConfig, version string, fld
 		}
 	}
 
+	allErrs = append(allErrs, validateKubeletMemoryManager(kubeletConfig, fldPath)...)
+
+	return allErrs
+}
+
+func validateKubeletMemoryManager(kubeletConfig core.KubeletConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	policy := ptr.Deref(kubeletConfig.MemoryManagerPolicy, core.MemoryManagerPolicyNone)
+	if kubeletConfig.MemoryManagerPolicy != nil {
+		path := fldPath.Child("memoryManagerPolicy")
+
+		supportedPolicies := []core.MemoryManagerPolicy{core.MemoryManagerPolicyNone, core.MemoryManagerPolicyStatic}
+		if !slices.Contains(supportedPolicies, policy) {
+			allErrs = append(allErrs, field.NotSupported(path, policy, supportedPolicies))
+		}
+
+		if featureGateEnabled, ok := kubeletConfig.FeatureGates["MemoryManager"]; ok && !featureGateEnabled && policy != core.MemoryManagerPolicyNone {
+			allErrs = append(allErrs, field.Forbidden(path, "memory manager policies other than 'None' are not available when kubelet's 'MemoryManager' feature gate is disabled"))
+		}
+	}
+
+	path := fldPath.Child("reservedMemory")
+	if policy == core.MemoryManagerPolicyStatic && len(kubeletConfig.ReservedMemory) == 0 {
+		allErrs = append(allErrs, field.Required(path, "reserved memory must be configured when the memory manager policy is 'Static'"))
+	}
+	if policy != core.MemoryManagerPolicyStatic && len(kubeletConfig.ReservedMemory) > 0 {
+		allErrs = append(allErrs, field.Forbidden(path, "reserved memory can only be configured when the memory manager policy is 'Static'"))
+	}
+
+	numaNodes := sets.New[int32]()
+	for i, reservation := range kubeletConfig.ReservedMemory {
+		idxPath := path.Index(i)
+
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(reservation.NumaNode), idxPath.Child("numaNode"))...)
+		if numaNodes.Has(reservation.NumaNode) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("numaNode"), reservation.NumaNode))
+		}
+		numaNodes.Insert(reservation.NumaNode)
+
+		if len(reservation.Limits) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("limits"), "at least one resource must be reserved"))
+		}
+		for _, name := range slices.Sorted(maps.Keys(reservation.Limits)) {
+			limitPath := idxPath.Child("limits").Key(string(name))
+
+			if name != corev1.ResourceMemory && !strings.HasPrefix(string(name), corev1.ResourceHugePagesPrefix) {
+				allErrs = append(allErrs, field.Invalid(limitPath, name, "only memory and hugepages resources can be reserved"))
+			}
+			if quantity := reservation.Limits[name]; quantity.Sign() <= 0 {
+				allErrs = append(allErrs, field.Invalid(limitPath, quantity.String(), "must be greater than zero"))
+			}
+		}
+	}
+
 	return allErrs
 }
 
@@ -2255,6 +3004,36 @@ func ValidateHibernationSchedule(seenSpecs sets.Set[string], schedule *core.Hibe
 	if schedule.Location != nil {
 		allErrs = append(allErrs, ValidateHibernationScheduleLocation(*schedule.Location, fldPath.Child("location"))...)
 	}
+	if len(schedule.Exceptions) > 0 && schedule.Start == nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("exceptions"), "exceptions can only be specified if start is set"))
+	}
+	allErrs = append(allErrs, validateHibernationScheduleExceptions(schedule.Exceptions, fldPath.Child("exceptions"))...)
+
+	return allErrs
+}
+
+func validateHibernationScheduleExceptions(exceptions []string, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs = field.ErrorList{}
+		seen    = sets.New[string]()
+	)
+
+	for i, exception := range exceptions {
+		idxPath := fldPath.Index(i)
+
+		if seen.Has(exception) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, exception))
+			continue
+		}
+		seen.Insert(exception)
+
+		if _, err := time.Parse(core.HibernationScheduleExceptionDateLayout, exception); err == nil {
+			continue
+		}
+		if _, err := cron.ParseStandard(exception); err != nil {
+			allErrs = append(allErrs, field.Invalid(idxPath, exception, "must be either a date in the format YYYYMMDD or a valid cron spec"))
+		}
+	}
 
 	return allErrs
 }
@@ -2367,6 +3146,50 @@ func ValidateContainerRuntimes(containerRuntime []core.ContainerRuntime, fldPath
 	return allErrs
 }
 
+// validateOSFamily validates the operating system family of the machines in this worker pool and whether the other
+// worker pool settings are compatible with it.
+func validateOSFamily(worker core.Worker, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs  = field.ErrorList{}
+		osFamily = *worker.Machine.OSFamily
+		osPath   = fldPath.Child("machine", "osFamily")
+	)
+
+	if !slices.Contains(v1beta1constants.ValidOSFamilies, osFamily) {
+		return append(allErrs, field.NotSupported(osPath, osFamily, v1beta1constants.ValidOSFamilies))
+	}
+
+	if osFamily != v1beta1constants.OSFamilyWindows {
+		return allErrs
+	}
+
+	if !features.DefaultFeatureGate.Enabled(features.WindowsWorkerPools) {
+		return append(allErrs, field.Forbidden(osPath, fmt.Sprintf("windows worker pools are only supported if the %s feature gate is enabled", features.WindowsWorkerPools)))
+	}
+
+	if worker.CRI != nil && worker.CRI.Name != core.CRINameContainerD {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("cri", "name"), "windows worker pools only support the containerd container runtime"))
+	}
+	if worker.Machine.Architecture != nil && *worker.Machine.Architecture != v1beta1constants.ArchitectureAMD64 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("machine", "architecture"), "windows worker pools only support the amd64 architecture"))
+	}
+	// In-place updates are performed by gardener-node-agent which does not run on Windows nodes.
+	if ptr.Deref(worker.UpdateStrategy, core.WorkerUpdateStrategyRollingUpdate) == core.WorkerUpdateStrategyInPlace {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("updateStrategy"), "windows worker pools do not support in-place updates"))
+	}
+	// Enforcing the node labels, annotations and taints is performed by gardener-node-agent as well.
+	if worker.NodeTemplate != nil && ptr.Deref(worker.NodeTemplate.EnforceLabels, false) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("nodeTemplate", "enforceLabels"), "windows worker pools do not support enforcing node labels"))
+	}
+	// Most system components (e.g., CoreDNS, metrics-server, VPN) are only built for Linux, hence they must not be
+	// scheduled to Windows nodes.
+	if helper.SystemComponentsAllowed(&worker) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("systemComponents", "allow"), "system components must not be allowed on windows worker pools"))
+	}
+
+	return allErrs
+}
+
 // ValidateArchitecture validates the CPU architecture of the machines in this worker pool.
 func ValidateArchitecture(arch *string, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -2378,7 +3201,7 @@ func ValidateArchitecture(arch *string, fldPath *field.Path) field.ErrorList {
 }
 
 // ValidateSystemComponents validates the given system components.
-func ValidateSystemComponents(systemComponents *core.SystemComponents, fldPath *field.Path, workerless bool) field.ErrorList {
+func ValidateSystemComponents(systemComponents *core.SystemComponents, workers []core.Worker, fldPath *field.Path, workerless bool) field.ErrorList {
 	allErrs := field.ErrorList{}
 
 	if systemComponents == nil {
@@ -2389,6 +3212,42 @@ func ValidateSystemComponents(systemComponents *core.SystemComponents, fldPath *
 	}
 
 	allErrs = append(allErrs, validateCoreDNS(systemComponents.CoreDNS, fldPath.Child("coreDNS"))...)
+	allErrs = append(allErrs, validateSystemComponentsDedicatedPool(systemComponents.DedicatedPool, workers, fldPath.Child("dedicatedPool"))...)
+	allErrs = append(allErrs, validateNodeLocalDNS(systemComponents.NodeLocalDNS, workers, fldPath.Child("nodeLocalDNS"))...)
+
+	return allErrs
+}
+
+// validateSystemComponentsDedicatedPool validates that the dedicated pool references an existing worker pool which
+// allows system components, and that no other worker pool allows them.
+func validateSystemComponentsDedicatedPool(dedicatedPool *string, workers []core.Worker, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if dedicatedPool == nil {
+		return allErrs
+	}
+
+	found := false
+	for _, worker := range workers {
+		if worker.Name == *dedicatedPool {
+			found = true
+			if !helper.SystemComponentsAllowed(&worker) {
+				allErrs = append(allErrs, field.Invalid(fldPath, *dedicatedPool, "the dedicated worker pool must allow system components"))
+			}
+			if worker.SystemComponents != nil && worker.SystemComponents.ReservedNodesPercentage != nil {
+				allErrs = append(allErrs, field.Forbidden(fldPath, "the dedicated worker pool must not reserve nodes for system components since all its nodes are reserved for them"))
+			}
+			continue
+		}
+
+		if helper.SystemComponentsAllowed(&worker) {
+			allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("worker pool %q must not allow system components when a dedicated worker pool is configured", worker.Name)))
+		}
+	}
+
+	if !found {
+		allErrs = append(allErrs, field.Invalid(fldPath, *dedicatedPool, "must reference an existing worker pool"))
+	}
 
 	return allErrs
 }
@@ -2407,6 +3266,161 @@ func validateCoreDNS(coreDNS *core.CoreDNS, fldPath *field.Path) field.ErrorList
 	if coreDNS.Rewriting != nil {
 		allErrs = append(allErrs, ValidateCoreDNSRewritingCommonSuffixes(coreDNS.Rewriting.CommonSuffixes, fldPath.Child("rewriting"))...)
 	}
+	allErrs = append(allErrs, validateCoreDNSCustomPlugins(coreDNS.CustomPlugins, fldPath.Child("customPlugins"))...)
+
+	return allErrs
+}
+
+var (
+	availableCoreDNSDNS64PrefixLengths = sets.New(32, 40, 48, 56, 64, 96)
+	// maxCoreDNSForwardUpstreams is the maximum number of upstreams supported by the forward plugin.
+	maxCoreDNSForwardUpstreams = 15
+)
+
+func validateCoreDNSCustomPlugins(customPlugins *core.CoreDNSCustomPlugins, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if customPlugins == nil {
+		return allErrs
+	}
+
+	if customPlugins.DNS64 != nil && customPlugins.DNS64.Prefix != nil {
+		prefixPath := fldPath.Child("dns64", "prefix")
+		ip, ipNet, err := net.ParseCIDR(*customPlugins.DNS64.Prefix)
+		if err != nil || ip.To4() != nil {
+			allErrs = append(allErrs, field.Invalid(prefixPath, *customPlugins.DNS64.Prefix, "must be an IPv6 CIDR"))
+		} else if ones, _ := ipNet.Mask.Size(); !availableCoreDNSDNS64PrefixLengths.Has(ones) {
+			allErrs = append(allErrs, field.Invalid(prefixPath, *customPlugins.DNS64.Prefix, fmt.Sprintf("prefix length must be one of %v", sets.List(availableCoreDNSDNS64PrefixLengths))))
+		}
+	}
+
+	zones := sets.New[string]()
+	for i, forwardZone := range customPlugins.ForwardZones {
+		idxPath := fldPath.Child("forwardZones").Index(i)
+		zone := strings.TrimSuffix(forwardZone.Zone, ".")
+
+		if len(zone) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("zone"), "must provide a zone"))
+		} else {
+			for _, msg := range validation.IsDNS1123Subdomain(zone) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("zone"), forwardZone.Zone, msg))
+			}
+			if zone == core.DefaultDomain || strings.HasSuffix(zone, "."+core.DefaultDomain) {
+				allErrs = append(allErrs, field.Forbidden(idxPath.Child("zone"), fmt.Sprintf("must not be the cluster domain %q or one of its subdomains", core.DefaultDomain)))
+			}
+			if zones.Has(zone) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("zone"), forwardZone.Zone))
+			}
+			zones.Insert(zone)
+		}
+
+		if len(forwardZone.Upstreams) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("upstreams"), "must provide at least one upstream"))
+		} else if len(forwardZone.Upstreams) > maxCoreDNSForwardUpstreams {
+			allErrs = append(allErrs, field.TooMany(idxPath.Child("upstreams"), len(forwardZone.Upstreams), maxCoreDNSForwardUpstreams))
+		}
+		for j, upstream := range forwardZone.Upstreams {
+			if !isValidCoreDNSUpstream(upstream) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("upstreams").Index(j), upstream, "must be an IP address with an optional port"))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+func isValidCoreDNSUpstream(upstream string) bool {
+	if net.ParseIP(upstream) != nil {
+		return true
+	}
+
+	host, port, err := net.SplitHostPort(upstream)
+	if err != nil || net.ParseIP(host) == nil {
+		return false
+	}
+
+	portNum, err := strconv.Atoi(port)
+	return err == nil && len(validation.IsValidPortNum(portNum)) == 0
+}
+
+var (
+	// nodeLocalDNSClusterDNSZones are the zones of node local DNS whose requests are forwarded to the cluster DNS.
+	nodeLocalDNSClusterDNSZones = sets.New(core.DefaultDomain, "in-addr.arpa", "ip6.arpa")
+	// nodeLocalDNSUpstreamDNSZone is the zone of node local DNS whose requests are forwarded to the upstream DNS.
+	nodeLocalDNSUpstreamDNSZone    = "."
+	availableNodeLocalDNSProtocols = sets.New(
+		string(core.NodeLocalDNSProtocolTCP),
+		string(core.NodeLocalDNSProtocolUDP),
+	)
+)
+
+// validateNodeLocalDNS validates the given node local DNS settings.
+func validateNodeLocalDNS(nodeLocalDNS *core.NodeLocalDNS, workers []core.Worker, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if nodeLocalDNS == nil {
+		return allErrs
+	}
+
+	if cache := nodeLocalDNS.Cache; cache != nil {
+		cachePath := fldPath.Child("cache")
+		if cache.SuccessTTLSeconds != nil && *cache.SuccessTTLSeconds <= 0 {
+			allErrs = append(allErrs, field.Invalid(cachePath.Child("successTTLSeconds"), *cache.SuccessTTLSeconds, "must be greater than 0"))
+		}
+		if cache.DenialTTLSeconds != nil {
+			if *cache.DenialTTLSeconds <= 0 {
+				allErrs = append(allErrs, field.Invalid(cachePath.Child("denialTTLSeconds"), *cache.DenialTTLSeconds, "must be greater than 0"))
+			}
+			if ptr.Deref(cache.DisableNegativeCaching, false) {
+				allErrs = append(allErrs, field.Forbidden(cachePath.Child("denialTTLSeconds"), "must not be set when negative caching is disabled"))
+			}
+		}
+	}
+
+	zones := sets.New[string]()
+	for i, zoneProtocol := range nodeLocalDNS.ZoneProtocols {
+		idxPath := fldPath.Child("zoneProtocols").Index(i)
+
+		switch {
+		case zoneProtocol.Zone == nodeLocalDNSUpstreamDNSZone:
+			if nodeLocalDNS.ForceTCPToUpstreamDNS != nil && *nodeLocalDNS.ForceTCPToUpstreamDNS != (zoneProtocol.Protocol == core.NodeLocalDNSProtocolTCP) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("protocol"), zoneProtocol.Protocol, "conflicts with forceTCPToUpstreamDNS"))
+			}
+		case nodeLocalDNSClusterDNSZones.Has(zoneProtocol.Zone):
+			if nodeLocalDNS.ForceTCPToClusterDNS != nil && *nodeLocalDNS.ForceTCPToClusterDNS != (zoneProtocol.Protocol == core.NodeLocalDNSProtocolTCP) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("protocol"), zoneProtocol.Protocol, "conflicts with forceTCPToClusterDNS"))
+			}
+		default:
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("zone"), zoneProtocol.Zone, append(sets.List(nodeLocalDNSClusterDNSZones), nodeLocalDNSUpstreamDNSZone)))
+		}
+
+		if zones.Has(zoneProtocol.Zone) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("zone"), zoneProtocol.Zone))
+		}
+		zones.Insert(zoneProtocol.Zone)
+
+		if !availableNodeLocalDNSProtocols.Has(string(zoneProtocol.Protocol)) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("protocol"), zoneProtocol.Protocol, sets.List(availableNodeLocalDNSProtocols)))
+		}
+	}
+
+	workerPoolNames := sets.New[string]()
+	for _, worker := range workers {
+		workerPoolNames.Insert(worker.Name)
+	}
+
+	disabledWorkerPools := sets.New[string]()
+	for i, workerPoolName := range nodeLocalDNS.DisabledWorkerPools {
+		idxPath := fldPath.Child("disabledWorkerPools").Index(i)
+
+		if !workerPoolNames.Has(workerPoolName) {
+			allErrs = append(allErrs, field.Invalid(idxPath, workerPoolName, "must reference an existing worker pool"))
+		}
+		if disabledWorkerPools.Has(workerPoolName) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, workerPoolName))
+		}
+		disabledWorkerPools.Insert(workerPoolName)
+	}
 
 	return allErrs
 }
@@ -2466,7 +3480,7 @@ func validateShootOperation(operation, maintenanceOperation string, shoot *core.
 		if !availableShootOperations.Has(operation) {
 			allErrs = append(allErrs, field.NotSupported(fldPathOp, operation, sets.List(availableShootOperations)))
 		}
-		if helper.IsShootInHibernation(shoot) && forbiddenShootOperationsWhenHibernated.Has(operation) {
+		if helper.IsShootInHibernation(shoot) && (forbiddenShootOperationsWhenHibernated.Has(operation) || operation == v1beta1constants.ShootOperationBackupNow) {
 			allErrs = append(allErrs, field.Forbidden(fldPathOp, "operation is not permitted when shoot is hibernated or is waking up"))
 		}
 		if !apiequality.Semantic.DeepEqual(getResourcesForEncryption(shoot.Spec.Kubernetes.KubeAPIServer), shoot.Status.EncryptedResources) &&
@@ -598,6 +598,32 @@ var _ = Describe("Shoot Validation Tests", func() {
 			})
 		})
 
+		Context("shoot class", func() {
+			It("should pass as shoot class is not changed", func() {
+				shoot.Spec.ShootClassName = ptr.To("small")
+				newShoot := prepareShootForUpdate(shoot)
+
+				errorList := ValidateShootUpdate(newShoot, shoot)
+
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid to change the shoot class", func() {
+				shoot.Spec.ShootClassName = ptr.To("small")
+				newShoot := prepareShootForUpdate(shoot)
+				newShoot.Spec.ShootClassName = ptr.To("large")
+
+				errorList := ValidateShootUpdate(newShoot, shoot)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.shootClassName"),
+					})),
+				))
+			})
+		})
+
 		DescribeTable("purpose validation",
 			func(purpose core.ShootPurpose, namespace string, matcher gomegatypes.GomegaMatcher) {
 				shootCopy := shoot.DeepCopy()
@@ -646,17 +672,41 @@ var _ = Describe("Shoot Validation Tests", func() {
 				Entry("should forbid addons on production shoots", core.ShootPurposeProduction, false),
 			)
 
-			It("should forbid addon configuration if the shoot is workerless", func() {
+			It("should forbid addons requiring nodes if the shoot is workerless", func() {
 				shoot.Spec.Provider.Workers = []core.Worker{}
-				shoot.Spec.Addons = &core.Addons{}
+				shoot.Spec.Addons = &core.Addons{
+					KubernetesDashboard: &core.KubernetesDashboard{Addon: core.Addon{Enabled: false}},
+					NginxIngress:        &core.NginxIngress{Addon: core.Addon{Enabled: true}},
+				}
 				shoot.Spec.Kubernetes.KubeControllerManager = nil
 
 				errorList := ValidateShoot(shoot)
 
-				Expect(errorList).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
-					"Type":   Equal(field.ErrorTypeForbidden),
-					"Field":  Equal("spec.addons"),
-					"Detail": ContainSubstring("addons cannot be enabled for Workerless Shoot clusters"),
+				Expect(errorList).To(ContainElements(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeForbidden),
+						"Field":  Equal("spec.addons.kubernetesDashboard"),
+						"Detail": Equal("addon requires nodes and cannot be enabled for workerless Shoot clusters"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeForbidden),
+						"Field":  Equal("spec.addons.nginxIngress"),
+						"Detail": Equal("addon requires nodes and cannot be enabled for workerless Shoot clusters"),
+					})),
+				))
+			})
+
+			It("should allow managed addons if the shoot is workerless", func() {
+				shoot.Spec.Provider.Workers = []core.Worker{}
+				shoot.Spec.Addons = &core.Addons{
+					Managed: []core.ManagedAddon{{Name: "oidc-webhook", Version: "1.0.0"}},
+				}
+				shoot.Spec.Kubernetes.KubeControllerManager = nil
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).NotTo(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Field": HavePrefix("spec.addons"),
 				}))))
 			})
 
@@ -696,6 +746,45 @@ var _ = Describe("Shoot Validation Tests", func() {
 					"Field": Equal("spec.addons.nginxIngress.externalTrafficPolicy"),
 				}))))
 			})
+
+			It("should allow managed addons on non-evaluation shoots", func() {
+				shoot.Spec.Purpose = ptr.To(core.ShootPurposeProduction)
+				shoot.Spec.Addons = &core.Addons{
+					Managed: []core.ManagedAddon{
+						{Name: "cert-manager", Version: "1.16.1", Values: &runtime.RawExtension{Raw: []byte(`{"replicas":2}`)}},
+						{Name: "external-dns", Version: "0.15.0"},
+					},
+				}
+
+				Expect(ValidateShoot(shoot)).To(BeEmpty())
+			})
+
+			It("should forbid invalid managed addons", func() {
+				shoot.Spec.Addons.Managed = []core.ManagedAddon{
+					{Name: "cert-manager", Version: "1.16.1"},
+					{Name: "cert-manager", Version: "1.16.1"},
+					{Values: &runtime.RawExtension{Raw: []byte(`["foo"]`)}},
+				}
+
+				Expect(ValidateShoot(shoot)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("spec.addons.managed[1].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.addons.managed[2].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.addons.managed[2].version"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.addons.managed[2].values"),
+					})),
+				))
+			})
 		})
 
 		It("should forbid unsupported specification (provider independent)", func() {
@@ -900,6 +989,55 @@ var _ = Describe("Shoot Validation Tests", func() {
 			))
 		})
 
+		It("should forbid invalid data residency constraints", func() {
+			shoot.Spec.DataResidency = &core.DataResidency{
+				Jurisdictions: []string{"EU", "", "EU"},
+				Geographies:   []string{"europe"},
+			}
+
+			Expect(ValidateShoot(shoot)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.dataResidency.jurisdictions[1]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("spec.dataResidency.jurisdictions[2]"),
+				})),
+			))
+		})
+
+		It("should allow valid etcd backup settings", func() {
+			shoot.Spec.ETCD = &core.ETCD{
+				Backup: &core.ETCDBackup{
+					FullSnapshotSchedule:         ptr.To("0 */12 * * *"),
+					DeltaSnapshotRetentionPeriod: &metav1.Duration{Duration: 72 * time.Hour},
+				},
+			}
+
+			Expect(ValidateShoot(shoot)).To(BeEmpty())
+		})
+
+		It("should forbid invalid etcd backup settings", func() {
+			shoot.Spec.ETCD = &core.ETCD{
+				Backup: &core.ETCDBackup{
+					FullSnapshotSchedule:         ptr.To("every day"),
+					DeltaSnapshotRetentionPeriod: &metav1.Duration{Duration: -time.Hour},
+				},
+			}
+
+			Expect(ValidateShoot(shoot)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.etcd.backup.fullSnapshotSchedule"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.etcd.backup.deltaSnapshotRetentionPeriod"),
+				})),
+			))
+		})
+
 		It("should forbid invalid tolerations", func() {
 			shoot.Spec.Tolerations = []core.Toleration{
 				{},
@@ -949,6 +1087,25 @@ var _ = Describe("Shoot Validation Tests", func() {
 			))
 		})
 
+		Context("control plane egress", func() {
+			It("should allow a dedicated control plane egress", func() {
+				shoot.Spec.ControlPlane = &core.ControlPlane{Egress: &core.ControlPlaneEgress{Dedicated: true}}
+
+				Expect(ValidateShoot(shoot)).To(BeEmpty())
+			})
+
+			It("should forbid a dedicated control plane egress in case of workerless shoot", func() {
+				shoot.Spec.Provider.Workers = nil
+				shoot.Spec.ControlPlane = &core.ControlPlane{Egress: &core.ControlPlaneEgress{Dedicated: true}}
+
+				Expect(ValidateShoot(shoot)).To(ContainElements(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeForbidden),
+					"Field":  Equal("spec.controlPlane.egress.dedicated"),
+					"Detail": ContainSubstring("this field should not be set for workerless Shoot clusters"),
+				}))))
+			})
+		})
+
 		Context("seed selector", func() {
 			seedSelector := &core.SeedSelector{LabelSelector: metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}}}
 
@@ -2310,6 +2467,193 @@ var _ = Describe("Shoot Validation Tests", func() {
 				})
 			})
 
+			Context("authorized networks", func() {
+				It("should allow valid authorized networks", func() {
+					shoot.Spec.Kubernetes.KubeAPIServer.AuthorizedNetworks = []string{"10.0.0.0/8", "2001:db8::/32", "1.2.3.4/32"}
+
+					Expect(ValidateShoot(shoot)).To(BeEmpty())
+				})
+
+				It("should forbid invalid, non-canonical and duplicate networks", func() {
+					shoot.Spec.Kubernetes.KubeAPIServer.AuthorizedNetworks = []string{"foo", "10.0.0.1/8", "1.2.3.4/32", "1.2.3.4/32"}
+
+					Expect(ValidateShoot(shoot)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.kubernetes.kubeAPIServer.authorizedNetworks[0]"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.kubernetes.kubeAPIServer.authorizedNetworks[1]"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeDuplicate),
+							"Field": Equal("spec.kubernetes.kubeAPIServer.authorizedNetworks[3]"),
+						})),
+					))
+				})
+			})
+
+			Context("API priority and fairness", func() {
+				It("should allow valid settings", func() {
+					shoot.Spec.Kubernetes.KubeAPIServer.APIPriorityAndFairness = &core.APIPriorityAndFairness{
+						MaxSeats:      ptr.To[int32](1000),
+						ConfigMapName: ptr.To("flowcontrol"),
+					}
+
+					Expect(ValidateShoot(shoot)).To(BeEmpty())
+				})
+
+				It("should forbid invalid settings", func() {
+					shoot.Spec.Kubernetes.KubeAPIServer.APIPriorityAndFairness = &core.APIPriorityAndFairness{
+						MaxSeats:      ptr.To[int32](0),
+						ConfigMapName: ptr.To(""),
+					}
+
+					Expect(ValidateShoot(shoot)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.kubernetes.kubeAPIServer.apiPriorityAndFairness.maxSeats"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("spec.kubernetes.kubeAPIServer.apiPriorityAndFairness.configMapName"),
+						})),
+					))
+				})
+
+				It("should forbid combining max seats with inflight limits", func() {
+					shoot.Spec.Kubernetes.KubeAPIServer.Requests = &core.APIServerRequests{MaxMutatingInflight: ptr.To[int32](200)}
+					shoot.Spec.Kubernetes.KubeAPIServer.APIPriorityAndFairness = &core.APIPriorityAndFairness{MaxSeats: ptr.To[int32](1000)}
+
+					Expect(ValidateShoot(shoot)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeForbidden),
+							"Field": Equal("spec.kubernetes.kubeAPIServer.apiPriorityAndFairness.maxSeats"),
+						})),
+					))
+				})
+			})
+
+			Context("endpoint", func() {
+				It("should allow a valid kube-apiserver endpoint", func() {
+					shoot.Spec.Kubernetes.KubeAPIServer.Endpoint = &core.KubeAPIServerEndpoint{
+						AdditionalHostnames: []string{"api.example.com", "kube.example.com"},
+						Port:                ptr.To[int32](6443),
+					}
+
+					Expect(ValidateShoot(shoot)).To(BeEmpty())
+				})
+
+				It("should forbid invalid kube-apiserver endpoints", func() {
+					shoot.Spec.Kubernetes.KubeAPIServer.Endpoint = &core.KubeAPIServerEndpoint{
+						AdditionalHostnames: []string{"*.example.com", "api.example.com", "api.example.com"},
+						Port:                ptr.To[int32](443),
+					}
+
+					Expect(ValidateShoot(shoot)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.kubernetes.kubeAPIServer.endpoint.additionalHostnames[0]"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeDuplicate),
+							"Field": Equal("spec.kubernetes.kubeAPIServer.endpoint.additionalHostnames[2]"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeForbidden),
+							"Field": Equal("spec.kubernetes.kubeAPIServer.endpoint.port"),
+						})),
+					))
+				})
+
+				It("should forbid an invalid kube-apiserver endpoint port", func() {
+					shoot.Spec.Kubernetes.KubeAPIServer.Endpoint = &core.KubeAPIServerEndpoint{Port: ptr.To[int32](0)}
+
+					Expect(ValidateShoot(shoot)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.kubernetes.kubeAPIServer.endpoint.port"),
+						})),
+					))
+				})
+
+				It("should allow requesting a managed certificate for additional host names", func() {
+					shoot.Spec.Kubernetes.KubeAPIServer.Endpoint = &core.KubeAPIServerEndpoint{
+						AdditionalHostnames: []string{"api.example.com"},
+						ManagedCertificate:  ptr.To(true),
+					}
+
+					Expect(ValidateShoot(shoot)).To(BeEmpty())
+				})
+
+				It("should forbid requesting a managed certificate without additional host names", func() {
+					shoot.Spec.Kubernetes.KubeAPIServer.Endpoint = &core.KubeAPIServerEndpoint{ManagedCertificate: ptr.To(true)}
+
+					Expect(ValidateShoot(shoot)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeForbidden),
+							"Field": Equal("spec.kubernetes.kubeAPIServer.endpoint.managedCertificate"),
+						})),
+					))
+				})
+			})
+
+			Context("vpn", func() {
+				It("should allow configuring the highly available VPN", func() {
+					shoot.Spec.Kubernetes.KubeAPIServer.VPN = &core.KubeAPIServerVPNConfig{
+						HighAvailability: ptr.To(true),
+						SeedServers:      ptr.To[int32](4),
+						ShootClients:     ptr.To[int32](3),
+					}
+
+					Expect(ValidateShoot(shoot)).To(BeEmpty())
+				})
+
+				It("should forbid invalid numbers of seed servers and shoot clients", func() {
+					shoot.Spec.Kubernetes.KubeAPIServer.VPN = &core.KubeAPIServerVPNConfig{
+						SeedServers:  ptr.To[int32](1),
+						ShootClients: ptr.To[int32](5),
+					}
+
+					Expect(ValidateShoot(shoot)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.kubernetes.kubeAPIServer.vpn.seedServers"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.kubernetes.kubeAPIServer.vpn.shootClients"),
+						})),
+					))
+				})
+
+				It("should forbid configuring the number of tunnels if the highly available VPN is disabled", func() {
+					shoot.Spec.Kubernetes.KubeAPIServer.VPN = &core.KubeAPIServerVPNConfig{
+						HighAvailability: ptr.To(false),
+						SeedServers:      ptr.To[int32](3),
+					}
+
+					Expect(ValidateShoot(shoot)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeForbidden),
+							"Field": Equal("spec.kubernetes.kubeAPIServer.vpn.seedServers"),
+						})),
+					))
+				})
+
+				It("should forbid configuring the VPN for workerless shoots", func() {
+					shoot.Spec.Provider.Workers = []core.Worker{}
+					shoot.Spec.Kubernetes.KubeAPIServer.VPN = &core.KubeAPIServerVPNConfig{HighAvailability: ptr.To(true)}
+
+					Expect(ValidateShoot(shoot)).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeForbidden),
+						"Field":  Equal("spec.kubernetes.kubeAPIServer.vpn"),
+						"Detail": ContainSubstring("this field should not be set for workerless Shoot clusters"),
+					}))))
+				})
+			})
+
 			Context("service account config", func() {
 				It("should not allow to specify a negative max token duration", func() {
 					shoot.Spec.Kubernetes.KubeAPIServer.ServiceAccountConfig = &core.ServiceAccountConfig{
@@ -2783,42 +3127,162 @@ var _ = Describe("Shoot Validation Tests", func() {
 			})
 		})
 
-		Context("KubeProxy validation", func() {
+		Context("NamespaceDefaults validation", func() {
 			BeforeEach(func() {
-				shoot.Spec.Kubernetes.KubeProxy = &core.KubeProxyConfig{}
+				shoot.Spec.Kubernetes.NamespaceDefaults = &core.NamespaceDefaults{
+					NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "foo"}},
+					LimitRanges: []core.NamespaceDefaultsLimitRange{{
+						Name: "defaults",
+						Spec: corev1.LimitRangeSpec{Limits: []corev1.LimitRangeItem{{
+							Type:           corev1.LimitTypeContainer,
+							DefaultRequest: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m")},
+						}}},
+					}},
+					ResourceQuotas: []core.NamespaceDefaultsResourceQuota{{
+						Name: "quota",
+						Spec: corev1.ResourceQuotaSpec{
+							Hard:   corev1.ResourceList{corev1.ResourcePods: resource.MustParse("100")},
+							Scopes: []corev1.ResourceQuotaScope{corev1.ResourceQuotaScopeNotTerminating},
+						},
+					}},
+				}
 			})
 
-			It("should prevent setting kubeproxy config for workerless shoots", func() {
-				shoot.Spec.Provider.Workers = []core.Worker{}
-				shoot.Spec.Kubernetes.KubeProxy = &core.KubeProxyConfig{
-					KubernetesConfig: core.KubernetesConfig{
-						FeatureGates: nil,
-					},
-				}
+			It("should allow valid namespace defaults", func() {
+				Expect(ValidateShoot(shoot)).To(BeEmpty())
+			})
 
-				errorList := ValidateShoot(shoot)
-				Expect(errorList).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
-					"Type":   Equal(field.ErrorTypeForbidden),
-					"Field":  Equal("spec.kubernetes.kubeProxy"),
-					"Detail": ContainSubstring("this field should not be set for workerless Shoot clusters"),
+			It("should allow namespace defaults for workerless shoots", func() {
+				shoot.Spec.Provider.Workers = nil
+				shoot.Spec.Kubernetes.KubeProxy = nil
+				shoot.Spec.Kubernetes.KubeScheduler = nil
+				shoot.Spec.Kubernetes.Kubelet = nil
+				shoot.Spec.Kubernetes.ClusterAutoscaler = nil
+				shoot.Spec.Kubernetes.VerticalPodAutoscaler = nil
+
+				Expect(ValidateShoot(shoot)).NotTo(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Field": HavePrefix("spec.kubernetes.namespaceDefaults"),
 				}))))
 			})
 
-			It("should succeed when using IPTables mode", func() {
-				mode := core.ProxyModeIPTables
-				shoot.Spec.Kubernetes.KubeProxy.Mode = &mode
-				errorList := ValidateShoot(shoot)
+			It("should forbid invalid namespace selectors", func() {
+				shoot.Spec.Kubernetes.NamespaceDefaults.NamespaceSelector = &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "team", Operator: "foo"}}}
 
-				Expect(errorList).To(BeEmpty())
+				Expect(ValidateShoot(shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.kubernetes.namespaceDefaults.namespaceSelector.matchExpressions[0].operator"),
+				}))))
 			})
 
-			It("should succeed when using IPVS mode", func() {
-				mode := core.ProxyModeIPVS
-				shoot.Spec.Kubernetes.KubeProxy.Mode = &mode
-				errorList := ValidateShoot(shoot)
+			It("should forbid invalid and duplicate names", func() {
+				shoot.Spec.Kubernetes.NamespaceDefaults.LimitRanges = append(shoot.Spec.Kubernetes.NamespaceDefaults.LimitRanges, shoot.Spec.Kubernetes.NamespaceDefaults.LimitRanges[0])
+				shoot.Spec.Kubernetes.NamespaceDefaults.ResourceQuotas[0].Name = "Foo_Bar"
 
-				Expect(errorList).To(BeEmpty())
-			})
+				Expect(ValidateShoot(shoot)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("spec.kubernetes.namespaceDefaults.limitRanges[1].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.kubernetes.namespaceDefaults.resourceQuotas[0].name"),
+					})),
+				))
+			})
+
+			It("should forbid invalid limit ranges", func() {
+				shoot.Spec.Kubernetes.NamespaceDefaults.LimitRanges = []core.NamespaceDefaultsLimitRange{
+					{Name: "empty"},
+					{
+						Name: "invalid",
+						Spec: corev1.LimitRangeSpec{Limits: []corev1.LimitRangeItem{{
+							Type: "Node",
+							Max:  corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("-1Gi")},
+						}}},
+					},
+				}
+
+				Expect(ValidateShoot(shoot)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.kubernetes.namespaceDefaults.limitRanges[0].spec.limits"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("spec.kubernetes.namespaceDefaults.limitRanges[1].spec.limits[0].type"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.kubernetes.namespaceDefaults.limitRanges[1].spec.limits[0].max[memory]"),
+					})),
+				))
+			})
+
+			It("should forbid invalid resource quotas", func() {
+				shoot.Spec.Kubernetes.NamespaceDefaults.ResourceQuotas = []core.NamespaceDefaultsResourceQuota{
+					{Name: "empty"},
+					{
+						Name: "invalid",
+						Spec: corev1.ResourceQuotaSpec{
+							Hard:   corev1.ResourceList{corev1.ResourcePods: resource.MustParse("-1")},
+							Scopes: []corev1.ResourceQuotaScope{"foo"},
+						},
+					},
+				}
+
+				Expect(ValidateShoot(shoot)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.kubernetes.namespaceDefaults.resourceQuotas[0].spec.hard"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.kubernetes.namespaceDefaults.resourceQuotas[1].spec.hard[pods]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("spec.kubernetes.namespaceDefaults.resourceQuotas[1].spec.scopes[0]"),
+					})),
+				))
+			})
+		})
+
+		Context("KubeProxy validation", func() {
+			BeforeEach(func() {
+				shoot.Spec.Kubernetes.KubeProxy = &core.KubeProxyConfig{}
+			})
+
+			It("should prevent setting kubeproxy config for workerless shoots", func() {
+				shoot.Spec.Provider.Workers = []core.Worker{}
+				shoot.Spec.Kubernetes.KubeProxy = &core.KubeProxyConfig{
+					KubernetesConfig: core.KubernetesConfig{
+						FeatureGates: nil,
+					},
+				}
+
+				errorList := ValidateShoot(shoot)
+				Expect(errorList).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeForbidden),
+					"Field":  Equal("spec.kubernetes.kubeProxy"),
+					"Detail": ContainSubstring("this field should not be set for workerless Shoot clusters"),
+				}))))
+			})
+
+			It("should succeed when using IPTables mode", func() {
+				mode := core.ProxyModeIPTables
+				shoot.Spec.Kubernetes.KubeProxy.Mode = &mode
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should succeed when using IPVS mode", func() {
+				mode := core.ProxyModeIPVS
+				shoot.Spec.Kubernetes.KubeProxy.Mode = &mode
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(BeEmpty())
+			})
 
 			It("should fail when using nil proxy mode", func() {
 				shoot.Spec.Kubernetes.KubeProxy.Mode = nil
@@ -3120,6 +3584,81 @@ var _ = Describe("Shoot Validation Tests", func() {
 				}))))
 			})
 
+			It("should allow a valid audit webhook", func() {
+				shoot.Spec.Kubernetes.KubeAPIServer.AuditConfig.Webhook = &core.AuditWebhook{
+					KubeconfigSecretName: "audit-webhook",
+					BatchMaxSize:         ptr.To[int32](100),
+					BatchMaxWait:         &metav1.Duration{Duration: 10 * time.Second},
+				}
+
+				Expect(ValidateShoot(shoot)).To(BeEmpty())
+			})
+
+			It("should forbid an invalid audit webhook", func() {
+				shoot.Spec.Kubernetes.KubeAPIServer.AuditConfig.Webhook = &core.AuditWebhook{
+					BatchMaxSize: ptr.To[int32](0),
+					BatchMaxWait: &metav1.Duration{Duration: -time.Second},
+				}
+
+				Expect(ValidateShoot(shoot)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.kubernetes.kubeAPIServer.auditConfig.webhook.kubeconfigSecretName"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.kubernetes.kubeAPIServer.auditConfig.webhook.batchMaxSize"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.kubernetes.kubeAPIServer.auditConfig.webhook.batchMaxWait"),
+					})),
+				))
+			})
+
+			It("should allow a valid audit sink", func() {
+				shoot.Spec.Kubernetes.KubeAPIServer.AuditConfig.Sink = &core.AuditSink{Type: "foo"}
+
+				Expect(ValidateShoot(shoot)).To(BeEmpty())
+			})
+
+			It("should forbid an invalid audit sink", func() {
+				shoot.Spec.Kubernetes.KubeAPIServer.AuditConfig.Webhook = &core.AuditWebhook{KubeconfigSecretName: "audit-webhook"}
+				shoot.Spec.Kubernetes.KubeAPIServer.AuditConfig.Sink = &core.AuditSink{}
+
+				Expect(ValidateShoot(shoot)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.kubernetes.kubeAPIServer.auditConfig.sink"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.kubernetes.kubeAPIServer.auditConfig.sink.type"),
+					})),
+				))
+			})
+
+			It("should forbid changing the type of the audit sink", func() {
+				shoot.Spec.Kubernetes.KubeAPIServer.AuditConfig.Sink = &core.AuditSink{Type: "foo"}
+				newShoot := prepareShootForUpdate(shoot)
+				newShoot.Spec.Kubernetes.KubeAPIServer.AuditConfig.Sink.Type = "bar"
+
+				Expect(ValidateShootUpdate(newShoot, shoot)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.kubernetes.kubeAPIServer.auditConfig.sink.type"),
+					})),
+				))
+			})
+
+			It("should allow removing the audit sink", func() {
+				shoot.Spec.Kubernetes.KubeAPIServer.AuditConfig.Sink = &core.AuditSink{Type: "foo"}
+				newShoot := prepareShootForUpdate(shoot)
+				newShoot.Spec.Kubernetes.KubeAPIServer.AuditConfig.Sink = nil
+
+				Expect(ValidateShootUpdate(newShoot, shoot)).To(BeEmpty())
+			})
+
 			It("should allow nil AuditConfig", func() {
 				shoot.Spec.Kubernetes.KubeAPIServer.AuditConfig = nil
 				errorList := ValidateShoot(shoot)
@@ -3330,6 +3869,29 @@ var _ = Describe("Shoot Validation Tests", func() {
 			})
 		})
 
+		Context("KubeControllerManager controllers validation", func() {
+			It("should allow valid controllers", func() {
+				shoot.Spec.Kubernetes.KubeControllerManager.Controllers = []core.KubeControllerManagerController{
+					{Name: "ttl-after-finished", Enabled: ptr.To(false)},
+					{Name: "deployment", ConcurrentSyncs: ptr.To[int32](100)},
+				}
+
+				Expect(ValidateShoot(shoot)).To(BeEmpty())
+			})
+
+			It("should forbid controllers not supported by the Kubernetes version", func() {
+				shoot.Spec.Kubernetes.Version = "1.28.2"
+				shoot.Spec.Kubernetes.KubeControllerManager.Controllers = []core.KubeControllerManagerController{
+					{Name: "taint-eviction-controller", Enabled: ptr.To(false)},
+				}
+
+				Expect(ValidateShoot(shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.kubernetes.kubeControllerManager.controllers[0].name"),
+				}))))
+			})
+		})
+
 		Context("Kubernetes Version", func() {
 			It("should require a kubernetes version", func() {
 				shoot.Spec.Kubernetes.Version = ""
@@ -3507,6 +4069,79 @@ var _ = Describe("Shoot Validation Tests", func() {
 			})
 		})
 
+		Context("worker pool update strategy", func() {
+			It("should allow setting the rolling update strategy for existing worker pools", func() {
+				newShoot := prepareShootForUpdate(shoot)
+				newShoot.Spec.Provider.Workers[0].UpdateStrategy = ptr.To(core.WorkerUpdateStrategyRollingUpdate)
+
+				Expect(ValidateShootUpdate(newShoot, shoot)).To(BeEmpty())
+			})
+
+			It("should forbid switching existing worker pools to the in-place update strategy", func() {
+				newShoot := prepareShootForUpdate(shoot)
+				newShoot.Spec.Provider.Workers[0].UpdateStrategy = ptr.To(core.WorkerUpdateStrategyInPlace)
+
+				Expect(ValidateShootUpdate(newShoot, shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.provider.workers[0].updateStrategy"),
+				}))))
+			})
+
+			It("should forbid switching existing worker pools to the rolling update strategy", func() {
+				shoot.Spec.Provider.Workers[0].UpdateStrategy = ptr.To(core.WorkerUpdateStrategyInPlace)
+				newShoot := prepareShootForUpdate(shoot)
+				newShoot.Spec.Provider.Workers[0].UpdateStrategy = nil
+
+				Expect(ValidateShootUpdate(newShoot, shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.provider.workers[0].updateStrategy"),
+				}))))
+			})
+
+			It("should allow adding new worker pools with the in-place update strategy", func() {
+				newShoot := prepareShootForUpdate(shoot)
+				worker := *newShoot.Spec.Provider.Workers[0].DeepCopy()
+				worker.Name = "in-place"
+				worker.UpdateStrategy = ptr.To(core.WorkerUpdateStrategyInPlace)
+				newShoot.Spec.Provider.Workers = append(newShoot.Spec.Provider.Workers, worker)
+
+				Expect(ValidateShootUpdate(newShoot, shoot)).To(BeEmpty())
+			})
+
+			It("should allow changing the machine image version of worker pools with the in-place update strategy", func() {
+				shoot.Spec.Provider.Workers[0].UpdateStrategy = ptr.To(core.WorkerUpdateStrategyInPlace)
+				newShoot := prepareShootForUpdate(shoot)
+				newShoot.Spec.Provider.Workers[0].Machine.Image.Version = "2.0.0"
+
+				Expect(ValidateShootUpdate(newShoot, shoot)).To(BeEmpty())
+			})
+
+			It("should forbid changing the machine image name of worker pools with the in-place update strategy", func() {
+				shoot.Spec.Provider.Workers[0].UpdateStrategy = ptr.To(core.WorkerUpdateStrategyInPlace)
+				newShoot := prepareShootForUpdate(shoot)
+				newShoot.Spec.Provider.Workers[0].Machine.Image.Name = "other-image"
+
+				Expect(ValidateShootUpdate(newShoot, shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.provider.workers[0].machine.image.name"),
+				}))))
+			})
+		})
+
+		Context("worker pool operating system family", func() {
+			It("should forbid changing the operating system family of existing worker pools", func() {
+				shoot.Spec.Provider.Workers[0].Machine.OSFamily = ptr.To(v1beta1constants.OSFamilyLinux)
+				newShoot := prepareShootForUpdate(shoot)
+				newShoot.Spec.Provider.Workers[0].Machine.OSFamily = ptr.To("plan9")
+
+				Expect(ValidateShootUpdate(newShoot, shoot)).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("spec.provider.workers[0].machine.osFamily"),
+					"Detail": Equal("field is immutable"),
+				}))))
+			})
+		})
+
 		Context("networking section", func() {
 			Context("Workerless Shoots", func() {
 				It("should forbid setting networking.type, networking.providerConfig, networking.pods, networking.nodes", func() {
@@ -3788,6 +4423,41 @@ var _ = Describe("Shoot Validation Tests", func() {
 				errorList := ValidateShoot(shoot)
 				Expect(errorList).To(BeEmpty())
 			})
+
+			It("should allow migrating from IPv4 single-stack to dual-stack", func() {
+				shoot.Spec.Networking.IPFamilies = []core.IPFamily{core.IPFamilyIPv4}
+
+				newShoot := prepareShootForUpdate(shoot)
+				newShoot.Spec.Networking.IPFamilies = []core.IPFamily{core.IPFamilyIPv4, core.IPFamilyIPv6}
+
+				Expect(ValidateShootUpdate(newShoot, shoot)).To(BeEmpty())
+			})
+
+			It("should forbid migrating from IPv4 single-stack to dual-stack with IPv6 as primary IP family", func() {
+				shoot.Spec.Networking.IPFamilies = []core.IPFamily{core.IPFamilyIPv4}
+
+				newShoot := prepareShootForUpdate(shoot)
+				newShoot.Spec.Networking.IPFamilies = []core.IPFamily{core.IPFamilyIPv6, core.IPFamilyIPv4}
+
+				Expect(ValidateShootUpdate(newShoot, shoot)).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("spec.networking.ipFamilies"),
+					"Detail": ContainSubstring(`field is immutable`),
+				}))
+			})
+
+			It("should forbid migrating from dual-stack to IPv4 single-stack", func() {
+				shoot.Spec.Networking.IPFamilies = []core.IPFamily{core.IPFamilyIPv4, core.IPFamilyIPv6}
+
+				newShoot := prepareShootForUpdate(shoot)
+				newShoot.Spec.Networking.IPFamilies = []core.IPFamily{core.IPFamilyIPv4}
+
+				Expect(ValidateShootUpdate(newShoot, shoot)).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("spec.networking.ipFamilies"),
+					"Detail": ContainSubstring(`field is immutable`),
+				}))
+			})
 		})
 
 		Context("maintenance section", func() {
@@ -3848,39 +4518,197 @@ var _ = Describe("Shoot Validation Tests", func() {
 					"Detail": ContainSubstring("this field should not be set for workerless Shoot cluster"),
 				}))))
 			})
-		})
 
-		It("should forbid updating the spec for shoots with deletion timestamp", func() {
-			newShoot := prepareShootForUpdate(shoot)
-			deletionTimestamp := metav1.NewTime(time.Now())
-			shoot.DeletionTimestamp = &deletionTimestamp
-			newShoot.DeletionTimestamp = &deletionTimestamp
-			newShoot.Spec.Maintenance.AutoUpdate.KubernetesVersion = false
+			Context("exclusions", func() {
+				var begin time.Time
 
-			errorList := ValidateShootUpdate(newShoot, shoot)
+				BeforeEach(func() {
+					begin = time.Date(2024, 11, 25, 0, 0, 0, 0, time.UTC)
+				})
 
-			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
-				"Type":   Equal(field.ErrorTypeForbidden),
-				"Field":  Equal("spec"),
-				"Detail": Equal("Maintenance.AutoUpdate.KubernetesVersion: false != true"),
-			}))))
-		})
+				It("should allow valid exclusions", func() {
+					shoot.Spec.Maintenance.Exclusions = []core.MaintenanceExclusion{
+						{
+							Name:       "black-friday",
+							Begin:      metav1.NewTime(begin),
+							End:        metav1.NewTime(begin.Add(7 * 24 * time.Hour)),
+							Operations: []core.MaintenanceExclusionOperation{core.MaintenanceExclusionOperationNodeRoll, core.MaintenanceExclusionOperationEtcdMaintenance},
+						},
+						{
+							Name:       "christmas",
+							Begin:      metav1.NewTime(begin.Add(28 * 24 * time.Hour)),
+							End:        metav1.NewTime(begin.Add(35 * 24 * time.Hour)),
+							Operations: []core.MaintenanceExclusionOperation{core.MaintenanceExclusionOperationCARotation},
+						},
+					}
 
-		It("should allow updating the metadata for shoots with deletion timestamp", func() {
-			newShoot := prepareShootForUpdate(shoot)
-			deletionTimestamp := metav1.NewTime(time.Now())
-			shoot.DeletionTimestamp = &deletionTimestamp
-			newShoot.DeletionTimestamp = &deletionTimestamp
-			newShoot.Labels = map[string]string{
-				"new-key": "new-value",
-			}
+					Expect(ValidateShoot(shoot)).To(BeEmpty())
+				})
 
-			errorList := ValidateShootUpdate(newShoot, shoot)
+				It("should forbid exclusions without required fields", func() {
+					shoot.Spec.Maintenance.Exclusions = []core.MaintenanceExclusion{{}}
 
-			Expect(errorList).To(BeEmpty())
-		})
+					Expect(ValidateShoot(shoot)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("spec.maintenance.exclusions[0].name"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("spec.maintenance.exclusions[0].begin"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("spec.maintenance.exclusions[0].end"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("spec.maintenance.exclusions[0].operations"),
+						})),
+					))
+				})
 
-		Describe("kubeconfig rotation", func() {
+				It("should forbid invalid exclusions", func() {
+					shoot.Spec.Maintenance.Exclusions = []core.MaintenanceExclusion{
+						{
+							Name:       "peak-season",
+							Begin:      metav1.NewTime(begin),
+							End:        metav1.NewTime(begin),
+							Operations: []core.MaintenanceExclusionOperation{"Foo", core.MaintenanceExclusionOperationNodeRoll, core.MaintenanceExclusionOperationNodeRoll},
+						},
+						{
+							Name:       "peak-season",
+							Begin:      metav1.NewTime(begin),
+							End:        metav1.NewTime(begin.Add(91 * 24 * time.Hour)),
+							Operations: []core.MaintenanceExclusionOperation{core.MaintenanceExclusionOperationCARotation},
+						},
+					}
+
+					Expect(ValidateShoot(shoot)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeInvalid),
+							"Field":  Equal("spec.maintenance.exclusions[0].end"),
+							"Detail": Equal("end must be after begin"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeNotSupported),
+							"Field": Equal("spec.maintenance.exclusions[0].operations[0]"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeDuplicate),
+							"Field": Equal("spec.maintenance.exclusions[0].operations[2]"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeDuplicate),
+							"Field": Equal("spec.maintenance.exclusions[1].name"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeInvalid),
+							"Field":  Equal("spec.maintenance.exclusions[1]"),
+							"Detail": Equal("exclusion must not be longer than 2160h0m0s"),
+						})),
+					))
+				})
+			})
+
+			Context("worker pools", func() {
+				It("should allow valid worker pool maintenance time windows", func() {
+					shoot.Spec.Maintenance.WorkerPools = []core.WorkerPoolMaintenance{
+						{
+							Name:       "worker-name",
+							TimeWindow: core.MaintenanceTimeWindow{Begin: "030000+0100", End: "040000+0100"},
+						},
+					}
+
+					Expect(ValidateShoot(shoot)).To(BeEmpty())
+				})
+
+				It("should forbid worker pool maintenance time windows without name", func() {
+					shoot.Spec.Maintenance.WorkerPools = []core.WorkerPoolMaintenance{
+						{TimeWindow: core.MaintenanceTimeWindow{Begin: "030000+0100", End: "040000+0100"}},
+					}
+
+					Expect(ValidateShoot(shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("spec.maintenance.workerPools[0].name"),
+					}))))
+				})
+
+				It("should forbid invalid worker pool maintenance time windows", func() {
+					shoot.Spec.Maintenance.WorkerPools = []core.WorkerPoolMaintenance{
+						{
+							Name:       "worker-name",
+							TimeWindow: core.MaintenanceTimeWindow{Begin: "invalidformat", End: "invalidformat"},
+						},
+						{
+							Name:       "worker-name",
+							TimeWindow: core.MaintenanceTimeWindow{Begin: "225000+0100", End: "231000+0100"},
+						},
+						{
+							Name:       "foo",
+							TimeWindow: core.MaintenanceTimeWindow{Begin: "145000+0100", End: "215000+0100"},
+						},
+					}
+
+					Expect(ValidateShoot(shoot)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.maintenance.workerPools[0].timeWindow.begin/end"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeDuplicate),
+							"Field": Equal("spec.maintenance.workerPools[1].name"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeInvalid),
+							"Field":  Equal("spec.maintenance.workerPools[1].timeWindow"),
+							"Detail": Equal("time window must not be smaller than 30m0s"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeNotFound),
+							"Field": Equal("spec.maintenance.workerPools[2].name"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":   Equal(field.ErrorTypeInvalid),
+							"Field":  Equal("spec.maintenance.workerPools[2].timeWindow"),
+							"Detail": Equal("time window must not be greater than 6h0m0s"),
+						})),
+					))
+				})
+			})
+		})
+
+		It("should forbid updating the spec for shoots with deletion timestamp", func() {
+			newShoot := prepareShootForUpdate(shoot)
+			deletionTimestamp := metav1.NewTime(time.Now())
+			shoot.DeletionTimestamp = &deletionTimestamp
+			newShoot.DeletionTimestamp = &deletionTimestamp
+			newShoot.Spec.Maintenance.AutoUpdate.KubernetesVersion = false
+
+			errorList := ValidateShootUpdate(newShoot, shoot)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeForbidden),
+				"Field":  Equal("spec"),
+				"Detail": Equal("Maintenance.AutoUpdate.KubernetesVersion: false != true"),
+			}))))
+		})
+
+		It("should allow updating the metadata for shoots with deletion timestamp", func() {
+			newShoot := prepareShootForUpdate(shoot)
+			deletionTimestamp := metav1.NewTime(time.Now())
+			shoot.DeletionTimestamp = &deletionTimestamp
+			newShoot.DeletionTimestamp = &deletionTimestamp
+			newShoot.Labels = map[string]string{
+				"new-key": "new-value",
+			}
+
+			errorList := ValidateShootUpdate(newShoot, shoot)
+
+			Expect(errorList).To(BeEmpty())
+		})
+
+		Describe("kubeconfig rotation", func() {
 			DescribeTable("DisallowKubeconfigRotationForShootInDeletion",
 				func(oldAnnotations, newAnnotations map[string]string, newSetDeletionTimestamp, expectedError bool) {
 					now := metav1.NewTime(time.Now())
@@ -3921,7 +4749,7 @@ var _ = Describe("Shoot Validation Tests", func() {
 		Describe("#ValidateSystemComponents", func() {
 			DescribeTable("validate system components",
 				func(systemComponents *core.SystemComponents, workerlessShoot bool, matcher gomegatypes.GomegaMatcher) {
-					Expect(ValidateSystemComponents(systemComponents, nil, workerlessShoot)).To(matcher)
+					Expect(ValidateSystemComponents(systemComponents, nil, nil, workerlessShoot)).To(matcher)
 				},
 				Entry("no system components", nil, false, BeEmpty()),
 				Entry("no system components Workerless Shoot", nil, false, BeEmpty()),
@@ -3937,6 +4765,228 @@ var _ = Describe("Shoot Validation Tests", func() {
 					"Type": Equal(field.ErrorTypeNotSupported),
 				})))),
 			)
+
+			DescribeTable("validate core dns custom plugins",
+				func(customPlugins *core.CoreDNSCustomPlugins, matcher gomegatypes.GomegaMatcher) {
+					Expect(ValidateSystemComponents(&core.SystemComponents{CoreDNS: &core.CoreDNS{CustomPlugins: customPlugins}}, nil, field.NewPath("systemComponents"), false)).To(matcher)
+				},
+				Entry("no custom plugins", nil, BeEmpty()),
+				Entry("valid custom plugins", &core.CoreDNSCustomPlugins{
+					Log:   ptr.To(true),
+					DNS64: &core.CoreDNSDNS64{Prefix: ptr.To("64:ff9b::/96")},
+					ForwardZones: []core.CoreDNSForwardZone{
+						{Zone: "corp.example.com", Upstreams: []string{"10.0.0.10", "10.0.0.11:5353"}},
+						{Zone: "10.in-addr.arpa.", Upstreams: []string{"[2001:db8::53]:53", "2001:db8::54"}},
+					},
+				}, BeEmpty()),
+				Entry("dns64 without prefix", &core.CoreDNSCustomPlugins{DNS64: &core.CoreDNSDNS64{}}, BeEmpty()),
+				Entry("invalid dns64 prefix", &core.CoreDNSCustomPlugins{DNS64: &core.CoreDNSDNS64{Prefix: ptr.To("10.0.0.0/8")}}, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("systemComponents.coreDNS.customPlugins.dns64.prefix"),
+					"Detail": Equal("must be an IPv6 CIDR"),
+				})))),
+				Entry("unsupported dns64 prefix length", &core.CoreDNSCustomPlugins{DNS64: &core.CoreDNSDNS64{Prefix: ptr.To("64:ff9b::/80")}}, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("systemComponents.coreDNS.customPlugins.dns64.prefix"),
+					"Detail": Equal("prefix length must be one of [32 40 48 56 64 96]"),
+				})))),
+				Entry("invalid forward zones", &core.CoreDNSCustomPlugins{
+					ForwardZones: []core.CoreDNSForwardZone{
+						{Zone: "", Upstreams: []string{"10.0.0.10"}},
+						{Zone: "foo_bar", Upstreams: []string{"10.0.0.10"}},
+						{Zone: "svc.cluster.local", Upstreams: []string{"10.0.0.10"}},
+						{Zone: "corp.example.com", Upstreams: []string{"10.0.0.10"}},
+						{Zone: "corp.example.com.", Upstreams: []string{"10.0.0.10"}},
+					},
+				}, ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("systemComponents.coreDNS.customPlugins.forwardZones[0].zone"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("systemComponents.coreDNS.customPlugins.forwardZones[1].zone"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("systemComponents.coreDNS.customPlugins.forwardZones[2].zone"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("systemComponents.coreDNS.customPlugins.forwardZones[4].zone"),
+					})),
+				)),
+				Entry("invalid forward upstreams", &core.CoreDNSCustomPlugins{
+					ForwardZones: []core.CoreDNSForwardZone{
+						{Zone: "foo.example.com"},
+						{Zone: "bar.example.com", Upstreams: []string{"10.0.0.10:0", "dns.example.com"}},
+						{Zone: "baz.example.com", Upstreams: []string{"10.0.0.1", "10.0.0.2", "10.0.0.3", "10.0.0.4", "10.0.0.5", "10.0.0.6", "10.0.0.7", "10.0.0.8", "10.0.0.9", "10.0.0.10", "10.0.0.11", "10.0.0.12", "10.0.0.13", "10.0.0.14", "10.0.0.15", "10.0.0.16"}},
+					},
+				}, ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("systemComponents.coreDNS.customPlugins.forwardZones[0].upstreams"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("systemComponents.coreDNS.customPlugins.forwardZones[1].upstreams[0]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("systemComponents.coreDNS.customPlugins.forwardZones[1].upstreams[1]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeTooMany),
+						"Field": Equal("systemComponents.coreDNS.customPlugins.forwardZones[2].upstreams"),
+					})),
+				)),
+			)
+
+			DescribeTable("validate dedicated pool",
+				func(workers []core.Worker, matcher gomegatypes.GomegaMatcher) {
+					Expect(ValidateSystemComponents(&core.SystemComponents{DedicatedPool: ptr.To("system")}, workers, field.NewPath("systemComponents"), false)).To(matcher)
+				},
+				Entry("dedicated pool is the only pool allowing system components", []core.Worker{
+					{Name: "system", SystemComponents: &core.WorkerSystemComponents{Allow: true}},
+					{Name: "user", SystemComponents: &core.WorkerSystemComponents{Allow: false}},
+				}, BeEmpty()),
+				Entry("dedicated pool does not exist", []core.Worker{
+					{Name: "user", SystemComponents: &core.WorkerSystemComponents{Allow: false}},
+				}, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("systemComponents.dedicatedPool"),
+					"Detail": Equal("must reference an existing worker pool"),
+				})))),
+				Entry("dedicated pool does not allow system components", []core.Worker{
+					{Name: "system", SystemComponents: &core.WorkerSystemComponents{Allow: false}},
+				}, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("systemComponents.dedicatedPool"),
+					"Detail": Equal("the dedicated worker pool must allow system components"),
+				})))),
+				Entry("dedicated pool reserves nodes for system components", []core.Worker{
+					{Name: "system", SystemComponents: &core.WorkerSystemComponents{Allow: true, ReservedNodesPercentage: ptr.To[int32](50)}},
+				}, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeForbidden),
+					"Field":  Equal("systemComponents.dedicatedPool"),
+					"Detail": Equal("the dedicated worker pool must not reserve nodes for system components since all its nodes are reserved for them"),
+				})))),
+				Entry("other pools allow system components", []core.Worker{
+					{Name: "system", SystemComponents: &core.WorkerSystemComponents{Allow: true}},
+					{Name: "user"},
+					{Name: "other", SystemComponents: &core.WorkerSystemComponents{Allow: true}},
+				}, ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeForbidden),
+						"Field":  Equal("systemComponents.dedicatedPool"),
+						"Detail": Equal(`worker pool "user" must not allow system components when a dedicated worker pool is configured`),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeForbidden),
+						"Field":  Equal("systemComponents.dedicatedPool"),
+						"Detail": Equal(`worker pool "other" must not allow system components when a dedicated worker pool is configured`),
+					})),
+				)),
+			)
+
+			DescribeTable("validate node local dns",
+				func(nodeLocalDNS *core.NodeLocalDNS, matcher gomegatypes.GomegaMatcher) {
+					workers := []core.Worker{{Name: "pool-1"}, {Name: "pool-2"}}
+					Expect(ValidateSystemComponents(&core.SystemComponents{NodeLocalDNS: nodeLocalDNS}, workers, field.NewPath("systemComponents"), false)).To(matcher)
+				},
+				Entry("only enabled", &core.NodeLocalDNS{Enabled: true}, BeEmpty()),
+				Entry("valid settings", &core.NodeLocalDNS{
+					Enabled:               true,
+					ForceTCPToUpstreamDNS: ptr.To(false),
+					Cache:                 &core.NodeLocalDNSCache{SuccessTTLSeconds: ptr.To[int32](60), DenialTTLSeconds: ptr.To[int32](10)},
+					ZoneProtocols: []core.NodeLocalDNSZoneProtocol{
+						{Zone: "cluster.local", Protocol: core.NodeLocalDNSProtocolUDP},
+						{Zone: "ip6.arpa", Protocol: core.NodeLocalDNSProtocolTCP},
+						{Zone: ".", Protocol: core.NodeLocalDNSProtocolUDP},
+					},
+					DisabledWorkerPools: []string{"pool-2"},
+				}, BeEmpty()),
+				Entry("negative caching disabled", &core.NodeLocalDNS{
+					Enabled: true,
+					Cache:   &core.NodeLocalDNSCache{DisableNegativeCaching: ptr.To(true)},
+				}, BeEmpty()),
+				Entry("invalid cache settings", &core.NodeLocalDNS{
+					Enabled: true,
+					Cache:   &core.NodeLocalDNSCache{SuccessTTLSeconds: ptr.To[int32](0), DenialTTLSeconds: ptr.To[int32](-1)},
+				}, ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("systemComponents.nodeLocalDNS.cache.successTTLSeconds"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("systemComponents.nodeLocalDNS.cache.denialTTLSeconds"),
+					})),
+				)),
+				Entry("denial TTL with disabled negative caching", &core.NodeLocalDNS{
+					Enabled: true,
+					Cache:   &core.NodeLocalDNSCache{DenialTTLSeconds: ptr.To[int32](10), DisableNegativeCaching: ptr.To(true)},
+				}, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeForbidden),
+					"Field":  Equal("systemComponents.nodeLocalDNS.cache.denialTTLSeconds"),
+					"Detail": Equal("must not be set when negative caching is disabled"),
+				})))),
+				Entry("invalid zone protocols", &core.NodeLocalDNS{
+					Enabled: true,
+					ZoneProtocols: []core.NodeLocalDNSZoneProtocol{
+						{Zone: "example.com", Protocol: core.NodeLocalDNSProtocolTCP},
+						{Zone: "cluster.local", Protocol: "QUIC"},
+						{Zone: "cluster.local", Protocol: core.NodeLocalDNSProtocolTCP},
+					},
+				}, ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("systemComponents.nodeLocalDNS.zoneProtocols[0].zone"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("systemComponents.nodeLocalDNS.zoneProtocols[1].protocol"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("systemComponents.nodeLocalDNS.zoneProtocols[2].zone"),
+					})),
+				)),
+				Entry("zone protocols conflicting with forced TCP", &core.NodeLocalDNS{
+					Enabled:               true,
+					ForceTCPToClusterDNS:  ptr.To(true),
+					ForceTCPToUpstreamDNS: ptr.To(false),
+					ZoneProtocols: []core.NodeLocalDNSZoneProtocol{
+						{Zone: "in-addr.arpa", Protocol: core.NodeLocalDNSProtocolUDP},
+						{Zone: ".", Protocol: core.NodeLocalDNSProtocolTCP},
+					},
+				}, ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("systemComponents.nodeLocalDNS.zoneProtocols[0].protocol"),
+						"Detail": Equal("conflicts with forceTCPToClusterDNS"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("systemComponents.nodeLocalDNS.zoneProtocols[1].protocol"),
+						"Detail": Equal("conflicts with forceTCPToUpstreamDNS"),
+					})),
+				)),
+				Entry("invalid disabled worker pools", &core.NodeLocalDNS{
+					Enabled:             true,
+					DisabledWorkerPools: []string{"pool-1", "pool-3", "pool-1"},
+				}, ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":   Equal(field.ErrorTypeInvalid),
+						"Field":  Equal("systemComponents.nodeLocalDNS.disabledWorkerPools[1]"),
+						"Detail": Equal("must reference an existing worker pool"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("systemComponents.nodeLocalDNS.disabledWorkerPools[2]"),
+					})),
+				)),
+			)
 		})
 
 		Describe("#ValidateCoreDNSRewritingCommonSuffixes", func() {
@@ -4942,6 +5992,31 @@ var _ = Describe("Shoot Validation Tests", func() {
 				Expect(ValidateShoot(shoot)).To(BeEmpty())
 			})
 
+			It("should return nothing if backup-now operation annotation is set", func() {
+				metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "gardener.cloud/operation", "backup-now")
+				Expect(ValidateShoot(shoot)).To(BeEmpty())
+			})
+
+			It("should forbid the backup-now operation annotation when shoot is hibernated", func() {
+				shoot.Spec.Hibernation = &core.Hibernation{Enabled: ptr.To(true)}
+				metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "gardener.cloud/operation", "backup-now")
+
+				Expect(ValidateShoot(shoot)).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeForbidden),
+					"Field":  Equal("metadata.annotations[gardener.cloud/operation]"),
+					"Detail": ContainSubstring("operation is not permitted when shoot is hibernated or is waking up"),
+				}))))
+			})
+
+			It("should forbid the backup-now operation as maintenance operation annotation", func() {
+				metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "maintenance.gardener.cloud/operation", "backup-now")
+
+				Expect(ValidateShoot(shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("metadata.annotations[maintenance.gardener.cloud/operation]"),
+				}))))
+			})
+
 			It("should return nothing if both operation annotations are valid and do not have the same value", func() {
 				metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "gardener.cloud/operation", "rotate-serviceaccount-key-start")
 				metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "maintenance.gardener.cloud/operation", "rotate-etcd-encryption-key-start")
@@ -5577,6 +6652,55 @@ var _ = Describe("Shoot Validation Tests", func() {
 				}))
 			})
 		})
+
+		Context("validate shoot size class", func() {
+			It("should allow valid size classes", func() {
+				newShoot.Status.SizeClass = ptr.To(core.ShootSizeClassExtraLarge)
+
+				Expect(ValidateShootStatusUpdate(newShoot.Status, shoot.Status)).To(BeEmpty())
+			})
+
+			It("should forbid unknown size classes", func() {
+				newShoot.Status.SizeClass = ptr.To(core.ShootSizeClass("XXL"))
+
+				Expect(ValidateShootStatusUpdate(newShoot.Status, shoot.Status)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("status.sizeClass"),
+				}))))
+			})
+
+			It("should allow valid legacy component removals", func() {
+				newShoot.Status.LegacyComponentRemovals = []core.LegacyComponentRemoval{
+					{Name: "foo", State: core.LastOperationStateProcessing},
+					{Name: "bar", State: core.LastOperationStateSucceeded},
+				}
+
+				Expect(ValidateShootStatusUpdate(newShoot.Status, shoot.Status)).To(BeEmpty())
+			})
+
+			It("should forbid invalid legacy component removals", func() {
+				newShoot.Status.LegacyComponentRemovals = []core.LegacyComponentRemoval{
+					{Name: "", State: core.LastOperationStateProcessing},
+					{Name: "foo", State: core.LastOperationStateSucceeded},
+					{Name: "foo", State: core.LastOperationStateError},
+				}
+
+				Expect(ValidateShootStatusUpdate(newShoot.Status, shoot.Status)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("status.legacyComponentRemovals[0].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("status.legacyComponentRemovals[2].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("status.legacyComponentRemovals[2].state"),
+					})),
+				))
+			})
+		})
 	})
 
 	Describe("#ValidateWorker", func() {
@@ -5650,8 +6774,347 @@ var _ = Describe("Shoot Validation Tests", func() {
 			),
 		)
 
-		DescribeTable("reject when maxUnavailable and maxSurge are invalid",
-			func(maxUnavailable, maxSurge intstr.IntOrString, expectType field.ErrorType) {
+		DescribeTable("reject when maxUnavailable and maxSurge are invalid",
+			func(maxUnavailable, maxSurge intstr.IntOrString, expectType field.ErrorType) {
+				worker := core.Worker{
+					Name: "worker-name",
+					Machine: core.Machine{
+						Type: "large",
+						Image: &core.ShootMachineImage{
+							Name:    "image-name",
+							Version: "1.0.0",
+						},
+						Architecture: ptr.To("amd64"),
+					},
+					MaxSurge:       &maxSurge,
+					MaxUnavailable: &maxUnavailable,
+				}
+				errList := ValidateWorker(worker, core.Kubernetes{Version: ""}, nil, false)
+
+				Expect(errList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type": Equal(expectType),
+				}))))
+			},
+
+			// double zero values (percent or int)
+			Entry("two zero integers", intstr.FromInt32(0), intstr.FromInt32(0), field.ErrorTypeInvalid),
+			Entry("zero int and zero percent", intstr.FromInt32(0), intstr.FromString("0%"), field.ErrorTypeInvalid),
+			Entry("zero percent and zero int", intstr.FromString("0%"), intstr.FromInt32(0), field.ErrorTypeInvalid),
+			Entry("two zero percents", intstr.FromString("0%"), intstr.FromString("0%"), field.ErrorTypeInvalid),
+
+			// greater than 100
+			Entry("maxUnavailable greater than 100 percent", intstr.FromString("101%"), intstr.FromString("100%"), field.ErrorTypeInvalid),
+
+			// below zero tests
+			Entry("values are not below zero", intstr.FromInt32(-1), intstr.FromInt32(0), field.ErrorTypeInvalid),
+			Entry("percentage is not less than zero", intstr.FromString("-90%"), intstr.FromString("90%"), field.ErrorTypeInvalid),
+		)
+
+		DescribeTable("reject when labels are invalid",
+			func(labels map[string]string, expectType field.ErrorType) {
+				maxSurge := intstr.FromInt32(1)
+				maxUnavailable := intstr.FromInt32(0)
+				worker := core.Worker{
+					Name: "worker-name",
+					Machine: core.Machine{
+						Type: "large",
+						Image: &core.ShootMachineImage{
+							Name:    "image-name",
+							Version: "1.0.0",
+						},
+						Architecture: ptr.To("amd64"),
+					},
+					MaxSurge:       &maxSurge,
+					MaxUnavailable: &maxUnavailable,
+					Labels:         labels,
+				}
+				errList := ValidateWorker(worker, core.Kubernetes{Version: ""}, nil, false)
+
+				Expect(errList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type": Equal(expectType),
+				}))))
+			},
+
+			// invalid keys
+			Entry("missing prefix", map[string]string{"/foo": "bar"}, field.ErrorTypeInvalid),
+			Entry("too long name", map[string]string{"foo/somethingthatiswaylongerthanthelimitofthiswhichissixtythreecharacters": "baz"}, field.ErrorTypeInvalid),
+			Entry("too many parts", map[string]string{"foo/bar/baz": "null"}, field.ErrorTypeInvalid),
+			Entry("invalid name", map[string]string{"foo/bar%baz": "null"}, field.ErrorTypeInvalid),
+
+			// invalid values
+			Entry("too long", map[string]string{"foo": "somethingthatiswaylongerthanthelimitofthiswhichissixtythreecharacters"}, field.ErrorTypeInvalid),
+			Entry("invalid", map[string]string{"foo": "no/slashes/allowed"}, field.ErrorTypeInvalid),
+		)
+
+		DescribeTable("reject when annotations are invalid",
+			func(annotations map[string]string, expectType field.ErrorType) {
+				maxSurge := intstr.FromInt32(1)
+				maxUnavailable := intstr.FromInt32(0)
+				worker := core.Worker{
+					Name: "worker-name",
+					Machine: core.Machine{
+						Type: "large",
+						Image: &core.ShootMachineImage{
+							Name:    "image-name",
+							Version: "1.0.0",
+						},
+						Architecture: ptr.To("amd64"),
+					},
+					MaxSurge:       &maxSurge,
+					MaxUnavailable: &maxUnavailable,
+					Annotations:    annotations,
+				}
+				errList := ValidateWorker(worker, core.Kubernetes{Version: ""}, nil, false)
+
+				Expect(errList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type": Equal(expectType),
+				}))))
+			},
+
+			// invalid keys
+			Entry("missing prefix", map[string]string{"/foo": "bar"}, field.ErrorTypeInvalid),
+			Entry("too long name", map[string]string{"foo/somethingthatiswaylongerthanthelimitofthiswhichissixtythreecharacters": "baz"}, field.ErrorTypeInvalid),
+			Entry("too many parts", map[string]string{"foo/bar/baz": "null"}, field.ErrorTypeInvalid),
+			Entry("invalid name", map[string]string{"foo/bar%baz": "null"}, field.ErrorTypeInvalid),
+
+			// invalid value
+			Entry("too long", map[string]string{"foo": strings.Repeat("a", 262142)}, field.ErrorTypeTooLong),
+		)
+
+		DescribeTable("reject when taints are invalid",
+			func(taints []corev1.Taint, expectType field.ErrorType) {
+				maxSurge := intstr.FromInt32(1)
+				maxUnavailable := intstr.FromInt32(0)
+				worker := core.Worker{
+					Name: "worker-name",
+					Machine: core.Machine{
+						Type: "large",
+						Image: &core.ShootMachineImage{
+							Name:    "image-name",
+							Version: "1.0.0",
+						},
+						Architecture: ptr.To("amd64"),
+					},
+					MaxSurge:       &maxSurge,
+					MaxUnavailable: &maxUnavailable,
+					Taints:         taints,
+				}
+				errList := ValidateWorker(worker, core.Kubernetes{Version: ""}, nil, false)
+
+				Expect(errList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type": Equal(expectType),
+				}))))
+			},
+
+			// invalid keys
+			Entry("missing prefix", []corev1.Taint{{Key: "/foo", Value: "bar", Effect: corev1.TaintEffectNoSchedule}}, field.ErrorTypeInvalid),
+			Entry("missing prefix", []corev1.Taint{{Key: "/foo", Value: "bar", Effect: corev1.TaintEffectNoSchedule}}, field.ErrorTypeInvalid),
+			Entry("too long name", []corev1.Taint{{Key: "foo/somethingthatiswaylongerthanthelimitofthiswhichissixtythreecharacters", Value: "bar", Effect: corev1.TaintEffectNoSchedule}}, field.ErrorTypeInvalid),
+			Entry("too many parts", []corev1.Taint{{Key: "foo/bar/baz", Value: "bar", Effect: corev1.TaintEffectNoSchedule}}, field.ErrorTypeInvalid),
+			Entry("invalid name", []corev1.Taint{{Key: "foo/bar%baz", Value: "bar", Effect: corev1.TaintEffectNoSchedule}}, field.ErrorTypeInvalid),
+
+			// invalid values
+			Entry("too long", []corev1.Taint{{Key: "foo", Value: "somethingthatiswaylongerthanthelimitofthiswhichissixtythreecharacters", Effect: corev1.TaintEffectNoSchedule}}, field.ErrorTypeInvalid),
+			Entry("invalid", []corev1.Taint{{Key: "foo", Value: "no/slashes/allowed", Effect: corev1.TaintEffectNoSchedule}}, field.ErrorTypeInvalid),
+
+			// invalid effects
+			Entry("no effect", []corev1.Taint{{Key: "foo", Value: "bar"}}, field.ErrorTypeRequired),
+			Entry("non-existing", []corev1.Taint{{Key: "foo", Value: "bar", Effect: corev1.TaintEffect("does-not-exist")}}, field.ErrorTypeNotSupported),
+
+			// uniqueness by key/effect
+			Entry("not unique", []corev1.Taint{{Key: "foo", Value: "bar", Effect: corev1.TaintEffectNoSchedule}, {Key: "foo", Value: "baz", Effect: corev1.TaintEffectNoSchedule}}, field.ErrorTypeDuplicate),
+		)
+
+		It("should reject if volume is undefined and data volumes are defined", func() {
+			maxSurge := intstr.FromInt32(1)
+			maxUnavailable := intstr.FromInt32(0)
+			dataVolumes := []core.DataVolume{{Name: "vol1-name", VolumeSize: "75Gi"}}
+			worker := core.Worker{
+				Name: "worker-name",
+				Machine: core.Machine{
+					Type: "large",
+					Image: &core.ShootMachineImage{
+						Name:    "image-name",
+						Version: "1.0.0",
+					},
+					Architecture: ptr.To("amd64"),
+				},
+				MaxSurge:       &maxSurge,
+				MaxUnavailable: &maxUnavailable,
+				DataVolumes:    dataVolumes,
+			}
+			errList := ValidateWorker(worker, core.Kubernetes{Version: ""}, nil, false)
+			Expect(errList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("volume"),
+			}))))
+		})
+
+		It("should reject if data volume size does not match size regex", func() {
+			maxSurge := intstr.FromInt32(1)
+			maxUnavailable := intstr.FromInt32(0)
+			name := "vol1-name"
+			vol := core.Volume{Name: &name, VolumeSize: "75Gi"}
+			dataVolumes := []core.DataVolume{{Name: name, VolumeSize: "75Gi"}, {Name: "vol2-name", VolumeSize: "12MiB"}}
+			worker := core.Worker{
+				Name: "worker-name",
+				Machine: core.Machine{
+					Type: "large",
+					Image: &core.ShootMachineImage{
+						Name:    "image-name",
+						Version: "1.0.0",
+					},
+					Architecture: ptr.To("amd64"),
+				},
+				MaxSurge:       &maxSurge,
+				MaxUnavailable: &maxUnavailable,
+				Volume:         &vol,
+				DataVolumes:    dataVolumes,
+			}
+			errList := ValidateWorker(worker, core.Kubernetes{Version: ""}, nil, false)
+			Expect(errList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":     Equal(field.ErrorTypeInvalid),
+				"Field":    Equal("dataVolumes[1].size"),
+				"BadValue": Equal("12MiB"),
+			}))))
+		})
+
+		It("should reject if volume iops or throughput are not positive", func() {
+			worker := core.Worker{
+				Name: "worker-name",
+				Machine: core.Machine{
+					Type: "large",
+					Image: &core.ShootMachineImage{
+						Name:    "image-name",
+						Version: "1.0.0",
+					},
+					Architecture: ptr.To("amd64"),
+				},
+				MaxSurge:       ptr.To(intstr.FromInt32(1)),
+				MaxUnavailable: ptr.To(intstr.FromInt32(0)),
+				Volume:         &core.Volume{VolumeSize: "75Gi", IOPS: ptr.To[int64](0), Throughput: ptr.To[int64](125)},
+				DataVolumes:    []core.DataVolume{{Name: "vol1-name", VolumeSize: "75Gi", IOPS: ptr.To[int64](3000), Throughput: ptr.To[int64](-1)}},
+			}
+
+			errList := ValidateWorker(worker, core.Kubernetes{Version: ""}, nil, false)
+			Expect(errList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("volume.iops"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("dataVolumes[0].throughput"),
+				})),
+			))
+		})
+
+		DescribeTable("capacity type",
+			func(capacityType *core.CapacityType, matcher gomegatypes.GomegaMatcher) {
+				worker := core.Worker{
+					Name: "worker-name",
+					Machine: core.Machine{
+						Type: "large",
+						Image: &core.ShootMachineImage{
+							Name:    "image-name",
+							Version: "1.0.0",
+						},
+						Architecture: ptr.To("amd64"),
+					},
+					MaxSurge:       ptr.To(intstr.FromInt32(1)),
+					MaxUnavailable: ptr.To(intstr.FromInt32(0)),
+					CapacityType:   capacityType,
+				}
+
+				Expect(ValidateWorker(worker, core.Kubernetes{Version: ""}, nil, false)).To(matcher)
+			},
+
+			Entry("should allow no capacity type", nil, BeEmpty()),
+			Entry("should allow on-demand capacity", ptr.To(core.CapacityTypeOnDemand), BeEmpty()),
+			Entry("should allow spot capacity", ptr.To(core.CapacityTypeSpot), BeEmpty()),
+			Entry("should allow spot capacity with fallback", ptr.To(core.CapacityTypeSpotWithFallback), BeEmpty()),
+			Entry("should forbid unknown capacity types", ptr.To(core.CapacityType("Reserved")), ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("capacityType"),
+			})))),
+		)
+
+		DescribeTable("sysctls",
+			func(sysctls map[string]string, matcher gomegatypes.GomegaMatcher) {
+				worker := core.Worker{
+					Name: "worker-name",
+					Machine: core.Machine{
+						Type: "large",
+						Image: &core.ShootMachineImage{
+							Name:    "image-name",
+							Version: "1.0.0",
+						},
+						Architecture: ptr.To("amd64"),
+					},
+					MaxSurge:       ptr.To(intstr.FromInt32(1)),
+					MaxUnavailable: ptr.To(intstr.FromInt32(0)),
+					Sysctls:        sysctls,
+				}
+
+				Expect(ValidateWorker(worker, core.Kubernetes{Version: ""}, nil, false)).To(matcher)
+			},
+
+			Entry("should allow no sysctls", nil, BeEmpty()),
+			Entry("should allow sysctls in supported namespaces", map[string]string{"net.ipv4.tcp_rmem": "4096 131072 16777216", "vm.max_map_count": "262144", "fs.inotify.max_user_watches": "524288"}, BeEmpty()),
+			Entry("should forbid sysctls in unsupported namespaces", map[string]string{"dev.raid.speed_limit_max": "1000"}, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("sysctls[dev.raid.speed_limit_max]"),
+			})))),
+			Entry("should forbid invalid sysctl names", map[string]string{"kernel": "1"}, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("sysctls[kernel]"),
+			})))),
+			Entry("should forbid empty or multi-line sysctl values", map[string]string{"vm.swappiness": " ", "net.core.somaxconn": "1\nkernel.panic = 0"}, ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("sysctls[net.core.somaxconn]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("sysctls[vm.swappiness]"),
+				})),
+			)),
+		)
+
+		DescribeTable("kernel modules",
+			func(kernelModules []string, matcher gomegatypes.GomegaMatcher) {
+				worker := core.Worker{
+					Name: "worker-name",
+					Machine: core.Machine{
+						Type: "large",
+						Image: &core.ShootMachineImage{
+							Name:    "image-name",
+							Version: "1.0.0",
+						},
+						Architecture: ptr.To("amd64"),
+					},
+					MaxSurge:       ptr.To(intstr.FromInt32(1)),
+					MaxUnavailable: ptr.To(intstr.FromInt32(0)),
+					KernelModules:  kernelModules,
+				}
+
+				Expect(ValidateWorker(worker, core.Kubernetes{Version: ""}, nil, false)).To(matcher)
+			},
+
+			Entry("should allow no kernel modules", nil, BeEmpty()),
+			Entry("should allow supported kernel modules", []string{"br_netfilter", "ip_vs", "wireguard"}, BeEmpty()),
+			Entry("should forbid unsupported kernel modules", []string{"br_netfilter", "floppy"}, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("kernelModules[1]"),
+			})))),
+			Entry("should forbid duplicate kernel modules", []string{"ip_vs", "ip_vs"}, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("kernelModules[1]"),
+			})))),
+		)
+
+		DescribeTable("reserved nodes for system components",
+			func(systemComponents *core.WorkerSystemComponents, matcher gomegatypes.GomegaMatcher) {
 				worker := core.Worker{
 					Name: "worker-name",
 					Machine: core.Machine{
@@ -5662,34 +7125,33 @@ var _ = Describe("Shoot Validation Tests", func() {
 						},
 						Architecture: ptr.To("amd64"),
 					},
-					MaxSurge:       &maxSurge,
-					MaxUnavailable: &maxUnavailable,
+					MaxSurge:         ptr.To(intstr.FromInt32(1)),
+					MaxUnavailable:   ptr.To(intstr.FromInt32(0)),
+					SystemComponents: systemComponents,
 				}
-				errList := ValidateWorker(worker, core.Kubernetes{Version: ""}, nil, false)
 
-				Expect(errList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
-					"Type": Equal(expectType),
-				}))))
+				Expect(ValidateWorker(worker, core.Kubernetes{Version: ""}, nil, false)).To(matcher)
 			},
 
-			// double zero values (percent or int)
-			Entry("two zero integers", intstr.FromInt32(0), intstr.FromInt32(0), field.ErrorTypeInvalid),
-			Entry("zero int and zero percent", intstr.FromInt32(0), intstr.FromString("0%"), field.ErrorTypeInvalid),
-			Entry("zero percent and zero int", intstr.FromString("0%"), intstr.FromInt32(0), field.ErrorTypeInvalid),
-			Entry("two zero percents", intstr.FromString("0%"), intstr.FromString("0%"), field.ErrorTypeInvalid),
-
-			// greater than 100
-			Entry("maxUnavailable greater than 100 percent", intstr.FromString("101%"), intstr.FromString("100%"), field.ErrorTypeInvalid),
-
-			// below zero tests
-			Entry("values are not below zero", intstr.FromInt32(-1), intstr.FromInt32(0), field.ErrorTypeInvalid),
-			Entry("percentage is not less than zero", intstr.FromString("-90%"), intstr.FromString("90%"), field.ErrorTypeInvalid),
+			Entry("should allow no reservation", &core.WorkerSystemComponents{Allow: true}, BeEmpty()),
+			Entry("should allow a valid percentage", &core.WorkerSystemComponents{Allow: true, ReservedNodesPercentage: ptr.To[int32](20)}, BeEmpty()),
+			Entry("should allow reserving all nodes", &core.WorkerSystemComponents{Allow: true, ReservedNodesPercentage: ptr.To[int32](100)}, BeEmpty()),
+			Entry("should forbid a percentage of zero", &core.WorkerSystemComponents{Allow: true, ReservedNodesPercentage: ptr.To[int32](0)}, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("systemComponents.reservedNodesPercentage"),
+			})))),
+			Entry("should forbid a percentage above 100", &core.WorkerSystemComponents{Allow: true, ReservedNodesPercentage: ptr.To[int32](101)}, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("systemComponents.reservedNodesPercentage"),
+			})))),
+			Entry("should forbid a reservation if system components are not allowed", &core.WorkerSystemComponents{Allow: false, ReservedNodesPercentage: ptr.To[int32](20)}, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("systemComponents.reservedNodesPercentage"),
+			})))),
 		)
 
-		DescribeTable("reject when labels are invalid",
-			func(labels map[string]string, expectType field.ErrorType) {
-				maxSurge := intstr.FromInt32(1)
-				maxUnavailable := intstr.FromInt32(0)
+		DescribeTable("rollout strategy",
+			func(rolloutStrategy *core.WorkerRolloutStrategy, matcher gomegatypes.GomegaMatcher) {
 				worker := core.Worker{
 					Name: "worker-name",
 					Machine: core.Machine{
@@ -5700,32 +7162,26 @@ var _ = Describe("Shoot Validation Tests", func() {
 						},
 						Architecture: ptr.To("amd64"),
 					},
-					MaxSurge:       &maxSurge,
-					MaxUnavailable: &maxUnavailable,
-					Labels:         labels,
+					MaxSurge:        ptr.To(intstr.FromInt32(1)),
+					MaxUnavailable:  ptr.To(intstr.FromInt32(0)),
+					RolloutStrategy: rolloutStrategy,
 				}
-				errList := ValidateWorker(worker, core.Kubernetes{Version: ""}, nil, false)
 
-				Expect(errList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
-					"Type": Equal(expectType),
-				}))))
+				Expect(ValidateWorker(worker, core.Kubernetes{Version: ""}, nil, false)).To(matcher)
 			},
 
-			// invalid keys
-			Entry("missing prefix", map[string]string{"/foo": "bar"}, field.ErrorTypeInvalid),
-			Entry("too long name", map[string]string{"foo/somethingthatiswaylongerthanthelimitofthiswhichissixtythreecharacters": "baz"}, field.ErrorTypeInvalid),
-			Entry("too many parts", map[string]string{"foo/bar/baz": "null"}, field.ErrorTypeInvalid),
-			Entry("invalid name", map[string]string{"foo/bar%baz": "null"}, field.ErrorTypeInvalid),
-
-			// invalid values
-			Entry("too long", map[string]string{"foo": "somethingthatiswaylongerthanthelimitofthiswhichissixtythreecharacters"}, field.ErrorTypeInvalid),
-			Entry("invalid", map[string]string{"foo": "no/slashes/allowed"}, field.ErrorTypeInvalid),
+			Entry("should allow no rollout strategy", nil, BeEmpty()),
+			Entry("should allow no order", &core.WorkerRolloutStrategy{}, BeEmpty()),
+			Entry("should allow order zero", &core.WorkerRolloutStrategy{Order: ptr.To[int32](0)}, BeEmpty()),
+			Entry("should allow positive order", &core.WorkerRolloutStrategy{Order: ptr.To[int32](2)}, BeEmpty()),
+			Entry("should forbid negative order", &core.WorkerRolloutStrategy{Order: ptr.To[int32](-1)}, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("rolloutStrategy.order"),
+			})))),
 		)
 
-		DescribeTable("reject when annotations are invalid",
-			func(annotations map[string]string, expectType field.ErrorType) {
-				maxSurge := intstr.FromInt32(1)
-				maxUnavailable := intstr.FromInt32(0)
+		DescribeTable("update strategy",
+			func(updateStrategy *core.WorkerUpdateStrategy, matcher gomegatypes.GomegaMatcher) {
 				worker := core.Worker{
 					Name: "worker-name",
 					Machine: core.Machine{
@@ -5736,31 +7192,25 @@ var _ = Describe("Shoot Validation Tests", func() {
 						},
 						Architecture: ptr.To("amd64"),
 					},
-					MaxSurge:       &maxSurge,
-					MaxUnavailable: &maxUnavailable,
-					Annotations:    annotations,
+					MaxSurge:       ptr.To(intstr.FromInt32(1)),
+					MaxUnavailable: ptr.To(intstr.FromInt32(0)),
+					UpdateStrategy: updateStrategy,
 				}
-				errList := ValidateWorker(worker, core.Kubernetes{Version: ""}, nil, false)
 
-				Expect(errList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
-					"Type": Equal(expectType),
-				}))))
+				Expect(ValidateWorker(worker, core.Kubernetes{Version: ""}, nil, false)).To(matcher)
 			},
 
-			// invalid keys
-			Entry("missing prefix", map[string]string{"/foo": "bar"}, field.ErrorTypeInvalid),
-			Entry("too long name", map[string]string{"foo/somethingthatiswaylongerthanthelimitofthiswhichissixtythreecharacters": "baz"}, field.ErrorTypeInvalid),
-			Entry("too many parts", map[string]string{"foo/bar/baz": "null"}, field.ErrorTypeInvalid),
-			Entry("invalid name", map[string]string{"foo/bar%baz": "null"}, field.ErrorTypeInvalid),
-
-			// invalid value
-			Entry("too long", map[string]string{"foo": strings.Repeat("a", 262142)}, field.ErrorTypeTooLong),
+			Entry("should allow no update strategy", nil, BeEmpty()),
+			Entry("should allow the rolling update strategy", ptr.To(core.WorkerUpdateStrategyRollingUpdate), BeEmpty()),
+			Entry("should allow the in-place update strategy", ptr.To(core.WorkerUpdateStrategyInPlace), BeEmpty()),
+			Entry("should forbid unknown update strategies", ptr.To(core.WorkerUpdateStrategy("Recreate")), ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("updateStrategy"),
+			})))),
 		)
 
-		DescribeTable("reject when taints are invalid",
-			func(taints []corev1.Taint, expectType field.ErrorType) {
-				maxSurge := intstr.FromInt32(1)
-				maxUnavailable := intstr.FromInt32(0)
+		DescribeTable("machine bootstrap settings",
+			func(settings *core.MachineControllerManagerSettings, matcher gomegatypes.GomegaMatcher) {
 				worker := core.Worker{
 					Name: "worker-name",
 					Machine: core.Machine{
@@ -5771,90 +7221,36 @@ var _ = Describe("Shoot Validation Tests", func() {
 						},
 						Architecture: ptr.To("amd64"),
 					},
-					MaxSurge:       &maxSurge,
-					MaxUnavailable: &maxUnavailable,
-					Taints:         taints,
+					MaxSurge:                         ptr.To(intstr.FromInt32(1)),
+					MaxUnavailable:                   ptr.To(intstr.FromInt32(0)),
+					MachineControllerManagerSettings: settings,
 				}
-				errList := ValidateWorker(worker, core.Kubernetes{Version: ""}, nil, false)
 
-				Expect(errList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
-					"Type": Equal(expectType),
-				}))))
+				Expect(ValidateWorker(worker, core.Kubernetes{Version: ""}, nil, false)).To(matcher)
 			},
 
-			// invalid keys
-			Entry("missing prefix", []corev1.Taint{{Key: "/foo", Value: "bar", Effect: corev1.TaintEffectNoSchedule}}, field.ErrorTypeInvalid),
-			Entry("missing prefix", []corev1.Taint{{Key: "/foo", Value: "bar", Effect: corev1.TaintEffectNoSchedule}}, field.ErrorTypeInvalid),
-			Entry("too long name", []corev1.Taint{{Key: "foo/somethingthatiswaylongerthanthelimitofthiswhichissixtythreecharacters", Value: "bar", Effect: corev1.TaintEffectNoSchedule}}, field.ErrorTypeInvalid),
-			Entry("too many parts", []corev1.Taint{{Key: "foo/bar/baz", Value: "bar", Effect: corev1.TaintEffectNoSchedule}}, field.ErrorTypeInvalid),
-			Entry("invalid name", []corev1.Taint{{Key: "foo/bar%baz", Value: "bar", Effect: corev1.TaintEffectNoSchedule}}, field.ErrorTypeInvalid),
-
-			// invalid values
-			Entry("too long", []corev1.Taint{{Key: "foo", Value: "somethingthatiswaylongerthanthelimitofthiswhichissixtythreecharacters", Effect: corev1.TaintEffectNoSchedule}}, field.ErrorTypeInvalid),
-			Entry("invalid", []corev1.Taint{{Key: "foo", Value: "no/slashes/allowed", Effect: corev1.TaintEffectNoSchedule}}, field.ErrorTypeInvalid),
-
-			// invalid effects
-			Entry("no effect", []corev1.Taint{{Key: "foo", Value: "bar"}}, field.ErrorTypeRequired),
-			Entry("non-existing", []corev1.Taint{{Key: "foo", Value: "bar", Effect: corev1.TaintEffect("does-not-exist")}}, field.ErrorTypeNotSupported),
-
-			// uniqueness by key/effect
-			Entry("not unique", []corev1.Taint{{Key: "foo", Value: "bar", Effect: corev1.TaintEffectNoSchedule}, {Key: "foo", Value: "baz", Effect: corev1.TaintEffectNoSchedule}}, field.ErrorTypeDuplicate),
+			Entry("should allow no settings", nil, BeEmpty()),
+			Entry("should allow valid settings", &core.MachineControllerManagerSettings{
+				MachineBootstrapTimeout:       &metav1.Duration{Duration: 15 * time.Minute},
+				MachineBootstrapFailurePolicy: ptr.To(core.MachineBootstrapFailurePolicyReplace),
+			}, BeEmpty()),
+			Entry("should allow the ignore policy", &core.MachineControllerManagerSettings{
+				MachineBootstrapFailurePolicy: ptr.To(core.MachineBootstrapFailurePolicyIgnore),
+			}, BeEmpty()),
+			Entry("should forbid non-positive timeouts", &core.MachineControllerManagerSettings{
+				MachineBootstrapTimeout: &metav1.Duration{},
+			}, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("machineControllerManager.machineBootstrapTimeout"),
+			})))),
+			Entry("should forbid unknown policies", &core.MachineControllerManagerSettings{
+				MachineBootstrapFailurePolicy: ptr.To(core.MachineBootstrapFailurePolicy("Reboot")),
+			}, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("machineControllerManager.machineBootstrapFailurePolicy"),
+			})))),
 		)
 
-		It("should reject if volume is undefined and data volumes are defined", func() {
-			maxSurge := intstr.FromInt32(1)
-			maxUnavailable := intstr.FromInt32(0)
-			dataVolumes := []core.DataVolume{{Name: "vol1-name", VolumeSize: "75Gi"}}
-			worker := core.Worker{
-				Name: "worker-name",
-				Machine: core.Machine{
-					Type: "large",
-					Image: &core.ShootMachineImage{
-						Name:    "image-name",
-						Version: "1.0.0",
-					},
-					Architecture: ptr.To("amd64"),
-				},
-				MaxSurge:       &maxSurge,
-				MaxUnavailable: &maxUnavailable,
-				DataVolumes:    dataVolumes,
-			}
-			errList := ValidateWorker(worker, core.Kubernetes{Version: ""}, nil, false)
-			Expect(errList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
-				"Type":  Equal(field.ErrorTypeRequired),
-				"Field": Equal("volume"),
-			}))))
-		})
-
-		It("should reject if data volume size does not match size regex", func() {
-			maxSurge := intstr.FromInt32(1)
-			maxUnavailable := intstr.FromInt32(0)
-			name := "vol1-name"
-			vol := core.Volume{Name: &name, VolumeSize: "75Gi"}
-			dataVolumes := []core.DataVolume{{Name: name, VolumeSize: "75Gi"}, {Name: "vol2-name", VolumeSize: "12MiB"}}
-			worker := core.Worker{
-				Name: "worker-name",
-				Machine: core.Machine{
-					Type: "large",
-					Image: &core.ShootMachineImage{
-						Name:    "image-name",
-						Version: "1.0.0",
-					},
-					Architecture: ptr.To("amd64"),
-				},
-				MaxSurge:       &maxSurge,
-				MaxUnavailable: &maxUnavailable,
-				Volume:         &vol,
-				DataVolumes:    dataVolumes,
-			}
-			errList := ValidateWorker(worker, core.Kubernetes{Version: ""}, nil, false)
-			Expect(errList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
-				"Type":     Equal(field.ErrorTypeInvalid),
-				"Field":    Equal("dataVolumes[1].size"),
-				"BadValue": Equal("12MiB"),
-			}))))
-		})
-
 		It("should reject if data volume name is invalid", func() {
 			maxSurge := intstr.FromInt32(1)
 			maxUnavailable := intstr.FromInt32(0)
@@ -6065,6 +7461,89 @@ var _ = Describe("Shoot Validation Tests", func() {
 			})))),
 		)
 
+		Context("operating system family", func() {
+			var worker core.Worker
+
+			BeforeEach(func() {
+				worker = core.Worker{
+					Name: "worker",
+					Machine: core.Machine{
+						Type:         "large",
+						Image:        &core.ShootMachineImage{Name: "image-name", Version: "1.0.0"},
+						Architecture: ptr.To(v1beta1constants.ArchitectureAMD64),
+						OSFamily:     ptr.To(v1beta1constants.OSFamilyWindows),
+					},
+					CRI:              &core.CRI{Name: core.CRINameContainerD},
+					SystemComponents: &core.WorkerSystemComponents{Allow: false},
+				}
+			})
+
+			It("should allow linux worker pools", func() {
+				worker.Machine.OSFamily = ptr.To(v1beta1constants.OSFamilyLinux)
+				worker.SystemComponents = nil
+
+				Expect(ValidateWorker(worker, core.Kubernetes{Version: "1.31.1"}, field.NewPath("worker"), false)).To(BeEmpty())
+			})
+
+			It("should forbid unknown operating system families", func() {
+				worker.Machine.OSFamily = ptr.To("plan9")
+
+				Expect(ValidateWorker(worker, core.Kubernetes{Version: "1.31.1"}, field.NewPath("worker"), false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("worker.machine.osFamily"),
+					})),
+				))
+			})
+
+			It("should forbid windows worker pools if the feature gate is disabled", func() {
+				DeferCleanup(test.WithFeatureGate(features.DefaultFeatureGate, features.WindowsWorkerPools, false))
+
+				Expect(ValidateWorker(worker, core.Kubernetes{Version: "1.31.1"}, field.NewPath("worker"), false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("worker.machine.osFamily"),
+					})),
+				))
+			})
+
+			Context("feature gate enabled", func() {
+				BeforeEach(func() {
+					DeferCleanup(test.WithFeatureGate(features.DefaultFeatureGate, features.WindowsWorkerPools, true))
+				})
+
+				It("should allow valid windows worker pools", func() {
+					Expect(ValidateWorker(worker, core.Kubernetes{Version: "1.31.1"}, field.NewPath("worker"), false)).To(BeEmpty())
+				})
+
+				It("should forbid incompatible settings for windows worker pools", func() {
+					worker.Machine.Architecture = ptr.To(v1beta1constants.ArchitectureARM64)
+					worker.SystemComponents = nil
+					worker.UpdateStrategy = ptr.To(core.WorkerUpdateStrategyInPlace)
+					worker.NodeTemplate = &core.WorkerNodeTemplate{EnforceLabels: ptr.To(true)}
+
+					Expect(ValidateWorker(worker, core.Kubernetes{Version: "1.31.1"}, field.NewPath("worker"), false)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeForbidden),
+							"Field": Equal("worker.machine.architecture"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeForbidden),
+							"Field": Equal("worker.systemComponents.allow"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeForbidden),
+							"Field": Equal("worker.updateStrategy"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeForbidden),
+							"Field": Equal("worker.nodeTemplate.enforceLabels"),
+						})),
+					))
+				})
+			})
+		})
+
 		It("validate that container runtime has a type", func() {
 			worker := core.Worker{
 				Name: "worker",
@@ -6614,6 +8093,76 @@ var _ = Describe("Shoot Validation Tests", func() {
 			})))),
 		)
 
+		DescribeTable("MemoryManager",
+			func(policy *string, reservedMemory []core.KubeletMemoryReservation, memoryManagerFeatureGate *bool, matcher gomegatypes.GomegaMatcher) {
+				kubeletConfig := core.KubeletConfig{
+					MemoryManagerPolicy: (*core.MemoryManagerPolicy)(policy),
+					ReservedMemory:      reservedMemory,
+				}
+				if memoryManagerFeatureGate != nil {
+					kubeletConfig.FeatureGates = map[string]bool{"MemoryManager": *memoryManagerFeatureGate}
+				}
+
+				errList := ValidateKubeletConfig(kubeletConfig, "1.31", nil)
+
+				Expect(errList).To(matcher)
+			},
+
+			Entry("should allow empty memory manager configuration", nil, nil, nil, BeEmpty()),
+			Entry("should allow None policy", ptr.To("None"), nil, nil, BeEmpty()),
+			Entry("should allow Static policy with reserved memory", ptr.To("Static"), []core.KubeletMemoryReservation{
+				{NumaNode: 0, Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("600Mi"), "hugepages-2Mi": resource.MustParse("1Gi")}},
+				{NumaNode: 1, Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("500Mi")}},
+			}, nil, BeEmpty()),
+			Entry("should forbid unsupported policy", ptr.To("BestEffort"), nil, nil, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("memoryManagerPolicy"),
+			})))),
+			Entry("should forbid Static policy when feature gate is disabled", ptr.To("Static"), []core.KubeletMemoryReservation{
+				{NumaNode: 0, Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1100Mi")}},
+			}, ptr.To(false), ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeForbidden),
+				"Field":  Equal("memoryManagerPolicy"),
+				"Detail": Equal("memory manager policies other than 'None' are not available when kubelet's 'MemoryManager' feature gate is disabled"),
+			})))),
+			Entry("should require reserved memory for Static policy", ptr.To("Static"), nil, nil, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("reservedMemory"),
+			})))),
+			Entry("should forbid reserved memory without Static policy", nil, []core.KubeletMemoryReservation{
+				{NumaNode: 0, Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1100Mi")}},
+			}, nil, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("reservedMemory"),
+			})))),
+			Entry("should forbid invalid reservations", ptr.To("Static"), []core.KubeletMemoryReservation{
+				{NumaNode: -1, Limits: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")}},
+				{NumaNode: 0, Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("0")}},
+				{NumaNode: 0},
+			}, nil, ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("reservedMemory[0].numaNode"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("reservedMemory[0].limits[cpu]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("reservedMemory[1].limits[memory]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("reservedMemory[2].numaNode"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("reservedMemory[2].limits"),
+				})),
+			)),
+		)
+
 		validResourceQuantity := resource.MustParse(validResourceQuantityValueMi)
 		invalidResourceQuantity := resource.MustParse(invalidResourceQuantityValue)
 
@@ -7018,6 +8567,27 @@ var _ = Describe("Shoot Validation Tests", func() {
 						"Field": Equal(field.NewPath("end").String()),
 					})),
 				)),
+			Entry("valid exceptions", sets.New[string](), &core.HibernationSchedule{Start: ptr.To("0 20 * * *"), Exceptions: []string{"20241224", "* * * * 6,0"}}, BeEmpty()),
+			Entry("invalid and duplicate exceptions", sets.New[string](), &core.HibernationSchedule{Start: ptr.To("0 20 * * *"), Exceptions: []string{"2024-12-24", "20241224", "20241224", "foo"}},
+				ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal(field.NewPath("exceptions").Index(0).String()),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal(field.NewPath("exceptions").Index(2).String()),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal(field.NewPath("exceptions").Index(3).String()),
+					})),
+				)),
+			Entry("exceptions without start", sets.New[string](), &core.HibernationSchedule{End: ptr.To("0 8 * * *"), Exceptions: []string{"20241224"}},
+				ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal(field.NewPath("exceptions").String()),
+				})))),
 		)
 	})
 
@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+)
+
+// ValidateShootClass validates a ShootClass object.
+func ValidateShootClass(shootClass *core.ShootClass) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMeta(&shootClass.ObjectMeta, false, apivalidation.NameIsDNSLabel, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, ValidateShootClassSpec(&shootClass.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+// ValidateShootClassUpdate validates a ShootClass object before an update.
+func ValidateShootClassUpdate(new, old *core.ShootClass) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaUpdate(&new.ObjectMeta, &old.ObjectMeta, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, ValidateShootClass(new)...)
+
+	return allErrs
+}
+
+// ValidateShootClassSpec validates the specification of a ShootClass object.
+func ValidateShootClassSpec(spec *core.ShootClassSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if spec.ProviderType != nil && len(*spec.ProviderType) == 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("providerType"), *spec.ProviderType, "must not be empty if set"))
+	}
+
+	if len(spec.Workers) > 0 {
+		if spec.ProviderType == nil {
+			allErrs = append(allErrs, field.Required(fldPath.Child("providerType"), "must be set if workers are specified"))
+		}
+
+		for i, worker := range spec.Workers {
+			idxPath := fldPath.Child("workers").Index(i)
+
+			// The Kubernetes version of the Shoot is unknown at this point, hence worker pools must not pin a version.
+			if worker.Kubernetes != nil && worker.Kubernetes.Version != nil {
+				allErrs = append(allErrs, field.Forbidden(idxPath.Child("kubernetes", "version"), "must not specify a Kubernetes version in a ShootClass"))
+				continue
+			}
+
+			allErrs = append(allErrs, ValidateWorker(worker, core.Kubernetes{}, idxPath, true)...)
+		}
+
+		allErrs = append(allErrs, ValidateWorkers(spec.Workers, fldPath.Child("workers"))...)
+	}
+
+	if spec.ControlPlane != nil && spec.ControlPlane.HighAvailability != nil {
+		allErrs = append(allErrs, ValidateFailureToleranceTypeValue(spec.ControlPlane.HighAvailability.FailureTolerance.Type, fldPath.Child("controlPlane", "highAvailability", "failureTolerance", "type"))...)
+	}
+
+	if spec.Hibernation != nil {
+		allErrs = append(allErrs, ValidateHibernationSchedules(spec.Hibernation.Schedules, fldPath.Child("hibernation", "schedules"))...)
+	}
+
+	return allErrs
+}
@@ -0,0 +1,162 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	. "github.com/gardener/gardener/pkg/apis/core/validation"
+)
+
+var _ = Describe("ShootClass Validation Tests", func() {
+	var shootClass *core.ShootClass
+
+	BeforeEach(func() {
+		maxSurge := intstr.FromInt32(1)
+		maxUnavailable := intstr.FromInt32(0)
+
+		shootClass = &core.ShootClass{
+			ObjectMeta: metav1.ObjectMeta{Name: "small"},
+			Spec: core.ShootClassSpec{
+				ProviderType: ptr.To("aws"),
+				Workers: []core.Worker{{
+					Name: "worker",
+					Machine: core.Machine{
+						Type:         "m5.large",
+						Image:        &core.ShootMachineImage{Name: "gardenlinux"},
+						Architecture: ptr.To("amd64"),
+					},
+					Minimum:          1,
+					Maximum:          3,
+					MaxSurge:         &maxSurge,
+					MaxUnavailable:   &maxUnavailable,
+					SystemComponents: &core.WorkerSystemComponents{Allow: true},
+				}},
+				ControlPlane: &core.ControlPlane{
+					HighAvailability: &core.HighAvailability{FailureTolerance: core.FailureTolerance{Type: core.FailureToleranceTypeZone}},
+				},
+				Hibernation: &core.Hibernation{
+					Schedules: []core.HibernationSchedule{{Start: ptr.To("0 20 * * *"), End: ptr.To("0 6 * * *")}},
+				},
+			},
+		}
+	})
+
+	Describe("#ValidateShootClass", func() {
+		It("should pass as shoot class is valid", func() {
+			Expect(ValidateShootClass(shootClass)).To(BeEmpty())
+		})
+
+		It("should pass as shoot class is empty", func() {
+			shootClass.Spec = core.ShootClassSpec{}
+
+			Expect(ValidateShootClass(shootClass)).To(BeEmpty())
+		})
+
+		It("should forbid invalid metadata", func() {
+			shootClass.Name = "Small"
+
+			Expect(ValidateShootClass(shootClass)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("metadata.name"),
+			}))))
+		})
+
+		It("should forbid an empty provider type", func() {
+			shootClass.Spec.ProviderType = ptr.To("")
+
+			Expect(ValidateShootClass(shootClass)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.providerType"),
+			}))))
+		})
+
+		It("should require the provider type if workers are specified", func() {
+			shootClass.Spec.ProviderType = nil
+
+			Expect(ValidateShootClass(shootClass)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.providerType"),
+			}))))
+		})
+
+		It("should forbid invalid workers", func() {
+			shootClass.Spec.Workers[0].Machine.Type = ""
+			shootClass.Spec.Workers = append(shootClass.Spec.Workers, shootClass.Spec.Workers[0])
+
+			Expect(ValidateShootClass(shootClass)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.workers[0].machine.type"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.workers[1].machine.type"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("spec.workers[1].name"),
+				})),
+			))
+		})
+
+		It("should forbid pinning the Kubernetes version of workers", func() {
+			shootClass.Spec.Workers[0].Kubernetes = &core.WorkerKubernetes{Version: ptr.To("1.30.0")}
+
+			Expect(ValidateShootClass(shootClass)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("spec.workers[0].kubernetes.version"),
+			}))))
+		})
+
+		It("should forbid an invalid failure tolerance type", func() {
+			shootClass.Spec.ControlPlane.HighAvailability.FailureTolerance.Type = "foo"
+
+			Expect(ValidateShootClass(shootClass)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("spec.controlPlane.highAvailability.failureTolerance.type"),
+			}))))
+		})
+
+		It("should forbid invalid hibernation schedules", func() {
+			shootClass.Spec.Hibernation.Schedules[0].Start = ptr.To("foo")
+
+			Expect(ValidateShootClass(shootClass)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.hibernation.schedules[0].start"),
+			}))))
+		})
+	})
+
+	Describe("#ValidateShootClassUpdate", func() {
+		It("should allow changing the spec", func() {
+			newShootClass := shootClass.DeepCopy()
+			newShootClass.ResourceVersion = "1"
+			shootClass.ResourceVersion = "1"
+			newShootClass.Spec.Workers[0].Maximum = 5
+
+			Expect(ValidateShootClassUpdate(newShootClass, shootClass)).To(BeEmpty())
+		})
+
+		It("should validate the new spec", func() {
+			newShootClass := shootClass.DeepCopy()
+			newShootClass.ResourceVersion = "1"
+			shootClass.ResourceVersion = "1"
+			newShootClass.Spec.ProviderType = nil
+
+			Expect(ValidateShootClassUpdate(newShootClass, shootClass)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.providerType"),
+			}))))
+		})
+	})
+})
@@ -297,6 +297,34 @@ func validateMachineTypes(machineTypes []core.MachineType, fldPath *field.Path)
 		if machineType.Storage != nil {
 			allErrs = append(allErrs, validateMachineTypeStorage(*machineType.Storage, idxPath.Child("storage"))...)
 		}
+
+		allErrs = append(allErrs, validateMachineTypeCapacityTypes(machineType.CapacityTypes, idxPath.Child("capacityTypes"))...)
+		allErrs = append(allErrs, validateTypeRegionAvailability(machineType.Regions, idxPath.Child("regions"))...)
+	}
+
+	return allErrs
+}
+
+var availableMachineTypeCapacityTypes = sets.New(
+	string(core.CapacityTypeOnDemand),
+	string(core.CapacityTypeSpot),
+)
+
+// validateMachineTypeCapacityTypes validates the capacity types supported by a machine type.
+func validateMachineTypeCapacityTypes(capacityTypes []core.CapacityType, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs = field.ErrorList{}
+		seen    = sets.New[core.CapacityType]()
+	)
+
+	for i, capacityType := range capacityTypes {
+		if !availableMachineTypeCapacityTypes.Has(string(capacityType)) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Index(i), capacityType, sets.List(availableMachineTypeCapacityTypes)))
+		}
+		if seen.Has(capacityType) {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Index(i), capacityType))
+		}
+		seen.Insert(capacityType)
 	}
 
 	return allErrs
@@ -329,6 +357,53 @@ func validateVolumeTypes(volumeTypes []core.VolumeType, fldPath *field.Path) fie
 		if volumeType.MinSize != nil {
 			allErrs = append(allErrs, kubernetescorevalidation.ValidateResourceQuantityValue("minSize", *volumeType.MinSize, idxPath.Child("minSize"))...)
 		}
+
+		if volumeType.MaxIOPS != nil {
+			allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(*volumeType.MaxIOPS, idxPath.Child("maxIOPS"))...)
+		}
+
+		if volumeType.MaxThroughput != nil {
+			allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(*volumeType.MaxThroughput, idxPath.Child("maxThroughput"))...)
+		}
+
+		allErrs = append(allErrs, validateTypeRegionAvailability(volumeType.Regions, idxPath.Child("regions"))...)
+	}
+
+	return allErrs
+}
+
+// validateTypeRegionAvailability validates the regions and zones in which a machine or volume type is available.
+func validateTypeRegionAvailability(regions []core.TypeRegionAvailability, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs     = field.ErrorList{}
+		regionNames = sets.New[string]()
+	)
+
+	for i, region := range regions {
+		idxPath := fldPath.Index(i)
+		namePath := idxPath.Child("name")
+
+		if len(region.Name) == 0 {
+			allErrs = append(allErrs, field.Required(namePath, "must provide a region name"))
+		} else if regionNames.Has(region.Name) {
+			allErrs = append(allErrs, field.Duplicate(namePath, region.Name))
+		}
+		regionNames.Insert(region.Name)
+
+		zoneNames := sets.New[string]()
+		for j, zone := range region.Zones {
+			zonePath := idxPath.Child("zones").Index(j)
+			if len(zone) == 0 {
+				allErrs = append(allErrs, field.Required(zonePath, "must provide a zone name"))
+			} else if zoneNames.Has(zone) {
+				allErrs = append(allErrs, field.Duplicate(zonePath, zone))
+			}
+			zoneNames.Insert(zone)
+		}
+
+		if region.PricingClass != nil && len(*region.PricingClass) == 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("pricingClass"), *region.PricingClass, "must not be empty if set"))
+		}
 	}
 
 	return allErrs
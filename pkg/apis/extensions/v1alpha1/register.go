@@ -35,6 +35,8 @@ var (
 // Adds the list of known types to Scheme.
 func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
+		&AuditSink{},
+		&AuditSinkList{},
 		&BackupBucket{},
 		&BackupBucketList{},
 		&BackupEntry{},
@@ -0,0 +1,80 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ Object = (*AuditSink)(nil)
+
+// AuditSinkResource is a constant for the name of the AuditSink resource.
+const AuditSinkResource = "AuditSink"
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:scope=Namespaced,path=auditsinks,singular=auditsink
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name=Type,JSONPath=".spec.type",type=string,description="The type of the audit sink provider for this resource."
+// +kubebuilder:printcolumn:name=Status,JSONPath=".status.lastOperation.state",type=string,description="status of the last operation, one of Aborted, Processing, Succeeded, Error, Failed"
+// +kubebuilder:printcolumn:name=Age,JSONPath=".metadata.creationTimestamp",type=date,description="creation timestamp"
+
+// AuditSink is a specification for the delivery of the audit events of a shoot's kube-apiserver to a managed service
+// of a provider (e.g., AWS CloudWatch or Google Cloud Logging).
+type AuditSink struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	// Specification of the AuditSink.
+	// If the object's deletion timestamp is set, this field is immutable.
+	Spec AuditSinkSpec `json:"spec"`
+	// +optional
+	Status AuditSinkStatus `json:"status"`
+}
+
+// GetExtensionSpec implements Object.
+func (a *AuditSink) GetExtensionSpec() Spec {
+	return &a.Spec
+}
+
+// GetExtensionStatus implements Object.
+func (a *AuditSink) GetExtensionStatus() Status {
+	return &a.Status
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AuditSinkList is a list of AuditSink resources.
+type AuditSinkList struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// Items is the list of AuditSinks.
+	Items []AuditSink `json:"items"`
+}
+
+// AuditSinkSpec is the spec for an AuditSink resource.
+type AuditSinkSpec struct {
+	// DefaultSpec is a structure containing common fields used by all extension resources.
+	DefaultSpec `json:",inline"`
+}
+
+// AuditSinkStatus is the status for an AuditSink resource.
+type AuditSinkStatus struct {
+	// DefaultStatus is a structure containing common fields used by all extension resources.
+	DefaultStatus `json:",inline"`
+	// WebhookKubeconfigSecretRef is a reference to a secret in the namespace of the AuditSink resource which contains
+	// the kubeconfig (data key 'kubeconfig') of the audit webhook backend the kube-apiserver sends its audit events to.
+	// The extension is responsible for delivering the received audit events to the managed service.
+	// +optional
+	WebhookKubeconfigSecretRef *corev1.LocalObjectReference `json:"webhookKubeconfigSecretRef,omitempty"`
+}
+
+// GetExtensionType returns the type of this AuditSink resource.
+func (a *AuditSink) GetExtensionType() string {
+	return a.Spec.Type
+}
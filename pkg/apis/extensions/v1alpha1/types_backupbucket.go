@@ -66,8 +66,34 @@ type BackupBucketSpec struct {
 	Region string `json:"region"`
 	// SecretRef is a reference to a secret that contains the credentials to access object store.
 	SecretRef corev1.SecretReference `json:"secretRef"`
+	// Immutability contains the immutability (object lock) configuration of the bucket. If set, the extension must
+	// protect objects stored in the bucket against deletion and overwrites for the configured retention period.
+	// +optional
+	Immutability *BackupBucketImmutability `json:"immutability,omitempty"`
+}
+
+// BackupBucketImmutability contains the immutability (object lock) configuration of a backup bucket.
+type BackupBucketImmutability struct {
+	// RetentionMode is the retention mode of the objects in the bucket. Possible values are `Governance` and
+	// `Compliance`.
+	RetentionMode BackupBucketRetentionMode `json:"retentionMode"`
+	// RetentionPeriod is the duration for which objects in the bucket are protected against deletion and overwrites
+	// after they have been written.
+	RetentionPeriod metav1.Duration `json:"retentionPeriod"`
 }
 
+// BackupBucketRetentionMode is a type alias for string.
+type BackupBucketRetentionMode string
+
+const (
+	// BackupBucketRetentionModeGovernance is a constant for the governance retention mode. Protected objects can still
+	// be deleted and the retention settings can still be relaxed by principals with special permissions.
+	BackupBucketRetentionModeGovernance BackupBucketRetentionMode = "Governance"
+	// BackupBucketRetentionModeCompliance is a constant for the compliance retention mode. Protected objects cannot be
+	// deleted by anyone before their retention period has expired and the retention settings can only be tightened.
+	BackupBucketRetentionModeCompliance BackupBucketRetentionMode = "Compliance"
+)
+
 // BackupBucketStatus is the status for an BackupBucket resource.
 type BackupBucketStatus struct {
 	// DefaultStatus is a structure containing common fields used by all extension resources.
@@ -57,6 +57,11 @@ type BastionSpec struct {
 	UserData []byte `json:"userData"`
 	// Ingress controls from where the created bastion host should be reachable.
 	Ingress []BastionIngressPolicy `json:"ingress"`
+	// Ports are additional TCP ports of the worker nodes which must be reachable from the bastion host, in addition to
+	// the SSH port. They are used for tunnels through the SSH connection to the bastion host, e.g., for RDP connections
+	// to Windows nodes.
+	// +optional
+	Ports []int32 `json:"ports,omitempty"`
 }
 
 // BastionIngressPolicy represents an ingress policy for SSH bastion hosts.
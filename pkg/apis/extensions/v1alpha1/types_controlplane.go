@@ -79,12 +79,20 @@ type ControlPlaneSpec struct {
 	Region string `json:"region"`
 	// SecretRef is a reference to a secret that contains the cloud provider specific credentials.
 	SecretRef corev1.SecretReference `json:"secretRef"`
+	// DedicatedEgress specifies whether the egress traffic of the control plane components in the shoot namespace shall
+	// leave the seed via a source IP dedicated to this shoot.
+	// +optional
+	DedicatedEgress bool `json:"dedicatedEgress,omitempty"`
 }
 
 // ControlPlaneStatus is the status of a ControlPlane resource.
 type ControlPlaneStatus struct {
 	// DefaultStatus is a structure containing common fields used by all extension resources.
 	DefaultStatus `json:",inline"`
+	// EgressCIDRs is a list of CIDRs used by the control plane components as the source IP for egress traffic if a
+	// dedicated egress was requested via `.spec.dedicatedEgress`.
+	// +optional
+	EgressCIDRs []string `json:"egressCIDRs,omitempty"`
 }
 
 // Purpose is a string alias.
@@ -65,7 +65,8 @@ type NetworkSpec struct {
 	PodCIDR string `json:"podCIDR"`
 	// ServiceCIDR defines the CIDR that will be used for services. This field is immutable.
 	ServiceCIDR string `json:"serviceCIDR"`
-	// IPFamilies specifies the IP protocol versions to use for shoot networking. This field is immutable.
+	// IPFamilies specifies the IP protocol versions to use for shoot networking.
+	// The only supported change of this field is the migration from ["IPv4"] to ["IPv4", "IPv6"].
 	// See https://github.com/gardener/gardener/blob/master/docs/development/ipv6.md
 	// +optional
 	IPFamilies []IPFamily `json:"ipFamilies,omitempty"`
@@ -75,6 +76,52 @@ type NetworkSpec struct {
 type NetworkStatus struct {
 	// DefaultStatus is a structure containing common fields used by all extension resources.
 	DefaultStatus `json:",inline"`
+	// PodCIDRUtilization contains information about the utilization of the pod CIDR. It is only reported by network
+	// extensions which support it.
+	// +optional
+	PodCIDRUtilization *PodCIDRUtilization `json:"podCIDRUtilization,omitempty"`
+}
+
+// PodCIDRUtilization contains information about the utilization of the pod CIDR.
+type PodCIDRUtilization struct {
+	// IPAddressUtilization is the utilization of the whole pod CIDR.
+	IPAddressUtilization `json:",inline"`
+	// Zones contains the utilization of the pod CIDR per zone.
+	// +optional
+	Zones []PodCIDRZoneUtilization `json:"zones,omitempty"`
+	// Nodes contains the utilization of the pod CIDR ranges assigned to the nodes.
+	// +optional
+	Nodes []PodCIDRNodeUtilization `json:"nodes,omitempty"`
+	// LastUpdateTime is the last time the utilization was updated.
+	// +optional
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+}
+
+// PodCIDRZoneUtilization contains information about the utilization of the pod CIDR in a zone.
+type PodCIDRZoneUtilization struct {
+	// Name is the name of the zone.
+	Name string `json:"name"`
+	// IPAddressUtilization is the utilization of the pod CIDR in the zone.
+	IPAddressUtilization `json:",inline"`
+}
+
+// PodCIDRNodeUtilization contains information about the utilization of the pod CIDR range assigned to a node.
+type PodCIDRNodeUtilization struct {
+	// Name is the name of the node.
+	Name string `json:"name"`
+	// Zone is the zone of the node.
+	// +optional
+	Zone *string `json:"zone,omitempty"`
+	// IPAddressUtilization is the utilization of the pod CIDR range of the node.
+	IPAddressUtilization `json:",inline"`
+}
+
+// IPAddressUtilization contains the number of available and allocated IP addresses.
+type IPAddressUtilization struct {
+	// Capacity is the number of IP addresses which can be allocated to pods.
+	Capacity int64 `json:"capacity"`
+	// Allocated is the number of IP addresses which are allocated to pods or reserved for nodes.
+	Allocated int64 `json:"allocated"`
 }
 
 // GetExtensionType returns the type of this Network resource.
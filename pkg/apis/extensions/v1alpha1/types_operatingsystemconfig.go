@@ -85,6 +85,13 @@ type OperatingSystemConfigSpec struct {
 	// +patchStrategy=merge
 	// +optional
 	Files []File `json:"files,omitempty" patchStrategy:"merge" patchMergeKey:"path"`
+	// OSFamily is the operating system family of the machines this configuration is rendered for. Possible values are
+	// "linux" and "windows". For "windows", Gardener does not render any systemd units. Instead, the files contain
+	// the bootstrap data (see constants in `pkg/component/extensions/operatingsystemconfig/windows`) which must be
+	// translated by the extension into a PowerShell script executed by cloudbase-init.
+	// Defaults to "linux" if not set. This field is immutable.
+	// +optional
+	OSFamily *string `json:"osFamily,omitempty"`
 }
 
 // Unit is a unit for the operating system configuration (usually, a systemd unit).
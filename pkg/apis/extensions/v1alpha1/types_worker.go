@@ -161,11 +161,41 @@ type WorkerPool struct {
 	// Architecture is the CPU architecture of the worker pool machines and machine image.
 	// +optional
 	Architecture *string `json:"architecture,omitempty"`
+	// OSFamily is the operating system family of the worker pool machines and machine image. Possible values are
+	// "linux" and "windows".
+	// +optional
+	OSFamily *string `json:"osFamily,omitempty"`
 	// ClusterAutoscaler contains the cluster autoscaler configurations for the worker pool.
 	// +optional
 	ClusterAutoscaler *ClusterAutoscalerOptions `json:"clusterAutoscaler,omitempty"`
+	// CapacityType is the type of capacity used for the machines of this worker pool. If it is `SpotWithFallback`, the
+	// provider is expected to create machine deployments for both spot and on-demand capacity and to report a higher
+	// priority for the spot machine deployments in the status.
+	// +optional
+	CapacityType *gardencorev1beta1.CapacityType `json:"capacityType,omitempty"`
+	// UpdateStrategy is the strategy used for updating the machine image and the Kubernetes version of the machines of
+	// this worker pool. Defaults to `RollingUpdate`.
+	// +optional
+	UpdateStrategy *UpdateStrategy `json:"updateStrategy,omitempty"`
+	// RolloutStrategy contains settings for rolling out the machines of this worker pool relative to the other worker
+	// pools. Machine deployments of worker pools with a lower order are expected to be rolled out before those of worker
+	// pools with a higher order.
+	// +optional
+	RolloutStrategy *gardencorev1beta1.WorkerRolloutStrategy `json:"rolloutStrategy,omitempty"`
 }
 
+// UpdateStrategy is the update strategy of a worker pool.
+type UpdateStrategy string
+
+const (
+	// UpdateStrategyRollingUpdate indicates that machines are replaced by new machines when the machine image or the
+	// Kubernetes version of the worker pool changes.
+	UpdateStrategyRollingUpdate UpdateStrategy = "RollingUpdate"
+	// UpdateStrategyInPlace indicates that changes of the machine image or the Kubernetes version of the worker pool are
+	// applied to the existing machines by the gardener-node-agent.
+	UpdateStrategyInPlace UpdateStrategy = "InPlace"
+)
+
 // ClusterAutoscalerOptions contains the cluster autoscaler configurations for a worker pool.
 type ClusterAutoscalerOptions struct {
 	// ScaleDownUtilizationThreshold defines the threshold in fraction (0.0 - 1.0) under which a node is being removed.
@@ -214,6 +244,12 @@ type Volume struct {
 	// Encrypted determines if the volume should be encrypted.
 	// +optional
 	Encrypted *bool `json:"encrypted,omitempty"`
+	// IOPS is the number of provisioned I/O operations per second of the volume.
+	// +optional
+	IOPS *int64 `json:"iops,omitempty"`
+	// Throughput is the provisioned throughput of the volume in MiB/s.
+	// +optional
+	Throughput *int64 `json:"throughput,omitempty"`
 }
 
 // DataVolume contains information about a data volume.
@@ -228,6 +264,12 @@ type DataVolume struct {
 	// Encrypted determines if the volume should be encrypted.
 	// +optional
 	Encrypted *bool `json:"encrypted,omitempty"`
+	// IOPS is the number of provisioned I/O operations per second of the volume.
+	// +optional
+	IOPS *int64 `json:"iops,omitempty"`
+	// Throughput is the provisioned throughput of the volume in MiB/s.
+	// +optional
+	Throughput *int64 `json:"throughput,omitempty"`
 }
 
 // WorkerStatus is the status for a Worker resource.
@@ -242,6 +284,35 @@ type WorkerStatus struct {
 	// MachineDeploymentsLastUpdateTime is the timestamp when the status.MachineDeployments slice was last updated.
 	// +optional
 	MachineDeploymentsLastUpdateTime *metav1.Time `json:"machineDeploymentsLastUpdateTime,omitempty"`
+	// InPlaceUpdates contains the progress of in-place updates of worker pools with the `InPlace` update strategy.
+	// +optional
+	InPlaceUpdates []InPlaceUpdateStatus `json:"inPlaceUpdates,omitempty"`
+	// NodeBootstrap contains information about nodes which are stuck in their bootstrap per worker pool.
+	// +optional
+	NodeBootstrap []NodeBootstrapStatus `json:"nodeBootstrap,omitempty"`
+}
+
+// NodeBootstrapStatus contains information about nodes of a worker pool which are stuck in their bootstrap.
+type NodeBootstrapStatus struct {
+	// PoolName is the name of the worker pool.
+	PoolName string `json:"poolName"`
+	// NodesStuckInBootstrap is the number of nodes which have not completed their bootstrap within the bootstrap
+	// timeout.
+	NodesStuckInBootstrap int32 `json:"nodesStuckInBootstrap"`
+	// NodesReplaced is the number of nodes stuck in their bootstrap whose machines were deleted during the last
+	// reconciliation in order to replace them.
+	NodesReplaced int32 `json:"nodesReplaced"`
+}
+
+// InPlaceUpdateStatus contains the progress of the in-place update of a worker pool.
+type InPlaceUpdateStatus struct {
+	// PoolName is the name of the worker pool.
+	PoolName string `json:"poolName"`
+	// Hash is the hash of the desired machine image and Kubernetes version of the worker pool.
+	Hash string `json:"hash"`
+	// NodesPendingUpdate is the list of names of nodes which have not yet applied the desired hash.
+	// +optional
+	NodesPendingUpdate []string `json:"nodesPendingUpdate,omitempty"`
 }
 
 // MachineDeployment is a created machine deployment.
@@ -252,4 +323,9 @@ type MachineDeployment struct {
 	Minimum int32 `json:"minimum"`
 	// Maximum is the maximum number for this machine deployment.
 	Maximum int32 `json:"maximum"`
+	// Priority is the priority of this machine deployment used by the cluster-autoscaler when deciding which machine
+	// deployment to scale up. Machine deployments with higher priorities are preferred. If no machine deployment has a
+	// priority, the expander configured in the shoot specification is used exclusively.
+	// +optional
+	Priority *int32 `json:"priority,omitempty"`
 }
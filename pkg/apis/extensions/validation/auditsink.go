@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"strings"
+
+	"github.com/go-test/deep"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+// ValidateAuditSink validates an AuditSink object.
+func ValidateAuditSink(sink *extensionsv1alpha1.AuditSink) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMeta(&sink.ObjectMeta, true, apivalidation.NameIsDNSSubdomain, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, ValidateAuditSinkSpec(&sink.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+// ValidateAuditSinkUpdate validates an AuditSink object before an update.
+func ValidateAuditSinkUpdate(new, old *extensionsv1alpha1.AuditSink) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaUpdate(&new.ObjectMeta, &old.ObjectMeta, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, ValidateAuditSinkSpecUpdate(&new.Spec, &old.Spec, new.DeletionTimestamp != nil, field.NewPath("spec"))...)
+	allErrs = append(allErrs, ValidateAuditSink(new)...)
+
+	return allErrs
+}
+
+// ValidateAuditSinkSpec validates the specification of an AuditSink object.
+func ValidateAuditSinkSpec(spec *extensionsv1alpha1.AuditSinkSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(spec.Type) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("type"), "field is required"))
+	}
+
+	return allErrs
+}
+
+// ValidateAuditSinkSpecUpdate validates the spec of an AuditSink object before an update.
+func ValidateAuditSinkSpecUpdate(new, old *extensionsv1alpha1.AuditSinkSpec, deletionTimestampSet bool, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if deletionTimestampSet && !apiequality.Semantic.DeepEqual(new, old) {
+		if diff := deep.Equal(new, old); diff != nil {
+			return field.ErrorList{field.Forbidden(fldPath, strings.Join(diff, ","))}
+		}
+		return apivalidation.ValidateImmutableField(new, old, fldPath)
+	}
+
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(new.Type, old.Type, fldPath.Child("type"))...)
+
+	return allErrs
+}
@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/gardener/gardener/pkg/apis/extensions/validation"
+)
+
+var _ = Describe("AuditSink validation tests", func() {
+	var sink *extensionsv1alpha1.AuditSink
+
+	BeforeEach(func() {
+		sink = &extensionsv1alpha1.AuditSink{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-sink",
+				Namespace: "shoot-namespace-seed",
+			},
+			Spec: extensionsv1alpha1.AuditSinkSpec{
+				DefaultSpec: extensionsv1alpha1.DefaultSpec{
+					Type:           "provider",
+					ProviderConfig: &runtime.RawExtension{},
+				},
+			},
+		}
+	})
+
+	Describe("#ValidAuditSink", func() {
+		It("should forbid empty AuditSink resources", func() {
+			errorList := ValidateAuditSink(&extensionsv1alpha1.AuditSink{})
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("metadata.name"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("metadata.namespace"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.type"),
+			}))))
+		})
+
+		It("should allow valid AuditSink resources", func() {
+			errorList := ValidateAuditSink(sink)
+
+			Expect(errorList).To(BeEmpty())
+		})
+	})
+
+	Describe("#ValidAuditSinkUpdate", func() {
+		It("should prevent updating anything if deletion time stamp is set", func() {
+			now := metav1.Now()
+			sink.DeletionTimestamp = &now
+			newSink := prepareAuditSinkForUpdate(sink)
+			newSink.Spec.ProviderConfig = nil
+
+			errorList := ValidateAuditSinkUpdate(newSink, sink)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("spec"),
+			}))))
+		})
+
+		It("should prevent updating the type", func() {
+			newSink := prepareAuditSinkForUpdate(sink)
+			newSink.Spec.Type = "changed-type"
+
+			errorList := ValidateAuditSinkUpdate(newSink, sink)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.type"),
+			}))))
+		})
+
+		It("should allow updating the provider config", func() {
+			newSink := prepareAuditSinkForUpdate(sink)
+			newSink.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{"foo":"bar"}`)}
+
+			errorList := ValidateAuditSinkUpdate(newSink, sink)
+
+			Expect(errorList).To(BeEmpty())
+		})
+	})
+})
+
+func prepareAuditSinkForUpdate(obj *extensionsv1alpha1.AuditSink) *extensionsv1alpha1.AuditSink {
+	newObj := obj.DeepCopy()
+	newObj.ResourceVersion = "1"
+	return newObj
+}
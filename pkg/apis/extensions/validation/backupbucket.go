@@ -10,11 +10,17 @@ import (
 	"github.com/go-test/deep"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 )
 
+var availableBackupBucketRetentionModes = sets.New(
+	string(extensionsv1alpha1.BackupBucketRetentionModeGovernance),
+	string(extensionsv1alpha1.BackupBucketRetentionModeCompliance),
+)
+
 // ValidateBackupBucket validates a BackupBucket object.
 func ValidateBackupBucket(bb *extensionsv1alpha1.BackupBucket) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -51,6 +57,16 @@ func ValidateBackupBucketSpec(spec *extensionsv1alpha1.BackupBucketSpec, fldPath
 		allErrs = append(allErrs, field.Required(fldPath.Child("secretRef", "name"), "field is required"))
 	}
 
+	if immutability := spec.Immutability; immutability != nil {
+		if !availableBackupBucketRetentionModes.Has(string(immutability.RetentionMode)) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("immutability", "retentionMode"), immutability.RetentionMode, sets.List(availableBackupBucketRetentionModes)))
+		}
+
+		if immutability.RetentionPeriod.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("immutability", "retentionPeriod"), immutability.RetentionPeriod.Duration.String(), "must be positive"))
+		}
+	}
+
 	return allErrs
 }
 
@@ -5,6 +5,8 @@
 package validation_test
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
@@ -60,6 +62,30 @@ var _ = Describe("BackupBucket validation tests", func() {
 
 			Expect(errorList).To(BeEmpty())
 		})
+
+		It("should forbid an invalid immutability configuration", func() {
+			bb.Spec.Immutability = &extensionsv1alpha1.BackupBucketImmutability{
+				RetentionMode:   "Locked",
+				RetentionPeriod: metav1.Duration{Duration: -time.Hour},
+			}
+
+			Expect(ValidateBackupBucket(bb)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("spec.immutability.retentionMode"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.immutability.retentionPeriod"),
+			}))))
+		})
+
+		It("should allow a valid immutability configuration", func() {
+			bb.Spec.Immutability = &extensionsv1alpha1.BackupBucketImmutability{
+				RetentionMode:   extensionsv1alpha1.BackupBucketRetentionModeGovernance,
+				RetentionPeriod: metav1.Duration{Duration: 24 * time.Hour},
+			}
+
+			Expect(ValidateBackupBucket(bb)).To(BeEmpty())
+		})
 	})
 
 	Describe("#ValidBackupBucketUpdate", func() {
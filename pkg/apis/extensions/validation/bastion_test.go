@@ -68,6 +68,15 @@ var _ = Describe("Bastion validation tests", func() {
 
 			Expect(errorList).To(BeEmpty())
 		})
+
+		It("should forbid invalid ports", func() {
+			bastion.Spec.Ports = []int32{3389, 0}
+
+			Expect(ValidateBastion(bastion)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.ports[1]"),
+			}))))
+		})
 	})
 
 	Describe("#ValidBastionUpdate", func() {
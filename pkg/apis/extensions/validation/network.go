@@ -99,13 +99,21 @@ func ValidateNetworkSpecUpdate(new, old *extensionsv1alpha1.NetworkSpec, deletio
 	// allow upgrades from empty IPFamilies to the default of IPv4
 	// the if condition can be removed once the network extension of all shoots have been updated
 	// TODO: Remove in Gardener 1.87
-	if !(old.IPFamilies == nil && slices.Equal(new.IPFamilies, []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4})) {
+	if !(old.IPFamilies == nil && slices.Equal(new.IPFamilies, []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4})) &&
+		!isIPv4ToDualStackMigration(old.IPFamilies, new.IPFamilies) {
 		allErrs = append(allErrs, apivalidation.ValidateImmutableField(new.IPFamilies, old.IPFamilies, fldPath.Child("ipFamilies"))...)
 	}
 
 	return allErrs
 }
 
+// isIPv4ToDualStackMigration returns true if the IP families are changed from IPv4 single-stack to dual-stack networking
+// with IPv4 as primary IP family.
+func isIPv4ToDualStackMigration(oldIPFamilies, newIPFamilies []extensionsv1alpha1.IPFamily) bool {
+	return (len(oldIPFamilies) == 0 || slices.Equal(oldIPFamilies, []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4})) &&
+		slices.Equal(newIPFamilies, []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4, extensionsv1alpha1.IPFamilyIPv6})
+}
+
 var availableIPFamilies = sets.New(
 	string(extensionsv1alpha1.IPFamilyIPv4),
 	string(extensionsv1alpha1.IPFamilyIPv6),
@@ -245,6 +245,14 @@ var _ = Describe("Network validation tests", func() {
 			}))))
 		})
 
+		It("should allow migrating the ipFamilies from IPv4 single-stack to dual-stack", func() {
+			network.Spec.IPFamilies = []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4}
+			newNetwork := prepareNetworkForUpdate(network)
+			newNetwork.Spec.IPFamilies = []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4, extensionsv1alpha1.IPFamilyIPv6}
+
+			Expect(ValidateNetworkUpdate(newNetwork, network)).To(BeEmpty())
+		})
+
 		It("should allow updating the provider config", func() {
 			newNetwork := prepareNetworkForUpdate(network)
 			newNetwork.Spec.ProviderConfig = nil
@@ -20,6 +20,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
 
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 )
 
@@ -67,6 +68,11 @@ func ValidateOperatingSystemConfigSpec(spec *extensionsv1alpha1.OperatingSystemC
 		}
 	}
 
+	allErrs = append(allErrs, validateOSFamily(spec.OSFamily, fldPath.Child("osFamily"))...)
+	if ptr.Deref(spec.OSFamily, v1beta1constants.OSFamilyLinux) == v1beta1constants.OSFamilyWindows && len(spec.Units) > 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("units"), "units are not supported for the windows operating system family"))
+	}
+
 	allErrs = append(allErrs, ValidateCRIConfig(spec.CRIConfig, spec.Purpose, fldPath.Child("criConfig"))...)
 	allErrs = append(allErrs, ValidateUnits(spec.Units, pathsFromFiles, fldPath.Child("units"))...)
 	allErrs = append(allErrs, ValidateFiles(spec.Files, fldPath.Child("files"))...)
@@ -367,6 +373,7 @@ func ValidateOperatingSystemConfigSpecUpdate(new, old *extensionsv1alpha1.Operat
 
 	allErrs = append(allErrs, apivalidation.ValidateImmutableField(new.Type, old.Type, fldPath.Child("type"))...)
 	allErrs = append(allErrs, apivalidation.ValidateImmutableField(new.Purpose, old.Purpose, fldPath.Child("purpose"))...)
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(new.OSFamily, old.OSFamily, fldPath.Child("osFamily"))...)
 
 	return allErrs
 }
@@ -126,6 +126,31 @@ var _ = Describe("OperatingSystemConfig validation tests", func() {
 			}))))
 		})
 
+		It("should forbid OperatingSystemConfig resources with invalid operating system family", func() {
+			oscCopy := osc.DeepCopy()
+			oscCopy.Spec.OSFamily = ptr.To("foo")
+
+			errorList := ValidateOperatingSystemConfig(oscCopy)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("spec.osFamily"),
+			}))))
+		})
+
+		It("should forbid units for OperatingSystemConfig resources of the windows operating system family", func() {
+			oscCopy := osc.DeepCopy()
+			oscCopy.Spec.OSFamily = ptr.To("windows")
+			oscCopy.Spec.Units = []extensionsv1alpha1.Unit{{Name: "foo"}}
+
+			errorList := ValidateOperatingSystemConfig(oscCopy)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("spec.units"),
+			}))))
+		})
+
 		It("should forbid OperatingSystemConfig resources with invalid units", func() {
 			oscCopy := osc.DeepCopy()
 			oscCopy.Spec.Units = []extensionsv1alpha1.Unit{{
@@ -586,10 +611,11 @@ var _ = Describe("OperatingSystemConfig validation tests", func() {
 			}))))
 		})
 
-		It("should prevent updating the type and purpose", func() {
+		It("should prevent updating the type, purpose and operating system family", func() {
 			newOperatingSystemConfig := prepareOperatingSystemConfigForUpdate(osc)
 			newOperatingSystemConfig.Spec.Type = "changed-type"
 			newOperatingSystemConfig.Spec.Purpose = extensionsv1alpha1.OperatingSystemConfigPurposeProvision
+			newOperatingSystemConfig.Spec.OSFamily = ptr.To("linux")
 
 			errorList := ValidateOperatingSystemConfigUpdate(newOperatingSystemConfig, osc)
 
@@ -600,6 +626,9 @@ var _ = Describe("OperatingSystemConfig validation tests", func() {
 				})), PointTo(MatchFields(IgnoreExtras, Fields{
 					"Type":  Equal(field.ErrorTypeInvalid),
 					"Field": Equal("spec.purpose"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.osFamily"),
 				})),
 				PointTo(MatchFields(IgnoreExtras, Fields{
 					"Type":   Equal(field.ErrorTypeForbidden),
@@ -11,6 +11,7 @@ import (
 	"github.com/go-test/deep"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
@@ -18,6 +19,11 @@ import (
 	kubernetescorevalidation "github.com/gardener/gardener/pkg/utils/validation/kubernetes/core"
 )
 
+var availableUpdateStrategies = sets.New(
+	extensionsv1alpha1.UpdateStrategyRollingUpdate,
+	extensionsv1alpha1.UpdateStrategyInPlace,
+)
+
 // ValidateWorker validates a Worker object.
 func ValidateWorker(worker *extensionsv1alpha1.Worker) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -78,11 +84,16 @@ func ValidateWorkerPools(pools []extensionsv1alpha1.WorkerPool, fldPath *field.P
 		}
 
 		allErrs = append(allErrs, validateArchitecture(pool.Architecture, idxPath.Child("architecture"))...)
+		allErrs = append(allErrs, validateOSFamily(pool.OSFamily, idxPath.Child("osFamily"))...)
 
 		if len(pool.Name) == 0 {
 			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "field is required"))
 		}
 
+		if pool.UpdateStrategy != nil && !availableUpdateStrategies.Has(*pool.UpdateStrategy) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("updateStrategy"), *pool.UpdateStrategy, sets.List(availableUpdateStrategies)))
+		}
+
 		if pool.NodeTemplate != nil {
 			for resourceName, value := range pool.NodeTemplate.Capacity {
 				allErrs = append(allErrs, kubernetescorevalidation.ValidateResourceQuantityValue(string(resourceName), value, idxPath.Child("nodeTemplate", "capacity", string(resourceName)))...)
@@ -103,6 +114,16 @@ func validateArchitecture(arch *string, fldPath *field.Path) field.ErrorList {
 	return allErrs
 }
 
+func validateOSFamily(osFamily *string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if osFamily != nil && !slices.Contains(v1beta1constants.ValidOSFamilies, *osFamily) {
+		allErrs = append(allErrs, field.NotSupported(fldPath, *osFamily, v1beta1constants.ValidOSFamilies))
+	}
+
+	return allErrs
+}
+
 // ValidateWorkerSpecUpdate validates the spec of a Worker object before an update.
 func ValidateWorkerSpecUpdate(new, old *extensionsv1alpha1.WorkerSpec, deletionTimestampSet bool, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
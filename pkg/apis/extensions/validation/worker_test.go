@@ -80,6 +80,7 @@ var _ = Describe("Worker validation tests", func() {
 
 			workerCopy.Spec.Pools[0] = extensionsv1alpha1.WorkerPool{
 				Architecture: ptr.To("test"),
+				OSFamily:     ptr.To("test"),
 			}
 
 			workerCopy.Spec.Pools[0].NodeTemplate = &extensionsv1alpha1.NodeTemplate{
@@ -110,6 +111,9 @@ var _ = Describe("Worker validation tests", func() {
 			})), PointTo(MatchFields(IgnoreExtras, Fields{
 				"Type":  Equal(field.ErrorTypeNotSupported),
 				"Field": Equal("spec.pools[0].architecture"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("spec.pools[0].osFamily"),
 			}))))
 		})
 
@@ -118,6 +122,23 @@ var _ = Describe("Worker validation tests", func() {
 
 			Expect(errorList).To(BeEmpty())
 		})
+
+		It("should forbid unsupported update strategies", func() {
+			workerCopy := worker.DeepCopy()
+			workerCopy.Spec.Pools[0].UpdateStrategy = ptr.To(extensionsv1alpha1.UpdateStrategy("Recreate"))
+
+			Expect(ValidateWorker(workerCopy)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("spec.pools[0].updateStrategy"),
+			}))))
+		})
+
+		It("should allow the in-place update strategy", func() {
+			workerCopy := worker.DeepCopy()
+			workerCopy.Spec.Pools[0].UpdateStrategy = ptr.To(extensionsv1alpha1.UpdateStrategyInPlace)
+
+			Expect(ValidateWorker(workerCopy)).To(BeEmpty())
+		})
 	})
 
 	Describe("#ValidWorkerUpdate", func() {
@@ -50,8 +50,31 @@ type BastionSpec struct {
 	SSHPublicKey string
 	// Ingress controls from where the created bastion host should be reachable.
 	Ingress []BastionIngressPolicy
+	// Ports are additional ports of the shoot worker nodes which can be reached through the bastion, e.g., for RDP
+	// connections to Windows nodes or for database debugging tunnels. Connections to these ports are tunneled through
+	// the SSH connection to the bastion via port forwarding. This field is immutable.
+	Ports []BastionPort
 }
 
+// BastionPort is a port of the shoot worker nodes which can be reached through the bastion.
+type BastionPort struct {
+	// Protocol is the protocol spoken on the port. Possible values are "RDP" and "TCP".
+	Protocol BastionProtocol
+	// Port is the port number on the shoot worker nodes. Defaults to 3389 for the "RDP" protocol and must be set for
+	// the "TCP" protocol.
+	Port *int32
+}
+
+// BastionProtocol is a protocol which can be tunneled through the bastion.
+type BastionProtocol string
+
+const (
+	// BastionProtocolRDP is the protocol for remote desktop connections, e.g., to Windows nodes.
+	BastionProtocolRDP BastionProtocol = "RDP"
+	// BastionProtocolTCP is the protocol for generic TCP tunnels.
+	BastionProtocolTCP BastionProtocol = "TCP"
+)
+
 // BastionIngressPolicy represents an ingress policy for SSH bastion hosts.
 type BastionIngressPolicy struct {
 	// IPBlock defines an IP block that is allowed to access the bastion.
@@ -0,0 +1,98 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operations
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ShootOperationBatch applies an operation annotation to a label-selected set of shoots with rate limiting and tracks
+// the result for each shoot.
+type ShootOperationBatch struct {
+	metav1.TypeMeta
+	// Standard object metadata.
+	metav1.ObjectMeta
+	// Specification of the ShootOperationBatch.
+	Spec ShootOperationBatchSpec
+	// Most recently observed status of the ShootOperationBatch.
+	Status ShootOperationBatchStatus
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ShootOperationBatchList is a list of ShootOperationBatch objects.
+type ShootOperationBatchList struct {
+	metav1.TypeMeta
+	// Standard list object metadata.
+	metav1.ListMeta
+	// Items is the list of ShootOperationBatch.
+	Items []ShootOperationBatch
+}
+
+// ShootOperationBatchSpec is the specification of a ShootOperationBatch.
+type ShootOperationBatchSpec struct {
+	// Operation is the value of the `gardener.cloud/operation` annotation which is applied to the selected shoots.
+	// Supported values are `reconcile`, `retry`, and `rotate-observability-credentials`. This field is immutable.
+	Operation string
+	// ShootSelector selects the shoots in all namespaces the operation is applied to. This field is immutable.
+	ShootSelector metav1.LabelSelector
+	// ShootsPerMinute is the maximum number of shoots the operation is applied to per minute.
+	ShootsPerMinute *int32
+}
+
+// ShootOperationBatchStatus holds the most recently observed status of the ShootOperationBatch.
+type ShootOperationBatchStatus struct {
+	// Phase is the current phase of the ShootOperationBatch.
+	Phase ShootOperationBatchPhase
+	// Shoots contains the result of the operation for each selected shoot. The list of shoots is determined once when
+	// the ShootOperationBatch is processed for the first time.
+	Shoots []ShootOperationResult
+	// CompletionTime is the time when the operation was applied to all selected shoots.
+	CompletionTime *metav1.Time
+	// ObservedGeneration is the most recent generation observed for this ShootOperationBatch.
+	ObservedGeneration int64
+}
+
+// ShootOperationBatchPhase is the phase of a ShootOperationBatch.
+type ShootOperationBatchPhase string
+
+const (
+	// ShootOperationBatchProcessing indicates that the operation is still being applied to the selected shoots.
+	ShootOperationBatchProcessing ShootOperationBatchPhase = "Processing"
+	// ShootOperationBatchSucceeded indicates that the operation was applied to all selected shoots.
+	ShootOperationBatchSucceeded ShootOperationBatchPhase = "Succeeded"
+	// ShootOperationBatchFailed indicates that the operation could not be applied to at least one selected shoot.
+	ShootOperationBatchFailed ShootOperationBatchPhase = "Failed"
+)
+
+// ShootOperationResult is the result of applying the operation to a single shoot.
+type ShootOperationResult struct {
+	// Name is the name of the shoot.
+	Name string
+	// Namespace is the namespace of the shoot.
+	Namespace string
+	// State is the state of the operation for this shoot.
+	State ShootOperationState
+	// Message contains details about the result, e.g. why the operation could not be applied.
+	Message *string
+	// LastUpdateTime is the time when the state was last updated.
+	LastUpdateTime *metav1.Time
+}
+
+// ShootOperationState is the state of the operation for a single shoot.
+type ShootOperationState string
+
+const (
+	// ShootOperationPending indicates that the operation has not yet been applied to the shoot.
+	ShootOperationPending ShootOperationState = "Pending"
+	// ShootOperationApplied indicates that the operation annotation was applied to the shoot.
+	ShootOperationApplied ShootOperationState = "Applied"
+	// ShootOperationFailed indicates that the operation annotation could not be applied to the shoot.
+	ShootOperationFailed ShootOperationState = "Failed"
+)
@@ -6,8 +6,23 @@ package v1alpha1
 
 import (
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
 )
 
 func addDefaultingFuncs(scheme *runtime.Scheme) error {
 	return RegisterDefaults(scheme)
 }
+
+// SetDefaults_BastionPort sets default values for BastionPort objects.
+func SetDefaults_BastionPort(obj *BastionPort) {
+	if obj.Port == nil && obj.Protocol == BastionProtocolRDP {
+		obj.Port = ptr.To[int32](3389)
+	}
+}
+
+// SetDefaults_ShootOperationBatchSpec sets default values for ShootOperationBatchSpec objects.
+func SetDefaults_ShootOperationBatchSpec(obj *ShootOperationBatchSpec) {
+	if obj.ShootsPerMinute == nil {
+		obj.ShootsPerMinute = ptr.To[int32](10)
+	}
+}
@@ -0,0 +1,41 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	. "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
+)
+
+var _ = Describe("Defaults", func() {
+	Describe("#SetObjectDefaults_Bastion", func() {
+		var obj *Bastion
+
+		BeforeEach(func() {
+			obj = &Bastion{
+				Spec: BastionSpec{
+					Ports: []BastionPort{
+						{Protocol: BastionProtocolRDP},
+						{Protocol: BastionProtocolRDP, Port: ptr.To[int32](13389)},
+						{Protocol: BastionProtocolTCP},
+					},
+				},
+			}
+		})
+
+		It("should default the port of the RDP protocol only", func() {
+			SetObjectDefaults_Bastion(obj)
+
+			Expect(obj.Spec.Ports).To(Equal([]BastionPort{
+				{Protocol: BastionProtocolRDP, Port: ptr.To[int32](3389)},
+				{Protocol: BastionProtocolRDP, Port: ptr.To[int32](13389)},
+				{Protocol: BastionProtocolTCP},
+			}))
+		})
+	})
+})
@@ -0,0 +1,37 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	. "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
+)
+
+var _ = Describe("Defaults", func() {
+	Describe("#SetObjectDefaults_ShootOperationBatch", func() {
+		var obj *ShootOperationBatch
+
+		BeforeEach(func() {
+			obj = &ShootOperationBatch{}
+		})
+
+		It("should default the shoots per minute", func() {
+			SetObjectDefaults_ShootOperationBatch(obj)
+
+			Expect(obj.Spec.ShootsPerMinute).To(Equal(ptr.To[int32](10)))
+		})
+
+		It("should not overwrite the shoots per minute", func() {
+			obj.Spec.ShootsPerMinute = ptr.To[int32](1)
+
+			SetObjectDefaults_ShootOperationBatch(obj)
+
+			Expect(obj.Spec.ShootsPerMinute).To(Equal(ptr.To[int32](1)))
+		})
+	})
+})
@@ -39,6 +39,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
 		&Bastion{},
 		&BastionList{},
+		&ShootOperationBatch{},
+		&ShootOperationBatchList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 
@@ -0,0 +1,109 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ShootOperationBatch applies an operation annotation to a label-selected set of shoots with rate limiting and tracks
+// the result for each shoot.
+type ShootOperationBatch struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard object metadata.
+	metav1.ObjectMeta `json:"metadata" protobuf:"bytes,1,opt,name=metadata"`
+	// Specification of the ShootOperationBatch.
+	Spec ShootOperationBatchSpec `json:"spec" protobuf:"bytes,2,opt,name=spec"`
+	// Most recently observed status of the ShootOperationBatch.
+	// +optional
+	Status ShootOperationBatchStatus `json:"status" protobuf:"bytes,3,opt,name=status"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ShootOperationBatchList is a list of ShootOperationBatch objects.
+type ShootOperationBatchList struct {
+	metav1.TypeMeta `json:",inline"`
+	// Standard list object metadata.
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	// Items is the list of ShootOperationBatch.
+	Items []ShootOperationBatch `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// ShootOperationBatchSpec is the specification of a ShootOperationBatch.
+type ShootOperationBatchSpec struct {
+	// Operation is the value of the `gardener.cloud/operation` annotation which is applied to the selected shoots.
+	// Supported values are `reconcile`, `retry`, and `rotate-observability-credentials`. This field is immutable.
+	Operation string `json:"operation" protobuf:"bytes,1,opt,name=operation"`
+	// ShootSelector selects the shoots in all namespaces the operation is applied to. This field is immutable.
+	ShootSelector metav1.LabelSelector `json:"shootSelector" protobuf:"bytes,2,opt,name=shootSelector"`
+	// ShootsPerMinute is the maximum number of shoots the operation is applied to per minute. Defaults to `10`.
+	// +optional
+	ShootsPerMinute *int32 `json:"shootsPerMinute,omitempty" protobuf:"varint,3,opt,name=shootsPerMinute"`
+}
+
+// ShootOperationBatchStatus holds the most recently observed status of the ShootOperationBatch.
+type ShootOperationBatchStatus struct {
+	// Phase is the current phase of the ShootOperationBatch.
+	// +optional
+	Phase ShootOperationBatchPhase `json:"phase,omitempty" protobuf:"bytes,1,opt,name=phase,casttype=ShootOperationBatchPhase"`
+	// Shoots contains the result of the operation for each selected shoot. The list of shoots is determined once when
+	// the ShootOperationBatch is processed for the first time.
+	// +optional
+	Shoots []ShootOperationResult `json:"shoots,omitempty" protobuf:"bytes,2,rep,name=shoots"`
+	// CompletionTime is the time when the operation was applied to all selected shoots.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty" protobuf:"bytes,3,opt,name=completionTime"`
+	// ObservedGeneration is the most recent generation observed for this ShootOperationBatch.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty" protobuf:"varint,4,opt,name=observedGeneration"`
+}
+
+// ShootOperationBatchPhase is the phase of a ShootOperationBatch.
+// +enum
+type ShootOperationBatchPhase string
+
+const (
+	// ShootOperationBatchProcessing indicates that the operation is still being applied to the selected shoots.
+	ShootOperationBatchProcessing ShootOperationBatchPhase = "Processing"
+	// ShootOperationBatchSucceeded indicates that the operation was applied to all selected shoots.
+	ShootOperationBatchSucceeded ShootOperationBatchPhase = "Succeeded"
+	// ShootOperationBatchFailed indicates that the operation could not be applied to at least one selected shoot.
+	ShootOperationBatchFailed ShootOperationBatchPhase = "Failed"
+)
+
+// ShootOperationResult is the result of applying the operation to a single shoot.
+type ShootOperationResult struct {
+	// Name is the name of the shoot.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Namespace is the namespace of the shoot.
+	Namespace string `json:"namespace" protobuf:"bytes,2,opt,name=namespace"`
+	// State is the state of the operation for this shoot.
+	State ShootOperationState `json:"state" protobuf:"bytes,3,opt,name=state,casttype=ShootOperationState"`
+	// Message contains details about the result, e.g. why the operation could not be applied.
+	// +optional
+	Message *string `json:"message,omitempty" protobuf:"bytes,4,opt,name=message"`
+	// LastUpdateTime is the time when the state was last updated.
+	// +optional
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty" protobuf:"bytes,5,opt,name=lastUpdateTime"`
+}
+
+// ShootOperationState is the state of the operation for a single shoot.
+// +enum
+type ShootOperationState string
+
+const (
+	// ShootOperationPending indicates that the operation has not yet been applied to the shoot.
+	ShootOperationPending ShootOperationState = "Pending"
+	// ShootOperationApplied indicates that the operation annotation was applied to the shoot.
+	ShootOperationApplied ShootOperationState = "Applied"
+	// ShootOperationFailed indicates that the operation annotation could not be applied to the shoot.
+	ShootOperationFailed ShootOperationState = "Failed"
+)
@@ -58,6 +58,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*BastionPort)(nil), (*operations.BastionPort)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BastionPort_To_operations_BastionPort(a.(*BastionPort), b.(*operations.BastionPort), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*operations.BastionPort)(nil), (*BastionPort)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_operations_BastionPort_To_v1alpha1_BastionPort(a.(*operations.BastionPort), b.(*BastionPort), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*BastionSpec)(nil), (*operations.BastionSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_BastionSpec_To_operations_BastionSpec(a.(*BastionSpec), b.(*operations.BastionSpec), scope)
 	}); err != nil {
@@ -78,6 +88,56 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShootOperationBatch)(nil), (*operations.ShootOperationBatch)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootOperationBatch_To_operations_ShootOperationBatch(a.(*ShootOperationBatch), b.(*operations.ShootOperationBatch), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*operations.ShootOperationBatch)(nil), (*ShootOperationBatch)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_operations_ShootOperationBatch_To_v1alpha1_ShootOperationBatch(a.(*operations.ShootOperationBatch), b.(*ShootOperationBatch), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootOperationBatchList)(nil), (*operations.ShootOperationBatchList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootOperationBatchList_To_operations_ShootOperationBatchList(a.(*ShootOperationBatchList), b.(*operations.ShootOperationBatchList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*operations.ShootOperationBatchList)(nil), (*ShootOperationBatchList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_operations_ShootOperationBatchList_To_v1alpha1_ShootOperationBatchList(a.(*operations.ShootOperationBatchList), b.(*ShootOperationBatchList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootOperationBatchSpec)(nil), (*operations.ShootOperationBatchSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootOperationBatchSpec_To_operations_ShootOperationBatchSpec(a.(*ShootOperationBatchSpec), b.(*operations.ShootOperationBatchSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*operations.ShootOperationBatchSpec)(nil), (*ShootOperationBatchSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_operations_ShootOperationBatchSpec_To_v1alpha1_ShootOperationBatchSpec(a.(*operations.ShootOperationBatchSpec), b.(*ShootOperationBatchSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootOperationBatchStatus)(nil), (*operations.ShootOperationBatchStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootOperationBatchStatus_To_operations_ShootOperationBatchStatus(a.(*ShootOperationBatchStatus), b.(*operations.ShootOperationBatchStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*operations.ShootOperationBatchStatus)(nil), (*ShootOperationBatchStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_operations_ShootOperationBatchStatus_To_v1alpha1_ShootOperationBatchStatus(a.(*operations.ShootOperationBatchStatus), b.(*ShootOperationBatchStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootOperationResult)(nil), (*operations.ShootOperationResult)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootOperationResult_To_operations_ShootOperationResult(a.(*ShootOperationResult), b.(*operations.ShootOperationResult), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*operations.ShootOperationResult)(nil), (*ShootOperationResult)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_operations_ShootOperationResult_To_v1alpha1_ShootOperationResult(a.(*operations.ShootOperationResult), b.(*ShootOperationResult), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -155,12 +215,35 @@ func Convert_operations_BastionList_To_v1alpha1_BastionList(in *operations.Basti
 	return autoConvert_operations_BastionList_To_v1alpha1_BastionList(in, out, s)
 }
 
+func autoConvert_v1alpha1_BastionPort_To_operations_BastionPort(in *BastionPort, out *operations.BastionPort, s conversion.Scope) error {
+	out.Protocol = operations.BastionProtocol(in.Protocol)
+	out.Port = (*int32)(unsafe.Pointer(in.Port))
+	return nil
+}
+
+// Convert_v1alpha1_BastionPort_To_operations_BastionPort is an autogenerated conversion function.
+func Convert_v1alpha1_BastionPort_To_operations_BastionPort(in *BastionPort, out *operations.BastionPort, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BastionPort_To_operations_BastionPort(in, out, s)
+}
+
+func autoConvert_operations_BastionPort_To_v1alpha1_BastionPort(in *operations.BastionPort, out *BastionPort, s conversion.Scope) error {
+	out.Protocol = BastionProtocol(in.Protocol)
+	out.Port = (*int32)(unsafe.Pointer(in.Port))
+	return nil
+}
+
+// Convert_operations_BastionPort_To_v1alpha1_BastionPort is an autogenerated conversion function.
+func Convert_operations_BastionPort_To_v1alpha1_BastionPort(in *operations.BastionPort, out *BastionPort, s conversion.Scope) error {
+	return autoConvert_operations_BastionPort_To_v1alpha1_BastionPort(in, out, s)
+}
+
 func autoConvert_v1alpha1_BastionSpec_To_operations_BastionSpec(in *BastionSpec, out *operations.BastionSpec, s conversion.Scope) error {
 	out.ShootRef = in.ShootRef
 	out.SeedName = (*string)(unsafe.Pointer(in.SeedName))
 	out.ProviderType = (*string)(unsafe.Pointer(in.ProviderType))
 	out.SSHPublicKey = in.SSHPublicKey
 	out.Ingress = *(*[]operations.BastionIngressPolicy)(unsafe.Pointer(&in.Ingress))
+	out.Ports = *(*[]operations.BastionPort)(unsafe.Pointer(&in.Ports))
 	return nil
 }
 
@@ -175,6 +258,7 @@ func autoConvert_operations_BastionSpec_To_v1alpha1_BastionSpec(in *operations.B
 	out.ProviderType = (*string)(unsafe.Pointer(in.ProviderType))
 	out.SSHPublicKey = in.SSHPublicKey
 	out.Ingress = *(*[]BastionIngressPolicy)(unsafe.Pointer(&in.Ingress))
+	out.Ports = *(*[]BastionPort)(unsafe.Pointer(&in.Ports))
 	return nil
 }
 
@@ -210,3 +294,135 @@ func autoConvert_operations_BastionStatus_To_v1alpha1_BastionStatus(in *operatio
 func Convert_operations_BastionStatus_To_v1alpha1_BastionStatus(in *operations.BastionStatus, out *BastionStatus, s conversion.Scope) error {
 	return autoConvert_operations_BastionStatus_To_v1alpha1_BastionStatus(in, out, s)
 }
+
+func autoConvert_v1alpha1_ShootOperationBatch_To_operations_ShootOperationBatch(in *ShootOperationBatch, out *operations.ShootOperationBatch, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_ShootOperationBatchSpec_To_operations_ShootOperationBatchSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_ShootOperationBatchStatus_To_operations_ShootOperationBatchStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_ShootOperationBatch_To_operations_ShootOperationBatch is an autogenerated conversion function.
+func Convert_v1alpha1_ShootOperationBatch_To_operations_ShootOperationBatch(in *ShootOperationBatch, out *operations.ShootOperationBatch, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootOperationBatch_To_operations_ShootOperationBatch(in, out, s)
+}
+
+func autoConvert_operations_ShootOperationBatch_To_v1alpha1_ShootOperationBatch(in *operations.ShootOperationBatch, out *ShootOperationBatch, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_operations_ShootOperationBatchSpec_To_v1alpha1_ShootOperationBatchSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_operations_ShootOperationBatchStatus_To_v1alpha1_ShootOperationBatchStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_operations_ShootOperationBatch_To_v1alpha1_ShootOperationBatch is an autogenerated conversion function.
+func Convert_operations_ShootOperationBatch_To_v1alpha1_ShootOperationBatch(in *operations.ShootOperationBatch, out *ShootOperationBatch, s conversion.Scope) error {
+	return autoConvert_operations_ShootOperationBatch_To_v1alpha1_ShootOperationBatch(in, out, s)
+}
+
+func autoConvert_v1alpha1_ShootOperationBatchList_To_operations_ShootOperationBatchList(in *ShootOperationBatchList, out *operations.ShootOperationBatchList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]operations.ShootOperationBatch)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_ShootOperationBatchList_To_operations_ShootOperationBatchList is an autogenerated conversion function.
+func Convert_v1alpha1_ShootOperationBatchList_To_operations_ShootOperationBatchList(in *ShootOperationBatchList, out *operations.ShootOperationBatchList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootOperationBatchList_To_operations_ShootOperationBatchList(in, out, s)
+}
+
+func autoConvert_operations_ShootOperationBatchList_To_v1alpha1_ShootOperationBatchList(in *operations.ShootOperationBatchList, out *ShootOperationBatchList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]ShootOperationBatch)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_operations_ShootOperationBatchList_To_v1alpha1_ShootOperationBatchList is an autogenerated conversion function.
+func Convert_operations_ShootOperationBatchList_To_v1alpha1_ShootOperationBatchList(in *operations.ShootOperationBatchList, out *ShootOperationBatchList, s conversion.Scope) error {
+	return autoConvert_operations_ShootOperationBatchList_To_v1alpha1_ShootOperationBatchList(in, out, s)
+}
+
+func autoConvert_v1alpha1_ShootOperationBatchSpec_To_operations_ShootOperationBatchSpec(in *ShootOperationBatchSpec, out *operations.ShootOperationBatchSpec, s conversion.Scope) error {
+	out.Operation = in.Operation
+	out.ShootSelector = in.ShootSelector
+	out.ShootsPerMinute = (*int32)(unsafe.Pointer(in.ShootsPerMinute))
+	return nil
+}
+
+// Convert_v1alpha1_ShootOperationBatchSpec_To_operations_ShootOperationBatchSpec is an autogenerated conversion function.
+func Convert_v1alpha1_ShootOperationBatchSpec_To_operations_ShootOperationBatchSpec(in *ShootOperationBatchSpec, out *operations.ShootOperationBatchSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootOperationBatchSpec_To_operations_ShootOperationBatchSpec(in, out, s)
+}
+
+func autoConvert_operations_ShootOperationBatchSpec_To_v1alpha1_ShootOperationBatchSpec(in *operations.ShootOperationBatchSpec, out *ShootOperationBatchSpec, s conversion.Scope) error {
+	out.Operation = in.Operation
+	out.ShootSelector = in.ShootSelector
+	out.ShootsPerMinute = (*int32)(unsafe.Pointer(in.ShootsPerMinute))
+	return nil
+}
+
+// Convert_operations_ShootOperationBatchSpec_To_v1alpha1_ShootOperationBatchSpec is an autogenerated conversion function.
+func Convert_operations_ShootOperationBatchSpec_To_v1alpha1_ShootOperationBatchSpec(in *operations.ShootOperationBatchSpec, out *ShootOperationBatchSpec, s conversion.Scope) error {
+	return autoConvert_operations_ShootOperationBatchSpec_To_v1alpha1_ShootOperationBatchSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_ShootOperationBatchStatus_To_operations_ShootOperationBatchStatus(in *ShootOperationBatchStatus, out *operations.ShootOperationBatchStatus, s conversion.Scope) error {
+	out.Phase = operations.ShootOperationBatchPhase(in.Phase)
+	out.Shoots = *(*[]operations.ShootOperationResult)(unsafe.Pointer(&in.Shoots))
+	out.CompletionTime = (*metav1.Time)(unsafe.Pointer(in.CompletionTime))
+	out.ObservedGeneration = in.ObservedGeneration
+	return nil
+}
+
+// Convert_v1alpha1_ShootOperationBatchStatus_To_operations_ShootOperationBatchStatus is an autogenerated conversion function.
+func Convert_v1alpha1_ShootOperationBatchStatus_To_operations_ShootOperationBatchStatus(in *ShootOperationBatchStatus, out *operations.ShootOperationBatchStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootOperationBatchStatus_To_operations_ShootOperationBatchStatus(in, out, s)
+}
+
+func autoConvert_operations_ShootOperationBatchStatus_To_v1alpha1_ShootOperationBatchStatus(in *operations.ShootOperationBatchStatus, out *ShootOperationBatchStatus, s conversion.Scope) error {
+	out.Phase = ShootOperationBatchPhase(in.Phase)
+	out.Shoots = *(*[]ShootOperationResult)(unsafe.Pointer(&in.Shoots))
+	out.CompletionTime = (*metav1.Time)(unsafe.Pointer(in.CompletionTime))
+	out.ObservedGeneration = in.ObservedGeneration
+	return nil
+}
+
+// Convert_operations_ShootOperationBatchStatus_To_v1alpha1_ShootOperationBatchStatus is an autogenerated conversion function.
+func Convert_operations_ShootOperationBatchStatus_To_v1alpha1_ShootOperationBatchStatus(in *operations.ShootOperationBatchStatus, out *ShootOperationBatchStatus, s conversion.Scope) error {
+	return autoConvert_operations_ShootOperationBatchStatus_To_v1alpha1_ShootOperationBatchStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_ShootOperationResult_To_operations_ShootOperationResult(in *ShootOperationResult, out *operations.ShootOperationResult, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Namespace = in.Namespace
+	out.State = operations.ShootOperationState(in.State)
+	out.Message = (*string)(unsafe.Pointer(in.Message))
+	out.LastUpdateTime = (*metav1.Time)(unsafe.Pointer(in.LastUpdateTime))
+	return nil
+}
+
+// Convert_v1alpha1_ShootOperationResult_To_operations_ShootOperationResult is an autogenerated conversion function.
+func Convert_v1alpha1_ShootOperationResult_To_operations_ShootOperationResult(in *ShootOperationResult, out *operations.ShootOperationResult, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootOperationResult_To_operations_ShootOperationResult(in, out, s)
+}
+
+func autoConvert_operations_ShootOperationResult_To_v1alpha1_ShootOperationResult(in *operations.ShootOperationResult, out *ShootOperationResult, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Namespace = in.Namespace
+	out.State = ShootOperationState(in.State)
+	out.Message = (*string)(unsafe.Pointer(in.Message))
+	out.LastUpdateTime = (*metav1.Time)(unsafe.Pointer(in.LastUpdateTime))
+	return nil
+}
+
+// Convert_operations_ShootOperationResult_To_v1alpha1_ShootOperationResult is an autogenerated conversion function.
+func Convert_operations_ShootOperationResult_To_v1alpha1_ShootOperationResult(in *operations.ShootOperationResult, out *ShootOperationResult, s conversion.Scope) error {
+	return autoConvert_operations_ShootOperationResult_To_v1alpha1_ShootOperationResult(in, out, s)
+}
@@ -17,5 +17,34 @@ import (
 // Public to allow building arbitrary schemes.
 // All generated defaulters are covering - they call all nested defaulters.
 func RegisterDefaults(scheme *runtime.Scheme) error {
+	scheme.AddTypeDefaultingFunc(&Bastion{}, func(obj interface{}) { SetObjectDefaults_Bastion(obj.(*Bastion)) })
+	scheme.AddTypeDefaultingFunc(&BastionList{}, func(obj interface{}) { SetObjectDefaults_BastionList(obj.(*BastionList)) })
+	scheme.AddTypeDefaultingFunc(&ShootOperationBatch{}, func(obj interface{}) { SetObjectDefaults_ShootOperationBatch(obj.(*ShootOperationBatch)) })
+	scheme.AddTypeDefaultingFunc(&ShootOperationBatchList{}, func(obj interface{}) { SetObjectDefaults_ShootOperationBatchList(obj.(*ShootOperationBatchList)) })
 	return nil
 }
+
+func SetObjectDefaults_Bastion(in *Bastion) {
+	for i := range in.Spec.Ports {
+		a := &in.Spec.Ports[i]
+		SetDefaults_BastionPort(a)
+	}
+}
+
+func SetObjectDefaults_BastionList(in *BastionList) {
+	for i := range in.Items {
+		a := &in.Items[i]
+		SetObjectDefaults_Bastion(a)
+	}
+}
+
+func SetObjectDefaults_ShootOperationBatch(in *ShootOperationBatch) {
+	SetDefaults_ShootOperationBatchSpec(&in.Spec)
+}
+
+func SetObjectDefaults_ShootOperationBatchList(in *ShootOperationBatchList) {
+	for i := range in.Items {
+		a := &in.Items[i]
+		SetObjectDefaults_ShootOperationBatch(a)
+	}
+}
@@ -10,6 +10,8 @@ import (
 
 	"golang.org/x/crypto/ssh"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
@@ -65,6 +67,46 @@ func ValidateBastionSpec(spec *operations.BastionSpec, fldPath *field.Path) fiel
 		}
 	}
 
+	allErrs = append(allErrs, validateBastionPorts(spec.Ports, fldPath.Child("ports"))...)
+
+	return allErrs
+}
+
+var availableBastionProtocols = sets.New(
+	operations.BastionProtocolRDP,
+	operations.BastionProtocolTCP,
+)
+
+func validateBastionPorts(ports []operations.BastionPort, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs     = field.ErrorList{}
+		portNumbers = sets.New[int32]()
+	)
+
+	for i, port := range ports {
+		idxPath := fldPath.Index(i)
+
+		if !availableBastionProtocols.Has(port.Protocol) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("protocol"), port.Protocol, sets.List(availableBastionProtocols)))
+		}
+
+		if port.Port == nil {
+			allErrs = append(allErrs, field.Required(idxPath.Child("port"), "port must be set"))
+			continue
+		}
+
+		for _, msg := range validation.IsValidPortNum(int(*port.Port)) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("port"), *port.Port, msg))
+		}
+		if *port.Port == 22 {
+			allErrs = append(allErrs, field.Forbidden(idxPath.Child("port"), "the SSH port is always reachable through the bastion"))
+		}
+		if portNumbers.Has(*port.Port) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("port"), *port.Port))
+		}
+		portNumbers.Insert(*port.Port)
+	}
+
 	return allErrs
 }
 
@@ -74,6 +116,7 @@ func ValidateBastionSpecUpdate(newSpec, oldSpec *operations.BastionSpec, fldPath
 
 	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.ShootRef.Name, oldSpec.ShootRef.Name, fldPath.Child("shootRef.name"))...)
 	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.SSHPublicKey, oldSpec.SSHPublicKey, fldPath.Child("sshPublicKey"))...)
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.Ports, oldSpec.Ports, fldPath.Child("ports"))...)
 
 	return allErrs
 }
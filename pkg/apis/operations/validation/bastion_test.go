@@ -12,6 +12,7 @@ import (
 	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener/pkg/apis/operations"
 	. "github.com/gardener/gardener/pkg/apis/operations/validation"
@@ -116,6 +117,48 @@ var _ = Describe("validation", func() {
 			}))))
 		})
 
+		It("should allow valid ports", func() {
+			bastion.Spec.Ports = []operations.BastionPort{
+				{Protocol: operations.BastionProtocolRDP, Port: ptr.To[int32](3389)},
+				{Protocol: operations.BastionProtocolTCP, Port: ptr.To[int32](5432)},
+			}
+
+			Expect(ValidateBastion(bastion)).To(BeEmpty())
+		})
+
+		It("should forbid invalid ports", func() {
+			bastion.Spec.Ports = []operations.BastionPort{
+				{Protocol: "UDP", Port: ptr.To[int32](53)},
+				{Protocol: operations.BastionProtocolTCP},
+				{Protocol: operations.BastionProtocolTCP, Port: ptr.To[int32](70000)},
+				{Protocol: operations.BastionProtocolTCP, Port: ptr.To[int32](22)},
+				{Protocol: operations.BastionProtocolTCP, Port: ptr.To[int32](53)},
+			}
+
+			Expect(ValidateBastion(bastion)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("spec.ports[0].protocol"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.ports[1].port"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.ports[2].port"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.ports[3].port"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("spec.ports[4].port"),
+				})),
+			))
+		})
+
 		It("should forbid changing Shoot ref", func() {
 			newBastion := prepareBastionForUpdate(bastion)
 			newBastion.Spec.ShootRef.Name = "another-shoot"
@@ -139,6 +182,18 @@ var _ = Describe("validation", func() {
 				"Field": Equal("spec.sshPublicKey"),
 			}))))
 		})
+
+		It("should forbid changing ports", func() {
+			newBastion := prepareBastionForUpdate(bastion)
+			newBastion.Spec.Ports = []operations.BastionPort{{Protocol: operations.BastionProtocolRDP, Port: ptr.To[int32](3389)}}
+
+			errorList := ValidateBastionUpdate(newBastion, bastion)
+
+			Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.ports"),
+			}))))
+		})
 	})
 })
 
@@ -0,0 +1,117 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/apis/operations"
+)
+
+var (
+	availableShootOperationBatchOperations = sets.New(
+		v1beta1constants.GardenerOperationReconcile,
+		v1beta1constants.ShootOperationRetry,
+		v1beta1constants.OperationRotateObservabilityCredentials,
+	)
+	availableShootOperationBatchPhases = sets.New(
+		string(operations.ShootOperationBatchProcessing),
+		string(operations.ShootOperationBatchSucceeded),
+		string(operations.ShootOperationBatchFailed),
+	)
+	availableShootOperationStates = sets.New(
+		string(operations.ShootOperationPending),
+		string(operations.ShootOperationApplied),
+		string(operations.ShootOperationFailed),
+	)
+)
+
+// ValidateShootOperationBatch validates a ShootOperationBatch object.
+func ValidateShootOperationBatch(batch *operations.ShootOperationBatch) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMeta(&batch.ObjectMeta, false, apivalidation.NameIsDNSSubdomain, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, ValidateShootOperationBatchSpec(&batch.Spec, field.NewPath("spec"))...)
+
+	return allErrs
+}
+
+// ValidateShootOperationBatchUpdate validates a ShootOperationBatch object before an update.
+func ValidateShootOperationBatchUpdate(newBatch, oldBatch *operations.ShootOperationBatch) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateObjectMetaUpdate(&newBatch.ObjectMeta, &oldBatch.ObjectMeta, field.NewPath("metadata"))...)
+	allErrs = append(allErrs, ValidateShootOperationBatchSpecUpdate(&newBatch.Spec, &oldBatch.Spec, field.NewPath("spec"))...)
+	allErrs = append(allErrs, ValidateShootOperationBatch(newBatch)...)
+
+	return allErrs
+}
+
+// ValidateShootOperationBatchSpec validates the specification of a ShootOperationBatch object.
+func ValidateShootOperationBatchSpec(spec *operations.ShootOperationBatchSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(spec.Operation) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("operation"), "must provide an operation"))
+	} else if !availableShootOperationBatchOperations.Has(spec.Operation) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("operation"), spec.Operation, sets.List(availableShootOperationBatchOperations)))
+	}
+
+	if len(spec.ShootSelector.MatchLabels) == 0 && len(spec.ShootSelector.MatchExpressions) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("shootSelector"), "must not select all shoots"))
+	}
+	allErrs = append(allErrs, metav1validation.ValidateLabelSelector(&spec.ShootSelector, metav1validation.LabelSelectorValidationOptions{}, fldPath.Child("shootSelector"))...)
+
+	if spec.ShootsPerMinute != nil && *spec.ShootsPerMinute <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("shootsPerMinute"), *spec.ShootsPerMinute, "must be greater than 0"))
+	}
+
+	return allErrs
+}
+
+// ValidateShootOperationBatchSpecUpdate validates the specification of a ShootOperationBatch object before an update.
+func ValidateShootOperationBatchSpecUpdate(newSpec, oldSpec *operations.ShootOperationBatchSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(newSpec.Operation, oldSpec.Operation, fldPath.Child("operation"))...)
+	if !apiequality.Semantic.DeepEqual(newSpec.ShootSelector, oldSpec.ShootSelector) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("shootSelector"), "field is immutable"))
+	}
+
+	return allErrs
+}
+
+// ValidateShootOperationBatchStatusUpdate validates the status field of a ShootOperationBatch object.
+func ValidateShootOperationBatchStatusUpdate(newBatch, _ *operations.ShootOperationBatch) field.ErrorList {
+	var (
+		allErrs = field.ErrorList{}
+		fldPath = field.NewPath("status")
+	)
+
+	if len(newBatch.Status.Phase) > 0 && !availableShootOperationBatchPhases.Has(string(newBatch.Status.Phase)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("phase"), newBatch.Status.Phase, sets.List(availableShootOperationBatchPhases)))
+	}
+
+	for i, result := range newBatch.Status.Shoots {
+		idxPath := fldPath.Child("shoots").Index(i)
+
+		if len(result.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide the name of the shoot"))
+		}
+		if len(result.Namespace) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("namespace"), "must provide the namespace of the shoot"))
+		}
+		if !availableShootOperationStates.Has(string(result.State)) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("state"), result.State, sets.List(availableShootOperationStates)))
+		}
+	}
+
+	return allErrs
+}
@@ -0,0 +1,156 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener/pkg/apis/operations"
+	. "github.com/gardener/gardener/pkg/apis/operations/validation"
+)
+
+var _ = Describe("ShootOperationBatch validation", func() {
+	var batch *operations.ShootOperationBatch
+
+	BeforeEach(func() {
+		batch = &operations.ShootOperationBatch{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "rotate-observability-credentials",
+			},
+			Spec: operations.ShootOperationBatchSpec{
+				Operation:       "rotate-observability-credentials",
+				ShootSelector:   metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}},
+				ShootsPerMinute: ptr.To[int32](10),
+			},
+		}
+	})
+
+	Describe("#ValidateShootOperationBatch", func() {
+		It("should not return any errors", func() {
+			Expect(ValidateShootOperationBatch(batch)).To(BeEmpty())
+		})
+
+		It("should forbid ShootOperationBatch resources with empty metadata", func() {
+			batch.ObjectMeta = metav1.ObjectMeta{}
+
+			Expect(ValidateShootOperationBatch(batch)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("metadata.name"),
+			}))))
+		})
+
+		It("should forbid an empty operation", func() {
+			batch.Spec.Operation = ""
+
+			Expect(ValidateShootOperationBatch(batch)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.operation"),
+			}))))
+		})
+
+		It("should forbid unsupported operations", func() {
+			batch.Spec.Operation = "maintain"
+
+			Expect(ValidateShootOperationBatch(batch)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("spec.operation"),
+			}))))
+		})
+
+		It("should forbid selecting all shoots", func() {
+			batch.Spec.ShootSelector = metav1.LabelSelector{}
+
+			Expect(ValidateShootOperationBatch(batch)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.shootSelector"),
+			}))))
+		})
+
+		It("should forbid invalid shoot selectors", func() {
+			batch.Spec.ShootSelector = metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "foo", Operator: "Foo"}}}
+
+			Expect(ValidateShootOperationBatch(batch)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.shootSelector.matchExpressions[0].operator"),
+			}))))
+		})
+
+		It("should forbid non-positive shoots per minute", func() {
+			batch.Spec.ShootsPerMinute = ptr.To[int32](0)
+
+			Expect(ValidateShootOperationBatch(batch)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.shootsPerMinute"),
+			}))))
+		})
+	})
+
+	Describe("#ValidateShootOperationBatchUpdate", func() {
+		var newBatch *operations.ShootOperationBatch
+
+		BeforeEach(func() {
+			batch.ResourceVersion = "1"
+			newBatch = batch.DeepCopy()
+		})
+
+		It("should allow changing the shoots per minute", func() {
+			newBatch.Spec.ShootsPerMinute = ptr.To[int32](1)
+
+			Expect(ValidateShootOperationBatchUpdate(newBatch, batch)).To(BeEmpty())
+		})
+
+		It("should forbid changing the operation and the shoot selector", func() {
+			newBatch.Spec.Operation = "reconcile"
+			newBatch.Spec.ShootSelector.MatchLabels["foo"] = "baz"
+
+			Expect(ValidateShootOperationBatchUpdate(newBatch, batch)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.operation"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("spec.shootSelector"),
+			}))))
+		})
+	})
+
+	Describe("#ValidateShootOperationBatchStatusUpdate", func() {
+		It("should allow valid status", func() {
+			newBatch := batch.DeepCopy()
+			newBatch.Status = operations.ShootOperationBatchStatus{
+				Phase:  operations.ShootOperationBatchProcessing,
+				Shoots: []operations.ShootOperationResult{{Name: "foo", Namespace: "garden-bar", State: operations.ShootOperationPending}},
+			}
+
+			Expect(ValidateShootOperationBatchStatusUpdate(newBatch, batch)).To(BeEmpty())
+		})
+
+		It("should forbid invalid status", func() {
+			newBatch := batch.DeepCopy()
+			newBatch.Status = operations.ShootOperationBatchStatus{
+				Phase:  "Unknown",
+				Shoots: []operations.ShootOperationResult{{State: "Unknown"}},
+			}
+
+			Expect(ValidateShootOperationBatchStatusUpdate(newBatch, batch)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("status.phase"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("status.shoots[0].name"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("status.shoots[0].namespace"),
+			})), PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("status.shoots[0].state"),
+			}))))
+		})
+	})
+})
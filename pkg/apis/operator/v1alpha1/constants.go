@@ -22,6 +22,11 @@ const (
 	// to its own version after a successful operator.gardener.cloud/v1alpha1.Garden reconciliation.
 	LabelKeyGardenletAutoUpdates = "operator.gardener.cloud/auto-update-gardenlet-helm-chart-ref"
 
+	// AnnotationConfirmationRuntimeWorkersDeletion is a constant for an annotation on a Garden which must be set to
+	// "true" when `.spec.runtimeCluster.workers` is removed. It confirms that the worker pools of the runtime cluster
+	// and all their nodes shall be deleted.
+	AnnotationConfirmationRuntimeWorkersDeletion = "confirmation.gardener.cloud/runtime-workers-deletion"
+
 	// OperationRotateWorkloadIdentityKeyStart is a constant for an annotation on a Garden indicating that the
 	// rotation of the workload identity signing key shall be started.
 	OperationRotateWorkloadIdentityKeyStart = "rotate-workload-identity-key-start"
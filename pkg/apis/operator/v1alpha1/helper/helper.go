@@ -168,3 +168,8 @@ func HighAvailabilityEnabled(garden *operatorv1alpha1.Garden) bool {
 func TopologyAwareRoutingEnabled(settings *operatorv1alpha1.Settings) bool {
 	return settings != nil && settings.TopologyAwareRouting != nil && settings.TopologyAwareRouting.Enabled
 }
+
+// EventLoggingEnabled returns true if the event logging is enabled for the virtual garden cluster.
+func EventLoggingEnabled(garden *operatorv1alpha1.Garden) bool {
+	return garden.Spec.VirtualCluster.EventLogging != nil && garden.Spec.VirtualCluster.EventLogging.Enabled
+}
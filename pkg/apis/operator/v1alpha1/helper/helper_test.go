@@ -215,4 +215,15 @@ var _ = Describe("helper", func() {
 		Entry("topology-aware routing enabled", &operatorv1alpha1.Settings{TopologyAwareRouting: &operatorv1alpha1.SettingTopologyAwareRouting{Enabled: true}}, true),
 		Entry("topology-aware routing disabled", &operatorv1alpha1.Settings{TopologyAwareRouting: &operatorv1alpha1.SettingTopologyAwareRouting{Enabled: false}}, false),
 	)
+
+	DescribeTable("#EventLoggingEnabled",
+		func(eventLogging *operatorv1alpha1.EventLogging, expected bool) {
+			garden := &operatorv1alpha1.Garden{Spec: operatorv1alpha1.GardenSpec{VirtualCluster: operatorv1alpha1.VirtualCluster{EventLogging: eventLogging}}}
+			Expect(EventLoggingEnabled(garden)).To(Equal(expected))
+		},
+
+		Entry("no event logging configuration", nil, false),
+		Entry("event logging enabled", &operatorv1alpha1.EventLogging{Enabled: true}, true),
+		Entry("event logging disabled", &operatorv1alpha1.EventLogging{Enabled: false}, false),
+	)
 })
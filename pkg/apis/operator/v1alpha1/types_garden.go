@@ -10,6 +10,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/sets"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
@@ -98,6 +99,11 @@ type RuntimeCluster struct {
 	// Volume contains settings for persistent volumes created in the runtime cluster.
 	// +optional
 	Volume *Volume `json:"volume,omitempty"`
+	// Workers contains the configuration for worker pools of the runtime cluster which are managed by
+	// gardener-operator. If set, a `Worker` extension resource is created in the garden namespace which must be handled
+	// by an extension registered for the `Worker` kind and the given type.
+	// +optional
+	Workers *RuntimeWorkers `json:"workers,omitempty"`
 }
 
 // Ingress configures the Ingress specific settings of the runtime cluster.
@@ -204,6 +210,94 @@ type Volume struct {
 	MinimumSize *resource.Quantity `json:"minimumSize,omitempty"`
 }
 
+// RuntimeWorkers contains the configuration for worker pools of the runtime cluster.
+type RuntimeWorkers struct {
+	// Type is the type of the extension responsible for the worker pools, e.g., the infrastructure provider of the
+	// runtime cluster.
+	// +kubebuilder:validation:MinLength=1
+	Type string `json:"type"`
+	// SecretRef is the reference to a secret in the garden namespace containing the infrastructure credentials.
+	SecretRef corev1.LocalObjectReference `json:"secretRef"`
+	// InfrastructureProviderStatus is the provider-specific information about the infrastructure of the runtime
+	// cluster (e.g., networks, subnets, security groups) which is required by the extension to create the machines.
+	// +kubebuilder:validation:XPreserveUnknownFields
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	InfrastructureProviderStatus *runtime.RawExtension `json:"infrastructureProviderStatus,omitempty"`
+	// Pools is the list of worker pools.
+	// +kubebuilder:validation:MinItems=1
+	Pools []RuntimeWorkerPool `json:"pools"`
+}
+
+// RuntimeWorkerPool contains the configuration for a worker pool of the runtime cluster.
+type RuntimeWorkerPool struct {
+	// Name is the name of the worker pool.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// MachineType is the machine type of the worker pool.
+	// +kubebuilder:validation:MinLength=1
+	MachineType string `json:"machineType"`
+	// MachineImage is the machine image of the worker pool.
+	MachineImage RuntimeWorkerMachineImage `json:"machineImage"`
+	// Architecture is the CPU architecture of the machines and the machine image.
+	// +kubebuilder:validation:Enum=amd64;arm64
+	// +kubebuilder:default=amd64
+	// +optional
+	Architecture *string `json:"architecture,omitempty"`
+	// Minimum is the minimum number of machines of the worker pool.
+	// +kubebuilder:validation:Minimum=0
+	Minimum int32 `json:"minimum"`
+	// Maximum is the maximum number of machines of the worker pool.
+	// +kubebuilder:validation:Minimum=0
+	Maximum int32 `json:"maximum"`
+	// MaxSurge is the maximum number of machines that are created during an update.
+	// Defaults to 1.
+	// +optional
+	MaxSurge *intstr.IntOrString `json:"maxSurge,omitempty"`
+	// MaxUnavailable is the maximum number of machines that can be unavailable during an update.
+	// Defaults to 0.
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+	// Zones is the list of availability zones of the worker pool. Defaults to the zones in
+	// `.spec.runtimeCluster.provider.zones`.
+	// +optional
+	Zones []string `json:"zones,omitempty"`
+	// Labels is a map of labels for all nodes of the worker pool.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+	// Annotations is a map of annotations for all nodes of the worker pool.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// Taints is a list of taints for all nodes of the worker pool.
+	// +optional
+	Taints []corev1.Taint `json:"taints,omitempty"`
+	// Volume contains information about the root disks of the machines.
+	// +optional
+	Volume *gardencorev1beta1.Volume `json:"volume,omitempty"`
+	// ProviderConfig is the provider-specific configuration for the worker pool.
+	// +kubebuilder:validation:XPreserveUnknownFields
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	ProviderConfig *runtime.RawExtension `json:"providerConfig,omitempty"`
+	// UserDataSecretRef references a secret in the garden namespace and a data key containing the user data which
+	// bootstraps the machines and lets them join the runtime cluster.
+	UserDataSecretRef corev1.SecretKeySelector `json:"userDataSecretRef"`
+	// MachineControllerManagerSettings contains configurations for the machine-controller-manager, e.g., the drain
+	// and health timeouts.
+	// +optional
+	MachineControllerManagerSettings *gardencorev1beta1.MachineControllerManagerSettings `json:"machineControllerManager,omitempty"`
+}
+
+// RuntimeWorkerMachineImage contains the name and the version of a machine image.
+type RuntimeWorkerMachineImage struct {
+	// Name is the name of the machine image.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// Version is the version of the machine image.
+	// +kubebuilder:validation:MinLength=1
+	Version string `json:"version"`
+}
+
 // VirtualCluster contains configuration for the virtual cluster.
 type VirtualCluster struct {
 	// ControlPlane holds information about the general settings for the control plane of the virtual cluster.
@@ -214,6 +308,9 @@ type VirtualCluster struct {
 	// ETCD contains configuration for the etcds of the virtual garden cluster.
 	// +optional
 	ETCD *ETCD `json:"etcd,omitempty"`
+	// EventLogging contains configuration for persisting the events of the virtual garden cluster.
+	// +optional
+	EventLogging *EventLogging `json:"eventLogging,omitempty"`
 	// Gardener contains the configuration options for the Gardener control plane components.
 	Gardener Gardener `json:"gardener"`
 	// Kubernetes contains the version and configuration options for the Kubernetes components of the virtual garden
@@ -233,6 +330,20 @@ type DNS struct {
 	Domains []DNSDomain `json:"domains,omitempty"`
 }
 
+// EventLogging contains configuration for persisting the events of the virtual garden cluster.
+type EventLogging struct {
+	// Enabled controls whether an event-logger is deployed to the runtime cluster. It captures the events of the virtual
+	// garden cluster (e.g., for Shoots, Seeds, or Projects) and persists them in the Vali of the runtime cluster, hence
+	// they remain available (according to the retention of Vali) after they have expired in the virtual garden cluster.
+	Enabled bool `json:"enabled"`
+	// Namespaces is the list of namespaces in the virtual garden cluster whose events shall be captured. Events for
+	// Shoots are recorded in the namespaces of their Projects, while events for cluster-scoped resources like Seeds or
+	// Projects are recorded in the `default` namespace.
+	// Defaults to `default` and `garden`.
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty"`
+}
+
 // ETCD contains configuration for the etcds of the virtual garden cluster.
 type ETCD struct {
 	// Main contains configuration for the main etcd.
@@ -582,6 +693,9 @@ type GardenerDashboardConfig struct {
 	// GitHub contains configuration for the GitHub ticketing feature.
 	// +optional
 	GitHub *DashboardGitHub `json:"gitHub,omitempty"`
+	// Ingress contains configuration for the ingress of the dashboard.
+	// +optional
+	Ingress *DashboardIngress `json:"ingress,omitempty"`
 	// LogLevel is the configured log level. Must be one of [trace,debug,info,warn,error].
 	// Defaults to info.
 	// +kubebuilder:validation:Enum=trace;debug;info;warn;error
@@ -621,6 +735,19 @@ type DashboardGitHub struct {
 	PollInterval *metav1.Duration `json:"pollInterval,omitempty"`
 }
 
+// DashboardIngress contains configuration for the ingress of the dashboard.
+type DashboardIngress struct {
+	// Annotations are additional annotations for the ingress of the dashboard. Annotations managed by the operator
+	// cannot be overwritten.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// TLSSecretRef is the reference to a secret in the garden namespace containing the TLS certificate ('tls.crt') and
+	// key ('tls.key') for the dashboard hosts. If not provided, the wildcard certificate of the runtime cluster's
+	// ingress domains is used, or a self-signed server certificate is generated.
+	// +optional
+	TLSSecretRef *corev1.LocalObjectReference `json:"tlsSecretRef,omitempty"`
+}
+
 // DashboardOIDC contains configuration for the OIDC settings.
 type DashboardOIDC struct {
 	// ClientIDPublic is the public client ID.
@@ -638,6 +765,10 @@ type DashboardOIDC struct {
 	// +kubebuilder:validation:Pattern="^([0-9]+(\\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$"
 	// +optional
 	SessionLifetime *metav1.Duration `json:"sessionLifetime,omitempty"`
+	// CABundle is the PEM-encoded CA bundle used to verify the TLS connection to the OpenID issuer.
+	// Falls back to the API server's OIDC CA bundle configuration if not set here.
+	// +optional
+	CABundle *string `json:"caBundle,omitempty"`
 	// AdditionalScopes is the list of additional OIDC scopes.
 	// +optional
 	AdditionalScopes []string `json:"additionalScopes,omitempty"`
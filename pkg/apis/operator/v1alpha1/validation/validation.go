@@ -8,14 +8,17 @@ import (
 	"fmt"
 	"net"
 	"slices"
+	"strconv"
 	"strings"
 
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/component-base/featuregate"
 	"k8s.io/utils/ptr"
@@ -95,6 +98,17 @@ func validateRuntimeClusterUpdate(oldGarden, newGarden *operatorv1alpha1.Garden)
 		allErrs = append(allErrs, apivalidation.ValidateImmutableField(oldRuntimeCluster.Ingress.Domains[0].Name, newRuntimeCluster.Ingress.Domains[0].Name, fldPath.Child("ingress", "domains").Index(0))...)
 	}
 
+	if oldRuntimeCluster.Workers != nil && newRuntimeCluster.Workers == nil && newGarden.Annotations[operatorv1alpha1.AnnotationConfirmationRuntimeWorkersDeletion] != "true" {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("workers"), fmt.Sprintf("worker pools can only be removed if the %s=true annotation is set, all nodes of the worker pools will be deleted", operatorv1alpha1.AnnotationConfirmationRuntimeWorkersDeletion)))
+	}
+
+	if oldRuntimeCluster.Workers != nil && newRuntimeCluster.Workers != nil {
+		allErrs = append(allErrs, apivalidation.ValidateImmutableField(oldRuntimeCluster.Workers.Type, newRuntimeCluster.Workers.Type, fldPath.Child("workers", "type"))...)
+		if oldRuntimeCluster.Provider.Region != nil {
+			allErrs = append(allErrs, apivalidation.ValidateImmutableField(oldRuntimeCluster.Provider.Region, newRuntimeCluster.Provider.Region, fldPath.Child("provider", "region"))...)
+		}
+	}
+
 	return allErrs
 }
 
@@ -167,9 +181,88 @@ func validateRuntimeCluster(dns *operatorv1alpha1.DNSManagement, runtimeCluster
 
 	allErrs = validateDomains(dns, runtimeCluster.Ingress.Domains, fldPath.Child("ingress", "domains"), allErrs)
 
+	if runtimeCluster.Workers != nil {
+		allErrs = append(allErrs, validateRuntimeWorkers(runtimeCluster.Workers, runtimeCluster.Provider, fldPath.Child("workers"))...)
+	}
+
+	return allErrs
+}
+
+func validateRuntimeWorkers(workers *operatorv1alpha1.RuntimeWorkers, provider operatorv1alpha1.Provider, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if provider.Region == nil {
+		allErrs = append(allErrs, field.Required(field.NewPath("spec", "runtimeCluster", "provider", "region"), "region must be set when worker pools are configured"))
+	}
+
+	if len(workers.Pools) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("pools"), "at least one worker pool must be configured"))
+	}
+
+	poolNames := sets.New[string]()
+	for i, pool := range workers.Pools {
+		idxPath := fldPath.Child("pools").Index(i)
+
+		for _, msg := range apivalidation.NameIsDNSLabel(pool.Name, false) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), pool.Name, msg))
+		}
+		if poolNames.Has(pool.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), pool.Name))
+		}
+		poolNames.Insert(pool.Name)
+
+		if pool.Maximum < pool.Minimum {
+			allErrs = append(allErrs, field.Forbidden(idxPath.Child("maximum"), "maximum value must not be less than minimum value"))
+		}
+
+		allErrs = append(allErrs, gardencorevalidation.ValidatePositiveIntOrPercent(pool.MaxSurge, idxPath.Child("maxSurge"))...)
+		allErrs = append(allErrs, gardencorevalidation.ValidatePositiveIntOrPercent(pool.MaxUnavailable, idxPath.Child("maxUnavailable"))...)
+		if pool.MaxSurge != nil && pool.MaxUnavailable != nil && getIntOrPercentValue(*pool.MaxSurge) == 0 && getIntOrPercentValue(*pool.MaxUnavailable) == 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("maxUnavailable"), pool.MaxUnavailable, "may not be 0 when `maxSurge` is 0, too"))
+		}
+
+		if len(pool.Zones) == 0 && len(provider.Zones) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("zones"), "zones must be set if no zones are configured in .spec.runtimeCluster.provider.zones"))
+		}
+		for j, zone := range pool.Zones {
+			if len(provider.Zones) > 0 && !slices.Contains(provider.Zones, zone) {
+				allErrs = append(allErrs, field.NotSupported(idxPath.Child("zones").Index(j), zone, provider.Zones))
+			}
+		}
+
+		allErrs = append(allErrs, metav1validation.ValidateLabels(pool.Labels, idxPath.Child("labels"))...)
+		allErrs = append(allErrs, apivalidation.ValidateAnnotations(pool.Annotations, idxPath.Child("annotations"))...)
+
+		if len(pool.UserDataSecretRef.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("userDataSecretRef", "name"), "must reference a secret containing the user data"))
+		}
+		if len(pool.UserDataSecretRef.Key) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("userDataSecretRef", "key"), "must specify the data key containing the user data"))
+		}
+	}
+
 	return allErrs
 }
 
+func getIntOrPercentValue(intOrStringValue intstr.IntOrString) int {
+	value, isPercent := getPercentValue(intOrStringValue)
+	if isPercent {
+		return value
+	}
+	return intOrStringValue.IntValue()
+}
+
+func getPercentValue(intOrStringValue intstr.IntOrString) (int, bool) {
+	if intOrStringValue.Type != intstr.String {
+		return 0, false
+	}
+	if len(validation.IsValidPercent(intOrStringValue.StrVal)) != 0 {
+		return 0, false
+	}
+	value, _ := strconv.Atoi(intOrStringValue.StrVal[:len(intOrStringValue.StrVal)-1])
+	return value, true
+}
+
 func validateDomains(dns *operatorv1alpha1.DNSManagement, domains []operatorv1alpha1.DNSDomain, path *field.Path, allErrs field.ErrorList) field.ErrorList {
 	names := sets.New[string]()
 	for i, domain := range domains {
@@ -218,6 +311,16 @@ func validateVirtualCluster(dns *operatorv1alpha1.DNSManagement, virtualCluster
 		}
 
 		allErrs = append(allErrs, gardencorevalidation.ValidateKubeAPIServer(coreKubeAPIServerConfig, virtualCluster.Kubernetes.Version, true, gardenerutils.DefaultResourcesForEncryption(), path)...)
+
+		if len(coreKubeAPIServerConfig.AuthorizedNetworks) > 0 {
+			allErrs = append(allErrs, field.Forbidden(path.Child("authorizedNetworks"), "is not supported for the virtual garden cluster"))
+		}
+		if coreKubeAPIServerConfig.Endpoint != nil {
+			allErrs = append(allErrs, field.Forbidden(path.Child("endpoint"), "is not supported for the virtual garden cluster"))
+		}
+		if coreKubeAPIServerConfig.APIPriorityAndFairness != nil {
+			allErrs = append(allErrs, field.Forbidden(path.Child("apiPriorityAndFairness"), "is not supported for the virtual garden cluster"))
+		}
 	}
 
 	if kubeControllerManager := virtualCluster.Kubernetes.KubeControllerManager; kubeControllerManager != nil && kubeControllerManager.KubeControllerManagerConfig != nil {
@@ -232,6 +335,7 @@ func validateVirtualCluster(dns *operatorv1alpha1.DNSManagement, virtualCluster
 	}
 
 	allErrs = append(allErrs, validateGardener(virtualCluster.Gardener, virtualCluster.Kubernetes, fldPath.Child("gardener"))...)
+	allErrs = append(allErrs, validateEventLogging(virtualCluster.EventLogging, fldPath.Child("eventLogging"))...)
 
 	if _, _, err := net.ParseCIDR(virtualCluster.Networking.Services); err != nil {
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("networking", "services"), virtualCluster.Networking.Services, "cannot parse service network cidr: "+err.Error()))
@@ -249,6 +353,29 @@ func validateVirtualCluster(dns *operatorv1alpha1.DNSManagement, virtualCluster
 	return allErrs
 }
 
+func validateEventLogging(eventLogging *operatorv1alpha1.EventLogging, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if eventLogging == nil {
+		return allErrs
+	}
+
+	namespaces := sets.New[string]()
+	for i, namespace := range eventLogging.Namespaces {
+		idxPath := fldPath.Child("namespaces").Index(i)
+
+		for _, msg := range apivalidation.ValidateNamespaceName(namespace, false) {
+			allErrs = append(allErrs, field.Invalid(idxPath, namespace, msg))
+		}
+		if namespaces.Has(namespace) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, namespace))
+		}
+		namespaces.Insert(namespace)
+	}
+
+	return allErrs
+}
+
 func validateGardener(gardener operatorv1alpha1.Gardener, kubernetes operatorv1alpha1.Kubernetes, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -439,6 +566,20 @@ func validateGardenerDashboardConfig(config *operatorv1alpha1.GardenerDashboardC
 		if oidc.IssuerURL != nil {
 			allErrs = append(allErrs, gardencorevalidation.ValidateOIDCIssuerURL(*oidc.IssuerURL, oidcPath.Child("issuerURL"))...)
 		}
+
+		if oidc.SessionLifetime != nil && oidc.SessionLifetime.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(oidcPath.Child("sessionLifetime"), oidc.SessionLifetime.Duration.String(), "must be a positive duration"))
+		}
+
+		if oidc.CABundle != nil {
+			if _, err := utils.DecodeCertificate([]byte(*oidc.CABundle)); err != nil {
+				allErrs = append(allErrs, field.Invalid(oidcPath.Child("caBundle"), *oidc.CABundle, "caBundle is not a valid PEM-encoded certificate"))
+			}
+		}
+	}
+
+	if ingress := config.Ingress; ingress != nil {
+		allErrs = append(allErrs, apivalidation.ValidateAnnotations(ingress.Annotations, fldPath.Child("ingress", "annotations"))...)
 	}
 
 	return allErrs
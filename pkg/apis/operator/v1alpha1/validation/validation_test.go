@@ -6,6 +6,7 @@ package validation_test
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/Masterminds/semver/v3"
 	. "github.com/onsi/ginkgo/v2"
@@ -18,6 +19,7 @@ import (
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/component-base/featuregate"
@@ -1150,6 +1152,108 @@ var _ = Describe("Validation Tests", func() {
 					))
 				})
 			})
+
+			Context("workers", func() {
+				var pool operatorv1alpha1.RuntimeWorkerPool
+
+				BeforeEach(func() {
+					garden.Spec.RuntimeCluster.Provider = operatorv1alpha1.Provider{
+						Region: ptr.To("region"),
+						Zones:  []string{"a", "b"},
+					}
+					pool = operatorv1alpha1.RuntimeWorkerPool{
+						Name:              "pool",
+						MachineType:       "large",
+						MachineImage:      operatorv1alpha1.RuntimeWorkerMachineImage{Name: "gardenlinux", Version: "1.2.3"},
+						Minimum:           1,
+						Maximum:           3,
+						UserDataSecretRef: corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "user-data"}, Key: "data"},
+					}
+				})
+
+				JustBeforeEach(func() {
+					garden.Spec.RuntimeCluster.Workers = &operatorv1alpha1.RuntimeWorkers{
+						Type:      "provider",
+						SecretRef: corev1.LocalObjectReference{Name: "credentials"},
+						Pools:     []operatorv1alpha1.RuntimeWorkerPool{pool},
+					}
+				})
+
+				It("should allow valid worker pools", func() {
+					Expect(ValidateGarden(garden)).To(BeEmpty())
+				})
+
+				It("should complain if the region is not set", func() {
+					garden.Spec.RuntimeCluster.Provider.Region = nil
+
+					Expect(ValidateGarden(garden)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("spec.runtimeCluster.provider.region"),
+						})),
+					))
+				})
+
+				It("should complain about invalid worker pool settings", func() {
+					pool := &garden.Spec.RuntimeCluster.Workers.Pools[0]
+					pool.Name = "Invalid_Name"
+					pool.Maximum = 0
+					pool.MaxSurge = ptr.To(intstr.FromInt32(0))
+					pool.MaxUnavailable = ptr.To(intstr.FromString("0%"))
+					pool.Zones = []string{"c"}
+					pool.Labels = map[string]string{"foo/bar/baz": "value"}
+					pool.UserDataSecretRef.Key = ""
+
+					Expect(ValidateGarden(garden)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.runtimeCluster.workers.pools[0].name"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeForbidden),
+							"Field": Equal("spec.runtimeCluster.workers.pools[0].maximum"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.runtimeCluster.workers.pools[0].maxUnavailable"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeNotSupported),
+							"Field": Equal("spec.runtimeCluster.workers.pools[0].zones[0]"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.runtimeCluster.workers.pools[0].labels"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("spec.runtimeCluster.workers.pools[0].userDataSecretRef.key"),
+						})),
+					))
+				})
+
+				It("should complain if no zones are configured at all", func() {
+					garden.Spec.RuntimeCluster.Provider.Zones = nil
+
+					Expect(ValidateGarden(garden)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("spec.runtimeCluster.workers.pools[0].zones"),
+						})),
+					))
+				})
+
+				It("should complain about duplicate worker pool names", func() {
+					garden.Spec.RuntimeCluster.Workers.Pools = append(garden.Spec.RuntimeCluster.Workers.Pools, pool)
+
+					Expect(ValidateGarden(garden)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeDuplicate),
+							"Field": Equal("spec.runtimeCluster.workers.pools[1].name"),
+						})),
+					))
+				})
+			})
 		})
 
 		Context("virtual cluster", func() {
@@ -1238,6 +1342,81 @@ var _ = Describe("Validation Tests", func() {
 				})
 			})
 
+			Context("EventLogging", func() {
+				It("should allow valid namespaces", func() {
+					garden.Spec.VirtualCluster.EventLogging = &operatorv1alpha1.EventLogging{
+						Enabled:    true,
+						Namespaces: []string{"default", "garden-foo"},
+					}
+
+					Expect(ValidateGarden(garden)).To(BeEmpty())
+				})
+
+				It("should complain about invalid and duplicate namespaces", func() {
+					garden.Spec.VirtualCluster.EventLogging = &operatorv1alpha1.EventLogging{
+						Enabled:    true,
+						Namespaces: []string{"default", "Foo_Bar", "default"},
+					}
+
+					Expect(ValidateGarden(garden)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.virtualCluster.eventLogging.namespaces[1]"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeDuplicate),
+							"Field": Equal("spec.virtualCluster.eventLogging.namespaces[2]"),
+						})),
+					))
+				})
+			})
+
+			Context("KubeAPIServer", func() {
+				It("should forbid configuring authorized networks", func() {
+					garden.Spec.VirtualCluster.Kubernetes.KubeAPIServer = &operatorv1alpha1.KubeAPIServerConfig{KubeAPIServerConfig: &gardencorev1beta1.KubeAPIServerConfig{
+						AuthorizedNetworks: []string{"10.0.0.0/8"},
+					}}
+
+					Expect(ValidateGarden(garden)).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.virtualCluster.kubernetes.kubeAPIServer.authorizedNetworks"),
+					}))))
+				})
+
+				It("should forbid configuring the endpoint", func() {
+					garden.Spec.VirtualCluster.Kubernetes.KubeAPIServer = &operatorv1alpha1.KubeAPIServerConfig{KubeAPIServerConfig: &gardencorev1beta1.KubeAPIServerConfig{
+						Endpoint: &gardencorev1beta1.KubeAPIServerEndpoint{Port: ptr.To[int32](6443)},
+					}}
+
+					Expect(ValidateGarden(garden)).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.virtualCluster.kubernetes.kubeAPIServer.endpoint"),
+					}))))
+				})
+
+				It("should forbid configuring API priority and fairness", func() {
+					garden.Spec.VirtualCluster.Kubernetes.KubeAPIServer = &operatorv1alpha1.KubeAPIServerConfig{KubeAPIServerConfig: &gardencorev1beta1.KubeAPIServerConfig{
+						APIPriorityAndFairness: &gardencorev1beta1.APIPriorityAndFairness{MaxSeats: ptr.To[int32](1000)},
+					}}
+
+					Expect(ValidateGarden(garden)).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.virtualCluster.kubernetes.kubeAPIServer.apiPriorityAndFairness"),
+					}))))
+				})
+
+				It("should forbid configuring the VPN", func() {
+					garden.Spec.VirtualCluster.Kubernetes.KubeAPIServer = &operatorv1alpha1.KubeAPIServerConfig{KubeAPIServerConfig: &gardencorev1beta1.KubeAPIServerConfig{
+						VPN: &gardencorev1beta1.KubeAPIServerVPNConfig{HighAvailability: ptr.To(true)},
+					}}
+
+					Expect(ValidateGarden(garden)).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.virtualCluster.kubernetes.kubeAPIServer.vpn"),
+					}))))
+				})
+			})
+
 			Context("Networking", func() {
 				It("should complain about an invalid service CIDR", func() {
 					garden.Spec.VirtualCluster.Networking.Services = "not-parseable-cidr"
@@ -2037,6 +2216,58 @@ var _ = Describe("Validation Tests", func() {
 
 							Expect(ValidateGarden(garden)).To(BeEmpty())
 						})
+
+						It("should complain when the session lifetime is not positive", func() {
+							garden.Spec.VirtualCluster.Gardener.Dashboard = &operatorv1alpha1.GardenerDashboardConfig{OIDC: &operatorv1alpha1.DashboardOIDC{
+								SessionLifetime: &metav1.Duration{Duration: -time.Hour},
+							}}
+							garden.Spec.VirtualCluster.Kubernetes.KubeAPIServer = &operatorv1alpha1.KubeAPIServerConfig{KubeAPIServerConfig: &gardencorev1beta1.KubeAPIServerConfig{OIDCConfig: &gardencorev1beta1.OIDCConfig{
+								IssuerURL: ptr.To("https://example.com"),
+								ClientID:  ptr.To("my-client-id"),
+							}}}
+
+							Expect(ValidateGarden(garden)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+								"Type":  Equal(field.ErrorTypeInvalid),
+								"Field": Equal("spec.virtualCluster.gardener.gardenerDashboard.oidc.sessionLifetime"),
+							}))))
+						})
+
+						It("should complain when the CA bundle is invalid", func() {
+							garden.Spec.VirtualCluster.Gardener.Dashboard = &operatorv1alpha1.GardenerDashboardConfig{OIDC: &operatorv1alpha1.DashboardOIDC{
+								CABundle: ptr.To("not-a-certificate"),
+							}}
+							garden.Spec.VirtualCluster.Kubernetes.KubeAPIServer = &operatorv1alpha1.KubeAPIServerConfig{KubeAPIServerConfig: &gardencorev1beta1.KubeAPIServerConfig{OIDCConfig: &gardencorev1beta1.OIDCConfig{
+								IssuerURL: ptr.To("https://example.com"),
+								ClientID:  ptr.To("my-client-id"),
+							}}}
+
+							Expect(ValidateGarden(garden)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+								"Type":  Equal(field.ErrorTypeInvalid),
+								"Field": Equal("spec.virtualCluster.gardener.gardenerDashboard.oidc.caBundle"),
+							}))))
+						})
+					})
+
+					Context("Ingress", func() {
+						It("should complain when the annotations are invalid", func() {
+							garden.Spec.VirtualCluster.Gardener.Dashboard = &operatorv1alpha1.GardenerDashboardConfig{Ingress: &operatorv1alpha1.DashboardIngress{
+								Annotations: map[string]string{"foo/bar/baz": "value"},
+							}}
+
+							Expect(ValidateGarden(garden)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+								"Type":  Equal(field.ErrorTypeInvalid),
+								"Field": Equal("spec.virtualCluster.gardener.gardenerDashboard.ingress.annotations"),
+							}))))
+						})
+
+						It("should not complain when the annotations are valid", func() {
+							garden.Spec.VirtualCluster.Gardener.Dashboard = &operatorv1alpha1.GardenerDashboardConfig{Ingress: &operatorv1alpha1.DashboardIngress{
+								Annotations:  map[string]string{"cert.gardener.cloud/purpose": "managed"},
+								TLSSecretRef: &corev1.LocalObjectReference{Name: "dashboard-tls"},
+							}}
+
+							Expect(ValidateGarden(garden)).To(BeEmpty())
+						})
 					})
 				})
 			})
@@ -2148,6 +2379,48 @@ var _ = Describe("Validation Tests", func() {
 					}))))
 				})
 			})
+
+			Context("workers", func() {
+				It("should forbid changing the worker type", func() {
+					oldGarden.Spec.RuntimeCluster.Workers = &operatorv1alpha1.RuntimeWorkers{Type: "foo"}
+					newGarden.Spec.RuntimeCluster.Workers = &operatorv1alpha1.RuntimeWorkers{Type: "bar"}
+
+					Expect(ValidateGardenUpdate(oldGarden, newGarden)).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.runtimeCluster.workers.type"),
+					}))))
+				})
+
+				It("should forbid changing the region", func() {
+					oldGarden.Spec.RuntimeCluster.Workers = &operatorv1alpha1.RuntimeWorkers{Type: "foo"}
+					oldGarden.Spec.RuntimeCluster.Provider.Region = ptr.To("region")
+					newGarden.Spec.RuntimeCluster.Workers = &operatorv1alpha1.RuntimeWorkers{Type: "foo"}
+					newGarden.Spec.RuntimeCluster.Provider.Region = ptr.To("other-region")
+
+					Expect(ValidateGardenUpdate(oldGarden, newGarden)).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.runtimeCluster.provider.region"),
+					}))))
+				})
+
+				It("should forbid removing the workers without confirmation", func() {
+					oldGarden.Spec.RuntimeCluster.Workers = &operatorv1alpha1.RuntimeWorkers{Type: "foo"}
+
+					Expect(ValidateGardenUpdate(oldGarden, newGarden)).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("spec.runtimeCluster.workers"),
+					}))))
+				})
+
+				It("should allow removing the workers with confirmation", func() {
+					oldGarden.Spec.RuntimeCluster.Workers = &operatorv1alpha1.RuntimeWorkers{Type: "foo"}
+					metav1.SetMetaDataAnnotation(&newGarden.ObjectMeta, "confirmation.gardener.cloud/runtime-workers-deletion", "true")
+
+					Expect(ValidateGardenUpdate(oldGarden, newGarden)).NotTo(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+						"Field": Equal("spec.runtimeCluster.workers"),
+					}))))
+				})
+			})
 		})
 
 		Context("virtual cluster", func() {
@@ -10,13 +10,14 @@
 package v1alpha1
 
 import (
-	corev1 "github.com/gardener/gardener/pkg/apis/core/v1"
+	apiscorev1 "github.com/gardener/gardener/pkg/apis/core/v1"
 	v1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
-	apicorev1 "k8s.io/api/core/v1"
+	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	v1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -344,6 +345,34 @@ func (in *DashboardGitHub) DeepCopy() *DashboardGitHub {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DashboardIngress) DeepCopyInto(out *DashboardIngress) {
+	*out = *in
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.TLSSecretRef != nil {
+		in, out := &in.TLSSecretRef, &out.TLSSecretRef
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DashboardIngress.
+func (in *DashboardIngress) DeepCopy() *DashboardIngress {
+	if in == nil {
+		return nil
+	}
+	out := new(DashboardIngress)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DashboardOIDC) DeepCopyInto(out *DashboardOIDC) {
 	*out = *in
@@ -362,6 +391,11 @@ func (in *DashboardOIDC) DeepCopyInto(out *DashboardOIDC) {
 		*out = new(metav1.Duration)
 		**out = **in
 	}
+	if in.CABundle != nil {
+		in, out := &in.CABundle, &out.CABundle
+		*out = new(string)
+		**out = **in
+	}
 	if in.AdditionalScopes != nil {
 		in, out := &in.AdditionalScopes, &out.AdditionalScopes
 		*out = make([]string, len(*in))
@@ -544,6 +578,27 @@ func (in *ETCDMain) DeepCopy() *ETCDMain {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventLogging) DeepCopyInto(out *EventLogging) {
+	*out = *in
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventLogging.
+func (in *EventLogging) DeepCopy() *EventLogging {
+	if in == nil {
+		return nil
+	}
+	out := new(EventLogging)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Extension) DeepCopyInto(out *Extension) {
 	*out = *in
@@ -614,7 +669,7 @@ func (in *ExtensionHelm) DeepCopyInto(out *ExtensionHelm) {
 	*out = *in
 	if in.OCIRepository != nil {
 		in, out := &in.OCIRepository, &out.OCIRepository
-		*out = new(corev1.OCIRepository)
+		*out = new(apiscorev1.OCIRepository)
 		(*in).DeepCopyInto(*out)
 	}
 	return
@@ -1011,12 +1066,12 @@ func (in *GardenerDashboardConfig) DeepCopyInto(out *GardenerDashboardConfig) {
 	}
 	if in.FrontendConfigMapRef != nil {
 		in, out := &in.FrontendConfigMapRef, &out.FrontendConfigMapRef
-		*out = new(apicorev1.LocalObjectReference)
+		*out = new(corev1.LocalObjectReference)
 		**out = **in
 	}
 	if in.AssetsConfigMapRef != nil {
 		in, out := &in.AssetsConfigMapRef, &out.AssetsConfigMapRef
-		*out = new(apicorev1.LocalObjectReference)
+		*out = new(corev1.LocalObjectReference)
 		**out = **in
 	}
 	if in.GitHub != nil {
@@ -1024,6 +1079,11 @@ func (in *GardenerDashboardConfig) DeepCopyInto(out *GardenerDashboardConfig) {
 		*out = new(DashboardGitHub)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Ingress != nil {
+		in, out := &in.Ingress, &out.Ingress
+		*out = new(DashboardIngress)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.LogLevel != nil {
 		in, out := &in.LogLevel, &out.LogLevel
 		*out = new(string)
@@ -1401,6 +1461,11 @@ func (in *RuntimeCluster) DeepCopyInto(out *RuntimeCluster) {
 		*out = new(Volume)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Workers != nil {
+		in, out := &in.Workers, &out.Workers
+		*out = new(RuntimeWorkers)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1440,6 +1505,125 @@ func (in *RuntimeNetworking) DeepCopy() *RuntimeNetworking {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuntimeWorkerMachineImage) DeepCopyInto(out *RuntimeWorkerMachineImage) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuntimeWorkerMachineImage.
+func (in *RuntimeWorkerMachineImage) DeepCopy() *RuntimeWorkerMachineImage {
+	if in == nil {
+		return nil
+	}
+	out := new(RuntimeWorkerMachineImage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuntimeWorkerPool) DeepCopyInto(out *RuntimeWorkerPool) {
+	*out = *in
+	out.MachineImage = in.MachineImage
+	if in.Architecture != nil {
+		in, out := &in.Architecture, &out.Architecture
+		*out = new(string)
+		**out = **in
+	}
+	if in.MaxSurge != nil {
+		in, out := &in.MaxSurge, &out.MaxSurge
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.Zones != nil {
+		in, out := &in.Zones, &out.Zones
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Taints != nil {
+		in, out := &in.Taints, &out.Taints
+		*out = make([]corev1.Taint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Volume != nil {
+		in, out := &in.Volume, &out.Volume
+		*out = new(v1beta1.Volume)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProviderConfig != nil {
+		in, out := &in.ProviderConfig, &out.ProviderConfig
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	in.UserDataSecretRef.DeepCopyInto(&out.UserDataSecretRef)
+	if in.MachineControllerManagerSettings != nil {
+		in, out := &in.MachineControllerManagerSettings, &out.MachineControllerManagerSettings
+		*out = new(v1beta1.MachineControllerManagerSettings)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuntimeWorkerPool.
+func (in *RuntimeWorkerPool) DeepCopy() *RuntimeWorkerPool {
+	if in == nil {
+		return nil
+	}
+	out := new(RuntimeWorkerPool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuntimeWorkers) DeepCopyInto(out *RuntimeWorkers) {
+	*out = *in
+	out.SecretRef = in.SecretRef
+	if in.InfrastructureProviderStatus != nil {
+		in, out := &in.InfrastructureProviderStatus, &out.InfrastructureProviderStatus
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Pools != nil {
+		in, out := &in.Pools, &out.Pools
+		*out = make([]RuntimeWorkerPool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuntimeWorkers.
+func (in *RuntimeWorkers) DeepCopy() *RuntimeWorkers {
+	if in == nil {
+		return nil
+	}
+	out := new(RuntimeWorkers)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SNI) DeepCopyInto(out *SNI) {
 	*out = *in
@@ -1592,6 +1776,11 @@ func (in *VirtualCluster) DeepCopyInto(out *VirtualCluster) {
 		*out = new(ETCD)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.EventLogging != nil {
+		in, out := &in.EventLogging, &out.EventLogging
+		*out = new(EventLogging)
+		(*in).DeepCopyInto(*out)
+	}
 	in.Gardener.DeepCopyInto(&out.Gardener)
 	in.Kubernetes.DeepCopyInto(&out.Kubernetes)
 	out.Maintenance = in.Maintenance
@@ -71,6 +71,14 @@ const (
 	// requestor shall sync the token to a secret in the target cluster with the given namespace.
 	TokenRequestorTargetSecretNamespace = "token-requestor.resources.gardener.cloud/target-secret-namespace"
 
+	// ImmutableReferencesConvert is a constant for an annotation on a Deployment, StatefulSet or DaemonSet which
+	// instructs the immutable-references controller to replace all references to mutable ConfigMaps and Secrets in
+	// the pod template with references to immutable copies of them.
+	ImmutableReferencesConvert = "immutable-references.resources.gardener.cloud/convert"
+	// ImmutableReferencesOrigin is a constant for an annotation on a ConfigMap or Secret created by the
+	// immutable-references controller. Its value is the name of the original object the copy was created from.
+	ImmutableReferencesOrigin = "immutable-references.resources.gardener.cloud/origin"
+
 	// ResourceManagerPurpose is a constant for the key in a label describing the purpose of the respective object
 	// reconciled by the resource manager.
 	ResourceManagerPurpose = "resources.gardener.cloud/purpose"
@@ -80,6 +88,9 @@ const (
 	// LabelPurposeTokenInvalidation is a constant for a label value indicating that this secret should be considered by
 	// the token-invalidator.
 	LabelPurposeTokenInvalidation = "token-invalidator"
+	// LabelPurposeNamespaceDefaults is a constant for a label value indicating that this LimitRange, ResourceQuota or
+	// NetworkPolicy is managed by the namespace-defaults controller.
+	LabelPurposeNamespaceDefaults = "namespace-defaults"
 	// ResourceManagerClass is a constant for the key in a label describing the class of the respective object. This can
 	// be used to differentiate between multiple instances of the same controller (e.g., token-requestor).
 	ResourceManagerClass = "resources.gardener.cloud/class"
@@ -273,6 +284,21 @@ type ManagedResourceStatus struct {
 	// SecretsDataChecksum is the checksum of referenced secrets data.
 	// +optional
 	SecretsDataChecksum *string `json:"secretsDataChecksum,omitempty"`
+	// ResourcesApplied contains a summary of the objects applied during the last successful reconciliation.
+	// +optional
+	ResourcesApplied *ResourcesAppliedSummary `json:"resourcesApplied,omitempty"`
+}
+
+// ResourcesAppliedSummary contains a summary of the objects applied during a reconciliation of a ManagedResource.
+type ResourcesAppliedSummary struct {
+	// Created is the number of objects which have been created.
+	Created int32 `json:"created"`
+	// Updated is the number of objects which have been updated.
+	Updated int32 `json:"updated"`
+	// Unchanged is the number of objects which did not need to be created or updated.
+	Unchanged int32 `json:"unchanged"`
+	// Deleted is the number of objects which have been deleted because they are no longer part of the ManagedResource.
+	Deleted int32 `json:"deleted"`
 }
 
 // ObjectReference is a reference to another object.
@@ -163,6 +163,11 @@ func (in *ManagedResourceStatus) DeepCopyInto(out *ManagedResourceStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.ResourcesApplied != nil {
+		in, out := &in.ResourcesApplied, &out.ResourcesApplied
+		*out = new(ResourcesAppliedSummary)
+		**out = **in
+	}
 	return
 }
 
@@ -206,3 +211,19 @@ func (in *ObjectReference) DeepCopy() *ObjectReference {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourcesAppliedSummary) DeepCopyInto(out *ResourcesAppliedSummary) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourcesAppliedSummary.
+func (in *ResourcesAppliedSummary) DeepCopy() *ResourcesAppliedSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourcesAppliedSummary)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -62,6 +62,8 @@ type GardenletSelfDeployment struct {
 	// ComponentImageVectorOverwrite is the component image vector overwrite for the components deployed by this
 	// gardenlet.
 	ComponentImageVectorOverwrite *string
+	// Registry contains settings for pulling the gardenlet Helm chart and container image in restricted networks.
+	Registry *GardenletRegistry
 }
 
 // GardenletHelm is the Helm deployment configuration for gardenlet.
@@ -70,6 +72,38 @@ type GardenletHelm struct {
 	OCIRepository gardencore.OCIRepository
 }
 
+// GardenletRegistry contains settings for pulling the gardenlet Helm chart and container image in restricted networks.
+type GardenletRegistry struct {
+	// Mirrors is a list of mirrors for OCI registries. References to the gardenlet Helm chart and container image
+	// which are hosted in a source registry are rewritten to the respective mirror.
+	Mirrors []RegistryMirror
+	// PullSecretRef is a reference to a secret of type `kubernetes.io/dockerconfigjson` in the namespace of the
+	// Gardenlet which contains the credentials for pulling the gardenlet Helm chart.
+	PullSecretRef *corev1.LocalObjectReference
+	// Proxy contains the settings of the proxy used for pulling the gardenlet Helm chart. They are also passed to the
+	// gardenlet container via the `HTTP_PROXY`, `HTTPS_PROXY` and `NO_PROXY` environment variables.
+	Proxy *ProxyConfiguration
+}
+
+// RegistryMirror specifies a mirror for an OCI registry.
+type RegistryMirror struct {
+	// Source is the host (and optional port) of the mirrored registry, e.g. `europe-docker.pkg.dev`.
+	Source string
+	// Mirror is the host (and optional port) of the mirror with an optional path prefix, e.g.
+	// `registry.example.com/gardener`.
+	Mirror string
+}
+
+// ProxyConfiguration contains proxy settings.
+type ProxyConfiguration struct {
+	// HTTPProxy is the proxy used for HTTP requests.
+	HTTPProxy *string
+	// HTTPSProxy is the proxy used for HTTPS requests.
+	HTTPSProxy *string
+	// NoProxy is a comma-separated list of hosts for which no proxy is used.
+	NoProxy *string
+}
+
 // GardenletStatus is the status of a Gardenlet.
 type GardenletStatus struct {
 	// Conditions represents the latest available observations of a Gardenlet's current state.
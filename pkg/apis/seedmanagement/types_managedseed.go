@@ -8,6 +8,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	gardencore "github.com/gardener/gardener/pkg/apis/core"
 )
@@ -53,6 +54,20 @@ type ManagedSeedSpec struct {
 	// Gardenlet specifies that the ManagedSeed controller should deploy a gardenlet into the cluster
 	// with the given deployment parameters and GardenletConfiguration.
 	Gardenlet GardenletConfig
+	// Maintenance contains settings for coordinating the maintenance of the ManagedSeed's shoot with the shoots whose
+	// control planes are hosted on the seed.
+	Maintenance *ManagedSeedMaintenance
+}
+
+// ManagedSeedMaintenance contains settings for coordinating the maintenance of the ManagedSeed's shoot with the shoots
+// whose control planes are hosted on the seed.
+type ManagedSeedMaintenance struct {
+	// MaxUnavailableControlPlanes is the maximum number or percentage of hosted shoot control planes which may be
+	// unavailable when the maintenance of the ManagedSeed's shoot is started. Maintenance operations roll the nodes of the
+	// seed and thereby drain control plane pods, hence they are postponed while more control planes are unavailable.
+	// Postponed maintenance operations are retried within the maintenance time window of the ManagedSeed's shoot.
+	// If not set, the maintenance is not coordinated with the hosted shoots.
+	MaxUnavailableControlPlanes *intstr.IntOrString
 }
 
 // Shoot identifies the Shoot that should be registered as Seed.
@@ -112,6 +127,8 @@ type Image struct {
 	// PullPolicy is the image pull policy. One of Always, Never, IfNotPresent.
 	// Defaults to Always if latest tag is specified, or IfNotPresent otherwise.
 	PullPolicy *corev1.PullPolicy
+	// Digest is the image digest, takes precedence over tag. The value should be in the format 'sha256:<HASH>'.
+	Digest *string
 }
 
 // Bootstrap describes a mechanism for bootstrapping gardenlet connection to the Garden cluster.
@@ -142,4 +159,7 @@ const (
 	// ManagedSeedSeedRegistered is a condition type for indicating whether the ManagedSeed's seed has been registered,
 	// either directly or by deploying gardenlet into the shoot.
 	ManagedSeedSeedRegistered gardencore.ConditionType = "SeedRegistered"
+	// ManagedSeedPreflightChecksPassed is a condition type for indicating whether the ManagedSeed's shoot meets the
+	// requirements of a seed.
+	ManagedSeedPreflightChecksPassed gardencore.ConditionType = "PreflightChecksPassed"
 )
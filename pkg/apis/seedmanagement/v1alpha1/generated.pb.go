@@ -23,6 +23,8 @@ import (
 	math_bits "math/bits"
 	reflect "reflect"
 	strings "strings"
+
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -176,10 +178,38 @@ func (m *GardenletList) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GardenletList proto.InternalMessageInfo
 
+func (m *GardenletRegistry) Reset()      { *m = GardenletRegistry{} }
+func (*GardenletRegistry) ProtoMessage() {}
+func (*GardenletRegistry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d64c05a219673fe5, []int{5}
+}
+func (m *GardenletRegistry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GardenletRegistry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *GardenletRegistry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GardenletRegistry.Merge(m, src)
+}
+func (m *GardenletRegistry) XXX_Size() int {
+	return m.Size()
+}
+func (m *GardenletRegistry) XXX_DiscardUnknown() {
+	xxx_messageInfo_GardenletRegistry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GardenletRegistry proto.InternalMessageInfo
+
 func (m *GardenletSelfDeployment) Reset()      { *m = GardenletSelfDeployment{} }
 func (*GardenletSelfDeployment) ProtoMessage() {}
 func (*GardenletSelfDeployment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d64c05a219673fe5, []int{5}
+	return fileDescriptor_d64c05a219673fe5, []int{6}
 }
 func (m *GardenletSelfDeployment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -207,7 +237,7 @@ var xxx_messageInfo_GardenletSelfDeployment proto.InternalMessageInfo
 func (m *GardenletSpec) Reset()      { *m = GardenletSpec{} }
 func (*GardenletSpec) ProtoMessage() {}
 func (*GardenletSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d64c05a219673fe5, []int{6}
+	return fileDescriptor_d64c05a219673fe5, []int{7}
 }
 func (m *GardenletSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -235,7 +265,7 @@ var xxx_messageInfo_GardenletSpec proto.InternalMessageInfo
 func (m *GardenletStatus) Reset()      { *m = GardenletStatus{} }
 func (*GardenletStatus) ProtoMessage() {}
 func (*GardenletStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d64c05a219673fe5, []int{7}
+	return fileDescriptor_d64c05a219673fe5, []int{8}
 }
 func (m *GardenletStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -263,7 +293,7 @@ var xxx_messageInfo_GardenletStatus proto.InternalMessageInfo
 func (m *Image) Reset()      { *m = Image{} }
 func (*Image) ProtoMessage() {}
 func (*Image) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d64c05a219673fe5, []int{8}
+	return fileDescriptor_d64c05a219673fe5, []int{9}
 }
 func (m *Image) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -291,7 +321,7 @@ var xxx_messageInfo_Image proto.InternalMessageInfo
 func (m *ManagedSeed) Reset()      { *m = ManagedSeed{} }
 func (*ManagedSeed) ProtoMessage() {}
 func (*ManagedSeed) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d64c05a219673fe5, []int{9}
+	return fileDescriptor_d64c05a219673fe5, []int{10}
 }
 func (m *ManagedSeed) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -319,7 +349,7 @@ var xxx_messageInfo_ManagedSeed proto.InternalMessageInfo
 func (m *ManagedSeedList) Reset()      { *m = ManagedSeedList{} }
 func (*ManagedSeedList) ProtoMessage() {}
 func (*ManagedSeedList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d64c05a219673fe5, []int{10}
+	return fileDescriptor_d64c05a219673fe5, []int{11}
 }
 func (m *ManagedSeedList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -344,10 +374,38 @@ func (m *ManagedSeedList) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ManagedSeedList proto.InternalMessageInfo
 
+func (m *ManagedSeedMaintenance) Reset()      { *m = ManagedSeedMaintenance{} }
+func (*ManagedSeedMaintenance) ProtoMessage() {}
+func (*ManagedSeedMaintenance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d64c05a219673fe5, []int{12}
+}
+func (m *ManagedSeedMaintenance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ManagedSeedMaintenance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ManagedSeedMaintenance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ManagedSeedMaintenance.Merge(m, src)
+}
+func (m *ManagedSeedMaintenance) XXX_Size() int {
+	return m.Size()
+}
+func (m *ManagedSeedMaintenance) XXX_DiscardUnknown() {
+	xxx_messageInfo_ManagedSeedMaintenance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ManagedSeedMaintenance proto.InternalMessageInfo
+
 func (m *ManagedSeedSet) Reset()      { *m = ManagedSeedSet{} }
 func (*ManagedSeedSet) ProtoMessage() {}
 func (*ManagedSeedSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d64c05a219673fe5, []int{11}
+	return fileDescriptor_d64c05a219673fe5, []int{13}
 }
 func (m *ManagedSeedSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -375,7 +433,7 @@ var xxx_messageInfo_ManagedSeedSet proto.InternalMessageInfo
 func (m *ManagedSeedSetList) Reset()      { *m = ManagedSeedSetList{} }
 func (*ManagedSeedSetList) ProtoMessage() {}
 func (*ManagedSeedSetList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d64c05a219673fe5, []int{12}
+	return fileDescriptor_d64c05a219673fe5, []int{14}
 }
 func (m *ManagedSeedSetList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -403,7 +461,7 @@ var xxx_messageInfo_ManagedSeedSetList proto.InternalMessageInfo
 func (m *ManagedSeedSetSpec) Reset()      { *m = ManagedSeedSetSpec{} }
 func (*ManagedSeedSetSpec) ProtoMessage() {}
 func (*ManagedSeedSetSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d64c05a219673fe5, []int{13}
+	return fileDescriptor_d64c05a219673fe5, []int{15}
 }
 func (m *ManagedSeedSetSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -431,7 +489,7 @@ var xxx_messageInfo_ManagedSeedSetSpec proto.InternalMessageInfo
 func (m *ManagedSeedSetStatus) Reset()      { *m = ManagedSeedSetStatus{} }
 func (*ManagedSeedSetStatus) ProtoMessage() {}
 func (*ManagedSeedSetStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d64c05a219673fe5, []int{14}
+	return fileDescriptor_d64c05a219673fe5, []int{16}
 }
 func (m *ManagedSeedSetStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -459,7 +517,7 @@ var xxx_messageInfo_ManagedSeedSetStatus proto.InternalMessageInfo
 func (m *ManagedSeedSpec) Reset()      { *m = ManagedSeedSpec{} }
 func (*ManagedSeedSpec) ProtoMessage() {}
 func (*ManagedSeedSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d64c05a219673fe5, []int{15}
+	return fileDescriptor_d64c05a219673fe5, []int{17}
 }
 func (m *ManagedSeedSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -487,7 +545,7 @@ var xxx_messageInfo_ManagedSeedSpec proto.InternalMessageInfo
 func (m *ManagedSeedStatus) Reset()      { *m = ManagedSeedStatus{} }
 func (*ManagedSeedStatus) ProtoMessage() {}
 func (*ManagedSeedStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d64c05a219673fe5, []int{16}
+	return fileDescriptor_d64c05a219673fe5, []int{18}
 }
 func (m *ManagedSeedStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -515,7 +573,7 @@ var xxx_messageInfo_ManagedSeedStatus proto.InternalMessageInfo
 func (m *ManagedSeedTemplate) Reset()      { *m = ManagedSeedTemplate{} }
 func (*ManagedSeedTemplate) ProtoMessage() {}
 func (*ManagedSeedTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d64c05a219673fe5, []int{17}
+	return fileDescriptor_d64c05a219673fe5, []int{19}
 }
 func (m *ManagedSeedTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -543,7 +601,7 @@ var xxx_messageInfo_ManagedSeedTemplate proto.InternalMessageInfo
 func (m *PendingReplica) Reset()      { *m = PendingReplica{} }
 func (*PendingReplica) ProtoMessage() {}
 func (*PendingReplica) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d64c05a219673fe5, []int{18}
+	return fileDescriptor_d64c05a219673fe5, []int{20}
 }
 func (m *PendingReplica) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -568,10 +626,66 @@ func (m *PendingReplica) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PendingReplica proto.InternalMessageInfo
 
+func (m *ProxyConfiguration) Reset()      { *m = ProxyConfiguration{} }
+func (*ProxyConfiguration) ProtoMessage() {}
+func (*ProxyConfiguration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d64c05a219673fe5, []int{21}
+}
+func (m *ProxyConfiguration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProxyConfiguration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ProxyConfiguration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProxyConfiguration.Merge(m, src)
+}
+func (m *ProxyConfiguration) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProxyConfiguration) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProxyConfiguration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProxyConfiguration proto.InternalMessageInfo
+
+func (m *RegistryMirror) Reset()      { *m = RegistryMirror{} }
+func (*RegistryMirror) ProtoMessage() {}
+func (*RegistryMirror) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d64c05a219673fe5, []int{22}
+}
+func (m *RegistryMirror) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegistryMirror) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *RegistryMirror) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegistryMirror.Merge(m, src)
+}
+func (m *RegistryMirror) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegistryMirror) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegistryMirror.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegistryMirror proto.InternalMessageInfo
+
 func (m *RollingUpdateStrategy) Reset()      { *m = RollingUpdateStrategy{} }
 func (*RollingUpdateStrategy) ProtoMessage() {}
 func (*RollingUpdateStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d64c05a219673fe5, []int{19}
+	return fileDescriptor_d64c05a219673fe5, []int{23}
 }
 func (m *RollingUpdateStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -599,7 +713,7 @@ var xxx_messageInfo_RollingUpdateStrategy proto.InternalMessageInfo
 func (m *Shoot) Reset()      { *m = Shoot{} }
 func (*Shoot) ProtoMessage() {}
 func (*Shoot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d64c05a219673fe5, []int{20}
+	return fileDescriptor_d64c05a219673fe5, []int{24}
 }
 func (m *Shoot) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -627,7 +741,7 @@ var xxx_messageInfo_Shoot proto.InternalMessageInfo
 func (m *UpdateStrategy) Reset()      { *m = UpdateStrategy{} }
 func (*UpdateStrategy) ProtoMessage() {}
 func (*UpdateStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d64c05a219673fe5, []int{21}
+	return fileDescriptor_d64c05a219673fe5, []int{25}
 }
 func (m *UpdateStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -660,12 +774,14 @@ func init() {
 	proto.RegisterMapType((map[string]string)(nil), "github.com.gardener.gardener.pkg.apis.seedmanagement.v1alpha1.GardenletDeployment.PodLabelsEntry")
 	proto.RegisterType((*GardenletHelm)(nil), "github.com.gardener.gardener.pkg.apis.seedmanagement.v1alpha1.GardenletHelm")
 	proto.RegisterType((*GardenletList)(nil), "github.com.gardener.gardener.pkg.apis.seedmanagement.v1alpha1.GardenletList")
+	proto.RegisterType((*GardenletRegistry)(nil), "github.com.gardener.gardener.pkg.apis.seedmanagement.v1alpha1.GardenletRegistry")
 	proto.RegisterType((*GardenletSelfDeployment)(nil), "github.com.gardener.gardener.pkg.apis.seedmanagement.v1alpha1.GardenletSelfDeployment")
 	proto.RegisterType((*GardenletSpec)(nil), "github.com.gardener.gardener.pkg.apis.seedmanagement.v1alpha1.GardenletSpec")
 	proto.RegisterType((*GardenletStatus)(nil), "github.com.gardener.gardener.pkg.apis.seedmanagement.v1alpha1.GardenletStatus")
 	proto.RegisterType((*Image)(nil), "github.com.gardener.gardener.pkg.apis.seedmanagement.v1alpha1.Image")
 	proto.RegisterType((*ManagedSeed)(nil), "github.com.gardener.gardener.pkg.apis.seedmanagement.v1alpha1.ManagedSeed")
 	proto.RegisterType((*ManagedSeedList)(nil), "github.com.gardener.gardener.pkg.apis.seedmanagement.v1alpha1.ManagedSeedList")
+	proto.RegisterType((*ManagedSeedMaintenance)(nil), "github.com.gardener.gardener.pkg.apis.seedmanagement.v1alpha1.ManagedSeedMaintenance")
 	proto.RegisterType((*ManagedSeedSet)(nil), "github.com.gardener.gardener.pkg.apis.seedmanagement.v1alpha1.ManagedSeedSet")
 	proto.RegisterType((*ManagedSeedSetList)(nil), "github.com.gardener.gardener.pkg.apis.seedmanagement.v1alpha1.ManagedSeedSetList")
 	proto.RegisterType((*ManagedSeedSetSpec)(nil), "github.com.gardener.gardener.pkg.apis.seedmanagement.v1alpha1.ManagedSeedSetSpec")
@@ -674,6 +790,8 @@ func init() {
 	proto.RegisterType((*ManagedSeedStatus)(nil), "github.com.gardener.gardener.pkg.apis.seedmanagement.v1alpha1.ManagedSeedStatus")
 	proto.RegisterType((*ManagedSeedTemplate)(nil), "github.com.gardener.gardener.pkg.apis.seedmanagement.v1alpha1.ManagedSeedTemplate")
 	proto.RegisterType((*PendingReplica)(nil), "github.com.gardener.gardener.pkg.apis.seedmanagement.v1alpha1.PendingReplica")
+	proto.RegisterType((*ProxyConfiguration)(nil), "github.com.gardener.gardener.pkg.apis.seedmanagement.v1alpha1.ProxyConfiguration")
+	proto.RegisterType((*RegistryMirror)(nil), "github.com.gardener.gardener.pkg.apis.seedmanagement.v1alpha1.RegistryMirror")
 	proto.RegisterType((*RollingUpdateStrategy)(nil), "github.com.gardener.gardener.pkg.apis.seedmanagement.v1alpha1.RollingUpdateStrategy")
 	proto.RegisterType((*Shoot)(nil), "github.com.gardener.gardener.pkg.apis.seedmanagement.v1alpha1.Shoot")
 	proto.RegisterType((*UpdateStrategy)(nil), "github.com.gardener.gardener.pkg.apis.seedmanagement.v1alpha1.UpdateStrategy")
@@ -684,131 +802,152 @@ func init() {
 }
 
 var fileDescriptor_d64c05a219673fe5 = []byte{
-	// 1980 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0xdd, 0x6f, 0x1c, 0x49,
-	0x11, 0xf7, 0xd8, 0x5e, 0xdb, 0x53, 0x8e, 0xed, 0xb8, 0xed, 0x4b, 0xf6, 0x8c, 0xb2, 0x1b, 0x46,
-	0x02, 0x99, 0x8f, 0x9b, 0x25, 0xe1, 0x84, 0xc2, 0x71, 0x3e, 0xc9, 0xe3, 0x84, 0x24, 0x87, 0x1d,
-	0x9b, 0x5e, 0x3b, 0x48, 0x88, 0x07, 0x7a, 0x67, 0xdb, 0xeb, 0xc1, 0xf3, 0x75, 0x33, 0xbd, 0x9b,
-	0xac, 0x90, 0xe0, 0xc4, 0x1b, 0x27, 0x21, 0x21, 0xfe, 0x03, 0x84, 0xc4, 0xdf, 0x92, 0xc7, 0x08,
-	0x81, 0x74, 0x02, 0xb4, 0xba, 0x2c, 0x08, 0x01, 0xff, 0x00, 0x22, 0x0f, 0x08, 0x75, 0x4f, 0xcf,
-	0xe7, 0xce, 0x26, 0x4e, 0xbc, 0xb1, 0x04, 0x6f, 0x3b, 0xf5, 0xf1, 0xab, 0xea, 0xea, 0xea, 0xae,
-	0xaa, 0x5e, 0xd8, 0xeb, 0x58, 0xec, 0xa4, 0xdb, 0xd2, 0x4d, 0xcf, 0x69, 0x74, 0x48, 0xd0, 0xa6,
-	0x2e, 0x0d, 0xd2, 0x1f, 0xfe, 0x69, 0xa7, 0x41, 0x7c, 0x2b, 0x6c, 0x84, 0x94, 0xb6, 0x1d, 0xe2,
-	0x92, 0x0e, 0x75, 0xa8, 0xcb, 0x1a, 0xbd, 0x1b, 0xc4, 0xf6, 0x4f, 0xc8, 0x8d, 0x46, 0x87, 0x8b,
-	0x11, 0x46, 0xdb, 0xba, 0x1f, 0x78, 0xcc, 0x43, 0x5b, 0x29, 0x9c, 0x1e, 0xa3, 0xa4, 0x3f, 0xfc,
-	0xd3, 0x8e, 0xce, 0xe1, 0xf4, 0x3c, 0x9c, 0x1e, 0xc3, 0x6d, 0x6c, 0x9d, 0xcd, 0x1b, 0xd3, 0x0b,
-	0x68, 0xa3, 0x37, 0x62, 0x7d, 0xc3, 0x78, 0x25, 0xf5, 0x16, 0x65, 0xa3, 0x2b, 0xd8, 0x78, 0x27,
-	0x8b, 0xe1, 0x75, 0xbc, 0x86, 0x20, 0xb7, 0xba, 0xc7, 0xe2, 0x4b, 0x7c, 0x88, 0x5f, 0x52, 0x5c,
-	0x3b, 0xbd, 0x15, 0xea, 0x96, 0xc7, 0x81, 0xc7, 0xba, 0xf5, 0x6e, 0x2a, 0xe3, 0x10, 0xf3, 0xc4,
-	0x72, 0x69, 0xd0, 0x4f, 0xbd, 0x71, 0x28, 0x23, 0x65, 0x5a, 0x8d, 0x71, 0x5a, 0x41, 0xd7, 0x65,
-	0x96, 0x43, 0x47, 0x14, 0xbe, 0xf1, 0x32, 0x85, 0xd0, 0x3c, 0xa1, 0x0e, 0x29, 0xea, 0x69, 0x7f,
-	0x98, 0x06, 0xf5, 0xae, 0x08, 0x92, 0x4d, 0x19, 0xfa, 0x21, 0x2c, 0x70, 0x8f, 0xda, 0x84, 0x91,
-	0xaa, 0x72, 0x5d, 0xd9, 0x5c, 0xbc, 0xf9, 0x35, 0x3d, 0x02, 0xd6, 0xb3, 0xc0, 0xe9, 0x5e, 0x72,
-	0x69, 0xbd, 0x77, 0x43, 0xdf, 0x6f, 0xfd, 0x88, 0x9a, 0x6c, 0x8f, 0x32, 0x62, 0xa0, 0x27, 0x83,
-	0xfa, 0xd4, 0x70, 0x50, 0x87, 0x94, 0x86, 0x13, 0x54, 0xe4, 0xc2, 0x6c, 0xe8, 0x53, 0xb3, 0x3a,
-	0x2d, 0xd0, 0x77, 0xf5, 0x73, 0xa5, 0x8c, 0x9e, 0x78, 0xde, 0xf4, 0xa9, 0x69, 0x5c, 0x92, 0x96,
-	0x67, 0xf9, 0x17, 0x16, 0x76, 0x50, 0x0f, 0xe6, 0x42, 0x46, 0x58, 0x37, 0xac, 0xce, 0x08, 0x8b,
-	0x0f, 0x26, 0x66, 0x51, 0xa0, 0x1a, 0xcb, 0xd2, 0xe6, 0x5c, 0xf4, 0x8d, 0xa5, 0x35, 0xed, 0x6f,
-	0xd3, 0xb0, 0x92, 0xc8, 0xee, 0x78, 0xee, 0xb1, 0xd5, 0x41, 0x3f, 0x53, 0x00, 0xda, 0xd4, 0xb7,
-	0xbd, 0x3e, 0xc7, 0x94, 0x01, 0xc6, 0x93, 0x72, 0xe8, 0x76, 0x82, 0x6c, 0x2c, 0xf3, 0xf0, 0xa7,
-	0xdf, 0x38, 0x63, 0x15, 0x1d, 0xc1, 0x9c, 0x29, 0xdc, 0x91, 0x5b, 0xf0, 0xce, 0xd8, 0x0d, 0x96,
-	0x99, 0xa3, 0x63, 0xf2, 0xe8, 0xce, 0x63, 0x46, 0xdd, 0xd0, 0xf2, 0xdc, 0x74, 0xbd, 0xd1, 0x9a,
-	0xb0, 0x04, 0x43, 0xb7, 0x40, 0x6d, 0x79, 0x1e, 0x0b, 0x59, 0x40, 0x7c, 0x11, 0x6a, 0xd5, 0xd8,
-	0x18, 0x0e, 0xea, 0xaa, 0x11, 0x13, 0x9f, 0x67, 0x3f, 0x70, 0x2a, 0x8c, 0xb6, 0x60, 0xc5, 0xa1,
-	0x41, 0x87, 0x7e, 0xcf, 0x62, 0x27, 0x07, 0x24, 0xe0, 0x91, 0x99, 0xbd, 0xae, 0x6c, 0x2e, 0x18,
-	0x6b, 0xc3, 0x41, 0x7d, 0x65, 0x2f, 0xcf, 0xc2, 0x45, 0x59, 0xed, 0x5f, 0x0b, 0xb0, 0x56, 0x12,
-	0x03, 0xf4, 0x2e, 0x5c, 0x0a, 0xa8, 0x6f, 0x5b, 0x26, 0xd9, 0xf1, 0xba, 0x32, 0xda, 0x15, 0xe3,
-	0xf2, 0x70, 0x50, 0xbf, 0x84, 0x33, 0x74, 0x9c, 0x93, 0x42, 0xbb, 0xb0, 0x1e, 0xd0, 0x9e, 0xc5,
-	0x97, 0x7a, 0xcf, 0x0a, 0x99, 0x17, 0xf4, 0x77, 0x2d, 0xc7, 0x62, 0x22, 0x56, 0x15, 0xa3, 0x3a,
-	0x1c, 0xd4, 0xd7, 0x71, 0x09, 0x1f, 0x97, 0x6a, 0xa1, 0x6f, 0x03, 0x0a, 0x69, 0xd0, 0xb3, 0x4c,
-	0xba, 0x6d, 0x9a, 0x1c, 0xff, 0x01, 0x71, 0xa8, 0x8c, 0xce, 0x95, 0xe1, 0xa0, 0x8e, 0x9a, 0x23,
-	0x5c, 0x5c, 0xa2, 0x81, 0x28, 0x54, 0x2c, 0x87, 0x74, 0xa8, 0x08, 0xcc, 0xe2, 0xcd, 0xdb, 0xe7,
-	0x4c, 0x99, 0xfb, 0x1c, 0xcb, 0x50, 0x87, 0x83, 0x7a, 0x45, 0xfc, 0xc4, 0x11, 0x3a, 0x3a, 0x02,
-	0x35, 0xa0, 0xa1, 0xd7, 0x0d, 0x4c, 0x1a, 0x56, 0x2b, 0xc2, 0xd4, 0x66, 0x26, 0x3b, 0x74, 0x7e,
-	0xc5, 0xf1, 0xc3, 0x8e, 0xa5, 0x10, 0xa6, 0x1f, 0x75, 0xad, 0x40, 0x80, 0x87, 0xc6, 0x12, 0xdf,
-	0xed, 0x98, 0x13, 0xe2, 0x14, 0x09, 0xfd, 0x4a, 0x01, 0xd5, 0xf7, 0xda, 0xbb, 0xa4, 0x45, 0xed,
-	0xb0, 0x3a, 0x77, 0x7d, 0x66, 0x73, 0xf1, 0x26, 0x99, 0x7c, 0xd6, 0xeb, 0x07, 0xb1, 0x8d, 0x3b,
-	0x2e, 0x0b, 0xfa, 0xc6, 0xaa, 0xcc, 0x54, 0x35, 0xa1, 0xe3, 0xd4, 0x0d, 0xf4, 0x5b, 0x05, 0x96,
-	0x7d, 0xaf, 0xbd, 0xed, 0xba, 0x1e, 0x23, 0xcc, 0xf2, 0xdc, 0xb0, 0x3a, 0x2f, 0x3c, 0x3b, 0x7e,
-	0x33, 0x9e, 0x65, 0x0c, 0x45, 0xee, 0x5d, 0x91, 0xee, 0x2d, 0xe7, 0x99, 0xb8, 0xe0, 0x15, 0x32,
-	0x61, 0x95, 0xb4, 0xdb, 0x16, 0xff, 0x20, 0xf6, 0x43, 0xcf, 0xee, 0x3a, 0x34, 0xac, 0x2e, 0x08,
-	0x57, 0x37, 0xca, 0x36, 0x27, 0x12, 0x31, 0xde, 0x96, 0xf0, 0xab, 0xdb, 0x45, 0x65, 0x3c, 0x8a,
-	0x87, 0x1e, 0xc1, 0x95, 0x22, 0x71, 0x8f, 0x67, 0x5f, 0x58, 0x55, 0x85, 0xa5, 0xfa, 0x78, 0x4b,
-	0x42, 0xce, 0xa8, 0x49, 0x73, 0x57, 0xb6, 0x4b, 0x61, 0xf0, 0x18, 0x78, 0xf4, 0x4d, 0x98, 0xa1,
-	0x6e, 0xaf, 0x0a, 0xe3, 0xd7, 0x73, 0xc7, 0xed, 0x3d, 0x24, 0x81, 0xb1, 0x28, 0x0d, 0xcc, 0xdc,
-	0x71, 0x7b, 0x98, 0xeb, 0x6c, 0xbc, 0x0f, 0xcb, 0xf9, 0x1d, 0x47, 0x97, 0x61, 0xe6, 0x94, 0xf6,
-	0xc5, 0x49, 0x57, 0x31, 0xff, 0x89, 0xd6, 0xa1, 0xd2, 0x23, 0x76, 0x97, 0x8a, 0xf3, 0xab, 0xe2,
-	0xe8, 0xe3, 0xbd, 0xe9, 0x5b, 0xca, 0xc6, 0x36, 0xac, 0x95, 0xec, 0xca, 0xab, 0x40, 0x68, 0x9f,
-	0x28, 0xb0, 0x94, 0xec, 0xf6, 0x3d, 0x6a, 0x3b, 0xa8, 0x0f, 0x4b, 0x9e, 0x69, 0x61, 0xea, 0x7b,
-	0xa1, 0xc5, 0x6f, 0x01, 0x79, 0xc5, 0xbf, 0x7f, 0xc6, 0x94, 0x8a, 0x97, 0xbc, 0xbf, 0x73, 0x3f,
-	0xc5, 0x30, 0xde, 0x92, 0x2b, 0x5f, 0xca, 0x91, 0x71, 0xde, 0x92, 0xf6, 0xe7, 0xac, 0x33, 0xbb,
-	0x56, 0xc8, 0xd0, 0x0f, 0x46, 0x6a, 0xb9, 0x7e, 0xb6, 0x5a, 0xce, 0xb5, 0x45, 0x25, 0xbf, 0x2c,
-	0x2d, 0x2f, 0xc4, 0x94, 0x4c, 0x1d, 0x77, 0xa0, 0x62, 0x31, 0xea, 0x84, 0xd5, 0x69, 0xb1, 0x75,
-	0xf7, 0x26, 0x75, 0x6a, 0x8c, 0x25, 0x69, 0xb4, 0x72, 0x9f, 0xc3, 0xe3, 0xc8, 0x8a, 0xf6, 0xd7,
-	0x19, 0xb8, 0x9a, 0x96, 0x5e, 0x6a, 0x1f, 0x67, 0x6e, 0xfa, 0x5f, 0x2b, 0xb0, 0xd6, 0x19, 0x3d,
-	0x75, 0x6f, 0xb0, 0xbe, 0x7e, 0x4e, 0xfa, 0x58, 0x56, 0x78, 0x70, 0x99, 0x2f, 0xbc, 0xed, 0x39,
-	0xa1, 0xb6, 0x33, 0xe9, 0xb6, 0x87, 0x67, 0x5d, 0xda, 0xf6, 0xf0, 0x2f, 0x2c, 0xec, 0xf0, 0x3a,
-	0x26, 0xee, 0xf4, 0x87, 0xd4, 0x64, 0x5e, 0xb0, 0xdf, 0xa3, 0xc1, 0xa3, 0xc0, 0x62, 0x71, 0xed,
-	0x11, 0x75, 0xec, 0x7e, 0x09, 0x1f, 0x97, 0x6a, 0xa1, 0x0e, 0x5c, 0x33, 0x3d, 0xc7, 0xf7, 0x5c,
-	0xea, 0xb2, 0x32, 0x35, 0x51, 0x97, 0x54, 0xe3, 0xf3, 0xc3, 0x41, 0xfd, 0xda, 0xce, 0x8b, 0x04,
-	0xf1, 0x8b, 0x71, 0xb4, 0xbf, 0x4f, 0x67, 0xb2, 0x98, 0x77, 0x71, 0xe8, 0x93, 0xb2, 0x9e, 0xe9,
-	0xe1, 0xc4, 0x9a, 0xb8, 0x5c, 0x26, 0xa5, 0xad, 0xeb, 0xc5, 0xf6, 0x4e, 0x21, 0xac, 0x9d, 0x76,
-	0x5b, 0x34, 0xfa, 0x6a, 0x52, 0x33, 0xa0, 0x0c, 0xd3, 0x63, 0xd9, 0xb0, 0x96, 0x56, 0xe0, 0x5d,
-	0xcf, 0x24, 0x76, 0xd4, 0x5f, 0x63, 0x7a, 0x4c, 0x03, 0xea, 0x9a, 0xd4, 0xb8, 0xca, 0x33, 0xf2,
-	0x3b, 0xa3, 0x40, 0xb8, 0x0c, 0x5d, 0x7b, 0xaa, 0x64, 0x1a, 0xd4, 0xa8, 0x79, 0x45, 0x1f, 0x01,
-	0x98, 0x9e, 0x1b, 0x5d, 0xd4, 0x61, 0x55, 0x11, 0x27, 0x7b, 0xeb, 0xd5, 0x2e, 0x2f, 0x31, 0x57,
-	0xe9, 0x3b, 0x31, 0x4a, 0x1a, 0xd2, 0x84, 0x14, 0xe2, 0x8c, 0x11, 0xf4, 0x21, 0x20, 0xaf, 0xc5,
-	0x5b, 0x1e, 0xda, 0xbe, 0x1b, 0x8d, 0x26, 0x96, 0xe7, 0x8a, 0xf0, 0xce, 0x18, 0x1b, 0x52, 0x17,
-	0xed, 0x8f, 0x48, 0xe0, 0x12, 0x2d, 0xed, 0x37, 0x0a, 0x44, 0x0d, 0x0d, 0xd2, 0x01, 0x82, 0xfc,
-	0x2d, 0xac, 0x46, 0x4d, 0x71, 0xe6, 0x02, 0xcd, 0x48, 0xa0, 0xb7, 0x61, 0x86, 0x91, 0x68, 0x57,
-	0x55, 0x63, 0x9e, 0x97, 0x99, 0x43, 0xd2, 0xc1, 0x9c, 0x86, 0xf6, 0x01, 0xfc, 0xae, 0x6d, 0x1f,
-	0x78, 0xb6, 0x65, 0xf6, 0xe5, 0xf9, 0x69, 0x70, 0xa8, 0x83, 0x84, 0xfa, 0x7c, 0x50, 0xbf, 0x36,
-	0x3a, 0x09, 0xea, 0xa9, 0x00, 0xce, 0x40, 0x68, 0x7f, 0x9a, 0x86, 0xc5, 0x3d, 0x91, 0x94, 0xed,
-	0x26, 0xa5, 0xed, 0x0b, 0x98, 0xb9, 0xfc, 0xdc, 0xcc, 0x75, 0xde, 0x09, 0x28, 0xe3, 0xfb, 0xd8,
-	0xa9, 0xeb, 0x71, 0x61, 0xea, 0x3a, 0x98, 0xa0, 0xcd, 0x17, 0xcf, 0x5d, 0x9f, 0x29, 0xb0, 0x92,
-	0x91, 0xbe, 0x80, 0x4a, 0xe8, 0xe5, 0x2b, 0xe1, 0x87, 0x93, 0x5b, 0xea, 0xb8, 0x5a, 0x38, 0x0d,
-	0xcb, 0xd9, 0x80, 0x5c, 0xc8, 0xdc, 0x1e, 0xe6, 0x72, 0xe8, 0xbb, 0x13, 0xdc, 0xcf, 0x17, 0x0c,
-	0xef, 0x3f, 0x2e, 0xa4, 0x51, 0x73, 0xb2, 0x66, 0x5f, 0x32, 0xc1, 0x2b, 0x80, 0xf2, 0x0a, 0x17,
-	0x90, 0x4c, 0x41, 0x3e, 0x99, 0xf6, 0x26, 0xba, 0xe0, 0x31, 0xf9, 0xf4, 0x9f, 0xd9, 0xe2, 0x42,
-	0x45, 0xe5, 0xdd, 0x84, 0x05, 0x39, 0x1a, 0x87, 0x72, 0x78, 0xbe, 0xc4, 0x9d, 0x96, 0xc3, 0x73,
-	0x88, 0x13, 0x2e, 0x22, 0xb0, 0x10, 0x52, 0x5b, 0x94, 0x72, 0x99, 0x1f, 0x5f, 0x3f, 0x63, 0x48,
-	0x78, 0xf3, 0xde, 0x94, 0xaa, 0x69, 0x5c, 0x62, 0x0a, 0x4e, 0x60, 0xd1, 0xc7, 0x0a, 0x2c, 0x30,
-	0xea, 0xf8, 0x36, 0x91, 0x4d, 0xcc, 0xf9, 0x1b, 0xbb, 0xcc, 0x92, 0x0f, 0x25, 0x72, 0xea, 0x42,
-	0x4c, 0xc1, 0x89, 0x55, 0xf4, 0x13, 0x58, 0x0a, 0x4f, 0x3c, 0x8f, 0xc5, 0x2c, 0x39, 0x8c, 0x6f,
-	0xbf, 0x4e, 0x7d, 0x6c, 0x66, 0x81, 0xd2, 0x0e, 0x3f, 0x47, 0xc6, 0x79, 0x73, 0xe8, 0xe7, 0x0a,
-	0x2c, 0x77, 0xfd, 0x36, 0x61, 0xb4, 0xc9, 0x02, 0xc2, 0x68, 0xa7, 0x2f, 0x67, 0xf4, 0xf3, 0x26,
-	0xc9, 0x51, 0x0e, 0xd4, 0x40, 0x7c, 0x28, 0xcd, 0xd3, 0x70, 0xc1, 0xf0, 0xd8, 0x67, 0x92, 0xb9,
-	0xd7, 0x79, 0x26, 0xd1, 0xfe, 0x38, 0x07, 0xeb, 0x65, 0x47, 0x73, 0x4c, 0x73, 0xa0, 0xbc, 0x4e,
-	0x73, 0x80, 0xbe, 0x9a, 0x49, 0xe7, 0xe8, 0x35, 0x27, 0xd9, 0xec, 0x92, 0x94, 0xfe, 0x16, 0x2c,
-	0x05, 0x94, 0xb4, 0xfb, 0x31, 0x4b, 0xe4, 0x5c, 0x25, 0xdd, 0x29, 0x9c, 0x65, 0xe2, 0xbc, 0x2c,
-	0xba, 0x0b, 0xab, 0x2e, 0x7d, 0xcc, 0xe4, 0xf7, 0x83, 0xae, 0xd3, 0xa2, 0x81, 0xc8, 0x96, 0x4a,
-	0x3a, 0x96, 0x3f, 0x28, 0x0a, 0xe0, 0x51, 0x1d, 0xb4, 0x0d, 0x2b, 0x66, 0x37, 0x10, 0xef, 0x5e,
-	0xb1, 0x1f, 0x15, 0x01, 0x73, 0x55, 0xc2, 0xac, 0xec, 0xe4, 0xd9, 0xb8, 0x28, 0xcf, 0x21, 0xa2,
-	0xbd, 0x6b, 0x27, 0x10, 0x73, 0x79, 0x88, 0xa3, 0x3c, 0x1b, 0x17, 0xe5, 0x73, 0x5e, 0x44, 0xbb,
-	0x57, 0x9d, 0x17, 0x6d, 0xd0, 0xa8, 0x17, 0x11, 0x1b, 0x17, 0xe5, 0xd1, 0x07, 0x71, 0xea, 0x26,
-	0x08, 0x0b, 0xd1, 0x23, 0x58, 0xfc, 0x08, 0x72, 0x94, 0xe3, 0xe2, 0x82, 0x34, 0x7a, 0x0f, 0x96,
-	0x4d, 0xcf, 0xb6, 0xc5, 0x47, 0xf4, 0x9c, 0xa7, 0x8a, 0x45, 0x88, 0x5c, 0xdd, 0xc9, 0x71, 0x70,
-	0x41, 0xb2, 0xd0, 0xd4, 0xc2, 0x45, 0x34, 0xb5, 0xfc, 0xa8, 0xfa, 0xd4, 0x6d, 0x5b, 0x6e, 0x47,
-	0x46, 0xb1, 0xba, 0x38, 0x91, 0xa3, 0x7a, 0x90, 0x03, 0x8d, 0x96, 0x9f, 0xa7, 0xe1, 0x82, 0x61,
-	0xed, 0xdf, 0xf9, 0x86, 0x48, 0x5c, 0xed, 0x14, 0x2a, 0xe2, 0x6e, 0x91, 0x05, 0xec, 0xbc, 0xef,
-	0x89, 0xe2, 0xda, 0x8a, 0xde, 0x13, 0xc5, 0x4f, 0x1c, 0xa1, 0xa3, 0x9f, 0x82, 0x9a, 0xcc, 0xc2,
-	0x93, 0x7e, 0x7e, 0x8f, 0x46, 0xa8, 0xf4, 0x91, 0x2f, 0x61, 0xe0, 0xd4, 0xa6, 0xf6, 0x3b, 0x05,
-	0x56, 0x47, 0x5a, 0xc7, 0xff, 0xf5, 0x29, 0xe7, 0x1f, 0x0a, 0xac, 0x95, 0xd4, 0xae, 0xff, 0xc7,
-	0x39, 0x42, 0xfb, 0xa7, 0x02, 0x85, 0xfc, 0x46, 0xd7, 0x61, 0xd6, 0x25, 0x0e, 0x95, 0x43, 0x5d,
-	0xa2, 0x24, 0xde, 0xce, 0x05, 0x07, 0x7d, 0x00, 0x73, 0x01, 0x25, 0xa1, 0x0c, 0xb0, 0x6a, 0x7c,
-	0x31, 0x6e, 0xf0, 0xb0, 0xa0, 0x3e, 0x1f, 0xd4, 0xd7, 0x0b, 0x67, 0x46, 0xd0, 0xb1, 0xd4, 0x42,
-	0xfb, 0x50, 0x09, 0x2d, 0xd7, 0x8c, 0xfb, 0x8c, 0x2f, 0x9f, 0x2d, 0x8a, 0x87, 0x96, 0x43, 0xd3,
-	0x06, 0xab, 0xc9, 0x01, 0x70, 0x84, 0x83, 0xbe, 0x00, 0xf3, 0x01, 0x65, 0x81, 0x45, 0x43, 0x59,
-	0x05, 0x16, 0x87, 0x83, 0xfa, 0x3c, 0x8e, 0x48, 0x38, 0xe6, 0x69, 0xb7, 0xe1, 0x2d, 0xcc, 0xaf,
-	0x2e, 0xb7, 0x93, 0xaf, 0xbe, 0xe8, 0x2b, 0xa0, 0xfa, 0x24, 0x60, 0x56, 0x52, 0xfd, 0x2a, 0xd1,
-	0x6b, 0xfb, 0x41, 0x4c, 0xc4, 0x29, 0x5f, 0xfb, 0x12, 0x44, 0x87, 0xf0, 0xe5, 0x81, 0xd2, 0x7e,
-	0xaf, 0x40, 0xa1, 0xd0, 0xa3, 0x9b, 0x30, 0xcb, 0xfa, 0x7e, 0xac, 0x54, 0xe3, 0x0a, 0x87, 0x7d,
-	0x9f, 0x3e, 0x1f, 0xd4, 0x51, 0x5e, 0x92, 0x53, 0xb1, 0x90, 0x45, 0xbf, 0x50, 0x60, 0x29, 0xc8,
-	0x3a, 0x2e, 0x13, 0xe4, 0xf0, 0x9c, 0x09, 0x52, 0x1a, 0x0c, 0x63, 0x55, 0x94, 0xdf, 0x2c, 0x0b,
-	0xe7, 0xad, 0x1b, 0xe6, 0x93, 0x67, 0xb5, 0xa9, 0xa7, 0xcf, 0x6a, 0x53, 0x9f, 0x3e, 0xab, 0x4d,
-	0x7d, 0x3c, 0xac, 0x29, 0x4f, 0x86, 0x35, 0xe5, 0xe9, 0xb0, 0xa6, 0x7c, 0x3a, 0xac, 0x29, 0x9f,
-	0x0d, 0x6b, 0xca, 0x2f, 0xff, 0x52, 0x9b, 0xfa, 0xfe, 0xd6, 0xb9, 0xfe, 0xfb, 0xfe, 0x6f, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0x13, 0x58, 0xd0, 0xd5, 0x3b, 0x1f, 0x00, 0x00,
+	// 2306 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5a, 0x5b, 0x6f, 0x1c, 0x49,
+	0x15, 0x4e, 0xdb, 0x1e, 0xdb, 0x73, 0x1c, 0xdb, 0x71, 0x39, 0x9b, 0xcc, 0x3a, 0xca, 0x4c, 0x68,
+	0x89, 0x55, 0xb8, 0x6c, 0x9b, 0x64, 0x57, 0x28, 0x2c, 0x9b, 0x95, 0xdc, 0x4e, 0x48, 0xb2, 0xd8,
+	0xf1, 0x6c, 0x8d, 0x1d, 0x24, 0xc4, 0xc3, 0x96, 0x7b, 0xca, 0xe3, 0x26, 0x7d, 0xdb, 0xea, 0x9a,
+	0x89, 0x07, 0x24, 0x58, 0x21, 0x1e, 0x60, 0x25, 0x24, 0x04, 0x2f, 0xbc, 0xf2, 0xc2, 0x23, 0x7f,
+	0x83, 0x3c, 0x46, 0x08, 0xa4, 0x15, 0xa0, 0x51, 0x32, 0x20, 0x04, 0xfc, 0x01, 0xa4, 0x3c, 0x20,
+	0x54, 0x97, 0xbe, 0xcd, 0x25, 0x37, 0xcf, 0x5a, 0x82, 0xb7, 0xae, 0x73, 0xf9, 0xce, 0xa9, 0xaa,
+	0x53, 0x75, 0x4e, 0x9d, 0x19, 0xd8, 0x6e, 0xb9, 0xfc, 0xb0, 0xbd, 0x6f, 0x39, 0xa1, 0xbf, 0xde,
+	0x22, 0xac, 0x49, 0x03, 0xca, 0xb2, 0x8f, 0xe8, 0x7e, 0x6b, 0x9d, 0x44, 0x6e, 0xbc, 0x1e, 0x53,
+	0xda, 0xf4, 0x49, 0x40, 0x5a, 0xd4, 0xa7, 0x01, 0x5f, 0xef, 0x5c, 0x21, 0x5e, 0x74, 0x48, 0xae,
+	0xac, 0xb7, 0x84, 0x18, 0xe1, 0xb4, 0x69, 0x45, 0x2c, 0xe4, 0x21, 0xba, 0x9e, 0xc1, 0x59, 0x09,
+	0x4a, 0xf6, 0x11, 0xdd, 0x6f, 0x59, 0x02, 0xce, 0x2a, 0xc2, 0x59, 0x09, 0xdc, 0xda, 0xf5, 0x17,
+	0xf3, 0xc6, 0x09, 0x19, 0x5d, 0xef, 0x0c, 0x59, 0x5f, 0xb3, 0x5f, 0x4a, 0x7d, 0x9f, 0xf2, 0xe1,
+	0x19, 0xac, 0xbd, 0x99, 0xc7, 0x08, 0x5b, 0xe1, 0xba, 0x24, 0xef, 0xb7, 0x0f, 0xe4, 0x48, 0x0e,
+	0xe4, 0x97, 0x16, 0x37, 0xef, 0x5f, 0x8b, 0x2d, 0x37, 0x14, 0xc0, 0x63, 0xdd, 0x7a, 0x3b, 0x93,
+	0xf1, 0x89, 0x73, 0xe8, 0x06, 0x94, 0x75, 0x33, 0x6f, 0x7c, 0xca, 0xc9, 0x28, 0xad, 0xf5, 0x71,
+	0x5a, 0xac, 0x1d, 0x70, 0xd7, 0xa7, 0x43, 0x0a, 0x5f, 0x7d, 0x9e, 0x42, 0xec, 0x1c, 0x52, 0x9f,
+	0x0c, 0xe9, 0xbd, 0x35, 0x4e, 0xaf, 0xcd, 0x5d, 0x6f, 0xdd, 0x0d, 0x78, 0xcc, 0xd9, 0xa0, 0x92,
+	0xf9, 0xc7, 0x29, 0x28, 0xdf, 0x92, 0x2b, 0xeb, 0x51, 0x8e, 0x3e, 0x84, 0x79, 0x31, 0x8d, 0x26,
+	0xe1, 0xa4, 0x62, 0x5c, 0x32, 0x2e, 0x2f, 0x5c, 0xfd, 0x8a, 0xa5, 0x50, 0xad, 0x3c, 0x6a, 0x16,
+	0x00, 0x42, 0xda, 0xea, 0x5c, 0xb1, 0x76, 0xf6, 0xbf, 0x4b, 0x1d, 0xbe, 0x4d, 0x39, 0xb1, 0xd1,
+	0xc3, 0x5e, 0xed, 0x54, 0xbf, 0x57, 0x83, 0x8c, 0x86, 0x53, 0x54, 0x14, 0xc0, 0x4c, 0x1c, 0x51,
+	0xa7, 0x32, 0x25, 0xd1, 0xb7, 0xac, 0x63, 0xc5, 0x99, 0x95, 0x7a, 0xde, 0x88, 0xa8, 0x63, 0x9f,
+	0xd6, 0x96, 0x67, 0xc4, 0x08, 0x4b, 0x3b, 0xa8, 0x03, 0xb3, 0x31, 0x27, 0xbc, 0x1d, 0x57, 0xa6,
+	0xa5, 0xc5, 0xbb, 0x13, 0xb3, 0x28, 0x51, 0xed, 0x25, 0x6d, 0x73, 0x56, 0x8d, 0xb1, 0xb6, 0x66,
+	0xfe, 0x7d, 0x0a, 0x96, 0x53, 0xd9, 0xcd, 0x30, 0x38, 0x70, 0x5b, 0xe8, 0x47, 0x06, 0x40, 0x93,
+	0x46, 0x5e, 0xd8, 0x15, 0x98, 0x7a, 0x81, 0xf1, 0xa4, 0x1c, 0xba, 0x91, 0x22, 0xdb, 0x4b, 0x62,
+	0xf9, 0xb3, 0x31, 0xce, 0x59, 0x45, 0x7b, 0x30, 0xeb, 0x48, 0x77, 0xf4, 0x16, 0xbc, 0x39, 0x76,
+	0x83, 0x75, 0xb8, 0x59, 0x98, 0x3c, 0xb8, 0x79, 0xc4, 0x69, 0x10, 0xbb, 0x61, 0x90, 0xcd, 0x57,
+	0xcd, 0x09, 0x6b, 0x30, 0x74, 0x0d, 0xca, 0xfb, 0x61, 0x28, 0x42, 0x8c, 0x44, 0x72, 0xa9, 0xcb,
+	0xf6, 0x5a, 0xbf, 0x57, 0x2b, 0xdb, 0x09, 0xf1, 0x69, 0x7e, 0x80, 0x33, 0x61, 0x74, 0x1d, 0x96,
+	0x7d, 0xca, 0x5a, 0xf4, 0x5b, 0x2e, 0x3f, 0xac, 0x13, 0x26, 0x56, 0x66, 0xe6, 0x92, 0x71, 0x79,
+	0xde, 0x5e, 0xed, 0xf7, 0x6a, 0xcb, 0xdb, 0x45, 0x16, 0x1e, 0x94, 0x35, 0xff, 0x3d, 0x0f, 0xab,
+	0x23, 0xd6, 0x00, 0xbd, 0x0d, 0xa7, 0x19, 0x8d, 0x3c, 0xd7, 0x21, 0x9b, 0x61, 0x5b, 0xaf, 0x76,
+	0xc9, 0x3e, 0xd3, 0xef, 0xd5, 0x4e, 0xe3, 0x1c, 0x1d, 0x17, 0xa4, 0xd0, 0x16, 0x9c, 0x65, 0xb4,
+	0xe3, 0x8a, 0xa9, 0xde, 0x76, 0x63, 0x1e, 0xb2, 0xee, 0x96, 0xeb, 0xbb, 0x5c, 0xae, 0x55, 0xc9,
+	0xae, 0xf4, 0x7b, 0xb5, 0xb3, 0x78, 0x04, 0x1f, 0x8f, 0xd4, 0x42, 0xdf, 0x00, 0x14, 0x53, 0xd6,
+	0x71, 0x1d, 0xba, 0xe1, 0x38, 0x02, 0xff, 0x2e, 0xf1, 0xa9, 0x5e, 0x9d, 0x73, 0xfd, 0x5e, 0x0d,
+	0x35, 0x86, 0xb8, 0x78, 0x84, 0x06, 0xa2, 0x50, 0x72, 0x7d, 0xd2, 0xa2, 0x72, 0x61, 0x16, 0xae,
+	0xde, 0x38, 0x66, 0xc8, 0xdc, 0x11, 0x58, 0x76, 0xb9, 0xdf, 0xab, 0x95, 0xe4, 0x27, 0x56, 0xe8,
+	0x68, 0x0f, 0xca, 0x8c, 0xc6, 0x61, 0x9b, 0x39, 0x34, 0xae, 0x94, 0xa4, 0xa9, 0xcb, 0xb9, 0xe8,
+	0xb0, 0xc4, 0xbd, 0x28, 0x0e, 0x3b, 0xd6, 0x42, 0x98, 0x7e, 0xd4, 0x76, 0x99, 0x04, 0x8f, 0xed,
+	0x45, 0xb1, 0xdb, 0x09, 0x27, 0xc6, 0x19, 0x12, 0xfa, 0x85, 0x01, 0xe5, 0x28, 0x6c, 0x6e, 0x91,
+	0x7d, 0xea, 0xc5, 0x95, 0xd9, 0x4b, 0xd3, 0x97, 0x17, 0xae, 0x92, 0xc9, 0x47, 0xbd, 0x55, 0x4f,
+	0x6c, 0xdc, 0x0c, 0x38, 0xeb, 0xda, 0x2b, 0x3a, 0x52, 0xcb, 0x29, 0x1d, 0x67, 0x6e, 0xa0, 0xdf,
+	0x18, 0xb0, 0x14, 0x85, 0xcd, 0x8d, 0x20, 0x08, 0x39, 0xe1, 0x6e, 0x18, 0xc4, 0x95, 0x39, 0xe9,
+	0xd9, 0xc1, 0x67, 0xe3, 0x59, 0xce, 0x90, 0x72, 0xef, 0x9c, 0x76, 0x6f, 0xa9, 0xc8, 0xc4, 0x03,
+	0x5e, 0x21, 0x07, 0x56, 0x48, 0xb3, 0xe9, 0x8a, 0x01, 0xf1, 0xee, 0x85, 0x5e, 0xdb, 0xa7, 0x71,
+	0x65, 0x5e, 0xba, 0xba, 0x36, 0x6a, 0x73, 0x94, 0x88, 0xfd, 0xba, 0x86, 0x5f, 0xd9, 0x18, 0x54,
+	0xc6, 0xc3, 0x78, 0xe8, 0x01, 0x9c, 0x1b, 0x24, 0x6e, 0x8b, 0xe8, 0x8b, 0x2b, 0x65, 0x69, 0xa9,
+	0x36, 0xde, 0x92, 0x94, 0xb3, 0xab, 0xda, 0xdc, 0xb9, 0x8d, 0x91, 0x30, 0x78, 0x0c, 0x3c, 0xfa,
+	0x1a, 0x4c, 0xd3, 0xa0, 0x53, 0x81, 0xf1, 0xf3, 0xb9, 0x19, 0x74, 0xee, 0x11, 0x66, 0x2f, 0x68,
+	0x03, 0xd3, 0x37, 0x83, 0x0e, 0x16, 0x3a, 0x6b, 0xef, 0xc2, 0x52, 0x71, 0xc7, 0xd1, 0x19, 0x98,
+	0xbe, 0x4f, 0xbb, 0xf2, 0xa4, 0x97, 0xb1, 0xf8, 0x44, 0x67, 0xa1, 0xd4, 0x21, 0x5e, 0x9b, 0xca,
+	0xf3, 0x5b, 0xc6, 0x6a, 0xf0, 0xce, 0xd4, 0x35, 0x63, 0x6d, 0x03, 0x56, 0x47, 0xec, 0xca, 0xcb,
+	0x40, 0x98, 0x9f, 0x18, 0xb0, 0x98, 0xee, 0xf6, 0x6d, 0xea, 0xf9, 0xa8, 0x0b, 0x8b, 0xa1, 0xe3,
+	0x62, 0x1a, 0x85, 0xb1, 0x2b, 0x6e, 0x01, 0x7d, 0xc5, 0xbf, 0xfb, 0x82, 0x21, 0x95, 0x4c, 0x79,
+	0x67, 0xf3, 0x4e, 0x86, 0x61, 0xbf, 0xa6, 0x67, 0xbe, 0x58, 0x20, 0xe3, 0xa2, 0x25, 0xf3, 0x2f,
+	0x79, 0x67, 0xb6, 0xdc, 0x98, 0xa3, 0xef, 0x0c, 0xe5, 0x72, 0xeb, 0xc5, 0x72, 0xb9, 0xd0, 0x96,
+	0x99, 0xfc, 0x8c, 0xb6, 0x3c, 0x9f, 0x50, 0x72, 0x79, 0xdc, 0x87, 0x92, 0xcb, 0xa9, 0x1f, 0x57,
+	0xa6, 0xe4, 0xd6, 0xdd, 0x9e, 0xd4, 0xa9, 0xb1, 0x17, 0xb5, 0xd1, 0xd2, 0x1d, 0x01, 0x8f, 0x95,
+	0x15, 0xf3, 0x6f, 0x53, 0xb0, 0x92, 0xca, 0x60, 0xda, 0x72, 0x63, 0xb1, 0x5b, 0x47, 0x30, 0xe7,
+	0xbb, 0x8c, 0x85, 0x2c, 0xae, 0x18, 0xd2, 0x8d, 0xed, 0x63, 0xba, 0x91, 0x20, 0x6f, 0x4b, 0x54,
+	0x7b, 0x59, 0xfb, 0x32, 0xa7, 0xc6, 0x31, 0x4e, 0xcc, 0x21, 0x02, 0x8b, 0x51, 0xdb, 0xf3, 0x1a,
+	0xd4, 0x61, 0xc2, 0x9f, 0x83, 0xca, 0xd4, 0xf8, 0xeb, 0x72, 0x2b, 0x74, 0x88, 0xa7, 0x8a, 0x21,
+	0x4c, 0x0f, 0x28, 0xa3, 0x81, 0x43, 0xed, 0x15, 0xb1, 0xa3, 0xf5, 0x3c, 0x04, 0x2e, 0x22, 0x22,
+	0x06, 0xa5, 0x88, 0x85, 0x47, 0x5d, 0x5d, 0xb8, 0x7c, 0x70, 0xcc, 0xa9, 0xd5, 0x05, 0x96, 0x4a,
+	0xda, 0x6d, 0x26, 0x43, 0x5e, 0x65, 0x00, 0x49, 0xc7, 0xca, 0x94, 0xf9, 0x78, 0x06, 0xce, 0x67,
+	0x15, 0x0e, 0xf5, 0x0e, 0x72, 0x09, 0xf5, 0xd7, 0x06, 0xac, 0xb6, 0x86, 0x2f, 0xb7, 0xcf, 0xb0,
+	0x8c, 0xb9, 0xa0, 0x97, 0x7f, 0x54, 0x7e, 0xc7, 0xa3, 0x7c, 0x11, 0xd5, 0xe5, 0x21, 0xf5, 0xfc,
+	0x49, 0x57, 0x97, 0xe2, 0x70, 0x67, 0xd5, 0xa5, 0x18, 0x61, 0x69, 0x47, 0x94, 0x0b, 0x32, 0x75,
+	0xde, 0xa3, 0x0e, 0x0f, 0xd9, 0x4e, 0x87, 0xb2, 0x07, 0xcc, 0xe5, 0x49, 0x8a, 0x97, 0xe5, 0xc2,
+	0x9d, 0x11, 0x7c, 0x3c, 0x52, 0x0b, 0xb5, 0xe0, 0xa2, 0x13, 0xfa, 0x51, 0x18, 0xd0, 0x80, 0x8f,
+	0x52, 0x93, 0xe9, 0xbf, 0x6c, 0x7f, 0xae, 0xdf, 0xab, 0x5d, 0xdc, 0x7c, 0x96, 0x20, 0x7e, 0x36,
+	0x0e, 0xfa, 0x1e, 0xcc, 0x33, 0x1d, 0xe9, 0x3a, 0xcf, 0xd7, 0x27, 0xb5, 0x54, 0xc9, 0x09, 0xb2,
+	0x4f, 0x8b, 0x8b, 0x23, 0x19, 0xe1, 0xd4, 0x9e, 0xf9, 0x8f, 0xa9, 0xdc, 0x45, 0x25, 0x0a, 0x75,
+	0xf4, 0xc9, 0xa8, 0xb2, 0xf8, 0xde, 0xc4, 0xea, 0xf4, 0x42, 0x14, 0x67, 0xaf, 0x93, 0x93, 0x2d,
+	0x8f, 0x63, 0x58, 0xbd, 0xdf, 0xde, 0xa7, 0x6a, 0x94, 0xdd, 0x1a, 0xd3, 0x2f, 0x79, 0x6b, 0x9c,
+	0x17, 0xa7, 0xe1, 0x9b, 0xc3, 0x40, 0x78, 0x14, 0xba, 0xf9, 0xc8, 0xc8, 0xbd, 0x41, 0xd4, 0xfb,
+	0x04, 0x7d, 0x04, 0xe0, 0x84, 0x81, 0xca, 0xc5, 0xc9, 0xad, 0x79, 0xfd, 0xe5, 0xf2, 0x93, 0x7c,
+	0x6f, 0x5b, 0x9b, 0x09, 0x4a, 0xb6, 0xa4, 0x29, 0x29, 0xc6, 0x39, 0x23, 0xe8, 0x7d, 0x40, 0xe1,
+	0xbe, 0xa8, 0x6a, 0x69, 0xf3, 0x96, 0x7a, 0x7d, 0xba, 0x61, 0x20, 0x97, 0x77, 0xda, 0x5e, 0xd3,
+	0xba, 0x68, 0x67, 0x48, 0x02, 0x8f, 0xd0, 0x32, 0x7f, 0x67, 0x80, 0xaa, 0x59, 0x91, 0x05, 0xc0,
+	0x8a, 0x89, 0xb6, 0xac, 0xde, 0x3d, 0xb9, 0x1c, 0x99, 0x93, 0x40, 0xaf, 0xc3, 0x34, 0x27, 0x6a,
+	0x57, 0xcb, 0xf6, 0x9c, 0xa8, 0x24, 0x76, 0x49, 0x0b, 0x0b, 0x1a, 0xda, 0x01, 0x10, 0x57, 0x6f,
+	0x3d, 0xf4, 0x5c, 0xa7, 0xab, 0xcf, 0xee, 0xba, 0x80, 0xaa, 0xa7, 0xd4, 0xa7, 0xbd, 0xda, 0xc5,
+	0xe1, 0x0e, 0x81, 0x95, 0x09, 0xe0, 0x1c, 0x04, 0x32, 0x61, 0xb6, 0xe9, 0xb6, 0x68, 0xcc, 0xf5,
+	0x89, 0x05, 0x11, 0x11, 0x37, 0x24, 0x05, 0x6b, 0x8e, 0xf9, 0xe7, 0x29, 0x58, 0xd8, 0x96, 0x81,
+	0xdb, 0x6c, 0x50, 0xda, 0x3c, 0x81, 0xa7, 0x77, 0x54, 0x78, 0x7a, 0x1f, 0xf7, 0x21, 0x9c, 0xf3,
+	0x7d, 0xec, 0xe3, 0xfb, 0x68, 0xe0, 0xf1, 0x5d, 0x9f, 0xa0, 0xcd, 0x67, 0x3f, 0xbf, 0x1f, 0x1b,
+	0xb0, 0x9c, 0x93, 0x3e, 0x81, 0x82, 0x28, 0x2c, 0x16, 0x44, 0xef, 0x4f, 0x6e, 0xaa, 0x63, 0x4a,
+	0xa2, 0xdf, 0x1a, 0x70, 0x2e, 0x27, 0xb5, 0x4d, 0xdc, 0x80, 0xd3, 0x80, 0x04, 0x0e, 0x45, 0xbf,
+	0x34, 0xe0, 0x82, 0x4f, 0x8e, 0xf6, 0x02, 0xd2, 0x21, 0xae, 0x47, 0xf6, 0x3d, 0xba, 0x19, 0x06,
+	0x9c, 0x85, 0x5e, 0xdd, 0x23, 0x01, 0x8d, 0x9f, 0x1b, 0x5f, 0xa2, 0x61, 0x64, 0xa9, 0x86, 0x91,
+	0x75, 0x27, 0xe0, 0x3b, 0xac, 0xc1, 0x99, 0x1b, 0xb4, 0xec, 0x5a, 0xbf, 0x57, 0xbb, 0xb0, 0x3d,
+	0x1e, 0x18, 0x3f, 0xcb, 0xaa, 0xa8, 0xe1, 0x96, 0xf2, 0x3b, 0x78, 0x22, 0xfd, 0xa6, 0xb8, 0x10,
+	0xf4, 0x1f, 0x4c, 0x30, 0x00, 0x9f, 0xd1, 0x74, 0xfa, 0xfe, 0x40, 0xdc, 0x37, 0x26, 0x6b, 0xf6,
+	0x39, 0x9d, 0x27, 0x03, 0x50, 0x51, 0xe1, 0x04, 0xa2, 0x9f, 0x15, 0xa3, 0x7f, 0x7b, 0xa2, 0x13,
+	0x1e, 0x73, 0x00, 0xfe, 0x33, 0x33, 0x38, 0x51, 0x59, 0x4e, 0x5c, 0x86, 0x79, 0xdd, 0xd2, 0x89,
+	0x75, 0xd3, 0x47, 0x97, 0x22, 0x8a, 0x86, 0x53, 0x2e, 0x22, 0x30, 0x1f, 0x53, 0x4f, 0xd6, 0x46,
+	0x3a, 0x3e, 0xde, 0x7a, 0xc1, 0x25, 0x11, 0x8f, 0xce, 0x86, 0x56, 0xcd, 0xd6, 0x25, 0xa1, 0xe0,
+	0x14, 0x16, 0x7d, 0x6c, 0xc0, 0x3c, 0xa7, 0x7e, 0xe4, 0x11, 0x5d, 0x15, 0x1e, 0xbf, 0x52, 0xce,
+	0x4d, 0x79, 0x57, 0x23, 0x67, 0x2e, 0x24, 0x14, 0x9c, 0x5a, 0x45, 0x3f, 0x80, 0xc5, 0xf8, 0x30,
+	0x0c, 0x79, 0xc2, 0xd2, 0x4d, 0xa4, 0x8d, 0x57, 0x49, 0xfa, 0x8d, 0x3c, 0x50, 0xf6, 0x32, 0x2d,
+	0x90, 0x71, 0xd1, 0x1c, 0xfa, 0xa9, 0x01, 0x4b, 0xed, 0xa8, 0x49, 0x38, 0x6d, 0x70, 0x46, 0x38,
+	0x6d, 0x25, 0x35, 0xe7, 0x71, 0x83, 0x64, 0xaf, 0x00, 0x6a, 0x23, 0xd1, 0x4c, 0x29, 0xd2, 0xf0,
+	0x80, 0xe1, 0xb1, 0xed, 0xbd, 0xd9, 0x57, 0x69, 0xef, 0x99, 0x7f, 0x9a, 0x85, 0xb3, 0xa3, 0x8e,
+	0xe6, 0x98, 0x8a, 0xc7, 0x78, 0x95, 0x8a, 0x07, 0x7d, 0x39, 0x17, 0xce, 0xaa, 0x0b, 0x99, 0x6e,
+	0xf6, 0x88, 0x90, 0xfe, 0x3a, 0x2c, 0x32, 0x4a, 0x9a, 0xdd, 0x84, 0x25, 0x63, 0xae, 0x94, 0xed,
+	0x14, 0xce, 0x33, 0x71, 0x51, 0x16, 0xdd, 0x82, 0x95, 0x80, 0x1e, 0x71, 0x3d, 0xbe, 0xdb, 0xf6,
+	0xf7, 0x29, 0x93, 0xd1, 0x52, 0xca, 0xda, 0x49, 0x77, 0x07, 0x05, 0xf0, 0xb0, 0x0e, 0xda, 0x80,
+	0x65, 0xa7, 0xcd, 0x64, 0xbf, 0x36, 0xf1, 0xa3, 0x24, 0x61, 0xce, 0x6b, 0x98, 0xe5, 0xcd, 0x22,
+	0x1b, 0x0f, 0xca, 0x0b, 0x08, 0xb5, 0x77, 0xcd, 0x14, 0x62, 0xb6, 0x08, 0xb1, 0x57, 0x64, 0xe3,
+	0x41, 0xf9, 0x82, 0x17, 0x6a, 0xf7, 0x2a, 0x73, 0xb2, 0x1c, 0x1b, 0xf6, 0x42, 0xb1, 0xf1, 0xa0,
+	0x3c, 0x7a, 0x2f, 0x09, 0xdd, 0x14, 0x61, 0x5e, 0x35, 0x6f, 0x93, 0xe6, 0xdd, 0x5e, 0x81, 0x8b,
+	0x07, 0xa4, 0xd1, 0x3b, 0xb0, 0xe4, 0x84, 0x9e, 0x27, 0x07, 0xaa, 0x0d, 0x5d, 0x96, 0x93, 0x90,
+	0xb1, 0xba, 0x59, 0xe0, 0xe0, 0x01, 0xc9, 0x81, 0x4a, 0x1d, 0x4e, 0xa2, 0x52, 0x17, 0x47, 0x35,
+	0xa2, 0x41, 0xd3, 0x0d, 0x5a, 0x7a, 0x15, 0x2b, 0x0b, 0x13, 0x39, 0xaa, 0xf5, 0x02, 0xa8, 0x9a,
+	0x7e, 0x91, 0x86, 0x07, 0x0c, 0x9b, 0x3f, 0x9e, 0x2e, 0x54, 0x70, 0xf2, 0x6a, 0xa7, 0x50, 0x92,
+	0x77, 0x4b, 0xc5, 0x98, 0x48, 0x1f, 0x5c, 0x5e, 0x5b, 0xaa, 0x0b, 0x22, 0x3f, 0xb1, 0x42, 0x47,
+	0x3f, 0x84, 0x72, 0xda, 0x5c, 0x98, 0xf4, 0xcf, 0x46, 0xea, 0x5d, 0x98, 0x35, 0xa7, 0x53, 0x06,
+	0xce, 0x6c, 0xa2, 0x9f, 0x18, 0xb0, 0xe0, 0x67, 0xf5, 0x9c, 0xbe, 0xb1, 0xf7, 0x26, 0x97, 0x38,
+	0x72, 0xc5, 0xa2, 0xbd, 0xdc, 0xef, 0xd5, 0x16, 0x72, 0x04, 0x9c, 0x37, 0x6d, 0xfe, 0xde, 0x80,
+	0x95, 0xa1, 0xb2, 0xfb, 0x7f, 0xfd, 0x15, 0xf9, 0x4f, 0x03, 0x56, 0x47, 0xa4, 0xd1, 0xff, 0xc7,
+	0x37, 0x98, 0xf9, 0x2f, 0x03, 0x06, 0x8e, 0x1a, 0xba, 0x04, 0x33, 0x81, 0xf8, 0x21, 0x4a, 0x3d,
+	0x9a, 0x53, 0x25, 0xf9, 0xf3, 0x93, 0xe4, 0xa0, 0xf7, 0x60, 0x96, 0x51, 0x12, 0xeb, 0x05, 0x2e,
+	0xdb, 0x6f, 0x24, 0xb5, 0x26, 0x96, 0xd4, 0xa7, 0xbd, 0xda, 0xd9, 0x81, 0xe3, 0x2b, 0xe9, 0x58,
+	0x6b, 0xa1, 0x1d, 0x28, 0xc5, 0xae, 0x88, 0x5c, 0x75, 0x7a, 0xbe, 0xf8, 0x62, 0xab, 0xb8, 0xeb,
+	0xfa, 0x34, 0xab, 0xf5, 0x1a, 0x02, 0x00, 0x2b, 0x1c, 0xf4, 0x79, 0x98, 0x63, 0x94, 0x33, 0x97,
+	0xc6, 0x3a, 0x21, 0x2d, 0x88, 0xb6, 0x2c, 0x56, 0x24, 0x9c, 0xf0, 0xcc, 0x5f, 0x19, 0x80, 0x86,
+	0x1b, 0x9d, 0xe8, 0x4b, 0x50, 0x3e, 0xe4, 0x3c, 0x92, 0x1c, 0x3d, 0x6b, 0xf9, 0x73, 0xd5, 0xed,
+	0xdd, 0xdd, 0xba, 0x24, 0xe2, 0x8c, 0x2f, 0x1a, 0x0b, 0x62, 0x10, 0x2b, 0xe9, 0xa9, 0xac, 0xb1,
+	0x20, 0xa4, 0x1b, 0x4a, 0x3c, 0x27, 0x21, 0x5c, 0x0b, 0xc2, 0x7a, 0xda, 0xa9, 0x2d, 0x2b, 0xd7,
+	0xee, 0x2a, 0x12, 0x4e, 0x78, 0xe6, 0x87, 0xb0, 0x54, 0xec, 0x2e, 0xa3, 0x37, 0x60, 0x56, 0xfd,
+	0x44, 0x96, 0x74, 0x2f, 0xd2, 0x82, 0x5e, 0x52, 0xb1, 0xe6, 0x0a, 0x39, 0xd5, 0x76, 0xae, 0x4c,
+	0x15, 0xe5, 0x14, 0x0e, 0xd6, 0x5c, 0xf3, 0x06, 0xbc, 0x86, 0x45, 0x0a, 0x09, 0x5a, 0xc5, 0x2a,
+	0x48, 0x4c, 0x3f, 0x22, 0x8c, 0xbb, 0x69, 0x15, 0x52, 0x52, 0xd3, 0xaf, 0x27, 0x44, 0x9c, 0xf1,
+	0xcd, 0x2f, 0x80, 0xba, 0x0c, 0x9f, 0x1f, 0x25, 0xe6, 0x1f, 0x0c, 0x18, 0x28, 0xb8, 0xd0, 0x55,
+	0x98, 0xe1, 0xdd, 0x28, 0x51, 0xaa, 0x0a, 0x85, 0xdd, 0x6e, 0x44, 0x9f, 0xf6, 0x6a, 0xa8, 0x28,
+	0x29, 0xa8, 0x58, 0xca, 0xa2, 0x9f, 0x19, 0xb0, 0xc8, 0xf2, 0x8e, 0xeb, 0xd3, 0xb1, 0x7b, 0xdc,
+	0x66, 0xfe, 0xa8, 0xc5, 0x50, 0x8d, 0xf7, 0x02, 0x0b, 0x17, 0xad, 0xdb, 0xce, 0xc3, 0x27, 0xd5,
+	0x53, 0x8f, 0x9e, 0x54, 0x4f, 0x7d, 0xfa, 0xa4, 0x7a, 0xea, 0xe3, 0x7e, 0xd5, 0x78, 0xd8, 0xaf,
+	0x1a, 0x8f, 0xfa, 0x55, 0xe3, 0xd3, 0x7e, 0xd5, 0x78, 0xdc, 0xaf, 0x1a, 0x3f, 0xff, 0x6b, 0xf5,
+	0xd4, 0xb7, 0xaf, 0x1f, 0xeb, 0x0f, 0x37, 0xff, 0x1d, 0x00, 0x2f, 0xd9, 0x71, 0xd3, 0xb0, 0x23,
+	0x00, 0x00,
 }
 
 func (m *Gardenlet) Marshal() (dAtA []byte, err error) {
@@ -1160,6 +1299,67 @@ func (m *GardenletList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GardenletRegistry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GardenletRegistry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GardenletRegistry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Proxy != nil {
+		{
+			size, err := m.Proxy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PullSecretRef != nil {
+		{
+			size, err := m.PullSecretRef.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Mirrors) > 0 {
+		for iNdEx := len(m.Mirrors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Mirrors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GardenletSelfDeployment) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1180,6 +1380,18 @@ func (m *GardenletSelfDeployment) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.Registry != nil {
+		{
+			size, err := m.Registry.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.ComponentImageVectorOverwrite != nil {
 		i -= len(*m.ComponentImageVectorOverwrite)
 		copy(dAtA[i:], *m.ComponentImageVectorOverwrite)
@@ -1332,6 +1544,13 @@ func (m *Image) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Digest != nil {
+		i -= len(*m.Digest)
+		copy(dAtA[i:], *m.Digest)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.Digest)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if m.PullPolicy != nil {
 		i -= len(*m.PullPolicy)
 		copy(dAtA[i:], *m.PullPolicy)
@@ -1456,6 +1675,41 @@ func (m *ManagedSeedList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ManagedSeedMaintenance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ManagedSeedMaintenance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ManagedSeedMaintenance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxUnavailableControlPlanes != nil {
+		{
+			size, err := m.MaxUnavailableControlPlanes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ManagedSeedSet) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1733,6 +1987,18 @@ func (m *ManagedSeedSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Maintenance != nil {
+		{
+			size, err := m.Maintenance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	{
 		size, err := m.Gardenlet.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1889,6 +2155,83 @@ func (m *PendingReplica) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ProxyConfiguration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProxyConfiguration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProxyConfiguration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NoProxy != nil {
+		i -= len(*m.NoProxy)
+		copy(dAtA[i:], *m.NoProxy)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.NoProxy)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.HTTPSProxy != nil {
+		i -= len(*m.HTTPSProxy)
+		copy(dAtA[i:], *m.HTTPSProxy)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.HTTPSProxy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.HTTPProxy != nil {
+		i -= len(*m.HTTPProxy)
+		copy(dAtA[i:], *m.HTTPProxy)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.HTTPProxy)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegistryMirror) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegistryMirror) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegistryMirror) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Mirror)
+	copy(dAtA[i:], m.Mirror)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Mirror)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Source)
+	copy(dAtA[i:], m.Source)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Source)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *RollingUpdateStrategy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2124,6 +2467,29 @@ func (m *GardenletList) Size() (n int) {
 	return n
 }
 
+func (m *GardenletRegistry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Mirrors) > 0 {
+		for _, e := range m.Mirrors {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.PullSecretRef != nil {
+		l = m.PullSecretRef.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Proxy != nil {
+		l = m.Proxy.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *GardenletSelfDeployment) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2142,6 +2508,10 @@ func (m *GardenletSelfDeployment) Size() (n int) {
 		l = len(*m.ComponentImageVectorOverwrite)
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Registry != nil {
+		l = m.Registry.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -2196,6 +2566,10 @@ func (m *Image) Size() (n int) {
 		l = len(*m.PullPolicy)
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.Digest != nil {
+		l = len(*m.Digest)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -2231,6 +2605,19 @@ func (m *ManagedSeedList) Size() (n int) {
 	return n
 }
 
+func (m *ManagedSeedMaintenance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxUnavailableControlPlanes != nil {
+		l = m.MaxUnavailableControlPlanes.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *ManagedSeedSet) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2332,6 +2719,10 @@ func (m *ManagedSeedSpec) Size() (n int) {
 	}
 	l = m.Gardenlet.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.Maintenance != nil {
+		l = m.Maintenance.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -2382,6 +2773,40 @@ func (m *PendingReplica) Size() (n int) {
 	return n
 }
 
+func (m *ProxyConfiguration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HTTPProxy != nil {
+		l = len(*m.HTTPProxy)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.HTTPSProxy != nil {
+		l = len(*m.HTTPSProxy)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.NoProxy != nil {
+		l = len(*m.NoProxy)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *RegistryMirror) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Source)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Mirror)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
 func (m *RollingUpdateStrategy) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2533,6 +2958,23 @@ func (this *GardenletList) String() string {
 	}, "")
 	return s
 }
+func (this *GardenletRegistry) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForMirrors := "[]RegistryMirror{"
+	for _, f := range this.Mirrors {
+		repeatedStringForMirrors += strings.Replace(strings.Replace(f.String(), "RegistryMirror", "RegistryMirror", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForMirrors += "}"
+	s := strings.Join([]string{`&GardenletRegistry{`,
+		`Mirrors:` + repeatedStringForMirrors + `,`,
+		`PullSecretRef:` + strings.Replace(fmt.Sprintf("%v", this.PullSecretRef), "LocalObjectReference", "v11.LocalObjectReference", 1) + `,`,
+		`Proxy:` + strings.Replace(this.Proxy.String(), "ProxyConfiguration", "ProxyConfiguration", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *GardenletSelfDeployment) String() string {
 	if this == nil {
 		return "nil"
@@ -2542,6 +2984,7 @@ func (this *GardenletSelfDeployment) String() string {
 		`Helm:` + strings.Replace(strings.Replace(this.Helm.String(), "GardenletHelm", "GardenletHelm", 1), `&`, ``, 1) + `,`,
 		`ImageVectorOverwrite:` + valueToStringGenerated(this.ImageVectorOverwrite) + `,`,
 		`ComponentImageVectorOverwrite:` + valueToStringGenerated(this.ComponentImageVectorOverwrite) + `,`,
+		`Registry:` + strings.Replace(this.Registry.String(), "GardenletRegistry", "GardenletRegistry", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2582,6 +3025,7 @@ func (this *Image) String() string {
 		`Repository:` + valueToStringGenerated(this.Repository) + `,`,
 		`Tag:` + valueToStringGenerated(this.Tag) + `,`,
 		`PullPolicy:` + valueToStringGenerated(this.PullPolicy) + `,`,
+		`Digest:` + valueToStringGenerated(this.Digest) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2614,6 +3058,16 @@ func (this *ManagedSeedList) String() string {
 	}, "")
 	return s
 }
+func (this *ManagedSeedMaintenance) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ManagedSeedMaintenance{`,
+		`MaxUnavailableControlPlanes:` + strings.Replace(fmt.Sprintf("%v", this.MaxUnavailableControlPlanes), "IntOrString", "intstr.IntOrString", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ManagedSeedSet) String() string {
 	if this == nil {
 		return "nil"
@@ -2689,6 +3143,7 @@ func (this *ManagedSeedSpec) String() string {
 	s := strings.Join([]string{`&ManagedSeedSpec{`,
 		`Shoot:` + strings.Replace(this.Shoot.String(), "Shoot", "Shoot", 1) + `,`,
 		`Gardenlet:` + strings.Replace(strings.Replace(this.Gardenlet.String(), "GardenletConfig", "GardenletConfig", 1), `&`, ``, 1) + `,`,
+		`Maintenance:` + strings.Replace(this.Maintenance.String(), "ManagedSeedMaintenance", "ManagedSeedMaintenance", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2733,6 +3188,29 @@ func (this *PendingReplica) String() string {
 	}, "")
 	return s
 }
+func (this *ProxyConfiguration) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ProxyConfiguration{`,
+		`HTTPProxy:` + valueToStringGenerated(this.HTTPProxy) + `,`,
+		`HTTPSProxy:` + valueToStringGenerated(this.HTTPSProxy) + `,`,
+		`NoProxy:` + valueToStringGenerated(this.NoProxy) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RegistryMirror) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RegistryMirror{`,
+		`Source:` + fmt.Sprintf("%v", this.Source) + `,`,
+		`Mirror:` + fmt.Sprintf("%v", this.Mirror) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *RollingUpdateStrategy) String() string {
 	if this == nil {
 		return "nil"
@@ -3845,7 +4323,7 @@ func (m *GardenletList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GardenletSelfDeployment) Unmarshal(dAtA []byte) error {
+func (m *GardenletRegistry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3868,15 +4346,15 @@ func (m *GardenletSelfDeployment) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GardenletSelfDeployment: wiretype end group for non-group")
+			return fmt.Errorf("proto: GardenletRegistry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GardenletSelfDeployment: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GardenletRegistry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GardenletDeployment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mirrors", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3903,13 +4381,14 @@ func (m *GardenletSelfDeployment) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GardenletDeployment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Mirrors = append(m.Mirrors, RegistryMirror{})
+			if err := m.Mirrors[len(m.Mirrors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Helm", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PullSecretRef", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3936,15 +4415,18 @@ func (m *GardenletSelfDeployment) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Helm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.PullSecretRef == nil {
+				m.PullSecretRef = &v11.LocalObjectReference{}
+			}
+			if err := m.PullSecretRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ImageVectorOverwrite", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proxy", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -3954,57 +4436,27 @@ func (m *GardenletSelfDeployment) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.ImageVectorOverwrite = &s
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ComponentImageVectorOverwrite", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if m.Proxy == nil {
+				m.Proxy = &ProxyConfiguration{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Proxy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.ComponentImageVectorOverwrite = &s
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4027,7 +4479,7 @@ func (m *GardenletSelfDeployment) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GardenletSpec) Unmarshal(dAtA []byte) error {
+func (m *GardenletSelfDeployment) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4050,15 +4502,15 @@ func (m *GardenletSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GardenletSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: GardenletSelfDeployment: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GardenletSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GardenletSelfDeployment: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deployment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GardenletDeployment", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4085,13 +4537,13 @@ func (m *GardenletSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Deployment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GardenletDeployment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Helm", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4118,13 +4570,79 @@ func (m *GardenletSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Helm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KubeconfigSecretRef", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ImageVectorOverwrite", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.ImageVectorOverwrite = &s
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ComponentImageVectorOverwrite", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.ComponentImageVectorOverwrite = &s
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Registry", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4151,10 +4669,10 @@ func (m *GardenletSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.KubeconfigSecretRef == nil {
-				m.KubeconfigSecretRef = &v11.LocalObjectReference{}
+			if m.Registry == nil {
+				m.Registry = &GardenletRegistry{}
 			}
-			if err := m.KubeconfigSecretRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Registry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4179,7 +4697,7 @@ func (m *GardenletSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GardenletStatus) Unmarshal(dAtA []byte) error {
+func (m *GardenletSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4202,15 +4720,15 @@ func (m *GardenletStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GardenletStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: GardenletSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GardenletStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GardenletSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deployment", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4237,16 +4755,15 @@ func (m *GardenletStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Conditions = append(m.Conditions, v1beta1.Condition{})
-			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Deployment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObservedGeneration", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
-			m.ObservedGeneration = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -4256,27 +4773,180 @@ func (m *GardenletStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ObservedGeneration |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KubeconfigSecretRef", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.KubeconfigSecretRef == nil {
+				m.KubeconfigSecretRef = &v11.LocalObjectReference{}
+			}
+			if err := m.KubeconfigSecretRef.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GardenletStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GardenletStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GardenletStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Conditions = append(m.Conditions, v1beta1.Condition{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedGeneration", wireType)
+			}
+			m.ObservedGeneration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObservedGeneration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
@@ -4410,6 +5080,39 @@ func (m *Image) Unmarshal(dAtA []byte) error {
 			s := k8s_io_api_core_v1.PullPolicy(dAtA[iNdEx:postIndex])
 			m.PullPolicy = &s
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Digest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Digest = &s
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -4697,7 +5400,7 @@ func (m *ManagedSeedList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ManagedSeedSet) Unmarshal(dAtA []byte) error {
+func (m *ManagedSeedMaintenance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4720,15 +5423,15 @@ func (m *ManagedSeedSet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ManagedSeedSet: wiretype end group for non-group")
+			return fmt.Errorf("proto: ManagedSeedMaintenance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ManagedSeedSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ManagedSeedMaintenance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUnavailableControlPlanes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4755,13 +5458,66 @@ func (m *ManagedSeedSet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.MaxUnavailableControlPlanes == nil {
+				m.MaxUnavailableControlPlanes = &intstr.IntOrString{}
+			}
+			if err := m.MaxUnavailableControlPlanes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ManagedSeedSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ManagedSeedSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ManagedSeedSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4788,13 +5544,46 @@ func (m *ManagedSeedSet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5604,6 +6393,42 @@ func (m *ManagedSeedSpec) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Maintenance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Maintenance == nil {
+				m.Maintenance = &ManagedSeedMaintenance{}
+			}
+			if err := m.Maintenance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -6011,6 +6836,269 @@ func (m *PendingReplica) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ProxyConfiguration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProxyConfiguration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProxyConfiguration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HTTPProxy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.HTTPProxy = &s
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HTTPSProxy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.HTTPSProxy = &s
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoProxy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.NoProxy = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegistryMirror) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegistryMirror: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegistryMirror: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mirror", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mirror = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *RollingUpdateStrategy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
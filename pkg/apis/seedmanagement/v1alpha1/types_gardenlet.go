@@ -73,6 +73,9 @@ type GardenletSelfDeployment struct {
 	// gardenlet.
 	// +optional
 	ComponentImageVectorOverwrite *string `json:"componentImageVectorOverwrite,omitempty" protobuf:"bytes,4,opt,name=componentImageVectorOverwrite"`
+	// Registry contains settings for pulling the gardenlet Helm chart and container image in restricted networks.
+	// +optional
+	Registry *GardenletRegistry `json:"registry,omitempty" protobuf:"bytes,5,opt,name=registry"`
 }
 
 // GardenletHelm is the Helm deployment configuration for gardenlet.
@@ -81,6 +84,46 @@ type GardenletHelm struct {
 	OCIRepository gardencorev1.OCIRepository `json:"ociRepository" protobuf:"bytes,1,opt,name=ociRepository"`
 }
 
+// GardenletRegistry contains settings for pulling the gardenlet Helm chart and container image in restricted networks.
+type GardenletRegistry struct {
+	// Mirrors is a list of mirrors for OCI registries. References to the gardenlet Helm chart and container image
+	// which are hosted in a source registry are rewritten to the respective mirror.
+	// +patchMergeKey=source
+	// +patchStrategy=merge
+	// +optional
+	Mirrors []RegistryMirror `json:"mirrors,omitempty" patchStrategy:"merge" patchMergeKey:"source" protobuf:"bytes,1,rep,name=mirrors"`
+	// PullSecretRef is a reference to a secret of type `kubernetes.io/dockerconfigjson` in the namespace of the
+	// Gardenlet which contains the credentials for pulling the gardenlet Helm chart.
+	// +optional
+	PullSecretRef *corev1.LocalObjectReference `json:"pullSecretRef,omitempty" protobuf:"bytes,2,opt,name=pullSecretRef"`
+	// Proxy contains the settings of the proxy used for pulling the gardenlet Helm chart. They are also passed to the
+	// gardenlet container via the `HTTP_PROXY`, `HTTPS_PROXY` and `NO_PROXY` environment variables.
+	// +optional
+	Proxy *ProxyConfiguration `json:"proxy,omitempty" protobuf:"bytes,3,opt,name=proxy"`
+}
+
+// RegistryMirror specifies a mirror for an OCI registry.
+type RegistryMirror struct {
+	// Source is the host (and optional port) of the mirrored registry, e.g. `europe-docker.pkg.dev`.
+	Source string `json:"source" protobuf:"bytes,1,opt,name=source"`
+	// Mirror is the host (and optional port) of the mirror with an optional path prefix, e.g.
+	// `registry.example.com/gardener`.
+	Mirror string `json:"mirror" protobuf:"bytes,2,opt,name=mirror"`
+}
+
+// ProxyConfiguration contains proxy settings.
+type ProxyConfiguration struct {
+	// HTTPProxy is the proxy used for HTTP requests.
+	// +optional
+	HTTPProxy *string `json:"httpProxy,omitempty" protobuf:"bytes,1,opt,name=httpProxy"`
+	// HTTPSProxy is the proxy used for HTTPS requests.
+	// +optional
+	HTTPSProxy *string `json:"httpsProxy,omitempty" protobuf:"bytes,2,opt,name=httpsProxy"`
+	// NoProxy is a comma-separated list of hosts for which no proxy is used.
+	// +optional
+	NoProxy *string `json:"noProxy,omitempty" protobuf:"bytes,3,opt,name=noProxy"`
+}
+
 // GardenletStatus is the status of a Gardenlet.
 type GardenletStatus struct {
 	// Conditions represents the latest available observations of a Gardenlet's current state.
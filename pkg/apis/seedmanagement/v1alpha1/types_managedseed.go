@@ -8,6 +8,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 )
@@ -64,6 +65,22 @@ type ManagedSeedSpec struct {
 	// Gardenlet specifies that the ManagedSeed controller should deploy a gardenlet into the cluster
 	// with the given deployment parameters and GardenletConfiguration.
 	Gardenlet GardenletConfig `json:"gardenlet" protobuf:"bytes,3,opt,name=gardenlet"`
+	// Maintenance contains settings for coordinating the maintenance of the ManagedSeed's shoot with the shoots whose
+	// control planes are hosted on the seed.
+	// +optional
+	Maintenance *ManagedSeedMaintenance `json:"maintenance,omitempty" protobuf:"bytes,4,opt,name=maintenance"`
+}
+
+// ManagedSeedMaintenance contains settings for coordinating the maintenance of the ManagedSeed's shoot with the shoots
+// whose control planes are hosted on the seed.
+type ManagedSeedMaintenance struct {
+	// MaxUnavailableControlPlanes is the maximum number or percentage of hosted shoot control planes which may be
+	// unavailable when the maintenance of the ManagedSeed's shoot is started. Maintenance operations roll the nodes of the
+	// seed and thereby drain control plane pods, hence they are postponed while more control planes are unavailable.
+	// Postponed maintenance operations are retried within the maintenance time window of the ManagedSeed's shoot.
+	// If not set, the maintenance is not coordinated with the hosted shoots.
+	// +optional
+	MaxUnavailableControlPlanes *intstr.IntOrString `json:"maxUnavailableControlPlanes,omitempty" protobuf:"bytes,1,opt,name=maxUnavailableControlPlanes"`
 }
 
 // Shoot identifies the Shoot that should be registered as Seed.
@@ -140,9 +157,13 @@ type Image struct {
 	// Defaults to Always if latest tag is specified, or IfNotPresent otherwise.
 	// +optional
 	PullPolicy *corev1.PullPolicy `json:"pullPolicy,omitempty" protobuf:"bytes,3,opt,name=pullPolicy"`
+	// Digest is the image digest, takes precedence over tag. The value should be in the format 'sha256:<HASH>'.
+	// +optional
+	Digest *string `json:"digest,omitempty" protobuf:"bytes,4,opt,name=digest"`
 }
 
 // Bootstrap describes a mechanism for bootstrapping gardenlet connection to the Garden cluster.
+// +enum
 type Bootstrap string
 
 const (
@@ -172,4 +193,7 @@ const (
 	ManagedSeedShootReconciled gardencorev1beta1.ConditionType = "ShootReconciled"
 	// SeedRegistered is a condition type for indicating whether the seed has been registered by gardenlet.
 	SeedRegistered gardencorev1beta1.ConditionType = "SeedRegistered"
+	// ManagedSeedPreflightChecksPassed is a condition type for indicating whether the ManagedSeed's shoot meets the
+	// requirements of a seed.
+	ManagedSeedPreflightChecksPassed gardencorev1beta1.ConditionType = "PreflightChecksPassed"
 )
@@ -78,6 +78,7 @@ type UpdateStrategy struct {
 
 // UpdateStrategyType is a string enumeration type that enumerates
 // all possible update strategies for the ManagedSeedSet controller.
+// +enum
 type UpdateStrategyType string
 
 const (
@@ -134,6 +135,7 @@ type ManagedSeedSetStatus struct {
 }
 
 // PendingReplicaReason is a string enumeration type that enumerates all possible reasons for a replica to be pending.
+// +enum
 type PendingReplicaReason string
 
 const (
@@ -19,6 +19,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
 )
 
 func init() {
@@ -68,6 +69,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*GardenletRegistry)(nil), (*seedmanagement.GardenletRegistry)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_GardenletRegistry_To_seedmanagement_GardenletRegistry(a.(*GardenletRegistry), b.(*seedmanagement.GardenletRegistry), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*seedmanagement.GardenletRegistry)(nil), (*GardenletRegistry)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_seedmanagement_GardenletRegistry_To_v1alpha1_GardenletRegistry(a.(*seedmanagement.GardenletRegistry), b.(*GardenletRegistry), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*GardenletSelfDeployment)(nil), (*seedmanagement.GardenletSelfDeployment)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_GardenletSelfDeployment_To_seedmanagement_GardenletSelfDeployment(a.(*GardenletSelfDeployment), b.(*seedmanagement.GardenletSelfDeployment), scope)
 	}); err != nil {
@@ -118,6 +129,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ManagedSeedMaintenance)(nil), (*seedmanagement.ManagedSeedMaintenance)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ManagedSeedMaintenance_To_seedmanagement_ManagedSeedMaintenance(a.(*ManagedSeedMaintenance), b.(*seedmanagement.ManagedSeedMaintenance), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*seedmanagement.ManagedSeedMaintenance)(nil), (*ManagedSeedMaintenance)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_seedmanagement_ManagedSeedMaintenance_To_v1alpha1_ManagedSeedMaintenance(a.(*seedmanagement.ManagedSeedMaintenance), b.(*ManagedSeedMaintenance), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ManagedSeedSet)(nil), (*seedmanagement.ManagedSeedSet)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ManagedSeedSet_To_seedmanagement_ManagedSeedSet(a.(*ManagedSeedSet), b.(*seedmanagement.ManagedSeedSet), scope)
 	}); err != nil {
@@ -198,6 +219,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ProxyConfiguration)(nil), (*seedmanagement.ProxyConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ProxyConfiguration_To_seedmanagement_ProxyConfiguration(a.(*ProxyConfiguration), b.(*seedmanagement.ProxyConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*seedmanagement.ProxyConfiguration)(nil), (*ProxyConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_seedmanagement_ProxyConfiguration_To_v1alpha1_ProxyConfiguration(a.(*seedmanagement.ProxyConfiguration), b.(*ProxyConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RegistryMirror)(nil), (*seedmanagement.RegistryMirror)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RegistryMirror_To_seedmanagement_RegistryMirror(a.(*RegistryMirror), b.(*seedmanagement.RegistryMirror), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*seedmanagement.RegistryMirror)(nil), (*RegistryMirror)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_seedmanagement_RegistryMirror_To_v1alpha1_RegistryMirror(a.(*seedmanagement.RegistryMirror), b.(*RegistryMirror), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*RollingUpdateStrategy)(nil), (*seedmanagement.RollingUpdateStrategy)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_RollingUpdateStrategy_To_seedmanagement_RollingUpdateStrategy(a.(*RollingUpdateStrategy), b.(*seedmanagement.RollingUpdateStrategy), scope)
 	}); err != nil {
@@ -437,6 +478,30 @@ func Convert_seedmanagement_GardenletList_To_v1alpha1_GardenletList(in *seedmana
 	return autoConvert_seedmanagement_GardenletList_To_v1alpha1_GardenletList(in, out, s)
 }
 
+func autoConvert_v1alpha1_GardenletRegistry_To_seedmanagement_GardenletRegistry(in *GardenletRegistry, out *seedmanagement.GardenletRegistry, s conversion.Scope) error {
+	out.Mirrors = *(*[]seedmanagement.RegistryMirror)(unsafe.Pointer(&in.Mirrors))
+	out.PullSecretRef = (*corev1.LocalObjectReference)(unsafe.Pointer(in.PullSecretRef))
+	out.Proxy = (*seedmanagement.ProxyConfiguration)(unsafe.Pointer(in.Proxy))
+	return nil
+}
+
+// Convert_v1alpha1_GardenletRegistry_To_seedmanagement_GardenletRegistry is an autogenerated conversion function.
+func Convert_v1alpha1_GardenletRegistry_To_seedmanagement_GardenletRegistry(in *GardenletRegistry, out *seedmanagement.GardenletRegistry, s conversion.Scope) error {
+	return autoConvert_v1alpha1_GardenletRegistry_To_seedmanagement_GardenletRegistry(in, out, s)
+}
+
+func autoConvert_seedmanagement_GardenletRegistry_To_v1alpha1_GardenletRegistry(in *seedmanagement.GardenletRegistry, out *GardenletRegistry, s conversion.Scope) error {
+	out.Mirrors = *(*[]RegistryMirror)(unsafe.Pointer(&in.Mirrors))
+	out.PullSecretRef = (*corev1.LocalObjectReference)(unsafe.Pointer(in.PullSecretRef))
+	out.Proxy = (*ProxyConfiguration)(unsafe.Pointer(in.Proxy))
+	return nil
+}
+
+// Convert_seedmanagement_GardenletRegistry_To_v1alpha1_GardenletRegistry is an autogenerated conversion function.
+func Convert_seedmanagement_GardenletRegistry_To_v1alpha1_GardenletRegistry(in *seedmanagement.GardenletRegistry, out *GardenletRegistry, s conversion.Scope) error {
+	return autoConvert_seedmanagement_GardenletRegistry_To_v1alpha1_GardenletRegistry(in, out, s)
+}
+
 func autoConvert_v1alpha1_GardenletSelfDeployment_To_seedmanagement_GardenletSelfDeployment(in *GardenletSelfDeployment, out *seedmanagement.GardenletSelfDeployment, s conversion.Scope) error {
 	if err := Convert_v1alpha1_GardenletDeployment_To_seedmanagement_GardenletDeployment(&in.GardenletDeployment, &out.GardenletDeployment, s); err != nil {
 		return err
@@ -446,6 +511,7 @@ func autoConvert_v1alpha1_GardenletSelfDeployment_To_seedmanagement_GardenletSel
 	}
 	out.ImageVectorOverwrite = (*string)(unsafe.Pointer(in.ImageVectorOverwrite))
 	out.ComponentImageVectorOverwrite = (*string)(unsafe.Pointer(in.ComponentImageVectorOverwrite))
+	out.Registry = (*seedmanagement.GardenletRegistry)(unsafe.Pointer(in.Registry))
 	return nil
 }
 
@@ -463,6 +529,7 @@ func autoConvert_seedmanagement_GardenletSelfDeployment_To_v1alpha1_GardenletSel
 	}
 	out.ImageVectorOverwrite = (*string)(unsafe.Pointer(in.ImageVectorOverwrite))
 	out.ComponentImageVectorOverwrite = (*string)(unsafe.Pointer(in.ComponentImageVectorOverwrite))
+	out.Registry = (*GardenletRegistry)(unsafe.Pointer(in.Registry))
 	return nil
 }
 
@@ -519,6 +586,7 @@ func autoConvert_v1alpha1_Image_To_seedmanagement_Image(in *Image, out *seedmana
 	out.Repository = (*string)(unsafe.Pointer(in.Repository))
 	out.Tag = (*string)(unsafe.Pointer(in.Tag))
 	out.PullPolicy = (*corev1.PullPolicy)(unsafe.Pointer(in.PullPolicy))
+	out.Digest = (*string)(unsafe.Pointer(in.Digest))
 	return nil
 }
 
@@ -531,6 +599,7 @@ func autoConvert_seedmanagement_Image_To_v1alpha1_Image(in *seedmanagement.Image
 	out.Repository = (*string)(unsafe.Pointer(in.Repository))
 	out.Tag = (*string)(unsafe.Pointer(in.Tag))
 	out.PullPolicy = (*corev1.PullPolicy)(unsafe.Pointer(in.PullPolicy))
+	out.Digest = (*string)(unsafe.Pointer(in.Digest))
 	return nil
 }
 
@@ -613,6 +682,26 @@ func Convert_seedmanagement_ManagedSeedList_To_v1alpha1_ManagedSeedList(in *seed
 	return autoConvert_seedmanagement_ManagedSeedList_To_v1alpha1_ManagedSeedList(in, out, s)
 }
 
+func autoConvert_v1alpha1_ManagedSeedMaintenance_To_seedmanagement_ManagedSeedMaintenance(in *ManagedSeedMaintenance, out *seedmanagement.ManagedSeedMaintenance, s conversion.Scope) error {
+	out.MaxUnavailableControlPlanes = (*intstr.IntOrString)(unsafe.Pointer(in.MaxUnavailableControlPlanes))
+	return nil
+}
+
+// Convert_v1alpha1_ManagedSeedMaintenance_To_seedmanagement_ManagedSeedMaintenance is an autogenerated conversion function.
+func Convert_v1alpha1_ManagedSeedMaintenance_To_seedmanagement_ManagedSeedMaintenance(in *ManagedSeedMaintenance, out *seedmanagement.ManagedSeedMaintenance, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ManagedSeedMaintenance_To_seedmanagement_ManagedSeedMaintenance(in, out, s)
+}
+
+func autoConvert_seedmanagement_ManagedSeedMaintenance_To_v1alpha1_ManagedSeedMaintenance(in *seedmanagement.ManagedSeedMaintenance, out *ManagedSeedMaintenance, s conversion.Scope) error {
+	out.MaxUnavailableControlPlanes = (*intstr.IntOrString)(unsafe.Pointer(in.MaxUnavailableControlPlanes))
+	return nil
+}
+
+// Convert_seedmanagement_ManagedSeedMaintenance_To_v1alpha1_ManagedSeedMaintenance is an autogenerated conversion function.
+func Convert_seedmanagement_ManagedSeedMaintenance_To_v1alpha1_ManagedSeedMaintenance(in *seedmanagement.ManagedSeedMaintenance, out *ManagedSeedMaintenance, s conversion.Scope) error {
+	return autoConvert_seedmanagement_ManagedSeedMaintenance_To_v1alpha1_ManagedSeedMaintenance(in, out, s)
+}
+
 func autoConvert_v1alpha1_ManagedSeedSet_To_seedmanagement_ManagedSeedSet(in *ManagedSeedSet, out *seedmanagement.ManagedSeedSet, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1alpha1_ManagedSeedSetSpec_To_seedmanagement_ManagedSeedSetSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -770,6 +859,7 @@ func autoConvert_v1alpha1_ManagedSeedSpec_To_seedmanagement_ManagedSeedSpec(in *
 	if err := Convert_v1alpha1_GardenletConfig_To_seedmanagement_GardenletConfig(&in.Gardenlet, &out.Gardenlet, s); err != nil {
 		return err
 	}
+	out.Maintenance = (*seedmanagement.ManagedSeedMaintenance)(unsafe.Pointer(in.Maintenance))
 	return nil
 }
 
@@ -783,6 +873,7 @@ func autoConvert_seedmanagement_ManagedSeedSpec_To_v1alpha1_ManagedSeedSpec(in *
 	if err := Convert_seedmanagement_GardenletConfig_To_v1alpha1_GardenletConfig(&in.Gardenlet, &out.Gardenlet, s); err != nil {
 		return err
 	}
+	out.Maintenance = (*ManagedSeedMaintenance)(unsafe.Pointer(in.Maintenance))
 	return nil
 }
 
@@ -865,6 +956,52 @@ func Convert_seedmanagement_PendingReplica_To_v1alpha1_PendingReplica(in *seedma
 	return autoConvert_seedmanagement_PendingReplica_To_v1alpha1_PendingReplica(in, out, s)
 }
 
+func autoConvert_v1alpha1_ProxyConfiguration_To_seedmanagement_ProxyConfiguration(in *ProxyConfiguration, out *seedmanagement.ProxyConfiguration, s conversion.Scope) error {
+	out.HTTPProxy = (*string)(unsafe.Pointer(in.HTTPProxy))
+	out.HTTPSProxy = (*string)(unsafe.Pointer(in.HTTPSProxy))
+	out.NoProxy = (*string)(unsafe.Pointer(in.NoProxy))
+	return nil
+}
+
+// Convert_v1alpha1_ProxyConfiguration_To_seedmanagement_ProxyConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_ProxyConfiguration_To_seedmanagement_ProxyConfiguration(in *ProxyConfiguration, out *seedmanagement.ProxyConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ProxyConfiguration_To_seedmanagement_ProxyConfiguration(in, out, s)
+}
+
+func autoConvert_seedmanagement_ProxyConfiguration_To_v1alpha1_ProxyConfiguration(in *seedmanagement.ProxyConfiguration, out *ProxyConfiguration, s conversion.Scope) error {
+	out.HTTPProxy = (*string)(unsafe.Pointer(in.HTTPProxy))
+	out.HTTPSProxy = (*string)(unsafe.Pointer(in.HTTPSProxy))
+	out.NoProxy = (*string)(unsafe.Pointer(in.NoProxy))
+	return nil
+}
+
+// Convert_seedmanagement_ProxyConfiguration_To_v1alpha1_ProxyConfiguration is an autogenerated conversion function.
+func Convert_seedmanagement_ProxyConfiguration_To_v1alpha1_ProxyConfiguration(in *seedmanagement.ProxyConfiguration, out *ProxyConfiguration, s conversion.Scope) error {
+	return autoConvert_seedmanagement_ProxyConfiguration_To_v1alpha1_ProxyConfiguration(in, out, s)
+}
+
+func autoConvert_v1alpha1_RegistryMirror_To_seedmanagement_RegistryMirror(in *RegistryMirror, out *seedmanagement.RegistryMirror, s conversion.Scope) error {
+	out.Source = in.Source
+	out.Mirror = in.Mirror
+	return nil
+}
+
+// Convert_v1alpha1_RegistryMirror_To_seedmanagement_RegistryMirror is an autogenerated conversion function.
+func Convert_v1alpha1_RegistryMirror_To_seedmanagement_RegistryMirror(in *RegistryMirror, out *seedmanagement.RegistryMirror, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RegistryMirror_To_seedmanagement_RegistryMirror(in, out, s)
+}
+
+func autoConvert_seedmanagement_RegistryMirror_To_v1alpha1_RegistryMirror(in *seedmanagement.RegistryMirror, out *RegistryMirror, s conversion.Scope) error {
+	out.Source = in.Source
+	out.Mirror = in.Mirror
+	return nil
+}
+
+// Convert_seedmanagement_RegistryMirror_To_v1alpha1_RegistryMirror is an autogenerated conversion function.
+func Convert_seedmanagement_RegistryMirror_To_v1alpha1_RegistryMirror(in *seedmanagement.RegistryMirror, out *RegistryMirror, s conversion.Scope) error {
+	return autoConvert_seedmanagement_RegistryMirror_To_v1alpha1_RegistryMirror(in, out, s)
+}
+
 func autoConvert_v1alpha1_RollingUpdateStrategy_To_seedmanagement_RollingUpdateStrategy(in *RollingUpdateStrategy, out *seedmanagement.RollingUpdateStrategy, s conversion.Scope) error {
 	out.Partition = (*int32)(unsafe.Pointer(in.Partition))
 	return nil
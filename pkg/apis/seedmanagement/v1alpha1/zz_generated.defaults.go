@@ -10,6 +10,7 @@
 package v1alpha1
 
 import (
+	v1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1 "k8s.io/api/core/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -202,6 +203,51 @@ func SetObjectDefaults_ManagedSeedSet(in *ManagedSeedSet) {
 			}
 		}
 	}
+	if in.Spec.ShootTemplate.Spec.Kubernetes.ClusterAutoscaler != nil {
+		if in.Spec.ShootTemplate.Spec.Kubernetes.ClusterAutoscaler.ScaleDownUtilizationThreshold == nil {
+			var ptrVar1 float64 = 0.5
+			in.Spec.ShootTemplate.Spec.Kubernetes.ClusterAutoscaler.ScaleDownUtilizationThreshold = &ptrVar1
+		}
+		if in.Spec.ShootTemplate.Spec.Kubernetes.ClusterAutoscaler.Expander == nil {
+			var ptrVar1 v1beta1.ExpanderMode = "least-waste"
+			in.Spec.ShootTemplate.Spec.Kubernetes.ClusterAutoscaler.Expander = &ptrVar1
+		}
+		if in.Spec.ShootTemplate.Spec.Kubernetes.ClusterAutoscaler.MaxGracefulTerminationSeconds == nil {
+			var ptrVar1 int32 = 600
+			in.Spec.ShootTemplate.Spec.Kubernetes.ClusterAutoscaler.MaxGracefulTerminationSeconds = &ptrVar1
+		}
+		if in.Spec.ShootTemplate.Spec.Kubernetes.ClusterAutoscaler.MaxEmptyBulkDelete == nil {
+			var ptrVar1 int32 = 10
+			in.Spec.ShootTemplate.Spec.Kubernetes.ClusterAutoscaler.MaxEmptyBulkDelete = &ptrVar1
+		}
+		if in.Spec.ShootTemplate.Spec.Kubernetes.ClusterAutoscaler.IgnoreDaemonsetsUtilization == nil {
+			var ptrVar1 bool = false
+			in.Spec.ShootTemplate.Spec.Kubernetes.ClusterAutoscaler.IgnoreDaemonsetsUtilization = &ptrVar1
+		}
+		if in.Spec.ShootTemplate.Spec.Kubernetes.ClusterAutoscaler.Verbosity == nil {
+			var ptrVar1 int32 = 2
+			in.Spec.ShootTemplate.Spec.Kubernetes.ClusterAutoscaler.Verbosity = &ptrVar1
+		}
+	}
+	for i := range in.Spec.ShootTemplate.Spec.Provider.Workers {
+		a := &in.Spec.ShootTemplate.Spec.Provider.Workers[i]
+		if a.Machine.Architecture == nil {
+			var ptrVar1 string = "amd64"
+			a.Machine.Architecture = &ptrVar1
+		}
+		if a.Machine.OSFamily == nil {
+			var ptrVar1 string = "linux"
+			a.Machine.OSFamily = &ptrVar1
+		}
+	}
+	if in.Spec.ShootTemplate.Spec.Purpose == nil {
+		var ptrVar1 v1beta1.ShootPurpose = "evaluation"
+		in.Spec.ShootTemplate.Spec.Purpose = &ptrVar1
+	}
+	if in.Spec.ShootTemplate.Spec.SchedulerName == nil {
+		var ptrVar1 string = "default-scheduler"
+		in.Spec.ShootTemplate.Spec.SchedulerName = &ptrVar1
+	}
 	if in.Spec.UpdateStrategy != nil {
 		SetDefaults_UpdateStrategy(in.Spec.UpdateStrategy)
 		if in.Spec.UpdateStrategy.RollingUpdate != nil {
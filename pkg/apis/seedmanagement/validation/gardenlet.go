@@ -5,7 +5,11 @@
 package validation
 
 import (
+	"net/url"
+	"strings"
+
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
@@ -49,6 +53,10 @@ func ValidateGardenletSpec(spec *seedmanagement.GardenletSpec, fldPath *field.Pa
 
 	allErrs = append(allErrs, validation.ValidateOCIRepository(&spec.Deployment.Helm.OCIRepository, fldPath.Child("deployment", "helm", "ociRepository"))...)
 
+	if spec.Deployment.Registry != nil {
+		allErrs = append(allErrs, validateGardenletRegistry(spec.Deployment.Registry, fldPath.Child("deployment", "registry"))...)
+	}
+
 	if spec.Config != nil {
 		allErrs = append(allErrs, validateGardenletConfig(spec.Config, seedmanagement.BootstrapToken, false, fldPath.Child("config"), false)...)
 	}
@@ -56,6 +64,50 @@ func ValidateGardenletSpec(spec *seedmanagement.GardenletSpec, fldPath *field.Pa
 	return allErrs
 }
 
+func validateGardenletRegistry(registry *seedmanagement.GardenletRegistry, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	sources := sets.New[string]()
+	for i, mirror := range registry.Mirrors {
+		idxPath := fldPath.Child("mirrors").Index(i)
+
+		if mirror.Source == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("source"), "must provide the host of the mirrored registry"))
+		} else if strings.Contains(mirror.Source, "/") {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("source"), mirror.Source, "must only contain the host and an optional port"))
+		} else if sources.Has(mirror.Source) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("source"), mirror.Source))
+		}
+		sources.Insert(mirror.Source)
+
+		if mirror.Mirror == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("mirror"), "must provide the host of the mirror"))
+		} else if strings.Contains(mirror.Mirror, "://") {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("mirror"), mirror.Mirror, "must not contain a scheme"))
+		}
+	}
+
+	if registry.PullSecretRef != nil && registry.PullSecretRef.Name == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("pullSecretRef", "name"), "must provide the name of the pull secret"))
+	}
+
+	if proxy := registry.Proxy; proxy != nil {
+		for fieldName, proxyURL := range map[string]*string{
+			"httpProxy":  proxy.HTTPProxy,
+			"httpsProxy": proxy.HTTPSProxy,
+		} {
+			if proxyURL == nil {
+				continue
+			}
+			if u, err := url.Parse(*proxyURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("proxy", fieldName), *proxyURL, "must be a valid URL with scheme 'http' or 'https'"))
+			}
+		}
+	}
+
+	return allErrs
+}
+
 // ValidateGardenletSpecUpdate validates the specification updates of a Gardenlet object.
 func ValidateGardenletSpecUpdate(newSpec, oldSpec *seedmanagement.GardenletSpec, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
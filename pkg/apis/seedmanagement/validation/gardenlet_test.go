@@ -213,6 +213,7 @@ var _ = Describe("Gardenlet Validation Tests", func() {
 						Repository: ptr.To(""),
 						Tag:        ptr.To(""),
 						PullPolicy: ptr.To[corev1.PullPolicy]("foo"),
+						Digest:     ptr.To("md5:foo"),
 					},
 					PodLabels:      map[string]string{"foo!": "bar"},
 					PodAnnotations: map[string]string{"bar@": "baz"},
@@ -244,6 +245,10 @@ var _ = Describe("Gardenlet Validation Tests", func() {
 						"Type":  Equal(field.ErrorTypeNotSupported),
 						"Field": Equal("spec.deployment.image.pullPolicy"),
 					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.deployment.image.digest"),
+					})),
 					PointTo(MatchFields(IgnoreExtras, Fields{
 						"Type":  Equal(field.ErrorTypeInvalid),
 						"Field": Equal("spec.deployment.podLabels"),
@@ -263,6 +268,71 @@ var _ = Describe("Gardenlet Validation Tests", func() {
 				))
 			})
 
+			Context("registry", func() {
+				It("should allow valid registry settings", func() {
+					gardenlet.Spec.Deployment.Registry = &seedmanagement.GardenletRegistry{
+						Mirrors: []seedmanagement.RegistryMirror{
+							{Source: "europe-docker.pkg.dev", Mirror: "registry.example.com/gardener"},
+							{Source: "localhost:5001", Mirror: "registry.example.com:5000"},
+						},
+						PullSecretRef: &corev1.LocalObjectReference{Name: "registry-credentials"},
+						Proxy: &seedmanagement.ProxyConfiguration{
+							HTTPProxy:  ptr.To("http://proxy.example.com:3128"),
+							HTTPSProxy: ptr.To("https://proxy.example.com:3129"),
+							NoProxy:    ptr.To("10.0.0.0/8,.svc"),
+						},
+					}
+
+					Expect(ValidateGardenlet(gardenlet)).To(BeEmpty())
+				})
+
+				It("should forbid invalid registry settings", func() {
+					gardenlet.Spec.Deployment.Registry = &seedmanagement.GardenletRegistry{
+						Mirrors: []seedmanagement.RegistryMirror{
+							{Source: "europe-docker.pkg.dev", Mirror: "registry.example.com/gardener"},
+							{Source: "europe-docker.pkg.dev", Mirror: "https://registry.example.com"},
+							{Source: "europe-docker.pkg.dev/gardener-project"},
+						},
+						PullSecretRef: &corev1.LocalObjectReference{},
+						Proxy: &seedmanagement.ProxyConfiguration{
+							HTTPProxy:  ptr.To("proxy.example.com:3128"),
+							HTTPSProxy: ptr.To("socks5://proxy.example.com:1080"),
+						},
+					}
+
+					Expect(ValidateGardenlet(gardenlet)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeDuplicate),
+							"Field": Equal("spec.deployment.registry.mirrors[1].source"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.deployment.registry.mirrors[1].mirror"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.deployment.registry.mirrors[2].source"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("spec.deployment.registry.mirrors[2].mirror"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("spec.deployment.registry.pullSecretRef.name"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.deployment.registry.proxy.httpProxy"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("spec.deployment.registry.proxy.httpsProxy"),
+						})),
+					))
+				})
+			})
+
 			It("should forbid garden client connection kubeconfig if bootstrap is specified", func() {
 				gardenlet.Spec.Config = gardenletConfiguration(seedx,
 					&gardenletconfigv1alpha1.GardenClientConnection{
@@ -7,6 +7,7 @@ package validation
 import (
 	"fmt"
 	"slices"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
@@ -17,6 +18,7 @@ import (
 	"k8s.io/utils/ptr"
 
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	gardencorevalidation "github.com/gardener/gardener/pkg/apis/core/validation"
 	"github.com/gardener/gardener/pkg/apis/seedmanagement"
 	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
 	gardenlethelper "github.com/gardener/gardener/pkg/gardenlet/apis/config/helper"
@@ -96,6 +98,10 @@ func ValidateManagedSeedSpec(spec *seedmanagement.ManagedSeedSpec, fldPath *fiel
 
 	allErrs = append(allErrs, validateGardenlet(&spec.Gardenlet, fldPath.Child("gardenlet"), inTemplate)...)
 
+	if spec.Maintenance != nil {
+		allErrs = append(allErrs, validateMaintenance(spec.Maintenance, fldPath.Child("maintenance"))...)
+	}
+
 	return allErrs
 }
 
@@ -132,6 +138,15 @@ func validateShoot(shoot *seedmanagement.Shoot, fldPath *field.Path, inTemplate
 	return allErrs
 }
 
+func validateMaintenance(maintenance *seedmanagement.ManagedSeedMaintenance, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, gardencorevalidation.ValidatePositiveIntOrPercent(maintenance.MaxUnavailableControlPlanes, fldPath.Child("maxUnavailableControlPlanes"))...)
+	allErrs = append(allErrs, gardencorevalidation.IsNotMoreThan100Percent(maintenance.MaxUnavailableControlPlanes, fldPath.Child("maxUnavailableControlPlanes"))...)
+
+	return allErrs
+}
+
 func validateGardenlet(gardenlet *seedmanagement.GardenletConfig, fldPath *field.Path, inTemplate bool) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -243,6 +258,9 @@ func validateImage(image *seedmanagement.Image, fldPath *field.Path) field.Error
 	if image.Tag != nil && *image.Tag == "" {
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("tag"), *image.Tag, "tag must not be empty if specified"))
 	}
+	if image.Digest != nil && !strings.HasPrefix(*image.Digest, "sha256:") {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("digest"), *image.Digest, "must start with 'sha256:'"))
+	}
 	if image.PullPolicy != nil {
 		validValues := []string{string(corev1.PullAlways), string(corev1.PullIfNotPresent), string(corev1.PullNever)}
 		if !slices.Contains(validValues, string(*image.PullPolicy)) {
@@ -11,6 +11,7 @@ import (
 	gomegatypes "github.com/onsi/gomega/types"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/component-base/config/v1alpha1"
 	"k8s.io/utils/ptr"
@@ -195,6 +196,38 @@ var _ = Describe("ManagedSeed Validation Tests", func() {
 			))
 		})
 
+		Context("maintenance", func() {
+			It("should allow valid maximum numbers of unavailable control planes", func() {
+				managedSeed.Spec.Maintenance = &seedmanagement.ManagedSeedMaintenance{MaxUnavailableControlPlanes: ptr.To(intstr.FromString("10%"))}
+				Expect(ValidateManagedSeed(managedSeed)).To(BeEmpty())
+
+				managedSeed.Spec.Maintenance.MaxUnavailableControlPlanes = ptr.To(intstr.FromInt32(0))
+				Expect(ValidateManagedSeed(managedSeed)).To(BeEmpty())
+			})
+
+			It("should forbid a negative maximum number of unavailable control planes", func() {
+				managedSeed.Spec.Maintenance = &seedmanagement.ManagedSeedMaintenance{MaxUnavailableControlPlanes: ptr.To(intstr.FromInt32(-1))}
+
+				Expect(ValidateManagedSeed(managedSeed)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.maintenance.maxUnavailableControlPlanes"),
+					})),
+				))
+			})
+
+			It("should forbid a maximum percentage of unavailable control planes greater than 100%", func() {
+				managedSeed.Spec.Maintenance = &seedmanagement.ManagedSeedMaintenance{MaxUnavailableControlPlanes: ptr.To(intstr.FromString("150%"))}
+
+				Expect(ValidateManagedSeed(managedSeed)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("spec.maintenance.maxUnavailableControlPlanes"),
+					})),
+				))
+			})
+		})
+
 		Context("gardenlet", func() {
 			var (
 				seedx *gardencorev1beta1.Seed
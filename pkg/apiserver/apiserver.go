@@ -15,12 +15,15 @@ import (
 	genericapiserver "k8s.io/apiserver/pkg/server"
 	kubeinformers "k8s.io/client-go/informers"
 	"k8s.io/client-go/util/keyutil"
+	"k8s.io/utils/clock"
 
 	corerest "github.com/gardener/gardener/pkg/apiserver/registry/core/rest"
+	shootstore "github.com/gardener/gardener/pkg/apiserver/registry/core/shoot/storage"
 	operationsrest "github.com/gardener/gardener/pkg/apiserver/registry/operations/rest"
 	securityrest "github.com/gardener/gardener/pkg/apiserver/registry/security/rest"
 	seedmanagementrest "github.com/gardener/gardener/pkg/apiserver/registry/seedmanagement/rest"
 	settingsrest "github.com/gardener/gardener/pkg/apiserver/registry/settings/rest"
+	"github.com/gardener/gardener/pkg/apiserver/storage/projectencryption"
 	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/externalversions"
 	"github.com/gardener/gardener/pkg/logger"
 	"github.com/gardener/gardener/pkg/utils/workloadidentity"
@@ -28,13 +31,14 @@ import (
 
 // ExtraConfig contains non-generic Gardener API server configuration.
 type ExtraConfig struct {
-	AdminKubeconfigMaxExpiration       time.Duration
-	ViewerKubeconfigMaxExpiration      time.Duration
-	CredentialsRotationInterval        time.Duration
-	WorkloadIdentityTokenIssuer        string
-	WorkloadIdentityTokenMinExpiration time.Duration
-	WorkloadIdentityTokenMaxExpiration time.Duration
-	WorkloadIdentitySigningKey         any
+	AdminKubeconfigMaxExpiration         time.Duration
+	ViewerKubeconfigMaxExpiration        time.Duration
+	TokenExchangeKubeconfigMaxExpiration time.Duration
+	CredentialsRotationInterval          time.Duration
+	WorkloadIdentityTokenIssuer          string
+	WorkloadIdentityTokenMinExpiration   time.Duration
+	WorkloadIdentityTokenMaxExpiration   time.Duration
+	WorkloadIdentitySigningKey           any
 }
 
 // Config contains Gardener API server configuration.
@@ -98,11 +102,13 @@ func (c completedConfig) New() (*GardenerServer, error) {
 	var (
 		s                = &GardenerServer{GenericAPIServer: genericServer}
 		coreAPIGroupInfo = (corerest.StorageProvider{
-			AdminKubeconfigMaxExpiration:  c.ExtraConfig.AdminKubeconfigMaxExpiration,
-			ViewerKubeconfigMaxExpiration: c.ExtraConfig.ViewerKubeconfigMaxExpiration,
-			CredentialsRotationInterval:   c.ExtraConfig.CredentialsRotationInterval,
-			KubeInformerFactory:           c.kubeInformerFactory,
-			CoreInformerFactory:           c.coreInformerFactory,
+			AdminKubeconfigMaxExpiration:         c.ExtraConfig.AdminKubeconfigMaxExpiration,
+			ViewerKubeconfigMaxExpiration:        c.ExtraConfig.ViewerKubeconfigMaxExpiration,
+			TokenExchangeKubeconfigMaxExpiration: c.ExtraConfig.TokenExchangeKubeconfigMaxExpiration,
+			CredentialsRotationInterval:          c.ExtraConfig.CredentialsRotationInterval,
+			TrustedIdentityTokenVerifier:         shootstore.NewOIDCTokenVerifier(shootstore.NewOIDCDiscoveryHTTPClient(10*time.Second), clock.RealClock{}),
+			KubeInformerFactory:                  c.kubeInformerFactory,
+			CoreInformerFactory:                  c.coreInformerFactory,
 		}).NewRESTStorage(c.GenericConfig.RESTOptionsGetter)
 		seedManagementAPIGroupInfo = (seedmanagementrest.StorageProvider{}).NewRESTStorage(c.GenericConfig.RESTOptionsGetter)
 		settingsAPIGroupInfo       = (settingsrest.StorageProvider{}).NewRESTStorage(c.GenericConfig.RESTOptionsGetter)
@@ -122,17 +128,21 @@ func (c completedConfig) New() (*GardenerServer, error) {
 
 // ExtraOptions is used for providing additional options to the Gardener API Server
 type ExtraOptions struct {
-	ClusterIdentity                    string
-	AdminKubeconfigMaxExpiration       time.Duration
-	ViewerKubeconfigMaxExpiration      time.Duration
-	CredentialsRotationInterval        time.Duration
-	WorkloadIdentityTokenIssuer        string
-	WorkloadIdentityTokenMinExpiration time.Duration
-	WorkloadIdentityTokenMaxExpiration time.Duration
-	WorkloadIdentitySigningKeyFile     string
+	ClusterIdentity                      string
+	AdminKubeconfigMaxExpiration         time.Duration
+	ViewerKubeconfigMaxExpiration        time.Duration
+	TokenExchangeKubeconfigMaxExpiration time.Duration
+	CredentialsRotationInterval          time.Duration
+	WorkloadIdentityTokenIssuer          string
+	WorkloadIdentityTokenMinExpiration   time.Duration
+	WorkloadIdentityTokenMaxExpiration   time.Duration
+	WorkloadIdentitySigningKeyFile       string
+	ProjectEncryptionConfigFile          string
 
 	LogLevel  string
 	LogFormat string
+
+	projectEncryptionConfig *projectencryption.Configuration
 }
 
 // Validate checks if the required flags are set
@@ -152,6 +162,11 @@ func (o *ExtraOptions) Validate() []error {
 		allErrors = append(allErrors, errors.New("--shoot-viewer-kubeconfig-max-expiration must be between 1 hour and 2^32 seconds"))
 	}
 
+	if o.TokenExchangeKubeconfigMaxExpiration < time.Hour ||
+		o.TokenExchangeKubeconfigMaxExpiration > time.Duration(1<<32)*time.Second {
+		allErrors = append(allErrors, errors.New("--shoot-token-exchange-kubeconfig-max-expiration must be between 1 hour and 2^32 seconds"))
+	}
+
 	if o.CredentialsRotationInterval < 24*time.Hour ||
 		o.CredentialsRotationInterval > time.Duration(1<<32)*time.Second {
 		allErrors = append(allErrors, errors.New("--shoot-credentials-rotation-interval must be between 24 hours and 2^32 seconds"))
@@ -178,6 +193,12 @@ func (o *ExtraOptions) Validate() []error {
 		}
 	}
 
+	if len(o.ProjectEncryptionConfigFile) != 0 {
+		if _, err := o.ProjectEncryptionConfiguration(); err != nil {
+			allErrors = append(allErrors, fmt.Errorf("--project-encryption-config is invalid, err: %w", err))
+		}
+	}
+
 	if !sets.New(logger.AllLogLevels...).Has(o.LogLevel) {
 		allErrors = append(allErrors, fmt.Errorf("invalid --log-level: %s", o.LogLevel))
 	}
@@ -189,16 +210,34 @@ func (o *ExtraOptions) Validate() []error {
 	return allErrors
 }
 
+// ProjectEncryptionConfiguration returns the configuration for encrypting project-scoped resources which is read from
+// --project-encryption-config. The file is only read once. It returns nil if the flag is not set.
+func (o *ExtraOptions) ProjectEncryptionConfiguration() (*projectencryption.Configuration, error) {
+	if len(o.ProjectEncryptionConfigFile) == 0 || o.projectEncryptionConfig != nil {
+		return o.projectEncryptionConfig, nil
+	}
+
+	config, err := projectencryption.LoadConfiguration(o.ProjectEncryptionConfigFile)
+	if err != nil {
+		return nil, err
+	}
+	o.projectEncryptionConfig = config
+
+	return config, nil
+}
+
 // AddFlags adds flags related to cluster identity to the options
 func (o *ExtraOptions) AddFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&o.ClusterIdentity, "cluster-identity", o.ClusterIdentity, "This flag is used for specifying the identity of the Garden cluster")
 	fs.DurationVar(&o.AdminKubeconfigMaxExpiration, "shoot-admin-kubeconfig-max-expiration", time.Hour*24, "The maximum validity duration of a credential requested to a Shoot by an AdminKubeconfigRequest. If an otherwise valid AdminKubeconfigRequest with a validity duration larger than this value is requested, a credential will be issued with a validity duration of this value.")
 	fs.DurationVar(&o.ViewerKubeconfigMaxExpiration, "shoot-viewer-kubeconfig-max-expiration", time.Hour*24, "The maximum validity duration of a credential requested to a Shoot by an ViewerKubeconfigRequest. If an otherwise valid ViewerKubeconfigRequest with a validity duration larger than this value is requested, a credential will be issued with a validity duration of this value.")
+	fs.DurationVar(&o.TokenExchangeKubeconfigMaxExpiration, "shoot-token-exchange-kubeconfig-max-expiration", time.Hour, "The maximum validity duration of a credential requested to a Shoot by a TokenExchangeRequest. If an otherwise valid TokenExchangeRequest with a validity duration larger than this value is requested, a credential will be issued with a validity duration of this value.")
 	fs.DurationVar(&o.CredentialsRotationInterval, "shoot-credentials-rotation-interval", time.Hour*24*90, "The duration after the initial shoot creation or the last credentials rotation when a client warning for the next credentials rotation is issued.")
 	fs.StringVar(&o.WorkloadIdentityTokenIssuer, "workload-identity-token-issuer", o.WorkloadIdentityTokenIssuer, "The issuer identifier of the workload identity tokens set in the 'iss' claim. If set, it must be a valid URL")
 	fs.DurationVar(&o.WorkloadIdentityTokenMinExpiration, "workload-identity-token-min-expiration", time.Hour, "The minimum validity duration of a workload identity token. If an otherwise valid TokenRequest with a validity duration less than this value is requested, a token will be issued with a validity duration of this value.")
 	fs.DurationVar(&o.WorkloadIdentityTokenMaxExpiration, "workload-identity-token-max-expiration", time.Hour*48, "The maximum validity duration of a workload identity token. If an otherwise valid TokenRequest with a validity duration greater than this value is requested, a token will be issued with a validity duration of this value.")
 	fs.StringVar(&o.WorkloadIdentitySigningKeyFile, "workload-identity-signing-key-file", o.WorkloadIdentitySigningKeyFile, "Path to the file that contains the current private key of the workload identity token issuer. The issuer will sign issued ID tokens with this private key.")
+	fs.StringVar(&o.ProjectEncryptionConfigFile, "project-encryption-config", o.ProjectEncryptionConfigFile, "Path to the file that contains the configuration for encrypting project-scoped resources (e.g., ShootStates and InternalSecrets) with per-project data keys. The encryption is applied in addition to the one configured via --encryption-provider-config.")
 
 	fs.StringVar(&o.LogLevel, "log-level", "info", "The level/severity for the logs. Must be one of [info,debug,error]")
 	fs.StringVar(&o.LogFormat, "log-format", "json", "The format for the logs. Must be one of [json,text]")
@@ -208,6 +247,7 @@ func (o *ExtraOptions) AddFlags(fs *pflag.FlagSet) {
 func (o *ExtraOptions) ApplyTo(c *Config) error {
 	c.ExtraConfig.AdminKubeconfigMaxExpiration = o.AdminKubeconfigMaxExpiration
 	c.ExtraConfig.ViewerKubeconfigMaxExpiration = o.ViewerKubeconfigMaxExpiration
+	c.ExtraConfig.TokenExchangeKubeconfigMaxExpiration = o.TokenExchangeKubeconfigMaxExpiration
 	c.ExtraConfig.CredentialsRotationInterval = o.CredentialsRotationInterval
 	c.ExtraConfig.WorkloadIdentityTokenIssuer = o.WorkloadIdentityTokenIssuer
 	c.ExtraConfig.WorkloadIdentityTokenMinExpiration = o.WorkloadIdentityTokenMinExpiration
@@ -0,0 +1,133 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cel_test
+
+import (
+	"context"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/apis/core/validation"
+	. "github.com/gardener/gardener/pkg/apiserver/cel"
+)
+
+// The invariants below are enforced by both the Go validation and the CEL validation rules. These tests ensure that
+// both implementations agree, i.e., that they either both reject or both accept an object.
+var _ = Describe("Parity with Go validation", func() {
+	var ctx = context.Background()
+
+	filter := func(allErrs field.ErrorList, detail string) field.ErrorList {
+		var filtered field.ErrorList
+		for _, err := range allErrs {
+			if strings.Contains(err.Detail, detail) {
+				filtered = append(filtered, err)
+			}
+		}
+		return filtered
+	}
+
+	Describe("CloudProfile", func() {
+		var (
+			validator = NewValidator(gardencorev1beta1.SchemeGroupVersion.WithKind("CloudProfile"))
+
+			supported  = ptr.To(core.ClassificationSupported)
+			deprecated = ptr.To(core.ClassificationDeprecated)
+		)
+
+		DescribeTable("supported versions per minor version",
+			func(kubernetesVersions []core.ExpirableVersion, machineImageVersions []core.MachineImageVersion, rejected bool) {
+				cloudProfile := &core.CloudProfile{
+					ObjectMeta: metav1.ObjectMeta{Name: "profile"},
+					Spec: core.CloudProfileSpec{
+						Kubernetes: core.KubernetesSettings{Versions: kubernetesVersions},
+						MachineImages: []core.MachineImage{{
+							Name:     "image",
+							Versions: machineImageVersions,
+						}},
+						Type: "local",
+					},
+				}
+
+				goErrs := filter(validation.ValidateCloudProfile(cloudProfile), `Only one "supported" version is allowed per minor version`)
+				celErrs := validator.Validate(ctx, cloudProfile, nil)
+
+				if rejected {
+					Expect(goErrs).NotTo(BeEmpty())
+					Expect(celErrs).NotTo(BeEmpty())
+				} else {
+					Expect(goErrs).To(BeEmpty())
+					Expect(celErrs).To(BeEmpty())
+				}
+			},
+
+			Entry("one supported version per minor version",
+				[]core.ExpirableVersion{{Version: "1.31.1", Classification: supported}, {Version: "1.31.0", Classification: deprecated}, {Version: "1.30.5", Classification: supported}},
+				[]core.MachineImageVersion{{ExpirableVersion: core.ExpirableVersion{Version: "1.2.3", Classification: supported}}, {ExpirableVersion: core.ExpirableVersion{Version: "1.3.0", Classification: supported}}},
+				false,
+			),
+			Entry("multiple supported Kubernetes versions per minor version",
+				[]core.ExpirableVersion{{Version: "1.31.1", Classification: supported}, {Version: "1.31.0", Classification: supported}},
+				[]core.MachineImageVersion{{ExpirableVersion: core.ExpirableVersion{Version: "1.2.3", Classification: supported}}},
+				true,
+			),
+			Entry("multiple supported machine image versions per minor version",
+				[]core.ExpirableVersion{{Version: "1.31.1", Classification: supported}},
+				[]core.MachineImageVersion{{ExpirableVersion: core.ExpirableVersion{Version: "1.2.3", Classification: supported}}, {ExpirableVersion: core.ExpirableVersion{Version: "1.2.2", Classification: supported}}},
+				true,
+			),
+			Entry("multiple supported versions of different minor versions",
+				[]core.ExpirableVersion{{Version: "1.31.1", Classification: supported}, {Version: "1.30.0", Classification: supported}, {Version: "1.29.0", Classification: supported}},
+				[]core.MachineImageVersion{{ExpirableVersion: core.ExpirableVersion{Version: "1.2.3", Classification: supported}}, {ExpirableVersion: core.ExpirableVersion{Version: "2.2.3", Classification: supported}}},
+				false,
+			),
+		)
+	})
+
+	Describe("Seed", func() {
+		var validator = NewValidator(gardencorev1beta1.SchemeGroupVersion.WithKind("Seed"))
+
+		DescribeTable("overlapping networks",
+			func(nodes *string, pods, services string, shootDefaults *core.ShootNetworks, rejected bool) {
+				seed := &core.Seed{
+					ObjectMeta: metav1.ObjectMeta{Name: "seed"},
+					Spec: core.SeedSpec{
+						Networks: core.SeedNetworks{
+							Nodes:         nodes,
+							Pods:          pods,
+							Services:      services,
+							ShootDefaults: shootDefaults,
+						},
+					},
+				}
+
+				goErrs := filter(validation.ValidateSeed(seed), `must not overlap with "spec.networks.`)
+				celErrs := validator.Validate(ctx, seed, nil)
+
+				if rejected {
+					Expect(goErrs).NotTo(BeEmpty())
+					Expect(celErrs).NotTo(BeEmpty())
+				} else {
+					Expect(goErrs).To(BeEmpty())
+					Expect(celErrs).To(BeEmpty())
+				}
+			},
+
+			Entry("non-overlapping networks", ptr.To("10.250.0.0/16"), "100.96.0.0/11", "100.64.0.0/13", &core.ShootNetworks{Pods: ptr.To("100.128.0.0/11"), Services: ptr.To("100.72.0.0/13")}, false),
+			Entry("non-overlapping networks without optional networks", nil, "100.96.0.0/11", "100.64.0.0/13", nil, false),
+			Entry("services overlapping with pods", nil, "10.0.1.0/24", "10.0.1.64/26", nil, true),
+			Entry("nodes overlapping with pods", ptr.To("10.0.0.0/8"), "10.0.1.0/24", "100.64.0.0/13", nil, true),
+			Entry("shoot default pods overlapping with services", ptr.To("10.250.0.0/16"), "100.96.0.0/11", "100.64.0.0/13", &core.ShootNetworks{Pods: ptr.To("100.64.0.0/16")}, true),
+			Entry("shoot default services overlapping with nodes", ptr.To("10.250.0.0/16"), "100.96.0.0/11", "100.64.0.0/13", &core.ShootNetworks{Services: ptr.To("10.250.100.0/24")}, true),
+			Entry("shoot default services overlapping with shoot default pods", nil, "100.96.0.0/11", "100.64.0.0/13", &core.ShootNetworks{Pods: ptr.To("100.128.0.0/11"), Services: ptr.To("100.128.0.0/13")}, true),
+		)
+	})
+})
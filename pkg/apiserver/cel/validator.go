@@ -0,0 +1,187 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cel
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"k8s.io/apiextensions-apiserver/pkg/apis/apiextensions"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	structuralschema "k8s.io/apiextensions-apiserver/pkg/apiserver/schema"
+	apiextensionscel "k8s.io/apiextensions-apiserver/pkg/apiserver/schema/cel"
+	"k8s.io/apiextensions-apiserver/pkg/apiserver/schema/cel/model"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	celconfig "k8s.io/apiserver/pkg/apis/cel"
+	"k8s.io/apiserver/pkg/cel/environment"
+	"k8s.io/apiserver/pkg/cel/openapi/resolver"
+
+	"github.com/gardener/gardener/pkg/api"
+	"github.com/gardener/gardener/pkg/apiserver/openapi"
+)
+
+// Validator validates objects against the CEL validation rules (x-kubernetes-validations) which are declared via
+// `+k8s:validation:cel` markers on the versioned API types and hence are part of the generated OpenAPI definitions.
+// The rules are compiled lazily when the first object is validated and the result is cached for subsequent calls.
+type Validator struct {
+	gvk schema.GroupVersionKind
+
+	once      sync.Once
+	validator *apiextensionscel.Validator
+	err       error
+}
+
+// NewValidator returns a Validator for the CEL validation rules of the OpenAPI schema of the given group, version and
+// kind. Objects passed to the Validator can be of any version (including the internal one) known to the Gardener API
+// scheme, they are converted to the given version before the rules are evaluated.
+func NewValidator(gvk schema.GroupVersionKind) *Validator {
+	return &Validator{gvk: gvk}
+}
+
+// Compile compiles the CEL validation rules if this has not happened yet and returns the compilation error, if any.
+func (v *Validator) Compile() error {
+	v.once.Do(func() {
+		v.validator, v.err = compile(v.gvk)
+	})
+	return v.err
+}
+
+// Validate evaluates the CEL validation rules for the given object. The old object is optional and only needed for
+// transition rules (i.e., rules referring to `oldSelf`), it must be nil for create requests. If the rules cannot be
+// compiled, an internal error is returned instead of evaluating them.
+func (v *Validator) Validate(ctx context.Context, obj, oldObj runtime.Object) field.ErrorList {
+	if err := v.Compile(); err != nil {
+		return field.ErrorList{field.InternalError(nil, err)}
+	}
+
+	if v.validator == nil {
+		// the schema does not declare any CEL validation rules
+		return nil
+	}
+
+	newUnstructured, err := v.toUnstructured(obj)
+	if err != nil {
+		return field.ErrorList{field.InternalError(nil, err)}
+	}
+
+	var oldUnstructured map[string]interface{}
+	if oldObj != nil {
+		if oldUnstructured, err = v.toUnstructured(oldObj); err != nil {
+			return field.ErrorList{field.InternalError(nil, err)}
+		}
+	}
+
+	// The schema is only needed by the validator when it is called for nested schemas, hence it can be left empty here.
+	errs, _ := v.validator.Validate(ctx, nil, nil, newUnstructured, oldUnstructured, celconfig.RuntimeCELCostBudget)
+	return errs
+}
+
+// AppendErrors appends the errors returned by the CEL validation rules to the given errors of the Go validation. Some
+// invariants are enforced by both, hence CEL errors for a field which is already reported (or whose parent or child
+// field is already reported) by the Go validation are dropped to not report the same violation twice.
+func AppendErrors(allErrs, celErrs field.ErrorList) field.ErrorList {
+	for _, celErr := range celErrs {
+		if !isReported(allErrs, celErr.Field) {
+			allErrs = append(allErrs, celErr)
+		}
+	}
+	return allErrs
+}
+
+func isReported(allErrs field.ErrorList, fieldPath string) bool {
+	for _, err := range allErrs {
+		if err.Field == fieldPath || isChildPath(err.Field, fieldPath) || isChildPath(fieldPath, err.Field) {
+			return true
+		}
+	}
+	return false
+}
+
+func isChildPath(child, parent string) bool {
+	return strings.HasPrefix(child, parent+".") || strings.HasPrefix(child, parent+"[")
+}
+
+func compile(gvk schema.GroupVersionKind) (*apiextensionscel.Validator, error) {
+	openAPISchema, err := resolver.NewDefinitionsSchemaResolver(openapi.GetOpenAPIDefinitions, api.Scheme).ResolveSchema(gvk)
+	if err != nil {
+		return nil, fmt.Errorf("failed resolving OpenAPI schema for %s: %w", gvk, err)
+	}
+
+	// The OpenAPI schema is converted to the apiextensions representation via its JSON serialization, this is the same
+	// format that is used for the OpenAPI schemas of CustomResourceDefinitions.
+	raw, err := json.Marshal(openAPISchema)
+	if err != nil {
+		return nil, fmt.Errorf("failed marshalling OpenAPI schema for %s: %w", gvk, err)
+	}
+
+	schemaV1 := &apiextensionsv1.JSONSchemaProps{}
+	if err := json.Unmarshal(raw, schemaV1); err != nil {
+		return nil, fmt.Errorf("failed unmarshalling OpenAPI schema for %s: %w", gvk, err)
+	}
+
+	schemaInternal := &apiextensions.JSONSchemaProps{}
+	if err := apiextensionsv1.Convert_v1_JSONSchemaProps_To_apiextensions_JSONSchemaProps(schemaV1, schemaInternal, nil); err != nil {
+		return nil, fmt.Errorf("failed converting OpenAPI schema for %s: %w", gvk, err)
+	}
+
+	structural, err := structuralschema.NewStructural(schemaInternal)
+	if err != nil {
+		return nil, fmt.Errorf("failed computing structural schema for %s: %w", gvk, err)
+	}
+
+	if errs := compilationErrors(structural, true, nil); len(errs) > 0 {
+		return nil, fmt.Errorf("failed compiling CEL validation rules for %s: %w", gvk, errs.ToAggregate())
+	}
+
+	return apiextensionscel.NewValidator(structural, true, celconfig.PerCallLimit), nil
+}
+
+func (v *Validator) toUnstructured(obj runtime.Object) (map[string]interface{}, error) {
+	versioned, err := api.Scheme.ConvertToVersion(obj, v.gvk.GroupVersion())
+	if err != nil {
+		return nil, fmt.Errorf("failed converting object to %s: %w", v.gvk.GroupVersion(), err)
+	}
+	return runtime.DefaultUnstructuredConverter.ToUnstructured(versioned)
+}
+
+// compilationErrors compiles all CEL validation rules of the given schema and its nested schemas and returns the
+// compilation errors. The validator returned by the apiextensions library would only report them when evaluating the
+// rules, but the rules are part of the API definitions and hence invalid rules should be reported as such.
+func compilationErrors(s *structuralschema.Structural, isResourceRoot bool, fldPath *field.Path) field.ErrorList {
+	if s == nil {
+		return nil
+	}
+
+	allErrs := field.ErrorList{}
+
+	if len(s.XValidations) > 0 {
+		results, err := apiextensionscel.Compile(s, model.SchemaDeclType(s, isResourceRoot), celconfig.PerCallLimit, environment.MustBaseEnvSet(environment.DefaultCompatibilityVersion(), true), apiextensionscel.NewExpressionsEnvLoader())
+		if err != nil {
+			allErrs = append(allErrs, field.InternalError(fldPath, err))
+		}
+		for i, result := range results {
+			if result.Error != nil {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("x-kubernetes-validations").Index(i), s.XValidations[i].Rule, result.Error.Detail))
+			}
+		}
+	}
+
+	for name, property := range s.Properties {
+		allErrs = append(allErrs, compilationErrors(&property, false, fldPath.Child(name))...)
+	}
+	if s.Items != nil {
+		allErrs = append(allErrs, compilationErrors(s.Items, false, fldPath.Child("items"))...)
+	}
+	if s.AdditionalProperties != nil {
+		allErrs = append(allErrs, compilationErrors(s.AdditionalProperties.Structural, false, fldPath.Child("additionalProperties"))...)
+	}
+
+	return allErrs
+}
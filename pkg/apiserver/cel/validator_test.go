@@ -0,0 +1,228 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cel_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/gardener/gardener/pkg/apiserver/cel"
+)
+
+var _ = Describe("Validator", func() {
+	var ctx = context.Background()
+
+	Describe("#NewValidator", func() {
+		It("should return an internal error for an unknown kind", func() {
+			validator := NewValidator(gardencorev1beta1.SchemeGroupVersion.WithKind("Foo"))
+
+			Expect(validator.Compile()).To(MatchError(ContainSubstring("failed resolving OpenAPI schema")))
+			Expect(validator.Validate(ctx, &core.Project{ObjectMeta: metav1.ObjectMeta{Name: "foo"}}, nil)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeInternal),
+				"Detail": ContainSubstring("failed resolving OpenAPI schema"),
+			}))))
+		})
+
+		It("should succeed for a kind without validation rules", func() {
+			validator := NewValidator(gardencorev1beta1.SchemeGroupVersion.WithKind("Project"))
+
+			Expect(validator.Compile()).To(Succeed())
+			Expect(validator.Validate(ctx, &core.Project{ObjectMeta: metav1.ObjectMeta{Name: "foo"}}, nil)).To(BeEmpty())
+		})
+	})
+
+	Describe("#AppendErrors", func() {
+		It("should drop CEL errors for fields which are already reported", func() {
+			allErrs := field.ErrorList{
+				field.Forbidden(field.NewPath("spec", "kubernetes", "versions").Index(1), "go"),
+				field.Invalid(field.NewPath("spec", "networks", "services"), "10.0.0.0/24", "go"),
+			}
+
+			Expect(AppendErrors(allErrs, field.ErrorList{
+				field.Invalid(field.NewPath("spec", "kubernetes", "versions"), nil, "cel"),
+				field.Invalid(field.NewPath("spec", "networks", "services"), nil, "cel"),
+				field.Invalid(field.NewPath("spec", "networks", "servicesFoo"), nil, "cel"),
+				field.Invalid(field.NewPath("spec", "machineImages").Index(0).Child("versions"), nil, "cel"),
+			})).To(HaveExactElements(
+				allErrs[0],
+				allErrs[1],
+				field.Invalid(field.NewPath("spec", "networks", "servicesFoo"), nil, "cel"),
+				field.Invalid(field.NewPath("spec", "machineImages").Index(0).Child("versions"), nil, "cel"),
+			))
+		})
+	})
+
+	Describe("CloudProfile", func() {
+		var (
+			validator *Validator
+
+			supported  = ptr.To(core.ClassificationSupported)
+			deprecated = ptr.To(core.ClassificationDeprecated)
+
+			cloudProfile *core.CloudProfile
+		)
+
+		BeforeEach(func() {
+			validator = NewValidator(gardencorev1beta1.SchemeGroupVersion.WithKind("CloudProfile"))
+
+			cloudProfile = &core.CloudProfile{
+				ObjectMeta: metav1.ObjectMeta{Name: "profile"},
+				Spec: core.CloudProfileSpec{
+					Kubernetes: core.KubernetesSettings{
+						Versions: []core.ExpirableVersion{
+							{Version: "1.31.1", Classification: supported},
+							{Version: "1.31.0", Classification: deprecated},
+							{Version: "1.30.5", Classification: supported},
+							{Version: "1.30.4"},
+						},
+					},
+					MachineImages: []core.MachineImage{{
+						Name: "image",
+						Versions: []core.MachineImageVersion{
+							{ExpirableVersion: core.ExpirableVersion{Version: "1.2.3", Classification: supported}},
+							{ExpirableVersion: core.ExpirableVersion{Version: "1.3", Classification: supported}},
+							{ExpirableVersion: core.ExpirableVersion{Version: "1.2.2", Classification: deprecated}},
+						},
+					}},
+				},
+			}
+		})
+
+		It("should allow one supported version per minor version", func() {
+			Expect(validator.Validate(ctx, cloudProfile, nil)).To(BeEmpty())
+		})
+
+		It("should forbid multiple supported Kubernetes versions per minor version", func() {
+			cloudProfile.Spec.Kubernetes.Versions[1].Classification = supported
+
+			Expect(validator.Validate(ctx, cloudProfile, nil)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeInvalid),
+				"Field":  Equal("spec.kubernetes.versions"),
+				"Detail": Equal("only one supported version is allowed per minor version"),
+			}))))
+		})
+
+		It("should forbid multiple supported machine image versions per minor version", func() {
+			cloudProfile.Spec.MachineImages[0].Versions[2].Classification = supported
+
+			Expect(validator.Validate(ctx, cloudProfile, nil)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeInvalid),
+				"Field":  Equal("spec.machineImages[0].versions"),
+				"Detail": Equal("only one supported version is allowed per minor version"),
+			}))))
+		})
+
+		It("should ignore versions which are not semantic versions", func() {
+			cloudProfile.Spec.MachineImages[0].Versions = append(cloudProfile.Spec.MachineImages[0].Versions,
+				core.MachineImageVersion{ExpirableVersion: core.ExpirableVersion{Version: "1", Classification: supported}},
+				core.MachineImageVersion{ExpirableVersion: core.ExpirableVersion{Version: "2", Classification: supported}},
+			)
+
+			Expect(validator.Validate(ctx, cloudProfile, nil)).To(BeEmpty())
+		})
+	})
+
+	Describe("Seed", func() {
+		var (
+			validator *Validator
+			seed      *core.Seed
+		)
+
+		BeforeEach(func() {
+			validator = NewValidator(gardencorev1beta1.SchemeGroupVersion.WithKind("Seed"))
+
+			seed = &core.Seed{
+				ObjectMeta: metav1.ObjectMeta{Name: "seed"},
+				Spec: core.SeedSpec{
+					Networks: core.SeedNetworks{
+						Nodes:    ptr.To("10.250.0.0/16"),
+						Pods:     "100.96.0.0/11",
+						Services: "100.64.0.0/13",
+						ShootDefaults: &core.ShootNetworks{
+							Pods:     ptr.To("100.128.0.0/11"),
+							Services: ptr.To("100.72.0.0/13"),
+						},
+					},
+				},
+			}
+		})
+
+		It("should allow non-overlapping networks", func() {
+			Expect(validator.Validate(ctx, seed, nil)).To(BeEmpty())
+		})
+
+		It("should allow non-overlapping networks without optional networks", func() {
+			seed.Spec.Networks.Nodes = nil
+			seed.Spec.Networks.ShootDefaults = nil
+
+			Expect(validator.Validate(ctx, seed, nil)).To(BeEmpty())
+		})
+
+		It("should allow non-overlapping dual-stack networks", func() {
+			seed.Spec.Networks.Nodes = ptr.To("2001:db8:1::/48")
+			seed.Spec.Networks.Pods = "2001:db8:2::/48"
+			seed.Spec.Networks.Services = "100.64.0.0/13"
+			seed.Spec.Networks.ShootDefaults = nil
+
+			Expect(validator.Validate(ctx, seed, nil)).To(BeEmpty())
+		})
+
+		It("should ignore invalid CIDRs", func() {
+			seed.Spec.Networks.Nodes = ptr.To("foo")
+			seed.Spec.Networks.Services = "bar"
+
+			Expect(validator.Validate(ctx, seed, nil)).To(BeEmpty())
+		})
+
+		It("should forbid overlapping networks", func() {
+			seed.Spec.Networks.Nodes = ptr.To("10.0.0.0/8")
+			seed.Spec.Networks.Pods = "10.0.1.0/24"
+			seed.Spec.Networks.Services = "10.0.1.64/26"
+			seed.Spec.Networks.ShootDefaults.Pods = ptr.To("10.0.1.128/26")
+			seed.Spec.Networks.ShootDefaults.Services = ptr.To("10.0.1.128/26")
+
+			Expect(validator.Validate(ctx, seed, nil)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("spec.networks.services"),
+					"Detail": Equal("must not overlap with the pod network"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("spec.networks.nodes"),
+					"Detail": Equal("must not overlap with the pod or service network"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("spec.networks.shootDefaults.pods"),
+					"Detail": Equal("must not overlap with the pod, service or node network"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("spec.networks.shootDefaults.services"),
+					"Detail": Equal("must not overlap with the pod, service or node network or the default shoot pod network"),
+				})),
+			))
+		})
+
+		It("should forbid a shoot default network overlapping with the node network", func() {
+			seed.Spec.Networks.ShootDefaults.Services = ptr.To("10.250.100.0/24")
+
+			Expect(validator.Validate(ctx, seed, nil)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Field": Equal("spec.networks.shootDefaults.services"),
+				})),
+			))
+		})
+	})
+})
@@ -24,6 +24,12 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/authentication/v1alpha1.AdminKubeconfigRequest":          schema_pkg_apis_authentication_v1alpha1_AdminKubeconfigRequest(ref),
 		"github.com/gardener/gardener/pkg/apis/authentication/v1alpha1.AdminKubeconfigRequestSpec":      schema_pkg_apis_authentication_v1alpha1_AdminKubeconfigRequestSpec(ref),
 		"github.com/gardener/gardener/pkg/apis/authentication/v1alpha1.AdminKubeconfigRequestStatus":    schema_pkg_apis_authentication_v1alpha1_AdminKubeconfigRequestStatus(ref),
+		"github.com/gardener/gardener/pkg/apis/authentication/v1alpha1.KubeconfigBundleRequest":         schema_pkg_apis_authentication_v1alpha1_KubeconfigBundleRequest(ref),
+		"github.com/gardener/gardener/pkg/apis/authentication/v1alpha1.KubeconfigBundleRequestSpec":     schema_pkg_apis_authentication_v1alpha1_KubeconfigBundleRequestSpec(ref),
+		"github.com/gardener/gardener/pkg/apis/authentication/v1alpha1.KubeconfigBundleRequestStatus":   schema_pkg_apis_authentication_v1alpha1_KubeconfigBundleRequestStatus(ref),
+		"github.com/gardener/gardener/pkg/apis/authentication/v1alpha1.TokenExchangeRequest":            schema_pkg_apis_authentication_v1alpha1_TokenExchangeRequest(ref),
+		"github.com/gardener/gardener/pkg/apis/authentication/v1alpha1.TokenExchangeRequestSpec":        schema_pkg_apis_authentication_v1alpha1_TokenExchangeRequestSpec(ref),
+		"github.com/gardener/gardener/pkg/apis/authentication/v1alpha1.TokenExchangeRequestStatus":      schema_pkg_apis_authentication_v1alpha1_TokenExchangeRequestStatus(ref),
 		"github.com/gardener/gardener/pkg/apis/authentication/v1alpha1.ViewerKubeconfigRequest":         schema_pkg_apis_authentication_v1alpha1_ViewerKubeconfigRequest(ref),
 		"github.com/gardener/gardener/pkg/apis/authentication/v1alpha1.ViewerKubeconfigRequestSpec":     schema_pkg_apis_authentication_v1alpha1_ViewerKubeconfigRequestSpec(ref),
 		"github.com/gardener/gardener/pkg/apis/authentication/v1alpha1.ViewerKubeconfigRequestStatus":   schema_pkg_apis_authentication_v1alpha1_ViewerKubeconfigRequestStatus(ref),
@@ -31,19 +37,25 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1.ControllerDeploymentList":                        schema_pkg_apis_core_v1_ControllerDeploymentList(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1.HelmControllerDeployment":                        schema_pkg_apis_core_v1_HelmControllerDeployment(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1.OCIRepository":                                   schema_pkg_apis_core_v1_OCIRepository(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.APIPriorityAndFairness":                     schema_pkg_apis_core_v1beta1_APIPriorityAndFairness(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.APIServerLogging":                           schema_pkg_apis_core_v1beta1_APIServerLogging(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.APIServerRequests":                          schema_pkg_apis_core_v1beta1_APIServerRequests(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.AccessRestriction":                          schema_pkg_apis_core_v1beta1_AccessRestriction(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.AccessRestrictionWithOptions":               schema_pkg_apis_core_v1beta1_AccessRestrictionWithOptions(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Addon":                                      schema_pkg_apis_core_v1beta1_Addon(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.AddonDefinition":                            schema_pkg_apis_core_v1beta1_AddonDefinition(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.AddonVersion":                               schema_pkg_apis_core_v1beta1_AddonVersion(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Addons":                                     schema_pkg_apis_core_v1beta1_Addons(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.AdmissionPlugin":                            schema_pkg_apis_core_v1beta1_AdmissionPlugin(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Alerting":                                   schema_pkg_apis_core_v1beta1_Alerting(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.AuditConfig":                                schema_pkg_apis_core_v1beta1_AuditConfig(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.AuditPolicy":                                schema_pkg_apis_core_v1beta1_AuditPolicy(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.AuditSink":                                  schema_pkg_apis_core_v1beta1_AuditSink(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.AuditWebhook":                               schema_pkg_apis_core_v1beta1_AuditWebhook(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.AuthorizerKubeconfigReference":              schema_pkg_apis_core_v1beta1_AuthorizerKubeconfigReference(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.AvailabilityZone":                           schema_pkg_apis_core_v1beta1_AvailabilityZone(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.BackupBucket":                               schema_pkg_apis_core_v1beta1_BackupBucket(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.BackupBucketImmutability":                   schema_pkg_apis_core_v1beta1_BackupBucketImmutability(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.BackupBucketList":                           schema_pkg_apis_core_v1beta1_BackupBucketList(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.BackupBucketProvider":                       schema_pkg_apis_core_v1beta1_BackupBucketProvider(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.BackupBucketSpec":                           schema_pkg_apis_core_v1beta1_BackupBucketSpec(ref),
@@ -61,11 +73,14 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.CloudProfileList":                           schema_pkg_apis_core_v1beta1_CloudProfileList(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.CloudProfileReference":                      schema_pkg_apis_core_v1beta1_CloudProfileReference(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.CloudProfileSpec":                           schema_pkg_apis_core_v1beta1_CloudProfileSpec(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.CloudProfileStatus":                         schema_pkg_apis_core_v1beta1_CloudProfileStatus(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.CloudProfileUsage":                          schema_pkg_apis_core_v1beta1_CloudProfileUsage(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ClusterAutoscaler":                          schema_pkg_apis_core_v1beta1_ClusterAutoscaler(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ClusterAutoscalerOptions":                   schema_pkg_apis_core_v1beta1_ClusterAutoscalerOptions(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Condition":                                  schema_pkg_apis_core_v1beta1_Condition(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ContainerRuntime":                           schema_pkg_apis_core_v1beta1_ContainerRuntime(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ControlPlane":                               schema_pkg_apis_core_v1beta1_ControlPlane(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ControlPlaneEgress":                         schema_pkg_apis_core_v1beta1_ControlPlaneEgress(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ControllerDeployment":                       schema_pkg_apis_core_v1beta1_ControllerDeployment(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ControllerDeploymentList":                   schema_pkg_apis_core_v1beta1_ControllerDeploymentList(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ControllerInstallation":                     schema_pkg_apis_core_v1beta1_ControllerInstallation(ref),
@@ -80,22 +95,36 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ControllerResourceLifecycle":                schema_pkg_apis_core_v1beta1_ControllerResourceLifecycle(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.CoreDNS":                                    schema_pkg_apis_core_v1beta1_CoreDNS(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.CoreDNSAutoscaling":                         schema_pkg_apis_core_v1beta1_CoreDNSAutoscaling(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.CoreDNSCustomPlugins":                       schema_pkg_apis_core_v1beta1_CoreDNSCustomPlugins(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.CoreDNSDNS64":                               schema_pkg_apis_core_v1beta1_CoreDNSDNS64(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.CoreDNSForwardZone":                         schema_pkg_apis_core_v1beta1_CoreDNSForwardZone(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.CoreDNSRewriting":                           schema_pkg_apis_core_v1beta1_CoreDNSRewriting(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.DNS":                                        schema_pkg_apis_core_v1beta1_DNS(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.DNSIncludeExclude":                          schema_pkg_apis_core_v1beta1_DNSIncludeExclude(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.DNSProvider":                                schema_pkg_apis_core_v1beta1_DNSProvider(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.DataResidency":                              schema_pkg_apis_core_v1beta1_DataResidency(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.DataVolume":                                 schema_pkg_apis_core_v1beta1_DataVolume(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.DeploymentRef":                              schema_pkg_apis_core_v1beta1_DeploymentRef(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.DeprecatedField":                            schema_pkg_apis_core_v1beta1_DeprecatedField(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.DualApprovalForDeletion":                    schema_pkg_apis_core_v1beta1_DualApprovalForDeletion(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ETCD":                                       schema_pkg_apis_core_v1beta1_ETCD(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ETCDBackup":                                 schema_pkg_apis_core_v1beta1_ETCDBackup(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ETCDEncryptionKeyRotation":                  schema_pkg_apis_core_v1beta1_ETCDEncryptionKeyRotation(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.EncryptionConfig":                           schema_pkg_apis_core_v1beta1_EncryptionConfig(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ErrorTriage":                                schema_pkg_apis_core_v1beta1_ErrorTriage(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.EtcdSnapshot":                               schema_pkg_apis_core_v1beta1_EtcdSnapshot(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ExpirableVersion":                           schema_pkg_apis_core_v1beta1_ExpirableVersion(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ExposureClass":                              schema_pkg_apis_core_v1beta1_ExposureClass(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ExposureClassIngressGateway":                schema_pkg_apis_core_v1beta1_ExposureClassIngressGateway(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ExposureClassList":                          schema_pkg_apis_core_v1beta1_ExposureClassList(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ExposureClassLoadBalancerService":           schema_pkg_apis_core_v1beta1_ExposureClassLoadBalancerService(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ExposureClassScheduling":                    schema_pkg_apis_core_v1beta1_ExposureClassScheduling(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Extension":                                  schema_pkg_apis_core_v1beta1_Extension(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ExtensionResourceState":                     schema_pkg_apis_core_v1beta1_ExtensionResourceState(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.FailureTolerance":                           schema_pkg_apis_core_v1beta1_FailureTolerance(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.FeatureGate":                                schema_pkg_apis_core_v1beta1_FeatureGate(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.FeatureMatrix":                              schema_pkg_apis_core_v1beta1_FeatureMatrix(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.FeatureMatrixList":                          schema_pkg_apis_core_v1beta1_FeatureMatrixList(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Gardener":                                   schema_pkg_apis_core_v1beta1_Gardener(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.GardenerResourceData":                       schema_pkg_apis_core_v1beta1_GardenerResourceData(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.HelmControllerDeployment":                   schema_pkg_apis_core_v1beta1_HelmControllerDeployment(ref),
@@ -108,7 +137,10 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.InternalSecret":                             schema_pkg_apis_core_v1beta1_InternalSecret(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.InternalSecretList":                         schema_pkg_apis_core_v1beta1_InternalSecretList(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeAPIServerConfig":                        schema_pkg_apis_core_v1beta1_KubeAPIServerConfig(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeAPIServerEndpoint":                      schema_pkg_apis_core_v1beta1_KubeAPIServerEndpoint(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeAPIServerVPNConfig":                     schema_pkg_apis_core_v1beta1_KubeAPIServerVPNConfig(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeControllerManagerConfig":                schema_pkg_apis_core_v1beta1_KubeControllerManagerConfig(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeControllerManagerController":            schema_pkg_apis_core_v1beta1_KubeControllerManagerController(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeProxyConfig":                            schema_pkg_apis_core_v1beta1_KubeProxyConfig(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeSchedulerConfig":                        schema_pkg_apis_core_v1beta1_KubeSchedulerConfig(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeletConfig":                              schema_pkg_apis_core_v1beta1_KubeletConfig(ref),
@@ -116,6 +148,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeletConfigEvictionMinimumReclaim":        schema_pkg_apis_core_v1beta1_KubeletConfigEvictionMinimumReclaim(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeletConfigEvictionSoftGracePeriod":       schema_pkg_apis_core_v1beta1_KubeletConfigEvictionSoftGracePeriod(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeletConfigReserved":                      schema_pkg_apis_core_v1beta1_KubeletConfigReserved(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeletMemoryReservation":                   schema_pkg_apis_core_v1beta1_KubeletMemoryReservation(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Kubernetes":                                 schema_pkg_apis_core_v1beta1_Kubernetes(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubernetesConfig":                           schema_pkg_apis_core_v1beta1_KubernetesConfig(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubernetesDashboard":                        schema_pkg_apis_core_v1beta1_KubernetesDashboard(ref),
@@ -123,19 +156,27 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.LastError":                                  schema_pkg_apis_core_v1beta1_LastError(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.LastMaintenance":                            schema_pkg_apis_core_v1beta1_LastMaintenance(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.LastOperation":                              schema_pkg_apis_core_v1beta1_LastOperation(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.LegacyComponentRemoval":                     schema_pkg_apis_core_v1beta1_LegacyComponentRemoval(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.LoadBalancerServicesProxyProtocol":          schema_pkg_apis_core_v1beta1_LoadBalancerServicesProxyProtocol(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Machine":                                    schema_pkg_apis_core_v1beta1_Machine(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineControllerManagerSettings":           schema_pkg_apis_core_v1beta1_MachineControllerManagerSettings(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineImage":                               schema_pkg_apis_core_v1beta1_MachineImage(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineImageVersion":                        schema_pkg_apis_core_v1beta1_MachineImageVersion(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineImageVersionUsage":                   schema_pkg_apis_core_v1beta1_MachineImageVersionUsage(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineType":                                schema_pkg_apis_core_v1beta1_MachineType(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineTypeStorage":                         schema_pkg_apis_core_v1beta1_MachineTypeStorage(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Maintenance":                                schema_pkg_apis_core_v1beta1_Maintenance(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenanceAutoUpdate":                      schema_pkg_apis_core_v1beta1_MaintenanceAutoUpdate(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenanceExclusion":                       schema_pkg_apis_core_v1beta1_MaintenanceExclusion(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenanceTimeWindow":                      schema_pkg_apis_core_v1beta1_MaintenanceTimeWindow(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ManagedAddon":                               schema_pkg_apis_core_v1beta1_ManagedAddon(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MemorySwapConfiguration":                    schema_pkg_apis_core_v1beta1_MemorySwapConfiguration(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Monitoring":                                 schema_pkg_apis_core_v1beta1_Monitoring(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.NamedResourceReference":                     schema_pkg_apis_core_v1beta1_NamedResourceReference(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.NamespaceDefaults":                          schema_pkg_apis_core_v1beta1_NamespaceDefaults(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.NamespaceDefaultsLimitRange":                schema_pkg_apis_core_v1beta1_NamespaceDefaultsLimitRange(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.NamespaceDefaultsNetworkPolicies":           schema_pkg_apis_core_v1beta1_NamespaceDefaultsNetworkPolicies(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.NamespaceDefaultsResourceQuota":             schema_pkg_apis_core_v1beta1_NamespaceDefaultsResourceQuota(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.NamespacedCloudProfile":                     schema_pkg_apis_core_v1beta1_NamespacedCloudProfile(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.NamespacedCloudProfileList":                 schema_pkg_apis_core_v1beta1_NamespacedCloudProfileList(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.NamespacedCloudProfileSpec":                 schema_pkg_apis_core_v1beta1_NamespacedCloudProfileSpec(ref),
@@ -144,13 +185,18 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.NetworkingStatus":                           schema_pkg_apis_core_v1beta1_NetworkingStatus(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.NginxIngress":                               schema_pkg_apis_core_v1beta1_NginxIngress(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.NodeLocalDNS":                               schema_pkg_apis_core_v1beta1_NodeLocalDNS(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.NodeLocalDNSCache":                          schema_pkg_apis_core_v1beta1_NodeLocalDNSCache(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.NodeLocalDNSZoneProtocol":                   schema_pkg_apis_core_v1beta1_NodeLocalDNSZoneProtocol(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.OCIRepository":                              schema_pkg_apis_core_v1beta1_OCIRepository(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.OIDCConfig":                                 schema_pkg_apis_core_v1beta1_OIDCConfig(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ObservabilityRotation":                      schema_pkg_apis_core_v1beta1_ObservabilityRotation(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.OpenIDConnectClientAuthentication":          schema_pkg_apis_core_v1beta1_OpenIDConnectClientAuthentication(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.PendingMaintenanceOperation":                schema_pkg_apis_core_v1beta1_PendingMaintenanceOperation(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Project":                                    schema_pkg_apis_core_v1beta1_Project(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectList":                                schema_pkg_apis_core_v1beta1_ProjectList(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectMember":                              schema_pkg_apis_core_v1beta1_ProjectMember(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectMemberSync":                          schema_pkg_apis_core_v1beta1_ProjectMemberSync(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectMemberSyncGroup":                     schema_pkg_apis_core_v1beta1_ProjectMemberSyncGroup(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectSpec":                                schema_pkg_apis_core_v1beta1_ProjectSpec(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectStatus":                              schema_pkg_apis_core_v1beta1_ProjectStatus(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectTolerations":                         schema_pkg_apis_core_v1beta1_ProjectTolerations(ref),
@@ -159,6 +205,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.QuotaList":                                  schema_pkg_apis_core_v1beta1_QuotaList(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.QuotaSpec":                                  schema_pkg_apis_core_v1beta1_QuotaSpec(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Region":                                     schema_pkg_apis_core_v1beta1_Region(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.RegionMetadata":                             schema_pkg_apis_core_v1beta1_RegionMetadata(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ResourceData":                               schema_pkg_apis_core_v1beta1_ResourceData(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ResourceWatchCacheSize":                     schema_pkg_apis_core_v1beta1_ResourceWatchCacheSize(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SSHAccess":                                  schema_pkg_apis_core_v1beta1_SSHAccess(ref),
@@ -167,12 +214,19 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SecretBindingProvider":                      schema_pkg_apis_core_v1beta1_SecretBindingProvider(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Seed":                                       schema_pkg_apis_core_v1beta1_Seed(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedBackup":                                 schema_pkg_apis_core_v1beta1_SeedBackup(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedBackupETCD":                             schema_pkg_apis_core_v1beta1_SeedBackupETCD(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedCredentials":                            schema_pkg_apis_core_v1beta1_SeedCredentials(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedCredentialsRotation":                    schema_pkg_apis_core_v1beta1_SeedCredentialsRotation(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedDNS":                                    schema_pkg_apis_core_v1beta1_SeedDNS(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedDNSProvider":                            schema_pkg_apis_core_v1beta1_SeedDNSProvider(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedIngressDomainMigration":                 schema_pkg_apis_core_v1beta1_SeedIngressDomainMigration(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedList":                                   schema_pkg_apis_core_v1beta1_SeedList(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedMaintenance":                            schema_pkg_apis_core_v1beta1_SeedMaintenance(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedNetworks":                               schema_pkg_apis_core_v1beta1_SeedNetworks(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedPendingMaintenanceOperation":            schema_pkg_apis_core_v1beta1_SeedPendingMaintenanceOperation(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedProvider":                               schema_pkg_apis_core_v1beta1_SeedProvider(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSelector":                               schema_pkg_apis_core_v1beta1_SeedSelector(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingACME":                            schema_pkg_apis_core_v1beta1_SeedSettingACME(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingDependencyWatchdog":              schema_pkg_apis_core_v1beta1_SeedSettingDependencyWatchdog(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingDependencyWatchdogProber":        schema_pkg_apis_core_v1beta1_SeedSettingDependencyWatchdogProber(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingDependencyWatchdogWeeder":        schema_pkg_apis_core_v1beta1_SeedSettingDependencyWatchdogWeeder(ref),
@@ -194,12 +248,16 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ServiceAccountKeyRotation":                  schema_pkg_apis_core_v1beta1_ServiceAccountKeyRotation(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Shoot":                                      schema_pkg_apis_core_v1beta1_Shoot(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootAdvertisedAddress":                     schema_pkg_apis_core_v1beta1_ShootAdvertisedAddress(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootClass":                                 schema_pkg_apis_core_v1beta1_ShootClass(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootClassList":                             schema_pkg_apis_core_v1beta1_ShootClassList(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootClassSpec":                             schema_pkg_apis_core_v1beta1_ShootClassSpec(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootCredentials":                           schema_pkg_apis_core_v1beta1_ShootCredentials(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootCredentialsRotation":                   schema_pkg_apis_core_v1beta1_ShootCredentialsRotation(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootKubeconfigRotation":                    schema_pkg_apis_core_v1beta1_ShootKubeconfigRotation(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootList":                                  schema_pkg_apis_core_v1beta1_ShootList(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootMachineImage":                          schema_pkg_apis_core_v1beta1_ShootMachineImage(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootNetworks":                              schema_pkg_apis_core_v1beta1_ShootNetworks(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootRemediation":                           schema_pkg_apis_core_v1beta1_ShootRemediation(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootSSHKeypairRotation":                    schema_pkg_apis_core_v1beta1_ShootSSHKeypairRotation(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootSpec":                                  schema_pkg_apis_core_v1beta1_ShootSpec(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootState":                                 schema_pkg_apis_core_v1beta1_ShootState(ref),
@@ -211,19 +269,31 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.StructuredAuthorization":                    schema_pkg_apis_core_v1beta1_StructuredAuthorization(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.SystemComponents":                           schema_pkg_apis_core_v1beta1_SystemComponents(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Toleration":                                 schema_pkg_apis_core_v1beta1_Toleration(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.TrustedIdentityProvider":                    schema_pkg_apis_core_v1beta1_TrustedIdentityProvider(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.TypeRegionAvailability":                     schema_pkg_apis_core_v1beta1_TypeRegionAvailability(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.VersionUsage":                               schema_pkg_apis_core_v1beta1_VersionUsage(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.VerticalPodAutoscaler":                      schema_pkg_apis_core_v1beta1_VerticalPodAutoscaler(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Volume":                                     schema_pkg_apis_core_v1beta1_Volume(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.VolumeType":                                 schema_pkg_apis_core_v1beta1_VolumeType(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.WatchCacheSizes":                            schema_pkg_apis_core_v1beta1_WatchCacheSizes(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Worker":                                     schema_pkg_apis_core_v1beta1_Worker(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerKubernetes":                           schema_pkg_apis_core_v1beta1_WorkerKubernetes(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerNodeTemplate":                         schema_pkg_apis_core_v1beta1_WorkerNodeTemplate(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerPoolMaintenance":                      schema_pkg_apis_core_v1beta1_WorkerPoolMaintenance(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerRolloutStrategy":                      schema_pkg_apis_core_v1beta1_WorkerRolloutStrategy(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerSystemComponents":                     schema_pkg_apis_core_v1beta1_WorkerSystemComponents(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkersSettings":                            schema_pkg_apis_core_v1beta1_WorkersSettings(ref),
 		"github.com/gardener/gardener/pkg/apis/operations/v1alpha1.Bastion":                             schema_pkg_apis_operations_v1alpha1_Bastion(ref),
 		"github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionIngressPolicy":                schema_pkg_apis_operations_v1alpha1_BastionIngressPolicy(ref),
 		"github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionList":                         schema_pkg_apis_operations_v1alpha1_BastionList(ref),
+		"github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionPort":                         schema_pkg_apis_operations_v1alpha1_BastionPort(ref),
 		"github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionSpec":                         schema_pkg_apis_operations_v1alpha1_BastionSpec(ref),
 		"github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionStatus":                       schema_pkg_apis_operations_v1alpha1_BastionStatus(ref),
+		"github.com/gardener/gardener/pkg/apis/operations/v1alpha1.ShootOperationBatch":                 schema_pkg_apis_operations_v1alpha1_ShootOperationBatch(ref),
+		"github.com/gardener/gardener/pkg/apis/operations/v1alpha1.ShootOperationBatchList":             schema_pkg_apis_operations_v1alpha1_ShootOperationBatchList(ref),
+		"github.com/gardener/gardener/pkg/apis/operations/v1alpha1.ShootOperationBatchSpec":             schema_pkg_apis_operations_v1alpha1_ShootOperationBatchSpec(ref),
+		"github.com/gardener/gardener/pkg/apis/operations/v1alpha1.ShootOperationBatchStatus":           schema_pkg_apis_operations_v1alpha1_ShootOperationBatchStatus(ref),
+		"github.com/gardener/gardener/pkg/apis/operations/v1alpha1.ShootOperationResult":                schema_pkg_apis_operations_v1alpha1_ShootOperationResult(ref),
 		"github.com/gardener/gardener/pkg/apis/security/v1alpha1.ContextObject":                         schema_pkg_apis_security_v1alpha1_ContextObject(ref),
 		"github.com/gardener/gardener/pkg/apis/security/v1alpha1.CredentialsBinding":                    schema_pkg_apis_security_v1alpha1_CredentialsBinding(ref),
 		"github.com/gardener/gardener/pkg/apis/security/v1alpha1.CredentialsBindingList":                schema_pkg_apis_security_v1alpha1_CredentialsBindingList(ref),
@@ -241,12 +311,14 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.GardenletDeployment":             schema_pkg_apis_seedmanagement_v1alpha1_GardenletDeployment(ref),
 		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.GardenletHelm":                   schema_pkg_apis_seedmanagement_v1alpha1_GardenletHelm(ref),
 		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.GardenletList":                   schema_pkg_apis_seedmanagement_v1alpha1_GardenletList(ref),
+		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.GardenletRegistry":               schema_pkg_apis_seedmanagement_v1alpha1_GardenletRegistry(ref),
 		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.GardenletSelfDeployment":         schema_pkg_apis_seedmanagement_v1alpha1_GardenletSelfDeployment(ref),
 		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.GardenletSpec":                   schema_pkg_apis_seedmanagement_v1alpha1_GardenletSpec(ref),
 		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.GardenletStatus":                 schema_pkg_apis_seedmanagement_v1alpha1_GardenletStatus(ref),
 		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.Image":                           schema_pkg_apis_seedmanagement_v1alpha1_Image(ref),
 		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.ManagedSeed":                     schema_pkg_apis_seedmanagement_v1alpha1_ManagedSeed(ref),
 		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.ManagedSeedList":                 schema_pkg_apis_seedmanagement_v1alpha1_ManagedSeedList(ref),
+		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.ManagedSeedMaintenance":          schema_pkg_apis_seedmanagement_v1alpha1_ManagedSeedMaintenance(ref),
 		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.ManagedSeedSet":                  schema_pkg_apis_seedmanagement_v1alpha1_ManagedSeedSet(ref),
 		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.ManagedSeedSetList":              schema_pkg_apis_seedmanagement_v1alpha1_ManagedSeedSetList(ref),
 		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.ManagedSeedSetSpec":              schema_pkg_apis_seedmanagement_v1alpha1_ManagedSeedSetSpec(ref),
@@ -255,6 +327,8 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.ManagedSeedStatus":               schema_pkg_apis_seedmanagement_v1alpha1_ManagedSeedStatus(ref),
 		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.ManagedSeedTemplate":             schema_pkg_apis_seedmanagement_v1alpha1_ManagedSeedTemplate(ref),
 		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.PendingReplica":                  schema_pkg_apis_seedmanagement_v1alpha1_PendingReplica(ref),
+		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.ProxyConfiguration":              schema_pkg_apis_seedmanagement_v1alpha1_ProxyConfiguration(ref),
+		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.RegistryMirror":                  schema_pkg_apis_seedmanagement_v1alpha1_RegistryMirror(ref),
 		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.RollingUpdateStrategy":           schema_pkg_apis_seedmanagement_v1alpha1_RollingUpdateStrategy(ref),
 		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.Shoot":                           schema_pkg_apis_seedmanagement_v1alpha1_Shoot(ref),
 		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.UpdateStrategy":                  schema_pkg_apis_seedmanagement_v1alpha1_UpdateStrategy(ref),
@@ -741,6 +815,215 @@ func schema_pkg_apis_authentication_v1alpha1_AdminKubeconfigRequestStatus(ref co
 	}
 }
 
+func schema_pkg_apis_authentication_v1alpha1_KubeconfigBundleRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "KubeconfigBundleRequest can be used to request a kubeconfig for a Shoot cluster which contains multiple contexts (admin, viewer, and in-cluster endpoint) and the full bundle of cluster CAs, including CAs which are introduced during an ongoing CA rotation.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard object metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec is the specification of the KubeconfigBundleRequest.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/authentication/v1alpha1.KubeconfigBundleRequestSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status is the status of the KubeconfigBundleRequest.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/authentication/v1alpha1.KubeconfigBundleRequestStatus"),
+						},
+					},
+				},
+				Required: []string{"spec", "status"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/authentication/v1alpha1.KubeconfigBundleRequestSpec", "github.com/gardener/gardener/pkg/apis/authentication/v1alpha1.KubeconfigBundleRequestStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_authentication_v1alpha1_KubeconfigBundleRequestSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "KubeconfigBundleRequestSpec contains the expiration time of the kubeconfig.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"expirationSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExpirationSeconds is the requested validity duration of the credentials. The credential issuer may return credentials with a different validity duration so a client needs to check the 'expirationTimestamp' field in a response. Defaults to 1 hour.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_authentication_v1alpha1_KubeconfigBundleRequestStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "KubeconfigBundleRequestStatus is the status of the KubeconfigBundleRequest containing the kubeconfig and expiration of the credentials.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kubeconfig": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kubeconfig contains the kubeconfig with admin and viewer contexts for the shoot cluster.",
+							Type:        []string{"string"},
+							Format:      "byte",
+						},
+					},
+					"expirationTimestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExpirationTimestamp is the expiration timestamp of the earliest expiring credential in the returned kubeconfig.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"kubeconfig", "expirationTimestamp"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_authentication_v1alpha1_TokenExchangeRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TokenExchangeRequest can be used to exchange a token issued by an external OIDC identity provider, which is trusted by the project of the Shoot, for a short-lived kubeconfig for a Shoot cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard object metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec is the specification of the TokenExchangeRequest.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/authentication/v1alpha1.TokenExchangeRequestSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status is the status of the TokenExchangeRequest.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/authentication/v1alpha1.TokenExchangeRequestStatus"),
+						},
+					},
+				},
+				Required: []string{"spec", "status"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/authentication/v1alpha1.TokenExchangeRequestSpec", "github.com/gardener/gardener/pkg/apis/authentication/v1alpha1.TokenExchangeRequestStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_authentication_v1alpha1_TokenExchangeRequestSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TokenExchangeRequestSpec contains the token to exchange and the expiration time of the kubeconfig.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"token": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Token is the token issued by a trusted identity provider of the Shoot's project.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"expirationSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExpirationSeconds is the requested validity duration of the credential. The credential issuer may return a credential with a different validity duration so a client needs to check the 'expirationTimestamp' field in a response. Defaults to 1 hour.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"token"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_authentication_v1alpha1_TokenExchangeRequestStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TokenExchangeRequestStatus is the status of the TokenExchangeRequest containing the kubeconfig and expiration of the credential.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kubeconfig": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kubeconfig contains the kubeconfig for the shoot cluster.",
+							Type:        []string{"string"},
+							Format:      "byte",
+						},
+					},
+					"expirationTimestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExpirationTimestamp is the expiration timestamp of the returned credential.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"kubeconfig", "expirationTimestamp"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_pkg_apis_authentication_v1alpha1_ViewerKubeconfigRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1009,6 +1292,33 @@ func schema_pkg_apis_core_v1_OCIRepository(ref common.ReferenceCallback) common.
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_APIPriorityAndFairness(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "APIPriorityAndFairness contains settings for the API Priority and Fairness feature of the kube-apiserver.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxSeats": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxSeats is the total number of seats (i.e., the concurrency limit) of the kube-apiserver which are shared by all priority levels. It cannot be combined with `.requests`.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"configMapName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConfigMapName is the name of the ConfigMap in the project namespace which contains custom FlowSchemas and PriorityLevelConfigurations (flowcontrol.apiserver.k8s.io/v1) in the `config.yaml` data key. They are deployed to the shoot cluster as managed resources. The mandatory and system-critical configurations cannot be overwritten, and custom FlowSchemas must neither take precedence over nor route requests to the system-critical ones.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_APIServerLogging(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1145,65 +1455,194 @@ func schema_pkg_apis_core_v1beta1_Addon(ref common.ReferenceCallback) common.Ope
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_Addons(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_AddonDefinition(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Addons is a collection of configuration for specific addons which are managed by the Gardener.",
+				Description: "AddonDefinition declares a managed addon which can be deployed into shoot clusters.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kubernetesDashboard": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "KubernetesDashboard holds configuration settings for the kubernetes dashboard addon.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.KubernetesDashboard"),
+							Description: "Name is the name of the addon.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"nginxIngress": {
+					"versions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "version",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
 						SchemaProps: spec.SchemaProps{
-							Description: "NginxIngress holds configuration settings for the nginx-ingress addon.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.NginxIngress"),
+							Description: "Versions contains the available versions of the addon.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.AddonVersion"),
+									},
+								},
+							},
+						},
+					},
+					"workerlessSupported": {
+						SchemaProps: spec.SchemaProps{
+							Description: "WorkerlessSupported specifies whether the addon can be enabled for workerless Shoot clusters, i.e., whether it does not require any nodes. Defaults to false.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"name", "versions"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubernetesDashboard", "github.com/gardener/gardener/pkg/apis/core/v1beta1.NginxIngress"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.AddonVersion"},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_AdmissionPlugin(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_AddonVersion(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "AdmissionPlugin contains information about a specific admission plugin and its corresponding configuration.",
+				Description: "AddonVersion is a version of a managed addon.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"version": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the name of the plugin.",
+							Description: "Version is the version identifier.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"config": {
+					"expirationDate": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Config is the configuration of the plugin.",
-							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+							Description: "ExpirationDate defines the time at which this version expires.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"disabled": {
+					"classification": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Disabled specifies whether this plugin should be disabled.",
-							Type:        []string{"boolean"},
+							Description: "Classification defines the state of a version (preview, supported, deprecated)\n\nPossible enum values:\n - `\"deprecated\"` indicates that a patch version should not be used anymore, should be updated to a new version and will eventually expire.\n - `\"preview\"` indicates that a version has recently been added and not promoted to \"Supported\" yet. ClassificationPreview versions will not be considered for automatic Kubernetes and Machine Image patch version updates.\n - `\"supported\"` indicates that a patch version is the recommended version for a shoot. Only one \"supported\" version is allowed per minor version. Supported versions are eligible for the automated Kubernetes and Machine image patch version update for shoot clusters in Gardener.",
+							Type:        []string{"string"},
 							Format:      "",
+							Enum:        []interface{}{"deprecated", "preview", "supported"},
 						},
 					},
-					"kubeconfigSecretName": {
+					"chart": {
 						SchemaProps: spec.SchemaProps{
-							Description: "KubeconfigSecretName specifies the name of a secret containing the kubeconfig for this admission plugin.",
-							Type:        []string{"string"},
+							Description: "Chart is the reference to the Helm chart of the addon in an OCI repository.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.OCIRepository"),
+						},
+					},
+					"valuesSchema": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ValuesSchema is an OpenAPI v3 schema which the values provided for the addon in the Shoot specification are validated against. If not set, no values are allowed for this version.",
+							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+						},
+					},
+				},
+				Required: []string{"version", "chart"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.OCIRepository", "k8s.io/apimachinery/pkg/apis/meta/v1.Time", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_Addons(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Addons is a collection of configuration for specific addons which are managed by the Gardener.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kubernetesDashboard": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KubernetesDashboard holds configuration settings for the kubernetes dashboard addon. This field is deprecated and will be removed in a future version, use managed addons instead.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.KubernetesDashboard"),
+						},
+					},
+					"nginxIngress": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NginxIngress holds configuration settings for the nginx-ingress addon. This field is deprecated and will be removed in a future version, use managed addons instead.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.NginxIngress"),
+						},
+					},
+					"managed": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "name",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Managed is the list of managed addons which are deployed into the shoot cluster. The addons must be declared in the catalog of the referenced CloudProfile.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ManagedAddon"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubernetesDashboard", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ManagedAddon", "github.com/gardener/gardener/pkg/apis/core/v1beta1.NginxIngress"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_AdmissionPlugin(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AdmissionPlugin contains information about a specific admission plugin and its corresponding configuration.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the plugin.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"config": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Config is the configuration of the plugin.",
+							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+						},
+					},
+					"disabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Disabled specifies whether this plugin should be disabled.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"kubeconfigSecretName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KubeconfigSecretName specifies the name of a secret containing the kubeconfig for this admission plugin.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"configMapName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConfigMapName specifies the name of a ConfigMap containing the configuration of the plugin in its `config.yaml` data key. The ConfigMap must be in the same namespace as the object referencing it. It is mutually exclusive with Config. Changes to the ConfigMap are applied during the next reconciliation.",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
@@ -1257,11 +1696,23 @@ func schema_pkg_apis_core_v1beta1_AuditConfig(ref common.ReferenceCallback) comm
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.AuditPolicy"),
 						},
 					},
+					"webhook": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Webhook contains settings for sending the audit events of the kube-apiserver to a webhook backend.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.AuditWebhook"),
+						},
+					},
+					"sink": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Sink contains settings for delivering the audit events of the kube-apiserver to a managed service of a provider (e.g., AWS CloudWatch or Google Cloud Logging). The delivery is implemented by an extension for the given type. This field is mutually exclusive with the `webhook` field.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.AuditSink"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.AuditPolicy"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.AuditPolicy", "github.com/gardener/gardener/pkg/apis/core/v1beta1.AuditSink", "github.com/gardener/gardener/pkg/apis/core/v1beta1.AuditWebhook"},
 	}
 }
 
@@ -1286,6 +1737,73 @@ func schema_pkg_apis_core_v1beta1_AuditPolicy(ref common.ReferenceCallback) comm
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_AuditSink(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AuditSink contains settings for delivering the audit events of the kube-apiserver to a managed service of a provider.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the type of the audit sink extension.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"providerConfig": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProviderConfig is the configuration passed to the audit sink extension.",
+							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+						},
+					},
+				},
+				Required: []string{"type"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/runtime.RawExtension"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_AuditWebhook(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AuditWebhook contains settings for an audit webhook backend of the kube-apiserver.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kubeconfigSecretName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KubeconfigSecretName specifies the name of a secret containing the kubeconfig for the audit webhook backend. The secret must be referenced in the shoot's `.spec.resources`.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"batchMaxSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BatchMaxSize is the maximum size of a batch of audit events sent to the webhook backend (flag `--audit-webhook-batch-max-size`).",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"batchMaxWait": {
+						SchemaProps: spec.SchemaProps{
+							Description: "BatchMaxWait is the amount of time to wait before sending a batch of audit events that has not reached the maximum size (flag `--audit-webhook-batch-max-wait`).",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+				Required: []string{"kubeconfigSecretName"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_AuthorizerKubeconfigReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1419,6 +1937,37 @@ func schema_pkg_apis_core_v1beta1_BackupBucket(ref common.ReferenceCallback) com
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_BackupBucketImmutability(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BackupBucketImmutability contains the immutability (object lock) configuration of a backup bucket.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"retentionMode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RetentionMode is the retention mode of the objects in the bucket. Possible values are `Governance` and `Compliance`.\n\nPossible enum values:\n - `\"Compliance\"` is a constant for the compliance retention mode. Protected objects cannot be deleted by anyone before their retention period has expired and the retention settings can only be tightened.\n - `\"Governance\"` is a constant for the governance retention mode. Protected objects can still be deleted and the retention settings can still be relaxed by principals with special permissions.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+							Enum:        []interface{}{"Compliance", "Governance"},
+						},
+					},
+					"retentionPeriod": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RetentionPeriod is the duration for which objects in the bucket are protected against deletion and overwrites after they have been written.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+				Required: []string{"retentionMode", "retentionPeriod"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_BackupBucketList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -1534,12 +2083,18 @@ func schema_pkg_apis_core_v1beta1_BackupBucketSpec(ref common.ReferenceCallback)
 							Format:      "",
 						},
 					},
+					"immutability": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Immutability contains the immutability (object lock) configuration of the bucket. If set, objects stored in the bucket are protected against deletion and overwrites for the configured retention period.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.BackupBucketImmutability"),
+						},
+					},
 				},
 				Required: []string{"provider", "secretRef"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.BackupBucketProvider", "k8s.io/api/core/v1.SecretReference", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.BackupBucketImmutability", "github.com/gardener/gardener/pkg/apis/core/v1beta1.BackupBucketProvider", "k8s.io/api/core/v1.SecretReference", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
 	}
 }
 
@@ -1854,10 +2409,11 @@ func schema_pkg_apis_core_v1beta1_CARotation(ref common.ReferenceCallback) commo
 				Properties: map[string]spec.Schema{
 					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Phase describes the phase of the certificate authority credential rotation.",
+							Description: "Phase describes the phase of the certificate authority credential rotation.\n\nPossible enum values:\n - `\"Completed\"` is a constant for the credentials rotation phase describing that the procedure was completed.\n - `\"Completing\"` is a constant for the credentials rotation phase describing that the procedure is being completed.\n - `\"Prepared\"` is a constant for the credentials rotation phase describing that the procedure was prepared.\n - `\"Preparing\"` is a constant for the credentials rotation phase describing that the procedure is being prepared.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
+							Enum:        []interface{}{"Completed", "Completing", "Prepared", "Preparing"},
 						},
 					},
 					"lastCompletionTime": {
@@ -1902,10 +2458,11 @@ func schema_pkg_apis_core_v1beta1_CRI(ref common.ReferenceCallback) common.OpenA
 				Properties: map[string]spec.Schema{
 					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The name of the CRI library. Supported values are `containerd`.",
+							Description: "The name of the CRI library. Supported values are `containerd`.\n\nPossible enum values:\n - `\"containerd\"` is a constant for ContainerD CRI name.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
+							Enum:        []interface{}{"containerd"},
 						},
 					},
 					"containerRuntimes": {
@@ -1966,11 +2523,18 @@ func schema_pkg_apis_core_v1beta1_CloudProfile(ref common.ReferenceCallback) com
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.CloudProfileSpec"),
 						},
 					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status contains the current status of the cloud profile.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.CloudProfileStatus"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.CloudProfileSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.CloudProfileSpec", "github.com/gardener/gardener/pkg/apis/core/v1beta1.CloudProfileStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
@@ -2182,51 +2746,142 @@ func schema_pkg_apis_core_v1beta1_CloudProfileSpec(ref common.ReferenceCallback)
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Bastion"),
 						},
 					},
+					"addons": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "name",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Addons is the catalog of managed addons which can be deployed into shoot clusters using this profile via `.spec.addons.managed` in the Shoot specification.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.AddonDefinition"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"kubernetes", "machineImages", "machineTypes", "regions", "type"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Bastion", "github.com/gardener/gardener/pkg/apis/core/v1beta1.KubernetesSettings", "github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineImage", "github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineType", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Region", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSelector", "github.com/gardener/gardener/pkg/apis/core/v1beta1.VolumeType", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.AddonDefinition", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Bastion", "github.com/gardener/gardener/pkg/apis/core/v1beta1.KubernetesSettings", "github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineImage", "github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineType", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Region", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSelector", "github.com/gardener/gardener/pkg/apis/core/v1beta1.VolumeType", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_ClusterAutoscaler(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_CloudProfileStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ClusterAutoscaler contains the configuration flags for the Kubernetes cluster autoscaler.",
+				Description: "CloudProfileStatus contains the status of the cloud profile.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"scaleDownDelayAfterAdd": {
+					"usage": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ScaleDownDelayAfterAdd defines how long after scale up that scale down evaluation resumes (default: 1 hour).",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
-						},
-					},
-					"scaleDownDelayAfterDelete": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ScaleDownDelayAfterDelete how long after node deletion that scale down evaluation resumes, defaults to scanInterval (default: 0 secs).",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+							Description: "Usage contains information about the Kubernetes and machine image versions which are used by Shoots referencing the CloudProfile, either directly or via a NamespacedCloudProfile. It is maintained by gardener-controller-manager.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.CloudProfileUsage"),
 						},
 					},
-					"scaleDownDelayAfterFailure": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.CloudProfileUsage"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_CloudProfileUsage(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CloudProfileUsage contains information about the versions of a CloudProfile which are in use.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kubernetesVersions": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ScaleDownDelayAfterFailure how long after scale down failure that scale down evaluation resumes (default: 3 mins).",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+							Description: "KubernetesVersions is the list of Kubernetes versions which are in use.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.VersionUsage"),
+									},
+								},
+							},
 						},
 					},
-					"scaleDownUnneededTime": {
+					"machineImageVersions": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ScaleDownUnneededTime defines how long a node should be unneeded before it is eligible for scale down (default: 30 mins).",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+							Description: "MachineImageVersions is the list of machine image versions which are in use.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineImageVersionUsage"),
+									},
+								},
+							},
 						},
 					},
-					"scaleDownUtilizationThreshold": {
+					"lastUpdateTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ScaleDownUtilizationThreshold defines the threshold in fraction (0.0 - 1.0) under which a node is being removed (default: 0.5).",
-							Type:        []string{"number"},
-							Format:      "double",
+							Description: "LastUpdateTime is the time when the usage was computed the last time.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineImageVersionUsage", "github.com/gardener/gardener/pkg/apis/core/v1beta1.VersionUsage", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_ClusterAutoscaler(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ClusterAutoscaler contains the configuration flags for the Kubernetes cluster autoscaler.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"scaleDownDelayAfterAdd": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ScaleDownDelayAfterAdd defines how long after scale up that scale down evaluation resumes (default: 1 hour).",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"scaleDownDelayAfterDelete": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ScaleDownDelayAfterDelete how long after node deletion that scale down evaluation resumes, defaults to scanInterval (default: 0 secs).",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"scaleDownDelayAfterFailure": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ScaleDownDelayAfterFailure how long after scale down failure that scale down evaluation resumes (default: 3 mins).",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"scaleDownUnneededTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ScaleDownUnneededTime defines how long a node should be unneeded before it is eligible for scale down (default: 30 mins).",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"scaleDownUtilizationThreshold": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ScaleDownUtilizationThreshold defines the threshold in fraction (0.0 - 1.0) under which a node is being removed (default: 0.5).",
+							Default:     0.5,
+							Type:        []string{"number"},
+							Format:      "double",
 						},
 					},
 					"scanInterval": {
@@ -2238,6 +2893,7 @@ func schema_pkg_apis_core_v1beta1_ClusterAutoscaler(ref common.ReferenceCallback
 					"expander": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Expander defines the algorithm to use during scale up (default: least-waste). See: https://github.com/gardener/autoscaler/blob/machine-controller-manager-provider/cluster-autoscaler/FAQ.md#what-are-expanders.",
+							Default:     "least-waste",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -2251,6 +2907,7 @@ func schema_pkg_apis_core_v1beta1_ClusterAutoscaler(ref common.ReferenceCallback
 					"maxGracefulTerminationSeconds": {
 						SchemaProps: spec.SchemaProps{
 							Description: "MaxGracefulTerminationSeconds is the number of seconds CA waits for pod termination when trying to scale down a node (default: 600).",
+							Default:     600,
 							Type:        []string{"integer"},
 							Format:      "int32",
 						},
@@ -2279,6 +2936,7 @@ func schema_pkg_apis_core_v1beta1_ClusterAutoscaler(ref common.ReferenceCallback
 					"maxEmptyBulkDelete": {
 						SchemaProps: spec.SchemaProps{
 							Description: "MaxEmptyBulkDelete specifies the maximum number of empty nodes that can be deleted at the same time (default: 10).",
+							Default:     10,
 							Type:        []string{"integer"},
 							Format:      "int32",
 						},
@@ -2286,6 +2944,7 @@ func schema_pkg_apis_core_v1beta1_ClusterAutoscaler(ref common.ReferenceCallback
 					"ignoreDaemonsetsUtilization": {
 						SchemaProps: spec.SchemaProps{
 							Description: "IgnoreDaemonsetsUtilization allows CA to ignore DaemonSet pods when calculating resource utilization for scaling down (default: false).",
+							Default:     false,
 							Type:        []string{"boolean"},
 							Format:      "",
 						},
@@ -2293,6 +2952,7 @@ func schema_pkg_apis_core_v1beta1_ClusterAutoscaler(ref common.ReferenceCallback
 					"verbosity": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Verbosity allows CA to modify its log level (default: 2).",
+							Default:     2,
 							Type:        []string{"integer"},
 							Format:      "int32",
 						},
@@ -2500,11 +3160,37 @@ func schema_pkg_apis_core_v1beta1_ControlPlane(ref common.ReferenceCallback) com
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.HighAvailability"),
 						},
 					},
+					"egress": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Egress holds the configuration settings for the egress traffic of the control plane components of a shoot.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ControlPlaneEgress"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.HighAvailability"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.ControlPlaneEgress", "github.com/gardener/gardener/pkg/apis/core/v1beta1.HighAvailability"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_ControlPlaneEgress(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ControlPlaneEgress holds the configuration settings for the egress traffic of the control plane components of a shoot.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"dedicated": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Dedicated specifies whether the egress traffic of the control plane components running in the seed (e.g., calls from the kube-apiserver to webhooks in the shoot or calls to the cloud provider API) shall leave the seed via a source IP dedicated to this shoot. This allows allow-listing a stable per-cluster source IP. The dedicated egress must be provided by the extension controller responsible for the `ControlPlane` resource.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -2840,9 +3526,10 @@ func schema_pkg_apis_core_v1beta1_ControllerRegistrationDeployment(ref common.Re
 				Properties: map[string]spec.Schema{
 					"policy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Policy controls how the controller is deployed. It defaults to 'OnDemand'.",
+							Description: "Policy controls how the controller is deployed. It defaults to 'OnDemand'.\n\nPossible enum values:\n - `\"Always\"` specifies that the controller shall be deployed always, independent of whether another resource requires it or the respective seed has shoots.\n - `\"AlwaysExceptNoShoots\"` specifies that the controller shall be deployed always, independent of whether another resource requires it, but only when the respective seed has at least one shoot.\n - `\"OnDemand\"` specifies that the controller shall be only deployed if required by another resource. If nothing requires it then the controller shall not be deployed.",
 							Type:        []string{"string"},
 							Format:      "",
+							Enum:        []interface{}{"Always", "AlwaysExceptNoShoots", "OnDemand"},
 						},
 					},
 					"seedSelector": {
@@ -3033,23 +3720,26 @@ func schema_pkg_apis_core_v1beta1_ControllerResourceLifecycle(ref common.Referen
 				Properties: map[string]spec.Schema{
 					"reconcile": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Reconcile defines the strategy during reconciliation.",
+							Description: "Reconcile defines the strategy during reconciliation.\n\nPossible enum values:\n - `\"AfterKubeAPIServer\"` specifies that a resource should be handled after the kube-apiserver.\n - `\"AfterWorker\"` specifies that a resource should be handled after workers. This is only available during reconcile.\n - `\"BeforeKubeAPIServer\"` specifies that a resource should be handled before the kube-apiserver.",
 							Type:        []string{"string"},
 							Format:      "",
+							Enum:        []interface{}{"AfterKubeAPIServer", "AfterWorker", "BeforeKubeAPIServer"},
 						},
 					},
 					"delete": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Delete defines the strategy during deletion.",
+							Description: "Delete defines the strategy during deletion.\n\nPossible enum values:\n - `\"AfterKubeAPIServer\"` specifies that a resource should be handled after the kube-apiserver.\n - `\"AfterWorker\"` specifies that a resource should be handled after workers. This is only available during reconcile.\n - `\"BeforeKubeAPIServer\"` specifies that a resource should be handled before the kube-apiserver.",
 							Type:        []string{"string"},
 							Format:      "",
+							Enum:        []interface{}{"AfterKubeAPIServer", "AfterWorker", "BeforeKubeAPIServer"},
 						},
 					},
 					"migrate": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Migrate defines the strategy during migration.",
+							Description: "Migrate defines the strategy during migration.\n\nPossible enum values:\n - `\"AfterKubeAPIServer\"` specifies that a resource should be handled after the kube-apiserver.\n - `\"AfterWorker\"` specifies that a resource should be handled after workers. This is only available during reconcile.\n - `\"BeforeKubeAPIServer\"` specifies that a resource should be handled before the kube-apiserver.",
 							Type:        []string{"string"},
 							Format:      "",
+							Enum:        []interface{}{"AfterKubeAPIServer", "AfterWorker", "BeforeKubeAPIServer"},
 						},
 					},
 				},
@@ -3077,11 +3767,17 @@ func schema_pkg_apis_core_v1beta1_CoreDNS(ref common.ReferenceCallback) common.O
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.CoreDNSRewriting"),
 						},
 					},
+					"customPlugins": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CustomPlugins contains the settings of the optional plugins in the plugin chain of the Core DNS components running in the data plane of the Shoot cluster.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.CoreDNSCustomPlugins"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.CoreDNSAutoscaling", "github.com/gardener/gardener/pkg/apis/core/v1beta1.CoreDNSRewriting"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.CoreDNSAutoscaling", "github.com/gardener/gardener/pkg/apis/core/v1beta1.CoreDNSCustomPlugins", "github.com/gardener/gardener/pkg/apis/core/v1beta1.CoreDNSRewriting"},
 	}
 }
 
@@ -3094,10 +3790,11 @@ func schema_pkg_apis_core_v1beta1_CoreDNSAutoscaling(ref common.ReferenceCallbac
 				Properties: map[string]spec.Schema{
 					"mode": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The mode of the autoscaling to be used for the Core DNS components running in the data plane of the Shoot cluster. Supported values are `horizontal` and `cluster-proportional`.",
+							Description: "The mode of the autoscaling to be used for the Core DNS components running in the data plane of the Shoot cluster. Supported values are `horizontal` and `cluster-proportional`.\n\nPossible enum values:\n - `\"cluster-proportional\"` is a constant for cluster-proportional Core DNS autoscaling mode.\n - `\"horizontal\"` is a constant for horizontal Core DNS autoscaling mode.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
+							Enum:        []interface{}{"cluster-proportional", "horizontal"},
 						},
 					},
 				},
@@ -3107,6 +3804,105 @@ func schema_pkg_apis_core_v1beta1_CoreDNSAutoscaling(ref common.ReferenceCallbac
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_CoreDNSCustomPlugins(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CoreDNSCustomPlugins contains the settings of the optional plugins in the plugin chain of the Core DNS components.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"log": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Log indicates whether all DNS queries are logged. By default, only failed queries are logged.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"dns64": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DNS64 contains the settings of the dns64 plugin which synthesizes AAAA records from A records. If unset, the plugin is disabled.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.CoreDNSDNS64"),
+						},
+					},
+					"forwardZones": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ForwardZones is a list of zones whose DNS queries are forwarded to dedicated upstream name servers instead of the name servers of the nodes.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.CoreDNSForwardZone"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.CoreDNSDNS64", "github.com/gardener/gardener/pkg/apis/core/v1beta1.CoreDNSForwardZone"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_CoreDNSDNS64(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CoreDNSDNS64 contains the settings of the dns64 plugin.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"prefix": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Prefix is the IPv6 prefix used to synthesize AAAA records. Its length must be one of 32, 40, 48, 56, 64 or 96. Defaults to the well-known prefix `64:ff9b::/96` if unset.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_CoreDNSForwardZone(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CoreDNSForwardZone contains the settings for forwarding the DNS queries of a zone.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"zone": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Zone is the domain whose DNS queries are forwarded, e.g. `corp.example.com`.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"upstreams": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Upstreams is the list of name servers the DNS queries are forwarded to. Each entry is an IP address with an optional port, e.g. `10.0.0.10` or `10.0.0.10:5353`. At most 15 upstreams are supported.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"zone", "upstreams"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_CoreDNSRewriting(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3268,6 +4064,49 @@ func schema_pkg_apis_core_v1beta1_DNSProvider(ref common.ReferenceCallback) comm
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_DataResidency(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DataResidency contains constraints for the placement of a shoot cluster wrt data residency.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"jurisdictions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Jurisdictions is the list of legal jurisdictions which are allowed for the regions of the shoot and its seed.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"geographies": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Geographies is the list of geographical areas which are allowed for the regions of the shoot and its seed.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_DataVolume(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3305,6 +4144,20 @@ func schema_pkg_apis_core_v1beta1_DataVolume(ref common.ReferenceCallback) commo
 							Format:      "",
 						},
 					},
+					"iops": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IOPS is the number of provisioned I/O operations per second of the volume.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"throughput": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Throughput is the provisioned throughput of the volume in MiB/s.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
 				},
 				Required: []string{"name", "size"},
 			},
@@ -3334,6 +4187,59 @@ func schema_pkg_apis_core_v1beta1_DeploymentRef(ref common.ReferenceCallback) co
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_DeprecatedField(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DeprecatedField contains information about a deprecated API field.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion is the API version of the resource containing the field.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is the kind of the resource containing the field.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path is the path of the field in the resource, e.g. spec.kubernetes.kubeAPIServer.oidcConfig.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Description explains the deprecation and names the replacement of the field, if any.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"forbiddenFromKubernetesVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ForbiddenFromKubernetesVersion is the Kubernetes version starting from which the field is forbidden. It is only set if the field is forbidden depending on the Kubernetes version of the resource.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"apiVersion", "kind", "path", "description"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_DualApprovalForDeletion(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3372,32 +4278,82 @@ func schema_pkg_apis_core_v1beta1_DualApprovalForDeletion(ref common.ReferenceCa
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_ETCDEncryptionKeyRotation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_ETCD(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ETCDEncryptionKeyRotation contains information about the ETCD encryption key credential rotation.",
+				Description: "ETCD contains settings for the etcd of the shoot cluster.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"phase": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Phase describes the phase of the ETCD encryption key credential rotation.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"lastCompletionTime": {
+					"backup": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LastCompletionTime is the most recent time when the ETCD encryption key credential rotation was successfully completed.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "Backup contains settings for the backup of the etcd of the shoot cluster.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ETCDBackup"),
 						},
 					},
-					"lastInitiationTime": {
-						SchemaProps: spec.SchemaProps{
-							Description: "LastInitiationTime is the most recent time when the ETCD encryption key credential rotation was initiated.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
-						},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.ETCDBackup"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_ETCDBackup(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ETCDBackup contains settings for the backup of the etcd of the shoot cluster. The settings are only considered if the seed hosting the control plane of the shoot has a backup configured. They must be within the bounds defined in the `.spec.backup.etcd` field of the seed.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"fullSnapshotSchedule": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FullSnapshotSchedule is the cron schedule (in standard cron format) which defines when full snapshots of the etcd are taken. If not specified, a daily schedule within the maintenance time window of the shoot is used.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"deltaSnapshotRetentionPeriod": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeltaSnapshotRetentionPeriod is the duration for which delta snapshots are retained, excluding the latest snapshot set. If not specified, the default configured for the gardenlet is used.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_ETCDEncryptionKeyRotation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ETCDEncryptionKeyRotation contains information about the ETCD encryption key credential rotation.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase describes the phase of the ETCD encryption key credential rotation.\n\nPossible enum values:\n - `\"Completed\"` is a constant for the credentials rotation phase describing that the procedure was completed.\n - `\"Completing\"` is a constant for the credentials rotation phase describing that the procedure is being completed.\n - `\"Prepared\"` is a constant for the credentials rotation phase describing that the procedure was prepared.\n - `\"Preparing\"` is a constant for the credentials rotation phase describing that the procedure is being prepared.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+							Enum:        []interface{}{"Completed", "Completing", "Prepared", "Preparing"},
+						},
+					},
+					"lastCompletionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastCompletionTime is the most recent time when the ETCD encryption key credential rotation was successfully completed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastInitiationTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastInitiationTime is the most recent time when the ETCD encryption key credential rotation was initiated.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
 					},
 					"lastInitiationFinishedTime": {
 						SchemaProps: spec.SchemaProps{
@@ -3449,6 +4405,118 @@ func schema_pkg_apis_core_v1beta1_EncryptionConfig(ref common.ReferenceCallback)
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_ErrorTriage(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ErrorTriage contains the classification of an error into an actionable category.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"category": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Category is the category the error was classified into.\n\nPossible enum values:\n - `\"GardenerBugSuspect\"` indicates that the error could not be attributed to the user or the infrastructure provider, hence it might be caused by a bug in Gardener or one of its extensions.\n - `\"ProviderQuota\"` indicates that the error was caused by exhausted quotas or depleted resources at the infrastructure provider.\n - `\"Transient\"` indicates that the error is likely transient and will be resolved by a retry.\n - `\"UserConfiguration\"` indicates that the error was caused by the configuration provided by the user, e.g., invalid infrastructure credentials or a problematic webhook.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+							Enum:        []interface{}{"GardenerBugSuspect", "ProviderQuota", "Transient", "UserConfiguration"},
+						},
+					},
+					"confidence": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Confidence is the confidence of the classification.\n\nPossible enum values:\n - `\"High\"` indicates that the classification is based on well-defined error codes.\n - `\"Low\"` indicates that the error could not be classified based on codes or known patterns.\n - `\"Medium\"` indicates that the classification is based on well-known patterns in the error description.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+							Enum:        []interface{}{"High", "Low", "Medium"},
+						},
+					},
+					"suggestions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Suggestions are hints on how the error can be resolved.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"category", "confidence"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_EtcdSnapshot(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "EtcdSnapshot contains information about an on-demand full snapshot of the main etcd.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase is the phase of the snapshot.\n\nPossible enum values:\n - `\"Failed\"` indicates that the requested snapshot could not be taken.\n - `\"Pending\"` indicates that a snapshot was requested but not yet taken.\n - `\"Succeeded\"` indicates that the requested snapshot was taken successfully.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+							Enum:        []interface{}{"Failed", "Pending", "Succeeded"},
+						},
+					},
+					"lastInitiationTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastInitiationTime is the most recent time when a snapshot was requested.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"lastCompletionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastCompletionTime is the most recent time when a requested snapshot was taken successfully.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the snapshot.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"location": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Location is the location of the snapshot in the backup bucket (<bucket>/<path>).",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastRevision": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastRevision is the last etcd revision contained in the snapshot.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message contains details in case the snapshot could not be taken.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"phase"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_ExpirableVersion(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3472,9 +4540,10 @@ func schema_pkg_apis_core_v1beta1_ExpirableVersion(ref common.ReferenceCallback)
 					},
 					"classification": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Classification defines the state of a version (preview, supported, deprecated)",
+							Description: "Classification defines the state of a version (preview, supported, deprecated)\n\nPossible enum values:\n - `\"deprecated\"` indicates that a patch version should not be used anymore, should be updated to a new version and will eventually expire.\n - `\"preview\"` indicates that a version has recently been added and not promoted to \"Supported\" yet. ClassificationPreview versions will not be considered for automatic Kubernetes and Machine Image patch version updates.\n - `\"supported\"` indicates that a patch version is the recommended version for a shoot. Only one \"supported\" version is allowed per minor version. Supported versions are eligible for the automated Kubernetes and Machine image patch version update for shoot clusters in Gardener.",
 							Type:        []string{"string"},
 							Format:      "",
+							Enum:        []interface{}{"deprecated", "preview", "supported"},
 						},
 					},
 				},
@@ -3528,12 +4597,51 @@ func schema_pkg_apis_core_v1beta1_ExposureClass(ref common.ReferenceCallback) co
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ExposureClassScheduling"),
 						},
 					},
+					"loadBalancerService": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LoadBalancerService contains optional configuration for the load balancer service of the istio ingress gateway of the handler which exposes the kube-apiservers of shoots using this ExposureClass.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ExposureClassLoadBalancerService"),
+						},
+					},
+					"ingressGateway": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IngressGateway contains optional sizing configuration for the istio ingress gateway of the handler which exposes the kube-apiservers of shoots using this ExposureClass.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ExposureClassIngressGateway"),
+						},
+					},
 				},
 				Required: []string{"handler"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.ExposureClassScheduling", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.ExposureClassIngressGateway", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ExposureClassLoadBalancerService", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ExposureClassScheduling", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_ExposureClassIngressGateway(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ExposureClassIngressGateway contains sizing configuration for an istio ingress gateway.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"minReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MinReplicas is the minimum number of replicas of the istio ingress gateway.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxReplicas is the maximum number of replicas of the istio ingress gateway.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -3588,6 +4696,50 @@ func schema_pkg_apis_core_v1beta1_ExposureClassList(ref common.ReferenceCallback
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_ExposureClassLoadBalancerService(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ExposureClassLoadBalancerService contains configuration for the load balancer service of an istio ingress gateway.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"annotations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Annotations is a key value map to annotate the load balancer service. They take precedence over the annotations configured for the handler in the gardenlet configuration and for the load balancer services of the Seed.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"sourceRanges": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SourceRanges is a list of CIDRs which are allowed to access the load balancer. If not specified, access is not restricted.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_ExposureClassScheduling(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -3733,10 +4885,11 @@ func schema_pkg_apis_core_v1beta1_FailureTolerance(ref common.ReferenceCallback)
 				Properties: map[string]spec.Schema{
 					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type specifies the type of failure that the highly available resource can tolerate",
+							Description: "Type specifies the type of failure that the highly available resource can tolerate\n\nPossible enum values:\n - `\"node\"` specifies that a highly available resource can tolerate the failure of one or more nodes within a single-zone setup and still be available.\n - `\"zone\"` specifies that a highly available resource can tolerate the failure of one or more zones within a multi-zone setup and still be available.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
+							Enum:        []interface{}{"node", "zone"},
 						},
 					},
 				},
@@ -3746,73 +4899,249 @@ func schema_pkg_apis_core_v1beta1_FailureTolerance(ref common.ReferenceCallback)
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_Gardener(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_FeatureGate(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Gardener holds the information about the Gardener version that operated a resource.",
+				Description: "FeatureGate contains information about a feature gate.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"id": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ID is the container id of the Gardener which last acted on a resource.",
+							Description: "Name is the name of the feature gate.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"name": {
+					"default": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the hostname (pod name) of the Gardener which last acted on a resource.",
-							Default:     "",
-							Type:        []string{"string"},
+							Description: "Default is the default value of the feature gate.",
+							Default:     false,
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"version": {
+					"preRelease": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Version is the version of the Gardener which last acted on a resource.",
+							Description: "PreRelease is the maturity level of the feature gate, e.g. ALPHA, BETA or GA.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"lockToDefault": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LockToDefault indicates that the feature gate cannot be set to a value other than its default.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"enabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Enabled indicates whether the feature gate is enabled in gardener-apiserver. It is only set for feature gates which are relevant for gardener-apiserver, other components might be configured differently.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
-				Required: []string{"id", "name", "version"},
+				Required: []string{"name", "default", "preRelease"},
 			},
 		},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_GardenerResourceData(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_FeatureMatrix(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "GardenerResourceData holds the data which is used to generate resources, deployed in the Shoot's control plane.",
+				Description: "FeatureMatrix lists the feature gates and the deprecated API fields of the Gardener version serving it. It is computed by gardener-apiserver on request and cannot be created or modified.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name of the object required to generate resources",
-							Default:     "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"type": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type of the object",
-							Default:     "",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"data": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Data contains the payload required to generate resources",
-							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
-						},
-					},
+							Description: "Standard object metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"gardenerVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GardenerVersion is the version of the gardener-apiserver serving the FeatureMatrix.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"featureGates": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FeatureGates is the list of all feature gates of the Gardener version.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.FeatureGate"),
+									},
+								},
+							},
+						},
+					},
+					"deprecatedFields": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeprecatedFields is the list of deprecated API fields of the Gardener version.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.DeprecatedField"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"gardenerVersion"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.DeprecatedField", "github.com/gardener/gardener/pkg/apis/core/v1beta1.FeatureGate", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_FeatureMatrixList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "FeatureMatrixList is a collection of FeatureMatrices.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard list object metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Items is the list of FeatureMatrices.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.FeatureMatrix"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.FeatureMatrix", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_Gardener(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Gardener holds the information about the Gardener version that operated a resource.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ID is the container id of the Gardener which last acted on a resource.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the hostname (pod name) of the Gardener which last acted on a resource.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Version is the version of the Gardener which last acted on a resource.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"id", "name", "version"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_GardenerResourceData(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GardenerResourceData holds the data which is used to generate resources, deployed in the Shoot's control plane.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the object required to generate resources",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type of the object",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"data": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Data contains the payload required to generate resources",
+							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+						},
+					},
 					"labels": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Labels are labels of the object",
@@ -3936,6 +5265,21 @@ func schema_pkg_apis_core_v1beta1_HibernationSchedule(ref common.ReferenceCallba
 							Format:      "",
 						},
 					},
+					"exceptions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Exceptions is a list of dates or cron specs at which the shoot must not be hibernated by this schedule, e.g., during planned load tests or release weekends. Dates must be specified in the RFC 5545 date format (YYYYMMDD). Hibernations whose time matches one of the cron specs are skipped as well. Both are evaluated in the location of the schedule. Waking up the shoot is never skipped.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
@@ -4020,7 +5364,7 @@ func schema_pkg_apis_core_v1beta1_Ingress(ref common.ReferenceCallback) common.O
 				Properties: map[string]spec.Schema{
 					"domain": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Domain specifies the IngressDomain of the cluster pointing to the ingress controller endpoint. It will be used to construct ingress URLs for system applications running in Shoot/Garden clusters. Once set this field is immutable.",
+							Description: "Domain specifies the IngressDomain of the cluster pointing to the ingress controller endpoint. It will be used to construct ingress URLs for system applications running in Shoot/Garden clusters. Changing this field triggers a migration of all shoots hosted on the seed, see `.status.ingressDomainMigration`.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
@@ -4033,6 +5377,21 @@ func schema_pkg_apis_core_v1beta1_Ingress(ref common.ReferenceCallback) common.O
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.IngressController"),
 						},
 					},
+					"kubeAPIServerPorts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KubeAPIServerPorts are additional ports of the Seed's istio ingress gateways on which the kube-apiservers of shoots can be exposed besides port 443 (see `.spec.kubernetes.kubeAPIServer.endpoint.port` of Shoots). The ports are forwarded to the same listener as port 443, i.e., all shoot kube-apiservers on this Seed are reachable via these ports.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: 0,
+										Type:    []string{"integer"},
+										Format:  "int32",
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"domain", "controller"},
 			},
@@ -4359,103 +5718,79 @@ func schema_pkg_apis_core_v1beta1_KubeAPIServerConfig(ref common.ReferenceCallba
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.StructuredAuthorization"),
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.APIServerLogging", "github.com/gardener/gardener/pkg/apis/core/v1beta1.APIServerRequests", "github.com/gardener/gardener/pkg/apis/core/v1beta1.AdmissionPlugin", "github.com/gardener/gardener/pkg/apis/core/v1beta1.AuditConfig", "github.com/gardener/gardener/pkg/apis/core/v1beta1.EncryptionConfig", "github.com/gardener/gardener/pkg/apis/core/v1beta1.OIDCConfig", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ServiceAccountConfig", "github.com/gardener/gardener/pkg/apis/core/v1beta1.StructuredAuthentication", "github.com/gardener/gardener/pkg/apis/core/v1beta1.StructuredAuthorization", "github.com/gardener/gardener/pkg/apis/core/v1beta1.WatchCacheSizes", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
-	}
-}
-
-func schema_pkg_apis_core_v1beta1_KubeControllerManagerConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "KubeControllerManagerConfig contains configuration settings for the kube-controller-manager.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"featureGates": {
+					"authorizedNetworks": {
 						SchemaProps: spec.SchemaProps{
-							Description: "FeatureGates contains information about enabled feature gates.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "AuthorizedNetworks is a list of CIDRs which are allowed to access the kube-apiserver via its external domain. Connections from other source IPs are rejected by the istio ingress gateway of the seed. The internal domain (used by the shoot's nodes and control plane components) is not restricted. If empty, the access is not restricted.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: false,
-										Type:    []string{"boolean"},
+										Default: "",
+										Type:    []string{"string"},
 										Format:  "",
 									},
 								},
 							},
 						},
 					},
-					"horizontalPodAutoscaler": {
-						SchemaProps: spec.SchemaProps{
-							Description: "HorizontalPodAutoscalerConfig contains horizontal pod autoscaler configuration settings for the kube-controller-manager.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.HorizontalPodAutoscalerConfig"),
-						},
-					},
-					"nodeCIDRMaskSize": {
+					"endpoint": {
 						SchemaProps: spec.SchemaProps{
-							Description: "NodeCIDRMaskSize defines the mask size for node cidr in cluster (default is 24). This field is immutable.",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "Endpoint contains settings for exposing the kube-apiserver under additional host names and on an additional port.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeAPIServerEndpoint"),
 						},
 					},
-					"podEvictionTimeout": {
+					"apiPriorityAndFairness": {
 						SchemaProps: spec.SchemaProps{
-							Description: "PodEvictionTimeout defines the grace period for deleting pods on failed nodes. Defaults to 2m.\n\nDeprecated: The corresponding kube-controller-manager flag `--pod-eviction-timeout` is deprecated in favor of the kube-apiserver flags `--default-not-ready-toleration-seconds` and `--default-unreachable-toleration-seconds`. The `--pod-eviction-timeout` flag does not have effect when the taint based eviction is enabled. The taint based eviction is beta (enabled by default) since Kubernetes 1.13 and GA since Kubernetes 1.18. Hence, instead of setting this field, set the `spec.kubernetes.kubeAPIServer.defaultNotReadyTolerationSeconds` and `spec.kubernetes.kubeAPIServer.defaultUnreachableTolerationSeconds`.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+							Description: "APIPriorityAndFairness contains settings for the API Priority and Fairness feature of the kube-apiserver.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.APIPriorityAndFairness"),
 						},
 					},
-					"nodeMonitorGracePeriod": {
+					"vpn": {
 						SchemaProps: spec.SchemaProps{
-							Description: "NodeMonitorGracePeriod defines the grace period before an unresponsive node is marked unhealthy.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+							Description: "VPN contains settings for the VPN tunnels between the kube-apiserver and the shoot cluster.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeAPIServerVPNConfig"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.HorizontalPodAutoscalerConfig", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.APIPriorityAndFairness", "github.com/gardener/gardener/pkg/apis/core/v1beta1.APIServerLogging", "github.com/gardener/gardener/pkg/apis/core/v1beta1.APIServerRequests", "github.com/gardener/gardener/pkg/apis/core/v1beta1.AdmissionPlugin", "github.com/gardener/gardener/pkg/apis/core/v1beta1.AuditConfig", "github.com/gardener/gardener/pkg/apis/core/v1beta1.EncryptionConfig", "github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeAPIServerEndpoint", "github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeAPIServerVPNConfig", "github.com/gardener/gardener/pkg/apis/core/v1beta1.OIDCConfig", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ServiceAccountConfig", "github.com/gardener/gardener/pkg/apis/core/v1beta1.StructuredAuthentication", "github.com/gardener/gardener/pkg/apis/core/v1beta1.StructuredAuthorization", "github.com/gardener/gardener/pkg/apis/core/v1beta1.WatchCacheSizes", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_KubeProxyConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_KubeAPIServerEndpoint(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "KubeProxyConfig contains configuration settings for the kube-proxy.",
+				Description: "KubeAPIServerEndpoint contains settings for exposing the kube-apiserver under additional host names and on an additional port of the seed's istio ingress gateway.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"featureGates": {
+					"additionalHostnames": {
 						SchemaProps: spec.SchemaProps{
-							Description: "FeatureGates contains information about enabled feature gates.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "AdditionalHostnames are additional host names under which the kube-apiserver is reachable via the seed's istio ingress gateway. They are added to the serving certificate of the kube-apiserver. The DNS records for these host names are not managed by Gardener and must point to the external domain of the kube-apiserver.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: false,
-										Type:    []string{"boolean"},
+										Default: "",
+										Type:    []string{"string"},
 										Format:  "",
 									},
 								},
 							},
 						},
 					},
-					"mode": {
+					"port": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Mode specifies which proxy mode to use. defaults to IPTables.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Port is the port on which the kube-apiserver is advertised via its external domain instead of 443. It must be one of the ports offered by the seed in `.spec.ingress.kubeAPIServerPorts`. Port 443 continues to be served.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"enabled": {
+					"managedCertificate": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Enabled indicates whether kube-proxy should be deployed or not. Depending on the networking extensions switching kube-proxy off might be rejected. Consulting the respective documentation of the used networking extension is recommended before using this field. defaults to true if not specified.",
+							Description: "ManagedCertificate specifies whether gardenlet issues and rotates a publicly trusted certificate for the additional host names using the ACME server configured in `.spec.settings.acme` of the seed. The certificate is served by the kube-apiserver for the additional host names instead of the certificate signed by the cluster CA. Defaults to false.",
 							Type:        []string{"boolean"},
 							Format:      "",
 						},
@@ -4466,25 +5801,209 @@ func schema_pkg_apis_core_v1beta1_KubeProxyConfig(ref common.ReferenceCallback)
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_KubeSchedulerConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_KubeAPIServerVPNConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "KubeSchedulerConfig contains configuration settings for the kube-scheduler.",
+				Description: "KubeAPIServerVPNConfig contains settings for the VPN tunnels between the kube-apiserver and the shoot cluster.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"featureGates": {
+					"highAvailability": {
 						SchemaProps: spec.SchemaProps{
-							Description: "FeatureGates contains information about enabled feature gates.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: false,
-										Type:    []string{"boolean"},
-										Format:  "",
-									},
+							Description: "HighAvailability enables the highly available VPN. In this mode, multiple vpn-seed-server replicas and vpn-shoot clients establish multiple tunnels, and the kube-apiserver selects a working tunnel for each request path. Defaults to true if the control plane is configured to be highly available.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"seedServers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SeedServers is the number of vpn-seed-server replicas used for the highly available VPN. Allowed values are between 2 and 4. Defaults to 2.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"shootClients": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ShootClients is the number of vpn-shoot clients used for the highly available VPN. Allowed values are between 2 and 4. Defaults to 2.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_KubeControllerManagerConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "KubeControllerManagerConfig contains configuration settings for the kube-controller-manager.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"featureGates": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FeatureGates contains information about enabled feature gates.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: false,
+										Type:    []string{"boolean"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"horizontalPodAutoscaler": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HorizontalPodAutoscalerConfig contains horizontal pod autoscaler configuration settings for the kube-controller-manager.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.HorizontalPodAutoscalerConfig"),
+						},
+					},
+					"nodeCIDRMaskSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeCIDRMaskSize defines the mask size for node cidr in cluster (default is 24). This field is immutable.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"podEvictionTimeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PodEvictionTimeout defines the grace period for deleting pods on failed nodes. Defaults to 2m.\n\nDeprecated: The corresponding kube-controller-manager flag `--pod-eviction-timeout` is deprecated in favor of the kube-apiserver flags `--default-not-ready-toleration-seconds` and `--default-unreachable-toleration-seconds`. The `--pod-eviction-timeout` flag does not have effect when the taint based eviction is enabled. The taint based eviction is beta (enabled by default) since Kubernetes 1.13 and GA since Kubernetes 1.18. Hence, instead of setting this field, set the `spec.kubernetes.kubeAPIServer.defaultNotReadyTolerationSeconds` and `spec.kubernetes.kubeAPIServer.defaultUnreachableTolerationSeconds`.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"nodeMonitorGracePeriod": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeMonitorGracePeriod defines the grace period before an unresponsive node is marked unhealthy.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"controllers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Controllers contains the settings of individual controllers of the kube-controller-manager, e.g., to disable them or to configure the number of objects they sync concurrently.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeControllerManagerController"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.HorizontalPodAutoscalerConfig", "github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeControllerManagerController", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_KubeControllerManagerController(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "KubeControllerManagerController contains the settings of an individual controller of the kube-controller-manager.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the controller, e.g., `ttl-after-finished`.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"enabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Enabled indicates whether the controller is enabled. If unset, the controller keeps its default state. Controllers which are required by Gardener cannot be disabled.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"concurrentSyncs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConcurrentSyncs is the number of objects the controller is allowed to sync concurrently. Only supported for controllers offering a corresponding `--concurrent-*-syncs` flag.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_KubeProxyConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "KubeProxyConfig contains configuration settings for the kube-proxy.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"featureGates": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FeatureGates contains information about enabled feature gates.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: false,
+										Type:    []string{"boolean"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"mode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Mode specifies which proxy mode to use. defaults to IPTables.\n\nPossible enum values:\n - `\"IPTables\"` uses iptables as proxy implementation.\n - `\"IPVS\"` uses ipvs as proxy implementation.",
+							Type:        []string{"string"},
+							Format:      "",
+							Enum:        []interface{}{"IPTables", "IPVS"},
+						},
+					},
+					"enabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Enabled indicates whether kube-proxy should be deployed or not. Depending on the networking extensions switching kube-proxy off might be rejected. Consulting the respective documentation of the used networking extension is recommended before using this field. defaults to true if not specified.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_KubeSchedulerConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "KubeSchedulerConfig contains configuration settings for the kube-scheduler.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"featureGates": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FeatureGates contains information about enabled feature gates.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: false,
+										Type:    []string{"boolean"},
+										Format:  "",
+									},
 								},
 							},
 						},
@@ -4498,9 +6017,10 @@ func schema_pkg_apis_core_v1beta1_KubeSchedulerConfig(ref common.ReferenceCallba
 					},
 					"profile": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Profile configures the scheduling profile for the cluster. If not specified, the used profile is \"balanced\" (provides the default kube-scheduler behavior).",
+							Description: "Profile configures the scheduling profile for the cluster. If not specified, the used profile is \"balanced\" (provides the default kube-scheduler behavior).\n\nPossible enum values:\n - `\"balanced\"` is a scheduling profile that attempts to spread Pods evenly across Nodes to obtain a more balanced resource usage. This profile provides the default kube-scheduler behavior.\n - `\"bin-packing\"` is a scheduling profile that scores Nodes based on the allocation of resources. It prioritizes Nodes with most allocated resources. This leads the Node count in the cluster to be minimized and the Node resource utilization to be increased.",
 							Type:        []string{"string"},
 							Format:      "",
+							Enum:        []interface{}{"balanced", "bin-packing"},
 						},
 					},
 				},
@@ -4690,11 +6210,33 @@ func schema_pkg_apis_core_v1beta1_KubeletConfig(ref common.ReferenceCallback) co
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.MemorySwapConfiguration"),
 						},
 					},
+					"memoryManagerPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MemoryManagerPolicy is the name of the policy to use by the memory manager. May be one of {\"None\", \"Static\"}. The \"Static\" policy requires reservedMemory to be configured. Default: None\n\nPossible enum values:\n - `\"None\"` is a constant for the kubelet's memory manager policy not affecting the memory allocation.\n - `\"Static\"` is a constant for the kubelet's memory manager policy guaranteeing memory (and hugepages) allocation from a minimal set of NUMA nodes for pods in the Guaranteed QoS class.",
+							Type:        []string{"string"},
+							Format:      "",
+							Enum:        []interface{}{"None", "Static"},
+						},
+					},
+					"reservedMemory": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReservedMemory specifies the memory reservations for NUMA nodes used by the \"Static\" memory manager policy. The sum of all reservations for a resource must equal the memory reserved via kubeReserved plus the hard eviction threshold (memory.available), otherwise the kubelet refuses to start.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeletMemoryReservation"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeletConfigEviction", "github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeletConfigEvictionMinimumReclaim", "github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeletConfigEvictionSoftGracePeriod", "github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeletConfigReserved", "github.com/gardener/gardener/pkg/apis/core/v1beta1.MemorySwapConfiguration", "k8s.io/apimachinery/pkg/api/resource.Quantity", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeletConfigEviction", "github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeletConfigEvictionMinimumReclaim", "github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeletConfigEvictionSoftGracePeriod", "github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeletConfigReserved", "github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeletMemoryReservation", "github.com/gardener/gardener/pkg/apis/core/v1beta1.MemorySwapConfiguration", "k8s.io/apimachinery/pkg/api/resource.Quantity", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
@@ -4875,6 +6417,44 @@ func schema_pkg_apis_core_v1beta1_KubeletConfigReserved(ref common.ReferenceCall
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_KubeletMemoryReservation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "KubeletMemoryReservation specifies the memory reservation of different types for a NUMA node.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"numaNode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NumaNode is the index of the NUMA node.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"limits": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Limits are the reserved amounts per resource, e.g. memory or hugepages-1Gi.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"numaNode", "limits"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_Kubernetes(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -4938,11 +6518,17 @@ func schema_pkg_apis_core_v1beta1_Kubernetes(ref common.ReferenceCallback) commo
 							Format:      "",
 						},
 					},
+					"namespaceDefaults": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NamespaceDefaults contains LimitRanges and ResourceQuotas which are applied to the namespaces in the Shoot cluster.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.NamespaceDefaults"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.ClusterAutoscaler", "github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeAPIServerConfig", "github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeControllerManagerConfig", "github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeProxyConfig", "github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeSchedulerConfig", "github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeletConfig", "github.com/gardener/gardener/pkg/apis/core/v1beta1.VerticalPodAutoscaler"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.ClusterAutoscaler", "github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeAPIServerConfig", "github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeControllerManagerConfig", "github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeProxyConfig", "github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeSchedulerConfig", "github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeletConfig", "github.com/gardener/gardener/pkg/apis/core/v1beta1.NamespaceDefaults", "github.com/gardener/gardener/pkg/apis/core/v1beta1.VerticalPodAutoscaler"},
 	}
 }
 
@@ -5016,10 +6602,11 @@ func schema_pkg_apis_core_v1beta1_KubernetesSettings(ref common.ReferenceCallbac
 							Extensions: spec.Extensions{
 								"x-kubernetes-patch-merge-key": "version",
 								"x-kubernetes-patch-strategy":  "merge",
+								"x-kubernetes-validations":     []interface{}{map[string]interface{}{"message": "only one supported version is allowed per minor version", "rule": "self.filter(v, has(v.classification) && v.classification == 'supported' && v.version.split('.').size() >= 2).all(v, self.filter(w, has(w.classification) && w.classification == 'supported' && w.version.split('.').size() >= 2 && w.version.split('.')[0] == v.version.split('.')[0] && w.version.split('.')[1] == v.version.split('.')[1]).size() == 1)"}},
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "Versions is the list of allowed Kubernetes versions with optional expiration dates for Shoot clusters.",
+							Description: "Versions is the list of allowed Kubernetes versions with optional expiration dates for Shoot clusters. Only one version per minor version may be classified as supported.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -5031,11 +6618,17 @@ func schema_pkg_apis_core_v1beta1_KubernetesSettings(ref common.ReferenceCallbac
 							},
 						},
 					},
+					"maxExpirationExtension": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxExpirationExtension is the maximum duration by which NamespacedCloudProfiles may extend the expiration date of a Kubernetes version beyond the expiration date defined in the CloudProfile. If not set, the expiration dates may be extended without limit. It must not be set in NamespacedCloudProfiles.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.ExpirableVersion"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.ExpirableVersion", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
@@ -5082,12 +6675,18 @@ func schema_pkg_apis_core_v1beta1_LastError(ref common.ReferenceCallback) common
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
+					"triage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Triage contains the classification of the last error into an actionable category.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ErrorTriage"),
+						},
+					},
 				},
 				Required: []string{"description"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.ErrorTriage", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -5114,10 +6713,11 @@ func schema_pkg_apis_core_v1beta1_LastMaintenance(ref common.ReferenceCallback)
 					},
 					"state": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Status of the last maintenance operation, one of Processing, Succeeded, Error.",
+							Description: "Status of the last maintenance operation, one of Processing, Succeeded, Error.\n\nPossible enum values:\n - `\"Aborted\"` indicates that an operation has been aborted.\n - `\"Error\"` indicates that an operation is completed with errors and will be retried.\n - `\"Failed\"` indicates that an operation is completed with errors and won't be retried.\n - `\"Pending\"` indicates that an operation cannot be done now, but will be tried in future.\n - `\"Processing\"` indicates that an operation is ongoing.\n - `\"Succeeded\"` indicates that an operation has completed successfully.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
+							Enum:        []interface{}{"Aborted", "Error", "Failed", "Pending", "Processing", "Succeeded"},
 						},
 					},
 					"failureReason": {
@@ -5167,18 +6767,20 @@ func schema_pkg_apis_core_v1beta1_LastOperation(ref common.ReferenceCallback) co
 					},
 					"state": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Status of the last operation, one of Aborted, Processing, Succeeded, Error, Failed.",
+							Description: "Status of the last operation, one of Aborted, Processing, Succeeded, Error, Failed.\n\nPossible enum values:\n - `\"Aborted\"` indicates that an operation has been aborted.\n - `\"Error\"` indicates that an operation is completed with errors and will be retried.\n - `\"Failed\"` indicates that an operation is completed with errors and won't be retried.\n - `\"Pending\"` indicates that an operation cannot be done now, but will be tried in future.\n - `\"Processing\"` indicates that an operation is ongoing.\n - `\"Succeeded\"` indicates that an operation has completed successfully.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
+							Enum:        []interface{}{"Aborted", "Error", "Failed", "Pending", "Processing", "Succeeded"},
 						},
 					},
 					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type of the last operation, one of Create, Reconcile, Delete, Migrate, Restore.",
+							Description: "Type of the last operation, one of Create, Reconcile, Delete, Migrate, Restore.\n\nPossible enum values:\n - `\"Create\"` indicates a 'create' operation.\n - `\"Delete\"` indicates a 'delete' operation.\n - `\"Migrate\"` indicates a 'migrate' operation.\n - `\"Reconcile\"` indicates a 'reconcile' operation.\n - `\"Restore\"` indicates a 'restore' operation.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
+							Enum:        []interface{}{"Create", "Delete", "Migrate", "Reconcile", "Restore"},
 						},
 					},
 				},
@@ -5190,32 +6792,71 @@ func schema_pkg_apis_core_v1beta1_LastOperation(ref common.ReferenceCallback) co
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_LoadBalancerServicesProxyProtocol(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_LegacyComponentRemoval(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "LoadBalancerServicesProxyProtocol controls whether ProxyProtocol is (optionally) allowed for the load balancer services.",
+				Description: "LegacyComponentRemoval contains information about the removal of a deprecated component from the shoot cluster.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"allowed": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Allowed controls whether the ProxyProtocol is optionally allowed for the load balancer services. This should only be enabled if the load balancer services are already using ProxyProtocol or will be reconfigured to use it soon. Until the load balancers are configured with ProxyProtocol, enabling this setting may allow clients to spoof their source IP addresses. The option allows a migration from non-ProxyProtocol to ProxyProtocol without downtime (depending on the infrastructure). Defaults to false.",
-							Default:     false,
-							Type:        []string{"boolean"},
+							Description: "Name is the name of the legacy component.",
+							Default:     "",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-				},
-				Required: []string{"allowed"},
-			},
-		},
-	}
-}
-
-func schema_pkg_apis_core_v1beta1_Machine(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
+					"state": {
+						SchemaProps: spec.SchemaProps{
+							Description: "State is the state of the removal, one of Processing, Succeeded.\n\nPossible enum values:\n - `\"Aborted\"` indicates that an operation has been aborted.\n - `\"Error\"` indicates that an operation is completed with errors and will be retried.\n - `\"Failed\"` indicates that an operation is completed with errors and won't be retried.\n - `\"Pending\"` indicates that an operation cannot be done now, but will be tried in future.\n - `\"Processing\"` indicates that an operation is ongoing.\n - `\"Succeeded\"` indicates that an operation has completed successfully.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+							Enum:        []interface{}{"Aborted", "Error", "Failed", "Pending", "Processing", "Succeeded"},
+						},
+					},
+					"lastTransitionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastTransitionTime is the last time the state transitioned.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"name", "state", "lastTransitionTime"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_LoadBalancerServicesProxyProtocol(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "LoadBalancerServicesProxyProtocol controls whether ProxyProtocol is (optionally) allowed for the load balancer services.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"allowed": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Allowed controls whether the ProxyProtocol is optionally allowed for the load balancer services. This should only be enabled if the load balancer services are already using ProxyProtocol or will be reconfigured to use it soon. Until the load balancers are configured with ProxyProtocol, enabling this setting may allow clients to spoof their source IP addresses. The option allows a migration from non-ProxyProtocol to ProxyProtocol without downtime (depending on the infrastructure). Defaults to false.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"allowed"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_Machine(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
 				Description: "Machine contains information about the machine type and image.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
@@ -5236,6 +6877,15 @@ func schema_pkg_apis_core_v1beta1_Machine(ref common.ReferenceCallback) common.O
 					"architecture": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Architecture is CPU architecture of machines in this worker pool.",
+							Default:     "amd64",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"osFamily": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OSFamily is the operating system family of machines in this worker pool. Possible values are \"linux\" and \"windows\". Defaults to \"linux\". This field is immutable.",
+							Default:     "linux",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -5296,6 +6946,20 @@ func schema_pkg_apis_core_v1beta1_MachineControllerManagerSettings(ref common.Re
 							},
 						},
 					},
+					"machineBootstrapTimeout": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MachineBootstrapTimeout is the period after which a node which has not completed its bootstrap is considered stuck. A node has not completed its bootstrap as long as gardener-node-agent has not applied the operating system config or the node-critical components are not ready (default: 20m).",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"machineBootstrapFailurePolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MachineBootstrapFailurePolicy determines what happens with nodes which are stuck in their bootstrap. Possible values are `Ignore` and `Replace`. With `Replace`, the machines of such nodes are deleted so that they get replaced by new machines (default: Ignore).\n\nPossible enum values:\n - `\"Ignore\"` indicates that nodes stuck in their bootstrap are only reported.\n - `\"Replace\"` indicates that the machines of nodes stuck in their bootstrap are replaced.",
+							Type:        []string{"string"},
+							Format:      "",
+							Enum:        []interface{}{"Ignore", "Replace"},
+						},
+					},
 				},
 			},
 		},
@@ -5324,10 +6988,11 @@ func schema_pkg_apis_core_v1beta1_MachineImage(ref common.ReferenceCallback) com
 							Extensions: spec.Extensions{
 								"x-kubernetes-patch-merge-key": "version",
 								"x-kubernetes-patch-strategy":  "merge",
+								"x-kubernetes-validations":     []interface{}{map[string]interface{}{"message": "only one supported version is allowed per minor version", "rule": "self.filter(v, has(v.classification) && v.classification == 'supported' && v.version.split('.').size() >= 2).all(v, self.filter(w, has(w.classification) && w.classification == 'supported' && w.version.split('.').size() >= 2 && w.version.split('.')[0] == v.version.split('.')[0] && w.version.split('.')[1] == v.version.split('.')[1]).size() == 1)"}},
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "Versions contains versions, expiration dates and container runtimes of the machine image",
+							Description: "Versions contains versions, expiration dates and container runtimes of the machine image. Only one version per minor version may be classified as supported.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -5341,9 +7006,10 @@ func schema_pkg_apis_core_v1beta1_MachineImage(ref common.ReferenceCallback) com
 					},
 					"updateStrategy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "UpdateStrategy is the update strategy to use for the machine image. Possible values are:\n - patch: update to the latest patch version of the current minor version.\n - minor: update to the latest minor and patch version.\n - major: always update to the overall latest version (default).",
+							Description: "UpdateStrategy is the update strategy to use for the machine image. Possible values are:\n - patch: update to the latest patch version of the current minor version.\n - minor: update to the latest minor and patch version.\n - major: always update to the overall latest version (default).\n\nPossible enum values:\n - `\"major\"` indicates that auto-updates are performed always to the overall latest version.\n - `\"minor\"` indicates that auto-updates are performed to the latest patch and minor version of the current major version. When using an expired version during the maintenance window, force updates to the latest minor and patch of the next (not necessarily consecutive) major version.\n - `\"patch\"` indicates that auto-updates are performed to the latest patch version of the current minor version. When using an expired version during the maintenance window, force updates to the latest patch of the next (not necessarily consecutive) minor when using an expired version.",
 							Type:        []string{"string"},
 							Format:      "",
+							Enum:        []interface{}{"major", "minor", "patch"},
 						},
 					},
 				},
@@ -5378,9 +7044,10 @@ func schema_pkg_apis_core_v1beta1_MachineImageVersion(ref common.ReferenceCallba
 					},
 					"classification": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Classification defines the state of a version (preview, supported, deprecated)",
+							Description: "Classification defines the state of a version (preview, supported, deprecated)\n\nPossible enum values:\n - `\"deprecated\"` indicates that a patch version should not be used anymore, should be updated to a new version and will eventually expire.\n - `\"preview\"` indicates that a version has recently been added and not promoted to \"Supported\" yet. ClassificationPreview versions will not be considered for automatic Kubernetes and Machine Image patch version updates.\n - `\"supported\"` indicates that a patch version is the recommended version for a shoot. Only one \"supported\" version is allowed per minor version. Supported versions are eligible for the automated Kubernetes and Machine image patch version update for shoot clusters in Gardener.",
 							Type:        []string{"string"},
 							Format:      "",
+							Enum:        []interface{}{"deprecated", "preview", "supported"},
 						},
 					},
 					"cri": {
@@ -5428,6 +7095,44 @@ func schema_pkg_apis_core_v1beta1_MachineImageVersion(ref common.ReferenceCallba
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_MachineImageVersionUsage(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MachineImageVersionUsage contains information about the usage of a machine image version.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the machine image.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Version is the version of the machine image.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"shoots": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Shoots is the number of Shoots using the machine image version in at least one of their worker pools.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"name", "version", "shoots"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_MachineType(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -5470,6 +7175,7 @@ func schema_pkg_apis_core_v1beta1_MachineType(ref common.ReferenceCallback) comm
 					"usable": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Usable defines if the machine type can be used for shoot clusters.",
+							Default:     true,
 							Type:        []string{"boolean"},
 							Format:      "",
 						},
@@ -5477,16 +7183,47 @@ func schema_pkg_apis_core_v1beta1_MachineType(ref common.ReferenceCallback) comm
 					"architecture": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Architecture is the CPU architecture of this machine type.",
+							Default:     "amd64",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"capacityTypes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CapacityTypes is the list of capacity types supported by this machine type. If empty, only on-demand capacity is supported. Worker pools using the `SpotWithFallback` capacity type require both `Spot` and `OnDemand` capacity.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+										Enum:    []interface{}{"OnDemand", "Spot", "SpotWithFallback"},
+									},
+								},
+							},
+						},
+					},
+					"regions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Regions restricts the availability of this machine type to the listed regions. If empty, the machine type is available in all regions of the cloud profile.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.TypeRegionAvailability"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"cpu", "gpu", "memory", "name"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineTypeStorage", "k8s.io/apimachinery/pkg/api/resource.Quantity"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineTypeStorage", "github.com/gardener/gardener/pkg/apis/core/v1beta1.TypeRegionAvailability", "k8s.io/apimachinery/pkg/api/resource.Quantity"},
 	}
 }
 
@@ -5560,11 +7297,51 @@ func schema_pkg_apis_core_v1beta1_Maintenance(ref common.ReferenceCallback) comm
 							Format:      "",
 						},
 					},
+					"exclusions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "name",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Exclusions is a list of time windows in which the listed operations must not be performed by Gardener, e.g., during blackout periods like retail peak seasons.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenanceExclusion"),
+									},
+								},
+							},
+						},
+					},
+					"workerPools": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "name",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "WorkerPools contains maintenance time windows for individual worker pools. Automatic updates of the machine image and Kubernetes versions of the listed worker pools are performed in their own time window instead of the time window of the shoot.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerPoolMaintenance"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenanceAutoUpdate", "github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenanceTimeWindow"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenanceAutoUpdate", "github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenanceExclusion", "github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenanceTimeWindow", "github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerPoolMaintenance"},
 	}
 }
 
@@ -5597,6 +7374,58 @@ func schema_pkg_apis_core_v1beta1_MaintenanceAutoUpdate(ref common.ReferenceCall
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_MaintenanceExclusion(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MaintenanceExclusion is a time window in which certain operations must not be performed.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the exclusion.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"begin": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Begin is the point in time when the exclusion starts.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"end": {
+						SchemaProps: spec.SchemaProps{
+							Description: "End is the point in time when the exclusion ends.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"operations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Operations is the list of operations which must not be performed while the exclusion is active. Possible values are \"NodeRoll\", \"EtcdMaintenance\" and \"CARotation\".",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+										Enum:    []interface{}{"CARotation", "EtcdMaintenance", "NodeRoll"},
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"name", "begin", "end", "operations"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_MaintenanceTimeWindow(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -5627,6 +7456,44 @@ func schema_pkg_apis_core_v1beta1_MaintenanceTimeWindow(ref common.ReferenceCall
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_ManagedAddon(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ManagedAddon references an addon from the catalog of the CloudProfile which is deployed into the shoot cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the addon in the catalog.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Version is the version of the addon.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"values": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Values are the values for the Helm chart of the addon. They are validated against the values schema of the addon version.",
+							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+						},
+					},
+				},
+				Required: []string{"name", "version"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/runtime.RawExtension"},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_MemorySwapConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -5636,9 +7503,10 @@ func schema_pkg_apis_core_v1beta1_MemorySwapConfiguration(ref common.ReferenceCa
 				Properties: map[string]spec.Schema{
 					"swapBehavior": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SwapBehavior configures swap memory available to container workloads. May be one of {\"LimitedSwap\", \"UnlimitedSwap\"} defaults to: LimitedSwap",
+							Description: "SwapBehavior configures swap memory available to container workloads. May be one of {\"LimitedSwap\", \"UnlimitedSwap\"} defaults to: LimitedSwap\n\nPossible enum values:\n - `\"LimitedSwap\"` is a constant for the kubelet's swap behavior limiting the amount of swap usable for Kubernetes workloads. Workloads on the node not managed by Kubernetes can still swap. - cgroupsv1 host: Kubernetes workloads can use any combination of memory and swap, up to the pod's memory limit - cgroupsv2 host: swap is managed independently from memory. Kubernetes workloads cannot use swap memory.\n - `\"NoSwap\"` is a constant for the kubelet's swap behavior restricting Kubernetes workloads to not use swap. Only available for Kubernetes versions >= v1.30.\n - `\"UnlimitedSwap\"` is a constant for the kubelet's swap behavior enabling Kubernetes workloads to use as much swap memory as required, up to the system limit (not limited by pod or container memory limits). Only available for Kubernetes versions < v1.30.",
 							Type:        []string{"string"},
 							Format:      "",
+							Enum:        []interface{}{"LimitedSwap", "NoSwap", "UnlimitedSwap"},
 						},
 					},
 				},
@@ -5699,39 +7567,178 @@ func schema_pkg_apis_core_v1beta1_NamedResourceReference(ref common.ReferenceCal
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_NamespacedCloudProfile(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_NamespaceDefaults(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "NamespacedCloudProfile represents certain properties about a provider environment.",
+				Description: "NamespaceDefaults contains LimitRanges and ResourceQuotas which are applied to the namespaces in the Shoot cluster.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"apiVersion": {
+					"namespaceSelector": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "NamespaceSelector selects the namespaces to which the LimitRanges and ResourceQuotas are applied. If not set, they are applied to all namespaces. Namespaces managed by Gardener, e.g. `kube-system`, are never selected.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
 						},
 					},
-					"metadata": {
+					"limitRanges": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Standard object metadata.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Description: "LimitRanges are the LimitRanges which are applied to the selected namespaces.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.NamespaceDefaultsLimitRange"),
+									},
+								},
+							},
 						},
 					},
-					"spec": {
+					"resourceQuotas": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec defines the provider environment properties.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.NamespacedCloudProfileSpec"),
+							Description: "ResourceQuotas are the ResourceQuotas which are applied to the selected namespaces.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.NamespaceDefaultsResourceQuota"),
+									},
+								},
+							},
+						},
+					},
+					"networkPolicies": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NetworkPolicies configures the default NetworkPolicies which are applied to the selected namespaces.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.NamespaceDefaultsNetworkPolicies"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.NamespaceDefaultsLimitRange", "github.com/gardener/gardener/pkg/apis/core/v1beta1.NamespaceDefaultsNetworkPolicies", "github.com/gardener/gardener/pkg/apis/core/v1beta1.NamespaceDefaultsResourceQuota", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_NamespaceDefaultsLimitRange(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NamespaceDefaultsLimitRange is a LimitRange which is applied to the selected namespaces in the Shoot cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the LimitRange in the namespaces.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec is the specification of the LimitRange.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/api/core/v1.LimitRangeSpec"),
+						},
+					},
+				},
+				Required: []string{"name", "spec"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.LimitRangeSpec"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_NamespaceDefaultsNetworkPolicies(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NamespaceDefaultsNetworkPolicies configures the default NetworkPolicies which are applied to the selected namespaces in the Shoot cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"denyAll": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DenyAll enables a NetworkPolicy which denies all ingress and egress traffic of the pods in the selected namespaces. Additionally, NetworkPolicies allowing egress traffic to the cluster DNS and to the kube-apiserver are applied. Namespaces labeled with `networking.gardener.cloud/default-network-policies=disabled` are excluded.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"denyAll"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_NamespaceDefaultsResourceQuota(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NamespaceDefaultsResourceQuota is a ResourceQuota which is applied to the selected namespaces in the Shoot cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the ResourceQuota in the namespaces.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec is the specification of the ResourceQuota.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/api/core/v1.ResourceQuotaSpec"),
+						},
+					},
+				},
+				Required: []string{"name", "spec"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.ResourceQuotaSpec"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_NamespacedCloudProfile(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NamespacedCloudProfile represents certain properties about a provider environment.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard object metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec defines the provider environment properties.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.NamespacedCloudProfileSpec"),
 						},
 					},
 					"status": {
@@ -5974,7 +7981,7 @@ func schema_pkg_apis_core_v1beta1_Networking(ref common.ReferenceCallback) commo
 					},
 					"ipFamilies": {
 						SchemaProps: spec.SchemaProps{
-							Description: "IPFamilies specifies the IP protocol versions to use for shoot networking. This field is immutable. See https://github.com/gardener/gardener/blob/master/docs/development/ipv6.md. Defaults to [\"IPv4\"].",
+							Description: "IPFamilies specifies the IP protocol versions to use for shoot networking. The only supported change of this field is the migration from [\"IPv4\"] to [\"IPv4\", \"IPv6\"]. See https://github.com/gardener/gardener/blob/master/docs/development/ipv6.md. Defaults to [\"IPv4\"].",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -5982,6 +7989,7 @@ func schema_pkg_apis_core_v1beta1_Networking(ref common.ReferenceCallback) commo
 										Default: "",
 										Type:    []string{"string"},
 										Format:  "",
+										Enum:    []interface{}{"IPv4", "IPv6"},
 									},
 								},
 							},
@@ -6062,6 +8070,21 @@ func schema_pkg_apis_core_v1beta1_NetworkingStatus(ref common.ReferenceCallback)
 							},
 						},
 					},
+					"controlPlaneEgressCIDRs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ControlPlaneEgressCIDRs is a list of CIDRs used by the control plane components of the shoot as the source IP for egress traffic as reported by the used ControlPlane extension controller. It is only populated if a dedicated control plane egress is configured.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
@@ -6165,10 +8188,112 @@ func schema_pkg_apis_core_v1beta1_NodeLocalDNS(ref common.ReferenceCallback) com
 							Format:      "",
 						},
 					},
+					"cache": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Cache contains the settings of the cache of node local DNS. If unset, successful responses are cached for up to 30 seconds and negative responses for up to 5 seconds (`cluster.local`) or 30 seconds (all other zones).",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.NodeLocalDNSCache"),
+						},
+					},
+					"zoneProtocols": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ZoneProtocols is a list of zones for which the protocol used by node local DNS to forward requests is overridden. Overrides take precedence over ForceTCPToClusterDNS and ForceTCPToUpstreamDNS.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.NodeLocalDNSZoneProtocol"),
+									},
+								},
+							},
+						},
+					},
+					"disabledWorkerPools": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DisabledWorkerPools is a list of names of worker pools whose nodes do not run node local DNS. Pods on these nodes resolve DNS directly via the cluster DNS (Core DNS).",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"enabled"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.NodeLocalDNSCache", "github.com/gardener/gardener/pkg/apis/core/v1beta1.NodeLocalDNSZoneProtocol"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_NodeLocalDNSCache(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NodeLocalDNSCache contains the settings of the cache of node local DNS.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"successTTLSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SuccessTTLSeconds is the maximum time in seconds successful responses are cached. Defaults to 30.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"denialTTLSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DenialTTLSeconds is the maximum time in seconds negative responses (NXDOMAIN, NODATA) are cached. Defaults to 5 for `cluster.local` and 30 for all other zones. Must not be set if negative caching is disabled.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"disableNegativeCaching": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DisableNegativeCaching indicates whether caching of negative responses is disabled. Defaults to false.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_NodeLocalDNSZoneProtocol(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NodeLocalDNSZoneProtocol contains the protocol used by node local DNS to forward requests of a zone.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"zone": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Zone is the zone served by node local DNS. Supported values are `cluster.local`, `in-addr.arpa`, `ip6.arpa` and `.` (all other domains).",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"protocol": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Protocol is the protocol used to forward requests of the zone. Supported values are `TCP` (always use TCP) and `UDP` (prefer UDP and retry truncated responses with TCP).\n\nPossible enum values:\n - `\"TCP\"` is a constant for forwarding requests always via TCP.\n - `\"UDP\"` is a constant for forwarding requests preferably via UDP.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+							Enum:        []interface{}{"TCP", "UDP"},
+						},
+					},
+				},
+				Required: []string{"zone", "protocol"},
+			},
+		},
 	}
 }
 
@@ -6377,61 +8502,77 @@ func schema_pkg_apis_core_v1beta1_OpenIDConnectClientAuthentication(ref common.R
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_Project(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_PendingMaintenanceOperation(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Project holds certain properties about a Gardener project.",
+				Description: "PendingMaintenanceOperation is an update which will be applied to the Shoot in its next maintenance time window.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Type is the type of the update, one of KubernetesVersion, MachineImageVersion, Configuration.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"workerPool": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "WorkerPool is the name of the worker pool which is updated. It is not set for updates of the control plane.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"currentVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Standard object metadata.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Description: "CurrentVersion is the currently used Kubernetes or machine image version.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"spec": {
+					"targetVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec defines the project properties.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectSpec"),
+							Description: "TargetVersion is the Kubernetes or machine image version the Shoot will be updated to. It is not set if no suitable version could be determined, in which case the maintenance will fail.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"status": {
+					"forced": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Most recently observed status of the Project.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectStatus"),
+							Description: "Forced indicates that the update is applied regardless of the auto-update settings of the Shoot, e.g., because the current version expires.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Description is a human-readable description of the update and the reason why it is applied.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"scheduledTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ScheduledTime is the beginning of the maintenance time window in which the update will be applied.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
+				Required: []string{"type", "description", "scheduledTime"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectSpec", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_ProjectList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_Project(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ProjectList is a collection of Projects.",
+				Description: "Project holds certain properties about a Gardener project.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -6450,7 +8591,57 @@ func schema_pkg_apis_core_v1beta1_ProjectList(ref common.ReferenceCallback) comm
 					},
 					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Standard list object metadata.",
+							Description: "Standard object metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec defines the project properties.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Most recently observed status of the Project.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectSpec", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_ProjectList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ProjectList is a collection of Projects.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard list object metadata.",
 							Default:     map[string]interface{}{},
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
 						},
@@ -6545,6 +8736,80 @@ func schema_pkg_apis_core_v1beta1_ProjectMember(ref common.ReferenceCallback) co
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_ProjectMemberSync(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ProjectMemberSync contains the configuration for synchronizing the project members from groups of an external identity provider.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"groupPrefix": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GroupPrefix is the prefix which is prepended to the group names when they are added as members of the project. It must match the groups prefix configured for the OIDC authentication of the garden cluster (e.g., 'oidc:').",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"groups": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Groups is a list of mappings from groups of the identity provider to roles in the project.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectMemberSyncGroup"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"groups"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectMemberSyncGroup"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_ProjectMemberSyncGroup(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ProjectMemberSyncGroup maps a group of an external identity provider to roles in the project.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the group as presented in the groups claim of the identity provider.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"roles": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Roles is the list of project roles which are granted to the members of the group.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"name", "roles"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_ProjectSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -6619,11 +8884,45 @@ func schema_pkg_apis_core_v1beta1_ProjectSpec(ref common.ReferenceCallback) comm
 							},
 						},
 					},
+					"trustedIdentityProviders": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TrustedIdentityProviders contains a list of external OIDC identity providers whose tokens can be exchanged for short-lived credentials for the shoot clusters of this project.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.TrustedIdentityProvider"),
+									},
+								},
+							},
+						},
+					},
+					"quotas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Quotas is a list of references to Quota objects which are enforced for all shoots of this project, independent of the credentials they use. Modifying this field requires the `modify-spec-quotas` custom verb.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.ObjectReference"),
+									},
+								},
+							},
+						},
+					},
+					"memberSync": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MemberSync contains the configuration for synchronizing the project members from groups of an external identity provider. Members which are managed by the synchronization are maintained by Gardener and should not be changed manually.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectMemberSync"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.DualApprovalForDeletion", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectMember", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectTolerations", "k8s.io/api/rbac/v1.Subject"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.DualApprovalForDeletion", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectMember", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectMemberSync", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectTolerations", "github.com/gardener/gardener/pkg/apis/core/v1beta1.TrustedIdentityProvider", "k8s.io/api/core/v1.ObjectReference", "k8s.io/api/rbac/v1.Subject"},
 	}
 }
 
@@ -6643,9 +8942,10 @@ func schema_pkg_apis_core_v1beta1_ProjectStatus(ref common.ReferenceCallback) co
 					},
 					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Phase is the current phase of the project.",
+							Description: "Phase is the current phase of the project.\n\nPossible enum values:\n - `\"Failed\"` indicates that the project reconciliation failed.\n - `\"Pending\"` indicates that the project reconciliation is pending.\n - `\"Ready\"` indicates that the project reconciliation was successful.\n - `\"Terminating\"` indicates that the project is in termination process.",
 							Type:        []string{"string"},
 							Format:      "",
+							Enum:        []interface{}{"Failed", "Pending", "Ready", "Terminating"},
 						},
 					},
 					"staleSinceTimestamp": {
@@ -6994,12 +9294,52 @@ func schema_pkg_apis_core_v1beta1_Region(ref common.ReferenceCallback) common.Op
 							},
 						},
 					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Metadata contains structured information about the region, e.g. wrt data residency.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.RegionMetadata"),
+						},
+					},
 				},
 				Required: []string{"name"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.AccessRestriction", "github.com/gardener/gardener/pkg/apis/core/v1beta1.AvailabilityZone"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.AccessRestriction", "github.com/gardener/gardener/pkg/apis/core/v1beta1.AvailabilityZone", "github.com/gardener/gardener/pkg/apis/core/v1beta1.RegionMetadata"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_RegionMetadata(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RegionMetadata contains structured information about a region.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"geography": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Geography is the geographical area the region is located in, e.g. `europe` or `north-america`.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"jurisdiction": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Jurisdiction is the legal jurisdiction which applies to data stored in the region, e.g. `EU` or `US`.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"latencyMatrixKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LatencyMatrixKey is the key of the region in the region ConfigMap of the gardener-scheduler which contains the latencies between regions (used by the `MinimalDistance` strategy). Defaults to the name of the region.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -7329,12 +9669,93 @@ func schema_pkg_apis_core_v1beta1_SeedBackup(ref common.ReferenceCallback) commo
 							Ref:         ref("k8s.io/api/core/v1.SecretReference"),
 						},
 					},
+					"immutability": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Immutability contains the immutability (object lock) configuration of the backup bucket. If set, the etcd backups of the shoots are protected against deletion and overwrites for the configured retention period.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.BackupBucketImmutability"),
+						},
+					},
+					"etcd": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ETCD contains bounds for the etcd backup settings which can be configured in the `.spec.etcd.backup` field of the shoots hosted on this seed.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedBackupETCD"),
+						},
+					},
 				},
 				Required: []string{"provider", "secretRef"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.SecretReference", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.BackupBucketImmutability", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedBackupETCD", "k8s.io/api/core/v1.SecretReference", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_SeedBackupETCD(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SeedBackupETCD contains bounds for the etcd backup settings of the shoots hosted on a seed.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"minFullSnapshotInterval": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MinFullSnapshotInterval is the minimum interval between two full snapshots which shoots may configure via their full snapshot schedule.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"maxDeltaSnapshotRetentionPeriod": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxDeltaSnapshotRetentionPeriod is the maximum retention period for delta snapshots which shoots may configure.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_SeedCredentials(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SeedCredentials contains information about the credentials of the seed-internal PKI.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"rotation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rotation contains information about the credential rotations.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedCredentialsRotation"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedCredentialsRotation"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_SeedCredentialsRotation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SeedCredentialsRotation contains information about the rotation of credentials.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"certificateAuthorities": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CertificateAuthorities contains information about the seed certificate authority credential rotation.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.CARotation"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.CARotation"},
 	}
 }
 
@@ -7390,46 +9811,104 @@ func schema_pkg_apis_core_v1beta1_SeedDNSProvider(ref common.ReferenceCallback)
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_SeedList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_SeedIngressDomainMigration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "SeedList is a collection of Seeds.",
+				Description: "SeedIngressDomainMigration contains information about the migration of the shoots hosted on a seed to a changed ingress domain.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"previousDomain": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "PreviousDomain is the ingress domain of the seed before the migration was started.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"domain": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Domain is the ingress domain the shoots are migrated to.",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"startTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Standard list object metadata.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Description: "StartTime is the time when the migration was started.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"items": {
+					"completionTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Items is the list of Seeds.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Seed"),
-									},
-								},
-							},
+							Description: "CompletionTime is the time when all shoots were migrated to the new ingress domain.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"shoots": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Shoots is the number of shoots hosted on the seed which have to be migrated.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"migratedShoots": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MigratedShoots is the number of shoots which were successfully reconciled with the new ingress domain.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"previousDomain", "domain", "startTime"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_SeedList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SeedList is a collection of Seeds.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard list object metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Items is the list of Seeds.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Seed"),
+									},
+								},
+							},
 						},
 					},
 				},
@@ -7441,6 +9920,29 @@ func schema_pkg_apis_core_v1beta1_SeedList(ref common.ReferenceCallback) common.
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_SeedMaintenance(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SeedMaintenance contains information about the maintenance of a Seed.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"timeWindow": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TimeWindow contains information about the time window in which gardenlet may update the seed system components, e.g., after gardenlet was upgraded.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenanceTimeWindow"),
+						},
+					},
+				},
+				Required: []string{"timeWindow"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenanceTimeWindow"},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_SeedNetworks(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -7502,6 +10004,7 @@ func schema_pkg_apis_core_v1beta1_SeedNetworks(ref common.ReferenceCallback) com
 										Default: "",
 										Type:    []string{"string"},
 										Format:  "",
+										Enum:    []interface{}{"IPv4", "IPv6"},
 									},
 								},
 							},
@@ -7510,12 +10013,69 @@ func schema_pkg_apis_core_v1beta1_SeedNetworks(ref common.ReferenceCallback) com
 				},
 				Required: []string{"pods", "services"},
 			},
+			VendorExtensible: spec.VendorExtensible{
+				Extensions: spec.Extensions{
+					"x-kubernetes-validations": []interface{}{map[string]interface{}{"fieldPath": ".services", "message": "must not overlap with the pod network", "rule": "!isCIDR(self.pods) || !isCIDR(self.services) || !(cidr(self.pods).containsIP(cidr(self.services).ip()) || cidr(self.services).containsIP(cidr(self.pods).ip()))"}, map[string]interface{}{"fieldPath": ".nodes", "message": "must not overlap with the pod or service network", "rule": "!has(self.nodes) || !isCIDR(self.nodes) || [self.pods, self.services].all(n, !isCIDR(n) || !(cidr(n).containsIP(cidr(self.nodes).ip()) || cidr(self.nodes).containsIP(cidr(n).ip())))"}, map[string]interface{}{"fieldPath": ".shootDefaults.pods", "message": "must not overlap with the pod, service or node network", "rule": "!has(self.shootDefaults) || !has(self.shootDefaults.pods) || !isCIDR(self.shootDefaults.pods) || ([self.pods, self.services] + (has(self.nodes) ? [self.nodes] : [])).all(n, !isCIDR(n) || !(cidr(n).containsIP(cidr(self.shootDefaults.pods).ip()) || cidr(self.shootDefaults.pods).containsIP(cidr(n).ip())))"}, map[string]interface{}{"fieldPath": ".shootDefaults.services", "message": "must not overlap with the pod, service or node network or the default shoot pod network", "rule": "!has(self.shootDefaults) || !has(self.shootDefaults.services) || !isCIDR(self.shootDefaults.services) || ([self.pods, self.services] + (has(self.nodes) ? [self.nodes] : []) + (has(self.shootDefaults.pods) ? [self.shootDefaults.pods] : [])).all(n, !isCIDR(n) || !(cidr(n).containsIP(cidr(self.shootDefaults.services).ip()) || cidr(self.shootDefaults.services).containsIP(cidr(n).ip())))"}},
+				},
+			},
 		},
 		Dependencies: []string{
 			"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootNetworks"},
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_SeedPendingMaintenanceOperation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SeedPendingMaintenanceOperation is an operation which will be performed in the next maintenance time window of the Seed.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the type of the operation, one of SystemComponentsUpdate.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"currentVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CurrentVersion is the version of the gardenlet which last deployed the seed system components.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"targetVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetVersion is the version of the gardenlet which will deploy the seed system components.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Description is a human-readable description of the operation.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"scheduledTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ScheduledTime is the beginning of the maintenance time window in which the operation will be performed.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"type", "description", "scheduledTime"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_SeedProvider(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -7560,12 +10120,18 @@ func schema_pkg_apis_core_v1beta1_SeedProvider(ref common.ReferenceCallback) com
 							},
 						},
 					},
+					"regionMetadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RegionMetadata contains structured information about the region of the seed cluster, e.g. wrt data residency. If not set, the metadata of the region in the CloudProfile of the shoot is used (if the provider types match).",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.RegionMetadata"),
+						},
+					},
 				},
 				Required: []string{"type", "region"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/runtime.RawExtension"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.RegionMetadata", "k8s.io/apimachinery/pkg/runtime.RawExtension"},
 	}
 }
 
@@ -7634,6 +10200,35 @@ func schema_pkg_apis_core_v1beta1_SeedSelector(ref common.ReferenceCallback) com
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_SeedSettingACME(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SeedSettingACME configures the ACME server which is used for issuing managed certificates for the kube-apiservers of shoots hosted by the seed.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"directoryURL": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DirectoryURL is the URL of the directory endpoint of the ACME server, e.g. `https://acme-v02.api.letsencrypt.org/directory`.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"email": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Email is the contact email address of the ACME account which is registered by gardenlet.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"directoryURL"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_SeedSettingDependencyWatchdog(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -8023,11 +10618,17 @@ func schema_pkg_apis_core_v1beta1_SeedSettings(ref common.ReferenceCallback) com
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingTopologyAwareRouting"),
 						},
 					},
+					"acme": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ACME configures the ACME server (e.g., Let's Encrypt) which is used for issuing managed certificates for the kube-apiservers of shoots hosted by the seed, see `.spec.kubernetes.kubeAPIServer.endpoint.managedCertificate` in the Shoot API.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingACME"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingDependencyWatchdog", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingExcessCapacityReservation", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingLoadBalancerServices", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingScheduling", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingTopologyAwareRouting", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingVerticalPodAutoscaler"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingACME", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingDependencyWatchdog", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingExcessCapacityReservation", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingLoadBalancerServices", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingScheduling", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingTopologyAwareRouting", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettingVerticalPodAutoscaler"},
 	}
 }
 
@@ -8111,12 +10712,18 @@ func schema_pkg_apis_core_v1beta1_SeedSpec(ref common.ReferenceCallback) common.
 							},
 						},
 					},
+					"maintenance": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Maintenance contains information about the time window in which gardenlet may update the seed system components. If it is not specified, the seed system components are updated immediately, e.g., after gardenlet was upgraded.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedMaintenance"),
+						},
+					},
 				},
 				Required: []string{"dns", "networks", "provider"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.AccessRestriction", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Ingress", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedBackup", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedDNS", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedNetworks", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedProvider", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettings", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedTaint", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedVolume"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.AccessRestriction", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Ingress", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedBackup", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedDNS", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedMaintenance", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedNetworks", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedProvider", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSettings", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedTaint", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedVolume"},
 	}
 }
 
@@ -8214,11 +10821,44 @@ func schema_pkg_apis_core_v1beta1_SeedStatus(ref common.ReferenceCallback) commo
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.LastOperation"),
 						},
 					},
+					"ingressDomainMigration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IngressDomainMigration contains information about the migration of the shoots hosted on this seed to a changed ingress domain.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedIngressDomainMigration"),
+						},
+					},
+					"credentials": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Credentials contains information about the credentials of the seed-internal PKI.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedCredentials"),
+						},
+					},
+					"systemComponentsVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SystemComponentsVersion is the version of the gardenlet which last deployed the seed system components.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"pendingMaintenanceOperations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PendingMaintenanceOperations lists the operations which will be performed in the next maintenance time window of the Seed.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedPendingMaintenanceOperation"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Condition", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Gardener", "github.com/gardener/gardener/pkg/apis/core/v1beta1.LastOperation", "k8s.io/apimachinery/pkg/api/resource.Quantity", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Condition", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Gardener", "github.com/gardener/gardener/pkg/apis/core/v1beta1.LastOperation", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedCredentials", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedIngressDomainMigration", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedPendingMaintenanceOperation", "k8s.io/apimachinery/pkg/api/resource.Quantity", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -8244,6 +10884,13 @@ func schema_pkg_apis_core_v1beta1_SeedTaint(ref common.ReferenceCallback) common
 							Format:      "",
 						},
 					},
+					"effect": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Effect is the effect of the taint on shoots that do not tolerate it. Valid effects are NoSchedule, PreferNoSchedule and NoExecute. If not set, the taint has the NoSchedule effect.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"key"},
 			},
@@ -8410,10 +11057,11 @@ func schema_pkg_apis_core_v1beta1_ServiceAccountKeyRotation(ref common.Reference
 				Properties: map[string]spec.Schema{
 					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Phase describes the phase of the service account key credential rotation.",
+							Description: "Phase describes the phase of the service account key credential rotation.\n\nPossible enum values:\n - `\"Completed\"` is a constant for the credentials rotation phase describing that the procedure was completed.\n - `\"Completing\"` is a constant for the credentials rotation phase describing that the procedure is being completed.\n - `\"Prepared\"` is a constant for the credentials rotation phase describing that the procedure was prepared.\n - `\"Preparing\"` is a constant for the credentials rotation phase describing that the procedure is being prepared.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
+							Enum:        []interface{}{"Completed", "Completing", "Prepared", "Preparing"},
 						},
 					},
 					"lastCompletionTime": {
@@ -8529,63 +11177,212 @@ func schema_pkg_apis_core_v1beta1_ShootAdvertisedAddress(ref common.ReferenceCal
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_ShootCredentials(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_ShootClass(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ShootCredentials contains information about the shoot credentials.",
+				Description: "ShootClass represents a template for Shoot clusters, e.g., a t-shirt size, which encapsulates the worker pool layout, the high availability settings and the hibernation defaults. Shoots can reference a ShootClass by name, its settings are applied to the Shoot when it is created.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"rotation": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Rotation contains information about the credential rotations.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootCredentialsRotation"),
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard object metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec contains the settings of the ShootClass.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootClassSpec"),
 						},
 					},
 				},
+				Required: []string{"spec"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootCredentialsRotation"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootClassSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_ShootCredentialsRotation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_ShootClassList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ShootCredentialsRotation contains information about the rotation of credentials.",
+				Description: "ShootClassList is a collection of ShootClasses.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"certificateAuthorities": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CertificateAuthorities contains information about the certificate authority credential rotation.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.CARotation"),
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"kubeconfig": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kubeconfig contains information about the kubeconfig credential rotation.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootKubeconfigRotation"),
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"sshKeypair": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SSHKeypair contains information about the ssh-keypair credential rotation.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootSSHKeypairRotation"),
+							Description: "Standard list object metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
 						},
 					},
-					"observability": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Observability contains information about the observability credential rotation.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ObservabilityRotation"),
+							Description: "Items is the list of ShootClasses.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootClass"),
+									},
+								},
+							},
 						},
 					},
-					"serviceAccountKey": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ServiceAccountKey contains information about the service account key credential rotation.",
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ServiceAccountKeyRotation"),
-						},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootClass", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_ShootClassSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootClassSpec contains the settings of a ShootClass.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"providerType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProviderType restricts the usage of the ShootClass to Shoots of the given provider type. It must be set if worker pools are specified.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"workers": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "name",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Workers is the list of worker pools which is applied to Shoots not specifying any worker pools.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Worker"),
+									},
+								},
+							},
+						},
+					},
+					"controlPlane": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ControlPlane contains the control plane settings (e.g., high availability) which are applied to Shoots not specifying any control plane settings.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ControlPlane"),
+						},
+					},
+					"hibernation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Hibernation contains the hibernation settings which are applied to Shoots not specifying any hibernation settings.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Hibernation"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.ControlPlane", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Hibernation", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Worker"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_ShootCredentials(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootCredentials contains information about the shoot credentials.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"rotation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rotation contains information about the credential rotations.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootCredentialsRotation"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootCredentialsRotation"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_ShootCredentialsRotation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootCredentialsRotation contains information about the rotation of credentials.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"certificateAuthorities": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CertificateAuthorities contains information about the certificate authority credential rotation.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.CARotation"),
+						},
+					},
+					"kubeconfig": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kubeconfig contains information about the kubeconfig credential rotation.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootKubeconfigRotation"),
+						},
+					},
+					"sshKeypair": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SSHKeypair contains information about the ssh-keypair credential rotation.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootSSHKeypairRotation"),
+						},
+					},
+					"observability": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Observability contains information about the observability credential rotation.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ObservabilityRotation"),
+						},
+					},
+					"serviceAccountKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ServiceAccountKey contains information about the service account key credential rotation.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ServiceAccountKeyRotation"),
+						},
 					},
 					"etcdEncryptionKey": {
 						SchemaProps: spec.SchemaProps{
@@ -8743,6 +11540,61 @@ func schema_pkg_apis_core_v1beta1_ShootNetworks(ref common.ReferenceCallback) co
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_ShootRemediation(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootRemediation contains information about the last execution of a remediation action for a failed condition.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"action": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Action is the name of the remediation action.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"conditionType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConditionType is the type of the failed condition which triggered the last execution.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"state": {
+						SchemaProps: spec.SchemaProps{
+							Description: "State is the state of the last execution, one of Succeeded, Failed.\n\nPossible enum values:\n - `\"Aborted\"` indicates that an operation has been aborted.\n - `\"Error\"` indicates that an operation is completed with errors and will be retried.\n - `\"Failed\"` indicates that an operation is completed with errors and won't be retried.\n - `\"Pending\"` indicates that an operation cannot be done now, but will be tried in future.\n - `\"Processing\"` indicates that an operation is ongoing.\n - `\"Succeeded\"` indicates that an operation has completed successfully.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+							Enum:        []interface{}{"Aborted", "Error", "Failed", "Pending", "Processing", "Succeeded"},
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Description is a human-readable description of the result of the last execution.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastExecutionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastExecutionTime is the time of the last execution.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"action", "conditionType", "state", "description", "lastExecutionTime"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_ShootSSHKeypairRotation(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -8850,9 +11702,11 @@ func schema_pkg_apis_core_v1beta1_ShootSpec(ref common.ReferenceCallback) common
 					},
 					"purpose": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Purpose is the purpose class for this cluster.",
+							Description: "Purpose is the purpose class for this cluster.\n\nPossible enum values:\n - `\"development\"` is a constant for the development purpose.\n - `\"evaluation\"` is a constant for the evaluation purpose.\n - `\"infrastructure\"` is a constant for the infrastructure purpose.\n - `\"production\"` is a constant for the production purpose.\n - `\"testing\"` is a constant for the testing purpose.",
+							Default:     "evaluation",
 							Type:        []string{"string"},
 							Format:      "",
+							Enum:        []interface{}{"development", "evaluation", "infrastructure", "production", "testing"},
 						},
 					},
 					"region": {
@@ -8939,6 +11793,7 @@ func schema_pkg_apis_core_v1beta1_ShootSpec(ref common.ReferenceCallback) common
 					"schedulerName": {
 						SchemaProps: spec.SchemaProps{
 							Description: "SchedulerName is the name of the responsible scheduler which schedules the shoot. If not specified, the default scheduler takes over. This field is immutable.",
+							Default:     "default-scheduler",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -8970,12 +11825,37 @@ func schema_pkg_apis_core_v1beta1_ShootSpec(ref common.ReferenceCallback) common
 							},
 						},
 					},
+					"dataResidency": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DataResidency contains constraints for the placement of the shoot cluster wrt data residency. Both the region of the shoot and the region of the seed hosting its control plane must satisfy them.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.DataResidency"),
+						},
+					},
+					"etcd": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ETCD contains settings for the etcd of the shoot cluster.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ETCD"),
+						},
+					},
+					"expirationTimestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExpirationTimestamp is the time after which the shoot cluster is automatically deleted. Warning events are emitted on the Shoot shortly before. If the project requires a dual approval for the deletion of this shoot, it is not deleted automatically.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"shootClassName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ShootClassName is the name of a ShootClass whose worker pools, control plane and hibernation settings are applied to the Shoot when it is created, unless the Shoot specifies them itself. This field is immutable.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"kubernetes", "provider", "region"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.AccessRestrictionWithOptions", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Addons", "github.com/gardener/gardener/pkg/apis/core/v1beta1.CloudProfileReference", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ControlPlane", "github.com/gardener/gardener/pkg/apis/core/v1beta1.DNS", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Extension", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Hibernation", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Kubernetes", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Maintenance", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Monitoring", "github.com/gardener/gardener/pkg/apis/core/v1beta1.NamedResourceReference", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Networking", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Provider", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSelector", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SystemComponents", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Toleration"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.AccessRestrictionWithOptions", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Addons", "github.com/gardener/gardener/pkg/apis/core/v1beta1.CloudProfileReference", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ControlPlane", "github.com/gardener/gardener/pkg/apis/core/v1beta1.DNS", "github.com/gardener/gardener/pkg/apis/core/v1beta1.DataResidency", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ETCD", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Extension", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Hibernation", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Kubernetes", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Maintenance", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Monitoring", "github.com/gardener/gardener/pkg/apis/core/v1beta1.NamedResourceReference", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Networking", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Provider", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SeedSelector", "github.com/gardener/gardener/pkg/apis/core/v1beta1.SystemComponents", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Toleration", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -9326,12 +12206,80 @@ func schema_pkg_apis_core_v1beta1_ShootStatus(ref common.ReferenceCallback) comm
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.NetworkingStatus"),
 						},
 					},
+					"sizeClass": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SizeClass is the size class of the shoot cluster. It is periodically computed based on the number of nodes and the volume of requests to the API server.\n\nPossible enum values:\n - `\"L\"` is a constant for large shoot clusters.\n - `\"M\"` is a constant for medium-sized shoot clusters.\n - `\"S\"` is a constant for small shoot clusters.\n - `\"XL\"` is a constant for extra-large shoot clusters.",
+							Type:        []string{"string"},
+							Format:      "",
+							Enum:        []interface{}{"L", "M", "S", "XL"},
+						},
+					},
+					"legacyComponentRemovals": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "name",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "LegacyComponentRemovals contains information about the removal of deprecated components from the shoot cluster.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.LegacyComponentRemoval"),
+									},
+								},
+							},
+						},
+					},
+					"etcdSnapshot": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EtcdSnapshot contains information about the last on-demand full snapshot of the Shoot's main etcd.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.EtcdSnapshot"),
+						},
+					},
+					"pendingMaintenanceOperations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PendingMaintenanceOperations lists the updates which will be applied to the Shoot in its next maintenance time window(s).",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.PendingMaintenanceOperation"),
+									},
+								},
+							},
+						},
+					},
+					"remediations": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "action",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Remediations contains information about the last executions of remediation actions for failed conditions of the Shoot.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootRemediation"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"gardener", "hibernated", "technicalID", "uid"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Condition", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Gardener", "github.com/gardener/gardener/pkg/apis/core/v1beta1.LastError", "github.com/gardener/gardener/pkg/apis/core/v1beta1.LastMaintenance", "github.com/gardener/gardener/pkg/apis/core/v1beta1.LastOperation", "github.com/gardener/gardener/pkg/apis/core/v1beta1.NetworkingStatus", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootAdvertisedAddress", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootCredentials", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Condition", "github.com/gardener/gardener/pkg/apis/core/v1beta1.EtcdSnapshot", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Gardener", "github.com/gardener/gardener/pkg/apis/core/v1beta1.LastError", "github.com/gardener/gardener/pkg/apis/core/v1beta1.LastMaintenance", "github.com/gardener/gardener/pkg/apis/core/v1beta1.LastOperation", "github.com/gardener/gardener/pkg/apis/core/v1beta1.LegacyComponentRemoval", "github.com/gardener/gardener/pkg/apis/core/v1beta1.NetworkingStatus", "github.com/gardener/gardener/pkg/apis/core/v1beta1.PendingMaintenanceOperation", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootAdvertisedAddress", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootCredentials", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootRemediation", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -9443,6 +12391,13 @@ func schema_pkg_apis_core_v1beta1_SystemComponents(ref common.ReferenceCallback)
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.NodeLocalDNS"),
 						},
 					},
+					"dedicatedPool": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DedicatedPool is the name of the worker pool which exclusively hosts the system components running in the data plane of the Shoot cluster. The nodes of this pool are tainted so that regular workload is not scheduled onto them. System components are not allowed to run on any other worker pool, i.e., `.systemComponents.allow` of all other worker pools defaults to `false` and must not be set to `true`.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -9480,37 +12435,179 @@ func schema_pkg_apis_core_v1beta1_Toleration(ref common.ReferenceCallback) commo
 	}
 }
 
-func schema_pkg_apis_core_v1beta1_VerticalPodAutoscaler(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_core_v1beta1_TrustedIdentityProvider(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "VerticalPodAutoscaler contains the configuration flags for the Kubernetes vertical pod autoscaler.",
+				Description: "TrustedIdentityProvider contains the configuration of an external OIDC identity provider (e.g., a CI system) whose tokens are federated into short-lived shoot credentials.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"enabled": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Enabled specifies whether the Kubernetes VPA shall be enabled for the shoot cluster.",
-							Default:     false,
-							Type:        []string{"boolean"},
+							Description: "Name is the name of the identity provider.",
+							Default:     "",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"evictAfterOOMThreshold": {
-						SchemaProps: spec.SchemaProps{
-							Description: "EvictAfterOOMThreshold defines the threshold that will lead to pod eviction in case it OOMed in less than the given threshold since its start and if it has only one container (default: 10m0s).",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
-						},
-					},
-					"evictionRateBurst": {
+					"issuer": {
 						SchemaProps: spec.SchemaProps{
-							Description: "EvictionRateBurst defines the burst of pods that can be evicted (default: 1)",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "Issuer is the URL of the OIDC issuer. It must use the https scheme.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"evictionRateLimit": {
+					"audiences": {
 						SchemaProps: spec.SchemaProps{
-							Description: "EvictionRateLimit defines the number of pods that can be evicted per second. A rate limit set to 0 or -1 will disable the rate limiter (default: -1).",
+							Description: "Audiences is the list of acceptable audiences. The 'aud' claim of presented tokens must contain at least one of them.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"subjects": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Subjects is the list of acceptable values for the 'sub' claim of presented tokens. A value ending with '*' matches all subjects with the given prefix.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"access": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Access is the access level granted to the shoot clusters when exchanging tokens of this identity provider. Possible values are 'Admin' and 'Viewer'. Defaults to 'Viewer'.\n\nPossible enum values:\n - `\"Admin\"` grants admin access to the shoot clusters.\n - `\"Viewer\"` grants viewer access (excluding Secrets) to the shoot clusters.",
+							Type:        []string{"string"},
+							Format:      "",
+							Enum:        []interface{}{"Admin", "Viewer"},
+						},
+					},
+				},
+				Required: []string{"name", "issuer", "audiences", "subjects"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_TypeRegionAvailability(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TypeRegionAvailability describes the availability of a machine or volume type in a region.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the region.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"zones": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Zones is the list of zones of the region in which the type is available. If empty, the type is available in all zones of the region.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"pricingClass": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PricingClass is an optional classification of the price of the type in this region, e.g. `standard` or `premium`.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_VersionUsage(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VersionUsage contains information about the usage of a version.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Version is the version.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"shoots": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Shoots is the number of Shoots using the version.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"version", "shoots"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_VerticalPodAutoscaler(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VerticalPodAutoscaler contains the configuration flags for the Kubernetes vertical pod autoscaler.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"enabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Enabled specifies whether the Kubernetes VPA shall be enabled for the shoot cluster.",
+							Default:     false,
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"evictAfterOOMThreshold": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EvictAfterOOMThreshold defines the threshold that will lead to pod eviction in case it OOMed in less than the given threshold since its start and if it has only one container (default: 10m0s).",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"evictionRateBurst": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EvictionRateBurst defines the burst of pods that can be evicted (default: 1)",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"evictionRateLimit": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EvictionRateLimit defines the number of pods that can be evicted per second. A rate limit set to 0 or -1 will disable the rate limiter (default: -1).",
 							Type:        []string{"number"},
 							Format:      "double",
 						},
@@ -9640,6 +12737,20 @@ func schema_pkg_apis_core_v1beta1_Volume(ref common.ReferenceCallback) common.Op
 							Format:      "",
 						},
 					},
+					"iops": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IOPS is the number of provisioned I/O operations per second of the volume.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"throughput": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Throughput is the provisioned throughput of the volume in MiB/s.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
 				},
 				Required: []string{"size"},
 			},
@@ -9673,6 +12784,7 @@ func schema_pkg_apis_core_v1beta1_VolumeType(ref common.ReferenceCallback) commo
 					"usable": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Usable defines if the volume type can be used for shoot clusters.",
+							Default:     true,
 							Type:        []string{"boolean"},
 							Format:      "",
 						},
@@ -9683,12 +12795,40 @@ func schema_pkg_apis_core_v1beta1_VolumeType(ref common.ReferenceCallback) commo
 							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
 						},
 					},
+					"maxIOPS": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxIOPS is the maximal number of provisioned I/O operations per second supported by this volume type. If not set, volumes of this type do not support configuring IOPS.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"maxThroughput": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxThroughput is the maximal provisioned throughput in MiB/s supported by this volume type. If not set, volumes of this type do not support configuring throughput.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"regions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Regions restricts the availability of this volume type to the listed regions. If empty, the volume type is available in all regions of the cloud profile.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.TypeRegionAvailability"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"class", "name"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.TypeRegionAvailability", "k8s.io/apimachinery/pkg/api/resource.Quantity"},
 	}
 }
 
@@ -9905,7 +13045,7 @@ func schema_pkg_apis_core_v1beta1_Worker(ref common.ReferenceCallback) common.Op
 					},
 					"sysctls": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Sysctls is a map of kernel settings to apply on all machines in this worker pool.",
+							Description: "Sysctls is a map of kernel settings to apply on all machines in this worker pool. Only settings in the `fs`, `kernel`, `net`, `user` and `vm` namespaces are allowed.",
 							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
@@ -9925,12 +13065,55 @@ func schema_pkg_apis_core_v1beta1_Worker(ref common.ReferenceCallback) common.Op
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ClusterAutoscalerOptions"),
 						},
 					},
+					"capacityType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CapacityType is the type of capacity used for the machines of this worker pool (default: OnDemand). Possible values are `OnDemand`, `Spot` and `SpotWithFallback`. With `SpotWithFallback`, the machines are preferably created with spot/preemptible capacity and the cluster-autoscaler falls back to on-demand capacity if no spot capacity is available. The capacity type must be supported by the machine type in the CloudProfile.\n\nPossible enum values:\n - `\"OnDemand\"` is a constant for regular on-demand capacity.\n - `\"Spot\"` is a constant for spot/preemptible capacity which can be reclaimed by the infrastructure provider at any time.\n - `\"SpotWithFallback\"` is a constant for preferring spot/preemptible capacity while falling back to on-demand capacity if no spot capacity is available.",
+							Type:        []string{"string"},
+							Format:      "",
+							Enum:        []interface{}{"OnDemand", "Spot", "SpotWithFallback"},
+						},
+					},
+					"updateStrategy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UpdateStrategy is the strategy used for applying changes of the machine image version and the Kubernetes version to the machines of this worker pool (default: RollingUpdate). With `RollingUpdate`, the machines are replaced by new machines. With `InPlace`, the changes are applied to the existing machines by gardener-node-agent without rolling the worker pool. The update strategy cannot be switched between `RollingUpdate` and `InPlace` once the worker pool has been created.\n\nPossible enum values:\n - `\"InPlace\"` indicates that changes of the machine image version or the Kubernetes version of the worker pool are applied to the existing machines.\n - `\"RollingUpdate\"` indicates that machines are replaced by new machines when the machine image version or the Kubernetes version of the worker pool changes.",
+							Type:        []string{"string"},
+							Format:      "",
+							Enum:        []interface{}{"InPlace", "RollingUpdate"},
+						},
+					},
+					"rolloutStrategy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RolloutStrategy contains settings for rolling out the machines of this worker pool relative to the other worker pools.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerRolloutStrategy"),
+						},
+					},
+					"nodeTemplate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeTemplate contains settings for how the labels, annotations and taints of this worker pool are applied to the `Node` objects.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerNodeTemplate"),
+						},
+					},
+					"kernelModules": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KernelModules is a list of kernel modules to load on all machines in this worker pool. Only kernel modules from a list of supported modules are allowed.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"name", "machine", "maximum", "minimum"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.CRI", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ClusterAutoscalerOptions", "github.com/gardener/gardener/pkg/apis/core/v1beta1.DataVolume", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Machine", "github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineControllerManagerSettings", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Volume", "github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerKubernetes", "github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerSystemComponents", "k8s.io/api/core/v1.Taint", "k8s.io/apimachinery/pkg/runtime.RawExtension", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.CRI", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ClusterAutoscalerOptions", "github.com/gardener/gardener/pkg/apis/core/v1beta1.DataVolume", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Machine", "github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineControllerManagerSettings", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Volume", "github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerKubernetes", "github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerNodeTemplate", "github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerRolloutStrategy", "github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerSystemComponents", "k8s.io/api/core/v1.Taint", "k8s.io/apimachinery/pkg/runtime.RawExtension", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
 	}
 }
 
@@ -9962,6 +13145,77 @@ func schema_pkg_apis_core_v1beta1_WorkerKubernetes(ref common.ReferenceCallback)
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_WorkerNodeTemplate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WorkerNodeTemplate contains settings for how the labels, annotations and taints of a worker pool are applied to the `Node` objects.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"enforceLabels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EnforceLabels makes gardener-node-agent continuously reconcile the labels, annotations and taints of the worker pool on the existing `Node` objects, i.e., manual changes to them are reverted. By default, they are only applied when a `Node` is created or when they are changed in the worker pool (default: false).",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_WorkerPoolMaintenance(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WorkerPoolMaintenance contains information about the time window for maintenance operations of a worker pool.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the worker pool.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"timeWindow": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TimeWindow contains information about the time window for maintenance operations of the worker pool.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenanceTimeWindow"),
+						},
+					},
+				},
+				Required: []string{"name", "timeWindow"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenanceTimeWindow"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_WorkerRolloutStrategy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WorkerRolloutStrategy contains settings for rolling out the machines of a worker pool.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"order": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Order is the position of the worker pool in the sequence of rolling updates of all worker pools, e.g., during a Kubernetes version upgrade or a credentials rotation. Worker pools with a lower order are rolled before worker pools with a higher order, worker pools with the same order are rolled concurrently (default: 0).",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_WorkerSystemComponents(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -9977,6 +13231,13 @@ func schema_pkg_apis_core_v1beta1_WorkerSystemComponents(ref common.ReferenceCal
 							Format:      "",
 						},
 					},
+					"reservedNodesPercentage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReservedNodesPercentage is the percentage (1-100) of nodes of this worker pool which are reserved for system components. The reserved nodes are selected by gardener-resource-manager and tainted so that only system components (and pods tolerating the taint) are scheduled onto them. This keeps capacity for system components available even if the other nodes of the worker pool are saturated by user workload. The number of reserved nodes is rounded up.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 				},
 				Required: []string{"allow"},
 			},
@@ -10005,11 +13266,299 @@ func schema_pkg_apis_core_v1beta1_WorkersSettings(ref common.ReferenceCallback)
 	}
 }
 
-func schema_pkg_apis_operations_v1alpha1_Bastion(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_operations_v1alpha1_Bastion(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Bastion holds details about an SSH bastion for a shoot cluster.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard object metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specification of the Bastion.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Most recently observed status of the Bastion.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionStatus"),
+						},
+					},
+				},
+				Required: []string{"metadata", "spec"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionSpec", "github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_pkg_apis_operations_v1alpha1_BastionIngressPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BastionIngressPolicy represents an ingress policy for SSH bastion hosts.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ipBlock": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IPBlock defines an IP block that is allowed to access the bastion.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/api/networking/v1.IPBlock"),
+						},
+					},
+				},
+				Required: []string{"ipBlock"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/networking/v1.IPBlock"},
+	}
+}
+
+func schema_pkg_apis_operations_v1alpha1_BastionList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BastionList is a list of Bastion objects.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Standard list object metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Items is the list of Bastion.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/operations/v1alpha1.Bastion"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/operations/v1alpha1.Bastion", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_pkg_apis_operations_v1alpha1_BastionPort(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BastionPort is a port of the shoot worker nodes which can be reached through the bastion.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"protocol": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Protocol is the protocol spoken on the port. Possible values are \"RDP\" and \"TCP\".\n\nPossible enum values:\n - `\"RDP\"` is the protocol for remote desktop connections, e.g., to Windows nodes.\n - `\"TCP\"` is the protocol for generic TCP tunnels.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+							Enum:        []interface{}{"RDP", "TCP"},
+						},
+					},
+					"port": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Port is the port number on the shoot worker nodes. Defaults to 3389 for the \"RDP\" protocol and must be set for the \"TCP\" protocol.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"protocol"},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_operations_v1alpha1_BastionSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BastionSpec is the specification of a Bastion.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"shootRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ShootRef defines the target shoot for a Bastion. The name field of the ShootRef is immutable.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+						},
+					},
+					"seedName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SeedName is the name of the seed to which this Bastion is currently scheduled. This field is populated at the beginning of a create/reconcile operation.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"providerType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProviderType is cloud provider used by the referenced Shoot.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"sshPublicKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SSHPublicKey is the user's public key. This field is immutable.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ingress": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Ingress controls from where the created bastion host should be reachable.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionIngressPolicy"),
+									},
+								},
+							},
+						},
+					},
+					"ports": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Ports are additional ports of the shoot worker nodes which can be reached through the bastion, e.g., for RDP connections to Windows nodes or for database debugging tunnels. Connections to these ports are tunneled through the SSH connection to the bastion via port forwarding. This field is immutable.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionPort"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"shootRef", "sshPublicKey", "ingress"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionIngressPolicy", "github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionPort", "k8s.io/api/core/v1.LocalObjectReference"},
+	}
+}
+
+func schema_pkg_apis_operations_v1alpha1_BastionStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BastionStatus holds the most recently observed status of the Bastion.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"ingress": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Ingress holds the public IP and/or hostname of the bastion instance.",
+							Ref:         ref("k8s.io/api/core/v1.LoadBalancerIngress"),
+						},
+					},
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "type",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions represents the latest available observations of a Bastion's current state.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Condition"),
+									},
+								},
+							},
+						},
+					},
+					"lastHeartbeatTimestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastHeartbeatTimestamp is the time when the bastion was last marked as not to be deleted. When this is set, the ExpirationTimestamp is advanced as well.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"expirationTimestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExpirationTimestamp is the time after which a Bastion is supposed to be garbage collected.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the most recent generation observed for this Bastion. It corresponds to the Bastion's generation, which is updated on mutation by the API Server.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Condition", "k8s.io/api/core/v1.LoadBalancerIngress", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_pkg_apis_operations_v1alpha1_ShootOperationBatch(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Bastion holds details about an SSH bastion for a shoot cluster.",
+				Description: "ShootOperationBatch applies an operation annotation to a label-selected set of shoots with rate limiting and tracks the result for each shoot.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -10035,16 +13584,16 @@ func schema_pkg_apis_operations_v1alpha1_Bastion(ref common.ReferenceCallback) c
 					},
 					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Specification of the Bastion.",
+							Description: "Specification of the ShootOperationBatch.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionSpec"),
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/operations/v1alpha1.ShootOperationBatchSpec"),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Most recently observed status of the Bastion.",
+							Description: "Most recently observed status of the ShootOperationBatch.",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionStatus"),
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/operations/v1alpha1.ShootOperationBatchStatus"),
 						},
 					},
 				},
@@ -10052,38 +13601,15 @@ func schema_pkg_apis_operations_v1alpha1_Bastion(ref common.ReferenceCallback) c
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionSpec", "github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
-	}
-}
-
-func schema_pkg_apis_operations_v1alpha1_BastionIngressPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "BastionIngressPolicy represents an ingress policy for SSH bastion hosts.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"ipBlock": {
-						SchemaProps: spec.SchemaProps{
-							Description: "IPBlock defines an IP block that is allowed to access the bastion.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/api/networking/v1.IPBlock"),
-						},
-					},
-				},
-				Required: []string{"ipBlock"},
-			},
-		},
-		Dependencies: []string{
-			"k8s.io/api/networking/v1.IPBlock"},
+			"github.com/gardener/gardener/pkg/apis/operations/v1alpha1.ShootOperationBatchSpec", "github.com/gardener/gardener/pkg/apis/operations/v1alpha1.ShootOperationBatchStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_pkg_apis_operations_v1alpha1_BastionList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_operations_v1alpha1_ShootOperationBatchList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "BastionList is a list of Bastion objects.",
+				Description: "ShootOperationBatchList is a list of ShootOperationBatch objects.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -10109,13 +13635,13 @@ func schema_pkg_apis_operations_v1alpha1_BastionList(ref common.ReferenceCallbac
 					},
 					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Items is the list of Bastion.",
+							Description: "Items is the list of ShootOperationBatch.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/gardener/pkg/apis/operations/v1alpha1.Bastion"),
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/operations/v1alpha1.ShootOperationBatch"),
 									},
 								},
 							},
@@ -10126,126 +13652,149 @@ func schema_pkg_apis_operations_v1alpha1_BastionList(ref common.ReferenceCallbac
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/operations/v1alpha1.Bastion", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/gardener/gardener/pkg/apis/operations/v1alpha1.ShootOperationBatch", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_pkg_apis_operations_v1alpha1_BastionSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_operations_v1alpha1_ShootOperationBatchSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "BastionSpec is the specification of a Bastion.",
+				Description: "ShootOperationBatchSpec is the specification of a ShootOperationBatch.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"shootRef": {
+					"operation": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ShootRef defines the target shoot for a Bastion. The name field of the ShootRef is immutable.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+							Description: "Operation is the value of the `gardener.cloud/operation` annotation which is applied to the selected shoots. Supported values are `reconcile`, `retry`, and `rotate-observability-credentials`. This field is immutable.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"seedName": {
+					"shootSelector": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SeedName is the name of the seed to which this Bastion is currently scheduled. This field is populated at the beginning of a create/reconcile operation.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "ShootSelector selects the shoots in all namespaces the operation is applied to. This field is immutable.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
 						},
 					},
-					"providerType": {
+					"shootsPerMinute": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ProviderType is cloud provider used by the referenced Shoot.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "ShootsPerMinute is the maximum number of shoots the operation is applied to per minute. Defaults to `10`.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"sshPublicKey": {
+				},
+				Required: []string{"operation", "shootSelector"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
+func schema_pkg_apis_operations_v1alpha1_ShootOperationBatchStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootOperationBatchStatus holds the most recently observed status of the ShootOperationBatch.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SSHPublicKey is the user's public key. This field is immutable.",
-							Default:     "",
+							Description: "Phase is the current phase of the ShootOperationBatch.\n\nPossible enum values:\n - `\"Failed\"` indicates that the operation could not be applied to at least one selected shoot.\n - `\"Processing\"` indicates that the operation is still being applied to the selected shoots.\n - `\"Succeeded\"` indicates that the operation was applied to all selected shoots.",
 							Type:        []string{"string"},
 							Format:      "",
+							Enum:        []interface{}{"Failed", "Processing", "Succeeded"},
 						},
 					},
-					"ingress": {
+					"shoots": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Ingress controls from where the created bastion host should be reachable.",
+							Description: "Shoots contains the result of the operation for each selected shoot. The list of shoots is determined once when the ShootOperationBatch is processed for the first time.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionIngressPolicy"),
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/operations/v1alpha1.ShootOperationResult"),
 									},
 								},
 							},
 						},
 					},
+					"completionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CompletionTime is the time when the operation was applied to all selected shoots.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"observedGeneration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ObservedGeneration is the most recent generation observed for this ShootOperationBatch.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
 				},
-				Required: []string{"shootRef", "sshPublicKey", "ingress"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionIngressPolicy", "k8s.io/api/core/v1.LocalObjectReference"},
+			"github.com/gardener/gardener/pkg/apis/operations/v1alpha1.ShootOperationResult", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_pkg_apis_operations_v1alpha1_BastionStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_pkg_apis_operations_v1alpha1_ShootOperationResult(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "BastionStatus holds the most recently observed status of the Bastion.",
+				Description: "ShootOperationResult is the result of applying the operation to a single shoot.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"ingress": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Ingress holds the public IP and/or hostname of the bastion instance.",
-							Ref:         ref("k8s.io/api/core/v1.LoadBalancerIngress"),
+							Description: "Name is the name of the shoot.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"conditions": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-patch-merge-key": "type",
-								"x-kubernetes-patch-strategy":  "merge",
-							},
-						},
+					"namespace": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Conditions represents the latest available observations of a Bastion's current state.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.Condition"),
-									},
-								},
-							},
+							Description: "Namespace is the namespace of the shoot.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"lastHeartbeatTimestamp": {
+					"state": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LastHeartbeatTimestamp is the time when the bastion was last marked as not to be deleted. When this is set, the ExpirationTimestamp is advanced as well.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "State is the state of the operation for this shoot.\n\nPossible enum values:\n - `\"Applied\"` indicates that the operation annotation was applied to the shoot.\n - `\"Failed\"` indicates that the operation annotation could not be applied to the shoot.\n - `\"Pending\"` indicates that the operation has not yet been applied to the shoot.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+							Enum:        []interface{}{"Applied", "Failed", "Pending"},
 						},
 					},
-					"expirationTimestamp": {
+					"message": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ExpirationTimestamp is the time after which a Bastion is supposed to be garbage collected.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "Message contains details about the result, e.g. why the operation could not be applied.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"observedGeneration": {
+					"lastUpdateTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ObservedGeneration is the most recent generation observed for this Bastion. It corresponds to the Bastion's generation, which is updated on mutation by the API Server.",
-							Type:        []string{"integer"},
-							Format:      "int64",
+							Description: "LastUpdateTime is the time when the state was last updated.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
+				Required: []string{"name", "namespace", "state"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Condition", "k8s.io/api/core/v1.LoadBalancerIngress", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -10812,9 +14361,10 @@ func schema_pkg_apis_seedmanagement_v1alpha1_GardenletConfig(ref common.Referenc
 					},
 					"bootstrap": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Bootstrap is the mechanism that should be used for bootstrapping gardenlet connection to the Garden cluster. One of ServiceAccount, BootstrapToken, None. If set to ServiceAccount or BootstrapToken, a service account or a bootstrap token will be created in the garden cluster and used to compute the bootstrap kubeconfig. If set to None, the gardenClientConnection.kubeconfig field will be used to connect to the Garden cluster. Defaults to BootstrapToken. This field is immutable.",
+							Description: "Bootstrap is the mechanism that should be used for bootstrapping gardenlet connection to the Garden cluster. One of ServiceAccount, BootstrapToken, None. If set to ServiceAccount or BootstrapToken, a service account or a bootstrap token will be created in the garden cluster and used to compute the bootstrap kubeconfig. If set to None, the gardenClientConnection.kubeconfig field will be used to connect to the Garden cluster. Defaults to BootstrapToken. This field is immutable.\n\nPossible enum values:\n - `\"BootstrapToken\"` means that a bootstrap token should be used for bootstrapping gardenlet connection to the Garden cluster.\n - `\"None\"` means that gardenlet connection to the Garden cluster should not be bootstrapped and the gardenClientConnection.kubeconfig field should be used to connect to the Garden cluster.\n - `\"ServiceAccount\"` means that a temporary service account should be used for bootstrapping gardenlet connection to the Garden cluster.",
 							Type:        []string{"string"},
 							Format:      "",
+							Enum:        []interface{}{"BootstrapToken", "None", "ServiceAccount"},
 						},
 					},
 					"mergeWithParent": {
@@ -11028,6 +14578,53 @@ func schema_pkg_apis_seedmanagement_v1alpha1_GardenletList(ref common.ReferenceC
 	}
 }
 
+func schema_pkg_apis_seedmanagement_v1alpha1_GardenletRegistry(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "GardenletRegistry contains settings for pulling the gardenlet Helm chart and container image in restricted networks.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"mirrors": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "source",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Mirrors is a list of mirrors for OCI registries. References to the gardenlet Helm chart and container image which are hosted in a source registry are rewritten to the respective mirror.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.RegistryMirror"),
+									},
+								},
+							},
+						},
+					},
+					"pullSecretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PullSecretRef is a reference to a secret of type `kubernetes.io/dockerconfigjson` in the namespace of the Gardenlet which contains the credentials for pulling the gardenlet Helm chart.",
+							Ref:         ref("k8s.io/api/core/v1.LocalObjectReference"),
+						},
+					},
+					"proxy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Proxy contains the settings of the proxy used for pulling the gardenlet Helm chart. They are also passed to the gardenlet container via the `HTTP_PROXY`, `HTTPS_PROXY` and `NO_PROXY` environment variables.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.ProxyConfiguration"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.ProxyConfiguration", "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.RegistryMirror", "k8s.io/api/core/v1.LocalObjectReference"},
+	}
+}
+
 func schema_pkg_apis_seedmanagement_v1alpha1_GardenletSelfDeployment(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -11163,12 +14760,18 @@ func schema_pkg_apis_seedmanagement_v1alpha1_GardenletSelfDeployment(ref common.
 							Format:      "",
 						},
 					},
+					"registry": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Registry contains settings for pulling the gardenlet Helm chart and container image in restricted networks.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.GardenletRegistry"),
+						},
+					},
 				},
 				Required: []string{"helm"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.GardenletHelm", "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.Image", "k8s.io/api/core/v1.EnvVar", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.Volume", "k8s.io/api/core/v1.VolumeMount"},
+			"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.GardenletHelm", "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.GardenletRegistry", "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.Image", "k8s.io/api/core/v1.EnvVar", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.Volume", "k8s.io/api/core/v1.VolumeMount"},
 	}
 }
 
@@ -11278,6 +14881,13 @@ func schema_pkg_apis_seedmanagement_v1alpha1_Image(ref common.ReferenceCallback)
 							Enum:        []interface{}{"Always", "IfNotPresent", "Never"},
 						},
 					},
+					"digest": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Digest is the image digest, takes precedence over tag. The value should be in the format 'sha256:<HASH>'.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -11385,6 +14995,27 @@ func schema_pkg_apis_seedmanagement_v1alpha1_ManagedSeedList(ref common.Referenc
 	}
 }
 
+func schema_pkg_apis_seedmanagement_v1alpha1_ManagedSeedMaintenance(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ManagedSeedMaintenance contains settings for coordinating the maintenance of the ManagedSeed's shoot with the shoots whose control planes are hosted on the seed.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxUnavailableControlPlanes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxUnavailableControlPlanes is the maximum number or percentage of hosted shoot control planes which may be unavailable when the maintenance of the ManagedSeed's shoot is started. Maintenance operations roll the nodes of the seed and thereby drain control plane pods, hence they are postponed while more control planes are unavailable. Postponed maintenance operations are retried within the maintenance time window of the ManagedSeed's shoot. If not set, the maintenance is not coordinated with the hosted shoots.",
+							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
+	}
+}
+
 func schema_pkg_apis_seedmanagement_v1alpha1_ManagedSeedSet(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -11669,12 +15300,18 @@ func schema_pkg_apis_seedmanagement_v1alpha1_ManagedSeedSpec(ref common.Referenc
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.GardenletConfig"),
 						},
 					},
+					"maintenance": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Maintenance contains settings for coordinating the maintenance of the ManagedSeed's shoot with the shoots whose control planes are hosted on the seed.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.ManagedSeedMaintenance"),
+						},
+					},
 				},
 				Required: []string{"gardenlet"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.GardenletConfig", "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.Shoot"},
+			"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.GardenletConfig", "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.ManagedSeedMaintenance", "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.Shoot"},
 	}
 }
 
@@ -11766,10 +15403,11 @@ func schema_pkg_apis_seedmanagement_v1alpha1_PendingReplica(ref common.Reference
 					},
 					"reason": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Reason is the reason for the replica to be pending.",
+							Description: "Reason is the reason for the replica to be pending.\n\nPossible enum values:\n - `\"ManagedSeedDeleting\"` indicates that the replica's managed seed is deleting.\n - `\"ManagedSeedPreparing\"` indicates that the replica's managed seed is preparing.\n - `\"SeedNotReady\"` indicates that the replica's seed is not ready.\n - `\"ShootDeleteFailed\"` indicates that the deletion of tis replica's shoot has failed.\n - `\"ShootDeleting\"` indicates that the replica's shoot is deleting.\n - `\"ShootNotHealthy\"` indicates that the replica's shoot is not healthy.\n - `\"ShootReconcileFailed\"` indicates that the reconciliation of this replica's shoot has failed.\n - `\"ShootReconciling\"` indicates that the replica's shoot is reconciling.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
+							Enum:        []interface{}{"ManagedSeedDeleting", "ManagedSeedPreparing", "SeedNotReady", "ShootDeleteFailed", "ShootDeleting", "ShootNotHealthy", "ShootReconcileFailed", "ShootReconciling"},
 						},
 					},
 					"since": {
@@ -11794,6 +15432,70 @@ func schema_pkg_apis_seedmanagement_v1alpha1_PendingReplica(ref common.Reference
 	}
 }
 
+func schema_pkg_apis_seedmanagement_v1alpha1_ProxyConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ProxyConfiguration contains proxy settings.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"httpProxy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HTTPProxy is the proxy used for HTTP requests.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"httpsProxy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HTTPSProxy is the proxy used for HTTPS requests.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"noProxy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NoProxy is a comma-separated list of hosts for which no proxy is used.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_pkg_apis_seedmanagement_v1alpha1_RegistryMirror(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RegistryMirror specifies a mirror for an OCI registry.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"source": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Source is the host (and optional port) of the mirrored registry, e.g. `europe-docker.pkg.dev`.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"mirror": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Mirror is the host (and optional port) of the mirror with an optional path prefix, e.g. `registry.example.com/gardener`.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"source", "mirror"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_seedmanagement_v1alpha1_RollingUpdateStrategy(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -11845,9 +15547,10 @@ func schema_pkg_apis_seedmanagement_v1alpha1_UpdateStrategy(ref common.Reference
 				Properties: map[string]spec.Schema{
 					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type indicates the type of the UpdateStrategy. Defaults to RollingUpdate.",
+							Description: "Type indicates the type of the UpdateStrategy. Defaults to RollingUpdate.\n\nPossible enum values:\n - `\"RollingUpdate\"` indicates that update will be applied to all ManagedSeeds / Shoots in the ManagedSeedSet with respect to the ManagedSeedSet ordering constraints.",
 							Type:        []string{"string"},
 							Format:      "",
+							Enum:        []interface{}{"RollingUpdate"},
 						},
 					},
 					"rollingUpdate": {
@@ -0,0 +1,84 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package openapi_test
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/kube-openapi/pkg/common"
+	"k8s.io/kube-openapi/pkg/validation/spec"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/gardener/gardener/pkg/apiserver/openapi"
+)
+
+var _ = Describe("OpenAPI definitions", func() {
+	var definitions map[string]common.OpenAPIDefinition
+
+	BeforeEach(func() {
+		definitions = GetOpenAPIDefinitions(func(path string) spec.Ref { return spec.MustCreateRef("#/definitions/" + path) })
+	})
+
+	DescribeTable("should publish the same defaults as the defaulting logic",
+		func(name string, getDefaultedObject func() any) {
+			definition, ok := definitions["github.com/gardener/gardener/pkg/apis/core/v1beta1."+name]
+			Expect(ok).To(BeTrue())
+
+			raw, err := json.Marshal(getDefaultedObject())
+			Expect(err).NotTo(HaveOccurred())
+			defaulted := map[string]any{}
+			Expect(json.Unmarshal(raw, &defaulted)).To(Succeed())
+
+			var checked int
+			for property, schema := range definition.Schema.Properties {
+				// Zero values of required fields and structs are published as defaults as well, skip them.
+				if schema.Default == nil || schema.Default == "" {
+					continue
+				}
+				if _, isStruct := schema.Default.(map[string]any); isStruct {
+					continue
+				}
+
+				Expect(defaulted).To(HaveKeyWithValue(property, BeEquivalentTo(schema.Default)), "default of property %q", property)
+				checked++
+			}
+			Expect(checked).To(BeNumerically(">", 0))
+		},
+
+		Entry("ClusterAutoscaler", "ClusterAutoscaler", func() any {
+			obj := &gardencorev1beta1.ClusterAutoscaler{}
+			gardencorev1beta1.SetDefaults_ClusterAutoscaler(obj)
+			return obj
+		}),
+		Entry("MachineType", "MachineType", func() any {
+			obj := &gardencorev1beta1.MachineType{}
+			gardencorev1beta1.SetDefaults_MachineType(obj)
+			return obj
+		}),
+		Entry("VolumeType", "VolumeType", func() any {
+			obj := &gardencorev1beta1.VolumeType{}
+			gardencorev1beta1.SetDefaults_VolumeType(obj)
+			return obj
+		}),
+		Entry("ShootSpec", "ShootSpec", func() any {
+			obj := &gardencorev1beta1.Shoot{}
+			gardencorev1beta1.SetDefaults_Shoot(obj)
+			return obj.Spec
+		}),
+		Entry("Machine", "Machine", func() any {
+			obj := &gardencorev1beta1.Shoot{Spec: gardencorev1beta1.ShootSpec{Provider: gardencorev1beta1.Provider{Workers: []gardencorev1beta1.Worker{{Name: "worker"}}}}}
+			gardencorev1beta1.SetDefaults_Shoot(obj)
+			return obj.Spec.Provider.Workers[0].Machine
+		}),
+	)
+
+	It("should publish the values of enum types", func() {
+		Expect(definitions["github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootSpec"].Schema.Properties["purpose"].Enum).To(ConsistOf(
+			"evaluation", "testing", "development", "production", "infrastructure",
+		))
+	})
+})
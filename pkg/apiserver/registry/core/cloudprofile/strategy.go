@@ -15,16 +15,22 @@ import (
 
 	"github.com/gardener/gardener/pkg/api"
 	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/gardener/gardener/pkg/apis/core/validation"
+	"github.com/gardener/gardener/pkg/apiserver/cel"
 )
 
 type cloudProfileStrategy struct {
 	runtime.ObjectTyper
 	names.NameGenerator
+
+	// celValidator evaluates the CEL validation rules declared on the CloudProfile API types. The rules are compiled
+	// when the first CloudProfile is validated.
+	celValidator *cel.Validator
 }
 
 // Strategy defines the storage strategy for CloudProfiles.
-var Strategy = cloudProfileStrategy{api.Scheme, names.SimpleNameGenerator}
+var Strategy = cloudProfileStrategy{api.Scheme, names.SimpleNameGenerator, cel.NewValidator(gardencorev1beta1.SchemeGroupVersion.WithKind("CloudProfile"))}
 
 func (cloudProfileStrategy) NamespaceScoped() bool {
 	return false
@@ -34,11 +40,13 @@ func (cloudProfileStrategy) PrepareForCreate(_ context.Context, obj runtime.Obje
 	cloudProfile := obj.(*core.CloudProfile)
 
 	dropExpiredVersions(cloudProfile)
+	cloudProfile.Status = core.CloudProfileStatus{}
 }
 
-func (cloudProfileStrategy) Validate(_ context.Context, obj runtime.Object) field.ErrorList {
+func (s cloudProfileStrategy) Validate(ctx context.Context, obj runtime.Object) field.ErrorList {
 	cloudProfile := obj.(*core.CloudProfile)
-	return validation.ValidateCloudProfile(cloudProfile)
+
+	return cel.AppendErrors(validation.ValidateCloudProfile(cloudProfile), s.celValidator.Validate(ctx, cloudProfile, nil))
 }
 
 func (cloudProfileStrategy) Canonicalize(obj runtime.Object) {
@@ -55,6 +63,8 @@ func (cloudProfileStrategy) PrepareForUpdate(_ context.Context, newObj, oldObj r
 	oldCloudProfile := oldObj.(*core.CloudProfile)
 	newCloudProfile := newObj.(*core.CloudProfile)
 
+	newCloudProfile.Status = oldCloudProfile.Status // can only be changed by status subresource
+
 	syncLegacyAccessRestrictionLabelWithNewFieldOnUpdate(newCloudProfile, oldCloudProfile)
 }
 
@@ -62,9 +72,10 @@ func (cloudProfileStrategy) AllowUnconditionalUpdate() bool {
 	return true
 }
 
-func (cloudProfileStrategy) ValidateUpdate(_ context.Context, newObj, oldObj runtime.Object) field.ErrorList {
+func (s cloudProfileStrategy) ValidateUpdate(ctx context.Context, newObj, oldObj runtime.Object) field.ErrorList {
 	oldProfile, newProfile := oldObj.(*core.CloudProfile), newObj.(*core.CloudProfile)
-	return validation.ValidateCloudProfileUpdate(newProfile, oldProfile)
+
+	return cel.AppendErrors(validation.ValidateCloudProfileUpdate(newProfile, oldProfile), s.celValidator.Validate(ctx, newProfile, oldProfile))
 }
 
 // WarningsOnCreate returns warnings to the client performing a create.
@@ -77,6 +88,23 @@ func (cloudProfileStrategy) WarningsOnUpdate(_ context.Context, _, _ runtime.Obj
 	return nil
 }
 
+type cloudProfileStatusStrategy struct {
+	cloudProfileStrategy
+}
+
+// StatusStrategy defines the storage strategy for the status subresource of CloudProfiles.
+var StatusStrategy = cloudProfileStatusStrategy{Strategy}
+
+func (cloudProfileStatusStrategy) PrepareForUpdate(_ context.Context, obj, old runtime.Object) {
+	newCloudProfile := obj.(*core.CloudProfile)
+	oldCloudProfile := old.(*core.CloudProfile)
+	newCloudProfile.Spec = oldCloudProfile.Spec
+}
+
+func (cloudProfileStatusStrategy) ValidateUpdate(_ context.Context, obj, _ runtime.Object) field.ErrorList {
+	return validation.ValidateCloudProfileStatus(&obj.(*core.CloudProfile).Status, field.NewPath("status"))
+}
+
 func dropExpiredVersions(cloudProfile *core.CloudProfile) {
 	var validKubernetesVersions []core.ExpirableVersion
 
@@ -181,6 +181,30 @@ var _ = Describe("Strategy", func() {
 			Expect(newCloudProfile.Spec.Regions[0].AccessRestrictions).To(BeEmpty())
 			Expect(newCloudProfile.Spec.Regions[0].Labels).To(Equal(map[string]string{"seed.gardener.cloud/eu-access": "true"}))
 		})
+
+		It("should not allow changing the status", func() {
+			oldCloudProfile.Status.Usage = &core.CloudProfileUsage{KubernetesVersions: []core.VersionUsage{{Version: "1.31.1", Shoots: 1}}}
+			newCloudProfile.Status.Usage = &core.CloudProfileUsage{}
+
+			cloudprofileregistry.Strategy.PrepareForUpdate(context.Background(), newCloudProfile, oldCloudProfile)
+
+			Expect(newCloudProfile.Status).To(Equal(oldCloudProfile.Status))
+		})
+	})
+
+	Describe("#StatusStrategy.PrepareForUpdate", func() {
+		It("should not allow changing the spec", func() {
+			oldCloudProfile := &core.CloudProfile{Spec: core.CloudProfileSpec{Type: "local"}}
+			newCloudProfile := &core.CloudProfile{
+				Spec:   core.CloudProfileSpec{Type: "other"},
+				Status: core.CloudProfileStatus{Usage: &core.CloudProfileUsage{KubernetesVersions: []core.VersionUsage{{Version: "1.31.1", Shoots: 1}}}},
+			}
+
+			cloudprofileregistry.StatusStrategy.PrepareForUpdate(context.Background(), newCloudProfile, oldCloudProfile)
+
+			Expect(newCloudProfile.Spec).To(Equal(oldCloudProfile.Spec))
+			Expect(newCloudProfile.Status.Usage).NotTo(BeNil())
+		})
 	})
 
 	Describe("#Canonicalize", func() {
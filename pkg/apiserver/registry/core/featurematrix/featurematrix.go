@@ -0,0 +1,129 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package featurematrix
+
+import (
+	"slices"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/component-base/featuregate"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/features"
+)
+
+// Name is the name of the only FeatureMatrix served by gardener-apiserver.
+const Name = "gardener"
+
+var shootAPIVersion = gardencorev1beta1.SchemeGroupVersion.String()
+
+// DeprecatedFields is the list of deprecated API fields of this Gardener version. It must be maintained together with
+// the `Deprecated:` notes in the API types.
+var DeprecatedFields = []core.DeprecatedField{
+	{
+		APIVersion:  shootAPIVersion,
+		Kind:        "Shoot",
+		Path:        "spec.dns.providers",
+		Description: "Configuring multiple DNS providers is deprecated and will be forbidden in a future release. Please use the DNS extension provider config (e.g. shoot-dns-service) for additional providers.",
+	},
+	{
+		APIVersion:  shootAPIVersion,
+		Kind:        "Shoot",
+		Path:        "spec.dns.providers[].domains",
+		Description: "This field is deprecated and will be removed in a future release. Please use the DNS extension provider config (e.g. shoot-dns-service) for additional configuration.",
+	},
+	{
+		APIVersion:  shootAPIVersion,
+		Kind:        "Shoot",
+		Path:        "spec.dns.providers[].primary",
+		Description: "This field is deprecated and will be removed in a future release. Please use the DNS extension provider config (e.g. shoot-dns-service) for additional and non-primary providers.",
+	},
+	{
+		APIVersion:  shootAPIVersion,
+		Kind:        "Shoot",
+		Path:        "spec.dns.providers[].zones",
+		Description: "This field is deprecated and will be removed in a future release. Please use the DNS extension provider config (e.g. shoot-dns-service) for additional configuration.",
+	},
+	{
+		APIVersion:                     shootAPIVersion,
+		Kind:                           "Shoot",
+		Path:                           "spec.kubernetes.clusterAutoscaler.ignoreTaints",
+		Description:                    "Ignore taints are deprecated as of Kubernetes 1.29 and treated as startup taints. Please use spec.kubernetes.clusterAutoscaler.startupTaints instead.",
+		ForbiddenFromKubernetesVersion: ptr.To("1.32"),
+	},
+	{
+		APIVersion:                     shootAPIVersion,
+		Kind:                           "Shoot",
+		Path:                           "spec.kubernetes.kubeAPIServer.oidcConfig",
+		Description:                    "Please configure and use structured authentication instead of OIDC flags.",
+		ForbiddenFromKubernetesVersion: ptr.To("1.32"),
+	},
+	{
+		APIVersion:                     shootAPIVersion,
+		Kind:                           "Shoot",
+		Path:                           "spec.kubernetes.kubeAPIServer.oidcConfig.clientAuthentication",
+		Description:                    "This field has no implemented use.",
+		ForbiddenFromKubernetesVersion: ptr.To("1.31"),
+	},
+	{
+		APIVersion:  shootAPIVersion,
+		Kind:        "Shoot",
+		Path:        "spec.kubernetes.kubeControllerManager.podEvictionTimeout",
+		Description: "The field does not have effect since Kubernetes 1.13. Please use the spec.kubernetes.kubeAPIServer.defaultNotReadyTolerationSeconds and spec.kubernetes.kubeAPIServer.defaultUnreachableTolerationSeconds fields instead.",
+	},
+	{
+		APIVersion:                     shootAPIVersion,
+		Kind:                           "Shoot",
+		Path:                           "spec.kubernetes.kubelet.systemReserved",
+		Description:                    "Separately configuring resource reservations for system processes is deprecated. Please merge existing resource reservations into the kubeReserved field.",
+		ForbiddenFromKubernetesVersion: ptr.To("1.31"),
+	},
+	{
+		APIVersion:                     shootAPIVersion,
+		Kind:                           "Shoot",
+		Path:                           "spec.provider.workers[].kubernetes.kubelet.systemReserved",
+		Description:                    "Separately configuring resource reservations for system processes is deprecated. Please merge existing resource reservations into the kubeReserved field.",
+		ForbiddenFromKubernetesVersion: ptr.To("1.31"),
+	},
+}
+
+// New computes the FeatureMatrix for the given Gardener version. The enablement state is only reported for the feature
+// gates known to the given feature gate of gardener-apiserver.
+func New(featureGate featuregate.MutableFeatureGate, gardenerVersion string) *core.FeatureMatrix {
+	var (
+		knownFeatures = featureGate.GetAll()
+		featureGates  = make([]core.FeatureGate, 0, len(features.AllFeatureGates))
+	)
+
+	for name, spec := range features.AllFeatureGates {
+		featureGateInfo := core.FeatureGate{
+			Name:          string(name),
+			Default:       spec.Default,
+			PreRelease:    string(spec.PreRelease),
+			LockToDefault: spec.LockToDefault,
+		}
+		if _, ok := knownFeatures[name]; ok {
+			featureGateInfo.Enabled = ptr.To(featureGate.Enabled(name))
+		}
+		featureGates = append(featureGates, featureGateInfo)
+	}
+
+	slices.SortFunc(featureGates, func(a, b core.FeatureGate) int { return strings.Compare(a.Name, b.Name) })
+
+	deprecatedFields := make([]core.DeprecatedField, 0, len(DeprecatedFields))
+	for _, deprecatedField := range DeprecatedFields {
+		deprecatedFields = append(deprecatedFields, *deprecatedField.DeepCopy())
+	}
+
+	return &core.FeatureMatrix{
+		ObjectMeta:       metav1.ObjectMeta{Name: Name},
+		GardenerVersion:  gardenerVersion,
+		FeatureGates:     featureGates,
+		DeprecatedFields: deprecatedFields,
+	}
+}
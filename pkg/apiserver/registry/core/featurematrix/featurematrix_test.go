@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package featurematrix_test
+
+import (
+	"slices"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/component-base/featuregate"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	. "github.com/gardener/gardener/pkg/apiserver/registry/core/featurematrix"
+	"github.com/gardener/gardener/pkg/features"
+)
+
+var _ = Describe("FeatureMatrix", func() {
+	Describe("#New", func() {
+		var featureGate featuregate.MutableFeatureGate
+
+		BeforeEach(func() {
+			featureGate = featuregate.NewFeatureGate()
+			Expect(featureGate.Add(features.GetFeatures(features.ShootForceDeletion, features.UseNamespacedCloudProfile))).To(Succeed())
+			Expect(featureGate.SetFromMap(map[string]bool{string(features.UseNamespacedCloudProfile): true})).To(Succeed())
+		})
+
+		It("should compute the feature matrix", func() {
+			featureMatrix := New(featureGate, "v1.100.0")
+
+			Expect(featureMatrix.Name).To(Equal("gardener"))
+			Expect(featureMatrix.GardenerVersion).To(Equal("v1.100.0"))
+			Expect(featureMatrix.DeprecatedFields).To(Equal(DeprecatedFields))
+			Expect(featureMatrix.FeatureGates).To(HaveLen(len(features.AllFeatureGates)))
+			Expect(slices.IsSortedFunc(featureMatrix.FeatureGates, func(a, b core.FeatureGate) int { return strings.Compare(a.Name, b.Name) })).To(BeTrue())
+		})
+
+		It("should report the enablement state only for the feature gates of gardener-apiserver", func() {
+			featureMatrix := New(featureGate, "v1.100.0")
+
+			Expect(featureMatrix.FeatureGates).To(ContainElements(
+				core.FeatureGate{Name: "ShootForceDeletion", Default: true, PreRelease: "BETA", Enabled: ptr.To(true)},
+				core.FeatureGate{Name: "UseNamespacedCloudProfile", Default: false, PreRelease: "ALPHA", Enabled: ptr.To(true)},
+				MatchAllFields(Fields{
+					"Name":          Equal("NewVPN"),
+					"Default":       BeFalse(),
+					"PreRelease":    Equal("ALPHA"),
+					"LockToDefault": BeFalse(),
+					"Enabled":       BeNil(),
+				}),
+			))
+		})
+
+		It("should not share the deprecated fields with the returned object", func() {
+			featureMatrix := New(featureGate, "v1.100.0")
+			featureMatrix.DeprecatedFields[0].Description = "changed"
+
+			Expect(DeprecatedFields[0].Description).NotTo(Equal("changed"))
+		})
+	})
+})
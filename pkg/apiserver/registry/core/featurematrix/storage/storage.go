@@ -0,0 +1,95 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"context"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metainternalversion "k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/registry/rest"
+	"k8s.io/component-base/featuregate"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	"github.com/gardener/gardener/pkg/apiserver/registry/core/featurematrix"
+)
+
+// REST implements a read-only RESTStorage for FeatureMatrix. The only FeatureMatrix is computed on request and not
+// persisted.
+type REST struct {
+	rest.TableConvertor
+
+	featureGate     featuregate.MutableFeatureGate
+	gardenerVersion string
+}
+
+var (
+	_ rest.Getter               = &REST{}
+	_ rest.Lister               = &REST{}
+	_ rest.Scoper               = &REST{}
+	_ rest.SingularNameProvider = &REST{}
+)
+
+// NewREST returns a RESTStorage object that serves the FeatureMatrix of the given Gardener version.
+func NewREST(featureGate featuregate.MutableFeatureGate, gardenerVersion string) *REST {
+	return &REST{
+		TableConvertor:  newTableConvertor(),
+		featureGate:     featureGate,
+		gardenerVersion: gardenerVersion,
+	}
+}
+
+// New returns an empty FeatureMatrix.
+func (r *REST) New() runtime.Object {
+	return &core.FeatureMatrix{}
+}
+
+// NewList returns an empty FeatureMatrixList.
+func (r *REST) NewList() runtime.Object {
+	return &core.FeatureMatrixList{}
+}
+
+// Destroy cleans up its resources on shutdown.
+func (r *REST) Destroy() {}
+
+// NamespaceScoped returns false because FeatureMatrix is cluster-scoped.
+func (r *REST) NamespaceScoped() bool {
+	return false
+}
+
+// GetSingularName returns the singular name of the resource.
+func (r *REST) GetSingularName() string {
+	return "featurematrix"
+}
+
+// Get returns the FeatureMatrix with the given name.
+func (r *REST) Get(_ context.Context, name string, _ *metav1.GetOptions) (runtime.Object, error) {
+	if name != featurematrix.Name {
+		return nil, apierrors.NewNotFound(core.Resource("featurematrices"), name)
+	}
+	return featurematrix.New(r.featureGate, r.gardenerVersion), nil
+}
+
+// List returns a list containing the only FeatureMatrix if it matches the given selectors.
+func (r *REST) List(_ context.Context, options *metainternalversion.ListOptions) (runtime.Object, error) {
+	featureMatrix := featurematrix.New(r.featureGate, r.gardenerVersion)
+	list := &core.FeatureMatrixList{}
+
+	if options != nil {
+		if options.LabelSelector != nil && !options.LabelSelector.Matches(labels.Set(featureMatrix.Labels)) {
+			return list, nil
+		}
+		if options.FieldSelector != nil && !options.FieldSelector.Matches(fields.Set{"metadata.name": featureMatrix.Name}) {
+			return list, nil
+		}
+	}
+
+	list.Items = []core.FeatureMatrix{*featureMatrix}
+	return list, nil
+}
@@ -0,0 +1,76 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storage_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metainternalversion "k8s.io/apimachinery/pkg/apis/meta/internalversion"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/component-base/featuregate"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	. "github.com/gardener/gardener/pkg/apiserver/registry/core/featurematrix/storage"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("REST", func() {
+	var (
+		ctx  = context.TODO()
+		rest *REST
+	)
+
+	BeforeEach(func() {
+		rest = NewREST(featuregate.NewFeatureGate(), "v1.100.0")
+	})
+
+	Describe("#Get", func() {
+		It("should return the feature matrix", func() {
+			obj, err := rest.Get(ctx, "gardener", &metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+
+			featureMatrix, ok := obj.(*core.FeatureMatrix)
+			Expect(ok).To(BeTrue())
+			Expect(featureMatrix.Name).To(Equal("gardener"))
+			Expect(featureMatrix.GardenerVersion).To(Equal("v1.100.0"))
+			Expect(featureMatrix.FeatureGates).NotTo(BeEmpty())
+			Expect(featureMatrix.DeprecatedFields).NotTo(BeEmpty())
+		})
+
+		It("should return a not found error for other names", func() {
+			_, err := rest.Get(ctx, "foo", &metav1.GetOptions{})
+			Expect(err).To(BeNotFoundError())
+		})
+	})
+
+	Describe("#List", func() {
+		It("should return a list with the feature matrix", func() {
+			obj, err := rest.List(ctx, &metainternalversion.ListOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(obj.(*core.FeatureMatrixList).Items).To(ConsistOf(HaveField("Name", "gardener")))
+		})
+
+		It("should return an empty list if the field selector does not match", func() {
+			obj, err := rest.List(ctx, &metainternalversion.ListOptions{FieldSelector: fields.OneTermEqualSelector("metadata.name", "foo")})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(obj.(*core.FeatureMatrixList).Items).To(BeEmpty())
+		})
+	})
+
+	Describe("#ConvertToTable", func() {
+		It("should convert the feature matrix to a table", func() {
+			obj, err := rest.Get(ctx, "gardener", &metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+
+			table, err := rest.ConvertToTable(ctx, obj, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(table.Rows).To(HaveLen(1))
+			Expect(table.Rows[0].Cells[:2]).To(Equal([]any{"gardener", "v1.100.0"}))
+		})
+	})
+})
@@ -12,6 +12,7 @@ import (
 	"k8s.io/apiserver/pkg/registry/rest"
 	genericapiserver "k8s.io/apiserver/pkg/server"
 	kubeinformers "k8s.io/client-go/informers"
+	"k8s.io/component-base/version"
 
 	"github.com/gardener/gardener/pkg/api"
 	"github.com/gardener/gardener/pkg/apis/core"
@@ -24,6 +25,7 @@ import (
 	controllerinstallationstore "github.com/gardener/gardener/pkg/apiserver/registry/core/controllerinstallation/storage"
 	controllerregistrationstore "github.com/gardener/gardener/pkg/apiserver/registry/core/controllerregistration/storage"
 	exposureclassstore "github.com/gardener/gardener/pkg/apiserver/registry/core/exposureclass/storage"
+	featurematrixstore "github.com/gardener/gardener/pkg/apiserver/registry/core/featurematrix/storage"
 	internalsecretstore "github.com/gardener/gardener/pkg/apiserver/registry/core/internalsecret/storage"
 	namespacedcloudprofilestore "github.com/gardener/gardener/pkg/apiserver/registry/core/namespacedcloudprofile/storage"
 	projectstore "github.com/gardener/gardener/pkg/apiserver/registry/core/project/storage"
@@ -31,17 +33,21 @@ import (
 	secretbindingstore "github.com/gardener/gardener/pkg/apiserver/registry/core/secretbinding/storage"
 	seedstore "github.com/gardener/gardener/pkg/apiserver/registry/core/seed/storage"
 	shootstore "github.com/gardener/gardener/pkg/apiserver/registry/core/shoot/storage"
+	shootclassstore "github.com/gardener/gardener/pkg/apiserver/registry/core/shootclass/storage"
 	shootstatestore "github.com/gardener/gardener/pkg/apiserver/registry/core/shootstate/storage"
 	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/externalversions"
+	"github.com/gardener/gardener/pkg/features"
 )
 
 // StorageProvider contains configurations related to the core resources.
 type StorageProvider struct {
-	AdminKubeconfigMaxExpiration  time.Duration
-	ViewerKubeconfigMaxExpiration time.Duration
-	CredentialsRotationInterval   time.Duration
-	KubeInformerFactory           kubeinformers.SharedInformerFactory
-	CoreInformerFactory           gardencoreinformers.SharedInformerFactory
+	AdminKubeconfigMaxExpiration         time.Duration
+	ViewerKubeconfigMaxExpiration        time.Duration
+	TokenExchangeKubeconfigMaxExpiration time.Duration
+	CredentialsRotationInterval          time.Duration
+	TrustedIdentityTokenVerifier         shootstore.TokenVerifier
+	KubeInformerFactory                  kubeinformers.SharedInformerFactory
+	CoreInformerFactory                  gardencoreinformers.SharedInformerFactory
 }
 
 // NewRESTStorage creates a new API group info object and registers the v1beta1 core storage.
@@ -79,6 +85,7 @@ func (p StorageProvider) v1beta1Storage(restOptionsGetter generic.RESTOptionsGet
 
 	cloudprofileStorage := cloudprofilestore.NewStorage(restOptionsGetter)
 	storage["cloudprofiles"] = cloudprofileStorage.CloudProfile
+	storage["cloudprofiles/status"] = cloudprofileStorage.Status
 
 	namespacedcloudprofileStorage := namespacedcloudprofilestore.NewStorage(restOptionsGetter)
 	storage["namespacedcloudprofiles"] = namespacedcloudprofileStorage.NamespacedCloudProfile
@@ -97,6 +104,8 @@ func (p StorageProvider) v1beta1Storage(restOptionsGetter generic.RESTOptionsGet
 	exposureClassStorage := exposureclassstore.NewStorage(restOptionsGetter)
 	storage["exposureclasses"] = exposureClassStorage.ExposureClass
 
+	storage["featurematrices"] = featurematrixstore.NewREST(features.DefaultFeatureGate, version.Get().GitVersion)
+
 	storage["internalsecrets"] = internalsecretstore.NewREST(restOptionsGetter)
 
 	projectStorage := projectstore.NewStorage(restOptionsGetter)
@@ -113,6 +122,9 @@ func (p StorageProvider) v1beta1Storage(restOptionsGetter generic.RESTOptionsGet
 	storage["seeds"] = seedStorage.Seed
 	storage["seeds/status"] = seedStorage.Status
 
+	shootClassStorage := shootclassstore.NewStorage(restOptionsGetter)
+	storage["shootclasses"] = shootClassStorage.ShootClass
+
 	shootStateStorage := shootstatestore.NewStorage(restOptionsGetter)
 	storage["shootstates"] = shootStateStorage.ShootState
 
@@ -121,15 +133,21 @@ func (p StorageProvider) v1beta1Storage(restOptionsGetter generic.RESTOptionsGet
 		p.CoreInformerFactory.Core().V1beta1().InternalSecrets().Lister(),
 		p.KubeInformerFactory.Core().V1().Secrets().Lister(),
 		p.KubeInformerFactory.Core().V1().ConfigMaps().Lister(),
+		p.CoreInformerFactory.Core().V1beta1().Projects().Lister(),
+		p.TrustedIdentityTokenVerifier,
 		p.AdminKubeconfigMaxExpiration,
 		p.ViewerKubeconfigMaxExpiration,
+		p.TokenExchangeKubeconfigMaxExpiration,
 		p.CredentialsRotationInterval,
 	)
 	storage["shoots"] = shootStorage.Shoot
 	storage["shoots/status"] = shootStorage.Status
 	storage["shoots/binding"] = shootStorage.Binding
+	storage["shoots/force-delete"] = shootStorage.ForceDelete
 	storage["shoots/adminkubeconfig"] = shootStorage.AdminKubeconfig
 	storage["shoots/viewerkubeconfig"] = shootStorage.ViewerKubeconfig
+	storage["shoots/tokenexchange"] = shootStorage.TokenExchange
+	storage["shoots/kubeconfigbundle"] = shootStorage.KubeconfigBundle
 
 	return storage
 }
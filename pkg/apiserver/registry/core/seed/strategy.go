@@ -9,25 +9,34 @@ import (
 	"slices"
 
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/apiserver/pkg/storage/names"
 
 	"github.com/gardener/gardener/pkg/api"
 	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/gardener/gardener/pkg/apis/core/validation"
+	"github.com/gardener/gardener/pkg/apiserver/cel"
 )
 
+// celValidator evaluates the CEL validation rules declared on the Seed API types. The rules are compiled when the first
+// Seed is validated.
+var celValidator = cel.NewValidator(gardencorev1beta1.SchemeGroupVersion.WithKind("Seed"))
+
 // Strategy defines the strategy for storing seeds.
 type Strategy struct {
 	runtime.ObjectTyper
 	names.NameGenerator
+
+	celValidator *cel.Validator
 }
 
 // NewStrategy defines the storage strategy for Seeds.
 func NewStrategy() Strategy {
-	return Strategy{api.Scheme, names.SimpleNameGenerator}
+	return Strategy{api.Scheme, names.SimpleNameGenerator, celValidator}
 }
 
 // NamespaceScoped returns true if the object must be within a namespace.
@@ -57,6 +66,21 @@ func (s Strategy) PrepareForUpdate(_ context.Context, obj, old runtime.Object) {
 	}
 
 	syncLegacyAccessRestrictionLabelWithNewFieldOnUpdate(newSeed, oldSeed)
+	startIngressDomainMigration(newSeed, oldSeed)
+}
+
+// startIngressDomainMigration records the start of a migration of the hosted shoots in the status of the seed if its
+// ingress domain was changed. The migration itself is carried out by the gardener-controller-manager.
+func startIngressDomainMigration(newSeed, oldSeed *core.Seed) {
+	if oldSeed.Spec.Ingress == nil || newSeed.Spec.Ingress == nil || oldSeed.Spec.Ingress.Domain == newSeed.Spec.Ingress.Domain {
+		return
+	}
+
+	newSeed.Status.IngressDomainMigration = &core.SeedIngressDomainMigration{
+		PreviousDomain: oldSeed.Spec.Ingress.Domain,
+		Domain:         newSeed.Spec.Ingress.Domain,
+		StartTime:      metav1.Now(),
+	}
 }
 
 func mustIncreaseGeneration(oldSeed, newSeed *core.Seed) bool {
@@ -82,6 +106,9 @@ func mustIncreaseGeneration(oldSeed, newSeed *core.Seed) bool {
 			return true
 		case v1beta1constants.GardenerOperationRenewKubeconfig:
 			return true
+		case v1beta1constants.OperationRotateCAStart,
+			v1beta1constants.OperationRotateCAComplete:
+			return true
 		}
 	}
 
@@ -89,9 +116,10 @@ func mustIncreaseGeneration(oldSeed, newSeed *core.Seed) bool {
 }
 
 // Validate validates the given object.
-func (Strategy) Validate(_ context.Context, obj runtime.Object) field.ErrorList {
+func (s Strategy) Validate(ctx context.Context, obj runtime.Object) field.ErrorList {
 	seed := obj.(*core.Seed)
-	return validation.ValidateSeed(seed)
+
+	return cel.AppendErrors(validation.ValidateSeed(seed), s.celValidator.Validate(ctx, seed, nil))
 }
 
 // Canonicalize allows an object to be mutated into a canonical form. This
@@ -118,9 +146,10 @@ func (Strategy) AllowUnconditionalUpdate() bool {
 }
 
 // ValidateUpdate validates the update on the given old and new object.
-func (Strategy) ValidateUpdate(_ context.Context, newObj, oldObj runtime.Object) field.ErrorList {
+func (s Strategy) ValidateUpdate(ctx context.Context, newObj, oldObj runtime.Object) field.ErrorList {
 	oldSeed, newSeed := oldObj.(*core.Seed), newObj.(*core.Seed)
-	return validation.ValidateSeedUpdate(newSeed, oldSeed)
+
+	return cel.AppendErrors(validation.ValidateSeedUpdate(newSeed, oldSeed), s.celValidator.Validate(ctx, newSeed, oldSeed))
 }
 
 // WarningsOnCreate returns warnings to the client performing a create.
@@ -9,7 +9,9 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener/pkg/apis/core"
@@ -86,6 +88,22 @@ var _ = Describe("Strategy", func() {
 				Expect(newSeed.Generation).To(Equal(oldSeed.Generation + 1))
 			})
 
+			It("should bump the generation if the operation annotation was set to rotate-ca-start", func() {
+				metav1.SetMetaDataAnnotation(&newSeed.ObjectMeta, "gardener.cloud/operation", "rotate-ca-start")
+
+				strategy.PrepareForUpdate(ctx, newSeed, oldSeed)
+
+				Expect(newSeed.Generation).To(Equal(oldSeed.Generation + 1))
+			})
+
+			It("should bump the generation if the operation annotation was set to rotate-ca-complete", func() {
+				metav1.SetMetaDataAnnotation(&newSeed.ObjectMeta, "gardener.cloud/operation", "rotate-ca-complete")
+
+				strategy.PrepareForUpdate(ctx, newSeed, oldSeed)
+
+				Expect(newSeed.Generation).To(Equal(oldSeed.Generation + 1))
+			})
+
 			It("should bump the generation if the operation annotation was set to renew-workload-identity-tokens", func() {
 				metav1.SetMetaDataAnnotation(&newSeed.ObjectMeta, "gardener.cloud/operation", "renew-workload-identity-tokens")
 
@@ -141,6 +159,63 @@ var _ = Describe("Strategy", func() {
 				})
 			})
 		})
+
+		Context("ingress domain migration", func() {
+			BeforeEach(func() {
+				oldSeed.Spec.Ingress = &core.Ingress{Domain: "old.example.com"}
+				newSeed.Spec.Ingress = &core.Ingress{Domain: "old.example.com"}
+			})
+
+			It("should not start a migration if the ingress domain is unchanged", func() {
+				strategy.PrepareForUpdate(ctx, newSeed, oldSeed)
+
+				Expect(newSeed.Status.IngressDomainMigration).To(BeNil())
+			})
+
+			It("should keep a completed migration if the ingress domain is unchanged", func() {
+				oldSeed.Status.IngressDomainMigration = &core.SeedIngressDomainMigration{PreviousDomain: "foo", Domain: "old.example.com", CompletionTime: ptr.To(metav1.Now())}
+
+				strategy.PrepareForUpdate(ctx, newSeed, oldSeed)
+
+				Expect(newSeed.Status.IngressDomainMigration).To(Equal(oldSeed.Status.IngressDomainMigration))
+			})
+
+			It("should start a migration if the ingress domain was changed", func() {
+				oldSeed.Status.IngressDomainMigration = &core.SeedIngressDomainMigration{PreviousDomain: "foo", Domain: "old.example.com", CompletionTime: ptr.To(metav1.Now())}
+				newSeed.Spec.Ingress.Domain = "new.example.com"
+
+				strategy.PrepareForUpdate(ctx, newSeed, oldSeed)
+
+				Expect(newSeed.Status.IngressDomainMigration).To(PointTo(MatchFields(IgnoreExtras, Fields{
+					"PreviousDomain": Equal("old.example.com"),
+					"Domain":         Equal("new.example.com"),
+					"StartTime":      Not(BeZero()),
+					"CompletionTime": BeNil(),
+				})))
+			})
+		})
+	})
+
+	Describe("#Validate", func() {
+		It("should not report violations of invariants validated by Go and CEL twice", func() {
+			seed := &core.Seed{
+				Spec: core.SeedSpec{
+					Networks: core.SeedNetworks{
+						Pods:     "10.0.1.0/24",
+						Services: "10.0.1.64/26",
+					},
+				},
+			}
+
+			Expect(NewStrategy().Validate(ctx, seed)).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeInvalid),
+				"Field":  Equal("spec.networks.services"),
+				"Detail": Equal(`must not overlap with "spec.networks.pods" ("10.0.1.0/24")`),
+			}))))
+			Expect(NewStrategy().Validate(ctx, seed)).NotTo(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Detail": Equal("must not overlap with the pod network"),
+			}))))
+		})
 	})
 
 	Describe("#Canonicalize", func() {
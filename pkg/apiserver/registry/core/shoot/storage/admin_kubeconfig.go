@@ -39,6 +39,6 @@ func NewAdminKubeconfigREST(
 		newObjectFunc: func() runtime.Object {
 			return &authenticationv1alpha1.AdminKubeconfigRequest{}
 		},
-		clientCertificateOrganization: user.SystemPrivilegedGroup,
+		authenticateFunc: authenticateUserFromContext(user.SystemPrivilegedGroup),
 	}
 }
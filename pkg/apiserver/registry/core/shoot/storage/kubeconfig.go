@@ -38,9 +38,10 @@ type KubeconfigREST struct {
 	shootStorage         getter
 	maxExpirationSeconds int64
 
-	gvk                           schema.GroupVersionKind
-	newObjectFunc                 func() runtime.Object
-	clientCertificateOrganization string
+	gvk           schema.GroupVersionKind
+	newObjectFunc func() runtime.Object
+	// authenticateFunc returns the common name and the organization of the client certificate issued for the request.
+	authenticateFunc func(ctx context.Context, shoot *core.Shoot, kubeconfigRequest *authenticationapi.KubeconfigRequest) (string, string, error)
 }
 
 var (
@@ -61,8 +62,7 @@ func (r *KubeconfigREST) Destroy() {
 // Create returns a kubeconfig request with kubeconfig based on
 // - shoot's advertised addresses
 // - shoot's certificate authority
-// - user making the request
-// - configured organization for the client certificate
+// - identity and organization for the client certificate determined by the authenticate func
 func (r *KubeconfigREST) Create(ctx context.Context, name string, obj runtime.Object, createValidation rest.ValidateObjectFunc, _ *metav1.CreateOptions) (runtime.Object, error) {
 	if createValidation != nil {
 		if err := createValidation(ctx, obj.DeepCopyObject()); err != nil {
@@ -79,65 +79,29 @@ func (r *KubeconfigREST) Create(ctx context.Context, name string, obj runtime.Ob
 		return nil, apierrors.NewInvalid(r.gvk.GroupKind(), "", errs)
 	}
 
-	userInfo, ok := genericapirequest.UserFrom(ctx)
-	if !ok {
-		return nil, apierrors.NewBadRequest("no user in context")
-	}
-
-	// prepare: get shoot object
-	shootObj, err := r.shootStorage.Get(ctx, name, &metav1.GetOptions{})
+	shoot, err := getShoot(ctx, r.shootStorage, name)
 	if err != nil {
 		return nil, err
 	}
 
-	shoot, ok := shootObj.(*core.Shoot)
-	if !ok {
-		return nil, apierrors.NewInternalError(fmt.Errorf("cannot convert to *core.Shoot object - got type %T", shootObj))
-	}
-
-	// filter only addresses that actually advertise the kube-apiserver
-	// it is possible that the list of addresses also include URLs like the shoot's issuer URL
-	var kubeAPIServerAddresses []core.ShootAdvertisedAddress
-	for _, addr := range shoot.Status.AdvertisedAddresses {
-		if addr.Name == v1beta1constants.AdvertisedAddressExternal ||
-			addr.Name == v1beta1constants.AdvertisedAddressInternal ||
-			addr.Name == v1beta1constants.AdvertisedAddressUnmanaged {
-			kubeAPIServerAddresses = append(kubeAPIServerAddresses, addr)
-		}
-	}
-	if len(kubeAPIServerAddresses) == 0 {
-		fieldErr := field.Invalid(field.NewPath("status", "status"), shoot.Status.AdvertisedAddresses, "no suitable advertised address for kube-apiserver found in .status.advertisedAddresses")
-		return nil, apierrors.NewInvalid(r.gvk.GroupKind(), shoot.Name, field.ErrorList{fieldErr})
-	}
-
-	// prepare: get cluster and client CA
-	caClientSecret, err := r.internalSecretLister.InternalSecrets(shoot.Namespace).Get(gardenerutils.ComputeShootProjectResourceName(shoot.Name, gardenerutils.ShootProjectSecretSuffixCAClient))
+	commonName, organization, err := r.authenticateFunc(ctx, shoot, kubeconfigRequest)
 	if err != nil {
-		return nil, apierrors.NewInternalError(fmt.Errorf("could not get client CA secret: %w", err))
+		return nil, err
 	}
 
-	clientCACertificate, err := secrets.LoadCertificate("", caClientSecret.Data[secrets.DataKeyPrivateKeyCA], caClientSecret.Data[secrets.DataKeyCertificateCA])
+	kubeAPIServerAddresses, err := getKubeAPIServerAddresses(r.gvk.GroupKind(), shoot)
 	if err != nil {
-		return nil, apierrors.NewInternalError(fmt.Errorf("could not load client CA certificate from secret: %w", err))
+		return nil, err
 	}
 
-	var clusterCABundle []byte
-	caClusterConfigMap, err := r.configMapLister.ConfigMaps(shoot.Namespace).Get(gardenerutils.ComputeShootProjectResourceName(shoot.Name, gardenerutils.ShootProjectConfigMapSuffixCACluster))
-	// TODO(petersutter): Remove this fallback of reading the <shoot-name>.ca-cluster Secret after v1.135 has been released
-	if apierrors.IsNotFound(err) {
-		caClusterSecret, err := r.secretLister.Secrets(shoot.Namespace).Get(gardenerutils.ComputeShootProjectResourceName(shoot.Name, gardenerutils.ShootProjectSecretSuffixCACluster))
-		if err != nil {
-			return nil, apierrors.NewInternalError(fmt.Errorf("could not get cluster CA secret: %w", err))
-		}
-		clusterCABundle = caClusterSecret.Data[secrets.DataKeyCertificateCA]
-	} else if err != nil {
-		return nil, apierrors.NewInternalError(fmt.Errorf("could not get cluster CA config map: %w", err))
-	} else {
-		clusterCABundle = []byte(caClusterConfigMap.Data[secrets.DataKeyCertificateCA])
+	clientCACertificate, err := loadClientCACertificate(r.internalSecretLister, shoot)
+	if err != nil {
+		return nil, err
 	}
 
-	if len(clusterCABundle) == 0 {
-		return nil, apierrors.NewInternalError(fmt.Errorf("could not load cluster CA bundle"))
+	clusterCABundle, err := loadClusterCABundle(r.secretLister, r.configMapLister, shoot)
+	if err != nil {
+		return nil, err
 	}
 
 	// generate kubeconfig with client certificate
@@ -151,8 +115,8 @@ func (r *KubeconfigREST) Create(ctx context.Context, name string, obj runtime.Ob
 		cpsc     = secrets.ControlPlaneSecretConfig{
 			Name: authName,
 			CertificateSecretConfig: &secrets.CertificateSecretConfig{
-				CommonName:   userInfo.GetName(),
-				Organization: []string{r.clientCertificateOrganization},
+				CommonName:   commonName,
+				Organization: []string{organization},
 				CertType:     secrets.ClientCert,
 				Validity:     &validity,
 				SigningCA:    clientCACertificate,
@@ -195,6 +159,93 @@ func (r *KubeconfigREST) GroupVersionKind(schema.GroupVersion) schema.GroupVersi
 	return r.gvk
 }
 
+// authenticateUserFromContext returns an authenticate func which issues the client certificate for the user making the
+// request with the given organization.
+func authenticateUserFromContext(organization string) func(context.Context, *core.Shoot, *authenticationapi.KubeconfigRequest) (string, string, error) {
+	return func(ctx context.Context, _ *core.Shoot, _ *authenticationapi.KubeconfigRequest) (string, string, error) {
+		userInfo, ok := genericapirequest.UserFrom(ctx)
+		if !ok {
+			return "", "", apierrors.NewBadRequest("no user in context")
+		}
+		return userInfo.GetName(), organization, nil
+	}
+}
+
+// getShoot returns the Shoot with the given name from the given storage.
+func getShoot(ctx context.Context, shootStorage getter, name string) (*core.Shoot, error) {
+	shootObj, err := shootStorage.Get(ctx, name, &metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	shoot, ok := shootObj.(*core.Shoot)
+	if !ok {
+		return nil, apierrors.NewInternalError(fmt.Errorf("cannot convert to *core.Shoot object - got type %T", shootObj))
+	}
+
+	return shoot, nil
+}
+
+// getKubeAPIServerAddresses returns the advertised addresses of the shoot which actually advertise the kube-apiserver.
+// It is possible that the list of addresses also include URLs like the shoot's issuer URL.
+func getKubeAPIServerAddresses(groupKind schema.GroupKind, shoot *core.Shoot) ([]core.ShootAdvertisedAddress, error) {
+	var kubeAPIServerAddresses []core.ShootAdvertisedAddress
+	for _, addr := range shoot.Status.AdvertisedAddresses {
+		if addr.Name == v1beta1constants.AdvertisedAddressExternal ||
+			addr.Name == v1beta1constants.AdvertisedAddressInternal ||
+			addr.Name == v1beta1constants.AdvertisedAddressUnmanaged {
+			kubeAPIServerAddresses = append(kubeAPIServerAddresses, addr)
+		}
+	}
+
+	if len(kubeAPIServerAddresses) == 0 {
+		fieldErr := field.Invalid(field.NewPath("status", "status"), shoot.Status.AdvertisedAddresses, "no suitable advertised address for kube-apiserver found in .status.advertisedAddresses")
+		return nil, apierrors.NewInvalid(groupKind, shoot.Name, field.ErrorList{fieldErr})
+	}
+
+	return kubeAPIServerAddresses, nil
+}
+
+// loadClientCACertificate loads the client CA of the shoot which is used to sign the issued client certificates.
+func loadClientCACertificate(internalSecretLister gardencorev1beta1listers.InternalSecretLister, shoot *core.Shoot) (*secrets.Certificate, error) {
+	caClientSecret, err := internalSecretLister.InternalSecrets(shoot.Namespace).Get(gardenerutils.ComputeShootProjectResourceName(shoot.Name, gardenerutils.ShootProjectSecretSuffixCAClient))
+	if err != nil {
+		return nil, apierrors.NewInternalError(fmt.Errorf("could not get client CA secret: %w", err))
+	}
+
+	clientCACertificate, err := secrets.LoadCertificate("", caClientSecret.Data[secrets.DataKeyPrivateKeyCA], caClientSecret.Data[secrets.DataKeyCertificateCA])
+	if err != nil {
+		return nil, apierrors.NewInternalError(fmt.Errorf("could not load client CA certificate from secret: %w", err))
+	}
+
+	return clientCACertificate, nil
+}
+
+// loadClusterCABundle loads the cluster CA bundle of the shoot. During a CA rotation, the bundle contains both the old
+// and the new CA.
+func loadClusterCABundle(secretLister kubecorev1listers.SecretLister, configMapLister kubecorev1listers.ConfigMapLister, shoot *core.Shoot) ([]byte, error) {
+	var clusterCABundle []byte
+	caClusterConfigMap, err := configMapLister.ConfigMaps(shoot.Namespace).Get(gardenerutils.ComputeShootProjectResourceName(shoot.Name, gardenerutils.ShootProjectConfigMapSuffixCACluster))
+	// TODO(petersutter): Remove this fallback of reading the <shoot-name>.ca-cluster Secret after v1.135 has been released
+	if apierrors.IsNotFound(err) {
+		caClusterSecret, err := secretLister.Secrets(shoot.Namespace).Get(gardenerutils.ComputeShootProjectResourceName(shoot.Name, gardenerutils.ShootProjectSecretSuffixCACluster))
+		if err != nil {
+			return nil, apierrors.NewInternalError(fmt.Errorf("could not get cluster CA secret: %w", err))
+		}
+		clusterCABundle = caClusterSecret.Data[secrets.DataKeyCertificateCA]
+	} else if err != nil {
+		return nil, apierrors.NewInternalError(fmt.Errorf("could not get cluster CA config map: %w", err))
+	} else {
+		clusterCABundle = []byte(caClusterConfigMap.Data[secrets.DataKeyCertificateCA])
+	}
+
+	if len(clusterCABundle) == 0 {
+		return nil, apierrors.NewInternalError(fmt.Errorf("could not load cluster CA bundle"))
+	}
+
+	return clusterCABundle, nil
+}
+
 type getter interface {
 	Get(ctx context.Context, name string, options *metav1.GetOptions) (runtime.Object, error)
 }
@@ -0,0 +1,248 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/authentication/user"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/rest"
+	kubecorev1listers "k8s.io/client-go/listers/core/v1"
+	clientcmdlatest "k8s.io/client-go/tools/clientcmd/api/latest"
+	clientcmdv1 "k8s.io/client-go/tools/clientcmd/api/v1"
+
+	"github.com/gardener/gardener/pkg/api"
+	authenticationapi "github.com/gardener/gardener/pkg/apis/authentication"
+	authenticationv1alpha1 "github.com/gardener/gardener/pkg/apis/authentication/v1alpha1"
+	authenticationvalidation "github.com/gardener/gardener/pkg/apis/authentication/validation"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	gardencorev1beta1listers "github.com/gardener/gardener/pkg/client/core/listers/core/v1beta1"
+	"github.com/gardener/gardener/pkg/utils/secrets"
+)
+
+const (
+	// kubeconfigBundleClusterNameInCluster is the suffix of the cluster name pointing to the in-cluster endpoint of the
+	// kube-apiserver.
+	kubeconfigBundleClusterNameInCluster = "in-cluster"
+	// kubeconfigBundleInClusterAPIServerHost is the host of the kube-apiserver when accessed from within the shoot.
+	kubeconfigBundleInClusterAPIServerHost = "kubernetes.default.svc.cluster.local"
+	// kubeconfigBundleAuthInfoAdmin is the suffix of the auth info and context names using the admin credentials.
+	kubeconfigBundleAuthInfoAdmin = "admin"
+	// kubeconfigBundleAuthInfoViewer is the suffix of the auth info and context names using the viewer credentials.
+	kubeconfigBundleAuthInfoViewer = "viewer"
+)
+
+// KubeconfigBundleREST implements a RESTStorage for a kubeconfig bundle request.
+type KubeconfigBundleREST struct {
+	// TODO(petersutter): Remove secretLister field from struct after v1.135 has been released, as the cluster CA should then only be read from the ConfigMap.
+	secretLister               kubecorev1listers.SecretLister
+	internalSecretLister       gardencorev1beta1listers.InternalSecretLister
+	configMapLister            kubecorev1listers.ConfigMapLister
+	shootStorage               getter
+	adminMaxExpirationSeconds  int64
+	viewerMaxExpirationSeconds int64
+}
+
+var (
+	_ = rest.NamedCreater(&KubeconfigBundleREST{})
+	_ = rest.GroupVersionKindProvider(&KubeconfigBundleREST{})
+)
+
+// NewKubeconfigBundleREST returns a new KubeconfigBundleREST for kubeconfig bundles. The validity of the contained
+// admin and viewer credentials is capped by the given maximum expirations, respectively.
+func NewKubeconfigBundleREST(
+	shootGetter getter,
+	secretLister kubecorev1listers.SecretLister,
+	internalSecretLister gardencorev1beta1listers.InternalSecretLister,
+	configMapLister kubecorev1listers.ConfigMapLister,
+	adminMaxExpiration time.Duration,
+	viewerMaxExpiration time.Duration,
+) *KubeconfigBundleREST {
+	return &KubeconfigBundleREST{
+		secretLister:               secretLister,
+		internalSecretLister:       internalSecretLister,
+		configMapLister:            configMapLister,
+		shootStorage:               shootGetter,
+		adminMaxExpirationSeconds:  int64(adminMaxExpiration.Seconds()),
+		viewerMaxExpirationSeconds: int64(viewerMaxExpiration.Seconds()),
+	}
+}
+
+// New returns an instance of the object.
+func (r *KubeconfigBundleREST) New() runtime.Object {
+	return &authenticationv1alpha1.KubeconfigBundleRequest{}
+}
+
+// Destroy cleans up its resources on shutdown.
+func (r *KubeconfigBundleREST) Destroy() {
+	// Given that underlying store is shared with REST, we don't destroy it here explicitly.
+}
+
+// Create returns a kubeconfig bundle request with a kubeconfig containing
+// - a cluster for each of the shoot's advertised addresses and for the in-cluster endpoint
+// - the shoot's full cluster CA bundle (including the new CA during a CA rotation)
+// - a short-lived admin and a viewer client certificate for the user making the request
+// - an admin and a viewer context for each cluster, the viewer context of the first cluster being the current context
+func (r *KubeconfigBundleREST) Create(ctx context.Context, name string, obj runtime.Object, createValidation rest.ValidateObjectFunc, _ *metav1.CreateOptions) (runtime.Object, error) {
+	if createValidation != nil {
+		if err := createValidation(ctx, obj.DeepCopyObject()); err != nil {
+			return nil, err
+		}
+	}
+
+	kubeconfigRequest := &authenticationapi.KubeconfigRequest{}
+	if err := api.Scheme.Convert(obj, kubeconfigRequest, nil); err != nil {
+		return nil, fmt.Errorf("failed converting %T to %T: %w", obj, kubeconfigRequest, err)
+	}
+
+	if errs := authenticationvalidation.ValidateKubeconfigRequest(kubeconfigRequest); len(errs) != 0 {
+		return nil, apierrors.NewInvalid(r.groupVersionKind().GroupKind(), "", errs)
+	}
+
+	shoot, err := getShoot(ctx, r.shootStorage, name)
+	if err != nil {
+		return nil, err
+	}
+
+	userInfo, ok := genericapirequest.UserFrom(ctx)
+	if !ok {
+		return nil, apierrors.NewBadRequest("no user in context")
+	}
+
+	kubeAPIServerAddresses, err := getKubeAPIServerAddresses(r.groupVersionKind().GroupKind(), shoot)
+	if err != nil {
+		return nil, err
+	}
+
+	clientCACertificate, err := loadClientCACertificate(r.internalSecretLister, shoot)
+	if err != nil {
+		return nil, err
+	}
+
+	clusterCABundle, err := loadClusterCABundle(r.secretLister, r.configMapLister, shoot)
+	if err != nil {
+		return nil, err
+	}
+
+	// generate client certificates
+	authName := fmt.Sprintf("%s--%s", shoot.Namespace, shoot.Name)
+
+	adminCertificate, err := generateClientCertificate(authName+"-"+kubeconfigBundleAuthInfoAdmin, userInfo.GetName(), user.SystemPrivilegedGroup, clientCACertificate, kubeconfigRequest.Spec.ExpirationSeconds, r.adminMaxExpirationSeconds)
+	if err != nil {
+		return nil, err
+	}
+
+	viewerCertificate, err := generateClientCertificate(authName+"-"+kubeconfigBundleAuthInfoViewer, userInfo.GetName(), v1beta1constants.ShootGroupViewers, clientCACertificate, kubeconfigRequest.Spec.ExpirationSeconds, r.viewerMaxExpirationSeconds)
+	if err != nil {
+		return nil, err
+	}
+
+	// generate kubeconfig with admin and viewer contexts for all clusters
+	config := &clientcmdv1.Config{
+		AuthInfos: []clientcmdv1.NamedAuthInfo{
+			{
+				Name: authName + "-" + kubeconfigBundleAuthInfoAdmin,
+				AuthInfo: clientcmdv1.AuthInfo{
+					ClientCertificateData: adminCertificate.CertificatePEM,
+					ClientKeyData:         adminCertificate.PrivateKeyPEM,
+				},
+			},
+			{
+				Name: authName + "-" + kubeconfigBundleAuthInfoViewer,
+				AuthInfo: clientcmdv1.AuthInfo{
+					ClientCertificateData: viewerCertificate.CertificatePEM,
+					ClientKeyData:         viewerCertificate.PrivateKeyPEM,
+				},
+			},
+		},
+	}
+
+	addCluster := func(clusterName, apiServerHost string) {
+		config.Clusters = append(config.Clusters, clientcmdv1.NamedCluster{
+			Name: clusterName,
+			Cluster: clientcmdv1.Cluster{
+				CertificateAuthorityData: clusterCABundle,
+				Server:                   fmt.Sprintf("https://%s", apiServerHost),
+			},
+		})
+
+		for _, authInfo := range []string{kubeconfigBundleAuthInfoAdmin, kubeconfigBundleAuthInfoViewer} {
+			config.Contexts = append(config.Contexts, clientcmdv1.NamedContext{
+				Name: clusterName + "-" + authInfo,
+				Context: clientcmdv1.Context{
+					Cluster:  clusterName,
+					AuthInfo: authName + "-" + authInfo,
+				},
+			})
+		}
+	}
+
+	for _, address := range kubeAPIServerAddresses {
+		u, err := url.Parse(address.URL)
+		if err != nil {
+			return nil, err
+		}
+		addCluster(fmt.Sprintf("%s-%s", authName, address.Name), u.Host)
+	}
+	addCluster(fmt.Sprintf("%s-%s", authName, kubeconfigBundleClusterNameInCluster), kubeconfigBundleInClusterAPIServerHost)
+
+	config.CurrentContext = fmt.Sprintf("%s-%s-%s", authName, kubeAPIServerAddresses[0].Name, kubeconfigBundleAuthInfoViewer)
+
+	kubeconfig, err := runtime.Encode(clientcmdlatest.Codec, config)
+	if err != nil {
+		return nil, err
+	}
+
+	// return generated kubeconfig in status
+	expirationTimestamp := adminCertificate.Certificate.NotAfter
+	if viewerCertificate.Certificate.NotAfter.Before(expirationTimestamp) {
+		expirationTimestamp = viewerCertificate.Certificate.NotAfter
+	}
+
+	kubeconfigRequest.Status.Kubeconfig = kubeconfig
+	kubeconfigRequest.Status.ExpirationTimestamp = metav1.Time{Time: expirationTimestamp}
+
+	if err := api.Scheme.Convert(kubeconfigRequest, obj, nil); err != nil {
+		return nil, fmt.Errorf("failed converting %T to %T: %w", kubeconfigRequest, obj, err)
+	}
+
+	return obj, nil
+}
+
+// GroupVersionKind returns the GVK for the kubeconfig bundle request type.
+func (r *KubeconfigBundleREST) GroupVersionKind(schema.GroupVersion) schema.GroupVersionKind {
+	return r.groupVersionKind()
+}
+
+func (r *KubeconfigBundleREST) groupVersionKind() schema.GroupVersionKind {
+	return authenticationv1alpha1.SchemeGroupVersion.WithKind("KubeconfigBundleRequest")
+}
+
+// generateClientCertificate generates a client certificate signed by the given CA. The requested validity is capped by
+// the given maximum expiration seconds.
+func generateClientCertificate(name, commonName, organization string, signingCA *secrets.Certificate, expirationSeconds, maxExpirationSeconds int64) (*secrets.Certificate, error) {
+	if maxExpirationSeconds > 0 && expirationSeconds > maxExpirationSeconds {
+		expirationSeconds = maxExpirationSeconds
+	}
+
+	validity := time.Duration(expirationSeconds) * time.Second
+
+	return (&secrets.CertificateSecretConfig{
+		Name:         name,
+		CommonName:   commonName,
+		Organization: []string{organization},
+		CertType:     secrets.ClientCert,
+		Validity:     &validity,
+		SigningCA:    signingCA,
+	}).GenerateCertificate()
+}
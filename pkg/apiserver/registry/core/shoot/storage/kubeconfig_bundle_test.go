@@ -0,0 +1,220 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/endpoints/request"
+	clientcmdlatest "k8s.io/client-go/tools/clientcmd/api/latest"
+	clientcmdv1 "k8s.io/client-go/tools/clientcmd/api/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+
+	authenticationv1alpha1 "github.com/gardener/gardener/pkg/apis/authentication/v1alpha1"
+	gardencore "github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+	"github.com/gardener/gardener/pkg/utils/test"
+)
+
+var _ = Describe("Kubeconfig Bundle", func() {
+	const (
+		name      = "test"
+		userName  = "foo"
+		namespace = "baz"
+	)
+
+	var (
+		ctx context.Context
+		obj *authenticationv1alpha1.KubeconfigBundleRequest
+
+		shoot              *gardencore.Shoot
+		caClusterConfigMap *corev1.ConfigMap
+		caClientSecret     *gardencorev1beta1.InternalSecret
+
+		shootGetter          *fakeGetter
+		secretLister         *fakeSecretLister
+		internalSecretLister *fakeInternalSecretLister
+		configMapLister      *fakeConfigMapLister
+
+		kcREST *KubeconfigBundleREST
+
+		// the bundle contains the current and the new cluster CA during a CA rotation
+		clusterCABundle = []byte("cluster-ca-cert1\ncluster-ca-cert2")
+	)
+
+	BeforeEach(func() {
+		DeferCleanup(test.WithVar(&secretsutils.Clock, testclock.NewFakeClock(time.Unix(10, 0))))
+
+		clientCA, err := (&secretsutils.CertificateSecretConfig{
+			Name:       "ca-client",
+			CommonName: "ca-client",
+			CertType:   secretsutils.CACert,
+		}).GenerateCertificate()
+		Expect(err).NotTo(HaveOccurred())
+
+		caClusterConfigMap = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: name + ".ca-cluster", Namespace: namespace},
+			Data:       map[string]string{"ca.crt": string(clusterCABundle)},
+		}
+		caClientSecret = &gardencorev1beta1.InternalSecret{
+			ObjectMeta: metav1.ObjectMeta{Name: name + ".ca-client", Namespace: namespace},
+			Data: map[string][]byte{
+				"ca.crt": clientCA.CertificatePEM,
+				"ca.key": clientCA.PrivateKeyPEM,
+			},
+		}
+
+		shoot = &gardencore.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Status: gardencore.ShootStatus{
+				AdvertisedAddresses: []gardencore.ShootAdvertisedAddress{
+					{
+						Name: "external",
+						URL:  "https://foo.bar.external:9443",
+					},
+					{
+						Name: "internal",
+						URL:  "https://foo.bar.internal:9443",
+					},
+					{
+						Name: "service-account-issuer",
+						URL:  "https://foo.bar.issuer:9443",
+					},
+				},
+			},
+		}
+
+		shootGetter = &fakeGetter{obj: shoot}
+		secretLister = &fakeSecretLister{err: errors.New("unexpected call")}
+		internalSecretLister = &fakeInternalSecretLister{obj: caClientSecret}
+		configMapLister = &fakeConfigMapLister{obj: caClusterConfigMap}
+
+		obj = &authenticationv1alpha1.KubeconfigBundleRequest{
+			Spec: authenticationv1alpha1.KubeconfigBundleRequestSpec{
+				ExpirationSeconds: ptr.To(int64(time.Hour.Seconds() * 2)),
+			},
+		}
+
+		kcREST = NewKubeconfigBundleREST(shootGetter, secretLister, internalSecretLister, configMapLister, time.Hour, 3*time.Hour)
+
+		ctx = request.WithUser(context.Background(), &user.DefaultInfo{Name: userName})
+	})
+
+	Context("request fails", func() {
+		var (
+			actual runtime.Object
+			err    error
+		)
+
+		AfterEach(func() {
+			actual, err = kcREST.Create(ctx, name, obj, nil, nil)
+
+			Expect(err).To(HaveOccurred())
+			Expect(actual).To(BeNil())
+		})
+
+		It("returns an error if validation fails", func() {
+			obj.Spec.ExpirationSeconds = ptr.To(int64(-1))
+		})
+
+		It("returns an error if there is no user in the context", func() {
+			ctx = context.TODO()
+		})
+
+		It("returns an error if it cannot get the shoot", func() {
+			shootGetter.err = errors.New("can't get shoot")
+		})
+
+		It("returns an error if there are no advertised addresses in shoot status", func() {
+			shoot.Status.AdvertisedAddresses = nil
+		})
+
+		It("returns an error if the ca-client secret doesn't exist", func() {
+			internalSecretLister.err = apierrors.NewNotFound(gardencore.Resource("internalsecrets"), caClientSecret.Name)
+		})
+
+		It("returns an error if the ca-cluster config map is missing the public key", func() {
+			delete(caClusterConfigMap.Data, "ca.crt")
+		})
+	})
+
+	Context("request succeeds", func() {
+		It("should successfully issue a kubeconfig bundle", func() {
+			actual, err := kcREST.Create(ctx, name, obj, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(actual).To(BeAssignableToTypeOf(&authenticationv1alpha1.KubeconfigBundleRequest{}))
+
+			status := actual.(*authenticationv1alpha1.KubeconfigBundleRequest).Status
+			Expect(status.ExpirationTimestamp.Time).To(Equal(time.Unix(10, 0).Add(time.Hour)))
+
+			config := &clientcmdv1.Config{}
+			Expect(runtime.DecodeInto(clientcmdlatest.Codec, status.Kubeconfig, config)).To(Succeed())
+
+			Expect(config.Clusters).To(ConsistOf(
+				clientcmdv1.NamedCluster{
+					Name:    "baz--test-external",
+					Cluster: clientcmdv1.Cluster{Server: "https://foo.bar.external:9443", CertificateAuthorityData: clusterCABundle},
+				},
+				clientcmdv1.NamedCluster{
+					Name:    "baz--test-internal",
+					Cluster: clientcmdv1.Cluster{Server: "https://foo.bar.internal:9443", CertificateAuthorityData: clusterCABundle},
+				},
+				clientcmdv1.NamedCluster{
+					Name:    "baz--test-in-cluster",
+					Cluster: clientcmdv1.Cluster{Server: "https://kubernetes.default.svc.cluster.local", CertificateAuthorityData: clusterCABundle},
+				},
+			))
+
+			Expect(config.Contexts).To(ConsistOf(
+				clientcmdv1.NamedContext{Name: "baz--test-external-admin", Context: clientcmdv1.Context{Cluster: "baz--test-external", AuthInfo: "baz--test-admin"}},
+				clientcmdv1.NamedContext{Name: "baz--test-external-viewer", Context: clientcmdv1.Context{Cluster: "baz--test-external", AuthInfo: "baz--test-viewer"}},
+				clientcmdv1.NamedContext{Name: "baz--test-internal-admin", Context: clientcmdv1.Context{Cluster: "baz--test-internal", AuthInfo: "baz--test-admin"}},
+				clientcmdv1.NamedContext{Name: "baz--test-internal-viewer", Context: clientcmdv1.Context{Cluster: "baz--test-internal", AuthInfo: "baz--test-viewer"}},
+				clientcmdv1.NamedContext{Name: "baz--test-in-cluster-admin", Context: clientcmdv1.Context{Cluster: "baz--test-in-cluster", AuthInfo: "baz--test-admin"}},
+				clientcmdv1.NamedContext{Name: "baz--test-in-cluster-viewer", Context: clientcmdv1.Context{Cluster: "baz--test-in-cluster", AuthInfo: "baz--test-viewer"}},
+			))
+			Expect(config.CurrentContext).To(Equal("baz--test-external-viewer"))
+
+			Expect(config.AuthInfos).To(HaveLen(2))
+
+			By("Verify admin client certificate")
+			Expect(config.AuthInfos[0].Name).To(Equal("baz--test-admin"))
+			adminCert := parseCertificate(config.AuthInfos[0].AuthInfo.ClientCertificateData)
+			Expect(adminCert.Subject.CommonName).To(Equal(userName))
+			Expect(adminCert.Subject.Organization).To(ConsistOf("system:masters"))
+			Expect(adminCert.NotAfter.Unix()).To(Equal(time.Unix(10, 0).Add(time.Hour).Unix()))
+			Expect(config.AuthInfos[0].AuthInfo.ClientKeyData).NotTo(BeEmpty())
+
+			By("Verify viewer client certificate")
+			Expect(config.AuthInfos[1].Name).To(Equal("baz--test-viewer"))
+			viewerCert := parseCertificate(config.AuthInfos[1].AuthInfo.ClientCertificateData)
+			Expect(viewerCert.Subject.CommonName).To(Equal(userName))
+			Expect(viewerCert.Subject.Organization).To(ConsistOf("gardener.cloud:system:viewers"))
+			Expect(viewerCert.NotAfter.Unix()).To(Equal(time.Unix(10, 0).Add(2 * time.Hour).Unix()))
+			Expect(config.AuthInfos[1].AuthInfo.ClientKeyData).NotTo(BeEmpty())
+		})
+	})
+})
+
+func parseCertificate(data []byte) *x509.Certificate {
+	certPem, _ := pem.Decode(data)
+	ExpectWithOffset(1, certPem).NotTo(BeNil())
+	cert, err := x509.ParseCertificate(certPem.Bytes)
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+	return cert
+}
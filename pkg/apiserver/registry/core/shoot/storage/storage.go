@@ -32,7 +32,10 @@ type ShootStorage struct {
 	Status           *StatusREST
 	AdminKubeconfig  *KubeconfigREST
 	ViewerKubeconfig *KubeconfigREST
+	TokenExchange    *KubeconfigREST
+	KubeconfigBundle *KubeconfigBundleREST
 	Binding          *BindingREST
+	ForceDelete      *ForceDeleteREST
 }
 
 // NewStorage creates a new ShootStorage object.
@@ -41,23 +44,29 @@ func NewStorage(
 	internalSecretLister gardencorev1beta1listers.InternalSecretLister,
 	secretLister kubecorev1listers.SecretLister,
 	configMapLister kubecorev1listers.ConfigMapLister,
+	projectLister gardencorev1beta1listers.ProjectLister,
+	tokenVerifier TokenVerifier,
 	adminKubeconfigMaxExpiration time.Duration,
 	viewerKubeconfigMaxExpiration time.Duration,
+	tokenExchangeKubeconfigMaxExpiration time.Duration,
 	credentialsRotationInterval time.Duration,
 ) ShootStorage {
-	shootRest, shootStatusRest, bindingREST := NewREST(optsGetter, credentialsRotationInterval)
+	shootRest, shootStatusRest, bindingREST, forceDeleteREST := NewREST(optsGetter, credentialsRotationInterval)
 
 	return ShootStorage{
 		Shoot:            shootRest,
 		Status:           shootStatusRest,
 		Binding:          bindingREST,
+		ForceDelete:      forceDeleteREST,
 		AdminKubeconfig:  NewAdminKubeconfigREST(shootRest, secretLister, internalSecretLister, configMapLister, adminKubeconfigMaxExpiration),
 		ViewerKubeconfig: NewViewerKubeconfigREST(shootRest, secretLister, internalSecretLister, configMapLister, viewerKubeconfigMaxExpiration),
+		TokenExchange:    NewTokenExchangeKubeconfigREST(shootRest, secretLister, internalSecretLister, configMapLister, projectLister, tokenVerifier, tokenExchangeKubeconfigMaxExpiration),
+		KubeconfigBundle: NewKubeconfigBundleREST(shootRest, secretLister, internalSecretLister, configMapLister, adminKubeconfigMaxExpiration, viewerKubeconfigMaxExpiration),
 	}
 }
 
 // NewREST returns a RESTStorage object that will work against shoots.
-func NewREST(optsGetter generic.RESTOptionsGetter, credentialsRotationInterval time.Duration) (*REST, *StatusREST, *BindingREST) {
+func NewREST(optsGetter generic.RESTOptionsGetter, credentialsRotationInterval time.Duration) (*REST, *StatusREST, *BindingREST, *ForceDeleteREST) {
 	var (
 		shootStrategy = shoot.NewStrategy(credentialsRotationInterval)
 		store         = &genericregistry.Store{
@@ -89,7 +98,9 @@ func NewREST(optsGetter generic.RESTOptionsGetter, credentialsRotationInterval t
 	statusStore.UpdateStrategy = shoot.NewStatusStrategy()
 	bindingStore := *store
 	bindingStore.UpdateStrategy = shoot.NewBindingStrategy()
-	return &REST{store}, &StatusREST{store: &statusStore}, &BindingREST{store: &bindingStore}
+	forceDeleteStore := *store
+	forceDeleteStore.UpdateStrategy = shoot.NewForceDeleteStrategy()
+	return &REST{store}, &StatusREST{store: &statusStore}, &BindingREST{store: &bindingStore}, &ForceDeleteREST{store: &forceDeleteStore}
 }
 
 // Implement CategoriesProvider
@@ -164,6 +175,38 @@ func (r *BindingREST) Update(ctx context.Context, name string, objInfo rest.Upda
 	return r.store.Update(ctx, name, objInfo, createValidation, updateValidation, forceAllowCreate, options)
 }
 
+// ForceDeleteREST implements the REST endpoint for force-deleting a Shoot.
+type ForceDeleteREST struct {
+	store *genericregistry.Store
+}
+
+var (
+	_ rest.Storage = &ForceDeleteREST{}
+	_ rest.Getter  = &ForceDeleteREST{}
+	_ rest.Updater = &ForceDeleteREST{}
+)
+
+// New creates a new (empty) internal Shoot object.
+func (r *ForceDeleteREST) New() runtime.Object {
+	return &core.Shoot{}
+}
+
+// Destroy cleans up its resources on shutdown.
+func (r *ForceDeleteREST) Destroy() {
+	// Given that underlying store is shared with REST,
+	// we don't destroy it here explicitly.
+}
+
+// Get retrieves the object from the storage. It is required to support Patch.
+func (r *ForceDeleteREST) Get(ctx context.Context, name string, options *metav1.GetOptions) (runtime.Object, error) {
+	return r.store.Get(ctx, name, options)
+}
+
+// Update sets the force-deletion annotation on the object.
+func (r *ForceDeleteREST) Update(ctx context.Context, name string, objInfo rest.UpdatedObjectInfo, createValidation rest.ValidateObjectFunc, updateValidation rest.ValidateObjectUpdateFunc, forceAllowCreate bool, options *metav1.UpdateOptions) (runtime.Object, bool, error) {
+	return r.store.Update(ctx, name, objInfo, createValidation, updateValidation, forceAllowCreate, options)
+}
+
 // Implement ShortNamesProvider
 var _ rest.ShortNamesProvider = &REST{}
 
@@ -0,0 +1,144 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-jose/go-jose/v4/jwt"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/authentication/user"
+	kubecorev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/utils/ptr"
+
+	authenticationapi "github.com/gardener/gardener/pkg/apis/authentication"
+	authenticationv1alpha1 "github.com/gardener/gardener/pkg/apis/authentication/v1alpha1"
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	gardencorev1beta1listers "github.com/gardener/gardener/pkg/client/core/listers/core/v1beta1"
+	admissionutils "github.com/gardener/gardener/plugin/pkg/utils"
+)
+
+// TrustedIdentityUserPrefix is the prefix of the user names of client certificates issued for tokens of trusted
+// identity providers.
+const TrustedIdentityUserPrefix = "gardener.cloud:trusted-identity:"
+
+// NewTokenExchangeKubeconfigREST returns a new KubeconfigREST for kubeconfigs issued in exchange for tokens of the
+// trusted identity providers of the Shoot's project.
+func NewTokenExchangeKubeconfigREST(
+	shootGetter getter,
+	secretLister kubecorev1listers.SecretLister,
+	internalSecretLister gardencorev1beta1listers.InternalSecretLister,
+	configMapLister kubecorev1listers.ConfigMapLister,
+	projectLister gardencorev1beta1listers.ProjectLister,
+	tokenVerifier TokenVerifier,
+	maxExpiration time.Duration,
+) *KubeconfigREST {
+	return &KubeconfigREST{
+		secretLister:         secretLister,
+		internalSecretLister: internalSecretLister,
+		configMapLister:      configMapLister,
+		shootStorage:         shootGetter,
+		maxExpirationSeconds: int64(maxExpiration.Seconds()),
+
+		gvk: schema.GroupVersionKind{
+			Group:   authenticationv1alpha1.SchemeGroupVersion.Group,
+			Version: authenticationv1alpha1.SchemeGroupVersion.Version,
+			Kind:    "TokenExchangeRequest",
+		},
+		newObjectFunc: func() runtime.Object {
+			return &authenticationv1alpha1.TokenExchangeRequest{}
+		},
+		authenticateFunc: authenticateTrustedIdentity(projectLister, tokenVerifier),
+	}
+}
+
+// authenticateTrustedIdentity returns an authenticate func which verifies the token of the request against the trusted
+// identity providers of the Shoot's project. The client certificate is issued for the subject of the token with the
+// organization matching the access level of the first identity provider accepting the token.
+func authenticateTrustedIdentity(projectLister gardencorev1beta1listers.ProjectLister, tokenVerifier TokenVerifier) func(context.Context, *core.Shoot, *authenticationapi.KubeconfigRequest) (string, string, error) {
+	return func(ctx context.Context, shoot *core.Shoot, kubeconfigRequest *authenticationapi.KubeconfigRequest) (string, string, error) {
+		if len(kubeconfigRequest.Spec.Token) == 0 {
+			return "", "", apierrors.NewInvalid(authenticationapi.Kind("TokenExchangeRequest"), "", field.ErrorList{field.Required(field.NewPath("spec", "token"), "must provide a token")})
+		}
+
+		parsedToken, err := jwt.ParseSigned(kubeconfigRequest.Spec.Token, supportedSigningAlgorithms)
+		if err != nil {
+			return "", "", apierrors.NewUnauthorized(fmt.Sprintf("failed parsing token: %v", err))
+		}
+		unverifiedClaims := &jwt.Claims{}
+		if err := parsedToken.UnsafeClaimsWithoutVerification(unverifiedClaims); err != nil {
+			return "", "", apierrors.NewUnauthorized(fmt.Sprintf("failed reading token claims: %v", err))
+		}
+
+		project, err := admissionutils.ProjectForNamespaceFromLister(projectLister, shoot.Namespace)
+		if err != nil {
+			return "", "", apierrors.NewInternalError(fmt.Errorf("could not get project for namespace %q: %w", shoot.Namespace, err))
+		}
+
+		var providers []gardencorev1beta1.TrustedIdentityProvider
+		for _, provider := range project.Spec.TrustedIdentityProviders {
+			if provider.Issuer == unverifiedClaims.Issuer {
+				providers = append(providers, provider)
+			}
+		}
+		if len(providers) == 0 {
+			return "", "", apierrors.NewUnauthorized(fmt.Sprintf("issuer %q is not trusted by project %q", unverifiedClaims.Issuer, project.Name))
+		}
+
+		claims, err := tokenVerifier.Verify(ctx, unverifiedClaims.Issuer, kubeconfigRequest.Spec.Token)
+		if err != nil {
+			return "", "", apierrors.NewUnauthorized(fmt.Sprintf("failed verifying token: %v", err))
+		}
+
+		for _, provider := range providers {
+			if !audienceMatches(provider.Audiences, claims.Audience) || !subjectMatches(provider.Subjects, claims.Subject) {
+				continue
+			}
+
+			organization := v1beta1constants.ShootGroupViewers
+			if ptr.Deref(provider.Access, gardencorev1beta1.TrustedIdentityProviderAccessViewer) == gardencorev1beta1.TrustedIdentityProviderAccessAdmin {
+				organization = user.SystemPrivilegedGroup
+			}
+
+			return TrustedIdentityUserPrefix + provider.Name + ":" + claims.Subject, organization, nil
+		}
+
+		return "", "", apierrors.NewUnauthorized(fmt.Sprintf("token with subject %q is not accepted by any trusted identity provider of project %q", claims.Subject, project.Name))
+	}
+}
+
+func audienceMatches(audiences []string, tokenAudience jwt.Audience) bool {
+	for _, audience := range audiences {
+		if tokenAudience.Contains(audience) {
+			return true
+		}
+	}
+	return false
+}
+
+func subjectMatches(subjects []string, tokenSubject string) bool {
+	if len(tokenSubject) == 0 {
+		return false
+	}
+
+	for _, subject := range subjects {
+		if prefix, ok := strings.CutSuffix(subject, "*"); ok {
+			if len(prefix) > 0 && strings.HasPrefix(tokenSubject, prefix) {
+				return true
+			}
+		} else if subject == tokenSubject {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,239 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"time"
+
+	"github.com/go-jose/go-jose/v4/jwt"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientcmdlatest "k8s.io/client-go/tools/clientcmd/api/latest"
+	clientcmdv1 "k8s.io/client-go/tools/clientcmd/api/v1"
+	"k8s.io/utils/ptr"
+
+	authenticationv1alpha1 "github.com/gardener/gardener/pkg/apis/authentication/v1alpha1"
+	gardencore "github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	gardencorev1beta1listers "github.com/gardener/gardener/pkg/client/core/listers/core/v1beta1"
+	"github.com/gardener/gardener/pkg/utils/secrets"
+	"github.com/gardener/gardener/pkg/utils/workloadidentity"
+)
+
+var _ = Describe("Token Exchange", func() {
+	const (
+		name      = "test"
+		namespace = "garden-dev"
+		issuer    = "https://token.actions.githubusercontent.com"
+		subject   = "repo:gardener/gardener:ref:refs/heads/master"
+	)
+
+	var (
+		ctx = context.Background()
+
+		project       *gardencorev1beta1.Project
+		projectLister *fakeProjectLister
+		tokenVerifier *fakeTokenVerifier
+		shootGetter   *fakeGetter
+		kcREST        *KubeconfigREST
+
+		token string
+		obj   *authenticationv1alpha1.TokenExchangeRequest
+	)
+
+	BeforeEach(func() {
+		ca, err := (&secrets.CertificateSecretConfig{Name: "ca-client", CommonName: "ca-client", CertType: secrets.CACert}).GenerateCertificate()
+		Expect(err).NotTo(HaveOccurred())
+
+		shootGetter = &fakeGetter{obj: &gardencore.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Status: gardencore.ShootStatus{
+				AdvertisedAddresses: []gardencore.ShootAdvertisedAddress{{Name: "external", URL: "https://api.test.example.com"}},
+			},
+		}}
+
+		project = &gardencorev1beta1.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: "dev"},
+			Spec: gardencorev1beta1.ProjectSpec{
+				Namespace: ptr.To(namespace),
+				TrustedIdentityProviders: []gardencorev1beta1.TrustedIdentityProvider{
+					{
+						Name:      "other",
+						Issuer:    "https://gitlab.example.com",
+						Audiences: []string{"gardener"},
+						Subjects:  []string{"*"},
+						Access:    ptr.To(gardencorev1beta1.TrustedIdentityProviderAccessAdmin),
+					},
+					{
+						Name:      "github",
+						Issuer:    issuer,
+						Audiences: []string{"gardener"},
+						Subjects:  []string{"repo:gardener/gardener:*"},
+						Access:    ptr.To(gardencorev1beta1.TrustedIdentityProviderAccessViewer),
+					},
+				},
+			},
+		}
+		projectLister = &fakeProjectLister{projects: []*gardencorev1beta1.Project{project}}
+
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		Expect(err).NotTo(HaveOccurred())
+		tokenIssuer, err := workloadidentity.NewTokenIssuer(key, issuer, 600, 3600)
+		Expect(err).NotTo(HaveOccurred())
+		token, _, err = tokenIssuer.IssueToken(subject, []string{"gardener"}, 600)
+		Expect(err).NotTo(HaveOccurred())
+
+		tokenVerifier = &fakeTokenVerifier{claims: &jwt.Claims{Issuer: issuer, Subject: subject, Audience: jwt.Audience{"gardener"}}}
+
+		kcREST = NewTokenExchangeKubeconfigREST(
+			shootGetter,
+			&fakeSecretLister{},
+			&fakeInternalSecretLister{obj: &gardencorev1beta1.InternalSecret{Data: map[string][]byte{
+				"ca.crt": ca.CertificatePEM,
+				"ca.key": ca.PrivateKeyPEM,
+			}}},
+			&fakeConfigMapLister{obj: &corev1.ConfigMap{Data: map[string]string{"ca.crt": "cluster-ca"}}},
+			projectLister,
+			tokenVerifier,
+			time.Hour,
+		)
+
+		obj = &authenticationv1alpha1.TokenExchangeRequest{
+			Spec: authenticationv1alpha1.TokenExchangeRequestSpec{
+				Token:             token,
+				ExpirationSeconds: ptr.To(int64(3 * 3600)),
+			},
+		}
+	})
+
+	clientCertificate := func(actual runtime.Object) *x509.Certificate {
+		config := &clientcmdv1.Config{}
+		Expect(runtime.DecodeInto(clientcmdlatest.Codec, actual.(*authenticationv1alpha1.TokenExchangeRequest).Status.Kubeconfig, config)).To(Succeed())
+		Expect(config.AuthInfos).To(HaveLen(1))
+
+		certPem, _ := pem.Decode(config.AuthInfos[0].AuthInfo.ClientCertificateData)
+		cert, err := x509.ParseCertificate(certPem.Bytes)
+		Expect(err).NotTo(HaveOccurred())
+		return cert
+	}
+
+	It("should issue a viewer kubeconfig for the subject of the token", func() {
+		actual, err := kcREST.Create(ctx, name, obj, nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(tokenVerifier.issuer).To(Equal(issuer))
+		cert := clientCertificate(actual)
+		Expect(cert.Subject.CommonName).To(Equal("gardener.cloud:trusted-identity:github:" + subject))
+		Expect(cert.Subject.Organization).To(ConsistOf("gardener.cloud:system:viewers"))
+		Expect(cert.NotAfter).To(BeTemporally("<=", time.Now().Add(time.Hour)))
+	})
+
+	It("should issue an admin kubeconfig if the identity provider grants admin access", func() {
+		project.Spec.TrustedIdentityProviders[1].Access = ptr.To(gardencorev1beta1.TrustedIdentityProviderAccessAdmin)
+
+		actual, err := kcREST.Create(ctx, name, obj, nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(clientCertificate(actual).Subject.Organization).To(ConsistOf("system:masters"))
+	})
+
+	It("should fail if no token is provided", func() {
+		obj.Spec.Token = ""
+
+		_, err := kcREST.Create(ctx, name, obj, nil, nil)
+		Expect(apierrors.IsInvalid(err)).To(BeTrue())
+	})
+
+	It("should fail if the token is malformed", func() {
+		obj.Spec.Token = "foo"
+
+		_, err := kcREST.Create(ctx, name, obj, nil, nil)
+		Expect(apierrors.IsUnauthorized(err)).To(BeTrue())
+	})
+
+	It("should fail if the project cannot be found", func() {
+		projectLister.projects = nil
+
+		_, err := kcREST.Create(ctx, name, obj, nil, nil)
+		Expect(apierrors.IsInternalError(err)).To(BeTrue())
+	})
+
+	It("should fail if the issuer is not trusted by the project", func() {
+		project.Spec.TrustedIdentityProviders = project.Spec.TrustedIdentityProviders[:1]
+
+		_, err := kcREST.Create(ctx, name, obj, nil, nil)
+		Expect(apierrors.IsUnauthorized(err)).To(BeTrue())
+		Expect(err).To(MatchError(ContainSubstring("is not trusted by project")))
+		Expect(tokenVerifier.issuer).To(BeEmpty())
+	})
+
+	It("should fail if the token cannot be verified", func() {
+		tokenVerifier.err = errors.New("fake")
+
+		_, err := kcREST.Create(ctx, name, obj, nil, nil)
+		Expect(apierrors.IsUnauthorized(err)).To(BeTrue())
+	})
+
+	It("should fail if the audience does not match", func() {
+		tokenVerifier.claims.Audience = jwt.Audience{"other"}
+
+		_, err := kcREST.Create(ctx, name, obj, nil, nil)
+		Expect(apierrors.IsUnauthorized(err)).To(BeTrue())
+	})
+
+	It("should fail if the subject does not match", func() {
+		tokenVerifier.claims.Subject = "repo:gardener/dashboard:ref:refs/heads/master"
+
+		_, err := kcREST.Create(ctx, name, obj, nil, nil)
+		Expect(apierrors.IsUnauthorized(err)).To(BeTrue())
+		Expect(err).To(MatchError(ContainSubstring("is not accepted by any trusted identity provider")))
+	})
+})
+
+var _ = Describe("#subjectMatches", func() {
+	DescribeTable("should match subjects",
+		func(subjects []string, subject string, matches bool) {
+			Expect(subjectMatches(subjects, subject)).To(Equal(matches))
+		},
+
+		Entry("exact match", []string{"foo"}, "foo", true),
+		Entry("no exact match", []string{"foo"}, "foobar", false),
+		Entry("prefix match", []string{"bar", "foo*"}, "foobar", true),
+		Entry("no prefix match", []string{"foo*"}, "barfoo", false),
+		Entry("bare wildcard", []string{"*"}, "foo", false),
+		Entry("empty subject", []string{"foo*"}, "", false),
+	)
+})
+
+type fakeProjectLister struct {
+	gardencorev1beta1listers.ProjectLister
+	projects []*gardencorev1beta1.Project
+}
+
+func (f *fakeProjectLister) List(labels.Selector) ([]*gardencorev1beta1.Project, error) {
+	return f.projects, nil
+}
+
+type fakeTokenVerifier struct {
+	claims *jwt.Claims
+	err    error
+	issuer string
+}
+
+func (f *fakeTokenVerifier) Verify(_ context.Context, issuer, _ string) (*jwt.Claims, error) {
+	f.issuer = issuer
+	return f.claims, f.err
+}
@@ -0,0 +1,278 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/lru"
+)
+
+const (
+	// keySetCacheTTL is the duration after which the cached key set of an issuer is refreshed.
+	keySetCacheTTL = 10 * time.Minute
+	// keySetMinRefreshInterval is the minimum duration between two refreshes of the key set of an issuer which are
+	// triggered by tokens signed with unknown keys.
+	keySetMinRefreshInterval = time.Minute
+	// maxDiscoveryResponseBytes is the maximum size of responses of discovery and JWKS endpoints.
+	maxDiscoveryResponseBytes = 1 << 20
+	// maxCachedKeySets is the maximum number of issuers whose key sets are cached.
+	maxCachedKeySets = 1000
+)
+
+// supportedSigningAlgorithms are the signing algorithms accepted for tokens of trusted identity providers.
+var supportedSigningAlgorithms = []jose.SignatureAlgorithm{
+	jose.RS256, jose.RS384, jose.RS512,
+	jose.ES256, jose.ES384, jose.ES512,
+	jose.PS256, jose.PS384, jose.PS512,
+}
+
+// TokenVerifier verifies tokens issued by external OIDC identity providers.
+type TokenVerifier interface {
+	// Verify verifies the signature, the issuer and the validity period of the given token and returns its claims.
+	Verify(ctx context.Context, issuer, token string) (*jwt.Claims, error)
+}
+
+type issuerKeySet struct {
+	// lock serializes the refreshes of the key set of a single issuer without blocking the verification of tokens of
+	// other issuers.
+	lock      sync.Mutex
+	keySet    jose.JSONWebKeySet
+	fetchedAt time.Time
+}
+
+type oidcTokenVerifier struct {
+	client *http.Client
+	clock  clock.Clock
+
+	lock    sync.Mutex
+	keySets *lru.Cache
+}
+
+// NewOIDCTokenVerifier returns a TokenVerifier which discovers the signing keys of issuers via their OpenID Connect
+// discovery documents and caches them. The given client should be created with NewOIDCDiscoveryHTTPClient.
+func NewOIDCTokenVerifier(client *http.Client, clock clock.Clock) TokenVerifier {
+	return newOIDCTokenVerifier(client, clock, maxCachedKeySets)
+}
+
+func newOIDCTokenVerifier(client *http.Client, clock clock.Clock, maxCachedKeySets int) *oidcTokenVerifier {
+	return &oidcTokenVerifier{
+		client:  client,
+		clock:   clock,
+		keySets: lru.New(maxCachedKeySets),
+	}
+}
+
+func (v *oidcTokenVerifier) Verify(ctx context.Context, issuer, token string) (*jwt.Claims, error) {
+	parsedToken, err := jwt.ParseSigned(token, supportedSigningAlgorithms)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing token: %w", err)
+	}
+	if len(parsedToken.Headers) != 1 {
+		return nil, fmt.Errorf("token must have exactly one signature, got %d", len(parsedToken.Headers))
+	}
+	keyID := parsedToken.Headers[0].KeyID
+
+	keys, err := v.keysFor(ctx, issuer, keyID)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		claims     = &jwt.Claims{}
+		verifyErrs []error
+		verified   bool
+	)
+
+	for _, key := range keys {
+		if err := parsedToken.Claims(key.Key, claims); err != nil {
+			verifyErrs = append(verifyErrs, err)
+			continue
+		}
+		verified = true
+		break
+	}
+	if !verified {
+		return nil, fmt.Errorf("failed verifying token signature: %w", errors.Join(verifyErrs...))
+	}
+
+	if claims.Expiry == nil {
+		return nil, errors.New("token does not have an expiration time")
+	}
+	if err := claims.ValidateWithLeeway(jwt.Expected{Issuer: issuer, Time: v.clock.Now()}, jwt.DefaultLeeway); err != nil {
+		return nil, fmt.Errorf("failed validating token claims: %w", err)
+	}
+
+	return claims, nil
+}
+
+// issuerKeySetFor returns the cache entry of the given issuer and creates it if it does not exist yet. The least
+// recently used entry is evicted if the cache is full.
+func (v *oidcTokenVerifier) issuerKeySetFor(issuer string) *issuerKeySet {
+	v.lock.Lock()
+	defer v.lock.Unlock()
+
+	if entry, ok := v.keySets.Get(issuer); ok {
+		return entry.(*issuerKeySet)
+	}
+
+	entry := &issuerKeySet{}
+	v.keySets.Add(issuer, entry)
+	return entry
+}
+
+// keysFor returns the keys of the given issuer matching the given key ID. If no key matches, the key set is refreshed
+// unless it was fetched only recently.
+func (v *oidcTokenVerifier) keysFor(ctx context.Context, issuer, keyID string) ([]jose.JSONWebKey, error) {
+	entry := v.issuerKeySetFor(issuer)
+
+	entry.lock.Lock()
+	defer entry.lock.Unlock()
+
+	if entry.fetchedAt.IsZero() || v.clock.Since(entry.fetchedAt) > keySetCacheTTL {
+		if err := v.refreshKeySet(ctx, issuer, entry); err != nil {
+			return nil, err
+		}
+	}
+
+	keys := keysWithID(entry.keySet, keyID)
+	if len(keys) == 0 && v.clock.Since(entry.fetchedAt) > keySetMinRefreshInterval {
+		if err := v.refreshKeySet(ctx, issuer, entry); err != nil {
+			return nil, err
+		}
+		keys = keysWithID(entry.keySet, keyID)
+	}
+
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("no signing key found for key ID %q of issuer %q", keyID, issuer)
+	}
+	return keys, nil
+}
+
+func (v *oidcTokenVerifier) refreshKeySet(ctx context.Context, issuer string, entry *issuerKeySet) error {
+	issuerURL, err := url.Parse(issuer)
+	if err != nil {
+		return fmt.Errorf("failed parsing issuer %q: %w", issuer, err)
+	}
+	if issuerURL.Scheme != "https" {
+		return fmt.Errorf("issuer %q must use the https scheme", issuer)
+	}
+
+	discovery := struct {
+		Issuer  string `json:"issuer"`
+		JWKSURI string `json:"jwks_uri"`
+	}{}
+	if err := v.getJSON(ctx, strings.TrimSuffix(issuer, "/")+"/.well-known/openid-configuration", &discovery); err != nil {
+		return fmt.Errorf("failed discovering OpenID configuration of issuer %q: %w", issuer, err)
+	}
+	if discovery.Issuer != issuer {
+		return fmt.Errorf("issuer %q in OpenID configuration does not match expected issuer %q", discovery.Issuer, issuer)
+	}
+	if len(discovery.JWKSURI) == 0 {
+		return fmt.Errorf("OpenID configuration of issuer %q does not contain a jwks_uri", issuer)
+	}
+
+	jwksURL, err := url.Parse(discovery.JWKSURI)
+	if err != nil {
+		return fmt.Errorf("failed parsing jwks_uri %q of issuer %q: %w", discovery.JWKSURI, issuer, err)
+	}
+	if jwksURL.Scheme != "https" || jwksURL.Host != issuerURL.Host {
+		return fmt.Errorf("jwks_uri %q of issuer %q must use the https scheme and the host of the issuer", discovery.JWKSURI, issuer)
+	}
+
+	keySet := jose.JSONWebKeySet{}
+	if err := v.getJSON(ctx, jwksURL.String(), &keySet); err != nil {
+		return fmt.Errorf("failed fetching signing keys of issuer %q: %w", issuer, err)
+	}
+
+	entry.keySet = keySet
+	entry.fetchedAt = v.clock.Now()
+	return nil
+}
+
+func (v *oidcTokenVerifier) getJSON(ctx context.Context, url string, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	return json.NewDecoder(io.LimitReader(resp.Body, maxDiscoveryResponseBytes)).Decode(out)
+}
+
+func keysWithID(keySet jose.JSONWebKeySet, keyID string) []jose.JSONWebKey {
+	if len(keyID) == 0 {
+		return keySet.Keys
+	}
+	return keySet.Key(keyID)
+}
+
+// NewOIDCDiscoveryHTTPClient returns an HTTP client for fetching the discovery documents and key sets of trusted
+// identity providers. Since the issuers are configured by project members, the client refuses to connect to
+// non-public addresses, e.g., the cluster network or the metadata services of cloud providers.
+func NewOIDCDiscoveryHTTPClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: 30 * time.Second,
+		Control: denyNonPublicAddresses,
+	}
+
+	return &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			ForceAttemptHTTP2:   true,
+			MaxIdleConns:        100,
+			IdleConnTimeout:     90 * time.Second,
+			TLSHandshakeTimeout: 10 * time.Second,
+		},
+	}
+}
+
+// cgnatPrefix is the shared address space for carrier-grade NAT (RFC 6598) which is commonly used for cluster networks.
+var cgnatPrefix = netip.MustParsePrefix("100.64.0.0/10")
+
+// denyNonPublicAddresses is used as control function of net.Dialer. It is called with the resolved address of every
+// connection attempt (including redirects), hence it also protects against DNS names resolving to non-public addresses.
+func denyNonPublicAddresses(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+
+	ip, err := netip.ParseAddr(host)
+	if err != nil {
+		return err
+	}
+	ip = ip.Unmap()
+
+	if !ip.IsGlobalUnicast() || ip.IsPrivate() || cgnatPrefix.Contains(ip) {
+		return fmt.Errorf("connecting to non-public address %s is not allowed", ip)
+	}
+	return nil
+}
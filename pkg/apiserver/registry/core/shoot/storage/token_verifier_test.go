@@ -0,0 +1,244 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	gomegatypes "github.com/onsi/gomega/types"
+	testclock "k8s.io/utils/clock/testing"
+
+	"github.com/gardener/gardener/pkg/utils/workloadidentity"
+)
+
+var _ = Describe("OIDC Token Verifier", func() {
+	var (
+		ctx = context.Background()
+
+		server        *httptest.Server
+		issuer        string
+		discoveryHits atomic.Int32
+		jwksURI       string
+		unblockSlow   chan struct{}
+
+		signingKey *rsa.PrivateKey
+		servedKeys []any
+		fakeClock  *testclock.FakeClock
+
+		verifier TokenVerifier
+	)
+
+	BeforeEach(func() {
+		var err error
+		signingKey, err = rsa.GenerateKey(rand.Reader, 2048)
+		Expect(err).NotTo(HaveOccurred())
+		servedKeys = []any{signingKey.Public()}
+		discoveryHits.Store(0)
+		jwksURI = ""
+		unblockSlow = make(chan struct{})
+
+		mux := http.NewServeMux()
+		mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, _ *http.Request) {
+			discoveryHits.Add(1)
+			if len(jwksURI) > 0 {
+				_, _ = fmt.Fprintf(w, `{"issuer":%q,"jwks_uri":%q}`, issuer, jwksURI)
+				return
+			}
+			config, err := workloadidentity.OpenIDConfig(issuer, servedKeys...)
+			Expect(err).NotTo(HaveOccurred())
+			_, _ = w.Write(config)
+		})
+		mux.HandleFunc("/slow/.well-known/openid-configuration", func(w http.ResponseWriter, _ *http.Request) {
+			<-unblockSlow
+			w.WriteHeader(http.StatusNotFound)
+		})
+		mux.HandleFunc("/jwks", func(w http.ResponseWriter, _ *http.Request) {
+			jwks, err := workloadidentity.JWKS(servedKeys...)
+			Expect(err).NotTo(HaveOccurred())
+			_, _ = w.Write(jwks)
+		})
+
+		server = httptest.NewTLSServer(mux)
+		DeferCleanup(server.Close)
+		DeferCleanup(func() { close(unblockSlow) })
+		issuer = server.URL
+
+		fakeClock = testclock.NewFakeClock(time.Now())
+		verifier = NewOIDCTokenVerifier(server.Client(), fakeClock)
+	})
+
+	issueToken := func(key any, iss string) string {
+		tokenIssuer, err := workloadidentity.NewTokenIssuer(key, iss, 600, 3600)
+		Expect(err).NotTo(HaveOccurred())
+		token, _, err := tokenIssuer.IssueToken("repo:gardener/gardener:ref:refs/heads/master", []string{"gardener"}, 600)
+		Expect(err).NotTo(HaveOccurred())
+		return token
+	}
+
+	It("should successfully verify a token", func() {
+		claims, err := verifier.Verify(ctx, issuer, issueToken(signingKey, issuer))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(claims.Issuer).To(Equal(issuer))
+		Expect(claims.Subject).To(Equal("repo:gardener/gardener:ref:refs/heads/master"))
+		Expect(claims.Audience).To(ConsistOf("gardener"))
+	})
+
+	It("should cache the signing keys", func() {
+		token := issueToken(signingKey, issuer)
+
+		_, err := verifier.Verify(ctx, issuer, token)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = verifier.Verify(ctx, issuer, token)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(discoveryHits.Load()).To(Equal(int32(1)))
+
+		fakeClock.Step(keySetCacheTTL + time.Second)
+		_, err = verifier.Verify(ctx, issuer, issueToken(signingKey, issuer))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(discoveryHits.Load()).To(Equal(int32(2)))
+	})
+
+	It("should refresh the signing keys if the key is unknown", func() {
+		_, err := verifier.Verify(ctx, issuer, issueToken(signingKey, issuer))
+		Expect(err).NotTo(HaveOccurred())
+
+		rotatedKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).NotTo(HaveOccurred())
+		servedKeys = append(servedKeys, rotatedKey.Public())
+
+		_, err = verifier.Verify(ctx, issuer, issueToken(rotatedKey, issuer))
+		Expect(err).To(MatchError(ContainSubstring("no signing key found")))
+
+		fakeClock.Step(keySetMinRefreshInterval + time.Second)
+		_, err = verifier.Verify(ctx, issuer, issueToken(rotatedKey, issuer))
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should fail if the token is malformed", func() {
+		_, err := verifier.Verify(ctx, issuer, "foo")
+		Expect(err).To(MatchError(ContainSubstring("failed parsing token")))
+	})
+
+	It("should fail if the token is expired", func() {
+		token := issueToken(signingKey, issuer)
+		fakeClock.Step(time.Hour)
+
+		_, err := verifier.Verify(ctx, issuer, token)
+		Expect(err).To(MatchError(ContainSubstring("failed validating token claims")))
+	})
+
+	It("should fail if the token was issued by another issuer", func() {
+		_, err := verifier.Verify(ctx, issuer, issueToken(signingKey, "https://other.example.com"))
+		Expect(err).To(MatchError(ContainSubstring("failed validating token claims")))
+	})
+
+	It("should fail if the issuer in the discovery document does not match", func() {
+		_, err := verifier.Verify(ctx, issuer+"/", issueToken(signingKey, issuer+"/"))
+		Expect(err).To(MatchError(ContainSubstring("does not match expected issuer")))
+	})
+
+	It("should fail if the discovery fails", func() {
+		_, err := verifier.Verify(ctx, issuer+"/unknown", issueToken(signingKey, issuer+"/unknown"))
+		Expect(err).To(MatchError(ContainSubstring("unexpected status code 404")))
+	})
+
+	It("should fail if the issuer does not use the https scheme", func() {
+		httpIssuer := strings.Replace(issuer, "https://", "http://", 1)
+
+		_, err := verifier.Verify(ctx, httpIssuer, issueToken(signingKey, issuer))
+		Expect(err).To(MatchError(ContainSubstring("must use the https scheme")))
+		Expect(discoveryHits.Load()).To(BeZero())
+	})
+
+	It("should fail if the jwks_uri does not use the host of the issuer", func() {
+		jwksURI = "https://other.example.com/jwks"
+
+		_, err := verifier.Verify(ctx, issuer, issueToken(signingKey, issuer))
+		Expect(err).To(MatchError(ContainSubstring("must use the https scheme and the host of the issuer")))
+	})
+
+	It("should fail if the jwks_uri does not use the https scheme", func() {
+		jwksURI = strings.Replace(issuer, "https://", "http://", 1) + "/jwks"
+
+		_, err := verifier.Verify(ctx, issuer, issueToken(signingKey, issuer))
+		Expect(err).To(MatchError(ContainSubstring("must use the https scheme and the host of the issuer")))
+	})
+
+	It("should not block the verification of tokens of other issuers while fetching keys", func() {
+		slowIssuer := issuer + "/slow"
+		slowToken := issueToken(signingKey, slowIssuer)
+
+		done := make(chan struct{})
+		go func() {
+			defer GinkgoRecover()
+			defer close(done)
+			_, err := verifier.Verify(ctx, slowIssuer, slowToken)
+			Expect(err).To(HaveOccurred())
+		}()
+
+		_, err := verifier.Verify(ctx, issuer, issueToken(signingKey, issuer))
+		Expect(err).NotTo(HaveOccurred())
+
+		unblockSlow <- struct{}{}
+		Eventually(done).Should(BeClosed())
+	})
+
+	It("should bound the number of cached key sets", func() {
+		verifier := newOIDCTokenVerifier(server.Client(), fakeClock, 1)
+		token := issueToken(signingKey, issuer)
+
+		_, err := verifier.Verify(ctx, issuer, token)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(discoveryHits.Load()).To(Equal(int32(1)))
+
+		_, err = verifier.Verify(ctx, issuer+"/unknown", issueToken(signingKey, issuer+"/unknown"))
+		Expect(err).To(HaveOccurred())
+		Expect(verifier.keySets.Len()).To(Equal(1))
+
+		_, err = verifier.Verify(ctx, issuer, token)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(discoveryHits.Load()).To(Equal(int32(2)))
+	})
+
+	It("should refuse to connect to non-public addresses with the discovery client", func() {
+		client := NewOIDCDiscoveryHTTPClient(time.Second)
+		client.Transport.(*http.Transport).TLSClientConfig = server.Client().Transport.(*http.Transport).TLSClientConfig
+		verifier := NewOIDCTokenVerifier(client, fakeClock)
+
+		_, err := verifier.Verify(ctx, issuer, issueToken(signingKey, issuer))
+		Expect(err).To(MatchError(ContainSubstring("connecting to non-public address 127.0.0.1 is not allowed")))
+		Expect(discoveryHits.Load()).To(BeZero())
+	})
+
+	DescribeTable("#denyNonPublicAddresses",
+		func(address string, matcher gomegatypes.GomegaMatcher) {
+			Expect(denyNonPublicAddresses("tcp", address, nil)).To(matcher)
+		},
+
+		Entry("public IPv4 address", "203.0.113.10:443", Succeed()),
+		Entry("public IPv6 address", "[2001:db8::1]:443", Succeed()),
+		Entry("loopback address", "127.0.0.1:443", MatchError(ContainSubstring("not allowed"))),
+		Entry("IPv6 loopback address", "[::1]:443", MatchError(ContainSubstring("not allowed"))),
+		Entry("private address", "10.1.2.3:443", MatchError(ContainSubstring("not allowed"))),
+		Entry("IPv6 unique local address", "[fd00::1]:443", MatchError(ContainSubstring("not allowed"))),
+		Entry("link-local address", "169.254.169.254:80", MatchError(ContainSubstring("not allowed"))),
+		Entry("IPv4-mapped link-local address", "[::ffff:169.254.169.254]:80", MatchError(ContainSubstring("not allowed"))),
+		Entry("shared address space", "100.64.0.10:443", MatchError(ContainSubstring("not allowed"))),
+		Entry("unspecified address", "0.0.0.0:443", MatchError(ContainSubstring("not allowed"))),
+	)
+})
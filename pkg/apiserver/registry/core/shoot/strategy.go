@@ -128,9 +128,10 @@ func mustIncreaseGeneration(oldShoot, newShoot *core.Shoot) bool {
 				v1beta1constants.OperationRotateETCDEncryptionKeyStart,
 				v1beta1constants.OperationRotateETCDEncryptionKeyComplete,
 				v1beta1constants.ShootOperationRotateKubeconfigCredentials,
-				v1beta1constants.OperationRotateObservabilityCredentials:
+				v1beta1constants.OperationRotateObservabilityCredentials,
+				v1beta1constants.ShootOperationBackupNow:
 				// We don't want to remove the annotation so that the gardenlet can pick it up and perform
-				// the rotation. It has to remove the annotation after it is done.
+				// the rotation or take the etcd snapshot. It has to remove the annotation after it is done.
 				mustIncrease, mustRemoveOperationAnnotation = true, false
 
 			case v1beta1constants.ShootOperationRotateSSHKeypair:
@@ -438,3 +439,39 @@ func syncLegacyAccessRestrictionLabelWithNewFieldOnUpdate(shoot, oldShoot *core.
 		}
 	}
 }
+
+type shootForceDeleteStrategy struct {
+	shootStrategy
+}
+
+// NewForceDeleteStrategy returns a new storage strategy for the force-delete subresource of Shoots.
+func NewForceDeleteStrategy() shootForceDeleteStrategy {
+	return shootForceDeleteStrategy{NewStrategy(0)}
+}
+
+// PrepareForUpdate discards all changes of the request and only sets the force-deletion annotation. The annotation is
+// validated like any other update of the Shoot, i.e., it is only accepted for Shoots which are already marked for
+// deletion and whose deletion is stuck due to one of the errors allowing force-deletion. The generation is increased
+// like for regular updates setting the annotation so that the deletion is retried right away.
+func (shootForceDeleteStrategy) PrepareForUpdate(_ context.Context, obj, old runtime.Object) {
+	newShoot := obj.(*core.Shoot)
+	oldShoot := old.(*core.Shoot)
+
+	resourceVersion := newShoot.ResourceVersion
+	oldShoot.DeepCopyInto(newShoot)
+	newShoot.ResourceVersion = resourceVersion
+
+	metav1.SetMetaDataAnnotation(&newShoot.ObjectMeta, v1beta1constants.AnnotationConfirmationForceDeletion, "true")
+
+	if mustIncreaseGeneration(oldShoot, newShoot) {
+		newShoot.Generation = oldShoot.Generation + 1
+	}
+}
+
+func (shootForceDeleteStrategy) WarningsOnCreate(_ context.Context, _ runtime.Object) []string {
+	return nil
+}
+
+func (shootForceDeleteStrategy) WarningsOnUpdate(_ context.Context, _, _ runtime.Object) []string {
+	return nil
+}
@@ -575,6 +575,13 @@ var _ = Describe("Strategy", func() {
 					false,
 				),
 
+				Entry("backup-now",
+					v1beta1constants.ShootOperationBackupNow,
+					nil,
+					true,
+					true,
+				),
+
 				Entry("rotate-credentials-start",
 					v1beta1constants.OperationRotateCredentialsStart,
 					nil,
@@ -911,6 +918,77 @@ var _ = Describe("Strategy", func() {
 	})
 })
 
+var _ = Describe("ForceDeleteStrategy", func() {
+	var (
+		ctx      = context.Background()
+		strategy rest.RESTUpdateStrategy
+
+		oldShoot, newShoot *core.Shoot
+	)
+
+	BeforeEach(func() {
+		strategy = NewForceDeleteStrategy()
+
+		oldShoot = &core.Shoot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              "shoot",
+				Namespace:         "garden-foo",
+				ResourceVersion:   "1",
+				Generation:        3,
+				DeletionTimestamp: &metav1.Time{},
+				Annotations:       map[string]string{"foo": "bar"},
+			},
+			Spec: core.ShootSpec{Region: "eu-west-1"},
+			Status: core.ShootStatus{
+				LastErrors: []core.LastError{{Codes: []core.ErrorCode{core.ErrorInfraDependencies}}},
+			},
+		}
+		newShoot = oldShoot.DeepCopy()
+		newShoot.ResourceVersion = "2"
+	})
+
+	Describe("#PrepareForUpdate", func() {
+		It("should set the force-deletion annotation", func() {
+			strategy.PrepareForUpdate(ctx, newShoot, oldShoot)
+
+			Expect(newShoot.Annotations).To(Equal(map[string]string{
+				"foo": "bar",
+				"confirmation.gardener.cloud/force-deletion": "true",
+			}))
+			Expect(oldShoot.Annotations).NotTo(HaveKey("confirmation.gardener.cloud/force-deletion"))
+		})
+
+		It("should increase the generation", func() {
+			strategy.PrepareForUpdate(ctx, newShoot, oldShoot)
+
+			Expect(newShoot.Generation).To(Equal(int64(4)))
+		})
+
+		It("should not increase the generation if the force-deletion annotation is already set", func() {
+			metav1.SetMetaDataAnnotation(&oldShoot.ObjectMeta, "confirmation.gardener.cloud/force-deletion", "true")
+
+			strategy.PrepareForUpdate(ctx, newShoot, oldShoot)
+
+			Expect(newShoot.Generation).To(Equal(int64(3)))
+		})
+
+		It("should discard all other changes but keep the resource version of the request", func() {
+			newShoot.Labels = map[string]string{"foo": "bar"}
+			newShoot.Annotations = nil
+			newShoot.Spec.Region = "eu-central-1"
+			newShoot.Status.LastErrors = nil
+
+			strategy.PrepareForUpdate(ctx, newShoot, oldShoot)
+
+			Expect(newShoot.Labels).To(BeEmpty())
+			Expect(newShoot.Annotations).To(HaveKeyWithValue("foo", "bar"))
+			Expect(newShoot.Spec).To(Equal(oldShoot.Spec))
+			Expect(newShoot.Status).To(Equal(oldShoot.Status))
+			Expect(newShoot.ResourceVersion).To(Equal("2"))
+		})
+	})
+})
+
 var _ = Describe("ToSelectableFields", func() {
 	It("should return correct fields", func() {
 		result := ToSelectableFields(createNewShootObject("foo"))
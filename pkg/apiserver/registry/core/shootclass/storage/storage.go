@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/registry/generic"
+	genericregistry "k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	"github.com/gardener/gardener/pkg/apiserver/registry/core/shootclass"
+)
+
+// REST implements a RESTStorage for ShootClass.
+type REST struct {
+	*genericregistry.Store
+}
+
+// ShootClassStorage implements the storage for ShootClass.
+type ShootClassStorage struct {
+	ShootClass *REST
+}
+
+// NewStorage creates a new ShootClassStorage object.
+func NewStorage(optsGetter generic.RESTOptionsGetter) ShootClassStorage {
+	return ShootClassStorage{
+		ShootClass: NewREST(optsGetter),
+	}
+}
+
+// NewREST returns a RESTStorage object that will work with ShootClass objects.
+func NewREST(optsGetter generic.RESTOptionsGetter) *REST {
+	shootClassStrategy := shootclass.NewStrategy()
+	store := &genericregistry.Store{
+		NewFunc:                   func() runtime.Object { return &core.ShootClass{} },
+		NewListFunc:               func() runtime.Object { return &core.ShootClassList{} },
+		DefaultQualifiedResource:  core.Resource("shootclasses"),
+		SingularQualifiedResource: core.Resource("shootclass"),
+		EnableGarbageCollection:   true,
+
+		CreateStrategy: shootClassStrategy,
+		UpdateStrategy: shootClassStrategy,
+		DeleteStrategy: shootClassStrategy,
+
+		TableConvertor: newTableConvertor(),
+	}
+
+	options := &generic.StoreOptions{RESTOptions: optsGetter}
+	if err := store.CompleteWithOptions(options); err != nil {
+		panic(err)
+	}
+	return &REST{store}
+}
+
+// Implement ShortNamesProvider.
+var _ rest.ShortNamesProvider = &REST{}
+
+// ShortNames implements the ShortNamesProvider interface. Returns a list of short names for a resource.
+func (r *REST) ShortNames() []string {
+	return []string{"shootcls"}
+}
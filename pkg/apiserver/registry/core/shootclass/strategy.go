@@ -0,0 +1,84 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shootclass
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/storage/names"
+
+	"github.com/gardener/gardener/pkg/api"
+	"github.com/gardener/gardener/pkg/apis/core"
+	"github.com/gardener/gardener/pkg/apis/core/validation"
+)
+
+// ShootClassStrategy define the strategy for storing shootclasses.
+type ShootClassStrategy struct {
+	runtime.ObjectTyper
+	names.NameGenerator
+}
+
+// NewStrategy return a storage strategy for shootclasses.
+func NewStrategy() ShootClassStrategy {
+	return ShootClassStrategy{
+		api.Scheme,
+		names.SimpleNameGenerator,
+	}
+}
+
+// NamespaceScoped indicates if the object is namespaced scoped.
+func (ShootClassStrategy) NamespaceScoped() bool {
+	return false
+}
+
+// PrepareForCreate mutates the object before creation.
+// It is called before Validate.
+func (ShootClassStrategy) PrepareForCreate(_ context.Context, _ runtime.Object) {
+}
+
+// PrepareForUpdate allows to modify an object before it get stored.
+// It is called before ValidateUpdate.
+func (ShootClassStrategy) PrepareForUpdate(_ context.Context, _, _ runtime.Object) {
+}
+
+// Validate allow to validate the object.
+func (ShootClassStrategy) Validate(_ context.Context, obj runtime.Object) field.ErrorList {
+	shootClass := obj.(*core.ShootClass)
+	return validation.ValidateShootClass(shootClass)
+}
+
+// ValidateUpdate validates the update on the object.
+// The old and the new version of the object are passed in.
+func (ShootClassStrategy) ValidateUpdate(_ context.Context, newObj, oldObj runtime.Object) field.ErrorList {
+	oldShootClass, newShootClass := oldObj.(*core.ShootClass), newObj.(*core.ShootClass)
+	return validation.ValidateShootClassUpdate(newShootClass, oldShootClass)
+}
+
+// Canonicalize can be used to transform the object into its canonical format.
+func (ShootClassStrategy) Canonicalize(_ runtime.Object) {
+}
+
+// AllowCreateOnUpdate indicates if the object can be created via a PUT operation.
+func (ShootClassStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+// AllowUnconditionalUpdate indicates if the object can be updated
+// independently of the resource version.
+func (ShootClassStrategy) AllowUnconditionalUpdate() bool {
+	return false
+}
+
+// WarningsOnCreate returns warnings to the client performing a create.
+func (ShootClassStrategy) WarningsOnCreate(_ context.Context, _ runtime.Object) []string {
+	return nil
+}
+
+// WarningsOnUpdate returns warnings to the client performing the update.
+func (ShootClassStrategy) WarningsOnUpdate(_ context.Context, _, _ runtime.Object) []string {
+	return nil
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/gardener/gardener/pkg/apis/operations"
 	operationsv1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
 	bastionstore "github.com/gardener/gardener/pkg/apiserver/registry/operations/bastion/storage"
+	shootoperationbatchstore "github.com/gardener/gardener/pkg/apiserver/registry/operations/shootoperationbatch/storage"
 )
 
 // StorageProvider is an empty struct.
@@ -38,5 +39,9 @@ func (p StorageProvider) v1alpha1Storage(restOptionsGetter generic.RESTOptionsGe
 	storage["bastions"] = bastionStorage.Bastion
 	storage["bastions/status"] = bastionStorage.Status
 
+	shootOperationBatchStorage := shootoperationbatchstore.NewStorage(restOptionsGetter)
+	storage["shootoperationbatches"] = shootOperationBatchStorage.ShootOperationBatch
+	storage["shootoperationbatches/status"] = shootOperationBatchStorage.Status
+
 	return storage
 }
@@ -0,0 +1,108 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/registry/generic"
+	genericregistry "k8s.io/apiserver/pkg/registry/generic/registry"
+	"k8s.io/apiserver/pkg/registry/rest"
+
+	"github.com/gardener/gardener/pkg/apis/operations"
+	"github.com/gardener/gardener/pkg/apiserver/registry/operations/shootoperationbatch"
+)
+
+// REST implements a RESTStorage for ShootOperationBatches against etcd.
+type REST struct {
+	*genericregistry.Store
+}
+
+// ShootOperationBatchStorage implements the storage for ShootOperationBatches and their status subresource.
+type ShootOperationBatchStorage struct {
+	ShootOperationBatch *REST
+	Status              *StatusREST
+}
+
+// NewStorage creates a new ShootOperationBatchStorage object.
+func NewStorage(optsGetter generic.RESTOptionsGetter) ShootOperationBatchStorage {
+	batchRest, batchStatusRest := NewREST(optsGetter)
+
+	return ShootOperationBatchStorage{
+		ShootOperationBatch: batchRest,
+		Status:              batchStatusRest,
+	}
+}
+
+// NewREST returns a RESTStorage object that will work against ShootOperationBatches.
+func NewREST(optsGetter generic.RESTOptionsGetter) (*REST, *StatusREST) {
+	store := &genericregistry.Store{
+		NewFunc:                   func() runtime.Object { return &operations.ShootOperationBatch{} },
+		NewListFunc:               func() runtime.Object { return &operations.ShootOperationBatchList{} },
+		DefaultQualifiedResource:  operations.Resource("shootoperationbatches"),
+		SingularQualifiedResource: operations.Resource("shootoperationbatch"),
+		EnableGarbageCollection:   true,
+		PredicateFunc:             shootoperationbatch.MatchShootOperationBatch,
+
+		CreateStrategy: shootoperationbatch.Strategy,
+		UpdateStrategy: shootoperationbatch.Strategy,
+		DeleteStrategy: shootoperationbatch.Strategy,
+
+		TableConvertor: newTableConvertor(),
+	}
+	options := &generic.StoreOptions{
+		RESTOptions: optsGetter,
+		AttrFunc:    shootoperationbatch.GetAttrs,
+	}
+	if err := store.CompleteWithOptions(options); err != nil {
+		panic(err)
+	}
+
+	statusStore := *store
+	statusStore.UpdateStrategy = shootoperationbatch.StatusStrategy
+	return &REST{store}, &StatusREST{store: &statusStore}
+}
+
+// StatusREST implements the REST endpoint for changing the status of a ShootOperationBatch.
+type StatusREST struct {
+	store *genericregistry.Store
+}
+
+var (
+	_ rest.Storage = &StatusREST{}
+	_ rest.Getter  = &StatusREST{}
+	_ rest.Updater = &StatusREST{}
+)
+
+// New creates a new (empty) internal ShootOperationBatch object.
+func (r *StatusREST) New() runtime.Object {
+	return &operations.ShootOperationBatch{}
+}
+
+// Destroy cleans up its resources on shutdown.
+func (r *StatusREST) Destroy() {
+	// Given that underlying store is shared with REST,
+	// we don't destroy it here explicitly.
+}
+
+// Get retrieves the object from the storage. It is required to support Patch.
+func (r *StatusREST) Get(ctx context.Context, name string, options *metav1.GetOptions) (runtime.Object, error) {
+	return r.store.Get(ctx, name, options)
+}
+
+// Update alters the status subset of an object.
+func (r *StatusREST) Update(ctx context.Context, name string, objInfo rest.UpdatedObjectInfo, createValidation rest.ValidateObjectFunc, updateValidation rest.ValidateObjectUpdateFunc, forceAllowCreate bool, options *metav1.UpdateOptions) (runtime.Object, bool, error) {
+	return r.store.Update(ctx, name, objInfo, createValidation, updateValidation, forceAllowCreate, options)
+}
+
+// Implement ShortNamesProvider
+var _ rest.ShortNamesProvider = &REST{}
+
+// ShortNames implements the ShortNamesProvider interface. Returns a list of short names for a resource.
+func (r *REST) ShortNames() []string {
+	return []string{"sob"}
+}
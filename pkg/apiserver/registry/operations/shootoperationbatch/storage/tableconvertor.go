@@ -0,0 +1,93 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metatable "k8s.io/apimachinery/pkg/api/meta/table"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apiserver/pkg/registry/rest"
+
+	"github.com/gardener/gardener/pkg/apis/operations"
+)
+
+var swaggerMetadataDescriptions = metav1.ObjectMeta{}.SwaggerDoc()
+
+type convertor struct {
+	headers []metav1beta1.TableColumnDefinition
+}
+
+func newTableConvertor() rest.TableConvertor {
+	return &convertor{
+		headers: []metav1beta1.TableColumnDefinition{
+			{Name: "Name", Type: "string", Format: "name", Description: swaggerMetadataDescriptions["name"]},
+			{Name: "Operation", Type: "string", Description: "The operation applied to the selected shoots."},
+			{Name: "Phase", Type: "string", Description: "The current phase of the batch."},
+			{Name: "Progress", Type: "string", Description: "The number of shoots the operation was applied to out of all selected shoots."},
+			{Name: "Failed", Type: "integer", Description: "The number of shoots the operation could not be applied to."},
+			{Name: "Age", Type: "date", Description: swaggerMetadataDescriptions["creationTimestamp"]},
+		},
+	}
+}
+
+// ConvertToTable converts the output to a table.
+func (c *convertor) ConvertToTable(_ context.Context, obj runtime.Object, _ runtime.Object) (*metav1beta1.Table, error) {
+	var (
+		err   error
+		table = &metav1beta1.Table{
+			ColumnDefinitions: c.headers,
+		}
+	)
+
+	if m, err := meta.ListAccessor(obj); err == nil {
+		table.ResourceVersion = m.GetResourceVersion()
+		table.Continue = m.GetContinue()
+	} else {
+		if m, err := meta.CommonAccessor(obj); err == nil {
+			table.ResourceVersion = m.GetResourceVersion()
+		}
+	}
+
+	table.Rows, err = metatable.MetaToTableRow(obj, func(obj runtime.Object, _ metav1.Object, _, _ string) ([]any, error) {
+		var (
+			batch = obj.(*operations.ShootOperationBatch)
+			cells = []any{}
+
+			processed, failed int
+		)
+
+		for _, result := range batch.Status.Shoots {
+			switch result.State {
+			case operations.ShootOperationApplied:
+				processed++
+			case operations.ShootOperationFailed:
+				processed++
+				failed++
+			}
+		}
+
+		cells = append(cells, batch.Name)
+		cells = append(cells, batch.Spec.Operation)
+
+		if len(batch.Status.Phase) == 0 {
+			cells = append(cells, "<pending>")
+		} else {
+			cells = append(cells, string(batch.Status.Phase))
+		}
+
+		cells = append(cells, fmt.Sprintf("%d/%d", processed, len(batch.Status.Shoots)))
+		cells = append(cells, failed)
+		cells = append(cells, metatable.ConvertToHumanReadableDateType(batch.CreationTimestamp))
+
+		return cells, nil
+	})
+
+	return table, err
+}
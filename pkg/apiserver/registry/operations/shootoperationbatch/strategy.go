@@ -0,0 +1,137 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shootoperationbatch
+
+import (
+	"context"
+	"fmt"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/apiserver/pkg/registry/generic"
+	"k8s.io/apiserver/pkg/storage"
+	"k8s.io/apiserver/pkg/storage/names"
+
+	"github.com/gardener/gardener/pkg/api"
+	"github.com/gardener/gardener/pkg/apis/operations"
+	operationsvalidation "github.com/gardener/gardener/pkg/apis/operations/validation"
+)
+
+type shootOperationBatchStrategy struct {
+	runtime.ObjectTyper
+	names.NameGenerator
+}
+
+// Strategy defines the storage strategy for ShootOperationBatches.
+var Strategy = shootOperationBatchStrategy{api.Scheme, names.SimpleNameGenerator}
+
+func (shootOperationBatchStrategy) NamespaceScoped() bool {
+	return false
+}
+
+func (shootOperationBatchStrategy) PrepareForCreate(_ context.Context, obj runtime.Object) {
+	batch := obj.(*operations.ShootOperationBatch)
+
+	batch.Generation = 1
+	batch.Status = operations.ShootOperationBatchStatus{}
+}
+
+func (shootOperationBatchStrategy) PrepareForUpdate(_ context.Context, obj, old runtime.Object) {
+	newBatch := obj.(*operations.ShootOperationBatch)
+	oldBatch := old.(*operations.ShootOperationBatch)
+	newBatch.Status = oldBatch.Status
+
+	if mustIncreaseGeneration(oldBatch, newBatch) {
+		newBatch.Generation = oldBatch.Generation + 1
+	}
+}
+
+func mustIncreaseGeneration(oldBatch, newBatch *operations.ShootOperationBatch) bool {
+	// The ShootOperationBatch specification changes.
+	if !apiequality.Semantic.DeepEqual(oldBatch.Spec, newBatch.Spec) {
+		return true
+	}
+
+	// The deletion timestamp was set.
+	if oldBatch.DeletionTimestamp == nil && newBatch.DeletionTimestamp != nil {
+		return true
+	}
+
+	return false
+}
+
+func (shootOperationBatchStrategy) Validate(_ context.Context, obj runtime.Object) field.ErrorList {
+	batch := obj.(*operations.ShootOperationBatch)
+	return operationsvalidation.ValidateShootOperationBatch(batch)
+}
+
+func (shootOperationBatchStrategy) Canonicalize(_ runtime.Object) {
+}
+
+func (shootOperationBatchStrategy) AllowCreateOnUpdate() bool {
+	return false
+}
+
+func (shootOperationBatchStrategy) ValidateUpdate(_ context.Context, newObj, oldObj runtime.Object) field.ErrorList {
+	oldBatch, newBatch := oldObj.(*operations.ShootOperationBatch), newObj.(*operations.ShootOperationBatch)
+	return operationsvalidation.ValidateShootOperationBatchUpdate(newBatch, oldBatch)
+}
+
+func (shootOperationBatchStrategy) AllowUnconditionalUpdate() bool {
+	return false
+}
+
+// WarningsOnCreate returns warnings to the client performing a create.
+func (shootOperationBatchStrategy) WarningsOnCreate(_ context.Context, _ runtime.Object) []string {
+	return nil
+}
+
+// WarningsOnUpdate returns warnings to the client performing the update.
+func (shootOperationBatchStrategy) WarningsOnUpdate(_ context.Context, _, _ runtime.Object) []string {
+	return nil
+}
+
+type shootOperationBatchStatusStrategy struct {
+	shootOperationBatchStrategy
+}
+
+// StatusStrategy defines the storage strategy for the status subresource of ShootOperationBatches.
+var StatusStrategy = shootOperationBatchStatusStrategy{Strategy}
+
+func (shootOperationBatchStatusStrategy) PrepareForUpdate(_ context.Context, obj, old runtime.Object) {
+	newBatch := obj.(*operations.ShootOperationBatch)
+	oldBatch := old.(*operations.ShootOperationBatch)
+	newBatch.Spec = oldBatch.Spec
+}
+
+func (shootOperationBatchStatusStrategy) ValidateUpdate(_ context.Context, obj, old runtime.Object) field.ErrorList {
+	return operationsvalidation.ValidateShootOperationBatchStatusUpdate(obj.(*operations.ShootOperationBatch), old.(*operations.ShootOperationBatch))
+}
+
+// ToSelectableFields returns a field set that represents the object
+func ToSelectableFields(batch *operations.ShootOperationBatch) fields.Set {
+	return generic.ObjectMetaFieldsSet(&batch.ObjectMeta, false)
+}
+
+// GetAttrs returns labels and fields of a given object for filtering purposes.
+func GetAttrs(obj runtime.Object) (labels.Set, fields.Set, error) {
+	batch, ok := obj.(*operations.ShootOperationBatch)
+	if !ok {
+		return nil, nil, fmt.Errorf("not a ShootOperationBatch")
+	}
+	return labels.Set(batch.ObjectMeta.Labels), ToSelectableFields(batch), nil
+}
+
+// MatchShootOperationBatch returns a generic matcher for a given label and field selector.
+func MatchShootOperationBatch(label labels.Selector, field fields.Selector) storage.SelectionPredicate {
+	return storage.SelectionPredicate{
+		Label:    label,
+		Field:    field,
+		GetAttrs: GetAttrs,
+	}
+}
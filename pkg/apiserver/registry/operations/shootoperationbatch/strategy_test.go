@@ -0,0 +1,114 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shootoperationbatch_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	gardencore "github.com/gardener/gardener/pkg/apis/core"
+	"github.com/gardener/gardener/pkg/apis/operations"
+	. "github.com/gardener/gardener/pkg/apiserver/registry/operations/shootoperationbatch"
+)
+
+var _ = Describe("Strategy", func() {
+	var (
+		ctx   = context.TODO()
+		batch *operations.ShootOperationBatch
+	)
+
+	BeforeEach(func() {
+		batch = &operations.ShootOperationBatch{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "test",
+				Labels: map[string]string{"foo": "bar"},
+			},
+			Spec: operations.ShootOperationBatchSpec{
+				Operation:       "reconcile",
+				ShootSelector:   metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}},
+				ShootsPerMinute: ptr.To[int32](10),
+			},
+		}
+	})
+
+	Describe("#PrepareForCreate", func() {
+		It("should set the generation and reset the status", func() {
+			batch.Status.Phase = operations.ShootOperationBatchSucceeded
+
+			Strategy.PrepareForCreate(ctx, batch)
+
+			Expect(batch.Generation).To(Equal(int64(1)))
+			Expect(batch.Status).To(Equal(operations.ShootOperationBatchStatus{}))
+		})
+	})
+
+	Describe("#PrepareForUpdate", func() {
+		var oldBatch *operations.ShootOperationBatch
+
+		BeforeEach(func() {
+			batch.Generation = 1
+			batch.Status.Phase = operations.ShootOperationBatchProcessing
+			oldBatch = batch.DeepCopy()
+		})
+
+		It("should keep the status and generation if nothing changed", func() {
+			batch.Status.Phase = operations.ShootOperationBatchSucceeded
+
+			Strategy.PrepareForUpdate(ctx, batch, oldBatch)
+
+			Expect(batch.Generation).To(Equal(int64(1)))
+			Expect(batch.Status.Phase).To(Equal(operations.ShootOperationBatchProcessing))
+		})
+
+		It("should increase the generation if the spec changed", func() {
+			batch.Spec.ShootsPerMinute = ptr.To[int32](1)
+
+			Strategy.PrepareForUpdate(ctx, batch, oldBatch)
+
+			Expect(batch.Generation).To(Equal(int64(2)))
+		})
+
+		It("should increase the generation if the deletion timestamp was set", func() {
+			batch.DeletionTimestamp = &metav1.Time{}
+
+			Strategy.PrepareForUpdate(ctx, batch, oldBatch)
+
+			Expect(batch.Generation).To(Equal(int64(2)))
+		})
+	})
+
+	Describe("#StatusStrategy.PrepareForUpdate", func() {
+		It("should only update the status", func() {
+			oldBatch := batch.DeepCopy()
+			batch.Spec.Operation = "retry"
+			batch.Status.Phase = operations.ShootOperationBatchSucceeded
+
+			StatusStrategy.PrepareForUpdate(ctx, batch, oldBatch)
+
+			Expect(batch.Spec.Operation).To(Equal("reconcile"))
+			Expect(batch.Status.Phase).To(Equal(operations.ShootOperationBatchSucceeded))
+		})
+	})
+
+	Describe("#GetAttrs", func() {
+		It("should return error when object is not a ShootOperationBatch", func() {
+			_, _, err := GetAttrs(&gardencore.Seed{})
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should return correct result", func() {
+			ls, fs, err := GetAttrs(batch)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ls).To(HaveLen(1))
+			Expect(ls.Get("foo")).To(Equal("bar"))
+			Expect(fs.Get("metadata.name")).To(Equal("test"))
+		})
+	})
+})
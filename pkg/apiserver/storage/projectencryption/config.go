@@ -0,0 +1,155 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package projectencryption
+
+import (
+	"encoding/base64"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/yaml"
+)
+
+// Configuration is the configuration for the encryption of project-scoped resources with per-project data keys.
+type Configuration struct {
+	// Resources is the list of resources (in the format `<resource>.<group>`) which are encrypted. If empty,
+	// DefaultResources are encrypted.
+	Resources []string `json:"resources,omitempty"`
+	// Keys is the list of key encryption keys. The per-project data keys are derived from the first key, the other keys
+	// are only used for decryption of data which was written before the keys were rotated.
+	Keys []Key `json:"keys"`
+	// KMS is the configuration of a KMS v2 plugin. If set, the secrets of the keys are encrypted by the KMS and are
+	// decrypted by the plugin when gardener-apiserver starts.
+	KMS *KMSConfiguration `json:"kms,omitempty"`
+	// AllowUnencryptedData allows reading objects of the configured resources which are not encrypted with a per-project
+	// data key yet. Such objects are encrypted with the next write. It must only be enabled while existing objects are
+	// migrated after the encryption was enabled for a resource. If disabled, reading unencrypted objects fails.
+	AllowUnencryptedData bool `json:"allowUnencryptedData,omitempty"`
+}
+
+// Key is a key encryption key.
+type Key struct {
+	// Name is the name of the key. It is stored as part of the encrypted data and must not be changed.
+	Name string `json:"name"`
+	// Secret is the base64-encoded secret of the key. It must be 32 bytes long. If a KMS is configured, it is the
+	// base64-encoded ciphertext of the secret returned by the KMS plugin.
+	Secret string `json:"secret"`
+	// KeyID is the ID of the KMS key which was used to encrypt the secret. It must be set if a KMS is configured.
+	KeyID string `json:"keyID,omitempty"`
+}
+
+// KMSConfiguration is the configuration of a KMS v2 plugin.
+type KMSConfiguration struct {
+	// Name is the name of the KMS plugin.
+	Name string `json:"name"`
+	// Endpoint is the gRPC endpoint of the KMS plugin, e.g. `unix:///var/run/kms-plugin/socket.sock`.
+	Endpoint string `json:"endpoint"`
+	// Timeout is the timeout for calls to the KMS plugin. Defaults to 3s.
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+}
+
+// DefaultKMSTimeout is the default timeout for calls to the KMS plugin.
+const DefaultKMSTimeout = 3 * time.Second
+
+// DefaultResources are the resources which are encrypted if no resources are configured.
+var DefaultResources = []schema.GroupResource{
+	{Group: "core.gardener.cloud", Resource: "shootstates"},
+	{Group: "core.gardener.cloud", Resource: "internalsecrets"},
+}
+
+// LoadConfiguration reads the configuration from the given file and validates it.
+func LoadConfiguration(path string) (*Configuration, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed reading project encryption configuration from file %q: %w", path, err)
+	}
+
+	config := &Configuration{}
+	if err := yaml.UnmarshalStrict(data, config); err != nil {
+		return nil, fmt.Errorf("failed decoding project encryption configuration: %w", err)
+	}
+
+	if errs := ValidateConfiguration(config); len(errs) > 0 {
+		return nil, fmt.Errorf("project encryption configuration is invalid: %w", errs.ToAggregate())
+	}
+
+	return config, nil
+}
+
+// ValidateConfiguration validates the given configuration.
+func ValidateConfiguration(config *Configuration) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for i, resource := range config.Resources {
+		if schema.ParseGroupResource(resource).Group == "" {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("resources").Index(i), resource, "must be in the format <resource>.<group>"))
+		}
+	}
+
+	if len(config.Keys) == 0 {
+		allErrs = append(allErrs, field.Required(field.NewPath("keys"), "at least one key must be configured"))
+	}
+
+	if config.KMS != nil {
+		kmsPath := field.NewPath("kms")
+
+		if config.KMS.Name == "" {
+			allErrs = append(allErrs, field.Required(kmsPath.Child("name"), "must provide a name"))
+		}
+		if !strings.HasPrefix(config.KMS.Endpoint, "unix://") {
+			allErrs = append(allErrs, field.Invalid(kmsPath.Child("endpoint"), config.KMS.Endpoint, "must be a unix domain socket endpoint with the format unix://<path>"))
+		}
+		if config.KMS.Timeout != nil && config.KMS.Timeout.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(kmsPath.Child("timeout"), config.KMS.Timeout.Duration.String(), "must be positive"))
+		}
+	}
+
+	names := sets.New[string]()
+	for i, key := range config.Keys {
+		idxPath := field.NewPath("keys").Index(i)
+
+		if key.Name == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide a name"))
+		} else if names.Has(key.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), key.Name))
+		}
+		names.Insert(key.Name)
+
+		secret, err := base64.StdEncoding.DecodeString(key.Secret)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("secret"), "", "must be base64-encoded"))
+		} else if config.KMS == nil && len(secret) != 32 {
+			// The length of secrets encrypted by the KMS can only be checked after they were decrypted.
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("secret"), "", "must be 32 bytes long"))
+		}
+
+		if config.KMS != nil && key.KeyID == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("keyID"), "must provide the ID of the KMS key if a KMS is configured"))
+		}
+		if config.KMS == nil && key.KeyID != "" {
+			allErrs = append(allErrs, field.Forbidden(idxPath.Child("keyID"), "must not be set if no KMS is configured"))
+		}
+	}
+
+	return allErrs
+}
+
+func (c *Configuration) resources() sets.Set[schema.GroupResource] {
+	if len(c.Resources) == 0 {
+		return sets.New(DefaultResources...)
+	}
+
+	out := sets.New[schema.GroupResource]()
+	for _, resource := range c.Resources {
+		out.Insert(schema.ParseGroupResource(resource))
+	}
+	return out
+}
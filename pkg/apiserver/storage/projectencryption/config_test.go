@@ -0,0 +1,122 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package projectencryption_test
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	. "github.com/gardener/gardener/pkg/apiserver/storage/projectencryption"
+)
+
+var _ = Describe("Configuration", func() {
+	const validSecret = "MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY="
+
+	Describe("#LoadConfiguration", func() {
+		var path string
+
+		BeforeEach(func() {
+			path = filepath.Join(GinkgoT().TempDir(), "config.yaml")
+		})
+
+		It("should load a valid configuration", func() {
+			Expect(os.WriteFile(path, []byte(`resources:
+- shootstates.core.gardener.cloud
+keys:
+- name: key1
+  secret: `+validSecret+`
+`), 0600)).To(Succeed())
+
+			Expect(LoadConfiguration(path)).To(Equal(&Configuration{
+				Resources: []string{"shootstates.core.gardener.cloud"},
+				Keys:      []Key{{Name: "key1", Secret: validSecret}},
+			}))
+		})
+
+		It("should fail if the file does not exist", func() {
+			_, err := LoadConfiguration(path)
+			Expect(err).To(MatchError(ContainSubstring("failed reading project encryption configuration")))
+		})
+
+		It("should fail if the configuration contains unknown fields", func() {
+			Expect(os.WriteFile(path, []byte(`foo: bar`), 0600)).To(Succeed())
+
+			_, err := LoadConfiguration(path)
+			Expect(err).To(MatchError(ContainSubstring("failed decoding project encryption configuration")))
+		})
+
+		It("should fail if the configuration is invalid", func() {
+			Expect(os.WriteFile(path, []byte(`keys: []`), 0600)).To(Succeed())
+
+			_, err := LoadConfiguration(path)
+			Expect(err).To(MatchError(ContainSubstring("project encryption configuration is invalid")))
+		})
+	})
+
+	Describe("#ValidateConfiguration", func() {
+		It("should allow a valid configuration", func() {
+			Expect(ValidateConfiguration(&Configuration{
+				Keys: []Key{{Name: "key2", Secret: validSecret}, {Name: "key1", Secret: validSecret}},
+			})).To(BeEmpty())
+		})
+
+		It("should forbid invalid configurations", func() {
+			Expect(ValidateConfiguration(&Configuration{
+				Resources: []string{"shootstates"},
+				Keys: []Key{
+					{Name: "", Secret: validSecret},
+					{Name: "key1", Secret: "not-base64"},
+					{Name: "key1", Secret: "Zm9v"},
+				},
+			})).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("resources[0]")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeRequired), "Field": Equal("keys[0].name")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("keys[1].secret"), "Detail": Equal("must be base64-encoded")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeDuplicate), "Field": Equal("keys[2].name")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("keys[2].secret"), "Detail": Equal("must be 32 bytes long")})),
+			))
+		})
+
+		It("should allow a valid configuration with KMS", func() {
+			Expect(ValidateConfiguration(&Configuration{
+				Keys: []Key{{Name: "key1", Secret: "Zm9v", KeyID: "kms-key"}},
+				KMS:  &KMSConfiguration{Name: "kms", Endpoint: "unix:///kms.sock", Timeout: &metav1.Duration{Duration: time.Second}},
+			})).To(BeEmpty())
+		})
+
+		It("should forbid invalid configurations with KMS", func() {
+			Expect(ValidateConfiguration(&Configuration{
+				Keys: []Key{{Name: "key1", Secret: "Zm9v"}},
+				KMS:  &KMSConfiguration{Endpoint: "/kms.sock", Timeout: &metav1.Duration{}},
+			})).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeRequired), "Field": Equal("kms.name")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("kms.endpoint")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeInvalid), "Field": Equal("kms.timeout")})),
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeRequired), "Field": Equal("keys[0].keyID")})),
+			))
+		})
+
+		It("should forbid key IDs without KMS", func() {
+			Expect(ValidateConfiguration(&Configuration{
+				Keys: []Key{{Name: "key1", Secret: validSecret, KeyID: "kms-key"}},
+			})).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeForbidden), "Field": Equal("keys[0].keyID")})),
+			))
+		})
+
+		It("should require at least one key", func() {
+			Expect(ValidateConfiguration(&Configuration{})).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{"Type": Equal(field.ErrorTypeRequired), "Field": Equal("keys")})),
+			))
+		})
+	})
+})
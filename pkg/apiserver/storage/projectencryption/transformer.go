@@ -0,0 +1,287 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package projectencryption
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"golang.org/x/crypto/hkdf"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	"k8s.io/apiserver/pkg/storage/value"
+	"k8s.io/apiserver/pkg/storage/value/encrypt/envelope/kmsv2"
+	"k8s.io/apiserver/pkg/storage/value/encrypt/identity"
+	kmsservice "k8s.io/kms/pkg/service"
+)
+
+// Prefix is the prefix of data encrypted with a per-project data key. It is followed by the name of the key encryption
+// key and a colon.
+const Prefix = "gardener:enc:project:v1:"
+
+var _ value.ResourceTransformers = &resourceTransformers{}
+
+// NewKMSService is used to connect to the KMS v2 plugin. Exposed for testing.
+var NewKMSService = kmsv2.NewGRPCService
+
+type resourceTransformers struct {
+	delegate    value.ResourceTransformers
+	resources   sets.Set[schema.GroupResource]
+	transformer *transformer
+}
+
+// NewResourceTransformers returns resource transformers which encrypt the configured resources with per-project data
+// keys before they are passed to the transformers of the given delegate (e.g., the transformers configured via
+// `--encryption-provider-config`). The delegate may be nil. If a KMS is configured, the secrets of the keys are decrypted
+// by the KMS plugin.
+func NewResourceTransformers(ctx context.Context, config *Configuration, delegate value.ResourceTransformers) (value.ResourceTransformers, error) {
+	keys, err := decodeKeys(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+
+	t := newTransformer(keys, config.AllowUnencryptedData)
+
+	return &resourceTransformers{
+		delegate:    delegate,
+		resources:   config.resources(),
+		transformer: t,
+	}, nil
+}
+
+// TransformerForResource implements value.ResourceTransformers.
+func (r *resourceTransformers) TransformerForResource(resource schema.GroupResource) value.Transformer {
+	var delegate value.Transformer = identity.NewEncryptCheckTransformer()
+	if r.delegate != nil {
+		delegate = r.delegate.TransformerForResource(resource)
+	}
+
+	if !r.resources.Has(resource) {
+		return delegate
+	}
+
+	return &layeredTransformer{inner: r.transformer, outer: delegate}
+}
+
+// layeredTransformer applies the inner transformer before the outer transformer when writing to the storage and vice
+// versa when reading from the storage.
+type layeredTransformer struct {
+	inner, outer value.Transformer
+}
+
+func (l *layeredTransformer) TransformFromStorage(ctx context.Context, data []byte, dataCtx value.Context) ([]byte, bool, error) {
+	out, outerStale, err := l.outer.TransformFromStorage(ctx, data, dataCtx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	out, innerStale, err := l.inner.TransformFromStorage(ctx, out, dataCtx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return out, outerStale || innerStale, nil
+}
+
+func (l *layeredTransformer) TransformToStorage(ctx context.Context, data []byte, dataCtx value.Context) ([]byte, error) {
+	out, err := l.inner.TransformToStorage(ctx, data, dataCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	return l.outer.TransformToStorage(ctx, out, dataCtx)
+}
+
+// transformer encrypts data with AES-GCM using a data key which is specific to the project namespace of the object.
+// The data keys are derived from the key encryption keys with HKDF, hence they never have to be stored. The storage
+// key of the object is used as additional authenticated data, i.e., encrypted data cannot be copied to another object.
+type transformer struct {
+	primaryKey           string
+	keys                 map[string][]byte
+	allowUnencryptedData bool
+
+	lock     sync.RWMutex
+	dataKeys map[string]cipher.AEAD
+}
+
+// namedSecret is the decoded (and decrypted) secret of a key encryption key.
+type namedSecret struct {
+	name   string
+	secret []byte
+}
+
+func newTransformer(keys []namedSecret, allowUnencryptedData bool) *transformer {
+	t := &transformer{
+		keys:                 make(map[string][]byte, len(keys)),
+		allowUnencryptedData: allowUnencryptedData,
+		dataKeys:             make(map[string]cipher.AEAD),
+	}
+
+	for i, key := range keys {
+		if i == 0 {
+			t.primaryKey = key.name
+		}
+		t.keys[key.name] = key.secret
+	}
+
+	return t
+}
+
+// decodeKeys decodes the secrets of the configured keys. If a KMS is configured, the secrets are decrypted by the KMS
+// plugin.
+func decodeKeys(ctx context.Context, config *Configuration) ([]namedSecret, error) {
+	var kms kmsservice.Service
+	if config.KMS != nil {
+		timeout := DefaultKMSTimeout
+		if config.KMS.Timeout != nil {
+			timeout = config.KMS.Timeout.Duration
+		}
+
+		var err error
+		kms, err = NewKMSService(ctx, config.KMS.Endpoint, config.KMS.Name, timeout)
+		if err != nil {
+			return nil, fmt.Errorf("failed connecting to KMS plugin %q: %w", config.KMS.Name, err)
+		}
+	}
+
+	out := make([]namedSecret, 0, len(config.Keys))
+	for _, key := range config.Keys {
+		secret, err := base64.StdEncoding.DecodeString(key.Secret)
+		if err != nil {
+			return nil, fmt.Errorf("failed decoding secret of key %q: %w", key.Name, err)
+		}
+
+		if kms != nil {
+			secret, err = kms.Decrypt(ctx, string(uuid.NewUUID()), &kmsservice.DecryptRequest{Ciphertext: secret, KeyID: key.KeyID})
+			if err != nil {
+				return nil, fmt.Errorf("failed decrypting secret of key %q with KMS plugin %q: %w", key.Name, config.KMS.Name, err)
+			}
+			if len(secret) != 32 {
+				return nil, fmt.Errorf("decrypted secret of key %q must be 32 bytes long", key.Name)
+			}
+		}
+
+		out = append(out, namedSecret{name: key.Name, secret: secret})
+	}
+
+	return out, nil
+}
+
+func (t *transformer) TransformFromStorage(_ context.Context, data []byte, dataCtx value.Context) ([]byte, bool, error) {
+	if !bytes.HasPrefix(data, []byte(Prefix)) {
+		if !t.allowUnencryptedData {
+			return nil, false, fmt.Errorf("data is not encrypted with a per-project data key, reading unencrypted data is only allowed during migration (allowUnencryptedData)")
+		}
+
+		// The data was written before the encryption was enabled. It is returned as is and marked as stale so that it
+		// gets encrypted with the next write.
+		return data, true, nil
+	}
+
+	keyName, encrypted, found := bytes.Cut(data[len(Prefix):], []byte(":"))
+	if !found {
+		return nil, false, fmt.Errorf("encrypted data does not contain the name of the key")
+	}
+
+	aead, err := t.dataKey(string(keyName), dataCtx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	nonceSize := aead.NonceSize()
+	if len(encrypted) < nonceSize {
+		return nil, false, fmt.Errorf("encrypted data is too short")
+	}
+
+	out, err := aead.Open(nil, encrypted[:nonceSize], encrypted[nonceSize:], dataCtx.AuthenticatedData())
+	if err != nil {
+		return nil, false, fmt.Errorf("failed decrypting data with data key derived from key %q: %w", keyName, err)
+	}
+
+	return out, string(keyName) != t.primaryKey, nil
+}
+
+func (t *transformer) TransformToStorage(_ context.Context, data []byte, dataCtx value.Context) ([]byte, error) {
+	aead, err := t.dataKey(t.primaryKey, dataCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := Prefix + t.primaryKey + ":"
+	out := make([]byte, len(prefix)+aead.NonceSize(), len(prefix)+aead.NonceSize()+len(data)+aead.Overhead())
+	copy(out, prefix)
+
+	nonce := out[len(prefix):]
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, fmt.Errorf("failed generating nonce: %w", err)
+	}
+
+	return aead.Seal(out, nonce, data, dataCtx.AuthenticatedData()), nil
+}
+
+// dataKey returns the data key for the project namespace of the object identified by the given data context. The data
+// key is derived from the key encryption key with the given name.
+func (t *transformer) dataKey(keyName string, dataCtx value.Context) (cipher.AEAD, error) {
+	namespace, err := namespaceFromStorageKey(string(dataCtx.AuthenticatedData()))
+	if err != nil {
+		return nil, err
+	}
+
+	cacheKey := keyName + "/" + namespace
+
+	t.lock.RLock()
+	aead, ok := t.dataKeys[cacheKey]
+	t.lock.RUnlock()
+	if ok {
+		return aead, nil
+	}
+
+	secret, ok := t.keys[keyName]
+	if !ok {
+		return nil, fmt.Errorf("key %q is not configured", keyName)
+	}
+
+	dataKey := make([]byte, 32)
+	if _, err := io.ReadFull(hkdf.New(sha256.New, secret, nil, []byte(namespace)), dataKey); err != nil {
+		return nil, fmt.Errorf("failed deriving data key for namespace %q: %w", namespace, err)
+	}
+
+	block, err := aes.NewCipher(dataKey)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err = cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	t.lock.Lock()
+	t.dataKeys[cacheKey] = aead
+	t.lock.Unlock()
+
+	return aead, nil
+}
+
+// namespaceFromStorageKey returns the namespace of a namespaced object from its storage key, which has the format
+// `<prefix>/<group>/<resource>/<namespace>/<name>`.
+func namespaceFromStorageKey(key string) (string, error) {
+	parts := strings.Split(strings.TrimSuffix(key, "/"), "/")
+	if len(parts) < 3 || parts[len(parts)-2] == "" {
+		return "", fmt.Errorf("cannot determine namespace from storage key %q", key)
+	}
+
+	return parts[len(parts)-2], nil
+}
@@ -0,0 +1,210 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package projectencryption_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apiserver/pkg/storage/value"
+	kmsservice "k8s.io/kms/pkg/service"
+
+	. "github.com/gardener/gardener/pkg/apiserver/storage/projectencryption"
+	"github.com/gardener/gardener/pkg/utils/test"
+)
+
+var _ = Describe("Transformer", func() {
+	var (
+		ctx = context.Background()
+
+		key1 = Key{Name: "key1", Secret: base64.StdEncoding.EncodeToString([]byte("0123456789abcdef0123456789abcdef"))}
+		key2 = Key{Name: "key2", Secret: base64.StdEncoding.EncodeToString([]byte("fedcba9876543210fedcba9876543210"))}
+
+		shootStates = schema.GroupResource{Group: "core.gardener.cloud", Resource: "shootstates"}
+
+		plaintext     = []byte("some-shoot-state")
+		storageKeyFoo = value.DefaultContext("/registry-gardener/core.gardener.cloud/shootstates/garden-foo/shoot")
+		storageKeyBar = value.DefaultContext("/registry-gardener/core.gardener.cloud/shootstates/garden-bar/shoot")
+	)
+
+	newTransformer := func(config *Configuration, delegate value.ResourceTransformers) value.Transformer {
+		resourceTransformers, err := NewResourceTransformers(ctx, config, delegate)
+		Expect(err).NotTo(HaveOccurred())
+		return resourceTransformers.TransformerForResource(shootStates)
+	}
+
+	It("should encrypt and decrypt configured resources", func() {
+		transformer := newTransformer(&Configuration{Keys: []Key{key1}}, nil)
+
+		encrypted, err := transformer.TransformToStorage(ctx, plaintext, storageKeyFoo)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(encrypted)).To(HavePrefix("gardener:enc:project:v1:key1:"))
+		Expect(string(encrypted)).NotTo(ContainSubstring(string(plaintext)))
+
+		decrypted, stale, err := transformer.TransformFromStorage(ctx, encrypted, storageKeyFoo)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stale).To(BeFalse())
+		Expect(decrypted).To(Equal(plaintext))
+	})
+
+	It("should not encrypt resources which are not configured", func() {
+		resourceTransformers, err := NewResourceTransformers(ctx, &Configuration{Keys: []Key{key1}}, nil)
+		Expect(err).NotTo(HaveOccurred())
+		transformer := resourceTransformers.TransformerForResource(schema.GroupResource{Group: "core.gardener.cloud", Resource: "shoots"})
+
+		Expect(transformer.TransformToStorage(ctx, plaintext, storageKeyFoo)).To(Equal(plaintext))
+	})
+
+	It("should use different data keys per project", func() {
+		transformer := newTransformer(&Configuration{Keys: []Key{key1}}, nil)
+
+		encrypted, err := transformer.TransformToStorage(ctx, plaintext, storageKeyFoo)
+		Expect(err).NotTo(HaveOccurred())
+
+		_, _, err = transformer.TransformFromStorage(ctx, encrypted, storageKeyBar)
+		Expect(err).To(MatchError(ContainSubstring("failed decrypting data with data key derived from key \"key1\"")))
+	})
+
+	It("should return unencrypted data as stale if unencrypted data is allowed", func() {
+		transformer := newTransformer(&Configuration{Keys: []Key{key1}, AllowUnencryptedData: true}, nil)
+
+		decrypted, stale, err := transformer.TransformFromStorage(ctx, plaintext, storageKeyFoo)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stale).To(BeTrue())
+		Expect(decrypted).To(Equal(plaintext))
+	})
+
+	It("should fail reading unencrypted data if unencrypted data is not allowed", func() {
+		transformer := newTransformer(&Configuration{Keys: []Key{key1}}, nil)
+
+		_, _, err := transformer.TransformFromStorage(ctx, plaintext, storageKeyFoo)
+		Expect(err).To(MatchError(ContainSubstring("data is not encrypted with a per-project data key")))
+	})
+
+	It("should decrypt data encrypted with an old key and return it as stale", func() {
+		encrypted, err := newTransformer(&Configuration{Keys: []Key{key1}}, nil).TransformToStorage(ctx, plaintext, storageKeyFoo)
+		Expect(err).NotTo(HaveOccurred())
+
+		transformer := newTransformer(&Configuration{Keys: []Key{key2, key1}}, nil)
+
+		decrypted, stale, err := transformer.TransformFromStorage(ctx, encrypted, storageKeyFoo)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stale).To(BeTrue())
+		Expect(decrypted).To(Equal(plaintext))
+
+		reencrypted, err := transformer.TransformToStorage(ctx, decrypted, storageKeyFoo)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(reencrypted)).To(HavePrefix("gardener:enc:project:v1:key2:"))
+	})
+
+	It("should fail if the key is no longer configured", func() {
+		encrypted, err := newTransformer(&Configuration{Keys: []Key{key1}}, nil).TransformToStorage(ctx, plaintext, storageKeyFoo)
+		Expect(err).NotTo(HaveOccurred())
+
+		_, _, err = newTransformer(&Configuration{Keys: []Key{key2}}, nil).TransformFromStorage(ctx, encrypted, storageKeyFoo)
+		Expect(err).To(MatchError(`key "key1" is not configured`))
+	})
+
+	Context("with KMS", func() {
+		var (
+			kms    *fakeKMS
+			config *Configuration
+		)
+
+		BeforeEach(func() {
+			kms = &fakeKMS{keyID: "kms-key"}
+			DeferCleanup(test.WithVar(&NewKMSService, func(_ context.Context, endpoint, providerName string, callTimeout time.Duration) (kmsservice.Service, error) {
+				Expect(endpoint).To(Equal("unix:///kms.sock"))
+				Expect(providerName).To(Equal("kms"))
+				Expect(callTimeout).To(Equal(DefaultKMSTimeout))
+				return kms, nil
+			}))
+
+			config = &Configuration{
+				Keys: []Key{{Name: "key1", Secret: base64.StdEncoding.EncodeToString(append([]byte("kms:"), []byte("0123456789abcdef0123456789abcdef")...)), KeyID: "kms-key"}},
+				KMS:  &KMSConfiguration{Name: "kms", Endpoint: "unix:///kms.sock"},
+			}
+		})
+
+		It("should use the secrets decrypted by the KMS", func() {
+			encrypted, err := newTransformer(config, nil).TransformToStorage(ctx, plaintext, storageKeyFoo)
+			Expect(err).NotTo(HaveOccurred())
+
+			// The data keys are the same as if the decrypted secret was configured directly.
+			decrypted, _, err := newTransformer(&Configuration{Keys: []Key{key1}}, nil).TransformFromStorage(ctx, encrypted, storageKeyFoo)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(decrypted).To(Equal(plaintext))
+		})
+
+		It("should fail if the KMS cannot decrypt the secret", func() {
+			config.Keys[0].KeyID = "other-key"
+
+			_, err := NewResourceTransformers(ctx, config, nil)
+			Expect(err).To(MatchError(ContainSubstring(`failed decrypting secret of key "key1" with KMS plugin "kms"`)))
+		})
+
+		It("should fail if the decrypted secret has the wrong length", func() {
+			config.Keys[0].Secret = base64.StdEncoding.EncodeToString([]byte("kms:foo"))
+
+			_, err := NewResourceTransformers(ctx, config, nil)
+			Expect(err).To(MatchError(`decrypted secret of key "key1" must be 32 bytes long`))
+		})
+	})
+
+	It("should apply the transformers of the delegate after the project encryption", func() {
+		transformer := newTransformer(&Configuration{Keys: []Key{key1}}, &prefixTransformers{prefix: "outer:"})
+
+		encrypted, err := transformer.TransformToStorage(ctx, plaintext, storageKeyFoo)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(encrypted)).To(HavePrefix("outer:gardener:enc:project:v1:key1:"))
+
+		decrypted, stale, err := transformer.TransformFromStorage(ctx, encrypted, storageKeyFoo)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stale).To(BeFalse())
+		Expect(decrypted).To(Equal(plaintext))
+	})
+})
+
+// fakeKMS "decrypts" ciphertexts by stripping the `kms:` prefix.
+type fakeKMS struct {
+	keyID string
+}
+
+func (f *fakeKMS) Decrypt(_ context.Context, _ string, req *kmsservice.DecryptRequest) ([]byte, error) {
+	if req.KeyID != f.keyID || !bytes.HasPrefix(req.Ciphertext, []byte("kms:")) {
+		return nil, fmt.Errorf("cannot decrypt")
+	}
+	return bytes.TrimPrefix(req.Ciphertext, []byte("kms:")), nil
+}
+
+func (f *fakeKMS) Encrypt(_ context.Context, _ string, _ []byte) (*kmsservice.EncryptResponse, error) {
+	return nil, fmt.Errorf("not supported")
+}
+
+func (f *fakeKMS) Status(_ context.Context) (*kmsservice.StatusResponse, error) {
+	return &kmsservice.StatusResponse{KeyID: f.keyID}, nil
+}
+
+type prefixTransformers struct {
+	prefix string
+}
+
+func (p *prefixTransformers) TransformerForResource(_ schema.GroupResource) value.Transformer {
+	return p
+}
+
+func (p *prefixTransformers) TransformFromStorage(_ context.Context, data []byte, _ value.Context) ([]byte, bool, error) {
+	return data[len(p.prefix):], false, nil
+}
+
+func (p *prefixTransformers) TransformToStorage(_ context.Context, data []byte, _ value.Context) ([]byte, error) {
+	return append([]byte(p.prefix), data...), nil
+}
@@ -30,6 +30,7 @@ type CoreV1beta1Interface interface {
 	SecretBindingsGetter
 	SeedsGetter
 	ShootsGetter
+	ShootClassesGetter
 	ShootStatesGetter
 }
 
@@ -94,6 +95,10 @@ func (c *CoreV1beta1Client) Shoots(namespace string) ShootInterface {
 	return newShoots(c, namespace)
 }
 
+func (c *CoreV1beta1Client) ShootClasses() ShootClassInterface {
+	return newShootClasses(c)
+}
+
 func (c *CoreV1beta1Client) ShootStates(namespace string) ShootStateInterface {
 	return newShootStates(c, namespace)
 }
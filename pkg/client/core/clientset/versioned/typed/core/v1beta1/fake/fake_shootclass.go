@@ -0,0 +1,114 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeShootClasses implements ShootClassInterface
+type FakeShootClasses struct {
+	Fake *FakeCoreV1beta1
+}
+
+var shootclassesResource = v1beta1.SchemeGroupVersion.WithResource("shootclasses")
+
+var shootclassesKind = v1beta1.SchemeGroupVersion.WithKind("ShootClass")
+
+// Get takes name of the shootClass, and returns the corresponding shootClass object, and an error if there is any.
+func (c *FakeShootClasses) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.ShootClass, err error) {
+	emptyResult := &v1beta1.ShootClass{}
+	obj, err := c.Fake.
+		Invokes(testing.NewRootGetActionWithOptions(shootclassesResource, name, options), emptyResult)
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1beta1.ShootClass), err
+}
+
+// List takes label and field selectors, and returns the list of ShootClasses that match those selectors.
+func (c *FakeShootClasses) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.ShootClassList, err error) {
+	emptyResult := &v1beta1.ShootClassList{}
+	obj, err := c.Fake.
+		Invokes(testing.NewRootListActionWithOptions(shootclassesResource, shootclassesKind, opts), emptyResult)
+	if obj == nil {
+		return emptyResult, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1beta1.ShootClassList{ListMeta: obj.(*v1beta1.ShootClassList).ListMeta}
+	for _, item := range obj.(*v1beta1.ShootClassList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested shootClasses.
+func (c *FakeShootClasses) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewRootWatchActionWithOptions(shootclassesResource, opts))
+}
+
+// Create takes the representation of a shootClass and creates it.  Returns the server's representation of the shootClass, and an error, if there is any.
+func (c *FakeShootClasses) Create(ctx context.Context, shootClass *v1beta1.ShootClass, opts v1.CreateOptions) (result *v1beta1.ShootClass, err error) {
+	emptyResult := &v1beta1.ShootClass{}
+	obj, err := c.Fake.
+		Invokes(testing.NewRootCreateActionWithOptions(shootclassesResource, shootClass, opts), emptyResult)
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1beta1.ShootClass), err
+}
+
+// Update takes the representation of a shootClass and updates it. Returns the server's representation of the shootClass, and an error, if there is any.
+func (c *FakeShootClasses) Update(ctx context.Context, shootClass *v1beta1.ShootClass, opts v1.UpdateOptions) (result *v1beta1.ShootClass, err error) {
+	emptyResult := &v1beta1.ShootClass{}
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateActionWithOptions(shootclassesResource, shootClass, opts), emptyResult)
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1beta1.ShootClass), err
+}
+
+// Delete takes name of the shootClass and deletes it. Returns an error if one occurs.
+func (c *FakeShootClasses) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewRootDeleteActionWithOptions(shootclassesResource, name, opts), &v1beta1.ShootClass{})
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeShootClasses) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewRootDeleteCollectionActionWithOptions(shootclassesResource, opts, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1beta1.ShootClassList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched shootClass.
+func (c *FakeShootClasses) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.ShootClass, err error) {
+	emptyResult := &v1beta1.ShootClass{}
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceActionWithOptions(shootclassesResource, name, pt, data, opts, subresources...), emptyResult)
+	if obj == nil {
+		return emptyResult, err
+	}
+	return obj.(*v1beta1.ShootClass), err
+}
@@ -34,4 +34,6 @@ type SeedExpansion interface{}
 
 type ShootExpansion interface{}
 
+type ShootClassExpansion interface{}
+
 type ShootStateExpansion interface{}
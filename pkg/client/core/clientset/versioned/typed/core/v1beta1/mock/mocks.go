@@ -242,6 +242,20 @@ func (mr *MockCoreV1beta1InterfaceMockRecorder) Seeds() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Seeds", reflect.TypeOf((*MockCoreV1beta1Interface)(nil).Seeds))
 }
 
+// ShootClasses mocks base method.
+func (m *MockCoreV1beta1Interface) ShootClasses() v1beta10.ShootClassInterface {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ShootClasses")
+	ret0, _ := ret[0].(v1beta10.ShootClassInterface)
+	return ret0
+}
+
+// ShootClasses indicates an expected call of ShootClasses.
+func (mr *MockCoreV1beta1InterfaceMockRecorder) ShootClasses() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ShootClasses", reflect.TypeOf((*MockCoreV1beta1Interface)(nil).ShootClasses))
+}
+
 // ShootStates mocks base method.
 func (m *MockCoreV1beta1Interface) ShootStates(namespace string) v1beta10.ShootStateInterface {
 	m.ctrl.T.Helper()
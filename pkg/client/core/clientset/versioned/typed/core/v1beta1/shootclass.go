@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	"context"
+
+	v1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	scheme "github.com/gardener/gardener/pkg/client/core/clientset/versioned/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// ShootClassesGetter has a method to return a ShootClassInterface.
+// A group's client should implement this interface.
+type ShootClassesGetter interface {
+	ShootClasses() ShootClassInterface
+}
+
+// ShootClassInterface has methods to work with ShootClass resources.
+type ShootClassInterface interface {
+	Create(ctx context.Context, shootClass *v1beta1.ShootClass, opts v1.CreateOptions) (*v1beta1.ShootClass, error)
+	Update(ctx context.Context, shootClass *v1beta1.ShootClass, opts v1.UpdateOptions) (*v1beta1.ShootClass, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1beta1.ShootClass, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1beta1.ShootClassList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.ShootClass, err error)
+	ShootClassExpansion
+}
+
+// shootClasses implements ShootClassInterface
+type shootClasses struct {
+	*gentype.ClientWithList[*v1beta1.ShootClass, *v1beta1.ShootClassList]
+}
+
+// newShootClasses returns a ShootClasses
+func newShootClasses(c *CoreV1beta1Client) *shootClasses {
+	return &shootClasses{
+		gentype.NewClientWithList[*v1beta1.ShootClass, *v1beta1.ShootClassList](
+			"shootclasses",
+			c.RESTClient(),
+			scheme.ParameterCodec,
+			"",
+			func() *v1beta1.ShootClass { return &v1beta1.ShootClass{} },
+			func() *v1beta1.ShootClassList { return &v1beta1.ShootClassList{} }),
+	}
+}
@@ -40,6 +40,8 @@ type Interface interface {
 	Seeds() SeedInformer
 	// Shoots returns a ShootInformer.
 	Shoots() ShootInformer
+	// ShootClasses returns a ShootClassInformer.
+	ShootClasses() ShootClassInformer
 	// ShootStates returns a ShootStateInformer.
 	ShootStates() ShootStateInformer
 }
@@ -125,6 +127,11 @@ func (v *version) Shoots() ShootInformer {
 	return &shootInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// ShootClasses returns a ShootClassInformer.
+func (v *version) ShootClasses() ShootClassInformer {
+	return &shootClassInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // ShootStates returns a ShootStateInformer.
 func (v *version) ShootStates() ShootStateInformer {
 	return &shootStateInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
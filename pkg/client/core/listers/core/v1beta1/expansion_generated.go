@@ -86,6 +86,10 @@ type ShootListerExpansion interface{}
 // ShootNamespaceLister.
 type ShootNamespaceListerExpansion interface{}
 
+// ShootClassListerExpansion allows custom methods to be added to
+// ShootClassLister.
+type ShootClassListerExpansion interface{}
+
 // ShootStateListerExpansion allows custom methods to be added to
 // ShootStateLister.
 type ShootStateListerExpansion interface{}
@@ -0,0 +1,36 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/listers"
+	"k8s.io/client-go/tools/cache"
+)
+
+// ShootClassLister helps list ShootClasses.
+// All objects returned here must be treated as read-only.
+type ShootClassLister interface {
+	// List lists all ShootClasses in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.ShootClass, err error)
+	// Get retrieves the ShootClass from the index for a given name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1beta1.ShootClass, error)
+	ShootClassListerExpansion
+}
+
+// shootClassLister implements the ShootClassLister interface.
+type shootClassLister struct {
+	listers.ResourceIndexer[*v1beta1.ShootClass]
+}
+
+// NewShootClassLister returns a new ShootClassLister.
+func NewShootClassLister(indexer cache.Indexer) ShootClassLister {
+	return &shootClassLister{listers.New[*v1beta1.ShootClass](indexer, v1beta1.Resource("shootclass"))}
+}
@@ -322,11 +322,16 @@ func newClientSet(conf *Config) (Interface, error) {
 				return nil, err
 			}
 
-			runtimeClient = &FallbackClient{
-				Client: cachedClient,
-				Reader: runtimeAPIReader,
+			runtimeClient = &informerClient{
+				Client: &FallbackClient{
+					Client: cachedClient,
+					Reader: runtimeAPIReader,
+				},
+				informers: runtimeCache,
 			}
 		}
+	} else if conf.runtimeCache != nil {
+		runtimeClient = &informerClient{Client: runtimeClient, informers: runtimeCache}
 	}
 
 	// prepare rest config with contentType defaulted to protobuf for client-go style clients that either talk to
@@ -392,6 +397,18 @@ func newClient(conf *Config, reader client.Reader) (client.Client, error) {
 	return client.New(conf.restConfig, conf.clientOptions)
 }
 
+// informerClient is a client.Client whose reads are served by the informers of the given cache. It exposes them so
+// that callers can react on changes observed by the informers instead of polling objects, see health.InformerClient.
+type informerClient struct {
+	client.Client
+	informers cache.Informers
+}
+
+// Informers returns the informers of the cache which serves the reads of the client.
+func (c *informerClient) Informers() cache.Informers {
+	return c.informers
+}
+
 var _ client.Client = &FallbackClient{}
 
 // FallbackClient holds a `client.Client` and a `client.Reader` which is meant as a fallback
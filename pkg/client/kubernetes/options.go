@@ -65,7 +65,8 @@ func WithRuntimeClient(runtimeClient client.Client) ConfigFunc {
 	}
 }
 
-// WithRuntimeCache returns a ConfigFunc that sets the passed runtimeCache on the Config object.
+// WithRuntimeCache returns a ConfigFunc that sets the passed runtimeCache on the Config object. If a runtime client is
+// passed as well (see WithRuntimeClient), the cache is expected to serve the reads of this client.
 func WithRuntimeCache(runtimeCache cache.Cache) ConfigFunc {
 	return func(config *Config) error {
 		config.runtimeCache = runtimeCache
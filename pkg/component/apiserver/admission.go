@@ -56,6 +56,10 @@ const (
 	volumeNameAdmissionKubeconfigSecrets      = "admission-kubeconfigs"
 	volumeMountPathAdmissionConfiguration     = "/etc/kubernetes/admission"
 	volumeMountPathAdmissionKubeconfigSecrets = "/etc/kubernetes/admission-kubeconfigs" // #nosec G101 -- No credential.
+
+	// DataKeyConfigMapAdmissionPluginConfig is the key of the ConfigMap containing the configuration of an admission
+	// plugin.
+	DataKeyConfigMapAdmissionPluginConfig = "config.yaml"
 )
 
 // ReconcileSecretAdmissionKubeconfigs reconciles the secret containing the kubeconfig for admission plugins.
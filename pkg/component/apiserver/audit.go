@@ -143,6 +143,10 @@ func InjectAuditSettings(deployment *appsv1.Deployment, configMapAuditPolicy *co
 		deployment.Spec.Template.Spec.Containers[0].Args = append(deployment.Spec.Template.Spec.Containers[0].Args, fmt.Sprintf("--audit-webhook-batch-max-size=%d", *v))
 	}
 
+	if v := auditConfig.Webhook.BatchMaxWait; v != nil {
+		deployment.Spec.Template.Spec.Containers[0].Args = append(deployment.Spec.Template.Spec.Containers[0].Args, "--audit-webhook-batch-max-wait="+v.String())
+	}
+
 	if v := auditConfig.Webhook.Version; v != nil {
 		deployment.Spec.Template.Spec.Containers[0].Args = append(deployment.Spec.Template.Spec.Containers[0].Args, "--audit-webhook-version="+*v)
 	}
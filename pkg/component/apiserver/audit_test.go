@@ -6,6 +6,7 @@ package apiserver_test
 
 import (
 	"context"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -222,6 +223,7 @@ rules:
 			InjectAuditSettings(deployment, configMapAuditPolicy, secretWebhookKubeconfig, &AuditConfig{Webhook: &AuditWebhook{
 				Kubeconfig:   []byte("foo"),
 				BatchMaxSize: ptr.To[int32](2),
+				BatchMaxWait: ptr.To(5 * time.Second),
 				Version:      ptr.To("bar"),
 			}})
 
@@ -234,6 +236,7 @@ rules:
 									"--audit-policy-file=/etc/kubernetes/audit/audit-policy.yaml",
 									"--audit-webhook-config-file=/etc/kubernetes/webhook/audit/kubeconfig.yaml",
 									"--audit-webhook-batch-max-size=2",
+									"--audit-webhook-batch-max-wait=5s",
 									"--audit-webhook-version=bar",
 								},
 								VolumeMounts: []corev1.VolumeMount{
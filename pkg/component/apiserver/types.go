@@ -5,6 +5,8 @@
 package apiserver
 
 import (
+	"time"
+
 	"github.com/Masterminds/semver/v3"
 	corev1 "k8s.io/api/core/v1"
 
@@ -72,6 +74,8 @@ type AuditWebhook struct {
 	Kubeconfig []byte
 	// BatchMaxSize is the maximum size of a batch.
 	BatchMaxSize *int32
+	// BatchMaxWait is the amount of time to wait before force writing the batch that had not reached the max size.
+	BatchMaxWait *time.Duration
 	// Version is the API group and version used for serializing audit events written to webhook.
 	Version *string
 }
@@ -7,9 +7,13 @@ package clusterautoscaler
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"slices"
+	"strings"
 
 	"github.com/Masterminds/semver/v3"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"gopkg.in/yaml.v3"
 	appsv1 "k8s.io/api/apps/v1"
 	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -49,6 +53,9 @@ const (
 
 	portNameMetrics       = "metrics"
 	portMetrics     int32 = 8085
+
+	configMapNamePriorityExpander = "cluster-autoscaler-priority-expander"
+	configMapDataKeyPriorities    = "priorities"
 )
 
 // Interface contains functions for a cluster-autoscaler deployer.
@@ -450,7 +457,7 @@ func (c *clusterAutoscaler) computeCommand() []string {
 	gardencorev1beta1.SetDefaults_ClusterAutoscaler(c.config)
 
 	command = append(command,
-		fmt.Sprintf("--expander=%s", *c.config.Expander),
+		fmt.Sprintf("--expander=%s", c.computeExpander()),
 		fmt.Sprintf("--max-graceful-termination-sec=%d", *c.config.MaxGracefulTerminationSeconds),
 		fmt.Sprintf("--max-node-provision-time=%s", c.config.MaxNodeProvisionTime.Duration),
 		fmt.Sprintf("--scale-down-utilization-threshold=%f", *c.config.ScaleDownUtilizationThreshold),
@@ -485,6 +492,53 @@ func (c *clusterAutoscaler) computeCommand() []string {
 	return command
 }
 
+// computeExpander returns the expander configured in the shoot specification. If at least one machine deployment has a
+// priority, the priority expander is prepended so that the configured expander is only used to break ties.
+func (c *clusterAutoscaler) computeExpander() string {
+	expander := string(*c.config.Expander)
+
+	if !c.hasMachineDeploymentPriorities() || slices.Contains(strings.Split(expander, ","), string(gardencorev1beta1.ClusterAutoscalerExpanderPriority)) {
+		return expander
+	}
+
+	return string(gardencorev1beta1.ClusterAutoscalerExpanderPriority) + "," + expander
+}
+
+func (c *clusterAutoscaler) hasMachineDeploymentPriorities() bool {
+	return slices.ContainsFunc(c.machineDeployments, func(machineDeployment extensionsv1alpha1.MachineDeployment) bool {
+		return machineDeployment.Priority != nil
+	})
+}
+
+// computePriorityExpanderConfigMap computes the configuration of the priority expander which is read by the
+// cluster-autoscaler from the kube-system namespace of the shoot. Machine deployments without a priority are considered
+// with priority 0. It returns nil if no machine deployment has a priority.
+func (c *clusterAutoscaler) computePriorityExpanderConfigMap() (*corev1.ConfigMap, error) {
+	if !c.hasMachineDeploymentPriorities() {
+		return nil, nil
+	}
+
+	priorities := make(map[int32][]string)
+	for _, machineDeployment := range c.machineDeployments {
+		priority := ptr.Deref(machineDeployment.Priority, 0)
+		// The node group ids of the cluster-autoscaler may or may not be qualified with the control plane namespace.
+		priorities[priority] = append(priorities[priority], fmt.Sprintf(`^(%s\.)?%s$`, regexp.QuoteMeta(c.namespace), regexp.QuoteMeta(machineDeployment.Name)))
+	}
+
+	data, err := yaml.Marshal(priorities)
+	if err != nil {
+		return nil, fmt.Errorf("failed marshalling priority expander configuration: %w", err)
+	}
+
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      configMapNamePriorityExpander,
+			Namespace: metav1.NamespaceSystem,
+		},
+		Data: map[string]string{configMapDataKeyPriorities: string(data)},
+	}, nil
+}
+
 func (c *clusterAutoscaler) computeShootResourcesData(serviceAccountName string) (map[string][]byte, error) {
 	var (
 		registry = managedresources.NewRegistry(kubernetes.ShootScheme, kubernetes.ShootCodec, kubernetes.ShootSerializer)
@@ -618,10 +672,20 @@ func (c *clusterAutoscaler) computeShootResourcesData(serviceAccountName string)
 		}
 	)
 
-	return registry.AddAllAndSerialize(
+	objects := []client.Object{
 		clusterRole,
 		clusterRoleBinding,
 		role,
 		rolebinding,
-	)
+	}
+
+	priorityExpanderConfigMap, err := c.computePriorityExpanderConfigMap()
+	if err != nil {
+		return nil, err
+	}
+	if priorityExpanderConfigMap != nil {
+		objects = append(objects, priorityExpanderConfigMap)
+	}
+
+	return registry.AddAllAndSerialize(objects...)
 }
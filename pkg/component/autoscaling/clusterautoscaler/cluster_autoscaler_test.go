@@ -661,6 +661,34 @@ var _ = Describe("ClusterAutoscaler", func() {
 			It("w/ config, kubernetes version < 1.26", func() { test(true, false) })
 			It("w/ config, kubernetes version >= 1.26", func() { test(true, true) })
 		})
+
+		It("should configure the priority expander if machine deployments have priorities", func() {
+			clusterAutoscaler = New(fakeClient, namespace, sm, image, replicas, nil, 0, semver.MustParse("1.26.1"))
+			clusterAutoscaler.SetNamespaceUID(namespaceUID)
+			clusterAutoscaler.SetMachineDeployments([]extensionsv1alpha1.MachineDeployment{
+				{Name: machineDeployment1Name, Minimum: machineDeployment1Min, Maximum: machineDeployment1Max, Priority: ptr.To[int32](20)},
+				{Name: machineDeployment2Name, Minimum: machineDeployment2Min, Maximum: machineDeployment2Max},
+			})
+
+			Expect(clusterAutoscaler.Deploy(ctx)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+			Expect(managedResource).To(consistOf(clusterRoleShoot, clusterRoleBindingShoot, roleShoot, roleBindingShoot, &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "cluster-autoscaler-priority-expander",
+					Namespace: "kube-system",
+				},
+				Data: map[string]string{"priorities": `0:
+    - ^(` + namespace + `\.)?pool2$
+20:
+    - ^(` + namespace + `\.)?pool1$
+`},
+			}))
+
+			actualDeployment := &appsv1.Deployment{}
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: "cluster-autoscaler"}, actualDeployment)).To(Succeed())
+			Expect(actualDeployment.Spec.Template.Spec.Containers[0].Command).To(ContainElement("--expander=priority,least-waste"))
+		})
 	})
 
 	Describe("#Destroy", func() {
@@ -6,8 +6,10 @@ package etcd
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"path"
 	"strconv"
 	"strings"
 	"time"
@@ -77,6 +79,14 @@ const (
 	statefulSetNamePrefix      = "etcd"
 	containerNameEtcd          = "etcd"
 	containerNameBackupRestore = "backup-restore"
+
+	// DefragmentationScheduleSuspended is a cron schedule which never triggers (there is no 30th of February). It is
+	// used while the defragmentation of etcd is suspended.
+	DefragmentationScheduleSuspended = "0 0 30 2 *"
+
+	// annotationKeyFullSnapshotScheduleOverridden is the key of an annotation on the Etcd resource which states that its
+	// full snapshot schedule was configured explicitly (see BackupConfig.FullSnapshotScheduleOverridden).
+	annotationKeyFullSnapshotScheduleOverridden = "etcd.gardener.cloud/full-snapshot-schedule-overridden"
 )
 
 var (
@@ -89,6 +99,9 @@ type Interface interface {
 	component.DeployWaiter
 	// Snapshot triggers the backup-restore sidecar to perform a full snapshot in case backup configuration is provided.
 	Snapshot(context.Context, rest.HTTPClient) error
+	// FullSnapshot triggers the backup-restore sidecar to perform an out-of-schedule full snapshot in case backup
+	// configuration is provided. It returns information about the taken snapshot.
+	FullSnapshot(context.Context, rest.HTTPClient) (*SnapshotInfo, error)
 	// SetBackupConfig sets the backup configuration.
 	SetBackupConfig(config *BackupConfig)
 	// Get retrieves the Etcd resource
@@ -150,6 +163,7 @@ type Values struct {
 	StorageCapacity             string
 	StorageClassName            *string
 	DefragmentationSchedule     *string
+	DefragmentationSuspended    bool
 	CARotationPhase             gardencorev1beta1.CredentialsRotationPhase
 	RuntimeKubernetesVersion    *semver.Version
 	BackupConfig                *BackupConfig
@@ -158,6 +172,10 @@ type Values struct {
 	PriorityClassName           string
 	HighAvailabilityEnabled     bool
 	TopologyAwareRoutingEnabled bool
+	SizeClass                   gardencorev1beta1.ShootSizeClass
+	// SizeClassUpdateAllowed specifies whether the resource requests of an existing etcd may be adapted to a changed
+	// size class. Changing them rolls the etcd pods, hence this should only be allowed during maintenance.
+	SizeClassUpdateAllowed bool
 }
 
 func (e *etcd) Deploy(ctx context.Context) error {
@@ -177,7 +195,7 @@ func (e *etcd) Deploy(ctx context.Context) error {
 
 		replicas = e.computeReplicas(existingEtcd)
 
-		resourcesEtcd, resourcesBackupRestore = e.computeContainerResources()
+		resourcesEtcd, resourcesBackupRestore = e.computeContainerResources(existingEtcd)
 		garbageCollectionPolicy               = druidv1alpha1.GarbageCollectionPolicy(druidv1alpha1.GarbageCollectionPolicyExponential)
 		garbageCollectionPeriod               = metav1.Duration{Duration: 12 * time.Hour}
 		compressionPolicy                     = druidv1alpha1.GzipCompression
@@ -385,6 +403,11 @@ func (e *etcd) Deploy(ctx context.Context) error {
 				Prefix:    fmt.Sprintf("%s/etcd-%s", e.values.BackupConfig.Prefix, e.values.Role),
 			}
 			e.etcd.Spec.Backup.FullSnapshotSchedule = e.computeFullSnapshotSchedule(existingEtcd)
+			if e.values.BackupConfig.FullSnapshotScheduleOverridden {
+				metav1.SetMetaDataAnnotation(&e.etcd.ObjectMeta, annotationKeyFullSnapshotScheduleOverridden, "true")
+			} else {
+				delete(e.etcd.Annotations, annotationKeyFullSnapshotScheduleOverridden)
+			}
 			e.etcd.Spec.Backup.DeltaSnapshotPeriod = &deltaSnapshotPeriod
 			e.etcd.Spec.Backup.DeltaSnapshotMemoryLimit = ptr.To(resource.MustParse("100Mi"))
 			e.etcd.Spec.Backup.DeltaSnapshotRetentionPeriod = e.values.BackupConfig.DeltaSnapshotRetentionPeriod
@@ -814,23 +837,75 @@ func (e *etcd) reconcileVerticalPodAutoscaler(ctx context.Context, vpa *vpaautos
 }
 
 func (e *etcd) Snapshot(ctx context.Context, httpClient rest.HTTPClient) error {
+	resp, err := e.triggerFullSnapshot(ctx, httpClient, true)
+	if err != nil {
+		return err
+	}
+	if resp != nil && resp.Body != nil {
+		_ = resp.Body.Close()
+	}
+	return nil
+}
+
+func (e *etcd) FullSnapshot(ctx context.Context, httpClient rest.HTTPClient) (*SnapshotInfo, error) {
+	resp, err := e.triggerFullSnapshot(ctx, httpClient, false)
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil || resp.Body == nil {
+		return nil, fmt.Errorf("response of ETCD snapshot does not contain any information about the snapshot")
+	}
+	defer resp.Body.Close()
+
+	snapshot := &backupRestoreSnapshot{}
+	if err := json.NewDecoder(resp.Body).Decode(snapshot); err != nil {
+		return nil, fmt.Errorf("failed decoding response of ETCD snapshot: %w", err)
+	}
+
+	return &SnapshotInfo{
+		Name:         snapshot.SnapName,
+		Location:     path.Join(e.values.BackupConfig.Container, snapshot.Prefix, snapshot.SnapDir, snapshot.SnapName),
+		LastRevision: snapshot.LastRevision,
+		CreatedOn:    snapshot.CreatedOn,
+	}, nil
+}
+
+func (e *etcd) triggerFullSnapshot(ctx context.Context, httpClient rest.HTTPClient, final bool) (*http.Response, error) {
 	if e.values.BackupConfig == nil {
-		return fmt.Errorf("no backup is configured for this etcd, cannot make a snapshot")
+		return nil, fmt.Errorf("no backup is configured for this etcd, cannot make a snapshot")
 	}
 
-	url := fmt.Sprintf("https://%s%s.%s:%d/snapshot/full?final=true", e.values.NamePrefix, etcdconstants.ServiceName(e.values.Role), e.namespace, etcdconstants.PortBackupRestore)
+	url := fmt.Sprintf("https://%s%s.%s:%d/snapshot/full", e.values.NamePrefix, etcdconstants.ServiceName(e.values.Role), e.namespace, etcdconstants.PortBackupRestore)
+	if final {
+		url += "?final=true"
+	}
 
 	request, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	resp, err := httpClient.Do(request)
-	if err == nil && resp != nil && resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("error occurred while initiating ETCD snapshot: %s", resp.Status)
+	if err != nil {
+		return nil, err
+	}
+	if resp != nil && resp.StatusCode != http.StatusOK {
+		if resp.Body != nil {
+			_ = resp.Body.Close()
+		}
+		return nil, fmt.Errorf("error occurred while initiating ETCD snapshot: %s", resp.Status)
 	}
 
-	return err
+	return resp, nil
+}
+
+// backupRestoreSnapshot is the subset of the snapshot information returned by the backup-restore sidecar.
+type backupRestoreSnapshot struct {
+	SnapDir      string    `json:"snapDir"`
+	SnapName     string    `json:"snapName"`
+	Prefix       string    `json:"prefix"`
+	LastRevision int64     `json:"lastRevision"`
+	CreatedOn    time.Time `json:"createdOn"`
 }
 
 func (e *etcd) clientServiceDNSNames() []string {
@@ -939,18 +1014,38 @@ func (e *etcd) GetReplicas() *int32 { return e.values.Replicas }
 
 func (e *etcd) SetReplicas(replicas *int32) { e.values.Replicas = replicas }
 
-func (e *etcd) computeContainerResources() (*corev1.ResourceRequirements, *corev1.ResourceRequirements) {
+// computeContainerResources computes the resource requests of the containers. The requests of the etcd container depend
+// on the size class. The requests of an existing etcd are only adapted if this is allowed (see SizeClassUpdateAllowed).
+func (e *etcd) computeContainerResources(existingEtcd *druidv1alpha1.Etcd) (*corev1.ResourceRequirements, *corev1.ResourceRequirements) {
+	resourcesBackupRestore := &corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse("10m"),
+			corev1.ResourceMemory: resource.MustParse("40Mi"),
+		},
+	}
+
+	if existingEtcd != nil && existingEtcd.Spec.Etcd.Resources != nil && !e.values.SizeClassUpdateAllowed {
+		return existingEtcd.Spec.Etcd.Resources, resourcesBackupRestore
+	}
+
+	var cpu, memory string
+	switch e.values.SizeClass {
+	case gardencorev1beta1.ShootSizeClassMedium:
+		cpu, memory = "500m", "2G"
+	case gardencorev1beta1.ShootSizeClassLarge:
+		cpu, memory = "1", "4G"
+	case gardencorev1beta1.ShootSizeClassExtraLarge:
+		cpu, memory = "2", "8G"
+	default:
+		cpu, memory = "300m", "1G"
+	}
+
 	return &corev1.ResourceRequirements{
-			Requests: corev1.ResourceList{
-				corev1.ResourceCPU:    resource.MustParse("300m"),
-				corev1.ResourceMemory: resource.MustParse("1G"),
-			},
-		}, &corev1.ResourceRequirements{
-			Requests: corev1.ResourceList{
-				corev1.ResourceCPU:    resource.MustParse("10m"),
-				corev1.ResourceMemory: resource.MustParse("40Mi"),
-			},
-		}
+		Requests: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse(cpu),
+			corev1.ResourceMemory: resource.MustParse(memory),
+		},
+	}, resourcesBackupRestore
 }
 
 func (e *etcd) computeReplicas(existingEtcd *druidv1alpha1.Etcd) int32 {
@@ -965,8 +1060,12 @@ func (e *etcd) computeReplicas(existingEtcd *druidv1alpha1.Etcd) int32 {
 }
 
 func (e *etcd) computeDefragmentationSchedule(existingEtcd *druidv1alpha1.Etcd) *string {
+	if e.values.DefragmentationSuspended {
+		return ptr.To(DefragmentationScheduleSuspended)
+	}
+
 	defragmentationSchedule := e.values.DefragmentationSchedule
-	if existingEtcd != nil && existingEtcd.Spec.Etcd.DefragmentationSchedule != nil {
+	if existingEtcd != nil && existingEtcd.Spec.Etcd.DefragmentationSchedule != nil && *existingEtcd.Spec.Etcd.DefragmentationSchedule != DefragmentationScheduleSuspended {
 		defragmentationSchedule = existingEtcd.Spec.Etcd.DefragmentationSchedule
 	}
 	return defragmentationSchedule
@@ -974,7 +1073,11 @@ func (e *etcd) computeDefragmentationSchedule(existingEtcd *druidv1alpha1.Etcd)
 
 func (e *etcd) computeFullSnapshotSchedule(existingEtcd *druidv1alpha1.Etcd) *string {
 	fullSnapshotSchedule := &e.values.BackupConfig.FullSnapshotSchedule
-	if existingEtcd != nil && existingEtcd.Spec.Backup.FullSnapshotSchedule != nil {
+	// An existing schedule is kept unless the schedule is configured explicitly or the existing schedule was configured
+	// explicitly before (i.e., the explicit configuration was removed in the meantime).
+	if !e.values.BackupConfig.FullSnapshotScheduleOverridden &&
+		existingEtcd != nil && existingEtcd.Spec.Backup.FullSnapshotSchedule != nil &&
+		!metav1.HasAnnotation(existingEtcd.ObjectMeta, annotationKeyFullSnapshotScheduleOverridden) {
 		fullSnapshotSchedule = existingEtcd.Spec.Backup.FullSnapshotSchedule
 	}
 	return fullSnapshotSchedule
@@ -1009,6 +1112,18 @@ func (e *etcd) handlePeerCertificates(ctx context.Context) (caSecretName, peerSe
 	return
 }
 
+// SnapshotInfo contains information about a snapshot taken by the backup-restore sidecar.
+type SnapshotInfo struct {
+	// Name is the name of the snapshot.
+	Name string
+	// Location is the location of the snapshot in the blob storage bucket (<bucket>/<path>).
+	Location string
+	// LastRevision is the last etcd revision contained in the snapshot.
+	LastRevision int64
+	// CreatedOn is the time when the snapshot was created.
+	CreatedOn time.Time
+}
+
 // BackupConfig contains information for configuring the backup-restore sidecar so that it takes regularly backups of
 // the etcd's data directory.
 type BackupConfig struct {
@@ -1022,6 +1137,10 @@ type BackupConfig struct {
 	Prefix string
 	// FullSnapshotSchedule is a cron schedule that declares how frequent full snapshots shall be taken.
 	FullSnapshotSchedule string
+	// FullSnapshotScheduleOverridden states whether the full snapshot schedule was configured explicitly (e.g., in the
+	// Shoot specification). If true, the schedule is always applied. Otherwise, the schedule of an existing Etcd
+	// resource is kept.
+	FullSnapshotScheduleOverridden bool
 	// LeaderElection contains configuration for the leader election for the etcd backup-restore sidecar.
 	LeaderElection *gardenletconfig.ETCDBackupLeaderElection
 	// DeltaSnapshotRetentionPeriod defines the duration for which delta snapshots will be retained, excluding the latest snapshot set.
@@ -8,8 +8,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/Masterminds/semver/v3"
@@ -88,6 +90,7 @@ var _ = Describe("Etcd", func() {
 			End:   "5678",
 		}
 		highAvailabilityEnabled bool
+		sizeClass               gardencorev1beta1.ShootSizeClass
 		caRotationPhase         gardencorev1beta1.CredentialsRotationPhase
 		backupConfig            *BackupConfig
 		now                     = time.Time{}
@@ -330,6 +333,9 @@ var _ = Describe("Etcd", func() {
 				obj.Spec.Backup.FullSnapshotSchedule = &fullSnapshotSchedule
 				obj.Spec.Backup.DeltaSnapshotPeriod = &deltaSnapshotPeriod
 				obj.Spec.Backup.DeltaSnapshotRetentionPeriod = &metav1.Duration{Duration: 15 * 24 * time.Hour}
+				if backupConfig.FullSnapshotScheduleOverridden {
+					metav1.SetMetaDataAnnotation(&obj.ObjectMeta, "etcd.gardener.cloud/full-snapshot-schedule-overridden", "true")
+				}
 				obj.Spec.Backup.DeltaSnapshotMemoryLimit = &deltaSnapshotMemoryLimit
 
 				if backupConfig.LeaderElection != nil {
@@ -665,6 +671,7 @@ var _ = Describe("Etcd", func() {
 		sm = fakesecretsmanager.New(fakeClient, testNamespace)
 		backupConfig = nil
 		replicas = ptr.To[int32](1)
+		sizeClass = ""
 	})
 
 	JustBeforeEach(func() {
@@ -686,6 +693,7 @@ var _ = Describe("Etcd", func() {
 			MaintenanceTimeWindow:   maintenanceTimeWindow,
 			HighAvailabilityEnabled: highAvailabilityEnabled,
 			BackupConfig:            backupConfig,
+			SizeClass:               sizeClass,
 		})
 	})
 
@@ -1027,6 +1035,146 @@ var _ = Describe("Etcd", func() {
 			Expect(etcd.Deploy(ctx)).To(Succeed())
 		})
 
+		It("should successfully deploy (normal etcd) and suspend the defragmentation", func() {
+			etcd = New(log, c, testNamespace, sm, Values{
+				Role:                     testRole,
+				Class:                    class,
+				Replicas:                 replicas,
+				StorageCapacity:          storageCapacity,
+				StorageClassName:         &storageClassName,
+				DefragmentationSchedule:  &defragmentationSchedule,
+				DefragmentationSuspended: true,
+				PriorityClassName:        priorityClassName,
+				MaintenanceTimeWindow:    maintenanceTimeWindow,
+			})
+
+			oldTimeNow := TimeNow
+			defer func() { TimeNow = oldTimeNow }()
+			TimeNow = func() time.Time { return now }
+
+			existingDefragmentationSchedule := "foobardefragexisting"
+
+			gomock.InOrder(
+				c.EXPECT().Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: etcdName}, gomock.AssignableToTypeOf(&druidv1alpha1.Etcd{})).DoAndReturn(func(_ context.Context, _ client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+					(&druidv1alpha1.Etcd{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      etcdName,
+							Namespace: testNamespace,
+						},
+						Spec: druidv1alpha1.EtcdSpec{
+							Etcd: druidv1alpha1.EtcdConfig{
+								DefragmentationSchedule: &existingDefragmentationSchedule,
+							},
+						},
+						Status: druidv1alpha1.EtcdStatus{
+							Etcd: &druidv1alpha1.CrossVersionObjectReference{
+								Name: etcdName,
+							},
+						},
+					}).DeepCopyInto(obj.(*druidv1alpha1.Etcd))
+					return nil
+				}),
+				c.EXPECT().Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: etcdName}, gomock.AssignableToTypeOf(&druidv1alpha1.Etcd{})),
+				c.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&druidv1alpha1.Etcd{}), gomock.Any()).Do(func(_ context.Context, obj *druidv1alpha1.Etcd, _ client.Patch, _ ...client.PatchOption) {
+					// ignore status when comparing
+					obj.Status = druidv1alpha1.EtcdStatus{}
+
+					Expect(obj).To(DeepEqual(etcdObjFor(
+						class,
+						1,
+						nil,
+						DefragmentationScheduleSuspended,
+						"",
+						nil,
+						nil,
+						secretNameCA,
+						secretNameClient,
+						secretNameServer,
+						nil,
+						nil,
+						false)))
+				}),
+				c.EXPECT().Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: vpaName}, gomock.AssignableToTypeOf(&vpaautoscalingv1.VerticalPodAutoscaler{})).Return(apierrors.NewNotFound(schema.GroupResource{}, "")),
+				c.EXPECT().Create(ctx, gomock.AssignableToTypeOf(&vpaautoscalingv1.VerticalPodAutoscaler{}), gomock.Any()).Do(func(_ context.Context, obj client.Object, _ ...client.CreateOption) {
+					Expect(obj).To(DeepEqual(expectedVPAFor(class, "")))
+				}),
+				c.EXPECT().Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: "shoot-etcd-" + testRole}, gomock.AssignableToTypeOf(&monitoringv1.ServiceMonitor{})),
+				c.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&monitoringv1.ServiceMonitor{}), gomock.Any()).Do(func(_ context.Context, obj client.Object, _ client.Patch, _ ...client.PatchOption) {
+					Expect(obj).To(DeepEqual(serviceMonitor("shoot", "etcd-client")))
+				}),
+				c.EXPECT().Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: "shoot-etcd-" + testRole}, gomock.AssignableToTypeOf(&monitoringv1.PrometheusRule{})),
+				c.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&monitoringv1.PrometheusRule{}), gomock.Any()).Do(func(_ context.Context, obj client.Object, _ client.Patch, _ ...client.PatchOption) {
+					Expect(obj).To(DeepEqual(prometheusRule("shoot", class, 1, false)))
+				}),
+			)
+
+			Expect(etcd.Deploy(ctx)).To(Succeed())
+		})
+
+		It("should successfully deploy (normal etcd) and resume the suspended defragmentation", func() {
+			oldTimeNow := TimeNow
+			defer func() { TimeNow = oldTimeNow }()
+			TimeNow = func() time.Time { return now }
+
+			existingDefragmentationSchedule := DefragmentationScheduleSuspended
+
+			gomock.InOrder(
+				c.EXPECT().Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: etcdName}, gomock.AssignableToTypeOf(&druidv1alpha1.Etcd{})).DoAndReturn(func(_ context.Context, _ client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+					(&druidv1alpha1.Etcd{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      etcdName,
+							Namespace: testNamespace,
+						},
+						Spec: druidv1alpha1.EtcdSpec{
+							Etcd: druidv1alpha1.EtcdConfig{
+								DefragmentationSchedule: &existingDefragmentationSchedule,
+							},
+						},
+						Status: druidv1alpha1.EtcdStatus{
+							Etcd: &druidv1alpha1.CrossVersionObjectReference{
+								Name: etcdName,
+							},
+						},
+					}).DeepCopyInto(obj.(*druidv1alpha1.Etcd))
+					return nil
+				}),
+				c.EXPECT().Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: etcdName}, gomock.AssignableToTypeOf(&druidv1alpha1.Etcd{})),
+				c.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&druidv1alpha1.Etcd{}), gomock.Any()).Do(func(_ context.Context, obj *druidv1alpha1.Etcd, _ client.Patch, _ ...client.PatchOption) {
+					// ignore status when comparing
+					obj.Status = druidv1alpha1.EtcdStatus{}
+
+					Expect(obj).To(DeepEqual(etcdObjFor(
+						class,
+						1,
+						nil,
+						defragmentationSchedule,
+						"",
+						nil,
+						nil,
+						secretNameCA,
+						secretNameClient,
+						secretNameServer,
+						nil,
+						nil,
+						false)))
+				}),
+				c.EXPECT().Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: vpaName}, gomock.AssignableToTypeOf(&vpaautoscalingv1.VerticalPodAutoscaler{})).Return(apierrors.NewNotFound(schema.GroupResource{}, "")),
+				c.EXPECT().Create(ctx, gomock.AssignableToTypeOf(&vpaautoscalingv1.VerticalPodAutoscaler{}), gomock.Any()).Do(func(_ context.Context, obj client.Object, _ ...client.CreateOption) {
+					Expect(obj).To(DeepEqual(expectedVPAFor(class, "")))
+				}),
+				c.EXPECT().Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: "shoot-etcd-" + testRole}, gomock.AssignableToTypeOf(&monitoringv1.ServiceMonitor{})),
+				c.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&monitoringv1.ServiceMonitor{}), gomock.Any()).Do(func(_ context.Context, obj client.Object, _ client.Patch, _ ...client.PatchOption) {
+					Expect(obj).To(DeepEqual(serviceMonitor("shoot", "etcd-client")))
+				}),
+				c.EXPECT().Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: "shoot-etcd-" + testRole}, gomock.AssignableToTypeOf(&monitoringv1.PrometheusRule{})),
+				c.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&monitoringv1.PrometheusRule{}), gomock.Any()).Do(func(_ context.Context, obj client.Object, _ client.Patch, _ ...client.PatchOption) {
+					Expect(obj).To(DeepEqual(prometheusRule("shoot", class, 1, false)))
+				}),
+			)
+
+			Expect(etcd.Deploy(ctx)).To(Succeed())
+		})
+
 		It("should successfully deploy (normal etcd) and not keep the existing resource request settings", func() {
 			oldTimeNow := TimeNow
 			defer func() { TimeNow = oldTimeNow }()
@@ -1068,6 +1216,130 @@ var _ = Describe("Etcd", func() {
 			Expect(etcd.Deploy(ctx)).To(Succeed())
 		})
 
+		Context("with size class", func() {
+			BeforeEach(func() {
+				sizeClass = gardencorev1beta1.ShootSizeClassExtraLarge
+			})
+
+			It("should successfully deploy (normal etcd) with resource requests according to the size class", func() {
+				oldTimeNow := TimeNow
+				defer func() { TimeNow = oldTimeNow }()
+				TimeNow = func() time.Time { return now }
+
+				gomock.InOrder(
+					c.EXPECT().Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: etcdName}, gomock.AssignableToTypeOf(&druidv1alpha1.Etcd{})).Return(apierrors.NewNotFound(schema.GroupResource{}, "")),
+					c.EXPECT().Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: etcdName}, gomock.AssignableToTypeOf(&druidv1alpha1.Etcd{})),
+					c.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&druidv1alpha1.Etcd{}), gomock.Any()).Do(func(_ context.Context, obj client.Object, _ client.Patch, _ ...client.PatchOption) {
+						Expect(obj).To(DeepEqual(etcdObjFor(
+							class,
+							1,
+							nil,
+							"",
+							"",
+							&corev1.ResourceRequirements{
+								Requests: corev1.ResourceList{
+									corev1.ResourceCPU:    resource.MustParse("2"),
+									corev1.ResourceMemory: resource.MustParse("8G"),
+								},
+							},
+							nil,
+							secretNameCA,
+							secretNameClient,
+							secretNameServer,
+							nil,
+							nil,
+							false)))
+					}),
+					c.EXPECT().Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: vpaName}, gomock.AssignableToTypeOf(&vpaautoscalingv1.VerticalPodAutoscaler{})).Return(apierrors.NewNotFound(schema.GroupResource{}, "")),
+					c.EXPECT().Create(ctx, gomock.AssignableToTypeOf(&vpaautoscalingv1.VerticalPodAutoscaler{}), gomock.Any()).Do(func(_ context.Context, obj client.Object, _ ...client.CreateOption) {
+						Expect(obj).To(DeepEqual(expectedVPAFor(class, "")))
+					}),
+					c.EXPECT().Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: "shoot-etcd-" + testRole}, gomock.AssignableToTypeOf(&monitoringv1.ServiceMonitor{})),
+					c.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&monitoringv1.ServiceMonitor{}), gomock.Any()).Do(func(_ context.Context, obj client.Object, _ client.Patch, _ ...client.PatchOption) {
+						Expect(obj).To(DeepEqual(serviceMonitor("shoot", "etcd-client")))
+					}),
+					c.EXPECT().Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: "shoot-etcd-" + testRole}, gomock.AssignableToTypeOf(&monitoringv1.PrometheusRule{})),
+					c.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&monitoringv1.PrometheusRule{}), gomock.Any()).Do(func(_ context.Context, obj client.Object, _ client.Patch, _ ...client.PatchOption) {
+						Expect(obj).To(DeepEqual(prometheusRule("shoot", class, 1, false)))
+					}),
+				)
+
+				Expect(etcd.Deploy(ctx)).To(Succeed())
+			})
+
+			test := func(sizeClassUpdateAllowed bool, expectedResources *corev1.ResourceRequirements) {
+				oldTimeNow := TimeNow
+				defer func() { TimeNow = oldTimeNow }()
+				TimeNow = func() time.Time { return now }
+
+				etcd = New(log, c, testNamespace, sm, Values{
+					Role:                    testRole,
+					Class:                   class,
+					Replicas:                replicas,
+					StorageCapacity:         storageCapacity,
+					StorageClassName:        &storageClassName,
+					DefragmentationSchedule: &defragmentationSchedule,
+					PriorityClassName:       priorityClassName,
+					MaintenanceTimeWindow:   maintenanceTimeWindow,
+					SizeClass:               sizeClass,
+					SizeClassUpdateAllowed:  sizeClassUpdateAllowed,
+				})
+
+				gomock.InOrder(
+					c.EXPECT().Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: etcdName}, gomock.AssignableToTypeOf(&druidv1alpha1.Etcd{})).DoAndReturn(func(_ context.Context, _ client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+						(&druidv1alpha1.Etcd{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      etcdName,
+								Namespace: testNamespace,
+							},
+							Spec: druidv1alpha1.EtcdSpec{
+								Replicas: 1,
+								Etcd: druidv1alpha1.EtcdConfig{
+									Resources: &corev1.ResourceRequirements{
+										Requests: corev1.ResourceList{
+											corev1.ResourceCPU:    resource.MustParse("300m"),
+											corev1.ResourceMemory: resource.MustParse("1G"),
+										},
+									},
+								},
+							},
+						}).DeepCopyInto(obj.(*druidv1alpha1.Etcd))
+						return nil
+					}),
+					c.EXPECT().Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: etcdName}, gomock.AssignableToTypeOf(&druidv1alpha1.Etcd{})),
+					c.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&druidv1alpha1.Etcd{}), gomock.Any()).Do(func(_ context.Context, obj *druidv1alpha1.Etcd, _ client.Patch, _ ...client.PatchOption) {
+						Expect(obj.Spec.Etcd.Resources).To(DeepEqual(expectedResources))
+					}),
+					c.EXPECT().Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: vpaName}, gomock.AssignableToTypeOf(&vpaautoscalingv1.VerticalPodAutoscaler{})).Return(apierrors.NewNotFound(schema.GroupResource{}, "")),
+					c.EXPECT().Create(ctx, gomock.AssignableToTypeOf(&vpaautoscalingv1.VerticalPodAutoscaler{}), gomock.Any()),
+					c.EXPECT().Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: "shoot-etcd-" + testRole}, gomock.AssignableToTypeOf(&monitoringv1.ServiceMonitor{})),
+					c.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&monitoringv1.ServiceMonitor{}), gomock.Any()),
+					c.EXPECT().Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: "shoot-etcd-" + testRole}, gomock.AssignableToTypeOf(&monitoringv1.PrometheusRule{})),
+					c.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&monitoringv1.PrometheusRule{}), gomock.Any()),
+				)
+
+				Expect(etcd.Deploy(ctx)).To(Succeed())
+			}
+
+			It("should keep the resource requests of an existing etcd if the update is not allowed", func() {
+				test(false, &corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("300m"),
+						corev1.ResourceMemory: resource.MustParse("1G"),
+					},
+				})
+			})
+
+			It("should adapt the resource requests of an existing etcd if the update is allowed", func() {
+				test(true, &corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("2"),
+						corev1.ResourceMemory: resource.MustParse("8G"),
+					},
+				})
+			})
+		})
+
 		for _, shootPurpose := range []gardencorev1beta1.ShootPurpose{gardencorev1beta1.ShootPurposeEvaluation, gardencorev1beta1.ShootPurposeProduction} {
 			var purpose = shootPurpose
 			It(fmt.Sprintf("should successfully deploy (important etcd): purpose = %q", purpose), func() {
@@ -1251,6 +1523,138 @@ var _ = Describe("Etcd", func() {
 
 				Expect(etcd.Deploy(ctx)).To(Succeed())
 			})
+
+			It("should successfully deploy (with backup) and apply the overridden backup schedule", func() {
+				oldTimeNow := TimeNow
+				defer func() { TimeNow = oldTimeNow }()
+				TimeNow = func() time.Time { return now }
+
+				existingBackupSchedule := "foobarbackupexisting"
+				overriddenBackupConfig := *backupConfig
+				overriddenBackupConfig.FullSnapshotScheduleOverridden = true
+				etcd.SetBackupConfig(&overriddenBackupConfig)
+
+				gomock.InOrder(
+					c.EXPECT().Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: etcdName}, gomock.AssignableToTypeOf(&druidv1alpha1.Etcd{})).DoAndReturn(func(_ context.Context, _ client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+						(&druidv1alpha1.Etcd{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      etcdName,
+								Namespace: testNamespace,
+							},
+							Spec: druidv1alpha1.EtcdSpec{
+								Backup: druidv1alpha1.BackupSpec{
+									FullSnapshotSchedule: &existingBackupSchedule,
+								},
+							},
+							Status: druidv1alpha1.EtcdStatus{
+								Etcd: &druidv1alpha1.CrossVersionObjectReference{
+									Name: "",
+								},
+							},
+						}).DeepCopyInto(obj.(*druidv1alpha1.Etcd))
+						return nil
+					}),
+					c.EXPECT().Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: etcdName}, gomock.AssignableToTypeOf(&druidv1alpha1.Etcd{})),
+					c.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&druidv1alpha1.Etcd{}), gomock.Any()).Do(func(_ context.Context, obj client.Object, _ client.Patch, _ ...client.PatchOption) {
+						expobj := etcdObjFor(
+							class,
+							1,
+							&overriddenBackupConfig,
+							"",
+							"",
+							nil,
+							nil,
+							secretNameCA,
+							secretNameClient,
+							secretNameServer,
+							nil,
+							nil,
+							false)
+						expobj.Status.Etcd = &druidv1alpha1.CrossVersionObjectReference{}
+
+						Expect(obj).To(DeepEqual(expobj))
+					}),
+					c.EXPECT().Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: vpaName}, gomock.AssignableToTypeOf(&vpaautoscalingv1.VerticalPodAutoscaler{})).Return(apierrors.NewNotFound(schema.GroupResource{}, "")),
+					c.EXPECT().Create(ctx, gomock.AssignableToTypeOf(&vpaautoscalingv1.VerticalPodAutoscaler{}), gomock.Any()).Do(func(_ context.Context, obj client.Object, _ ...client.CreateOption) {
+						Expect(obj).To(DeepEqual(expectedVPAFor(class, "")))
+					}),
+					c.EXPECT().Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: "shoot-etcd-" + testRole}, gomock.AssignableToTypeOf(&monitoringv1.ServiceMonitor{})),
+					c.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&monitoringv1.ServiceMonitor{}), gomock.Any()).Do(func(_ context.Context, obj client.Object, _ client.Patch, _ ...client.PatchOption) {
+						Expect(obj).To(DeepEqual(serviceMonitor("shoot", "etcd-client")))
+					}),
+					c.EXPECT().Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: "shoot-etcd-" + testRole}, gomock.AssignableToTypeOf(&monitoringv1.PrometheusRule{})),
+					c.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&monitoringv1.PrometheusRule{}), gomock.Any()).Do(func(_ context.Context, obj client.Object, _ client.Patch, _ ...client.PatchOption) {
+						Expect(obj).To(DeepEqual(prometheusRule("shoot", class, *replicas, true)))
+					}),
+				)
+
+				Expect(etcd.Deploy(ctx)).To(Succeed())
+			})
+
+			It("should successfully deploy (with backup) and replace the existing backup schedule if it was overridden before", func() {
+				oldTimeNow := TimeNow
+				defer func() { TimeNow = oldTimeNow }()
+				TimeNow = func() time.Time { return now }
+
+				existingBackupSchedule := "foobarbackupexisting"
+
+				gomock.InOrder(
+					c.EXPECT().Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: etcdName}, gomock.AssignableToTypeOf(&druidv1alpha1.Etcd{})).DoAndReturn(func(_ context.Context, _ client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+						(&druidv1alpha1.Etcd{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:        etcdName,
+								Namespace:   testNamespace,
+								Annotations: map[string]string{"etcd.gardener.cloud/full-snapshot-schedule-overridden": "true"},
+							},
+							Spec: druidv1alpha1.EtcdSpec{
+								Backup: druidv1alpha1.BackupSpec{
+									FullSnapshotSchedule: &existingBackupSchedule,
+								},
+							},
+							Status: druidv1alpha1.EtcdStatus{
+								Etcd: &druidv1alpha1.CrossVersionObjectReference{
+									Name: "",
+								},
+							},
+						}).DeepCopyInto(obj.(*druidv1alpha1.Etcd))
+						return nil
+					}),
+					c.EXPECT().Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: etcdName}, gomock.AssignableToTypeOf(&druidv1alpha1.Etcd{})),
+					c.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&druidv1alpha1.Etcd{}), gomock.Any()).Do(func(_ context.Context, obj client.Object, _ client.Patch, _ ...client.PatchOption) {
+						expobj := etcdObjFor(
+							class,
+							1,
+							backupConfig,
+							"",
+							"",
+							nil,
+							nil,
+							secretNameCA,
+							secretNameClient,
+							secretNameServer,
+							nil,
+							nil,
+							false)
+						expobj.Status.Etcd = &druidv1alpha1.CrossVersionObjectReference{}
+
+						Expect(obj).To(DeepEqual(expobj))
+					}),
+					c.EXPECT().Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: vpaName}, gomock.AssignableToTypeOf(&vpaautoscalingv1.VerticalPodAutoscaler{})).Return(apierrors.NewNotFound(schema.GroupResource{}, "")),
+					c.EXPECT().Create(ctx, gomock.AssignableToTypeOf(&vpaautoscalingv1.VerticalPodAutoscaler{}), gomock.Any()).Do(func(_ context.Context, obj client.Object, _ ...client.CreateOption) {
+						Expect(obj).To(DeepEqual(expectedVPAFor(class, "")))
+					}),
+					c.EXPECT().Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: "shoot-etcd-" + testRole}, gomock.AssignableToTypeOf(&monitoringv1.ServiceMonitor{})),
+					c.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&monitoringv1.ServiceMonitor{}), gomock.Any()).Do(func(_ context.Context, obj client.Object, _ client.Patch, _ ...client.PatchOption) {
+						Expect(obj).To(DeepEqual(serviceMonitor("shoot", "etcd-client")))
+					}),
+					c.EXPECT().Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: "shoot-etcd-" + testRole}, gomock.AssignableToTypeOf(&monitoringv1.PrometheusRule{})),
+					c.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&monitoringv1.PrometheusRule{}), gomock.Any()).Do(func(_ context.Context, obj client.Object, _ client.Patch, _ ...client.PatchOption) {
+						Expect(obj).To(DeepEqual(prometheusRule("shoot", class, *replicas, true)))
+					}),
+				)
+
+				Expect(etcd.Deploy(ctx)).To(Succeed())
+			})
 		})
 
 		When("HA setup is configured", func() {
@@ -1848,6 +2252,73 @@ var _ = Describe("Etcd", func() {
 		})
 	})
 
+	Describe("#FullSnapshot", func() {
+		It("should return an error when the backup config is nil", func() {
+			_, err := etcd.FullSnapshot(ctx, nil)
+			Expect(err).To(MatchError(ContainSubstring("no backup is configured")))
+		})
+
+		Context("w/ backup configuration", func() {
+			var (
+				mockHttpClient *rest.MockHTTPClient
+				request        *http.Request
+			)
+
+			BeforeEach(func() {
+				mockHttpClient = rest.NewMockHTTPClient(ctrl)
+				backupConfig = &BackupConfig{Container: "bucket"}
+
+				var err error
+				request, err = http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("https://etcd-%s-client.%s:8080/snapshot/full", testRole, testNamespace), nil)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("should successfully take the full snapshot and return its information", func() {
+				mockHttpClient.EXPECT().Do(request).Return(&http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(strings.NewReader(`{"kind":"Full","lastRevision":42,"createdOn":"2024-01-02T03:04:05Z","snapDir":"","snapName":"Full-00000000-00000042-1704164645","prefix":"shoot--foo--bar/etcd-main/v2"}`)),
+				}, nil)
+
+				snapshot, err := etcd.FullSnapshot(ctx, mockHttpClient)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(snapshot).To(Equal(&SnapshotInfo{
+					Name:         "Full-00000000-00000042-1704164645",
+					Location:     "bucket/shoot--foo--bar/etcd-main/v2/Full-00000000-00000042-1704164645",
+					LastRevision: 42,
+					CreatedOn:    time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+				}))
+			})
+
+			It("should return an error when the backup-restore sidecar does not respond with OK", func() {
+				mockHttpClient.EXPECT().Do(request).Return(&http.Response{
+					StatusCode: http.StatusInternalServerError,
+					Status:     "500 Internal Server Error",
+					Body:       io.NopCloser(strings.NewReader("")),
+				}, nil)
+
+				_, err := etcd.FullSnapshot(ctx, mockHttpClient)
+				Expect(err).To(MatchError(ContainSubstring("500 Internal Server Error")))
+			})
+
+			It("should return an error when the response cannot be decoded", func() {
+				mockHttpClient.EXPECT().Do(request).Return(&http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(strings.NewReader("foo")),
+				}, nil)
+
+				_, err := etcd.FullSnapshot(ctx, mockHttpClient)
+				Expect(err).To(MatchError(ContainSubstring("failed decoding response")))
+			})
+
+			It("should return an error when the execution command fails", func() {
+				mockHttpClient.EXPECT().Do(request).Return(nil, fakeErr)
+
+				_, err := etcd.FullSnapshot(ctx, mockHttpClient)
+				Expect(err).To(MatchError(fakeErr))
+			})
+		})
+	})
+
 	Describe("#Scale", func() {
 		var etcdObj *druidv1alpha1.Etcd
 
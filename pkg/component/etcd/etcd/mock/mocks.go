@@ -71,6 +71,21 @@ func (mr *MockInterfaceMockRecorder) Destroy(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Destroy", reflect.TypeOf((*MockInterface)(nil).Destroy), ctx)
 }
 
+// FullSnapshot mocks base method.
+func (m *MockInterface) FullSnapshot(arg0 context.Context, arg1 rest.HTTPClient) (*etcd.SnapshotInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FullSnapshot", arg0, arg1)
+	ret0, _ := ret[0].(*etcd.SnapshotInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FullSnapshot indicates an expected call of FullSnapshot.
+func (mr *MockInterfaceMockRecorder) FullSnapshot(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FullSnapshot", reflect.TypeOf((*MockInterface)(nil).FullSnapshot), arg0, arg1)
+}
+
 // Get mocks base method.
 func (m *MockInterface) Get(arg0 context.Context) (*v1alpha1.Etcd, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,407 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package objectrecovery
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/v1alpha1"
+	"github.com/go-logr/logr"
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/component"
+	"github.com/gardener/gardener/pkg/component/etcd/etcd"
+	etcdconstants "github.com/gardener/gardener/pkg/component/etcd/etcd/constants"
+	"github.com/gardener/gardener/pkg/extensions"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
+)
+
+const (
+	// Name is the name of the temporary etcd which serves the restored snapshot.
+	Name = "etcd-object-recovery"
+	// LabelRoleValue is the value of the role label of the temporary etcd.
+	LabelRoleValue = "object-recovery"
+	// DataKeyArchive is the key in the data of the secret which contains the archive with the recovered objects.
+	DataKeyArchive = "objects.tar.gz"
+	// MaxArchiveSize is the maximum size of the archive with the recovered objects. It must fit into a secret.
+	MaxArchiveSize = 1000 * 1024
+
+	// DefaultInterval is the default interval for retry operations.
+	DefaultInterval = 5 * time.Second
+	// DefaultSevereThreshold is the default threshold until an error reported by another component is treated as 'severe'.
+	DefaultSevereThreshold = 3 * time.Minute
+	// DefaultTimeout is the default timeout and defines how long Gardener should wait for the temporary etcd to be
+	// restored and ready.
+	DefaultTimeout = 15 * time.Minute
+
+	registryPrefix = "/registry/"
+	pageSize       = 500
+)
+
+var (
+	// TimeNow is a function returning the current time exposed for testing.
+	TimeNow = time.Now
+	// NewClient creates a new client for the temporary etcd. It is exposed for testing.
+	NewClient = func(cfg clientv3.Config) (KeyValueClient, error) { return clientv3.New(cfg) }
+
+	decoder = serializer.NewCodecFactory(kubernetes.ShootScheme).UniversalDeserializer()
+)
+
+// KeyValueClient is the subset of the etcd client used to read the restored objects.
+type KeyValueClient interface {
+	Get(ctx context.Context, key string, opts ...clientv3.OpOption) (*clientv3.GetResponse, error)
+	Close() error
+}
+
+// Interface contains functions to manage the temporary etcd used for recovering objects from a backup.
+type Interface interface {
+	component.DeployWaiter
+	// Extract reads the selected objects from the temporary etcd and returns them as gzipped tar archive.
+	Extract(context.Context) ([]byte, error)
+}
+
+// Values contains the values used to create the temporary etcd and to extract objects from it.
+type Values struct {
+	// BackupStore is the specification of the object store containing the backups which shall be restored.
+	BackupStore druidv1alpha1.StoreSpec
+	// Namespaces are the namespaces of the shoot cluster whose objects shall be extracted.
+	Namespaces []string
+	// Resources are the resources whose objects shall be extracted. They are matched against the resource path in the
+	// etcd keys, e.g., `configmaps` or `cert.gardener.cloud/certificates`. If empty, objects of all resources are
+	// extracted.
+	Resources []string
+	// Revision is the etcd revision at which the objects shall be extracted, see SnapshotRevision. If zero, the objects
+	// are extracted at the latest revision of the restored backup.
+	Revision int64
+}
+
+// SnapshotRevision returns the last etcd revision contained in the given snapshot. The snapshot is identified by the
+// name of its object in the backup bucket as created by etcd-backup-restore, i.e.,
+// `<Full|Incr>-<start-revision>-<last-revision>-<unix-timestamp>[.<compression-suffix>]`.
+func SnapshotRevision(snapshot string) (int64, error) {
+	name, _, _ := strings.Cut(path.Base(snapshot), ".")
+
+	segments := strings.Split(name, "-")
+	if len(segments) < 4 || (segments[0] != "Full" && segments[0] != "Incr") {
+		return 0, fmt.Errorf("invalid snapshot name %q, expected <Full|Incr>-<start-revision>-<last-revision>-<unix-timestamp>", snapshot)
+	}
+
+	revision, err := strconv.ParseInt(segments[2], 10, 64)
+	if err != nil || revision <= 0 {
+		return 0, fmt.Errorf("invalid last revision %q in snapshot name %q", segments[2], snapshot)
+	}
+	return revision, nil
+}
+
+// New creates a new instance of Interface.
+func New(
+	log logr.Logger,
+	client client.Client,
+	namespace string,
+	secretsManager secretsmanager.Interface,
+	values Values,
+	waitInterval time.Duration,
+	waitSevereThreshold time.Duration,
+	waitTimeout time.Duration,
+) Interface {
+	return &objectRecovery{
+		log:                 log,
+		client:              client,
+		namespace:           namespace,
+		secretsManager:      secretsManager,
+		values:              values,
+		waitInterval:        waitInterval,
+		waitSevereThreshold: waitSevereThreshold,
+		waitTimeout:         waitTimeout,
+	}
+}
+
+type objectRecovery struct {
+	log                 logr.Logger
+	client              client.Client
+	namespace           string
+	secretsManager      secretsmanager.Interface
+	values              Values
+	waitInterval        time.Duration
+	waitSevereThreshold time.Duration
+	waitTimeout         time.Duration
+}
+
+// Deploy creates the temporary etcd. Its specification is derived from the main etcd, however, it is restored from
+// the configured backup store and runs with a single replica. Auto-compaction is disabled so that the revision history
+// of the restored backup remains readable, see Values.Revision.
+func (o *objectRecovery) Deploy(ctx context.Context) error {
+	etcdMain := &druidv1alpha1.Etcd{}
+	if err := o.client.Get(ctx, client.ObjectKey{Name: v1beta1constants.ETCDMain, Namespace: o.namespace}, etcdMain); err != nil {
+		return fmt.Errorf("failed reading main etcd: %w", err)
+	}
+
+	recoveryEtcd := o.emptyEtcd()
+	recoveryEtcd.Labels = map[string]string{
+		v1beta1constants.LabelRole:  LabelRoleValue,
+		v1beta1constants.GardenRole: v1beta1constants.GardenRoleControlPlane,
+	}
+	metav1.SetMetaDataAnnotation(&recoveryEtcd.ObjectMeta, v1beta1constants.GardenerOperation, v1beta1constants.GardenerOperationReconcile)
+	metav1.SetMetaDataAnnotation(&recoveryEtcd.ObjectMeta, v1beta1constants.GardenerTimestamp, TimeNow().UTC().Format(time.RFC3339Nano))
+
+	recoveryEtcd.Spec = *etcdMain.Spec.DeepCopy()
+	recoveryEtcd.Spec.Replicas = 1
+	recoveryEtcd.Spec.VolumeClaimTemplate = ptr.To(Name)
+	recoveryEtcd.Spec.Backup.Store = o.values.BackupStore.DeepCopy()
+	recoveryEtcd.Spec.Common.AutoCompactionRetention = ptr.To("0")
+	recoveryEtcd.Spec.Labels = withRoleLabel(recoveryEtcd.Spec.Labels)
+	if recoveryEtcd.Spec.Selector != nil {
+		recoveryEtcd.Spec.Selector.MatchLabels = withRoleLabel(recoveryEtcd.Spec.Selector.MatchLabels)
+	}
+
+	return o.client.Create(ctx, recoveryEtcd)
+}
+
+// Wait waits until the temporary etcd is restored and ready.
+func (o *objectRecovery) Wait(ctx context.Context) error {
+	return extensions.WaitUntilObjectReadyWithHealthFunction(
+		ctx,
+		o.client,
+		o.log,
+		func(obj client.Object) error {
+			recoveryEtcd, ok := obj.(*druidv1alpha1.Etcd)
+			if !ok {
+				return fmt.Errorf("expected *druidv1alpha1.Etcd but got %T", obj)
+			}
+			return health.CheckEtcd(recoveryEtcd)
+		},
+		o.emptyEtcd(),
+		"Etcd",
+		o.waitInterval,
+		o.waitSevereThreshold,
+		o.waitTimeout,
+		nil,
+	)
+}
+
+// Destroy deletes the temporary etcd and its volume.
+func (o *objectRecovery) Destroy(ctx context.Context) error {
+	return kubernetesutils.DeleteObjects(ctx, o.client,
+		o.emptyEtcd(),
+		&corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: Name + "-" + Name + "-0", Namespace: o.namespace}},
+	)
+}
+
+// WaitCleanup waits until the temporary etcd is deleted.
+func (o *objectRecovery) WaitCleanup(ctx context.Context) error {
+	timeoutCtx, cancel := context.WithTimeout(ctx, o.waitTimeout)
+	defer cancel()
+	return kubernetesutils.WaitUntilResourceDeleted(timeoutCtx, o.client, o.emptyEtcd(), o.waitInterval)
+}
+
+// Extract reads the selected objects from the temporary etcd at the configured revision and returns them as gzipped
+// tar archive. Objects which can be decoded are stored as YAML, all other objects (e.g., those encrypted at rest) are
+// stored as they are persisted in etcd.
+func (o *objectRecovery) Extract(ctx context.Context) ([]byte, error) {
+	tlsConfig, err := o.tlsConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	etcdClient, err := NewClient(clientv3.Config{
+		Endpoints:   []string{fmt.Sprintf("https://%s.%s.svc:%d", etcdconstants.ServiceName(LabelRoleValue), o.namespace, etcdconstants.PortEtcdClient)},
+		TLS:         tlsConfig,
+		DialTimeout: 30 * time.Second,
+		Context:     ctx,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed creating client for temporary etcd: %w", err)
+	}
+	defer func() {
+		if err := etcdClient.Close(); err != nil {
+			o.log.Error(err, "Failed closing client for temporary etcd")
+		}
+	}()
+
+	var (
+		buffer    bytes.Buffer
+		gzWriter  = gzip.NewWriter(&buffer)
+		tarWriter = tar.NewWriter(gzWriter)
+		count     int
+	)
+
+	addObject := func(key, value []byte) error {
+		name, content := archiveEntry(string(key), value)
+		if err := tarWriter.WriteHeader(&tar.Header{
+			Name:    name,
+			Mode:    0600,
+			Size:    int64(len(content)),
+			ModTime: TimeNow().UTC(),
+		}); err != nil {
+			return err
+		}
+		if _, err := tarWriter.Write(content); err != nil {
+			return err
+		}
+		count++
+		return nil
+	}
+
+	if len(o.values.Resources) > 0 {
+		for _, resource := range o.values.Resources {
+			for _, namespace := range o.values.Namespaces {
+				if err := list(ctx, etcdClient, registryPrefix+resource+"/"+namespace+"/", o.values.Revision, addObject); err != nil {
+					return nil, err
+				}
+			}
+		}
+	} else {
+		namespaces := sets.New(o.values.Namespaces...)
+		if err := list(ctx, etcdClient, registryPrefix, o.values.Revision, func(key, value []byte) error {
+			if !namespaces.Has(namespaceOfKey(string(key))) {
+				return nil
+			}
+			return addObject(key, value)
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tarWriter.Close(); err != nil {
+		return nil, err
+	}
+	if err := gzWriter.Close(); err != nil {
+		return nil, err
+	}
+
+	if buffer.Len() > MaxArchiveSize {
+		return nil, fmt.Errorf("archive with %d recovered objects exceeds the maximum size of %d bytes, please restrict the selected namespaces or resources", count, MaxArchiveSize)
+	}
+
+	o.log.Info("Extracted objects from temporary etcd", "count", count, "size", buffer.Len())
+	return buffer.Bytes(), nil
+}
+
+func (o *objectRecovery) tlsConfig() (*tls.Config, error) {
+	caSecret, found := o.secretsManager.Get(v1beta1constants.SecretNameCAETCD)
+	if !found {
+		return nil, fmt.Errorf("secret %q not found", v1beta1constants.SecretNameCAETCD)
+	}
+	clientSecret, found := o.secretsManager.Get(etcd.SecretNameClient)
+	if !found {
+		return nil, fmt.Errorf("secret %q not found", etcd.SecretNameClient)
+	}
+
+	caCerts := x509.NewCertPool()
+	caCerts.AppendCertsFromPEM(caSecret.Data[secretsutils.DataKeyCertificateBundle])
+
+	clientCertificate, err := tls.X509KeyPair(clientSecret.Data[secretsutils.DataKeyCertificate], clientSecret.Data[secretsutils.DataKeyPrivateKey])
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing etcd client certificate: %w", err)
+	}
+
+	return &tls.Config{
+		RootCAs:      caCerts,
+		Certificates: []tls.Certificate{clientCertificate},
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+func (o *objectRecovery) emptyEtcd() *druidv1alpha1.Etcd {
+	return &druidv1alpha1.Etcd{ObjectMeta: metav1.ObjectMeta{Name: Name, Namespace: o.namespace}}
+}
+
+func withRoleLabel(labels map[string]string) map[string]string {
+	if _, ok := labels[v1beta1constants.LabelRole]; !ok {
+		return labels
+	}
+
+	out := make(map[string]string, len(labels))
+	for k, v := range labels {
+		out[k] = v
+	}
+	out[v1beta1constants.LabelRole] = LabelRoleValue
+	return out
+}
+
+// list calls the given function for all key-value pairs with the given prefix at the given revision (zero means the
+// latest revision). The keys are read in pages in order to limit the size of the responses.
+func list(ctx context.Context, kv KeyValueClient, prefix string, revision int64, fn func(key, value []byte) error) error {
+	var (
+		key      = prefix
+		rangeEnd = clientv3.GetPrefixRangeEnd(prefix)
+	)
+
+	for {
+		resp, err := kv.Get(ctx, key, clientv3.WithRange(rangeEnd), clientv3.WithLimit(pageSize), clientv3.WithSort(clientv3.SortByKey, clientv3.SortAscend), clientv3.WithRev(revision))
+		if err != nil {
+			if errors.Is(err, rpctypes.ErrCompacted) || errors.Is(err, rpctypes.ErrFutureRev) {
+				return fmt.Errorf("revision %d is not contained in the restored backup, only snapshots taken since the latest full snapshot can be selected: %w", revision, err)
+			}
+			return fmt.Errorf("failed reading keys with prefix %q from temporary etcd: %w", prefix, err)
+		}
+
+		for _, kvs := range resp.Kvs {
+			if err := fn(kvs.Key, kvs.Value); err != nil {
+				return err
+			}
+		}
+
+		if !resp.More || len(resp.Kvs) == 0 {
+			return nil
+		}
+		key = string(resp.Kvs[len(resp.Kvs)-1].Key) + "\x00"
+	}
+}
+
+// namespaceOfKey returns the namespace of a namespaced object key, i.e., `/registry/<resource>/<namespace>/<name>` or
+// `/registry/<group>/<resource>/<namespace>/<name>`.
+func namespaceOfKey(key string) string {
+	segments := strings.Split(strings.TrimPrefix(key, registryPrefix), "/")
+	if len(segments) < 3 {
+		return ""
+	}
+	return segments[len(segments)-2]
+}
+
+// archiveEntry returns the file name and content of the archive entry for the given key-value pair.
+func archiveEntry(key string, value []byte) (string, []byte) {
+	name := strings.TrimPrefix(key, registryPrefix)
+
+	if obj, gvk, err := decoder.Decode(value, nil, nil); err == nil {
+		obj.GetObjectKind().SetGroupVersionKind(*gvk)
+		if content, err := yaml.Marshal(obj); err == nil {
+			return name + ".yaml", content
+		}
+	}
+
+	// Custom resources are stored as JSON which can be converted without knowing their types.
+	if bytes.HasPrefix(bytes.TrimSpace(value), []byte("{")) {
+		if content, err := yaml.JSONToYAML(value); err == nil {
+			return name + ".yaml", content
+		}
+	}
+
+	return name + ".raw", value
+}
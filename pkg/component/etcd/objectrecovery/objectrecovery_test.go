@@ -0,0 +1,310 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package objectrecovery_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/v1alpha1"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	gomegatypes "github.com/onsi/gomega/types"
+	"go.etcd.io/etcd/api/v3/mvccpb"
+	"go.etcd.io/etcd/api/v3/v3rpc/rpctypes"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/serializer/protobuf"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/component/etcd/objectrecovery"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
+	fakesecretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager/fake"
+	"github.com/gardener/gardener/pkg/utils/test"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("ObjectRecovery", func() {
+	var (
+		ctx       = context.Background()
+		namespace = "shoot--foo--bar"
+		now       = time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+		fakeClient client.Client
+		values     Values
+		recovery   Interface
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		values = Values{
+			BackupStore: druidv1alpha1.StoreSpec{
+				Container: ptr.To("bucket"),
+				Prefix:    "object-recovery-shoot--foo--bar--uid/etcd-main",
+			},
+			Namespaces: []string{"default"},
+		}
+
+		DeferCleanup(test.WithVar(&TimeNow, func() time.Time { return now }))
+	})
+
+	JustBeforeEach(func() {
+		recovery = New(logr.Discard(), fakeClient, namespace, fakesecretsmanager.New(fakeClient, namespace), values, time.Millisecond, time.Millisecond, time.Millisecond)
+	})
+
+	Describe("#Deploy", func() {
+		It("should fail if the main etcd does not exist", func() {
+			Expect(recovery.Deploy(ctx)).To(MatchError(ContainSubstring("failed reading main etcd")))
+		})
+
+		It("should create the temporary etcd based on the main etcd", func() {
+			Expect(fakeClient.Create(ctx, &druidv1alpha1.Etcd{
+				ObjectMeta: metav1.ObjectMeta{Name: "etcd-main", Namespace: namespace},
+				Spec: druidv1alpha1.EtcdSpec{
+					Replicas:            3,
+					VolumeClaimTemplate: ptr.To("main-etcd"),
+					Labels:              map[string]string{"role": "main", "app": "etcd-statefulset"},
+					Selector:            &metav1.LabelSelector{MatchLabels: map[string]string{"role": "main", "app": "etcd-statefulset"}},
+					Backup: druidv1alpha1.BackupSpec{
+						FullSnapshotSchedule: ptr.To("0 */24 * * *"),
+						Store: &druidv1alpha1.StoreSpec{
+							Container: ptr.To("bucket"),
+							Prefix:    "shoot--foo--bar--uid/etcd-main",
+						},
+					},
+				},
+			})).To(Succeed())
+
+			Expect(recovery.Deploy(ctx)).To(Succeed())
+
+			recoveryEtcd := &druidv1alpha1.Etcd{}
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Name: "etcd-object-recovery", Namespace: namespace}, recoveryEtcd)).To(Succeed())
+			Expect(recoveryEtcd.Labels).To(Equal(map[string]string{"role": "object-recovery", "gardener.cloud/role": "controlplane"}))
+			Expect(recoveryEtcd.Annotations).To(Equal(map[string]string{
+				"gardener.cloud/operation": "reconcile",
+				"gardener.cloud/timestamp": now.Format(time.RFC3339Nano),
+			}))
+			Expect(recoveryEtcd.Spec.Replicas).To(Equal(int32(1)))
+			Expect(recoveryEtcd.Spec.VolumeClaimTemplate).To(PointTo(Equal("etcd-object-recovery")))
+			Expect(recoveryEtcd.Spec.Labels).To(Equal(map[string]string{"role": "object-recovery", "app": "etcd-statefulset"}))
+			Expect(recoveryEtcd.Spec.Selector.MatchLabels).To(Equal(map[string]string{"role": "object-recovery", "app": "etcd-statefulset"}))
+			Expect(recoveryEtcd.Spec.Backup.FullSnapshotSchedule).To(PointTo(Equal("0 */24 * * *")))
+			Expect(recoveryEtcd.Spec.Backup.Store).To(PointTo(Equal(values.BackupStore)))
+			Expect(recoveryEtcd.Spec.Common.AutoCompactionRetention).To(PointTo(Equal("0")))
+		})
+	})
+
+	DescribeTable("#SnapshotRevision",
+		func(snapshot string, matcher gomegatypes.GomegaMatcher, expectErr bool) {
+			revision, err := SnapshotRevision(snapshot)
+			if expectErr {
+				Expect(err).To(HaveOccurred())
+				return
+			}
+			Expect(err).NotTo(HaveOccurred())
+			Expect(revision).To(matcher)
+		},
+
+		Entry("full snapshot", "Full-00000000-00001234-1704164645", Equal(int64(1234)), false),
+		Entry("compressed delta snapshot", "Incr-00001235-00001300-1704164945.gz", Equal(int64(1300)), false),
+		Entry("snapshot with path", "shoot--foo--bar--uid/etcd-main/v2/Incr-00001235-00001300-1704164945.gz", Equal(int64(1300)), false),
+		Entry("unknown kind", "Chunk-00001235-00001300-1704164945", nil, true),
+		Entry("missing segments", "Full-00001235", nil, true),
+		Entry("invalid revision", "Full-00000000-abc-1704164645", nil, true),
+	)
+
+	Describe("#Destroy", func() {
+		It("should delete the temporary etcd and its volume", func() {
+			recoveryEtcd := &druidv1alpha1.Etcd{ObjectMeta: metav1.ObjectMeta{Name: "etcd-object-recovery", Namespace: namespace}}
+			pvc := &corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: "etcd-object-recovery-etcd-object-recovery-0", Namespace: namespace}}
+			Expect(fakeClient.Create(ctx, recoveryEtcd)).To(Succeed())
+			Expect(fakeClient.Create(ctx, pvc)).To(Succeed())
+
+			Expect(recovery.Destroy(ctx)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(recoveryEtcd), recoveryEtcd)).To(BeNotFoundError())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(pvc), pvc)).To(BeNotFoundError())
+		})
+	})
+
+	Describe("#Extract", func() {
+		var etcdClient *fakeKeyValueClient
+
+		BeforeEach(func() {
+			ca, err := (&secretsutils.CertificateSecretConfig{Name: "ca-etcd", CommonName: "ca-etcd", CertType: secretsutils.CACert}).GenerateCertificate()
+			Expect(err).NotTo(HaveOccurred())
+			clientCertificate, err := (&secretsutils.CertificateSecretConfig{Name: "etcd-client", CommonName: "etcd-client", CertType: secretsutils.ClientCert, SigningCA: ca}).GenerateCertificate()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeClient.Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "ca-etcd", Namespace: namespace},
+				Data:       map[string][]byte{"bundle.crt": ca.CertificatePEM},
+			})).To(Succeed())
+			Expect(fakeClient.Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "etcd-client", Namespace: namespace},
+				Data:       map[string][]byte{"tls.crt": clientCertificate.CertificatePEM, "tls.key": clientCertificate.PrivateKeyPEM},
+			})).To(Succeed())
+
+			var protobufConfigMap bytes.Buffer
+			Expect(protobuf.NewSerializer(kubernetes.ShootScheme, kubernetes.ShootScheme).Encode(&corev1.ConfigMap{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+				ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "default"},
+				Data:       map[string]string{"key": "value"},
+			}, &protobufConfigMap)).To(Succeed())
+
+			etcdClient = &fakeKeyValueClient{data: map[string]string{
+				"/registry/configmaps/default/foo":            protobufConfigMap.String(),
+				"/registry/configmaps/other/foo":              protobufConfigMap.String(),
+				"/registry/secrets/default/bar":               "k8s:enc:aescbc:v1:key1:encrypted",
+				"/registry/example.com/widgets/default/baz":   `{"apiVersion":"example.com/v1","kind":"Widget","metadata":{"name":"baz","namespace":"default"}}`,
+				"/registry/namespaces/default":                `{}`,
+				"/registry/example.com/widgets/other/default": `{}`,
+			}}
+
+			DeferCleanup(test.WithVar(&NewClient, func(cfg clientv3.Config) (KeyValueClient, error) {
+				Expect(cfg.Endpoints).To(ConsistOf("https://etcd-object-recovery-client.shoot--foo--bar.svc:2379"))
+				Expect(cfg.TLS.Certificates).To(HaveLen(1))
+				return etcdClient, nil
+			}))
+		})
+
+		It("should extract the objects of all resources in the selected namespaces", func() {
+			archive, err := recovery.Extract(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(etcdClient.closed).To(BeTrue())
+
+			files := readArchive(archive)
+			Expect(files).To(HaveLen(3))
+			Expect(files).To(HaveKeyWithValue("configmaps/default/foo.yaml", ContainSubstring("kind: ConfigMap")))
+			Expect(files).To(HaveKeyWithValue("configmaps/default/foo.yaml", ContainSubstring("key: value")))
+			Expect(files).To(HaveKeyWithValue("secrets/default/bar.raw", Equal("k8s:enc:aescbc:v1:key1:encrypted")))
+			Expect(files).To(HaveKeyWithValue("example.com/widgets/default/baz.yaml", ContainSubstring("kind: Widget")))
+		})
+
+		Context("with selected resources", func() {
+			BeforeEach(func() {
+				values.Resources = []string{"configmaps"}
+				values.Namespaces = []string{"default", "other"}
+			})
+
+			It("should only extract the objects of the selected resources", func() {
+				archive, err := recovery.Extract(ctx)
+				Expect(err).NotTo(HaveOccurred())
+
+				files := readArchive(archive)
+				Expect(files).To(HaveLen(2))
+				Expect(files).To(HaveKey("configmaps/default/foo.yaml"))
+				Expect(files).To(HaveKey("configmaps/other/foo.yaml"))
+			})
+		})
+
+		It("should read the keys at the latest revision by default", func() {
+			_, err := recovery.Extract(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(etcdClient.revisions).NotTo(BeEmpty())
+			Expect(etcdClient.revisions).To(HaveEach(BeZero()))
+		})
+
+		Context("with selected revision", func() {
+			BeforeEach(func() {
+				values.Revision = 1300
+			})
+
+			It("should read the keys at the selected revision", func() {
+				_, err := recovery.Extract(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(etcdClient.revisions).NotTo(BeEmpty())
+				Expect(etcdClient.revisions).To(HaveEach(Equal(int64(1300))))
+			})
+
+			It("should fail if the revision is not contained in the restored backup", func() {
+				etcdClient.err = rpctypes.ErrCompacted
+
+				_, err := recovery.Extract(ctx)
+				Expect(err).To(MatchError(ContainSubstring("revision 1300 is not contained in the restored backup")))
+			})
+		})
+
+		It("should fail if the keys cannot be read", func() {
+			etcdClient.err = errors.New("fake")
+
+			_, err := recovery.Extract(ctx)
+			Expect(err).To(MatchError(ContainSubstring("fake")))
+		})
+	})
+})
+
+func readArchive(archive []byte) map[string]string {
+	gzReader, err := gzip.NewReader(bytes.NewReader(archive))
+	Expect(err).NotTo(HaveOccurred())
+	tarReader := tar.NewReader(gzReader)
+
+	files := map[string]string{}
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			return files
+		}
+		Expect(err).NotTo(HaveOccurred())
+
+		content, err := io.ReadAll(tarReader)
+		Expect(err).NotTo(HaveOccurred())
+		files[header.Name] = string(content)
+	}
+}
+
+// fakeKeyValueClient serves the requested key range in pages of two keys.
+type fakeKeyValueClient struct {
+	data      map[string]string
+	err       error
+	closed    bool
+	revisions []int64
+}
+
+func (f *fakeKeyValueClient) Get(_ context.Context, key string, opts ...clientv3.OpOption) (*clientv3.GetResponse, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	op := clientv3.OpGet(key, opts...)
+	f.revisions = append(f.revisions, op.Rev())
+	rangeEnd := string(op.RangeBytes())
+
+	var keys []string
+	for k := range f.data {
+		if k >= key && (rangeEnd == "" || strings.Compare(k, rangeEnd) < 0) {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	resp := &clientv3.GetResponse{}
+	for i, k := range keys {
+		if i == 2 {
+			resp.More = true
+			break
+		}
+		resp.Kvs = append(resp.Kvs, &mvccpb.KeyValue{Key: []byte(k), Value: []byte(f.data[k])})
+	}
+	return resp, nil
+}
+
+func (f *fakeKeyValueClient) Close() error {
+	f.closed = true
+	return nil
+}
@@ -0,0 +1,197 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package auditsink
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/component"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/extensions"
+)
+
+const (
+	// DefaultInterval is the default interval for retry operations.
+	DefaultInterval = 5 * time.Second
+	// DefaultSevereThreshold is the default threshold until an error reported by another component is treated as 'severe'.
+	DefaultSevereThreshold = 30 * time.Second
+	// DefaultTimeout is the default timeout and defines how long Gardener should wait
+	// for a successful reconciliation of an AuditSink resource.
+	DefaultTimeout = 3 * time.Minute
+)
+
+// TimeNow returns the current time. Exposed for testing.
+var TimeNow = time.Now
+
+// Interface is an interface for managing the AuditSink CRD deployment.
+type Interface interface {
+	component.DeployMigrateWaiter
+	// WebhookKubeconfigSecretRef returns the reference to the secret containing the kubeconfig for the audit webhook
+	// backend of the kube-apiserver as reported by the extension.
+	WebhookKubeconfigSecretRef() *corev1.LocalObjectReference
+}
+
+// Values contains the values used to create an AuditSink CRD
+type Values struct {
+	// Namespace is the namespace of the Shoot in the Seed.
+	Namespace string
+	// Name is the name of the AuditSink extension. Commonly the Shoot's name.
+	Name string
+	// Type is the type of the AuditSink extension.
+	Type string
+	// ProviderConfig contains the provider config for the AuditSink extension.
+	ProviderConfig *runtime.RawExtension
+}
+
+// New creates a new instance of DeployWaiter for an AuditSink.
+func New(
+	log logr.Logger,
+	client client.Client,
+	values *Values,
+	waitInterval time.Duration,
+	waitSevereThreshold time.Duration,
+	waitTimeout time.Duration,
+) Interface {
+	return &auditSink{
+		client:              client,
+		log:                 log,
+		values:              values,
+		waitInterval:        waitInterval,
+		waitSevereThreshold: waitSevereThreshold,
+		waitTimeout:         waitTimeout,
+
+		auditSink: &extensionsv1alpha1.AuditSink{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      values.Name,
+				Namespace: values.Namespace,
+			},
+		},
+	}
+}
+
+type auditSink struct {
+	values              *Values
+	log                 logr.Logger
+	client              client.Client
+	waitInterval        time.Duration
+	waitSevereThreshold time.Duration
+	waitTimeout         time.Duration
+
+	auditSink                  *extensionsv1alpha1.AuditSink
+	webhookKubeconfigSecretRef *corev1.LocalObjectReference
+}
+
+// Deploy uses the seed client to create or update the AuditSink custom resource in the Shoot namespace in the Seed.
+func (a *auditSink) Deploy(ctx context.Context) error {
+	_, err := a.deploy(ctx, v1beta1constants.GardenerOperationReconcile)
+	return err
+}
+
+// Restore uses the seed client and the ShootState to create the AuditSink custom resource in the Shoot namespace in
+// the Seed and restore its state.
+func (a *auditSink) Restore(ctx context.Context, shootState *v1beta1.ShootState) error {
+	return extensions.RestoreExtensionWithDeployFunction(
+		ctx,
+		a.client,
+		shootState,
+		extensionsv1alpha1.AuditSinkResource,
+		a.deploy,
+	)
+}
+
+// Migrate migrates the AuditSink custom resource.
+func (a *auditSink) Migrate(ctx context.Context) error {
+	return extensions.MigrateExtensionObject(
+		ctx,
+		a.client,
+		a.auditSink,
+	)
+}
+
+// WaitMigrate waits until the AuditSink custom resource has been successfully migrated.
+func (a *auditSink) WaitMigrate(ctx context.Context) error {
+	return extensions.WaitUntilExtensionObjectMigrated(
+		ctx,
+		a.client,
+		a.auditSink,
+		extensionsv1alpha1.AuditSinkResource,
+		a.waitInterval,
+		a.waitTimeout,
+	)
+}
+
+// Destroy deletes the AuditSink CRD.
+func (a *auditSink) Destroy(ctx context.Context) error {
+	return extensions.DeleteExtensionObject(
+		ctx,
+		a.client,
+		a.auditSink,
+	)
+}
+
+// Wait waits until the AuditSink CRD is ready (deployed or restored).
+func (a *auditSink) Wait(ctx context.Context) error {
+	return extensions.WaitUntilExtensionObjectReady(
+		ctx,
+		a.client,
+		a.log,
+		a.auditSink,
+		extensionsv1alpha1.AuditSinkResource,
+		a.waitInterval,
+		a.waitSevereThreshold,
+		a.waitTimeout,
+		func() error {
+			a.webhookKubeconfigSecretRef = a.auditSink.Status.WebhookKubeconfigSecretRef
+			return nil
+		},
+	)
+}
+
+// WaitCleanup waits until the AuditSink CRD is deleted.
+func (a *auditSink) WaitCleanup(ctx context.Context) error {
+	return extensions.WaitUntilExtensionObjectDeleted(
+		ctx,
+		a.client,
+		a.log,
+		a.auditSink,
+		extensionsv1alpha1.AuditSinkResource,
+		a.waitInterval,
+		a.waitTimeout,
+	)
+}
+
+// WebhookKubeconfigSecretRef returns the reference to the secret containing the kubeconfig for the audit webhook
+// backend of the kube-apiserver.
+func (a *auditSink) WebhookKubeconfigSecretRef() *corev1.LocalObjectReference {
+	return a.webhookKubeconfigSecretRef
+}
+
+func (a *auditSink) deploy(ctx context.Context, operation string) (extensionsv1alpha1.Object, error) {
+	_, err := controllerutils.GetAndCreateOrMergePatch(ctx, a.client, a.auditSink, func() error {
+		metav1.SetMetaDataAnnotation(&a.auditSink.ObjectMeta, v1beta1constants.GardenerOperation, operation)
+		metav1.SetMetaDataAnnotation(&a.auditSink.ObjectMeta, v1beta1constants.GardenerTimestamp, TimeNow().UTC().Format(time.RFC3339Nano))
+
+		a.auditSink.Spec = extensionsv1alpha1.AuditSinkSpec{
+			DefaultSpec: extensionsv1alpha1.DefaultSpec{
+				Type:           a.values.Type,
+				ProviderConfig: a.values.ProviderConfig,
+			},
+		}
+
+		return nil
+	})
+
+	return a.auditSink, err
+}
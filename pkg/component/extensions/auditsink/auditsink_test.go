@@ -0,0 +1,372 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package auditsink_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/component/extensions/auditsink"
+	"github.com/gardener/gardener/pkg/extensions"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	"github.com/gardener/gardener/pkg/utils/test"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+	mockclient "github.com/gardener/gardener/third_party/mock/controller-runtime/client"
+	mocktime "github.com/gardener/gardener/third_party/mock/go/time"
+)
+
+var _ = Describe("#AuditSink", func() {
+	const (
+		auditSinkNs   = "test-namespace"
+		auditSinkName = "test-deploy"
+		auditSinkType = "cloudwatch"
+	)
+	var (
+		ctrl *gomock.Controller
+
+		ctx              context.Context
+		c                client.Client
+		expected, empty  *extensionsv1alpha1.AuditSink
+		values           *auditsink.Values
+		log              logr.Logger
+		defaultDepWaiter auditsink.Interface
+
+		mockNow *mocktime.MockNow
+		now     time.Time
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+
+		mockNow = mocktime.NewMockNow(ctrl)
+		now = time.Now()
+
+		ctx = context.TODO()
+		log = logr.Discard()
+
+		s := runtime.NewScheme()
+		Expect(extensionsv1alpha1.AddToScheme(s)).NotTo(HaveOccurred())
+
+		c = fake.NewClientBuilder().WithScheme(s).Build()
+
+		values = &auditsink.Values{
+			Name:           auditSinkName,
+			Namespace:      auditSinkNs,
+			Type:           auditSinkType,
+			ProviderConfig: &runtime.RawExtension{Raw: []byte(`{"foo":"bar"}`)},
+		}
+
+		empty = &extensionsv1alpha1.AuditSink{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      auditSinkName,
+				Namespace: auditSinkNs,
+			},
+		}
+		expected = &extensionsv1alpha1.AuditSink{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      auditSinkName,
+				Namespace: auditSinkNs,
+				Annotations: map[string]string{
+					v1beta1constants.GardenerOperation: v1beta1constants.GardenerOperationReconcile,
+					v1beta1constants.GardenerTimestamp: now.UTC().Format(time.RFC3339Nano),
+				},
+			},
+			Spec: extensionsv1alpha1.AuditSinkSpec{
+				DefaultSpec: extensionsv1alpha1.DefaultSpec{
+					Type:           auditSinkType,
+					ProviderConfig: &runtime.RawExtension{Raw: []byte(`{"foo":"bar"}`)},
+				},
+			},
+		}
+
+		defaultDepWaiter = auditsink.New(log, c, values, time.Millisecond, 250*time.Millisecond, 500*time.Millisecond)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#Deploy", func() {
+		It("should create correct AuditSink", func() {
+			defer test.WithVars(
+				&auditsink.TimeNow, mockNow.Do,
+			)()
+
+			mockNow.EXPECT().Do().Return(now.UTC()).AnyTimes()
+
+			Expect(defaultDepWaiter.Deploy(ctx)).ToNot(HaveOccurred())
+
+			actual := &extensionsv1alpha1.AuditSink{}
+			err := c.Get(ctx, client.ObjectKey{Name: auditSinkName, Namespace: auditSinkNs}, actual)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(actual).To(DeepDerivativeEqual(expected))
+		})
+	})
+
+	Describe("#Wait", func() {
+		It("should return error when it's not found", func() {
+			Expect(defaultDepWaiter.Wait(ctx)).To(HaveOccurred())
+		})
+
+		It("should return error when it's not ready", func() {
+			expected.Status.LastError = &gardencorev1beta1.LastError{
+				Description: "Some error",
+			}
+
+			Expect(c.Create(ctx, expected)).ToNot(HaveOccurred(), "creating audit sink succeeds")
+			Expect(defaultDepWaiter.Wait(ctx)).To(HaveOccurred(), "audit sink indicates error")
+		})
+
+		It("should return error if we haven't observed the latest timestamp annotation", func() {
+			defer test.WithVars(
+				&auditsink.TimeNow, mockNow.Do,
+			)()
+			mockNow.EXPECT().Do().Return(now.UTC()).AnyTimes()
+
+			By("Deploy")
+			// Deploy should fill internal state with the added timestamp annotation
+			Expect(defaultDepWaiter.Deploy(ctx)).To(Succeed())
+
+			By("Patch object")
+			patch := client.MergeFrom(expected.DeepCopy())
+			expected.Status.LastError = nil
+			// remove operation annotation, add old timestamp annotation
+			expected.ObjectMeta.Annotations = map[string]string{
+				v1beta1constants.GardenerTimestamp: now.Add(-time.Millisecond).UTC().Format(time.RFC3339Nano),
+			}
+			expected.Status.LastOperation = &gardencorev1beta1.LastOperation{
+				State: gardencorev1beta1.LastOperationStateSucceeded,
+			}
+			Expect(c.Patch(ctx, expected, patch)).To(Succeed(), "patching audit sink succeeds")
+
+			By("Wait")
+			Expect(defaultDepWaiter.Wait(ctx)).NotTo(Succeed(), "audit sink indicates error")
+		})
+
+		It("should return no error when it's ready", func() {
+			defer test.WithVars(
+				&auditsink.TimeNow, mockNow.Do,
+			)()
+			mockNow.EXPECT().Do().Return(now.UTC()).AnyTimes()
+
+			By("Deploy")
+			// Deploy should fill internal state with the added timestamp annotation
+			Expect(defaultDepWaiter.Deploy(ctx)).To(Succeed())
+
+			By("Patch object")
+			patch := client.MergeFrom(expected.DeepCopy())
+			expected.Status.LastError = nil
+			// remove operation annotation, add up-to-date timestamp annotation
+			expected.ObjectMeta.Annotations = map[string]string{
+				v1beta1constants.GardenerTimestamp: now.UTC().Format(time.RFC3339Nano),
+			}
+			expected.Status.LastOperation = &gardencorev1beta1.LastOperation{
+				State:          gardencorev1beta1.LastOperationStateSucceeded,
+				LastUpdateTime: metav1.Time{Time: now.UTC().Add(time.Second)},
+			}
+			expected.Status.WebhookKubeconfigSecretRef = &corev1.LocalObjectReference{Name: "audit-webhook-kubeconfig"}
+			Expect(c.Patch(ctx, expected, patch)).To(Succeed(), "patching audit sink succeeds")
+
+			By("Wait")
+			Expect(defaultDepWaiter.Wait(ctx)).To(Succeed(), "audit sink is ready")
+			Expect(defaultDepWaiter.WebhookKubeconfigSecretRef()).To(Equal(&corev1.LocalObjectReference{Name: "audit-webhook-kubeconfig"}))
+		})
+	})
+
+	Describe("#Destroy", func() {
+		It("should not return error when it's not found", func() {
+			Expect(defaultDepWaiter.Destroy(ctx)).ToNot(HaveOccurred())
+		})
+
+		It("should not return error when it's deleted successfully", func() {
+			Expect(c.Create(ctx, expected)).ToNot(HaveOccurred(), "adding pre-existing audit sink succeeds")
+
+			Expect(defaultDepWaiter.Destroy(ctx)).ToNot(HaveOccurred())
+		})
+
+		It("should return error when it's not deleted successfully", func() {
+			defer test.WithVars(
+				&extensions.TimeNow, mockNow.Do,
+				&gardenerutils.TimeNow, mockNow.Do,
+			)()
+
+			mockNow.EXPECT().Do().Return(now.UTC()).AnyTimes()
+
+			expected := extensionsv1alpha1.AuditSink{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      auditSinkName,
+					Namespace: auditSinkNs,
+					Annotations: map[string]string{
+						v1beta1constants.ConfirmationDeletion: "true",
+						v1beta1constants.GardenerTimestamp:    now.UTC().Format(time.RFC3339Nano),
+					},
+				}}
+
+			mc := mockclient.NewMockClient(ctrl)
+			// add deletion confirmation and Timestamp annotation
+			mc.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&extensionsv1alpha1.AuditSink{}), gomock.Any())
+
+			mc.EXPECT().Delete(ctx, &expected).Times(1).Return(fmt.Errorf("some random error"))
+
+			defaultDepWaiter = auditsink.New(log, mc, &auditsink.Values{
+				Namespace: auditSinkNs,
+				Name:      auditSinkName,
+			}, time.Millisecond, 250*time.Millisecond, 500*time.Millisecond)
+
+			err := defaultDepWaiter.Destroy(ctx)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("#WaitCleanup", func() {
+		It("should not return error when it's already removed", func() {
+			Expect(defaultDepWaiter.WaitCleanup(ctx)).ToNot(HaveOccurred())
+		})
+	})
+
+	Describe("#Restore", func() {
+		var (
+			shootState *gardencorev1beta1.ShootState
+		)
+
+		BeforeEach(func() {
+			shootState = &gardencorev1beta1.ShootState{
+				Spec: gardencorev1beta1.ShootStateSpec{
+					Extensions: []gardencorev1beta1.ExtensionResourceState{
+						{
+							Name:  &expected.Name,
+							Kind:  extensionsv1alpha1.AuditSinkResource,
+							State: &runtime.RawExtension{Raw: []byte(`{"dummy":"state"}`)},
+						},
+					},
+				},
+			}
+		})
+
+		It("should restore the audit sink state if it exists in the shoot state", func() {
+			defer test.WithVars(
+				&auditsink.TimeNow, mockNow.Do,
+				&extensions.TimeNow, mockNow.Do,
+			)()
+			mockNow.EXPECT().Do().Return(now.UTC()).AnyTimes()
+
+			mc := mockclient.NewMockClient(ctrl)
+			mockStatusWriter := mockclient.NewMockStatusWriter(ctrl)
+
+			mc.EXPECT().Status().Return(mockStatusWriter)
+
+			mc.EXPECT().Get(ctx, client.ObjectKeyFromObject(empty), gomock.AssignableToTypeOf(empty)).
+				Return(apierrors.NewNotFound(extensionsv1alpha1.Resource("auditsinks"), auditSinkName))
+
+			// deploy with wait-for-state annotation
+			obj := expected.DeepCopy()
+			metav1.SetMetaDataAnnotation(&obj.ObjectMeta, "gardener.cloud/operation", "wait-for-state")
+			metav1.SetMetaDataAnnotation(&obj.ObjectMeta, "gardener.cloud/timestamp", now.UTC().Format(time.RFC3339Nano))
+			obj.TypeMeta = metav1.TypeMeta{}
+			mc.EXPECT().Create(ctx, test.HasObjectKeyOf(obj)).
+				DoAndReturn(func(_ context.Context, actual client.Object, _ ...client.CreateOption) error {
+					Expect(actual).To(DeepEqual(obj))
+					return nil
+				})
+
+			// restore state
+			expectedWithState := obj.DeepCopy()
+			expectedWithState.Status.State = &runtime.RawExtension{Raw: []byte(`{"dummy":"state"}`)}
+			test.EXPECTStatusPatch(ctx, mockStatusWriter, expectedWithState, obj, types.MergePatchType)
+
+			// annotate with restore annotation
+			expectedWithRestore := expectedWithState.DeepCopy()
+			metav1.SetMetaDataAnnotation(&expectedWithRestore.ObjectMeta, "gardener.cloud/operation", "restore")
+			test.EXPECTPatch(ctx, mc, expectedWithRestore, expectedWithState, types.MergePatchType)
+
+			defaultDepWaiter = auditsink.New(log, mc, values, time.Millisecond, 250*time.Millisecond, 500*time.Millisecond)
+			Expect(defaultDepWaiter.Restore(ctx, shootState)).To(Succeed())
+		})
+	})
+
+	Describe("#Migrate", func() {
+		It("should migrate the resource", func() {
+			defer test.WithVars(
+				&auditsink.TimeNow, mockNow.Do,
+				&extensions.TimeNow, mockNow.Do,
+			)()
+			mockNow.EXPECT().Do().Return(now.UTC()).AnyTimes()
+			mc := mockclient.NewMockClient(ctrl)
+
+			expectedCopy := empty.DeepCopy()
+			metav1.SetMetaDataAnnotation(&expectedCopy.ObjectMeta, v1beta1constants.GardenerOperation, v1beta1constants.GardenerOperationMigrate)
+			metav1.SetMetaDataAnnotation(&expectedCopy.ObjectMeta, v1beta1constants.GardenerTimestamp, now.UTC().Format(time.RFC3339Nano))
+			test.EXPECTPatch(ctx, mc, expectedCopy, empty, types.MergePatchType)
+
+			defaultDepWaiter = auditsink.New(log, mc, values, time.Millisecond, 250*time.Millisecond, 500*time.Millisecond)
+			Expect(defaultDepWaiter.Migrate(ctx)).To(Succeed())
+		})
+
+		It("should not return error if resource does not exist", func() {
+			defer test.WithVars(
+				&auditsink.TimeNow, mockNow.Do,
+				&extensions.TimeNow, mockNow.Do,
+			)()
+			mockNow.EXPECT().Do().Return(now.UTC()).AnyTimes()
+			mc := mockclient.NewMockClient(ctrl)
+
+			expectedCopy := empty.DeepCopy()
+			metav1.SetMetaDataAnnotation(&expectedCopy.ObjectMeta, v1beta1constants.GardenerOperation, v1beta1constants.GardenerOperationMigrate)
+			metav1.SetMetaDataAnnotation(&expectedCopy.ObjectMeta, v1beta1constants.GardenerTimestamp, now.UTC().Format(time.RFC3339Nano))
+			test.EXPECTPatch(ctx, mc, expectedCopy, empty, types.MergePatchType)
+
+			defaultDepWaiter = auditsink.New(log, mc, values, time.Millisecond, 250*time.Millisecond, 500*time.Millisecond)
+			Expect(defaultDepWaiter.Migrate(ctx)).To(Succeed())
+		})
+	})
+
+	Describe("#WaitMigrate", func() {
+		It("should not return error when resource is missing", func() {
+			Expect(defaultDepWaiter.WaitMigrate(ctx)).To(Succeed())
+		})
+
+		It("should return error if resource is not yet migrated successfully", func() {
+			expected.Status.LastError = &gardencorev1beta1.LastError{
+				Description: "Some error",
+			}
+
+			expected.Status.LastOperation = &gardencorev1beta1.LastOperation{
+				State: gardencorev1beta1.LastOperationStateError,
+				Type:  gardencorev1beta1.LastOperationTypeMigrate,
+			}
+
+			Expect(c.Create(ctx, expected)).To(Succeed(), "creating audit sink succeeds")
+			Expect(defaultDepWaiter.WaitMigrate(ctx)).To(HaveOccurred())
+		})
+
+		It("should not return error if resource gets migrated successfully", func() {
+			expected.Status.LastError = nil
+			expected.Status.LastOperation = &gardencorev1beta1.LastOperation{
+				State: gardencorev1beta1.LastOperationStateSucceeded,
+				Type:  gardencorev1beta1.LastOperationTypeMigrate,
+			}
+
+			Expect(c.Create(ctx, expected)).ToNot(HaveOccurred(), "creating audit sink succeeds")
+			Expect(defaultDepWaiter.WaitMigrate(ctx)).ToNot(HaveOccurred(), "audit sink is ready, should not return an error")
+		})
+	})
+})
@@ -41,6 +41,8 @@ type Interface interface {
 	component.DeployMigrateWaiter
 	SetInfrastructureProviderStatus(*runtime.RawExtension)
 	ProviderStatus() *runtime.RawExtension
+	// EgressCIDRs returns a list of CIDRs used as source IP by the egress traffic of the control plane components.
+	EgressCIDRs() []string
 }
 
 // Values contains the values used to create an ControlPlane resources.
@@ -60,6 +62,9 @@ type Values struct {
 	// InfrastructureProviderStatus is the provider status of the Infrastructure resource which might be relevant for
 	// the ControlPlane reconciliation.
 	InfrastructureProviderStatus *runtime.RawExtension
+	// DedicatedEgress specifies whether the egress traffic of the control plane components shall leave the seed via a
+	// source IP dedicated to the shoot.
+	DedicatedEgress bool
 }
 
 // New creates a new instance of Interface.
@@ -103,6 +108,7 @@ type controlPlane struct {
 
 	controlPlane   *extensionsv1alpha1.ControlPlane
 	providerStatus *runtime.RawExtension
+	egressCIDRs    []string
 }
 
 // Deploy uses the seed client to create or update the ControlPlane resource.
@@ -133,6 +139,7 @@ func (c *controlPlane) deploy(ctx context.Context, operation string) (extensions
 				Namespace: c.controlPlane.Namespace,
 			},
 			InfrastructureProviderStatus: c.values.InfrastructureProviderStatus,
+			DedicatedEgress:              c.values.DedicatedEgress,
 		}
 
 		return nil
@@ -185,6 +192,7 @@ func (c *controlPlane) Wait(ctx context.Context) error {
 		c.waitTimeout,
 		func() error {
 			c.providerStatus = c.controlPlane.Status.ProviderStatus
+			c.egressCIDRs = c.controlPlane.Status.EgressCIDRs
 			return nil
 		},
 	)
@@ -224,3 +232,8 @@ func (c *controlPlane) SetInfrastructureProviderStatus(status *runtime.RawExtens
 func (c *controlPlane) ProviderStatus() *runtime.RawExtension {
 	return c.providerStatus
 }
+
+// EgressCIDRs returns a list of CIDRs used as source IP by the egress traffic of the control plane components.
+func (c *controlPlane) EgressCIDRs() []string {
+	return c.egressCIDRs
+}
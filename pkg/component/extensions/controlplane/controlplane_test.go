@@ -136,6 +136,21 @@ var _ = Describe("ControlPlane", func() {
 			}))
 		})
 
+		It("should successfully deploy the ControlPlane resource with dedicated egress", func() {
+			defer test.WithVars(&controlplane.TimeNow, mockNow.Do)()
+			mockNow.EXPECT().Do().Return(now.UTC()).AnyTimes()
+
+			values.DedicatedEgress = true
+			cpSpec.DedicatedEgress = true
+			defaultDepWaiter = controlplane.New(log, c, values, time.Millisecond, 250*time.Millisecond, 500*time.Millisecond)
+
+			Expect(defaultDepWaiter.Deploy(ctx)).To(Succeed())
+
+			obj := &extensionsv1alpha1.ControlPlane{}
+			Expect(c.Get(ctx, client.ObjectKey{Name: name, Namespace: namespace}, obj)).To(Succeed())
+			Expect(obj.Spec).To(DeepEqual(cpSpec))
+		})
+
 		It("should successfully deploy the ControlPlane resource (purpose == exposure)", func() {
 			defer test.WithVars(&controlplane.TimeNow, mockNow.Do)()
 			mockNow.EXPECT().Do().Return(now.UTC()).AnyTimes()
@@ -221,10 +236,12 @@ var _ = Describe("ControlPlane", func() {
 				State:          gardencorev1beta1.LastOperationStateSucceeded,
 				LastUpdateTime: metav1.Time{Time: now.UTC().Add(time.Second)},
 			}
+			cp.Status.EgressCIDRs = []string{"1.2.3.4/32"}
 			Expect(c.Patch(ctx, cp, patch)).To(Succeed(), "patching controlplane succeeds")
 
 			By("Wait")
 			Expect(defaultDepWaiter.Wait(ctx)).To(Succeed(), "controlplane is ready")
+			Expect(defaultDepWaiter.EgressCIDRs()).To(ConsistOf("1.2.3.4/32"))
 		})
 
 		It("should return error if we haven't observed the latest timestamp annotation (purpose == exposure)", func() {
@@ -70,6 +70,20 @@ func (mr *MockInterfaceMockRecorder) Destroy(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Destroy", reflect.TypeOf((*MockInterface)(nil).Destroy), ctx)
 }
 
+// EgressCIDRs mocks base method.
+func (m *MockInterface) EgressCIDRs() []string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EgressCIDRs")
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// EgressCIDRs indicates an expected call of EgressCIDRs.
+func (mr *MockInterfaceMockRecorder) EgressCIDRs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EgressCIDRs", reflect.TypeOf((*MockInterface)(nil).EgressCIDRs))
+}
+
 // Migrate mocks base method.
 func (m *MockInterface) Migrate(ctx context.Context) error {
 	m.ctrl.T.Helper()
@@ -16,6 +16,8 @@ import (
 )
 
 var (
+	//go:embed assets/crd-extensions.gardener.cloud_auditsinks.yaml
+	auditSinkCRD string
 	//go:embed assets/crd-extensions.gardener.cloud_backupbuckets.yaml
 	backupBucketCRD string
 	//go:embed assets/crd-extensions.gardener.cloud_backupentries.yaml
@@ -48,20 +50,21 @@ var (
 func init() {
 	generalCRDs = []string{
 		backupBucketCRD,
+		clusterCRD,
 		dnsRecordCRD,
 		extensionCRD,
+		workerCRD,
 	}
 
 	shootCRDs = []string{
+		auditSinkCRD,
 		backupEntryCRD,
 		bastionCRD,
-		clusterCRD,
 		containerRuntimeCRD,
 		controlPlaneCRD,
 		infrastructureCRD,
 		networkCRD,
 		operatingSystemConfigCRD,
-		workerCRD,
 	}
 }
 
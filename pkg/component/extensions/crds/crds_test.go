@@ -60,6 +60,7 @@ var _ = Describe("#CRDs", func() {
 				Expect(c.Get(ctx, client.ObjectKey{Name: crdName}, &apiextensionsv1.CustomResourceDefinition{})).To(Succeed())
 			},
 
+			Entry("AuditSink", "auditsinks.extensions.gardener.cloud"),
 			Entry("BackupBucket", "backupbuckets.extensions.gardener.cloud"),
 			Entry("BackupEntry", "backupentries.extensions.gardener.cloud"),
 			Entry("Bastion", "bastions.extensions.gardener.cloud"),
@@ -82,6 +83,7 @@ var _ = Describe("#CRDs", func() {
 				Expect(c.Get(ctx, client.ObjectKey{Name: crdName}, &apiextensionsv1.CustomResourceDefinition{})).To(Succeed())
 			},
 
+			Entry("AuditSink", "auditsinks.extensions.gardener.cloud"),
 			Entry("BackupBucket", "backupbuckets.extensions.gardener.cloud"),
 			Entry("BackupEntry", "backupentries.extensions.gardener.cloud"),
 			Entry("Bastion", "bastions.extensions.gardener.cloud"),
@@ -107,10 +109,11 @@ var _ = Describe("#CRDs", func() {
 				Expect(c.Get(ctx, client.ObjectKey{Name: crdName}, &apiextensionsv1.CustomResourceDefinition{})).To(matcher)
 			},
 
+			Entry("AuditSink", "auditsinks.extensions.gardener.cloud", BeNotFoundError()),
 			Entry("BackupBucket", "backupbuckets.extensions.gardener.cloud", Succeed()),
 			Entry("BackupEntry", "backupentries.extensions.gardener.cloud", BeNotFoundError()),
 			Entry("Bastion", "bastions.extensions.gardener.cloud", BeNotFoundError()),
-			Entry("Cluster", "clusters.extensions.gardener.cloud", BeNotFoundError()),
+			Entry("Cluster", "clusters.extensions.gardener.cloud", Succeed()),
 			Entry("ContainerRuntime", "containerruntimes.extensions.gardener.cloud", BeNotFoundError()),
 			Entry("ControlPlane", "controlplanes.extensions.gardener.cloud", BeNotFoundError()),
 			Entry("DNSRecord", "dnsrecords.extensions.gardener.cloud", Succeed()),
@@ -118,7 +121,7 @@ var _ = Describe("#CRDs", func() {
 			Entry("Infrastructure", "infrastructures.extensions.gardener.cloud", BeNotFoundError()),
 			Entry("Network", "networks.extensions.gardener.cloud", BeNotFoundError()),
 			Entry("OperatingSystemConfig", "operatingsystemconfigs.extensions.gardener.cloud", BeNotFoundError()),
-			Entry("Worker", "workers.extensions.gardener.cloud", BeNotFoundError()),
+			Entry("Worker", "workers.extensions.gardener.cloud", Succeed()),
 		)
 
 		DescribeTable("should re-create CRD if it is deleted",
@@ -129,10 +132,11 @@ var _ = Describe("#CRDs", func() {
 				Expect(c.Get(ctx, client.ObjectKey{Name: crdName}, &apiextensionsv1.CustomResourceDefinition{})).To(matcher)
 			},
 
+			Entry("AuditSink", "auditsinks.extensions.gardener.cloud", BeNotFoundError()),
 			Entry("BackupBucket", "backupbuckets.extensions.gardener.cloud", Succeed()),
 			Entry("BackupEntry", "backupentries.extensions.gardener.cloud", BeNotFoundError()),
 			Entry("Bastion", "bastions.extensions.gardener.cloud", BeNotFoundError()),
-			Entry("Cluster", "clusters.extensions.gardener.cloud", BeNotFoundError()),
+			Entry("Cluster", "clusters.extensions.gardener.cloud", Succeed()),
 			Entry("ContainerRuntime", "containerruntimes.extensions.gardener.cloud", BeNotFoundError()),
 			Entry("ControlPlane", "controlplanes.extensions.gardener.cloud", BeNotFoundError()),
 			Entry("DNSRecord", "dnsrecords.extensions.gardener.cloud", Succeed()),
@@ -140,7 +144,7 @@ var _ = Describe("#CRDs", func() {
 			Entry("Infrastructure", "infrastructures.extensions.gardener.cloud", BeNotFoundError()),
 			Entry("Network", "networks.extensions.gardener.cloud", BeNotFoundError()),
 			Entry("OperatingSystemConfig", "operatingsystemconfigs.extensions.gardener.cloud", BeNotFoundError()),
-			Entry("Worker", "workers.extensions.gardener.cloud", BeNotFoundError()),
+			Entry("Worker", "workers.extensions.gardener.cloud", Succeed()),
 		)
 	})
 
@@ -154,10 +158,11 @@ var _ = Describe("#CRDs", func() {
 				Expect(c.Get(ctx, client.ObjectKey{Name: crdName}, &apiextensionsv1.CustomResourceDefinition{})).To(matcher)
 			},
 
+			Entry("AuditSink", "auditsinks.extensions.gardener.cloud", Succeed()),
 			Entry("BackupBucket", "backupbuckets.extensions.gardener.cloud", BeNotFoundError()),
 			Entry("BackupEntry", "backupentries.extensions.gardener.cloud", Succeed()),
 			Entry("Bastion", "bastions.extensions.gardener.cloud", Succeed()),
-			Entry("Cluster", "clusters.extensions.gardener.cloud", Succeed()),
+			Entry("Cluster", "clusters.extensions.gardener.cloud", BeNotFoundError()),
 			Entry("ContainerRuntime", "containerruntimes.extensions.gardener.cloud", Succeed()),
 			Entry("ControlPlane", "controlplanes.extensions.gardener.cloud", Succeed()),
 			Entry("DNSRecord", "dnsrecords.extensions.gardener.cloud", BeNotFoundError()),
@@ -165,7 +170,7 @@ var _ = Describe("#CRDs", func() {
 			Entry("Infrastructure", "infrastructures.extensions.gardener.cloud", Succeed()),
 			Entry("Network", "networks.extensions.gardener.cloud", Succeed()),
 			Entry("OperatingSystemConfig", "operatingsystemconfigs.extensions.gardener.cloud", Succeed()),
-			Entry("Worker", "workers.extensions.gardener.cloud", Succeed()),
+			Entry("Worker", "workers.extensions.gardener.cloud", BeNotFoundError()),
 		)
 
 		DescribeTable("should re-create CRD if it is deleted",
@@ -176,10 +181,11 @@ var _ = Describe("#CRDs", func() {
 				Expect(c.Get(ctx, client.ObjectKey{Name: crdName}, &apiextensionsv1.CustomResourceDefinition{})).To(matcher)
 			},
 
+			Entry("AuditSink", "auditsinks.extensions.gardener.cloud", Succeed()),
 			Entry("BackupBucket", "backupbuckets.extensions.gardener.cloud", BeNotFoundError()),
 			Entry("BackupEntry", "backupentries.extensions.gardener.cloud", Succeed()),
 			Entry("Bastion", "bastions.extensions.gardener.cloud", Succeed()),
-			Entry("Cluster", "clusters.extensions.gardener.cloud", Succeed()),
+			Entry("Cluster", "clusters.extensions.gardener.cloud", BeNotFoundError()),
 			Entry("ContainerRuntime", "containerruntimes.extensions.gardener.cloud", Succeed()),
 			Entry("ControlPlane", "controlplanes.extensions.gardener.cloud", Succeed()),
 			Entry("DNSRecord", "dnsrecords.extensions.gardener.cloud", BeNotFoundError()),
@@ -187,7 +193,7 @@ var _ = Describe("#CRDs", func() {
 			Entry("Infrastructure", "infrastructures.extensions.gardener.cloud", Succeed()),
 			Entry("Network", "networks.extensions.gardener.cloud", Succeed()),
 			Entry("OperatingSystemConfig", "operatingsystemconfigs.extensions.gardener.cloud", Succeed()),
-			Entry("Worker", "workers.extensions.gardener.cloud", Succeed()),
+			Entry("Worker", "workers.extensions.gardener.cloud", BeNotFoundError()),
 		)
 	})
 
@@ -220,7 +226,7 @@ var _ = Describe("#CRDs", func() {
 			Expect(c.Get(ctx, client.ObjectKey{Name: "backupbuckets.extensions.gardener.cloud"}, &apiextensionsv1.CustomResourceDefinition{})).To(Succeed())
 			Expect(c.Get(ctx, client.ObjectKey{Name: "backupentries.extensions.gardener.cloud"}, &apiextensionsv1.CustomResourceDefinition{})).To(BeNotFoundError())
 			Expect(c.Get(ctx, client.ObjectKey{Name: "bastions.extensions.gardener.cloud"}, &apiextensionsv1.CustomResourceDefinition{})).To(BeNotFoundError())
-			Expect(c.Get(ctx, client.ObjectKey{Name: "clusters.extensions.gardener.cloud"}, &apiextensionsv1.CustomResourceDefinition{})).To(BeNotFoundError())
+			Expect(c.Get(ctx, client.ObjectKey{Name: "clusters.extensions.gardener.cloud"}, &apiextensionsv1.CustomResourceDefinition{})).To(Succeed())
 			Expect(c.Get(ctx, client.ObjectKey{Name: "containerruntimes.extensions.gardener.cloud"}, &apiextensionsv1.CustomResourceDefinition{})).To(BeNotFoundError())
 			Expect(c.Get(ctx, client.ObjectKey{Name: "controlplanes.extensions.gardener.cloud"}, &apiextensionsv1.CustomResourceDefinition{})).To(BeNotFoundError())
 			Expect(c.Get(ctx, client.ObjectKey{Name: "dnsrecords.extensions.gardener.cloud"}, &apiextensionsv1.CustomResourceDefinition{})).To(Succeed())
@@ -228,7 +234,7 @@ var _ = Describe("#CRDs", func() {
 			Expect(c.Get(ctx, client.ObjectKey{Name: "infrastructures.extensions.gardener.cloud"}, &apiextensionsv1.CustomResourceDefinition{})).To(BeNotFoundError())
 			Expect(c.Get(ctx, client.ObjectKey{Name: "networks.extensions.gardener.cloud"}, &apiextensionsv1.CustomResourceDefinition{})).To(BeNotFoundError())
 			Expect(c.Get(ctx, client.ObjectKey{Name: "operatingsystemconfigs.extensions.gardener.cloud"}, &apiextensionsv1.CustomResourceDefinition{})).To(BeNotFoundError())
-			Expect(c.Get(ctx, client.ObjectKey{Name: "workers.extensions.gardener.cloud"}, &apiextensionsv1.CustomResourceDefinition{})).To(BeNotFoundError())
+			Expect(c.Get(ctx, client.ObjectKey{Name: "workers.extensions.gardener.cloud"}, &apiextensionsv1.CustomResourceDefinition{})).To(Succeed())
 		})
 
 		It("should delete general CRDs only", func() {
@@ -238,7 +244,7 @@ var _ = Describe("#CRDs", func() {
 			Expect(c.Get(ctx, client.ObjectKey{Name: "backupbuckets.extensions.gardener.cloud"}, &apiextensionsv1.CustomResourceDefinition{})).To(BeNotFoundError())
 			Expect(c.Get(ctx, client.ObjectKey{Name: "backupentries.extensions.gardener.cloud"}, &apiextensionsv1.CustomResourceDefinition{})).To(Succeed())
 			Expect(c.Get(ctx, client.ObjectKey{Name: "bastions.extensions.gardener.cloud"}, &apiextensionsv1.CustomResourceDefinition{})).To(Succeed())
-			Expect(c.Get(ctx, client.ObjectKey{Name: "clusters.extensions.gardener.cloud"}, &apiextensionsv1.CustomResourceDefinition{})).To(Succeed())
+			Expect(c.Get(ctx, client.ObjectKey{Name: "clusters.extensions.gardener.cloud"}, &apiextensionsv1.CustomResourceDefinition{})).To(BeNotFoundError())
 			Expect(c.Get(ctx, client.ObjectKey{Name: "containerruntimes.extensions.gardener.cloud"}, &apiextensionsv1.CustomResourceDefinition{})).To(Succeed())
 			Expect(c.Get(ctx, client.ObjectKey{Name: "controlplanes.extensions.gardener.cloud"}, &apiextensionsv1.CustomResourceDefinition{})).To(Succeed())
 			Expect(c.Get(ctx, client.ObjectKey{Name: "dnsrecords.extensions.gardener.cloud"}, &apiextensionsv1.CustomResourceDefinition{})).To(BeNotFoundError())
@@ -246,7 +252,7 @@ var _ = Describe("#CRDs", func() {
 			Expect(c.Get(ctx, client.ObjectKey{Name: "infrastructures.extensions.gardener.cloud"}, &apiextensionsv1.CustomResourceDefinition{})).To(Succeed())
 			Expect(c.Get(ctx, client.ObjectKey{Name: "networks.extensions.gardener.cloud"}, &apiextensionsv1.CustomResourceDefinition{})).To(Succeed())
 			Expect(c.Get(ctx, client.ObjectKey{Name: "operatingsystemconfigs.extensions.gardener.cloud"}, &apiextensionsv1.CustomResourceDefinition{})).To(Succeed())
-			Expect(c.Get(ctx, client.ObjectKey{Name: "workers.extensions.gardener.cloud"}, &apiextensionsv1.CustomResourceDefinition{})).To(Succeed())
+			Expect(c.Get(ctx, client.ObjectKey{Name: "workers.extensions.gardener.cloud"}, &apiextensionsv1.CustomResourceDefinition{})).To(BeNotFoundError())
 		})
 	})
 })
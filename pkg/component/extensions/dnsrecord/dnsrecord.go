@@ -83,6 +83,9 @@ type Values struct {
 	IPStack string
 	// Labels is a set of labels that should be applied to the DNSRecord resource.
 	Labels map[string]string
+	// PropagationVerification configures the verification of the propagation of the DNS record after the DNSRecord
+	// resource has been reconciled successfully. If nil, the propagation is not verified.
+	PropagationVerification *PropagationVerification
 }
 
 // New creates a new instance that implements component.DeployMigrateWaiter.
@@ -269,9 +272,10 @@ func (d *dnsRecord) Destroy(ctx context.Context) error {
 // WaitUntilExtensionObjectReady is an alias for extensions.WaitUntilExtensionObjectReady. Exposed for tests.
 var WaitUntilExtensionObjectReady = extensions.WaitUntilExtensionObjectReady
 
-// Wait waits until the DNSRecord resource is ready.
+// Wait waits until the DNSRecord resource is ready. If configured, it afterwards waits until the DNS record has been
+// propagated to the name servers.
 func (d *dnsRecord) Wait(ctx context.Context) error {
-	return WaitUntilExtensionObjectReady(
+	if err := WaitUntilExtensionObjectReady(
 		ctx,
 		d.client,
 		d.log,
@@ -281,7 +285,11 @@ func (d *dnsRecord) Wait(ctx context.Context) error {
 		d.waitSevereThreshold,
 		d.waitTimeout,
 		nil,
-	)
+	); err != nil {
+		return err
+	}
+
+	return d.verifyPropagation(ctx)
 }
 
 // WaitMigrate waits until the DNSRecord resource is migrated successfully.
@@ -7,6 +7,7 @@ package dnsrecord_test
 import (
 	"context"
 	"errors"
+	"net"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -640,6 +641,80 @@ var _ = Describe("DNSRecord", func() {
 
 			Expect(dnsRecord.Wait(ctx)).To(Succeed(), "dnsrecord is ready")
 		})
+
+		Context("with propagation verification", func() {
+			var resolvers map[string]*fakeResolver
+
+			BeforeEach(func() {
+				resolvers = map[string]*fakeResolver{
+					"":            {ns: map[string][]*net.NS{"external.example.com": {{Host: "ns1.example.com."}, {Host: "ns2.example.com."}}}},
+					"ns1:53":      {hosts: map[string][]string{dnsName: {address}}},
+					"ns2:53":      {hosts: map[string][]string{dnsName: {address, "5.6.7.8"}}},
+					"outdated:53": {hosts: map[string][]string{}},
+				}
+				resolvers["ns1.example.com:53"] = resolvers["ns1:53"]
+				resolvers["ns2.example.com:53"] = resolvers["ns2:53"]
+
+				DeferCleanup(test.WithVar(&dnsrecord.NewResolver, func(nameServer string) dnsrecord.Resolver {
+					return resolvers[nameServer]
+				}))
+
+				values.PropagationVerification = &dnsrecord.PropagationVerification{
+					InitialBackoff: time.Millisecond,
+					MaxBackoff:     time.Millisecond,
+					Timeout:        50 * time.Millisecond,
+				}
+
+				Expect(dnsRecord.Deploy(ctx)).To(Succeed())
+
+				patch := client.MergeFrom(dns.DeepCopy())
+				dns.ObjectMeta.Annotations = map[string]string{
+					v1beta1constants.GardenerTimestamp: now.UTC().Format(time.RFC3339Nano),
+				}
+				dns.Status.LastOperation = &gardencorev1beta1.LastOperation{
+					State:          gardencorev1beta1.LastOperationStateSucceeded,
+					LastUpdateTime: metav1.Time{Time: now.UTC().Add(time.Second)},
+				}
+				Expect(c.Patch(ctx, dns, patch)).To(Succeed(), "patching dnsrecord succeeds")
+			})
+
+			It("should succeed if the record was propagated to the configured name servers", func() {
+				values.PropagationVerification.NameServers = []string{"ns1:53", "ns2:53"}
+
+				Expect(dnsRecord.Wait(ctx)).To(Succeed())
+			})
+
+			It("should succeed if the record was propagated to the authoritative name servers", func() {
+				Expect(dnsRecord.Wait(ctx)).To(Succeed())
+			})
+
+			It("should fail if the record was not propagated to all name servers", func() {
+				values.PropagationVerification.NameServers = []string{"ns1:53", "outdated:53"}
+
+				Expect(dnsRecord.Wait(ctx)).To(MatchError(ContainSubstring(`DNS record "foo.bar.external.example.com" was not propagated within 50ms: name server outdated:53`)))
+			})
+
+			It("should fail if the record has a different value", func() {
+				values.PropagationVerification.NameServers = []string{"ns1:53"}
+				resolvers["ns1:53"].hosts[dnsName] = []string{"5.6.7.8"}
+
+				Expect(dnsRecord.Wait(ctx)).To(MatchError(ContainSubstring(`expected value "1.2.3.4" not found in [5.6.7.8]`)))
+			})
+
+			It("should fail if the authoritative name servers cannot be determined", func() {
+				resolvers[""].ns = nil
+
+				Expect(dnsRecord.Wait(ctx)).To(MatchError(ContainSubstring(`no authoritative name servers found for "foo.bar.external.example.com"`)))
+			})
+
+			It("should replace the wildcard label when verifying a wildcard record", func() {
+				values.DNSName = "*.bar.external.example.com"
+				values.PropagationVerification.NameServers = []string{"ns1:53"}
+				resolvers["ns1:53"].hosts["gardener-propagation-check.bar.external.example.com"] = []string{address}
+
+				Expect(dnsRecord.Wait(ctx)).To(Succeed())
+			})
+		})
 	})
 
 	Describe("#Destroy", func() {
@@ -833,3 +908,30 @@ var _ = Describe("DNSRecord", func() {
 		})
 	})
 })
+
+type fakeResolver struct {
+	hosts map[string][]string
+	ns    map[string][]*net.NS
+}
+
+func (f *fakeResolver) LookupHost(_ context.Context, host string) ([]string, error) {
+	if addresses, ok := f.hosts[host]; ok {
+		return addresses, nil
+	}
+	return nil, &net.DNSError{Name: host, Err: "no such host", IsNotFound: true}
+}
+
+func (f *fakeResolver) LookupCNAME(_ context.Context, host string) (string, error) {
+	return "", &net.DNSError{Name: host, Err: "no such host", IsNotFound: true}
+}
+
+func (f *fakeResolver) LookupTXT(_ context.Context, name string) ([]string, error) {
+	return nil, &net.DNSError{Name: name, Err: "no such host", IsNotFound: true}
+}
+
+func (f *fakeResolver) LookupNS(_ context.Context, name string) ([]*net.NS, error) {
+	if nameServers, ok := f.ns[name]; ok {
+		return nameServers, nil
+	}
+	return nil, &net.DNSError{Name: name, Err: "no such host", IsNotFound: true}
+}
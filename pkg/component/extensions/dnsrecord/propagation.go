@@ -0,0 +1,181 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dnsrecord
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"slices"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+)
+
+// wildcardReplacement is the label which replaces the wildcard label of a DNS name when verifying the propagation of
+// a wildcard DNS record.
+const wildcardReplacement = "gardener-propagation-check"
+
+// PropagationVerification configures the verification of the propagation of the DNS record after the DNSRecord
+// resource has been reconciled successfully.
+type PropagationVerification struct {
+	// NameServers is a list of name server endpoints (`<host>:<port>`) which are queried. If empty, the authoritative
+	// name servers of the DNS zone are determined and queried.
+	NameServers []string
+	// InitialBackoff is the initial duration between two verification attempts. It is doubled after each unsuccessful
+	// attempt.
+	InitialBackoff time.Duration
+	// MaxBackoff is the maximum duration between two verification attempts.
+	MaxBackoff time.Duration
+	// Timeout is the duration after which the verification fails.
+	Timeout time.Duration
+}
+
+// Resolver resolves DNS names.
+type Resolver interface {
+	LookupHost(ctx context.Context, host string) ([]string, error)
+	LookupCNAME(ctx context.Context, host string) (string, error)
+	LookupTXT(ctx context.Context, name string) ([]string, error)
+	LookupNS(ctx context.Context, name string) ([]*net.NS, error)
+}
+
+// NewResolver returns a resolver which sends all queries to the given name server. If the name server is empty, the
+// default resolver is returned. Exposed for testing.
+var NewResolver = func(nameServer string) Resolver {
+	if nameServer == "" {
+		return net.DefaultResolver
+	}
+
+	return &net.Resolver{
+		PreferGo: true,
+		Dial: func(ctx context.Context, network, _ string) (net.Conn, error) {
+			var dialer net.Dialer
+			return dialer.DialContext(ctx, network, nameServer)
+		},
+	}
+}
+
+// verifyPropagation queries the DNS record from the configured (or the authoritative) name servers until all of them
+// return the expected values. Authoritative name servers do not cache negative responses, hence querying them directly
+// is not affected by a premature lookup of the DNS name (e.g., before the record was created by the provider).
+func (d *dnsRecord) verifyPropagation(ctx context.Context) error {
+	verification := d.values.PropagationVerification
+	if verification == nil || len(d.values.Values) == 0 {
+		return nil
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, verification.Timeout)
+	defer cancel()
+
+	dnsName := d.values.DNSName
+	if strings.HasPrefix(dnsName, "*.") {
+		dnsName = wildcardReplacement + dnsName[1:]
+	}
+
+	var (
+		pending = sets.New(verification.NameServers...)
+		lastErr error
+	)
+
+	if err := wait.ExponentialBackoffWithContext(timeoutCtx, wait.Backoff{
+		Duration: verification.InitialBackoff,
+		Factor:   2,
+		Cap:      verification.MaxBackoff,
+		Steps:    math.MaxInt32,
+	}, func(ctx context.Context) (bool, error) {
+		if pending.Len() == 0 {
+			nameServers, err := lookupAuthoritativeNameServers(ctx, dnsName)
+			if err != nil {
+				lastErr = err
+				d.log.Info("Could not determine authoritative name servers, retrying", "dnsName", dnsName, "err", err.Error())
+				return false, nil
+			}
+			pending.Insert(nameServers...)
+		}
+
+		for _, nameServer := range sets.List(pending) {
+			if err := d.checkNameServer(ctx, NewResolver(nameServer), dnsName); err != nil {
+				lastErr = fmt.Errorf("name server %s: %w", nameServer, err)
+				d.log.Info("DNS record not yet propagated, retrying", "dnsName", dnsName, "nameServer", nameServer, "err", err.Error())
+				continue
+			}
+			pending.Delete(nameServer)
+		}
+
+		return pending.Len() == 0, nil
+	}); err != nil {
+		if lastErr != nil {
+			err = lastErr
+		}
+		return fmt.Errorf("DNS record %q was not propagated within %s: %w", d.values.DNSName, verification.Timeout, err)
+	}
+
+	d.log.Info("DNS record was propagated to all name servers", "dnsName", d.values.DNSName)
+	return nil
+}
+
+func (d *dnsRecord) checkNameServer(ctx context.Context, resolver Resolver, dnsName string) error {
+	var (
+		actual []string
+		err    error
+	)
+
+	switch d.values.RecordType {
+	case extensionsv1alpha1.DNSRecordTypeA, extensionsv1alpha1.DNSRecordTypeAAAA, "":
+		actual, err = resolver.LookupHost(ctx, dnsName)
+	case extensionsv1alpha1.DNSRecordTypeCNAME:
+		var cname string
+		cname, err = resolver.LookupCNAME(ctx, dnsName)
+		actual = []string{strings.TrimSuffix(cname, ".")}
+	case extensionsv1alpha1.DNSRecordTypeTXT:
+		actual, err = resolver.LookupTXT(ctx, dnsName)
+	default:
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, value := range d.values.Values {
+		if !slices.Contains(actual, strings.TrimSuffix(value, ".")) {
+			return fmt.Errorf("expected value %q not found in %v", value, actual)
+		}
+	}
+
+	return nil
+}
+
+// lookupAuthoritativeNameServers returns the endpoints of the authoritative name servers of the zone containing the
+// given DNS name. The zone is determined by walking up the DNS name until NS records are found.
+func lookupAuthoritativeNameServers(ctx context.Context, dnsName string) ([]string, error) {
+	resolver := NewResolver("")
+
+	for name := strings.TrimSuffix(dnsName, "."); strings.Contains(name, "."); name = name[strings.Index(name, ".")+1:] {
+		nameServers, err := resolver.LookupNS(ctx, name)
+		if err != nil {
+			var dnsErr *net.DNSError
+			if errors.As(err, &dnsErr) && dnsErr.IsNotFound {
+				continue
+			}
+			return nil, fmt.Errorf("failed looking up NS records for %q: %w", name, err)
+		}
+
+		if len(nameServers) > 0 {
+			out := make([]string, 0, len(nameServers))
+			for _, nameServer := range nameServers {
+				out = append(out, net.JoinHostPort(strings.TrimSuffix(nameServer.Host, "."), "53"))
+			}
+			return out, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no authoritative name servers found for %q", dnsName)
+}
@@ -40,6 +40,7 @@ type Interface interface {
 	component.DeployMigrateWaiter
 	SetPodCIDRs([]net.IPNet)
 	SetServiceCIDRs([]net.IPNet)
+	SetIPFamilies([]extensionsv1alpha1.IPFamily)
 }
 
 // Values contains the values used to create a Network CRD
@@ -207,3 +208,7 @@ func (n *network) SetPodCIDRs(pods []net.IPNet) {
 func (n *network) SetServiceCIDRs(services []net.IPNet) {
 	n.values.ServiceCIDRs = services
 }
+
+func (n *network) SetIPFamilies(ipFamilies []extensionsv1alpha1.IPFamily) {
+	n.values.IPFamilies = ipFamilies
+}
@@ -148,6 +148,18 @@ func (mr *MockInterfaceMockRecorder) SetClusterDNSAddresses(arg0 any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetClusterDNSAddresses", reflect.TypeOf((*MockInterface)(nil).SetClusterDNSAddresses), arg0)
 }
 
+// SetCoreDNSAddresses mocks base method.
+func (m *MockInterface) SetCoreDNSAddresses(arg0 []string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetCoreDNSAddresses", arg0)
+}
+
+// SetCoreDNSAddresses indicates an expected call of SetCoreDNSAddresses.
+func (mr *MockInterfaceMockRecorder) SetCoreDNSAddresses(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCoreDNSAddresses", reflect.TypeOf((*MockInterface)(nil).SetCoreDNSAddresses), arg0)
+}
+
 // SetCredentialsRotationStatus mocks base method.
 func (m *MockInterface) SetCredentialsRotationStatus(arg0 *v1beta1.ShootCredentialsRotation) {
 	m.ctrl.T.Helper()
@@ -8,6 +8,9 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"maps"
+	"slices"
+	"strings"
 	"sync"
 	"time"
 
@@ -33,6 +36,7 @@ import (
 	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original/components/gardeneruser"
 	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original/components/nodeagent"
 	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original/components/sshdensurer"
+	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/windows"
 	"github.com/gardener/gardener/pkg/controllerutils"
 	"github.com/gardener/gardener/pkg/extensions"
 	"github.com/gardener/gardener/pkg/features"
@@ -93,6 +97,8 @@ type Interface interface {
 	WorkerPoolNameToOperatingSystemConfigsMap() map[string]*OperatingSystemConfigs
 	// SetClusterDNSAddresses sets the cluster DNS addresses.
 	SetClusterDNSAddresses([]string)
+	// SetCoreDNSAddresses sets the CoreDNS addresses.
+	SetCoreDNSAddresses([]string)
 }
 
 // Values contains the values used to create an OperatingSystemConfig resource.
@@ -125,6 +131,9 @@ type OriginalValues struct {
 	CABundle *string
 	// ClusterDNSAddresses are the addresses for in-cluster DNS.
 	ClusterDNSAddresses []string
+	// CoreDNSAddresses are the addresses of the CoreDNS service. They are used as in-cluster DNS addresses for worker
+	// pools which have node local DNS disabled.
+	CoreDNSAddresses []string
 	// ClusterDomain is the Kubernetes cluster domain.
 	ClusterDomain string
 	// Images is a map containing the necessary container images for the systemd units (hyperkube and pause-container).
@@ -148,6 +157,8 @@ type OriginalValues struct {
 	NodeMonitorGracePeriod metav1.Duration
 	// NodeLocalDNSEnabled indicates whether node local dns is enabled or not.
 	NodeLocalDNSEnabled bool
+	// SystemComponents contains the settings of the system components running in the data plane of the shoot.
+	SystemComponents *gardencorev1beta1.SystemComponents
 	// PrimaryIPFamily represents the preferred IP family (IPv4 or IPv6) to be used.
 	PrimaryIPFamily gardencorev1beta1.IPFamily
 }
@@ -668,6 +679,10 @@ func (o *operatingSystemConfig) SetClusterDNSAddresses(clusterDNSAddresses []str
 	o.values.ClusterDNSAddresses = clusterDNSAddresses
 }
 
+func (o *operatingSystemConfig) SetCoreDNSAddresses(coreDNSAddresses []string) {
+	o.values.CoreDNSAddresses = coreDNSAddresses
+}
+
 func (o *operatingSystemConfig) newDeployer(version int, osc *extensionsv1alpha1.OperatingSystemConfig, worker gardencorev1beta1.Worker, purpose extensionsv1alpha1.OperatingSystemConfigPurpose) (deployer, error) {
 	criName := extensionsv1alpha1.CRINameContainerD
 	if worker.CRI != nil {
@@ -721,6 +736,12 @@ func (o *operatingSystemConfig) newDeployer(version int, osc *extensionsv1alpha1
 		return deployer{}, err
 	}
 
+	nodeLocalDNSEnabled := nodeLocalDNSEnabledForWorkerPool(o.values, worker.Name)
+	clusterDNSAddresses := o.values.ClusterDNSAddresses
+	if !nodeLocalDNSEnabled && len(o.values.CoreDNSAddresses) > 0 {
+		clusterDNSAddresses = o.values.CoreDNSAddresses
+	}
+
 	return deployer{
 		client:                  o.client,
 		osc:                     osc,
@@ -731,7 +752,7 @@ func (o *operatingSystemConfig) newDeployer(version int, osc *extensionsv1alpha1
 		caBundle:                caBundle,
 		clusterCASecretName:     clusterCASecret.Name,
 		clusterCABundle:         clusterCASecret.Data[secretsutils.DataKeyCertificateBundle],
-		clusterDNSAddresses:     o.values.ClusterDNSAddresses,
+		clusterDNSAddresses:     clusterDNSAddresses,
 		clusterDomain:           o.values.ClusterDomain,
 		criName:                 criName,
 		images:                  images,
@@ -746,12 +767,16 @@ func (o *operatingSystemConfig) newDeployer(version int, osc *extensionsv1alpha1
 		valiIngressHostName:     o.values.ValiIngressHostName,
 		valitailEnabled:         o.values.ValitailEnabled,
 		nodeMonitorGracePeriod:  o.values.NodeMonitorGracePeriod,
-		nodeLocalDNSEnabled:     o.values.NodeLocalDNSEnabled,
+		nodeLocalDNSEnabled:     nodeLocalDNSEnabled,
 		primaryIPFamily:         o.values.PrimaryIPFamily,
-		taints:                  worker.Taints,
+		taints:                  gardenerutils.TaintsForWorkerPool(worker, o.values.SystemComponents),
 	}, nil
 }
 
+func nodeLocalDNSEnabledForWorkerPool(values *Values, workerPoolName string) bool {
+	return values.NodeLocalDNSEnabled && !v1beta1helper.IsNodeLocalDNSDisabledForWorkerPool(values.SystemComponents, workerPoolName)
+}
+
 func setDefaultEvictionMemoryAvailable(evictionHard, evictionSoft map[string]string, machineTypes []gardencorev1beta1.MachineType, machineType string) {
 	evictionHardMemoryAvailable, evictionSoftMemoryAvailable := "100Mi", "200Mi"
 
@@ -823,6 +848,8 @@ var (
 	InitConfigFn = nodeinit.Config
 	// OriginalConfigFn is a function for computing the downloaded cloud config user data units and files.
 	OriginalConfigFn = original.Config
+	// WindowsConfigFn is a function for computing the files for worker pools of the windows operating system family.
+	WindowsConfigFn = windows.Config
 )
 
 func (d *deployer) deploy(ctx context.Context, operation string) (extensionsv1alpha1.Object, error) {
@@ -839,7 +866,9 @@ func (d *deployer) deploy(ctx context.Context, operation string) (extensionsv1al
 		ClusterDomain:           d.clusterDomain,
 		CRIName:                 d.criName,
 		Images:                  d.images,
+		NodeAnnotations:         d.worker.Annotations,
 		NodeLabels:              gardenerutils.NodeLabelsForWorkerPool(d.worker, d.nodeLocalDNSEnabled, d.key),
+		NodeMetadataEnforced:    v1beta1helper.NodeTemplateEnforced(&d.worker),
 		NodeMonitorGracePeriod:  d.nodeMonitorGracePeriod,
 		KubeletCABundle:         d.kubeletCABundle,
 		KubeletConfigParameters: d.kubeletConfigParameters,
@@ -853,12 +882,25 @@ func (d *deployer) deploy(ctx context.Context, operation string) (extensionsv1al
 		ValiIngress:             d.valiIngressHostName,
 		APIServerURL:            d.apiServerURL,
 		Sysctls:                 d.worker.Sysctls,
+		KernelModules:           d.worker.KernelModules,
 		PreferIPv6:              d.primaryIPFamily == gardencorev1beta1.IPFamilyIPv6,
 		Taints:                  d.taints,
 	}
 
-	switch d.purpose {
-	case extensionsv1alpha1.OperatingSystemConfigPurposeProvision:
+	switch {
+	case ptr.Deref(d.worker.Machine.OSFamily, v1beta1constants.OSFamilyLinux) == v1beta1constants.OSFamilyWindows:
+		// Windows machines neither run systemd nor the gardener-node-agent, hence the operating system extension is
+		// responsible for bootstrapping and reconciling them based on the rendered files.
+		if d.purpose != extensionsv1alpha1.OperatingSystemConfigPurposeProvision && d.purpose != extensionsv1alpha1.OperatingSystemConfigPurposeReconcile {
+			return nil, fmt.Errorf("unknown purpose: %q", d.purpose)
+		}
+
+		files, err = WindowsConfigFn(componentsContext, d.clusterCABundle, d.purpose)
+		if err != nil {
+			return nil, err
+		}
+
+	case d.purpose == extensionsv1alpha1.OperatingSystemConfigPurposeProvision:
 		units, files, err = InitConfigFn(
 			d.worker,
 			d.images[imagevector.ContainerImageNameGardenerNodeAgent].String(),
@@ -880,7 +922,7 @@ func (d *deployer) deploy(ctx context.Context, operation string) (extensionsv1al
 			}
 		}
 
-	case extensionsv1alpha1.OperatingSystemConfigPurposeReconcile:
+	case d.purpose == extensionsv1alpha1.OperatingSystemConfigPurposeReconcile:
 		units, files, err = OriginalConfigFn(componentsContext)
 		if err != nil {
 			return nil, err
@@ -903,6 +945,7 @@ func (d *deployer) deploy(ctx context.Context, operation string) (extensionsv1al
 		d.osc.Spec.Type = d.worker.Machine.Image.Name
 		d.osc.Spec.ProviderConfig = d.worker.Machine.Image.ProviderConfig
 		d.osc.Spec.Purpose = d.purpose
+		d.osc.Spec.OSFamily = d.worker.Machine.OSFamily
 		d.osc.Spec.Units = units
 		d.osc.Spec.Files = files
 
@@ -921,7 +964,7 @@ func (d *deployer) deploy(ctx context.Context, operation string) (extensionsv1al
 				d.osc.Spec.CRIConfig.Containerd.SandboxImage = pauseImage.String()
 			}
 
-			if version.ConstraintK8sGreaterEqual131.Check(d.kubernetesVersion) {
+			if version.ConstraintK8sGreaterEqual131.Check(d.kubernetesVersion) && ptr.Deref(d.worker.Machine.OSFamily, v1beta1constants.OSFamilyLinux) == v1beta1constants.OSFamilyLinux {
 				d.osc.Spec.CRIConfig.CgroupDriver = ptr.To(extensionsv1alpha1.CgroupDriverSystemd)
 			}
 		}
@@ -959,7 +1002,7 @@ func calculateKeyForVersion(
 		// TODO(MichaelEischer): Remove KeyV1 after support for Kubernetes 1.30 is dropped
 		return KeyV1(worker.Name, kubernetesVersion, worker.CRI), nil
 	case 2:
-		return KeyV2(kubernetesVersion, values.CredentialsRotationStatus, worker, values.NodeLocalDNSEnabled, kubeletConfiguration), nil
+		return KeyV2(kubernetesVersion, values.CredentialsRotationStatus, worker, nodeLocalDNSEnabledForWorkerPool(values, worker.Name), kubeletConfiguration), nil
 	default:
 		return "", fmt.Errorf("unsupported osc key hash version %v", version)
 	}
@@ -986,7 +1029,8 @@ func KeyV1(workerPoolName string, kubernetesVersion *semver.Version, criConfig *
 
 // KeyV2 returns the key that can be used as secret name based on the provided worker name,
 // Kubernetes version, machine type, image, worker volume, CRI, credentials rotation, node local dns
-// and kubelet configuration.
+// and kubelet configuration. For worker pools with the in-place update strategy, the Kubernetes version and the
+// machine image version are not part of the key since nodes are updated in-place when they change.
 func KeyV2(
 	kubernetesVersion *semver.Version,
 	credentialsRotation *gardencorev1beta1.ShootCredentialsRotation,
@@ -1006,6 +1050,13 @@ func KeyV2(
 		worker.Machine.Image.Name + *worker.Machine.Image.Version,
 	}
 
+	if ptr.Deref(worker.UpdateStrategy, gardencorev1beta1.WorkerUpdateStrategyRollingUpdate) == gardencorev1beta1.WorkerUpdateStrategyInPlace {
+		data = []string{
+			worker.Machine.Type,
+			worker.Machine.Image.Name,
+		}
+	}
+
 	if worker.Volume != nil {
 		data = append(data, worker.Volume.VolumeSize)
 		if worker.Volume.Type != nil {
@@ -1046,6 +1097,12 @@ func KeyV2(
 		if policy := kubeletConfiguration.CPUManagerPolicy; policy != nil {
 			data = append(data, *policy)
 		}
+		if policy := kubeletConfiguration.MemoryManagerPolicy; policy != nil {
+			data = append(data, string(*policy))
+		}
+		for _, reservation := range kubeletConfiguration.ReservedMemory {
+			data = append(data, fmt.Sprintf("%d-%s", reservation.NumaNode, reservedMemoryLimits(reservation.Limits)))
+		}
 	}
 
 	var result string
@@ -1056,6 +1113,15 @@ func KeyV2(
 	return fmt.Sprintf("gardener-node-agent-%s-%s", worker.Name, utils.ComputeSHA256Hex([]byte(result))[:16])
 }
 
+func reservedMemoryLimits(limits corev1.ResourceList) string {
+	out := make([]string, 0, len(limits))
+	for _, name := range slices.Sorted(maps.Keys(limits)) {
+		quantity := limits[name]
+		out = append(out, fmt.Sprintf("%s=%s", name, quantity.String()))
+	}
+	return strings.Join(out, ",")
+}
+
 func keySuffix(version int, machineImageName string, purpose extensionsv1alpha1.OperatingSystemConfigPurpose) string {
 	imagePrefix := ""
 	if version == 1 {
@@ -595,6 +595,39 @@ var _ = Describe("OperatingSystemConfig", func() {
 				}
 			})
 
+			It("should render the files for worker pools of the windows operating system family", func() {
+				windowsConfigFn := func(_ components.Context, _ []byte, purpose extensionsv1alpha1.OperatingSystemConfigPurpose) ([]extensionsv1alpha1.File, error) {
+					return []extensionsv1alpha1.File{{Path: `C:\k\` + string(purpose)}}, nil
+				}
+
+				DeferCleanup(test.WithVars(
+					&TimeNow, mockNow.Do,
+					&InitConfigFn, initConfigFn,
+					&OriginalConfigFn, originalConfigFn,
+					&WindowsConfigFn, windowsConfigFn,
+				))
+
+				mockNow.EXPECT().Do().Return(now.UTC()).AnyTimes()
+
+				values.Workers = []gardencorev1beta1.Worker{*workers[1].DeepCopy()}
+				values.Workers[0].Machine.OSFamily = ptr.To(v1beta1constants.OSFamilyWindows)
+				defaultDepWaiter = New(log, c, sm, values, time.Millisecond, 250*time.Millisecond, 500*time.Millisecond)
+
+				Expect(defaultDepWaiter.Deploy(ctx)).To(Succeed())
+
+				for _, e := range expected[2:] {
+					actual := &extensionsv1alpha1.OperatingSystemConfig{}
+					Expect(c.Get(ctx, client.ObjectKey{Name: e.Name, Namespace: e.Namespace}, actual)).To(Succeed())
+
+					Expect(actual.Spec.OSFamily).To(Equal(ptr.To(v1beta1constants.OSFamilyWindows)))
+					Expect(actual.Spec.Units).To(BeEmpty())
+					Expect(actual.Spec.Files).To(ConsistOf(extensionsv1alpha1.File{Path: `C:\k\` + string(e.Spec.Purpose)}))
+					if actual.Spec.CRIConfig != nil {
+						Expect(actual.Spec.CRIConfig.CgroupDriver).To(BeNil())
+					}
+				}
+			})
+
 			It("should exclude the bootstrap token file if purpose is not provision", func() {
 				bootstrapTokenFile := extensionsv1alpha1.File{Path: "/var/lib/gardener-node-agent/credentials/bootstrap-token"}
 				initConfigFnWithBootstrapToken := func(worker gardencorev1beta1.Worker, nodeAgentImage string, config *nodeagentconfigv1alpha1.NodeAgentConfiguration) ([]extensionsv1alpha1.Unit, []extensionsv1alpha1.File, error) {
@@ -1279,6 +1312,10 @@ var _ = Describe("OperatingSystemConfig", func() {
 				p.Minimum = 1
 			})
 
+			It("when disabling node local dns for another worker pool", func() {
+				values.SystemComponents = &gardencorev1beta1.SystemComponents{NodeLocalDNS: &gardencorev1beta1.NodeLocalDNS{Enabled: true, DisabledWorkerPools: []string{"other"}}}
+			})
+
 			It("when changing maximum", func() {
 				p.Maximum = 2
 			})
@@ -1463,6 +1500,15 @@ var _ = Describe("OperatingSystemConfig", func() {
 				values.NodeLocalDNSEnabled = true
 			})
 
+			It("when disabling node local dns for the worker pool", func() {
+				var err error
+				values.NodeLocalDNSEnabled = true
+				hash, err = CalculateKeyForVersion(2, kubernetesVersion, values, p, kubeletConfig)
+				Expect(err).ToNot(HaveOccurred())
+
+				values.SystemComponents = &gardencorev1beta1.SystemComponents{NodeLocalDNS: &gardencorev1beta1.NodeLocalDNS{Enabled: true, DisabledWorkerPools: []string{p.Name}}}
+			})
+
 			It("when changing kubeReserved CPU", func() {
 				kubeletConfig.KubeReserved.CPU = ptr.To(resource.MustParse("100m"))
 			})
@@ -1503,6 +1549,16 @@ var _ = Describe("OperatingSystemConfig", func() {
 				kubeletConfig.CPUManagerPolicy = ptr.To("test")
 			})
 
+			It("when changing MemoryManagerPolicy", func() {
+				kubeletConfig.MemoryManagerPolicy = ptr.To(gardencorev1beta1.MemoryManagerPolicyStatic)
+			})
+
+			It("when changing ReservedMemory", func() {
+				kubeletConfig.ReservedMemory = []gardencorev1beta1.KubeletMemoryReservation{
+					{NumaNode: 0, Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1124Mi")}},
+				}
+			})
+
 			It("when changing systemReserved CPU", func() {
 				kubeletConfig.SystemReserved = &gardencorev1beta1.KubeletConfigReserved{
 					CPU: ptr.To(resource.MustParse("1m")),
@@ -1527,5 +1583,29 @@ var _ = Describe("OperatingSystemConfig", func() {
 				}
 			})
 		})
+
+		Context("in-place update strategy", func() {
+			BeforeEach(func() {
+				p.UpdateStrategy = ptr.To(gardencorev1beta1.WorkerUpdateStrategyInPlace)
+
+				var err error
+				hash, err = CalculateKeyForVersion(2, kubernetesVersion, values, p, kubeletConfig)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("should not change the hash value when changing the kubernetes version", func() {
+				Expect(CalculateKeyForVersion(2, semver.MustParse("1.3.0"), values, p, kubeletConfig)).To(Equal(hash))
+			})
+
+			It("should not change the hash value when changing the machine image version", func() {
+				p.Machine.Image.Version = ptr.To("new-version")
+				Expect(CalculateKeyForVersion(2, kubernetesVersion, values, p, kubeletConfig)).To(Equal(hash))
+			})
+
+			It("should change the hash value when changing the machine image name", func() {
+				p.Machine.Image.Name = "new-image"
+				Expect(CalculateKeyForVersion(2, kubernetesVersion, values, p, kubeletConfig)).NotTo(Equal(hash))
+			})
+		})
 	})
 })
@@ -29,7 +29,9 @@ type Context struct {
 	ClusterDomain           string
 	CRIName                 extensionsv1alpha1.CRIName
 	Images                  map[string]*imagevector.Image
+	NodeAnnotations         map[string]string
 	NodeLabels              map[string]string
+	NodeMetadataEnforced    bool
 	NodeMonitorGracePeriod  metav1.Duration
 	KubeletCABundle         []byte
 	KubeletCLIFlags         ConfigurableKubeletCLIFlags
@@ -43,6 +45,7 @@ type Context struct {
 	ValitailEnabled         bool
 	APIServerURL            string
 	Sysctls                 map[string]string
+	KernelModules           []string
 	PreferIPv6              bool
 	Taints                  []corev1.Taint
 }
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"slices"
 	"strconv"
+	"strings"
 
 	"k8s.io/component-helpers/node/util/sysctl"
 	"k8s.io/utils/ptr"
@@ -88,7 +89,32 @@ func (component) Config(ctx components.Context) ([]extensionsv1alpha1.Unit, []ex
 		FilePaths: []string{kernelSettingsFile.Path},
 	}
 
-	return []extensionsv1alpha1.Unit{systemdSysctlUnit}, []extensionsv1alpha1.File{kernelSettingsFile}, nil
+	units := []extensionsv1alpha1.Unit{systemdSysctlUnit}
+	files := []extensionsv1alpha1.File{kernelSettingsFile}
+
+	// Custom kernel modules for worker group
+	if len(ctx.KernelModules) > 0 {
+		kernelModulesFile := extensionsv1alpha1.File{
+			Path:        v1beta1constants.OperatingSystemConfigFilePathKernelModules,
+			Permissions: ptr.To[uint32](0644),
+			Content: extensionsv1alpha1.FileContent{
+				Inline: &extensionsv1alpha1.FileContentInline{
+					Data: strings.Join(ctx.KernelModules, "\n") + "\n",
+				},
+			},
+		}
+
+		units = append(units, extensionsv1alpha1.Unit{
+			// it needs to be restarted, because the /etc/modules-load.d/ files are only read when it is started
+			Name:      "systemd-modules-load.service",
+			Command:   ptr.To(extensionsv1alpha1.CommandRestart),
+			Enable:    ptr.To(true),
+			FilePaths: []string{kernelModulesFile.Path},
+		})
+		files = append(files, kernelModulesFile)
+	}
+
+	return units, files, nil
 }
 
 // Do not change the encoding here because extensions might modify it!
@@ -106,6 +106,31 @@ var _ = Describe("Component", func() {
 		Entry("should return the expected units and files if configured to add kernel settings", "1.25.0", dummySettingConfig, nil, dummySettingMap, true),
 		Entry("should return the expected units and files if kube-proxy is disabled", "1.25.0", nonKubeProxyData, nil, nil, false),
 	)
+
+	It("should return the expected units and files if configured to load kernel modules", func() {
+		units, files, err := component.Config(components.Context{
+			KubernetesVersion: semver.MustParse("1.31.0"),
+			KubeProxyEnabled:  true,
+			KernelModules:     []string{"br_netfilter", "ip_vs"},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(units).To(ContainElement(extensionsv1alpha1.Unit{
+			Name:      "systemd-modules-load.service",
+			Command:   ptr.To(extensionsv1alpha1.CommandRestart),
+			Enable:    ptr.To(true),
+			FilePaths: []string{"/etc/modules-load.d/99-k8s-worker.conf"},
+		}))
+		Expect(files).To(ContainElement(extensionsv1alpha1.File{
+			Path:        "/etc/modules-load.d/99-k8s-worker.conf",
+			Permissions: ptr.To[uint32](0644),
+			Content: extensionsv1alpha1.FileContent{
+				Inline: &extensionsv1alpha1.FileContentInline{
+					Data: "br_netfilter\nip_vs\n",
+				},
+			},
+		}))
+	})
 })
 
 const data = `# A higher vm.max_map_count is great for elasticsearch, mongo, or other mmap users
@@ -44,9 +44,11 @@ type ConfigurableKubeletConfigParameters struct {
 	RegistryBurst                    *int32
 	KubeReserved                     map[string]string
 	MaxPods                          *int32
+	MemoryManagerPolicy              *string
 	MemorySwap                       *kubeletconfigv1beta1.MemorySwapConfiguration
 	PodPidsLimit                     *int64
 	ProtectKernelDefaults            *bool
+	ReservedMemory                   []kubeletconfigv1beta1.MemoryReservation
 	SystemReserved                   map[string]string
 }
 
@@ -183,6 +185,16 @@ func KubeletConfigParametersFromCoreV1beta1KubeletConfig(kubeletConfig *gardenco
 		if kubeletConfig.MemorySwap != nil && kubeletConfig.MemorySwap.SwapBehavior != nil {
 			out.MemorySwap = &kubeletconfigv1beta1.MemorySwapConfiguration{SwapBehavior: string(*kubeletConfig.MemorySwap.SwapBehavior)}
 		}
+
+		if policy := kubeletConfig.MemoryManagerPolicy; policy != nil {
+			out.MemoryManagerPolicy = ptr.To(string(*policy))
+		}
+		for _, reservation := range kubeletConfig.ReservedMemory {
+			out.ReservedMemory = append(out.ReservedMemory, kubeletconfigv1beta1.MemoryReservation{
+				NumaNode: reservation.NumaNode,
+				Limits:   reservation.Limits.DeepCopy(),
+			})
+		}
 	}
 
 	return out
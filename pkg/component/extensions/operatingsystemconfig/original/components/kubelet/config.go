@@ -82,6 +82,7 @@ func Config(kubernetesVersion *semver.Version, clusterDNSAddresses []string, clu
 		KubeReserved:                     params.KubeReserved,
 		MaxOpenFiles:                     1000000,
 		MaxPods:                          *params.MaxPods,
+		MemoryManagerPolicy:              ptr.Deref(params.MemoryManagerPolicy, ""),
 		PodsPerCore:                      0,
 		PodPidsLimit:                     params.PodPidsLimit,
 		ProtectKernelDefaults:            *params.ProtectKernelDefaults,
@@ -94,6 +95,7 @@ func Config(kubernetesVersion *semver.Version, clusterDNSAddresses []string, clu
 		ServerTLSBootstrap:               true,
 		StreamingConnectionIdleTimeout:   *params.StreamingConnectionIdleTimeout,
 		RegisterWithTaints:               nodeTaints,
+		ReservedMemory:                   params.ReservedMemory,
 		RegistryPullQPS:                  params.RegistryPullQPS,
 		RegistryBurst:                    ptr.Deref(params.RegistryBurst, 0),
 		SyncFrequency:                    metav1.Duration{Duration: time.Minute},
@@ -11,6 +11,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kubeletconfigv1beta1 "k8s.io/kubelet/config/v1beta1"
 	"k8s.io/utils/ptr"
@@ -46,8 +47,10 @@ var _ = Describe("Config", func() {
 			RegistryBurst:                    ptr.To[int32](20),
 			KubeReserved:                     map[string]string{"cpu": "123"},
 			MaxPods:                          ptr.To[int32](24),
+			MemoryManagerPolicy:              ptr.To("Static"),
 			MemorySwap:                       &kubeletconfigv1beta1.MemorySwapConfiguration{SwapBehavior: "UnlimitedSwap"},
 			PodPidsLimit:                     ptr.To[int64](101),
+			ReservedMemory:                   []kubeletconfigv1beta1.MemoryReservation{{NumaNode: 0, Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1124Mi")}}},
 			SystemReserved:                   map[string]string{"memory": "321"},
 			StreamingConnectionIdleTimeout:   &metav1.Duration{Duration: time.Minute * 12},
 		}
@@ -228,6 +231,7 @@ var _ = Describe("Config", func() {
 			KubeReserved:                     utils.MergeStringMaps(params.KubeReserved, map[string]string{"memory": "1Gi"}),
 			MaxOpenFiles:                     1000000,
 			MaxPods:                          *params.MaxPods,
+			MemoryManagerPolicy:              *params.MemoryManagerPolicy,
 			MemorySwap:                       *params.MemorySwap,
 			PodsPerCore:                      0,
 			PodPidsLimit:                     params.PodPidsLimit,
@@ -246,6 +250,7 @@ var _ = Describe("Config", func() {
 			},
 			RegistryBurst:                  20,
 			RegistryPullQPS:                ptr.To[int32](10),
+			ReservedMemory:                 params.ReservedMemory,
 			ResolverConfig:                 ptr.To("/etc/resolv.conf"),
 			RuntimeRequestTimeout:          metav1.Duration{Duration: 2 * time.Minute},
 			SerializeImagePulls:            params.SerializeImagePulls,
@@ -69,7 +69,16 @@ func (component) Config(ctx components.Context) ([]extensionsv1alpha1.Unit, []ex
 		})
 	}
 
-	files, err := Files(ComponentConfig(ctx.Key, ctx.KubernetesVersion, ctx.APIServerURL, caBundle, additionalTokenSyncConfigs))
+	config := ComponentConfig(ctx.Key, ctx.KubernetesVersion, ctx.APIServerURL, caBundle, additionalTokenSyncConfigs)
+	if ctx.NodeMetadataEnforced {
+		config.Controllers.NodeMetadata = &nodeagentconfigv1alpha1.NodeMetadataControllerConfig{
+			Labels:      ctx.NodeLabels,
+			Annotations: ctx.NodeAnnotations,
+			Taints:      ctx.Taints,
+		}
+	}
+
+	files, err := Files(config)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed generating files: %w", err)
 	}
@@ -10,6 +10,7 @@ import (
 	"github.com/Masterminds/semver/v3"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 
@@ -89,6 +90,39 @@ WantedBy=multi-user.target`),
 			))
 			Expect(files).To(ConsistOf(expectedFiles))
 		})
+
+		It("should configure the node metadata controller if the node metadata is enforced", func() {
+			var (
+				key         = "key"
+				labels      = map[string]string{"foo": "bar"}
+				annotations = map[string]string{"baz": "qux"}
+				taints      = []corev1.Taint{{Key: "dedicated", Value: "pool", Effect: corev1.TaintEffectNoSchedule}}
+			)
+
+			config := ComponentConfig(key, kubernetesVersion, apiServerURL, caBundle, nil)
+			config.Controllers.NodeMetadata = &nodeagentconfigv1alpha1.NodeMetadataControllerConfig{
+				Labels:      labels,
+				Annotations: annotations,
+				Taints:      taints,
+			}
+			expectedFiles, err := Files(config)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, files, err := component.Config(components.Context{
+				Key:                  key,
+				KubernetesVersion:    kubernetesVersion,
+				APIServerURL:         apiServerURL,
+				CABundle:             ptr.To(string(caBundle)),
+				Images:               map[string]*imagevectorutils.Image{"gardener-node-agent": {Repository: ptr.To("gardener-node-agent"), Tag: ptr.To("v1")}},
+				NodeLabels:           labels,
+				NodeAnnotations:      annotations,
+				NodeMetadataEnforced: true,
+				Taints:               taints,
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(files).To(ContainElements(expectedFiles))
+		})
 	})
 
 	Describe("#UnitContent", func() {
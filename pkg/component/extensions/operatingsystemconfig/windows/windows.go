@@ -0,0 +1,133 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package windows
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"k8s.io/utils/ptr"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original/components"
+	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original/components/kubelet"
+	oscutils "github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/utils"
+	"github.com/gardener/gardener/pkg/utils"
+)
+
+const (
+	// BaseDir is the directory on Windows machines containing the bootstrap data rendered by Gardener.
+	BaseDir = `C:\k`
+	// PathBootstrapToken is the path to the file containing the bootstrap token used by the kubelet for joining the
+	// cluster.
+	PathBootstrapToken = BaseDir + `\bootstrap-token`
+	// PathAPIServerURL is the path to the file containing the URL of the shoot's kube-apiserver.
+	PathAPIServerURL = BaseDir + `\apiserver-url`
+	// PathClusterCACert is the path to the file containing the CA bundle of the shoot cluster.
+	PathClusterCACert = BaseDir + `\ca.crt`
+	// PathKubeletCACert is the path to the file containing the CA bundle used by the kubelet for authenticating
+	// clients.
+	PathKubeletCACert = BaseDir + `\kubelet-ca.crt`
+	// PathKubeletConfig is the path to the file containing the kubelet configuration.
+	PathKubeletConfig = BaseDir + `\kubelet-config.yaml`
+	// PathNodeLabels is the path to the file containing the comma-separated labels the kubelet registers the node
+	// with.
+	PathNodeLabels = BaseDir + `\node-labels`
+)
+
+// Config returns the files for the OperatingSystemConfig of Windows worker pools. Windows machines do not run systemd
+// or the gardener-node-agent, hence no units are rendered. Instead, the files contain the data required to join the
+// machine to the cluster, and the operating system extension is responsible for translating them into a PowerShell
+// script which is executed by cloudbase-init (or a similar tool) when the machine boots.
+// ### !CAUTION! ###
+// For the 'provision' purpose, the result is sent as user data to the providers which usually limit its size to 16 KB.
+// ### !CAUTION! ###
+func Config(ctx components.Context, clusterCABundle []byte, purpose extensionsv1alpha1.OperatingSystemConfigPurpose) ([]extensionsv1alpha1.File, error) {
+	kubeletConfig := kubelet.Config(ctx.KubernetesVersion, ctx.ClusterDNSAddresses, ctx.ClusterDomain, ctx.Taints, ctx.KubeletConfigParameters)
+	// The following settings are not supported by the kubelet on Windows.
+	kubeletConfig.Authentication.X509.ClientCAFile = PathKubeletCACert
+	kubeletConfig.CgroupDriver = ""
+	kubeletConfig.CgroupRoot = ""
+	kubeletConfig.CgroupsPerQOS = ptr.To(false)
+	kubeletConfig.EnforceNodeAllocatable = []string{}
+	kubeletConfig.MemoryManagerPolicy = ""
+	kubeletConfig.ReservedMemory = nil
+	kubeletConfig.ResolverConfig = ptr.To("")
+
+	kubeletConfigFCI, err := kubelet.NewConfigCodec(oscutils.NewFileContentInlineCodec()).Encode(kubeletConfig, string(extensionsv1alpha1.B64FileCodecID))
+	if err != nil {
+		return nil, fmt.Errorf("failed encoding kubelet configuration: %w", err)
+	}
+
+	files := []extensionsv1alpha1.File{
+		{
+			Path:        PathAPIServerURL,
+			Permissions: ptr.To[uint32](0644),
+			Content: extensionsv1alpha1.FileContent{
+				Inline: &extensionsv1alpha1.FileContentInline{Data: ctx.APIServerURL},
+			},
+		},
+		{
+			Path:        PathClusterCACert,
+			Permissions: ptr.To[uint32](0644),
+			Content: extensionsv1alpha1.FileContent{
+				Inline: &extensionsv1alpha1.FileContentInline{
+					Encoding: string(extensionsv1alpha1.B64FileCodecID),
+					Data:     utils.EncodeBase64(clusterCABundle),
+				},
+			},
+		},
+		{
+			Path:        PathKubeletCACert,
+			Permissions: ptr.To[uint32](0644),
+			Content: extensionsv1alpha1.FileContent{
+				Inline: &extensionsv1alpha1.FileContentInline{
+					Encoding: string(extensionsv1alpha1.B64FileCodecID),
+					Data:     utils.EncodeBase64(ctx.KubeletCABundle),
+				},
+			},
+		},
+		{
+			Path:        PathKubeletConfig,
+			Permissions: ptr.To[uint32](0644),
+			Content:     extensionsv1alpha1.FileContent{Inline: kubeletConfigFCI},
+		},
+		{
+			Path:        PathNodeLabels,
+			Permissions: ptr.To[uint32](0644),
+			Content: extensionsv1alpha1.FileContent{
+				Inline: &extensionsv1alpha1.FileContentInline{Data: nodeLabels(ctx.NodeLabels)},
+			},
+		},
+	}
+
+	if purpose == extensionsv1alpha1.OperatingSystemConfigPurposeProvision {
+		files = append(files, extensionsv1alpha1.File{
+			Path:        PathBootstrapToken,
+			Permissions: ptr.To[uint32](0640),
+			Content: extensionsv1alpha1.FileContent{
+				Inline: &extensionsv1alpha1.FileContentInline{
+					// The bootstrap token will be created by the machine-controller-manager when creating an actual
+					// machine, and it will replace this "magic" string with the actual token in the user data.
+					Data: "<<BOOTSTRAP_TOKEN>>",
+				},
+				TransmitUnencoded: ptr.To(true),
+			},
+		})
+	}
+
+	return files, nil
+}
+
+func nodeLabels(labels map[string]string) string {
+	out := make([]string, 0, len(labels))
+	for key, value := range labels {
+		out = append(out, key+"="+value)
+	}
+	sort.Strings(out)
+
+	return strings.Join(out, ",")
+}
@@ -0,0 +1,110 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package windows_test
+
+import (
+	"github.com/Masterminds/semver/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original/components"
+	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original/components/kubelet"
+	oscutils "github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/utils"
+	. "github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/windows"
+	"github.com/gardener/gardener/pkg/utils"
+)
+
+var _ = Describe("Windows", func() {
+	Describe("#Config", func() {
+		var (
+			clusterCABundle = []byte("cluster-ca")
+			ctx             components.Context
+		)
+
+		BeforeEach(func() {
+			ctx = components.Context{
+				APIServerURL:        "https://api.example.com",
+				ClusterDNSAddresses: []string{"10.0.0.10"},
+				ClusterDomain:       "cluster.local",
+				KubeletCABundle:     []byte("kubelet-ca"),
+				KubernetesVersion:   semver.MustParse("1.31.1"),
+				NodeLabels: map[string]string{
+					"worker.gardener.cloud/pool": "windows",
+					"kubernetes.io/arch":         "amd64",
+				},
+			}
+		})
+
+		It("should return the expected files for the provision purpose", func() {
+			files, err := Config(ctx, clusterCABundle, extensionsv1alpha1.OperatingSystemConfigPurposeProvision)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(files).To(ContainElements(
+				extensionsv1alpha1.File{
+					Path:        `C:\k\apiserver-url`,
+					Permissions: ptr.To[uint32](0644),
+					Content:     extensionsv1alpha1.FileContent{Inline: &extensionsv1alpha1.FileContentInline{Data: "https://api.example.com"}},
+				},
+				extensionsv1alpha1.File{
+					Path:        `C:\k\ca.crt`,
+					Permissions: ptr.To[uint32](0644),
+					Content:     extensionsv1alpha1.FileContent{Inline: &extensionsv1alpha1.FileContentInline{Encoding: "b64", Data: utils.EncodeBase64(clusterCABundle)}},
+				},
+				extensionsv1alpha1.File{
+					Path:        `C:\k\kubelet-ca.crt`,
+					Permissions: ptr.To[uint32](0644),
+					Content:     extensionsv1alpha1.FileContent{Inline: &extensionsv1alpha1.FileContentInline{Encoding: "b64", Data: utils.EncodeBase64([]byte("kubelet-ca"))}},
+				},
+				extensionsv1alpha1.File{
+					Path:        `C:\k\node-labels`,
+					Permissions: ptr.To[uint32](0644),
+					Content:     extensionsv1alpha1.FileContent{Inline: &extensionsv1alpha1.FileContentInline{Data: "kubernetes.io/arch=amd64,worker.gardener.cloud/pool=windows"}},
+				},
+				extensionsv1alpha1.File{
+					Path:        `C:\k\bootstrap-token`,
+					Permissions: ptr.To[uint32](0640),
+					Content: extensionsv1alpha1.FileContent{
+						Inline:            &extensionsv1alpha1.FileContentInline{Data: "<<BOOTSTRAP_TOKEN>>"},
+						TransmitUnencoded: ptr.To(true),
+					},
+				},
+			))
+			Expect(files).To(HaveLen(6))
+		})
+
+		It("should not contain the bootstrap token for the reconcile purpose", func() {
+			files, err := Config(ctx, clusterCABundle, extensionsv1alpha1.OperatingSystemConfigPurposeReconcile)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(files).To(HaveLen(5))
+			Expect(files).NotTo(ContainElement(HaveField("Path", `C:\k\bootstrap-token`)))
+		})
+
+		It("should render a kubelet configuration compatible with Windows", func() {
+			files, err := Config(ctx, clusterCABundle, extensionsv1alpha1.OperatingSystemConfigPurposeProvision)
+			Expect(err).NotTo(HaveOccurred())
+
+			var kubeletConfigFile *extensionsv1alpha1.File
+			for _, file := range files {
+				if file.Path == `C:\k\kubelet-config.yaml` {
+					kubeletConfigFile = &file
+				}
+			}
+			Expect(kubeletConfigFile).NotTo(BeNil())
+
+			kubeletConfig, err := kubelet.NewConfigCodec(oscutils.NewFileContentInlineCodec()).Decode(kubeletConfigFile.Content.Inline)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(kubeletConfig.Authentication.X509.ClientCAFile).To(Equal(`C:\k\kubelet-ca.crt`))
+			Expect(kubeletConfig.CgroupsPerQOS).To(Equal(ptr.To(false)))
+			Expect(kubeletConfig.CgroupDriver).To(BeEmpty())
+			Expect(kubeletConfig.CgroupRoot).To(BeEmpty())
+			Expect(kubeletConfig.EnforceNodeAllocatable).To(BeEmpty())
+			Expect(kubeletConfig.ClusterDNS).To(ConsistOf("10.0.0.10"))
+		})
+	})
+})
@@ -79,6 +79,8 @@ type Values struct {
 	WorkerPoolNameToOperatingSystemConfigsMap map[string]*operatingsystemconfig.OperatingSystemConfigs
 	// NodeLocalDNSEnabled indicates whether node local dns is enabled or not.
 	NodeLocalDNSEnabled bool
+	// SystemComponents contains the settings of the system components running in the data plane of the shoot.
+	SystemComponents *gardencorev1beta1.SystemComponents
 }
 
 // New creates a new instance of Interface.
@@ -140,10 +142,12 @@ func (w *worker) deploy(ctx context.Context, operation string) (extensionsv1alph
 		var volume *extensionsv1alpha1.Volume
 		if workerPool.Volume != nil {
 			volume = &extensionsv1alpha1.Volume{
-				Name:      workerPool.Volume.Name,
-				Type:      workerPool.Volume.Type,
-				Size:      workerPool.Volume.VolumeSize,
-				Encrypted: workerPool.Volume.Encrypted,
+				Name:       workerPool.Volume.Name,
+				Type:       workerPool.Volume.Type,
+				Size:       workerPool.Volume.VolumeSize,
+				Encrypted:  workerPool.Volume.Encrypted,
+				IOPS:       workerPool.Volume.IOPS,
+				Throughput: workerPool.Volume.Throughput,
 			}
 		}
 
@@ -151,10 +155,12 @@ func (w *worker) deploy(ctx context.Context, operation string) (extensionsv1alph
 		if len(workerPool.DataVolumes) > 0 {
 			for _, dataVolume := range workerPool.DataVolumes {
 				dataVolumes = append(dataVolumes, extensionsv1alpha1.DataVolume{
-					Name:      dataVolume.Name,
-					Type:      dataVolume.Type,
-					Size:      dataVolume.VolumeSize,
-					Encrypted: dataVolume.Encrypted,
+					Name:       dataVolume.Name,
+					Type:       dataVolume.Type,
+					Size:       dataVolume.VolumeSize,
+					Encrypted:  dataVolume.Encrypted,
+					IOPS:       dataVolume.IOPS,
+					Throughput: dataVolume.Throughput,
 				})
 			}
 		}
@@ -204,6 +210,11 @@ func (w *worker) deploy(ctx context.Context, operation string) (extensionsv1alph
 			}
 		}
 
+		var updateStrategy *extensionsv1alpha1.UpdateStrategy
+		if workerPool.UpdateStrategy != nil {
+			updateStrategy = ptr.To(extensionsv1alpha1.UpdateStrategy(*workerPool.UpdateStrategy))
+		}
+
 		var autoscalerOptions *extensionsv1alpha1.ClusterAutoscalerOptions
 		if workerPool.ClusterAutoscaler != nil {
 			autoscalerOptions = &extensionsv1alpha1.ClusterAutoscalerOptions{}
@@ -231,8 +242,8 @@ func (w *worker) deploy(ctx context.Context, operation string) (extensionsv1alph
 			MaxSurge:       *workerPool.MaxSurge,
 			MaxUnavailable: *workerPool.MaxUnavailable,
 			Annotations:    workerPool.Annotations,
-			Labels:         gardenerutils.NodeLabelsForWorkerPool(workerPool, w.values.NodeLocalDNSEnabled, gardenerNodeAgentSecretName),
-			Taints:         workerPool.Taints,
+			Labels:         gardenerutils.NodeLabelsForWorkerPool(workerPool, w.values.NodeLocalDNSEnabled && !v1beta1helper.IsNodeLocalDNSDisabledForWorkerPool(w.values.SystemComponents, workerPool.Name), gardenerNodeAgentSecretName),
+			Taints:         gardenerutils.TaintsForWorkerPool(workerPool, w.values.SystemComponents),
 			MachineType:    workerPool.Machine.Type,
 			MachineImage: extensionsv1alpha1.MachineImage{
 				Name:    workerPool.Machine.Image.Name,
@@ -252,7 +263,11 @@ func (w *worker) deploy(ctx context.Context, operation string) (extensionsv1alph
 			Zones:                            workerPool.Zones,
 			MachineControllerManagerSettings: workerPool.MachineControllerManagerSettings,
 			Architecture:                     workerPool.Machine.Architecture,
+			OSFamily:                         workerPool.Machine.OSFamily,
 			ClusterAutoscaler:                autoscalerOptions,
+			CapacityType:                     workerPool.CapacityType,
+			UpdateStrategy:                   updateStrategy,
+			RolloutStrategy:                  workerPool.RolloutStrategy,
 		})
 	}
 
@@ -78,10 +78,13 @@ var _ = Describe("Worker", func() {
 		worker1VolumeType                     = "worker1volumetype"
 		worker1VolumeSize                     = "42Gi"
 		worker1VolumeEncrypted                = true
+		worker1VolumeIOPS                     = int64(3000)
+		worker1VolumeThroughput               = int64(125)
 		worker1DataVolume1Name                = "worker1datavolume1name"
 		worker1DataVolume1Type                = "worker1datavolume1type"
 		worker1DataVolume1Size                = "43Gi"
 		worker1DataVolume1Encrypted           = false
+		worker1DataVolume1IOPS                = int64(6000)
 		worker1KubeletDataVolumeName          = "worker1kubeletdatavol"
 		worker1CRIName                        = gardencorev1beta1.CRIName("cri")
 		worker1CRIContainerRuntime1Type       = "cruntime"
@@ -192,12 +195,15 @@ var _ = Describe("Worker", func() {
 							Version: &worker1MachineImageVersion,
 						},
 						Architecture: worker1Arch,
+						OSFamily:     ptr.To("linux"),
 					},
 					Volume: &gardencorev1beta1.Volume{
 						Name:       &worker1VolumeName,
 						Type:       &worker1VolumeType,
 						VolumeSize: worker1VolumeSize,
 						Encrypted:  &worker1VolumeEncrypted,
+						IOPS:       &worker1VolumeIOPS,
+						Throughput: &worker1VolumeThroughput,
 					},
 					DataVolumes: []gardencorev1beta1.DataVolume{
 						{
@@ -205,6 +211,7 @@ var _ = Describe("Worker", func() {
 							Type:       &worker1DataVolume1Type,
 							VolumeSize: worker1DataVolume1Size,
 							Encrypted:  &worker1DataVolume1Encrypted,
+							IOPS:       &worker1DataVolume1IOPS,
 						},
 					},
 					KubeletDataVolumeName: &worker1KubeletDataVolumeName,
@@ -236,6 +243,9 @@ var _ = Describe("Worker", func() {
 						Version: &workerKubernetesVersion,
 					},
 					ClusterAutoscaler: &gardencorev1beta1.ClusterAutoscalerOptions{},
+					CapacityType:      ptr.To(gardencorev1beta1.CapacityTypeSpotWithFallback),
+					UpdateStrategy:    ptr.To(gardencorev1beta1.WorkerUpdateStrategyInPlace),
+					RolloutStrategy:   &gardencorev1beta1.WorkerRolloutStrategy{Order: ptr.To[int32](1)},
 				},
 			},
 		}
@@ -290,10 +300,12 @@ var _ = Describe("Worker", func() {
 					ProviderConfig:    worker1ProviderConfig,
 					UserDataSecretRef: corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: worker1UserDataSecretName}, Key: "cloud_config"},
 					Volume: &extensionsv1alpha1.Volume{
-						Name:      &worker1VolumeName,
-						Type:      &worker1VolumeType,
-						Size:      worker1VolumeSize,
-						Encrypted: &worker1VolumeEncrypted,
+						Name:       &worker1VolumeName,
+						Type:       &worker1VolumeType,
+						Size:       worker1VolumeSize,
+						Encrypted:  &worker1VolumeEncrypted,
+						IOPS:       &worker1VolumeIOPS,
+						Throughput: &worker1VolumeThroughput,
 					},
 					DataVolumes: []extensionsv1alpha1.DataVolume{
 						{
@@ -301,6 +313,7 @@ var _ = Describe("Worker", func() {
 							Type:      &worker1DataVolume1Type,
 							Size:      worker1DataVolume1Size,
 							Encrypted: &worker1DataVolume1Encrypted,
+							IOPS:      &worker1DataVolume1IOPS,
 						},
 					},
 					KubeletDataVolumeName:            &worker1KubeletDataVolumeName,
@@ -309,6 +322,7 @@ var _ = Describe("Worker", func() {
 					MachineControllerManagerSettings: worker1MCMSettings,
 					NodeTemplate:                     workerPool1NodeTemplate,
 					Architecture:                     worker1Arch,
+					OSFamily:                         ptr.To("linux"),
 					ClusterAutoscaler:                emptyAutoscalerOptions,
 				},
 				{
@@ -336,6 +350,9 @@ var _ = Describe("Worker", func() {
 					NodeTemplate:      workerPool2NodeTemplate,
 					Architecture:      worker2Arch,
 					ClusterAutoscaler: emptyAutoscalerOptions,
+					CapacityType:      ptr.To(gardencorev1beta1.CapacityTypeSpotWithFallback),
+					UpdateStrategy:    ptr.To(extensionsv1alpha1.UpdateStrategyInPlace),
+					RolloutStrategy:   &gardencorev1beta1.WorkerRolloutStrategy{Order: ptr.To[int32](1)},
 				},
 			},
 		}
@@ -373,6 +390,47 @@ var _ = Describe("Worker", func() {
 				Spec: wSpec,
 			}))
 		})
+		It("should add the taint for the worker pool dedicated to system components", func() {
+			defer test.WithVars(&worker.TimeNow, mockNow.Do)()
+			mockNow.EXPECT().Do().Return(now.UTC()).AnyTimes()
+
+			newValues := *values
+			newValues.SystemComponents = &gardencorev1beta1.SystemComponents{DedicatedPool: ptr.To(worker1Name)}
+
+			expectedWorkerSpec := wSpec.DeepCopy()
+			expectedWorkerSpec.Pools[0].Taints = append(expectedWorkerSpec.Pools[0].Taints, corev1.Taint{
+				Key:    "worker.gardener.cloud/system-components-dedicated",
+				Value:  "true",
+				Effect: corev1.TaintEffectNoSchedule,
+			})
+
+			defaultDepWaiter = worker.New(log, c, &newValues, time.Millisecond, 250*time.Millisecond, 500*time.Millisecond)
+			Expect(defaultDepWaiter.Deploy(ctx)).To(Succeed())
+
+			obj := &extensionsv1alpha1.Worker{}
+			Expect(c.Get(ctx, client.ObjectKey{Name: name, Namespace: namespace}, obj)).To(Succeed())
+			Expect(obj.Spec.Pools).To(DeepEqual(expectedWorkerSpec.Pools))
+		})
+
+		It("should not label the nodes of worker pools with disabled node-local-dns", func() {
+			defer test.WithVars(&worker.TimeNow, mockNow.Do)()
+			mockNow.EXPECT().Do().Return(now.UTC()).AnyTimes()
+
+			newValues := *values
+			newValues.NodeLocalDNSEnabled = true
+			newValues.SystemComponents = &gardencorev1beta1.SystemComponents{NodeLocalDNS: &gardencorev1beta1.NodeLocalDNS{Enabled: true, DisabledWorkerPools: []string{worker1Name}}}
+
+			expectedWorkerSpec := wSpec.DeepCopy()
+			expectedWorkerSpec.Pools[1].Labels["networking.gardener.cloud/node-local-dns-enabled"] = "true"
+
+			defaultDepWaiter = worker.New(log, c, &newValues, time.Millisecond, 250*time.Millisecond, 500*time.Millisecond)
+			Expect(defaultDepWaiter.Deploy(ctx)).To(Succeed())
+
+			obj := &extensionsv1alpha1.Worker{}
+			Expect(c.Get(ctx, client.ObjectKey{Name: name, Namespace: namespace}, obj)).To(Succeed())
+			Expect(obj.Spec.Pools).To(DeepEqual(expectedWorkerSpec.Pools))
+		})
+
 		It("should initialize nodeTemplate when it exists for pool in worker resource, but absent in cloudProfile", func() {
 			defer test.WithVars(&worker.TimeNow, mockNow.Do)()
 			mockNow.EXPECT().Do().Return(now.UTC()).AnyTimes()
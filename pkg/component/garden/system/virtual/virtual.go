@@ -323,9 +323,15 @@ func (g *gardenSystem) computeResourcesData() (map[string][]byte, error) {
 						"cloudprofiles",
 						"exposureclasses",
 						"seeds",
+						"shootclasses",
 					},
 					Verbs: []string{"get", "list", "watch"},
 				},
+				{
+					APIGroups: []string{gardencorev1beta1.GroupName},
+					Resources: []string{"featurematrices"},
+					Verbs:     []string{"get", "list"},
+				},
 				{
 					// allow shoot owners to use kube-state-metrics with a custom resource state configuration to expose metrics about e.g. shoots
 					APIGroups: []string{apiextensionsv1.GroupName},
@@ -459,9 +465,15 @@ func (g *gardenSystem) computeResourcesData() (map[string][]byte, error) {
 					Resources: []string{
 						"shoots/adminkubeconfig",
 						"shoots/viewerkubeconfig",
+						"shoots/kubeconfigbundle",
 					},
 					Verbs: []string{"create"},
 				},
+				{
+					APIGroups: []string{gardencorev1beta1.GroupName},
+					Resources: []string{"shoots/force-delete"},
+					Verbs:     []string{"get", "patch", "update"},
+				},
 				{
 					APIGroups: []string{gardencorev1beta1.GroupName},
 					Resources: []string{"namespacedcloudprofiles"},
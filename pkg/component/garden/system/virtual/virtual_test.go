@@ -341,9 +341,15 @@ var _ = Describe("Virtual", func() {
 						"cloudprofiles",
 						"exposureclasses",
 						"seeds",
+						"shootclasses",
 					},
 					Verbs: []string{"get", "list", "watch"},
 				},
+				{
+					APIGroups: []string{"core.gardener.cloud"},
+					Resources: []string{"featurematrices"},
+					Verbs:     []string{"get", "list"},
+				},
 				{
 					APIGroups: []string{"apiextensions.k8s.io"},
 					Resources: []string{"customresourcedefinitions"},
@@ -476,9 +482,15 @@ var _ = Describe("Virtual", func() {
 					Resources: []string{
 						"shoots/adminkubeconfig",
 						"shoots/viewerkubeconfig",
+						"shoots/kubeconfigbundle",
 					},
 					Verbs: []string{"create"},
 				},
+				{
+					APIGroups: []string{"core.gardener.cloud"},
+					Resources: []string{"shoots/force-delete"},
+					Verbs:     []string{"get", "patch", "update"},
+				},
 				{
 					APIGroups: []string{"core.gardener.cloud"},
 					Resources: []string{"namespacedcloudprofiles"},
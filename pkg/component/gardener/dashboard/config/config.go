@@ -82,6 +82,7 @@ type OIDC struct {
 	RedirectURIs       []string   `yaml:"redirect_uris"`
 	Scope              string     `yaml:"scope"`
 	RejectUnauthorized bool       `yaml:"rejectUnauthorized"`
+	CA                 string     `yaml:"ca,omitempty"`
 	Public             OIDCPublic `yaml:"public"`
 }
 
@@ -121,6 +121,7 @@ func (g *gardenerDashboard) configMap(ctx context.Context) (*corev1.ConfigMap, e
 			RedirectURIs:       redirectURIs,
 			Scope:              strings.Join(append([]string{"openid", "email"}, g.values.OIDC.DashboardOIDC.AdditionalScopes...), " "),
 			RejectUnauthorized: true,
+			CA:                 ptr.Deref(g.values.OIDC.DashboardOIDC.CABundle, ""),
 			Public: config.OIDCPublic{
 				ClientID: g.values.OIDC.ClientIDPublic,
 				UsePKCE:  true,
@@ -87,6 +87,11 @@ type IngressValues struct {
 	// WildcardCertSecretName is name of a secret containing the wildcard TLS certificate which is issued for the
 	// ingress domains. If not provided, a self-signed server certificate will be created.
 	WildcardCertSecretName *string
+	// TLSSecretName is the name of a secret containing the TLS certificate for the dashboard hosts. It takes precedence
+	// over the wildcard certificate.
+	TLSSecretName *string
+	// Annotations are additional annotations for the ingress.
+	Annotations map[string]string
 }
 
 // Interface contains function for deploying the gardener-dashboard.
@@ -38,6 +38,7 @@ import (
 	. "github.com/gardener/gardener/pkg/component/gardener/dashboard"
 	operatorclient "github.com/gardener/gardener/pkg/operator/client"
 	"github.com/gardener/gardener/pkg/resourcemanager/controller/garbagecollector/references"
+	"github.com/gardener/gardener/pkg/utils"
 	"github.com/gardener/gardener/pkg/utils/gardener"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 	"github.com/gardener/gardener/pkg/utils/retry"
@@ -59,7 +60,7 @@ var _ = Describe("GardenerDashboard", func() {
 		image                 = "gardener-dashboard-image:latest"
 		apiServerURL          = "api.local.gardener.cloud"
 		logLevel              = "debug"
-		ingressValues         = IngressValues{Domains: []string{"first", "second"}}
+		ingressValues         IngressValues
 		enableTokenLogin      bool
 		terminal              *TerminalValues
 		oidc                  *OIDCValues
@@ -102,6 +103,7 @@ var _ = Describe("GardenerDashboard", func() {
 
 	BeforeEach(func() {
 		enableTokenLogin = true
+		ingressValues = IngressValues{Domains: []string{"first", "second"}}
 		terminal = nil
 		oidc = nil
 		gitHub = nil
@@ -254,7 +256,14 @@ frontend:
 
 				configRaw += `
   scope: ` + strings.Join(append([]string{"openid", "email"}, oidc.AdditionalScopes...), " ") + `
-  rejectUnauthorized: true
+  rejectUnauthorized: true`
+
+				if oidc.CABundle != nil {
+					configRaw += `
+  ca: ` + *oidc.CABundle
+				}
+
+				configRaw += `
   public:
     clientId: ` + oidc.ClientIDPublic + `
     usePKCE: true
@@ -657,15 +666,15 @@ frontend:
 					"app":  "gardener",
 					"role": "dashboard",
 				},
-				Annotations: map[string]string{
+				Annotations: utils.MergeStringMaps(ingressValues.Annotations, map[string]string{
 					"nginx.ingress.kubernetes.io/ssl-redirect":          "true",
 					"nginx.ingress.kubernetes.io/use-port-in-redirects": "true",
-				},
+				}),
 			},
 			Spec: networkingv1.IngressSpec{
 				IngressClassName: ptr.To("nginx-ingress-gardener"),
 				TLS: []networkingv1.IngressTLS{{
-					SecretName: "gardener-dashboard-tls",
+					SecretName: ptr.Deref(ingressValues.TLSSecretName, "gardener-dashboard-tls"),
 					Hosts:      []string{"dashboard.first", "dashboard.second"},
 				}},
 				Rules: []networkingv1.IngressRule{
@@ -1087,6 +1096,44 @@ frontend:
 				})
 			})
 
+			When("oidc is configured with a CA bundle", func() {
+				BeforeEach(func() {
+					oidc = &OIDCValues{
+						DashboardOIDC: operatorv1alpha1.DashboardOIDC{
+							CABundle:  ptr.To("some-ca-bundle"),
+							SecretRef: corev1.LocalObjectReference{Name: "some-oidc-secret"},
+						},
+						IssuerURL:      "https://issuer",
+						ClientIDPublic: "public-client",
+					}
+
+					Expect(fakeClient.Create(ctx, &corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{Name: oidc.DashboardOIDC.SecretRef.Name, Namespace: namespace},
+						Data:       map[string][]byte{"client_id": []byte("id"), "client_secret": []byte("secret")},
+					})).To(Succeed())
+				})
+
+				It("should successfully deploy all resources", func() {
+					Expect(managedResourceRuntime).To(consistOf(expectedRuntimeObjects...))
+					Expect(managedResourceVirtual).To(consistOf(expectedVirtualObjects...))
+				})
+			})
+
+			When("ingress is configured", func() {
+				BeforeEach(func() {
+					ingressValues.TLSSecretName = ptr.To("dashboard-tls")
+					ingressValues.Annotations = map[string]string{
+						"foo": "bar",
+						"nginx.ingress.kubernetes.io/ssl-redirect": "false",
+					}
+				})
+
+				It("should successfully deploy all resources", func() {
+					Expect(managedResourceRuntime).To(consistOf(expectedRuntimeObjects...))
+					Expect(managedResourceVirtual).To(consistOf(expectedVirtualObjects...))
+				})
+			})
+
 			When("github is configured", func() {
 				BeforeEach(func() {
 					gitHub = &operatorv1alpha1.DashboardGitHub{
@@ -13,6 +13,7 @@ import (
 
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	operatorv1alpha1 "github.com/gardener/gardener/pkg/apis/operator/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils"
 	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
 	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
 )
@@ -26,7 +27,7 @@ func (g *gardenerDashboard) ingressHosts() []string {
 }
 
 func (g *gardenerDashboard) ingress(ctx context.Context) (*networkingv1.Ingress, error) {
-	tlsSecretName := ptr.Deref(g.values.Ingress.WildcardCertSecretName, "")
+	tlsSecretName := ptr.Deref(g.values.Ingress.TLSSecretName, ptr.Deref(g.values.Ingress.WildcardCertSecretName, ""))
 	if tlsSecretName == "" {
 		ingressTLSSecret, err := g.secretsManager.Generate(ctx, &secretsutils.CertificateSecretConfig{
 			Name:                        deploymentName + "-tls",
@@ -47,10 +48,10 @@ func (g *gardenerDashboard) ingress(ctx context.Context) (*networkingv1.Ingress,
 			Name:      deploymentName,
 			Namespace: g.namespace,
 			Labels:    GetLabels(),
-			Annotations: map[string]string{
+			Annotations: utils.MergeStringMaps(g.values.Ingress.Annotations, map[string]string{
 				"nginx.ingress.kubernetes.io/ssl-redirect":          "true",
 				"nginx.ingress.kubernetes.io/use-port-in-redirects": "true",
-			},
+			}),
 		},
 		Spec: networkingv1.IngressSpec{
 			IngressClassName: ptr.To(v1beta1constants.SeedNginxIngressClass),
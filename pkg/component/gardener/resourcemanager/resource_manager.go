@@ -49,6 +49,7 @@ import (
 	resourcemanagerconstants "github.com/gardener/gardener/pkg/component/gardener/resourcemanager/constants"
 	kubeapiserverconstants "github.com/gardener/gardener/pkg/component/kubernetes/apiserver/constants"
 	kubescheduler "github.com/gardener/gardener/pkg/component/kubernetes/scheduler"
+	corednsconstants "github.com/gardener/gardener/pkg/component/networking/coredns/constants"
 	"github.com/gardener/gardener/pkg/component/observability/monitoring/prometheus/seed"
 	"github.com/gardener/gardener/pkg/component/observability/monitoring/prometheus/shoot"
 	monitoringutils "github.com/gardener/gardener/pkg/component/observability/monitoring/utils"
@@ -274,6 +275,10 @@ type Values struct {
 	MaxConcurrentNetworkPolicyWorkers *int
 	// NamePrefix is the prefix for the resource names.
 	NamePrefix string
+	// NamespaceDefaults are the LimitRanges, ResourceQuotas and NetworkPolicies which shall be created in the namespaces
+	// of the target cluster. When this is provided, the respective controller is enabled in resource-manager. This value is only
+	// applicable for the GRM that is deployed in the Shoot control plane (when TargetDiffersFromSourceCluster=true).
+	NamespaceDefaults *gardencorev1beta1.NamespaceDefaults
 	// PriorityClassName is the name of the priority class.
 	PriorityClassName string
 	// Replicas is the number of replicas for the gardener-resource-manager deployment.
@@ -651,6 +656,11 @@ func (r *resourceManager) ensureConfigMap(ctx context.Context, configMap *corev1
 		}
 
 		config.Controllers.NodeCriticalComponents.Enabled = true
+		config.Controllers.NodeSystemComponentsReservation.Enabled = true
+
+		if r.values.NamespaceDefaults != nil {
+			config.Controllers.NamespaceDefaults = r.namespaceDefaultsControllerConfig()
+		}
 	}
 
 	// this function should be called at the last to make sure we disable
@@ -1398,6 +1408,7 @@ func GetCRDDeletionProtectionValidatingWebhooks(secretServerCA *corev1.Secret, b
 						APIGroups:   []string{extensionsv1alpha1.SchemeGroupVersion.Group},
 						APIVersions: []string{extensionsv1alpha1.SchemeGroupVersion.Version},
 						Resources: []string{
+							"auditsinks",
 							"backupbuckets",
 							"backupentries",
 							"bastions",
@@ -1440,6 +1451,15 @@ func GetExtensionValidationValidatingWebhooks(secretServerCA *corev1.Secret, bui
 		path     string
 		rule     admissionregistrationv1.Rule
 	}{
+		{
+			resource: "auditsinks",
+			rule: admissionregistrationv1.Rule{
+				APIGroups:   []string{extensionsv1alpha1.SchemeGroupVersion.Group},
+				APIVersions: []string{extensionsv1alpha1.SchemeGroupVersion.Version},
+				Resources:   []string{"auditsinks"},
+			},
+			path: extensionvalidation.WebhookPathAuditSink,
+		},
 		{
 			resource: "backupbuckets",
 			rule: admissionregistrationv1.Rule{
@@ -1997,6 +2017,136 @@ func (r *resourceManager) getDeploymentTemplateLabels() map[string]string {
 	})
 }
 
+func (r *resourceManager) namespaceDefaultsControllerConfig() resourcemanagerconfigv1alpha1.NamespaceDefaultsControllerConfig {
+	namespaceSelector := &metav1.LabelSelector{}
+	if r.values.NamespaceDefaults.NamespaceSelector != nil {
+		namespaceSelector = r.values.NamespaceDefaults.NamespaceSelector.DeepCopy()
+	}
+	// Namespaces managed by Gardener are never selected.
+	namespaceSelector.MatchExpressions = append(namespaceSelector.MatchExpressions, metav1.LabelSelectorRequirement{
+		Key:      corev1.LabelMetadataName,
+		Operator: metav1.LabelSelectorOpNotIn,
+		Values: []string{
+			metav1.NamespaceSystem,
+			metav1.NamespacePublic,
+			corev1.NamespaceNodeLease,
+			v1beta1constants.KubernetesDashboardNamespace,
+		},
+	})
+
+	config := resourcemanagerconfigv1alpha1.NamespaceDefaultsControllerConfig{
+		Enabled:           true,
+		NamespaceSelector: namespaceSelector,
+	}
+
+	for _, limitRange := range r.values.NamespaceDefaults.LimitRanges {
+		config.LimitRanges = append(config.LimitRanges, corev1.LimitRange{
+			ObjectMeta: metav1.ObjectMeta{Name: limitRange.Name},
+			Spec:       *limitRange.Spec.DeepCopy(),
+		})
+	}
+
+	for _, resourceQuota := range r.values.NamespaceDefaults.ResourceQuotas {
+		config.ResourceQuotas = append(config.ResourceQuotas, corev1.ResourceQuota{
+			ObjectMeta: metav1.ObjectMeta{Name: resourceQuota.Name},
+			Spec:       *resourceQuota.Spec.DeepCopy(),
+		})
+	}
+
+	if r.values.NamespaceDefaults.NetworkPolicies != nil && r.values.NamespaceDefaults.NetworkPolicies.DenyAll {
+		// Namespaces can opt out of the default network policies via a label.
+		config.NetworkPolicyNamespaceSelector = &metav1.LabelSelector{
+			MatchExpressions: []metav1.LabelSelectorRequirement{{
+				Key:      v1beta1constants.LabelNetworkPolicyDefaultNetworkPolicies,
+				Operator: metav1.LabelSelectorOpNotIn,
+				Values:   []string{v1beta1constants.LabelNetworkPolicyDefaultNetworkPoliciesDisabled},
+			}},
+		}
+		config.NetworkPolicies = defaultNetworkPolicies()
+	}
+
+	return config
+}
+
+func defaultNetworkPolicies() []networkingv1.NetworkPolicy {
+	var (
+		port53      = intstr.FromInt32(53)
+		port443     = intstr.FromInt32(kubeapiserverconstants.Port)
+		port8053    = intstr.FromInt32(corednsconstants.PortServer)
+		protocolUDP = corev1.ProtocolUDP
+		protocolTCP = corev1.ProtocolTCP
+	)
+
+	return []networkingv1.NetworkPolicy{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "gardener.cloud--deny-all",
+				Annotations: map[string]string{
+					v1beta1constants.GardenerDescription: "Denies all ingress and egress traffic of all pods in this namespace.",
+				},
+			},
+			Spec: networkingv1.NetworkPolicySpec{
+				PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "gardener.cloud--allow-to-dns",
+				Annotations: map[string]string{
+					v1beta1constants.GardenerDescription: fmt.Sprintf("Allows egress traffic from all pods in this "+
+						"namespace to DNS running in the '%s' namespace.", metav1.NamespaceSystem),
+				},
+			},
+			Spec: networkingv1.NetworkPolicySpec{
+				PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeEgress},
+				Egress: []networkingv1.NetworkPolicyEgressRule{
+					{
+						To: []networkingv1.NetworkPolicyPeer{{
+							NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{corev1.LabelMetadataName: metav1.NamespaceSystem}},
+							PodSelector: &metav1.LabelSelector{
+								MatchExpressions: []metav1.LabelSelectorRequirement{{
+									Key:      corednsconstants.LabelKey,
+									Operator: metav1.LabelSelectorOpIn,
+									Values:   []string{corednsconstants.LabelValue},
+								}},
+							},
+						}},
+						Ports: []networkingv1.NetworkPolicyPort{
+							{Protocol: &protocolUDP, Port: &port8053},
+							{Protocol: &protocolTCP, Port: &port8053},
+						},
+					},
+					// this allows Pods with 'dnsPolicy: Default' to talk to the node's DNS provider and all Pods to talk
+					// to node-local-dns.
+					{
+						To: []networkingv1.NetworkPolicyPeer{
+							{IPBlock: &networkingv1.IPBlock{CIDR: "0.0.0.0/0"}},
+							{IPBlock: &networkingv1.IPBlock{CIDR: "::/0"}},
+						},
+						Ports: []networkingv1.NetworkPolicyPort{
+							{Protocol: &protocolUDP, Port: &port53},
+							{Protocol: &protocolTCP, Port: &port53},
+						},
+					},
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "gardener.cloud--allow-to-apiserver",
+				Annotations: map[string]string{
+					v1beta1constants.GardenerDescription: "Allows egress traffic from all pods in this namespace to the " +
+						"API server in TCP port 443.",
+				},
+			},
+			Spec: networkingv1.NetworkPolicySpec{
+				PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeEgress},
+				Egress:      []networkingv1.NetworkPolicyEgressRule{{Ports: []networkingv1.NetworkPolicyPort{{Port: &port443, Protocol: &protocolTCP}}}},
+			},
+		},
+	}
+}
+
 func (r *resourceManager) getNetworkPolicyLabels() map[string]string {
 	labels := map[string]string{
 		v1beta1constants.LabelNetworkPolicyToDNS:              v1beta1constants.LabelNetworkPolicyAllowed,
@@ -2074,6 +2224,7 @@ func disableControllersAndWebhooksForWorkerlessShoot(config *resourcemanagerconf
 	// disable unneeded controllers
 	config.Controllers.CSRApprover.Enabled = false
 	config.Controllers.NodeCriticalComponents.Enabled = false
+	config.Controllers.NodeSystemComponentsReservation.Enabled = false
 
 	// disable unneeded webhooks
 	config.Webhooks.PodSchedulerName.Enabled = false
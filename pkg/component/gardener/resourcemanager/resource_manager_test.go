@@ -19,6 +19,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	policyv1 "k8s.io/api/policy/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
@@ -111,6 +112,22 @@ var _ = Describe("ResourceManager", func() {
 		additionalNetworkPolicyNamespaceSelectors = []metav1.LabelSelector{{MatchLabels: map[string]string{"foo": "bar"}}}
 		kubernetesServiceHost                     = "some-host"
 		targetNamespaces                          = []string{"foo", "bar"}
+		namespaceDefaults                         = &gardencorev1beta1.NamespaceDefaults{
+			NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}},
+			LimitRanges: []gardencorev1beta1.NamespaceDefaultsLimitRange{{
+				Name: "defaults",
+				Spec: corev1.LimitRangeSpec{Limits: []corev1.LimitRangeItem{{
+					Type:           corev1.LimitTypeContainer,
+					DefaultRequest: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m")},
+				}}},
+			}},
+			ResourceQuotas: []gardencorev1beta1.NamespaceDefaultsResourceQuota{{
+				Name: "quota",
+				Spec: corev1.ResourceQuotaSpec{Hard: corev1.ResourceList{corev1.ResourcePods: resource.MustParse("10")}},
+			}},
+
+			NetworkPolicies: &gardencorev1beta1.NamespaceDefaultsNetworkPolicies{DenyAll: true},
+		}
 
 		allowAll                            []rbacv1.PolicyRule
 		allowManagedResources               []rbacv1.PolicyRule
@@ -339,6 +356,7 @@ var _ = Describe("ResourceManager", func() {
 			LogFormat:                           "json",
 			Zones:                               []string{"a", "b"},
 			ManagedResourceLabels:               map[string]string{"foo": "bar"},
+			NamespaceDefaults:                   namespaceDefaults,
 		}
 		resourceManager = New(c, deployNamespace, sm, cfg)
 		resourceManager.SetSecrets(secrets)
@@ -457,6 +475,91 @@ var _ = Describe("ResourceManager", func() {
 				}
 
 				config.Controllers.NodeCriticalComponents.Enabled = !isWorkerless
+				config.Controllers.NodeSystemComponentsReservation.Enabled = !isWorkerless
+				config.Controllers.NamespaceDefaults = resourcemanagerconfigv1alpha1.NamespaceDefaultsControllerConfig{
+					Enabled: true,
+					NamespaceSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{"team": "a"},
+						MatchExpressions: []metav1.LabelSelectorRequirement{{
+							Key:      "kubernetes.io/metadata.name",
+							Operator: metav1.LabelSelectorOpNotIn,
+							Values:   []string{"kube-system", "kube-public", "kube-node-lease", "kubernetes-dashboard"},
+						}},
+					},
+					LimitRanges: []corev1.LimitRange{{
+						ObjectMeta: metav1.ObjectMeta{Name: "defaults"},
+						Spec:       namespaceDefaults.LimitRanges[0].Spec,
+					}},
+					ResourceQuotas: []corev1.ResourceQuota{{
+						ObjectMeta: metav1.ObjectMeta{Name: "quota"},
+						Spec:       namespaceDefaults.ResourceQuotas[0].Spec,
+					}},
+					NetworkPolicyNamespaceSelector: &metav1.LabelSelector{
+						MatchExpressions: []metav1.LabelSelectorRequirement{{
+							Key:      "networking.gardener.cloud/default-network-policies",
+							Operator: metav1.LabelSelectorOpNotIn,
+							Values:   []string{"disabled"},
+						}},
+					},
+					NetworkPolicies: []networkingv1.NetworkPolicy{
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:        "gardener.cloud--deny-all",
+								Annotations: map[string]string{"gardener.cloud/description": "Denies all ingress and egress traffic of all pods in this namespace."},
+							},
+							Spec: networkingv1.NetworkPolicySpec{
+								PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress},
+							},
+						},
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:        "gardener.cloud--allow-to-dns",
+								Annotations: map[string]string{"gardener.cloud/description": "Allows egress traffic from all pods in this namespace to DNS running in the 'kube-system' namespace."},
+							},
+							Spec: networkingv1.NetworkPolicySpec{
+								PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeEgress},
+								Egress: []networkingv1.NetworkPolicyEgressRule{
+									{
+										To: []networkingv1.NetworkPolicyPeer{{
+											NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"kubernetes.io/metadata.name": "kube-system"}},
+											PodSelector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{
+												Key:      "k8s-app",
+												Operator: metav1.LabelSelectorOpIn,
+												Values:   []string{"kube-dns"},
+											}}},
+										}},
+										Ports: []networkingv1.NetworkPolicyPort{
+											{Protocol: ptr.To(corev1.ProtocolUDP), Port: ptr.To(intstr.FromInt32(8053))},
+											{Protocol: ptr.To(corev1.ProtocolTCP), Port: ptr.To(intstr.FromInt32(8053))},
+										},
+									},
+									{
+										To: []networkingv1.NetworkPolicyPeer{
+											{IPBlock: &networkingv1.IPBlock{CIDR: "0.0.0.0/0"}},
+											{IPBlock: &networkingv1.IPBlock{CIDR: "::/0"}},
+										},
+										Ports: []networkingv1.NetworkPolicyPort{
+											{Protocol: ptr.To(corev1.ProtocolUDP), Port: ptr.To(intstr.FromInt32(53))},
+											{Protocol: ptr.To(corev1.ProtocolTCP), Port: ptr.To(intstr.FromInt32(53))},
+										},
+									},
+								},
+							},
+						},
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:        "gardener.cloud--allow-to-apiserver",
+								Annotations: map[string]string{"gardener.cloud/description": "Allows egress traffic from all pods in this namespace to the API server in TCP port 443."},
+							},
+							Spec: networkingv1.NetworkPolicySpec{
+								PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeEgress},
+								Egress: []networkingv1.NetworkPolicyEgressRule{{Ports: []networkingv1.NetworkPolicyPort{
+									{Protocol: ptr.To(corev1.ProtocolTCP), Port: ptr.To(intstr.FromInt32(443))},
+								}}},
+							},
+						},
+					},
+				}
 				config.Webhooks.PodSchedulerName = resourcemanagerconfigv1alpha1.PodSchedulerNameWebhookConfig{
 					Enabled:       !isWorkerless,
 					SchedulerName: ptr.To("bin-packing-scheduler"),
@@ -1473,6 +1576,7 @@ subjects:
 								APIGroups:   []string{"extensions.gardener.cloud"},
 								APIVersions: []string{"v1alpha1"},
 								Resources: []string{
+									"auditsinks",
 									"backupbuckets",
 									"backupentries",
 									"bastions",
@@ -1503,6 +1607,32 @@ subjects:
 					SideEffects:             &sideEffect,
 					TimeoutSeconds:          ptr.To[int32](10),
 				},
+				{
+					Name: "validation.extensions.auditsinks.resources.gardener.cloud",
+					Rules: []admissionregistrationv1.RuleWithOperations{
+						{
+							Rule: admissionregistrationv1.Rule{
+								APIGroups:   []string{"extensions.gardener.cloud"},
+								APIVersions: []string{"v1alpha1"},
+								Resources:   []string{"auditsinks"},
+							},
+							Operations: []admissionregistrationv1.OperationType{"CREATE", "UPDATE"},
+						},
+					},
+					FailurePolicy:     &failurePolicyFail,
+					NamespaceSelector: &metav1.LabelSelector{},
+					ClientConfig: admissionregistrationv1.WebhookClientConfig{
+						Service: &admissionregistrationv1.ServiceReference{
+							Name:      "gardener-resource-manager",
+							Namespace: deployNamespace,
+							Path:      ptr.To("/validate-extensions-gardener-cloud-v1alpha1-auditsink"),
+						},
+					},
+					AdmissionReviewVersions: []string{"v1beta1", "v1"},
+					MatchPolicy:             &matchPolicyExact,
+					SideEffects:             &sideEffect,
+					TimeoutSeconds:          ptr.To[int32](10),
+				},
 				{
 					Name: "validation.extensions.backupbuckets.resources.gardener.cloud",
 					Rules: []admissionregistrationv1.RuleWithOperations{
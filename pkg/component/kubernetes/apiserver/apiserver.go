@@ -61,6 +61,8 @@ type Interface interface {
 	// TODO(oliver-goetz): Consider removing this method when we support Kubernetes version with structured authorization only.
 	//  See https://github.com/gardener/gardener/pull/10682#discussion_r1816324389 for more information.
 	AppendAuthorizationWebhook(AuthorizationWebhook)
+	// SetAuditWebhook sets the audit webhook backend in the audit configuration of the Values of the deployer.
+	SetAuditWebhook(*apiserver.AuditWebhook)
 	// SetExternalHostname sets the ExternalHostname field in the Values of the deployer.
 	SetExternalHostname(string)
 	// SetExternalServer sets the ExternalServer field in the Values of the deployer.
@@ -551,6 +553,13 @@ func (k *kubeAPIServer) AppendAuthorizationWebhook(webhook AuthorizationWebhook)
 	k.values.AuthorizationWebhooks = append(k.values.AuthorizationWebhooks, webhook)
 }
 
+func (k *kubeAPIServer) SetAuditWebhook(webhook *apiserver.AuditWebhook) {
+	if k.values.Audit == nil {
+		k.values.Audit = &apiserver.AuditConfig{}
+	}
+	k.values.Audit.Webhook = webhook
+}
+
 func (k *kubeAPIServer) SetAutoscalingReplicas(replicas *int32) {
 	k.values.Autoscaling.Replicas = replicas
 }
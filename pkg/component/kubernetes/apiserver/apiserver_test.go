@@ -4547,6 +4547,22 @@ kind: AuthenticationConfiguration
 		})
 	})
 
+	Describe("#SetAuditWebhook", func() {
+		It("should properly set the field", func() {
+			v := &apiserver.AuditWebhook{Kubeconfig: []byte("kubeconfig")}
+			kapi.SetAuditWebhook(v)
+			Expect(kapi.GetValues().Audit.Webhook).To(Equal(v))
+		})
+
+		It("should keep the existing audit policy", func() {
+			kapi = New(kubernetesInterface, namespace, sm, Values{Values: apiserver.Values{Audit: &apiserver.AuditConfig{Policy: ptr.To("policy")}}})
+
+			v := &apiserver.AuditWebhook{Kubeconfig: []byte("kubeconfig")}
+			kapi.SetAuditWebhook(v)
+			Expect(kapi.GetValues().Audit).To(Equal(&apiserver.AuditConfig{Policy: ptr.To("policy"), Webhook: v}))
+		})
+	})
+
 	Describe("#SetServiceAccountConfig", func() {
 		It("should properly set the field", func() {
 			v := ServiceAccountConfig{Issuer: "foo"}
@@ -112,6 +112,18 @@ func (mr *MockInterfaceMockRecorder) GetValues() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetValues", reflect.TypeOf((*MockInterface)(nil).GetValues))
 }
 
+// SetAuditWebhook mocks base method.
+func (m *MockInterface) SetAuditWebhook(arg0 *apiserver.AuditWebhook) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetAuditWebhook", arg0)
+}
+
+// SetAuditWebhook indicates an expected call of SetAuditWebhook.
+func (mr *MockInterfaceMockRecorder) SetAuditWebhook(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAuditWebhook", reflect.TypeOf((*MockInterface)(nil).SetAuditWebhook), arg0)
+}
+
 // SetAutoscalingAPIServerResources mocks base method.
 func (m *MockInterface) SetAutoscalingAPIServerResources(arg0 v1.ResourceRequirements) {
 	m.ctrl.T.Helper()
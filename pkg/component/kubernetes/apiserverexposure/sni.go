@@ -9,6 +9,7 @@ import (
 	"context"
 	_ "embed"
 	"fmt"
+	"slices"
 	"text/template"
 
 	"github.com/Masterminds/sprig/v3"
@@ -34,6 +35,14 @@ var (
 	//go:embed templates/envoyfilter.yaml
 	envoyFilterSpecTemplateContent string
 	envoyFilterSpecTemplate        *template.Template
+
+	//go:embed templates/envoyfilter-accesslog.yaml
+	accessLogEnvoyFilterTemplateContent string
+	accessLogEnvoyFilterTemplate        *template.Template
+
+	//go:embed templates/authorizationpolicy.yaml
+	authorizationPolicyTemplateContent string
+	authorizationPolicyTemplate        *template.Template
 )
 
 func init() {
@@ -42,6 +51,16 @@ func init() {
 		Funcs(sprig.TxtFuncMap()).
 		Parse(envoyFilterSpecTemplateContent),
 	)
+	accessLogEnvoyFilterTemplate = template.Must(template.
+		New("access-log-envoy-filter").
+		Funcs(sprig.TxtFuncMap()).
+		Parse(accessLogEnvoyFilterTemplateContent),
+	)
+	authorizationPolicyTemplate = template.Must(template.
+		New("authorization-policy").
+		Funcs(sprig.TxtFuncMap()).
+		Parse(authorizationPolicyTemplateContent),
+	)
 }
 
 // SNIValues configure the kube-apiserver service SNI.
@@ -49,6 +68,8 @@ type SNIValues struct {
 	Hosts               []string
 	APIServerProxy      *APIServerProxy
 	IstioIngressGateway IstioIngressGateway
+	AuthorizedNetworks  *AuthorizedNetworks
+	AccessLog           *AccessLog
 }
 
 // APIServerProxy contains values for the APIServer proxy protocol configuration.
@@ -56,6 +77,32 @@ type APIServerProxy struct {
 	APIServerClusterIP string
 }
 
+// AuthorizedNetworks contains the values for restricting the access to the kube-apiserver and the VPN endpoint to the
+// given networks. The restriction is enforced by the istio ingress gateway based on the SNI of the connections to the
+// kube-apiserver and the Reversed-VPN header of the VPN connections.
+type AuthorizedNetworks struct {
+	// Hosts are the external host names of the kube-apiserver which can only be accessed from CIDRs.
+	Hosts []string
+	// CIDRs are the networks which are allowed to access the kube-apiserver.
+	CIDRs []string
+	// InternalHosts are the internal host names of the kube-apiserver which can only be accessed from CIDRs and
+	// InternalCIDRs.
+	InternalHosts []string
+	// InternalCIDRs are the networks which are additionally allowed to access the internal hosts and the VPN endpoint,
+	// e.g., the egress networks of the shoot's nodes. If empty, the internal hosts and the VPN endpoint are not restricted.
+	InternalCIDRs []string
+	// VPNGatewayPort is the port of the VPN endpoint of the istio ingress gateway. If zero, the VPN endpoint is not
+	// restricted.
+	VPNGatewayPort int
+}
+
+// AccessLog contains the values for the sampled access logging of the connections to the kube-apiserver at the istio
+// ingress gateway.
+type AccessLog struct {
+	// SamplingPercentage is the percentage (1-100) of the connections which are logged.
+	SamplingPercentage int32
+}
+
 // IstioIngressGateway contains the values for istio ingress gateway configuration.
 type IstioIngressGateway struct {
 	Namespace string
@@ -99,6 +146,24 @@ type envoyFilterTemplateValues struct {
 	APIServerClusterIPPrefixLen int
 }
 
+type accessLogEnvoyFilterTemplateValues struct {
+	*AccessLog
+	IngressGatewayLabels map[string]string
+	Name                 string
+	Namespace            string
+	ShootNamespace       string
+	Hosts                []string
+}
+
+type authorizationPolicyTemplateValues struct {
+	*AuthorizedNetworks
+	AllowedInternalCIDRs []string
+	VPNHeaderValue       string
+	IngressGatewayLabels map[string]string
+	Name                 string
+	Namespace            string
+}
+
 func (s *sni) Deploy(ctx context.Context) error {
 	var (
 		values = s.valuesFunc()
@@ -111,6 +176,8 @@ func (s *sni) Deploy(ctx context.Context) error {
 		envoyFilterSpec bytes.Buffer
 	)
 
+	registry := managedresources.NewRegistry(kubernetes.SeedScheme, kubernetes.SeedCodec, kubernetes.SeedSerializer)
+
 	if values.APIServerProxy != nil {
 		envoyFilter := s.emptyEnvoyFilter()
 		apiServerClusterIPPrefixLen, err := netutils.GetBitLen(values.APIServerProxy.APIServerClusterIP)
@@ -130,10 +197,54 @@ func (s *sni) Deploy(ctx context.Context) error {
 			return err
 		}
 
-		filename := fmt.Sprintf("envoyfilter__%s__%s.yaml", envoyFilter.Namespace, envoyFilter.Name)
-		registry := managedresources.NewRegistry(kubernetes.SeedScheme, kubernetes.SeedCodec, kubernetes.SeedSerializer)
-		registry.AddSerialized(filename, envoyFilterSpec.Bytes())
+		registry.AddSerialized(fmt.Sprintf("envoyfilter__%s__%s.yaml", envoyFilter.Namespace, envoyFilter.Name), envoyFilterSpec.Bytes())
+	}
 
+	restrictAccess := values.AuthorizedNetworks != nil && len(values.AuthorizedNetworks.CIDRs) > 0 &&
+		(len(values.AuthorizedNetworks.Hosts) > 0 || len(values.AuthorizedNetworks.InternalCIDRs) > 0)
+	if restrictAccess {
+		var (
+			authorizationPolicy     = s.emptyAuthorizationPolicy()
+			authorizationPolicySpec bytes.Buffer
+		)
+
+		if err := authorizationPolicyTemplate.Execute(&authorizationPolicySpec, authorizationPolicyTemplateValues{
+			AuthorizedNetworks:   values.AuthorizedNetworks,
+			AllowedInternalCIDRs: append(slices.Clone(values.AuthorizedNetworks.CIDRs), values.AuthorizedNetworks.InternalCIDRs...),
+			// The VPN clients of the shoot connect to the vpn-seed-server services in the control plane namespace.
+			VPNHeaderValue:       fmt.Sprintf("*.%s.svc.%s", s.namespace, gardencorev1beta1.DefaultDomain),
+			IngressGatewayLabels: values.IstioIngressGateway.Labels,
+			Name:                 authorizationPolicy.Name,
+			Namespace:            authorizationPolicy.Namespace,
+		}); err != nil {
+			return err
+		}
+
+		registry.AddSerialized(fmt.Sprintf("authorizationpolicy__%s__%s.yaml", authorizationPolicy.Namespace, authorizationPolicy.Name), authorizationPolicySpec.Bytes())
+	}
+
+	accessLogging := values.AccessLog != nil && values.AccessLog.SamplingPercentage > 0 && len(values.Hosts) > 0
+	if accessLogging {
+		var (
+			accessLogEnvoyFilter     = s.emptyAccessLogEnvoyFilter()
+			accessLogEnvoyFilterSpec bytes.Buffer
+		)
+
+		if err := accessLogEnvoyFilterTemplate.Execute(&accessLogEnvoyFilterSpec, accessLogEnvoyFilterTemplateValues{
+			AccessLog:            values.AccessLog,
+			IngressGatewayLabels: values.IstioIngressGateway.Labels,
+			Name:                 accessLogEnvoyFilter.Name,
+			Namespace:            accessLogEnvoyFilter.Namespace,
+			ShootNamespace:       s.namespace,
+			Hosts:                values.Hosts,
+		}); err != nil {
+			return err
+		}
+
+		registry.AddSerialized(fmt.Sprintf("envoyfilter__%s__%s.yaml", accessLogEnvoyFilter.Namespace, accessLogEnvoyFilter.Name), accessLogEnvoyFilterSpec.Bytes())
+	}
+
+	if values.APIServerProxy != nil || restrictAccess || accessLogging {
 		serializedObjects, err := registry.SerializedObjects()
 		if err != nil {
 			return err
@@ -142,6 +253,8 @@ func (s *sni) Deploy(ctx context.Context) error {
 		if err := managedresources.CreateForSeed(ctx, s.client, s.namespace, managedResourceName, false, serializedObjects); err != nil {
 			return err
 		}
+	} else if err := managedresources.DeleteForSeed(ctx, s.client, s.namespace, managedResourceName); err != nil {
+		return err
 	}
 
 	if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, s.client, destinationRule, istio.DestinationRuleWithLocalityPreference(destinationRule, getLabels(), hostName)); err != nil {
@@ -184,6 +297,14 @@ func (s *sni) emptyEnvoyFilter() *istionetworkingv1alpha3.EnvoyFilter {
 	return &istionetworkingv1alpha3.EnvoyFilter{ObjectMeta: metav1.ObjectMeta{Name: s.namespace, Namespace: s.valuesFunc().IstioIngressGateway.Namespace}}
 }
 
+func (s *sni) emptyAccessLogEnvoyFilter() *istionetworkingv1alpha3.EnvoyFilter {
+	return &istionetworkingv1alpha3.EnvoyFilter{ObjectMeta: metav1.ObjectMeta{Name: s.namespace + "-access-log", Namespace: s.valuesFunc().IstioIngressGateway.Namespace}}
+}
+
+func (s *sni) emptyAuthorizationPolicy() metav1.ObjectMeta {
+	return metav1.ObjectMeta{Name: s.namespace, Namespace: s.valuesFunc().IstioIngressGateway.Namespace}
+}
+
 func (s *sni) emptyGateway() *istionetworkingv1beta1.Gateway {
 	return &istionetworkingv1beta1.Gateway{ObjectMeta: metav1.ObjectMeta{Name: s.name, Namespace: s.namespace}}
 }
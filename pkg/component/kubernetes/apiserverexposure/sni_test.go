@@ -6,6 +6,7 @@ package apiserverexposure_test
 
 import (
 	"context"
+	"strings"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -46,6 +47,8 @@ var _ = Describe("#SNI", func() {
 		hostName         = "kube-apiserver." + namespace + ".svc.cluster.local"
 
 		apiServerProxyValues *APIServerProxy
+		authorizedNetworks   *AuthorizedNetworks
+		accessLog            *AccessLog
 
 		expectedDestinationRule       *istionetworkingv1beta1.DestinationRule
 		expectedGateway               *istionetworkingv1beta1.Gateway
@@ -66,6 +69,8 @@ var _ = Describe("#SNI", func() {
 		apiServerProxyValues = &APIServerProxy{
 			APIServerClusterIP: "1.1.1.1",
 		}
+		authorizedNetworks = nil
+		accessLog = nil
 
 		expectedDestinationRule = &istionetworkingv1beta1.DestinationRule{
 			ObjectMeta: metav1.ObjectMeta{
@@ -179,8 +184,10 @@ var _ = Describe("#SNI", func() {
 	JustBeforeEach(func() {
 		defaultDepWaiter = NewSNI(c, v1beta1constants.DeploymentNameKubeAPIServer, namespace, func() *SNIValues {
 			val := &SNIValues{
-				Hosts:          hosts,
-				APIServerProxy: apiServerProxyValues,
+				Hosts:              hosts,
+				APIServerProxy:     apiServerProxyValues,
+				AuthorizedNetworks: authorizedNetworks,
+				AccessLog:          accessLog,
 				IstioIngressGateway: IstioIngressGateway{
 					Namespace: istioNamespace,
 					Labels:    istioLabels,
@@ -191,6 +198,8 @@ var _ = Describe("#SNI", func() {
 	})
 
 	Describe("#Deploy", func() {
+		var managedResourceData []byte
+
 		test := func() {
 			Expect(defaultDepWaiter.Deploy(ctx)).To(Succeed())
 
@@ -223,8 +232,11 @@ var _ = Describe("#SNI", func() {
 
 				mrData, err := test.BrotliDecompression(managedResourceSecret.Data["data.yaml.br"])
 				Expect(err).NotTo(HaveOccurred())
+				managedResourceData = mrData
 
-				managedResourceEnvoyFilter, _, err := kubernetes.ShootCodec.UniversalDecoder().Decode(mrData, nil, &istionetworkingv1alpha3.EnvoyFilter{})
+				// the EnvoyFilter is the last document since the objects are sorted by their file names
+				documents := strings.Split(string(mrData), "---\n")
+				managedResourceEnvoyFilter, _, err := kubernetes.ShootCodec.UniversalDecoder().Decode([]byte(documents[len(documents)-1]), nil, &istionetworkingv1alpha3.EnvoyFilter{})
 				Expect(err).ToNot(HaveOccurred())
 				Expect(managedResourceEnvoyFilter.GetObjectKind()).To(Equal(&metav1.TypeMeta{Kind: "EnvoyFilter", APIVersion: "networking.istio.io/v1alpha3"}))
 				actualEnvoyFilter := managedResourceEnvoyFilter.(*istionetworkingv1alpha3.EnvoyFilter)
@@ -247,6 +259,144 @@ var _ = Describe("#SNI", func() {
 			It("should succeed deploying", func() {
 				test()
 			})
+
+			It("should delete the managed resource", func() {
+				Expect(c.Create(ctx, &resourcesv1alpha1.ManagedResource{ObjectMeta: metav1.ObjectMeta{Name: expectedManagedResource.Name, Namespace: namespace}})).To(Succeed())
+
+				test()
+
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(expectedManagedResource), &resourcesv1alpha1.ManagedResource{})).To(BeNotFoundError())
+			})
+		})
+
+		Context("when access logging is configured", func() {
+			BeforeEach(func() {
+				accessLog = &AccessLog{SamplingPercentage: 5}
+			})
+
+			It("should deploy an envoy filter for sampled access logging", func() {
+				test()
+
+				Expect(string(managedResourceData)).To(ContainSubstring(`apiVersion: networking.istio.io/v1alpha3
+kind: EnvoyFilter
+metadata:
+  name: test-namespace-access-log
+  namespace: istio-foo
+spec:
+  workloadSelector:
+    labels:
+      foo: bar
+  configPatches:
+  - applyTo: NETWORK_FILTER
+    match:
+      context: GATEWAY
+      listener:
+        portNumber: 9443
+        filterChain:
+          sni: "foo.bar"
+          filter:
+            name: envoy.filters.network.tcp_proxy
+    patch:
+      operation: MERGE
+      value:
+        typed_config:
+          "@type": type.googleapis.com/envoy.extensions.filters.network.tcp_proxy.v3.TcpProxy
+          access_log:
+          - name: envoy.access_loggers.file
+            filter:
+              runtime_filter:
+                runtime_key: "kube_apiserver_access_log.test-namespace"
+                percent_sampled:
+                  numerator: 5
+                  denominator: HUNDRED
+                use_independent_randomness: true
+`))
+				Expect(string(managedResourceData)).To(ContainSubstring(`shoot_namespace: "test-namespace"`))
+			})
+
+			It("should not deploy an envoy filter if the sampling percentage is zero", func() {
+				accessLog.SamplingPercentage = 0
+
+				test()
+
+				Expect(string(managedResourceData)).NotTo(ContainSubstring("kube-apiserver-access-sample"))
+			})
+		})
+
+		Context("when authorized networks are configured", func() {
+			BeforeEach(func() {
+				authorizedNetworks = &AuthorizedNetworks{
+					Hosts: []string{"api.foo.bar"},
+					CIDRs: []string{"10.0.0.0/8", "2001:db8::/32"},
+				}
+			})
+
+			It("should deploy an authorization policy for the istio ingress gateway", func() {
+				test()
+
+				Expect(string(managedResourceData)).To(ContainSubstring(`apiVersion: security.istio.io/v1beta1
+kind: AuthorizationPolicy
+metadata:
+  name: test-namespace
+  namespace: istio-foo
+spec:
+  selector:
+    matchLabels:
+      foo: bar
+  action: DENY
+  rules:
+  - from:
+    - source:
+        notRemoteIpBlocks:
+        - "10.0.0.0/8"
+        - "2001:db8::/32"
+    when:
+    - key: connection.sni
+      values:
+      - "api.foo.bar"
+`))
+				Expect(string(managedResourceData)).To(ContainSubstring("kind: EnvoyFilter"))
+				Expect(string(managedResourceData)).NotTo(ContainSubstring("Reversed-VPN"))
+				Expect(string(managedResourceData)).NotTo(ContainSubstring("kube-apiserver-access-sample"))
+			})
+
+			It("should also restrict the internal domain and the VPN endpoint if internal networks are configured", func() {
+				authorizedNetworks.InternalHosts = []string{"api.internal.foo.bar"}
+				authorizedNetworks.InternalCIDRs = []string{"1.2.3.4/32", "100.64.0.0/12"}
+				authorizedNetworks.VPNGatewayPort = 8132
+
+				test()
+
+				Expect(string(managedResourceData)).To(ContainSubstring(`      values:
+      - "api.foo.bar"
+  - from:
+    - source:
+        notRemoteIpBlocks:
+        - "10.0.0.0/8"
+        - "2001:db8::/32"
+        - "1.2.3.4/32"
+        - "100.64.0.0/12"
+    when:
+    - key: connection.sni
+      values:
+      - "api.internal.foo.bar"
+  - from:
+    - source:
+        notRemoteIpBlocks:
+        - "10.0.0.0/8"
+        - "2001:db8::/32"
+        - "1.2.3.4/32"
+        - "100.64.0.0/12"
+    to:
+    - operation:
+        ports:
+        - "8132"
+    when:
+    - key: request.headers[Reversed-VPN]
+      values:
+      - "*.test-namespace.svc.cluster.local"
+`))
+			})
 		})
 	})
 
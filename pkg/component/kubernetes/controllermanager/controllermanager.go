@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"slices"
 	"strings"
 	"time"
 
@@ -44,6 +45,7 @@ import (
 	netutils "github.com/gardener/gardener/pkg/utils/net"
 	"github.com/gardener/gardener/pkg/utils/secrets"
 	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
+	kubecontrollermanagervalidation "github.com/gardener/gardener/pkg/utils/validation/kubecontrollermanager"
 	versionutils "github.com/gardener/gardener/pkg/utils/version"
 )
 
@@ -760,6 +762,20 @@ func (k *kubeControllerManager) computeCommand(port int32) []string {
 		}
 	}
 
+	if k.values.Config != nil {
+		for _, controller := range k.values.Config.Controllers {
+			if controller.Enabled == nil {
+				continue
+			}
+
+			if *controller.Enabled {
+				controllersToEnable.Insert(controller.Name)
+			} else {
+				controllersToDisable.Insert(controller.Name)
+			}
+		}
+	}
+
 	cmdControllers := "--controllers=" + strings.Join(sets.List(controllersToEnable.Difference(controllersToDisable)), ",")
 	if controllersToDisable.Len() > 0 {
 		cmdControllers += ",-" + strings.Join(sets.List(controllersToDisable), ",-")
@@ -781,6 +797,8 @@ func (k *kubeControllerManager) computeCommand(port int32) []string {
 		command = append(command, fmt.Sprintf("--concurrent-serviceaccount-token-syncs=%d", v))
 	}
 
+	command = k.applyControllerConcurrentSyncs(command)
+
 	if k.values.Config != nil && len(k.values.Config.FeatureGates) > 0 {
 		command = append(command, kubernetesutils.FeatureGatesToCommandLineParameter(k.values.Config.FeatureGates))
 	}
@@ -809,6 +827,34 @@ func (k *kubeControllerManager) computeCommand(port int32) []string {
 	return command
 }
 
+// applyControllerConcurrentSyncs overwrites the `--concurrent-*-syncs` flags in the given command with the values
+// configured for the individual controllers, or appends them if they are not present yet.
+func (k *kubeControllerManager) applyControllerConcurrentSyncs(command []string) []string {
+	if k.values.Config == nil {
+		return command
+	}
+
+	for _, controller := range k.values.Config.Controllers {
+		flag := kubecontrollermanagervalidation.ConcurrentSyncsFlag(controller.Name)
+		if controller.ConcurrentSyncs == nil || flag == "" {
+			continue
+		}
+
+		var (
+			prefix = "--" + flag + "="
+			arg    = fmt.Sprintf("%s%d", prefix, *controller.ConcurrentSyncs)
+		)
+
+		if i := slices.IndexFunc(command, func(c string) bool { return strings.HasPrefix(c, prefix) }); i >= 0 {
+			command[i] = arg
+		} else {
+			command = append(command, arg)
+		}
+	}
+
+	return command
+}
+
 func (k *kubeControllerManager) getHorizontalPodAutoscalerConfig() gardencorev1beta1.HorizontalPodAutoscalerConfig {
 	defaultHPATolerance := gardencorev1beta1.DefaultHPATolerance
 	horizontalPodAutoscalerConfig := gardencorev1beta1.HorizontalPodAutoscalerConfig{
@@ -816,6 +816,46 @@ namespace: kube-system
 			),
 		)
 
+		Context("when controllers are configured", func() {
+			BeforeEach(func() {
+				values = Values{
+					RuntimeVersion:         runtimeKubernetesVersion,
+					TargetVersion:          semverVersion,
+					Image:                  image,
+					PriorityClassName:      priorityClassName,
+					PodNetworks:            podCIDRs,
+					ServiceNetworks:        serviceCIDRs,
+					ClusterSigningDuration: clusterSigningDuration,
+					ControllerWorkers:      controllerWorkers,
+					ControllerSyncPeriods:  controllerSyncPeriods,
+					Config: &gardencorev1beta1.KubeControllerManagerConfig{
+						Controllers: []gardencorev1beta1.KubeControllerManagerController{
+							{Name: "ttl-after-finished", Enabled: ptr.To(false)},
+							{Name: "deployment", ConcurrentSyncs: ptr.To[int32](100)},
+							{Name: "service", ConcurrentSyncs: ptr.To[int32](5)},
+						},
+					},
+				}
+				kubeControllerManager = New(testLogger, fakeInterface, namespace, sm, values)
+				kubeControllerManager.SetReplicaCount(replicas)
+			})
+
+			It("should translate the controllers to the respective flags", func() {
+				Expect(kubeControllerManager.Deploy(ctx)).To(Succeed())
+
+				actualDeployment := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "kube-controller-manager", Namespace: namespace}}
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(actualDeployment), actualDeployment)).To(Succeed())
+
+				command := actualDeployment.Spec.Template.Spec.Containers[0].Command
+				Expect(command).To(ContainElements(
+					"--controllers=*,bootstrapsigner,tokencleaner,-ttl-after-finished",
+					"--concurrent-deployment-syncs=100",
+					"--concurrent-service-syncs=5",
+				))
+				Expect(command).NotTo(ContainElement(HavePrefix("--concurrent-deployment-syncs=50")))
+			})
+		})
+
 		Context("when name prefix is set", func() {
 			BeforeEach(func() {
 				values = Values{
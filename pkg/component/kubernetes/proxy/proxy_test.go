@@ -659,6 +659,7 @@ echo "${KUBE_PROXY_MODE}" >"$1"
 								},
 								NodeSelector: map[string]string{
 									"worker.gardener.cloud/kubernetes-version": pool.KubernetesVersion.String(),
+									"kubernetes.io/os":                         "linux",
 									"worker.gardener.cloud/pool":               pool.Name,
 								},
 								PriorityClassName: "system-node-critical",
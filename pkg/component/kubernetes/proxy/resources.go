@@ -230,6 +230,7 @@ func (k *kubeProxy) computePoolResourcesData(pool WorkerPool) (map[string][]byte
 						NodeSelector: map[string]string{
 							v1beta1constants.LabelWorkerPool:              pool.Name,
 							v1beta1constants.LabelWorkerKubernetesVersion: pool.KubernetesVersion.String(),
+							corev1.LabelOSStable:                          v1beta1constants.OSFamilyLinux,
 						},
 						InitContainers:    k.getInitContainers(pool.KubernetesVersion, pool.Image),
 						PriorityClassName: "system-node-critical",
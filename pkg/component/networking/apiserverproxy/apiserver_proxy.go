@@ -24,6 +24,7 @@ import (
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/component"
@@ -74,7 +75,8 @@ type Values struct {
 	ProxySeedServerHost string
 	Image               string
 	SidecarImage        string
-	DNSLookupFamily     string
+	// IPFamilies are the IP families of the shoot.
+	IPFamilies []gardencorev1beta1.IPFamily
 
 	advertiseIPAddress string
 }
@@ -203,7 +205,7 @@ func (a *apiserverProxy) computeResourcesData() (map[string][]byte, error) {
 	var envoyYAML bytes.Buffer
 	if err := tplEnvoy.Execute(&envoyYAML, map[string]any{
 		"advertiseIPAddress":  a.values.advertiseIPAddress,
-		"dnsLookupFamily":     a.values.DNSLookupFamily,
+		"dnsLookupFamily":     dnsLookupFamily(a.values.IPFamilies),
 		"adminPort":           adminPort,
 		"proxySeedServerHost": a.values.ProxySeedServerHost,
 		"proxySeedServerPort": proxySeedServerPort,
@@ -285,6 +287,7 @@ func (a *apiserverProxy) computeResourcesData() (map[string][]byte, error) {
 					Spec: corev1.PodSpec{
 						ServiceAccountName: name,
 						PriorityClassName:  "system-node-critical",
+						NodeSelector:       map[string]string{corev1.LabelOSStable: v1beta1constants.OSFamilyLinux},
 						Tolerations: []corev1.Toleration{
 							{Effect: corev1.TaintEffectNoSchedule, Operator: corev1.TolerationOpExists},
 							{Effect: corev1.TaintEffectNoExecute, Operator: corev1.TolerationOpExists},
@@ -457,6 +460,25 @@ func (a *apiserverProxy) computeResourcesData() (map[string][]byte, error) {
 	)
 }
 
+// dnsLookupFamily returns the lookup family used for resolving the kube-apiserver host in the seed. Single-stack shoots
+// use only their IP family as AUTO causes an unnecessary failed lookup, see
+// https://www.envoyproxy.io/docs/envoy/latest/api-v3/config/cluster/v3/cluster.proto#enum-config-cluster-v3-cluster-dnslookupfamily.
+// Dual-stack shoots prefer their primary IP family and fall back to the secondary one, e.g., if the seed is reachable
+// via one IP family only.
+func dnsLookupFamily(ipFamilies []gardencorev1beta1.IPFamily) string {
+	switch {
+	case gardencorev1beta1.IsIPv4SingleStack(ipFamilies):
+		return "V4_ONLY"
+	case gardencorev1beta1.IsIPv6SingleStack(ipFamilies):
+		return "V6_ONLY"
+	case ipFamilies[0] == gardencorev1beta1.IPFamilyIPv6:
+		// AUTO prefers IPv6 and falls back to IPv4.
+		return "AUTO"
+	default:
+		return "V4_PREFERRED"
+	}
+}
+
 func getDefaultLabels() map[string]string {
 	return utils.MergeStringMaps(
 		map[string]string{
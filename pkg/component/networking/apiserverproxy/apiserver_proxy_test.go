@@ -49,6 +49,7 @@ var _ = Describe("APIServerProxy", func() {
 		values             Values
 		component          Interface
 		advertiseIPAddress string
+		dnsLookupFamily    string
 
 		managedResourceName = "shoot-core-apiserver-proxy"
 		namespace           = "some-namespace"
@@ -185,11 +186,11 @@ var _ = Describe("APIServerProxy", func() {
 
 	BeforeEach(func() {
 		advertiseIPAddress = "10.2.170.21"
+		dnsLookupFamily = "V4_ONLY"
 		values = Values{
 			Image:               image,
 			SidecarImage:        sidecarImage,
 			ProxySeedServerHost: proxySeedServerHost,
-			DNSLookupFamily:     "V4_ONLY",
 		}
 	})
 
@@ -248,7 +249,7 @@ var _ = Describe("APIServerProxy", func() {
 			utilruntime.Must(references.InjectAnnotations(expectedMr))
 			Expect(managedResource).To(DeepEqual(expectedMr))
 			Expect(managedResource).To(consistOf(
-				getConfigYAML(hash, values.DNSLookupFamily, advertiseIPAddress),
+				getConfigYAML(hash, dnsLookupFamily, advertiseIPAddress),
 				getDaemonSet(hash, advertiseIPAddress),
 				service,
 				serviceAccount,
@@ -274,7 +275,8 @@ var _ = Describe("APIServerProxy", func() {
 
 		Context("IPv6", func() {
 			BeforeEach(func() {
-				values.DNSLookupFamily = "V6_ONLY"
+				values.IPFamilies = []gardencorev1beta1.IPFamily{gardencorev1beta1.IPFamilyIPv6}
+				dnsLookupFamily = "V6_ONLY"
 				advertiseIPAddress = "2001:db8::1"
 			})
 
@@ -282,6 +284,29 @@ var _ = Describe("APIServerProxy", func() {
 				test("3fdb1aaf")
 			})
 		})
+
+		Context("dual-stack (IPv4 primary)", func() {
+			BeforeEach(func() {
+				values.IPFamilies = []gardencorev1beta1.IPFamily{gardencorev1beta1.IPFamilyIPv4, gardencorev1beta1.IPFamilyIPv6}
+				dnsLookupFamily = "V4_PREFERRED"
+			})
+
+			It("should deploy the managed resource successfully", func() {
+				test("ea92916f")
+			})
+		})
+
+		Context("dual-stack (IPv6 primary)", func() {
+			BeforeEach(func() {
+				values.IPFamilies = []gardencorev1beta1.IPFamily{gardencorev1beta1.IPFamilyIPv6, gardencorev1beta1.IPFamilyIPv4}
+				dnsLookupFamily = "AUTO"
+				advertiseIPAddress = "64:ff9b:1::a02:aa15"
+			})
+
+			It("should deploy the managed resource successfully", func() {
+				test("8a847994")
+			})
+		})
 	})
 
 	Describe("#Destroy", func() {
@@ -719,6 +744,7 @@ func getDaemonSet(hash string, advertiseIPAddress string) *appsv1.DaemonSet {
 						},
 					},
 					PriorityClassName: "system-node-critical",
+					NodeSelector:      map[string]string{"kubernetes.io/os": "linux"},
 					SecurityContext: &corev1.PodSecurityContext{
 						SeccompProfile: &corev1.SeccompProfile{
 							Type: corev1.SeccompProfileTypeRuntimeDefault,
@@ -9,6 +9,7 @@ import (
 	"net"
 	"regexp"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/Masterminds/semver/v3"
@@ -63,6 +64,9 @@ const (
 	volumeNameConfigCustom      = "custom-config-volume"
 	volumeMountPathConfig       = "/etc/coredns"
 	volumeMountPathConfigCustom = "/etc/coredns/custom"
+
+	// defaultDNS64Prefix is the well-known prefix used by the dns64 plugin, see RFC 6052.
+	defaultDNS64Prefix = "64:ff9b::/96"
 )
 
 // Interface contains functions for a CoreDNS deployer.
@@ -103,6 +107,8 @@ type Values struct {
 	SearchPathRewriteCommonSuffixes []string
 	// IPFamilies specifies the IP protocol versions to use for core dns.
 	IPFamilies []gardencorev1beta1.IPFamily
+	// CustomPlugins contains the settings of the optional plugins in the plugin chain of CoreDNS.
+	CustomPlugins *gardencorev1beta1.CoreDNSCustomPlugins
 }
 
 // New creates a new instance of DeployWaiter for coredns.
@@ -293,10 +299,7 @@ func (c *coreDNS) computeResourcesData() (map[string][]byte, error) {
 			},
 			Data: map[string]string{
 				configDataKey: `.:` + strconv.Itoa(corednsconstants.PortServer) + ` {
-  errors
-  log . {
-      class error
-  }
+  errors` + getLogPlugin(c.values.CustomPlugins) + `
   health {
       lameduck 15s
   }
@@ -306,7 +309,7 @@ func (c *coreDNS) computeResourcesData() (map[string][]byte, error) {
       fallthrough in-addr.arpa ip6.arpa
       ttl 30
   }
-  prometheus :` + strconv.Itoa(portMetrics) + `
+  prometheus :` + strconv.Itoa(portMetrics) + getDNS64Plugin(c.values.CustomPlugins) + `
   forward . /etc/resolv.conf
   cache 30
   loop
@@ -314,7 +317,7 @@ func (c *coreDNS) computeResourcesData() (map[string][]byte, error) {
   loadbalance round_robin
   import custom/*.override
 }
-import custom/*.server
+` + getForwardZoneServerBlocks(c.values.CustomPlugins) + `import custom/*.server
 `,
 			},
 		}
@@ -858,6 +861,42 @@ func getSearchPathRewrites(clusterDomain string, commonSuffixes []string) string
   }` + suffixRewrites
 }
 
+func getLogPlugin(customPlugins *gardencorev1beta1.CoreDNSCustomPlugins) string {
+	if customPlugins != nil && ptr.Deref(customPlugins.Log, false) {
+		return `
+  log`
+	}
+	return `
+  log . {
+      class error
+  }`
+}
+
+func getDNS64Plugin(customPlugins *gardencorev1beta1.CoreDNSCustomPlugins) string {
+	if customPlugins == nil || customPlugins.DNS64 == nil {
+		return ""
+	}
+	return `
+  dns64 ` + ptr.Deref(customPlugins.DNS64.Prefix, defaultDNS64Prefix)
+}
+
+func getForwardZoneServerBlocks(customPlugins *gardencorev1beta1.CoreDNSCustomPlugins) string {
+	if customPlugins == nil {
+		return ""
+	}
+
+	serverBlocks := ""
+	for _, forwardZone := range customPlugins.ForwardZones {
+		serverBlocks += strings.TrimSuffix(forwardZone.Zone, ".") + `:` + strconv.Itoa(corednsconstants.PortServer) + ` {
+  errors` + getLogPlugin(customPlugins) + `
+  cache 30
+  forward . ` + strings.Join(forwardZone.Upstreams, " ") + `
+}
+`
+	}
+	return serverBlocks
+}
+
 func getIPFamilyPolicy(ipFamilies []gardencorev1beta1.IPFamily) corev1.IPFamilyPolicy {
 	ipFamiliesSet := sets.New[gardencorev1beta1.IPFamily](ipFamilies...)
 	if ipFamiliesSet.Has(gardencorev1beta1.IPFamilyIPv4) && ipFamiliesSet.Has(gardencorev1beta1.IPFamilyIPv6) {
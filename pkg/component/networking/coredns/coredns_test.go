@@ -8,6 +8,7 @@ import (
 	"context"
 	"net"
 	"regexp"
+	"strings"
 
 	"github.com/Masterminds/semver/v3"
 	. "github.com/onsi/ginkgo/v2"
@@ -117,15 +118,21 @@ subjects:
   name: coredns
   namespace: kube-system
 `
-		configMapYAML = func(commonSuffixes []string) string {
+		configMapYAML = func(commonSuffixes []string, customPlugins *gardencorev1beta1.CoreDNSCustomPlugins) string {
+			logPlugin := `
+      log . {
+          class error
+      }`
+			if customPlugins != nil && ptr.Deref(customPlugins.Log, false) {
+				logPlugin = `
+      log`
+			}
+
 			out := `apiVersion: v1
 data:
   Corefile: |
     .:8053 {
-      errors
-      log . {
-          class error
-      }
+      errors` + logPlugin + `
       health {
           lameduck 15s
       }
@@ -149,7 +156,12 @@ data:
           fallthrough in-addr.arpa ip6.arpa
           ttl 30
       }
-      prometheus :9153
+      prometheus :9153`
+			if customPlugins != nil && customPlugins.DNS64 != nil {
+				out += `
+      dns64 ` + ptr.Deref(customPlugins.DNS64.Prefix, "64:ff9b::/96")
+			}
+			out += `
       forward . /etc/resolv.conf
       cache 30
       loop
@@ -157,7 +169,18 @@ data:
       loadbalance round_robin
       import custom/*.override
     }
-    import custom/*.server
+`
+			if customPlugins != nil {
+				for _, forwardZone := range customPlugins.ForwardZones {
+					out += `    ` + strings.TrimSuffix(forwardZone.Zone, ".") + `:8053 {
+      errors` + logPlugin + `
+      cache 30
+      forward . ` + strings.Join(forwardZone.Upstreams, " ") + `
+    }
+`
+				}
+			}
+			out += `    import custom/*.server
 kind: ConfigMap
 metadata:
   creationTimestamp: null
@@ -747,6 +770,7 @@ status: {}
 			cpaEnabled = false
 			vpaEnabled = false
 			commonSuffixes = []string{}
+			values.CustomPlugins = nil
 			k8sGreaterEquals126 = false
 			values.IPFamilies = []gardencorev1beta1.IPFamily{gardencorev1beta1.IPFamilyIPv4}
 		})
@@ -799,7 +823,7 @@ status: {}
 				serviceAccountYAML,
 				clusterRoleYAML,
 				clusterRoleBindingYAML,
-				configMapYAML(commonSuffixes),
+				configMapYAML(commonSuffixes, values.CustomPlugins),
 				configMapCustomYAML,
 				serviceYAML(ipFamilyPolicy(values.IPFamilies)),
 				networkPolicyYAML,
@@ -894,6 +918,40 @@ status: {}
 			})
 		})
 
+		Context("w/ custom plugins", func() {
+			BeforeEach(func() {
+				values.CustomPlugins = &gardencorev1beta1.CoreDNSCustomPlugins{
+					Log:   ptr.To(true),
+					DNS64: &gardencorev1beta1.CoreDNSDNS64{},
+					ForwardZones: []gardencorev1beta1.CoreDNSForwardZone{
+						{Zone: "corp.example.com.", Upstreams: []string{"10.0.0.10", "10.0.0.11:5353"}},
+						{Zone: "10.in-addr.arpa", Upstreams: []string{"[2001:db8::53]:53"}},
+					},
+				}
+			})
+
+			It("should successfully deploy all resources", func() {
+				Expect(manifests).To(ContainElement(deploymentYAMLFor("", nil, true, true)))
+				Expect(manifests).To(ContainElement(ContainSubstring(`
+      dns64 64:ff9b::/96
+      forward . /etc/resolv.conf`)))
+				Expect(manifests).To(ContainElement(ContainSubstring(`
+    corp.example.com:8053 {
+      errors
+      log
+      cache 30
+      forward . 10.0.0.10 10.0.0.11:5353
+    }
+    10.in-addr.arpa:8053 {
+      errors
+      log
+      cache 30
+      forward . [2001:db8::53]:53
+    }
+    import custom/*.server`)))
+			})
+		})
+
 		Context("w/ cluster proportional autoscaler enabled", func() {
 			BeforeEach(func() {
 				cpaEnabled = true
@@ -31,6 +31,7 @@ type IngressGatewayValues struct {
 	Image                              string
 	IstiodNamespace                    string
 	LoadBalancerIP                     *string
+	LoadBalancerSourceRanges           []string
 	MaxReplicas                        *int
 	MinReplicas                        *int
 	Namespace                          string
@@ -65,6 +66,7 @@ func (i *istiod) generateIstioIngressGatewayChart() (*chartrenderer.RenderedChar
 			"image":                              istioIngressGateway.Image,
 			"istiodNamespace":                    istioIngressGateway.IstiodNamespace,
 			"loadBalancerIP":                     istioIngressGateway.LoadBalancerIP,
+			"loadBalancerSourceRanges":           istioIngressGateway.LoadBalancerSourceRanges,
 			"serviceName":                        v1beta1constants.DefaultSNIIngressServiceName,
 			"proxyProtocolEnabled":               istioIngressGateway.ProxyProtocolEnabled,
 			"terminateLoadBalancerProxyProtocol": istioIngressGateway.TerminateLoadBalancerProxyProtocol,
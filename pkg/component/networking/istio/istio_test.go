@@ -203,6 +203,11 @@ var _ = Describe("istiod", func() {
 			return string(data)
 		}
 
+		istioIngressServiceSourceRanges = func() string {
+			data, _ := os.ReadFile("./test_charts/ingress_service_source_ranges.yaml")
+			return string(data)
+		}
+
 		istioIngressServiceAccount = func() string {
 			data, _ := os.ReadFile("./test_charts/ingress_serviceaccount.yaml")
 			return string(data)
@@ -624,6 +629,38 @@ var _ = Describe("istiod", func() {
 			})
 		})
 
+		Context("load balancer source ranges", func() {
+			BeforeEach(func() {
+				externalTrafficPolicy = corev1.ServiceExternalTrafficPolicyCluster
+				igw[0].ExternalTrafficPolicy = &externalTrafficPolicy
+				igw[0].LoadBalancerSourceRanges = []string{"10.0.0.0/8", "192.168.0.0/16"}
+				istiod = NewIstio(
+					c,
+					renderer,
+					Values{
+						Istiod: IstiodValues{
+							Enabled:     true,
+							Image:       "foo/bar",
+							Namespace:   deployNS,
+							TrustDomain: "foo.local",
+							Zones:       []string{"a", "b", "c"},
+						},
+						IngressGateway: igw,
+					},
+				)
+			})
+
+			It("should successfully deploy correct load balancer source ranges", func() {
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResourceIstioSecret), managedResourceIstioSecret)).To(Succeed())
+
+				var err error
+				istioManifests, err := test.ExtractManifestsFromManagedResourceData(managedResourceIstioSecret.Data)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(istioManifests).To(ContainElement(istioIngressServiceSourceRanges()))
+			})
+		})
+
 		Context("dual stack istio service", func() {
 			BeforeEach(func() {
 				externalTrafficPolicy = corev1.ServiceExternalTrafficPolicyLocal
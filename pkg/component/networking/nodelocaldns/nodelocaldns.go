@@ -220,49 +220,46 @@ func (n *nodeLocalDNS) computeResourcesData() (map[string][]byte, error) {
 			Data: map[string]string{
 				configDataKey: domain + `:53 {
     errors
-    cache {
-            success 9984 30
-            denial 9984 5
-    }
+    ` + n.cache(domain) + `
     reload
     loop
     bind ` + n.bindIP() + `
     forward . ` + strings.Join(n.values.ClusterDNS, " ") + ` {
-            ` + n.forceTcpToClusterDNS() + `
+            ` + n.forwardProtocol(domain, n.forceTcpToClusterDNS()) + `
     }
     prometheus :` + strconv.Itoa(prometheusPort) + `
     health ` + n.getHealthAddress() + `:` + strconv.Itoa(livenessProbePort) + `
     }
 in-addr.arpa:53 {
     errors
-    cache 30
+    ` + n.cache("in-addr.arpa") + `
     reload
     loop
     bind ` + n.bindIP() + `
     forward . ` + strings.Join(n.values.ClusterDNS, " ") + ` {
-            ` + n.forceTcpToClusterDNS() + `
+            ` + n.forwardProtocol("in-addr.arpa", n.forceTcpToClusterDNS()) + `
     }
     prometheus :` + strconv.Itoa(prometheusPort) + `
     }
 ip6.arpa:53 {
     errors
-    cache 30
+    ` + n.cache("ip6.arpa") + `
     reload
     loop
     bind ` + n.bindIP() + `
     forward . ` + strings.Join(n.values.ClusterDNS, " ") + ` {
-            ` + n.forceTcpToClusterDNS() + `
+            ` + n.forwardProtocol("ip6.arpa", n.forceTcpToClusterDNS()) + `
     }
     prometheus :` + strconv.Itoa(prometheusPort) + `
     }
 .:53 {
     errors
-    cache 30
+    ` + n.cache(".") + `
     reload
     loop
     bind ` + n.bindIP() + `
     forward . ` + n.upstreamDNSAddress() + ` {
-            ` + n.forceTcpToUpstreamDNS() + `
+            ` + n.forwardProtocol(".", n.forceTcpToUpstreamDNS()) + `
     }
     prometheus :` + strconv.Itoa(prometheusPort) + `
     }
@@ -359,6 +356,7 @@ ip6.arpa:53 {
 						},
 						NodeSelector: map[string]string{
 							v1beta1constants.LabelNodeLocalDNS: "true",
+							corev1.LabelOSStable:               v1beta1constants.OSFamilyLinux,
 						},
 						Containers: []corev1.Container{
 							{
@@ -569,6 +567,50 @@ func (n *nodeLocalDNS) forceTcpToUpstreamDNS() string {
 	return "prefer_udp"
 }
 
+func (n *nodeLocalDNS) forwardProtocol(zone, defaultProtocol string) string {
+	if n.values.Config != nil {
+		for _, zoneProtocol := range n.values.Config.ZoneProtocols {
+			if zoneProtocol.Zone != zone {
+				continue
+			}
+			if zoneProtocol.Protocol == gardencorev1beta1.NodeLocalDNSProtocolUDP {
+				return "prefer_udp"
+			}
+			return "force_tcp"
+		}
+	}
+	return defaultProtocol
+}
+
+// cache returns the cache directive of the server block for the given zone. Without explicit cache settings, the
+// `cluster.local` zone caches negative responses for 5 seconds while all other zones use a common TTL of 30 seconds.
+func (n *nodeLocalDNS) cache(zone string) string {
+	var (
+		cache                   = &gardencorev1beta1.NodeLocalDNSCache{}
+		defaultDenialTTLSeconds = int32(30)
+	)
+
+	if zone == domain {
+		defaultDenialTTLSeconds = 5
+	}
+
+	if n.values.Config != nil && n.values.Config.Cache != nil {
+		cache = n.values.Config.Cache
+	} else if zone != domain {
+		return "cache 30"
+	}
+
+	denial := "denial 9984 " + strconv.Itoa(int(ptr.Deref(cache.DenialTTLSeconds, defaultDenialTTLSeconds)))
+	if ptr.Deref(cache.DisableNegativeCaching, false) {
+		denial = "disable denial"
+	}
+
+	return `cache {
+            success 9984 ` + strconv.Itoa(int(ptr.Deref(cache.SuccessTTLSeconds, 30))) + `
+            ` + denial + `
+    }`
+}
+
 func (n *nodeLocalDNS) upstreamDNSAddress() string {
 	if n.values.Config != nil && ptr.Deref(n.values.Config.DisableForwardToUpstreamDNS, false) {
 		return strings.Join(n.values.ClusterDNS, " ")
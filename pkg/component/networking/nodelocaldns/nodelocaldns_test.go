@@ -402,6 +402,7 @@ status:
 								},
 								NodeSelector: map[string]string{
 									v1beta1constants.LabelNodeLocalDNS: "true",
+									"kubernetes.io/os":                 "linux",
 								},
 								SecurityContext: &corev1.PodSecurityContext{
 									SeccompProfile: &corev1.SeccompProfile{
@@ -866,6 +867,84 @@ ip6.arpa:53 {
 						Expect(daemonset).To(DeepEqual(managedResourceDaemonset))
 					})
 				})
+
+				Context("Cache and ZoneProtocols", func() {
+					BeforeEach(func() {
+						values.Config = &gardencorev1beta1.NodeLocalDNS{Enabled: true,
+							Cache: &gardencorev1beta1.NodeLocalDNSCache{
+								SuccessTTLSeconds: ptr.To[int32](60),
+								DenialTTLSeconds:  ptr.To[int32](10),
+							},
+							ZoneProtocols: []gardencorev1beta1.NodeLocalDNSZoneProtocol{
+								{Zone: "cluster.local", Protocol: gardencorev1beta1.NodeLocalDNSProtocolUDP},
+								{Zone: ".", Protocol: gardencorev1beta1.NodeLocalDNSProtocolTCP},
+							},
+						}
+					})
+
+					It("should render the cache settings and protocols in all server blocks", func() {
+						corefile, err := extractCorefile(manifests, kubernetes.ShootCodec.UniversalDeserializer())
+						Expect(err).ToNot(HaveOccurred())
+
+						Expect(strings.Count(corefile, `    cache {
+            success 9984 60
+            denial 9984 10
+    }`)).To(Equal(4))
+						Expect(corefile).To(ContainSubstring(`cluster.local:53 {
+    errors
+    cache {
+            success 9984 60
+            denial 9984 10
+    }
+    reload
+    loop
+    bind ` + bindIP(values) + `
+    forward . ` + strings.Join(values.ClusterDNS, " ") + ` {
+            prefer_udp
+    }`))
+						Expect(corefile).To(ContainSubstring(`in-addr.arpa:53 {
+    errors
+    cache {
+            success 9984 60
+            denial 9984 10
+    }
+    reload
+    loop
+    bind ` + bindIP(values) + `
+    forward . ` + strings.Join(values.ClusterDNS, " ") + ` {
+            force_tcp
+    }`))
+						Expect(corefile).To(ContainSubstring(`.:53 {
+    errors
+    cache {
+            success 9984 60
+            denial 9984 10
+    }
+    reload
+    loop
+    bind ` + bindIP(values) + `
+    forward . __PILLAR__UPSTREAM__SERVERS__ {
+            force_tcp
+    }`))
+					})
+
+					Context("with negative caching disabled", func() {
+						BeforeEach(func() {
+							values.Config.Cache = &gardencorev1beta1.NodeLocalDNSCache{DisableNegativeCaching: ptr.To(true)}
+						})
+
+						It("should disable caching of negative responses in all server blocks", func() {
+							corefile, err := extractCorefile(manifests, kubernetes.ShootCodec.UniversalDeserializer())
+							Expect(err).ToNot(HaveOccurred())
+
+							Expect(strings.Count(corefile, `    cache {
+            success 9984 30
+            disable denial
+    }`)).To(Equal(4))
+							Expect(corefile).NotTo(ContainSubstring("denial 9984"))
+						})
+					})
+				})
 			})
 		})
 
@@ -1360,3 +1439,17 @@ func extractDaemonSet(manifests []string, decoder runtime.Decoder) (*appsv1.Daem
 	}
 	return ds, nil
 }
+
+func extractCorefile(manifests []string, decoder runtime.Decoder) (string, error) {
+	configMap := &corev1.ConfigMap{}
+	for _, manifest := range manifests {
+		if strings.Contains(manifest, "kind: ConfigMap") {
+			_, _, err := decoder.Decode([]byte(manifest), nil, configMap)
+			if err != nil {
+				return "", err
+			}
+			break
+		}
+	}
+	return configMap.Data["Corefile"], nil
+}
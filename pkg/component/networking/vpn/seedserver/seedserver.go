@@ -64,8 +64,10 @@ const (
 	OpenVPNPort = 1194
 	// HighAvailabilityReplicaCount is the replica count used when highly available VPN is configured.
 	HighAvailabilityReplicaCount = 2
-	metricsPortName              = "metrics"
-	metricsPort                  = 15000
+	// HighAvailabilityMaxReplicaCount is the maximum replica count which can be configured for the highly available VPN.
+	HighAvailabilityMaxReplicaCount = 4
+	metricsPortName                 = "metrics"
+	metricsPort                     = 15000
 
 	envoyProxyContainerName = "envoy-proxy"
 
@@ -226,11 +228,13 @@ func (v *vpnSeedServer) Deploy(ctx context.Context) error {
 				return err
 			}
 		}
-		if err := kubernetesutils.DeleteObjects(ctx, v.client,
-			v.emptyDeployment(),
-			v.emptyService(nil),
-			v.emptyDestinationRule(nil),
-		); err != nil {
+
+		objects := []client.Object{v.emptyDeployment(), v.emptyService(nil), v.emptyDestinationRule(nil)}
+		// Clean up the services of seed servers which are no longer used after the number of seed servers was reduced.
+		for i := v.values.HighAvailabilityNumberOfSeedServers; i < HighAvailabilityMaxReplicaCount; i++ {
+			objects = append(objects, v.emptyService(&i), v.emptyDestinationRule(&i))
+		}
+		if err := kubernetesutils.DeleteObjects(ctx, v.client, objects...); err != nil {
 			return err
 		}
 	} else {
@@ -245,7 +249,7 @@ func (v *vpnSeedServer) Deploy(ctx context.Context) error {
 		}
 
 		objects := []client.Object{v.emptyStatefulSet()}
-		for i := 0; i < v.values.HighAvailabilityNumberOfSeedServers; i++ {
+		for i := 0; i < HighAvailabilityMaxReplicaCount; i++ {
 			objects = append(objects, v.emptyService(&i), v.emptyDestinationRule(&i))
 		}
 		if err := kubernetesutils.DeleteObjects(ctx, v.client, objects...); err != nil {
@@ -989,7 +993,7 @@ func (v *vpnSeedServer) Destroy(ctx context.Context) error {
 		v.emptyEnvoyFilter(),
 		v.emptyPodDisruptionBudget(),
 	}
-	for i := 0; i < v.values.HighAvailabilityNumberOfSeedServers; i++ {
+	for i := 0; i < HighAvailabilityMaxReplicaCount; i++ {
 		objects = append(objects, v.emptyDestinationRule(&i), v.emptyService(&i))
 	}
 	return kubernetesutils.DeleteObjects(ctx, v.client, objects...)
@@ -963,6 +963,10 @@ var _ = Describe("VpnSeedServer", func() {
 				svc.ResourceVersion = ""
 				Expect(c.Create(ctx, svc)).To(Succeed())
 
+				surplusService := indexedService(3)
+				surplusService.ResourceVersion = ""
+				Expect(c.Create(ctx, surplusService)).To(Succeed())
+
 				Expect(vpnSeedServer.Deploy(ctx)).To(Succeed())
 
 				actualSecretServer := &corev1.Secret{}
@@ -1013,6 +1017,7 @@ var _ = Describe("VpnSeedServer", func() {
 				Expect(c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: "vpn-seed-server"}, &appsv1.Deployment{})).To(BeNotFoundError())
 				Expect(c.Get(ctx, client.ObjectKeyFromObject(destinationRule()), &istionetworkingv1beta1.DestinationRule{})).To(BeNotFoundError())
 				Expect(c.Get(ctx, client.ObjectKeyFromObject(expectedService), &corev1.Service{})).To(BeNotFoundError())
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(indexedService(3)), &corev1.Service{})).To(BeNotFoundError())
 			})
 
 			Context("Kubernetes versions < 1.26", func() {
@@ -46,29 +46,7 @@ func (b *bootstrapper) Deploy(ctx context.Context) error {
 			ObjectMeta: metav1.ObjectMeta{
 				Name: clusterRoleName,
 			},
-			Rules: []rbacv1.PolicyRule{
-				{
-					APIGroups: []string{machinev1alpha1.GroupName},
-					Resources: []string{"*"},
-					Verbs:     []string{"*"},
-				},
-				{
-					APIGroups: []string{corev1.GroupName},
-					Resources: []string{"configmaps", "secrets", "endpoints", "events", "pods"},
-					Verbs:     []string{"*"},
-				},
-				{
-					APIGroups: []string{coordinationv1.GroupName},
-					Resources: []string{"leases"},
-					Verbs:     []string{"create"},
-				},
-				{
-					APIGroups:     []string{coordinationv1.GroupName},
-					Resources:     []string{"leases"},
-					Verbs:         []string{"get", "watch", "update"},
-					ResourceNames: []string{"machine-controller", "machine-controller-manager"},
-				},
-			},
+			Rules: controlClusterRules(),
 		}
 	)
 
@@ -80,6 +58,34 @@ func (b *bootstrapper) Deploy(ctx context.Context) error {
 	return managedresources.CreateForSeed(ctx, b.client, b.namespace, managedResourceControlName, false, resources)
 }
 
+// controlClusterRules returns the policy rules machine-controller-manager needs in the cluster containing the machine
+// resources.
+func controlClusterRules() []rbacv1.PolicyRule {
+	return []rbacv1.PolicyRule{
+		{
+			APIGroups: []string{machinev1alpha1.GroupName},
+			Resources: []string{"*"},
+			Verbs:     []string{"*"},
+		},
+		{
+			APIGroups: []string{corev1.GroupName},
+			Resources: []string{"configmaps", "secrets", "endpoints", "events", "pods"},
+			Verbs:     []string{"*"},
+		},
+		{
+			APIGroups: []string{coordinationv1.GroupName},
+			Resources: []string{"leases"},
+			Verbs:     []string{"create"},
+		},
+		{
+			APIGroups:     []string{coordinationv1.GroupName},
+			Resources:     []string{"leases"},
+			Verbs:         []string{"get", "watch", "update"},
+			ResourceNames: []string{"machine-controller", "machine-controller-manager"},
+		},
+	}
+}
+
 func (b *bootstrapper) Destroy(ctx context.Context) error {
 	return managedresources.DeleteForSeed(ctx, b.client, b.namespace, managedResourceControlName)
 }
@@ -20,10 +20,13 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	vpaautoscalingv1 "k8s.io/autoscaler/vertical-pod-autoscaler/pkg/apis/autoscaling.k8s.io/v1"
+	clientcmdlatest "k8s.io/client-go/tools/clientcmd/api/latest"
+	clientcmdv1 "k8s.io/client-go/tools/clientcmd/api/v1"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -32,6 +35,7 @@ import (
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/component"
 	kubeapiserverconstants "github.com/gardener/gardener/pkg/component/kubernetes/apiserver/constants"
+	"github.com/gardener/gardener/pkg/component/observability/monitoring/prometheus/garden"
 	"github.com/gardener/gardener/pkg/component/observability/monitoring/prometheus/shoot"
 	monitoringutils "github.com/gardener/gardener/pkg/component/observability/monitoring/utils"
 	"github.com/gardener/gardener/pkg/controllerutils"
@@ -41,6 +45,7 @@ import (
 	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
 	"github.com/gardener/gardener/pkg/utils/managedresources"
 	"github.com/gardener/gardener/pkg/utils/retry"
+	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
 	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
 )
 
@@ -50,6 +55,13 @@ const (
 	containerName             = "machine-controller-manager"
 	serviceName               = "machine-controller-manager"
 	managedResourceTargetName = "shoot-core-machine-controller-manager"
+	// managedResourceRuntimeName is the name of the ManagedResource containing the RBAC resources required by
+	// machine-controller-manager when it manages the nodes of the runtime cluster it is running in.
+	managedResourceRuntimeName = "machine-controller-manager-runtime"
+	// secretNameRuntimeKubeconfig is the name of the secret containing the kubeconfig used by machine-controller-manager
+	// and the provider sidecar containers to access the runtime cluster they are running in.
+	secretNameRuntimeKubeconfig = "machine-controller-manager-runtime-kubeconfig"
+	volumeNameKubeconfig        = "kubeconfig"
 	// VPAName is the name of the vertical pod autoscaler for the machine-controller-manager.
 	VPAName = "machine-controller-manager-vpa"
 )
@@ -91,6 +103,9 @@ type Values struct {
 	Replicas int32
 	// RuntimeKubernetesVersion is the Kubernetes version of the runtime cluster.
 	RuntimeKubernetesVersion *semver.Version
+	// ManagesRuntimeCluster specifies whether machine-controller-manager manages the nodes of the cluster it is running
+	// in (e.g., the garden runtime cluster) instead of the nodes of a shoot cluster.
+	ManagesRuntimeCluster bool
 
 	namespaceUID types.UID
 }
@@ -108,19 +123,29 @@ func (m *machineControllerManager) Deploy(ctx context.Context) error {
 		serviceMonitor      = m.emptyServiceMonitor()
 	)
 
-	genericTokenKubeconfigSecret, found := m.secretsManager.Get(v1beta1constants.SecretNameGenericTokenKubeconfig)
-	if !found {
-		return fmt.Errorf("secret %q not found", v1beta1constants.SecretNameGenericTokenKubeconfig)
+	var genericTokenKubeconfigSecretName string
+	if !m.values.ManagesRuntimeCluster {
+		genericTokenKubeconfigSecret, found := m.secretsManager.Get(v1beta1constants.SecretNameGenericTokenKubeconfig)
+		if !found {
+			return fmt.Errorf("secret %q not found", v1beta1constants.SecretNameGenericTokenKubeconfig)
+		}
+		genericTokenKubeconfigSecretName = genericTokenKubeconfigSecret.Name
 	}
 
 	if _, err := controllerutils.GetAndCreateOrStrategicMergePatch(ctx, m.client, serviceAccount, func() error {
-		serviceAccount.AutomountServiceAccountToken = ptr.To(false)
+		// When managing the runtime cluster, machine-controller-manager and the provider sidecar containers access it
+		// with the token of this service account.
+		serviceAccount.AutomountServiceAccountToken = ptr.To(m.values.ManagesRuntimeCluster)
 		return nil
 	}); err != nil {
 		return err
 	}
 
-	if _, err := controllerutils.GetAndCreateOrStrategicMergePatch(ctx, m.client, clusterRoleBinding, func() error {
+	if m.values.ManagesRuntimeCluster {
+		if err := m.reconcileRuntimeKubeconfigSecret(ctx); err != nil {
+			return err
+		}
+	} else if _, err := controllerutils.GetAndCreateOrStrategicMergePatch(ctx, m.client, clusterRoleBinding, func() error {
 		clusterRoleBinding.OwnerReferences = []metav1.OwnerReference{{
 			APIVersion:         "v1",
 			Kind:               "Namespace",
@@ -179,8 +204,10 @@ func (m *machineControllerManager) Deploy(ctx context.Context) error {
 		return err
 	}
 
-	if err := shootAccessSecret.Reconcile(ctx, m.client); err != nil {
-		return err
+	if !m.values.ManagesRuntimeCluster {
+		if err := shootAccessSecret.Reconcile(ctx, m.client); err != nil {
+			return err
+		}
 	}
 
 	if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, m.client, deployment, func() error {
@@ -195,13 +222,12 @@ func (m *machineControllerManager) Deploy(ctx context.Context) error {
 		deployment.Spec.Template = corev1.PodTemplateSpec{
 			ObjectMeta: metav1.ObjectMeta{
 				Labels: utils.MergeStringMaps(getLabels(), map[string]string{
-					v1beta1constants.GardenRole:                                                                                 v1beta1constants.GardenRoleControlPlane,
-					v1beta1constants.LabelPodMaintenanceRestart:                                                                 "true",
-					v1beta1constants.LabelNetworkPolicyToDNS:                                                                    v1beta1constants.LabelNetworkPolicyAllowed,
-					v1beta1constants.LabelNetworkPolicyToPublicNetworks:                                                         v1beta1constants.LabelNetworkPolicyAllowed,
-					v1beta1constants.LabelNetworkPolicyToPrivateNetworks:                                                        v1beta1constants.LabelNetworkPolicyAllowed,
-					v1beta1constants.LabelNetworkPolicyToRuntimeAPIServer:                                                       v1beta1constants.LabelNetworkPolicyAllowed,
-					gardenerutils.NetworkPolicyLabel(v1beta1constants.DeploymentNameKubeAPIServer, kubeapiserverconstants.Port): v1beta1constants.LabelNetworkPolicyAllowed,
+					v1beta1constants.GardenRole:                           v1beta1constants.GardenRoleControlPlane,
+					v1beta1constants.LabelPodMaintenanceRestart:           "true",
+					v1beta1constants.LabelNetworkPolicyToDNS:              v1beta1constants.LabelNetworkPolicyAllowed,
+					v1beta1constants.LabelNetworkPolicyToPublicNetworks:   v1beta1constants.LabelNetworkPolicyAllowed,
+					v1beta1constants.LabelNetworkPolicyToPrivateNetworks:  v1beta1constants.LabelNetworkPolicyAllowed,
+					v1beta1constants.LabelNetworkPolicyToRuntimeAPIServer: v1beta1constants.LabelNetworkPolicyAllowed,
 				}),
 			},
 			Spec: corev1.PodSpec{
@@ -252,7 +278,27 @@ func (m *machineControllerManager) Deploy(ctx context.Context) error {
 			},
 		}
 
-		utilruntime.Must(gardenerutils.InjectGenericKubeconfig(deployment, genericTokenKubeconfigSecret.Name, shootAccessSecret.Secret.Name))
+		if m.values.ManagesRuntimeCluster {
+			deployment.Spec.Template.Spec.PriorityClassName = v1beta1constants.PriorityClassNameGardenSystem300
+			deployment.Spec.Template.Spec.Containers[0].VolumeMounts = []corev1.VolumeMount{{
+				Name:      volumeNameKubeconfig,
+				MountPath: gardenerutils.VolumeMountPathGenericKubeconfig,
+				ReadOnly:  true,
+			}}
+			deployment.Spec.Template.Spec.Volumes = []corev1.Volume{{
+				Name: volumeNameKubeconfig,
+				VolumeSource: corev1.VolumeSource{
+					Secret: &corev1.SecretVolumeSource{
+						SecretName:  secretNameRuntimeKubeconfig,
+						DefaultMode: ptr.To[int32](0640),
+					},
+				},
+			}}
+			return nil
+		}
+
+		metav1.SetMetaDataLabel(&deployment.Spec.Template.ObjectMeta, gardenerutils.NetworkPolicyLabel(v1beta1constants.DeploymentNameKubeAPIServer, kubeapiserverconstants.Port), v1beta1constants.LabelNetworkPolicyAllowed)
+		utilruntime.Must(gardenerutils.InjectGenericKubeconfig(deployment, genericTokenKubeconfigSecretName, shootAccessSecret.Secret.Name))
 		return nil
 	}); err != nil {
 		return err
@@ -292,7 +338,7 @@ func (m *machineControllerManager) Deploy(ctx context.Context) error {
 	}
 
 	if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, m.client, prometheusRule, func() error {
-		metav1.SetMetaDataLabel(&prometheusRule.ObjectMeta, "prometheus", shoot.Label)
+		metav1.SetMetaDataLabel(&prometheusRule.ObjectMeta, "prometheus", m.prometheusLabel())
 		prometheusRule.Spec = monitoringv1.PrometheusRuleSpec{
 			Groups: []monitoringv1.RuleGroup{{
 				Name: "machine-controller-manager.rules",
@@ -319,7 +365,7 @@ func (m *machineControllerManager) Deploy(ctx context.Context) error {
 	}
 
 	if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, m.client, serviceMonitor, func() error {
-		metav1.SetMetaDataLabel(&serviceMonitor.ObjectMeta, "prometheus", shoot.Label)
+		metav1.SetMetaDataLabel(&serviceMonitor.ObjectMeta, "prometheus", m.prometheusLabel())
 		serviceMonitor.Spec = monitoringv1.ServiceMonitorSpec{
 			Selector: metav1.LabelSelector{MatchLabels: getLabels()},
 			Endpoints: []monitoringv1.Endpoint{
@@ -427,7 +473,16 @@ func (m *machineControllerManager) Deploy(ctx context.Context) error {
 		return err
 	}
 
-	data, err := m.computeShootResourcesData(shootAccessSecret.ServiceAccountName)
+	if m.values.ManagesRuntimeCluster {
+		data, err := m.computeTargetResourcesData(serviceAccount.Name, m.namespace, true)
+		if err != nil {
+			return err
+		}
+
+		return managedresources.CreateForSeed(ctx, m.client, m.namespace, managedResourceRuntimeName, false, data)
+	}
+
+	data, err := m.computeTargetResourcesData(shootAccessSecret.ServiceAccountName, metav1.NamespaceSystem, false)
 	if err != nil {
 		return err
 	}
@@ -435,7 +490,36 @@ func (m *machineControllerManager) Deploy(ctx context.Context) error {
 	return managedresources.CreateForShoot(ctx, m.client, m.namespace, managedResourceTargetName, managedresources.LabelValueGardener, false, data)
 }
 
+func (m *machineControllerManager) reconcileRuntimeKubeconfigSecret(ctx context.Context) error {
+	kubeconfig, err := runtime.Encode(clientcmdlatest.Codec, kubernetesutils.NewKubeconfig(
+		"runtime",
+		clientcmdv1.Cluster{
+			Server:               "kubernetes.default.svc",
+			CertificateAuthority: "/var/run/secrets/kubernetes.io/serviceaccount/ca.crt",
+		},
+		clientcmdv1.AuthInfo{TokenFile: "/var/run/secrets/kubernetes.io/serviceaccount/token"},
+	))
+	if err != nil {
+		return err
+	}
+
+	secret := m.emptyRuntimeKubeconfigSecret()
+	_, err = controllerutils.GetAndCreateOrMergePatch(ctx, m.client, secret, func() error {
+		secret.Labels = utils.MergeStringMaps(secret.Labels, getLabels())
+		secret.Type = corev1.SecretTypeOpaque
+		secret.Data = map[string][]byte{secretsutils.DataKeyKubeconfig: kubeconfig}
+		return nil
+	})
+	return err
+}
+
 func (m *machineControllerManager) Destroy(ctx context.Context) error {
+	if m.values.ManagesRuntimeCluster {
+		if err := managedresources.DeleteForSeed(ctx, m.client, m.namespace, managedResourceRuntimeName); err != nil {
+			return err
+		}
+	}
+
 	return kubernetesutils.DeleteObjects(ctx, m.client,
 		m.emptyManagedResource(),
 		m.emptyServiceMonitor(),
@@ -444,6 +528,7 @@ func (m *machineControllerManager) Destroy(ctx context.Context) error {
 		m.emptyPodDisruptionBudget(),
 		m.emptyDeployment(),
 		m.newShootAccessSecret().Secret,
+		m.emptyRuntimeKubeconfigSecret(),
 		m.emptyService(),
 		m.emptyClusterRoleBindingRuntime(),
 		m.emptyServiceAccount(),
@@ -486,7 +571,7 @@ func (m *machineControllerManager) WaitCleanup(ctx context.Context) error {
 
 func (m *machineControllerManager) SetNamespaceUID(uid types.UID) { m.values.namespaceUID = uid }
 
-func (m *machineControllerManager) computeShootResourcesData(serviceAccountName string) (map[string][]byte, error) {
+func (m *machineControllerManager) computeTargetResourcesData(serviceAccountName, serviceAccountNamespace string, includeControlResources bool) (map[string][]byte, error) {
 	var (
 		registry = managedresources.NewRegistry(kubernetes.ShootScheme, kubernetes.ShootCodec, kubernetes.ShootSerializer)
 
@@ -540,7 +625,7 @@ func (m *machineControllerManager) computeShootResourcesData(serviceAccountName
 			Subjects: []rbacv1.Subject{{
 				Kind:      rbacv1.ServiceAccountKind,
 				Name:      serviceAccountName,
-				Namespace: metav1.NamespaceSystem,
+				Namespace: serviceAccountNamespace,
 			}},
 		}
 
@@ -567,7 +652,7 @@ func (m *machineControllerManager) computeShootResourcesData(serviceAccountName
 			Subjects: []rbacv1.Subject{{
 				Kind:      rbacv1.ServiceAccountKind,
 				Name:      serviceAccountName,
-				Namespace: metav1.NamespaceSystem,
+				Namespace: serviceAccountNamespace,
 			}},
 			RoleRef: rbacv1.RoleRef{
 				APIGroup: rbacv1.GroupName,
@@ -577,12 +662,43 @@ func (m *machineControllerManager) computeShootResourcesData(serviceAccountName
 		}
 	)
 
-	return registry.AddAllAndSerialize(
+	objects := []client.Object{
 		clusterRole,
 		clusterRoleBinding,
 		role,
 		roleBinding,
-	)
+	}
+
+	if includeControlResources {
+		// When machine-controller-manager manages the cluster it is running in, the permissions for the machine resources
+		// in the control namespace are not granted by the cluster role deployed by the bootstrapper.
+		controlRole := &rbacv1.Role{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "gardener.cloud:system:machine-controller-manager",
+				Namespace: m.namespace,
+			},
+			Rules: controlClusterRules(),
+		}
+
+		objects = append(objects, controlRole, &rbacv1.RoleBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "gardener.cloud:system:machine-controller-manager",
+				Namespace: m.namespace,
+			},
+			Subjects: []rbacv1.Subject{{
+				Kind:      rbacv1.ServiceAccountKind,
+				Name:      serviceAccountName,
+				Namespace: serviceAccountNamespace,
+			}},
+			RoleRef: rbacv1.RoleRef{
+				APIGroup: rbacv1.GroupName,
+				Kind:     "Role",
+				Name:     controlRole.Name,
+			},
+		})
+	}
+
+	return registry.AddAllAndSerialize(objects...)
 }
 
 func (m *machineControllerManager) emptyServiceAccount() *corev1.ServiceAccount {
@@ -601,6 +717,10 @@ func (m *machineControllerManager) newShootAccessSecret() *gardenerutils.AccessS
 	return gardenerutils.NewShootAccessSecret(v1beta1constants.DeploymentNameMachineControllerManager, m.namespace)
 }
 
+func (m *machineControllerManager) emptyRuntimeKubeconfigSecret() *corev1.Secret {
+	return &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: secretNameRuntimeKubeconfig, Namespace: m.namespace}}
+}
+
 func (m *machineControllerManager) emptyDeployment() *appsv1.Deployment {
 	return &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: v1beta1constants.DeploymentNameMachineControllerManager, Namespace: m.namespace}}
 }
@@ -614,17 +734,24 @@ func (m *machineControllerManager) emptyVPA() *vpaautoscalingv1.VerticalPodAutos
 }
 
 func (m *machineControllerManager) emptyPrometheusRule() *monitoringv1.PrometheusRule {
-	return &monitoringv1.PrometheusRule{ObjectMeta: monitoringutils.ConfigObjectMeta(v1beta1constants.DeploymentNameMachineControllerManager, m.namespace, shoot.Label)}
+	return &monitoringv1.PrometheusRule{ObjectMeta: monitoringutils.ConfigObjectMeta(v1beta1constants.DeploymentNameMachineControllerManager, m.namespace, m.prometheusLabel())}
 }
 
 func (m *machineControllerManager) emptyServiceMonitor() *monitoringv1.ServiceMonitor {
-	return &monitoringv1.ServiceMonitor{ObjectMeta: monitoringutils.ConfigObjectMeta(v1beta1constants.DeploymentNameMachineControllerManager, m.namespace, shoot.Label)}
+	return &monitoringv1.ServiceMonitor{ObjectMeta: monitoringutils.ConfigObjectMeta(v1beta1constants.DeploymentNameMachineControllerManager, m.namespace, m.prometheusLabel())}
 }
 
 func (m *machineControllerManager) emptyManagedResource() *resourcesv1alpha1.ManagedResource {
 	return &resourcesv1alpha1.ManagedResource{ObjectMeta: metav1.ObjectMeta{Name: managedResourceTargetName, Namespace: m.namespace}}
 }
 
+func (m *machineControllerManager) prometheusLabel() string {
+	if m.values.ManagesRuntimeCluster {
+		return garden.Label
+	}
+	return shoot.Label
+}
+
 func getLabels() map[string]string {
 	return map[string]string{
 		v1beta1constants.LabelApp:  v1beta1constants.LabelKubernetes,
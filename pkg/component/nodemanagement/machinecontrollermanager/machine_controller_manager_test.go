@@ -6,11 +6,13 @@ package machinecontrollermanager_test
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/Masterminds/semver/v3"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	appsv1 "k8s.io/api/apps/v1"
 	autoscalingv1 "k8s.io/api/autoscaling/v1"
@@ -609,6 +611,151 @@ subjects:
 		})
 	})
 
+	Context("when managing the runtime cluster", func() {
+		var (
+			runtimeKubeconfigSecret *corev1.Secret
+			runtimeManagedResource  *resourcesv1alpha1.ManagedResource
+		)
+
+		JustBeforeEach(func() {
+			values.ManagesRuntimeCluster = true
+			mcm = New(fakeClient, namespace, sm, values)
+
+			runtimeKubeconfigSecret = &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "machine-controller-manager-runtime-kubeconfig", Namespace: namespace}}
+			runtimeManagedResource = &resourcesv1alpha1.ManagedResource{ObjectMeta: metav1.ObjectMeta{Name: "machine-controller-manager-runtime", Namespace: namespace}}
+		})
+
+		It("should successfully deploy all resources", func() {
+			Expect(mcm.Deploy(ctx)).To(Succeed())
+
+			actualServiceAccount := &corev1.ServiceAccount{}
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(serviceAccount), actualServiceAccount)).To(Succeed())
+			Expect(actualServiceAccount.AutomountServiceAccountToken).To(PointTo(BeTrue()))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(clusterRoleBinding), &rbacv1.ClusterRoleBinding{})).To(BeNotFoundError())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shootAccessSecret), &corev1.Secret{})).To(BeNotFoundError())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedResource), &resourcesv1alpha1.ManagedResource{})).To(BeNotFoundError())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(runtimeKubeconfigSecret), runtimeKubeconfigSecret)).To(Succeed())
+			Expect(string(runtimeKubeconfigSecret.Data["kubeconfig"])).To(And(
+				ContainSubstring("server: https://kubernetes.default.svc"),
+				ContainSubstring("certificate-authority: /var/run/secrets/kubernetes.io/serviceaccount/ca.crt"),
+				ContainSubstring("tokenFile: /var/run/secrets/kubernetes.io/serviceaccount/token"),
+			))
+
+			actualDeployment := &appsv1.Deployment{}
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(deployment), actualDeployment)).To(Succeed())
+			Expect(actualDeployment.Spec.Template.Labels).NotTo(HaveKey("networking.resources.gardener.cloud/to-kube-apiserver-tcp-443"))
+			Expect(actualDeployment.Spec.Template.Spec.PriorityClassName).To(Equal("gardener-garden-system-300"))
+			Expect(actualDeployment.Spec.Template.Spec.Containers).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+				"Command": Equal(deployment.Spec.Template.Spec.Containers[0].Command),
+				"VolumeMounts": ConsistOf(corev1.VolumeMount{
+					Name:      "kubeconfig",
+					MountPath: "/var/run/secrets/gardener.cloud/shoot/generic-kubeconfig",
+					ReadOnly:  true,
+				}),
+			})))
+			Expect(actualDeployment.Spec.Template.Spec.Volumes).To(ConsistOf(corev1.Volume{
+				Name: "kubeconfig",
+				VolumeSource: corev1.VolumeSource{
+					Secret: &corev1.SecretVolumeSource{
+						SecretName:  "machine-controller-manager-runtime-kubeconfig",
+						DefaultMode: ptr.To[int32](0640),
+					},
+				},
+			}))
+
+			actualPrometheusRule := &monitoringv1.PrometheusRule{}
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Name: "garden-machine-controller-manager", Namespace: namespace}, actualPrometheusRule)).To(Succeed())
+			Expect(actualPrometheusRule.Labels).To(HaveKeyWithValue("prometheus", "garden"))
+
+			actualServiceMonitor := &monitoringv1.ServiceMonitor{}
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Name: "garden-machine-controller-manager", Namespace: namespace}, actualServiceMonitor)).To(Succeed())
+			Expect(actualServiceMonitor.Labels).To(HaveKeyWithValue("prometheus", "garden"))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(runtimeManagedResource), runtimeManagedResource)).To(Succeed())
+			Expect(runtimeManagedResource.Spec.Class).To(PointTo(Equal("seed")))
+
+			actualManagedResourceSecret := &corev1.Secret{}
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Name: runtimeManagedResource.Spec.SecretRefs[0].Name, Namespace: namespace}, actualManagedResourceSecret)).To(Succeed())
+
+			manifests, err := test.ExtractManifestsFromManagedResourceData(actualManagedResourceSecret.Data)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(manifests).To(ConsistOf(
+				clusterRoleYAML,
+				strings.ReplaceAll(clusterRoleBindingYAML, "  namespace: kube-system\n", "  namespace: "+namespace+"\n"),
+				roleYAML,
+				strings.ReplaceAll(roleBindingYAML, "- kind: ServiceAccount\n  name: machine-controller-manager\n  namespace: kube-system\n", "- kind: ServiceAccount\n  name: machine-controller-manager\n  namespace: "+namespace+"\n"),
+				`apiVersion: rbac.authorization.k8s.io/v1
+kind: Role
+metadata:
+  creationTimestamp: null
+  name: gardener.cloud:system:machine-controller-manager
+  namespace: `+namespace+`
+rules:
+- apiGroups:
+  - machine.sapcloud.io
+  resources:
+  - '*'
+  verbs:
+  - '*'
+- apiGroups:
+  - ""
+  resources:
+  - configmaps
+  - secrets
+  - endpoints
+  - events
+  - pods
+  verbs:
+  - '*'
+- apiGroups:
+  - coordination.k8s.io
+  resources:
+  - leases
+  verbs:
+  - create
+- apiGroups:
+  - coordination.k8s.io
+  resourceNames:
+  - machine-controller
+  - machine-controller-manager
+  resources:
+  - leases
+  verbs:
+  - get
+  - watch
+  - update
+`,
+				`apiVersion: rbac.authorization.k8s.io/v1
+kind: RoleBinding
+metadata:
+  creationTimestamp: null
+  name: gardener.cloud:system:machine-controller-manager
+  namespace: `+namespace+`
+roleRef:
+  apiGroup: rbac.authorization.k8s.io
+  kind: Role
+  name: gardener.cloud:system:machine-controller-manager
+subjects:
+- kind: ServiceAccount
+  name: machine-controller-manager
+  namespace: `+namespace+`
+`,
+			))
+		})
+
+		It("should successfully destroy all resources", func() {
+			Expect(mcm.Deploy(ctx)).To(Succeed())
+			Expect(mcm.Destroy(ctx)).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(runtimeManagedResource), &resourcesv1alpha1.ManagedResource{})).To(BeNotFoundError())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(runtimeKubeconfigSecret), &corev1.Secret{})).To(BeNotFoundError())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(deployment), &appsv1.Deployment{})).To(BeNotFoundError())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(serviceAccount), &corev1.ServiceAccount{})).To(BeNotFoundError())
+		})
+	})
+
 	Describe("#Destroy", func() {
 		It("should successfully destroy all resources", func() {
 			Expect(fakeClient.Create(ctx, serviceAccount)).To(Succeed())
@@ -192,6 +192,7 @@ func (c *nodeProblemDetector) computeResourcesData() (map[string][]byte, error)
 						HostNetwork:                   false,
 						TerminationGracePeriodSeconds: ptr.To(daemonSetTerminationGracePeriodSeconds),
 						PriorityClassName:             v1beta1constants.PriorityClassNameShootSystem900,
+						NodeSelector:                  map[string]string{corev1.LabelOSStable: v1beta1constants.OSFamilyLinux},
 						SecurityContext: &corev1.PodSecurityContext{
 							SeccompProfile: &corev1.SeccompProfile{
 								Type: corev1.SeccompProfileTypeRuntimeDefault,
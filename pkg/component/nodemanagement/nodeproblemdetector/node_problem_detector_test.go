@@ -232,6 +232,8 @@ spec:
           name: kmsg
           readOnly: true
       dnsPolicy: Default
+      nodeSelector:
+        kubernetes.io/os: linux
       priorityClassName: gardener-shoot-system-900
       securityContext:
         seccompProfile:
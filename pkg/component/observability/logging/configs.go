@@ -14,6 +14,7 @@ import (
 	kubeapiserver "github.com/gardener/gardener/pkg/component/kubernetes/apiserver"
 	kubecontrollermanager "github.com/gardener/gardener/pkg/component/kubernetes/controllermanager"
 	"github.com/gardener/gardener/pkg/component/networking/nginxingress"
+	"github.com/gardener/gardener/pkg/component/observability/logging/eventlogger"
 	"github.com/gardener/gardener/pkg/component/observability/logging/vali"
 	"github.com/gardener/gardener/pkg/component/observability/monitoring/kubestatemetrics"
 )
@@ -34,4 +35,5 @@ var GardenCentralLoggingConfigurations = []component.CentralLoggingConfiguration
 	etcd.CentralLoggingConfiguration,
 	kubeapiserver.CentralLoggingConfiguration,
 	kubecontrollermanager.CentralLoggingConfiguration,
+	eventlogger.CentralLoggingConfiguration,
 }
@@ -8,6 +8,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	appsv1 "k8s.io/api/apps/v1"
 	autoscalingv1 "k8s.io/api/autoscaling/v1"
@@ -45,6 +46,12 @@ type Values struct {
 	Image string
 	// Replicas is the number of pod replicas.
 	Replicas int32
+	// PriorityClassName is the name of the priority class of the event-logger pods.
+	PriorityClassName string
+	// NamePrefix is the prefix of the kube-apiserver deployment of the target cluster, e.g. `virtual-garden-`.
+	NamePrefix string
+	// TargetEventNamespaces are the namespaces in the target cluster whose events are captured.
+	TargetEventNamespaces []string
 }
 type eventLogger struct {
 	client         client.Client
@@ -53,7 +60,7 @@ type eventLogger struct {
 	values         Values
 }
 
-// New creates a new instance of event-logger for the shoot event logging.
+// New creates a new instance of event-logger for the event logging of a shoot or the virtual garden cluster.
 func New(
 	client client.Client,
 	namespace string,
@@ -243,14 +250,14 @@ func (l *eventLogger) reconcileDeployment(ctx context.Context) error {
 			Template: corev1.PodTemplateSpec{
 				ObjectMeta: metav1.ObjectMeta{
 					Labels: utils.MergeStringMaps(getLabels(), map[string]string{
-						v1beta1constants.LabelNetworkPolicyToDNS:                                                                    v1beta1constants.LabelNetworkPolicyAllowed,
-						v1beta1constants.LabelNetworkPolicyToRuntimeAPIServer:                                                       v1beta1constants.LabelNetworkPolicyAllowed,
-						gardenerutils.NetworkPolicyLabel(v1beta1constants.DeploymentNameKubeAPIServer, kubeapiserverconstants.Port): v1beta1constants.LabelNetworkPolicyAllowed,
+						v1beta1constants.LabelNetworkPolicyToDNS:              v1beta1constants.LabelNetworkPolicyAllowed,
+						v1beta1constants.LabelNetworkPolicyToRuntimeAPIServer: v1beta1constants.LabelNetworkPolicyAllowed,
+						gardenerutils.NetworkPolicyLabel(l.values.NamePrefix+v1beta1constants.DeploymentNameKubeAPIServer, kubeapiserverconstants.Port): v1beta1constants.LabelNetworkPolicyAllowed,
 					}),
 				},
 				Spec: corev1.PodSpec{
 					ServiceAccountName: name,
-					PriorityClassName:  v1beta1constants.PriorityClassNameShootControlPlane100,
+					PriorityClassName:  l.values.PriorityClassName,
 					Containers: []corev1.Container{
 						{
 							Name:            name,
@@ -348,7 +355,7 @@ func (l *eventLogger) computeCommand() []string {
 		"./event-logger",
 		"--seed-event-namespaces=" + l.namespace,
 		"--shoot-kubeconfig=" + gardenerutils.PathGenericKubeconfig,
-		"--shoot-event-namespaces=" + metav1.NamespaceSystem + "," + metav1.NamespaceDefault,
+		"--shoot-event-namespaces=" + strings.Join(l.values.TargetEventNamespaces, ","),
 	}
 }
 
@@ -218,8 +218,10 @@ var _ = Describe("EventLogger", func() {
 			namespace,
 			fakeSecretManager,
 			Values{
-				Image:    image,
-				Replicas: 1,
+				Image:                 image,
+				Replicas:              1,
+				PriorityClassName:     "gardener-system-100",
+				TargetEventNamespaces: []string{"kube-system", "default"},
 			},
 		)
 		Expect(err).ToNot(HaveOccurred())
@@ -501,6 +503,40 @@ var _ = Describe("EventLogger", func() {
 		})
 	})
 
+	Context("virtual garden cluster", func() {
+		BeforeEach(func() {
+			var err error
+			eventLoggerDeployer, err = New(
+				c,
+				namespace,
+				fakeSecretManager,
+				Values{
+					Image:                 image,
+					Replicas:              1,
+					PriorityClassName:     "gardener-garden-system-100",
+					NamePrefix:            "virtual-garden-",
+					TargetEventNamespaces: []string{"default", "garden", "garden-foo"},
+				},
+			)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should deploy the event-logger for the virtual garden cluster", func() {
+			Expect(eventLoggerDeployer.Deploy(ctx)).To(Succeed())
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(eventLoggerDeployment), eventLoggerDeployment)).To(Succeed())
+			Expect(eventLoggerDeployment.Spec.Template.Labels).To(HaveKeyWithValue("networking.resources.gardener.cloud/to-virtual-garden-kube-apiserver-tcp-443", "allowed"))
+			Expect(eventLoggerDeployment.Spec.Template.Labels).NotTo(HaveKey("networking.resources.gardener.cloud/to-kube-apiserver-tcp-443"))
+			Expect(eventLoggerDeployment.Spec.Template.Spec.PriorityClassName).To(Equal("gardener-garden-system-100"))
+			Expect(eventLoggerDeployment.Spec.Template.Spec.Containers[0].Command).To(Equal([]string{
+				"./event-logger",
+				"--seed-event-namespaces=" + namespace,
+				"--shoot-kubeconfig=/var/run/secrets/gardener.cloud/shoot/generic-kubeconfig/kubeconfig",
+				"--shoot-event-namespaces=default,garden,garden-foo",
+			}))
+		})
+	})
+
 	Describe("#Destroy", func() {
 		It("should successfully destroy all resources", func() {
 			Expect(c.Create(ctx, managedResource)).To(Succeed())
@@ -182,6 +182,80 @@ func newGardenCustomResourceStateMetrics() customresourcestate.Resource {
 	return resource
 }
 
+func newNetworkCustomResourceStateMetrics() customresourcestate.Resource {
+	resource := customresourcestate.Resource{
+		GroupVersionKind: customresourcestate.GroupVersionKind{
+			Group:   "extensions.gardener.cloud",
+			Kind:    "Network",
+			Version: "v1alpha1",
+		},
+		Labels: customresourcestate.Labels{
+			LabelsFromPath: map[string][]string{
+				"network":   {"metadata", "name"},
+				"namespace": {"metadata", "namespace"},
+			},
+		},
+	}
+
+	helpMessages := map[string]string{
+		"capacity":  "Number of IP addresses which can be allocated to pods",
+		"allocated": "Number of IP addresses which are allocated to pods or reserved for nodes",
+	}
+
+	for _, attr := range []string{"capacity", "allocated"} {
+		resource.Metrics = append(resource.Metrics,
+			customresourcestate.Generator{
+				Name: "network_pod_cidr_" + attr,
+				Help: helpMessages[attr] + " in the pod CIDR.",
+				Each: customresourcestate.Metric{
+					Type: metric.Gauge,
+					Gauge: &customresourcestate.MetricGauge{
+						MetricMeta: customresourcestate.MetricMeta{
+							Path: []string{"status", "podCIDRUtilization"},
+						},
+						ValueFrom: []string{attr},
+					},
+				},
+			},
+			customresourcestate.Generator{
+				Name: "network_pod_cidr_zone_" + attr,
+				Help: helpMessages[attr] + " in the part of the pod CIDR used in a zone.",
+				Each: customresourcestate.Metric{
+					Type: metric.Gauge,
+					Gauge: &customresourcestate.MetricGauge{
+						MetricMeta: customresourcestate.MetricMeta{
+							Path: []string{"status", "podCIDRUtilization", "zones"},
+							LabelsFromPath: map[string][]string{
+								"zone": {"name"},
+							},
+						},
+						ValueFrom: []string{attr},
+					},
+				},
+			},
+			customresourcestate.Generator{
+				Name: "network_pod_cidr_node_" + attr,
+				Help: helpMessages[attr] + " in the pod CIDR range of a node.",
+				Each: customresourcestate.Metric{
+					Type: metric.Gauge,
+					Gauge: &customresourcestate.MetricGauge{
+						MetricMeta: customresourcestate.MetricMeta{
+							Path: []string{"status", "podCIDRUtilization", "nodes"},
+							LabelsFromPath: map[string][]string{
+								"node": {"name"},
+								"zone": {"zone"},
+							},
+						},
+						ValueFrom: []string{attr},
+					},
+				},
+			},
+		)
+	}
+
+	return resource
+}
+
 // Option is a functional option type used to configure the CustomResourceState settings
 type Option func(*customresourcestate.Metrics)
 
@@ -190,6 +264,11 @@ func WithGardenResourceMetrics(c *customresourcestate.Metrics) {
 	c.Spec.Resources = append(c.Spec.Resources, newGardenCustomResourceStateMetrics())
 }
 
+// WithNetworkMetrics adds the custom resource state configuration for the Network extension resource
+func WithNetworkMetrics(c *customresourcestate.Metrics) {
+	c.Spec.Resources = append(c.Spec.Resources, newNetworkCustomResourceStateMetrics())
+}
+
 // WithVPAMetrics adds the custom resource state configuration for the VerticalPodAutoscaler resource
 func WithVPAMetrics(c *customresourcestate.Metrics) {
 	c.Spec.Resources = append(c.Spec.Resources, newCustomResourceStateMetricsForVPA())
@@ -31,9 +31,13 @@ func expectedCustomResourceStateConfig(suffix string) string {
 	options = []Option{WithVPAMetrics}
 	relativePath = "testdata/custom-resource-state-vpa.expectation.yaml"
 
-	if suffix == SuffixRuntime {
+	switch suffix {
+	case SuffixRuntime:
 		options = append(options, WithGardenResourceMetrics)
 		relativePath = "testdata/custom-resource-state-garden.expectation.yaml"
+	case SuffixSeed:
+		options = append(options, WithNetworkMetrics)
+		relativePath = "testdata/custom-resource-state-seed.expectation.yaml"
 	}
 
 	expectFilePath, err = filepath.Abs(relativePath)
@@ -137,6 +137,14 @@ var _ = Describe("KubeStateMetrics", func() {
 				})
 			}
 
+			if nameSuffix == SuffixSeed {
+				obj.Rules = append(obj.Rules, rbacv1.PolicyRule{
+					APIGroups: []string{"extensions.gardener.cloud"},
+					Resources: []string{"networks"},
+					Verbs:     []string{"list", "watch"},
+				})
+			}
+
 			return obj
 		}
 		clusterRoleBindingFor = func(clusterType component.ClusterType, nameSuffix string) *rbacv1.ClusterRoleBinding {
@@ -101,6 +101,14 @@ func (k *kubeStateMetrics) clusterRole() *rbacv1.ClusterRole {
 			Verbs:     []string{"list", "watch"},
 		})
 	}
+
+	if k.values.NameSuffix == SuffixSeed {
+		clusterRole.Rules = append(clusterRole.Rules, rbacv1.PolicyRule{
+			APIGroups: []string{"extensions.gardener.cloud"},
+			Resources: []string{"networks"},
+			Verbs:     []string{"list", "watch"},
+		})
+	}
 	return &clusterRole
 }
 
@@ -704,8 +712,11 @@ func (k *kubeStateMetrics) nameSuffix() string {
 
 func (k *kubeStateMetrics) customResourceStateConfigMap() (*corev1.ConfigMap, error) {
 	opts := []Option{WithVPAMetrics}
-	if k.values.NameSuffix == SuffixRuntime {
+	switch k.values.NameSuffix {
+	case SuffixRuntime:
 		opts = append(opts, WithGardenResourceMetrics)
+	case SuffixSeed:
+		opts = append(opts, WithNetworkMetrics)
 	}
 
 	customResourceStateConfig, err := yaml.Marshal(NewCustomResourceStateConfig(opts...))
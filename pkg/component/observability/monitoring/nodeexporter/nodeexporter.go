@@ -356,6 +356,7 @@ func (n *nodeExporter) computeResourcesData() (map[string][]byte, error) {
 						HostNetwork:                  true,
 						HostPID:                      true,
 						PriorityClassName:            "system-cluster-critical",
+						NodeSelector:                 map[string]string{corev1.LabelOSStable: v1beta1constants.OSFamilyLinux},
 						ServiceAccountName:           serviceAccount.Name,
 						AutomountServiceAccountToken: ptr.To(false),
 						SecurityContext: &corev1.PodSecurityContext{
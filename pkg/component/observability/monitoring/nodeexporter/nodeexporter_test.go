@@ -377,6 +377,8 @@ spec:
           readOnly: true
       hostNetwork: true
       hostPID: true
+      nodeSelector:
+        kubernetes.io/os: linux
       priorityClassName: system-cluster-critical
       securityContext:
         runAsNonRoot: true
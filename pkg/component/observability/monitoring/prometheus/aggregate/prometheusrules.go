@@ -22,17 +22,25 @@ var (
 	//go:embed assets/prometheusrules/metering.rules.stateful.yaml
 	meteringStatefulYAML []byte
 	meteringStateful     *monitoringv1.PrometheusRule
+
+	//go:embed assets/prometheusrules/control-plane-usage.rules.yaml
+	controlPlaneUsageYAML []byte
+	controlPlaneUsage     *monitoringv1.PrometheusRule
 )
 
 func init() {
 	meteringStateful = &monitoringv1.PrometheusRule{}
 	utilruntime.Must(runtime.DecodeInto(monitoringutils.Decoder, meteringStatefulYAML, meteringStateful))
+
+	controlPlaneUsage = &monitoringv1.PrometheusRule{}
+	utilruntime.Must(runtime.DecodeInto(monitoringutils.Decoder, controlPlaneUsageYAML, controlPlaneUsage))
 }
 
 // CentralPrometheusRules returns the central PrometheusRule resources for the aggregate prometheus.
 func CentralPrometheusRules() []*monitoringv1.PrometheusRule {
 	return []*monitoringv1.PrometheusRule{
 		meteringStateful.DeepCopy(),
+		controlPlaneUsage.DeepCopy(),
 		{
 			ObjectMeta: metav1.ObjectMeta{Name: "seed"},
 			Spec: monitoringv1.PrometheusRuleSpec{
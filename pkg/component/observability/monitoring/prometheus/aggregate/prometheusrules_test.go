@@ -25,6 +25,10 @@ var _ = Describe("PrometheusRules", func() {
 					"TypeMeta":   MatchFields(IgnoreExtras, Fields{"APIVersion": Equal("monitoring.coreos.com/v1"), "Kind": Equal("PrometheusRule")}),
 					"ObjectMeta": MatchFields(IgnoreExtras, Fields{"Name": Equal("metering-stateful")}),
 				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"TypeMeta":   MatchFields(IgnoreExtras, Fields{"APIVersion": Equal("monitoring.coreos.com/v1"), "Kind": Equal("PrometheusRule")}),
+					"ObjectMeta": MatchFields(IgnoreExtras, Fields{"Name": Equal("control-plane-usage")}),
+				})),
 				Equal(&monitoringv1.PrometheusRule{
 					ObjectMeta: metav1.ObjectMeta{Name: "seed"},
 					Spec: monitoringv1.PrometheusRuleSpec{
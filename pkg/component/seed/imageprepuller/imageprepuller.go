@@ -0,0 +1,281 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package imageprepuller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener/imagevector"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/component"
+	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
+	"github.com/gardener/gardener/pkg/utils/managedresources"
+)
+
+const (
+	// ManagedResourceName is the name of the ManagedResource containing the resource specifications.
+	ManagedResourceName = "image-pre-puller"
+	// AnnotationKubernetesVersions is the annotation on the DaemonSet which contains the Kubernetes versions whose
+	// images are pre-pulled. For each version, it records the time since which it is no longer used by any shoot (empty
+	// if it is still in use).
+	AnnotationKubernetesVersions = "image-pre-puller.gardener.cloud/kubernetes-versions"
+
+	name = "image-pre-puller"
+)
+
+// controlPlaneImages are the names of the images which are pre-pulled for every Kubernetes version together with the
+// binaries which are executed to make the init containers terminate immediately after the image was pulled.
+var controlPlaneImages = []struct {
+	name   string
+	binary string
+}{
+	{imagevector.ContainerImageNameKubeApiserver, "/usr/local/bin/kube-apiserver"},
+	{imagevector.ContainerImageNameKubeControllerManager, "/usr/local/bin/kube-controller-manager"},
+	{imagevector.ContainerImageNameKubeScheduler, "/usr/local/bin/kube-scheduler"},
+}
+
+// Values is a set of configuration values for the image pre-puller.
+type Values struct {
+	// KubernetesVersions are the Kubernetes versions currently used by the shoots hosted on the seed.
+	KubernetesVersions []string
+	// RetentionPeriod is the duration for which the images of a Kubernetes version are still pre-pulled after it is no
+	// longer contained in KubernetesVersions.
+	RetentionPeriod time.Duration
+	// ImageVector is used to find the control plane images for the Kubernetes versions.
+	ImageVector imagevectorutils.ImageVector
+	// PauseImage is the image of the container which keeps the pods running after the images were pulled.
+	PauseImage string
+	// RuntimeVersion is the Kubernetes version of the seed cluster.
+	RuntimeVersion *semver.Version
+}
+
+// New creates a new instance of DeployWaiter for the image pre-puller. It deploys a DaemonSet which pulls the control
+// plane images of the given Kubernetes versions on all nodes of the seed cluster.
+func New(
+	client client.Client,
+	namespace string,
+	clock clock.Clock,
+	values Values,
+) component.DeployWaiter {
+	return &imagePrePuller{
+		client:    client,
+		namespace: namespace,
+		clock:     clock,
+		values:    values,
+	}
+}
+
+type imagePrePuller struct {
+	client    client.Client
+	namespace string
+	clock     clock.Clock
+	values    Values
+}
+
+func (i *imagePrePuller) Deploy(ctx context.Context) error {
+	versions, err := i.computeKubernetesVersions(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(versions) == 0 {
+		return i.Destroy(ctx)
+	}
+
+	data, err := i.computeResourcesData(versions)
+	if err != nil {
+		return err
+	}
+
+	return managedresources.CreateForSeed(ctx, i.client, i.namespace, ManagedResourceName, false, data)
+}
+
+func (i *imagePrePuller) Destroy(ctx context.Context) error {
+	return managedresources.DeleteForSeed(ctx, i.client, i.namespace, ManagedResourceName)
+}
+
+// TimeoutWaitForManagedResource is the timeout used while waiting for the ManagedResources to become healthy
+// or deleted.
+var TimeoutWaitForManagedResource = 2 * time.Minute
+
+func (i *imagePrePuller) Wait(ctx context.Context) error {
+	timeoutCtx, cancel := context.WithTimeout(ctx, TimeoutWaitForManagedResource)
+	defer cancel()
+
+	return managedresources.WaitUntilHealthy(timeoutCtx, i.client, i.namespace, ManagedResourceName)
+}
+
+func (i *imagePrePuller) WaitCleanup(ctx context.Context) error {
+	timeoutCtx, cancel := context.WithTimeout(ctx, TimeoutWaitForManagedResource)
+	defer cancel()
+
+	return managedresources.WaitUntilDeleted(timeoutCtx, i.client, i.namespace, ManagedResourceName)
+}
+
+// computeKubernetesVersions merges the Kubernetes versions currently in use with the versions which were pre-pulled
+// before. Versions which are no longer in use are kept until their retention period has passed. The result maps the
+// versions to the time since which they are no longer in use (empty if they are still in use).
+func (i *imagePrePuller) computeKubernetesVersions(ctx context.Context) (map[string]string, error) {
+	previousVersions := map[string]string{}
+
+	daemonSet := &appsv1.DaemonSet{}
+	if err := i.client.Get(ctx, client.ObjectKey{Name: name, Namespace: i.namespace}, daemonSet); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("failed reading DaemonSet %s: %w", client.ObjectKeyFromObject(daemonSet), err)
+		}
+	} else if value, ok := daemonSet.Annotations[AnnotationKubernetesVersions]; ok {
+		if err := json.Unmarshal([]byte(value), &previousVersions); err != nil {
+			return nil, fmt.Errorf("failed parsing annotation %s of DaemonSet %s: %w", AnnotationKubernetesVersions, client.ObjectKeyFromObject(daemonSet), err)
+		}
+	}
+
+	var (
+		now      = i.clock.Now().UTC()
+		versions = make(map[string]string, len(i.values.KubernetesVersions))
+	)
+
+	for _, version := range i.values.KubernetesVersions {
+		versions[version] = ""
+	}
+
+	for version, unusedSince := range previousVersions {
+		if _, ok := versions[version]; ok {
+			continue
+		}
+
+		unusedSinceTime := now
+		if unusedSince != "" {
+			t, err := time.Parse(time.RFC3339, unusedSince)
+			if err != nil {
+				return nil, fmt.Errorf("failed parsing time since which Kubernetes version %s is unused: %w", version, err)
+			}
+			unusedSinceTime = t
+		}
+
+		if now.Sub(unusedSinceTime) >= i.values.RetentionPeriod {
+			continue
+		}
+
+		versions[version] = unusedSinceTime.Format(time.RFC3339)
+	}
+
+	return versions, nil
+}
+
+func (i *imagePrePuller) computeResourcesData(versions map[string]string) (map[string][]byte, error) {
+	var (
+		registry       = managedresources.NewRegistry(kubernetes.SeedScheme, kubernetes.SeedCodec, kubernetes.SeedSerializer)
+		initContainers []corev1.Container
+	)
+
+	for _, version := range slices.Sorted(maps.Keys(versions)) {
+		for _, controlPlaneImage := range controlPlaneImages {
+			image, err := i.values.ImageVector.FindImage(controlPlaneImage.name, imagevectorutils.RuntimeVersion(i.values.RuntimeVersion.String()), imagevectorutils.TargetVersion(version))
+			if err != nil {
+				return nil, fmt.Errorf("failed finding image %s for Kubernetes version %s: %w", controlPlaneImage.name, version, err)
+			}
+
+			initContainers = append(initContainers, corev1.Container{
+				Name:            controlPlaneImage.name + "-" + strings.ReplaceAll(version, ".", "-"),
+				Image:           image.String(),
+				ImagePullPolicy: corev1.PullIfNotPresent,
+				Command:         []string{controlPlaneImage.binary, "--version"},
+				Resources: corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("5m"),
+						corev1.ResourceMemory: resource.MustParse("16Mi"),
+					},
+				},
+				SecurityContext: &corev1.SecurityContext{AllowPrivilegeEscalation: ptr.To(false)},
+			})
+		}
+	}
+
+	versionsAnnotation, err := json.Marshal(versions)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := registry.Add(&appsv1.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: i.namespace,
+			Labels:    getLabels(),
+			Annotations: map[string]string{
+				AnnotationKubernetesVersions: string(versionsAnnotation),
+				// Pre-pulling is a best-effort optimization, hence it must not affect the health of the seed.
+				resourcesv1alpha1.SkipHealthCheck: "true",
+			},
+		},
+		Spec: appsv1.DaemonSetSpec{
+			RevisionHistoryLimit: ptr.To[int32](2),
+			Selector:             &metav1.LabelSelector{MatchLabels: getLabels()},
+			UpdateStrategy: appsv1.DaemonSetUpdateStrategy{
+				Type: appsv1.RollingUpdateDaemonSetStrategyType,
+				RollingUpdate: &appsv1.RollingUpdateDaemonSet{
+					// Limit the number of nodes pulling images at the same time to not overload the registries.
+					MaxUnavailable: ptr.To(intstr.FromString("25%")),
+				},
+			},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: getLabels(),
+				},
+				Spec: corev1.PodSpec{
+					AutomountServiceAccountToken:  ptr.To(false),
+					PriorityClassName:             v1beta1constants.PriorityClassNameSeedSystem600,
+					TerminationGracePeriodSeconds: ptr.To[int64](5),
+					SecurityContext: &corev1.PodSecurityContext{
+						RunAsNonRoot: ptr.To(true),
+						RunAsUser:    ptr.To[int64](65532),
+						RunAsGroup:   ptr.To[int64](65532),
+					},
+					InitContainers: initContainers,
+					Containers: []corev1.Container{{
+						Name:            "pause",
+						Image:           i.values.PauseImage,
+						ImagePullPolicy: corev1.PullIfNotPresent,
+						Resources: corev1.ResourceRequirements{
+							Requests: corev1.ResourceList{
+								corev1.ResourceCPU:    resource.MustParse("1m"),
+								corev1.ResourceMemory: resource.MustParse("4Mi"),
+							},
+						},
+						SecurityContext: &corev1.SecurityContext{AllowPrivilegeEscalation: ptr.To(false)},
+					}},
+				},
+			},
+		},
+	}); err != nil {
+		return nil, err
+	}
+
+	return registry.SerializedObjects()
+}
+
+func getLabels() map[string]string {
+	return map[string]string{
+		v1beta1constants.LabelApp: name,
+	}
+}
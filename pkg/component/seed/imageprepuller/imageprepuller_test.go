@@ -0,0 +1,238 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package imageprepuller_test
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/types"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/component"
+	. "github.com/gardener/gardener/pkg/component/seed/imageprepuller"
+	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("ImagePrePuller", func() {
+	var (
+		ctx = context.Background()
+
+		namespace  = "some-namespace"
+		pauseImage = "pause:latest"
+		now        = time.Date(2024, 10, 1, 12, 0, 0, 0, time.UTC)
+
+		c         client.Client
+		fakeClock *testclock.FakeClock
+		values    Values
+		component component.DeployWaiter
+		consistOf func(...client.Object) types.GomegaMatcher
+
+		managedResource *resourcesv1alpha1.ManagedResource
+		daemonSet       *appsv1.DaemonSet
+	)
+
+	BeforeEach(func() {
+		c = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		fakeClock = testclock.NewFakeClock(now)
+		consistOf = NewManagedResourceConsistOfObjectsMatcher(c)
+
+		values = Values{
+			KubernetesVersions: []string{"1.31.1", "1.30.5"},
+			RetentionPeriod:    24 * time.Hour,
+			ImageVector: imagevectorutils.ImageVector{
+				{Name: "kube-apiserver", Repository: ptr.To("registry/kube-apiserver")},
+				{Name: "kube-controller-manager", Repository: ptr.To("registry/kube-controller-manager")},
+				{Name: "kube-scheduler", Repository: ptr.To("registry/kube-scheduler")},
+			},
+			PauseImage:     pauseImage,
+			RuntimeVersion: semver.MustParse("1.31.0"),
+		}
+
+		managedResource = &resourcesv1alpha1.ManagedResource{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "image-pre-puller",
+				Namespace: namespace,
+			},
+		}
+		daemonSet = &appsv1.DaemonSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "image-pre-puller",
+				Namespace: namespace,
+			},
+		}
+	})
+
+	JustBeforeEach(func() {
+		component = New(c, namespace, fakeClock, values)
+	})
+
+	expectedDaemonSet := func(versionsAnnotation string, versions ...string) *appsv1.DaemonSet {
+		var initContainers []corev1.Container
+		for _, version := range versions {
+			for _, name := range []string{"kube-apiserver", "kube-controller-manager", "kube-scheduler"} {
+				initContainers = append(initContainers, corev1.Container{
+					Name:            name + "-" + strings.ReplaceAll(version, ".", "-"),
+					Image:           "registry/" + name + ":v" + version,
+					ImagePullPolicy: corev1.PullIfNotPresent,
+					Command:         []string{"/usr/local/bin/" + name, "--version"},
+					Resources: corev1.ResourceRequirements{
+						Requests: corev1.ResourceList{
+							corev1.ResourceCPU:    resource.MustParse("5m"),
+							corev1.ResourceMemory: resource.MustParse("16Mi"),
+						},
+					},
+					SecurityContext: &corev1.SecurityContext{AllowPrivilegeEscalation: ptr.To(false)},
+				})
+			}
+		}
+
+		return &appsv1.DaemonSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "image-pre-puller",
+				Namespace: namespace,
+				Labels:    map[string]string{"app": "image-pre-puller"},
+				Annotations: map[string]string{
+					"image-pre-puller.gardener.cloud/kubernetes-versions": versionsAnnotation,
+					"resources.gardener.cloud/skip-health-check":          "true",
+				},
+			},
+			Spec: appsv1.DaemonSetSpec{
+				RevisionHistoryLimit: ptr.To[int32](2),
+				Selector:             &metav1.LabelSelector{MatchLabels: map[string]string{"app": "image-pre-puller"}},
+				UpdateStrategy: appsv1.DaemonSetUpdateStrategy{
+					Type: appsv1.RollingUpdateDaemonSetStrategyType,
+					RollingUpdate: &appsv1.RollingUpdateDaemonSet{
+						MaxUnavailable: ptr.To(intstr.FromString("25%")),
+					},
+				},
+				Template: corev1.PodTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{
+						Labels: map[string]string{"app": "image-pre-puller"},
+					},
+					Spec: corev1.PodSpec{
+						AutomountServiceAccountToken:  ptr.To(false),
+						PriorityClassName:             "gardener-system-600",
+						TerminationGracePeriodSeconds: ptr.To[int64](5),
+						SecurityContext: &corev1.PodSecurityContext{
+							RunAsNonRoot: ptr.To(true),
+							RunAsUser:    ptr.To[int64](65532),
+							RunAsGroup:   ptr.To[int64](65532),
+						},
+						InitContainers: initContainers,
+						Containers: []corev1.Container{{
+							Name:            "pause",
+							Image:           pauseImage,
+							ImagePullPolicy: corev1.PullIfNotPresent,
+							Resources: corev1.ResourceRequirements{
+								Requests: corev1.ResourceList{
+									corev1.ResourceCPU:    resource.MustParse("1m"),
+									corev1.ResourceMemory: resource.MustParse("4Mi"),
+								},
+							},
+							SecurityContext: &corev1.SecurityContext{AllowPrivilegeEscalation: ptr.To(false)},
+						}},
+					},
+				},
+			},
+		}
+	}
+
+	Describe("#Deploy", func() {
+		It("should pre-pull the images of the Kubernetes versions in use", func() {
+			Expect(component.Deploy(ctx)).To(Succeed())
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+			Expect(managedResource).To(consistOf(expectedDaemonSet(`{"1.30.5":"","1.31.1":""}`, "1.30.5", "1.31.1")))
+		})
+
+		It("should keep pre-pulling the images of versions which are no longer in use until the retention period passed", func() {
+			daemonSet.Annotations = map[string]string{
+				"image-pre-puller.gardener.cloud/kubernetes-versions": `{"1.29.9":"","1.30.5":"2024-09-30T18:00:00Z","1.30.4":"2024-09-30T10:00:00Z","1.31.1":""}`,
+			}
+			Expect(c.Create(ctx, daemonSet)).To(Succeed())
+
+			Expect(component.Deploy(ctx)).To(Succeed())
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+			Expect(managedResource).To(consistOf(expectedDaemonSet(
+				`{"1.29.9":"2024-10-01T12:00:00Z","1.30.5":"","1.31.1":""}`,
+				"1.29.9", "1.30.5", "1.31.1",
+			)))
+		})
+
+		Context("when a version is no longer in use", func() {
+			BeforeEach(func() {
+				values.KubernetesVersions = []string{"1.31.1"}
+			})
+
+			It("should keep the version until the retention period passed", func() {
+				daemonSet.Annotations = map[string]string{
+					"image-pre-puller.gardener.cloud/kubernetes-versions": `{"1.30.5":"2024-09-30T18:00:00Z","1.31.1":""}`,
+				}
+				Expect(c.Create(ctx, daemonSet)).To(Succeed())
+
+				Expect(component.Deploy(ctx)).To(Succeed())
+
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+				Expect(managedResource).To(consistOf(expectedDaemonSet(`{"1.30.5":"2024-09-30T18:00:00Z","1.31.1":""}`, "1.30.5", "1.31.1")))
+			})
+
+		})
+
+		Context("when no version is in use anymore", func() {
+			BeforeEach(func() {
+				values.KubernetesVersions = nil
+			})
+
+			It("should delete the resources once the retention period passed", func() {
+				daemonSet.Annotations = map[string]string{
+					"image-pre-puller.gardener.cloud/kubernetes-versions": `{"1.30.5":"2024-09-30T10:00:00Z"}`,
+				}
+				Expect(c.Create(ctx, daemonSet)).To(Succeed())
+				Expect(c.Create(ctx, managedResource)).To(Succeed())
+
+				Expect(component.Deploy(ctx)).To(Succeed())
+
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(BeNotFoundError())
+			})
+		})
+
+		Context("when an image is missing in the image vector", func() {
+			BeforeEach(func() {
+				values.ImageVector = values.ImageVector[:1]
+			})
+
+			It("should fail", func() {
+				Expect(component.Deploy(ctx)).To(MatchError(ContainSubstring("failed finding image kube-controller-manager")))
+			})
+		})
+	})
+
+	Describe("#Destroy", func() {
+		It("should successfully destroy all resources", func() {
+			Expect(c.Create(ctx, managedResource)).To(Succeed())
+
+			Expect(component.Destroy(ctx)).To(Succeed())
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(BeNotFoundError())
+		})
+	})
+})
@@ -38,10 +38,33 @@ func computeAPIServerAuditConfig(
 	*apiserver.AuditConfig,
 	error,
 ) {
-	if config == nil || config.AuditPolicy == nil || config.AuditPolicy.ConfigMapRef == nil {
+	if config == nil {
 		return nil, nil
 	}
 
+	if webhookConfig == nil && config.Webhook != nil {
+		key := client.ObjectKey{Namespace: objectMeta.Namespace, Name: config.Webhook.KubeconfigSecretName}
+		kubeconfig, err := gardenerutils.FetchKubeconfigFromSecret(ctx, cl, key)
+		if err != nil {
+			return nil, fmt.Errorf("failed reading kubeconfig for audit webhook from referenced secret %s: %w", key, err)
+		}
+
+		webhookConfig = &apiserver.AuditWebhook{
+			Kubeconfig:   kubeconfig,
+			BatchMaxSize: config.Webhook.BatchMaxSize,
+		}
+		if config.Webhook.BatchMaxWait != nil {
+			webhookConfig.BatchMaxWait = &config.Webhook.BatchMaxWait.Duration
+		}
+	}
+
+	if config.AuditPolicy == nil || config.AuditPolicy.ConfigMapRef == nil {
+		if webhookConfig == nil {
+			return nil, nil
+		}
+		return &apiserver.AuditConfig{Webhook: webhookConfig}, nil
+	}
+
 	var (
 		out = &apiserver.AuditConfig{
 			Webhook: webhookConfig,
@@ -225,7 +248,20 @@ func convertToAdmissionPluginConfigs(ctx context.Context, gardenClient client.Cl
 	)
 
 	for _, plugin := range plugins {
-		config := apiserver.AdmissionPluginConfig{AdmissionPlugin: plugin}
+		config := apiserver.AdmissionPluginConfig{AdmissionPlugin: *plugin.DeepCopy()}
+		if plugin.ConfigMapName != nil {
+			configMap := &corev1.ConfigMap{}
+			key := client.ObjectKey{Namespace: namespace, Name: *plugin.ConfigMapName}
+			if err := gardenClient.Get(ctx, key, configMap); err != nil {
+				return nil, fmt.Errorf("failed reading config for admission plugin from referenced ConfigMap %s: %w", key, err)
+			}
+
+			configRaw, ok := configMap.Data[apiserver.DataKeyConfigMapAdmissionPluginConfig]
+			if !ok {
+				return nil, fmt.Errorf("missing '.data[%s]' in admission plugin config ConfigMap %s", apiserver.DataKeyConfigMapAdmissionPluginConfig, key)
+			}
+			config.Config = &runtime.RawExtension{Raw: []byte(configRaw)}
+		}
 		if plugin.KubeconfigSecretName != nil {
 			key := client.ObjectKey{Namespace: namespace, Name: *plugin.KubeconfigSecretName}
 			config.Kubeconfig, err = gardenerutils.FetchKubeconfigFromSecret(ctx, gardenClient, key)
@@ -19,22 +19,39 @@ import (
 
 // SnapshotEtcd performs a full snapshot on ETCD main.
 func SnapshotEtcd(ctx context.Context, secretsManager secretsmanager.Interface, etcdMain etcd.Interface) error {
+	httpClient, err := etcdBackupRestoreHTTPClient(secretsManager)
+	if err != nil {
+		return err
+	}
+
+	return etcdMain.Snapshot(ctx, httpClient)
+}
+
+// FullSnapshotEtcd triggers an out-of-schedule full snapshot of the etcd-main and returns information about it.
+func FullSnapshotEtcd(ctx context.Context, secretsManager secretsmanager.Interface, etcdMain etcd.Interface) (*etcd.SnapshotInfo, error) {
+	httpClient, err := etcdBackupRestoreHTTPClient(secretsManager)
+	if err != nil {
+		return nil, err
+	}
+
+	return etcdMain.FullSnapshot(ctx, httpClient)
+}
+
+func etcdBackupRestoreHTTPClient(secretsManager secretsmanager.Interface) (*http.Client, error) {
 	etcdCASecret, found := secretsManager.Get(v1beta1constants.SecretNameCAETCD)
 	if !found {
-		return fmt.Errorf("secret %q not found", v1beta1constants.SecretNameCAETCD)
+		return nil, fmt.Errorf("secret %q not found", v1beta1constants.SecretNameCAETCD)
 	}
 
 	caCerts := x509.NewCertPool()
 	caCerts.AppendCertsFromPEM(etcdCASecret.Data[secretsutils.DataKeyCertificateBundle])
 
-	httpClient := &http.Client{
+	return &http.Client{
 		Transport: &http.Transport{
 			TLSClientConfig: &tls.Config{
 				RootCAs:    caCerts,
 				MinVersion: tls.VersionTLS12,
 			},
 		},
-	}
-
-	return etcdMain.Snapshot(ctx, httpClient)
+	}, nil
 }
@@ -136,7 +136,8 @@ func NewIstio(
 
 // AddIstioIngressGateway adds an Istio ingress gateway to the given deployer. It uses the first Ingress Gateway
 // to fill out common chart values. Hence, it is assumed that at least one Ingress Gateway was added to the given
-// `istioDeployer` before calling this function.
+// `istioDeployer` before calling this function. The given replica overrides take precedence over the values of the first
+// Ingress Gateway if they are set.
 func AddIstioIngressGateway(
 	ctx context.Context,
 	cl client.Client,
@@ -149,6 +150,9 @@ func AddIstioIngressGateway(
 	zone *string,
 	dualStack bool,
 	terminateLoadBalancerProxyProtocol *bool,
+	loadBalancerSourceRanges []string,
+	minReplicasOverride *int,
+	maxReplicasOverride *int,
 ) error {
 	gatewayValues := istioDeployer.GetValues().IngressGateway
 	if len(gatewayValues) < 1 {
@@ -179,6 +183,16 @@ func AddIstioIngressGateway(
 		}
 	}
 
+	if minReplicasOverride != nil {
+		minReplicas = minReplicasOverride
+	}
+	if maxReplicasOverride != nil {
+		maxReplicas = maxReplicasOverride
+	}
+	if minReplicas != nil && maxReplicas != nil && *maxReplicas < *minReplicas {
+		maxReplicas = ptr.To(*minReplicas)
+	}
+
 	istioDeployer.AddIngressGateway(istio.IngressGatewayValues{
 		Annotations:                        utils.MergeStringMaps(annotations),
 		Labels:                             utils.MergeStringMaps(labels),
@@ -188,6 +202,7 @@ func AddIstioIngressGateway(
 		MaxReplicas:                        maxReplicas,
 		ExternalTrafficPolicy:              externalTrafficPolicy,
 		LoadBalancerIP:                     serviceExternalIP,
+		LoadBalancerSourceRanges:           loadBalancerSourceRanges,
 		Image:                              templateValues.Image,
 		IstiodNamespace:                    v1beta1constants.IstioSystemNamespace,
 		Ports:                              templateValues.Ports,
@@ -307,7 +322,7 @@ func commonIstioIngressNetworkPolicyLabels(vpnEnabled bool) map[string]string {
 		labels[gardenerutils.NetworkPolicyLabel(v1beta1constants.LabelNetworkPolicyShootNamespaceAlias+"-"+v1beta1constants.DeploymentNameVPNSeedServer, vpnseedserver.OpenVPNPort)] = v1beta1constants.LabelNetworkPolicyAllowed
 		labels[gardenerutils.NetworkPolicyLabel(v1beta1constants.GardenNamespace+"-"+vpnauthzserver.Name, vpnauthzserver.ServerPort)] = v1beta1constants.LabelNetworkPolicyAllowed
 
-		for i := 0; i < vpnseedserver.HighAvailabilityReplicaCount; i++ {
+		for i := 0; i < vpnseedserver.HighAvailabilityMaxReplicaCount; i++ {
 			labels[gardenerutils.NetworkPolicyLabel(fmt.Sprintf("%s-%s-%d", v1beta1constants.LabelNetworkPolicyShootNamespaceAlias, v1beta1constants.DeploymentNameVPNSeedServer, i), vpnseedserver.OpenVPNPort)] = v1beta1constants.LabelNetworkPolicyAllowed
 		}
 	}
@@ -9,6 +9,7 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
 	gomegatypes "github.com/onsi/gomega/types"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -100,6 +101,8 @@ func checkIstio(istioDeploy istio.Interface, testValues istioTestValues) {
 		networkPolicyLabels["networking.resources.gardener.cloud/to-all-shoots-vpn-seed-server-tcp-1194"] = "allowed"
 		networkPolicyLabels["networking.resources.gardener.cloud/to-all-shoots-vpn-seed-server-0-tcp-1194"] = "allowed"
 		networkPolicyLabels["networking.resources.gardener.cloud/to-all-shoots-vpn-seed-server-1-tcp-1194"] = "allowed"
+		networkPolicyLabels["networking.resources.gardener.cloud/to-all-shoots-vpn-seed-server-2-tcp-1194"] = "allowed"
+		networkPolicyLabels["networking.resources.gardener.cloud/to-all-shoots-vpn-seed-server-3-tcp-1194"] = "allowed"
 		networkPolicyLabels["networking.resources.gardener.cloud/to-garden-nginx-ingress-controller-tcp-443"] = "allowed"
 	}
 
@@ -345,7 +348,11 @@ var _ = Describe("Istio", func() {
 				serviceExternalIP,
 				zone,
 				false,
-				&proxyProtoclLB)).To(MatchError("at least one ingress gateway must be present before adding further ones"))
+				&proxyProtoclLB,
+				nil,
+				nil,
+				nil,
+			)).To(MatchError("at least one ingress gateway must be present before adding further ones"))
 		})
 
 		Context("without zone", func() {
@@ -365,7 +372,11 @@ var _ = Describe("Istio", func() {
 					serviceExternalIP,
 					zone,
 					false,
-					&proxyProtoclLB)).To(Succeed())
+					&proxyProtoclLB,
+					nil,
+					nil,
+					nil,
+				)).To(Succeed())
 
 				checkAdditionalIstioGateway(
 					testValues.client,
@@ -398,7 +409,11 @@ var _ = Describe("Istio", func() {
 					serviceExternalIP,
 					zone,
 					false,
-					&proxyProtoclLB)).To(Succeed())
+					&proxyProtoclLB,
+					nil,
+					nil,
+					nil,
+				)).To(Succeed())
 
 				checkAdditionalIstioGateway(
 					testValues.client,
@@ -434,7 +449,11 @@ var _ = Describe("Istio", func() {
 						serviceExternalIP,
 						zone,
 						false,
-						&proxyProtoclLB)).To(Succeed())
+						&proxyProtoclLB,
+						nil,
+						nil,
+						nil,
+					)).To(Succeed())
 
 					checkAdditionalIstioGateway(
 						testValues.client,
@@ -451,6 +470,63 @@ var _ = Describe("Istio", func() {
 			})
 		})
 
+		Context("with load balancer source ranges and replica overrides", func() {
+			BeforeEach(func() {
+				zone = nil
+			})
+
+			It("should successfully add an additional ingress gateway with the overridden settings", func() {
+				Expect(AddIstioIngressGateway(
+					context.Background(),
+					testValues.client,
+					istioDeploy,
+					namespace,
+					annotations,
+					labels,
+					&externalTrafficPolicy,
+					serviceExternalIP,
+					zone,
+					false,
+					&proxyProtoclLB,
+					[]string{"10.0.0.0/8"},
+					ptr.To(5),
+					nil,
+				)).To(Succeed())
+
+				ingressValues := istioDeploy.GetValues().IngressGateway
+				gateway := ingressValues[len(ingressValues)-1]
+				Expect(gateway.Namespace).To(Equal(namespace))
+				Expect(gateway.LoadBalancerSourceRanges).To(ConsistOf("10.0.0.0/8"))
+				Expect(gateway.MinReplicas).To(PointTo(Equal(5)))
+				Expect(gateway.MaxReplicas).To(Equal(ingressValues[0].MaxReplicas))
+			})
+
+			It("should raise the maximum replicas to the overridden minimum replicas", func() {
+				Expect(AddIstioIngressGateway(
+					context.Background(),
+					testValues.client,
+					istioDeploy,
+					namespace,
+					annotations,
+					labels,
+					&externalTrafficPolicy,
+					serviceExternalIP,
+					zone,
+					false,
+					&proxyProtoclLB,
+					nil,
+					ptr.To(12),
+					ptr.To(10),
+				)).To(Succeed())
+
+				ingressValues := istioDeploy.GetValues().IngressGateway
+				gateway := ingressValues[len(ingressValues)-1]
+				Expect(gateway.LoadBalancerSourceRanges).To(BeEmpty())
+				Expect(gateway.MinReplicas).To(PointTo(Equal(12)))
+				Expect(gateway.MaxReplicas).To(PointTo(Equal(12)))
+			})
+		})
+
 		Context("without zone, dualstack enabled", func() {
 			BeforeEach(func() {
 				zone = nil
@@ -468,7 +544,11 @@ var _ = Describe("Istio", func() {
 					serviceExternalIP,
 					zone,
 					true,
-					&proxyProtoclLB)).To(Succeed())
+					&proxyProtoclLB,
+					nil,
+					nil,
+					nil,
+				)).To(Succeed())
 
 				checkAdditionalIstioGateway(
 					testValues.client,
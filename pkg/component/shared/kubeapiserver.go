@@ -153,6 +153,13 @@ func NewKubeAPIServer(
 		logging = apiServerConfig.Logging
 		oidcConfig = apiServerConfig.OIDCConfig
 		requests = apiServerConfig.Requests
+		if apiServerConfig.APIPriorityAndFairness != nil && apiServerConfig.APIPriorityAndFairness.MaxSeats != nil {
+			// With API priority and fairness, the server's total concurrency limit is the sum of both inflight limits.
+			requests = &gardencorev1beta1.APIServerRequests{
+				MaxNonMutatingInflight: apiServerConfig.APIPriorityAndFairness.MaxSeats,
+				MaxMutatingInflight:    ptr.To[int32](0),
+			}
+		}
 		runtimeConfig = apiServerConfig.RuntimeConfig
 		watchCacheSizes = apiServerConfig.WatchCacheSizes
 	}
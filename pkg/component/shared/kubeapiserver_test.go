@@ -288,6 +288,42 @@ var _ = Describe("KubeAPIServer", func() {
 				),
 			)
 
+			Context("admission plugin config from ConfigMap", func() {
+				var configMap *corev1.ConfigMap
+
+				BeforeEach(func() {
+					configMap = &corev1.ConfigMap{
+						ObjectMeta: metav1.ObjectMeta{Name: "admission-config", Namespace: objectMeta.Namespace},
+						Data:       map[string]string{"config.yaml": "foo-config"},
+					}
+					apiServerConfig.AdmissionPlugins = []gardencorev1beta1.AdmissionPlugin{{Name: "Foo", ConfigMapName: ptr.To(configMap.Name)}}
+				})
+
+				It("should read the plugin config from the referenced ConfigMap", func() {
+					Expect(resourceConfigClient.Create(ctx, configMap)).To(Succeed())
+
+					kubeAPIServer, err := NewKubeAPIServer(ctx, runtimeClientSet, resourceConfigClient, namespace, objectMeta, runtimeVersion, targetVersion, sm, namePrefix, apiServerConfig, autoscalingConfig, vpnConfig, priorityClassName, false, staticTokenKubeconfigEnabled, auditWebhookConfig, authenticationWebhookConfig, authorizationWebhookConfigs, resourcesToStoreInETCDEvents)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(kubeAPIServer.GetValues().EnabledAdmissionPlugins).To(ContainElement(apiserver.AdmissionPluginConfig{
+						AdmissionPlugin: gardencorev1beta1.AdmissionPlugin{Name: "Foo", ConfigMapName: ptr.To(configMap.Name), Config: &runtime.RawExtension{Raw: []byte("foo-config")}},
+					}))
+					Expect(apiServerConfig.AdmissionPlugins[0].Config).To(BeNil())
+				})
+
+				It("should fail if the referenced ConfigMap does not exist", func() {
+					_, err := NewKubeAPIServer(ctx, runtimeClientSet, resourceConfigClient, namespace, objectMeta, runtimeVersion, targetVersion, sm, namePrefix, apiServerConfig, autoscalingConfig, vpnConfig, priorityClassName, false, staticTokenKubeconfigEnabled, auditWebhookConfig, authenticationWebhookConfig, authorizationWebhookConfigs, resourcesToStoreInETCDEvents)
+					Expect(err).To(MatchError(ContainSubstring("failed reading config for admission plugin from referenced ConfigMap")))
+				})
+
+				It("should fail if the referenced ConfigMap does not contain the config", func() {
+					configMap.Data = nil
+					Expect(resourceConfigClient.Create(ctx, configMap)).To(Succeed())
+
+					_, err := NewKubeAPIServer(ctx, runtimeClientSet, resourceConfigClient, namespace, objectMeta, runtimeVersion, targetVersion, sm, namePrefix, apiServerConfig, autoscalingConfig, vpnConfig, priorityClassName, false, staticTokenKubeconfigEnabled, auditWebhookConfig, authenticationWebhookConfig, authorizationWebhookConfigs, resourcesToStoreInETCDEvents)
+					Expect(err).To(MatchError(ContainSubstring("missing '.data[config.yaml]' in admission plugin config ConfigMap")))
+				})
+			})
+
 			Context("should have the expected disabled admission plugins", func() {
 				var expectedDisabledPlugins []gardencorev1beta1.AdmissionPlugin
 
@@ -707,6 +743,62 @@ exemptions:
 					},
 					Not(HaveOccurred()),
 				),
+				Entry("webhook is configured but referenced kubeconfig secret is missing",
+					func() {
+						apiServerConfig = &gardencorev1beta1.KubeAPIServerConfig{
+							AuditConfig: &gardencorev1beta1.AuditConfig{
+								Webhook: &gardencorev1beta1.AuditWebhook{KubeconfigSecretName: secret.Name},
+							},
+						}
+					},
+					nil,
+					MatchError(ContainSubstring("failed reading kubeconfig for audit webhook from referenced secret")),
+				),
+				Entry("webhook is configured without audit policy",
+					func() {
+						Expect(resourceConfigClient.Create(ctx, secret)).To(Succeed())
+
+						apiServerConfig = &gardencorev1beta1.KubeAPIServerConfig{
+							AuditConfig: &gardencorev1beta1.AuditConfig{
+								Webhook: &gardencorev1beta1.AuditWebhook{
+									KubeconfigSecretName: secret.Name,
+									BatchMaxSize:         ptr.To[int32](10),
+									BatchMaxWait:         &metav1.Duration{Duration: 5 * time.Second},
+								},
+							},
+						}
+					},
+					&apiserver.AuditConfig{
+						Webhook: &apiserver.AuditWebhook{
+							Kubeconfig:   []byte("kubeconfig-data"),
+							BatchMaxSize: ptr.To[int32](10),
+							BatchMaxWait: ptr.To(5 * time.Second),
+						},
+					},
+					Not(HaveOccurred()),
+				),
+				Entry("webhook is configured with audit policy",
+					func() {
+						Expect(resourceConfigClient.Create(ctx, auditPolicyConfigMap)).To(Succeed())
+						Expect(resourceConfigClient.Create(ctx, secret)).To(Succeed())
+
+						apiServerConfig = &gardencorev1beta1.KubeAPIServerConfig{
+							AuditConfig: &gardencorev1beta1.AuditConfig{
+								AuditPolicy: &gardencorev1beta1.AuditPolicy{
+									ConfigMapRef: &corev1.ObjectReference{
+										Name: auditPolicyConfigMap.Name,
+									},
+								},
+								Webhook: &gardencorev1beta1.AuditWebhook{KubeconfigSecretName: secret.Name},
+							},
+						}
+					},
+					&apiserver.AuditConfig{
+						Policy:  &policy,
+						Webhook: &apiserver.AuditWebhook{Kubeconfig: []byte("kubeconfig-data")},
+					},
+					Not(HaveOccurred()),
+				),
 			)
 		})
 
@@ -1112,6 +1204,19 @@ authorizers:
 				Expect(err).NotTo(HaveOccurred())
 				Expect(kubeAPIServer.GetValues().Requests).To(Equal(requests))
 			})
+
+			It("should derive the inflight limits from the API priority and fairness seats", func() {
+				apiServerConfig = &gardencorev1beta1.KubeAPIServerConfig{
+					APIPriorityAndFairness: &gardencorev1beta1.APIPriorityAndFairness{MaxSeats: ptr.To[int32](1000)},
+				}
+
+				kubeAPIServer, err := NewKubeAPIServer(ctx, runtimeClientSet, resourceConfigClient, namespace, objectMeta, runtimeVersion, targetVersion, sm, namePrefix, apiServerConfig, autoscalingConfig, vpnConfig, priorityClassName, isWorkerless, staticTokenKubeconfigEnabled, auditWebhookConfig, authenticationWebhookConfig, authorizationWebhookConfigs, resourcesToStoreInETCDEvents)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(kubeAPIServer.GetValues().Requests).To(Equal(&gardencorev1beta1.APIServerRequests{
+					MaxNonMutatingInflight: ptr.To[int32](1000),
+					MaxMutatingInflight:    ptr.To[int32](0),
+				}))
+			})
 		})
 
 		Describe("RuntimeConfig", func() {
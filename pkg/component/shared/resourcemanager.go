@@ -120,6 +120,7 @@ func NewTargetGardenerResourceManager(
 	targetNamespaces []string,
 	nodeAgentReconciliationMaxDelay *metav1.Duration,
 	nodeAgentAuthorizerEnabled bool,
+	namespaceDefaults *gardencorev1beta1.NamespaceDefaults,
 ) (
 	resourcemanager.Interface,
 	error,
@@ -146,6 +147,7 @@ func NewTargetGardenerResourceManager(
 		MaxConcurrentTokenRequestorWorkers:   ptr.To(5),
 		MaxConcurrentCSRApproverWorkers:      ptr.To(5),
 		NamePrefix:                           namePrefix,
+		NamespaceDefaults:                    namespaceDefaults,
 		PodTopologySpreadConstraintsEnabled:  podTopologySpreadConstraintsEnabled,
 		PriorityClassName:                    priorityClassName,
 		SchedulingProfile:                    schedulingProfile,
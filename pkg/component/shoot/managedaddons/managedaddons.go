@@ -0,0 +1,225 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package managedaddons
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1 "github.com/gardener/gardener/pkg/apis/core/v1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/chartrenderer"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/component"
+	"github.com/gardener/gardener/pkg/utils/flow"
+	"github.com/gardener/gardener/pkg/utils/managedresources"
+	"github.com/gardener/gardener/pkg/utils/oci"
+)
+
+const (
+	// ManagedResourceNamePrefix is the prefix of the names of the ManagedResources containing the resources of the
+	// managed addons.
+	ManagedResourceNamePrefix = "shoot-addon-managed-"
+	// NamespacePrefix is the prefix of the namespaces in the shoot cluster the managed addons are deployed to.
+	NamespacePrefix = "addon-"
+	// LabelKeyName is the key of the label on the ManagedResources containing the name of the managed addon.
+	LabelKeyName = "addon.gardener.cloud/name"
+	// LabelKeyVersion is the key of the label on the ManagedResources containing the version of the managed addon.
+	LabelKeyVersion = "addon.gardener.cloud/version"
+)
+
+// Interface contains functions for a managed addons deployer.
+type Interface interface {
+	component.DeployWaiter
+	// SetAddons sets the managed addons which shall be deployed.
+	SetAddons([]Addon)
+}
+
+// Addon contains the information required for deploying a managed addon.
+type Addon struct {
+	// Name is the name of the addon.
+	Name string
+	// Version is the version of the addon.
+	Version string
+	// Chart is the reference to the Helm chart of the addon.
+	Chart *gardencorev1.OCIRepository
+	// Values are the JSON-encoded values for the Helm chart.
+	Values []byte
+}
+
+// New creates a new instance of DeployWaiter for the managed addons.
+func New(
+	client client.Client,
+	namespace string,
+	helmRegistry oci.Interface,
+	chartRenderer chartrenderer.Interface,
+) Interface {
+	return &managedAddons{
+		client:        client,
+		namespace:     namespace,
+		helmRegistry:  helmRegistry,
+		chartRenderer: chartRenderer,
+	}
+}
+
+type managedAddons struct {
+	client        client.Client
+	namespace     string
+	helmRegistry  oci.Interface
+	chartRenderer chartrenderer.Interface
+	addons        []Addon
+}
+
+func (m *managedAddons) Deploy(ctx context.Context) error {
+	wanted := sets.New[string]()
+
+	for _, addon := range m.addons {
+		data, err := m.computeResourcesData(ctx, addon)
+		if err != nil {
+			return fmt.Errorf("failed computing resources for managed addon %q: %w", addon.Name, err)
+		}
+
+		name := ManagedResourceName(addon.Name)
+		if err := managedresources.CreateForShootWithLabels(ctx, m.client, m.namespace, name, managedresources.LabelValueGardener, false, map[string]string{
+			v1beta1constants.GardenRole: v1beta1constants.GardenRoleManagedAddon,
+			LabelKeyName:                addon.Name,
+			LabelKeyVersion:             addon.Version,
+		}, data); err != nil {
+			return fmt.Errorf("failed deploying managed addon %q: %w", addon.Name, err)
+		}
+		wanted.Insert(name)
+	}
+
+	staleManagedResources, err := m.listManagedResources(ctx)
+	if err != nil {
+		return err
+	}
+
+	var fns []flow.TaskFn
+	for _, managedResource := range staleManagedResources {
+		if wanted.Has(managedResource.Name) {
+			continue
+		}
+
+		name := managedResource.Name
+		fns = append(fns, func(ctx context.Context) error {
+			return managedresources.DeleteForShoot(ctx, m.client, m.namespace, name)
+		})
+	}
+
+	return flow.Parallel(fns...)(ctx)
+}
+
+func (m *managedAddons) Destroy(ctx context.Context) error {
+	managedResources, err := m.listManagedResources(ctx)
+	if err != nil {
+		return err
+	}
+
+	var fns []flow.TaskFn
+	for _, managedResource := range managedResources {
+		name := managedResource.Name
+		fns = append(fns, func(ctx context.Context) error {
+			return managedresources.DeleteForShoot(ctx, m.client, m.namespace, name)
+		})
+	}
+
+	return flow.Parallel(fns...)(ctx)
+}
+
+// TimeoutWaitForManagedResource is the timeout used while waiting for the ManagedResources to become healthy
+// or deleted.
+var TimeoutWaitForManagedResource = 5 * time.Minute
+
+func (m *managedAddons) Wait(ctx context.Context) error {
+	timeoutCtx, cancel := context.WithTimeout(ctx, TimeoutWaitForManagedResource)
+	defer cancel()
+
+	var fns []flow.TaskFn
+	for _, addon := range m.addons {
+		name := ManagedResourceName(addon.Name)
+		fns = append(fns, func(ctx context.Context) error {
+			return managedresources.WaitUntilHealthy(ctx, m.client, m.namespace, name)
+		})
+	}
+
+	return flow.Parallel(fns...)(timeoutCtx)
+}
+
+func (m *managedAddons) WaitCleanup(ctx context.Context) error {
+	timeoutCtx, cancel := context.WithTimeout(ctx, TimeoutWaitForManagedResource)
+	defer cancel()
+
+	return managedresources.WaitUntilListDeleted(timeoutCtx, m.client, &resourcesv1alpha1.ManagedResourceList{}, client.InNamespace(m.namespace), client.MatchingLabels{v1beta1constants.GardenRole: v1beta1constants.GardenRoleManagedAddon})
+}
+
+func (m *managedAddons) SetAddons(addons []Addon) {
+	m.addons = addons
+}
+
+func (m *managedAddons) listManagedResources(ctx context.Context) ([]resourcesv1alpha1.ManagedResource, error) {
+	managedResourceList := &resourcesv1alpha1.ManagedResourceList{}
+	if err := m.client.List(ctx, managedResourceList, client.InNamespace(m.namespace), client.MatchingLabels{v1beta1constants.GardenRole: v1beta1constants.GardenRoleManagedAddon}); err != nil {
+		return nil, fmt.Errorf("failed listing ManagedResources of managed addons: %w", err)
+	}
+	return managedResourceList.Items, nil
+}
+
+func (m *managedAddons) computeResourcesData(ctx context.Context, addon Addon) (map[string][]byte, error) {
+	archive, err := m.helmRegistry.Pull(ctx, addon.Chart)
+	if err != nil {
+		return nil, fmt.Errorf("failed pulling Helm chart from OCI repository %q: %w", addon.Chart.GetURL(), err)
+	}
+
+	values := map[string]any{}
+	if len(addon.Values) > 0 {
+		if err := json.Unmarshal(addon.Values, &values); err != nil {
+			return nil, fmt.Errorf("failed decoding values: %w", err)
+		}
+	}
+
+	namespace := Namespace(addon.Name)
+	renderedChart, err := m.chartRenderer.RenderArchive(archive, addon.Name, namespace, values)
+	if err != nil {
+		return nil, fmt.Errorf("failed rendering Helm chart %q: %w", addon.Chart.GetURL(), err)
+	}
+
+	registry := managedresources.NewRegistry(kubernetes.ShootScheme, kubernetes.ShootCodec, kubernetes.ShootSerializer)
+	data, err := registry.AddAllAndSerialize(&corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: namespace,
+			Labels: map[string]string{
+				v1beta1constants.GardenRole: v1beta1constants.GardenRoleManagedAddon,
+				LabelKeyName:                addon.Name,
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	maps.Copy(data, renderedChart.AsSecretData())
+	return data, nil
+}
+
+// ManagedResourceName returns the name of the ManagedResource for the managed addon with the given name.
+func ManagedResourceName(addonName string) string {
+	return ManagedResourceNamePrefix + addonName
+}
+
+// Namespace returns the name of the namespace in the shoot cluster the managed addon with the given name is deployed
+// to.
+func Namespace(addonName string) string {
+	return NamespacePrefix + addonName
+}
@@ -0,0 +1,123 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package managedaddons_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"helm.sh/helm/v3/pkg/releaseutil"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/yaml"
+
+	gardencorev1 "github.com/gardener/gardener/pkg/apis/core/v1"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/chartrenderer"
+	mockchartrenderer "github.com/gardener/gardener/pkg/chartrenderer/mock"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/component/shoot/managedaddons"
+	"github.com/gardener/gardener/pkg/utils/managedresources"
+	fakeoci "github.com/gardener/gardener/pkg/utils/oci/fake"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("ManagedAddons", func() {
+	var (
+		ctx       = context.Background()
+		namespace = "shoot--foo--bar"
+
+		ctrl          *gomock.Controller
+		fakeClient    client.Client
+		chartRenderer *mockchartrenderer.MockInterface
+		registry      *fakeoci.Registry
+		chart         *gardencorev1.OCIRepository
+
+		deployer Interface
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		chartRenderer = mockchartrenderer.NewMockInterface(ctrl)
+		registry = fakeoci.NewRegistry()
+		chart = &gardencorev1.OCIRepository{Ref: ptr.To("example.com/charts/cert-manager:v1.16.1")}
+
+		deployer = New(fakeClient, namespace, registry, chartRenderer)
+		deployer.SetAddons([]Addon{{
+			Name:    "cert-manager",
+			Version: "1.16.1",
+			Chart:   chart,
+			Values:  []byte(`{"replicas":2}`),
+		}})
+	})
+
+	Describe("#Deploy", func() {
+		It("should fail if the chart cannot be pulled", func() {
+			Expect(deployer.Deploy(ctx)).To(MatchError(ContainSubstring(`failed pulling Helm chart from OCI repository "example.com/charts/cert-manager:v1.16.1"`)))
+		})
+
+		It("should deploy the addon and delete stale addons", func() {
+			stale := &resourcesv1alpha1.ManagedResource{ObjectMeta: metav1.ObjectMeta{
+				Name:      "shoot-addon-managed-external-dns",
+				Namespace: namespace,
+				Labels:    map[string]string{"gardener.cloud/role": "managed-addon"},
+			}}
+			unrelated := &resourcesv1alpha1.ManagedResource{ObjectMeta: metav1.ObjectMeta{Name: "shoot-core", Namespace: namespace}}
+			Expect(fakeClient.Create(ctx, stale)).To(Succeed())
+			Expect(fakeClient.Create(ctx, unrelated)).To(Succeed())
+
+			manifest := "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: cert-manager-config\n  namespace: addon-cert-manager\n"
+			head := &releaseutil.SimpleHead{}
+			Expect(yaml.Unmarshal([]byte(manifest), head)).To(Succeed())
+
+			registry.AddArtifact(chart, []byte("cert-manager-chart"))
+			chartRenderer.EXPECT().RenderArchive([]byte("cert-manager-chart"), "cert-manager", "addon-cert-manager", map[string]any{"replicas": float64(2)}).Return(&chartrenderer.RenderedChart{
+				ChartName: "cert-manager",
+				Manifests: []releaseutil.Manifest{{Name: "cert-manager/templates/configmap.yaml", Content: manifest, Head: head}},
+			}, nil)
+
+			Expect(deployer.Deploy(ctx)).To(Succeed())
+
+			managedResource := &resourcesv1alpha1.ManagedResource{}
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: "shoot-addon-managed-cert-manager"}, managedResource)).To(Succeed())
+			Expect(managedResource.Labels).To(And(
+				HaveKeyWithValue("gardener.cloud/role", "managed-addon"),
+				HaveKeyWithValue("addon.gardener.cloud/name", "cert-manager"),
+				HaveKeyWithValue("addon.gardener.cloud/version", "1.16.1"),
+				HaveKeyWithValue("origin", "gardener"),
+			))
+
+			objects, err := managedresources.GetObjects(ctx, fakeClient, namespace, managedResource.Name)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(objects).To(ConsistOf(
+				And(BeAssignableToTypeOf(&corev1.Namespace{}), HaveField("Name", "addon-cert-manager"), HaveField("Labels", HaveKeyWithValue("addon.gardener.cloud/name", "cert-manager"))),
+				And(BeAssignableToTypeOf(&corev1.ConfigMap{}), HaveField("Name", "cert-manager-config")),
+			))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(stale), stale)).To(BeNotFoundError())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(unrelated), unrelated)).To(Succeed())
+		})
+	})
+
+	Describe("#Destroy", func() {
+		It("should delete all managed addons", func() {
+			managedResource := &resourcesv1alpha1.ManagedResource{ObjectMeta: metav1.ObjectMeta{
+				Name:      "shoot-addon-managed-cert-manager",
+				Namespace: namespace,
+				Labels:    map[string]string{"gardener.cloud/role": "managed-addon"},
+			}}
+			Expect(fakeClient.Create(ctx, managedResource)).To(Succeed())
+
+			Expect(deployer.Destroy(ctx)).To(Succeed())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(BeNotFoundError())
+		})
+	})
+})
@@ -0,0 +1,122 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gardenletdeployer
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	seedmanagementv1alpha1 "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils"
+	"github.com/gardener/gardener/pkg/utils/oci"
+)
+
+// HelmChartPullOptions computes the options for pulling the gardenlet Helm chart based on the registry settings of the
+// given Gardenlet. The pull secret is read from the namespace of the Gardenlet.
+func HelmChartPullOptions(ctx context.Context, reader client.Reader, gardenlet *seedmanagementv1alpha1.Gardenlet) ([]oci.PullOption, error) {
+	registry := gardenlet.Spec.Deployment.Registry
+	if registry == nil {
+		return nil, nil
+	}
+
+	var opts []oci.PullOption
+
+	if mirrors := registryMirrors(registry); len(mirrors) > 0 {
+		opts = append(opts, oci.WithMirrors(mirrors))
+	}
+
+	if registry.PullSecretRef != nil {
+		secret := &corev1.Secret{}
+		if err := reader.Get(ctx, client.ObjectKey{Namespace: gardenlet.Namespace, Name: registry.PullSecretRef.Name}, secret); err != nil {
+			return nil, fmt.Errorf("failed reading pull secret for gardenlet Helm chart: %w", err)
+		}
+
+		dockerConfigJSON, ok := secret.Data[corev1.DockerConfigJsonKey]
+		if !ok {
+			return nil, fmt.Errorf("pull secret %s does not contain key %q", client.ObjectKeyFromObject(secret), corev1.DockerConfigJsonKey)
+		}
+		opts = append(opts, oci.WithDockerConfigJSON(dockerConfigJSON))
+	}
+
+	if proxy := registry.Proxy; proxy != nil {
+		opts = append(opts, oci.WithProxy(ptr.Deref(proxy.HTTPProxy, ""), ptr.Deref(proxy.HTTPSProxy, ""), ptr.Deref(proxy.NoProxy, "")))
+	}
+
+	return opts, nil
+}
+
+// ApplyRegistrySettingsToChartValues rewrites the gardenlet image repository in the given chart values to the
+// configured mirror and adds the configured proxy settings as environment variables for the gardenlet container.
+func ApplyRegistrySettingsToChartValues(values map[string]any, registry *seedmanagementv1alpha1.GardenletRegistry) (map[string]any, error) {
+	if registry == nil {
+		return values, nil
+	}
+
+	if mirrors := registryMirrors(registry); len(mirrors) > 0 {
+		repository, err := utils.GetFromValuesMap(values, "image", "repository")
+		if err != nil {
+			return nil, err
+		}
+
+		if repository, ok := repository.(string); ok && repository != "" {
+			values, err = utils.SetToValuesMap(values, oci.MirrorReference(repository, mirrors), "image", "repository")
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if proxy := registry.Proxy; proxy != nil {
+		env, err := utils.GetFromValuesMap(values, "env")
+		if err != nil {
+			return nil, err
+		}
+
+		envList, _ := env.([]any)
+		for _, proxyEnv := range []struct {
+			name  string
+			value *string
+		}{
+			{"HTTP_PROXY", proxy.HTTPProxy},
+			{"HTTPS_PROXY", proxy.HTTPSProxy},
+			{"NO_PROXY", proxy.NoProxy},
+		} {
+			if proxyEnv.value == nil || hasEnv(envList, proxyEnv.name) {
+				continue
+			}
+			envList = append(envList, map[string]any{"name": proxyEnv.name, "value": *proxyEnv.value})
+		}
+
+		if len(envList) > 0 {
+			values, err = utils.SetToValuesMap(values, envList, "env")
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return values, nil
+}
+
+func registryMirrors(registry *seedmanagementv1alpha1.GardenletRegistry) map[string]string {
+	mirrors := make(map[string]string, len(registry.Mirrors))
+	for _, mirror := range registry.Mirrors {
+		mirrors[mirror.Source] = mirror.Mirror
+	}
+	return mirrors
+}
+
+func hasEnv(envList []any, name string) bool {
+	for _, env := range envList {
+		if env, ok := env.(map[string]any); ok && env["name"] == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,132 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gardenletdeployer
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	seedmanagementv1alpha1 "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/oci"
+)
+
+var _ = Describe("Registry", func() {
+	var (
+		ctx        = context.Background()
+		fakeClient client.Client
+		gardenlet  *seedmanagementv1alpha1.Gardenlet
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+		gardenlet = &seedmanagementv1alpha1.Gardenlet{ObjectMeta: metav1.ObjectMeta{Name: "seed", Namespace: "garden"}}
+	})
+
+	Describe("#HelmChartPullOptions", func() {
+		It("should return no options if no registry settings are configured", func() {
+			Expect(HelmChartPullOptions(ctx, fakeClient, gardenlet)).To(BeEmpty())
+		})
+
+		It("should return the options for the configured registry settings", func() {
+			Expect(fakeClient.Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "registry-credentials", Namespace: "garden"},
+				Type:       corev1.SecretTypeDockerConfigJson,
+				Data:       map[string][]byte{corev1.DockerConfigJsonKey: []byte(`{"auths":{}}`)},
+			})).To(Succeed())
+
+			gardenlet.Spec.Deployment.Registry = &seedmanagementv1alpha1.GardenletRegistry{
+				Mirrors:       []seedmanagementv1alpha1.RegistryMirror{{Source: "europe-docker.pkg.dev", Mirror: "registry.example.com"}},
+				PullSecretRef: &corev1.LocalObjectReference{Name: "registry-credentials"},
+				Proxy:         &seedmanagementv1alpha1.ProxyConfiguration{HTTPSProxy: ptr.To("http://proxy.example.com:3128")},
+			}
+
+			opts, err := HelmChartPullOptions(ctx, fakeClient, gardenlet)
+			Expect(err).NotTo(HaveOccurred())
+
+			pullOpts := &oci.PullOptions{}
+			for _, opt := range opts {
+				opt(pullOpts)
+			}
+
+			Expect(pullOpts.Mirrors).To(Equal(map[string]string{"europe-docker.pkg.dev": "registry.example.com"}))
+			Expect(pullOpts.DockerConfigJSON).To(Equal([]byte(`{"auths":{}}`)))
+			Expect(pullOpts.Proxy.HTTPSProxy).To(Equal("http://proxy.example.com:3128"))
+			Expect(pullOpts.Proxy.HTTPProxy).To(BeEmpty())
+		})
+
+		It("should fail if the pull secret does not exist", func() {
+			gardenlet.Spec.Deployment.Registry = &seedmanagementv1alpha1.GardenletRegistry{
+				PullSecretRef: &corev1.LocalObjectReference{Name: "registry-credentials"},
+			}
+
+			_, err := HelmChartPullOptions(ctx, fakeClient, gardenlet)
+			Expect(err).To(MatchError(ContainSubstring("failed reading pull secret")))
+		})
+
+		It("should fail if the pull secret does not contain a docker config", func() {
+			Expect(fakeClient.Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "registry-credentials", Namespace: "garden"},
+			})).To(Succeed())
+
+			gardenlet.Spec.Deployment.Registry = &seedmanagementv1alpha1.GardenletRegistry{
+				PullSecretRef: &corev1.LocalObjectReference{Name: "registry-credentials"},
+			}
+
+			_, err := HelmChartPullOptions(ctx, fakeClient, gardenlet)
+			Expect(err).To(MatchError(ContainSubstring(`does not contain key ".dockerconfigjson"`)))
+		})
+	})
+
+	Describe("#ApplyRegistrySettingsToChartValues", func() {
+		var values map[string]any
+
+		BeforeEach(func() {
+			values = map[string]any{
+				"image": map[string]any{
+					"repository": "europe-docker.pkg.dev/gardener-project/releases/gardener/gardenlet",
+					"tag":        "v1.0.0",
+				},
+				"env": []any{
+					map[string]any{"name": "FOO", "value": "bar"},
+					map[string]any{"name": "NO_PROXY", "value": "custom"},
+				},
+			}
+		})
+
+		It("should not change the values if no registry settings are configured", func() {
+			Expect(ApplyRegistrySettingsToChartValues(values, nil)).To(Equal(values))
+		})
+
+		It("should rewrite the image and add the proxy environment variables", func() {
+			Expect(ApplyRegistrySettingsToChartValues(values, &seedmanagementv1alpha1.GardenletRegistry{
+				Mirrors: []seedmanagementv1alpha1.RegistryMirror{{Source: "europe-docker.pkg.dev", Mirror: "registry.example.com/mirror"}},
+				Proxy: &seedmanagementv1alpha1.ProxyConfiguration{
+					HTTPProxy:  ptr.To("http://proxy.example.com:3128"),
+					HTTPSProxy: ptr.To("http://proxy.example.com:3128"),
+					NoProxy:    ptr.To("10.0.0.0/8"),
+				},
+			})).To(Equal(map[string]any{
+				"image": map[string]any{
+					"repository": "registry.example.com/mirror/gardener-project/releases/gardener/gardenlet",
+					"tag":        "v1.0.0",
+				},
+				"env": []any{
+					map[string]any{"name": "FOO", "value": "bar"},
+					map[string]any{"name": "NO_PROXY", "value": "custom"},
+					map[string]any{"name": "HTTP_PROXY", "value": "http://proxy.example.com:3128"},
+					map[string]any{"name": "HTTPS_PROXY", "value": "http://proxy.example.com:3128"},
+				},
+			}))
+		})
+	})
+})
@@ -69,10 +69,18 @@ type ControllerManagerControllerConfiguration struct {
 	SeedExtensionsCheck *SeedExtensionsCheckControllerConfiguration
 	// SeedBackupBucketsCheck defines the configuration of the SeedBackupBucketsCheck controller.
 	SeedBackupBucketsCheck *SeedBackupBucketsCheckControllerConfiguration
+	// SeedBackupEntriesCheck defines the configuration of the SeedBackupEntriesCheck controller.
+	SeedBackupEntriesCheck *SeedBackupEntriesCheckControllerConfiguration
+	// SeedIngressDomainMigration defines the configuration of the SeedIngressDomainMigration controller.
+	SeedIngressDomainMigration *SeedIngressDomainMigrationControllerConfiguration
 	// ShootMaintenance defines the configuration of the ShootMaintenance controller.
 	ShootMaintenance ShootMaintenanceControllerConfiguration
+	// ShootPendingMaintenance defines the configuration of the ShootPendingMaintenance controller.
+	ShootPendingMaintenance *ShootPendingMaintenanceControllerConfiguration
 	// ShootQuota defines the configuration of the ShootQuota controller.
 	ShootQuota *ShootQuotaControllerConfiguration
+	// ShootExpiration defines the configuration of the ShootExpiration controller.
+	ShootExpiration *ShootExpirationControllerConfiguration
 	// ShootHibernation defines the configuration of the ShootHibernation controller.
 	ShootHibernation ShootHibernationControllerConfiguration
 	// ShootReference defines the configuration of the ShootReference controller. If unspecified, it is defaulted with `concurrentSyncs=5`.
@@ -83,6 +91,10 @@ type ControllerManagerControllerConfiguration struct {
 	ShootConditions *ShootConditionsControllerConfiguration
 	// ShootStatusLabel defines the configuration of the ShootStatusLabel controller.
 	ShootStatusLabel *ShootStatusLabelControllerConfiguration
+	// ShootMigration defines the configuration of the ShootMigration controller.
+	ShootMigration *ShootMigrationControllerConfiguration
+	// ShootOperationBatch defines the configuration of the ShootOperationBatch controller.
+	ShootOperationBatch *ShootOperationBatchControllerConfiguration
 	// ManagedSeedSet defines the configuration of the ManagedSeedSet controller.
 	ManagedSeedSet *ManagedSeedSetControllerConfiguration
 }
@@ -252,6 +264,30 @@ type SeedBackupBucketsCheckControllerConfiguration struct {
 	ConditionThresholds []ConditionThreshold
 }
 
+// SeedBackupEntriesCheckControllerConfiguration defines the configuration of the
+// SeedBackupEntriesCheck controller.
+type SeedBackupEntriesCheckControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	ConcurrentSyncs *int
+	// SyncPeriod is the duration how often the existing resources are reconciled (how
+	// often the check for orphaned BackupEntries is performed).
+	SyncPeriod *metav1.Duration
+}
+
+// SeedIngressDomainMigrationControllerConfiguration defines the configuration of the
+// SeedIngressDomainMigration controller.
+type SeedIngressDomainMigrationControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	ConcurrentSyncs *int
+	// SyncPeriod is the duration how often the progress of running ingress domain migrations is checked.
+	SyncPeriod *metav1.Duration
+	// BatchSize is the maximum number of shoots per seed which are reconciled concurrently for migrating them to
+	// the changed ingress domain.
+	BatchSize *int
+}
+
 // ShootMaintenanceControllerConfiguration defines the configuration of the
 // ShootMaintenance controller.
 type ShootMaintenanceControllerConfiguration struct {
@@ -264,6 +300,16 @@ type ShootMaintenanceControllerConfiguration struct {
 	EnableShootCoreAddonRestarter *bool
 }
 
+// ShootPendingMaintenanceControllerConfiguration defines the configuration of the
+// ShootPendingMaintenance controller.
+type ShootPendingMaintenanceControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	ConcurrentSyncs *int
+	// SyncPeriod is the duration how often the pending maintenance operations of shoots are computed.
+	SyncPeriod *metav1.Duration
+}
+
 // ShootQuotaControllerConfiguration defines the configuration of the
 // ShootQuota controller.
 type ShootQuotaControllerConfiguration struct {
@@ -275,6 +321,16 @@ type ShootQuotaControllerConfiguration struct {
 	SyncPeriod *metav1.Duration
 }
 
+// ShootExpirationControllerConfiguration defines the configuration of the
+// ShootExpiration controller.
+type ShootExpirationControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	ConcurrentSyncs *int
+	// WarningPeriod is the duration before the expiration timestamp of a Shoot in which warning events are emitted.
+	WarningPeriod *metav1.Duration
+}
+
 // ShootHibernationControllerConfiguration defines the configuration of the
 // ShootHibernation controller.
 type ShootHibernationControllerConfiguration struct {
@@ -324,6 +380,22 @@ type ShootStatusLabelControllerConfiguration struct {
 	ConcurrentSyncs *int
 }
 
+// ShootMigrationControllerConfiguration defines the configuration of the
+// ShootMigration controller.
+type ShootMigrationControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events. If set to 0, the controller is disabled.
+	ConcurrentSyncs *int
+}
+
+// ShootOperationBatchControllerConfiguration defines the configuration of the
+// ShootOperationBatch controller.
+type ShootOperationBatchControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	ConcurrentSyncs *int
+}
+
 // ManagedSeedSetControllerConfiguration defines the configuration of the
 // ManagedSeedSet controller.
 type ManagedSeedSetControllerConfiguration struct {
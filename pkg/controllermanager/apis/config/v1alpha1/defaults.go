@@ -220,6 +220,29 @@ func SetDefaults_SeedBackupBucketsCheckControllerConfiguration(obj *SeedBackupBu
 	}
 }
 
+// SetDefaults_SeedBackupEntriesCheckControllerConfiguration sets defaults for the SeedBackupEntriesCheckControllerConfiguration.
+func SetDefaults_SeedBackupEntriesCheckControllerConfiguration(obj *SeedBackupEntriesCheckControllerConfiguration) {
+	if obj.ConcurrentSyncs == nil {
+		obj.ConcurrentSyncs = ptr.To(DefaultControllerConcurrentSyncs)
+	}
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{Duration: 10 * time.Minute}
+	}
+}
+
+// SetDefaults_SeedIngressDomainMigrationControllerConfiguration sets defaults for the SeedIngressDomainMigrationControllerConfiguration.
+func SetDefaults_SeedIngressDomainMigrationControllerConfiguration(obj *SeedIngressDomainMigrationControllerConfiguration) {
+	if obj.ConcurrentSyncs == nil {
+		obj.ConcurrentSyncs = ptr.To(DefaultControllerConcurrentSyncs)
+	}
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{Duration: 30 * time.Second}
+	}
+	if obj.BatchSize == nil {
+		obj.BatchSize = ptr.To(10)
+	}
+}
+
 // SetDefaults_ShootHibernationControllerConfiguration sets defaults for the ShootHibernationControllerConfiguration.
 func SetDefaults_ShootHibernationControllerConfiguration(obj *ShootHibernationControllerConfiguration) {
 	if obj.ConcurrentSyncs == nil {
@@ -240,6 +263,16 @@ func SetDefaults_ShootMaintenanceControllerConfiguration(obj *ShootMaintenanceCo
 	}
 }
 
+// SetDefaults_ShootPendingMaintenanceControllerConfiguration sets defaults for the ShootPendingMaintenanceControllerConfiguration.
+func SetDefaults_ShootPendingMaintenanceControllerConfiguration(obj *ShootPendingMaintenanceControllerConfiguration) {
+	if obj.ConcurrentSyncs == nil {
+		obj.ConcurrentSyncs = ptr.To(DefaultControllerConcurrentSyncs)
+	}
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{Duration: time.Hour}
+	}
+}
+
 // SetDefaults_ShootQuotaControllerConfiguration sets defaults for the ShootQuotaControllerConfiguration.
 func SetDefaults_ShootQuotaControllerConfiguration(obj *ShootQuotaControllerConfiguration) {
 	if obj.ConcurrentSyncs == nil {
@@ -252,6 +285,16 @@ func SetDefaults_ShootQuotaControllerConfiguration(obj *ShootQuotaControllerConf
 	}
 }
 
+// SetDefaults_ShootExpirationControllerConfiguration sets defaults for the ShootExpirationControllerConfiguration.
+func SetDefaults_ShootExpirationControllerConfiguration(obj *ShootExpirationControllerConfiguration) {
+	if obj.ConcurrentSyncs == nil {
+		obj.ConcurrentSyncs = ptr.To(DefaultControllerConcurrentSyncs)
+	}
+	if obj.WarningPeriod == nil {
+		obj.WarningPeriod = &metav1.Duration{Duration: 24 * time.Hour}
+	}
+}
+
 // SetDefaults_ShootReferenceControllerConfiguration sets defaults for the ShootReferenceControllerConfiguration.
 func SetDefaults_ShootReferenceControllerConfiguration(obj *ShootReferenceControllerConfiguration) {
 	if obj.ConcurrentSyncs == nil {
@@ -283,6 +326,20 @@ func SetDefaults_ShootStatusLabelControllerConfiguration(obj *ShootStatusLabelCo
 	}
 }
 
+// SetDefaults_ShootMigrationControllerConfiguration sets defaults for the ShootMigrationControllerConfiguration.
+func SetDefaults_ShootMigrationControllerConfiguration(obj *ShootMigrationControllerConfiguration) {
+	if obj.ConcurrentSyncs == nil {
+		obj.ConcurrentSyncs = ptr.To(DefaultControllerConcurrentSyncs)
+	}
+}
+
+// SetDefaults_ShootOperationBatchControllerConfiguration sets defaults for the ShootOperationBatchControllerConfiguration.
+func SetDefaults_ShootOperationBatchControllerConfiguration(obj *ShootOperationBatchControllerConfiguration) {
+	if obj.ConcurrentSyncs == nil {
+		obj.ConcurrentSyncs = ptr.To(DefaultControllerConcurrentSyncs)
+	}
+}
+
 // SetDefaults_ManagedSeedSetControllerConfiguration sets defaults for the ManagedSeedSetControllerConfiguration.
 func SetDefaults_ManagedSeedSetControllerConfiguration(obj *ManagedSeedSetControllerConfiguration) {
 	if obj.ConcurrentSyncs == nil {
@@ -337,9 +394,21 @@ func SetDefaults_ControllerManagerControllerConfiguration(obj *ControllerManager
 	if obj.SeedBackupBucketsCheck == nil {
 		obj.SeedBackupBucketsCheck = &SeedBackupBucketsCheckControllerConfiguration{}
 	}
+	if obj.SeedBackupEntriesCheck == nil {
+		obj.SeedBackupEntriesCheck = &SeedBackupEntriesCheckControllerConfiguration{}
+	}
+	if obj.SeedIngressDomainMigration == nil {
+		obj.SeedIngressDomainMigration = &SeedIngressDomainMigrationControllerConfiguration{}
+	}
+	if obj.ShootPendingMaintenance == nil {
+		obj.ShootPendingMaintenance = &ShootPendingMaintenanceControllerConfiguration{}
+	}
 	if obj.ShootQuota == nil {
 		obj.ShootQuota = &ShootQuotaControllerConfiguration{}
 	}
+	if obj.ShootExpiration == nil {
+		obj.ShootExpiration = &ShootExpirationControllerConfiguration{}
+	}
 	if obj.ShootReference == nil {
 		obj.ShootReference = &ShootReferenceControllerConfiguration{}
 	}
@@ -352,6 +421,12 @@ func SetDefaults_ControllerManagerControllerConfiguration(obj *ControllerManager
 	if obj.ShootStatusLabel == nil {
 		obj.ShootStatusLabel = &ShootStatusLabelControllerConfiguration{}
 	}
+	if obj.ShootMigration == nil {
+		obj.ShootMigration = &ShootMigrationControllerConfiguration{}
+	}
+	if obj.ShootOperationBatch == nil {
+		obj.ShootOperationBatch = &ShootOperationBatchControllerConfiguration{}
+	}
 
 	if obj.ManagedSeedSet == nil {
 		obj.ManagedSeedSet = &ManagedSeedSetControllerConfiguration{
@@ -550,6 +550,62 @@ var _ = Describe("Defaults", func() {
 		})
 	})
 
+	Describe("SeedBackupEntriesCheckControllerConfiguration defaulting", func() {
+		It("should default SeedBackupEntriesCheckControllerConfiguration correctly", func() {
+			expected := &SeedBackupEntriesCheckControllerConfiguration{
+				ConcurrentSyncs: ptr.To(DefaultControllerConcurrentSyncs),
+				SyncPeriod:      &metav1.Duration{Duration: 10 * time.Minute},
+			}
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.SeedBackupEntriesCheck).To(Equal(expected))
+		})
+
+		It("should not default fields that are set", func() {
+			obj = &ControllerManagerConfiguration{
+				Controllers: ControllerManagerControllerConfiguration{
+					SeedBackupEntriesCheck: &SeedBackupEntriesCheckControllerConfiguration{
+						ConcurrentSyncs: ptr.To(10),
+						SyncPeriod:      &metav1.Duration{Duration: time.Hour},
+					},
+				},
+			}
+			expected := obj.Controllers.SeedBackupEntriesCheck.DeepCopy()
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.SeedBackupEntriesCheck).To(Equal(expected))
+		})
+	})
+
+	Describe("SeedIngressDomainMigrationControllerConfiguration defaulting", func() {
+		It("should default SeedIngressDomainMigrationControllerConfiguration correctly", func() {
+			expected := &SeedIngressDomainMigrationControllerConfiguration{
+				ConcurrentSyncs: ptr.To(DefaultControllerConcurrentSyncs),
+				SyncPeriod:      &metav1.Duration{Duration: 30 * time.Second},
+				BatchSize:       ptr.To(10),
+			}
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.SeedIngressDomainMigration).To(Equal(expected))
+		})
+
+		It("should not default fields that are set", func() {
+			obj = &ControllerManagerConfiguration{
+				Controllers: ControllerManagerControllerConfiguration{
+					SeedIngressDomainMigration: &SeedIngressDomainMigrationControllerConfiguration{
+						ConcurrentSyncs: ptr.To(10),
+						SyncPeriod:      &metav1.Duration{Duration: time.Minute},
+						BatchSize:       ptr.To(5),
+					},
+				},
+			}
+			expected := obj.Controllers.SeedIngressDomainMigration.DeepCopy()
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.SeedIngressDomainMigration).To(Equal(expected))
+		})
+	})
+
 	Describe("ShootHibernationControllerConfiguration defaulting", func() {
 		It("should default ShootHibernationControllerConfiguration correctly", func() {
 			expected := &ShootHibernationControllerConfiguration{
@@ -604,6 +660,33 @@ var _ = Describe("Defaults", func() {
 		})
 	})
 
+	Describe("ShootPendingMaintenanceControllerConfiguration defaulting", func() {
+		It("should default ShootPendingMaintenanceControllerConfiguration correctly", func() {
+			expected := &ShootPendingMaintenanceControllerConfiguration{
+				ConcurrentSyncs: ptr.To(DefaultControllerConcurrentSyncs),
+				SyncPeriod:      &metav1.Duration{Duration: time.Hour},
+			}
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.ShootPendingMaintenance).To(Equal(expected))
+		})
+
+		It("should not default fields that are set", func() {
+			obj = &ControllerManagerConfiguration{
+				Controllers: ControllerManagerControllerConfiguration{
+					ShootPendingMaintenance: &ShootPendingMaintenanceControllerConfiguration{
+						ConcurrentSyncs: ptr.To(10),
+						SyncPeriod:      &metav1.Duration{Duration: 10 * time.Minute},
+					},
+				},
+			}
+			expected := obj.Controllers.ShootPendingMaintenance.DeepCopy()
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.ShootPendingMaintenance).To(Equal(expected))
+		})
+	})
+
 	Describe("ShootQuotaControllerConfiguration defaulting", func() {
 		It("should default ShootQuotaControllerConfiguration correctly", func() {
 			expected := &ShootQuotaControllerConfiguration{
@@ -635,6 +718,33 @@ var _ = Describe("Defaults", func() {
 		})
 	})
 
+	Describe("ShootExpirationControllerConfiguration defaulting", func() {
+		It("should default ShootExpirationControllerConfiguration correctly", func() {
+			expected := &ShootExpirationControllerConfiguration{
+				ConcurrentSyncs: ptr.To(DefaultControllerConcurrentSyncs),
+				WarningPeriod:   &metav1.Duration{Duration: 24 * time.Hour},
+			}
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.ShootExpiration).To(Equal(expected))
+		})
+
+		It("should not default fields that are set", func() {
+			obj = &ControllerManagerConfiguration{
+				Controllers: ControllerManagerControllerConfiguration{
+					ShootExpiration: &ShootExpirationControllerConfiguration{
+						ConcurrentSyncs: ptr.To(10),
+						WarningPeriod:   &metav1.Duration{Duration: time.Hour},
+					},
+				},
+			}
+			expected := obj.Controllers.ShootExpiration.DeepCopy()
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.ShootExpiration).To(Equal(expected))
+		})
+	})
+
 	Describe("ShootReferenceControllerConfiguration defaulting", func() {
 		It("should default ShootReferenceControllerConfiguration correctly", func() {
 			expected := &ShootReferenceControllerConfiguration{
@@ -749,6 +859,56 @@ var _ = Describe("Defaults", func() {
 		})
 	})
 
+	Describe("ShootMigrationControllerConfiguration defaulting", func() {
+		It("should default ShootMigrationControllerConfiguration correctly", func() {
+			expected := &ShootMigrationControllerConfiguration{
+				ConcurrentSyncs: ptr.To(DefaultControllerConcurrentSyncs),
+			}
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.ShootMigration).To(Equal(expected))
+		})
+
+		It("should not default fields that are set", func() {
+			obj = &ControllerManagerConfiguration{
+				Controllers: ControllerManagerControllerConfiguration{
+					ShootMigration: &ShootMigrationControllerConfiguration{
+						ConcurrentSyncs: ptr.To(0),
+					},
+				},
+			}
+			expected := obj.Controllers.ShootMigration.DeepCopy()
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.ShootMigration).To(Equal(expected))
+		})
+	})
+
+	Describe("ShootOperationBatchControllerConfiguration defaulting", func() {
+		It("should default ShootOperationBatchControllerConfiguration correctly", func() {
+			expected := &ShootOperationBatchControllerConfiguration{
+				ConcurrentSyncs: ptr.To(DefaultControllerConcurrentSyncs),
+			}
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.ShootOperationBatch).To(Equal(expected))
+		})
+
+		It("should not default fields that are set", func() {
+			obj = &ControllerManagerConfiguration{
+				Controllers: ControllerManagerControllerConfiguration{
+					ShootOperationBatch: &ShootOperationBatchControllerConfiguration{
+						ConcurrentSyncs: ptr.To(10),
+					},
+				},
+			}
+			expected := obj.Controllers.ShootOperationBatch.DeepCopy()
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.ShootOperationBatch).To(Equal(expected))
+		})
+	})
+
 	Describe("ManagedSeedSetControllerConfiguration defaulting", func() {
 		It("should default ManagedSeedSetControllerConfiguration correctly if nil", func() {
 			expected := &ManagedSeedSetControllerConfiguration{
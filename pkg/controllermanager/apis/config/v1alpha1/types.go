@@ -87,11 +87,23 @@ type ControllerManagerControllerConfiguration struct {
 	// SeedBackupBucketsCheck defines the configuration of the SeedBackupBucketsCheck controller.
 	// +optional
 	SeedBackupBucketsCheck *SeedBackupBucketsCheckControllerConfiguration `json:"seedBackupBucketsCheck,omitempty"`
+	// SeedBackupEntriesCheck defines the configuration of the SeedBackupEntriesCheck controller.
+	// +optional
+	SeedBackupEntriesCheck *SeedBackupEntriesCheckControllerConfiguration `json:"seedBackupEntriesCheck,omitempty"`
+	// SeedIngressDomainMigration defines the configuration of the SeedIngressDomainMigration controller.
+	// +optional
+	SeedIngressDomainMigration *SeedIngressDomainMigrationControllerConfiguration `json:"seedIngressDomainMigration,omitempty"`
 	// ShootMaintenance defines the configuration of the ShootMaintenance controller.
 	ShootMaintenance ShootMaintenanceControllerConfiguration `json:"shootMaintenance"`
+	// ShootPendingMaintenance defines the configuration of the ShootPendingMaintenance controller.
+	// +optional
+	ShootPendingMaintenance *ShootPendingMaintenanceControllerConfiguration `json:"shootPendingMaintenance,omitempty"`
 	// ShootQuota defines the configuration of the ShootQuota controller.
 	// +optional
 	ShootQuota *ShootQuotaControllerConfiguration `json:"shootQuota,omitempty"`
+	// ShootExpiration defines the configuration of the ShootExpiration controller.
+	// +optional
+	ShootExpiration *ShootExpirationControllerConfiguration `json:"shootExpiration,omitempty"`
 	// ShootHibernation defines the configuration of the ShootHibernation controller.
 	ShootHibernation ShootHibernationControllerConfiguration `json:"shootHibernation"`
 	// ShootReference defines the configuration of the ShootReference controller. If unspecified, it is defaulted with `concurrentSyncs=5`.
@@ -106,6 +118,13 @@ type ControllerManagerControllerConfiguration struct {
 	// ShootStatusLabel defines the configuration of the ShootStatusLabel controller.
 	// +optional
 	ShootStatusLabel *ShootStatusLabelControllerConfiguration `json:"shootStatusLabel,omitempty"`
+	// ShootMigration defines the configuration of the ShootMigration controller. If unspecified, it is defaulted with
+	// `concurrentSyncs=5`.
+	// +optional
+	ShootMigration *ShootMigrationControllerConfiguration `json:"shootMigration,omitempty"`
+	// ShootOperationBatch defines the configuration of the ShootOperationBatch controller.
+	// +optional
+	ShootOperationBatch *ShootOperationBatchControllerConfiguration `json:"shootOperationBatch,omitempty"`
 	// ManagedSeedSet defines the configuration of the ManagedSeedSet controller.
 	// +optional
 	ManagedSeedSet *ManagedSeedSetControllerConfiguration `json:"managedSeedSet,omitempty"`
@@ -306,6 +325,35 @@ type SeedBackupBucketsCheckControllerConfiguration struct {
 	ConditionThresholds []ConditionThreshold `json:"conditionThresholds,omitempty"`
 }
 
+// SeedBackupEntriesCheckControllerConfiguration defines the configuration of the SeedBackupEntriesCheck
+// controller.
+type SeedBackupEntriesCheckControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	// +optional
+	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+	// SyncPeriod is the duration how often the existing resources are reconciled (how
+	// often the check for orphaned BackupEntries is performed).
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+}
+
+// SeedIngressDomainMigrationControllerConfiguration defines the configuration of the SeedIngressDomainMigration
+// controller.
+type SeedIngressDomainMigrationControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	// +optional
+	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+	// SyncPeriod is the duration how often the progress of running ingress domain migrations is checked.
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+	// BatchSize is the maximum number of shoots per seed which are reconciled concurrently for migrating them to
+	// the changed ingress domain.
+	// +optional
+	BatchSize *int `json:"batchSize,omitempty"`
+}
+
 // ShootMaintenanceControllerConfiguration defines the configuration of the
 // ShootMaintenance controller.
 type ShootMaintenanceControllerConfiguration struct {
@@ -321,6 +369,18 @@ type ShootMaintenanceControllerConfiguration struct {
 	EnableShootCoreAddonRestarter *bool `json:"enableShootCoreAddonRestarter"`
 }
 
+// ShootPendingMaintenanceControllerConfiguration defines the configuration of the
+// ShootPendingMaintenance controller.
+type ShootPendingMaintenanceControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	// +optional
+	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+	// SyncPeriod is the duration how often the pending maintenance operations of shoots are computed.
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+}
+
 // ShootQuotaControllerConfiguration defines the configuration of the
 // ShootQuota controller.
 type ShootQuotaControllerConfiguration struct {
@@ -334,6 +394,19 @@ type ShootQuotaControllerConfiguration struct {
 	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
 }
 
+// ShootExpirationControllerConfiguration defines the configuration of the
+// ShootExpiration controller.
+type ShootExpirationControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	// +optional
+	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+	// WarningPeriod is the duration before the expiration timestamp of a Shoot in which warning events are emitted
+	// (defaults to '24h').
+	// +optional
+	WarningPeriod *metav1.Duration `json:"warningPeriod,omitempty"`
+}
+
 // ShootHibernationControllerConfiguration defines the configuration of the
 // ShootHibernation controller.
 type ShootHibernationControllerConfiguration struct {
@@ -392,6 +465,24 @@ type ShootStatusLabelControllerConfiguration struct {
 	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
 }
 
+// ShootMigrationControllerConfiguration defines the configuration of the
+// ShootMigration controller.
+type ShootMigrationControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events. If set to 0, the controller is disabled.
+	// +optional
+	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+}
+
+// ShootOperationBatchControllerConfiguration defines the configuration of the
+// ShootOperationBatch controller.
+type ShootOperationBatchControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	// +optional
+	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+}
+
 // ManagedSeedSetControllerConfiguration defines the configuration of the
 // ManagedSeedSet controller.
 type ManagedSeedSetControllerConfiguration struct {
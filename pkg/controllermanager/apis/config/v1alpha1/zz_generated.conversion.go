@@ -202,6 +202,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SeedBackupEntriesCheckControllerConfiguration)(nil), (*config.SeedBackupEntriesCheckControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SeedBackupEntriesCheckControllerConfiguration_To_config_SeedBackupEntriesCheckControllerConfiguration(a.(*SeedBackupEntriesCheckControllerConfiguration), b.(*config.SeedBackupEntriesCheckControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.SeedBackupEntriesCheckControllerConfiguration)(nil), (*SeedBackupEntriesCheckControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_SeedBackupEntriesCheckControllerConfiguration_To_v1alpha1_SeedBackupEntriesCheckControllerConfiguration(a.(*config.SeedBackupEntriesCheckControllerConfiguration), b.(*SeedBackupEntriesCheckControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SeedControllerConfiguration)(nil), (*config.SeedControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_SeedControllerConfiguration_To_config_SeedControllerConfiguration(a.(*SeedControllerConfiguration), b.(*config.SeedControllerConfiguration), scope)
 	}); err != nil {
@@ -222,6 +232,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SeedIngressDomainMigrationControllerConfiguration)(nil), (*config.SeedIngressDomainMigrationControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SeedIngressDomainMigrationControllerConfiguration_To_config_SeedIngressDomainMigrationControllerConfiguration(a.(*SeedIngressDomainMigrationControllerConfiguration), b.(*config.SeedIngressDomainMigrationControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.SeedIngressDomainMigrationControllerConfiguration)(nil), (*SeedIngressDomainMigrationControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_SeedIngressDomainMigrationControllerConfiguration_To_v1alpha1_SeedIngressDomainMigrationControllerConfiguration(a.(*config.SeedIngressDomainMigrationControllerConfiguration), b.(*SeedIngressDomainMigrationControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Server)(nil), (*config.Server)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Server_To_config_Server(a.(*Server), b.(*config.Server), scope)
 	}); err != nil {
@@ -252,6 +272,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShootExpirationControllerConfiguration)(nil), (*config.ShootExpirationControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootExpirationControllerConfiguration_To_config_ShootExpirationControllerConfiguration(a.(*ShootExpirationControllerConfiguration), b.(*config.ShootExpirationControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ShootExpirationControllerConfiguration)(nil), (*ShootExpirationControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ShootExpirationControllerConfiguration_To_v1alpha1_ShootExpirationControllerConfiguration(a.(*config.ShootExpirationControllerConfiguration), b.(*ShootExpirationControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ShootHibernationControllerConfiguration)(nil), (*config.ShootHibernationControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ShootHibernationControllerConfiguration_To_config_ShootHibernationControllerConfiguration(a.(*ShootHibernationControllerConfiguration), b.(*config.ShootHibernationControllerConfiguration), scope)
 	}); err != nil {
@@ -272,6 +302,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShootMigrationControllerConfiguration)(nil), (*config.ShootMigrationControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootMigrationControllerConfiguration_To_config_ShootMigrationControllerConfiguration(a.(*ShootMigrationControllerConfiguration), b.(*config.ShootMigrationControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ShootMigrationControllerConfiguration)(nil), (*ShootMigrationControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ShootMigrationControllerConfiguration_To_v1alpha1_ShootMigrationControllerConfiguration(a.(*config.ShootMigrationControllerConfiguration), b.(*ShootMigrationControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootOperationBatchControllerConfiguration)(nil), (*config.ShootOperationBatchControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootOperationBatchControllerConfiguration_To_config_ShootOperationBatchControllerConfiguration(a.(*ShootOperationBatchControllerConfiguration), b.(*config.ShootOperationBatchControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ShootOperationBatchControllerConfiguration)(nil), (*ShootOperationBatchControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ShootOperationBatchControllerConfiguration_To_v1alpha1_ShootOperationBatchControllerConfiguration(a.(*config.ShootOperationBatchControllerConfiguration), b.(*ShootOperationBatchControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ShootPendingMaintenanceControllerConfiguration)(nil), (*config.ShootPendingMaintenanceControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootPendingMaintenanceControllerConfiguration_To_config_ShootPendingMaintenanceControllerConfiguration(a.(*ShootPendingMaintenanceControllerConfiguration), b.(*config.ShootPendingMaintenanceControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ShootPendingMaintenanceControllerConfiguration)(nil), (*ShootPendingMaintenanceControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ShootPendingMaintenanceControllerConfiguration_To_v1alpha1_ShootPendingMaintenanceControllerConfiguration(a.(*config.ShootPendingMaintenanceControllerConfiguration), b.(*ShootPendingMaintenanceControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ShootQuotaControllerConfiguration)(nil), (*config.ShootQuotaControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ShootQuotaControllerConfiguration_To_config_ShootQuotaControllerConfiguration(a.(*ShootQuotaControllerConfiguration), b.(*config.ShootQuotaControllerConfiguration), scope)
 	}); err != nil {
@@ -526,10 +586,14 @@ func autoConvert_v1alpha1_ControllerManagerControllerConfiguration_To_config_Con
 	out.Seed = (*config.SeedControllerConfiguration)(unsafe.Pointer(in.Seed))
 	out.SeedExtensionsCheck = (*config.SeedExtensionsCheckControllerConfiguration)(unsafe.Pointer(in.SeedExtensionsCheck))
 	out.SeedBackupBucketsCheck = (*config.SeedBackupBucketsCheckControllerConfiguration)(unsafe.Pointer(in.SeedBackupBucketsCheck))
+	out.SeedBackupEntriesCheck = (*config.SeedBackupEntriesCheckControllerConfiguration)(unsafe.Pointer(in.SeedBackupEntriesCheck))
+	out.SeedIngressDomainMigration = (*config.SeedIngressDomainMigrationControllerConfiguration)(unsafe.Pointer(in.SeedIngressDomainMigration))
 	if err := Convert_v1alpha1_ShootMaintenanceControllerConfiguration_To_config_ShootMaintenanceControllerConfiguration(&in.ShootMaintenance, &out.ShootMaintenance, s); err != nil {
 		return err
 	}
+	out.ShootPendingMaintenance = (*config.ShootPendingMaintenanceControllerConfiguration)(unsafe.Pointer(in.ShootPendingMaintenance))
 	out.ShootQuota = (*config.ShootQuotaControllerConfiguration)(unsafe.Pointer(in.ShootQuota))
+	out.ShootExpiration = (*config.ShootExpirationControllerConfiguration)(unsafe.Pointer(in.ShootExpiration))
 	if err := Convert_v1alpha1_ShootHibernationControllerConfiguration_To_config_ShootHibernationControllerConfiguration(&in.ShootHibernation, &out.ShootHibernation, s); err != nil {
 		return err
 	}
@@ -537,6 +601,8 @@ func autoConvert_v1alpha1_ControllerManagerControllerConfiguration_To_config_Con
 	out.ShootRetry = (*config.ShootRetryControllerConfiguration)(unsafe.Pointer(in.ShootRetry))
 	out.ShootConditions = (*config.ShootConditionsControllerConfiguration)(unsafe.Pointer(in.ShootConditions))
 	out.ShootStatusLabel = (*config.ShootStatusLabelControllerConfiguration)(unsafe.Pointer(in.ShootStatusLabel))
+	out.ShootMigration = (*config.ShootMigrationControllerConfiguration)(unsafe.Pointer(in.ShootMigration))
+	out.ShootOperationBatch = (*config.ShootOperationBatchControllerConfiguration)(unsafe.Pointer(in.ShootOperationBatch))
 	out.ManagedSeedSet = (*config.ManagedSeedSetControllerConfiguration)(unsafe.Pointer(in.ManagedSeedSet))
 	return nil
 }
@@ -570,10 +636,14 @@ func autoConvert_config_ControllerManagerControllerConfiguration_To_v1alpha1_Con
 	out.Seed = (*SeedControllerConfiguration)(unsafe.Pointer(in.Seed))
 	out.SeedExtensionsCheck = (*SeedExtensionsCheckControllerConfiguration)(unsafe.Pointer(in.SeedExtensionsCheck))
 	out.SeedBackupBucketsCheck = (*SeedBackupBucketsCheckControllerConfiguration)(unsafe.Pointer(in.SeedBackupBucketsCheck))
+	out.SeedBackupEntriesCheck = (*SeedBackupEntriesCheckControllerConfiguration)(unsafe.Pointer(in.SeedBackupEntriesCheck))
+	out.SeedIngressDomainMigration = (*SeedIngressDomainMigrationControllerConfiguration)(unsafe.Pointer(in.SeedIngressDomainMigration))
 	if err := Convert_config_ShootMaintenanceControllerConfiguration_To_v1alpha1_ShootMaintenanceControllerConfiguration(&in.ShootMaintenance, &out.ShootMaintenance, s); err != nil {
 		return err
 	}
+	out.ShootPendingMaintenance = (*ShootPendingMaintenanceControllerConfiguration)(unsafe.Pointer(in.ShootPendingMaintenance))
 	out.ShootQuota = (*ShootQuotaControllerConfiguration)(unsafe.Pointer(in.ShootQuota))
+	out.ShootExpiration = (*ShootExpirationControllerConfiguration)(unsafe.Pointer(in.ShootExpiration))
 	if err := Convert_config_ShootHibernationControllerConfiguration_To_v1alpha1_ShootHibernationControllerConfiguration(&in.ShootHibernation, &out.ShootHibernation, s); err != nil {
 		return err
 	}
@@ -581,6 +651,8 @@ func autoConvert_config_ControllerManagerControllerConfiguration_To_v1alpha1_Con
 	out.ShootRetry = (*ShootRetryControllerConfiguration)(unsafe.Pointer(in.ShootRetry))
 	out.ShootConditions = (*ShootConditionsControllerConfiguration)(unsafe.Pointer(in.ShootConditions))
 	out.ShootStatusLabel = (*ShootStatusLabelControllerConfiguration)(unsafe.Pointer(in.ShootStatusLabel))
+	out.ShootMigration = (*ShootMigrationControllerConfiguration)(unsafe.Pointer(in.ShootMigration))
+	out.ShootOperationBatch = (*ShootOperationBatchControllerConfiguration)(unsafe.Pointer(in.ShootOperationBatch))
 	out.ManagedSeedSet = (*ManagedSeedSetControllerConfiguration)(unsafe.Pointer(in.ManagedSeedSet))
 	return nil
 }
@@ -851,6 +923,28 @@ func Convert_config_SeedBackupBucketsCheckControllerConfiguration_To_v1alpha1_Se
 	return autoConvert_config_SeedBackupBucketsCheckControllerConfiguration_To_v1alpha1_SeedBackupBucketsCheckControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_SeedBackupEntriesCheckControllerConfiguration_To_config_SeedBackupEntriesCheckControllerConfiguration(in *SeedBackupEntriesCheckControllerConfiguration, out *config.SeedBackupEntriesCheckControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	return nil
+}
+
+// Convert_v1alpha1_SeedBackupEntriesCheckControllerConfiguration_To_config_SeedBackupEntriesCheckControllerConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_SeedBackupEntriesCheckControllerConfiguration_To_config_SeedBackupEntriesCheckControllerConfiguration(in *SeedBackupEntriesCheckControllerConfiguration, out *config.SeedBackupEntriesCheckControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SeedBackupEntriesCheckControllerConfiguration_To_config_SeedBackupEntriesCheckControllerConfiguration(in, out, s)
+}
+
+func autoConvert_config_SeedBackupEntriesCheckControllerConfiguration_To_v1alpha1_SeedBackupEntriesCheckControllerConfiguration(in *config.SeedBackupEntriesCheckControllerConfiguration, out *SeedBackupEntriesCheckControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	return nil
+}
+
+// Convert_config_SeedBackupEntriesCheckControllerConfiguration_To_v1alpha1_SeedBackupEntriesCheckControllerConfiguration is an autogenerated conversion function.
+func Convert_config_SeedBackupEntriesCheckControllerConfiguration_To_v1alpha1_SeedBackupEntriesCheckControllerConfiguration(in *config.SeedBackupEntriesCheckControllerConfiguration, out *SeedBackupEntriesCheckControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_config_SeedBackupEntriesCheckControllerConfiguration_To_v1alpha1_SeedBackupEntriesCheckControllerConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_SeedControllerConfiguration_To_config_SeedControllerConfiguration(in *SeedControllerConfiguration, out *config.SeedControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
 	out.MonitorPeriod = (*v1.Duration)(unsafe.Pointer(in.MonitorPeriod))
@@ -901,6 +995,30 @@ func Convert_config_SeedExtensionsCheckControllerConfiguration_To_v1alpha1_SeedE
 	return autoConvert_config_SeedExtensionsCheckControllerConfiguration_To_v1alpha1_SeedExtensionsCheckControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_SeedIngressDomainMigrationControllerConfiguration_To_config_SeedIngressDomainMigrationControllerConfiguration(in *SeedIngressDomainMigrationControllerConfiguration, out *config.SeedIngressDomainMigrationControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.BatchSize = (*int)(unsafe.Pointer(in.BatchSize))
+	return nil
+}
+
+// Convert_v1alpha1_SeedIngressDomainMigrationControllerConfiguration_To_config_SeedIngressDomainMigrationControllerConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_SeedIngressDomainMigrationControllerConfiguration_To_config_SeedIngressDomainMigrationControllerConfiguration(in *SeedIngressDomainMigrationControllerConfiguration, out *config.SeedIngressDomainMigrationControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SeedIngressDomainMigrationControllerConfiguration_To_config_SeedIngressDomainMigrationControllerConfiguration(in, out, s)
+}
+
+func autoConvert_config_SeedIngressDomainMigrationControllerConfiguration_To_v1alpha1_SeedIngressDomainMigrationControllerConfiguration(in *config.SeedIngressDomainMigrationControllerConfiguration, out *SeedIngressDomainMigrationControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.BatchSize = (*int)(unsafe.Pointer(in.BatchSize))
+	return nil
+}
+
+// Convert_config_SeedIngressDomainMigrationControllerConfiguration_To_v1alpha1_SeedIngressDomainMigrationControllerConfiguration is an autogenerated conversion function.
+func Convert_config_SeedIngressDomainMigrationControllerConfiguration_To_v1alpha1_SeedIngressDomainMigrationControllerConfiguration(in *config.SeedIngressDomainMigrationControllerConfiguration, out *SeedIngressDomainMigrationControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_config_SeedIngressDomainMigrationControllerConfiguration_To_v1alpha1_SeedIngressDomainMigrationControllerConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_Server_To_config_Server(in *Server, out *config.Server, s conversion.Scope) error {
 	out.BindAddress = in.BindAddress
 	out.Port = in.Port
@@ -965,6 +1083,28 @@ func Convert_config_ShootConditionsControllerConfiguration_To_v1alpha1_ShootCond
 	return autoConvert_config_ShootConditionsControllerConfiguration_To_v1alpha1_ShootConditionsControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_ShootExpirationControllerConfiguration_To_config_ShootExpirationControllerConfiguration(in *ShootExpirationControllerConfiguration, out *config.ShootExpirationControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	out.WarningPeriod = (*v1.Duration)(unsafe.Pointer(in.WarningPeriod))
+	return nil
+}
+
+// Convert_v1alpha1_ShootExpirationControllerConfiguration_To_config_ShootExpirationControllerConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_ShootExpirationControllerConfiguration_To_config_ShootExpirationControllerConfiguration(in *ShootExpirationControllerConfiguration, out *config.ShootExpirationControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootExpirationControllerConfiguration_To_config_ShootExpirationControllerConfiguration(in, out, s)
+}
+
+func autoConvert_config_ShootExpirationControllerConfiguration_To_v1alpha1_ShootExpirationControllerConfiguration(in *config.ShootExpirationControllerConfiguration, out *ShootExpirationControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	out.WarningPeriod = (*v1.Duration)(unsafe.Pointer(in.WarningPeriod))
+	return nil
+}
+
+// Convert_config_ShootExpirationControllerConfiguration_To_v1alpha1_ShootExpirationControllerConfiguration is an autogenerated conversion function.
+func Convert_config_ShootExpirationControllerConfiguration_To_v1alpha1_ShootExpirationControllerConfiguration(in *config.ShootExpirationControllerConfiguration, out *ShootExpirationControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_config_ShootExpirationControllerConfiguration_To_v1alpha1_ShootExpirationControllerConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_ShootHibernationControllerConfiguration_To_config_ShootHibernationControllerConfiguration(in *ShootHibernationControllerConfiguration, out *config.ShootHibernationControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
 	out.TriggerDeadlineDuration = (*v1.Duration)(unsafe.Pointer(in.TriggerDeadlineDuration))
@@ -1011,6 +1151,68 @@ func Convert_config_ShootMaintenanceControllerConfiguration_To_v1alpha1_ShootMai
 	return autoConvert_config_ShootMaintenanceControllerConfiguration_To_v1alpha1_ShootMaintenanceControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_ShootMigrationControllerConfiguration_To_config_ShootMigrationControllerConfiguration(in *ShootMigrationControllerConfiguration, out *config.ShootMigrationControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	return nil
+}
+
+// Convert_v1alpha1_ShootMigrationControllerConfiguration_To_config_ShootMigrationControllerConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_ShootMigrationControllerConfiguration_To_config_ShootMigrationControllerConfiguration(in *ShootMigrationControllerConfiguration, out *config.ShootMigrationControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootMigrationControllerConfiguration_To_config_ShootMigrationControllerConfiguration(in, out, s)
+}
+
+func autoConvert_config_ShootMigrationControllerConfiguration_To_v1alpha1_ShootMigrationControllerConfiguration(in *config.ShootMigrationControllerConfiguration, out *ShootMigrationControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	return nil
+}
+
+// Convert_config_ShootMigrationControllerConfiguration_To_v1alpha1_ShootMigrationControllerConfiguration is an autogenerated conversion function.
+func Convert_config_ShootMigrationControllerConfiguration_To_v1alpha1_ShootMigrationControllerConfiguration(in *config.ShootMigrationControllerConfiguration, out *ShootMigrationControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_config_ShootMigrationControllerConfiguration_To_v1alpha1_ShootMigrationControllerConfiguration(in, out, s)
+}
+
+func autoConvert_v1alpha1_ShootOperationBatchControllerConfiguration_To_config_ShootOperationBatchControllerConfiguration(in *ShootOperationBatchControllerConfiguration, out *config.ShootOperationBatchControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	return nil
+}
+
+// Convert_v1alpha1_ShootOperationBatchControllerConfiguration_To_config_ShootOperationBatchControllerConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_ShootOperationBatchControllerConfiguration_To_config_ShootOperationBatchControllerConfiguration(in *ShootOperationBatchControllerConfiguration, out *config.ShootOperationBatchControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootOperationBatchControllerConfiguration_To_config_ShootOperationBatchControllerConfiguration(in, out, s)
+}
+
+func autoConvert_config_ShootOperationBatchControllerConfiguration_To_v1alpha1_ShootOperationBatchControllerConfiguration(in *config.ShootOperationBatchControllerConfiguration, out *ShootOperationBatchControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	return nil
+}
+
+// Convert_config_ShootOperationBatchControllerConfiguration_To_v1alpha1_ShootOperationBatchControllerConfiguration is an autogenerated conversion function.
+func Convert_config_ShootOperationBatchControllerConfiguration_To_v1alpha1_ShootOperationBatchControllerConfiguration(in *config.ShootOperationBatchControllerConfiguration, out *ShootOperationBatchControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_config_ShootOperationBatchControllerConfiguration_To_v1alpha1_ShootOperationBatchControllerConfiguration(in, out, s)
+}
+
+func autoConvert_v1alpha1_ShootPendingMaintenanceControllerConfiguration_To_config_ShootPendingMaintenanceControllerConfiguration(in *ShootPendingMaintenanceControllerConfiguration, out *config.ShootPendingMaintenanceControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	return nil
+}
+
+// Convert_v1alpha1_ShootPendingMaintenanceControllerConfiguration_To_config_ShootPendingMaintenanceControllerConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_ShootPendingMaintenanceControllerConfiguration_To_config_ShootPendingMaintenanceControllerConfiguration(in *ShootPendingMaintenanceControllerConfiguration, out *config.ShootPendingMaintenanceControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootPendingMaintenanceControllerConfiguration_To_config_ShootPendingMaintenanceControllerConfiguration(in, out, s)
+}
+
+func autoConvert_config_ShootPendingMaintenanceControllerConfiguration_To_v1alpha1_ShootPendingMaintenanceControllerConfiguration(in *config.ShootPendingMaintenanceControllerConfiguration, out *ShootPendingMaintenanceControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	return nil
+}
+
+// Convert_config_ShootPendingMaintenanceControllerConfiguration_To_v1alpha1_ShootPendingMaintenanceControllerConfiguration is an autogenerated conversion function.
+func Convert_config_ShootPendingMaintenanceControllerConfiguration_To_v1alpha1_ShootPendingMaintenanceControllerConfiguration(in *config.ShootPendingMaintenanceControllerConfiguration, out *ShootPendingMaintenanceControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_config_ShootPendingMaintenanceControllerConfiguration_To_v1alpha1_ShootPendingMaintenanceControllerConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_ShootQuotaControllerConfiguration_To_config_ShootQuotaControllerConfiguration(in *ShootQuotaControllerConfiguration, out *config.ShootQuotaControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
 	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
@@ -244,12 +244,32 @@ func (in *ControllerManagerControllerConfiguration) DeepCopyInto(out *Controller
 		*out = new(SeedBackupBucketsCheckControllerConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SeedBackupEntriesCheck != nil {
+		in, out := &in.SeedBackupEntriesCheck, &out.SeedBackupEntriesCheck
+		*out = new(SeedBackupEntriesCheckControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SeedIngressDomainMigration != nil {
+		in, out := &in.SeedIngressDomainMigration, &out.SeedIngressDomainMigration
+		*out = new(SeedIngressDomainMigrationControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	in.ShootMaintenance.DeepCopyInto(&out.ShootMaintenance)
+	if in.ShootPendingMaintenance != nil {
+		in, out := &in.ShootPendingMaintenance, &out.ShootPendingMaintenance
+		*out = new(ShootPendingMaintenanceControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ShootQuota != nil {
 		in, out := &in.ShootQuota, &out.ShootQuota
 		*out = new(ShootQuotaControllerConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ShootExpiration != nil {
+		in, out := &in.ShootExpiration, &out.ShootExpiration
+		*out = new(ShootExpirationControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	in.ShootHibernation.DeepCopyInto(&out.ShootHibernation)
 	if in.ShootReference != nil {
 		in, out := &in.ShootReference, &out.ShootReference
@@ -271,6 +291,16 @@ func (in *ControllerManagerControllerConfiguration) DeepCopyInto(out *Controller
 		*out = new(ShootStatusLabelControllerConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ShootMigration != nil {
+		in, out := &in.ShootMigration, &out.ShootMigration
+		*out = new(ShootMigrationControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ShootOperationBatch != nil {
+		in, out := &in.ShootOperationBatch, &out.ShootOperationBatch
+		*out = new(ShootOperationBatchControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ManagedSeedSet != nil {
 		in, out := &in.ManagedSeedSet, &out.ManagedSeedSet
 		*out = new(ManagedSeedSetControllerConfiguration)
@@ -569,6 +599,32 @@ func (in *SeedBackupBucketsCheckControllerConfiguration) DeepCopy() *SeedBackupB
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedBackupEntriesCheckControllerConfiguration) DeepCopyInto(out *SeedBackupEntriesCheckControllerConfiguration) {
+	*out = *in
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(int)
+		**out = **in
+	}
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedBackupEntriesCheckControllerConfiguration.
+func (in *SeedBackupEntriesCheckControllerConfiguration) DeepCopy() *SeedBackupEntriesCheckControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedBackupEntriesCheckControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SeedControllerConfiguration) DeepCopyInto(out *SeedControllerConfiguration) {
 	*out = *in
@@ -636,6 +692,37 @@ func (in *SeedExtensionsCheckControllerConfiguration) DeepCopy() *SeedExtensions
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedIngressDomainMigrationControllerConfiguration) DeepCopyInto(out *SeedIngressDomainMigrationControllerConfiguration) {
+	*out = *in
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(int)
+		**out = **in
+	}
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.BatchSize != nil {
+		in, out := &in.BatchSize, &out.BatchSize
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedIngressDomainMigrationControllerConfiguration.
+func (in *SeedIngressDomainMigrationControllerConfiguration) DeepCopy() *SeedIngressDomainMigrationControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedIngressDomainMigrationControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Server) DeepCopyInto(out *Server) {
 	*out = *in
@@ -699,6 +786,32 @@ func (in *ShootConditionsControllerConfiguration) DeepCopy() *ShootConditionsCon
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootExpirationControllerConfiguration) DeepCopyInto(out *ShootExpirationControllerConfiguration) {
+	*out = *in
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(int)
+		**out = **in
+	}
+	if in.WarningPeriod != nil {
+		in, out := &in.WarningPeriod, &out.WarningPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootExpirationControllerConfiguration.
+func (in *ShootExpirationControllerConfiguration) DeepCopy() *ShootExpirationControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootExpirationControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootHibernationControllerConfiguration) DeepCopyInto(out *ShootHibernationControllerConfiguration) {
 	*out = *in
@@ -756,6 +869,74 @@ func (in *ShootMaintenanceControllerConfiguration) DeepCopy() *ShootMaintenanceC
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootMigrationControllerConfiguration) DeepCopyInto(out *ShootMigrationControllerConfiguration) {
+	*out = *in
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootMigrationControllerConfiguration.
+func (in *ShootMigrationControllerConfiguration) DeepCopy() *ShootMigrationControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootMigrationControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootOperationBatchControllerConfiguration) DeepCopyInto(out *ShootOperationBatchControllerConfiguration) {
+	*out = *in
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootOperationBatchControllerConfiguration.
+func (in *ShootOperationBatchControllerConfiguration) DeepCopy() *ShootOperationBatchControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootOperationBatchControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootPendingMaintenanceControllerConfiguration) DeepCopyInto(out *ShootPendingMaintenanceControllerConfiguration) {
+	*out = *in
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(int)
+		**out = **in
+	}
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootPendingMaintenanceControllerConfiguration.
+func (in *ShootPendingMaintenanceControllerConfiguration) DeepCopy() *ShootPendingMaintenanceControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootPendingMaintenanceControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootQuotaControllerConfiguration) DeepCopyInto(out *ShootQuotaControllerConfiguration) {
 	*out = *in
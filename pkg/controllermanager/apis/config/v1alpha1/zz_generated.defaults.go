@@ -72,10 +72,22 @@ func SetObjectDefaults_ControllerManagerConfiguration(in *ControllerManagerConfi
 	if in.Controllers.SeedBackupBucketsCheck != nil {
 		SetDefaults_SeedBackupBucketsCheckControllerConfiguration(in.Controllers.SeedBackupBucketsCheck)
 	}
+	if in.Controllers.SeedBackupEntriesCheck != nil {
+		SetDefaults_SeedBackupEntriesCheckControllerConfiguration(in.Controllers.SeedBackupEntriesCheck)
+	}
+	if in.Controllers.SeedIngressDomainMigration != nil {
+		SetDefaults_SeedIngressDomainMigrationControllerConfiguration(in.Controllers.SeedIngressDomainMigration)
+	}
 	SetDefaults_ShootMaintenanceControllerConfiguration(&in.Controllers.ShootMaintenance)
+	if in.Controllers.ShootPendingMaintenance != nil {
+		SetDefaults_ShootPendingMaintenanceControllerConfiguration(in.Controllers.ShootPendingMaintenance)
+	}
 	if in.Controllers.ShootQuota != nil {
 		SetDefaults_ShootQuotaControllerConfiguration(in.Controllers.ShootQuota)
 	}
+	if in.Controllers.ShootExpiration != nil {
+		SetDefaults_ShootExpirationControllerConfiguration(in.Controllers.ShootExpiration)
+	}
 	SetDefaults_ShootHibernationControllerConfiguration(&in.Controllers.ShootHibernation)
 	if in.Controllers.ShootReference != nil {
 		SetDefaults_ShootReferenceControllerConfiguration(in.Controllers.ShootReference)
@@ -89,6 +101,12 @@ func SetObjectDefaults_ControllerManagerConfiguration(in *ControllerManagerConfi
 	if in.Controllers.ShootStatusLabel != nil {
 		SetDefaults_ShootStatusLabelControllerConfiguration(in.Controllers.ShootStatusLabel)
 	}
+	if in.Controllers.ShootMigration != nil {
+		SetDefaults_ShootMigrationControllerConfiguration(in.Controllers.ShootMigration)
+	}
+	if in.Controllers.ShootOperationBatch != nil {
+		SetDefaults_ShootOperationBatchControllerConfiguration(in.Controllers.ShootOperationBatch)
+	}
 	if in.Controllers.ManagedSeedSet != nil {
 		SetDefaults_ManagedSeedSetControllerConfiguration(in.Controllers.ManagedSeedSet)
 	}
@@ -27,6 +27,7 @@ import (
 	"github.com/gardener/gardener/pkg/controllermanager/controller/secretbinding"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/seed"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/shootoperationbatch"
 )
 
 // AddToManager adds all controller-manager controllers to the given manager.
@@ -49,9 +50,7 @@ func AddToManager(ctx context.Context, mgr manager.Manager, cfg *config.Controll
 		return fmt.Errorf("failed adding CertificateSigningRequest controller: %w", err)
 	}
 
-	if err := (&cloudprofile.Reconciler{
-		Config: *cfg.Controllers.CloudProfile,
-	}).AddToManager(mgr); err != nil {
+	if err := cloudprofile.AddToManager(ctx, mgr, *cfg); err != nil {
 		return fmt.Errorf("failed adding CloudProfile controller: %w", err)
 	}
 
@@ -121,5 +120,11 @@ func AddToManager(ctx context.Context, mgr manager.Manager, cfg *config.Controll
 		return fmt.Errorf("failed adding Shoot controller: %w", err)
 	}
 
+	if err := (&shootoperationbatch.Reconciler{
+		Config: *cfg.Controllers.ShootOperationBatch,
+	}).AddToManager(mgr); err != nil {
+		return fmt.Errorf("failed adding ShootOperationBatch controller: %w", err)
+	}
+
 	return nil
 }
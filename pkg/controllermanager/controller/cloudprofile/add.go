@@ -5,32 +5,29 @@
 package cloudprofile
 
 import (
-	"k8s.io/utils/ptr"
-	"sigs.k8s.io/controller-runtime/pkg/builder"
-	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"context"
+	"fmt"
+
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
-	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/cloudprofile/cloudprofile"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/cloudprofile/usage"
 )
 
-// ControllerName is the name of this controller.
-const ControllerName = "cloudprofile"
-
-// AddToManager adds Reconciler to the given manager.
-func (r *Reconciler) AddToManager(mgr manager.Manager) error {
-	if r.Client == nil {
-		r.Client = mgr.GetClient()
+// AddToManager adds all CloudProfile controllers to the given manager.
+func AddToManager(ctx context.Context, mgr manager.Manager, cfg config.ControllerManagerConfiguration) error {
+	if err := (&cloudprofile.Reconciler{
+		Config: *cfg.Controllers.CloudProfile,
+	}).AddToManager(mgr); err != nil {
+		return fmt.Errorf("failed adding main reconciler: %w", err)
 	}
-	if r.Recorder == nil {
-		r.Recorder = mgr.GetEventRecorderFor(ControllerName + "-controller")
+
+	if err := (&usage.Reconciler{
+		Config: *cfg.Controllers.CloudProfile,
+	}).AddToManager(ctx, mgr); err != nil {
+		return fmt.Errorf("failed adding usage reconciler: %w", err)
 	}
 
-	return builder.
-		ControllerManagedBy(mgr).
-		Named(ControllerName).
-		For(&gardencorev1beta1.CloudProfile{}).
-		WithOptions(controller.Options{
-			MaxConcurrentReconciles: ptr.Deref(r.Config.ConcurrentSyncs, 0),
-		}).
-		Complete(r)
+	return nil
 }
@@ -13,5 +13,5 @@ import (
 
 func TestCloudProfile(t *testing.T) {
 	RegisterFailHandler(Fail)
-	RunSpecs(t, "ControllerManager Controller CloudProfile Suite")
+	RunSpecs(t, "ControllerManager Controller CloudProfile Main Suite")
 }
@@ -21,7 +21,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
-	. "github.com/gardener/gardener/pkg/controllermanager/controller/cloudprofile"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/cloudprofile/cloudprofile"
 	mockclient "github.com/gardener/gardener/third_party/mock/controller-runtime/client"
 )
 
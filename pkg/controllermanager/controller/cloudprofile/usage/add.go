@@ -0,0 +1,131 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package usage
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/go-logr/logr"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/controllerutils/mapper"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+// ControllerName is the name of this controller.
+const ControllerName = "cloudprofile-usage"
+
+// AddToManager adds Reconciler to the given manager.
+func (r *Reconciler) AddToManager(ctx context.Context, mgr manager.Manager) error {
+	if r.Client == nil {
+		r.Client = mgr.GetClient()
+	}
+	if r.Clock == nil {
+		r.Clock = clock.RealClock{}
+	}
+
+	c, err := builder.
+		ControllerManagedBy(mgr).
+		Named(ControllerName).
+		For(&gardencorev1beta1.CloudProfile{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: ptr.Deref(r.Config.ConcurrentSyncs, 0),
+		}).
+		Build(r)
+	if err != nil {
+		return err
+	}
+
+	if err := c.Watch(
+		source.Kind[client.Object](mgr.GetCache(),
+			&gardencorev1beta1.Shoot{},
+			mapper.EnqueueRequestsFrom(ctx, mgr.GetCache(), mapper.MapFunc(r.MapShootToCloudProfile), mapper.UpdateWithOldAndNew, c.GetLogger()),
+			r.ShootPredicate(),
+		)); err != nil {
+		return err
+	}
+
+	return c.Watch(
+		source.Kind[client.Object](mgr.GetCache(),
+			&gardencorev1beta1.NamespacedCloudProfile{},
+			mapper.EnqueueRequestsFrom(ctx, mgr.GetCache(), mapper.MapFunc(r.MapNamespacedCloudProfileToCloudProfile), mapper.UpdateWithOldAndNew, c.GetLogger()),
+			predicate.GenerationChangedPredicate{},
+		),
+	)
+}
+
+// ShootPredicate returns true for all events except for 'UPDATE' events. For 'UPDATE' events, it only returns true
+// when the referenced cloud profile or one of the versions in use has changed, or when the shoot is being deleted.
+func (r *Reconciler) ShootPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			shoot, ok := e.ObjectNew.(*gardencorev1beta1.Shoot)
+			if !ok {
+				return false
+			}
+			oldShoot, ok := e.ObjectOld.(*gardencorev1beta1.Shoot)
+			if !ok {
+				return false
+			}
+
+			return !reflect.DeepEqual(gardenerutils.BuildCloudProfileReference(shoot), gardenerutils.BuildCloudProfileReference(oldShoot)) ||
+				shoot.Spec.Kubernetes.Version != oldShoot.Spec.Kubernetes.Version ||
+				!machineImagesOfShoot(shoot).Equal(machineImagesOfShoot(oldShoot)) ||
+				(shoot.DeletionTimestamp != nil) != (oldShoot.DeletionTimestamp != nil)
+		},
+	}
+}
+
+// MapShootToCloudProfile is a mapper.MapFunc for mapping a Shoot to the CloudProfile it references, either directly
+// or via a NamespacedCloudProfile.
+func (r *Reconciler) MapShootToCloudProfile(ctx context.Context, log logr.Logger, reader client.Reader, obj client.Object) []reconcile.Request {
+	shoot, ok := obj.(*gardencorev1beta1.Shoot)
+	if !ok {
+		return nil
+	}
+
+	cloudProfileReference := gardenerutils.BuildCloudProfileReference(shoot)
+	if cloudProfileReference == nil {
+		return nil
+	}
+
+	switch cloudProfileReference.Kind {
+	case v1beta1constants.CloudProfileReferenceKindCloudProfile:
+		return []reconcile.Request{{NamespacedName: client.ObjectKey{Name: cloudProfileReference.Name}}}
+
+	case v1beta1constants.CloudProfileReferenceKindNamespacedCloudProfile:
+		namespacedCloudProfile := &gardencorev1beta1.NamespacedCloudProfile{}
+		if err := reader.Get(ctx, client.ObjectKey{Name: cloudProfileReference.Name, Namespace: shoot.Namespace}, namespacedCloudProfile); err != nil {
+			log.Error(err, "Failed to get NamespacedCloudProfile referenced by Shoot", "namespacedCloudProfileName", cloudProfileReference.Name)
+			return nil
+		}
+		return r.MapNamespacedCloudProfileToCloudProfile(ctx, log, reader, namespacedCloudProfile)
+	}
+
+	return nil
+}
+
+// MapNamespacedCloudProfileToCloudProfile is a mapper.MapFunc for mapping a NamespacedCloudProfile to its parent
+// CloudProfile.
+func (r *Reconciler) MapNamespacedCloudProfileToCloudProfile(_ context.Context, _ logr.Logger, _ client.Reader, obj client.Object) []reconcile.Request {
+	namespacedCloudProfile, ok := obj.(*gardencorev1beta1.NamespacedCloudProfile)
+	if !ok || namespacedCloudProfile.Spec.Parent.Kind != v1beta1constants.CloudProfileReferenceKindCloudProfile {
+		return nil
+	}
+
+	return []reconcile.Request{{NamespacedName: client.ObjectKey{Name: namespacedCloudProfile.Spec.Parent.Name}}}
+}
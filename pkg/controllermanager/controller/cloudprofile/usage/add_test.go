@@ -0,0 +1,129 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package usage_test
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/cloudprofile/usage"
+)
+
+var _ = Describe("Add", func() {
+	var (
+		ctx = context.Background()
+		log = logr.Discard()
+
+		fakeClient client.Client
+		reconciler *Reconciler
+		shoot      *gardencorev1beta1.Shoot
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+		reconciler = &Reconciler{Client: fakeClient}
+
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-test"},
+			Spec: gardencorev1beta1.ShootSpec{
+				CloudProfile: &gardencorev1beta1.CloudProfileReference{Kind: "CloudProfile", Name: "profile"},
+				Kubernetes:   gardencorev1beta1.Kubernetes{Version: "1.31.1"},
+				Provider: gardencorev1beta1.Provider{
+					Workers: []gardencorev1beta1.Worker{{
+						Name:    "worker",
+						Machine: gardencorev1beta1.Machine{Image: &gardencorev1beta1.ShootMachineImage{Name: "gardenlinux", Version: ptr.To("1592.1.0")}},
+					}},
+				},
+			},
+		}
+	})
+
+	Describe("#ShootPredicate", func() {
+		var (
+			p        predicate.Predicate
+			oldShoot *gardencorev1beta1.Shoot
+		)
+
+		BeforeEach(func() {
+			p = reconciler.ShootPredicate()
+			oldShoot = shoot.DeepCopy()
+		})
+
+		It("should return true for create and delete events", func() {
+			Expect(p.Create(event.CreateEvent{Object: shoot})).To(BeTrue())
+			Expect(p.Delete(event.DeleteEvent{Object: shoot})).To(BeTrue())
+		})
+
+		It("should return false if nothing relevant changed", func() {
+			shoot.Spec.Provider.Workers[0].Minimum = 3
+			Expect(p.Update(event.UpdateEvent{ObjectOld: oldShoot, ObjectNew: shoot})).To(BeFalse())
+		})
+
+		It("should return false if the machine image version is changed to an equal value", func() {
+			shoot.Spec.Provider.Workers[0].Machine.Image.Version = ptr.To("1592.1.0")
+			Expect(p.Update(event.UpdateEvent{ObjectOld: oldShoot, ObjectNew: shoot})).To(BeFalse())
+		})
+
+		It("should return true if the cloud profile reference changed", func() {
+			shoot.Spec.CloudProfile.Name = "other"
+			Expect(p.Update(event.UpdateEvent{ObjectOld: oldShoot, ObjectNew: shoot})).To(BeTrue())
+		})
+
+		It("should return true if the Kubernetes version changed", func() {
+			shoot.Spec.Kubernetes.Version = "1.31.2"
+			Expect(p.Update(event.UpdateEvent{ObjectOld: oldShoot, ObjectNew: shoot})).To(BeTrue())
+		})
+
+		It("should return true if a machine image version changed", func() {
+			shoot.Spec.Provider.Workers[0].Machine.Image.Version = ptr.To("1592.2.0")
+			Expect(p.Update(event.UpdateEvent{ObjectOld: oldShoot, ObjectNew: shoot})).To(BeTrue())
+		})
+
+		It("should return true if the shoot is being deleted", func() {
+			shoot.DeletionTimestamp = &metav1.Time{}
+			Expect(p.Update(event.UpdateEvent{ObjectOld: oldShoot, ObjectNew: shoot})).To(BeTrue())
+		})
+	})
+
+	Describe("#MapShootToCloudProfile", func() {
+		It("should map to the referenced CloudProfile", func() {
+			Expect(reconciler.MapShootToCloudProfile(ctx, log, fakeClient, shoot)).To(ConsistOf(reconcile.Request{NamespacedName: client.ObjectKey{Name: "profile"}}))
+		})
+
+		It("should map to the legacy referenced CloudProfile", func() {
+			shoot.Spec.CloudProfile = nil
+			shoot.Spec.CloudProfileName = ptr.To("legacy")
+			Expect(reconciler.MapShootToCloudProfile(ctx, log, fakeClient, shoot)).To(ConsistOf(reconcile.Request{NamespacedName: client.ObjectKey{Name: "legacy"}}))
+		})
+
+		It("should map to the parent of the referenced NamespacedCloudProfile", func() {
+			Expect(fakeClient.Create(ctx, &gardencorev1beta1.NamespacedCloudProfile{
+				ObjectMeta: metav1.ObjectMeta{Name: "custom", Namespace: shoot.Namespace},
+				Spec:       gardencorev1beta1.NamespacedCloudProfileSpec{Parent: gardencorev1beta1.CloudProfileReference{Kind: "CloudProfile", Name: "parent"}},
+			})).To(Succeed())
+			shoot.Spec.CloudProfile = &gardencorev1beta1.CloudProfileReference{Kind: "NamespacedCloudProfile", Name: "custom"}
+
+			Expect(reconciler.MapShootToCloudProfile(ctx, log, fakeClient, shoot)).To(ConsistOf(reconcile.Request{NamespacedName: client.ObjectKey{Name: "parent"}}))
+		})
+
+		It("should return nil if the referenced NamespacedCloudProfile does not exist", func() {
+			shoot.Spec.CloudProfile = &gardencorev1beta1.CloudProfileReference{Kind: "NamespacedCloudProfile", Name: "custom"}
+
+			Expect(reconciler.MapShootToCloudProfile(ctx, log, fakeClient, shoot)).To(BeEmpty())
+		})
+	})
+})
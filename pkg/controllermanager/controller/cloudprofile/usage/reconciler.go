@@ -0,0 +1,156 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package usage
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+// Reconciler tracks which Kubernetes and machine image versions of CloudProfiles are in use by Shoots and maintains
+// this information in the status of the CloudProfiles.
+type Reconciler struct {
+	Client client.Client
+	Config config.CloudProfileControllerConfiguration
+	Clock  clock.Clock
+}
+
+// Reconcile performs the main reconciliation logic.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
+	defer cancel()
+
+	cloudProfile := &gardencorev1beta1.CloudProfile{}
+	if err := r.Client.Get(ctx, request.NamespacedName, cloudProfile); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	namespacedCloudProfileList, err := controllerutils.GetNamespacedCloudProfilesReferencingCloudProfile(ctx, r.Client, cloudProfile.Name)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed listing NamespacedCloudProfiles referencing the CloudProfile: %w", err)
+	}
+
+	namespacedCloudProfiles := sets.New[client.ObjectKey]()
+	for _, namespacedCloudProfile := range namespacedCloudProfileList.Items {
+		namespacedCloudProfiles.Insert(client.ObjectKeyFromObject(&namespacedCloudProfile))
+	}
+
+	shootList := &gardencorev1beta1.ShootList{}
+	if err := r.Client.List(ctx, shootList); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed listing Shoots: %w", err)
+	}
+
+	usage := computeUsage(cloudProfile.Name, namespacedCloudProfiles, shootList.Items)
+	if oldUsage := cloudProfile.Status.Usage; oldUsage != nil &&
+		apiequality.Semantic.DeepEqual(oldUsage.KubernetesVersions, usage.KubernetesVersions) &&
+		apiequality.Semantic.DeepEqual(oldUsage.MachineImageVersions, usage.MachineImageVersions) {
+		return reconcile.Result{}, nil
+	}
+
+	log.Info("Updating usage of CloudProfile", "kubernetesVersions", len(usage.KubernetesVersions), "machineImageVersions", len(usage.MachineImageVersions))
+
+	patch := client.MergeFrom(cloudProfile.DeepCopy())
+	usage.LastUpdateTime = &metav1.Time{Time: r.Clock.Now()}
+	cloudProfile.Status.Usage = usage
+	if err := r.Client.Status().Patch(ctx, cloudProfile, patch); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed updating usage of CloudProfile: %w", err)
+	}
+
+	return reconcile.Result{}, nil
+}
+
+func computeUsage(cloudProfileName string, namespacedCloudProfiles sets.Set[client.ObjectKey], shoots []gardencorev1beta1.Shoot) *gardencorev1beta1.CloudProfileUsage {
+	var (
+		kubernetesVersions   = map[string]int32{}
+		machineImageVersions = map[machineImageVersion]int32{}
+	)
+
+	for _, shoot := range shoots {
+		if shoot.DeletionTimestamp != nil || !isShootReferencingCloudProfile(&shoot, cloudProfileName, namespacedCloudProfiles) {
+			continue
+		}
+
+		kubernetesVersions[shoot.Spec.Kubernetes.Version]++
+		for machineImage := range machineImagesOfShoot(&shoot) {
+			machineImageVersions[machineImage]++
+		}
+	}
+
+	usage := &gardencorev1beta1.CloudProfileUsage{}
+	for version, shoots := range kubernetesVersions {
+		usage.KubernetesVersions = append(usage.KubernetesVersions, gardencorev1beta1.VersionUsage{Version: version, Shoots: shoots})
+	}
+	for machineImage, shoots := range machineImageVersions {
+		usage.MachineImageVersions = append(usage.MachineImageVersions, gardencorev1beta1.MachineImageVersionUsage{Name: machineImage.name, Version: machineImage.version, Shoots: shoots})
+	}
+
+	slices.SortFunc(usage.KubernetesVersions, func(a, b gardencorev1beta1.VersionUsage) int {
+		return strings.Compare(a.Version, b.Version)
+	})
+	slices.SortFunc(usage.MachineImageVersions, func(a, b gardencorev1beta1.MachineImageVersionUsage) int {
+		if c := strings.Compare(a.Name, b.Name); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Version, b.Version)
+	})
+
+	return usage
+}
+
+func isShootReferencingCloudProfile(shoot *gardencorev1beta1.Shoot, cloudProfileName string, namespacedCloudProfiles sets.Set[client.ObjectKey]) bool {
+	cloudProfileReference := gardenerutils.BuildCloudProfileReference(shoot)
+	if cloudProfileReference == nil {
+		return false
+	}
+
+	switch cloudProfileReference.Kind {
+	case v1beta1constants.CloudProfileReferenceKindCloudProfile:
+		return cloudProfileReference.Name == cloudProfileName
+	case v1beta1constants.CloudProfileReferenceKindNamespacedCloudProfile:
+		return namespacedCloudProfiles.Has(client.ObjectKey{Name: cloudProfileReference.Name, Namespace: shoot.Namespace})
+	}
+
+	return false
+}
+
+type machineImageVersion struct {
+	name, version string
+}
+
+// machineImagesOfShoot returns the set of machine image versions which are used by the worker pools of the given
+// shoot.
+func machineImagesOfShoot(shoot *gardencorev1beta1.Shoot) sets.Set[machineImageVersion] {
+	machineImages := sets.New[machineImageVersion]()
+	for _, worker := range shoot.Spec.Provider.Workers {
+		if worker.Machine.Image == nil || worker.Machine.Image.Version == nil {
+			continue
+		}
+		machineImages.Insert(machineImageVersion{name: worker.Machine.Image.Name, version: *worker.Machine.Image.Version})
+	}
+	return machineImages
+}
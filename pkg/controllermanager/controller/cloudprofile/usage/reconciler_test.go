@@ -0,0 +1,134 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package usage_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/pkg/api/indexer"
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/cloudprofile/usage"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx       = context.Background()
+		fakeClock = testclock.NewFakeClock(time.Now().Round(time.Second))
+
+		fakeClient   client.Client
+		reconciler   *Reconciler
+		cloudProfile *gardencorev1beta1.CloudProfile
+	)
+
+	newShoot := func(name string, cloudProfile gardencorev1beta1.CloudProfileReference, kubernetesVersion string, imageVersions ...string) *gardencorev1beta1.Shoot {
+		shoot := &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "garden-test"},
+			Spec: gardencorev1beta1.ShootSpec{
+				CloudProfile: &cloudProfile,
+				Kubernetes:   gardencorev1beta1.Kubernetes{Version: kubernetesVersion},
+			},
+		}
+		for _, version := range imageVersions {
+			shoot.Spec.Provider.Workers = append(shoot.Spec.Provider.Workers, gardencorev1beta1.Worker{
+				Name:    "worker-" + version,
+				Machine: gardencorev1beta1.Machine{Image: &gardencorev1beta1.ShootMachineImage{Name: "gardenlinux", Version: ptr.To(version)}},
+			})
+		}
+		return shoot
+	}
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).
+			WithStatusSubresource(&gardencorev1beta1.CloudProfile{}).
+			WithIndex(
+				&gardencorev1beta1.NamespacedCloudProfile{},
+				core.NamespacedCloudProfileParentRefName,
+				indexer.NamespacedCloudProfileParentRefNameIndexerFunc,
+			).Build()
+		reconciler = &Reconciler{Client: fakeClient, Clock: fakeClock}
+
+		cloudProfile = &gardencorev1beta1.CloudProfile{ObjectMeta: metav1.ObjectMeta{Name: "profile"}}
+		Expect(fakeClient.Create(ctx, cloudProfile)).To(Succeed())
+	})
+
+	reconcileAndGet := func() {
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(cloudProfile)})
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		ExpectWithOffset(1, fakeClient.Get(ctx, client.ObjectKeyFromObject(cloudProfile), cloudProfile)).To(Succeed())
+	}
+
+	It("should do nothing if the CloudProfile is gone", func() {
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKey{Name: "other"}})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should set an empty usage if no shoot references the CloudProfile", func() {
+		Expect(fakeClient.Create(ctx, newShoot("other", gardencorev1beta1.CloudProfileReference{Kind: "CloudProfile", Name: "other"}, "1.31.1", "1592.1.0"))).To(Succeed())
+
+		reconcileAndGet()
+
+		Expect(cloudProfile.Status.Usage).To(Equal(&gardencorev1beta1.CloudProfileUsage{
+			LastUpdateTime: &metav1.Time{Time: fakeClock.Now()},
+		}))
+	})
+
+	It("should track the versions used by shoots referencing the CloudProfile directly or via NamespacedCloudProfiles", func() {
+		Expect(fakeClient.Create(ctx, &gardencorev1beta1.NamespacedCloudProfile{
+			ObjectMeta: metav1.ObjectMeta{Name: "custom", Namespace: "garden-test"},
+			Spec:       gardencorev1beta1.NamespacedCloudProfileSpec{Parent: gardencorev1beta1.CloudProfileReference{Kind: "CloudProfile", Name: "profile"}},
+		})).To(Succeed())
+
+		deletingShoot := newShoot("deleting", gardencorev1beta1.CloudProfileReference{Kind: "CloudProfile", Name: "profile"}, "1.29.0", "1443.0.0")
+		deletingShoot.Finalizers = []string{"gardener"}
+		Expect(fakeClient.Create(ctx, deletingShoot)).To(Succeed())
+		Expect(fakeClient.Delete(ctx, deletingShoot)).To(Succeed())
+
+		for _, shoot := range []*gardencorev1beta1.Shoot{
+			newShoot("shoot1", gardencorev1beta1.CloudProfileReference{Kind: "CloudProfile", Name: "profile"}, "1.31.1", "1592.1.0", "1592.2.0"),
+			newShoot("shoot2", gardencorev1beta1.CloudProfileReference{Kind: "CloudProfile", Name: "profile"}, "1.31.1", "1592.1.0", "1592.1.0"),
+			newShoot("shoot3", gardencorev1beta1.CloudProfileReference{Kind: "NamespacedCloudProfile", Name: "custom"}, "1.30.5", "1592.2.0"),
+			newShoot("shoot4", gardencorev1beta1.CloudProfileReference{Kind: "CloudProfile", Name: "other"}, "1.28.0", "1312.0.0"),
+		} {
+			Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+		}
+
+		reconcileAndGet()
+
+		Expect(cloudProfile.Status.Usage).To(Equal(&gardencorev1beta1.CloudProfileUsage{
+			KubernetesVersions: []gardencorev1beta1.VersionUsage{
+				{Version: "1.30.5", Shoots: 1},
+				{Version: "1.31.1", Shoots: 2},
+			},
+			MachineImageVersions: []gardencorev1beta1.MachineImageVersionUsage{
+				{Name: "gardenlinux", Version: "1592.1.0", Shoots: 2},
+				{Name: "gardenlinux", Version: "1592.2.0", Shoots: 2},
+			},
+			LastUpdateTime: &metav1.Time{Time: fakeClock.Now()},
+		}))
+	})
+
+	It("should not update the status if the usage did not change", func() {
+		Expect(fakeClient.Create(ctx, newShoot("shoot1", gardencorev1beta1.CloudProfileReference{Kind: "CloudProfile", Name: "profile"}, "1.31.1", "1592.1.0"))).To(Succeed())
+		reconcileAndGet()
+		lastUpdateTime := cloudProfile.Status.Usage.LastUpdateTime
+
+		fakeClock.Step(time.Hour)
+		reconcileAndGet()
+
+		Expect(cloudProfile.Status.Usage.LastUpdateTime).To(Equal(lastUpdateTime))
+	})
+})
@@ -11,6 +11,7 @@ import (
 	"golang.org/x/exp/maps"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/ptr"
@@ -116,7 +117,7 @@ func MergeCloudProfiles(namespacedCloudProfile *gardencorev1beta1.NamespacedClou
 	namespacedCloudProfile.Status.CloudProfileSpec = cloudProfile.Spec
 
 	if namespacedCloudProfile.Spec.Kubernetes != nil {
-		namespacedCloudProfile.Status.CloudProfileSpec.Kubernetes.Versions = mergeDeep(namespacedCloudProfile.Status.CloudProfileSpec.Kubernetes.Versions, namespacedCloudProfile.Spec.Kubernetes.Versions, expirableVersionKeyFunc, mergeExpirationDates, false)
+		namespacedCloudProfile.Status.CloudProfileSpec.Kubernetes.Versions = mergeDeep(namespacedCloudProfile.Status.CloudProfileSpec.Kubernetes.Versions, namespacedCloudProfile.Spec.Kubernetes.Versions, expirableVersionKeyFunc, mergeKubernetesVersionsFunc(cloudProfile.Spec.Kubernetes.MaxExpirationExtension), false)
 	}
 	namespacedCloudProfile.Status.CloudProfileSpec.MachineImages = mergeDeep(namespacedCloudProfile.Status.CloudProfileSpec.MachineImages, namespacedCloudProfile.Spec.MachineImages, machineImageKeyFunc, mergeMachineImages, true)
 	namespacedCloudProfile.Status.CloudProfileSpec.MachineTypes = mergeDeep(namespacedCloudProfile.Status.CloudProfileSpec.MachineTypes, namespacedCloudProfile.Spec.MachineTypes, machineTypeKeyFunc, nil, true)
@@ -139,6 +140,20 @@ func mergeExpirationDates(base, override gardencorev1beta1.ExpirableVersion) gar
 	return base
 }
 
+// mergeKubernetesVersionsFunc returns a function merging the expiration dates of Kubernetes versions. The expiration
+// date overridden in the NamespacedCloudProfile is capped to the expiration date in the CloudProfile extended by the
+// given maximum extension. This way, overrides exceeding a later reduced maximum extension are not effective anymore.
+func mergeKubernetesVersionsFunc(maxExpirationExtension *metav1.Duration) func(gardencorev1beta1.ExpirableVersion, gardencorev1beta1.ExpirableVersion) gardencorev1beta1.ExpirableVersion {
+	return func(base, override gardencorev1beta1.ExpirableVersion) gardencorev1beta1.ExpirableVersion {
+		if maxExpirationExtension != nil && base.ExpirationDate != nil && override.ExpirationDate != nil {
+			if latestExpirationDate := metav1.NewTime(base.ExpirationDate.Add(maxExpirationExtension.Duration)); override.ExpirationDate.After(latestExpirationDate.Time) {
+				override.ExpirationDate = &latestExpirationDate
+			}
+		}
+		return mergeExpirationDates(base, override)
+	}
+}
+
 func mergeMachineImages(base, override gardencorev1beta1.MachineImage) gardencorev1beta1.MachineImage {
 	base.Versions = mergeDeep(base.Versions, override.Versions, machineImageVersionKeyFunc, mergeMachineImageVersions, true)
 	return base
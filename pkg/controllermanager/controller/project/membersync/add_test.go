@@ -0,0 +1,53 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package membersync_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/project/membersync"
+)
+
+var _ = Describe("Add", func() {
+	var reconciler *Reconciler
+
+	BeforeEach(func() {
+		reconciler = &Reconciler{}
+	})
+
+	Describe("ProjectPredicate", func() {
+		var (
+			p       predicate.Predicate
+			project *gardencorev1beta1.Project
+		)
+
+		BeforeEach(func() {
+			p = reconciler.ProjectPredicate()
+			project = &gardencorev1beta1.Project{}
+		})
+
+		It("should return false if the project neither configures a member sync nor has synchronized members", func() {
+			Expect(p.Create(event.CreateEvent{Object: project})).To(BeFalse())
+			Expect(p.Update(event.UpdateEvent{ObjectOld: project, ObjectNew: project})).To(BeFalse())
+		})
+
+		It("should return true if the project configures a member sync", func() {
+			project.Spec.MemberSync = &gardencorev1beta1.ProjectMemberSync{}
+			Expect(p.Create(event.CreateEvent{Object: project})).To(BeTrue())
+			Expect(p.Update(event.UpdateEvent{ObjectOld: project, ObjectNew: project})).To(BeTrue())
+		})
+
+		It("should return true if the project has synchronized members", func() {
+			metav1.SetMetaDataAnnotation(&project.ObjectMeta, "project.gardener.cloud/synced-member-groups", "")
+			Expect(p.Create(event.CreateEvent{Object: project})).To(BeTrue())
+			Expect(p.Update(event.UpdateEvent{ObjectOld: project, ObjectNew: project})).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,152 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package membersync
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	"github.com/gardener/gardener/pkg/controllerutils"
+)
+
+// Reconciler reconciles Projects and synchronizes their members with the groups configured in the member sync
+// configuration.
+type Reconciler struct {
+	Client client.Client
+	Config config.ProjectControllerConfiguration
+}
+
+// Reconcile reconciles Projects and synchronizes their members with the groups configured in the member sync
+// configuration.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
+	defer cancel()
+
+	project := &gardencorev1beta1.Project{}
+	if err := r.Client.Get(ctx, request.NamespacedName, project); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if project.DeletionTimestamp != nil {
+		return reconcile.Result{}, nil
+	}
+
+	desiredMembers := desiredGroupMembers(project.Spec.MemberSync)
+	previouslySyncedMembers := sets.New[string]()
+	if value := project.Annotations[v1beta1constants.ProjectSyncedMemberGroups]; value != "" {
+		previouslySyncedMembers.Insert(strings.Split(value, ",")...)
+	}
+
+	originalProject := project.DeepCopy()
+
+	var (
+		members       []gardencorev1beta1.ProjectMember
+		syncedMembers = sets.New[string]()
+	)
+
+	for _, member := range project.Spec.Members {
+		if member.Kind != rbacv1.GroupKind {
+			members = append(members, member)
+			continue
+		}
+
+		roles, desired := desiredMembers[member.Name]
+		if !desired {
+			if previouslySyncedMembers.Has(member.Name) {
+				log.Info("Removing group member which is no longer synchronized", "member", member.Name)
+				continue
+			}
+			members = append(members, member)
+			continue
+		}
+
+		// The owner role is never synchronized but must not be revoked when taking over an existing member.
+		if slices.Contains(memberRoles(member), gardencorev1beta1.ProjectMemberOwner) {
+			roles = append([]string{gardencorev1beta1.ProjectMemberOwner}, roles...)
+		}
+		members = append(members, newProjectMember(member.Subject, roles))
+		syncedMembers.Insert(member.Name)
+	}
+
+	for _, name := range sets.List(sets.KeySet(desiredMembers).Difference(syncedMembers)) {
+		log.Info("Adding synchronized group member", "member", name)
+		members = append(members, newProjectMember(rbacv1.Subject{
+			APIGroup: rbacv1.GroupName,
+			Kind:     rbacv1.GroupKind,
+			Name:     name,
+		}, desiredMembers[name]))
+		syncedMembers.Insert(name)
+	}
+	project.Spec.Members = members
+
+	if syncedMembers.Len() > 0 {
+		metav1.SetMetaDataAnnotation(&project.ObjectMeta, v1beta1constants.ProjectSyncedMemberGroups, strings.Join(sets.List(syncedMembers), ","))
+	} else {
+		delete(project.Annotations, v1beta1constants.ProjectSyncedMemberGroups)
+	}
+
+	if apiequality.Semantic.DeepEqual(originalProject, project) {
+		return reconcile.Result{}, nil
+	}
+
+	if err := r.Client.Patch(ctx, project, client.MergeFrom(originalProject)); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed synchronizing project members: %w", err)
+	}
+
+	return reconcile.Result{}, nil
+}
+
+// desiredGroupMembers computes the names of the group members and their roles based on the given member sync
+// configuration.
+func desiredGroupMembers(memberSync *gardencorev1beta1.ProjectMemberSync) map[string][]string {
+	desired := make(map[string][]string)
+	if memberSync == nil {
+		return desired
+	}
+
+	for _, group := range memberSync.Groups {
+		desired[ptr.Deref(memberSync.GroupPrefix, "")+group.Name] = slices.Clone(group.Roles)
+	}
+	return desired
+}
+
+func newProjectMember(subject rbacv1.Subject, roles []string) gardencorev1beta1.ProjectMember {
+	member := gardencorev1beta1.ProjectMember{Subject: subject}
+	if len(roles) > 0 {
+		member.Role = roles[0]
+	}
+	if len(roles) > 1 {
+		member.Roles = roles[1:]
+	}
+	return member
+}
+
+func memberRoles(member gardencorev1beta1.ProjectMember) []string {
+	if member.Role == "" {
+		return member.Roles
+	}
+	return append([]string{member.Role}, member.Roles...)
+}
@@ -0,0 +1,144 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package membersync_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/project/membersync"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx        = context.TODO()
+		fakeClient client.Client
+		reconciler *Reconciler
+		request    reconcile.Request
+
+		project *gardencorev1beta1.Project
+		user    gardencorev1beta1.ProjectMember
+	)
+
+	group := func(name, role string, roles ...string) gardencorev1beta1.ProjectMember {
+		return gardencorev1beta1.ProjectMember{
+			Subject: rbacv1.Subject{APIGroup: rbacv1.GroupName, Kind: rbacv1.GroupKind, Name: name},
+			Role:    role,
+			Roles:   roles,
+		}
+	}
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+		reconciler = &Reconciler{Client: fakeClient}
+
+		user = gardencorev1beta1.ProjectMember{
+			Subject: rbacv1.Subject{APIGroup: rbacv1.GroupName, Kind: rbacv1.UserKind, Name: "john.doe@example.com"},
+			Role:    "owner",
+		}
+		project = &gardencorev1beta1.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+			Spec: gardencorev1beta1.ProjectSpec{
+				Members: []gardencorev1beta1.ProjectMember{user},
+				MemberSync: &gardencorev1beta1.ProjectMemberSync{
+					GroupPrefix: ptr.To("oidc:"),
+					Groups: []gardencorev1beta1.ProjectMemberSyncGroup{
+						{Name: "developers", Roles: []string{"admin", "extension:foo"}},
+						{Name: "auditors", Roles: []string{"viewer"}},
+					},
+				},
+			},
+		}
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(project)}
+	})
+
+	reconcileAndGetProject := func() *gardencorev1beta1.Project {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		Expect(fakeClient.Get(ctx, request.NamespacedName, project)).To(Succeed())
+		return project
+	}
+
+	It("should do nothing if the project does not exist", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+	})
+
+	It("should add the configured groups as members", func() {
+		Expect(fakeClient.Create(ctx, project)).To(Succeed())
+
+		project = reconcileAndGetProject()
+		Expect(project.Spec.Members).To(Equal([]gardencorev1beta1.ProjectMember{
+			user,
+			group("oidc:auditors", "viewer"),
+			group("oidc:developers", "admin", "extension:foo"),
+		}))
+		Expect(project.Annotations).To(HaveKeyWithValue(v1beta1constants.ProjectSyncedMemberGroups, "oidc:auditors,oidc:developers"))
+	})
+
+	It("should update the roles of existing group members and keep the owner role", func() {
+		project.Spec.Members = []gardencorev1beta1.ProjectMember{
+			group("oidc:developers", "viewer"),
+			group("oidc:auditors", "owner", "admin"),
+		}
+		Expect(fakeClient.Create(ctx, project)).To(Succeed())
+
+		project = reconcileAndGetProject()
+		Expect(project.Spec.Members).To(Equal([]gardencorev1beta1.ProjectMember{
+			group("oidc:developers", "admin", "extension:foo"),
+			group("oidc:auditors", "owner", "viewer"),
+		}))
+	})
+
+	It("should remove members which are no longer synchronized but keep manually managed members", func() {
+		metav1.SetMetaDataAnnotation(&project.ObjectMeta, v1beta1constants.ProjectSyncedMemberGroups, "oidc:auditors,oidc:developers,oidc:operators")
+		project.Spec.MemberSync.Groups = project.Spec.MemberSync.Groups[:1]
+		project.Spec.Members = []gardencorev1beta1.ProjectMember{
+			user,
+			group("oidc:auditors", "viewer"),
+			group("oidc:developers", "admin", "extension:foo"),
+			group("manual", "viewer"),
+		}
+		Expect(fakeClient.Create(ctx, project)).To(Succeed())
+
+		project = reconcileAndGetProject()
+		Expect(project.Spec.Members).To(Equal([]gardencorev1beta1.ProjectMember{
+			user,
+			group("oidc:developers", "admin", "extension:foo"),
+			group("manual", "viewer"),
+		}))
+		Expect(project.Annotations).To(HaveKeyWithValue(v1beta1constants.ProjectSyncedMemberGroups, "oidc:developers"))
+	})
+
+	It("should remove all synchronized members and the annotation if the member sync is removed", func() {
+		metav1.SetMetaDataAnnotation(&project.ObjectMeta, v1beta1constants.ProjectSyncedMemberGroups, "oidc:developers")
+		project.Spec.MemberSync = nil
+		project.Spec.Members = []gardencorev1beta1.ProjectMember{
+			user,
+			group("oidc:developers", "admin"),
+		}
+		Expect(fakeClient.Create(ctx, project)).To(Succeed())
+
+		project = reconcileAndGetProject()
+		Expect(project.Spec.Members).To(Equal([]gardencorev1beta1.ProjectMember{user}))
+		Expect(project.Annotations).NotTo(HaveKey(v1beta1constants.ProjectSyncedMemberGroups))
+	})
+
+	It("should not update the project if the members are already in sync", func() {
+		Expect(fakeClient.Create(ctx, project)).To(Succeed())
+		resourceVersion := reconcileAndGetProject().ResourceVersion
+
+		Expect(reconcileAndGetProject().ResourceVersion).To(Equal(resourceVersion))
+	})
+})
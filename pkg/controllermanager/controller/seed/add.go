@@ -12,7 +12,9 @@ import (
 
 	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/seed/backupbucketscheck"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/seed/backupentriescheck"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/seed/extensionscheck"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/seed/ingressdomainmigration"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/seed/lifecycle"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/seed/secrets"
 )
@@ -25,12 +27,24 @@ func AddToManager(ctx context.Context, mgr manager.Manager, cfg config.Controlle
 		return fmt.Errorf("failed adding backupbuckets check reconciler: %w", err)
 	}
 
+	if err := (&backupentriescheck.Reconciler{
+		Config: *cfg.Controllers.SeedBackupEntriesCheck,
+	}).AddToManager(ctx, mgr); err != nil {
+		return fmt.Errorf("failed adding backupentries check reconciler: %w", err)
+	}
+
 	if err := (&extensionscheck.Reconciler{
 		Config: *cfg.Controllers.SeedExtensionsCheck,
 	}).AddToManager(ctx, mgr); err != nil {
 		return fmt.Errorf("failed adding extensions check reconciler: %w", err)
 	}
 
+	if err := (&ingressdomainmigration.Reconciler{
+		Config: *cfg.Controllers.SeedIngressDomainMigration,
+	}).AddToManager(mgr); err != nil {
+		return fmt.Errorf("failed adding ingress domain migration reconciler: %w", err)
+	}
+
 	if err := (&lifecycle.Reconciler{
 		Config: *cfg.Controllers.Seed,
 	}).AddToManager(mgr); err != nil {
@@ -0,0 +1,114 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backupentriescheck
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/controllerutils/mapper"
+	predicateutils "github.com/gardener/gardener/pkg/controllerutils/predicate"
+)
+
+// ControllerName is the name of this controller.
+const ControllerName = "seed-backupentries-check"
+
+// AddToManager adds Reconciler to the given manager.
+func (r *Reconciler) AddToManager(ctx context.Context, mgr manager.Manager) error {
+	if r.Client == nil {
+		r.Client = mgr.GetClient()
+	}
+
+	c, err := controller.New(
+		ControllerName,
+		mgr,
+		controller.Options{
+			Reconciler:              r,
+			MaxConcurrentReconciles: ptr.Deref(r.Config.ConcurrentSyncs, 0),
+			// if going into exponential backoff, wait at most the configured sync period
+			RateLimiter: workqueue.NewTypedWithMaxWaitRateLimiter(workqueue.DefaultTypedControllerRateLimiter[reconcile.Request](), r.Config.SyncPeriod.Duration),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := c.Watch(
+		source.Kind[client.Object](mgr.GetCache(),
+			&gardencorev1beta1.Seed{},
+			&handler.EnqueueRequestForObject{},
+			predicateutils.ForEventTypes(predicateutils.Create),
+		)); err != nil {
+		return err
+	}
+
+	return c.Watch(
+		source.Kind[client.Object](mgr.GetCache(),
+			&gardencorev1beta1.BackupEntry{},
+			mapper.EnqueueRequestsFrom(ctx, mgr.GetCache(), mapper.MapFunc(r.MapBackupEntryToSeed), mapper.UpdateWithNew, c.GetLogger()),
+			r.BackupEntryPredicate(),
+		))
+}
+
+// BackupEntryPredicate reacts only on 'CREATE' and 'UPDATE' events. It returns false if .spec.seedName == nil. For
+// updates, it only returns true when the annotations changed, e.g., when the deletion of an orphaned BackupEntry was
+// confirmed.
+func (r *Reconciler) BackupEntryPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(e event.CreateEvent) bool {
+			backupEntry, ok := e.Object.(*gardencorev1beta1.BackupEntry)
+			if !ok {
+				return false
+			}
+			return backupEntry.Spec.SeedName != nil
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			backupEntry, ok := e.ObjectNew.(*gardencorev1beta1.BackupEntry)
+			if !ok {
+				return false
+			}
+
+			oldBackupEntry, ok := e.ObjectOld.(*gardencorev1beta1.BackupEntry)
+			if !ok {
+				return false
+			}
+
+			if backupEntry.Spec.SeedName == nil {
+				return false
+			}
+			return !equality.Semantic.DeepEqual(oldBackupEntry.Annotations, backupEntry.Annotations)
+		},
+		DeleteFunc:  func(_ event.DeleteEvent) bool { return false },
+		GenericFunc: func(_ event.GenericEvent) bool { return false },
+	}
+}
+
+// MapBackupEntryToSeed is a mapper.MapFunc for mapping a BackupEntry to the referenced Seed.
+func (r *Reconciler) MapBackupEntryToSeed(_ context.Context, _ logr.Logger, _ client.Reader, obj client.Object) []reconcile.Request {
+	backupEntry, ok := obj.(*gardencorev1beta1.BackupEntry)
+	if !ok {
+		return nil
+	}
+
+	if backupEntry.Spec.SeedName == nil {
+		return nil
+	}
+
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: *backupEntry.Spec.SeedName}}}
+}
@@ -0,0 +1,131 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backupentriescheck_test
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/seed/backupentriescheck"
+)
+
+var _ = Describe("Add", func() {
+	var (
+		reconciler  *Reconciler
+		backupEntry *gardencorev1beta1.BackupEntry
+	)
+
+	BeforeEach(func() {
+		reconciler = &Reconciler{}
+		backupEntry = &gardencorev1beta1.BackupEntry{
+			Spec: gardencorev1beta1.BackupEntrySpec{
+				SeedName: ptr.To("seed"),
+			},
+		}
+	})
+
+	Describe("BackupEntryPredicate", func() {
+		var p predicate.Predicate
+
+		BeforeEach(func() {
+			p = reconciler.BackupEntryPredicate()
+		})
+
+		Describe("#Create", func() {
+			It("should return false because object is no BackupEntry", func() {
+				Expect(p.Create(event.CreateEvent{})).To(BeFalse())
+			})
+
+			It("should return false because seed name is not set", func() {
+				backupEntry.Spec.SeedName = nil
+				Expect(p.Create(event.CreateEvent{Object: backupEntry})).To(BeFalse())
+			})
+
+			It("should return true because seed name is set", func() {
+				Expect(p.Create(event.CreateEvent{Object: backupEntry})).To(BeTrue())
+			})
+		})
+
+		Describe("#Update", func() {
+			It("should return false because object is no BackupEntry", func() {
+				Expect(p.Update(event.UpdateEvent{})).To(BeFalse())
+			})
+
+			It("should return false because old object is no BackupEntry", func() {
+				Expect(p.Update(event.UpdateEvent{ObjectNew: backupEntry})).To(BeFalse())
+			})
+
+			It("should return false because seed name is not set", func() {
+				backupEntry.Spec.SeedName = nil
+				oldBackupEntry := backupEntry.DeepCopy()
+				backupEntry.Annotations = map[string]string{"foo": "bar"}
+				Expect(p.Update(event.UpdateEvent{ObjectNew: backupEntry, ObjectOld: oldBackupEntry})).To(BeFalse())
+			})
+
+			It("should return false because annotations did not change", func() {
+				Expect(p.Update(event.UpdateEvent{ObjectNew: backupEntry, ObjectOld: backupEntry})).To(BeFalse())
+			})
+
+			It("should return true because annotations changed", func() {
+				oldBackupEntry := backupEntry.DeepCopy()
+				backupEntry.Annotations = map[string]string{"confirmation.gardener.cloud/deletion": "true"}
+				Expect(p.Update(event.UpdateEvent{ObjectNew: backupEntry, ObjectOld: oldBackupEntry})).To(BeTrue())
+			})
+		})
+
+		Describe("#Delete", func() {
+			It("should return false", func() {
+				Expect(p.Delete(event.DeleteEvent{})).To(BeFalse())
+			})
+		})
+
+		Describe("#Generic", func() {
+			It("should return false", func() {
+				Expect(p.Generic(event.GenericEvent{})).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("#MapBackupEntryToSeed", func() {
+		var (
+			ctx        = context.TODO()
+			log        logr.Logger
+			fakeClient client.Client
+		)
+
+		BeforeEach(func() {
+			log = logr.Discard()
+			fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+		})
+
+		It("should do nothing if the object is no BackupEntry", func() {
+			Expect(reconciler.MapBackupEntryToSeed(ctx, log, fakeClient, &corev1.Secret{})).To(BeEmpty())
+		})
+
+		It("should do nothing if seed name is not set", func() {
+			backupEntry.Spec.SeedName = nil
+			Expect(reconciler.MapBackupEntryToSeed(ctx, log, fakeClient, backupEntry)).To(BeEmpty())
+		})
+
+		It("should map the BackupEntry to the Seed", func() {
+			Expect(reconciler.MapBackupEntryToSeed(ctx, log, fakeClient, backupEntry)).To(ConsistOf(
+				reconcile.Request{NamespacedName: types.NamespacedName{Name: *backupEntry.Spec.SeedName}},
+			))
+		})
+	})
+})
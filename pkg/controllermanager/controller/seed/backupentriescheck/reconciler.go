@@ -0,0 +1,148 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backupentriescheck
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+// Reconciler reconciles Seeds and reports the BackupEntries scheduled to the seed which do no longer belong to an
+// existing shoot in the 'orphaned-backupentries' ConfigMap in the namespace of the seed (seed-<name>). Orphaned
+// BackupEntries which are annotated with 'confirmation.gardener.cloud/deletion=true' are deleted.
+type Reconciler struct {
+	Client client.Client
+	Config config.SeedBackupEntriesCheckControllerConfiguration
+}
+
+// Reconcile reconciles Seeds and reports the BackupEntries scheduled to the seed which do no longer belong to an
+// existing shoot.
+func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, r.Config.SyncPeriod.Duration)
+	defer cancel()
+
+	seed := &gardencorev1beta1.Seed{}
+	if err := r.Client.Get(ctx, req.NamespacedName, seed); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	backupEntryList := &gardencorev1beta1.BackupEntryList{}
+	if err := r.Client.List(ctx, backupEntryList, client.MatchingFields{core.BackupEntrySeedName: seed.Name}); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	orphanedBackupEntries := map[string]string{}
+
+	for _, backupEntry := range backupEntryList.Items {
+		if backupEntry.DeletionTimestamp != nil {
+			continue
+		}
+
+		reason, err := r.orphanReason(ctx, seed.Name, &backupEntry)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		if reason == "" {
+			continue
+		}
+
+		if gardenerutils.CheckIfDeletionIsConfirmed(&backupEntry) == nil {
+			log.Info("Deleting orphaned BackupEntry since its deletion was confirmed", "backupEntry", client.ObjectKeyFromObject(&backupEntry), "reason", reason)
+			if err := r.Client.Delete(ctx, &backupEntry); client.IgnoreNotFound(err) != nil {
+				return reconcile.Result{}, fmt.Errorf("failed deleting orphaned BackupEntry %s: %w", client.ObjectKeyFromObject(&backupEntry), err)
+			}
+			continue
+		}
+
+		// Namespaces and names of BackupEntries are DNS labels/subdomains, hence '<namespace>.<name>' is a valid
+		// ConfigMap key.
+		orphanedBackupEntries[backupEntry.Namespace+"."+backupEntry.Name] = reason
+	}
+
+	if err := r.reportOrphanedBackupEntries(ctx, seed.Name, orphanedBackupEntries); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed reporting orphaned BackupEntries: %w", err)
+	}
+
+	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+}
+
+// orphanReason returns a non-empty reason in case the given BackupEntry does no longer belong to an existing shoot
+// scheduled to the given seed.
+func (r *Reconciler) orphanReason(ctx context.Context, seedName string, backupEntry *gardencorev1beta1.BackupEntry) (string, error) {
+	var ownerRef *metav1.OwnerReference
+	for _, ref := range backupEntry.OwnerReferences {
+		if ref.APIVersion == gardencorev1beta1.SchemeGroupVersion.String() && ref.Kind == "Shoot" {
+			ownerRef = &ref
+			break
+		}
+	}
+	if ownerRef == nil {
+		return "no owning shoot", nil
+	}
+
+	shoot := &gardencorev1beta1.Shoot{}
+	if err := r.Client.Get(ctx, client.ObjectKey{Namespace: backupEntry.Namespace, Name: ownerRef.Name}, shoot); err != nil {
+		if apierrors.IsNotFound(err) {
+			return fmt.Sprintf("shoot %q does not exist", ownerRef.Name), nil
+		}
+		return "", fmt.Errorf("failed reading shoot %s for BackupEntry %s: %w", ownerRef.Name, client.ObjectKeyFromObject(backupEntry), err)
+	}
+
+	if shoot.UID != ownerRef.UID {
+		return fmt.Sprintf("shoot %q was recreated", ownerRef.Name), nil
+	}
+
+	if seedName != ptr.Deref(shoot.Spec.SeedName, "") && seedName != ptr.Deref(shoot.Status.SeedName, "") {
+		return fmt.Sprintf("shoot %q is no longer scheduled to this seed", ownerRef.Name), nil
+	}
+
+	// Source BackupEntries are only needed while the control plane of the shoot is restored after a migration.
+	if strings.HasPrefix(backupEntry.Name, v1beta1constants.BackupSourcePrefix+"-") &&
+		(shoot.Status.LastOperation == nil ||
+			shoot.Status.LastOperation.Type != gardencorev1beta1.LastOperationTypeRestore ||
+			shoot.Status.LastOperation.State == gardencorev1beta1.LastOperationStateSucceeded) {
+		return fmt.Sprintf("shoot %q is not being restored", ownerRef.Name), nil
+	}
+
+	return "", nil
+}
+
+// reportOrphanedBackupEntries maintains the ConfigMap which reports the orphaned BackupEntries of the given seed. The
+// ConfigMap is deleted if there are no orphaned BackupEntries to not clutter the namespace of the seed.
+func (r *Reconciler) reportOrphanedBackupEntries(ctx context.Context, seedName string, orphanedBackupEntries map[string]string) error {
+	configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: v1beta1constants.ConfigMapNameOrphanedBackupEntries, Namespace: gardenerutils.ComputeGardenNamespace(seedName)}}
+
+	if len(orphanedBackupEntries) == 0 {
+		return client.IgnoreNotFound(r.Client.Delete(ctx, configMap))
+	}
+
+	_, err := controllerutils.GetAndCreateOrMergePatch(ctx, r.Client, configMap, func() error {
+		configMap.Data = orphanedBackupEntries
+		return nil
+	})
+	return err
+}
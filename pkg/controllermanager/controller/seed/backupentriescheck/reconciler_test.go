@@ -0,0 +1,187 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package backupentriescheck_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/pkg/api/indexer"
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/seed/backupentriescheck"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Reconciler", func() {
+	const (
+		syncPeriod = 1 * time.Second
+		namespace  = "garden-project"
+	)
+
+	var (
+		ctx = context.TODO()
+		c   client.Client
+
+		reconciler reconcile.Reconciler
+		request    reconcile.Request
+
+		seed  *gardencorev1beta1.Seed
+		shoot *gardencorev1beta1.Shoot
+	)
+
+	BeforeEach(func() {
+		seed = &gardencorev1beta1.Seed{ObjectMeta: metav1.ObjectMeta{Name: "seed"}}
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: namespace, UID: "shoot-uid"},
+			Spec:       gardencorev1beta1.ShootSpec{SeedName: ptr.To(seed.Name)},
+		}
+
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(seed)}
+
+		c = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.GardenScheme).
+			WithObjects(seed, shoot).
+			WithStatusSubresource(seed).
+			WithIndex(&gardencorev1beta1.BackupEntry{}, core.BackupEntrySeedName, indexer.BackupEntrySeedNameIndexerFunc).
+			Build()
+
+		reconciler = &Reconciler{
+			Client: c,
+			Config: config.SeedBackupEntriesCheckControllerConfiguration{SyncPeriod: &metav1.Duration{Duration: syncPeriod}},
+		}
+	})
+
+	reconcileAndGetReport := func() map[string]string {
+		result, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		Expect(c.Get(ctx, request.NamespacedName, seed)).To(Succeed())
+		Expect(seed.Status.Conditions).To(BeEmpty())
+
+		configMap := &corev1.ConfigMap{}
+		if err := c.Get(ctx, client.ObjectKey{Namespace: "seed-seed", Name: "orphaned-backupentries"}, configMap); err != nil {
+			Expect(err).To(BeNotFoundError())
+			return nil
+		}
+		return configMap.Data
+	}
+
+	It("should do nothing if Seed is gone", func() {
+		Expect(c.Delete(ctx, seed)).To(Succeed())
+
+		result, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(reconcile.Result{}))
+	})
+
+	It("should not report anything if there are no BackupEntries", func() {
+		Expect(reconcileAndGetReport()).To(BeNil())
+	})
+
+	It("should not report anything if all BackupEntries belong to existing shoots", func() {
+		Expect(c.Create(ctx, newBackupEntry("entry", seed.Name, shoot))).To(Succeed())
+		Expect(c.Create(ctx, newBackupEntry("other-seed", "other", nil))).To(Succeed())
+
+		Expect(reconcileAndGetReport()).To(BeNil())
+	})
+
+	It("should keep the source BackupEntry while the shoot is being restored", func() {
+		shoot.Status.LastOperation = &gardencorev1beta1.LastOperation{
+			Type:  gardencorev1beta1.LastOperationTypeRestore,
+			State: gardencorev1beta1.LastOperationStateProcessing,
+		}
+		Expect(c.Update(ctx, shoot)).To(Succeed())
+		Expect(c.Create(ctx, newBackupEntry("source-entry", seed.Name, shoot))).To(Succeed())
+
+		Expect(reconcileAndGetReport()).To(BeNil())
+	})
+
+	It("should report orphaned BackupEntries", func() {
+		otherShoot := shoot.DeepCopy()
+		otherShoot.Name = "other-shoot"
+		otherShoot.UID = "other-uid"
+		otherShoot.Spec.SeedName = ptr.To("other")
+		otherShoot.ResourceVersion = ""
+
+		recreatedShoot := shoot.DeepCopy()
+		recreatedShoot.UID = "old-uid"
+
+		deletedShoot := shoot.DeepCopy()
+		deletedShoot.Name = "deleted"
+
+		Expect(c.Create(ctx, otherShoot)).To(Succeed())
+		Expect(c.Create(ctx, newBackupEntry("no-owner", seed.Name, nil))).To(Succeed())
+		Expect(c.Create(ctx, newBackupEntry("deleted", seed.Name, deletedShoot))).To(Succeed())
+		Expect(c.Create(ctx, newBackupEntry("recreated", seed.Name, recreatedShoot))).To(Succeed())
+		Expect(c.Create(ctx, newBackupEntry("moved", seed.Name, otherShoot))).To(Succeed())
+		Expect(c.Create(ctx, newBackupEntry("source-entry", seed.Name, shoot))).To(Succeed())
+
+		Expect(reconcileAndGetReport()).To(Equal(map[string]string{
+			"garden-project.no-owner":     "no owning shoot",
+			"garden-project.deleted":      `shoot "deleted" does not exist`,
+			"garden-project.recreated":    `shoot "shoot" was recreated`,
+			"garden-project.moved":        `shoot "other-shoot" is no longer scheduled to this seed`,
+			"garden-project.source-entry": `shoot "shoot" is not being restored`,
+		}))
+	})
+
+	It("should delete the report once there are no orphaned BackupEntries anymore", func() {
+		Expect(c.Create(ctx, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "orphaned-backupentries", Namespace: "seed-seed"},
+			Data:       map[string]string{"garden-project.no-owner": "no owning shoot"},
+		})).To(Succeed())
+
+		Expect(reconcileAndGetReport()).To(BeNil())
+	})
+
+	It("should delete orphaned BackupEntries if the deletion is confirmed", func() {
+		deletedShoot := shoot.DeepCopy()
+		deletedShoot.Name = "deleted"
+
+		confirmed := newBackupEntry("confirmed", seed.Name, deletedShoot)
+		confirmed.Annotations = map[string]string{"confirmation.gardener.cloud/deletion": "true"}
+		Expect(c.Create(ctx, confirmed)).To(Succeed())
+
+		owned := newBackupEntry("owned", seed.Name, shoot)
+		owned.Annotations = map[string]string{"confirmation.gardener.cloud/deletion": "true"}
+		Expect(c.Create(ctx, owned)).To(Succeed())
+
+		Expect(reconcileAndGetReport()).To(BeNil())
+
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(confirmed), confirmed)).To(BeNotFoundError())
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(owned), owned)).To(Succeed())
+	})
+})
+
+func newBackupEntry(name, seedName string, owner *gardencorev1beta1.Shoot) *gardencorev1beta1.BackupEntry {
+	backupEntry := &gardencorev1beta1.BackupEntry{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "garden-project"},
+		Spec:       gardencorev1beta1.BackupEntrySpec{SeedName: ptr.To(seedName)},
+	}
+
+	if owner != nil {
+		backupEntry.OwnerReferences = []metav1.OwnerReference{{
+			APIVersion: gardencorev1beta1.SchemeGroupVersion.String(),
+			Kind:       "Shoot",
+			Name:       owner.Name,
+			UID:        owner.UID,
+		}}
+	}
+
+	return backupEntry
+}
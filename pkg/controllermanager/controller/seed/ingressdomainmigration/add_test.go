@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ingressdomainmigration_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/seed/ingressdomainmigration"
+)
+
+var _ = Describe("Add", func() {
+	var (
+		reconciler *Reconciler
+		seed       *gardencorev1beta1.Seed
+	)
+
+	BeforeEach(func() {
+		reconciler = &Reconciler{}
+		seed = &gardencorev1beta1.Seed{}
+	})
+
+	Describe("#SeedPredicate", func() {
+		var p predicate.Predicate
+
+		BeforeEach(func() {
+			p = reconciler.SeedPredicate()
+		})
+
+		It("should return false if no migration was started", func() {
+			Expect(p.Create(event.CreateEvent{Object: seed})).To(BeFalse())
+			Expect(p.Update(event.UpdateEvent{ObjectNew: seed})).To(BeFalse())
+		})
+
+		It("should return false if the migration is completed", func() {
+			seed.Status.IngressDomainMigration = &gardencorev1beta1.SeedIngressDomainMigration{CompletionTime: ptr.To(metav1.Now())}
+
+			Expect(p.Create(event.CreateEvent{Object: seed})).To(BeFalse())
+			Expect(p.Update(event.UpdateEvent{ObjectNew: seed})).To(BeFalse())
+		})
+
+		It("should return true if the migration is in progress", func() {
+			seed.Status.IngressDomainMigration = &gardencorev1beta1.SeedIngressDomainMigration{}
+
+			Expect(p.Create(event.CreateEvent{Object: seed})).To(BeTrue())
+			Expect(p.Update(event.UpdateEvent{ObjectNew: seed})).To(BeTrue())
+		})
+	})
+})
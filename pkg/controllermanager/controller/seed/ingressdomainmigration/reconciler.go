@@ -0,0 +1,136 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ingressdomainmigration
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	"github.com/gardener/gardener/pkg/controllerutils"
+)
+
+// Reconciler reconciles Seeds whose ingress domain was changed. It triggers the reconciliation of the hosted Shoots in
+// batches so that their ingress resources, certificates and DNS records are switched to the new ingress domain, and it
+// reports the progress in the status of the Seed.
+type Reconciler struct {
+	Client client.Client
+	Config config.SeedIngressDomainMigrationControllerConfiguration
+	Clock  clock.Clock
+}
+
+// Reconcile reconciles Seeds whose ingress domain was changed.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, r.Config.SyncPeriod.Duration)
+	defer cancel()
+
+	seed := &gardencorev1beta1.Seed{}
+	if err := r.Client.Get(ctx, request.NamespacedName, seed); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if !migrationInProgress(seed) {
+		return reconcile.Result{}, nil
+	}
+	migration := seed.Status.IngressDomainMigration
+	log = log.WithValues("previousDomain", migration.PreviousDomain, "domain", migration.Domain)
+
+	// The ingress controller, the wildcard certificate and the DNS record of the seed have to be switched to the new
+	// ingress domain before the shoots can be migrated.
+	if !seedReconciled(seed) {
+		log.Info("Waiting for Seed to be reconciled with the new ingress domain")
+		return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+	}
+
+	shootList := &gardencorev1beta1.ShootList{}
+	if err := r.Client.List(ctx, shootList, client.MatchingFields{core.ShootStatusSeedName: seed.Name}); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed listing shoots hosted on seed: %w", err)
+	}
+
+	var (
+		shoots, migratedShoots int32
+		shootsInProgress       int
+		shootsToTrigger        []*gardencorev1beta1.Shoot
+	)
+
+	for i := range shootList.Items {
+		shoot := &shootList.Items[i]
+		if shoot.DeletionTimestamp != nil {
+			continue
+		}
+		shoots++
+
+		switch {
+		case shoot.Annotations[v1beta1constants.AnnotationShootIngressDomainMigration] != migration.Domain:
+			// Shoots with a pending operation are triggered as soon as the operation was picked up, otherwise the
+			// operation would be overwritten.
+			if _, ok := shoot.Annotations[v1beta1constants.GardenerOperation]; !ok {
+				shootsToTrigger = append(shootsToTrigger, shoot)
+			}
+		case shootReconciled(shoot):
+			migratedShoots++
+		case shoot.Status.LastOperation == nil || shoot.Status.LastOperation.State != gardencorev1beta1.LastOperationStateFailed:
+			// Failed shoots require manual intervention, hence they do not block the migration of further shoots.
+			shootsInProgress++
+		}
+	}
+
+	for _, shoot := range shootsToTrigger[:min(len(shootsToTrigger), max(0, ptr.Deref(r.Config.BatchSize, 1)-shootsInProgress))] {
+		patch := client.MergeFrom(shoot.DeepCopy())
+		metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.AnnotationShootIngressDomainMigration, migration.Domain)
+		metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.GardenerOperation, v1beta1constants.GardenerOperationReconcile)
+		if err := r.Client.Patch(ctx, shoot, patch); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed triggering reconciliation of shoot %s: %w", client.ObjectKeyFromObject(shoot), err)
+		}
+		log.Info("Triggered reconciliation of Shoot for migrating it to the new ingress domain", "shoot", client.ObjectKeyFromObject(shoot))
+	}
+
+	patch := client.MergeFromWithOptions(seed.DeepCopy(), client.MergeFromWithOptimisticLock{})
+	seed.Status.IngressDomainMigration.Shoots = shoots
+	seed.Status.IngressDomainMigration.MigratedShoots = migratedShoots
+	if migratedShoots == shoots {
+		seed.Status.IngressDomainMigration.CompletionTime = ptr.To(metav1.NewTime(r.Clock.Now().UTC()))
+	}
+	if err := r.Client.Status().Patch(ctx, seed, patch); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed updating ingress domain migration status: %w", err)
+	}
+
+	if seed.Status.IngressDomainMigration.CompletionTime != nil {
+		log.Info("Ingress domain migration completed", "shoots", shoots)
+		return reconcile.Result{}, nil
+	}
+
+	log.Info("Ingress domain migration in progress", "shoots", shoots, "migratedShoots", migratedShoots)
+	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+}
+
+func seedReconciled(seed *gardencorev1beta1.Seed) bool {
+	return seed.Status.ObservedGeneration >= seed.Generation &&
+		seed.Status.LastOperation != nil &&
+		seed.Status.LastOperation.State == gardencorev1beta1.LastOperationStateSucceeded
+}
+
+func shootReconciled(shoot *gardencorev1beta1.Shoot) bool {
+	return shoot.Status.ObservedGeneration >= shoot.Generation &&
+		shoot.Status.LastOperation != nil &&
+		shoot.Status.LastOperation.State == gardencorev1beta1.LastOperationStateSucceeded
+}
@@ -0,0 +1,213 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ingressdomainmigration_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/seed/ingressdomainmigration"
+)
+
+var _ = Describe("Reconciler", func() {
+	const (
+		syncPeriod = 30 * time.Second
+		namespace  = "garden-project"
+		domain     = "new.example.com"
+	)
+
+	var (
+		ctx = context.TODO()
+		c   client.Client
+
+		fakeClock  *testclock.FakeClock
+		reconciler reconcile.Reconciler
+		request    reconcile.Request
+
+		seed *gardencorev1beta1.Seed
+	)
+
+	BeforeEach(func() {
+		fakeClock = testclock.NewFakeClock(time.Now().Round(time.Second))
+
+		seed = &gardencorev1beta1.Seed{
+			ObjectMeta: metav1.ObjectMeta{Name: "seed", Generation: 2},
+			Spec: gardencorev1beta1.SeedSpec{
+				Ingress: &gardencorev1beta1.Ingress{Domain: domain},
+			},
+			Status: gardencorev1beta1.SeedStatus{
+				ObservedGeneration: 2,
+				LastOperation:      &gardencorev1beta1.LastOperation{State: gardencorev1beta1.LastOperationStateSucceeded},
+				IngressDomainMigration: &gardencorev1beta1.SeedIngressDomainMigration{
+					PreviousDomain: "old.example.com",
+					Domain:         domain,
+					StartTime:      metav1.NewTime(fakeClock.Now().Add(-time.Hour)),
+				},
+			},
+		}
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(seed)}
+	})
+
+	JustBeforeEach(func() {
+		c = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.GardenScheme).
+			WithObjects(seed).
+			WithStatusSubresource(seed).
+			WithIndex(&gardencorev1beta1.Shoot{}, core.ShootStatusSeedName, func(obj client.Object) []string {
+				shoot, ok := obj.(*gardencorev1beta1.Shoot)
+				if !ok {
+					return []string{""}
+				}
+				return []string{ptr.Deref(shoot.Status.SeedName, "")}
+			}).
+			Build()
+
+		reconciler = &Reconciler{
+			Client: c,
+			Config: config.SeedIngressDomainMigrationControllerConfiguration{
+				SyncPeriod: &metav1.Duration{Duration: syncPeriod},
+				BatchSize:  ptr.To(2),
+			},
+			Clock: fakeClock,
+		}
+	})
+
+	newShoot := func(name string, mutate func(*gardencorev1beta1.Shoot)) *gardencorev1beta1.Shoot {
+		shoot := &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Generation: 1},
+			Status: gardencorev1beta1.ShootStatus{
+				SeedName:           ptr.To(seed.Name),
+				ObservedGeneration: 1,
+				LastOperation:      &gardencorev1beta1.LastOperation{State: gardencorev1beta1.LastOperationStateSucceeded},
+			},
+		}
+		if mutate != nil {
+			mutate(shoot)
+		}
+		ExpectWithOffset(1, c.Create(ctx, shoot)).To(Succeed())
+		return shoot
+	}
+
+	migrated := func(shoot *gardencorev1beta1.Shoot) {
+		metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "shoot.gardener.cloud/ingress-domain-migration", domain)
+	}
+
+	inProgress := func(shoot *gardencorev1beta1.Shoot) {
+		migrated(shoot)
+		shoot.Generation = 2
+	}
+
+	triggered := func(shoot *gardencorev1beta1.Shoot) bool {
+		ExpectWithOffset(1, c.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+		return shoot.Annotations["shoot.gardener.cloud/ingress-domain-migration"] == domain &&
+			shoot.Annotations["gardener.cloud/operation"] == "reconcile"
+	}
+
+	It("should do nothing if the Seed is gone", func() {
+		Expect(c.Delete(ctx, seed)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+	})
+
+	It("should do nothing if no migration is in progress", func() {
+		seed.Status.IngressDomainMigration.CompletionTime = ptr.To(metav1.Now())
+		Expect(c.Status().Update(ctx, seed)).To(Succeed())
+		shoot := newShoot("shoot", nil)
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		Expect(triggered(shoot)).To(BeFalse())
+	})
+
+	It("should wait for the Seed to be reconciled with the new ingress domain", func() {
+		seed.Generation = 3
+		Expect(c.Update(ctx, seed)).To(Succeed())
+		shoot := newShoot("shoot", nil)
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+		Expect(triggered(shoot)).To(BeFalse())
+	})
+
+	It("should trigger the reconciliation of the Shoots in batches and report the progress", func() {
+		var shoots []*gardencorev1beta1.Shoot
+		for i := range 3 {
+			shoots = append(shoots, newShoot(fmt.Sprintf("shoot-%d", i), nil))
+		}
+		newShoot("migrated", migrated)
+		deletingShoot := newShoot("deleting", func(shoot *gardencorev1beta1.Shoot) {
+			shoot.Finalizers = []string{"gardener"}
+		})
+		Expect(c.Delete(ctx, deletingShoot)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		Expect(triggered(shoots[0])).To(BeTrue())
+		Expect(triggered(shoots[1])).To(BeTrue())
+		Expect(triggered(shoots[2])).To(BeFalse())
+
+		Expect(c.Get(ctx, request.NamespacedName, seed)).To(Succeed())
+		Expect(seed.Status.IngressDomainMigration.Shoots).To(Equal(int32(4)))
+		Expect(seed.Status.IngressDomainMigration.MigratedShoots).To(Equal(int32(1)))
+		Expect(seed.Status.IngressDomainMigration.CompletionTime).To(BeNil())
+	})
+
+	It("should not exceed the batch size with Shoots which are still being reconciled", func() {
+		newShoot("in-progress", inProgress)
+		newShoot("failed", func(shoot *gardencorev1beta1.Shoot) {
+			inProgress(shoot)
+			shoot.Status.LastOperation.State = gardencorev1beta1.LastOperationStateFailed
+		})
+		shoot1 := newShoot("shoot-1", nil)
+		shoot2 := newShoot("shoot-2", nil)
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		Expect(triggered(shoot1)).NotTo(Equal(triggered(shoot2)))
+	})
+
+	It("should not overwrite pending operations of Shoots", func() {
+		shoot := newShoot("shoot", func(shoot *gardencorev1beta1.Shoot) {
+			metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "gardener.cloud/operation", "rotate-credentials-start")
+		})
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+		Expect(shoot.Annotations).To(Equal(map[string]string{"gardener.cloud/operation": "rotate-credentials-start"}))
+	})
+
+	It("should complete the migration once all Shoots were migrated", func() {
+		newShoot("shoot-1", migrated)
+		newShoot("shoot-2", migrated)
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+		Expect(c.Get(ctx, request.NamespacedName, seed)).To(Succeed())
+		Expect(seed.Status.IngressDomainMigration.Shoots).To(Equal(int32(2)))
+		Expect(seed.Status.IngressDomainMigration.MigratedShoots).To(Equal(int32(2)))
+		Expect(seed.Status.IngressDomainMigration.CompletionTime).To(PointTo(HaveField("Time", BeTemporally("==", fakeClock.Now()))))
+	})
+
+	It("should complete the migration immediately if the Seed does not host any Shoots", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+		Expect(c.Get(ctx, request.NamespacedName, seed)).To(Succeed())
+		Expect(seed.Status.IngressDomainMigration.CompletionTime).NotTo(BeNil())
+	})
+})
@@ -8,12 +8,16 @@ import (
 	"context"
 	"fmt"
 
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/conditions"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/expiration"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/hibernation"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/maintenance"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/migration"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/pendingmaintenance"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/quota"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/reference"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/retry"
@@ -28,6 +32,12 @@ func AddToManager(ctx context.Context, mgr manager.Manager, cfg config.Controlle
 		return fmt.Errorf("failed adding conditions reconciler: %w", err)
 	}
 
+	if err := (&expiration.Reconciler{
+		Config: *cfg.Controllers.ShootExpiration,
+	}).AddToManager(mgr); err != nil {
+		return fmt.Errorf("failed adding expiration reconciler: %w", err)
+	}
+
 	if err := (&hibernation.Reconciler{
 		Config: cfg.Controllers.ShootHibernation,
 	}).AddToManager(mgr); err != nil {
@@ -40,6 +50,12 @@ func AddToManager(ctx context.Context, mgr manager.Manager, cfg config.Controlle
 		return fmt.Errorf("failed adding maintenance reconciler: %w", err)
 	}
 
+	if err := (&pendingmaintenance.Reconciler{
+		Config: *cfg.Controllers.ShootPendingMaintenance,
+	}).AddToManager(mgr); err != nil {
+		return fmt.Errorf("failed adding pending maintenance reconciler: %w", err)
+	}
+
 	if err := (&quota.Reconciler{
 		Config: *cfg.Controllers.ShootQuota,
 	}).AddToManager(mgr); err != nil {
@@ -62,5 +78,13 @@ func AddToManager(ctx context.Context, mgr manager.Manager, cfg config.Controlle
 		return fmt.Errorf("failed adding statuslabel reconciler: %w", err)
 	}
 
+	if cfg.Controllers.ShootMigration != nil && ptr.Deref(cfg.Controllers.ShootMigration.ConcurrentSyncs, 0) > 0 {
+		if err := (&migration.Reconciler{
+			Config: *cfg.Controllers.ShootMigration,
+		}).AddToManager(mgr); err != nil {
+			return fmt.Errorf("failed adding migration reconciler: %w", err)
+		}
+	}
+
 	return nil
 }
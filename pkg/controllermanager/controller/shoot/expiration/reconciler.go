@@ -0,0 +1,122 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package expiration
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+// Reconciler reconciles Shoots and deletes them once their expiration timestamp has passed.
+type Reconciler struct {
+	Client   client.Client
+	Config   config.ShootExpirationControllerConfiguration
+	Clock    clock.Clock
+	Recorder record.EventRecorder
+}
+
+// Reconcile reconciles Shoots and deletes them once their expiration timestamp has passed.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
+	defer cancel()
+
+	shoot := &gardencorev1beta1.Shoot{}
+	if err := r.Client.Get(ctx, request.NamespacedName, shoot); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if shoot.Spec.ExpirationTimestamp == nil || shoot.DeletionTimestamp != nil {
+		return reconcile.Result{}, nil
+	}
+
+	var (
+		expirationTime = shoot.Spec.ExpirationTimestamp.UTC()
+		expiresIn      = expirationTime.Sub(r.Clock.Now().UTC())
+		warningPeriod  = ptr.Deref(r.Config.WarningPeriod, metav1.Duration{}).Duration
+	)
+
+	if expiresIn > 0 {
+		if expiresIn > warningPeriod {
+			return reconcile.Result{RequeueAfter: expiresIn - warningPeriod}, nil
+		}
+
+		r.Recorder.Eventf(shoot, corev1.EventTypeWarning, gardencorev1beta1.ShootEventExpiring, "Shoot expires at %s and will be deleted afterwards", expirationTime.Format(time.RFC3339))
+		return reconcile.Result{RequeueAfter: expiresIn}, nil
+	}
+
+	dualApprovalRequired, err := r.deletionMustBeDualApproved(ctx, shoot)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if dualApprovalRequired {
+		log.Info("Shoot expired but its deletion requires a dual approval, skipping deletion", "expirationTime", expirationTime)
+		r.Recorder.Eventf(shoot, corev1.EventTypeWarning, gardencorev1beta1.ShootEventExpiredDeletionNotApproved, "Shoot expired at %s but is not deleted automatically since its deletion requires a dual approval", expirationTime.Format(time.RFC3339))
+		return reconcile.Result{}, nil
+	}
+
+	log.Info("Shoot expired, deleting Shoot", "expirationTime", expirationTime)
+	r.Recorder.Eventf(shoot, corev1.EventTypeWarning, gardencorev1beta1.ShootEventExpired, "Shoot expired at %s, deleting it", expirationTime.Format(time.RFC3339))
+
+	// We have to annotate the Shoot to confirm the deletion.
+	if err := gardenerutils.ConfirmDeletion(ctx, r.Client, shoot); err != nil {
+		if apierrors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	// Now we are allowed to delete the Shoot (to set the deletionTimestamp).
+	return reconcile.Result{}, client.IgnoreNotFound(r.Client.Delete(ctx, shoot))
+}
+
+// deletionMustBeDualApproved checks whether the project of the shoot requires a dual approval for its deletion. In this
+// case, the controller cannot delete the shoot on its own.
+func (r *Reconciler) deletionMustBeDualApproved(ctx context.Context, shoot *gardencorev1beta1.Shoot) (bool, error) {
+	project, err := gardenerutils.ProjectForNamespaceFromReader(ctx, r.Client, shoot.Namespace)
+	if err != nil {
+		return false, fmt.Errorf("failed getting project for namespace %s: %w", shoot.Namespace, err)
+	}
+
+	for _, dualApproval := range project.Spec.DualApprovalForDeletion {
+		if dualApproval.Resource != "shoots" {
+			continue
+		}
+
+		selector, err := metav1.LabelSelectorAsSelector(&dualApproval.Selector)
+		if err != nil {
+			return false, fmt.Errorf("failed parsing label selector for dual approval of shoots: %w", err)
+		}
+
+		if selector.Matches(labels.Set(shoot.Labels)) && ptr.Deref(dualApproval.IncludeServiceAccounts, true) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
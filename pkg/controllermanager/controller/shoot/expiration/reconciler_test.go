@@ -0,0 +1,170 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package expiration_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/pkg/api/indexer"
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/shoot/expiration"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx          = context.TODO()
+		now          = time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+		fakeClock    *testclock.FakeClock
+		fakeClient   client.Client
+		fakeRecorder *record.FakeRecorder
+		reconciler   *Reconciler
+
+		project *gardencorev1beta1.Project
+		shoot   *gardencorev1beta1.Shoot
+	)
+
+	BeforeEach(func() {
+		fakeClock = testclock.NewFakeClock(now)
+		fakeClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.GardenScheme).
+			WithIndex(&gardencorev1beta1.Project{}, core.ProjectNamespace, indexer.ProjectNamespaceIndexerFunc).
+			Build()
+		fakeRecorder = record.NewFakeRecorder(1)
+
+		reconciler = &Reconciler{
+			Client:   fakeClient,
+			Clock:    fakeClock,
+			Recorder: fakeRecorder,
+			Config: config.ShootExpirationControllerConfiguration{
+				ConcurrentSyncs: ptr.To(1),
+				WarningPeriod:   &metav1.Duration{Duration: time.Hour},
+			},
+		}
+
+		project = &gardencorev1beta1.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+			Spec:       gardencorev1beta1.ProjectSpec{Namespace: ptr.To("garden-foo")},
+		}
+		Expect(fakeClient.Create(ctx, project)).To(Succeed())
+
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "garden-foo", Labels: map[string]string{"purpose": "ci"}},
+			Spec: gardencorev1beta1.ShootSpec{
+				ExpirationTimestamp: &metav1.Time{Time: now.Add(3 * time.Hour)},
+			},
+		}
+		Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+	})
+
+	reconcileShoot := func() reconcile.Result {
+		result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)})
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		return result
+	}
+
+	It("should do nothing if the shoot has no expiration timestamp", func() {
+		shoot.Spec.ExpirationTimestamp = nil
+		Expect(fakeClient.Update(ctx, shoot)).To(Succeed())
+
+		Expect(reconcileShoot()).To(Equal(reconcile.Result{}))
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+		Expect(fakeRecorder.Events).To(BeEmpty())
+	})
+
+	It("should requeue until the warning period starts", func() {
+		Expect(reconcileShoot()).To(Equal(reconcile.Result{RequeueAfter: 2 * time.Hour}))
+		Expect(fakeRecorder.Events).To(BeEmpty())
+	})
+
+	It("should emit a warning event within the warning period", func() {
+		fakeClock.Step(150 * time.Minute)
+
+		Expect(reconcileShoot()).To(Equal(reconcile.Result{RequeueAfter: 30 * time.Minute}))
+		Expect(fakeRecorder.Events).To(Receive(Equal("Warning Expiring Shoot expires at 2024-01-01T15:00:00Z and will be deleted afterwards")))
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+	})
+
+	It("should delete the shoot once it has expired", func() {
+		fakeClock.Step(3*time.Hour + time.Second)
+
+		Expect(reconcileShoot()).To(Equal(reconcile.Result{}))
+		Expect(fakeRecorder.Events).To(Receive(Equal("Warning Expired Shoot expired at 2024-01-01T15:00:00Z, deleting it")))
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(BeNotFoundError())
+	})
+
+	It("should not delete the shoot if its deletion requires a dual approval", func() {
+		project.Spec.DualApprovalForDeletion = []gardencorev1beta1.DualApprovalForDeletion{{
+			Resource: "shoots",
+			Selector: metav1.LabelSelector{MatchLabels: map[string]string{"purpose": "ci"}},
+		}}
+		Expect(fakeClient.Update(ctx, project)).To(Succeed())
+		fakeClock.Step(3*time.Hour + time.Second)
+
+		Expect(reconcileShoot()).To(Equal(reconcile.Result{}))
+		Expect(fakeRecorder.Events).To(Receive(Equal("Warning ExpiredDeletionNotApproved Shoot expired at 2024-01-01T15:00:00Z but is not deleted automatically since its deletion requires a dual approval")))
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+		Expect(shoot.DeletionTimestamp).To(BeNil())
+	})
+
+	It("should delete the shoot if the dual approval does not apply to it", func() {
+		project.Spec.DualApprovalForDeletion = []gardencorev1beta1.DualApprovalForDeletion{{
+			Resource: "shoots",
+			Selector: metav1.LabelSelector{MatchLabels: map[string]string{"purpose": "production"}},
+		}}
+		Expect(fakeClient.Update(ctx, project)).To(Succeed())
+		fakeClock.Step(3*time.Hour + time.Second)
+
+		Expect(reconcileShoot()).To(Equal(reconcile.Result{}))
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(BeNotFoundError())
+	})
+
+	Describe("#ShootPredicate", func() {
+		var oldShoot *gardencorev1beta1.Shoot
+
+		BeforeEach(func() {
+			oldShoot = shoot.DeepCopy()
+		})
+
+		It("should return true for created shoots with an expiration timestamp", func() {
+			Expect(reconciler.ShootPredicate().Create(event.CreateEvent{Object: shoot})).To(BeTrue())
+		})
+
+		It("should return false for created shoots without an expiration timestamp", func() {
+			shoot.Spec.ExpirationTimestamp = nil
+			Expect(reconciler.ShootPredicate().Create(event.CreateEvent{Object: shoot})).To(BeFalse())
+		})
+
+		It("should return true if the expiration timestamp changed", func() {
+			shoot.Spec.ExpirationTimestamp = &metav1.Time{Time: now.Add(time.Hour)}
+			Expect(reconciler.ShootPredicate().Update(event.UpdateEvent{ObjectOld: oldShoot, ObjectNew: shoot})).To(BeTrue())
+		})
+
+		It("should return false if the expiration timestamp did not change", func() {
+			shoot.Labels["foo"] = "bar"
+			Expect(reconciler.ShootPredicate().Update(event.UpdateEvent{ObjectOld: oldShoot, ObjectNew: shoot})).To(BeFalse())
+		})
+
+		It("should return false if the expiration timestamp was removed", func() {
+			shoot.Spec.ExpirationTimestamp = nil
+			Expect(reconciler.ShootPredicate().Update(event.UpdateEvent{ObjectOld: oldShoot, ObjectNew: shoot})).To(BeFalse())
+		})
+	})
+})
@@ -42,9 +42,38 @@ const (
 // parsedHibernationSchedule holds the loaded location, parsed cron schedule and information whether
 // the cluster should be hibernated or woken up.
 type parsedHibernationSchedule struct {
-	location  time.Location
-	schedule  cron.Schedule
-	operation operation
+	location   time.Location
+	schedule   cron.Schedule
+	operation  operation
+	exceptions []parsedHibernationScheduleException
+}
+
+// parsedHibernationScheduleException holds an exception of a hibernation schedule. The schedule is only set if the
+// exception is a cron spec, otherwise the exception is a date.
+type parsedHibernationScheduleException struct {
+	value    string
+	schedule cron.Schedule
+}
+
+// exception returns the exception which matches the given time 't' or nil if no exception matches.
+// The input 't' is converted in the schedule's location before any calculations are done.
+func (s *parsedHibernationSchedule) exception(t time.Time) *string {
+	t = t.In(&s.location)
+
+	for _, exception := range s.exceptions {
+		if exception.schedule == nil {
+			if t.Format(gardencorev1beta1.HibernationScheduleExceptionDateLayout) == exception.value {
+				return &exception.value
+			}
+			continue
+		}
+
+		if minute := t.Truncate(time.Minute); exception.schedule.Next(minute.Add(-time.Second)).Equal(minute) {
+			return &exception.value
+		}
+	}
+
+	return nil
 }
 
 // next returns the time in UTC from the schedule, that is immediately after the input time 't'.
@@ -119,12 +148,19 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	// Get the schedule which caused the current reconciliation and check whether the shoot should be hibernated or woken up.
 	// If no such schedule is found, the hibernation schedules were changed mid-air and the shoot must be
 	// hibernated or wakeup the at a later time.
-	mostRecentSchedule := getScheduleWithMostRecentTime(parsedSchedules, r.Config.TriggerDeadlineDuration, shoot, now)
+	mostRecentSchedule, mostRecentTime := getScheduleWithMostRecentTime(parsedSchedules, r.Config.TriggerDeadlineDuration, shoot, now)
 	if mostRecentSchedule != nil {
-		if err := r.hibernateOrWakeUpShootBasedOnSchedule(ctx, shoot, mostRecentSchedule, now); err != nil {
-			return reconcile.Result{}, err
+		if exception := mostRecentSchedule.exception(*mostRecentTime); exception != nil {
+			if err := r.skipHibernation(ctx, shoot, *exception, now); err != nil {
+				return reconcile.Result{}, err
+			}
+			log.Info("Skipped hibernation due to exception of schedule", "exception", *exception)
+		} else {
+			if err := r.hibernateOrWakeUpShootBasedOnSchedule(ctx, shoot, mostRecentSchedule, now); err != nil {
+				return reconcile.Result{}, err
+			}
+			log.Info("Successfully set hibernation.enabled", "enabled", *shoot.Spec.Hibernation.Enabled)
 		}
-		log.Info("Successfully set hibernation.enabled", "enabled", *shoot.Spec.Hibernation.Enabled)
 	}
 
 	requeueAfter := nextHibernationTimeDuration(parsedSchedules, now)
@@ -151,6 +187,16 @@ func (r *Reconciler) hibernateOrWakeUpShootBasedOnSchedule(ctx context.Context,
 	return r.Client.Status().Patch(ctx, shoot, patch)
 }
 
+// skipHibernation records that a hibernation was skipped due to the given exception. The trigger time is updated so
+// that the skipped hibernation is not considered again.
+func (r *Reconciler) skipHibernation(ctx context.Context, shoot *gardencorev1beta1.Shoot, exception string, now time.Time) error {
+	r.Recorder.Eventf(shoot, corev1.EventTypeNormal, gardencorev1beta1.ShootEventHibernationSkipped, "Skipping hibernation of cluster due to exception %q of schedule", exception)
+
+	patch := client.MergeFrom(shoot.DeepCopy())
+	shoot.Status.LastHibernationTriggerTime = &metav1.Time{Time: now}
+	return r.Client.Status().Patch(ctx, shoot, patch)
+}
+
 // parseHibernationSchedules parses the given HibernationSchedules and returns an array of ParsedHibernationSchedules
 // If the Location of a HibernationSchedule is `nil`, it is defaulted to UTC.
 func parseHibernationSchedules(schedules []gardencorev1beta1.HibernationSchedule) ([]parsedHibernationSchedule, error) {
@@ -172,8 +218,14 @@ func parseHibernationSchedules(schedules []gardencorev1beta1.HibernationSchedule
 			if err != nil {
 				return nil, err
 			}
+
+			exceptions, err := parseHibernationScheduleExceptions(schedule.Exceptions)
+			if err != nil {
+				return nil, err
+			}
+
 			parsedHibernationSchedules = append(parsedHibernationSchedules,
-				parsedHibernationSchedule{location: *location, schedule: parsed, operation: hibernate},
+				parsedHibernationSchedule{location: *location, schedule: parsed, operation: hibernate, exceptions: exceptions},
 			)
 		}
 
@@ -191,6 +243,27 @@ func parseHibernationSchedules(schedules []gardencorev1beta1.HibernationSchedule
 	return parsedHibernationSchedules, nil
 }
 
+// parseHibernationScheduleExceptions parses the given exceptions of a HibernationSchedule. An exception is either a
+// date or a cron spec.
+func parseHibernationScheduleExceptions(exceptions []string) ([]parsedHibernationScheduleException, error) {
+	var parsedExceptions []parsedHibernationScheduleException
+
+	for _, exception := range exceptions {
+		if _, err := time.Parse(gardencorev1beta1.HibernationScheduleExceptionDateLayout, exception); err == nil {
+			parsedExceptions = append(parsedExceptions, parsedHibernationScheduleException{value: exception})
+			continue
+		}
+
+		parsed, err := cron.ParseStandard(exception)
+		if err != nil {
+			return nil, err
+		}
+		parsedExceptions = append(parsedExceptions, parsedHibernationScheduleException{value: exception, schedule: parsed})
+	}
+
+	return parsedExceptions, nil
+}
+
 // nextHibernationTimeDuration returns the time duration after which to requeue the shoot based on the hibernation schedules and current time.
 // It adds a 100ms padding to the next requeue to account for Network Time Protocol(NTP) time skews.
 // If the time drifts are adjusted which in most realistic cases would be around 100ms, scheduled hibernation
@@ -208,8 +281,9 @@ func nextHibernationTimeDuration(schedules []parsedHibernationSchedule, now time
 	return timeStamps[0].Add(nextScheduleDelta).Sub(now)
 }
 
-// getScheduleWithMostRecentTime returns the ParsedHibernationSchedule that contains the schedule with the most recent (previous) execution time.
-func getScheduleWithMostRecentTime(schedules []parsedHibernationSchedule, triggerDeadlineDuration *metav1.Duration, shoot *gardencorev1beta1.Shoot, now time.Time) *parsedHibernationSchedule {
+// getScheduleWithMostRecentTime returns the ParsedHibernationSchedule that contains the schedule with the most recent (previous) execution time
+// together with this execution time.
+func getScheduleWithMostRecentTime(schedules []parsedHibernationSchedule, triggerDeadlineDuration *metav1.Duration, shoot *gardencorev1beta1.Shoot, now time.Time) (*parsedHibernationSchedule, *time.Time) {
 	// If the shoot has just been created or has never been hibernated, use the creation timestamp.
 	earliestTime := shoot.CreationTimestamp.Time
 	if shoot.Status.LastHibernationTriggerTime != nil {
@@ -230,26 +304,22 @@ func getScheduleWithMostRecentTime(schedules []parsedHibernationSchedule, trigge
 
 	// Iterate over all schedules that were parsed from the shoot specification until we find one that contains
 	// a time entry between `earliestTime` and `now`` and that time entry is the latest one (most recent) with respect to `now`
-	var scheduleWithMostRecentTime *parsedHibernationSchedule
+	var (
+		scheduleWithMostRecentTime *parsedHibernationSchedule
+		mostRecentTime             *time.Time
+	)
 	for i := range schedules {
 		cur := schedules[i].previous(earliestTime, now)
 		if cur == nil {
 			continue
 		}
-		if scheduleWithMostRecentTime == nil {
-			scheduleWithMostRecentTime = &schedules[i]
-			continue
-		}
-		mostRecentTime := scheduleWithMostRecentTime.previous(earliestTime, now)
-		if mostRecentTime == nil {
-			continue
-		}
-		if cur.After(*mostRecentTime) {
+		if scheduleWithMostRecentTime == nil || cur.After(*mostRecentTime) {
 			scheduleWithMostRecentTime = &schedules[i]
+			mostRecentTime = cur
 		}
 	}
 
-	return scheduleWithMostRecentTime
+	return scheduleWithMostRecentTime, mostRecentTime
 }
 
 func getShootHibernationSchedules(hibernation *gardencorev1beta1.Hibernation) []gardencorev1beta1.HibernationSchedule {
@@ -130,6 +130,40 @@ var _ = Describe("Shoot Hibernation", func() {
 				Expect(prev).To(BeNil())
 			})
 		})
+
+		Describe("#exception", func() {
+			It("should return the date exception matching the given time in the schedule's location", func() {
+				parsedSchedule := parsedHibernationSchedule{
+					location:   mustLoadLocation(locationEUSofia),
+					schedule:   mustParseStandard(everyDayAt2),
+					exceptions: []parsedHibernationScheduleException{{value: "20220411"}, {value: "20220412"}},
+				}
+
+				Expect(parsedSchedule.exception(mustParseRFC3339Time(weekDayAt7))).To(PointTo(Equal("20220412")))
+				// 2022-04-12T22:00:00Z is already the 13th of April in Europe/Sofia.
+				Expect(parsedSchedule.exception(mustParseRFC3339Time(weekDayAt19).Add(3 * time.Hour))).To(BeNil())
+			})
+
+			It("should return the cron exception matching the given time", func() {
+				parsedSchedule := parsedHibernationSchedule{
+					location:   mustLoadLocation("UTC"),
+					schedule:   mustParseStandard(everyDayAt7),
+					exceptions: []parsedHibernationScheduleException{{value: "* * 12 4 *", schedule: mustParseStandard("* * 12 4 *")}},
+				}
+
+				Expect(parsedSchedule.exception(mustParseRFC3339Time(weekDayAt7))).To(PointTo(Equal("* * 12 4 *")))
+				Expect(parsedSchedule.exception(mustParseRFC3339Time(weekDayAt7).Add(24 * time.Hour))).To(BeNil())
+			})
+
+			It("should return nil if there are no exceptions", func() {
+				parsedSchedule := parsedHibernationSchedule{
+					location: mustLoadLocation("UTC"),
+					schedule: mustParseStandard(everyDayAt7),
+				}
+
+				Expect(parsedSchedule.exception(mustParseRFC3339Time(weekDayAt7))).To(BeNil())
+			})
+		})
 	})
 
 	Context("Shoot hibernation reconciliation", func() {
@@ -416,7 +450,51 @@ var _ = Describe("Shoot Hibernation", func() {
 					triggerDeadlineDuration:     shortDeadline,
 					expectedRequeueDurationFunc: requeueAfterBasedOnSchedule(everyDayAt2, "UTC"),
 				}),
+				Entry("when shoot should be woken up on a date which is an exception of the schedule", testEntry{
+					timeNow: timeWithOffset(weekDayAt2, 0),
+					shootSettings: func(shoot *gardencorev1beta1.Shoot) {
+						shoot.CreationTimestamp = metav1.Time{Time: timeWithOffset(weekDayAt2, -24*time.Hour)()}
+						shoot.Spec.Hibernation.Schedules = []gardencorev1beta1.HibernationSchedule{{
+							Start:      &everyDayAt7,
+							End:        &everyDayAt2,
+							Exceptions: []string{"20220412"},
+						}}
+					},
+					triggerDeadlineDuration:     noDeadLine,
+					triggerHibernationOrWakeup:  true,
+					expectedHibernationEnabled:  false,
+					expectedRequeueDurationFunc: requeueAfterBasedOnSchedule(everyDayAt7, "UTC"),
+				}),
 			)
+
+			It("should skip the hibernation if the schedule has a matching exception", func() {
+				timeNow := mustParseRFC3339Time(weekDayAt7)
+				fakeClock = testclock.NewFakeClock(timeNow)
+				fakeRecorder := record.NewFakeRecorder(1)
+
+				shoot.CreationTimestamp = metav1.Time{Time: timeNow.Add(-24 * time.Hour)}
+				shoot.Spec.Hibernation.Schedules = []gardencorev1beta1.HibernationSchedule{{
+					Start:      &everyDayAt7,
+					End:        &everyDayAt2,
+					Exceptions: []string{"20220412"},
+				}}
+				Expect(c.Create(ctx, shoot)).To(Succeed())
+
+				reconciler := &Reconciler{
+					Client:   c,
+					Recorder: fakeRecorder,
+					Clock:    fakeClock,
+				}
+
+				result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result).To(Equal(reconcile.Result{RequeueAfter: requeueAfterBasedOnSchedule(everyDayAt2, "UTC")(timeNow)}))
+
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+				Expect(shoot.Spec.Hibernation.Enabled).To(BeNil())
+				Expect(shoot.Status.LastHibernationTriggerTime.Time.UTC()).To(Equal(timeNow))
+				Expect(fakeRecorder.Events).To(Receive(ContainSubstring(gardencorev1beta1.ShootEventHibernationSkipped)))
+			})
 		})
 	})
 })
@@ -57,7 +57,8 @@ func (r *Reconciler) ShootPredicate() predicate.Predicate {
 			}
 
 			return (hasMaintainNowAnnotation(shoot) && !hasMaintainNowAnnotation(oldShoot)) ||
-				!apiequality.Semantic.DeepEqual(oldShoot.Spec.Maintenance.TimeWindow, shoot.Spec.Maintenance.TimeWindow)
+				!apiequality.Semantic.DeepEqual(oldShoot.Spec.Maintenance.TimeWindow, shoot.Spec.Maintenance.TimeWindow) ||
+				!apiequality.Semantic.DeepEqual(oldShoot.Spec.Maintenance.WorkerPools, shoot.Spec.Maintenance.WorkerPools)
 		},
 	}
 }
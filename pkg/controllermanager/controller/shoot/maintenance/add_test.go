@@ -82,6 +82,15 @@ var _ = Describe("Add", func() {
 				shoot.Spec.Maintenance.TimeWindow.End = "456"
 				Expect(p.Update(event.UpdateEvent{ObjectNew: shoot, ObjectOld: oldShoot})).To(BeTrue())
 			})
+
+			It("should return true because there is a worker pool time window change", func() {
+				oldShoot := shoot.DeepCopy()
+				shoot.Spec.Maintenance.WorkerPools = []gardencorev1beta1.WorkerPoolMaintenance{{
+					Name:       "worker",
+					TimeWindow: gardencorev1beta1.MaintenanceTimeWindow{Begin: "123", End: "456"},
+				}}
+				Expect(p.Update(event.UpdateEvent{ObjectNew: shoot, ObjectOld: oldShoot})).To(BeTrue())
+			})
 		})
 
 		Describe("#Delete", func() {
@@ -0,0 +1,138 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package maintenance
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	"github.com/gardener/gardener/pkg/utils/timewindow"
+)
+
+// PendingOperations computes the updates which will be applied to the given Shoot in its next maintenance time
+// window(s) following the given time. The expiration dates of versions in the CloudProfile are evaluated at the
+// beginning of the respective maintenance time window.
+func PendingOperations(shoot *gardencorev1beta1.Shoot, cloudProfile *gardencorev1beta1.CloudProfile, now time.Time) ([]gardencorev1beta1.PendingMaintenanceOperation, error) {
+	// the shoot itself and all worker pools without an own time window are maintained in the time window of the shoot
+	workerPools := sets.New[string]()
+	for _, worker := range shoot.Spec.Provider.Workers {
+		if !hasWorkerPoolMaintenanceTimeWindow(shoot, worker.Name) {
+			workerPools.Insert(worker.Name)
+		}
+	}
+
+	operations, err := pendingOperations(shoot, cloudProfile, true, workerPools, nextMaintenanceTime(gardenerutils.EffectiveShootMaintenanceTimeWindow(shoot), now))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, worker := range shoot.Spec.Provider.Workers {
+		if !hasWorkerPoolMaintenanceTimeWindow(shoot, worker.Name) {
+			continue
+		}
+
+		workerPoolOperations, err := pendingOperations(shoot, cloudProfile, false, sets.New(worker.Name), nextMaintenanceTime(gardenerutils.EffectiveWorkerPoolMaintenanceTimeWindow(shoot, worker.Name), now))
+		if err != nil {
+			return nil, err
+		}
+		operations = append(operations, workerPoolOperations...)
+	}
+
+	return operations, nil
+}
+
+func pendingOperations(shoot *gardencorev1beta1.Shoot, cloudProfile *gardencorev1beta1.CloudProfile, maintainShoot bool, workerPools sets.Set[string], scheduledTime time.Time) ([]gardencorev1beta1.PendingMaintenanceOperation, error) {
+	maintainedShoot := shoot.DeepCopy()
+
+	result, err := maintain(logr.Discard(), maintainedShoot, cloudProfile, maintainShoot, workerPools, scheduledTime)
+	if err != nil {
+		return nil, err
+	}
+
+	var operations []gardencorev1beta1.PendingMaintenanceOperation
+
+	if result.kubernetesControlPlaneUpdate != nil {
+		operations = appendPendingVersionUpdate(operations, gardencorev1beta1.PendingMaintenanceOperationKubernetesVersion, nil, "Kubernetes version",
+			shoot.Spec.Kubernetes.Version, maintainedShoot.Spec.Kubernetes.Version, *result.kubernetesControlPlaneUpdate, scheduledTime)
+	}
+
+	for i, worker := range shoot.Spec.Provider.Workers {
+		if update, ok := result.workerToKubernetesUpdate[worker.Name]; ok {
+			operations = appendPendingVersionUpdate(operations, gardencorev1beta1.PendingMaintenanceOperationKubernetesVersion, &worker.Name, "Kubernetes version",
+				ptr.Deref(worker.Kubernetes.Version, ""), ptr.Deref(maintainedShoot.Spec.Provider.Workers[i].Kubernetes.Version, ""), update, scheduledTime)
+		}
+
+		if update, ok := result.workerToMachineImageUpdate[worker.Name]; ok {
+			operations = appendPendingVersionUpdate(operations, gardencorev1beta1.PendingMaintenanceOperationMachineImageVersion, &worker.Name, fmt.Sprintf("Machine image %q", worker.Machine.Image.Name),
+				ptr.Deref(worker.Machine.Image.Version, ""), ptr.Deref(maintainedShoot.Spec.Provider.Workers[i].Machine.Image.Version, ""), update, scheduledTime)
+		}
+	}
+
+	for _, operation := range result.operations {
+		operations = append(operations, gardencorev1beta1.PendingMaintenanceOperation{
+			Type:          gardencorev1beta1.PendingMaintenanceOperationConfiguration,
+			Forced:        true,
+			Description:   operation,
+			ScheduledTime: metav1.NewTime(scheduledTime),
+		})
+	}
+
+	return operations, nil
+}
+
+func appendPendingVersionUpdate(
+	operations []gardencorev1beta1.PendingMaintenanceOperation,
+	operationType gardencorev1beta1.PendingMaintenanceOperationType,
+	workerPool *string,
+	subject string,
+	currentVersion string,
+	targetVersion string,
+	update updateResult,
+	scheduledTime time.Time,
+) []gardencorev1beta1.PendingMaintenanceOperation {
+	operation := gardencorev1beta1.PendingMaintenanceOperation{
+		Type:           operationType,
+		WorkerPool:     workerPool,
+		CurrentVersion: &currentVersion,
+		Forced:         update.isForced,
+		ScheduledTime:  metav1.NewTime(scheduledTime),
+	}
+
+	switch {
+	case !update.isSuccessful:
+		operation.Description = fmt.Sprintf("%s cannot be updated, hence the maintenance will fail: %s. Reason for update: %s", subject, update.description, update.reason)
+	case targetVersion == currentVersion:
+		// worker pools are not updated to a higher Kubernetes version than the control plane
+		return operations
+	default:
+		operation.TargetVersion = &targetVersion
+		operation.Description = fmt.Sprintf("%s will be updated from %q to %q. Reason: %s", subject, currentVersion, targetVersion, update.reason)
+	}
+
+	return append(operations, operation)
+}
+
+// nextMaintenanceTime returns the beginning of the next occurrence of the given maintenance time window, or the given
+// time if it is contained in the time window.
+func nextMaintenanceTime(window *timewindow.MaintenanceTimeWindow, now time.Time) time.Time {
+	// timestamps are serialized with a precision of seconds
+	now = now.UTC().Truncate(time.Second)
+	if window.Contains(now) {
+		return now
+	}
+
+	begin := window.AdjustedBegin(now)
+	if begin.Before(now) {
+		begin = begin.AddDate(0, 0, 1)
+	}
+	return begin
+}
@@ -0,0 +1,201 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package maintenance_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/shoot/maintenance"
+)
+
+var _ = Describe("PendingOperations", func() {
+	var (
+		nextMaintenance = metav1.NewTime(time.Date(2024, 1, 1, 22, 0, 0, 0, time.UTC))
+
+		now          time.Time
+		cloudProfile *gardencorev1beta1.CloudProfile
+		shoot        *gardencorev1beta1.Shoot
+	)
+
+	BeforeEach(func() {
+		now = time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC)
+
+		cloudProfile = &gardencorev1beta1.CloudProfile{
+			Spec: gardencorev1beta1.CloudProfileSpec{
+				Kubernetes: gardencorev1beta1.KubernetesSettings{
+					Versions: []gardencorev1beta1.ExpirableVersion{
+						{Version: "1.26.5"},
+						{Version: "1.27.3"},
+						{Version: "1.30.1"},
+						{Version: "1.30.5"},
+					},
+				},
+				MachineImages: []gardencorev1beta1.MachineImage{{
+					Name:           "gardenlinux",
+					UpdateStrategy: ptr.To(gardencorev1beta1.UpdateStrategyMajor),
+					Versions: []gardencorev1beta1.MachineImageVersion{
+						{
+							ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "1.0.0"},
+							CRI:              []gardencorev1beta1.CRI{{Name: gardencorev1beta1.CRINameContainerD}},
+							Architectures:    []string{"amd64"},
+						},
+						{
+							ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "1.1.0"},
+							CRI:              []gardencorev1beta1.CRI{{Name: gardencorev1beta1.CRINameContainerD}},
+							Architectures:    []string{"amd64"},
+						},
+					},
+				}},
+			},
+		}
+
+		shoot = &gardencorev1beta1.Shoot{
+			Spec: gardencorev1beta1.ShootSpec{
+				Kubernetes: gardencorev1beta1.Kubernetes{Version: "1.30.1"},
+				Maintenance: &gardencorev1beta1.Maintenance{
+					AutoUpdate: &gardencorev1beta1.MaintenanceAutoUpdate{
+						KubernetesVersion:   false,
+						MachineImageVersion: ptr.To(false),
+					},
+					TimeWindow: &gardencorev1beta1.MaintenanceTimeWindow{Begin: "220000+0000", End: "230000+0000"},
+				},
+				Provider: gardencorev1beta1.Provider{
+					Workers: []gardencorev1beta1.Worker{{
+						Name: "worker",
+						Machine: gardencorev1beta1.Machine{
+							Image:        &gardencorev1beta1.ShootMachineImage{Name: "gardenlinux", Version: ptr.To("1.0.0")},
+							Architecture: ptr.To("amd64"),
+						},
+					}},
+				},
+			},
+		}
+	})
+
+	It("should not report any operation if no update is due", func() {
+		Expect(PendingOperations(shoot, cloudProfile, now)).To(BeEmpty())
+	})
+
+	It("should report automatic updates of the Kubernetes and machine image versions", func() {
+		shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion = true
+		shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion = ptr.To(true)
+
+		Expect(PendingOperations(shoot, cloudProfile, now)).To(ConsistOf(
+			gardencorev1beta1.PendingMaintenanceOperation{
+				Type:           gardencorev1beta1.PendingMaintenanceOperationKubernetesVersion,
+				CurrentVersion: ptr.To("1.30.1"),
+				TargetVersion:  ptr.To("1.30.5"),
+				Description:    `Kubernetes version will be updated from "1.30.1" to "1.30.5". Reason: Automatic update of Kubernetes version configured`,
+				ScheduledTime:  nextMaintenance,
+			},
+			gardencorev1beta1.PendingMaintenanceOperation{
+				Type:           gardencorev1beta1.PendingMaintenanceOperationMachineImageVersion,
+				WorkerPool:     ptr.To("worker"),
+				CurrentVersion: ptr.To("1.0.0"),
+				TargetVersion:  ptr.To("1.1.0"),
+				Description:    `Machine image "gardenlinux" will be updated from "1.0.0" to "1.1.0". Reason: Automatic update of the machine image version is configured (image update strategy: major)`,
+				ScheduledTime:  nextMaintenance,
+			},
+		))
+	})
+
+	It("should report forced updates of versions expiring until the next maintenance time window", func() {
+		cloudProfile.Spec.Kubernetes.Versions[2].ExpirationDate = ptr.To(metav1.NewTime(now.Add(time.Hour)))
+
+		Expect(PendingOperations(shoot, cloudProfile, now)).To(ConsistOf(
+			gardencorev1beta1.PendingMaintenanceOperation{
+				Type:           gardencorev1beta1.PendingMaintenanceOperationKubernetesVersion,
+				CurrentVersion: ptr.To("1.30.1"),
+				TargetVersion:  ptr.To("1.30.5"),
+				Forced:         true,
+				Description:    `Kubernetes version will be updated from "1.30.1" to "1.30.5". Reason: Kubernetes version expired - force update required`,
+				ScheduledTime:  nextMaintenance,
+			},
+		))
+	})
+
+	It("should not report updates of versions expiring after the next maintenance time window", func() {
+		cloudProfile.Spec.Kubernetes.Versions[2].ExpirationDate = ptr.To(metav1.NewTime(nextMaintenance.Add(time.Hour)))
+
+		Expect(PendingOperations(shoot, cloudProfile, now)).To(BeEmpty())
+	})
+
+	It("should report updates which cannot be performed", func() {
+		shoot.Spec.Provider.Workers[0].Machine.Image.Version = ptr.To("1.1.0")
+		cloudProfile.Spec.MachineImages[0].Versions[1].ExpirationDate = ptr.To(metav1.NewTime(now))
+
+		Expect(PendingOperations(shoot, cloudProfile, now)).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+			"Type":           Equal(gardencorev1beta1.PendingMaintenanceOperationMachineImageVersion),
+			"WorkerPool":     PointTo(Equal("worker")),
+			"CurrentVersion": PointTo(Equal("1.1.0")),
+			"TargetVersion":  BeNil(),
+			"Forced":         BeTrue(),
+			"Description":    HavePrefix(`Machine image "gardenlinux" cannot be updated, hence the maintenance will fail`),
+		})))
+	})
+
+	It("should report configuration changes enforced by a Kubernetes update", func() {
+		shoot.Spec.Kubernetes.Version = "1.26.5"
+		shoot.Spec.Kubernetes.EnableStaticTokenKubeconfig = ptr.To(true)
+		cloudProfile.Spec.Kubernetes.Versions[0].ExpirationDate = ptr.To(metav1.NewTime(now))
+
+		Expect(PendingOperations(shoot, cloudProfile, now)).To(ConsistOf(
+			MatchFields(IgnoreExtras, Fields{
+				"Type":          Equal(gardencorev1beta1.PendingMaintenanceOperationKubernetesVersion),
+				"TargetVersion": PointTo(Equal("1.27.3")),
+				"Forced":        BeTrue(),
+			}),
+			gardencorev1beta1.PendingMaintenanceOperation{
+				Type:          gardencorev1beta1.PendingMaintenanceOperationConfiguration,
+				Forced:        true,
+				Description:   ".spec.kubernetes.enableStaticTokenKubeconfig is set to false. Reason: The static token kubeconfig can no longer be enabled for Shoot clusters using Kubernetes version 1.27+",
+				ScheduledTime: nextMaintenance,
+			},
+		))
+	})
+
+	It("should report updates of worker pools in their own maintenance time window", func() {
+		shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion = ptr.To(true)
+		shoot.Spec.Maintenance.WorkerPools = []gardencorev1beta1.WorkerPoolMaintenance{{
+			Name:       "worker",
+			TimeWindow: gardencorev1beta1.MaintenanceTimeWindow{Begin: "020000+0000", End: "030000+0000"},
+		}}
+
+		Expect(PendingOperations(shoot, cloudProfile, now)).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+			"Type":          Equal(gardencorev1beta1.PendingMaintenanceOperationMachineImageVersion),
+			"TargetVersion": PointTo(Equal("1.1.0")),
+			"ScheduledTime": Equal(metav1.NewTime(time.Date(2024, 1, 2, 2, 0, 0, 0, time.UTC))),
+		})))
+	})
+
+	It("should not report updates of versions while node rolls are excluded", func() {
+		shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion = true
+		shoot.Spec.Maintenance.Exclusions = []gardencorev1beta1.MaintenanceExclusion{{
+			Name:       "freeze",
+			Begin:      metav1.NewTime(now),
+			End:        metav1.NewTime(now.AddDate(0, 0, 1)),
+			Operations: []gardencorev1beta1.MaintenanceExclusionOperation{gardencorev1beta1.MaintenanceExclusionOperationNodeRoll},
+		}}
+
+		Expect(PendingOperations(shoot, cloudProfile, now)).To(BeEmpty())
+	})
+
+	It("should schedule the operations for the current time if it is within the maintenance time window", func() {
+		shoot.Spec.Maintenance.AutoUpdate.KubernetesVersion = true
+		now = time.Date(2024, 1, 1, 22, 10, 30, 500, time.UTC)
+
+		Expect(PendingOperations(shoot, cloudProfile, now)).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+			"Type":          Equal(gardencorev1beta1.PendingMaintenanceOperationKubernetesVersion),
+			"ScheduledTime": Equal(metav1.NewTime(time.Date(2024, 1, 1, 22, 10, 30, 0, time.UTC))),
+		})))
+	})
+})
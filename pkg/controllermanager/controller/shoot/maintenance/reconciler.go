@@ -17,6 +17,8 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
@@ -24,12 +26,14 @@ import (
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	"github.com/gardener/gardener/pkg/apis/core"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
 	"github.com/gardener/gardener/pkg/controllerutils"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 	admissionpluginsvalidation "github.com/gardener/gardener/pkg/utils/validation/admissionplugins"
 	featuresvalidation "github.com/gardener/gardener/pkg/utils/validation/features"
 	versionutils "github.com/gardener/gardener/pkg/utils/version"
@@ -72,6 +76,16 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{RequeueAfter: requeueAfter}, nil
 	}
 
+	reason, err := r.maintenancePostponedReason(ctx, shoot)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if reason != "" {
+		log.Info("Postponing maintenance of Shoot", "reason", reason, "retryAfter", postponedMaintenanceRetryPeriod)
+		r.Recorder.Event(shoot, corev1.EventTypeNormal, gardencorev1beta1.ShootMaintenancePostponed, "Maintenance postponed: "+reason)
+		return reconcile.Result{RequeueAfter: postponedMaintenanceRetryPeriod}, nil
+	}
+
 	if err := r.reconcile(ctx, log, shoot); err != nil {
 		return reconcile.Result{}, err
 	}
@@ -80,15 +94,83 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	return reconcile.Result{RequeueAfter: requeueAfter}, nil
 }
 
+// postponedMaintenanceRetryPeriod is the period after which a postponed maintenance is retried. If the maintenance time
+// window has ended by then, the maintenance is postponed to the next time window.
+const postponedMaintenanceRetryPeriod = 5 * time.Minute
+
+// maintenancePostponedReason returns a reason if the maintenance of the given shoot must be postponed. This is the case
+// if the shoot is registered as a ManagedSeed which limits the number of unavailable hosted shoot control planes, and
+// this limit is exceeded. Maintenance operations roll the nodes of the seed and thereby drain control plane pods, hence
+// they should not add to an already existing unavailability. Maintenance triggered explicitly via the `maintain`
+// operation annotation is never postponed.
+func (r *Reconciler) maintenancePostponedReason(ctx context.Context, shoot *gardencorev1beta1.Shoot) (string, error) {
+	if hasMaintainNowAnnotation(shoot) {
+		return "", nil
+	}
+
+	managedSeed, err := kubernetesutils.GetManagedSeedWithReader(ctx, r.Client, shoot.Namespace, shoot.Name)
+	if err != nil {
+		return "", fmt.Errorf("failed getting ManagedSeed for shoot: %w", err)
+	}
+	if managedSeed == nil || managedSeed.Spec.Maintenance == nil || managedSeed.Spec.Maintenance.MaxUnavailableControlPlanes == nil {
+		return "", nil
+	}
+
+	shootList := &gardencorev1beta1.ShootList{}
+	if err := r.Client.List(ctx, shootList, client.MatchingFields{core.ShootStatusSeedName: managedSeed.Name}); err != nil {
+		return "", fmt.Errorf("failed listing shoots hosted on seed %s: %w", managedSeed.Name, err)
+	}
+
+	var controlPlanes, unavailableControlPlanes int
+	for _, hostedShoot := range shootList.Items {
+		// hibernated shoots do not run a control plane on the seed
+		if hostedShoot.Status.IsHibernated {
+			continue
+		}
+
+		controlPlanes++
+		if !isControlPlaneAvailable(&hostedShoot) {
+			unavailableControlPlanes++
+		}
+	}
+
+	maxUnavailableControlPlanes, err := intstr.GetScaledValueFromIntOrPercent(managedSeed.Spec.Maintenance.MaxUnavailableControlPlanes, controlPlanes, false)
+	if err != nil {
+		return "", fmt.Errorf("failed computing maximum number of unavailable control planes for ManagedSeed %s: %w", client.ObjectKeyFromObject(managedSeed), err)
+	}
+
+	if unavailableControlPlanes > maxUnavailableControlPlanes {
+		return fmt.Sprintf("%d of %d control planes hosted on seed %q are unavailable, but only %d may be unavailable when the maintenance is started", unavailableControlPlanes, controlPlanes, managedSeed.Name, maxUnavailableControlPlanes), nil
+	}
+
+	return "", nil
+}
+
+// isControlPlaneAvailable returns false if the API server or the control plane of the given shoot is reported as
+// unhealthy.
+func isControlPlaneAvailable(shoot *gardencorev1beta1.Shoot) bool {
+	for _, conditionType := range []gardencorev1beta1.ConditionType{gardencorev1beta1.ShootAPIServerAvailable, gardencorev1beta1.ShootControlPlaneHealthy} {
+		if condition := v1beta1helper.GetCondition(shoot.Status.Conditions, conditionType); condition != nil && condition.Status != gardencorev1beta1.ConditionTrue {
+			return false
+		}
+	}
+	return true
+}
+
 func requeueAfterDuration(shoot *gardencorev1beta1.Shoot) (time.Duration, time.Time) {
 	var (
-		now             = time.Now()
-		window          = gardenerutils.EffectiveShootMaintenanceTimeWindow(shoot)
-		duration        = window.RandomDurationUntilNext(now, false)
-		nextMaintenance = time.Now().UTC().Add(duration)
+		now      = time.Now()
+		duration time.Duration
 	)
 
-	return duration, nextMaintenance
+	// worker pools might define their own time windows, hence the shoot needs to be requeued for the earliest of them
+	for i, window := range gardenerutils.EffectiveMaintenanceTimeWindows(shoot) {
+		if d := window.RandomDurationUntilNext(now, false); i == 0 || d < duration {
+			duration = d
+		}
+	}
+
+	return duration, time.Now().UTC().Add(duration)
 }
 
 // updateResult represents the result of a Kubernetes or Machine image maintenance operation
@@ -98,51 +180,222 @@ type updateResult struct {
 	description  string
 	reason       string
 	isSuccessful bool
+	// isForced is true if the update is performed regardless of the auto-update settings of the Shoot
+	isForced bool
 }
 
 func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gardencorev1beta1.Shoot) error {
-	log.Info("Maintaining Shoot")
-
 	var (
 		maintainedShoot = shoot.DeepCopy()
-		// for maintenance operations unrelated to machine images and Kubernetes versions
-		operations []string
-		err        error
+
+		// the shoot itself is only maintained in its own time window, while worker pools which define their own time
+		// window might be maintained independently
+		maintainShoot         = hasMaintainNowAnnotation(shoot) || gardenerutils.IsNowInEffectiveShootMaintenanceTimeWindow(shoot, r.Clock)
+		workerPoolsToMaintain = workerPoolsToMaintain(shoot, maintainShoot, r.Clock)
 	)
 
-	workerToKubernetesUpdate := make(map[string]updateResult)
-	workerToMachineImageUpdate := make(map[string]updateResult)
+	if maintainShoot {
+		log.Info("Maintaining Shoot")
+	} else {
+		log.Info("Maintaining worker pools of Shoot", "workerPools", sets.List(workerPoolsToMaintain))
+	}
 
 	cloudProfile, err := gardenerutils.GetCloudProfile(ctx, r.Client, shoot)
 	if err != nil {
 		return err
 	}
 
-	if !v1beta1helper.IsWorkerless(shoot) {
-		workerToMachineImageUpdate, err = maintainMachineImages(log, maintainedShoot, cloudProfile)
-		if err != nil {
-			// continue execution to allow the kubernetes version update
-			log.Error(err, "Failed to maintain Shoot machine images")
+	result, err := maintain(log, maintainedShoot, cloudProfile, maintainShoot, workerPoolsToMaintain, r.Clock.Now())
+	if err != nil {
+		return err
+	}
+
+	var (
+		kubernetesControlPlaneUpdate = result.kubernetesControlPlaneUpdate
+		workerToKubernetesUpdate     = result.workerToKubernetesUpdate
+		workerToMachineImageUpdate   = result.workerToMachineImageUpdate
+		// for maintenance operations unrelated to machine images and Kubernetes versions
+		operations = result.operations
+	)
+
+	if !maintainShoot && len(workerToKubernetesUpdate) == 0 && len(workerToMachineImageUpdate) == 0 {
+		log.Info("Worker pool maintenance completed, nothing to be updated")
+		return nil
+	}
+
+	if maintainShoot {
+		operation := maintainOperation(maintainedShoot)
+		if operation != "" {
+			operations = append(operations, fmt.Sprintf("Added %q operation annotation", operation))
 		}
 	}
 
-	kubernetesControlPlaneUpdate, err := maintainKubernetesVersion(log, maintainedShoot.Spec.Kubernetes.Version, maintainedShoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, func(v string) (string, error) {
-		maintainedShoot.Spec.Kubernetes.Version = v
-		return v, nil
-	})
-	if err != nil {
-		// continue execution to allow the machine image version update and Kubernetes updates to worker pools
-		log.Error(err, "Failed to maintain Shoot kubernetes version")
+	requirePatch := len(operations) > 0 || kubernetesControlPlaneUpdate != nil || len(workerToKubernetesUpdate) > 0 || len(workerToMachineImageUpdate) > 0
+	if requirePatch {
+		patch := client.MergeFrom(shoot.DeepCopy())
+
+		// make sure to include both successful and failed maintenance operations
+		description, failureReason := buildMaintenanceMessages(
+			kubernetesControlPlaneUpdate,
+			workerToKubernetesUpdate,
+			workerToMachineImageUpdate,
+		)
+
+		// append also other maintenance operation
+		if len(operations) > 0 {
+			description = fmt.Sprintf("%s, %s", description, strings.Join(operations, ", "))
+		}
+
+		shoot.Status.LastMaintenance = &gardencorev1beta1.LastMaintenance{
+			Description:   description,
+			TriggeredTime: metav1.Time{Time: r.Clock.Now()},
+			State:         gardencorev1beta1.LastOperationStateProcessing,
+		}
+
+		// if any maintenance operation failed, set the status to 'Failed' and retry in the next maintenance cycle
+		if failureReason != "" {
+			shoot.Status.LastMaintenance.State = gardencorev1beta1.LastOperationStateFailed
+			shoot.Status.LastMaintenance.FailureReason = &failureReason
+		}
+
+		// First dry run the update call to check if it can be executed successfully (maintenance might yield a Shoot configuration that is rejected by the ApiServer).
+		// If the dry run fails, the shoot maintenance is marked as failed and is retried only in
+		// next maintenance window.
+		if err := r.Client.Update(ctx, maintainedShoot.DeepCopy(), &client.UpdateOptions{
+			DryRun: []string{metav1.DryRunAll},
+		}); err != nil {
+			// If shoot maintenance is triggered by `gardener.cloud/operation=maintain` annotation and if it fails in dry run,
+			// `maintain` operation annotation needs to be removed so that if reason for failure is fixed and maintenance is triggered
+			// again via `maintain` operation annotation then it should not fail with the reason that annotation is already present.
+			// Removal of annotation during shoot status patch is possible cause only spec is kept in original form during status update
+			// https://github.com/gardener/gardener/blob/a2f7de0badaae6170d7b9b84c163b8cab43a84d2/pkg/apiserver/registry/core/shoot/strategy.go#L258-L267
+			if hasMaintainNowAnnotation(shoot) {
+				delete(shoot.Annotations, v1beta1constants.GardenerOperation)
+			}
+			shoot.Status.LastMaintenance.Description = "Maintenance failed"
+			shoot.Status.LastMaintenance.State = gardencorev1beta1.LastOperationStateFailed
+			shoot.Status.LastMaintenance.FailureReason = ptr.To(fmt.Sprintf("Updates to the Shoot failed to be applied: %s", err.Error()))
+			if err := r.Client.Status().Patch(ctx, shoot, patch); err != nil {
+				return err
+			}
+
+			log.Info("Shoot maintenance failed", "reason", err)
+			return nil
+		}
+
+		if err := r.Client.Status().Patch(ctx, shoot, patch); err != nil {
+			return err
+		}
 	}
 
-	oldShootKubernetesVersion, err := semver.NewVersion(shoot.Spec.Kubernetes.Version)
-	if err != nil {
+	// update shoot spec changes in maintenance call
+	shoot.Spec = *maintainedShoot.Spec.DeepCopy()
+	if maintainShoot {
+		_ = maintainOperation(shoot)
+		maintainTasks(shoot, r.Config)
+	} else {
+		maintainWorkerPoolOperation(shoot)
+	}
+
+	// try to maintain shoot, but don't retry on conflict, because a conflict means that we potentially operated on stale
+	// data (e.g. when calculating the updated k8s version), so rather return error and backoff
+	if err := r.Client.Update(ctx, shoot); err != nil {
+		r.Recorder.Event(shoot, corev1.EventTypeWarning, gardencorev1beta1.ShootMaintenanceFailed, err.Error())
 		return err
 	}
 
+	// if the maintenance patch is not required and the last maintenance operation state is failed,
+	// this means the maintenance was retried and succeeded. Alternatively, changes could have been made
+	// outside of the maintenance window to fix the maintenance error. In either case, remove the failed state.
+	if !requirePatch && shoot.Status.LastMaintenance != nil && shoot.Status.LastMaintenance.State == gardencorev1beta1.LastOperationStateFailed {
+		patch := client.MergeFrom(shoot.DeepCopy())
+		shoot.Status.LastMaintenance.State = gardencorev1beta1.LastOperationStateSucceeded
+		shoot.Status.LastMaintenance.Description = "Maintenance succeeded"
+		shoot.Status.LastMaintenance.FailureReason = nil
+
+		if err := r.Client.Status().Patch(ctx, shoot, patch); err != nil {
+			return err
+		}
+	}
+
+	if shoot.Status.LastMaintenance != nil && shoot.Status.LastMaintenance.State == gardencorev1beta1.LastOperationStateProcessing {
+		patch := client.MergeFrom(shoot.DeepCopy())
+		shoot.Status.LastMaintenance.State = gardencorev1beta1.LastOperationStateSucceeded
+
+		if err := r.Client.Status().Patch(ctx, shoot, patch); err != nil {
+			return err
+		}
+	}
+
+	// make sure to report (partial) maintenance failures
+	if kubernetesControlPlaneUpdate != nil {
+		if kubernetesControlPlaneUpdate.isSuccessful {
+			r.Recorder.Eventf(shoot, corev1.EventTypeNormal, gardencorev1beta1.ShootEventK8sVersionMaintenance, "%s", fmt.Sprintf("Control Plane: %s. Reason: %s.", kubernetesControlPlaneUpdate.description, kubernetesControlPlaneUpdate.reason))
+		} else {
+			r.Recorder.Eventf(shoot, corev1.EventTypeWarning, gardencorev1beta1.ShootEventK8sVersionMaintenance, "%s", fmt.Sprintf("Control Plane: Kubernetes version maintenance failed. Reason for update: %s. Error: %v", kubernetesControlPlaneUpdate.reason, kubernetesControlPlaneUpdate.description))
+		}
+	}
+
+	r.recordMaintenanceEventsForPool(workerToKubernetesUpdate, shoot, gardencorev1beta1.ShootEventK8sVersionMaintenance, "Kubernetes")
+	r.recordMaintenanceEventsForPool(workerToMachineImageUpdate, shoot, gardencorev1beta1.ShootEventImageVersionMaintenance, "Machine image")
+
+	log.Info("Shoot maintenance completed")
+	return nil
+}
+
+// maintenanceResult contains the updates of Kubernetes and machine image versions and further operations performed
+// during the maintenance of a Shoot.
+type maintenanceResult struct {
+	kubernetesControlPlaneUpdate *updateResult
+	workerToKubernetesUpdate     map[string]updateResult
+	workerToMachineImageUpdate   map[string]updateResult
+	// operations contains maintenance operations unrelated to machine images and Kubernetes versions
+	operations []string
+}
+
+// maintain performs all updates which are due at the given time on the given Shoot. The Kubernetes version of the
+// control plane is only updated if maintainShoot is true, updates of worker pools are only performed for the given
+// worker pools.
+func maintain(log logr.Logger, maintainedShoot *gardencorev1beta1.Shoot, cloudProfile *gardencorev1beta1.CloudProfile, maintainShoot bool, workerPoolsToMaintain sets.Set[string], now time.Time) (*maintenanceResult, error) {
+	result := &maintenanceResult{
+		workerToKubernetesUpdate:   make(map[string]updateResult),
+		workerToMachineImageUpdate: make(map[string]updateResult),
+	}
+
+	oldShootKubernetesVersion, err := semver.NewVersion(maintainedShoot.Spec.Kubernetes.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	// Updates of machine image and Kubernetes versions roll the nodes of the shoot, hence they are skipped while node
+	// rolls are excluded and performed in the first maintenance time window after the exclusion has ended.
+	nodeRollExclusion := v1beta1helper.ActiveMaintenanceExclusion(maintainedShoot.Spec.Maintenance, gardencorev1beta1.MaintenanceExclusionOperationNodeRoll, now)
+	if nodeRollExclusion != nil {
+		log.Info("Skipping updates of machine image and Kubernetes versions because node rolls are excluded", "exclusion", nodeRollExclusion.Name, "end", nodeRollExclusion.End)
+	}
+
+	if !v1beta1helper.IsWorkerless(maintainedShoot) && nodeRollExclusion == nil {
+		result.workerToMachineImageUpdate, err = maintainMachineImages(log, maintainedShoot, cloudProfile, workerPoolsToMaintain, now)
+		if err != nil {
+			// continue execution to allow the kubernetes version update
+			log.Error(err, "Failed to maintain Shoot machine images")
+		}
+	}
+
+	if maintainShoot && nodeRollExclusion == nil {
+		result.kubernetesControlPlaneUpdate, err = maintainKubernetesVersion(log, maintainedShoot.Spec.Kubernetes.Version, maintainedShoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, now, func(v string) (string, error) {
+			maintainedShoot.Spec.Kubernetes.Version = v
+			return v, nil
+		})
+		if err != nil {
+			// continue execution to allow the machine image version update and Kubernetes updates to worker pools
+			log.Error(err, "Failed to maintain Shoot kubernetes version")
+		}
+	}
+
 	shootKubernetesVersion, err := semver.NewVersion(maintainedShoot.Spec.Kubernetes.Version)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// Set the .spec.kubernetes.enableStaticTokenKubeconfig field to false, when Shoot cluster is being forcefully updated to K8s >= 1.27.
@@ -153,11 +406,11 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gard
 				maintainedShoot.Spec.Kubernetes.EnableStaticTokenKubeconfig = ptr.To(false)
 
 				reason := ".spec.kubernetes.enableStaticTokenKubeconfig is set to false. Reason: The static token kubeconfig can no longer be enabled for Shoot clusters using Kubernetes version 1.27+"
-				operations = append(operations, reason)
+				result.operations = append(result.operations, reason)
 			}
 
 			reasonsForIncreasingMaxWorkers := ensureSufficientMaxWorkers(maintainedShoot, fmt.Sprintf("Maximum number of workers of a worker group must be greater or equal to its number of zones for updating Kubernetes to %q", shootKubernetesVersion.String()))
-			operations = append(operations, reasonsForIncreasingMaxWorkers...)
+			result.operations = append(result.operations, reasonsForIncreasingMaxWorkers...)
 		}
 	}
 
@@ -170,7 +423,7 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gard
 				maintainedShoot.Spec.Kubernetes.KubeAPIServer.OIDCConfig.ClientAuthentication = nil
 
 				reason := ".spec.kubernetes.kubeAPIServer.oidcConfig.clientAuthentication is set to nil. Reason: The field was no-op since its introduction and can no longer be enabled for Shoot clusters using Kubernetes version 1.31+"
-				operations = append(operations, reason)
+				result.operations = append(result.operations, reason)
 			}
 		}
 	}
@@ -185,19 +438,19 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gard
 				maintainedShoot.Spec.Kubernetes.KubeAPIServer.OIDCConfig = nil
 
 				reason := ".spec.kubernetes.kubeAPIServer.oidcConfig is set to nil. Reason: The field has been deprecated in favor of structured authentication and can no longer be enabled for Shoot clusters using Kubernetes version 1.32+"
-				operations = append(operations, reason)
+				result.operations = append(result.operations, reason)
 			}
 		}
 	}
 
 	// Now it's time to update worker pool kubernetes version if specified
 	for i, pool := range maintainedShoot.Spec.Provider.Workers {
-		if pool.Kubernetes == nil || pool.Kubernetes.Version == nil {
+		if pool.Kubernetes == nil || pool.Kubernetes.Version == nil || nodeRollExclusion != nil || !workerPoolsToMaintain.Has(pool.Name) {
 			continue
 		}
 
 		workerLog := log.WithValues("worker", pool.Name)
-		workerKubernetesUpdate, err := maintainKubernetesVersion(workerLog, *pool.Kubernetes.Version, maintainedShoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, func(v string) (string, error) {
+		workerKubernetesUpdate, err := maintainKubernetesVersion(workerLog, *pool.Kubernetes.Version, maintainedShoot.Spec.Maintenance.AutoUpdate.KubernetesVersion, cloudProfile, now, func(v string) (string, error) {
 			workerPoolSemver, err := semver.NewVersion(v)
 			if err != nil {
 				return "", err
@@ -216,21 +469,16 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gard
 		}
 
 		if workerKubernetesUpdate != nil {
-			result := updateResult{
-				reason: workerKubernetesUpdate.reason,
-			}
-			result.isSuccessful = workerKubernetesUpdate.isSuccessful
-			result.description = workerKubernetesUpdate.description
-			workerToKubernetesUpdate[pool.Name] = result
+			result.workerToKubernetesUpdate[pool.Name] = *workerKubernetesUpdate
 		}
 	}
 
 	if reasons := maintainFeatureGatesForShoot(maintainedShoot); len(reasons) > 0 {
-		operations = append(operations, reasons...)
+		result.operations = append(result.operations, reasons...)
 	}
 
 	if reasons := maintainAdmissionPluginsForShoot(maintainedShoot); len(reasons) > 0 {
-		operations = append(operations, reasons...)
+		result.operations = append(result.operations, reasons...)
 	}
 
 	// Set the swap behavior to `LimitedSwap`, when the shoot cluster and/or worker pool is updated to k8s version >= 1.30.
@@ -238,7 +486,7 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gard
 		if versionutils.ConstraintK8sLess130.Check(oldShootKubernetesVersion) &&
 			versionutils.ConstraintK8sGreaterEqual130.Check(shootKubernetesVersion) &&
 			maintainedShoot.Spec.Kubernetes.Kubelet != nil {
-			operations = append(operations, setLimitedSwap(maintainedShoot.Spec.Kubernetes.Kubelet, "spec.kubernetes.kubelet.memorySwap.swapBehavior")...)
+			result.operations = append(result.operations, setLimitedSwap(maintainedShoot.Spec.Kubernetes.Kubelet, "spec.kubernetes.kubelet.memorySwap.swapBehavior")...)
 		}
 
 		for i := range maintainedShoot.Spec.Provider.Workers {
@@ -246,11 +494,11 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gard
 				kubeletVersion := ptr.Deref(maintainedShoot.Spec.Provider.Workers[i].Kubernetes.Version, maintainedShoot.Spec.Kubernetes.Version)
 				kubeletSemverVersion, err := semver.NewVersion(kubeletVersion)
 				if err != nil {
-					return fmt.Errorf("error parsing kubelet version for worker pool %q: %w", maintainedShoot.Spec.Provider.Workers[i].Name, err)
+					return nil, fmt.Errorf("error parsing kubelet version for worker pool %q: %w", maintainedShoot.Spec.Provider.Workers[i].Name, err)
 				}
 
 				if versionutils.ConstraintK8sGreaterEqual130.Check(kubeletSemverVersion) {
-					operations = append(operations, setLimitedSwap(maintainedShoot.Spec.Provider.Workers[i].Kubernetes.Kubelet, fmt.Sprintf("spec.provider.workers[%d].kubernetes.kubelet.memorySwap.swapBehavior", i))...)
+					result.operations = append(result.operations, setLimitedSwap(maintainedShoot.Spec.Provider.Workers[i].Kubernetes.Kubelet, fmt.Sprintf("spec.provider.workers[%d].kubernetes.kubelet.memorySwap.swapBehavior", i))...)
 				}
 			}
 		}
@@ -265,7 +513,7 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gard
 				maintainedShoot.Spec.Kubernetes.Kubelet.SystemReserved = nil
 
 				reason := ".spec.kubernetes.kubelet.systemReserved is added to .spec.kubernetes.kubelet.kubeReserved. Reason: The systemReserved field is forbidden for Shoot clusters using Kubernetes version 1.31+, its value has to be added to kubeReserved"
-				operations = append(operations, reason)
+				result.operations = append(result.operations, reason)
 			}
 		}
 
@@ -275,7 +523,7 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gard
 				kubeletVersion := ptr.Deref(maintainedShoot.Spec.Provider.Workers[i].Kubernetes.Version, maintainedShoot.Spec.Kubernetes.Version)
 				kubeletSemverVersion, err := semver.NewVersion(kubeletVersion)
 				if err != nil {
-					return fmt.Errorf("error parsing kubelet version for worker pool %q: %w", maintainedShoot.Spec.Provider.Workers[i].Name, err)
+					return nil, fmt.Errorf("error parsing kubelet version for worker pool %q: %w", maintainedShoot.Spec.Provider.Workers[i].Name, err)
 				}
 
 				if versionutils.ConstraintK8sGreaterEqual131.Check(kubeletSemverVersion) {
@@ -283,124 +531,13 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, shoot *gard
 					maintainedShoot.Spec.Provider.Workers[i].Kubernetes.Kubelet.SystemReserved = nil
 
 					reason := fmt.Sprintf(".spec.provider.workers[%[1]d].kubernetes.kubelet.systemReserved is added to .spec.provider.workers[%[1]d].kubernetes.kubelet.kubeReserved. Reason: The systemReserved field is forbidden for Shoot clusters using Kubernetes version 1.31+, its value has to be added to kubeReserved", i)
-					operations = append(operations, reason)
+					result.operations = append(result.operations, reason)
 				}
 			}
 		}
 	}
 
-	operation := maintainOperation(maintainedShoot)
-	if operation != "" {
-		operations = append(operations, fmt.Sprintf("Added %q operation annotation", operation))
-	}
-
-	requirePatch := len(operations) > 0 || kubernetesControlPlaneUpdate != nil || len(workerToKubernetesUpdate) > 0 || len(workerToMachineImageUpdate) > 0
-	if requirePatch {
-		patch := client.MergeFrom(shoot.DeepCopy())
-
-		// make sure to include both successful and failed maintenance operations
-		description, failureReason := buildMaintenanceMessages(
-			kubernetesControlPlaneUpdate,
-			workerToKubernetesUpdate,
-			workerToMachineImageUpdate,
-		)
-
-		// append also other maintenance operation
-		if len(operations) > 0 {
-			description = fmt.Sprintf("%s, %s", description, strings.Join(operations, ", "))
-		}
-
-		shoot.Status.LastMaintenance = &gardencorev1beta1.LastMaintenance{
-			Description:   description,
-			TriggeredTime: metav1.Time{Time: r.Clock.Now()},
-			State:         gardencorev1beta1.LastOperationStateProcessing,
-		}
-
-		// if any maintenance operation failed, set the status to 'Failed' and retry in the next maintenance cycle
-		if failureReason != "" {
-			shoot.Status.LastMaintenance.State = gardencorev1beta1.LastOperationStateFailed
-			shoot.Status.LastMaintenance.FailureReason = &failureReason
-		}
-
-		// First dry run the update call to check if it can be executed successfully (maintenance might yield a Shoot configuration that is rejected by the ApiServer).
-		// If the dry run fails, the shoot maintenance is marked as failed and is retried only in
-		// next maintenance window.
-		if err := r.Client.Update(ctx, maintainedShoot.DeepCopy(), &client.UpdateOptions{
-			DryRun: []string{metav1.DryRunAll},
-		}); err != nil {
-			// If shoot maintenance is triggered by `gardener.cloud/operation=maintain` annotation and if it fails in dry run,
-			// `maintain` operation annotation needs to be removed so that if reason for failure is fixed and maintenance is triggered
-			// again via `maintain` operation annotation then it should not fail with the reason that annotation is already present.
-			// Removal of annotation during shoot status patch is possible cause only spec is kept in original form during status update
-			// https://github.com/gardener/gardener/blob/a2f7de0badaae6170d7b9b84c163b8cab43a84d2/pkg/apiserver/registry/core/shoot/strategy.go#L258-L267
-			if hasMaintainNowAnnotation(shoot) {
-				delete(shoot.Annotations, v1beta1constants.GardenerOperation)
-			}
-			shoot.Status.LastMaintenance.Description = "Maintenance failed"
-			shoot.Status.LastMaintenance.State = gardencorev1beta1.LastOperationStateFailed
-			shoot.Status.LastMaintenance.FailureReason = ptr.To(fmt.Sprintf("Updates to the Shoot failed to be applied: %s", err.Error()))
-			if err := r.Client.Status().Patch(ctx, shoot, patch); err != nil {
-				return err
-			}
-
-			log.Info("Shoot maintenance failed", "reason", err)
-			return nil
-		}
-
-		if err := r.Client.Status().Patch(ctx, shoot, patch); err != nil {
-			return err
-		}
-	}
-
-	// update shoot spec changes in maintenance call
-	shoot.Spec = *maintainedShoot.Spec.DeepCopy()
-	_ = maintainOperation(shoot)
-	maintainTasks(shoot, r.Config)
-
-	// try to maintain shoot, but don't retry on conflict, because a conflict means that we potentially operated on stale
-	// data (e.g. when calculating the updated k8s version), so rather return error and backoff
-	if err := r.Client.Update(ctx, shoot); err != nil {
-		r.Recorder.Event(shoot, corev1.EventTypeWarning, gardencorev1beta1.ShootMaintenanceFailed, err.Error())
-		return err
-	}
-
-	// if the maintenance patch is not required and the last maintenance operation state is failed,
-	// this means the maintenance was retried and succeeded. Alternatively, changes could have been made
-	// outside of the maintenance window to fix the maintenance error. In either case, remove the failed state.
-	if !requirePatch && shoot.Status.LastMaintenance != nil && shoot.Status.LastMaintenance.State == gardencorev1beta1.LastOperationStateFailed {
-		patch := client.MergeFrom(shoot.DeepCopy())
-		shoot.Status.LastMaintenance.State = gardencorev1beta1.LastOperationStateSucceeded
-		shoot.Status.LastMaintenance.Description = "Maintenance succeeded"
-		shoot.Status.LastMaintenance.FailureReason = nil
-
-		if err := r.Client.Status().Patch(ctx, shoot, patch); err != nil {
-			return err
-		}
-	}
-
-	if shoot.Status.LastMaintenance != nil && shoot.Status.LastMaintenance.State == gardencorev1beta1.LastOperationStateProcessing {
-		patch := client.MergeFrom(shoot.DeepCopy())
-		shoot.Status.LastMaintenance.State = gardencorev1beta1.LastOperationStateSucceeded
-
-		if err := r.Client.Status().Patch(ctx, shoot, patch); err != nil {
-			return err
-		}
-	}
-
-	// make sure to report (partial) maintenance failures
-	if kubernetesControlPlaneUpdate != nil {
-		if kubernetesControlPlaneUpdate.isSuccessful {
-			r.Recorder.Eventf(shoot, corev1.EventTypeNormal, gardencorev1beta1.ShootEventK8sVersionMaintenance, "%s", fmt.Sprintf("Control Plane: %s. Reason: %s.", kubernetesControlPlaneUpdate.description, kubernetesControlPlaneUpdate.reason))
-		} else {
-			r.Recorder.Eventf(shoot, corev1.EventTypeWarning, gardencorev1beta1.ShootEventK8sVersionMaintenance, "%s", fmt.Sprintf("Control Plane: Kubernetes version maintenance failed. Reason for update: %s. Error: %v", kubernetesControlPlaneUpdate.reason, kubernetesControlPlaneUpdate.description))
-		}
-	}
-
-	r.recordMaintenanceEventsForPool(workerToKubernetesUpdate, shoot, gardencorev1beta1.ShootEventK8sVersionMaintenance, "Kubernetes")
-	r.recordMaintenanceEventsForPool(workerToMachineImageUpdate, shoot, gardencorev1beta1.ShootEventImageVersionMaintenance, "Machine image")
-
-	log.Info("Shoot maintenance completed")
-	return nil
+	return result, nil
 }
 
 // buildMaintenanceMessages builds a combined message containing the performed maintenance operations over all worker pools. If the maintenance operation failed, the description
@@ -499,6 +636,43 @@ func maintainOperation(shoot *gardencorev1beta1.Shoot) string {
 	return operation
 }
 
+// maintainWorkerPoolOperation makes sure that updates of worker pools performed in their own maintenance time window are
+// rolled out immediately, even if spec update rollouts are confined to the maintenance time window of the shoot.
+func maintainWorkerPoolOperation(shoot *gardencorev1beta1.Shoot) {
+	if shoot.Status.LastOperation == nil || shoot.Status.LastOperation.State == gardencorev1beta1.LastOperationStateFailed {
+		return
+	}
+
+	if _, ok := shoot.Annotations[v1beta1constants.GardenerOperation]; !ok {
+		metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.GardenerOperation, v1beta1constants.GardenerOperationReconcile)
+	}
+}
+
+// workerPoolsToMaintain returns the names of the worker pools whose effective maintenance time window contains the
+// current time. If the shoot is maintained, all worker pools without an own time window are maintained as well.
+func workerPoolsToMaintain(shoot *gardencorev1beta1.Shoot, maintainShoot bool, clock clock.Clock) sets.Set[string] {
+	workerPools := sets.New[string]()
+
+	for _, worker := range shoot.Spec.Provider.Workers {
+		if hasMaintainNowAnnotation(shoot) || gardenerutils.EffectiveWorkerPoolMaintenanceTimeWindow(shoot, worker.Name).Contains(clock.Now()) {
+			workerPools.Insert(worker.Name)
+			continue
+		}
+
+		if maintainShoot && !hasWorkerPoolMaintenanceTimeWindow(shoot, worker.Name) {
+			workerPools.Insert(worker.Name)
+		}
+	}
+
+	return workerPools
+}
+
+func hasWorkerPoolMaintenanceTimeWindow(shoot *gardencorev1beta1.Shoot, name string) bool {
+	return shoot.Spec.Maintenance != nil && slices.ContainsFunc(shoot.Spec.Maintenance.WorkerPools, func(workerPool gardencorev1beta1.WorkerPoolMaintenance) bool {
+		return workerPool.Name == name
+	})
+}
+
 func maintainTasks(shoot *gardencorev1beta1.Shoot, config config.ShootMaintenanceControllerConfiguration) {
 	controllerutils.AddTasks(shoot.Annotations,
 		v1beta1constants.ShootTaskDeployInfrastructure,
@@ -517,7 +691,7 @@ func maintainTasks(shoot *gardencorev1beta1.Shoot, config config.ShootMaintenanc
 }
 
 // maintainMachineImages updates the machine images of a Shoot's worker pools if necessary
-func maintainMachineImages(log logr.Logger, shoot *gardencorev1beta1.Shoot, cloudProfile *gardencorev1beta1.CloudProfile) (map[string]updateResult, error) {
+func maintainMachineImages(log logr.Logger, shoot *gardencorev1beta1.Shoot, cloudProfile *gardencorev1beta1.CloudProfile, workerPools sets.Set[string], now time.Time) (map[string]updateResult, error) {
 	maintenanceResults := make(map[string]updateResult)
 
 	controlPlaneVersion, err := semver.NewVersion(shoot.Spec.Kubernetes.Version)
@@ -526,6 +700,10 @@ func maintainMachineImages(log logr.Logger, shoot *gardencorev1beta1.Shoot, clou
 	}
 
 	for i, worker := range shoot.Spec.Provider.Workers {
+		if !workerPools.Has(worker.Name) {
+			continue
+		}
+
 		workerImage := worker.Machine.Image
 		workerLog := log.WithValues("worker", worker.Name, "image", workerImage.Name, "version", workerImage.Version)
 
@@ -544,7 +722,7 @@ func maintainMachineImages(log logr.Logger, shoot *gardencorev1beta1.Shoot, clou
 		filteredMachineImageVersionsFromCloudProfile = filterForKubeleteVersionConstraint(filteredMachineImageVersionsFromCloudProfile, kubeletVersion)
 
 		// first check if the machine image version should be updated
-		shouldBeUpdated, reason, isExpired := shouldMachineImageVersionBeUpdated(workerImage, filteredMachineImageVersionsFromCloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion)
+		shouldBeUpdated, reason, isExpired := shouldMachineImageVersionBeUpdated(workerImage, filteredMachineImageVersionsFromCloudProfile, *shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion, now)
 		if !shouldBeUpdated {
 			continue
 		}
@@ -556,6 +734,7 @@ func maintainMachineImages(log logr.Logger, shoot *gardencorev1beta1.Shoot, clou
 				description:  fmt.Sprintf("failed to update machine image %q: %s", workerImage.Name, err.Error()),
 				reason:       reason,
 				isSuccessful: false,
+				isForced:     isExpired,
 			}
 			continue
 		}
@@ -569,6 +748,7 @@ func maintainMachineImages(log logr.Logger, shoot *gardencorev1beta1.Shoot, clou
 			description:  fmt.Sprintf("Updated machine image %q from %q to %q", workerImage.Name, *workerImage.Version, updatedMachineImageVersion),
 			reason:       reason,
 			isSuccessful: true,
+			isForced:     isExpired,
 		}
 
 		// update the machine image version
@@ -579,8 +759,8 @@ func maintainMachineImages(log logr.Logger, shoot *gardencorev1beta1.Shoot, clou
 }
 
 // maintainKubernetesVersion updates the Kubernetes version if necessary and returns the reason why an update was done
-func maintainKubernetesVersion(log logr.Logger, kubernetesVersion string, autoUpdate bool, profile *gardencorev1beta1.CloudProfile, updateFunc func(string) (string, error)) (*updateResult, error) {
-	shouldBeUpdated, reason, isExpired, err := shouldKubernetesVersionBeUpdated(kubernetesVersion, autoUpdate, profile)
+func maintainKubernetesVersion(log logr.Logger, kubernetesVersion string, autoUpdate bool, profile *gardencorev1beta1.CloudProfile, now time.Time, updateFunc func(string) (string, error)) (*updateResult, error) {
+	shouldBeUpdated, reason, isExpired, err := shouldKubernetesVersionBeUpdated(kubernetesVersion, autoUpdate, profile, now)
 	if err != nil {
 		return nil, err
 	}
@@ -594,6 +774,7 @@ func maintainKubernetesVersion(log logr.Logger, kubernetesVersion string, autoUp
 			description:  fmt.Sprintf("could not determine higher suitable version than %q: %v", kubernetesVersion, err),
 			reason:       reason,
 			isSuccessful: false,
+			isForced:     isExpired,
 		}, err
 	}
 	// current version is already the latest
@@ -608,6 +789,7 @@ func maintainKubernetesVersion(log logr.Logger, kubernetesVersion string, autoUp
 			description:  err.Error(),
 			reason:       reason,
 			isSuccessful: false,
+			isForced:     isExpired,
 		}, err
 	}
 
@@ -616,6 +798,7 @@ func maintainKubernetesVersion(log logr.Logger, kubernetesVersion string, autoUp
 		description:  fmt.Sprintf("Updated Kubernetes version from %q to %q", kubernetesVersion, actualUpdatedKubernetesVersion),
 		reason:       reason,
 		isSuccessful: true,
+		isForced:     isExpired,
 	}, nil
 }
 
@@ -630,7 +813,7 @@ func determineKubernetesVersion(kubernetesVersion string, profile *gardencorev1b
 	return version, nil
 }
 
-func shouldKubernetesVersionBeUpdated(kubernetesVersion string, autoUpdate bool, profile *gardencorev1beta1.CloudProfile) (shouldBeUpdated bool, reason string, isExpired bool, error error) {
+func shouldKubernetesVersionBeUpdated(kubernetesVersion string, autoUpdate bool, profile *gardencorev1beta1.CloudProfile, now time.Time) (shouldBeUpdated bool, reason string, isExpired bool, error error) {
 	versionExistsInCloudProfile, version, err := v1beta1helper.KubernetesVersionExistsInCloudProfile(profile, kubernetesVersion)
 	if err != nil {
 		return false, "", false, err
@@ -642,7 +825,7 @@ func shouldKubernetesVersionBeUpdated(kubernetesVersion string, autoUpdate bool,
 		return true, updateReason, true, nil
 	}
 
-	if ExpirationDateExpired(version.ExpirationDate) {
+	if ExpirationDateExpired(version.ExpirationDate, now) {
 		updateReason = "Kubernetes version expired - force update required"
 		return true, updateReason, true, nil
 	}
@@ -656,7 +839,17 @@ func shouldKubernetesVersionBeUpdated(kubernetesVersion string, autoUpdate bool,
 }
 
 func mustMaintainNow(shoot *gardencorev1beta1.Shoot, clock clock.Clock) bool {
-	return hasMaintainNowAnnotation(shoot) || gardenerutils.IsNowInEffectiveShootMaintenanceTimeWindow(shoot, clock)
+	if hasMaintainNowAnnotation(shoot) {
+		return true
+	}
+
+	for _, window := range gardenerutils.EffectiveMaintenanceTimeWindows(shoot) {
+		if window.Contains(clock.Now()) {
+			return true
+		}
+	}
+
+	return false
 }
 
 func hasMaintainNowAnnotation(shoot *gardencorev1beta1.Shoot) bool {
@@ -791,7 +984,7 @@ func determineMachineImage(cloudProfile *gardencorev1beta1.CloudProfile, shootMa
 	return machineImageFromCloudProfile, nil
 }
 
-func shouldMachineImageVersionBeUpdated(shootMachineImage *gardencorev1beta1.ShootMachineImage, machineImage *gardencorev1beta1.MachineImage, autoUpdate bool) (shouldBeUpdated bool, reason string, isExpired bool) {
+func shouldMachineImageVersionBeUpdated(shootMachineImage *gardencorev1beta1.ShootMachineImage, machineImage *gardencorev1beta1.MachineImage, autoUpdate bool, now time.Time) (shouldBeUpdated bool, reason string, isExpired bool) {
 	versionExistsInCloudProfile, versionIndex := v1beta1helper.ShootMachineImageVersionExists(*machineImage, *shootMachineImage)
 
 	var updateReason string
@@ -800,7 +993,7 @@ func shouldMachineImageVersionBeUpdated(shootMachineImage *gardencorev1beta1.Sho
 		return true, updateReason, true
 	}
 
-	if ExpirationDateExpired(machineImage.Versions[versionIndex].ExpirationDate) {
+	if ExpirationDateExpired(machineImage.Versions[versionIndex].ExpirationDate, now) {
 		updateReason = fmt.Sprintf("Machine image version expired - force update required (image update strategy: %s)", *machineImage.UpdateStrategy)
 		return true, updateReason, true
 	}
@@ -887,11 +1080,11 @@ func determineVersionForStrategy(expirableVersions []gardencorev1beta1.Expirable
 }
 
 // ExpirationDateExpired returns if now is equal or after the given expirationDate
-func ExpirationDateExpired(timestamp *metav1.Time) bool {
+func ExpirationDateExpired(timestamp *metav1.Time, now time.Time) bool {
 	if timestamp == nil {
 		return false
 	}
-	return time.Now().UTC().After(timestamp.Time) || time.Now().UTC().Equal(timestamp.Time)
+	return now.UTC().After(timestamp.Time) || now.UTC().Equal(timestamp.Time)
 }
 
 // ensureSufficientMaxWorkers ensures that the number of max workers of a worker group is greater or equal to its number of zones
@@ -0,0 +1,97 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package migration_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/shoot/migration"
+)
+
+var _ = Describe("Add", func() {
+	var reconciler *Reconciler
+
+	BeforeEach(func() {
+		reconciler = &Reconciler{}
+	})
+
+	Describe("ShootPredicate", func() {
+		var (
+			p     predicate.Predicate
+			shoot *gardencorev1beta1.Shoot
+		)
+
+		BeforeEach(func() {
+			p = reconciler.ShootPredicate()
+			shoot = &gardencorev1beta1.Shoot{
+				Spec: gardencorev1beta1.ShootSpec{
+					Kubernetes: gardencorev1beta1.Kubernetes{
+						ClusterAutoscaler: &gardencorev1beta1.ClusterAutoscaler{
+							IgnoreTaints: []string{"foo"},
+						},
+					},
+				},
+			}
+		})
+
+		Describe("#Create", func() {
+			It("should return false because object is no shoot", func() {
+				Expect(p.Create(event.CreateEvent{})).To(BeFalse())
+			})
+
+			It("should return true because shoot uses deprecated fields", func() {
+				Expect(p.Create(event.CreateEvent{Object: shoot})).To(BeTrue())
+			})
+
+			It("should return false because shoot does not use deprecated fields", func() {
+				shoot.Spec.Kubernetes.ClusterAutoscaler = nil
+				Expect(p.Create(event.CreateEvent{Object: shoot})).To(BeFalse())
+			})
+
+			It("should return false because shoot opted out of the migration", func() {
+				metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "shoot.gardener.cloud/skip-deprecated-fields-migration", "true")
+				Expect(p.Create(event.CreateEvent{Object: shoot})).To(BeFalse())
+			})
+
+			It("should return false because shoot is being deleted", func() {
+				shoot.DeletionTimestamp = &metav1.Time{}
+				Expect(p.Create(event.CreateEvent{Object: shoot})).To(BeFalse())
+			})
+		})
+
+		Describe("#Update", func() {
+			It("should return false because new object is no shoot", func() {
+				Expect(p.Update(event.UpdateEvent{})).To(BeFalse())
+			})
+
+			It("should return true because shoot uses deprecated fields", func() {
+				Expect(p.Update(event.UpdateEvent{ObjectNew: shoot, ObjectOld: shoot})).To(BeTrue())
+				Expect(shoot.Spec.Kubernetes.ClusterAutoscaler.IgnoreTaints).To(ConsistOf("foo"))
+			})
+
+			It("should return false because shoot does not use deprecated fields", func() {
+				shoot.Spec.Kubernetes.ClusterAutoscaler = nil
+				Expect(p.Update(event.UpdateEvent{ObjectNew: shoot, ObjectOld: shoot})).To(BeFalse())
+			})
+		})
+
+		Describe("#Delete", func() {
+			It("should return false", func() {
+				Expect(p.Delete(event.DeleteEvent{})).To(BeFalse())
+			})
+		})
+
+		Describe("#Generic", func() {
+			It("should return false", func() {
+				Expect(p.Generic(event.GenericEvent{})).To(BeFalse())
+			})
+		})
+	})
+})
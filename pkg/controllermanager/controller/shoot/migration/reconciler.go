@@ -0,0 +1,153 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package migration
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	"github.com/gardener/gardener/pkg/controllerutils"
+)
+
+// Reconciler reconciles Shoots and rewrites deprecated fields to their replacements.
+type Reconciler struct {
+	Client   client.Client
+	Config   config.ShootMigrationControllerConfiguration
+	Recorder record.EventRecorder
+}
+
+// Reconcile reconciles Shoots and rewrites deprecated fields to their replacements.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
+	defer cancel()
+
+	shoot := &gardencorev1beta1.Shoot{}
+	if err := r.Client.Get(ctx, request.NamespacedName, shoot); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if shoot.DeletionTimestamp != nil || migrationSkipped(shoot) {
+		return reconcile.Result{}, nil
+	}
+
+	patch := client.MergeFromWithOptions(shoot.DeepCopy(), client.MergeFromWithOptimisticLock{})
+
+	migrations := MigrateDeprecatedFields(shoot)
+	if len(migrations) == 0 {
+		return reconcile.Result{}, nil
+	}
+
+	log.Info("Migrating deprecated fields", "migrations", migrations)
+	if err := r.Client.Patch(ctx, shoot, patch); err != nil {
+		r.Recorder.Eventf(shoot, corev1.EventTypeWarning, gardencorev1beta1.ShootEventDeprecatedFieldsMigrationFailed, "Failed migrating deprecated fields: %v", err)
+		return reconcile.Result{}, fmt.Errorf("failed migrating deprecated fields: %w", err)
+	}
+
+	r.Recorder.Eventf(shoot, corev1.EventTypeNormal, gardencorev1beta1.ShootEventDeprecatedFieldsMigrated, "Migrated deprecated fields: %s. Please adapt your manifests accordingly or annotate the Shoot with %s=true to opt out.", strings.Join(migrations, "; "), v1beta1constants.AnnotationShootSkipDeprecatedFieldsMigration)
+	return reconcile.Result{}, nil
+}
+
+func migrationSkipped(shoot *gardencorev1beta1.Shoot) bool {
+	return strings.EqualFold(shoot.Annotations[v1beta1constants.AnnotationShootSkipDeprecatedFieldsMigration], "true")
+}
+
+// MigrateDeprecatedFields rewrites the deprecated fields of the given Shoot to their replacements (without changing
+// the effective configuration) and returns a description of every performed migration.
+func MigrateDeprecatedFields(shoot *gardencorev1beta1.Shoot) []string {
+	var migrations []string
+
+	if ca := shoot.Spec.Kubernetes.ClusterAutoscaler; ca != nil && len(ca.IgnoreTaints) > 0 {
+		// Ignore taints are treated as startup taints by the cluster-autoscaler.
+		for _, taint := range ca.IgnoreTaints {
+			if !slices.Contains(ca.StartupTaints, taint) {
+				ca.StartupTaints = append(ca.StartupTaints, taint)
+			}
+		}
+		ca.IgnoreTaints = nil
+		migrations = append(migrations, "moved .spec.kubernetes.clusterAutoscaler.ignoreTaints to .spec.kubernetes.clusterAutoscaler.startupTaints")
+	}
+
+	if oidc := shoot.Spec.Kubernetes.KubeAPIServer; oidc != nil && oidc.OIDCConfig != nil && oidc.OIDCConfig.ClientAuthentication != nil {
+		// The field has no implemented use, hence it can be dropped without any effect.
+		oidc.OIDCConfig.ClientAuthentication = nil
+		migrations = append(migrations, "removed unused .spec.kubernetes.kubeAPIServer.oidcConfig.clientAuthentication")
+	}
+
+	if mergeSystemReserved(shoot.Spec.Kubernetes.Kubelet) {
+		migrations = append(migrations, "merged .spec.kubernetes.kubelet.systemReserved into .spec.kubernetes.kubelet.kubeReserved")
+	}
+
+	for i, worker := range shoot.Spec.Provider.Workers {
+		if worker.Kubernetes != nil && mergeSystemReserved(shoot.Spec.Provider.Workers[i].Kubernetes.Kubelet) {
+			migrations = append(migrations, fmt.Sprintf("merged .spec.provider.workers[%d].kubernetes.kubelet.systemReserved into .spec.provider.workers[%d].kubernetes.kubelet.kubeReserved", i, i))
+		}
+	}
+
+	return migrations
+}
+
+// kubeReservedDefaults are the reservations applied by gardenlet if the respective kubeReserved value is unset, see
+// pkg/component/extensions/operatingsystemconfig/original/components/kubelet.
+var kubeReservedDefaults = map[corev1.ResourceName]resource.Quantity{
+	corev1.ResourceCPU:    resource.MustParse("80m"),
+	corev1.ResourceMemory: resource.MustParse("1Gi"),
+}
+
+// mergeSystemReserved adds the system reservations to the kube reservations, so that the allocatable resources of the
+// nodes stay the same.
+func mergeSystemReserved(kubelet *gardencorev1beta1.KubeletConfig) bool {
+	if kubelet == nil || kubelet.SystemReserved == nil {
+		return false
+	}
+
+	if kubelet.KubeReserved == nil {
+		kubelet.KubeReserved = &gardencorev1beta1.KubeletConfigReserved{}
+	}
+
+	for _, r := range []struct {
+		name         corev1.ResourceName
+		kube, system **resource.Quantity
+	}{
+		{corev1.ResourceCPU, &kubelet.KubeReserved.CPU, &kubelet.SystemReserved.CPU},
+		{corev1.ResourceMemory, &kubelet.KubeReserved.Memory, &kubelet.SystemReserved.Memory},
+		{corev1.ResourceEphemeralStorage, &kubelet.KubeReserved.EphemeralStorage, &kubelet.SystemReserved.EphemeralStorage},
+		{corev1.ResourcePods, &kubelet.KubeReserved.PID, &kubelet.SystemReserved.PID},
+	} {
+		if *r.system == nil {
+			continue
+		}
+
+		sum := resource.Quantity{Format: (*r.system).Format}
+		if *r.kube != nil {
+			sum = (*r.kube).DeepCopy()
+		} else if defaultValue, ok := kubeReservedDefaults[r.name]; ok {
+			sum = defaultValue.DeepCopy()
+		}
+		sum.Add(**r.system)
+		*r.kube = &sum
+	}
+
+	kubelet.SystemReserved = nil
+	return true
+}
@@ -0,0 +1,151 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package migration_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/shoot/migration"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx = context.TODO()
+
+		fakeClient   client.Client
+		fakeRecorder *record.FakeRecorder
+		reconciler   *Reconciler
+
+		shoot *gardencorev1beta1.Shoot
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+		fakeRecorder = record.NewFakeRecorder(1)
+		reconciler = &Reconciler{Client: fakeClient, Recorder: fakeRecorder}
+
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "shoot",
+				Namespace: "garden-project",
+			},
+			Spec: gardencorev1beta1.ShootSpec{
+				Kubernetes: gardencorev1beta1.Kubernetes{
+					ClusterAutoscaler: &gardencorev1beta1.ClusterAutoscaler{
+						IgnoreTaints:  []string{"foo", "bar"},
+						StartupTaints: []string{"bar"},
+					},
+				},
+			},
+		}
+	})
+
+	Describe("#Reconcile", func() {
+		It("should do nothing if the shoot is gone", func() {
+			Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)})).To(Equal(reconcile.Result{}))
+			Expect(fakeRecorder.Events).To(BeEmpty())
+		})
+
+		It("should migrate the deprecated fields and emit an event", func() {
+			Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+
+			Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)})).To(Equal(reconcile.Result{}))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Spec.Kubernetes.ClusterAutoscaler.IgnoreTaints).To(BeEmpty())
+			Expect(shoot.Spec.Kubernetes.ClusterAutoscaler.StartupTaints).To(Equal([]string{"bar", "foo"}))
+			Expect(fakeRecorder.Events).To(Receive(HavePrefix("Normal DeprecatedFieldsMigrated Migrated deprecated fields: moved .spec.kubernetes.clusterAutoscaler.ignoreTaints")))
+		})
+
+		It("should do nothing if the shoot opted out of the migration", func() {
+			metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "shoot.gardener.cloud/skip-deprecated-fields-migration", "true")
+			Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+
+			Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)})).To(Equal(reconcile.Result{}))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Spec.Kubernetes.ClusterAutoscaler.IgnoreTaints).To(ConsistOf("foo", "bar"))
+			Expect(fakeRecorder.Events).To(BeEmpty())
+		})
+
+		It("should do nothing if the shoot does not use deprecated fields", func() {
+			shoot.Spec.Kubernetes.ClusterAutoscaler = nil
+			Expect(fakeClient.Create(ctx, shoot)).To(Succeed())
+
+			Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)})).To(Equal(reconcile.Result{}))
+			Expect(fakeRecorder.Events).To(BeEmpty())
+		})
+	})
+
+	Describe("#MigrateDeprecatedFields", func() {
+		It("should drop the OIDC client authentication", func() {
+			shoot.Spec.Kubernetes.ClusterAutoscaler = nil
+			shoot.Spec.Kubernetes.KubeAPIServer = &gardencorev1beta1.KubeAPIServerConfig{
+				OIDCConfig: &gardencorev1beta1.OIDCConfig{
+					ClientID:             ptr.To("client"),
+					ClientAuthentication: &gardencorev1beta1.OpenIDConnectClientAuthentication{Secret: ptr.To("secret")},
+				},
+			}
+
+			Expect(MigrateDeprecatedFields(shoot)).To(ConsistOf(ContainSubstring("oidcConfig.clientAuthentication")))
+			Expect(shoot.Spec.Kubernetes.KubeAPIServer.OIDCConfig.ClientAuthentication).To(BeNil())
+			Expect(shoot.Spec.Kubernetes.KubeAPIServer.OIDCConfig.ClientID).To(PointTo(Equal("client")))
+		})
+
+		It("should merge the system reservations into the kube reservations", func() {
+			shoot.Spec.Kubernetes.ClusterAutoscaler = nil
+			shoot.Spec.Kubernetes.Kubelet = &gardencorev1beta1.KubeletConfig{
+				KubeReserved: &gardencorev1beta1.KubeletConfigReserved{
+					CPU: ptr.To(resource.MustParse("100m")),
+				},
+				SystemReserved: &gardencorev1beta1.KubeletConfigReserved{
+					CPU:              ptr.To(resource.MustParse("50m")),
+					Memory:           ptr.To(resource.MustParse("512Mi")),
+					EphemeralStorage: ptr.To(resource.MustParse("1Gi")),
+				},
+			}
+			shoot.Spec.Provider.Workers = []gardencorev1beta1.Worker{
+				{Name: "worker1"},
+				{Name: "worker2", Kubernetes: &gardencorev1beta1.WorkerKubernetes{
+					Kubelet: &gardencorev1beta1.KubeletConfig{
+						SystemReserved: &gardencorev1beta1.KubeletConfigReserved{
+							PID: ptr.To(resource.MustParse("100")),
+						},
+					},
+				}},
+			}
+
+			Expect(MigrateDeprecatedFields(shoot)).To(ConsistOf(
+				ContainSubstring(".spec.kubernetes.kubelet.systemReserved"),
+				ContainSubstring(".spec.provider.workers[1].kubernetes.kubelet.systemReserved"),
+			))
+
+			kubeReserved := shoot.Spec.Kubernetes.Kubelet.KubeReserved
+			Expect(shoot.Spec.Kubernetes.Kubelet.SystemReserved).To(BeNil())
+			Expect(kubeReserved.CPU.Cmp(resource.MustParse("150m"))).To(BeZero())
+			Expect(kubeReserved.Memory.Cmp(resource.MustParse("1536Mi"))).To(BeZero())
+			Expect(kubeReserved.EphemeralStorage.Cmp(resource.MustParse("1Gi"))).To(BeZero())
+			Expect(kubeReserved.PID).To(BeNil())
+
+			workerKubelet := shoot.Spec.Provider.Workers[1].Kubernetes.Kubelet
+			Expect(workerKubelet.SystemReserved).To(BeNil())
+			Expect(workerKubelet.KubeReserved.PID.Cmp(resource.MustParse("100"))).To(BeZero())
+			Expect(workerKubelet.KubeReserved.CPU).To(BeNil())
+		})
+	})
+})
@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package pendingmaintenance
+
+import (
+	"context"
+	"fmt"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot/maintenance"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+// Reconciler reconciles Shoots and reports the updates which will be applied in their next maintenance time window(s)
+// in their status.
+type Reconciler struct {
+	Client client.Client
+	Config config.ShootPendingMaintenanceControllerConfiguration
+	Clock  clock.Clock
+}
+
+// Reconcile reconciles Shoots and reports the updates which will be applied in their next maintenance time window(s)
+// in their status.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
+	defer cancel()
+
+	shoot := &gardencorev1beta1.Shoot{}
+	if err := r.Client.Get(ctx, request.NamespacedName, shoot); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if shoot.DeletionTimestamp != nil {
+		log.V(1).Info("Skipping Shoot because it is marked for deletion")
+		return reconcile.Result{}, nil
+	}
+
+	cloudProfile, err := gardenerutils.GetCloudProfile(ctx, r.Client, shoot)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed getting CloudProfile for shoot: %w", err)
+	}
+
+	// CloudProfiles are not watched, hence the pending operations are recomputed periodically to account for changed
+	// expiration dates or newly offered versions.
+	operations, err := maintenance.PendingOperations(shoot, cloudProfile, r.Clock.Now())
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed computing pending maintenance operations: %w", err)
+	}
+
+	if !apiequality.Semantic.DeepEqual(shoot.Status.PendingMaintenanceOperations, operations) {
+		log.Info("Updating pending maintenance operations", "count", len(operations))
+
+		patch := client.MergeFrom(shoot.DeepCopy())
+		shoot.Status.PendingMaintenanceOperations = operations
+		if err := r.Client.Status().Patch(ctx, shoot, patch); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed updating pending maintenance operations: %w", err)
+		}
+	}
+
+	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+}
@@ -0,0 +1,120 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package pendingmaintenance_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/shoot/pendingmaintenance"
+)
+
+var _ = Describe("Reconciler", func() {
+	const syncPeriod = time.Hour
+
+	var (
+		ctx = context.TODO()
+		c   client.Client
+
+		fakeClock  *testclock.FakeClock
+		reconciler reconcile.Reconciler
+		request    reconcile.Request
+
+		cloudProfile *gardencorev1beta1.CloudProfile
+		shoot        *gardencorev1beta1.Shoot
+	)
+
+	BeforeEach(func() {
+		fakeClock = testclock.NewFakeClock(time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC))
+
+		cloudProfile = &gardencorev1beta1.CloudProfile{
+			ObjectMeta: metav1.ObjectMeta{Name: "profile"},
+			Spec: gardencorev1beta1.CloudProfileSpec{
+				Kubernetes: gardencorev1beta1.KubernetesSettings{
+					Versions: []gardencorev1beta1.ExpirableVersion{
+						{Version: "1.30.1", ExpirationDate: ptr.To(metav1.NewTime(fakeClock.Now().Add(time.Hour)))},
+						{Version: "1.30.5"},
+					},
+				},
+			},
+		}
+
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-project"},
+			Spec: gardencorev1beta1.ShootSpec{
+				CloudProfile: &gardencorev1beta1.CloudProfileReference{Kind: "CloudProfile", Name: cloudProfile.Name},
+				Kubernetes:   gardencorev1beta1.Kubernetes{Version: "1.30.1"},
+				Maintenance: &gardencorev1beta1.Maintenance{
+					AutoUpdate: &gardencorev1beta1.MaintenanceAutoUpdate{MachineImageVersion: ptr.To(false)},
+					TimeWindow: &gardencorev1beta1.MaintenanceTimeWindow{Begin: "220000+0000", End: "230000+0000"},
+				},
+			},
+		}
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)}
+	})
+
+	JustBeforeEach(func() {
+		c = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.GardenScheme).
+			WithObjects(cloudProfile, shoot).
+			WithStatusSubresource(shoot).
+			Build()
+
+		reconciler = &Reconciler{
+			Client: c,
+			Config: config.ShootPendingMaintenanceControllerConfiguration{SyncPeriod: &metav1.Duration{Duration: syncPeriod}},
+			Clock:  fakeClock,
+		}
+	})
+
+	It("should do nothing if the Shoot is gone", func() {
+		Expect(c.Delete(ctx, shoot)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+	})
+
+	It("should report the pending maintenance operations", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		Expect(c.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+		Expect(shoot.Status.PendingMaintenanceOperations).To(ConsistOf(MatchAllFields(Fields{
+			"Type":           Equal(gardencorev1beta1.PendingMaintenanceOperationKubernetesVersion),
+			"WorkerPool":     BeNil(),
+			"CurrentVersion": PointTo(Equal("1.30.1")),
+			"TargetVersion":  PointTo(Equal("1.30.5")),
+			"Forced":         BeTrue(),
+			"Description":    Equal(`Kubernetes version will be updated from "1.30.1" to "1.30.5". Reason: Kubernetes version expired - force update required`),
+			"ScheduledTime":  HaveField("Time", BeTemporally("==", time.Date(2024, 1, 1, 22, 0, 0, 0, time.UTC))),
+		})))
+	})
+
+	It("should remove pending maintenance operations which are no longer due", func() {
+		cloudProfile.Spec.Kubernetes.Versions[0].ExpirationDate = nil
+		Expect(c.Update(ctx, cloudProfile)).To(Succeed())
+		shoot.Status.PendingMaintenanceOperations = []gardencorev1beta1.PendingMaintenanceOperation{{
+			Type:        gardencorev1beta1.PendingMaintenanceOperationKubernetesVersion,
+			Description: "outdated",
+		}}
+		Expect(c.Status().Update(ctx, shoot)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+
+		Expect(c.Get(ctx, request.NamespacedName, shoot)).To(Succeed())
+		Expect(shoot.Status.PendingMaintenanceOperations).To(BeEmpty())
+	})
+})
@@ -46,6 +46,7 @@ func Predicate(oldObj, newObj client.Object) bool {
 		!apiequality.Semantic.Equalities.DeepEqual(oldShoot.Spec.Kubernetes.KubeAPIServer.AuditConfig, newShoot.Spec.Kubernetes.KubeAPIServer.AuditConfig) ||
 		!apiequality.Semantic.Equalities.DeepEqual(oldShoot.Spec.Kubernetes.KubeAPIServer.StructuredAuthentication, newShoot.Spec.Kubernetes.KubeAPIServer.StructuredAuthentication) ||
 		!apiequality.Semantic.Equalities.DeepEqual(oldShoot.Spec.Kubernetes.KubeAPIServer.StructuredAuthorization, newShoot.Spec.Kubernetes.KubeAPIServer.StructuredAuthorization) ||
+		v1beta1helper.GetShootAPIPriorityAndFairnessConfigMapName(oldShoot.Spec.Kubernetes.KubeAPIServer) != v1beta1helper.GetShootAPIPriorityAndFairnessConfigMapName(newShoot.Spec.Kubernetes.KubeAPIServer) ||
 		!v1beta1helper.ShootResourceReferencesEqual(oldShoot.Spec.Resources, newShoot.Spec.Resources)
 }
 
@@ -79,6 +80,10 @@ func getReferencedConfigMapNames(obj client.Object) []string {
 	if configMapName := v1beta1helper.GetShootAuthorizationConfigurationConfigMapName(shoot.Spec.Kubernetes.KubeAPIServer); configMapName != "" {
 		out = append(out, configMapName)
 	}
+	if configMapName := v1beta1helper.GetShootAPIPriorityAndFairnessConfigMapName(shoot.Spec.Kubernetes.KubeAPIServer); configMapName != "" {
+		out = append(out, configMapName)
+	}
+	out = append(out, configMapNamesForAdmissionPlugins(shoot)...)
 	out = append(out, namesForReferencedResources(shoot, "ConfigMap")...)
 	return out
 }
@@ -114,6 +119,21 @@ func secretNamesForAdmissionPlugins(shoot *gardencorev1beta1.Shoot) []string {
 	return names
 }
 
+func configMapNamesForAdmissionPlugins(shoot *gardencorev1beta1.Shoot) []string {
+	if shoot.Spec.Kubernetes.KubeAPIServer == nil {
+		return nil
+	}
+
+	var names []string
+	for _, plugin := range shoot.Spec.Kubernetes.KubeAPIServer.AdmissionPlugins {
+		if plugin.ConfigMapName != nil {
+			names = append(names, *plugin.ConfigMapName)
+		}
+	}
+
+	return names
+}
+
 func secretNamesForStructuredAuthorization(shoot *gardencorev1beta1.Shoot) []string {
 	if shoot.Spec.Kubernetes.KubeAPIServer == nil || shoot.Spec.Kubernetes.KubeAPIServer.StructuredAuthorization == nil {
 		return nil
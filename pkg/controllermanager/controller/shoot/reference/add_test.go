@@ -81,6 +81,12 @@ var _ = Describe("Add", func() {
 			Expect(Predicate(oldShoot, shoot)).To(BeTrue())
 		})
 
+		It("should return true because the API priority and fairness config map changed", func() {
+			oldShoot := shoot.DeepCopy()
+			shoot.Spec.Kubernetes.KubeAPIServer.APIPriorityAndFairness = &gardencorev1beta1.APIPriorityAndFairness{ConfigMapName: ptr.To("flowcontrol")}
+			Expect(Predicate(oldShoot, shoot)).To(BeTrue())
+		})
+
 		It("should return true because the resources field changed", func() {
 			oldShoot := shoot.DeepCopy()
 			shoot.Spec.Resources = []gardencorev1beta1.NamedResourceReference{{
@@ -0,0 +1,200 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shootoperationbatch
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	operationsv1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	"github.com/gardener/gardener/pkg/controllerutils"
+)
+
+// Reconciler reconciles ShootOperationBatches and applies their operation annotation to the selected shoots.
+type Reconciler struct {
+	Client client.Client
+	Config config.ShootOperationBatchControllerConfiguration
+	Clock  clock.Clock
+}
+
+// Reconcile determines the shoots selected by a ShootOperationBatch and applies the operation annotation to one shoot
+// at a time, respecting the configured rate.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
+	defer cancel()
+
+	batch := &operationsv1alpha1.ShootOperationBatch{}
+	if err := r.Client.Get(ctx, request.NamespacedName, batch); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if batch.DeletionTimestamp != nil {
+		return reconcile.Result{}, nil
+	}
+
+	switch batch.Status.Phase {
+	case operationsv1alpha1.ShootOperationBatchSucceeded, operationsv1alpha1.ShootOperationBatchFailed:
+		log.V(1).Info("Batch is already completed, nothing to be done")
+		return reconcile.Result{}, nil
+	case "":
+		if err := r.initialize(ctx, log, batch); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	return r.processNextShoot(ctx, log, batch)
+}
+
+func (r *Reconciler) initialize(ctx context.Context, log logr.Logger, batch *operationsv1alpha1.ShootOperationBatch) error {
+	selector, err := metav1.LabelSelectorAsSelector(&batch.Spec.ShootSelector)
+	if err != nil {
+		return fmt.Errorf("failed parsing shoot selector: %w", err)
+	}
+
+	shootList := &gardencorev1beta1.ShootList{}
+	if err := r.Client.List(ctx, shootList, client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return fmt.Errorf("failed listing shoots: %w", err)
+	}
+
+	shoots := make([]operationsv1alpha1.ShootOperationResult, 0, len(shootList.Items))
+	for _, shoot := range shootList.Items {
+		shoots = append(shoots, operationsv1alpha1.ShootOperationResult{
+			Name:      shoot.Name,
+			Namespace: shoot.Namespace,
+			State:     operationsv1alpha1.ShootOperationPending,
+		})
+	}
+	slices.SortFunc(shoots, func(a, b operationsv1alpha1.ShootOperationResult) int {
+		return cmp.Or(cmp.Compare(a.Namespace, b.Namespace), cmp.Compare(a.Name, b.Name))
+	})
+
+	log.Info("Determined shoots for batch", "operation", batch.Spec.Operation, "count", len(shoots))
+
+	patch := client.MergeFrom(batch.DeepCopy())
+	batch.Status.Phase = operationsv1alpha1.ShootOperationBatchProcessing
+	batch.Status.Shoots = shoots
+	batch.Status.ObservedGeneration = batch.Generation
+	return r.Client.Status().Patch(ctx, batch, patch)
+}
+
+func (r *Reconciler) processNextShoot(ctx context.Context, log logr.Logger, batch *operationsv1alpha1.ShootOperationBatch) (reconcile.Result, error) {
+	var (
+		interval       = time.Minute / time.Duration(ptr.Deref(batch.Spec.ShootsPerMinute, 10))
+		next           = -1
+		lastUpdateTime time.Time
+	)
+
+	for i, result := range batch.Status.Shoots {
+		if result.State == operationsv1alpha1.ShootOperationPending {
+			if next == -1 {
+				next = i
+			}
+			continue
+		}
+
+		if result.LastUpdateTime != nil && result.LastUpdateTime.After(lastUpdateTime) {
+			lastUpdateTime = result.LastUpdateTime.Time
+		}
+	}
+
+	patch := client.MergeFrom(batch.DeepCopy())
+	batch.Status.ObservedGeneration = batch.Generation
+
+	if next == -1 {
+		r.complete(batch)
+		log.Info("Batch completed", "phase", batch.Status.Phase)
+		return reconcile.Result{}, r.Client.Status().Patch(ctx, batch, patch)
+	}
+
+	if !lastUpdateTime.IsZero() {
+		if wait := lastUpdateTime.Add(interval).Sub(r.Clock.Now()); wait > 0 {
+			log.V(1).Info("Rate limit reached, requeuing", "requeueAfter", wait)
+			return reconcile.Result{RequeueAfter: wait}, nil
+		}
+	}
+
+	result := &batch.Status.Shoots[next]
+	state, message, err := r.applyOperation(ctx, log, batch.Spec.Operation, client.ObjectKey{Namespace: result.Namespace, Name: result.Name})
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	result.State = state
+	result.Message = message
+	result.LastUpdateTime = ptr.To(metav1.NewTime(r.Clock.Now()))
+
+	if next == len(batch.Status.Shoots)-1 {
+		r.complete(batch)
+		log.Info("Batch completed", "phase", batch.Status.Phase)
+	}
+
+	if err := r.Client.Status().Patch(ctx, batch, patch); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed updating status: %w", err)
+	}
+
+	if batch.Status.Phase != operationsv1alpha1.ShootOperationBatchProcessing {
+		return reconcile.Result{}, nil
+	}
+	return reconcile.Result{RequeueAfter: interval}, nil
+}
+
+// applyOperation applies the operation annotation to the shoot with the given key. Problems which cannot be resolved by
+// retrying are reported via the returned state and message, all other errors are returned.
+func (r *Reconciler) applyOperation(ctx context.Context, log logr.Logger, operation string, key client.ObjectKey) (operationsv1alpha1.ShootOperationState, *string, error) {
+	shoot := &gardencorev1beta1.Shoot{}
+	if err := r.Client.Get(ctx, key, shoot); err != nil {
+		if apierrors.IsNotFound(err) {
+			return operationsv1alpha1.ShootOperationFailed, ptr.To("Shoot does not exist anymore"), nil
+		}
+		return "", nil, fmt.Errorf("failed reading shoot %s: %w", key, err)
+	}
+
+	if shoot.DeletionTimestamp != nil {
+		return operationsv1alpha1.ShootOperationFailed, ptr.To("Shoot is being deleted"), nil
+	}
+
+	patch := client.MergeFrom(shoot.DeepCopy())
+	metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.GardenerOperation, operation)
+	if err := r.Client.Patch(ctx, shoot, patch); err != nil {
+		if apierrors.IsInvalid(err) || apierrors.IsForbidden(err) || apierrors.IsBadRequest(err) || apierrors.IsNotFound(err) {
+			return operationsv1alpha1.ShootOperationFailed, ptr.To(fmt.Sprintf("Failed applying operation: %v", err)), nil
+		}
+		return "", nil, fmt.Errorf("failed applying operation to shoot %s: %w", key, err)
+	}
+
+	log.Info("Applied operation to shoot", "operation", operation, "shoot", key)
+	return operationsv1alpha1.ShootOperationApplied, nil, nil
+}
+
+func (r *Reconciler) complete(batch *operationsv1alpha1.ShootOperationBatch) {
+	batch.Status.Phase = operationsv1alpha1.ShootOperationBatchSucceeded
+	for _, result := range batch.Status.Shoots {
+		if result.State == operationsv1alpha1.ShootOperationFailed {
+			batch.Status.Phase = operationsv1alpha1.ShootOperationBatchFailed
+			break
+		}
+	}
+	batch.Status.CompletionTime = ptr.To(metav1.NewTime(r.Clock.Now()))
+}
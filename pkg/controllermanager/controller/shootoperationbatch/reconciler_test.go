@@ -0,0 +1,152 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shootoperationbatch_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	operationsv1alpha1 "github.com/gardener/gardener/pkg/apis/operations/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/shootoperationbatch"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx        = context.TODO()
+		fakeClient client.Client
+		fakeClock  *testclock.FakeClock
+		reconciler reconcile.Reconciler
+		request    reconcile.Request
+
+		batch                  *operationsv1alpha1.ShootOperationBatch
+		shoot1, shoot2, shoot3 *gardencorev1beta1.Shoot
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.GardenScheme).
+			WithStatusSubresource(&operationsv1alpha1.ShootOperationBatch{}).
+			Build()
+		fakeClock = testclock.NewFakeClock(time.Now().Round(time.Second))
+
+		reconciler = &Reconciler{
+			Client: fakeClient,
+			Config: config.ShootOperationBatchControllerConfiguration{ConcurrentSyncs: ptr.To(1)},
+			Clock:  fakeClock,
+		}
+
+		batch = &operationsv1alpha1.ShootOperationBatch{
+			ObjectMeta: metav1.ObjectMeta{Name: "batch", Generation: 1},
+			Spec: operationsv1alpha1.ShootOperationBatchSpec{
+				Operation:       v1beta1constants.GardenerOperationReconcile,
+				ShootSelector:   metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}},
+				ShootsPerMinute: ptr.To[int32](6),
+			},
+		}
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(batch)}
+
+		shoot1 = &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "shoot1", Namespace: "garden-b", Labels: map[string]string{"foo": "bar"}}}
+		shoot2 = &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "shoot2", Namespace: "garden-a", Labels: map[string]string{"foo": "bar"}}}
+		shoot3 = &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "shoot3", Namespace: "garden-a", Labels: map[string]string{"foo": "baz"}}}
+	})
+
+	JustBeforeEach(func() {
+		Expect(fakeClient.Create(ctx, batch)).To(Succeed())
+	})
+
+	It("should do nothing if the batch does not exist", func() {
+		Expect(fakeClient.Delete(ctx, batch)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+	})
+
+	Context("with selected shoots", func() {
+		BeforeEach(func() {
+			Expect(fakeClient.Create(ctx, shoot1)).To(Succeed())
+			Expect(fakeClient.Create(ctx, shoot2)).To(Succeed())
+			Expect(fakeClient.Create(ctx, shoot3)).To(Succeed())
+		})
+
+		It("should apply the operation to the selected shoots with the configured rate", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: 10 * time.Second}))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(batch), batch)).To(Succeed())
+			Expect(batch.Status.Phase).To(Equal(operationsv1alpha1.ShootOperationBatchProcessing))
+			Expect(batch.Status.ObservedGeneration).To(Equal(int64(1)))
+			Expect(batch.Status.Shoots).To(Equal([]operationsv1alpha1.ShootOperationResult{
+				{Name: "shoot2", Namespace: "garden-a", State: operationsv1alpha1.ShootOperationApplied, LastUpdateTime: ptr.To(metav1.NewTime(fakeClock.Now()))},
+				{Name: "shoot1", Namespace: "garden-b", State: operationsv1alpha1.ShootOperationPending},
+			}))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot2), shoot2)).To(Succeed())
+			Expect(shoot2.Annotations).To(HaveKeyWithValue("gardener.cloud/operation", "reconcile"))
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot1), shoot1)).To(Succeed())
+			Expect(shoot1.Annotations).NotTo(HaveKey("gardener.cloud/operation"))
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot3), shoot3)).To(Succeed())
+			Expect(shoot3.Annotations).NotTo(HaveKey("gardener.cloud/operation"))
+
+			By("Respect the rate limit")
+			fakeClock.Step(4 * time.Second)
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: 6 * time.Second}))
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot1), shoot1)).To(Succeed())
+			Expect(shoot1.Annotations).NotTo(HaveKey("gardener.cloud/operation"))
+
+			By("Apply the operation to the next shoot")
+			fakeClock.Step(6 * time.Second)
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot1), shoot1)).To(Succeed())
+			Expect(shoot1.Annotations).To(HaveKeyWithValue("gardener.cloud/operation", "reconcile"))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(batch), batch)).To(Succeed())
+			Expect(batch.Status.Phase).To(Equal(operationsv1alpha1.ShootOperationBatchSucceeded))
+			Expect(batch.Status.CompletionTime).To(PointTo(Equal(metav1.NewTime(fakeClock.Now()))))
+			Expect(batch.Status.Shoots[1].State).To(Equal(operationsv1alpha1.ShootOperationApplied))
+
+			By("Do nothing once completed")
+			Expect(fakeClient.Patch(ctx, shoot1, client.RawPatch(client.Merge.Type(), []byte(`{"metadata":{"annotations":null}}`)))).To(Succeed())
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(shoot1), shoot1)).To(Succeed())
+			Expect(shoot1.Annotations).NotTo(HaveKey("gardener.cloud/operation"))
+		})
+
+		It("should report shoots which do not exist anymore as failed", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: 10 * time.Second}))
+
+			Expect(fakeClient.Delete(ctx, shoot1)).To(Succeed())
+			fakeClock.Step(10 * time.Second)
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(batch), batch)).To(Succeed())
+			Expect(batch.Status.Phase).To(Equal(operationsv1alpha1.ShootOperationBatchFailed))
+			Expect(batch.Status.Shoots[0].State).To(Equal(operationsv1alpha1.ShootOperationApplied))
+			Expect(batch.Status.Shoots[1].State).To(Equal(operationsv1alpha1.ShootOperationFailed))
+			Expect(batch.Status.Shoots[1].Message).To(PointTo(Equal("Shoot does not exist anymore")))
+		})
+	})
+
+	Context("without selected shoots", func() {
+		It("should complete the batch immediately", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(batch), batch)).To(Succeed())
+			Expect(batch.Status.Phase).To(Equal(operationsv1alpha1.ShootOperationBatchSucceeded))
+			Expect(batch.Status.Shoots).To(BeEmpty())
+		})
+	})
+})
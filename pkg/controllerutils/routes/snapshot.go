@@ -0,0 +1,274 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package routes
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"runtime/pprof"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/common/expfmt"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/client-go/rest"
+	"k8s.io/component-base/version"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+	"sigs.k8s.io/controller-runtime/pkg/metrics/filters"
+
+	"github.com/gardener/gardener/pkg/logger"
+)
+
+// DebugSnapshotPath is the path of the endpoint serving debug snapshots.
+const DebugSnapshotPath = "/debug/snapshot"
+
+// queueMetricPrefixes are the prefixes of the metrics describing the states of the controllers and their queues.
+var queueMetricPrefixes = []string{"workqueue_", "controller_runtime_reconcile_", "controller_runtime_active_workers", "controller_runtime_max_concurrent_reconciles"}
+
+// CachedObjects describes objects in a cache which are counted for debug snapshots. The caller must only specify
+// object kinds which are watched by the controllers of the component, otherwise new informers are started when
+// producing a snapshot.
+type CachedObjects struct {
+	// Cluster is the name of the cluster the cache belongs to.
+	Cluster string
+	// Reader is the cache reader.
+	Reader client.Reader
+	// Lists are the object lists to count.
+	Lists []client.ObjectList
+}
+
+// DebugSnapshot produces support bundles containing the goroutine and heap profiles, the states of the controller
+// queues, the numbers of cached objects and the most recent errors of the running component.
+type DebugSnapshot struct {
+	// Component is the name of the component.
+	Component string
+	// Clock is used to determine the time of the snapshot.
+	Clock clock.Clock
+	// Gatherer is used to gather the metrics describing the queue states. Defaults to the controller-runtime registry.
+	Gatherer prometheus.Gatherer
+	// Errors is the ring buffer containing the most recent errors. Defaults to logger.RecentErrors.
+	Errors *logger.ErrorRingBuffer
+
+	lock          sync.RWMutex
+	cachedObjects []CachedObjects
+}
+
+// AddCachedObjects adds objects which are counted when producing snapshots. It is safe to call this function after the
+// endpoint has been started.
+func (s *DebugSnapshot) AddCachedObjects(cachedObjects CachedObjects) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.cachedObjects = append(s.cachedObjects, cachedObjects)
+}
+
+// ServeHTTP writes the support bundle as gzipped tarball.
+func (s *DebugSnapshot) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	now := time.Now()
+	if s.Clock != nil {
+		now = s.Clock.Now()
+	}
+
+	files, err := s.collect(req.Context(), now)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var (
+		buffer    = &bytes.Buffer{}
+		gzw       = gzip.NewWriter(buffer)
+		tw        = tar.NewWriter(gzw)
+		directory = fmt.Sprintf("%s-%s", s.Component, now.UTC().Format("20060102-150405"))
+	)
+
+	for _, file := range files {
+		if err := tw.WriteHeader(&tar.Header{
+			Name:    directory + "/" + file.name,
+			Mode:    0600,
+			Size:    int64(len(file.content)),
+			ModTime: now,
+		}); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		if _, err := tw.Write(file.content); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if err := gzw.Close(); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/gzip")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", directory+".tar.gz"))
+	_, _ = w.Write(buffer.Bytes())
+}
+
+type snapshotFile struct {
+	name    string
+	content []byte
+}
+
+func (s *DebugSnapshot) collect(ctx context.Context, now time.Time) ([]snapshotFile, error) {
+	info, err := json.MarshalIndent(map[string]any{
+		"component": s.Component,
+		"version":   version.Get(),
+		"time":      now.UTC(),
+	}, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	goroutines, err := profile("goroutine", 2)
+	if err != nil {
+		return nil, err
+	}
+
+	heap, err := profile("heap", 0)
+	if err != nil {
+		return nil, err
+	}
+
+	queues, err := s.queueStates()
+	if err != nil {
+		return nil, err
+	}
+
+	objects, err := json.MarshalIndent(s.countCachedObjects(ctx), "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	errorRingBuffer := s.Errors
+	if errorRingBuffer == nil {
+		errorRingBuffer = logger.RecentErrors
+	}
+	errors, err := json.MarshalIndent(errorRingBuffer.Entries(), "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	return []snapshotFile{
+		{name: "info.json", content: info},
+		{name: "goroutines.txt", content: goroutines},
+		{name: "heap.pprof", content: heap},
+		{name: "queues.txt", content: queues},
+		{name: "objects.json", content: objects},
+		{name: "errors.json", content: errors},
+	}, nil
+}
+
+func profile(name string, debug int) ([]byte, error) {
+	p := pprof.Lookup(name)
+	if p == nil {
+		return nil, fmt.Errorf("profile %q not found", name)
+	}
+
+	buffer := &bytes.Buffer{}
+	if err := p.WriteTo(buffer, debug); err != nil {
+		return nil, fmt.Errorf("failed writing %s profile: %w", name, err)
+	}
+	return buffer.Bytes(), nil
+}
+
+func (s *DebugSnapshot) queueStates() ([]byte, error) {
+	var gatherer prometheus.Gatherer = metrics.Registry
+	if s.Gatherer != nil {
+		gatherer = s.Gatherer
+	}
+
+	families, err := gatherer.Gather()
+	if err != nil {
+		return nil, fmt.Errorf("failed gathering metrics: %w", err)
+	}
+
+	buffer := &bytes.Buffer{}
+	for _, family := range families {
+		if !hasAnyPrefix(family.GetName(), queueMetricPrefixes) {
+			continue
+		}
+		if _, err := expfmt.MetricFamilyToText(buffer, family); err != nil {
+			return nil, fmt.Errorf("failed writing metric %s: %w", family.GetName(), err)
+		}
+	}
+	return buffer.Bytes(), nil
+}
+
+func (s *DebugSnapshot) countCachedObjects(ctx context.Context) map[string]map[string]any {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	out := make(map[string]map[string]any, len(s.cachedObjects))
+	for _, cachedObjects := range s.cachedObjects {
+		counts := make(map[string]any, len(cachedObjects.Lists))
+		for _, list := range cachedObjects.Lists {
+			list = list.DeepCopyObject().(client.ObjectList)
+			name := strings.TrimPrefix(fmt.Sprintf("%T", list), "*")
+
+			if err := cachedObjects.Reader.List(ctx, list); err != nil {
+				counts[name] = err.Error()
+				continue
+			}
+			counts[name] = meta.LenList(list)
+		}
+		out[cachedObjects.Cluster] = counts
+	}
+	return out
+}
+
+func hasAnyPrefix(name string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// DebugHandlers returns the profiling handlers and the handler for the given debug snapshot. Requests for snapshots are
+// authenticated and authorized by delegating to the API server of the given cluster, i.e., the caller must be allowed
+// to `get` the non-resource URL `/debug/snapshot`.
+func DebugHandlers(log logr.Logger, restConfig *rest.Config, snapshot *DebugSnapshot) (map[string]http.Handler, error) {
+	httpClient, err := rest.HTTPClientFor(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating HTTP client: %w", err)
+	}
+
+	filter, err := filters.WithAuthenticationAndAuthorization(restConfig, httpClient)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating authentication and authorization filter: %w", err)
+	}
+
+	snapshotHandler, err := filter(log.WithName("debug-snapshot"), snapshot)
+	if err != nil {
+		return nil, fmt.Errorf("failed applying authentication and authorization filter: %w", err)
+	}
+
+	handlers := make(map[string]http.Handler, len(ProfilingHandlers)+1)
+	for path, handler := range ProfilingHandlers {
+		handlers[path] = handler
+	}
+	handlers[DebugSnapshotPath] = snapshotHandler
+
+	return handlers, nil
+}
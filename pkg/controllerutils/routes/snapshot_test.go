@@ -0,0 +1,93 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package routes_test
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/go-logr/logr/funcr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/controllerutils/routes"
+	"github.com/gardener/gardener/pkg/logger"
+)
+
+var _ = Describe("DebugSnapshot", func() {
+	var (
+		ctx = context.Background()
+
+		registry *prometheus.Registry
+		errs     *logger.ErrorRingBuffer
+		snapshot *DebugSnapshot
+	)
+
+	BeforeEach(func() {
+		registry = prometheus.NewRegistry()
+		depth := prometheus.NewGaugeVec(prometheus.GaugeOpts{Name: "workqueue_depth"}, []string{"name"})
+		depth.WithLabelValues("shoot").Set(3)
+		registry.MustRegister(depth, prometheus.NewGauge(prometheus.GaugeOpts{Name: "unrelated_metric"}))
+
+		errs = logger.NewErrorRingBuffer(5)
+		errs.Wrap(funcr.New(func(_, _ string) {}, funcr.Options{})).Error(errors.New("fake"), "Reconciler error")
+
+		fakeClient := fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		Expect(fakeClient.Create(ctx, &resourcesv1alpha1.ManagedResource{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "bar"}})).To(Succeed())
+
+		snapshot = &DebugSnapshot{
+			Component: "gardenlet",
+			Clock:     testclock.NewFakeClock(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)),
+			Gatherer:  registry,
+			Errors:    errs,
+		}
+		snapshot.AddCachedObjects(CachedObjects{Cluster: "seed", Reader: fakeClient, Lists: []client.ObjectList{&resourcesv1alpha1.ManagedResourceList{}}})
+	})
+
+	It("should serve a support bundle", func() {
+		recorder := httptest.NewRecorder()
+		snapshot.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, DebugSnapshotPath, nil))
+
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		Expect(recorder.Header().Get("Content-Disposition")).To(Equal(`attachment; filename="gardenlet-20240102-030405.tar.gz"`))
+
+		gzr, err := gzip.NewReader(recorder.Body)
+		Expect(err).NotTo(HaveOccurred())
+
+		files := map[string]string{}
+		tr := tar.NewReader(gzr)
+		for {
+			header, err := tr.Next()
+			if err == io.EOF {
+				break
+			}
+			Expect(err).NotTo(HaveOccurred())
+
+			content, err := io.ReadAll(tr)
+			Expect(err).NotTo(HaveOccurred())
+			files[header.Name] = string(content)
+		}
+
+		Expect(files).To(HaveKeyWithValue("gardenlet-20240102-030405/info.json", ContainSubstring(`"component": "gardenlet"`)))
+		Expect(files).To(HaveKeyWithValue("gardenlet-20240102-030405/goroutines.txt", ContainSubstring("goroutine")))
+		Expect(files).To(HaveKeyWithValue("gardenlet-20240102-030405/heap.pprof", Not(BeEmpty())))
+		Expect(files).To(HaveKeyWithValue("gardenlet-20240102-030405/queues.txt", And(ContainSubstring(`workqueue_depth{name="shoot"} 3`), Not(ContainSubstring("unrelated_metric")))))
+		Expect(files).To(HaveKeyWithValue("gardenlet-20240102-030405/objects.json", MatchJSON(`{"seed":{"v1alpha1.ManagedResourceList":1}}`)))
+		Expect(files).To(HaveKeyWithValue("gardenlet-20240102-030405/errors.json", And(ContainSubstring(`"message": "Reconciler error"`), ContainSubstring(`"error": "fake"`))))
+	})
+})
@@ -53,6 +53,8 @@ func WaitUntilExtensionObjectReady(
 // function that should be executed.
 // Passed objects are expected to be filled with the latest state the controller/component
 // observed/retrieved, but at least namespace and name.
+// If the reads of the client are served by the informers of a cache (see health.InformerClient), the object is
+// re-evaluated as soon as the informer observes a change instead of only after the next interval.
 func WaitUntilObjectReadyWithHealthFunction(
 	ctx context.Context,
 	c client.Client,
@@ -82,8 +84,24 @@ func WaitUntilObjectReadyWithHealthFunction(
 		healthFunc = health.And(health.ObjectHasAnnotationWithValue(v1beta1constants.GardenerTimestamp, expectedTimestamp), healthFunc)
 	}
 
+	untilTimeout := retry.UntilTimeout
+	if informerClient, ok := c.(health.InformerClient); ok {
+		watchCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		if changes, err := health.WatchObject(watchCtx, informerClient.Informers(), obj); err != nil {
+			log.V(1).Info("Falling back to polling since object cannot be watched", "reason", err.Error())
+		} else {
+			untilTimeout = func(ctx context.Context, interval, timeout time.Duration, f retry.Func) error {
+				ctx, cancel := context.WithTimeout(ctx, timeout)
+				defer cancel()
+				return retry.UntilFor(ctx, intervalOrChange(interval, changes), retry.DefaultErrorAggregatorFactory().New(), f)
+			}
+		}
+	}
+
 	var objectKind string
-	if err := retry.UntilTimeout(ctx, interval, timeout, func(ctx context.Context) (bool, error) {
+	if err := untilTimeout(ctx, interval, timeout, func(ctx context.Context) (bool, error) {
 		retryCountUntilSevere++
 
 		if err := c.Get(ctx, client.ObjectKeyFromObject(obj), obj); err != nil {
@@ -128,6 +146,22 @@ func WaitUntilObjectReadyWithHealthFunction(
 	return nil
 }
 
+// intervalOrChange returns a retry.WaitFunc which waits for the given interval or until a change is received on the
+// given channel, whichever happens first.
+func intervalOrChange(interval time.Duration, changes <-chan struct{}) retry.WaitFunc {
+	return func(ctx context.Context) (context.Context, context.CancelFunc) {
+		waitCtx, cancel := context.WithTimeout(ctx, interval)
+		go func() {
+			select {
+			case <-changes:
+				cancel()
+			case <-waitCtx.Done():
+			}
+		}()
+		return waitCtx, cancel
+	}
+}
+
 // DeleteExtensionObject deletes a given extension object.
 // Passed objects are expected to be filled with the latest state the controller/component
 // observed/retrieved, but at least namespace and name.
@@ -18,6 +18,8 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/cache/informertest"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
@@ -227,6 +229,34 @@ var _ = Describe("extensions", func() {
 			Expect(err).NotTo(HaveOccurred())
 		})
 
+		It("should re-evaluate the object as soon as the informer observes a change", func() {
+			informers := &informertest.FakeInformers{Scheme: scheme}
+			informer, err := informers.FakeInformerFor(ctx, expected)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(c.Create(ctx, expected)).To(Succeed())
+
+			var calls int
+			Expect(WaitUntilObjectReadyWithHealthFunction(
+				ctx, &informerClient{Client: c, informers: informers}, log,
+				func(obj client.Object) error {
+					calls++
+					if calls == 1 {
+						// The interval is much longer than the timeout, hence the object is only re-evaluated in time if
+						// the change observed by the informer ends the wait.
+						changed := obj.DeepCopyObject().(client.Object)
+						go informer.Update(changed, changed)
+						return errors.New("not ready")
+					}
+					return nil
+				},
+				expected, extensionsv1alpha1.WorkerResource,
+				time.Hour, time.Hour, 10*time.Second,
+				nil,
+			)).To(Succeed())
+			Expect(calls).To(Equal(2))
+		})
+
 		It("should return error if ready func returns error", func() {
 			fakeError := &specialWrappingError{
 				error: v1beta1helper.NewErrorWithCodes(errors.New("foo"), gardencorev1beta1.ErrorInfraUnauthorized),
@@ -872,6 +902,15 @@ var _ = Describe("extensions", func() {
 	})
 })
 
+type informerClient struct {
+	client.Client
+	informers cache.Informers
+}
+
+func (c *informerClient) Informers() cache.Informers {
+	return c.informers
+}
+
 type specialWrappingError struct {
 	error
 }
@@ -65,6 +65,17 @@ const (
 	// owner: @oliver-goetz
 	// alpha: v1.109
 	NodeAgentAuthorizer featuregate.Feature = "NodeAgentAuthorizer"
+
+	// RemoveLegacyShootLogging removes the legacy shoot logging stack (Vali in the shoot control plane and Valitail on
+	// the shoot nodes) from all shoot clusters. The progress of the removal is tracked in the shoot status.
+	// owner: @gardener/gardener-maintainers
+	// alpha: v1.111.0
+	RemoveLegacyShootLogging featuregate.Feature = "RemoveLegacyShootLogging"
+
+	// WindowsWorkerPools allows configuring worker pools with the Windows operating system family in Shoots.
+	// owner: @gardener/gardener-maintainers
+	// alpha: v1.111.0
+	WindowsWorkerPools featuregate.Feature = "WindowsWorkerPools"
 )
 
 // DefaultFeatureGate is the central feature gate map used by all gardener components.
@@ -100,6 +111,8 @@ var AllFeatureGates = map[featuregate.Feature]featuregate.FeatureSpec{
 	NewWorkerPoolHash:         {Default: false, PreRelease: featuregate.Alpha},
 	NewVPN:                    {Default: false, PreRelease: featuregate.Alpha},
 	NodeAgentAuthorizer:       {Default: false, PreRelease: featuregate.Alpha},
+	RemoveLegacyShootLogging:  {Default: false, PreRelease: featuregate.Alpha},
+	WindowsWorkerPools:        {Default: false, PreRelease: featuregate.Alpha},
 }
 
 // GetFeatures returns a feature gate map with the respective specifications. Non-existing feature gates are ignored.
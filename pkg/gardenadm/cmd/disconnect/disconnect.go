@@ -0,0 +1,48 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package disconnect
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+)
+
+// NewCommand creates a new cobra.Command.
+func NewCommand(ioStreams genericiooptions.IOStreams) *cobra.Command {
+	opts := &Options{}
+
+	cmd := &cobra.Command{
+		Use:   "disconnect",
+		Short: "Detach the cluster from its garden and continue managing it locally",
+		Long:  "Detach the cluster from its garden: revoke the credentials of the gardenlet for the garden and point the controllers in the cluster back to the local configuration, while preserving the identity and state of the cluster",
+
+		Example: `# Detach the cluster from its garden
+gardenadm disconnect`,
+
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if err := opts.Complete(); err != nil {
+				return err
+			}
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			return run(cmd.Context(), ioStreams, opts)
+		},
+	}
+
+	opts.addFlags(cmd.Flags())
+
+	return cmd
+}
+
+// TODO: Implement this command once `gardenadm connect` deploys a gardenlet connected to a garden.
+func run(_ context.Context, _ genericiooptions.IOStreams, _ *Options) error {
+	return fmt.Errorf("gardenadm disconnect is not implemented yet, it requires gardenadm connect to deploy a gardenlet connected to a garden")
+}
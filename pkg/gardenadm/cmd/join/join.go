@@ -7,25 +7,76 @@ package join
 import (
 	"context"
 	"fmt"
+	"os"
+	"path"
+	"strings"
 
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/client-go/rest"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logzap "sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	extensionsv1alpha1helper "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1/helper"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/nodeinit"
+	nodeagentcomponent "github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original/components/nodeagent"
+	"github.com/gardener/gardener/pkg/features"
+	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/nodeagent"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/nodeagent/dbus"
+)
+
+var (
+	// NewClient creates a client for the autonomous shoot cluster. It is exposed for testing.
+	NewClient = func(restConfig *rest.Config) (client.Client, error) {
+		return client.New(restConfig, client.Options{Scheme: kubernetes.ShootScheme})
+	}
+	// FS is the file system of the node. It is exposed for testing.
+	FS = afero.Afero{Fs: afero.NewOsFs()}
+	// NewDBus creates a dbus connection for managing the systemd units of the node. It is exposed for testing.
+	NewDBus = dbus.New
+
+	decoder runtime.Decoder
 )
 
+func init() {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(extensionsv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(nodeagentconfigv1alpha1.AddToScheme(scheme))
+	decoder = serializer.NewCodecFactory(scheme).UniversalDeserializer()
+}
+
 // NewCommand creates a new cobra.Command.
 func NewCommand(ioStreams genericiooptions.IOStreams) *cobra.Command {
 	opts := &Options{}
 
 	cmd := &cobra.Command{
-		Use:   "join",
+		Use:   "join <control-plane-address>",
 		Short: "Bootstrap further control plane nodes or worker nodes and join them to the cluster",
-		Long:  "Bootstrap further control plane nodes or worker nodes and join them to the cluster",
+		Long: "Bootstrap further control plane nodes or worker nodes and join them to the cluster. " +
+			"The operating system config of the worker pool is downloaded from the cluster with the help of the bootstrap token, " +
+			"and gardener-node-agent is bootstrapped on this machine. It then applies the operating system config and starts " +
+			"the kubelet. Use `gardenadm token create --print-join-command` on the first control plane node to get the full command.",
 
 		Example: `# Bootstrap a worker node and join it to the cluster
-gardenadm join`,
+gardenadm join --bootstrap-token foo123.bar4567890baz123 --ca-certificate <base64-encoded-ca-bundle> --gardener-node-agent-secret-name <secret-name> https://api.example.com`,
+
+		Args: cobra.MaximumNArgs(1),
 
-		RunE: func(cmd *cobra.Command, _ []string) error {
-			if err := opts.Complete(); err != nil {
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := opts.Complete(args); err != nil {
 				return err
 			}
 
@@ -42,7 +93,151 @@ gardenadm join`,
 	return cmd
 }
 
-func run(_ context.Context, ioStreams genericiooptions.IOStreams, _ *Options) error {
-	fmt.Fprintln(ioStreams.Out, "not implemented either")
+func run(ctx context.Context, ioStreams genericiooptions.IOStreams, opts *Options) error {
+	c, err := NewClient(&rest.Config{
+		Host:            opts.ControlPlaneAddress,
+		BearerToken:     opts.BootstrapToken,
+		TLSClientConfig: rest.TLSClientConfig{CAData: opts.CACertificate},
+	})
+	if err != nil {
+		return fmt.Errorf("failed creating client for control plane %q: %w", opts.ControlPlaneAddress, err)
+	}
+
+	fmt.Fprintf(ioStreams.Out, "Fetching operating system config from secret %q\n", opts.GardenerNodeAgentSecretName)
+	osc, err := fetchOperatingSystemConfig(ctx, c, opts.GardenerNodeAgentSecretName)
+	if err != nil {
+		return err
+	}
+
+	units, files, err := nodeInitConfig(osc, opts.GardenerNodeAgentSecretName)
+	if err != nil {
+		return err
+	}
+
+	hostName, err := nodeagent.GetHostName()
+	if err != nil {
+		return fmt.Errorf("failed fetching hostname: %w", err)
+	}
+
+	replacer := strings.NewReplacer(
+		"<<BOOTSTRAP_TOKEN>>", opts.BootstrapToken,
+		"<<MACHINE_NAME>>", hostName,
+	)
+
+	for _, file := range files {
+		fmt.Fprintf(ioStreams.Out, "Writing file %q\n", file.Path)
+		if err := writeFile(file, replacer); err != nil {
+			return err
+		}
+	}
+
+	for _, unit := range units {
+		unitFilePath := path.Join("/", "etc", "systemd", "system", unit.Name)
+		fmt.Fprintf(ioStreams.Out, "Writing unit file %q\n", unitFilePath)
+		if err := FS.WriteFile(unitFilePath, []byte(ptr.Deref(unit.Content, "")), 0644); err != nil {
+			return fmt.Errorf("failed writing unit file %q: %w", unitFilePath, err)
+		}
+	}
+
+	systemd := NewDBus(logger.MustNewZapLogger(logger.InfoLevel, logger.FormatText, logzap.WriteTo(ioStreams.ErrOut)))
+
+	if err := systemd.DaemonReload(ctx); err != nil {
+		return fmt.Errorf("failed reloading systemd daemon: %w", err)
+	}
+
+	if err := systemd.Enable(ctx, nodeagentconfigv1alpha1.InitUnitName); err != nil {
+		return fmt.Errorf("failed enabling unit %q: %w", nodeagentconfigv1alpha1.InitUnitName, err)
+	}
+
+	if err := systemd.Start(ctx, nil, nil, nodeagentconfigv1alpha1.InitUnitName); err != nil {
+		return fmt.Errorf("failed starting unit %q: %w", nodeagentconfigv1alpha1.InitUnitName, err)
+	}
+
+	fmt.Fprintln(ioStreams.Out, "gardener-node-agent has been bootstrapped, the node will join the cluster shortly")
+	return nil
+}
+
+func fetchOperatingSystemConfig(ctx context.Context, c client.Client, secretName string) (*extensionsv1alpha1.OperatingSystemConfig, error) {
+	secret := &corev1.Secret{}
+	if err := c.Get(ctx, client.ObjectKey{Name: secretName, Namespace: metav1.NamespaceSystem}, secret); err != nil {
+		return nil, fmt.Errorf("failed reading secret %q containing the operating system config: %w", secretName, err)
+	}
+
+	osc := &extensionsv1alpha1.OperatingSystemConfig{}
+	if err := runtime.DecodeInto(decoder, secret.Data[nodeagentconfigv1alpha1.DataKeyOperatingSystemConfig], osc); err != nil {
+		return nil, fmt.Errorf("failed decoding operating system config from secret %q: %w", secretName, err)
+	}
+
+	if osc.Labels == nil {
+		osc.Labels = map[string]string{}
+	}
+	osc.Labels[v1beta1constants.LabelWorkerPool] = secret.Labels[v1beta1constants.LabelWorkerPool]
+
+	return osc, nil
+}
+
+// nodeInitConfig computes the units and files for bootstrapping gardener-node-agent in the same way as for machines
+// created by machine-controller-manager, i.e., it uses the gardener-node-agent image and configuration contained in the
+// operating system config.
+func nodeInitConfig(osc *extensionsv1alpha1.OperatingSystemConfig, secretName string) ([]extensionsv1alpha1.Unit, []extensionsv1alpha1.File, error) {
+	var nodeAgentImage string
+	if file := fileWithPath(osc.Spec.Files, nodeagentcomponent.PathBinary); file != nil && file.Content.ImageRef != nil {
+		nodeAgentImage = file.Content.ImageRef.Image
+	}
+	if nodeAgentImage == "" {
+		return nil, nil, fmt.Errorf("operating system config in secret %q does not contain the gardener-node-agent image", secretName)
+	}
+
+	configFile := fileWithPath(osc.Spec.Files, nodeagentconfigv1alpha1.ConfigFilePath)
+	if configFile == nil || configFile.Content.Inline == nil {
+		return nil, nil, fmt.Errorf("operating system config in secret %q does not contain the gardener-node-agent configuration", secretName)
+	}
+
+	configRaw, err := extensionsv1alpha1helper.Decode(configFile.Content.Inline.Encoding, []byte(configFile.Content.Inline.Data))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed decoding gardener-node-agent configuration: %w", err)
+	}
+
+	config := &nodeagentconfigv1alpha1.NodeAgentConfiguration{}
+	if err := runtime.DecodeInto(decoder, configRaw, config); err != nil {
+		return nil, nil, fmt.Errorf("failed decoding gardener-node-agent configuration: %w", err)
+	}
+
+	// The feature gates of gardener-node-agent influence the files required for bootstrapping, hence gardenadm uses the
+	// same settings as the control plane.
+	if err := features.DefaultFeatureGate.SetFromMap(config.FeatureGates); err != nil {
+		return nil, nil, fmt.Errorf("failed setting feature gates of gardener-node-agent: %w", err)
+	}
+
+	return nodeinit.Config(gardencorev1beta1.Worker{Name: osc.Labels[v1beta1constants.LabelWorkerPool]}, nodeAgentImage, config)
+}
+
+func fileWithPath(files []extensionsv1alpha1.File, filePath string) *extensionsv1alpha1.File {
+	for _, file := range files {
+		if file.Path == filePath {
+			return &file
+		}
+	}
+	return nil
+}
+
+func writeFile(file extensionsv1alpha1.File, replacer *strings.Replacer) error {
+	if file.Content.Inline == nil {
+		return fmt.Errorf("file %q has no inline content", file.Path)
+	}
+
+	content, err := extensionsv1alpha1helper.Decode(file.Content.Inline.Encoding, []byte(file.Content.Inline.Data))
+	if err != nil {
+		return fmt.Errorf("failed decoding content of file %q: %w", file.Path, err)
+	}
+
+	if err := FS.MkdirAll(path.Dir(file.Path), os.ModeDir|0755); err != nil {
+		return fmt.Errorf("failed creating directory for file %q: %w", file.Path, err)
+	}
+
+	if err := FS.WriteFile(file.Path, []byte(replacer.Replace(string(content))), os.FileMode(ptr.Deref(file.Permissions, 0600))); err != nil {
+		return fmt.Errorf("failed writing file %q: %w", file.Path, err)
+	}
+
 	return nil
 }
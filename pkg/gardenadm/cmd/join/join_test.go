@@ -5,36 +5,154 @@
 package join_test
 
 import (
-	"bytes"
-	"io"
+	"context"
+	"encoding/json"
 
+	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	nodeagentcomponent "github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original/components/nodeagent"
+	"github.com/gardener/gardener/pkg/features"
 	. "github.com/gardener/gardener/pkg/gardenadm/cmd/join"
+	"github.com/gardener/gardener/pkg/nodeagent"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/nodeagent/dbus"
+	fakedbus "github.com/gardener/gardener/pkg/nodeagent/dbus/fake"
+	"github.com/gardener/gardener/pkg/utils/test"
 )
 
 var _ = Describe("Join", func() {
 	var (
+		ctx       = context.Background()
 		ioStreams genericiooptions.IOStreams
-		out       *bytes.Buffer
 		cmd       *cobra.Command
+		args      = []string{"https://api.example.com"}
+
+		fakeClient client.Client
+		fakeFS     afero.Afero
+		fakeDBus   *fakedbus.DBus
+		restConfig *rest.Config
+
+		nodeAgentFeatureGates map[string]bool
 	)
 
 	BeforeEach(func() {
-		ioStreams, _, out, _ = genericiooptions.NewTestIOStreams()
+		ioStreams, _, _, _ = genericiooptions.NewTestIOStreams()
 		cmd = NewCommand(ioStreams)
+		cmd.SetContext(ctx)
+		Expect(cmd.Flags().Set("bootstrap-token", "foo123.bar4567890baz123")).To(Succeed())
+		Expect(cmd.Flags().Set("ca-certificate", "Y2E=")).To(Succeed())
+		Expect(cmd.Flags().Set("gardener-node-agent-secret-name", "gardener-node-agent-worker-1234")).To(Succeed())
+
+		nodeAgentFeatureGates = nil
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).Build()
+		fakeFS = afero.Afero{Fs: afero.NewMemMapFs()}
+		fakeDBus = fakedbus.New()
+
+		DeferCleanup(test.WithVars(
+			&NewClient, func(config *rest.Config) (client.Client, error) {
+				restConfig = config
+				return fakeClient, nil
+			},
+			&FS, fakeFS,
+			&NewDBus, func(logr.Logger) dbus.DBus { return fakeDBus },
+		))
+	})
+
+	JustBeforeEach(func() {
+		configFiles, err := nodeagentcomponent.Files(&nodeagentconfigv1alpha1.NodeAgentConfiguration{
+			APIServer: nodeagentconfigv1alpha1.APIServer{Server: "https://api.example.com", CABundle: []byte("ca")},
+			Controllers: nodeagentconfigv1alpha1.ControllerConfiguration{
+				OperatingSystemConfig: nodeagentconfigv1alpha1.OperatingSystemConfigControllerConfig{SecretName: "gardener-node-agent-worker-1234"},
+			},
+			FeatureGates: nodeAgentFeatureGates,
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		oscRaw, err := json.Marshal(&extensionsv1alpha1.OperatingSystemConfig{
+			TypeMeta: metav1.TypeMeta{APIVersion: "extensions.gardener.cloud/v1alpha1", Kind: "OperatingSystemConfig"},
+			Spec: extensionsv1alpha1.OperatingSystemConfigSpec{
+				Files: append(configFiles, extensionsv1alpha1.File{
+					Path: "/opt/bin/gardener-node-agent",
+					Content: extensionsv1alpha1.FileContent{
+						ImageRef: &extensionsv1alpha1.FileContentImageRef{Image: "gardener-node-agent:v1", FilePathInImage: "/gardener-node-agent"},
+					},
+				}),
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(fakeClient.Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "gardener-node-agent-worker-1234",
+				Namespace: "kube-system",
+				Labels:    map[string]string{"worker.gardener.cloud/pool": "worker"},
+			},
+			Data: map[string][]byte{"osc.yaml": oscRaw},
+		})).To(Succeed())
 	})
 
 	Describe("#RunE", func() {
-		It("should return the expected output", func() {
-			Expect(cmd.RunE(cmd, nil)).To(Succeed())
+		It("should bootstrap gardener-node-agent with the operating system config of the worker pool", func() {
+			Expect(cmd.RunE(cmd, args)).To(Succeed())
 
-			output, err := io.ReadAll(out)
+			Expect(restConfig.Host).To(Equal("https://api.example.com"))
+			Expect(restConfig.BearerToken).To(Equal("foo123.bar4567890baz123"))
+			Expect(restConfig.CAData).To(Equal([]byte("ca")))
+
+			test.AssertFileOnDisk(fakeFS, "/var/lib/gardener-node-agent/credentials/bootstrap-token", "foo123.bar4567890baz123", 0640)
+
+			initScript, err := fakeFS.ReadFile("/var/lib/gardener-node-agent/init.sh")
 			Expect(err).NotTo(HaveOccurred())
-			Expect(string(output)).To(Equal("not implemented either\n"))
+			Expect(string(initScript)).To(ContainSubstring(`ctr images pull  "gardener-node-agent:v1"`))
+
+			config, err := fakeFS.ReadFile("/var/lib/gardener-node-agent/config.yaml")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(config)).To(ContainSubstring("secretName: gardener-node-agent-worker-1234"))
+			Expect(string(config)).To(ContainSubstring("server: https://api.example.com"))
+
+			unit, err := fakeFS.ReadFile("/etc/systemd/system/gardener-node-init.service")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(unit)).To(ContainSubstring("ExecStart=/var/lib/gardener-node-agent/init.sh"))
+
+			Expect(fakeDBus.Actions).To(Equal([]fakedbus.SystemdAction{
+				{Action: fakedbus.ActionDaemonReload},
+				{Action: fakedbus.ActionEnable, UnitNames: []string{"gardener-node-init.service"}},
+				{Action: fakedbus.ActionStart, UnitNames: []string{"gardener-node-init.service"}},
+			}))
+		})
+
+		Context("when gardener-node-agent uses the NodeAgentAuthorizer feature", func() {
+			BeforeEach(func() {
+				nodeAgentFeatureGates = map[string]bool{"NodeAgentAuthorizer": true}
+
+				DeferCleanup(test.WithFeatureGate(features.DefaultFeatureGate, features.NodeAgentAuthorizer, false))
+				DeferCleanup(test.WithVar(&nodeagent.Hostname, func() (string, error) { return "Node-1", nil }))
+			})
+
+			It("should write the machine name", func() {
+				Expect(cmd.RunE(cmd, args)).To(Succeed())
+
+				test.AssertFileOnDisk(fakeFS, "/var/lib/gardener-node-agent/machine-name", "node-1", 0640)
+			})
+		})
+
+		It("should fail if the operating system config secret does not exist", func() {
+			Expect(cmd.Flags().Set("gardener-node-agent-secret-name", "foo")).To(Succeed())
+
+			Expect(cmd.RunE(cmd, args)).To(MatchError(ContainSubstring(`failed reading secret "foo" containing the operating system config`)))
+			Expect(fakeDBus.Actions).To(BeEmpty())
 		})
 	})
 })
@@ -5,16 +5,62 @@
 package join
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/spf13/pflag"
+	bootstraptokenutil "k8s.io/cluster-bootstrap/token/util"
 )
 
 // Options contains options for this command.
-type Options struct{}
+type Options struct {
+	// ControlPlaneAddress is the address of the kube-apiserver of the autonomous shoot cluster.
+	ControlPlaneAddress string
+	// BootstrapToken is the bootstrap token used for joining the node.
+	BootstrapToken string
+	// CACertificate is the CA bundle of the kube-apiserver of the autonomous shoot cluster.
+	CACertificate []byte
+	// GardenerNodeAgentSecretName is the name of the secret containing the operating system config of the worker pool
+	// which the node shall join.
+	GardenerNodeAgentSecretName string
+}
 
 // Complete completes the options.
-func (o *Options) Complete() error { return nil }
+func (o *Options) Complete(args []string) error {
+	if len(args) > 0 {
+		o.ControlPlaneAddress = strings.TrimSpace(args[0])
+	}
+
+	if o.ControlPlaneAddress != "" && !strings.Contains(o.ControlPlaneAddress, "://") {
+		o.ControlPlaneAddress = "https://" + o.ControlPlaneAddress
+	}
+
+	return nil
+}
 
 // Validate validates the options.
-func (o *Options) Validate() error { return nil }
+func (o *Options) Validate() error {
+	if o.ControlPlaneAddress == "" {
+		return fmt.Errorf("must provide the address of the control plane")
+	}
+
+	if !bootstraptokenutil.IsValidBootstrapToken(o.BootstrapToken) {
+		return fmt.Errorf("must provide a bootstrap token of the form \"[a-z0-9]{6}.[a-z0-9]{16}\"")
+	}
+
+	if len(o.CACertificate) == 0 {
+		return fmt.Errorf("must provide the CA certificate of the control plane")
+	}
+
+	if o.GardenerNodeAgentSecretName == "" {
+		return fmt.Errorf("must provide the name of the gardener-node-agent secret")
+	}
+
+	return nil
+}
 
-func (o *Options) addFlags(_ *pflag.FlagSet) {}
+func (o *Options) addFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.BootstrapToken, "bootstrap-token", "", "Bootstrap token for joining the cluster (create it with `gardenadm token create`)")
+	fs.BytesBase64Var(&o.CACertificate, "ca-certificate", nil, "Base64-encoded CA certificate bundle of the control plane")
+	fs.StringVar(&o.GardenerNodeAgentSecretName, "gardener-node-agent-secret-name", "", "Name of the secret in the kube-system namespace containing the operating system config of the worker pool the node shall join")
+}
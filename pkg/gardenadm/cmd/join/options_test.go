@@ -17,18 +17,56 @@ var _ = Describe("Options", func() {
 	)
 
 	BeforeEach(func() {
-		options = &Options{}
+		options = &Options{
+			BootstrapToken:              "foo123.bar4567890baz123",
+			CACertificate:               []byte("ca"),
+			GardenerNodeAgentSecretName: "gardener-node-agent-worker-1234",
+		}
 	})
 
 	Describe("#Complete", func() {
-		It("should return nil", func() {
-			Expect(options.Complete()).To(Succeed())
+		It("should use the first argument as control plane address", func() {
+			Expect(options.Complete([]string{"https://api.example.com"})).To(Succeed())
+			Expect(options.ControlPlaneAddress).To(Equal("https://api.example.com"))
+		})
+
+		It("should default the scheme of the control plane address", func() {
+			Expect(options.Complete([]string{"api.example.com:443"})).To(Succeed())
+			Expect(options.ControlPlaneAddress).To(Equal("https://api.example.com:443"))
 		})
 	})
 
 	Describe("#Validate", func() {
-		It("should return nil", func() {
+		BeforeEach(func() {
+			options.ControlPlaneAddress = "https://api.example.com"
+		})
+
+		It("should pass for valid options", func() {
 			Expect(options.Validate()).To(Succeed())
 		})
+
+		It("should fail because control plane address is not set", func() {
+			options.ControlPlaneAddress = ""
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring("must provide the address of the control plane")))
+		})
+
+		It("should fail because bootstrap token is invalid", func() {
+			options.BootstrapToken = "foo"
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring("must provide a bootstrap token")))
+		})
+
+		It("should fail because CA certificate is not set", func() {
+			options.CACertificate = nil
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring("must provide the CA certificate")))
+		})
+
+		It("should fail because gardener-node-agent secret name is not set", func() {
+			options.GardenerNodeAgentSecretName = ""
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring("must provide the name of the gardener-node-agent secret")))
+		})
 	})
 })
@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/pflag"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+)
+
+// NewClientSetFromFile creates a client set for the cluster referenced by the given kubeconfig file. It is exposed for
+// testing.
+var NewClientSetFromFile = func(kubeconfigPath string) (kubernetes.Interface, error) {
+	return kubernetes.NewClientFromFile("", kubeconfigPath,
+		kubernetes.WithClientOptions(client.Options{Scheme: kubernetes.ShootScheme}),
+		kubernetes.WithDisabledCachedClient(),
+	)
+}
+
+// KubeconfigOptions contains options for commands interacting with the autonomous shoot cluster.
+type KubeconfigOptions struct {
+	// Kubeconfig is the path to the kubeconfig file pointing to the autonomous shoot cluster.
+	Kubeconfig string
+}
+
+// Complete completes the options.
+func (o *KubeconfigOptions) Complete() error {
+	if o.Kubeconfig == "" {
+		o.Kubeconfig = os.Getenv("KUBECONFIG")
+	}
+
+	return nil
+}
+
+// Validate validates the options.
+func (o *KubeconfigOptions) Validate() error {
+	if len(o.Kubeconfig) == 0 {
+		return fmt.Errorf("must provide a path to the kubeconfig of the autonomous shoot cluster")
+	}
+
+	return nil
+}
+
+// AddFlags adds the flags for the options to the given flag set.
+func (o *KubeconfigOptions) AddFlags(fs *pflag.FlagSet) {
+	fs.StringVarP(&o.Kubeconfig, "kubeconfig", "k", "", "Path to the kubeconfig file pointing to the autonomous shoot cluster")
+}
+
+// ClientSet returns a client set for the autonomous shoot cluster.
+func (o *KubeconfigOptions) ClientSet() (kubernetes.Interface, error) {
+	clientSet, err := NewClientSetFromFile(o.Kubeconfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating client for kubeconfig %q: %w", o.Kubeconfig, err)
+	}
+	return clientSet, nil
+}
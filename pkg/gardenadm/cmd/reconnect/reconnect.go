@@ -0,0 +1,48 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package reconnect
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+)
+
+// NewCommand creates a new cobra.Command.
+func NewCommand(ioStreams genericiooptions.IOStreams) *cobra.Command {
+	opts := &Options{}
+
+	cmd := &cobra.Command{
+		Use:   "reconnect",
+		Short: "Re-register a previously disconnected cluster with a garden",
+		Long:  "Re-register a previously disconnected cluster with a garden, preserving the identity and state of the cluster",
+
+		Example: `# Re-register the cluster with its garden
+gardenadm reconnect`,
+
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if err := opts.Complete(); err != nil {
+				return err
+			}
+
+			if err := opts.Validate(); err != nil {
+				return err
+			}
+
+			return run(cmd.Context(), ioStreams, opts)
+		},
+	}
+
+	opts.addFlags(cmd.Flags())
+
+	return cmd
+}
+
+// TODO: Implement this command once `gardenadm connect` deploys a gardenlet connected to a garden.
+func run(_ context.Context, _ genericiooptions.IOStreams, _ *Options) error {
+	return fmt.Errorf("gardenadm reconnect is not implemented yet, it requires gardenadm connect to deploy a gardenlet connected to a garden")
+}
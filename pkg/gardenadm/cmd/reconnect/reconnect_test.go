@@ -0,0 +1,36 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package reconnect_test
+
+import (
+	"bytes"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	. "github.com/gardener/gardener/pkg/gardenadm/cmd/reconnect"
+)
+
+var _ = Describe("Reconnect", func() {
+	var (
+		ioStreams genericiooptions.IOStreams
+		out       *bytes.Buffer
+		cmd       *cobra.Command
+	)
+
+	BeforeEach(func() {
+		ioStreams, _, out, _ = genericiooptions.NewTestIOStreams()
+		cmd = NewCommand(ioStreams)
+	})
+
+	Describe("#RunE", func() {
+		It("should return an error since the command is not implemented yet", func() {
+			Expect(cmd.RunE(cmd, nil)).To(MatchError(ContainSubstring("gardenadm reconnect is not implemented yet")))
+			Expect(out.String()).To(BeEmpty())
+		})
+	})
+})
@@ -7,9 +7,22 @@ package create
 import (
 	"context"
 	"fmt"
+	"os"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
+	bootstraptokenapi "k8s.io/cluster-bootstrap/token/api"
+	bootstraptokenutil "k8s.io/cluster-bootstrap/token/util"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/utils"
 )
 
 // NewCommand creates a new cobra.Command.
@@ -19,15 +32,18 @@ func NewCommand(ioStreams genericiooptions.IOStreams) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "create [token]",
 		Short: "Create a bootstrap token on the server",
-		Long: "The [token] is the actual token to write." +
-			"This should be a securely generated random token of the form \"[a-z0-9]{6}.[a-z0-9]{16}\"." +
+		Long: "The [token] is the actual token to write. " +
+			"This should be a securely generated random token of the form \"[a-z0-9]{6}.[a-z0-9]{16}\". " +
 			"If no [token] is given, gardenadm will generate a random token instead.",
 
 		Example: `# Create a bootstrap token with id "foo123" on the server
 gardenadm token create foo123.bar4567890baz123
 
 # Create a bootstrap token generated randomly
-gardenadm token create`,
+gardenadm token create
+
+# Create a bootstrap token and print the command for joining nodes of worker pool "worker"
+gardenadm token create --print-join-command --worker-pool-name worker`,
 
 		Args: cobra.MaximumNArgs(1),
 
@@ -49,7 +65,83 @@ gardenadm token create`,
 	return cmd
 }
 
-func run(_ context.Context, ioStreams genericiooptions.IOStreams, _ *Options) error {
-	fmt.Fprintln(ioStreams.Out, "not implemented")
+func run(ctx context.Context, ioStreams genericiooptions.IOStreams, opts *Options) error {
+	clientSet, err := opts.ClientSet()
+	if err != nil {
+		return err
+	}
+
+	tokenID, tokenSecret, _ := strings.Cut(opts.Token, ".")
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      bootstraptokenutil.BootstrapTokenSecretName(tokenID),
+			Namespace: metav1.NamespaceSystem,
+		},
+		Type: bootstraptokenapi.SecretTypeBootstrapToken,
+		Data: map[string][]byte{
+			bootstraptokenapi.BootstrapTokenDescriptionKey:      []byte(opts.Description),
+			bootstraptokenapi.BootstrapTokenIDKey:               []byte(tokenID),
+			bootstraptokenapi.BootstrapTokenSecretKey:           []byte(tokenSecret),
+			bootstraptokenapi.BootstrapTokenExpirationKey:       []byte(time.Now().UTC().Add(opts.Validity).Format(time.RFC3339)),
+			bootstraptokenapi.BootstrapTokenUsageAuthentication: []byte("true"),
+			bootstraptokenapi.BootstrapTokenUsageSigningKey:     []byte("true"),
+		},
+	}
+
+	if err := clientSet.Client().Create(ctx, secret); err != nil {
+		if apierrors.IsAlreadyExists(err) {
+			return fmt.Errorf("bootstrap token with id %q already exists", tokenID)
+		}
+		return fmt.Errorf("failed creating bootstrap token secret: %w", err)
+	}
+
+	if !opts.PrintJoinCommand {
+		fmt.Fprintln(ioStreams.Out, opts.Token)
+		return nil
+	}
+
+	joinCommand, err := computeJoinCommand(ctx, clientSet, opts)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(ioStreams.Out, joinCommand)
 	return nil
 }
+
+func computeJoinCommand(ctx context.Context, clientSet kubernetes.Interface, opts *Options) (string, error) {
+	secretList := &corev1.SecretList{}
+	if err := clientSet.Client().List(ctx, secretList, client.InNamespace(metav1.NamespaceSystem), client.MatchingLabels{
+		v1beta1constants.GardenRole:      v1beta1constants.GardenRoleOperatingSystemConfig,
+		v1beta1constants.LabelWorkerPool: opts.WorkerPoolName,
+	}); err != nil {
+		return "", fmt.Errorf("failed listing operating system config secrets: %w", err)
+	}
+
+	var oscSecret *corev1.Secret
+	for _, secret := range secretList.Items {
+		if oscSecret == nil || oscSecret.CreationTimestamp.Before(&secret.CreationTimestamp) {
+			oscSecret = secret.DeepCopy()
+		}
+	}
+	if oscSecret == nil {
+		return "", fmt.Errorf("no operating system config secret found for worker pool %q", opts.WorkerPoolName)
+	}
+
+	restConfig := clientSet.RESTConfig()
+	caBundle := restConfig.CAData
+	if len(caBundle) == 0 && restConfig.CAFile != "" {
+		var err error
+		if caBundle, err = os.ReadFile(restConfig.CAFile); err != nil {
+			return "", fmt.Errorf("failed reading CA file %q: %w", restConfig.CAFile, err)
+		}
+	}
+	if len(caBundle) == 0 {
+		return "", fmt.Errorf("kubeconfig %q does not contain a certificate authority", opts.Kubeconfig)
+	}
+
+	return fmt.Sprintf("gardenadm join --bootstrap-token %s --ca-certificate %s --gardener-node-agent-secret-name %s %s",
+		opts.Token, utils.EncodeBase64(caBundle), oscSecret.Name, restConfig.Host,
+	), nil
+}
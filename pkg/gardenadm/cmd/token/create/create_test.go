@@ -6,35 +6,106 @@ package create_test
 
 import (
 	"bytes"
+	"context"
 	"io"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	kubernetesfake "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	gardenadmcmd "github.com/gardener/gardener/pkg/gardenadm/cmd"
 	. "github.com/gardener/gardener/pkg/gardenadm/cmd/token/create"
+	"github.com/gardener/gardener/pkg/utils/test"
 )
 
 var _ = Describe("Create", func() {
 	var (
+		ctx       = context.Background()
+		token     = "foo123.bar4567890baz123"
 		ioStreams genericiooptions.IOStreams
 		out       *bytes.Buffer
 		cmd       *cobra.Command
+
+		fakeClient client.Client
 	)
 
 	BeforeEach(func() {
 		ioStreams, _, out, _ = genericiooptions.NewTestIOStreams()
 		cmd = NewCommand(ioStreams)
+		cmd.SetContext(ctx)
+		Expect(cmd.Flags().Set("kubeconfig", "some-path")).To(Succeed())
+
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).Build()
+		DeferCleanup(test.WithVar(&gardenadmcmd.NewClientSetFromFile, func(kubeconfigPath string) (kubernetes.Interface, error) {
+			Expect(kubeconfigPath).To(Equal("some-path"))
+			return kubernetesfake.NewClientSetBuilder().
+				WithClient(fakeClient).
+				WithRESTConfig(&rest.Config{Host: "https://api.example.com", TLSClientConfig: rest.TLSClientConfig{CAData: []byte("ca")}}).
+				Build(), nil
+		}))
 	})
 
 	Describe("#RunE", func() {
-		It("should return the expected output", func() {
-			Expect(cmd.RunE(cmd, []string{"some-token"})).To(Succeed())
+		It("should create the bootstrap token and print it", func() {
+			Expect(cmd.RunE(cmd, []string{token})).To(Succeed())
 
 			output, err := io.ReadAll(out)
 			Expect(err).NotTo(HaveOccurred())
-			Expect(string(output)).To(Equal("not implemented\n"))
+			Expect(string(output)).To(Equal(token + "\n"))
+
+			secret := &corev1.Secret{}
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Name: "bootstrap-token-foo123", Namespace: "kube-system"}, secret)).To(Succeed())
+			Expect(secret.Type).To(Equal(corev1.SecretType("bootstrap.kubernetes.io/token")))
+			Expect(secret.Data).To(HaveKeyWithValue("token-id", []byte("foo123")))
+			Expect(secret.Data).To(HaveKeyWithValue("token-secret", []byte("bar4567890baz123")))
+			Expect(secret.Data).To(HaveKeyWithValue("usage-bootstrap-authentication", []byte("true")))
+			Expect(secret.Data).To(HaveKeyWithValue("usage-bootstrap-signing", []byte("true")))
+
+			expiration, err := time.Parse(time.RFC3339, string(secret.Data["expiration"]))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(expiration).To(BeTemporally("~", time.Now().Add(time.Hour), time.Minute))
+		})
+
+		It("should fail if the bootstrap token already exists", func() {
+			Expect(fakeClient.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "bootstrap-token-foo123", Namespace: "kube-system"}})).To(Succeed())
+
+			Expect(cmd.RunE(cmd, []string{token})).To(MatchError(ContainSubstring(`bootstrap token with id "foo123" already exists`)))
+		})
+
+		Context("when printing the join command", func() {
+			BeforeEach(func() {
+				Expect(cmd.Flags().Set("print-join-command", "true")).To(Succeed())
+				Expect(cmd.Flags().Set("worker-pool-name", "worker")).To(Succeed())
+			})
+
+			It("should print the join command with the newest operating system config secret of the worker pool", func() {
+				for _, secret := range []*corev1.Secret{
+					{ObjectMeta: metav1.ObjectMeta{Name: "gardener-node-agent-worker-1111", Namespace: "kube-system", CreationTimestamp: metav1.NewTime(time.Now().Add(-time.Hour)), Labels: map[string]string{"gardener.cloud/role": "operating-system-config", "worker.gardener.cloud/pool": "worker"}}},
+					{ObjectMeta: metav1.ObjectMeta{Name: "gardener-node-agent-worker-2222", Namespace: "kube-system", CreationTimestamp: metav1.Now(), Labels: map[string]string{"gardener.cloud/role": "operating-system-config", "worker.gardener.cloud/pool": "worker"}}},
+					{ObjectMeta: metav1.ObjectMeta{Name: "gardener-node-agent-other", Namespace: "kube-system", CreationTimestamp: metav1.NewTime(time.Now().Add(time.Hour)), Labels: map[string]string{"gardener.cloud/role": "operating-system-config", "worker.gardener.cloud/pool": "other"}}},
+				} {
+					Expect(fakeClient.Create(ctx, secret)).To(Succeed())
+				}
+
+				Expect(cmd.RunE(cmd, []string{token})).To(Succeed())
+
+				output, err := io.ReadAll(out)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(output)).To(Equal("gardenadm join --bootstrap-token foo123.bar4567890baz123 --ca-certificate Y2E= --gardener-node-agent-secret-name gardener-node-agent-worker-2222 https://api.example.com\n"))
+			})
+
+			It("should fail if there is no operating system config secret for the worker pool", func() {
+				Expect(cmd.RunE(cmd, []string{token})).To(MatchError(ContainSubstring(`no operating system config secret found for worker pool "worker"`)))
+			})
 		})
 	})
 })
@@ -7,25 +7,47 @@ package create
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/spf13/pflag"
+	bootstraptokenutil "k8s.io/cluster-bootstrap/token/util"
+
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
 )
 
 // Options contains options for this command.
 type Options struct {
+	cmd.KubeconfigOptions
+
 	// Token is the token to create.
 	Token string
+	// Description is the description of the token.
+	Description string
+	// Validity is the duration for which the token is valid.
+	Validity time.Duration
+	// PrintJoinCommand specifies whether the `gardenadm join` command for the created token shall be printed instead of
+	// the token only.
+	PrintJoinCommand bool
+	// WorkerPoolName is the name of the worker pool which nodes shall join when using the printed join command.
+	WorkerPoolName string
 }
 
 // Complete completes the options.
 func (o *Options) Complete(args []string) error {
+	if err := o.KubeconfigOptions.Complete(); err != nil {
+		return err
+	}
+
 	if len(args) > 0 {
 		o.Token = strings.TrimSpace(args[0])
 	}
 
 	if o.Token == "" {
-		// TODO: Generate a random token instead.
-		o.Token = "foo123.bar4567890baz123"
+		token, err := bootstraptokenutil.GenerateBootstrapToken()
+		if err != nil {
+			return fmt.Errorf("failed generating bootstrap token: %w", err)
+		}
+		o.Token = token
 	}
 
 	return nil
@@ -33,13 +55,33 @@ func (o *Options) Complete(args []string) error {
 
 // Validate validates the options.
 func (o *Options) Validate() error {
+	if err := o.KubeconfigOptions.Validate(); err != nil {
+		return err
+	}
+
 	if o.Token == "" {
 		return fmt.Errorf("must provide a token to create")
 	}
 
-	// TODO: Validate that the token has the correct/expected format
+	if !bootstraptokenutil.IsValidBootstrapToken(o.Token) {
+		return fmt.Errorf("token %q must be of the form \"[a-z0-9]{6}.[a-z0-9]{16}\"", o.Token)
+	}
+
+	if o.Validity <= 0 {
+		return fmt.Errorf("validity must be positive")
+	}
+
+	if o.PrintJoinCommand && o.WorkerPoolName == "" {
+		return fmt.Errorf("must provide a worker pool name when printing the join command")
+	}
 
 	return nil
 }
 
-func (o *Options) addFlags(_ *pflag.FlagSet) {}
+func (o *Options) addFlags(fs *pflag.FlagSet) {
+	o.KubeconfigOptions.AddFlags(fs)
+	fs.StringVarP(&o.Description, "description", "d", "Used for joining nodes via `gardenadm join`", "Description of the bootstrap token")
+	fs.DurationVar(&o.Validity, "validity", time.Hour, "Duration after which the bootstrap token expires")
+	fs.BoolVarP(&o.PrintJoinCommand, "print-join-command", "j", false, "Print the full `gardenadm join` command instead of the token only")
+	fs.StringVarP(&o.WorkerPoolName, "worker-pool-name", "w", "", "Name of the worker pool the nodes shall join, required with --print-join-command")
+}
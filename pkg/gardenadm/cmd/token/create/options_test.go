@@ -5,6 +5,8 @@
 package create_test
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
@@ -15,11 +17,13 @@ var _ = Describe("Options", func() {
 	var (
 		options *Options
 
-		token = "some.token"
+		token = "foo123.bar4567890baz123"
 	)
 
 	BeforeEach(func() {
 		options = &Options{}
+		options.Kubeconfig = "some-path"
+		options.Validity = time.Hour
 	})
 
 	Describe("#Complete", func() {
@@ -30,19 +34,47 @@ var _ = Describe("Options", func() {
 
 		It("should generate a random token", func() {
 			Expect(options.Complete(nil)).To(Succeed())
-			Expect(options.Token).To(Equal("foo123.bar4567890baz123"))
+			Expect(options.Token).To(MatchRegexp(`^[a-z0-9]{6}\.[a-z0-9]{16}$`))
 		})
 	})
 
 	Describe("#Validate", func() {
-		It("should pass for valid options", func() {
-			options.Token = "foo"
+		BeforeEach(func() {
+			options.Token = token
+		})
 
+		It("should pass for valid options", func() {
 			Expect(options.Validate()).To(Succeed())
 		})
 
+		It("should fail because kubeconfig is not set", func() {
+			options.Kubeconfig = ""
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring("must provide a path to the kubeconfig")))
+		})
+
 		It("should fail because token is not set", func() {
+			options.Token = ""
+
 			Expect(options.Validate()).To(MatchError(ContainSubstring("must provide a token to create")))
 		})
+
+		It("should fail because token has an invalid format", func() {
+			options.Token = "foo"
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring("must be of the form")))
+		})
+
+		It("should fail because validity is not positive", func() {
+			options.Validity = 0
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring("validity must be positive")))
+		})
+
+		It("should fail because worker pool name is not set when printing the join command", func() {
+			options.PrintJoinCommand = true
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring("must provide a worker pool name")))
+		})
 	})
 })
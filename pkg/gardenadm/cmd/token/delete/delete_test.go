@@ -6,35 +6,68 @@ package delete_test
 
 import (
 	"bytes"
+	"context"
 	"io"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	kubernetesfake "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	gardenadmcmd "github.com/gardener/gardener/pkg/gardenadm/cmd"
 	. "github.com/gardener/gardener/pkg/gardenadm/cmd/token/delete"
+	"github.com/gardener/gardener/pkg/utils/test"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
 )
 
 var _ = Describe("Delete", func() {
 	var (
+		ctx       = context.Background()
 		ioStreams genericiooptions.IOStreams
 		out       *bytes.Buffer
 		cmd       *cobra.Command
+
+		fakeClient client.Client
+		secret     *corev1.Secret
 	)
 
 	BeforeEach(func() {
 		ioStreams, _, out, _ = genericiooptions.NewTestIOStreams()
 		cmd = NewCommand(ioStreams)
+		cmd.SetContext(ctx)
+		Expect(cmd.Flags().Set("kubeconfig", "some-path")).To(Succeed())
+
+		secret = &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "bootstrap-token-foo123", Namespace: "kube-system"}}
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).WithObjects(secret).Build()
+		DeferCleanup(test.WithVar(&gardenadmcmd.NewClientSetFromFile, func(string) (kubernetes.Interface, error) {
+			return kubernetesfake.NewClientSetBuilder().WithClient(fakeClient).Build(), nil
+		}))
 	})
 
 	Describe("#RunE", func() {
-		It("should return the expected output", func() {
-			Expect(cmd.RunE(cmd, []string{"some-token-id"})).To(Succeed())
+		It("should delete the bootstrap token", func() {
+			Expect(cmd.RunE(cmd, []string{"foo123"})).To(Succeed())
 
 			output, err := io.ReadAll(out)
 			Expect(err).NotTo(HaveOccurred())
-			Expect(string(output)).To(Equal("not implemented\n"))
+			Expect(string(output)).To(Equal("bootstrap token \"foo123\" deleted\n"))
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(secret), secret)).To(BeNotFoundError())
+		})
+
+		It("should delete the bootstrap token when the full token is given", func() {
+			Expect(cmd.RunE(cmd, []string{"foo123.bar4567890baz123"})).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(secret), secret)).To(BeNotFoundError())
+		})
+
+		It("should fail if the bootstrap token does not exist", func() {
+			Expect(cmd.RunE(cmd, []string{"bar456"})).To(MatchError(ContainSubstring(`bootstrap token with id "bar456" does not exist`)))
 		})
 	})
 })
@@ -9,18 +9,28 @@ import (
 	"strings"
 
 	"github.com/spf13/pflag"
+	bootstraptokenutil "k8s.io/cluster-bootstrap/token/util"
+
+	"github.com/gardener/gardener/pkg/gardenadm/cmd"
 )
 
 // Options contains options for this command.
 type Options struct {
+	cmd.KubeconfigOptions
+
 	// TokenID is the ID of the token to delete.
 	TokenID string
 }
 
 // Complete completes the options.
 func (o *Options) Complete(args []string) error {
+	if err := o.KubeconfigOptions.Complete(); err != nil {
+		return err
+	}
+
 	if len(args) > 0 {
-		o.TokenID = strings.TrimSpace(args[0])
+		// Allow passing the full token as well, only its ID is relevant for the deletion.
+		o.TokenID, _, _ = strings.Cut(strings.TrimSpace(args[0]), ".")
 	}
 
 	return nil
@@ -28,11 +38,21 @@ func (o *Options) Complete(args []string) error {
 
 // Validate validates the options.
 func (o *Options) Validate() error {
+	if err := o.KubeconfigOptions.Validate(); err != nil {
+		return err
+	}
+
 	if o.TokenID == "" {
 		return fmt.Errorf("must provide a token ID to delete")
 	}
 
+	if !bootstraptokenutil.IsValidBootstrapTokenID(o.TokenID) {
+		return fmt.Errorf("token ID %q must be of the form \"[a-z0-9]{6}\"", o.TokenID)
+	}
+
 	return nil
 }
 
-func (o *Options) addFlags(_ *pflag.FlagSet) {}
+func (o *Options) addFlags(fs *pflag.FlagSet) {
+	o.KubeconfigOptions.AddFlags(fs)
+}
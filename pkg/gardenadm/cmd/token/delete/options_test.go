@@ -15,11 +15,12 @@ var _ = Describe("Options", func() {
 	var (
 		options *Options
 
-		tokenID = "token-id"
+		tokenID = "foo123"
 	)
 
 	BeforeEach(func() {
 		options = &Options{}
+		options.Kubeconfig = "some-path"
 	})
 
 	Describe("#Complete", func() {
@@ -27,17 +28,35 @@ var _ = Describe("Options", func() {
 			Expect(options.Complete([]string{tokenID})).To(Succeed())
 			Expect(options.TokenID).To(Equal(tokenID))
 		})
+
+		It("should use the ID of the token given as first argument", func() {
+			Expect(options.Complete([]string{tokenID + ".bar4567890baz123"})).To(Succeed())
+			Expect(options.TokenID).To(Equal(tokenID))
+		})
 	})
 
 	Describe("#Validate", func() {
 		It("should pass for valid options", func() {
-			options.TokenID = "foo123"
+			options.TokenID = tokenID
 
 			Expect(options.Validate()).To(Succeed())
 		})
 
+		It("should fail because kubeconfig is not set", func() {
+			options.TokenID = tokenID
+			options.Kubeconfig = ""
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring("must provide a path to the kubeconfig")))
+		})
+
 		It("should fail because token ID is not set", func() {
 			Expect(options.Validate()).To(MatchError(ContainSubstring("must provide a token ID to delete")))
 		})
+
+		It("should fail because token ID has an invalid format", func() {
+			options.TokenID = "token-id"
+
+			Expect(options.Validate()).To(MatchError(ContainSubstring("must be of the form")))
+		})
 	})
 })
@@ -10,6 +10,7 @@ import (
 
 	"github.com/spf13/cobra"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
+	bootstraptokenutil "k8s.io/cluster-bootstrap/token/util"
 )
 
 // NewCommand creates a new cobra.Command.
@@ -19,7 +20,7 @@ func NewCommand(ioStreams genericiooptions.IOStreams) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "generate",
 		Short: "Generate a random bootstrap token",
-		Long:  "Generate a random bootstrap token",
+		Long:  "Generate a random bootstrap token of the form \"[a-z0-9]{6}.[a-z0-9]{16}\" without creating it on the server",
 
 		Example: `# Generate a random bootstrap token
 gardenadm token generate`,
@@ -43,6 +44,11 @@ gardenadm token generate`,
 }
 
 func run(_ context.Context, ioStreams genericiooptions.IOStreams, _ *Options) error {
-	fmt.Fprintln(ioStreams.Out, "not implemented")
+	token, err := bootstraptokenutil.GenerateBootstrapToken()
+	if err != nil {
+		return fmt.Errorf("failed generating bootstrap token: %w", err)
+	}
+
+	fmt.Fprintln(ioStreams.Out, token)
 	return nil
 }
@@ -29,12 +29,12 @@ var _ = Describe("Generate", func() {
 	})
 
 	Describe("#RunE", func() {
-		It("should return the expected output", func() {
+		It("should print a random bootstrap token", func() {
 			Expect(cmd.RunE(cmd, nil)).To(Succeed())
 
 			output, err := io.ReadAll(out)
 			Expect(err).NotTo(HaveOccurred())
-			Expect(string(output)).To(Equal("not implemented\n"))
+			Expect(string(output)).To(MatchRegexp(`^[a-z0-9]{6}\.[a-z0-9]{16}\n$`))
 		})
 	})
 })
@@ -7,9 +7,14 @@ package list
 import (
 	"context"
 	"fmt"
+	"text/tabwriter"
 
 	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
+	bootstraptokenapi "k8s.io/cluster-bootstrap/token/api"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // NewCommand creates a new cobra.Command.
@@ -42,7 +47,36 @@ gardenadm token list`,
 	return cmd
 }
 
-func run(_ context.Context, ioStreams genericiooptions.IOStreams, _ *Options) error {
-	fmt.Fprintln(ioStreams.Out, "not implemented")
-	return nil
+func run(ctx context.Context, ioStreams genericiooptions.IOStreams, opts *Options) error {
+	clientSet, err := opts.ClientSet()
+	if err != nil {
+		return err
+	}
+
+	secretList := &corev1.SecretList{}
+	if err := clientSet.Client().List(ctx, secretList, client.InNamespace(metav1.NamespaceSystem)); err != nil {
+		return fmt.Errorf("failed listing bootstrap token secrets: %w", err)
+	}
+
+	w := tabwriter.NewWriter(ioStreams.Out, 10, 4, 3, ' ', 0)
+	fmt.Fprintln(w, "TOKEN ID\tEXPIRES\tDESCRIPTION")
+
+	for _, secret := range secretList.Items {
+		if secret.Type != bootstraptokenapi.SecretTypeBootstrapToken {
+			continue
+		}
+
+		expiration := string(secret.Data[bootstraptokenapi.BootstrapTokenExpirationKey])
+		if expiration == "" {
+			expiration = "<never>"
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%s\n",
+			secret.Data[bootstraptokenapi.BootstrapTokenIDKey],
+			expiration,
+			secret.Data[bootstraptokenapi.BootstrapTokenDescriptionKey],
+		)
+	}
+
+	return w.Flush()
 }
@@ -6,18 +6,27 @@ package list_test
 
 import (
 	"bytes"
+	"context"
 	"io"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	kubernetesfake "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	gardenadmcmd "github.com/gardener/gardener/pkg/gardenadm/cmd"
 	. "github.com/gardener/gardener/pkg/gardenadm/cmd/token/list"
+	"github.com/gardener/gardener/pkg/utils/test"
 )
 
 var _ = Describe("List", func() {
 	var (
+		ctx       = context.Background()
 		ioStreams genericiooptions.IOStreams
 		out       *bytes.Buffer
 		cmd       *cobra.Command
@@ -26,15 +35,47 @@ var _ = Describe("List", func() {
 	BeforeEach(func() {
 		ioStreams, _, out, _ = genericiooptions.NewTestIOStreams()
 		cmd = NewCommand(ioStreams)
+		cmd.SetContext(ctx)
+		Expect(cmd.Flags().Set("kubeconfig", "some-path")).To(Succeed())
+
+		fakeClient := fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).WithObjects(
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "bootstrap-token-foo123", Namespace: "kube-system"},
+				Type:       "bootstrap.kubernetes.io/token",
+				Data: map[string][]byte{
+					"token-id":     []byte("foo123"),
+					"token-secret": []byte("bar4567890baz123"),
+					"expiration":   []byte("2024-01-02T03:04:05Z"),
+					"description":  []byte("some description"),
+				},
+			},
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "bootstrap-token-bar456", Namespace: "kube-system"},
+				Type:       "bootstrap.kubernetes.io/token",
+				Data: map[string][]byte{
+					"token-id":     []byte("bar456"),
+					"token-secret": []byte("foo4567890baz123"),
+					"description":  []byte("never expires"),
+				},
+			},
+			&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "kube-system"}},
+		).Build()
+
+		DeferCleanup(test.WithVar(&gardenadmcmd.NewClientSetFromFile, func(string) (kubernetes.Interface, error) {
+			return kubernetesfake.NewClientSetBuilder().WithClient(fakeClient).Build(), nil
+		}))
 	})
 
 	Describe("#RunE", func() {
-		It("should return the expected output", func() {
+		It("should list the bootstrap tokens without revealing their secrets", func() {
 			Expect(cmd.RunE(cmd, nil)).To(Succeed())
 
 			output, err := io.ReadAll(out)
 			Expect(err).NotTo(HaveOccurred())
-			Expect(string(output)).To(Equal("not implemented\n"))
+			Expect(string(output)).To(Equal(`TOKEN ID   EXPIRES                DESCRIPTION
+bar456     <never>                never expires
+foo123     2024-01-02T03:04:05Z   some description
+`))
 		})
 	})
 })
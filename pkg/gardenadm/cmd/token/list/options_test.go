@@ -21,14 +21,23 @@ var _ = Describe("Options", func() {
 	})
 
 	Describe("#Complete", func() {
-		It("should return nil", func() {
+		It("should default the kubeconfig from the environment", func() {
+			GinkgoT().Setenv("KUBECONFIG", "some-path")
+
 			Expect(options.Complete()).To(Succeed())
+			Expect(options.Kubeconfig).To(Equal("some-path"))
 		})
 	})
 
 	Describe("#Validate", func() {
-		It("should return nil", func() {
+		It("should pass for valid options", func() {
+			options.Kubeconfig = "some-path"
+
 			Expect(options.Validate()).To(Succeed())
 		})
+
+		It("should fail because kubeconfig is not set", func() {
+			Expect(options.Validate()).To(MatchError(ContainSubstring("must provide a path to the kubeconfig")))
+		})
 	})
 })
@@ -108,6 +108,13 @@ func IsMonitoringEnabled(c *config.GardenletConfiguration) bool {
 	return true
 }
 
+// IsImagePrePullingEnabled returns true if the control plane images shall be pre-pulled on the seed nodes.
+func IsImagePrePullingEnabled(c *config.GardenletConfiguration) bool {
+	return c != nil && c.ImagePrePulling != nil &&
+		c.ImagePrePulling.Enabled != nil &&
+		*c.ImagePrePulling.Enabled
+}
+
 // GetManagedResourceProgressingThreshold returns ManagedResourceProgressingThreshold if set otherwise it returns nil.
 func GetManagedResourceProgressingThreshold(c *config.GardenletConfiguration) *metav1.Duration {
 	if c != nil && c.Controllers != nil && c.Controllers.ShootCare != nil && c.Controllers.ShootCare.ManagedResourceProgressingThreshold != nil {
@@ -115,3 +122,18 @@ func GetManagedResourceProgressingThreshold(c *config.GardenletConfiguration) *m
 	}
 	return nil
 }
+
+// GetDNSPropagationConfiguration returns the DNS propagation configuration for the given DNS provider type if configured
+// otherwise it returns nil.
+func GetDNSPropagationConfiguration(c *config.GardenletConfiguration, providerType string) *config.DNSPropagationConfiguration {
+	if c == nil || c.Controllers == nil || c.Controllers.Shoot == nil {
+		return nil
+	}
+
+	for _, dnsPropagation := range c.Controllers.Shoot.DNSPropagation {
+		if dnsPropagation.ProviderType == providerType {
+			return &dnsPropagation
+		}
+	}
+	return nil
+}
@@ -266,6 +266,32 @@ var _ = Describe("helper", func() {
 		})
 	})
 
+	Describe("#IsImagePrePullingEnabled", func() {
+		It("should return false when GardenletConfiguration is nil", func() {
+			Expect(IsImagePrePullingEnabled(nil)).To(BeFalse())
+		})
+
+		It("should return false when ImagePrePulling is nil", func() {
+			Expect(IsImagePrePullingEnabled(&config.GardenletConfiguration{})).To(BeFalse())
+		})
+
+		It("should return false when ImagePrePulling.Enabled is nil", func() {
+			gardenletConfig := &config.GardenletConfiguration{
+				ImagePrePulling: &config.ImagePrePulling{},
+			}
+
+			Expect(IsImagePrePullingEnabled(gardenletConfig)).To(BeFalse())
+		})
+
+		It("should return true when ImagePrePulling.Enabled is true", func() {
+			gardenletConfig := &config.GardenletConfiguration{
+				ImagePrePulling: &config.ImagePrePulling{Enabled: ptr.To(true)},
+			}
+
+			Expect(IsImagePrePullingEnabled(gardenletConfig)).To(BeTrue())
+		})
+	})
+
 	Describe("#GetManagedResourceProgressingThreshold", func() {
 		It("should return nil the GardenletConfiguration is nil", func() {
 			Expect(GetManagedResourceProgressingThreshold(nil)).To(BeNil())
@@ -308,4 +334,34 @@ var _ = Describe("helper", func() {
 			Expect(GetManagedResourceProgressingThreshold(gardenletConfig)).To(Equal(threshold))
 		})
 	})
+
+	Describe("#GetDNSPropagationConfiguration", func() {
+		It("should return nil when the GardenletConfiguration is nil", func() {
+			Expect(GetDNSPropagationConfiguration(nil, "foo")).To(BeNil())
+		})
+
+		It("should return nil when the Shoot controller configuration is empty", func() {
+			gardenletConfig := &config.GardenletConfiguration{
+				Controllers: &config.GardenletControllerConfiguration{},
+			}
+
+			Expect(GetDNSPropagationConfiguration(gardenletConfig, "foo")).To(BeNil())
+		})
+
+		It("should return the configuration for the given provider type", func() {
+			gardenletConfig := &config.GardenletConfiguration{
+				Controllers: &config.GardenletControllerConfiguration{
+					Shoot: &config.ShootControllerConfiguration{
+						DNSPropagation: []config.DNSPropagationConfiguration{
+							{ProviderType: "foo", Timeout: &metav1.Duration{Duration: time.Minute}},
+							{ProviderType: "bar", Timeout: &metav1.Duration{Duration: time.Hour}},
+						},
+					},
+				},
+			}
+
+			Expect(GetDNSPropagationConfiguration(gardenletConfig, "bar")).To(Equal(&config.DNSPropagationConfiguration{ProviderType: "bar", Timeout: &metav1.Duration{Duration: time.Hour}}))
+			Expect(GetDNSPropagationConfiguration(gardenletConfig, "baz")).To(BeNil())
+		})
+	})
 })
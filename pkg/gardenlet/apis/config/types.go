@@ -67,6 +67,12 @@ type GardenletConfiguration struct {
 	Monitoring *MonitoringConfig
 	// NodeToleration contains optional settings for default tolerations.
 	NodeToleration *NodeToleration
+	// ShootNamespaceConstraints contains optional templates for a ResourceQuota and a LimitRange which are reconciled
+	// into all shoot namespaces in the seed cluster.
+	ShootNamespaceConstraints *ShootNamespaceConstraints
+	// ImagePrePulling contains optional settings for pre-pulling the control plane images of the Kubernetes versions
+	// used by the shoots hosted on the seed.
+	ImagePrePulling *ImagePrePulling
 }
 
 // GardenClientConnection specifies the kubeconfig file and the client connection settings
@@ -148,6 +154,8 @@ type GardenletControllerConfiguration struct {
 	ShootCare *ShootCareControllerConfiguration
 	// ShootState defines the configuration of the ShootState controller.
 	ShootState *ShootStateControllerConfiguration
+	// ShootHibernationCleanup defines the configuration of the ShootHibernationCleanup controller.
+	ShootHibernationCleanup *ShootHibernationCleanupControllerConfiguration
 	// NetworkPolicy defines the configuration of the NetworkPolicy controller.
 	NetworkPolicy *NetworkPolicyControllerConfiguration
 	// ManagedSeed defines the configuration of the ManagedSeed controller.
@@ -158,6 +166,8 @@ type GardenletControllerConfiguration struct {
 	TokenRequestorWorkloadIdentity *TokenRequestorWorkloadIdentityControllerConfiguration
 	// VPAEvictionRequirements defines the configuration of the VPAEvictionRequirements controller.
 	VPAEvictionRequirements *VPAEvictionRequirementsControllerConfiguration
+	// EtcdDefragmentationCoordinator defines the configuration of the EtcdDefragmentationCoordinator controller.
+	EtcdDefragmentationCoordinator *EtcdDefragmentationCoordinatorControllerConfiguration
 }
 
 // BackupBucketControllerConfiguration defines the configuration of the BackupBucket
@@ -252,6 +262,40 @@ type ShootControllerConfiguration struct {
 	// DNSEntryTTLSeconds is the TTL in seconds that is being used for DNS entries when reconciling shoots.
 	// Default: 120s
 	DNSEntryTTLSeconds *int64
+	// DNSPropagation contains settings for waiting for the propagation of the DNS records of shoots per DNS provider
+	// type.
+	DNSPropagation []DNSPropagationConfiguration
+	// ForceDeletionExtensionGracePeriod is the duration how long gardenlet waits for extension resources to be deleted
+	// when force-deleting a Shoot. Afterwards, the finalizers of the remaining extension resources are removed.
+	ForceDeletionExtensionGracePeriod *metav1.Duration
+}
+
+// DNSPropagationConfiguration contains settings for waiting for the propagation of DNS records of a DNS provider type.
+type DNSPropagationConfiguration struct {
+	// ProviderType is the type of the DNS provider (e.g., `aws-route53`) for which the settings apply.
+	ProviderType string
+	// Timeout is the duration how long gardenlet waits for DNSRecords of this provider type to be reconciled
+	// successfully. Defaults to 2m if not set.
+	Timeout *metav1.Duration
+	// Verification configures an active verification of the propagation of the DNS records after they have been
+	// reconciled successfully. If not set, the propagation is not verified.
+	Verification *DNSPropagationVerification
+}
+
+// DNSPropagationVerification configures how the propagation of DNS records is verified. The records are queried
+// directly from the given name servers, which are not subject to negative caching of recursive resolvers.
+type DNSPropagationVerification struct {
+	// NameServers is a list of name server endpoints (`<host>:<port>`) which are queried. If empty, the authoritative
+	// name servers of the DNS zone are determined and queried.
+	NameServers []string
+	// InitialBackoff is the initial duration between two verification attempts. It is doubled after each unsuccessful
+	// attempt. Defaults to 5s.
+	InitialBackoff *metav1.Duration
+	// MaxBackoff is the maximum duration between two verification attempts. Defaults to 1m.
+	MaxBackoff *metav1.Duration
+	// Timeout is the duration after which the verification fails if the DNS records have not been propagated to all
+	// name servers. Defaults to 5m.
+	Timeout *metav1.Duration
 }
 
 // ShootCareControllerConfiguration defines the configuration of the ShootCare
@@ -276,6 +320,9 @@ type ShootCareControllerConfiguration struct {
 	// practices (https://kubernetes.io/docs/reference/access-authn-authz/extensible-admission-controllers/#best-practices-and-warnings)
 	// is enabled.
 	WebhookRemediatorEnabled *bool
+	// Remediations is a list of predefined remediation actions which are executed when the respective condition of a
+	// Shoot fails.
+	Remediations []ConditionRemediation
 }
 
 // SeedCareControllerConfiguration defines the configuration of the SeedCare
@@ -297,6 +344,17 @@ type ShootStateControllerConfiguration struct {
 	SyncPeriod *metav1.Duration
 }
 
+// ShootHibernationCleanupControllerConfiguration defines the configuration of the ShootHibernationCleanup controller.
+type ShootHibernationCleanupControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on events.
+	ConcurrentSyncs *int
+	// SyncPeriod is the duration how often the state of long-hibernated shoots is pruned.
+	SyncPeriod *metav1.Duration
+	// MinimumHibernationDuration is the duration for which a shoot must be hibernated before its stale autoscaling
+	// state in the seed is pruned.
+	MinimumHibernationDuration *metav1.Duration
+}
+
 // StaleExtensionHealthChecks defines the configuration of the check for stale extension health checks.
 type StaleExtensionHealthChecks struct {
 	// Enabled specifies whether the check for stale extensions health checks is enabled.
@@ -316,6 +374,26 @@ type ConditionThreshold struct {
 	Duration metav1.Duration
 }
 
+// ConditionRemediation defines a predefined remediation action which is executed when a condition of a Shoot fails.
+type ConditionRemediation struct {
+	// Type is the type of the condition whose failure triggers the remediation.
+	Type string
+	// Action is the predefined remediation action.
+	Action RemediationAction
+	// MinInterval is the minimum duration between two executions of the action for the same Shoot.
+	MinInterval *metav1.Duration
+}
+
+// RemediationAction is a predefined remediation action.
+type RemediationAction string
+
+const (
+	// RemediationActionRestartVPN restarts the VPN pods in the shoot control plane and in the shoot cluster.
+	RemediationActionRestartVPN RemediationAction = "RestartVPN"
+	// RemediationActionReconcileDNSRecords triggers a reconciliation of the DNSRecords of the shoot.
+	RemediationActionReconcileDNSRecords RemediationAction = "ReconcileDNSRecords"
+)
+
 // NetworkPolicyControllerConfiguration defines the configuration of the NetworkPolicy
 // controller.
 type NetworkPolicyControllerConfiguration struct {
@@ -349,6 +427,16 @@ type ManagedSeedControllerConfiguration struct {
 	// The applied jitterPeriod is taken from SyncJitterPeriod.
 	// Defaults to false.
 	JitterUpdates *bool
+	// PreflightChecks contains configuration for the checks which are performed before gardenlet is deployed into the
+	// shoot of a ManagedSeed.
+	PreflightChecks *ManagedSeedPreflightChecksConfiguration
+}
+
+// ManagedSeedPreflightChecksConfiguration defines the configuration of the preflight checks of the ManagedSeed controller.
+type ManagedSeedPreflightChecksConfiguration struct {
+	// MinimumAllocatable is the minimum amount of resources which the ready nodes of the shoot must provide in total.
+	// If not set, the resources of the shoot are not checked.
+	MinimumAllocatable corev1.ResourceList
 }
 
 // TokenRequestorServiceAccountControllerConfiguration defines the configuration of the TokenRequestorServiceAccount controller.
@@ -369,6 +457,19 @@ type VPAEvictionRequirementsControllerConfiguration struct {
 	ConcurrentSyncs *int
 }
 
+// EtcdDefragmentationCoordinatorControllerConfiguration defines the configuration of the
+// EtcdDefragmentationCoordinator controller.
+type EtcdDefragmentationCoordinatorControllerConfiguration struct {
+	// SyncPeriod is the duration how often the defragmentation schedules of the etcds in the seed are coordinated.
+	SyncPeriod *metav1.Duration
+	// SlotDuration is the duration reserved for the defragmentation of an etcd. Defragmentations starting within the
+	// same slot are considered to run concurrently.
+	SlotDuration *metav1.Duration
+	// MaxConcurrentDefragmentationsPerZone is the maximum number of etcd defragmentations which may run concurrently
+	// in an availability zone of the seed.
+	MaxConcurrentDefragmentationsPerZone *int
+}
+
 // ResourcesConfiguration defines the total capacity for seed resources and the amount reserved for use by Gardener.
 type ResourcesConfiguration struct {
 	// Capacity defines the total resources of a seed.
@@ -577,3 +678,27 @@ type NodeToleration struct {
 	// should be added to pods not already tolerating this taint.
 	DefaultUnreachableTolerationSeconds *int64
 }
+
+// ShootNamespaceConstraints contains templates for a ResourceQuota and a LimitRange which are reconciled into all shoot
+// namespaces in the seed cluster. They prevent single control planes from monopolizing the resources of the seed.
+type ShootNamespaceConstraints struct {
+	// ResourceQuota is the template for the ResourceQuota in the shoot namespaces.
+	ResourceQuota *corev1.ResourceQuotaSpec
+	// LimitRange is the template for the LimitRange in the shoot namespaces.
+	LimitRange *corev1.LimitRangeSpec
+	// ResourceQuotaScaleFactors maps shoot size classes to factors by which the hard limits of the ResourceQuota
+	// template are multiplied for shoots of the respective size class. Shoots whose size class is not contained in this
+	// map get the unscaled ResourceQuota.
+	ResourceQuotaScaleFactors map[string]int32
+}
+
+// ImagePrePulling contains settings for pre-pulling the control plane images of the Kubernetes versions used by the
+// shoots hosted on the seed. Pre-pulled images prevent that rescheduled or upgraded control plane pods are delayed by
+// image pulls.
+type ImagePrePulling struct {
+	// Enabled controls whether the control plane images are pre-pulled on all nodes of the seed cluster.
+	Enabled *bool
+	// RetentionPeriod is the duration for which the images of a Kubernetes version are still pre-pulled after the
+	// version is no longer used by any shoot hosted on the seed.
+	RetentionPeriod *metav1.Duration
+}
@@ -164,6 +164,9 @@ func SetDefaults_GardenletControllerConfiguration(obj *GardenletControllerConfig
 	if obj.ShootState == nil {
 		obj.ShootState = &ShootStateControllerConfiguration{}
 	}
+	if obj.ShootHibernationCleanup == nil {
+		obj.ShootHibernationCleanup = &ShootHibernationCleanupControllerConfiguration{}
+	}
 	if obj.NetworkPolicy == nil {
 		obj.NetworkPolicy = &NetworkPolicyControllerConfiguration{}
 	}
@@ -179,6 +182,9 @@ func SetDefaults_GardenletControllerConfiguration(obj *GardenletControllerConfig
 	if obj.VPAEvictionRequirements == nil {
 		obj.VPAEvictionRequirements = &VPAEvictionRequirementsControllerConfiguration{}
 	}
+	if obj.EtcdDefragmentationCoordinator == nil {
+		obj.EtcdDefragmentationCoordinator = &EtcdDefragmentationCoordinatorControllerConfiguration{}
+	}
 }
 
 // SetDefaults_ClientConnectionConfiguration sets defaults for the client connection objects.
@@ -245,6 +251,16 @@ func SetDefaults_ShootMonitoringConfig(obj *ShootMonitoringConfig) {
 	}
 }
 
+// SetDefaults_ImagePrePulling sets the defaults for the image pre-pulling.
+func SetDefaults_ImagePrePulling(obj *ImagePrePulling) {
+	if obj.Enabled == nil {
+		obj.Enabled = ptr.To(false)
+	}
+	if obj.RetentionPeriod == nil {
+		obj.RetentionPeriod = &metav1.Duration{Duration: 7 * 24 * time.Hour}
+	}
+}
+
 // SetDefaults_BastionControllerConfiguration sets defaults for the bastion controller.
 func SetDefaults_BastionControllerConfiguration(obj *BastionControllerConfiguration) {
 	if obj.ConcurrentSyncs == nil {
@@ -345,6 +361,23 @@ func SetDefaults_ShootControllerConfiguration(obj *ShootControllerConfiguration)
 	if obj.DNSEntryTTLSeconds == nil {
 		obj.DNSEntryTTLSeconds = ptr.To[int64](120)
 	}
+
+	if obj.ForceDeletionExtensionGracePeriod == nil {
+		obj.ForceDeletionExtensionGracePeriod = &metav1.Duration{Duration: 5 * time.Minute}
+	}
+}
+
+// SetDefaults_DNSPropagationVerification sets defaults for the verification of the propagation of DNS records.
+func SetDefaults_DNSPropagationVerification(obj *DNSPropagationVerification) {
+	if obj.InitialBackoff == nil {
+		obj.InitialBackoff = &metav1.Duration{Duration: 5 * time.Second}
+	}
+	if obj.MaxBackoff == nil {
+		obj.MaxBackoff = &metav1.Duration{Duration: time.Minute}
+	}
+	if obj.Timeout == nil {
+		obj.Timeout = &metav1.Duration{Duration: 5 * time.Minute}
+	}
 }
 
 // SetDefaults_ShootCareControllerConfiguration sets defaults for the shoot care controller.
@@ -365,6 +398,13 @@ func SetDefaults_ShootCareControllerConfiguration(obj *ShootCareControllerConfig
 	}
 }
 
+// SetDefaults_ConditionRemediation sets defaults for the condition remediations of the shoot care controller.
+func SetDefaults_ConditionRemediation(obj *ConditionRemediation) {
+	if obj.MinInterval == nil {
+		obj.MinInterval = &metav1.Duration{Duration: 30 * time.Minute}
+	}
+}
+
 // SetDefaults_StaleExtensionHealthChecks sets defaults for the stale extension health checks.
 func SetDefaults_StaleExtensionHealthChecks(obj *StaleExtensionHealthChecks) {
 	if obj.Threshold == nil {
@@ -383,6 +423,19 @@ func SetDefaults_ShootStateControllerConfiguration(obj *ShootStateControllerConf
 	}
 }
 
+// SetDefaults_ShootHibernationCleanupControllerConfiguration sets defaults for the shoot hibernation cleanup controller.
+func SetDefaults_ShootHibernationCleanupControllerConfiguration(obj *ShootHibernationCleanupControllerConfiguration) {
+	if obj.ConcurrentSyncs == nil {
+		obj.ConcurrentSyncs = ptr.To(5)
+	}
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{Duration: time.Hour}
+	}
+	if obj.MinimumHibernationDuration == nil {
+		obj.MinimumHibernationDuration = &metav1.Duration{Duration: 7 * 24 * time.Hour}
+	}
+}
+
 // SetDefaults_NetworkPolicyControllerConfiguration sets defaults for the network policy controller.
 func SetDefaults_NetworkPolicyControllerConfiguration(obj *NetworkPolicyControllerConfiguration) {
 	if obj.ConcurrentSyncs == nil {
@@ -439,6 +492,19 @@ func SetDefaults_VPAEvictionRequirementsControllerConfiguration(obj *VPAEviction
 	}
 }
 
+// SetDefaults_EtcdDefragmentationCoordinatorControllerConfiguration sets defaults for the EtcdDefragmentationCoordinator controller.
+func SetDefaults_EtcdDefragmentationCoordinatorControllerConfiguration(obj *EtcdDefragmentationCoordinatorControllerConfiguration) {
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{Duration: time.Hour}
+	}
+	if obj.SlotDuration == nil {
+		obj.SlotDuration = &metav1.Duration{Duration: 10 * time.Minute}
+	}
+	if obj.MaxConcurrentDefragmentationsPerZone == nil {
+		obj.MaxConcurrentDefragmentationsPerZone = ptr.To(2)
+	}
+}
+
 // SetDefaults_SNI sets defaults for SNI.
 func SetDefaults_SNI(obj *SNI) {
 	if obj.Ingress == nil {
@@ -46,7 +46,9 @@ var _ = Describe("Defaults", func() {
 			Expect(obj.Controllers.ShootCare).NotTo(BeNil())
 			Expect(obj.Controllers.SeedCare).NotTo(BeNil())
 			Expect(obj.Controllers.ShootState).NotTo(BeNil())
+			Expect(obj.Controllers.ShootHibernationCleanup).NotTo(BeNil())
 			Expect(obj.Controllers.ManagedSeed).NotTo(BeNil())
+			Expect(obj.Controllers.EtcdDefragmentationCoordinator).NotTo(BeNil())
 			Expect(obj.LeaderElection).NotTo(BeNil())
 			Expect(obj.LogLevel).To(Equal(logger.InfoLevel))
 			Expect(obj.LogFormat).To(Equal(logger.FormatJSON))
@@ -366,6 +368,7 @@ var _ = Describe("Defaults", func() {
 			Expect(obj.Controllers.Shoot.ReconcileInMaintenanceOnly).To(PointTo(Equal(false)))
 			Expect(obj.Controllers.Shoot.RetryDuration).To(PointTo(Equal(metav1.Duration{Duration: 12 * time.Hour})))
 			Expect(obj.Controllers.Shoot.DNSEntryTTLSeconds).To(PointTo(Equal(int64(120))))
+			Expect(obj.Controllers.Shoot.ForceDeletionExtensionGracePeriod).To(PointTo(Equal(metav1.Duration{Duration: 5 * time.Minute})))
 		})
 
 		It("should not overwrite already set values for the shoot controller configuration", func() {
@@ -378,6 +381,8 @@ var _ = Describe("Defaults", func() {
 					ReconcileInMaintenanceOnly: ptr.To(true),
 					RetryDuration:              &v,
 					DNSEntryTTLSeconds:         ptr.To[int64](60),
+
+					ForceDeletionExtensionGracePeriod: &v,
 				},
 			}
 			SetObjectDefaults_GardenletConfiguration(obj)
@@ -388,6 +393,29 @@ var _ = Describe("Defaults", func() {
 			Expect(obj.Controllers.Shoot.ReconcileInMaintenanceOnly).To(PointTo(Equal(true)))
 			Expect(obj.Controllers.Shoot.RetryDuration).To(PointTo(Equal(metav1.Duration{Duration: 2 * time.Hour})))
 			Expect(obj.Controllers.Shoot.DNSEntryTTLSeconds).To(PointTo(Equal(int64(60))))
+			Expect(obj.Controllers.Shoot.ForceDeletionExtensionGracePeriod).To(PointTo(Equal(metav1.Duration{Duration: 2 * time.Hour})))
+		})
+
+		It("should default the DNS propagation verification settings", func() {
+			obj.Controllers = &GardenletControllerConfiguration{
+				Shoot: &ShootControllerConfiguration{
+					DNSPropagation: []DNSPropagationConfiguration{
+						{ProviderType: "foo"},
+						{ProviderType: "bar", Verification: &DNSPropagationVerification{}},
+						{ProviderType: "baz", Verification: &DNSPropagationVerification{MaxBackoff: &metav1.Duration{Duration: 30 * time.Second}}},
+					},
+				},
+			}
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.Shoot.DNSPropagation[0].Timeout).To(BeNil())
+			Expect(obj.Controllers.Shoot.DNSPropagation[0].Verification).To(BeNil())
+			Expect(obj.Controllers.Shoot.DNSPropagation[1].Verification).To(Equal(&DNSPropagationVerification{
+				InitialBackoff: &metav1.Duration{Duration: 5 * time.Second},
+				MaxBackoff:     &metav1.Duration{Duration: time.Minute},
+				Timeout:        &metav1.Duration{Duration: 5 * time.Minute},
+			}))
+			Expect(obj.Controllers.Shoot.DNSPropagation[2].Verification.MaxBackoff).To(Equal(&metav1.Duration{Duration: 30 * time.Second}))
 		})
 	})
 
@@ -418,6 +446,23 @@ var _ = Describe("Defaults", func() {
 		})
 	})
 
+	Describe("ConditionRemediation defaulting", func() {
+		It("should default the minimum interval of the remediations", func() {
+			obj.Controllers = &GardenletControllerConfiguration{
+				ShootCare: &ShootCareControllerConfiguration{
+					Remediations: []ConditionRemediation{
+						{Type: "SystemComponentsHealthy", Action: RemediationActionRestartVPN},
+						{Type: "APIServerAvailable", Action: RemediationActionReconcileDNSRecords, MinInterval: &metav1.Duration{Duration: time.Hour}},
+					},
+				},
+			}
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.ShootCare.Remediations[0].MinInterval).To(PointTo(Equal(metav1.Duration{Duration: 30 * time.Minute})))
+			Expect(obj.Controllers.ShootCare.Remediations[1].MinInterval).To(PointTo(Equal(metav1.Duration{Duration: time.Hour})))
+		})
+	})
+
 	Describe("StaleExtensionHealthChecks defaulting", func() {
 		It("should default the stale extension health checks", func() {
 			SetObjectDefaults_GardenletConfiguration(obj)
@@ -463,6 +508,32 @@ var _ = Describe("Defaults", func() {
 		})
 	})
 
+	Describe("ShootHibernationCleanupControllerConfiguration defaulting", func() {
+		It("should default the shoot hibernation cleanup controller configuration", func() {
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.ShootHibernationCleanup.ConcurrentSyncs).To(PointTo(Equal(5)))
+			Expect(obj.Controllers.ShootHibernationCleanup.SyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: time.Hour})))
+			Expect(obj.Controllers.ShootHibernationCleanup.MinimumHibernationDuration).To(PointTo(Equal(metav1.Duration{Duration: 7 * 24 * time.Hour})))
+		})
+
+		It("should not overwrite already set values for the shoot hibernation cleanup controller configuration", func() {
+			obj.Controllers = &GardenletControllerConfiguration{
+				ShootHibernationCleanup: &ShootHibernationCleanupControllerConfiguration{
+					ConcurrentSyncs:            ptr.To(0),
+					SyncPeriod:                 &metav1.Duration{Duration: 2 * time.Hour},
+					MinimumHibernationDuration: &metav1.Duration{Duration: 24 * time.Hour},
+				},
+			}
+
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.ShootHibernationCleanup.ConcurrentSyncs).To(PointTo(Equal(0)))
+			Expect(obj.Controllers.ShootHibernationCleanup.SyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: 2 * time.Hour})))
+			Expect(obj.Controllers.ShootHibernationCleanup.MinimumHibernationDuration).To(PointTo(Equal(metav1.Duration{Duration: 24 * time.Hour})))
+		})
+	})
+
 	Describe("NetworkPolicyControllerConfiguration defaulting", func() {
 		It("should default the network policy controller configuration", func() {
 			SetObjectDefaults_GardenletConfiguration(obj)
@@ -563,6 +634,31 @@ var _ = Describe("Defaults", func() {
 		})
 	})
 
+	Describe("EtcdDefragmentationCoordinatorControllerConfiguration defaulting", func() {
+		It("should default the etcd defragmentation coordinator controller configuration", func() {
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.EtcdDefragmentationCoordinator.SyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: time.Hour})))
+			Expect(obj.Controllers.EtcdDefragmentationCoordinator.SlotDuration).To(PointTo(Equal(metav1.Duration{Duration: 10 * time.Minute})))
+			Expect(obj.Controllers.EtcdDefragmentationCoordinator.MaxConcurrentDefragmentationsPerZone).To(PointTo(Equal(2)))
+		})
+
+		It("should not overwrite already set values for the etcd defragmentation coordinator controller configuration", func() {
+			obj.Controllers = &GardenletControllerConfiguration{
+				EtcdDefragmentationCoordinator: &EtcdDefragmentationCoordinatorControllerConfiguration{
+					SyncPeriod:                           &metav1.Duration{Duration: time.Minute},
+					SlotDuration:                         &metav1.Duration{Duration: 30 * time.Minute},
+					MaxConcurrentDefragmentationsPerZone: ptr.To(5),
+				},
+			}
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.Controllers.EtcdDefragmentationCoordinator.SyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: time.Minute})))
+			Expect(obj.Controllers.EtcdDefragmentationCoordinator.SlotDuration).To(PointTo(Equal(metav1.Duration{Duration: 30 * time.Minute})))
+			Expect(obj.Controllers.EtcdDefragmentationCoordinator.MaxConcurrentDefragmentationsPerZone).To(PointTo(Equal(5)))
+		})
+	})
+
 	Describe("LeaderElectionConfiguration defaulting", func() {
 		It("should correctly default the leader election configuration", func() {
 			SetObjectDefaults_GardenletConfiguration(obj)
@@ -862,6 +958,33 @@ var _ = Describe("Defaults", func() {
 			Expect(*obj.Monitoring.Shoot.Enabled).To(BeFalse())
 		})
 	})
+
+	Describe("ImagePrePulling defaulting", func() {
+		It("should not default the image pre-pulling configuration if it is not set", func() {
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.ImagePrePulling).To(BeNil())
+		})
+
+		It("should default the image pre-pulling configuration", func() {
+			obj.ImagePrePulling = &ImagePrePulling{}
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.ImagePrePulling.Enabled).To(PointTo(BeFalse()))
+			Expect(obj.ImagePrePulling.RetentionPeriod).To(PointTo(Equal(metav1.Duration{Duration: 168 * time.Hour})))
+		})
+
+		It("should not overwrite already set values for the image pre-pulling configuration", func() {
+			obj.ImagePrePulling = &ImagePrePulling{
+				Enabled:         ptr.To(true),
+				RetentionPeriod: &metav1.Duration{Duration: time.Hour},
+			}
+			SetObjectDefaults_GardenletConfiguration(obj)
+
+			Expect(obj.ImagePrePulling.Enabled).To(PointTo(BeTrue()))
+			Expect(obj.ImagePrePulling.RetentionPeriod).To(PointTo(Equal(metav1.Duration{Duration: time.Hour})))
+		})
+	})
 })
 
 var _ = Describe("Constants", func() {
@@ -84,6 +84,14 @@ type GardenletConfiguration struct {
 	// NodeToleration contains optional settings for default tolerations.
 	// +optional
 	NodeToleration *NodeToleration `json:"nodeToleration,omitempty"`
+	// ShootNamespaceConstraints contains optional templates for a ResourceQuota and a LimitRange which are reconciled
+	// into all shoot namespaces in the seed cluster.
+	// +optional
+	ShootNamespaceConstraints *ShootNamespaceConstraints `json:"shootNamespaceConstraints,omitempty"`
+	// ImagePrePulling contains optional settings for pre-pulling the control plane images of the Kubernetes versions
+	// used by the shoots hosted on the seed.
+	// +optional
+	ImagePrePulling *ImagePrePulling `json:"imagePrePulling,omitempty"`
 }
 
 // GardenClientConnection specifies the kubeconfig file and the client connection settings
@@ -185,6 +193,9 @@ type GardenletControllerConfiguration struct {
 	// ShootState defines the configuration of the ShootState controller.
 	// +optional
 	ShootState *ShootStateControllerConfiguration `json:"shootState,omitempty"`
+	// ShootHibernationCleanup defines the configuration of the ShootHibernationCleanup controller.
+	// +optional
+	ShootHibernationCleanup *ShootHibernationCleanupControllerConfiguration `json:"shootHibernationCleanup,omitempty"`
 	// NetworkPolicy defines the configuration of the NetworkPolicy controller
 	// +optional
 	NetworkPolicy *NetworkPolicyControllerConfiguration `json:"networkPolicy,omitempty"`
@@ -200,6 +211,9 @@ type GardenletControllerConfiguration struct {
 	// VPAEvictionRequirements defines the configuration of the VPAEvictionRequirements controller.
 	// +optional
 	VPAEvictionRequirements *VPAEvictionRequirementsControllerConfiguration `json:"vpaEvictionRequirements,omitempty"`
+	// EtcdDefragmentationCoordinator defines the configuration of the EtcdDefragmentationCoordinator controller.
+	// +optional
+	EtcdDefragmentationCoordinator *EtcdDefragmentationCoordinatorControllerConfiguration `json:"etcdDefragmentationCoordinator,omitempty"`
 }
 
 // BackupBucketControllerConfiguration defines the configuration of the BackupBucket
@@ -313,6 +327,49 @@ type ShootControllerConfiguration struct {
 	// Default: 120s
 	// +optional
 	DNSEntryTTLSeconds *int64 `json:"dnsEntryTTLSeconds,omitempty"`
+	// DNSPropagation contains settings for waiting for the propagation of the DNS records of shoots per DNS provider
+	// type.
+	// +optional
+	DNSPropagation []DNSPropagationConfiguration `json:"dnsPropagation,omitempty"`
+	// ForceDeletionExtensionGracePeriod is the duration how long gardenlet waits for extension resources to be deleted
+	// when force-deleting a Shoot. Afterwards, the finalizers of the remaining extension resources are removed.
+	// Defaults to 5m.
+	// +optional
+	ForceDeletionExtensionGracePeriod *metav1.Duration `json:"forceDeletionExtensionGracePeriod,omitempty"`
+}
+
+// DNSPropagationConfiguration contains settings for waiting for the propagation of DNS records of a DNS provider type.
+type DNSPropagationConfiguration struct {
+	// ProviderType is the type of the DNS provider (e.g., `aws-route53`) for which the settings apply.
+	ProviderType string `json:"providerType"`
+	// Timeout is the duration how long gardenlet waits for DNSRecords of this provider type to be reconciled
+	// successfully. Defaults to 2m if not set.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+	// Verification configures an active verification of the propagation of the DNS records after they have been
+	// reconciled successfully. If not set, the propagation is not verified.
+	// +optional
+	Verification *DNSPropagationVerification `json:"verification,omitempty"`
+}
+
+// DNSPropagationVerification configures how the propagation of DNS records is verified. The records are queried
+// directly from the given name servers, which are not subject to negative caching of recursive resolvers.
+type DNSPropagationVerification struct {
+	// NameServers is a list of name server endpoints (`<host>:<port>`) which are queried. If empty, the authoritative
+	// name servers of the DNS zone are determined and queried.
+	// +optional
+	NameServers []string `json:"nameServers,omitempty"`
+	// InitialBackoff is the initial duration between two verification attempts. It is doubled after each unsuccessful
+	// attempt. Defaults to 5s.
+	// +optional
+	InitialBackoff *metav1.Duration `json:"initialBackoff,omitempty"`
+	// MaxBackoff is the maximum duration between two verification attempts. Defaults to 1m.
+	// +optional
+	MaxBackoff *metav1.Duration `json:"maxBackoff,omitempty"`
+	// Timeout is the duration after which the verification fails if the DNS records have not been propagated to all
+	// name servers. Defaults to 5m.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
 }
 
 // ShootCareControllerConfiguration defines the configuration of the ShootCare
@@ -343,6 +400,10 @@ type ShootCareControllerConfiguration struct {
 	// is enabled.
 	// +optional
 	WebhookRemediatorEnabled *bool `json:"webhookRemediatorEnabled,omitempty"`
+	// Remediations is a list of predefined remediation actions which are executed when the respective condition of a
+	// Shoot fails.
+	// +optional
+	Remediations []ConditionRemediation `json:"remediations,omitempty"`
 }
 
 // SeedCareControllerConfiguration defines the configuration of the SeedCare
@@ -368,6 +429,21 @@ type ShootStateControllerConfiguration struct {
 	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
 }
 
+// ShootHibernationCleanupControllerConfiguration defines the configuration of the ShootHibernationCleanup controller.
+type ShootHibernationCleanupControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on events. The controller is disabled if
+	// set to 0.
+	// +optional
+	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+	// SyncPeriod is the duration how often the state of long-hibernated shoots is pruned.
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+	// MinimumHibernationDuration is the duration for which a shoot must be hibernated before its stale autoscaling
+	// state in the seed is pruned.
+	// +optional
+	MinimumHibernationDuration *metav1.Duration `json:"minimumHibernationDuration,omitempty"`
+}
+
 // StaleExtensionHealthChecks defines the configuration of the check for stale extension health checks.
 type StaleExtensionHealthChecks struct {
 	// Enabled specifies whether the check for stale extensions health checks is enabled.
@@ -388,6 +464,29 @@ type ConditionThreshold struct {
 	Duration metav1.Duration `json:"duration"`
 }
 
+// ConditionRemediation defines a predefined remediation action which is executed when a condition of a Shoot fails.
+type ConditionRemediation struct {
+	// Type is the type of the condition whose failure triggers the remediation.
+	Type string `json:"type"`
+	// Action is the predefined remediation action.
+	// Possible values are `RestartVPN` and `ReconcileDNSRecords`.
+	Action RemediationAction `json:"action"`
+	// MinInterval is the minimum duration between two executions of the action for the same Shoot.
+	// Defaults to 30m.
+	// +optional
+	MinInterval *metav1.Duration `json:"minInterval,omitempty"`
+}
+
+// RemediationAction is a predefined remediation action.
+type RemediationAction string
+
+const (
+	// RemediationActionRestartVPN restarts the VPN pods in the shoot control plane and in the shoot cluster.
+	RemediationActionRestartVPN RemediationAction = "RestartVPN"
+	// RemediationActionReconcileDNSRecords triggers a reconciliation of the DNSRecords of the shoot.
+	RemediationActionReconcileDNSRecords RemediationAction = "ReconcileDNSRecords"
+)
+
 // NetworkPolicyControllerConfiguration defines the configuration of the NetworkPolicy
 // controller.
 type NetworkPolicyControllerConfiguration struct {
@@ -428,6 +527,18 @@ type ManagedSeedControllerConfiguration struct {
 	// The applied jitterPeriod is taken from SyncJitterPeriod.
 	// +optional
 	JitterUpdates *bool `json:"jitterUpdates,omitempty"`
+	// PreflightChecks contains configuration for the checks which are performed before gardenlet is deployed into the
+	// shoot of a ManagedSeed.
+	// +optional
+	PreflightChecks *ManagedSeedPreflightChecksConfiguration `json:"preflightChecks,omitempty"`
+}
+
+// ManagedSeedPreflightChecksConfiguration defines the configuration of the preflight checks of the ManagedSeed controller.
+type ManagedSeedPreflightChecksConfiguration struct {
+	// MinimumAllocatable is the minimum amount of resources which the ready nodes of the shoot must provide in total.
+	// If not set, the resources of the shoot are not checked.
+	// +optional
+	MinimumAllocatable corev1.ResourceList `json:"minimumAllocatable,omitempty"`
 }
 
 // TokenRequestorServiceAccountControllerConfiguration defines the configuration of the TokenRequestorServiceAccount controller.
@@ -451,6 +562,23 @@ type VPAEvictionRequirementsControllerConfiguration struct {
 	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
 }
 
+// EtcdDefragmentationCoordinatorControllerConfiguration defines the configuration of the
+// EtcdDefragmentationCoordinator controller.
+type EtcdDefragmentationCoordinatorControllerConfiguration struct {
+	// SyncPeriod is the duration how often the defragmentation schedules of the etcds in the seed are coordinated.
+	// Defaults to 1h.
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+	// SlotDuration is the duration reserved for the defragmentation of an etcd. Defragmentations starting within the
+	// same slot are considered to run concurrently. Defaults to 10m.
+	// +optional
+	SlotDuration *metav1.Duration `json:"slotDuration,omitempty"`
+	// MaxConcurrentDefragmentationsPerZone is the maximum number of etcd defragmentations which may run concurrently
+	// in an availability zone of the seed. Defaults to 2.
+	// +optional
+	MaxConcurrentDefragmentationsPerZone *int `json:"maxConcurrentDefragmentationsPerZone,omitempty"`
+}
+
 // ResourcesConfiguration defines the total capacity for seed resources and the amount reserved for use by Gardener.
 type ResourcesConfiguration struct {
 	// Capacity defines the total resources of a seed.
@@ -745,3 +873,34 @@ type NodeToleration struct {
 	// +optional
 	DefaultUnreachableTolerationSeconds *int64 `json:"defaultUnreachableTolerationSeconds,omitempty"`
 }
+
+// ShootNamespaceConstraints contains templates for a ResourceQuota and a LimitRange which are reconciled into all shoot
+// namespaces in the seed cluster. They prevent single control planes from monopolizing the resources of the seed.
+type ShootNamespaceConstraints struct {
+	// ResourceQuota is the template for the ResourceQuota in the shoot namespaces.
+	// +optional
+	ResourceQuota *corev1.ResourceQuotaSpec `json:"resourceQuota,omitempty"`
+	// LimitRange is the template for the LimitRange in the shoot namespaces.
+	// +optional
+	LimitRange *corev1.LimitRangeSpec `json:"limitRange,omitempty"`
+	// ResourceQuotaScaleFactors maps shoot size classes to factors by which the hard limits of the ResourceQuota
+	// template are multiplied for shoots of the respective size class. Shoots whose size class is not contained in this
+	// map get the unscaled ResourceQuota.
+	// +optional
+	ResourceQuotaScaleFactors map[string]int32 `json:"resourceQuotaScaleFactors,omitempty"`
+}
+
+// ImagePrePulling contains settings for pre-pulling the control plane images of the Kubernetes versions used by the
+// shoots hosted on the seed. Pre-pulled images prevent that rescheduled or upgraded control plane pods are delayed by
+// image pulls.
+type ImagePrePulling struct {
+	// Enabled controls whether the control plane images are pre-pulled on all nodes of the seed cluster.
+	// Defaults to false.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+	// RetentionPeriod is the duration for which the images of a Kubernetes version are still pre-pulled after the
+	// version is no longer used by any shoot hosted on the seed.
+	// Defaults to 168h (7 days).
+	// +optional
+	RetentionPeriod *metav1.Duration `json:"retentionPeriod,omitempty"`
+}
@@ -71,6 +71,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ConditionRemediation)(nil), (*config.ConditionRemediation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ConditionRemediation_To_config_ConditionRemediation(a.(*ConditionRemediation), b.(*config.ConditionRemediation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ConditionRemediation)(nil), (*ConditionRemediation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ConditionRemediation_To_v1alpha1_ConditionRemediation(a.(*config.ConditionRemediation), b.(*ConditionRemediation), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ConditionThreshold)(nil), (*config.ConditionThreshold)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ConditionThreshold_To_config_ConditionThreshold(a.(*ConditionThreshold), b.(*config.ConditionThreshold), scope)
 	}); err != nil {
@@ -121,6 +131,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*DNSPropagationConfiguration)(nil), (*config.DNSPropagationConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DNSPropagationConfiguration_To_config_DNSPropagationConfiguration(a.(*DNSPropagationConfiguration), b.(*config.DNSPropagationConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.DNSPropagationConfiguration)(nil), (*DNSPropagationConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_DNSPropagationConfiguration_To_v1alpha1_DNSPropagationConfiguration(a.(*config.DNSPropagationConfiguration), b.(*DNSPropagationConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DNSPropagationVerification)(nil), (*config.DNSPropagationVerification)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DNSPropagationVerification_To_config_DNSPropagationVerification(a.(*DNSPropagationVerification), b.(*config.DNSPropagationVerification), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.DNSPropagationVerification)(nil), (*DNSPropagationVerification)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_DNSPropagationVerification_To_v1alpha1_DNSPropagationVerification(a.(*config.DNSPropagationVerification), b.(*DNSPropagationVerification), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ETCDBackupLeaderElection)(nil), (*config.ETCDBackupLeaderElection)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ETCDBackupLeaderElection_To_config_ETCDBackupLeaderElection(a.(*ETCDBackupLeaderElection), b.(*config.ETCDBackupLeaderElection), scope)
 	}); err != nil {
@@ -151,6 +181,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*EtcdDefragmentationCoordinatorControllerConfiguration)(nil), (*config.EtcdDefragmentationCoordinatorControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_EtcdDefragmentationCoordinatorControllerConfiguration_To_config_EtcdDefragmentationCoordinatorControllerConfiguration(a.(*EtcdDefragmentationCoordinatorControllerConfiguration), b.(*config.EtcdDefragmentationCoordinatorControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.EtcdDefragmentationCoordinatorControllerConfiguration)(nil), (*EtcdDefragmentationCoordinatorControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_EtcdDefragmentationCoordinatorControllerConfiguration_To_v1alpha1_EtcdDefragmentationCoordinatorControllerConfiguration(a.(*config.EtcdDefragmentationCoordinatorControllerConfiguration), b.(*EtcdDefragmentationCoordinatorControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ExposureClassHandler)(nil), (*config.ExposureClassHandler)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ExposureClassHandler_To_config_ExposureClassHandler(a.(*ExposureClassHandler), b.(*config.ExposureClassHandler), scope)
 	}); err != nil {
@@ -211,6 +251,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ImagePrePulling)(nil), (*config.ImagePrePulling)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ImagePrePulling_To_config_ImagePrePulling(a.(*ImagePrePulling), b.(*config.ImagePrePulling), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ImagePrePulling)(nil), (*ImagePrePulling)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ImagePrePulling_To_v1alpha1_ImagePrePulling(a.(*config.ImagePrePulling), b.(*ImagePrePulling), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*KubeconfigValidity)(nil), (*config.KubeconfigValidity)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_KubeconfigValidity_To_config_KubeconfigValidity(a.(*KubeconfigValidity), b.(*config.KubeconfigValidity), scope)
 	}); err != nil {
@@ -251,6 +301,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ManagedSeedPreflightChecksConfiguration)(nil), (*config.ManagedSeedPreflightChecksConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ManagedSeedPreflightChecksConfiguration_To_config_ManagedSeedPreflightChecksConfiguration(a.(*ManagedSeedPreflightChecksConfiguration), b.(*config.ManagedSeedPreflightChecksConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ManagedSeedPreflightChecksConfiguration)(nil), (*ManagedSeedPreflightChecksConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ManagedSeedPreflightChecksConfiguration_To_v1alpha1_ManagedSeedPreflightChecksConfiguration(a.(*config.ManagedSeedPreflightChecksConfiguration), b.(*ManagedSeedPreflightChecksConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*MonitoringConfig)(nil), (*config.MonitoringConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_MonitoringConfig_To_config_MonitoringConfig(a.(*MonitoringConfig), b.(*config.MonitoringConfig), scope)
 	}); err != nil {
@@ -421,6 +481,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShootHibernationCleanupControllerConfiguration)(nil), (*config.ShootHibernationCleanupControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootHibernationCleanupControllerConfiguration_To_config_ShootHibernationCleanupControllerConfiguration(a.(*ShootHibernationCleanupControllerConfiguration), b.(*config.ShootHibernationCleanupControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ShootHibernationCleanupControllerConfiguration)(nil), (*ShootHibernationCleanupControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ShootHibernationCleanupControllerConfiguration_To_v1alpha1_ShootHibernationCleanupControllerConfiguration(a.(*config.ShootHibernationCleanupControllerConfiguration), b.(*ShootHibernationCleanupControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ShootMonitoringConfig)(nil), (*config.ShootMonitoringConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ShootMonitoringConfig_To_config_ShootMonitoringConfig(a.(*ShootMonitoringConfig), b.(*config.ShootMonitoringConfig), scope)
 	}); err != nil {
@@ -431,6 +501,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShootNamespaceConstraints)(nil), (*config.ShootNamespaceConstraints)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootNamespaceConstraints_To_config_ShootNamespaceConstraints(a.(*ShootNamespaceConstraints), b.(*config.ShootNamespaceConstraints), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ShootNamespaceConstraints)(nil), (*ShootNamespaceConstraints)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ShootNamespaceConstraints_To_v1alpha1_ShootNamespaceConstraints(a.(*config.ShootNamespaceConstraints), b.(*ShootNamespaceConstraints), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ShootNodeLogging)(nil), (*config.ShootNodeLogging)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ShootNodeLogging_To_config_ShootNodeLogging(a.(*ShootNodeLogging), b.(*config.ShootNodeLogging), scope)
 	}); err != nil {
@@ -606,6 +686,30 @@ func Convert_config_BastionControllerConfiguration_To_v1alpha1_BastionController
 	return autoConvert_config_BastionControllerConfiguration_To_v1alpha1_BastionControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_ConditionRemediation_To_config_ConditionRemediation(in *ConditionRemediation, out *config.ConditionRemediation, s conversion.Scope) error {
+	out.Type = in.Type
+	out.Action = config.RemediationAction(in.Action)
+	out.MinInterval = (*v1.Duration)(unsafe.Pointer(in.MinInterval))
+	return nil
+}
+
+// Convert_v1alpha1_ConditionRemediation_To_config_ConditionRemediation is an autogenerated conversion function.
+func Convert_v1alpha1_ConditionRemediation_To_config_ConditionRemediation(in *ConditionRemediation, out *config.ConditionRemediation, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ConditionRemediation_To_config_ConditionRemediation(in, out, s)
+}
+
+func autoConvert_config_ConditionRemediation_To_v1alpha1_ConditionRemediation(in *config.ConditionRemediation, out *ConditionRemediation, s conversion.Scope) error {
+	out.Type = in.Type
+	out.Action = RemediationAction(in.Action)
+	out.MinInterval = (*v1.Duration)(unsafe.Pointer(in.MinInterval))
+	return nil
+}
+
+// Convert_config_ConditionRemediation_To_v1alpha1_ConditionRemediation is an autogenerated conversion function.
+func Convert_config_ConditionRemediation_To_v1alpha1_ConditionRemediation(in *config.ConditionRemediation, out *ConditionRemediation, s conversion.Scope) error {
+	return autoConvert_config_ConditionRemediation_To_v1alpha1_ConditionRemediation(in, out, s)
+}
+
 func autoConvert_v1alpha1_ConditionThreshold_To_config_ConditionThreshold(in *ConditionThreshold, out *config.ConditionThreshold, s conversion.Scope) error {
 	out.Type = in.Type
 	out.Duration = in.Duration
@@ -710,6 +814,56 @@ func Convert_config_CustodianController_To_v1alpha1_CustodianController(in *conf
 	return autoConvert_config_CustodianController_To_v1alpha1_CustodianController(in, out, s)
 }
 
+func autoConvert_v1alpha1_DNSPropagationConfiguration_To_config_DNSPropagationConfiguration(in *DNSPropagationConfiguration, out *config.DNSPropagationConfiguration, s conversion.Scope) error {
+	out.ProviderType = in.ProviderType
+	out.Timeout = (*v1.Duration)(unsafe.Pointer(in.Timeout))
+	out.Verification = (*config.DNSPropagationVerification)(unsafe.Pointer(in.Verification))
+	return nil
+}
+
+// Convert_v1alpha1_DNSPropagationConfiguration_To_config_DNSPropagationConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_DNSPropagationConfiguration_To_config_DNSPropagationConfiguration(in *DNSPropagationConfiguration, out *config.DNSPropagationConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DNSPropagationConfiguration_To_config_DNSPropagationConfiguration(in, out, s)
+}
+
+func autoConvert_config_DNSPropagationConfiguration_To_v1alpha1_DNSPropagationConfiguration(in *config.DNSPropagationConfiguration, out *DNSPropagationConfiguration, s conversion.Scope) error {
+	out.ProviderType = in.ProviderType
+	out.Timeout = (*v1.Duration)(unsafe.Pointer(in.Timeout))
+	out.Verification = (*DNSPropagationVerification)(unsafe.Pointer(in.Verification))
+	return nil
+}
+
+// Convert_config_DNSPropagationConfiguration_To_v1alpha1_DNSPropagationConfiguration is an autogenerated conversion function.
+func Convert_config_DNSPropagationConfiguration_To_v1alpha1_DNSPropagationConfiguration(in *config.DNSPropagationConfiguration, out *DNSPropagationConfiguration, s conversion.Scope) error {
+	return autoConvert_config_DNSPropagationConfiguration_To_v1alpha1_DNSPropagationConfiguration(in, out, s)
+}
+
+func autoConvert_v1alpha1_DNSPropagationVerification_To_config_DNSPropagationVerification(in *DNSPropagationVerification, out *config.DNSPropagationVerification, s conversion.Scope) error {
+	out.NameServers = *(*[]string)(unsafe.Pointer(&in.NameServers))
+	out.InitialBackoff = (*v1.Duration)(unsafe.Pointer(in.InitialBackoff))
+	out.MaxBackoff = (*v1.Duration)(unsafe.Pointer(in.MaxBackoff))
+	out.Timeout = (*v1.Duration)(unsafe.Pointer(in.Timeout))
+	return nil
+}
+
+// Convert_v1alpha1_DNSPropagationVerification_To_config_DNSPropagationVerification is an autogenerated conversion function.
+func Convert_v1alpha1_DNSPropagationVerification_To_config_DNSPropagationVerification(in *DNSPropagationVerification, out *config.DNSPropagationVerification, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DNSPropagationVerification_To_config_DNSPropagationVerification(in, out, s)
+}
+
+func autoConvert_config_DNSPropagationVerification_To_v1alpha1_DNSPropagationVerification(in *config.DNSPropagationVerification, out *DNSPropagationVerification, s conversion.Scope) error {
+	out.NameServers = *(*[]string)(unsafe.Pointer(&in.NameServers))
+	out.InitialBackoff = (*v1.Duration)(unsafe.Pointer(in.InitialBackoff))
+	out.MaxBackoff = (*v1.Duration)(unsafe.Pointer(in.MaxBackoff))
+	out.Timeout = (*v1.Duration)(unsafe.Pointer(in.Timeout))
+	return nil
+}
+
+// Convert_config_DNSPropagationVerification_To_v1alpha1_DNSPropagationVerification is an autogenerated conversion function.
+func Convert_config_DNSPropagationVerification_To_v1alpha1_DNSPropagationVerification(in *config.DNSPropagationVerification, out *DNSPropagationVerification, s conversion.Scope) error {
+	return autoConvert_config_DNSPropagationVerification_To_v1alpha1_DNSPropagationVerification(in, out, s)
+}
+
 func autoConvert_v1alpha1_ETCDBackupLeaderElection_To_config_ETCDBackupLeaderElection(in *ETCDBackupLeaderElection, out *config.ETCDBackupLeaderElection, s conversion.Scope) error {
 	out.ReelectionPeriod = (*v1.Duration)(unsafe.Pointer(in.ReelectionPeriod))
 	out.EtcdConnectionTimeout = (*v1.Duration)(unsafe.Pointer(in.EtcdConnectionTimeout))
@@ -782,6 +936,30 @@ func Convert_config_ETCDController_To_v1alpha1_ETCDController(in *config.ETCDCon
 	return autoConvert_config_ETCDController_To_v1alpha1_ETCDController(in, out, s)
 }
 
+func autoConvert_v1alpha1_EtcdDefragmentationCoordinatorControllerConfiguration_To_config_EtcdDefragmentationCoordinatorControllerConfiguration(in *EtcdDefragmentationCoordinatorControllerConfiguration, out *config.EtcdDefragmentationCoordinatorControllerConfiguration, s conversion.Scope) error {
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.SlotDuration = (*v1.Duration)(unsafe.Pointer(in.SlotDuration))
+	out.MaxConcurrentDefragmentationsPerZone = (*int)(unsafe.Pointer(in.MaxConcurrentDefragmentationsPerZone))
+	return nil
+}
+
+// Convert_v1alpha1_EtcdDefragmentationCoordinatorControllerConfiguration_To_config_EtcdDefragmentationCoordinatorControllerConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_EtcdDefragmentationCoordinatorControllerConfiguration_To_config_EtcdDefragmentationCoordinatorControllerConfiguration(in *EtcdDefragmentationCoordinatorControllerConfiguration, out *config.EtcdDefragmentationCoordinatorControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_EtcdDefragmentationCoordinatorControllerConfiguration_To_config_EtcdDefragmentationCoordinatorControllerConfiguration(in, out, s)
+}
+
+func autoConvert_config_EtcdDefragmentationCoordinatorControllerConfiguration_To_v1alpha1_EtcdDefragmentationCoordinatorControllerConfiguration(in *config.EtcdDefragmentationCoordinatorControllerConfiguration, out *EtcdDefragmentationCoordinatorControllerConfiguration, s conversion.Scope) error {
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.SlotDuration = (*v1.Duration)(unsafe.Pointer(in.SlotDuration))
+	out.MaxConcurrentDefragmentationsPerZone = (*int)(unsafe.Pointer(in.MaxConcurrentDefragmentationsPerZone))
+	return nil
+}
+
+// Convert_config_EtcdDefragmentationCoordinatorControllerConfiguration_To_v1alpha1_EtcdDefragmentationCoordinatorControllerConfiguration is an autogenerated conversion function.
+func Convert_config_EtcdDefragmentationCoordinatorControllerConfiguration_To_v1alpha1_EtcdDefragmentationCoordinatorControllerConfiguration(in *config.EtcdDefragmentationCoordinatorControllerConfiguration, out *EtcdDefragmentationCoordinatorControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_config_EtcdDefragmentationCoordinatorControllerConfiguration_To_v1alpha1_EtcdDefragmentationCoordinatorControllerConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_ExposureClassHandler_To_config_ExposureClassHandler(in *ExposureClassHandler, out *config.ExposureClassHandler, s conversion.Scope) error {
 	out.Name = in.Name
 	if err := Convert_v1alpha1_LoadBalancerServiceConfig_To_config_LoadBalancerServiceConfig(&in.LoadBalancerService, &out.LoadBalancerService, s); err != nil {
@@ -933,6 +1111,8 @@ func autoConvert_v1alpha1_GardenletConfiguration_To_config_GardenletConfiguratio
 	out.ExposureClassHandlers = *(*[]config.ExposureClassHandler)(unsafe.Pointer(&in.ExposureClassHandlers))
 	out.Monitoring = (*config.MonitoringConfig)(unsafe.Pointer(in.Monitoring))
 	out.NodeToleration = (*config.NodeToleration)(unsafe.Pointer(in.NodeToleration))
+	out.ShootNamespaceConstraints = (*config.ShootNamespaceConstraints)(unsafe.Pointer(in.ShootNamespaceConstraints))
+	out.ImagePrePulling = (*config.ImagePrePulling)(unsafe.Pointer(in.ImagePrePulling))
 	return nil
 }
 
@@ -1010,6 +1190,8 @@ func autoConvert_config_GardenletConfiguration_To_v1alpha1_GardenletConfiguratio
 	out.ExposureClassHandlers = *(*[]ExposureClassHandler)(unsafe.Pointer(&in.ExposureClassHandlers))
 	out.Monitoring = (*MonitoringConfig)(unsafe.Pointer(in.Monitoring))
 	out.NodeToleration = (*NodeToleration)(unsafe.Pointer(in.NodeToleration))
+	out.ShootNamespaceConstraints = (*ShootNamespaceConstraints)(unsafe.Pointer(in.ShootNamespaceConstraints))
+	out.ImagePrePulling = (*ImagePrePulling)(unsafe.Pointer(in.ImagePrePulling))
 	return nil
 }
 
@@ -1031,11 +1213,13 @@ func autoConvert_v1alpha1_GardenletControllerConfiguration_To_config_GardenletCo
 	out.Shoot = (*config.ShootControllerConfiguration)(unsafe.Pointer(in.Shoot))
 	out.ShootCare = (*config.ShootCareControllerConfiguration)(unsafe.Pointer(in.ShootCare))
 	out.ShootState = (*config.ShootStateControllerConfiguration)(unsafe.Pointer(in.ShootState))
+	out.ShootHibernationCleanup = (*config.ShootHibernationCleanupControllerConfiguration)(unsafe.Pointer(in.ShootHibernationCleanup))
 	out.NetworkPolicy = (*config.NetworkPolicyControllerConfiguration)(unsafe.Pointer(in.NetworkPolicy))
 	out.ManagedSeed = (*config.ManagedSeedControllerConfiguration)(unsafe.Pointer(in.ManagedSeed))
 	out.TokenRequestorServiceAccount = (*config.TokenRequestorServiceAccountControllerConfiguration)(unsafe.Pointer(in.TokenRequestorServiceAccount))
 	out.TokenRequestorWorkloadIdentity = (*config.TokenRequestorWorkloadIdentityControllerConfiguration)(unsafe.Pointer(in.TokenRequestorWorkloadIdentity))
 	out.VPAEvictionRequirements = (*config.VPAEvictionRequirementsControllerConfiguration)(unsafe.Pointer(in.VPAEvictionRequirements))
+	out.EtcdDefragmentationCoordinator = (*config.EtcdDefragmentationCoordinatorControllerConfiguration)(unsafe.Pointer(in.EtcdDefragmentationCoordinator))
 	return nil
 }
 
@@ -1057,11 +1241,13 @@ func autoConvert_config_GardenletControllerConfiguration_To_v1alpha1_GardenletCo
 	out.Shoot = (*ShootControllerConfiguration)(unsafe.Pointer(in.Shoot))
 	out.ShootCare = (*ShootCareControllerConfiguration)(unsafe.Pointer(in.ShootCare))
 	out.ShootState = (*ShootStateControllerConfiguration)(unsafe.Pointer(in.ShootState))
+	out.ShootHibernationCleanup = (*ShootHibernationCleanupControllerConfiguration)(unsafe.Pointer(in.ShootHibernationCleanup))
 	out.NetworkPolicy = (*NetworkPolicyControllerConfiguration)(unsafe.Pointer(in.NetworkPolicy))
 	out.ManagedSeed = (*ManagedSeedControllerConfiguration)(unsafe.Pointer(in.ManagedSeed))
 	out.TokenRequestorServiceAccount = (*TokenRequestorServiceAccountControllerConfiguration)(unsafe.Pointer(in.TokenRequestorServiceAccount))
 	out.TokenRequestorWorkloadIdentity = (*TokenRequestorWorkloadIdentityControllerConfiguration)(unsafe.Pointer(in.TokenRequestorWorkloadIdentity))
 	out.VPAEvictionRequirements = (*VPAEvictionRequirementsControllerConfiguration)(unsafe.Pointer(in.VPAEvictionRequirements))
+	out.EtcdDefragmentationCoordinator = (*EtcdDefragmentationCoordinatorControllerConfiguration)(unsafe.Pointer(in.EtcdDefragmentationCoordinator))
 	return nil
 }
 
@@ -1090,6 +1276,28 @@ func Convert_config_GardenletObjectControllerConfiguration_To_v1alpha1_Gardenlet
 	return autoConvert_config_GardenletObjectControllerConfiguration_To_v1alpha1_GardenletObjectControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_ImagePrePulling_To_config_ImagePrePulling(in *ImagePrePulling, out *config.ImagePrePulling, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	out.RetentionPeriod = (*v1.Duration)(unsafe.Pointer(in.RetentionPeriod))
+	return nil
+}
+
+// Convert_v1alpha1_ImagePrePulling_To_config_ImagePrePulling is an autogenerated conversion function.
+func Convert_v1alpha1_ImagePrePulling_To_config_ImagePrePulling(in *ImagePrePulling, out *config.ImagePrePulling, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ImagePrePulling_To_config_ImagePrePulling(in, out, s)
+}
+
+func autoConvert_config_ImagePrePulling_To_v1alpha1_ImagePrePulling(in *config.ImagePrePulling, out *ImagePrePulling, s conversion.Scope) error {
+	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
+	out.RetentionPeriod = (*v1.Duration)(unsafe.Pointer(in.RetentionPeriod))
+	return nil
+}
+
+// Convert_config_ImagePrePulling_To_v1alpha1_ImagePrePulling is an autogenerated conversion function.
+func Convert_config_ImagePrePulling_To_v1alpha1_ImagePrePulling(in *config.ImagePrePulling, out *ImagePrePulling, s conversion.Scope) error {
+	return autoConvert_config_ImagePrePulling_To_v1alpha1_ImagePrePulling(in, out, s)
+}
+
 func autoConvert_v1alpha1_KubeconfigValidity_To_config_KubeconfigValidity(in *KubeconfigValidity, out *config.KubeconfigValidity, s conversion.Scope) error {
 	out.Validity = (*v1.Duration)(unsafe.Pointer(in.Validity))
 	out.AutoRotationJitterPercentageMin = (*int32)(unsafe.Pointer(in.AutoRotationJitterPercentageMin))
@@ -1166,6 +1374,7 @@ func autoConvert_v1alpha1_ManagedSeedControllerConfiguration_To_config_ManagedSe
 	out.WaitSyncPeriod = (*v1.Duration)(unsafe.Pointer(in.WaitSyncPeriod))
 	out.SyncJitterPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncJitterPeriod))
 	out.JitterUpdates = (*bool)(unsafe.Pointer(in.JitterUpdates))
+	out.PreflightChecks = (*config.ManagedSeedPreflightChecksConfiguration)(unsafe.Pointer(in.PreflightChecks))
 	return nil
 }
 
@@ -1180,6 +1389,7 @@ func autoConvert_config_ManagedSeedControllerConfiguration_To_v1alpha1_ManagedSe
 	out.WaitSyncPeriod = (*v1.Duration)(unsafe.Pointer(in.WaitSyncPeriod))
 	out.SyncJitterPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncJitterPeriod))
 	out.JitterUpdates = (*bool)(unsafe.Pointer(in.JitterUpdates))
+	out.PreflightChecks = (*ManagedSeedPreflightChecksConfiguration)(unsafe.Pointer(in.PreflightChecks))
 	return nil
 }
 
@@ -1188,6 +1398,26 @@ func Convert_config_ManagedSeedControllerConfiguration_To_v1alpha1_ManagedSeedCo
 	return autoConvert_config_ManagedSeedControllerConfiguration_To_v1alpha1_ManagedSeedControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_ManagedSeedPreflightChecksConfiguration_To_config_ManagedSeedPreflightChecksConfiguration(in *ManagedSeedPreflightChecksConfiguration, out *config.ManagedSeedPreflightChecksConfiguration, s conversion.Scope) error {
+	out.MinimumAllocatable = *(*corev1.ResourceList)(unsafe.Pointer(&in.MinimumAllocatable))
+	return nil
+}
+
+// Convert_v1alpha1_ManagedSeedPreflightChecksConfiguration_To_config_ManagedSeedPreflightChecksConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_ManagedSeedPreflightChecksConfiguration_To_config_ManagedSeedPreflightChecksConfiguration(in *ManagedSeedPreflightChecksConfiguration, out *config.ManagedSeedPreflightChecksConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ManagedSeedPreflightChecksConfiguration_To_config_ManagedSeedPreflightChecksConfiguration(in, out, s)
+}
+
+func autoConvert_config_ManagedSeedPreflightChecksConfiguration_To_v1alpha1_ManagedSeedPreflightChecksConfiguration(in *config.ManagedSeedPreflightChecksConfiguration, out *ManagedSeedPreflightChecksConfiguration, s conversion.Scope) error {
+	out.MinimumAllocatable = *(*corev1.ResourceList)(unsafe.Pointer(&in.MinimumAllocatable))
+	return nil
+}
+
+// Convert_config_ManagedSeedPreflightChecksConfiguration_To_v1alpha1_ManagedSeedPreflightChecksConfiguration is an autogenerated conversion function.
+func Convert_config_ManagedSeedPreflightChecksConfiguration_To_v1alpha1_ManagedSeedPreflightChecksConfiguration(in *config.ManagedSeedPreflightChecksConfiguration, out *ManagedSeedPreflightChecksConfiguration, s conversion.Scope) error {
+	return autoConvert_config_ManagedSeedPreflightChecksConfiguration_To_v1alpha1_ManagedSeedPreflightChecksConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_MonitoringConfig_To_config_MonitoringConfig(in *MonitoringConfig, out *config.MonitoringConfig, s conversion.Scope) error {
 	out.Shoot = (*config.ShootMonitoringConfig)(unsafe.Pointer(in.Shoot))
 	return nil
@@ -1487,6 +1717,7 @@ func autoConvert_v1alpha1_ShootCareControllerConfiguration_To_config_ShootCareCo
 	out.ManagedResourceProgressingThreshold = (*v1.Duration)(unsafe.Pointer(in.ManagedResourceProgressingThreshold))
 	out.ConditionThresholds = *(*[]config.ConditionThreshold)(unsafe.Pointer(&in.ConditionThresholds))
 	out.WebhookRemediatorEnabled = (*bool)(unsafe.Pointer(in.WebhookRemediatorEnabled))
+	out.Remediations = *(*[]config.ConditionRemediation)(unsafe.Pointer(&in.Remediations))
 	return nil
 }
 
@@ -1502,6 +1733,7 @@ func autoConvert_config_ShootCareControllerConfiguration_To_v1alpha1_ShootCareCo
 	out.ManagedResourceProgressingThreshold = (*v1.Duration)(unsafe.Pointer(in.ManagedResourceProgressingThreshold))
 	out.ConditionThresholds = *(*[]ConditionThreshold)(unsafe.Pointer(&in.ConditionThresholds))
 	out.WebhookRemediatorEnabled = (*bool)(unsafe.Pointer(in.WebhookRemediatorEnabled))
+	out.Remediations = *(*[]ConditionRemediation)(unsafe.Pointer(&in.Remediations))
 	return nil
 }
 
@@ -1542,6 +1774,8 @@ func autoConvert_v1alpha1_ShootControllerConfiguration_To_config_ShootController
 	out.RetryDuration = (*v1.Duration)(unsafe.Pointer(in.RetryDuration))
 	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
 	out.DNSEntryTTLSeconds = (*int64)(unsafe.Pointer(in.DNSEntryTTLSeconds))
+	out.DNSPropagation = *(*[]config.DNSPropagationConfiguration)(unsafe.Pointer(&in.DNSPropagation))
+	out.ForceDeletionExtensionGracePeriod = (*v1.Duration)(unsafe.Pointer(in.ForceDeletionExtensionGracePeriod))
 	return nil
 }
 
@@ -1558,6 +1792,8 @@ func autoConvert_config_ShootControllerConfiguration_To_v1alpha1_ShootController
 	out.RetryDuration = (*v1.Duration)(unsafe.Pointer(in.RetryDuration))
 	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
 	out.DNSEntryTTLSeconds = (*int64)(unsafe.Pointer(in.DNSEntryTTLSeconds))
+	out.DNSPropagation = *(*[]DNSPropagationConfiguration)(unsafe.Pointer(&in.DNSPropagation))
+	out.ForceDeletionExtensionGracePeriod = (*v1.Duration)(unsafe.Pointer(in.ForceDeletionExtensionGracePeriod))
 	return nil
 }
 
@@ -1586,6 +1822,30 @@ func Convert_config_ShootEventLogging_To_v1alpha1_ShootEventLogging(in *config.S
 	return autoConvert_config_ShootEventLogging_To_v1alpha1_ShootEventLogging(in, out, s)
 }
 
+func autoConvert_v1alpha1_ShootHibernationCleanupControllerConfiguration_To_config_ShootHibernationCleanupControllerConfiguration(in *ShootHibernationCleanupControllerConfiguration, out *config.ShootHibernationCleanupControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.MinimumHibernationDuration = (*v1.Duration)(unsafe.Pointer(in.MinimumHibernationDuration))
+	return nil
+}
+
+// Convert_v1alpha1_ShootHibernationCleanupControllerConfiguration_To_config_ShootHibernationCleanupControllerConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_ShootHibernationCleanupControllerConfiguration_To_config_ShootHibernationCleanupControllerConfiguration(in *ShootHibernationCleanupControllerConfiguration, out *config.ShootHibernationCleanupControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootHibernationCleanupControllerConfiguration_To_config_ShootHibernationCleanupControllerConfiguration(in, out, s)
+}
+
+func autoConvert_config_ShootHibernationCleanupControllerConfiguration_To_v1alpha1_ShootHibernationCleanupControllerConfiguration(in *config.ShootHibernationCleanupControllerConfiguration, out *ShootHibernationCleanupControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.MinimumHibernationDuration = (*v1.Duration)(unsafe.Pointer(in.MinimumHibernationDuration))
+	return nil
+}
+
+// Convert_config_ShootHibernationCleanupControllerConfiguration_To_v1alpha1_ShootHibernationCleanupControllerConfiguration is an autogenerated conversion function.
+func Convert_config_ShootHibernationCleanupControllerConfiguration_To_v1alpha1_ShootHibernationCleanupControllerConfiguration(in *config.ShootHibernationCleanupControllerConfiguration, out *ShootHibernationCleanupControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_config_ShootHibernationCleanupControllerConfiguration_To_v1alpha1_ShootHibernationCleanupControllerConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_ShootMonitoringConfig_To_config_ShootMonitoringConfig(in *ShootMonitoringConfig, out *config.ShootMonitoringConfig, s conversion.Scope) error {
 	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
 	out.RemoteWrite = (*config.RemoteWriteMonitoringConfig)(unsafe.Pointer(in.RemoteWrite))
@@ -1610,6 +1870,30 @@ func Convert_config_ShootMonitoringConfig_To_v1alpha1_ShootMonitoringConfig(in *
 	return autoConvert_config_ShootMonitoringConfig_To_v1alpha1_ShootMonitoringConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_ShootNamespaceConstraints_To_config_ShootNamespaceConstraints(in *ShootNamespaceConstraints, out *config.ShootNamespaceConstraints, s conversion.Scope) error {
+	out.ResourceQuota = (*corev1.ResourceQuotaSpec)(unsafe.Pointer(in.ResourceQuota))
+	out.LimitRange = (*corev1.LimitRangeSpec)(unsafe.Pointer(in.LimitRange))
+	out.ResourceQuotaScaleFactors = *(*map[string]int32)(unsafe.Pointer(&in.ResourceQuotaScaleFactors))
+	return nil
+}
+
+// Convert_v1alpha1_ShootNamespaceConstraints_To_config_ShootNamespaceConstraints is an autogenerated conversion function.
+func Convert_v1alpha1_ShootNamespaceConstraints_To_config_ShootNamespaceConstraints(in *ShootNamespaceConstraints, out *config.ShootNamespaceConstraints, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootNamespaceConstraints_To_config_ShootNamespaceConstraints(in, out, s)
+}
+
+func autoConvert_config_ShootNamespaceConstraints_To_v1alpha1_ShootNamespaceConstraints(in *config.ShootNamespaceConstraints, out *ShootNamespaceConstraints, s conversion.Scope) error {
+	out.ResourceQuota = (*corev1.ResourceQuotaSpec)(unsafe.Pointer(in.ResourceQuota))
+	out.LimitRange = (*corev1.LimitRangeSpec)(unsafe.Pointer(in.LimitRange))
+	out.ResourceQuotaScaleFactors = *(*map[string]int32)(unsafe.Pointer(&in.ResourceQuotaScaleFactors))
+	return nil
+}
+
+// Convert_config_ShootNamespaceConstraints_To_v1alpha1_ShootNamespaceConstraints is an autogenerated conversion function.
+func Convert_config_ShootNamespaceConstraints_To_v1alpha1_ShootNamespaceConstraints(in *config.ShootNamespaceConstraints, out *ShootNamespaceConstraints, s conversion.Scope) error {
+	return autoConvert_config_ShootNamespaceConstraints_To_v1alpha1_ShootNamespaceConstraints(in, out, s)
+}
+
 func autoConvert_v1alpha1_ShootNodeLogging_To_config_ShootNodeLogging(in *ShootNodeLogging, out *config.ShootNodeLogging, s conversion.Scope) error {
 	out.ShootPurposes = *(*[]core.ShootPurpose)(unsafe.Pointer(&in.ShootPurposes))
 	return nil
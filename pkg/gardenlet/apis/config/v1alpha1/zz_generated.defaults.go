@@ -67,16 +67,29 @@ func SetObjectDefaults_GardenletConfiguration(in *GardenletConfiguration) {
 		}
 		if in.Controllers.Shoot != nil {
 			SetDefaults_ShootControllerConfiguration(in.Controllers.Shoot)
+			for i := range in.Controllers.Shoot.DNSPropagation {
+				a := &in.Controllers.Shoot.DNSPropagation[i]
+				if a.Verification != nil {
+					SetDefaults_DNSPropagationVerification(a.Verification)
+				}
+			}
 		}
 		if in.Controllers.ShootCare != nil {
 			SetDefaults_ShootCareControllerConfiguration(in.Controllers.ShootCare)
 			if in.Controllers.ShootCare.StaleExtensionHealthChecks != nil {
 				SetDefaults_StaleExtensionHealthChecks(in.Controllers.ShootCare.StaleExtensionHealthChecks)
 			}
+			for i := range in.Controllers.ShootCare.Remediations {
+				a := &in.Controllers.ShootCare.Remediations[i]
+				SetDefaults_ConditionRemediation(a)
+			}
 		}
 		if in.Controllers.ShootState != nil {
 			SetDefaults_ShootStateControllerConfiguration(in.Controllers.ShootState)
 		}
+		if in.Controllers.ShootHibernationCleanup != nil {
+			SetDefaults_ShootHibernationCleanupControllerConfiguration(in.Controllers.ShootHibernationCleanup)
+		}
 		if in.Controllers.NetworkPolicy != nil {
 			SetDefaults_NetworkPolicyControllerConfiguration(in.Controllers.NetworkPolicy)
 		}
@@ -92,6 +105,9 @@ func SetObjectDefaults_GardenletConfiguration(in *GardenletConfiguration) {
 		if in.Controllers.VPAEvictionRequirements != nil {
 			SetDefaults_VPAEvictionRequirementsControllerConfiguration(in.Controllers.VPAEvictionRequirements)
 		}
+		if in.Controllers.EtcdDefragmentationCoordinator != nil {
+			SetDefaults_EtcdDefragmentationCoordinatorControllerConfiguration(in.Controllers.EtcdDefragmentationCoordinator)
+		}
 	}
 	if in.LeaderElection != nil {
 		SetDefaults_LeaderElectionConfiguration(in.LeaderElection)
@@ -133,4 +149,7 @@ func SetObjectDefaults_GardenletConfiguration(in *GardenletConfiguration) {
 			SetDefaults_ShootMonitoringConfig(in.Monitoring.Shoot)
 		}
 	}
+	if in.ImagePrePulling != nil {
+		SetDefaults_ImagePrePulling(in.ImagePrePulling)
+	}
 }
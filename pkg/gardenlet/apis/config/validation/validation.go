@@ -20,6 +20,7 @@ import (
 	gardencorevalidation "github.com/gardener/gardener/pkg/apis/core/validation"
 	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
 	"github.com/gardener/gardener/pkg/logger"
+	kubernetescorevalidation "github.com/gardener/gardener/pkg/utils/validation/kubernetes/core"
 )
 
 // ValidateGardenletConfiguration validates a GardenletConfiguration object.
@@ -59,12 +60,18 @@ func ValidateGardenletConfiguration(cfg *config.GardenletConfiguration, fldPath
 		if cfg.Controllers.ShootCare != nil {
 			allErrs = append(allErrs, validateShootCareControllerConfiguration(cfg.Controllers.ShootCare, fldPath.Child("controllers", "shootCare"))...)
 		}
+		if cfg.Controllers.ShootHibernationCleanup != nil {
+			allErrs = append(allErrs, validateShootHibernationCleanupControllerConfiguration(cfg.Controllers.ShootHibernationCleanup, fldPath.Child("controllers", "shootHibernationCleanup"))...)
+		}
 		if cfg.Controllers.ManagedSeed != nil {
 			allErrs = append(allErrs, validateManagedSeedControllerConfiguration(cfg.Controllers.ManagedSeed, fldPath.Child("controllers", "managedSeed"))...)
 		}
 		if cfg.Controllers.NetworkPolicy != nil {
 			allErrs = append(allErrs, validateNetworkPolicyControllerConfiguration(cfg.Controllers.NetworkPolicy, fldPath.Child("controllers", "networkPolicy"))...)
 		}
+		if cfg.Controllers.EtcdDefragmentationCoordinator != nil {
+			allErrs = append(allErrs, validateEtcdDefragmentationCoordinatorControllerConfiguration(cfg.Controllers.EtcdDefragmentationCoordinator, fldPath.Child("controllers", "etcdDefragmentationCoordinator"))...)
+		}
 	}
 
 	if cfg.LogLevel != "" {
@@ -94,10 +101,10 @@ func ValidateGardenletConfiguration(cfg *config.GardenletConfiguration, fldPath
 				allErrs = append(allErrs, field.Invalid(resourcesPath.Child("reserved", string(resourceName)), cfg.Resources.Reserved[resourceName], "reserved must be lower or equal to capacity"))
 			}
 		}
-		for resourceName := range cfg.Resources.Reserved {
-			if _, ok := cfg.Resources.Capacity[resourceName]; !ok {
-				allErrs = append(allErrs, field.Invalid(resourcesPath.Child("reserved", string(resourceName)), cfg.Resources.Reserved[resourceName], "reserved without capacity"))
-			}
+		// Reserved resources without configured capacity are allowed since their capacity might be reported dynamically,
+		// see the capacity reporters of the seed controller.
+		for resourceName, quantity := range cfg.Resources.Reserved {
+			allErrs = append(allErrs, kubernetescorevalidation.ValidateResourceQuantityValue(string(resourceName), quantity, resourcesPath.Child("reserved", string(resourceName)))...)
 		}
 	}
 
@@ -130,6 +137,14 @@ func ValidateGardenletConfiguration(cfg *config.GardenletConfiguration, fldPath
 		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(ptr.Deref(nodeTolerationCfg.DefaultUnreachableTolerationSeconds, 0), nodeTolerationConfigPath.Child("defaultUnreachableTolerationSeconds"))...)
 	}
 
+	if cfg.ShootNamespaceConstraints != nil {
+		allErrs = append(allErrs, validateShootNamespaceConstraints(cfg.ShootNamespaceConstraints, fldPath.Child("shootNamespaceConstraints"))...)
+	}
+
+	if cfg.ImagePrePulling != nil && cfg.ImagePrePulling.RetentionPeriod != nil && cfg.ImagePrePulling.RetentionPeriod.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("imagePrePulling", "retentionPeriod"), cfg.ImagePrePulling.RetentionPeriod.Duration.String(), "must be non-negative"))
+	}
+
 	return allErrs
 }
 
@@ -144,6 +159,29 @@ func ValidateGardenletConfigurationUpdate(newCfg, oldCfg *config.GardenletConfig
 	return allErrs
 }
 
+func validateShootNamespaceConstraints(cfg *config.ShootNamespaceConstraints, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if cfg.ResourceQuota != nil {
+		hardPath := fldPath.Child("resourceQuota", "hard")
+		for resourceName, quantity := range cfg.ResourceQuota.Hard {
+			allErrs = append(allErrs, kubernetescorevalidation.ValidateResourceQuantityValue(resourceName.String(), quantity, hardPath.Key(resourceName.String()))...)
+		}
+	}
+
+	for sizeClass, factor := range cfg.ResourceQuotaScaleFactors {
+		if factor < 1 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("resourceQuotaScaleFactors").Key(sizeClass), factor, "scale factor must be at least 1"))
+		}
+	}
+
+	if len(cfg.ResourceQuotaScaleFactors) > 0 && cfg.ResourceQuota == nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("resourceQuotaScaleFactors"), "scale factors can only be configured together with a resource quota"))
+	}
+
+	return allErrs
+}
+
 func validateShootControllerConfiguration(cfg *config.ShootControllerConfiguration, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -163,6 +201,10 @@ func validateShootControllerConfiguration(cfg *config.ShootControllerConfigurati
 		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(cfg.SyncPeriod.Duration), fldPath.Child("syncPeriod"))...)
 	}
 
+	if cfg.ForceDeletionExtensionGracePeriod != nil {
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(cfg.ForceDeletionExtensionGracePeriod.Duration), fldPath.Child("forceDeletionExtensionGracePeriod"))...)
+	}
+
 	if cfg.DNSEntryTTLSeconds != nil {
 		const (
 			dnsEntryTTLSecondsMin = 30
@@ -174,6 +216,52 @@ func validateShootControllerConfiguration(cfg *config.ShootControllerConfigurati
 		}
 	}
 
+	providerTypes := sets.New[string]()
+	for i, dnsPropagation := range cfg.DNSPropagation {
+		idxPath := fldPath.Child("dnsPropagation").Index(i)
+
+		if dnsPropagation.ProviderType == "" {
+			allErrs = append(allErrs, field.Required(idxPath.Child("providerType"), "must provide a DNS provider type"))
+		} else if providerTypes.Has(dnsPropagation.ProviderType) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("providerType"), dnsPropagation.ProviderType))
+		}
+		providerTypes.Insert(dnsPropagation.ProviderType)
+
+		if dnsPropagation.Timeout != nil && dnsPropagation.Timeout.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("timeout"), dnsPropagation.Timeout.Duration.String(), "must be positive"))
+		}
+
+		if dnsPropagation.Verification != nil {
+			allErrs = append(allErrs, validateDNSPropagationVerification(dnsPropagation.Verification, idxPath.Child("verification"))...)
+		}
+	}
+
+	return allErrs
+}
+
+func validateDNSPropagationVerification(cfg *config.DNSPropagationVerification, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for i, nameServer := range cfg.NameServers {
+		if _, port, err := net.SplitHostPort(nameServer); err != nil || port == "" {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("nameServers").Index(i), nameServer, "must be in the format <host>:<port>"))
+		}
+	}
+
+	if cfg.InitialBackoff != nil && cfg.InitialBackoff.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("initialBackoff"), cfg.InitialBackoff.Duration.String(), "must be positive"))
+	}
+	if cfg.MaxBackoff != nil && cfg.MaxBackoff.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxBackoff"), cfg.MaxBackoff.Duration.String(), "must be positive"))
+	}
+	if cfg.Timeout != nil && cfg.Timeout.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("timeout"), cfg.Timeout.Duration.String(), "must be positive"))
+	}
+
+	if cfg.InitialBackoff != nil && cfg.MaxBackoff != nil && cfg.InitialBackoff.Duration > cfg.MaxBackoff.Duration {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("initialBackoff"), cfg.InitialBackoff.Duration.String(), "must not be greater than maxBackoff"))
+	}
+
 	return allErrs
 }
 
@@ -200,6 +288,43 @@ func validateShootCareControllerConfiguration(cfg *config.ShootCareControllerCon
 		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(cfg.ConditionThresholds[i].Duration.Duration), fldPath.Child("conditionThresholds").Index(i).Child("duration"))...)
 	}
 
+	for i, remediation := range cfg.Remediations {
+		idxPath := fldPath.Child("remediations").Index(i)
+
+		if len(remediation.Type) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("type"), "must provide the condition type"))
+		}
+		if !availableRemediationActions.Has(remediation.Action) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("action"), remediation.Action, sets.List(availableRemediationActions)))
+		}
+		if remediation.MinInterval != nil && remediation.MinInterval.Duration <= 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("minInterval"), remediation.MinInterval.Duration.String(), "must be positive"))
+		}
+	}
+
+	return allErrs
+}
+
+var availableRemediationActions = sets.New(
+	config.RemediationActionRestartVPN,
+	config.RemediationActionReconcileDNSRecords,
+)
+
+func validateShootHibernationCleanupControllerConfiguration(cfg *config.ShootHibernationCleanupControllerConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if cfg.ConcurrentSyncs != nil {
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(*cfg.ConcurrentSyncs), fldPath.Child("concurrentSyncs"))...)
+	}
+
+	if cfg.SyncPeriod != nil && cfg.SyncPeriod.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("syncPeriod"), cfg.SyncPeriod.Duration.String(), "must be positive"))
+	}
+
+	if cfg.MinimumHibernationDuration != nil {
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(cfg.MinimumHibernationDuration.Duration), fldPath.Child("minimumHibernationDuration"))...)
+	}
+
 	return allErrs
 }
 
@@ -218,6 +343,11 @@ func validateManagedSeedControllerConfiguration(cfg *config.ManagedSeedControlle
 	if cfg.SyncJitterPeriod != nil {
 		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(cfg.SyncJitterPeriod.Duration), fldPath.Child("syncJitterPeriod"))...)
 	}
+	if cfg.PreflightChecks != nil {
+		for resourceName, quantity := range cfg.PreflightChecks.MinimumAllocatable {
+			allErrs = append(allErrs, kubernetescorevalidation.ValidateResourceQuantityValue(string(resourceName), quantity, fldPath.Child("preflightChecks", "minimumAllocatable", string(resourceName)))...)
+		}
+	}
 
 	return allErrs
 }
@@ -261,6 +391,26 @@ func validateBackupEntryControllerConfiguration(cfg *config.BackupEntryControlle
 	return allErrs
 }
 
+func validateEtcdDefragmentationCoordinatorControllerConfiguration(cfg *config.EtcdDefragmentationCoordinatorControllerConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if cfg.SyncPeriod != nil && cfg.SyncPeriod.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("syncPeriod"), cfg.SyncPeriod.Duration.String(), "must be positive"))
+	}
+
+	if cfg.SlotDuration != nil {
+		if slot := cfg.SlotDuration.Duration; slot < time.Minute || slot > time.Hour || slot%time.Minute != 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("slotDuration"), slot.String(), "must be a multiple of one minute between 1m and 1h"))
+		}
+	}
+
+	if cfg.MaxConcurrentDefragmentationsPerZone != nil && *cfg.MaxConcurrentDefragmentationsPerZone < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxConcurrentDefragmentationsPerZone"), *cfg.MaxConcurrentDefragmentationsPerZone, "must be at least 1"))
+	}
+
+	return allErrs
+}
+
 func validateBastionControllerConfiguration(cfg *config.BastionControllerConfiguration, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
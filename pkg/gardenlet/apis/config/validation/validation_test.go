@@ -214,6 +214,7 @@ var _ = Describe("GardenletConfiguration", func() {
 				cfg.Controllers.Shoot.ProgressReportPeriod = &metav1.Duration{Duration: -1}
 				cfg.Controllers.Shoot.SyncPeriod = &metav1.Duration{Duration: -1}
 				cfg.Controllers.Shoot.RetryDuration = &metav1.Duration{Duration: -1}
+				cfg.Controllers.Shoot.ForceDeletionExtensionGracePeriod = &metav1.Duration{Duration: -1}
 
 				errorList := ValidateGardenletConfiguration(cfg, nil, false)
 
@@ -234,6 +235,10 @@ var _ = Describe("GardenletConfiguration", func() {
 						"Type":  Equal(field.ErrorTypeInvalid),
 						"Field": Equal("controllers.shoot.retryDuration"),
 					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shoot.forceDeletionExtensionGracePeriod"),
+					})),
 				))
 			})
 
@@ -258,6 +263,100 @@ var _ = Describe("GardenletConfiguration", func() {
 					"Field": Equal("controllers.shoot.dnsEntryTTLSeconds"),
 				}))))
 			})
+
+			It("should allow valid DNS propagation settings", func() {
+				cfg.Controllers.Shoot.DNSPropagation = []config.DNSPropagationConfiguration{
+					{ProviderType: "aws-route53", Timeout: &metav1.Duration{Duration: 10 * time.Minute}},
+					{
+						ProviderType: "openstack-designate",
+						Verification: &config.DNSPropagationVerification{
+							NameServers:    []string{"10.0.0.1:53", "ns1.example.com:53"},
+							InitialBackoff: &metav1.Duration{Duration: 5 * time.Second},
+							MaxBackoff:     &metav1.Duration{Duration: time.Minute},
+							Timeout:        &metav1.Duration{Duration: 5 * time.Minute},
+						},
+					},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(BeEmpty())
+			})
+
+			It("should forbid invalid DNS propagation settings", func() {
+				cfg.Controllers.Shoot.DNSPropagation = []config.DNSPropagationConfiguration{
+					{ProviderType: "aws-route53", Timeout: &metav1.Duration{Duration: -1}},
+					{ProviderType: "aws-route53"},
+					{
+						Verification: &config.DNSPropagationVerification{
+							NameServers:    []string{"10.0.0.1"},
+							InitialBackoff: &metav1.Duration{Duration: 2 * time.Minute},
+							MaxBackoff:     &metav1.Duration{Duration: time.Minute},
+							Timeout:        &metav1.Duration{Duration: 0},
+						},
+					},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shoot.dnsPropagation[0].timeout"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("controllers.shoot.dnsPropagation[1].providerType"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("controllers.shoot.dnsPropagation[2].providerType"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shoot.dnsPropagation[2].verification.nameServers[0]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shoot.dnsPropagation[2].verification.timeout"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shoot.dnsPropagation[2].verification.initialBackoff"),
+					})),
+				))
+			})
+		})
+
+		Context("shootHibernationCleanup controller", func() {
+			It("should allow valid configuration", func() {
+				cfg.Controllers.ShootHibernationCleanup = &config.ShootHibernationCleanupControllerConfiguration{
+					ConcurrentSyncs:            ptr.To(5),
+					SyncPeriod:                 &metav1.Duration{Duration: time.Hour},
+					MinimumHibernationDuration: &metav1.Duration{Duration: 24 * time.Hour},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(BeEmpty())
+			})
+
+			It("should forbid invalid configuration", func() {
+				cfg.Controllers.ShootHibernationCleanup = &config.ShootHibernationCleanupControllerConfiguration{
+					ConcurrentSyncs:            ptr.To(-1),
+					SyncPeriod:                 &metav1.Duration{},
+					MinimumHibernationDuration: &metav1.Duration{Duration: -1},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shootHibernationCleanup.concurrentSyncs"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shootHibernationCleanup.syncPeriod"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shootHibernationCleanup.minimumHibernationDuration"),
+					})),
+				))
+			})
 		})
 
 		Context("shootCare controller", func() {
@@ -295,6 +394,37 @@ var _ = Describe("GardenletConfiguration", func() {
 					})),
 				))
 			})
+
+			It("should allow valid remediations", func() {
+				cfg.Controllers.ShootCare.Remediations = []config.ConditionRemediation{
+					{Type: "SystemComponentsHealthy", Action: config.RemediationActionRestartVPN, MinInterval: &metav1.Duration{Duration: time.Hour}},
+					{Type: "APIServerAvailable", Action: config.RemediationActionReconcileDNSRecords},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(BeEmpty())
+			})
+
+			It("should forbid invalid remediations", func() {
+				cfg.Controllers.ShootCare.Remediations = []config.ConditionRemediation{
+					{Action: config.RemediationActionRestartVPN},
+					{Type: "APIServerAvailable", Action: "DeleteEverything", MinInterval: &metav1.Duration{}},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("controllers.shootCare.remediations[0].type"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("controllers.shootCare.remediations[1].action"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.shootCare.remediations[1].minInterval"),
+					})),
+				))
+			})
 		})
 
 		Context("managed seed controller", func() {
@@ -305,6 +435,9 @@ var _ = Describe("GardenletConfiguration", func() {
 				cfg.Controllers.ManagedSeed.SyncPeriod = &metav1.Duration{Duration: -1}
 				cfg.Controllers.ManagedSeed.WaitSyncPeriod = &metav1.Duration{Duration: -1}
 				cfg.Controllers.ManagedSeed.SyncJitterPeriod = &metav1.Duration{Duration: -1}
+				cfg.Controllers.ManagedSeed.PreflightChecks = &config.ManagedSeedPreflightChecksConfiguration{
+					MinimumAllocatable: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("-1")},
+				}
 
 				errorList := ValidateGardenletConfiguration(cfg, nil, false)
 
@@ -325,6 +458,10 @@ var _ = Describe("GardenletConfiguration", func() {
 						"Type":  Equal(field.ErrorTypeInvalid),
 						"Field": Equal("controllers.managedSeed.syncJitterPeriod"),
 					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.managedSeed.preflightChecks.minimumAllocatable.cpu"),
+					})),
 				))
 			})
 		})
@@ -381,6 +518,41 @@ var _ = Describe("GardenletConfiguration", func() {
 			})
 		})
 
+		Context("etcd defragmentation coordinator controller", func() {
+			BeforeEach(func() {
+				cfg.Controllers.EtcdDefragmentationCoordinator = &config.EtcdDefragmentationCoordinatorControllerConfiguration{
+					SyncPeriod:                           &metav1.Duration{Duration: time.Hour},
+					SlotDuration:                         &metav1.Duration{Duration: 10 * time.Minute},
+					MaxConcurrentDefragmentationsPerZone: ptr.To(2),
+				}
+			})
+
+			It("should allow valid configuration", func() {
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(BeEmpty())
+			})
+
+			It("should forbid invalid configuration", func() {
+				cfg.Controllers.EtcdDefragmentationCoordinator.SyncPeriod = &metav1.Duration{}
+				cfg.Controllers.EtcdDefragmentationCoordinator.SlotDuration = &metav1.Duration{Duration: 90 * time.Second}
+				cfg.Controllers.EtcdDefragmentationCoordinator.MaxConcurrentDefragmentationsPerZone = ptr.To(0)
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.etcdDefragmentationCoordinator.syncPeriod"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.etcdDefragmentationCoordinator.slotDuration"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.etcdDefragmentationCoordinator.maxConcurrentDefragmentationsPerZone"),
+					})),
+				))
+			})
+		})
+
 		Context("network policy controller", func() {
 			BeforeEach(func() {
 				cfg.Controllers.NetworkPolicy = &config.NetworkPolicyControllerConfiguration{}
@@ -459,13 +631,23 @@ var _ = Describe("GardenletConfiguration", func() {
 				}))))
 			})
 
-			It("should forbid reserved without capacity", func() {
+			It("should allow reserved without configured capacity", func() {
 				cfg.Resources = &config.ResourcesConfiguration{
 					Reserved: corev1.ResourceList{
 						"foo": resource.MustParse("42"),
 					},
 				}
 
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(BeEmpty())
+			})
+
+			It("should forbid negative reserved quantities", func() {
+				cfg.Resources = &config.ResourcesConfiguration{
+					Reserved: corev1.ResourceList{
+						"foo": resource.MustParse("-1"),
+					},
+				}
+
 				errorList := ValidateGardenletConfiguration(cfg, nil, false)
 
 				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
@@ -632,6 +814,86 @@ var _ = Describe("GardenletConfiguration", func() {
 				)
 			})
 		})
+
+		Context("shootNamespaceConstraints", func() {
+			It("should pass with valid constraints", func() {
+				cfg.ShootNamespaceConstraints = &config.ShootNamespaceConstraints{
+					ResourceQuota: &corev1.ResourceQuotaSpec{
+						Hard: corev1.ResourceList{
+							corev1.ResourceRequestsCPU: resource.MustParse("10"),
+							corev1.ResourcePods:        resource.MustParse("100"),
+						},
+					},
+					LimitRange: &corev1.LimitRangeSpec{
+						Limits: []corev1.LimitRangeItem{{
+							Type:           corev1.LimitTypeContainer,
+							DefaultRequest: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("64Mi")},
+						}},
+					},
+					ResourceQuotaScaleFactors: map[string]int32{"S": 1, "XL": 4},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(BeEmpty())
+			})
+
+			It("should fail with negative quantities and invalid scale factors", func() {
+				cfg.ShootNamespaceConstraints = &config.ShootNamespaceConstraints{
+					ResourceQuota: &corev1.ResourceQuotaSpec{
+						Hard: corev1.ResourceList{corev1.ResourceRequestsCPU: resource.MustParse("-1")},
+					},
+					ResourceQuotaScaleFactors: map[string]int32{"M": 0},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("shootNamespaceConstraints.resourceQuota.hard[requests.cpu]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("shootNamespaceConstraints.resourceQuotaScaleFactors[M]"),
+					})),
+				))
+			})
+
+			It("should forbid scale factors without resource quota", func() {
+				cfg.ShootNamespaceConstraints = &config.ShootNamespaceConstraints{
+					ResourceQuotaScaleFactors: map[string]int32{"L": 2},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("shootNamespaceConstraints.resourceQuotaScaleFactors"),
+					})),
+				))
+			})
+		})
+
+		Context("imagePrePulling", func() {
+			It("should pass with a valid retention period", func() {
+				cfg.ImagePrePulling = &config.ImagePrePulling{
+					Enabled:         ptr.To(true),
+					RetentionPeriod: &metav1.Duration{Duration: 24 * time.Hour},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(BeEmpty())
+			})
+
+			It("should forbid a negative retention period", func() {
+				cfg.ImagePrePulling = &config.ImagePrePulling{
+					Enabled:         ptr.To(true),
+					RetentionPeriod: &metav1.Duration{Duration: -time.Hour},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("imagePrePulling.retentionPeriod"),
+					})),
+				))
+			})
+		})
 	})
 
 	Describe("#ValidateGardenletConfigurationUpdate", func() {
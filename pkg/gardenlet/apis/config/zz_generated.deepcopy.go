@@ -131,6 +131,27 @@ func (in *BastionControllerConfiguration) DeepCopy() *BastionControllerConfigura
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConditionRemediation) DeepCopyInto(out *ConditionRemediation) {
+	*out = *in
+	if in.MinInterval != nil {
+		in, out := &in.MinInterval, &out.MinInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConditionRemediation.
+func (in *ConditionRemediation) DeepCopy() *ConditionRemediation {
+	if in == nil {
+		return nil
+	}
+	out := new(ConditionRemediation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConditionThreshold) DeepCopyInto(out *ConditionThreshold) {
 	*out = *in
@@ -237,6 +258,68 @@ func (in *CustodianController) DeepCopy() *CustodianController {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSPropagationConfiguration) DeepCopyInto(out *DNSPropagationConfiguration) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Verification != nil {
+		in, out := &in.Verification, &out.Verification
+		*out = new(DNSPropagationVerification)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSPropagationConfiguration.
+func (in *DNSPropagationConfiguration) DeepCopy() *DNSPropagationConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSPropagationConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSPropagationVerification) DeepCopyInto(out *DNSPropagationVerification) {
+	*out = *in
+	if in.NameServers != nil {
+		in, out := &in.NameServers, &out.NameServers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.InitialBackoff != nil {
+		in, out := &in.InitialBackoff, &out.InitialBackoff
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxBackoff != nil {
+		in, out := &in.MaxBackoff, &out.MaxBackoff
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSPropagationVerification.
+func (in *DNSPropagationVerification) DeepCopy() *DNSPropagationVerification {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSPropagationVerification)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ETCDBackupLeaderElection) DeepCopyInto(out *ETCDBackupLeaderElection) {
 	*out = *in
@@ -332,6 +415,37 @@ func (in *ETCDController) DeepCopy() *ETCDController {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EtcdDefragmentationCoordinatorControllerConfiguration) DeepCopyInto(out *EtcdDefragmentationCoordinatorControllerConfiguration) {
+	*out = *in
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.SlotDuration != nil {
+		in, out := &in.SlotDuration, &out.SlotDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxConcurrentDefragmentationsPerZone != nil {
+		in, out := &in.MaxConcurrentDefragmentationsPerZone, &out.MaxConcurrentDefragmentationsPerZone
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EtcdDefragmentationCoordinatorControllerConfiguration.
+func (in *EtcdDefragmentationCoordinatorControllerConfiguration) DeepCopy() *EtcdDefragmentationCoordinatorControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(EtcdDefragmentationCoordinatorControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExposureClassHandler) DeepCopyInto(out *ExposureClassHandler) {
 	*out = *in
@@ -501,6 +615,16 @@ func (in *GardenletConfiguration) DeepCopyInto(out *GardenletConfiguration) {
 		*out = new(NodeToleration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ShootNamespaceConstraints != nil {
+		in, out := &in.ShootNamespaceConstraints, &out.ShootNamespaceConstraints
+		*out = new(ShootNamespaceConstraints)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ImagePrePulling != nil {
+		in, out := &in.ImagePrePulling, &out.ImagePrePulling
+		*out = new(ImagePrePulling)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -585,6 +709,11 @@ func (in *GardenletControllerConfiguration) DeepCopyInto(out *GardenletControlle
 		*out = new(ShootStateControllerConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ShootHibernationCleanup != nil {
+		in, out := &in.ShootHibernationCleanup, &out.ShootHibernationCleanup
+		*out = new(ShootHibernationCleanupControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.NetworkPolicy != nil {
 		in, out := &in.NetworkPolicy, &out.NetworkPolicy
 		*out = new(NetworkPolicyControllerConfiguration)
@@ -610,6 +739,11 @@ func (in *GardenletControllerConfiguration) DeepCopyInto(out *GardenletControlle
 		*out = new(VPAEvictionRequirementsControllerConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.EtcdDefragmentationCoordinator != nil {
+		in, out := &in.EtcdDefragmentationCoordinator, &out.EtcdDefragmentationCoordinator
+		*out = new(EtcdDefragmentationCoordinatorControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -644,6 +778,32 @@ func (in *GardenletObjectControllerConfiguration) DeepCopy() *GardenletObjectCon
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImagePrePulling) DeepCopyInto(out *ImagePrePulling) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RetentionPeriod != nil {
+		in, out := &in.RetentionPeriod, &out.RetentionPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImagePrePulling.
+func (in *ImagePrePulling) DeepCopy() *ImagePrePulling {
+	if in == nil {
+		return nil
+	}
+	out := new(ImagePrePulling)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubeconfigValidity) DeepCopyInto(out *KubeconfigValidity) {
 	*out = *in
@@ -762,6 +922,11 @@ func (in *ManagedSeedControllerConfiguration) DeepCopyInto(out *ManagedSeedContr
 		*out = new(bool)
 		**out = **in
 	}
+	if in.PreflightChecks != nil {
+		in, out := &in.PreflightChecks, &out.PreflightChecks
+		*out = new(ManagedSeedPreflightChecksConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -775,6 +940,29 @@ func (in *ManagedSeedControllerConfiguration) DeepCopy() *ManagedSeedControllerC
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedSeedPreflightChecksConfiguration) DeepCopyInto(out *ManagedSeedPreflightChecksConfiguration) {
+	*out = *in
+	if in.MinimumAllocatable != nil {
+		in, out := &in.MinimumAllocatable, &out.MinimumAllocatable
+		*out = make(corev1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedSeedPreflightChecksConfiguration.
+func (in *ManagedSeedPreflightChecksConfiguration) DeepCopy() *ManagedSeedPreflightChecksConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedSeedPreflightChecksConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MonitoringConfig) DeepCopyInto(out *MonitoringConfig) {
 	*out = *in
@@ -1126,6 +1314,13 @@ func (in *ShootCareControllerConfiguration) DeepCopyInto(out *ShootCareControlle
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Remediations != nil {
+		in, out := &in.Remediations, &out.Remediations
+		*out = make([]ConditionRemediation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1194,6 +1389,18 @@ func (in *ShootControllerConfiguration) DeepCopyInto(out *ShootControllerConfigu
 		*out = new(int64)
 		**out = **in
 	}
+	if in.DNSPropagation != nil {
+		in, out := &in.DNSPropagation, &out.DNSPropagation
+		*out = make([]DNSPropagationConfiguration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ForceDeletionExtensionGracePeriod != nil {
+		in, out := &in.ForceDeletionExtensionGracePeriod, &out.ForceDeletionExtensionGracePeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -1228,6 +1435,37 @@ func (in *ShootEventLogging) DeepCopy() *ShootEventLogging {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootHibernationCleanupControllerConfiguration) DeepCopyInto(out *ShootHibernationCleanupControllerConfiguration) {
+	*out = *in
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(int)
+		**out = **in
+	}
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MinimumHibernationDuration != nil {
+		in, out := &in.MinimumHibernationDuration, &out.MinimumHibernationDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootHibernationCleanupControllerConfiguration.
+func (in *ShootHibernationCleanupControllerConfiguration) DeepCopy() *ShootHibernationCleanupControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootHibernationCleanupControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootMonitoringConfig) DeepCopyInto(out *ShootMonitoringConfig) {
 	*out = *in
@@ -1261,6 +1499,39 @@ func (in *ShootMonitoringConfig) DeepCopy() *ShootMonitoringConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootNamespaceConstraints) DeepCopyInto(out *ShootNamespaceConstraints) {
+	*out = *in
+	if in.ResourceQuota != nil {
+		in, out := &in.ResourceQuota, &out.ResourceQuota
+		*out = new(corev1.ResourceQuotaSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LimitRange != nil {
+		in, out := &in.LimitRange, &out.LimitRange
+		*out = new(corev1.LimitRangeSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResourceQuotaScaleFactors != nil {
+		in, out := &in.ResourceQuotaScaleFactors, &out.ResourceQuotaScaleFactors
+		*out = make(map[string]int32, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootNamespaceConstraints.
+func (in *ShootNamespaceConstraints) DeepCopy() *ShootNamespaceConstraints {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootNamespaceConstraints)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ShootNodeLogging) DeepCopyInto(out *ShootNodeLogging) {
 	*out = *in
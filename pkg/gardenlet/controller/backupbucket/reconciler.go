@@ -137,6 +137,7 @@ func (r *Reconciler) reconcileBackupBucket(
 				Name:      extensionSecret.Name,
 				Namespace: extensionSecret.Namespace,
 			},
+			Immutability: extensionBackupBucketImmutability(backupBucket.Spec.Immutability),
 		}
 	)
 
@@ -487,3 +488,14 @@ func generateBackupBucketSecretName(backupBucketName string) string {
 func generateGeneratedBackupBucketSecretName(backupBucketName string) string {
 	return v1beta1constants.SecretPrefixGeneratedBackupBucket + backupBucketName
 }
+
+func extensionBackupBucketImmutability(immutability *gardencorev1beta1.BackupBucketImmutability) *extensionsv1alpha1.BackupBucketImmutability {
+	if immutability == nil {
+		return nil
+	}
+
+	return &extensionsv1alpha1.BackupBucketImmutability{
+		RetentionMode:   extensionsv1alpha1.BackupBucketRetentionMode(immutability.RetentionMode),
+		RetentionPeriod: immutability.RetentionPeriod,
+	}
+}
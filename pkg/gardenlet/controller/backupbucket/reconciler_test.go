@@ -186,6 +186,28 @@ var _ = Describe("Controller", func() {
 		}))
 	})
 
+	It("should pass the immutability configuration to the extension BackupBucket", func() {
+		backupBucket.Spec.Immutability = &gardencorev1beta1.BackupBucketImmutability{
+			RetentionMode:   gardencorev1beta1.BackupBucketRetentionModeCompliance,
+			RetentionPeriod: metav1.Duration{Duration: 7 * 24 * time.Hour},
+		}
+		Expect(gardenClient.Update(ctx, backupBucket)).To(Succeed())
+
+		Expect(seedClient.Create(ctx, extensionSecret)).To(Succeed())
+		Expect(seedClient.Create(ctx, extensionBackupBucket)).To(Succeed())
+
+		result, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(reconcile.Result{}))
+
+		Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(extensionBackupBucket), extensionBackupBucket)).To(Succeed())
+		Expect(extensionBackupBucket.Annotations).To(HaveKeyWithValue(v1beta1constants.GardenerOperation, v1beta1constants.GardenerOperationReconcile))
+		Expect(extensionBackupBucket.Spec.Immutability).To(Equal(&extensionsv1alpha1.BackupBucketImmutability{
+			RetentionMode:   extensionsv1alpha1.BackupBucketRetentionModeCompliance,
+			RetentionPeriod: metav1.Duration{Duration: 7 * 24 * time.Hour},
+		}))
+	})
+
 	It("should not reconcile the extension BackupBucket if the secret data or extension spec hasn't changed", func() {
 		Expect(seedClient.Create(ctx, extensionSecret)).To(Succeed())
 		Expect(seedClient.Create(ctx, extensionBackupBucket)).To(Succeed())
@@ -109,6 +109,13 @@ func (r *Reconciler) reconcileBastion(
 		}
 	}
 
+	var extensionPorts []int32
+	for _, port := range bastion.Spec.Ports {
+		if port.Port != nil {
+			extensionPorts = append(extensionPorts, *port.Port)
+		}
+	}
+
 	var (
 		mustReconcileExtensionBastion = false
 		lastObservedError             error
@@ -118,6 +125,7 @@ func (r *Reconciler) reconcileBastion(
 			},
 			UserData: createUserData(bastion),
 			Ingress:  extensionIngress,
+			Ports:    extensionPorts,
 		}
 	)
 
@@ -68,6 +68,7 @@ func (r *Reconciler) AddToManager(ctx context.Context, mgr manager.Manager, gard
 		object            client.Object
 		newObjectListFunc func() client.ObjectList
 	}{
+		{extensionsv1alpha1.AuditSinkResource, &extensionsv1alpha1.AuditSink{}, func() client.ObjectList { return &extensionsv1alpha1.AuditSinkList{} }},
 		{extensionsv1alpha1.BackupBucketResource, &extensionsv1alpha1.BackupBucket{}, func() client.ObjectList { return &extensionsv1alpha1.BackupBucketList{} }},
 		{extensionsv1alpha1.BackupEntryResource, &extensionsv1alpha1.BackupEntry{}, func() client.ObjectList { return &extensionsv1alpha1.BackupEntryList{} }},
 		{extensionsv1alpha1.BastionResource, &extensionsv1alpha1.Bastion{}, func() client.ObjectList { return &extensionsv1alpha1.BastionList{} }},
@@ -117,7 +117,12 @@ func (r *Reconciler) deployGardenlet(
 		return fmt.Errorf("failed preparing gardenlet chart values: %w", err)
 	}
 
-	archive, err := r.HelmRegistry.Pull(ctx, &gardenlet.Spec.Deployment.Helm.OCIRepository)
+	pullOpts, err := gardenletdeployer.HelmChartPullOptions(ctx, r.GardenClient, gardenlet)
+	if err != nil {
+		return fmt.Errorf("failed computing options for pulling Helm chart: %w", err)
+	}
+
+	archive, err := r.HelmRegistry.Pull(ctx, &gardenlet.Spec.Deployment.Helm.OCIRepository, pullOpts...)
 	if err != nil {
 		return fmt.Errorf("failed pulling Helm chart from OCI repository: %w", err)
 	}
@@ -175,7 +180,7 @@ func (r *Reconciler) prepareGardenletChartValues(
 		values["componentImageVectorOverwrites"] = *imageVector
 	}
 
-	return values, nil
+	return gardenletdeployer.ApplyRegistrySettingsToChartValues(values, gardenlet.Spec.Deployment.Registry)
 }
 
 func updateCondition(clock clock.Clock, status *seedmanagementv1alpha1.GardenletStatus, conditionStatus gardencorev1beta1.ConditionStatus, reason, message string) {
@@ -0,0 +1,190 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package managedseed
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	"github.com/gardener/gardener/pkg/utils/validation/kubernetesversion"
+)
+
+const (
+	defaultNodeCIDRMaskSizeV4 = 24
+	defaultNodeCIDRMaskSizeV6 = 64
+)
+
+// PreflightChecker checks whether the shoot of a ManagedSeed meets the requirements of a seed before gardenlet is
+// deployed into it.
+type PreflightChecker struct {
+	// GardenClient is used to read the ControllerRegistrations.
+	GardenClient client.Reader
+	// GetShootClientFunc returns a client for the shoot. It is only called if the resources of the shoot are checked.
+	GetShootClientFunc func(context.Context) (client.Client, error)
+	// Config is the configuration of the preflight checks.
+	Config *config.ManagedSeedPreflightChecksConfiguration
+}
+
+// Check performs the preflight checks for the given shoot and seed template and returns a description for each failed
+// check. The required extensions are only checked if a seed template is given. An error is returned if the checks
+// could not be performed.
+func (p *PreflightChecker) Check(ctx context.Context, shoot *gardencorev1beta1.Shoot, seedTemplate *gardencorev1beta1.SeedTemplate) ([]string, error) {
+	var failures []string
+
+	if err := kubernetesversion.CheckIfSupported(shoot.Spec.Kubernetes.Version); err != nil {
+		failures = append(failures, fmt.Sprintf("Kubernetes version of shoot is not supported for seeds: %v", err))
+	}
+
+	if failure := checkPodsCIDR(shoot); failure != "" {
+		failures = append(failures, failure)
+	}
+
+	if seedTemplate != nil {
+		failure, err := p.checkRequiredExtensions(ctx, seedTemplate)
+		if err != nil {
+			return nil, err
+		}
+		if failure != "" {
+			failures = append(failures, failure)
+		}
+	}
+
+	if v1beta1helper.HibernationIsEnabled(shoot) {
+		failures = append(failures, "shoot is hibernated")
+	} else if p.Config != nil && len(p.Config.MinimumAllocatable) > 0 {
+		failure, err := p.checkAllocatableResources(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if failure != "" {
+			failures = append(failures, failure)
+		}
+	}
+
+	return failures, nil
+}
+
+// checkPodsCIDR checks that the pods CIDR of the shoot provides enough node CIDRs for the maximum number of nodes of
+// all worker pools.
+func checkPodsCIDR(shoot *gardencorev1beta1.Shoot) string {
+	if shoot.Spec.Networking == nil || shoot.Spec.Networking.Pods == nil || shoot.Spec.Provider.Workers == nil {
+		return ""
+	}
+
+	_, podsCIDR, err := net.ParseCIDR(*shoot.Spec.Networking.Pods)
+	if err != nil {
+		return fmt.Sprintf("pods CIDR %q of shoot cannot be parsed: %v", *shoot.Spec.Networking.Pods, err)
+	}
+
+	podsPrefixLength, addressLength := podsCIDR.Mask.Size()
+	nodeCIDRMaskSize := int32(defaultNodeCIDRMaskSizeV4)
+	if addressLength == net.IPv6len*8 {
+		nodeCIDRMaskSize = defaultNodeCIDRMaskSizeV6
+	}
+	if kcm := shoot.Spec.Kubernetes.KubeControllerManager; kcm != nil && kcm.NodeCIDRMaskSize != nil {
+		nodeCIDRMaskSize = *kcm.NodeCIDRMaskSize
+	}
+
+	var maxNodes int64
+	for _, worker := range shoot.Spec.Provider.Workers {
+		maxNodes += int64(worker.Maximum)
+	}
+
+	// With more than 62 bits difference, the number of node CIDRs exceeds any possible number of nodes.
+	sizeDifference := int(nodeCIDRMaskSize) - podsPrefixLength
+	if sizeDifference < 0 || sizeDifference > 62 {
+		return ""
+	}
+
+	if nodeCIDRs := int64(1) << sizeDifference; nodeCIDRs < maxNodes {
+		return fmt.Sprintf("pods CIDR %s of shoot only provides %d node CIDRs of size /%d, but the worker pools can scale up to %d nodes", podsCIDR, nodeCIDRs, nodeCIDRMaskSize, maxNodes)
+	}
+
+	return ""
+}
+
+// checkRequiredExtensions checks that ControllerRegistrations exist for all extensions required by the seed.
+func (p *PreflightChecker) checkRequiredExtensions(ctx context.Context, seedTemplate *gardencorev1beta1.SeedTemplate) (string, error) {
+	requiredExtensions := gardenerutils.ComputeRequiredExtensionsForSeed(&gardencorev1beta1.Seed{Spec: seedTemplate.Spec})
+	if seedTemplate.Spec.Backup != nil {
+		requiredExtensions.Insert(gardenerutils.ExtensionsID(extensionsv1alpha1.BackupBucketResource, seedTemplate.Spec.Backup.Provider))
+	}
+
+	controllerRegistrationList := &gardencorev1beta1.ControllerRegistrationList{}
+	if err := p.GardenClient.List(ctx, controllerRegistrationList); err != nil {
+		return "", fmt.Errorf("failed listing ControllerRegistrations: %w", err)
+	}
+
+	for _, kindType := range requiredExtensions.UnsortedList() {
+		extensionKind, extensionType, _ := strings.Cut(kindType, "/")
+		if slices.ContainsFunc(controllerRegistrationList.Items, func(controllerRegistration gardencorev1beta1.ControllerRegistration) bool {
+			return v1beta1helper.IsResourceSupported(controllerRegistration.Spec.Resources, extensionKind, extensionType)
+		}) {
+			requiredExtensions.Delete(kindType)
+		}
+	}
+
+	if len(requiredExtensions) == 0 {
+		return "", nil
+	}
+
+	missing := requiredExtensions.UnsortedList()
+	slices.Sort(missing)
+	return "no ControllerRegistration found for required extensions " + strings.Join(missing, ", "), nil
+}
+
+// checkAllocatableResources checks that the ready nodes of the shoot provide at least the configured amount of
+// allocatable resources in total.
+func (p *PreflightChecker) checkAllocatableResources(ctx context.Context) (string, error) {
+	shootClient, err := p.GetShootClientFunc(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed getting shoot client: %w", err)
+	}
+
+	nodeList := &corev1.NodeList{}
+	if err := shootClient.List(ctx, nodeList); err != nil {
+		return "", fmt.Errorf("failed listing nodes of shoot: %w", err)
+	}
+
+	allocatable := corev1.ResourceList{}
+	for _, node := range nodeList.Items {
+		if !slices.ContainsFunc(node.Status.Conditions, func(condition corev1.NodeCondition) bool {
+			return condition.Type == corev1.NodeReady && condition.Status == corev1.ConditionTrue
+		}) {
+			continue
+		}
+
+		for name, quantity := range node.Status.Allocatable {
+			sum := allocatable[name]
+			sum.Add(quantity)
+			allocatable[name] = sum
+		}
+	}
+
+	var insufficient []string
+	for name, minimum := range p.Config.MinimumAllocatable {
+		if available := allocatable[name]; available.Cmp(minimum) < 0 {
+			insufficient = append(insufficient, fmt.Sprintf("%s (%s/%s)", name, available.String(), minimum.String()))
+		}
+	}
+
+	if len(insufficient) == 0 {
+		return "", nil
+	}
+
+	slices.Sort(insufficient)
+	return "ready nodes of shoot provide insufficient allocatable resources: " + strings.Join(insufficient, ", "), nil
+}
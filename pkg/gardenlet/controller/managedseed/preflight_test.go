@@ -0,0 +1,170 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package managedseed_test
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/managedseed"
+)
+
+var _ = Describe("PreflightChecker", func() {
+	var (
+		ctx = context.Background()
+
+		gardenClient client.Client
+		shootClient  client.Client
+		checker      *PreflightChecker
+
+		shoot        *gardencorev1beta1.Shoot
+		seedTemplate *gardencorev1beta1.SeedTemplate
+	)
+
+	BeforeEach(func() {
+		gardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+		shootClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).Build()
+		checker = &PreflightChecker{
+			GardenClient:       gardenClient,
+			GetShootClientFunc: func(context.Context) (client.Client, error) { return shootClient, nil },
+		}
+
+		shoot = &gardencorev1beta1.Shoot{
+			Spec: gardencorev1beta1.ShootSpec{
+				Kubernetes: gardencorev1beta1.Kubernetes{Version: "1.31.1"},
+				Networking: &gardencorev1beta1.Networking{Pods: ptr.To("100.96.0.0/11")},
+				Provider: gardencorev1beta1.Provider{
+					Workers: []gardencorev1beta1.Worker{{Name: "worker", Maximum: 10}},
+				},
+			},
+		}
+		seedTemplate = &gardencorev1beta1.SeedTemplate{
+			Spec: gardencorev1beta1.SeedSpec{
+				Provider: gardencorev1beta1.SeedProvider{Type: "local"},
+				Backup:   &gardencorev1beta1.SeedBackup{Provider: "local"},
+			},
+		}
+
+		Expect(gardenClient.Create(ctx, &gardencorev1beta1.ControllerRegistration{
+			ObjectMeta: metav1.ObjectMeta{Name: "provider-local"},
+			Spec: gardencorev1beta1.ControllerRegistrationSpec{Resources: []gardencorev1beta1.ControllerResource{
+				{Kind: "BackupBucket", Type: "local"},
+				{Kind: "ControlPlane", Type: "local"},
+				{Kind: "Infrastructure", Type: "local"},
+				{Kind: "Worker", Type: "Local"},
+			}},
+		})).To(Succeed())
+	})
+
+	It("should succeed if all checks pass", func() {
+		Expect(checker.Check(ctx, shoot, seedTemplate)).To(BeEmpty())
+	})
+
+	It("should fail if the Kubernetes version is not supported", func() {
+		shoot.Spec.Kubernetes.Version = "1.10.0"
+
+		Expect(checker.Check(ctx, shoot, seedTemplate)).To(ConsistOf(ContainSubstring(`unsupported kubernetes version "1.10.0"`)))
+	})
+
+	It("should fail if the pods CIDR is too small for the maximum number of nodes", func() {
+		shoot.Spec.Networking.Pods = ptr.To("100.96.0.0/22")
+
+		Expect(checker.Check(ctx, shoot, seedTemplate)).To(ConsistOf(
+			"pods CIDR 100.96.0.0/22 of shoot only provides 4 node CIDRs of size /24, but the worker pools can scale up to 10 nodes",
+		))
+	})
+
+	It("should consider the configured node CIDR mask size", func() {
+		shoot.Spec.Networking.Pods = ptr.To("100.96.0.0/22")
+		shoot.Spec.Kubernetes.KubeControllerManager = &gardencorev1beta1.KubeControllerManagerConfig{NodeCIDRMaskSize: ptr.To[int32](26)}
+
+		Expect(checker.Check(ctx, shoot, seedTemplate)).To(BeEmpty())
+	})
+
+	It("should fail if required extensions are not registered", func() {
+		seedTemplate.Spec.DNS.Provider = &gardencorev1beta1.SeedDNSProvider{Type: "aws-route53"}
+		seedTemplate.Spec.Backup.Provider = "gcp"
+
+		Expect(checker.Check(ctx, shoot, seedTemplate)).To(ConsistOf(
+			"no ControllerRegistration found for required extensions BackupBucket/gcp, DNSRecord/aws-route53",
+		))
+	})
+
+	It("should not check the required extensions if no seed template is given", func() {
+		seedTemplate.Spec.Backup.Provider = "gcp"
+
+		Expect(checker.Check(ctx, shoot, nil)).To(BeEmpty())
+	})
+
+	It("should fail if the shoot is hibernated", func() {
+		shoot.Spec.Hibernation = &gardencorev1beta1.Hibernation{Enabled: ptr.To(true)}
+
+		Expect(checker.Check(ctx, shoot, seedTemplate)).To(ConsistOf("shoot is hibernated"))
+	})
+
+	Context("allocatable resources", func() {
+		createNode := func(name string, ready bool, cpu, memory string) {
+			status := corev1.ConditionFalse
+			if ready {
+				status = corev1.ConditionTrue
+			}
+
+			ExpectWithOffset(1, shootClient.Create(ctx, &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{Name: name},
+				Status: corev1.NodeStatus{
+					Allocatable: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse(cpu),
+						corev1.ResourceMemory: resource.MustParse(memory),
+					},
+					Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: status}},
+				},
+			})).To(Succeed())
+		}
+
+		BeforeEach(func() {
+			checker.Config = &config.ManagedSeedPreflightChecksConfiguration{
+				MinimumAllocatable: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("4"),
+					corev1.ResourceMemory: resource.MustParse("16Gi"),
+				},
+			}
+		})
+
+		It("should succeed if the ready nodes provide enough resources", func() {
+			createNode("node-a", true, "2", "8Gi")
+			createNode("node-b", true, "2", "8Gi")
+
+			Expect(checker.Check(ctx, shoot, seedTemplate)).To(BeEmpty())
+		})
+
+		It("should fail if the ready nodes do not provide enough resources", func() {
+			createNode("node-a", true, "2", "8Gi")
+			createNode("node-b", false, "2", "8Gi")
+
+			Expect(checker.Check(ctx, shoot, seedTemplate)).To(ConsistOf(
+				"ready nodes of shoot provide insufficient allocatable resources: cpu (2/4), memory (8Gi/16Gi)",
+			))
+		})
+
+		It("should return an error if the shoot client cannot be created", func() {
+			checker.GetShootClientFunc = func(context.Context) (client.Client, error) { return nil, fmt.Errorf("fake") }
+
+			_, err := checker.Check(ctx, shoot, seedTemplate)
+			Expect(err).To(MatchError(ContainSubstring("fake")))
+		})
+	})
+})
@@ -7,6 +7,7 @@ package managedseed
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/go-logr/logr"
 	appsv1 "k8s.io/api/apps/v1"
@@ -37,6 +38,11 @@ import (
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 )
 
+const (
+	reasonPreflightChecksPassed = "PreflightChecksPassed"
+	reasonPreflightChecksFailed = "PreflightChecksFailed"
+)
+
 // Reconciler reconciles the ManagedSeed.
 type Reconciler struct {
 	GardenConfig          *rest.Config
@@ -188,6 +194,29 @@ func (r *Reconciler) reconcile(
 	updateCondition(r.Clock, status, seedmanagementv1alpha1.ManagedSeedShootReconciled, gardencorev1beta1.ConditionTrue, gardencorev1beta1.EventReconciled,
 		fmt.Sprintf("Shoot %q has been reconciled", client.ObjectKeyFromObject(shoot).String()))
 
+	// Check if shoot meets the requirements of a seed and update PreflightChecksPassed condition. The checks are only
+	// performed as long as the seed has not been registered, so that they do not block updates of existing seeds.
+	if seedRegistered := v1beta1helper.GetCondition(status.Conditions, seedmanagementv1alpha1.SeedRegistered); seedRegistered == nil || seedRegistered.Status != gardencorev1beta1.ConditionTrue {
+		failures, err := r.newPreflightChecker(shoot).Check(ctx, shoot, seedTemplateOrNil(ms))
+		if err != nil {
+			if updateErr := r.updateStatus(ctx, ms, status); updateErr != nil {
+				log.Error(updateErr, "Could not update status", "status", status)
+			}
+			return reconcile.Result{}, fmt.Errorf("could not perform preflight checks: %w", err)
+		}
+
+		if len(failures) > 0 {
+			log.Info("Preflight checks failed", "failures", failures)
+
+			msg := "Preflight checks failed: " + strings.Join(failures, "; ")
+			r.Recorder.Event(ms, corev1.EventTypeWarning, reasonPreflightChecksFailed, msg)
+			updateCondition(r.Clock, status, seedmanagementv1alpha1.ManagedSeedPreflightChecksPassed, gardencorev1beta1.ConditionFalse, reasonPreflightChecksFailed, msg)
+
+			return reconcile.Result{RequeueAfter: r.Config.Controllers.ManagedSeed.WaitSyncPeriod.Duration}, r.updateStatus(ctx, ms, status)
+		}
+		updateCondition(r.Clock, status, seedmanagementv1alpha1.ManagedSeedPreflightChecksPassed, gardencorev1beta1.ConditionTrue, reasonPreflightChecksPassed, "All preflight checks passed")
+	}
+
 	// Reconcile creation or update
 	log.V(1).Info("Reconciling")
 	status.Conditions, err = actuator.Reconcile(ctx, log, ms, status.Conditions, ms.Spec.Gardenlet.Deployment, &ms.Spec.Gardenlet.Config, helper.GetBootstrap(ms.Spec.Gardenlet.Bootstrap), ptr.Deref(ms.Spec.Gardenlet.MergeWithParent, false))
@@ -263,6 +292,30 @@ func (r *Reconciler) delete(
 	return reconcile.Result{RequeueAfter: r.Config.Controllers.ManagedSeed.SyncPeriod.Duration}, nil
 }
 
+func (r *Reconciler) newPreflightChecker(shoot *gardencorev1beta1.Shoot) *PreflightChecker {
+	return &PreflightChecker{
+		GardenClient: r.GardenClient,
+		GetShootClientFunc: func(ctx context.Context) (client.Client, error) {
+			shootClient, err := r.ShootClientMap.GetClient(ctx, keys.ForShoot(shoot))
+			if err != nil {
+				return nil, err
+			}
+			return shootClient.Client(), nil
+		},
+		Config: r.Config.Controllers.ManagedSeed.PreflightChecks,
+	}
+}
+
+// seedTemplateOrNil returns the seed template of the given ManagedSeed or nil if it cannot be extracted. In the latter
+// case, the error is reported when deploying gardenlet.
+func seedTemplateOrNil(ms *seedmanagementv1alpha1.ManagedSeed) *gardencorev1beta1.SeedTemplate {
+	seedTemplate, _, err := helper.ExtractSeedTemplateAndGardenletConfig(ms.Name, &ms.Spec.Gardenlet.Config)
+	if err != nil {
+		return nil
+	}
+	return seedTemplate
+}
+
 func shootReconciled(shoot *gardencorev1beta1.Shoot) bool {
 	lastOp := shoot.Status.LastOperation
 	return shoot.Generation == shoot.Status.ObservedGeneration && lastOp != nil && lastOp.State == gardencorev1beta1.LastOperationStateSucceeded
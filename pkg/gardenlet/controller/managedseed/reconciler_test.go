@@ -11,6 +11,7 @@ import (
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
 	"go.uber.org/mock/gomock"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -52,8 +53,9 @@ var _ = Describe("Reconciler", func() {
 		ctx     context.Context
 		request reconcile.Request
 
-		managedSeed *seedmanagementv1alpha1.ManagedSeed
-		status      *seedmanagementv1alpha1.ManagedSeedStatus
+		managedSeed            *seedmanagementv1alpha1.ManagedSeed
+		status                 *seedmanagementv1alpha1.ManagedSeedStatus
+		shootKubernetesVersion string
 	)
 
 	BeforeEach(func() {
@@ -104,6 +106,7 @@ var _ = Describe("Reconciler", func() {
 		status = &seedmanagementv1alpha1.ManagedSeedStatus{
 			ObservedGeneration: 1,
 		}
+		shootKubernetesVersion = "1.31.1"
 	})
 
 	AfterEach(func() {
@@ -126,6 +129,9 @@ var _ = Describe("Reconciler", func() {
 						ObjectMeta: metav1.ObjectMeta{
 							Generation: 1,
 						},
+						Spec: gardencorev1beta1.ShootSpec{
+							Kubernetes: gardencorev1beta1.Kubernetes{Version: shootKubernetesVersion},
+						},
 						Status: gardencorev1beta1.ShootStatus{
 							LastOperation: &gardencorev1beta1.LastOperation{
 								State: gardencorev1beta1.LastOperationStateSucceeded,
@@ -167,6 +173,13 @@ var _ = Describe("Reconciler", func() {
 					LastUpdateTime:     metav1.Time{Time: fakeClock.Now()},
 					Reason:             gardencorev1beta1.EventReconciled,
 					Message:            `Shoot "/" has been reconciled`,
+				}, {
+					Type:               seedmanagementv1alpha1.ManagedSeedPreflightChecksPassed,
+					Status:             gardencorev1beta1.ConditionTrue,
+					LastTransitionTime: metav1.Time{Time: fakeClock.Now()},
+					LastUpdateTime:     metav1.Time{Time: fakeClock.Now()},
+					Reason:             "PreflightChecksPassed",
+					Message:            "All preflight checks passed",
 				}}
 			})
 
@@ -199,6 +212,44 @@ var _ = Describe("Reconciler", func() {
 				Expect(err).ToNot(HaveOccurred())
 				Expect(result).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
 			})
+
+			It("should not deploy gardenlet if the preflight checks fail", func() {
+				shootKubernetesVersion = "1.10.0"
+				expectGetManagedSeed()
+				expectGetShoot()
+				managedSeed.Finalizers = []string{gardencorev1beta1.GardenerName}
+				expectPatchManagedSeedStatus(func(ms *seedmanagementv1alpha1.ManagedSeed) {
+					Expect(ms.Status.Conditions).To(ContainElement(MatchFields(IgnoreExtras, Fields{
+						"Type":    Equal(seedmanagementv1alpha1.ManagedSeedPreflightChecksPassed),
+						"Status":  Equal(gardencorev1beta1.ConditionFalse),
+						"Reason":  Equal("PreflightChecksFailed"),
+						"Message": ContainSubstring(`unsupported kubernetes version "1.10.0"`),
+					})))
+				})
+
+				result, err := reconciler.Reconcile(ctx, request)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result).To(Equal(reconcile.Result{RequeueAfter: waitSyncPeriod}))
+			})
+
+			It("should not perform the preflight checks if the seed is already registered", func() {
+				shootKubernetesVersion = "1.10.0"
+				managedSeed.Status.Conditions = append(managedSeed.Status.Conditions, gardencorev1beta1.Condition{
+					Type:   seedmanagementv1alpha1.SeedRegistered,
+					Status: gardencorev1beta1.ConditionTrue,
+				})
+				expectGetManagedSeed()
+				expectGetShoot()
+				managedSeed.Finalizers = []string{gardencorev1beta1.GardenerName}
+				actuator.EXPECT().Reconcile(gomock.Any(), gomock.AssignableToTypeOf(logr.Logger{}), managedSeed, managedSeed.Status.Conditions, managedSeed.Spec.Gardenlet.Deployment, &runtime.RawExtension{}, seedmanagementv1alpha1.BootstrapNone, false).Return(nil, nil)
+				expectPatchManagedSeedStatus(func(ms *seedmanagementv1alpha1.ManagedSeed) {
+					Expect(&ms.Status).To(Equal(status))
+				})
+
+				result, err := reconciler.Reconcile(ctx, request)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+			})
 		})
 
 		Context("delete", func() {
@@ -0,0 +1,164 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package care
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/extensions"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+// controlPlaneExtensionKindToObjectList returns the lists of all namespaced extension resources which can be part of a
+// shoot control plane.
+func controlPlaneExtensionKindToObjectList() map[string]client.ObjectList {
+	return map[string]client.ObjectList{
+		extensionsv1alpha1.AuditSinkResource:             &extensionsv1alpha1.AuditSinkList{},
+		extensionsv1alpha1.BastionResource:               &extensionsv1alpha1.BastionList{},
+		extensionsv1alpha1.ContainerRuntimeResource:      &extensionsv1alpha1.ContainerRuntimeList{},
+		extensionsv1alpha1.ControlPlaneResource:          &extensionsv1alpha1.ControlPlaneList{},
+		extensionsv1alpha1.DNSRecordResource:             &extensionsv1alpha1.DNSRecordList{},
+		extensionsv1alpha1.ExtensionResource:             &extensionsv1alpha1.ExtensionList{},
+		extensionsv1alpha1.InfrastructureResource:        &extensionsv1alpha1.InfrastructureList{},
+		extensionsv1alpha1.NetworkResource:               &extensionsv1alpha1.NetworkList{},
+		extensionsv1alpha1.OperatingSystemConfigResource: &extensionsv1alpha1.OperatingSystemConfigList{},
+		extensionsv1alpha1.WorkerResource:                &extensionsv1alpha1.WorkerList{},
+	}
+}
+
+// checkControlPlaneOwnership detects shoot control plane namespaces in the seed cluster which do no longer belong to a
+// shoot, e.g., because a shoot deletion or migration failed half-way. Such namespaces (together with the extension
+// resources in them) are reported in the 'orphaned-control-planes' ConfigMap in the garden namespace of the seed
+// cluster. If a namespace is annotated with 'confirmation.gardener.cloud/deletion=true', its extension resources are
+// deleted first so that the extensions can clean up the infrastructure, and the namespace itself is deleted afterwards.
+// The ConfigMap only exists as long as orphaned control planes are detected to not clutter the seed cluster.
+func (r *Reconciler) checkControlPlaneOwnership(ctx context.Context, log logr.Logger) error {
+	// The garden cache only contains shoots which are related to this seed (either via .spec.seedName or
+	// .status.seedName), hence a simple list is sufficient.
+	shootList := &gardencorev1beta1.ShootList{}
+	if err := r.GardenClient.List(ctx, shootList); err != nil {
+		return fmt.Errorf("failed listing shoots: %w", err)
+	}
+
+	technicalIDs := sets.New[string]()
+	for _, shoot := range shootList.Items {
+		if shoot.Status.TechnicalID != "" {
+			technicalIDs.Insert(shoot.Status.TechnicalID)
+		}
+	}
+
+	namespaceList := &corev1.NamespaceList{}
+	if err := r.SeedClient.List(ctx, namespaceList, client.MatchingLabels{v1beta1constants.GardenRole: v1beta1constants.GardenRoleShoot}); err != nil {
+		return fmt.Errorf("failed listing shoot namespaces: %w", err)
+	}
+
+	orphanedControlPlanes := map[string]string{}
+
+	for _, namespace := range namespaceList.Items {
+		if namespace.DeletionTimestamp != nil || technicalIDs.Has(namespace.Name) {
+			continue
+		}
+
+		kindToObjectList, extensionResources, err := r.listExtensionResources(ctx, namespace.Name)
+		if err != nil {
+			return err
+		}
+
+		if gardenerutils.CheckIfDeletionIsConfirmed(&namespace) != nil {
+			orphanedControlPlanes[namespace.Name] = "Extension resources: " + extensionResourcesInfo(extensionResources)
+			continue
+		}
+
+		if len(extensionResources) > 0 {
+			log.Info("Deleting extension resources of orphaned control plane since its deletion was confirmed", "namespace", namespace.Name)
+			for _, objectList := range kindToObjectList {
+				if err := extensions.DeleteExtensionObjects(ctx, r.SeedClient, objectList, namespace.Name, nil); err != nil {
+					return fmt.Errorf("failed deleting extension resources in namespace %s: %w", namespace.Name, err)
+				}
+			}
+			// The namespace is deleted once all extension resources are gone, i.e., once the extensions cleaned up the
+			// resources of the control plane (e.g., the infrastructure or the DNS entries).
+			orphanedControlPlanes[namespace.Name] = "Deletion confirmed, waiting for extension resources to be deleted: " + extensionResourcesInfo(extensionResources)
+			continue
+		}
+
+		log.Info("Deleting Cluster resource and namespace of orphaned control plane since its deletion was confirmed", "namespace", namespace.Name)
+		if err := client.IgnoreNotFound(r.SeedClient.Delete(ctx, &extensionsv1alpha1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: namespace.Name}})); err != nil {
+			return fmt.Errorf("failed deleting Cluster resource %s: %w", namespace.Name, err)
+		}
+		if err := client.IgnoreNotFound(r.SeedClient.Delete(ctx, &namespace)); err != nil {
+			return fmt.Errorf("failed deleting namespace %s: %w", namespace.Name, err)
+		}
+	}
+
+	return r.reportOrphanedControlPlanes(ctx, orphanedControlPlanes)
+}
+
+// listExtensionResources lists the extension resources in the given namespace. It returns the lists of all kinds as
+// well as the names of the found resources in the form '<kind>/<name>'.
+func (r *Reconciler) listExtensionResources(ctx context.Context, namespace string) (map[string]client.ObjectList, []string, error) {
+	var (
+		kindToObjectList   = controlPlaneExtensionKindToObjectList()
+		extensionResources []string
+	)
+
+	for kind, objectList := range kindToObjectList {
+		if err := r.SeedClient.List(ctx, objectList, client.InNamespace(namespace)); err != nil {
+			return nil, nil, fmt.Errorf("failed listing %s resources in namespace %s: %w", kind, namespace, err)
+		}
+
+		if err := meta.EachListItem(objectList, func(obj runtime.Object) error {
+			accessor, err := meta.Accessor(obj)
+			if err != nil {
+				return err
+			}
+			extensionResources = append(extensionResources, kind+"/"+accessor.GetName())
+			return nil
+		}); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	slices.Sort(extensionResources)
+	return kindToObjectList, extensionResources, nil
+}
+
+func extensionResourcesInfo(extensionResources []string) string {
+	if len(extensionResources) == 0 {
+		return "none"
+	}
+	return strings.Join(extensionResources, ", ")
+}
+
+// reportOrphanedControlPlanes maintains the ConfigMap which reports the orphaned control planes. The ConfigMap is
+// deleted if there are no orphaned control planes.
+func (r *Reconciler) reportOrphanedControlPlanes(ctx context.Context, orphanedControlPlanes map[string]string) error {
+	configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: v1beta1constants.ConfigMapNameOrphanedControlPlanes, Namespace: v1beta1constants.GardenNamespace}}
+
+	if len(orphanedControlPlanes) == 0 {
+		return client.IgnoreNotFound(r.SeedClient.Delete(ctx, configMap))
+	}
+
+	_, err := controllerutils.GetAndCreateOrMergePatch(ctx, r.SeedClient, configMap, func() error {
+		configMap.Data = orphanedControlPlanes
+		return nil
+	})
+	return err
+}
@@ -0,0 +1,133 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package care_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/seed/care"
+	"github.com/gardener/gardener/pkg/utils/test"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Control plane ownership", func() {
+	var (
+		ctx          = context.Background()
+		gardenClient client.Client
+		seedClient   client.Client
+		reconciler   *Reconciler
+		req          = reconcile.Request{NamespacedName: client.ObjectKey{Name: seedName}}
+
+		seed           *gardencorev1beta1.Seed
+		shoot          *gardencorev1beta1.Shoot
+		namespace      *corev1.Namespace
+		dnsRecord      *extensionsv1alpha1.DNSRecord
+		infrastructure *extensionsv1alpha1.Infrastructure
+	)
+
+	BeforeEach(func() {
+		DeferCleanup(test.WithVars(&NewHealthCheck,
+			healthCheckFunc(func(_ SeedConditions) []gardencorev1beta1.Condition { return nil })))
+
+		seed = &gardencorev1beta1.Seed{ObjectMeta: metav1.ObjectMeta{Name: seedName}}
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-project"},
+			Status:     gardencorev1beta1.ShootStatus{TechnicalID: "shoot--project--shoot"},
+		}
+		namespace = &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+			Name:   "shoot--project--orphan",
+			Labels: map[string]string{"gardener.cloud/role": "shoot"},
+		}}
+		dnsRecord = &extensionsv1alpha1.DNSRecord{ObjectMeta: metav1.ObjectMeta{Name: "orphan-external", Namespace: namespace.Name}}
+		infrastructure = &extensionsv1alpha1.Infrastructure{ObjectMeta: metav1.ObjectMeta{Name: "orphan", Namespace: namespace.Name}}
+
+		gardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithObjects(seed, shoot).WithStatusSubresource(&gardencorev1beta1.Seed{}).Build()
+		seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithObjects(
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: shoot.Status.TechnicalID, Labels: map[string]string{"gardener.cloud/role": "shoot"}}},
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "garden"}},
+		).Build()
+
+		reconciler = &Reconciler{
+			GardenClient: gardenClient,
+			SeedClient:   seedClient,
+			Config:       config.SeedCareControllerConfiguration{SyncPeriod: &metav1.Duration{Duration: careSyncPeriod}},
+			Clock:        testclock.NewFakeClock(time.Now()),
+		}
+	})
+
+	reconcileAndGetReport := func() *corev1.ConfigMap {
+		Expect(reconciler.Reconcile(ctx, req)).To(Equal(reconcile.Result{RequeueAfter: careSyncPeriod}))
+
+		configMap := &corev1.ConfigMap{}
+		if err := seedClient.Get(ctx, client.ObjectKey{Namespace: "garden", Name: "orphaned-control-planes"}, configMap); err != nil {
+			Expect(err).To(BeNotFoundError())
+			return nil
+		}
+		return configMap
+	}
+
+	It("should not report anything if all control planes belong to existing shoots", func() {
+		Expect(reconcileAndGetReport()).To(BeNil())
+		Expect(gardenClient.Get(ctx, req.NamespacedName, seed)).To(Succeed())
+		Expect(seed.Status.Conditions).To(BeEmpty())
+	})
+
+	It("should report orphaned control planes together with their extension resources", func() {
+		Expect(seedClient.Create(ctx, namespace)).To(Succeed())
+		Expect(seedClient.Create(ctx, dnsRecord)).To(Succeed())
+		Expect(seedClient.Create(ctx, infrastructure)).To(Succeed())
+
+		Expect(reconcileAndGetReport().Data).To(Equal(map[string]string{
+			"shoot--project--orphan": "Extension resources: DNSRecord/orphan-external, Infrastructure/orphan",
+		}))
+		Expect(gardenClient.Get(ctx, req.NamespacedName, seed)).To(Succeed())
+		Expect(seed.Status.Conditions).To(BeEmpty())
+	})
+
+	It("should delete the report once the orphaned control planes are gone", func() {
+		Expect(seedClient.Create(ctx, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "orphaned-control-planes", Namespace: "garden"},
+			Data:       map[string]string{"shoot--project--orphan": "Extension resources: none"},
+		})).To(Succeed())
+
+		Expect(reconcileAndGetReport()).To(BeNil())
+	})
+
+	It("should delete the extension resources and afterwards the Cluster and the namespace if the deletion is confirmed", func() {
+		cluster := &extensionsv1alpha1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: namespace.Name}}
+
+		metav1.SetMetaDataAnnotation(&namespace.ObjectMeta, "confirmation.gardener.cloud/deletion", "true")
+		Expect(seedClient.Create(ctx, namespace)).To(Succeed())
+		Expect(seedClient.Create(ctx, dnsRecord)).To(Succeed())
+		Expect(seedClient.Create(ctx, infrastructure)).To(Succeed())
+		Expect(seedClient.Create(ctx, cluster)).To(Succeed())
+
+		Expect(reconcileAndGetReport().Data).To(Equal(map[string]string{
+			"shoot--project--orphan": "Deletion confirmed, waiting for extension resources to be deleted: DNSRecord/orphan-external, Infrastructure/orphan",
+		}))
+		Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(dnsRecord), dnsRecord)).To(BeNotFoundError())
+		Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(infrastructure), infrastructure)).To(BeNotFoundError())
+		Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(cluster), cluster)).To(Succeed())
+		Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(namespace), namespace)).To(Succeed())
+
+		Expect(reconcileAndGetReport()).To(BeNil())
+		Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(cluster), cluster)).To(BeNotFoundError())
+		Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(namespace), namespace)).To(BeNotFoundError())
+	})
+})
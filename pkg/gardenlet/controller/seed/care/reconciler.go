@@ -13,6 +13,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
@@ -34,6 +35,7 @@ type Reconciler struct {
 	SeedClient   client.Client
 	Config       config.SeedCareControllerConfiguration
 	Clock        clock.Clock
+	Recorder     record.EventRecorder
 	Namespace    *string
 	SeedName     string
 }
@@ -96,6 +98,16 @@ func (r *Reconciler) Reconcile(reconcileCtx context.Context, req reconcile.Reque
 		return reconcile.Result{}, fmt.Errorf("failed performing garbage collection: %w", err)
 	}
 
+	// Detect (and delete, if confirmed) control planes which do no longer belong to a shoot
+	if err := r.checkControlPlaneOwnership(ctx, log); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed checking control plane ownership: %w", err)
+	}
+
+	// Detect volumes of control planes which are stuck in attaching or detaching
+	if err := r.checkVolumeAttachments(ctx, seed); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed checking volume attachments: %w", err)
+	}
+
 	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
 }
 
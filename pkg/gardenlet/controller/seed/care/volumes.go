@@ -0,0 +1,133 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package care
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	healthutils "github.com/gardener/gardener/pkg/utils/kubernetes/health"
+)
+
+// EventVolumeAttachmentStuck is an event reason for a volume of a shoot control plane which is stuck in attaching or
+// detaching.
+const EventVolumeAttachmentStuck = "VolumeAttachmentStuck"
+
+// checkVolumeAttachments detects volumes of shoot control planes (e.g., of etcd) which are stuck in attaching or
+// detaching. Such volumes prevent the affected pods from starting and are a common cause for prolonged control plane
+// outages. They are reported in the VolumeAttachmentsHealthy condition, and a warning event with remediation hints is
+// recorded for the seed and the affected shoot.
+// The condition is only maintained once stuck volumes were detected to not clutter the seed status.
+func (r *Reconciler) checkVolumeAttachments(ctx context.Context, seed *gardencorev1beta1.Seed) error {
+	namespaceList := &corev1.NamespaceList{}
+	if err := r.SeedClient.List(ctx, namespaceList, client.MatchingLabels{v1beta1constants.GardenRole: v1beta1constants.GardenRoleShoot}); err != nil {
+		return fmt.Errorf("failed listing shoot namespaces: %w", err)
+	}
+
+	shootNamespaces := sets.New[string]()
+	for _, namespace := range namespaceList.Items {
+		shootNamespaces.Insert(namespace.Name)
+	}
+
+	persistentVolumeList := &corev1.PersistentVolumeList{}
+	if err := r.SeedClient.List(ctx, persistentVolumeList); err != nil {
+		return fmt.Errorf("failed listing persistent volumes: %w", err)
+	}
+
+	volumeNameToClaim := make(map[string]types.NamespacedName)
+	for _, persistentVolume := range persistentVolumeList.Items {
+		if claimRef := persistentVolume.Spec.ClaimRef; claimRef != nil && shootNamespaces.Has(claimRef.Namespace) {
+			volumeNameToClaim[persistentVolume.Name] = types.NamespacedName{Namespace: claimRef.Namespace, Name: claimRef.Name}
+		}
+	}
+
+	volumeAttachmentList := &storagev1.VolumeAttachmentList{}
+	if err := r.SeedClient.List(ctx, volumeAttachmentList); err != nil {
+		return fmt.Errorf("failed listing volume attachments: %w", err)
+	}
+
+	var (
+		stuckVolumes []string
+		shoots       map[string]*gardencorev1beta1.Shoot
+	)
+
+	for _, volumeAttachment := range volumeAttachmentList.Items {
+		claim, ok := volumeNameToClaim[ptr.Deref(volumeAttachment.Spec.Source.PersistentVolumeName, "")]
+		if !ok {
+			continue
+		}
+
+		err := healthutils.CheckVolumeAttachment(&volumeAttachment, r.Clock.Now(), healthutils.VolumeAttachmentStuckThreshold)
+		if err == nil {
+			continue
+		}
+
+		info := fmt.Sprintf("PersistentVolumeClaim: %s, Node: %s, VolumeAttachment: %s: %v", claim, volumeAttachment.Spec.NodeName, volumeAttachment.Name, err)
+		stuckVolumes = append(stuckVolumes, info)
+
+		if shoots == nil {
+			if shoots, err = r.shootsByTechnicalID(ctx); err != nil {
+				return err
+			}
+		}
+
+		message := fmt.Sprintf("Volume of PersistentVolumeClaim %s is unhealthy: %v. Check the node %q and the CSI driver for problems. "+
+			"If the node does no longer exist, the volume might still be attached on infrastructure level and must be detached manually, "+
+			"afterwards the VolumeAttachment %q can be deleted.", claim, err, volumeAttachment.Spec.NodeName, volumeAttachment.Name)
+		r.Recorder.Event(seed, corev1.EventTypeWarning, EventVolumeAttachmentStuck, message)
+		if shoot, ok := shoots[claim.Namespace]; ok {
+			r.Recorder.Event(shoot, corev1.EventTypeWarning, EventVolumeAttachmentStuck, message)
+		}
+	}
+
+	condition := v1beta1helper.GetCondition(seed.Status.Conditions, gardencorev1beta1.SeedVolumeAttachmentsHealthy)
+	if condition == nil && len(stuckVolumes) == 0 {
+		return nil
+	}
+
+	updatedCondition := v1beta1helper.GetOrInitConditionWithClock(r.Clock, seed.Status.Conditions, gardencorev1beta1.SeedVolumeAttachmentsHealthy)
+	if len(stuckVolumes) > 0 {
+		msg := "The following volumes of shoot control planes are stuck in attaching or detaching:"
+		for _, info := range stuckVolumes {
+			msg += fmt.Sprintf("\n* %s", info)
+		}
+		updatedCondition = v1beta1helper.UpdatedConditionWithClock(r.Clock, updatedCondition, gardencorev1beta1.ConditionFalse, "VolumeAttachmentsStuck", msg)
+	} else {
+		updatedCondition = v1beta1helper.UpdatedConditionWithClock(r.Clock, updatedCondition, gardencorev1beta1.ConditionTrue, "VolumeAttachmentsHealthy", "No volumes of shoot control planes are stuck in attaching or detaching.")
+	}
+
+	if condition != nil && !v1beta1helper.ConditionsNeedUpdate([]gardencorev1beta1.Condition{*condition}, []gardencorev1beta1.Condition{updatedCondition}) {
+		return nil
+	}
+
+	patch := client.StrategicMergeFrom(seed.DeepCopy())
+	seed.Status.Conditions = v1beta1helper.MergeConditions(seed.Status.Conditions, updatedCondition)
+	return r.GardenClient.Status().Patch(ctx, seed, patch)
+}
+
+func (r *Reconciler) shootsByTechnicalID(ctx context.Context) (map[string]*gardencorev1beta1.Shoot, error) {
+	shootList := &gardencorev1beta1.ShootList{}
+	if err := r.GardenClient.List(ctx, shootList); err != nil {
+		return nil, fmt.Errorf("failed listing shoots: %w", err)
+	}
+
+	shoots := make(map[string]*gardencorev1beta1.Shoot, len(shootList.Items))
+	for _, shoot := range shootList.Items {
+		if shoot.Status.TechnicalID != "" {
+			shoots[shoot.Status.TechnicalID] = shoot.DeepCopy()
+		}
+	}
+	return shoots, nil
+}
@@ -0,0 +1,133 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package care_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/seed/care"
+	"github.com/gardener/gardener/pkg/utils/test"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Volume attachments", func() {
+	var (
+		ctx          = context.Background()
+		gardenClient client.Client
+		seedClient   client.Client
+		recorder     *record.FakeRecorder
+		reconciler   *Reconciler
+		req          = reconcile.Request{NamespacedName: client.ObjectKey{Name: seedName}}
+		now          = time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+		seed             *gardencorev1beta1.Seed
+		shoot            *gardencorev1beta1.Shoot
+		volumeAttachment *storagev1.VolumeAttachment
+	)
+
+	BeforeEach(func() {
+		DeferCleanup(test.WithVars(&NewHealthCheck,
+			healthCheckFunc(func(_ SeedConditions) []gardencorev1beta1.Condition { return nil })))
+
+		seed = &gardencorev1beta1.Seed{ObjectMeta: metav1.ObjectMeta{Name: seedName}}
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-project"},
+			Status:     gardencorev1beta1.ShootStatus{TechnicalID: "shoot--project--shoot"},
+		}
+		volumeAttachment = &storagev1.VolumeAttachment{
+			ObjectMeta: metav1.ObjectMeta{Name: "csi-1", CreationTimestamp: metav1.NewTime(now.Add(-10 * time.Minute))},
+			Spec: storagev1.VolumeAttachmentSpec{
+				NodeName: "node-1",
+				Source:   storagev1.VolumeAttachmentSource{PersistentVolumeName: ptr.To("pv-1")},
+			},
+		}
+
+		gardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithObjects(seed, shoot).WithStatusSubresource(&gardencorev1beta1.Seed{}).Build()
+		seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithObjects(
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: shoot.Status.TechnicalID, Labels: map[string]string{"gardener.cloud/role": "shoot"}}},
+			&corev1.PersistentVolume{
+				ObjectMeta: metav1.ObjectMeta{Name: "pv-1"},
+				Spec:       corev1.PersistentVolumeSpec{ClaimRef: &corev1.ObjectReference{Namespace: shoot.Status.TechnicalID, Name: "main-etcd-etcd-main-0"}},
+			},
+			&corev1.PersistentVolume{
+				ObjectMeta: metav1.ObjectMeta{Name: "pv-2"},
+				Spec:       corev1.PersistentVolumeSpec{ClaimRef: &corev1.ObjectReference{Namespace: "garden", Name: "foo"}},
+			},
+		).Build()
+
+		recorder = record.NewFakeRecorder(2)
+		reconciler = &Reconciler{
+			GardenClient: gardenClient,
+			SeedClient:   seedClient,
+			Config:       config.SeedCareControllerConfiguration{SyncPeriod: &metav1.Duration{Duration: careSyncPeriod}},
+			Clock:        testclock.NewFakeClock(now),
+			Recorder:     recorder,
+		}
+	})
+
+	reconcileAndGetSeed := func() *gardencorev1beta1.Seed {
+		Expect(reconciler.Reconcile(ctx, req)).To(Equal(reconcile.Result{RequeueAfter: careSyncPeriod}))
+		Expect(gardenClient.Get(ctx, req.NamespacedName, seed)).To(Succeed())
+		return seed
+	}
+
+	It("should not add the condition if no volumes are stuck", func() {
+		volumeAttachment.Status.Attached = true
+		Expect(seedClient.Create(ctx, volumeAttachment)).To(Succeed())
+
+		Expect(reconcileAndGetSeed().Status.Conditions).To(BeEmpty())
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("should ignore stuck volumes which do not belong to shoot control planes", func() {
+		volumeAttachment.Spec.Source.PersistentVolumeName = ptr.To("pv-2")
+		Expect(seedClient.Create(ctx, volumeAttachment)).To(Succeed())
+
+		Expect(reconcileAndGetSeed().Status.Conditions).To(BeEmpty())
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("should report stuck volumes and record events for the seed and the shoot", func() {
+		volumeAttachment.Status.AttachError = &storagev1.VolumeError{Message: "disk is attached to another instance"}
+		Expect(seedClient.Create(ctx, volumeAttachment)).To(Succeed())
+
+		Expect(reconcileAndGetSeed().Status.Conditions).To(ConsistOf(And(
+			OfType(gardencorev1beta1.SeedVolumeAttachmentsHealthy),
+			WithStatus(gardencorev1beta1.ConditionFalse),
+			WithReason("VolumeAttachmentsStuck"),
+			WithMessageSubstrings("PersistentVolumeClaim: shoot--project--shoot/main-etcd-etcd-main-0, Node: node-1, VolumeAttachment: csi-1: volume is stuck in attaching since 2024-01-01T11:50:00Z: disk is attached to another instance"),
+		)))
+
+		Expect(recorder.Events).To(Receive(And(ContainSubstring("Warning VolumeAttachmentStuck"), ContainSubstring(`Check the node "node-1"`))))
+		Expect(recorder.Events).To(Receive(ContainSubstring("Warning VolumeAttachmentStuck")))
+	})
+
+	It("should set the condition to `True` once the stuck volumes are gone", func() {
+		seed.Status.Conditions = []gardencorev1beta1.Condition{{Type: gardencorev1beta1.SeedVolumeAttachmentsHealthy, Status: gardencorev1beta1.ConditionFalse}}
+		Expect(gardenClient.Status().Update(ctx, seed)).To(Succeed())
+
+		Expect(reconcileAndGetSeed().Status.Conditions).To(ConsistOf(And(
+			OfType(gardencorev1beta1.SeedVolumeAttachmentsHealthy),
+			WithStatus(gardencorev1beta1.ConditionTrue),
+			WithReason("VolumeAttachmentsHealthy"),
+		)))
+	})
+})
@@ -0,0 +1,298 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package etcddefragmentation
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/v1alpha1"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/component/etcd/etcd"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/extensions"
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	seedcontroller "github.com/gardener/gardener/pkg/gardenlet/controller/seed/seed"
+	"github.com/gardener/gardener/pkg/utils/timewindow"
+)
+
+const minutesPerDay = 24 * 60
+
+// Reconciler coordinates the defragmentation schedules of the etcds of all shoots in the seed. It bounds the number of
+// defragmentations running concurrently per availability zone by moving the schedules of colliding etcds to free slots
+// within the maintenance time windows of their shoots. Moving a schedule rolls the etcd, hence schedules are only moved
+// within the maintenance time window of the seed (if configured).
+type Reconciler struct {
+	GardenClient client.Reader
+	SeedClient   client.Client
+	Config       config.EtcdDefragmentationCoordinatorControllerConfiguration
+	Clock        clock.Clock
+	SeedName     string
+}
+
+// defragmentation describes the defragmentation schedule of an etcd.
+type defragmentation struct {
+	etcd *druidv1alpha1.Etcd
+	// minuteOfDay is the start of the defragmentation in minutes after midnight (UTC).
+	minuteOfDay int
+	// scheduleSuffix are the day-of-month, month and day-of-week fields of the schedule.
+	scheduleSuffix string
+	// zones are the availability zones the etcd is running in.
+	zones []string
+	// window is the maintenance time window of the shoot. If nil, the defragmentation may be moved to any time.
+	window *timewindow.MaintenanceTimeWindow
+}
+
+// Reconcile coordinates the defragmentation schedules of the etcds of all shoots in the seed.
+func (r *Reconciler) Reconcile(ctx context.Context, _ reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
+	defer cancel()
+
+	seed := &gardencorev1beta1.Seed{}
+	if err := r.GardenClient.Get(ctx, client.ObjectKey{Name: r.SeedName}, seed); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if seed.Spec.Maintenance != nil {
+		window, err := timewindow.ParseMaintenanceTimeWindow(seed.Spec.Maintenance.TimeWindow.Begin, seed.Spec.Maintenance.TimeWindow.End)
+		if err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed parsing maintenance time window of seed: %w", err)
+		}
+
+		now := r.Clock.Now()
+		if begin, ok := seedcontroller.NextMaintenanceTimeWindowBegin(window, now); ok {
+			requeueAfter := min(begin.Sub(now), r.Config.SyncPeriod.Duration)
+			log.Info("Postponing coordination of defragmentation schedules to the maintenance time window of the seed", "maintenanceTimeWindowBegin", begin, "requeueAfter", requeueAfter)
+			return reconcile.Result{RequeueAfter: requeueAfter}, nil
+		}
+	}
+
+	defragmentations, err := r.listDefragmentations(ctx, log)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if err := r.coordinate(ctx, log, defragmentations); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+}
+
+func (r *Reconciler) listDefragmentations(ctx context.Context, log logr.Logger) ([]defragmentation, error) {
+	etcdList := &druidv1alpha1.EtcdList{}
+	if err := r.SeedClient.List(ctx, etcdList); err != nil {
+		return nil, fmt.Errorf("failed listing etcds: %w", err)
+	}
+
+	var (
+		defragmentations []defragmentation
+		namespaces       = map[string]*corev1.Namespace{}
+		windows          = map[string]*timewindow.MaintenanceTimeWindow{}
+	)
+
+	for i := range etcdList.Items {
+		etcdObj := &etcdList.Items[i]
+
+		if !strings.HasPrefix(etcdObj.Namespace, v1beta1constants.TechnicalIDPrefix) {
+			continue
+		}
+
+		schedule := etcdObj.Spec.Etcd.DefragmentationSchedule
+		if schedule == nil || *schedule == etcd.DefragmentationScheduleSuspended {
+			continue
+		}
+
+		minuteOfDay, scheduleSuffix, ok := parseSchedule(*schedule)
+		if !ok {
+			log.V(1).Info("Skipping etcd with unsupported defragmentation schedule", "etcd", client.ObjectKeyFromObject(etcdObj), "schedule", *schedule)
+			continue
+		}
+
+		namespace, ok := namespaces[etcdObj.Namespace]
+		if !ok {
+			namespace = &corev1.Namespace{}
+			if err := r.SeedClient.Get(ctx, client.ObjectKey{Name: etcdObj.Namespace}, namespace); err != nil {
+				return nil, fmt.Errorf("failed reading namespace %s: %w", etcdObj.Namespace, err)
+			}
+			namespaces[etcdObj.Namespace] = namespace
+
+			window, err := r.maintenanceTimeWindow(ctx, etcdObj.Namespace)
+			if err != nil {
+				return nil, err
+			}
+			windows[etcdObj.Namespace] = window
+		}
+
+		defragmentations = append(defragmentations, defragmentation{
+			etcd:           etcdObj,
+			minuteOfDay:    minuteOfDay,
+			scheduleSuffix: scheduleSuffix,
+			zones:          zonesOf(namespace),
+			window:         windows[etcdObj.Namespace],
+		})
+	}
+
+	slices.SortFunc(defragmentations, func(a, b defragmentation) int {
+		return strings.Compare(client.ObjectKeyFromObject(a.etcd).String(), client.ObjectKeyFromObject(b.etcd).String())
+	})
+
+	return defragmentations, nil
+}
+
+func (r *Reconciler) maintenanceTimeWindow(ctx context.Context, namespace string) (*timewindow.MaintenanceTimeWindow, error) {
+	shoot, err := extensions.GetShoot(ctx, r.SeedClient, namespace)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed reading shoot from cluster %s: %w", namespace, err)
+	}
+
+	if shoot == nil || shoot.Spec.Maintenance == nil || shoot.Spec.Maintenance.TimeWindow == nil {
+		return nil, nil
+	}
+
+	window, err := timewindow.ParseMaintenanceTimeWindow(shoot.Spec.Maintenance.TimeWindow.Begin, shoot.Spec.Maintenance.TimeWindow.End)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing maintenance time window of shoot in namespace %s: %w", namespace, err)
+	}
+	if window.Equal(timewindow.AlwaysTimeWindow) {
+		return nil, nil
+	}
+
+	return window, nil
+}
+
+// coordinate assigns the defragmentations to slots. Defragmentations keep their slot as long as the maximum number of
+// concurrent defragmentations is not exceeded in any of their zones. Otherwise, they are moved to the nearest free slot
+// within the maintenance time window of their shoot.
+func (r *Reconciler) coordinate(ctx context.Context, log logr.Logger, defragmentations []defragmentation) error {
+	var (
+		slotMinutes   = int(r.Config.SlotDuration.Minutes())
+		numberOfSlots = (minutesPerDay + slotMinutes - 1) / slotMinutes
+		maxConcurrent = *r.Config.MaxConcurrentDefragmentationsPerZone
+		occupancy     = map[string]map[int]int{}
+	)
+
+	fits := func(zones []string, slot int) bool {
+		for _, zone := range zones {
+			if occupancy[zone][slot] >= maxConcurrent {
+				return false
+			}
+		}
+		return true
+	}
+
+	occupy := func(zones []string, slot int) {
+		for _, zone := range zones {
+			if occupancy[zone] == nil {
+				occupancy[zone] = map[int]int{}
+			}
+			occupancy[zone][slot]++
+		}
+	}
+
+	for _, d := range defragmentations {
+		slot := d.minuteOfDay / slotMinutes
+		if fits(d.zones, slot) {
+			occupy(d.zones, slot)
+			continue
+		}
+
+		newSlot, found := -1, false
+		for offset := 1; offset <= numberOfSlots/2 && !found; offset++ {
+			for _, candidate := range []int{(slot + offset) % numberOfSlots, (slot - offset + numberOfSlots) % numberOfSlots} {
+				if withinWindow(d.window, candidate*slotMinutes) && fits(d.zones, candidate) {
+					newSlot, found = candidate, true
+					break
+				}
+			}
+		}
+
+		log := log.WithValues("etcd", client.ObjectKeyFromObject(d.etcd))
+
+		if !found {
+			log.Info("No free defragmentation slot found within maintenance time window, keeping schedule", "schedule", *d.etcd.Spec.Etcd.DefragmentationSchedule)
+			occupy(d.zones, slot)
+			continue
+		}
+
+		minuteOfDay := newSlot * slotMinutes
+		schedule := fmt.Sprintf("%d %d %s", minuteOfDay%60, minuteOfDay/60, d.scheduleSuffix)
+		log.Info("Moving defragmentation schedule to free slot", "oldSchedule", *d.etcd.Spec.Etcd.DefragmentationSchedule, "newSchedule", schedule)
+
+		patch := client.MergeFrom(d.etcd.DeepCopy())
+		d.etcd.Spec.Etcd.DefragmentationSchedule = &schedule
+		if err := r.SeedClient.Patch(ctx, d.etcd, patch); err != nil {
+			return fmt.Errorf("failed patching defragmentation schedule of etcd %s: %w", client.ObjectKeyFromObject(d.etcd), err)
+		}
+		occupy(d.zones, newSlot)
+	}
+
+	return nil
+}
+
+// parseSchedule parses cron schedules with fixed minute and hour, e.g. '30 2 */3 * *'. It returns the start of the
+// schedule in minutes after midnight and the remaining fields of the schedule.
+func parseSchedule(schedule string) (int, string, bool) {
+	fields := strings.Fields(schedule)
+	if len(fields) != 5 {
+		return 0, "", false
+	}
+
+	minute, err := strconv.Atoi(fields[0])
+	if err != nil || minute < 0 || minute > 59 {
+		return 0, "", false
+	}
+	hour, err := strconv.Atoi(fields[1])
+	if err != nil || hour < 0 || hour > 23 {
+		return 0, "", false
+	}
+
+	return hour*60 + minute, strings.Join(fields[2:], " "), true
+}
+
+func zonesOf(namespace *corev1.Namespace) []string {
+	var zones []string
+	for _, zone := range strings.Split(namespace.Annotations[resourcesv1alpha1.HighAvailabilityConfigZones], ",") {
+		if zone = strings.TrimSpace(zone); zone != "" {
+			zones = append(zones, zone)
+		}
+	}
+
+	if len(zones) == 0 {
+		// Without zone information, all etcds are considered to share the same zone.
+		return []string{""}
+	}
+	return zones
+}
+
+func withinWindow(window *timewindow.MaintenanceTimeWindow, minuteOfDay int) bool {
+	if window == nil {
+		return true
+	}
+	return window.Contains(time.Date(1, 1, 1, minuteOfDay/60, minuteOfDay%60, 0, 0, time.UTC))
+}
@@ -0,0 +1,219 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package etcddefragmentation_test
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/seed/etcddefragmentation"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx = context.Background()
+
+		gardenClient client.Client
+		seedClient   client.Client
+		fakeClock    *testclock.FakeClock
+		seed         *gardencorev1beta1.Seed
+		reconciler   *Reconciler
+	)
+
+	BeforeEach(func() {
+		gardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+		seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		fakeClock = testclock.NewFakeClock(time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC))
+
+		seed = &gardencorev1beta1.Seed{ObjectMeta: metav1.ObjectMeta{Name: "seed"}}
+		Expect(gardenClient.Create(ctx, seed)).To(Succeed())
+
+		reconciler = &Reconciler{
+			GardenClient: gardenClient,
+			SeedClient:   seedClient,
+			Clock:        fakeClock,
+			Config: config.EtcdDefragmentationCoordinatorControllerConfiguration{
+				SyncPeriod:                           &metav1.Duration{Duration: time.Hour},
+				SlotDuration:                         &metav1.Duration{Duration: 10 * time.Minute},
+				MaxConcurrentDefragmentationsPerZone: ptr.To(1),
+			},
+			SeedName: "seed",
+		}
+	})
+
+	createShoot := func(namespace, zones string, maintenance *gardencorev1beta1.Maintenance) {
+		ExpectWithOffset(1, seedClient.Create(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+			Name:        namespace,
+			Annotations: map[string]string{"high-availability-config.resources.gardener.cloud/zones": zones},
+		}})).To(Succeed())
+
+		shoot := &gardencorev1beta1.Shoot{
+			TypeMeta: metav1.TypeMeta{APIVersion: gardencorev1beta1.SchemeGroupVersion.String(), Kind: "Shoot"},
+			Spec:     gardencorev1beta1.ShootSpec{Maintenance: maintenance},
+		}
+		raw, err := json.Marshal(shoot)
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		ExpectWithOffset(1, seedClient.Create(ctx, &extensionsv1alpha1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: namespace},
+			Spec:       extensionsv1alpha1.ClusterSpec{Shoot: runtime.RawExtension{Raw: raw}},
+		})).To(Succeed())
+	}
+
+	createEtcd := func(namespace, name, schedule string) {
+		ExpectWithOffset(1, seedClient.Create(ctx, &druidv1alpha1.Etcd{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec:       druidv1alpha1.EtcdSpec{Etcd: druidv1alpha1.EtcdConfig{DefragmentationSchedule: ptr.To(schedule)}},
+		})).To(Succeed())
+	}
+
+	scheduleOf := func(namespace, name string) string {
+		etcd := &druidv1alpha1.Etcd{}
+		ExpectWithOffset(1, seedClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, etcd)).To(Succeed())
+		return *etcd.Spec.Etcd.DefragmentationSchedule
+	}
+
+	maintenanceWindow := func(begin, end string) *gardencorev1beta1.Maintenance {
+		return &gardencorev1beta1.Maintenance{TimeWindow: &gardencorev1beta1.MaintenanceTimeWindow{Begin: begin, End: end}}
+	}
+
+	It("should requeue after the sync period", func() {
+		Expect(reconciler.Reconcile(ctx, reconcile.Request{})).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+	})
+
+	It("should stop reconciling if the seed is gone", func() {
+		Expect(gardenClient.Delete(ctx, seed)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, reconcile.Request{})).To(Equal(reconcile.Result{}))
+	})
+
+	Context("seed with maintenance time window", func() {
+		BeforeEach(func() {
+			createShoot("shoot--foo--a", "zone-a", maintenanceWindow("220000+0000", "230000+0000"))
+			createShoot("shoot--foo--b", "zone-a", maintenanceWindow("220000+0000", "230000+0000"))
+			createEtcd("shoot--foo--a", "etcd-main", "3 22 */3 * *")
+			createEtcd("shoot--foo--b", "etcd-main", "7 22 */3 * *")
+
+			seed.Spec.Maintenance = &gardencorev1beta1.SeedMaintenance{TimeWindow: gardencorev1beta1.MaintenanceTimeWindow{Begin: "123000+0000", End: "133000+0000"}}
+			Expect(gardenClient.Update(ctx, seed)).To(Succeed())
+		})
+
+		It("should not move schedules outside of the maintenance time window of the seed", func() {
+			Expect(reconciler.Reconcile(ctx, reconcile.Request{})).To(Equal(reconcile.Result{RequeueAfter: 30 * time.Minute}))
+
+			Expect(scheduleOf("shoot--foo--a", "etcd-main")).To(Equal("3 22 */3 * *"))
+			Expect(scheduleOf("shoot--foo--b", "etcd-main")).To(Equal("7 22 */3 * *"))
+		})
+
+		It("should requeue after the sync period if the maintenance time window of the seed begins later", func() {
+			fakeClock.SetTime(time.Date(2024, 1, 1, 14, 0, 0, 0, time.UTC))
+
+			Expect(reconciler.Reconcile(ctx, reconcile.Request{})).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+
+			Expect(scheduleOf("shoot--foo--b", "etcd-main")).To(Equal("7 22 */3 * *"))
+		})
+
+		It("should move schedules within the maintenance time window of the seed", func() {
+			fakeClock.SetTime(time.Date(2024, 1, 1, 13, 0, 0, 0, time.UTC))
+
+			Expect(reconciler.Reconcile(ctx, reconcile.Request{})).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+
+			Expect(scheduleOf("shoot--foo--a", "etcd-main")).To(Equal("3 22 */3 * *"))
+			Expect(scheduleOf("shoot--foo--b", "etcd-main")).To(Equal("10 22 */3 * *"))
+		})
+	})
+
+	It("should not change schedules of etcds which do not collide", func() {
+		createShoot("shoot--foo--bar", "zone-a", maintenanceWindow("220000+0000", "230000+0000"))
+		createEtcd("shoot--foo--bar", "etcd-main", "5 22 */3 * *")
+		createEtcd("shoot--foo--bar", "etcd-events", "25 22 */3 * *")
+
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(scheduleOf("shoot--foo--bar", "etcd-main")).To(Equal("5 22 */3 * *"))
+		Expect(scheduleOf("shoot--foo--bar", "etcd-events")).To(Equal("25 22 */3 * *"))
+	})
+
+	It("should move colliding schedules to the nearest free slot within the maintenance time window", func() {
+		createShoot("shoot--foo--a", "zone-a", maintenanceWindow("220000+0000", "230000+0000"))
+		createShoot("shoot--foo--b", "zone-a", maintenanceWindow("220000+0000", "230000+0000"))
+		createShoot("shoot--foo--c", "zone-a", maintenanceWindow("220000+0000", "221500+0000"))
+		createEtcd("shoot--foo--a", "etcd-main", "3 22 */3 * *")
+		createEtcd("shoot--foo--b", "etcd-main", "7 22 */3 * *")
+		createEtcd("shoot--foo--c", "etcd-main", "8 22 * * *")
+
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(scheduleOf("shoot--foo--a", "etcd-main")).To(Equal("3 22 */3 * *"))
+		Expect(scheduleOf("shoot--foo--b", "etcd-main")).To(Equal("10 22 */3 * *"))
+		// There is no free slot within the maintenance time window, hence the schedule is kept.
+		Expect(scheduleOf("shoot--foo--c", "etcd-main")).To(Equal("8 22 * * *"))
+	})
+
+	It("should only consider etcds in the same zone as colliding", func() {
+		createShoot("shoot--foo--a", "zone-a", maintenanceWindow("220000+0000", "230000+0000"))
+		createShoot("shoot--foo--b", "zone-b", maintenanceWindow("220000+0000", "230000+0000"))
+		createShoot("shoot--foo--c", "zone-a,zone-b,zone-c", maintenanceWindow("220000+0000", "230000+0000"))
+		createEtcd("shoot--foo--a", "etcd-main", "3 22 */3 * *")
+		createEtcd("shoot--foo--b", "etcd-main", "4 22 */3 * *")
+		createEtcd("shoot--foo--c", "etcd-main", "5 22 */3 * *")
+
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(scheduleOf("shoot--foo--a", "etcd-main")).To(Equal("3 22 */3 * *"))
+		Expect(scheduleOf("shoot--foo--b", "etcd-main")).To(Equal("4 22 */3 * *"))
+		Expect(scheduleOf("shoot--foo--c", "etcd-main")).To(Equal("10 22 */3 * *"))
+	})
+
+	It("should move schedules to any slot if the shoot has no maintenance time window", func() {
+		createShoot("shoot--foo--a", "", nil)
+		createShoot("shoot--foo--b", "", nil)
+		createEtcd("shoot--foo--a", "etcd-main", "55 23 */3 * *")
+		createEtcd("shoot--foo--b", "etcd-main", "50 23 */3 * *")
+
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(scheduleOf("shoot--foo--a", "etcd-main")).To(Equal("55 23 */3 * *"))
+		Expect(scheduleOf("shoot--foo--b", "etcd-main")).To(Equal("0 0 */3 * *"))
+	})
+
+	It("should ignore suspended, unparsable and non-shoot etcds", func() {
+		createShoot("shoot--foo--a", "", nil)
+		createShoot("shoot--foo--b", "", nil)
+		createEtcd("shoot--foo--a", "etcd-main", "0 0 30 2 *")
+		createEtcd("shoot--foo--a", "etcd-events", "*/5 * * * *")
+		Expect(seedClient.Create(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "garden"}})).To(Succeed())
+		createEtcd("garden", "etcd-main", "2 0 30 2 *")
+		createEtcd("shoot--foo--b", "etcd-main", "1 0 30 2 *")
+
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(scheduleOf("shoot--foo--a", "etcd-main")).To(Equal("0 0 30 2 *"))
+		Expect(scheduleOf("shoot--foo--a", "etcd-events")).To(Equal("*/5 * * * *"))
+		Expect(scheduleOf("garden", "etcd-main")).To(Equal("2 0 30 2 *"))
+		Expect(scheduleOf("shoot--foo--b", "etcd-main")).To(Equal("1 0 30 2 *"))
+	})
+})
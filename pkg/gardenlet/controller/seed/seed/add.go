@@ -49,6 +49,9 @@ func (r *Reconciler) AddToManager(mgr manager.Manager, gardenCluster cluster.Clu
 	if r.GardenNamespace == "" {
 		r.GardenNamespace = v1beta1constants.GardenNamespace
 	}
+	if r.CapacityReporters == nil {
+		r.CapacityReporters = []CapacityReporter{&ConfigMapCapacityReporter{Client: r.SeedClientSet.APIReader(), Namespace: r.GardenNamespace}}
+	}
 
 	if r.ClientCertificateExpirationTimestamp == nil {
 		gardenletClientCertificate, err := kubernetesutils.ClientCertificateFromRESTConfig(gardenCluster.GetConfig())
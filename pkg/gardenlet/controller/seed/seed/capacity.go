@@ -0,0 +1,110 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package seed
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+)
+
+// CapacityReporter reports the capacity of resources of the seed cluster which cannot be configured statically, e.g.,
+// the number of load balancers or persistent volumes which can still be created in the seed's infrastructure account.
+type CapacityReporter interface {
+	// ReportCapacity returns the capacity of the resources known to the reporter.
+	ReportCapacity(ctx context.Context) (corev1.ResourceList, error)
+}
+
+// ConfigMapCapacityReporter reports the capacity of resources from ConfigMaps in the given namespace which are labeled
+// with `seed.gardener.cloud/capacity=true`. Each key of such a ConfigMap is the name of a resource, the value is its
+// capacity. This allows operators or extensions to feed arbitrary resource dimensions into the seed status.
+type ConfigMapCapacityReporter struct {
+	Client    client.Reader
+	Namespace string
+}
+
+// ReportCapacity returns the capacity of the resources reported in the labeled ConfigMaps.
+func (c *ConfigMapCapacityReporter) ReportCapacity(ctx context.Context) (corev1.ResourceList, error) {
+	configMapList := &corev1.ConfigMapList{}
+	if err := c.Client.List(ctx, configMapList, client.InNamespace(c.Namespace), client.MatchingLabels{v1beta1constants.LabelSeedCapacity: "true"}); err != nil {
+		return nil, fmt.Errorf("failed listing capacity ConfigMaps in namespace %s: %w", c.Namespace, err)
+	}
+
+	var (
+		capacity   = corev1.ResourceList{}
+		reportedBy = make(map[corev1.ResourceName]string)
+	)
+
+	for _, configMap := range configMapList.Items {
+		for key, value := range configMap.Data {
+			resourceName := corev1.ResourceName(key)
+
+			if other, ok := reportedBy[resourceName]; ok {
+				return nil, fmt.Errorf("capacity of resource %q is reported by multiple ConfigMaps (%s, %s)", resourceName, other, configMap.Name)
+			}
+
+			quantity, err := resource.ParseQuantity(value)
+			if err != nil {
+				return nil, fmt.Errorf("failed parsing capacity of resource %q reported by ConfigMap %s: %w", resourceName, configMap.Name, err)
+			}
+
+			capacity[resourceName] = quantity
+			reportedBy[resourceName] = configMap.Name
+		}
+	}
+
+	return capacity, nil
+}
+
+// ComputeCapacityAndAllocatable computes the capacity and allocatable resources of the seed. The capacity is taken from
+// the resources configuration and from the capacity reporters, whereas the configured capacity takes precedence over
+// the reported one. The allocatable amount of a resource is its capacity minus the configured reserved amount.
+func ComputeCapacityAndAllocatable(ctx context.Context, resources *config.ResourcesConfiguration, reporters []CapacityReporter) (corev1.ResourceList, corev1.ResourceList, error) {
+	var (
+		capacity = corev1.ResourceList{}
+		reserved corev1.ResourceList
+	)
+
+	for _, reporter := range reporters {
+		reportedCapacity, err := reporter.ReportCapacity(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for resourceName, quantity := range reportedCapacity {
+			capacity[resourceName] = quantity
+		}
+	}
+
+	if resources != nil {
+		for resourceName, quantity := range resources.Capacity {
+			capacity[resourceName] = quantity
+		}
+		reserved = resources.Reserved
+	}
+
+	if len(capacity) == 0 {
+		return nil, nil, nil
+	}
+
+	allocatable := make(corev1.ResourceList, len(capacity))
+	for resourceName, quantity := range capacity {
+		allocatable[resourceName] = quantity
+
+		if reservedQuantity, ok := reserved[resourceName]; ok {
+			allocatableQuantity := quantity.DeepCopy()
+			allocatableQuantity.Sub(reservedQuantity)
+			allocatable[resourceName] = allocatableQuantity
+		}
+	}
+
+	return capacity, allocatable, nil
+}
@@ -0,0 +1,132 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package seed_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/seed/seed"
+)
+
+type fakeCapacityReporter struct {
+	capacity corev1.ResourceList
+	err      error
+}
+
+func (f *fakeCapacityReporter) ReportCapacity(_ context.Context) (corev1.ResourceList, error) {
+	return f.capacity, f.err
+}
+
+var _ = Describe("Capacity", func() {
+	var (
+		ctx = context.Background()
+
+		fakeClient client.Client
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+	})
+
+	Describe("ConfigMapCapacityReporter", func() {
+		var reporter *ConfigMapCapacityReporter
+
+		BeforeEach(func() {
+			reporter = &ConfigMapCapacityReporter{Client: fakeClient, Namespace: "garden"}
+		})
+
+		createConfigMap := func(name, namespace string, labels, data map[string]string) {
+			ExpectWithOffset(1, fakeClient.Create(ctx, &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: labels},
+				Data:       data,
+			})).To(Succeed())
+		}
+
+		It("should return an empty list if no ConfigMap exists", func() {
+			Expect(reporter.ReportCapacity(ctx)).To(BeEmpty())
+		})
+
+		It("should return the capacity reported by all labeled ConfigMaps", func() {
+			createConfigMap("volumes", "garden", map[string]string{"seed.gardener.cloud/capacity": "true"}, map[string]string{"persistent-volumes": "1000"})
+			createConfigMap("load-balancers", "garden", map[string]string{"seed.gardener.cloud/capacity": "true"}, map[string]string{"load-balancers": "50"})
+			createConfigMap("unlabeled", "garden", nil, map[string]string{"foo": "1"})
+			createConfigMap("other-namespace", "default", map[string]string{"seed.gardener.cloud/capacity": "true"}, map[string]string{"bar": "1"})
+
+			Expect(reporter.ReportCapacity(ctx)).To(Equal(corev1.ResourceList{
+				"persistent-volumes": resource.MustParse("1000"),
+				"load-balancers":     resource.MustParse("50"),
+			}))
+		})
+
+		It("should fail if a quantity cannot be parsed", func() {
+			createConfigMap("volumes", "garden", map[string]string{"seed.gardener.cloud/capacity": "true"}, map[string]string{"persistent-volumes": "many"})
+
+			_, err := reporter.ReportCapacity(ctx)
+			Expect(err).To(MatchError(ContainSubstring(`failed parsing capacity of resource "persistent-volumes" reported by ConfigMap volumes`)))
+		})
+
+		It("should fail if a resource is reported by multiple ConfigMaps", func() {
+			createConfigMap("volumes", "garden", map[string]string{"seed.gardener.cloud/capacity": "true"}, map[string]string{"persistent-volumes": "1000"})
+			createConfigMap("volumes2", "garden", map[string]string{"seed.gardener.cloud/capacity": "true"}, map[string]string{"persistent-volumes": "500"})
+
+			_, err := reporter.ReportCapacity(ctx)
+			Expect(err).To(MatchError(ContainSubstring(`capacity of resource "persistent-volumes" is reported by multiple ConfigMaps`)))
+		})
+	})
+
+	Describe("#ComputeCapacityAndAllocatable", func() {
+		It("should return nothing if no capacity is configured or reported", func() {
+			capacity, allocatable, err := ComputeCapacityAndAllocatable(ctx, nil, []CapacityReporter{&fakeCapacityReporter{}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(capacity).To(BeNil())
+			Expect(allocatable).To(BeNil())
+		})
+
+		It("should merge the configured and reported capacity and subtract the reserved resources", func() {
+			resources := &config.ResourcesConfiguration{
+				Capacity: corev1.ResourceList{
+					"shoots":         resource.MustParse("100"),
+					"load-balancers": resource.MustParse("20"),
+				},
+				Reserved: corev1.ResourceList{
+					"shoots":             resource.MustParse("10"),
+					"persistent-volumes": resource.MustParse("100"),
+				},
+			}
+			reporter := &fakeCapacityReporter{capacity: corev1.ResourceList{
+				"persistent-volumes": resource.MustParse("1000"),
+				"load-balancers":     resource.MustParse("50"),
+			}}
+
+			capacity, allocatable, err := ComputeCapacityAndAllocatable(ctx, resources, []CapacityReporter{reporter})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(capacity).To(Equal(corev1.ResourceList{
+				"shoots":             resource.MustParse("100"),
+				"load-balancers":     resource.MustParse("20"),
+				"persistent-volumes": resource.MustParse("1000"),
+			}))
+			Expect(allocatable).To(HaveLen(3))
+			Expect(allocatable.Name("shoots", resource.DecimalSI).Value()).To(Equal(int64(90)))
+			Expect(allocatable.Name("load-balancers", resource.DecimalSI).Value()).To(Equal(int64(20)))
+			Expect(allocatable.Name("persistent-volumes", resource.DecimalSI).Value()).To(Equal(int64(900)))
+		})
+
+		It("should fail if a reporter fails", func() {
+			_, _, err := ComputeCapacityAndAllocatable(ctx, nil, []CapacityReporter{&fakeCapacityReporter{err: errors.New("fake")}})
+			Expect(err).To(MatchError("fake"))
+		})
+	})
+})
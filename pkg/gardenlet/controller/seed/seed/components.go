@@ -17,6 +17,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -60,6 +61,7 @@ import (
 	seedprometheus "github.com/gardener/gardener/pkg/component/observability/monitoring/prometheus/seed"
 	"github.com/gardener/gardener/pkg/component/observability/monitoring/prometheusoperator"
 	"github.com/gardener/gardener/pkg/component/observability/plutono"
+	"github.com/gardener/gardener/pkg/component/seed/imageprepuller"
 	seedsystem "github.com/gardener/gardener/pkg/component/seed/system"
 	sharedcomponent "github.com/gardener/gardener/pkg/component/shared"
 	"github.com/gardener/gardener/pkg/features"
@@ -94,6 +96,7 @@ type components struct {
 	dwdWeeder                component.DeployWaiter
 	dwdProber                component.DeployWaiter
 	vpnAuthzServer           component.DeployWaiter
+	imagePrePuller           component.DeployWaiter
 
 	kubeAPIServerService component.Deployer
 	kubeAPIServerIngress component.Deployer
@@ -174,6 +177,10 @@ func (r *Reconciler) instantiateComponents(
 	if err != nil {
 		return
 	}
+	c.imagePrePuller, err = r.newImagePrePuller(ctx, seed.GetInfo())
+	if err != nil {
+		return
+	}
 
 	c.kubeAPIServerService = r.newKubeAPIServerService(wildCardCertSecret)
 	c.kubeAPIServerIngress = r.newKubeAPIServerIngress(seed, wildCardCertSecret, c.istioDefaultLabels, c.istioDefaultNamespace)
@@ -264,6 +271,18 @@ func (r *Reconciler) newGardenerResourceManager(seed *gardencorev1beta1.Seed, se
 func (r *Reconciler) newIstio(ctx context.Context, seed *seedpkg.Seed, isGardenCluster bool) (component.DeployWaiter, map[string]string, string, error) {
 	labels := sharedcomponent.GetIstioZoneLabels(r.Config.SNI.Ingress.Labels, nil)
 
+	servicePorts := []corev1.ServicePort{
+		{Name: "proxy", Port: 8443, TargetPort: intstr.FromInt32(8443)},
+		{Name: "tcp", Port: 443, TargetPort: intstr.FromInt32(9443)},
+		{Name: "tls-tunnel", Port: vpnseedserver.GatewayPort, TargetPort: intstr.FromInt32(vpnseedserver.GatewayPort)},
+	}
+	// Additional kube-apiserver ports are forwarded to the same SNI listener as port 443.
+	if ingress := seed.GetInfo().Spec.Ingress; ingress != nil {
+		for _, port := range ingress.KubeAPIServerPorts {
+			servicePorts = append(servicePorts, corev1.ServicePort{Name: fmt.Sprintf("tcp-%d", port), Port: port, TargetPort: intstr.FromInt32(9443)})
+		}
+	}
+
 	istioDeployer, err := sharedcomponent.NewIstio(
 		ctx,
 		r.SeedClientSet.Client(),
@@ -277,11 +296,7 @@ func (r *Reconciler) newIstio(ctx context.Context, seed *seedpkg.Seed, isGardenC
 		seed.GetLoadBalancerServiceAnnotations(),
 		seed.GetLoadBalancerServiceExternalTrafficPolicy(),
 		r.Config.SNI.Ingress.ServiceExternalIP,
-		[]corev1.ServicePort{
-			{Name: "proxy", Port: 8443, TargetPort: intstr.FromInt32(8443)},
-			{Name: "tcp", Port: 443, TargetPort: intstr.FromInt32(9443)},
-			{Name: "tls-tunnel", Port: vpnseedserver.GatewayPort, TargetPort: intstr.FromInt32(vpnseedserver.GatewayPort)},
-		},
+		servicePorts,
 		true,
 		seed.GetLoadBalancerServiceProxyProtocolTermination(),
 		true,
@@ -307,27 +322,40 @@ func (r *Reconciler) newIstio(ctx context.Context, seed *seedpkg.Seed, isGardenC
 				&zone,
 				seed.IsDualStack(),
 				seed.GetZonalLoadBalancerServiceProxyProtocolTermination(zone),
+				nil,
+				nil,
+				nil,
 			); err != nil {
 				return nil, nil, "", err
 			}
 		}
 	}
 
+	exposureClassGatewaySettings, err := ComputeExposureClassGatewaySettings(ctx, r.GardenClient, seed.GetInfo().Name)
+	if err != nil {
+		return nil, nil, "", err
+	}
+
 	// Add for each ExposureClass handler in the config an own Ingress Gateway and Proxy Gateway.
 	for _, handler := range r.Config.ExposureClassHandlers {
+		settings := exposureClassGatewaySettings[handler.Name]
+
 		if err := sharedcomponent.AddIstioIngressGateway(
 			ctx,
 			r.SeedClientSet.Client(),
 			istioDeployer,
 			*handler.SNI.Ingress.Namespace,
-			// handler.LoadBalancerService.Annotations must put last to override non-exposure class related keys.
-			utils.MergeStringMaps(seed.GetLoadBalancerServiceAnnotations(), handler.LoadBalancerService.Annotations),
+			// handler.LoadBalancerService.Annotations and the ExposureClass annotations must put last to override non-exposure class related keys.
+			utils.MergeStringMaps(seed.GetLoadBalancerServiceAnnotations(), handler.LoadBalancerService.Annotations, settings.Annotations),
 			sharedcomponent.GetIstioZoneLabels(gardenerutils.GetMandatoryExposureClassHandlerSNILabels(handler.SNI.Ingress.Labels, handler.Name), nil),
 			seed.GetLoadBalancerServiceExternalTrafficPolicy(),
 			handler.SNI.Ingress.ServiceExternalIP,
 			nil,
 			seed.IsDualStack(),
 			seed.GetLoadBalancerServiceProxyProtocolTermination(),
+			settings.LoadBalancerSourceRanges,
+			settings.MinReplicas,
+			settings.MaxReplicas,
 		); err != nil {
 			return nil, nil, "", err
 		}
@@ -340,14 +368,17 @@ func (r *Reconciler) newIstio(ctx context.Context, seed *seedpkg.Seed, isGardenC
 					r.SeedClientSet.Client(),
 					istioDeployer,
 					sharedcomponent.GetIstioNamespaceForZone(*handler.SNI.Ingress.Namespace, zone),
-					// handler.LoadBalancerService.Annotations must put last to override non-exposure class related keys.
-					utils.MergeStringMaps(seed.GetZonalLoadBalancerServiceAnnotations(zone), handler.LoadBalancerService.Annotations),
+					// handler.LoadBalancerService.Annotations and the ExposureClass annotations must put last to override non-exposure class related keys.
+					utils.MergeStringMaps(seed.GetZonalLoadBalancerServiceAnnotations(zone), handler.LoadBalancerService.Annotations, settings.Annotations),
 					sharedcomponent.GetIstioZoneLabels(gardenerutils.GetMandatoryExposureClassHandlerSNILabels(handler.SNI.Ingress.Labels, handler.Name), &zone),
 					seed.GetZonalLoadBalancerServiceExternalTrafficPolicy(zone),
 					nil,
 					&zone,
 					seed.IsDualStack(),
 					seed.GetZonalLoadBalancerServiceProxyProtocolTermination(zone),
+					settings.LoadBalancerSourceRanges,
+					settings.MinReplicas,
+					settings.MaxReplicas,
 				); err != nil {
 					return nil, nil, "", err
 				}
@@ -443,6 +474,44 @@ func (r *Reconciler) newVPNAuthzServer() (component.DeployWaiter, error) {
 	), nil
 }
 
+func (r *Reconciler) newImagePrePuller(ctx context.Context, seed *gardencorev1beta1.Seed) (component.DeployWaiter, error) {
+	if !gardenlethelper.IsImagePrePullingEnabled(&r.Config) {
+		return component.OpDestroyWithoutWait(imageprepuller.New(r.SeedClientSet.Client(), r.GardenNamespace, r.Clock, imageprepuller.Values{})), nil
+	}
+
+	image, err := imagevector.Containers().FindImage(imagevector.ContainerImageNamePauseContainer)
+	if err != nil {
+		return nil, err
+	}
+
+	// The garden cache only contains shoots which are related to this seed (either via .spec.seedName or
+	// .status.seedName), hence a simple list is sufficient.
+	shootList := &gardencorev1beta1.ShootList{}
+	if err := r.GardenClient.List(ctx, shootList); err != nil {
+		return nil, fmt.Errorf("failed listing shoots: %w", err)
+	}
+
+	kubernetesVersions := sets.New[string]()
+	for _, shoot := range shootList.Items {
+		if specSeedName, statusSeedName := gardenerutils.GetShootSeedNames(&shoot); ptr.Deref(specSeedName, "") == seed.Name || ptr.Deref(statusSeedName, "") == seed.Name {
+			kubernetesVersions.Insert(shoot.Spec.Kubernetes.Version)
+		}
+	}
+
+	return imageprepuller.New(
+		r.SeedClientSet.Client(),
+		r.GardenNamespace,
+		r.Clock,
+		imageprepuller.Values{
+			KubernetesVersions: sets.List(kubernetesVersions),
+			RetentionPeriod:    ptr.Deref(r.Config.ImagePrePulling.RetentionPeriod, metav1.Duration{}).Duration,
+			ImageVector:        imagevector.Containers(),
+			PauseImage:         image.String(),
+			RuntimeVersion:     r.SeedVersion,
+		},
+	), nil
+}
+
 func (r *Reconciler) newSystem(seed *gardencorev1beta1.Seed) (component.DeployWaiter, error) {
 	image, err := imagevector.Containers().FindImage(imagevector.ContainerImageNamePauseContainer)
 	if err != nil {
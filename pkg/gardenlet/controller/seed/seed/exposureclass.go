@@ -0,0 +1,122 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package seed
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+// ExposureClassGatewaySettings contains the settings of all ExposureClasses referencing the same handler which must be
+// applied to the istio ingress gateways of this handler.
+type ExposureClassGatewaySettings struct {
+	// Annotations are the additional annotations for the load balancer service.
+	Annotations map[string]string
+	// LoadBalancerSourceRanges are the client IP ranges allowed to access the load balancer service.
+	LoadBalancerSourceRanges []string
+	// MinReplicas is the minimum number of replicas of the ingress gateway.
+	MinReplicas *int
+	// MaxReplicas is the maximum number of replicas of the ingress gateway.
+	MaxReplicas *int
+}
+
+// ComputeExposureClassGatewaySettings computes the ingress gateway settings per ExposureClass handler based on the
+// ExposureClasses used by the shoots scheduled to the given seed. If multiple ExposureClasses reference the same
+// handler, their settings are merged: annotations are applied in alphabetical order of the ExposureClass names, source
+// ranges are unified and the highest replica bounds win.
+func ComputeExposureClassGatewaySettings(ctx context.Context, gardenClient client.Reader, seedName string) (map[string]ExposureClassGatewaySettings, error) {
+	// The garden cache only contains shoots which are related to this seed (either via .spec.seedName or
+	// .status.seedName), hence a simple list is sufficient.
+	shootList := &gardencorev1beta1.ShootList{}
+	if err := gardenClient.List(ctx, shootList); err != nil {
+		return nil, fmt.Errorf("failed listing shoots: %w", err)
+	}
+
+	exposureClassNames := sets.New[string]()
+	for _, shoot := range shootList.Items {
+		if shoot.Spec.ExposureClassName == nil {
+			continue
+		}
+		if specSeedName, statusSeedName := gardenerutils.GetShootSeedNames(&shoot); ptr.Deref(specSeedName, "") == seedName || ptr.Deref(statusSeedName, "") == seedName {
+			exposureClassNames.Insert(*shoot.Spec.ExposureClassName)
+		}
+	}
+
+	var (
+		settings     = make(map[string]ExposureClassGatewaySettings)
+		sourceRanges = make(map[string]sets.Set[string])
+	)
+
+	// sets.List returns the names sorted, hence annotations of ExposureClasses are merged in a stable order.
+	for _, name := range sets.List(exposureClassNames) {
+		exposureClass := &gardencorev1beta1.ExposureClass{}
+		if err := gardenClient.Get(ctx, client.ObjectKey{Name: name}, exposureClass); err != nil {
+			return nil, fmt.Errorf("failed reading ExposureClass %s: %w", name, err)
+		}
+
+		if exposureClass.LoadBalancerService == nil && exposureClass.IngressGateway == nil {
+			continue
+		}
+
+		handlerSettings := settings[exposureClass.Handler]
+
+		if lb := exposureClass.LoadBalancerService; lb != nil {
+			for key, value := range lb.Annotations {
+				if handlerSettings.Annotations == nil {
+					handlerSettings.Annotations = make(map[string]string)
+				}
+				handlerSettings.Annotations[key] = value
+			}
+
+			if len(lb.SourceRanges) > 0 {
+				if sourceRanges[exposureClass.Handler] == nil {
+					sourceRanges[exposureClass.Handler] = sets.New[string]()
+				}
+				sourceRanges[exposureClass.Handler].Insert(lb.SourceRanges...)
+			}
+		}
+
+		if gw := exposureClass.IngressGateway; gw != nil {
+			handlerSettings.MinReplicas = maxReplicas(handlerSettings.MinReplicas, gw.MinReplicas)
+			handlerSettings.MaxReplicas = maxReplicas(handlerSettings.MaxReplicas, gw.MaxReplicas)
+		}
+
+		settings[exposureClass.Handler] = handlerSettings
+	}
+
+	for handler, ranges := range sourceRanges {
+		handlerSettings := settings[handler]
+		handlerSettings.LoadBalancerSourceRanges = sets.List(ranges)
+		settings[handler] = handlerSettings
+	}
+
+	// Ensure that the maximum is never lower than the minimum after merging the settings of different ExposureClasses.
+	for handler, handlerSettings := range settings {
+		if handlerSettings.MinReplicas != nil && handlerSettings.MaxReplicas != nil && *handlerSettings.MaxReplicas < *handlerSettings.MinReplicas {
+			handlerSettings.MaxReplicas = ptr.To(*handlerSettings.MinReplicas)
+			settings[handler] = handlerSettings
+		}
+	}
+
+	return settings, nil
+}
+
+func maxReplicas(current *int, replicas *int32) *int {
+	if replicas == nil {
+		return current
+	}
+	if current == nil {
+		return ptr.To(int(*replicas))
+	}
+	return ptr.To(slices.Max([]int{*current, int(*replicas)}))
+}
@@ -0,0 +1,116 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package seed_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/seed/seed"
+)
+
+var _ = Describe("ExposureClass", func() {
+	Describe("#ComputeExposureClassGatewaySettings", func() {
+		const seedName = "seed"
+
+		var (
+			ctx          context.Context
+			gardenClient client.Client
+
+			newShoot = func(name string, seedName, exposureClassName *string) *gardencorev1beta1.Shoot {
+				return &gardencorev1beta1.Shoot{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "garden-foo"},
+					Spec: gardencorev1beta1.ShootSpec{
+						SeedName:          seedName,
+						ExposureClassName: exposureClassName,
+					},
+				}
+			}
+		)
+
+		BeforeEach(func() {
+			ctx = context.Background()
+			gardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+		})
+
+		It("should return no settings if no shoot uses an ExposureClass", func() {
+			Expect(gardenClient.Create(ctx, newShoot("shoot1", ptr.To(seedName), nil))).To(Succeed())
+
+			Expect(ComputeExposureClassGatewaySettings(ctx, gardenClient, seedName)).To(BeEmpty())
+		})
+
+		It("should fail if a referenced ExposureClass does not exist", func() {
+			Expect(gardenClient.Create(ctx, newShoot("shoot1", ptr.To(seedName), ptr.To("foo")))).To(Succeed())
+
+			_, err := ComputeExposureClassGatewaySettings(ctx, gardenClient, seedName)
+			Expect(err).To(MatchError(ContainSubstring("failed reading ExposureClass foo")))
+		})
+
+		It("should merge the settings of all ExposureClasses used by shoots on the seed", func() {
+			for _, exposureClass := range []*gardencorev1beta1.ExposureClass{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "a"},
+					Handler:    "handler1",
+					LoadBalancerService: &gardencorev1beta1.ExposureClassLoadBalancerService{
+						Annotations:  map[string]string{"foo": "a", "bar": "a"},
+						SourceRanges: []string{"10.0.0.0/8", "192.168.0.0/16"},
+					},
+					IngressGateway: &gardencorev1beta1.ExposureClassIngressGateway{
+						MinReplicas: ptr.To[int32](3),
+						MaxReplicas: ptr.To[int32](4),
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "b"},
+					Handler:    "handler1",
+					LoadBalancerService: &gardencorev1beta1.ExposureClassLoadBalancerService{
+						Annotations:  map[string]string{"foo": "b"},
+						SourceRanges: []string{"10.0.0.0/8", "172.16.0.0/12"},
+					},
+					IngressGateway: &gardencorev1beta1.ExposureClassIngressGateway{
+						MinReplicas: ptr.To[int32](5),
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "c"},
+					Handler:    "handler2",
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "d"},
+					Handler:    "handler3",
+					LoadBalancerService: &gardencorev1beta1.ExposureClassLoadBalancerService{
+						Annotations: map[string]string{"baz": "d"},
+					},
+				},
+			} {
+				Expect(gardenClient.Create(ctx, exposureClass)).To(Succeed())
+			}
+
+			Expect(gardenClient.Create(ctx, newShoot("shoot1", ptr.To(seedName), ptr.To("b")))).To(Succeed())
+			Expect(gardenClient.Create(ctx, newShoot("shoot2", ptr.To(seedName), ptr.To("a")))).To(Succeed())
+			Expect(gardenClient.Create(ctx, newShoot("shoot3", ptr.To(seedName), ptr.To("c")))).To(Succeed())
+			Expect(gardenClient.Create(ctx, newShoot("shoot4", ptr.To("other-seed"), ptr.To("d")))).To(Succeed())
+
+			settings, err := ComputeExposureClassGatewaySettings(ctx, gardenClient, seedName)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(settings).To(HaveLen(1))
+			Expect(settings).To(HaveKeyWithValue("handler1", MatchAllFields(Fields{
+				"Annotations":              Equal(map[string]string{"foo": "b", "bar": "a"}),
+				"LoadBalancerSourceRanges": Equal([]string{"10.0.0.0/8", "172.16.0.0/12", "192.168.0.0/16"}),
+				"MinReplicas":              PointTo(Equal(5)),
+				"MaxReplicas":              PointTo(Equal(5)),
+			})))
+		})
+	})
+})
@@ -0,0 +1,88 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package seed
+
+import (
+	"fmt"
+	"time"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/utils/timewindow"
+)
+
+// MaintenanceGate describes the maintenance operations of a Seed which are postponed to its next maintenance time
+// window. Rolling the Istio ingress gateways and the nginx ingress controller as well as updating etcd-druid (which
+// rolls the etcds of all hosted shoots) are always postponed while a gate exists.
+type MaintenanceGate struct {
+	// NextWindowBegin is the beginning of the next maintenance time window of the Seed.
+	NextWindowBegin time.Time
+	// CurrentVersion is the version of the gardenlet which last deployed the seed system components.
+	CurrentVersion string
+	// PostponeSystemComponentsUpdate states whether the update of the seed system components to a new gardenlet
+	// version is postponed.
+	PostponeSystemComponentsUpdate bool
+}
+
+// ComputeMaintenanceGate returns the maintenance operations of the given Seed which must be postponed to its next
+// maintenance time window when reconciling it with the given gardenlet version. It returns nil if all operations can
+// be performed right away, i.e., if
+// - the Seed has no maintenance time window or is being deleted,
+// - the Seed specification was changed or a reconciliation was requested (generation not yet observed),
+// - the seed system components were not deployed yet,
+// - the given time is within the maintenance time window.
+func ComputeMaintenanceGate(seed *gardencorev1beta1.Seed, version string, now time.Time) (*MaintenanceGate, error) {
+	if seed.Spec.Maintenance == nil || seed.DeletionTimestamp != nil || seed.Generation != seed.Status.ObservedGeneration {
+		return nil, nil
+	}
+
+	currentVersion := SystemComponentsVersion(seed)
+	if currentVersion == "" {
+		return nil, nil
+	}
+
+	window, err := timewindow.ParseMaintenanceTimeWindow(seed.Spec.Maintenance.TimeWindow.Begin, seed.Spec.Maintenance.TimeWindow.End)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing maintenance time window of seed: %w", err)
+	}
+
+	begin, ok := NextMaintenanceTimeWindowBegin(window, now)
+	if !ok {
+		return nil, nil
+	}
+
+	return &MaintenanceGate{
+		NextWindowBegin:                begin,
+		CurrentVersion:                 currentVersion,
+		PostponeSystemComponentsUpdate: currentVersion != version,
+	}, nil
+}
+
+// NextMaintenanceTimeWindowBegin returns the beginning of the next occurrence of the given maintenance time window.
+// It returns false if the given time is within the maintenance time window.
+func NextMaintenanceTimeWindowBegin(window *timewindow.MaintenanceTimeWindow, now time.Time) (time.Time, bool) {
+	// timestamps are serialized with a precision of seconds
+	now = now.UTC().Truncate(time.Second)
+	if window.Contains(now) {
+		return time.Time{}, false
+	}
+
+	begin := window.AdjustedBegin(now)
+	if begin.Before(now) {
+		begin = begin.AddDate(0, 0, 1)
+	}
+	return begin, true
+}
+
+// SystemComponentsVersion returns the version of the gardenlet which last deployed the seed system components. Seeds
+// reconciled before this information was recorded fall back to the version of the gardenlet which last acted on them.
+func SystemComponentsVersion(seed *gardencorev1beta1.Seed) string {
+	if seed.Status.SystemComponentsVersion != nil {
+		return *seed.Status.SystemComponentsVersion
+	}
+	if seed.Status.Gardener != nil {
+		return seed.Status.Gardener.Version
+	}
+	return ""
+}
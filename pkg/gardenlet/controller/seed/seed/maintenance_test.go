@@ -0,0 +1,113 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package seed_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/seed/seed"
+)
+
+var _ = Describe("Maintenance", func() {
+	const (
+		currentVersion = "v1.100.0"
+		targetVersion  = "v1.101.0"
+	)
+
+	var (
+		seed *gardencorev1beta1.Seed
+		// inside the maintenance time window: 22:30 UTC
+		insideWindow = time.Date(2024, time.March, 1, 22, 30, 0, 0, time.UTC)
+		// outside the maintenance time window: 10:00 UTC
+		outsideWindow = time.Date(2024, time.March, 1, 10, 0, 0, 0, time.UTC)
+	)
+
+	BeforeEach(func() {
+		seed = &gardencorev1beta1.Seed{
+			ObjectMeta: metav1.ObjectMeta{Name: "seed", Generation: 2},
+			Spec: gardencorev1beta1.SeedSpec{
+				Maintenance: &gardencorev1beta1.SeedMaintenance{
+					TimeWindow: gardencorev1beta1.MaintenanceTimeWindow{Begin: "220000+0000", End: "230000+0000"},
+				},
+			},
+			Status: gardencorev1beta1.SeedStatus{
+				ObservedGeneration:      2,
+				SystemComponentsVersion: ptr.To(currentVersion),
+				LastOperation:           &gardencorev1beta1.LastOperation{State: gardencorev1beta1.LastOperationStateSucceeded},
+			},
+		}
+	})
+
+	Describe("#ComputeMaintenanceGate", func() {
+		It("should postpone the update to the beginning of the next maintenance time window", func() {
+			Expect(ComputeMaintenanceGate(seed, targetVersion, outsideWindow)).To(PointTo(Equal(MaintenanceGate{
+				NextWindowBegin:                time.Date(2024, time.March, 1, 22, 0, 0, 0, time.UTC),
+				CurrentVersion:                 currentVersion,
+				PostponeSystemComponentsUpdate: true,
+			})))
+		})
+
+		It("should postpone the update to the next day if the maintenance time window already passed", func() {
+			Expect(ComputeMaintenanceGate(seed, targetVersion, time.Date(2024, time.March, 1, 23, 30, 0, 0, time.UTC))).To(PointTo(MatchFields(IgnoreExtras, Fields{
+				"NextWindowBegin":                Equal(time.Date(2024, time.March, 2, 22, 0, 0, 0, time.UTC)),
+				"PostponeSystemComponentsUpdate": BeTrue(),
+			})))
+		})
+
+		It("should fall back to the version of the gardenlet which last acted on the seed", func() {
+			seed.Status.SystemComponentsVersion = nil
+			seed.Status.Gardener = &gardencorev1beta1.Gardener{Version: currentVersion}
+
+			Expect(ComputeMaintenanceGate(seed, targetVersion, outsideWindow)).To(PointTo(MatchFields(IgnoreExtras, Fields{
+				"CurrentVersion":                 Equal(currentVersion),
+				"PostponeSystemComponentsUpdate": BeTrue(),
+			})))
+		})
+
+		It("should postpone the disruptive operations even if the system components are already deployed with the version", func() {
+			seed.Status.SystemComponentsVersion = ptr.To(targetVersion)
+
+			Expect(ComputeMaintenanceGate(seed, targetVersion, outsideWindow)).To(PointTo(MatchFields(IgnoreExtras, Fields{
+				"NextWindowBegin":                Equal(time.Date(2024, time.March, 1, 22, 0, 0, 0, time.UTC)),
+				"PostponeSystemComponentsUpdate": BeFalse(),
+			})))
+		})
+
+		It("should postpone the update even if the last operation failed", func() {
+			seed.Status.LastOperation.State = gardencorev1beta1.LastOperationStateError
+
+			Expect(ComputeMaintenanceGate(seed, targetVersion, outsideWindow)).To(PointTo(MatchFields(IgnoreExtras, Fields{
+				"PostponeSystemComponentsUpdate": BeTrue(),
+			})))
+		})
+
+		DescribeTable("should not postpone any operation",
+			func(mutate func(), now time.Time) {
+				mutate()
+				Expect(ComputeMaintenanceGate(seed, targetVersion, now)).To(BeNil())
+			},
+
+			Entry("inside the maintenance time window", func() {}, insideWindow),
+			Entry("without maintenance time window", func() { seed.Spec.Maintenance = nil }, outsideWindow),
+			Entry("when the seed is being deleted", func() { seed.DeletionTimestamp = &metav1.Time{} }, outsideWindow),
+			Entry("when the generation was not observed yet", func() { seed.Generation = 3 }, outsideWindow),
+			Entry("when the system components were not deployed yet", func() { seed.Status.SystemComponentsVersion = nil }, outsideWindow),
+		)
+
+		It("should fail if the maintenance time window cannot be parsed", func() {
+			seed.Spec.Maintenance.TimeWindow.Begin = "invalid"
+
+			_, err := ComputeMaintenanceGate(seed, targetVersion, outsideWindow)
+			Expect(err).To(MatchError(ContainSubstring("failed parsing maintenance time window of seed")))
+		})
+	})
+})
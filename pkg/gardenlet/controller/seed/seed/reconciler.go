@@ -23,6 +23,7 @@ import (
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
 	seedpkg "github.com/gardener/gardener/pkg/gardenlet/operation/seed"
@@ -31,6 +32,7 @@ import (
 	gardenletutils "github.com/gardener/gardener/pkg/utils/gardener/gardenlet"
 	"github.com/gardener/gardener/pkg/utils/imagevector"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
 )
 
 // Reconciler reconciles Seed resources and provisions or de-provisions the seed system components.
@@ -45,6 +47,7 @@ type Reconciler struct {
 	ComponentImageVectors                imagevector.ComponentImageVectors
 	ClientCertificateExpirationTimestamp *metav1.Time
 	GardenNamespace                      string
+	CapacityReporters                    []CapacityReporter
 }
 
 // Reconcile reconciles Seed resources and provisions or de-provisions the seed system components.
@@ -73,6 +76,14 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		operationType = gardencorev1beta1.LastOperationTypeDelete
 	}
 
+	gate, err := ComputeMaintenanceGate(seed, r.Identity.Version, r.Clock.Now())
+	if err != nil {
+		return reconcile.Result{}, r.updateStatusOperationError(ctx, seed, err, operationType)
+	}
+	if gate != nil {
+		log.Info("Postponing maintenance operations to next maintenance time window", "scheduledTime", gate.NextWindowBegin, "systemComponentsUpdate", gate.PostponeSystemComponentsUpdate)
+	}
+
 	if err := r.updateStatusOperationStart(ctx, seed, operationType); err != nil {
 		return reconcile.Result{}, r.updateStatusOperationError(ctx, seed, err, operationType)
 	}
@@ -113,11 +124,16 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return result, nil
 	}
 
-	if err := r.reconcile(ctx, log, seedObj, seedIsGarden, isManagedSeed); err != nil {
+	if err := r.reconcile(ctx, log, seedObj, seedIsGarden, isManagedSeed, gate); err != nil {
 		return reconcile.Result{}, r.updateStatusOperationError(ctx, seed, err, operationType)
 	}
 
-	return reconcile.Result{RequeueAfter: r.Config.Controllers.Seed.SyncPeriod.Duration}, r.updateStatusOperationSuccess(ctx, seed, operationType)
+	requeueAfter := r.Config.Controllers.Seed.SyncPeriod.Duration
+	if gate != nil {
+		requeueAfter = min(gate.NextWindowBegin.Sub(r.Clock.Now()), requeueAfter)
+	}
+
+	return reconcile.Result{RequeueAfter: requeueAfter}, r.updateStatusOperationSuccess(ctx, seed, operationType, gate)
 }
 
 func (r *Reconciler) reportProgress(log logr.Logger, seed *gardencorev1beta1.Seed) flow.ProgressReporter {
@@ -157,27 +173,20 @@ func (r *Reconciler) updateStatusOperationStart(ctx context.Context, seed *garde
 		Description:    description,
 		LastUpdateTime: now,
 	}
+	// Record the version which deployed the seed system components before the gardenlet version is updated, so that it
+	// does not get lost if the reconciliation fails.
+	if currentVersion := SystemComponentsVersion(seed); seed.Status.SystemComponentsVersion == nil && currentVersion != "" {
+		seed.Status.SystemComponentsVersion = &currentVersion
+	}
 	seed.Status.Gardener = r.Identity
 	seed.Status.ObservedGeneration = seed.Generation
 	seed.Status.ClientCertificateExpirationTimestamp = r.ClientCertificateExpirationTimestamp
 	seed.Status.KubernetesVersion = ptr.To(r.SeedClientSet.Version())
 
 	// Initialize capacity and allocatable
-	var capacity, allocatable corev1.ResourceList
-	if r.Config.Resources != nil && len(r.Config.Resources.Capacity) > 0 {
-		capacity = make(corev1.ResourceList, len(r.Config.Resources.Capacity))
-		allocatable = make(corev1.ResourceList, len(r.Config.Resources.Capacity))
-
-		for resourceName, quantity := range r.Config.Resources.Capacity {
-			capacity[resourceName] = quantity
-			allocatable[resourceName] = quantity
-
-			if reservedQuantity, ok := r.Config.Resources.Reserved[resourceName]; ok {
-				allocatableQuantity := quantity.DeepCopy()
-				allocatableQuantity.Sub(reservedQuantity)
-				allocatable[resourceName] = allocatableQuantity
-			}
-		}
+	capacity, allocatable, err := ComputeCapacityAndAllocatable(ctx, r.Config.Resources, r.CapacityReporters)
+	if err != nil {
+		return fmt.Errorf("failed computing capacity of seed: %w", err)
 	}
 
 	if capacity != nil {
@@ -187,20 +196,47 @@ func (r *Reconciler) updateStatusOperationStart(ctx context.Context, seed *garde
 		seed.Status.Allocatable = allocatable
 	}
 
-	return r.GardenClient.Status().Update(ctx, seed)
+	var mustRemoveOperationAnnotation bool
+
+	switch seed.Annotations[v1beta1constants.GardenerOperation] {
+	case v1beta1constants.OperationRotateCAStart:
+		mustRemoveOperationAnnotation = true
+		startRotationCA(seed, &now)
+	case v1beta1constants.OperationRotateCAComplete:
+		mustRemoveOperationAnnotation = true
+		completeRotationCA(seed, &now)
+	}
+
+	if err := r.GardenClient.Status().Update(ctx, seed); err != nil {
+		return err
+	}
+
+	if mustRemoveOperationAnnotation {
+		patch := client.MergeFrom(seed.DeepCopy())
+		delete(seed.Annotations, v1beta1constants.GardenerOperation)
+		return r.GardenClient.Patch(ctx, seed, patch)
+	}
+
+	return nil
 }
 
-func (r *Reconciler) updateStatusOperationSuccess(ctx context.Context, seed *gardencorev1beta1.Seed, operationType gardencorev1beta1.LastOperationType) error {
+func (r *Reconciler) updateStatusOperationSuccess(ctx context.Context, seed *gardencorev1beta1.Seed, operationType gardencorev1beta1.LastOperationType, gate *MaintenanceGate) error {
 	var (
 		now                        = metav1.NewTime(r.Clock.Now().UTC())
 		description                string
 		setConditionsToProgressing bool
+		postponeUpdate             = gate != nil && gate.PostponeSystemComponentsUpdate
 	)
 
 	switch operationType {
 	case gardencorev1beta1.LastOperationTypeReconcile:
 		description = "Seed cluster has been successfully reconciled."
-		setConditionsToProgressing = true
+		setConditionsToProgressing = !postponeUpdate
+		if postponeUpdate {
+			description = fmt.Sprintf("Seed cluster has been reconciled, the update of the seed system components from gardenlet version %s to %s is postponed to the next maintenance time window.", gate.CurrentVersion, r.Identity.Version)
+		} else if gate != nil {
+			description = "Seed cluster has been successfully reconciled, Istio, the nginx ingress controller and etcd-druid are only updated within the maintenance time window."
+		}
 	case gardencorev1beta1.LastOperationTypeDelete:
 		description = "Seed cluster has been successfully deleted."
 		setConditionsToProgressing = false
@@ -227,6 +263,38 @@ func (r *Reconciler) updateStatusOperationSuccess(ctx context.Context, seed *gar
 		}
 	}
 
+	switch v1beta1helper.GetSeedCARotationPhase(seed.Status.Credentials) {
+	case gardencorev1beta1.RotationPreparing:
+		v1beta1helper.MutateSeedCARotation(seed, func(rotation *gardencorev1beta1.CARotation) {
+			rotation.Phase = gardencorev1beta1.RotationPrepared
+			rotation.LastInitiationFinishedTime = &now
+		})
+
+	case gardencorev1beta1.RotationCompleting:
+		v1beta1helper.MutateSeedCARotation(seed, func(rotation *gardencorev1beta1.CARotation) {
+			rotation.Phase = gardencorev1beta1.RotationCompleted
+			rotation.LastCompletionTime = &now
+			rotation.LastInitiationFinishedTime = nil
+			rotation.LastCompletionTriggeredTime = nil
+		})
+	}
+
+	if operationType == gardencorev1beta1.LastOperationTypeReconcile {
+		if postponeUpdate {
+			seed.Status.SystemComponentsVersion = ptr.To(gate.CurrentVersion)
+			seed.Status.PendingMaintenanceOperations = []gardencorev1beta1.SeedPendingMaintenanceOperation{{
+				Type:           gardencorev1beta1.SeedPendingMaintenanceOperationSystemComponentsUpdate,
+				CurrentVersion: ptr.To(gate.CurrentVersion),
+				TargetVersion:  ptr.To(r.Identity.Version),
+				Description:    fmt.Sprintf("Seed system components will be updated from gardenlet version %s to %s.", gate.CurrentVersion, r.Identity.Version),
+				ScheduledTime:  metav1.NewTime(gate.NextWindowBegin),
+			}}
+		} else {
+			seed.Status.SystemComponentsVersion = ptr.To(r.Identity.Version)
+			seed.Status.PendingMaintenanceOperations = nil
+		}
+	}
+
 	seed.Status.LastOperation = &gardencorev1beta1.LastOperation{
 		Type:           operationType,
 		State:          gardencorev1beta1.LastOperationStateSucceeded,
@@ -257,6 +325,49 @@ func (r *Reconciler) updateStatusOperationError(ctx context.Context, seed *garde
 	return err
 }
 
+func startRotationCA(seed *gardencorev1beta1.Seed, now *metav1.Time) {
+	v1beta1helper.MutateSeedCARotation(seed, func(rotation *gardencorev1beta1.CARotation) {
+		rotation.Phase = gardencorev1beta1.RotationPreparing
+		rotation.LastInitiationTime = now
+		rotation.LastInitiationFinishedTime = nil
+		rotation.LastCompletionTriggeredTime = nil
+	})
+}
+
+func completeRotationCA(seed *gardencorev1beta1.Seed, now *metav1.Time) {
+	v1beta1helper.MutateSeedCARotation(seed, func(rotation *gardencorev1beta1.CARotation) {
+		rotation.Phase = gardencorev1beta1.RotationCompleting
+		rotation.LastCompletionTriggeredTime = now
+	})
+}
+
+func lastSecretRotationStartTimes(seed *gardencorev1beta1.Seed) map[string]time.Time {
+	rotation := make(map[string]time.Time)
+
+	if seedStatus := seed.Status; seedStatus.Credentials != nil && seedStatus.Credentials.Rotation != nil {
+		if seedStatus.Credentials.Rotation.CertificateAuthorities != nil && seedStatus.Credentials.Rotation.CertificateAuthorities.LastInitiationTime != nil {
+			rotation[v1beta1constants.SecretNameCASeed] = seedStatus.Credentials.Rotation.CertificateAuthorities.LastInitiationTime.Time
+		}
+	}
+
+	return rotation
+}
+
+func caCertGenerateOptionsFor(rotationPhase gardencorev1beta1.CredentialsRotationPhase) []secretsmanager.GenerateOption {
+	options := []secretsmanager.GenerateOption{secretsmanager.Rotate(secretsmanager.KeepOld)}
+
+	switch rotationPhase {
+	case gardencorev1beta1.RotationCompleting:
+		options = append(options, secretsmanager.IgnoreOldSecrets())
+	case "", gardencorev1beta1.RotationCompleted:
+		// When no manual rotation is in progress, the old CA is dropped 24 hours after its automatic rotation. During a
+		// manual rotation, the old CA is kept in the bundle until the rotation is completed.
+		options = append(options, secretsmanager.IgnoreOldSecretsAfter(24*time.Hour))
+	}
+
+	return options
+}
+
 // determineClusterIdentity determines the identity of a cluster, in cases where the identity was
 // created manually or the Seed was created as Shoot, and later registered as Seed and already has
 // an identity, it should not be changed.
@@ -177,6 +177,10 @@ func (r *Reconciler) runDeleteSeedFlow(
 			Name: "Destroy VPN authorization server",
 			Fn:   component.OpDestroyAndWait(c.vpnAuthzServer).Destroy,
 		})
+		destroyImagePrePuller = g.Add(flow.Task{
+			Name: "Destroy image pre-puller",
+			Fn:   component.OpDestroyAndWait(c.imagePrePuller).Destroy,
+		})
 		destroyIstio = g.Add(flow.Task{
 			Name: "Destroy Istio",
 			Fn:   component.OpDestroyAndWait(c.istio).Destroy,
@@ -250,6 +254,7 @@ func (r *Reconciler) runDeleteSeedFlow(
 			destroyKubeAPIServerIngress,
 			destroyKubeAPIServerService,
 			destroyVPNAuthzServer,
+			destroyImagePrePuller,
 			destroyIstio,
 			destroyFluentOperatorResources,
 			destroyPrometheusOperator,
@@ -278,6 +283,7 @@ func (r *Reconciler) runDeleteSeedFlow(
 		destroyMachineControllerManagerCRDs = g.Add(flow.Task{
 			Name:         "Destroy machine-controller-manager CRDs",
 			Fn:           component.OpDestroyAndWait(c.machineCRD).Destroy,
+			SkipIf:       seedIsGarden,
 			Dependencies: flow.NewTaskIDs(ensureNoControllerInstallationsExist),
 		})
 		destroyEtcdCRD = g.Add(flow.Task{
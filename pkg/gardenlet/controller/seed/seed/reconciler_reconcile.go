@@ -27,6 +27,7 @@ import (
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
 	"github.com/gardener/gardener/pkg/component"
 	"github.com/gardener/gardener/pkg/component/clusteridentity"
@@ -53,6 +54,7 @@ func (r *Reconciler) reconcile(
 	seedObj *seedpkg.Seed,
 	seedIsGarden bool,
 	isManagedSeed bool,
+	gate *MaintenanceGate,
 ) error {
 	seed := seedObj.GetInfo()
 
@@ -68,7 +70,7 @@ func (r *Reconciler) reconcile(
 		return err
 	}
 
-	if err := r.runReconcileSeedFlow(ctx, log, seedObj, seedIsGarden, isManagedSeed); err != nil {
+	if err := r.runReconcileSeedFlow(ctx, log, seedObj, seedIsGarden, isManagedSeed, gate); err != nil {
 		return err
 	}
 
@@ -103,6 +105,7 @@ func (r *Reconciler) runReconcileSeedFlow(
 	seed *seedpkg.Seed,
 	seedIsGarden bool,
 	isManagedSeed bool,
+	gate *MaintenanceGate,
 ) error {
 	// VPA is a prerequisite. If it's enabled then we deploy the CRD (and later also the related components) as part of
 	// the flow. However, when it's disabled then we check whether it is indeed available (and fail, otherwise).
@@ -145,21 +148,24 @@ func (r *Reconciler) runReconcileSeedFlow(
 		r.SeedClientSet.Client(),
 		r.GardenNamespace,
 		v1beta1constants.SecretManagerIdentityGardenlet,
-		secretsmanager.Config{CASecretAutoRotation: true},
+		secretsmanager.Config{
+			CASecretAutoRotation: true,
+			SecretNamesToTimes:   lastSecretRotationStartTimes(seed.GetInfo()),
+		},
 	)
 	if err != nil {
 		return err
 	}
 
 	// Deploy dedicated CA certificate for seed cluster, auto-rotate it roughly once a month and drop the old CA 24 hours
-	// after rotation.
+	// after rotation. When a CA rotation is triggered manually, the old CA is kept until the rotation is completed.
 	log.Info("Generating CA certificates for seed cluster")
 	if _, err := secretsManager.Generate(ctx, &secretsutils.CertificateSecretConfig{
 		Name:       v1beta1constants.SecretNameCASeed,
 		CommonName: "kubernetes",
 		CertType:   secretsutils.CACert,
 		Validity:   ptr.To(30 * 24 * time.Hour),
-	}, secretsmanager.Rotate(secretsmanager.KeepOld), secretsmanager.IgnoreOldSecretsAfter(24*time.Hour)); err != nil {
+	}, caCertGenerateOptionsFor(v1beta1helper.GetSeedCARotationPhase(seed.GetInfo().Status.Credentials))...); err != nil {
 		return err
 	}
 
@@ -202,6 +208,14 @@ func (r *Reconciler) runReconcileSeedFlow(
 	}
 
 	var (
+		// While the update of the seed system components is postponed, only the components deployed by the new
+		// gardenlet version are skipped. Everything else (e.g., custom resource definitions, secrets, DNS records, or
+		// the renewal of credentials) is still reconciled.
+		postponeSystemComponentsUpdate = gate != nil && gate.PostponeSystemComponentsUpdate
+		// Rolling the Istio ingress gateways and the nginx ingress controller as well as updating etcd-druid (which rolls
+		// the etcds of all hosted shoots) is only done within the maintenance time window.
+		postponeDisruptiveOperations = gate != nil
+
 		g = flow.NewGraph("Seed reconciliation")
 
 		deployMachineCRD = g.Add(flow.Task{
@@ -258,7 +272,7 @@ func (r *Reconciler) runReconcileSeedFlow(
 			Name:         "Deploying and waiting for gardener-resource-manager to be healthy",
 			Fn:           component.OpWait(c.gardenerResourceManager).Deploy,
 			Dependencies: flow.NewTaskIDs(syncPointCRDs),
-			SkipIf:       seedIsGarden,
+			SkipIf:       seedIsGarden || postponeSystemComponentsUpdate,
 		})
 		deploySystemResources = g.Add(flow.Task{
 			Name:         "Deploying system resources",
@@ -302,6 +316,7 @@ func (r *Reconciler) runReconcileSeedFlow(
 			Name:         "Deploying Istio",
 			Fn:           c.istio.Deploy,
 			Dependencies: flow.NewTaskIDs(syncPointReadyForSystemComponents),
+			SkipIf:       postponeDisruptiveOperations,
 		})
 		_ = g.Add(flow.Task{
 			Name: "Waiting until istio LoadBalancer is ready and managed ingress DNS record is reconciled",
@@ -313,6 +328,7 @@ func (r *Reconciler) runReconcileSeedFlow(
 				return component.OpWait(ingressDNSRecord).Deploy(ctx)
 			},
 			Dependencies: flow.NewTaskIDs(deployIstio),
+			SkipIf:       postponeDisruptiveOperations,
 		})
 		_ = g.Add(flow.Task{
 			Name:         "Deploying cluster-autoscaler resources",
@@ -328,16 +344,25 @@ func (r *Reconciler) runReconcileSeedFlow(
 			Name:         "Deploying dependency-watchdog-weeder",
 			Fn:           c.dwdWeeder.Deploy,
 			Dependencies: flow.NewTaskIDs(syncPointReadyForSystemComponents),
+			SkipIf:       postponeSystemComponentsUpdate,
 		})
 		_ = g.Add(flow.Task{
 			Name:         "Deploying dependency-watchdog-prober",
 			Fn:           c.dwdProber.Deploy,
 			Dependencies: flow.NewTaskIDs(syncPointReadyForSystemComponents),
+			SkipIf:       postponeSystemComponentsUpdate,
 		})
 		_ = g.Add(flow.Task{
 			Name:         "Deploying VPN authorization server",
 			Fn:           c.vpnAuthzServer.Deploy,
 			Dependencies: flow.NewTaskIDs(syncPointReadyForSystemComponents),
+			SkipIf:       postponeSystemComponentsUpdate,
+		})
+		_ = g.Add(flow.Task{
+			Name:         "Deploying image pre-puller",
+			Fn:           c.imagePrePuller.Deploy,
+			Dependencies: flow.NewTaskIDs(syncPointReadyForSystemComponents),
+			SkipIf:       postponeSystemComponentsUpdate,
 		})
 		_ = g.Add(flow.Task{
 			Name: "Renewing garden access secrets",
@@ -398,72 +423,77 @@ func (r *Reconciler) runReconcileSeedFlow(
 			Name:         "Deploying Kubernetes vertical pod autoscaler",
 			Fn:           c.verticalPodAutoscaler.Deploy,
 			Dependencies: flow.NewTaskIDs(syncPointReadyForSystemComponents),
-			SkipIf:       seedIsGarden,
+			SkipIf:       seedIsGarden || postponeSystemComponentsUpdate,
 		})
 		_ = g.Add(flow.Task{
 			Name:         "Deploying ETCD Druid",
 			Fn:           c.etcdDruid.Deploy,
 			Dependencies: flow.NewTaskIDs(syncPointReadyForSystemComponents),
-			SkipIf:       seedIsGarden,
+			SkipIf:       seedIsGarden || postponeDisruptiveOperations,
 		})
 
 		_ = g.Add(flow.Task{
 			Name:         "Deploying kube-state-metrics",
 			Fn:           c.kubeStateMetrics.Deploy,
 			Dependencies: flow.NewTaskIDs(syncPointReadyForSystemComponents),
+			SkipIf:       postponeSystemComponentsUpdate,
 		})
 		deployFluentOperator = g.Add(flow.Task{
 			Name:         "Deploying Fluent Operator",
 			Fn:           c.fluentOperator.Deploy,
 			Dependencies: flow.NewTaskIDs(syncPointReadyForSystemComponents),
-			SkipIf:       seedIsGarden,
+			SkipIf:       seedIsGarden || postponeSystemComponentsUpdate,
 		})
 		_ = g.Add(flow.Task{
 			Name:         "Deploying Fluent Bit",
 			Fn:           c.fluentBit.Deploy,
 			Dependencies: flow.NewTaskIDs(syncPointReadyForSystemComponents, deployFluentOperator),
-			SkipIf:       seedIsGarden,
+			SkipIf:       seedIsGarden || postponeSystemComponentsUpdate,
 		})
 		_ = g.Add(flow.Task{
 			Name:         "Deploying Fluent Operator custom resources",
 			Fn:           c.fluentOperatorCustomResources.Deploy,
 			Dependencies: flow.NewTaskIDs(syncPointReadyForSystemComponents, deployFluentOperator),
-			SkipIf:       seedIsGarden,
+			SkipIf:       seedIsGarden || postponeSystemComponentsUpdate,
 		})
 		_ = g.Add(flow.Task{
 			Name:         "Deploying Plutono",
 			Fn:           c.plutono.Deploy,
 			Dependencies: flow.NewTaskIDs(syncPointReadyForSystemComponents),
-			SkipIf:       seedIsGarden,
+			SkipIf:       seedIsGarden || postponeSystemComponentsUpdate,
 		})
 		_ = g.Add(flow.Task{
 			Name:         "Deploying Vali",
 			Fn:           c.vali.Deploy,
 			Dependencies: flow.NewTaskIDs(syncPointReadyForSystemComponents),
-			SkipIf:       seedIsGarden,
+			SkipIf:       seedIsGarden || postponeSystemComponentsUpdate,
 		})
 		_ = g.Add(flow.Task{
 			Name:         "Deploying Prometheus Operator",
 			Fn:           c.prometheusOperator.Deploy,
 			Dependencies: flow.NewTaskIDs(syncPointReadyForSystemComponents),
-			SkipIf:       seedIsGarden,
+			SkipIf:       seedIsGarden || postponeSystemComponentsUpdate,
 		})
 		_ = g.Add(flow.Task{
 			Name:         "Deploying cache Prometheus",
 			Fn:           c.cachePrometheus.Deploy,
 			Dependencies: flow.NewTaskIDs(syncPointReadyForSystemComponents),
+			SkipIf:       postponeSystemComponentsUpdate,
 		})
 		_ = g.Add(flow.Task{
-			Name: "Deploying seed Prometheus",
-			Fn:   c.seedPrometheus.Deploy,
+			Name:   "Deploying seed Prometheus",
+			Fn:     c.seedPrometheus.Deploy,
+			SkipIf: postponeSystemComponentsUpdate,
 		})
 		_ = g.Add(flow.Task{
-			Name: "Deploying aggregate Prometheus",
-			Fn:   c.aggregatePrometheus.Deploy,
+			Name:   "Deploying aggregate Prometheus",
+			Fn:     c.aggregatePrometheus.Deploy,
+			SkipIf: postponeSystemComponentsUpdate,
 		})
 		_ = g.Add(flow.Task{
-			Name: "Deploying Alertmanager",
-			Fn:   c.alertManager.Deploy,
+			Name:   "Deploying Alertmanager",
+			Fn:     c.alertManager.Deploy,
+			SkipIf: postponeSystemComponentsUpdate,
 		})
 	)
 
@@ -474,6 +504,12 @@ func (r *Reconciler) runReconcileSeedFlow(
 		return flow.Errors(err)
 	}
 
+	// The skipped components might still use secrets which were not generated in this reconciliation, hence they must
+	// not be cleaned up.
+	if postponeDisruptiveOperations {
+		return nil
+	}
+
 	return secretsManager.Cleanup(ctx)
 }
 
@@ -506,7 +542,8 @@ func deployBackupBucketInGarden(ctx context.Context, k8sGardenClient client.Clie
 				Name:      seed.Spec.Backup.SecretRef.Name,
 				Namespace: seed.Spec.Backup.SecretRef.Namespace,
 			},
-			SeedName: &seed.Name, // In future this will be moved to gardener-scheduler.
+			SeedName:     &seed.Name, // In future this will be moved to gardener-scheduler.
+			Immutability: seed.Spec.Backup.Immutability,
 		}
 		return nil
 	})
@@ -21,6 +21,7 @@ import (
 	"github.com/gardener/gardener/pkg/client/kubernetes/clientmap"
 	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/care"
+	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/hibernationcleanup"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/shoot"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/state"
 )
@@ -69,6 +70,15 @@ func AddToManager(
 		return fmt.Errorf("failed adding care reconciler: %w", err)
 	}
 
+	if cfg.Controllers.ShootHibernationCleanup != nil && ptr.Deref(cfg.Controllers.ShootHibernationCleanup.ConcurrentSyncs, 0) > 0 {
+		if err := (&hibernationcleanup.Reconciler{
+			Config:   *cfg.Controllers.ShootHibernationCleanup,
+			SeedName: cfg.SeedConfig.Name,
+		}).AddToManager(mgr, gardenCluster, seedCluster); err != nil {
+			return fmt.Errorf("failed adding hibernation cleanup reconciler: %w", err)
+		}
+	}
+
 	// If gardenlet is responsible for an unmanaged seed we want to add the state reconciler which performs periodic
 	// backups of shoot states (see GEP-22).
 	if shootStateControllerEnabled {
@@ -15,6 +15,7 @@ import (
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/selection"
@@ -25,11 +26,13 @@ import (
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
 	"github.com/gardener/gardener/pkg/component/gardener/resourcemanager"
 	"github.com/gardener/gardener/pkg/gardenlet/operation/botanist/matchers"
 	"github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
 	"github.com/gardener/gardener/pkg/utils"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
 	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
 )
@@ -44,6 +47,9 @@ const (
 	// Any webhook on lease resources in kube-system namespace with a larger timeout can break leader election of essential
 	// control plane controllers.
 	WebhookMaximumTimeoutSecondsNotProblematicForLeases = 3
+	// PodCIDRUtilizationThresholdPercentage is the percentage of allocated IP addresses in the pod CIDR (or in the part
+	// of the pod CIDR used in a zone) above which the pod CIDR utilization is not considered acceptable anymore.
+	PodCIDRUtilizationThresholdPercentage = 80
 )
 
 func shootHibernatedConstraints(clock clock.Clock, conditions ...gardencorev1beta1.Condition) []gardencorev1beta1.Condition {
@@ -118,6 +124,20 @@ func (c *Constraint) constraintsChecks(
 		constraints.caCertificateValiditiesAcceptable = v1beta1helper.UpdatedConditionWithClock(c.clock, constraints.caCertificateValiditiesAcceptable, status, reason, message, errorCodes...)
 	}
 
+	status, reason, message, err = c.CheckIfPodCIDRUtilizationAcceptable(ctx)
+	if err != nil {
+		constraints.podCIDRUtilizationAcceptable = v1beta1helper.UpdatedConditionUnknownErrorWithClock(c.clock, constraints.podCIDRUtilizationAcceptable, err)
+	} else {
+		constraints.podCIDRUtilizationAcceptable = v1beta1helper.UpdatedConditionWithClock(c.clock, constraints.podCIDRUtilizationAcceptable, status, reason, message)
+	}
+
+	status, reason, message, err = c.CheckIfBackupImmutabilityConfigured(ctx)
+	if err != nil {
+		constraints.backupImmutabilityConfigured = v1beta1helper.UpdatedConditionUnknownErrorWithClock(c.clock, constraints.backupImmutabilityConfigured, err)
+	} else {
+		constraints.backupImmutabilityConfigured = v1beta1helper.UpdatedConditionWithClock(c.clock, constraints.backupImmutabilityConfigured, status, reason, message)
+	}
+
 	// Now check constraints depending on the shoot's kube-apiserver to be up and running
 	shootClient, apiServerRunning, err := c.initializeShootClients()
 	if err != nil {
@@ -129,14 +149,14 @@ func (c *Constraint) constraintsChecks(
 
 		return filterOptionalConstraints(
 			[]gardencorev1beta1.Condition{constraints.hibernationPossible, constraints.maintenancePreconditionsSatisfied},
-			[]gardencorev1beta1.Condition{constraints.caCertificateValiditiesAcceptable},
+			[]gardencorev1beta1.Condition{constraints.caCertificateValiditiesAcceptable, constraints.podCIDRUtilizationAcceptable, constraints.backupImmutabilityConfigured},
 		)
 	}
 	if !apiServerRunning {
 		// don't check constraints if API server has already been deleted or has not been created yet
 		return filterOptionalConstraints(
 			shootControlPlaneNotRunningConstraints(c.clock, constraints.hibernationPossible, constraints.maintenancePreconditionsSatisfied),
-			[]gardencorev1beta1.Condition{constraints.caCertificateValiditiesAcceptable},
+			[]gardencorev1beta1.Condition{constraints.caCertificateValiditiesAcceptable, constraints.podCIDRUtilizationAcceptable, constraints.backupImmutabilityConfigured},
 		)
 	}
 	c.shootClient = shootClient.Client()
@@ -157,9 +177,16 @@ func (c *Constraint) constraintsChecks(
 		constraints.crdsWithProblematicConversionWebhooks = v1beta1helper.UpdatedConditionWithClock(c.clock, constraints.crdsWithProblematicConversionWebhooks, status, reason, message)
 	}
 
+	status, reason, message, err = c.CheckIfDualStackNodesMigrationReady(ctx)
+	if err != nil {
+		constraints.dualStackNodesMigrationReady = v1beta1helper.UpdatedConditionUnknownErrorWithClock(c.clock, constraints.dualStackNodesMigrationReady, err)
+	} else {
+		constraints.dualStackNodesMigrationReady = v1beta1helper.UpdatedConditionWithClock(c.clock, constraints.dualStackNodesMigrationReady, status, reason, message)
+	}
+
 	return filterOptionalConstraints(
 		[]gardencorev1beta1.Condition{constraints.hibernationPossible, constraints.maintenancePreconditionsSatisfied},
-		[]gardencorev1beta1.Condition{constraints.caCertificateValiditiesAcceptable, constraints.crdsWithProblematicConversionWebhooks},
+		[]gardencorev1beta1.Condition{constraints.caCertificateValiditiesAcceptable, constraints.podCIDRUtilizationAcceptable, constraints.backupImmutabilityConfigured, constraints.crdsWithProblematicConversionWebhooks, constraints.dualStackNodesMigrationReady},
 	)
 }
 
@@ -238,6 +265,116 @@ func (c *Constraint) CheckIfCACertificateValiditiesAcceptable(ctx context.Contex
 		nil
 }
 
+// CheckIfPodCIDRUtilizationAcceptable checks whether the utilization of the pod CIDR (as reported by the network
+// extension in the status of the Network resource) is below PodCIDRUtilizationThresholdPercentage, both for the whole
+// pod CIDR and for each zone.
+func (c *Constraint) CheckIfPodCIDRUtilizationAcceptable(ctx context.Context) (gardencorev1beta1.ConditionStatus, string, string, error) {
+	network := &extensionsv1alpha1.Network{}
+	if err := c.seedClient.Get(ctx, client.ObjectKey{Namespace: c.shoot.SeedNamespace, Name: c.shoot.GetInfo().Name}, network); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return "", "", "", fmt.Errorf("could not get Network resource to check the pod CIDR utilization: %w", err)
+		}
+	}
+
+	utilization := network.Status.PodCIDRUtilization
+	if utilization == nil {
+		return gardencorev1beta1.ConditionTrue,
+			"PodCIDRUtilizationUnknown",
+			"The network extension does not report the utilization of the pod CIDR.",
+			nil
+	}
+
+	var exhausted []string
+	if isIPAddressUtilizationCritical(utilization.IPAddressUtilization) {
+		exhausted = append(exhausted, fmt.Sprintf("pod CIDR %s (%d/%d IP addresses allocated)", network.Spec.PodCIDR, utilization.Allocated, utilization.Capacity))
+	}
+	for _, zone := range utilization.Zones {
+		if isIPAddressUtilizationCritical(zone.IPAddressUtilization) {
+			exhausted = append(exhausted, fmt.Sprintf("zone %q (%d/%d IP addresses allocated)", zone.Name, zone.Allocated, zone.Capacity))
+		}
+	}
+
+	if len(exhausted) > 0 {
+		return gardencorev1beta1.ConditionFalse,
+			"PodCIDRUtilizationHigh",
+			fmt.Sprintf("More than %d%% of the IP addresses available for pods are allocated, the cluster might not be able to scale further: %s", PodCIDRUtilizationThresholdPercentage, strings.Join(exhausted, ", ")),
+			nil
+	}
+
+	return gardencorev1beta1.ConditionTrue,
+		"PodCIDRUtilizationAcceptable",
+		fmt.Sprintf("Less than %d%% of the IP addresses available for pods are allocated.", PodCIDRUtilizationThresholdPercentage),
+		nil
+}
+
+// CheckIfBackupImmutabilityConfigured checks whether the backup bucket storing the etcd backups of the shoot is
+// configured with an immutability policy which protects the backups against deletion and overwrites.
+func (c *Constraint) CheckIfBackupImmutabilityConfigured(ctx context.Context) (gardencorev1beta1.ConditionStatus, string, string, error) {
+	backupEntry := &extensionsv1alpha1.BackupEntry{}
+	if err := c.seedClient.Get(ctx, client.ObjectKey{Name: c.shoot.BackupEntryName}, backupEntry); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return "", "", "", fmt.Errorf("could not get BackupEntry resource to check the backup immutability: %w", err)
+		}
+
+		return gardencorev1beta1.ConditionTrue,
+			"BackupNotConfigured",
+			"The etcd of the shoot is not backed up.",
+			nil
+	}
+
+	backupBucket := &extensionsv1alpha1.BackupBucket{}
+	if err := c.seedClient.Get(ctx, client.ObjectKey{Name: backupEntry.Spec.BucketName}, backupBucket); err != nil {
+		return "", "", "", fmt.Errorf("could not get BackupBucket resource to check the backup immutability: %w", err)
+	}
+
+	immutability := backupBucket.Spec.Immutability
+	if immutability == nil {
+		return gardencorev1beta1.ConditionFalse,
+			"BackupImmutabilityNotConfigured",
+			"The backup bucket is not configured with an immutability policy, the etcd backups are not protected against deletion and overwrites.",
+			nil
+	}
+
+	return gardencorev1beta1.ConditionTrue,
+		"BackupImmutabilityConfigured",
+		fmt.Sprintf("The etcd backups are protected against deletion and overwrites for %s (retention mode %s).", immutability.RetentionPeriod.Duration, immutability.RetentionMode),
+		nil
+}
+
+// CheckIfDualStackNodesMigrationReady checks whether all nodes of a dual-stack shoot have been assigned pod CIDRs of all
+// IP families. This is not the case for nodes which have been created before the shoot was migrated from single-stack
+// to dual-stack networking, hence such nodes must be rolled out to complete the migration.
+func (c *Constraint) CheckIfDualStackNodesMigrationReady(ctx context.Context) (gardencorev1beta1.ConditionStatus, string, string, error) {
+	networking := c.shoot.GetInfo().Spec.Networking
+	if networking == nil || len(networking.IPFamilies) < 2 {
+		return gardencorev1beta1.ConditionTrue,
+			"DualStackNotConfigured",
+			"The shoot does not use dual-stack networking.",
+			nil
+	}
+
+	nodeList := &corev1.NodeList{}
+	if err := c.shootClient.List(ctx, nodeList); err != nil {
+		return "", "", "", fmt.Errorf("could not list nodes to check the migration to dual-stack networking: %w", err)
+	}
+
+	if nodeNames := gardenerutils.NodesWithoutPodCIDRsOfIPFamilies(nodeList.Items, networking.IPFamilies); len(nodeNames) > 0 {
+		return gardencorev1beta1.ConditionFalse,
+			"NodesNotMigrated",
+			fmt.Sprintf("Some nodes have not been assigned pod CIDRs of all IP families yet and must be rolled out to complete the migration to dual-stack networking: %s", strings.Join(nodeNames, ", ")),
+			nil
+	}
+
+	return gardencorev1beta1.ConditionTrue,
+		"NodesMigrated",
+		"All nodes have been assigned pod CIDRs of all IP families.",
+		nil
+}
+
+func isIPAddressUtilizationCritical(utilization extensionsv1alpha1.IPAddressUtilization) bool {
+	return utilization.Capacity > 0 && utilization.Allocated*100 > utilization.Capacity*PodCIDRUtilizationThresholdPercentage
+}
+
 // checkIfCRDsWithProblematicConversionWebhooksPresent checks whether there are CRDs with multiple stored versions and
 // conversion webhooks are present in the cluster.
 func (c *Constraint) checkIfCRDsWithProblematicConversionWebhooksPresent(ctx context.Context) (gardencorev1beta1.ConditionStatus, string, string, error) {
@@ -425,6 +562,9 @@ type ShootConstraints struct {
 	maintenancePreconditionsSatisfied     gardencorev1beta1.Condition
 	caCertificateValiditiesAcceptable     gardencorev1beta1.Condition
 	crdsWithProblematicConversionWebhooks gardencorev1beta1.Condition
+	podCIDRUtilizationAcceptable          gardencorev1beta1.Condition
+	backupImmutabilityConfigured          gardencorev1beta1.Condition
+	dualStackNodesMigrationReady          gardencorev1beta1.Condition
 }
 
 // ConvertToSlice returns the shoot constraints as a slice.
@@ -434,6 +574,9 @@ func (g ShootConstraints) ConvertToSlice() []gardencorev1beta1.Condition {
 		g.maintenancePreconditionsSatisfied,
 		g.caCertificateValiditiesAcceptable,
 		g.crdsWithProblematicConversionWebhooks,
+		g.podCIDRUtilizationAcceptable,
+		g.backupImmutabilityConfigured,
+		g.dualStackNodesMigrationReady,
 	}
 }
 
@@ -444,6 +587,9 @@ func (g ShootConstraints) ConstraintTypes() []gardencorev1beta1.ConditionType {
 		g.maintenancePreconditionsSatisfied.Type,
 		g.caCertificateValiditiesAcceptable.Type,
 		g.crdsWithProblematicConversionWebhooks.Type,
+		g.podCIDRUtilizationAcceptable.Type,
+		g.backupImmutabilityConfigured.Type,
+		g.dualStackNodesMigrationReady.Type,
 	}
 }
 
@@ -455,5 +601,8 @@ func NewShootConstraints(clock clock.Clock, shoot *gardencorev1beta1.Shoot) Shoo
 		maintenancePreconditionsSatisfied:     v1beta1helper.GetOrInitConditionWithClock(clock, shoot.Status.Constraints, gardencorev1beta1.ShootMaintenancePreconditionsSatisfied),
 		caCertificateValiditiesAcceptable:     v1beta1helper.GetOrInitConditionWithClock(clock, shoot.Status.Constraints, gardencorev1beta1.ShootCACertificateValiditiesAcceptable),
 		crdsWithProblematicConversionWebhooks: v1beta1helper.GetOrInitConditionWithClock(clock, shoot.Status.Constraints, gardencorev1beta1.ShootCRDsWithProblematicConversionWebhooks),
+		podCIDRUtilizationAcceptable:          v1beta1helper.GetOrInitConditionWithClock(clock, shoot.Status.Constraints, gardencorev1beta1.ShootPodCIDRUtilizationAcceptable),
+		backupImmutabilityConfigured:          v1beta1helper.GetOrInitConditionWithClock(clock, shoot.Status.Constraints, gardencorev1beta1.ShootBackupImmutabilityConfigured),
+		dualStackNodesMigrationReady:          v1beta1helper.GetOrInitConditionWithClock(clock, shoot.Status.Constraints, gardencorev1beta1.ShootDualStackNodesMigrationReady),
 	}
 }
@@ -42,6 +42,7 @@ import (
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	kubernetesfake "github.com/gardener/gardener/pkg/client/kubernetes/fake"
 	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/care"
@@ -473,7 +474,7 @@ var _ = Describe("Constraints", func() {
 			shoot := &shootpkg.Shoot{
 				SeedNamespace: seedNamespace,
 			}
-			shoot.SetInfo(&gardencorev1beta1.Shoot{})
+			shoot.SetInfo(&gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "bar"}})
 
 			constraint = NewConstraint(
 				logr.Discard(),
@@ -629,6 +630,182 @@ var _ = Describe("Constraints", func() {
 				Expect(errorCodes).To(BeNil())
 			})
 		})
+
+		Describe("#CheckIfPodCIDRUtilizationAcceptable", func() {
+			var network *extensionsv1alpha1.Network
+
+			BeforeEach(func() {
+				network = &extensionsv1alpha1.Network{
+					ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: seedNamespace},
+					Spec:       extensionsv1alpha1.NetworkSpec{PodCIDR: "100.64.0.0/16"},
+				}
+			})
+
+			It("should return a 'true' condition when there is no Network resource", func() {
+				status, reason, message, err := constraint.CheckIfPodCIDRUtilizationAcceptable(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(status).To(Equal(gardencorev1beta1.ConditionTrue))
+				Expect(reason).To(Equal("PodCIDRUtilizationUnknown"))
+				Expect(message).To(Equal("The network extension does not report the utilization of the pod CIDR."))
+			})
+
+			It("should return a 'true' condition when the utilization is below the threshold", func() {
+				network.Status.PodCIDRUtilization = &extensionsv1alpha1.PodCIDRUtilization{
+					IPAddressUtilization: extensionsv1alpha1.IPAddressUtilization{Capacity: 65536, Allocated: 50000},
+					Zones: []extensionsv1alpha1.PodCIDRZoneUtilization{
+						{Name: "zone-a", IPAddressUtilization: extensionsv1alpha1.IPAddressUtilization{Capacity: 32768, Allocated: 26214}},
+						{Name: "zone-b", IPAddressUtilization: extensionsv1alpha1.IPAddressUtilization{Capacity: 32768, Allocated: 23786}},
+					},
+				}
+				Expect(seedClient.Create(ctx, network)).To(Succeed())
+
+				status, reason, message, err := constraint.CheckIfPodCIDRUtilizationAcceptable(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(status).To(Equal(gardencorev1beta1.ConditionTrue))
+				Expect(reason).To(Equal("PodCIDRUtilizationAcceptable"))
+				Expect(message).To(Equal("Less than 80% of the IP addresses available for pods are allocated."))
+			})
+
+			It("should return a 'false' condition when the utilization of a zone is above the threshold", func() {
+				network.Status.PodCIDRUtilization = &extensionsv1alpha1.PodCIDRUtilization{
+					IPAddressUtilization: extensionsv1alpha1.IPAddressUtilization{Capacity: 65536, Allocated: 50000},
+					Zones: []extensionsv1alpha1.PodCIDRZoneUtilization{
+						{Name: "zone-a", IPAddressUtilization: extensionsv1alpha1.IPAddressUtilization{Capacity: 32768, Allocated: 30000}},
+						{Name: "zone-b", IPAddressUtilization: extensionsv1alpha1.IPAddressUtilization{Capacity: 32768, Allocated: 20000}},
+					},
+				}
+				Expect(seedClient.Create(ctx, network)).To(Succeed())
+
+				status, reason, message, err := constraint.CheckIfPodCIDRUtilizationAcceptable(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(status).To(Equal(gardencorev1beta1.ConditionFalse))
+				Expect(reason).To(Equal("PodCIDRUtilizationHigh"))
+				Expect(message).To(Equal(`More than 80% of the IP addresses available for pods are allocated, the cluster might not be able to scale further: zone "zone-a" (30000/32768 IP addresses allocated)`))
+			})
+
+			It("should return a 'false' condition when the utilization of the pod CIDR is above the threshold", func() {
+				network.Status.PodCIDRUtilization = &extensionsv1alpha1.PodCIDRUtilization{
+					IPAddressUtilization: extensionsv1alpha1.IPAddressUtilization{Capacity: 65536, Allocated: 60000},
+				}
+				Expect(seedClient.Create(ctx, network)).To(Succeed())
+
+				status, reason, message, err := constraint.CheckIfPodCIDRUtilizationAcceptable(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(status).To(Equal(gardencorev1beta1.ConditionFalse))
+				Expect(reason).To(Equal("PodCIDRUtilizationHigh"))
+				Expect(message).To(Equal("More than 80% of the IP addresses available for pods are allocated, the cluster might not be able to scale further: pod CIDR 100.64.0.0/16 (60000/65536 IP addresses allocated)"))
+			})
+		})
+
+		Describe("#CheckIfBackupImmutabilityConfigured", func() {
+			var (
+				backupEntry  *extensionsv1alpha1.BackupEntry
+				backupBucket *extensionsv1alpha1.BackupBucket
+			)
+
+			BeforeEach(func() {
+				constraint = NewConstraint(
+					logr.Discard(),
+					&shootpkg.Shoot{SeedNamespace: seedNamespace, BackupEntryName: "shoot--foo--bar--uid"},
+					seedClient,
+					nil,
+					clock,
+				)
+
+				backupEntry = &extensionsv1alpha1.BackupEntry{
+					ObjectMeta: metav1.ObjectMeta{Name: "shoot--foo--bar--uid"},
+					Spec:       extensionsv1alpha1.BackupEntrySpec{BucketName: "bucket"},
+				}
+				backupBucket = &extensionsv1alpha1.BackupBucket{
+					ObjectMeta: metav1.ObjectMeta{Name: "bucket"},
+				}
+			})
+
+			It("should return a 'true' condition when there is no BackupEntry resource", func() {
+				status, reason, message, err := constraint.CheckIfBackupImmutabilityConfigured(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(status).To(Equal(gardencorev1beta1.ConditionTrue))
+				Expect(reason).To(Equal("BackupNotConfigured"))
+				Expect(message).To(Equal("The etcd of the shoot is not backed up."))
+			})
+
+			It("should return an error when the BackupBucket resource does not exist", func() {
+				Expect(seedClient.Create(ctx, backupEntry)).To(Succeed())
+
+				_, _, _, err := constraint.CheckIfBackupImmutabilityConfigured(ctx)
+				Expect(err).To(MatchError(ContainSubstring("could not get BackupBucket resource")))
+			})
+
+			It("should return a 'false' condition when the BackupBucket has no immutability configuration", func() {
+				Expect(seedClient.Create(ctx, backupEntry)).To(Succeed())
+				Expect(seedClient.Create(ctx, backupBucket)).To(Succeed())
+
+				status, reason, message, err := constraint.CheckIfBackupImmutabilityConfigured(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(status).To(Equal(gardencorev1beta1.ConditionFalse))
+				Expect(reason).To(Equal("BackupImmutabilityNotConfigured"))
+				Expect(message).To(Equal("The backup bucket is not configured with an immutability policy, the etcd backups are not protected against deletion and overwrites."))
+			})
+
+			It("should return a 'true' condition when the BackupBucket has an immutability configuration", func() {
+				backupBucket.Spec.Immutability = &extensionsv1alpha1.BackupBucketImmutability{
+					RetentionMode:   extensionsv1alpha1.BackupBucketRetentionModeCompliance,
+					RetentionPeriod: metav1.Duration{Duration: 7 * 24 * time.Hour},
+				}
+				Expect(seedClient.Create(ctx, backupEntry)).To(Succeed())
+				Expect(seedClient.Create(ctx, backupBucket)).To(Succeed())
+
+				status, reason, message, err := constraint.CheckIfBackupImmutabilityConfigured(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(status).To(Equal(gardencorev1beta1.ConditionTrue))
+				Expect(reason).To(Equal("BackupImmutabilityConfigured"))
+				Expect(message).To(Equal("The etcd backups are protected against deletion and overwrites for 168h0m0s (retention mode Compliance)."))
+			})
+		})
+
+		Describe("#CheckIfDualStackNodesMigrationReady", func() {
+			var constraints ShootConstraints
+
+			BeforeEach(func() {
+				shoot := &shootpkg.Shoot{SeedNamespace: seedNamespace}
+				shoot.SetInfo(&gardencorev1beta1.Shoot{
+					ObjectMeta: metav1.ObjectMeta{Name: "bar"},
+					Spec: gardencorev1beta1.ShootSpec{
+						Networking: &gardencorev1beta1.Networking{IPFamilies: []gardencorev1beta1.IPFamily{gardencorev1beta1.IPFamilyIPv4, gardencorev1beta1.IPFamilyIPv6}},
+					},
+				})
+
+				constraint = NewConstraint(
+					logr.Discard(),
+					shoot,
+					seedClient,
+					func() (kubernetes.Interface, bool, error) {
+						return kubernetesfake.NewClientSetBuilder().WithClient(shootClient).Build(), true, nil
+					},
+					clock,
+				)
+				constraints = NewShootConstraints(clock, shoot.GetInfo())
+
+				Expect(shootClient.Create(ctx, &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node1"}, Spec: corev1.NodeSpec{PodCIDRs: []string{"100.96.0.0/24", "2001:db8::/64"}}})).To(Succeed())
+			})
+
+			It("should not add the constraint if all nodes have been migrated", func() {
+				Expect(constraint.Check(ctx, constraints)).NotTo(ContainCondition(
+					OfType(gardencorev1beta1.ShootDualStackNodesMigrationReady),
+				))
+			})
+
+			It("should add the constraint if some nodes have not been migrated", func() {
+				Expect(shootClient.Create(ctx, &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node2"}, Spec: corev1.NodeSpec{PodCIDRs: []string{"100.96.1.0/24"}}})).To(Succeed())
+
+				Expect(constraint.Check(ctx, constraints)).To(ContainCondition(
+					OfType(gardencorev1beta1.ShootDualStackNodesMigrationReady),
+					WithStatus(gardencorev1beta1.ConditionProgressing),
+					WithReason("NodesNotMigrated"),
+					WithMessage("Some nodes have not been assigned pod CIDRs of all IP families yet and must be rolled out to complete the migration to dual-stack networking: node2"),
+				))
+			})
+		})
 	})
 
 	Describe("ShootConstraints", func() {
@@ -641,6 +818,9 @@ var _ = Describe("Constraints", func() {
 					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
 					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
 					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
+					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
+					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
+					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
 				))
 			})
 
@@ -660,6 +840,9 @@ var _ = Describe("Constraints", func() {
 					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
 					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
 					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
+					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
+					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
+					beConditionWithStatusAndMsg("Unknown", "ConditionInitialized", "The condition has been initialized but its semantic check has not been performed yet."),
 				))
 			})
 		})
@@ -673,6 +856,9 @@ var _ = Describe("Constraints", func() {
 					OfType("MaintenancePreconditionsSatisfied"),
 					OfType("CACertificateValiditiesAcceptable"),
 					OfType("CRDsWithProblematicConversionWebhooks"),
+					OfType("PodCIDRUtilizationAcceptable"),
+					OfType("BackupImmutabilityConfigured"),
+					OfType("DualStackNodesMigrationReady"),
 				))
 			})
 		})
@@ -686,6 +872,9 @@ var _ = Describe("Constraints", func() {
 					gardencorev1beta1.ConditionType("MaintenancePreconditionsSatisfied"),
 					gardencorev1beta1.ConditionType("CACertificateValiditiesAcceptable"),
 					gardencorev1beta1.ConditionType("CRDsWithProblematicConversionWebhooks"),
+					gardencorev1beta1.ConditionType("PodCIDRUtilizationAcceptable"),
+					gardencorev1beta1.ConditionType("BackupImmutabilityConfigured"),
+					gardencorev1beta1.ConditionType("DualStackNodesMigrationReady"),
 				))
 			})
 		})
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	"github.com/gardener/machine-controller-manager/pkg/util/provider/machineutils"
 	"github.com/go-logr/logr"
 	appsv1 "k8s.io/api/apps/v1"
 	coordinationv1 "k8s.io/api/coordination/v1"
@@ -42,6 +43,7 @@ import (
 	"github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
 	"github.com/gardener/gardener/pkg/gardenlet/operation/seed"
 	"github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
 	"github.com/gardener/gardener/pkg/utils/flow"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
@@ -289,6 +291,7 @@ func (h *Health) retrieveExtensions(ctx context.Context) ([]runtime.Object, erro
 	var (
 		allExtensions       []runtime.Object
 		extensionObjectList = []client.ObjectList{
+			&extensionsv1alpha1.AuditSinkList{},
 			&extensionsv1alpha1.ExtensionList{},
 		}
 	)
@@ -406,6 +409,12 @@ func (h *Health) checkControlPlane(
 		return nil, err
 	}
 
+	// Volumes stuck in attaching or detaching are checked first since they are the root cause for unhealthy control plane
+	// components (e.g., etcd) which cannot start.
+	if exitCondition, err := h.healthChecker.CheckControlPlaneVolumeAttachments(ctx, h.shoot.SeedNamespace, condition); err != nil || exitCondition != nil {
+		return exitCondition, err
+	}
+
 	if exitCondition, err := h.healthChecker.CheckControlPlane(ctx, h.shoot.SeedNamespace, requiredControlPlaneDeployments, requiredControlPlaneEtcds, condition); err != nil || exitCondition != nil {
 		return exitCondition, err
 	}
@@ -708,6 +717,16 @@ func (h *Health) CheckClusterNodes(
 	}
 
 	if checkScaleUp {
+		eventList := &corev1.EventList{}
+		if err := shootClient.Client().List(ctx, eventList, client.InNamespace(metav1.NamespaceDefault), client.MatchingFields{"reason": nodeagentconfigv1alpha1.EventReasonBootstrapFailed}); err != nil {
+			return nil, err
+		}
+
+		if err := CheckNodeBootstrapFailures(machineList, eventList); err != nil {
+			c := v1beta1helper.FailedCondition(h.clock, h.shoot.GetInfo().Status.LastOperation, h.conditionThresholds, condition, "NodeBootstrapFailed", err.Error())
+			return &c, nil
+		}
+
 		if err := CheckNodesScalingUp(machineList, readyNodes, desiredMachines); err != nil {
 			c := v1beta1helper.FailedCondition(h.clock, h.shoot.GetInfo().Status.LastOperation, h.conditionThresholds, condition, "NodesScalingUp", err.Error())
 			return &c, nil
@@ -821,6 +840,49 @@ func CheckNodesScalingUp(machineList *machinev1alpha1.MachineList, readyNodes, d
 	return fmt.Errorf("%s provisioning and should join the cluster soon", cosmeticMachineMessage(pendingMachines))
 }
 
+// CheckNodeBootstrapFailures returns an error if gardener-node-agent reported a failure while bootstrapping a machine
+// which has not joined the cluster yet. The error contains the message of the latest reported failure, which includes
+// an excerpt of the gardener-node-agent journal.
+func CheckNodeBootstrapFailures(machineList *machinev1alpha1.MachineList, eventList *corev1.EventList) error {
+	machinesWithoutNode := sets.New[string]()
+	for _, machine := range machineList.Items {
+		if machine.DeletionTimestamp == nil && machine.Labels[machinev1alpha1.NodeLabelKey] == "" {
+			machinesWithoutNode.Insert(machine.Name)
+		}
+	}
+
+	var (
+		failedMachines = sets.New[string]()
+		latestFailure  *corev1.Event
+		latestMachine  string
+	)
+
+	for i, event := range eventList.Items {
+		// The machine name is only known by gardener-node-agent if the NodeAgentAuthorizer feature gate is enabled.
+		// Otherwise, fall back to the node name, which equals the machine name for most providers.
+		machineName := event.Annotations[machineutils.MachineLabelKey]
+		if machineName == "" {
+			machineName = event.InvolvedObject.Name
+		}
+
+		if !machinesWithoutNode.Has(machineName) {
+			continue
+		}
+
+		failedMachines.Insert(machineName)
+		if latestFailure == nil || latestFailure.LastTimestamp.Before(&event.LastTimestamp) {
+			latestFailure = &eventList.Items[i]
+			latestMachine = machineName
+		}
+	}
+
+	if latestFailure == nil {
+		return nil
+	}
+
+	return fmt.Errorf("%s failing to bootstrap, latest failure reported for machine %q: %s", cosmeticMachineMessage(failedMachines.Len()), latestMachine, latestFailure.Message)
+}
+
 // CheckNodesScalingDown returns an error if nodes are being scaled down.
 func CheckNodesScalingDown(machineList *machinev1alpha1.MachineList, nodeList *corev1.NodeList, registeredNodes, desiredMachines int) error {
 	if registeredNodes == desiredMachines {
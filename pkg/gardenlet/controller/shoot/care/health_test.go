@@ -596,6 +596,54 @@ var _ = Describe("health check", func() {
 		)
 	})
 
+	Describe("#CheckNodeBootstrapFailures", func() {
+		var (
+			machineList *machinev1alpha1.MachineList
+			eventList   *corev1.EventList
+		)
+
+		BeforeEach(func() {
+			machineList = &machinev1alpha1.MachineList{
+				Items: []machinev1alpha1.Machine{
+					{ObjectMeta: metav1.ObjectMeta{Name: "machine1", Labels: map[string]string{"node": "node1"}}},
+					{ObjectMeta: metav1.ObjectMeta{Name: "machine2"}},
+					{ObjectMeta: metav1.ObjectMeta{Name: "machine3"}},
+				},
+			}
+			eventList = &corev1.EventList{}
+		})
+
+		It("should succeed if no bootstrap failures were reported", func() {
+			Expect(CheckNodeBootstrapFailures(machineList, eventList)).To(Succeed())
+		})
+
+		It("should succeed if bootstrap failures were only reported for machines which have joined the cluster or are unknown", func() {
+			eventList.Items = []corev1.Event{
+				{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{"node.gardener.cloud/machine-name": "machine1"}}, Message: "failure"},
+				{InvolvedObject: corev1.ObjectReference{Name: "machine4"}, Message: "failure"},
+			}
+
+			Expect(CheckNodeBootstrapFailures(machineList, eventList)).To(Succeed())
+		})
+
+		It("should return the latest bootstrap failure of machines which have not joined the cluster", func() {
+			eventList.Items = []corev1.Event{
+				{
+					ObjectMeta:    metav1.ObjectMeta{Annotations: map[string]string{"node.gardener.cloud/machine-name": "machine2"}},
+					Message:       "older failure",
+					LastTimestamp: metav1.NewTime(fakeClock.Now().Add(-time.Minute)),
+				},
+				{
+					InvolvedObject: corev1.ObjectReference{Name: "machine3"},
+					Message:        "latest failure",
+					LastTimestamp:  metav1.NewTime(fakeClock.Now()),
+				},
+			}
+
+			Expect(CheckNodeBootstrapFailures(machineList, eventList)).To(MatchError(`2 machines are failing to bootstrap, latest failure reported for machine "machine3": latest failure`))
+		})
+	})
+
 	Describe("#CheckNodesScalingUp", func() {
 		It("should return true if number of ready nodes equal number of desired machines", func() {
 			Expect(CheckNodesScalingUp(nil, 1, 1)).To(Succeed())
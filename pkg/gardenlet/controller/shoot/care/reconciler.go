@@ -11,7 +11,9 @@ import (
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -42,6 +44,10 @@ var (
 	NewGarbageCollector = defaultNewGarbageCollector
 	// NewWebhookRemediator is used to create a new webhook remediation instance.
 	NewWebhookRemediator = defaultNewWebhookRemediator
+	// NewConditionRemediator is used to create a new condition remediation instance.
+	NewConditionRemediator = defaultNewConditionRemediator
+	// NewSizeClassifier is used to create a new size classification instance.
+	NewSizeClassifier = defaultNewSizeClassifier
 )
 
 // Reconciler reconciles Shoot resources and executes care operations, e.g. health checks or garbage collection.
@@ -54,8 +60,10 @@ type Reconciler struct {
 	Identity              *gardencorev1beta1.Gardener
 	GardenClusterIdentity string
 	SeedName              string
+	Recorder              record.EventRecorder
 
-	gardenSecrets map[string]*corev1.Secret
+	gardenSecrets             map[string]*corev1.Secret
+	sizeClassificationHistory SizeClassificationHistory
 }
 
 // Reconcile executes care operations, e.g. health checks or garbage collection.
@@ -129,6 +137,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 		staleExtensionHealthCheckThreshold    = gardenlethelper.StaleExtensionHealthChecksThreshold(r.Config.Controllers.ShootCare.StaleExtensionHealthChecks)
 		initializeShootClients                = shootClientInitializer(careCtx, o)
 		updatedConditions, updatedConstraints []gardencorev1beta1.Condition
+		updatedSizeClass                      = shoot.Status.SizeClass
 	)
 
 	if err := flow.Parallel(
@@ -179,20 +188,51 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 			}
 			return nil
 		},
+		// Trigger size classification
+		func(ctx context.Context) error {
+			sizeClass, err := NewSizeClassifier(log, r.SeedClientSet.Client(), o.Shoot.SeedNamespace, shoot, initializeShootClients, r.Clock, &r.sizeClassificationHistory).Classify(ctx)
+			if err != nil {
+				// errors during size classification are only being logged and do not cause the care operation to fail
+				log.Error(err, "Failed computing size class of shoot")
+				return nil
+			}
+			if sizeClass != nil {
+				updatedSizeClass = sizeClass
+			}
+			return nil
+		},
 	)(careCtx); err != nil {
 		return reconcile.Result{}, err
 	}
 
-	// Update Shoot status (conditions, constraints) if necessary
+	// Trigger remediations for failed conditions, this is done after the health check since it depends on its result
+	var updatedRemediations []gardencorev1beta1.ShootRemediation
+	if len(r.Config.Controllers.ShootCare.Remediations) > 0 {
+		updatedRemediations = NewConditionRemediator(
+			log,
+			r.SeedClientSet.Client(),
+			o.Shoot.SeedNamespace,
+			shoot,
+			initializeShootClients,
+			r.Recorder,
+			r.Clock,
+			r.Config.Controllers.ShootCare.Remediations,
+		).Remediate(careCtx, updatedConditions)
+		// errors during remediation are only being logged and do not cause the care operation to fail
+	}
+
+	// Update Shoot status (conditions, constraints, size class, remediations) if necessary
 	if v1beta1helper.ConditionsNeedUpdate(shootConditions.ConvertToSlice(), updatedConditions) ||
-		v1beta1helper.ConditionsNeedUpdate(shootConstraints.ConvertToSlice(), updatedConstraints) {
-		log.V(1).Info("Updating status conditions and constraints")
+		v1beta1helper.ConditionsNeedUpdate(shootConstraints.ConvertToSlice(), updatedConstraints) ||
+		!ptr.Equal(shoot.Status.SizeClass, updatedSizeClass) ||
+		!apiequality.Semantic.DeepEqual(shoot.Status.Remediations, updatedRemediations) {
+		log.V(1).Info("Updating status conditions, constraints, size class and remediations")
 		// Rebuild shoot conditions and constraints to ensure that only the conditions and constraints with the
 		// correct types will be updated, and any other conditions will remain intact
 		conditions := v1beta1helper.BuildConditions(shoot.Status.Conditions, updatedConditions, shootConditions.ConditionTypes())
 		constraints := v1beta1helper.BuildConditions(shoot.Status.Constraints, updatedConstraints, shootConstraints.ConstraintTypes())
 
-		if err := r.patchStatus(ctx, shoot, conditions, constraints); err != nil {
+		if err := r.patchStatus(ctx, shoot, conditions, constraints, updatedSizeClass, updatedRemediations); err != nil {
 			log.Error(err, "Error when trying to update the shoot status")
 			return reconcile.Result{}, err
 		}
@@ -209,10 +249,12 @@ func (r *Reconciler) conditionThresholdsToProgressingMapping() map[gardencorev1b
 	return out
 }
 
-func (r *Reconciler) patchStatus(ctx context.Context, shoot *gardencorev1beta1.Shoot, conditions, constraints []gardencorev1beta1.Condition) error {
+func (r *Reconciler) patchStatus(ctx context.Context, shoot *gardencorev1beta1.Shoot, conditions, constraints []gardencorev1beta1.Condition, sizeClass *gardencorev1beta1.ShootSizeClass, remediations []gardencorev1beta1.ShootRemediation) error {
 	patch := client.StrategicMergeFrom(shoot.DeepCopy())
 	shoot.Status.Conditions = conditions
 	shoot.Status.Constraints = constraints
+	shoot.Status.SizeClass = sizeClass
+	shoot.Status.Remediations = remediations
 	return r.GardenClient.Status().Patch(ctx, shoot, patch)
 }
 
@@ -231,7 +273,7 @@ func (r *Reconciler) patchStatusToUnknown(ctx context.Context, shoot *gardencore
 		return nil
 	}
 
-	return r.patchStatus(ctx, shoot, updatedConditions, updatedConstraints)
+	return r.patchStatus(ctx, shoot, updatedConditions, updatedConstraints, shoot.Status.SizeClass, shoot.Status.Remediations)
 }
 
 func shootClientInitializer(ctx context.Context, o *operation.Operation) func() (kubernetes.Interface, bool, error) {
@@ -18,6 +18,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/clock"
 	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -192,6 +193,7 @@ var _ = Describe("Shoot Care Control", func() {
 				shootClientMap clientmap.ClientMap
 				managedSeed    *seedmanagementv1alpha1.ManagedSeed
 				operationFunc  NewOperationFunc
+				sizeClass      *gardencorev1beta1.ShootSizeClass
 			)
 
 			JustBeforeEach(func() {
@@ -210,6 +212,7 @@ var _ = Describe("Shoot Care Control", func() {
 				DeferCleanup(test.WithVars(
 					&NewOperation, operationFunc,
 					&NewGarbageCollector, nopGarbageCollectorFunc(),
+					&NewSizeClassifier, sizeClassifierFunc(func() *gardencorev1beta1.ShootSizeClass { return sizeClass }),
 				))
 				reconciler = &Reconciler{
 					GardenClient:   gardenClient,
@@ -223,6 +226,7 @@ var _ = Describe("Shoot Care Control", func() {
 
 			AfterEach(func() {
 				shoot = nil
+				sizeClass = nil
 			})
 
 			Context("when no conditions / constraints are returned", func() {
@@ -266,6 +270,27 @@ var _ = Describe("Shoot Care Control", func() {
 					Expect(updatedShoot.Status.Conditions).To(BeEmpty())
 					Expect(updatedShoot.Status.Constraints).To(BeEmpty())
 				})
+
+				It("should set the size class", func() {
+					sizeClass = ptr.To(gardencorev1beta1.ShootSizeClassLarge)
+
+					Expect(reconciler.Reconcile(ctx, req)).To(Equal(reconcile.Result{RequeueAfter: careSyncPeriod}))
+
+					updatedShoot := &gardencorev1beta1.Shoot{}
+					Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), updatedShoot)).To(Succeed())
+					Expect(updatedShoot.Status.SizeClass).To(PointTo(Equal(gardencorev1beta1.ShootSizeClassLarge)))
+				})
+
+				It("should keep the size class if it cannot be determined", func() {
+					shoot.Status.SizeClass = ptr.To(gardencorev1beta1.ShootSizeClassMedium)
+					Expect(gardenClient.Status().Update(ctx, shoot)).To(Succeed())
+
+					Expect(reconciler.Reconcile(ctx, req)).To(Equal(reconcile.Result{RequeueAfter: careSyncPeriod}))
+
+					updatedShoot := &gardencorev1beta1.Shoot{}
+					Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), updatedShoot)).To(Succeed())
+					Expect(updatedShoot.Status.SizeClass).To(PointTo(Equal(gardencorev1beta1.ShootSizeClassMedium)))
+				})
 			})
 
 			Context("when conditions / constraints are returned unchanged", func() {
@@ -568,6 +593,18 @@ func nopGarbageCollectorFunc() NewGarbageCollectorFunc {
 	}
 }
 
+type sizeClassifier func() *gardencorev1beta1.ShootSizeClass
+
+func (s sizeClassifier) Classify(_ context.Context) (*gardencorev1beta1.ShootSizeClass, error) {
+	return s(), nil
+}
+
+func sizeClassifierFunc(fn sizeClassifier) NewSizeClassifierFunc {
+	return func(_ logr.Logger, _ client.Client, _ string, _ *gardencorev1beta1.Shoot, _ ShootClientInit, _ clock.Clock, _ *SizeClassificationHistory) SizeClassifier {
+		return fn
+	}
+}
+
 func consistOfConditionsInUnknownStatus(message string, isWorkerless bool) types.GomegaMatcher {
 	var expectedLength = 4
 	matcher := And(
@@ -628,5 +665,20 @@ func consistOfConstraintsInUnknownStatus(message string) types.GomegaMatcher {
 			"Status":  Equal(gardencorev1beta1.ConditionUnknown),
 			"Message": Equal(message),
 		}),
+		MatchFields(IgnoreExtras, Fields{
+			"Type":    Equal(gardencorev1beta1.ShootPodCIDRUtilizationAcceptable),
+			"Status":  Equal(gardencorev1beta1.ConditionUnknown),
+			"Message": Equal(message),
+		}),
+		MatchFields(IgnoreExtras, Fields{
+			"Type":    Equal(gardencorev1beta1.ShootBackupImmutabilityConfigured),
+			"Status":  Equal(gardencorev1beta1.ConditionUnknown),
+			"Message": Equal(message),
+		}),
+		MatchFields(IgnoreExtras, Fields{
+			"Type":    Equal(gardencorev1beta1.ShootDualStackNodesMigrationReady),
+			"Status":  Equal(gardencorev1beta1.ConditionUnknown),
+			"Message": Equal(message),
+		}),
 	)
 }
@@ -0,0 +1,222 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package care
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	gardenletconfig "github.com/gardener/gardener/pkg/gardenlet/apis/config"
+)
+
+const (
+	// EventRemediationExecuted is an event reason for an executed remediation action.
+	EventRemediationExecuted = "RemediationExecuted"
+	// EventRemediationFailed is an event reason for a failed remediation action.
+	EventRemediationFailed = "RemediationFailed"
+
+	labelValueVPNSeedServer = "vpn-seed-server"
+	labelValueVPNShoot      = "vpn-shoot"
+)
+
+// lastRemediation returns the last execution of the given remediation action which is recorded in the status of the
+// given shoot.
+func lastRemediation(remediations []gardencorev1beta1.ShootRemediation, action gardenletconfig.RemediationAction) *gardencorev1beta1.ShootRemediation {
+	for i := range remediations {
+		if remediations[i].Action == string(action) {
+			return &remediations[i]
+		}
+	}
+	return nil
+}
+
+// ConditionRemediation executes predefined remediation actions for failed conditions of a Shoot.
+type ConditionRemediation struct {
+	log                    logr.Logger
+	seedClient             client.Client
+	seedNamespace          string
+	shoot                  *gardencorev1beta1.Shoot
+	initializeShootClients ShootClientInit
+	recorder               record.EventRecorder
+	clock                  clock.Clock
+	remediations           []gardenletconfig.ConditionRemediation
+}
+
+// NewConditionRemediation creates a new instance for executing remediation actions for failed conditions.
+func NewConditionRemediation(
+	log logr.Logger,
+	seedClient client.Client,
+	seedNamespace string,
+	shoot *gardencorev1beta1.Shoot,
+	shootClientInit ShootClientInit,
+	recorder record.EventRecorder,
+	clock clock.Clock,
+	remediations []gardenletconfig.ConditionRemediation,
+) *ConditionRemediation {
+	return &ConditionRemediation{
+		log:                    log,
+		seedClient:             seedClient,
+		seedNamespace:          seedNamespace,
+		shoot:                  shoot,
+		initializeShootClients: shootClientInit,
+		recorder:               recorder,
+		clock:                  clock,
+		remediations:           remediations,
+	}
+}
+
+// Remediate executes the configured remediation actions whose condition is failed. Actions which were already
+// executed for the Shoot within their minimum interval are skipped. Every execution is recorded as event on the Shoot.
+// It returns the remediations which shall be recorded in the Shoot status, i.e., the last executions of all configured
+// actions. The status is used for rate limiting the actions, hence it is kept across gardenlet restarts.
+func (r *ConditionRemediation) Remediate(ctx context.Context, conditions []gardencorev1beta1.Condition) []gardencorev1beta1.ShootRemediation {
+	var (
+		configuredActions = make(map[gardenletconfig.RemediationAction]struct{})
+		executed          = make(map[gardenletconfig.RemediationAction]gardencorev1beta1.ShootRemediation)
+	)
+
+	for _, remediation := range r.remediations {
+		configuredActions[remediation.Action] = struct{}{}
+	}
+
+	if r.shoot.DeletionTimestamp == nil && !v1beta1helper.HibernationIsEnabled(r.shoot) && !r.shoot.Status.IsHibernated &&
+		(r.shoot.Status.LastOperation == nil || r.shoot.Status.LastOperation.State != gardencorev1beta1.LastOperationStateProcessing) {
+		for _, remediation := range r.remediations {
+			condition := v1beta1helper.GetCondition(conditions, gardencorev1beta1.ConditionType(remediation.Type))
+			if condition == nil || condition.Status != gardencorev1beta1.ConditionFalse {
+				continue
+			}
+
+			if _, ok := executed[remediation.Action]; ok {
+				continue
+			}
+
+			log := r.log.WithValues("action", remediation.Action, "conditionType", remediation.Type)
+
+			var minInterval time.Duration
+			if remediation.MinInterval != nil {
+				minInterval = remediation.MinInterval.Duration
+			}
+			if last := lastRemediation(r.shoot.Status.Remediations, remediation.Action); last != nil && r.clock.Since(last.LastExecutionTime.Time) < minInterval {
+				log.V(1).Info("Skipping remediation since it was already executed recently", "minInterval", minInterval, "lastExecutionTime", last.LastExecutionTime)
+				continue
+			}
+
+			result := gardencorev1beta1.ShootRemediation{
+				Action:            string(remediation.Action),
+				ConditionType:     condition.Type,
+				LastExecutionTime: metav1.NewTime(r.clock.Now()),
+			}
+
+			log.Info("Executing remediation for failed condition", "reason", condition.Reason)
+			if err := r.execute(ctx, remediation.Action); err != nil {
+				log.Error(err, "Failed executing remediation")
+				r.recorder.Eventf(r.shoot, corev1.EventTypeWarning, EventRemediationFailed, "Failed executing remediation %q for failed condition %q: %v", remediation.Action, remediation.Type, err)
+				result.State = gardencorev1beta1.LastOperationStateFailed
+				result.Description = fmt.Sprintf("Failed executing remediation: %v", err)
+				executed[remediation.Action] = result
+				continue
+			}
+
+			r.recorder.Eventf(r.shoot, corev1.EventTypeNormal, EventRemediationExecuted, "Executed remediation %q for failed condition %q (reason: %s)", remediation.Action, remediation.Type, condition.Reason)
+			result.State = gardencorev1beta1.LastOperationStateSucceeded
+			result.Description = fmt.Sprintf("Executed remediation for failed condition (reason: %s)", condition.Reason)
+			executed[remediation.Action] = result
+		}
+	}
+
+	var out []gardencorev1beta1.ShootRemediation
+	for _, existing := range r.shoot.Status.Remediations {
+		action := gardenletconfig.RemediationAction(existing.Action)
+		if _, ok := configuredActions[action]; !ok {
+			continue
+		}
+		if result, ok := executed[action]; ok {
+			out = append(out, result)
+			delete(executed, action)
+			continue
+		}
+		out = append(out, existing)
+	}
+	for _, remediation := range r.remediations {
+		if result, ok := executed[remediation.Action]; ok {
+			out = append(out, result)
+			delete(executed, remediation.Action)
+		}
+	}
+
+	return out
+}
+
+func (r *ConditionRemediation) execute(ctx context.Context, action gardenletconfig.RemediationAction) error {
+	switch action {
+	case gardenletconfig.RemediationActionRestartVPN:
+		return r.restartVPN(ctx)
+	case gardenletconfig.RemediationActionReconcileDNSRecords:
+		return r.reconcileDNSRecords(ctx)
+	default:
+		return fmt.Errorf("unknown remediation action %q", action)
+	}
+}
+
+// restartVPN deletes the VPN pods in the shoot control plane and, if the API server is running, in the shoot cluster so
+// that they are recreated and the tunnel is established anew.
+func (r *ConditionRemediation) restartVPN(ctx context.Context) error {
+	if v1beta1helper.IsWorkerless(r.shoot) {
+		return nil
+	}
+
+	if err := r.seedClient.DeleteAllOf(ctx, &corev1.Pod{}, client.InNamespace(r.seedNamespace), client.MatchingLabels{v1beta1constants.LabelApp: labelValueVPNSeedServer}); err != nil {
+		return fmt.Errorf("failed deleting vpn-seed-server pods: %w", err)
+	}
+
+	shootClient, apiServerRunning, err := r.initializeShootClients()
+	if err != nil {
+		return err
+	}
+	if !apiServerRunning {
+		return nil
+	}
+
+	if err := shootClient.Client().DeleteAllOf(ctx, &corev1.Pod{}, client.InNamespace(metav1.NamespaceSystem), client.MatchingLabels{v1beta1constants.LabelApp: labelValueVPNShoot}); err != nil {
+		return fmt.Errorf("failed deleting vpn-shoot pods: %w", err)
+	}
+
+	return nil
+}
+
+// reconcileDNSRecords annotates all DNSRecords of the shoot with the reconcile operation.
+func (r *ConditionRemediation) reconcileDNSRecords(ctx context.Context) error {
+	dnsRecordList := &extensionsv1alpha1.DNSRecordList{}
+	if err := r.seedClient.List(ctx, dnsRecordList, client.InNamespace(r.seedNamespace)); err != nil {
+		return fmt.Errorf("failed listing DNSRecords: %w", err)
+	}
+
+	for _, dnsRecord := range dnsRecordList.Items {
+		if dnsRecord.DeletionTimestamp != nil || dnsRecord.Annotations[v1beta1constants.GardenerOperation] == v1beta1constants.GardenerOperationReconcile {
+			continue
+		}
+
+		patch := client.MergeFrom(dnsRecord.DeepCopy())
+		metav1.SetMetaDataAnnotation(&dnsRecord.ObjectMeta, v1beta1constants.GardenerOperation, v1beta1constants.GardenerOperationReconcile)
+		if err := r.seedClient.Patch(ctx, &dnsRecord, patch); err != nil {
+			return fmt.Errorf("failed annotating DNSRecord %s: %w", client.ObjectKeyFromObject(&dnsRecord), err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,196 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package care_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	testclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	kubernetesfake "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	gardenletconfig "github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/care"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("ConditionRemediation", func() {
+	var (
+		ctx           = context.Background()
+		seedNamespace = "shoot--foo--bar"
+
+		seedClient      client.Client
+		shootClient     client.Client
+		shootClientInit func() (kubernetes.Interface, bool, error)
+		recorder        *record.FakeRecorder
+		fakeClock       *testclock.FakeClock
+
+		shoot        *gardencorev1beta1.Shoot
+		remediations []gardenletconfig.ConditionRemediation
+		conditions   []gardencorev1beta1.Condition
+
+		vpnSeedServerPod *corev1.Pod
+		vpnShootPod      *corev1.Pod
+		otherPod         *corev1.Pod
+		dnsRecord        *extensionsv1alpha1.DNSRecord
+	)
+
+	BeforeEach(func() {
+		seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		shootClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).Build()
+		shootClientInit = func() (kubernetes.Interface, bool, error) {
+			return kubernetesfake.NewClientSetBuilder().WithClient(shootClient).Build(), true, nil
+		}
+		recorder = record.NewFakeRecorder(10)
+		fakeClock = testclock.NewFakeClock(time.Now())
+
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "garden-foo"},
+			Spec: gardencorev1beta1.ShootSpec{
+				Provider: gardencorev1beta1.Provider{Workers: []gardencorev1beta1.Worker{{Name: "worker"}}},
+			},
+		}
+		remediations = []gardenletconfig.ConditionRemediation{
+			{Type: string(gardencorev1beta1.ShootSystemComponentsHealthy), Action: gardenletconfig.RemediationActionRestartVPN, MinInterval: &metav1.Duration{Duration: time.Hour}},
+			{Type: string(gardencorev1beta1.ShootAPIServerAvailable), Action: gardenletconfig.RemediationActionReconcileDNSRecords, MinInterval: &metav1.Duration{Duration: time.Hour}},
+		}
+		conditions = []gardencorev1beta1.Condition{
+			{Type: gardencorev1beta1.ShootSystemComponentsHealthy, Status: gardencorev1beta1.ConditionFalse, Reason: "TunnelDown"},
+			{Type: gardencorev1beta1.ShootAPIServerAvailable, Status: gardencorev1beta1.ConditionTrue},
+		}
+
+		vpnSeedServerPod = &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "vpn-seed-server-0", Namespace: seedNamespace, Labels: map[string]string{"app": "vpn-seed-server"}}}
+		vpnShootPod = &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "vpn-shoot-0", Namespace: "kube-system", Labels: map[string]string{"app": "vpn-shoot"}}}
+		otherPod = &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "kube-apiserver-0", Namespace: seedNamespace, Labels: map[string]string{"app": "kubernetes"}}}
+		dnsRecord = &extensionsv1alpha1.DNSRecord{ObjectMeta: metav1.ObjectMeta{Name: "bar-external", Namespace: seedNamespace}}
+
+		Expect(seedClient.Create(ctx, vpnSeedServerPod)).To(Succeed())
+		Expect(seedClient.Create(ctx, otherPod)).To(Succeed())
+		Expect(seedClient.Create(ctx, dnsRecord)).To(Succeed())
+		Expect(shootClient.Create(ctx, vpnShootPod)).To(Succeed())
+	})
+
+	// remediate executes the remediations and records the result in the shoot status like the care reconciler does.
+	remediate := func() {
+		shoot.Status.Remediations = NewConditionRemediation(logr.Discard(), seedClient, seedNamespace, shoot, shootClientInit, recorder, fakeClock, remediations).Remediate(ctx, conditions)
+	}
+
+	It("should restart the VPN pods if the respective condition failed", func() {
+		remediate()
+
+		Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(vpnSeedServerPod), &corev1.Pod{})).To(BeNotFoundError())
+		Expect(shootClient.Get(ctx, client.ObjectKeyFromObject(vpnShootPod), &corev1.Pod{})).To(BeNotFoundError())
+		Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(otherPod), &corev1.Pod{})).To(Succeed())
+
+		Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(dnsRecord), dnsRecord)).To(Succeed())
+		Expect(dnsRecord.Annotations).NotTo(HaveKey(v1beta1constants.GardenerOperation))
+
+		Expect(recorder.Events).To(Receive(Equal(`Normal RemediationExecuted Executed remediation "RestartVPN" for failed condition "SystemComponentsHealthy" (reason: TunnelDown)`)))
+		Expect(recorder.Events).NotTo(Receive())
+
+		Expect(shoot.Status.Remediations).To(ConsistOf(gardencorev1beta1.ShootRemediation{
+			Action:            "RestartVPN",
+			ConditionType:     gardencorev1beta1.ShootSystemComponentsHealthy,
+			State:             gardencorev1beta1.LastOperationStateSucceeded,
+			Description:       "Executed remediation for failed condition (reason: TunnelDown)",
+			LastExecutionTime: metav1.NewTime(fakeClock.Now()),
+		}))
+	})
+
+	It("should record failed remediations in the status", func() {
+		shootClientInit = func() (kubernetes.Interface, bool, error) {
+			return nil, false, fmt.Errorf("fake error")
+		}
+
+		remediate()
+
+		Expect(recorder.Events).To(Receive(ContainSubstring("Warning RemediationFailed")))
+		Expect(shoot.Status.Remediations).To(ConsistOf(And(
+			HaveField("Action", "RestartVPN"),
+			HaveField("State", gardencorev1beta1.LastOperationStateFailed),
+			HaveField("Description", ContainSubstring("fake error")),
+		)))
+	})
+
+	It("should drop remediations of actions which are no longer configured from the status", func() {
+		shoot.Status.Remediations = []gardencorev1beta1.ShootRemediation{{Action: "Unknown"}, {Action: "ReconcileDNSRecords", State: gardencorev1beta1.LastOperationStateSucceeded}}
+		conditions[0].Status = gardencorev1beta1.ConditionTrue
+
+		remediate()
+
+		Expect(shoot.Status.Remediations).To(ConsistOf(gardencorev1beta1.ShootRemediation{Action: "ReconcileDNSRecords", State: gardencorev1beta1.LastOperationStateSucceeded}))
+	})
+
+	It("should trigger a reconciliation of the DNSRecords if the respective condition failed", func() {
+		conditions[1].Status = gardencorev1beta1.ConditionFalse
+		conditions[1].Reason = "HealthzRequestFailed"
+
+		remediate()
+
+		Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(dnsRecord), dnsRecord)).To(Succeed())
+		Expect(dnsRecord.Annotations).To(HaveKeyWithValue(v1beta1constants.GardenerOperation, v1beta1constants.GardenerOperationReconcile))
+
+		Expect(recorder.Events).To(Receive(ContainSubstring("RestartVPN")))
+		Expect(recorder.Events).To(Receive(Equal(`Normal RemediationExecuted Executed remediation "ReconcileDNSRecords" for failed condition "APIServerAvailable" (reason: HealthzRequestFailed)`)))
+	})
+
+	It("should not execute remediations for progressing or unknown conditions", func() {
+		conditions[0].Status = gardencorev1beta1.ConditionProgressing
+		conditions[1].Status = gardencorev1beta1.ConditionUnknown
+
+		remediate()
+
+		Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(vpnSeedServerPod), &corev1.Pod{})).To(Succeed())
+		Expect(recorder.Events).NotTo(Receive())
+	})
+
+	It("should not execute remediations while the shoot is being reconciled", func() {
+		shoot.Status.LastOperation = &gardencorev1beta1.LastOperation{State: gardencorev1beta1.LastOperationStateProcessing}
+
+		remediate()
+
+		Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(vpnSeedServerPod), &corev1.Pod{})).To(Succeed())
+		Expect(recorder.Events).NotTo(Receive())
+	})
+
+	It("should rate limit the remediations based on the last execution recorded in the status", func() {
+		shoot.Status.Remediations = []gardencorev1beta1.ShootRemediation{{Action: "RestartVPN", LastExecutionTime: metav1.NewTime(fakeClock.Now().Add(-30 * time.Minute))}}
+
+		remediate()
+		Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(vpnSeedServerPod), &corev1.Pod{})).To(Succeed())
+		Expect(recorder.Events).NotTo(Receive())
+		Expect(shoot.Status.Remediations).To(ConsistOf(HaveField("LastExecutionTime", metav1.NewTime(fakeClock.Now().Add(-30*time.Minute)))))
+	})
+
+	It("should rate limit the remediations per shoot and action", func() {
+		remediate()
+		Expect(recorder.Events).To(Receive())
+
+		Expect(seedClient.Create(ctx, &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "vpn-seed-server-1", Namespace: seedNamespace, Labels: map[string]string{"app": "vpn-seed-server"}}})).To(Succeed())
+
+		fakeClock.Step(30 * time.Minute)
+		remediate()
+		Expect(seedClient.Get(ctx, client.ObjectKey{Name: "vpn-seed-server-1", Namespace: seedNamespace}, &corev1.Pod{})).To(Succeed())
+		Expect(recorder.Events).NotTo(Receive())
+
+		fakeClock.Step(30 * time.Minute)
+		remediate()
+		Expect(seedClient.Get(ctx, client.ObjectKey{Name: "vpn-seed-server-1", Namespace: seedNamespace}, &corev1.Pod{})).To(BeNotFoundError())
+		Expect(recorder.Events).To(Receive(ContainSubstring("RestartVPN")))
+	})
+})
@@ -0,0 +1,242 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package care
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+)
+
+const (
+	metricAPIServerRequestTotal   = "apiserver_request_total"
+	metricProcessStartTimeSeconds = "process_start_time_seconds"
+
+	// SizeClassificationInterval is the minimum interval between two size classifications of a shoot. Classifying a
+	// shoot requires scraping the metrics of its API server, hence it is not done in every care cycle.
+	SizeClassificationInterval = time.Hour
+	// sizeClassHysteresis is the relative margin by which the number of nodes or the request rate of a shoot must
+	// exceed (or fall below) the bounds of its current size class before the class is changed. This prevents flapping
+	// for shoots whose load is close to a bound.
+	sizeClassHysteresis = 0.2
+)
+
+// SizeClassificationHistory remembers when shoots were classified in order to rate limit the classifications. It is
+// only kept in memory: losing it (e.g., on restart) merely results in an additional classification.
+// The zero value is ready for use.
+type SizeClassificationHistory struct {
+	lock            sync.Mutex
+	classifications map[string]time.Time
+}
+
+func (h *SizeClassificationHistory) due(shoot *gardencorev1beta1.Shoot, now time.Time) bool {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	lastClassification, ok := h.classifications[client.ObjectKeyFromObject(shoot).String()]
+	return !ok || now.Sub(lastClassification) >= SizeClassificationInterval
+}
+
+func (h *SizeClassificationHistory) remember(shoot *gardencorev1beta1.Shoot, now time.Time) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	if h.classifications == nil {
+		h.classifications = make(map[string]time.Time)
+	}
+	h.classifications[client.ObjectKeyFromObject(shoot).String()] = now
+}
+
+// SizeClassification contains required information for classifying the size of a shoot cluster.
+type SizeClassification struct {
+	log                    logr.Logger
+	seedClient             client.Client
+	seedNamespace          string
+	shoot                  *gardencorev1beta1.Shoot
+	initializeShootClients ShootClientInit
+	clock                  clock.Clock
+	history                *SizeClassificationHistory
+}
+
+// NewSizeClassification creates a new instance for the size classification of a shoot cluster.
+func NewSizeClassification(
+	log logr.Logger,
+	seedClient client.Client,
+	seedNamespace string,
+	shoot *gardencorev1beta1.Shoot,
+	shootClientInit ShootClientInit,
+	clock clock.Clock,
+	history *SizeClassificationHistory,
+) *SizeClassification {
+	return &SizeClassification{
+		log:                    log,
+		seedClient:             seedClient,
+		seedNamespace:          seedNamespace,
+		shoot:                  shoot,
+		initializeShootClients: shootClientInit,
+		clock:                  clock,
+		history:                history,
+	}
+}
+
+// Classify computes the size class of the shoot cluster based on the number of nodes and the volume of requests to
+// the API server. The class is only changed if the new class is confirmed with a margin (hysteresis), and shoots are
+// classified at most once per SizeClassificationInterval. It returns nil if the size class was not determined, e.g.,
+// because the API server is not running or the shoot was classified recently.
+func (s *SizeClassification) Classify(ctx context.Context) (*gardencorev1beta1.ShootSizeClass, error) {
+	if !s.history.due(s.shoot, s.clock.Now()) {
+		return nil, nil
+	}
+
+	shootClient, apiServerRunning, err := s.initializeShootClients()
+	if err != nil {
+		return nil, err
+	}
+	if !apiServerRunning {
+		return nil, nil
+	}
+
+	nodeList := &metav1.PartialObjectMetadataList{}
+	nodeList.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("NodeList"))
+	if err := shootClient.Client().List(ctx, nodeList); err != nil {
+		return nil, fmt.Errorf("failed listing nodes: %w", err)
+	}
+
+	metrics, err := shootClient.RESTClient().Get().AbsPath("/metrics").DoRaw(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed fetching metrics of API server: %w", err)
+	}
+
+	deployment := &appsv1.Deployment{}
+	if err := s.seedClient.Get(ctx, client.ObjectKey{Namespace: s.seedNamespace, Name: v1beta1constants.DeploymentNameKubeAPIServer}, deployment); err != nil {
+		return nil, fmt.Errorf("failed reading API server deployment: %w", err)
+	}
+
+	requestsPerSecond, err := s.requestsPerSecond(metrics, deployment.Status.ReadyReplicas)
+	if err != nil {
+		return nil, err
+	}
+
+	s.history.remember(s.shoot, s.clock.Now())
+
+	sizeClass := sizeClassWithHysteresis(s.shoot.Status.SizeClass, len(nodeList.Items), requestsPerSecond)
+	s.log.V(1).Info("Computed size class of shoot", "sizeClass", sizeClass, "nodes", len(nodeList.Items), "requestsPerSecond", requestsPerSecond)
+	return &sizeClass, nil
+}
+
+// sizeClassWithHysteresis computes the size class for the given number of nodes and request rate. A shoot is only
+// moved to a larger (smaller) class than its current one if this is still the case after decreasing (increasing) the
+// numbers by sizeClassHysteresis.
+func sizeClassWithHysteresis(current *gardencorev1beta1.ShootSizeClass, nodeCount int, requestsPerSecond float64) gardencorev1beta1.ShootSizeClass {
+	sizeClass := v1beta1helper.ComputeShootSizeClass(nodeCount, requestsPerSecond)
+	if current == nil {
+		return sizeClass
+	}
+
+	currentWeight := v1beta1helper.ShootSizeClassWeight(*current)
+
+	switch weight := v1beta1helper.ShootSizeClassWeight(sizeClass); {
+	case weight > currentWeight:
+		sizeClass = v1beta1helper.ComputeShootSizeClass(int(float64(nodeCount)*(1-sizeClassHysteresis)), requestsPerSecond*(1-sizeClassHysteresis))
+		if v1beta1helper.ShootSizeClassWeight(sizeClass) <= currentWeight {
+			return *current
+		}
+	case weight < currentWeight:
+		sizeClass = v1beta1helper.ComputeShootSizeClass(int(math.Ceil(float64(nodeCount)*(1+sizeClassHysteresis))), requestsPerSecond*(1+sizeClassHysteresis))
+		if v1beta1helper.ShootSizeClassWeight(sizeClass) >= currentWeight {
+			return *current
+		}
+	}
+
+	return sizeClass
+}
+
+// requestsPerSecond estimates the rate of requests to the API server based on the total number of requests served by
+// the API server instance since its start. Requests are load-balanced across all instances, hence the rate is
+// multiplied with the number of replicas.
+func (s *SizeClassification) requestsPerSecond(metrics []byte, replicas int32) (float64, error) {
+	var (
+		requestsTotal, startTime float64
+		scanner                  = bufio.NewScanner(bytes.NewReader(metrics))
+	)
+
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		name, value, ok := parseSample(line)
+		if !ok {
+			continue
+		}
+
+		switch name {
+		case metricAPIServerRequestTotal:
+			requestsTotal += value
+		case metricProcessStartTimeSeconds:
+			startTime = value
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return 0, fmt.Errorf("failed parsing metrics of API server: %w", err)
+	}
+
+	if startTime == 0 {
+		return 0, fmt.Errorf("metric %s not found", metricProcessStartTimeSeconds)
+	}
+
+	uptime := float64(s.clock.Now().Unix()) - startTime
+	if uptime <= 0 {
+		return 0, nil
+	}
+
+	return requestsTotal / uptime * float64(max(replicas, 1)), nil
+}
+
+// parseSample parses a sample line in the Prometheus text format and returns the metric name and the value.
+func parseSample(line string) (string, float64, bool) {
+	name, rest, found := strings.Cut(line, "{")
+	if found {
+		_, rest, found = strings.Cut(rest, "}")
+		if !found {
+			return "", 0, false
+		}
+	} else {
+		name, rest, found = strings.Cut(line, " ")
+		if !found {
+			return "", 0, false
+		}
+	}
+
+	fields := strings.Fields(rest)
+	if len(fields) == 0 {
+		return "", 0, false
+	}
+
+	value, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		return "", 0, false
+	}
+
+	return strings.TrimSpace(name), value, true
+}
@@ -0,0 +1,190 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package care_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	fakerestclient "k8s.io/client-go/rest/fake"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	kubernetesfake "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/care"
+)
+
+var _ = Describe("SizeClassification", func() {
+	var (
+		ctx           = context.Background()
+		seedNamespace = "shoot--foo--bar"
+		now           = time.Unix(1700000000, 0)
+
+		seedClient       client.Client
+		shootClient      client.Client
+		fakeRESTClient   *fakerestclient.RESTClient
+		apiServerRunning bool
+		fakeClock        *testclock.FakeClock
+		shoot            *gardencorev1beta1.Shoot
+		history          *SizeClassificationHistory
+
+		classifier *SizeClassification
+	)
+
+	BeforeEach(func() {
+		seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		shootClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).Build()
+		fakeRESTClient = &fakerestclient.RESTClient{
+			NegotiatedSerializer: serializer.NewCodecFactory(kubernetes.ShootScheme).WithoutConversion(),
+			Resp: &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(metrics(0, now.Add(-time.Hour)))),
+			},
+		}
+		apiServerRunning = true
+		fakeClock = testclock.NewFakeClock(now)
+		shoot = &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "garden-foo"}}
+		history = &SizeClassificationHistory{}
+
+		shootClientSet := kubernetesfake.NewClientSetBuilder().WithClient(shootClient).WithRESTClient(fakeRESTClient).Build()
+		shootClientInit := func() (kubernetes.Interface, bool, error) {
+			return shootClientSet, apiServerRunning, nil
+		}
+
+		Expect(seedClient.Create(ctx, &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "kube-apiserver", Namespace: seedNamespace},
+			Status:     appsv1.DeploymentStatus{ReadyReplicas: 2},
+		})).To(Succeed())
+
+		classifier = NewSizeClassification(logr.Discard(), seedClient, seedNamespace, shoot, shootClientInit, fakeClock, history)
+	})
+
+	createNodes := func(count int) {
+		for i := 0; i < count; i++ {
+			Expect(shootClient.Create(ctx, &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("node-%d", i)}})).To(Succeed())
+		}
+	}
+
+	Describe("#Classify", func() {
+		It("should return nil if the API server is not running", func() {
+			apiServerRunning = false
+
+			Expect(classifier.Classify(ctx)).To(BeNil())
+		})
+
+		It("should classify a shoot with few nodes and requests as small", func() {
+			createNodes(3)
+
+			Expect(classifier.Classify(ctx)).To(Equal(ptr.To(gardencorev1beta1.ShootSizeClassSmall)))
+		})
+
+		It("should classify the shoot based on the number of nodes", func() {
+			createNodes(11)
+
+			Expect(classifier.Classify(ctx)).To(Equal(ptr.To(gardencorev1beta1.ShootSizeClassMedium)))
+		})
+
+		It("should classify the shoot based on the request rate of all API server replicas", func() {
+			// 180000 requests in one hour served by one of two replicas result in 100 requests per second
+			fakeRESTClient.Resp.Body = io.NopCloser(strings.NewReader(metrics(180000, now.Add(-time.Hour))))
+			Expect(classifier.Classify(ctx)).To(Equal(ptr.To(gardencorev1beta1.ShootSizeClassMedium)))
+
+			*history = SizeClassificationHistory{}
+			fakeRESTClient.Resp.Body = io.NopCloser(strings.NewReader(metrics(180001, now.Add(-time.Hour))))
+			Expect(classifier.Classify(ctx)).To(Equal(ptr.To(gardencorev1beta1.ShootSizeClassLarge)))
+		})
+
+		It("should not classify the shoot again within the classification interval", func() {
+			createNodes(11)
+			Expect(classifier.Classify(ctx)).To(Equal(ptr.To(gardencorev1beta1.ShootSizeClassMedium)))
+
+			fakeClock.Step(SizeClassificationInterval - time.Second)
+			Expect(classifier.Classify(ctx)).To(BeNil())
+
+			fakeClock.Step(time.Second)
+			fakeRESTClient.Resp.Body = io.NopCloser(strings.NewReader(metrics(0, now.Add(-time.Hour))))
+			Expect(classifier.Classify(ctx)).To(Equal(ptr.To(gardencorev1beta1.ShootSizeClassMedium)))
+		})
+
+		It("should not classify the shoot again if the classification failed", func() {
+			fakeRESTClient.Err = fmt.Errorf("fake")
+			_, err := classifier.Classify(ctx)
+			Expect(err).To(HaveOccurred())
+
+			fakeRESTClient.Err = nil
+			Expect(classifier.Classify(ctx)).To(Equal(ptr.To(gardencorev1beta1.ShootSizeClassSmall)))
+		})
+
+		Context("hysteresis", func() {
+			It("should not move the shoot to a larger class if the bound is only exceeded slightly", func() {
+				shoot.Status.SizeClass = ptr.To(gardencorev1beta1.ShootSizeClassSmall)
+				createNodes(13)
+
+				Expect(classifier.Classify(ctx)).To(Equal(ptr.To(gardencorev1beta1.ShootSizeClassSmall)))
+			})
+
+			It("should move the shoot to a larger class if the bound is exceeded by the margin", func() {
+				shoot.Status.SizeClass = ptr.To(gardencorev1beta1.ShootSizeClassSmall)
+				createNodes(14)
+
+				Expect(classifier.Classify(ctx)).To(Equal(ptr.To(gardencorev1beta1.ShootSizeClassMedium)))
+			})
+
+			It("should not move the shoot to a smaller class if it is only slightly below the bound", func() {
+				shoot.Status.SizeClass = ptr.To(gardencorev1beta1.ShootSizeClassMedium)
+				createNodes(9)
+
+				Expect(classifier.Classify(ctx)).To(Equal(ptr.To(gardencorev1beta1.ShootSizeClassMedium)))
+			})
+
+			It("should move the shoot to a smaller class if it is below the bound by the margin", func() {
+				shoot.Status.SizeClass = ptr.To(gardencorev1beta1.ShootSizeClassMedium)
+				createNodes(8)
+
+				Expect(classifier.Classify(ctx)).To(Equal(ptr.To(gardencorev1beta1.ShootSizeClassSmall)))
+			})
+		})
+
+		It("should return an error if the start time of the API server cannot be determined", func() {
+			fakeRESTClient.Resp.Body = io.NopCloser(strings.NewReader(`apiserver_request_total{code="200",verb="GET"} 10`))
+
+			_, err := classifier.Classify(ctx)
+			Expect(err).To(MatchError(ContainSubstring("process_start_time_seconds not found")))
+		})
+
+		It("should return an error if the metrics cannot be fetched", func() {
+			fakeRESTClient.Err = fmt.Errorf("fake")
+
+			_, err := classifier.Classify(ctx)
+			Expect(err).To(MatchError(ContainSubstring("failed fetching metrics of API server")))
+		})
+	})
+})
+
+func metrics(requests int, startTime time.Time) string {
+	return fmt.Sprintf(`# HELP apiserver_request_total [STABLE] Counter of apiserver requests broken out for each verb, dry run value, group, version, resource, scope, component, and HTTP response code.
+# TYPE apiserver_request_total counter
+apiserver_request_total{code="200",component="apiserver",verb="GET"} %d
+apiserver_request_total{code="201",component="apiserver",verb="POST"} %d
+# HELP process_start_time_seconds Start time of the process since unix epoch in seconds.
+# TYPE process_start_time_seconds gauge
+process_start_time_seconds %d
+`, requests/2, requests-requests/2, startTime.Unix())
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -123,6 +124,52 @@ var defaultNewWebhookRemediator = func(log logr.Logger, shoot *gardencorev1beta1
 	return NewWebhookRemediation(log, shoot, init)
 }
 
+// ConditionRemediator is an interface used to execute remediation actions for failed conditions.
+type ConditionRemediator interface {
+	Remediate(ctx context.Context, conditions []gardencorev1beta1.Condition) []gardencorev1beta1.ShootRemediation
+}
+
+// NewConditionRemediatorFunc is a function used to create a new instance to execute remediation actions for failed
+// conditions.
+type NewConditionRemediatorFunc func(
+	log logr.Logger,
+	seedClient client.Client,
+	seedNamespace string,
+	shoot *gardencorev1beta1.Shoot,
+	init ShootClientInit,
+	recorder record.EventRecorder,
+	clock clock.Clock,
+	remediations []gardenletconfig.ConditionRemediation,
+) ConditionRemediator
+
+// defaultNewConditionRemediator is the default function to create a new instance to execute remediation actions for
+// failed conditions.
+var defaultNewConditionRemediator NewConditionRemediatorFunc = func(
+	log logr.Logger,
+	seedClient client.Client,
+	seedNamespace string,
+	shoot *gardencorev1beta1.Shoot,
+	init ShootClientInit,
+	recorder record.EventRecorder,
+	clock clock.Clock,
+	remediations []gardenletconfig.ConditionRemediation,
+) ConditionRemediator {
+	return NewConditionRemediation(log, seedClient, seedNamespace, shoot, init, recorder, clock, remediations)
+}
+
+// SizeClassifier is an interface used to compute the size class of a shoot cluster.
+type SizeClassifier interface {
+	Classify(ctx context.Context) (*gardencorev1beta1.ShootSizeClass, error)
+}
+
+// NewSizeClassifierFunc is a function used to create a new instance to compute the size class of a shoot cluster.
+type NewSizeClassifierFunc func(log logr.Logger, seedClient client.Client, seedNamespace string, shoot *gardencorev1beta1.Shoot, init ShootClientInit, clock clock.Clock, history *SizeClassificationHistory) SizeClassifier
+
+// defaultNewSizeClassifier is the default function to create a new instance to compute the size class of a shoot cluster.
+var defaultNewSizeClassifier NewSizeClassifierFunc = func(log logr.Logger, seedClient client.Client, seedNamespace string, shoot *gardencorev1beta1.Shoot, init ShootClientInit, clock clock.Clock, history *SizeClassificationHistory) SizeClassifier {
+	return NewSizeClassification(log, seedClient, seedNamespace, shoot, init, clock, history)
+}
+
 // NewOperationFunc is a function used to create a new `operation.Operation` instance.
 type NewOperationFunc func(
 	ctx context.Context,
@@ -0,0 +1,151 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package hibernationcleanup
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	vpaautoscalingv1 "k8s.io/autoscaler/vertical-pod-autoscaler/pkg/apis/autoscaling.k8s.io/v1"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+)
+
+// AnnotationHibernatedSince is the annotation on the shoot namespace in the seed which contains the time since which
+// the shoot is hibernated.
+const AnnotationHibernatedSince = "shoot.gardener.cloud/hibernated-since"
+
+// Reconciler prunes the stale autoscaling state of long-hibernated Shoots in the seed.
+type Reconciler struct {
+	GardenClient client.Client
+	SeedClient   client.Client
+	Config       config.ShootHibernationCleanupControllerConfiguration
+	Clock        clock.Clock
+	SeedName     string
+}
+
+// Reconcile prunes the VerticalPodAutoscaler checkpoints and recommendations in the shoot namespace of Shoots which
+// have been hibernated for longer than the configured minimum hibernation duration. The recommendations were computed
+// for the load before the hibernation, hence they would otherwise be applied to the control plane components on
+// wake-up and cause the components to be resized again and again until the recommendations have converged.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
+	defer cancel()
+
+	shoot := &gardencorev1beta1.Shoot{}
+	if err := r.GardenClient.Get(ctx, request.NamespacedName, shoot); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	// if shoot got deleted or is no longer managed by this gardenlet (e.g., due to migration to another seed) then don't requeue
+	if shoot.DeletionTimestamp != nil || ptr.Deref(shoot.Spec.SeedName, "") != r.SeedName || shoot.Status.TechnicalID == "" {
+		return reconcile.Result{}, nil
+	}
+
+	namespace := &corev1.Namespace{}
+	if err := r.SeedClient.Get(ctx, client.ObjectKey{Name: shoot.Status.TechnicalID}, namespace); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Shoot namespace does not exist in seed, nothing to clean up")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("failed reading shoot namespace %s: %w", shoot.Status.TechnicalID, err)
+	}
+
+	if !shoot.Status.IsHibernated {
+		if _, ok := namespace.Annotations[AnnotationHibernatedSince]; !ok {
+			return reconcile.Result{}, nil
+		}
+
+		log.Info("Shoot is no longer hibernated, removing hibernation timestamp from shoot namespace")
+		patch := client.MergeFrom(namespace.DeepCopy())
+		delete(namespace.Annotations, AnnotationHibernatedSince)
+		return reconcile.Result{}, r.SeedClient.Patch(ctx, namespace, patch)
+	}
+
+	var (
+		now             = r.Clock.Now().UTC()
+		hibernatedSince = now
+	)
+
+	if value, ok := namespace.Annotations[AnnotationHibernatedSince]; ok {
+		t, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed parsing annotation %s of shoot namespace %s: %w", AnnotationHibernatedSince, namespace.Name, err)
+		}
+		hibernatedSince = t.UTC()
+	} else {
+		log.Info("Shoot is hibernated, adding hibernation timestamp to shoot namespace")
+		patch := client.MergeFrom(namespace.DeepCopy())
+		metav1.SetMetaDataAnnotation(&namespace.ObjectMeta, AnnotationHibernatedSince, now.Format(time.RFC3339))
+		if err := r.SeedClient.Patch(ctx, namespace, patch); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed adding hibernation timestamp to shoot namespace %s: %w", namespace.Name, err)
+		}
+	}
+
+	minimumHibernationDuration := ptr.Deref(r.Config.MinimumHibernationDuration, metav1.Duration{}).Duration
+	if hibernatedFor := now.Sub(hibernatedSince); hibernatedFor < minimumHibernationDuration {
+		requeueAfter := minimumHibernationDuration - hibernatedFor
+		log.Info("Shoot is not yet hibernated long enough for pruning its autoscaling state", "hibernatedSince", hibernatedSince, "requeueAfter", requeueAfter)
+		return reconcile.Result{RequeueAfter: requeueAfter}, nil
+	}
+
+	log.Info("Pruning stale autoscaling state of long-hibernated shoot", "hibernatedSince", hibernatedSince)
+	if err := r.pruneAutoscalingState(ctx, namespace.Name); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if !v1beta1helper.HibernationIsEnabled(shoot) {
+		// The shoot is waking up. Its autoscaling state was pruned a last time, so that the control plane components start
+		// with their baseline resource requests. The hibernation timestamp is removed once the wake-up has finished.
+		return reconcile.Result{}, nil
+	}
+
+	// The VPA recommender might restore checkpoints from its in-memory state, hence the state is pruned periodically for
+	// as long as the shoot is hibernated.
+	return reconcile.Result{RequeueAfter: ptr.Deref(r.Config.SyncPeriod, metav1.Duration{Duration: time.Hour}).Duration}, nil
+}
+
+func (r *Reconciler) pruneAutoscalingState(ctx context.Context, namespace string) error {
+	if err := r.SeedClient.DeleteAllOf(ctx, &vpaautoscalingv1.VerticalPodAutoscalerCheckpoint{}, client.InNamespace(namespace)); err != nil {
+		return fmt.Errorf("failed deleting VerticalPodAutoscalerCheckpoints in namespace %s: %w", namespace, err)
+	}
+
+	vpaList := &vpaautoscalingv1.VerticalPodAutoscalerList{}
+	if err := r.SeedClient.List(ctx, vpaList, client.InNamespace(namespace)); err != nil {
+		return fmt.Errorf("failed listing VerticalPodAutoscalers in namespace %s: %w", namespace, err)
+	}
+
+	for _, vpa := range vpaList.Items {
+		if vpa.Status.Recommendation == nil {
+			continue
+		}
+
+		patch := client.MergeFrom(vpa.DeepCopy())
+		vpa.Status.Recommendation = nil
+		if err := r.SeedClient.Status().Patch(ctx, &vpa, patch); err != nil {
+			return fmt.Errorf("failed resetting recommendation of VerticalPodAutoscaler %s: %w", client.ObjectKeyFromObject(&vpa), err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,202 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package hibernationcleanup_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	vpaautoscalingv1 "k8s.io/autoscaler/vertical-pod-autoscaler/pkg/apis/autoscaling.k8s.io/v1"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/hibernationcleanup"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx = context.Background()
+
+		seedName = "seed"
+		now      = time.Date(2024, 10, 1, 12, 0, 0, 0, time.UTC)
+
+		gardenClient client.Client
+		seedClient   client.Client
+		fakeClock    *testclock.FakeClock
+		reconciler   *Reconciler
+		request      reconcile.Request
+
+		shoot      *gardencorev1beta1.Shoot
+		namespace  *corev1.Namespace
+		vpa        *vpaautoscalingv1.VerticalPodAutoscaler
+		checkpoint *vpaautoscalingv1.VerticalPodAutoscalerCheckpoint
+	)
+
+	BeforeEach(func() {
+		gardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+		seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithStatusSubresource(&vpaautoscalingv1.VerticalPodAutoscaler{}).Build()
+		fakeClock = testclock.NewFakeClock(now)
+
+		reconciler = &Reconciler{
+			GardenClient: gardenClient,
+			SeedClient:   seedClient,
+			Config: config.ShootHibernationCleanupControllerConfiguration{
+				SyncPeriod:                 &metav1.Duration{Duration: time.Hour},
+				MinimumHibernationDuration: &metav1.Duration{Duration: 24 * time.Hour},
+			},
+			Clock:    fakeClock,
+			SeedName: seedName,
+		}
+
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-project"},
+			Spec: gardencorev1beta1.ShootSpec{
+				SeedName:    &seedName,
+				Hibernation: &gardencorev1beta1.Hibernation{Enabled: ptr.To(true)},
+			},
+			Status: gardencorev1beta1.ShootStatus{
+				TechnicalID:  "shoot--project--shoot",
+				IsHibernated: true,
+			},
+		}
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)}
+
+		namespace = &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: shoot.Status.TechnicalID}}
+		vpa = &vpaautoscalingv1.VerticalPodAutoscaler{
+			ObjectMeta: metav1.ObjectMeta{Name: "kube-apiserver", Namespace: namespace.Name},
+			Status: vpaautoscalingv1.VerticalPodAutoscalerStatus{
+				Recommendation: &vpaautoscalingv1.RecommendedPodResources{
+					ContainerRecommendations: []vpaautoscalingv1.RecommendedContainerResources{{
+						ContainerName: "kube-apiserver",
+						Target:        corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2")},
+					}},
+				},
+			},
+		}
+		checkpoint = &vpaautoscalingv1.VerticalPodAutoscalerCheckpoint{
+			ObjectMeta: metav1.ObjectMeta{Name: "kube-apiserver", Namespace: namespace.Name},
+		}
+	})
+
+	JustBeforeEach(func() {
+		Expect(gardenClient.Create(ctx, shoot)).To(Succeed())
+		Expect(seedClient.Create(ctx, namespace)).To(Succeed())
+		Expect(seedClient.Create(ctx, vpa)).To(Succeed())
+		Expect(seedClient.Create(ctx, checkpoint)).To(Succeed())
+	})
+
+	expectAutoscalingStateKept := func() {
+		ExpectWithOffset(1, seedClient.Get(ctx, client.ObjectKeyFromObject(checkpoint), checkpoint)).To(Succeed())
+		ExpectWithOffset(1, seedClient.Get(ctx, client.ObjectKeyFromObject(vpa), vpa)).To(Succeed())
+		ExpectWithOffset(1, vpa.Status.Recommendation).NotTo(BeNil())
+	}
+
+	expectAutoscalingStatePruned := func() {
+		ExpectWithOffset(1, seedClient.Get(ctx, client.ObjectKeyFromObject(checkpoint), checkpoint)).To(BeNotFoundError())
+		ExpectWithOffset(1, seedClient.Get(ctx, client.ObjectKeyFromObject(vpa), vpa)).To(Succeed())
+		ExpectWithOffset(1, vpa.Status.Recommendation).To(BeNil())
+	}
+
+	Context("when the shoot is not hibernated", func() {
+		BeforeEach(func() {
+			shoot.Spec.Hibernation = nil
+			shoot.Status.IsHibernated = false
+			namespace.Annotations = map[string]string{"shoot.gardener.cloud/hibernated-since": now.Add(-48 * time.Hour).Format(time.RFC3339)}
+		})
+
+		It("should remove the hibernation timestamp and keep the autoscaling state", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+			Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(namespace), namespace)).To(Succeed())
+			Expect(namespace.Annotations).NotTo(HaveKey("shoot.gardener.cloud/hibernated-since"))
+			expectAutoscalingStateKept()
+		})
+	})
+
+	Context("when the shoot is hosted by another seed", func() {
+		BeforeEach(func() {
+			shoot.Spec.SeedName = ptr.To("other-seed")
+			namespace.Annotations = map[string]string{"shoot.gardener.cloud/hibernated-since": now.Add(-48 * time.Hour).Format(time.RFC3339)}
+		})
+
+		It("should do nothing", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+			expectAutoscalingStateKept()
+		})
+	})
+
+	Context("when the shoot just got hibernated", func() {
+		It("should record the hibernation timestamp and requeue", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: 24 * time.Hour}))
+
+			Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(namespace), namespace)).To(Succeed())
+			Expect(namespace.Annotations).To(HaveKeyWithValue("shoot.gardener.cloud/hibernated-since", "2024-10-01T12:00:00Z"))
+			expectAutoscalingStateKept()
+		})
+	})
+
+	Context("when the shoot is not yet hibernated long enough", func() {
+		BeforeEach(func() {
+			namespace.Annotations = map[string]string{"shoot.gardener.cloud/hibernated-since": now.Add(-20 * time.Hour).Format(time.RFC3339)}
+		})
+
+		It("should requeue until the minimum hibernation duration passed", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: 4 * time.Hour}))
+
+			expectAutoscalingStateKept()
+		})
+	})
+
+	Context("when the shoot is hibernated long enough", func() {
+		BeforeEach(func() {
+			namespace.Annotations = map[string]string{"shoot.gardener.cloud/hibernated-since": now.Add(-30 * time.Hour).Format(time.RFC3339)}
+		})
+
+		It("should prune the autoscaling state and requeue", func() {
+			Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Hour}))
+
+			expectAutoscalingStatePruned()
+		})
+
+		Context("when the shoot is waking up", func() {
+			BeforeEach(func() {
+				shoot.Spec.Hibernation.Enabled = ptr.To(false)
+			})
+
+			It("should prune the autoscaling state a last time and not requeue", func() {
+				Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+				expectAutoscalingStatePruned()
+
+				Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(namespace), namespace)).To(Succeed())
+				Expect(namespace.Annotations).To(HaveKey("shoot.gardener.cloud/hibernated-since"))
+			})
+		})
+	})
+
+	Context("when the hibernation timestamp cannot be parsed", func() {
+		BeforeEach(func() {
+			namespace.Annotations = map[string]string{"shoot.gardener.cloud/hibernated-since": "foo"}
+		})
+
+		It("should return an error", func() {
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).To(MatchError(ContainSubstring("failed parsing annotation shoot.gardener.cloud/hibernated-since")))
+		})
+	})
+})
@@ -33,6 +33,7 @@ var (
 
 var (
 	extensionKindToObjectList = map[string]client.ObjectList{
+		extensionsv1alpha1.AuditSinkResource:             &extensionsv1alpha1.AuditSinkList{},
 		extensionsv1alpha1.ContainerRuntimeResource:      &extensionsv1alpha1.ContainerRuntimeList{},
 		extensionsv1alpha1.ControlPlaneResource:          &extensionsv1alpha1.ControlPlaneList{},
 		extensionsv1alpha1.ExtensionResource:             &extensionsv1alpha1.ExtensionList{},
@@ -84,9 +85,52 @@ func (c *cleaner) DeleteExtensionObjects(ctx context.Context) error {
 
 // WaitUntilExtensionObjectsDeleted waits until all extension objects in the shoot namespace have been deleted.
 func (c *cleaner) WaitUntilExtensionObjectsDeleted(ctx context.Context) error {
+	return c.waitUntilExtensionObjectsDeleted(ctx, DefaultTimeout)
+}
+
+// WaitUntilExtensionObjectsDeletedOrFinalize waits until all extension objects in the shoot namespace have been
+// deleted. If they are not gone within the given grace period, their finalizers are removed so that extension
+// controllers which fail to clean up do not block the deletion.
+func (c *cleaner) WaitUntilExtensionObjectsDeletedOrFinalize(gracePeriod time.Duration) flow.TaskFn {
+	return func(ctx context.Context) error {
+		if err := c.waitUntilExtensionObjectsDeleted(ctx, gracePeriod); err == nil || ctx.Err() != nil {
+			return err
+		}
+
+		c.log.Info("Extension resources have not been deleted within grace period, removing their finalizers", "gracePeriod", gracePeriod)
+		if err := c.FinalizeExtensionObjects(ctx); err != nil {
+			return err
+		}
+
+		return c.WaitUntilExtensionObjectsDeleted(ctx)
+	}
+}
+
+// FinalizeExtensionObjects removes the finalizers of all extension objects in the shoot namespace which are already
+// marked for deletion.
+func (c *cleaner) FinalizeExtensionObjects(ctx context.Context) error {
+	return utilclient.ApplyToObjectKinds(ctx, func(_ string, objectList client.ObjectList) flow.TaskFn {
+		return func(ctx context.Context) error {
+			if err := c.seedClient.List(ctx, objectList, client.InNamespace(c.seedNamespace)); err != nil {
+				return err
+			}
+
+			return utilclient.ApplyToObjects(ctx, objectList, func(ctx context.Context, object client.Object) error {
+				if object.GetDeletionTimestamp() == nil || len(object.GetFinalizers()) == 0 {
+					return nil
+				}
+
+				c.log.Info("Removing finalizers", "kind", object.GetObjectKind().GroupVersionKind().Kind, "object", client.ObjectKeyFromObject(object))
+				return controllerutils.RemoveAllFinalizers(ctx, c.seedClient, object)
+			})
+		}
+	}, extensionKindToObjectList)
+}
+
+func (c *cleaner) waitUntilExtensionObjectsDeleted(ctx context.Context, timeout time.Duration) error {
 	return utilclient.ApplyToObjectKinds(ctx, func(kind string, objectList client.ObjectList) flow.TaskFn {
 		return func(ctx context.Context) error {
-			return extensions.WaitUntilExtensionObjectsDeleted(ctx, c.seedClient, c.log, objectList, kind, c.seedNamespace, DefaultInterval, DefaultTimeout, nil)
+			return extensions.WaitUntilExtensionObjectsDeleted(ctx, c.seedClient, c.log, objectList, kind, c.seedNamespace, DefaultInterval, timeout, nil)
 		}
 	}, extensionKindToObjectList)
 }
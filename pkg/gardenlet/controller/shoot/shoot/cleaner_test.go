@@ -202,6 +202,46 @@ var _ = Describe("cleaner", func() {
 		})
 	})
 
+	Describe("#WaitUntilExtensionObjectsDeletedOrFinalize", func() {
+		It("should not remove finalizers if the extension objects are deleted within the grace period", func() {
+			Expect(cleaner.WaitUntilExtensionObjectsDeletedOrFinalize(time.Minute)(ctx)).To(Succeed())
+		})
+
+		It("should remove the finalizers of extension objects which are not deleted within the grace period", func() {
+			controlPlane.Finalizers = []string{finalizer}
+			extension.Finalizers = []string{finalizer}
+
+			for _, object := range []client.Object{controlPlane, extension} {
+				Expect(seedClient.Create(ctx, object)).To(Succeed())
+				Expect(seedClient.Delete(ctx, object)).To(Succeed())
+			}
+
+			Expect(cleaner.WaitUntilExtensionObjectsDeletedOrFinalize(200 * time.Millisecond)(ctx)).To(Succeed())
+
+			for _, object := range []client.Object{controlPlane, extension} {
+				Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(object), object)).To(BeNotFoundError())
+			}
+		})
+	})
+
+	Describe("#FinalizeExtensionObjects", func() {
+		It("should only remove the finalizers of extension objects marked for deletion", func() {
+			controlPlane.Finalizers = []string{finalizer}
+			extension.Finalizers = []string{finalizer}
+
+			for _, object := range []client.Object{controlPlane, extension} {
+				Expect(seedClient.Create(ctx, object)).To(Succeed())
+			}
+			Expect(seedClient.Delete(ctx, controlPlane)).To(Succeed())
+
+			Expect(cleaner.FinalizeExtensionObjects(ctx)).To(Succeed())
+
+			Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(controlPlane), controlPlane)).To(BeNotFoundError())
+			Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(extension), extension)).To(Succeed())
+			Expect(extension.Finalizers).To(ConsistOf(finalizer))
+		})
+	})
+
 	Describe("#DeleteMachineResources", func() {
 		It("should successfully delete all machine related resources in the given namespace", func() {
 			machine.Finalizers = []string{finalizer}
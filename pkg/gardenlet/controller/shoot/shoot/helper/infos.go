@@ -12,6 +12,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	gardenletconfig "github.com/gardener/gardener/pkg/gardenlet/apis/config"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
@@ -64,13 +65,23 @@ type ControllerInfos struct {
 	respectSyncPeriodOverwrite bool
 
 	// based on shoot object
-	isIgnored                             bool
-	isFailed                              bool
-	isUpToDate                            bool
-	confineSpecUpdateRollout              bool
-	maintenanceTimeWindow                 *timewindow.MaintenanceTimeWindow
+	isIgnored                bool
+	isFailed                 bool
+	isUpToDate               bool
+	confineSpecUpdateRollout bool
+	// maintenanceTimeWindows contains the time window of the shoot and the time windows of its worker pools, the shoot is
+	// regularly reconciled in all of them
+	maintenanceTimeWindows []*timewindow.MaintenanceTimeWindow
+	// currentMaintenanceTimeWindow is set to the first of the maintenanceTimeWindows which contains the current time
+	currentMaintenanceTimeWindow          *timewindow.MaintenanceTimeWindow
 	isNowInEffectiveMaintenanceTimeWindow bool
 	alreadyReconciledDuringThisTimeWindow bool
+	// durationUntilExclusionEnd is set if the shoot requests an operation that is excluded by an active maintenance
+	// exclusion
+	durationUntilExclusionEnd *time.Duration
+	// durationUntilEtcdMaintenanceExclusionEnd is set if the shoot has an active maintenance exclusion for etcd
+	// maintenance
+	durationUntilEtcdMaintenanceExclusionEnd *time.Duration
 
 	// based on gardenlet config and shoot object
 	syncPeriod time.Duration
@@ -80,7 +91,11 @@ type ControllerInfos struct {
 // These results are supposed to be used/handled immediately. Always call CalculateControllerInfos to re-calculate the
 // results before using them.
 func CalculateControllerInfos(shoot *gardencorev1beta1.Shoot, clock clock.Clock, cfg gardenletconfig.ShootControllerConfiguration) ControllerInfos {
-	respectSyncPeriodOverwrite := ptr.Deref(cfg.RespectSyncPeriodOverwrite, false)
+	var (
+		respectSyncPeriodOverwrite = ptr.Deref(cfg.RespectSyncPeriodOverwrite, false)
+		maintenanceTimeWindows     = gardenerutils.EffectiveMaintenanceTimeWindows(shoot)
+		currentWindow              = currentMaintenanceTimeWindow(maintenanceTimeWindows, clock)
+	)
 
 	i := ControllerInfos{
 		OperationType: ComputeOperationType(shoot),
@@ -93,9 +108,13 @@ func CalculateControllerInfos(shoot *gardencorev1beta1.Shoot, clock clock.Clock,
 		isFailed:                              gardenerutils.IsShootFailedAndUpToDate(shoot),
 		isUpToDate:                            gardenerutils.IsObservedAtLatestGenerationAndSucceeded(shoot),
 		confineSpecUpdateRollout:              v1beta1helper.ShootConfinesSpecUpdateRollout(shoot.Spec.Maintenance),
-		maintenanceTimeWindow:                 gardenerutils.EffectiveShootMaintenanceTimeWindow(shoot),
-		isNowInEffectiveMaintenanceTimeWindow: gardenerutils.IsNowInEffectiveShootMaintenanceTimeWindow(shoot, clock),
-		alreadyReconciledDuringThisTimeWindow: gardenerutils.LastReconciliationDuringThisTimeWindow(shoot, clock),
+		maintenanceTimeWindows:                maintenanceTimeWindows,
+		currentMaintenanceTimeWindow:          currentWindow,
+		isNowInEffectiveMaintenanceTimeWindow: currentWindow != nil,
+		alreadyReconciledDuringThisTimeWindow: currentWindow != nil && gardenerutils.LastReconciliationDuringTimeWindow(shoot, currentWindow, clock),
+		durationUntilExclusionEnd:             durationUntilExclusionEnd(shoot, clock),
+
+		durationUntilEtcdMaintenanceExclusionEnd: durationUntilEtcdMaintenanceExclusionEnd(shoot, clock),
 
 		syncPeriod: gardenerutils.SyncPeriodOfShoot(respectSyncPeriodOverwrite, cfg.SyncPeriod.Duration, shoot),
 	}
@@ -114,6 +133,12 @@ func (i ControllerInfos) shouldReconcileNow() bool {
 		return false
 	}
 
+	// if the shoot requests an operation that is excluded by one of its active maintenance exclusions, we postpone the
+	// operation until the exclusion ends
+	if i.durationUntilExclusionEnd != nil {
+		return false
+	}
+
 	// in the following checks, we optimize when and how often existing shoots are reconciled
 	// all operations other than Reconcile are excluded from these optimizations as we want to perform the operations immediately
 	if i.OperationType != gardencorev1beta1.LastOperationTypeReconcile {
@@ -160,6 +185,12 @@ func (i ControllerInfos) enqueueAfter() time.Duration {
 		return 0
 	}
 
+	// if the shoot requests an operation that is excluded by one of its active maintenance exclusions, we enqueue the
+	// shoot when the exclusion ends
+	if i.durationUntilExclusionEnd != nil {
+		return *i.durationUntilExclusionEnd
+	}
+
 	// in the following checks, we optimize when existing shoots are reconciled after gardenlet startup to prevent
 	// overload situations caused by immediately reconciling all existing shoots
 	// all operations other than Reconcile are excluded from these optimizations as we want to perform the operations immediately
@@ -189,12 +220,12 @@ func (i ControllerInfos) enqueueAfter() time.Duration {
 	// if the shoot is in its maintenance time window right now but has not been reconciled in the time window so far,
 	// schedule the regular reconciliation for a random time in this time window
 	if i.isNowInEffectiveMaintenanceTimeWindow && !i.alreadyReconciledDuringThisTimeWindow {
-		return i.maintenanceTimeWindow.RandomDurationUntilNext(i.clock.Now(), true)
+		return i.currentMaintenanceTimeWindow.RandomDurationUntilNext(i.clock.Now(), true)
 	}
 
 	// shoot reconciliations are confined, and the shoot's maintenance time window is not met, or it was already
 	// reconciled in this time window -> schedule a reconciliation during the next maintenance time window
-	return i.maintenanceTimeWindow.RandomDurationUntilNext(i.clock.Now(), false)
+	return i.durationUntilNextMaintenanceTimeWindow()
 }
 
 func (i ControllerInfos) requeueAfter() reconcile.Result {
@@ -203,6 +234,12 @@ func (i ControllerInfos) requeueAfter() reconcile.Result {
 		return reconcile.Result{}
 	}
 
+	// if the shoot requests an operation that is excluded by one of its active maintenance exclusions, we requeue the
+	// shoot when the exclusion ends
+	if i.durationUntilExclusionEnd != nil {
+		return reconcile.Result{RequeueAfter: *i.durationUntilExclusionEnd}
+	}
+
 	// from here on, we have a case of regular reconciliation (i.e., shoot is observed at latest generation and in
 	// 'Reconcile Succeeded' state).
 
@@ -210,7 +247,7 @@ func (i ControllerInfos) requeueAfter() reconcile.Result {
 	// we requeue according to the sync period configured in gardenlet configuration (which might have been overwritten on
 	// the shoot via annotation).
 	if !i.reconcileInMaintenanceOnly && !i.confineSpecUpdateRollout {
-		return reconcile.Result{RequeueAfter: i.syncPeriod}
+		return i.resumeEtcdMaintenanceIfEarlier(reconcile.Result{RequeueAfter: i.syncPeriod})
 	}
 
 	// from here on, either:
@@ -219,5 +256,91 @@ func (i ControllerInfos) requeueAfter() reconcile.Result {
 
 	// shoot reconciliations are confined, and the shoot's maintenance time window is not met, or it was already
 	// reconciled in this time window -> schedule a reconciliation during the next maintenance time window
-	return reconcile.Result{RequeueAfter: i.maintenanceTimeWindow.RandomDurationUntilNext(i.clock.Now(), false)}
+	return i.resumeEtcdMaintenanceIfEarlier(reconcile.Result{RequeueAfter: i.durationUntilNextMaintenanceTimeWindow()})
+}
+
+// durationUntilNextMaintenanceTimeWindow returns a random duration until the earliest of the next maintenance time
+// windows of the shoot and its worker pools.
+func (i ControllerInfos) durationUntilNextMaintenanceTimeWindow() time.Duration {
+	var duration time.Duration
+
+	for j, window := range i.maintenanceTimeWindows {
+		if d := window.RandomDurationUntilNext(i.clock.Now(), false); j == 0 || d < duration {
+			duration = d
+		}
+	}
+
+	return duration
+}
+
+// currentMaintenanceTimeWindow returns the first of the given maintenance time windows which contains the current time.
+// It returns nil if the current time is not contained in any of them.
+func currentMaintenanceTimeWindow(windows []*timewindow.MaintenanceTimeWindow, clock clock.Clock) *timewindow.MaintenanceTimeWindow {
+	for _, window := range windows {
+		if window.Contains(clock.Now()) {
+			return window
+		}
+	}
+	return nil
+}
+
+func (i ControllerInfos) resumeEtcdMaintenanceIfEarlier(result reconcile.Result) reconcile.Result {
+	// if etcd maintenance is not excluded right now, there is nothing to resume
+	if i.durationUntilEtcdMaintenanceExclusionEnd == nil {
+		return result
+	}
+
+	// etcd maintenance is suspended by gardenlet during the exclusion, hence the shoot needs to be reconciled when the
+	// exclusion ends to resume it -> schedule the next reconciliation at the end of the exclusion if this is earlier
+	if *i.durationUntilEtcdMaintenanceExclusionEnd < result.RequeueAfter {
+		return reconcile.Result{RequeueAfter: *i.durationUntilEtcdMaintenanceExclusionEnd}
+	}
+
+	return result
+}
+
+// durationUntilExclusionEnd returns the duration until the active maintenance exclusion ends which excludes the
+// operation requested via the operation annotation of the given shoot. It returns nil if the requested operation is not
+// excluded right now.
+func durationUntilExclusionEnd(shoot *gardencorev1beta1.Shoot, clock clock.Clock) *time.Duration {
+	var excludedOperations []gardencorev1beta1.MaintenanceExclusionOperation
+
+	switch shoot.Annotations[v1beta1constants.GardenerOperation] {
+	case v1beta1constants.OperationRotateCAStart, v1beta1constants.OperationRotateCredentialsStart:
+		// starting the CA rotation rolls the nodes of the shoot
+		excludedOperations = []gardencorev1beta1.MaintenanceExclusionOperation{
+			gardencorev1beta1.MaintenanceExclusionOperationCARotation,
+			gardencorev1beta1.MaintenanceExclusionOperationNodeRoll,
+		}
+	case v1beta1constants.OperationRotateCAComplete, v1beta1constants.OperationRotateCredentialsComplete:
+		excludedOperations = []gardencorev1beta1.MaintenanceExclusionOperation{
+			gardencorev1beta1.MaintenanceExclusionOperationCARotation,
+		}
+	default:
+		return nil
+	}
+
+	var exclusionEnd *time.Time
+	for _, operation := range excludedOperations {
+		if exclusion := v1beta1helper.ActiveMaintenanceExclusion(shoot.Spec.Maintenance, operation, clock.Now()); exclusion != nil {
+			if exclusionEnd == nil || exclusion.End.After(*exclusionEnd) {
+				exclusionEnd = &exclusion.End.Time
+			}
+		}
+	}
+
+	if exclusionEnd == nil {
+		return nil
+	}
+	return ptr.To(exclusionEnd.Sub(clock.Now()))
+}
+
+// durationUntilEtcdMaintenanceExclusionEnd returns the duration until the active maintenance exclusion for etcd
+// maintenance of the given shoot ends. It returns nil if etcd maintenance is not excluded right now.
+func durationUntilEtcdMaintenanceExclusionEnd(shoot *gardencorev1beta1.Shoot, clock clock.Clock) *time.Duration {
+	exclusion := v1beta1helper.ActiveMaintenanceExclusion(shoot.Spec.Maintenance, gardencorev1beta1.MaintenanceExclusionOperationEtcdMaintenance, clock.Now())
+	if exclusion == nil {
+		return nil
+	}
+	return ptr.To(exclusion.End.Sub(clock.Now()))
 }
@@ -338,6 +338,178 @@ var _ = Describe("CalculateControllerInfos", func() {
 
 				testReconciliationsConfined()
 			})
+
+			Context("worker pool defines its own maintenance time window", func() {
+				var workerPoolTimeWindow timewindow.MaintenanceTimeWindow
+
+				BeforeEach(func() {
+					cfg.ReconcileInMaintenanceOnly = ptr.To(true)
+					shoot.Spec.Maintenance.WorkerPools = []gardencorev1beta1.WorkerPoolMaintenance{{
+						Name:       "worker",
+						TimeWindow: gardencorev1beta1.MaintenanceTimeWindow{Begin: "120000+0000", End: "130000+0000"},
+					}}
+					workerPoolTimeWindow = *gardenerutils.EffectiveWorkerPoolMaintenanceTimeWindow(shoot, "worker")
+				})
+
+				Context("currently not in any maintenance time window", func() {
+					It("should enqueue the shoot during the next maintenance time window of the worker pool", func() {
+						enqueueAfter := infos.EnqueueAfter
+						Expect(enqueueAfter).To(BeNumerically(">", 2*time.Hour))
+						Expect(enqueueAfter).To(BeNumerically("<", 3*time.Hour))
+						Expect(workerPoolTimeWindow.Contains(cl.Now().Add(enqueueAfter))).To(BeTrue())
+					})
+				})
+
+				Context("currently in maintenance time window of the worker pool", func() {
+					BeforeEach(func() {
+						cl.SetTime(time.Date(2022, 12, 12, 12, 5, 0, 0, time.UTC))
+					})
+
+					It("should reconcile the shoot immediately", func() {
+						Expect(infos.ShouldReconcileNow).To(BeTrue())
+					})
+
+					Context("already reconciled in this maintenance time window", func() {
+						BeforeEach(func() {
+							shoot.Status.LastOperation.LastUpdateTime = metav1.NewTime(time.Date(2022, 12, 12, 12, 1, 0, 0, time.UTC))
+						})
+
+						It("should not reconcile the shoot immediately", func() {
+							Expect(infos.ShouldReconcileNow).To(BeFalse())
+						})
+
+						It("should requeue the shoot during the next maintenance time window of the shoot", func() {
+							requeueAfter := infos.RequeueAfter
+							Expect(requeueAfter.RequeueAfter).To(BeNumerically(">", 9*time.Hour))
+							Expect(requeueAfter.RequeueAfter).To(BeNumerically("<", 11*time.Hour))
+							Expect(timeWindow.Contains(cl.Now().Add(requeueAfter.RequeueAfter))).To(BeTrue())
+						})
+					})
+				})
+			})
+		})
+
+		Context("maintenance exclusions", func() {
+			var exclusionEnd time.Time
+
+			BeforeEach(func() {
+				exclusionEnd = cl.Now().Add(48 * time.Hour)
+
+				shoot.Spec.Maintenance.Exclusions = []gardencorev1beta1.MaintenanceExclusion{{
+					Name:       "peak-season",
+					Begin:      metav1.NewTime(cl.Now().Add(-time.Hour)),
+					End:        metav1.NewTime(exclusionEnd),
+					Operations: []gardencorev1beta1.MaintenanceExclusionOperation{gardencorev1beta1.MaintenanceExclusionOperationCARotation},
+				}}
+			})
+
+			Context("CA rotation start is requested", func() {
+				BeforeEach(func() {
+					shoot.Generation++
+					metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.GardenerOperation, v1beta1constants.OperationRotateCAStart)
+				})
+
+				It("should not reconcile the shoot immediately", func() {
+					Expect(infos.ShouldReconcileNow).To(BeFalse())
+					Expect(infos.ShouldOnlySyncClusterResource).To(BeFalse())
+				})
+
+				It("should enqueue the shoot when the exclusion ends", func() {
+					Expect(infos.EnqueueAfter).To(Equal(exclusionEnd.Sub(cl.Now())))
+				})
+
+				It("should requeue the shoot when the exclusion ends", func() {
+					Expect(infos.RequeueAfter).To(Equal(reconcile.Result{RequeueAfter: exclusionEnd.Sub(cl.Now())}))
+				})
+
+				Context("exclusion has ended", func() {
+					BeforeEach(func() {
+						cl.Step(48 * time.Hour)
+					})
+
+					It("should reconcile the shoot immediately", func() {
+						Expect(infos.ShouldReconcileNow).To(BeTrue())
+						Expect(infos.EnqueueAfter).To(Equal(time.Duration(0)))
+					})
+				})
+			})
+
+			Context("credentials rotation completion is requested", func() {
+				BeforeEach(func() {
+					shoot.Generation++
+					metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.GardenerOperation, v1beta1constants.OperationRotateCredentialsComplete)
+				})
+
+				It("should not reconcile the shoot immediately", func() {
+					Expect(infos.ShouldReconcileNow).To(BeFalse())
+					Expect(infos.EnqueueAfter).To(Equal(exclusionEnd.Sub(cl.Now())))
+				})
+			})
+
+			Context("CA rotation start is requested but only node rolls are excluded", func() {
+				BeforeEach(func() {
+					shoot.Spec.Maintenance.Exclusions[0].Operations = []gardencorev1beta1.MaintenanceExclusionOperation{gardencorev1beta1.MaintenanceExclusionOperationNodeRoll}
+
+					shoot.Generation++
+					metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.GardenerOperation, v1beta1constants.OperationRotateCAStart)
+				})
+
+				It("should not reconcile the shoot immediately", func() {
+					Expect(infos.ShouldReconcileNow).To(BeFalse())
+					Expect(infos.EnqueueAfter).To(Equal(exclusionEnd.Sub(cl.Now())))
+				})
+			})
+
+			Context("CA rotation completion is requested but only node rolls are excluded", func() {
+				BeforeEach(func() {
+					shoot.Spec.Maintenance.Exclusions[0].Operations = []gardencorev1beta1.MaintenanceExclusionOperation{gardencorev1beta1.MaintenanceExclusionOperationNodeRoll}
+
+					shoot.Generation++
+					metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.GardenerOperation, v1beta1constants.OperationRotateCAComplete)
+				})
+
+				It("should reconcile the shoot immediately", func() {
+					Expect(infos.ShouldReconcileNow).To(BeTrue())
+					Expect(infos.EnqueueAfter).To(Equal(time.Duration(0)))
+				})
+			})
+
+			Context("no excluded operation is requested", func() {
+				It("should reconcile the shoot immediately", func() {
+					Expect(infos.ShouldReconcileNow).To(BeTrue())
+					Expect(infos.EnqueueAfter).To(Equal(time.Duration(0)))
+				})
+
+				It("should requeue with the general sync period", func() {
+					Expect(infos.RequeueAfter).To(Equal(reconcile.Result{RequeueAfter: cfg.SyncPeriod.Duration}))
+				})
+			})
+
+			Context("etcd maintenance is excluded", func() {
+				BeforeEach(func() {
+					shoot.Spec.Maintenance.Exclusions[0].Operations = []gardencorev1beta1.MaintenanceExclusionOperation{gardencorev1beta1.MaintenanceExclusionOperationEtcdMaintenance}
+				})
+
+				It("should reconcile the shoot immediately", func() {
+					Expect(infos.ShouldReconcileNow).To(BeTrue())
+					Expect(infos.EnqueueAfter).To(Equal(time.Duration(0)))
+				})
+
+				It("should requeue with the general sync period if the exclusion ends later", func() {
+					Expect(infos.RequeueAfter).To(Equal(reconcile.Result{RequeueAfter: cfg.SyncPeriod.Duration}))
+				})
+
+				Context("exclusion ends before the next regular reconciliation", func() {
+					BeforeEach(func() {
+						exclusionEnd = cl.Now().Add(10 * time.Minute)
+						shoot.Spec.Maintenance.Exclusions[0].End = metav1.NewTime(exclusionEnd)
+					})
+
+					It("should requeue the shoot when the exclusion ends", func() {
+						Expect(infos.RequeueAfter).To(Equal(reconcile.Result{RequeueAfter: 10 * time.Minute}))
+					})
+				})
+			})
 		})
 
 		Context("shoot is ignored", func() {
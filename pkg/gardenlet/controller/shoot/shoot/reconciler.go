@@ -39,6 +39,7 @@ import (
 	"github.com/gardener/gardener/pkg/features"
 	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/shoot/helper"
+	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/shoot/triage"
 	"github.com/gardener/gardener/pkg/gardenlet/operation"
 	botanistpkg "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
 	"github.com/gardener/gardener/pkg/gardenlet/operation/garden"
@@ -50,10 +51,17 @@ import (
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
+	"github.com/gardener/gardener/pkg/utils/oci"
 	retryutils "github.com/gardener/gardener/pkg/utils/retry"
 )
 
-const taskID = "initializeOperation"
+const (
+	taskID = "initializeOperation"
+	// requeueAfterSeedOutageMode is the duration after which a Shoot is requeued while its Seed is in outage mode.
+	requeueAfterSeedOutageMode = time.Minute
+	// eventObjectRecoveryFailed is the reason of the event which reports a failed recovery of objects from the etcd backup.
+	eventObjectRecoveryFailed = "ObjectRecoveryFailed"
+)
 
 // Reconciler implements the main shoot reconciliation logic, i.e., creation, hibernation, migration and deletion.
 type Reconciler struct {
@@ -66,6 +74,7 @@ type Reconciler struct {
 	GardenClusterIdentity       string
 	Clock                       clock.Clock
 	ShootStateControllerEnabled bool
+	HelmRegistry                oci.Interface
 }
 
 // Reconcile implements the main shoot reconciliation logic, i.e., creation, hibernation, migration and deletion.
@@ -86,6 +95,27 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{}, nil
 	}
 
+	seed := &gardencorev1beta1.Seed{}
+	if err := r.GardenClient.Get(ctx, client.ObjectKey{Name: r.Config.SeedConfig.Name}, seed); err != nil {
+		return reconcile.Result{}, fmt.Errorf("error retrieving seed: %w", err)
+	}
+
+	seedOutageModeActive := v1beta1helper.SeedOutageModeActive(seed)
+	if err := r.patchReconciliationDegradedCondition(ctx, shoot, seedOutageModeActive); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed patching %s condition: %w", gardencorev1beta1.ShootReconciliationDegraded, err)
+	}
+
+	if seedOutageModeActive {
+		// Only health-critical actions (e.g., the health checks of the care controller) are performed for the Shoot while
+		// its Seed is in outage mode. Starting flows which roll out or rotate anything is postponed to prevent that they
+		// are interrupted half-way by the planned work on the Seed.
+		if shoot.DeletionTimestamp != nil {
+			r.Recorder.Eventf(shoot, corev1.EventTypeWarning, gardencorev1beta1.ShootEventDeletionPostponed, "Deletion of Shoot cluster is postponed while Seed %q is in outage mode", r.Config.SeedConfig.Name)
+		}
+		log.Info("Skipping because Seed is in outage mode", "requeueAfter", requeueAfterSeedOutageMode)
+		return reconcile.Result{RequeueAfter: requeueAfterSeedOutageMode}, nil
+	}
+
 	if shoot.DeletionTimestamp != nil {
 		return r.deleteShoot(ctx, log, shoot)
 	}
@@ -375,6 +405,7 @@ func (r *Reconciler) initializeOperation(
 	op, err := operation.
 		NewBuilder().
 		WithLogger(log).
+		WithClock(r.Clock).
 		WithConfig(&r.Config).
 		WithGardenerInfo(r.Identity).
 		WithGardenClusterIdentity(r.GardenClusterIdentity).
@@ -382,6 +413,7 @@ func (r *Reconciler) initializeOperation(
 		WithGarden(gardenObj).
 		WithSeed(seedObj).
 		WithShoot(shootObj).
+		WithHelmRegistry(r.HelmRegistry).
 		Build(ctx, r.GardenClient, r.SeedClientSet, r.ShootClientMap)
 	if err != nil {
 		return nil, err
@@ -611,6 +643,10 @@ func (r *Reconciler) updateShootStatusOperationStart(
 	case v1beta1constants.OperationRotateETCDEncryptionKeyComplete:
 		mustRemoveOperationAnnotation = true
 		completeRotationETCDEncryptionKey(shoot, &now)
+
+	case v1beta1constants.ShootOperationBackupNow:
+		mustRemoveOperationAnnotation = true
+		requestEtcdSnapshot(shoot, &now)
 	}
 
 	if err := r.GardenClient.Status().Update(ctx, shoot); err != nil {
@@ -809,7 +845,8 @@ func (r *Reconciler) patchShootStatusOperationError(
 	}
 
 	shoot.Status.Gardener = *r.Identity
-	shoot.Status.LastErrors = lastErrors
+	shoot.Status.LastErrors = triage.Triage(lastErrors)
+	triage.RecordMetrics(operationType, shoot.Status.LastErrors)
 
 	if shoot.Status.LastOperation == nil {
 		shoot.Status.LastOperation = &gardencorev1beta1.LastOperation{}
@@ -822,6 +859,34 @@ func (r *Reconciler) patchShootStatusOperationError(
 	return r.GardenClient.Status().Patch(ctx, shoot, statusPatch)
 }
 
+func (r *Reconciler) patchReconciliationDegradedCondition(ctx context.Context, shoot *gardencorev1beta1.Shoot, seedOutageModeActive bool) error {
+	if !seedOutageModeActive {
+		if v1beta1helper.GetCondition(shoot.Status.Conditions, gardencorev1beta1.ShootReconciliationDegraded) == nil {
+			return nil
+		}
+
+		patch := client.MergeFrom(shoot.DeepCopy())
+		shoot.Status.Conditions = v1beta1helper.RemoveConditions(shoot.Status.Conditions, gardencorev1beta1.ShootReconciliationDegraded)
+		return r.GardenClient.Status().Patch(ctx, shoot, patch)
+	}
+
+	reason, message := "SeedOutageMode", fmt.Sprintf("Seed %q is in outage mode (annotation %q), hence only health-critical actions are performed for the Shoot and its reconciliation, migration, and deletion are postponed.", r.Config.SeedConfig.Name, v1beta1constants.AnnotationSeedOutageMode)
+	if shoot.DeletionTimestamp != nil {
+		reason, message = "SeedOutageModeDeletionPostponed", fmt.Sprintf("Seed %q is in outage mode (annotation %q), hence the deletion of the Shoot is postponed until the outage mode is ended.", r.Config.SeedConfig.Name, v1beta1constants.AnnotationSeedOutageMode)
+	}
+
+	condition := v1beta1helper.GetOrInitConditionWithClock(r.Clock, shoot.Status.Conditions, gardencorev1beta1.ShootReconciliationDegraded)
+	if condition.Status == gardencorev1beta1.ConditionTrue && condition.Reason == reason {
+		return nil
+	}
+
+	condition = v1beta1helper.UpdatedConditionWithClock(r.Clock, condition, gardencorev1beta1.ConditionTrue, reason, message)
+
+	patch := client.MergeFrom(shoot.DeepCopy())
+	shoot.Status.Conditions = v1beta1helper.MergeConditions(shoot.Status.Conditions, condition)
+	return r.GardenClient.Status().Patch(ctx, shoot, patch)
+}
+
 func (r *Reconciler) shootHasBastions(ctx context.Context, shoot *gardencorev1beta1.Shoot) (bool, error) {
 	return kubernetesutils.ResourcesExist(ctx, r.GardenClient, &operationsv1alpha1.BastionList{}, r.GardenClient.Scheme(), client.MatchingFields{operations.BastionShootName: shoot.Name})
 }
@@ -1013,3 +1078,12 @@ func startRotationObservability(shoot *gardencorev1beta1.Shoot, now *metav1.Time
 		rotation.LastInitiationTime = now
 	})
 }
+
+func requestEtcdSnapshot(shoot *gardencorev1beta1.Shoot, now *metav1.Time) {
+	if shoot.Status.EtcdSnapshot == nil {
+		shoot.Status.EtcdSnapshot = &gardencorev1beta1.EtcdSnapshot{}
+	}
+	shoot.Status.EtcdSnapshot.Phase = gardencorev1beta1.EtcdSnapshotPending
+	shoot.Status.EtcdSnapshot.LastInitiationTime = now
+	shoot.Status.EtcdSnapshot.Message = nil
+}
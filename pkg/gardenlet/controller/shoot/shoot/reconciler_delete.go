@@ -571,6 +571,16 @@ func (r *Reconciler) runDeleteShootFlow(ctx context.Context, o *operation.Operat
 			Fn:           botanist.Shoot.Components.ControlPlane.KubeAPIServer.WaitCleanup,
 			Dependencies: flow.NewTaskIDs(deleteKubeAPIServer),
 		})
+		destroyAuditSink = g.Add(flow.Task{
+			Name:         "Destroying audit sink",
+			Fn:           flow.TaskFn(botanist.Shoot.Components.Extensions.AuditSink.Destroy).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			Dependencies: flow.NewTaskIDs(waitUntilKubeAPIServerDeleted),
+		})
+		waitUntilAuditSinkDeleted = g.Add(flow.Task{
+			Name:         "Waiting until audit sink has been deleted",
+			Fn:           botanist.Shoot.Components.Extensions.AuditSink.WaitCleanup,
+			Dependencies: flow.NewTaskIDs(destroyAuditSink),
+		})
 		deleteExtensionResourcesAfterKubeAPIServer = g.Add(flow.Task{
 			Name:         "Deleting extension resources after kube-apiserver",
 			Fn:           flow.TaskFn(botanist.Shoot.Components.Extensions.Extension.DestroyAfterKubeAPIServer).RetryUntilTimeout(defaultInterval, defaultTimeout),
@@ -676,6 +686,7 @@ func (r *Reconciler) runDeleteShootFlow(ctx context.Context, o *operation.Operat
 			deletePlutono,
 			destroySeedLogging,
 			waitUntilKubeAPIServerDeleted,
+			waitUntilAuditSinkDeleted,
 			waitUntilControlPlaneDeleted,
 			waitUntilControlPlaneExposureDeleted,
 			waitUntilExtensionResourcesAfterKubeAPIServerDeleted,
@@ -706,10 +717,15 @@ func (r *Reconciler) runDeleteShootFlow(ctx context.Context, o *operation.Operat
 			Fn:           flow.TaskFn(botanist.WaitUntilEtcdsDeleted).RetryUntilTimeout(defaultInterval, defaultTimeout),
 			Dependencies: flow.NewTaskIDs(syncPoint, destroyEtcd),
 		})
+		destroyObjectRecovery = g.Add(flow.Task{
+			Name:         "Destroying leftovers of object recovery from etcd backup",
+			Fn:           flow.TaskFn(botanist.DestroyObjectRecovery).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			Dependencies: flow.NewTaskIDs(syncPoint),
+		})
 		deleteNamespace = g.Add(flow.Task{
 			Name:         "Deleting shoot namespace in Seed",
 			Fn:           flow.TaskFn(botanist.DeleteSeedNamespace).RetryUntilTimeout(defaultInterval, defaultTimeout),
-			Dependencies: flow.NewTaskIDs(syncPoint, destroyInternalDomainDNSRecord, destroyReferencedResources, waitUntilEtcdDeleted),
+			Dependencies: flow.NewTaskIDs(syncPoint, destroyInternalDomainDNSRecord, destroyReferencedResources, waitUntilEtcdDeleted, destroyObjectRecovery),
 		})
 		_ = g.Add(flow.Task{
 			Name:         "Waiting until shoot namespace in Seed has been deleted",
@@ -727,11 +743,14 @@ func (r *Reconciler) runDeleteShootFlow(ctx context.Context, o *operation.Operat
 		f = g.Compile()
 	)
 
+	defer o.PersistTimeline(ctx)
+
 	if err := f.Run(ctx, flow.Opts{
 		Log:              o.Logger,
 		ProgressReporter: r.newProgressReporter(o.ReportShootProgress),
 		ErrorCleaner:     o.CleanShootTaskError,
 		ErrorContext:     errorContext,
+		TaskRecorder:     o.RecordTask,
 	}); err != nil {
 		return v1beta1helper.NewWrappedLastErrors(v1beta1helper.FormatLastErrDescription(err), flow.Errors(err))
 	}
@@ -83,7 +83,7 @@ func (r *Reconciler) runForceDeleteShootFlow(ctx context.Context, log logr.Logge
 		})
 		waitUntilExtensionObjectsDeleted = g.Add(flow.Task{
 			Name:         "Waiting until extension resources have been deleted",
-			Fn:           cleaner.WaitUntilExtensionObjectsDeleted,
+			Fn:           cleaner.WaitUntilExtensionObjectsDeletedOrFinalize(r.Config.Controllers.Shoot.ForceDeletionExtensionGracePeriod.Duration),
 			Dependencies: flow.NewTaskIDs(deleteExtensionObjects),
 		})
 		destroyIngressDomainDNSRecord = g.Add(flow.Task{
@@ -193,11 +193,14 @@ func (r *Reconciler) runForceDeleteShootFlow(ctx context.Context, log logr.Logge
 		f = g.Compile()
 	)
 
+	defer o.PersistTimeline(ctx)
+
 	if err := f.Run(ctx, flow.Opts{
 		Log:              o.Logger,
 		ProgressReporter: r.newProgressReporter(o.ReportShootProgress),
 		ErrorCleaner:     o.CleanShootTaskError,
 		ErrorContext:     errorContext,
+		TaskRecorder:     o.RecordTask,
 	}); err != nil {
 		return v1beta1helper.NewWrappedLastErrors(v1beta1helper.FormatLastErrDescription(err), flow.Errors(err))
 	}
@@ -103,6 +103,7 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 		shootControlPlaneLoggingEnabled = botanist.Shoot.IsShootControlPlaneLoggingEnabled(botanist.Config)
 		deployKubeAPIServerTaskTimeout  = defaultTimeout
 		shootSSHAccessEnabled           = v1beta1helper.ShootEnablesSSHAccess(o.Shoot.GetInfo())
+		hasAuditSink                    = v1beta1helper.GetShootAuditSink(o.Shoot.GetInfo().Spec.Kubernetes.KubeAPIServer) != nil
 	)
 
 	// During the 'Preparing' phase of different rotation operations, components are deployed twice. Also, the
@@ -135,6 +136,11 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 		return v1beta1helper.NewWrappedLastErrors(v1beta1helper.FormatLastErrDescription(err), err)
 	}
 
+	networkDualStackMigrationPending, err := botanist.IsNetworkDualStackMigrationPending(ctx)
+	if err != nil {
+		return v1beta1helper.NewWrappedLastErrors(v1beta1helper.FormatLastErrDescription(err), err)
+	}
+
 	var (
 		g = flow.NewGraph(fmt.Sprintf("Shoot cluster %s", utils.IifString(isRestoring, "restoration", "reconciliation")))
 
@@ -142,6 +148,11 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			Name: "Deploying Shoot namespace in Seed",
 			Fn:   flow.TaskFn(botanist.DeploySeedNamespace).RetryUntilTimeout(defaultInterval, defaultTimeout),
 		})
+		_ = g.Add(flow.Task{
+			Name:         "Deploying ResourceQuota and LimitRange for Shoot namespace in Seed",
+			Fn:           flow.TaskFn(botanist.DeploySeedNamespaceConstraints).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			Dependencies: flow.NewTaskIDs(deployNamespace),
+		})
 		ensureShootClusterIdentity = g.Add(flow.Task{
 			Name:         "Ensuring Shoot cluster identity",
 			Fn:           flow.TaskFn(botanist.EnsureShootClusterIdentity).RetryUntilTimeout(defaultInterval, defaultTimeout),
@@ -294,6 +305,24 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			SkipIf:       o.Shoot.HibernationEnabled || skipReadiness,
 			Dependencies: flow.NewTaskIDs(deployETCD),
 		})
+		_ = g.Add(flow.Task{
+			Name:         "Taking on-demand snapshot of main etcd",
+			Fn:           botanist.TakeOnDemandEtcdSnapshot,
+			SkipIf:       o.Shoot.HibernationEnabled || !requiresEtcdSnapshot(o.Shoot.GetInfo()),
+			Dependencies: flow.NewTaskIDs(waitUntilEtcdReady),
+		})
+		_ = g.Add(flow.Task{
+			Name: "Recovering objects from etcd backup",
+			// The recovery is requested by operators and must not block the reconciliation of the shoot, hence errors are
+			// only reported.
+			Fn: flow.TaskFn(botanist.RecoverObjectsFromEtcdBackup).Recover(func(_ context.Context, err error) error {
+				o.Logger.Error(err, "Failed recovering objects from etcd backup")
+				r.Recorder.Event(o.Shoot.GetInfo(), corev1.EventTypeWarning, eventObjectRecoveryFailed, fmt.Sprintf("Failed recovering objects from etcd backup: %v", err))
+				return nil
+			}),
+			SkipIf:       o.Shoot.HibernationEnabled || !allowBackup || !botanistpkg.ShootRequestsObjectRecovery(o.Shoot.GetInfo()),
+			Dependencies: flow.NewTaskIDs(waitUntilEtcdReady),
+		})
 		deployExtensionResourcesBeforeKAPI = g.Add(flow.Task{
 			Name:         "Deploying extension resources before kube-apiserver",
 			Fn:           flow.TaskFn(botanist.DeployExtensionsBeforeKubeAPIServer).RetryUntilTimeout(defaultInterval, defaultTimeout),
@@ -306,15 +335,35 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			SkipIf:       o.Shoot.HibernationEnabled || skipReadiness,
 			Dependencies: flow.NewTaskIDs(deployExtensionResourcesBeforeKAPI),
 		})
+		deployAuditSink = g.Add(flow.Task{
+			Name:         "Deploying audit sink",
+			Fn:           flow.TaskFn(botanist.DeployAuditSink).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			SkipIf:       o.Shoot.HibernationEnabled || !hasAuditSink,
+			Dependencies: flow.NewTaskIDs(deployNamespace, deployCloudProviderSecret, deployReferencedResources),
+		})
+		waitUntilAuditSinkReady = g.Add(flow.Task{
+			Name:         "Waiting until audit sink has been reconciled",
+			Fn:           botanist.Shoot.Components.Extensions.AuditSink.Wait,
+			SkipIf:       o.Shoot.HibernationEnabled || !hasAuditSink || skipReadiness,
+			Dependencies: flow.NewTaskIDs(deployAuditSink),
+		})
+		deployKubeAPIServerManagedCertificate = g.Add(flow.Task{
+			Name:         "Deploying managed certificate for Kubernetes API server",
+			Fn:           botanist.DeployKubeAPIServerManagedCertificate,
+			SkipIf:       o.Shoot.HibernationEnabled,
+			Dependencies: flow.NewTaskIDs(deployNamespace),
+		})
 		deployKubeAPIServer = g.Add(flow.Task{
 			Name: "Deploying Kubernetes API server",
 			Fn:   flow.TaskFn(botanist.DeployKubeAPIServer).RetryUntilTimeout(defaultInterval, deployKubeAPIServerTaskTimeout),
 			Dependencies: flow.NewTaskIDs(
 				initializeSecretsManagement,
+				deployKubeAPIServerManagedCertificate,
 				deployETCD,
 				waitUntilEtcdReady,
 				waitUntilKubeAPIServerServiceIsReady,
 				waitUntilExtensionResourcesBeforeKAPIReady,
+				waitUntilAuditSinkReady,
 			).InsertIf(!hasNodesCIDR, waitUntilInfrastructureReady),
 		})
 		waitUntilKubeAPIServerIsReady = g.Add(flow.Task{
@@ -323,6 +372,18 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			SkipIf:       o.Shoot.HibernationEnabled || skipReadiness,
 			Dependencies: flow.NewTaskIDs(deployKubeAPIServer),
 		})
+		destroyAuditSink = g.Add(flow.Task{
+			Name:         "Destroying audit sink",
+			Fn:           flow.TaskFn(botanist.Shoot.Components.Extensions.AuditSink.Destroy).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			SkipIf:       hasAuditSink,
+			Dependencies: flow.NewTaskIDs(waitUntilKubeAPIServerIsReady),
+		})
+		_ = g.Add(flow.Task{
+			Name:         "Waiting until audit sink has been deleted",
+			Fn:           botanist.Shoot.Components.Extensions.AuditSink.WaitCleanup,
+			SkipIf:       hasAuditSink,
+			Dependencies: flow.NewTaskIDs(destroyAuditSink),
+		})
 		scaleEtcdAfterRestore = g.Add(flow.Task{
 			Name:         "Scaling main and events etcd after kube-apiserver is ready",
 			Fn:           flow.TaskFn(botanist.ScaleUpETCD).RetryUntilTimeout(defaultInterval, helper.GetEtcdDeployTimeout(o.Shoot, defaultTimeout)),
@@ -380,10 +441,8 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			Dependencies: flow.NewTaskIDs(waitUntilKubeAPIServerWithNodeAgentAuthorizerIsReady, waitUntilGardenerResourceManagerReady),
 		})
 		waitUntilControlPlaneReady = g.Add(flow.Task{
-			Name: "Waiting until shoot control plane has been reconciled",
-			Fn: flow.TaskFn(func(ctx context.Context) error {
-				return botanist.Shoot.Components.Extensions.ControlPlane.Wait(ctx)
-			}),
+			Name:         "Waiting until shoot control plane has been reconciled",
+			Fn:           botanist.WaitUntilControlPlaneReady,
 			SkipIf:       o.Shoot.IsWorkerless || skipReadiness,
 			Dependencies: flow.NewTaskIDs(deployControlPlane),
 		})
@@ -630,6 +689,12 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			SkipIf:       o.Shoot.HibernationEnabled,
 			Dependencies: flow.NewTaskIDs(waitUntilGardenerResourceManagerReady, initializeShootClients, waitUntilOperatingSystemConfigReady, waitUntilShootNamespacesReady),
 		})
+		deployAPIPriorityAndFairness = g.Add(flow.Task{
+			Name:         "Deploying API priority and fairness configuration",
+			Fn:           flow.TaskFn(botanist.DeployAPIPriorityAndFairness).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			SkipIf:       o.Shoot.HibernationEnabled,
+			Dependencies: flow.NewTaskIDs(waitUntilGardenerResourceManagerReady, initializeShootClients),
+		})
 		deployCoreDNS = g.Add(flow.Task{
 			Name: "Deploying CoreDNS system component",
 			Fn: flow.TaskFn(func(ctx context.Context) error {
@@ -728,6 +793,12 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			SkipIf:       o.Shoot.IsWorkerless || o.Shoot.HibernationEnabled,
 			Dependencies: flow.NewTaskIDs(waitUntilGardenerResourceManagerReady, initializeShootClients, ensureShootClusterIdentity, deployKubeScheduler, waitUntilShootNamespacesReady),
 		})
+		deployManagedAddons = g.Add(flow.Task{
+			Name:         "Deploying managed addons",
+			Fn:           flow.TaskFn(botanist.DeployManagedAddons).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			SkipIf:       o.Shoot.HibernationEnabled,
+			Dependencies: flow.NewTaskIDs(waitUntilGardenerResourceManagerReady, initializeShootClients, ensureShootClusterIdentity, deployKubeScheduler, waitUntilShootNamespacesReady),
+		})
 		deployManagedResourceForGardenerNodeAgent = g.Add(flow.Task{
 			Name:         "Deploying managed resources for the gardener-node-agent",
 			Fn:           flow.TaskFn(botanist.DeployManagedResourceForGardenerNodeAgent).RetryUntilTimeout(defaultInterval, defaultTimeout),
@@ -739,6 +810,7 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			waitUntilNetworkIsReady,
 			deployAPIServerProxy,
 			deployShootSystemResources,
+			deployAPIPriorityAndFairness,
 			deployCoreDNS,
 			deployNodeExporter,
 			deployNodeLocalDNS,
@@ -749,6 +821,7 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			deployBlackboxExporter,
 			deployKubernetesDashboard,
 			deployNginxIngressAddon,
+			deployManagedAddons,
 		)
 
 		scaleClusterAutoscalerToZero = g.Add(flow.Task{
@@ -797,6 +870,20 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			SkipIf:       o.Shoot.IsWorkerless || skipReadiness,
 			Dependencies: flow.NewTaskIDs(deployWorker, waitUntilWorkerStatusUpdate, deployManagedResourceForGardenerNodeAgent),
 		})
+		deployNetworkForDualStackMigration = g.Add(flow.Task{
+			Name:         "Deploying shoot network plugin after nodes have been migrated to dual-stack networking",
+			Fn:           flow.TaskFn(botanist.DeployNetworkForDualStackMigration).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			SkipIf:       o.Shoot.IsWorkerless || !networkDualStackMigrationPending,
+			Dependencies: flow.NewTaskIDs(waitUntilNetworkIsReady, waitUntilWorkerReady),
+		})
+		_ = g.Add(flow.Task{
+			Name: "Waiting until shoot network plugin has been reconciled after nodes have been migrated to dual-stack networking",
+			Fn: flow.TaskFn(func(ctx context.Context) error {
+				return botanist.Shoot.Components.Extensions.Network.Wait(ctx)
+			}),
+			SkipIf:       o.Shoot.IsWorkerless || !networkDualStackMigrationPending || skipReadiness,
+			Dependencies: flow.NewTaskIDs(deployNetworkForDualStackMigration),
+		})
 		_ = g.Add(flow.Task{
 			Name:         "Waiting until extension resources handled after workers are ready",
 			Fn:           botanist.Shoot.Components.Extensions.Extension.WaitAfterWorker,
@@ -815,6 +902,12 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 			Fn:           flow.TaskFn(botanist.DeployPlutono).RetryUntilTimeout(defaultInterval, 2*time.Minute),
 			Dependencies: flow.NewTaskIDs(deploySeedLogging),
 		})
+		_ = g.Add(flow.Task{
+			Name:         "Removing legacy components",
+			Fn:           flow.TaskFn(botanist.RemoveLegacyComponents).RetryUntilTimeout(defaultInterval, defaultTimeout),
+			SkipIf:       o.Shoot.HibernationEnabled,
+			Dependencies: flow.NewTaskIDs(deploySeedLogging, waitUntilGardenerResourceManagerReady),
+		})
 		nginxLBReady = g.Add(flow.Task{
 			Name:         "Waiting until nginx ingress LoadBalancer is ready",
 			Fn:           botanist.WaitUntilNginxIngressServiceIsReady,
@@ -975,11 +1068,14 @@ func (r *Reconciler) runReconcileShootFlow(ctx context.Context, o *operation.Ope
 
 	f := g.Compile()
 
+	defer o.PersistTimeline(ctx)
+
 	if err := f.Run(ctx, flow.Opts{
 		Log:              o.Logger,
 		ProgressReporter: r.newProgressReporter(o.ReportShootProgress),
 		ErrorContext:     errorContext,
 		ErrorCleaner:     o.CleanShootTaskError,
+		TaskRecorder:     o.RecordTask,
 	}); err != nil {
 		return v1beta1helper.NewWrappedLastErrors(v1beta1helper.FormatLastErrDescription(err), flow.Errors(err))
 	}
@@ -1066,3 +1162,7 @@ func deleteGardenerNodeAgentShootAccess(ctx context.Context, o *operation.Operat
 		},
 	)
 }
+
+func requiresEtcdSnapshot(shoot *gardencorev1beta1.Shoot) bool {
+	return shoot.Status.EtcdSnapshot != nil && shoot.Status.EtcdSnapshot.Phase == gardencorev1beta1.EtcdSnapshotPending
+}
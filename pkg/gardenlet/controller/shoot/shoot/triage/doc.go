@@ -0,0 +1,7 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package triage contains the classification of errors of failed shoot operations into actionable categories.
+// The classification is persisted in the `.status.lastErrors[].triage` field of the Shoot and exposed as metrics.
+package triage
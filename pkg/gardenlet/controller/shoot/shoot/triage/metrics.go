@@ -0,0 +1,37 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package triage
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	runtimemetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+var metricErrors = promauto.With(runtimemetrics.Registry).NewCounterVec(
+	prometheus.CounterOpts{
+		Namespace: "gardenlet",
+		Subsystem: "shoot",
+		Name:      "operation_errors_total",
+		Help:      "Total number of errors of failed shoot operations by their triage. The value of the label 'category' is one of 'UserConfiguration', 'ProviderQuota', 'Transient' or 'GardenerBugSuspect'.",
+	},
+	[]string{
+		"operation",
+		"category",
+		"confidence",
+	},
+)
+
+// RecordMetrics records the triage of the given last errors of a failed operation of the given type.
+func RecordMetrics(operationType gardencorev1beta1.LastOperationType, lastErrors []gardencorev1beta1.LastError) {
+	for _, lastError := range lastErrors {
+		if lastError.Triage == nil {
+			continue
+		}
+		metricErrors.WithLabelValues(string(operationType), string(lastError.Triage.Category), string(lastError.Triage.Confidence)).Inc()
+	}
+}
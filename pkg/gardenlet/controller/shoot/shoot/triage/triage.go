@@ -0,0 +1,157 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package triage
+
+import (
+	"regexp"
+	"slices"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+type codeClassification struct {
+	category   gardencorev1beta1.ErrorCategory
+	suggestion string
+}
+
+var (
+	codeClassifications = map[gardencorev1beta1.ErrorCode]codeClassification{
+		gardencorev1beta1.ErrorInfraUnauthenticated: {
+			category:   gardencorev1beta1.ErrorCategoryUserConfiguration,
+			suggestion: "Check that the infrastructure credentials referenced by the Shoot are valid and not expired.",
+		},
+		gardencorev1beta1.ErrorInfraUnauthorized: {
+			category:   gardencorev1beta1.ErrorCategoryUserConfiguration,
+			suggestion: "Check that the infrastructure credentials referenced by the Shoot have all permissions required by the provider extension.",
+		},
+		gardencorev1beta1.ErrorInfraDependencies: {
+			category:   gardencorev1beta1.ErrorCategoryUserConfiguration,
+			suggestion: "Remove infrastructure resources which were created outside of Gardener and depend on resources managed by Gardener.",
+		},
+		gardencorev1beta1.ErrorCleanupClusterResources: {
+			category:   gardencorev1beta1.ErrorCategoryUserConfiguration,
+			suggestion: "Check for resources in the Shoot cluster which are stuck in deletion, e.g., due to finalizers of uninstalled controllers.",
+		},
+		gardencorev1beta1.ErrorConfigurationProblem: {
+			category:   gardencorev1beta1.ErrorCategoryUserConfiguration,
+			suggestion: "Check the Shoot specification and the resources referenced by it for invalid or unsupported configuration.",
+		},
+		gardencorev1beta1.ErrorRetryableConfigurationProblem: {
+			category:   gardencorev1beta1.ErrorCategoryUserConfiguration,
+			suggestion: "Check the Shoot specification and the resources referenced by it, the operation is retried automatically once the problem is resolved.",
+		},
+		gardencorev1beta1.ErrorProblematicWebhook: {
+			category:   gardencorev1beta1.ErrorCategoryUserConfiguration,
+			suggestion: "Adapt the webhook configurations in the Shoot cluster to follow the Kubernetes best practices for admission webhooks.",
+		},
+		gardencorev1beta1.ErrorInfraQuotaExceeded: {
+			category:   gardencorev1beta1.ErrorCategoryProviderQuota,
+			suggestion: "Request a quota increase at the infrastructure provider or reduce the resources requested by the Shoot, e.g., the maximum number of machines.",
+		},
+		gardencorev1beta1.ErrorInfraResourcesDepleted: {
+			category:   gardencorev1beta1.ErrorCategoryProviderQuota,
+			suggestion: "Choose a different machine type or zone since the requested resources are currently not available at the infrastructure provider.",
+		},
+		gardencorev1beta1.ErrorInfraRateLimitsExceeded: {
+			category:   gardencorev1beta1.ErrorCategoryTransient,
+			suggestion: "Reduce the number of requests issued by other clients against the infrastructure account or request higher rate limits.",
+		},
+		gardencorev1beta1.ErrorRetryableInfraDependencies: {
+			category:   gardencorev1beta1.ErrorCategoryTransient,
+			suggestion: "Wait until dependent infrastructure resources are released, the operation is retried automatically.",
+		},
+	}
+
+	// categoryPrecedence defines which category wins if the codes of an error map to different categories.
+	categoryPrecedence = []gardencorev1beta1.ErrorCategory{
+		gardencorev1beta1.ErrorCategoryProviderQuota,
+		gardencorev1beta1.ErrorCategoryUserConfiguration,
+		gardencorev1beta1.ErrorCategoryTransient,
+	}
+
+	bugSuspectRegexp = regexp.MustCompile(`(?i)(panic|nil pointer|invalid memory address|index out of range)`)
+	transientRegexp  = regexp.MustCompile(`(?i)(timeout|timed out|deadline exceeded|connection refused|connection reset|no such host|tls handshake|the object has been modified|too many requests|service unavailable|temporarily unavailable|unexpected EOF)`)
+)
+
+const (
+	suggestionTransient  = "No action required, the operation is retried automatically."
+	suggestionBugSuspect = "Contact the Gardener operators since the error might be caused by a bug in Gardener or one of its extensions."
+	suggestionUnknown    = "Contact the Gardener operators if the error persists."
+)
+
+// Triage returns a copy of the given last errors with their triage being set.
+func Triage(lastErrors []gardencorev1beta1.LastError) []gardencorev1beta1.LastError {
+	if lastErrors == nil {
+		return nil
+	}
+
+	result := make([]gardencorev1beta1.LastError, 0, len(lastErrors))
+	for _, lastError := range lastErrors {
+		lastError.Triage = Classify(lastError)
+		result = append(result, lastError)
+	}
+	return result
+}
+
+// Classify classifies the given last error into an actionable category. Well-defined error codes take precedence over
+// the error description, which is only checked for well-known patterns if no codes are present. Errors which cannot be
+// classified are reported as potential Gardener bugs with low confidence.
+func Classify(lastError gardencorev1beta1.LastError) *gardencorev1beta1.ErrorTriage {
+	if triage := classifyCodes(lastError.Codes); triage != nil {
+		return triage
+	}
+
+	switch {
+	case bugSuspectRegexp.MatchString(lastError.Description):
+		return &gardencorev1beta1.ErrorTriage{
+			Category:    gardencorev1beta1.ErrorCategoryGardenerBugSuspect,
+			Confidence:  gardencorev1beta1.ErrorTriageConfidenceMedium,
+			Suggestions: []string{suggestionBugSuspect},
+		}
+	case transientRegexp.MatchString(lastError.Description):
+		return &gardencorev1beta1.ErrorTriage{
+			Category:    gardencorev1beta1.ErrorCategoryTransient,
+			Confidence:  gardencorev1beta1.ErrorTriageConfidenceMedium,
+			Suggestions: []string{suggestionTransient},
+		}
+	default:
+		return &gardencorev1beta1.ErrorTriage{
+			Category:    gardencorev1beta1.ErrorCategoryGardenerBugSuspect,
+			Confidence:  gardencorev1beta1.ErrorTriageConfidenceLow,
+			Suggestions: []string{suggestionUnknown},
+		}
+	}
+}
+
+func classifyCodes(codes []gardencorev1beta1.ErrorCode) *gardencorev1beta1.ErrorTriage {
+	var (
+		categoryIndex = -1
+		suggestions   []string
+	)
+
+	for _, code := range codes {
+		classification, ok := codeClassifications[code]
+		if !ok {
+			continue
+		}
+
+		if index := slices.Index(categoryPrecedence, classification.category); categoryIndex == -1 || index < categoryIndex {
+			categoryIndex = index
+		}
+		if !slices.Contains(suggestions, classification.suggestion) {
+			suggestions = append(suggestions, classification.suggestion)
+		}
+	}
+
+	if categoryIndex == -1 {
+		return nil
+	}
+
+	return &gardencorev1beta1.ErrorTriage{
+		Category:    categoryPrecedence[categoryIndex],
+		Confidence:  gardencorev1beta1.ErrorTriageConfidenceHigh,
+		Suggestions: suggestions,
+	}
+}
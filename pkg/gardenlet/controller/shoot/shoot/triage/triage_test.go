@@ -0,0 +1,90 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package triage_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/utils/ptr"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/shoot/triage"
+)
+
+var _ = Describe("Triage", func() {
+	Describe("#Classify", func() {
+		DescribeTable("should classify the error",
+			func(lastError gardencorev1beta1.LastError, category gardencorev1beta1.ErrorCategory, confidence gardencorev1beta1.ErrorTriageConfidence, suggestions int) {
+				Expect(Classify(lastError)).To(PointTo(MatchAllFields(Fields{
+					"Category":    Equal(category),
+					"Confidence":  Equal(confidence),
+					"Suggestions": HaveLen(suggestions),
+				})))
+			},
+
+			Entry("user configuration error",
+				gardencorev1beta1.LastError{Description: "invalid credentials", Codes: []gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorInfraUnauthenticated}},
+				gardencorev1beta1.ErrorCategoryUserConfiguration, gardencorev1beta1.ErrorTriageConfidenceHigh, 1,
+			),
+			Entry("provider quota error",
+				gardencorev1beta1.LastError{Description: "quota exceeded", Codes: []gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorInfraQuotaExceeded}},
+				gardencorev1beta1.ErrorCategoryProviderQuota, gardencorev1beta1.ErrorTriageConfidenceHigh, 1,
+			),
+			Entry("transient error based on codes",
+				gardencorev1beta1.LastError{Description: "rate limit", Codes: []gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorInfraRateLimitsExceeded}},
+				gardencorev1beta1.ErrorCategoryTransient, gardencorev1beta1.ErrorTriageConfidenceHigh, 1,
+			),
+			Entry("error with multiple codes by precedence of the categories",
+				gardencorev1beta1.LastError{Description: "foo", Codes: []gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorInfraRateLimitsExceeded, gardencorev1beta1.ErrorConfigurationProblem, gardencorev1beta1.ErrorInfraQuotaExceeded}},
+				gardencorev1beta1.ErrorCategoryProviderQuota, gardencorev1beta1.ErrorTriageConfidenceHigh, 3,
+			),
+			Entry("error with unknown codes based on the description",
+				gardencorev1beta1.LastError{Description: "context deadline exceeded", Codes: []gardencorev1beta1.ErrorCode{"ERR_UNKNOWN"}},
+				gardencorev1beta1.ErrorCategoryTransient, gardencorev1beta1.ErrorTriageConfidenceMedium, 1,
+			),
+			Entry("transient error based on the description",
+				gardencorev1beta1.LastError{Description: `Operation cannot be fulfilled on secrets "foo": the object has been modified; please apply your changes to the latest version and try again`},
+				gardencorev1beta1.ErrorCategoryTransient, gardencorev1beta1.ErrorTriageConfidenceMedium, 1,
+			),
+			Entry("suspected bug based on the description",
+				gardencorev1beta1.LastError{Description: "runtime error: invalid memory address or nil pointer dereference"},
+				gardencorev1beta1.ErrorCategoryGardenerBugSuspect, gardencorev1beta1.ErrorTriageConfidenceMedium, 1,
+			),
+			Entry("unknown error",
+				gardencorev1beta1.LastError{Description: "something went wrong"},
+				gardencorev1beta1.ErrorCategoryGardenerBugSuspect, gardencorev1beta1.ErrorTriageConfidenceLow, 1,
+			),
+		)
+	})
+
+	Describe("#Triage", func() {
+		It("should return nil if there are no last errors", func() {
+			Expect(Triage(nil)).To(BeNil())
+		})
+
+		It("should set the triage for all last errors without modifying the input", func() {
+			lastErrors := []gardencorev1beta1.LastError{
+				{Description: "quota exceeded", TaskID: ptr.To("foo"), Codes: []gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorInfraQuotaExceeded}},
+				{Description: "connection refused", TaskID: ptr.To("bar")},
+			}
+
+			result := Triage(lastErrors)
+
+			Expect(result).To(ConsistOf(
+				MatchFields(IgnoreExtras, Fields{
+					"TaskID": PointTo(Equal("foo")),
+					"Triage": PointTo(MatchFields(IgnoreExtras, Fields{"Category": Equal(gardencorev1beta1.ErrorCategoryProviderQuota)})),
+				}),
+				MatchFields(IgnoreExtras, Fields{
+					"TaskID": PointTo(Equal("bar")),
+					"Triage": PointTo(MatchFields(IgnoreExtras, Fields{"Category": Equal(gardencorev1beta1.ErrorCategoryTransient)})),
+				}),
+			))
+			Expect(lastErrors[0].Triage).To(BeNil())
+			Expect(lastErrors[1].Triage).To(BeNil())
+		})
+	})
+})
@@ -6,6 +6,8 @@ package botanist
 
 import (
 	"context"
+	"net"
+	"strconv"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
@@ -37,7 +39,7 @@ func (b *Botanist) ToAdvertisedAddresses() ([]gardencorev1beta1.ShootAdvertisedA
 	if b.Shoot.ExternalClusterDomain != nil && len(*b.Shoot.ExternalClusterDomain) > 0 {
 		addresses = append(addresses, gardencorev1beta1.ShootAdvertisedAddress{
 			Name: v1beta1constants.AdvertisedAddressExternal,
-			URL:  "https://" + gardenerutils.GetAPIServerDomain(*b.Shoot.ExternalClusterDomain),
+			URL:  "https://" + b.externalKubeAPIServerHost(),
 		})
 	}
 
@@ -78,3 +80,15 @@ func (b *Botanist) ToAdvertisedAddresses() ([]gardencorev1beta1.ShootAdvertisedA
 
 	return addresses, nil
 }
+
+// externalKubeAPIServerHost returns the external host of the kube-apiserver including the custom port, if configured.
+func (b *Botanist) externalKubeAPIServerHost() string {
+	host := gardenerutils.GetAPIServerDomain(*b.Shoot.ExternalClusterDomain)
+
+	if shoot := b.Shoot.GetInfo(); shoot != nil && shoot.Spec.Kubernetes.KubeAPIServer != nil &&
+		shoot.Spec.Kubernetes.KubeAPIServer.Endpoint != nil && shoot.Spec.Kubernetes.KubeAPIServer.Endpoint.Port != nil {
+		return net.JoinHostPort(host, strconv.Itoa(int(*shoot.Spec.Kubernetes.KubeAPIServer.Endpoint.Port)))
+	}
+
+	return host
+}
@@ -52,6 +52,20 @@ var _ = Describe("AdvertisedAddresses", func() {
 			}))
 		})
 
+		It("returns external address with custom port", func() {
+			botanist.Shoot.ExternalClusterDomain = ptr.To("foo.bar")
+			botanist.Shoot.GetInfo().Spec.Kubernetes.KubeAPIServer = &gardencorev1beta1.KubeAPIServerConfig{
+				Endpoint: &gardencorev1beta1.KubeAPIServerEndpoint{Port: ptr.To[int32](6443)},
+			}
+
+			addresses, err := botanist.ToAdvertisedAddresses()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(addresses).To(ConsistOf(gardencorev1beta1.ShootAdvertisedAddress{
+				Name: "external",
+				URL:  "https://api.foo.bar:6443",
+			}))
+		})
+
 		It("returns internal and service-account-issuer addresses", func() {
 			botanist.Shoot.InternalClusterDomain = "baz.foo"
 
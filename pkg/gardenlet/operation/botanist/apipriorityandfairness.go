@@ -0,0 +1,60 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	errorsutils "github.com/gardener/gardener/pkg/utils/errors"
+	"github.com/gardener/gardener/pkg/utils/managedresources"
+)
+
+const (
+	// ManagedResourceNameAPIPriorityAndFairness is the name of the managed resource used to deploy the custom API
+	// priority and fairness configuration to the shoot cluster.
+	ManagedResourceNameAPIPriorityAndFairness = "shoot-core-flowcontrol"
+	// APIPriorityAndFairnessConfigMapDataKey is the key in the referenced ConfigMap which holds the FlowSchemas and
+	// PriorityLevelConfigurations.
+	APIPriorityAndFairnessConfigMapDataKey = "config.yaml"
+)
+
+// DeployAPIPriorityAndFairness reads the custom API priority and fairness configuration referenced by the Shoot from
+// the Garden cluster and deploys it to the shoot cluster. If no configuration is referenced, the managed resource is
+// deleted.
+func (b *Botanist) DeployAPIPriorityAndFairness(ctx context.Context) error {
+	configMapName := v1beta1helper.GetShootAPIPriorityAndFairnessConfigMapName(b.Shoot.GetInfo().Spec.Kubernetes.KubeAPIServer)
+	if configMapName == "" {
+		return managedresources.DeleteForShoot(ctx, b.SeedClientSet.Client(), b.Shoot.SeedNamespace, ManagedResourceNameAPIPriorityAndFairness)
+	}
+
+	configMap := &corev1.ConfigMap{}
+	if err := b.GardenClient.Get(ctx, client.ObjectKey{Namespace: b.Shoot.GetInfo().Namespace, Name: configMapName}, configMap); err != nil {
+		if apierrors.IsNotFound(err) {
+			return errorsutils.Wrap(err, "failed reading API priority and fairness config map %s", client.ObjectKeyFromObject(configMap)).
+				WithComponent(ManagedResourceNameAPIPriorityAndFairness).
+				WithCodes(gardencorev1beta1.ErrorConfigurationProblem)
+		}
+		return errorsutils.Wrap(err, "failed reading API priority and fairness config map %s", client.ObjectKeyFromObject(configMap)).
+			WithComponent(ManagedResourceNameAPIPriorityAndFairness)
+	}
+
+	config, ok := configMap.Data[APIPriorityAndFairnessConfigMapDataKey]
+	if !ok {
+		return errorsutils.New("missing %q data key in API priority and fairness config map %s", APIPriorityAndFairnessConfigMapDataKey, client.ObjectKeyFromObject(configMap)).
+			WithComponent(ManagedResourceNameAPIPriorityAndFairness).
+			WithCodes(gardencorev1beta1.ErrorConfigurationProblem).
+			WithRetryable(false)
+	}
+
+	return managedresources.CreateForShoot(ctx, b.SeedClientSet.Client(), b.Shoot.SeedNamespace, ManagedResourceNameAPIPriorityAndFairness, managedresources.LabelValueGardener, false, map[string][]byte{
+		"flowcontrol.yaml": []byte(config),
+	})
+}
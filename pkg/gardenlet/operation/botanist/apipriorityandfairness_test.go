@@ -0,0 +1,122 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	kubernetesfake "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	"github.com/gardener/gardener/pkg/gardenlet/operation"
+	. "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
+	"github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
+	errorsutils "github.com/gardener/gardener/pkg/utils/errors"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("APIPriorityAndFairness", func() {
+	var (
+		gardenClient client.Client
+		seedClient   client.Client
+
+		botanist *Botanist
+
+		ctx             = context.TODO()
+		gardenNamespace = "garden-foo"
+		seedNamespace   = "shoot--foo--bar"
+
+		config    = "apiVersion: flowcontrol.apiserver.k8s.io/v1\nkind: PriorityLevelConfiguration\n"
+		configMap *corev1.ConfigMap
+
+		managedResource       *resourcesv1alpha1.ManagedResource
+		managedResourceSecret *corev1.Secret
+	)
+
+	BeforeEach(func() {
+		gardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+		seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+
+		botanist = &Botanist{Operation: &operation.Operation{
+			GardenClient:  gardenClient,
+			SeedClientSet: kubernetesfake.NewClientSetBuilder().WithClient(seedClient).Build(),
+			Shoot:         &shoot.Shoot{SeedNamespace: seedNamespace},
+		}}
+
+		configMap = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "flowcontrol", Namespace: gardenNamespace},
+			Data:       map[string]string{"config.yaml": config},
+		}
+
+		botanist.Shoot.SetInfo(&gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Namespace: gardenNamespace},
+			Spec: gardencorev1beta1.ShootSpec{
+				Kubernetes: gardencorev1beta1.Kubernetes{
+					KubeAPIServer: &gardencorev1beta1.KubeAPIServerConfig{
+						APIPriorityAndFairness: &gardencorev1beta1.APIPriorityAndFairness{ConfigMapName: ptr.To(configMap.Name)},
+					},
+				},
+			},
+		})
+
+		managedResource = &resourcesv1alpha1.ManagedResource{ObjectMeta: metav1.ObjectMeta{Name: "shoot-core-flowcontrol", Namespace: seedNamespace}}
+		managedResourceSecret = &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "managedresource-shoot-core-flowcontrol", Namespace: seedNamespace}}
+	})
+
+	Describe("#DeployAPIPriorityAndFairness", func() {
+		It("should deploy the referenced configuration to the shoot", func() {
+			Expect(gardenClient.Create(ctx, configMap)).To(Succeed())
+
+			Expect(botanist.DeployAPIPriorityAndFairness(ctx)).To(Succeed())
+
+			Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+			Expect(managedResource.Spec.Class).To(BeNil())
+			Expect(managedResource.Spec.KeepObjects).To(Equal(ptr.To(false)))
+			Expect(managedResource.Spec.SecretRefs).To(HaveLen(1))
+
+			managedResourceSecret.Name = managedResource.Spec.SecretRefs[0].Name
+			Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(managedResourceSecret), managedResourceSecret)).To(Succeed())
+			Expect(managedResourceSecret.Data).To(HaveKeyWithValue("flowcontrol.yaml", []byte(config)))
+		})
+
+		It("should fail if the referenced config map does not exist", func() {
+			err := botanist.DeployAPIPriorityAndFairness(ctx)
+			Expect(err).To(MatchError(ContainSubstring("failed reading API priority and fairness config map")))
+			Expect(v1beta1helper.ExtractErrorCodes(err)).To(ConsistOf(gardencorev1beta1.ErrorConfigurationProblem))
+			Expect(errorsutils.Component(err)).To(Equal("shoot-core-flowcontrol"))
+		})
+
+		It("should fail if the referenced config map does not contain the configuration", func() {
+			configMap.Data = nil
+			Expect(gardenClient.Create(ctx, configMap)).To(Succeed())
+
+			err := botanist.DeployAPIPriorityAndFairness(ctx)
+			Expect(err).To(MatchError(ContainSubstring(`missing "config.yaml" data key`)))
+			Expect(v1beta1helper.ExtractErrorCodes(err)).To(ConsistOf(gardencorev1beta1.ErrorConfigurationProblem))
+			Expect(errorsutils.IsRetryable(err)).To(BeFalse())
+		})
+
+		It("should delete the managed resource if no configuration is referenced", func() {
+			Expect(seedClient.Create(ctx, managedResource)).To(Succeed())
+			Expect(seedClient.Create(ctx, managedResourceSecret)).To(Succeed())
+
+			botanist.Shoot.GetInfo().Spec.Kubernetes.KubeAPIServer.APIPriorityAndFairness = nil
+			Expect(botanist.DeployAPIPriorityAndFairness(ctx)).To(Succeed())
+
+			Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(managedResource), &resourcesv1alpha1.ManagedResource{})).To(BeNotFoundError())
+			Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(managedResourceSecret), &corev1.Secret{})).To(BeNotFoundError())
+		})
+	})
+})
@@ -0,0 +1,88 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/component/apiserver"
+	"github.com/gardener/gardener/pkg/component/extensions/auditsink"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+// DefaultAuditSink creates the default deployer for the AuditSink custom resource.
+func (b *Botanist) DefaultAuditSink() auditsink.Interface {
+	values := &auditsink.Values{
+		Namespace: b.Shoot.SeedNamespace,
+		Name:      b.Shoot.GetInfo().Name,
+	}
+
+	if sink := v1beta1helper.GetShootAuditSink(b.Shoot.GetInfo().Spec.Kubernetes.KubeAPIServer); sink != nil {
+		values.Type = sink.Type
+		values.ProviderConfig = sink.ProviderConfig
+	}
+
+	return auditsink.New(
+		b.Logger,
+		b.SeedClientSet.Client(),
+		values,
+		auditsink.DefaultInterval,
+		auditsink.DefaultSevereThreshold,
+		auditsink.DefaultTimeout,
+	)
+}
+
+// DeployAuditSink deploys the AuditSink custom resource and triggers the restore operation in case
+// the Shoot is in the restore phase of the control plane migration.
+func (b *Botanist) DeployAuditSink(ctx context.Context) error {
+	if b.IsRestorePhase() {
+		return b.Shoot.Components.Extensions.AuditSink.Restore(ctx, b.Shoot.GetShootState())
+	}
+	return b.Shoot.Components.Extensions.AuditSink.Deploy(ctx)
+}
+
+// computeKubeAPIServerAuditSinkWebhook computes the audit webhook backend of the kube-apiserver for the audit sink
+// configured in the Shoot specification. The kubeconfig is read from the secret reported by the AuditSink extension.
+// It returns nil if the Shoot does not configure an audit sink.
+func (b *Botanist) computeKubeAPIServerAuditSinkWebhook(ctx context.Context) (*apiserver.AuditWebhook, error) {
+	if v1beta1helper.GetShootAuditSink(b.Shoot.GetInfo().Spec.Kubernetes.KubeAPIServer) == nil {
+		return nil, nil
+	}
+
+	secretRef := b.Shoot.Components.Extensions.AuditSink.WebhookKubeconfigSecretRef()
+	if secretRef == nil {
+		// The AuditSink has not been waited for in the current flow, hence read the reference from its status.
+		auditSink := &extensionsv1alpha1.AuditSink{}
+		if err := b.SeedClientSet.Client().Get(ctx, client.ObjectKey{Namespace: b.Shoot.SeedNamespace, Name: b.Shoot.GetInfo().Name}, auditSink); client.IgnoreNotFound(err) != nil {
+			return nil, fmt.Errorf("failed reading AuditSink: %w", err)
+		}
+		secretRef = auditSink.Status.WebhookKubeconfigSecretRef
+	}
+
+	if secretRef == nil {
+		// Do not block the deletion of the Shoot in case the AuditSink never became ready.
+		if b.Shoot.GetInfo().DeletionTimestamp != nil {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("AuditSink has not reported a secret containing the kubeconfig for the audit webhook yet")
+	}
+
+	key := client.ObjectKey{Namespace: b.Shoot.SeedNamespace, Name: secretRef.Name}
+	kubeconfig, err := gardenerutils.FetchKubeconfigFromSecret(ctx, b.SeedClientSet.Client(), key)
+	if err != nil {
+		if apierrors.IsNotFound(err) && b.Shoot.GetInfo().DeletionTimestamp != nil {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed reading kubeconfig for audit webhook from secret %s reported by AuditSink: %w", key, err)
+	}
+
+	return &apiserver.AuditWebhook{Kubeconfig: kubeconfig}, nil
+}
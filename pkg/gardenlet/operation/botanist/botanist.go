@@ -54,6 +54,7 @@ func New(ctx context.Context, o *operation.Operation) (*Botanist, error) {
 	o.Shoot.Components.Extensions.InternalDNSRecord = b.DefaultInternalDNSRecord()
 	o.Shoot.Components.Extensions.IngressDNSRecord = b.DefaultIngressDNSRecord()
 
+	o.Shoot.Components.Extensions.AuditSink = b.DefaultAuditSink()
 	o.Shoot.Components.Extensions.Extension, err = b.DefaultExtension(ctx)
 	if err != nil {
 		return nil, err
@@ -212,6 +213,7 @@ func New(ctx context.Context, o *operation.Operation) (*Botanist, error) {
 			return nil, err
 		}
 	}
+	o.Shoot.Components.Addons.Managed = b.DefaultManagedAddons()
 
 	return b, nil
 }
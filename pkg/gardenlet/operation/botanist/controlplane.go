@@ -6,6 +6,7 @@ package botanist
 
 import (
 	"context"
+	"slices"
 	"time"
 
 	appsv1 "k8s.io/api/apps/v1"
@@ -16,6 +17,7 @@ import (
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/client/kubernetes/clientmap/keys"
@@ -149,6 +151,7 @@ func (b *Botanist) DefaultControlPlane(purpose extensionsv1alpha1.Purpose) exten
 		values.Type = b.Shoot.GetInfo().Spec.Provider.Type
 		values.ProviderConfig = b.Shoot.GetInfo().Spec.Provider.ControlPlaneConfig
 		values.Region = b.Shoot.GetInfo().Spec.Region
+		values.DedicatedEgress = v1beta1helper.IsControlPlaneEgressDedicated(b.Shoot.GetInfo())
 
 	case extensionsv1alpha1.Exposure:
 		values.Type = b.Seed.GetInfo().Spec.Provider.Type
@@ -171,6 +174,35 @@ func (b *Botanist) DeployControlPlane(ctx context.Context) error {
 	return b.deployOrRestoreControlPlane(ctx, b.Shoot.Components.Extensions.ControlPlane)
 }
 
+// WaitUntilControlPlaneReady waits until the ControlPlane custom resource (purpose normal) has been reconciled and
+// reports the egress CIDRs of the control plane in the Shoot status.
+func (b *Botanist) WaitUntilControlPlaneReady(ctx context.Context) error {
+	if err := b.Shoot.Components.Extensions.ControlPlane.Wait(ctx); err != nil {
+		return err
+	}
+
+	var egressCIDRs []string
+	if v1beta1helper.IsControlPlaneEgressDedicated(b.Shoot.GetInfo()) {
+		egressCIDRs = b.Shoot.Components.Extensions.ControlPlane.EgressCIDRs()
+	}
+
+	var currentEgressCIDRs []string
+	if networking := b.Shoot.GetInfo().Status.Networking; networking != nil {
+		currentEgressCIDRs = networking.ControlPlaneEgressCIDRs
+	}
+	if slices.Equal(egressCIDRs, currentEgressCIDRs) {
+		return nil
+	}
+
+	return b.Shoot.UpdateInfoStatus(ctx, b.GardenClient, true, func(shoot *gardencorev1beta1.Shoot) error {
+		if shoot.Status.Networking == nil {
+			shoot.Status.Networking = &gardencorev1beta1.NetworkingStatus{}
+		}
+		shoot.Status.Networking.ControlPlaneEgressCIDRs = egressCIDRs
+		return nil
+	})
+}
+
 // DeployControlPlaneExposure deploys or restores the ControlPlane custom resource (purpose exposure).
 func (b *Botanist) DeployControlPlaneExposure(ctx context.Context) error {
 	return b.deployOrRestoreControlPlane(ctx, b.Shoot.Components.Extensions.ControlPlaneExposure)
@@ -11,9 +11,13 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
 	mockcontrolplane "github.com/gardener/gardener/pkg/component/extensions/controlplane/mock"
 	mockdnsrecord "github.com/gardener/gardener/pkg/component/extensions/dnsrecord/mock"
 	mockinfrastructure "github.com/gardener/gardener/pkg/component/extensions/infrastructure/mock"
@@ -114,6 +118,64 @@ var _ = Describe("controlplane", func() {
 		})
 	})
 
+	Describe("#WaitUntilControlPlaneReady", func() {
+		var (
+			gardenClient client.Client
+			shootObj     *gardencorev1beta1.Shoot
+		)
+
+		BeforeEach(func() {
+			shootObj = &gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "garden-foo"},
+				Spec: gardencorev1beta1.ShootSpec{
+					ControlPlane: &gardencorev1beta1.ControlPlane{Egress: &gardencorev1beta1.ControlPlaneEgress{Dedicated: true}},
+				},
+			}
+		})
+
+		JustBeforeEach(func() {
+			gardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithObjects(shootObj).WithStatusSubresource(&gardencorev1beta1.Shoot{}).Build()
+
+			botanist.GardenClient = gardenClient
+			botanist.Shoot.SetInfo(shootObj)
+		})
+
+		It("should return the error of the wait", func() {
+			controlPlane.EXPECT().Wait(ctx).Return(fakeErr)
+			Expect(botanist.WaitUntilControlPlaneReady(ctx)).To(MatchError(fakeErr))
+		})
+
+		It("should report the egress CIDRs of the control plane in the status", func() {
+			controlPlane.EXPECT().Wait(ctx)
+			controlPlane.EXPECT().EgressCIDRs().Return([]string{"1.2.3.4/32"})
+
+			Expect(botanist.WaitUntilControlPlaneReady(ctx)).To(Succeed())
+
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shootObj), shootObj)).To(Succeed())
+			Expect(shootObj.Status.Networking.ControlPlaneEgressCIDRs).To(ConsistOf("1.2.3.4/32"))
+		})
+
+		Context("without dedicated egress", func() {
+			BeforeEach(func() {
+				shootObj.Spec.ControlPlane = nil
+				shootObj.Status.Networking = &gardencorev1beta1.NetworkingStatus{
+					EgressCIDRs:             []string{"5.6.7.8/32"},
+					ControlPlaneEgressCIDRs: []string{"1.2.3.4/32"},
+				}
+			})
+
+			It("should remove the egress CIDRs of the control plane from the status", func() {
+				controlPlane.EXPECT().Wait(ctx)
+
+				Expect(botanist.WaitUntilControlPlaneReady(ctx)).To(Succeed())
+
+				Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shootObj), shootObj)).To(Succeed())
+				Expect(shootObj.Status.Networking.EgressCIDRs).To(ConsistOf("5.6.7.8/32"))
+				Expect(shootObj.Status.Networking.ControlPlaneEgressCIDRs).To(BeEmpty())
+			})
+		})
+	})
+
 	Describe("#DeployControlPlaneExposure()", func() {
 		Context("deploy", func() {
 			It("should deploy successfully", func() {
@@ -36,6 +36,7 @@ func (b *Botanist) DefaultCoreDNS() (coredns.Interface, error) {
 		Image:                           image.String(),
 		AutoscalingMode:                 gardencorev1beta1.CoreDNSAutoscalingModeHorizontal,
 		SearchPathRewriteCommonSuffixes: getCommonSuffixesForRewriting(b.Shoot.GetInfo().Spec.SystemComponents),
+		CustomPlugins:                   getCoreDNSCustomPlugins(b.Shoot.GetInfo().Spec.SystemComponents),
 		KubernetesVersion:               b.Shoot.KubernetesVersion,
 		// Pod/node network CIDRs and cluster IPs are set on deployment to handle dynamic network CIDRs
 	}
@@ -101,3 +102,10 @@ func getCommonSuffixesForRewriting(systemComponents *gardencorev1beta1.SystemCom
 	}
 	return []string{}
 }
+
+func getCoreDNSCustomPlugins(systemComponents *gardencorev1beta1.SystemComponents) *gardencorev1beta1.CoreDNSCustomPlugins {
+	if systemComponents != nil && systemComponents.CoreDNS != nil {
+		return systemComponents.CoreDNS.CustomPlugins
+	}
+	return nil
+}
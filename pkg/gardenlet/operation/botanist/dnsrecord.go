@@ -11,6 +11,7 @@ import (
 	"github.com/gardener/gardener/pkg/component"
 	extensionsdnsrecord "github.com/gardener/gardener/pkg/component/extensions/dnsrecord"
 	"github.com/gardener/gardener/pkg/controllerutils"
+	gardenlethelper "github.com/gardener/gardener/pkg/gardenlet/apis/config/helper"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 )
 
@@ -34,14 +35,7 @@ func (b *Botanist) DefaultExternalDNSRecord() extensionsdnsrecord.Interface {
 		values.DNSName = gardenerutils.GetAPIServerDomain(*b.Shoot.ExternalClusterDomain)
 	}
 
-	return extensionsdnsrecord.New(
-		b.Logger,
-		b.SeedClientSet.Client(),
-		values,
-		extensionsdnsrecord.DefaultInterval,
-		extensionsdnsrecord.DefaultSevereThreshold,
-		extensionsdnsrecord.DefaultTimeout,
-	)
+	return b.newDNSRecord(values)
 }
 
 // DefaultInternalDNSRecord creates the default deployer for the internal DNSRecord resource.
@@ -67,13 +61,36 @@ func (b *Botanist) DefaultInternalDNSRecord() extensionsdnsrecord.Interface {
 		values.DNSName = gardenerutils.GetAPIServerDomain(b.Shoot.InternalClusterDomain)
 	}
 
+	return b.newDNSRecord(values)
+}
+
+// newDNSRecord creates a new deployer for a DNSRecord resource. The timeout for waiting for the DNSRecord and the
+// verification of its propagation are taken from the gardenlet configuration for the DNS provider type.
+func (b *Botanist) newDNSRecord(values *extensionsdnsrecord.Values) extensionsdnsrecord.Interface {
+	timeout := extensionsdnsrecord.DefaultTimeout
+
+	if dnsPropagation := gardenlethelper.GetDNSPropagationConfiguration(b.Config, values.Type); dnsPropagation != nil {
+		if dnsPropagation.Timeout != nil {
+			timeout = dnsPropagation.Timeout.Duration
+		}
+
+		if verification := dnsPropagation.Verification; verification != nil {
+			values.PropagationVerification = &extensionsdnsrecord.PropagationVerification{
+				NameServers:    verification.NameServers,
+				InitialBackoff: verification.InitialBackoff.Duration,
+				MaxBackoff:     verification.MaxBackoff.Duration,
+				Timeout:        verification.Timeout.Duration,
+			}
+		}
+	}
+
 	return extensionsdnsrecord.New(
 		b.Logger,
 		b.SeedClientSet.Client(),
 		values,
 		extensionsdnsrecord.DefaultInterval,
 		extensionsdnsrecord.DefaultSevereThreshold,
-		extensionsdnsrecord.DefaultTimeout,
+		timeout,
 	)
 }
 
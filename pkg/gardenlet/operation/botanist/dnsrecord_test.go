@@ -196,6 +196,30 @@ var _ = Describe("dnsrecord", func() {
 			}))
 		})
 
+		It("should configure the propagation verification for the DNS provider type", func() {
+			b.Config.Controllers.Shoot.DNSPropagation = []config.DNSPropagationConfiguration{
+				{ProviderType: internalProvider},
+				{
+					ProviderType: externalProvider,
+					Timeout:      &metav1.Duration{Duration: 10 * time.Minute},
+					Verification: &config.DNSPropagationVerification{
+						NameServers:    []string{"10.0.0.1:53"},
+						InitialBackoff: &metav1.Duration{Duration: time.Second},
+						MaxBackoff:     &metav1.Duration{Duration: time.Minute},
+						Timeout:        &metav1.Duration{Duration: 5 * time.Minute},
+					},
+				},
+			}
+
+			Expect(b.DefaultExternalDNSRecord().GetValues().PropagationVerification).To(Equal(&dnsrecord.PropagationVerification{
+				NameServers:    []string{"10.0.0.1:53"},
+				InitialBackoff: time.Second,
+				MaxBackoff:     time.Minute,
+				Timeout:        5 * time.Minute,
+			}))
+			Expect(b.DefaultInternalDNSRecord().GetValues().PropagationVerification).To(BeNil())
+		})
+
 		DescribeTable("should set AnnotateOperation value to true",
 			func(mutateShootFn func()) {
 				mutateShootFn()
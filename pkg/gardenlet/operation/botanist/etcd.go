@@ -22,6 +22,7 @@ import (
 	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
 	"github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
 	"github.com/gardener/gardener/pkg/utils/flow"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	"github.com/gardener/gardener/pkg/utils/timewindow"
 )
 
@@ -35,6 +36,10 @@ func (b *Botanist) DefaultEtcd(role string, class etcd.Class) (etcd.Interface, e
 		return nil, err
 	}
 
+	// etcd maintenance is suspended while it is excluded by one of the shoot's maintenance exclusions, the shoot
+	// controller reconciles the shoot when the exclusion ends for resuming it
+	etcdMaintenanceExcluded := v1beta1helper.ActiveMaintenanceExclusion(b.Shoot.GetInfo().Spec.Maintenance, gardencorev1beta1.MaintenanceExclusionOperationEtcdMaintenance, b.Clock.Now()) != nil
+
 	var replicas *int32
 	if !b.Shoot.HibernationEnabled {
 		replicas = ptr.To(getEtcdReplicas(b.Shoot.GetInfo()))
@@ -51,20 +56,26 @@ func (b *Botanist) DefaultEtcd(role string, class etcd.Class) (etcd.Interface, e
 			Replicas:                    replicas,
 			StorageCapacity:             b.Seed.GetValidVolumeSize("10Gi"),
 			DefragmentationSchedule:     &defragmentationSchedule,
+			DefragmentationSuspended:    etcdMaintenanceExcluded,
 			CARotationPhase:             v1beta1helper.GetShootCARotationPhase(b.Shoot.GetInfo().Status.Credentials),
 			RuntimeKubernetesVersion:    b.Seed.KubernetesVersion,
 			MaintenanceTimeWindow:       *b.Shoot.GetInfo().Spec.Maintenance.TimeWindow,
-			EvictionRequirement:         getEvictionRequirement(class, b.Shoot),
+			EvictionRequirement:         getEvictionRequirement(class, b.Shoot, etcdMaintenanceExcluded),
 			PriorityClassName:           v1beta1constants.PriorityClassNameShootControlPlane500,
 			HighAvailabilityEnabled:     v1beta1helper.IsHAControlPlaneConfigured(b.Shoot.GetInfo()),
 			TopologyAwareRoutingEnabled: b.Shoot.TopologyAwareRoutingEnabled,
+			SizeClass:                   v1beta1helper.GetShootSizeClass(b.Shoot.GetInfo()),
+			SizeClassUpdateAllowed:      gardenerutils.IsNowInEffectiveShootMaintenanceTimeWindow(b.Shoot.GetInfo(), b.Clock),
 		},
 	)
 
 	return e, nil
 }
 
-func getEvictionRequirement(c etcd.Class, s *shoot.Shoot) *string {
+func getEvictionRequirement(c etcd.Class, s *shoot.Shoot, maintenanceExcluded bool) *string {
+	if maintenanceExcluded {
+		return ptr.To(v1beta1constants.EvictionRequirementNever)
+	}
 	if c == etcd.ClassImportant && (s.Purpose == gardencorev1beta1.ShootPurposeProduction || s.Purpose == gardencorev1beta1.ShootPurposeInfrastructure) {
 		return ptr.To(v1beta1constants.EvictionRequirementNever)
 	}
@@ -96,7 +107,7 @@ func (b *Botanist) DeployEtcd(ctx context.Context) error {
 			deltaSnapshotRetentionPeriod = b.Config.ETCDConfig.DeltaSnapshotRetentionPeriod
 		}
 
-		b.Shoot.Components.ControlPlane.EtcdMain.SetBackupConfig(&etcd.BackupConfig{
+		backupConfig := &etcd.BackupConfig{
 			Provider:                     b.Seed.GetInfo().Spec.Backup.Provider,
 			SecretRefName:                v1beta1constants.BackupSecretName,
 			Prefix:                       b.Shoot.BackupEntryName,
@@ -104,7 +115,10 @@ func (b *Botanist) DeployEtcd(ctx context.Context) error {
 			FullSnapshotSchedule:         snapshotSchedule,
 			LeaderElection:               backupLeaderElection,
 			DeltaSnapshotRetentionPeriod: deltaSnapshotRetentionPeriod,
-		})
+		}
+		b.mergeShootETCDBackupSettings(backupConfig)
+
+		b.Shoot.Components.ControlPlane.EtcdMain.SetBackupConfig(backupConfig)
 	}
 
 	// Roll out the new peer CA first so that every member in the cluster trusts the old and the new CA.
@@ -151,6 +165,36 @@ func (b *Botanist) SnapshotEtcd(ctx context.Context) error {
 	return shared.SnapshotEtcd(ctx, b.SecretsManager, b.Shoot.Components.ControlPlane.EtcdMain)
 }
 
+// TakeOnDemandEtcdSnapshot triggers a full snapshot of etcd-main which was requested by the Shoot owner via the
+// `backup-now` operation and reports its result in the Shoot status. A failed snapshot does not fail the
+// reconciliation, it is only reflected in the status.
+func (b *Botanist) TakeOnDemandEtcdSnapshot(ctx context.Context) error {
+	etcdSnapshot := b.Shoot.GetInfo().Status.EtcdSnapshot.DeepCopy()
+	if etcdSnapshot == nil {
+		etcdSnapshot = &gardencorev1beta1.EtcdSnapshot{}
+	}
+
+	snapshot, err := shared.FullSnapshotEtcd(ctx, b.SecretsManager, b.Shoot.Components.ControlPlane.EtcdMain)
+	if err != nil {
+		b.Logger.Error(err, "Failed taking on-demand etcd snapshot")
+		etcdSnapshot.Phase = gardencorev1beta1.EtcdSnapshotFailed
+		etcdSnapshot.Message = ptr.To(err.Error())
+	} else {
+		b.Logger.Info("Took on-demand etcd snapshot", "name", snapshot.Name, "location", snapshot.Location)
+		etcdSnapshot.Phase = gardencorev1beta1.EtcdSnapshotSucceeded
+		etcdSnapshot.LastCompletionTime = &metav1.Time{Time: snapshot.CreatedOn.UTC()}
+		etcdSnapshot.Name = &snapshot.Name
+		etcdSnapshot.Location = &snapshot.Location
+		etcdSnapshot.LastRevision = &snapshot.LastRevision
+		etcdSnapshot.Message = nil
+	}
+
+	return b.Shoot.UpdateInfoStatus(ctx, b.GardenClient, false, func(shoot *gardencorev1beta1.Shoot) error {
+		shoot.Status.EtcdSnapshot = etcdSnapshot
+		return nil
+	})
+}
+
 // ScaleETCDToZero scales ETCD main and events replicas to zero.
 func (b *Botanist) ScaleETCDToZero(ctx context.Context) error {
 	return b.scaleETCD(ctx, 0)
@@ -229,6 +273,35 @@ func (b *Botanist) restoreMultiNodeEtcd(ctx context.Context) error {
 	)(ctx)
 }
 
+// mergeShootETCDBackupSettings merges the etcd backup settings from the shoot specification into the given backup
+// config which contains the defaults. Settings which are not within the bounds defined by the seed are ignored (e.g.,
+// because the bounds were tightened or the shoot was migrated to another seed), i.e., the defaults are kept for them.
+func (b *Botanist) mergeShootETCDBackupSettings(backupConfig *etcd.BackupConfig) {
+	shootETCD := b.Shoot.GetInfo().Spec.ETCD
+	if shootETCD == nil || shootETCD.Backup == nil {
+		return
+	}
+
+	bounds := b.Seed.GetInfo().Spec.Backup.ETCD
+
+	if schedule := shootETCD.Backup.FullSnapshotSchedule; schedule != nil {
+		if err := v1beta1helper.CheckETCDFullSnapshotSchedule(bounds, *schedule); err != nil {
+			b.Logger.Info("Ignoring full snapshot schedule of etcd backup configured in shoot specification", "reason", err.Error())
+		} else {
+			backupConfig.FullSnapshotSchedule = *schedule
+			backupConfig.FullSnapshotScheduleOverridden = true
+		}
+	}
+
+	if retentionPeriod := shootETCD.Backup.DeltaSnapshotRetentionPeriod; retentionPeriod != nil {
+		if err := v1beta1helper.CheckETCDDeltaSnapshotRetentionPeriod(bounds, retentionPeriod.Duration); err != nil {
+			b.Logger.Info("Ignoring delta snapshot retention period of etcd backup configured in shoot specification", "reason", err.Error())
+		} else {
+			backupConfig.DeltaSnapshotRetentionPeriod = retentionPeriod
+		}
+	}
+}
+
 func determineBackupSchedule(shoot *gardencorev1beta1.Shoot) (string, error) {
 	return timewindow.DetermineSchedule(
 		"%d %d * * *",
@@ -24,11 +24,13 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	testclock "k8s.io/utils/clock/testing"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	seedmanagementv1alpha1 "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
@@ -42,6 +44,7 @@ import (
 	shootpkg "github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
 	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
 	fakesecretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager/fake"
+	"github.com/gardener/gardener/pkg/utils/test"
 	mockclient "github.com/gardener/gardener/third_party/mock/controller-runtime/client"
 )
 
@@ -54,6 +57,7 @@ var _ = Describe("Etcd", func() {
 		fakeClient       client.Client
 		sm               secretsmanager.Interface
 		botanist         *Botanist
+		fakeClock        *testclock.FakeClock
 
 		ctx                   = context.TODO()
 		fakeErr               = errors.New("fake err")
@@ -76,7 +80,9 @@ var _ = Describe("Etcd", func() {
 			Build()
 		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetesscheme.Scheme).Build()
 		sm = fakesecretsmanager.New(fakeClient, namespace)
-		botanist = &Botanist{Operation: &operation.Operation{}}
+		// outside the maintenance time window
+		fakeClock = testclock.NewFakeClock(time.Date(2024, time.January, 1, 10, 0, 0, 0, time.UTC))
+		botanist = &Botanist{Operation: &operation.Operation{Clock: fakeClock}}
 	})
 
 	AfterEach(func() {
@@ -200,6 +206,63 @@ var _ = Describe("Etcd", func() {
 			})
 		})
 
+		Context("with active etcd maintenance exclusion", func() {
+			BeforeEach(func() {
+				botanist.ManagedSeed = nil
+				botanist.Shoot.GetInfo().Spec.Maintenance.Exclusions = []gardencorev1beta1.MaintenanceExclusion{{
+					Name:       "peak-season",
+					Begin:      metav1.NewTime(fakeClock.Now().Add(-time.Hour)),
+					End:        metav1.NewTime(fakeClock.Now().Add(time.Hour)),
+					Operations: []gardencorev1beta1.MaintenanceExclusionOperation{gardencorev1beta1.MaintenanceExclusionOperationEtcdMaintenance},
+				}}
+			})
+
+			It("should suspend the defragmentation and forbid evictions", func() {
+				var values etcd.Values
+
+				oldNewEtcd := NewEtcd
+				defer func() { NewEtcd = oldNewEtcd }()
+				NewEtcd = func(_ logr.Logger, _ client.Client, _ string, _ secretsmanager.Interface, v etcd.Values) etcd.Interface {
+					values = v
+					return &newEtcdValidator{}
+				}
+
+				etcd, err := botanist.DefaultEtcd(role, class)
+				Expect(etcd).NotTo(BeNil())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(values.DefragmentationSuspended).To(BeTrue())
+				Expect(values.EvictionRequirement).To(PointTo(Equal(v1beta1constants.EvictionRequirementNever)))
+			})
+		})
+
+		Context("size class", func() {
+			var values etcd.Values
+
+			BeforeEach(func() {
+				DeferCleanup(test.WithVar(&NewEtcd, func(_ logr.Logger, _ client.Client, _ string, _ secretsmanager.Interface, v etcd.Values) etcd.Interface {
+					values = v
+					return &newEtcdValidator{}
+				}))
+			})
+
+			It("should not allow updating the resources according to the size class outside of the maintenance time window", func() {
+				_, err := botanist.DefaultEtcd(role, class)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(values.SizeClassUpdateAllowed).To(BeFalse())
+			})
+
+			It("should allow updating the resources according to the size class in the maintenance time window", func() {
+				fakeClock.SetTime(time.Date(2024, time.January, 1, 14, 0, 0, 0, time.UTC))
+
+				_, err := botanist.DefaultEtcd(role, class)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(values.SizeClassUpdateAllowed).To(BeTrue())
+			})
+		})
+
 		It("should return an error because the maintenance time window cannot be parsed", func() {
 			botanist.Shoot.GetInfo().Spec.Maintenance.TimeWindow = &gardencorev1beta1.MaintenanceTimeWindow{
 				Begin: "foobar",
@@ -346,6 +409,55 @@ var _ = Describe("Etcd", func() {
 				Expect(botanist.DeployEtcd(ctx)).To(Succeed())
 			})
 
+			It("should merge the etcd backup settings of the shoot within the bounds of the seed", func() {
+				botanist.Seed.GetInfo().Spec.Backup.ETCD = &gardencorev1beta1.SeedBackupETCD{
+					MinFullSnapshotInterval:         &metav1.Duration{Duration: 6 * time.Hour},
+					MaxDeltaSnapshotRetentionPeriod: &metav1.Duration{Duration: 7 * 24 * time.Hour},
+				}
+				botanist.Shoot.GetInfo().Spec.ETCD = &gardencorev1beta1.ETCD{
+					Backup: &gardencorev1beta1.ETCDBackup{
+						FullSnapshotSchedule:         ptr.To("0 */12 * * *"),
+						DeltaSnapshotRetentionPeriod: &metav1.Duration{Duration: 3 * 24 * time.Hour},
+					},
+				}
+
+				expectGetBackupSecret()
+				etcdMain.EXPECT().SetBackupConfig(&etcd.BackupConfig{
+					Provider:                       backupProvider,
+					SecretRefName:                  "etcd-backup",
+					Prefix:                         namespace + "--" + string(shootUID),
+					Container:                      bucketName,
+					FullSnapshotSchedule:           "0 */12 * * *",
+					FullSnapshotScheduleOverridden: true,
+					LeaderElection:                 backupLeaderElectionConfig,
+					DeltaSnapshotRetentionPeriod:   &metav1.Duration{Duration: 3 * 24 * time.Hour},
+				})
+				etcdMain.EXPECT().Deploy(ctx)
+				etcdEvents.EXPECT().Deploy(ctx)
+
+				Expect(botanist.DeployEtcd(ctx)).To(Succeed())
+			})
+
+			It("should ignore the etcd backup settings of the shoot which are out of the bounds of the seed", func() {
+				botanist.Seed.GetInfo().Spec.Backup.ETCD = &gardencorev1beta1.SeedBackupETCD{
+					MinFullSnapshotInterval:         &metav1.Duration{Duration: 6 * time.Hour},
+					MaxDeltaSnapshotRetentionPeriod: &metav1.Duration{Duration: 7 * 24 * time.Hour},
+				}
+				botanist.Shoot.GetInfo().Spec.ETCD = &gardencorev1beta1.ETCD{
+					Backup: &gardencorev1beta1.ETCDBackup{
+						FullSnapshotSchedule:         ptr.To("0 * * * *"),
+						DeltaSnapshotRetentionPeriod: &metav1.Duration{Duration: 14 * 24 * time.Hour},
+					},
+				}
+
+				expectGetBackupSecret()
+				expectSetBackupConfig()
+				etcdMain.EXPECT().Deploy(ctx)
+				etcdEvents.EXPECT().Deploy(ctx)
+
+				Expect(botanist.DeployEtcd(ctx)).To(Succeed())
+			})
+
 			It("should fail when reading the backup secret fails", func() {
 				c.EXPECT().Get(ctx, client.ObjectKey{Namespace: namespace, Name: "etcd-backup"}, gomock.AssignableToTypeOf(&corev1.Secret{})).Return(fakeErr)
 
@@ -449,6 +561,77 @@ var _ = Describe("Etcd", func() {
 		})
 	})
 
+	Describe("#TakeOnDemandEtcdSnapshot", func() {
+		var (
+			etcdMain     *mocketcd.MockInterface
+			gardenClient client.Client
+			shoot        *gardencorev1beta1.Shoot
+			initiatedAt  = metav1.NewTime(time.Date(2024, 1, 2, 3, 0, 0, 0, time.UTC))
+		)
+
+		BeforeEach(func() {
+			etcdMain = mocketcd.NewMockInterface(ctrl)
+
+			shoot = &gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "garden-foo"},
+				Status: gardencorev1beta1.ShootStatus{
+					EtcdSnapshot: &gardencorev1beta1.EtcdSnapshot{
+						Phase:              gardencorev1beta1.EtcdSnapshotPending,
+						LastInitiationTime: &initiatedAt,
+					},
+				},
+			}
+			gardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithObjects(shoot).WithStatusSubresource(&gardencorev1beta1.Shoot{}).Build()
+
+			Expect(fakeClient.Create(ctx, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "ca-etcd", Namespace: namespace}})).To(Succeed())
+
+			botanist.Logger = logr.Discard()
+			botanist.GardenClient = gardenClient
+			botanist.SecretsManager = sm
+			botanist.Shoot = &shootpkg.Shoot{
+				Components: &shootpkg.Components{
+					ControlPlane: &shootpkg.ControlPlane{
+						EtcdMain: etcdMain,
+					},
+				},
+			}
+			botanist.Shoot.SetInfo(shoot)
+		})
+
+		It("should report the taken snapshot in the shoot status", func() {
+			etcdMain.EXPECT().FullSnapshot(ctx, gomock.Any()).Return(&etcd.SnapshotInfo{
+				Name:         "Full-00000000-00000042-1704164645",
+				Location:     "bucket/shoot--foo--bar/etcd-main/v2/Full-00000000-00000042-1704164645",
+				LastRevision: 42,
+				CreatedOn:    time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+			}, nil)
+
+			Expect(botanist.TakeOnDemandEtcdSnapshot(ctx)).To(Succeed())
+
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Status.EtcdSnapshot).To(PointTo(MatchAllFields(Fields{
+				"Phase":              Equal(gardencorev1beta1.EtcdSnapshotSucceeded),
+				"LastInitiationTime": PointTo(HaveField("Time", BeTemporally("==", initiatedAt.Time))),
+				"LastCompletionTime": PointTo(HaveField("Time", BeTemporally("==", time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)))),
+				"Name":               PointTo(Equal("Full-00000000-00000042-1704164645")),
+				"Location":           PointTo(Equal("bucket/shoot--foo--bar/etcd-main/v2/Full-00000000-00000042-1704164645")),
+				"LastRevision":       PointTo(Equal(int64(42))),
+				"Message":            BeNil(),
+			})))
+		})
+
+		It("should report a failed snapshot in the shoot status", func() {
+			etcdMain.EXPECT().FullSnapshot(ctx, gomock.Any()).Return(nil, fakeErr)
+
+			Expect(botanist.TakeOnDemandEtcdSnapshot(ctx)).To(Succeed())
+
+			Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+			Expect(shoot.Status.EtcdSnapshot.Phase).To(Equal(gardencorev1beta1.EtcdSnapshotFailed))
+			Expect(shoot.Status.EtcdSnapshot.Message).To(PointTo(Equal("fake err")))
+			Expect(shoot.Status.EtcdSnapshot.LastCompletionTime).To(BeNil())
+		})
+	})
+
 	Describe("#DestroyEtcd", func() {
 		var (
 			etcdMain, etcdEvents *mocketcd.MockInterface
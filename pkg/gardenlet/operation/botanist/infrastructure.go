@@ -63,6 +63,10 @@ func (b *Botanist) WaitForInfrastructure(ctx context.Context) error {
 	}
 
 	networkingStatus := &gardencorev1beta1.NetworkingStatus{}
+	if networking := b.Shoot.GetInfo().Status.Networking; networking != nil {
+		// The control plane egress CIDRs are reported by the ControlPlane extension, hence keep them.
+		networkingStatus.ControlPlaneEgressCIDRs = networking.ControlPlaneEgressCIDRs
+	}
 	if nodesCIDRs := b.Shoot.Components.Extensions.Infrastructure.NodesCIDRs(); len(nodesCIDRs) > 0 {
 		// Only update node CIDR if it's not already set.
 		if b.Shoot.GetInfo().Spec.Networking.Nodes == nil {
@@ -94,6 +94,16 @@ func (b *Botanist) computeKubeAPIServerAutoscalingConfig() apiserver.Autoscaling
 		}
 	)
 
+	// The initial resource requests depend on the size class of the shoot, afterwards they are managed by the autoscaler.
+	switch v1beta1helper.GetShootSizeClass(b.Shoot.GetInfo()) {
+	case gardencorev1beta1.ShootSizeClassMedium:
+		apiServerResources.Requests = corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("500m"), corev1.ResourceMemory: resource.MustParse("1Gi")}
+	case gardencorev1beta1.ShootSizeClassLarge:
+		apiServerResources.Requests = corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1"), corev1.ResourceMemory: resource.MustParse("2Gi")}
+	case gardencorev1beta1.ShootSizeClassExtraLarge:
+		apiServerResources.Requests = corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2"), corev1.ResourceMemory: resource.MustParse("4Gi")}
+	}
+
 	if v1beta1helper.IsHAControlPlaneConfigured(b.Shoot.GetInfo()) {
 		minReplicas = 3
 	}
@@ -134,6 +144,8 @@ func (b *Botanist) computeKubeAPIServerServerCertificateConfig() kubeapiserver.S
 		dnsNames = append(dnsNames, *(b.Shoot.GetInfo().Spec.DNS.Domain), gardenerutils.GetAPIServerDomain(*b.Shoot.ExternalClusterDomain))
 	}
 
+	dnsNames = append(dnsNames, b.kubeAPIServerAdditionalHostnames()...)
+
 	return kubeapiserver.ServerCertificateConfig{
 		ExtraIPAddresses: ipAddresses,
 		ExtraDNSNames:    dnsNames,
@@ -153,6 +165,11 @@ func (b *Botanist) computeKubeAPIServerSNIConfig() kubeapiserver.SNIConfig {
 		config.TLS = append(config.TLS, kubeapiserver.TLSSNIConfig{SecretName: &b.ControlPlaneWildcardCert.Name, DomainPatterns: []string{b.ComputeKubeAPIServerHost()}})
 	}
 
+	// Serve the managed certificate for the additional host names if requested, see DeployKubeAPIServerManagedCertificate.
+	if b.kubeAPIServerManagedCertificateRequested() {
+		config.TLS = append(config.TLS, kubeapiserver.TLSSNIConfig{SecretName: ptr.To(SecretNameKubeAPIServerManagedCertificate), DomainPatterns: b.kubeAPIServerAdditionalHostnames()})
+	}
+
 	return config
 }
 
@@ -212,6 +229,14 @@ func (b *Botanist) DeployKubeAPIServer(ctx context.Context) error {
 		}
 	}
 
+	auditSinkWebhook, err := b.computeKubeAPIServerAuditSinkWebhook(ctx)
+	if err != nil {
+		return err
+	}
+	if auditSinkWebhook != nil {
+		b.Shoot.Components.ControlPlane.KubeAPIServer.SetAuditWebhook(auditSinkWebhook)
+	}
+
 	if err := shared.DeployKubeAPIServer(
 		ctx,
 		b.SeedClientSet.Client(),
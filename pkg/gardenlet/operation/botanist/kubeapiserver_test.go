@@ -150,6 +150,7 @@ var _ = Describe("KubeAPIServer", func() {
 			},
 			Status: gardencorev1beta1.ShootStatus{
 				TechnicalID: seedNamespace,
+				SizeClass:   ptr.To(gardencorev1beta1.ShootSizeClassSmall),
 			},
 		})
 		botanist.Shoot.SetShootState(&gardencorev1beta1.ShootState{})
@@ -269,6 +270,22 @@ var _ = Describe("KubeAPIServer", func() {
 						ScaleDownDisabled: false,
 					},
 				),
+				Entry("shoot has size class XL",
+					func() {
+						botanist.Shoot.GetInfo().Status.SizeClass = ptr.To(gardencorev1beta1.ShootSizeClassExtraLarge)
+					},
+					apiserver.AutoscalingConfig{
+						APIServerResources: corev1.ResourceRequirements{
+							Requests: corev1.ResourceList{
+								corev1.ResourceCPU:    resource.MustParse("2"),
+								corev1.ResourceMemory: resource.MustParse("4Gi"),
+							},
+						},
+						MinReplicas:       2,
+						MaxReplicas:       6,
+						ScaleDownDisabled: false,
+					},
+				),
 			)
 		})
 	})
@@ -352,6 +369,25 @@ var _ = Describe("KubeAPIServer", func() {
 						},
 					},
 				),
+				Entry("Managed certificate requested",
+					func() {
+						botanist.Shoot.GetInfo().Spec.Kubernetes.KubeAPIServer = &gardencorev1beta1.KubeAPIServerConfig{
+							Endpoint: &gardencorev1beta1.KubeAPIServerEndpoint{
+								AdditionalHostnames: []string{"api.example.com"},
+								ManagedCertificate:  ptr.To(true),
+							},
+						}
+					},
+					kubeapiserver.SNIConfig{
+						Enabled: false,
+						TLS: []kubeapiserver.TLSSNIConfig{
+							{
+								SecretName:     ptr.To("kube-apiserver-managed-certificate"),
+								DomainPatterns: []string{"api.example.com"},
+							},
+						},
+					},
+				),
 			)
 		})
 
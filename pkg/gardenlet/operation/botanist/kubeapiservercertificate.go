@@ -0,0 +1,192 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist
+
+import (
+	"context"
+	"crypto"
+	"errors"
+	"fmt"
+
+	"golang.org/x/crypto/acme"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	extensionsdnsrecord "github.com/gardener/gardener/pkg/component/extensions/dnsrecord"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	acmeutils "github.com/gardener/gardener/pkg/utils/acme"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+)
+
+const (
+	// SecretNameKubeAPIServerManagedCertificate is the name of the secret in the shoot namespace of the seed which
+	// contains the managed certificate for the additional host names of the kube-apiserver.
+	SecretNameKubeAPIServerManagedCertificate = "kube-apiserver-managed-certificate"
+	// SecretNameACMEAccountKey is the name of the secret in the garden namespace of the seed which contains the key of
+	// the ACME account used by gardenlet.
+	SecretNameACMEAccountKey = "acme-account-key"
+	// DataKeyACMEAccountKey is the key in the data of the ACME account key secret which contains the PEM encoded key.
+	DataKeyACMEAccountKey = "key.pem"
+
+	dnsRecordACMEChallengeName = "acme-challenge"
+)
+
+// NewACMEIssuer creates the issuer for the managed certificate of the kube-apiserver. Exposed for testing.
+var NewACMEIssuer = func(directoryURL string, accountKey crypto.Signer, email *string, solver acmeutils.DNS01Solver) acmeutils.CertificateIssuer {
+	return &acmeutils.Issuer{
+		Client: &acme.Client{Key: accountKey, DirectoryURL: directoryURL},
+		Email:  email,
+		Solver: solver,
+	}
+}
+
+// DeployKubeAPIServerManagedCertificate issues the managed certificate for the additional host names of the
+// kube-apiserver via the ACME server configured for the seed, or renews it if two thirds of its lifetime have elapsed
+// or the host names changed. The DNS-01 challenges are expected to be delegated to the TXT record
+// '_acme-challenge.api.<shoot-domain>' via CNAME records which are maintained by the shoot owner. If no managed
+// certificate is requested, a previously issued certificate is deleted.
+func (b *Botanist) DeployKubeAPIServerManagedCertificate(ctx context.Context) error {
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: SecretNameKubeAPIServerManagedCertificate, Namespace: b.Shoot.SeedNamespace}}
+
+	if !b.kubeAPIServerManagedCertificateRequested() {
+		return kubernetesutils.DeleteObject(ctx, b.SeedClientSet.Client(), secret)
+	}
+
+	acmeSettings := b.acmeSettings()
+	if acmeSettings == nil {
+		return fmt.Errorf("a managed certificate is requested for the kube-apiserver but seed %q has no ACME server configured", b.Seed.GetInfo().Name)
+	}
+	if !b.NeedsExternalDNS() {
+		return errors.New("a managed certificate for the kube-apiserver requires an external domain managed by Gardener")
+	}
+
+	if err := b.SeedClientSet.Client().Get(ctx, client.ObjectKeyFromObject(secret), secret); err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	hostnames := b.kubeAPIServerAdditionalHostnames()
+	if !acmeutils.CertificateNeedsRenewal(secret.Data[corev1.TLSCertKey], hostnames, NowFunc()) {
+		return nil
+	}
+
+	accountKey, err := b.getOrCreateACMEAccountKey(ctx)
+	if err != nil {
+		return fmt.Errorf("failed getting ACME account key: %w", err)
+	}
+
+	privateKey, privateKeyPEM, err := acmeutils.GenerateKey()
+	if err != nil {
+		return fmt.Errorf("failed generating private key for managed certificate: %w", err)
+	}
+
+	b.Logger.Info("Issuing managed certificate for kube-apiserver", "hostnames", hostnames, "directoryURL", acmeSettings.DirectoryURL)
+	certificate, err := NewACMEIssuer(acmeSettings.DirectoryURL, accountKey, acmeSettings.Email, &acmeChallengeSolver{botanist: b}).Issue(ctx, hostnames, privateKey)
+	if err != nil {
+		return fmt.Errorf("failed issuing managed certificate for kube-apiserver (ensure that a CNAME record for '_acme-challenge.<hostname>' pointing to %q exists for each additional host name): %w",
+			gardenerutils.GetKubeAPIServerACMEChallengeDomain(*b.Shoot.ExternalClusterDomain), err)
+	}
+
+	_, err = controllerutils.GetAndCreateOrMergePatch(ctx, b.SeedClientSet.Client(), secret, func() error {
+		secret.Type = corev1.SecretTypeTLS
+		secret.Data = map[string][]byte{
+			corev1.TLSCertKey:       certificate,
+			corev1.TLSPrivateKeyKey: privateKeyPEM,
+		}
+		return nil
+	})
+	return err
+}
+
+// getOrCreateACMEAccountKey returns the key of the ACME account of gardenlet. It is shared by all shoots of the seed
+// and generated on first use.
+func (b *Botanist) getOrCreateACMEAccountKey(ctx context.Context) (crypto.Signer, error) {
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: SecretNameACMEAccountKey, Namespace: v1beta1constants.GardenNamespace}}
+
+	if err := b.SeedClientSet.Client().Get(ctx, client.ObjectKeyFromObject(secret), secret); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, err
+		}
+
+		_, keyPEM, err := acmeutils.GenerateKey()
+		if err != nil {
+			return nil, err
+		}
+		secret.Data = map[string][]byte{DataKeyACMEAccountKey: keyPEM}
+
+		// Another shoot might have created the account key concurrently, hence the secret is read again.
+		if err := b.SeedClientSet.Client().Create(ctx, secret); client.IgnoreAlreadyExists(err) != nil {
+			return nil, err
+		}
+		if err := b.SeedClientSet.Client().Get(ctx, client.ObjectKeyFromObject(secret), secret); err != nil {
+			return nil, err
+		}
+	}
+
+	return acmeutils.ParseKey(secret.Data[DataKeyACMEAccountKey])
+}
+
+func (b *Botanist) kubeAPIServerManagedCertificateRequested() bool {
+	kubeAPIServer := b.Shoot.GetInfo().Spec.Kubernetes.KubeAPIServer
+	return kubeAPIServer != nil && kubeAPIServer.Endpoint != nil && ptr.Deref(kubeAPIServer.Endpoint.ManagedCertificate, false) && len(kubeAPIServer.Endpoint.AdditionalHostnames) > 0
+}
+
+func (b *Botanist) acmeSettings() *gardencorev1beta1.SeedSettingACME {
+	if settings := b.Seed.GetInfo().Spec.Settings; settings != nil {
+		return settings.ACME
+	}
+	return nil
+}
+
+// acmeChallengeSolver publishes the DNS-01 challenge records via a DNSRecord in the external domain of the shoot.
+type acmeChallengeSolver struct {
+	botanist  *Botanist
+	dnsRecord extensionsdnsrecord.Interface
+}
+
+func (s *acmeChallengeSolver) Present(ctx context.Context, values []string) error {
+	s.dnsRecord = s.botanist.newACMEChallengeDNSRecord(values)
+	if err := s.dnsRecord.Deploy(ctx); err != nil {
+		return err
+	}
+	return s.dnsRecord.Wait(ctx)
+}
+
+func (s *acmeChallengeSolver) CleanUp(ctx context.Context) error {
+	if s.dnsRecord == nil {
+		return nil
+	}
+	if err := s.dnsRecord.Destroy(ctx); err != nil {
+		return err
+	}
+	return s.dnsRecord.WaitCleanup(ctx)
+}
+
+func (b *Botanist) newACMEChallengeDNSRecord(values []string) extensionsdnsrecord.Interface {
+	dnsRecordValues := &extensionsdnsrecord.Values{
+		Name:              b.Shoot.GetInfo().Name + "-" + dnsRecordACMEChallengeName,
+		SecretName:        DNSRecordSecretPrefix + "-" + b.Shoot.GetInfo().Name + "-" + dnsRecordACMEChallengeName,
+		Namespace:         b.Shoot.SeedNamespace,
+		TTL:               b.Config.Controllers.Shoot.DNSEntryTTLSeconds,
+		AnnotateOperation: true,
+		Type:              b.Shoot.ExternalDomain.Provider,
+		SecretData:        b.Shoot.ExternalDomain.SecretData,
+		DNSName:           gardenerutils.GetKubeAPIServerACMEChallengeDomain(*b.Shoot.ExternalClusterDomain),
+		RecordType:        extensionsv1alpha1.DNSRecordTypeTXT,
+		Values:            values,
+	}
+
+	if b.Shoot.ExternalDomain.Zone != "" {
+		dnsRecordValues.Zone = &b.Shoot.ExternalDomain.Zone
+	}
+
+	return b.newDNSRecord(dnsRecordValues)
+}
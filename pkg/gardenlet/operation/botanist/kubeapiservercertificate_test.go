@@ -0,0 +1,196 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist_test
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	kubernetesfake "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	"github.com/gardener/gardener/pkg/gardenlet/operation"
+	. "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
+	"github.com/gardener/gardener/pkg/gardenlet/operation/seed"
+	"github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
+	acmeutils "github.com/gardener/gardener/pkg/utils/acme"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	"github.com/gardener/gardener/pkg/utils/test"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("KubeAPIServerManagedCertificate", func() {
+	const (
+		namespace    = "shoot--foo--bar"
+		directoryURL = "https://acme.example.com/directory"
+	)
+
+	var (
+		ctx       = context.TODO()
+		now       = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+		hostnames = []string{"api.example.com", "kube.example.com"}
+
+		c            client.Client
+		botanist     *Botanist
+		issuer       *fakeIssuer
+		issuerValues struct {
+			directoryURL string
+			email        *string
+		}
+		secret *corev1.Secret
+	)
+
+	BeforeEach(func() {
+		c = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		issuer = &fakeIssuer{certificate: generateCertificate(hostnames, now)}
+
+		DeferCleanup(test.WithVars(
+			&NowFunc, func() time.Time { return now },
+			&NewACMEIssuer, func(directoryURL string, accountKey crypto.Signer, email *string, _ acmeutils.DNS01Solver) acmeutils.CertificateIssuer {
+				Expect(accountKey).NotTo(BeNil())
+				issuerValues.directoryURL, issuerValues.email = directoryURL, email
+				return issuer
+			},
+		))
+
+		botanist = &Botanist{Operation: &operation.Operation{
+			Logger:        logr.Discard(),
+			SeedClientSet: kubernetesfake.NewClientSetBuilder().WithClient(c).Build(),
+			Seed:          &seed.Seed{},
+			Shoot: &shoot.Shoot{
+				SeedNamespace:         namespace,
+				ExternalClusterDomain: ptr.To("foo.example.com"),
+				ExternalDomain:        &gardenerutils.Domain{Domain: "foo.example.com", Provider: "provider"},
+			},
+		}}
+		botanist.Seed.SetInfo(&gardencorev1beta1.Seed{
+			ObjectMeta: metav1.ObjectMeta{Name: "seed"},
+			Spec: gardencorev1beta1.SeedSpec{
+				Settings: &gardencorev1beta1.SeedSettings{
+					ACME: &gardencorev1beta1.SeedSettingACME{DirectoryURL: directoryURL, Email: ptr.To("admin@example.com")},
+				},
+			},
+		})
+		botanist.Shoot.SetInfo(&gardencorev1beta1.Shoot{
+			Spec: gardencorev1beta1.ShootSpec{
+				DNS: &gardencorev1beta1.DNS{Domain: ptr.To("foo.example.com")},
+				Kubernetes: gardencorev1beta1.Kubernetes{
+					KubeAPIServer: &gardencorev1beta1.KubeAPIServerConfig{
+						Endpoint: &gardencorev1beta1.KubeAPIServerEndpoint{
+							AdditionalHostnames: hostnames,
+							ManagedCertificate:  ptr.To(true),
+						},
+					},
+				},
+			},
+		})
+
+		secret = &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "kube-apiserver-managed-certificate", Namespace: namespace}}
+	})
+
+	Describe("#DeployKubeAPIServerManagedCertificate", func() {
+		It("should issue the certificate and generate the ACME account key", func() {
+			Expect(botanist.DeployKubeAPIServerManagedCertificate(ctx)).To(Succeed())
+
+			Expect(issuer.domains).To(Equal(hostnames))
+			Expect(issuerValues.directoryURL).To(Equal(directoryURL))
+			Expect(issuerValues.email).To(PointTo(Equal("admin@example.com")))
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(secret), secret)).To(Succeed())
+			Expect(secret.Type).To(Equal(corev1.SecretTypeTLS))
+			Expect(secret.Data).To(HaveKeyWithValue("tls.crt", issuer.certificate))
+			Expect(secret.Data).To(HaveKey("tls.key"))
+
+			accountKeySecret := &corev1.Secret{}
+			Expect(c.Get(ctx, client.ObjectKey{Name: "acme-account-key", Namespace: "garden"}, accountKeySecret)).To(Succeed())
+			Expect(acmeutils.ParseKey(accountKeySecret.Data["key.pem"])).NotTo(BeNil())
+		})
+
+		It("should not renew a valid certificate", func() {
+			secret.Data = map[string][]byte{"tls.crt": generateCertificate(hostnames, now.Add(-time.Hour))}
+			Expect(c.Create(ctx, secret)).To(Succeed())
+
+			Expect(botanist.DeployKubeAPIServerManagedCertificate(ctx)).To(Succeed())
+
+			Expect(issuer.domains).To(BeNil())
+		})
+
+		It("should renew the certificate if the host names changed", func() {
+			secret.Data = map[string][]byte{"tls.crt": generateCertificate(hostnames[:1], now.Add(-time.Hour))}
+			Expect(c.Create(ctx, secret)).To(Succeed())
+
+			Expect(botanist.DeployKubeAPIServerManagedCertificate(ctx)).To(Succeed())
+
+			Expect(issuer.domains).To(Equal(hostnames))
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(secret), secret)).To(Succeed())
+			Expect(secret.Data).To(HaveKeyWithValue("tls.crt", issuer.certificate))
+		})
+
+		It("should return an error hinting at the challenge delegation if the certificate cannot be issued", func() {
+			issuer.err = errors.New("fake")
+
+			Expect(botanist.DeployKubeAPIServerManagedCertificate(ctx)).To(MatchError(ContainSubstring(`pointing to "_acme-challenge.api.foo.example.com"`)))
+		})
+
+		It("should fail if the seed has no ACME server configured", func() {
+			botanist.Seed.GetInfo().Spec.Settings = nil
+
+			Expect(botanist.DeployKubeAPIServerManagedCertificate(ctx)).To(MatchError(ContainSubstring(`seed "seed" has no ACME server configured`)))
+		})
+
+		It("should delete the certificate if it is no longer requested", func() {
+			Expect(c.Create(ctx, secret)).To(Succeed())
+			botanist.Shoot.GetInfo().Spec.Kubernetes.KubeAPIServer.Endpoint.ManagedCertificate = nil
+
+			Expect(botanist.DeployKubeAPIServerManagedCertificate(ctx)).To(Succeed())
+
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(secret), secret)).To(BeNotFoundError())
+			Expect(issuer.domains).To(BeNil())
+		})
+	})
+})
+
+type fakeIssuer struct {
+	domains     []string
+	certificate []byte
+	err         error
+}
+
+func (f *fakeIssuer) Issue(_ context.Context, domains []string, _ crypto.Signer) ([]byte, error) {
+	f.domains = domains
+	return f.certificate, f.err
+}
+
+func generateCertificate(domains []string, notBefore time.Time) []byte {
+	key, _, err := acmeutils.GenerateKey()
+	Expect(err).NotTo(HaveOccurred())
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		DNSNames:     domains,
+		NotBefore:    notBefore,
+		NotAfter:     notBefore.Add(90 * 24 * time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	Expect(err).NotTo(HaveOccurred())
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
@@ -7,6 +7,8 @@ package botanist
 import (
 	"context"
 	"net"
+	"slices"
+	"strconv"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -14,6 +16,7 @@ import (
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/gardener/gardener/pkg/component"
 	kubeapiserverexposure "github.com/gardener/gardener/pkg/component/kubernetes/apiserverexposure"
+	vpnseedserver "github.com/gardener/gardener/pkg/component/networking/vpn/seedserver"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 )
 
@@ -92,10 +95,10 @@ func (b *Botanist) setAPIServerServiceClusterIP(clusterIP string) {
 		b.Shoot.SeedNamespace,
 		func() *kubeapiserverexposure.SNIValues {
 			return &kubeapiserverexposure.SNIValues{
-				Hosts: []string{
+				Hosts: append([]string{
 					gardenerutils.GetAPIServerDomain(*b.Shoot.ExternalClusterDomain),
 					gardenerutils.GetAPIServerDomain(b.Shoot.InternalClusterDomain),
-				},
+				}, b.kubeAPIServerAdditionalHostnames()...),
 				APIServerProxy: &kubeapiserverexposure.APIServerProxy{
 					APIServerClusterIP: b.APIServerClusterIP,
 				},
@@ -103,11 +106,74 @@ func (b *Botanist) setAPIServerServiceClusterIP(clusterIP string) {
 					Namespace: b.IstioNamespace(),
 					Labels:    b.IstioLabels(),
 				},
+				AuthorizedNetworks: b.kubeAPIServerAuthorizedNetworks(),
+				AccessLog:          b.kubeAPIServerAccessLog(),
 			}
 		},
 	)
 }
 
+// kubeAPIServerAuthorizedNetworks returns the networks which are allowed to access the kube-apiserver and the VPN
+// endpoint. The internal domain and the VPN endpoint are used by the shoot's nodes, hence they are only restricted once
+// the egress CIDRs of the shoot are known. The seed's pod and node networks are always allowed for them since the
+// control plane components access the kube-apiserver via the internal domain as well.
+func (b *Botanist) kubeAPIServerAuthorizedNetworks() *kubeapiserverexposure.AuthorizedNetworks {
+	kubeAPIServer := b.Shoot.GetInfo().Spec.Kubernetes.KubeAPIServer
+	if kubeAPIServer == nil || len(kubeAPIServer.AuthorizedNetworks) == 0 {
+		return nil
+	}
+
+	hosts := append([]string{gardenerutils.GetAPIServerDomain(*b.Shoot.ExternalClusterDomain)}, b.kubeAPIServerAdditionalHostnames()...)
+	// The kube-apiserver ingress (see DefaultKubeAPIServerIngress) is served by the default istio ingress gateway, hence
+	// its host can only be restricted by the same policy if the shoot does not use a dedicated ingress gateway.
+	if b.IstioNamespace() == b.DefaultIstioNamespace() {
+		hosts = append(hosts, b.ComputeKubeAPIServerHost())
+	}
+
+	authorizedNetworks := &kubeapiserverexposure.AuthorizedNetworks{
+		Hosts: hosts,
+		CIDRs: kubeAPIServer.AuthorizedNetworks,
+	}
+
+	if networking := b.Shoot.GetInfo().Status.Networking; networking != nil && len(networking.EgressCIDRs) > 0 {
+		seedNetworks := b.Seed.GetInfo().Spec.Networks
+		authorizedNetworks.InternalHosts = []string{gardenerutils.GetAPIServerDomain(b.Shoot.InternalClusterDomain)}
+		authorizedNetworks.InternalCIDRs = append(slices.Clone(networking.EgressCIDRs), seedNetworks.Pods)
+		if seedNetworks.Nodes != nil {
+			authorizedNetworks.InternalCIDRs = append(authorizedNetworks.InternalCIDRs, *seedNetworks.Nodes)
+		}
+		authorizedNetworks.VPNGatewayPort = vpnseedserver.GatewayPort
+	}
+
+	return authorizedNetworks
+}
+
+// kubeAPIServerAdditionalHostnames returns the additional host names under which the kube-apiserver is exposed. Their
+// DNS records are managed by the shoot owner and point to the external domain.
+func (b *Botanist) kubeAPIServerAdditionalHostnames() []string {
+	if kubeAPIServer := b.Shoot.GetInfo().Spec.Kubernetes.KubeAPIServer; kubeAPIServer != nil && kubeAPIServer.Endpoint != nil {
+		return kubeAPIServer.Endpoint.AdditionalHostnames
+	}
+	return nil
+}
+
+// kubeAPIServerAccessLog returns the access log configuration for the kube-apiserver SNI if sampled access logging was
+// requested via the shoot annotation.
+func (b *Botanist) kubeAPIServerAccessLog() *kubeapiserverexposure.AccessLog {
+	value, ok := b.Shoot.GetInfo().Annotations[v1beta1constants.AnnotationShootKubeAPIServerAccessLogSamplingPercentage]
+	if !ok {
+		return nil
+	}
+
+	percentage, err := strconv.ParseInt(value, 10, 32)
+	if err != nil || percentage < 1 || percentage > 100 {
+		b.Logger.Info("Ignoring invalid kube-apiserver access log sampling percentage", "annotation", v1beta1constants.AnnotationShootKubeAPIServerAccessLogSamplingPercentage, "value", value)
+		return nil
+	}
+
+	return &kubeapiserverexposure.AccessLog{SamplingPercentage: int32(percentage)}
+}
+
 // DefaultKubeAPIServerIngress returns a deployer for the kube-apiserver ingress.
 func (b *Botanist) DefaultKubeAPIServerIngress() component.Deployer {
 	return kubeapiserverexposure.NewIngress(
@@ -0,0 +1,142 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/component-base/featuregate"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/component"
+	"github.com/gardener/gardener/pkg/features"
+)
+
+// LegacyComponentNameLogging is the name of the legacy shoot logging stack (Vali in the shoot control plane and
+// Valitail on the shoot nodes).
+const LegacyComponentNameLogging = "logging"
+
+// legacyComponent is a deprecated component of shoot clusters which is removed once the respective feature gate is
+// enabled or the shoot opted in to the removal.
+type legacyComponent struct {
+	name        string
+	featureGate featuregate.Feature
+	deployer    component.DeployWaiter
+}
+
+func (b *Botanist) legacyComponents() []legacyComponent {
+	return []legacyComponent{
+		{
+			name:        LegacyComponentNameLogging,
+			featureGate: features.RemoveLegacyShootLogging,
+			deployer:    b.Shoot.Components.ControlPlane.Vali,
+		},
+	}
+}
+
+// isLegacyComponentRemoved returns true if the removal of the legacy component with the given name is enabled, i.e.,
+// the component must not be deployed anymore.
+func (b *Botanist) isLegacyComponentRemoved(name string) bool {
+	for _, c := range b.legacyComponents() {
+		if c.name == name {
+			return b.isLegacyComponentRemovalEnabled(c)
+		}
+	}
+	return false
+}
+
+// isLegacyComponentRemovalEnabled returns true if the removal of the given legacy component is enabled via its feature
+// gate or if the shoot opted in to the removal via the `alpha.shoot.gardener.cloud/remove-legacy-components`
+// annotation.
+func (b *Botanist) isLegacyComponentRemovalEnabled(c legacyComponent) bool {
+	if features.DefaultFeatureGate.Enabled(c.featureGate) {
+		return true
+	}
+
+	for _, name := range strings.Split(b.Shoot.GetInfo().Annotations[v1beta1constants.ShootAlphaRemoveLegacyComponents], ",") {
+		if strings.TrimSpace(name) == c.name {
+			return true
+		}
+	}
+	return false
+}
+
+// RemoveLegacyComponents removes all legacy components whose removal is enabled via feature gates or the shoot's
+// opt-in annotation and waits until their objects have been garbage collected. The progress of the removals is tracked in the shoot status. Entries of
+// legacy components whose removal has been disabled again are dropped from the status since the components are deployed
+// again.
+func (b *Botanist) RemoveLegacyComponents(ctx context.Context) error {
+	var (
+		toRemove []legacyComponent
+		names    = sets.New[string]()
+	)
+
+	for _, c := range b.legacyComponents() {
+		if b.isLegacyComponentRemovalEnabled(c) {
+			toRemove = append(toRemove, c)
+			names.Insert(c.name)
+		}
+	}
+
+	if err := b.updateLegacyComponentRemovals(ctx, names, nil); err != nil {
+		return err
+	}
+
+	for _, c := range toRemove {
+		if err := c.deployer.Destroy(ctx); err != nil {
+			return fmt.Errorf("failed removing legacy component %q: %w", c.name, err)
+		}
+		if err := c.deployer.WaitCleanup(ctx); err != nil {
+			return fmt.Errorf("failed waiting for removal of legacy component %q: %w", c.name, err)
+		}
+	}
+
+	return b.updateLegacyComponentRemovals(ctx, names, names)
+}
+
+// updateLegacyComponentRemovals updates the legacy component removals in the shoot status such that they contain
+// exactly the given names. New removals are marked as processing, removals of the given succeeded names are marked as
+// succeeded. The state of all other existing removals is kept.
+func (b *Botanist) updateLegacyComponentRemovals(ctx context.Context, names, succeeded sets.Set[string]) error {
+	desired := make([]gardencorev1beta1.LegacyComponentRemoval, 0, names.Len())
+
+	for _, name := range sets.List(names) {
+		state := gardencorev1beta1.LastOperationStateProcessing
+		if succeeded.Has(name) {
+			state = gardencorev1beta1.LastOperationStateSucceeded
+		}
+
+		removal := gardencorev1beta1.LegacyComponentRemoval{Name: name, State: state, LastTransitionTime: metav1.Now()}
+		if i := slices.IndexFunc(b.Shoot.GetInfo().Status.LegacyComponentRemovals, func(r gardencorev1beta1.LegacyComponentRemoval) bool {
+			return r.Name == name
+		}); i >= 0 {
+			if existing := b.Shoot.GetInfo().Status.LegacyComponentRemovals[i]; existing.State == state || !succeeded.Has(name) {
+				removal = existing
+			}
+		}
+
+		desired = append(desired, removal)
+	}
+
+	if len(desired) == 0 {
+		desired = nil
+	}
+
+	if apiequality.Semantic.DeepEqual(b.Shoot.GetInfo().Status.LegacyComponentRemovals, desired) {
+		return nil
+	}
+
+	return b.Shoot.UpdateInfoStatus(ctx, b.GardenClient, false, func(shoot *gardencorev1beta1.Shoot) error {
+		shoot.Status.LegacyComponentRemovals = desired
+		return nil
+	})
+}
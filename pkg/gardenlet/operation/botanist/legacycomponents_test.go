@@ -0,0 +1,184 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	mockcomponent "github.com/gardener/gardener/pkg/component/mock"
+	"github.com/gardener/gardener/pkg/features"
+	"github.com/gardener/gardener/pkg/gardenlet/operation"
+	. "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
+	shootpkg "github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
+	"github.com/gardener/gardener/pkg/utils/test"
+)
+
+var _ = Describe("LegacyComponents", func() {
+	var (
+		ctx     = context.TODO()
+		fakeErr = fmt.Errorf("fake error")
+
+		ctrl         *gomock.Controller
+		valiDeployer *mockcomponent.MockDeployWaiter
+		gardenClient client.Client
+		shoot        *gardencorev1beta1.Shoot
+		botanist     *Botanist
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		valiDeployer = mockcomponent.NewMockDeployWaiter(ctrl)
+
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "garden-foo"},
+		}
+	})
+
+	JustBeforeEach(func() {
+		gardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithObjects(shoot).WithStatusSubresource(&gardencorev1beta1.Shoot{}).Build()
+
+		botanist = &Botanist{
+			Operation: &operation.Operation{
+				GardenClient: gardenClient,
+				Shoot: &shootpkg.Shoot{
+					Components: &shootpkg.Components{
+						ControlPlane: &shootpkg.ControlPlane{
+							Vali: valiDeployer,
+						},
+					},
+				},
+			},
+		}
+		botanist.Shoot.SetInfo(shoot)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#RemoveLegacyComponents", func() {
+		Context("removal is disabled", func() {
+			BeforeEach(func() {
+				DeferCleanup(test.WithFeatureGate(features.DefaultFeatureGate, features.RemoveLegacyShootLogging, false))
+			})
+
+			It("should do nothing", func() {
+				Expect(botanist.RemoveLegacyComponents(ctx)).To(Succeed())
+
+				Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+				Expect(shoot.Status.LegacyComponentRemovals).To(BeEmpty())
+			})
+
+			Context("shoot opted in to the removal", func() {
+				BeforeEach(func() {
+					metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, "alpha.shoot.gardener.cloud/remove-legacy-components", "foo, logging")
+				})
+
+				It("should remove the component and mark the removal as succeeded", func() {
+					gomock.InOrder(
+						valiDeployer.EXPECT().Destroy(ctx),
+						valiDeployer.EXPECT().WaitCleanup(ctx),
+					)
+
+					Expect(botanist.RemoveLegacyComponents(ctx)).To(Succeed())
+
+					Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+					Expect(shoot.Status.LegacyComponentRemovals).To(ConsistOf(And(
+						HaveField("Name", LegacyComponentNameLogging),
+						HaveField("State", gardencorev1beta1.LastOperationStateSucceeded),
+					)))
+				})
+			})
+
+			Context("removal was enabled before", func() {
+				BeforeEach(func() {
+					shoot.Status.LegacyComponentRemovals = []gardencorev1beta1.LegacyComponentRemoval{
+						{Name: LegacyComponentNameLogging, State: gardencorev1beta1.LastOperationStateSucceeded},
+					}
+				})
+
+				It("should drop the removal from the status", func() {
+					Expect(botanist.RemoveLegacyComponents(ctx)).To(Succeed())
+
+					Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+					Expect(shoot.Status.LegacyComponentRemovals).To(BeEmpty())
+				})
+			})
+		})
+
+		Context("removal is enabled", func() {
+			BeforeEach(func() {
+				DeferCleanup(test.WithFeatureGate(features.DefaultFeatureGate, features.RemoveLegacyShootLogging, true))
+			})
+
+			It("should remove the component and mark the removal as succeeded", func() {
+				gomock.InOrder(
+					valiDeployer.EXPECT().Destroy(ctx),
+					valiDeployer.EXPECT().WaitCleanup(ctx),
+				)
+
+				Expect(botanist.RemoveLegacyComponents(ctx)).To(Succeed())
+
+				Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+				Expect(shoot.Status.LegacyComponentRemovals).To(ConsistOf(And(
+					HaveField("Name", LegacyComponentNameLogging),
+					HaveField("State", gardencorev1beta1.LastOperationStateSucceeded),
+				)))
+			})
+
+			It("should mark the removal as processing if the cleanup does not succeed", func() {
+				gomock.InOrder(
+					valiDeployer.EXPECT().Destroy(ctx),
+					valiDeployer.EXPECT().WaitCleanup(ctx).Return(fakeErr),
+				)
+
+				Expect(botanist.RemoveLegacyComponents(ctx)).To(MatchError(ContainSubstring("failed waiting for removal of legacy component \"logging\"")))
+
+				Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+				Expect(shoot.Status.LegacyComponentRemovals).To(ConsistOf(And(
+					HaveField("Name", LegacyComponentNameLogging),
+					HaveField("State", gardencorev1beta1.LastOperationStateProcessing),
+				)))
+			})
+
+			Context("removal has already succeeded", func() {
+				var lastTransitionTime = metav1.NewTime(time.Now().Add(-24 * time.Hour).Truncate(time.Second))
+
+				BeforeEach(func() {
+					shoot.Status.LegacyComponentRemovals = []gardencorev1beta1.LegacyComponentRemoval{
+						{Name: LegacyComponentNameLogging, State: gardencorev1beta1.LastOperationStateSucceeded, LastTransitionTime: lastTransitionTime},
+					}
+				})
+
+				It("should ensure the component is still removed without changing the status", func() {
+					gomock.InOrder(
+						valiDeployer.EXPECT().Destroy(ctx),
+						valiDeployer.EXPECT().WaitCleanup(ctx),
+					)
+
+					Expect(botanist.RemoveLegacyComponents(ctx)).To(Succeed())
+
+					Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+					Expect(shoot.Status.LegacyComponentRemovals).To(ConsistOf(And(
+						HaveField("Name", LegacyComponentNameLogging),
+						HaveField("State", gardencorev1beta1.LastOperationStateSucceeded),
+						HaveField("LastTransitionTime.Time", BeTemporally("==", lastTransitionTime.Time)),
+					)))
+				})
+			})
+		})
+	})
+})
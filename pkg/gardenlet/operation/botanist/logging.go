@@ -7,6 +7,8 @@ package botanist
 import (
 	"context"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
 	"github.com/gardener/gardener/imagevector"
 	gardencore "github.com/gardener/gardener/pkg/apis/core"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
@@ -33,6 +35,11 @@ func (b *Botanist) DeployLogging(ctx context.Context) error {
 		}
 	}
 
+	// the removal of the legacy logging stack is handled by RemoveLegacyComponents
+	if b.isLegacyComponentRemoved(LegacyComponentNameLogging) {
+		return nil
+	}
+
 	// check if vali is enabled in gardenlet config, default is true
 	if !gardenlethelper.IsValiEnabled(b.Config) {
 		return b.Shoot.Components.ControlPlane.Vali.Destroy(ctx)
@@ -52,6 +59,7 @@ func (b *Botanist) DestroySeedLogging(ctx context.Context) error {
 
 func (b *Botanist) isShootNodeLoggingEnabled() bool {
 	if b.Shoot != nil && !b.Shoot.IsWorkerless && b.Shoot.IsShootControlPlaneLoggingEnabled(b.Config) &&
+		!b.isLegacyComponentRemoved(LegacyComponentNameLogging) &&
 		gardenlethelper.IsValiEnabled(b.Config) && b.Config != nil &&
 		b.Config.Logging != nil && b.Config.Logging.ShootNodeLogging != nil {
 		for _, purpose := range b.Config.Logging.ShootNodeLogging.ShootPurposes {
@@ -79,14 +87,16 @@ func (b *Botanist) DefaultEventLogger() (component.Deployer, error) {
 		b.Shoot.SeedNamespace,
 		b.SecretsManager,
 		eventlogger.Values{
-			Image:    imageEventLogger.String(),
-			Replicas: b.Shoot.GetReplicas(1),
+			Image:                 imageEventLogger.String(),
+			Replicas:              b.Shoot.GetReplicas(1),
+			PriorityClassName:     v1beta1constants.PriorityClassNameShootControlPlane100,
+			TargetEventNamespaces: []string{metav1.NamespaceSystem, metav1.NamespaceDefault},
 		},
 	)
 }
 
 // DefaultVali returns a deployer for Vali.
-func (b *Botanist) DefaultVali() (component.Deployer, error) {
+func (b *Botanist) DefaultVali() (component.DeployWaiter, error) {
 	return shared.NewVali(
 		b.SeedClientSet.Client(),
 		b.Shoot.SeedNamespace,
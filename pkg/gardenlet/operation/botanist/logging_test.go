@@ -22,6 +22,7 @@ import (
 	"github.com/gardener/gardener/pkg/client/kubernetes/fake"
 	"github.com/gardener/gardener/pkg/client/kubernetes/mock"
 	mockcomponent "github.com/gardener/gardener/pkg/component/mock"
+	"github.com/gardener/gardener/pkg/features"
 	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
 	"github.com/gardener/gardener/pkg/gardenlet/operation"
 	. "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
@@ -29,6 +30,7 @@ import (
 	shootpkg "github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
 	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
 	fakesecretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager/fake"
+	"github.com/gardener/gardener/pkg/utils/test"
 	mockclient "github.com/gardener/gardener/third_party/mock/controller-runtime/client"
 )
 
@@ -40,7 +42,7 @@ var _ = Describe("Logging", func() {
 		k8sSeedClient       kubernetes.Interface
 		botanist            *Botanist
 		eventLoggerDeployer *mockcomponent.MockDeployer
-		valiDeployer        *mockcomponent.MockDeployer
+		valiDeployer        *mockcomponent.MockDeployWaiter
 		fakeSecretManager   secretsmanager.Interface
 		chartApplier        *mock.MockChartApplier
 		ctx                 = context.TODO()
@@ -65,7 +67,7 @@ var _ = Describe("Logging", func() {
 			Build()
 
 		eventLoggerDeployer = mockcomponent.NewMockDeployer(ctrl)
-		valiDeployer = mockcomponent.NewMockDeployer(ctrl)
+		valiDeployer = mockcomponent.NewMockDeployWaiter(ctrl)
 		fakeSecretManager = fakesecretsmanager.New(c, seedNamespace)
 
 		botanist = &Botanist{
@@ -212,6 +214,14 @@ var _ = Describe("Logging", func() {
 			Expect(botanist.DeployLogging(ctx)).To(Succeed())
 		})
 
+		It("should neither deploy nor destroy Vali when the removal of the legacy logging stack is enabled", func() {
+			DeferCleanup(test.WithFeatureGate(features.DefaultFeatureGate, features.RemoveLegacyShootLogging, true))
+
+			eventLoggerDeployer.EXPECT().Deploy(ctx)
+
+			Expect(botanist.DeployLogging(ctx)).To(Succeed())
+		})
+
 		Context("Tests expecting a failure", func() {
 			It("should fail to delete the logging stack when ShootEventLoggerDeployer Destroy return error", func() {
 				*botanist.Config.Logging.Enabled = false
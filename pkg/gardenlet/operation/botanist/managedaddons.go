@@ -0,0 +1,87 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/version"
+
+	gardencorev1 "github.com/gardener/gardener/pkg/apis/core/v1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/chartrenderer"
+	"github.com/gardener/gardener/pkg/component/shoot/managedaddons"
+)
+
+// DefaultManagedAddons returns a deployer for the managed addons.
+func (b *Botanist) DefaultManagedAddons() managedaddons.Interface {
+	var serverVersion = &version.Info{}
+	if kubernetesVersion := b.Shoot.KubernetesVersion; kubernetesVersion != nil {
+		serverVersion = &version.Info{
+			GitVersion: "v" + kubernetesVersion.String(),
+			Major:      strconv.FormatUint(kubernetesVersion.Major(), 10),
+			Minor:      strconv.FormatUint(kubernetesVersion.Minor(), 10),
+		}
+	}
+
+	return managedaddons.New(
+		b.SeedClientSet.Client(),
+		b.Shoot.SeedNamespace,
+		b.HelmRegistry,
+		chartrenderer.NewWithServerVersion(serverVersion),
+	)
+}
+
+// DeployManagedAddons deploys the managed addons configured in the Shoot specification and removes the ones which are
+// no longer configured.
+func (b *Botanist) DeployManagedAddons(ctx context.Context) error {
+	addons, err := b.computeManagedAddons()
+	if err != nil {
+		return err
+	}
+
+	b.Shoot.Components.Addons.Managed.SetAddons(addons)
+	return b.Shoot.Components.Addons.Managed.Deploy(ctx)
+}
+
+func (b *Botanist) computeManagedAddons() ([]managedaddons.Addon, error) {
+	addons := b.Shoot.GetInfo().Spec.Addons
+	if addons == nil || len(addons.Managed) == 0 {
+		return nil, nil
+	}
+
+	result := make([]managedaddons.Addon, 0, len(addons.Managed))
+	for _, addon := range addons.Managed {
+		definition := v1beta1helper.FindAddonDefinition(b.Shoot.CloudProfile.Spec.Addons, addon.Name)
+		if definition == nil {
+			return nil, fmt.Errorf("managed addon %q is not declared in the CloudProfile", addon.Name)
+		}
+
+		addonVersion := v1beta1helper.FindAddonVersion(definition.Versions, addon.Version)
+		if addonVersion == nil {
+			return nil, fmt.Errorf("version %q of managed addon %q is not declared in the CloudProfile", addon.Version, addon.Name)
+		}
+
+		managedAddon := managedaddons.Addon{
+			Name:    addon.Name,
+			Version: addon.Version,
+			Chart: &gardencorev1.OCIRepository{
+				Ref:        addonVersion.Chart.Ref,
+				Repository: addonVersion.Chart.Repository,
+				Tag:        addonVersion.Chart.Tag,
+				Digest:     addonVersion.Chart.Digest,
+			},
+		}
+		if addon.Values != nil {
+			managedAddon.Values = addon.Values.Raw
+		}
+
+		result = append(result, managedAddon)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,108 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	gardencorev1 "github.com/gardener/gardener/pkg/apis/core/v1"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/component/shoot/managedaddons"
+	mockmanagedaddons "github.com/gardener/gardener/pkg/component/shoot/managedaddons/mock"
+	"github.com/gardener/gardener/pkg/gardenlet/operation"
+	. "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
+	shootpkg "github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
+)
+
+var _ = Describe("ManagedAddons", func() {
+	var (
+		ctx     = context.TODO()
+		fakeErr = errors.New("fake err")
+
+		ctrl          *gomock.Controller
+		managedAddons *mockmanagedaddons.MockInterface
+		botanist      *Botanist
+		shoot         *gardencorev1beta1.Shoot
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		managedAddons = mockmanagedaddons.NewMockInterface(ctrl)
+
+		botanist = &Botanist{Operation: &operation.Operation{
+			Shoot: &shootpkg.Shoot{
+				CloudProfile: &gardencorev1beta1.CloudProfile{
+					Spec: gardencorev1beta1.CloudProfileSpec{
+						Addons: []gardencorev1beta1.AddonDefinition{{
+							Name: "cert-manager",
+							Versions: []gardencorev1beta1.AddonVersion{{
+								ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "1.16.1"},
+								Chart:            gardencorev1beta1.OCIRepository{Repository: ptr.To("example.com/charts/cert-manager"), Tag: ptr.To("v1.16.1")},
+							}},
+						}},
+					},
+				},
+				Components: &shootpkg.Components{
+					Addons: &shootpkg.Addons{
+						Managed: managedAddons,
+					},
+				},
+			},
+		}}
+		shoot = &gardencorev1beta1.Shoot{
+			Spec: gardencorev1beta1.ShootSpec{
+				Addons: &gardencorev1beta1.Addons{},
+			},
+		}
+	})
+
+	Describe("#DeployManagedAddons", func() {
+		It("should deploy the configured addons", func() {
+			shoot.Spec.Addons.Managed = []gardencorev1beta1.ManagedAddon{{
+				Name:    "cert-manager",
+				Version: "1.16.1",
+				Values:  &runtime.RawExtension{Raw: []byte(`{"replicas":2}`)},
+			}}
+			botanist.Shoot.SetInfo(shoot)
+
+			gomock.InOrder(
+				managedAddons.EXPECT().SetAddons([]managedaddons.Addon{{
+					Name:    "cert-manager",
+					Version: "1.16.1",
+					Chart:   &gardencorev1.OCIRepository{Repository: ptr.To("example.com/charts/cert-manager"), Tag: ptr.To("v1.16.1")},
+					Values:  []byte(`{"replicas":2}`),
+				}}),
+				managedAddons.EXPECT().Deploy(ctx),
+			)
+
+			Expect(botanist.DeployManagedAddons(ctx)).To(Succeed())
+		})
+
+		It("should remove all addons if none are configured", func() {
+			botanist.Shoot.SetInfo(shoot)
+
+			gomock.InOrder(
+				managedAddons.EXPECT().SetAddons(nil),
+				managedAddons.EXPECT().Deploy(ctx).Return(fakeErr),
+			)
+
+			Expect(botanist.DeployManagedAddons(ctx)).To(MatchError(fakeErr))
+		})
+
+		It("should fail if the addon version is not declared in the CloudProfile", func() {
+			shoot.Spec.Addons.Managed = []gardencorev1beta1.ManagedAddon{{Name: "cert-manager", Version: "1.0.0"}}
+			botanist.Shoot.SetInfo(shoot)
+
+			Expect(botanist.DeployManagedAddons(ctx)).To(MatchError(`version "1.0.0" of managed addon "cert-manager" is not declared in the CloudProfile`))
+		})
+	})
+})
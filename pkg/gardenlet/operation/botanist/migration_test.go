@@ -24,6 +24,7 @@ import (
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	kubernetesfake "github.com/gardener/gardener/pkg/client/kubernetes/fake"
 	mocketcd "github.com/gardener/gardener/pkg/component/etcd/etcd/mock"
+	mockauditsink "github.com/gardener/gardener/pkg/component/extensions/auditsink/mock"
 	mockcontainerruntime "github.com/gardener/gardener/pkg/component/extensions/containerruntime/mock"
 	mockcontrolplane "github.com/gardener/gardener/pkg/component/extensions/controlplane/mock"
 	mockinfrastructure "github.com/gardener/gardener/pkg/component/extensions/infrastructure/mock"
@@ -42,6 +43,7 @@ var _ = Describe("migration", func() {
 	var (
 		ctrl *gomock.Controller
 
+		auditSink             *mockauditsink.MockInterface
 		containerRuntime      *mockcontainerruntime.MockInterface
 		controlPlane          *mockcontrolplane.MockInterface
 		controlPlaneExposure  *mockcontrolplane.MockInterface
@@ -61,6 +63,7 @@ var _ = Describe("migration", func() {
 	BeforeEach(func() {
 		ctrl = gomock.NewController(GinkgoT())
 
+		auditSink = mockauditsink.NewMockInterface(ctrl)
 		containerRuntime = mockcontainerruntime.NewMockInterface(ctrl)
 		controlPlane = mockcontrolplane.NewMockInterface(ctrl)
 		controlPlaneExposure = mockcontrolplane.NewMockInterface(ctrl)
@@ -77,6 +80,7 @@ var _ = Describe("migration", func() {
 			Shoot: &shootpkg.Shoot{
 				Components: &shootpkg.Components{
 					Extensions: &shootpkg.Extensions{
+						AuditSink:             auditSink,
 						ContainerRuntime:      containerRuntime,
 						ControlPlane:          controlPlane,
 						ControlPlaneExposure:  controlPlaneExposure,
@@ -96,6 +100,7 @@ var _ = Describe("migration", func() {
 
 	Describe("#MigrateAllExtensionResources", func() {
 		It("should call the Migrate() func of all extension components", func() {
+			auditSink.EXPECT().Migrate(ctx)
 			containerRuntime.EXPECT().Migrate(ctx)
 			controlPlaneExposure.EXPECT().Migrate(ctx)
 			network.EXPECT().Migrate(ctx)
@@ -106,6 +111,7 @@ var _ = Describe("migration", func() {
 		})
 
 		It("should return an error if not all the Migrate() func of all extension components succeed", func() {
+			auditSink.EXPECT().Migrate(ctx)
 			containerRuntime.EXPECT().Migrate(ctx)
 			controlPlaneExposure.EXPECT().Migrate(ctx)
 			network.EXPECT().Migrate(ctx).Return(fakeErr)
@@ -120,6 +126,7 @@ var _ = Describe("migration", func() {
 
 	Describe("#WaitUntilAllExtensionResourcesMigrated", func() {
 		It("should call the Migrate() func of all extension components", func() {
+			auditSink.EXPECT().WaitMigrate(ctx)
 			containerRuntime.EXPECT().WaitMigrate(ctx)
 			controlPlaneExposure.EXPECT().WaitMigrate(ctx)
 			network.EXPECT().WaitMigrate(ctx)
@@ -131,11 +138,13 @@ var _ = Describe("migration", func() {
 
 		It("should call the Migrate() func of all the required extension components for workerless Shoot", func() {
 			botanist.Shoot.IsWorkerless = true
+			auditSink.EXPECT().WaitMigrate(ctx)
 
 			Expect(botanist.WaitUntilExtensionResourcesMigrated(ctx)).To(Succeed())
 		})
 
 		It("should return an error if not all the WaitMigrate() func of all extension components succeed", func() {
+			auditSink.EXPECT().WaitMigrate(ctx)
 			containerRuntime.EXPECT().WaitMigrate(ctx)
 			controlPlaneExposure.EXPECT().WaitMigrate(ctx)
 			network.EXPECT().WaitMigrate(ctx).Return(fakeErr)
@@ -150,6 +159,7 @@ var _ = Describe("migration", func() {
 
 	Describe("#DestroyAllExtensionResources", func() {
 		It("should call the Destroy() func of all extension components", func() {
+			auditSink.EXPECT().Destroy(ctx)
 			containerRuntime.EXPECT().Destroy(ctx)
 			controlPlaneExposure.EXPECT().Destroy(ctx)
 			network.EXPECT().Destroy(ctx)
@@ -161,11 +171,13 @@ var _ = Describe("migration", func() {
 
 		It("should call the Destroy() func of all required extension components (workerless shoot)", func() {
 			botanist.Shoot.IsWorkerless = true
+			auditSink.EXPECT().Destroy(ctx)
 
 			Expect(botanist.DestroyExtensionResourcesInParallel(ctx)).To(Succeed())
 		})
 
 		It("should return an error if not all the Destroy() func of all extension components succeed", func() {
+			auditSink.EXPECT().Destroy(ctx)
 			containerRuntime.EXPECT().Destroy(ctx).Return(fakeErr)
 			controlPlaneExposure.EXPECT().Destroy(ctx).Return(fakeErr)
 			network.EXPECT().Destroy(ctx)
@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+)
+
+// SeedNamespaceConstraintsName is the name of the ResourceQuota and LimitRange objects in the shoot namespace in the
+// seed cluster.
+const SeedNamespaceConstraintsName = "shoot-namespace-constraints"
+
+// DeploySeedNamespaceConstraints deploys the ResourceQuota and LimitRange configured in the gardenlet configuration
+// into the shoot namespace in the seed cluster. The hard limits of the ResourceQuota are scaled according to the size
+// class of the shoot. Objects which are not configured (anymore) are deleted.
+func (b *Botanist) DeploySeedNamespaceConstraints(ctx context.Context) error {
+	var (
+		resourceQuota = &corev1.ResourceQuota{ObjectMeta: metav1.ObjectMeta{Name: SeedNamespaceConstraintsName, Namespace: b.Shoot.SeedNamespace}}
+		limitRange    = &corev1.LimitRange{ObjectMeta: metav1.ObjectMeta{Name: SeedNamespaceConstraintsName, Namespace: b.Shoot.SeedNamespace}}
+	)
+
+	if b.Config == nil || b.Config.ShootNamespaceConstraints == nil {
+		return kubernetesutils.DeleteObjects(ctx, b.SeedClientSet.Client(), resourceQuota, limitRange)
+	}
+
+	constraints := b.Config.ShootNamespaceConstraints
+
+	if constraints.ResourceQuota == nil {
+		if err := kubernetesutils.DeleteObject(ctx, b.SeedClientSet.Client(), resourceQuota); err != nil {
+			return err
+		}
+	} else {
+		factor, ok := constraints.ResourceQuotaScaleFactors[string(v1beta1helper.GetShootSizeClass(b.Shoot.GetInfo()))]
+		if !ok {
+			factor = 1
+		}
+
+		if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, b.SeedClientSet.Client(), resourceQuota, func() error {
+			resourceQuota.Spec = *scaleResourceQuotaSpec(constraints.ResourceQuota, factor)
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+
+	if constraints.LimitRange == nil {
+		return kubernetesutils.DeleteObject(ctx, b.SeedClientSet.Client(), limitRange)
+	}
+
+	_, err := controllerutils.GetAndCreateOrMergePatch(ctx, b.SeedClientSet.Client(), limitRange, func() error {
+		limitRange.Spec = *constraints.LimitRange.DeepCopy()
+		return nil
+	})
+	return err
+}
+
+func scaleResourceQuotaSpec(spec *corev1.ResourceQuotaSpec, factor int32) *corev1.ResourceQuotaSpec {
+	out := spec.DeepCopy()
+	if factor <= 1 {
+		return out
+	}
+
+	for resourceName, quantity := range out.Hard {
+		quantity.Mul(int64(factor))
+		out.Hard[resourceName] = quantity
+	}
+
+	return out
+}
@@ -0,0 +1,132 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist_test
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	kubernetesfake "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
+	"github.com/gardener/gardener/pkg/gardenlet/operation"
+	. "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
+	"github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("NamespaceConstraints", func() {
+	var (
+		ctx       = context.Background()
+		namespace = "shoot--foo--bar"
+
+		seedClient client.Client
+		botanist   *Botanist
+
+		resourceQuota *corev1.ResourceQuota
+		limitRange    *corev1.LimitRange
+	)
+
+	BeforeEach(func() {
+		seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+
+		botanist = &Botanist{Operation: &operation.Operation{
+			Logger:        logr.Discard(),
+			SeedClientSet: kubernetesfake.NewClientSetBuilder().WithClient(seedClient).Build(),
+			Config:        &config.GardenletConfiguration{},
+			Shoot:         &shoot.Shoot{SeedNamespace: namespace},
+		}}
+		botanist.Shoot.SetInfo(&gardencorev1beta1.Shoot{
+			Spec: gardencorev1beta1.ShootSpec{
+				Provider: gardencorev1beta1.Provider{
+					Workers: []gardencorev1beta1.Worker{{Name: "pool", Maximum: 30}},
+				},
+			},
+		})
+
+		resourceQuota = &corev1.ResourceQuota{ObjectMeta: metav1.ObjectMeta{Name: "shoot-namespace-constraints", Namespace: namespace}}
+		limitRange = &corev1.LimitRange{ObjectMeta: metav1.ObjectMeta{Name: "shoot-namespace-constraints", Namespace: namespace}}
+	})
+
+	Describe("#DeploySeedNamespaceConstraints", func() {
+		It("should delete existing objects if no constraints are configured", func() {
+			Expect(seedClient.Create(ctx, resourceQuota.DeepCopy())).To(Succeed())
+			Expect(seedClient.Create(ctx, limitRange.DeepCopy())).To(Succeed())
+
+			Expect(botanist.DeploySeedNamespaceConstraints(ctx)).To(Succeed())
+
+			Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(resourceQuota), resourceQuota)).To(BeNotFoundError())
+			Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(limitRange), limitRange)).To(BeNotFoundError())
+		})
+
+		Context("with constraints", func() {
+			var limitRangeSpec *corev1.LimitRangeSpec
+
+			BeforeEach(func() {
+				limitRangeSpec = &corev1.LimitRangeSpec{
+					Limits: []corev1.LimitRangeItem{{
+						Type:           corev1.LimitTypeContainer,
+						DefaultRequest: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("64Mi")},
+					}},
+				}
+
+				botanist.Config.ShootNamespaceConstraints = &config.ShootNamespaceConstraints{
+					ResourceQuota: &corev1.ResourceQuotaSpec{
+						Hard: corev1.ResourceList{
+							corev1.ResourceRequestsCPU:    resource.MustParse("10"),
+							corev1.ResourceRequestsMemory: resource.MustParse("20Gi"),
+						},
+					},
+					LimitRange: limitRangeSpec,
+				}
+			})
+
+			It("should deploy the unscaled resource quota and the limit range", func() {
+				Expect(botanist.DeploySeedNamespaceConstraints(ctx)).To(Succeed())
+
+				Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(resourceQuota), resourceQuota)).To(Succeed())
+				Expect(resourceQuota.Spec.Hard).To(Equal(corev1.ResourceList{
+					corev1.ResourceRequestsCPU:    resource.MustParse("10"),
+					corev1.ResourceRequestsMemory: resource.MustParse("20Gi"),
+				}))
+
+				Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(limitRange), limitRange)).To(Succeed())
+				Expect(limitRange.Spec).To(Equal(*limitRangeSpec))
+			})
+
+			It("should scale the resource quota according to the size class of the shoot", func() {
+				botanist.Config.ShootNamespaceConstraints.ResourceQuotaScaleFactors = map[string]int32{"S": 1, "M": 3}
+
+				Expect(botanist.DeploySeedNamespaceConstraints(ctx)).To(Succeed())
+
+				Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(resourceQuota), resourceQuota)).To(Succeed())
+				Expect(resourceQuota.Spec.Hard).To(And(
+					HaveKeyWithValue(corev1.ResourceRequestsCPU, BeComparableTo(resource.MustParse("30"))),
+					HaveKeyWithValue(corev1.ResourceRequestsMemory, BeComparableTo(resource.MustParse("60Gi"))),
+				))
+				Expect(botanist.Config.ShootNamespaceConstraints.ResourceQuota.Hard).To(HaveKeyWithValue(corev1.ResourceRequestsCPU, BeComparableTo(resource.MustParse("10"))))
+			})
+
+			It("should delete the limit range if it is no longer configured", func() {
+				Expect(botanist.DeploySeedNamespaceConstraints(ctx)).To(Succeed())
+
+				botanist.Config.ShootNamespaceConstraints.LimitRange = nil
+				Expect(botanist.DeploySeedNamespaceConstraints(ctx)).To(Succeed())
+
+				Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(resourceQuota), resourceQuota)).To(Succeed())
+				Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(limitRange), limitRange)).To(BeNotFoundError())
+			})
+		})
+	})
+})
@@ -7,17 +7,16 @@ package botanist
 import (
 	"context"
 
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/component/extensions/network"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 )
 
 // DefaultNetwork creates the default deployer for the Network custom resource.
 func (b *Botanist) DefaultNetwork() network.Interface {
-	var ipFamilies []extensionsv1alpha1.IPFamily
-	for _, ipFamily := range b.Shoot.GetInfo().Spec.Networking.IPFamilies {
-		ipFamilies = append(ipFamilies, extensionsv1alpha1.IPFamily(ipFamily))
-	}
-
 	return network.New(
 		b.Logger,
 		b.SeedClientSet.Client(),
@@ -25,7 +24,7 @@ func (b *Botanist) DefaultNetwork() network.Interface {
 			Namespace:      b.Shoot.SeedNamespace,
 			Name:           b.Shoot.GetInfo().Name,
 			Type:           *b.Shoot.GetInfo().Spec.Networking.Type,
-			IPFamilies:     ipFamilies,
+			IPFamilies:     b.shootNetworkIPFamilies(),
 			ProviderConfig: b.Shoot.GetInfo().Spec.Networking.ProviderConfig,
 		},
 		network.DefaultInterval,
@@ -35,8 +34,39 @@ func (b *Botanist) DefaultNetwork() network.Interface {
 }
 
 // DeployNetwork deploys the Network custom resource and triggers the restore operation in case
-// the Shoot is in the restore phase of the control plane migration
+// the Shoot is in the restore phase of the control plane migration.
+// While the Shoot is migrated from single-stack to dual-stack networking, the IP families of the Network resource are
+// kept, see DeployNetworkForDualStackMigration.
 func (b *Botanist) DeployNetwork(ctx context.Context) error {
+	ipFamilies, err := b.networkIPFamilies(ctx, false)
+	if err != nil {
+		return err
+	}
+
+	return b.deployNetwork(ctx, ipFamilies)
+}
+
+// DeployNetworkForDualStackMigration deploys the Network custom resource with the IP families of the Shoot if all nodes
+// have been assigned pod CIDRs of all IP families after the Shoot was migrated from single-stack to dual-stack
+// networking. Otherwise, the IP families of the Network resource are kept until the nodes have been rolled out.
+func (b *Botanist) DeployNetworkForDualStackMigration(ctx context.Context) error {
+	ipFamilies, err := b.networkIPFamilies(ctx, true)
+	if err != nil {
+		return err
+	}
+
+	return b.deployNetwork(ctx, ipFamilies)
+}
+
+// IsNetworkDualStackMigrationPending returns true if the Shoot has been migrated from single-stack to dual-stack
+// networking but the IP families of the existing Network resource have not been changed yet.
+func (b *Botanist) IsNetworkDualStackMigrationPending(ctx context.Context) (bool, error) {
+	pending, _, err := b.networkDualStackMigrationPending(ctx)
+	return pending, err
+}
+
+func (b *Botanist) deployNetwork(ctx context.Context, ipFamilies []extensionsv1alpha1.IPFamily) error {
+	b.Shoot.Components.Extensions.Network.SetIPFamilies(ipFamilies)
 	b.Shoot.Components.Extensions.Network.SetPodCIDRs(b.Shoot.Networks.Pods)
 	b.Shoot.Components.Extensions.Network.SetServiceCIDRs(b.Shoot.Networks.Services)
 
@@ -46,3 +76,65 @@ func (b *Botanist) DeployNetwork(ctx context.Context) error {
 
 	return b.Shoot.Components.Extensions.Network.Deploy(ctx)
 }
+
+func (b *Botanist) networkIPFamilies(ctx context.Context, checkNodes bool) ([]extensionsv1alpha1.IPFamily, error) {
+	pending, currentIPFamilies, err := b.networkDualStackMigrationPending(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !pending {
+		return b.shootNetworkIPFamilies(), nil
+	}
+
+	// The nodes can only be checked if the shoot client has been initialized, e.g., it is not while the shoot is
+	// hibernated. In this case, the migration is continued with the next reconciliation.
+	if !checkNodes || b.ShootClientSet == nil {
+		return currentIPFamilies, nil
+	}
+
+	nodeList := &corev1.NodeList{}
+	if err := b.ShootClientSet.Client().List(ctx, nodeList); err != nil {
+		return nil, err
+	}
+
+	if nodeNames := gardenerutils.NodesWithoutPodCIDRsOfIPFamilies(nodeList.Items, b.Shoot.GetInfo().Spec.Networking.IPFamilies); len(nodeNames) > 0 {
+		b.Logger.Info("Keeping IP families of network until all nodes have been migrated to dual-stack networking", "ipFamilies", currentIPFamilies, "nodes", nodeNames)
+		return currentIPFamilies, nil
+	}
+
+	return b.shootNetworkIPFamilies(), nil
+}
+
+// networkDualStackMigrationPending returns true and the IP families of the existing Network resource if the Shoot has
+// been migrated from single-stack to dual-stack networking but the Network resource has not been changed yet.
+func (b *Botanist) networkDualStackMigrationPending(ctx context.Context) (bool, []extensionsv1alpha1.IPFamily, error) {
+	if len(b.shootNetworkIPFamilies()) < 2 {
+		return false, nil, nil
+	}
+
+	network := &extensionsv1alpha1.Network{}
+	if err := b.SeedClientSet.Client().Get(ctx, client.ObjectKey{Namespace: b.Shoot.SeedNamespace, Name: b.Shoot.GetInfo().Name}, network); err != nil {
+		return false, nil, client.IgnoreNotFound(err)
+	}
+
+	if len(network.Spec.IPFamilies) >= 2 {
+		return false, nil, nil
+	}
+	if len(network.Spec.IPFamilies) == 0 {
+		return true, []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4}, nil
+	}
+	return true, network.Spec.IPFamilies, nil
+}
+
+func (b *Botanist) shootNetworkIPFamilies() []extensionsv1alpha1.IPFamily {
+	networking := b.Shoot.GetInfo().Spec.Networking
+	if networking == nil {
+		return nil
+	}
+
+	var ipFamilies []extensionsv1alpha1.IPFamily
+	for _, ipFamily := range networking.IPFamilies {
+		ipFamilies = append(ipFamilies, extensionsv1alpha1.IPFamily(ipFamily))
+	}
+	return ipFamilies
+}
@@ -12,8 +12,15 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	kubernetesfake "github.com/gardener/gardener/pkg/client/kubernetes/fake"
 	mocknetwork "github.com/gardener/gardener/pkg/component/extensions/network/mock"
 	"github.com/gardener/gardener/pkg/gardenlet/operation"
 	. "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
@@ -57,6 +64,7 @@ var _ = Describe("Network", func() {
 
 	Describe("#DeployNetwork", func() {
 		BeforeEach(func() {
+			network.EXPECT().SetIPFamilies(gomock.Nil())
 			network.EXPECT().SetPodCIDRs(botanist.Shoot.Networks.Pods)
 			network.EXPECT().SetServiceCIDRs(botanist.Shoot.Networks.Services)
 		})
@@ -95,4 +103,104 @@ var _ = Describe("Network", func() {
 			})
 		})
 	})
+
+	Context("dual-stack migration", func() {
+		var (
+			seedClient  client.Client
+			shootClient client.Client
+
+			dualStack = []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4, extensionsv1alpha1.IPFamilyIPv6}
+			ipv4      = []extensionsv1alpha1.IPFamily{extensionsv1alpha1.IPFamilyIPv4}
+		)
+
+		BeforeEach(func() {
+			seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+			shootClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).Build()
+
+			botanist.SeedClientSet = kubernetesfake.NewClientSetBuilder().WithClient(seedClient).Build()
+			botanist.ShootClientSet = kubernetesfake.NewClientSetBuilder().WithClient(shootClient).Build()
+			botanist.Shoot.SeedNamespace = "shoot--foo--bar"
+			botanist.Shoot.SetInfo(&gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Name: "bar"},
+				Spec: gardencorev1beta1.ShootSpec{
+					Networking: &gardencorev1beta1.Networking{
+						IPFamilies: []gardencorev1beta1.IPFamily{gardencorev1beta1.IPFamilyIPv4, gardencorev1beta1.IPFamilyIPv6},
+					},
+				},
+			})
+
+			Expect(seedClient.Create(ctx, &extensionsv1alpha1.Network{
+				ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "shoot--foo--bar"},
+				Spec:       extensionsv1alpha1.NetworkSpec{IPFamilies: ipv4},
+			})).To(Succeed())
+			Expect(shootClient.Create(ctx, &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{Name: "node"},
+				Spec:       corev1.NodeSpec{PodCIDRs: []string{"10.0.0.0/24"}},
+			})).To(Succeed())
+		})
+
+		Describe("#IsNetworkDualStackMigrationPending", func() {
+			It("should return true if the network has not been migrated yet", func() {
+				Expect(botanist.IsNetworkDualStackMigrationPending(ctx)).To(BeTrue())
+			})
+
+			It("should return false if the network has already been migrated", func() {
+				network := &extensionsv1alpha1.Network{}
+				Expect(seedClient.Get(ctx, client.ObjectKey{Name: "bar", Namespace: "shoot--foo--bar"}, network)).To(Succeed())
+				network.Spec.IPFamilies = dualStack
+				Expect(seedClient.Update(ctx, network)).To(Succeed())
+
+				Expect(botanist.IsNetworkDualStackMigrationPending(ctx)).To(BeFalse())
+			})
+
+			It("should return false if the network does not exist", func() {
+				Expect(seedClient.Delete(ctx, &extensionsv1alpha1.Network{ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "shoot--foo--bar"}})).To(Succeed())
+
+				Expect(botanist.IsNetworkDualStackMigrationPending(ctx)).To(BeFalse())
+			})
+		})
+
+		Describe("#DeployNetwork", func() {
+			It("should keep the IP families of the network", func() {
+				network.EXPECT().SetIPFamilies(ipv4)
+				network.EXPECT().SetPodCIDRs(botanist.Shoot.Networks.Pods)
+				network.EXPECT().SetServiceCIDRs(botanist.Shoot.Networks.Services)
+				network.EXPECT().Deploy(ctx)
+
+				Expect(botanist.DeployNetwork(ctx)).To(Succeed())
+			})
+		})
+
+		Describe("#DeployNetworkForDualStackMigration", func() {
+			BeforeEach(func() {
+				network.EXPECT().SetPodCIDRs(botanist.Shoot.Networks.Pods)
+				network.EXPECT().SetServiceCIDRs(botanist.Shoot.Networks.Services)
+				network.EXPECT().Deploy(ctx)
+			})
+
+			It("should keep the IP families of the network if not all nodes have been migrated", func() {
+				network.EXPECT().SetIPFamilies(ipv4)
+
+				Expect(botanist.DeployNetworkForDualStackMigration(ctx)).To(Succeed())
+			})
+
+			It("should keep the IP families of the network if the shoot client is not initialized", func() {
+				botanist.ShootClientSet = nil
+				network.EXPECT().SetIPFamilies(ipv4)
+
+				Expect(botanist.DeployNetworkForDualStackMigration(ctx)).To(Succeed())
+			})
+
+			It("should switch to dual-stack if all nodes have been migrated", func() {
+				node := &corev1.Node{}
+				Expect(shootClient.Get(ctx, client.ObjectKey{Name: "node"}, node)).To(Succeed())
+				node.Spec.PodCIDRs = []string{"10.0.0.0/24", "2001:db8:1::/64"}
+				Expect(shootClient.Update(ctx, node)).To(Succeed())
+
+				network.EXPECT().SetIPFamilies(dualStack)
+
+				Expect(botanist.DeployNetworkForDualStackMigration(ctx)).To(Succeed())
+			})
+		})
+	})
 })
@@ -0,0 +1,221 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/v1alpha1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/component"
+	etcdcopybackupstask "github.com/gardener/gardener/pkg/component/etcd/copybackupstask"
+	"github.com/gardener/gardener/pkg/component/etcd/objectrecovery"
+	extensionsbackupentry "github.com/gardener/gardener/pkg/component/extensions/backupentry"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+var (
+	// NewObjectRecovery is a function exposed for testing.
+	NewObjectRecovery = objectrecovery.New
+	// NewObjectRecoveryBackupEntry is a function exposed for testing.
+	NewObjectRecoveryBackupEntry = extensionsbackupentry.New
+	// ObjectRecoveryNowFunc is a function returning the current time exposed for testing.
+	ObjectRecoveryNowFunc = time.Now
+)
+
+// ShootRequestsObjectRecovery returns true if the Shoot is annotated to recover objects from the etcd backup.
+func ShootRequestsObjectRecovery(shoot *gardencorev1beta1.Shoot) bool {
+	return len(splitAnnotationValue(shoot.Annotations[v1beta1constants.AnnotationShootObjectRecoveryNamespaces])) > 0
+}
+
+// RecoverObjectsFromEtcdBackup restores the backup of the main etcd into a temporary etcd, extracts the objects of the
+// requested namespaces and resources at the requested snapshot, and stores them as archive in the
+// `<shoot-name>.object-recovery` secret in the project namespace. The annotations requesting the recovery are removed
+// in any case, so that a failing recovery is not retried with every reconciliation.
+func (b *Botanist) RecoverObjectsFromEtcdBackup(ctx context.Context) error {
+	return errors.Join(
+		b.recoverObjectsFromEtcdBackup(ctx),
+		b.Shoot.UpdateInfo(ctx, b.GardenClient, false, func(shoot *gardencorev1beta1.Shoot) error {
+			delete(shoot.Annotations, v1beta1constants.AnnotationShootObjectRecoveryNamespaces)
+			delete(shoot.Annotations, v1beta1constants.AnnotationShootObjectRecoveryResources)
+			delete(shoot.Annotations, v1beta1constants.AnnotationShootObjectRecoverySnapshot)
+			return nil
+		}),
+	)
+}
+
+// recoverObjectsFromEtcdBackup copies the latest full snapshot and the subsequent delta snapshots of the main etcd to a
+// dedicated BackupEntry first, so that the temporary etcd never writes to the backups of the main etcd. The dedicated
+// BackupEntry is deleted afterwards, which makes the responsible extension delete the copied backups.
+func (b *Botanist) recoverObjectsFromEtcdBackup(ctx context.Context) (err error) {
+	var (
+		namespaces = splitAnnotationValue(b.Shoot.GetInfo().Annotations[v1beta1constants.AnnotationShootObjectRecoveryNamespaces])
+		resources  = splitAnnotationValue(b.Shoot.GetInfo().Annotations[v1beta1constants.AnnotationShootObjectRecoveryResources])
+		snapshot   = strings.TrimSpace(b.Shoot.GetInfo().Annotations[v1beta1constants.AnnotationShootObjectRecoverySnapshot])
+		revision   int64
+		now        = ObjectRecoveryNowFunc().UTC()
+	)
+
+	if snapshot != "" {
+		if revision, err = objectrecovery.SnapshotRevision(snapshot); err != nil {
+			return err
+		}
+	}
+
+	etcdMain := &druidv1alpha1.Etcd{}
+	if err := b.SeedClientSet.Client().Get(ctx, client.ObjectKey{Name: v1beta1constants.ETCDMain, Namespace: b.Shoot.SeedNamespace}, etcdMain); err != nil {
+		return fmt.Errorf("failed reading main etcd: %w", err)
+	}
+	if etcdMain.Spec.Backup.Store == nil {
+		return fmt.Errorf("no backup is configured for the main etcd, cannot recover objects")
+	}
+
+	mainBackupEntry := &extensionsv1alpha1.BackupEntry{}
+	if err := b.SeedClientSet.Client().Get(ctx, client.ObjectKey{Name: b.Shoot.BackupEntryName}, mainBackupEntry); err != nil {
+		return fmt.Errorf("failed reading BackupEntry of the main etcd: %w", err)
+	}
+
+	var (
+		sourceStore = *etcdMain.Spec.Backup.Store
+		targetStore = *sourceStore.DeepCopy()
+	)
+	targetStore.Prefix = path.Join(b.objectRecoveryBackupEntryName(), path.Base(sourceStore.Prefix))
+
+	recovery, copyBackupsTask, backupEntry := b.newObjectRecovery(
+		objectrecovery.Values{
+			BackupStore: targetStore,
+			Namespaces:  namespaces,
+			Resources:   resources,
+			Revision:    revision,
+		},
+		etcdcopybackupstask.Values{
+			SourceStore: sourceStore,
+			TargetStore: targetStore,
+			MaxBackups:  ptr.To[uint32](1),
+		},
+		extensionsbackupentry.Values{
+			Type:                       mainBackupEntry.Spec.Type,
+			ProviderConfig:             mainBackupEntry.Spec.ProviderConfig,
+			Region:                     mainBackupEntry.Spec.Region,
+			SecretRef:                  mainBackupEntry.Spec.SecretRef,
+			BucketName:                 mainBackupEntry.Spec.BucketName,
+			BackupBucketProviderStatus: mainBackupEntry.Spec.BackupBucketProviderStatus,
+		},
+	)
+
+	// Clean up leftovers of a previous attempt since neither the copy task nor the temporary etcd can be updated, and the
+	// copied backups must not be mixed with those of a previous attempt.
+	if err := component.OpDestroyAndWait(recovery, copyBackupsTask, backupEntry).Destroy(ctx); err != nil {
+		return err
+	}
+	defer func() {
+		err = errors.Join(err, component.OpDestroyAndWait(recovery, copyBackupsTask, backupEntry).Destroy(ctx))
+	}()
+
+	b.Logger.Info("Recovering objects from etcd backup", "namespaces", namespaces, "resources", resources, "snapshot", snapshot)
+
+	if err := component.OpWait(backupEntry, copyBackupsTask, recovery).Deploy(ctx); err != nil {
+		return err
+	}
+
+	archive, err := recovery.Extract(ctx)
+	if err != nil {
+		return err
+	}
+
+	annotations := map[string]string{
+		v1beta1constants.AnnotationShootObjectRecoveryNamespaces: strings.Join(namespaces, ","),
+		v1beta1constants.AnnotationShootObjectRecoveryResources:  strings.Join(resources, ","),
+		v1beta1constants.GardenerTimestamp:                       now.Format(time.RFC3339),
+	}
+	if snapshot != "" {
+		annotations[v1beta1constants.AnnotationShootObjectRecoverySnapshot] = snapshot
+	}
+
+	return b.syncShootCredentialToGarden(
+		ctx,
+		gardenerutils.ShootProjectSecretSuffixObjectRecovery,
+		nil,
+		annotations,
+		map[string][]byte{objectrecovery.DataKeyArchive: archive},
+	)
+}
+
+// DestroyObjectRecovery deletes the leftovers of an object recovery whose clean-up failed, i.e., the temporary etcd, the
+// copy task, and the BackupEntry containing the copied backups.
+func (b *Botanist) DestroyObjectRecovery(ctx context.Context) error {
+	recovery, copyBackupsTask, backupEntry := b.newObjectRecovery(objectrecovery.Values{}, etcdcopybackupstask.Values{}, extensionsbackupentry.Values{})
+	return component.OpDestroyAndWait(recovery, copyBackupsTask, backupEntry).Destroy(ctx)
+}
+
+// newObjectRecovery creates the temporary etcd, the copy task, and the BackupEntry for recovering objects with the given
+// values. The names and namespaces are set by this function.
+func (b *Botanist) newObjectRecovery(
+	recoveryValues objectrecovery.Values,
+	copyBackupsTaskValues etcdcopybackupstask.Values,
+	backupEntryValues extensionsbackupentry.Values,
+) (
+	objectrecovery.Interface,
+	component.DeployWaiter,
+	component.DeployWaiter,
+) {
+	copyBackupsTaskValues.Name = objectrecovery.Name
+	copyBackupsTaskValues.Namespace = b.Shoot.SeedNamespace
+	backupEntryValues.Name = b.objectRecoveryBackupEntryName()
+
+	return NewObjectRecovery(
+			b.Logger,
+			b.SeedClientSet.Client(),
+			b.Shoot.SeedNamespace,
+			b.SecretsManager,
+			recoveryValues,
+			objectrecovery.DefaultInterval,
+			objectrecovery.DefaultSevereThreshold,
+			objectrecovery.DefaultTimeout,
+		),
+		NewEtcdCopyBackupsTask(
+			b.Logger,
+			b.SeedClientSet.Client(),
+			&copyBackupsTaskValues,
+			etcdcopybackupstask.DefaultInterval,
+			etcdcopybackupstask.DefaultSevereThreshold,
+			etcdcopybackupstask.DefaultTimeout,
+		),
+		NewObjectRecoveryBackupEntry(
+			b.Logger,
+			b.SeedClientSet.Client(),
+			b.Clock,
+			&backupEntryValues,
+			extensionsbackupentry.DefaultInterval,
+			extensionsbackupentry.DefaultSevereThreshold,
+			extensionsbackupentry.DefaultTimeout,
+		)
+}
+
+// objectRecoveryBackupEntryName returns the name of the BackupEntry which contains the copied backups. Its deletion makes
+// the responsible extension delete all objects with the name as prefix in the backup bucket.
+func (b *Botanist) objectRecoveryBackupEntryName() string {
+	return fmt.Sprintf("%s-%s", objectrecovery.LabelRoleValue, b.Shoot.BackupEntryName)
+}
+
+func splitAnnotationValue(value string) []string {
+	var out []string
+	for _, v := range strings.Split(value, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			out = append(out, v)
+		}
+	}
+	return out
+}
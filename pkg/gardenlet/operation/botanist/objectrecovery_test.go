@@ -0,0 +1,293 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package botanist_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	druidv1alpha1 "github.com/gardener/etcd-druid/api/v1alpha1"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	kubernetesfake "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	etcdcopybackupstask "github.com/gardener/gardener/pkg/component/etcd/copybackupstask"
+	mocketcdcopybackupstask "github.com/gardener/gardener/pkg/component/etcd/copybackupstask/mock"
+	"github.com/gardener/gardener/pkg/component/etcd/objectrecovery"
+	mockobjectrecovery "github.com/gardener/gardener/pkg/component/etcd/objectrecovery/mock"
+	extensionsbackupentry "github.com/gardener/gardener/pkg/component/extensions/backupentry"
+	mockextensionsbackupentry "github.com/gardener/gardener/pkg/component/extensions/backupentry/mock"
+	"github.com/gardener/gardener/pkg/gardenlet/operation"
+	. "github.com/gardener/gardener/pkg/gardenlet/operation/botanist"
+	shootpkg "github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
+	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
+	"github.com/gardener/gardener/pkg/utils/test"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("ObjectRecovery", func() {
+	var (
+		ctx       = context.Background()
+		namespace = "shoot--foo--bar"
+		now       = time.Unix(1704164645, 0)
+		fakeErr   = errors.New("fake err")
+
+		ctrl            *gomock.Controller
+		seedClient      client.Client
+		gardenClient    client.Client
+		backupEntry     *mockextensionsbackupentry.MockInterface
+		copyBackupsTask *mocketcdcopybackupstask.MockInterface
+		recovery        *mockobjectrecovery.MockInterface
+
+		sourceStore            druidv1alpha1.StoreSpec
+		targetStore            druidv1alpha1.StoreSpec
+		backupEntryValues      *extensionsbackupentry.Values
+		copyBackupsTaskValues  *etcdcopybackupstask.Values
+		recoveryValues         objectrecovery.Values
+		expectedRecoveryValues objectrecovery.Values
+
+		shoot    *gardencorev1beta1.Shoot
+		botanist *Botanist
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		backupEntry = mockextensionsbackupentry.NewMockInterface(ctrl)
+		copyBackupsTask = mocketcdcopybackupstask.NewMockInterface(ctrl)
+		recovery = mockobjectrecovery.NewMockInterface(ctrl)
+
+		sourceStore = druidv1alpha1.StoreSpec{
+			Container: ptr.To("bucket"),
+			Prefix:    "shoot--foo--bar--uid/etcd-main",
+		}
+		targetStore = druidv1alpha1.StoreSpec{
+			Container: ptr.To("bucket"),
+			Prefix:    "object-recovery-shoot--foo--bar--uid/etcd-main",
+		}
+
+		seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		Expect(seedClient.Create(ctx, &druidv1alpha1.Etcd{
+			ObjectMeta: metav1.ObjectMeta{Name: "etcd-main", Namespace: namespace},
+			Spec:       druidv1alpha1.EtcdSpec{Backup: druidv1alpha1.BackupSpec{Store: sourceStore.DeepCopy()}},
+		})).To(Succeed())
+		Expect(seedClient.Create(ctx, &extensionsv1alpha1.BackupEntry{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot--foo--bar--uid"},
+			Spec: extensionsv1alpha1.BackupEntrySpec{
+				DefaultSpec: extensionsv1alpha1.DefaultSpec{Type: "provider"},
+				Region:      "region",
+				SecretRef:   corev1.SecretReference{Name: "entry-shoot--foo--bar--uid", Namespace: "garden"},
+				BucketName:  "bucket",
+			},
+		})).To(Succeed())
+
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "bar",
+				Namespace: "garden-foo",
+				UID:       "uid",
+				Annotations: map[string]string{
+					"shoot.gardener.cloud/object-recovery-namespaces": "default, kube-public",
+					"shoot.gardener.cloud/object-recovery-resources":  "configmaps",
+				},
+			},
+		}
+		gardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithObjects(shoot).Build()
+
+		botanist = &Botanist{
+			Operation: &operation.Operation{
+				Logger:        logr.Discard(),
+				Clock:         testclock.NewFakeClock(now),
+				GardenClient:  gardenClient,
+				SeedClientSet: kubernetesfake.NewClientSetBuilder().WithClient(seedClient).Build(),
+				Shoot:         &shootpkg.Shoot{SeedNamespace: namespace, BackupEntryName: "shoot--foo--bar--uid"},
+			},
+		}
+		botanist.Shoot.SetInfo(shoot)
+
+		expectedRecoveryValues = objectrecovery.Values{
+			BackupStore: targetStore,
+			Namespaces:  []string{"default", "kube-public"},
+			Resources:   []string{"configmaps"},
+		}
+
+		DeferCleanup(test.WithVars(
+			&ObjectRecoveryNowFunc, func() time.Time { return now },
+			&NewObjectRecoveryBackupEntry, func(_ logr.Logger, _ client.Client, _ clock.Clock, values *extensionsbackupentry.Values, _, _, _ time.Duration) extensionsbackupentry.Interface {
+				backupEntryValues = values
+				return backupEntry
+			},
+			&NewEtcdCopyBackupsTask, func(_ logr.Logger, _ client.Client, values *etcdcopybackupstask.Values, _, _, _ time.Duration) etcdcopybackupstask.Interface {
+				copyBackupsTaskValues = values
+				return copyBackupsTask
+			},
+			&NewObjectRecovery, func(_ logr.Logger, _ client.Client, ns string, _ secretsmanager.Interface, values objectrecovery.Values, _, _, _ time.Duration) objectrecovery.Interface {
+				Expect(ns).To(Equal(namespace))
+				recoveryValues = values
+				return recovery
+			},
+		))
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	expectCleanup := func() {
+		gomock.InOrder(
+			recovery.EXPECT().Destroy(ctx),
+			recovery.EXPECT().WaitCleanup(ctx),
+			copyBackupsTask.EXPECT().Destroy(ctx),
+			copyBackupsTask.EXPECT().WaitCleanup(ctx),
+			backupEntry.EXPECT().Destroy(ctx),
+			backupEntry.EXPECT().WaitCleanup(ctx),
+		)
+	}
+
+	expectAnnotationsRemoved := func() {
+		Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+		Expect(shoot.Annotations).NotTo(HaveKey("shoot.gardener.cloud/object-recovery-namespaces"))
+		Expect(shoot.Annotations).NotTo(HaveKey("shoot.gardener.cloud/object-recovery-resources"))
+		Expect(shoot.Annotations).NotTo(HaveKey("shoot.gardener.cloud/object-recovery-snapshot"))
+	}
+
+	Describe("#ShootRequestsObjectRecovery", func() {
+		It("should return true if namespaces are requested", func() {
+			Expect(ShootRequestsObjectRecovery(shoot)).To(BeTrue())
+		})
+
+		It("should return false if no namespaces are requested", func() {
+			shoot.Annotations["shoot.gardener.cloud/object-recovery-namespaces"] = " , "
+			Expect(ShootRequestsObjectRecovery(shoot)).To(BeFalse())
+		})
+	})
+
+	Describe("#RecoverObjectsFromEtcdBackup", func() {
+		expectRecovery := func(extractErr error) {
+			expectCleanup()
+			gomock.InOrder(
+				backupEntry.EXPECT().Deploy(ctx),
+				backupEntry.EXPECT().Wait(ctx),
+				copyBackupsTask.EXPECT().Deploy(ctx),
+				copyBackupsTask.EXPECT().Wait(ctx),
+				recovery.EXPECT().Deploy(ctx),
+				recovery.EXPECT().Wait(ctx),
+			)
+			if extractErr != nil {
+				recovery.EXPECT().Extract(ctx).Return(nil, extractErr)
+			} else {
+				recovery.EXPECT().Extract(ctx).Return([]byte("archive"), nil)
+			}
+			expectCleanup()
+		}
+
+		It("should recover the objects via a dedicated backup entry and store them in the project namespace", func() {
+			expectRecovery(nil)
+
+			Expect(botanist.RecoverObjectsFromEtcdBackup(ctx)).To(Succeed())
+
+			Expect(backupEntryValues).To(Equal(&extensionsbackupentry.Values{
+				Name:       "object-recovery-shoot--foo--bar--uid",
+				Type:       "provider",
+				Region:     "region",
+				SecretRef:  corev1.SecretReference{Name: "entry-shoot--foo--bar--uid", Namespace: "garden"},
+				BucketName: "bucket",
+			}))
+			Expect(copyBackupsTaskValues).To(Equal(&etcdcopybackupstask.Values{
+				Name:        "etcd-object-recovery",
+				Namespace:   namespace,
+				SourceStore: sourceStore,
+				TargetStore: targetStore,
+				MaxBackups:  ptr.To[uint32](1),
+			}))
+			Expect(recoveryValues).To(Equal(expectedRecoveryValues))
+
+			secret := &corev1.Secret{}
+			Expect(gardenClient.Get(ctx, client.ObjectKey{Name: "bar.object-recovery", Namespace: "garden-foo"}, secret)).To(Succeed())
+			Expect(secret.Data).To(Equal(map[string][]byte{"objects.tar.gz": []byte("archive")}))
+			Expect(secret.Annotations).To(Equal(map[string]string{
+				"shoot.gardener.cloud/object-recovery-namespaces": "default,kube-public",
+				"shoot.gardener.cloud/object-recovery-resources":  "configmaps",
+				"gardener.cloud/timestamp":                        now.UTC().Format(time.RFC3339),
+			}))
+			Expect(secret.OwnerReferences).To(ConsistOf(HaveField("Name", "bar")))
+
+			expectAnnotationsRemoved()
+		})
+
+		It("should recover the objects at the revision of the selected snapshot", func() {
+			shoot.Annotations["shoot.gardener.cloud/object-recovery-snapshot"] = "Incr-00001235-00001300-1704164945.gz"
+			Expect(gardenClient.Update(ctx, shoot)).To(Succeed())
+			botanist.Shoot.SetInfo(shoot)
+			expectedRecoveryValues.Revision = 1300
+			expectRecovery(nil)
+
+			Expect(botanist.RecoverObjectsFromEtcdBackup(ctx)).To(Succeed())
+			Expect(recoveryValues).To(Equal(expectedRecoveryValues))
+
+			secret := &corev1.Secret{}
+			Expect(gardenClient.Get(ctx, client.ObjectKey{Name: "bar.object-recovery", Namespace: "garden-foo"}, secret)).To(Succeed())
+			Expect(secret.Annotations).To(HaveKeyWithValue("shoot.gardener.cloud/object-recovery-snapshot", "Incr-00001235-00001300-1704164945.gz"))
+
+			expectAnnotationsRemoved()
+		})
+
+		It("should clean up and remove the annotations if the extraction fails", func() {
+			expectRecovery(fakeErr)
+
+			Expect(botanist.RecoverObjectsFromEtcdBackup(ctx)).To(MatchError(fakeErr))
+
+			Expect(gardenClient.Get(ctx, client.ObjectKey{Name: "bar.object-recovery", Namespace: "garden-foo"}, &corev1.Secret{})).To(BeNotFoundError())
+			expectAnnotationsRemoved()
+		})
+
+		It("should fail and remove the annotations if the selected snapshot is invalid", func() {
+			shoot.Annotations["shoot.gardener.cloud/object-recovery-snapshot"] = "latest"
+			Expect(gardenClient.Update(ctx, shoot)).To(Succeed())
+			botanist.Shoot.SetInfo(shoot)
+
+			Expect(botanist.RecoverObjectsFromEtcdBackup(ctx)).To(MatchError(ContainSubstring("invalid snapshot name")))
+			expectAnnotationsRemoved()
+		})
+
+		It("should fail if no backup is configured", func() {
+			etcdMain := &druidv1alpha1.Etcd{ObjectMeta: metav1.ObjectMeta{Name: "etcd-main", Namespace: namespace}}
+			Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(etcdMain), etcdMain)).To(Succeed())
+			etcdMain.Spec.Backup.Store = nil
+			Expect(seedClient.Update(ctx, etcdMain)).To(Succeed())
+
+			Expect(botanist.RecoverObjectsFromEtcdBackup(ctx)).To(MatchError(ContainSubstring("no backup is configured")))
+		})
+	})
+
+	Describe("#DestroyObjectRecovery", func() {
+		It("should destroy the temporary etcd, the copy task, and the backup entry", func() {
+			expectCleanup()
+
+			Expect(botanist.DestroyObjectRecovery(ctx)).To(Succeed())
+			Expect(backupEntryValues.Name).To(Equal("object-recovery-shoot--foo--bar--uid"))
+			Expect(copyBackupsTaskValues.Name).To(Equal("etcd-object-recovery"))
+			Expect(copyBackupsTaskValues.Namespace).To(Equal(namespace))
+		})
+
+		It("should fail if the clean-up fails", func() {
+			recovery.EXPECT().Destroy(ctx).Return(fakeErr)
+
+			Expect(botanist.DestroyObjectRecovery(ctx)).To(MatchError(fakeErr))
+		})
+	})
+})
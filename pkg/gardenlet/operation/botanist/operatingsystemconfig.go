@@ -76,6 +76,7 @@ func (b *Botanist) DefaultOperatingSystemConfig() (operatingsystemconfig.Interfa
 				ValiIngressHostName:    valiIngressHost,
 				NodeLocalDNSEnabled:    v1beta1helper.IsNodeLocalDNSEnabled(b.Shoot.GetInfo().Spec.SystemComponents),
 				NodeMonitorGracePeriod: *b.Shoot.GetInfo().Spec.Kubernetes.KubeControllerManager.NodeMonitorGracePeriod,
+				SystemComponents:       b.Shoot.GetInfo().Spec.SystemComponents,
 				PrimaryIPFamily:        b.Shoot.GetInfo().Spec.Networking.IPFamilies[0],
 			},
 		},
@@ -115,10 +116,11 @@ func (b *Botanist) DeployOperatingSystemConfig(ctx context.Context) error {
 		b.Shoot.Components.Extensions.OperatingSystemConfig.SetSSHPublicKeys(publicKeys)
 	}
 
-	var clusterDNSAddresses []string
+	var coreDNSAddresses []string
 	for _, ip := range b.Shoot.Networks.CoreDNS {
-		clusterDNSAddresses = append(clusterDNSAddresses, ip.String())
+		coreDNSAddresses = append(coreDNSAddresses, ip.String())
 	}
+	clusterDNSAddresses := coreDNSAddresses
 	if b.Shoot.NodeLocalDNSEnabled && b.Shoot.IPVSEnabled() {
 		// If IPVS is enabled then instruct the kubelet to create pods resolving DNS to the `nodelocaldns` network
 		// interface link-local ip address. For more information checkout the usage documentation under
@@ -132,6 +134,8 @@ func (b *Botanist) DeployOperatingSystemConfig(ctx context.Context) error {
 		}
 	}
 	b.Shoot.Components.Extensions.OperatingSystemConfig.SetClusterDNSAddresses(clusterDNSAddresses)
+	// Worker pools with disabled node-local-dns resolve DNS directly via CoreDNS.
+	b.Shoot.Components.Extensions.OperatingSystemConfig.SetCoreDNSAddresses(coreDNSAddresses)
 
 	if b.IsRestorePhase() {
 		return b.Shoot.Components.Extensions.OperatingSystemConfig.Restore(ctx, b.Shoot.GetShootState())
@@ -13,6 +13,7 @@ import (
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
@@ -36,6 +37,13 @@ func (b *Botanist) DefaultResourceManager() (resourcemanager.Interface, error) {
 		defaultUnreachableTolerationSeconds = nodeToleration.DefaultUnreachableTolerationSeconds
 	}
 
+	// The namespace-defaults controller is always enabled so that it cleans up the LimitRanges and ResourceQuotas once
+	// they are removed from the Shoot specification.
+	namespaceDefaults := &gardencorev1beta1.NamespaceDefaults{}
+	if v := b.Shoot.GetInfo().Spec.Kubernetes.NamespaceDefaults; v != nil {
+		namespaceDefaults = v
+	}
+
 	return shared.NewTargetGardenerResourceManager(
 		b.SeedClientSet.Client(),
 		b.Shoot.SeedNamespace,
@@ -50,13 +58,14 @@ func (b *Botanist) DefaultResourceManager() (resourcemanager.Interface, error) {
 		v1beta1constants.PriorityClassNameShootControlPlane400,
 		v1beta1helper.ShootSchedulingProfile(b.Shoot.GetInfo()),
 		v1beta1constants.SecretNameCACluster,
-		gardenerutils.ExtractSystemComponentsTolerations(b.Shoot.GetInfo().Spec.Provider.Workers),
+		gardenerutils.ExtractSystemComponentsTolerations(b.Shoot.GetInfo().Spec.Provider.Workers, b.Shoot.GetInfo().Spec.SystemComponents),
 		b.Shoot.TopologyAwareRoutingEnabled,
 		ptr.To(b.Shoot.ComputeOutOfClusterAPIServerAddress(true)),
 		b.Shoot.IsWorkerless,
 		[]string{metav1.NamespaceSystem, v1beta1constants.KubernetesDashboardNamespace, corev1.NamespaceNodeLease},
 		b.Shoot.OSCSyncJitterPeriod,
 		true,
+		namespaceDefaults,
 	)
 }
 
@@ -23,6 +23,7 @@ import (
 	securityv1alpha1 "github.com/gardener/gardener/pkg/apis/security/v1alpha1"
 	kubeapiserver "github.com/gardener/gardener/pkg/component/kubernetes/apiserver"
 	"github.com/gardener/gardener/pkg/controllerutils"
+	errorsutils "github.com/gardener/gardener/pkg/utils/errors"
 	"github.com/gardener/gardener/pkg/utils/flow"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	"github.com/gardener/gardener/pkg/utils/gardener/tokenrequest"
@@ -358,7 +359,7 @@ func (b *Botanist) syncShootCredentialToGarden(
 	})
 
 	if err != nil && quotaExceededRegex.MatchString(err.Error()) {
-		return v1beta1helper.NewErrorWithCodes(err, gardencorev1beta1.ErrorInfraQuotaExceeded)
+		return errorsutils.Wrap(err, "failed deploying secret %s to garden", client.ObjectKeyFromObject(gardenSecret)).WithCodes(gardencorev1beta1.ErrorInfraQuotaExceeded)
 	}
 	return err
 }
@@ -416,7 +417,7 @@ func (b *Botanist) syncShootConfigMapToGarden(
 	})
 
 	if err != nil && quotaExceededRegex.MatchString(err.Error()) {
-		return v1beta1helper.NewErrorWithCodes(err, gardencorev1beta1.ErrorInfraQuotaExceeded)
+		return errorsutils.Wrap(err, "failed deploying config map %s to garden", client.ObjectKeyFromObject(gardenConfigMap)).WithCodes(gardencorev1beta1.ErrorInfraQuotaExceeded)
 	}
 	return err
 }
@@ -17,7 +17,7 @@ import (
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
-	"github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	errorsutils "github.com/gardener/gardener/pkg/utils/errors"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 	"github.com/gardener/gardener/pkg/utils/retry"
 )
@@ -80,9 +80,7 @@ func (b *Botanist) WaitUntilNoPodRunning(ctx context.Context) error {
 				if pod.Namespace == metav1.NamespaceSystem || pod.Namespace == v1beta1constants.KubernetesDashboardNamespace {
 					return retry.MinorError(fmt.Errorf(message, client.ObjectKeyFromObject(&pod).String()))
 				}
-				return retry.MinorError(helper.NewErrorWithCodes(fmt.Errorf(message,
-					client.ObjectKeyFromObject(&pod).String()),
-					gardencorev1beta1.ErrorCleanupClusterResources))
+				return retry.MinorError(errorsutils.New(message, client.ObjectKeyFromObject(&pod).String()).WithCodes(gardencorev1beta1.ErrorCleanupClusterResources))
 			}
 		}
 
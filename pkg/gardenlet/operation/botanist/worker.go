@@ -40,6 +40,7 @@ func (b *Botanist) DefaultWorker() worker.Interface {
 			KubernetesVersion:   b.Shoot.KubernetesVersion,
 			MachineTypes:        b.Shoot.CloudProfile.Spec.MachineTypes,
 			NodeLocalDNSEnabled: v1beta1helper.IsNodeLocalDNSEnabled(b.Shoot.GetInfo().Spec.SystemComponents),
+			SystemComponents:    b.Shoot.GetInfo().Spec.SystemComponents,
 		},
 		worker.DefaultInterval,
 		worker.DefaultSevereThreshold,
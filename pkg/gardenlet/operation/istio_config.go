@@ -35,10 +35,16 @@ func (o *Operation) DefaultIstioNamespace() string {
 func (o *Operation) IstioLoadBalancerAnnotations() map[string]string {
 	zone := o.singleZoneIfPinned()
 	if exposureClassHandler := o.exposureClassHandler(); exposureClassHandler != nil {
+		// Annotations configured in the ExposureClass must be put last to override all other keys.
+		var exposureClassAnnotations map[string]string
+		if o.Shoot.ExposureClass.LoadBalancerService != nil {
+			exposureClassAnnotations = o.Shoot.ExposureClass.LoadBalancerService.Annotations
+		}
+
 		if zone != nil {
-			return utils.MergeStringMaps(exposureClassHandler.LoadBalancerService.Annotations, o.Seed.GetZonalLoadBalancerServiceAnnotations(*zone))
+			return utils.MergeStringMaps(exposureClassHandler.LoadBalancerService.Annotations, o.Seed.GetZonalLoadBalancerServiceAnnotations(*zone), exposureClassAnnotations)
 		}
-		return utils.MergeStringMaps(o.Seed.GetLoadBalancerServiceAnnotations(), exposureClassHandler.LoadBalancerService.Annotations)
+		return utils.MergeStringMaps(o.Seed.GetLoadBalancerServiceAnnotations(), exposureClassHandler.LoadBalancerService.Annotations, exposureClassAnnotations)
 	}
 	if zone != nil {
 		return o.Seed.GetZonalLoadBalancerServiceAnnotations(*zone)
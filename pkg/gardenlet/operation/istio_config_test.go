@@ -200,5 +200,31 @@ var _ = Describe("istioconfig", func() {
 				),
 			)
 		})
+
+		Context("exposure class with load balancer service annotations", func() {
+			var exposureClassLoadBalancerAnnotations = map[string]string{"exposure": "overridden", "foo": "bar"}
+
+			BeforeEach(func() {
+				exposureClassCopy := exposureClass.DeepCopy()
+				exposureClassCopy.LoadBalancerService = &gardencorev1beta1.ExposureClassLoadBalancerService{
+					Annotations: exposureClassLoadBalancerAnnotations,
+				}
+
+				shootCopy := shoot.DeepCopy()
+				shootCopy.Spec.ExposureClassName = &exposureClassName
+				operation.Shoot.SetInfo(shootCopy)
+				operation.Shoot.ExposureClass = exposureClassCopy
+			})
+
+			It("should add the annotations of the exposure class last for non-pinned control planes", func() {
+				Expect(operation.IstioLoadBalancerAnnotations()).To(Equal(utils.MergeStringMaps(defaultAnnotations, exposureClassAnnotations, exposureClassLoadBalancerAnnotations)))
+			})
+
+			It("should add the annotations of the exposure class last for pinned control planes", func() {
+				operation.SeedNamespaceObject.Annotations[resourcesv1alpha1.HighAvailabilityConfigZones] = zoneName
+
+				Expect(operation.IstioLoadBalancerAnnotations()).To(Equal(utils.MergeStringMaps(exposureClassAnnotations, zoneAnnotations, exposureClassLoadBalancerAnnotations)))
+			})
+		})
 	})
 })
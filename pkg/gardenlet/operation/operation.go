@@ -15,6 +15,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
@@ -32,6 +33,7 @@ import (
 	"github.com/gardener/gardener/pkg/utils/flow"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/oci"
 	versionutils "github.com/gardener/gardener/pkg/utils/version"
 )
 
@@ -131,6 +133,18 @@ func (b *Builder) WithSeedFrom(gardenClient client.Reader, seedName string) *Bui
 	return b
 }
 
+// WithHelmRegistry sets the registry which is used for pulling Helm charts from OCI repositories.
+func (b *Builder) WithHelmRegistry(helmRegistry oci.Interface) *Builder {
+	b.helmRegistry = helmRegistry
+	return b
+}
+
+// WithClock sets the clock which is used by the operation. If not set, the real clock is used.
+func (b *Builder) WithClock(c clock.Clock) *Builder {
+	b.clock = c
+	return b
+}
+
 // WithShoot sets the shootFunc attribute at the Builder.
 func (b *Builder) WithShoot(s *shootpkg.Shoot) *Builder {
 	b.shootFunc = func(_ context.Context, _ client.Reader, _ *garden.Garden, _ *seed.Seed, _ *corev1.Secret) (*shootpkg.Shoot, error) {
@@ -182,6 +196,12 @@ func (b *Builder) Build(
 		GardenClient:   gardenClient,
 		SeedClientSet:  seedClientSet,
 		ShootClientMap: shootClientMap,
+		HelmRegistry:   b.helmRegistry,
+		Clock:          b.clock,
+	}
+
+	if operation.Clock == nil {
+		operation.Clock = clock.RealClock{}
 	}
 
 	config, err := b.configFunc()
@@ -272,6 +272,17 @@ func (b *Builder) Build(ctx context.Context, c client.Reader) (*Shoot, error) {
 	}
 	shoot.VPNHighAvailabilityNumberOfSeedServers = vpnseedserver.HighAvailabilityReplicaCount
 	shoot.VPNHighAvailabilityNumberOfShootClients = vpnseedserver.HighAvailabilityReplicaCount
+	if kubeAPIServer := shoot.GetInfo().Spec.Kubernetes.KubeAPIServer; kubeAPIServer != nil && kubeAPIServer.VPN != nil {
+		if v := kubeAPIServer.VPN.HighAvailability; v != nil {
+			shoot.VPNHighAvailabilityEnabled = *v
+		}
+		if v := kubeAPIServer.VPN.SeedServers; v != nil {
+			shoot.VPNHighAvailabilityNumberOfSeedServers = int(*v)
+		}
+		if v := kubeAPIServer.VPN.ShootClients; v != nil {
+			shoot.VPNHighAvailabilityNumberOfShootClients = int(*v)
+		}
+	}
 	if vpnVPAUpdateDisabled, err := strconv.ParseBool(shoot.GetInfo().GetAnnotations()[v1beta1constants.ShootAlphaControlPlaneVPNVPAUpdateDisabled]); err == nil {
 		shoot.VPNVPAUpdateDisabled = vpnVPAUpdateDisabled
 	}
@@ -439,10 +450,13 @@ func (s *Shoot) UpdateInfoStatus(ctx context.Context, c client.Client, useStrate
 // Note that this method does not return ControlPlane and Infrastructure components as they require specific handling during migration.
 func (s *Shoot) GetExtensionComponentsForParallelMigration() []component.DeployMigrateWaiter {
 	if s.IsWorkerless {
-		return []component.DeployMigrateWaiter{}
+		return []component.DeployMigrateWaiter{
+			s.Components.Extensions.AuditSink,
+		}
 	}
 
 	return []component.DeployMigrateWaiter{
+		s.Components.Extensions.AuditSink,
 		s.Components.Extensions.ContainerRuntime,
 		s.Components.Extensions.ControlPlaneExposure,
 		s.Components.Extensions.Network,
@@ -21,6 +21,7 @@ import (
 	"github.com/gardener/gardener/pkg/component/clusteridentity"
 	etcdcopybackupstask "github.com/gardener/gardener/pkg/component/etcd/copybackupstask"
 	"github.com/gardener/gardener/pkg/component/etcd/etcd"
+	"github.com/gardener/gardener/pkg/component/extensions/auditsink"
 	"github.com/gardener/gardener/pkg/component/extensions/containerruntime"
 	"github.com/gardener/gardener/pkg/component/extensions/controlplane"
 	"github.com/gardener/gardener/pkg/component/extensions/dnsrecord"
@@ -43,6 +44,7 @@ import (
 	"github.com/gardener/gardener/pkg/component/observability/monitoring/alertmanager"
 	"github.com/gardener/gardener/pkg/component/observability/monitoring/prometheus"
 	"github.com/gardener/gardener/pkg/component/observability/plutono"
+	"github.com/gardener/gardener/pkg/component/shoot/managedaddons"
 	shootsystem "github.com/gardener/gardener/pkg/component/shoot/system"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 )
@@ -136,13 +138,14 @@ type ControlPlane struct {
 	Plutono                  plutono.Interface
 	Prometheus               prometheus.Interface
 	ResourceManager          resourcemanager.Interface
-	Vali                     component.Deployer
+	Vali                     component.DeployWaiter
 	VerticalPodAutoscaler    vpa.Interface
 	VPNSeedServer            vpnseedserver.Interface
 }
 
 // Extensions contains references to extension resources.
 type Extensions struct {
+	AuditSink             auditsink.Interface
 	ContainerRuntime      containerruntime.Interface
 	ControlPlane          controlplane.Interface
 	ControlPlaneExposure  controlplane.Interface
@@ -176,6 +179,7 @@ type SystemComponents struct {
 type Addons struct {
 	KubernetesDashboard kubernetesdashboard.Interface
 	NginxIngress        component.Deployer
+	Managed             managedaddons.Interface
 }
 
 // Networks contains pre-calculated subnets and IP address for various components.
@@ -0,0 +1,109 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/utils/flow"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+const (
+	// TimelineDataKey is the key in the data of the `<shoot-name>.timeline` config map containing the timeline entries.
+	TimelineDataKey = "timeline.json"
+	// TimelineMaxEntries is the maximum number of entries kept in the `<shoot-name>.timeline` config map. Older entries
+	// are dropped first.
+	TimelineMaxEntries = 1000
+)
+
+// TimelineEntry is a single entry of the operation timeline of a shoot.
+type TimelineEntry struct {
+	// Flow is the name of the flow the task belongs to.
+	Flow string `json:"flow"`
+	// Task is the ID of the task.
+	Task string `json:"task"`
+	// Start is the time when the task was started.
+	Start time.Time `json:"start"`
+	// End is the time when the task has finished.
+	End time.Time `json:"end"`
+	// Error is the error returned by the task, if any.
+	Error string `json:"error,omitempty"`
+}
+
+// RecordTask records the given task in the timeline of the operation. It can be used as flow.TaskRecorderFn.
+func (o *Operation) RecordTask(record flow.TaskRecord) {
+	entry := TimelineEntry{
+		Flow:  record.FlowName,
+		Task:  string(record.TaskID),
+		Start: record.Start.UTC().Truncate(time.Millisecond),
+		End:   record.Start.Add(record.Duration).UTC().Truncate(time.Millisecond),
+	}
+	if record.Error != nil {
+		entry.Error = record.Error.Error()
+	}
+
+	o.timelineMutex.Lock()
+	defer o.timelineMutex.Unlock()
+	o.timeline = append(o.timeline, entry)
+}
+
+// PersistTimeline appends the recorded timeline entries to the `<shoot-name>.timeline` config map in the project
+// namespace, so that the course of past operations can be reconstructed without access to the seed. Only the latest
+// TimelineMaxEntries entries are kept.
+func (o *Operation) PersistTimeline(ctx context.Context) {
+	o.timelineMutex.Lock()
+	defer o.timelineMutex.Unlock()
+
+	if len(o.timeline) == 0 {
+		return
+	}
+
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      gardenerutils.ComputeShootProjectResourceName(o.Shoot.GetInfo().Name, gardenerutils.ShootProjectConfigMapSuffixTimeline),
+			Namespace: o.Shoot.GetInfo().Namespace,
+		},
+	}
+
+	if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, o.GardenClient, configMap, func() error {
+		var timeline []TimelineEntry
+		if data, ok := configMap.Data[TimelineDataKey]; ok {
+			if err := json.Unmarshal([]byte(data), &timeline); err != nil {
+				o.Logger.Info("Dropping unreadable timeline", "configMap", configMap.Name, "err", err.Error())
+				timeline = nil
+			}
+		}
+
+		timeline = append(timeline, o.timeline...)
+		if len(timeline) > TimelineMaxEntries {
+			timeline = timeline[len(timeline)-TimelineMaxEntries:]
+		}
+
+		data, err := json.Marshal(timeline)
+		if err != nil {
+			return fmt.Errorf("failed marshalling timeline: %w", err)
+		}
+
+		configMap.OwnerReferences = []metav1.OwnerReference{
+			*metav1.NewControllerRef(o.Shoot.GetInfo(), gardencorev1beta1.SchemeGroupVersion.WithKind("Shoot")),
+		}
+		configMap.Data = map[string]string{TimelineDataKey: string(data)}
+		return nil
+	}); err != nil {
+		o.Logger.Error(err, "Could not persist timeline of shoot operation")
+		return
+	}
+
+	o.timeline = nil
+}
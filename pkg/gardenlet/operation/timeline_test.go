@@ -0,0 +1,110 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operation_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/gardenlet/operation"
+	shootpkg "github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
+	"github.com/gardener/gardener/pkg/utils/flow"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Timeline", func() {
+	var (
+		ctx   = context.Background()
+		start = time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+		gardenClient client.Client
+		shoot        *gardencorev1beta1.Shoot
+		o            *Operation
+		configMap    *corev1.ConfigMap
+	)
+
+	BeforeEach(func() {
+		gardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+		shoot = &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "garden-foo", UID: "uid"}}
+
+		o = &Operation{
+			Logger:       logr.Discard(),
+			GardenClient: gardenClient,
+			Shoot:        &shootpkg.Shoot{},
+		}
+		o.Shoot.SetInfo(shoot)
+
+		configMap = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "bar.timeline", Namespace: "garden-foo"}}
+	})
+
+	readTimeline := func() []TimelineEntry {
+		ExpectWithOffset(1, gardenClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)).To(Succeed())
+		var timeline []TimelineEntry
+		ExpectWithOffset(1, json.Unmarshal([]byte(configMap.Data["timeline.json"]), &timeline)).To(Succeed())
+		return timeline
+	}
+
+	It("should not create the config map if no task was recorded", func() {
+		o.PersistTimeline(ctx)
+
+		Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)).To(BeNotFoundError())
+	})
+
+	It("should persist the recorded tasks", func() {
+		o.RecordTask(flow.TaskRecord{FlowName: "Shoot cluster reconciliation", TaskID: "Deploying foo", Start: start, Duration: time.Second})
+		o.RecordTask(flow.TaskRecord{FlowName: "Shoot cluster reconciliation", TaskID: "Deploying bar", Start: start, Duration: 2 * time.Second, Error: errors.New("fake")})
+		o.PersistTimeline(ctx)
+
+		Expect(readTimeline()).To(Equal([]TimelineEntry{
+			{Flow: "Shoot cluster reconciliation", Task: "Deploying foo", Start: start, End: start.Add(time.Second)},
+			{Flow: "Shoot cluster reconciliation", Task: "Deploying bar", Start: start, End: start.Add(2 * time.Second), Error: "fake"},
+		}))
+		Expect(configMap.OwnerReferences).To(ConsistOf(And(
+			HaveField("Name", "bar"),
+			HaveField("Kind", "Shoot"),
+			HaveField("Controller", PointTo(BeTrue())),
+		)))
+	})
+
+	It("should append to the existing timeline and only keep the latest entries", func() {
+		for i := range TimelineMaxEntries {
+			o.RecordTask(flow.TaskRecord{FlowName: "first", TaskID: flow.TaskID(fmt.Sprintf("task-%d", i)), Start: start})
+		}
+		o.PersistTimeline(ctx)
+		Expect(readTimeline()).To(HaveLen(TimelineMaxEntries))
+
+		o.RecordTask(flow.TaskRecord{FlowName: "second", TaskID: "task", Start: start})
+		o.PersistTimeline(ctx)
+
+		timeline := readTimeline()
+		Expect(timeline).To(HaveLen(TimelineMaxEntries))
+		Expect(timeline[0].Task).To(Equal("task-1"))
+		Expect(timeline[TimelineMaxEntries-1].Flow).To(Equal("second"))
+	})
+
+	It("should start a new timeline if the existing one cannot be decoded", func() {
+		configMap.Data = map[string]string{"timeline.json": "{"}
+		Expect(gardenClient.Create(ctx, configMap)).To(Succeed())
+
+		o.RecordTask(flow.TaskRecord{FlowName: "flow", TaskID: "task", Start: start})
+		o.PersistTimeline(ctx)
+
+		Expect(readTimeline()).To(Equal([]TimelineEntry{{Flow: "flow", Task: "task", Start: start, End: start}}))
+	})
+})
@@ -10,6 +10,7 @@ import (
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
@@ -21,6 +22,7 @@ import (
 	"github.com/gardener/gardener/pkg/gardenlet/operation/garden"
 	"github.com/gardener/gardener/pkg/gardenlet/operation/seed"
 	"github.com/gardener/gardener/pkg/gardenlet/operation/shoot"
+	"github.com/gardener/gardener/pkg/utils/oci"
 	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
 )
 
@@ -34,6 +36,8 @@ type Builder struct {
 	secretsFunc               func() (map[string]*corev1.Secret, error)
 	seedFunc                  func(context.Context) (*seed.Seed, error)
 	shootFunc                 func(context.Context, client.Reader, *garden.Garden, *seed.Seed, *corev1.Secret) (*shoot.Shoot, error)
+	helmRegistry              oci.Interface
+	clock                     clock.Clock
 }
 
 // Operation contains all data required to perform an operation on a Shoot cluster.
@@ -44,6 +48,7 @@ type Operation struct {
 
 	Config                *config.GardenletConfiguration
 	Logger                logr.Logger
+	Clock                 clock.Clock
 	GardenerInfo          *gardencorev1beta1.Gardener
 	GardenClusterIdentity string
 	Garden                *garden.Garden
@@ -55,10 +60,14 @@ type Operation struct {
 	SeedClientSet         kubernetes.Interface
 	ShootClientMap        clientmap.ClientMap
 	ShootClientSet        kubernetes.Interface
+	HelmRegistry          oci.Interface
 	APIServerAddress      string
 	APIServerClusterIP    string
 	SeedNamespaceObject   *corev1.Namespace
 
 	// ControlPlaneWildcardCert is a wildcard tls certificate which is issued for the seed's ingress domain.
 	ControlPlaneWildcardCert *corev1.Secret
+
+	timeline      []TimelineEntry
+	timelineMutex sync.Mutex
 }
@@ -0,0 +1,163 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package logger
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+)
+
+// RecentErrors is the ring buffer which keeps the most recent error log entries of the running component. It is filled
+// by all loggers wrapped with RecentErrors.Wrap.
+var RecentErrors = NewErrorRingBuffer(100)
+
+// ErrorEntry is an error log entry kept in an ErrorRingBuffer.
+type ErrorEntry struct {
+	// Time is the time when the error was logged.
+	Time time.Time `json:"time"`
+	// Logger is the name of the logger.
+	Logger string `json:"logger,omitempty"`
+	// Message is the log message.
+	Message string `json:"message"`
+	// Error is the logged error.
+	Error string `json:"error,omitempty"`
+	// Values are the key-value pairs attached to the log entry.
+	Values map[string]string `json:"values,omitempty"`
+}
+
+// ErrorRingBuffer keeps the most recent error log entries in memory.
+type ErrorRingBuffer struct {
+	lock    sync.Mutex
+	entries []ErrorEntry
+	next    int
+	full    bool
+	now     func() time.Time
+}
+
+// NewErrorRingBuffer returns a new ring buffer which keeps the given number of error log entries.
+func NewErrorRingBuffer(size int) *ErrorRingBuffer {
+	return &ErrorRingBuffer{
+		entries: make([]ErrorEntry, size),
+		now:     time.Now,
+	}
+}
+
+// Wrap returns a logger which records all logged errors in the ring buffer in addition to passing them to the given
+// logger.
+func (b *ErrorRingBuffer) Wrap(log logr.Logger) logr.Logger {
+	sink := log.GetSink()
+	// account for the additional stack frame of the wrapping sink
+	if callDepthSink, ok := sink.(logr.CallDepthLogSink); ok {
+		sink = callDepthSink.WithCallDepth(1)
+	}
+
+	return log.WithSink(&errorRecordingSink{LogSink: sink, buffer: b})
+}
+
+// Entries returns the recorded error log entries, starting with the oldest one.
+func (b *ErrorRingBuffer) Entries() []ErrorEntry {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if !b.full {
+		return append([]ErrorEntry{}, b.entries[:b.next]...)
+	}
+	return append(append([]ErrorEntry{}, b.entries[b.next:]...), b.entries[:b.next]...)
+}
+
+func (b *ErrorRingBuffer) add(entry ErrorEntry) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if len(b.entries) == 0 {
+		return
+	}
+
+	entry.Time = b.now().UTC()
+	b.entries[b.next] = entry
+	b.next = (b.next + 1) % len(b.entries)
+	if b.next == 0 {
+		b.full = true
+	}
+}
+
+type errorRecordingSink struct {
+	logr.LogSink
+	buffer *ErrorRingBuffer
+
+	name   string
+	values []any
+}
+
+func (s *errorRecordingSink) Info(level int, msg string, keysAndValues ...any) {
+	s.LogSink.Info(level, msg, keysAndValues...)
+}
+
+func (s *errorRecordingSink) Error(err error, msg string, keysAndValues ...any) {
+	entry := ErrorEntry{
+		Logger:  s.name,
+		Message: msg,
+		Values:  toStringMap(append(append([]any{}, s.values...), keysAndValues...)),
+	}
+	if err != nil {
+		entry.Error = err.Error()
+	}
+	s.buffer.add(entry)
+
+	s.LogSink.Error(err, msg, keysAndValues...)
+}
+
+func (s *errorRecordingSink) WithValues(keysAndValues ...any) logr.LogSink {
+	return &errorRecordingSink{
+		LogSink: s.LogSink.WithValues(keysAndValues...),
+		buffer:  s.buffer,
+		name:    s.name,
+		values:  append(append([]any{}, s.values...), keysAndValues...),
+	}
+}
+
+func (s *errorRecordingSink) WithName(name string) logr.LogSink {
+	fullName := name
+	if s.name != "" {
+		fullName = strings.Join([]string{s.name, name}, ".")
+	}
+
+	return &errorRecordingSink{
+		LogSink: s.LogSink.WithName(name),
+		buffer:  s.buffer,
+		name:    fullName,
+		values:  s.values,
+	}
+}
+
+func (s *errorRecordingSink) WithCallDepth(depth int) logr.LogSink {
+	sink, ok := s.LogSink.(logr.CallDepthLogSink)
+	if !ok {
+		return s
+	}
+
+	return &errorRecordingSink{
+		LogSink: sink.WithCallDepth(depth),
+		buffer:  s.buffer,
+		name:    s.name,
+		values:  s.values,
+	}
+}
+
+func toStringMap(keysAndValues []any) map[string]string {
+	if len(keysAndValues) == 0 {
+		return nil
+	}
+
+	out := make(map[string]string, len(keysAndValues)/2)
+	for i := 0; i+1 < len(keysAndValues); i += 2 {
+		out[fmt.Sprint(keysAndValues[i])] = fmt.Sprint(keysAndValues[i+1])
+	}
+	return out
+}
@@ -0,0 +1,66 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package logger_test
+
+import (
+	"errors"
+
+	"github.com/go-logr/logr"
+	"github.com/go-logr/logr/funcr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+
+	. "github.com/gardener/gardener/pkg/logger"
+)
+
+var _ = Describe("ErrorRingBuffer", func() {
+	var (
+		buffer *ErrorRingBuffer
+		logged []string
+		log    logr.Logger
+	)
+
+	BeforeEach(func() {
+		buffer = NewErrorRingBuffer(2)
+		logged = nil
+		log = buffer.Wrap(funcr.New(func(prefix, args string) {
+			logged = append(logged, prefix+" "+args)
+		}, funcr.Options{}))
+	})
+
+	It("should record errors and pass all entries to the wrapped logger", func() {
+		log.WithName("controller").WithValues("object", "foo").Error(errors.New("fake"), "Reconciler error", "attempt", 1)
+		log.Info("Not recorded")
+
+		Expect(logged).To(HaveLen(2))
+		Expect(buffer.Entries()).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+			"Logger":  Equal("controller"),
+			"Message": Equal("Reconciler error"),
+			"Error":   Equal("fake"),
+			"Values":  Equal(map[string]string{"object": "foo", "attempt": "1"}),
+		})))
+	})
+
+	It("should only keep the most recent errors", func() {
+		log.Error(errors.New("first"), "First")
+		log.Error(errors.New("second"), "Second")
+		log.Error(errors.New("third"), "Third")
+
+		Expect(buffer.Entries()).To(HaveExactElements(
+			HaveField("Message", "Second"),
+			HaveField("Message", "Third"),
+		))
+	})
+
+	It("should join the names of nested loggers", func() {
+		log.WithName("a").WithName("b").Error(nil, "Failed")
+
+		Expect(buffer.Entries()).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+			"Logger": Equal("a.b"),
+			"Error":  BeEmpty(),
+		})))
+	})
+})
@@ -6,6 +6,7 @@ package v1alpha1
 
 import (
 	"github.com/Masterminds/semver/v3"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
 )
@@ -47,6 +48,10 @@ const (
 	// AnnotationKeyChecksumAppliedOperatingSystemConfig is a constant for an annotation key on a Node describing the
 	// checksum of the last applied operating system configuration.
 	AnnotationKeyChecksumAppliedOperatingSystemConfig = "checksum/cloud-config-data"
+
+	// EventReasonBootstrapFailed is the reason of the event reported by gardener-node-agent if it fails to apply the
+	// operating system configuration before the node has been registered.
+	EventReasonBootstrapFailed = "BootstrapFailed"
 )
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -102,6 +107,9 @@ type ControllerConfiguration struct {
 	OperatingSystemConfig OperatingSystemConfigControllerConfig `json:"operatingSystemConfig"`
 	// Token is the configuration for the access token controller.
 	Token TokenControllerConfig `json:"token"`
+	// NodeMetadata is the configuration for the node metadata controller. If it is not set, the controller is disabled.
+	// +optional
+	NodeMetadata *NodeMetadataControllerConfig `json:"nodeMetadata,omitempty"`
 }
 
 // OperatingSystemConfigControllerConfig defines the configuration of the operating system config controller.
@@ -117,6 +125,20 @@ type OperatingSystemConfigControllerConfig struct {
 	KubernetesVersion *semver.Version `json:"kubernetesVersion"`
 }
 
+// NodeMetadataControllerConfig defines the configuration of the node metadata controller which continuously
+// reconciles the labels, annotations and taints of the worker pool on the node.
+type NodeMetadataControllerConfig struct {
+	// Labels are the labels which must be present on the node.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+	// Annotations are the annotations which must be present on the node.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// Taints are the taints which must be present on the node.
+	// +optional
+	Taints []corev1.Taint `json:"taints,omitempty"`
+}
+
 // TokenControllerConfig defines the configuration of the access token controller.
 type TokenControllerConfig struct {
 	// SyncConfigs is the list of configurations for syncing access tokens.
@@ -20,6 +20,7 @@ import (
 	"github.com/gardener/gardener/pkg/nodeagent/controller/hostnamecheck"
 	"github.com/gardener/gardener/pkg/nodeagent/controller/lease"
 	"github.com/gardener/gardener/pkg/nodeagent/controller/node"
+	"github.com/gardener/gardener/pkg/nodeagent/controller/nodemetadata"
 	"github.com/gardener/gardener/pkg/nodeagent/controller/operatingsystemconfig"
 	"github.com/gardener/gardener/pkg/nodeagent/controller/token"
 )
@@ -44,9 +45,18 @@ func AddToManager(ctx context.Context, cancel context.CancelFunc, mgr manager.Ma
 		return fmt.Errorf("failed adding node controller: %w", err)
 	}
 
+	if cfg.Controllers.NodeMetadata != nil {
+		if err := (&nodemetadata.Reconciler{
+			Config: *cfg.Controllers.NodeMetadata,
+		}).AddToManager(mgr, nodePredicate); err != nil {
+			return fmt.Errorf("failed adding node metadata controller: %w", err)
+		}
+	}
+
 	if err := (&operatingsystemconfig.Reconciler{
 		Config:        cfg.Controllers.OperatingSystemConfig,
 		HostName:      hostName,
+		MachineName:   machineName,
 		NodeName:      nodeName,
 		CancelContext: cancel,
 	}).AddToManager(ctx, mgr); err != nil {
@@ -0,0 +1,93 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package nodemetadata
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/pkg/controllerutils"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+)
+
+// Reconciler ensures that the labels, annotations and taints of the worker pool are present on the node. Labels,
+// annotations and taints which are not part of the configuration are left untouched.
+type Reconciler struct {
+	Client client.Client
+	Config nodeagentconfigv1alpha1.NodeMetadataControllerConfig
+}
+
+// Reconcile ensures that the labels, annotations and taints of the worker pool are present on the node.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
+	defer cancel()
+
+	node := &corev1.Node{}
+	if err := r.Client.Get(ctx, request.NamespacedName, node); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	patch := client.MergeFromWithOptions(node.DeepCopy(), client.MergeFromWithOptimisticLock{})
+	original := node.DeepCopy()
+
+	for key, value := range r.Config.Labels {
+		metav1.SetMetaDataLabel(&node.ObjectMeta, key, value)
+	}
+	for key, value := range r.Config.Annotations {
+		metav1.SetMetaDataAnnotation(&node.ObjectMeta, key, value)
+	}
+	node.Spec.Taints = ensureTaints(node.Spec.Taints, r.Config.Taints)
+
+	if apiequality.Semantic.DeepEqual(original.ObjectMeta, node.ObjectMeta) && apiequality.Semantic.DeepEqual(original.Spec.Taints, node.Spec.Taints) {
+		return reconcile.Result{}, nil
+	}
+
+	log.Info("Reconciling labels, annotations and taints of the worker pool on the node")
+	if err := r.Client.Patch(ctx, node, patch); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed patching node: %w", err)
+	}
+
+	return reconcile.Result{}, nil
+}
+
+// ensureTaints adds the desired taints to the given taints. Existing taints with the same key and effect as a desired
+// taint are overwritten.
+func ensureTaints(taints, desiredTaints []corev1.Taint) []corev1.Taint {
+	result := append([]corev1.Taint{}, taints...)
+
+outer:
+	for _, desired := range desiredTaints {
+		for i, taint := range result {
+			if taint.Key == desired.Key && taint.Effect == desired.Effect {
+				if taint.Value != desired.Value {
+					result[i].Value = desired.Value
+					result[i].TimeAdded = nil
+				}
+				continue outer
+			}
+		}
+		result = append(result, desired)
+	}
+
+	if len(result) == 0 {
+		return taints
+	}
+	return result
+}
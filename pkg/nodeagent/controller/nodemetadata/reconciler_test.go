@@ -0,0 +1,90 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package nodemetadata_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	. "github.com/gardener/gardener/pkg/nodeagent/controller/nodemetadata"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx        = context.TODO()
+		fakeClient client.Client
+		reconciler *Reconciler
+		node       *corev1.Node
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).Build()
+		reconciler = &Reconciler{
+			Client: fakeClient,
+			Config: nodeagentconfigv1alpha1.NodeMetadataControllerConfig{
+				Labels:      map[string]string{"worker.gardener.cloud/pool": "pool", "foo": "bar"},
+				Annotations: map[string]string{"baz": "qux"},
+				Taints: []corev1.Taint{
+					{Key: "dedicated", Value: "pool", Effect: corev1.TaintEffectNoSchedule},
+					{Key: "gpu", Effect: corev1.TaintEffectNoExecute},
+				},
+			},
+		}
+
+		node = &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "node",
+				Labels:      map[string]string{"worker.gardener.cloud/pool": "pool", "foo": "changed", "custom": "label"},
+				Annotations: map[string]string{"custom": "annotation"},
+			},
+			Spec: corev1.NodeSpec{
+				Taints: []corev1.Taint{
+					{Key: "dedicated", Value: "other", Effect: corev1.TaintEffectNoSchedule},
+					{Key: "dedicated", Value: "other", Effect: corev1.TaintEffectNoExecute},
+				},
+			},
+		}
+		Expect(fakeClient.Create(ctx, node)).To(Succeed())
+	})
+
+	It("should do nothing if the node does not exist", func() {
+		Expect(fakeClient.Delete(ctx, node)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(node)})).To(Equal(reconcile.Result{}))
+	})
+
+	It("should restore the labels, annotations and taints of the worker pool", func() {
+		Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(node)})).To(Equal(reconcile.Result{}))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+		Expect(node.Labels).To(Equal(map[string]string{"worker.gardener.cloud/pool": "pool", "foo": "bar", "custom": "label"}))
+		Expect(node.Annotations).To(Equal(map[string]string{"baz": "qux", "custom": "annotation"}))
+		Expect(node.Spec.Taints).To(Equal([]corev1.Taint{
+			{Key: "dedicated", Value: "pool", Effect: corev1.TaintEffectNoSchedule},
+			{Key: "dedicated", Value: "other", Effect: corev1.TaintEffectNoExecute},
+			{Key: "gpu", Effect: corev1.TaintEffectNoExecute},
+		}))
+	})
+
+	It("should not patch the node if it is already up-to-date", func() {
+		Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(node)})).To(Equal(reconcile.Result{}))
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+		resourceVersion := node.ResourceVersion
+
+		Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(node)})).To(Equal(reconcile.Result{}))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+		Expect(node.ResourceVersion).To(Equal(resourceVersion))
+	})
+})
@@ -63,10 +63,35 @@ func (r *Reconciler) AddToManager(ctx context.Context, mgr manager.Manager) erro
 				r.SecretPredicate(),
 				predicateutils.ForEventTypes(predicateutils.Create, predicateutils.Update)),
 		).
+		Watches(
+			&corev1.Node{},
+			handler.EnqueueRequestsFromMapFunc(r.NodeToSecretMapper()),
+			builder.WithPredicates(r.NodePredicate()),
+		).
 		WithOptions(controller.Options{MaxConcurrentReconciles: 1}).
 		Complete(r)
 }
 
+// NodePredicate returns 'true' when the annotation requesting an in-place update of the node gets set or changed.
+func (r *Reconciler) NodePredicate() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(_ event.CreateEvent) bool { return false },
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return e.ObjectOld.GetAnnotations()[v1beta1constants.AnnotationInPlaceUpdateDesiredHash] != e.ObjectNew.GetAnnotations()[v1beta1constants.AnnotationInPlaceUpdateDesiredHash] &&
+				e.ObjectNew.GetAnnotations()[v1beta1constants.AnnotationInPlaceUpdateDesiredHash] != ""
+		},
+		DeleteFunc:  func(_ event.DeleteEvent) bool { return false },
+		GenericFunc: func(_ event.GenericEvent) bool { return false },
+	}
+}
+
+// NodeToSecretMapper returns a mapper that returns requests for the secret containing the operating system config.
+func (r *Reconciler) NodeToSecretMapper() handler.MapFunc {
+	return func(_ context.Context, _ client.Object) []reconcile.Request {
+		return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: r.Config.SecretName, Namespace: metav1.NamespaceSystem}}}
+	}
+}
+
 // SecretPredicate returns the predicate for Secret events.
 func (r *Reconciler) SecretPredicate() predicate.Predicate {
 	return predicate.Funcs{
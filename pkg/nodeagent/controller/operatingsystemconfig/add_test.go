@@ -78,6 +78,59 @@ var _ = Describe("Add", func() {
 		})
 	})
 
+	Describe("#NodePredicate", func() {
+		var (
+			p    predicate.Predicate
+			node *corev1.Node
+		)
+
+		BeforeEach(func() {
+			p = (&Reconciler{}).NodePredicate()
+			node = &corev1.Node{}
+		})
+
+		It("should return false for create events", func() {
+			Expect(p.Create(event.CreateEvent{Object: node})).To(BeFalse())
+		})
+
+		Describe("#Update", func() {
+			It("should return false because the desired hash does not change", func() {
+				Expect(p.Update(event.UpdateEvent{ObjectOld: node, ObjectNew: node})).To(BeFalse())
+			})
+
+			It("should return false because the desired hash is removed", func() {
+				oldNode := node.DeepCopy()
+				oldNode.Annotations = map[string]string{"worker.gardener.cloud/in-place-update-desired-hash": "foo"}
+				Expect(p.Update(event.UpdateEvent{ObjectOld: oldNode, ObjectNew: node})).To(BeFalse())
+			})
+
+			It("should return true because the desired hash changes", func() {
+				oldNode := node.DeepCopy()
+				node.Annotations = map[string]string{"worker.gardener.cloud/in-place-update-desired-hash": "foo"}
+				Expect(p.Update(event.UpdateEvent{ObjectOld: oldNode, ObjectNew: node})).To(BeTrue())
+			})
+		})
+
+		It("should return false for delete events", func() {
+			Expect(p.Delete(event.DeleteEvent{Object: node})).To(BeFalse())
+		})
+
+		It("should return false for generic events", func() {
+			Expect(p.Generic(event.GenericEvent{Object: node})).To(BeFalse())
+		})
+	})
+
+	Describe("#NodeToSecretMapper", func() {
+		It("should map to the secret containing the operating system config", func() {
+			reconciler := &Reconciler{}
+			reconciler.Config.SecretName = "osc-secret"
+
+			Expect(reconciler.NodeToSecretMapper()(context.Background(), &corev1.Node{})).To(ConsistOf(
+				reconcile.Request{NamespacedName: types.NamespacedName{Name: "osc-secret", Namespace: "kube-system"}},
+			))
+		})
+	})
+
 	Describe("#EnqueueWithJitterDelay", func() {
 		var (
 			ctx = context.Background()
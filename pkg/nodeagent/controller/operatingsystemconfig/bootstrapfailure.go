@@ -0,0 +1,47 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operatingsystemconfig
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gardener/machine-controller-manager/pkg/util/provider/machineutils"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	nodeagentconfigv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+)
+
+// BootstrapFailureJournalLines is the number of lines of the gardener-node-agent journal which are attached to the
+// event reporting a bootstrap failure.
+const BootstrapFailureJournalLines = 30
+
+// ReportBootstrapFailure records a warning event for the node containing the given error and the last lines of the
+// gardener-node-agent journal. It is used for failures which happen before the node has been registered, i.e., before
+// the logging agents run, so that operators can investigate them without console access to the machine. The event is
+// annotated with the machine name (if known), which allows gardenlet to attach it to the corresponding machine.
+func (r *Reconciler) ReportBootstrapFailure(ctx context.Context, log logr.Logger, reconcileErr error) {
+	journal, err := Exec(ctx, "journalctl", "--unit", nodeagentconfigv1alpha1.UnitName, "--lines", strconv.Itoa(BootstrapFailureJournalLines), "--no-pager", "--output", "short-iso")
+	if err != nil {
+		log.Error(err, "Failed reading journal for reporting bootstrap failure", "unitName", nodeagentconfigv1alpha1.UnitName)
+		journal = []byte(fmt.Sprintf("<failed reading journal: %v>", err))
+	}
+
+	var annotations map[string]string
+	if r.MachineName != "" {
+		annotations = map[string]string{machineutils.MachineLabelKey: r.MachineName}
+	}
+
+	// The node is not registered yet, hence the event refers to the node name which kubelet is expected to register.
+	node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: r.HostName}}
+	r.Recorder.AnnotatedEventf(node, annotations, corev1.EventTypeWarning, nodeagentconfigv1alpha1.EventReasonBootstrapFailed,
+		"Failed applying operating system config: %v\nLast %d lines of the %s journal:\n%s",
+		reconcileErr, BootstrapFailureJournalLines, nodeagentconfigv1alpha1.UnitName, strings.TrimSpace(string(journal)),
+	)
+}
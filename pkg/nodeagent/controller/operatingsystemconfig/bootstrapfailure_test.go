@@ -0,0 +1,66 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operatingsystemconfig_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/client-go/tools/record"
+
+	. "github.com/gardener/gardener/pkg/nodeagent/controller/operatingsystemconfig"
+	"github.com/gardener/gardener/pkg/utils/test"
+)
+
+var _ = Describe("BootstrapFailure", func() {
+	Describe("#ReportBootstrapFailure", func() {
+		var (
+			ctx = context.Background()
+
+			recorder   *record.FakeRecorder
+			reconciler *Reconciler
+
+			executedCommand []string
+		)
+
+		BeforeEach(func() {
+			recorder = record.NewFakeRecorder(1)
+			reconciler = &Reconciler{Recorder: recorder, HostName: "host"}
+
+			DeferCleanup(test.WithVar(&Exec, func(_ context.Context, command string, arg ...string) ([]byte, error) {
+				executedCommand = append([]string{command}, arg...)
+				return []byte("line1\nline2\n"), nil
+			}))
+		})
+
+		It("should report the error and the journal excerpt", func() {
+			reconciler.ReportBootstrapFailure(ctx, logr.Discard(), errors.New("fake"))
+
+			Expect(executedCommand).To(Equal([]string{"journalctl", "--unit", "gardener-node-agent.service", "--lines", "30", "--no-pager", "--output", "short-iso"}))
+			Expect(recorder.Events).To(Receive(Equal("Warning BootstrapFailed Failed applying operating system config: fake\nLast 30 lines of the gardener-node-agent.service journal:\nline1\nline2")))
+		})
+
+		It("should annotate the event with the machine name", func() {
+			reconciler.MachineName = "machine"
+
+			reconciler.ReportBootstrapFailure(ctx, logr.Discard(), errors.New("fake"))
+
+			Expect(recorder.Events).To(Receive(HaveSuffix("line2 map[node.gardener.cloud/machine-name:machine]")))
+		})
+
+		It("should report the error even if the journal cannot be read", func() {
+			DeferCleanup(test.WithVar(&Exec, func(_ context.Context, _ string, _ ...string) ([]byte, error) {
+				return nil, errors.New("no journal")
+			}))
+
+			reconciler.ReportBootstrapFailure(ctx, logr.Discard(), errors.New("fake"))
+
+			Expect(recorder.Events).To(Receive(HaveSuffix("journal:\n<failed reading journal: no journal>")))
+		})
+	})
+})
@@ -163,6 +163,13 @@ func computeOperatingSystemConfigChanges(log logr.Logger, fs afero.Afero, newOSC
 	}
 	changes.Containerd.Registries = computeContainerdRegistryDiffs(newRegistries, oldRegistries)
 
+	if !changes.Containerd.ConfigFileChanged && len(changes.Containerd.Registries.Deleted) == 0 {
+		changes.Kubelet, err = computeKubeletChanges(log, oldOSCFiles, changes)
+		if err != nil {
+			return nil, fmt.Errorf("failed computing kubelet changes: %w", err)
+		}
+	}
+
 	changes.lock.Lock()
 	defer changes.lock.Unlock()
 	return changes, changes.persist()
@@ -0,0 +1,154 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operatingsystemconfig
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	extensionsv1alpha1helper "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1/helper"
+	"github.com/gardener/gardener/pkg/utils/retry"
+)
+
+var (
+	// KubeletReadinessTimeout is the timeout for waiting until the node is ready again after kubelet has been
+	// reconfigured live. Exposed for testing.
+	KubeletReadinessTimeout = 5 * time.Minute
+	// KubeletReadinessInterval is the interval for checking whether the node is ready again after kubelet has been
+	// reconfigured live. Exposed for testing.
+	KubeletReadinessInterval = 5 * time.Second
+
+	// disruptiveKubeletConfigurationFields are the fields of the kubelet configuration which cannot be applied by simply
+	// restarting kubelet since they affect the resources or the state of the already running pods, e.g., the CPU manager
+	// state must be wiped before a policy change is accepted by kubelet.
+	disruptiveKubeletConfigurationFields = sets.New(
+		"cgroupDriver",
+		"cgroupRoot",
+		"cgroupsPerQOS",
+		"cpuManagerPolicy",
+		"cpuManagerPolicyOptions",
+		"enforceNodeAllocatable",
+		"evictionHard",
+		"kubeReserved",
+		"kubeReservedCgroup",
+		"memoryManagerPolicy",
+		"reservedMemory",
+		"reservedSystemCPUs",
+		"systemReserved",
+		"systemReservedCgroup",
+		"topologyManagerPolicy",
+		"topologyManagerPolicyOptions",
+		"topologyManagerScope",
+	)
+)
+
+// KubeletConfigurationChanges returns the names of the top-level fields which differ between the given kubelet
+// configurations (as found in the kubelet configuration file) and whether all of them can be applied live, i.e., by
+// restarting kubelet without draining the node.
+func KubeletConfigurationChanges(oldConfig, newConfig []byte) ([]string, bool, error) {
+	var oldFields, newFields map[string]any
+
+	if err := yaml.Unmarshal(oldConfig, &oldFields); err != nil {
+		return nil, false, fmt.Errorf("failed unmarshalling old kubelet configuration: %w", err)
+	}
+	if err := yaml.Unmarshal(newConfig, &newFields); err != nil {
+		return nil, false, fmt.Errorf("failed unmarshalling new kubelet configuration: %w", err)
+	}
+
+	changedFields := sets.New[string]()
+	for name, value := range oldFields {
+		if newValue, ok := newFields[name]; !ok || !apiequality.Semantic.DeepEqual(value, newValue) {
+			changedFields.Insert(name)
+		}
+	}
+	for name := range newFields {
+		if _, ok := oldFields[name]; !ok {
+			changedFields.Insert(name)
+		}
+	}
+
+	return sets.List(changedFields), !changedFields.HasAny(disruptiveKubeletConfigurationFields.UnsortedList()...), nil
+}
+
+// computeKubeletChanges checks whether the changes between the old and the new OperatingSystemConfig only affect the
+// kubelet configuration file (and hence the kubelet unit) and whether they can be applied by restarting kubelet.
+func computeKubeletChanges(log logr.Logger, oldFiles []extensionsv1alpha1.File, changes *operatingSystemConfigChanges) (kubeletChanges, error) {
+	if len(changes.Files.Changed) != 1 || changes.Files.Changed[0].Path != v1beta1constants.OperatingSystemConfigFilePathKubeletConfig ||
+		len(changes.Files.Deleted) > 0 || len(changes.Units.Deleted) > 0 ||
+		slices.ContainsFunc(changes.Units.Commands, func(c unitCommand) bool {
+			return c.Name != v1beta1constants.OperatingSystemConfigUnitNameKubeletService
+		}) {
+		return kubeletChanges{}, nil
+	}
+
+	oldFileIndex := slices.IndexFunc(oldFiles, func(f extensionsv1alpha1.File) bool {
+		return f.Path == v1beta1constants.OperatingSystemConfigFilePathKubeletConfig
+	})
+	if oldFileIndex == -1 || oldFiles[oldFileIndex].Content.Inline == nil || changes.Files.Changed[0].Content.Inline == nil {
+		return kubeletChanges{}, nil
+	}
+
+	oldConfig, err := extensionsv1alpha1helper.Decode(oldFiles[oldFileIndex].Content.Inline.Encoding, []byte(oldFiles[oldFileIndex].Content.Inline.Data))
+	if err != nil {
+		return kubeletChanges{}, fmt.Errorf("unable to decode old kubelet configuration: %w", err)
+	}
+	newConfig, err := extensionsv1alpha1helper.Decode(changes.Files.Changed[0].Content.Inline.Encoding, []byte(changes.Files.Changed[0].Content.Inline.Data))
+	if err != nil {
+		return kubeletChanges{}, fmt.Errorf("unable to decode new kubelet configuration: %w", err)
+	}
+
+	changedFields, live, err := KubeletConfigurationChanges(oldConfig, newConfig)
+	if err != nil {
+		return kubeletChanges{}, err
+	}
+
+	if !live {
+		log.Info("Kubelet configuration changes affect fields which usually require a node roll, applying them without readiness verification", "changedFields", changedFields)
+		return kubeletChanges{}, nil
+	}
+
+	log.Info("Kubelet configuration changes can be applied live", "changedFields", changedFields)
+	return kubeletChanges{LiveReconfiguration: true, ChangedFields: changedFields}, nil
+}
+
+// waitForNodeReadinessAfterKubeletRestart waits until kubelet reported the node as ready after it has been restarted.
+func (r *Reconciler) waitForNodeReadinessAfterKubeletRestart(ctx context.Context, log logr.Logger, node *corev1.Node, restartTime *metav1.Time) error {
+	return retry.UntilTimeout(ctx, KubeletReadinessInterval, KubeletReadinessTimeout, func(ctx context.Context) (done bool, err error) {
+		if err := r.Client.Get(ctx, client.ObjectKeyFromObject(node), node); err != nil {
+			return retry.SevereError(fmt.Errorf("failed getting node: %w", err))
+		}
+
+		for _, condition := range node.Status.Conditions {
+			if condition.Type != corev1.NodeReady {
+				continue
+			}
+
+			if condition.Status != corev1.ConditionTrue {
+				log.Info("Waiting for node to become ready after kubelet restart", "reason", condition.Reason)
+				return retry.MinorError(fmt.Errorf("node is not ready: %s", condition.Message))
+			}
+			if restartTime != nil && condition.LastHeartbeatTime.Before(restartTime) {
+				log.Info("Waiting for kubelet to report node status after restart")
+				return retry.MinorError(fmt.Errorf("kubelet did not report node status since restart at %s", restartTime.UTC().Format(time.RFC3339)))
+			}
+
+			return retry.Ok()
+		}
+
+		return retry.MinorError(fmt.Errorf("node has no %s condition", corev1.NodeReady))
+	})
+}
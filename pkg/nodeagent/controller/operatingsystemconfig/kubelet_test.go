@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package operatingsystemconfig_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener/pkg/nodeagent/controller/operatingsystemconfig"
+)
+
+var _ = Describe("Kubelet", func() {
+	Describe("#KubeletConfigurationChanges", func() {
+		oldConfig := []byte(`apiVersion: kubelet.config.k8s.io/v1beta1
+kind: KubeletConfiguration
+maxPods: 110
+imageGCHighThresholdPercent: 50
+cpuManagerPolicy: none
+kubeReserved:
+  cpu: 80m
+  memory: 1Gi
+`)
+
+		It("should report no changes for equal configurations", func() {
+			changedFields, live, err := KubeletConfigurationChanges(oldConfig, oldConfig)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(changedFields).To(BeEmpty())
+			Expect(live).To(BeTrue())
+		})
+
+		It("should allow live reconfiguration for non-disruptive fields", func() {
+			changedFields, live, err := KubeletConfigurationChanges(oldConfig, []byte(`apiVersion: kubelet.config.k8s.io/v1beta1
+kind: KubeletConfiguration
+maxPods: 250
+cpuManagerPolicy: none
+kubeReserved:
+  cpu: 80m
+  memory: 1Gi
+serializeImagePulls: false
+`))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(changedFields).To(ConsistOf("imageGCHighThresholdPercent", "maxPods", "serializeImagePulls"))
+			Expect(live).To(BeTrue())
+		})
+
+		It("should not allow live reconfiguration for disruptive fields", func() {
+			changedFields, live, err := KubeletConfigurationChanges(oldConfig, []byte(`apiVersion: kubelet.config.k8s.io/v1beta1
+kind: KubeletConfiguration
+maxPods: 250
+imageGCHighThresholdPercent: 50
+cpuManagerPolicy: static
+kubeReserved:
+  cpu: 80m
+  memory: 2Gi
+`))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(changedFields).To(ConsistOf("cpuManagerPolicy", "kubeReserved", "maxPods"))
+			Expect(live).To(BeFalse())
+		})
+
+		It("should return an error for invalid configurations", func() {
+			_, _, err := KubeletConfigurationChanges(oldConfig, []byte(`{`))
+			Expect(err).To(MatchError(ContainSubstring("failed unmarshalling new kubelet configuration")))
+		})
+	})
+})
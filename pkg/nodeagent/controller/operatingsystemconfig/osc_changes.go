@@ -10,6 +10,7 @@ import (
 	"sync"
 
 	"github.com/spf13/afero"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/yaml"
 
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
@@ -19,11 +20,12 @@ type operatingSystemConfigChanges struct {
 	lock sync.Mutex
 	fs   afero.Afero
 
-	OperatingSystemConfigChecksum string     `json:"operatingSystemConfigChecksum"`
-	Units                         units      `json:"units"`
-	Files                         files      `json:"files"`
-	Containerd                    containerd `json:"containerd"`
-	MustRestartNodeAgent          bool       `json:"mustRestartNodeAgent"`
+	OperatingSystemConfigChecksum string         `json:"operatingSystemConfigChecksum"`
+	Units                         units          `json:"units"`
+	Files                         files          `json:"files"`
+	Containerd                    containerd     `json:"containerd"`
+	Kubelet                       kubeletChanges `json:"kubelet"`
+	MustRestartNodeAgent          bool           `json:"mustRestartNodeAgent"`
 }
 
 type units struct {
@@ -60,6 +62,16 @@ type containerd struct {
 	Registries containerdRegistries `json:"registries"`
 }
 
+type kubeletChanges struct {
+	// LiveReconfiguration tracks if the changes only affect kubelet configuration fields which can be applied by
+	// restarting kubelet, so that GNA verifies the node readiness after the restart.
+	LiveReconfiguration bool `json:"liveReconfiguration"`
+	// ChangedFields are the changed fields of the kubelet configuration.
+	ChangedFields []string `json:"changedFields,omitempty"`
+	// RestartTime is the time when kubelet was restarted to apply the changed configuration.
+	RestartTime *metav1.Time `json:"restartTime,omitempty"`
+}
+
 type containerdRegistries struct {
 	Desired []extensionsv1alpha1.RegistryConfig `json:"desired,omitempty"`
 	Deleted []extensionsv1alpha1.RegistryConfig `json:"deleted,omitempty"`
@@ -178,6 +190,22 @@ func (o *operatingSystemConfigChanges) completedContainerdConfigFileChange() err
 	return o.persist()
 }
 
+func (o *operatingSystemConfigChanges) setKubeletRestartTime(t metav1.Time) error {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	o.Kubelet.RestartTime = &t
+	return o.persist()
+}
+
+func (o *operatingSystemConfigChanges) completedKubeletLiveReconfiguration() error {
+	o.lock.Lock()
+	defer o.lock.Unlock()
+
+	o.Kubelet = kubeletChanges{}
+	return o.persist()
+}
+
 func (o *operatingSystemConfigChanges) completedContainerdRegistriesDesired(upstream string) error {
 	o.lock.Lock()
 	defer o.lock.Unlock()
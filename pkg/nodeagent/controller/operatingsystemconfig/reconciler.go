@@ -14,6 +14,7 @@ import (
 	"path"
 	"path/filepath"
 	"slices"
+	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -71,12 +72,13 @@ type Reconciler struct {
 	Extractor     registry.Extractor
 	CancelContext context.CancelFunc
 	HostName      string
+	MachineName   string
 	NodeName      string
 }
 
 // Reconcile decodes the OperatingSystemConfig resources from secrets and applies the systemd units and files to the
 // node.
-func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (result reconcile.Result, err error) {
 	log := logf.FromContext(ctx)
 
 	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
@@ -102,6 +104,15 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{}, nil
 	}
 
+	if node == nil {
+		// The logging agents do not run before the node has been registered, hence failures are reported via events.
+		defer func() {
+			if err != nil {
+				r.ReportBootstrapFailure(ctx, log, err)
+			}
+		}()
+	}
+
 	osc, oscChecksum, err := extractOSCFromSecret(secret)
 	if err != nil {
 		return reconcile.Result{}, fmt.Errorf("failed extracting OSC from secret: %w", err)
@@ -118,6 +129,13 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	}
 
 	if node != nil && node.Annotations[nodeagentconfigv1alpha1.AnnotationKeyChecksumAppliedOperatingSystemConfig] == oscChecksum {
+		if inPlaceUpdatePending(node) {
+			log.Info("Configuration on this node is up to date, completing in-place update")
+			patch := client.MergeFrom(node.DeepCopy())
+			completeInPlaceUpdate(node)
+			return reconcile.Result{}, r.Client.Patch(ctx, node, patch)
+		}
+
 		log.Info("Configuration on this node is up to date, nothing to be done")
 		return reconcile.Result{}, nil
 	}
@@ -178,6 +196,19 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{}, fmt.Errorf("failed removing deleted files: %w", err)
 	}
 
+	if oscChanges.Kubelet.LiveReconfiguration && node != nil {
+		log.Info("Verifying node readiness after live reconfiguration of kubelet", "changedFields", oscChanges.Kubelet.ChangedFields)
+		if err := r.waitForNodeReadinessAfterKubeletRestart(ctx, log, node, oscChanges.Kubelet.RestartTime); err != nil {
+			r.Recorder.Eventf(node, corev1.EventTypeWarning, "KubeletReconfigurationFailed", "Node did not become ready after live reconfiguration of kubelet: %v", err)
+			return reconcile.Result{}, fmt.Errorf("failed waiting for node readiness after live reconfiguration of kubelet: %w", err)
+		}
+
+		r.Recorder.Eventf(node, corev1.EventTypeNormal, "KubeletReconfigured", "Kubelet has been reconfigured live without node roll (changed fields: %s)", strings.Join(oscChanges.Kubelet.ChangedFields, ", "))
+		if err := oscChanges.completedKubeletLiveReconfiguration(); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
 	log.Info("Successfully applied operating system config")
 
 	log.Info("Persisting current operating system config as 'last-applied' file to the disk", "path", lastAppliedOperatingSystemConfigFilePath)
@@ -216,10 +247,26 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	patch := client.MergeFrom(node.DeepCopy())
 	metav1.SetMetaDataLabel(&node.ObjectMeta, v1beta1constants.LabelWorkerKubernetesVersion, r.Config.KubernetesVersion.String())
 	metav1.SetMetaDataAnnotation(&node.ObjectMeta, nodeagentconfigv1alpha1.AnnotationKeyChecksumAppliedOperatingSystemConfig, oscChecksum)
+	if inPlaceUpdatePending(node) {
+		completeInPlaceUpdate(node)
+	}
 
 	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, r.Client.Patch(ctx, node, patch)
 }
 
+// inPlaceUpdatePending returns true if the worker actuator has selected the node for an in-place update which has not
+// yet been reported as applied.
+func inPlaceUpdatePending(node *corev1.Node) bool {
+	desiredHash := node.Annotations[v1beta1constants.AnnotationInPlaceUpdateDesiredHash]
+	return desiredHash != "" && desiredHash != node.Annotations[v1beta1constants.AnnotationInPlaceUpdateCurrentHash]
+}
+
+// completeInPlaceUpdate reports the in-place update of the node as applied. It must only be called once the current
+// operating system config has been applied successfully.
+func completeInPlaceUpdate(node *corev1.Node) {
+	metav1.SetMetaDataAnnotation(&node.ObjectMeta, v1beta1constants.AnnotationInPlaceUpdateCurrentHash, node.Annotations[v1beta1constants.AnnotationInPlaceUpdateDesiredHash])
+}
+
 func (r *Reconciler) getNode(ctx context.Context) (*corev1.Node, bool, error) {
 	if r.NodeName != "" {
 		node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: r.NodeName}}
@@ -497,6 +544,11 @@ func (r *Reconciler) executeUnitCommands(ctx context.Context, log logr.Logger, n
 		fns []flow.TaskFn
 
 		restart = func(ctx context.Context, unitName string) error {
+			if unitName == v1beta1constants.OperatingSystemConfigUnitNameKubeletService && oscChanges.Kubelet.LiveReconfiguration {
+				if err := oscChanges.setKubeletRestartTime(metav1.Now()); err != nil {
+					return err
+				}
+			}
 			if err := r.DBus.Restart(ctx, r.Recorder, node, unitName); err != nil {
 				return fmt.Errorf("unable to restart unit %q: %w", unitName, err)
 			}
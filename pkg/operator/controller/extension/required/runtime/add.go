@@ -45,6 +45,7 @@ var runtimeClusterExtensions = []extension{
 	{objectKind: extensionsv1alpha1.BackupBucketResource, object: &extensionsv1alpha1.BackupBucket{}, newObjectListFunc: func() client.ObjectList { return &extensionsv1alpha1.BackupBucketList{} }},
 	{objectKind: extensionsv1alpha1.DNSRecordResource, object: &extensionsv1alpha1.DNSRecord{}, newObjectListFunc: func() client.ObjectList { return &extensionsv1alpha1.DNSRecordList{} }},
 	{objectKind: extensionsv1alpha1.ExtensionResource, object: &extensionsv1alpha1.Extension{}, newObjectListFunc: func() client.ObjectList { return &extensionsv1alpha1.ExtensionList{} }},
+	{objectKind: extensionsv1alpha1.WorkerResource, object: &extensionsv1alpha1.Worker{}, newObjectListFunc: func() client.ObjectList { return &extensionsv1alpha1.WorkerList{} }},
 }
 
 // AddToManager adds Reconciler to the given manager.
@@ -59,7 +59,9 @@ import (
 	kubeapiserverexposure "github.com/gardener/gardener/pkg/component/kubernetes/apiserverexposure"
 	kubecontrollermanager "github.com/gardener/gardener/pkg/component/kubernetes/controllermanager"
 	"github.com/gardener/gardener/pkg/component/networking/istio"
+	"github.com/gardener/gardener/pkg/component/nodemanagement/machinecontrollermanager"
 	"github.com/gardener/gardener/pkg/component/observability/logging"
+	"github.com/gardener/gardener/pkg/component/observability/logging/eventlogger"
 	"github.com/gardener/gardener/pkg/component/observability/logging/fluentcustomresources"
 	"github.com/gardener/gardener/pkg/component/observability/logging/fluentoperator"
 	"github.com/gardener/gardener/pkg/component/observability/monitoring/alertmanager"
@@ -78,6 +80,7 @@ import (
 	"github.com/gardener/gardener/pkg/logger"
 	"github.com/gardener/gardener/pkg/utils"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 	secretsutils "github.com/gardener/gardener/pkg/utils/secrets"
 	secretsmanager "github.com/gardener/gardener/pkg/utils/secrets/manager"
@@ -90,6 +93,7 @@ type components struct {
 	istioCRD      component.Deployer
 	fluentCRD     component.Deployer
 	extensionCRD  component.Deployer
+	machineCRD    component.Deployer
 	prometheusCRD component.DeployWaiter
 
 	gardenerResourceManager component.DeployWaiter
@@ -99,6 +103,8 @@ type components struct {
 	istio                   istio.Interface
 	nginxIngressController  component.DeployWaiter
 
+	machineControllerManager component.DeployWaiter
+
 	etcdMain                             etcd.Interface
 	etcdEvents                           etcd.Interface
 	kubeAPIServerService                 component.DeployWaiter
@@ -126,6 +132,7 @@ type components struct {
 	fluentOperatorCustomResources component.DeployWaiter
 	plutono                       plutono.Interface
 	vali                          component.Deployer
+	eventLogger                   component.Deployer
 	prometheusOperator            component.DeployWaiter
 	alertManager                  alertmanager.Interface
 	prometheusGarden              prometheus.Interface
@@ -161,6 +168,7 @@ func (r *Reconciler) instantiateComponents(
 		return
 	}
 	c.extensionCRD = extensioncrds.NewCRD(applier, true, false)
+	c.machineCRD = machinecontrollermanager.NewCRD(r.RuntimeClientSet.Client(), applier)
 
 	// garden system components
 	c.gardenerResourceManager, err = r.newGardenerResourceManager(garden, secretsManager)
@@ -184,6 +192,10 @@ func (r *Reconciler) instantiateComponents(
 	if err != nil {
 		return
 	}
+	c.machineControllerManager, err = r.newMachineControllerManager(secretsManager)
+	if err != nil {
+		return
+	}
 
 	// virtual garden control plane components
 	c.etcdMain, err = r.newEtcd(log, garden, secretsManager, v1beta1constants.ETCDRoleMain, etcd.ClassImportant)
@@ -276,6 +288,10 @@ func (r *Reconciler) instantiateComponents(
 	if err != nil {
 		return
 	}
+	c.eventLogger, err = r.newEventLogger(garden, secretsManager)
+	if err != nil {
+		return
+	}
 	c.plutono, err = r.newPlutono(secretsManager, primaryIngressDomain.Name, wildcardCertSecretName)
 	if err != nil {
 		return
@@ -381,6 +397,7 @@ func (r *Reconciler) newVirtualGardenGardenerResourceManager(secretsManager secr
 		[]string{v1beta1constants.GardenNamespace, metav1.NamespaceSystem, gardencorev1beta1.GardenerShootIssuerNamespace},
 		nil,
 		false,
+		nil,
 	)
 }
 
@@ -411,6 +428,20 @@ func (r *Reconciler) newEtcdDruid(secretsManager secretsmanager.Interface) (comp
 	)
 }
 
+func (r *Reconciler) newMachineControllerManager(secretsManager secretsmanager.Interface) (component.DeployWaiter, error) {
+	image, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameMachineControllerManager, imagevectorutils.RuntimeVersion(r.RuntimeVersion.String()), imagevectorutils.TargetVersion(r.RuntimeVersion.String()))
+	if err != nil {
+		return nil, err
+	}
+
+	return machinecontrollermanager.New(r.RuntimeClientSet.Client(), namespaceRuntimeWorkers, secretsManager, machinecontrollermanager.Values{
+		Image:                    image.String(),
+		Replicas:                 1,
+		RuntimeKubernetesVersion: r.RuntimeVersion,
+		ManagesRuntimeCluster:    true,
+	}), nil
+}
+
 func (r *Reconciler) newRuntimeSystem() component.DeployWaiter {
 	return runtimegardensystem.New(r.RuntimeClientSet.Client(), r.GardenNamespace)
 }
@@ -1087,6 +1118,17 @@ func (r *Reconciler) newGardenerDashboard(garden *operatorv1alpha1.Garden, secre
 				IssuerURL:      ptr.Deref(garden.Spec.VirtualCluster.Gardener.Dashboard.OIDC.IssuerURL, *garden.Spec.VirtualCluster.Kubernetes.KubeAPIServer.OIDCConfig.IssuerURL),
 				ClientIDPublic: ptr.Deref(garden.Spec.VirtualCluster.Gardener.Dashboard.OIDC.ClientIDPublic, *garden.Spec.VirtualCluster.Kubernetes.KubeAPIServer.OIDCConfig.ClientID),
 			}
+
+			if values.OIDC.CABundle == nil && garden.Spec.VirtualCluster.Kubernetes.KubeAPIServer.OIDCConfig != nil {
+				values.OIDC.CABundle = garden.Spec.VirtualCluster.Kubernetes.KubeAPIServer.OIDCConfig.CABundle
+			}
+		}
+
+		if config.Ingress != nil {
+			values.Ingress.Annotations = config.Ingress.Annotations
+			if config.Ingress.TLSSecretRef != nil {
+				values.Ingress.TLSSecretName = &config.Ingress.TLSSecretRef.Name
+			}
 		}
 
 		values.GitHub = config.GitHub
@@ -1166,6 +1208,31 @@ func (r *Reconciler) newVali() (component.Deployer, error) {
 	)
 }
 
+func (r *Reconciler) newEventLogger(garden *operatorv1alpha1.Garden, secretsManager secretsmanager.Interface) (component.Deployer, error) {
+	image, err := imagevector.Containers().FindImage(imagevector.ContainerImageNameEventLogger)
+	if err != nil {
+		return nil, err
+	}
+
+	targetEventNamespaces := []string{metav1.NamespaceDefault, v1beta1constants.GardenNamespace}
+	if eventLogging := garden.Spec.VirtualCluster.EventLogging; eventLogging != nil && len(eventLogging.Namespaces) > 0 {
+		targetEventNamespaces = eventLogging.Namespaces
+	}
+
+	return eventlogger.New(
+		r.RuntimeClientSet.Client(),
+		r.GardenNamespace,
+		secretsManager,
+		eventlogger.Values{
+			Image:                 image.String(),
+			Replicas:              1,
+			PriorityClassName:     v1beta1constants.PriorityClassNameGardenSystem100,
+			NamePrefix:            namePrefix,
+			TargetEventNamespaces: targetEventNamespaces,
+		},
+	)
+}
+
 func (r *Reconciler) newPrometheusOperator() (component.DeployWaiter, error) {
 	return sharedcomponent.NewPrometheusOperator(
 		r.RuntimeClientSet.Client(),
@@ -97,6 +97,10 @@ func (r *Reconciler) delete(
 			},
 		})
 
+		destroyEventLogger = g.Add(flow.Task{
+			Name: "Destroying event-logger",
+			Fn:   c.eventLogger.Destroy,
+		})
 		destroyGardenerDiscoveryServer = g.Add(flow.Task{
 			Name: "Destroying Gardener Discovery Server",
 			Fn:   component.OpDestroyAndWait(c.gardenerDiscoveryServer).Destroy,
@@ -131,6 +135,7 @@ func (r *Reconciler) delete(
 			Dependencies: flow.NewTaskIDs(destroyGardenerAPIServer),
 		})
 		syncPointVirtualGardenManagedResourcesDestroyed = flow.NewTaskIDs(
+			destroyEventLogger,
 			destroyGardenerDiscoveryServer,
 			destroyTerminalControllerManager,
 			destroyGardenerDashboard,
@@ -246,6 +251,18 @@ func (r *Reconciler) delete(
 			SkipIf:       garden.Spec.VirtualCluster.ETCD == nil || garden.Spec.VirtualCluster.ETCD.Main == nil || garden.Spec.VirtualCluster.ETCD.Main.Backup == nil,
 			Dependencies: flow.NewTaskIDs(syncPointVirtualGardenControlPlaneDestroyed),
 		})
+		destroyRuntimeWorker = g.Add(flow.Task{
+			Name: "Destroying worker pools of runtime cluster",
+			Fn: func(ctx context.Context) error {
+				return r.destroyRuntimeWorkers(ctx, log, garden, c.machineControllerManager, false)
+			},
+			Dependencies: flow.NewTaskIDs(syncPointVirtualGardenControlPlaneDestroyed),
+		})
+		destroyMachineCRD = g.Add(flow.Task{
+			Name:         "Destroying machine-related custom resource definitions",
+			Fn:           component.OpDestroyAndWait(c.machineCRD).Destroy,
+			Dependencies: flow.NewTaskIDs(destroyRuntimeWorker),
+		})
 		destroyEtcdDruid = g.Add(flow.Task{
 			Name:         "Destroying ETCD Druid",
 			Fn:           component.OpDestroyAndWait(c.etcdDruid).Destroy,
@@ -294,6 +311,8 @@ func (r *Reconciler) delete(
 		syncPointCleanedUp = flow.NewTaskIDs(
 			destroyDNSRecords,
 			destroyMainETCDBackupBucket,
+			destroyRuntimeWorker,
+			destroyMachineCRD,
 			destroyEtcdDruid,
 			destroyIstio,
 			destroyVerticalPodAutoscaler,
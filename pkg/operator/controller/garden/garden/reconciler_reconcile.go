@@ -278,6 +278,20 @@ func (r *Reconciler) reconcile(
 				!hasExtensionForBackupBucket,
 			Dependencies: flow.NewTaskIDs(deployExtensionCRD),
 		})
+		deployMachineCRD = g.Add(flow.Task{
+			Name: "Deploying machine-related custom resource definitions",
+			Fn:   c.machineCRD.Deploy,
+		})
+		_ = g.Add(flow.Task{
+			Name: "Reconciling worker pools of runtime cluster",
+			Fn: func(ctx context.Context) error {
+				if garden.Spec.RuntimeCluster.Workers == nil {
+					return r.destroyRuntimeWorkers(ctx, log, garden, c.machineControllerManager, true)
+				}
+				return r.deployRuntimeWorkers(ctx, log, garden, c.machineControllerManager)
+			},
+			Dependencies: flow.NewTaskIDs(deployGardenerResourceManager, deployExtensionCRD, deployMachineCRD, deployPrometheusCRD),
+		})
 		deployEtcds = g.Add(flow.Task{
 			Name:         "Deploying main and events ETCDs of virtual garden",
 			Fn:           r.deployEtcdsFunc(garden, c.etcdMain, c.etcdEvents, backupBucket),
@@ -528,6 +542,16 @@ func (r *Reconciler) reconcile(
 			Fn:           c.vali.Deploy,
 			Dependencies: flow.NewTaskIDs(deployGardenerResourceManager),
 		})
+		_ = g.Add(flow.Task{
+			Name: "Reconciling event-logger",
+			Fn: func(ctx context.Context) error {
+				if !helper.EventLoggingEnabled(garden) {
+					return c.eventLogger.Destroy(ctx)
+				}
+				return c.eventLogger.Deploy(ctx)
+			},
+			Dependencies: flow.NewTaskIDs(deployGardenerResourceManager, deployVirtualGardenGardenerResourceManager, waitUntilKubeAPIServerIsReady),
+		})
 
 		_ = g.Add(flow.Task{
 			Name:         "Deploying prometheus-operator",
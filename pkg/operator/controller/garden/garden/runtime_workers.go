@@ -0,0 +1,351 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package garden
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	operatorv1alpha1 "github.com/gardener/gardener/pkg/apis/operator/v1alpha1"
+	"github.com/gardener/gardener/pkg/component"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/extensions"
+	"github.com/gardener/gardener/pkg/utils"
+)
+
+// namespaceRuntimeWorkers is the namespace containing the Worker and Cluster extension resources and the
+// machine-controller-manager managing the worker pools of the runtime cluster. A dedicated namespace is used since it
+// must be labeled with the provider type so that the control plane webhook of the provider extension injects its
+// machine-controller-manager sidecar. The garden namespace must not carry this label, otherwise the webhook would
+// mutate the control plane components of the virtual garden cluster as well.
+const namespaceRuntimeWorkers = "garden-runtime-workers"
+
+func (r *Reconciler) deployRuntimeWorkers(ctx context.Context, log logr.Logger, garden *operatorv1alpha1.Garden, machineControllerManager component.DeployWaiter) error {
+	if garden.Spec.RuntimeCluster.Provider.Region == nil {
+		return fmt.Errorf("no region found in spec.runtimeCluster.provider.region in Garden resource")
+	}
+
+	if err := r.deployRuntimeWorkersNamespace(ctx, garden); err != nil {
+		return fmt.Errorf("failed deploying namespace %s: %w", namespaceRuntimeWorkers, err)
+	}
+
+	if err := r.syncRuntimeWorkersUserDataSecrets(ctx, garden); err != nil {
+		return fmt.Errorf("failed syncing user data secrets: %w", err)
+	}
+
+	if err := r.deployRuntimeWorkersCluster(ctx, garden); err != nil {
+		return fmt.Errorf("failed deploying Cluster resource: %w", err)
+	}
+
+	if err := component.OpWait(machineControllerManager).Deploy(ctx); err != nil {
+		return fmt.Errorf("failed deploying machine-controller-manager: %w", err)
+	}
+
+	worker := runtimeWorker()
+	if err := r.deployRuntimeWorker(ctx, garden, worker); err != nil {
+		return err
+	}
+
+	return extensions.WaitUntilExtensionObjectReady(
+		ctx,
+		r.RuntimeClientSet.Client(),
+		log,
+		worker,
+		extensionsv1alpha1.WorkerResource,
+		5*time.Second,
+		30*time.Second,
+		10*time.Minute,
+		nil,
+	)
+}
+
+// destroyRuntimeWorkers deletes the worker pools of the runtime cluster together with the machine-controller-manager,
+// the Cluster resource and the namespace. If requireConfirmation is true, an existing Worker is only deleted when the
+// Garden is annotated with the deletion confirmation for the runtime workers.
+func (r *Reconciler) destroyRuntimeWorkers(ctx context.Context, log logr.Logger, garden *operatorv1alpha1.Garden, machineControllerManager component.DeployWaiter, requireConfirmation bool) error {
+	worker := runtimeWorker()
+
+	if requireConfirmation && garden.Annotations[operatorv1alpha1.AnnotationConfirmationRuntimeWorkersDeletion] != "true" {
+		if err := r.RuntimeClientSet.Client().Get(ctx, client.ObjectKeyFromObject(worker), worker); err == nil {
+			return fmt.Errorf("worker pools of runtime cluster are no longer configured but their deletion is not confirmed, annotate the Garden with %s=true to delete them and all their nodes", operatorv1alpha1.AnnotationConfirmationRuntimeWorkersDeletion)
+		} else if !apierrors.IsNotFound(err) && !meta.IsNoMatchError(err) {
+			return err
+		}
+	}
+
+	if err := extensions.DeleteExtensionObject(ctx, r.RuntimeClientSet.Client(), worker); err != nil {
+		return err
+	}
+
+	if err := extensions.WaitUntilExtensionObjectDeleted(
+		ctx,
+		r.RuntimeClientSet.Client(),
+		log,
+		worker,
+		extensionsv1alpha1.WorkerResource,
+		5*time.Second,
+		10*time.Minute,
+	); err != nil {
+		return err
+	}
+
+	if err := component.OpDestroyAndWait(machineControllerManager).Destroy(ctx); err != nil {
+		return fmt.Errorf("failed destroying machine-controller-manager: %w", err)
+	}
+
+	if err := client.IgnoreNotFound(r.RuntimeClientSet.Client().Delete(ctx, &extensionsv1alpha1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: namespaceRuntimeWorkers}})); err != nil {
+		return fmt.Errorf("failed deleting Cluster resource: %w", err)
+	}
+
+	if err := client.IgnoreNotFound(r.RuntimeClientSet.Client().Delete(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: namespaceRuntimeWorkers}})); err != nil {
+		return fmt.Errorf("failed deleting namespace %s: %w", namespaceRuntimeWorkers, err)
+	}
+
+	if _, ok := garden.Annotations[operatorv1alpha1.AnnotationConfirmationRuntimeWorkersDeletion]; ok && garden.DeletionTimestamp == nil {
+		patch := client.MergeFrom(garden.DeepCopy())
+		delete(garden.Annotations, operatorv1alpha1.AnnotationConfirmationRuntimeWorkersDeletion)
+		if err := r.RuntimeClientSet.Client().Patch(ctx, garden, patch); err != nil {
+			return fmt.Errorf("failed removing %s annotation: %w", operatorv1alpha1.AnnotationConfirmationRuntimeWorkersDeletion, err)
+		}
+	}
+
+	return nil
+}
+
+func (r *Reconciler) deployRuntimeWorkersNamespace(ctx context.Context, garden *operatorv1alpha1.Garden) error {
+	namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: namespaceRuntimeWorkers}}
+	_, err := controllerutils.GetAndCreateOrMergePatch(ctx, r.RuntimeClientSet.Client(), namespace, func() error {
+		metav1.SetMetaDataLabel(&namespace.ObjectMeta, v1beta1constants.LabelShootProvider, garden.Spec.RuntimeCluster.Workers.Type)
+		return nil
+	})
+	return err
+}
+
+// syncRuntimeWorkersUserDataSecrets copies the user data secrets referenced by the worker pools from the garden
+// namespace into the namespace of the Worker resource.
+func (r *Reconciler) syncRuntimeWorkersUserDataSecrets(ctx context.Context, garden *operatorv1alpha1.Garden) error {
+	names := sets.New[string]()
+	for _, pool := range garden.Spec.RuntimeCluster.Workers.Pools {
+		names.Insert(pool.UserDataSecretRef.Name)
+	}
+
+	for _, name := range sets.List(names) {
+		source := &corev1.Secret{}
+		if err := r.RuntimeClientSet.Client().Get(ctx, client.ObjectKey{Name: name, Namespace: r.GardenNamespace}, source); err != nil {
+			return err
+		}
+
+		secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespaceRuntimeWorkers}}
+		if _, err := controllerutils.GetAndCreateOrMergePatch(ctx, r.RuntimeClientSet.Client(), secret, func() error {
+			secret.Labels = utils.MergeStringMaps(secret.Labels, map[string]string{labelKeyOrigin: labelValueOperator})
+			secret.Type = source.Type
+			secret.Data = source.Data
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deployRuntimeWorkersCluster deploys the Cluster resource for the namespace of the Worker resource. It is required by
+// the provider extensions and is computed from the runtime cluster configuration since there is no real Shoot, Seed,
+// and CloudProfile for the runtime cluster.
+func (r *Reconciler) deployRuntimeWorkersCluster(ctx context.Context, garden *operatorv1alpha1.Garden) error {
+	var (
+		runtimeCluster    = garden.Spec.RuntimeCluster
+		kubernetesVersion = r.RuntimeVersion.String()
+		machineImages     []gardencorev1beta1.MachineImage
+		workers           []gardencorev1beta1.Worker
+	)
+
+	for _, pool := range runtimeCluster.Workers.Pools {
+		architecture := ptr.Deref(pool.Architecture, v1beta1constants.ArchitectureAMD64)
+
+		machineImages = appendMachineImageVersion(machineImages, pool.MachineImage, architecture)
+		workers = append(workers, gardencorev1beta1.Worker{
+			Name: pool.Name,
+			Machine: gardencorev1beta1.Machine{
+				Type: pool.MachineType,
+				Image: &gardencorev1beta1.ShootMachineImage{
+					Name:    pool.MachineImage.Name,
+					Version: ptr.To(pool.MachineImage.Version),
+				},
+				Architecture: ptr.To(architecture),
+			},
+			Minimum:                          pool.Minimum,
+			Maximum:                          pool.Maximum,
+			MaxSurge:                         pool.MaxSurge,
+			MaxUnavailable:                   pool.MaxUnavailable,
+			Zones:                            pool.Zones,
+			Labels:                           pool.Labels,
+			Annotations:                      pool.Annotations,
+			Taints:                           pool.Taints,
+			Volume:                           pool.Volume,
+			ProviderConfig:                   pool.ProviderConfig,
+			MachineControllerManagerSettings: pool.MachineControllerManagerSettings,
+		})
+	}
+
+	cloudProfile := &gardencorev1beta1.CloudProfile{
+		ObjectMeta: metav1.ObjectMeta{Name: garden.Name},
+		Spec: gardencorev1beta1.CloudProfileSpec{
+			Type:          runtimeCluster.Workers.Type,
+			Kubernetes:    gardencorev1beta1.KubernetesSettings{Versions: []gardencorev1beta1.ExpirableVersion{{Version: kubernetesVersion}}},
+			MachineImages: machineImages,
+			Regions:       []gardencorev1beta1.Region{{Name: *runtimeCluster.Provider.Region}},
+		},
+	}
+
+	seed := &gardencorev1beta1.Seed{
+		ObjectMeta: metav1.ObjectMeta{Name: garden.Name},
+		Spec: gardencorev1beta1.SeedSpec{
+			Provider: gardencorev1beta1.SeedProvider{
+				Type:   runtimeCluster.Workers.Type,
+				Region: *runtimeCluster.Provider.Region,
+				Zones:  runtimeCluster.Provider.Zones,
+			},
+		},
+	}
+
+	shoot := &gardencorev1beta1.Shoot{
+		ObjectMeta: metav1.ObjectMeta{Name: garden.Name, Namespace: r.GardenNamespace},
+		Spec: gardencorev1beta1.ShootSpec{
+			CloudProfileName: ptr.To(cloudProfile.Name),
+			Kubernetes:       gardencorev1beta1.Kubernetes{Version: kubernetesVersion},
+			Networking: &gardencorev1beta1.Networking{
+				Pods:     ptr.To(runtimeCluster.Networking.Pods),
+				Services: ptr.To(runtimeCluster.Networking.Services),
+				Nodes:    runtimeCluster.Networking.Nodes,
+			},
+			Provider: gardencorev1beta1.Provider{
+				Type:    runtimeCluster.Workers.Type,
+				Workers: workers,
+			},
+			Region:   *runtimeCluster.Provider.Region,
+			SeedName: ptr.To(seed.Name),
+		},
+		Status: gardencorev1beta1.ShootStatus{TechnicalID: namespaceRuntimeWorkers},
+	}
+
+	return extensions.SyncClusterResourceToSeed(ctx, r.RuntimeClientSet.Client(), namespaceRuntimeWorkers, shoot, cloudProfile, seed)
+}
+
+func appendMachineImageVersion(machineImages []gardencorev1beta1.MachineImage, image operatorv1alpha1.RuntimeWorkerMachineImage, architecture string) []gardencorev1beta1.MachineImage {
+	for i, machineImage := range machineImages {
+		if machineImage.Name != image.Name {
+			continue
+		}
+
+		for j, version := range machineImage.Versions {
+			if version.Version == image.Version {
+				if !slices.Contains(version.Architectures, architecture) {
+					machineImages[i].Versions[j].Architectures = append(machineImages[i].Versions[j].Architectures, architecture)
+				}
+				return machineImages
+			}
+		}
+
+		machineImages[i].Versions = append(machineImages[i].Versions, newMachineImageVersion(image.Version, architecture))
+		return machineImages
+	}
+
+	return append(machineImages, gardencorev1beta1.MachineImage{
+		Name:     image.Name,
+		Versions: []gardencorev1beta1.MachineImageVersion{newMachineImageVersion(image.Version, architecture)},
+	})
+}
+
+func newMachineImageVersion(version, architecture string) gardencorev1beta1.MachineImageVersion {
+	return gardencorev1beta1.MachineImageVersion{
+		ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: version},
+		Architectures:    []string{architecture},
+	}
+}
+
+func (r *Reconciler) deployRuntimeWorker(ctx context.Context, garden *operatorv1alpha1.Garden, worker *extensionsv1alpha1.Worker) error {
+	workers := garden.Spec.RuntimeCluster.Workers
+
+	pools := make([]extensionsv1alpha1.WorkerPool, 0, len(workers.Pools))
+	for _, pool := range workers.Pools {
+		workerPool := extensionsv1alpha1.WorkerPool{
+			Name:        pool.Name,
+			MachineType: pool.MachineType,
+			MachineImage: extensionsv1alpha1.MachineImage{
+				Name:    pool.MachineImage.Name,
+				Version: pool.MachineImage.Version,
+			},
+			Architecture:                     ptr.To(ptr.Deref(pool.Architecture, v1beta1constants.ArchitectureAMD64)),
+			Minimum:                          pool.Minimum,
+			Maximum:                          pool.Maximum,
+			MaxSurge:                         ptr.Deref(pool.MaxSurge, intstr.FromInt32(1)),
+			MaxUnavailable:                   ptr.Deref(pool.MaxUnavailable, intstr.FromInt32(0)),
+			Zones:                            pool.Zones,
+			Labels:                           pool.Labels,
+			Annotations:                      pool.Annotations,
+			Taints:                           pool.Taints,
+			ProviderConfig:                   pool.ProviderConfig,
+			UserDataSecretRef:                pool.UserDataSecretRef,
+			MachineControllerManagerSettings: pool.MachineControllerManagerSettings,
+		}
+
+		if len(workerPool.Zones) == 0 {
+			workerPool.Zones = garden.Spec.RuntimeCluster.Provider.Zones
+		}
+
+		if pool.Volume != nil {
+			workerPool.Volume = &extensionsv1alpha1.Volume{
+				Name:      pool.Volume.Name,
+				Type:      pool.Volume.Type,
+				Size:      pool.Volume.VolumeSize,
+				Encrypted: pool.Volume.Encrypted,
+			}
+		}
+
+		pools = append(pools, workerPool)
+	}
+
+	_, err := controllerutils.GetAndCreateOrMergePatch(ctx, r.RuntimeClientSet.Client(), worker, func() error {
+		metav1.SetMetaDataAnnotation(&worker.ObjectMeta, v1beta1constants.GardenerOperation, v1beta1constants.GardenerOperationReconcile)
+		metav1.SetMetaDataAnnotation(&worker.ObjectMeta, v1beta1constants.GardenerTimestamp, time.Now().UTC().Format(time.RFC3339Nano))
+
+		worker.Spec = extensionsv1alpha1.WorkerSpec{
+			DefaultSpec: extensionsv1alpha1.DefaultSpec{
+				Type:  workers.Type,
+				Class: ptr.To(extensionsv1alpha1.ExtensionClassGarden),
+			},
+			InfrastructureProviderStatus: workers.InfrastructureProviderStatus,
+			Region:                       *garden.Spec.RuntimeCluster.Provider.Region,
+			SecretRef: corev1.SecretReference{
+				Name:      workers.SecretRef.Name,
+				Namespace: r.GardenNamespace,
+			},
+			Pools: pools,
+		}
+		return nil
+	})
+	return err
+}
+
+// runtimeWorker returns the Worker object managing the node pools of the runtime cluster.
+func runtimeWorker() *extensionsv1alpha1.Worker {
+	return &extensionsv1alpha1.Worker{ObjectMeta: metav1.ObjectMeta{Name: "garden-runtime", Namespace: namespaceRuntimeWorkers}}
+}
@@ -12,6 +12,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	operatorv1alpha1 "github.com/gardener/gardener/pkg/apis/operator/v1alpha1"
 	"github.com/gardener/gardener/pkg/controller/reference"
@@ -52,9 +53,14 @@ func Predicate(oldObj, newObj client.Object) bool {
 		gardenerAPIServerAuditWebhookSecretChanged(oldGarden.Spec.VirtualCluster.Gardener.APIServer, newGarden.Spec.VirtualCluster.Gardener.APIServer) ||
 		kubeAPIServerAdmissionPluginSecretsChanged(oldGarden.Spec.VirtualCluster.Kubernetes.KubeAPIServer, newGarden.Spec.VirtualCluster.Kubernetes.KubeAPIServer) ||
 		gardenerAPIServerAdmissionPluginSecretChanged(oldGarden.Spec.VirtualCluster.Gardener.APIServer, newGarden.Spec.VirtualCluster.Gardener.APIServer) ||
+		kubeAPIServerAdmissionPluginConfigMapsChanged(oldGarden.Spec.VirtualCluster.Kubernetes.KubeAPIServer, newGarden.Spec.VirtualCluster.Kubernetes.KubeAPIServer) ||
+		gardenerAPIServerAdmissionPluginConfigMapsChanged(oldGarden.Spec.VirtualCluster.Gardener.APIServer, newGarden.Spec.VirtualCluster.Gardener.APIServer) ||
 		kubeAPIServerStructuredAuthenticationConfigMapChanged(oldGarden.Spec.VirtualCluster.Kubernetes.KubeAPIServer, newGarden.Spec.VirtualCluster.Kubernetes.KubeAPIServer) ||
 		kubeAPIServerStructuredAuthorizationConfigMapChanged(oldGarden.Spec.VirtualCluster.Kubernetes.KubeAPIServer, newGarden.Spec.VirtualCluster.Kubernetes.KubeAPIServer) ||
-		kubeAPIServerStructuredAuthorizationSecretsChanged(oldGarden.Spec.VirtualCluster.Kubernetes.KubeAPIServer, newGarden.Spec.VirtualCluster.Kubernetes.KubeAPIServer)
+		kubeAPIServerStructuredAuthorizationSecretsChanged(oldGarden.Spec.VirtualCluster.Kubernetes.KubeAPIServer, newGarden.Spec.VirtualCluster.Kubernetes.KubeAPIServer) ||
+		dashboardSecretsChanged(oldGarden.Spec.VirtualCluster.Gardener.Dashboard, newGarden.Spec.VirtualCluster.Gardener.Dashboard) ||
+		dashboardConfigMapsChanged(oldGarden.Spec.VirtualCluster.Gardener.Dashboard, newGarden.Spec.VirtualCluster.Gardener.Dashboard) ||
+		runtimeWorkersSecretsChanged(oldGarden.Spec.RuntimeCluster.Workers, newGarden.Spec.RuntimeCluster.Workers)
 }
 
 func kubeAPIServerAuditPolicyConfigMapChanged(oldKubeAPIServer, newKubeAPIServer *operatorv1alpha1.KubeAPIServerConfig) bool {
@@ -252,6 +258,102 @@ func gardenerAPIServerAdmissionPluginSecretChanged(oldGardenerAPIServer, newGard
 	return !oldSecrets.Equal(newSecrets)
 }
 
+func kubeAPIServerAdmissionPluginConfigMapsChanged(oldKubeAPIServer, newKubeAPIServer *operatorv1alpha1.KubeAPIServerConfig) bool {
+	var oldPlugins, newPlugins []gardencorev1beta1.AdmissionPlugin
+
+	if oldKubeAPIServer != nil && oldKubeAPIServer.KubeAPIServerConfig != nil {
+		oldPlugins = oldKubeAPIServer.AdmissionPlugins
+	}
+	if newKubeAPIServer != nil && newKubeAPIServer.KubeAPIServerConfig != nil {
+		newPlugins = newKubeAPIServer.AdmissionPlugins
+	}
+
+	return !sets.New(admissionPluginConfigMapNames(oldPlugins)...).Equal(sets.New(admissionPluginConfigMapNames(newPlugins)...))
+}
+
+func gardenerAPIServerAdmissionPluginConfigMapsChanged(oldGardenerAPIServer, newGardenerAPIServer *operatorv1alpha1.GardenerAPIServerConfig) bool {
+	var oldPlugins, newPlugins []gardencorev1beta1.AdmissionPlugin
+
+	if oldGardenerAPIServer != nil {
+		oldPlugins = oldGardenerAPIServer.AdmissionPlugins
+	}
+	if newGardenerAPIServer != nil {
+		newPlugins = newGardenerAPIServer.AdmissionPlugins
+	}
+
+	return !sets.New(admissionPluginConfigMapNames(oldPlugins)...).Equal(sets.New(admissionPluginConfigMapNames(newPlugins)...))
+}
+
+func admissionPluginConfigMapNames(plugins []gardencorev1beta1.AdmissionPlugin) []string {
+	var out []string
+	for _, plugin := range plugins {
+		if plugin.ConfigMapName != nil {
+			out = append(out, *plugin.ConfigMapName)
+		}
+	}
+	return out
+}
+
+func dashboardSecretsChanged(oldDashboard, newDashboard *operatorv1alpha1.GardenerDashboardConfig) bool {
+	return !sets.New(dashboardSecretNames(oldDashboard)...).Equal(sets.New(dashboardSecretNames(newDashboard)...))
+}
+
+func dashboardConfigMapsChanged(oldDashboard, newDashboard *operatorv1alpha1.GardenerDashboardConfig) bool {
+	return !sets.New(dashboardConfigMapNames(oldDashboard)...).Equal(sets.New(dashboardConfigMapNames(newDashboard)...))
+}
+
+func dashboardSecretNames(dashboard *operatorv1alpha1.GardenerDashboardConfig) []string {
+	if dashboard == nil {
+		return nil
+	}
+
+	var out []string
+	if dashboard.OIDC != nil {
+		out = append(out, dashboard.OIDC.SecretRef.Name)
+	}
+	if dashboard.GitHub != nil {
+		out = append(out, dashboard.GitHub.SecretRef.Name)
+	}
+	if dashboard.Ingress != nil && dashboard.Ingress.TLSSecretRef != nil {
+		out = append(out, dashboard.Ingress.TLSSecretRef.Name)
+	}
+
+	return out
+}
+
+func dashboardConfigMapNames(dashboard *operatorv1alpha1.GardenerDashboardConfig) []string {
+	if dashboard == nil {
+		return nil
+	}
+
+	var out []string
+	if dashboard.FrontendConfigMapRef != nil {
+		out = append(out, dashboard.FrontendConfigMapRef.Name)
+	}
+	if dashboard.AssetsConfigMapRef != nil {
+		out = append(out, dashboard.AssetsConfigMapRef.Name)
+	}
+
+	return out
+}
+
+func runtimeWorkersSecretsChanged(oldWorkers, newWorkers *operatorv1alpha1.RuntimeWorkers) bool {
+	return !sets.New(runtimeWorkersSecretNames(oldWorkers)...).Equal(sets.New(runtimeWorkersSecretNames(newWorkers)...))
+}
+
+func runtimeWorkersSecretNames(workers *operatorv1alpha1.RuntimeWorkers) []string {
+	if workers == nil {
+		return nil
+	}
+
+	out := []string{workers.SecretRef.Name}
+	for _, pool := range workers.Pools {
+		out = append(out, pool.UserDataSecretRef.Name)
+	}
+
+	return out
+}
+
 func getReferencedSecretNames(obj client.Object) []string {
 	garden, ok := obj.(*operatorv1alpha1.Garden)
 	if !ok {
@@ -311,6 +413,9 @@ func getReferencedSecretNames(obj client.Object) []string {
 		}
 	}
 
+	out = append(out, dashboardSecretNames(virtualCluster.Gardener.Dashboard)...)
+	out = append(out, runtimeWorkersSecretNames(garden.Spec.RuntimeCluster.Workers)...)
+
 	return out
 }
 
@@ -335,11 +440,20 @@ func getReferencedConfigMapNames(obj client.Object) []string {
 		if configMapName := v1beta1helper.GetShootAuthorizationConfigurationConfigMapName(virtualCluster.Kubernetes.KubeAPIServer.KubeAPIServerConfig); configMapName != "" {
 			out = append(out, configMapName)
 		}
+		if virtualCluster.Kubernetes.KubeAPIServer.KubeAPIServerConfig != nil {
+			out = append(out, admissionPluginConfigMapNames(virtualCluster.Kubernetes.KubeAPIServer.AdmissionPlugins)...)
+		}
+	}
+
+	if virtualCluster.Gardener.APIServer != nil {
+		out = append(out, admissionPluginConfigMapNames(virtualCluster.Gardener.APIServer.AdmissionPlugins)...)
 	}
 
 	if virtualCluster.Gardener.APIServer != nil && virtualCluster.Gardener.APIServer.AuditConfig != nil && virtualCluster.Gardener.APIServer.AuditConfig.AuditPolicy != nil && virtualCluster.Gardener.APIServer.AuditConfig.AuditPolicy.ConfigMapRef != nil {
 		out = append(out, virtualCluster.Gardener.APIServer.AuditConfig.AuditPolicy.ConfigMapRef.Name)
 	}
 
+	out = append(out, dashboardConfigMapNames(virtualCluster.Gardener.Dashboard)...)
+
 	return out
 }
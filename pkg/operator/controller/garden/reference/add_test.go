@@ -142,5 +142,41 @@ var _ = Describe("Add", func() {
 			garden.Spec.VirtualCluster.Gardener.APIServer.AdmissionPlugins = []gardencorev1beta1.AdmissionPlugin{{KubeconfigSecretName: ptr.To("foo")}}
 			Expect(Predicate(oldShoot, garden)).To(BeTrue())
 		})
+
+		It("should return true because the kube-apiserver admission plugin config map fields changed", func() {
+			oldShoot := garden.DeepCopy()
+			garden.Spec.VirtualCluster.Kubernetes.KubeAPIServer.AdmissionPlugins = []gardencorev1beta1.AdmissionPlugin{{ConfigMapName: ptr.To("foo")}}
+			Expect(Predicate(oldShoot, garden)).To(BeTrue())
+		})
+
+		It("should return true because the gardener-apiserver admission plugin config map fields changed", func() {
+			oldShoot := garden.DeepCopy()
+			garden.Spec.VirtualCluster.Gardener.APIServer.AdmissionPlugins = []gardencorev1beta1.AdmissionPlugin{{ConfigMapName: ptr.To("foo")}}
+			Expect(Predicate(oldShoot, garden)).To(BeTrue())
+		})
+
+		It("should return true because the gardener-dashboard secret fields changed", func() {
+			oldShoot := garden.DeepCopy()
+			garden.Spec.VirtualCluster.Gardener.Dashboard = &operatorv1alpha1.GardenerDashboardConfig{
+				OIDC:    &operatorv1alpha1.DashboardOIDC{SecretRef: corev1.LocalObjectReference{Name: "oidc"}},
+				Ingress: &operatorv1alpha1.DashboardIngress{TLSSecretRef: &corev1.LocalObjectReference{Name: "tls"}},
+			}
+			Expect(Predicate(oldShoot, garden)).To(BeTrue())
+		})
+
+		It("should return true because the gardener-dashboard config map fields changed", func() {
+			oldShoot := garden.DeepCopy()
+			garden.Spec.VirtualCluster.Gardener.Dashboard = &operatorv1alpha1.GardenerDashboardConfig{FrontendConfigMapRef: &corev1.LocalObjectReference{Name: "frontend"}}
+			Expect(Predicate(oldShoot, garden)).To(BeTrue())
+		})
+
+		It("should return true because the runtime worker secret fields changed", func() {
+			oldShoot := garden.DeepCopy()
+			garden.Spec.RuntimeCluster.Workers = &operatorv1alpha1.RuntimeWorkers{
+				SecretRef: corev1.LocalObjectReference{Name: "cloudprovider"},
+				Pools:     []operatorv1alpha1.RuntimeWorkerPool{{UserDataSecretRef: corev1.SecretKeySelector{LocalObjectReference: corev1.LocalObjectReference{Name: "user-data"}}}},
+			}
+			Expect(Predicate(oldShoot, garden)).To(BeTrue())
+		})
 	})
 })
@@ -113,11 +113,21 @@ func (r *Reconciler) newActuator(gardenlet *seedmanagementv1alpha1.Gardenlet) ga
 			return ""
 		},
 		ApplyGardenletChart: func(ctx context.Context, targetChartApplier kubernetes.ChartApplier, values map[string]interface{}) error {
-			archive, err := r.HelmRegistry.Pull(ctx, &gardenlet.Spec.Deployment.Helm.OCIRepository)
+			pullOpts, err := gardenletdeployer.HelmChartPullOptions(ctx, r.VirtualClient, gardenlet)
+			if err != nil {
+				return fmt.Errorf("failed computing options for pulling Helm chart: %w", err)
+			}
+
+			archive, err := r.HelmRegistry.Pull(ctx, &gardenlet.Spec.Deployment.Helm.OCIRepository, pullOpts...)
 			if err != nil {
 				return fmt.Errorf("failed pulling Helm chart from OCI repository: %w", err)
 			}
 
+			values, err = gardenletdeployer.ApplyRegistrySettingsToChartValues(values, gardenlet.Spec.Deployment.Registry)
+			if err != nil {
+				return fmt.Errorf("failed applying registry settings to chart values: %w", err)
+			}
+
 			return targetChartApplier.ApplyFromArchive(ctx, archive, r.GardenNamespaceTarget, "gardenlet", kubernetes.Values(values))
 		},
 		Clock:                 r.Clock,
@@ -6,6 +6,7 @@ package config
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	componentbaseconfig "k8s.io/component-base/config"
 )
@@ -90,16 +91,22 @@ type ResourceManagerControllerConfiguration struct {
 	GarbageCollector GarbageCollectorControllerConfig
 	// Health is the configuration for the health controller.
 	Health HealthControllerConfig
+	// ImmutableReferences is the configuration for the immutable-references controller.
+	ImmutableReferences ImmutableReferencesControllerConfig
 	// CSRApprover is the configuration for the csr-approver controller.
 	CSRApprover CSRApproverControllerConfig
 	// ManagedResource is the configuration for the managed resource controller.
 	ManagedResource ManagedResourceControllerConfig
+	// NamespaceDefaults is the configuration for the namespace-defaults controller.
+	NamespaceDefaults NamespaceDefaultsControllerConfig
 	// NetworkPolicy is the configuration for the networkpolicy controller.
 	NetworkPolicy NetworkPolicyControllerConfig
 	// NodeCriticalComponents is the configuration for the node critical components controller.
 	NodeCriticalComponents NodeCriticalComponentsControllerConfig
 	// NodeAgentReconciliationDelay is the configuration for the node-agent reconciliation delay controller.
 	NodeAgentReconciliationDelay NodeAgentReconciliationDelayControllerConfig
+	// NodeSystemComponentsReservation is the configuration for the node system components reservation controller.
+	NodeSystemComponentsReservation NodeSystemComponentsReservationControllerConfig
 	// TokenInvalidator is the configuration for the token-invalidator controller.
 	TokenInvalidator TokenInvalidatorControllerConfig
 	// TokenRequestor is the configuration for the token-requestor controller.
@@ -132,6 +139,14 @@ type HealthControllerConfig struct {
 	SyncPeriod *metav1.Duration
 }
 
+// ImmutableReferencesControllerConfig is the configuration for the immutable-references controller.
+type ImmutableReferencesControllerConfig struct {
+	// Enabled defines whether this controller is enabled.
+	Enabled bool
+	// ConcurrentSyncs is the number of concurrent worker routines for this controller.
+	ConcurrentSyncs *int
+}
+
 // ManagedResourceControllerConfig is the configuration for the managed resource controller.
 type ManagedResourceControllerConfig struct {
 	// ConcurrentSyncs is the number of concurrent worker routines for this controller.
@@ -147,6 +162,26 @@ type ManagedResourceControllerConfig struct {
 	ManagedByLabelValue *string
 }
 
+// NamespaceDefaultsControllerConfig is the configuration for the namespace-defaults controller.
+type NamespaceDefaultsControllerConfig struct {
+	// Enabled defines whether this controller is enabled.
+	Enabled bool
+	// ConcurrentSyncs is the number of concurrent worker routines for this controller.
+	ConcurrentSyncs *int
+	// NamespaceSelector is the label selector for namespaces in which the LimitRanges and ResourceQuotas shall be
+	// created. A nil selector means all namespaces.
+	NamespaceSelector *metav1.LabelSelector
+	// LimitRanges is the list of LimitRanges that shall be created in the selected namespaces.
+	LimitRanges []corev1.LimitRange
+	// ResourceQuotas is the list of ResourceQuotas that shall be created in the selected namespaces.
+	ResourceQuotas []corev1.ResourceQuota
+	// NetworkPolicyNamespaceSelector is an additional label selector for namespaces in which the NetworkPolicies shall
+	// be created. A nil selector means all namespaces selected by NamespaceSelector.
+	NetworkPolicyNamespaceSelector *metav1.LabelSelector
+	// NetworkPolicies is the list of NetworkPolicies that shall be created in the selected namespaces.
+	NetworkPolicies []networkingv1.NetworkPolicy
+}
+
 // NetworkPolicyControllerConfig is the configuration for the networkpolicy controller.
 type NetworkPolicyControllerConfig struct {
 	// Enabled defines whether this controller is enabled.
@@ -206,6 +241,13 @@ type NodeAgentReconciliationDelayControllerConfig struct {
 	MaxDelay *metav1.Duration
 }
 
+// NodeSystemComponentsReservationControllerConfig is the configuration for the node system components reservation
+// controller.
+type NodeSystemComponentsReservationControllerConfig struct {
+	// Enabled defines whether this controller is enabled.
+	Enabled bool
+}
+
 // ResourceManagerWebhookConfiguration defines the configuration of the webhooks.
 type ResourceManagerWebhookConfiguration struct {
 	// CRDDeletionProtection is the configuration for the crd-deletion-protection webhook.
@@ -105,6 +105,13 @@ func SetDefaults_GarbageCollectorControllerConfig(obj *GarbageCollectorControlle
 	}
 }
 
+// SetDefaults_NamespaceDefaultsControllerConfig sets defaults for the NamespaceDefaultsControllerConfig object.
+func SetDefaults_NamespaceDefaultsControllerConfig(obj *NamespaceDefaultsControllerConfig) {
+	if obj.Enabled && obj.ConcurrentSyncs == nil {
+		obj.ConcurrentSyncs = ptr.To(5)
+	}
+}
+
 // SetDefaults_NetworkPolicyControllerConfig sets defaults for the NetworkPolicyControllerConfig object.
 func SetDefaults_NetworkPolicyControllerConfig(obj *NetworkPolicyControllerConfig) {
 	if obj.Enabled && obj.ConcurrentSyncs == nil {
@@ -122,6 +129,13 @@ func SetDefaults_HealthControllerConfig(obj *HealthControllerConfig) {
 	}
 }
 
+// SetDefaults_ImmutableReferencesControllerConfig sets defaults for the ImmutableReferencesControllerConfig object.
+func SetDefaults_ImmutableReferencesControllerConfig(obj *ImmutableReferencesControllerConfig) {
+	if obj.Enabled && obj.ConcurrentSyncs == nil {
+		obj.ConcurrentSyncs = ptr.To(5)
+	}
+}
+
 // SetDefaults_ManagedResourceControllerConfig sets defaults for the ManagedResourceControllerConfig object.
 func SetDefaults_ManagedResourceControllerConfig(obj *ManagedResourceControllerConfig) {
 	if obj.ConcurrentSyncs == nil {
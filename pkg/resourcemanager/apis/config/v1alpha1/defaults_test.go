@@ -263,6 +263,37 @@ var _ = Describe("ResourceManager defaulting", func() {
 		})
 	})
 
+	Describe("NamespaceDefaultsConfig defaulting", func() {
+		It("should not default the NamespaceDefaultsConfig because it is disabled", func() {
+			obj.Controllers.NamespaceDefaults = NamespaceDefaultsControllerConfig{}
+
+			SetObjectDefaults_ResourceManagerConfiguration(obj)
+
+			Expect(obj.Controllers.NamespaceDefaults.ConcurrentSyncs).To(BeNil())
+		})
+
+		It("should default the NamespaceDefaultsConfig because it is enabled", func() {
+			obj.Controllers.NamespaceDefaults = NamespaceDefaultsControllerConfig{
+				Enabled: true,
+			}
+
+			SetObjectDefaults_ResourceManagerConfiguration(obj)
+
+			Expect(obj.Controllers.NamespaceDefaults.ConcurrentSyncs).To(PointTo(Equal(5)))
+		})
+
+		It("should not overwrite already set values for NamespaceDefaultsConfig", func() {
+			obj.Controllers.NamespaceDefaults = NamespaceDefaultsControllerConfig{
+				Enabled:         true,
+				ConcurrentSyncs: ptr.To(6),
+			}
+
+			SetObjectDefaults_ResourceManagerConfiguration(obj)
+
+			Expect(obj.Controllers.NamespaceDefaults.ConcurrentSyncs).To(PointTo(Equal(6)))
+		})
+	})
+
 	Describe("NetworkPolicyConfig defaulting", func() {
 		It("should not default the NetworkPolicyConfig because it is disabled", func() {
 			obj.Controllers.NetworkPolicy = NetworkPolicyControllerConfig{}
@@ -346,6 +377,37 @@ var _ = Describe("ResourceManager defaulting", func() {
 		})
 	})
 
+	Describe("ImmutableReferencesControllerConfig defaulting", func() {
+		It("should not default the ImmutableReferencesControllerConfig because it is disabled", func() {
+			obj.Controllers.ImmutableReferences = ImmutableReferencesControllerConfig{}
+
+			SetObjectDefaults_ResourceManagerConfiguration(obj)
+
+			Expect(obj.Controllers.ImmutableReferences.ConcurrentSyncs).To(BeNil())
+		})
+
+		It("should default the ImmutableReferencesControllerConfig because it is enabled", func() {
+			obj.Controllers.ImmutableReferences = ImmutableReferencesControllerConfig{
+				Enabled: true,
+			}
+
+			SetObjectDefaults_ResourceManagerConfiguration(obj)
+
+			Expect(obj.Controllers.ImmutableReferences.ConcurrentSyncs).To(PointTo(Equal(5)))
+		})
+
+		It("should not overwrite already set values for ImmutableReferencesControllerConfig", func() {
+			obj.Controllers.ImmutableReferences = ImmutableReferencesControllerConfig{
+				Enabled:         true,
+				ConcurrentSyncs: ptr.To(2),
+			}
+
+			SetObjectDefaults_ResourceManagerConfiguration(obj)
+
+			Expect(obj.Controllers.ImmutableReferences.ConcurrentSyncs).To(PointTo(Equal(2)))
+		})
+	})
+
 	Describe("TokenInvalidatorControllerConfig defaulting", func() {
 		It("should not default the TokenInvalidatorControllerConfig because it is disabled", func() {
 			obj.Controllers.TokenInvalidator = TokenInvalidatorControllerConfig{}
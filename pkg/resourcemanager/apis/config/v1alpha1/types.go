@@ -6,6 +6,7 @@ package v1alpha1
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
 )
@@ -99,16 +100,22 @@ type ResourceManagerControllerConfiguration struct {
 	GarbageCollector GarbageCollectorControllerConfig `json:"garbageCollector"`
 	// Health is the configuration for the health controller.
 	Health HealthControllerConfig `json:"health"`
+	// ImmutableReferences is the configuration for the immutable-references controller.
+	ImmutableReferences ImmutableReferencesControllerConfig `json:"immutableReferences"`
 	// CSRApprover is the configuration for the csr-approver controller.
 	CSRApprover CSRApproverControllerConfig `json:"csrApprover"`
 	// ManagedResource is the configuration for the managed resource controller.
 	ManagedResource ManagedResourceControllerConfig `json:"managedResource"`
+	// NamespaceDefaults is the configuration for the namespace-defaults controller.
+	NamespaceDefaults NamespaceDefaultsControllerConfig `json:"namespaceDefaults"`
 	// NetworkPolicy is the configuration for the networkpolicy controller.
 	NetworkPolicy NetworkPolicyControllerConfig `json:"networkPolicy"`
 	// NodeCriticalComponents is the configuration for the node critical components controller.
 	NodeCriticalComponents NodeCriticalComponentsControllerConfig `json:"nodeCriticalComponents"`
 	// NodeAgentReconciliationDelay is the configuration for the node-agent reconciliation delay controller.
 	NodeAgentReconciliationDelay NodeAgentReconciliationDelayControllerConfig `json:"nodeAgentReconciliationDelay"`
+	// NodeSystemComponentsReservation is the configuration for the node system components reservation controller.
+	NodeSystemComponentsReservation NodeSystemComponentsReservationControllerConfig `json:"nodeSystemComponentsReservation"`
 	// TokenInvalidator is the configuration for the token-invalidator controller.
 	TokenInvalidator TokenInvalidatorControllerConfig `json:"tokenInvalidator"`
 	// TokenRequestor is the configuration for the token-requestor controller.
@@ -145,6 +152,15 @@ type HealthControllerConfig struct {
 	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
 }
 
+// ImmutableReferencesControllerConfig is the configuration for the immutable-references controller.
+type ImmutableReferencesControllerConfig struct {
+	// Enabled defines whether this controller is enabled.
+	Enabled bool `json:"enabled"`
+	// ConcurrentSyncs is the number of concurrent worker routines for this controller.
+	// +optional
+	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+}
+
 // ManagedResourceControllerConfig is the configuration for the managed resource controller.
 type ManagedResourceControllerConfig struct {
 	// ConcurrentSyncs is the number of concurrent worker routines for this controller.
@@ -164,6 +180,32 @@ type ManagedResourceControllerConfig struct {
 	ManagedByLabelValue *string `json:"managedByLabelValue,omitempty"`
 }
 
+// NamespaceDefaultsControllerConfig is the configuration for the namespace-defaults controller.
+type NamespaceDefaultsControllerConfig struct {
+	// Enabled defines whether this controller is enabled.
+	Enabled bool `json:"enabled"`
+	// ConcurrentSyncs is the number of concurrent worker routines for this controller.
+	// +optional
+	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+	// NamespaceSelector is the label selector for namespaces in which the LimitRanges and ResourceQuotas shall be
+	// created. A nil selector means all namespaces.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+	// LimitRanges is the list of LimitRanges that shall be created in the selected namespaces.
+	// +optional
+	LimitRanges []corev1.LimitRange `json:"limitRanges,omitempty"`
+	// ResourceQuotas is the list of ResourceQuotas that shall be created in the selected namespaces.
+	// +optional
+	ResourceQuotas []corev1.ResourceQuota `json:"resourceQuotas,omitempty"`
+	// NetworkPolicyNamespaceSelector is an additional label selector for namespaces in which the NetworkPolicies shall
+	// be created. A nil selector means all namespaces selected by NamespaceSelector.
+	// +optional
+	NetworkPolicyNamespaceSelector *metav1.LabelSelector `json:"networkPolicyNamespaceSelector,omitempty"`
+	// NetworkPolicies is the list of NetworkPolicies that shall be created in the selected namespaces.
+	// +optional
+	NetworkPolicies []networkingv1.NetworkPolicy `json:"networkPolicies,omitempty"`
+}
+
 // NetworkPolicyControllerConfig is the configuration for the networkpolicy controller.
 type NetworkPolicyControllerConfig struct {
 	// Enabled defines whether this controller is enabled.
@@ -232,6 +274,13 @@ type NodeAgentReconciliationDelayControllerConfig struct {
 	MaxDelay *metav1.Duration `json:"maxDelay,omitempty"`
 }
 
+// NodeSystemComponentsReservationControllerConfig is the configuration for the node system components reservation
+// controller.
+type NodeSystemComponentsReservationControllerConfig struct {
+	// Enabled defines whether this controller is enabled.
+	Enabled bool `json:"enabled"`
+}
+
 // ResourceManagerWebhookConfiguration defines the configuration of the webhooks.
 type ResourceManagerWebhookConfiguration struct {
 	// CRDDeletionProtection is the configuration for the crd-deletion-protection webhook.
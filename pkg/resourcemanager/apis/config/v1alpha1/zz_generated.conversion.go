@@ -14,6 +14,7 @@ import (
 
 	config "github.com/gardener/gardener/pkg/resourcemanager/apis/config"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
@@ -118,6 +119,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ImmutableReferencesControllerConfig)(nil), (*config.ImmutableReferencesControllerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ImmutableReferencesControllerConfig_To_config_ImmutableReferencesControllerConfig(a.(*ImmutableReferencesControllerConfig), b.(*config.ImmutableReferencesControllerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ImmutableReferencesControllerConfig)(nil), (*ImmutableReferencesControllerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ImmutableReferencesControllerConfig_To_v1alpha1_ImmutableReferencesControllerConfig(a.(*config.ImmutableReferencesControllerConfig), b.(*ImmutableReferencesControllerConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*IngressControllerSelector)(nil), (*config.IngressControllerSelector)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_IngressControllerSelector_To_config_IngressControllerSelector(a.(*IngressControllerSelector), b.(*config.IngressControllerSelector), scope)
 	}); err != nil {
@@ -148,6 +159,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*NamespaceDefaultsControllerConfig)(nil), (*config.NamespaceDefaultsControllerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NamespaceDefaultsControllerConfig_To_config_NamespaceDefaultsControllerConfig(a.(*NamespaceDefaultsControllerConfig), b.(*config.NamespaceDefaultsControllerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.NamespaceDefaultsControllerConfig)(nil), (*NamespaceDefaultsControllerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_NamespaceDefaultsControllerConfig_To_v1alpha1_NamespaceDefaultsControllerConfig(a.(*config.NamespaceDefaultsControllerConfig), b.(*NamespaceDefaultsControllerConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*NetworkPolicyControllerConfig)(nil), (*config.NetworkPolicyControllerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_NetworkPolicyControllerConfig_To_config_NetworkPolicyControllerConfig(a.(*NetworkPolicyControllerConfig), b.(*config.NetworkPolicyControllerConfig), scope)
 	}); err != nil {
@@ -188,6 +209,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*NodeSystemComponentsReservationControllerConfig)(nil), (*config.NodeSystemComponentsReservationControllerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NodeSystemComponentsReservationControllerConfig_To_config_NodeSystemComponentsReservationControllerConfig(a.(*NodeSystemComponentsReservationControllerConfig), b.(*config.NodeSystemComponentsReservationControllerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.NodeSystemComponentsReservationControllerConfig)(nil), (*NodeSystemComponentsReservationControllerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_NodeSystemComponentsReservationControllerConfig_To_v1alpha1_NodeSystemComponentsReservationControllerConfig(a.(*config.NodeSystemComponentsReservationControllerConfig), b.(*NodeSystemComponentsReservationControllerConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*PodSchedulerNameWebhookConfig)(nil), (*config.PodSchedulerNameWebhookConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_PodSchedulerNameWebhookConfig_To_config_PodSchedulerNameWebhookConfig(a.(*PodSchedulerNameWebhookConfig), b.(*config.PodSchedulerNameWebhookConfig), scope)
 	}); err != nil {
@@ -541,6 +572,28 @@ func Convert_config_HighAvailabilityConfigWebhookConfig_To_v1alpha1_HighAvailabi
 	return autoConvert_config_HighAvailabilityConfigWebhookConfig_To_v1alpha1_HighAvailabilityConfigWebhookConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_ImmutableReferencesControllerConfig_To_config_ImmutableReferencesControllerConfig(in *ImmutableReferencesControllerConfig, out *config.ImmutableReferencesControllerConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	return nil
+}
+
+// Convert_v1alpha1_ImmutableReferencesControllerConfig_To_config_ImmutableReferencesControllerConfig is an autogenerated conversion function.
+func Convert_v1alpha1_ImmutableReferencesControllerConfig_To_config_ImmutableReferencesControllerConfig(in *ImmutableReferencesControllerConfig, out *config.ImmutableReferencesControllerConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ImmutableReferencesControllerConfig_To_config_ImmutableReferencesControllerConfig(in, out, s)
+}
+
+func autoConvert_config_ImmutableReferencesControllerConfig_To_v1alpha1_ImmutableReferencesControllerConfig(in *config.ImmutableReferencesControllerConfig, out *ImmutableReferencesControllerConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	return nil
+}
+
+// Convert_config_ImmutableReferencesControllerConfig_To_v1alpha1_ImmutableReferencesControllerConfig is an autogenerated conversion function.
+func Convert_config_ImmutableReferencesControllerConfig_To_v1alpha1_ImmutableReferencesControllerConfig(in *config.ImmutableReferencesControllerConfig, out *ImmutableReferencesControllerConfig, s conversion.Scope) error {
+	return autoConvert_config_ImmutableReferencesControllerConfig_To_v1alpha1_ImmutableReferencesControllerConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_IngressControllerSelector_To_config_IngressControllerSelector(in *IngressControllerSelector, out *config.IngressControllerSelector, s conversion.Scope) error {
 	out.Namespace = in.Namespace
 	out.PodSelector = in.PodSelector
@@ -611,6 +664,38 @@ func Convert_config_ManagedResourceControllerConfig_To_v1alpha1_ManagedResourceC
 	return autoConvert_config_ManagedResourceControllerConfig_To_v1alpha1_ManagedResourceControllerConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_NamespaceDefaultsControllerConfig_To_config_NamespaceDefaultsControllerConfig(in *NamespaceDefaultsControllerConfig, out *config.NamespaceDefaultsControllerConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	out.NamespaceSelector = (*v1.LabelSelector)(unsafe.Pointer(in.NamespaceSelector))
+	out.LimitRanges = *(*[]corev1.LimitRange)(unsafe.Pointer(&in.LimitRanges))
+	out.ResourceQuotas = *(*[]corev1.ResourceQuota)(unsafe.Pointer(&in.ResourceQuotas))
+	out.NetworkPolicyNamespaceSelector = (*v1.LabelSelector)(unsafe.Pointer(in.NetworkPolicyNamespaceSelector))
+	out.NetworkPolicies = *(*[]networkingv1.NetworkPolicy)(unsafe.Pointer(&in.NetworkPolicies))
+	return nil
+}
+
+// Convert_v1alpha1_NamespaceDefaultsControllerConfig_To_config_NamespaceDefaultsControllerConfig is an autogenerated conversion function.
+func Convert_v1alpha1_NamespaceDefaultsControllerConfig_To_config_NamespaceDefaultsControllerConfig(in *NamespaceDefaultsControllerConfig, out *config.NamespaceDefaultsControllerConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NamespaceDefaultsControllerConfig_To_config_NamespaceDefaultsControllerConfig(in, out, s)
+}
+
+func autoConvert_config_NamespaceDefaultsControllerConfig_To_v1alpha1_NamespaceDefaultsControllerConfig(in *config.NamespaceDefaultsControllerConfig, out *NamespaceDefaultsControllerConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	out.NamespaceSelector = (*v1.LabelSelector)(unsafe.Pointer(in.NamespaceSelector))
+	out.LimitRanges = *(*[]corev1.LimitRange)(unsafe.Pointer(&in.LimitRanges))
+	out.ResourceQuotas = *(*[]corev1.ResourceQuota)(unsafe.Pointer(&in.ResourceQuotas))
+	out.NetworkPolicyNamespaceSelector = (*v1.LabelSelector)(unsafe.Pointer(in.NetworkPolicyNamespaceSelector))
+	out.NetworkPolicies = *(*[]networkingv1.NetworkPolicy)(unsafe.Pointer(&in.NetworkPolicies))
+	return nil
+}
+
+// Convert_config_NamespaceDefaultsControllerConfig_To_v1alpha1_NamespaceDefaultsControllerConfig is an autogenerated conversion function.
+func Convert_config_NamespaceDefaultsControllerConfig_To_v1alpha1_NamespaceDefaultsControllerConfig(in *config.NamespaceDefaultsControllerConfig, out *NamespaceDefaultsControllerConfig, s conversion.Scope) error {
+	return autoConvert_config_NamespaceDefaultsControllerConfig_To_v1alpha1_NamespaceDefaultsControllerConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_NetworkPolicyControllerConfig_To_config_NetworkPolicyControllerConfig(in *NetworkPolicyControllerConfig, out *config.NetworkPolicyControllerConfig, s conversion.Scope) error {
 	out.Enabled = in.Enabled
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
@@ -707,6 +792,26 @@ func Convert_config_NodeCriticalComponentsControllerConfig_To_v1alpha1_NodeCriti
 	return autoConvert_config_NodeCriticalComponentsControllerConfig_To_v1alpha1_NodeCriticalComponentsControllerConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_NodeSystemComponentsReservationControllerConfig_To_config_NodeSystemComponentsReservationControllerConfig(in *NodeSystemComponentsReservationControllerConfig, out *config.NodeSystemComponentsReservationControllerConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_v1alpha1_NodeSystemComponentsReservationControllerConfig_To_config_NodeSystemComponentsReservationControllerConfig is an autogenerated conversion function.
+func Convert_v1alpha1_NodeSystemComponentsReservationControllerConfig_To_config_NodeSystemComponentsReservationControllerConfig(in *NodeSystemComponentsReservationControllerConfig, out *config.NodeSystemComponentsReservationControllerConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NodeSystemComponentsReservationControllerConfig_To_config_NodeSystemComponentsReservationControllerConfig(in, out, s)
+}
+
+func autoConvert_config_NodeSystemComponentsReservationControllerConfig_To_v1alpha1_NodeSystemComponentsReservationControllerConfig(in *config.NodeSystemComponentsReservationControllerConfig, out *NodeSystemComponentsReservationControllerConfig, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_config_NodeSystemComponentsReservationControllerConfig_To_v1alpha1_NodeSystemComponentsReservationControllerConfig is an autogenerated conversion function.
+func Convert_config_NodeSystemComponentsReservationControllerConfig_To_v1alpha1_NodeSystemComponentsReservationControllerConfig(in *config.NodeSystemComponentsReservationControllerConfig, out *NodeSystemComponentsReservationControllerConfig, s conversion.Scope) error {
+	return autoConvert_config_NodeSystemComponentsReservationControllerConfig_To_v1alpha1_NodeSystemComponentsReservationControllerConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_PodSchedulerNameWebhookConfig_To_config_PodSchedulerNameWebhookConfig(in *PodSchedulerNameWebhookConfig, out *config.PodSchedulerNameWebhookConfig, s conversion.Scope) error {
 	out.Enabled = in.Enabled
 	out.SchedulerName = (*string)(unsafe.Pointer(in.SchedulerName))
@@ -868,12 +973,18 @@ func autoConvert_v1alpha1_ResourceManagerControllerConfiguration_To_config_Resou
 	if err := Convert_v1alpha1_HealthControllerConfig_To_config_HealthControllerConfig(&in.Health, &out.Health, s); err != nil {
 		return err
 	}
+	if err := Convert_v1alpha1_ImmutableReferencesControllerConfig_To_config_ImmutableReferencesControllerConfig(&in.ImmutableReferences, &out.ImmutableReferences, s); err != nil {
+		return err
+	}
 	if err := Convert_v1alpha1_CSRApproverControllerConfig_To_config_CSRApproverControllerConfig(&in.CSRApprover, &out.CSRApprover, s); err != nil {
 		return err
 	}
 	if err := Convert_v1alpha1_ManagedResourceControllerConfig_To_config_ManagedResourceControllerConfig(&in.ManagedResource, &out.ManagedResource, s); err != nil {
 		return err
 	}
+	if err := Convert_v1alpha1_NamespaceDefaultsControllerConfig_To_config_NamespaceDefaultsControllerConfig(&in.NamespaceDefaults, &out.NamespaceDefaults, s); err != nil {
+		return err
+	}
 	if err := Convert_v1alpha1_NetworkPolicyControllerConfig_To_config_NetworkPolicyControllerConfig(&in.NetworkPolicy, &out.NetworkPolicy, s); err != nil {
 		return err
 	}
@@ -883,6 +994,9 @@ func autoConvert_v1alpha1_ResourceManagerControllerConfiguration_To_config_Resou
 	if err := Convert_v1alpha1_NodeAgentReconciliationDelayControllerConfig_To_config_NodeAgentReconciliationDelayControllerConfig(&in.NodeAgentReconciliationDelay, &out.NodeAgentReconciliationDelay, s); err != nil {
 		return err
 	}
+	if err := Convert_v1alpha1_NodeSystemComponentsReservationControllerConfig_To_config_NodeSystemComponentsReservationControllerConfig(&in.NodeSystemComponentsReservation, &out.NodeSystemComponentsReservation, s); err != nil {
+		return err
+	}
 	if err := Convert_v1alpha1_TokenInvalidatorControllerConfig_To_config_TokenInvalidatorControllerConfig(&in.TokenInvalidator, &out.TokenInvalidator, s); err != nil {
 		return err
 	}
@@ -906,12 +1020,18 @@ func autoConvert_config_ResourceManagerControllerConfiguration_To_v1alpha1_Resou
 	if err := Convert_config_HealthControllerConfig_To_v1alpha1_HealthControllerConfig(&in.Health, &out.Health, s); err != nil {
 		return err
 	}
+	if err := Convert_config_ImmutableReferencesControllerConfig_To_v1alpha1_ImmutableReferencesControllerConfig(&in.ImmutableReferences, &out.ImmutableReferences, s); err != nil {
+		return err
+	}
 	if err := Convert_config_CSRApproverControllerConfig_To_v1alpha1_CSRApproverControllerConfig(&in.CSRApprover, &out.CSRApprover, s); err != nil {
 		return err
 	}
 	if err := Convert_config_ManagedResourceControllerConfig_To_v1alpha1_ManagedResourceControllerConfig(&in.ManagedResource, &out.ManagedResource, s); err != nil {
 		return err
 	}
+	if err := Convert_config_NamespaceDefaultsControllerConfig_To_v1alpha1_NamespaceDefaultsControllerConfig(&in.NamespaceDefaults, &out.NamespaceDefaults, s); err != nil {
+		return err
+	}
 	if err := Convert_config_NetworkPolicyControllerConfig_To_v1alpha1_NetworkPolicyControllerConfig(&in.NetworkPolicy, &out.NetworkPolicy, s); err != nil {
 		return err
 	}
@@ -921,6 +1041,9 @@ func autoConvert_config_ResourceManagerControllerConfiguration_To_v1alpha1_Resou
 	if err := Convert_config_NodeAgentReconciliationDelayControllerConfig_To_v1alpha1_NodeAgentReconciliationDelayControllerConfig(&in.NodeAgentReconciliationDelay, &out.NodeAgentReconciliationDelay, s); err != nil {
 		return err
 	}
+	if err := Convert_config_NodeSystemComponentsReservationControllerConfig_To_v1alpha1_NodeSystemComponentsReservationControllerConfig(&in.NodeSystemComponentsReservation, &out.NodeSystemComponentsReservation, s); err != nil {
+		return err
+	}
 	if err := Convert_config_TokenInvalidatorControllerConfig_To_v1alpha1_TokenInvalidatorControllerConfig(&in.TokenInvalidator, &out.TokenInvalidator, s); err != nil {
 		return err
 	}
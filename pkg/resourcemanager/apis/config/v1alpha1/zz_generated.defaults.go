@@ -36,8 +36,10 @@ func SetObjectDefaults_ResourceManagerConfiguration(in *ResourceManagerConfigura
 	SetDefaults_ResourceManagerControllerConfiguration(&in.Controllers)
 	SetDefaults_GarbageCollectorControllerConfig(&in.Controllers.GarbageCollector)
 	SetDefaults_HealthControllerConfig(&in.Controllers.Health)
+	SetDefaults_ImmutableReferencesControllerConfig(&in.Controllers.ImmutableReferences)
 	SetDefaults_CSRApproverControllerConfig(&in.Controllers.CSRApprover)
 	SetDefaults_ManagedResourceControllerConfig(&in.Controllers.ManagedResource)
+	SetDefaults_NamespaceDefaultsControllerConfig(&in.Controllers.NamespaceDefaults)
 	SetDefaults_NetworkPolicyControllerConfig(&in.Controllers.NetworkPolicy)
 	SetDefaults_NodeCriticalComponentsControllerConfig(&in.Controllers.NodeCriticalComponents)
 	SetDefaults_NodeAgentReconciliationDelayControllerConfig(&in.Controllers.NodeAgentReconciliationDelay)
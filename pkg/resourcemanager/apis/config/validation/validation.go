@@ -9,6 +9,7 @@ import (
 
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	componentbaseconfigvalidation "k8s.io/component-base/config/validation"
@@ -101,8 +102,18 @@ func validateResourceManagerControllerConfiguration(conf config.ResourceManagerC
 	allErrs = append(allErrs, validateConcurrentSyncs(conf.Health.ConcurrentSyncs, fldPath.Child("health"))...)
 	allErrs = append(allErrs, validateSyncPeriod(conf.Health.SyncPeriod, fldPath.Child("health"))...)
 
+	if conf.ImmutableReferences.Enabled {
+		allErrs = append(allErrs, validateConcurrentSyncs(conf.ImmutableReferences.ConcurrentSyncs, fldPath.Child("immutableReferences"))...)
+	}
+
 	allErrs = append(allErrs, validateManagedResourceControllerConfiguration(conf.ManagedResource, fldPath.Child("managedResources"))...)
 
+	if conf.NamespaceDefaults.Enabled {
+		allErrs = append(allErrs, validateConcurrentSyncs(conf.NamespaceDefaults.ConcurrentSyncs, fldPath.Child("namespaceDefaults"))...)
+		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(conf.NamespaceDefaults.NamespaceSelector, metav1validation.LabelSelectorValidationOptions{}, fldPath.Child("namespaceDefaults", "namespaceSelector"))...)
+		allErrs = append(allErrs, metav1validation.ValidateLabelSelector(conf.NamespaceDefaults.NetworkPolicyNamespaceSelector, metav1validation.LabelSelectorValidationOptions{}, fldPath.Child("namespaceDefaults", "networkPolicyNamespaceSelector"))...)
+	}
+
 	if conf.TokenRequestor.Enabled {
 		allErrs = append(allErrs, validateConcurrentSyncs(conf.TokenRequestor.ConcurrentSyncs, fldPath.Child("tokenRequestor"))...)
 	}
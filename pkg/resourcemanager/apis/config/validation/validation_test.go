@@ -211,6 +211,20 @@ var _ = Describe("Validation", func() {
 				})
 			})
 
+			Context("immutable references", func() {
+				It("should return errors because concurrent syncs are <= 0", func() {
+					conf.Controllers.ImmutableReferences.Enabled = true
+					conf.Controllers.ImmutableReferences.ConcurrentSyncs = ptr.To(0)
+
+					Expect(ValidateResourceManagerConfiguration(conf)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("controllers.immutableReferences.concurrentSyncs"),
+						})),
+					))
+				})
+			})
+
 			Context("health", func() {
 				It("should return errors because concurrent syncs are <= 0", func() {
 					conf.Controllers.Health.ConcurrentSyncs = ptr.To(0)
@@ -309,6 +323,20 @@ var _ = Describe("Validation", func() {
 				})
 			})
 
+			Context("namespace defaults", func() {
+				It("should return errors because concurrent syncs are <= 0", func() {
+					conf.Controllers.NamespaceDefaults.Enabled = true
+					conf.Controllers.NamespaceDefaults.ConcurrentSyncs = ptr.To(0)
+
+					Expect(ValidateResourceManagerConfiguration(conf)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("controllers.namespaceDefaults.concurrentSyncs"),
+						})),
+					))
+				})
+			})
+
 			Context("node agent reconciliation delay", func() {
 				BeforeEach(func() {
 					conf.Controllers.NodeAgentReconciliationDelay.Enabled = true
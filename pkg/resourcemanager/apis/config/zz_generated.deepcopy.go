@@ -11,6 +11,7 @@ package config
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	componentbaseconfig "k8s.io/component-base/config"
@@ -203,6 +204,27 @@ func (in *HighAvailabilityConfigWebhookConfig) DeepCopy() *HighAvailabilityConfi
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImmutableReferencesControllerConfig) DeepCopyInto(out *ImmutableReferencesControllerConfig) {
+	*out = *in
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImmutableReferencesControllerConfig.
+func (in *ImmutableReferencesControllerConfig) DeepCopy() *ImmutableReferencesControllerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ImmutableReferencesControllerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IngressControllerSelector) DeepCopyInto(out *IngressControllerSelector) {
 	*out = *in
@@ -272,6 +294,58 @@ func (in *ManagedResourceControllerConfig) DeepCopy() *ManagedResourceController
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceDefaultsControllerConfig) DeepCopyInto(out *NamespaceDefaultsControllerConfig) {
+	*out = *in
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(int)
+		**out = **in
+	}
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LimitRanges != nil {
+		in, out := &in.LimitRanges, &out.LimitRanges
+		*out = make([]corev1.LimitRange, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ResourceQuotas != nil {
+		in, out := &in.ResourceQuotas, &out.ResourceQuotas
+		*out = make([]corev1.ResourceQuota, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NetworkPolicyNamespaceSelector != nil {
+		in, out := &in.NetworkPolicyNamespaceSelector, &out.NetworkPolicyNamespaceSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NetworkPolicies != nil {
+		in, out := &in.NetworkPolicies, &out.NetworkPolicies
+		*out = make([]networkingv1.NetworkPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceDefaultsControllerConfig.
+func (in *NamespaceDefaultsControllerConfig) DeepCopy() *NamespaceDefaultsControllerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceDefaultsControllerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkPolicyControllerConfig) DeepCopyInto(out *NetworkPolicyControllerConfig) {
 	*out = *in
@@ -373,6 +447,22 @@ func (in *NodeCriticalComponentsControllerConfig) DeepCopy() *NodeCriticalCompon
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeSystemComponentsReservationControllerConfig) DeepCopyInto(out *NodeSystemComponentsReservationControllerConfig) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeSystemComponentsReservationControllerConfig.
+func (in *NodeSystemComponentsReservationControllerConfig) DeepCopy() *NodeSystemComponentsReservationControllerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeSystemComponentsReservationControllerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PodSchedulerNameWebhookConfig) DeepCopyInto(out *PodSchedulerNameWebhookConfig) {
 	*out = *in
@@ -486,11 +576,14 @@ func (in *ResourceManagerControllerConfiguration) DeepCopyInto(out *ResourceMana
 	}
 	in.GarbageCollector.DeepCopyInto(&out.GarbageCollector)
 	in.Health.DeepCopyInto(&out.Health)
+	in.ImmutableReferences.DeepCopyInto(&out.ImmutableReferences)
 	in.CSRApprover.DeepCopyInto(&out.CSRApprover)
 	in.ManagedResource.DeepCopyInto(&out.ManagedResource)
+	in.NamespaceDefaults.DeepCopyInto(&out.NamespaceDefaults)
 	in.NetworkPolicy.DeepCopyInto(&out.NetworkPolicy)
 	in.NodeCriticalComponents.DeepCopyInto(&out.NodeCriticalComponents)
 	in.NodeAgentReconciliationDelay.DeepCopyInto(&out.NodeAgentReconciliationDelay)
+	out.NodeSystemComponentsReservation = in.NodeSystemComponentsReservation
 	in.TokenInvalidator.DeepCopyInto(&out.TokenInvalidator)
 	in.TokenRequestor.DeepCopyInto(&out.TokenRequestor)
 	return
@@ -22,7 +22,9 @@ import (
 	"github.com/gardener/gardener/pkg/resourcemanager/controller/csrapprover"
 	"github.com/gardener/gardener/pkg/resourcemanager/controller/garbagecollector"
 	"github.com/gardener/gardener/pkg/resourcemanager/controller/health"
+	"github.com/gardener/gardener/pkg/resourcemanager/controller/immutablereferences"
 	"github.com/gardener/gardener/pkg/resourcemanager/controller/managedresource"
+	"github.com/gardener/gardener/pkg/resourcemanager/controller/namespacedefaults"
 	"github.com/gardener/gardener/pkg/resourcemanager/controller/networkpolicy"
 	"github.com/gardener/gardener/pkg/resourcemanager/controller/node"
 	"github.com/gardener/gardener/pkg/resourcemanager/controller/tokeninvalidator"
@@ -58,6 +60,12 @@ func AddToManager(ctx context.Context, mgr manager.Manager, sourceCluster, targe
 		return fmt.Errorf("failed adding health controller: %w", err)
 	}
 
+	if cfg.Controllers.ImmutableReferences.Enabled {
+		if err := immutablereferences.AddToManager(ctx, mgr, targetCluster, cfg.Controllers.ImmutableReferences); err != nil {
+			return fmt.Errorf("failed adding immutable references controller: %w", err)
+		}
+	}
+
 	if err := (&managedresource.Reconciler{
 		Config:                    cfg.Controllers.ManagedResource,
 		ClassFilter:               resourcemanagerpredicate.NewClassFilter(*cfg.Controllers.ResourceClass),
@@ -67,6 +75,14 @@ func AddToManager(ctx context.Context, mgr manager.Manager, sourceCluster, targe
 		return fmt.Errorf("failed adding managed resource controller: %w", err)
 	}
 
+	if cfg.Controllers.NamespaceDefaults.Enabled {
+		if err := (&namespacedefaults.Reconciler{
+			Config: cfg.Controllers.NamespaceDefaults,
+		}).AddToManager(mgr, targetCluster); err != nil {
+			return fmt.Errorf("failed adding namespace defaults controller: %w", err)
+		}
+	}
+
 	if cfg.Controllers.NetworkPolicy.Enabled {
 		if err := (&networkpolicy.Reconciler{
 			Config: cfg.Controllers.NetworkPolicy,
@@ -0,0 +1,141 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package immutablereferences
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/controllerutils/mapper"
+	"github.com/gardener/gardener/pkg/resourcemanager/apis/config"
+	"github.com/gardener/gardener/pkg/resourcemanager/controller/garbagecollector/references"
+)
+
+// ControllerName is the name of the controller.
+const ControllerName = "immutable-references"
+
+// AddToManager adds the immutable-references controllers for all supported workload resources to the given manager.
+func AddToManager(ctx context.Context, mgr manager.Manager, targetCluster cluster.Cluster, cfg config.ImmutableReferencesControllerConfig) error {
+	for _, workload := range []struct {
+		kind              string
+		newObjectFunc     func() client.Object
+		newObjectListFunc func() client.ObjectList
+	}{
+		{"Deployment", func() client.Object { return &appsv1.Deployment{} }, func() client.ObjectList { return &appsv1.DeploymentList{} }},
+		{"StatefulSet", func() client.Object { return &appsv1.StatefulSet{} }, func() client.ObjectList { return &appsv1.StatefulSetList{} }},
+		{"DaemonSet", func() client.Object { return &appsv1.DaemonSet{} }, func() client.ObjectList { return &appsv1.DaemonSetList{} }},
+	} {
+		if err := (&Reconciler{
+			Config:            cfg,
+			NewObjectFunc:     workload.newObjectFunc,
+			NewObjectListFunc: workload.newObjectListFunc,
+		}).AddToManager(ctx, mgr, targetCluster, ControllerName+"-"+strings.ToLower(workload.kind)); err != nil {
+			return fmt.Errorf("failed adding immutable-references reconciler for %s: %w", workload.kind, err)
+		}
+	}
+
+	return nil
+}
+
+// AddToManager adds Reconciler to the given manager.
+func (r *Reconciler) AddToManager(ctx context.Context, mgr manager.Manager, targetCluster cluster.Cluster, controllerName string) error {
+	if r.TargetClient == nil {
+		r.TargetClient = targetCluster.GetClient()
+	}
+	if r.TargetReader == nil {
+		r.TargetReader = targetCluster.GetAPIReader()
+	}
+
+	c, err := builder.
+		ControllerManagedBy(mgr).
+		Named(controllerName).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: ptr.Deref(r.Config.ConcurrentSyncs, 0),
+		}).
+		WatchesRawSource(
+			source.Kind[client.Object](targetCluster.GetCache(),
+				r.NewObjectFunc(),
+				&handler.EnqueueRequestForObject{},
+				r.WorkloadPredicate()),
+		).
+		Build(r)
+	if err != nil {
+		return err
+	}
+
+	for _, kind := range []string{"ConfigMap", "Secret"} {
+		obj := &metav1.PartialObjectMetadata{}
+		obj.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind(kind))
+
+		if err := c.Watch(
+			source.Kind[client.Object](targetCluster.GetCache(),
+				obj,
+				mapper.EnqueueRequestsFrom(ctx, targetCluster.GetCache(), mapper.MapFunc(r.MapReferencedObjectToWorkloads), mapper.UpdateWithNew, c.GetLogger()),
+				r.ReferencedObjectPredicate()),
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// WorkloadPredicate returns a predicate which only admits workload resources which opted in for the conversion of their
+// references.
+func (r *Reconciler) WorkloadPredicate() predicate.Predicate {
+	return predicate.NewPredicateFuncs(isRelevantWorkload)
+}
+
+// ReferencedObjectPredicate returns a predicate for ConfigMaps and Secrets. It filters out those objects which are
+// immutable copies created by this controller (or are otherwise garbage-collectable) since they never change.
+func (r *Reconciler) ReferencedObjectPredicate() predicate.Predicate {
+	isRelevant := func(obj client.Object) bool {
+		return obj.GetLabels()[references.LabelKeyGarbageCollectable] != references.LabelValueGarbageCollectable
+	}
+
+	return predicate.Funcs{
+		CreateFunc:  func(e event.CreateEvent) bool { return isRelevant(e.Object) },
+		UpdateFunc:  func(e event.UpdateEvent) bool { return isRelevant(e.ObjectNew) },
+		DeleteFunc:  func(_ event.DeleteEvent) bool { return false },
+		GenericFunc: func(_ event.GenericEvent) bool { return false },
+	}
+}
+
+// MapReferencedObjectToWorkloads maps a ConfigMap or Secret to all workload resources in the same namespace which
+// opted in for the conversion of their references.
+func (r *Reconciler) MapReferencedObjectToWorkloads(ctx context.Context, log logr.Logger, reader client.Reader, obj client.Object) []reconcile.Request {
+	list := r.NewObjectListFunc()
+	if err := reader.List(ctx, list, client.InNamespace(obj.GetNamespace())); err != nil {
+		log.Error(err, "Failed to list workload resources")
+		return nil
+	}
+
+	return mapper.ObjectListToRequests(list, isRelevantWorkload)
+}
+
+func isRelevantWorkload(obj client.Object) bool {
+	// Objects managed by a ManagedResource are excluded since the managedresource controller would revert the changed
+	// references during its next reconciliation.
+	return obj.GetAnnotations()[resourcesv1alpha1.ImmutableReferencesConvert] == "true" &&
+		obj.GetAnnotations()[resourcesv1alpha1.OriginAnnotation] == ""
+}
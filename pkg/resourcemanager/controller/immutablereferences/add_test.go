@@ -0,0 +1,113 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package immutablereferences_test
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	. "github.com/gardener/gardener/pkg/resourcemanager/controller/immutablereferences"
+)
+
+var _ = Describe("Add", func() {
+	var reconciler *Reconciler
+
+	BeforeEach(func() {
+		reconciler = &Reconciler{
+			NewObjectFunc:     func() client.Object { return &appsv1.Deployment{} },
+			NewObjectListFunc: func() client.ObjectList { return &appsv1.DeploymentList{} },
+		}
+	})
+
+	Describe("#WorkloadPredicate", func() {
+		var (
+			p          predicate.Predicate
+			deployment *appsv1.Deployment
+		)
+
+		BeforeEach(func() {
+			p = reconciler.WorkloadPredicate()
+			deployment = &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{"immutable-references.resources.gardener.cloud/convert": "true"},
+			}}
+		})
+
+		It("should return true when the object opted in", func() {
+			Expect(p.Create(event.CreateEvent{Object: deployment})).To(BeTrue())
+			Expect(p.Update(event.UpdateEvent{ObjectNew: deployment})).To(BeTrue())
+		})
+
+		It("should return false when the object did not opt in", func() {
+			deployment.Annotations = nil
+			Expect(p.Create(event.CreateEvent{Object: deployment})).To(BeFalse())
+			Expect(p.Update(event.UpdateEvent{ObjectNew: deployment})).To(BeFalse())
+		})
+
+		It("should return false when the object is managed by a ManagedResource", func() {
+			deployment.Annotations["resources.gardener.cloud/origin"] = "foo"
+			Expect(p.Create(event.CreateEvent{Object: deployment})).To(BeFalse())
+			Expect(p.Update(event.UpdateEvent{ObjectNew: deployment})).To(BeFalse())
+		})
+	})
+
+	Describe("#ReferencedObjectPredicate", func() {
+		var (
+			p         predicate.Predicate
+			configMap *corev1.ConfigMap
+		)
+
+		BeforeEach(func() {
+			p = reconciler.ReferencedObjectPredicate()
+			configMap = &corev1.ConfigMap{}
+		})
+
+		It("should return true for regular objects", func() {
+			Expect(p.Create(event.CreateEvent{Object: configMap})).To(BeTrue())
+			Expect(p.Update(event.UpdateEvent{ObjectNew: configMap})).To(BeTrue())
+		})
+
+		It("should return false for garbage-collectable objects", func() {
+			configMap.Labels = map[string]string{"resources.gardener.cloud/garbage-collectable-reference": "true"}
+			Expect(p.Create(event.CreateEvent{Object: configMap})).To(BeFalse())
+			Expect(p.Update(event.UpdateEvent{ObjectNew: configMap})).To(BeFalse())
+		})
+
+		It("should return false for delete and generic events", func() {
+			Expect(p.Delete(event.DeleteEvent{Object: configMap})).To(BeFalse())
+			Expect(p.Generic(event.GenericEvent{Object: configMap})).To(BeFalse())
+		})
+	})
+
+	Describe("#MapReferencedObjectToWorkloads", func() {
+		It("should map to all deployments in the namespace which opted in", func() {
+			fakeClient := fakeclient.NewClientBuilder().WithScheme(kubernetesscheme.Scheme).Build()
+
+			for _, deployment := range []*appsv1.Deployment{
+				{ObjectMeta: metav1.ObjectMeta{Name: "opted-in", Namespace: "ns", Annotations: map[string]string{"immutable-references.resources.gardener.cloud/convert": "true"}}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "not-opted-in", Namespace: "ns"}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "other-namespace", Namespace: "other", Annotations: map[string]string{"immutable-references.resources.gardener.cloud/convert": "true"}}},
+			} {
+				Expect(fakeClient.Create(context.TODO(), deployment)).To(Succeed())
+			}
+
+			Expect(reconciler.MapReferencedObjectToWorkloads(context.TODO(), logr.Discard(), fakeClient, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "cm", Namespace: "ns"}})).To(ConsistOf(
+				reconcile.Request{NamespacedName: types.NamespacedName{Name: "opted-in", Namespace: "ns"}},
+			))
+		})
+	})
+})
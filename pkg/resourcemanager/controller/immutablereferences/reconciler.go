@@ -0,0 +1,292 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package immutablereferences
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/resourcemanager/apis/config"
+	"github.com/gardener/gardener/pkg/resourcemanager/controller/garbagecollector/references"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+)
+
+// Reconciler replaces references to mutable ConfigMaps and Secrets in the pod templates of workload resources with
+// references to immutable copies of them. The copies are labeled as garbage-collectable, i.e., they are cleaned up by
+// the garbage-collector controller once they are no longer referenced.
+type Reconciler struct {
+	TargetClient      client.Client
+	TargetReader      client.Reader
+	Config            config.ImmutableReferencesControllerConfig
+	NewObjectFunc     func() client.Object
+	NewObjectListFunc func() client.ObjectList
+}
+
+// Reconcile replaces references to mutable ConfigMaps and Secrets with references to immutable copies.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
+	defer cancel()
+
+	obj := r.NewObjectFunc()
+	if err := r.TargetClient.Get(ctx, request.NamespacedName, obj); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if obj.GetDeletionTimestamp() != nil || !isRelevantWorkload(obj) {
+		log.V(1).Info("Object is being deleted or did not opt in for the conversion of its references, nothing to be done")
+		return reconcile.Result{}, nil
+	}
+
+	patch := client.MergeFromWithOptions(obj.DeepCopyObject().(client.Object), client.MergeFromWithOptimisticLock{})
+
+	podSpec, err := podSpecFor(obj)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	var (
+		secretNames    = map[string]string{}
+		configMapNames = map[string]string{}
+	)
+
+	for _, name := range referencedNames(podSpec, references.KindSecret) {
+		newName, err := r.immutableCopyName(ctx, log, &corev1.Secret{}, obj.GetNamespace(), name)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		if newName != name {
+			secretNames[name] = newName
+		}
+	}
+
+	for _, name := range referencedNames(podSpec, references.KindConfigMap) {
+		newName, err := r.immutableCopyName(ctx, log, &corev1.ConfigMap{}, obj.GetNamespace(), name)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		if newName != name {
+			configMapNames[name] = newName
+		}
+	}
+
+	if len(secretNames) == 0 && len(configMapNames) == 0 {
+		log.V(1).Info("All references already point to immutable objects, nothing to be done")
+		return reconcile.Result{}, nil
+	}
+
+	replaceReferences(podSpec, secretNames, configMapNames)
+	if err := references.InjectAnnotations(obj); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed injecting reference annotations: %w", err)
+	}
+
+	log.Info("Replacing references to mutable objects with references to immutable copies", "secrets", secretNames, "configMaps", configMapNames)
+	if err := r.TargetClient.Patch(ctx, obj, patch); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed replacing references: %w", err)
+	}
+
+	return reconcile.Result{}, nil
+}
+
+// immutableCopyName returns the name of the immutable copy which should be referenced instead of the object with the
+// given name. If necessary, the copy is created. If the object should not be replaced (e.g., because it does not exist
+// or is already immutable) then the given name is returned.
+func (r *Reconciler) immutableCopyName(ctx context.Context, log logr.Logger, obj client.Object, namespace, name string) (string, error) {
+	if err := r.TargetReader.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, obj); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Referenced object not found, skipping it", "kind", fmt.Sprintf("%T", obj), "name", name)
+			return name, nil
+		}
+		return "", fmt.Errorf("failed reading referenced object %s: %w", client.ObjectKey{Namespace: namespace, Name: name}, err)
+	}
+
+	if originName, ok := obj.GetAnnotations()[resourcesv1alpha1.ImmutableReferencesOrigin]; ok {
+		// The referenced object is a copy created earlier, so let's check whether the original object has changed.
+		if err := r.TargetReader.Get(ctx, client.ObjectKey{Namespace: namespace, Name: originName}, obj); err != nil {
+			if apierrors.IsNotFound(err) {
+				log.V(1).Info("Original object of immutable copy not found, keeping the copy", "kind", fmt.Sprintf("%T", obj), "name", name, "origin", originName)
+				return name, nil
+			}
+			return "", fmt.Errorf("failed reading original object %s: %w", client.ObjectKey{Namespace: namespace, Name: originName}, err)
+		}
+	}
+
+	copyObj, ok := newImmutableCopy(obj)
+	if !ok {
+		return obj.GetName(), nil
+	}
+
+	if err := r.TargetClient.Create(ctx, copyObj); err != nil && !apierrors.IsAlreadyExists(err) {
+		return "", fmt.Errorf("failed creating immutable copy of %s: %w", client.ObjectKeyFromObject(obj), err)
+	}
+
+	return copyObj.GetName(), nil
+}
+
+// newImmutableCopy returns an immutable copy of the given ConfigMap or Secret. If the given object is not eligible for
+// being copied (e.g., since it is already immutable) then false is returned.
+func newImmutableCopy(obj client.Object) (client.Object, bool) {
+	var copyObj client.Object
+
+	switch o := obj.(type) {
+	case *corev1.Secret:
+		// Service account token secrets are populated asynchronously by the token controller and must not be copied.
+		if ptr.Deref(o.Immutable, false) || o.Type == corev1.SecretTypeServiceAccountToken {
+			return nil, false
+		}
+		copyObj = &corev1.Secret{
+			ObjectMeta: copyObjectMeta(o.ObjectMeta),
+			Type:       o.Type,
+			Data:       o.Data,
+		}
+
+	case *corev1.ConfigMap:
+		if ptr.Deref(o.Immutable, false) {
+			return nil, false
+		}
+		copyObj = &corev1.ConfigMap{
+			ObjectMeta: copyObjectMeta(o.ObjectMeta),
+			Data:       o.Data,
+			BinaryData: o.BinaryData,
+		}
+
+	default:
+		return nil, false
+	}
+
+	if err := kubernetesutils.MakeUnique(copyObj); err != nil {
+		return nil, false
+	}
+
+	return copyObj, true
+}
+
+func copyObjectMeta(original metav1.ObjectMeta) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:        original.Name,
+		Namespace:   original.Namespace,
+		Annotations: map[string]string{resourcesv1alpha1.ImmutableReferencesOrigin: original.Name},
+	}
+}
+
+func podSpecFor(obj client.Object) (*corev1.PodSpec, error) {
+	switch o := obj.(type) {
+	case *appsv1.Deployment:
+		return &o.Spec.Template.Spec, nil
+	case *appsv1.StatefulSet:
+		return &o.Spec.Template.Spec, nil
+	case *appsv1.DaemonSet:
+		return &o.Spec.Template.Spec, nil
+	}
+
+	return nil, fmt.Errorf("unhandled object type %T", obj)
+}
+
+// referencedNames returns the names of all ConfigMaps or Secrets (depending on the given kind) referenced in volumes or
+// environment variables of the given pod spec.
+func referencedNames(podSpec *corev1.PodSpec, kind string) []string {
+	var (
+		names []string
+		seen  = map[string]struct{}{}
+		add   = func(name string) {
+			if _, ok := seen[name]; !ok && name != "" {
+				seen[name] = struct{}{}
+				names = append(names, name)
+			}
+		}
+	)
+
+	visitReferences(podSpec, func(refKind string, name *string) {
+		if refKind == kind {
+			add(*name)
+		}
+	})
+
+	return names
+}
+
+// replaceReferences replaces the names of referenced ConfigMaps and Secrets in the given pod spec according to the
+// given mappings.
+func replaceReferences(podSpec *corev1.PodSpec, secretNames, configMapNames map[string]string) {
+	visitReferences(podSpec, func(kind string, name *string) {
+		names := configMapNames
+		if kind == references.KindSecret {
+			names = secretNames
+		}
+
+		if newName, ok := names[*name]; ok {
+			*name = newName
+		}
+	})
+}
+
+func visitReferences(podSpec *corev1.PodSpec, visit func(kind string, name *string)) {
+	for i := range podSpec.Volumes {
+		volume := &podSpec.Volumes[i]
+
+		if volume.Secret != nil {
+			visit(references.KindSecret, &volume.Secret.SecretName)
+		}
+		if volume.ConfigMap != nil {
+			visit(references.KindConfigMap, &volume.ConfigMap.Name)
+		}
+		if volume.Projected != nil {
+			for j := range volume.Projected.Sources {
+				source := &volume.Projected.Sources[j]
+
+				if source.Secret != nil {
+					visit(references.KindSecret, &source.Secret.Name)
+				}
+				if source.ConfigMap != nil {
+					visit(references.KindConfigMap, &source.ConfigMap.Name)
+				}
+			}
+		}
+	}
+
+	for _, containers := range [][]corev1.Container{podSpec.InitContainers, podSpec.Containers} {
+		for i := range containers {
+			container := &containers[i]
+
+			for j := range container.EnvFrom {
+				if ref := container.EnvFrom[j].SecretRef; ref != nil {
+					visit(references.KindSecret, &ref.Name)
+				}
+				if ref := container.EnvFrom[j].ConfigMapRef; ref != nil {
+					visit(references.KindConfigMap, &ref.Name)
+				}
+			}
+
+			for j := range container.Env {
+				if container.Env[j].ValueFrom == nil {
+					continue
+				}
+				if ref := container.Env[j].ValueFrom.SecretKeyRef; ref != nil {
+					visit(references.KindSecret, &ref.Name)
+				}
+				if ref := container.Env[j].ValueFrom.ConfigMapKeyRef; ref != nil {
+					visit(references.KindConfigMap, &ref.Name)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,163 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package immutablereferences_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	. "github.com/gardener/gardener/pkg/resourcemanager/controller/immutablereferences"
+	"github.com/gardener/gardener/pkg/utils"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx        = context.TODO()
+		namespace  = "some-namespace"
+		fakeClient client.Client
+		reconciler *Reconciler
+		request    reconcile.Request
+
+		deployment *appsv1.Deployment
+		secret     *corev1.Secret
+		configMap  *corev1.ConfigMap
+
+		secretCopyName    string
+		configMapCopyName string
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetesscheme.Scheme).Build()
+		reconciler = &Reconciler{
+			TargetClient:      fakeClient,
+			TargetReader:      fakeClient,
+			NewObjectFunc:     func() client.Object { return &appsv1.Deployment{} },
+			NewObjectListFunc: func() client.ObjectList { return &appsv1.DeploymentList{} },
+		}
+
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "secret", Namespace: namespace},
+			Type:       corev1.SecretTypeOpaque,
+			Data:       map[string][]byte{"foo": []byte("bar")},
+		}
+		configMap = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "configmap", Namespace: namespace},
+			Data:       map[string]string{"foo": "bar"},
+		}
+		secretCopyName = secret.Name + "-" + utils.ComputeSecretChecksum(secret.Data)[:8]
+		configMapCopyName = configMap.Name + "-" + utils.ComputeSecretChecksum(map[string][]byte{"foo": []byte("bar")})[:8]
+
+		deployment = &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "deployment",
+				Namespace:   namespace,
+				Annotations: map[string]string{"immutable-references.resources.gardener.cloud/convert": "true"},
+			},
+			Spec: appsv1.DeploymentSpec{
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{{
+							Name: "container",
+							EnvFrom: []corev1.EnvFromSource{{
+								ConfigMapRef: &corev1.ConfigMapEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: configMap.Name}},
+							}},
+						}},
+						Volumes: []corev1.Volume{{
+							Name:         "secret",
+							VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: secret.Name}},
+						}},
+					},
+				},
+			},
+		}
+
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(deployment)}
+
+		Expect(fakeClient.Create(ctx, secret)).To(Succeed())
+		Expect(fakeClient.Create(ctx, configMap)).To(Succeed())
+	})
+
+	It("should do nothing if the object is gone", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+	})
+
+	It("should do nothing if the object did not opt in", func() {
+		deployment.Annotations = nil
+		Expect(fakeClient.Create(ctx, deployment)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(deployment), deployment)).To(Succeed())
+		Expect(deployment.Spec.Template.Spec.Volumes[0].Secret.SecretName).To(Equal(secret.Name))
+	})
+
+	It("should create immutable copies and replace the references", func() {
+		Expect(fakeClient.Create(ctx, deployment)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(deployment), deployment)).To(Succeed())
+		Expect(deployment.Spec.Template.Spec.Volumes[0].Secret.SecretName).To(Equal(secretCopyName))
+		Expect(deployment.Spec.Template.Spec.Containers[0].EnvFrom[0].ConfigMapRef.Name).To(Equal(configMapCopyName))
+		Expect(deployment.Spec.Template.Annotations).To(And(
+			HaveKeyWithValue(HavePrefix("reference.resources.gardener.cloud/secret-"), secretCopyName),
+			HaveKeyWithValue(HavePrefix("reference.resources.gardener.cloud/configmap-"), configMapCopyName),
+		))
+
+		secretCopy := &corev1.Secret{}
+		Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: secretCopyName}, secretCopy)).To(Succeed())
+		Expect(secretCopy.Immutable).To(PointTo(BeTrue()))
+		Expect(secretCopy.Data).To(Equal(secret.Data))
+		Expect(secretCopy.Labels).To(HaveKeyWithValue("resources.gardener.cloud/garbage-collectable-reference", "true"))
+		Expect(secretCopy.Annotations).To(HaveKeyWithValue("immutable-references.resources.gardener.cloud/origin", secret.Name))
+
+		configMapCopy := &corev1.ConfigMap{}
+		Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: configMapCopyName}, configMapCopy)).To(Succeed())
+		Expect(configMapCopy.Immutable).To(PointTo(BeTrue()))
+		Expect(configMapCopy.Data).To(Equal(configMap.Data))
+		Expect(configMapCopy.Annotations).To(HaveKeyWithValue("immutable-references.resources.gardener.cloud/origin", configMap.Name))
+	})
+
+	It("should switch to a new copy when the original object changed", func() {
+		Expect(fakeClient.Create(ctx, deployment)).To(Succeed())
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+		secret.Data = map[string][]byte{"foo": []byte("baz")}
+		Expect(fakeClient.Update(ctx, secret)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+		newSecretCopyName := secret.Name + "-" + utils.ComputeSecretChecksum(secret.Data)[:8]
+		Expect(newSecretCopyName).NotTo(Equal(secretCopyName))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(deployment), deployment)).To(Succeed())
+		Expect(deployment.Spec.Template.Spec.Volumes[0].Secret.SecretName).To(Equal(newSecretCopyName))
+		Expect(deployment.Spec.Template.Spec.Containers[0].EnvFrom[0].ConfigMapRef.Name).To(Equal(configMapCopyName))
+	})
+
+	It("should not touch references to already immutable or non-existing objects", func() {
+		secret.Immutable = ptr.To(true)
+		Expect(fakeClient.Update(ctx, secret)).To(Succeed())
+		deployment.Spec.Template.Spec.Containers[0].EnvFrom[0].ConfigMapRef.Name = "non-existing"
+		Expect(fakeClient.Create(ctx, deployment)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(deployment), deployment)).To(Succeed())
+		Expect(deployment.Spec.Template.Spec.Volumes[0].Secret.SecretName).To(Equal(secret.Name))
+		Expect(deployment.Spec.Template.Spec.Containers[0].EnvFrom[0].ConfigMapRef.Name).To(Equal("non-existing"))
+	})
+})
@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package managedresource
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/gardener/gardener/pkg/resourcemanager/metrics"
+)
+
+const (
+	managedResourceSubsystem = "managedresource"
+
+	operationCreated   = "created"
+	operationUpdated   = "updated"
+	operationUnchanged = "unchanged"
+	operationDeleted   = "deleted"
+)
+
+var (
+	metricObjectsApplied = metrics.Factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metrics.Namespace,
+			Subsystem: managedResourceSubsystem,
+			Name:      "objects_applied_total",
+			Help:      "Total number of objects applied to the target cluster per ManagedResource. The value of the label 'operation' is one of 'created', 'updated', 'unchanged' or 'deleted'.",
+		},
+		[]string{
+			"namespace",
+			"name",
+			"operation",
+		},
+	)
+
+	metricApplyDuration = metrics.Factory.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: metrics.Namespace,
+			Subsystem: managedResourceSubsystem,
+			Name:      "apply_duration_seconds",
+			Help:      "Histogram of duration of applying a single object to the target cluster.",
+			// Start with 5ms with the last bucket being [~10s, Inf)
+			Buckets: prometheus.ExponentialBuckets(0.005, 2, 12),
+		},
+		[]string{
+			"group",
+			"kind",
+		},
+	)
+
+	metricConflicts = metrics.Factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metrics.Namespace,
+			Subsystem: managedResourceSubsystem,
+			Name:      "conflicts_total",
+			Help:      "Total number of conflicts when applying objects to the target cluster per ManagedResource.",
+		},
+		[]string{
+			"namespace",
+			"name",
+		},
+	)
+)
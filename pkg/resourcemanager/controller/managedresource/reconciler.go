@@ -347,7 +347,8 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, mr *resourc
 	}
 
 	injectLabels := mergeMaps(mr.Spec.InjectLabels, map[string]string{resourcesv1alpha1.ManagedBy: *r.Config.ManagedByLabelValue})
-	if err := r.applyNewResources(reconcileCtx, log, origin, newResourcesObjects, injectLabels, equivalences); err != nil {
+	resourcesApplied, err := r.applyNewResources(reconcileCtx, log, mr, origin, newResourcesObjects, injectLabels, equivalences)
+	if err != nil {
 		conditionResourcesApplied = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionResourcesApplied, gardencorev1beta1.ConditionFalse, resourcesv1alpha1.ConditionApplyFailed, err.Error())
 		if err := updateConditions(ctx, r.SourceClient, mr, conditionResourcesApplied); err != nil {
 			return reconcile.Result{}, fmt.Errorf("could not update the ManagedResource status: %w", err)
@@ -362,7 +363,13 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, mr *resourc
 		conditionResourcesApplied = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionResourcesApplied, gardencorev1beta1.ConditionTrue, resourcesv1alpha1.ConditionApplySucceeded, "All resources are applied.")
 	}
 
-	if err := updateManagedResourceStatus(ctx, r.SourceClient, mr, &secretsDataChecksum, newResourcesObjectReferences, conditionResourcesApplied); err != nil {
+	for _, oldResource := range existingResourcesIndex.Objects() {
+		if !existingResourcesIndex.Found(oldResource) {
+			resourcesApplied.Deleted++
+		}
+	}
+
+	if err := updateManagedResourceStatus(ctx, r.SourceClient, mr, &secretsDataChecksum, newResourcesObjectReferences, resourcesApplied, conditionResourcesApplied); err != nil {
 		return reconcile.Result{}, fmt.Errorf("could not update the ManagedResource status: %w", err)
 	}
 
@@ -465,15 +472,16 @@ func (r *Reconciler) updateConditionsForDeletion(ctx context.Context, mr *resour
 	return updateConditions(ctx, r.SourceClient, mr, conditionResourcesHealthy, conditionResourcesProgressing)
 }
 
-func (r *Reconciler) applyNewResources(ctx context.Context, log logr.Logger, origin string, newResourcesObjects []object, labelsToInject map[string]string, equivalences Equivalences) error {
+func (r *Reconciler) applyNewResources(ctx context.Context, log logr.Logger, mr *resourcesv1alpha1.ManagedResource, origin string, newResourcesObjects []object, labelsToInject map[string]string, equivalences Equivalences) (*resourcesv1alpha1.ResourcesAppliedSummary, error) {
 	newResourcesObjects = sortByKind(newResourcesObjects)
+	resourcesApplied := &resourcesv1alpha1.ResourcesAppliedSummary{}
 
 	// get all HPA targetRefs to check if we should prevent overwriting replicas.
 	// VPAs don't have to be checked, as they don't update the spec directly and only mutate Pods via a MutatingWebhook
 	// and therefore don't interfere with the resource manager.
 	horizontallyScaledObjects, err := computeHorizontallyScaledObjectKeys(ctx, r.TargetClient)
 	if err != nil {
-		return fmt.Errorf("failed to compute all HPA target ref object keys: %w", err)
+		return nil, fmt.Errorf("failed to compute all HPA target ref object keys: %w", err)
 	}
 
 	for _, obj := range newResourcesObjects {
@@ -487,6 +495,7 @@ func (r *Reconciler) applyNewResources(ctx context.Context, log logr.Logger, ori
 
 		resourceLogger.V(1).Info("Applying")
 
+		applyStartTime := r.Clock.Now()
 		operationResult, err := controllerutils.TypedCreateOrUpdate(ctx, r.TargetClient, r.TargetScheme, current, ptr.Deref(r.Config.AlwaysUpdate, false), func() error {
 			metadata, err := meta.Accessor(obj.obj)
 			if err != nil {
@@ -507,33 +516,42 @@ func (r *Reconciler) applyNewResources(ctx context.Context, log logr.Logger, ori
 
 			return merge(origin, obj.obj, current, obj.forceOverwriteLabels, obj.oldInformation.Labels, obj.forceOverwriteAnnotations, obj.oldInformation.Annotations, scaledHorizontally)
 		})
+		metricApplyDuration.WithLabelValues(obj.obj.GroupVersionKind().Group, obj.obj.GetKind()).Observe(r.Clock.Since(applyStartTime).Seconds())
 		if err != nil {
 			if apierrors.IsConflict(err) {
-				return err
+				metricConflicts.WithLabelValues(mr.Namespace, mr.Name).Inc()
+				return nil, err
 			}
 
 			if apierrors.IsInvalid(err) && operationResult == controllerutil.OperationResultUpdated && deleteOnInvalidUpdate(current, err) {
 				if deleteErr := r.TargetClient.Delete(ctx, current); client.IgnoreNotFound(deleteErr) != nil {
-					return fmt.Errorf("error deleting object %q after 'invalid' update error: %s", resource, deleteErr)
+					return nil, fmt.Errorf("error deleting object %q after 'invalid' update error: %s", resource, deleteErr)
 				}
+				metricObjectsApplied.WithLabelValues(mr.Namespace, mr.Name, operationDeleted).Inc()
 				// return error directly, so that the create after delete will be retried
-				return fmt.Errorf("deleted object %q because of 'invalid' update error, and 'delete-on-invalid-update' annotation on object or the resource is an immutable ConfigMap/Secret: %s", resource, err)
+				return nil, fmt.Errorf("deleted object %q because of 'invalid' update error, and 'delete-on-invalid-update' annotation on object or the resource is an immutable ConfigMap/Secret: %s", resource, err)
 			}
 
-			return fmt.Errorf("error during apply of object %q: %s", resource, err)
+			return nil, fmt.Errorf("error during apply of object %q: %s", resource, err)
 		}
 
 		switch operationResult {
 		case controllerutil.OperationResultCreated:
 			resourceLogger.Info("Created resource because it was not existing before")
+			resourcesApplied.Created++
+			metricObjectsApplied.WithLabelValues(mr.Namespace, mr.Name, operationCreated).Inc()
 		case controllerutil.OperationResultUpdated:
 			resourceLogger.Info("Updated resource because its actual state differed from the desired state")
+			resourcesApplied.Updated++
+			metricObjectsApplied.WithLabelValues(mr.Namespace, mr.Name, operationUpdated).Inc()
 		case controllerutil.OperationResultNone:
 			resourceLogger.V(1).Info("Resource was neither created nor updated because its actual state matches with the desired state")
+			resourcesApplied.Unchanged++
+			metricObjectsApplied.WithLabelValues(mr.Namespace, mr.Name, operationUnchanged).Inc()
 		}
 	}
 
-	return nil
+	return resourcesApplied, nil
 }
 
 // computeHorizontallyScaledObjectKeys returns a set of object keys (in the form `Group/Kind/Namespace/Name`)
@@ -718,6 +736,8 @@ func (r *Reconciler) cleanOldResources(ctx context.Context, log logr.Logger, mr
 					return
 				}
 
+				metricObjectsApplied.WithLabelValues(mr.Namespace, mr.Name, operationDeleted).Inc()
+
 				if err := finalizeResourceIfNecessary(ctx, logger, r.TargetClient, r.Clock, obj); err != nil {
 					logger.Error(err, "Error when finalizing resource if necessary")
 					results <- &output{obj, true, err}
@@ -862,11 +882,13 @@ func updateManagedResourceStatus(
 	mr *resourcesv1alpha1.ManagedResource,
 	secretsDataChecksum *string,
 	resources []resourcesv1alpha1.ObjectReference,
+	resourcesApplied *resourcesv1alpha1.ResourcesAppliedSummary,
 	updatedConditions ...gardencorev1beta1.Condition,
 ) error {
 	mr.Status.Conditions = v1beta1helper.MergeConditions(mr.Status.Conditions, updatedConditions...)
 	mr.Status.SecretsDataChecksum = secretsDataChecksum
 	mr.Status.Resources = resources
+	mr.Status.ResourcesApplied = resourcesApplied
 	mr.Status.ObservedGeneration = mr.Generation
 	return c.Status().Update(ctx, mr)
 }
@@ -0,0 +1,280 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package namespacedefaults
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/resourcemanager/apis/config"
+)
+
+// Reconciler creates the configured LimitRanges, ResourceQuotas and NetworkPolicies in all selected namespaces and
+// removes those which are no longer desired.
+type Reconciler struct {
+	TargetClient client.Client
+	TargetReader client.Reader
+	Config       config.NamespaceDefaultsControllerConfig
+}
+
+// Reconcile creates the configured LimitRanges, ResourceQuotas and NetworkPolicies in all selected namespaces and
+// removes those which are no longer desired.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
+	defer cancel()
+
+	namespace := &metav1.PartialObjectMetadata{}
+	namespace.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("Namespace"))
+	if err := r.TargetClient.Get(ctx, request.NamespacedName, namespace); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if namespace.DeletionTimestamp != nil {
+		log.V(1).Info("Namespace is in deletion, nothing to be done")
+		return reconcile.Result{}, nil
+	}
+
+	var (
+		limitRanges     []corev1.LimitRange
+		resourceQuotas  []corev1.ResourceQuota
+		networkPolicies []networkingv1.NetworkPolicy
+	)
+
+	selector, err := labelSelectorAsSelector(r.Config.NamespaceSelector)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed parsing namespace selector: %w", err)
+	}
+
+	networkPolicySelector, err := labelSelectorAsSelector(r.Config.NetworkPolicyNamespaceSelector)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed parsing network policy namespace selector: %w", err)
+	}
+
+	if selector.Matches(labels.Set(namespace.Labels)) {
+		limitRanges = r.Config.LimitRanges
+		resourceQuotas = r.Config.ResourceQuotas
+
+		if networkPolicySelector.Matches(labels.Set(namespace.Labels)) {
+			networkPolicies = r.Config.NetworkPolicies
+		}
+	}
+
+	if err := r.reconcileLimitRanges(ctx, log, namespace.Name, limitRanges); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if err := r.reconcileResourceQuotas(ctx, log, namespace.Name, resourceQuotas); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{}, r.reconcileNetworkPolicies(ctx, log, namespace.Name, networkPolicies)
+}
+
+func labelSelectorAsSelector(labelSelector *metav1.LabelSelector) (labels.Selector, error) {
+	if labelSelector == nil {
+		return labels.Everything(), nil
+	}
+	return metav1.LabelSelectorAsSelector(labelSelector)
+}
+
+func (r *Reconciler) reconcileLimitRanges(ctx context.Context, log logr.Logger, namespace string, desired []corev1.LimitRange) error {
+	limitRangeList := &corev1.LimitRangeList{}
+	if err := r.TargetReader.List(ctx, limitRangeList, client.InNamespace(namespace), client.MatchingLabels{resourcesv1alpha1.ResourceManagerPurpose: resourcesv1alpha1.LabelPurposeNamespaceDefaults}); err != nil {
+		return fmt.Errorf("failed listing LimitRanges in namespace %s: %w", namespace, err)
+	}
+
+	existing := make(map[string]*corev1.LimitRange, len(limitRangeList.Items))
+	for _, limitRange := range limitRangeList.Items {
+		existing[limitRange.Name] = limitRange.DeepCopy()
+	}
+
+	for _, limitRange := range desired {
+		if obj, ok := existing[limitRange.Name]; ok {
+			delete(existing, limitRange.Name)
+
+			if apiequality.Semantic.DeepEqual(obj.Spec, limitRange.Spec) {
+				continue
+			}
+
+			log.Info("Updating LimitRange", "limitRange", client.ObjectKeyFromObject(obj))
+			patch := client.MergeFrom(obj.DeepCopy())
+			obj.Spec = *limitRange.Spec.DeepCopy()
+			if err := r.TargetClient.Patch(ctx, obj, patch); err != nil {
+				return fmt.Errorf("failed updating LimitRange %s: %w", client.ObjectKeyFromObject(obj), err)
+			}
+			continue
+		}
+
+		obj := &corev1.LimitRange{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      limitRange.Name,
+				Namespace: namespace,
+				Labels:    purposeLabels(limitRange.Labels),
+			},
+			Spec: *limitRange.Spec.DeepCopy(),
+		}
+
+		log.Info("Creating LimitRange", "limitRange", client.ObjectKeyFromObject(obj))
+		if err := r.TargetClient.Create(ctx, obj); err != nil {
+			if apierrors.IsAlreadyExists(err) {
+				log.Info("LimitRange already exists and is not managed by this controller, skipping", "limitRange", client.ObjectKeyFromObject(obj))
+				continue
+			}
+			return fmt.Errorf("failed creating LimitRange %s: %w", client.ObjectKeyFromObject(obj), err)
+		}
+	}
+
+	for _, obj := range existing {
+		log.Info("Deleting LimitRange", "limitRange", client.ObjectKeyFromObject(obj))
+		if err := r.TargetClient.Delete(ctx, obj); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed deleting LimitRange %s: %w", client.ObjectKeyFromObject(obj), err)
+		}
+	}
+
+	return nil
+}
+
+func (r *Reconciler) reconcileResourceQuotas(ctx context.Context, log logr.Logger, namespace string, desired []corev1.ResourceQuota) error {
+	resourceQuotaList := &corev1.ResourceQuotaList{}
+	if err := r.TargetReader.List(ctx, resourceQuotaList, client.InNamespace(namespace), client.MatchingLabels{resourcesv1alpha1.ResourceManagerPurpose: resourcesv1alpha1.LabelPurposeNamespaceDefaults}); err != nil {
+		return fmt.Errorf("failed listing ResourceQuotas in namespace %s: %w", namespace, err)
+	}
+
+	existing := make(map[string]*corev1.ResourceQuota, len(resourceQuotaList.Items))
+	for _, resourceQuota := range resourceQuotaList.Items {
+		existing[resourceQuota.Name] = resourceQuota.DeepCopy()
+	}
+
+	for _, resourceQuota := range desired {
+		if obj, ok := existing[resourceQuota.Name]; ok {
+			delete(existing, resourceQuota.Name)
+
+			if apiequality.Semantic.DeepEqual(obj.Spec, resourceQuota.Spec) {
+				continue
+			}
+
+			log.Info("Updating ResourceQuota", "resourceQuota", client.ObjectKeyFromObject(obj))
+			patch := client.MergeFrom(obj.DeepCopy())
+			obj.Spec = *resourceQuota.Spec.DeepCopy()
+			if err := r.TargetClient.Patch(ctx, obj, patch); err != nil {
+				return fmt.Errorf("failed updating ResourceQuota %s: %w", client.ObjectKeyFromObject(obj), err)
+			}
+			continue
+		}
+
+		obj := &corev1.ResourceQuota{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      resourceQuota.Name,
+				Namespace: namespace,
+				Labels:    purposeLabels(resourceQuota.Labels),
+			},
+			Spec: *resourceQuota.Spec.DeepCopy(),
+		}
+
+		log.Info("Creating ResourceQuota", "resourceQuota", client.ObjectKeyFromObject(obj))
+		if err := r.TargetClient.Create(ctx, obj); err != nil {
+			if apierrors.IsAlreadyExists(err) {
+				log.Info("ResourceQuota already exists and is not managed by this controller, skipping", "resourceQuota", client.ObjectKeyFromObject(obj))
+				continue
+			}
+			return fmt.Errorf("failed creating ResourceQuota %s: %w", client.ObjectKeyFromObject(obj), err)
+		}
+	}
+
+	for _, obj := range existing {
+		log.Info("Deleting ResourceQuota", "resourceQuota", client.ObjectKeyFromObject(obj))
+		if err := r.TargetClient.Delete(ctx, obj); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed deleting ResourceQuota %s: %w", client.ObjectKeyFromObject(obj), err)
+		}
+	}
+
+	return nil
+}
+
+func (r *Reconciler) reconcileNetworkPolicies(ctx context.Context, log logr.Logger, namespace string, desired []networkingv1.NetworkPolicy) error {
+	networkPolicyList := &networkingv1.NetworkPolicyList{}
+	if err := r.TargetReader.List(ctx, networkPolicyList, client.InNamespace(namespace), client.MatchingLabels{resourcesv1alpha1.ResourceManagerPurpose: resourcesv1alpha1.LabelPurposeNamespaceDefaults}); err != nil {
+		return fmt.Errorf("failed listing NetworkPolicies in namespace %s: %w", namespace, err)
+	}
+
+	existing := make(map[string]*networkingv1.NetworkPolicy, len(networkPolicyList.Items))
+	for _, networkPolicy := range networkPolicyList.Items {
+		existing[networkPolicy.Name] = networkPolicy.DeepCopy()
+	}
+
+	for _, networkPolicy := range desired {
+		if obj, ok := existing[networkPolicy.Name]; ok {
+			delete(existing, networkPolicy.Name)
+
+			if apiequality.Semantic.DeepEqual(obj.Spec, networkPolicy.Spec) {
+				continue
+			}
+
+			log.Info("Updating NetworkPolicy", "networkPolicy", client.ObjectKeyFromObject(obj))
+			patch := client.MergeFrom(obj.DeepCopy())
+			obj.Spec = *networkPolicy.Spec.DeepCopy()
+			if err := r.TargetClient.Patch(ctx, obj, patch); err != nil {
+				return fmt.Errorf("failed updating NetworkPolicy %s: %w", client.ObjectKeyFromObject(obj), err)
+			}
+			continue
+		}
+
+		obj := &networkingv1.NetworkPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        networkPolicy.Name,
+				Namespace:   namespace,
+				Labels:      purposeLabels(networkPolicy.Labels),
+				Annotations: networkPolicy.Annotations,
+			},
+			Spec: *networkPolicy.Spec.DeepCopy(),
+		}
+
+		log.Info("Creating NetworkPolicy", "networkPolicy", client.ObjectKeyFromObject(obj))
+		if err := r.TargetClient.Create(ctx, obj); err != nil {
+			if apierrors.IsAlreadyExists(err) {
+				log.Info("NetworkPolicy already exists and is not managed by this controller, skipping", "networkPolicy", client.ObjectKeyFromObject(obj))
+				continue
+			}
+			return fmt.Errorf("failed creating NetworkPolicy %s: %w", client.ObjectKeyFromObject(obj), err)
+		}
+	}
+
+	for _, obj := range existing {
+		log.Info("Deleting NetworkPolicy", "networkPolicy", client.ObjectKeyFromObject(obj))
+		if err := r.TargetClient.Delete(ctx, obj); client.IgnoreNotFound(err) != nil {
+			return fmt.Errorf("failed deleting NetworkPolicy %s: %w", client.ObjectKeyFromObject(obj), err)
+		}
+	}
+
+	return nil
+}
+
+func purposeLabels(in map[string]string) map[string]string {
+	out := make(map[string]string, len(in)+1)
+	for k, v := range in {
+		out[k] = v
+	}
+	out[resourcesv1alpha1.ResourceManagerPurpose] = resourcesv1alpha1.LabelPurposeNamespaceDefaults
+	return out
+}
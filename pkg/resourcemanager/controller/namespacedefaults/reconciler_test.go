@@ -0,0 +1,254 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package namespacedefaults_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/pkg/resourcemanager/apis/config"
+	. "github.com/gardener/gardener/pkg/resourcemanager/controller/namespacedefaults"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx = context.TODO()
+
+		fakeClient client.Client
+		reconciler *Reconciler
+
+		namespace  *corev1.Namespace
+		limitRange corev1.LimitRange
+		quota      corev1.ResourceQuota
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetesscheme.Scheme).Build()
+
+		namespace = &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "foo", Labels: map[string]string{"team": "a"}}}
+		Expect(fakeClient.Create(ctx, namespace)).To(Succeed())
+
+		limitRange = corev1.LimitRange{
+			ObjectMeta: metav1.ObjectMeta{Name: "defaults"},
+			Spec: corev1.LimitRangeSpec{Limits: []corev1.LimitRangeItem{{
+				Type:           corev1.LimitTypeContainer,
+				DefaultRequest: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("100m")},
+			}}},
+		}
+		quota = corev1.ResourceQuota{
+			ObjectMeta: metav1.ObjectMeta{Name: "quota"},
+			Spec: corev1.ResourceQuotaSpec{
+				Hard: corev1.ResourceList{corev1.ResourcePods: resource.MustParse("10")},
+			},
+		}
+
+		reconciler = &Reconciler{
+			TargetClient: fakeClient,
+			TargetReader: fakeClient,
+			Config: config.NamespaceDefaultsControllerConfig{
+				LimitRanges:    []corev1.LimitRange{limitRange},
+				ResourceQuotas: []corev1.ResourceQuota{quota},
+			},
+		}
+	})
+
+	reconcileNamespace := func() {
+		result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(namespace)})
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		ExpectWithOffset(1, result).To(Equal(reconcile.Result{}))
+	}
+
+	expectObjects := func(limitRangeNames, quotaNames []string) {
+		limitRangeList := &corev1.LimitRangeList{}
+		ExpectWithOffset(1, fakeClient.List(ctx, limitRangeList, client.InNamespace(namespace.Name))).To(Succeed())
+		names := []string{}
+		for _, obj := range limitRangeList.Items {
+			names = append(names, obj.Name)
+		}
+		ExpectWithOffset(1, names).To(ConsistOf(limitRangeNames))
+
+		quotaList := &corev1.ResourceQuotaList{}
+		ExpectWithOffset(1, fakeClient.List(ctx, quotaList, client.InNamespace(namespace.Name))).To(Succeed())
+		names = []string{}
+		for _, obj := range quotaList.Items {
+			names = append(names, obj.Name)
+		}
+		ExpectWithOffset(1, names).To(ConsistOf(quotaNames))
+	}
+
+	It("should do nothing if the namespace does not exist", func() {
+		Expect(fakeClient.Delete(ctx, namespace)).To(Succeed())
+
+		reconcileNamespace()
+		expectObjects(nil, nil)
+	})
+
+	It("should do nothing if the namespace is in deletion", func() {
+		namespace.Finalizers = []string{"kubernetes"}
+		Expect(fakeClient.Update(ctx, namespace)).To(Succeed())
+		Expect(fakeClient.Delete(ctx, namespace)).To(Succeed())
+
+		reconcileNamespace()
+		expectObjects(nil, nil)
+	})
+
+	It("should create the configured LimitRanges and ResourceQuotas", func() {
+		reconcileNamespace()
+		expectObjects([]string{"defaults"}, []string{"quota"})
+
+		obj := &corev1.LimitRange{}
+		Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace.Name, Name: "defaults"}, obj)).To(Succeed())
+		Expect(obj.Labels).To(HaveKeyWithValue("resources.gardener.cloud/purpose", "namespace-defaults"))
+		Expect(obj.Spec).To(Equal(limitRange.Spec))
+
+		quotaObj := &corev1.ResourceQuota{}
+		Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace.Name, Name: "quota"}, quotaObj)).To(Succeed())
+		Expect(quotaObj.Labels).To(HaveKeyWithValue("resources.gardener.cloud/purpose", "namespace-defaults"))
+		Expect(quotaObj.Spec).To(Equal(quota.Spec))
+	})
+
+	It("should update existing LimitRanges and ResourceQuotas", func() {
+		reconcileNamespace()
+
+		reconciler.Config.LimitRanges[0].Spec.Limits[0].DefaultRequest[corev1.ResourceCPU] = resource.MustParse("200m")
+		reconciler.Config.ResourceQuotas[0].Spec.Hard[corev1.ResourcePods] = resource.MustParse("20")
+		reconcileNamespace()
+
+		obj := &corev1.LimitRange{}
+		Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace.Name, Name: "defaults"}, obj)).To(Succeed())
+		Expect(obj.Spec.Limits[0].DefaultRequest.Cpu().String()).To(Equal("200m"))
+
+		quotaObj := &corev1.ResourceQuota{}
+		Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace.Name, Name: "quota"}, quotaObj)).To(Succeed())
+		Expect(quotaObj.Spec.Hard.Pods().String()).To(Equal("20"))
+	})
+
+	It("should not touch objects which are not managed by the controller", func() {
+		unmanaged := &corev1.LimitRange{ObjectMeta: metav1.ObjectMeta{Name: "defaults", Namespace: namespace.Name}}
+		Expect(fakeClient.Create(ctx, unmanaged)).To(Succeed())
+
+		reconcileNamespace()
+		expectObjects([]string{"defaults"}, []string{"quota"})
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(unmanaged), unmanaged)).To(Succeed())
+		Expect(unmanaged.Labels).To(BeEmpty())
+		Expect(unmanaged.Spec.Limits).To(BeEmpty())
+	})
+
+	It("should delete objects which are no longer desired", func() {
+		reconcileNamespace()
+		expectObjects([]string{"defaults"}, []string{"quota"})
+
+		reconciler.Config.LimitRanges = nil
+		reconciler.Config.ResourceQuotas = nil
+		reconcileNamespace()
+		expectObjects(nil, nil)
+	})
+
+	Context("namespace selector", func() {
+		It("should create the objects if the namespace matches the selector", func() {
+			reconciler.Config.NamespaceSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}}
+
+			reconcileNamespace()
+			expectObjects([]string{"defaults"}, []string{"quota"})
+		})
+
+		It("should remove the objects if the namespace no longer matches the selector", func() {
+			reconcileNamespace()
+			expectObjects([]string{"defaults"}, []string{"quota"})
+
+			reconciler.Config.NamespaceSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"team": "b"}}
+			reconcileNamespace()
+			expectObjects(nil, nil)
+		})
+	})
+
+	Context("network policies", func() {
+		var networkPolicy networkingv1.NetworkPolicy
+
+		BeforeEach(func() {
+			networkPolicy = networkingv1.NetworkPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "deny-all",
+					Annotations: map[string]string{"gardener.cloud/description": "Denies all traffic."},
+				},
+				Spec: networkingv1.NetworkPolicySpec{
+					PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress},
+				},
+			}
+
+			reconciler.Config.NetworkPolicies = []networkingv1.NetworkPolicy{networkPolicy}
+		})
+
+		expectNetworkPolicies := func(networkPolicyNames []string) {
+			networkPolicyList := &networkingv1.NetworkPolicyList{}
+			ExpectWithOffset(1, fakeClient.List(ctx, networkPolicyList, client.InNamespace(namespace.Name))).To(Succeed())
+			names := []string{}
+			for _, obj := range networkPolicyList.Items {
+				names = append(names, obj.Name)
+			}
+			ExpectWithOffset(1, names).To(ConsistOf(networkPolicyNames))
+		}
+
+		It("should create the configured NetworkPolicies", func() {
+			reconcileNamespace()
+			expectNetworkPolicies([]string{"deny-all"})
+
+			obj := &networkingv1.NetworkPolicy{}
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace.Name, Name: "deny-all"}, obj)).To(Succeed())
+			Expect(obj.Labels).To(HaveKeyWithValue("resources.gardener.cloud/purpose", "namespace-defaults"))
+			Expect(obj.Annotations).To(HaveKeyWithValue("gardener.cloud/description", "Denies all traffic."))
+			Expect(obj.Spec).To(Equal(networkPolicy.Spec))
+		})
+
+		It("should update existing NetworkPolicies", func() {
+			reconcileNamespace()
+
+			reconciler.Config.NetworkPolicies[0].Spec.PolicyTypes = []networkingv1.PolicyType{networkingv1.PolicyTypeIngress}
+			reconcileNamespace()
+
+			obj := &networkingv1.NetworkPolicy{}
+			Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace.Name, Name: "deny-all"}, obj)).To(Succeed())
+			Expect(obj.Spec.PolicyTypes).To(ConsistOf(networkingv1.PolicyTypeIngress))
+		})
+
+		It("should not create the NetworkPolicies if the namespace does not match the network policy selector", func() {
+			reconciler.Config.NetworkPolicyNamespaceSelector = &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{
+				Key:      "networking.gardener.cloud/default-network-policies",
+				Operator: metav1.LabelSelectorOpNotIn,
+				Values:   []string{"disabled"},
+			}}}
+
+			reconcileNamespace()
+			expectNetworkPolicies([]string{"deny-all"})
+
+			namespace.Labels["networking.gardener.cloud/default-network-policies"] = "disabled"
+			Expect(fakeClient.Update(ctx, namespace)).To(Succeed())
+
+			reconcileNamespace()
+			expectNetworkPolicies(nil)
+			expectObjects([]string{"defaults"}, []string{"quota"})
+		})
+
+		It("should delete NetworkPolicies which are no longer desired", func() {
+			reconcileNamespace()
+			expectNetworkPolicies([]string{"deny-all"})
+
+			reconciler.Config.NetworkPolicies = nil
+			reconcileNamespace()
+			expectNetworkPolicies(nil)
+		})
+	})
+})
@@ -13,6 +13,7 @@ import (
 	"github.com/gardener/gardener/pkg/resourcemanager/apis/config"
 	"github.com/gardener/gardener/pkg/resourcemanager/controller/node/agentreconciliationdelay"
 	"github.com/gardener/gardener/pkg/resourcemanager/controller/node/criticalcomponents"
+	"github.com/gardener/gardener/pkg/resourcemanager/controller/node/systemcomponentsreservation"
 )
 
 // AddToManager adds all node controllers to the given manager.
@@ -33,5 +34,11 @@ func AddToManager(mgr manager.Manager, targetCluster cluster.Cluster, cfg config
 		}
 	}
 
+	if cfg.Controllers.NodeSystemComponentsReservation.Enabled {
+		if err := (&systemcomponentsreservation.Reconciler{}).AddToManager(mgr, targetCluster); err != nil {
+			return fmt.Errorf("failed adding node-system-components-reservation controller: %w", err)
+		}
+	}
+
 	return nil
 }
@@ -0,0 +1,85 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package systemcomponentsreservation
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+)
+
+// ControllerName is the name of the controller.
+const ControllerName = "node-system-components-reservation"
+
+// AddToManager adds Reconciler to the given manager.
+func (r *Reconciler) AddToManager(mgr manager.Manager, targetCluster cluster.Cluster) error {
+	if r.TargetClient == nil {
+		r.TargetClient = targetCluster.GetClient()
+	}
+
+	return builder.
+		ControllerManagedBy(mgr).
+		Named(ControllerName).
+		WithOptions(controller.Options{MaxConcurrentReconciles: 1}).
+		WatchesRawSource(
+			source.Kind[client.Object](targetCluster.GetCache(),
+				&corev1.Node{},
+				handler.EnqueueRequestsFromMapFunc(MapNodeToWorkerPool),
+				r.NodePredicate()),
+		).
+		Complete(r)
+}
+
+// NodePredicate returns a predicate that filters for Node events which might change the set of nodes reserved for
+// system components in a worker pool, i.e., creations, deletions, and changes of the relevant labels.
+func (r *Reconciler) NodePredicate() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(e event.CreateEvent) bool { return hasWorkerPoolLabel(e.Object) },
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			if e.ObjectOld == nil || e.ObjectNew == nil {
+				return false
+			}
+
+			for _, key := range []string{
+				v1beta1constants.LabelWorkerPool,
+				v1beta1constants.LabelWorkerPoolSystemComponentsReservedNodesPercentage,
+				v1beta1constants.LabelWorkerPoolSystemComponentsReserved,
+			} {
+				if e.ObjectOld.GetLabels()[key] != e.ObjectNew.GetLabels()[key] {
+					return true
+				}
+			}
+
+			return e.ObjectOld.GetDeletionTimestamp() == nil && e.ObjectNew.GetDeletionTimestamp() != nil
+		},
+		DeleteFunc:  func(e event.DeleteEvent) bool { return hasWorkerPoolLabel(e.Object) },
+		GenericFunc: func(_ event.GenericEvent) bool { return false },
+	}
+}
+
+// MapNodeToWorkerPool maps the given Node to a request for the worker pool it belongs to.
+func MapNodeToWorkerPool(_ context.Context, obj client.Object) []reconcile.Request {
+	if !hasWorkerPoolLabel(obj) {
+		return nil
+	}
+
+	return []reconcile.Request{{NamespacedName: client.ObjectKey{Name: obj.GetLabels()[v1beta1constants.LabelWorkerPool]}}}
+}
+
+func hasWorkerPoolLabel(obj client.Object) bool {
+	return obj != nil && obj.GetLabels()[v1beta1constants.LabelWorkerPool] != ""
+}
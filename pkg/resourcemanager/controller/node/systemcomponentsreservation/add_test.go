@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package systemcomponentsreservation_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	. "github.com/gardener/gardener/pkg/resourcemanager/controller/node/systemcomponentsreservation"
+)
+
+var _ = Describe("Add", func() {
+	var node *corev1.Node
+
+	BeforeEach(func() {
+		node = &corev1.Node{ObjectMeta: metav1.ObjectMeta{
+			Name:   "node",
+			Labels: map[string]string{"worker.gardener.cloud/pool": "pool"},
+		}}
+	})
+
+	Describe("#NodePredicate", func() {
+		var p predicate.Predicate
+
+		BeforeEach(func() {
+			p = (&Reconciler{}).NodePredicate()
+		})
+
+		It("should react on creations and deletions of nodes of worker pools", func() {
+			Expect(p.Create(event.CreateEvent{Object: node})).To(BeTrue())
+			Expect(p.Delete(event.DeleteEvent{Object: node})).To(BeTrue())
+
+			node.Labels = nil
+			Expect(p.Create(event.CreateEvent{Object: node})).To(BeFalse())
+			Expect(p.Delete(event.DeleteEvent{Object: node})).To(BeFalse())
+		})
+
+		It("should react on updates of the relevant labels only", func() {
+			oldNode := node.DeepCopy()
+			node.Labels["foo"] = "bar"
+			Expect(p.Update(event.UpdateEvent{ObjectOld: oldNode, ObjectNew: node})).To(BeFalse())
+
+			node.Labels["worker.gardener.cloud/system-components-reserved-nodes-percentage"] = "10"
+			Expect(p.Update(event.UpdateEvent{ObjectOld: oldNode, ObjectNew: node})).To(BeTrue())
+
+			oldNode = node.DeepCopy()
+			node.Labels["worker.gardener.cloud/system-components-reserved"] = "true"
+			Expect(p.Update(event.UpdateEvent{ObjectOld: oldNode, ObjectNew: node})).To(BeTrue())
+		})
+
+		It("should react when the deletion of a node starts", func() {
+			oldNode := node.DeepCopy()
+			node.DeletionTimestamp = &metav1.Time{}
+			Expect(p.Update(event.UpdateEvent{ObjectOld: oldNode, ObjectNew: node})).To(BeTrue())
+		})
+	})
+
+	Describe("#MapNodeToWorkerPool", func() {
+		It("should map the node to its worker pool", func() {
+			Expect(MapNodeToWorkerPool(context.TODO(), node)).To(ConsistOf(reconcile.Request{NamespacedName: client.ObjectKey{Name: "pool"}}))
+		})
+
+		It("should not map nodes without worker pool", func() {
+			node.Labels = nil
+			Expect(MapNodeToWorkerPool(context.TODO(), node)).To(BeEmpty())
+		})
+	})
+})
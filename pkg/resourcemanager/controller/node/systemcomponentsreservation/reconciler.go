@@ -0,0 +1,150 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package systemcomponentsreservation
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/utils/flow"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+// Reconciler reserves a percentage of the nodes of a worker pool for system components by labeling and tainting them.
+// The percentage is read from the worker.gardener.cloud/system-components-reserved-nodes-percentage label which is
+// maintained by gardenlet on all nodes of the pool. The reconciled requests contain the name of the worker pool.
+type Reconciler struct {
+	TargetClient client.Client
+}
+
+// Reconcile determines the nodes of the worker pool which should be reserved for system components and adds or removes
+// the label and taint accordingly.
+func (r *Reconciler) Reconcile(reconcileCtx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(reconcileCtx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(reconcileCtx, controllerutils.DefaultReconciliationTimeout)
+	defer cancel()
+
+	nodeList := &corev1.NodeList{}
+	if err := r.TargetClient.List(ctx, nodeList, client.MatchingLabels{v1beta1constants.LabelWorkerPool: req.Name}); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed listing nodes of worker pool %q: %w", req.Name, err)
+	}
+
+	var nodes []*corev1.Node
+	for i := range nodeList.Items {
+		if nodeList.Items[i].DeletionTimestamp == nil {
+			nodes = append(nodes, &nodeList.Items[i])
+		}
+	}
+
+	reserved := NodesToReserve(nodes, ReservedNodesPercentage(nodes))
+	log.V(1).Info("Computed nodes reserved for system components", "reservedNodes", len(reserved), "nodes", len(nodes))
+
+	var taskFns []flow.TaskFn
+	for _, node := range nodes {
+		if isReserved(node) == reserved.Has(node.Name) {
+			continue
+		}
+
+		taskFns = append(taskFns, func(ctx context.Context) error {
+			patch := client.MergeFromWithOptions(node.DeepCopy(), client.MergeFromWithOptimisticLock{})
+			if reserved.Has(node.Name) {
+				Reserve(node)
+			} else {
+				Release(node)
+			}
+			return r.TargetClient.Patch(ctx, node, patch)
+		})
+	}
+
+	return reconcile.Result{}, flow.Parallel(taskFns...)(ctx)
+}
+
+// ReservedNodesPercentage returns the percentage of nodes to reserve for system components. It is read from the label
+// of the given nodes. If none of the nodes has a valid label, 0 is returned.
+func ReservedNodesPercentage(nodes []*corev1.Node) int {
+	for _, node := range nodes {
+		value, ok := node.Labels[v1beta1constants.LabelWorkerPoolSystemComponentsReservedNodesPercentage]
+		if !ok {
+			continue
+		}
+
+		if percentage, err := strconv.Atoi(value); err == nil && percentage > 0 && percentage <= 100 {
+			return percentage
+		}
+	}
+
+	return 0
+}
+
+// NodesToReserve returns the names of the nodes which should be reserved for system components. The number of nodes is
+// the given percentage of all nodes, rounded up. Nodes which are already reserved are preferred to avoid unnecessary
+// evictions, followed by the oldest nodes since they are less likely to be replaced soon.
+func NodesToReserve(nodes []*corev1.Node, percentage int) sets.Set[string] {
+	desired := (len(nodes)*percentage + 99) / 100
+
+	candidates := slices.Clone(nodes)
+	slices.SortStableFunc(candidates, func(a, b *corev1.Node) int {
+		if isReserved(a) != isReserved(b) {
+			if isReserved(a) {
+				return -1
+			}
+			return 1
+		}
+		if c := a.CreationTimestamp.Compare(b.CreationTimestamp.Time); c != 0 {
+			return c
+		}
+		if a.Name < b.Name {
+			return -1
+		}
+		if a.Name > b.Name {
+			return 1
+		}
+		return 0
+	})
+
+	out := sets.New[string]()
+	for _, node := range candidates[:desired] {
+		out.Insert(node.Name)
+	}
+	return out
+}
+
+// Reserve adds the label and the taint for system components reservation to the given node.
+func Reserve(node *corev1.Node) {
+	if node.Labels == nil {
+		node.Labels = map[string]string{}
+	}
+	node.Labels[v1beta1constants.LabelWorkerPoolSystemComponentsReserved] = "true"
+
+	taint := gardenerutils.SystemComponentsReservedTaint()
+	if !slices.ContainsFunc(node.Spec.Taints, func(t corev1.Taint) bool { return t.MatchTaint(&taint) }) {
+		node.Spec.Taints = append(node.Spec.Taints, taint)
+	}
+}
+
+// Release removes the label and the taint for system components reservation from the given node.
+func Release(node *corev1.Node) {
+	delete(node.Labels, v1beta1constants.LabelWorkerPoolSystemComponentsReserved)
+
+	taint := gardenerutils.SystemComponentsReservedTaint()
+	node.Spec.Taints = slices.DeleteFunc(node.Spec.Taints, func(t corev1.Taint) bool { return t.MatchTaint(&taint) })
+}
+
+func isReserved(node *corev1.Node) bool {
+	taint := gardenerutils.SystemComponentsReservedTaint()
+	return node.Labels[v1beta1constants.LabelWorkerPoolSystemComponentsReserved] == "true" &&
+		slices.ContainsFunc(node.Spec.Taints, func(t corev1.Taint) bool { return t.MatchTaint(&taint) })
+}
@@ -0,0 +1,174 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package systemcomponentsreservation_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/resourcemanager/controller/node/systemcomponentsreservation"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx        = context.TODO()
+		fakeClient client.Client
+		reconciler *Reconciler
+
+		reservedTaint = corev1.Taint{Key: "worker.gardener.cloud/system-components-reserved", Value: "true", Effect: corev1.TaintEffectNoSchedule}
+		otherTaint    = corev1.Taint{Key: "foo", Value: "bar", Effect: corev1.TaintEffectNoExecute}
+
+		now = time.Now()
+	)
+
+	newNode := func(name, pool string, age time.Duration, percentage string) *corev1.Node {
+		node := &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				CreationTimestamp: metav1.NewTime(now.Add(-age)),
+				Labels:            map[string]string{"worker.gardener.cloud/pool": pool},
+			},
+			Spec: corev1.NodeSpec{Taints: []corev1.Taint{otherTaint}},
+		}
+		if percentage != "" {
+			node.Labels["worker.gardener.cloud/system-components-reserved-nodes-percentage"] = percentage
+		}
+		return node
+	}
+
+	reserve := func(node *corev1.Node) *corev1.Node {
+		node.Labels["worker.gardener.cloud/system-components-reserved"] = "true"
+		node.Spec.Taints = append(node.Spec.Taints, reservedTaint)
+		return node
+	}
+
+	reservedNodeNames := func() []string {
+		nodeList := &corev1.NodeList{}
+		ExpectWithOffset(1, fakeClient.List(ctx, nodeList)).To(Succeed())
+
+		var names []string
+		for _, node := range nodeList.Items {
+			isLabeled := node.Labels["worker.gardener.cloud/system-components-reserved"] == "true"
+			ExpectWithOffset(1, node.Spec.Taints).To(ContainElement(otherTaint))
+			if isLabeled {
+				ExpectWithOffset(1, node.Spec.Taints).To(ConsistOf(otherTaint, reservedTaint))
+				names = append(names, node.Name)
+			} else {
+				ExpectWithOffset(1, node.Spec.Taints).To(ConsistOf(otherTaint))
+			}
+		}
+		return names
+	}
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).Build()
+		reconciler = &Reconciler{TargetClient: fakeClient}
+	})
+
+	reconcilePool := func(pool string) {
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKey{Name: pool}})
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+	}
+
+	It("should reserve the rounded up percentage of the oldest nodes of the pool", func() {
+		for _, node := range []*corev1.Node{
+			newNode("node-1", "pool", 1*time.Hour, "30"),
+			newNode("node-2", "pool", 3*time.Hour, "30"),
+			newNode("node-3", "pool", 2*time.Hour, "30"),
+			newNode("node-4", "pool", 4*time.Hour, "30"),
+			newNode("node-5", "other", 5*time.Hour, ""),
+		} {
+			Expect(fakeClient.Create(ctx, node)).To(Succeed())
+		}
+
+		reconcilePool("pool")
+		Expect(reservedNodeNames()).To(ConsistOf("node-2", "node-4"))
+	})
+
+	It("should prefer nodes which are already reserved", func() {
+		for _, node := range []*corev1.Node{
+			reserve(newNode("node-1", "pool", 1*time.Hour, "50")),
+			newNode("node-2", "pool", 2*time.Hour, "50"),
+			newNode("node-3", "pool", 3*time.Hour, "50"),
+		} {
+			Expect(fakeClient.Create(ctx, node)).To(Succeed())
+		}
+
+		reconcilePool("pool")
+		Expect(reservedNodeNames()).To(ConsistOf("node-1", "node-3"))
+	})
+
+	It("should release reserved nodes if the percentage was decreased", func() {
+		for _, node := range []*corev1.Node{
+			reserve(newNode("node-1", "pool", 1*time.Hour, "25")),
+			reserve(newNode("node-2", "pool", 2*time.Hour, "25")),
+			newNode("node-3", "pool", 3*time.Hour, "25"),
+			newNode("node-4", "pool", 4*time.Hour, "25"),
+		} {
+			Expect(fakeClient.Create(ctx, node)).To(Succeed())
+		}
+
+		reconcilePool("pool")
+		Expect(reservedNodeNames()).To(ConsistOf("node-2"))
+	})
+
+	It("should release all reserved nodes if no percentage is configured", func() {
+		for _, node := range []*corev1.Node{
+			reserve(newNode("node-1", "pool", 1*time.Hour, "")),
+			newNode("node-2", "pool", 2*time.Hour, ""),
+		} {
+			Expect(fakeClient.Create(ctx, node)).To(Succeed())
+		}
+
+		reconcilePool("pool")
+		Expect(reservedNodeNames()).To(BeEmpty())
+	})
+
+	It("should re-add the taint if it was removed from a reserved node", func() {
+		node := reserve(newNode("node-1", "pool", 1*time.Hour, "100"))
+		node.Spec.Taints = []corev1.Taint{otherTaint}
+		Expect(fakeClient.Create(ctx, node)).To(Succeed())
+
+		reconcilePool("pool")
+		Expect(reservedNodeNames()).To(ConsistOf("node-1"))
+	})
+
+	Describe("#NodesToReserve", func() {
+		It("should round up the number of nodes", func() {
+			nodes := []*corev1.Node{
+				newNode("node-1", "pool", 1*time.Hour, ""),
+				newNode("node-2", "pool", 1*time.Hour, ""),
+				newNode("node-3", "pool", 1*time.Hour, ""),
+			}
+
+			Expect(NodesToReserve(nodes, 0).UnsortedList()).To(BeEmpty())
+			Expect(NodesToReserve(nodes, 1).UnsortedList()).To(ConsistOf("node-1"))
+			Expect(NodesToReserve(nodes, 34).UnsortedList()).To(ConsistOf("node-1", "node-2"))
+			Expect(NodesToReserve(nodes, 100).UnsortedList()).To(ConsistOf("node-1", "node-2", "node-3"))
+		})
+	})
+
+	Describe("#ReservedNodesPercentage", func() {
+		It("should ignore invalid values", func() {
+			Expect(ReservedNodesPercentage([]*corev1.Node{
+				newNode("node-1", "pool", 0, "foo"),
+				newNode("node-2", "pool", 0, "150"),
+			})).To(Equal(0))
+			Expect(ReservedNodesPercentage([]*corev1.Node{
+				newNode("node-1", "pool", 0, ""),
+				newNode("node-2", "pool", 0, "20"),
+			})).To(Equal(20))
+		})
+	})
+})
@@ -17,6 +17,8 @@ import (
 const (
 	// HandlerName is the name of this webhook handler.
 	HandlerName = "extension-validation"
+	// WebhookPathAuditSink is the HTTP handler path for this admission webhook handler for AuditSink.
+	WebhookPathAuditSink = "/validate-extensions-gardener-cloud-v1alpha1-auditsink"
 	// WebhookPathBackupBucket is the HTTP handler path for this admission webhook handler for BackupBucket.
 	WebhookPathBackupBucket = "/validate-extensions-gardener-cloud-v1alpha1-backupbucket"
 	// WebhookPathBackupEntry is the HTTP handler path for this admission webhook handler for BackupEntry.
@@ -46,6 +48,7 @@ const (
 // AddToManager add the validators to the given managers.
 func AddToManager(mgr manager.Manager) error {
 	for obj, validator := range map[client.Object]admission.CustomValidator{
+		&extensionsv1alpha1.AuditSink{}:             &auditSinkValidator{},
 		&extensionsv1alpha1.BackupBucket{}:          &backupBucketValidator{},
 		&extensionsv1alpha1.BackupEntry{}:           &backupEntryValidator{},
 		&extensionsv1alpha1.Bastion{}:               &bastionValidator{},
@@ -18,6 +18,7 @@ import (
 )
 
 type (
+	auditSinkValidator             struct{}
 	backupBucketValidator          struct{}
 	backupEntryValidator           struct{}
 	bastionValidator               struct{}
@@ -32,6 +33,26 @@ type (
 	workerValidator                struct{}
 )
 
+func (auditSinkValidator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	object := obj.(*extensionsv1alpha1.AuditSink)
+	if errs := validation.ValidateAuditSink(object); len(errs) > 0 {
+		return nil, apierrors.NewInvalid(extensionsv1alpha1.Kind(extensionsv1alpha1.AuditSinkResource), object.GetName(), errs)
+	}
+	return nil, nil
+}
+
+func (auditSinkValidator) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	object := newObj.(*extensionsv1alpha1.AuditSink)
+	if errs := validation.ValidateAuditSinkUpdate(object, oldObj.(*extensionsv1alpha1.AuditSink)); len(errs) > 0 {
+		return nil, apierrors.NewInvalid(extensionsv1alpha1.Kind(extensionsv1alpha1.AuditSinkResource), object.GetName(), errs)
+	}
+	return nil, nil
+}
+
+func (auditSinkValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
 func (backupBucketValidator) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
 	object := obj.(*extensionsv1alpha1.BackupBucket)
 	if errs := validation.ValidateBackupBucket(object); len(errs) > 0 {
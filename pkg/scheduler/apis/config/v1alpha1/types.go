@@ -7,6 +7,7 @@ package v1alpha1
 import (
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
 )
@@ -105,6 +106,11 @@ type ShootSchedulerConfiguration struct {
 	ConcurrentSyncs int `json:"concurrentSyncs"`
 	// Strategy defines how seeds for shoots, that do not specify a seed explicitly, are being determined
 	Strategy CandidateDeterminationStrategy `json:"candidateDeterminationStrategy"`
+	// ResourceRequests defines the amount of seed resources (as reported in the `.status.allocatable` field of seeds)
+	// which is consumed by each shoot. Seeds whose allocatable amount of a requested resource would be exceeded are not
+	// considered for scheduling. The `shoots` resource is always requested with an amount of one.
+	// +optional
+	ResourceRequests corev1.ResourceList `json:"resourceRequests,omitempty"`
 }
 
 // ServerConfiguration contains details for the HTTP(S) servers.
@@ -11,6 +11,7 @@ import (
 
 	"github.com/gardener/gardener/pkg/logger"
 	schedulerconfigv1alpha1 "github.com/gardener/gardener/pkg/scheduler/apis/config/v1alpha1"
+	kubernetescorevalidation "github.com/gardener/gardener/pkg/utils/validation/kubernetes/core"
 )
 
 // ValidateConfiguration validates the configuration.
@@ -47,6 +48,10 @@ func validateSchedulerControllerConfiguration(schedulers schedulerconfigv1alpha1
 	if schedulers.Shoot != nil {
 		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(schedulers.Shoot.ConcurrentSyncs), fldPath.Child("shoot", "concurrentSyncs"))...)
 		allErrs = append(allErrs, validateStrategy(schedulers.Shoot.Strategy, fldPath.Child("shoot", "strategy"))...)
+
+		for resourceName, quantity := range schedulers.Shoot.ResourceRequests {
+			allErrs = append(allErrs, kubernetescorevalidation.ValidateResourceQuantityValue(string(resourceName), quantity, fldPath.Child("shoot", "resourceRequests", string(resourceName)))...)
+		}
 	}
 
 	return allErrs
@@ -8,6 +8,8 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
@@ -92,6 +94,18 @@ var _ = Describe("gardener-scheduler", func() {
 					"Field": Equal("schedulers.shoot.concurrentSyncs"),
 				}))))
 			})
+
+			It("should fail because shoot resource requests are negative", func() {
+				invalidConfiguration := defaultAdmissionConfiguration
+				invalidConfiguration.Schedulers.Shoot.ResourceRequests = corev1.ResourceList{"persistent-volumes": resource.MustParse("-1")}
+
+				err := ValidateConfiguration(&invalidConfiguration)
+
+				Expect(err).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("schedulers.shoot.resourceRequests.persistent-volumes"),
+				}))))
+			})
 		})
 	})
 })
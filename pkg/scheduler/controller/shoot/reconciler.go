@@ -16,6 +16,7 @@ import (
 	"golang.org/x/exp/maps"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -167,15 +168,20 @@ func (r *Reconciler) DetermineSeed(
 	if err != nil {
 		return nil, err
 	}
-	filteredSeeds, err = filterCandidates(shoot, shootList, filteredSeeds)
+	filteredSeeds, err = filterSeedsForDataResidency(filteredSeeds, shoot, &cloudProfile.Spec)
 	if err != nil {
 		return nil, err
 	}
-	filteredSeeds, err = applyStrategy(log, shoot, filteredSeeds, r.Config.Strategy, regionConfig)
+	filteredSeeds, err = filterCandidates(shoot, shootList, filteredSeeds, r.Config.ResourceRequests)
 	if err != nil {
 		return nil, err
 	}
-	return getSeedWithLeastShootsDeployed(filteredSeeds, shootList)
+	filteredSeeds = preferSeedsWithoutUntoleratedTaints(filteredSeeds, shoot)
+	filteredSeeds, err = applyStrategy(log, shoot, filteredSeeds, r.Config.Strategy, regionConfig, &cloudProfile.Spec)
+	if err != nil {
+		return nil, err
+	}
+	return getSeedWithLowestUsage(filteredSeeds, shootList)
 }
 
 func (r *Reconciler) getRegionConfigMap(ctx context.Context, log logr.Logger, cloudProfile *gardencorev1beta1.CloudProfile) (*corev1.ConfigMap, error) {
@@ -299,7 +305,31 @@ func filterSeedsForAccessRestrictions(seedList []gardencorev1beta1.Seed, shoot *
 	return seedsSupportingAccessRestrictions, nil
 }
 
-func applyStrategy(log logr.Logger, shoot *gardencorev1beta1.Shoot, seedList []gardencorev1beta1.Seed, strategy schedulerconfigv1alpha1.CandidateDeterminationStrategy, regionConfig *corev1.ConfigMap) ([]gardencorev1beta1.Seed, error) {
+// filterSeedsForDataResidency filters seeds whose regions do not satisfy the data residency constraints configured in
+// the shoot. It fails if the region of the shoot itself does not satisfy the constraints.
+func filterSeedsForDataResidency(seedList []gardencorev1beta1.Seed, shoot *gardencorev1beta1.Shoot, cloudProfileSpec *gardencorev1beta1.CloudProfileSpec) ([]gardencorev1beta1.Seed, error) {
+	if shoot.Spec.DataResidency == nil {
+		return seedList, nil
+	}
+
+	if !v1beta1helper.DataResidencyIsSatisfied(v1beta1helper.GetRegionMetadata(cloudProfileSpec, shoot.Spec.Region), shoot.Spec.DataResidency) {
+		return nil, fmt.Errorf("region %q of the shoot does not satisfy the data residency constraints configured in the shoot specification", shoot.Spec.Region)
+	}
+
+	var seedsSatisfyingDataResidency []gardencorev1beta1.Seed
+	for _, seed := range seedList {
+		if v1beta1helper.DataResidencyIsSatisfied(v1beta1helper.GetSeedRegionMetadata(&seed, cloudProfileSpec), shoot.Spec.DataResidency) {
+			seedsSatisfyingDataResidency = append(seedsSatisfyingDataResidency, seed)
+		}
+	}
+
+	if len(seedsSatisfyingDataResidency) == 0 {
+		return nil, fmt.Errorf("none of the %d seeds satisfies the data residency constraints configured in the shoot specification", len(seedList))
+	}
+	return seedsSatisfyingDataResidency, nil
+}
+
+func applyStrategy(log logr.Logger, shoot *gardencorev1beta1.Shoot, seedList []gardencorev1beta1.Seed, strategy schedulerconfigv1alpha1.CandidateDeterminationStrategy, regionConfig *corev1.ConfigMap, cloudProfileSpec *gardencorev1beta1.CloudProfileSpec) ([]gardencorev1beta1.Seed, error) {
 	var candidates []gardencorev1beta1.Seed
 
 	switch {
@@ -309,7 +339,7 @@ func applyStrategy(log logr.Logger, shoot *gardencorev1beta1.Shoot, seedList []g
 		candidates = determineCandidatesWithSameRegionStrategy(seedList, shoot)
 	case strategy == schedulerconfigv1alpha1.MinimalDistance:
 		var err error
-		candidates, err = determineCandidatesWithMinimalDistanceStrategy(log, shoot, seedList, regionConfig)
+		candidates, err = determineCandidatesWithMinimalDistanceStrategy(log, shoot, seedList, regionConfig, cloudProfileSpec)
 		if err != nil {
 			return nil, err
 		}
@@ -329,7 +359,7 @@ func applyStrategy(log logr.Logger, shoot *gardencorev1beta1.Shoot, seedList []g
 	return candidates, nil
 }
 
-func filterCandidates(shoot *gardencorev1beta1.Shoot, shootList []*gardencorev1beta1.Shoot, seedList []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, error) {
+func filterCandidates(shoot *gardencorev1beta1.Shoot, shootList []*gardencorev1beta1.Shoot, seedList []gardencorev1beta1.Seed, resourceRequests corev1.ResourceList) ([]gardencorev1beta1.Seed, error) {
 	var (
 		candidates    []gardencorev1beta1.Seed
 		seedNameToErr = make(map[string]error)
@@ -349,8 +379,8 @@ func filterCandidates(shoot *gardencorev1beta1.Shoot, shootList []*gardencorev1b
 			continue
 		}
 
-		if allocatableShoots, ok := seed.Status.Allocatable[gardencorev1beta1.ResourceShoots]; ok && int64(seedUsage[seed.Name]) >= allocatableShoots.Value() {
-			seedNameToErr[seed.Name] = errors.New("seed does not have available capacity for shoots")
+		if err := checkSeedCapacity(&seed, seedUsage[seed.Name], resourceRequests); err != nil {
+			seedNameToErr[seed.Name] = err
 			continue
 		}
 
@@ -363,18 +393,61 @@ func filterCandidates(shoot *gardencorev1beta1.Shoot, shootList []*gardencorev1b
 	return candidates, nil
 }
 
-// getSeedWithLeastShootsDeployed finds the best candidate (i.e. the one managing the smallest number of shoots right now).
-func getSeedWithLeastShootsDeployed(seedList []gardencorev1beta1.Seed, shootList []*gardencorev1beta1.Shoot) (*gardencorev1beta1.Seed, error) {
+// preferSeedsWithoutUntoleratedTaints drops the seeds with `PreferNoSchedule` taints which are not tolerated by the
+// shoot, unless there are only such seeds.
+func preferSeedsWithoutUntoleratedTaints(seedList []gardencorev1beta1.Seed, shoot *gardencorev1beta1.Shoot) []gardencorev1beta1.Seed {
+	var preferred []gardencorev1beta1.Seed
+
+	for _, seed := range seedList {
+		if v1beta1helper.PreferNoScheduleTaintsAreTolerated(seed.Spec.Taints, shoot.Spec.Tolerations) {
+			preferred = append(preferred, seed)
+		}
+	}
+
+	if len(preferred) == 0 {
+		return seedList
+	}
+	return preferred
+}
+
+// checkSeedCapacity checks whether the allocatable resources of the seed would be exceeded if another shoot was
+// scheduled onto it. Each shoot requests one unit of the `shoots` resource and the configured amount of all other
+// resources. Allocatable resources which are not requested by shoots are ignored.
+func checkSeedCapacity(seed *gardencorev1beta1.Seed, numberOfShoots int, resourceRequests corev1.ResourceList) error {
+	resourceNames := maps.Keys(seed.Status.Allocatable)
+	slices.Sort(resourceNames)
+
+	for _, resourceName := range resourceNames {
+		request, ok := resourceRequests[resourceName]
+		if resourceName == gardencorev1beta1.ResourceShoots {
+			request, ok = resource.MustParse("1"), true
+		}
+		if !ok || request.IsZero() {
+			continue
+		}
+
+		allocatable := seed.Status.Allocatable[resourceName]
+		if request.MilliValue()*int64(numberOfShoots+1) > allocatable.MilliValue() {
+			return fmt.Errorf("seed does not have available capacity for %s", resourceName)
+		}
+	}
+
+	return nil
+}
+
+// getSeedWithLowestUsage finds the best candidate, i.e. the one with the lowest usage right now. The usage of a seed is
+// the sum of the size class weights of all shoots it manages, so that larger shoots count more than smaller ones.
+func getSeedWithLowestUsage(seedList []gardencorev1beta1.Seed, shootList []*gardencorev1beta1.Shoot) (*gardencorev1beta1.Seed, error) {
 	var (
 		bestCandidate gardencorev1beta1.Seed
-		min           *int
-		seedUsage     = v1beta1helper.CalculateSeedUsage(shootList)
+		min           *int64
+		seedUsage     = v1beta1helper.CalculateWeightedSeedUsage(shootList)
 	)
 
 	for _, seed := range seedList {
-		if numberOfManagedShoots := seedUsage[seed.Name]; min == nil || numberOfManagedShoots < *min {
+		if usage := seedUsage[seed.Name]; min == nil || usage < *min {
 			bestCandidate = seed
-			min = &numberOfManagedShoots
+			min = &usage
 		}
 	}
 
@@ -415,8 +488,8 @@ func determineCandidatesWithSameRegionStrategy(seedList []gardencorev1beta1.Seed
 	return candidates
 }
 
-func determineCandidatesWithMinimalDistanceStrategy(log logr.Logger, shoot *gardencorev1beta1.Shoot, seedList []gardencorev1beta1.Seed, regionConfig *corev1.ConfigMap) ([]gardencorev1beta1.Seed, error) {
-	candidates, err := regionConfigMinimalDistance(log, seedList, shoot, regionConfig)
+func determineCandidatesWithMinimalDistanceStrategy(log logr.Logger, shoot *gardencorev1beta1.Shoot, seedList []gardencorev1beta1.Seed, regionConfig *corev1.ConfigMap, cloudProfileSpec *gardencorev1beta1.CloudProfileSpec) ([]gardencorev1beta1.Seed, error) {
+	candidates, err := regionConfigMinimalDistance(log, seedList, shoot, regionConfig, cloudProfileSpec)
 	if err != nil {
 		return nil, err
 	}
@@ -429,29 +502,34 @@ func determineCandidatesWithMinimalDistanceStrategy(log logr.Logger, shoot *gard
 	return candidates, nil
 }
 
-func regionConfigMinimalDistance(log logr.Logger, seeds []gardencorev1beta1.Seed, shoot *gardencorev1beta1.Shoot, regionConfig *corev1.ConfigMap) ([]gardencorev1beta1.Seed, error) {
-	var candidates []gardencorev1beta1.Seed
+func regionConfigMinimalDistance(log logr.Logger, seeds []gardencorev1beta1.Seed, shoot *gardencorev1beta1.Shoot, regionConfig *corev1.ConfigMap, cloudProfileSpec *gardencorev1beta1.CloudProfileSpec) ([]gardencorev1beta1.Seed, error) {
+	var (
+		candidates []gardencorev1beta1.Seed
+		shootKey   = latencyMatrixKey(v1beta1helper.GetRegionMetadata(cloudProfileSpec, shoot.Spec.Region), shoot.Spec.Region)
+	)
 
-	if regionConfig == nil || regionConfig.Data[shoot.Spec.Region] == "" {
-		log.Info("Region ConfigMap not provided or Shoot region not available", "region", shoot.Spec.Region)
+	if regionConfig == nil || regionConfig.Data[shootKey] == "" {
+		log.Info("Region ConfigMap not provided or Shoot region not available", "region", shoot.Spec.Region, "latencyMatrixKey", shootKey)
 		return candidates, nil
 	}
 
 	regionConfigData := make(map[string]int)
-	if err := yaml.Unmarshal([]byte(regionConfig.Data[shoot.Spec.Region]), &regionConfigData); err != nil {
-		return nil, fmt.Errorf("failed to determine seed candidates. Wrong format in region ConfigMap %s/%s, Region %q: %w", regionConfig.Namespace, regionConfig.Name, shoot.Spec.Region, err)
+	if err := yaml.Unmarshal([]byte(regionConfig.Data[shootKey]), &regionConfigData); err != nil {
+		return nil, fmt.Errorf("failed to determine seed candidates. Wrong format in region ConfigMap %s/%s, Region %q: %w", regionConfig.Namespace, regionConfig.Name, shootKey, err)
 	}
 
 	// If not configured otherwise, assume that a region has the smallest possible distance to itself.
-	if _, ok := regionConfigData[shoot.Spec.Region]; !ok {
-		regionConfigData[shoot.Spec.Region] = 0
+	if _, ok := regionConfigData[shootKey]; !ok {
+		regionConfigData[shootKey] = 0
 	}
 
 	minDistance := math.MaxInt32
 	for _, seed := range seeds {
-		dist, ok := regionConfigData[seed.Spec.Provider.Region]
+		seedKey := latencyMatrixKey(v1beta1helper.GetSeedRegionMetadata(&seed, cloudProfileSpec), seed.Spec.Provider.Region)
+
+		dist, ok := regionConfigData[seedKey]
 		if !ok {
-			log.Info("Seed region not available in scheduler region ConfigMap for shoot region", "seedName", seed.Name, "shootRegion", shoot.Spec.Region, "seedRegion", seed.Spec.Provider.Region)
+			log.Info("Seed region not available in scheduler region ConfigMap for shoot region", "seedName", seed.Name, "shootRegion", shoot.Spec.Region, "seedRegion", seed.Spec.Provider.Region, "latencyMatrixKey", seedKey)
 			continue
 		}
 
@@ -469,6 +547,14 @@ func regionConfigMinimalDistance(log logr.Logger, seeds []gardencorev1beta1.Seed
 	return candidates, nil
 }
 
+// latencyMatrixKey returns the key of a region in the scheduler's region ConfigMap. It defaults to the region name.
+func latencyMatrixKey(regionMetadata *gardencorev1beta1.RegionMetadata, regionName string) string {
+	if regionMetadata != nil && regionMetadata.LatencyMatrixKey != nil {
+		return *regionMetadata.LatencyMatrixKey
+	}
+	return regionName
+}
+
 func levenshteinMinimalDistance(seeds []gardencorev1beta1.Seed, shoot *gardencorev1beta1.Shoot) []gardencorev1beta1.Seed {
 	var (
 		minDistance   = 1000
@@ -458,6 +458,80 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(shoot.Spec.Region).NotTo(Equal(bestSeed.Spec.Provider.Region))
 		})
 
+		It("should only consider seeds satisfying the data residency constraints of the shoot", func() {
+			cloudProfile.Spec.Regions = []gardencorev1beta1.Region{{Name: region, Metadata: &gardencorev1beta1.RegionMetadata{Jurisdiction: ptr.To("EU")}}}
+			seed.Spec.Provider.RegionMetadata = &gardencorev1beta1.RegionMetadata{Jurisdiction: ptr.To("US")}
+
+			secondSeed := seedBase
+			secondSeed.Name = "seed-2"
+			secondSeed.Spec.Provider.Region = "europe-west1"
+			secondSeed.Spec.Provider.RegionMetadata = &gardencorev1beta1.RegionMetadata{Jurisdiction: ptr.To("EU")}
+
+			shoot.Spec.DataResidency = &gardencorev1beta1.DataResidency{Jurisdictions: []string{"EU"}}
+
+			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, &secondSeed)).To(Succeed())
+
+			bestSeed, err := reconciler.DetermineSeed(ctx, log, shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(secondSeed.Name))
+		})
+
+		It("should fail if the region of the shoot does not satisfy the data residency constraints of the shoot", func() {
+			cloudProfile.Spec.Regions = []gardencorev1beta1.Region{{Name: region, Metadata: &gardencorev1beta1.RegionMetadata{Jurisdiction: ptr.To("US")}}}
+			seed.Spec.Provider.RegionMetadata = &gardencorev1beta1.RegionMetadata{Jurisdiction: ptr.To("EU")}
+			shoot.Spec.DataResidency = &gardencorev1beta1.DataResidency{Jurisdictions: []string{"EU"}}
+
+			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
+
+			_, err := reconciler.DetermineSeed(ctx, log, shoot)
+			Expect(err).To(MatchError(ContainSubstring("of the shoot does not satisfy the data residency constraints")))
+		})
+
+		It("should fail if no seed satisfies the data residency constraints of the shoot", func() {
+			cloudProfile.Spec.Regions = []gardencorev1beta1.Region{{Name: region, Metadata: &gardencorev1beta1.RegionMetadata{Jurisdiction: ptr.To("EU")}}}
+			seed.Spec.Provider.RegionMetadata = &gardencorev1beta1.RegionMetadata{Jurisdiction: ptr.To("US")}
+			shoot.Spec.DataResidency = &gardencorev1beta1.DataResidency{Jurisdictions: []string{"EU"}}
+
+			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
+
+			_, err := reconciler.DetermineSeed(ctx, log, shoot)
+			Expect(err).To(MatchError(ContainSubstring("none of the 1 seeds satisfies the data residency constraints")))
+		})
+
+		It("should use the latency matrix keys of the regions when looking up the region config", func() {
+			cloudProfile.Spec.Regions = []gardencorev1beta1.Region{{
+				Name:     region,
+				Metadata: &gardencorev1beta1.RegionMetadata{LatencyMatrixKey: ptr.To("eu-central")},
+			}}
+
+			seed.Spec.Provider.Region = "region-1"
+			seed.Spec.Provider.RegionMetadata = &gardencorev1beta1.RegionMetadata{LatencyMatrixKey: ptr.To("eu-west")}
+
+			secondSeed := seedBase
+			secondSeed.Name = "seed-2"
+
+			Expect(fakeGardenClient.Create(ctx, &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "region-config",
+					Namespace:   "garden",
+					Labels:      map[string]string{"scheduling.gardener.cloud/purpose": "region-config"},
+					Annotations: map[string]string{"scheduling.gardener.cloud/cloudprofiles": cloudProfileName},
+				},
+				Data: map[string]string{"eu-central": "eu-west: 10\nus-east: 100"},
+			})).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, &secondSeed)).To(Succeed())
+
+			bestSeed, err := reconciler.DetermineSeed(ctx, log, shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(seedName))
+		})
+
 		It("should pick candidate with least shoots deployed", func() {
 			secondSeed := seedBase
 			secondSeed.Name = "seed-2"
@@ -478,6 +552,37 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(bestSeed.Name).To(Equal(secondSeed.Name))
 		})
 
+		It("should pick candidate with lowest usage based on the size classes of the shoots", func() {
+			secondSeed := seedBase
+			secondSeed.Name = "seed-2"
+
+			// first seed hosts one extra-large shoot, second seed hosts two small shoots -> expect seed-2 to be selected
+			largeShoot := shootBase
+			largeShoot.Name = "shoot-large"
+			largeShoot.Spec.SeedName = &seed.Name
+			largeShoot.Status.SizeClass = ptr.To(gardencorev1beta1.ShootSizeClassExtraLarge)
+
+			smallShoot1 := shootBase
+			smallShoot1.Name = "shoot-small-1"
+			smallShoot1.Spec.SeedName = &secondSeed.Name
+			smallShoot1.Status.SizeClass = ptr.To(gardencorev1beta1.ShootSizeClassSmall)
+
+			smallShoot2 := smallShoot1
+			smallShoot2.Name = "shoot-small-2"
+
+			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, &secondSeed)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, shoot)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, &largeShoot)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, &smallShoot1)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, &smallShoot2)).To(Succeed())
+
+			bestSeed, err := reconciler.DetermineSeed(ctx, log, shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(secondSeed.Name))
+		})
+
 		It("should find seed cluster that matches the seed selector of the CloudProfile and is from another region", func() {
 			newCloudProfile := cloudProfile.DeepCopy()
 			newCloudProfile.Name = "cloudprofile2"
@@ -598,6 +703,41 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(err).NotTo(HaveOccurred())
 			Expect(bestSeed.Name).To(Equal(secondSeed.Name))
 		})
+
+		It("should find seed cluster with enough available capacity for requested resources", func() {
+			schedulerConfiguration.Schedulers.Shoot.ResourceRequests = corev1.ResourceList{"persistent-volumes": resource.MustParse("3")}
+
+			seed.Status.Allocatable = corev1.ResourceList{
+				gardencorev1beta1.ResourceShoots: resource.MustParse("10"),
+				"persistent-volumes":             resource.MustParse("5"),
+			}
+
+			secondSeed := seedBase
+			secondSeed.Name = "seed-2"
+			secondSeed.Status.Allocatable = corev1.ResourceList{
+				"persistent-volumes": resource.MustParse("10"),
+				"load-balancers":     resource.MustParse("0"),
+			}
+
+			secondShoot := shootBase
+			secondShoot.Name = "shoot-2"
+			secondShoot.Spec.SeedName = &seed.Name
+
+			thirdShoot := shootBase
+			thirdShoot.Name = "shoot-3"
+			thirdShoot.Spec.SeedName = &secondSeed.Name
+
+			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, &secondSeed)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, shoot)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, &secondShoot)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, &thirdShoot)).To(Succeed())
+
+			bestSeed, err := reconciler.DetermineSeed(ctx, log, shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(secondSeed.Name))
+		})
 	})
 
 	Context("SEED DETERMINATION - Shoot does not reference a Seed - find an adequate one using default seed determination strategy", func() {
@@ -655,6 +795,31 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(bestSeed.Name).To(Equal(secondSeed.Name))
 		})
 
+		It("should avoid a seed cluster with a non-tolerated PreferNoSchedule taint", func() {
+			seed2 := seed.DeepCopy()
+			seed2.Name = "seed-2"
+			seed.Spec.Taints = []gardencorev1beta1.SeedTaint{{Key: "foo", Effect: ptr.To(gardencorev1beta1.SeedTaintEffectPreferNoSchedule)}}
+
+			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seed2)).To(Succeed())
+
+			bestSeed, err := reconciler.DetermineSeed(ctx, log, shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(seed2.Name))
+		})
+
+		It("should find a seed cluster with a non-tolerated PreferNoSchedule taint if there is no other candidate", func() {
+			seed.Spec.Taints = []gardencorev1beta1.SeedTaint{{Key: "foo", Effect: ptr.To(gardencorev1beta1.SeedTaintEffectPreferNoSchedule)}}
+
+			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
+
+			bestSeed, err := reconciler.DetermineSeed(ctx, log, shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(seedName))
+		})
+
 		// FAIL
 
 		It("should fail because it cannot find a seed cluster due to network disjointedness", func() {
@@ -676,7 +841,7 @@ var _ = Describe("Scheduler_Control", func() {
 			seed2 := seed.DeepCopy()
 			seed2.Name = "seed-2"
 			seed.Spec.Taints = []gardencorev1beta1.SeedTaint{{Key: "foo"}}
-			seed2.Spec.Taints = []gardencorev1beta1.SeedTaint{{Key: "bar"}}
+			seed2.Spec.Taints = []gardencorev1beta1.SeedTaint{{Key: "bar", Effect: ptr.To(gardencorev1beta1.SeedTaintEffectNoExecute)}}
 			shoot.Spec.Tolerations = nil
 
 			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
@@ -688,6 +853,21 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(bestSeed).To(BeNil())
 		})
 
+		It("should fail because it cannot find a seed cluster due to no available capacity for requested resources", func() {
+			schedulerConfiguration.Schedulers.Shoot.ResourceRequests = corev1.ResourceList{"load-balancers": resource.MustParse("1")}
+			seed.Status.Allocatable = corev1.ResourceList{
+				"load-balancers": resource.MustParse("0"),
+			}
+
+			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, shoot)).To(Succeed())
+
+			bestSeed, err := reconciler.DetermineSeed(ctx, log, shoot)
+			Expect(err).To(MatchError(ContainSubstring("seed does not have available capacity for load-balancers")))
+			Expect(bestSeed).To(BeNil())
+		})
+
 		It("should fail because it cannot find a seed cluster due to no available capacity for shoots", func() {
 			seed.Status.Allocatable = corev1.ResourceList{
 				gardencorev1beta1.ResourceShoots: resource.MustParse("1"),
@@ -846,7 +1026,7 @@ var _ = Describe("Scheduler_Control", func() {
 			testShoot.Spec.CloudProfileName = ptr.To("cloudprofile2")
 			testShoot.Spec.Provider.Type = "some-type"
 
-			candidates, err := applyStrategy(log, testShoot, []gardencorev1beta1.Seed{newSeedEnvironment2, oldSeedEnvironment1, otherSeedEnvironment2}, schedulerConfiguration.Schedulers.Shoot.Strategy, nil)
+			candidates, err := applyStrategy(log, testShoot, []gardencorev1beta1.Seed{newSeedEnvironment2, oldSeedEnvironment1, otherSeedEnvironment2}, schedulerConfiguration.Schedulers.Shoot.Strategy, nil, nil)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(candidates).To(HaveLen(2))
 			Expect(candidates[0].Name).To(Equal(newSeedEnvironment2.Name))
@@ -875,7 +1055,7 @@ var _ = Describe("Scheduler_Control", func() {
 			testShoot.Spec.CloudProfileName = ptr.To("cloudprofile2")
 			testShoot.Spec.Provider.Type = "some-type"
 
-			candidates, err := applyStrategy(log, testShoot, []gardencorev1beta1.Seed{newSeedEnvironment2, oldSeedEnvironment1, otherSeedEnvironment2}, schedulerConfiguration.Schedulers.Shoot.Strategy, nil)
+			candidates, err := applyStrategy(log, testShoot, []gardencorev1beta1.Seed{newSeedEnvironment2, oldSeedEnvironment1, otherSeedEnvironment2}, schedulerConfiguration.Schedulers.Shoot.Strategy, nil, nil)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(candidates).To(HaveLen(1))
 			Expect(candidates[0].Name).To(Equal(oldSeedEnvironment1.Name))
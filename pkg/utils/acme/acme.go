@@ -0,0 +1,210 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package acme
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"golang.org/x/crypto/acme"
+)
+
+// ChallengeTypeDNS01 is the type of the ACME challenge which is solved by publishing a TXT record.
+const ChallengeTypeDNS01 = "dns-01"
+
+// DNS01Solver publishes the TXT record values for DNS-01 challenges.
+type DNS01Solver interface {
+	// Present publishes the given values in the TXT record the challenges of all domains are delegated to and waits
+	// until the record is available.
+	Present(ctx context.Context, values []string) error
+	// CleanUp removes the TXT record.
+	CleanUp(ctx context.Context) error
+}
+
+// CertificateIssuer issues certificates for domains.
+type CertificateIssuer interface {
+	// Issue returns the PEM encoded certificate chain for the given domains and private key.
+	Issue(ctx context.Context, domains []string, privateKey crypto.Signer) ([]byte, error)
+}
+
+// Issuer issues certificates via the ACME protocol (RFC 8555) by solving DNS-01 challenges.
+type Issuer struct {
+	// Client is the ACME client. Its key is the key of the ACME account.
+	Client *acme.Client
+	// Email is the optional contact email address of the ACME account.
+	Email *string
+	// Solver publishes the TXT record values for the DNS-01 challenges.
+	Solver DNS01Solver
+}
+
+var _ CertificateIssuer = &Issuer{}
+
+// Issue registers the ACME account if necessary, orders a certificate for the given domains and returns the PEM
+// encoded certificate chain for the given private key.
+func (i *Issuer) Issue(ctx context.Context, domains []string, privateKey crypto.Signer) ([]byte, error) {
+	if err := i.register(ctx); err != nil {
+		return nil, fmt.Errorf("failed registering ACME account: %w", err)
+	}
+
+	order, err := i.Client.AuthorizeOrder(ctx, acme.DomainIDs(domains...))
+	if err != nil {
+		return nil, fmt.Errorf("failed creating ACME order: %w", err)
+	}
+
+	var (
+		authorizations []*acme.Authorization
+		challenges     []*acme.Challenge
+		recordValues   []string
+	)
+
+	for _, authzURL := range order.AuthzURLs {
+		authz, err := i.Client.GetAuthorization(ctx, authzURL)
+		if err != nil {
+			return nil, fmt.Errorf("failed getting ACME authorization: %w", err)
+		}
+
+		if authz.Status == acme.StatusValid {
+			continue
+		}
+
+		idx := slices.IndexFunc(authz.Challenges, func(challenge *acme.Challenge) bool { return challenge.Type == ChallengeTypeDNS01 })
+		if idx == -1 {
+			return nil, fmt.Errorf("ACME server does not offer a %s challenge for domain %q", ChallengeTypeDNS01, authz.Identifier.Value)
+		}
+
+		value, err := i.Client.DNS01ChallengeRecord(authz.Challenges[idx].Token)
+		if err != nil {
+			return nil, fmt.Errorf("failed computing %s challenge record for domain %q: %w", ChallengeTypeDNS01, authz.Identifier.Value, err)
+		}
+
+		authorizations = append(authorizations, authz)
+		challenges = append(challenges, authz.Challenges[idx])
+		recordValues = append(recordValues, value)
+	}
+
+	if len(challenges) > 0 {
+		if err := i.Solver.Present(ctx, recordValues); err != nil {
+			return nil, fmt.Errorf("failed presenting %s challenge records: %w", ChallengeTypeDNS01, err)
+		}
+
+		err := i.acceptChallenges(ctx, authorizations, challenges)
+		if cleanUpErr := i.Solver.CleanUp(ctx); cleanUpErr != nil {
+			err = errors.Join(err, fmt.Errorf("failed cleaning up %s challenge records: %w", ChallengeTypeDNS01, cleanUpErr))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if order, err = i.Client.WaitOrder(ctx, order.URI); err != nil {
+		return nil, fmt.Errorf("failed waiting for ACME order to become ready: %w", err)
+	}
+
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{DNSNames: domains}, privateKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed creating certificate signing request: %w", err)
+	}
+
+	chain, _, err := i.Client.CreateOrderCert(ctx, order.FinalizeURL, csr, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed finalizing ACME order: %w", err)
+	}
+
+	var certificate []byte
+	for _, der := range chain {
+		certificate = append(certificate, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})...)
+	}
+	return certificate, nil
+}
+
+func (i *Issuer) register(ctx context.Context) error {
+	account := &acme.Account{}
+	if i.Email != nil {
+		account.Contact = []string{"mailto:" + *i.Email}
+	}
+
+	if _, err := i.Client.Register(ctx, account, acme.AcceptTOS); err != nil && !errors.Is(err, acme.ErrAccountAlreadyExists) {
+		return err
+	}
+	return nil
+}
+
+func (i *Issuer) acceptChallenges(ctx context.Context, authorizations []*acme.Authorization, challenges []*acme.Challenge) error {
+	for _, challenge := range challenges {
+		if _, err := i.Client.Accept(ctx, challenge); err != nil {
+			return fmt.Errorf("failed accepting %s challenge: %w", ChallengeTypeDNS01, err)
+		}
+	}
+
+	for _, authz := range authorizations {
+		if _, err := i.Client.WaitAuthorization(ctx, authz.URI); err != nil {
+			return fmt.Errorf("authorization for domain %q failed: %w", authz.Identifier.Value, err)
+		}
+	}
+
+	return nil
+}
+
+// GenerateKey generates a new private key for an ACME account or a certificate and returns it together with its PEM
+// encoding.
+func GenerateKey() (*ecdsa.PrivateKey, []byte, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	der, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return key, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}), nil
+}
+
+// ParseKey parses a PEM encoded private key generated by GenerateKey.
+func ParseKey(data []byte) (*ecdsa.PrivateKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != "EC PRIVATE KEY" {
+		return nil, errors.New("no PEM encoded EC private key found")
+	}
+	return x509.ParseECPrivateKey(block.Bytes)
+}
+
+// CertificateNeedsRenewal returns true if the given PEM encoded certificate cannot be parsed, does not exactly cover
+// the given domains, or two thirds of its lifetime have elapsed at the given time.
+func CertificateNeedsRenewal(certificate []byte, domains []string, now time.Time) bool {
+	block, _ := pem.Decode(certificate)
+	if block == nil {
+		return true
+	}
+
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return true
+	}
+
+	if !sameDomains(cert.DNSNames, domains) {
+		return true
+	}
+
+	renewalTime := cert.NotBefore.Add(cert.NotAfter.Sub(cert.NotBefore) * 2 / 3)
+	return !now.Before(renewalTime)
+}
+
+func sameDomains(a, b []string) bool {
+	a, b = slices.Clone(a), slices.Clone(b)
+	slices.Sort(a)
+	slices.Sort(b)
+	return slices.Equal(slices.Compact(a), slices.Compact(b))
+}
@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package acme_test
+
+import (
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener/pkg/utils/acme"
+)
+
+var _ = Describe("ACME", func() {
+	Describe("#GenerateKey", func() {
+		It("should generate a key which can be parsed again", func() {
+			key, data, err := GenerateKey()
+			Expect(err).NotTo(HaveOccurred())
+
+			parsedKey, err := ParseKey(data)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(parsedKey.Equal(key)).To(BeTrue())
+		})
+	})
+
+	Describe("#ParseKey", func() {
+		It("should fail if the data does not contain a PEM encoded key", func() {
+			_, err := ParseKey([]byte("foo"))
+			Expect(err).To(MatchError("no PEM encoded EC private key found"))
+		})
+	})
+
+	Describe("#CertificateNeedsRenewal", func() {
+		var (
+			notBefore = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+			notAfter  = notBefore.Add(90 * 24 * time.Hour)
+			domains   = []string{"api.example.com", "kube.example.com"}
+
+			certificate []byte
+		)
+
+		BeforeEach(func() {
+			key, _, err := GenerateKey()
+			Expect(err).NotTo(HaveOccurred())
+
+			template := &x509.Certificate{
+				SerialNumber: big.NewInt(1),
+				Subject:      pkix.Name{CommonName: domains[0]},
+				DNSNames:     domains,
+				NotBefore:    notBefore,
+				NotAfter:     notAfter,
+			}
+			der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+			Expect(err).NotTo(HaveOccurred())
+			certificate = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+		})
+
+		It("should not require a renewal of a fresh certificate", func() {
+			Expect(CertificateNeedsRenewal(certificate, []string{"kube.example.com", "api.example.com"}, notBefore.Add(59*24*time.Hour))).To(BeFalse())
+		})
+
+		It("should require a renewal if two thirds of the lifetime have elapsed", func() {
+			Expect(CertificateNeedsRenewal(certificate, domains, notBefore.Add(60*24*time.Hour))).To(BeTrue())
+		})
+
+		It("should require a renewal if the domains changed", func() {
+			Expect(CertificateNeedsRenewal(certificate, domains[:1], notBefore)).To(BeTrue())
+		})
+
+		It("should require a renewal if the certificate cannot be parsed", func() {
+			Expect(CertificateNeedsRenewal([]byte("foo"), domains, notBefore)).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,125 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package errors
+
+import (
+	"errors"
+	"fmt"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+// Error is a structured error which carries error codes, the affected component and its retryability in addition to
+// its message and cause. It is meant to replace plain fmt.Errorf chains in flow tasks and component deployers so that
+// the information is consistently available for the `.status.lastErrors` of objects and for metrics.
+// Error codes are picked up by `v1beta1helper.ExtractErrorCodes` since Error implements the `Codes() []ErrorCode`
+// interface.
+type Error struct {
+	message   string
+	cause     error
+	component string
+	codes     []gardencorev1beta1.ErrorCode
+	retryable *bool
+}
+
+// New returns a new Error with the given formatted message.
+func New(format string, args ...any) *Error {
+	return &Error{message: fmt.Sprintf(format, args...)}
+}
+
+// Wrap returns a new Error with the given formatted message wrapping the given cause. Its message is rendered like
+// `fmt.Errorf("<message>: %w", cause)`. If cause is nil, Wrap behaves like New, hence callers have to check the cause
+// for nil before wrapping it (like with fmt.Errorf).
+func Wrap(cause error, format string, args ...any) *Error {
+	return &Error{message: fmt.Sprintf(format, args...), cause: cause}
+}
+
+// WithComponent sets the name of the component affected by the error.
+func (e *Error) WithComponent(component string) *Error {
+	e.component = component
+	return e
+}
+
+// WithCodes adds the given error codes to the error.
+func (e *Error) WithCodes(codes ...gardencorev1beta1.ErrorCode) *Error {
+	e.codes = append(e.codes, codes...)
+	return e
+}
+
+// WithRetryable marks the error as retryable or non-retryable.
+func (e *Error) WithRetryable(retryable bool) *Error {
+	e.retryable = &retryable
+	return e
+}
+
+// Error implements the error interface.
+func (e *Error) Error() string {
+	if e.cause == nil {
+		return e.message
+	}
+	if e.message == "" {
+		return e.cause.Error()
+	}
+	return e.message + ": " + e.cause.Error()
+}
+
+// Unwrap returns the cause of the error.
+func (e *Error) Unwrap() error {
+	return e.cause
+}
+
+// Codes returns the error codes of this error and of all errors in its chain of causes without duplicates.
+func (e *Error) Codes() []gardencorev1beta1.ErrorCode {
+	var (
+		codes []gardencorev1beta1.ErrorCode
+		seen  = map[gardencorev1beta1.ErrorCode]struct{}{}
+	)
+
+	add := func(cs ...gardencorev1beta1.ErrorCode) {
+		for _, code := range cs {
+			if _, ok := seen[code]; !ok {
+				seen[code] = struct{}{}
+				codes = append(codes, code)
+			}
+		}
+	}
+
+	add(e.codes...)
+
+	type coder interface {
+		Codes() []gardencorev1beta1.ErrorCode
+	}
+	var c coder
+	if e.cause != nil && errors.As(e.cause, &c) {
+		add(c.Codes()...)
+	}
+
+	return codes
+}
+
+// Component returns the component affected by the given error, i.e. the component of the innermost Error in the chain
+// which has one set. If no component is set, an empty string is returned.
+func Component(err error) string {
+	var component string
+	for err != nil {
+		if e, ok := err.(*Error); ok && e.component != "" {
+			component = e.component
+		}
+		err = errors.Unwrap(err)
+	}
+	return component
+}
+
+// IsRetryable returns false if the given error or any error in its chain was explicitly marked as non-retryable via
+// Error.WithRetryable. The outermost explicit marker takes precedence. Errors without marker are considered retryable.
+func IsRetryable(err error) bool {
+	for err != nil {
+		if e, ok := err.(*Error); ok && e.retryable != nil {
+			return *e.retryable
+		}
+		err = errors.Unwrap(err)
+	}
+	return true
+}
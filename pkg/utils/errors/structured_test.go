@@ -0,0 +1,108 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package errors_test
+
+import (
+	"errors"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	errorsutils "github.com/gardener/gardener/pkg/utils/errors"
+)
+
+var _ = Describe("Structured errors", func() {
+	var cause error
+
+	BeforeEach(func() {
+		cause = errors.New("cause")
+	})
+
+	Describe("#New", func() {
+		It("should format the message", func() {
+			err := errorsutils.New("failed deploying %s", "foo")
+
+			Expect(err).To(MatchError("failed deploying foo"))
+			Expect(err.Unwrap()).To(BeNil())
+		})
+	})
+
+	Describe("#Wrap", func() {
+		It("should behave like New if the cause is nil", func() {
+			err := errorsutils.Wrap(nil, "failed")
+
+			Expect(err).To(MatchError("failed"))
+			Expect(err.Unwrap()).To(BeNil())
+		})
+
+		It("should render the message like fmt.Errorf", func() {
+			err := errorsutils.Wrap(cause, "failed deploying %s", "foo")
+
+			Expect(err).To(MatchError(fmt.Errorf("failed deploying foo: %w", cause).Error()))
+			Expect(err).To(MatchError(cause))
+		})
+
+		It("should only render the cause if the message is empty", func() {
+			Expect(errorsutils.Wrap(cause, "")).To(MatchError("cause"))
+		})
+	})
+
+	Describe("#Codes", func() {
+		It("should return the codes of the whole chain without duplicates", func() {
+			inner := errorsutils.Wrap(cause, "inner").WithCodes(gardencorev1beta1.ErrorInfraQuotaExceeded, gardencorev1beta1.ErrorConfigurationProblem)
+			outer := errorsutils.Wrap(fmt.Errorf("middle: %w", inner), "outer").WithCodes(gardencorev1beta1.ErrorConfigurationProblem)
+
+			Expect(outer.Codes()).To(Equal([]gardencorev1beta1.ErrorCode{gardencorev1beta1.ErrorConfigurationProblem, gardencorev1beta1.ErrorInfraQuotaExceeded}))
+		})
+
+		It("should consider codes of other error types", func() {
+			err := errorsutils.Wrap(v1beta1helper.NewErrorWithCodes(cause, gardencorev1beta1.ErrorInfraDependencies), "outer")
+
+			Expect(err.Codes()).To(ConsistOf(gardencorev1beta1.ErrorInfraDependencies))
+		})
+
+		It("should be picked up when extracting error codes", func() {
+			err := fmt.Errorf("task failed: %w", errorsutils.New("failed").WithCodes(gardencorev1beta1.ErrorInfraUnauthorized))
+
+			Expect(v1beta1helper.ExtractErrorCodes(err)).To(ConsistOf(gardencorev1beta1.ErrorInfraUnauthorized))
+		})
+	})
+
+	Describe("#Component", func() {
+		It("should return an empty string if no component is set", func() {
+			Expect(errorsutils.Component(cause)).To(BeEmpty())
+			Expect(errorsutils.Component(errorsutils.Wrap(cause, "failed"))).To(BeEmpty())
+		})
+
+		It("should return the innermost component", func() {
+			inner := errorsutils.Wrap(cause, "inner").WithComponent("etcd-main")
+			outer := errorsutils.Wrap(fmt.Errorf("middle: %w", inner), "outer").WithComponent("botanist")
+
+			Expect(errorsutils.Component(outer)).To(Equal("etcd-main"))
+		})
+	})
+
+	Describe("#IsRetryable", func() {
+		It("should consider errors without marker as retryable", func() {
+			Expect(errorsutils.IsRetryable(cause)).To(BeTrue())
+			Expect(errorsutils.IsRetryable(errorsutils.Wrap(cause, "failed"))).To(BeTrue())
+		})
+
+		It("should detect non-retryable errors in the chain", func() {
+			err := fmt.Errorf("outer: %w", errorsutils.Wrap(cause, "failed").WithRetryable(false))
+
+			Expect(errorsutils.IsRetryable(err)).To(BeFalse())
+		})
+
+		It("should let the outermost marker take precedence", func() {
+			err := errorsutils.Wrap(errorsutils.Wrap(cause, "inner").WithRetryable(false), "outer").WithRetryable(true)
+
+			Expect(errorsutils.IsRetryable(err)).To(BeTrue())
+		})
+	})
+})
@@ -102,8 +102,27 @@ type Opts struct {
 	ErrorCleaner func(ctx context.Context, taskID string)
 	// ErrorContext is used to store any error related context.
 	ErrorContext *errorsutils.ErrorContext
+	// TaskRecorder is called for every task which has been executed (i.e., not skipped) once it has finished.
+	TaskRecorder TaskRecorderFn
 }
 
+// TaskRecord contains information about a task which has been executed as part of a Flow.
+type TaskRecord struct {
+	// FlowName is the name of the Flow.
+	FlowName string
+	// TaskID is the ID of the task.
+	TaskID TaskID
+	// Start is the time when the task was started.
+	Start time.Time
+	// Duration is the duration of the task.
+	Duration time.Duration
+	// Error is the error returned by the task, if any.
+	Error error
+}
+
+// TaskRecorderFn is called for every executed task of a Flow once it has finished.
+type TaskRecorderFn func(TaskRecord)
+
 // Run starts an execution of a Flow.
 // It blocks until the Flow has finished and returns the error, if any.
 func (f *Flow) Run(ctx context.Context, opts Opts) error {
@@ -185,6 +204,7 @@ func newExecution(flow *Flow, opts Opts) *execution {
 		opts.ProgressReporter,
 		opts.ErrorCleaner,
 		opts.ErrorContext,
+		opts.TaskRecorder,
 		make(chan *nodeResult),
 		make(map[TaskID]int),
 	}
@@ -200,6 +220,7 @@ type execution struct {
 	progressReporter ProgressReporter
 	errorCleaner     ErrorCleaner
 	errorContext     *errorsutils.ErrorContext
+	taskRecorder     TaskRecorderFn
 
 	done          chan *nodeResult
 	triggerCounts map[TaskID]int
@@ -236,7 +257,7 @@ func (e *execution) runNode(ctx context.Context, id TaskID) {
 		log.V(1).Info("Finished", "duration", duration)
 
 		if err != nil {
-			log.Error(err, "Error")
+			log.Error(err, "Error", "component", errorsutils.Component(err), "retryable", errorsutils.IsRetryable(err))
 			err = fmt.Errorf("task %q failed: %w", id, err)
 		} else {
 			log.Info("Succeeded")
@@ -272,6 +293,20 @@ func (e *execution) cleanErrors(ctx context.Context, taskID TaskID) {
 	}
 }
 
+func (e *execution) recordTask(result *nodeResult) {
+	if e.taskRecorder == nil || result.skipped {
+		return
+	}
+
+	e.taskRecorder(TaskRecord{
+		FlowName: e.flow.name,
+		TaskID:   result.TaskID,
+		Start:    e.flow.start.UTC().Add(result.delay),
+		Duration: result.duration,
+		Error:    result.Error,
+	})
+}
+
 func (e *execution) reportProgress(ctx context.Context) {
 	if e.progressReporter != nil {
 		e.progressReporter.Report(ctx, e.stats.Copy())
@@ -307,6 +342,7 @@ func (e *execution) run(ctx context.Context) error {
 	for e.stats.Running.Len() > 0 || e.stats.Skipped.Len() > 0 {
 		result := <-e.done
 		e.reportTaskMetrics(result)
+		e.recordTask(result)
 		if result.skipped {
 			e.stats.Skipped.Delete(result.TaskID)
 			if cancelErr = ctx.Err(); cancelErr == nil {
@@ -365,6 +401,11 @@ func (e *execution) reportTaskMetrics(r *nodeResult) {
 	if flowTaskResults != nil {
 		flowTaskResults.WithLabelValues(e.flow.name, string(r.TaskID), utils.IifString(r.Error == nil, "success", "error")).Inc()
 	}
+	if flowTaskErrors != nil && r.Error != nil {
+		flowTaskErrors.
+			WithLabelValues(e.flow.name, string(r.TaskID), errorsutils.Component(r.Error), utils.IifString(errorsutils.IsRetryable(r.Error), "true", "false")).
+			Inc()
+	}
 }
 
 func (e *execution) reportFlowMetrics() {
@@ -147,6 +147,33 @@ var _ = Describe("Flow", func() {
 			Expect(cleaned).To(BeTrue())
 		})
 
+		It("should record all executed tasks", func() {
+			var (
+				err1 = errors.New("err1")
+
+				g = flow.NewGraph("foo")
+				x = g.Add(flow.Task{Name: "x", Fn: func(_ context.Context) error { return nil }})
+				_ = g.Add(flow.Task{Name: "y", Fn: func(_ context.Context) error { return nil }, SkipIf: true})
+				_ = g.Add(flow.Task{Name: "z", Fn: func(_ context.Context) error { return err1 }, Dependencies: flow.NewTaskIDs(x)})
+				f = g.Compile()
+
+				records []flow.TaskRecord
+			)
+
+			Expect(f.Run(ctx, flow.Opts{TaskRecorder: func(record flow.TaskRecord) {
+				records = append(records, record)
+			}})).To(HaveOccurred())
+
+			Expect(records).To(HaveLen(2))
+			Expect(records[0].FlowName).To(Equal("foo"))
+			Expect(records[0].TaskID).To(Equal(flow.TaskID("x")))
+			Expect(records[0].Error).NotTo(HaveOccurred())
+			Expect(records[0].Start).NotTo(BeZero())
+			Expect(records[1].TaskID).To(Equal(flow.TaskID("z")))
+			Expect(records[1].Error).To(MatchError(ContainSubstring("err1")))
+			Expect(records[1].Start).NotTo(BeTemporally("<", records[0].Start))
+		})
+
 		It("should stop the execution after the context has been canceled in between tasks", func() {
 			var (
 				testCtx, cancelTestCtx = context.WithCancel(context.Background())
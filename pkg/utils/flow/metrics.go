@@ -15,6 +15,7 @@ var (
 	flowTaskDelaySeconds    *prometheus.HistogramVec
 	flowTaskDurationSeconds *prometheus.HistogramVec
 	flowTaskResults         *prometheus.CounterVec
+	flowTaskErrors          *prometheus.CounterVec
 	flowDurationSeconds     *prometheus.HistogramVec
 )
 
@@ -69,6 +70,20 @@ func RegisterMetrics(r prometheus.Registerer) {
 		},
 	)
 
+	flowTaskErrors = factory.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "task_errors_total",
+			Help:      "Flow task error counter. The labels 'component' and 'retryable' are taken from structured errors returned by the task.",
+		},
+		[]string{
+			"flow",
+			"task_id",
+			"component",
+			"retryable",
+		},
+	)
+
 	flowDurationSeconds = factory.NewHistogramVec(
 		prometheus.HistogramOpts{
 			Namespace: metricsNamespace,
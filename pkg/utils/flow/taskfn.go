@@ -11,6 +11,7 @@ import (
 
 	"github.com/hashicorp/go-multierror"
 
+	errorsutils "github.com/gardener/gardener/pkg/utils/errors"
 	"github.com/gardener/gardener/pkg/utils/retry"
 )
 
@@ -36,7 +37,8 @@ func (t TaskFn) Timeout(timeout time.Duration) TaskFn {
 	}
 }
 
-// RetryUntilTimeout returns a TaskFn that is retried until the timeout is reached.
+// RetryUntilTimeout returns a TaskFn that is retried until the timeout is reached. Errors which are explicitly marked as
+// non-retryable (see errorsutils.Error) are returned immediately.
 func (t TaskFn) RetryUntilTimeout(interval, timeout time.Duration) TaskFn {
 	return func(ctx context.Context) error {
 		ctx, cancel := context.WithTimeout(ctx, timeout)
@@ -44,6 +46,9 @@ func (t TaskFn) RetryUntilTimeout(interval, timeout time.Duration) TaskFn {
 
 		return retry.Until(ctx, interval, func(ctx context.Context) (done bool, err error) {
 			if err := t(ctx); err != nil {
+				if !errorsutils.IsRetryable(err) {
+					return retry.SevereError(err)
+				}
 				return retry.MinorError(err)
 			}
 			return retry.Ok()
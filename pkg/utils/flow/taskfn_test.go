@@ -8,6 +8,7 @@ import (
 	"context"
 	"errors"
 	"sync"
+	"time"
 
 	"github.com/hashicorp/go-multierror"
 	. "github.com/onsi/ginkgo/v2"
@@ -16,6 +17,7 @@ import (
 	"go.uber.org/mock/gomock"
 	"k8s.io/apimachinery/pkg/util/sets"
 
+	errorsutils "github.com/gardener/gardener/pkg/utils/errors"
 	"github.com/gardener/gardener/pkg/utils/flow"
 	mockflow "github.com/gardener/gardener/pkg/utils/flow/mock"
 )
@@ -237,6 +239,36 @@ var _ = Describe("task functions", func() {
 			Expect(err.(*multierror.Error).Errors).To(ConsistOf(err1, err2))
 		})
 	})
+
+	Describe("#RetryUntilTimeout", func() {
+		It("should retry the function until it succeeds", func() {
+			var calls int
+			fn := flow.TaskFn(func(_ context.Context) error {
+				calls++
+				if calls < 3 {
+					return errors.New("retryable")
+				}
+				return nil
+			})
+
+			Expect(fn.RetryUntilTimeout(time.Millisecond, time.Minute)(context.Background())).To(Succeed())
+			Expect(calls).To(Equal(3))
+		})
+
+		It("should not retry non-retryable errors", func() {
+			var (
+				calls int
+				cause = errorsutils.New("non-retryable").WithRetryable(false)
+			)
+			fn := flow.TaskFn(func(_ context.Context) error {
+				calls++
+				return cause
+			})
+
+			Expect(fn.RetryUntilTimeout(time.Millisecond, time.Minute)(context.Background())).To(MatchError(cause))
+			Expect(calls).To(Equal(1))
+		})
+	})
 })
 
 func findTasks(taskIds sets.Set[string], tasks *sync.Map) sets.Set[string] {
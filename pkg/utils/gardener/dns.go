@@ -88,6 +88,13 @@ func GetAPIServerDomain(domain string) string {
 	return fmt.Sprintf("%s.%s", APIServerFQDNPrefix, domain)
 }
 
+// GetKubeAPIServerACMEChallengeDomain returns the fully qualified domain name of the TXT record to which the ACME
+// DNS-01 challenges for the managed certificate of the kube-apiserver are delegated. The end result is
+// '_acme-challenge.api.<domain>'.
+func GetKubeAPIServerACMEChallengeDomain(domain string) string {
+	return "_acme-challenge." + GetAPIServerDomain(domain)
+}
+
 // GetOwnerDomain returns the fully qualified domain name for the owner of the Shoot cluster. The
 // end result is 'owner.<domain>'.
 func GetOwnerDomain(domain string) string {
@@ -9,6 +9,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net"
 	"slices"
 	"strconv"
 	"strings"
@@ -80,12 +81,17 @@ func IsNowInEffectiveShootMaintenanceTimeWindow(shoot *gardencorev1beta1.Shoot,
 // window of the shoot and if the <lastReconciliation> did not happen longer than the longest possible duration of a
 // maintenance time window.
 func LastReconciliationDuringThisTimeWindow(shoot *gardencorev1beta1.Shoot, clock clock.Clock) bool {
+	return LastReconciliationDuringTimeWindow(shoot, EffectiveShootMaintenanceTimeWindow(shoot), clock)
+}
+
+// LastReconciliationDuringTimeWindow returns true if <now> is contained in the given time window and if the
+// <lastReconciliation> did not happen longer than the longest possible duration of a maintenance time window.
+func LastReconciliationDuringTimeWindow(shoot *gardencorev1beta1.Shoot, timeWindow *timewindow.MaintenanceTimeWindow, clock clock.Clock) bool {
 	if shoot.Status.LastOperation == nil {
 		return false
 	}
 
 	var (
-		timeWindow         = EffectiveShootMaintenanceTimeWindow(shoot)
 		now                = clock.Now()
 		lastReconciliation = shoot.Status.LastOperation.LastUpdateTime.Time
 	)
@@ -150,6 +156,41 @@ func EffectiveShootMaintenanceTimeWindow(shoot *gardencorev1beta1.Shoot) *timewi
 	return EffectiveMaintenanceTimeWindow(timeWindow)
 }
 
+// EffectiveWorkerPoolMaintenanceTimeWindow returns the effective MaintenanceTimeWindow of the worker pool with the given
+// name. If the worker pool does not define its own time window, the effective MaintenanceTimeWindow of the Shoot is
+// returned.
+func EffectiveWorkerPoolMaintenanceTimeWindow(shoot *gardencorev1beta1.Shoot, workerPoolName string) *timewindow.MaintenanceTimeWindow {
+	if shoot.Spec.Maintenance != nil {
+		for _, workerPool := range shoot.Spec.Maintenance.WorkerPools {
+			if workerPool.Name != workerPoolName {
+				continue
+			}
+
+			timeWindow, err := timewindow.ParseMaintenanceTimeWindow(workerPool.TimeWindow.Begin, workerPool.TimeWindow.End)
+			if err != nil {
+				break
+			}
+			return EffectiveMaintenanceTimeWindow(timeWindow)
+		}
+	}
+
+	return EffectiveShootMaintenanceTimeWindow(shoot)
+}
+
+// EffectiveMaintenanceTimeWindows returns the effective MaintenanceTimeWindow of the given Shoot followed by the
+// effective MaintenanceTimeWindows of all its worker pools which define their own time window.
+func EffectiveMaintenanceTimeWindows(shoot *gardencorev1beta1.Shoot) []*timewindow.MaintenanceTimeWindow {
+	timeWindows := []*timewindow.MaintenanceTimeWindow{EffectiveShootMaintenanceTimeWindow(shoot)}
+
+	if shoot.Spec.Maintenance != nil {
+		for _, workerPool := range shoot.Spec.Maintenance.WorkerPools {
+			timeWindows = append(timeWindows, EffectiveWorkerPoolMaintenanceTimeWindow(shoot, workerPool.Name))
+		}
+	}
+
+	return timeWindows
+}
+
 // GetShootNameFromOwnerReferences attempts to get the name of the Shoot object which owns the passed in object.
 // If it is not owned by a Shoot, an empty string is returned.
 func GetShootNameFromOwnerReferences(objectMeta metav1.Object) string {
@@ -175,6 +216,10 @@ func NodeLabelsForWorkerPool(workerPool gardencorev1beta1.Worker, nodeLocalDNSEn
 
 	if v1beta1helper.SystemComponentsAllowed(&workerPool) {
 		labels[v1beta1constants.LabelWorkerPoolSystemComponents] = "true"
+
+		if workerPool.SystemComponents != nil && workerPool.SystemComponents.ReservedNodesPercentage != nil {
+			labels[v1beta1constants.LabelWorkerPoolSystemComponentsReservedNodesPercentage] = strconv.Itoa(int(*workerPool.SystemComponents.ReservedNodesPercentage))
+		}
 	}
 
 	// worker pool name labels
@@ -211,8 +256,12 @@ const (
 	ShootProjectSecretSuffixOldSSHKeypair = v1beta1constants.SecretNameSSHKeyPair + ".old"
 	// ShootProjectSecretSuffixMonitoring is a constant for a shoot project secret with suffix 'monitoring'.
 	ShootProjectSecretSuffixMonitoring = "monitoring"
+	// ShootProjectSecretSuffixObjectRecovery is a constant for a shoot project secret with suffix 'object-recovery'.
+	ShootProjectSecretSuffixObjectRecovery = "object-recovery"
 	// ShootProjectConfigMapSuffixCACluster is a constant for a shoot project secret with suffix 'ca-cluster'.
 	ShootProjectConfigMapSuffixCACluster = "ca-cluster"
+	// ShootProjectConfigMapSuffixTimeline is a constant for a shoot project config map with suffix 'timeline'.
+	ShootProjectConfigMapSuffixTimeline = "timeline"
 )
 
 // GetShootProjectSecretSuffixes returns the list of shoot-related project secret suffixes.
@@ -223,6 +272,7 @@ func GetShootProjectSecretSuffixes() []string {
 		ShootProjectSecretSuffixSSHKeypair,
 		ShootProjectSecretSuffixOldSSHKeypair,
 		ShootProjectSecretSuffixMonitoring,
+		ShootProjectSecretSuffixObjectRecovery,
 	}
 }
 
@@ -237,6 +287,7 @@ func GetShootProjectInternalSecretSuffixes() []string {
 func GetShootProjectConfigMapSuffixes() []string {
 	return []string{
 		ShootProjectConfigMapSuffixCACluster,
+		ShootProjectConfigMapSuffixTimeline,
 	}
 }
 
@@ -516,9 +567,40 @@ func GetShootSeedNames(obj client.Object) (*string, *string) {
 	return shoot.Spec.SeedName, shoot.Status.SeedName
 }
 
+// TaintsForWorkerPool returns a combined list of all user-specified and gardener-managed node taints.
+func TaintsForWorkerPool(workerPool gardencorev1beta1.Worker, systemComponents *gardencorev1beta1.SystemComponents) []corev1.Taint {
+	if !v1beta1helper.IsSystemComponentsDedicatedPool(systemComponents, workerPool.Name) {
+		return workerPool.Taints
+	}
+
+	// copy worker pool taints to not modify the shoot specification
+	taints := slices.Clone(workerPool.Taints)
+	if !slices.ContainsFunc(taints, func(taint corev1.Taint) bool {
+		return taint.Key == v1beta1constants.TaintWorkerPoolSystemComponentsDedicated
+	}) {
+		taints = append(taints, corev1.Taint{
+			Key:    v1beta1constants.TaintWorkerPoolSystemComponentsDedicated,
+			Value:  "true",
+			Effect: corev1.TaintEffectNoSchedule,
+		})
+	}
+
+	return taints
+}
+
+// SystemComponentsReservedTaint returns the taint which is added to the nodes reserved for system components (see
+// `.spec.provider.workers[].systemComponents.reservedNodesPercentage` in the Shoot API).
+func SystemComponentsReservedTaint() corev1.Taint {
+	return corev1.Taint{
+		Key:    v1beta1constants.LabelWorkerPoolSystemComponentsReserved,
+		Value:  "true",
+		Effect: corev1.TaintEffectNoSchedule,
+	}
+}
+
 // ExtractSystemComponentsTolerations returns tolerations that are required to schedule shoot system components
 // on the given workers. Tolerations are only considered for workers which have `SystemComponents.Allow: true`.
-func ExtractSystemComponentsTolerations(workers []gardencorev1beta1.Worker) []corev1.Toleration {
+func ExtractSystemComponentsTolerations(workers []gardencorev1beta1.Worker, systemComponents *gardencorev1beta1.SystemComponents) []corev1.Toleration {
 	var (
 		tolerations = sets.New[corev1.Toleration]()
 
@@ -529,10 +611,14 @@ func ExtractSystemComponentsTolerations(workers []gardencorev1beta1.Worker) []co
 
 	for _, worker := range workers {
 		if v1beta1helper.SystemComponentsAllowed(&worker) {
-			for _, taint := range worker.Taints {
+			for _, taint := range TaintsForWorkerPool(worker, systemComponents) {
 				toleration := kubernetesutils.TolerationForTaint(taint)
 				tolerations.Insert(comparableTolerations.Transform(toleration))
 			}
+
+			if worker.SystemComponents != nil && worker.SystemComponents.ReservedNodesPercentage != nil {
+				tolerations.Insert(comparableTolerations.Transform(kubernetesutils.TolerationForTaint(SystemComponentsReservedTaint())))
+			}
 		}
 	}
 
@@ -665,6 +751,10 @@ func ComputeRequiredExtensionsForShoot(shoot *gardencorev1beta1.Shoot, seed *gar
 		}
 	}
 
+	if sink := v1beta1helper.GetShootAuditSink(shoot.Spec.Kubernetes.KubeAPIServer); sink != nil {
+		requiredExtensions.Insert(ExtensionsID(extensionsv1alpha1.AuditSinkResource, sink.Type))
+	}
+
 	disabledExtensions := sets.New[string]()
 	for _, extension := range shoot.Spec.Extensions {
 		id := ExtensionsID(extensionsv1alpha1.ExtensionResource, extension.Type)
@@ -775,3 +865,39 @@ func GetIPStackForShoot(shoot *gardencorev1beta1.Shoot) string {
 	}
 	return getIPStackForFamilies(ipFamilies)
 }
+
+// NodesWithoutPodCIDRsOfIPFamilies returns the names of the given nodes which have not been assigned pod CIDRs of all
+// given IP families. Nodes which have not been assigned any pod CIDR yet are not considered. This is used to determine
+// whether the nodes of a shoot have been rolled out after migrating it from single-stack to dual-stack networking.
+func NodesWithoutPodCIDRsOfIPFamilies(nodes []corev1.Node, ipFamilies []gardencorev1beta1.IPFamily) []string {
+	var nodeNames []string
+
+	for _, node := range nodes {
+		podCIDRs := node.Spec.PodCIDRs
+		if len(podCIDRs) == 0 && node.Spec.PodCIDR != "" {
+			podCIDRs = []string{node.Spec.PodCIDR}
+		}
+		if len(podCIDRs) == 0 {
+			continue
+		}
+
+		nodeIPFamilies := sets.New[gardencorev1beta1.IPFamily]()
+		for _, podCIDR := range podCIDRs {
+			ip, _, err := net.ParseCIDR(podCIDR)
+			if err != nil {
+				continue
+			}
+			if ip.To4() != nil {
+				nodeIPFamilies.Insert(gardencorev1beta1.IPFamilyIPv4)
+			} else {
+				nodeIPFamilies.Insert(gardencorev1beta1.IPFamilyIPv6)
+			}
+		}
+
+		if !nodeIPFamilies.HasAll(ipFamilies...) {
+			nodeNames = append(nodeNames, node.Name)
+		}
+	}
+
+	return nodeNames
+}
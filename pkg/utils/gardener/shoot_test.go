@@ -257,6 +257,59 @@ var _ = Describe("Shoot", func() {
 				timewindow.NewMaintenanceTime(1, 45, 0))),
 	)
 
+	Describe("#EffectiveWorkerPoolMaintenanceTimeWindow", func() {
+		var shoot *gardencorev1beta1.Shoot
+
+		BeforeEach(func() {
+			shoot = &gardencorev1beta1.Shoot{
+				Spec: gardencorev1beta1.ShootSpec{
+					Maintenance: &gardencorev1beta1.Maintenance{
+						TimeWindow: &gardencorev1beta1.MaintenanceTimeWindow{
+							Begin: "010000+0000",
+							End:   "020000+0000",
+						},
+						WorkerPools: []gardencorev1beta1.WorkerPoolMaintenance{
+							{
+								Name:       "pool1",
+								TimeWindow: gardencorev1beta1.MaintenanceTimeWindow{Begin: "030000+0000", End: "040000+0000"},
+							},
+							{
+								Name:       "pool2",
+								TimeWindow: gardencorev1beta1.MaintenanceTimeWindow{Begin: "invalid", End: "invalid"},
+							},
+						},
+					},
+				},
+			}
+		})
+
+		It("should return the time window of the worker pool", func() {
+			Expect(EffectiveWorkerPoolMaintenanceTimeWindow(shoot, "pool1")).To(Equal(timewindow.NewMaintenanceTimeWindow(
+				timewindow.NewMaintenanceTime(3, 0, 0),
+				timewindow.NewMaintenanceTime(3, 45, 0))))
+		})
+
+		It("should return the time window of the shoot if the time window of the worker pool is invalid", func() {
+			Expect(EffectiveWorkerPoolMaintenanceTimeWindow(shoot, "pool2")).To(Equal(timewindow.NewMaintenanceTimeWindow(
+				timewindow.NewMaintenanceTime(1, 0, 0),
+				timewindow.NewMaintenanceTime(1, 45, 0))))
+		})
+
+		It("should return the time window of the shoot if the worker pool does not define its own time window", func() {
+			Expect(EffectiveWorkerPoolMaintenanceTimeWindow(shoot, "pool3")).To(Equal(timewindow.NewMaintenanceTimeWindow(
+				timewindow.NewMaintenanceTime(1, 0, 0),
+				timewindow.NewMaintenanceTime(1, 45, 0))))
+		})
+
+		It("should return all effective time windows", func() {
+			Expect(EffectiveMaintenanceTimeWindows(shoot)).To(Equal([]*timewindow.MaintenanceTimeWindow{
+				timewindow.NewMaintenanceTimeWindow(timewindow.NewMaintenanceTime(1, 0, 0), timewindow.NewMaintenanceTime(1, 45, 0)),
+				timewindow.NewMaintenanceTimeWindow(timewindow.NewMaintenanceTime(3, 0, 0), timewindow.NewMaintenanceTime(3, 45, 0)),
+				timewindow.NewMaintenanceTimeWindow(timewindow.NewMaintenanceTime(1, 0, 0), timewindow.NewMaintenanceTime(1, 45, 0)),
+			}))
+		})
+	})
+
 	DescribeTable("#GetShootNameFromOwnerReferences",
 		func(ownerRefs []metav1.OwnerReference, expectedName string) {
 			obj := &gardencorev1beta1.BackupEntry{
@@ -317,6 +370,18 @@ var _ = Describe("Shoot", func() {
 			)
 		})
 
+		It("should add the label for the percentage of nodes reserved for system components", func() {
+			workerPool.SystemComponents.ReservedNodesPercentage = ptr.To[int32](20)
+			Expect(NodeLabelsForWorkerPool(workerPool, false, "osc-key")).To(
+				HaveKeyWithValue("worker.gardener.cloud/system-components-reserved-nodes-percentage", "20"),
+			)
+
+			workerPool.SystemComponents = nil
+			Expect(NodeLabelsForWorkerPool(workerPool, false, "osc-key")).NotTo(
+				HaveKey("worker.gardener.cloud/system-components-reserved-nodes-percentage"),
+			)
+		})
+
 		It("should correctly handle the node-local-dns label", func() {
 			Expect(NodeLabelsForWorkerPool(workerPool, false, "osc-key")).To(
 				HaveKeyWithValue("networking.gardener.cloud/node-local-dns-enabled", "false"),
@@ -348,7 +413,7 @@ var _ = Describe("Shoot", func() {
 
 	Describe("#GetShootProjectSecretSuffixes", func() {
 		It("should return the expected list", func() {
-			Expect(GetShootProjectSecretSuffixes()).To(ConsistOf("kubeconfig", "ca-cluster", "ssh-keypair", "ssh-keypair.old", "monitoring"))
+			Expect(GetShootProjectSecretSuffixes()).To(ConsistOf("kubeconfig", "ca-cluster", "ssh-keypair", "ssh-keypair.old", "monitoring", "object-recovery"))
 		})
 	})
 
@@ -410,6 +475,7 @@ var _ = Describe("Shoot", func() {
 		Entry("unrelated suffix", "foo.bar", "", false),
 		Entry("wrong suffix delimiter", "foo:kubeconfig", "", false),
 		Entry("ca-cluster suffix", "baz.ca-cluster", "baz", true),
+		Entry("timeline suffix", "baz.timeline", "baz", true),
 	)
 
 	Describe("#NewShootAccessSecret", func() {
@@ -704,13 +770,80 @@ var _ = Describe("Shoot", func() {
 		})
 	})
 
+	Describe("#TaintsForWorkerPool", func() {
+		var workerPool gardencorev1beta1.Worker
+
+		BeforeEach(func() {
+			workerPool = gardencorev1beta1.Worker{
+				Name:   "system",
+				Taints: []corev1.Taint{{Key: "foo", Value: "bar", Effect: corev1.TaintEffectNoExecute}},
+			}
+		})
+
+		It("should return the taints of the worker pool if no pool is dedicated to system components", func() {
+			Expect(TaintsForWorkerPool(workerPool, nil)).To(Equal(workerPool.Taints))
+			Expect(TaintsForWorkerPool(workerPool, &gardencorev1beta1.SystemComponents{})).To(Equal(workerPool.Taints))
+			Expect(TaintsForWorkerPool(workerPool, &gardencorev1beta1.SystemComponents{DedicatedPool: ptr.To("other")})).To(Equal(workerPool.Taints))
+		})
+
+		It("should add the taint for the pool dedicated to system components", func() {
+			Expect(TaintsForWorkerPool(workerPool, &gardencorev1beta1.SystemComponents{DedicatedPool: ptr.To("system")})).To(ConsistOf(
+				corev1.Taint{Key: "foo", Value: "bar", Effect: corev1.TaintEffectNoExecute},
+				corev1.Taint{Key: "worker.gardener.cloud/system-components-dedicated", Value: "true", Effect: corev1.TaintEffectNoSchedule},
+			))
+			Expect(workerPool.Taints).To(HaveLen(1))
+		})
+
+		It("should not add the taint for the pool dedicated to system components if it is already present", func() {
+			workerPool.Taints = append(workerPool.Taints, corev1.Taint{Key: "worker.gardener.cloud/system-components-dedicated", Value: "true", Effect: corev1.TaintEffectNoExecute})
+
+			Expect(TaintsForWorkerPool(workerPool, &gardencorev1beta1.SystemComponents{DedicatedPool: ptr.To("system")})).To(Equal(workerPool.Taints))
+		})
+	})
+
 	Describe("#ExtractSystemComponentsTolerations", func() {
+		It("should return the toleration for the pool dedicated to system components", func() {
+			Expect(ExtractSystemComponentsTolerations([]gardencorev1beta1.Worker{
+				{
+					Name:             "system",
+					SystemComponents: &gardencorev1beta1.WorkerSystemComponents{Allow: true},
+				},
+				{
+					Name:             "user",
+					SystemComponents: &gardencorev1beta1.WorkerSystemComponents{Allow: false},
+				},
+			}, &gardencorev1beta1.SystemComponents{DedicatedPool: ptr.To("system")})).To(HaveExactElements(
+				corev1.Toleration{
+					Key:      "worker.gardener.cloud/system-components-dedicated",
+					Operator: corev1.TolerationOpEqual,
+					Value:    "true",
+					Effect:   corev1.TaintEffectNoSchedule,
+				},
+			))
+		})
+
+		It("should return the toleration for the nodes reserved for system components", func() {
+			Expect(ExtractSystemComponentsTolerations([]gardencorev1beta1.Worker{
+				{
+					Name:             "worker",
+					SystemComponents: &gardencorev1beta1.WorkerSystemComponents{Allow: true, ReservedNodesPercentage: ptr.To[int32](10)},
+				},
+			}, nil)).To(HaveExactElements(
+				corev1.Toleration{
+					Key:      "worker.gardener.cloud/system-components-reserved",
+					Operator: corev1.TolerationOpEqual,
+					Value:    "true",
+					Effect:   corev1.TaintEffectNoSchedule,
+				},
+			))
+		})
+
 		It("should return no tolerations when workers are 'nil'", func() {
-			Expect(ExtractSystemComponentsTolerations(nil)).To(BeEmpty())
+			Expect(ExtractSystemComponentsTolerations(nil, nil)).To(BeEmpty())
 		})
 
 		It("should return no tolerations when workers are empty", func() {
-			Expect(ExtractSystemComponentsTolerations([]gardencorev1beta1.Worker{})).To(BeEmpty())
+			Expect(ExtractSystemComponentsTolerations([]gardencorev1beta1.Worker{}, nil)).To(BeEmpty())
 		})
 
 		It("should return no tolerations when no taints are defined for system worker group", func() {
@@ -728,7 +861,7 @@ var _ = Describe("Shoot", func() {
 						},
 					},
 				},
-			})).To(BeEmpty())
+			}, nil)).To(BeEmpty())
 		})
 
 		It("should return tolerations in order", func() {
@@ -763,7 +896,7 @@ var _ = Describe("Shoot", func() {
 						},
 					},
 				},
-			})).To(HaveExactElements(
+			}, nil)).To(HaveExactElements(
 				corev1.Toleration{
 					Key:      "a",
 					Operator: corev1.TolerationOpEqual,
@@ -807,7 +940,7 @@ var _ = Describe("Shoot", func() {
 						},
 					},
 				},
-			})).To(ConsistOf(corev1.Toleration{
+			}, nil)).To(ConsistOf(corev1.Toleration{
 				Key:      "someKey",
 				Operator: corev1.TolerationOpEqual,
 				Value:    "someValue",
@@ -847,7 +980,7 @@ var _ = Describe("Shoot", func() {
 						},
 					},
 				},
-			})).To(ConsistOf(
+			}, nil)).To(ConsistOf(
 				corev1.Toleration{
 					Key:      "someKey",
 					Operator: corev1.TolerationOpEqual,
@@ -1089,6 +1222,7 @@ var _ = Describe("Shoot", func() {
 			extensionType3       = "extension3"
 			oscType              = "osctype"
 			containerRuntimeType = "containerruntimetype"
+			auditSinkType        = "auditsinktype"
 			dnsProviderType1     = "dnsprovider1"
 			dnsProviderType2     = "dnsprovider2"
 			dnsProviderType3     = "dnsprovider3"
@@ -1224,6 +1358,32 @@ var _ = Describe("Shoot", func() {
 			)))
 		})
 
+		It("should compute the correct list of required extensions (audit sink)", func() {
+			seed.Spec.Backup = nil
+			shoot.Spec.Kubernetes.KubeAPIServer = &gardencorev1beta1.KubeAPIServerConfig{
+				AuditConfig: &gardencorev1beta1.AuditConfig{
+					Sink: &gardencorev1beta1.AuditSink{Type: auditSinkType},
+				},
+			}
+
+			result := ComputeRequiredExtensionsForShoot(shoot, seed, controllerRegistrationList, internalDomain, externalDomain)
+
+			Expect(result).To(BeEquivalentTo(sets.New(
+				ExtensionsID(extensionsv1alpha1.ControlPlaneResource, seedProvider),
+				ExtensionsID(extensionsv1alpha1.ControlPlaneResource, shootProvider),
+				ExtensionsID(extensionsv1alpha1.InfrastructureResource, shootProvider),
+				ExtensionsID(extensionsv1alpha1.NetworkResource, networkingType),
+				ExtensionsID(extensionsv1alpha1.WorkerResource, shootProvider),
+				ExtensionsID(extensionsv1alpha1.AuditSinkResource, auditSinkType),
+				ExtensionsID(extensionsv1alpha1.ExtensionResource, extensionType1),
+				ExtensionsID(extensionsv1alpha1.OperatingSystemConfigResource, oscType),
+				ExtensionsID(extensionsv1alpha1.ContainerRuntimeResource, containerRuntimeType),
+				ExtensionsID(extensionsv1alpha1.DNSRecordResource, dnsProviderType1),
+				ExtensionsID(extensionsv1alpha1.DNSRecordResource, dnsProviderType2),
+				ExtensionsID(extensionsv1alpha1.ExtensionResource, extensionType2),
+			)))
+		})
+
 		It("should compute the correct list of required extensions (shoot explicitly disables globally enabled extension)", func() {
 			shoot.Spec.Extensions = append(shoot.Spec.Extensions, gardencorev1beta1.Extension{
 				Type:     extensionType2,
@@ -1401,4 +1561,28 @@ var _ = Describe("Shoot", func() {
 		Entry("dual-stack shoot (ipv4 preferred)", &gardencorev1beta1.Shoot{Spec: gardencorev1beta1.ShootSpec{Networking: &gardencorev1beta1.Networking{IPFamilies: []gardencorev1beta1.IPFamily{gardencorev1beta1.IPFamilyIPv4, gardencorev1beta1.IPFamilyIPv6}}}}, "dual-stack"),
 		Entry("dual-stack shoot (ipv6 preferred)", &gardencorev1beta1.Shoot{Spec: gardencorev1beta1.ShootSpec{Networking: &gardencorev1beta1.Networking{IPFamilies: []gardencorev1beta1.IPFamily{gardencorev1beta1.IPFamilyIPv6, gardencorev1beta1.IPFamilyIPv4}}}}, "dual-stack"),
 	)
+
+	Describe("#NodesWithoutPodCIDRsOfIPFamilies", func() {
+		var (
+			dualStack = []gardencorev1beta1.IPFamily{gardencorev1beta1.IPFamilyIPv4, gardencorev1beta1.IPFamilyIPv6}
+			nodes     []corev1.Node
+		)
+
+		BeforeEach(func() {
+			nodes = []corev1.Node{
+				{ObjectMeta: metav1.ObjectMeta{Name: "dual-stack"}, Spec: corev1.NodeSpec{PodCIDR: "100.96.0.0/24", PodCIDRs: []string{"100.96.0.0/24", "2001:db8::/64"}}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "single-stack"}, Spec: corev1.NodeSpec{PodCIDR: "100.96.1.0/24", PodCIDRs: []string{"100.96.1.0/24"}}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "legacy"}, Spec: corev1.NodeSpec{PodCIDR: "100.96.2.0/24"}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "unassigned"}},
+			}
+		})
+
+		It("should return the nodes which have not been assigned pod CIDRs of all IP families", func() {
+			Expect(NodesWithoutPodCIDRsOfIPFamilies(nodes, dualStack)).To(ConsistOf("single-stack", "legacy"))
+		})
+
+		It("should return no nodes if all nodes have pod CIDRs of all IP families", func() {
+			Expect(NodesWithoutPodCIDRsOfIPFamilies(nodes, []gardencorev1beta1.IPFamily{gardencorev1beta1.IPFamilyIPv4})).To(BeEmpty())
+		})
+	})
 })
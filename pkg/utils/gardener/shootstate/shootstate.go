@@ -204,6 +204,7 @@ func computeExtensionsDataAndResources(
 		objKind           string
 		newObjectListFunc func() client.ObjectList
 	}{
+		{extensionsv1alpha1.AuditSinkResource, func() client.ObjectList { return &extensionsv1alpha1.AuditSinkList{} }},
 		{extensionsv1alpha1.BackupEntryResource, func() client.ObjectList { return &extensionsv1alpha1.BackupEntryList{} }},
 		{extensionsv1alpha1.ContainerRuntimeResource, func() client.ObjectList { return &extensionsv1alpha1.ContainerRuntimeList{} }},
 		{extensionsv1alpha1.ControlPlaneResource, func() client.ObjectList { return &extensionsv1alpha1.ControlPlaneList{} }},
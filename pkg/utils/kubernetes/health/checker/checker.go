@@ -14,11 +14,13 @@ import (
 	druidv1alpha1 "github.com/gardener/etcd-druid/api/v1alpha1"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/selection"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
@@ -375,6 +377,69 @@ func (h *HealthChecker) CheckLoggingControlPlane(
 	return nil, nil
 }
 
+// CheckControlPlaneVolumeAttachments checks whether the volumes used by the control-plane pods in the given namespace are
+// stuck in attaching or detaching. Such volumes usually prevent the affected pods (e.g., etcd) from starting.
+func (h *HealthChecker) CheckControlPlaneVolumeAttachments(
+	ctx context.Context,
+	namespace string,
+	condition gardencorev1beta1.Condition,
+) (
+	*gardencorev1beta1.Condition,
+	error,
+) {
+	podList := &corev1.PodList{}
+	if err := h.reader.List(ctx, podList, client.InNamespace(namespace), client.MatchingLabelsSelector{Selector: controlPlaneSelector}); err != nil {
+		return nil, err
+	}
+
+	claimNames := sets.New[string]()
+	for _, pod := range podList.Items {
+		for _, volume := range pod.Spec.Volumes {
+			if volume.PersistentVolumeClaim != nil {
+				claimNames.Insert(volume.PersistentVolumeClaim.ClaimName)
+			}
+		}
+	}
+	if claimNames.Len() == 0 {
+		return nil, nil
+	}
+
+	pvcList := &corev1.PersistentVolumeClaimList{}
+	if err := h.reader.List(ctx, pvcList, client.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+
+	volumeNameToClaimName := make(map[string]string)
+	for _, pvc := range pvcList.Items {
+		if claimNames.Has(pvc.Name) && pvc.Spec.VolumeName != "" {
+			volumeNameToClaimName[pvc.Spec.VolumeName] = pvc.Name
+		}
+	}
+	if len(volumeNameToClaimName) == 0 {
+		return nil, nil
+	}
+
+	volumeAttachmentList := &storagev1.VolumeAttachmentList{}
+	if err := h.reader.List(ctx, volumeAttachmentList); err != nil {
+		return nil, err
+	}
+
+	for _, volumeAttachment := range volumeAttachmentList.Items {
+		claimName, ok := volumeNameToClaimName[ptr.Deref(volumeAttachment.Spec.Source.PersistentVolumeName, "")]
+		if !ok {
+			continue
+		}
+
+		if err := health.CheckVolumeAttachment(&volumeAttachment, h.clock.Now(), health.VolumeAttachmentStuckThreshold); err != nil {
+			c := v1beta1helper.FailedCondition(h.clock, h.lastOperation, h.conditionThresholds, condition, "VolumeAttachmentStuck",
+				fmt.Sprintf("Volume of PersistentVolumeClaim %q on node %q is unhealthy: %v", claimName, volumeAttachment.Spec.NodeName, err))
+			return &c, nil
+		}
+	}
+
+	return nil, nil
+}
+
 // CheckExtensionCondition checks whether the conditions provided by extensions are healthy.
 func (h *HealthChecker) CheckExtensionCondition(condition gardencorev1beta1.Condition, extensionsConditions []ExtensionCondition, staleExtensionHealthCheckThreshold *metav1.Duration) *gardencorev1beta1.Condition {
 	for _, cond := range extensionsConditions {
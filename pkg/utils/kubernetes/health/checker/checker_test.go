@@ -15,10 +15,12 @@ import (
 	"github.com/onsi/gomega/types"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/sets"
 	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
@@ -289,6 +291,71 @@ var _ = Describe("HealthChecker", func() {
 			),
 		)
 
+		Describe("#CheckControlPlaneVolumeAttachments", func() {
+			var (
+				checker          *HealthChecker
+				pod              *corev1.Pod
+				pvc              *corev1.PersistentVolumeClaim
+				volumeAttachment *storagev1.VolumeAttachment
+			)
+
+			BeforeEach(func() {
+				checker = NewHealthChecker(fakeClient, fakeClock, map[gardencorev1beta1.ConditionType]time.Duration{}, nil)
+
+				pod = &corev1.Pod{
+					ObjectMeta: metav1.ObjectMeta{Name: "etcd-main-0", Namespace: namespace, Labels: map[string]string{v1beta1constants.GardenRole: v1beta1constants.GardenRoleControlPlane}},
+					Spec: corev1.PodSpec{Volumes: []corev1.Volume{{
+						Name:         "data",
+						VolumeSource: corev1.VolumeSource{PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "main-etcd-etcd-main-0"}},
+					}}},
+				}
+				pvc = &corev1.PersistentVolumeClaim{
+					ObjectMeta: metav1.ObjectMeta{Name: "main-etcd-etcd-main-0", Namespace: namespace},
+					Spec:       corev1.PersistentVolumeClaimSpec{VolumeName: "pv-1"},
+				}
+				volumeAttachment = &storagev1.VolumeAttachment{
+					ObjectMeta: metav1.ObjectMeta{Name: "csi-1", CreationTimestamp: metav1.NewTime(fakeClock.Now().Add(-10 * time.Minute))},
+					Spec: storagev1.VolumeAttachmentSpec{
+						NodeName: "node-1",
+						Source:   storagev1.VolumeAttachmentSource{PersistentVolumeName: ptr.To("pv-1")},
+					},
+					Status: storagev1.VolumeAttachmentStatus{AttachError: &storagev1.VolumeError{Message: "disk is attached to another instance"}},
+				}
+
+				Expect(fakeClient.Create(ctx, pod)).To(Succeed())
+				Expect(fakeClient.Create(ctx, pvc)).To(Succeed())
+			})
+
+			It("should return nil if the volume is attached", func() {
+				volumeAttachment.Status = storagev1.VolumeAttachmentStatus{Attached: true}
+				Expect(fakeClient.Create(ctx, volumeAttachment)).To(Succeed())
+
+				Expect(checker.CheckControlPlaneVolumeAttachments(ctx, namespace, condition)).To(BeNil())
+			})
+
+			It("should return a failed condition if the volume is stuck in attaching", func() {
+				Expect(fakeClient.Create(ctx, volumeAttachment)).To(Succeed())
+
+				Expect(checker.CheckControlPlaneVolumeAttachments(ctx, namespace, condition)).To(PointTo(beConditionWithStatusReasonAndMessage(gardencorev1beta1.ConditionFalse, "VolumeAttachmentStuck",
+					fmt.Sprintf(`Volume of PersistentVolumeClaim "main-etcd-etcd-main-0" on node "node-1" is unhealthy: volume is stuck in attaching since %s: disk is attached to another instance`, fakeClock.Now().Add(-10*time.Minute).UTC().Format(time.RFC3339)))))
+			})
+
+			It("should ignore volumes which are not used by control-plane pods", func() {
+				pod.Labels = nil
+				Expect(fakeClient.Update(ctx, pod)).To(Succeed())
+				Expect(fakeClient.Create(ctx, volumeAttachment)).To(Succeed())
+
+				Expect(checker.CheckControlPlaneVolumeAttachments(ctx, namespace, condition)).To(BeNil())
+			})
+
+			It("should ignore volumes of other namespaces", func() {
+				volumeAttachment.Spec.Source.PersistentVolumeName = ptr.To("pv-2")
+				Expect(fakeClient.Create(ctx, volumeAttachment)).To(Succeed())
+
+				Expect(checker.CheckControlPlaneVolumeAttachments(ctx, namespace, condition)).To(BeNil())
+			})
+		})
+
 		// CheckExtensionCondition
 		DescribeTable("#CheckExtensionCondition - HealthCheckReport",
 			func(healthCheckOutdatedThreshold *metav1.Duration, condition gardencorev1beta1.Condition, extensionsConditions []ExtensionCondition, expected types.GomegaMatcher) {
@@ -0,0 +1,45 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package health
+
+import (
+	"fmt"
+	"time"
+
+	storagev1 "k8s.io/api/storage/v1"
+)
+
+// VolumeAttachmentStuckThreshold is the duration after which a VolumeAttachment which is still attaching or detaching
+// is considered stuck.
+const VolumeAttachmentStuckThreshold = 5 * time.Minute
+
+// CheckVolumeAttachment checks whether the given VolumeAttachment is stuck.
+// A VolumeAttachment is considered stuck if it is still not attached (or detached, if it is being deleted) after the
+// given threshold. The last attach or detach error is included in the returned error, if available.
+func CheckVolumeAttachment(volumeAttachment *storagev1.VolumeAttachment, now time.Time, threshold time.Duration) error {
+	if volumeAttachment.DeletionTimestamp != nil {
+		if now.Sub(volumeAttachment.DeletionTimestamp.Time) > threshold {
+			return volumeAttachmentStuckError("detaching", volumeAttachment.DeletionTimestamp.Time, volumeAttachment.Status.DetachError)
+		}
+		return nil
+	}
+
+	if volumeAttachment.Status.Attached {
+		return nil
+	}
+
+	if now.Sub(volumeAttachment.CreationTimestamp.Time) > threshold {
+		return volumeAttachmentStuckError("attaching", volumeAttachment.CreationTimestamp.Time, volumeAttachment.Status.AttachError)
+	}
+	return nil
+}
+
+func volumeAttachmentStuckError(operation string, since time.Time, volumeError *storagev1.VolumeError) error {
+	err := fmt.Errorf("volume is stuck in %s since %s", operation, since.UTC().Format(time.RFC3339))
+	if volumeError != nil && volumeError.Message != "" {
+		err = fmt.Errorf("%w: %s", err, volumeError.Message)
+	}
+	return err
+}
@@ -0,0 +1,63 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package health_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
+)
+
+var _ = Describe("CheckVolumeAttachment", func() {
+	var (
+		now              = time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+		threshold        = 5 * time.Minute
+		volumeAttachment *storagev1.VolumeAttachment
+	)
+
+	BeforeEach(func() {
+		volumeAttachment = &storagev1.VolumeAttachment{
+			ObjectMeta: metav1.ObjectMeta{CreationTimestamp: metav1.NewTime(now.Add(-10 * time.Minute))},
+		}
+	})
+
+	It("should not return an error if the volume is attached", func() {
+		volumeAttachment.Status.Attached = true
+		Expect(health.CheckVolumeAttachment(volumeAttachment, now, threshold)).To(Succeed())
+	})
+
+	It("should not return an error if the volume is attaching for less than the threshold", func() {
+		volumeAttachment.CreationTimestamp = metav1.NewTime(now.Add(-time.Minute))
+		volumeAttachment.Status.AttachError = &storagev1.VolumeError{Message: "transient"}
+		Expect(health.CheckVolumeAttachment(volumeAttachment, now, threshold)).To(Succeed())
+	})
+
+	It("should return an error if the volume is attaching for more than the threshold", func() {
+		Expect(health.CheckVolumeAttachment(volumeAttachment, now, threshold)).To(MatchError("volume is stuck in attaching since 2024-01-01T11:50:00Z"))
+	})
+
+	It("should return an error containing the attach error", func() {
+		volumeAttachment.Status.AttachError = &storagev1.VolumeError{Message: "disk is attached to another instance"}
+		Expect(health.CheckVolumeAttachment(volumeAttachment, now, threshold)).To(MatchError("volume is stuck in attaching since 2024-01-01T11:50:00Z: disk is attached to another instance"))
+	})
+
+	It("should not return an error if the volume is detaching for less than the threshold", func() {
+		volumeAttachment.Status.Attached = true
+		volumeAttachment.DeletionTimestamp = &metav1.Time{Time: now.Add(-time.Minute)}
+		Expect(health.CheckVolumeAttachment(volumeAttachment, now, threshold)).To(Succeed())
+	})
+
+	It("should return an error containing the detach error if the volume is detaching for more than the threshold", func() {
+		volumeAttachment.Status.Attached = true
+		volumeAttachment.DeletionTimestamp = &metav1.Time{Time: now.Add(-6 * time.Minute)}
+		volumeAttachment.Status.DetachError = &storagev1.VolumeError{Message: "node not found"}
+		Expect(health.CheckVolumeAttachment(volumeAttachment, now, threshold)).To(MatchError("volume is stuck in detaching since 2024-01-01T11:54:00Z: node not found"))
+	})
+})
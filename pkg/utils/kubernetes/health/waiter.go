@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package health
+
+import (
+	"context"
+	"fmt"
+
+	toolscache "k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// InformerClient is a client whose reads are served by the informers of a cache.
+type InformerClient interface {
+	client.Client
+	// Informers returns the informers of the cache which serves the reads of the client.
+	Informers() cache.Informers
+}
+
+// WatchObject registers an event handler on the informer for the kind of the given object and returns a channel which
+// receives a notification whenever the object is added, updated, or deleted. Notifications are coalesced, i.e., the
+// channel holds at most one pending notification. All callers share the informer (and hence its watch) of the given
+// cache, so observing objects does not cause additional API requests. The event handler is removed once the given
+// context is cancelled, i.e., the lifetime of the registration is scoped to the caller.
+func WatchObject(ctx context.Context, informers cache.Informers, obj client.Object) (<-chan struct{}, error) {
+	informer, err := informers.GetInformer(ctx, obj, cache.BlockUntilSynced(false))
+	if err != nil {
+		return nil, fmt.Errorf("failed getting informer: %w", err)
+	}
+
+	var (
+		key     = client.ObjectKeyFromObject(obj)
+		changes = make(chan struct{}, 1)
+		notify  = func(o interface{}) {
+			if tombstone, ok := o.(toolscache.DeletedFinalStateUnknown); ok {
+				o = tombstone.Obj
+			}
+			if object, ok := o.(client.Object); !ok || client.ObjectKeyFromObject(object) != key {
+				return
+			}
+
+			select {
+			case changes <- struct{}{}:
+			default:
+			}
+		}
+	)
+
+	registration, err := informer.AddEventHandler(toolscache.ResourceEventHandlerFuncs{
+		AddFunc:    notify,
+		UpdateFunc: func(_, newObj interface{}) { notify(newObj) },
+		DeleteFunc: notify,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed adding event handler: %w", err)
+	}
+
+	context.AfterFunc(ctx, func() {
+		// The informer is shared with other users of the cache, hence only the event handler is removed.
+		_ = informer.RemoveEventHandler(registration)
+	})
+
+	return changes, nil
+}
@@ -0,0 +1,151 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package health_test
+
+import (
+	"context"
+	"sync/atomic"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	toolscache "k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/cache/informertest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllertest"
+
+	"github.com/gardener/gardener/pkg/utils/kubernetes/health"
+)
+
+var _ = Describe("Waiter", func() {
+	Describe("#WatchObject", func() {
+		var (
+			ctx    context.Context
+			cancel context.CancelFunc
+
+			informers *fakeInformers
+			informer  *fakeInformer
+
+			configMap *corev1.ConfigMap
+		)
+
+		BeforeEach(func() {
+			ctx, cancel = context.WithCancel(context.Background())
+			DeferCleanup(cancel)
+
+			informer = &fakeInformer{FakeInformer: &controllertest.FakeInformer{}}
+			informers = &fakeInformers{FakeInformers: &informertest.FakeInformers{Scheme: scheme.Scheme}, informer: informer}
+
+			configMap = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "default"}}
+		})
+
+		It("should notify about changes of the object", func() {
+			changes, err := health.WatchObject(ctx, informers, configMap)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(changes).NotTo(Receive())
+
+			informer.Add(configMap)
+			Expect(changes).To(Receive())
+
+			informer.Update(configMap, configMap)
+			Expect(changes).To(Receive())
+
+			informer.Delete(configMap)
+			Expect(changes).To(Receive())
+
+			informer.Delete(toolscache.DeletedFinalStateUnknown{Key: "default/foo", Obj: configMap})
+			Expect(changes).To(Receive())
+		})
+
+		It("should coalesce notifications", func() {
+			changes, err := health.WatchObject(ctx, informers, configMap)
+			Expect(err).NotTo(HaveOccurred())
+
+			informer.Add(configMap)
+			informer.Update(configMap, configMap)
+			Expect(changes).To(Receive())
+			Expect(changes).NotTo(Receive())
+		})
+
+		It("should ignore changes of other objects", func() {
+			changes, err := health.WatchObject(ctx, informers, configMap)
+			Expect(err).NotTo(HaveOccurred())
+
+			informer.Add(&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "default"}})
+			informer.Add(&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "foo", Namespace: "other"}})
+			Expect(changes).NotTo(Receive())
+		})
+
+		It("should share the informer and remove the event handler once the context is cancelled", func() {
+			otherCtx, otherCancel := context.WithCancel(ctx)
+
+			_, err := health.WatchObject(ctx, informers, configMap)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = health.WatchObject(otherCtx, informers, configMap)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(informers.getInformerCalls.Load()).To(BeEquivalentTo(2))
+			Expect(informer.removed.Load()).To(BeZero())
+
+			otherCancel()
+			Eventually(informer.removed.Load).Should(BeEquivalentTo(1))
+
+			cancel()
+			Eventually(informer.removed.Load).Should(BeEquivalentTo(2))
+		})
+	})
+})
+
+type fakeInformers struct {
+	*informertest.FakeInformers
+	informer *fakeInformer
+
+	getInformerCalls atomic.Int32
+}
+
+func (f *fakeInformers) GetInformer(_ context.Context, _ client.Object, _ ...cache.InformerGetOption) (cache.Informer, error) {
+	f.getInformerCalls.Add(1)
+	return f.informer, nil
+}
+
+// fakeInformer records the registered event handlers so that events (including tombstones of deleted objects) can be
+// passed to them directly.
+type fakeInformer struct {
+	*controllertest.FakeInformer
+
+	handlers []toolscache.ResourceEventHandler
+	removed  atomic.Int32
+}
+
+func (f *fakeInformer) AddEventHandler(handler toolscache.ResourceEventHandler) (toolscache.ResourceEventHandlerRegistration, error) {
+	f.handlers = append(f.handlers, handler)
+	return nil, nil
+}
+
+func (f *fakeInformer) RemoveEventHandler(_ toolscache.ResourceEventHandlerRegistration) error {
+	f.removed.Add(1)
+	return nil
+}
+
+func (f *fakeInformer) Add(obj interface{}) {
+	for _, h := range f.handlers {
+		h.OnAdd(obj, false)
+	}
+}
+
+func (f *fakeInformer) Update(oldObj, newObj interface{}) {
+	for _, h := range f.handlers {
+		h.OnUpdate(oldObj, newObj)
+	}
+}
+
+func (f *fakeInformer) Delete(obj interface{}) {
+	for _, h := range f.handlers {
+		h.OnDelete(obj)
+	}
+}
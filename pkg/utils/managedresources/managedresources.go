@@ -321,12 +321,12 @@ func waitUntilHealthy(ctx context.Context, c client.Client, namespace, name stri
 		}
 
 		if err := health.CheckManagedResource(obj); err != nil {
-			return retry.MinorError(fmt.Errorf("managed resource %s/%s is not healthy", namespace, name))
+			return retry.MinorError(errorsutils.New("managed resource %s/%s is not healthy", namespace, name).WithComponent(name))
 		}
 
 		if andNotProgressing {
 			if err := health.CheckManagedResourceProgressing(obj); err != nil {
-				return retry.MinorError(fmt.Errorf("managed resource %s/%s is still progressing", namespace, name))
+				return retry.MinorError(errorsutils.New("managed resource %s/%s is still progressing", namespace, name).WithComponent(name))
 			}
 		}
 
@@ -29,6 +29,7 @@ import (
 	"github.com/gardener/gardener/pkg/controllerutils"
 	"github.com/gardener/gardener/pkg/resourcemanager/controller/garbagecollector/references"
 	"github.com/gardener/gardener/pkg/utils"
+	errorsutils "github.com/gardener/gardener/pkg/utils/errors"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 	. "github.com/gardener/gardener/pkg/utils/managedresources"
 	"github.com/gardener/gardener/pkg/utils/retry"
@@ -483,7 +484,9 @@ var _ = Describe("managedresources", func() {
 			})
 			Expect(err).To(Not(HaveOccurred()))
 
-			Expect(WaitUntilHealthy(ctx, fakeClient, namespace, name)).To(MatchError(ContainSubstring("managed resource test/managed-resource is not healthy")))
+			err = WaitUntilHealthy(ctx, fakeClient, namespace, name)
+			Expect(err).To(MatchError(ContainSubstring("managed resource test/managed-resource is not healthy")))
+			Expect(errorsutils.Component(err)).To(Equal(name))
 		})
 
 		It("should return error when the managed resource is not healthy yet (ResourcesApplied is not true)", func() {
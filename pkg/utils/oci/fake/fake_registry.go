@@ -31,7 +31,7 @@ func NewRegistry() *Registry {
 }
 
 // Pull implements oci.Interface
-func (r *Registry) Pull(_ context.Context, oci *gardencorev1.OCIRepository) ([]byte, error) {
+func (r *Registry) Pull(_ context.Context, oci *gardencorev1.OCIRepository, _ ...oci.PullOption) ([]byte, error) {
 	data, ok := r.artifacts[artifactKey(oci)]
 	if !ok {
 		return nil, fmt.Errorf("not found")
@@ -27,7 +27,7 @@ const (
 
 // Interface represents an OCI compatible registry.
 type Interface interface {
-	Pull(ctx context.Context, oci *gardencorev1.OCIRepository) ([]byte, error)
+	Pull(ctx context.Context, oci *gardencorev1.OCIRepository, opts ...PullOption) ([]byte, error)
 }
 
 // HelmRegistry can pull OCI Helm Charts.
@@ -43,8 +43,13 @@ func NewHelmRegistry() (*HelmRegistry, error) {
 }
 
 // Pull from the repository and return the compressed archive.
-func (r *HelmRegistry) Pull(ctx context.Context, oci *gardencorev1.OCIRepository) ([]byte, error) {
-	ref, err := buildRef(oci)
+func (r *HelmRegistry) Pull(ctx context.Context, oci *gardencorev1.OCIRepository, opts ...PullOption) ([]byte, error) {
+	pullOpts := &PullOptions{}
+	for _, opt := range opts {
+		opt(pullOpts)
+	}
+
+	ref, err := buildRef(oci, pullOpts.Mirrors)
 	if err != nil {
 		return nil, err
 	}
@@ -52,6 +57,12 @@ func (r *HelmRegistry) Pull(ctx context.Context, oci *gardencorev1.OCIRepository
 		remote.WithContext(ctx),
 	}
 
+	additionalRemoteOpts, err := pullOpts.remoteOptions()
+	if err != nil {
+		return nil, err
+	}
+	remoteOpts = append(remoteOpts, additionalRemoteOpts...)
+
 	key, err := cacheKeyFromRef(ref, remoteOpts...)
 	if err != nil {
 		return nil, err
@@ -82,8 +93,8 @@ func (r *HelmRegistry) Pull(ctx context.Context, oci *gardencorev1.OCIRepository
 	return blob, nil
 }
 
-func buildRef(oci *gardencorev1.OCIRepository) (name.Reference, error) {
-	ref := oci.GetURL()
+func buildRef(oci *gardencorev1.OCIRepository, mirrors map[string]string) (name.Reference, error) {
+	ref := MirrorReference(oci.GetURL(), mirrors)
 
 	opts := []name.Option{
 		name.StrictValidation,
@@ -73,6 +73,23 @@ var _ = Describe("helmregistry", func() {
 		Expect(out).NotTo(BeEmpty())
 	})
 
+	It("should pull the chart from the mirror", func() {
+		out, err := hr.Pull(ctx, &gardencorev1.OCIRepository{
+			Repository: ptr.To("example.com/charts/example"),
+			Digest:     ptr.To(exampleChartDigest),
+		}, WithMirrors(map[string]string{"example.com": registryAddress}))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(out).To(Equal(rawChart))
+	})
+
+	It("should return error if the proxy is not reachable", func() {
+		_, err := hr.Pull(ctx, &gardencorev1.OCIRepository{
+			Repository: ptr.To("example.com/charts/example"),
+			Tag:        ptr.To("0.1.0"),
+		}, WithProxy("http://127.0.0.1:1", "http://127.0.0.1:1", ""))
+		Expect(err).To(MatchError(ContainSubstring("proxyconnect")))
+	})
+
 	It("should use the cache", func() {
 		oci := &gardencorev1.OCIRepository{
 			Ref: ptr.To(fmt.Sprintf("%s/charts/example:0.1.0@%s", registryAddress, exampleChartDigest)),
@@ -124,29 +141,44 @@ var _ = Describe("buildRef", func() {
 	const digest = "sha256:7a855a6d69033dd3240d9648e8bd46a67a528059158e098c7794ac9227735b4a"
 
 	DescribeTable("buildRef",
-		func(oci *gardencorev1.OCIRepository, want name.Reference) {
-			Expect(buildRef(oci)).To(Equal(want))
+		func(oci *gardencorev1.OCIRepository, mirrors map[string]string, want name.Reference) {
+			Expect(buildRef(oci, mirrors)).To(Equal(want))
 		},
 		Entry("ref without digest",
 			&gardencorev1.OCIRepository{Ref: ptr.To("example.com/foo:1.0.0")},
+			nil,
 			mustNewTag("example.com/foo:1.0.0"),
 		),
 		Entry("ref with tag and digest",
 			&gardencorev1.OCIRepository{Ref: ptr.To("example.com/foo:1.0.0@" + digest)},
+			nil,
 			mustNewDigest("example.com/foo:1.0.0@"+digest),
 		),
 		Entry("repository with tag",
 			&gardencorev1.OCIRepository{Repository: ptr.To("example.com/foo"), Tag: ptr.To("1.0.0")},
+			nil,
 			mustNewTag("example.com/foo:1.0.0"),
 		),
 		Entry("repository with tag and digest",
 			&gardencorev1.OCIRepository{Repository: ptr.To("oci://example.com/foo"), Tag: ptr.To("1.0.0"), Digest: ptr.To(digest)},
+			nil,
 			mustNewDigest("example.com/foo@"+digest),
 		),
 		Entry("configure insecure in local setup when using garden.local.gardener.cloud",
 			&gardencorev1.OCIRepository{Ref: ptr.To("garden.local.gardener.cloud:5001/foo:1.0.0")},
+			nil,
 			name.MustParseReference("garden.local.gardener.cloud:5001/foo:1.0.0", name.Insecure),
 		),
+		Entry("ref rewritten to mirror",
+			&gardencorev1.OCIRepository{Ref: ptr.To("example.com/foo:1.0.0")},
+			map[string]string{"example.com": "mirror.example.org/example"},
+			mustNewTag("mirror.example.org/example/foo:1.0.0"),
+		),
+		Entry("repository with digest rewritten to mirror",
+			&gardencorev1.OCIRepository{Repository: ptr.To("oci://example.com/foo"), Digest: ptr.To(digest)},
+			map[string]string{"example.com": "mirror.example.org"},
+			mustNewDigest("mirror.example.org/foo@"+digest),
+		),
 	)
 })
 
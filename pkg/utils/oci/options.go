@@ -0,0 +1,166 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package oci
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"golang.org/x/net/http/httpproxy"
+)
+
+// PullOptions contains options for pulling artifacts from OCI registries.
+type PullOptions struct {
+	// Mirrors maps the hosts of source registries to mirrors. A mirror consists of a host and an optional path prefix.
+	Mirrors map[string]string
+	// DockerConfigJSON is the content of a docker config file (see `kubernetes.io/dockerconfigjson` secrets) containing
+	// the credentials for the registries.
+	DockerConfigJSON []byte
+	// Proxy is the configuration of the proxy used for connecting to the registries.
+	Proxy *httpproxy.Config
+}
+
+// PullOption is a function which mutates PullOptions.
+type PullOption func(*PullOptions)
+
+// WithMirrors configures mirrors for the source registries. References to artifacts hosted in a source registry are
+// rewritten to the respective mirror before pulling.
+func WithMirrors(mirrors map[string]string) PullOption {
+	return func(o *PullOptions) {
+		o.Mirrors = mirrors
+	}
+}
+
+// WithDockerConfigJSON configures the credentials for the registries in the docker config format.
+func WithDockerConfigJSON(dockerConfigJSON []byte) PullOption {
+	return func(o *PullOptions) {
+		o.DockerConfigJSON = dockerConfigJSON
+	}
+}
+
+// WithProxy configures the proxy used for connecting to the registries.
+func WithProxy(httpProxy, httpsProxy, noProxy string) PullOption {
+	return func(o *PullOptions) {
+		o.Proxy = &httpproxy.Config{
+			HTTPProxy:  httpProxy,
+			HTTPSProxy: httpsProxy,
+			NoProxy:    noProxy,
+		}
+	}
+}
+
+func (o *PullOptions) remoteOptions() ([]remote.Option, error) {
+	var opts []remote.Option
+
+	if len(o.DockerConfigJSON) > 0 {
+		keychain, err := newDockerConfigKeychain(o.DockerConfigJSON)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, remote.WithAuthFromKeychain(keychain))
+	}
+
+	if o.Proxy != nil {
+		proxyFunc := o.Proxy.ProxyFunc()
+		transport := remote.DefaultTransport.(*http.Transport).Clone()
+		transport.Proxy = func(req *http.Request) (*url.URL, error) {
+			return proxyFunc(req.URL)
+		}
+		opts = append(opts, remote.WithTransport(transport))
+	}
+
+	return opts, nil
+}
+
+// MirrorReference rewrites the given artifact or image reference to the mirror configured for its registry. If no
+// mirror is configured for the registry, the reference is returned unchanged.
+func MirrorReference(ref string, mirrors map[string]string) string {
+	ref = strings.TrimPrefix(ref, "oci://")
+
+	host, path, found := strings.Cut(ref, "/")
+	if !found {
+		return ref
+	}
+
+	mirror, ok := mirrors[host]
+	if !ok {
+		return ref
+	}
+
+	return strings.TrimSuffix(mirror, "/") + "/" + path
+}
+
+type dockerConfig struct {
+	Auths map[string]dockerConfigEntry `json:"auths"`
+}
+
+type dockerConfigEntry struct {
+	Username      string `json:"username,omitempty"`
+	Password      string `json:"password,omitempty"`
+	Auth          string `json:"auth,omitempty"`
+	IdentityToken string `json:"identitytoken,omitempty"`
+	RegistryToken string `json:"registrytoken,omitempty"`
+}
+
+// dockerConfigKeychain is an authn.Keychain which resolves the credentials from a docker config file.
+type dockerConfigKeychain struct {
+	auths map[string]dockerConfigEntry
+}
+
+func newDockerConfigKeychain(data []byte) (*dockerConfigKeychain, error) {
+	config := &dockerConfig{}
+	if err := json.Unmarshal(data, config); err != nil {
+		return nil, fmt.Errorf("failed decoding docker config: %w", err)
+	}
+
+	auths := make(map[string]dockerConfigEntry, len(config.Auths))
+	for server, entry := range config.Auths {
+		auths[normalizeRegistryHost(server)] = entry
+	}
+
+	return &dockerConfigKeychain{auths: auths}, nil
+}
+
+// Resolve implements authn.Keychain.
+func (k *dockerConfigKeychain) Resolve(resource authn.Resource) (authn.Authenticator, error) {
+	entry, ok := k.auths[resource.RegistryStr()]
+	if !ok {
+		return authn.Anonymous, nil
+	}
+
+	authConfig := authn.AuthConfig{
+		Username:      entry.Username,
+		Password:      entry.Password,
+		IdentityToken: entry.IdentityToken,
+		RegistryToken: entry.RegistryToken,
+	}
+
+	if entry.Auth != "" {
+		decoded, err := base64.StdEncoding.DecodeString(entry.Auth)
+		if err != nil {
+			return nil, fmt.Errorf("failed decoding auth for registry %q: %w", resource.RegistryStr(), err)
+		}
+		username, password, found := strings.Cut(string(decoded), ":")
+		if !found {
+			return nil, fmt.Errorf("auth for registry %q is not in the format 'username:password'", resource.RegistryStr())
+		}
+		authConfig.Username, authConfig.Password = username, password
+	}
+
+	return authn.FromConfig(authConfig), nil
+}
+
+func normalizeRegistryHost(server string) string {
+	server = strings.TrimPrefix(server, "https://")
+	server = strings.TrimPrefix(server, "http://")
+	host, _, _ := strings.Cut(server, "/")
+	return host
+}
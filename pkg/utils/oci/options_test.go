@@ -0,0 +1,70 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package oci
+
+import (
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Options", func() {
+	Describe("#MirrorReference", func() {
+		mirrors := map[string]string{
+			"europe-docker.pkg.dev": "registry.example.com/gardener/",
+			"localhost:5001":        "registry.example.com",
+		}
+
+		DescribeTable("should rewrite the reference",
+			func(ref, want string) {
+				Expect(MirrorReference(ref, mirrors)).To(Equal(want))
+			},
+			Entry("reference with tag", "europe-docker.pkg.dev/gardener-project/releases/charts/gardenlet:v1.0.0", "registry.example.com/gardener/gardener-project/releases/charts/gardenlet:v1.0.0"),
+			Entry("reference with oci prefix", "oci://europe-docker.pkg.dev/charts/gardenlet:v1.0.0", "registry.example.com/gardener/charts/gardenlet:v1.0.0"),
+			Entry("reference with port", "localhost:5001/charts/gardenlet@sha256:abc", "registry.example.com/charts/gardenlet@sha256:abc"),
+			Entry("reference without mirror", "example.com/charts/gardenlet:v1.0.0", "example.com/charts/gardenlet:v1.0.0"),
+			Entry("reference without registry", "gardenlet:v1.0.0", "gardenlet:v1.0.0"),
+		)
+	})
+
+	Describe("#dockerConfigKeychain", func() {
+		It("should fail if the docker config cannot be decoded", func() {
+			_, err := newDockerConfigKeychain([]byte("{"))
+			Expect(err).To(MatchError(ContainSubstring("failed decoding docker config")))
+		})
+
+		It("should resolve the credentials for the registry", func() {
+			keychain, err := newDockerConfigKeychain([]byte(`{"auths":{
+"https://registry.example.com/v1/":{"auth":"Zm9vOmJhcg=="},
+"other.example.com":{"username":"user","password":"pass"}
+}}`))
+			Expect(err).NotTo(HaveOccurred())
+
+			authenticator, err := keychain.Resolve(name.MustParseReference("registry.example.com/charts/gardenlet:v1.0.0").Context())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(authenticator.Authorization()).To(Equal(&authn.AuthConfig{Username: "foo", Password: "bar"}))
+
+			authenticator, err = keychain.Resolve(name.MustParseReference("other.example.com/charts/gardenlet:v1.0.0").Context())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(authenticator.Authorization()).To(Equal(&authn.AuthConfig{Username: "user", Password: "pass"}))
+		})
+
+		It("should fall back to anonymous access for unknown registries", func() {
+			keychain, err := newDockerConfigKeychain([]byte(`{"auths":{}}`))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(keychain.Resolve(name.MustParseReference("registry.example.com/charts/gardenlet:v1.0.0").Context())).To(Equal(authn.Anonymous))
+		})
+
+		It("should fail if the auth is malformed", func() {
+			keychain, err := newDockerConfigKeychain([]byte(`{"auths":{"registry.example.com":{"auth":"Zm9v"}}}`))
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = keychain.Resolve(name.MustParseReference("registry.example.com/charts/gardenlet:v1.0.0").Context())
+			Expect(err).To(MatchError(ContainSubstring("is not in the format 'username:password'")))
+		})
+	})
+})
@@ -7,7 +7,9 @@ package timewindow
 import (
 	"fmt"
 	"hash/crc32"
+	"time"
 
+	"github.com/robfig/cron"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 )
@@ -65,3 +67,47 @@ func RandomizeWithinFirstHourOfTimeWindow(scheduleFormat string, window Maintena
 
 	return fmt.Sprintf(scheduleFormat, randomTime.Minute(), randomTime.Hour())
 }
+
+const (
+	// maxScheduleActivations is the maximum number of activations of a schedule which are considered when computing its
+	// minimum interval.
+	maxScheduleActivations = 10000
+	// scheduleIntervalHorizon is the period of time for which the activations of a schedule are considered when
+	// computing its minimum interval.
+	scheduleIntervalHorizon = 366 * 24 * time.Hour
+)
+
+// MinimumScheduleInterval parses the given cron schedule (in standard cron format) and returns the smallest interval
+// between two consecutive activations of it. Only the activations within one year (but at most 10000 of them) are
+// considered. If the schedule is activated at most once in this period, the horizon of one year is returned.
+func MinimumScheduleInterval(schedule string) (time.Duration, error) {
+	parsed, err := cron.ParseStandard(schedule)
+	if err != nil {
+		return 0, fmt.Errorf("failed parsing schedule %q: %w", schedule, err)
+	}
+
+	var (
+		start       = time.Date(2001, time.January, 1, 0, 0, 0, 0, time.UTC)
+		horizon     = start.Add(scheduleIntervalHorizon)
+		minInterval = scheduleIntervalHorizon
+		previous    = parsed.Next(start)
+	)
+
+	for i := 0; i < maxScheduleActivations && !previous.IsZero() && previous.Before(horizon); i++ {
+		next := parsed.Next(previous)
+		if next.IsZero() {
+			break
+		}
+
+		if interval := next.Sub(previous); interval < minInterval {
+			minInterval = interval
+		}
+		// the interval cannot become smaller than the resolution of standard cron schedules
+		if minInterval <= time.Minute {
+			break
+		}
+		previous = next
+	}
+
+	return minInterval, nil
+}
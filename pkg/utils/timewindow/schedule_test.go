@@ -5,6 +5,8 @@
 package timewindow_test
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -63,4 +65,26 @@ var _ = Describe("Schedule", func() {
 			Expect(RandomizeWithinFirstHourOfTimeWindow(scheduleFormat, *window, uid)).To(Equal("55 12"))
 		})
 	})
+
+	Describe("#MinimumScheduleInterval", func() {
+		It("should return an error because the schedule cannot be parsed", func() {
+			_, err := MinimumScheduleInterval("not-parseable")
+			Expect(err).To(HaveOccurred())
+		})
+
+		DescribeTable("should return the minimum interval",
+			func(schedule string, expected time.Duration) {
+				Expect(MinimumScheduleInterval(schedule)).To(Equal(expected))
+			},
+
+			Entry("every minute", "* * * * *", time.Minute),
+			Entry("every 15 minutes", "*/15 * * * *", 15*time.Minute),
+			Entry("hourly", "5 * * * *", time.Hour),
+			Entry("twice a day", "0 6,18 * * *", 12*time.Hour),
+			Entry("irregular times a day", "0 1,2,20 * * *", time.Hour),
+			Entry("daily", "30 3 * * *", 24*time.Hour),
+			Entry("weekly", "0 0 * * 1", 7*24*time.Hour),
+			Entry("monthly", "0 0 1 * *", 28*24*time.Hour),
+		)
+	})
 })
@@ -7,6 +7,7 @@ package admissionplugins
 import (
 	"fmt"
 
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
@@ -153,6 +154,14 @@ func ValidateAdmissionPlugins(admissionPlugins []core.AdmissionPlugin, version s
 			if plugin.KubeconfigSecretName != nil && !admissionPluginsSupportingExternalKubeconfig.Has(plugin.Name) {
 				allErrs = append(allErrs, field.Forbidden(idxPath.Child("kubeconfigSecretName"), fmt.Sprintf("admission plugin %q does not allow specifying external kubeconfig", plugin.Name)))
 			}
+			if plugin.ConfigMapName != nil {
+				if plugin.Config != nil {
+					allErrs = append(allErrs, field.Forbidden(idxPath.Child("configMapName"), "must not be set together with config"))
+				}
+				for _, msg := range apivalidation.NameIsDNSSubdomain(*plugin.ConfigMapName, false) {
+					allErrs = append(allErrs, field.Invalid(idxPath.Child("configMapName"), *plugin.ConfigMapName, msg))
+				}
+			}
 			if err := validateAdmissionPluginConfig(plugin, idxPath); err != nil {
 				allErrs = append(allErrs, err)
 			}
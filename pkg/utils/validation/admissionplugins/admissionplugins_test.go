@@ -80,6 +80,17 @@ var _ = Describe("admissionplugins", func() {
 				"Detail": Equal("admission plugin \"TaintNodesByCondition\" does not allow specifying external kubeconfig"),
 			})))),
 			Entry("adding kubeconfig secret to admission plugin supporting external kubeconfig", []core.AdmissionPlugin{{Name: "ValidatingAdmissionWebhook", KubeconfigSecretName: ptr.To("test-secret")}}, "1.27.5", BeEmpty()),
+			Entry("adding config map to admission plugin", []core.AdmissionPlugin{{Name: "PodNodeSelector", ConfigMapName: ptr.To("test-configmap")}}, "1.27.5", BeEmpty()),
+			Entry("adding config map and kubeconfig secret to admission plugin", []core.AdmissionPlugin{{Name: "ValidatingAdmissionWebhook", ConfigMapName: ptr.To("test-configmap"), KubeconfigSecretName: ptr.To("test-secret")}}, "1.27.5", BeEmpty()),
+			Entry("adding config map with invalid name to admission plugin", []core.AdmissionPlugin{{Name: "PodNodeSelector", ConfigMapName: ptr.To("Test_ConfigMap")}}, "1.27.5", ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal(field.NewPath("admissionPlugins[0].configMapName").String()),
+			})))),
+			Entry("adding config map and config to admission plugin", []core.AdmissionPlugin{{Name: "PodNodeSelector", ConfigMapName: ptr.To("test-configmap"), Config: &runtime.RawExtension{Raw: []byte("{}")}}}, "1.27.5", ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeForbidden),
+				"Field":  Equal(field.NewPath("admissionPlugins[0].configMapName").String()),
+				"Detail": Equal("must not be set together with config"),
+			})))),
 		)
 
 		Describe("validate PodSecurity admissionPlugin config", func() {
@@ -0,0 +1,41 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package jsonschema
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/kube-openapi/pkg/validation/spec"
+	"k8s.io/kube-openapi/pkg/validation/strfmt"
+	"k8s.io/kube-openapi/pkg/validation/validate"
+)
+
+// Parse parses the given raw extension as OpenAPI v3 schema.
+func Parse(raw *runtime.RawExtension) (*spec.Schema, error) {
+	if raw == nil || len(raw.Raw) == 0 {
+		return nil, fmt.Errorf("schema must not be empty")
+	}
+
+	schema := &spec.Schema{}
+	if err := json.Unmarshal(raw.Raw, schema); err != nil {
+		return nil, fmt.Errorf("failed decoding schema: %w", err)
+	}
+	return schema, nil
+}
+
+// Validate validates the given values against the given OpenAPI v3 schema. Missing values are validated as empty
+// object so that required properties are enforced.
+func Validate(schema *spec.Schema, values *runtime.RawExtension) error {
+	var data any = map[string]any{}
+	if values != nil && len(values.Raw) > 0 {
+		if err := json.Unmarshal(values.Raw, &data); err != nil {
+			return fmt.Errorf("failed decoding values: %w", err)
+		}
+	}
+
+	return validate.AgainstSchema(schema, data, strfmt.Default)
+}
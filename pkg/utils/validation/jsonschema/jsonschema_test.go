@@ -0,0 +1,50 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package jsonschema_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	gomegatypes "github.com/onsi/gomega/types"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	. "github.com/gardener/gardener/pkg/utils/validation/jsonschema"
+)
+
+var _ = Describe("JSONSchema", func() {
+	Describe("#Parse", func() {
+		It("should parse a valid schema", func() {
+			schema, err := Parse(&runtime.RawExtension{Raw: []byte(`{"type":"object","properties":{"replicas":{"type":"integer"}}}`)})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(schema.Properties).To(HaveKey("replicas"))
+		})
+
+		It("should fail for an empty schema", func() {
+			_, err := Parse(nil)
+			Expect(err).To(MatchError(ContainSubstring("must not be empty")))
+		})
+
+		It("should fail for invalid JSON", func() {
+			_, err := Parse(&runtime.RawExtension{Raw: []byte(`{"type":`)})
+			Expect(err).To(MatchError(ContainSubstring("failed decoding schema")))
+		})
+	})
+
+	DescribeTable("#Validate",
+		func(values *runtime.RawExtension, matcher gomegatypes.GomegaMatcher) {
+			schema, err := Parse(&runtime.RawExtension{Raw: []byte(`{"type":"object","required":["replicas"],"additionalProperties":false,"properties":{"replicas":{"type":"integer","minimum":1}}}`)})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(Validate(schema, values)).To(matcher)
+		},
+
+		Entry("valid values", &runtime.RawExtension{Raw: []byte(`{"replicas":2}`)}, Succeed()),
+		Entry("missing required property", nil, MatchError(ContainSubstring("replicas in body is required"))),
+		Entry("wrong type", &runtime.RawExtension{Raw: []byte(`{"replicas":"two"}`)}, MatchError(ContainSubstring("replicas in body must be of type integer"))),
+		Entry("value out of range", &runtime.RawExtension{Raw: []byte(`{"replicas":0}`)}, MatchError(ContainSubstring("replicas in body should be greater than or equal to 1"))),
+		Entry("unknown property", &runtime.RawExtension{Raw: []byte(`{"replicas":1,"foo":"bar"}`)}, MatchError(ContainSubstring("foo"))),
+		Entry("invalid JSON", &runtime.RawExtension{Raw: []byte(`{`)}, MatchError(ContainSubstring("failed decoding values"))),
+	)
+})
@@ -0,0 +1,145 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package kubecontrollermanager
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	versionutils "github.com/gardener/gardener/pkg/utils/version"
+)
+
+// controllersVersionRanges contains the version ranges for all controllers of the kube-controller-manager which can be
+// configured via the `--controllers` flag.
+// Extracted from https://raw.githubusercontent.com/kubernetes/kubernetes/release-${version}/cmd/kube-controller-manager/names/controller_names.go
+// (or https://raw.githubusercontent.com/kubernetes/kubernetes/release-${version}/cmd/kube-controller-manager/app/controllermanager.go
+// for versions < 1.28).
+var controllersVersionRanges = map[string]*ControllerVersionRange{
+	"attachdetach":                         {},
+	"bootstrapsigner":                      {Required: true},
+	"cloud-node-lifecycle":                 {},
+	"clusterrole-aggregation":              {},
+	"cronjob":                              {},
+	"csrapproving":                         {Required: true},
+	"csrcleaner":                           {},
+	"csrsigning":                           {Required: true},
+	"daemonset":                            {Required: true, ConcurrentSyncsFlag: "concurrent-daemonset-syncs"},
+	"deployment":                           {Required: true, ConcurrentSyncsFlag: "concurrent-deployment-syncs"},
+	"disruption":                           {},
+	"endpoint":                             {Required: true, ConcurrentSyncsFlag: "concurrent-endpoint-syncs"},
+	"endpointslice":                        {Required: true, ConcurrentSyncsFlag: "concurrent-service-endpoint-syncs"},
+	"endpointslicemirroring":               {ConcurrentSyncsFlag: "mirroring-concurrent-service-endpoint-syncs"},
+	"ephemeral-volume":                     {ConcurrentSyncsFlag: "concurrent-ephemeralvolume-syncs"},
+	"garbagecollector":                     {Required: true, ConcurrentSyncsFlag: "concurrent-gc-syncs"},
+	"horizontalpodautoscaling":             {},
+	"job":                                  {},
+	"legacy-service-account-token-cleaner": {VersionRange: versionutils.VersionRange{AddedInVersion: "1.28"}},
+	"namespace":                            {Required: true, ConcurrentSyncsFlag: "concurrent-namespace-syncs"},
+	"nodeipam":                             {Required: true},
+	"nodelifecycle":                        {Required: true},
+	"persistentvolume-binder":              {},
+	"persistentvolume-expander":            {},
+	"podgc":                                {},
+	"pv-protection":                        {},
+	"pvc-protection":                       {},
+	"replicaset":                           {Required: true, ConcurrentSyncsFlag: "concurrent-replicaset-syncs"},
+	"replicationcontroller":                {ConcurrentSyncsFlag: "concurrent-rc-syncs"},
+	"resource-claim-controller":            {VersionRange: versionutils.VersionRange{AddedInVersion: "1.27"}},
+	"resourcequota":                        {ConcurrentSyncsFlag: "concurrent-resource-quota-syncs"},
+	"root-ca-cert-publisher":               {Required: true},
+	"route":                                {},
+	"service":                              {ConcurrentSyncsFlag: "concurrent-service-syncs"},
+	"service-cidr-controller":              {VersionRange: versionutils.VersionRange{AddedInVersion: "1.29"}},
+	"serviceaccount":                       {Required: true},
+	"serviceaccount-token":                 {Required: true, ConcurrentSyncsFlag: "concurrent-serviceaccount-token-syncs"},
+	"statefulset":                          {ConcurrentSyncsFlag: "concurrent-statefulset-syncs"},
+	"storage-version-gc":                   {},
+	"storage-version-migrator-controller":  {VersionRange: versionutils.VersionRange{AddedInVersion: "1.30"}},
+	"taint-eviction-controller":            {VersionRange: versionutils.VersionRange{AddedInVersion: "1.29"}},
+	"tokencleaner":                         {Required: true},
+	"ttl":                                  {},
+	"ttl-after-finished":                   {ConcurrentSyncsFlag: "concurrent-ttl-after-finished-syncs"},
+	"validatingadmissionpolicy-status-controller": {VersionRange: versionutils.VersionRange{AddedInVersion: "1.28"}},
+}
+
+// ControllerVersionRange represents a version range of type [AddedInVersion, RemovedInVersion) of a controller.
+type ControllerVersionRange struct {
+	// Required indicates whether the controller is required by Gardener and thus must not be disabled.
+	Required bool
+	// ConcurrentSyncsFlag is the name of the kube-controller-manager flag for the number of objects the controller
+	// syncs concurrently. It is empty if the controller does not offer such a flag.
+	ConcurrentSyncsFlag string
+	versionutils.VersionRange
+}
+
+// IsControllerSupported returns true if the given controller is supported for the given Kubernetes version.
+// A controller is only supported if it's a known controller and its version range contains the given Kubernetes version.
+func IsControllerSupported(controller, version string) (bool, error) {
+	vr := controllersVersionRanges[controller]
+	if vr == nil {
+		return false, fmt.Errorf("unknown controller %q", controller)
+	}
+	return vr.Contains(version)
+}
+
+// ConcurrentSyncsFlag returns the name of the kube-controller-manager flag for the number of objects the given controller
+// syncs concurrently. It returns an empty string if the controller is unknown or does not offer such a flag.
+func ConcurrentSyncsFlag(controller string) string {
+	if vr := controllersVersionRanges[controller]; vr != nil {
+		return vr.ConcurrentSyncsFlag
+	}
+	return ""
+}
+
+// ValidateControllers validates the given kube-controller-manager controllers against the given Kubernetes version.
+func ValidateControllers(controllers []core.KubeControllerManagerController, version string, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	names := sets.New[string]()
+	for i, controller := range controllers {
+		idxPath := fldPath.Index(i)
+
+		if len(controller.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide a name"))
+			continue
+		}
+
+		if names.Has(controller.Name) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), controller.Name))
+		}
+		names.Insert(controller.Name)
+
+		supported, err := IsControllerSupported(controller.Name, version)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), controller.Name, err.Error()))
+			continue
+		} else if !supported {
+			allErrs = append(allErrs, field.Forbidden(idxPath.Child("name"), fmt.Sprintf("controller %q is not supported in Kubernetes version %s", controller.Name, version)))
+			continue
+		}
+
+		disabled := !ptr.Deref(controller.Enabled, true)
+		if disabled && controllersVersionRanges[controller.Name].Required {
+			allErrs = append(allErrs, field.Forbidden(idxPath.Child("enabled"), fmt.Sprintf("controller %q cannot be disabled", controller.Name)))
+		}
+
+		if controller.ConcurrentSyncs != nil {
+			switch {
+			case ConcurrentSyncsFlag(controller.Name) == "":
+				allErrs = append(allErrs, field.Forbidden(idxPath.Child("concurrentSyncs"), fmt.Sprintf("controller %q does not support configuring concurrent syncs", controller.Name)))
+			case disabled:
+				allErrs = append(allErrs, field.Forbidden(idxPath.Child("concurrentSyncs"), "must not be set when the controller is disabled"))
+			case *controller.ConcurrentSyncs <= 0:
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("concurrentSyncs"), *controller.ConcurrentSyncs, "must be greater than 0"))
+			}
+		}
+	}
+
+	return allErrs
+}
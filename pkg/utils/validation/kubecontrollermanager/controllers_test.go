@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package kubecontrollermanager_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	gomegatypes "github.com/onsi/gomega/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	. "github.com/gardener/gardener/pkg/utils/validation/kubecontrollermanager"
+)
+
+var _ = Describe("controllers", func() {
+	DescribeTable("#IsControllerSupported",
+		func(controllerName, version string, supported, success bool) {
+			result, err := IsControllerSupported(controllerName, version)
+			if success {
+				Expect(err).To(Not(HaveOccurred()))
+				Expect(result).To(Equal(supported))
+			} else {
+				Expect(err).To(HaveOccurred())
+			}
+		},
+		Entry("Unknown controller", "Unknown", "1.28", false, false),
+		Entry("Known controller but version not present in supported range", "taint-eviction-controller", "1.28", false, true),
+		Entry("Known controller and version present in supported range", "taint-eviction-controller", "1.29", true, true),
+		Entry("Known controller but version range not present", "ttl-after-finished", "1.25", true, true),
+	)
+
+	DescribeTable("#ConcurrentSyncsFlag",
+		func(controllerName, expectedFlag string) {
+			Expect(ConcurrentSyncsFlag(controllerName)).To(Equal(expectedFlag))
+		},
+		Entry("Unknown controller", "Unknown", ""),
+		Entry("Controller without flag", "podgc", ""),
+		Entry("Controller with flag", "ttl-after-finished", "concurrent-ttl-after-finished-syncs"),
+	)
+
+	Describe("#ValidateControllers", func() {
+		DescribeTable("validate controllers",
+			func(controllers []core.KubeControllerManagerController, version string, matcher gomegatypes.GomegaMatcher) {
+				Expect(ValidateControllers(controllers, version, field.NewPath("controllers"))).To(matcher)
+			},
+			Entry("empty list", nil, "1.30.1", BeEmpty()),
+			Entry("valid controllers", []core.KubeControllerManagerController{
+				{Name: "ttl-after-finished", Enabled: ptr.To(false)},
+				{Name: "deployment", ConcurrentSyncs: ptr.To[int32](100)},
+				{Name: "taint-eviction-controller", Enabled: ptr.To(true)},
+			}, "1.30.1", BeEmpty()),
+			Entry("controller without name", []core.KubeControllerManagerController{{}}, "1.30.1", ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeRequired),
+				"Field":  Equal("controllers[0].name"),
+				"Detail": Equal("must provide a name"),
+			})))),
+			Entry("unknown controller", []core.KubeControllerManagerController{{Name: "foo"}}, "1.30.1", ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeInvalid),
+				"Field":  Equal("controllers[0].name"),
+				"Detail": Equal("unknown controller \"foo\""),
+			})))),
+			Entry("unsupported controller", []core.KubeControllerManagerController{{Name: "taint-eviction-controller", Enabled: ptr.To(false)}}, "1.28.5", ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeForbidden),
+				"Field":  Equal("controllers[0].name"),
+				"Detail": Equal("controller \"taint-eviction-controller\" is not supported in Kubernetes version 1.28.5"),
+			})))),
+			Entry("duplicate controller", []core.KubeControllerManagerController{{Name: "podgc"}, {Name: "podgc"}}, "1.30.1", ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("controllers[1].name"),
+			})))),
+			Entry("disabling required controller", []core.KubeControllerManagerController{{Name: "namespace", Enabled: ptr.To(false)}}, "1.30.1", ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeForbidden),
+				"Field":  Equal("controllers[0].enabled"),
+				"Detail": Equal("controller \"namespace\" cannot be disabled"),
+			})))),
+			Entry("invalid concurrent syncs", []core.KubeControllerManagerController{
+				{Name: "podgc", ConcurrentSyncs: ptr.To[int32](5)},
+				{Name: "ttl-after-finished", Enabled: ptr.To(false), ConcurrentSyncs: ptr.To[int32](5)},
+				{Name: "deployment", ConcurrentSyncs: ptr.To[int32](0)},
+			}, "1.30.1", ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeForbidden),
+					"Field":  Equal("controllers[0].concurrentSyncs"),
+					"Detail": Equal("controller \"podgc\" does not support configuring concurrent syncs"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":   Equal(field.ErrorTypeForbidden),
+					"Field":  Equal("controllers[1].concurrentSyncs"),
+					"Detail": Equal("must not be set when the controller is disabled"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers[2].concurrentSyncs"),
+				})),
+			)),
+		)
+	})
+})
@@ -10,6 +10,7 @@ import (
 	"io"
 	"strings"
 
+	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -30,8 +31,11 @@ const (
 	// `.spec.tolerations.whitelist` field in `Project` resources.
 	CustomVerbModifyProjectTolerationsWhitelist = "modify-spec-tolerations-whitelist"
 	// CustomVerbProjectManageMembers is a constant for the custom verb that allows to manage human users or
-	// groups subjects in the `.spec.members` field in `Project` resources.
+	// groups subjects in the `.spec.members` field and to modify the `.spec.memberSync` field in `Project` resources.
 	CustomVerbProjectManageMembers = "manage-members"
+	// CustomVerbModifyProjectQuotas is a constant for the custom verb that allows modifying the `.spec.quotas` field in
+	// `Project` resources.
+	CustomVerbModifyProjectQuotas = "modify-spec-quotas"
 
 	// CustomVerbNamespacedCloudProfileModifyKubernetes is a constant for the custom verb that allows modifying the
 	// `.spec.kubernetes` field in `NamespacedCloudProfile` resources.
@@ -42,6 +46,10 @@ const (
 	// CustomVerbNamespacedCloudProfileModifyProviderConfig is a constant for the custom verb that allows modifying the
 	// `.spec.providerConfig` field in `NamespacedCloudProfile` resources.
 	CustomVerbNamespacedCloudProfileModifyProviderConfig = "modify-spec-providerconfig"
+
+	// CustomVerbShootModifyETCDBackup is a constant for the custom verb that allows modifying the
+	// `.spec.etcd.backup` field in `Shoot` resources.
+	CustomVerbShootModifyETCDBackup = "modify-spec-etcd-backup"
 )
 
 // Register registers a plugin.
@@ -88,6 +96,8 @@ func (c *CustomVerbAuthorizer) Validate(ctx context.Context, a admission.Attribu
 		return c.admitProjects(ctx, a)
 	case core.Kind("NamespacedCloudProfile"):
 		return c.admitNamespacedCloudProfiles(ctx, a)
+	case core.Kind("Shoot"):
+		return c.admitShoots(ctx, a)
 	}
 
 	return nil
@@ -112,10 +122,22 @@ func (c *CustomVerbAuthorizer) admitProjects(ctx context.Context, a admission.At
 		}
 	}
 
+	if mustCheckProjectQuotas(oldObj.Spec.Quotas, obj.Spec.Quotas) {
+		if err := c.authorize(ctx, a, CustomVerbModifyProjectQuotas, "modify .spec.quotas"); err != nil {
+			return err
+		}
+	}
+
 	if mustCheckProjectTolerationsWhitelist(oldObj.Spec.Tolerations, obj.Spec.Tolerations) {
 		return c.authorize(ctx, a, CustomVerbModifyProjectTolerationsWhitelist, "modify .spec.tolerations.whitelist")
 	}
 
+	if mustCheckProjectMemberSync(oldObj.Spec.MemberSync, obj.Spec.MemberSync, obj.Spec.Owner, a.GetUserInfo()) {
+		if err := c.authorize(ctx, a, CustomVerbProjectManageMembers, "modify .spec.memberSync"); err != nil {
+			return err
+		}
+	}
+
 	if mustCheckProjectMembers(oldObj.Spec.Members, obj.Spec.Members, obj.Spec.Owner, a.GetUserInfo()) {
 		return c.authorize(ctx, a, CustomVerbProjectManageMembers, "manage human users or groups in .spec.members")
 	}
@@ -166,6 +188,32 @@ func (c *CustomVerbAuthorizer) admitNamespacedCloudProfiles(ctx context.Context,
 	return nil
 }
 
+func (c *CustomVerbAuthorizer) admitShoots(ctx context.Context, a admission.Attributes) error {
+	var (
+		oldObj = &core.Shoot{}
+		obj    *core.Shoot
+		ok     bool
+	)
+
+	obj, ok = a.GetObject().(*core.Shoot)
+	if !ok {
+		return apierrors.NewBadRequest("could not convert resource into Shoot object")
+	}
+
+	if a.GetOperation() == admission.Update {
+		oldObj, ok = a.GetOldObject().(*core.Shoot)
+		if !ok {
+			return apierrors.NewBadRequest("could not convert old resource into Shoot object")
+		}
+	}
+
+	if mustCheckETCDBackup(oldObj.Spec.ETCD, obj.Spec.ETCD) {
+		return c.authorize(ctx, a, CustomVerbShootModifyETCDBackup, "modify .spec.etcd.backup")
+	}
+
+	return nil
+}
+
 func (c *CustomVerbAuthorizer) authorize(ctx context.Context, a admission.Attributes, verb, operation string) error {
 	var (
 		userInfo  = a.GetUserInfo()
@@ -206,6 +254,10 @@ func mustCheckProjectTolerationsWhitelist(oldTolerations, tolerations *core.Proj
 	return !apiequality.Semantic.DeepEqual(oldTolerations.Whitelist, tolerations.Whitelist)
 }
 
+func mustCheckProjectQuotas(oldQuotas, quotas []corev1.ObjectReference) bool {
+	return !apiequality.Semantic.DeepEqual(oldQuotas, quotas)
+}
+
 func mustCheckProjectMembers(oldMembers, members []core.ProjectMember, owner *rbacv1.Subject, userInfo user.Info) bool {
 	if apiequality.Semantic.DeepEqual(oldMembers, members) {
 		return false
@@ -227,6 +279,16 @@ func mustCheckProjectMembers(oldMembers, members []core.ProjectMember, owner *rb
 	return !oldHumanUsers.Equal(newHumanUsers)
 }
 
+func mustCheckProjectMemberSync(oldMemberSync, memberSync *core.ProjectMemberSync, owner *rbacv1.Subject, userInfo user.Info) bool {
+	if apiequality.Semantic.DeepEqual(oldMemberSync, memberSync) {
+		return false
+	}
+
+	// The member sync grants project roles to all members of the configured groups, hence it is treated like managing
+	// human users and groups in `.spec.members`.
+	return !userIsOwner(userInfo, owner)
+}
+
 func findHumanUsers(members []core.ProjectMember) sets.Set[string] {
 	result := sets.New[string]()
 
@@ -281,3 +343,14 @@ func mustCheckMachineImages(oldMachineImages, machineImages []core.MachineImage)
 func mustCheckProviderConfig(oldProviderConfig, providerConfig *runtime.RawExtension) bool {
 	return !apiequality.Semantic.DeepEqual(oldProviderConfig, providerConfig)
 }
+
+func mustCheckETCDBackup(oldETCD, etcd *core.ETCD) bool {
+	var oldBackup, backup *core.ETCDBackup
+	if oldETCD != nil {
+		oldBackup = oldETCD.Backup
+	}
+	if etcd != nil {
+		backup = etcd.Backup
+	}
+	return !apiequality.Semantic.DeepEqual(oldBackup, backup)
+}
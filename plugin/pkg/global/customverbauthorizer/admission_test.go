@@ -11,6 +11,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -78,6 +79,70 @@ var _ = Describe("customverbauthorizer", func() {
 				Expect(err).NotTo(HaveOccurred())
 			})
 
+			Context("modify-spec-quotas verb", func() {
+				BeforeEach(func() {
+					authorizeAttributes.Verb = CustomVerbModifyProjectQuotas
+				})
+
+				It("should always allow creating a project without quotas", func() {
+					attrs = admission.NewAttributesRecord(project, nil, core.Kind("Project").WithVersion("version"), project.Namespace, project.Name, core.Resource("projects").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+					Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(Succeed())
+				})
+
+				Describe("permissions granted", func() {
+					BeforeEach(func() {
+						auth.EXPECT().Authorize(ctx, authorizeAttributes).Return(authorizer.DecisionAllow, "", nil)
+					})
+
+					It("should allow creating a project with quotas", func() {
+						project.Spec.Quotas = []corev1.ObjectReference{{Namespace: "garden", Name: "foo"}}
+
+						attrs = admission.NewAttributesRecord(project, nil, core.Kind("Project").WithVersion("version"), project.Namespace, project.Name, core.Resource("projects").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+						Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(Succeed())
+					})
+
+					It("should allow removing a project's quotas", func() {
+						project.Spec.Quotas = []corev1.ObjectReference{{Namespace: "garden", Name: "foo"}}
+						oldProject := project.DeepCopy()
+						project.Spec.Quotas = nil
+
+						attrs = admission.NewAttributesRecord(project, oldProject, core.Kind("Project").WithVersion("version"), project.Namespace, project.Name, core.Resource("projects").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+						Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(Succeed())
+					})
+				})
+
+				Describe("permissions not granted", func() {
+					BeforeEach(func() {
+						auth.EXPECT().Authorize(ctx, authorizeAttributes).Return(authorizer.DecisionDeny, "", nil)
+					})
+
+					It("should forbid creating a project with quotas", func() {
+						project.Spec.Quotas = []corev1.ObjectReference{{Namespace: "garden", Name: "foo"}}
+
+						attrs = admission.NewAttributesRecord(project, nil, core.Kind("Project").WithVersion("version"), project.Namespace, project.Name, core.Resource("projects").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+						Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).NotTo(Succeed())
+					})
+
+					It("should forbid updating a project's quotas", func() {
+						project.Spec.Quotas = []corev1.ObjectReference{{Namespace: "garden", Name: "foo"}}
+						oldProject := project.DeepCopy()
+						project.Spec.Quotas = append(project.Spec.Quotas, corev1.ObjectReference{Namespace: "garden", Name: "bar"})
+
+						attrs = admission.NewAttributesRecord(project, oldProject, core.Kind("Project").WithVersion("version"), project.Namespace, project.Name, core.Resource("projects").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+						Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).NotTo(Succeed())
+					})
+
+					It("should forbid removing a project's quotas", func() {
+						project.Spec.Quotas = []corev1.ObjectReference{{Namespace: "garden", Name: "foo"}}
+						oldProject := project.DeepCopy()
+						project.Spec.Quotas = nil
+
+						attrs = admission.NewAttributesRecord(project, oldProject, core.Kind("Project").WithVersion("version"), project.Namespace, project.Name, core.Resource("projects").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+						Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).NotTo(Succeed())
+					})
+				})
+			})
+
 			Context("modify-spec-tolerations-whitelist verb", func() {
 				BeforeEach(func() {
 					authorizeAttributes.Verb = CustomVerbModifyProjectTolerationsWhitelist
@@ -353,6 +418,92 @@ var _ = Describe("customverbauthorizer", func() {
 					})
 				})
 			})
+			Context("manage-members verb for member sync", func() {
+				var (
+					owner = rbacv1.Subject{
+						Kind: rbacv1.UserKind,
+						Name: "owner",
+					}
+					memberSync = &core.ProjectMemberSync{
+						Groups: []core.ProjectMemberSyncGroup{{Name: "developers", Roles: []string{"admin"}}},
+					}
+				)
+
+				BeforeEach(func() {
+					authorizeAttributes.Verb = CustomVerbProjectManageMembers
+					project.Spec.Owner = &owner
+				})
+
+				It("should always allow creating a project with member sync if creator=owner", func() {
+					project.Spec.Owner = &rbacv1.Subject{Kind: rbacv1.UserKind, Name: userInfo.Name}
+					project.Spec.MemberSync = memberSync
+					attrs = admission.NewAttributesRecord(project, nil, core.Kind("Project").WithVersion("version"), project.Namespace, project.Name, core.Resource("projects").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+					Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(Succeed())
+				})
+
+				It("should always allow updating a project without changing the member sync", func() {
+					project.Spec.MemberSync = memberSync
+					oldProject := project.DeepCopy()
+					project.Spec.Purpose = ptr.To("foo")
+
+					attrs = admission.NewAttributesRecord(project, oldProject, core.Kind("Project").WithVersion("version"), project.Namespace, project.Name, core.Resource("projects").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+					Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(Succeed())
+				})
+
+				Describe("permissions granted", func() {
+					BeforeEach(func() {
+						auth.EXPECT().Authorize(ctx, authorizeAttributes).Return(authorizer.DecisionAllow, "", nil)
+					})
+
+					It("should allow adding the member sync", func() {
+						oldProject := project.DeepCopy()
+						project.Spec.MemberSync = memberSync
+
+						attrs = admission.NewAttributesRecord(project, oldProject, core.Kind("Project").WithVersion("version"), project.Namespace, project.Name, core.Resource("projects").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+						Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(Succeed())
+					})
+				})
+
+				Describe("permissions not granted", func() {
+					BeforeEach(func() {
+						auth.EXPECT().Authorize(ctx, authorizeAttributes).Return(authorizer.DecisionDeny, "", nil)
+					})
+
+					It("should forbid creating a project with member sync if creator!=owner", func() {
+						project.Spec.MemberSync = memberSync
+						attrs = admission.NewAttributesRecord(project, nil, core.Kind("Project").WithVersion("version"), project.Namespace, project.Name, core.Resource("projects").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+						Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(MatchError(ContainSubstring("modify .spec.memberSync")))
+					})
+
+					It("should forbid adding the member sync (user!=owner)", func() {
+						oldProject := project.DeepCopy()
+						project.Spec.MemberSync = memberSync
+
+						attrs = admission.NewAttributesRecord(project, oldProject, core.Kind("Project").WithVersion("version"), project.Namespace, project.Name, core.Resource("projects").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+						Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(MatchError(ContainSubstring("modify .spec.memberSync")))
+					})
+
+					It("should forbid changing the groups of the member sync (user!=owner)", func() {
+						project.Spec.MemberSync = memberSync
+						oldProject := project.DeepCopy()
+						project.Spec.MemberSync = &core.ProjectMemberSync{
+							Groups: []core.ProjectMemberSyncGroup{{Name: "everyone", Roles: []string{"admin"}}},
+						}
+
+						attrs = admission.NewAttributesRecord(project, oldProject, core.Kind("Project").WithVersion("version"), project.Namespace, project.Name, core.Resource("projects").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+						Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(MatchError(ContainSubstring("modify .spec.memberSync")))
+					})
+
+					It("should forbid removing the member sync (user!=owner)", func() {
+						project.Spec.MemberSync = memberSync
+						oldProject := project.DeepCopy()
+						project.Spec.MemberSync = nil
+
+						attrs = admission.NewAttributesRecord(project, oldProject, core.Kind("Project").WithVersion("version"), project.Namespace, project.Name, core.Resource("projects").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+						Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(MatchError(ContainSubstring("modify .spec.memberSync")))
+					})
+				})
+			})
 		})
 
 		Context("NamespacedCloudProfiles", func() {
@@ -634,6 +785,115 @@ var _ = Describe("customverbauthorizer", func() {
 				})
 			})
 		})
+
+		Context("Shoots", func() {
+			var (
+				shoot *core.Shoot
+			)
+
+			BeforeEach(func() {
+				shoot = &core.Shoot{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "dummy",
+						Namespace: "dummy-namespace",
+					},
+				}
+
+				authorizeAttributes = authorizer.AttributesRecord{
+					User:            userInfo,
+					APIGroup:        "core.gardener.cloud",
+					Namespace:       shoot.Namespace,
+					Name:            shoot.Name,
+					ResourceRequest: true,
+				}
+
+				authorizeAttributes.Resource = "shoots"
+			})
+
+			Context("modify-spec-etcd-backup verb", func() {
+				BeforeEach(func() {
+					authorizeAttributes.Verb = CustomVerbShootModifyETCDBackup
+				})
+
+				It("should always allow creating a Shoot without etcd backup settings", func() {
+					shoot.Spec.ETCD = &core.ETCD{}
+
+					attrs = admission.NewAttributesRecord(shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+					Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(Succeed())
+				})
+
+				It("should always allow updating a Shoot without changing its etcd backup settings", func() {
+					shoot.Spec.ETCD = &core.ETCD{Backup: &core.ETCDBackup{FullSnapshotSchedule: ptr.To("0 */12 * * *")}}
+					oldShoot := shoot.DeepCopy()
+					shoot.Spec.Region = "foo"
+
+					attrs = admission.NewAttributesRecord(shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+					Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(Succeed())
+				})
+
+				Describe("permissions granted", func() {
+					BeforeEach(func() {
+						auth.EXPECT().Authorize(ctx, authorizeAttributes).Return(authorizer.DecisionAllow, "", nil)
+					})
+
+					It("should allow creating a Shoot with etcd backup settings", func() {
+						shoot.Spec.ETCD = &core.ETCD{Backup: &core.ETCDBackup{FullSnapshotSchedule: ptr.To("0 */12 * * *")}}
+
+						attrs = admission.NewAttributesRecord(shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+						Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(Succeed())
+					})
+
+					It("should allow updating a Shoot's etcd backup settings", func() {
+						shoot.Spec.ETCD = &core.ETCD{Backup: &core.ETCDBackup{FullSnapshotSchedule: ptr.To("0 */12 * * *")}}
+						oldShoot := shoot.DeepCopy()
+						shoot.Spec.ETCD.Backup.DeltaSnapshotRetentionPeriod = &metav1.Duration{Duration: 72 * time.Hour}
+
+						attrs = admission.NewAttributesRecord(shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+						Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(Succeed())
+					})
+
+					It("should allow removing a Shoot's etcd backup settings", func() {
+						shoot.Spec.ETCD = &core.ETCD{Backup: &core.ETCDBackup{FullSnapshotSchedule: ptr.To("0 */12 * * *")}}
+						oldShoot := shoot.DeepCopy()
+						shoot.Spec.ETCD = nil
+
+						attrs = admission.NewAttributesRecord(shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+						Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(Succeed())
+					})
+				})
+
+				Describe("permissions not granted", func() {
+					BeforeEach(func() {
+						auth.EXPECT().Authorize(ctx, authorizeAttributes).Return(authorizer.DecisionDeny, "", nil)
+					})
+
+					It("should forbid creating a Shoot with etcd backup settings", func() {
+						shoot.Spec.ETCD = &core.ETCD{Backup: &core.ETCDBackup{FullSnapshotSchedule: ptr.To("0 */12 * * *")}}
+
+						attrs = admission.NewAttributesRecord(shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+						Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).NotTo(Succeed())
+					})
+
+					It("should forbid updating a Shoot's etcd backup settings", func() {
+						shoot.Spec.ETCD = &core.ETCD{Backup: &core.ETCDBackup{FullSnapshotSchedule: ptr.To("0 */12 * * *")}}
+						oldShoot := shoot.DeepCopy()
+						shoot.Spec.ETCD.Backup.DeltaSnapshotRetentionPeriod = &metav1.Duration{Duration: 72 * time.Hour}
+
+						attrs = admission.NewAttributesRecord(shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+						Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).NotTo(Succeed())
+					})
+
+					It("should forbid removing a Shoot's etcd backup settings", func() {
+						shoot.Spec.ETCD = &core.ETCD{Backup: &core.ETCDBackup{FullSnapshotSchedule: ptr.To("0 */12 * * *")}}
+						oldShoot := shoot.DeepCopy()
+						shoot.Spec.ETCD = nil
+
+						attrs = admission.NewAttributesRecord(shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+						Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).NotTo(Succeed())
+					})
+				})
+			})
+		})
 	})
 
 	Describe("#Register", func() {
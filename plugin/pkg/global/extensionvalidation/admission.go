@@ -292,6 +292,10 @@ func (e *ExtensionValidator) validateShoot(kindToTypesMap map[string]sets.Set[st
 		}
 	}
 
+	if kubeAPIServer := spec.Kubernetes.KubeAPIServer; kubeAPIServer != nil && kubeAPIServer.AuditConfig != nil && kubeAPIServer.AuditConfig.Sink != nil {
+		requiredExtensions = append(requiredExtensions, requiredExtension{extensionsv1alpha1.AuditSinkResource, kubeAPIServer.AuditConfig.Sink.Type, fmt.Sprintf("%s audit sink type: %s", message, field.NewPath("spec", "kubernetes", "kubeAPIServer", "auditConfig", "sink", "type"))})
+	}
+
 	for i, extension := range spec.Extensions {
 		requiredExtensions = append(requiredExtensions, requiredExtension{extensionsv1alpha1.ExtensionResource, extension.Type, fmt.Sprintf("extension type: %s", field.NewPath("spec", "extensions").Index(i).Child("type"))})
 	}
@@ -286,6 +286,13 @@ var _ = Describe("ExtensionValidator", func() {
 					{Type: "foo1"},
 					{Type: "foo2"},
 				},
+				Kubernetes: core.Kubernetes{
+					KubeAPIServer: &core.KubeAPIServerConfig{
+						AuditConfig: &core.AuditConfig{
+							Sink: &core.AuditSink{Type: "foo7"},
+						},
+					},
+				},
 				Networking: &core.Networking{
 					Type: ptr.To("foo3"),
 				},
@@ -317,6 +324,7 @@ var _ = Describe("ExtensionValidator", func() {
 			kindToTypes = []struct {
 				extensionKind, extensionType string
 			}{
+				{extensionsv1alpha1.AuditSinkResource, shoot.Spec.Kubernetes.KubeAPIServer.AuditConfig.Sink.Type},
 				{extensionsv1alpha1.ControlPlaneResource, shoot.Spec.Provider.Type},
 				{extensionsv1alpha1.ExtensionResource, shoot.Spec.Extensions[0].Type},
 				{extensionsv1alpha1.ExtensionResource, shoot.Spec.Extensions[1].Type},
@@ -406,6 +406,9 @@ func (r *ReferenceManager) Admit(ctx context.Context, a admission.Attributes, _
 			}
 
 			err = r.ensureProjectNamespace(project)
+			if err == nil {
+				err = r.ensureProjectQuotas(project)
+			}
 		case admission.Update:
 			oldProject, ok := a.GetOldObject().(*core.Project)
 			if !ok {
@@ -414,6 +417,9 @@ func (r *ReferenceManager) Admit(ctx context.Context, a admission.Attributes, _
 			if oldProject.Spec.Namespace == nil && project.Spec.Namespace != nil {
 				err = r.ensureProjectNamespace(project)
 			}
+			if err == nil && !apiequality.Semantic.DeepEqual(oldProject.Spec.Quotas, project.Spec.Quotas) {
+				err = r.ensureProjectQuotas(project)
+			}
 		}
 
 		if project.Spec.Owner != nil {
@@ -597,6 +603,12 @@ func (r *ReferenceManager) Admit(ctx context.Context, a admission.Attributes, _
 				for channelResult := range channel {
 					err = multierror.Append(err, channelResult)
 				}
+
+				// The usage tracked by gardener-controller-manager also covers Shoots which are not yet known to the
+				// informer caches of this API server, hence consult it as well.
+				if err == nil {
+					err = validateRemovedVersionsAgainstUsage(oldCloudProfile, sets.Set[string](removedKubernetesVersions), removedMachineImageVersions)
+				}
 			}
 		}
 
@@ -740,6 +752,15 @@ func (r *ReferenceManager) ensureProjectNamespace(project *core.Project) error {
 	return nil
 }
 
+func (r *ReferenceManager) ensureProjectQuotas(project *core.Project) error {
+	for _, quotaRef := range project.Spec.Quotas {
+		if _, err := r.quotaLister.Quotas(quotaRef.Namespace).Get(quotaRef.Name); err != nil {
+			return fmt.Errorf("failed to get quota %s/%s: %w", quotaRef.Namespace, quotaRef.Name, err)
+		}
+	}
+	return nil
+}
+
 func (r *ReferenceManager) ensureBindingReferences(ctx context.Context, attributes admission.Attributes, binding runtime.Object) error {
 	var (
 		quotas                []corev1.ObjectReference
@@ -1021,6 +1042,13 @@ func (r *ReferenceManager) ensureShootReferences(ctx context.Context, attributes
 		}
 	}
 
+	if configMapName := helper.GetShootAPIPriorityAndFairnessConfigMapName(shoot.Spec.Kubernetes.KubeAPIServer); configMapName != "" &&
+		configMapName != helper.GetShootAPIPriorityAndFairnessConfigMapName(oldShoot.Spec.Kubernetes.KubeAPIServer) && shoot.DeletionTimestamp == nil {
+		if err := r.lookupConfigMap(ctx, shoot.Namespace, configMapName); err != nil {
+			return fmt.Errorf("failed to resolve API priority and fairness config map reference: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -1101,6 +1129,30 @@ func isShootRelatedToCloudProfile(shoot *gardencorev1beta1.Shoot, cloudProfile *
 			relevantNcp != nil && relevantNcp.Spec.Parent.Name == cloudProfile.Name
 }
 
+// validateRemovedVersionsAgainstUsage returns an error if one of the removed Kubernetes or machine image versions is
+// still in use according to the usage tracked in the status of the CloudProfile.
+func validateRemovedVersionsAgainstUsage(cloudProfile *core.CloudProfile, removedKubernetesVersions sets.Set[string], removedMachineImageVersions map[string]sets.Set[string]) error {
+	if cloudProfile.Status.Usage == nil {
+		return nil
+	}
+
+	var err error
+
+	for _, usage := range cloudProfile.Status.Usage.KubernetesVersions {
+		if usage.Shoots > 0 && removedKubernetesVersions.Has(usage.Version) {
+			err = multierror.Append(err, fmt.Errorf("unable to delete Kubernetes version %q from CloudProfile %q - version is still in use by %d shoot(s)", usage.Version, cloudProfile.Name, usage.Shoots))
+		}
+	}
+
+	for _, usage := range cloudProfile.Status.Usage.MachineImageVersions {
+		if usage.Shoots > 0 && removedMachineImageVersions[usage.Name].Has(usage.Version) {
+			err = multierror.Append(err, fmt.Errorf("unable to delete Machine image version '%s/%s' from CloudProfile %q - version is still in use by %d shoot(s)", usage.Name, usage.Version, cloudProfile.Name, usage.Shoots))
+		}
+	}
+
+	return err
+}
+
 // getRemovedKubernetesVersions returns Kubernetes versions that have been removed from the NamespacedCloudProfile.
 func getRemovedKubernetesVersions(namespacedCloudProfile, oldNamespacedCloudProfile *core.NamespacedCloudProfile) sets.Set[string] {
 	var removedKubernetesVersions sets.Set[string]
@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"slices"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -1360,6 +1361,16 @@ var _ = Describe("resourcereferencemanager", func() {
 				}, "failed to resolve structured authorization config map reference")
 			})
 
+			Context("API priority and fairness config maps", func() {
+				tests("API priority and fairness config map", "configmaps", func(shoot *core.Shoot) {
+					shoot.Spec.Kubernetes.KubeAPIServer = &core.KubeAPIServerConfig{
+						APIPriorityAndFairness: &core.APIPriorityAndFairness{
+							ConfigMapName: ptr.To("foo"),
+						},
+					}
+				}, "failed to resolve API priority and fairness config map reference")
+			})
+
 			Context("structured authorization kubeconfig secrets", func() {
 				tests("structured authorization kubeconfig secret", "secrets", func(shoot *core.Shoot) {
 					shoot.Spec.Kubernetes.KubeAPIServer = &core.KubeAPIServerConfig{
@@ -1738,6 +1749,32 @@ var _ = Describe("resourcereferencemanager", func() {
 					}),
 				})))
 			})
+			It("should allow referencing existing quotas", func() {
+				Expect(gardenCoreInformerFactory.Core().V1beta1().Projects().Informer().GetStore().Add(&project)).To(Succeed())
+				Expect(gardenCoreInformerFactory.Core().V1beta1().Quotas().Informer().GetStore().Add(&quota)).To(Succeed())
+
+				coreProject.Spec.Quotas = []corev1.ObjectReference{{Namespace: quota.Namespace, Name: quota.Name}}
+				attrs := admission.NewAttributesRecord(&coreProject, nil, core.Kind("Project").WithVersion("version"), coreProject.Namespace, coreProject.Name, core.Resource("projects").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, defaultUserInfo)
+
+				Expect(admissionHandler.Admit(context.TODO(), attrs, nil)).To(Succeed())
+			})
+
+			It("should forbid referencing non-existing quotas", func() {
+				Expect(gardenCoreInformerFactory.Core().V1beta1().Projects().Informer().GetStore().Add(&project)).To(Succeed())
+
+				coreProjectOld := coreProject.DeepCopy()
+				coreProject.Spec.Quotas = []corev1.ObjectReference{{Namespace: quota.Namespace, Name: quota.Name}}
+				attrs := admission.NewAttributesRecord(&coreProject, coreProjectOld, core.Kind("Project").WithVersion("version"), coreProject.Namespace, coreProject.Name, core.Resource("projects").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, defaultUserInfo)
+
+				err := admissionHandler.Admit(context.TODO(), attrs, nil)
+
+				Expect(err).To(PointTo(MatchFields(IgnoreExtras, Fields{
+					"ErrStatus": MatchFields(IgnoreExtras, Fields{
+						"Code":    Equal(int32(http.StatusForbidden)),
+						"Message": ContainSubstring("failed to get quota"),
+					}),
+				})))
+			})
 		})
 
 		Context("CloudProfile - Update Kubernetes versions", func() {
@@ -1818,6 +1855,33 @@ var _ = Describe("resourcereferencemanager", func() {
 				Expect(err.Error()).To(ContainSubstring("1.24.1"))
 			})
 
+			It("should reject removal of kubernetes versions that are still in use according to the tracked usage", func() {
+				cloudProfileOld := *cloudProfile.DeepCopy()
+				cloudProfileOld.Status.Usage = &core.CloudProfileUsage{
+					KubernetesVersions: []core.VersionUsage{
+						{Version: "1.24.1", Shoots: 3},
+						{Version: "1.24.0", Shoots: 0},
+					},
+				}
+
+				cloudProfileNew := *cloudProfileOld.DeepCopy()
+				cloudProfileNew.Spec = core.CloudProfileSpec{
+					Kubernetes: core.KubernetesSettings{
+						Versions: []core.ExpirableVersion{
+							{Version: "1.24.2"},
+						},
+					},
+				}
+
+				attrs := admission.NewAttributesRecord(&cloudProfileNew, &cloudProfileOld, core.Kind("CloudProfile").WithVersion("version"), "", cloudProfile.Name, core.Resource("CloudProfile").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, defaultUserInfo)
+
+				err := admissionHandler.Admit(context.TODO(), attrs, nil)
+
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring(`unable to delete Kubernetes version "1.24.1" from CloudProfile "aws-profile" - version is still in use by 3 shoot(s)`))
+				Expect(err.Error()).NotTo(ContainSubstring("1.24.0"))
+			})
+
 			It("should reject removal of kubernetes versions that are still in use by a NamespacedCloudProfile", func() {
 				namespacedCloudProfile := &gardencorev1beta1.NamespacedCloudProfile{
 					Spec: gardencorev1beta1.NamespacedCloudProfileSpec{
@@ -2137,6 +2201,30 @@ var _ = Describe("resourcereferencemanager", func() {
 				Expect(err.Error()).To(ContainSubstring(shootTwo.Spec.Provider.Workers[1].Machine.Image.Name))
 			})
 
+			It("should reject removal of a machine image version that is in use according to the tracked usage", func() {
+				cloudProfileOld := *cloudProfile.DeepCopy()
+				cloudProfileOld.Status.Usage = &core.CloudProfileUsage{
+					MachineImageVersions: []core.MachineImageVersionUsage{
+						{Name: "coreos", Version: "1.17.1", Shoots: 1},
+						{Name: "ubuntu", Version: "1.17.3", Shoots: 2},
+					},
+				}
+
+				// new cloud profile has version 1.17.1 of coreos removed.
+				cloudProfileNew := *cloudProfileOld.DeepCopy()
+				cloudProfileNew.Spec.MachineImages[0].Versions = slices.DeleteFunc(slices.Clone(versions), func(version core.MachineImageVersion) bool {
+					return version.Version == "1.17.1"
+				})
+
+				attrs := admission.NewAttributesRecord(&cloudProfileNew, &cloudProfileOld, core.Kind("CloudProfile").WithVersion("version"), "", cloudProfile.Name, core.Resource("CloudProfile").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, defaultUserInfo)
+
+				err := admissionHandler.Admit(context.TODO(), attrs, nil)
+
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring(`unable to delete Machine image version 'coreos/1.17.1' from CloudProfile "aws-profile" - version is still in use by 1 shoot(s)`))
+				Expect(err.Error()).NotTo(ContainSubstring("ubuntu"))
+			})
+
 			It("should accept removal of a machine image version that is in use by a shoot that is being deleted", func() {
 				t := metav1.Now()
 				shootTwoDeleted := shootTwo.DeepCopy()
@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"io"
 	"reflect"
+	"time"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -201,8 +202,12 @@ func (c *validationContext) validateKubernetesVersionOverrides(attr admission.At
 	now := ptr.To(metav1.Now())
 	parentVersions := utils.CreateMapFromSlice(c.parentCloudProfile.Spec.Kubernetes.Versions, func(version gardencorev1beta1.ExpirableVersion) string { return version.Version })
 	currentVersionsMerged := make(map[string]gardencore.ExpirableVersion)
+	currentOverrides := make(map[string]gardencore.ExpirableVersion)
 	if attr.GetOperation() == admission.Update {
 		currentVersionsMerged = utils.CreateMapFromSlice(c.oldNamespacedCloudProfile.Status.CloudProfileSpec.Kubernetes.Versions, func(version gardencore.ExpirableVersion) string { return version.Version })
+		if c.oldNamespacedCloudProfile.Spec.Kubernetes != nil {
+			currentOverrides = utils.CreateMapFromSlice(c.oldNamespacedCloudProfile.Spec.Kubernetes.Versions, func(version gardencore.ExpirableVersion) string { return version.Version })
+		}
 	}
 	for _, newVersion := range c.namespacedCloudProfile.Spec.Kubernetes.Versions {
 		if _, exists := parentVersions[newVersion.Version]; !exists {
@@ -216,10 +221,28 @@ func (c *validationContext) validateKubernetesVersionOverrides(attr admission.At
 				return fmt.Errorf("expiration date for version %q is in the past", newVersion.Version)
 			}
 		}
+		if latestExpirationDate := latestKubernetesVersionExpirationDate(c.parentCloudProfile, parentVersions[newVersion.Version]); latestExpirationDate != nil && newVersion.ExpirationDate.After(latestExpirationDate.Time) {
+			// Overrides which were accepted before the maximum expiration extension was reduced remain valid, they are
+			// capped when being merged into the status.
+			if override, exists := currentOverrides[newVersion.Version]; !exists || !override.ExpirationDate.Equal(newVersion.ExpirationDate) {
+				return fmt.Errorf("expiration date for version %q must not be later than %s (expiration date in parent CloudProfile extended by at most %s)", newVersion.Version, latestExpirationDate.UTC().Format(time.RFC3339), c.parentCloudProfile.Spec.Kubernetes.MaxExpirationExtension.Duration)
+			}
+		}
 	}
 	return nil
 }
 
+// latestKubernetesVersionExpirationDate returns the latest expiration date a NamespacedCloudProfile may set for the
+// given Kubernetes version of the parent CloudProfile. It returns nil if the expiration date may be extended without
+// limit.
+func latestKubernetesVersionExpirationDate(parentCloudProfile *gardencorev1beta1.CloudProfile, parentVersion gardencorev1beta1.ExpirableVersion) *metav1.Time {
+	maxExpirationExtension := parentCloudProfile.Spec.Kubernetes.MaxExpirationExtension
+	if maxExpirationExtension == nil || parentVersion.ExpirationDate == nil {
+		return nil
+	}
+	return ptr.To(metav1.NewTime(parentVersion.ExpirationDate.Add(maxExpirationExtension.Duration)))
+}
+
 func (c *validationContext) validateMachineImageOverrides(attr admission.Attributes) error {
 	now := ptr.To(metav1.Now())
 	parentImages := util.NewV1beta1ImagesContext(c.parentCloudProfile.Spec.MachineImages)
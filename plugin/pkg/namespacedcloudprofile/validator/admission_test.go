@@ -229,6 +229,61 @@ var _ = Describe("Admission", func() {
 
 				Expect(admissionHandler.Validate(ctx, attrs, nil)).To(MatchError(ContainSubstring("expiration date for version \"1.28.0\" is in the past")))
 			})
+
+			Context("maximum expiration extension", func() {
+				var parentExpirationDate time.Time
+
+				BeforeEach(func() {
+					parentExpirationDate = time.Now().Add(24 * time.Hour).UTC().Truncate(time.Second)
+					parentCloudProfile.Spec.Kubernetes.MaxExpirationExtension = &metav1.Duration{Duration: 7 * 24 * time.Hour}
+					parentCloudProfile.Spec.Kubernetes.Versions[2].ExpirationDate = &metav1.Time{Time: parentExpirationDate}
+					Expect(coreInformerFactory.Core().V1beta1().CloudProfiles().Informer().GetStore().Add(parentCloudProfile)).To(Succeed())
+				})
+
+				It("should allow extending the expiration date within the maximum extension", func() {
+					namespacedCloudProfile.Spec.Kubernetes = &gardencore.KubernetesSettings{Versions: []gardencore.ExpirableVersion{
+						{Version: "1.28.0", ExpirationDate: &metav1.Time{Time: parentExpirationDate.Add(7 * 24 * time.Hour)}},
+					}}
+
+					attrs := admission.NewAttributesRecord(namespacedCloudProfile, nil, gardencorev1beta1.Kind("NamespacedCloudProfile").WithVersion("version"), "", namespacedCloudProfile.Name, gardencorev1beta1.Resource("namespacedcloudprofile").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+					Expect(admissionHandler.Validate(ctx, attrs, nil)).To(Succeed())
+				})
+
+				It("should allow extending the expiration date of a version without expiration date in the parent CloudProfile", func() {
+					namespacedCloudProfile.Spec.Kubernetes = &gardencore.KubernetesSettings{Versions: []gardencore.ExpirableVersion{
+						{Version: "1.29.0", ExpirationDate: &metav1.Time{Time: parentExpirationDate.Add(30 * 24 * time.Hour)}},
+					}}
+
+					attrs := admission.NewAttributesRecord(namespacedCloudProfile, nil, gardencorev1beta1.Kind("NamespacedCloudProfile").WithVersion("version"), "", namespacedCloudProfile.Name, gardencorev1beta1.Resource("namespacedcloudprofile").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+					Expect(admissionHandler.Validate(ctx, attrs, nil)).To(Succeed())
+				})
+
+				It("should fail for extending the expiration date beyond the maximum extension", func() {
+					namespacedCloudProfile.Spec.Kubernetes = &gardencore.KubernetesSettings{Versions: []gardencore.ExpirableVersion{
+						{Version: "1.28.0", ExpirationDate: &metav1.Time{Time: parentExpirationDate.Add(8 * 24 * time.Hour)}},
+					}}
+
+					attrs := admission.NewAttributesRecord(namespacedCloudProfile, nil, gardencorev1beta1.Kind("NamespacedCloudProfile").WithVersion("version"), "", namespacedCloudProfile.Name, gardencorev1beta1.Resource("namespacedcloudprofile").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+					Expect(admissionHandler.Validate(ctx, attrs, nil)).To(MatchError(ContainSubstring("expiration date for version \"1.28.0\" must not be later than %s", parentExpirationDate.Add(7*24*time.Hour).Format(time.RFC3339))))
+				})
+
+				It("should allow updates to a NamespacedCloudProfile if an unchanged override exceeds a reduced maximum extension", func() {
+					namespacedCloudProfile.Spec.Kubernetes = &gardencore.KubernetesSettings{Versions: []gardencore.ExpirableVersion{
+						{Version: "1.28.0", ExpirationDate: &metav1.Time{Time: parentExpirationDate.Add(8 * 24 * time.Hour)}},
+					}}
+					updatedNamespacedCloudProfile := namespacedCloudProfile.DeepCopy()
+					updatedNamespacedCloudProfile.Spec.Kubernetes.Versions = append(updatedNamespacedCloudProfile.Spec.Kubernetes.Versions,
+						gardencore.ExpirableVersion{Version: "1.29.0", ExpirationDate: validExpirationDate},
+					)
+
+					attrs := admission.NewAttributesRecord(updatedNamespacedCloudProfile, namespacedCloudProfile, gardencorev1beta1.Kind("NamespacedCloudProfile").WithVersion("version"), "", namespacedCloudProfile.Name, gardencorev1beta1.Resource("namespacedcloudprofile").WithVersion("version"), "", admission.Update, &metav1.CreateOptions{}, false, nil)
+
+					Expect(admissionHandler.Validate(ctx, attrs, nil)).To(Succeed())
+				})
+			})
 		})
 
 		Describe("machineType", func() {
@@ -38,6 +38,10 @@ const (
 	PluginNameProjectValidator = "ProjectValidator"
 	// PluginNameSeedValidator is the name of the SeedValidator admission plugin.
 	PluginNameSeedValidator = "SeedValidator"
+	// PluginNameShootCloning is the name of the ShootCloning admission plugin.
+	PluginNameShootCloning = "ShootCloning"
+	// PluginNameShootClass is the name of the ShootClass admission plugin.
+	PluginNameShootClass = "ShootClass"
 	// PluginNameShootDNS is the name of the ShootDNS admission plugin.
 	PluginNameShootDNS = "ShootDNS"
 	// PluginNameShootDNSRewriting is the name of the ShootDNSRewriting admission plugin.
@@ -68,6 +72,8 @@ const (
 func AllPluginNames() []string {
 	return []string{
 		lifecycle.PluginName,                        // NamespaceLifecycle
+		PluginNameShootCloning,                      // ShootCloning
+		PluginNameShootClass,                        // ShootClass
 		PluginNameResourceReferenceManager,          // ResourceReferenceManager
 		PluginNameExtensionValidator,                // ExtensionValidator
 		PluginNameExtensionLabels,                   // ExtensionLabels
@@ -110,6 +116,8 @@ func AllPluginNames() []string {
 func DefaultOnPlugins() sets.Set[string] {
 	return sets.New[string](
 		lifecycle.PluginName,                      // NamespaceLifecycle
+		PluginNameShootCloning,                    // ShootCloning
+		PluginNameShootClass,                      // ShootClass
 		PluginNameResourceReferenceManager,        // ResourceReferenceManager
 		PluginNameExtensionValidator,              // ExtensionValidator
 		PluginNameExtensionLabels,                 // ExtensionLabels
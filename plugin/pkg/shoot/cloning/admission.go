@@ -0,0 +1,222 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cloning
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	admissioninitializer "github.com/gardener/gardener/pkg/apiserver/admission/initializer"
+	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/externalversions"
+	gardencorev1beta1listers "github.com/gardener/gardener/pkg/client/core/listers/core/v1beta1"
+	plugin "github.com/gardener/gardener/plugin/pkg"
+)
+
+// Register registers a plugin.
+func Register(plugins *admission.Plugins) {
+	plugins.Register(plugin.PluginNameShootCloning, func(_ io.Reader) (admission.Interface, error) {
+		return New()
+	})
+}
+
+// Cloning contains listers, the authorizer and the admission handler.
+type Cloning struct {
+	*admission.Handler
+
+	authorizer  authorizer.Authorizer
+	shootLister gardencorev1beta1listers.ShootLister
+	readyFunc   admission.ReadyFunc
+}
+
+var (
+	_ = admissioninitializer.WantsCoreInformerFactory(&Cloning{})
+	_ = admissioninitializer.WantsAuthorizer(&Cloning{})
+
+	readyFuncs []admission.ReadyFunc
+)
+
+// New creates a new Cloning admission plugin.
+func New() (*Cloning, error) {
+	return &Cloning{
+		Handler: admission.NewHandler(admission.Create),
+	}, nil
+}
+
+// AssignReadyFunc assigns the ready function to the admission handler.
+func (c *Cloning) AssignReadyFunc(f admission.ReadyFunc) {
+	c.readyFunc = f
+	c.SetReadyFunc(f)
+}
+
+// SetAuthorizer gets the authorizer.
+func (c *Cloning) SetAuthorizer(authorizer authorizer.Authorizer) {
+	c.authorizer = authorizer
+}
+
+// SetCoreInformerFactory sets the external garden core informer factory.
+func (c *Cloning) SetCoreInformerFactory(f gardencoreinformers.SharedInformerFactory) {
+	shootInformer := f.Core().V1beta1().Shoots()
+	c.shootLister = shootInformer.Lister()
+
+	readyFuncs = append(readyFuncs, shootInformer.Informer().HasSynced)
+}
+
+func (c *Cloning) waitUntilReady(attrs admission.Attributes) error {
+	// Wait until the caches have been synced
+	if c.readyFunc == nil {
+		c.AssignReadyFunc(func() bool {
+			for _, readyFunc := range readyFuncs {
+				if !readyFunc() {
+					return false
+				}
+			}
+			return true
+		})
+	}
+
+	if !c.WaitForReady() {
+		return admission.NewForbidden(attrs, errors.New("not yet ready to handle request"))
+	}
+
+	return nil
+}
+
+// ValidateInitialization checks whether the plugin was correctly initialized.
+func (c *Cloning) ValidateInitialization() error {
+	if c.authorizer == nil {
+		return errors.New("missing authorizer")
+	}
+	if c.shootLister == nil {
+		return errors.New("missing shoot lister")
+	}
+	return nil
+}
+
+var _ admission.MutationInterface = &Cloning{}
+
+// Admit copies the specification of the Shoot referenced in the clone-from annotation into newly created Shoots.
+func (c *Cloning) Admit(ctx context.Context, a admission.Attributes, _ admission.ObjectInterfaces) error {
+	if err := c.waitUntilReady(a); err != nil {
+		return fmt.Errorf("err while waiting for ready %w", err)
+	}
+
+	if a.GetKind().GroupKind() != core.Kind("Shoot") {
+		return nil
+	}
+
+	// Ignore any updates to shoot subresources.
+	if a.GetSubresource() != "" {
+		return nil
+	}
+
+	shoot, ok := a.GetObject().(*core.Shoot)
+	if !ok {
+		return apierrors.NewBadRequest("could not convert resource into Shoot object")
+	}
+
+	value, ok := shoot.Annotations[v1beta1constants.AnnotationShootCloneFrom]
+	if !ok {
+		return nil
+	}
+
+	sourceNamespace, sourceName, err := parseSource(value, a.GetNamespace())
+	if err != nil {
+		return apierrors.NewBadRequest(fmt.Sprintf("invalid value for annotation %s: %v", v1beta1constants.AnnotationShootCloneFrom, err))
+	}
+
+	if err := c.authorize(ctx, a, sourceNamespace, sourceName); err != nil {
+		return err
+	}
+
+	source, err := c.shootLister.Shoots(sourceNamespace).Get(sourceName)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return admission.NewForbidden(a, fmt.Errorf("shoot %s/%s to clone from does not exist", sourceNamespace, sourceName))
+		}
+		return apierrors.NewInternalError(fmt.Errorf("could not get shoot %s/%s to clone from: %w", sourceNamespace, sourceName, err))
+	}
+
+	spec := &core.ShootSpec{}
+	if err := gardencorev1beta1.Convert_v1beta1_ShootSpec_To_core_ShootSpec(source.Spec.DeepCopy(), spec, nil); err != nil {
+		return apierrors.NewInternalError(err)
+	}
+
+	cloneSpec(spec, &shoot.Spec)
+	shoot.Spec = *spec
+
+	return nil
+}
+
+func parseSource(value, namespace string) (string, string, error) {
+	parts := strings.Split(value, "/")
+
+	switch {
+	case len(parts) == 1 && parts[0] != "":
+		return namespace, parts[0], nil
+	case len(parts) == 2 && parts[0] != "" && parts[1] != "":
+		return parts[0], parts[1], nil
+	default:
+		return "", "", fmt.Errorf("%q must have the format <namespace>/<name> or <name>", value)
+	}
+}
+
+// authorize checks whether the user is allowed to read the Shoot to clone from. Otherwise, the annotation could be
+// used to read the specification of arbitrary Shoots.
+func (c *Cloning) authorize(ctx context.Context, a admission.Attributes, namespace, name string) error {
+	userInfo := a.GetUserInfo()
+
+	decision, _, err := c.authorizer.Authorize(ctx, authorizer.AttributesRecord{
+		User:            userInfo,
+		APIGroup:        core.GroupName,
+		Resource:        "shoots",
+		Namespace:       namespace,
+		Name:            name,
+		Verb:            "get",
+		ResourceRequest: true,
+	})
+	if err != nil {
+		return err
+	}
+	if decision != authorizer.DecisionAllow {
+		return admission.NewForbidden(a, fmt.Errorf("user %q is not allowed to get shoot %s/%s to clone from", userInfo.GetName(), namespace, name))
+	}
+
+	return nil
+}
+
+// cloneSpec adapts the copied specification of the source Shoot for the new Shoot. Fields which are bound to the
+// identity or the namespace of the source Shoot are taken from the new Shoot if set there, otherwise they are reset.
+func cloneSpec(spec, requested *core.ShootSpec) {
+	// The new Shoot is scheduled independently of the source Shoot unless a seed is requested explicitly.
+	spec.SeedName = requested.SeedName
+
+	// The domain of the source Shoot is already in use, hence the new Shoot either gets a default domain or the
+	// requested one.
+	if spec.DNS != nil {
+		spec.DNS.Domain = nil
+	}
+	if requested.DNS != nil && requested.DNS.Domain != nil {
+		if spec.DNS == nil {
+			spec.DNS = &core.DNS{}
+		}
+		spec.DNS.Domain = requested.DNS.Domain
+	}
+
+	// Credentials must be referenced from the namespace of the new Shoot, e.g., when cloning into another project.
+	if requested.SecretBindingName != nil || requested.CredentialsBindingName != nil {
+		spec.SecretBindingName = requested.SecretBindingName
+		spec.CredentialsBindingName = requested.CredentialsBindingName
+	}
+}
@@ -0,0 +1,195 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cloning_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"go.uber.org/mock/gomock"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/apiserver/pkg/authentication/user"
+	"k8s.io/apiserver/pkg/authorization/authorizer"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/externalversions"
+	. "github.com/gardener/gardener/plugin/pkg/shoot/cloning"
+	mockauthorizer "github.com/gardener/gardener/third_party/mock/apiserver/authorization/authorizer"
+)
+
+var _ = Describe("cloning", func() {
+	Describe("#Admit", func() {
+		var (
+			ctx = context.TODO()
+
+			auth                      *mockauthorizer.MockAuthorizer
+			gardenCoreInformerFactory gardencoreinformers.SharedInformerFactory
+			admissionHandler          *Cloning
+
+			userInfo = &user.DefaultInfo{Name: "foo"}
+
+			source *gardencorev1beta1.Shoot
+			shoot  *core.Shoot
+		)
+
+		BeforeEach(func() {
+			ctrl := gomock.NewController(GinkgoT())
+			auth = mockauthorizer.NewMockAuthorizer(ctrl)
+			gardenCoreInformerFactory = gardencoreinformers.NewSharedInformerFactory(nil, 0)
+
+			admissionHandler, _ = New()
+			admissionHandler.AssignReadyFunc(func() bool { return true })
+			admissionHandler.SetAuthorizer(auth)
+			admissionHandler.SetCoreInformerFactory(gardenCoreInformerFactory)
+
+			source = &gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "production",
+					Namespace: "garden-prod",
+				},
+				Spec: gardencorev1beta1.ShootSpec{
+					CloudProfileName:  ptr.To("aws"),
+					Region:            "eu-west-1",
+					SecretBindingName: ptr.To("prod-secret"),
+					SeedName:          ptr.To("seed"),
+					DNS:               &gardencorev1beta1.DNS{Domain: ptr.To("production.prod.example.com")},
+					Kubernetes:        gardencorev1beta1.Kubernetes{Version: "1.31.1"},
+					Provider: gardencorev1beta1.Provider{
+						Type:    "aws",
+						Workers: []gardencorev1beta1.Worker{{Name: "worker", Minimum: 1, Maximum: 3}},
+					},
+				},
+			}
+
+			shoot = &core.Shoot{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "staging",
+					Namespace:   "garden-prod",
+					Annotations: map[string]string{"shoot.gardener.cloud/clone-from": "production"},
+				},
+			}
+		})
+
+		admit := func() error {
+			attrs := admission.NewAttributesRecord(shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+			return admissionHandler.Admit(ctx, attrs, nil)
+		}
+
+		expectAuthorization := func(namespace string, decision authorizer.Decision) {
+			auth.EXPECT().Authorize(ctx, authorizer.AttributesRecord{
+				User:            userInfo,
+				APIGroup:        "core.gardener.cloud",
+				Resource:        "shoots",
+				Namespace:       namespace,
+				Name:            source.Name,
+				Verb:            "get",
+				ResourceRequest: true,
+			}).Return(decision, "", nil)
+		}
+
+		It("should do nothing because the resource is not Shoot", func() {
+			attrs := admission.NewAttributesRecord(nil, nil, core.Kind("Test").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("foos").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+
+			Expect(admissionHandler.Admit(ctx, attrs, nil)).To(Succeed())
+		})
+
+		It("should do nothing because the Shoot does not have the annotation", func() {
+			shoot.Annotations = nil
+			expected := shoot.DeepCopy()
+
+			Expect(admit()).To(Succeed())
+			Expect(shoot).To(Equal(expected))
+		})
+
+		It("should reject an invalid annotation value", func() {
+			shoot.Annotations["shoot.gardener.cloud/clone-from"] = "a/b/c"
+
+			err := admit()
+			Expect(apierrors.IsBadRequest(err)).To(BeTrue())
+			Expect(err).To(MatchError(ContainSubstring("must have the format <namespace>/<name> or <name>")))
+		})
+
+		It("should forbid cloning if the user is not allowed to read the source Shoot", func() {
+			expectAuthorization(source.Namespace, authorizer.DecisionDeny)
+			Expect(gardenCoreInformerFactory.Core().V1beta1().Shoots().Informer().GetStore().Add(source)).To(Succeed())
+
+			err := admit()
+			Expect(apierrors.IsForbidden(err)).To(BeTrue())
+			Expect(err).To(MatchError(ContainSubstring(`user "foo" is not allowed to get shoot garden-prod/production`)))
+		})
+
+		It("should forbid cloning if the source Shoot does not exist", func() {
+			expectAuthorization(source.Namespace, authorizer.DecisionAllow)
+
+			err := admit()
+			Expect(apierrors.IsForbidden(err)).To(BeTrue())
+			Expect(err).To(MatchError(ContainSubstring("shoot garden-prod/production to clone from does not exist")))
+		})
+
+		It("should copy the spec from a Shoot in the same namespace", func() {
+			expectAuthorization(source.Namespace, authorizer.DecisionAllow)
+			Expect(gardenCoreInformerFactory.Core().V1beta1().Shoots().Informer().GetStore().Add(source)).To(Succeed())
+
+			Expect(admit()).To(Succeed())
+			Expect(shoot.Spec).To(Equal(core.ShootSpec{
+				CloudProfileName:  ptr.To("aws"),
+				Region:            "eu-west-1",
+				SecretBindingName: ptr.To("prod-secret"),
+				DNS:               &core.DNS{},
+				Kubernetes:        core.Kubernetes{Version: "1.31.1"},
+				Provider: core.Provider{
+					Type:    "aws",
+					Workers: []core.Worker{{Name: "worker", Minimum: 1, Maximum: 3}},
+				},
+			}))
+			Expect(shoot.Annotations).To(HaveKeyWithValue("shoot.gardener.cloud/clone-from", "production"))
+			Expect(source.Spec.SeedName).To(PointTo(Equal("seed")))
+		})
+
+		It("should copy the spec from a Shoot in another namespace and keep the namespace-specific fields of the request", func() {
+			shoot.Namespace = "garden-staging"
+			shoot.Annotations["shoot.gardener.cloud/clone-from"] = "garden-prod/production"
+			shoot.Spec = core.ShootSpec{
+				CredentialsBindingName: ptr.To("staging-credentials"),
+				SeedName:               ptr.To("other-seed"),
+				DNS:                    &core.DNS{Domain: ptr.To("staging.example.com")},
+				Region:                 "eu-central-1",
+			}
+
+			expectAuthorization(source.Namespace, authorizer.DecisionAllow)
+			Expect(gardenCoreInformerFactory.Core().V1beta1().Shoots().Informer().GetStore().Add(source)).To(Succeed())
+
+			Expect(admit()).To(Succeed())
+			Expect(shoot.Spec.Region).To(Equal("eu-west-1"))
+			Expect(shoot.Spec.SeedName).To(PointTo(Equal("other-seed")))
+			Expect(shoot.Spec.DNS.Domain).To(PointTo(Equal("staging.example.com")))
+			Expect(shoot.Spec.SecretBindingName).To(BeNil())
+			Expect(shoot.Spec.CredentialsBindingName).To(PointTo(Equal("staging-credentials")))
+		})
+	})
+
+	Describe("#ValidateInitialization", func() {
+		It("should return an error if the authorizer is not set", func() {
+			admissionHandler, _ := New()
+			admissionHandler.SetCoreInformerFactory(gardencoreinformers.NewSharedInformerFactory(nil, 0))
+
+			Expect(admissionHandler.ValidateInitialization()).To(MatchError("missing authorizer"))
+		})
+
+		It("should not return an error if everything is set", func() {
+			admissionHandler, _ := New()
+			admissionHandler.SetAuthorizer(mockauthorizer.NewMockAuthorizer(gomock.NewController(GinkgoT())))
+			admissionHandler.SetCoreInformerFactory(gardencoreinformers.NewSharedInformerFactory(nil, 0))
+
+			Expect(admissionHandler.ValidateInitialization()).To(Succeed())
+		})
+	})
+})
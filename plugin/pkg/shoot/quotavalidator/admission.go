@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"slices"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
@@ -35,13 +36,16 @@ import (
 )
 
 var (
-	quotaMetricNames = [6]corev1.ResourceName{
+	quotaMetricNames = [9]corev1.ResourceName{
 		core.QuotaMetricCPU,
 		core.QuotaMetricGPU,
 		core.QuotaMetricMemory,
 		core.QuotaMetricStorageStandard,
 		core.QuotaMetricStoragePremium,
-		core.QuotaMetricLoadbalancer}
+		core.QuotaMetricLoadbalancer,
+		core.QuotaMetricControlPlaneUnits,
+		core.QuotaMetricShoots,
+		core.QuotaMetricNodes}
 )
 
 // Register registers a plugin.
@@ -55,6 +59,7 @@ func Register(plugins *admission.Plugins) {
 type QuotaValidator struct {
 	*admission.Handler
 	shootLister                  gardencorev1beta1listers.ShootLister
+	projectLister                gardencorev1beta1listers.ProjectLister
 	cloudProfileLister           gardencorev1beta1listers.CloudProfileLister
 	namespacedCloudProfileLister gardencorev1beta1listers.NamespacedCloudProfileLister
 	secretBindingLister          gardencorev1beta1listers.SecretBindingLister
@@ -90,6 +95,9 @@ func (q *QuotaValidator) SetCoreInformerFactory(f gardencoreinformers.SharedInfo
 	shootInformer := f.Core().V1beta1().Shoots()
 	q.shootLister = shootInformer.Lister()
 
+	projectInformer := f.Core().V1beta1().Projects()
+	q.projectLister = projectInformer.Lister()
+
 	cloudProfileInformer := f.Core().V1beta1().CloudProfiles()
 	q.cloudProfileLister = cloudProfileInformer.Lister()
 
@@ -102,7 +110,7 @@ func (q *QuotaValidator) SetCoreInformerFactory(f gardencoreinformers.SharedInfo
 	quotaInformer := f.Core().V1beta1().Quotas()
 	q.quotaLister = quotaInformer.Lister()
 
-	readyFuncs = append(readyFuncs, shootInformer.Informer().HasSynced, cloudProfileInformer.Informer().HasSynced, secretBindingInformer.Informer().HasSynced, quotaInformer.Informer().HasSynced)
+	readyFuncs = append(readyFuncs, shootInformer.Informer().HasSynced, projectInformer.Informer().HasSynced, cloudProfileInformer.Informer().HasSynced, secretBindingInformer.Informer().HasSynced, quotaInformer.Informer().HasSynced)
 }
 
 // SetSecurityInformerFactory gets Lister from SharedInformerFactory.
@@ -118,6 +126,9 @@ func (q *QuotaValidator) ValidateInitialization() error {
 	if q.shootLister == nil {
 		return errors.New("missing shoot lister")
 	}
+	if q.projectLister == nil {
+		return errors.New("missing project lister")
+	}
 	if q.cloudProfileLister == nil {
 		return errors.New("missing cloudProfile lister")
 	}
@@ -210,13 +221,29 @@ func (q *QuotaValidator) Validate(_ context.Context, a admission.Attributes, _ a
 		quotas = credentialsBindings.Quotas
 	}
 
+	var projectQuotas []corev1.ObjectReference
+	project, err := utils.ProjectForNamespaceFromLister(q.projectLister, shoot.Namespace)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return apierrors.NewInternalError(err)
+	}
+	if project != nil {
+		projectQuotas = project.Spec.Quotas
+	}
+
 	// Quotas are cumulative, means each quota must be not exceeded that the admission pass.
-	for _, quotaRef := range quotas {
+	for i, quotaRef := range slices.Concat(quotas, projectQuotas) {
 		quota, err := q.quotaLister.Quotas(quotaRef.Namespace).Get(quotaRef.Name)
 		if err != nil {
 			return apierrors.NewInternalError(err)
 		}
 
+		// Quotas referenced by the project account for all shoots of the project, quotas referenced by bindings only
+		// account for the shoots using a binding which references the same quota.
+		findShoots := q.findShootsReferQuota
+		if i >= len(quotas) {
+			findShoots = q.findShootsOfProject
+		}
+
 		// Get the max clusterLifeTime
 		if checkLifetime && quota.Spec.ClusterLifetimeDays != nil {
 			if maxShootLifetime == nil {
@@ -228,7 +255,7 @@ func (q *QuotaValidator) Validate(_ context.Context, a admission.Attributes, _ a
 		}
 
 		if checkQuota {
-			exceededMetrics, err := q.isQuotaExceeded(*shoot, *quota)
+			exceededMetrics, err := q.isQuotaExceeded(*shoot, *quota, findShoots)
 			if err != nil {
 				return apierrors.NewInternalError(err)
 			}
@@ -263,8 +290,8 @@ func (q *QuotaValidator) Validate(_ context.Context, a admission.Attributes, _ a
 	return nil
 }
 
-func (q *QuotaValidator) isQuotaExceeded(shoot core.Shoot, quota gardencorev1beta1.Quota) (*[]corev1.ResourceName, error) {
-	allocatedResources, err := q.determineAllocatedResources(quota, shoot)
+func (q *QuotaValidator) isQuotaExceeded(shoot core.Shoot, quota gardencorev1beta1.Quota, findShoots shootFinder) (*[]corev1.ResourceName, error) {
+	allocatedResources, err := q.determineAllocatedResources(quota, shoot, findShoots)
 	if err != nil {
 		return nil, err
 	}
@@ -288,8 +315,11 @@ func (q *QuotaValidator) isQuotaExceeded(shoot core.Shoot, quota gardencorev1bet
 	return nil, nil
 }
 
-func (q *QuotaValidator) determineAllocatedResources(quota gardencorev1beta1.Quota, shoot core.Shoot) (corev1.ResourceList, error) {
-	shoots, err := q.findShootsReferQuota(quota, shoot)
+// shootFinder returns the shoots (except for the given one) which allocate resources of the given quota.
+type shootFinder func(quota gardencorev1beta1.Quota, shoot core.Shoot) ([]core.Shoot, error)
+
+func (q *QuotaValidator) determineAllocatedResources(quota gardencorev1beta1.Quota, shoot core.Shoot, findShoots shootFinder) (corev1.ResourceList, error) {
+	shoots, err := findShoots(quota, shoot)
 	if err != nil {
 		return nil, err
 	}
@@ -391,6 +421,27 @@ func (q *QuotaValidator) findShootsReferQuota(quota gardencorev1beta1.Quota, sho
 	return shootsReferQuota, nil
 }
 
+func (q *QuotaValidator) findShootsOfProject(_ gardencorev1beta1.Quota, shoot core.Shoot) ([]core.Shoot, error) {
+	shoots, err := q.shootLister.Shoots(shoot.Namespace).List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	var shootsOfProject []core.Shoot
+	for _, s := range shoots {
+		if shoot.Name == s.Name {
+			continue
+		}
+
+		coreShoot := &core.Shoot{}
+		if err := gardencorev1beta1.Convert_v1beta1_Shoot_To_core_Shoot(s, coreShoot, nil); err != nil {
+			return nil, apierrors.NewInternalError(err)
+		}
+		shootsOfProject = append(shootsOfProject, *coreShoot)
+	}
+	return shootsOfProject, nil
+}
+
 func (q *QuotaValidator) determineRequiredResources(allocatedResources corev1.ResourceList, shoot core.Shoot) (corev1.ResourceList, error) {
 	shootResources, err := q.getShootResources(shoot)
 	if err != nil {
@@ -412,10 +463,11 @@ func (q *QuotaValidator) getShootResources(shoot core.Shoot) (corev1.ResourceLis
 
 	var (
 		countLB      int64 = 1
-		resources          = make(corev1.ResourceList)
-		workers            = getShootWorkerResources(&shoot, cloudProfileSpec)
-		machineTypes       = cloudProfileSpec.MachineTypes
-		volumeTypes        = cloudProfileSpec.VolumeTypes
+		countNodes   int64
+		resources    = make(corev1.ResourceList)
+		workers      = getShootWorkerResources(&shoot, cloudProfileSpec)
+		machineTypes = cloudProfileSpec.MachineTypes
+		volumeTypes  = cloudProfileSpec.VolumeTypes
 	)
 
 	for _, worker := range workers {
@@ -460,6 +512,7 @@ func (q *QuotaValidator) getShootResources(shoot core.Shoot) (corev1.ResourceLis
 		resources[core.QuotaMetricCPU] = sumQuantity(resources[core.QuotaMetricCPU], multiplyQuantity(machineType.CPU, worker.Maximum))
 		resources[core.QuotaMetricGPU] = sumQuantity(resources[core.QuotaMetricGPU], multiplyQuantity(machineType.GPU, worker.Maximum))
 		resources[core.QuotaMetricMemory] = sumQuantity(resources[core.QuotaMetricMemory], multiplyQuantity(machineType.Memory, worker.Maximum))
+		countNodes += int64(worker.Maximum)
 
 		size, _ := resource.ParseQuantity("0Gi")
 		if worker.Volume != nil {
@@ -483,6 +536,9 @@ func (q *QuotaValidator) getShootResources(shoot core.Shoot) (corev1.ResourceLis
 		countLB++
 	}
 	resources[core.QuotaMetricLoadbalancer] = *resource.NewQuantity(countLB, resource.DecimalSI)
+	resources[core.QuotaMetricControlPlaneUnits] = *resource.NewQuantity(helper.ShootSizeClassWeight(helper.GetShootSizeClass(&shoot)), resource.DecimalSI)
+	resources[core.QuotaMetricShoots] = *resource.NewQuantity(1, resource.DecimalSI)
+	resources[core.QuotaMetricNodes] = *resource.NewQuantity(countNodes, resource.DecimalSI)
 
 	return resources, nil
 }
@@ -339,6 +339,17 @@ var _ = Describe("quotavalidator", func() {
 				Expect(err).To(HaveOccurred())
 			})
 
+			It("should fail because the size class of the shoot exhausts the control plane units", func() {
+				quotaProject.Spec.Metrics[core.QuotaMetricControlPlaneUnits] = resource.MustParse("1")
+				Expect(coreInformerFactory.Core().V1beta1().Quotas().Informer().GetStore().Add(&quotaProject)).To(Succeed())
+
+				shoot.Status.SizeClass = ptr.To(core.ShootSizeClassMedium)
+				attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+				err := admissionHandler.Validate(context.TODO(), attrs, nil)
+				Expect(err).To(MatchError(ContainSubstring("controlplaneunits")))
+			})
+
 			It("should pass because can update non worker property although quota is exceeded", func() {
 				oldShoot = *shoot.DeepCopy()
 				quotaProject.Spec.Metrics[core.QuotaMetricCPU] = resource.MustParse("1")
@@ -381,6 +392,93 @@ var _ = Describe("quotavalidator", func() {
 			})
 		})
 
+		Context("tests for Shoots in Projects, which have at least one Quota referenced", func() {
+			var (
+				project      *gardencorev1beta1.Project
+				projectQuota *gardencorev1beta1.Quota
+			)
+
+			BeforeEach(func() {
+				secretBinding.Quotas = nil
+				Expect(coreInformerFactory.Core().V1beta1().SecretBindings().Informer().GetStore().Add(&secretBinding)).To(Succeed())
+
+				projectQuota = &gardencorev1beta1.Quota{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: trialNamespace,
+						Name:      "project-wide-quota",
+					},
+					Spec: gardencorev1beta1.QuotaSpec{
+						Scope: corev1.ObjectReference{
+							APIVersion: "core.gardener.cloud/v1beta1",
+							Kind:       "Project",
+						},
+						Metrics: corev1.ResourceList{
+							core.QuotaMetricShoots: resource.MustParse("1"),
+							core.QuotaMetricNodes:  resource.MustParse("1"),
+						},
+					},
+				}
+				Expect(coreInformerFactory.Core().V1beta1().Quotas().Informer().GetStore().Add(projectQuota)).To(Succeed())
+
+				project = &gardencorev1beta1.Project{
+					ObjectMeta: metav1.ObjectMeta{Name: "test"},
+					Spec: gardencorev1beta1.ProjectSpec{
+						Namespace: ptr.To(namespace),
+						Quotas:    []corev1.ObjectReference{{Namespace: trialNamespace, Name: "project-wide-quota"}},
+					},
+				}
+				Expect(coreInformerFactory.Core().V1beta1().Projects().Informer().GetStore().Add(project)).To(Succeed())
+			})
+
+			It("should pass because all quotas limits are sufficient", func() {
+				attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+				Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(Succeed())
+			})
+
+			It("should fail because the number of nodes is exceeded", func() {
+				shoot.Spec.Provider.Workers = workersBase2
+				attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+				Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(MatchError(ContainSubstring("nodes")))
+			})
+
+			It("should fail because other shoots of the project exhaust the number of shoots", func() {
+				shoot2 := *versionedShootBase.DeepCopy()
+				shoot2.Name = "test-shoot-2"
+				shoot2.Spec.SecretBindingName = ptr.To("other-binding")
+				shoot2.Spec.Provider.Workers[0].Maximum = 0
+				Expect(coreInformerFactory.Core().V1beta1().Shoots().Informer().GetStore().Add(&shoot2)).To(Succeed())
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+				err := admissionHandler.Validate(context.TODO(), attrs, nil)
+				Expect(err).To(MatchError(ContainSubstring("shoots")))
+				Expect(err).NotTo(MatchError(ContainSubstring("nodes")))
+			})
+
+			It("should pass because shoots of other projects are not considered", func() {
+				shoot2 := *versionedShootBase.DeepCopy()
+				shoot2.Name = "test-shoot-2"
+				shoot2.Namespace = "other"
+				Expect(coreInformerFactory.Core().V1beta1().Shoots().Informer().GetStore().Add(&shoot2)).To(Succeed())
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+				Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(Succeed())
+			})
+
+			It("should pass because updating the shoot itself is not counted twice", func() {
+				Expect(coreInformerFactory.Core().V1beta1().Shoots().Informer().GetStore().Add(versionedShootBase.DeepCopy())).To(Succeed())
+
+				oldShoot = *shoot.DeepCopy()
+				oldShoot.Spec.Provider.Workers[0].Machine.Type = machineTypeName2
+				attrs := admission.NewAttributesRecord(&shoot, &oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, nil)
+
+				Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(Succeed())
+			})
+		})
+
 		Context("tests for Quota validation corner cases", func() {
 			It("should pass because shoot is intended to get deleted", func() {
 				var now metav1.Time
@@ -0,0 +1,157 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shootclass
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apiserver/pkg/admission"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	admissioninitializer "github.com/gardener/gardener/pkg/apiserver/admission/initializer"
+	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/externalversions"
+	gardencorev1beta1listers "github.com/gardener/gardener/pkg/client/core/listers/core/v1beta1"
+	plugin "github.com/gardener/gardener/plugin/pkg"
+)
+
+// Register registers a plugin.
+func Register(plugins *admission.Plugins) {
+	plugins.Register(plugin.PluginNameShootClass, func(_ io.Reader) (admission.Interface, error) {
+		return New()
+	})
+}
+
+// ShootClass contains listers and admission handler.
+type ShootClass struct {
+	*admission.Handler
+
+	shootClassLister gardencorev1beta1listers.ShootClassLister
+	readyFunc        admission.ReadyFunc
+}
+
+var (
+	_ = admissioninitializer.WantsCoreInformerFactory(&ShootClass{})
+
+	readyFuncs []admission.ReadyFunc
+)
+
+// New creates a new ShootClass admission plugin.
+func New() (*ShootClass, error) {
+	return &ShootClass{
+		Handler: admission.NewHandler(admission.Create),
+	}, nil
+}
+
+// AssignReadyFunc assigns the ready function to the admission handler.
+func (s *ShootClass) AssignReadyFunc(f admission.ReadyFunc) {
+	s.readyFunc = f
+	s.SetReadyFunc(f)
+}
+
+// SetCoreInformerFactory sets the external garden core informer factory.
+func (s *ShootClass) SetCoreInformerFactory(f gardencoreinformers.SharedInformerFactory) {
+	shootClassInformer := f.Core().V1beta1().ShootClasses()
+	s.shootClassLister = shootClassInformer.Lister()
+
+	readyFuncs = append(readyFuncs, shootClassInformer.Informer().HasSynced)
+}
+
+func (s *ShootClass) waitUntilReady(attrs admission.Attributes) error {
+	// Wait until the caches have been synced
+	if s.readyFunc == nil {
+		s.AssignReadyFunc(func() bool {
+			for _, readyFunc := range readyFuncs {
+				if !readyFunc() {
+					return false
+				}
+			}
+			return true
+		})
+	}
+
+	if !s.WaitForReady() {
+		return admission.NewForbidden(attrs, errors.New("not yet ready to handle request"))
+	}
+
+	return nil
+}
+
+// ValidateInitialization checks whether the plugin was correctly initialized.
+func (s *ShootClass) ValidateInitialization() error {
+	if s.shootClassLister == nil {
+		return errors.New("missing ShootClass lister")
+	}
+	return nil
+}
+
+var _ admission.MutationInterface = &ShootClass{}
+
+// Admit applies the settings of the ShootClass referenced in newly created Shoots. Settings specified in the Shoot
+// itself take precedence over the ones of the ShootClass.
+func (s *ShootClass) Admit(_ context.Context, a admission.Attributes, _ admission.ObjectInterfaces) error {
+	if err := s.waitUntilReady(a); err != nil {
+		return fmt.Errorf("err while waiting for ready %w", err)
+	}
+
+	if a.GetKind().GroupKind() != core.Kind("Shoot") {
+		return nil
+	}
+
+	// Ignore any updates to shoot subresources.
+	if a.GetSubresource() != "" {
+		return nil
+	}
+
+	shoot, ok := a.GetObject().(*core.Shoot)
+	if !ok {
+		return apierrors.NewBadRequest("could not convert resource into Shoot object")
+	}
+
+	if shoot.Spec.ShootClassName == nil {
+		return nil
+	}
+
+	shootClass, err := s.shootClassLister.Get(*shoot.Spec.ShootClassName)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return admission.NewForbidden(a, fmt.Errorf("referenced shoot class %q does not exist", *shoot.Spec.ShootClassName))
+		}
+		return apierrors.NewInternalError(fmt.Errorf("could not get shoot class %q: %w", *shoot.Spec.ShootClassName, err))
+	}
+
+	if providerType := shootClass.Spec.ProviderType; providerType != nil && *providerType != shoot.Spec.Provider.Type {
+		return admission.NewForbidden(a, fmt.Errorf("shoot class %q can only be used for shoots of provider type %q", shootClass.Name, *providerType))
+	}
+
+	spec := &core.ShootClassSpec{}
+	if err := gardencorev1beta1.Convert_v1beta1_ShootClassSpec_To_core_ShootClassSpec(shootClass.Spec.DeepCopy(), spec, nil); err != nil {
+		return apierrors.NewInternalError(err)
+	}
+
+	applyShootClassSpec(spec, &shoot.Spec)
+
+	return nil
+}
+
+// applyShootClassSpec applies the settings of the ShootClass to the Shoot specification. Each setting is only applied if
+// the Shoot does not specify it on its own, i.e., the settings are never merged.
+func applyShootClassSpec(shootClassSpec *core.ShootClassSpec, shootSpec *core.ShootSpec) {
+	if len(shootSpec.Provider.Workers) == 0 {
+		shootSpec.Provider.Workers = shootClassSpec.Workers
+	}
+
+	if shootSpec.ControlPlane == nil {
+		shootSpec.ControlPlane = shootClassSpec.ControlPlane
+	}
+
+	if shootSpec.Hibernation == nil {
+		shootSpec.Hibernation = shootClassSpec.Hibernation
+	}
+}
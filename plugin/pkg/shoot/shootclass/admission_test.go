@@ -0,0 +1,152 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shootclass_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/admission"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/externalversions"
+	. "github.com/gardener/gardener/plugin/pkg/shoot/shootclass"
+)
+
+var _ = Describe("shootclass", func() {
+	Describe("#Admit", func() {
+		var (
+			ctx context.Context
+
+			shoot      *core.Shoot
+			shootClass *gardencorev1beta1.ShootClass
+
+			admissionHandler          *ShootClass
+			gardenCoreInformerFactory gardencoreinformers.SharedInformerFactory
+		)
+
+		BeforeEach(func() {
+			ctx = context.Background()
+
+			gardenCoreInformerFactory = gardencoreinformers.NewSharedInformerFactory(nil, 0)
+
+			admissionHandler, _ = New()
+			admissionHandler.AssignReadyFunc(func() bool { return true })
+			admissionHandler.SetCoreInformerFactory(gardenCoreInformerFactory)
+
+			shoot = &core.Shoot{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test",
+					Namespace: "garden-dev",
+				},
+				Spec: core.ShootSpec{
+					ShootClassName: ptr.To("small"),
+					Provider:       core.Provider{Type: "local"},
+				},
+			}
+
+			shootClass = &gardencorev1beta1.ShootClass{
+				ObjectMeta: metav1.ObjectMeta{Name: "small"},
+				Spec: gardencorev1beta1.ShootClassSpec{
+					ProviderType: ptr.To("local"),
+					Workers: []gardencorev1beta1.Worker{{
+						Name:    "worker",
+						Machine: gardencorev1beta1.Machine{Type: "local"},
+						Minimum: 1,
+						Maximum: 2,
+					}},
+					ControlPlane: &gardencorev1beta1.ControlPlane{
+						HighAvailability: &gardencorev1beta1.HighAvailability{FailureTolerance: gardencorev1beta1.FailureTolerance{Type: gardencorev1beta1.FailureToleranceTypeZone}},
+					},
+					Hibernation: &gardencorev1beta1.Hibernation{
+						Schedules: []gardencorev1beta1.HibernationSchedule{{Start: ptr.To("0 20 * * *")}},
+					},
+				},
+			}
+		})
+
+		admit := func() error {
+			attrs := admission.NewAttributesRecord(shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+			return admissionHandler.Admit(ctx, attrs, nil)
+		}
+
+		It("should do nothing because the resource is not Shoot", func() {
+			attrs := admission.NewAttributesRecord(nil, nil, core.Kind("Test").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("foos").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+			Expect(admissionHandler.Admit(ctx, attrs, nil)).To(Succeed())
+		})
+
+		It("should do nothing because the Shoot does not reference a ShootClass", func() {
+			shoot.Spec.ShootClassName = nil
+			expectedShoot := shoot.DeepCopy()
+
+			Expect(admit()).To(Succeed())
+			Expect(shoot).To(Equal(expectedShoot))
+		})
+
+		It("should forbid the request because the referenced ShootClass does not exist", func() {
+			err := admit()
+
+			Expect(apierrors.IsForbidden(err)).To(BeTrue())
+			Expect(err).To(MatchError(ContainSubstring(`referenced shoot class "small" does not exist`)))
+		})
+
+		It("should forbid the request because the provider type does not match", func() {
+			shoot.Spec.Provider.Type = "aws"
+			Expect(gardenCoreInformerFactory.Core().V1beta1().ShootClasses().Informer().GetStore().Add(shootClass)).To(Succeed())
+
+			err := admit()
+
+			Expect(apierrors.IsForbidden(err)).To(BeTrue())
+			Expect(err).To(MatchError(ContainSubstring(`shoot class "small" can only be used for shoots of provider type "local"`)))
+		})
+
+		It("should apply the settings of the ShootClass", func() {
+			Expect(gardenCoreInformerFactory.Core().V1beta1().ShootClasses().Informer().GetStore().Add(shootClass)).To(Succeed())
+
+			Expect(admit()).To(Succeed())
+			Expect(shoot.Spec.Provider.Workers).To(ConsistOf(MatchFields(IgnoreExtras, Fields{
+				"Name":    Equal("worker"),
+				"Machine": MatchFields(IgnoreExtras, Fields{"Type": Equal("local")}),
+				"Minimum": Equal(int32(1)),
+				"Maximum": Equal(int32(2)),
+			})))
+			Expect(shoot.Spec.ControlPlane).To(Equal(&core.ControlPlane{
+				HighAvailability: &core.HighAvailability{FailureTolerance: core.FailureTolerance{Type: core.FailureToleranceTypeZone}},
+			}))
+			Expect(shoot.Spec.Hibernation).To(Equal(&core.Hibernation{
+				Schedules: []core.HibernationSchedule{{Start: ptr.To("0 20 * * *")}},
+			}))
+		})
+
+		It("should apply the settings of a ShootClass without provider type", func() {
+			shootClass.Spec.ProviderType = nil
+			shootClass.Spec.Workers = nil
+			Expect(gardenCoreInformerFactory.Core().V1beta1().ShootClasses().Informer().GetStore().Add(shootClass)).To(Succeed())
+
+			Expect(admit()).To(Succeed())
+			Expect(shoot.Spec.Provider.Workers).To(BeEmpty())
+			Expect(shoot.Spec.ControlPlane).NotTo(BeNil())
+			Expect(shoot.Spec.Hibernation).NotTo(BeNil())
+		})
+
+		It("should not overwrite the settings specified in the Shoot", func() {
+			shoot.Spec.Provider.Workers = []core.Worker{{Name: "custom"}}
+			shoot.Spec.ControlPlane = &core.ControlPlane{}
+			shoot.Spec.Hibernation = &core.Hibernation{Enabled: ptr.To(false)}
+			expectedShoot := shoot.DeepCopy()
+			Expect(gardenCoreInformerFactory.Core().V1beta1().ShootClasses().Informer().GetStore().Add(shootClass)).To(Succeed())
+
+			Expect(admit()).To(Succeed())
+			Expect(shoot).To(Equal(expectedShoot))
+		})
+	})
+})
@@ -47,6 +47,7 @@ import (
 	securityv1alpha1listers "github.com/gardener/gardener/pkg/client/security/listers/security/v1alpha1"
 	"github.com/gardener/gardener/pkg/controllerutils"
 	cidrvalidation "github.com/gardener/gardener/pkg/utils/validation/cidr"
+	"github.com/gardener/gardener/pkg/utils/validation/jsonschema"
 	versionutils "github.com/gardener/gardener/pkg/utils/version"
 	plugin "github.com/gardener/gardener/plugin/pkg"
 	admissionutils "github.com/gardener/gardener/plugin/pkg/utils"
@@ -333,9 +334,16 @@ func (v *ValidateShoot) Admit(ctx context.Context, a admission.Attributes, _ adm
 	allErrs = append(allErrs, validationContext.validateShootNetworks(a, helper.IsWorkerless(shoot))...)
 	allErrs = append(allErrs, validationContext.validateKubernetes(a)...)
 	allErrs = append(allErrs, validationContext.validateRegion()...)
+	allErrs = append(allErrs, validationContext.validateDataResidency()...)
 	allErrs = append(allErrs, validationContext.validateAccessRestrictions()...)
+	allErrs = append(allErrs, validationContext.validateETCDBackup()...)
+	allErrs = append(allErrs, validationContext.validateKubeAPIServerEndpointPort()...)
+	allErrs = append(allErrs, validationContext.validateKubeAPIServerManagedCertificate()...)
 	allErrs = append(allErrs, validationContext.validateProvider(a)...)
 	allErrs = append(allErrs, validationContext.validateAdmissionPlugins(a, v.secretLister)...)
+	allErrs = append(allErrs, validationContext.validateAuditWebhook(a, v.secretLister)...)
+	allErrs = append(allErrs, validationContext.validateManagedAddons()...)
+	allErrs = append(allErrs, validationContext.validateShootConsistency()...)
 
 	// Skip the validation if the operation is admission.Delete or the spec hasn't changed.
 	if a.GetOperation() != admission.Delete && !reflect.DeepEqual(validationContext.shoot.Spec, validationContext.oldShoot.Spec) {
@@ -449,8 +457,9 @@ func (c *validationContext) validateScheduling(ctx context.Context, a admission.
 		if c.seed.Spec.Taints != nil {
 			for _, taint := range c.seed.Spec.Taints {
 				seedTaints = append(seedTaints, core.SeedTaint{
-					Key:   taint.Key,
-					Value: taint.Value,
+					Key:    taint.Key,
+					Value:  taint.Value,
+					Effect: (*core.SeedTaintEffect)(taint.Effect),
 				})
 			}
 		}
@@ -472,6 +481,10 @@ func (c *validationContext) validateScheduling(ctx context.Context, a admission.
 			return admission.NewForbidden(a, fmt.Errorf("forbidden to use a seed which doesn't support the access restrictions of the shoot"))
 		}
 
+		if !helper.DataResidencyIsSatisfied(toCoreRegionMetadata(v1beta1helper.GetSeedRegionMetadata(c.seed, c.cloudProfileSpec)), c.shoot.Spec.DataResidency) {
+			return admission.NewForbidden(a, fmt.Errorf("forbidden to use a seed whose region does not satisfy the data residency constraints of the shoot"))
+		}
+
 		if allocatableShoots, ok := c.seed.Status.Allocatable[core.ResourceShoots]; ok {
 			scheduledShoots, err := getNumberOfShootsOnSeed(shootLister, c.seed.Name)
 			if err != nil {
@@ -809,9 +822,8 @@ func (c *validationContext) addMetadataAnnotations(a admission.Attributes) {
 
 func (c *validationContext) validateAdmissionPlugins(a admission.Attributes, secretLister kubecorev1listers.SecretLister) field.ErrorList {
 	var (
-		allErrs           field.ErrorList
-		referencedSecrets = sets.New[string]()
-		path              = field.NewPath("spec", "kubernetes", "kubeAPIServer", "admissionPlugins")
+		allErrs field.ErrorList
+		path    = field.NewPath("spec", "kubernetes", "kubeAPIServer", "admissionPlugins")
 	)
 
 	if a.GetOperation() == admission.Delete {
@@ -822,12 +834,7 @@ func (c *validationContext) validateAdmissionPlugins(a admission.Attributes, sec
 		return nil
 	}
 
-	for _, referencedResource := range c.shoot.Spec.Resources {
-		if referencedResource.ResourceRef.APIVersion == "v1" && referencedResource.ResourceRef.Kind == "Secret" {
-			referencedSecrets.Insert(referencedResource.ResourceRef.Name)
-		}
-	}
-
+	referencedSecrets := c.referencedSecretNames()
 	for i, admissionPlugin := range c.shoot.Spec.Kubernetes.KubeAPIServer.AdmissionPlugins {
 		if admissionPlugin.KubeconfigSecretName != nil {
 			if !referencedSecrets.Has(*admissionPlugin.KubeconfigSecretName) {
@@ -876,6 +883,40 @@ func (c *validationContext) validateKubeAPIServerOIDCConfig(a admission.Attribut
 	return allErrs
 }
 
+func (c *validationContext) validateAuditWebhook(a admission.Attributes, secretLister kubecorev1listers.SecretLister) field.ErrorList {
+	path := field.NewPath("spec", "kubernetes", "kubeAPIServer", "auditConfig", "webhook", "kubeconfigSecretName")
+
+	if a.GetOperation() == admission.Delete {
+		return nil
+	}
+
+	if c.shoot.Spec.Kubernetes.KubeAPIServer == nil || c.shoot.Spec.Kubernetes.KubeAPIServer.AuditConfig == nil || c.shoot.Spec.Kubernetes.KubeAPIServer.AuditConfig.Webhook == nil {
+		return nil
+	}
+
+	secretName := c.shoot.Spec.Kubernetes.KubeAPIServer.AuditConfig.Webhook.KubeconfigSecretName
+	if !c.referencedSecretNames().Has(secretName) {
+		return field.ErrorList{field.Invalid(path, secretName, "secret should be referenced in shoot .spec.resources")}
+	}
+	if err := c.validateReferencedSecret(secretLister, secretName, c.shoot.Namespace, path); err != nil {
+		return field.ErrorList{err}
+	}
+
+	return nil
+}
+
+func (c *validationContext) referencedSecretNames() sets.Set[string] {
+	referencedSecrets := sets.New[string]()
+
+	for _, referencedResource := range c.shoot.Spec.Resources {
+		if referencedResource.ResourceRef.APIVersion == "v1" && referencedResource.ResourceRef.Kind == "Secret" {
+			referencedSecrets.Insert(referencedResource.ResourceRef.Name)
+		}
+	}
+
+	return referencedSecrets
+}
+
 func (c *validationContext) validateReferencedSecret(secretLister kubecorev1listers.SecretLister, secretName, namespace string, fldPath *field.Path) *field.Error {
 	var (
 		secret *corev1.Secret
@@ -1105,16 +1146,19 @@ func (c *validationContext) validateProvider(a admission.Attributes) field.Error
 			allErrs = append(allErrs, field.NotSupported(idxPath.Child("machine", "architecture"), *worker.Machine.Architecture, v1beta1constants.ValidArchitectures))
 		} else {
 			var (
-				isMachinePresentInCloudprofile, architectureSupported, availableInAllZones, isUsableMachine, supportedMachineTypes = validateMachineTypes(c.cloudProfileSpec.MachineTypes, worker.Machine, oldWorker.Machine, c.cloudProfileSpec.Regions, c.shoot.Spec.Region, worker.Zones)
-				detail                                                                                                             = fmt.Sprintf("machine type %q ", worker.Machine.Type)
+				isMachinePresentInCloudprofile, architectureSupported, availableInRegion, availableInAllZones, isUsableMachine, supportedMachineTypes = validateMachineTypes(c.cloudProfileSpec.MachineTypes, worker.Machine, oldWorker.Machine, c.cloudProfileSpec.Regions, c.shoot.Spec.Region, worker.Zones)
+				detail                                                                                                                                = fmt.Sprintf("machine type %q ", worker.Machine.Type)
 			)
 
 			if !isMachinePresentInCloudprofile {
 				allErrs = append(allErrs, field.NotSupported(idxPath.Child("machine", "type"), worker.Machine.Type, supportedMachineTypes))
-			} else if !architectureSupported || !availableInAllZones || !isUsableMachine {
+			} else if !architectureSupported || !availableInRegion || !availableInAllZones || !isUsableMachine {
 				if !isUsableMachine {
 					detail += "is unusable, "
 				}
+				if !availableInRegion {
+					detail += fmt.Sprintf("is unavailable in region %q or at least one of the selected zones, ", c.shoot.Spec.Region)
+				}
 				if !availableInAllZones {
 					detail += "is unavailable in at least one zone, "
 				}
@@ -1150,14 +1194,17 @@ func (c *validationContext) validateProvider(a admission.Attributes) field.Error
 				}
 			}
 		}
-		isVolumePresentInCloudprofile, availableInAllZones, isUsableVolume, supportedVolumeTypes := validateVolumeTypes(c.cloudProfileSpec.VolumeTypes, worker.Volume, oldWorker.Volume, c.cloudProfileSpec.Regions, c.shoot.Spec.Region, worker.Zones)
+		isVolumePresentInCloudprofile, availableInRegion, availableInAllZones, isUsableVolume, supportedVolumeTypes := validateVolumeTypes(c.cloudProfileSpec.VolumeTypes, worker.Volume, oldWorker.Volume, c.cloudProfileSpec.Regions, c.shoot.Spec.Region, worker.Zones)
 		if !isVolumePresentInCloudprofile {
 			allErrs = append(allErrs, field.NotSupported(idxPath.Child("volume", "type"), ptr.Deref(worker.Volume.Type, ""), supportedVolumeTypes))
-		} else if !availableInAllZones || !isUsableVolume {
+		} else if !availableInRegion || !availableInAllZones || !isUsableVolume {
 			detail := fmt.Sprintf("volume type %q ", *worker.Volume.Type)
 			if !isUsableVolume {
 				detail += "is unusable, "
 			}
+			if !availableInRegion {
+				detail += fmt.Sprintf("is unavailable in region %q or at least one of the selected zones, ", c.shoot.Spec.Region)
+			}
 			if !availableInAllZones {
 				detail += "is unavailable in at least one zone, "
 			}
@@ -1166,6 +1213,13 @@ func (c *validationContext) validateProvider(a admission.Attributes) field.Error
 		if ok, minSize := validateVolumeSize(c.cloudProfileSpec.VolumeTypes, c.cloudProfileSpec.MachineTypes, worker.Machine.Type, worker.Volume); !ok {
 			allErrs = append(allErrs, field.Invalid(idxPath.Child("volume", "size"), worker.Volume.VolumeSize, fmt.Sprintf("size must be >= %s", minSize)))
 		}
+		if worker.Volume != nil {
+			allErrs = append(allErrs, validateVolumePerformance(c.cloudProfileSpec.VolumeTypes, worker.Volume.Type, worker.Volume.IOPS, worker.Volume.Throughput, idxPath.Child("volume"))...)
+		}
+		for j, dataVolume := range worker.DataVolumes {
+			allErrs = append(allErrs, validateVolumePerformance(c.cloudProfileSpec.VolumeTypes, dataVolume.Type, dataVolume.IOPS, dataVolume.Throughput, idxPath.Child("dataVolumes").Index(j))...)
+		}
+		allErrs = append(allErrs, validateCapacityType(c.cloudProfileSpec.MachineTypes, worker, oldWorker, idxPath.Child("capacityType"))...)
 		if worker.Kubernetes != nil {
 			if worker.Kubernetes.Kubelet != nil {
 				kubeletConfig = worker.Kubernetes.Kubelet
@@ -1292,6 +1346,38 @@ func validateVolumeSize(volumeTypeConstraints []gardencorev1beta1.VolumeType, ma
 	return true, ""
 }
 
+func validateVolumePerformance(volumeTypeConstraints []gardencorev1beta1.VolumeType, volumeType *string, iops, throughput *int64, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if volumeType == nil || (iops == nil && throughput == nil) {
+		return allErrs
+	}
+
+	for _, volumeTypeConstraint := range volumeTypeConstraints {
+		if volumeTypeConstraint.Name != *volumeType {
+			continue
+		}
+
+		if iops != nil {
+			if volumeTypeConstraint.MaxIOPS == nil {
+				allErrs = append(allErrs, field.Forbidden(fldPath.Child("iops"), fmt.Sprintf("volume type %q does not support configuring iops", *volumeType)))
+			} else if *iops > *volumeTypeConstraint.MaxIOPS {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("iops"), *iops, fmt.Sprintf("iops must be <= %d for volume type %q", *volumeTypeConstraint.MaxIOPS, *volumeType)))
+			}
+		}
+
+		if throughput != nil {
+			if volumeTypeConstraint.MaxThroughput == nil {
+				allErrs = append(allErrs, field.Forbidden(fldPath.Child("throughput"), fmt.Sprintf("volume type %q does not support configuring throughput", *volumeType)))
+			} else if *throughput > *volumeTypeConstraint.MaxThroughput {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("throughput"), *throughput, fmt.Sprintf("throughput must be <= %d for volume type %q", *volumeTypeConstraint.MaxThroughput, *volumeType)))
+			}
+		}
+	}
+
+	return allErrs
+}
+
 func (c *validationContext) validateDNSDomainUniqueness(shootLister gardencorev1beta1listers.ShootLister) (field.ErrorList, error) {
 	var (
 		allErrs field.ErrorList
@@ -1462,14 +1548,15 @@ func validateKubernetesVersionConstraints(a admission.Attributes, constraints []
 	return allErrs
 }
 
-func validateMachineTypes(constraints []gardencorev1beta1.MachineType, machine, oldMachine core.Machine, regions []gardencorev1beta1.Region, region string, zones []string) (bool, bool, bool, bool, []string) {
+func validateMachineTypes(constraints []gardencorev1beta1.MachineType, machine, oldMachine core.Machine, regions []gardencorev1beta1.Region, region string, zones []string) (bool, bool, bool, bool, bool, []string) {
 	if machine.Type == oldMachine.Type && ptr.Equal(machine.Architecture, oldMachine.Architecture) {
-		return true, true, true, true, nil
+		return true, true, true, true, true, nil
 	}
 
 	var (
 		isMachinePresentInCloudprofile    = false
 		machinesWithSupportedArchitecture = sets.New[string]()
+		machinesAvailableInRegion         = sets.New[string]()
 		machinesAvailableInAllZones       = sets.New[string]()
 		usableMachines                    = sets.New[string]()
 	)
@@ -1481,6 +1568,9 @@ func validateMachineTypes(constraints []gardencorev1beta1.MachineType, machine,
 		if ptr.Deref(t.Usable, false) {
 			usableMachines.Insert(t.Name)
 		}
+		if v1beta1helper.IsTypeAvailableInRegion(t.Regions, region, zones) {
+			machinesAvailableInRegion.Insert(t.Name)
+		}
 		if !isUnavailableInAtleastOneZone(regions, region, zones, t.Name, func(zone gardencorev1beta1.AvailabilityZone) []string { return zone.UnavailableMachineTypes }) {
 			machinesAvailableInAllZones.Insert(t.Name)
 		}
@@ -1491,9 +1581,28 @@ func validateMachineTypes(constraints []gardencorev1beta1.MachineType, machine,
 
 	return isMachinePresentInCloudprofile,
 		machinesWithSupportedArchitecture.Has(machine.Type),
+		machinesAvailableInRegion.Has(machine.Type),
 		machinesAvailableInAllZones.Has(machine.Type),
 		usableMachines.Has(machine.Type),
-		sets.List(machinesWithSupportedArchitecture.Intersection(machinesAvailableInAllZones).Intersection(usableMachines))
+		sets.List(machinesWithSupportedArchitecture.Intersection(machinesAvailableInRegion).Intersection(machinesAvailableInAllZones).Intersection(usableMachines))
+}
+
+func validateCapacityType(constraints []gardencorev1beta1.MachineType, worker, oldWorker core.Worker, fldPath *field.Path) field.ErrorList {
+	if worker.CapacityType == nil || (worker.Machine.Type == oldWorker.Machine.Type && ptr.Equal(worker.CapacityType, oldWorker.CapacityType)) {
+		return nil
+	}
+
+	machineType := v1beta1helper.FindMachineTypeByName(constraints, worker.Machine.Type)
+	if machineType == nil {
+		// unknown machine types are already reported by the machine type validation
+		return nil
+	}
+
+	if !v1beta1helper.MachineTypeSupportsCapacityType(*machineType, gardencorev1beta1.CapacityType(*worker.CapacityType)) {
+		return field.ErrorList{field.Invalid(fldPath, *worker.CapacityType, fmt.Sprintf("capacity type is not supported by machine type %q, supported capacity types are %+v", worker.Machine.Type, machineType.CapacityTypes))}
+	}
+
+	return nil
 }
 
 func isUnavailableInAtleastOneZone(regions []gardencorev1beta1.Region, region string, zones []string, t string, unavailableTypes func(zone gardencorev1beta1.AvailabilityZone) []string) bool {
@@ -1565,9 +1674,9 @@ func validateKubeletConfig(fldPath *field.Path, machineTypes []gardencorev1beta1
 	return allErrs
 }
 
-func validateVolumeTypes(constraints []gardencorev1beta1.VolumeType, volume, oldVolume *core.Volume, regions []gardencorev1beta1.Region, region string, zones []string) (bool, bool, bool, []string) {
+func validateVolumeTypes(constraints []gardencorev1beta1.VolumeType, volume, oldVolume *core.Volume, regions []gardencorev1beta1.Region, region string, zones []string) (bool, bool, bool, bool, []string) {
 	if volume == nil || volume.Type == nil || (volume != nil && oldVolume != nil && volume.Type != nil && oldVolume.Type != nil && *volume.Type == *oldVolume.Type) {
-		return true, true, true, nil
+		return true, true, true, true, nil
 	}
 
 	var volumeType string
@@ -1577,6 +1686,7 @@ func validateVolumeTypes(constraints []gardencorev1beta1.VolumeType, volume, old
 
 	var (
 		isVolumePresentInCloudprofile = false
+		volumesAvailableInRegion      = sets.New[string]()
 		volumesAvailableInAllZones    = sets.New[string]()
 		usableVolumes                 = sets.New[string]()
 	)
@@ -1585,6 +1695,9 @@ func validateVolumeTypes(constraints []gardencorev1beta1.VolumeType, volume, old
 		if ptr.Deref(v.Usable, false) {
 			usableVolumes.Insert(v.Name)
 		}
+		if v1beta1helper.IsTypeAvailableInRegion(v.Regions, region, zones) {
+			volumesAvailableInRegion.Insert(v.Name)
+		}
 		if !isUnavailableInAtleastOneZone(regions, region, zones, v.Name, func(zone gardencorev1beta1.AvailabilityZone) []string { return zone.UnavailableVolumeTypes }) {
 			volumesAvailableInAllZones.Insert(v.Name)
 		}
@@ -1594,9 +1707,10 @@ func validateVolumeTypes(constraints []gardencorev1beta1.VolumeType, volume, old
 	}
 
 	return isVolumePresentInCloudprofile,
+		volumesAvailableInRegion.Has(volumeType),
 		volumesAvailableInAllZones.Has(volumeType),
 		usableVolumes.Has(volumeType),
-		sets.List(usableVolumes.Intersection(volumesAvailableInAllZones))
+		sets.List(usableVolumes.Intersection(volumesAvailableInRegion).Intersection(volumesAvailableInAllZones))
 }
 
 func (c *validationContext) validateRegion() field.ErrorList {
@@ -1621,6 +1735,314 @@ func (c *validationContext) validateRegion() field.ErrorList {
 	return field.ErrorList{field.NotSupported(fldPath, region, validValues)}
 }
 
+// validateDataResidency validates that the region of the shoot satisfies the data residency constraints of the shoot.
+// The check is only performed if the region or the constraints are changed so that existing shoots are not blocked if
+// the region metadata in the CloudProfile is changed.
+func (c *validationContext) validateDataResidency() field.ErrorList {
+	if c.shoot.Spec.DataResidency == nil ||
+		(c.shoot.Spec.Region == c.oldShoot.Spec.Region && apiequality.Semantic.DeepEqual(c.shoot.Spec.DataResidency, c.oldShoot.Spec.DataResidency)) {
+		return nil
+	}
+
+	if !helper.DataResidencyIsSatisfied(toCoreRegionMetadata(v1beta1helper.GetRegionMetadata(c.cloudProfileSpec, c.shoot.Spec.Region)), c.shoot.Spec.DataResidency) {
+		return field.ErrorList{field.Forbidden(field.NewPath("spec", "region"), fmt.Sprintf("region %q does not satisfy the data residency constraints of the shoot", c.shoot.Spec.Region))}
+	}
+
+	return nil
+}
+
+func toCoreRegionMetadata(regionMetadata *gardencorev1beta1.RegionMetadata) *core.RegionMetadata {
+	if regionMetadata == nil {
+		return nil
+	}
+
+	return &core.RegionMetadata{
+		Geography:        regionMetadata.Geography,
+		Jurisdiction:     regionMetadata.Jurisdiction,
+		LatencyMatrixKey: regionMetadata.LatencyMatrixKey,
+	}
+}
+
+func (c *validationContext) validateManagedAddons() field.ErrorList {
+	var (
+		allErrs   field.ErrorList
+		fldPath   = field.NewPath("spec", "addons", "managed")
+		oldAddons = map[string]core.ManagedAddon{}
+	)
+
+	if c.shoot.Spec.Addons == nil || len(c.shoot.Spec.Addons.Managed) == 0 {
+		return nil
+	}
+
+	if c.oldShoot.Spec.Addons != nil {
+		for _, addon := range c.oldShoot.Spec.Addons.Managed {
+			oldAddons[addon.Name] = addon
+		}
+	}
+
+	for i, addon := range c.shoot.Spec.Addons.Managed {
+		idxPath := fldPath.Index(i)
+
+		oldAddon, ok := oldAddons[addon.Name]
+		if ok && apiequality.Semantic.DeepEqual(addon, oldAddon) {
+			continue
+		}
+
+		definition := v1beta1helper.FindAddonDefinition(c.cloudProfileSpec.Addons, addon.Name)
+		if definition == nil {
+			var validValues []string
+			for _, d := range c.cloudProfileSpec.Addons {
+				validValues = append(validValues, d.Name)
+			}
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("name"), addon.Name, validValues))
+			continue
+		}
+
+		// Addons which do not support workerless Shoot clusters are reported by validateShootConsistency.
+		if helper.IsWorkerless(c.shoot) && !ptr.Deref(definition.WorkerlessSupported, false) {
+			continue
+		}
+
+		version := v1beta1helper.FindAddonVersion(definition.Versions, addon.Version)
+		if version == nil {
+			var validValues []string
+			for _, v := range definition.Versions {
+				if v.ExpirationDate == nil || v.ExpirationDate.Time.UTC().After(time.Now().UTC()) {
+					validValues = append(validValues, v.Version)
+				}
+			}
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("version"), addon.Version, validValues))
+			continue
+		}
+
+		if (!ok || oldAddon.Version != addon.Version) && version.ExpirationDate != nil && version.ExpirationDate.Time.UTC().Before(time.Now().UTC()) {
+			allErrs = append(allErrs, field.Forbidden(idxPath.Child("version"), fmt.Sprintf("version %q of addon %q is expired", addon.Version, addon.Name)))
+		}
+
+		if version.ValuesSchema == nil {
+			if addon.Values != nil {
+				allErrs = append(allErrs, field.Forbidden(idxPath.Child("values"), fmt.Sprintf("version %q of addon %q does not accept values", addon.Version, addon.Name)))
+			}
+			continue
+		}
+
+		schema, err := jsonschema.Parse(version.ValuesSchema)
+		if err != nil {
+			allErrs = append(allErrs, field.InternalError(idxPath.Child("values"), fmt.Errorf("failed parsing values schema of version %q of addon %q: %w", addon.Version, addon.Name, err)))
+			continue
+		}
+		if err := jsonschema.Validate(schema, addon.Values); err != nil {
+			var values string
+			if addon.Values != nil {
+				values = string(addon.Values.Raw)
+			}
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("values"), values, err.Error()))
+		}
+	}
+
+	return allErrs
+}
+
+// validateShootConsistency validates invariants which span multiple fields of the shoot specification and the
+// referenced CloudProfile. All violations are collected and returned together, so that users can fix them at once
+// instead of being confronted with one constraint after the other.
+func (c *validationContext) validateShootConsistency() field.ErrorList {
+	var allErrs field.ErrorList
+
+	if helper.IsWorkerless(c.shoot) {
+		allErrs = append(allErrs, c.validateWorkerlessAddons()...)
+		allErrs = append(allErrs, c.validateWorkerlessNetworking()...)
+	}
+	allErrs = append(allErrs, c.validateHighAvailabilityZones()...)
+
+	return allErrs
+}
+
+// validateWorkerlessAddons validates that workerless shoots only enable addons which can run without nodes.
+func (c *validationContext) validateWorkerlessAddons() field.ErrorList {
+	var (
+		allErrs   field.ErrorList
+		fldPath   = field.NewPath("spec", "addons")
+		oldAddons = map[string]core.ManagedAddon{}
+	)
+
+	if c.shoot.Spec.Addons == nil {
+		return nil
+	}
+
+	if c.shoot.Spec.Addons.KubernetesDashboard != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("kubernetesDashboard"), "addon requires nodes and cannot be enabled for workerless Shoot clusters"))
+	}
+	if c.shoot.Spec.Addons.NginxIngress != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("nginxIngress"), "addon requires nodes and cannot be enabled for workerless Shoot clusters"))
+	}
+
+	if c.oldShoot.Spec.Addons != nil {
+		for _, addon := range c.oldShoot.Spec.Addons.Managed {
+			oldAddons[addon.Name] = addon
+		}
+	}
+
+	for i, addon := range c.shoot.Spec.Addons.Managed {
+		if oldAddon, ok := oldAddons[addon.Name]; ok && apiequality.Semantic.DeepEqual(addon, oldAddon) {
+			continue
+		}
+
+		// Unknown addons are reported by validateManagedAddons.
+		if definition := v1beta1helper.FindAddonDefinition(c.cloudProfileSpec.Addons, addon.Name); definition != nil && !ptr.Deref(definition.WorkerlessSupported, false) {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("managed").Index(i).Child("name"), fmt.Sprintf("addon %q requires nodes and cannot be enabled for workerless Shoot clusters", addon.Name)))
+		}
+	}
+
+	return allErrs
+}
+
+// validateWorkerlessNetworking validates that workerless shoots do not configure a networking type, as the networking
+// extension deploys its components to the worker nodes.
+func (c *validationContext) validateWorkerlessNetworking() field.ErrorList {
+	var (
+		allErrs    field.ErrorList
+		fldPath    = field.NewPath("spec", "networking")
+		networking = c.shoot.Spec.Networking
+	)
+
+	if networking == nil {
+		return nil
+	}
+
+	if networking.Type != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("type"), fmt.Sprintf("networking type %q requires worker pools and cannot be configured for workerless Shoot clusters", *networking.Type)))
+	}
+	if networking.ProviderConfig != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("providerConfig"), "networking provider config requires worker pools and cannot be configured for workerless Shoot clusters"))
+	}
+
+	return allErrs
+}
+
+// validateHighAvailabilityZones validates that the region of the shoot offers enough zones for a control plane with
+// failure tolerance type 'zone'. Regions which do not list any zones in the CloudProfile are not validated. The check is
+// only performed if the failure tolerance type or the region is changed, hence removing zones from the CloudProfile does
+// not block unrelated updates of the shoot.
+func (c *validationContext) validateHighAvailabilityZones() field.ErrorList {
+	const minimumZones = 3
+
+	if !helper.IsMultiZonalShootControlPlane(c.shoot) ||
+		(helper.IsMultiZonalShootControlPlane(c.oldShoot) && c.shoot.Spec.Region == c.oldShoot.Spec.Region) {
+		return nil
+	}
+
+	for _, region := range c.cloudProfileSpec.Regions {
+		if region.Name != c.shoot.Spec.Region {
+			continue
+		}
+
+		if numberOfZones := len(region.Zones); numberOfZones > 0 && numberOfZones < minimumZones {
+			return field.ErrorList{field.Forbidden(
+				field.NewPath("spec", "controlPlane", "highAvailability", "failureTolerance", "type"),
+				fmt.Sprintf("failure tolerance type %q requires at least %d zones in region %q, but the CloudProfile only offers %d", core.FailureToleranceTypeZone, minimumZones, region.Name, numberOfZones),
+			)}
+		}
+
+		break
+	}
+
+	// Unknown regions are reported by validateRegion.
+	return nil
+}
+
+// validateETCDBackup validates that the etcd backup settings of the shoot are within the bounds defined by the seed. Only
+// changed settings are validated, hence neither tightening the bounds of a seed nor scheduling the shoot to another seed
+// block unrelated updates of the shoot. The gardenlet falls back to the defaults for settings which are out of bounds.
+func (c *validationContext) validateETCDBackup() field.ErrorList {
+	var (
+		allErrs field.ErrorList
+		fldPath = field.NewPath("spec", "etcd", "backup")
+	)
+
+	if c.seed == nil || c.seed.Spec.Backup == nil || c.shoot.Spec.ETCD == nil || c.shoot.Spec.ETCD.Backup == nil {
+		return nil
+	}
+
+	var (
+		bounds    = c.seed.Spec.Backup.ETCD
+		backup    = c.shoot.Spec.ETCD.Backup
+		oldBackup *core.ETCDBackup
+	)
+
+	if c.oldShoot.Spec.ETCD != nil {
+		oldBackup = c.oldShoot.Spec.ETCD.Backup
+	}
+	if oldBackup == nil {
+		oldBackup = &core.ETCDBackup{}
+	}
+
+	if schedule := backup.FullSnapshotSchedule; schedule != nil && !apiequality.Semantic.DeepEqual(schedule, oldBackup.FullSnapshotSchedule) {
+		if err := v1beta1helper.CheckETCDFullSnapshotSchedule(bounds, *schedule); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("fullSnapshotSchedule"), *schedule, err.Error()))
+		}
+	}
+
+	if retentionPeriod := backup.DeltaSnapshotRetentionPeriod; retentionPeriod != nil && !apiequality.Semantic.DeepEqual(retentionPeriod, oldBackup.DeltaSnapshotRetentionPeriod) {
+		if err := v1beta1helper.CheckETCDDeltaSnapshotRetentionPeriod(bounds, retentionPeriod.Duration); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("deltaSnapshotRetentionPeriod"), retentionPeriod.Duration.String(), err.Error()))
+		}
+	}
+
+	return allErrs
+}
+
+// validateKubeAPIServerEndpointPort validates that the custom port of the kube-apiserver endpoint is exposed by the
+// istio ingress gateway of the seed. It is only validated if the port or the seed of the shoot changed.
+func (c *validationContext) validateKubeAPIServerEndpointPort() field.ErrorList {
+	if c.seed == nil || c.shoot.Spec.Kubernetes.KubeAPIServer == nil || c.shoot.Spec.Kubernetes.KubeAPIServer.Endpoint == nil || c.shoot.Spec.Kubernetes.KubeAPIServer.Endpoint.Port == nil {
+		return nil
+	}
+
+	var (
+		fldPath = field.NewPath("spec", "kubernetes", "kubeAPIServer", "endpoint", "port")
+		port    = *c.shoot.Spec.Kubernetes.KubeAPIServer.Endpoint.Port
+		oldPort *int32
+	)
+
+	if c.oldShoot.Spec.Kubernetes.KubeAPIServer != nil && c.oldShoot.Spec.Kubernetes.KubeAPIServer.Endpoint != nil {
+		oldPort = c.oldShoot.Spec.Kubernetes.KubeAPIServer.Endpoint.Port
+	}
+
+	if ptr.Equal(oldPort, &port) && ptr.Equal(c.oldShoot.Spec.SeedName, c.shoot.Spec.SeedName) {
+		return nil
+	}
+
+	if c.seed.Spec.Ingress == nil || !slices.Contains(c.seed.Spec.Ingress.KubeAPIServerPorts, port) {
+		return field.ErrorList{field.Forbidden(fldPath, fmt.Sprintf("port %d is not exposed by the ingress gateway of seed %q", port, c.seed.Name))}
+	}
+
+	return nil
+}
+
+// validateKubeAPIServerManagedCertificate validates that the seed offers an ACME server if a managed certificate is
+// requested for the kube-apiserver endpoint. It is only validated if the request or the seed of the shoot changed.
+func (c *validationContext) validateKubeAPIServerManagedCertificate() field.ErrorList {
+	if c.seed == nil || !kubeAPIServerManagedCertificateRequested(c.shoot) {
+		return nil
+	}
+
+	if kubeAPIServerManagedCertificateRequested(c.oldShoot) && ptr.Equal(c.oldShoot.Spec.SeedName, c.shoot.Spec.SeedName) {
+		return nil
+	}
+
+	if c.seed.Spec.Settings == nil || c.seed.Spec.Settings.ACME == nil {
+		return field.ErrorList{field.Forbidden(field.NewPath("spec", "kubernetes", "kubeAPIServer", "endpoint", "managedCertificate"), fmt.Sprintf("seed %q does not offer managed certificates since it has no ACME server configured", c.seed.Name))}
+	}
+
+	return nil
+}
+
+func kubeAPIServerManagedCertificateRequested(shoot *core.Shoot) bool {
+	kubeAPIServer := shoot.Spec.Kubernetes.KubeAPIServer
+	return kubeAPIServer != nil && kubeAPIServer.Endpoint != nil && ptr.Deref(kubeAPIServer.Endpoint.ManagedCertificate, false)
+}
+
 func (c *validationContext) validateAccessRestrictions() field.ErrorList {
 	var (
 		allErrs     field.ErrorList
@@ -1812,6 +1812,59 @@ var _ = Describe("validator", func() {
 				attrs := admission.NewAttributesRecord(&shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Delete, &metav1.UpdateOptions{}, false, nil)
 				Expect(admissionHandler.Admit(ctx, attrs, nil)).To(Succeed())
 			})
+
+			It("should reject creation because the region of the seed does not satisfy the data residency constraints", func() {
+				seed.Spec.Provider.RegionMetadata = &gardencorev1beta1.RegionMetadata{Jurisdiction: ptr.To("US")}
+				Expect(coreInformerFactory.Core().V1beta1().Seeds().Informer().GetStore().Update(&seed)).To(Succeed())
+
+				shoot.Spec.DataResidency = &core.DataResidency{Jurisdictions: []string{"EU"}}
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+				err := admissionHandler.Admit(ctx, attrs, nil)
+
+				Expect(err).To(BeForbiddenError())
+				Expect(err.Error()).To(ContainSubstring("forbidden to use a seed whose region does not satisfy the data residency constraints of the shoot"))
+			})
+
+			It("should allow creation because the regions of the shoot and the seed satisfy the data residency constraints", func() {
+				cloudProfile.Spec.Regions[0].Metadata = &gardencorev1beta1.RegionMetadata{Jurisdiction: ptr.To("EU")}
+				Expect(coreInformerFactory.Core().V1beta1().CloudProfiles().Informer().GetStore().Update(&cloudProfile)).To(Succeed())
+				seed.Spec.Provider.RegionMetadata = &gardencorev1beta1.RegionMetadata{Jurisdiction: ptr.To("EU")}
+				Expect(coreInformerFactory.Core().V1beta1().Seeds().Informer().GetStore().Update(&seed)).To(Succeed())
+
+				shoot.Spec.DataResidency = &core.DataResidency{Jurisdictions: []string{"EU"}}
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+				Expect(admissionHandler.Admit(ctx, attrs, nil)).To(Succeed())
+			})
+
+			It("should reject creation because the region of the shoot does not satisfy the data residency constraints", func() {
+				cloudProfile.Spec.Regions[0].Metadata = &gardencorev1beta1.RegionMetadata{Jurisdiction: ptr.To("US")}
+				Expect(coreInformerFactory.Core().V1beta1().CloudProfiles().Informer().GetStore().Update(&cloudProfile)).To(Succeed())
+				seed.Spec.Provider.RegionMetadata = &gardencorev1beta1.RegionMetadata{Jurisdiction: ptr.To("EU")}
+				Expect(coreInformerFactory.Core().V1beta1().Seeds().Informer().GetStore().Update(&seed)).To(Succeed())
+
+				shoot.Spec.DataResidency = &core.DataResidency{Jurisdictions: []string{"EU"}}
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+				err := admissionHandler.Admit(ctx, attrs, nil)
+
+				Expect(err).To(BeForbiddenError())
+				Expect(err.Error()).To(ContainSubstring("does not satisfy the data residency constraints of the shoot"))
+			})
+
+			It("should allow updates if the region of the shoot no longer satisfies unchanged data residency constraints", func() {
+				cloudProfile.Spec.Regions[0].Metadata = &gardencorev1beta1.RegionMetadata{Jurisdiction: ptr.To("US")}
+				Expect(coreInformerFactory.Core().V1beta1().CloudProfiles().Informer().GetStore().Update(&cloudProfile)).To(Succeed())
+				seed.Spec.Provider.RegionMetadata = &gardencorev1beta1.RegionMetadata{Jurisdiction: ptr.To("EU")}
+				Expect(coreInformerFactory.Core().V1beta1().Seeds().Informer().GetStore().Update(&seed)).To(Succeed())
+
+				shoot.Spec.DataResidency = &core.DataResidency{Jurisdictions: []string{"EU"}}
+				oldShoot := shoot.DeepCopy()
+
+				attrs := admission.NewAttributesRecord(&shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, nil)
+				Expect(admissionHandler.Admit(ctx, attrs, nil)).To(Succeed())
+			})
 		})
 
 		Context("tests for unknown provider", func() {
@@ -2013,6 +2066,7 @@ var _ = Describe("validator", func() {
 					Context("seed has at least 3 zones", func() {
 						BeforeEach(func() {
 							seed.Spec.Provider.Zones = []string{"1", "2", "3"}
+							cloudProfile.Spec.Regions[0].Zones = []gardencorev1beta1.AvailabilityZone{{Name: "europe-a"}, {Name: "europe-b"}, {Name: "europe-c"}}
 						})
 
 						It("should allow scheduling non-HA shoot", func() {
@@ -2367,6 +2421,72 @@ var _ = Describe("validator", func() {
 				})
 			})
 
+			Context("audit webhook check", func() {
+				BeforeEach(func() {
+					shoot.Spec.Kubernetes.KubeAPIServer = &core.KubeAPIServerConfig{
+						AuditConfig: &core.AuditConfig{
+							Webhook: &core.AuditWebhook{KubeconfigSecretName: "secret-1"},
+						},
+					}
+
+					Expect(coreInformerFactory.Core().V1beta1().Projects().Informer().GetStore().Add(&project)).To(Succeed())
+					Expect(coreInformerFactory.Core().V1beta1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)).To(Succeed())
+					Expect(coreInformerFactory.Core().V1beta1().Seeds().Informer().GetStore().Add(&seed)).To(Succeed())
+					Expect(coreInformerFactory.Core().V1beta1().SecretBindings().Informer().GetStore().Add(&secretBinding)).To(Succeed())
+					Expect(securityInformerFactory.Security().V1alpha1().CredentialsBindings().Informer().GetStore().Add(&credentialsBinding)).To(Succeed())
+				})
+
+				It("should not allow because kubeconfig secret is not referenced in shoot .spec.resources", func() {
+					attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+					err := admissionHandler.Admit(ctx, attrs, nil)
+
+					Expect(err).To(BeForbiddenError())
+					Expect(err.Error()).To(ContainSubstring("secret should be referenced in shoot .spec.resources"))
+				})
+
+				Context("kubeconfig secret is referenced", func() {
+					BeforeEach(func() {
+						shoot.Spec.Resources = []core.NamedResourceReference{
+							{
+								Name: "ref-1",
+								ResourceRef: autoscalingv1.CrossVersionObjectReference{
+									Name:       "secret-1",
+									APIVersion: "v1",
+									Kind:       "Secret",
+								},
+							},
+						}
+					})
+
+					It("should not allow because referenced kubeconfig secret does not exist", func() {
+						attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+						err := admissionHandler.Admit(ctx, attrs, nil)
+
+						Expect(err).To(BeForbiddenError())
+					})
+
+					It("should not allow because referenced kubeconfig secret does not contain data kubeconfig", func() {
+						secret.Data = nil
+						Expect(kubeInformerFactory.Core().V1().Secrets().Informer().GetStore().Add(&secret)).To(Succeed())
+
+						attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+						err := admissionHandler.Admit(ctx, attrs, nil)
+
+						Expect(err).To(BeForbiddenError())
+					})
+
+					It("should allow because referenced kubeconfig secret is valid", func() {
+						secret.Data = map[string][]byte{"kubeconfig": {}}
+						Expect(kubeInformerFactory.Core().V1().Secrets().Informer().GetStore().Add(&secret)).To(Succeed())
+
+						attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+						err := admissionHandler.Admit(ctx, attrs, nil)
+
+						Expect(err).NotTo(HaveOccurred())
+					})
+				})
+			})
+
 			Context("oidc config check", func() {
 				BeforeEach(func() {
 					shoot.Spec.Kubernetes.KubeAPIServer = &core.KubeAPIServerConfig{}
@@ -2416,6 +2536,177 @@ var _ = Describe("validator", func() {
 				)
 			})
 
+			Context("managed addons check", func() {
+				BeforeEach(func() {
+					cloudProfile.Spec.Addons = []gardencorev1beta1.AddonDefinition{{
+						Name: "cert-manager",
+						Versions: []gardencorev1beta1.AddonVersion{
+							{
+								ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "1.15.0", ExpirationDate: &metav1.Time{Time: time.Now().Add(-time.Hour)}},
+								Chart:            gardencorev1beta1.OCIRepository{Ref: ptr.To("example.com/charts/cert-manager:v1.15.0")},
+							},
+							{
+								ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "1.16.1"},
+								Chart:            gardencorev1beta1.OCIRepository{Ref: ptr.To("example.com/charts/cert-manager:v1.16.1")},
+								ValuesSchema:     &runtime.RawExtension{Raw: []byte(`{"type":"object","additionalProperties":false,"properties":{"replicas":{"type":"integer"}}}`)},
+							},
+						},
+					}}
+
+					Expect(coreInformerFactory.Core().V1beta1().Projects().Informer().GetStore().Add(&project)).To(Succeed())
+					Expect(coreInformerFactory.Core().V1beta1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)).To(Succeed())
+					Expect(coreInformerFactory.Core().V1beta1().Seeds().Informer().GetStore().Add(&seed)).To(Succeed())
+					Expect(coreInformerFactory.Core().V1beta1().SecretBindings().Informer().GetStore().Add(&secretBinding)).To(Succeed())
+					Expect(securityInformerFactory.Security().V1alpha1().CredentialsBindings().Informer().GetStore().Add(&credentialsBinding)).To(Succeed())
+				})
+
+				DescribeTable("validate managed addons on shoot create", func(addon core.ManagedAddon, errorMatcher types.GomegaMatcher) {
+					shoot.Spec.Addons = &core.Addons{Managed: []core.ManagedAddon{addon}}
+
+					attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+					err := admissionHandler.Admit(ctx, attrs, nil)
+
+					Expect(err).To(errorMatcher)
+				},
+					Entry("should allow a valid addon", core.ManagedAddon{Name: "cert-manager", Version: "1.16.1", Values: &runtime.RawExtension{Raw: []byte(`{"replicas":2}`)}}, BeNil()),
+					Entry("should forbid an unknown addon", core.ManagedAddon{Name: "foo", Version: "1.0.0"}, MatchError(ContainSubstring("spec.addons.managed[0].name: Unsupported value"))),
+					Entry("should forbid an unknown version", core.ManagedAddon{Name: "cert-manager", Version: "1.0.0"}, MatchError(ContainSubstring("spec.addons.managed[0].version: Unsupported value"))),
+					Entry("should forbid an expired version", core.ManagedAddon{Name: "cert-manager", Version: "1.15.0"}, MatchError(ContainSubstring("is expired"))),
+					Entry("should forbid values for versions without values schema", core.ManagedAddon{Name: "cert-manager", Version: "1.15.0", Values: &runtime.RawExtension{Raw: []byte(`{}`)}}, MatchError(ContainSubstring("does not accept values"))),
+					Entry("should forbid values not matching the schema", core.ManagedAddon{Name: "cert-manager", Version: "1.16.1", Values: &runtime.RawExtension{Raw: []byte(`{"replicas":"two"}`)}}, MatchError(ContainSubstring("spec.addons.managed[0].values: Invalid value"))),
+				)
+
+				It("should allow keeping an expired version on shoot update", func() {
+					shoot.Spec.Addons = &core.Addons{Managed: []core.ManagedAddon{{Name: "cert-manager", Version: "1.15.0"}}}
+					oldShoot := shoot.DeepCopy()
+					shoot.Spec.Kubernetes.EnableStaticTokenKubeconfig = ptr.To(false)
+
+					attrs := admission.NewAttributesRecord(&shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, nil)
+					Expect(admissionHandler.Admit(ctx, attrs, nil)).To(Succeed())
+				})
+
+				It("should forbid updating to an expired version", func() {
+					shoot.Spec.Addons = &core.Addons{Managed: []core.ManagedAddon{{Name: "cert-manager", Version: "1.16.1"}}}
+					oldShoot := shoot.DeepCopy()
+					shoot.Spec.Addons.Managed[0].Version = "1.15.0"
+
+					attrs := admission.NewAttributesRecord(&shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, nil)
+					Expect(admissionHandler.Admit(ctx, attrs, nil)).To(MatchError(ContainSubstring("is expired")))
+				})
+
+				Context("workerless shoot", func() {
+					BeforeEach(func() {
+						shoot.Spec.Provider.Workers = nil
+						shoot.Spec.Networking.Pods = nil
+						shoot.Spec.Addons = &core.Addons{Managed: []core.ManagedAddon{{Name: "cert-manager", Version: "1.16.1"}}}
+					})
+
+					It("should forbid addons which do not support workerless shoots", func() {
+						attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+						Expect(admissionHandler.Admit(ctx, attrs, nil)).To(MatchError(ContainSubstring(`spec.addons.managed[0].name: Forbidden: addon "cert-manager" requires nodes and cannot be enabled for workerless Shoot clusters`)))
+					})
+
+					It("should allow addons which support workerless shoots", func() {
+						cloudProfile.Spec.Addons[0].WorkerlessSupported = ptr.To(true)
+
+						attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+						Expect(admissionHandler.Admit(ctx, attrs, nil)).To(Succeed())
+					})
+				})
+			})
+
+			Context("shoot consistency checks", func() {
+				BeforeEach(func() {
+					cloudProfile.Spec.Addons = []gardencorev1beta1.AddonDefinition{{
+						Name: "cert-manager",
+						Versions: []gardencorev1beta1.AddonVersion{{
+							ExpirableVersion: gardencorev1beta1.ExpirableVersion{Version: "1.16.1"},
+							Chart:            gardencorev1beta1.OCIRepository{Ref: ptr.To("example.com/charts/cert-manager:v1.16.1")},
+						}},
+					}}
+
+					Expect(coreInformerFactory.Core().V1beta1().Projects().Informer().GetStore().Add(&project)).To(Succeed())
+					Expect(coreInformerFactory.Core().V1beta1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)).To(Succeed())
+					Expect(coreInformerFactory.Core().V1beta1().Seeds().Informer().GetStore().Add(&seed)).To(Succeed())
+					Expect(coreInformerFactory.Core().V1beta1().SecretBindings().Informer().GetStore().Add(&secretBinding)).To(Succeed())
+					Expect(securityInformerFactory.Security().V1alpha1().CredentialsBindings().Informer().GetStore().Add(&credentialsBinding)).To(Succeed())
+				})
+
+				Context("workerless shoot", func() {
+					BeforeEach(func() {
+						shoot.Spec.Provider.Workers = nil
+						shoot.Spec.Networking.Pods = nil
+					})
+
+					It("should report all inconsistencies at once", func() {
+						shoot.Spec.Addons = &core.Addons{
+							KubernetesDashboard: &core.KubernetesDashboard{},
+							NginxIngress:        &core.NginxIngress{},
+							Managed:             []core.ManagedAddon{{Name: "cert-manager", Version: "1.16.1"}},
+						}
+						shoot.Spec.Networking.Type = ptr.To("calico")
+						shoot.Spec.Networking.ProviderConfig = &runtime.RawExtension{Raw: []byte(`{}`)}
+
+						attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+						err := admissionHandler.Admit(ctx, attrs, nil)
+
+						Expect(err).To(BeForbiddenError())
+						Expect(err).To(MatchError(And(
+							ContainSubstring("spec.addons.kubernetesDashboard: Forbidden: addon requires nodes"),
+							ContainSubstring("spec.addons.nginxIngress: Forbidden: addon requires nodes"),
+							ContainSubstring(`spec.addons.managed[0].name: Forbidden: addon "cert-manager" requires nodes`),
+							ContainSubstring(`spec.networking.type: Forbidden: networking type "calico" requires worker pools`),
+							ContainSubstring("spec.networking.providerConfig: Forbidden: networking provider config requires worker pools"),
+						)))
+					})
+
+					It("should not report unchanged managed addons on shoot update", func() {
+						shoot.Spec.Addons = &core.Addons{Managed: []core.ManagedAddon{{Name: "cert-manager", Version: "1.16.1"}}}
+						oldShoot := shoot.DeepCopy()
+						shoot.Spec.Kubernetes.EnableStaticTokenKubeconfig = ptr.To(false)
+
+						attrs := admission.NewAttributesRecord(&shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, nil)
+						Expect(admissionHandler.Admit(ctx, attrs, nil)).To(Succeed())
+					})
+				})
+
+				Context("high availability with failure tolerance type 'zone'", func() {
+					BeforeEach(func() {
+						seed.Spec.Provider.Zones = []string{"1", "2", "3"}
+						shoot.Annotations = make(map[string]string)
+						shoot.Spec.ControlPlane = &core.ControlPlane{HighAvailability: &core.HighAvailability{FailureTolerance: core.FailureTolerance{Type: core.FailureToleranceTypeZone}}}
+					})
+
+					It("should forbid regions with less than 3 zones", func() {
+						attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+						Expect(admissionHandler.Admit(ctx, attrs, nil)).To(MatchError(ContainSubstring(`spec.controlPlane.highAvailability.failureTolerance.type: Forbidden: failure tolerance type "zone" requires at least 3 zones in region "europe", but the CloudProfile only offers 1`)))
+					})
+
+					It("should allow regions with at least 3 zones", func() {
+						cloudProfile.Spec.Regions[0].Zones = []gardencorev1beta1.AvailabilityZone{{Name: "europe-a"}, {Name: "europe-b"}, {Name: "europe-c"}}
+
+						attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+						Expect(admissionHandler.Admit(ctx, attrs, nil)).To(Succeed())
+					})
+
+					It("should allow regions which do not list any zones", func() {
+						cloudProfile.Spec.Regions[0].Zones = nil
+						shoot.Spec.Provider.Workers[0].Zones = nil
+
+						attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+						Expect(admissionHandler.Admit(ctx, attrs, nil)).To(Succeed())
+					})
+
+					It("should not block unrelated updates of existing shoots", func() {
+						oldShoot := shoot.DeepCopy()
+						shoot.Spec.Kubernetes.EnableStaticTokenKubeconfig = ptr.To(false)
+
+						attrs := admission.NewAttributesRecord(&shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, nil)
+						Expect(admissionHandler.Admit(ctx, attrs, nil)).To(Succeed())
+					})
+				})
+			})
+
 			Context("networking settings checks", func() {
 				var (
 					oldShoot *core.Shoot
@@ -4882,6 +5173,34 @@ var _ = Describe("validator", func() {
 					Expect(err).To(MatchError(ContainSubstring("Unsupported value: %q: supported values: %q, %q]", "not-present-in-cloudprofile", "machine-type-1", "machine-type-2")))
 				})
 
+				It("should reject due to a capacity type not supported by the machine type", func() {
+					shoot.Spec.Provider.Workers[0].CapacityType = ptr.To(core.CapacityTypeSpot)
+
+					attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+					err := admissionHandler.Admit(ctx, attrs, nil)
+
+					Expect(err).To(BeForbiddenError())
+					Expect(err).To(MatchError(ContainSubstring("capacity type is not supported by machine type %q", shoot.Spec.Provider.Workers[0].Machine.Type)))
+				})
+
+				It("should not reject due to a capacity type supported by the machine type", func() {
+					cloudProfile.Spec.MachineTypes[0].CapacityTypes = []gardencorev1beta1.CapacityType{gardencorev1beta1.CapacityTypeOnDemand, gardencorev1beta1.CapacityTypeSpot}
+					Expect(coreInformerFactory.Core().V1beta1().CloudProfiles().Informer().GetStore().Update(&cloudProfile)).To(Succeed())
+
+					shoot.Spec.Provider.Workers[0].CapacityType = ptr.To(core.CapacityTypeSpotWithFallback)
+
+					attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+					Expect(admissionHandler.Admit(ctx, attrs, nil)).To(Succeed())
+				})
+
+				It("should not reject an unchanged capacity type which is no longer supported by the machine type", func() {
+					shoot.Spec.Provider.Workers[0].CapacityType = ptr.To(core.CapacityTypeSpot)
+					oldShoot := shoot.DeepCopy()
+
+					attrs := admission.NewAttributesRecord(&shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+					Expect(admissionHandler.Admit(ctx, attrs, nil)).To(Succeed())
+				})
+
 				It("should reject if the machine is unavailable in atleast one zone", func() {
 					unavailableMachine := "unavailable-machine"
 					zone := "some-zone"
@@ -4918,6 +5237,68 @@ var _ = Describe("validator", func() {
 					Expect(err).To(MatchError(ContainSubstring("machine type %q is unavailable in at least one zone", unavailableMachine)))
 				})
 
+				It("should reject if the machine is not available in the region", func() {
+					zone := "europe-a"
+					shoot.Spec.Provider.Workers[0].Machine.Type = "machine-type-1"
+					shoot.Spec.Provider.Workers[0].Zones = []string{zone}
+
+					cloudProfile.Spec.MachineTypes = []gardencorev1beta1.MachineType{
+						{
+							Name:         "machine-type-1",
+							Architecture: ptr.To("amd64"),
+							Usable:       ptr.To(true),
+							Regions:      []gardencorev1beta1.TypeRegionAvailability{{Name: "other-region"}},
+						},
+						{
+							Name:         "machine-type-2",
+							Architecture: ptr.To("amd64"),
+							Usable:       ptr.To(true),
+							Regions:      []gardencorev1beta1.TypeRegionAvailability{{Name: shoot.Spec.Region, Zones: []string{zone}, PricingClass: ptr.To("premium")}},
+						},
+					}
+
+					attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+					err := admissionHandler.Admit(ctx, attrs, nil)
+
+					Expect(err).To(BeForbiddenError())
+					Expect(err).To(MatchError(ContainSubstring("machine type %q is unavailable in region %q or at least one of the selected zones, supported types are [%s]", "machine-type-1", shoot.Spec.Region, "machine-type-2")))
+				})
+
+				It("should reject if the machine is only available in other zones of the region", func() {
+					shoot.Spec.Provider.Workers[0].Machine.Type = "machine-type-1"
+					shoot.Spec.Provider.Workers[0].Zones = []string{"europe-a"}
+
+					cloudProfile.Spec.MachineTypes = []gardencorev1beta1.MachineType{{
+						Name:         "machine-type-1",
+						Architecture: ptr.To("amd64"),
+						Usable:       ptr.To(true),
+						Regions:      []gardencorev1beta1.TypeRegionAvailability{{Name: shoot.Spec.Region, Zones: []string{"europe-b"}}},
+					}}
+
+					attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+					err := admissionHandler.Admit(ctx, attrs, nil)
+
+					Expect(err).To(BeForbiddenError())
+					Expect(err).To(MatchError(ContainSubstring("machine type %q is unavailable in region %q or at least one of the selected zones", "machine-type-1", shoot.Spec.Region)))
+				})
+
+				It("should allow if the machine is available in the region and all selected zones", func() {
+					shoot.Spec.Provider.Workers[0].Machine.Type = "machine-type-1"
+					shoot.Spec.Provider.Workers[0].Zones = []string{"europe-a"}
+
+					cloudProfile.Spec.MachineTypes = []gardencorev1beta1.MachineType{{
+						Name:         "machine-type-1",
+						Architecture: ptr.To("amd64"),
+						Usable:       ptr.To(true),
+						Regions:      []gardencorev1beta1.TypeRegionAvailability{{Name: shoot.Spec.Region, Zones: []string{"europe-a", "europe-b"}}},
+					}}
+
+					attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+					err := admissionHandler.Admit(ctx, attrs, nil)
+
+					Expect(err).NotTo(HaveOccurred())
+				})
+
 				It("should reject if the machine is not usable, is not having the same architecture mentioned in the cloudprofile and is not available in all zones", func() {
 					zone := "some-zone"
 					architecture := "amd64"
@@ -5039,6 +5420,42 @@ var _ = Describe("validator", func() {
 					Expect(err).To(MatchError(ContainSubstring("volume type %q is unavailable in at least one zone, supported types are [%s]", unavailableVolume, volumeType2)))
 				})
 
+				It("should reject if the volume is not available in the region", func() {
+					cloudProfile.Spec.VolumeTypes = []gardencorev1beta1.VolumeType{
+						{
+							Name:    "regional-volume",
+							Class:   "super-premium",
+							Usable:  ptr.To(true),
+							Regions: []gardencorev1beta1.TypeRegionAvailability{{Name: "other-region"}},
+						},
+						{
+							Name:    volumeType2,
+							Class:   "super-premium",
+							MinSize: &minVolSize,
+							Usable:  ptr.To(true),
+						},
+					}
+
+					shoot.Spec.Provider.Workers = []core.Worker{
+						{
+							Machine: core.Machine{
+								Type:         "machine-type-1",
+								Architecture: ptr.To("amd64"),
+							},
+							Volume: &core.Volume{
+								Type: ptr.To("regional-volume"),
+							},
+							Zones: []string{"europe-a"},
+						},
+					}
+
+					attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+					err := admissionHandler.Admit(ctx, attrs, nil)
+
+					Expect(err).To(BeForbiddenError())
+					Expect(err).To(MatchError(ContainSubstring("volume type %q is unavailable in region %q or at least one of the selected zones, supported types are [%s]", "regional-volume", shoot.Spec.Region, volumeType2)))
+				})
+
 				It("should reject if the volume is unusable and unavailable in atleast one zone", func() {
 					unavailableVolume := "unavailable-volume"
 					zone := "europe-a"
@@ -5179,6 +5596,50 @@ var _ = Describe("validator", func() {
 					Expect(err.Error()).To(ContainSubstring("spec.provider.workers[0].volume.size"))
 					Expect(err.Error()).To(ContainSubstring("spec.provider.workers[2].volume.size"))
 				})
+
+				It("should allow volume iops and throughput within the volume type limits", func() {
+					cloudProfile.Spec.VolumeTypes[0].MaxIOPS = ptr.To[int64](16000)
+					cloudProfile.Spec.VolumeTypes[0].MaxThroughput = ptr.To[int64](1000)
+
+					shoot.Spec.Provider.Workers[0].Volume = &core.Volume{
+						Type:       &volumeType,
+						VolumeSize: "20Gi",
+						IOPS:       ptr.To[int64](16000),
+						Throughput: ptr.To[int64](500),
+					}
+					shoot.Spec.Provider.Workers[0].DataVolumes = []core.DataVolume{{
+						Name:       "data",
+						Type:       &volumeType,
+						VolumeSize: "20Gi",
+						IOPS:       ptr.To[int64](3000),
+					}}
+
+					attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+					Expect(admissionHandler.Admit(ctx, attrs, nil)).To(Succeed())
+				})
+
+				It("should reject volume iops and throughput exceeding or not supported by the volume type", func() {
+					cloudProfile.Spec.VolumeTypes[0].MaxIOPS = ptr.To[int64](16000)
+
+					shoot.Spec.Provider.Workers[0].Volume = &core.Volume{
+						Type:       &volumeType,
+						VolumeSize: "20Gi",
+						IOPS:       ptr.To[int64](16001),
+					}
+					shoot.Spec.Provider.Workers[0].DataVolumes = []core.DataVolume{{
+						Name:       "data",
+						Type:       &volumeType,
+						VolumeSize: "20Gi",
+						Throughput: ptr.To[int64](500),
+					}}
+
+					attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+					err := admissionHandler.Admit(ctx, attrs, nil)
+
+					Expect(err).To(BeForbiddenError())
+					Expect(err).To(MatchError(ContainSubstring("spec.provider.workers[0].volume.iops: Invalid value: 16001: iops must be <= 16000 for volume type %q", volumeType)))
+					Expect(err).To(MatchError(ContainSubstring("spec.provider.workers[0].dataVolumes[0].throughput: Forbidden: volume type %q does not support configuring throughput", volumeType)))
+				})
 			})
 
 			Context("RawExtension internal API usage checks", func() {
@@ -5398,6 +5859,155 @@ var _ = Describe("validator", func() {
 			})
 		})
 
+		Context("etcd backup settings", func() {
+			BeforeEach(func() {
+				seed.Spec.Backup = &gardencorev1beta1.SeedBackup{
+					ETCD: &gardencorev1beta1.SeedBackupETCD{
+						MinFullSnapshotInterval:         &metav1.Duration{Duration: 6 * time.Hour},
+						MaxDeltaSnapshotRetentionPeriod: &metav1.Duration{Duration: 7 * 24 * time.Hour},
+					},
+				}
+
+				Expect(coreInformerFactory.Core().V1beta1().Projects().Informer().GetStore().Add(&project)).To(Succeed())
+				Expect(coreInformerFactory.Core().V1beta1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)).To(Succeed())
+				Expect(coreInformerFactory.Core().V1beta1().Seeds().Informer().GetStore().Add(&seed)).To(Succeed())
+				Expect(coreInformerFactory.Core().V1beta1().SecretBindings().Informer().GetStore().Add(&secretBinding)).To(Succeed())
+				Expect(securityInformerFactory.Security().V1alpha1().CredentialsBindings().Informer().GetStore().Add(&credentialsBinding)).To(Succeed())
+			})
+
+			It("should allow creation because the settings are within the bounds of the seed", func() {
+				shoot.Spec.ETCD = &core.ETCD{Backup: &core.ETCDBackup{
+					FullSnapshotSchedule:         ptr.To("0 */12 * * *"),
+					DeltaSnapshotRetentionPeriod: &metav1.Duration{Duration: 3 * 24 * time.Hour},
+				}}
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+				Expect(admissionHandler.Admit(ctx, attrs, nil)).To(Succeed())
+			})
+
+			It("should reject creation because the settings are out of the bounds of the seed", func() {
+				shoot.Spec.ETCD = &core.ETCD{Backup: &core.ETCDBackup{
+					FullSnapshotSchedule:         ptr.To("0 * * * *"),
+					DeltaSnapshotRetentionPeriod: &metav1.Duration{Duration: 14 * 24 * time.Hour},
+				}}
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+				err := admissionHandler.Admit(ctx, attrs, nil)
+
+				Expect(err).To(BeForbiddenError())
+				Expect(err.Error()).To(And(
+					ContainSubstring("spec.etcd.backup.fullSnapshotSchedule"),
+					ContainSubstring("spec.etcd.backup.deltaSnapshotRetentionPeriod"),
+				))
+			})
+
+			It("should reject update because the changed settings are out of the bounds of the seed", func() {
+				oldShoot := shoot.DeepCopy()
+				shoot.Spec.ETCD = &core.ETCD{Backup: &core.ETCDBackup{
+					DeltaSnapshotRetentionPeriod: &metav1.Duration{Duration: 14 * 24 * time.Hour},
+				}}
+
+				attrs := admission.NewAttributesRecord(&shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+				err := admissionHandler.Admit(ctx, attrs, nil)
+
+				Expect(err).To(BeForbiddenError())
+				Expect(err.Error()).To(ContainSubstring("exceeds the maximum retention period"))
+			})
+
+			It("should allow update because the unchanged settings are not validated against the bounds of the seed", func() {
+				shoot.Spec.ETCD = &core.ETCD{Backup: &core.ETCDBackup{
+					FullSnapshotSchedule: ptr.To("0 * * * *"),
+				}}
+				oldShoot := shoot.DeepCopy()
+				shoot.Spec.Kubernetes.KubeAPIServer = &core.KubeAPIServerConfig{EnableAnonymousAuthentication: ptr.To(false)}
+
+				attrs := admission.NewAttributesRecord(&shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+				Expect(admissionHandler.Admit(ctx, attrs, nil)).To(Succeed())
+			})
+		})
+
+		Context("kube-apiserver endpoint port", func() {
+			BeforeEach(func() {
+				seed.Spec.Ingress = &gardencorev1beta1.Ingress{
+					Domain:             "ingress.example.com",
+					KubeAPIServerPorts: []int32{6443},
+				}
+
+				Expect(coreInformerFactory.Core().V1beta1().Projects().Informer().GetStore().Add(&project)).To(Succeed())
+				Expect(coreInformerFactory.Core().V1beta1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)).To(Succeed())
+				Expect(coreInformerFactory.Core().V1beta1().Seeds().Informer().GetStore().Add(&seed)).To(Succeed())
+				Expect(coreInformerFactory.Core().V1beta1().SecretBindings().Informer().GetStore().Add(&secretBinding)).To(Succeed())
+				Expect(securityInformerFactory.Security().V1alpha1().CredentialsBindings().Informer().GetStore().Add(&credentialsBinding)).To(Succeed())
+			})
+
+			It("should allow creation because the port is exposed by the seed", func() {
+				shoot.Spec.Kubernetes.KubeAPIServer = &core.KubeAPIServerConfig{Endpoint: &core.KubeAPIServerEndpoint{Port: ptr.To[int32](6443)}}
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+				Expect(admissionHandler.Admit(ctx, attrs, nil)).To(Succeed())
+			})
+
+			It("should reject creation because the port is not exposed by the seed", func() {
+				shoot.Spec.Kubernetes.KubeAPIServer = &core.KubeAPIServerConfig{Endpoint: &core.KubeAPIServerEndpoint{Port: ptr.To[int32](7443)}}
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+				err := admissionHandler.Admit(ctx, attrs, nil)
+
+				Expect(err).To(BeForbiddenError())
+				Expect(err.Error()).To(ContainSubstring("port 7443 is not exposed by the ingress gateway of seed"))
+			})
+
+			It("should allow update because the unchanged port is not validated", func() {
+				shoot.Spec.Kubernetes.KubeAPIServer = &core.KubeAPIServerConfig{Endpoint: &core.KubeAPIServerEndpoint{Port: ptr.To[int32](7443)}}
+				oldShoot := shoot.DeepCopy()
+				shoot.Spec.Kubernetes.KubeAPIServer.EnableAnonymousAuthentication = ptr.To(false)
+
+				attrs := admission.NewAttributesRecord(&shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+				Expect(admissionHandler.Admit(ctx, attrs, nil)).To(Succeed())
+			})
+		})
+
+		Context("kube-apiserver managed certificate", func() {
+			BeforeEach(func() {
+				Expect(coreInformerFactory.Core().V1beta1().Projects().Informer().GetStore().Add(&project)).To(Succeed())
+				Expect(coreInformerFactory.Core().V1beta1().CloudProfiles().Informer().GetStore().Add(&cloudProfile)).To(Succeed())
+				Expect(securityInformerFactory.Security().V1alpha1().CredentialsBindings().Informer().GetStore().Add(&credentialsBinding)).To(Succeed())
+				Expect(coreInformerFactory.Core().V1beta1().SecretBindings().Informer().GetStore().Add(&secretBinding)).To(Succeed())
+
+				shoot.Spec.Kubernetes.KubeAPIServer = &core.KubeAPIServerConfig{Endpoint: &core.KubeAPIServerEndpoint{
+					AdditionalHostnames: []string{"api.example.com"},
+					ManagedCertificate:  ptr.To(true),
+				}}
+			})
+
+			It("should allow creation because the seed has an ACME server configured", func() {
+				seed.Spec.Settings = &gardencorev1beta1.SeedSettings{ACME: &gardencorev1beta1.SeedSettingACME{DirectoryURL: "https://acme.example.com/directory"}}
+				Expect(coreInformerFactory.Core().V1beta1().Seeds().Informer().GetStore().Add(&seed)).To(Succeed())
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+				Expect(admissionHandler.Admit(ctx, attrs, nil)).To(Succeed())
+			})
+
+			It("should reject creation because the seed has no ACME server configured", func() {
+				Expect(coreInformerFactory.Core().V1beta1().Seeds().Informer().GetStore().Add(&seed)).To(Succeed())
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, userInfo)
+				err := admissionHandler.Admit(ctx, attrs, nil)
+
+				Expect(err).To(BeForbiddenError())
+				Expect(err.Error()).To(ContainSubstring("has no ACME server configured"))
+			})
+
+			It("should allow update because the unchanged request is not validated", func() {
+				Expect(coreInformerFactory.Core().V1beta1().Seeds().Informer().GetStore().Add(&seed)).To(Succeed())
+				oldShoot := shoot.DeepCopy()
+				shoot.Spec.Kubernetes.KubeAPIServer.EnableAnonymousAuthentication = ptr.To(false)
+
+				attrs := admission.NewAttributesRecord(&shoot, oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, userInfo)
+				Expect(admissionHandler.Admit(ctx, attrs, nil)).To(Succeed())
+			})
+		})
+
 		Context("control plane migration", func() {
 			var (
 				oldSeedName string
@@ -565,6 +565,26 @@ var _ = Describe("Shoot Maintenance controller tests", func() {
 			}).Should(Succeed())
 		})
 
+		It("Do not update Shoot machine image in maintenance time: AutoUpdate.MachineImageVersion == true && node rolls are excluded", func() {
+			patch := client.StrategicMergeFrom(shoot.DeepCopy())
+			shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion = ptr.To(true)
+			shoot.Spec.Maintenance.Exclusions = []gardencorev1beta1.MaintenanceExclusion{{
+				Name:       "peak-season",
+				Begin:      metav1.NewTime(fakeClock.Now().Add(-time.Hour)),
+				End:        metav1.NewTime(fakeClock.Now().Add(time.Hour)),
+				Operations: []gardencorev1beta1.MaintenanceExclusionOperation{gardencorev1beta1.MaintenanceExclusionOperationNodeRoll},
+			}}
+			Expect(testClient.Patch(ctx, shoot, patch)).To(Succeed())
+
+			Expect(kubernetesutils.SetAnnotationAndUpdate(ctx, testClient, shoot, v1beta1constants.GardenerOperation, v1beta1constants.ShootOperationMaintain)).To(Succeed())
+
+			Consistently(func(g Gomega) {
+				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+				g.Expect(*shoot.Spec.Provider.Workers[0].Machine.Image).To(Equal(gardencorev1beta1.ShootMachineImage{Name: testMachineImage.Name, Version: testMachineImage.Version}))
+				g.Expect(*shoot.Spec.Provider.Workers[1].Machine.Image).To(Equal(gardencorev1beta1.ShootMachineImage{Name: testMachineImage.Name, Version: testMachineImage.Version}))
+			}).Should(Succeed())
+		})
+
 		Describe("AutoUpdateStrategy: major (default)", func() {
 			BeforeEach(func() {
 				By("Set updateStrategy: major for Shoot's machine image in the CloudProfile")
@@ -30,6 +30,7 @@ var _ = Describe("Shoot Reference controller tests", func() {
 		configMap2 *corev1.ConfigMap
 		configMap3 *corev1.ConfigMap
 		configMap4 *corev1.ConfigMap
+		configMap5 *corev1.ConfigMap
 
 		allReferencedObjects []client.Object
 		shoot                *gardencorev1beta1.Shoot
@@ -46,9 +47,10 @@ var _ = Describe("Shoot Reference controller tests", func() {
 		configMap2 = initializeObject("configMap").(*corev1.ConfigMap)
 		configMap3 = initializeObject("configMap").(*corev1.ConfigMap)
 		configMap4 = initializeObject("configMap").(*corev1.ConfigMap)
+		configMap5 = initializeObject("configMap").(*corev1.ConfigMap)
 
 		allReferencedObjects = append([]client.Object{}, secret1, secret2, secret3, secret4, secret5)
-		allReferencedObjects = append(allReferencedObjects, configMap1, configMap2, configMap3, configMap4)
+		allReferencedObjects = append(allReferencedObjects, configMap1, configMap2, configMap3, configMap4, configMap5)
 
 		shoot = &gardencorev1beta1.Shoot{
 			ObjectMeta: metav1.ObjectMeta{
@@ -86,6 +88,7 @@ var _ = Describe("Shoot Reference controller tests", func() {
 						AdmissionPlugins: []gardencorev1beta1.AdmissionPlugin{{
 							Name:                 "ValidatingAdmissionWebhook",
 							KubeconfigSecretName: &secret5.Name,
+							ConfigMapName:        &configMap5.Name,
 						}},
 						AuditConfig: &gardencorev1beta1.AuditConfig{
 							AuditPolicy: &gardencorev1beta1.AuditPolicy{
@@ -146,6 +146,10 @@ var _ = Describe("Bastion controller tests", func() {
 				Ingress: []operationsv1alpha1.BastionIngressPolicy{{
 					IPBlock: networkingv1.IPBlock{CIDR: "1.2.3.4/32"},
 				}},
+				Ports: []operationsv1alpha1.BastionPort{{
+					Protocol: operationsv1alpha1.BastionProtocolRDP,
+					Port:     ptr.To[int32](3389),
+				}},
 			},
 		}
 	})
@@ -276,6 +280,7 @@ var _ = Describe("Bastion controller tests", func() {
 				))
 				g.Expect(extensionBastion.Spec.Type).To(Equal(*operationsBastion.Spec.ProviderType))
 				g.Expect(extensionBastion.Spec.UserData).To(Equal(createUserData(operationsBastion)))
+				g.Expect(extensionBastion.Spec.Ports).To(ConsistOf(int32(3389)))
 			}).Should(Succeed())
 		})
 
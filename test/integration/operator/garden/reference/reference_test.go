@@ -34,6 +34,8 @@ var _ = Describe("Garden Reference controller tests", func() {
 		configMap2 *corev1.ConfigMap
 		configMap3 *corev1.ConfigMap
 		configMap4 *corev1.ConfigMap
+		configMap5 *corev1.ConfigMap
+		configMap6 *corev1.ConfigMap
 
 		allReferencedObjects []client.Object
 		garden               *operatorv1alpha1.Garden
@@ -54,9 +56,11 @@ var _ = Describe("Garden Reference controller tests", func() {
 		configMap2 = initializeObject("configMap").(*corev1.ConfigMap)
 		configMap3 = initializeObject("configMap").(*corev1.ConfigMap)
 		configMap4 = initializeObject("configMap").(*corev1.ConfigMap)
+		configMap5 = initializeObject("configMap").(*corev1.ConfigMap)
+		configMap6 = initializeObject("configMap").(*corev1.ConfigMap)
 
 		allReferencedObjects = append([]client.Object{}, secret1, secret2, secret3, secret4, secret5, secret6, secret7, secret8, secret9)
-		allReferencedObjects = append(allReferencedObjects, configMap1, configMap2, configMap3, configMap4)
+		allReferencedObjects = append(allReferencedObjects, configMap1, configMap2, configMap3, configMap4, configMap5, configMap6)
 
 		garden = &operatorv1alpha1.Garden{
 			ObjectMeta: metav1.ObjectMeta{
@@ -105,7 +109,7 @@ var _ = Describe("Garden Reference controller tests", func() {
 						Version: "1.26.3",
 						KubeAPIServer: &operatorv1alpha1.KubeAPIServerConfig{
 							KubeAPIServerConfig: &gardencorev1beta1.KubeAPIServerConfig{
-								AdmissionPlugins: []gardencorev1beta1.AdmissionPlugin{{Name: "ValidatingWebhook", KubeconfigSecretName: &secret2.Name}},
+								AdmissionPlugins: []gardencorev1beta1.AdmissionPlugin{{Name: "ValidatingWebhook", KubeconfigSecretName: &secret2.Name, ConfigMapName: &configMap5.Name}},
 								AuditConfig: &gardencorev1beta1.AuditConfig{
 									AuditPolicy: &gardencorev1beta1.AuditPolicy{
 										ConfigMapRef: &corev1.ObjectReference{
@@ -140,7 +144,7 @@ var _ = Describe("Garden Reference controller tests", func() {
 					Gardener: operatorv1alpha1.Gardener{
 						ClusterIdentity: "test",
 						APIServer: &operatorv1alpha1.GardenerAPIServerConfig{
-							AdmissionPlugins: []gardencorev1beta1.AdmissionPlugin{{Name: "ValidatingWebhook", KubeconfigSecretName: &secret6.Name}},
+							AdmissionPlugins: []gardencorev1beta1.AdmissionPlugin{{Name: "ValidatingWebhook", KubeconfigSecretName: &secret6.Name, ConfigMapName: &configMap6.Name}},
 							AuditConfig: &gardencorev1beta1.AuditConfig{
 								AuditPolicy: &gardencorev1beta1.AuditPolicy{
 									ConfigMapRef: &corev1.ObjectReference{
@@ -141,6 +141,12 @@ var _ = Describe("ManagedResource controller tests", func() {
 				}).Should(
 					ContainCondition(OfType(resourcesv1alpha1.ResourcesApplied), WithStatus(gardencorev1beta1.ConditionTrue), WithReason(resourcesv1alpha1.ConditionApplySucceeded)),
 				)
+
+				// The ConfigMap is either created by the first reconciliation or unchanged by subsequent ones.
+				Expect(managedResource.Status.ResourcesApplied).NotTo(BeNil())
+				Expect(managedResource.Status.ResourcesApplied.Created + managedResource.Status.ResourcesApplied.Unchanged).To(Equal(int32(1)))
+				Expect(managedResource.Status.ResourcesApplied.Updated).To(BeZero())
+				Expect(managedResource.Status.ResourcesApplied.Deleted).To(BeZero())
 			}
 
 			Context("with uncompressed data", func() {